@@ -0,0 +1,102 @@
+package api_container_tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/fips_mode"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// The certificates of the API containers are issued for this name rather than for their addresses, which depend on
+	// where they're reached from, e.g. the enclave network, the host machine or a port forward
+	ServerName = "kurtosis-api-container"
+)
+
+// NewClientTlsConfig returns the TLS config of the connections to an API container that requires mutual TLS, from the
+// PEM-encoded credentials the engine issued for its enclave. The connections are restricted to the FIPS-approved
+// parameters if the engine requires it, or if the process is in FIPS mode.
+func NewClientTlsConfig(certificateAuthorityPem string, clientCertificatePem string, clientKeyPem string, isFipsModeRequired bool) (*tls.Config, error) {
+	clientCertificate, err := tls.X509KeyPair([]byte(clientCertificatePem), []byte(clientKeyPem))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the client certificate of the API container")
+	}
+	tlsConfig, err := newClientTlsConfig(certificateAuthorityPem, []tls.Certificate{clientCertificate}, nil, isFipsModeRequired)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the TLS config of the connection to the API container")
+	}
+	return tlsConfig, nil
+}
+
+// NewClientTransportCredentials is NewClientTlsConfig for the gRPC connections
+func NewClientTransportCredentials(certificateAuthorityPem string, clientCertificatePem string, clientKeyPem string, isFipsModeRequired bool) (credentials.TransportCredentials, error) {
+	tlsConfig, err := NewClientTlsConfig(certificateAuthorityPem, clientCertificatePem, clientKeyPem, isFipsModeRequired)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the TLS config of the connection to the API container")
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// NewRenewingClientTransportCredentials is NewClientTransportCredentials for the client certificates that expire before
+// the caller is done with the API container, which getClientCertificate renews as the connections get established
+func NewRenewingClientTransportCredentials(certificateAuthorityPem string, getClientCertificate func(*tls.CertificateRequestInfo) (*tls.Certificate, error), isFipsModeRequired bool) (credentials.TransportCredentials, error) {
+	tlsConfig, err := newClientTlsConfig(certificateAuthorityPem, nil, getClientCertificate, isFipsModeRequired)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the TLS config of the connection to the API container")
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func newClientTlsConfig(certificateAuthorityPem string, clientCertificates []tls.Certificate, getClientCertificate func(*tls.CertificateRequestInfo) (*tls.Certificate, error), isFipsModeRequired bool) (*tls.Config, error) {
+	certificateAuthorityPool, err := NewCertificatePool(certificateAuthorityPem)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the certificate authority of the API container")
+	}
+	tlsConfig := &tls.Config{
+		Rand:                                nil,
+		Time:                                nil,
+		Certificates:                        clientCertificates,
+		NameToCertificate:                   nil,
+		GetCertificate:                      nil,
+		GetClientCertificate:                getClientCertificate,
+		GetConfigForClient:                  nil,
+		VerifyPeerCertificate:               nil,
+		VerifyConnection:                    nil,
+		RootCAs:                             certificateAuthorityPool,
+		NextProtos:                          nil,
+		ServerName:                          ServerName,
+		ClientAuth:                          0,
+		ClientCAs:                           nil,
+		InsecureSkipVerify:                  false,
+		CipherSuites:                        nil,
+		PreferServerCipherSuites:            false,
+		SessionTicketsDisabled:              false,
+		SessionTicketKey:                    [32]byte{},
+		ClientSessionCache:                  nil,
+		UnwrapSession:                       nil,
+		WrapSession:                         nil,
+		MinVersion:                          tls.VersionTLS12,
+		MaxVersion:                          0,
+		CurvePreferences:                    nil,
+		DynamicRecordSizingDisabled:         false,
+		Renegotiation:                       0,
+		KeyLogWriter:                        nil,
+		EncryptedClientHelloConfigList:      nil,
+		EncryptedClientHelloRejectionVerify: nil,
+	}
+	if isFipsModeRequired || fips_mode.IsEnabled() {
+		fips_mode.RestrictTlsConfig(tlsConfig)
+	}
+	return tlsConfig, nil
+}
+
+// NewCertificatePool returns a pool trusting the PEM-encoded certificate authority
+func NewCertificatePool(certificateAuthorityPem string) (*x509.CertPool, error) {
+	certificatePool := x509.NewCertPool()
+	if !certificatePool.AppendCertsFromPEM([]byte(certificateAuthorityPem)) {
+		return nil, stacktrace.NewError("No PEM-encoded certificate was found in the certificate authority")
+	}
+	return certificatePool, nil
+}
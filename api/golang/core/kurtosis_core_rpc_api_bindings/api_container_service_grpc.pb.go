@@ -25,6 +25,8 @@ const (
 	ApiContainerService_RunStarlarkPackage_FullMethodName                         = "/api_container_api.ApiContainerService/RunStarlarkPackage"
 	ApiContainerService_GetServices_FullMethodName                                = "/api_container_api.ApiContainerService/GetServices"
 	ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_FullMethodName = "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers"
+	ApiContainerService_GetServiceDependencyGraph_FullMethodName                  = "/api_container_api.ApiContainerService/GetServiceDependencyGraph"
+	ApiContainerService_SetEnclaveEnvVars_FullMethodName                          = "/api_container_api.ApiContainerService/SetEnclaveEnvVars"
 	ApiContainerService_ExecCommand_FullMethodName                                = "/api_container_api.ApiContainerService/ExecCommand"
 	ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName         = "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability"
 	ApiContainerService_WaitForHttpPostEndpointAvailability_FullMethodName        = "/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability"
@@ -34,10 +36,15 @@ const (
 	ApiContainerService_StoreFilesArtifactFromService_FullMethodName              = "/api_container_api.ApiContainerService/StoreFilesArtifactFromService"
 	ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName             = "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids"
 	ApiContainerService_InspectFilesArtifactContents_FullMethodName               = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
+	ApiContainerService_GetFilesArtifactHistory_FullMethodName                    = "/api_container_api.ApiContainerService/GetFilesArtifactHistory"
 	ApiContainerService_ConnectServices_FullMethodName                            = "/api_container_api.ApiContainerService/ConnectServices"
 	ApiContainerService_GetStarlarkRun_FullMethodName                             = "/api_container_api.ApiContainerService/GetStarlarkRun"
 	ApiContainerService_GetStarlarkScriptPlanYaml_FullMethodName                  = "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml"
 	ApiContainerService_GetStarlarkPackagePlanYaml_FullMethodName                 = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	ApiContainerService_WatchServiceEvents_FullMethodName                         = "/api_container_api.ApiContainerService/WatchServiceEvents"
+	ApiContainerService_StartServices_FullMethodName                              = "/api_container_api.ApiContainerService/StartServices"
+	ApiContainerService_StopServices_FullMethodName                               = "/api_container_api.ApiContainerService/StopServices"
+	ApiContainerService_RemoveServices_FullMethodName                             = "/api_container_api.ApiContainerService/RemoveServices"
 )
 
 // ApiContainerServiceClient is the client API for ApiContainerService service.
@@ -54,6 +61,11 @@ type ApiContainerServiceClient interface {
 	GetServices(ctx context.Context, in *GetServicesArgs, opts ...grpc.CallOption) (*GetServicesResponse, error)
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
+	// Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+	GetServiceDependencyGraph(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetServiceDependencyGraphResponse, error)
+	// Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+	// a service config take precedence over the enclave ones
+	SetEnclaveEnvVars(ctx context.Context, in *SetEnclaveEnvVarsArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Executes the given command inside a running container
 	ExecCommand(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (*ExecCommandResponse, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
@@ -70,6 +82,8 @@ type ApiContainerServiceClient interface {
 	StoreFilesArtifactFromService(ctx context.Context, in *StoreFilesArtifactFromServiceArgs, opts ...grpc.CallOption) (*StoreFilesArtifactFromServiceResponse, error)
 	ListFilesArtifactNamesAndUuids(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFilesArtifactNamesAndUuidsResponse, error)
 	InspectFilesArtifactContents(ctx context.Context, in *InspectFilesArtifactContentsRequest, opts ...grpc.CallOption) (*InspectFilesArtifactContentsResponse, error)
+	// Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+	GetFilesArtifactHistory(ctx context.Context, in *GetFilesArtifactHistoryArgs, opts ...grpc.CallOption) (*GetFilesArtifactHistoryResponse, error)
 	// User services port forwarding
 	ConnectServices(ctx context.Context, in *ConnectServicesArgs, opts ...grpc.CallOption) (*ConnectServicesResponse, error)
 	// Get last Starlark run
@@ -78,6 +92,14 @@ type ApiContainerServiceClient interface {
 	GetStarlarkScriptPlanYaml(ctx context.Context, in *StarlarkScriptPlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(ctx context.Context, in *StarlarkPackagePlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
+	// Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+	WatchServiceEvents(ctx context.Context, in *WatchServiceEventsArgs, opts ...grpc.CallOption) (ApiContainerService_WatchServiceEventsClient, error)
+	// Starts either all the services or none of them: the ones that started are stopped back if any fails to
+	StartServices(ctx context.Context, in *BulkServiceOperationArgs, opts ...grpc.CallOption) (*BulkServiceOperationResponse, error)
+	// Stops either all the services or none of them: the ones that stopped are started back if any fails to
+	StopServices(ctx context.Context, in *BulkServiceOperationArgs, opts ...grpc.CallOption) (*BulkServiceOperationResponse, error)
+	// Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+	RemoveServices(ctx context.Context, in *BulkServiceOperationArgs, opts ...grpc.CallOption) (*BulkServiceOperationResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -204,6 +226,24 @@ func (c *apiContainerServiceClient) GetExistingAndHistoricalServiceIdentifiers(c
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetServiceDependencyGraph(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetServiceDependencyGraphResponse, error) {
+	out := new(GetServiceDependencyGraphResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetServiceDependencyGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) SetEnclaveEnvVars(ctx context.Context, in *SetEnclaveEnvVarsArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_SetEnclaveEnvVars_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (*ExecCommandResponse, error) {
 	out := new(ExecCommandResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_ExecCommand_FullMethodName, in, out, opts...)
@@ -333,6 +373,15 @@ func (c *apiContainerServiceClient) InspectFilesArtifactContents(ctx context.Con
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetFilesArtifactHistory(ctx context.Context, in *GetFilesArtifactHistoryArgs, opts ...grpc.CallOption) (*GetFilesArtifactHistoryResponse, error) {
+	out := new(GetFilesArtifactHistoryResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetFilesArtifactHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) ConnectServices(ctx context.Context, in *ConnectServicesArgs, opts ...grpc.CallOption) (*ConnectServicesResponse, error) {
 	out := new(ConnectServicesResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_ConnectServices_FullMethodName, in, out, opts...)
@@ -369,6 +418,65 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) WatchServiceEvents(ctx context.Context, in *WatchServiceEventsArgs, opts ...grpc.CallOption) (ApiContainerService_WatchServiceEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[5], ApiContainerService_WatchServiceEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceWatchServiceEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_WatchServiceEventsClient interface {
+	Recv() (*ServiceEvent, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceWatchServiceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceWatchServiceEventsClient) Recv() (*ServiceEvent, error) {
+	m := new(ServiceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) StartServices(ctx context.Context, in *BulkServiceOperationArgs, opts ...grpc.CallOption) (*BulkServiceOperationResponse, error) {
+	out := new(BulkServiceOperationResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_StartServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) StopServices(ctx context.Context, in *BulkServiceOperationArgs, opts ...grpc.CallOption) (*BulkServiceOperationResponse, error) {
+	out := new(BulkServiceOperationResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_StopServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RemoveServices(ctx context.Context, in *BulkServiceOperationArgs, opts ...grpc.CallOption) (*BulkServiceOperationResponse, error) {
+	out := new(BulkServiceOperationResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_RemoveServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -383,6 +491,11 @@ type ApiContainerServiceServer interface {
 	GetServices(context.Context, *GetServicesArgs) (*GetServicesResponse, error)
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
+	// Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+	GetServiceDependencyGraph(context.Context, *emptypb.Empty) (*GetServiceDependencyGraphResponse, error)
+	// Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+	// a service config take precedence over the enclave ones
+	SetEnclaveEnvVars(context.Context, *SetEnclaveEnvVarsArgs) (*emptypb.Empty, error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
@@ -399,6 +512,8 @@ type ApiContainerServiceServer interface {
 	StoreFilesArtifactFromService(context.Context, *StoreFilesArtifactFromServiceArgs) (*StoreFilesArtifactFromServiceResponse, error)
 	ListFilesArtifactNamesAndUuids(context.Context, *emptypb.Empty) (*ListFilesArtifactNamesAndUuidsResponse, error)
 	InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsRequest) (*InspectFilesArtifactContentsResponse, error)
+	// Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+	GetFilesArtifactHistory(context.Context, *GetFilesArtifactHistoryArgs) (*GetFilesArtifactHistoryResponse, error)
 	// User services port forwarding
 	ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error)
 	// Get last Starlark run
@@ -407,6 +522,14 @@ type ApiContainerServiceServer interface {
 	GetStarlarkScriptPlanYaml(context.Context, *StarlarkScriptPlanYamlArgs) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error)
+	// Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+	WatchServiceEvents(*WatchServiceEventsArgs, ApiContainerService_WatchServiceEventsServer) error
+	// Starts either all the services or none of them: the ones that started are stopped back if any fails to
+	StartServices(context.Context, *BulkServiceOperationArgs) (*BulkServiceOperationResponse, error)
+	// Stops either all the services or none of them: the ones that stopped are started back if any fails to
+	StopServices(context.Context, *BulkServiceOperationArgs) (*BulkServiceOperationResponse, error)
+	// Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+	RemoveServices(context.Context, *BulkServiceOperationArgs) (*BulkServiceOperationResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -428,6 +551,12 @@ func (UnimplementedApiContainerServiceServer) GetServices(context.Context, *GetS
 func (UnimplementedApiContainerServiceServer) GetExistingAndHistoricalServiceIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalServiceIdentifiersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExistingAndHistoricalServiceIdentifiers not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetServiceDependencyGraph(context.Context, *emptypb.Empty) (*GetServiceDependencyGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceDependencyGraph not implemented")
+}
+func (UnimplementedApiContainerServiceServer) SetEnclaveEnvVars(context.Context, *SetEnclaveEnvVarsArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnclaveEnvVars not implemented")
+}
 func (UnimplementedApiContainerServiceServer) ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecCommand not implemented")
 }
@@ -455,6 +584,9 @@ func (UnimplementedApiContainerServiceServer) ListFilesArtifactNamesAndUuids(con
 func (UnimplementedApiContainerServiceServer) InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsRequest) (*InspectFilesArtifactContentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectFilesArtifactContents not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetFilesArtifactHistory(context.Context, *GetFilesArtifactHistoryArgs) (*GetFilesArtifactHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilesArtifactHistory not implemented")
+}
 func (UnimplementedApiContainerServiceServer) ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectServices not implemented")
 }
@@ -467,6 +599,18 @@ func (UnimplementedApiContainerServiceServer) GetStarlarkScriptPlanYaml(context.
 func (UnimplementedApiContainerServiceServer) GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkPackagePlanYaml not implemented")
 }
+func (UnimplementedApiContainerServiceServer) WatchServiceEvents(*WatchServiceEventsArgs, ApiContainerService_WatchServiceEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchServiceEvents not implemented")
+}
+func (UnimplementedApiContainerServiceServer) StartServices(context.Context, *BulkServiceOperationArgs) (*BulkServiceOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartServices not implemented")
+}
+func (UnimplementedApiContainerServiceServer) StopServices(context.Context, *BulkServiceOperationArgs) (*BulkServiceOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopServices not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RemoveServices(context.Context, *BulkServiceOperationArgs) (*BulkServiceOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveServices not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -583,6 +727,42 @@ func _ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_Handler(srv
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_GetServiceDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetServiceDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetServiceDependencyGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetServiceDependencyGraph(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_SetEnclaveEnvVars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnclaveEnvVarsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).SetEnclaveEnvVars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_SetEnclaveEnvVars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).SetEnclaveEnvVars(ctx, req.(*SetEnclaveEnvVarsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_ExecCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecCommandArgs)
 	if err := dec(in); err != nil {
@@ -756,6 +936,24 @@ func _ApiContainerService_InspectFilesArtifactContents_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_GetFilesArtifactHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilesArtifactHistoryArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetFilesArtifactHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetFilesArtifactHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetFilesArtifactHistory(ctx, req.(*GetFilesArtifactHistoryArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_ConnectServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConnectServicesArgs)
 	if err := dec(in); err != nil {
@@ -828,6 +1026,81 @@ func _ApiContainerService_GetStarlarkPackagePlanYaml_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_WatchServiceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchServiceEventsArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).WatchServiceEvents(m, &apiContainerServiceWatchServiceEventsServer{stream})
+}
+
+type ApiContainerService_WatchServiceEventsServer interface {
+	Send(*ServiceEvent) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceWatchServiceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceWatchServiceEventsServer) Send(m *ServiceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_StartServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkServiceOperationArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).StartServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_StartServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).StartServices(ctx, req.(*BulkServiceOperationArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_StopServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkServiceOperationArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).StopServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_StopServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).StopServices(ctx, req.(*BulkServiceOperationArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RemoveServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkServiceOperationArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RemoveServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RemoveServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RemoveServices(ctx, req.(*BulkServiceOperationArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -843,6 +1116,14 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExistingAndHistoricalServiceIdentifiers",
 			Handler:    _ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_Handler,
 		},
+		{
+			MethodName: "GetServiceDependencyGraph",
+			Handler:    _ApiContainerService_GetServiceDependencyGraph_Handler,
+		},
+		{
+			MethodName: "SetEnclaveEnvVars",
+			Handler:    _ApiContainerService_SetEnclaveEnvVars_Handler,
+		},
 		{
 			MethodName: "ExecCommand",
 			Handler:    _ApiContainerService_ExecCommand_Handler,
@@ -871,6 +1152,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InspectFilesArtifactContents",
 			Handler:    _ApiContainerService_InspectFilesArtifactContents_Handler,
 		},
+		{
+			MethodName: "GetFilesArtifactHistory",
+			Handler:    _ApiContainerService_GetFilesArtifactHistory_Handler,
+		},
 		{
 			MethodName: "ConnectServices",
 			Handler:    _ApiContainerService_ConnectServices_Handler,
@@ -887,6 +1172,18 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStarlarkPackagePlanYaml",
 			Handler:    _ApiContainerService_GetStarlarkPackagePlanYaml_Handler,
 		},
+		{
+			MethodName: "StartServices",
+			Handler:    _ApiContainerService_StartServices_Handler,
+		},
+		{
+			MethodName: "StopServices",
+			Handler:    _ApiContainerService_StopServices_Handler,
+		},
+		{
+			MethodName: "RemoveServices",
+			Handler:    _ApiContainerService_RemoveServices_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -914,6 +1211,11 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_DownloadFilesArtifact_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchServiceEvents",
+			Handler:       _ApiContainerService_WatchServiceEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -49,6 +49,12 @@ const (
 	// ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure is the fully-qualified
 	// name of the ApiContainerService's GetExistingAndHistoricalServiceIdentifiers RPC.
 	ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure = "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers"
+	// ApiContainerServiceGetServiceDependencyGraphProcedure is the fully-qualified name of the
+	// ApiContainerService's GetServiceDependencyGraph RPC.
+	ApiContainerServiceGetServiceDependencyGraphProcedure = "/api_container_api.ApiContainerService/GetServiceDependencyGraph"
+	// ApiContainerServiceSetEnclaveEnvVarsProcedure is the fully-qualified name of the
+	// ApiContainerService's SetEnclaveEnvVars RPC.
+	ApiContainerServiceSetEnclaveEnvVarsProcedure = "/api_container_api.ApiContainerService/SetEnclaveEnvVars"
 	// ApiContainerServiceExecCommandProcedure is the fully-qualified name of the ApiContainerService's
 	// ExecCommand RPC.
 	ApiContainerServiceExecCommandProcedure = "/api_container_api.ApiContainerService/ExecCommand"
@@ -76,6 +82,9 @@ const (
 	// ApiContainerServiceInspectFilesArtifactContentsProcedure is the fully-qualified name of the
 	// ApiContainerService's InspectFilesArtifactContents RPC.
 	ApiContainerServiceInspectFilesArtifactContentsProcedure = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
+	// ApiContainerServiceGetFilesArtifactHistoryProcedure is the fully-qualified name of the
+	// ApiContainerService's GetFilesArtifactHistory RPC.
+	ApiContainerServiceGetFilesArtifactHistoryProcedure = "/api_container_api.ApiContainerService/GetFilesArtifactHistory"
 	// ApiContainerServiceConnectServicesProcedure is the fully-qualified name of the
 	// ApiContainerService's ConnectServices RPC.
 	ApiContainerServiceConnectServicesProcedure = "/api_container_api.ApiContainerService/ConnectServices"
@@ -88,6 +97,18 @@ const (
 	// ApiContainerServiceGetStarlarkPackagePlanYamlProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkPackagePlanYaml RPC.
 	ApiContainerServiceGetStarlarkPackagePlanYamlProcedure = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	// ApiContainerServiceWatchServiceEventsProcedure is the fully-qualified name of the
+	// ApiContainerService's WatchServiceEvents RPC.
+	ApiContainerServiceWatchServiceEventsProcedure = "/api_container_api.ApiContainerService/WatchServiceEvents"
+	// ApiContainerServiceStartServicesProcedure is the fully-qualified name of the
+	// ApiContainerService's StartServices RPC.
+	ApiContainerServiceStartServicesProcedure = "/api_container_api.ApiContainerService/StartServices"
+	// ApiContainerServiceStopServicesProcedure is the fully-qualified name of the ApiContainerService's
+	// StopServices RPC.
+	ApiContainerServiceStopServicesProcedure = "/api_container_api.ApiContainerService/StopServices"
+	// ApiContainerServiceRemoveServicesProcedure is the fully-qualified name of the
+	// ApiContainerService's RemoveServices RPC.
+	ApiContainerServiceRemoveServicesProcedure = "/api_container_api.ApiContainerService/RemoveServices"
 )
 
 // ApiContainerServiceClient is a client for the api_container_api.ApiContainerService service.
@@ -102,6 +123,11 @@ type ApiContainerServiceClient interface {
 	GetServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServicesResponse], error)
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error)
+	// Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+	GetServiceDependencyGraph(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse], error)
+	// Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+	// a service config take precedence over the enclave ones
+	SetEnclaveEnvVars(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs]) (*connect.Response[emptypb.Empty], error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExecCommandArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExecCommandResponse], error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
@@ -118,6 +144,8 @@ type ApiContainerServiceClient interface {
 	StoreFilesArtifactFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
+	// Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+	GetFilesArtifactHistory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
 	// Get last Starlark run
@@ -126,6 +154,14 @@ type ApiContainerServiceClient interface {
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+	WatchServiceEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.ServiceEvent], error)
+	// Starts either all the services or none of them: the ones that started are stopped back if any fails to
+	StartServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error)
+	// Stops either all the services or none of them: the ones that stopped are started back if any fails to
+	StopServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error)
+	// Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+	RemoveServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error)
 }
 
 // NewApiContainerServiceClient constructs a client for the api_container_api.ApiContainerService
@@ -163,6 +199,16 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure,
 			opts...,
 		),
+		getServiceDependencyGraph: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetServiceDependencyGraphProcedure,
+			opts...,
+		),
+		setEnclaveEnvVars: connect.NewClient[kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceSetEnclaveEnvVarsProcedure,
+			opts...,
+		),
 		execCommand: connect.NewClient[kurtosis_core_rpc_api_bindings.ExecCommandArgs, kurtosis_core_rpc_api_bindings.ExecCommandResponse](
 			httpClient,
 			baseURL+ApiContainerServiceExecCommandProcedure,
@@ -208,6 +254,11 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceInspectFilesArtifactContentsProcedure,
 			opts...,
 		),
+		getFilesArtifactHistory: connect.NewClient[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs, kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetFilesArtifactHistoryProcedure,
+			opts...,
+		),
 		connectServices: connect.NewClient[kurtosis_core_rpc_api_bindings.ConnectServicesArgs, kurtosis_core_rpc_api_bindings.ConnectServicesResponse](
 			httpClient,
 			baseURL+ApiContainerServiceConnectServicesProcedure,
@@ -228,6 +279,26 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceGetStarlarkPackagePlanYamlProcedure,
 			opts...,
 		),
+		watchServiceEvents: connect.NewClient[kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs, kurtosis_core_rpc_api_bindings.ServiceEvent](
+			httpClient,
+			baseURL+ApiContainerServiceWatchServiceEventsProcedure,
+			opts...,
+		),
+		startServices: connect.NewClient[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs, kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse](
+			httpClient,
+			baseURL+ApiContainerServiceStartServicesProcedure,
+			opts...,
+		),
+		stopServices: connect.NewClient[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs, kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse](
+			httpClient,
+			baseURL+ApiContainerServiceStopServicesProcedure,
+			opts...,
+		),
+		removeServices: connect.NewClient[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs, kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse](
+			httpClient,
+			baseURL+ApiContainerServiceRemoveServicesProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -238,6 +309,8 @@ type apiContainerServiceClient struct {
 	runStarlarkPackage                         *connect.Client[kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
 	getServices                                *connect.Client[kurtosis_core_rpc_api_bindings.GetServicesArgs, kurtosis_core_rpc_api_bindings.GetServicesResponse]
 	getExistingAndHistoricalServiceIdentifiers *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse]
+	getServiceDependencyGraph                  *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse]
+	setEnclaveEnvVars                          *connect.Client[kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs, emptypb.Empty]
 	execCommand                                *connect.Client[kurtosis_core_rpc_api_bindings.ExecCommandArgs, kurtosis_core_rpc_api_bindings.ExecCommandResponse]
 	waitForHttpGetEndpointAvailability         *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs, emptypb.Empty]
 	waitForHttpPostEndpointAvailability        *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpPostEndpointAvailabilityArgs, emptypb.Empty]
@@ -247,10 +320,15 @@ type apiContainerServiceClient struct {
 	storeFilesArtifactFromService              *connect.Client[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs, kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse]
 	listFilesArtifactNamesAndUuids             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse]
 	inspectFilesArtifactContents               *connect.Client[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest, kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse]
+	getFilesArtifactHistory                    *connect.Client[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs, kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse]
 	connectServices                            *connect.Client[kurtosis_core_rpc_api_bindings.ConnectServicesArgs, kurtosis_core_rpc_api_bindings.ConnectServicesResponse]
 	getStarlarkRun                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse]
 	getStarlarkScriptPlanYaml                  *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
 	getStarlarkPackagePlanYaml                 *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
+	watchServiceEvents                         *connect.Client[kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs, kurtosis_core_rpc_api_bindings.ServiceEvent]
+	startServices                              *connect.Client[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs, kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse]
+	stopServices                               *connect.Client[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs, kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse]
+	removeServices                             *connect.Client[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs, kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse]
 }
 
 // RunStarlarkScript calls api_container_api.ApiContainerService.RunStarlarkScript.
@@ -279,6 +357,16 @@ func (c *apiContainerServiceClient) GetExistingAndHistoricalServiceIdentifiers(c
 	return c.getExistingAndHistoricalServiceIdentifiers.CallUnary(ctx, req)
 }
 
+// GetServiceDependencyGraph calls api_container_api.ApiContainerService.GetServiceDependencyGraph.
+func (c *apiContainerServiceClient) GetServiceDependencyGraph(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse], error) {
+	return c.getServiceDependencyGraph.CallUnary(ctx, req)
+}
+
+// SetEnclaveEnvVars calls api_container_api.ApiContainerService.SetEnclaveEnvVars.
+func (c *apiContainerServiceClient) SetEnclaveEnvVars(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.setEnclaveEnvVars.CallUnary(ctx, req)
+}
+
 // ExecCommand calls api_container_api.ApiContainerService.ExecCommand.
 func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ExecCommandArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExecCommandResponse], error) {
 	return c.execCommand.CallUnary(ctx, req)
@@ -329,6 +417,11 @@ func (c *apiContainerServiceClient) InspectFilesArtifactContents(ctx context.Con
 	return c.inspectFilesArtifactContents.CallUnary(ctx, req)
 }
 
+// GetFilesArtifactHistory calls api_container_api.ApiContainerService.GetFilesArtifactHistory.
+func (c *apiContainerServiceClient) GetFilesArtifactHistory(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse], error) {
+	return c.getFilesArtifactHistory.CallUnary(ctx, req)
+}
+
 // ConnectServices calls api_container_api.ApiContainerService.ConnectServices.
 func (c *apiContainerServiceClient) ConnectServices(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error) {
 	return c.connectServices.CallUnary(ctx, req)
@@ -350,6 +443,26 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return c.getStarlarkPackagePlanYaml.CallUnary(ctx, req)
 }
 
+// WatchServiceEvents calls api_container_api.ApiContainerService.WatchServiceEvents.
+func (c *apiContainerServiceClient) WatchServiceEvents(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.ServiceEvent], error) {
+	return c.watchServiceEvents.CallServerStream(ctx, req)
+}
+
+// StartServices calls api_container_api.ApiContainerService.StartServices.
+func (c *apiContainerServiceClient) StartServices(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error) {
+	return c.startServices.CallUnary(ctx, req)
+}
+
+// StopServices calls api_container_api.ApiContainerService.StopServices.
+func (c *apiContainerServiceClient) StopServices(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error) {
+	return c.stopServices.CallUnary(ctx, req)
+}
+
+// RemoveServices calls api_container_api.ApiContainerService.RemoveServices.
+func (c *apiContainerServiceClient) RemoveServices(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error) {
+	return c.removeServices.CallUnary(ctx, req)
+}
+
 // ApiContainerServiceHandler is an implementation of the api_container_api.ApiContainerService
 // service.
 type ApiContainerServiceHandler interface {
@@ -363,6 +476,11 @@ type ApiContainerServiceHandler interface {
 	GetServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServicesResponse], error)
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error)
+	// Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+	GetServiceDependencyGraph(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse], error)
+	// Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+	// a service config take precedence over the enclave ones
+	SetEnclaveEnvVars(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs]) (*connect.Response[emptypb.Empty], error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExecCommandArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExecCommandResponse], error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
@@ -379,6 +497,8 @@ type ApiContainerServiceHandler interface {
 	StoreFilesArtifactFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
+	// Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+	GetFilesArtifactHistory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
 	// Get last Starlark run
@@ -387,6 +507,14 @@ type ApiContainerServiceHandler interface {
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+	WatchServiceEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.ServiceEvent]) error
+	// Starts either all the services or none of them: the ones that started are stopped back if any fails to
+	StartServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error)
+	// Stops either all the services or none of them: the ones that stopped are started back if any fails to
+	StopServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error)
+	// Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+	RemoveServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error)
 }
 
 // NewApiContainerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -420,6 +548,16 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.GetExistingAndHistoricalServiceIdentifiers,
 		opts...,
 	)
+	apiContainerServiceGetServiceDependencyGraphHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetServiceDependencyGraphProcedure,
+		svc.GetServiceDependencyGraph,
+		opts...,
+	)
+	apiContainerServiceSetEnclaveEnvVarsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceSetEnclaveEnvVarsProcedure,
+		svc.SetEnclaveEnvVars,
+		opts...,
+	)
 	apiContainerServiceExecCommandHandler := connect.NewUnaryHandler(
 		ApiContainerServiceExecCommandProcedure,
 		svc.ExecCommand,
@@ -465,6 +603,11 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.InspectFilesArtifactContents,
 		opts...,
 	)
+	apiContainerServiceGetFilesArtifactHistoryHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetFilesArtifactHistoryProcedure,
+		svc.GetFilesArtifactHistory,
+		opts...,
+	)
 	apiContainerServiceConnectServicesHandler := connect.NewUnaryHandler(
 		ApiContainerServiceConnectServicesProcedure,
 		svc.ConnectServices,
@@ -485,6 +628,26 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.GetStarlarkPackagePlanYaml,
 		opts...,
 	)
+	apiContainerServiceWatchServiceEventsHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceWatchServiceEventsProcedure,
+		svc.WatchServiceEvents,
+		opts...,
+	)
+	apiContainerServiceStartServicesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceStartServicesProcedure,
+		svc.StartServices,
+		opts...,
+	)
+	apiContainerServiceStopServicesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceStopServicesProcedure,
+		svc.StopServices,
+		opts...,
+	)
+	apiContainerServiceRemoveServicesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRemoveServicesProcedure,
+		svc.RemoveServices,
+		opts...,
+	)
 	return "/api_container_api.ApiContainerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiContainerServiceRunStarlarkScriptProcedure:
@@ -497,6 +660,10 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceGetServicesHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure:
 			apiContainerServiceGetExistingAndHistoricalServiceIdentifiersHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetServiceDependencyGraphProcedure:
+			apiContainerServiceGetServiceDependencyGraphHandler.ServeHTTP(w, r)
+		case ApiContainerServiceSetEnclaveEnvVarsProcedure:
+			apiContainerServiceSetEnclaveEnvVarsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceExecCommandProcedure:
 			apiContainerServiceExecCommandHandler.ServeHTTP(w, r)
 		case ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure:
@@ -515,6 +682,8 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceListFilesArtifactNamesAndUuidsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceInspectFilesArtifactContentsProcedure:
 			apiContainerServiceInspectFilesArtifactContentsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetFilesArtifactHistoryProcedure:
+			apiContainerServiceGetFilesArtifactHistoryHandler.ServeHTTP(w, r)
 		case ApiContainerServiceConnectServicesProcedure:
 			apiContainerServiceConnectServicesHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkRunProcedure:
@@ -523,6 +692,14 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceGetStarlarkScriptPlanYamlHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkPackagePlanYamlProcedure:
 			apiContainerServiceGetStarlarkPackagePlanYamlHandler.ServeHTTP(w, r)
+		case ApiContainerServiceWatchServiceEventsProcedure:
+			apiContainerServiceWatchServiceEventsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceStartServicesProcedure:
+			apiContainerServiceStartServicesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceStopServicesProcedure:
+			apiContainerServiceStopServicesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRemoveServicesProcedure:
+			apiContainerServiceRemoveServicesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -552,6 +729,14 @@ func (UnimplementedApiContainerServiceHandler) GetExistingAndHistoricalServiceId
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) GetServiceDependencyGraph(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetServiceDependencyGraph is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) SetEnclaveEnvVars(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.SetEnclaveEnvVars is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) ExecCommand(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExecCommandArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExecCommandResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ExecCommand is not implemented"))
 }
@@ -588,6 +773,10 @@ func (UnimplementedApiContainerServiceHandler) InspectFilesArtifactContents(cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.InspectFilesArtifactContents is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) GetFilesArtifactHistory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetFilesArtifactHistory is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ConnectServices is not implemented"))
 }
@@ -603,3 +792,19 @@ func (UnimplementedApiContainerServiceHandler) GetStarlarkScriptPlanYaml(context
 func (UnimplementedApiContainerServiceHandler) GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml is not implemented"))
 }
+
+func (UnimplementedApiContainerServiceHandler) WatchServiceEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.ServiceEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.WatchServiceEvents is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) StartServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.StartServices is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) StopServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.StopServices is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RemoveServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RemoveServices is not implemented"))
+}
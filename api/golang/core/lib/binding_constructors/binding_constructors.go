@@ -2,6 +2,8 @@ package binding_constructors
 
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 // The generated bindings don't come with constructors (leaving it up to the user to initialize all the fields), so we
@@ -197,6 +199,47 @@ func NewStarlarkRunResponseLineFromInstructionResult(serializedInstructionResult
 	}
 }
 
+func NewStarlarkRunResponseLineFromTimedInstructionResult(serializedInstructionResult string, executionDuration time.Duration) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	executionDurationMilliseconds := uint64(executionDuration.Milliseconds())
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_InstructionResult{
+			InstructionResult: &kurtosis_core_rpc_api_bindings.StarlarkInstructionResult{
+				SerializedInstructionResult:   serializedInstructionResult,
+				ExecutionDurationMilliseconds: &executionDurationMilliseconds,
+			},
+		},
+	}
+}
+
+func NewStarlarkRunResponseLineFromContainerImageTiming(imageName string, serviceNames []string, duration time.Duration) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_ContainerImageTiming{
+			ContainerImageTiming: &kurtosis_core_rpc_api_bindings.StarlarkContainerImageTiming{
+				ImageName:            imageName,
+				ServiceNames:         serviceNames,
+				DurationMilliseconds: uint64(duration.Milliseconds()),
+			},
+		},
+	}
+}
+
+func NewStarlarkRunResponseLineFromRunPlan(plannedInstructions []*kurtosis_core_rpc_api_bindings.StarlarkPlannedInstruction) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Plan{
+			Plan: &kurtosis_core_rpc_api_bindings.StarlarkRunPlan{
+				Instructions: plannedInstructions,
+			},
+		},
+	}
+}
+
+func NewStarlarkPlannedInstruction(instruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction, serializedReturnedValue string) *kurtosis_core_rpc_api_bindings.StarlarkPlannedInstruction {
+	return &kurtosis_core_rpc_api_bindings.StarlarkPlannedInstruction{
+		Instruction:             instruction,
+		SerializedReturnedValue: serializedReturnedValue,
+	}
+}
+
 func NewStarlarkRunResponseLineFromInterpretationError(interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
 		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Error{
@@ -328,7 +371,17 @@ func NewStarlarkValidationError(errorMessage string) *kurtosis_core_rpc_api_bind
 
 func NewStarlarkExecutionError(errorMessage string) *kurtosis_core_rpc_api_bindings.StarlarkExecutionError {
 	return &kurtosis_core_rpc_api_bindings.StarlarkExecutionError{
-		ErrorMessage: errorMessage,
+		ErrorMessage:     errorMessage,
+		BackendErrorKind: kurtosis_core_rpc_api_bindings.BackendErrorKind_NO_BACKEND_ERROR,
+		RemediationHint:  "",
+	}
+}
+
+func NewStarlarkExecutionErrorFromBackendError(errorMessage string, backendErrorKind kurtosis_core_rpc_api_bindings.BackendErrorKind, remediationHint string) *kurtosis_core_rpc_api_bindings.StarlarkExecutionError {
+	return &kurtosis_core_rpc_api_bindings.StarlarkExecutionError{
+		ErrorMessage:     errorMessage,
+		BackendErrorKind: backendErrorKind,
+		RemediationHint:  remediationHint,
 	}
 }
 
@@ -393,6 +446,39 @@ func NewServiceInfo(
 		NodeSelectors:                       nodeSelectors,
 		Labels:                              labels,
 		TiniEnabled:                         &tiniEnabled,
+		Health:                              nil,
+		LastCrash:                           nil,
+	}
+}
+
+func NewServiceHealth(isHealthy bool, consecutiveFailures uint32, lastError string, restartCount uint32) *kurtosis_core_rpc_api_bindings.ServiceHealth {
+	return &kurtosis_core_rpc_api_bindings.ServiceHealth{
+		IsHealthy:           isHealthy,
+		ConsecutiveFailures: consecutiveFailures,
+		LastError:           lastError,
+		RestartCount:        restartCount,
+	}
+}
+
+func NewServiceCrashReport(exitCode int32, isOomKilled bool, finishedAt time.Time, reason string, diagnosticsFilesArtifactName string) *kurtosis_core_rpc_api_bindings.ServiceCrashReport {
+	return &kurtosis_core_rpc_api_bindings.ServiceCrashReport{
+		ExitCode:                     exitCode,
+		IsOomKilled:                  isOomKilled,
+		FinishedAt:                   timestamppb.New(finishedAt),
+		Reason:                       reason,
+		DiagnosticsFilesArtifactName: diagnosticsFilesArtifactName,
+	}
+}
+
+// ==============================================================================================
+//
+//	Set Enclave Env Vars
+//
+// ==============================================================================================
+
+func NewSetEnclaveEnvVarsArgs(envVars map[string]string) *kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs {
+	return &kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs{
+		EnvVars: envVars,
 	}
 }
 
@@ -466,3 +552,15 @@ func NewConnectServicesArgs(connect kurtosis_core_rpc_api_bindings.Connect) *kur
 func NewConnectServicesResponse() *kurtosis_core_rpc_api_bindings.ConnectServicesResponse {
 	return &kurtosis_core_rpc_api_bindings.ConnectServicesResponse{}
 }
+
+// ==============================================================================================
+//
+//	Bulk Service Operations
+//
+// ==============================================================================================
+
+func NewBulkServiceOperationArgs(serviceIdentifiers []string) *kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs {
+	return &kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs{
+		ServiceIdentifiers: serviceIdentifiers,
+	}
+}
@@ -19,6 +19,8 @@ package enclaves
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"github.com/kurtosis-tech/kurtosis/path-compression"
 	"io"
@@ -29,6 +31,7 @@ import (
 	yaml_convert "github.com/ghodss/yaml"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/kurtosis_errors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
 	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
@@ -43,6 +46,9 @@ type EnclaveUUID string
 const (
 	kurtosisYamlFilename    = "kurtosis.yml"
 	enforceMaxFileSizeLimit = true
+	// The files artifacts are streamed to the API container in chunks and written to disk as they arrive, so their
+	// size is only bounded by the files artifacts quota of the enclave
+	enforceMaxFileSizeLimitOnFilesArtifactUpload = false
 
 	osPathSeparatorString = string(os.PathSeparator)
 
@@ -347,7 +353,7 @@ func (enclaveCtx *EnclaveContext) GetServiceContext(serviceIdentifier string) (*
 	}
 	serviceInfo, found := response.GetServiceInfo()[serviceIdentifier]
 	if !found {
-		return nil, stacktrace.NewError("Failed to retrieve service information for service '%v'", serviceIdentifier)
+		return nil, stacktrace.Propagate(kurtosis_errors.NewNotFoundError("Couldn't find service '%v' in the enclave", serviceIdentifier), "Failed to retrieve service information for service '%v'", serviceIdentifier)
 	}
 	if serviceInfo.GetPrivateIpAddr() == "" {
 		return nil, stacktrace.NewError(
@@ -401,8 +407,65 @@ func (enclaveCtx *EnclaveContext) GetServices() (map[services.ServiceName]servic
 	return serviceInfos, nil
 }
 
+// GetServicesPage is the same as GetServices, but returns at most pageSize services (all of them if 0), ordered by name,
+// along with the token to pass to get the next page, which is empty if this is the last page. Only the services having
+// all the labels and in one of the statuses are returned, unless there's no status
+func (enclaveCtx *EnclaveContext) GetServicesPage(
+	labels map[string]string,
+	statuses []kurtosis_core_rpc_api_bindings.ServiceStatus,
+	pageSize uint32,
+	pageToken string,
+) (map[services.ServiceName]services.ServiceUUID, string, error) {
+	getServicesArgs := binding_constructors.NewGetServicesArgs(map[string]bool{})
+	getServicesArgs.Labels = labels
+	getServicesArgs.Statuses = statuses
+	getServicesArgs.PageSize = &pageSize
+	getServicesArgs.PageToken = &pageToken
+	response, err := enclaveCtx.client.GetServices(context.Background(), getServicesArgs)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting a page of the services in the enclave")
+	}
+
+	serviceInfos := make(map[services.ServiceName]services.ServiceUUID, len(response.GetServiceInfo()))
+	for serviceIdStr, responseServiceInfo := range response.GetServiceInfo() {
+		serviceInfos[services.ServiceName(serviceIdStr)] = services.ServiceUUID(responseServiceInfo.GetServiceUuid())
+	}
+	return serviceInfos, response.GetNextPageToken(), nil
+}
+
+// WatchServiceEvents streams the lifecycle events of the services, from now on until the returned function gets called,
+// only for the services with these names and of these types, unless there are none. The channel gets closed when the
+// stream ends
+func (enclaveCtx *EnclaveContext) WatchServiceEvents(
+	ctx context.Context,
+	serviceNames []string,
+	eventTypes []kurtosis_core_rpc_api_bindings.ServiceEventType,
+) (chan *kurtosis_core_rpc_api_bindings.ServiceEvent, context.CancelFunc, error) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	watchServiceEventsArgs := &kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs{
+		ServiceNames: serviceNames,
+		EventTypes:   eventTypes,
+	}
+	serviceEventChan := make(chan *kurtosis_core_rpc_api_bindings.ServiceEvent)
+
+	stream, err := enclaveCtx.client.WatchServiceEvents(ctxWithCancel, watchServiceEventsArgs)
+	if err != nil {
+		cancelCtxFunc() // manually call the cancel function as something went wrong
+		return nil, nil, stacktrace.Propagate(err, "An error occurred starting to watch the service events")
+	}
+
+	go runReceiveServiceEventRoutine(ctxWithCancel, cancelCtxFunc, stream, serviceEventChan)
+	return serviceEventChan, cancelCtxFunc, nil
+}
+
 func (enclaveCtx *EnclaveContext) UploadFiles(pathToUpload string, artifactName string) (services.FilesArtifactUUID, services.FileArtifactName, error) {
-	content, contentSize, _, err := path_compression.CompressPath(pathToUpload, enforceMaxFileSizeLimit)
+	return enclaveCtx.UploadFilesWithProgressReporter(pathToUpload, artifactName, nil)
+}
+
+// UploadFilesWithProgressReporter is the same as UploadFiles, but calls progressReporter every time a chunk of the
+// compressed content has been sent to the API container. progressReporter can be nil.
+func (enclaveCtx *EnclaveContext) UploadFilesWithProgressReporter(pathToUpload string, artifactName string, progressReporter grpc_file_streaming.ProgressReporter) (services.FilesArtifactUUID, services.FileArtifactName, error) {
+	content, contentSize, _, err := path_compression.CompressPath(pathToUpload, enforceMaxFileSizeLimitOnFilesArtifactUpload)
 	if err != nil {
 		return "", "", stacktrace.Propagate(err,
 			"There was an error compressing the file '%v' before upload",
@@ -415,7 +478,7 @@ func (enclaveCtx *EnclaveContext) UploadFiles(pathToUpload string, artifactName
 	if err != nil {
 		return "", "", stacktrace.Propagate(err, "An error was encountered initiating the data upload to the API Container.")
 	}
-	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse](client)
+	clientStream := grpc_file_streaming.NewClientStreamWithProgressReporter[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse](client, progressReporter)
 	response, err := clientStream.SendData(
 		artifactName,
 		content,
@@ -436,8 +499,11 @@ func (enclaveCtx *EnclaveContext) UploadFiles(pathToUpload string, artifactName
 	return services.FilesArtifactUUID(response.GetUuid()), services.FileArtifactName(response.GetName()), nil
 }
 
-func (enclaveCtx *EnclaveContext) StoreWebFiles(ctx context.Context, urlToStoreWeb string, artifactName string) (services.FilesArtifactUUID, error) {
+func (enclaveCtx *EnclaveContext) StoreWebFiles(ctx context.Context, urlToStoreWeb string, artifactName string, opts ...StoreWebFilesOption) (services.FilesArtifactUUID, error) {
 	args := binding_constructors.NewStoreWebFilesArtifactArgs(urlToStoreWeb, artifactName)
+	for _, opt := range opts {
+		opt(args)
+	}
 	response, err := enclaveCtx.client.StoreWebFilesArtifact(ctx, args)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred downloading files artifact from URL '%v'", urlToStoreWeb)
@@ -462,9 +528,40 @@ func (enclaveCtx *EnclaveContext) DownloadFilesArtifact(ctx context.Context, art
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", artifactIdentifier)
 	}
+	if err = verifyDownloadedFilesArtifact(client, artifactIdentifier, grpc_file_streaming.ComputeContentSha256(fileContent)); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred verifying the integrity of files artifact '%v'", artifactIdentifier)
+	}
 	return fileContent, nil
 }
 
+// DownloadFilesArtifactToWriter streams the files artifact content into the writer as it's received, without holding
+// the whole artifact in memory. progressReporter can be nil.
+func (enclaveCtx *EnclaveContext) DownloadFilesArtifactToWriter(ctx context.Context, artifactIdentifier string, writer io.Writer, progressReporter grpc_file_streaming.ProgressReporter) error {
+	args := binding_constructors.DownloadFilesArtifactArgs(artifactIdentifier)
+
+	client, err := enclaveCtx.client.DownloadFilesArtifact(ctx, args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred initiating the download of files artifact '%v'", artifactIdentifier)
+	}
+	// The content is hashed as it's written so that it can be verified without buffering it
+	contentHasher := sha256.New()
+	clientStream := grpc_file_streaming.NewClientStreamWithProgressReporter[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](client, progressReporter)
+	err = clientStream.ReceiveDataToWriter(
+		artifactIdentifier,
+		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
+			return dataChunk.Data, dataChunk.PreviousChunkHash, nil
+		},
+		io.MultiWriter(writer, contentHasher),
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", artifactIdentifier)
+	}
+	if err = verifyDownloadedFilesArtifact(client, artifactIdentifier, hex.EncodeToString(contentHasher.Sum(nil))); err != nil {
+		return stacktrace.Propagate(err, "An error occurred verifying the integrity of files artifact '%v'", artifactIdentifier)
+	}
+	return nil
+}
+
 func (enclaveCtx *EnclaveContext) InspectFilesArtifact(ctx context.Context, artifactName services.FileArtifactName) (*kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse, error) {
 	// TODO(vcolombo): Add a more intuitive return type to this call instead of returning the RPC response
 	response, err := enclaveCtx.client.InspectFilesArtifactContents(ctx, &kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest{
@@ -479,6 +576,18 @@ func (enclaveCtx *EnclaveContext) InspectFilesArtifact(ctx context.Context, arti
 	return response, nil
 }
 
+// GetFilesArtifactHistory returns the versions of the files artifact, oldest first. Any version can be used wherever a
+// files artifact identifier is expected with '<identifier>@<version>', or '<identifier>@latest'.
+func (enclaveCtx *EnclaveContext) GetFilesArtifactHistory(ctx context.Context, artifactIdentifier string) (*kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse, error) {
+	response, err := enclaveCtx.client.GetFilesArtifactHistory(ctx, &kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs{
+		Identifier: artifactIdentifier,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the history of files artifact '%v'", artifactIdentifier)
+	}
+	return response, nil
+}
+
 func (enclaveCtx *EnclaveContext) GetExistingAndHistoricalServiceIdentifiers(ctx context.Context) (*services.ServiceIdentifiers, error) {
 	response, err := enclaveCtx.client.GetExistingAndHistoricalServiceIdentifiers(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -487,6 +596,58 @@ func (enclaveCtx *EnclaveContext) GetExistingAndHistoricalServiceIdentifiers(ctx
 	return services.NewServiceIdentifiers(enclaveCtx.enclaveName, response.AllIdentifiers), nil
 }
 
+// GetServiceDependencyGraph returns the services of the enclave and the dependencies between them
+func (enclaveCtx *EnclaveContext) GetServiceDependencyGraph(ctx context.Context) (*kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse, error) {
+	response, err := enclaveCtx.client.GetServiceDependencyGraph(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching the service dependency graph")
+	}
+	return response, nil
+}
+
+// SetEnclaveEnvVars sets env vars that get injected into every service added to the enclave from now on. Env vars
+// already set in the enclave and absent from envVars are left untouched
+func (enclaveCtx *EnclaveContext) SetEnclaveEnvVars(ctx context.Context, envVars map[string]string) error {
+	args := binding_constructors.NewSetEnclaveEnvVarsArgs(envVars)
+	if _, err := enclaveCtx.client.SetEnclaveEnvVars(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the enclave env vars")
+	}
+	return nil
+}
+
+// StartServices starts either all the services or none of them. The response says which services failed, and for which
+// of the services that started the stopping back failed
+func (enclaveCtx *EnclaveContext) StartServices(ctx context.Context, serviceIdentifiers []string) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	args := binding_constructors.NewBulkServiceOperationArgs(serviceIdentifiers)
+	response, err := enclaveCtx.client.StartServices(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting services '%v'", serviceIdentifiers)
+	}
+	return response, nil
+}
+
+// StopServices stops either all the services or none of them. The response says which services failed, and for which
+// of the services that stopped the starting back failed
+func (enclaveCtx *EnclaveContext) StopServices(ctx context.Context, serviceIdentifiers []string) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	args := binding_constructors.NewBulkServiceOperationArgs(serviceIdentifiers)
+	response, err := enclaveCtx.client.StopServices(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred stopping services '%v'", serviceIdentifiers)
+	}
+	return response, nil
+}
+
+// RemoveServices removes either all the services or none of them, by stopping them all before removing them. The
+// response says which services failed, and for which of the services that stopped the starting back failed
+func (enclaveCtx *EnclaveContext) RemoveServices(ctx context.Context, serviceIdentifiers []string) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	args := binding_constructors.NewBulkServiceOperationArgs(serviceIdentifiers)
+	response, err := enclaveCtx.client.RemoveServices(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing services '%v'", serviceIdentifiers)
+	}
+	return response, nil
+}
+
 func (enclaveCtx *EnclaveContext) GetAllFilesArtifactNamesAndUuids(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid, error) {
 	response, err := enclaveCtx.client.ListFilesArtifactNamesAndUuids(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -639,6 +800,49 @@ func runReceiveStarlarkResponseLineRoutine(cancelCtxFunc context.CancelFunc, str
 	}
 }
 
+func runReceiveServiceEventRoutine(ctx context.Context, cancelCtxFunc context.CancelFunc, stream grpc.ClientStream, serviceEventChan chan *kurtosis_core_rpc_api_bindings.ServiceEvent) {
+	defer func() {
+		close(serviceEventChan)
+		cancelCtxFunc()
+	}()
+	for {
+		serviceEvent := new(kurtosis_core_rpc_api_bindings.ServiceEvent)
+		err := stream.RecvMsg(serviceEvent)
+		if err == io.EOF {
+			logrus.Debugf("Successfully reached the end of the service event stream. Closing.")
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				logrus.Errorf("Unexpected error happened reading the service event stream\n%v", err.Error())
+			}
+			return
+		}
+		// The caller can stop watching without draining the channel
+		select {
+		case serviceEventChan <- serviceEvent:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// verifyDownloadedFilesArtifact checks the checksum of the downloaded content against the one advertised by the APIC.
+// Older APICs, and artifacts stored before checksums were recorded, don't advertise any so they can't be verified.
+func verifyDownloadedFilesArtifact(stream grpc.ClientStream, artifactIdentifier string, actualContentSha256 string) error {
+	expectedContentSha256, found, err := grpc_file_streaming.GetContentSha256Header(stream)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the expected checksum of files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return nil
+	}
+	if err = grpc_file_streaming.VerifyContentSha256(expectedContentSha256, actualContentSha256); err != nil {
+		return stacktrace.Propagate(err, "The content received for files artifact '%v' doesn't match its checksum", artifactIdentifier)
+	}
+	return nil
+}
+
 func getErrFromStarlarkRunResult(result *StarlarkRunResult) error {
 	if result.InterpretationError != nil {
 		return stacktrace.NewError(result.InterpretationError.GetErrorMessage())
@@ -2,6 +2,7 @@ package enclaves
 
 import (
 	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_args_schema"
 	"github.com/kurtosis-tech/stacktrace"
 	"os"
 )
@@ -12,13 +13,14 @@ const (
 
 // fields are public because it's needed for YAML decoding
 type KurtosisYaml struct {
-	PackageName           string            `yaml:"name"`
-	PackageDescription    string            `yaml:"description"`
-	PackageReplaceOptions map[string]string `yaml:"replace"`
+	PackageName           string                                `yaml:"name"`
+	PackageDescription    string                                `yaml:"description"`
+	PackageReplaceOptions map[string]string                     `yaml:"replace"`
+	PackageArgs           package_args_schema.PackageArgsSchema `yaml:"args,omitempty"`
 }
 
 func NewKurtosisYaml(packageName string, packageDescription string, packageReplaceOptions map[string]string) *KurtosisYaml {
-	return &KurtosisYaml{PackageName: packageName, PackageDescription: packageDescription, PackageReplaceOptions: packageReplaceOptions}
+	return &KurtosisYaml{PackageName: packageName, PackageDescription: packageDescription, PackageReplaceOptions: packageReplaceOptions, PackageArgs: nil}
 }
 
 func ParseKurtosisYaml(kurtosisYamlFilepath string) (*KurtosisYaml, error) {
@@ -40,5 +42,9 @@ func ParseKurtosisYaml(kurtosisYamlFilepath string) (*KurtosisYaml, error) {
 		return nil, stacktrace.NewError("Field 'name', which is the Starlark package's name, in %v needs to be set and cannot be empty", kurtosisYamlFilename)
 	}
 
+	if err = kurtosisYaml.PackageArgs.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The 'args' section of the '%v' file at '%v' is invalid", kurtosisYamlFilename, kurtosisYamlFilepath)
+	}
+
 	return &kurtosisYaml, nil
 }
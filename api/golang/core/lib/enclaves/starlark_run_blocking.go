@@ -21,15 +21,19 @@ type StarlarkRunResult struct {
 	ValidationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError
 
 	ExecutionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+
+	// Only set for dry runs, which send the full plan once all the instructions were sent
+	Plan *kurtosis_core_rpc_api_bindings.StarlarkRunPlan
 }
 
-func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) *StarlarkRunResult {
+func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError, plan *kurtosis_core_rpc_api_bindings.StarlarkRunPlan) *StarlarkRunResult {
 	return &StarlarkRunResult{
 		RunOutput:           runOutput,
 		Instructions:        instructions,
 		InterpretationError: interpretationError,
 		ValidationErrors:    validationErrors,
 		ExecutionError:      executionError,
+		Plan:                plan,
 	}
 }
 
@@ -39,6 +43,7 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 	var interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
 	validationErrors := make([]*kurtosis_core_rpc_api_bindings.StarlarkValidationError, 0)
 	var executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+	var plan *kurtosis_core_rpc_api_bindings.StarlarkRunPlan
 
 	for responseLine := range starlarkRunResponseLines {
 		if responseLine.GetInstruction() != nil {
@@ -60,6 +65,8 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 				scriptOutput.WriteString(runFinishedEvent.GetSerializedOutput())
 				scriptOutput.WriteString(starlarkRunOutputLinesSplit)
 			}
+		} else if responseLine.GetPlan() != nil {
+			plan = responseLine.GetPlan()
 		}
 	}
 	return NewStarlarkRunResult(
@@ -67,5 +74,6 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 		instructions,
 		interpretationError,
 		validationErrors,
-		executionError)
+		executionError,
+		plan)
 }
@@ -0,0 +1,32 @@
+package enclaves
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+type StoreWebFilesOption func(args *kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs)
+
+// WithHeader adds a header to the request downloading the files; it can be used several times
+func WithHeader(name string, value string) StoreWebFilesOption {
+	return func(args *kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs) {
+		args.Headers = append(args.Headers, &kurtosis_core_rpc_api_bindings.HttpHeader{
+			Name:  name,
+			Value: value,
+		})
+	}
+}
+
+func WithBasicAuth(username string, password string) StoreWebFilesOption {
+	return func(args *kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs) {
+		args.BasicAuthUsername = &username
+		args.BasicAuthPassword = &password
+	}
+}
+
+// WithContentSha256 pins the hex-encoded SHA-256 of the files to download. The download fails if the content doesn't
+// match, and content already downloaded in the enclave with this checksum is reused instead of downloading it again
+func WithContentSha256(contentSha256 string) StoreWebFilesOption {
+	return func(args *kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs) {
+		args.ContentSha256 = &contentSha256
+	}
+}
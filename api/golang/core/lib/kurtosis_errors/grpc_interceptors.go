@@ -0,0 +1,33 @@
+package kurtosis_errors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryClientInterceptor makes the unary gRPC calls return typed errors
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, request, reply interface{}, conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return FromGrpcError(invoker(ctx, method, request, reply, conn, opts...))
+	}
+}
+
+// StreamClientInterceptor makes the streaming gRPC calls, and the messages they receive, return typed errors
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		clientStream, err := streamer(ctx, desc, conn, method, opts...)
+		if err != nil {
+			return nil, FromGrpcError(err)
+		}
+		return &errorConvertingClientStream{ClientStream: clientStream}, nil
+	}
+}
+
+type errorConvertingClientStream struct {
+	grpc.ClientStream
+}
+
+func (stream *errorConvertingClientStream) RecvMsg(message interface{}) error {
+	return FromGrpcError(stream.ClientStream.RecvMsg(message))
+}
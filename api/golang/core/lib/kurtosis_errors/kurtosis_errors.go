@@ -0,0 +1,125 @@
+package kurtosis_errors
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// kurtosisError is what the typed errors have in common. The SDK propagates them with stacktrace, which doesn't unwrap,
+// so they are looked for in the root cause of an error; see IsNotFound and the like
+type kurtosisError struct {
+	message string
+
+	// The gRPC error the typed error was made from, if any
+	cause error
+}
+
+func (err *kurtosisError) Error() string {
+	return err.message
+}
+
+func (err *kurtosisError) Unwrap() error {
+	return err.cause
+}
+
+func (err *kurtosisError) isKurtosisError() {}
+
+// NotFoundError means that the enclave, service or files artifact a call refers to doesn't exist
+type NotFoundError struct {
+	kurtosisError
+}
+
+// AlreadyExistsError means that the enclave, service or files artifact a call creates already exists
+type AlreadyExistsError struct {
+	kurtosisError
+}
+
+// UnavailableError means that the engine or the API container couldn't be reached; the call may succeed if retried
+type UnavailableError struct {
+	kurtosisError
+}
+
+// QuotaExceededError means that the call was denied because it would exceed a quota, e.g. the size of the files
+// artifacts of an enclave
+type QuotaExceededError struct {
+	kurtosisError
+}
+
+func NewNotFoundError(messageFormat string, messageArgs ...interface{}) *NotFoundError {
+	return &NotFoundError{kurtosisError{message: fmt.Sprintf(messageFormat, messageArgs...), cause: nil}}
+}
+
+func NewAlreadyExistsError(messageFormat string, messageArgs ...interface{}) *AlreadyExistsError {
+	return &AlreadyExistsError{kurtosisError{message: fmt.Sprintf(messageFormat, messageArgs...), cause: nil}}
+}
+
+func NewUnavailableError(messageFormat string, messageArgs ...interface{}) *UnavailableError {
+	return &UnavailableError{kurtosisError{message: fmt.Sprintf(messageFormat, messageArgs...), cause: nil}}
+}
+
+func NewQuotaExceededError(messageFormat string, messageArgs ...interface{}) *QuotaExceededError {
+	return &QuotaExceededError{kurtosisError{message: fmt.Sprintf(messageFormat, messageArgs...), cause: nil}}
+}
+
+// FromGrpcError returns the typed error matching the code of a gRPC error, which still carries the gRPC status, or the
+// error itself if no typed error matches
+func FromGrpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var alreadyTypedErr interface{ isKurtosisError() }
+	if errors.As(err, &alreadyTypedErr) {
+		return err
+	}
+	grpcStatus, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	baseErr := kurtosisError{message: err.Error(), cause: err}
+	switch grpcStatus.Code() {
+	case codes.NotFound:
+		return &NotFoundError{baseErr}
+	case codes.AlreadyExists:
+		return &AlreadyExistsError{baseErr}
+	case codes.Unavailable:
+		return &UnavailableError{baseErr}
+	case codes.ResourceExhausted:
+		return &QuotaExceededError{baseErr}
+	default:
+		return err
+	}
+}
+
+// IsNotFound returns true if the error, or the error it was propagated from, is a NotFoundError
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(getTypedRootCause(err), &notFoundErr)
+}
+
+// IsAlreadyExists returns true if the error, or the error it was propagated from, is an AlreadyExistsError
+func IsAlreadyExists(err error) bool {
+	var alreadyExistsErr *AlreadyExistsError
+	return errors.As(getTypedRootCause(err), &alreadyExistsErr)
+}
+
+// IsUnavailable returns true if the error, or the error it was propagated from, is an UnavailableError
+func IsUnavailable(err error) bool {
+	var unavailableErr *UnavailableError
+	return errors.As(getTypedRootCause(err), &unavailableErr)
+}
+
+// IsQuotaExceeded returns true if the error, or the error it was propagated from, is a QuotaExceededError
+func IsQuotaExceeded(err error) bool {
+	var quotaExceededErr *QuotaExceededError
+	return errors.As(getTypedRootCause(err), &quotaExceededErr)
+}
+
+// getTypedRootCause returns the error the error was propagated from, made a typed error if it's a gRPC error from a
+// client that doesn't convert them
+func getTypedRootCause(err error) error {
+	return FromGrpcError(stacktrace.RootCause(err))
+}
@@ -0,0 +1,63 @@
+package kurtosis_errors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testMethod = "/api_container_api.ApiContainerService/GetServices"
+)
+
+func TestFromGrpcError_MatchesCodes(t *testing.T) {
+	require.True(t, IsNotFound(FromGrpcError(status.Error(codes.NotFound, "No service 'api'"))))
+	require.True(t, IsAlreadyExists(FromGrpcError(status.Error(codes.AlreadyExists, "Service 'api' exists"))))
+	require.True(t, IsUnavailable(FromGrpcError(status.Error(codes.Unavailable, "connection refused"))))
+	require.True(t, IsQuotaExceeded(FromGrpcError(status.Error(codes.ResourceExhausted, "Files artifacts quota exceeded"))))
+
+	unknownErr := status.Error(codes.Unknown, "Something went wrong")
+	require.Equal(t, unknownErr, FromGrpcError(unknownErr))
+	require.Nil(t, FromGrpcError(nil))
+}
+
+func TestFromGrpcError_KeepsGrpcStatus(t *testing.T) {
+	err := FromGrpcError(status.Error(codes.NotFound, "No service 'api'"))
+
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, err, FromGrpcError(err))
+}
+
+func TestIsNotFound_LooksThroughPropagation(t *testing.T) {
+	err := stacktrace.Propagate(
+		stacktrace.Propagate(NewNotFoundError("Couldn't find enclave 'test'"), "An error occurred getting the enclave"),
+		"An error occurred creating the enclave context",
+	)
+
+	require.True(t, IsNotFound(err))
+	require.False(t, IsAlreadyExists(err))
+	require.False(t, IsNotFound(stacktrace.NewError("Couldn't find enclave 'test'")))
+	require.False(t, IsNotFound(nil))
+}
+
+func TestIsUnavailable_MatchesUnconvertedGrpcErrors(t *testing.T) {
+	err := stacktrace.Propagate(status.Error(codes.Unavailable, "connection refused"), "An error occurred getting the services")
+
+	require.True(t, IsUnavailable(err))
+}
+
+func TestUnaryClientInterceptor_ReturnsTypedErrors(t *testing.T) {
+	invoker := func(ctx context.Context, method string, request, reply interface{}, conn *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return status.Error(codes.ResourceExhausted, "Files artifacts quota exceeded")
+	}
+
+	err := UnaryClientInterceptor()(context.Background(), testMethod, nil, nil, nil, invoker)
+
+	var quotaExceededErr *QuotaExceededError
+	require.ErrorAs(t, err, &quotaExceededErr)
+}
@@ -0,0 +1,312 @@
+package package_args_schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	StringArgType = "string"
+	IntArgType    = "int"
+	FloatArgType  = "float"
+	BoolArgType   = "bool"
+	ListArgType   = "list"
+	DictArgType   = "dict"
+
+	emptySerializedArgs = ""
+
+	// reserved arg that tells the APIC how to deserialize the args; it's never declared by packages
+	kurtosisParserArgName = "_kurtosis_parser"
+
+	helpHeader          = "Package arguments:"
+	helpArgIndent       = "  "
+	helpDetailsIndent   = "      "
+	helpRequiredKeyword = "required"
+)
+
+var allArgTypes = []string{
+	StringArgType,
+	IntArgType,
+	FloatArgType,
+	BoolArgType,
+	ListArgType,
+	DictArgType,
+}
+
+// PackageArg is the declaration of a single argument of a package, as written in the 'args' section of its kurtosis.yml
+// fields are public because it's needed for YAML decoding
+type PackageArg struct {
+	Name        string        `yaml:"name"`
+	Type        string        `yaml:"type"`
+	Description string        `yaml:"description,omitempty"`
+	Required    bool          `yaml:"required,omitempty"`
+	Default     interface{}   `yaml:"default,omitempty"`
+	Enum        []interface{} `yaml:"enum,omitempty"`
+}
+
+// PackageArgsSchema is the ordered list of arguments a package accepts. An empty schema means the package didn't
+// declare its arguments, in which case no validation happens
+type PackageArgsSchema []*PackageArg
+
+// Validate checks that the schema itself is well-formed: every argument has a unique name and a known type, and its
+// default value and enum values match that type
+func (schema PackageArgsSchema) Validate() error {
+	seenArgNames := map[string]bool{}
+	for _, arg := range schema {
+		if arg.Name == "" {
+			return stacktrace.NewError("Found a package argument without a name; every argument needs one")
+		}
+		if seenArgNames[arg.Name] {
+			return stacktrace.NewError("Package argument '%s' is declared more than once", arg.Name)
+		}
+		seenArgNames[arg.Name] = true
+
+		if !isKnownArgType(arg.Type) {
+			return stacktrace.NewError("Package argument '%s' has type '%s' which isn't one of the supported types (%s)", arg.Name, arg.Type, strings.Join(allArgTypes, ", "))
+		}
+		if arg.Required && arg.Default != nil {
+			return stacktrace.NewError("Package argument '%s' is required and has a default value; a required argument can't have a default", arg.Name)
+		}
+		for _, enumValue := range arg.Enum {
+			if err := checkValueType(arg.Type, normalizeValue(enumValue)); err != nil {
+				return stacktrace.Propagate(err, "Enum value '%v' of package argument '%s' doesn't match its type", enumValue, arg.Name)
+			}
+		}
+		if arg.Default != nil {
+			if err := arg.checkValue(normalizeValue(arg.Default)); err != nil {
+				return stacktrace.Propagate(err, "Default value of package argument '%s' is invalid", arg.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateAndApplyDefaults checks the serialized JSON args against the schema and returns them serialized back to JSON
+// with the default values of the missing arguments added. All the problems found are reported at once, so that users
+// can fix their args in one go. The serialized args are returned untouched if the schema is empty
+func (schema PackageArgsSchema) ValidateAndApplyDefaults(serializedArgs string) (string, error) {
+	if len(schema) == 0 {
+		return serializedArgs, nil
+	}
+
+	args := map[string]interface{}{}
+	if strings.TrimSpace(serializedArgs) != emptySerializedArgs {
+		if err := json.Unmarshal([]byte(serializedArgs), &args); err != nil {
+			return "", stacktrace.Propagate(err, "The package declares its arguments so it expects a JSON object as args, but the args couldn't be parsed as one")
+		}
+	}
+
+	argsWithDefaults, err := schema.validateAndApplyDefaultsToArgs(args)
+	if err != nil {
+		return "", err
+	}
+
+	serializedArgsWithDefaults, err := json.Marshal(argsWithDefaults)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the package args with their default values")
+	}
+	return string(serializedArgsWithDefaults), nil
+}
+
+// GetMissingRequiredArgs returns the required arguments absent from args, in declaration order
+func (schema PackageArgsSchema) GetMissingRequiredArgs(args map[string]interface{}) []*PackageArg {
+	var missingArgs []*PackageArg
+	for _, arg := range schema {
+		if _, found := args[arg.Name]; arg.Required && !found {
+			missingArgs = append(missingArgs, arg)
+		}
+	}
+	return missingArgs
+}
+
+// FormatHelp renders the schema as a human-readable list of arguments, in declaration order
+func (schema PackageArgsSchema) FormatHelp() string {
+	helpLines := []string{helpHeader}
+	for _, arg := range schema {
+		argLine := fmt.Sprintf("%s%s (%s", helpArgIndent, arg.Name, arg.Type)
+		if arg.Required {
+			argLine += ", " + helpRequiredKeyword
+		}
+		argLine += ")"
+		helpLines = append(helpLines, argLine)
+
+		if arg.Description != "" {
+			helpLines = append(helpLines, helpDetailsIndent+arg.Description)
+		}
+		if len(arg.Enum) > 0 {
+			helpLines = append(helpLines, fmt.Sprintf("%sOne of: %s", helpDetailsIndent, arg.FormatEnum()))
+		}
+		if arg.Default != nil {
+			helpLines = append(helpLines, fmt.Sprintf("%sDefault: %s", helpDetailsIndent, formatValue(normalizeValue(arg.Default))))
+		}
+	}
+	return strings.Join(helpLines, "\n")
+}
+
+// ParseValue parses a value typed by a user for this argument and validates it. String values are taken as-is, any
+// other type is expected to be written as JSON
+func (arg *PackageArg) ParseValue(serializedValue string) (interface{}, error) {
+	var value interface{}
+	if arg.Type == StringArgType {
+		value = serializedValue
+	} else if err := json.Unmarshal([]byte(serializedValue), &value); err != nil {
+		return nil, stacktrace.Propagate(err, "Value '%s' of argument '%s' isn't valid JSON", serializedValue, arg.Name)
+	}
+	if err := arg.checkValue(value); err != nil {
+		return nil, stacktrace.Propagate(err, "Value '%s' isn't valid for argument '%s'", serializedValue, arg.Name)
+	}
+	return value, nil
+}
+
+// FormatEnum renders the allowed values of the argument as a comma-separated list
+func (arg *PackageArg) FormatEnum() string {
+	formattedEnumValues := make([]string, len(arg.Enum))
+	for idx, enumValue := range arg.Enum {
+		formattedEnumValues[idx] = formatValue(normalizeValue(enumValue))
+	}
+	return strings.Join(formattedEnumValues, ", ")
+}
+
+func (schema PackageArgsSchema) validateAndApplyDefaultsToArgs(args map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+
+	argsByName := map[string]*PackageArg{}
+	for _, arg := range schema {
+		argsByName[arg.Name] = arg
+	}
+
+	var unknownArgNames []string
+	for argName := range args {
+		if _, found := argsByName[argName]; !found && argName != kurtosisParserArgName {
+			unknownArgNames = append(unknownArgNames, argName)
+		}
+	}
+	sort.Strings(unknownArgNames)
+	for _, unknownArgName := range unknownArgNames {
+		problems = append(problems, fmt.Sprintf("argument '%s' isn't declared by the package", unknownArgName))
+	}
+
+	argsWithDefaults := make(map[string]interface{}, len(schema))
+	for argName, argValue := range args {
+		argsWithDefaults[argName] = argValue
+	}
+	for _, arg := range schema {
+		argValue, found := args[arg.Name]
+		if !found {
+			if arg.Required {
+				problems = append(problems, fmt.Sprintf("required argument '%s' is missing", arg.Name))
+			} else if arg.Default != nil {
+				argsWithDefaults[arg.Name] = normalizeValue(arg.Default)
+			}
+			continue
+		}
+		if err := arg.checkValue(argValue); err != nil {
+			problems = append(problems, fmt.Sprintf("argument '%s' is invalid: %s", arg.Name, err.Error()))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, stacktrace.NewError("The package args don't match the arguments declared in the package's kurtosis.yml:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return argsWithDefaults, nil
+}
+
+func (arg *PackageArg) checkValue(value interface{}) error {
+	if err := checkValueType(arg.Type, value); err != nil {
+		return err
+	}
+	if len(arg.Enum) == 0 {
+		return nil
+	}
+	for _, enumValue := range arg.Enum {
+		if valuesAreEqual(normalizeValue(enumValue), value) {
+			return nil
+		}
+	}
+	return stacktrace.NewError("value %s is not one of the allowed values (%s)", formatValue(value), arg.FormatEnum())
+}
+
+func checkValueType(argType string, value interface{}) error {
+	isValid := false
+	switch argType {
+	case StringArgType:
+		_, isValid = value.(string)
+	case IntArgType:
+		switch number := value.(type) {
+		case int:
+			isValid = true
+		case float64:
+			isValid = number == math.Trunc(number)
+		}
+	case FloatArgType:
+		switch value.(type) {
+		case int, float64:
+			isValid = true
+		}
+	case BoolArgType:
+		_, isValid = value.(bool)
+	case ListArgType:
+		_, isValid = value.([]interface{})
+	case DictArgType:
+		_, isValid = value.(map[string]interface{})
+	}
+	if !isValid {
+		return stacktrace.NewError("expected a value of type '%s' but got %s", argType, formatValue(value))
+	}
+	return nil
+}
+
+func isKnownArgType(argType string) bool {
+	for _, knownArgType := range allArgTypes {
+		if argType == knownArgType {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeValue converts a value decoded from YAML into the shape the same value has when decoded from JSON, so that
+// defaults and enum values declared in kurtosis.yml can be compared to args and serialized back to JSON
+func normalizeValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[interface{}]interface{}:
+		normalizedMap := make(map[string]interface{}, len(typedValue))
+		for key, mapValue := range typedValue {
+			normalizedMap[fmt.Sprintf("%v", key)] = normalizeValue(mapValue)
+		}
+		return normalizedMap
+	case []interface{}:
+		normalizedList := make([]interface{}, len(typedValue))
+		for idx, listValue := range typedValue {
+			normalizedList[idx] = normalizeValue(listValue)
+		}
+		return normalizedList
+	default:
+		return value
+	}
+}
+
+func valuesAreEqual(value1 interface{}, value2 interface{}) bool {
+	// numbers are compared through their JSON form, as a YAML int and a JSON float64 can hold the same number
+	serializedValue1, err := json.Marshal(value1)
+	if err != nil {
+		return false
+	}
+	serializedValue2, err := json.Marshal(value2)
+	if err != nil {
+		return false
+	}
+	return string(serializedValue1) == string(serializedValue2)
+}
+
+func formatValue(value interface{}) string {
+	serializedValue, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(serializedValue)
+}
@@ -0,0 +1,134 @@
+package package_args_schema
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	networkArgName   = "network"
+	nodeCountArgName = "node_count"
+	extraArgName     = "extra_config"
+)
+
+func TestValidate_Success(t *testing.T) {
+	require.NoError(t, getSchemaForTest().Validate())
+}
+
+func TestValidate_DuplicatedArgName(t *testing.T) {
+	schema := PackageArgsSchema{
+		{Name: networkArgName, Type: StringArgType, Description: "", Required: false, Default: nil, Enum: nil},
+		{Name: networkArgName, Type: IntArgType, Description: "", Required: false, Default: nil, Enum: nil},
+	}
+	require.ErrorContains(t, schema.Validate(), "declared more than once")
+}
+
+func TestValidate_UnknownType(t *testing.T) {
+	schema := PackageArgsSchema{
+		{Name: networkArgName, Type: "uint", Description: "", Required: false, Default: nil, Enum: nil},
+	}
+	require.ErrorContains(t, schema.Validate(), "isn't one of the supported types")
+}
+
+func TestValidate_DefaultNotInEnum(t *testing.T) {
+	schema := PackageArgsSchema{
+		{Name: networkArgName, Type: StringArgType, Description: "", Required: false, Default: "devnet", Enum: []interface{}{"mainnet", "testnet"}},
+	}
+	require.ErrorContains(t, schema.Validate(), "Default value of package argument 'network' is invalid")
+}
+
+func TestValidateAndApplyDefaults_AppliesDefaults(t *testing.T) {
+	serializedArgs, err := getSchemaForTest().ValidateAndApplyDefaults(`{"node_count": 3}`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"network": "testnet", "node_count": 3, "extra_config": {"verbose": true}}`, serializedArgs)
+}
+
+func TestValidateAndApplyDefaults_ReportsAllProblems(t *testing.T) {
+	_, err := getSchemaForTest().ValidateAndApplyDefaults(`{"network": "devnet", "unknown": 1}`)
+	require.ErrorContains(t, err, "argument 'unknown' isn't declared by the package")
+	require.ErrorContains(t, err, "required argument 'node_count' is missing")
+	require.ErrorContains(t, err, `value "devnet" is not one of the allowed values ("mainnet", "testnet")`)
+}
+
+func TestValidateAndApplyDefaults_WrongType(t *testing.T) {
+	_, err := getSchemaForTest().ValidateAndApplyDefaults(`{"node_count": 2.5}`)
+	require.ErrorContains(t, err, "argument 'node_count' is invalid: expected a value of type 'int' but got 2.5")
+}
+
+func TestValidateAndApplyDefaults_EmptySchemaLeavesArgsUntouched(t *testing.T) {
+	serializedArgs, err := PackageArgsSchema{}.ValidateAndApplyDefaults(`{"anything": "goes"}`)
+	require.NoError(t, err)
+	require.Equal(t, `{"anything": "goes"}`, serializedArgs)
+}
+
+func TestValidateAndApplyDefaults_AcceptsKurtosisParserArg(t *testing.T) {
+	serializedArgs, err := getSchemaForTest().ValidateAndApplyDefaults(`{"node_count": 1, "_kurtosis_parser": "struct"}`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"network": "testnet", "node_count": 1, "extra_config": {"verbose": true}, "_kurtosis_parser": "struct"}`, serializedArgs)
+}
+
+func TestParseValue(t *testing.T) {
+	schema := getSchemaForTest()
+
+	network, err := schema[0].ParseValue("mainnet")
+	require.NoError(t, err)
+	require.Equal(t, "mainnet", network)
+
+	nodeCount, err := schema[1].ParseValue("4")
+	require.NoError(t, err)
+	require.Equal(t, float64(4), nodeCount)
+
+	_, err = schema[0].ParseValue("devnet")
+	require.ErrorContains(t, err, "isn't valid for argument 'network'")
+
+	_, err = schema[1].ParseValue("four")
+	require.ErrorContains(t, err, "isn't valid JSON")
+}
+
+func TestGetMissingRequiredArgs(t *testing.T) {
+	missingArgs := getSchemaForTest().GetMissingRequiredArgs(map[string]interface{}{networkArgName: "mainnet"})
+	require.Len(t, missingArgs, 1)
+	require.Equal(t, nodeCountArgName, missingArgs[0].Name)
+}
+
+func TestFormatHelp(t *testing.T) {
+	expectedHelp := `Package arguments:
+  network (string)
+      The network to connect to
+      One of: "mainnet", "testnet"
+      Default: "testnet"
+  node_count (int, required)
+  extra_config (dict)
+      Default: {"verbose":true}`
+	require.Equal(t, expectedHelp, getSchemaForTest().FormatHelp())
+}
+
+func getSchemaForTest() PackageArgsSchema {
+	return PackageArgsSchema{
+		{
+			Name:        networkArgName,
+			Type:        StringArgType,
+			Description: "The network to connect to",
+			Required:    false,
+			Default:     "testnet",
+			Enum:        []interface{}{"mainnet", "testnet"},
+		},
+		{
+			Name:        nodeCountArgName,
+			Type:        IntArgType,
+			Description: "",
+			Required:    true,
+			Default:     nil,
+			Enum:        nil,
+		},
+		{
+			Name:        extraArgName,
+			Type:        DictArgType,
+			Description: "",
+			Required:    false,
+			// the shape YAML decodes dicts into
+			Default: map[interface{}]interface{}{"verbose": true},
+			Enum:    nil,
+		},
+	}
+}
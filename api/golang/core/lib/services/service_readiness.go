@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// The same defaults as the ready conditions of the services added through Starlark
+	DefaultReadinessCheckInterval = 1 * time.Second
+	DefaultReadinessCheckTimeout  = 15 * time.Minute
+
+	tcpNetwork = "tcp"
+
+	httpUrlFormat = "http://%v%v"
+)
+
+// WaitForPortOpen polls the public port with the given ID until it accepts TCP connections from the client, so it's
+// only usable on the ports of the services that got a public IP address and ports
+func (service *ServiceContext) WaitForPortOpen(
+	ctx context.Context,
+	portId string,
+	interval time.Duration,
+	timeout time.Duration,
+) error {
+	portAddress, err := service.getPublicTcpPortAddress(portId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the public address of port '%v' of service '%v'", portId, service.serviceName)
+	}
+	if err := waitUntilReady(ctx, interval, timeout, func(_ context.Context) error {
+		conn, err := net.DialTimeout(tcpNetwork, portAddress, interval)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred connecting to '%v'", portAddress)
+		}
+		return conn.Close()
+	}); err != nil {
+		return stacktrace.Propagate(err, "Port '%v' of service '%v' didn't open", portId, service.serviceName)
+	}
+	return nil
+}
+
+// WaitForHTTP polls the endpoint of the public port with the given ID, sending it the body if it's not empty, until it
+// responds with the expected status code; like WaitForPortOpen, it's only usable on the services with public ports
+func (service *ServiceContext) WaitForHTTP(
+	ctx context.Context,
+	portId string,
+	method string,
+	endpoint string,
+	body string,
+	expectedStatusCode int,
+	interval time.Duration,
+	timeout time.Duration,
+) error {
+	portAddress, err := service.getPublicTcpPortAddress(portId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the public address of port '%v' of service '%v'", portId, service.serviceName)
+	}
+	url := fmt.Sprintf(httpUrlFormat, portAddress, endpoint)
+	httpClient := &http.Client{
+		Transport:     nil,
+		CheckRedirect: nil,
+		Jar:           nil,
+		Timeout:       interval,
+	}
+	if err := waitUntilReady(ctx, interval, timeout, func(ctx context.Context) error {
+		request, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred building the '%v' request to '%v'", method, url)
+		}
+		response, err := httpClient.Do(request)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred sending the '%v' request to '%v'", method, url)
+		}
+		defer response.Body.Close()
+		// Draining the body lets the connection get reused by the next attempts
+		_, _ = io.Copy(io.Discard, response.Body)
+		if response.StatusCode != expectedStatusCode {
+			return stacktrace.NewError("Expected the '%v' request to '%v' to respond with status code '%v' but it responded with '%v'", method, url, expectedStatusCode, response.StatusCode)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "Endpoint '%v' of port '%v' of service '%v' didn't become available", endpoint, portId, service.serviceName)
+	}
+	return nil
+}
+
+// WaitForExec runs the command in the service until it exits with the expected exit code
+func (service *ServiceContext) WaitForExec(
+	ctx context.Context,
+	command []string,
+	expectedExitCode int32,
+	interval time.Duration,
+	timeout time.Duration,
+) error {
+	args := binding_constructors.NewExecCommandArgs(string(service.serviceName), command)
+	if err := waitUntilReady(ctx, interval, timeout, func(ctx context.Context) error {
+		response, err := service.client.ExecCommand(ctx, args)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred executing command '%v'", command)
+		}
+		if response.GetExitCode() != expectedExitCode {
+			return stacktrace.NewError("Expected command '%v' to exit with code '%v' but it exited with '%v' and output:\n%v", command, expectedExitCode, response.GetExitCode(), response.GetLogOutput())
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "Command '%v' didn't succeed on service '%v'", command, service.serviceName)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+func (service *ServiceContext) getPublicTcpPortAddress(portId string) (string, error) {
+	publicPort, found := service.publicPorts[portId]
+	if !found || service.publicIpAddr == "" {
+		return "", stacktrace.NewError("Service '%v' has no public port with ID '%v'", service.serviceName, portId)
+	}
+	if publicPort.GetTransportProtocol() != TransportProtocol_TCP {
+		return "", stacktrace.NewError("Port '%v' of service '%v' isn't a TCP port, which is the only kind that can be checked", portId, service.serviceName)
+	}
+	return net.JoinHostPort(service.publicIpAddr, strconv.Itoa(int(publicPort.GetNumber()))), nil
+}
+
+// waitUntilReady runs the check every interval until it passes, and returns the error of its last run if it didn't pass
+// before the timeout
+func waitUntilReady(ctx context.Context, interval time.Duration, timeout time.Duration, check func(ctx context.Context) error) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var lastCheckErr error
+	for {
+		checkErr := check(ctxWithTimeout)
+		if checkErr == nil {
+			return nil
+		}
+		// A run cut short by the timeout only fails because of it, so the error of the run before it tells more
+		if lastCheckErr == nil || ctxWithTimeout.Err() == nil {
+			lastCheckErr = checkErr
+		}
+		select {
+		case <-ctxWithTimeout.Done():
+			return stacktrace.Propagate(lastCheckErr, "The check still didn't pass after '%v'", timeout)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPortId   = "http"
+	testInterval = 10 * time.Millisecond
+	testTimeout  = 2 * time.Second
+)
+
+func TestWaitForPortOpen_SucceedsOnListeningPort(t *testing.T) {
+	listener, err := net.Listen(tcpNetwork, "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	service := newTestServiceContextForAddress(t, listener.Addr().String())
+	require.NoError(t, service.WaitForPortOpen(context.Background(), testPortId, testInterval, testTimeout))
+}
+
+func TestWaitForPortOpen_FailsOnUnknownPort(t *testing.T) {
+	service := newTestServiceContextForAddress(t, "127.0.0.1:1")
+	require.Error(t, service.WaitForPortOpen(context.Background(), "unknown", testInterval, testTimeout))
+}
+
+func TestWaitForHTTP_PollsUntilExpectedStatusCode(t *testing.T) {
+	var requestsCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if requestsCount.Add(1) < 3 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	service := newTestServiceContextForAddress(t, server.Listener.Addr().String())
+	require.NoError(t, service.WaitForHTTP(context.Background(), testPortId, http.MethodGet, "/health", "", http.StatusOK, testInterval, testTimeout))
+	require.Equal(t, int32(3), requestsCount.Load())
+}
+
+func TestWaitForHTTP_FailsAfterTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	service := newTestServiceContextForAddress(t, server.Listener.Addr().String())
+	err := service.WaitForHTTP(context.Background(), testPortId, http.MethodGet, "/health", "", http.StatusOK, testInterval, 100*time.Millisecond)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), strconv.Itoa(http.StatusServiceUnavailable))
+}
+
+func newTestServiceContextForAddress(t *testing.T, address string) *ServiceContext {
+	host, portStr, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	require.NoError(t, err)
+	ports := map[string]*PortSpec{
+		testPortId: NewPortSpec(uint16(port), TransportProtocol_TCP, ""),
+	}
+	return NewServiceContext(nil, "test-service", "test-uuid", host, ports, host, ports)
+}
@@ -0,0 +1,35 @@
+package shared_utils
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// GetPage returns the keys of the page following the one pageToken was returned with (the first page if pageToken is
+// empty), at most pageSize of them (all the remaining ones if pageSize is 0), and the token of the next page, which is
+// empty if this is the last page.
+// sortedKeys must be unique and sorted in ascending order; as the token is the last key of the page, keys added or
+// removed between two calls don't make the pages skip or repeat the other keys
+func GetPage(sortedKeys []string, pageSize uint32, pageToken string) ([]string, string, error) {
+	firstKeyIndex := 0
+	if pageToken != "" {
+		lastKeyOfPreviousPageBytes, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "Page token '%v' is invalid; it should be the next page token of a previous page", pageToken)
+		}
+		lastKeyOfPreviousPage := string(lastKeyOfPreviousPageBytes)
+		firstKeyIndex = sort.Search(len(sortedKeys), func(keyIndex int) bool {
+			return sortedKeys[keyIndex] > lastKeyOfPreviousPage
+		})
+	}
+
+	remainingKeys := sortedKeys[firstKeyIndex:]
+	if pageSize == 0 || int(pageSize) >= len(remainingKeys) {
+		return remainingKeys, "", nil
+	}
+	pageKeys := remainingKeys[:pageSize]
+	nextPageToken := base64.RawURLEncoding.EncodeToString([]byte(pageKeys[len(pageKeys)-1]))
+	return pageKeys, nextPageToken, nil
+}
@@ -0,0 +1,47 @@
+package shared_utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testSortedKeys = []string{"a", "b", "c", "d", "e"}
+
+func TestGetPage_AllKeysIfNoPageSize(t *testing.T) {
+	pageKeys, nextPageToken, err := GetPage(testSortedKeys, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, testSortedKeys, pageKeys)
+	require.Empty(t, nextPageToken)
+}
+
+func TestGetPage_WalksThroughAllPages(t *testing.T) {
+	pageKeys, nextPageToken, err := GetPage(testSortedKeys, 2, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, pageKeys)
+	require.NotEmpty(t, nextPageToken)
+
+	pageKeys, nextPageToken, err = GetPage(testSortedKeys, 2, nextPageToken)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "d"}, pageKeys)
+	require.NotEmpty(t, nextPageToken)
+
+	pageKeys, nextPageToken, err = GetPage(testSortedKeys, 2, nextPageToken)
+	require.NoError(t, err)
+	require.Equal(t, []string{"e"}, pageKeys)
+	require.Empty(t, nextPageToken)
+}
+
+func TestGetPage_LastKeyOfPreviousPageRemoved(t *testing.T) {
+	_, nextPageToken, err := GetPage(testSortedKeys, 2, "")
+	require.NoError(t, err)
+
+	pageKeys, _, err := GetPage([]string{"a", "c", "d", "e"}, 2, nextPageToken)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "d"}, pageKeys)
+}
+
+func TestGetPage_InvalidPageToken(t *testing.T) {
+	_, _, err := GetPage(testSortedKeys, 2, "not a token!")
+	require.Error(t, err)
+}
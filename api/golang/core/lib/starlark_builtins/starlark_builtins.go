@@ -0,0 +1,427 @@
+package starlark_builtins
+
+// Builtin is the signature of a builtin of the Kurtosis Starlark dialect, which lets tools check packages without
+// interpreting them. The signatures are kept in sync with the ones of the API container by a test there
+type Builtin struct {
+	Name string
+
+	// The arguments in the order they can be passed positionally
+	Arguments []*Argument
+
+	// Empty unless the builtin is deprecated
+	DeprecationMitigation string
+}
+
+type Argument struct {
+	Name string
+
+	IsOptional bool
+
+	// The Starlark type the value must have, e.g. 'string' or 'ServiceConfig', or empty if several types are accepted
+	TypeName string
+
+	// Empty unless the argument is always deprecated; arguments only deprecated for some values aren't flagged here
+	DeprecationMitigation string
+}
+
+// GetPlanInstructions returns the builtins called on the plan object, like plan.add_service
+func GetPlanInstructions() []*Builtin {
+	return []*Builtin{
+		{
+			Name: "add_service",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "config", IsOptional: false, TypeName: "ServiceConfig", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "add_services",
+			Arguments: []*Argument{
+				{Name: "configs", IsOptional: false, TypeName: "dict", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "add_log_alert",
+			Arguments: []*Argument{
+				{Name: "service_name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "regex", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "action", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "run", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "image", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "get_service",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name:                  "get_services",
+			Arguments:             []*Argument{},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "set_enclave_env_vars",
+			Arguments: []*Argument{
+				{Name: "env_vars", IsOptional: false, TypeName: "dict", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "set_service",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "config", IsOptional: false, TypeName: "ServiceConfig", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "get_files_artifact",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "verify",
+			Arguments: []*Argument{
+				{Name: "value", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "assertion", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "target_value", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "exec",
+			Arguments: []*Argument{
+				{Name: "service_name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "recipe", IsOptional: false, TypeName: "ExecRecipe", DeprecationMitigation: ""},
+				{Name: "acceptable_codes", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "skip_code_check", IsOptional: true, TypeName: "bool", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "print",
+			Arguments: []*Argument{
+				{Name: "msg", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "remove_service",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "render_templates",
+			Arguments: []*Argument{
+				{Name: "config", IsOptional: false, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "name", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "request",
+			Arguments: []*Argument{
+				{Name: "service_name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "recipe", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+				{Name: "acceptable_codes", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "skip_code_check", IsOptional: true, TypeName: "bool", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "start_service",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "run_python",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "run", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "args", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "packages", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "image", IsOptional: true, TypeName: "", DeprecationMitigation: ""},
+				{Name: "files", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "store", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "wait", IsOptional: true, TypeName: "", DeprecationMitigation: ""},
+				{Name: "acceptable_codes", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "skip_code_check", IsOptional: true, TypeName: "bool", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "run_sh",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "run", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "image", IsOptional: true, TypeName: "", DeprecationMitigation: ""},
+				{Name: "files", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "store", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "env_vars", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "wait", IsOptional: true, TypeName: "", DeprecationMitigation: ""},
+				{Name: "acceptable_codes", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "skip_code_check", IsOptional: true, TypeName: "bool", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "stop_service",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "store_image_files",
+			Arguments: []*Argument{
+				{Name: "image", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "src", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "name", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "store_service_files",
+			Arguments: []*Argument{
+				{Name: "service_name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "src", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "name", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "upload_files",
+			Arguments: []*Argument{
+				{Name: "src", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "name", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "wait",
+			Arguments: []*Argument{
+				{Name: "service_name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "recipe", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+				{Name: "field", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "assertion", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "target_value", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+				{Name: "interval", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "timeout", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+	}
+}
+
+// GetGlobalBuiltins returns the predeclared builtins whose signature is known: the Kurtosis type constructors and
+// helpers
+func GetGlobalBuiltins() []*Builtin {
+	return []*Builtin{
+		{
+			Name: "Service",
+			Arguments: []*Argument{
+				{Name: "name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "hostname", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "ip_address", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "ports", IsOptional: false, TypeName: "dict", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "Directory",
+			Arguments: []*Argument{
+				{Name: "artifact_names", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "persistent_key", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "size", IsOptional: true, TypeName: "int", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "ExecRecipe",
+			Arguments: []*Argument{
+				{Name: "command", IsOptional: false, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "extract", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "GetHttpRequestRecipe",
+			Arguments: []*Argument{
+				{Name: "port_id", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "endpoint", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "extract", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "headers", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "PostHttpRequestRecipe",
+			Arguments: []*Argument{
+				{Name: "port_id", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "endpoint", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "body", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "content_type", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "extract", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "headers", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "PortSpec",
+			Arguments: []*Argument{
+				{Name: "number", IsOptional: true, TypeName: "int", DeprecationMitigation: ""},
+				{Name: "transport_protocol", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "application_protocol", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "wait", IsOptional: true, TypeName: "", DeprecationMitigation: ""},
+				{Name: "url", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "StoreSpec",
+			Arguments: []*Argument{
+				{Name: "src", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "name", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "ServiceConfig",
+			Arguments: []*Argument{
+				{Name: "image", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+				{Name: "ports", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "public_ports", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "files", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "entrypoint", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "cmd", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "env_vars", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "private_ip_address_placeholder", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "cpu_allocation", IsOptional: true, TypeName: "int", DeprecationMitigation: "This field is being deprecated in favour of `max_cpu` to set a maximum cpu a container can use"},
+				{Name: "memory_allocation", IsOptional: true, TypeName: "int", DeprecationMitigation: "This field is being deprecated in favour of `max_memory` to set maximum memory a container can use"},
+				{Name: "max_cpu", IsOptional: true, TypeName: "int", DeprecationMitigation: ""},
+				{Name: "min_cpu", IsOptional: true, TypeName: "int", DeprecationMitigation: ""},
+				{Name: "max_memory", IsOptional: true, TypeName: "int", DeprecationMitigation: ""},
+				{Name: "min_memory", IsOptional: true, TypeName: "int", DeprecationMitigation: ""},
+				{Name: "ready_conditions", IsOptional: true, TypeName: "ReadyCondition", DeprecationMitigation: ""},
+				{Name: "labels", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "user", IsOptional: true, TypeName: "User", DeprecationMitigation: ""},
+				{Name: "tolerations", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "node_selectors", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "files_to_be_moved", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+				{Name: "tini_enabled", IsOptional: true, TypeName: "bool", DeprecationMitigation: ""},
+				{Name: "depends_on", IsOptional: true, TypeName: "list", DeprecationMitigation: ""},
+				{Name: "restart_policy", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "crash_dump_path", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "ReadyCondition",
+			Arguments: []*Argument{
+				{Name: "recipe", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+				{Name: "field", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "assertion", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "target_value", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+				{Name: "interval", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "timeout", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "ImageBuildSpec",
+			Arguments: []*Argument{
+				{Name: "image_name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "build_context_dir", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "build_file", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "target_stage", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "build_args", IsOptional: true, TypeName: "dict", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "NixBuildSpec",
+			Arguments: []*Argument{
+				{Name: "flake_location_dir", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "build_context_dir", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "image_name", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "flake_output", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "ImageSpec",
+			Arguments: []*Argument{
+				{Name: "image", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "registry", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "username", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "password", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "User",
+			Arguments: []*Argument{
+				{Name: "uid", IsOptional: false, TypeName: "int", DeprecationMitigation: ""},
+				{Name: "gid", IsOptional: true, TypeName: "int", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "Toleration",
+			Arguments: []*Argument{
+				{Name: "key", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "operator", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "value", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "effect", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+				{Name: "toleration_seconds", IsOptional: true, TypeName: "int", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "import_module",
+			Arguments: []*Argument{
+				{Name: "module_file", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "read_file",
+			Arguments: []*Argument{
+				{Name: "src", IsOptional: false, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+		{
+			Name: "assert_eq",
+			Arguments: []*Argument{
+				{Name: "actual", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+				{Name: "expected", IsOptional: false, TypeName: "", DeprecationMitigation: ""},
+				{Name: "msg", IsOptional: true, TypeName: "string", DeprecationMitigation: ""},
+			},
+			DeprecationMitigation: "",
+		},
+	}
+}
+
+// GetOtherPredeclaredNames returns the names predeclared on top of the Starlark universe and the global builtins
+func GetOtherPredeclaredNames() []string {
+	return []string{
+		"json",
+		"kurtosis",
+		"print",
+		"struct",
+		"time",
+	}
+}
@@ -22,6 +22,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+type SubsystemHealthStatus int32
+
+const (
+	SubsystemHealthStatus_SubsystemHealthStatus_HEALTHY SubsystemHealthStatus = 0
+	// The engine works, but not fully, e.g. the logs of some services aren't collected
+	SubsystemHealthStatus_SubsystemHealthStatus_DEGRADED SubsystemHealthStatus = 1
+	// The engine can't do its job, e.g. no logs are stored at all
+	SubsystemHealthStatus_SubsystemHealthStatus_UNHEALTHY SubsystemHealthStatus = 2
+)
+
+// Enum value maps for SubsystemHealthStatus.
+var (
+	SubsystemHealthStatus_name = map[int32]string{
+		0: "SubsystemHealthStatus_HEALTHY",
+		1: "SubsystemHealthStatus_DEGRADED",
+		2: "SubsystemHealthStatus_UNHEALTHY",
+	}
+	SubsystemHealthStatus_value = map[string]int32{
+		"SubsystemHealthStatus_HEALTHY":   0,
+		"SubsystemHealthStatus_DEGRADED":  1,
+		"SubsystemHealthStatus_UNHEALTHY": 2,
+	}
+)
+
+func (x SubsystemHealthStatus) Enum() *SubsystemHealthStatus {
+	p := new(SubsystemHealthStatus)
+	*p = x
+	return p
+}
+
+func (x SubsystemHealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubsystemHealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SubsystemHealthStatus) Type() protoreflect.EnumType {
+	return &file_engine_service_proto_enumTypes[0]
+}
+
+func (x SubsystemHealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubsystemHealthStatus.Descriptor instead.
+func (SubsystemHealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{0}
+}
+
 type EnclaveMode int32
 
 const (
@@ -52,11 +104,11 @@ func (x EnclaveMode) String() string {
 }
 
 func (EnclaveMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[0].Descriptor()
+	return file_engine_service_proto_enumTypes[1].Descriptor()
 }
 
 func (EnclaveMode) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[0]
+	return &file_engine_service_proto_enumTypes[1]
 }
 
 func (x EnclaveMode) Number() protoreflect.EnumNumber {
@@ -65,7 +117,7 @@ func (x EnclaveMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnclaveMode.Descriptor instead.
 func (EnclaveMode) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{0}
+	return file_engine_service_proto_rawDescGZIP(), []int{1}
 }
 
 // ==============================================================================================
@@ -111,11 +163,11 @@ func (x EnclaveContainersStatus) String() string {
 }
 
 func (EnclaveContainersStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[1].Descriptor()
+	return file_engine_service_proto_enumTypes[2].Descriptor()
 }
 
 func (EnclaveContainersStatus) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[1]
+	return &file_engine_service_proto_enumTypes[2]
 }
 
 func (x EnclaveContainersStatus) Number() protoreflect.EnumNumber {
@@ -124,7 +176,7 @@ func (x EnclaveContainersStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnclaveContainersStatus.Descriptor instead.
 func (EnclaveContainersStatus) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{1}
+	return file_engine_service_proto_rawDescGZIP(), []int{2}
 }
 
 // NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
@@ -168,11 +220,11 @@ func (x EnclaveAPIContainerStatus) String() string {
 }
 
 func (EnclaveAPIContainerStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[2].Descriptor()
+	return file_engine_service_proto_enumTypes[3].Descriptor()
 }
 
 func (EnclaveAPIContainerStatus) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[2]
+	return &file_engine_service_proto_enumTypes[3]
 }
 
 func (x EnclaveAPIContainerStatus) Number() protoreflect.EnumNumber {
@@ -181,7 +233,7 @@ func (x EnclaveAPIContainerStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnclaveAPIContainerStatus.Descriptor instead.
 func (EnclaveAPIContainerStatus) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{2}
+	return file_engine_service_proto_rawDescGZIP(), []int{3}
 }
 
 // The filter operator which can be text or regex type
@@ -222,11 +274,11 @@ func (x LogLineOperator) String() string {
 }
 
 func (LogLineOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[3].Descriptor()
+	return file_engine_service_proto_enumTypes[4].Descriptor()
 }
 
 func (LogLineOperator) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[3]
+	return &file_engine_service_proto_enumTypes[4]
 }
 
 func (x LogLineOperator) Number() protoreflect.EnumNumber {
@@ -235,7 +287,7 @@ func (x LogLineOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LogLineOperator.Descriptor instead.
 func (LogLineOperator) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{3}
+	return file_engine_service_proto_rawDescGZIP(), []int{4}
 }
 
 // ==============================================================================================
@@ -250,6 +302,10 @@ type GetEngineInfoResponse struct {
 
 	// Version of the engine server
 	EngineVersion string `protobuf:"bytes,1,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	// Whether the engine restricts its TLS connections, and the ones of the API containers, to the FIPS-approved parameters
+	FipsMode bool `protobuf:"varint,2,opt,name=fips_mode,json=fipsMode,proto3" json:"fips_mode,omitempty"`
+	// Whether the engine was built with the FIPS 140 validated crypto module
+	ValidatedCrypto bool `protobuf:"varint,3,opt,name=validated_crypto,json=validatedCrypto,proto3" json:"validated_crypto,omitempty"`
 }
 
 func (x *GetEngineInfoResponse) Reset() {
@@ -291,31 +347,36 @@ func (x *GetEngineInfoResponse) GetEngineVersion() string {
 	return ""
 }
 
+func (x *GetEngineInfoResponse) GetFipsMode() bool {
+	if x != nil {
+		return x.FipsMode
+	}
+	return false
+}
+
+func (x *GetEngineInfoResponse) GetValidatedCrypto() bool {
+	if x != nil {
+		return x.ValidatedCrypto
+	}
+	return false
+}
+
 // ==============================================================================================
 //
-//	Create Enclave
+//	Negotiate API Version
 //
 // ==============================================================================================
-type CreateEnclaveArgs struct {
+type NegotiateApiVersionArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the new Kurtosis Enclave
-	EnclaveName *string `protobuf:"bytes,1,opt,name=enclave_name,json=enclaveName,proto3,oneof" json:"enclave_name,omitempty"`
-	// The image tag of the API container that should be used inside the enclave
-	// If blank, will use the default version that the engine server uses
-	ApiContainerVersionTag *string `protobuf:"bytes,2,opt,name=api_container_version_tag,json=apiContainerVersionTag,proto3,oneof" json:"api_container_version_tag,omitempty"`
-	// The API container log level
-	ApiContainerLogLevel *string      `protobuf:"bytes,3,opt,name=api_container_log_level,json=apiContainerLogLevel,proto3,oneof" json:"api_container_log_level,omitempty"`
-	Mode                 *EnclaveMode `protobuf:"varint,4,opt,name=mode,proto3,enum=engine_api.EnclaveMode,oneof" json:"mode,omitempty"`
-	// Whether the APIC's container should run with the debug server to receive a remote debug connection
-	// This is not an EnclaveMode because we will need to debug both current Modes (Test and Prod)
-	ShouldApicRunInDebugMode *bool `protobuf:"varint,5,opt,name=should_apic_run_in_debug_mode,json=shouldApicRunInDebugMode,proto3,oneof" json:"should_apic_run_in_debug_mode,omitempty"`
+	// The Kurtosis version the client was built for, e.g. '0.90.1'
+	ClientVersion string `protobuf:"bytes,1,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
 }
 
-func (x *CreateEnclaveArgs) Reset() {
-	*x = CreateEnclaveArgs{}
+func (x *NegotiateApiVersionArgs) Reset() {
+	*x = NegotiateApiVersionArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -323,13 +384,13 @@ func (x *CreateEnclaveArgs) Reset() {
 	}
 }
 
-func (x *CreateEnclaveArgs) String() string {
+func (x *NegotiateApiVersionArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEnclaveArgs) ProtoMessage() {}
+func (*NegotiateApiVersionArgs) ProtoMessage() {}
 
-func (x *CreateEnclaveArgs) ProtoReflect() protoreflect.Message {
+func (x *NegotiateApiVersionArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,57 +402,33 @@ func (x *CreateEnclaveArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEnclaveArgs.ProtoReflect.Descriptor instead.
-func (*CreateEnclaveArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use NegotiateApiVersionArgs.ProtoReflect.Descriptor instead.
+func (*NegotiateApiVersionArgs) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateEnclaveArgs) GetEnclaveName() string {
-	if x != nil && x.EnclaveName != nil {
-		return *x.EnclaveName
-	}
-	return ""
-}
-
-func (x *CreateEnclaveArgs) GetApiContainerVersionTag() string {
-	if x != nil && x.ApiContainerVersionTag != nil {
-		return *x.ApiContainerVersionTag
-	}
-	return ""
-}
-
-func (x *CreateEnclaveArgs) GetApiContainerLogLevel() string {
-	if x != nil && x.ApiContainerLogLevel != nil {
-		return *x.ApiContainerLogLevel
+func (x *NegotiateApiVersionArgs) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
 	}
 	return ""
 }
 
-func (x *CreateEnclaveArgs) GetMode() EnclaveMode {
-	if x != nil && x.Mode != nil {
-		return *x.Mode
-	}
-	return EnclaveMode_TEST
-}
-
-func (x *CreateEnclaveArgs) GetShouldApicRunInDebugMode() bool {
-	if x != nil && x.ShouldApicRunInDebugMode != nil {
-		return *x.ShouldApicRunInDebugMode
-	}
-	return false
-}
-
-type CreateEnclaveResponse struct {
+type NegotiateApiVersionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// All the enclave information inside this object
-	EnclaveInfo *EnclaveInfo `protobuf:"bytes,1,opt,name=enclave_info,json=enclaveInfo,proto3" json:"enclave_info,omitempty"`
+	// Version of the engine server
+	EngineVersion string `protobuf:"bytes,1,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	// False if the client and the engine APIs may differ, i.e. their major or minor versions differ
+	IsCompatible bool `protobuf:"varint,2,opt,name=is_compatible,json=isCompatible,proto3" json:"is_compatible,omitempty"`
+	// What to do about the version skew; empty if the versions are compatible
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *CreateEnclaveResponse) Reset() {
-	*x = CreateEnclaveResponse{}
+func (x *NegotiateApiVersionResponse) Reset() {
+	*x = NegotiateApiVersionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -399,13 +436,13 @@ func (x *CreateEnclaveResponse) Reset() {
 	}
 }
 
-func (x *CreateEnclaveResponse) String() string {
+func (x *NegotiateApiVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEnclaveResponse) ProtoMessage() {}
+func (*NegotiateApiVersionResponse) ProtoMessage() {}
 
-func (x *CreateEnclaveResponse) ProtoReflect() protoreflect.Message {
+func (x *NegotiateApiVersionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -417,35 +454,49 @@ func (x *CreateEnclaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEnclaveResponse.ProtoReflect.Descriptor instead.
-func (*CreateEnclaveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NegotiateApiVersionResponse.ProtoReflect.Descriptor instead.
+func (*NegotiateApiVersionResponse) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateEnclaveResponse) GetEnclaveInfo() *EnclaveInfo {
+func (x *NegotiateApiVersionResponse) GetEngineVersion() string {
 	if x != nil {
-		return x.EnclaveInfo
+		return x.EngineVersion
 	}
-	return nil
+	return ""
 }
 
-type EnclaveAPIContainerInfo struct {
+func (x *NegotiateApiVersionResponse) GetIsCompatible() bool {
+	if x != nil {
+		return x.IsCompatible
+	}
+	return false
+}
+
+func (x *NegotiateApiVersionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Get Engine Health
+//
+// ==============================================================================================
+type GetEngineHealthResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The container engine ID of the API container
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	// The IP inside the enclave network of the API container (i.e. how services inside the network can reach the API container)
-	IpInsideEnclave string `protobuf:"bytes,2,opt,name=ip_inside_enclave,json=ipInsideEnclave,proto3" json:"ip_inside_enclave,omitempty"`
-	// The grpc port inside the enclave network that the API container is listening on
-	GrpcPortInsideEnclave uint32 `protobuf:"varint,3,opt,name=grpc_port_inside_enclave,json=grpcPortInsideEnclave,proto3" json:"grpc_port_inside_enclave,omitempty"`
-	// this is the bridge ip address that gets assigned to api container
-	BridgeIpAddress string `protobuf:"bytes,6,opt,name=bridge_ip_address,json=bridgeIpAddress,proto3" json:"bridge_ip_address,omitempty"`
+	// The worst status of the subsystems
+	Status     SubsystemHealthStatus `protobuf:"varint,1,opt,name=status,proto3,enum=engine_api.SubsystemHealthStatus" json:"status,omitempty"`
+	Subsystems []*SubsystemHealth    `protobuf:"bytes,2,rep,name=subsystems,proto3" json:"subsystems,omitempty"`
 }
 
-func (x *EnclaveAPIContainerInfo) Reset() {
-	*x = EnclaveAPIContainerInfo{}
+func (x *GetEngineHealthResponse) Reset() {
+	*x = GetEngineHealthResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -453,13 +504,13 @@ func (x *EnclaveAPIContainerInfo) Reset() {
 	}
 }
 
-func (x *EnclaveAPIContainerInfo) String() string {
+func (x *GetEngineHealthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnclaveAPIContainerInfo) ProtoMessage() {}
+func (*GetEngineHealthResponse) ProtoMessage() {}
 
-func (x *EnclaveAPIContainerInfo) ProtoReflect() protoreflect.Message {
+func (x *GetEngineHealthResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -471,53 +522,42 @@ func (x *EnclaveAPIContainerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnclaveAPIContainerInfo.ProtoReflect.Descriptor instead.
-func (*EnclaveAPIContainerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEngineHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetEngineHealthResponse) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EnclaveAPIContainerInfo) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
-}
-
-func (x *EnclaveAPIContainerInfo) GetIpInsideEnclave() string {
+func (x *GetEngineHealthResponse) GetStatus() SubsystemHealthStatus {
 	if x != nil {
-		return x.IpInsideEnclave
-	}
-	return ""
-}
-
-func (x *EnclaveAPIContainerInfo) GetGrpcPortInsideEnclave() uint32 {
-	if x != nil {
-		return x.GrpcPortInsideEnclave
+		return x.Status
 	}
-	return 0
+	return SubsystemHealthStatus_SubsystemHealthStatus_HEALTHY
 }
 
-func (x *EnclaveAPIContainerInfo) GetBridgeIpAddress() string {
+func (x *GetEngineHealthResponse) GetSubsystems() []*SubsystemHealth {
 	if x != nil {
-		return x.BridgeIpAddress
+		return x.Subsystems
 	}
-	return ""
+	return nil
 }
 
-// Will only be present if the API container is running
-type EnclaveAPIContainerHostMachineInfo struct {
+type SubsystemHealth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The interface IP on the container engine host machine where the API container can be reached
-	IpOnHostMachine string `protobuf:"bytes,4,opt,name=ip_on_host_machine,json=ipOnHostMachine,proto3" json:"ip_on_host_machine,omitempty"`
-	// The grpc port on the container engine host machine where the API container can be reached
-	GrpcPortOnHostMachine uint32 `protobuf:"varint,5,opt,name=grpc_port_on_host_machine,json=grpcPortOnHostMachine,proto3" json:"grpc_port_on_host_machine,omitempty"`
+	// The name of the subsystem, e.g. "logs-aggregator"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The part of the subsystem this health is about, e.g. the node of a logs collector; empty if the subsystem has a
+	// single part
+	Instance string                `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	Status   SubsystemHealthStatus `protobuf:"varint,3,opt,name=status,proto3,enum=engine_api.SubsystemHealthStatus" json:"status,omitempty"`
+	// What's wrong with the subsystem, or how it's set up if it's healthy
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *EnclaveAPIContainerHostMachineInfo) Reset() {
-	*x = EnclaveAPIContainerHostMachineInfo{}
+func (x *SubsystemHealth) Reset() {
+	*x = SubsystemHealth{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,13 +565,13 @@ func (x *EnclaveAPIContainerHostMachineInfo) Reset() {
 	}
 }
 
-func (x *EnclaveAPIContainerHostMachineInfo) String() string {
+func (x *SubsystemHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnclaveAPIContainerHostMachineInfo) ProtoMessage() {}
+func (*SubsystemHealth) ProtoMessage() {}
 
-func (x *EnclaveAPIContainerHostMachineInfo) ProtoReflect() protoreflect.Message {
+func (x *SubsystemHealth) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,52 +583,56 @@ func (x *EnclaveAPIContainerHostMachineInfo) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnclaveAPIContainerHostMachineInfo.ProtoReflect.Descriptor instead.
-func (*EnclaveAPIContainerHostMachineInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubsystemHealth.ProtoReflect.Descriptor instead.
+func (*SubsystemHealth) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *EnclaveAPIContainerHostMachineInfo) GetIpOnHostMachine() string {
+func (x *SubsystemHealth) GetName() string {
 	if x != nil {
-		return x.IpOnHostMachine
+		return x.Name
 	}
 	return ""
 }
 
-func (x *EnclaveAPIContainerHostMachineInfo) GetGrpcPortOnHostMachine() uint32 {
+func (x *SubsystemHealth) GetInstance() string {
 	if x != nil {
-		return x.GrpcPortOnHostMachine
+		return x.Instance
 	}
-	return 0
+	return ""
 }
 
-// Enclaves are defined by a network in the container system, which is why there's a bunch of network information here
-type EnclaveInfo struct {
+func (x *SubsystemHealth) GetStatus() SubsystemHealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubsystemHealthStatus_SubsystemHealthStatus_HEALTHY
+}
+
+func (x *SubsystemHealth) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Update Engine Config
+//
+// ==============================================================================================
+type UpdateEngineConfigArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// UUID of the enclave
-	EnclaveUuid string `protobuf:"bytes,1,opt,name=enclave_uuid,json=enclaveUuid,proto3" json:"enclave_uuid,omitempty"`
-	// Name of the enclave
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The shortened uuid of the enclave
-	ShortenedUuid string `protobuf:"bytes,3,opt,name=shortened_uuid,json=shortenedUuid,proto3" json:"shortened_uuid,omitempty"`
-	// State of all containers in the enclave
-	ContainersStatus EnclaveContainersStatus `protobuf:"varint,4,opt,name=containers_status,json=containersStatus,proto3,enum=engine_api.EnclaveContainersStatus" json:"containers_status,omitempty"`
-	// State specifically of the API container
-	ApiContainerStatus EnclaveAPIContainerStatus `protobuf:"varint,5,opt,name=api_container_status,json=apiContainerStatus,proto3,enum=engine_api.EnclaveAPIContainerStatus" json:"api_container_status,omitempty"`
-	// NOTE: Will not be present if the API container status is "NONEXISTENT"!!
-	ApiContainerInfo *EnclaveAPIContainerInfo `protobuf:"bytes,6,opt,name=api_container_info,json=apiContainerInfo,proto3" json:"api_container_info,omitempty"`
-	// NOTE: Will not be present if the API container status is not "RUNNING"!!
-	ApiContainerHostMachineInfo *EnclaveAPIContainerHostMachineInfo `protobuf:"bytes,7,opt,name=api_container_host_machine_info,json=apiContainerHostMachineInfo,proto3" json:"api_container_host_machine_info,omitempty"`
-	// The enclave's creation time
-	CreationTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	Mode         EnclaveMode            `protobuf:"varint,9,opt,name=mode,proto3,enum=engine_api.EnclaveMode" json:"mode,omitempty"`
+	// The part of the engine config that can change while the engine runs (log retention period, enclave quota, auth
+	// tokens and default enclave TTL), as JSON
+	ConfigJson string `protobuf:"bytes,1,opt,name=config_json,json=configJson,proto3" json:"config_json,omitempty"`
 }
 
-func (x *EnclaveInfo) Reset() {
-	*x = EnclaveInfo{}
+func (x *UpdateEngineConfigArgs) Reset() {
+	*x = UpdateEngineConfigArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,13 +640,13 @@ func (x *EnclaveInfo) Reset() {
 	}
 }
 
-func (x *EnclaveInfo) String() string {
+func (x *UpdateEngineConfigArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnclaveInfo) ProtoMessage() {}
+func (*UpdateEngineConfigArgs) ProtoMessage() {}
 
-func (x *EnclaveInfo) ProtoReflect() protoreflect.Message {
+func (x *UpdateEngineConfigArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,23 +658,628 @@ func (x *EnclaveInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnclaveInfo.ProtoReflect.Descriptor instead.
-func (*EnclaveInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateEngineConfigArgs.ProtoReflect.Descriptor instead.
+func (*UpdateEngineConfigArgs) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *EnclaveInfo) GetEnclaveUuid() string {
+func (x *UpdateEngineConfigArgs) GetConfigJson() string {
 	if x != nil {
-		return x.EnclaveUuid
+		return x.ConfigJson
 	}
 	return ""
 }
 
-func (x *EnclaveInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// ==============================================================================================
+//
+//	Get Audit Log
+//
+// ==============================================================================================
+type GetAuditLogArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only returns the operations done at or after this time; all of them if unset
+	Since *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	// Only returns the operations done by this principal, e.g. 'token:ci'
+	Principal *string `protobuf:"bytes,2,opt,name=principal,proto3,oneof" json:"principal,omitempty"`
+	// Only returns the latest operations, up to this number
+	Limit *uint32 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+}
+
+func (x *GetAuditLogArgs) Reset() {
+	*x = GetAuditLogArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogArgs) ProtoMessage() {}
+
+func (x *GetAuditLogArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogArgs.ProtoReflect.Descriptor instead.
+func (*GetAuditLogArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAuditLogArgs) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetAuditLogArgs) GetPrincipal() string {
+	if x != nil && x.Principal != nil {
+		return *x.Principal
+	}
+	return ""
+}
+
+func (x *GetAuditLogArgs) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type AuditLogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Who made the call, e.g. 'token:ci' or 'oidc:jane@example.com'; 'unauthenticated' if the engine doesn't require authentication
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// The engine API the call was made to, 'grpc' or 'rest'
+	Api string `protobuf:"bytes,3,opt,name=api,proto3" json:"api,omitempty"`
+	// The called procedure, e.g. '/engine_api.EngineService/DestroyEnclave', or the method and route of REST calls
+	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// SHA-256 of the arguments of the call, so that they can be matched without storing secrets
+	ArgsDigest string `protobuf:"bytes,5,opt,name=args_digest,json=argsDigest,proto3" json:"args_digest,omitempty"`
+	// 'ok', or the error code of the call
+	Result string `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AuditLogEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetArgsDigest() string {
+	if x != nil {
+		return x.ArgsDigest
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ordered from the oldest to the latest
+	Entries []*AuditLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogResponse) ProtoMessage() {}
+
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetAuditLogResponse) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Create Enclave
+//
+// ==============================================================================================
+type CreateEnclaveArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the new Kurtosis Enclave
+	EnclaveName *string `protobuf:"bytes,1,opt,name=enclave_name,json=enclaveName,proto3,oneof" json:"enclave_name,omitempty"`
+	// The image tag of the API container that should be used inside the enclave
+	// If blank, will use the default version that the engine server uses
+	ApiContainerVersionTag *string `protobuf:"bytes,2,opt,name=api_container_version_tag,json=apiContainerVersionTag,proto3,oneof" json:"api_container_version_tag,omitempty"`
+	// The API container log level
+	ApiContainerLogLevel *string      `protobuf:"bytes,3,opt,name=api_container_log_level,json=apiContainerLogLevel,proto3,oneof" json:"api_container_log_level,omitempty"`
+	Mode                 *EnclaveMode `protobuf:"varint,4,opt,name=mode,proto3,enum=engine_api.EnclaveMode,oneof" json:"mode,omitempty"`
+	// Whether the APIC's container should run with the debug server to receive a remote debug connection
+	// This is not an EnclaveMode because we will need to debug both current Modes (Test and Prod)
+	ShouldApicRunInDebugMode *bool `protobuf:"varint,5,opt,name=should_apic_run_in_debug_mode,json=shouldApicRunInDebugMode,proto3,oneof" json:"should_apic_run_in_debug_mode,omitempty"`
+	// How long the enclave lives before the engine destroys it, as a duration string like '4h' or '30m'. If blank, the
+	// engine's default TTL applies, and the enclave lives until it's removed if there's none
+	Ttl *string `protobuf:"bytes,6,opt,name=ttl,proto3,oneof" json:"ttl,omitempty"`
+	// The Kubernetes cluster to create the enclave in, when the engine spans several clusters. If blank, the engine puts
+	// the enclave on the cluster with the fewest enclaves
+	ClusterName *string `protobuf:"bytes,7,opt,name=cluster_name,json=clusterName,proto3,oneof" json:"cluster_name,omitempty"`
+	// Whether the enclave is created without a logs collector, so that it doesn't carry the logging footprint. The logs
+	// of its services are then read straight from the container engine, and aren't kept once the services are removed
+	ShouldSkipLogsCollection *bool `protobuf:"varint,8,opt,name=should_skip_logs_collection,json=shouldSkipLogsCollection,proto3,oneof" json:"should_skip_logs_collection,omitempty"`
+	// Whether the services of the enclave can only reach the destinations of the egress allowlist, besides the enclave
+	// itself and the DNS servers
+	ShouldRestrictEgress *bool `protobuf:"varint,9,opt,name=should_restrict_egress,json=shouldRestrictEgress,proto3,oneof" json:"should_restrict_egress,omitempty"`
+	// The CIDRs, IP addresses and domains the services of the enclave can reach when the egress is restricted. Setting
+	// any restricts the egress. The domains get resolved once, when the enclave gets created
+	EgressAllowlist []string `protobuf:"bytes,10,rep,name=egress_allowlist,json=egressAllowlist,proto3" json:"egress_allowlist,omitempty"`
+}
+
+func (x *CreateEnclaveArgs) Reset() {
+	*x = CreateEnclaveArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEnclaveArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnclaveArgs) ProtoMessage() {}
+
+func (x *CreateEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*CreateEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateEnclaveArgs) GetEnclaveName() string {
+	if x != nil && x.EnclaveName != nil {
+		return *x.EnclaveName
+	}
+	return ""
+}
+
+func (x *CreateEnclaveArgs) GetApiContainerVersionTag() string {
+	if x != nil && x.ApiContainerVersionTag != nil {
+		return *x.ApiContainerVersionTag
+	}
+	return ""
+}
+
+func (x *CreateEnclaveArgs) GetApiContainerLogLevel() string {
+	if x != nil && x.ApiContainerLogLevel != nil {
+		return *x.ApiContainerLogLevel
+	}
+	return ""
+}
+
+func (x *CreateEnclaveArgs) GetMode() EnclaveMode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return EnclaveMode_TEST
+}
+
+func (x *CreateEnclaveArgs) GetShouldApicRunInDebugMode() bool {
+	if x != nil && x.ShouldApicRunInDebugMode != nil {
+		return *x.ShouldApicRunInDebugMode
+	}
+	return false
+}
+
+func (x *CreateEnclaveArgs) GetTtl() string {
+	if x != nil && x.Ttl != nil {
+		return *x.Ttl
+	}
+	return ""
+}
+
+func (x *CreateEnclaveArgs) GetClusterName() string {
+	if x != nil && x.ClusterName != nil {
+		return *x.ClusterName
+	}
+	return ""
+}
+
+func (x *CreateEnclaveArgs) GetShouldSkipLogsCollection() bool {
+	if x != nil && x.ShouldSkipLogsCollection != nil {
+		return *x.ShouldSkipLogsCollection
+	}
+	return false
+}
+
+func (x *CreateEnclaveArgs) GetShouldRestrictEgress() bool {
+	if x != nil && x.ShouldRestrictEgress != nil {
+		return *x.ShouldRestrictEgress
+	}
+	return false
+}
+
+func (x *CreateEnclaveArgs) GetEgressAllowlist() []string {
+	if x != nil {
+		return x.EgressAllowlist
+	}
+	return nil
+}
+
+type CreateEnclaveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All the enclave information inside this object
+	EnclaveInfo *EnclaveInfo `protobuf:"bytes,1,opt,name=enclave_info,json=enclaveInfo,proto3" json:"enclave_info,omitempty"`
+}
+
+func (x *CreateEnclaveResponse) Reset() {
+	*x = CreateEnclaveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEnclaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnclaveResponse) ProtoMessage() {}
+
+func (x *CreateEnclaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnclaveResponse.ProtoReflect.Descriptor instead.
+func (*CreateEnclaveResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateEnclaveResponse) GetEnclaveInfo() *EnclaveInfo {
+	if x != nil {
+		return x.EnclaveInfo
+	}
+	return nil
+}
+
+type EnclaveAPIContainerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The container engine ID of the API container
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// The IP inside the enclave network of the API container (i.e. how services inside the network can reach the API container)
+	IpInsideEnclave string `protobuf:"bytes,2,opt,name=ip_inside_enclave,json=ipInsideEnclave,proto3" json:"ip_inside_enclave,omitempty"`
+	// The grpc port inside the enclave network that the API container is listening on
+	GrpcPortInsideEnclave uint32 `protobuf:"varint,3,opt,name=grpc_port_inside_enclave,json=grpcPortInsideEnclave,proto3" json:"grpc_port_inside_enclave,omitempty"`
+	// this is the bridge ip address that gets assigned to api container
+	BridgeIpAddress string `protobuf:"bytes,6,opt,name=bridge_ip_address,json=bridgeIpAddress,proto3" json:"bridge_ip_address,omitempty"`
+}
+
+func (x *EnclaveAPIContainerInfo) Reset() {
+	*x = EnclaveAPIContainerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveAPIContainerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveAPIContainerInfo) ProtoMessage() {}
+
+func (x *EnclaveAPIContainerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveAPIContainerInfo.ProtoReflect.Descriptor instead.
+func (*EnclaveAPIContainerInfo) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EnclaveAPIContainerInfo) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *EnclaveAPIContainerInfo) GetIpInsideEnclave() string {
+	if x != nil {
+		return x.IpInsideEnclave
+	}
+	return ""
+}
+
+func (x *EnclaveAPIContainerInfo) GetGrpcPortInsideEnclave() uint32 {
+	if x != nil {
+		return x.GrpcPortInsideEnclave
+	}
+	return 0
+}
+
+func (x *EnclaveAPIContainerInfo) GetBridgeIpAddress() string {
+	if x != nil {
+		return x.BridgeIpAddress
+	}
+	return ""
+}
+
+// Will only be present if the API container is running
+type EnclaveAPIContainerHostMachineInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The interface IP on the container engine host machine where the API container can be reached
+	IpOnHostMachine string `protobuf:"bytes,4,opt,name=ip_on_host_machine,json=ipOnHostMachine,proto3" json:"ip_on_host_machine,omitempty"`
+	// The grpc port on the container engine host machine where the API container can be reached
+	GrpcPortOnHostMachine uint32 `protobuf:"varint,5,opt,name=grpc_port_on_host_machine,json=grpcPortOnHostMachine,proto3" json:"grpc_port_on_host_machine,omitempty"`
+}
+
+func (x *EnclaveAPIContainerHostMachineInfo) Reset() {
+	*x = EnclaveAPIContainerHostMachineInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveAPIContainerHostMachineInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveAPIContainerHostMachineInfo) ProtoMessage() {}
+
+func (x *EnclaveAPIContainerHostMachineInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveAPIContainerHostMachineInfo.ProtoReflect.Descriptor instead.
+func (*EnclaveAPIContainerHostMachineInfo) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EnclaveAPIContainerHostMachineInfo) GetIpOnHostMachine() string {
+	if x != nil {
+		return x.IpOnHostMachine
+	}
+	return ""
+}
+
+func (x *EnclaveAPIContainerHostMachineInfo) GetGrpcPortOnHostMachine() uint32 {
+	if x != nil {
+		return x.GrpcPortOnHostMachine
+	}
+	return 0
+}
+
+// Enclaves are defined by a network in the container system, which is why there's a bunch of network information here
+type EnclaveInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID of the enclave
+	EnclaveUuid string `protobuf:"bytes,1,opt,name=enclave_uuid,json=enclaveUuid,proto3" json:"enclave_uuid,omitempty"`
+	// Name of the enclave
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The shortened uuid of the enclave
+	ShortenedUuid string `protobuf:"bytes,3,opt,name=shortened_uuid,json=shortenedUuid,proto3" json:"shortened_uuid,omitempty"`
+	// State of all containers in the enclave
+	ContainersStatus EnclaveContainersStatus `protobuf:"varint,4,opt,name=containers_status,json=containersStatus,proto3,enum=engine_api.EnclaveContainersStatus" json:"containers_status,omitempty"`
+	// State specifically of the API container
+	ApiContainerStatus EnclaveAPIContainerStatus `protobuf:"varint,5,opt,name=api_container_status,json=apiContainerStatus,proto3,enum=engine_api.EnclaveAPIContainerStatus" json:"api_container_status,omitempty"`
+	// NOTE: Will not be present if the API container status is "NONEXISTENT"!!
+	ApiContainerInfo *EnclaveAPIContainerInfo `protobuf:"bytes,6,opt,name=api_container_info,json=apiContainerInfo,proto3" json:"api_container_info,omitempty"`
+	// NOTE: Will not be present if the API container status is not "RUNNING"!!
+	ApiContainerHostMachineInfo *EnclaveAPIContainerHostMachineInfo `protobuf:"bytes,7,opt,name=api_container_host_machine_info,json=apiContainerHostMachineInfo,proto3" json:"api_container_host_machine_info,omitempty"`
+	// The enclave's creation time
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	Mode         EnclaveMode            `protobuf:"varint,9,opt,name=mode,proto3,enum=engine_api.EnclaveMode" json:"mode,omitempty"`
+	// Who created the enclave, e.g. 'token:ci' or 'oidc:jane'. Not present if the engine doesn't require authentication
+	Owner *string `protobuf:"bytes,10,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	// When the engine will destroy the enclave. Not present if the enclave has no TTL
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expiration_time,json=expirationTime,proto3,oneof" json:"expiration_time,omitempty"`
+	// The Kubernetes cluster the enclave runs in. Not present if the engine doesn't span several clusters
+	ClusterName *string `protobuf:"bytes,12,opt,name=cluster_name,json=clusterName,proto3,oneof" json:"cluster_name,omitempty"`
+	// Whether the API container only accepts the calls authenticated with a certificate issued for its enclave, which
+	// the caller gets from GetApiContainerTlsCredentials
+	IsApiContainerMtlsRequired bool `protobuf:"varint,14,opt,name=is_api_container_mtls_required,json=isApiContainerMtlsRequired,proto3" json:"is_api_container_mtls_required,omitempty"`
+}
+
+func (x *EnclaveInfo) Reset() {
+	*x = EnclaveInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveInfo) ProtoMessage() {}
+
+func (x *EnclaveInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveInfo.ProtoReflect.Descriptor instead.
+func (*EnclaveInfo) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EnclaveInfo) GetEnclaveUuid() string {
+	if x != nil {
+		return x.EnclaveUuid
+	}
+	return ""
+}
+
+func (x *EnclaveInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
 func (x *EnclaveInfo) GetShortenedUuid() string {
@@ -654,32 +1303,135 @@ func (x *EnclaveInfo) GetApiContainerStatus() EnclaveAPIContainerStatus {
 	return EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_NONEXISTENT
 }
 
-func (x *EnclaveInfo) GetApiContainerInfo() *EnclaveAPIContainerInfo {
+func (x *EnclaveInfo) GetApiContainerInfo() *EnclaveAPIContainerInfo {
+	if x != nil {
+		return x.ApiContainerInfo
+	}
+	return nil
+}
+
+func (x *EnclaveInfo) GetApiContainerHostMachineInfo() *EnclaveAPIContainerHostMachineInfo {
+	if x != nil {
+		return x.ApiContainerHostMachineInfo
+	}
+	return nil
+}
+
+func (x *EnclaveInfo) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *EnclaveInfo) GetMode() EnclaveMode {
+	if x != nil {
+		return x.Mode
+	}
+	return EnclaveMode_TEST
+}
+
+func (x *EnclaveInfo) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *EnclaveInfo) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *EnclaveInfo) GetClusterName() string {
+	if x != nil && x.ClusterName != nil {
+		return *x.ClusterName
+	}
+	return ""
+}
+
+func (x *EnclaveInfo) GetIsApiContainerMtlsRequired() bool {
+	if x != nil {
+		return x.IsApiContainerMtlsRequired
+	}
+	return false
+}
+
+// The credentials of a client of an API container, issued by the certificate authority the engine creates for its
+// enclave. Every field is PEM-encoded
+type ApiContainerTlsCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Certificate of the authority of the enclave, which also issued the certificate of the API container
+	CertificateAuthority string `protobuf:"bytes,1,opt,name=certificate_authority,json=certificateAuthority,proto3" json:"certificate_authority,omitempty"`
+	ClientCertificate    string `protobuf:"bytes,2,opt,name=client_certificate,json=clientCertificate,proto3" json:"client_certificate,omitempty"`
+	ClientKey            string `protobuf:"bytes,3,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	// Whether the connections to the API container must be restricted to the FIPS-approved TLS parameters
+	FipsMode bool `protobuf:"varint,4,opt,name=fips_mode,json=fipsMode,proto3" json:"fips_mode,omitempty"`
+}
+
+func (x *ApiContainerTlsCredentials) Reset() {
+	*x = ApiContainerTlsCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiContainerTlsCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiContainerTlsCredentials) ProtoMessage() {}
+
+func (x *ApiContainerTlsCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiContainerTlsCredentials.ProtoReflect.Descriptor instead.
+func (*ApiContainerTlsCredentials) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApiContainerTlsCredentials) GetCertificateAuthority() string {
 	if x != nil {
-		return x.ApiContainerInfo
+		return x.CertificateAuthority
 	}
-	return nil
+	return ""
 }
 
-func (x *EnclaveInfo) GetApiContainerHostMachineInfo() *EnclaveAPIContainerHostMachineInfo {
+func (x *ApiContainerTlsCredentials) GetClientCertificate() string {
 	if x != nil {
-		return x.ApiContainerHostMachineInfo
+		return x.ClientCertificate
 	}
-	return nil
+	return ""
 }
 
-func (x *EnclaveInfo) GetCreationTime() *timestamppb.Timestamp {
+func (x *ApiContainerTlsCredentials) GetClientKey() string {
 	if x != nil {
-		return x.CreationTime
+		return x.ClientKey
 	}
-	return nil
+	return ""
 }
 
-func (x *EnclaveInfo) GetMode() EnclaveMode {
+func (x *ApiContainerTlsCredentials) GetFipsMode() bool {
 	if x != nil {
-		return x.Mode
+		return x.FipsMode
 	}
-	return EnclaveMode_TEST
+	return false
 }
 
 type GetEnclavesResponse struct {
@@ -694,7 +1446,7 @@ type GetEnclavesResponse struct {
 func (x *GetEnclavesResponse) Reset() {
 	*x = GetEnclavesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[6]
+		mi := &file_engine_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -707,7 +1459,7 @@ func (x *GetEnclavesResponse) String() string {
 func (*GetEnclavesResponse) ProtoMessage() {}
 
 func (x *GetEnclavesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[6]
+	mi := &file_engine_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +1472,7 @@ func (x *GetEnclavesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnclavesResponse.ProtoReflect.Descriptor instead.
 func (*GetEnclavesResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{6}
+	return file_engine_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetEnclavesResponse) GetEnclaveInfo() map[string]*EnclaveInfo {
@@ -730,6 +1482,138 @@ func (x *GetEnclavesResponse) GetEnclaveInfo() map[string]*EnclaveInfo {
 	return nil
 }
 
+type ListEnclavesArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only the enclaves in one of these statuses; enclaves in any status if empty
+	Statuses []EnclaveContainersStatus `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=engine_api.EnclaveContainersStatus" json:"statuses,omitempty"`
+	// Only the enclaves created by this owner, e.g. 'token:ci'
+	Owner *string `protobuf:"bytes,2,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	// The maximum number of enclaves to return; all of them if not set or 0
+	PageSize *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// The next_page_token of the previous response, to get the page after it; the first page if not set
+	PageToken *string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+}
+
+func (x *ListEnclavesArgs) Reset() {
+	*x = ListEnclavesArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnclavesArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnclavesArgs) ProtoMessage() {}
+
+func (x *ListEnclavesArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnclavesArgs.ProtoReflect.Descriptor instead.
+func (*ListEnclavesArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListEnclavesArgs) GetStatuses() []EnclaveContainersStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListEnclavesArgs) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *ListEnclavesArgs) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEnclavesArgs) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type ListEnclavesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The enclaves of the page, the oldest first
+	EnclaveInfos []*EnclaveInfo `protobuf:"bytes,1,rep,name=enclave_infos,json=enclaveInfos,proto3" json:"enclave_infos,omitempty"`
+	// To pass as page_token to get the next page; not set if this is the last page
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+}
+
+func (x *ListEnclavesResponse) Reset() {
+	*x = ListEnclavesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnclavesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnclavesResponse) ProtoMessage() {}
+
+func (x *ListEnclavesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnclavesResponse.ProtoReflect.Descriptor instead.
+func (*ListEnclavesResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListEnclavesResponse) GetEnclaveInfos() []*EnclaveInfo {
+	if x != nil {
+		return x.EnclaveInfos
+	}
+	return nil
+}
+
+func (x *ListEnclavesResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
 // An enclave identifier is a collection of uuid, name and shortened uuid
 type EnclaveIdentifiers struct {
 	state         protoimpl.MessageState
@@ -747,7 +1631,7 @@ type EnclaveIdentifiers struct {
 func (x *EnclaveIdentifiers) Reset() {
 	*x = EnclaveIdentifiers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[7]
+		mi := &file_engine_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -760,7 +1644,7 @@ func (x *EnclaveIdentifiers) String() string {
 func (*EnclaveIdentifiers) ProtoMessage() {}
 
 func (x *EnclaveIdentifiers) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[7]
+	mi := &file_engine_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +1657,7 @@ func (x *EnclaveIdentifiers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveIdentifiers.ProtoReflect.Descriptor instead.
 func (*EnclaveIdentifiers) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{7}
+	return file_engine_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *EnclaveIdentifiers) GetEnclaveUuid() string {
@@ -790,38 +1674,201 @@ func (x *EnclaveIdentifiers) GetName() string {
 	return ""
 }
 
-func (x *EnclaveIdentifiers) GetShortenedUuid() string {
+func (x *EnclaveIdentifiers) GetShortenedUuid() string {
+	if x != nil {
+		return x.ShortenedUuid
+	}
+	return ""
+}
+
+type GetExistingAndHistoricalEnclaveIdentifiersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllIdentifiers []*EnclaveIdentifiers `protobuf:"bytes,1,rep,name=allIdentifiers,proto3" json:"allIdentifiers,omitempty"`
+}
+
+func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) Reset() {
+	*x = GetExistingAndHistoricalEnclaveIdentifiersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoMessage() {}
+
+func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExistingAndHistoricalEnclaveIdentifiersResponse.ProtoReflect.Descriptor instead.
+func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) GetAllIdentifiers() []*EnclaveIdentifiers {
+	if x != nil {
+		return x.AllIdentifiers
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Stop Enclave
+//
+// ==============================================================================================
+type StopEnclaveArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to stop
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+}
+
+func (x *StopEnclaveArgs) Reset() {
+	*x = StopEnclaveArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopEnclaveArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopEnclaveArgs) ProtoMessage() {}
+
+func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*StopEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StopEnclaveArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Destroy Enclave
+//
+// ==============================================================================================
+type DestroyEnclaveArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+}
+
+func (x *DestroyEnclaveArgs) Reset() {
+	*x = DestroyEnclaveArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestroyEnclaveArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyEnclaveArgs) ProtoMessage() {}
+
+func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*DestroyEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DestroyEnclaveArgs) GetEnclaveIdentifier() string {
 	if x != nil {
-		return x.ShortenedUuid
+		return x.EnclaveIdentifier
 	}
 	return ""
 }
 
-type GetExistingAndHistoricalEnclaveIdentifiersResponse struct {
+// ==============================================================================================
+//
+//	Share Enclave
+//
+// ==============================================================================================
+type ShareEnclaveArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AllIdentifiers []*EnclaveIdentifiers `protobuf:"bytes,1,rep,name=allIdentifiers,proto3" json:"allIdentifiers,omitempty"`
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to share
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	// Who gets access to the enclave, e.g. 'token:ci' or 'oidc:jane'
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// If true, the access of the principal is revoked instead
+	Revoke *bool `protobuf:"varint,3,opt,name=revoke,proto3,oneof" json:"revoke,omitempty"`
 }
 
-func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) Reset() {
-	*x = GetExistingAndHistoricalEnclaveIdentifiersResponse{}
+func (x *ShareEnclaveArgs) Reset() {
+	*x = ShareEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[8]
+		mi := &file_engine_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) String() string {
+func (x *ShareEnclaveArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoMessage() {}
+func (*ShareEnclaveArgs) ProtoMessage() {}
 
-func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[8]
+func (x *ShareEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,49 +1879,59 @@ func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExistingAndHistoricalEnclaveIdentifiersResponse.ProtoReflect.Descriptor instead.
-func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ShareEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*ShareEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) GetAllIdentifiers() []*EnclaveIdentifiers {
+func (x *ShareEnclaveArgs) GetEnclaveIdentifier() string {
 	if x != nil {
-		return x.AllIdentifiers
+		return x.EnclaveIdentifier
 	}
-	return nil
+	return ""
 }
 
-// ==============================================================================================
-//
-//	Stop Enclave
-//
-// ==============================================================================================
-type StopEnclaveArgs struct {
+func (x *ShareEnclaveArgs) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *ShareEnclaveArgs) GetRevoke() bool {
+	if x != nil && x.Revoke != nil {
+		return *x.Revoke
+	}
+	return false
+}
+
+type ShareEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to stop
-	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Who the enclave is shared with, besides its owner and admins
+	Grantees []string `protobuf:"bytes,2,rep,name=grantees,proto3" json:"grantees,omitempty"`
 }
 
-func (x *StopEnclaveArgs) Reset() {
-	*x = StopEnclaveArgs{}
+func (x *ShareEnclaveResponse) Reset() {
+	*x = ShareEnclaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[9]
+		mi := &file_engine_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopEnclaveArgs) String() string {
+func (x *ShareEnclaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopEnclaveArgs) ProtoMessage() {}
+func (*ShareEnclaveResponse) ProtoMessage() {}
 
-func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[9]
+func (x *ShareEnclaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,49 +1942,56 @@ func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopEnclaveArgs.ProtoReflect.Descriptor instead.
-func (*StopEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ShareEnclaveResponse.ProtoReflect.Descriptor instead.
+func (*ShareEnclaveResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *StopEnclaveArgs) GetEnclaveIdentifier() string {
+func (x *ShareEnclaveResponse) GetOwner() string {
 	if x != nil {
-		return x.EnclaveIdentifier
+		return x.Owner
 	}
 	return ""
 }
 
+func (x *ShareEnclaveResponse) GetGrantees() []string {
+	if x != nil {
+		return x.Grantees
+	}
+	return nil
+}
+
 // ==============================================================================================
 //
-//	Destroy Enclave
+//	Get API Container TLS Credentials
 //
 // ==============================================================================================
-type DestroyEnclaveArgs struct {
+type GetApiContainerTlsCredentialsArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave whose API container is called
 	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
 }
 
-func (x *DestroyEnclaveArgs) Reset() {
-	*x = DestroyEnclaveArgs{}
+func (x *GetApiContainerTlsCredentialsArgs) Reset() {
+	*x = GetApiContainerTlsCredentialsArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[10]
+		mi := &file_engine_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DestroyEnclaveArgs) String() string {
+func (x *GetApiContainerTlsCredentialsArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyEnclaveArgs) ProtoMessage() {}
+func (*GetApiContainerTlsCredentialsArgs) ProtoMessage() {}
 
-func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[10]
+func (x *GetApiContainerTlsCredentialsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,12 +2002,12 @@ func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyEnclaveArgs.ProtoReflect.Descriptor instead.
-func (*DestroyEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetApiContainerTlsCredentialsArgs.ProtoReflect.Descriptor instead.
+func (*GetApiContainerTlsCredentialsArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DestroyEnclaveArgs) GetEnclaveIdentifier() string {
+func (x *GetApiContainerTlsCredentialsArgs) GetEnclaveIdentifier() string {
 	if x != nil {
 		return x.EnclaveIdentifier
 	}
@@ -967,7 +2031,7 @@ type CleanArgs struct {
 func (x *CleanArgs) Reset() {
 	*x = CleanArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[11]
+		mi := &file_engine_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -980,7 +2044,7 @@ func (x *CleanArgs) String() string {
 func (*CleanArgs) ProtoMessage() {}
 
 func (x *CleanArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[11]
+	mi := &file_engine_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,7 +2057,7 @@ func (x *CleanArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanArgs.ProtoReflect.Descriptor instead.
 func (*CleanArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{11}
+	return file_engine_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CleanArgs) GetShouldCleanAll() bool {
@@ -1015,7 +2079,7 @@ type EnclaveNameAndUuid struct {
 func (x *EnclaveNameAndUuid) Reset() {
 	*x = EnclaveNameAndUuid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[12]
+		mi := &file_engine_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1028,7 +2092,7 @@ func (x *EnclaveNameAndUuid) String() string {
 func (*EnclaveNameAndUuid) ProtoMessage() {}
 
 func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[12]
+	mi := &file_engine_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,7 +2105,7 @@ func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveNameAndUuid.ProtoReflect.Descriptor instead.
 func (*EnclaveNameAndUuid) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{12}
+	return file_engine_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *EnclaveNameAndUuid) GetName() string {
@@ -1070,7 +2134,7 @@ type CleanResponse struct {
 func (x *CleanResponse) Reset() {
 	*x = CleanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[13]
+		mi := &file_engine_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1083,7 +2147,7 @@ func (x *CleanResponse) String() string {
 func (*CleanResponse) ProtoMessage() {}
 
 func (x *CleanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[13]
+	mi := &file_engine_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +2160,7 @@ func (x *CleanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanResponse.ProtoReflect.Descriptor instead.
 func (*CleanResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{13}
+	return file_engine_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CleanResponse) GetRemovedEnclaveNameAndUuids() []*EnclaveNameAndUuid {
@@ -1133,7 +2197,7 @@ type GetServiceLogsArgs struct {
 func (x *GetServiceLogsArgs) Reset() {
 	*x = GetServiceLogsArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[14]
+		mi := &file_engine_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1146,7 +2210,7 @@ func (x *GetServiceLogsArgs) String() string {
 func (*GetServiceLogsArgs) ProtoMessage() {}
 
 func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[14]
+	mi := &file_engine_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +2223,7 @@ func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceLogsArgs.ProtoReflect.Descriptor instead.
 func (*GetServiceLogsArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{14}
+	return file_engine_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetServiceLogsArgs) GetEnclaveIdentifier() string {
@@ -1219,7 +2283,7 @@ type GetServiceLogsResponse struct {
 func (x *GetServiceLogsResponse) Reset() {
 	*x = GetServiceLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[15]
+		mi := &file_engine_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1232,7 +2296,7 @@ func (x *GetServiceLogsResponse) String() string {
 func (*GetServiceLogsResponse) ProtoMessage() {}
 
 func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[15]
+	mi := &file_engine_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1245,7 +2309,7 @@ func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceLogsResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{15}
+	return file_engine_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetServiceLogsResponse) GetServiceLogsByServiceUuid() map[string]*LogLine {
@@ -1274,7 +2338,7 @@ type LogLine struct {
 func (x *LogLine) Reset() {
 	*x = LogLine{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[16]
+		mi := &file_engine_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1287,7 +2351,7 @@ func (x *LogLine) String() string {
 func (*LogLine) ProtoMessage() {}
 
 func (x *LogLine) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[16]
+	mi := &file_engine_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,7 +2364,7 @@ func (x *LogLine) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
 func (*LogLine) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{16}
+	return file_engine_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *LogLine) GetLine() []string {
@@ -1329,7 +2393,7 @@ type LogLineFilter struct {
 func (x *LogLineFilter) Reset() {
 	*x = LogLineFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[17]
+		mi := &file_engine_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1339,10 +2403,236 @@ func (x *LogLineFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogLineFilter) ProtoMessage() {}
+func (*LogLineFilter) ProtoMessage() {}
+
+func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
+func (*LogLineFilter) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LogLineFilter) GetOperator() LogLineOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return LogLineOperator_LogLineOperator_DOES_CONTAIN_TEXT
+}
+
+func (x *LogLineFilter) GetTextPattern() string {
+	if x != nil {
+		return x.TextPattern
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Get User Service Resource Usage
+//
+// ==============================================================================================
+type GetServiceResourceUsageArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the user service's Kurtosis Enclave
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	// Only returns the samples taken at or after this time; all the retained ones if unset
+	Since *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *GetServiceResourceUsageArgs) Reset() {
+	*x = GetServiceResourceUsageArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceResourceUsageArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceResourceUsageArgs) ProtoMessage() {}
+
+func (x *GetServiceResourceUsageArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceResourceUsageArgs.ProtoReflect.Descriptor instead.
+func (*GetServiceResourceUsageArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetServiceResourceUsageArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
+	}
+	return ""
+}
+
+func (x *GetServiceResourceUsageArgs) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type GetServiceResourceUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The samples grouped by service UUIDs; services that have no samples yet, e.g. because they just started, are left out
+	ResourceUsageByServiceUuid map[string]*ResourceUsageSeries `protobuf:"bytes,1,rep,name=resource_usage_by_service_uuid,json=resourceUsageByServiceUuid,proto3" json:"resource_usage_by_service_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// How often the engine samples the services
+	SamplingIntervalSeconds uint32 `protobuf:"varint,2,opt,name=sampling_interval_seconds,json=samplingIntervalSeconds,proto3" json:"sampling_interval_seconds,omitempty"`
+}
+
+func (x *GetServiceResourceUsageResponse) Reset() {
+	*x = GetServiceResourceUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceResourceUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceResourceUsageResponse) ProtoMessage() {}
+
+func (x *GetServiceResourceUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceResourceUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceResourceUsageResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetServiceResourceUsageResponse) GetResourceUsageByServiceUuid() map[string]*ResourceUsageSeries {
+	if x != nil {
+		return x.ResourceUsageByServiceUuid
+	}
+	return nil
+}
+
+func (x *GetServiceResourceUsageResponse) GetSamplingIntervalSeconds() uint32 {
+	if x != nil {
+		return x.SamplingIntervalSeconds
+	}
+	return 0
+}
+
+type ResourceUsageSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ordered from the oldest to the latest
+	Samples []*ResourceUsageSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *ResourceUsageSeries) Reset() {
+	*x = ResourceUsageSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceUsageSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsageSeries) ProtoMessage() {}
+
+func (x *ResourceUsageSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsageSeries.ProtoReflect.Descriptor instead.
+func (*ResourceUsageSeries) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResourceUsageSeries) GetSamples() []*ResourceUsageSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type ResourceUsageSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CpuMilliCores uint64                 `protobuf:"varint,2,opt,name=cpu_milli_cores,json=cpuMilliCores,proto3" json:"cpu_milli_cores,omitempty"`
+	MemoryBytes   uint64                 `protobuf:"varint,3,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	// The bytes the service received and sent over the network since it started; unset if the backend doesn't report them
+	NetworkRxBytes *uint64 `protobuf:"varint,4,opt,name=network_rx_bytes,json=networkRxBytes,proto3,oneof" json:"network_rx_bytes,omitempty"`
+	NetworkTxBytes *uint64 `protobuf:"varint,5,opt,name=network_tx_bytes,json=networkTxBytes,proto3,oneof" json:"network_tx_bytes,omitempty"`
+}
+
+func (x *ResourceUsageSample) Reset() {
+	*x = ResourceUsageSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceUsageSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsageSample) ProtoMessage() {}
 
-func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[17]
+func (x *ResourceUsageSample) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,23 +2643,44 @@ func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
-func (*LogLineFilter) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ResourceUsageSample.ProtoReflect.Descriptor instead.
+func (*ResourceUsageSample) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *LogLineFilter) GetOperator() LogLineOperator {
+func (x *ResourceUsageSample) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Operator
+		return x.Timestamp
 	}
-	return LogLineOperator_LogLineOperator_DOES_CONTAIN_TEXT
+	return nil
 }
 
-func (x *LogLineFilter) GetTextPattern() string {
+func (x *ResourceUsageSample) GetCpuMilliCores() uint64 {
 	if x != nil {
-		return x.TextPattern
+		return x.CpuMilliCores
 	}
-	return ""
+	return 0
+}
+
+func (x *ResourceUsageSample) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsageSample) GetNetworkRxBytes() uint64 {
+	if x != nil && x.NetworkRxBytes != nil {
+		return *x.NetworkRxBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsageSample) GetNetworkTxBytes() uint64 {
+	if x != nil && x.NetworkTxBytes != nil {
+		return *x.NetworkTxBytes
+	}
+	return 0
 }
 
 var File_engine_service_proto protoreflect.FileDescriptor
@@ -1381,300 +2692,566 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xa5, 0x03, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
-	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e,
-	0x0a, 0x19, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x16, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x3a,
-	0x0a, 0x17, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x02, 0x52, 0x14, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x48, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x1d,
-	0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6e, 0x5f,
-	0x69, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x18, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x41, 0x70, 0x69,
-	0x63, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61,
-	0x67, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c,
-	0x64, 0x5f, 0x61, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xcd, 0x01,
-	0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11,
-	0x69, 0x70, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x70, 0x49, 0x6e, 0x73, 0x69, 0x64,
-	0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x67, 0x72, 0x70, 0x63,
-	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63,
-	0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01,
-	0x0a, 0x22, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f,
-	0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x69, 0x70, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x12, 0x38, 0x0a, 0x19, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
-	0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x6e,
-	0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xcd, 0x04, 0x0a, 0x0b,
-	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x65,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x14, 0x61,
-	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50,
-	0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x12, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x74, 0x0a, 0x1f, 0x61, 0x70, 0x69, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x1b, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f,
-	0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a,
-	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65,
+	0x22, 0x86, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x70, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x70, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x17, 0x4e, 0x65, 0x67,
+	0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x1b,
+	0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x91, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x39,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x67, 0x73, 0x12, 0x30, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70,
+	0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x67, 0x73, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0xe2, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x3e, 0x0a, 0x19, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x16, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x88,
+	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x17, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x14, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65,
 	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x57, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x44, 0x0a, 0x1d, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x61, 0x70, 0x69, 0x63, 0x5f,
+	0x72, 0x75, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x18, 0x73, 0x68, 0x6f, 0x75, 0x6c,
+	0x64, 0x41, 0x70, 0x69, 0x63, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a,
+	0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x1b, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f,
+	0x73, 0x6b, 0x69, 0x70, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x18, 0x73, 0x68,
+	0x6f, 0x75, 0x6c, 0x64, 0x53, 0x6b, 0x69, 0x70, 0x4c, 0x6f, 0x67, 0x73, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x73, 0x68, 0x6f,
+	0x75, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x65, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x08, 0x52, 0x14, 0x73, 0x68, 0x6f,
+	0x75, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x45, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x1a,
+	0x0a, 0x18, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x61,
+	0x70, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74, 0x6c, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x1e,
+	0x0a, 0x1c, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x6c,
+	0x6f, 0x67, 0x73, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x19,
+	0x0a, 0x17, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x5f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xcd,
+	0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x70, 0x49, 0x6e, 0x73, 0x69,
+	0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x67, 0x72, 0x70,
+	0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70,
+	0x63, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b,
+	0x01, 0x0a, 0x22, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x6f, 0x6e, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x69, 0x70, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x12, 0x38, 0x0a, 0x19, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x4f,
+	0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xd3, 0x06, 0x0a,
+	0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x14,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41,
+	0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x12, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x74, 0x0a, 0x1f, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x1b, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48,
+	0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f,
+	0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
 	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x72, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65,
-	0x64, 0x55, 0x75, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
-	0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x61,
-	0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
+	0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0e,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x1e, 0x69, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6d, 0x74,
+	0x6c, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x1a, 0x69, 0x73, 0x41, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x4d, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x0d,
+	0x10, 0x0e, 0x22, 0xbc, 0x01, 0x0a, 0x1a, 0x41, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x70, 0x73, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x70, 0x73, 0x4d, 0x6f, 0x64,
+	0x65, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x57,
+	0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdb, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2b, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x72, 0x0a,
+	0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69,
+	0x64, 0x22, 0x7c, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52,
+	0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22,
+	0x40, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x22, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65,
 	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65,
 	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x09, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x75, 0x6c,
-	0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e,
-	0x41, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c,
-	0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x22, 0x3c, 0x0a, 0x12, 0x45,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x22, 0x48, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x21, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6c, 0x73,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x4f,
+	0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x73,
+	0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x41, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73,
+	0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x22,
+	0x3c, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e,
+	0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73, 0x0a,
+	0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41,
+	0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45,
 	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1e, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75,
-	0x69, 0x64, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0xe2,
-	0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
-	0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
-	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53,
+	0x64, 0x73, 0x22, 0xe2, 0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72,
+	0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55,
+	0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13,
+	0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f,
+	0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67,
+	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0b,
+	0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x41,
+	0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67,
+	0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x6c, 0x6c,
+	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f,
+	0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0xc4, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c,
+	0x6f, 0x67, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f,
+	0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65,
+	0x74, 0x1a, 0x60, 0x0a, 0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73,
+	0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53,
-	0x65, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x4c, 0x6f, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6a,
-	0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61,
-	0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
-	0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x27, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4c,
-	0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x67,
-	0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x22, 0xc4, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80,
-	0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f,
-	0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75,
-	0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69,
-	0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
-	0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x1a, 0x60, 0x0a,
-	0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67,
-	0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x49, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x07, 0x4c, 0x6f,
-	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a,
-	0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
-	0x2a, 0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x08, 0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f,
-	0x44, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x86, 0x01, 0x0a, 0x17, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57,
+	0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x22, 0x7e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41,
+	0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x1e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x4b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1a,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x6e, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a,
+	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x70,
+	0x75, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x70, 0x75, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x6f, 0x72,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01,
+	0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2a, 0x83, 0x01,
+	0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x75,
+	0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23,
+	0x0a, 0x1f, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x59, 0x10, 0x02, 0x2a, 0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x86, 0x01, 0x0a,
+	0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x6e, 0x63, 0x6c,
 	0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a,
-	0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44,
-	0x10, 0x02, 0x2a, 0x94, 0x01, 0x0a, 0x19, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50,
+	0x74, 0x75, 0x73, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50,
+	0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x94, 0x01, 0x0a, 0x19, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50,
 	0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x29, 0x0a, 0x25, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x4e, 0x4f,
-	0x4e, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x45,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
-	0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xc3, 0x01, 0x0a, 0x0f, 0x4c, 0x6f,
-	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a,
-	0x21, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45,
-	0x58, 0x54, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12,
-	0x2c, 0x0a, 0x28, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f,
-	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x30, 0x0a,
-	0x2c, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49,
-	0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x32,
-	0xae, 0x05, 0x0a, 0x0d, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x53, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a,
-	0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86,
-	0x01, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e,
-	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e,
-	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x45,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x25,
+	0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xc3, 0x01, 0x0a,
+	0x0f, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x25, 0x0a, 0x21, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54,
+	0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02,
+	0x12, 0x30, 0x0a, 0x2c, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58,
+	0x10, 0x03, 0x32, 0x9b, 0x0b, 0x0a, 0x0d, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x65, 0x0a, 0x13, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x27,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x65, 0x67, 0x6f,
+	0x74, 0x69, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x41,
 	0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a,
-	0x0e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12,
-	0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73,
-	0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x1b, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x05, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x12, 0x15, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12,
+	0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x12, 0x1c, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x20, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x86, 0x01, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41,
+	0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41,
+	0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a,
+	0x0a, 0x0e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0c, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54,
+	0x6c, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x26, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x69, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x05, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x12,
+	0x15, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x71, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
 	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x2b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
 	0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
 	0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72,
 	0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67,
@@ -1695,79 +3272,129 @@ func file_engine_service_proto_rawDescGZIP() []byte {
 	return file_engine_service_proto_rawDescData
 }
 
-var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_engine_service_proto_goTypes = []interface{}{
-	(EnclaveMode)(0),                                           // 0: engine_api.EnclaveMode
-	(EnclaveContainersStatus)(0),                               // 1: engine_api.EnclaveContainersStatus
-	(EnclaveAPIContainerStatus)(0),                             // 2: engine_api.EnclaveAPIContainerStatus
-	(LogLineOperator)(0),                                       // 3: engine_api.LogLineOperator
-	(*GetEngineInfoResponse)(nil),                              // 4: engine_api.GetEngineInfoResponse
-	(*CreateEnclaveArgs)(nil),                                  // 5: engine_api.CreateEnclaveArgs
-	(*CreateEnclaveResponse)(nil),                              // 6: engine_api.CreateEnclaveResponse
-	(*EnclaveAPIContainerInfo)(nil),                            // 7: engine_api.EnclaveAPIContainerInfo
-	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 8: engine_api.EnclaveAPIContainerHostMachineInfo
-	(*EnclaveInfo)(nil),                                        // 9: engine_api.EnclaveInfo
-	(*GetEnclavesResponse)(nil),                                // 10: engine_api.GetEnclavesResponse
-	(*EnclaveIdentifiers)(nil),                                 // 11: engine_api.EnclaveIdentifiers
-	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 12: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	(*StopEnclaveArgs)(nil),                                    // 13: engine_api.StopEnclaveArgs
-	(*DestroyEnclaveArgs)(nil),                                 // 14: engine_api.DestroyEnclaveArgs
-	(*CleanArgs)(nil),                                          // 15: engine_api.CleanArgs
-	(*EnclaveNameAndUuid)(nil),                                 // 16: engine_api.EnclaveNameAndUuid
-	(*CleanResponse)(nil),                                      // 17: engine_api.CleanResponse
-	(*GetServiceLogsArgs)(nil),                                 // 18: engine_api.GetServiceLogsArgs
-	(*GetServiceLogsResponse)(nil),                             // 19: engine_api.GetServiceLogsResponse
-	(*LogLine)(nil),                                            // 20: engine_api.LogLine
-	(*LogLineFilter)(nil),                                      // 21: engine_api.LogLineFilter
-	nil,                                                        // 22: engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	nil,                                                        // 23: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	nil,                                                        // 24: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	nil,                                                        // 25: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	(*timestamppb.Timestamp)(nil),                              // 26: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                      // 27: google.protobuf.Empty
+	(SubsystemHealthStatus)(0),                                 // 0: engine_api.SubsystemHealthStatus
+	(EnclaveMode)(0),                                           // 1: engine_api.EnclaveMode
+	(EnclaveContainersStatus)(0),                               // 2: engine_api.EnclaveContainersStatus
+	(EnclaveAPIContainerStatus)(0),                             // 3: engine_api.EnclaveAPIContainerStatus
+	(LogLineOperator)(0),                                       // 4: engine_api.LogLineOperator
+	(*GetEngineInfoResponse)(nil),                              // 5: engine_api.GetEngineInfoResponse
+	(*NegotiateApiVersionArgs)(nil),                            // 6: engine_api.NegotiateApiVersionArgs
+	(*NegotiateApiVersionResponse)(nil),                        // 7: engine_api.NegotiateApiVersionResponse
+	(*GetEngineHealthResponse)(nil),                            // 8: engine_api.GetEngineHealthResponse
+	(*SubsystemHealth)(nil),                                    // 9: engine_api.SubsystemHealth
+	(*UpdateEngineConfigArgs)(nil),                             // 10: engine_api.UpdateEngineConfigArgs
+	(*GetAuditLogArgs)(nil),                                    // 11: engine_api.GetAuditLogArgs
+	(*AuditLogEntry)(nil),                                      // 12: engine_api.AuditLogEntry
+	(*GetAuditLogResponse)(nil),                                // 13: engine_api.GetAuditLogResponse
+	(*CreateEnclaveArgs)(nil),                                  // 14: engine_api.CreateEnclaveArgs
+	(*CreateEnclaveResponse)(nil),                              // 15: engine_api.CreateEnclaveResponse
+	(*EnclaveAPIContainerInfo)(nil),                            // 16: engine_api.EnclaveAPIContainerInfo
+	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 17: engine_api.EnclaveAPIContainerHostMachineInfo
+	(*EnclaveInfo)(nil),                                        // 18: engine_api.EnclaveInfo
+	(*ApiContainerTlsCredentials)(nil),                         // 19: engine_api.ApiContainerTlsCredentials
+	(*GetEnclavesResponse)(nil),                                // 20: engine_api.GetEnclavesResponse
+	(*ListEnclavesArgs)(nil),                                   // 21: engine_api.ListEnclavesArgs
+	(*ListEnclavesResponse)(nil),                               // 22: engine_api.ListEnclavesResponse
+	(*EnclaveIdentifiers)(nil),                                 // 23: engine_api.EnclaveIdentifiers
+	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 24: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	(*StopEnclaveArgs)(nil),                                    // 25: engine_api.StopEnclaveArgs
+	(*DestroyEnclaveArgs)(nil),                                 // 26: engine_api.DestroyEnclaveArgs
+	(*ShareEnclaveArgs)(nil),                                   // 27: engine_api.ShareEnclaveArgs
+	(*ShareEnclaveResponse)(nil),                               // 28: engine_api.ShareEnclaveResponse
+	(*GetApiContainerTlsCredentialsArgs)(nil),                  // 29: engine_api.GetApiContainerTlsCredentialsArgs
+	(*CleanArgs)(nil),                                          // 30: engine_api.CleanArgs
+	(*EnclaveNameAndUuid)(nil),                                 // 31: engine_api.EnclaveNameAndUuid
+	(*CleanResponse)(nil),                                      // 32: engine_api.CleanResponse
+	(*GetServiceLogsArgs)(nil),                                 // 33: engine_api.GetServiceLogsArgs
+	(*GetServiceLogsResponse)(nil),                             // 34: engine_api.GetServiceLogsResponse
+	(*LogLine)(nil),                                            // 35: engine_api.LogLine
+	(*LogLineFilter)(nil),                                      // 36: engine_api.LogLineFilter
+	(*GetServiceResourceUsageArgs)(nil),                        // 37: engine_api.GetServiceResourceUsageArgs
+	(*GetServiceResourceUsageResponse)(nil),                    // 38: engine_api.GetServiceResourceUsageResponse
+	(*ResourceUsageSeries)(nil),                                // 39: engine_api.ResourceUsageSeries
+	(*ResourceUsageSample)(nil),                                // 40: engine_api.ResourceUsageSample
+	nil,                                                        // 41: engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	nil,                                                        // 42: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	nil,                                                        // 43: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	nil,                                                        // 44: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	nil,                                                        // 45: engine_api.GetServiceResourceUsageResponse.ResourceUsageByServiceUuidEntry
+	(*timestamppb.Timestamp)(nil),                              // 46: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                      // 47: google.protobuf.Empty
 }
 var file_engine_service_proto_depIdxs = []int32{
-	0,  // 0: engine_api.CreateEnclaveArgs.mode:type_name -> engine_api.EnclaveMode
-	9,  // 1: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
-	1,  // 2: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
-	2,  // 3: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
-	7,  // 4: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
-	8,  // 5: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
-	26, // 6: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
-	0,  // 7: engine_api.EnclaveInfo.mode:type_name -> engine_api.EnclaveMode
-	22, // 8: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	11, // 9: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
-	16, // 10: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
-	23, // 11: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	21, // 12: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
-	24, // 13: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	25, // 14: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	26, // 15: engine_api.LogLine.timestamp:type_name -> google.protobuf.Timestamp
-	3,  // 16: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
-	9,  // 17: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
-	20, // 18: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
-	27, // 19: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
-	5,  // 20: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
-	27, // 21: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
-	27, // 22: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
-	13, // 23: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
-	14, // 24: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
-	15, // 25: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
-	18, // 26: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
-	4,  // 27: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
-	6,  // 28: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
-	10, // 29: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
-	12, // 30: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	27, // 31: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
-	27, // 32: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
-	17, // 33: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
-	19, // 34: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
-	27, // [27:35] is the sub-list for method output_type
-	19, // [19:27] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	0,  // 0: engine_api.GetEngineHealthResponse.status:type_name -> engine_api.SubsystemHealthStatus
+	9,  // 1: engine_api.GetEngineHealthResponse.subsystems:type_name -> engine_api.SubsystemHealth
+	0,  // 2: engine_api.SubsystemHealth.status:type_name -> engine_api.SubsystemHealthStatus
+	46, // 3: engine_api.GetAuditLogArgs.since:type_name -> google.protobuf.Timestamp
+	46, // 4: engine_api.AuditLogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	12, // 5: engine_api.GetAuditLogResponse.entries:type_name -> engine_api.AuditLogEntry
+	1,  // 6: engine_api.CreateEnclaveArgs.mode:type_name -> engine_api.EnclaveMode
+	18, // 7: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	2,  // 8: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
+	3,  // 9: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
+	16, // 10: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
+	17, // 11: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
+	46, // 12: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
+	1,  // 13: engine_api.EnclaveInfo.mode:type_name -> engine_api.EnclaveMode
+	46, // 14: engine_api.EnclaveInfo.expiration_time:type_name -> google.protobuf.Timestamp
+	41, // 15: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	2,  // 16: engine_api.ListEnclavesArgs.statuses:type_name -> engine_api.EnclaveContainersStatus
+	18, // 17: engine_api.ListEnclavesResponse.enclave_infos:type_name -> engine_api.EnclaveInfo
+	23, // 18: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
+	31, // 19: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
+	42, // 20: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	36, // 21: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
+	43, // 22: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	44, // 23: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	46, // 24: engine_api.LogLine.timestamp:type_name -> google.protobuf.Timestamp
+	4,  // 25: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
+	46, // 26: engine_api.GetServiceResourceUsageArgs.since:type_name -> google.protobuf.Timestamp
+	45, // 27: engine_api.GetServiceResourceUsageResponse.resource_usage_by_service_uuid:type_name -> engine_api.GetServiceResourceUsageResponse.ResourceUsageByServiceUuidEntry
+	40, // 28: engine_api.ResourceUsageSeries.samples:type_name -> engine_api.ResourceUsageSample
+	46, // 29: engine_api.ResourceUsageSample.timestamp:type_name -> google.protobuf.Timestamp
+	18, // 30: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
+	35, // 31: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
+	39, // 32: engine_api.GetServiceResourceUsageResponse.ResourceUsageByServiceUuidEntry.value:type_name -> engine_api.ResourceUsageSeries
+	47, // 33: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
+	6,  // 34: engine_api.EngineService.NegotiateApiVersion:input_type -> engine_api.NegotiateApiVersionArgs
+	10, // 35: engine_api.EngineService.UpdateEngineConfig:input_type -> engine_api.UpdateEngineConfigArgs
+	11, // 36: engine_api.EngineService.GetAuditLog:input_type -> engine_api.GetAuditLogArgs
+	47, // 37: engine_api.EngineService.GetEngineHealth:input_type -> google.protobuf.Empty
+	14, // 38: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
+	47, // 39: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
+	21, // 40: engine_api.EngineService.ListEnclaves:input_type -> engine_api.ListEnclavesArgs
+	47, // 41: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
+	25, // 42: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
+	26, // 43: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
+	27, // 44: engine_api.EngineService.ShareEnclave:input_type -> engine_api.ShareEnclaveArgs
+	29, // 45: engine_api.EngineService.GetApiContainerTlsCredentials:input_type -> engine_api.GetApiContainerTlsCredentialsArgs
+	30, // 46: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
+	33, // 47: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
+	37, // 48: engine_api.EngineService.GetServiceResourceUsage:input_type -> engine_api.GetServiceResourceUsageArgs
+	5,  // 49: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
+	7,  // 50: engine_api.EngineService.NegotiateApiVersion:output_type -> engine_api.NegotiateApiVersionResponse
+	47, // 51: engine_api.EngineService.UpdateEngineConfig:output_type -> google.protobuf.Empty
+	13, // 52: engine_api.EngineService.GetAuditLog:output_type -> engine_api.GetAuditLogResponse
+	8,  // 53: engine_api.EngineService.GetEngineHealth:output_type -> engine_api.GetEngineHealthResponse
+	15, // 54: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
+	20, // 55: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
+	22, // 56: engine_api.EngineService.ListEnclaves:output_type -> engine_api.ListEnclavesResponse
+	24, // 57: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	47, // 58: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
+	47, // 59: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
+	28, // 60: engine_api.EngineService.ShareEnclave:output_type -> engine_api.ShareEnclaveResponse
+	19, // 61: engine_api.EngineService.GetApiContainerTlsCredentials:output_type -> engine_api.ApiContainerTlsCredentials
+	32, // 62: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
+	34, // 63: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
+	38, // 64: engine_api.EngineService.GetServiceResourceUsage:output_type -> engine_api.GetServiceResourceUsageResponse
+	49, // [49:65] is the sub-list for method output_type
+	33, // [33:49] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_engine_service_proto_init() }
@@ -1789,7 +3416,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEnclaveArgs); i {
+			switch v := v.(*NegotiateApiVersionArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1801,7 +3428,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEnclaveResponse); i {
+			switch v := v.(*NegotiateApiVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1813,7 +3440,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveAPIContainerInfo); i {
+			switch v := v.(*GetEngineHealthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1825,7 +3452,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveAPIContainerHostMachineInfo); i {
+			switch v := v.(*SubsystemHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1837,7 +3464,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveInfo); i {
+			switch v := v.(*UpdateEngineConfigArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1849,7 +3476,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnclavesResponse); i {
+			switch v := v.(*GetAuditLogArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1861,7 +3488,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveIdentifiers); i {
+			switch v := v.(*AuditLogEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1873,7 +3500,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExistingAndHistoricalEnclaveIdentifiersResponse); i {
+			switch v := v.(*GetAuditLogResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1885,7 +3512,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopEnclaveArgs); i {
+			switch v := v.(*CreateEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1897,7 +3524,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyEnclaveArgs); i {
+			switch v := v.(*CreateEnclaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1909,7 +3536,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanArgs); i {
+			switch v := v.(*EnclaveAPIContainerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1921,7 +3548,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveNameAndUuid); i {
+			switch v := v.(*EnclaveAPIContainerHostMachineInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1933,7 +3560,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanResponse); i {
+			switch v := v.(*EnclaveInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1945,7 +3572,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsArgs); i {
+			switch v := v.(*ApiContainerTlsCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1957,7 +3584,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsResponse); i {
+			switch v := v.(*GetEnclavesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1969,7 +3596,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogLine); i {
+			switch v := v.(*ListEnclavesArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1981,6 +3608,174 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnclavesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnclaveIdentifiers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExistingAndHistoricalEnclaveIdentifiersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopEnclaveArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyEnclaveArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareEnclaveArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareEnclaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApiContainerTlsCredentialsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnclaveNameAndUuid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogLineFilter); i {
 			case 0:
 				return &v.state
@@ -1992,17 +3787,71 @@ func file_engine_service_proto_init() {
 				return nil
 			}
 		}
+		file_engine_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceResourceUsageArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceResourceUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUsageSeries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUsageSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_engine_service_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	file_engine_service_proto_msgTypes[11].OneofWrappers = []interface{}{}
-	file_engine_service_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[35].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_engine_service_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   22,
+			NumEnums:      5,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
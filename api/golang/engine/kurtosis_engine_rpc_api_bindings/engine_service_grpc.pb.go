@@ -21,13 +21,21 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	EngineService_GetEngineInfo_FullMethodName                              = "/engine_api.EngineService/GetEngineInfo"
+	EngineService_NegotiateApiVersion_FullMethodName                        = "/engine_api.EngineService/NegotiateApiVersion"
+	EngineService_UpdateEngineConfig_FullMethodName                         = "/engine_api.EngineService/UpdateEngineConfig"
+	EngineService_GetAuditLog_FullMethodName                                = "/engine_api.EngineService/GetAuditLog"
+	EngineService_GetEngineHealth_FullMethodName                            = "/engine_api.EngineService/GetEngineHealth"
 	EngineService_CreateEnclave_FullMethodName                              = "/engine_api.EngineService/CreateEnclave"
 	EngineService_GetEnclaves_FullMethodName                                = "/engine_api.EngineService/GetEnclaves"
+	EngineService_ListEnclaves_FullMethodName                               = "/engine_api.EngineService/ListEnclaves"
 	EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
 	EngineService_StopEnclave_FullMethodName                                = "/engine_api.EngineService/StopEnclave"
 	EngineService_DestroyEnclave_FullMethodName                             = "/engine_api.EngineService/DestroyEnclave"
+	EngineService_ShareEnclave_FullMethodName                               = "/engine_api.EngineService/ShareEnclave"
+	EngineService_GetApiContainerTlsCredentials_FullMethodName              = "/engine_api.EngineService/GetApiContainerTlsCredentials"
 	EngineService_Clean_FullMethodName                                      = "/engine_api.EngineService/Clean"
 	EngineService_GetServiceLogs_FullMethodName                             = "/engine_api.EngineService/GetServiceLogs"
+	EngineService_GetServiceResourceUsage_FullMethodName                    = "/engine_api.EngineService/GetServiceResourceUsage"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -36,6 +44,16 @@ const (
 type EngineServiceClient interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
 	GetEngineInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEngineInfoResponse, error)
+	// Tells whether the engine API matches the one a client (CLI or SDK) was built for, so that version skew is reported
+	// clearly before other calls fail in confusing ways
+	NegotiateApiVersion(ctx context.Context, in *NegotiateApiVersionArgs, opts ...grpc.CallOption) (*NegotiateApiVersionResponse, error)
+	// Applies a new engine config, e.g. new auth tokens, to every engine replica without restarting them; only admins can do it
+	UpdateEngineConfig(ctx context.Context, in *UpdateEngineConfigArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the mutating calls made to the engine APIs, who made them and when; only admins can do it
+	GetAuditLog(ctx context.Context, in *GetAuditLogArgs, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	// Returns the health of the subsystems the engine relies on (backend, logs aggregator and collectors, state store and
+	// artifacts store), so that e.g. missing logs are noticed before they are needed
+	GetEngineHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEngineHealthResponse, error)
 	// ==============================================================================================
 	//
 	//	Enclave Management
@@ -45,16 +63,25 @@ type EngineServiceClient interface {
 	CreateEnclave(ctx context.Context, in *CreateEnclaveArgs, opts ...grpc.CallOption) (*CreateEnclaveResponse, error)
 	// Returns information about the existing enclaves
 	GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
+	// Returns a page of the existing enclaves, the oldest first, optionally only the ones in some statuses or of an owner
+	ListEnclaves(ctx context.Context, in *ListEnclavesArgs, opts ...grpc.CallOption) (*ListEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Grants or revokes access to an enclave; only the owner of the enclave and admins can do it
+	ShareEnclave(ctx context.Context, in *ShareEnclaveArgs, opts ...grpc.CallOption) (*ShareEnclaveResponse, error)
+	// Issues the caller a short-lived certificate to call the API container of an enclave requiring mutual TLS with;
+	// only the callers that can change the enclave get one
+	GetApiContainerTlsCredentials(ctx context.Context, in *GetApiContainerTlsCredentialsArgs, opts ...grpc.CallOption) (*ApiContainerTlsCredentials, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// Returns the CPU, memory and network usage the engine sampled lately for each running service of an enclave
+	GetServiceResourceUsage(ctx context.Context, in *GetServiceResourceUsageArgs, opts ...grpc.CallOption) (*GetServiceResourceUsageResponse, error)
 }
 
 type engineServiceClient struct {
@@ -74,6 +101,42 @@ func (c *engineServiceClient) GetEngineInfo(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *engineServiceClient) NegotiateApiVersion(ctx context.Context, in *NegotiateApiVersionArgs, opts ...grpc.CallOption) (*NegotiateApiVersionResponse, error) {
+	out := new(NegotiateApiVersionResponse)
+	err := c.cc.Invoke(ctx, EngineService_NegotiateApiVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) UpdateEngineConfig(ctx context.Context, in *UpdateEngineConfigArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_UpdateEngineConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogArgs, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetEngineHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEngineHealthResponse, error) {
+	out := new(GetEngineHealthResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetEngineHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) CreateEnclave(ctx context.Context, in *CreateEnclaveArgs, opts ...grpc.CallOption) (*CreateEnclaveResponse, error) {
 	out := new(CreateEnclaveResponse)
 	err := c.cc.Invoke(ctx, EngineService_CreateEnclave_FullMethodName, in, out, opts...)
@@ -92,6 +155,15 @@ func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *emptypb.Empty
 	return out, nil
 }
 
+func (c *engineServiceClient) ListEnclaves(ctx context.Context, in *ListEnclavesArgs, opts ...grpc.CallOption) (*ListEnclavesResponse, error) {
+	out := new(ListEnclavesResponse)
+	err := c.cc.Invoke(ctx, EngineService_ListEnclaves_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error) {
 	out := new(GetExistingAndHistoricalEnclaveIdentifiersResponse)
 	err := c.cc.Invoke(ctx, EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName, in, out, opts...)
@@ -119,6 +191,24 @@ func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnc
 	return out, nil
 }
 
+func (c *engineServiceClient) ShareEnclave(ctx context.Context, in *ShareEnclaveArgs, opts ...grpc.CallOption) (*ShareEnclaveResponse, error) {
+	out := new(ShareEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_ShareEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetApiContainerTlsCredentials(ctx context.Context, in *GetApiContainerTlsCredentialsArgs, opts ...grpc.CallOption) (*ApiContainerTlsCredentials, error) {
+	out := new(ApiContainerTlsCredentials)
+	err := c.cc.Invoke(ctx, EngineService_GetApiContainerTlsCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error) {
 	out := new(CleanResponse)
 	err := c.cc.Invoke(ctx, EngineService_Clean_FullMethodName, in, out, opts...)
@@ -160,12 +250,31 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) GetServiceResourceUsage(ctx context.Context, in *GetServiceResourceUsageArgs, opts ...grpc.CallOption) (*GetServiceResourceUsageResponse, error) {
+	out := new(GetServiceResourceUsageResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetServiceResourceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
 type EngineServiceServer interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
 	GetEngineInfo(context.Context, *emptypb.Empty) (*GetEngineInfoResponse, error)
+	// Tells whether the engine API matches the one a client (CLI or SDK) was built for, so that version skew is reported
+	// clearly before other calls fail in confusing ways
+	NegotiateApiVersion(context.Context, *NegotiateApiVersionArgs) (*NegotiateApiVersionResponse, error)
+	// Applies a new engine config, e.g. new auth tokens, to every engine replica without restarting them; only admins can do it
+	UpdateEngineConfig(context.Context, *UpdateEngineConfigArgs) (*emptypb.Empty, error)
+	// Returns the mutating calls made to the engine APIs, who made them and when; only admins can do it
+	GetAuditLog(context.Context, *GetAuditLogArgs) (*GetAuditLogResponse, error)
+	// Returns the health of the subsystems the engine relies on (backend, logs aggregator and collectors, state store and
+	// artifacts store), so that e.g. missing logs are noticed before they are needed
+	GetEngineHealth(context.Context, *emptypb.Empty) (*GetEngineHealthResponse, error)
 	// ==============================================================================================
 	//
 	//	Enclave Management
@@ -175,16 +284,25 @@ type EngineServiceServer interface {
 	CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error)
 	// Returns information about the existing enclaves
 	GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error)
+	// Returns a page of the existing enclaves, the oldest first, optionally only the ones in some statuses or of an owner
+	ListEnclaves(context.Context, *ListEnclavesArgs) (*ListEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
+	// Grants or revokes access to an enclave; only the owner of the enclave and admins can do it
+	ShareEnclave(context.Context, *ShareEnclaveArgs) (*ShareEnclaveResponse, error)
+	// Issues the caller a short-lived certificate to call the API container of an enclave requiring mutual TLS with;
+	// only the callers that can change the enclave get one
+	GetApiContainerTlsCredentials(context.Context, *GetApiContainerTlsCredentialsArgs) (*ApiContainerTlsCredentials, error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// Returns the CPU, memory and network usage the engine sampled lately for each running service of an enclave
+	GetServiceResourceUsage(context.Context, *GetServiceResourceUsageArgs) (*GetServiceResourceUsageResponse, error)
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -194,12 +312,27 @@ type UnimplementedEngineServiceServer struct {
 func (UnimplementedEngineServiceServer) GetEngineInfo(context.Context, *emptypb.Empty) (*GetEngineInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEngineInfo not implemented")
 }
+func (UnimplementedEngineServiceServer) NegotiateApiVersion(context.Context, *NegotiateApiVersionArgs) (*NegotiateApiVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NegotiateApiVersion not implemented")
+}
+func (UnimplementedEngineServiceServer) UpdateEngineConfig(context.Context, *UpdateEngineConfigArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEngineConfig not implemented")
+}
+func (UnimplementedEngineServiceServer) GetAuditLog(context.Context, *GetAuditLogArgs) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedEngineServiceServer) GetEngineHealth(context.Context, *emptypb.Empty) (*GetEngineHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEngineHealth not implemented")
+}
 func (UnimplementedEngineServiceServer) CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEnclave not implemented")
 }
 func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaves not implemented")
 }
+func (UnimplementedEngineServiceServer) ListEnclaves(context.Context, *ListEnclavesArgs) (*ListEnclavesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnclaves not implemented")
+}
 func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExistingAndHistoricalEnclaveIdentifiers not implemented")
 }
@@ -209,12 +342,21 @@ func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclav
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) ShareEnclave(context.Context, *ShareEnclaveArgs) (*ShareEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) GetApiContainerTlsCredentials(context.Context, *GetApiContainerTlsCredentialsArgs) (*ApiContainerTlsCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiContainerTlsCredentials not implemented")
+}
 func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*CleanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clean not implemented")
 }
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) GetServiceResourceUsage(context.Context, *GetServiceResourceUsageArgs) (*GetServiceResourceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceResourceUsage not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -245,6 +387,78 @@ func _EngineService_GetEngineInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_NegotiateApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NegotiateApiVersionArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).NegotiateApiVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_NegotiateApiVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).NegotiateApiVersion(ctx, req.(*NegotiateApiVersionArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_UpdateEngineConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEngineConfigArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).UpdateEngineConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_UpdateEngineConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).UpdateEngineConfig(ctx, req.(*UpdateEngineConfigArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetAuditLog(ctx, req.(*GetAuditLogArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetEngineHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetEngineHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetEngineHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetEngineHealth(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_CreateEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEnclaveArgs)
 	if err := dec(in); err != nil {
@@ -281,6 +495,24 @@ func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_ListEnclaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnclavesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ListEnclaves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ListEnclaves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ListEnclaves(ctx, req.(*ListEnclavesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_GetExistingAndHistoricalEnclaveIdentifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -335,6 +567,42 @@ func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_ShareEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ShareEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ShareEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ShareEnclave(ctx, req.(*ShareEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetApiContainerTlsCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiContainerTlsCredentialsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetApiContainerTlsCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetApiContainerTlsCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetApiContainerTlsCredentials(ctx, req.(*GetApiContainerTlsCredentialsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_Clean_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CleanArgs)
 	if err := dec(in); err != nil {
@@ -374,6 +642,24 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_GetServiceResourceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceResourceUsageArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetServiceResourceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetServiceResourceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetServiceResourceUsage(ctx, req.(*GetServiceResourceUsageArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -385,6 +671,22 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEngineInfo",
 			Handler:    _EngineService_GetEngineInfo_Handler,
 		},
+		{
+			MethodName: "NegotiateApiVersion",
+			Handler:    _EngineService_NegotiateApiVersion_Handler,
+		},
+		{
+			MethodName: "UpdateEngineConfig",
+			Handler:    _EngineService_UpdateEngineConfig_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _EngineService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "GetEngineHealth",
+			Handler:    _EngineService_GetEngineHealth_Handler,
+		},
 		{
 			MethodName: "CreateEnclave",
 			Handler:    _EngineService_CreateEnclave_Handler,
@@ -393,6 +695,10 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEnclaves",
 			Handler:    _EngineService_GetEnclaves_Handler,
 		},
+		{
+			MethodName: "ListEnclaves",
+			Handler:    _EngineService_ListEnclaves_Handler,
+		},
 		{
 			MethodName: "GetExistingAndHistoricalEnclaveIdentifiers",
 			Handler:    _EngineService_GetExistingAndHistoricalEnclaveIdentifiers_Handler,
@@ -405,10 +711,22 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyEnclave",
 			Handler:    _EngineService_DestroyEnclave_Handler,
 		},
+		{
+			MethodName: "ShareEnclave",
+			Handler:    _EngineService_ShareEnclave_Handler,
+		},
+		{
+			MethodName: "GetApiContainerTlsCredentials",
+			Handler:    _EngineService_GetApiContainerTlsCredentials_Handler,
+		},
 		{
 			MethodName: "Clean",
 			Handler:    _EngineService_Clean_Handler,
 		},
+		{
+			MethodName: "GetServiceResourceUsage",
+			Handler:    _EngineService_GetServiceResourceUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
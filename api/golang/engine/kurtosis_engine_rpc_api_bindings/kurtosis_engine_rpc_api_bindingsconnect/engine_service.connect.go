@@ -37,12 +37,27 @@ const (
 	// EngineServiceGetEngineInfoProcedure is the fully-qualified name of the EngineService's
 	// GetEngineInfo RPC.
 	EngineServiceGetEngineInfoProcedure = "/engine_api.EngineService/GetEngineInfo"
+	// EngineServiceNegotiateApiVersionProcedure is the fully-qualified name of the EngineService's
+	// NegotiateApiVersion RPC.
+	EngineServiceNegotiateApiVersionProcedure = "/engine_api.EngineService/NegotiateApiVersion"
+	// EngineServiceUpdateEngineConfigProcedure is the fully-qualified name of the EngineService's
+	// UpdateEngineConfig RPC.
+	EngineServiceUpdateEngineConfigProcedure = "/engine_api.EngineService/UpdateEngineConfig"
+	// EngineServiceGetAuditLogProcedure is the fully-qualified name of the EngineService's GetAuditLog
+	// RPC.
+	EngineServiceGetAuditLogProcedure = "/engine_api.EngineService/GetAuditLog"
+	// EngineServiceGetEngineHealthProcedure is the fully-qualified name of the EngineService's
+	// GetEngineHealth RPC.
+	EngineServiceGetEngineHealthProcedure = "/engine_api.EngineService/GetEngineHealth"
 	// EngineServiceCreateEnclaveProcedure is the fully-qualified name of the EngineService's
 	// CreateEnclave RPC.
 	EngineServiceCreateEnclaveProcedure = "/engine_api.EngineService/CreateEnclave"
 	// EngineServiceGetEnclavesProcedure is the fully-qualified name of the EngineService's GetEnclaves
 	// RPC.
 	EngineServiceGetEnclavesProcedure = "/engine_api.EngineService/GetEnclaves"
+	// EngineServiceListEnclavesProcedure is the fully-qualified name of the EngineService's
+	// ListEnclaves RPC.
+	EngineServiceListEnclavesProcedure = "/engine_api.EngineService/ListEnclaves"
 	// EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure is the fully-qualified name of
 	// the EngineService's GetExistingAndHistoricalEnclaveIdentifiers RPC.
 	EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
@@ -52,17 +67,36 @@ const (
 	// EngineServiceDestroyEnclaveProcedure is the fully-qualified name of the EngineService's
 	// DestroyEnclave RPC.
 	EngineServiceDestroyEnclaveProcedure = "/engine_api.EngineService/DestroyEnclave"
+	// EngineServiceShareEnclaveProcedure is the fully-qualified name of the EngineService's
+	// ShareEnclave RPC.
+	EngineServiceShareEnclaveProcedure = "/engine_api.EngineService/ShareEnclave"
+	// EngineServiceGetApiContainerTlsCredentialsProcedure is the fully-qualified name of the
+	// EngineService's GetApiContainerTlsCredentials RPC.
+	EngineServiceGetApiContainerTlsCredentialsProcedure = "/engine_api.EngineService/GetApiContainerTlsCredentials"
 	// EngineServiceCleanProcedure is the fully-qualified name of the EngineService's Clean RPC.
 	EngineServiceCleanProcedure = "/engine_api.EngineService/Clean"
 	// EngineServiceGetServiceLogsProcedure is the fully-qualified name of the EngineService's
 	// GetServiceLogs RPC.
 	EngineServiceGetServiceLogsProcedure = "/engine_api.EngineService/GetServiceLogs"
+	// EngineServiceGetServiceResourceUsageProcedure is the fully-qualified name of the EngineService's
+	// GetServiceResourceUsage RPC.
+	EngineServiceGetServiceResourceUsageProcedure = "/engine_api.EngineService/GetServiceResourceUsage"
 )
 
 // EngineServiceClient is a client for the engine_api.EngineService service.
 type EngineServiceClient interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
 	GetEngineInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse], error)
+	// Tells whether the engine API matches the one a client (CLI or SDK) was built for, so that version skew is reported
+	// clearly before other calls fail in confusing ways
+	NegotiateApiVersion(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse], error)
+	// Applies a new engine config, e.g. new auth tokens, to every engine replica without restarting them; only admins can do it
+	UpdateEngineConfig(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs]) (*connect.Response[emptypb.Empty], error)
+	// Returns the mutating calls made to the engine APIs, who made them and when; only admins can do it
+	GetAuditLog(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error)
+	// Returns the health of the subsystems the engine relies on (backend, logs aggregator and collectors, state store and
+	// artifacts store), so that e.g. missing logs are noticed before they are needed
+	GetEngineHealth(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse], error)
 	// ==============================================================================================
 	//
 	//	Enclave Management
@@ -72,16 +106,25 @@ type EngineServiceClient interface {
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
 	// Returns information about the existing enclaves
 	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	// Returns a page of the existing enclaves, the oldest first, optionally only the ones in some statuses or of an owner
+	ListEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ListEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ListEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Grants or revokes access to an enclave; only the owner of the enclave and admins can do it
+	ShareEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse], error)
+	// Issues the caller a short-lived certificate to call the API container of an enclave requiring mutual TLS with;
+	// only the callers that can change the enclave get one
+	GetApiContainerTlsCredentials(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse], error)
+	// Returns the CPU, memory and network usage the engine sampled lately for each running service of an enclave
+	GetServiceResourceUsage(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error)
 }
 
 // NewEngineServiceClient constructs a client for the engine_api.EngineService service. By default,
@@ -99,6 +142,26 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetEngineInfoProcedure,
 			opts...,
 		),
+		negotiateApiVersion: connect.NewClient[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs, kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse](
+			httpClient,
+			baseURL+EngineServiceNegotiateApiVersionProcedure,
+			opts...,
+		),
+		updateEngineConfig: connect.NewClient[kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs, emptypb.Empty](
+			httpClient,
+			baseURL+EngineServiceUpdateEngineConfigProcedure,
+			opts...,
+		),
+		getAuditLog: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs, kurtosis_engine_rpc_api_bindings.GetAuditLogResponse](
+			httpClient,
+			baseURL+EngineServiceGetAuditLogProcedure,
+			opts...,
+		),
+		getEngineHealth: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse](
+			httpClient,
+			baseURL+EngineServiceGetEngineHealthProcedure,
+			opts...,
+		),
 		createEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs, kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse](
 			httpClient,
 			baseURL+EngineServiceCreateEnclaveProcedure,
@@ -109,6 +172,11 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetEnclavesProcedure,
 			opts...,
 		),
+		listEnclaves: connect.NewClient[kurtosis_engine_rpc_api_bindings.ListEnclavesArgs, kurtosis_engine_rpc_api_bindings.ListEnclavesResponse](
+			httpClient,
+			baseURL+EngineServiceListEnclavesProcedure,
+			opts...,
+		),
 		getExistingAndHistoricalEnclaveIdentifiers: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse](
 			httpClient,
 			baseURL+EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure,
@@ -124,6 +192,16 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceDestroyEnclaveProcedure,
 			opts...,
 		),
+		shareEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs, kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceShareEnclaveProcedure,
+			opts...,
+		),
+		getApiContainerTlsCredentials: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs, kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials](
+			httpClient,
+			baseURL+EngineServiceGetApiContainerTlsCredentialsProcedure,
+			opts...,
+		),
 		clean: connect.NewClient[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse](
 			httpClient,
 			baseURL+EngineServiceCleanProcedure,
@@ -134,19 +212,32 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetServiceLogsProcedure,
 			opts...,
 		),
+		getServiceResourceUsage: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs, kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse](
+			httpClient,
+			baseURL+EngineServiceGetServiceResourceUsageProcedure,
+			opts...,
+		),
 	}
 }
 
 // engineServiceClient implements EngineServiceClient.
 type engineServiceClient struct {
 	getEngineInfo                              *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse]
+	negotiateApiVersion                        *connect.Client[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs, kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse]
+	updateEngineConfig                         *connect.Client[kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs, emptypb.Empty]
+	getAuditLog                                *connect.Client[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs, kurtosis_engine_rpc_api_bindings.GetAuditLogResponse]
+	getEngineHealth                            *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse]
 	createEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs, kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse]
 	getEnclaves                                *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
+	listEnclaves                               *connect.Client[kurtosis_engine_rpc_api_bindings.ListEnclavesArgs, kurtosis_engine_rpc_api_bindings.ListEnclavesResponse]
 	getExistingAndHistoricalEnclaveIdentifiers *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse]
 	stopEnclave                                *connect.Client[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs, emptypb.Empty]
 	destroyEnclave                             *connect.Client[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty]
+	shareEnclave                               *connect.Client[kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs, kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse]
+	getApiContainerTlsCredentials              *connect.Client[kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs, kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials]
 	clean                                      *connect.Client[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse]
 	getServiceLogs                             *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]
+	getServiceResourceUsage                    *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs, kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse]
 }
 
 // GetEngineInfo calls engine_api.EngineService.GetEngineInfo.
@@ -154,6 +245,26 @@ func (c *engineServiceClient) GetEngineInfo(ctx context.Context, req *connect.Re
 	return c.getEngineInfo.CallUnary(ctx, req)
 }
 
+// NegotiateApiVersion calls engine_api.EngineService.NegotiateApiVersion.
+func (c *engineServiceClient) NegotiateApiVersion(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse], error) {
+	return c.negotiateApiVersion.CallUnary(ctx, req)
+}
+
+// UpdateEngineConfig calls engine_api.EngineService.UpdateEngineConfig.
+func (c *engineServiceClient) UpdateEngineConfig(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.updateEngineConfig.CallUnary(ctx, req)
+}
+
+// GetAuditLog calls engine_api.EngineService.GetAuditLog.
+func (c *engineServiceClient) GetAuditLog(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error) {
+	return c.getAuditLog.CallUnary(ctx, req)
+}
+
+// GetEngineHealth calls engine_api.EngineService.GetEngineHealth.
+func (c *engineServiceClient) GetEngineHealth(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse], error) {
+	return c.getEngineHealth.CallUnary(ctx, req)
+}
+
 // CreateEnclave calls engine_api.EngineService.CreateEnclave.
 func (c *engineServiceClient) CreateEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error) {
 	return c.createEnclave.CallUnary(ctx, req)
@@ -164,6 +275,11 @@ func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Requ
 	return c.getEnclaves.CallUnary(ctx, req)
 }
 
+// ListEnclaves calls engine_api.EngineService.ListEnclaves.
+func (c *engineServiceClient) ListEnclaves(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.ListEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ListEnclavesResponse], error) {
+	return c.listEnclaves.CallUnary(ctx, req)
+}
+
 // GetExistingAndHistoricalEnclaveIdentifiers calls
 // engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers.
 func (c *engineServiceClient) GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error) {
@@ -180,6 +296,16 @@ func (c *engineServiceClient) DestroyEnclave(ctx context.Context, req *connect.R
 	return c.destroyEnclave.CallUnary(ctx, req)
 }
 
+// ShareEnclave calls engine_api.EngineService.ShareEnclave.
+func (c *engineServiceClient) ShareEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse], error) {
+	return c.shareEnclave.CallUnary(ctx, req)
+}
+
+// GetApiContainerTlsCredentials calls engine_api.EngineService.GetApiContainerTlsCredentials.
+func (c *engineServiceClient) GetApiContainerTlsCredentials(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials], error) {
+	return c.getApiContainerTlsCredentials.CallUnary(ctx, req)
+}
+
 // Clean calls engine_api.EngineService.Clean.
 func (c *engineServiceClient) Clean(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	return c.clean.CallUnary(ctx, req)
@@ -190,10 +316,25 @@ func (c *engineServiceClient) GetServiceLogs(ctx context.Context, req *connect.R
 	return c.getServiceLogs.CallServerStream(ctx, req)
 }
 
+// GetServiceResourceUsage calls engine_api.EngineService.GetServiceResourceUsage.
+func (c *engineServiceClient) GetServiceResourceUsage(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error) {
+	return c.getServiceResourceUsage.CallUnary(ctx, req)
+}
+
 // EngineServiceHandler is an implementation of the engine_api.EngineService service.
 type EngineServiceHandler interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
 	GetEngineInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse], error)
+	// Tells whether the engine API matches the one a client (CLI or SDK) was built for, so that version skew is reported
+	// clearly before other calls fail in confusing ways
+	NegotiateApiVersion(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse], error)
+	// Applies a new engine config, e.g. new auth tokens, to every engine replica without restarting them; only admins can do it
+	UpdateEngineConfig(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs]) (*connect.Response[emptypb.Empty], error)
+	// Returns the mutating calls made to the engine APIs, who made them and when; only admins can do it
+	GetAuditLog(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error)
+	// Returns the health of the subsystems the engine relies on (backend, logs aggregator and collectors, state store and
+	// artifacts store), so that e.g. missing logs are noticed before they are needed
+	GetEngineHealth(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse], error)
 	// ==============================================================================================
 	//
 	//	Enclave Management
@@ -203,16 +344,25 @@ type EngineServiceHandler interface {
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
 	// Returns information about the existing enclaves
 	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	// Returns a page of the existing enclaves, the oldest first, optionally only the ones in some statuses or of an owner
+	ListEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ListEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ListEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Grants or revokes access to an enclave; only the owner of the enclave and admins can do it
+	ShareEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse], error)
+	// Issues the caller a short-lived certificate to call the API container of an enclave requiring mutual TLS with;
+	// only the callers that can change the enclave get one
+	GetApiContainerTlsCredentials(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error
+	// Returns the CPU, memory and network usage the engine sampled lately for each running service of an enclave
+	GetServiceResourceUsage(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error)
 }
 
 // NewEngineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -226,6 +376,26 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetEngineInfo,
 		opts...,
 	)
+	engineServiceNegotiateApiVersionHandler := connect.NewUnaryHandler(
+		EngineServiceNegotiateApiVersionProcedure,
+		svc.NegotiateApiVersion,
+		opts...,
+	)
+	engineServiceUpdateEngineConfigHandler := connect.NewUnaryHandler(
+		EngineServiceUpdateEngineConfigProcedure,
+		svc.UpdateEngineConfig,
+		opts...,
+	)
+	engineServiceGetAuditLogHandler := connect.NewUnaryHandler(
+		EngineServiceGetAuditLogProcedure,
+		svc.GetAuditLog,
+		opts...,
+	)
+	engineServiceGetEngineHealthHandler := connect.NewUnaryHandler(
+		EngineServiceGetEngineHealthProcedure,
+		svc.GetEngineHealth,
+		opts...,
+	)
 	engineServiceCreateEnclaveHandler := connect.NewUnaryHandler(
 		EngineServiceCreateEnclaveProcedure,
 		svc.CreateEnclave,
@@ -236,6 +406,11 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetEnclaves,
 		opts...,
 	)
+	engineServiceListEnclavesHandler := connect.NewUnaryHandler(
+		EngineServiceListEnclavesProcedure,
+		svc.ListEnclaves,
+		opts...,
+	)
 	engineServiceGetExistingAndHistoricalEnclaveIdentifiersHandler := connect.NewUnaryHandler(
 		EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure,
 		svc.GetExistingAndHistoricalEnclaveIdentifiers,
@@ -251,6 +426,16 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.DestroyEnclave,
 		opts...,
 	)
+	engineServiceShareEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceShareEnclaveProcedure,
+		svc.ShareEnclave,
+		opts...,
+	)
+	engineServiceGetApiContainerTlsCredentialsHandler := connect.NewUnaryHandler(
+		EngineServiceGetApiContainerTlsCredentialsProcedure,
+		svc.GetApiContainerTlsCredentials,
+		opts...,
+	)
 	engineServiceCleanHandler := connect.NewUnaryHandler(
 		EngineServiceCleanProcedure,
 		svc.Clean,
@@ -261,24 +446,45 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetServiceLogs,
 		opts...,
 	)
+	engineServiceGetServiceResourceUsageHandler := connect.NewUnaryHandler(
+		EngineServiceGetServiceResourceUsageProcedure,
+		svc.GetServiceResourceUsage,
+		opts...,
+	)
 	return "/engine_api.EngineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EngineServiceGetEngineInfoProcedure:
 			engineServiceGetEngineInfoHandler.ServeHTTP(w, r)
+		case EngineServiceNegotiateApiVersionProcedure:
+			engineServiceNegotiateApiVersionHandler.ServeHTTP(w, r)
+		case EngineServiceUpdateEngineConfigProcedure:
+			engineServiceUpdateEngineConfigHandler.ServeHTTP(w, r)
+		case EngineServiceGetAuditLogProcedure:
+			engineServiceGetAuditLogHandler.ServeHTTP(w, r)
+		case EngineServiceGetEngineHealthProcedure:
+			engineServiceGetEngineHealthHandler.ServeHTTP(w, r)
 		case EngineServiceCreateEnclaveProcedure:
 			engineServiceCreateEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceGetEnclavesProcedure:
 			engineServiceGetEnclavesHandler.ServeHTTP(w, r)
+		case EngineServiceListEnclavesProcedure:
+			engineServiceListEnclavesHandler.ServeHTTP(w, r)
 		case EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure:
 			engineServiceGetExistingAndHistoricalEnclaveIdentifiersHandler.ServeHTTP(w, r)
 		case EngineServiceStopEnclaveProcedure:
 			engineServiceStopEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceDestroyEnclaveProcedure:
 			engineServiceDestroyEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceShareEnclaveProcedure:
+			engineServiceShareEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceGetApiContainerTlsCredentialsProcedure:
+			engineServiceGetApiContainerTlsCredentialsHandler.ServeHTTP(w, r)
 		case EngineServiceCleanProcedure:
 			engineServiceCleanHandler.ServeHTTP(w, r)
 		case EngineServiceGetServiceLogsProcedure:
 			engineServiceGetServiceLogsHandler.ServeHTTP(w, r)
+		case EngineServiceGetServiceResourceUsageProcedure:
+			engineServiceGetServiceResourceUsageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -292,6 +498,22 @@ func (UnimplementedEngineServiceHandler) GetEngineInfo(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEngineInfo is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) NegotiateApiVersion(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.NegotiateApiVersion is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) UpdateEngineConfig(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.UpdateEngineConfig is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) GetAuditLog(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetAuditLog is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) GetEngineHealth(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEngineHealth is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.CreateEnclave is not implemented"))
 }
@@ -300,6 +522,10 @@ func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEnclaves is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) ListEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ListEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ListEnclavesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ListEnclaves is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers is not implemented"))
 }
@@ -312,6 +538,14 @@ func (UnimplementedEngineServiceHandler) DestroyEnclave(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.DestroyEnclave is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) ShareEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ShareEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) GetApiContainerTlsCredentials(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetApiContainerTlsCredentials is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.Clean is not implemented"))
 }
@@ -319,3 +553,7 @@ func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request
 func (UnimplementedEngineServiceHandler) GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetServiceLogs is not implemented"))
 }
+
+func (UnimplementedEngineServiceHandler) GetServiceResourceUsage(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetServiceResourceUsage is not implemented"))
+}
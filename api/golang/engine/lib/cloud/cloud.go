@@ -3,6 +3,7 @@ package cloud
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"github.com/kurtosis-tech/kurtosis/api/golang/fips_mode"
 	api "github.com/kurtosis-tech/kurtosis/cloud/api/golang/kurtosis_backend_server_rpc_api_bindings"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
@@ -46,6 +47,9 @@ func CreateCloudClient(connectionStr string, caCertChain string) (api.KurtosisCl
 		EncryptedClientHelloConfigList:      nil,
 		EncryptedClientHelloRejectionVerify: nil,
 	}
+	if fips_mode.IsEnabled() {
+		fips_mode.RestrictTlsConfig(tlsConfig)
+	}
 	conn, err := grpc.Dial(connectionStr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -0,0 +1,112 @@
+package kurtosis_context
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/api_container_tls"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// The engine issues the callers short-lived certificates, so they're renewed this long before they expire to not
+	// have the connections established with the certificate rejected
+	clientCertificateRenewalMargin = 10 * time.Minute
+)
+
+// GetApiContainerTransportCredentials returns the credentials the API container of the enclave is called with: the
+// client certificates the engine issues to the caller if the API container requires mutual TLS, else none. It fails
+// rather than calling an API container requiring mutual TLS without a certificate.
+func GetApiContainerTransportCredentials(
+	ctx context.Context,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+) (credentials.TransportCredentials, error) {
+	if !enclaveInfo.GetIsApiContainerMtlsRequired() {
+		return insecure.NewCredentials(), nil
+	}
+	issuer := &apiContainerClientCertificateIssuer{
+		engineClient:      engineClient,
+		enclaveUuid:       enclaveInfo.GetEnclaveUuid(),
+		mutex:             &sync.Mutex{},
+		clientCertificate: nil,
+	}
+	tlsCredentials, err := issuer.issue(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a client certificate for the API container of enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	transportCredentials, err := api_container_tls.NewRenewingClientTransportCredentials(
+		tlsCredentials.GetCertificateAuthority(),
+		issuer.getClientCertificate,
+		tlsCredentials.GetFipsMode(),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the TLS credentials of the API container of enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	return transportCredentials, nil
+}
+
+// GetApiContainerTransportCredentials is GetApiContainerTransportCredentials with the engine of this context
+func (kurtosisCtx *KurtosisContext) GetApiContainerTransportCredentials(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (credentials.TransportCredentials, error) {
+	return GetApiContainerTransportCredentials(ctx, kurtosisCtx.engineClient, enclaveInfo)
+}
+
+// apiContainerClientCertificateIssuer gets the client certificates of an API container from the engine, getting a new
+// one when the current one is about to expire
+type apiContainerClientCertificateIssuer struct {
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient
+
+	enclaveUuid string
+
+	mutex *sync.Mutex
+
+	clientCertificate *tls.Certificate
+}
+
+func (issuer *apiContainerClientCertificateIssuer) getClientCertificate(requestInfo *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	issuer.mutex.Lock()
+	defer issuer.mutex.Unlock()
+
+	if issuer.clientCertificate != nil && time.Now().Add(clientCertificateRenewalMargin).Before(issuer.clientCertificate.Leaf.NotAfter) {
+		return issuer.clientCertificate, nil
+	}
+	if _, err := issuer.issueLocked(requestInfo.Context()); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred renewing the client certificate for the API container of enclave '%v'", issuer.enclaveUuid)
+	}
+	return issuer.clientCertificate, nil
+}
+
+func (issuer *apiContainerClientCertificateIssuer) issue(ctx context.Context) (*kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials, error) {
+	issuer.mutex.Lock()
+	defer issuer.mutex.Unlock()
+	return issuer.issueLocked(ctx)
+}
+
+func (issuer *apiContainerClientCertificateIssuer) issueLocked(ctx context.Context) (*kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials, error) {
+	args := &kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs{
+		EnclaveIdentifier: issuer.enclaveUuid,
+	}
+	tlsCredentials, err := issuer.engineClient.GetApiContainerTlsCredentials(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the TLS credentials of the API container from the engine")
+	}
+	clientCertificate, err := tls.X509KeyPair([]byte(tlsCredentials.GetClientCertificate()), []byte(tlsCredentials.GetClientKey()))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the client certificate the engine issued")
+	}
+	if clientCertificate.Leaf == nil {
+		leaf, err := x509.ParseCertificate(clientCertificate.Certificate[0])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the client certificate the engine issued")
+		}
+		clientCertificate.Leaf = leaf
+	}
+	issuer.clientCertificate = &clientCertificate
+	return tlsCredentials, nil
+}
@@ -0,0 +1,141 @@
+package kurtosis_context
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const (
+	testEnclaveUuid = "enclave-uuid"
+)
+
+type fakeEngineServiceClient struct {
+	kurtosis_engine_rpc_api_bindings.EngineServiceClient
+
+	certificateValidities []time.Duration
+
+	err error
+
+	calls int
+}
+
+func (client *fakeEngineServiceClient) GetApiContainerTlsCredentials(_ context.Context, args *kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs, _ ...grpc.CallOption) (*kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials, error) {
+	if client.err != nil {
+		return nil, client.err
+	}
+	if args.GetEnclaveIdentifier() != testEnclaveUuid {
+		return nil, errors.New("unexpected enclave")
+	}
+	validity := client.certificateValidities[client.calls]
+	client.calls++
+	certificatePem, keyPem := newTestCertificate(validity)
+	return &kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials{
+		CertificateAuthority: certificatePem,
+		ClientCertificate:    certificatePem,
+		ClientKey:            keyPem,
+		FipsMode:             false,
+	}, nil
+}
+
+func TestGetApiContainerTransportCredentials_InsecureIfMutualTlsNotRequired(t *testing.T) {
+	engineClient := &fakeEngineServiceClient{err: errors.New("should not be called")}
+	enclaveInfo := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		EnclaveUuid:                testEnclaveUuid,
+		IsApiContainerMtlsRequired: false,
+	}
+
+	transportCredentials, err := GetApiContainerTransportCredentials(context.Background(), engineClient, enclaveInfo)
+	require.NoError(t, err)
+	require.Equal(t, "insecure", transportCredentials.Info().SecurityProtocol)
+	require.Zero(t, engineClient.calls)
+}
+
+func TestGetApiContainerTransportCredentials_FailsIfEngineDoesNotIssueCertificate(t *testing.T) {
+	engineClient := &fakeEngineServiceClient{err: errors.New("permission denied")}
+	enclaveInfo := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		EnclaveUuid:                testEnclaveUuid,
+		IsApiContainerMtlsRequired: true,
+	}
+
+	_, err := GetApiContainerTransportCredentials(context.Background(), engineClient, enclaveInfo)
+	require.Error(t, err)
+}
+
+func TestGetApiContainerTransportCredentials_RenewsExpiringCertificate(t *testing.T) {
+	engineClient := &fakeEngineServiceClient{
+		certificateValidities: []time.Duration{time.Hour, clientCertificateRenewalMargin / 2, time.Hour},
+	}
+	enclaveInfo := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		EnclaveUuid:                testEnclaveUuid,
+		IsApiContainerMtlsRequired: true,
+	}
+
+	transportCredentials, err := GetApiContainerTransportCredentials(context.Background(), engineClient, enclaveInfo)
+	require.NoError(t, err)
+	require.Equal(t, "tls", transportCredentials.Info().SecurityProtocol)
+	require.Equal(t, 1, engineClient.calls)
+
+	issuer := &apiContainerClientCertificateIssuer{
+		engineClient:      engineClient,
+		enclaveUuid:       testEnclaveUuid,
+		mutex:             &sync.Mutex{},
+		clientCertificate: nil,
+	}
+	_, err = issuer.issue(context.Background())
+	require.NoError(t, err)
+	expiringCertificate := issuer.clientCertificate
+
+	renewedCertificate, err := issuer.getClientCertificate(&tls.CertificateRequestInfo{})
+	require.NoError(t, err)
+	require.NotSame(t, expiringCertificate, renewedCertificate)
+	require.Equal(t, 3, engineClient.calls)
+
+	sameCertificate, err := issuer.getClientCertificate(&tls.CertificateRequestInfo{})
+	require.NoError(t, err)
+	require.Same(t, renewedCertificate, sameCertificate)
+	require.Equal(t, 3, engineClient.calls)
+}
+
+func newTestCertificate(validity time.Duration) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(now.UnixNano()),
+		Subject:               pkix.Name{CommonName: "kurtosis-test"},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certificateDer, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDer})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certificatePem), string(keyPem)
+}
@@ -0,0 +1,36 @@
+package kurtosis_context
+
+import (
+	"context"
+	"os"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/grpc_compression"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	// GrpcCompressionEnvVar holds the compression ('gzip' or 'zstd') of the streaming calls to the engine and the API
+	// containers, e.g. the service logs and the files artifacts, which saves bandwidth when they're reached over a slow link
+	GrpcCompressionEnvVar = "KURTOSIS_GRPC_COMPRESSION"
+)
+
+// GetCompressionDialOptions returns the options compressing the streaming gRPC calls with the compression set in the
+// KURTOSIS_GRPC_COMPRESSION environment variable, if any. The servers answer with the compression of the call.
+// Unary calls carry little data so they're left uncompressed
+func GetCompressionDialOptions() []grpc.DialOption {
+	compression, err := grpc_compression.ParseCompression(os.Getenv(GrpcCompressionEnvVar))
+	if err != nil {
+		logrus.Warnf("Ignoring the '%v' environment variable as it's invalid; the streaming calls won't be compressed. Error was:\n%v", GrpcCompressionEnvVar, err)
+		return nil
+	}
+	if compression == grpc_compression.NoCompression {
+		return nil
+	}
+	compressionStr := string(compression)
+	return []grpc.DialOption{
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(ctx, desc, conn, method, append(opts, grpc.UseCompressor(compressionStr))...)
+		}),
+	}
+}
@@ -0,0 +1,45 @@
+package kurtosis_context
+
+import (
+	"context"
+	"os"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// EngineTokenEnvVar holds the token sent to engines that require authentication
+	EngineTokenEnvVar = "KURTOSIS_ENGINE_TOKEN"
+
+	authorizationMetadataKey = "authorization"
+	bearerTokenPrefix        = "Bearer "
+
+	// The engine is reached over plaintext HTTP/2, either locally or through a port forward
+	doesEngineTokenRequireTransportSecurity = false
+)
+
+// GetEngineTokenDialOptions returns the options making the gRPC calls to the engine carry the token set in the
+// KURTOSIS_ENGINE_TOKEN environment variable, if any
+func GetEngineTokenDialOptions() []grpc.DialOption {
+	token := os.Getenv(EngineTokenEnvVar)
+	if token == "" {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithPerRPCCredentials(engineTokenCredentials{token: token}),
+	}
+}
+
+type engineTokenCredentials struct {
+	token string
+}
+
+func (credentials engineTokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationMetadataKey: bearerTokenPrefix + credentials.token,
+	}, nil
+}
+
+func (credentials engineTokenCredentials) RequireTransportSecurity() bool {
+	return doesEngineTokenRequireTransportSecurity
+}
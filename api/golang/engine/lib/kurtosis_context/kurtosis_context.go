@@ -10,6 +10,7 @@ import (
 	portal_api "github.com/kurtosis-tech/kurtosis-portal/api/golang/generated"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/kurtosis_errors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/kurtosis_version"
@@ -64,16 +65,33 @@ var (
 type KurtosisContext struct {
 	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient
 	portalClient portal_api.KurtosisPortalClientClient
+
+	// Also used for the API containers of the enclave contexts
+	retryPolicy *RetryPolicy
 }
 
 // NewKurtosisContextFromLocalEngine
-// Attempts to create a KurtosisContext connected to a Kurtosis engine running locally
+// Attempts to create a KurtosisContext connected to a Kurtosis engine running locally, which never retries calls
 func NewKurtosisContextFromLocalEngine() (*KurtosisContext, error) {
+	return NewKurtosisContextFromLocalEngineWithRetryPolicy(NewNoRetryPolicy())
+}
+
+// NewKurtosisContextFromLocalEngineWithRetryPolicy
+// Attempts to create a KurtosisContext connected to a Kurtosis engine running locally, which retries the calls to the
+// engine and the API containers that can't reach them according to the retry policy
+func NewKurtosisContextFromLocalEngineWithRetryPolicy(retryPolicy *RetryPolicy) (*KurtosisContext, error) {
 	ctx := context.Background()
 	kurtosisEngineSocketStr := fmt.Sprintf("%v:%v", localHostIPAddressStr, DefaultGrpcEngineServerPortNum)
 
 	// TODO SECURITY: Use HTTPS to ensure we're connecting to the real Kurtosis API servers
-	conn, err := grpc.Dial(kurtosisEngineSocketStr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	dialOptions := append(
+		[]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes))},
+		GetEngineTokenDialOptions()...,
+	)
+	dialOptions = append(dialOptions, GetTracingDialOptions()...)
+	dialOptions = append(dialOptions, GetCompressionDialOptions()...)
+	dialOptions = append(dialOptions, GetErrorHandlingDialOptions(retryPolicy)...)
+	conn, err := grpc.Dial(kurtosisEngineSocketStr, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -103,6 +121,7 @@ func NewKurtosisContextFromLocalEngine() (*KurtosisContext, error) {
 	kurtosisContext := &KurtosisContext{
 		engineClient: engineServiceClient,
 		portalClient: portalClient,
+		retryPolicy:  retryPolicy,
 	}
 
 	return kurtosisContext, nil
@@ -117,7 +136,7 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(ctx context.Context, enclaveNa
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, kurtosisCtx.retryPolicy, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -137,7 +156,7 @@ func (kurtosisCtx *KurtosisContext) CreateEnclaveWithDebugEnabled(
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, kurtosisCtx.retryPolicy, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -154,7 +173,7 @@ func (kurtosisCtx *KurtosisContext) CreateProductionEnclave(ctx context.Context,
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, kurtosisCtx.retryPolicy, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -171,7 +190,7 @@ func (kurtosisCtx *KurtosisContext) CreateProductionEnclaveWithDebugEnabled(ctx
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, kurtosisCtx.retryPolicy, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -185,7 +204,7 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 		return nil, stacktrace.Propagate(err, "An error occurred while getting enclave with identifier '%v'", enclaveIdentifier)
 	}
 
-	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, enclaveInfo)
+	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, kurtosisCtx.retryPolicy, enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the returned enclave info")
 	}
@@ -218,6 +237,28 @@ func (kurtosisCtx *KurtosisContext) GetEnclaves(ctx context.Context) (*Enclaves,
 	}, nil
 }
 
+// ListEnclaves returns a page of at most pageSize enclaves (all of them if 0), the oldest first, and the token to pass to
+// get the next page, which is empty if this is the last page. Only the enclaves in one of the statuses are returned,
+// unless there's no status
+func (kurtosisCtx *KurtosisContext) ListEnclaves(
+	ctx context.Context,
+	statuses []kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus,
+	pageSize uint32,
+	pageToken string,
+) ([]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, string, error) {
+	args := &kurtosis_engine_rpc_api_bindings.ListEnclavesArgs{
+		Statuses:  statuses,
+		Owner:     nil,
+		PageSize:  &pageSize,
+		PageToken: &pageToken,
+	}
+	response, err := kurtosisCtx.engineClient.ListEnclaves(ctx, args)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred listing enclaves")
+	}
+	return response.GetEnclaveInfos(), response.GetNextPageToken(), nil
+}
+
 func (kurtosisCtx *KurtosisContext) GetEnclave(ctx context.Context, enclaveIdentifier string) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
 	enclaves, err := kurtosisCtx.GetEnclaves(ctx)
 	if err != nil {
@@ -248,7 +289,7 @@ func (kurtosisCtx *KurtosisContext) GetEnclave(ctx context.Context, enclaveIdent
 		}
 	}
 
-	return nil, stacktrace.NewError("Couldn't find an enclave for identifier '%v'", enclaveIdentifier)
+	return nil, stacktrace.Propagate(kurtosis_errors.NewNotFoundError("Couldn't find an enclave for identifier '%v'", enclaveIdentifier), "An error occurred getting enclave for identifier '%v'", enclaveIdentifier)
 }
 
 func (kurtosisCtx *KurtosisContext) StopEnclave(ctx context.Context, enclaveIdentifier string) error {
@@ -399,7 +440,9 @@ func runReceiveStreamLogsFromTheServerRoutine(
 
 func newEnclaveContextFromEnclaveInfo(
 	ctx context.Context,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	portalClient portal_api.KurtosisPortalClientClient,
+	retryPolicy *RetryPolicy,
 	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
 ) (*enclaves.EnclaveContext, error) {
 	// for remote contexts, we need to tunnel the APIC port to the local machine
@@ -447,8 +490,17 @@ func newEnclaveContextFromEnclaveInfo(
 		apiContainerHostMachineInfo.IpOnHostMachine,
 		apiContainerHostMachineInfo.GrpcPortOnHostMachine,
 	)
-	// TODO SECURITY: use HTTPS!
-	apiContainerConn, err := grpc.Dial(apiContainerHostMachineUrl, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	transportCredentials, err := GetApiContainerTransportCredentials(ctx, engineClient, enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the credentials of the API container")
+	}
+	dialOptions := append(
+		[]grpc.DialOption{grpc.WithTransportCredentials(transportCredentials), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes))},
+		GetTracingDialOptions()...,
+	)
+	dialOptions = append(dialOptions, GetCompressionDialOptions()...)
+	dialOptions = append(dialOptions, GetErrorHandlingDialOptions(retryPolicy)...)
+	apiContainerConn, err := grpc.Dial(apiContainerHostMachineUrl, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container on host machine URL '%v'", apiContainerHostMachineUrl)
 	}
@@ -464,14 +516,30 @@ func newEnclaveContextFromEnclaveInfo(
 }
 
 func validateEngineApiVersion(ctx context.Context, engineServiceClient kurtosis_engine_rpc_api_bindings.EngineServiceClient) error {
-	getEngineInfoResponse, err := engineServiceClient.GetEngineInfo(ctx, &emptypb.Empty{})
+	negotiateApiVersionArgs := &kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs{
+		ClientVersion: kurtosis_version.KurtosisVersion,
+	}
+	negotiateApiVersionResponse, err := engineServiceClient.NegotiateApiVersion(ctx, negotiateApiVersionArgs)
 	if err != nil {
-		errorStr := "An error occurred getting engine info"
-		grpcErrorCode := status.Code(err)
-		if grpcErrorCode == codes.Unavailable {
-			errorStr = "The Kurtosis Engine Server is unavailable and is probably not running; you will need to start it using the Kurtosis CLI before you can create a connection to it"
+		if status.Code(err) == codes.Unimplemented {
+			// Engines older than the negotiation leave it to the SDK to compare the versions
+			return validateEngineApiVersionLocally(ctx, engineServiceClient)
 		}
-		return stacktrace.Propagate(err, errorStr)
+		return stacktrace.Propagate(err, getEngineCallErrorStr(err, "An error occurred negotiating the API version with the engine"))
+	}
+	if !negotiateApiVersionResponse.GetIsCompatible() {
+		return stacktrace.NewError("An API version mismatch was detected between the running engine and this Kurtosis SDK library: %v", negotiateApiVersionResponse.GetMessage())
+	}
+	if negotiateApiVersionResponse.GetMessage() != "" {
+		logrus.Warn(negotiateApiVersionResponse.GetMessage())
+	}
+	return nil
+}
+
+func validateEngineApiVersionLocally(ctx context.Context, engineServiceClient kurtosis_engine_rpc_api_bindings.EngineServiceClient) error {
+	getEngineInfoResponse, err := engineServiceClient.GetEngineInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		return stacktrace.Propagate(err, getEngineCallErrorStr(err, "An error occurred getting engine info"))
 	}
 	runningEngineVersionStr := getEngineInfoResponse.GetEngineVersion()
 
@@ -511,6 +579,14 @@ func validateEngineApiVersion(ctx context.Context, engineServiceClient kurtosis_
 	return nil
 }
 
+// getEngineCallErrorStr explains an unavailable engine, which is the most common reason for the first call to fail
+func getEngineCallErrorStr(err error, defaultErrorStr string) string {
+	if status.Code(err) == codes.Unavailable {
+		return "The Kurtosis Engine Server is unavailable and is probably not running; you will need to start it using the Kurtosis CLI before you can create a connection to it"
+	}
+	return defaultErrorStr
+}
+
 func newGetServiceLogsArgs(
 	enclaveIdentifier string,
 	userServiceUUIDs map[services.ServiceUUID]bool,
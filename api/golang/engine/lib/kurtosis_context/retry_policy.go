@@ -0,0 +1,120 @@
+package kurtosis_context
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/kurtosis_errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultRetryMaxAttempts       = 5
+	defaultRetryInitialBackoff    = 500 * time.Millisecond
+	defaultRetryMaxBackoff        = 5 * time.Second
+	defaultRetryBackoffMultiplier = 2.0
+
+	noRetryMaxAttempts = 1
+)
+
+// RetryPolicy tells how the calls to the engine and the API containers are retried when they fail with an
+// UnavailableError, e.g. while the engine restarts. Streaming calls, like getting service logs, aren't retried, as they
+// may have already received messages
+type RetryPolicy struct {
+	// The number of times a call is made at most, the first one included; 1 never retries
+	MaxAttempts uint
+
+	// How long to wait before the first retry
+	InitialBackoff time.Duration
+
+	// How long to wait at most between two attempts
+	MaxBackoff time.Duration
+
+	// How much longer to wait before each retry than before the previous one
+	BackoffMultiplier float64
+}
+
+type retryPolicyOption func(*RetryPolicy)
+
+func NewRetryPolicy(opts ...retryPolicyOption) *RetryPolicy {
+	policy := &RetryPolicy{
+		MaxAttempts:       defaultRetryMaxAttempts,
+		InitialBackoff:    defaultRetryInitialBackoff,
+		MaxBackoff:        defaultRetryMaxBackoff,
+		BackoffMultiplier: defaultRetryBackoffMultiplier,
+	}
+
+	for _, opt := range opts {
+		opt(policy)
+	}
+
+	return policy
+}
+
+// NewNoRetryPolicy returns a policy making every call once, which is what the SDK does by default
+func NewNoRetryPolicy() *RetryPolicy {
+	return NewRetryPolicy(WithMaxAttempts(noRetryMaxAttempts))
+}
+
+func WithMaxAttempts(maxAttempts uint) retryPolicyOption {
+	return func(policy *RetryPolicy) {
+		policy.MaxAttempts = maxAttempts
+	}
+}
+
+func WithInitialBackoff(initialBackoff time.Duration) retryPolicyOption {
+	return func(policy *RetryPolicy) {
+		policy.InitialBackoff = initialBackoff
+	}
+}
+
+func WithMaxBackoff(maxBackoff time.Duration) retryPolicyOption {
+	return func(policy *RetryPolicy) {
+		policy.MaxBackoff = maxBackoff
+	}
+}
+
+func WithBackoffMultiplier(backoffMultiplier float64) retryPolicyOption {
+	return func(policy *RetryPolicy) {
+		policy.BackoffMultiplier = backoffMultiplier
+	}
+}
+
+// GetErrorHandlingDialOptions returns the options making the gRPC calls return typed errors from the kurtosis_errors
+// package, and retrying the unary calls according to the retry policy
+func GetErrorHandlingDialOptions(retryPolicy *RetryPolicy) []grpc.DialOption {
+	return []grpc.DialOption{
+		// Chained, as other options set interceptors too; the retries see the typed errors
+		grpc.WithChainUnaryInterceptor(newRetryingUnaryClientInterceptor(retryPolicy), kurtosis_errors.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(kurtosis_errors.StreamClientInterceptor()),
+	}
+}
+
+func newRetryingUnaryClientInterceptor(retryPolicy *RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, request, reply interface{}, conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		backoff := retryPolicy.InitialBackoff
+		for attempt := uint(1); ; attempt++ {
+			err := invoker(ctx, method, request, reply, conn, opts...)
+			if err == nil || !kurtosis_errors.IsUnavailable(err) || attempt >= retryPolicy.MaxAttempts {
+				return err
+			}
+			logrus.Debugf("Call '%v' failed as its server is unavailable; retrying in %v (attempt %d of %d). Error:\n%v", method, backoff, attempt, retryPolicy.MaxAttempts, err)
+			select {
+			case <-ctx.Done():
+				// The last failure tells more than the context ending
+				return err
+			case <-time.After(backoff):
+			}
+			backoff = getNextBackoff(retryPolicy, backoff)
+		}
+	}
+}
+
+func getNextBackoff(retryPolicy *RetryPolicy, backoff time.Duration) time.Duration {
+	nextBackoff := time.Duration(float64(backoff) * retryPolicy.BackoffMultiplier)
+	if nextBackoff > retryPolicy.MaxBackoff {
+		return retryPolicy.MaxBackoff
+	}
+	return nextBackoff
+}
@@ -0,0 +1,79 @@
+package kurtosis_context
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/kurtosis_errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testMethod  = "/engine_api.EngineService/GetEnclaves"
+	testBackoff = time.Millisecond
+)
+
+func TestRetryingUnaryClientInterceptor_RetriesUnavailableCalls(t *testing.T) {
+	attempts := 0
+	invoker := newFailingInvoker(&attempts, 2, codes.Unavailable)
+	retryPolicy := NewRetryPolicy(WithMaxAttempts(3), WithInitialBackoff(testBackoff))
+
+	err := newRetryingUnaryClientInterceptor(retryPolicy)(context.Background(), testMethod, nil, nil, nil, invoker)
+
+	require.NoError(t, err)
+	require.Equal(t, 3, attempts)
+}
+
+func TestRetryingUnaryClientInterceptor_StopsAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	invoker := newFailingInvoker(&attempts, 5, codes.Unavailable)
+	retryPolicy := NewRetryPolicy(WithMaxAttempts(2), WithInitialBackoff(testBackoff))
+
+	err := newRetryingUnaryClientInterceptor(retryPolicy)(context.Background(), testMethod, nil, nil, nil, invoker)
+
+	require.True(t, kurtosis_errors.IsUnavailable(err))
+	require.Equal(t, 2, attempts)
+}
+
+func TestRetryingUnaryClientInterceptor_DoesntRetryOtherErrors(t *testing.T) {
+	attempts := 0
+	invoker := newFailingInvoker(&attempts, 1, codes.NotFound)
+	retryPolicy := NewRetryPolicy(WithInitialBackoff(testBackoff))
+
+	err := newRetryingUnaryClientInterceptor(retryPolicy)(context.Background(), testMethod, nil, nil, nil, invoker)
+
+	require.True(t, kurtosis_errors.IsNotFound(err))
+	require.Equal(t, 1, attempts)
+}
+
+func TestRetryingUnaryClientInterceptor_NoRetryPolicy(t *testing.T) {
+	attempts := 0
+	invoker := newFailingInvoker(&attempts, 1, codes.Unavailable)
+
+	err := newRetryingUnaryClientInterceptor(NewNoRetryPolicy())(context.Background(), testMethod, nil, nil, nil, invoker)
+
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+}
+
+func TestGetNextBackoff_IsCapped(t *testing.T) {
+	retryPolicy := NewRetryPolicy(WithBackoffMultiplier(3), WithMaxBackoff(5*time.Second))
+
+	require.Equal(t, 3*time.Second, getNextBackoff(retryPolicy, time.Second))
+	require.Equal(t, 5*time.Second, getNextBackoff(retryPolicy, 3*time.Second))
+}
+
+// newFailingInvoker returns an invoker failing with the code for the first failures calls, then succeeding
+func newFailingInvoker(attempts *int, failures int, code codes.Code) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, request, reply interface{}, conn *grpc.ClientConn, opts ...grpc.CallOption) error {
+		*attempts++
+		if *attempts <= failures {
+			return kurtosis_errors.FromGrpcError(status.Error(code, "An error occurred"))
+		}
+		return nil
+	}
+}
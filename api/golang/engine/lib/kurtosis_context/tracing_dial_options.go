@@ -0,0 +1,16 @@
+package kurtosis_context
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+// GetTracingDialOptions returns the options making the gRPC calls carry the trace context of the caller, so the spans
+// of the engine and the API container join the trace of the caller. Without a tracer provider set, it only propagates
+// the trace context
+func GetTracingDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
+}
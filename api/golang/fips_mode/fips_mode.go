@@ -0,0 +1,59 @@
+package fips_mode
+
+import (
+	"crypto/tls"
+	"os"
+	"strconv"
+	"sync/atomic"
+)
+
+const (
+	// FipsModeEnvVar restricts the TLS connections of the process to the FIPS-approved parameters when it's 'true', e.g.
+	// for the SDKs that call API containers requiring it
+	FipsModeEnvVar = "KURTOSIS_FIPS_MODE"
+)
+
+// The FIPS 140 approved TLS 1.2 cipher suites Go implements; TLS 1.3 isn't allowed as Go doesn't let its cipher suites
+// be restricted
+var fipsCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+}
+
+var fipsCurvePreferences = []tls.CurveID{
+	tls.CurveP256,
+	tls.CurveP384,
+}
+
+var isEnabledByConfig atomic.Bool
+
+// Enable restricts the TLS connections the process makes from now on to the FIPS-approved parameters
+func Enable() {
+	isEnabledByConfig.Store(true)
+}
+
+// IsEnabled returns true if the TLS connections of the process must be restricted to the FIPS-approved parameters,
+// which is always the case in a build with validated crypto
+func IsEnabled() bool {
+	if isEnabledByConfig.Load() || IsValidatedCryptoInUse() {
+		return true
+	}
+	isEnabled, err := strconv.ParseBool(os.Getenv(FipsModeEnvVar))
+	return err == nil && isEnabled
+}
+
+// IsValidatedCryptoInUse returns true if the process was built with the FIPS 140 validated crypto module, i.e. with
+// GOEXPERIMENT=boringcrypto
+func IsValidatedCryptoInUse() bool {
+	return isBoringCryptoEnabled()
+}
+
+// RestrictTlsConfig restricts the TLS config to TLS 1.2 with the FIPS-approved cipher suites and curves
+func RestrictTlsConfig(tlsConfig *tls.Config) {
+	tlsConfig.MinVersion = tls.VersionTLS12
+	tlsConfig.MaxVersion = tls.VersionTLS12
+	tlsConfig.CipherSuites = fipsCipherSuites
+	tlsConfig.CurvePreferences = fipsCurvePreferences
+}
@@ -0,0 +1,31 @@
+package fips_mode
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsEnabled_FromEnvVar(t *testing.T) {
+	t.Setenv(FipsModeEnvVar, "")
+	require.Equal(t, IsValidatedCryptoInUse(), IsEnabled())
+
+	t.Setenv(FipsModeEnvVar, "true")
+	require.True(t, IsEnabled())
+
+	t.Setenv(FipsModeEnvVar, "not-a-bool")
+	require.Equal(t, IsValidatedCryptoInUse(), IsEnabled())
+}
+
+func TestRestrictTlsConfig(t *testing.T) {
+	tlsConfig := &tls.Config{}
+	RestrictTlsConfig(tlsConfig)
+	require.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+	require.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MaxVersion)
+	for _, cipherSuite := range tlsConfig.CipherSuites {
+		require.NotContains(t, tls.CipherSuiteName(cipherSuite), "CHACHA20")
+		require.Contains(t, tls.CipherSuiteName(cipherSuite), "GCM")
+	}
+	require.NotContains(t, tlsConfig.CurvePreferences, tls.X25519)
+}
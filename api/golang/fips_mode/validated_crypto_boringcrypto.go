@@ -0,0 +1,14 @@
+//go:build boringcrypto
+
+package fips_mode
+
+import (
+	"crypto/boring"
+	// Restricts every TLS connection of the process to the FIPS-approved parameters, including the ones of the servers
+	// whose TLS config isn't built here
+	_ "crypto/tls/fipsonly"
+)
+
+func isBoringCryptoEnabled() bool {
+	return boring.Enabled()
+}
@@ -0,0 +1,7 @@
+//go:build !boringcrypto
+
+package fips_mode
+
+func isBoringCryptoEnabled() bool {
+	return false
+}
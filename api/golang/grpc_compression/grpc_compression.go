@@ -0,0 +1,184 @@
+package grpc_compression
+
+import (
+	"io"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/klauspost/compress/zstd"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// Compression is the name of a compression the engine and the API container accept on their gRPC calls
+type Compression string
+
+const (
+	NoCompression   Compression = ""
+	GzipCompression Compression = gzip.Name
+	ZstdCompression Compression = "zstd"
+
+	// The messages are small and many streams run at once, so no more than one goroutine is used per message
+	zstdConcurrency = 1
+)
+
+var allCompressions = map[Compression]bool{
+	NoCompression:   true,
+	GzipCompression: true,
+	ZstdCompression: true,
+}
+
+// Importing this package registers the compressions with gRPC, so that the gRPC servers and clients of the process
+// accept them; the gzip one is registered by the gRPC gzip package it imports
+func init() {
+	encoding.RegisterCompressor(newZstdGrpcCompressor())
+}
+
+// ParseCompression returns the compression with the given name, or an error if it's not supported
+func ParseCompression(compressionStr string) (Compression, error) {
+	compression := Compression(compressionStr)
+	if _, found := allCompressions[compression]; !found {
+		return NoCompression, stacktrace.NewError("Unsupported gRPC compression '%v'; the supported ones are '%v' and '%v'", compressionStr, GzipCompression, ZstdCompression)
+	}
+	return compression, nil
+}
+
+// GetConnectHandlerOptions returns the options making a Connect handler accept the compressions that Connect doesn't
+// support out of the box, i.e. all of them but gzip
+func GetConnectHandlerOptions() []connect.HandlerOption {
+	return []connect.HandlerOption{
+		connect.WithCompression(
+			string(ZstdCompression),
+			func() connect.Decompressor {
+				return newZstdConnectDecompressor()
+			},
+			func() connect.Compressor {
+				return newZstdEncoder(nil)
+			},
+		),
+	}
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+// zstdGrpcCompressor implements the gRPC compressor interface, pooling the encoders and decoders as creating them is
+// costly
+type zstdGrpcCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+func newZstdGrpcCompressor() *zstdGrpcCompressor {
+	return &zstdGrpcCompressor{
+		encoders: sync.Pool{
+			New: nil,
+		},
+		decoders: sync.Pool{
+			New: nil,
+		},
+	}
+}
+
+func (compressor *zstdGrpcCompressor) Compress(writer io.Writer) (io.WriteCloser, error) {
+	encoder, found := compressor.encoders.Get().(*zstd.Encoder)
+	if !found {
+		return &pooledZstdEncoder{Encoder: newZstdEncoder(writer), pool: &compressor.encoders}, nil
+	}
+	encoder.Reset(writer)
+	return &pooledZstdEncoder{Encoder: encoder, pool: &compressor.encoders}, nil
+}
+
+func (compressor *zstdGrpcCompressor) Decompress(reader io.Reader) (io.Reader, error) {
+	decoder, found := compressor.decoders.Get().(*zstd.Decoder)
+	if !found {
+		newDecoder, err := zstd.NewReader(reader, zstd.WithDecoderConcurrency(zstdConcurrency))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a zstd decoder")
+		}
+		return &pooledZstdDecoder{Decoder: newDecoder, pool: &compressor.decoders, isReturnedToPool: false}, nil
+	}
+	if err := decoder.Reset(reader); err != nil {
+		compressor.decoders.Put(decoder)
+		return nil, stacktrace.Propagate(err, "An error occurred resetting a zstd decoder")
+	}
+	return &pooledZstdDecoder{Decoder: decoder, pool: &compressor.decoders, isReturnedToPool: false}, nil
+}
+
+func (compressor *zstdGrpcCompressor) Name() string {
+	return string(ZstdCompression)
+}
+
+// pooledZstdEncoder returns the encoder to the pool once the message is compressed
+type pooledZstdEncoder struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (encoder *pooledZstdEncoder) Close() error {
+	defer encoder.pool.Put(encoder.Encoder)
+	return encoder.Encoder.Close()
+}
+
+// pooledZstdDecoder returns the decoder to the pool once the message is decompressed, as gRPC reads the messages to
+// their end without closing them
+type pooledZstdDecoder struct {
+	*zstd.Decoder
+	pool *sync.Pool
+
+	isReturnedToPool bool
+}
+
+func (decoder *pooledZstdDecoder) Read(p []byte) (int, error) {
+	if decoder.isReturnedToPool {
+		return 0, io.EOF
+	}
+	readBytes, err := decoder.Decoder.Read(p)
+	if err == io.EOF {
+		decoder.isReturnedToPool = true
+		decoder.pool.Put(decoder.Decoder)
+	}
+	return readBytes, err
+}
+
+// zstdConnectDecompressor adapts the zstd decoder to Connect, which closes and resets its decompressors to reuse them
+// while closing a zstd decoder frees it for good
+type zstdConnectDecompressor struct {
+	decoder *zstd.Decoder
+	err     error
+}
+
+func newZstdConnectDecompressor() *zstdConnectDecompressor {
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(zstdConcurrency))
+	return &zstdConnectDecompressor{
+		decoder: decoder,
+		err:     err,
+	}
+}
+
+func (decompressor *zstdConnectDecompressor) Read(p []byte) (int, error) {
+	if decompressor.err != nil {
+		return 0, stacktrace.Propagate(decompressor.err, "An error occurred creating the zstd decoder")
+	}
+	return decompressor.decoder.Read(p)
+}
+
+func (decompressor *zstdConnectDecompressor) Reset(reader io.Reader) error {
+	if decompressor.err != nil {
+		return stacktrace.Propagate(decompressor.err, "An error occurred creating the zstd decoder")
+	}
+	return decompressor.decoder.Reset(reader)
+}
+
+func (decompressor *zstdConnectDecompressor) Close() error {
+	return nil
+}
+
+func newZstdEncoder(writer io.Writer) *zstd.Encoder {
+	// The options are valid, so creating the encoder can't fail
+	encoder, _ := zstd.NewWriter(writer, zstd.WithEncoderConcurrency(zstdConcurrency))
+	return encoder
+}
@@ -0,0 +1,65 @@
+package grpc_compression
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+)
+
+var testContent = strings.Repeat("2024-01-01T00:00:00Z INFO service started and listening on port 8080\n", 100)
+
+func TestParseCompression(t *testing.T) {
+	compression, err := ParseCompression("zstd")
+	require.NoError(t, err)
+	require.Equal(t, ZstdCompression, compression)
+
+	compression, err = ParseCompression("")
+	require.NoError(t, err)
+	require.Equal(t, NoCompression, compression)
+
+	_, err = ParseCompression("brotli")
+	require.Error(t, err)
+}
+
+func TestZstdGrpcCompressorIsRegistered(t *testing.T) {
+	compressor := encoding.GetCompressor(string(ZstdCompression))
+	require.NotNil(t, compressor)
+
+	// Compress several messages to exercise the pooled encoders and decoders
+	for i := 0; i < 3; i++ {
+		compressed := &bytes.Buffer{}
+		writer, err := compressor.Compress(compressed)
+		require.NoError(t, err)
+		_, err = writer.Write([]byte(testContent))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		require.Less(t, compressed.Len(), len(testContent))
+
+		reader, err := compressor.Decompress(compressed)
+		require.NoError(t, err)
+		decompressed, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, testContent, string(decompressed))
+	}
+}
+
+func TestZstdConnectDecompressorCanBeReused(t *testing.T) {
+	decompressor := newZstdConnectDecompressor()
+	for i := 0; i < 3; i++ {
+		compressed := &bytes.Buffer{}
+		encoder := newZstdEncoder(compressed)
+		_, err := encoder.Write([]byte(testContent))
+		require.NoError(t, err)
+		require.NoError(t, encoder.Close())
+
+		require.NoError(t, decompressor.Reset(compressed))
+		decompressed, err := io.ReadAll(decompressor)
+		require.NoError(t, err)
+		require.Equal(t, testContent, string(decompressed))
+		require.NoError(t, decompressor.Close())
+	}
+}
@@ -256,6 +256,30 @@ type FileArtifactUploadResult_FileArtifactUploadResult struct {
 	union json.RawMessage
 }
 
+// FilesArtifactHistory defines model for FilesArtifactHistory.
+type FilesArtifactHistory struct {
+	FileName string `json:"file_name"`
+
+	// Versions Versions of the files artifact, oldest first
+	Versions []FilesArtifactVersion `json:"versions"`
+}
+
+// FilesArtifactVersion defines model for FilesArtifactVersion.
+type FilesArtifactVersion struct {
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+
+	// FileUuid UUID under which the content of this version is stored; the latest version shares the UUID of the files artifact
+	FileUuid string `json:"file_uuid"`
+	IsLatest bool   `json:"is_latest"`
+	Version  int64  `json:"version"`
+}
+
+// HttpHeader defines model for HttpHeader.
+type HttpHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 // HttpMethodAvailability defines model for HttpMethodAvailability.
 type HttpMethodAvailability string
 
@@ -281,6 +305,12 @@ type LogLineFilter struct {
 // LogLineOperator defines model for LogLineOperator.
 type LogLineOperator string
 
+// PlanYaml defines model for PlanYaml.
+type PlanYaml struct {
+	// PlanYaml The plan of the Starlark run, as a YAML document
+	PlanYaml string `json:"plan_yaml"`
+}
+
 // Port Shared Objects (Used By Multiple Endpoints)
 type Port struct {
 	ApplicationProtocol *string `json:"application_protocol,omitempty"`
@@ -509,6 +539,24 @@ type StarlarkInterpretationError struct {
 	} `json:"interpretation_error"`
 }
 
+// StarlarkPackagePlanYaml defines model for StarlarkPackagePlanYaml.
+type StarlarkPackagePlanYaml struct {
+	// ClonePackage Whether the package should be cloned or not.
+	// If false, then the package will be pulled from the APIC local package store. If it's a local package then is must
+	// have been uploaded using UploadStarlarkPackage prior to getting its plan.
+	// If true, then the package will be cloned from GitHub
+	ClonePackage *bool `json:"clone_package,omitempty"`
+
+	// MainFunctionName The name of the main function, the default value is "run"
+	MainFunctionName *string `json:"main_function_name,omitempty"`
+
+	// Params Parameters data for the Starlark package main function
+	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// RelativePathToMainFile The relative main file filepath, the default value is the "main.star" file in the root of a package
+	RelativePathToMainFile *string `json:"relative_path_to_main_file,omitempty"`
+}
+
 // StarlarkRunFinishedEvent defines model for StarlarkRunFinishedEvent.
 type StarlarkRunFinishedEvent struct {
 	RunFinishedEvent struct {
@@ -544,6 +592,16 @@ type StarlarkRunResponseLine struct {
 	union json.RawMessage
 }
 
+// StarlarkScriptPlanYaml defines model for StarlarkScriptPlanYaml.
+type StarlarkScriptPlanYaml struct {
+	// MainFunctionName The name of the main function, the default value is "run"
+	MainFunctionName *string `json:"main_function_name,omitempty"`
+
+	// Params Parameters data for the Starlark script main function
+	Params           *map[string]interface{} `json:"params,omitempty"`
+	SerializedScript string                  `json:"serialized_script"`
+}
+
 // StarlarkValidationError defines model for StarlarkValidationError.
 type StarlarkValidationError struct {
 	ValidationError struct {
@@ -569,6 +627,19 @@ type StoreFilesArtifactFromService struct {
 
 // StoreWebFilesArtifact Store Web Files Artifact
 type StoreWebFilesArtifact struct {
+	// BasicAuthPassword Password for HTTP basic authentication, if the URL requires it
+	BasicAuthPassword *string `json:"basic_auth_password,omitempty"`
+
+	// BasicAuthUsername Username for HTTP basic authentication, if the URL requires it
+	BasicAuthUsername *string `json:"basic_auth_username,omitempty"`
+
+	// ContentSha256 Hex-encoded SHA-256 that the downloaded content must have. When set, content already downloaded with this
+	// checksum is reused instead of downloading it again
+	ContentSha256 *string `json:"content_sha256,omitempty"`
+
+	// Headers Headers sent along with the request (e.g. a token for the host serving the file)
+	Headers *[]HttpHeader `json:"headers,omitempty"`
+
 	// Name The name of the files artifact
 	Name string `json:"name"`
 
@@ -742,9 +813,15 @@ type PostEnclavesEnclaveIdentifierStarlarkPackagesMultipartRequestBody = PostEnc
 // PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdJSONRequestBody defines body for PostEnclavesEnclaveIdentifierStarlarkPackagesPackageId for application/json ContentType.
 type PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdJSONRequestBody = RunStarlarkPackage
 
+// PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody defines body for PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan for application/json ContentType.
+type PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody = StarlarkPackagePlanYaml
+
 // PostEnclavesEnclaveIdentifierStarlarkScriptsJSONRequestBody defines body for PostEnclavesEnclaveIdentifierStarlarkScripts for application/json ContentType.
 type PostEnclavesEnclaveIdentifierStarlarkScriptsJSONRequestBody = RunStarlarkScript
 
+// PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody defines body for PostEnclavesEnclaveIdentifierStarlarkScriptsPlan for application/json ContentType.
+type PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody = StarlarkScriptPlanYaml
+
 // PostEnclavesEnclaveIdentifierStatusJSONRequestBody defines body for PostEnclavesEnclaveIdentifierStatus for application/json ContentType.
 type PostEnclavesEnclaveIdentifierStatusJSONRequestBody = EnclaveTargetStatus
 
@@ -132,6 +132,9 @@ type ClientInterface interface {
 	// GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownload request
 	GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownload(ctx context.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory request
+	GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx context.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEnclavesEnclaveIdentifierLogs request
 	GetEnclavesEnclaveIdentifierLogs(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -171,11 +174,21 @@ type ClientInterface interface {
 
 	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageId(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, params *PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdParams, body PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithBody request with any body
+	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithBody(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, body PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostEnclavesEnclaveIdentifierStarlarkScriptsWithBody request with any body
 	PostEnclavesEnclaveIdentifierStarlarkScriptsWithBody(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *PostEnclavesEnclaveIdentifierStarlarkScriptsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostEnclavesEnclaveIdentifierStarlarkScripts(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *PostEnclavesEnclaveIdentifierStarlarkScriptsParams, body PostEnclavesEnclaveIdentifierStarlarkScriptsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithBody request with any body
+	PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithBody(ctx context.Context, enclaveIdentifier EnclaveIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx context.Context, enclaveIdentifier EnclaveIdentifier, body PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEnclavesEnclaveIdentifierStatus request
 	GetEnclavesEnclaveIdentifierStatus(ctx context.Context, enclaveIdentifier EnclaveIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -371,6 +384,18 @@ func (c *Client) GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownload
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx context.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryRequest(c.Server, enclaveIdentifier, artifactIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetEnclavesEnclaveIdentifierLogs(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetEnclavesEnclaveIdentifierLogsRequest(c.Server, enclaveIdentifier, params)
 	if err != nil {
@@ -539,6 +564,30 @@ func (c *Client) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageId(ctx cont
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithBody(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestWithBody(c.Server, enclaveIdentifier, packageId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, body PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequest(c.Server, enclaveIdentifier, packageId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostEnclavesEnclaveIdentifierStarlarkScriptsWithBody(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *PostEnclavesEnclaveIdentifierStarlarkScriptsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostEnclavesEnclaveIdentifierStarlarkScriptsRequestWithBody(c.Server, enclaveIdentifier, params, contentType, body)
 	if err != nil {
@@ -563,6 +612,30 @@ func (c *Client) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx context.Contex
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithBody(ctx context.Context, enclaveIdentifier EnclaveIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestWithBody(c.Server, enclaveIdentifier, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx context.Context, enclaveIdentifier EnclaveIdentifier, body PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequest(c.Server, enclaveIdentifier, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetEnclavesEnclaveIdentifierStatus(ctx context.Context, enclaveIdentifier EnclaveIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetEnclavesEnclaveIdentifierStatusRequest(c.Server, enclaveIdentifier)
 	if err != nil {
@@ -1087,6 +1160,47 @@ func NewGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadRequest(s
 	return req, nil
 }
 
+// NewGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryRequest generates requests for GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory
+func NewGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryRequest(server string, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, enclaveIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "artifact_identifier", runtime.ParamLocationPath, artifactIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/enclaves/%s/artifacts/%s/history", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetEnclavesEnclaveIdentifierLogsRequest generates requests for GetEnclavesEnclaveIdentifierLogs
 func NewGetEnclavesEnclaveIdentifierLogsRequest(server string, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierLogsParams) (*http.Request, error) {
 	var err error
@@ -1858,6 +1972,60 @@ func NewPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdRequestWithBody(se
 	return req, nil
 }
 
+// NewPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequest calls the generic PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan builder with application/json body
+func NewPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequest(server string, enclaveIdentifier EnclaveIdentifier, packageId PackageId, body PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestWithBody(server, enclaveIdentifier, packageId, "application/json", bodyReader)
+}
+
+// NewPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestWithBody generates requests for PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan with any type of body
+func NewPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestWithBody(server string, enclaveIdentifier EnclaveIdentifier, packageId PackageId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, enclaveIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "package_id", runtime.ParamLocationPath, packageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/enclaves/%s/starlark/packages/%s/plan", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewPostEnclavesEnclaveIdentifierStarlarkScriptsRequest calls the generic PostEnclavesEnclaveIdentifierStarlarkScripts builder with application/json body
 func NewPostEnclavesEnclaveIdentifierStarlarkScriptsRequest(server string, enclaveIdentifier EnclaveIdentifier, params *PostEnclavesEnclaveIdentifierStarlarkScriptsParams, body PostEnclavesEnclaveIdentifierStarlarkScriptsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -1927,6 +2095,53 @@ func NewPostEnclavesEnclaveIdentifierStarlarkScriptsRequestWithBody(server strin
 	return req, nil
 }
 
+// NewPostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequest calls the generic PostEnclavesEnclaveIdentifierStarlarkScriptsPlan builder with application/json body
+func NewPostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequest(server string, enclaveIdentifier EnclaveIdentifier, body PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestWithBody(server, enclaveIdentifier, "application/json", bodyReader)
+}
+
+// NewPostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestWithBody generates requests for PostEnclavesEnclaveIdentifierStarlarkScriptsPlan with any type of body
+func NewPostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestWithBody(server string, enclaveIdentifier EnclaveIdentifier, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, enclaveIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/enclaves/%s/starlark/scripts/plan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetEnclavesEnclaveIdentifierStatusRequest generates requests for GetEnclavesEnclaveIdentifierStatus
 func NewGetEnclavesEnclaveIdentifierStatusRequest(server string, enclaveIdentifier EnclaveIdentifier) (*http.Request, error) {
 	var err error
@@ -2154,6 +2369,9 @@ type ClientWithResponsesInterface interface {
 	// GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadWithResponse request
 	GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadResponse, error)
 
+	// GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryWithResponse request
+	GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse, error)
+
 	// GetEnclavesEnclaveIdentifierLogsWithResponse request
 	GetEnclavesEnclaveIdentifierLogsWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierLogsParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierLogsResponse, error)
 
@@ -2193,11 +2411,21 @@ type ClientWithResponsesInterface interface {
 
 	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, params *PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdParams, body PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdResponse, error)
 
+	// PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithBodyWithResponse request with any body
+	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithBodyWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse, error)
+
+	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, body PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse, error)
+
 	// PostEnclavesEnclaveIdentifierStarlarkScriptsWithBodyWithResponse request with any body
 	PostEnclavesEnclaveIdentifierStarlarkScriptsWithBodyWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *PostEnclavesEnclaveIdentifierStarlarkScriptsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkScriptsResponse, error)
 
 	PostEnclavesEnclaveIdentifierStarlarkScriptsWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *PostEnclavesEnclaveIdentifierStarlarkScriptsParams, body PostEnclavesEnclaveIdentifierStarlarkScriptsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkScriptsResponse, error)
 
+	// PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithBodyWithResponse request with any body
+	PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithBodyWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse, error)
+
+	PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, body PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse, error)
+
 	// GetEnclavesEnclaveIdentifierStatusWithResponse request
 	GetEnclavesEnclaveIdentifierStatusWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierStatusResponse, error)
 
@@ -2487,6 +2715,29 @@ func (r GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadResponse)
 	return 0
 }
 
+type GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FilesArtifactHistory
+	JSONDefault  *NotOk
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetEnclavesEnclaveIdentifierLogsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2737,6 +2988,29 @@ func (r PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdResponse) StatusCo
 	return 0
 }
 
+type PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlanYaml
+	JSONDefault  *NotOk
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostEnclavesEnclaveIdentifierStarlarkScriptsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2760,6 +3034,29 @@ func (r PostEnclavesEnclaveIdentifierStarlarkScriptsResponse) StatusCode() int {
 	return 0
 }
 
+type PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlanYaml
+	JSONDefault  *NotOk
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetEnclavesEnclaveIdentifierStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2983,6 +3280,15 @@ func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierArtifactsArtifactIdent
 	return ParseGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadResponse(rsp)
 }
 
+// GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryWithResponse request returning *GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse
+func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse, error) {
+	rsp, err := c.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx, enclaveIdentifier, artifactIdentifier, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse(rsp)
+}
+
 // GetEnclavesEnclaveIdentifierLogsWithResponse request returning *GetEnclavesEnclaveIdentifierLogsResponse
 func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierLogsWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierLogsParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierLogsResponse, error) {
 	rsp, err := c.GetEnclavesEnclaveIdentifierLogs(ctx, enclaveIdentifier, params, reqEditors...)
@@ -3106,6 +3412,23 @@ func (c *ClientWithResponses) PostEnclavesEnclaveIdentifierStarlarkPackagesPacka
 	return ParsePostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdResponse(rsp)
 }
 
+// PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithBodyWithResponse request with arbitrary body returning *PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse
+func (c *ClientWithResponses) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithBodyWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse, error) {
+	rsp, err := c.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithBody(ctx, enclaveIdentifier, packageId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, body PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse, error) {
+	rsp, err := c.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx, enclaveIdentifier, packageId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse(rsp)
+}
+
 // PostEnclavesEnclaveIdentifierStarlarkScriptsWithBodyWithResponse request with arbitrary body returning *PostEnclavesEnclaveIdentifierStarlarkScriptsResponse
 func (c *ClientWithResponses) PostEnclavesEnclaveIdentifierStarlarkScriptsWithBodyWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *PostEnclavesEnclaveIdentifierStarlarkScriptsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkScriptsResponse, error) {
 	rsp, err := c.PostEnclavesEnclaveIdentifierStarlarkScriptsWithBody(ctx, enclaveIdentifier, params, contentType, body, reqEditors...)
@@ -3123,6 +3446,23 @@ func (c *ClientWithResponses) PostEnclavesEnclaveIdentifierStarlarkScriptsWithRe
 	return ParsePostEnclavesEnclaveIdentifierStarlarkScriptsResponse(rsp)
 }
 
+// PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithBodyWithResponse request with arbitrary body returning *PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse
+func (c *ClientWithResponses) PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithBodyWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse, error) {
+	rsp, err := c.PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithBody(ctx, enclaveIdentifier, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, body PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse, error) {
+	rsp, err := c.PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx, enclaveIdentifier, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse(rsp)
+}
+
 // GetEnclavesEnclaveIdentifierStatusWithResponse request returning *GetEnclavesEnclaveIdentifierStatusResponse
 func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierStatusWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierStatusResponse, error) {
 	rsp, err := c.GetEnclavesEnclaveIdentifierStatus(ctx, enclaveIdentifier, reqEditors...)
@@ -3549,6 +3889,39 @@ func ParseGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadRespons
 	return response, nil
 }
 
+// ParseGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse parses an HTTP response from a GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryWithResponse call
+func ParseGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse(rsp *http.Response) (*GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FilesArtifactHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest NotOk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetEnclavesEnclaveIdentifierLogsResponse parses an HTTP response from a GetEnclavesEnclaveIdentifierLogsWithResponse call
 func ParseGetEnclavesEnclaveIdentifierLogsResponse(rsp *http.Response) (*GetEnclavesEnclaveIdentifierLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3891,6 +4264,39 @@ func ParsePostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdResponse(rsp *ht
 	return response, nil
 }
 
+// ParsePostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse parses an HTTP response from a PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanWithResponse call
+func ParsePostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse(rsp *http.Response) (*PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlanYaml
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest NotOk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostEnclavesEnclaveIdentifierStarlarkScriptsResponse parses an HTTP response from a PostEnclavesEnclaveIdentifierStarlarkScriptsWithResponse call
 func ParsePostEnclavesEnclaveIdentifierStarlarkScriptsResponse(rsp *http.Response) (*PostEnclavesEnclaveIdentifierStarlarkScriptsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3924,6 +4330,39 @@ func ParsePostEnclavesEnclaveIdentifierStarlarkScriptsResponse(rsp *http.Respons
 	return response, nil
 }
 
+// ParsePostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse parses an HTTP response from a PostEnclavesEnclaveIdentifierStarlarkScriptsPlanWithResponse call
+func ParsePostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse(rsp *http.Response) (*PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlanYaml
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest NotOk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetEnclavesEnclaveIdentifierStatusResponse parses an HTTP response from a GetEnclavesEnclaveIdentifierStatusWithResponse call
 func ParseGetEnclavesEnclaveIdentifierStatusResponse(rsp *http.Response) (*GetEnclavesEnclaveIdentifierStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
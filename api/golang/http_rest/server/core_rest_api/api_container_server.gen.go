@@ -44,6 +44,9 @@ type ServerInterface interface {
 	// Downloads a files artifact from the Kurtosis File System
 	// (GET /enclaves/{enclave_identifier}/artifacts/{artifact_identifier}/download)
 	GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownload(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier) error
+	// Get the version history of a files artifact
+	// (GET /enclaves/{enclave_identifier}/artifacts/{artifact_identifier}/history)
+	GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier) error
 	// Returns detailed information about alls services within the enclave
 	// (GET /enclaves/{enclave_identifier}/services)
 	GetEnclavesEnclaveIdentifierServices(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params GetEnclavesEnclaveIdentifierServicesParams) error
@@ -71,9 +74,15 @@ type ServerInterface interface {
 	// Executes a Starlark package on the user's behalf
 	// (POST /enclaves/{enclave_identifier}/starlark/packages/{package_id})
 	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageId(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId, params PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdParams) error
+	// Get the plan of a Starlark package
+	// (POST /enclaves/{enclave_identifier}/starlark/packages/{package_id}/plan)
+	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId) error
 	// Executes a Starlark script on the user's behalf
 	// (POST /enclaves/{enclave_identifier}/starlark/scripts)
 	PostEnclavesEnclaveIdentifierStarlarkScripts(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params PostEnclavesEnclaveIdentifierStarlarkScriptsParams) error
+	// Get the plan of a Starlark script
+	// (POST /enclaves/{enclave_identifier}/starlark/scripts/plan)
+	PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx echo.Context, enclaveIdentifier EnclaveIdentifier) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -201,6 +210,30 @@ func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierArtifactsArtifactId
 	return err
 }
 
+// GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "enclave_identifier" -------------
+	var enclaveIdentifier EnclaveIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, ctx.Param("enclave_identifier"), &enclaveIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifier: %s", err))
+	}
+
+	// ------------- Path parameter "artifact_identifier" -------------
+	var artifactIdentifier ArtifactIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "artifact_identifier", runtime.ParamLocationPath, ctx.Param("artifact_identifier"), &artifactIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter artifact_identifier: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx, enclaveIdentifier, artifactIdentifier)
+	return err
+}
+
 // GetEnclavesEnclaveIdentifierServices converts echo context to params.
 func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServices(ctx echo.Context) error {
 	var err error
@@ -454,6 +487,30 @@ func (w *ServerInterfaceWrapper) PostEnclavesEnclaveIdentifierStarlarkPackagesPa
 	return err
 }
 
+// PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "enclave_identifier" -------------
+	var enclaveIdentifier EnclaveIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, ctx.Param("enclave_identifier"), &enclaveIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifier: %s", err))
+	}
+
+	// ------------- Path parameter "package_id" -------------
+	var packageId PackageId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "package_id", runtime.ParamLocationPath, ctx.Param("package_id"), &packageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter package_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx, enclaveIdentifier, packageId)
+	return err
+}
+
 // PostEnclavesEnclaveIdentifierStarlarkScripts converts echo context to params.
 func (w *ServerInterfaceWrapper) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx echo.Context) error {
 	var err error
@@ -479,6 +536,22 @@ func (w *ServerInterfaceWrapper) PostEnclavesEnclaveIdentifierStarlarkScripts(ct
 	return err
 }
 
+// PostEnclavesEnclaveIdentifierStarlarkScriptsPlan converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "enclave_identifier" -------------
+	var enclaveIdentifier EnclaveIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, ctx.Param("enclave_identifier"), &enclaveIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifier: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx, enclaveIdentifier)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -513,6 +586,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/enclaves/:enclave_identifier/artifacts/services/:service_identifier", wrapper.PostEnclavesEnclaveIdentifierArtifactsServicesServiceIdentifier)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/artifacts/:artifact_identifier", wrapper.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifier)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/artifacts/:artifact_identifier/download", wrapper.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownload)
+	router.GET(baseURL+"/enclaves/:enclave_identifier/artifacts/:artifact_identifier/history", wrapper.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services", wrapper.GetEnclavesEnclaveIdentifierServices)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/services/connection", wrapper.PostEnclavesEnclaveIdentifierServicesConnection)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/history", wrapper.GetEnclavesEnclaveIdentifierServicesHistory)
@@ -522,7 +596,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/enclaves/:enclave_identifier/starlark", wrapper.GetEnclavesEnclaveIdentifierStarlark)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/starlark/packages", wrapper.PostEnclavesEnclaveIdentifierStarlarkPackages)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/starlark/packages/:package_id", wrapper.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageId)
+	router.POST(baseURL+"/enclaves/:enclave_identifier/starlark/packages/:package_id/plan", wrapper.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/starlark/scripts", wrapper.PostEnclavesEnclaveIdentifierStarlarkScripts)
+	router.POST(baseURL+"/enclaves/:enclave_identifier/starlark/scripts/plan", wrapper.PostEnclavesEnclaveIdentifierStarlarkScriptsPlan)
 
 }
 
@@ -718,6 +794,36 @@ func (response GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadde
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryRequestObject struct {
+	EnclaveIdentifier  EnclaveIdentifier  `json:"enclave_identifier"`
+	ArtifactIdentifier ArtifactIdentifier `json:"artifact_identifier"`
+}
+
+type GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponseObject interface {
+	VisitGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory200JSONResponse FilesArtifactHistory
+
+func (response GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory200JSONResponse) VisitGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistorydefaultJSONResponse struct {
+	Body       ResponseInfo
+	StatusCode int
+}
+
+func (response GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistorydefaultJSONResponse) VisitGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type GetEnclavesEnclaveIdentifierServicesRequestObject struct {
 	EnclaveIdentifier EnclaveIdentifier `json:"enclave_identifier"`
 	Params            GetEnclavesEnclaveIdentifierServicesParams
@@ -988,6 +1094,37 @@ func (response PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIddefaultJSON
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestObject struct {
+	EnclaveIdentifier EnclaveIdentifier `json:"enclave_identifier"`
+	PackageId         PackageId         `json:"package_id"`
+	Body              *PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody
+}
+
+type PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponseObject interface {
+	VisitPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse(w http.ResponseWriter) error
+}
+
+type PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan200JSONResponse PlanYaml
+
+func (response PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan200JSONResponse) VisitPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlandefaultJSONResponse struct {
+	Body       ResponseInfo
+	StatusCode int
+}
+
+func (response PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlandefaultJSONResponse) VisitPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type PostEnclavesEnclaveIdentifierStarlarkScriptsRequestObject struct {
 	EnclaveIdentifier EnclaveIdentifier `json:"enclave_identifier"`
 	Params            PostEnclavesEnclaveIdentifierStarlarkScriptsParams
@@ -1019,6 +1156,36 @@ func (response PostEnclavesEnclaveIdentifierStarlarkScriptsdefaultJSONResponse)
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type PostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestObject struct {
+	EnclaveIdentifier EnclaveIdentifier `json:"enclave_identifier"`
+	Body              *PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody
+}
+
+type PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponseObject interface {
+	VisitPostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse(w http.ResponseWriter) error
+}
+
+type PostEnclavesEnclaveIdentifierStarlarkScriptsPlan200JSONResponse PlanYaml
+
+func (response PostEnclavesEnclaveIdentifierStarlarkScriptsPlan200JSONResponse) VisitPostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostEnclavesEnclaveIdentifierStarlarkScriptsPlandefaultJSONResponse struct {
+	Body       ResponseInfo
+	StatusCode int
+}
+
+func (response PostEnclavesEnclaveIdentifierStarlarkScriptsPlandefaultJSONResponse) VisitPostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// List all files artifacts
@@ -1039,6 +1206,9 @@ type StrictServerInterface interface {
 	// Downloads a files artifact from the Kurtosis File System
 	// (GET /enclaves/{enclave_identifier}/artifacts/{artifact_identifier}/download)
 	GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownload(ctx context.Context, request GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadRequestObject) (GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierDownloadResponseObject, error)
+	// Get the version history of a files artifact
+	// (GET /enclaves/{enclave_identifier}/artifacts/{artifact_identifier}/history)
+	GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx context.Context, request GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryRequestObject) (GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponseObject, error)
 	// Returns detailed information about alls services within the enclave
 	// (GET /enclaves/{enclave_identifier}/services)
 	GetEnclavesEnclaveIdentifierServices(ctx context.Context, request GetEnclavesEnclaveIdentifierServicesRequestObject) (GetEnclavesEnclaveIdentifierServicesResponseObject, error)
@@ -1066,9 +1236,15 @@ type StrictServerInterface interface {
 	// Executes a Starlark package on the user's behalf
 	// (POST /enclaves/{enclave_identifier}/starlark/packages/{package_id})
 	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageId(ctx context.Context, request PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdRequestObject) (PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdResponseObject, error)
+	// Get the plan of a Starlark package
+	// (POST /enclaves/{enclave_identifier}/starlark/packages/{package_id}/plan)
+	PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx context.Context, request PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestObject) (PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponseObject, error)
 	// Executes a Starlark script on the user's behalf
 	// (POST /enclaves/{enclave_identifier}/starlark/scripts)
 	PostEnclavesEnclaveIdentifierStarlarkScripts(ctx context.Context, request PostEnclavesEnclaveIdentifierStarlarkScriptsRequestObject) (PostEnclavesEnclaveIdentifierStarlarkScriptsResponseObject, error)
+	// Get the plan of a Starlark script
+	// (POST /enclaves/{enclave_identifier}/starlark/scripts/plan)
+	PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx context.Context, request PostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestObject) (PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -1254,6 +1430,32 @@ func (sh *strictHandler) GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifier
 	return nil
 }
 
+// GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory operation middleware
+func (sh *strictHandler) GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, artifactIdentifier ArtifactIdentifier) error {
+	var request GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryRequestObject
+
+	request.EnclaveIdentifier = enclaveIdentifier
+	request.ArtifactIdentifier = artifactIdentifier
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx.Request().Context(), request.(GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponseObject); ok {
+		return validResponse.VisitGetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetEnclavesEnclaveIdentifierServices operation middleware
 func (sh *strictHandler) GetEnclavesEnclaveIdentifierServices(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params GetEnclavesEnclaveIdentifierServicesParams) error {
 	var request GetEnclavesEnclaveIdentifierServicesRequestObject
@@ -1511,6 +1713,38 @@ func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageId(
 	return nil
 }
 
+// PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan operation middleware
+func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, packageId PackageId) error {
+	var request PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestObject
+
+	request.EnclaveIdentifier = enclaveIdentifier
+	request.PackageId = packageId
+
+	var body PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx.Request().Context(), request.(PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponseObject); ok {
+		return validResponse.VisitPostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostEnclavesEnclaveIdentifierStarlarkScripts operation middleware
 func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params PostEnclavesEnclaveIdentifierStarlarkScriptsParams) error {
 	var request PostEnclavesEnclaveIdentifierStarlarkScriptsRequestObject
@@ -1543,86 +1777,127 @@ func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx echo.C
 	return nil
 }
 
+// PostEnclavesEnclaveIdentifierStarlarkScriptsPlan operation middleware
+func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx echo.Context, enclaveIdentifier EnclaveIdentifier) error {
+	var request PostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestObject
+
+	request.EnclaveIdentifier = enclaveIdentifier
+
+	var body PostEnclavesEnclaveIdentifierStarlarkScriptsPlanJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx.Request().Context(), request.(PostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostEnclavesEnclaveIdentifierStarlarkScriptsPlan")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponseObject); ok {
+		return validResponse.VisitPostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9a3MbuZF/BTV3Vd5NjUlnk0ou+ibLss2KV2JJdHRXK9cYnGmSiEBgAmAoc13671d4",
-	"zYODGQ71srPZ+3BecfDoN7objc7XKOXrnDNgSkZHX6McC7wGBcL8hYUiC5yqhGTAFFkQEPrnDGQqSK4I",
-	"Z9FRNFsB8gMRw2tAXKCiIFkUR0QPyLFaRXGkP0VHwTXjSMC/CiIgi46UKCCOZLqCNdabqW2up0klCFtG",
-	"d3dxBCyleAO9QH38OHkTI7niQgGDDNm/uXAALpBaAXILheEM7HIgmF9ySBVkiQCZcyahDeXEw5HlnDCF",
-	"BKhCMInUiki0wbSA2AyQIDYkBXRLKEVzQGssbiBDWCK8wYTiOQX0A4yWI/QeKOXoigua/TjyiP2rALGt",
-	"YdYCrB+RlVJ5sga14lmY++9nsymyA1AhIUOKo3QF6Y0Hj1CitiP0Bha4oAoRid6dzrrAq29XB+y/BSyi",
-	"o+i/xpXEju1XOX6vVP6zmXJc29FATxhRBNMkA4q3yZpQSiSknGUyjAwr1nMQWkTqYzVKt5goVDBFKIIv",
-	"kBaKsKVhz4IIqSwVUkxpB149gNTRXHCxxsqMV3/6KYo9QwhTsARhcMpxeoOXWjbDOLjvqJJdpFZYlfJj",
-	"wYcODa2tfpjEm2U6AFIrr3VemL2QjNBEoXUhFXuhkFRYaDjVqkZZSbFcjdBbLhBhUmGWAvrslhmvAFO1",
-	"+txBdIdZL9RcqMRyvQN4LpQXi6Bkd5Cxtm4fHYcwXM8GqZI5z7adZqSmOFrFJCgN7vT8chbXLEopBBKY",
-	"MSF6ql7X86eOGXIbd+lqA65+MgtQgkBA6X7GX2pKV2oRwkrBOlfSiq5BwIDuhNdp4pJstBoWOcIscwZU",
-	"/4AZAiG46ATcQnM4I8y8QdbkrN+SzEHdAjBUgdID6GNYDbvUBhLKlzLBcsvSoCwtMJUQoznl6Y0RKuQP",
-	"CkdzzR29BsICqhOoYd+1kPdi1AQjIDpzzilgZiB3ur7XDfGmpWb3nFCnnClMmDeE9qf1WsuMXPGCZnWz",
-	"iAgLq3QAjkMsZKXIr3nmdGFBKHzMKcfZa6fbGlRgSv/nuqCK5Fiosebvywwrs26A7XPCsCFya0+7q+Wf",
-	"2fGMq/ObnY1wnlOSYk3K8T+lpufXgSfvhVt6whbcorjjiDHvbjh9NPy0k/Xax5r/lwoLisXNqT1WOfvA",
-	"lwGF+igBEWPUjNSsBGe8kHSLvEhZb8ovYmV0QzC6grnk6Q0oqV1AI9JSCcBrTaM4ygXPQSjHEbN2Ih1I",
-	"Sbmckdc2UCXMxsc8DLjW3oFdjSfdYVG94P3SOfFTKRF8/k9IVWtiP7bt6XF0whnT/9mixCv0Ep2cn52d",
-	"nszQeIxeg1QIFgt9epojdMHFLRYZYctr9kf0Ep2dJ7Xh0+YQlBGprYr2QYAVaw2rGx3FUTW1BqInjQHR",
-	"arsJaRoUTtdZgoVlJFGwlgHalitiIfA2MiGHElvjo99r8ibZYBdRZRnR5MJ02gCra5GK7GStfTJriALj",
-	"pcKqkPu0tSTMpR0eECP9c2O3NvZxRcUadh2y0tgvKDOXs/Pp9PQNslJx8fHsbHL2Dl2zn9BL9PHs72fn",
-	"V2c1IXCjozhyI6M48qNCsqD188Sa+rDyIv91VxndAXEwx3do2lgmRKQahBcgC6raUgtfiEpSnsGgsz6O",
-	"KF8mvFB5EVDTYymLNUj0cfb25f/oGJhn1gz2W5gKhMbyIYTeEgrHLsx/U997F61wwDDVwYIAihXZmDDB",
-	"RgK0SjNEAT5L8msgwr4kv5bhvl4iRoSh+VYZf6tOyL/8OUhIBV9UkgvYELgNkBLNiTLLwxeF3HkaI7Ko",
-	"gUwpv5XoB0nWhGITQHw8m/zvC4lerABnL37cS3gfwmj89lH7AhYggKUBSuhhEvmBqOHCNLnibUw7o1Kn",
-	"pCy5EWuzrQN/dLsy/qyFQRtxojS9zZRCFQJCjPMH3LNst0Nbl6YyGO+jrfXSujRUw5iUua3CjE1EOZgz",
-	"OF9ER7/0m+cwK+/iQ1ywT3eh06MjQaJNi7Or7071waqjxaAZnegD4Q2/ZRqxn50latvy4w9Xx/936Uz5",
-	"z5PLS2ug/Sb2cxRH/lNoq78XQnFJ5FvAmolvKV6GNzs7TyZnl7OLjyezyfnZZXJyfPK+uV/XiNC22gEJ",
-	"GJAVFpChc0NJiX74KCFDr7foZ+OaU0CnLnknf2x7kpVXneSCK55yGjw/quzDANuuBGbSJBfqa/YJyMzP",
-	"mPoJd3GkY9BEkTXw0CGhoykTpboRKCuEQUQrmAN8n3KVuY8AxCFla8hx23HbPf2KbhKtQUq8hJ6zephG",
-	"zfTYXbTMAtUesYWsD6GZ29JL5enFxflFFEeTs7fnURxdHV+cdQnlBZiM2JRTkm47tOD0H6cXTuNK/SoV",
-	"QH+MYq94wS0K5uOvqc38BYhPOYMkrz43wbhagVqZ2LrKPVZhtZmcmeQ7V6NrVqUYlDbg9Uk+N5UXlEKG",
-	"FoKvzffj6eQEUZ5iWq2vuIARmiwQUS8kwjufzdJEmsTiNVvhDaA5AEPWMkOGCqlPDGvUd/BHuSDc5vow",
-	"pXpYm0YWDxPxd6PhMDdovCPqfTFHc1hwUY8DDX9lJcZl3iPWVC+yxKc8g9lel3IxWSVY52obOmHtOoUE",
-	"cf81MrFNRMH6Zxu2BlGBLzkIsgamME0W1rA3neo+fQydCIFAa0nUqpgnuFCrRPEbYPfE1QY/mTvskrWz",
-	"PH0Qto9HbYYwYcmiYKk5AcJulblyqN1J6TnIz7G3P5lLq9n0LZHoOhIFu45CoDPOEpO4I2xZAn4fhuVY",
-	"YEqBErnuX+LPO450h0k2F4s9IbDNne0GAv4yEmVYYeP3aYJ4VSz1rUG0KGCJfTSRaGc6UTyxrCG0gyNl",
-	"9GFX1o68/n96dgdP9I/XhuMjrdHXkZ3l3FHBuYkTsAc5eHi2D5DK7FwaCIOW+XcbcYCN+F23f2u6LUEQ",
-	"TMmvkCWyVJI92dLWlJD7dmmz/ZMyUg7kr45Z6NKBaH8k5ZSCgVrzX0easZUGd+ngKhNcCDokBD+ryZLb",
-	"NZgJcbcU+wPrvkU8gB3LmOuWBhJ7F20zoQLTxeCtffv40hEm1FK/g1KhRrUeQu9ckA1WkJA8wVnWcTU1",
-	"mSL9EaTcvQcnTJIMdgpSOjfRYVRvJrkPZxPihg6avJhTknZjMDXf60j8gRdKw/2HOuDodgUCmthJJACn",
-	"KzyncM3OzmenR+jKlyHog8Rny6oJ7IVComCMsGWz/CUjmf6WwYIwrUZbc68gTV2PucXG6Q2wDGUczCKy",
-	"yM3FgwD9j/bjLZ410i94kNiWHE9Day/3wzL2Tth9vv6ZtPvyMTV7Vz92hblL91ukimva3WMYvt1dgz+8",
-	"epPez+LmhN2TtqA3CokCnxuOwgBXoN/Rbm0gbHojycv8xp60TC0Z0jz6Kx8kdB4e6iA0SqDa80M7N6nV",
-	"S4kge7r83xaRgpLvL9DNFXtb5PzPw9LQfrUJUyByAcpk/uza+5LRfu4/MCXZPeaV1+lu2qfWPZT5vZcI",
-	"zSUCCujvuKGHXEl3EjEEUTl874377vZ9qIR9HNLxq1SiSMNGR08ZjlFj9F6E6hsPGLzge1DuQQOLZbH2",
-	"tcqD7GVg2WO3SPCu3jBHOyvJDj3bWaLqe7eBJTKRNyTPIQuVNsVRziXxOxyIxtRP7eGHNy0V3TpRbMA6",
-	"kEElJUOM6iWKgFyA1KZO28gwbWo2Vi9nQuSDYrtqVmjPgThOaxzaDTVosWYDD0Zt+DsJQgmDQ0pBPa7l",
-	"om6J2MM0ELeuy8y6BImOMTU6h4cPZlNg+iE2p3tOHef2SRbAuj7o2xwOQRj6MLso2FvCiFxBdroJqqIo",
-	"tNthhyQQHqO1o2CJLNIUpFwUdK9GVtUle46S1sp7aRAAeA8FwuWCZSqpKtH7YIvuDjo5LgrmL/I+aCUL",
-	"nBq1oVPBlzpADhS7uC9J+PhOCyGAqUQqyMshw4vMGtMPu0/m2t/U8+R9bFAb7uaSYdD2CkGTWnv4f1F7",
-	"YbO3oNKXcQ7zhXvqU4e6tF5AO0ozuuRsiDxfVC94DnXtq5N/sGt+kCNf14Whc9pH0wG7Nc3g0IlX2LwX",
-	"OABEW2RT8W030mnJ4KYc8G3OlNb+fdrk6dGC8XbPh+Hg707Yi4DfOgw3F2Bq23zh1FvB1y4T1AZ22EVN",
-	"s+AsmEDjhUhtbN/xInIuOS0UIDvSvoCqpUftr3ajslyA58SVC+zPsdUA6ClgM+S5gnmDQiHTwgWgK5ij",
-	"ZpngwIuJw+lXCBpIXl58QIojfzlnXyP5gsVBVNHL9lCjXQgVTBLOTqYuQXh5Mpv67OCbaS0zODvRf+nP",
-	"URzpT5/i0HMPf44roqj+5rN36OL0crYoKDqeTqI42oCQbvvRH0evNKg8B4ZzEh1Ffxq9Gr2K7Os6w4Ox",
-	"y7brP+7i6s/xikjFxXb356/tp6x3Q8aMPe3NrkuwhYw52EKwSRYdRe9Anbol3L/VbdlxOTtuPCvuOKGq",
-	"IePA09u7TzuvWH569eqgNyyDXL6u+svdAu/WK5fL0r31L+bMfbi9Su3atcRnbJ/kmJcxxXqNNQujD0Qq",
-	"89KqqUymUghrD+YX/0Q5Mn7FUF6OTZ3US5+SzbkM8HXK5QDGftArvbUZzUfisH8dte0mWu0B1Xjn9dTd",
-	"A4XkPvc8nSXCbV/vWeTGwiBdOVyjbN7X0pdWSMOOLrdSwfrBYiVgzRW05GrnoABKpa/oqz/Kq1l9vCPx",
-	"VRXgLcz3oHAfKb4wgD+hGD/KO7vwQR4Sqe6jvPlS8aHKcg9D+iwKcJxlyAqjlX7Fn0bg3eWkHH9tPwu9",
-	"u5cKpDzfdog/rj+bf2QFcM6ybNW7PIo+xHtnBV7VPqkWdUYLd0138reuJI7uL+TT6snXQMeXu4d5lP4/",
-	"nl1UQ81rnt81rZcXfBvndMJkDqkqn9YDc+WtrWd6jy86Y+8pPLYM+RLPfx9Z4qkC9dI+ZT+4McAziImn",
-	"qOxx6h7R5rjM7v6B/ui+lwT54/KxxGSHC0C1YnkItVVegjKVapqj7qY20FZDVmAFTMu+J8ufvkHkVK/j",
-	"/EbB0oVruJWBwoSazh8lnRGe88KE4LJixy1RK1fTXyvWvIeslt5jatsp+Bvmw8NxL48n1ULfcRzju0cM",
-	"97WePmKWICoO77SqeCh7q5zc/S3Ne7fGv00eLVA5/238FK/fQbVG8IVIUxZ8Xbx69dNfkOUVSTFFNWv6",
-	"IPZ3xYb3loXvLkh7ouCocTh8N2cB0m4vWVQl5E8hH+O0alfygPOgJSm1Pie/rai+3uIlICs7TV6eL8Bv",
-	"N3Z5Hkm29QFgs0xLsgFWNjtzj02wf2BRSwJUtfVPIdO+x6kcf601Rrwb4512FM4wNmn02rSis83nKpxM",
-	"q8BW79SyH11cvt4mOlAVvFiuELazPKXjx7DAZQeIKRfKNv07bnaH/Fb6tn9WvUnlgOH13qxDVsdqNWRc",
-	"T2vUAbN9A8XhQ++5Ubtx7qAtay0yu47MoEl4sB04MZ1KF7z0bne7lj6+mg8PwV29yf2cIT/5e/CIh1QN",
-	"NTJ2z3IGvAOFKJaqelArCnZflrslxu5ZjOy+3fC3j7j1kHeEZisikVSQ20eB9hT2DTHq7TNSzBrtOE07",
-	"4ECbkoOuO3Ymy+/8zvppLEI3ex5LNMZfq7dTfbdg9bbnpv5JCbJcuh4yVXcU7bNkkFO+XZc55rZkvd76",
-	"h/QxIuoFpdfMugMIM9uO0/YCFeDqrmzzl88dXTM/2yaxTgx9R/NGH0+93DXbEFw1Eh2hiWlEQyVHOZeS",
-	"zO2liu2jazLlpq0xy2xnJf/ivULWXAGucwrKQ6hWcM1Mog+Vkog+Bzrofh49TBvcv5Pnig5qD+wGH9vN",
-	"lsFPFUwE7Mwz3wuGCpufOXBoKxniNutZSBAvJJrDCtPFQ42GRUc+sZnQBvd3G3F/G3HpuPQ8luEb6brr",
-	"r/Mfrep2y0fWdPsU3w5dEgZjVxOrfykNQSnfOqwIK1wVW7gmCF4Km6QyNYkI2IYIzszbRFdsbP53RY7G",
-	"DoqRKY9bcamOTD7iboxzEsXRBguC59S7uL4jpCN09Le//vVvtSpg8+cnzbBWwwzBM/uQAZ1QXmSdEMkS",
-	"pJdf7b8W25FpyDS6cbejo5SvQyDWpjQhfVX7Py1Sn+7+PwAA//9fRf2362cAAA==",
+	"H4sIAAAAAAAC/+w9a3PbuLV/BcN7Z9LtMFaatttb30+O4ySeJrbGj+burDMMRB5JqEGABUA72oz/+x28",
+	"+BBBilL8SHe3H5q18Drn4LyBA36NUp4XnAFTMtr/GhVY4BwUCPMXForMcaoSkgFTZE5A6J8zkKkghSKc",
+	"RfvRxRKQ74gYzgFxgcqSZFEcEd2hwGoZxZFuivaDc8aRgH+XREAW7StRQhzJdAk51oupVaGHSSUIW0R3",
+	"d3EELKX4BgaBurw8fh0jueRCAYMM2b+5cADOkVoCchOF4QyssiWYXwpIFWSJAFlwJqEL5bGHIys4YQoJ",
+	"UKVgEqklkegG0xJi00GCuCEpoFtCKZoByrG4hgxhifANJhTPKKA/wN5iD70DSjn6yAXNftjziP27BLFq",
+	"YNYBbBiRpVJFkoNa8iy8++8uLqbIdkClhAwpjtIlpNcePEKJWu2h1zDHJVWISPT26KIPvOZyTcD+W8A8",
+	"2o/+a1Jz7MS2ysk7pYoPZshBY0UDPWFEEUyTDCheJTmhlEhIOctkGBlW5jMQmkWafTVKt5goVDJFKIIv",
+	"kJaKsIXZnjkRUlkqpJjSHrwGAGmiOecix8r0V39+GcV+QwhTsABhcCpweo0XmjfDOLh2VPMuUkusKv6x",
+	"4EOPhDZm347jzTQ9AKmllzrPzJ5J9tCxQnkpFXumkFRYaDjVskFZSbFc7qE3XCDCpMIsBfTZTTNZAqZq",
+	"+bmH6A6zQai5UInd9R7guVCeLYKc3UPGxrxDdByz4Xo0SJXMeLbqVSMNwdEiJkFpcKen5xdxQ6NUTCCB",
+	"GRWih+p5/f40MUNu4T5ZbcE1TGYBShAICN0H/KUhdJUUIawU5IWSlnUNAgZ0x7xOEhfkRothWSDMMqdA",
+	"9Q+YIRCCi17ALTTbb4QZN0qbnAxrkhmoWwCGalAGAL0PrWGnuoGE8oVMsFyxNMhLc0wlxGhGeXptmAp5",
+	"Q+ForndHz4GwgNoCtfS7ZvJBjNpgBFhnxjkFzAzkTtY3uiGuX1PvOaZOOVOYMK8I7U95rnlGLnlJs6Za",
+	"RISFRToAxzYashbkVzxzsjAnFC4LynH2ysm2BhWY0v+Zl1SRAgs10fv7PMPKzBvY9hlh2BC5s6Zd1e6f",
+	"WfGEq9PrtYVwUVCSYk3Kyb8kZ+1VhizvmZv6mM25RXHNEWPe3XDyaPbTDtZzH+j9P1dYUCyuj6xZ5ew9",
+	"XwQE6lICIkapGa5ZCs54KekKeZYy+wp+EsujNwSjjzCTPL0GJbULaFhaKgE41zSKo0LwAoRyO2LmTqQD",
+	"KammM/zaBaqC2fiY2wHXWTuwqvGkezSqZ7yfewd+qjiCz/4FqeoMHMa2OzyODjlj+j87lHiBnqPD05OT",
+	"o8MLNJmgVyAVgvlcW09jQudc3GKREba4Yn9Cz9HJadLoPm13QRmRWqtoHwRYmWtYXe8ojuqhDRA9aQyI",
+	"VtpNSNOicJpnCRZ2I4mCXAZoW82IhcCryIQcSqyMj77T4JvkBruIKsuIJhem0xZYfZPUZCe59smsIgr0",
+	"lwqrUm6S1oow57Z7gI30z63VutjHNRUb2PXwSmu9IM+cX5xOp0evkeWKs8uTk+OTt+iKvUTP0eXJP05O",
+	"P540mMD1juLI9YziyPcK8YKWz0Or6sPCi3zrujA6A7H1jq/RtDVNiEgNCM9AllR1uRa+EJWkPINRtj6O",
+	"KF8kvFRFGRDTAynLHCS6vHjz/H8QsJRnVg0Oa5gahNb0IYTeEAoHLsx/3Vx7Ha1wwDDVwYIAihW5MWGC",
+	"jQRonWaIAvssyS+BCPuc/FKF+3qKGBGGZitl/K0mIX/8S5CQCr6opBBwQ+A2QEo0I8pMD18UcvY0RmTe",
+	"AJlSfivRHyTJCcUmgLg8Of6/ZxI9WwLOnv2wkfA+hNH4baL2GcxBAEsDlNDdJPIdUcuFae+K1zHdjEqT",
+	"krLajVirbR34o9ul8WctDFqJE6XpbYaUqhQQ2jhv4B5luTXaujSVwXgTba2X1iehGsakym2Vpm8iqs6c",
+	"wek82v95WD2Ht/Iu3sYF+3R314OI9FO/I1JxsepBotfE3ICQhLOAFv+na+nbME4zkMpG81FcK9JNtKgg",
+	"dgts1LU1Ag1wP22ih5+96ywIwDpRhtUmaC9IDlLhvNCTGygG2LpkGQh0uyTpsgpOgFlFYuJ0C48J4hUX",
+	"kP2v6Uax0lT0rXKJBdjwvV9YQgJHZGKnCkVbFd3WLU1QQa6R3w9tkqC5XmgndOruHeAs5Kz1s6LOZGz2",
+	"ij0nmN59iwfyhvtfK3fj7ZH2N3USJehdHGs/6TW/ZVrePzgD3XVxDt5/PPjp3Hk4H47Pz63f4hexzVEc",
+	"+abQUv8oheKSyDeAtW57Q/EivNjJaXJ8cn5xdnl4cXx6cp4cHhy+a6/X1yO07JRi9hPOacB6U8ySlWsK",
+	"pM0oZp4pfYCHRMlik7dGPx18eI8ynpY5MLXZCFZrhbZRxw4B268FJEOnpptEf7iUkKFXK/TBRNUU0JHL",
+	"u8sfukFgHRAnheCKp5wGObFOHI5wy5TATJq8YHPOQb3iR0z9gLs40umjRJEceKnCtNc9kOuBslIYRLRt",
+	"dIBvIneVtgxAHNqAlgnqqtF1x7XsJ1EOUuIFDLjZ44zhhe67jpaZoF4jtpANIXThlvSSc3R2dnoWxdHx",
+	"yZvTKI4+Hpyd9AnOGZhk9pRTkq56JPXon0dnTitUOqASUt0YxV45BJcomZesqU3aB4hPOYOkqJvbYHxc",
+	"glqatFh9bFBnxMzgzJybcbV3xersoNK+V3OQTysXJaWQobnguWk/mB4fIspTTOv5FRewh47niKhnWhe0",
+	"m83URJozgSu2xDeAZgAMWacK9BmTdvasP7aGPyoE4TZNjynV3bo0sniYZF0/Gg5zg8Zbot6VMzSDORfN",
+	"FI7ZXxnFASOaUl5miT+tCB7UuGypSQhDXqhVyFbbeUoJYvc5MrFKRMmGR5ttDaKi83eCaC2NaTK3xqcd",
+	"Dw/JY8hqBXIkC6KW5SzBpVomil8D2xFXm7fInEFOcqd5hiDsmnCthjBhybxkqbEA4YjInBY2jpP1GOTH",
+	"2IPbzMLsTl6IRFeRKNlVFAKdcZaYnDthiwrwXTZMn+FTCpTIfHiKv6zFwD0qWc+XD2SvjCR1Ynh/jwDp",
+	"rLUJ2VqOgJe3FtGigCb2iYBEx8GJ4ondGkJ7dsT3dzPrGFz/nx7dsyf6xyuz43taoq8iO8pFkoJz45lj",
+	"D3LQeHYNSK12zg2EQc38u47YQkf8Ltu/NtmWIAim5BfIElkJyYaDjs6QkPt2bg/qjqskVyBpccBC54VE",
+	"+yMppxQM1Hr/dRgbW25w54XuUpGLb8dkz04avORWDSYxbVOyOSc2NIkHsGcac1LaQmLjpN1NqMGMfZi9",
+	"tu7QvvSECY1Tm1GnGNGdW3xXeheC3GAFCSkSnGU9p8rHU6QbQcq1GRFhkmSwdpesdxEdRg0eAg3hbELc",
+	"kKEpyhklaT8GU9PeROKPvFQa7j82AdfJVAFt7CQSgNOlPoa7YienF0f76KO/QaQNiU901wP0FR5RMkbY",
+	"on1zLSOZbstgTpgWo5U5EpTmSp7uN8PpNbAMZRzMJLIsdAckQP+j/XiLZ4P0cx4ktiXHw9Da8/24wzbH",
+	"7P6o7ZGk+/w+JXtdPtaZuU/2O6SKG9I9oBie7pjQG6/B86pHcXPC7kmX0Vt3AAPNLUdhhCsw7Gh3FhA2",
+	"vZEUVX5jQ1qmkQxpm/7aBwnZw20dhNbtxe740Mptag1SIrg9ff5vh0hBzvd3X8ztmC7L+Z/HnSD52Y6Z",
+	"AlEIUCbzZ+e+i8eN/SemJNthXHUTxg37tL41FpdBIrSnCAiga09ggFxJfxIxBFHVfeNlmfXlh1AJ+zik",
+	"51epRJmGlY4eMh6jVu+NCDUXHtF5zjegPIAGFguT6h+vLwPTHrhJQnrTbo52VpI1enazRHV7v4IlMpHX",
+	"pCggC5+TFVwSv8KWaEz90IH98Kqlplsvii1YR25QRcnQRg0SRUAhQAJTRkeGadPQsXq6kcd1wVGhNUfi",
+	"OG3s0HqoQcucjTSMWvH3EoQSBtvc4m6eVLsdNlPEHqaRuPXdQ2hykOjp06BzuPvobQoM30bn9I+JoyFL",
+	"FsC62elpjEMQhiHM3HFE/wHrb/XoZgHKhHxESXOKvOOBTTCB9gTJv9/z5zvkzz2iZyV7QxiRS8iOboL2",
+	"SpQaHdslgXAfbUJKlsgyTUHKeUk3mq369uQGf6sz80ZFEQD40zAFwtfhK16or6C/t5fKt3KvzkrmT7vf",
+	"EwYh16rRdSr4QoCUXRoXriUJ+7hpKQQwlUgFRdVl/CXq1vDtLl1wHZTpcXIXQ92Fuz1lGLSNTNCm1ob9",
+	"P2tUkG4sGPBlCuMCxoH6i7Fxn2fQnquHfXw2hp/P6grVbePf2j0eHb9uFe02ZeEu3hoq579tsVpbDY4d",
+	"+BGbergtQLSXSOOofXzZ76X8Wiyq7fyUh1Y9+ZcOxW+qDk/j6XbWH8LGM2AHxtsNDePBXx+wEQG/dBhu",
+	"LqB1PfiN4LnLT/dfUR3m982XciUvRWqdtPB0eCY5LRUg29OWVDcObeyvdqHKJ+YFcT7x5sx/A4CBG/GG",
+	"PB9h1qJQSJdzAbruDrXrDjrHpTMsSWrv/RRYylsuslBFiG0xQmsqhM0wpIcBU+6iZlV4cXn2HjnUJCJB",
+	"cjeWLSWInpIH13J/y7qb3olc4pd//bG74jv48txU5ECGzt8dPH/51x/rglV/8wGy6sa4jrOQDrP20Ecd",
+	"GElQcdWIqQCcrZrjXGE9kVfMlLHLMrfHfObZBsKkAmzOjPwYV1WBF5iwEEJLc3NbhjAxDUhaSDhb1FX9",
+	"rgjWPVmBkbnwVSnkJZfKnldVzytQ+GGsV9u4Th7wIu9LWksRuPSs91/xinRmKj/JOBnU0w7IXl1k0PRj",
+	"M6zguSJ58LSwe384eLZ2cTh152rnhxdTf6j2eto4ULs41H/pZn2S9noaOEWzz21Yz14RRXWbP/RCZ0fn",
+	"F/OS6qRC1CgyiF7s/WnvhQaVF8BwQaL96M97L/ZeRPY9CbPhE3dIrf+4i+s/J8uqkqX189fu4y13Y/pM",
+	"/HaZVRdgS3cKsPenj7NoP3oL6shN4f6tL5kcVKPj1kM6PT5r3WXShSW6+7RWt/3yxYutqrZHV9mEKo7W",
+	"y2w6dd3nVcDrxTkyfYwT17dqhc/EFqHraWWZ51hvYfSeSGXeFmjLn6amwjqm+dk/yhOZSGPsXk5Mjuq5",
+	"T3kUXAb2dcrliI19r2d6Yw8C72mH/XsAq36iNZ4MmKy9F3D3jUyyy/WI3qK4bvT3KHxjYZAuFdkqFPXV",
+	"o5UW0rCj85VUkH8zWwnIuYIOX63ZFqBU+mxq8xmKhqHAaxxfZ2BvYbYBhV24+MwA/oBsfC8vS4Q9zRBL",
+	"9fua7bc5vlVYdlCkjyIAB1mGLDNa7lf8YRje3emRk6/dh1DudhKBlBerHvbHzYei7lkAXDQnO9dE70Ue",
+	"4o2juuR7WCnqDWfv7u5+S0Li6P5MPqycfA28cXj3bR6l/49HZ9UAKk/gmjZv5T2Nc3rMZAGpWq/Xxt2H",
+	"Ke6fdSbeU7hvHvKVEf85vMRTBeq5fbxp66ewHoFNPEXlgFP3eDqnER97vmlTQIdc1jTfNJ5wwIMPOMTu",
+	"MQKpEGf6fN/nadw5mBePKL4fJvWvVfwH67vR71x4XB+HWd+Cau49ctwSYoEd+dOdRW7u6F3LnTScd+fu",
+	"i0XWCA9UK34PobugYgoQtMZxF/ACDx3KGqyA6dv0iNSnJ4jsm+U5TxTMn7knkDNQmFDImnRGeMZLkyKS",
+	"9XborLK7atKowdmBV6voJrUP3PmLg9unizw/HtYTfcdxtoNyi1jg4TM6EkS9w2uPB37r9nZt4vaa5j5t",
+	"0qM404GCyKfxo718B8UawRcizdW/q/LFi5c/OotEdF6voU2/afv7chc788J3l0R4IEelZRy+G1uAZAEp",
+	"mdeVgQ/BH5O0fkDyG+xBh1MaL0/+urJOzUc3A7yy9uzm4yWguk9tPg4n2xtt7rW2BbkBVj0/7WqIsa+b",
+	"bSSp6pLJh+Bp/9UJOfnaeKr+boLXXkILBo6vzOPg9jnwGidzR6LzNYvqhfC4epSH6MBD8HKxRNiO8pSO",
+	"70MDVw976Upe+wz7Qfu9/qeSt82jGnsxpnvzaxljZsdqOabfwMcqRoz2T9qP77rjQt1PmYxasvHRgj6T",
+	"GVQJ36wHDs23I/RNF3+4sfYdifsX8/EhuLuwt5sz5Ad/Dx7xmHuurYzyo2VbTNas+Rjirlvuppi4mgrZ",
+	"f/rmT8dxp3ZkD10szROjUNhLYNYK+3fOmkU2KWatDySYq1yB1+e2Oo5bGyy/8zsVD6MR+rfnvlhj8rUu",
+	"iR86pW1+iMpcIFWCLBauvqx+9E77LBkUlK/y6gyky1mvVv5Cd6wrxSi9YtYdQJjZDyTYrzMIcBdXbWHY",
+	"557vGHy2tyAdG/pvTLW+rKCnu2L6aw/Vpx30l4V0kRqVHBVcSjKzh372yyZ6kP3QDMvsg5n+BmKNrDmi",
+	"zgsKykOolnDFTKIPVZyIPge+afJ579ukwf17/FjRQc0k4812C+EHCyYCeuaRz61DpTiPHDh0hQxxm/Us",
+	"JYhnEs1gien8QZTGRBdh9muOqkI3CKU2FTpSrz+ZRlTjW002JPJvBd9qKScK3Zoq1oyHnwq+D6nS9SxP",
+	"IFkPdssjXFL8yHLSXvfRzq8899y/DbUIyAe2mtr/+N1k7m4yz90uPY44P5Hps0j+ti2fXfJBDJ+T9J1M",
+	"nQPr+7B0Thjuzb49sMVaqy79zRqs6nmyHbnYPptnuy4Ig4krxNG/VExeaWmdKwqbjTph5B4s9KyzdnHI",
+	"3LUHdkMEZ45ZTVGU+Xzv/sRBsWfu5OuKrn2TZL6b4IKYL3IIojPCzrL6rzc40kZ//9vf/t4oPTJ/ftJb",
+	"1CkMFDyz9dToUL+C3AuRrEB6/tX+a7HdM48n7127K1l7Kc9DIDaGtCF90fifZqJPd/8/ANsPJK1SewAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
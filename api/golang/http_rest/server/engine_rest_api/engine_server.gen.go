@@ -750,34 +750,34 @@ func (sh *strictHandler) GetEngineInfo(ctx echo.Context) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RZW2/bNhT+KwK3R9XK2oeifssSrzXW2obtYBuKQGXEY5uNRKok5TYw/N8HUtTNohU5",
-	"TQw0T5Z0rt+58BxmhyKepJwBUxINdyjFAiegQJgnYFGMtxBSAkzRFQWh3xKQkaCpopyhIbq5GV/7ntxw",
-	"oYAB8fJnLjyGE/D4ylMb8Kwg5COqeVKsNshHmgINXVp8JOBbRgUQNFQiAx/JaAMJ1urVQ6q5pBKUrdF+",
-	"r2kTvoUQx3HbvPHKMwK8nMjDcVxYIwfeVG1AfKcSPM7ih4JGKp6mQGp017DCWaw8Kr0VjmXpyLcMxEPl",
-	"Sc0Qh8V3nMeAGdrnNsuUMwkG5wlX03v9I+JMAVP6J07TmEZYuxF8ldqXXU3k7wJWaIh+C6rwBflXGcyt",
-	"6DFb8VzZQcQY/EghUtpDIbgwGFpmLfsypVecKUwZiGu4y9afOIEcWYMCGhoMfAQsS9Dwc/6kUb71W776",
-	"DXELhVVmU8vwovnNZDKevEc+Wiyns9noGvloMp2Eo3/Hi+VoskSVzCLiProSgBWMbFLptBU8BaFoDidO",
-	"aRgVKsOYr8MYtuBIDivBi/naMyS+Z32UnuLeePLXFDnUN+VvQUjKWajw2pGefpneeY44CBILb1dMraUm",
-	"EjpaG57FJMQpjUKRsZCykOhIhX1kueObJ2VRdJ+bZnf5XAWI332FSGn7riEGjfEiSxIsHtohyiuFhHUt",
-	"IWYkzDJKDAVVkMiesExwApeM3GSUaO3WHCwEfjB+teyzfJezcYnEBy7VJxxtKMsLp2XyWqRRmHKhQs7C",
-	"DZcqTHLyWlApU7AGoVXQtIOu0bwqzB08fofe236eud25E5SsIaRpiAkRIKUzN6uQU+IkqKyjTFICRUSP",
-	"gnKU7AgmDQtcEjps8B1edoA2Lg8g2caryFSdoE4kjtZ3eTYe4z1SeYbaym1J6XLDGe9mAdfzKKSWoUel",
-	"dVVMqzM+Ua5bmCyPjr69zR429SyWPcVYq2oS9IljOh5NHm2wS5qAVDhJ6+3/aN48of2fN9Vc+B2JziFQ",
-	"1ruOZC1mi2IeWI4WS+Sj2Xx6fXO1HE8nzgHA0fdbCX8UpH7QWCweK7Z+E83o02z5X5cnSyzWoNrCtIgj",
-	"fOujJxWYb8Uh3ScLGvQubxszZUthZIO44iLBCg1RRpl687qanWpHQAJS4rU7MvmLftPtUtMeemIEVDr8",
-	"3LIuh5ZWZQH4aD6fzpGP7Oz3z+XcBNMVgqrM664TrOCVTf5D2PUJaAFUVMX629+ZUFxS6c1Hi+Uqi73L",
-	"2Rj5qIwduhj8MbjQ6ngKDKcUDdGbwcXgAvlmjTL4B8Wmko+4MShoD7tmJnMtN8upl/OY5ai2rkkvk2Be",
-	"mD3HK5dD70u16XzxkLFNmLIfk1LTqLDJb2yVn93hrUiC2ha1vz3YlF5fXDzbnnQ4pDpWpUUWRSCljkth",
-	"BjJEdhFyKygtDvLFzuxXxSRcxAEqeBRey6oO0a0eq8BIb+L6HlQN1J/CBRNC9ScczxqV3OMQKo7ng5I6",
-	"D3gfqVSPQJdy6cBuxmUTvG8ZSPUnJw/Plk/NrXTf7E16Pd6/YDI3gnOeWOT+1u52WsHY+1VrCjZUKp7v",
-	"go9l9wdL+pN4nbJC1mf/9gp5vtyu3U95tGbTY+ju2rdo++Zp0NWjWyCc3LQdl3jHmvc5WqxUgj90pebj",
-	"LfasmPyqPeA9lO3YI6AwjYF4ZsZ5SsIGWCi6wpG5iz6BOoh5hONXK6pHqpMY9ayh4CmcEsSWRprO/jqo",
-	"vFNk7Yqfzy0jIPw7izkmvYTFfN0P+ML3k4iDiDMGUZ6Mp/BVB8cJTE+OSqcEXRgJZuQZJAEjKae6wnbm",
-	"6oplyZ2J6BbTGN/RmKpncPmEmCosYizuTyIOUhzd4zXIp3EFO/srpGR/moi8sfXWa9fqk3v+orjX+CU6",
-	"f3Ffdf7eX97/PGkgf3HYn3/Yd93b9B/5Xz44i17ByWtHPwTFxcTxEikvnF40kUstZ81irbVzbikbD/yA",
-	"KNP26IZrX4blS3NjWnVdrQnEtkjfpjcf9dDiAdtSwVmiofNRJmI0RBul0mFgIzMww82GSzU0J8U+wClF",
-	"PtpiQfFdnMdAf2j8ixa9e/v2HSr/R5s/3mpMD82YCU4ycyx7VzHPyFGLZGnSq529MjTeDiLNNri3N1mD",
-	"iCcuE2ssTUsvan866rf7/wMAAP//IQrWlZYgAAA=",
+	"H4sIAAAAAAAC/9RZW3PauBf/Kh79/48uzrYPnfKWTdiW2RYYILO708m4inUANbLkSjJthuG770iWb1gQ",
+	"SEl22pdi+1x/56JzlA1KRJoJDlwr1N+gDEucggZpn4AnDK8hpgS4pgsK0rwloBJJM00FR310czO8DgO1",
+	"ElIDBxIUz0IGHKcQiEWgVxA4QShE1PBkWK9QiAwF6vu0hEjCt5xKIKivZQ4hUskKUmzU64fMcCktKV+i",
+	"7dbQpmINMWasa95wEVgBQUEUYMZKa1QvGOsVyO9UQSA4eyhplBZZBqRBdw0LnDMdUBUsMFOVI99ykA+1",
+	"Jw1DPBbfCcEAc7QtbFaZ4AosziOhx/fmRyK4Bq7NT5xljCbYuBF9VcaXTUPk/yUsUB/9L6rDFxVfVTR1",
+	"ood8IQplOxHj8CODRBsPpRTSYuiYjezLjF4JrjHlIK/hLl9+EgQKZC0KqG8xCBHwPEX9z8WTQfk27Pga",
+	"tsTNNNa5Sy3Li6Y3o9Fw9B6FaDYfTyaDaxSi0XgUD/4ezuaD0RzdhrsRD9GVBKxh4JLKpK0UGUhNCzhx",
+	"RuOkVBkzsYwZrMGTHE5CwMQysCRh4HxUgRbBcPTHGHnUt+WvQSoqeKzx0pOeYZXeRY54CFIH76GYOktt",
+	"JEy0ViJnJMYZTWKZ85jymJhIxcfI8sd3u20W3ee22Yd8rgMk7r5Coo1918DAYDzL0xTLh26IikohcVNL",
+	"jDmJ85wSS0E1pOpIWEY4hUtObnJK0LYyB0uJH9C2flHb5/guJ8MKiQ9C6U84WVFeFE7H5KXMkjgTUseC",
+	"xyuhdJwW5I2gUq5hCdKooNkBulbzqjH38IQH9N4e55nfnTtJyRJimsWYEAlKeXOzDjklXoLaOsoVJVBG",
+	"dC8oe8n2YNKywCfhgA2hx8sDoA2rA0h18Soz1SSoF4m99V2djft491SepXZyO1IOueGNd7uAm3kUU8dw",
+	"RKUdqphOZ3yiXL8wVR0dx/Y2d9g0s1gdKcZZ1ZBgThzb8Wj6aIOd0xSUxmnWbP978+YJ7f9lU82H357o",
+	"7ALlvDuQrOVsUc4D88FsjkI0mY6vb67mw/HIOwB4+n4n4feCdBw0DovHiu24iWbwaTL/55AncyyXoLvC",
+	"jIg9fMu9JxXYb+UhfUwWtOh93rZmyo7CxAVxIWSKNeqjnHL95jUKPUdACkrhpT8yxYvjptu5od31xAqo",
+	"dYSFZYccmjuVJeCD6XQ8RSFys99fl1MbTF8I6jJvuk6whlcu+XdhNyegA1BTzcy3P3OphaIqmA5m80XO",
+	"gsvJEIWoih266P3WuzDqRAYcZxT10ZveRe8ChXaNsvhH5aZSjLgMNHSHXTuT+Zab+TgoeOxy1FjXVJAr",
+	"sC/snhNUy2Hwpd50vgTI2iZt2Q9JpWlQ2hS2tsrP/vDWJFEtG21vdzal1xcXZ9uTdodUz6o0y5MElDJx",
+	"Kc1AlsgtQn4FlcVRsdgZucopqeIANTwaL1Vdh+jWjFVgpbdxfQ+6AepP4YIJoeYTZpNWJR9xCJXH805J",
+	"vQx4H6nSj0CXCeXBbiJUG7xvOSj9uyAPZ8un9la6bfcmsx5vnzGZW8F5mVgU/jbudjrB2IZ1a4pWVGlR",
+	"7IKPZfcHR/qTeJ2yQjZn/+4K+XK53bifCmjDpsfQ3XRv0bbt0+BQj+6AcHLT7qrf27xfosUqLcXDodR8",
+	"vMW+KCa/ag94D1U7DghoTBmQwM44T0nYCEtNFzixd9EnUEdMJJi9WlAGJzKaWUPDUzgVyDVNDJ37tVN5",
+	"p8jalD/PLSMi4jtnApOzCKs7+OOymFgeF8QSx5OIo0RwDkmR2KfwneLCz0f4oARTZCnm5AySgJNMUFOt",
+	"G3sNxvP0zgZ0jSnDd5RRfQaXT4ipxpJheX8ScZTh5B4vQT2NK9q4XzEl2zOIiDKG+WlyimarnsR0kjp3",
+	"SXDyCTYrb2l+iXPMWfsfnGTVbdaT1otnh/38q4vvFur4Beb5gzM7KjhF7ZiHqLxm2V8i1fXZsyZypeVF",
+	"s9hoPTiFVd0HfkCSG3tMy3cv4+qlvf+t+77RBHJdpm/bm49mBAuAr6kUPAWuUYhyyVAfrbTO+pGLTM+O",
+	"aubPD317Vm0jnFEUojWWFN+xIgbmQ+sPzujd27fvUPUX5+Lx1mC6a8ZECpLbwSC4YiIney1SlUmvNsX/",
+	"hbe9xLD17t29XC8Rqc/EBkvb0ovGPxP12+2/AwA7igdPZCEAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -197,40 +197,40 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa3W/buhX/VwhuwDZAtbLeh4vrt6LX7Q2WJUHirhdoA11aOrLZUKTKD7de4P99IEXJ",
-	"+qBlOQtQDFheYlPnm+f8eHToJ5yKohQcuFZ4/oRLIkkBGqT7lgr+xfBU0y0kOWX1MuV4jr8akDscYU4K",
-	"wPMgaYRVuoGCOB4NhWP+s4Qcz/Gf4oPiuCJT8ZVYX1EO7xw/3kdY70ornEhJdni/jzDwlJEtJDQDrmlO",
-	"QVqZGahU0lJTYS378OHy1wipjZAaOGSo+i4ksqYikSO9AeQF4ajypiR6c3AmoCXCEr4aKiHDcy0NtH3z",
-	"ViotKV87M3PBmPiWMLE+GrA2SUDYSggGhDtp3BSWLmGUw1F5XaKARMo1rG1Y99YXbSRPCGOjNvbJTtip",
-	"QG5pOr45yw0gT4cOdPWupIJrQjlIpDdE+6WiIDyz+2lYhlaA4DukRkOGKA9vX8CO87avFmAMzRIF+lh8",
-	"BnRjapoK6OkLZLnSRDIiH5PKVSq4UxGOpuH0q+kEUwukJUkfq0SvRdgYE3TvRaNKjC2LkqSPZH2kFI6Z",
-	"ck5AXcKpUnBVpe+10DePHl80cBdeUpaMpsQqiL8o69xTS+IYaNx50Zc8F5WyHhxw+F5CahMGpBRVAXhm",
-	"K9uDjkM/KUqQmlZmMr86deMirGkBSpOiPGXzsiGsglNH8lOltC3poVEjVl8g1VZPFycHhtvPRAs5EW5v",
-	"anLrAXzXSUm0BsnDtdE2tlHUYxyx+aZlG3BTWDG/3izuk7c318s3l9fJcvH7EkfV2vXNMrher/3zzfLt",
-	"b8nd4v3i9xBL+/HBpMP2dTJnEMVUZG77cyELovEcG8r1T69xNADUCBeglC2h40kyLYeXlrYfZSfgoCOq",
-	"LAvFuCOmFeDF3d3NHY7w5fW7Gxzhj2/uri+v3wdjcl9B2pU/Fboh4UInuTDcgl0AISfXSc1tD5VktetI",
-	"c2CQZdRWL2G3Hf0Tkrmlro7LPhCpGgYXDhEGjkK9LDjc5Hj+aVx3Le2Sa5ClBO1wrJK9j6bx/oswmj2D",
-	"b1Hjsmd76KdP5cvDWBC6IobRaKAfRsKVHC+CkEUN+UNowzrkPfVjroRLmR5ZVVqatDomQizTPepQn3So",
-	"rXgCcS5OuDziBpFrU9TN/aQePCD2jRcSKuVqc8iKQdKL5wAFWs+TqrsIEalEPdKyhCzUZEa4FIrWGs50",
-	"47ZmHdmPyrCoFbejLnZsnbhBTSRDGzUaFAmlBAXcQssWwrFRIClh9N+QJVbcljAzIXeDXCGdE328be1Q",
-	"/0RlpuCdM/X4kZpTBkcDUrdmJ+X0fG2ERnWj5W2a6NsdKMP0KJQk8ghNK85h8snbFGA/B3OO87R9Hp5k",
-	"Aa/bRD/mcAjaMObZneHvKKdqA9liGyxFaXiSe5IEwjS2OgxPlElTUCo37GRFCqNLM2Gfh5JPxiBg8IkI",
-	"3EqxlqACLV7pnyThMzM1UgLXidJQNiTTG78OOzfFqnp9mQAHWmjCHJ96TuEP7e6KDJt2MvLdaJ0Iet2d",
-	"12+b3dfU5sW86cZQzYCjs/vQwzE1uY88q+ts59BUniGOnqGtW7NTGT8SyatUnGpiLmwLfdi3fls+qIht",
-	"Q/BjAHCgfywL63gMbPx24sF08/sMJx2oVYfsXrYHKk3NZ0TDK03dMR6YNdWwpKlm9tk/jNRCUYXuFvfL",
-	"3DD05vYSR3gLUlWldzH7++zCqhMlcFJSPMc/zS5mFzhywzAXh9jPhO2XfXT4Gm+o0kLu+stPwxnyfgpN",
-	"TKSmOUm1Oo86ZiIl7JVtcM5klFAIDc/h9K/tKn4aTlzPdDZ+qj++tIw4E984EySbJKyehq+rkUYXot+D",
-	"RoVhmpasuUCoh9kKWVaUCu4PEraboeWGKgQ8KwXlGqWEI6UlkMINZh39aoeA6g1IpLS1nq8/c4I+wkqJ",
-	"9BG0lcfBQSb6q4RUFAXwDLK/ISERgzVJd+i35fLWy6V8PcORH8tRwS+zyuqF99n/v2wcvqqm+u1bnyNH",
-	"zIEkDtyOHMPXFtdgYjSBp31HMoE8dAc1ga1/yzGBpXvZsn/oDbhfX1y82Hi7PZALTLfvmz4R1SZgR5QT",
-	"/1oREt5YG1ezeDcSN0VBLJC5PPeb/BfVTXCLuMSWyCfcpBx2I6cThVVLmVSFDbAc0v88vjNA+QVQbFRC",
-	"7C+wXkBSjSQqfiqF1L5L3cdkSygjK8qofgGXT2NgfX9nCf8HQM5XkPL/fyj6ncf1f/z7YfjXzvHng55v",
-	"u6cVpSeO/X2seh5X/OQ/JTTbnyeiiutkvdocSNeUQ+wbb7vSyGyG9xZswnfJJxDnvocmIkeEH+6xD/fb",
-	"7icD30ACopxqSjRkyCjK1+gPCVpS2PobJ6J2PP1j9pkvN4DcF9+8EY5W7kcIyhSQIcHZDgmeAiI8Q/C9",
-	"pBIQyTVIT1NW9+oSvUYbYaSqH0pwme40nA+OaCI2fubTwXFw1aMGKx8MzZ6FiMd+IvBfw8JZ9xX9Acvw",
-	"dxXj2PHVgNIvAh2B1BxDEX8fWge7a+KVfatDwLdUCu5uDiJsJMNzvNG6nMe+9Gbu7W8jlJ671mAf29fY",
-	"CG+JpGTF/GhPSF9e3kH8y88//4Kj5prYfXUW9c24lSKrJjfoLRMmO2qRakx69VT9r0p8llq22aN/FZ+l",
-	"ogiZ2GLpWnrR+rNb+bD/TwAAAP//53BKX7omAAA=",
+	"H4sIAAAAAAAC/+xabW/bOBL+KwTvgLsDVCvX/bBYfwu6bje4XBw47nWBNtDS0thmQ5EqX9z6DP/3AyVK",
+	"1gstS2mA4oDNl9jUzDMv5Dykhj7gWKSZ4MC1wtMDzogkKWiQ+bdY8M+Gx5ruIFpTVg5Tjqf4iwG5xwHm",
+	"JAU89YoGWMVbSEmuoyHNlf8qYY2n+C/hyXBYiKnwVmxuKYe3uT4+BljvMwtOpCR7fDwGGHjMyA4imgDX",
+	"dE1BWswEVCxppqmwnr1/f/NrgNRWSA0cElR8FxJZV5FYI70F5IBwUESTEb09BeOxEmAJXwyVkOCplgbq",
+	"sTkvlZaUb3I314Ix8TViYnM2YXURD9hKCAaE52jcpFYuYpTDWbymkAeRcg0bm9ajjUUbySPCWK+PbbEL",
+	"fiqQOxr3T85yC8jJoZNcOSux4JpQDhLpLdFuKE0JT+x8GpagFSD4BrHRkCDK/dPn8WPc9JUAxtAkUqDP",
+	"5acj12emqoCWPc8qV5pIRuRTVIRKBc9N+LNpOP1iGsnUAmlJ4qdioZcQNscEPThoVMDYsshI/EQ2Z0rh",
+	"nCtjEpovOJUJrorleyf0/Mnxiwaep5dkGaMxsQbCz8oGd6gh9pHGwkHf8LUojLXogMO3DGK7YEBKURSA",
+	"U7bYjnTsx0yKDKSmhZvMjQ6duABrmoLSJM0u+bysBI/HeiY/FkbrSI+VGbH6DLG2dpo82XHcfiZayIF0",
+	"Oy/FbQTwTUcZ0Rok99dG3dnKUEuxx+d5zTfgJrUwv85nD9Gb+d3y+uYuWs5+X+KgGLubL73j5di/r5dv",
+	"fosWs3ez330q9cePQTuWADdWTieLsUjy6V8LmRKNp9hQrn96jYMOoQY4BaVsCZ1fJMPW8NLKtrOcA5xs",
+	"BIVnvhw3YGoJni0W8wUO8M3d2zkO8Ifrxd3N3TtvTh4KSrt1u0IzJVzoaC0Mt2TnYcjBdVJq200lWu0b",
+	"aDkZJAm11UvYfcP+gMWMj528HD2ZKmlwljNCJ1AohwWH+RpPP/bbLtFuuAaZSdA5jxXYx2CY7n8Io8kz",
+	"9GYlLzu1x/byKWJ57EtCE6KbjYr6oSdd0fki8HlUiT/6Jqwh3jLfF4q/lOmZUaWliYttwqcyPKKG9MWA",
+	"6oYHCK/FhZB7wiByY9LycD/oDO6BvXYgvlIuJoesGEStfHZYoPY8Kk4XPiEVqSeaZZD4DpkBzoSipYWR",
+	"YdyXqj3zUTgW1PJ2NsSGrwMnqMqkb6J6kyIhk6CAW2rZgT83CiQljP4XksjC7QgzA9auV8tnc2CM97UZ",
+	"au+ozKS8saee31LXlMHZhJRHs4s4rVgr0KA8aDmfBsa2AGWY7qWSSJ6RqeXZLz54mjzqYzjnvE6A+3Yy",
+	"T9R1oR+zOXh96ItsYfhbyqnaQjLbeUtRGh6tnUgEfhlbHYZHysQxKLU27GJFCqMzM2Ceu8gXc+Bx+EIG",
+	"7qXYSFCeI17mnkT+PTM2UgLXkdKQVSLDD34NdW7SFchBZRxgLTRhuZ56TuF3/W5C+l27mPlmti4kvTyd",
+	"l2+bzdfUUhBVpzFUKuBg9Dn0tE0NPkeOOnXW19AxGO2V49ER1po1O1TxA5G8WIpDXbQ9hMfavLWP5Z2K",
+	"2FUCP4YAO/b7VmGZj46PXy88GO5+W+FiAKVpn9/LekOlqvmEaHilab6Ne3pNJS1pqpl99i8jtVBUocXs",
+	"Ybk2DF3f3+AA70CqovSuJv+cXFlzIgNOMoqn+KfJ1eQKB3kzLM9D6HrC9ssxOH0Nt1RpIfft4UO3h3wc",
+	"IhMSqemaxFqNkw6ZiAl7ZQ84IxUlpELDczTda7sKD92O68hgw0P58aUxwkR85UyQ5EXARsx2WHbWN6C7",
+	"dP8ONEoN0zRj1WVE2RhXyKraZrjblNh+gpZbqhDwJBOUaxQTjpSWQNK8yZvLr/YIqN6CREpb5/nmEyfo",
+	"A6yUiJ9AWzwOOf2iv0uwfXXgCST/sD1gBhsS79Fvy+W9w6V8M8GBa/FRwW+SwuuZi9n9v6kCvi1uCOo3",
+	"SGe2q5NI2M3bWa6uaXW6TwN06vctA8R991kD1No3JgNUmhc3x8dWs/z11dWLtcrrzT1Pp/yhOnOi0gWc",
+	"C62Je0XxgVfehkVf3+Iqk6ZE7osVUy7vv6nmAscB1sSWyEdcLTmct68uFFaJMqgKK5I6Lf9xemNK/vsZ",
+	"sRchdJdhL4BUMokKD5mQ2p14jyHZEcrIijKqXyDkyxzolPIF8X9Acq6ClPv/Q9lvnNaf/PfD+K++xp9P",
+	"eu4IP6wonXDo7nbV87TCg/sU0eT4AhBhxggfh1PMj3qW0ihz2pyMbCiH0L1G2JEKuLqKsHTnvxm/wHkP",
+	"LT6zl/L8dCtfYRU/gPgKEhDlVFNi76+NonyD/pCgJYWduz8jas/jPyafuP0xQP6lQLbsucp/UqFMCgkS",
+	"nO2R4DEg+3MK+JZRCYisNUgnk7toefI12gojVflQQl5ruYXx9IwGsvMnPpyeOxdXqjPy3tDkWZx8Zlq/",
+	"n5hG3b6020XdX4n0s9cXA0q/CHl5lmYfj7nb3TLZTRdv7TsqAr6jUvD8HiTARjI8xVuts2noSm+Sv8tu",
+	"hdLT/HByDElG7Rs7kdRewxSNSiFdebkA8S8///wLDqpL7/xr7lHbjXspkqIPhd4wYZKzHqnKpVeH4n9R",
+	"4pPYqk2eXGNhEovU52JNpenpVe3PTuXj8X8DAEQwQbSIJwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -226,6 +226,46 @@ pub struct ServiceInfo {
     /// Whether Tini is enabled
     #[prost(bool, optional, tag = "19")]
     pub tini_enabled: ::core::option::Option<bool>,
+    /// Result of the checks of the service's ready conditions, which keep running after the service started
+    /// Not set for services without ready conditions
+    #[prost(message, optional, tag = "20")]
+    pub health: ::core::option::Option<ServiceHealth>,
+    /// How the service last crashed, i.e. exited with an error or was OOM-killed, since it started
+    /// Not set for services that didn't crash
+    #[prost(message, optional, tag = "21")]
+    pub last_crash: ::core::option::Option<ServiceCrashReport>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceHealth {
+    /// False once the ready conditions failed several consecutive checks, until they pass again
+    #[prost(bool, tag = "1")]
+    pub is_healthy: bool,
+    #[prost(uint32, tag = "2")]
+    pub consecutive_failures: u32,
+    /// Error of the last failed check; empty if the last check passed
+    #[prost(string, tag = "3")]
+    pub last_error: ::prost::alloc::string::String,
+    /// Number of times the service got restarted because it was unhealthy, as per its restart policy
+    #[prost(uint32, tag = "4")]
+    pub restart_count: u32,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceCrashReport {
+    #[prost(int32, tag = "1")]
+    pub exit_code: i32,
+    #[prost(bool, tag = "2")]
+    pub is_oom_killed: bool,
+    #[prost(message, optional, tag = "3")]
+    pub finished_at: ::core::option::Option<::prost_types::Timestamp>,
+    /// Why the container runtime says the service exited, e.g. 'OOMKilled'; may be empty
+    #[prost(string, tag = "4")]
+    pub reason: ::prost::alloc::string::String,
+    /// Files artifact holding the exit state, last log lines, backend state and crash dump of the service
+    /// Empty if they couldn't be captured
+    #[prost(string, tag = "5")]
+    pub diagnostics_files_artifact_name: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -338,7 +378,7 @@ pub mod run_starlark_package_args {
 pub struct StarlarkRunResponseLine {
     #[prost(
         oneof = "starlark_run_response_line::RunResponseLine",
-        tags = "1, 2, 3, 4, 5, 6, 7"
+        tags = "1, 2, 3, 4, 5, 6, 7, 8, 9"
     )]
     pub run_response_line: ::core::option::Option<
         starlark_run_response_line::RunResponseLine,
@@ -363,6 +403,10 @@ pub mod starlark_run_response_line {
         Warning(super::StarlarkWarning),
         #[prost(message, tag = "7")]
         Info(super::StarlarkInfo),
+        #[prost(message, tag = "8")]
+        ContainerImageTiming(super::StarlarkContainerImageTiming),
+        #[prost(message, tag = "9")]
+        Plan(super::StarlarkRunPlan),
     }
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -398,6 +442,39 @@ pub struct StarlarkInstruction {
 pub struct StarlarkInstructionResult {
     #[prost(string, tag = "1")]
     pub serialized_instruction_result: ::prost::alloc::string::String,
+    /// Wall time spent executing the instruction. Not set for instructions skipped because they already ran in the enclave
+    #[prost(uint64, optional, tag = "2")]
+    pub execution_duration_milliseconds: ::core::option::Option<u64>,
+}
+/// Wall time spent pulling or building a container image before the run starts executing instructions
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StarlarkContainerImageTiming {
+    #[prost(string, tag = "1")]
+    pub image_name: ::prost::alloc::string::String,
+    /// The services of the run using this image
+    #[prost(string, repeated, tag = "2")]
+    pub service_names: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(uint64, tag = "3")]
+    pub duration_milliseconds: u64,
+}
+/// The full plan of a dry run, sent once all its instructions were streamed
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StarlarkRunPlan {
+    #[prost(message, repeated, tag = "1")]
+    pub instructions: ::prost::alloc::vec::Vec<StarlarkPlannedInstruction>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StarlarkPlannedInstruction {
+    /// The type, position and arguments of the instruction, as streamed during the run
+    #[prost(message, optional, tag = "1")]
+    pub instruction: ::core::option::Option<StarlarkInstruction>,
+    /// The value the instruction returns to the script, serialized as Starlark. The runtime values it produces, like the
+    /// IP address of a service, are placeholders that only get resolved when the instruction is executed
+    #[prost(string, tag = "2")]
+    pub serialized_returned_value: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -455,6 +532,12 @@ pub struct StarlarkValidationError {
 pub struct StarlarkExecutionError {
     #[prost(string, tag = "1")]
     pub error_message: ::prost::alloc::string::String,
+    /// Set when the execution failed because of a known problem of the container engine, e.g. an image that can't be pulled
+    #[prost(enumeration = "BackendErrorKind", tag = "2")]
+    pub backend_error_kind: i32,
+    /// What the user can do to fix the problem; empty if backend_error_kind isn't set
+    #[prost(string, tag = "3")]
+    pub remediation_hint: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -487,6 +570,21 @@ pub struct GetServicesArgs {
         ::prost::alloc::string::String,
         bool,
     >,
+    /// Only the services having all these labels
+    #[prost(map = "string, string", tag = "2")]
+    pub labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Only the services in one of these statuses; services in any status if empty
+    #[prost(enumeration = "ServiceStatus", repeated, tag = "3")]
+    pub statuses: ::prost::alloc::vec::Vec<i32>,
+    /// The maximum number of services to return, ordered by name; all of them if not set or 0
+    #[prost(uint32, optional, tag = "4")]
+    pub page_size: ::core::option::Option<u32>,
+    /// The next_page_token of the previous response, to get the page after it; the first page if not set
+    #[prost(string, optional, tag = "5")]
+    pub page_token: ::core::option::Option<::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -497,6 +595,9 @@ pub struct GetServicesResponse {
         ::prost::alloc::string::String,
         ServiceInfo,
     >,
+    /// To pass as page_token to get the next page; not set if this is the last page
+    #[prost(string, optional, tag = "2")]
+    pub next_page_token: ::core::option::Option<::prost::alloc::string::String>,
 }
 /// An service identifier is a collection of uuid, name and shortened uuid
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -519,6 +620,51 @@ pub struct GetExistingAndHistoricalServiceIdentifiersResponse {
     pub all_identifiers: ::prost::alloc::vec::Vec<ServiceIdentifiers>,
 }
 /// ==============================================================================================
+///                                     Get Service Dependency Graph
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceDependencyNode {
+    #[prost(string, tag = "1")]
+    pub service_name: ::prost::alloc::string::String,
+    /// Whether the service had ready conditions, in which case services depending on it were only started once it was ready
+    #[prost(bool, tag = "2")]
+    pub has_ready_conditions: bool,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceDependencyEdge {
+    /// Name of the service that depends on the other one
+    #[prost(string, tag = "1")]
+    pub service_name: ::prost::alloc::string::String,
+    /// Name of the service it depends on
+    #[prost(string, tag = "2")]
+    pub dependency_service_name: ::prost::alloc::string::String,
+    #[prost(enumeration = "ServiceDependencyKind", tag = "3")]
+    pub kind: i32,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetServiceDependencyGraphResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub nodes: ::prost::alloc::vec::Vec<ServiceDependencyNode>,
+    #[prost(message, repeated, tag = "2")]
+    pub edges: ::prost::alloc::vec::Vec<ServiceDependencyEdge>,
+}
+/// ==============================================================================================
+///                                     Set Enclave Env Vars
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SetEnclaveEnvVarsArgs {
+    /// Env vars to upsert, env vars already set in the enclave and absent from this map are left untouched
+    #[prost(map = "string, string", tag = "1")]
+    pub env_vars: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+}
+/// ==============================================================================================
 ///                                           Exec Command
 /// ==============================================================================================
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -657,6 +803,26 @@ pub struct StoreWebFilesArtifactArgs {
     /// The name of the files artifact
     #[prost(string, tag = "2")]
     pub name: ::prost::alloc::string::String,
+    /// Headers sent along with the request (e.g. a token for the host serving the file)
+    #[prost(message, repeated, tag = "3")]
+    pub headers: ::prost::alloc::vec::Vec<HttpHeader>,
+    /// Credentials for HTTP basic authentication, if the URL requires it
+    #[prost(string, optional, tag = "4")]
+    pub basic_auth_username: ::core::option::Option<::prost::alloc::string::String>,
+    #[prost(string, optional, tag = "5")]
+    pub basic_auth_password: ::core::option::Option<::prost::alloc::string::String>,
+    /// Hex-encoded SHA-256 that the downloaded content must have. When set, content already downloaded with this checksum
+    /// is reused instead of downloading it again
+    #[prost(string, optional, tag = "6")]
+    pub content_sha256: ::core::option::Option<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct HttpHeader {
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub value: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -712,6 +878,9 @@ pub struct InspectFilesArtifactContentsRequest {
 pub struct InspectFilesArtifactContentsResponse {
     #[prost(message, repeated, tag = "1")]
     pub file_descriptions: ::prost::alloc::vec::Vec<FileArtifactContentsFileDescription>,
+    /// Hex-encoded SHA-256 of the files artifact content; empty for artifacts stored before checksums were recorded
+    #[prost(string, tag = "2")]
+    pub content_sha256: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -728,6 +897,36 @@ pub struct FileArtifactContentsFileDescription {
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetFilesArtifactHistoryArgs {
+    /// UUID, shortened UUID or name of the files artifact, or of any of its versions
+    #[prost(string, tag = "1")]
+    pub identifier: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetFilesArtifactHistoryResponse {
+    #[prost(string, tag = "1")]
+    pub file_name: ::prost::alloc::string::String,
+    /// Versions of the files artifact, oldest first
+    #[prost(message, repeated, tag = "2")]
+    pub versions: ::prost::alloc::vec::Vec<FilesArtifactVersion>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct FilesArtifactVersion {
+    #[prost(uint32, tag = "1")]
+    pub version: u32,
+    /// UUID under which the content of this version is stored; the latest version shares the UUID of the files artifact
+    #[prost(string, tag = "2")]
+    pub file_uuid: ::prost::alloc::string::String,
+    /// Unset for versions stored before versions were tracked
+    #[prost(message, optional, tag = "3")]
+    pub created_at: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(bool, tag = "4")]
+    pub is_latest: bool,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct ConnectServicesArgs {
     #[prost(enumeration = "Connect", tag = "1")]
     pub connect: i32,
@@ -798,6 +997,60 @@ pub struct StarlarkPackagePlanYamlArgs {
     #[prost(string, optional, tag = "5")]
     pub main_function_name: ::core::option::Option<::prost::alloc::string::String>,
 }
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WatchServiceEventsArgs {
+    /// Only the events of these services; the events of all services if empty
+    #[prost(string, repeated, tag = "1")]
+    pub service_names: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// Only the events of these types; the events of all types if empty
+    #[prost(enumeration = "ServiceEventType", repeated, tag = "2")]
+    pub event_types: ::prost::alloc::vec::Vec<i32>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceEvent {
+    #[prost(string, tag = "1")]
+    pub service_name: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub service_uuid: ::prost::alloc::string::String,
+    #[prost(enumeration = "ServiceEventType", tag = "3")]
+    pub event_type: i32,
+    #[prost(message, optional, tag = "4")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    /// Details about the event, e.g. the exit code of a crashed service
+    #[prost(string, optional, tag = "5")]
+    pub message: ::core::option::Option<::prost::alloc::string::String>,
+}
+/// ==============================================================================================
+///                                   Bulk Service Operations
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BulkServiceOperationArgs {
+    /// The UUIDs, shortened UUIDs or names of the services
+    #[prost(string, repeated, tag = "1")]
+    pub service_identifiers: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BulkServiceOperationResponse {
+    /// Whether all the services went through the operation; if not, none of them did, except the ones in failed_rollbacks
+    #[prost(bool, tag = "1")]
+    pub is_applied: bool,
+    /// The errors of the services the operation failed for, by the identifier the service was asked with
+    #[prost(map = "string, string", tag = "2")]
+    pub failed_services: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// The errors of the services the operation went through for but couldn't be undone for, by the identifier the service was asked with
+    #[prost(map = "string, string", tag = "3")]
+    pub failed_rollbacks: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum ServiceStatus {
@@ -908,6 +1161,78 @@ impl KurtosisFeatureFlag {
         }
     }
 }
+/// Known problems of the container engine that executions can fail because of
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum BackendErrorKind {
+    NoBackendError = 0,
+    /// An image can't be pulled, e.g. because it doesn't exist or the registry refuses access to it
+    ImagePullBackOff = 1,
+    /// The cluster doesn't have enough CPU or memory left to run a service
+    InsufficientResources = 2,
+    /// The credentials Kurtosis uses aren't allowed to perform an operation on the cluster
+    RbacForbidden = 3,
+    /// An operation would go over the quota of the enclave or of its namespace
+    QuotaExceeded = 4,
+    /// A volume of a service can't be provisioned
+    VolumeProvisioningFailed = 5,
+}
+impl BackendErrorKind {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            BackendErrorKind::NoBackendError => "NO_BACKEND_ERROR",
+            BackendErrorKind::ImagePullBackOff => "IMAGE_PULL_BACK_OFF",
+            BackendErrorKind::InsufficientResources => "INSUFFICIENT_RESOURCES",
+            BackendErrorKind::RbacForbidden => "RBAC_FORBIDDEN",
+            BackendErrorKind::QuotaExceeded => "QUOTA_EXCEEDED",
+            BackendErrorKind::VolumeProvisioningFailed => "VOLUME_PROVISIONING_FAILED",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "NO_BACKEND_ERROR" => Some(Self::NoBackendError),
+            "IMAGE_PULL_BACK_OFF" => Some(Self::ImagePullBackOff),
+            "INSUFFICIENT_RESOURCES" => Some(Self::InsufficientResources),
+            "RBAC_FORBIDDEN" => Some(Self::RbacForbidden),
+            "QUOTA_EXCEEDED" => Some(Self::QuotaExceeded),
+            "VOLUME_PROVISIONING_FAILED" => Some(Self::VolumeProvisioningFailed),
+            _ => None,
+        }
+    }
+}
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ServiceDependencyKind {
+    /// The dependency was listed in the 'depends_on' attribute of the service config
+    Explicit = 0,
+    /// The service config references a runtime value (IP address, hostname...) of the dependency
+    RuntimeValue = 1,
+}
+impl ServiceDependencyKind {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            ServiceDependencyKind::Explicit => "EXPLICIT",
+            ServiceDependencyKind::RuntimeValue => "RUNTIME_VALUE",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "EXPLICIT" => Some(Self::Explicit),
+            "RUNTIME_VALUE" => Some(Self::RuntimeValue),
+            _ => None,
+        }
+    }
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum RestartPolicy {
@@ -934,6 +1259,45 @@ impl RestartPolicy {
         }
     }
 }
+/// ==============================================================================================
+///                                      Service Events
+/// ==============================================================================================
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ServiceEventType {
+    /// The container of the service started, including after an update or a restart
+    ServiceStarted = 0,
+    /// The service passed its ready conditions, or started if it has none
+    ServiceReady = 1,
+    /// The service was stopped or removed
+    ServiceStopped = 2,
+    /// The container of the service exited with an error or ran out of memory
+    ServiceCrashed = 3,
+}
+impl ServiceEventType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            ServiceEventType::ServiceStarted => "SERVICE_STARTED",
+            ServiceEventType::ServiceReady => "SERVICE_READY",
+            ServiceEventType::ServiceStopped => "SERVICE_STOPPED",
+            ServiceEventType::ServiceCrashed => "SERVICE_CRASHED",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "SERVICE_STARTED" => Some(Self::ServiceStarted),
+            "SERVICE_READY" => Some(Self::ServiceReady),
+            "SERVICE_STOPPED" => Some(Self::ServiceStopped),
+            "SERVICE_CRASHED" => Some(Self::ServiceCrashed),
+            _ => None,
+        }
+    }
+}
 /// Generated client implementations.
 pub mod api_container_service_client {
     #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
@@ -1171,6 +1535,68 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /// Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+        pub async fn get_service_dependency_graph(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetServiceDependencyGraphResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetServiceDependencyGraph",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetServiceDependencyGraph",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+        /// a service config take precedence over the enclave ones
+        pub async fn set_enclave_env_vars(
+            &mut self,
+            request: impl tonic::IntoRequest<
+                super::SetEnclaveEnvVarsArgs,
+            >,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/SetEnclaveEnvVars",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "SetEnclaveEnvVars",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         /// Executes the given command inside a running container
         pub async fn exec_command(
             &mut self,
@@ -1446,6 +1872,37 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /// Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+        pub async fn get_files_artifact_history(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetFilesArtifactHistoryArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetFilesArtifactHistoryResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetFilesArtifactHistory",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetFilesArtifactHistory",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         /// User services port forwarding
         pub async fn connect_services(
             &mut self,
@@ -1564,15 +2021,130 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-    }
-}
-/// Generated server implementations.
-pub mod api_container_service_server {
-    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
-    use tonic::codegen::*;
-    /// Generated trait containing gRPC methods that should be implemented for use with ApiContainerServiceServer.
-    #[async_trait]
-    pub trait ApiContainerService: Send + Sync + 'static {
+        /// Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+        pub async fn watch_service_events(
+            &mut self,
+            request: impl tonic::IntoRequest<super::WatchServiceEventsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::ServiceEvent>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/WatchServiceEvents",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "WatchServiceEvents",
+                    ),
+                );
+            self.inner.server_streaming(req, path, codec).await
+        }
+        /// Starts either all the services or none of them: the ones that started are stopped back if any fails to
+        pub async fn start_services(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BulkServiceOperationArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::BulkServiceOperationResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/StartServices",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("api_container_api.ApiContainerService", "StartServices"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Stops either all the services or none of them: the ones that stopped are started back if any fails to
+        pub async fn stop_services(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BulkServiceOperationArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::BulkServiceOperationResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/StopServices",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("api_container_api.ApiContainerService", "StopServices"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+        pub async fn remove_services(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BulkServiceOperationArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::BulkServiceOperationResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/RemoveServices",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("api_container_api.ApiContainerService", "RemoveServices"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod api_container_service_server {
+    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
+    use tonic::codegen::*;
+    /// Generated trait containing gRPC methods that should be implemented for use with ApiContainerServiceServer.
+    #[async_trait]
+    pub trait ApiContainerService: Send + Sync + 'static {
         /// Server streaming response type for the RunStarlarkScript method.
         type RunStarlarkScriptStream: futures_core::Stream<
                 Item = std::result::Result<super::StarlarkRunResponseLine, tonic::Status>,
@@ -1622,6 +2194,20 @@ pub mod api_container_service_server {
             tonic::Response<super::GetExistingAndHistoricalServiceIdentifiersResponse>,
             tonic::Status,
         >;
+        /// Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+        async fn get_service_dependency_graph(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetServiceDependencyGraphResponse>,
+            tonic::Status,
+        >;
+        /// Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+        /// a service config take precedence over the enclave ones
+        async fn set_enclave_env_vars(
+            &self,
+            request: tonic::Request<super::SetEnclaveEnvVarsArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
         /// Executes the given command inside a running container
         async fn exec_command(
             &self,
@@ -1692,6 +2278,14 @@ pub mod api_container_service_server {
             tonic::Response<super::InspectFilesArtifactContentsResponse>,
             tonic::Status,
         >;
+        /// Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+        async fn get_files_artifact_history(
+            &self,
+            request: tonic::Request<super::GetFilesArtifactHistoryArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetFilesArtifactHistoryResponse>,
+            tonic::Status,
+        >;
         /// User services port forwarding
         async fn connect_services(
             &self,
@@ -1718,6 +2312,44 @@ pub mod api_container_service_server {
             &self,
             request: tonic::Request<super::StarlarkPackagePlanYamlArgs>,
         ) -> std::result::Result<tonic::Response<super::PlanYaml>, tonic::Status>;
+        /// Server streaming response type for the WatchServiceEvents method.
+        type WatchServiceEventsStream: futures_core::Stream<
+                Item = std::result::Result<super::ServiceEvent, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+        async fn watch_service_events(
+            &self,
+            request: tonic::Request<super::WatchServiceEventsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::WatchServiceEventsStream>,
+            tonic::Status,
+        >;
+        /// Starts either all the services or none of them: the ones that started are stopped back if any fails to
+        async fn start_services(
+            &self,
+            request: tonic::Request<super::BulkServiceOperationArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::BulkServiceOperationResponse>,
+            tonic::Status,
+        >;
+        /// Stops either all the services or none of them: the ones that stopped are started back if any fails to
+        async fn stop_services(
+            &self,
+            request: tonic::Request<super::BulkServiceOperationArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::BulkServiceOperationResponse>,
+            tonic::Status,
+        >;
+        /// Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+        async fn remove_services(
+            &self,
+            request: tonic::Request<super::BulkServiceOperationArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::BulkServiceOperationResponse>,
+            tonic::Status,
+        >;
     }
     #[derive(Debug)]
     pub struct ApiContainerServiceServer<T: ApiContainerService> {
@@ -2036,6 +2668,95 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/api_container_api.ApiContainerService/GetServiceDependencyGraph" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetServiceDependencyGraphSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetServiceDependencyGraphSvc<T> {
+                        type Response = super::GetServiceDependencyGraphResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_service_dependency_graph(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetServiceDependencyGraphSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/SetEnclaveEnvVars" => {
+                    #[allow(non_camel_case_types)]
+                    struct SetEnclaveEnvVarsSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::SetEnclaveEnvVarsArgs>
+                    for SetEnclaveEnvVarsSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::SetEnclaveEnvVarsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).set_enclave_env_vars(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = SetEnclaveEnvVarsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/api_container_api.ApiContainerService/ExecCommand" => {
                     #[allow(non_camel_case_types)]
                     struct ExecCommandSvc<T: ApiContainerService>(pub Arc<T>);
@@ -2477,6 +3198,54 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/api_container_api.ApiContainerService/GetFilesArtifactHistory" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetFilesArtifactHistorySvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::GetFilesArtifactHistoryArgs>
+                    for GetFilesArtifactHistorySvc<T> {
+                        type Response = super::GetFilesArtifactHistoryResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetFilesArtifactHistoryArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_files_artifact_history(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetFilesArtifactHistorySvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/api_container_api.ApiContainerService/ConnectServices" => {
                     #[allow(non_camel_case_types)]
                     struct ConnectServicesSvc<T: ApiContainerService>(pub Arc<T>);
@@ -2660,6 +3429,191 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/api_container_api.ApiContainerService/WatchServiceEvents" => {
+                    #[allow(non_camel_case_types)]
+                    struct WatchServiceEventsSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::ServerStreamingService<super::WatchServiceEventsArgs>
+                    for WatchServiceEventsSvc<T> {
+                        type Response = super::ServiceEvent;
+                        type ResponseStream = T::WatchServiceEventsStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::WatchServiceEventsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).watch_service_events(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = WatchServiceEventsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/StartServices" => {
+                    #[allow(non_camel_case_types)]
+                    struct StartServicesSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::BulkServiceOperationArgs>
+                    for StartServicesSvc<T> {
+                        type Response = super::BulkServiceOperationResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::BulkServiceOperationArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).start_services(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = StartServicesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/StopServices" => {
+                    #[allow(non_camel_case_types)]
+                    struct StopServicesSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::BulkServiceOperationArgs>
+                    for StopServicesSvc<T> {
+                        type Response = super::BulkServiceOperationResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::BulkServiceOperationArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).stop_services(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = StopServicesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/RemoveServices" => {
+                    #[allow(non_camel_case_types)]
+                    struct RemoveServicesSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::BulkServiceOperationArgs>
+                    for RemoveServicesSvc<T> {
+                        type Response = super::BulkServiceOperationResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::BulkServiceOperationArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).remove_services(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = RemoveServicesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
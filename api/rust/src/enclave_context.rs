@@ -0,0 +1,292 @@
+use std::collections::HashMap;
+
+use sha1::{Digest, Sha1};
+use tonic::codec::Streaming;
+use tonic::transport::Channel;
+
+use crate::enclave_api::api_container_service_client::ApiContainerServiceClient;
+use crate::enclave_api::starlark_error::Error as StarlarkErrorKind;
+use crate::enclave_api::starlark_run_response_line::RunResponseLine;
+use crate::enclave_api::{
+    BulkServiceOperationArgs, BulkServiceOperationResponse, DataChunkMetadata, DownloadFilesArtifactArgs,
+    FilesArtifactNameAndUuid, GetServicesArgs, RunStarlarkPackageArgs, RunStarlarkScriptArgs, ServiceEvent,
+    ServiceEventType, ServiceInfo, StarlarkExecutionError, StarlarkInstruction, StarlarkInterpretationError,
+    StarlarkRunPlan, StarlarkRunResponseLine, StarlarkValidationError, StreamedDataChunk, UploadFilesArtifactResponse,
+    WatchServiceEventsArgs,
+};
+use crate::engine_api::{EnclaveApiContainerStatus, EnclaveInfo};
+use crate::error::{KurtosisError, Result};
+
+// The same as the other SDKs, so that the API container handles the chunks alike
+const FILES_ARTIFACT_CHUNK_SIZE: usize = 3 * 1024 * 1024;
+
+const EMPTY_SERIALIZED_PARAMS: &str = "{}";
+
+const STARLARK_RUN_OUTPUT_LINES_SPLIT: &str = "\n";
+
+/// Runs Starlark, and manages the services and files artifacts of an enclave, through its API container
+#[derive(Debug, Clone)]
+pub struct EnclaveContext {
+    apic_client: ApiContainerServiceClient<Channel>,
+    enclave_uuid: String,
+    enclave_name: String,
+}
+
+/// The outcome of a Starlark run, once it's over
+#[derive(Debug, Clone, Default)]
+pub struct StarlarkRunResult {
+    /// The results of the instructions, followed by the output of the run if it succeeded, one per line
+    pub run_output: String,
+    pub instructions: Vec<StarlarkInstruction>,
+    pub interpretation_error: Option<StarlarkInterpretationError>,
+    pub validation_errors: Vec<StarlarkValidationError>,
+    pub execution_error: Option<StarlarkExecutionError>,
+    /// Only set for dry runs, which send the full plan once all the instructions were sent
+    pub plan: Option<StarlarkRunPlan>,
+}
+
+impl StarlarkRunResult {
+    pub fn is_successful(&self) -> bool {
+        self.interpretation_error.is_none() && self.validation_errors.is_empty() && self.execution_error.is_none()
+    }
+}
+
+impl EnclaveContext {
+    pub(crate) async fn new_from_enclave_info(enclave_info: &EnclaveInfo) -> Result<Self> {
+        if enclave_info.api_container_status != EnclaveApiContainerStatus::Running as i32 {
+            return Err(KurtosisError::Unavailable(format!(
+                "The API container of enclave '{}' isn't running; start the enclave to use it",
+                enclave_info.name
+            )));
+        }
+        let host_machine_info = enclave_info.api_container_host_machine_info.as_ref().ok_or_else(|| {
+            KurtosisError::InvalidResponse(format!(
+                "The engine didn't return where the API container of enclave '{}' can be reached",
+                enclave_info.name
+            ))
+        })?;
+        let apic_url = format!("http://{}:{}", host_machine_info.ip_on_host_machine, host_machine_info.grpc_port_on_host_machine);
+        let apic_client = ApiContainerServiceClient::connect(apic_url).await?;
+        Ok(Self::new_from_apic_client(apic_client, &enclave_info.enclave_uuid, &enclave_info.name))
+    }
+
+    /// Uses a client set up by the caller, e.g. to reach the API container through a proxy
+    pub fn new_from_apic_client(apic_client: ApiContainerServiceClient<Channel>, enclave_uuid: &str, enclave_name: &str) -> Self {
+        Self {
+            apic_client,
+            enclave_uuid: enclave_uuid.to_string(),
+            enclave_name: enclave_name.to_string(),
+        }
+    }
+
+    pub fn enclave_uuid(&self) -> &str {
+        &self.enclave_uuid
+    }
+
+    pub fn enclave_name(&self) -> &str {
+        &self.enclave_name
+    }
+
+    /// Gives access to all the API container calls, including the ones this context doesn't wrap
+    pub fn apic_client(&self) -> ApiContainerServiceClient<Channel> {
+        self.apic_client.clone()
+    }
+
+    /// Runs the `run` function of a Starlark script, with the parameters serialized as a JSON object (empty for none),
+    /// and streams the progress of the run
+    pub async fn run_starlark_script(&mut self, serialized_script: &str, serialized_params: &str) -> Result<Streaming<StarlarkRunResponseLine>> {
+        let args = RunStarlarkScriptArgs {
+            serialized_script: serialized_script.to_string(),
+            serialized_params: Some(get_serialized_params_or_default(serialized_params)),
+            dry_run: Some(false),
+            parallelism: None,
+            main_function_name: None,
+            experimental_features: vec![],
+            cloud_instance_id: None,
+            cloud_user_id: None,
+            image_download_mode: None,
+            non_blocking_mode: None,
+        };
+        let stream = self.apic_client.run_starlark_script(args).await?.into_inner();
+        Ok(stream)
+    }
+
+    /// Runs a Starlark package the API container clones from GitHub, e.g. `github.com/kurtosis-tech/ethereum-package`,
+    /// and streams the progress of the run
+    pub async fn run_starlark_remote_package(&mut self, package_id: &str, serialized_params: &str) -> Result<Streaming<StarlarkRunResponseLine>> {
+        let args = RunStarlarkPackageArgs {
+            package_id: package_id.to_string(),
+            serialized_params: Some(get_serialized_params_or_default(serialized_params)),
+            dry_run: Some(false),
+            parallelism: None,
+            clone_package: Some(true),
+            relative_path_to_main_file: None,
+            main_function_name: None,
+            experimental_features: vec![],
+            cloud_instance_id: None,
+            cloud_user_id: None,
+            image_download_mode: None,
+            non_blocking_mode: None,
+            github_auth_token: None,
+            starlark_package_content: None,
+        };
+        let stream = self.apic_client.run_starlark_package(args).await?.into_inner();
+        Ok(stream)
+    }
+
+    /// Same as `run_starlark_script`, waiting for the run to be over
+    pub async fn run_starlark_script_blocking(&mut self, serialized_script: &str, serialized_params: &str) -> Result<StarlarkRunResult> {
+        let stream = self.run_starlark_script(serialized_script, serialized_params).await?;
+        read_starlark_run_result(stream).await
+    }
+
+    /// Same as `run_starlark_remote_package`, waiting for the run to be over
+    pub async fn run_starlark_remote_package_blocking(&mut self, package_id: &str, serialized_params: &str) -> Result<StarlarkRunResult> {
+        let stream = self.run_starlark_remote_package(package_id, serialized_params).await?;
+        read_starlark_run_result(stream).await
+    }
+
+    /// Returns the services of the enclave, by name
+    pub async fn get_services(&mut self) -> Result<HashMap<String, ServiceInfo>> {
+        let args = GetServicesArgs {
+            service_identifiers: HashMap::new(),
+            labels: HashMap::new(),
+            statuses: vec![],
+            page_size: None,
+            page_token: None,
+        };
+        let response = self.apic_client.get_services(args).await?.into_inner();
+        Ok(response.service_info.into_values().map(|service_info| (service_info.name.clone(), service_info)).collect())
+    }
+
+    /// Returns the service identified by its UUID, shortened UUID or name
+    pub async fn get_service(&mut self, service_identifier: &str) -> Result<ServiceInfo> {
+        let args = GetServicesArgs {
+            service_identifiers: HashMap::from([(service_identifier.to_string(), true)]),
+            labels: HashMap::new(),
+            statuses: vec![],
+            page_size: None,
+            page_token: None,
+        };
+        let response = self.apic_client.get_services(args).await?.into_inner();
+        response.service_info.into_values().next().ok_or_else(|| {
+            KurtosisError::NotFound(format!("No service found for identifier '{}' in enclave '{}'", service_identifier, self.enclave_name))
+        })
+    }
+
+    /// Streams the lifecycle events of the services of the enclave until the stream gets dropped; an empty list of
+    /// service names or event types means all of them
+    pub async fn watch_service_events(&mut self, service_names: &[&str], event_types: &[ServiceEventType]) -> Result<Streaming<ServiceEvent>> {
+        let args = WatchServiceEventsArgs {
+            service_names: service_names.iter().map(|service_name| service_name.to_string()).collect(),
+            event_types: event_types.iter().map(|event_type| *event_type as i32).collect(),
+        };
+        Ok(self.apic_client.watch_service_events(args).await?.into_inner())
+    }
+
+    /// Starts either all the given services or none of them, and reports the services that failed to start
+    pub async fn start_services(&mut self, service_identifiers: &[&str]) -> Result<BulkServiceOperationResponse> {
+        let args = new_bulk_service_operation_args(service_identifiers);
+        Ok(self.apic_client.start_services(args).await?.into_inner())
+    }
+
+    /// Stops either all the given services or none of them, and reports the services that failed to stop
+    pub async fn stop_services(&mut self, service_identifiers: &[&str]) -> Result<BulkServiceOperationResponse> {
+        let args = new_bulk_service_operation_args(service_identifiers);
+        Ok(self.apic_client.stop_services(args).await?.into_inner())
+    }
+
+    /// Removes either all the given services or none of them, and reports the services that failed to be removed
+    pub async fn remove_services(&mut self, service_identifiers: &[&str]) -> Result<BulkServiceOperationResponse> {
+        let args = new_bulk_service_operation_args(service_identifiers);
+        Ok(self.apic_client.remove_services(args).await?.into_inner())
+    }
+
+    /// Stores a files artifact from the content of a gzipped tarball, and returns its UUID and name
+    pub async fn upload_files_artifact(&mut self, artifact_name: &str, compressed_content: &[u8]) -> Result<UploadFilesArtifactResponse> {
+        let mut chunks = vec![];
+        let mut previous_chunk_hash = String::new();
+        for content_chunk in compressed_content.chunks(FILES_ARTIFACT_CHUNK_SIZE) {
+            chunks.push(StreamedDataChunk {
+                data: content_chunk.to_vec(),
+                previous_chunk_hash,
+                metadata: Some(DataChunkMetadata { name: artifact_name.to_string() }),
+            });
+            previous_chunk_hash = get_chunk_hash(content_chunk);
+        }
+        let response = self.apic_client.upload_files_artifact(tokio_stream::iter(chunks)).await?.into_inner();
+        Ok(response)
+    }
+
+    /// Returns the content of a files artifact, identified by its UUID or name, as a gzipped tarball
+    pub async fn download_files_artifact(&mut self, artifact_identifier: &str) -> Result<Vec<u8>> {
+        let args = DownloadFilesArtifactArgs { identifier: artifact_identifier.to_string() };
+        let mut stream = self.apic_client.download_files_artifact(args).await?.into_inner();
+        let mut content = vec![];
+        let mut expected_previous_chunk_hash = String::new();
+        while let Some(chunk) = stream.message().await? {
+            // Each chunk refers to the previous one, so that a lost chunk is noticed
+            if chunk.previous_chunk_hash != expected_previous_chunk_hash {
+                return Err(KurtosisError::InvalidResponse(format!(
+                    "A chunk of files artifact '{}' was lost during the download; expected previous chunk hash '{}' but got '{}'",
+                    artifact_identifier, expected_previous_chunk_hash, chunk.previous_chunk_hash
+                )));
+            }
+            expected_previous_chunk_hash = get_chunk_hash(&chunk.data);
+            content.extend_from_slice(&chunk.data);
+        }
+        Ok(content)
+    }
+
+    pub async fn get_files_artifacts_names_and_uuids(&mut self) -> Result<Vec<FilesArtifactNameAndUuid>> {
+        let response = self.apic_client.list_files_artifact_names_and_uuids(()).await?.into_inner();
+        Ok(response.file_names_and_uuids)
+    }
+}
+
+/// Reads a Starlark run until it's over, the same way as the other SDKs
+pub async fn read_starlark_run_result(mut stream: Streaming<StarlarkRunResponseLine>) -> Result<StarlarkRunResult> {
+    let mut result = StarlarkRunResult::default();
+    while let Some(response_line) = stream.message().await? {
+        match response_line.run_response_line {
+            Some(RunResponseLine::Instruction(instruction)) => result.instructions.push(instruction),
+            Some(RunResponseLine::InstructionResult(instruction_result)) => {
+                result.run_output.push_str(&instruction_result.serialized_instruction_result);
+                result.run_output.push_str(STARLARK_RUN_OUTPUT_LINES_SPLIT);
+            }
+            Some(RunResponseLine::Error(starlark_error)) => match starlark_error.error {
+                Some(StarlarkErrorKind::InterpretationError(err)) => result.interpretation_error = Some(err),
+                Some(StarlarkErrorKind::ValidationError(err)) => result.validation_errors.push(err),
+                Some(StarlarkErrorKind::ExecutionError(err)) => result.execution_error = Some(err),
+                None => {}
+            },
+            Some(RunResponseLine::RunFinishedEvent(run_finished_event)) => {
+                let serialized_output = run_finished_event.serialized_output.unwrap_or_default();
+                if run_finished_event.is_run_successful && !serialized_output.is_empty() {
+                    result.run_output.push_str(&serialized_output);
+                    result.run_output.push_str(STARLARK_RUN_OUTPUT_LINES_SPLIT);
+                }
+            }
+            Some(RunResponseLine::Plan(plan)) => result.plan = Some(plan),
+            _ => {}
+        }
+    }
+    Ok(result)
+}
+
+fn get_serialized_params_or_default(serialized_params: &str) -> String {
+    if serialized_params.is_empty() {
+        return EMPTY_SERIALIZED_PARAMS.to_string();
+    }
+    serialized_params.to_string()
+}
+
+fn get_chunk_hash(content_chunk: &[u8]) -> String {
+    hex::encode(Sha1::digest(content_chunk))
+}
+
+fn new_bulk_service_operation_args(service_identifiers: &[&str]) -> BulkServiceOperationArgs {
+    BulkServiceOperationArgs {
+        service_identifiers: service_identifiers.iter().map(|service_identifier| service_identifier.to_string()).collect(),
+    }
+}
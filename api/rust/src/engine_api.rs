@@ -7,6 +7,117 @@ pub struct GetEngineInfoResponse {
     /// Version of the engine server
     #[prost(string, tag = "1")]
     pub engine_version: ::prost::alloc::string::String,
+    /// Whether the engine restricts its TLS connections, and the ones of the API containers, to the FIPS-approved parameters
+    #[prost(bool, tag = "2")]
+    pub fips_mode: bool,
+    /// Whether the engine was built with the FIPS 140 validated crypto module
+    #[prost(bool, tag = "3")]
+    pub validated_crypto: bool,
+}
+/// ==============================================================================================
+///                                      Negotiate API Version
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct NegotiateApiVersionArgs {
+    /// The Kurtosis version the client was built for, e.g. '0.90.1'
+    #[prost(string, tag = "1")]
+    pub client_version: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct NegotiateApiVersionResponse {
+    /// Version of the engine server
+    #[prost(string, tag = "1")]
+    pub engine_version: ::prost::alloc::string::String,
+    /// False if the client and the engine APIs may differ, i.e. their major or minor versions differ
+    #[prost(bool, tag = "2")]
+    pub is_compatible: bool,
+    /// What to do about the version skew; empty if the versions are compatible
+    #[prost(string, tag = "3")]
+    pub message: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                        Get Engine Health
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEngineHealthResponse {
+    /// The worst status of the subsystems
+    #[prost(enumeration = "SubsystemHealthStatus", tag = "1")]
+    pub status: i32,
+    #[prost(message, repeated, tag = "2")]
+    pub subsystems: ::prost::alloc::vec::Vec<SubsystemHealth>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SubsystemHealth {
+    /// The name of the subsystem, e.g. "logs-aggregator"
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+    /// The part of the subsystem this health is about, e.g. the node of a logs collector; empty if the subsystem has a
+    /// single part
+    #[prost(string, tag = "2")]
+    pub instance: ::prost::alloc::string::String,
+    #[prost(enumeration = "SubsystemHealthStatus", tag = "3")]
+    pub status: i32,
+    /// What's wrong with the subsystem, or how it's set up if it's healthy
+    #[prost(string, tag = "4")]
+    pub message: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                     Update Engine Config
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UpdateEngineConfigArgs {
+    /// The part of the engine config that can change while the engine runs (log retention period, enclave quota, auth tokens and default enclave TTL), as JSON
+    #[prost(string, tag = "1")]
+    pub config_json: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                        Get Audit Log
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetAuditLogArgs {
+    /// Only returns the operations done at or after this time; all of them if unset
+    #[prost(message, optional, tag = "1")]
+    pub since: ::core::option::Option<::prost_types::Timestamp>,
+    /// Only returns the operations done by this principal, e.g. 'token:ci'
+    #[prost(string, optional, tag = "2")]
+    pub principal: ::core::option::Option<::prost::alloc::string::String>,
+    /// Only returns the latest operations, up to this number
+    #[prost(uint32, optional, tag = "3")]
+    pub limit: ::core::option::Option<u32>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AuditLogEntry {
+    #[prost(message, optional, tag = "1")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    /// Who made the call, e.g. 'token:ci' or 'oidc:jane@example.com'; 'unauthenticated' if the engine doesn't require authentication
+    #[prost(string, tag = "2")]
+    pub principal: ::prost::alloc::string::String,
+    /// The engine API the call was made to, 'grpc' or 'rest'
+    #[prost(string, tag = "3")]
+    pub api: ::prost::alloc::string::String,
+    /// The called procedure, e.g. '/engine_api.EngineService/DestroyEnclave', or the method and route of REST calls
+    #[prost(string, tag = "4")]
+    pub operation: ::prost::alloc::string::String,
+    /// SHA-256 of the arguments of the call, so that they can be matched without storing secrets
+    #[prost(string, tag = "5")]
+    pub args_digest: ::prost::alloc::string::String,
+    /// 'ok', or the error code of the call
+    #[prost(string, tag = "6")]
+    pub result: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetAuditLogResponse {
+    /// Ordered from the oldest to the latest
+    #[prost(message, repeated, tag = "1")]
+    pub entries: ::prost::alloc::vec::Vec<AuditLogEntry>,
 }
 /// ==============================================================================================
 ///                                         Create Enclave
@@ -32,6 +143,21 @@ pub struct CreateEnclaveArgs {
     /// This is not an EnclaveMode because we will need to debug both current Modes (Test and Prod)
     #[prost(bool, optional, tag = "5")]
     pub should_apic_run_in_debug_mode: ::core::option::Option<bool>,
+    /// How long the enclave lives before the engine destroys it, as a duration string like '4h' or '30m'. If blank, the engine's default TTL applies, and the enclave lives until it's removed if there's none
+    #[prost(string, optional, tag = "6")]
+    pub ttl: ::core::option::Option<::prost::alloc::string::String>,
+    /// The Kubernetes cluster to create the enclave in, when the engine spans several clusters. If blank, the engine puts the enclave on the cluster with the fewest enclaves
+    #[prost(string, optional, tag = "7")]
+    pub cluster_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// Whether the enclave is created without a logs collector, so that it doesn't carry the logging footprint. The logs of its services are then read straight from the container engine, and aren't kept once the services are removed
+    #[prost(bool, optional, tag = "8")]
+    pub should_skip_logs_collection: ::core::option::Option<bool>,
+    /// Whether the services of the enclave can only reach the destinations of the egress allowlist, besides the enclave itself and the DNS servers
+    #[prost(bool, optional, tag = "9")]
+    pub should_restrict_egress: ::core::option::Option<bool>,
+    /// The CIDRs, IP addresses and domains the services of the enclave can reach when the egress is restricted. Setting any restricts the egress. The domains get resolved once, when the enclave gets created
+    #[prost(string, repeated, tag = "10")]
+    pub egress_allowlist: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -99,6 +225,35 @@ pub struct EnclaveInfo {
     pub creation_time: ::core::option::Option<::prost_types::Timestamp>,
     #[prost(enumeration = "EnclaveMode", tag = "9")]
     pub mode: i32,
+    /// Who created the enclave, e.g. 'token:ci' or 'oidc:jane'. Not present if the engine doesn't require authentication
+    #[prost(string, optional, tag = "10")]
+    pub owner: ::core::option::Option<::prost::alloc::string::String>,
+    /// When the engine will destroy the enclave. Not present if the enclave has no TTL
+    #[prost(message, optional, tag = "11")]
+    pub expiration_time: ::core::option::Option<::prost_types::Timestamp>,
+    /// The Kubernetes cluster the enclave runs in. Not present if the engine doesn't span several clusters
+    #[prost(string, optional, tag = "12")]
+    pub cluster_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// Whether the API container only accepts the calls authenticated with a certificate issued for its enclave, which
+    /// the caller gets from GetApiContainerTlsCredentials
+    #[prost(bool, tag = "14")]
+    pub is_api_container_mtls_required: bool,
+}
+/// The credentials of a client of an API container, issued by the certificate authority the engine creates for its
+/// enclave. Every field is PEM-encoded
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ApiContainerTlsCredentials {
+    /// Certificate of the authority of the enclave, which also issued the certificate of the API container
+    #[prost(string, tag = "1")]
+    pub certificate_authority: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub client_certificate: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub client_key: ::prost::alloc::string::String,
+    /// Whether the connections to the API container must be restricted to the FIPS-approved TLS parameters
+    #[prost(bool, tag = "4")]
+    pub fips_mode: bool,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -110,6 +265,32 @@ pub struct GetEnclavesResponse {
         EnclaveInfo,
     >,
 }
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListEnclavesArgs {
+    /// Only the enclaves in one of these statuses; enclaves in any status if empty
+    #[prost(enumeration = "EnclaveContainersStatus", repeated, tag = "1")]
+    pub statuses: ::prost::alloc::vec::Vec<i32>,
+    /// Only the enclaves created by this owner, e.g. 'token:ci'
+    #[prost(string, optional, tag = "2")]
+    pub owner: ::core::option::Option<::prost::alloc::string::String>,
+    /// The maximum number of enclaves to return; all of them if not set or 0
+    #[prost(uint32, optional, tag = "3")]
+    pub page_size: ::core::option::Option<u32>,
+    /// The next_page_token of the previous response, to get the page after it; the first page if not set
+    #[prost(string, optional, tag = "4")]
+    pub page_token: ::core::option::Option<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListEnclavesResponse {
+    /// The enclaves of the page, the oldest first
+    #[prost(message, repeated, tag = "1")]
+    pub enclave_infos: ::prost::alloc::vec::Vec<EnclaveInfo>,
+    /// To pass as page_token to get the next page; not set if this is the last page
+    #[prost(string, optional, tag = "2")]
+    pub next_page_token: ::core::option::Option<::prost::alloc::string::String>,
+}
 /// An enclave identifier is a collection of uuid, name and shortened uuid
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -150,6 +331,38 @@ pub struct DestroyEnclaveArgs {
     #[prost(string, tag = "1")]
     pub enclave_identifier: ::prost::alloc::string::String,
 }
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ShareEnclaveArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to share
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+    /// Who gets access to the enclave, e.g. 'token:ci' or 'oidc:jane'
+    #[prost(string, tag = "2")]
+    pub principal: ::prost::alloc::string::String,
+    /// If true, the access of the principal is revoked instead
+    #[prost(bool, optional, tag = "3")]
+    pub revoke: ::core::option::Option<bool>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ShareEnclaveResponse {
+    #[prost(string, tag = "1")]
+    pub owner: ::prost::alloc::string::String,
+    /// Who the enclave is shared with, besides its owner and admins
+    #[prost(string, repeated, tag = "2")]
+    pub grantees: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// ==============================================================================================
+///                                Get API Container TLS Credentials
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetApiContainerTlsCredentialsArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave whose API container is called
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+}
 /// ==============================================================================================
 ///                                        Create Enclave
 /// ==============================================================================================
@@ -236,6 +449,86 @@ pub struct LogLineFilter {
     #[prost(string, tag = "2")]
     pub text_pattern: ::prost::alloc::string::String,
 }
+/// ==============================================================================================
+///                                 Get User Service Resource Usage
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetServiceResourceUsageArgs {
+    /// The identifier of the user service's Kurtosis Enclave
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+    /// Only returns the samples taken at or after this time; all the retained ones if unset
+    #[prost(message, optional, tag = "2")]
+    pub since: ::core::option::Option<::prost_types::Timestamp>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetServiceResourceUsageResponse {
+    /// The samples grouped by service UUIDs; services that have no samples yet, e.g. because they just started, are left out
+    #[prost(map = "string, message", tag = "1")]
+    pub resource_usage_by_service_uuid: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ResourceUsageSeries,
+    >,
+    /// How often the engine samples the services
+    #[prost(uint32, tag = "2")]
+    pub sampling_interval_seconds: u32,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ResourceUsageSeries {
+    /// Ordered from the oldest to the latest
+    #[prost(message, repeated, tag = "1")]
+    pub samples: ::prost::alloc::vec::Vec<ResourceUsageSample>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ResourceUsageSample {
+    #[prost(message, optional, tag = "1")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(uint64, tag = "2")]
+    pub cpu_milli_cores: u64,
+    #[prost(uint64, tag = "3")]
+    pub memory_bytes: u64,
+    /// The bytes the service received and sent over the network since it started; unset if the backend doesn't report them
+    #[prost(uint64, optional, tag = "4")]
+    pub network_rx_bytes: ::core::option::Option<u64>,
+    #[prost(uint64, optional, tag = "5")]
+    pub network_tx_bytes: ::core::option::Option<u64>,
+}
+/// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum SubsystemHealthStatus {
+    Healthy = 0,
+    /// The engine works, but not fully, e.g. the logs of some services aren't collected
+    Degraded = 1,
+    /// The engine can't do its job, e.g. no logs are stored at all
+    Unhealthy = 2,
+}
+impl SubsystemHealthStatus {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            SubsystemHealthStatus::Healthy => "SubsystemHealthStatus_HEALTHY",
+            SubsystemHealthStatus::Degraded => "SubsystemHealthStatus_DEGRADED",
+            SubsystemHealthStatus::Unhealthy => "SubsystemHealthStatus_UNHEALTHY",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "SubsystemHealthStatus_HEALTHY" => Some(Self::Healthy),
+            "SubsystemHealthStatus_DEGRADED" => Some(Self::Degraded),
+            "SubsystemHealthStatus_UNHEALTHY" => Some(Self::Unhealthy),
+            _ => None,
+        }
+    }
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum EnclaveMode {
@@ -492,6 +785,111 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "GetEngineInfo"));
             self.inner.unary(req, path, codec).await
         }
+        /// Tells whether the engine API matches the one a client (CLI or SDK) was built for, so that version skew is reported
+        /// clearly before other calls fail in confusing ways
+        pub async fn negotiate_api_version(
+            &mut self,
+            request: impl tonic::IntoRequest<super::NegotiateApiVersionArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::NegotiateApiVersionResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/NegotiateApiVersion",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("engine_api.EngineService", "NegotiateApiVersion"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Applies a new engine config, e.g. new auth tokens, to every engine replica without restarting them; only admins can do it
+        pub async fn update_engine_config(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UpdateEngineConfigArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/UpdateEngineConfig",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "UpdateEngineConfig"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Returns the mutating calls made to the engine APIs, who made them and when; only admins can do it
+        pub async fn get_audit_log(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetAuditLogArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetAuditLogResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetAuditLog",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "GetAuditLog"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Returns the health of the subsystems the engine relies on (backend, logs aggregator and collectors, state store and
+        /// artifacts store), so that e.g. missing logs are noticed before they are needed
+        pub async fn get_engine_health(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEngineHealthResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetEngineHealth",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "GetEngineHealth"));
+            self.inner.unary(req, path, codec).await
+        }
         /// ==============================================================================================
         ///                                   Enclave Management
         /// ==============================================================================================
@@ -547,6 +945,32 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "GetEnclaves"));
             self.inner.unary(req, path, codec).await
         }
+        /// Returns a page of the existing enclaves, the oldest first, optionally only the ones in some statuses or of an owner
+        pub async fn list_enclaves(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ListEnclavesArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListEnclavesResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/ListEnclaves",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "ListEnclaves"));
+            self.inner.unary(req, path, codec).await
+        }
         /// Returns information about all existing & historical enclaves
         pub async fn get_existing_and_historical_enclave_identifiers(
             &mut self,
@@ -624,11 +1048,14 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "DestroyEnclave"));
             self.inner.unary(req, path, codec).await
         }
-        /// Gets rid of old enclaves
-        pub async fn clean(
+        /// Grants or revokes access to an enclave; only the owner of the enclave and admins can do it
+        pub async fn share_enclave(
             &mut self,
-            request: impl tonic::IntoRequest<super::CleanArgs>,
-        ) -> std::result::Result<tonic::Response<super::CleanResponse>, tonic::Status> {
+            request: impl tonic::IntoRequest<super::ShareEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ShareEnclaveResponse>,
+            tonic::Status,
+        > {
             self.inner
                 .ready()
                 .await
@@ -640,19 +1067,20 @@ pub mod engine_service_client {
                 })?;
             let codec = tonic::codec::ProstCodec::default();
             let path = http::uri::PathAndQuery::from_static(
-                "/engine_api.EngineService/Clean",
+                "/engine_api.EngineService/ShareEnclave",
             );
             let mut req = request.into_request();
             req.extensions_mut()
-                .insert(GrpcMethod::new("engine_api.EngineService", "Clean"));
+                .insert(GrpcMethod::new("engine_api.EngineService", "ShareEnclave"));
             self.inner.unary(req, path, codec).await
         }
-        /// Get service logs
-        pub async fn get_service_logs(
+        /// Issues the caller a short-lived certificate to call the API container of an enclave requiring mutual TLS with;
+        /// only the callers that can change the enclave get one
+        pub async fn get_api_container_tls_credentials(
             &mut self,
-            request: impl tonic::IntoRequest<super::GetServiceLogsArgs>,
+            request: impl tonic::IntoRequest<super::GetApiContainerTlsCredentialsArgs>,
         ) -> std::result::Result<
-            tonic::Response<tonic::codec::Streaming<super::GetServiceLogsResponse>>,
+            tonic::Response<super::ApiContainerTlsCredentials>,
             tonic::Status,
         > {
             self.inner
@@ -666,19 +1094,101 @@ pub mod engine_service_client {
                 })?;
             let codec = tonic::codec::ProstCodec::default();
             let path = http::uri::PathAndQuery::from_static(
-                "/engine_api.EngineService/GetServiceLogs",
+                "/engine_api.EngineService/GetApiContainerTlsCredentials",
             );
             let mut req = request.into_request();
             req.extensions_mut()
-                .insert(GrpcMethod::new("engine_api.EngineService", "GetServiceLogs"));
-            self.inner.server_streaming(req, path, codec).await
+                .insert(
+                    GrpcMethod::new(
+                        "engine_api.EngineService",
+                        "GetApiContainerTlsCredentials",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
         }
-    }
-}
-/// Generated server implementations.
-pub mod engine_service_server {
-    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
-    use tonic::codegen::*;
+        /// Gets rid of old enclaves
+        pub async fn clean(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CleanArgs>,
+        ) -> std::result::Result<tonic::Response<super::CleanResponse>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/Clean",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "Clean"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Get service logs
+        pub async fn get_service_logs(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetServiceLogsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::GetServiceLogsResponse>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetServiceLogs",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "GetServiceLogs"));
+            self.inner.server_streaming(req, path, codec).await
+        }
+        /// Returns the CPU, memory and network usage the engine sampled lately for each running service of an enclave
+        pub async fn get_service_resource_usage(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetServiceResourceUsageArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetServiceResourceUsageResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetServiceResourceUsage",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("engine_api.EngineService", "GetServiceResourceUsage"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod engine_service_server {
+    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
+    use tonic::codegen::*;
     /// Generated trait containing gRPC methods that should be implemented for use with EngineServiceServer.
     #[async_trait]
     pub trait EngineService: Send + Sync + 'static {
@@ -690,6 +1200,37 @@ pub mod engine_service_server {
             tonic::Response<super::GetEngineInfoResponse>,
             tonic::Status,
         >;
+        /// Tells whether the engine API matches the one a client (CLI or SDK) was built for, so that version skew is reported
+        /// clearly before other calls fail in confusing ways
+        async fn negotiate_api_version(
+            &self,
+            request: tonic::Request<super::NegotiateApiVersionArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::NegotiateApiVersionResponse>,
+            tonic::Status,
+        >;
+        /// Applies a new engine config, e.g. new auth tokens, to every engine replica without restarting them; only admins can do it
+        async fn update_engine_config(
+            &self,
+            request: tonic::Request<super::UpdateEngineConfigArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Returns the mutating calls made to the engine APIs, who made them and when; only admins can do it
+        async fn get_audit_log(
+            &self,
+            request: tonic::Request<super::GetAuditLogArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetAuditLogResponse>,
+            tonic::Status,
+        >;
+        /// Returns the health of the subsystems the engine relies on (backend, logs aggregator and collectors, state store and
+        /// artifacts store), so that e.g. missing logs are noticed before they are needed
+        async fn get_engine_health(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEngineHealthResponse>,
+            tonic::Status,
+        >;
         /// ==============================================================================================
         ///                                   Enclave Management
         /// ==============================================================================================
@@ -709,6 +1250,14 @@ pub mod engine_service_server {
             tonic::Response<super::GetEnclavesResponse>,
             tonic::Status,
         >;
+        /// Returns a page of the existing enclaves, the oldest first, optionally only the ones in some statuses or of an owner
+        async fn list_enclaves(
+            &self,
+            request: tonic::Request<super::ListEnclavesArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListEnclavesResponse>,
+            tonic::Status,
+        >;
         /// Returns information about all existing & historical enclaves
         async fn get_existing_and_historical_enclave_identifiers(
             &self,
@@ -727,6 +1276,23 @@ pub mod engine_service_server {
             &self,
             request: tonic::Request<super::DestroyEnclaveArgs>,
         ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Grants or revokes access to an enclave; only the owner of the enclave and admins can do it
+        async fn share_enclave(
+            &self,
+            request: tonic::Request<super::ShareEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ShareEnclaveResponse>,
+            tonic::Status,
+        >;
+        /// Issues the caller a short-lived certificate to call the API container of an enclave requiring mutual TLS with;
+        /// only the callers that can change the enclave get one
+        async fn get_api_container_tls_credentials(
+            &self,
+            request: tonic::Request<super::GetApiContainerTlsCredentialsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ApiContainerTlsCredentials>,
+            tonic::Status,
+        >;
         /// Gets rid of old enclaves
         async fn clean(
             &self,
@@ -746,6 +1312,14 @@ pub mod engine_service_server {
             tonic::Response<Self::GetServiceLogsStream>,
             tonic::Status,
         >;
+        /// Returns the CPU, memory and network usage the engine sampled lately for each running service of an enclave
+        async fn get_service_resource_usage(
+            &self,
+            request: tonic::Request<super::GetServiceResourceUsageArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetServiceResourceUsageResponse>,
+            tonic::Status,
+        >;
     }
     #[derive(Debug)]
     pub struct EngineServiceServer<T: EngineService> {
@@ -867,6 +1441,185 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/NegotiateApiVersion" => {
+                    #[allow(non_camel_case_types)]
+                    struct NegotiateApiVersionSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::NegotiateApiVersionArgs>
+                    for NegotiateApiVersionSvc<T> {
+                        type Response = super::NegotiateApiVersionResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::NegotiateApiVersionArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).negotiate_api_version(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = NegotiateApiVersionSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/UpdateEngineConfig" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpdateEngineConfigSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::UpdateEngineConfigArgs>
+                    for UpdateEngineConfigSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpdateEngineConfigArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).update_engine_config(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = UpdateEngineConfigSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/GetAuditLog" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetAuditLogSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::GetAuditLogArgs>
+                    for GetAuditLogSvc<T> {
+                        type Response = super::GetAuditLogResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetAuditLogArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_audit_log(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetAuditLogSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/GetEngineHealth" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetEngineHealthSvc<T: EngineService>(pub Arc<T>);
+                    impl<T: EngineService> tonic::server::UnaryService<()>
+                    for GetEngineHealthSvc<T> {
+                        type Response = super::GetEngineHealthResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_engine_health(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetEngineHealthSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/engine_api.EngineService/CreateEnclave" => {
                     #[allow(non_camel_case_types)]
                     struct CreateEnclaveSvc<T: EngineService>(pub Arc<T>);
@@ -954,6 +1707,52 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/ListEnclaves" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListEnclavesSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::ListEnclavesArgs>
+                    for ListEnclavesSvc<T> {
+                        type Response = super::ListEnclavesResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ListEnclavesArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).list_enclaves(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ListEnclavesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers" => {
                     #[allow(non_camel_case_types)]
                     struct GetExistingAndHistoricalEnclaveIdentifiersSvc<
@@ -1095,6 +1894,101 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/ShareEnclave" => {
+                    #[allow(non_camel_case_types)]
+                    struct ShareEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::ShareEnclaveArgs>
+                    for ShareEnclaveSvc<T> {
+                        type Response = super::ShareEnclaveResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ShareEnclaveArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).share_enclave(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ShareEnclaveSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/GetApiContainerTlsCredentials" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetApiContainerTlsCredentialsSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<
+                        super::GetApiContainerTlsCredentialsArgs,
+                    > for GetApiContainerTlsCredentialsSvc<T> {
+                        type Response = super::ApiContainerTlsCredentials;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetApiContainerTlsCredentialsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner)
+                                    .get_api_container_tls_credentials(request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetApiContainerTlsCredentialsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/engine_api.EngineService/Clean" => {
                     #[allow(non_camel_case_types)]
                     struct CleanSvc<T: EngineService>(pub Arc<T>);
@@ -1184,6 +2078,52 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/GetServiceResourceUsage" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetServiceResourceUsageSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::GetServiceResourceUsageArgs>
+                    for GetServiceResourceUsageSvc<T> {
+                        type Response = super::GetServiceResourceUsageResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetServiceResourceUsageArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_service_resource_usage(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetServiceResourceUsageSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
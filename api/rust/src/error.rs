@@ -0,0 +1,74 @@
+use std::fmt;
+
+/// The errors returned by [`KurtosisContext`](crate::kurtosis_context::KurtosisContext) and
+/// [`EnclaveContext`](crate::enclave_context::EnclaveContext)
+#[derive(Debug)]
+pub enum KurtosisError {
+    /// The engine or the API container of an enclave couldn't be connected to
+    Connection(tonic::transport::Error),
+    /// A call to the engine or to the API container of an enclave failed
+    Call(tonic::Status),
+    /// The enclave, service or files artifact a call refers to doesn't exist
+    NotFound(String),
+    /// The enclave can't be used, e.g. because it's stopped
+    Unavailable(String),
+    /// The engine or the API container answered something unexpected, e.g. a corrupted files artifact
+    InvalidResponse(String),
+}
+
+pub type Result<T> = std::result::Result<T, KurtosisError>;
+
+impl KurtosisError {
+    /// Returns true if the enclave, service or files artifact a call refers to doesn't exist, whether the SDK or the
+    /// server noticed it
+    pub fn is_not_found(&self) -> bool {
+        match self {
+            KurtosisError::NotFound(_) => true,
+            KurtosisError::Call(status) => status.code() == tonic::Code::NotFound,
+            _ => false,
+        }
+    }
+
+    /// Returns true if the engine or the API container couldn't be reached; the call may succeed if retried
+    pub fn is_unavailable(&self) -> bool {
+        match self {
+            KurtosisError::Connection(_) => true,
+            KurtosisError::Call(status) => status.code() == tonic::Code::Unavailable,
+            _ => false,
+        }
+    }
+}
+
+impl fmt::Display for KurtosisError {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {
+            KurtosisError::Connection(err) => write!(f, "An error occurred connecting to Kurtosis: {}", err),
+            KurtosisError::Call(status) => write!(f, "A call to Kurtosis failed with code '{}': {}", status.code(), status.message()),
+            KurtosisError::NotFound(message) => write!(f, "{}", message),
+            KurtosisError::Unavailable(message) => write!(f, "{}", message),
+            KurtosisError::InvalidResponse(message) => write!(f, "{}", message),
+        }
+    }
+}
+
+impl std::error::Error for KurtosisError {
+    fn source(&self) -> Option<&(dyn std::error::Error + 'static)> {
+        match self {
+            KurtosisError::Connection(err) => Some(err),
+            KurtosisError::Call(status) => Some(status),
+            _ => None,
+        }
+    }
+}
+
+impl From<tonic::transport::Error> for KurtosisError {
+    fn from(err: tonic::transport::Error) -> Self {
+        KurtosisError::Connection(err)
+    }
+}
+
+impl From<tonic::Status> for KurtosisError {
+    fn from(status: tonic::Status) -> Self {
+        KurtosisError::Call(status)
+    }
+}
@@ -0,0 +1,132 @@
+use std::collections::{HashMap, HashSet};
+
+use tonic::codec::Streaming;
+use tonic::transport::Channel;
+
+use crate::enclave_context::EnclaveContext;
+use crate::engine_api::engine_service_client::EngineServiceClient;
+use crate::engine_api::{
+    CreateEnclaveArgs, DestroyEnclaveArgs, EnclaveInfo, GetServiceLogsArgs, GetServiceLogsResponse, LogLineFilter,
+    StopEnclaveArgs,
+};
+use crate::error::{KurtosisError, Result};
+
+pub const DEFAULT_GRPC_ENGINE_SERVER_PORT_NUM: u16 = 9710;
+
+const LOCAL_HOST_IP_ADDRESS: &str = "127.0.0.1";
+
+/// The entry point of the SDK: manages the enclaves of an engine and gets the logs of their services
+#[derive(Debug, Clone)]
+pub struct KurtosisContext {
+    engine_client: EngineServiceClient<Channel>,
+}
+
+impl KurtosisContext {
+    /// Connects to the engine running on this machine, started with `kurtosis engine start`
+    pub async fn new_from_local_engine() -> Result<Self> {
+        let engine_url = format!("http://{}:{}", LOCAL_HOST_IP_ADDRESS, DEFAULT_GRPC_ENGINE_SERVER_PORT_NUM);
+        Self::new_from_engine_url(&engine_url).await
+    }
+
+    /// Connects to the engine listening at the URL, e.g. `http://127.0.0.1:9710`
+    pub async fn new_from_engine_url(engine_url: &str) -> Result<Self> {
+        let engine_client = EngineServiceClient::connect(engine_url.to_string()).await?;
+        Ok(Self::new_from_engine_client(engine_client))
+    }
+
+    /// Uses a client set up by the caller, e.g. with TLS or an interceptor adding an authentication token
+    pub fn new_from_engine_client(engine_client: EngineServiceClient<Channel>) -> Self {
+        Self { engine_client }
+    }
+
+    /// Gives access to all the engine calls, including the ones this context doesn't wrap
+    pub fn engine_client(&self) -> EngineServiceClient<Channel> {
+        self.engine_client.clone()
+    }
+
+    /// Creates an enclave with the default API container version and log level, and connects to it
+    pub async fn create_enclave(&mut self, enclave_name: &str) -> Result<EnclaveContext> {
+        let args = CreateEnclaveArgs {
+            enclave_name: Some(enclave_name.to_string()),
+            api_container_version_tag: None,
+            api_container_log_level: None,
+            mode: None,
+            should_apic_run_in_debug_mode: None,
+            ttl: None,
+        };
+        let response = self.engine_client.create_enclave(args).await?.into_inner();
+        let enclave_info = response.enclave_info.ok_or_else(|| {
+            KurtosisError::InvalidResponse(format!("The engine didn't return the info of the created enclave '{}'", enclave_name))
+        })?;
+        EnclaveContext::new_from_enclave_info(&enclave_info).await
+    }
+
+    /// Connects to an existing enclave, identified by its UUID, shortened UUID or name
+    pub async fn get_enclave_context(&mut self, enclave_identifier: &str) -> Result<EnclaveContext> {
+        let enclave_info = self.get_enclave(enclave_identifier).await?;
+        EnclaveContext::new_from_enclave_info(&enclave_info).await
+    }
+
+    /// Returns the enclaves of the engine, by UUID
+    pub async fn get_enclaves(&mut self) -> Result<HashMap<String, EnclaveInfo>> {
+        let response = self.engine_client.get_enclaves(()).await?.into_inner();
+        Ok(response.enclave_info)
+    }
+
+    /// Returns the enclave identified by its UUID, shortened UUID or name
+    pub async fn get_enclave(&mut self, enclave_identifier: &str) -> Result<EnclaveInfo> {
+        let enclaves = self.get_enclaves().await?;
+        if let Some(enclave_info) = enclaves.get(enclave_identifier) {
+            return Ok(enclave_info.clone());
+        }
+        let matching_enclaves: Vec<&EnclaveInfo> = enclaves
+            .values()
+            .filter(|enclave_info| enclave_info.name == enclave_identifier || enclave_info.shortened_uuid == enclave_identifier)
+            .collect();
+        match matching_enclaves.as_slice() {
+            [enclave_info] => Ok((*enclave_info).clone()),
+            [] => Err(KurtosisError::NotFound(format!("No enclave found for identifier '{}'", enclave_identifier))),
+            _ => Err(KurtosisError::InvalidResponse(format!(
+                "Found {} enclaves for identifier '{}'; use the enclave UUID instead",
+                matching_enclaves.len(),
+                enclave_identifier
+            ))),
+        }
+    }
+
+    pub async fn stop_enclave(&mut self, enclave_identifier: &str) -> Result<()> {
+        let args = StopEnclaveArgs { enclave_identifier: enclave_identifier.to_string() };
+        self.engine_client.stop_enclave(args).await?;
+        Ok(())
+    }
+
+    pub async fn destroy_enclave(&mut self, enclave_identifier: &str) -> Result<()> {
+        let args = DestroyEnclaveArgs { enclave_identifier: enclave_identifier.to_string() };
+        self.engine_client.destroy_enclave(args).await?;
+        Ok(())
+    }
+
+    /// Streams the logs of services identified by their UUID, the oldest line first. The stream ends after the last
+    /// existing line unless `should_follow_logs` is true; if `should_return_all_logs` is false, only the last
+    /// `num_log_lines` existing lines are sent. Each filter is applied to the lines the previous one kept, like grep
+    pub async fn get_service_logs(
+        &mut self,
+        enclave_identifier: &str,
+        service_uuids: &HashSet<String>,
+        should_follow_logs: bool,
+        should_return_all_logs: bool,
+        num_log_lines: u32,
+        log_line_filters: Vec<LogLineFilter>,
+    ) -> Result<Streaming<GetServiceLogsResponse>> {
+        let args = GetServiceLogsArgs {
+            enclave_identifier: enclave_identifier.to_string(),
+            service_uuid_set: service_uuids.iter().map(|service_uuid| (service_uuid.clone(), true)).collect(),
+            follow_logs: Some(should_follow_logs),
+            conjunctive_filters: log_line_filters,
+            return_all_logs: Some(should_return_all_logs),
+            num_log_lines: Some(num_log_lines),
+        };
+        let stream = self.engine_client.get_service_logs(args).await?.into_inner();
+        Ok(stream)
+    }
+}
@@ -4,4 +4,8 @@ pub mod engine_api {
 
 pub mod enclave_api {
 	include!("./api_container_api.rs");
-}
\ No newline at end of file
+}
+
+pub mod error;
+pub mod kurtosis_context;
+pub mod enclave_context;
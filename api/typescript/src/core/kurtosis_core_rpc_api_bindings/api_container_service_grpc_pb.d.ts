@@ -13,6 +13,8 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   runStarlarkPackage: grpc.MethodDefinition<api_container_service_pb.RunStarlarkPackageArgs, api_container_service_pb.StarlarkRunResponseLine>;
   getServices: grpc.MethodDefinition<api_container_service_pb.GetServicesArgs, api_container_service_pb.GetServicesResponse>;
   getExistingAndHistoricalServiceIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
+  getServiceDependencyGraph: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetServiceDependencyGraphResponse>;
+  setEnclaveEnvVars: grpc.MethodDefinition<api_container_service_pb.SetEnclaveEnvVarsArgs, google_protobuf_empty_pb.Empty>;
   execCommand: grpc.MethodDefinition<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandResponse>;
   waitForHttpGetEndpointAvailability: grpc.MethodDefinition<api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
   waitForHttpPostEndpointAvailability: grpc.MethodDefinition<api_container_service_pb.WaitForHttpPostEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
@@ -22,10 +24,15 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   storeFilesArtifactFromService: grpc.MethodDefinition<api_container_service_pb.StoreFilesArtifactFromServiceArgs, api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
   listFilesArtifactNamesAndUuids: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
   inspectFilesArtifactContents: grpc.MethodDefinition<api_container_service_pb.InspectFilesArtifactContentsRequest, api_container_service_pb.InspectFilesArtifactContentsResponse>;
+  getFilesArtifactHistory: grpc.MethodDefinition<api_container_service_pb.GetFilesArtifactHistoryArgs, api_container_service_pb.GetFilesArtifactHistoryResponse>;
   connectServices: grpc.MethodDefinition<api_container_service_pb.ConnectServicesArgs, api_container_service_pb.ConnectServicesResponse>;
   getStarlarkRun: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunResponse>;
   getStarlarkScriptPlanYaml: grpc.MethodDefinition<api_container_service_pb.StarlarkScriptPlanYamlArgs, api_container_service_pb.PlanYaml>;
   getStarlarkPackagePlanYaml: grpc.MethodDefinition<api_container_service_pb.StarlarkPackagePlanYamlArgs, api_container_service_pb.PlanYaml>;
+  watchServiceEvents: grpc.MethodDefinition<api_container_service_pb.WatchServiceEventsArgs, api_container_service_pb.ServiceEvent>;
+  startServices: grpc.MethodDefinition<api_container_service_pb.BulkServiceOperationArgs, api_container_service_pb.BulkServiceOperationResponse>;
+  stopServices: grpc.MethodDefinition<api_container_service_pb.BulkServiceOperationArgs, api_container_service_pb.BulkServiceOperationResponse>;
+  removeServices: grpc.MethodDefinition<api_container_service_pb.BulkServiceOperationArgs, api_container_service_pb.BulkServiceOperationResponse>;
 }
 
 export const ApiContainerServiceService: IApiContainerServiceService;
@@ -36,6 +43,8 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   runStarlarkPackage: grpc.handleServerStreamingCall<api_container_service_pb.RunStarlarkPackageArgs, api_container_service_pb.StarlarkRunResponseLine>;
   getServices: grpc.handleUnaryCall<api_container_service_pb.GetServicesArgs, api_container_service_pb.GetServicesResponse>;
   getExistingAndHistoricalServiceIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
+  getServiceDependencyGraph: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetServiceDependencyGraphResponse>;
+  setEnclaveEnvVars: grpc.handleUnaryCall<api_container_service_pb.SetEnclaveEnvVarsArgs, google_protobuf_empty_pb.Empty>;
   execCommand: grpc.handleUnaryCall<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandResponse>;
   waitForHttpGetEndpointAvailability: grpc.handleUnaryCall<api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
   waitForHttpPostEndpointAvailability: grpc.handleUnaryCall<api_container_service_pb.WaitForHttpPostEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
@@ -45,10 +54,15 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   storeFilesArtifactFromService: grpc.handleUnaryCall<api_container_service_pb.StoreFilesArtifactFromServiceArgs, api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
   listFilesArtifactNamesAndUuids: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
   inspectFilesArtifactContents: grpc.handleUnaryCall<api_container_service_pb.InspectFilesArtifactContentsRequest, api_container_service_pb.InspectFilesArtifactContentsResponse>;
+  getFilesArtifactHistory: grpc.handleUnaryCall<api_container_service_pb.GetFilesArtifactHistoryArgs, api_container_service_pb.GetFilesArtifactHistoryResponse>;
   connectServices: grpc.handleUnaryCall<api_container_service_pb.ConnectServicesArgs, api_container_service_pb.ConnectServicesResponse>;
   getStarlarkRun: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunResponse>;
   getStarlarkScriptPlanYaml: grpc.handleUnaryCall<api_container_service_pb.StarlarkScriptPlanYamlArgs, api_container_service_pb.PlanYaml>;
   getStarlarkPackagePlanYaml: grpc.handleUnaryCall<api_container_service_pb.StarlarkPackagePlanYamlArgs, api_container_service_pb.PlanYaml>;
+  watchServiceEvents: grpc.handleServerStreamingCall<api_container_service_pb.WatchServiceEventsArgs, api_container_service_pb.ServiceEvent>;
+  startServices: grpc.handleUnaryCall<api_container_service_pb.BulkServiceOperationArgs, api_container_service_pb.BulkServiceOperationResponse>;
+  stopServices: grpc.handleUnaryCall<api_container_service_pb.BulkServiceOperationArgs, api_container_service_pb.BulkServiceOperationResponse>;
+  removeServices: grpc.handleUnaryCall<api_container_service_pb.BulkServiceOperationArgs, api_container_service_pb.BulkServiceOperationResponse>;
 }
 
 export class ApiContainerServiceClient extends grpc.Client {
@@ -66,6 +80,12 @@ export class ApiContainerServiceClient extends grpc.Client {
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
+  getServiceDependencyGraph(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetServiceDependencyGraphResponse>): grpc.ClientUnaryCall;
+  getServiceDependencyGraph(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServiceDependencyGraphResponse>): grpc.ClientUnaryCall;
+  getServiceDependencyGraph(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServiceDependencyGraphResponse>): grpc.ClientUnaryCall;
+  setEnclaveEnvVars(argument: api_container_service_pb.SetEnclaveEnvVarsArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setEnclaveEnvVars(argument: api_container_service_pb.SetEnclaveEnvVarsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setEnclaveEnvVars(argument: api_container_service_pb.SetEnclaveEnvVarsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
@@ -92,6 +112,9 @@ export class ApiContainerServiceClient extends grpc.Client {
   inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsRequest, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
   inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsRequest, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
   inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsRequest, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
+  getFilesArtifactHistory(argument: api_container_service_pb.GetFilesArtifactHistoryArgs, callback: grpc.requestCallback<api_container_service_pb.GetFilesArtifactHistoryResponse>): grpc.ClientUnaryCall;
+  getFilesArtifactHistory(argument: api_container_service_pb.GetFilesArtifactHistoryArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetFilesArtifactHistoryResponse>): grpc.ClientUnaryCall;
+  getFilesArtifactHistory(argument: api_container_service_pb.GetFilesArtifactHistoryArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetFilesArtifactHistoryResponse>): grpc.ClientUnaryCall;
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
@@ -104,4 +127,15 @@ export class ApiContainerServiceClient extends grpc.Client {
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
+  watchServiceEvents(argument: api_container_service_pb.WatchServiceEventsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ServiceEvent>;
+  watchServiceEvents(argument: api_container_service_pb.WatchServiceEventsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ServiceEvent>;
+  startServices(argument: api_container_service_pb.BulkServiceOperationArgs, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
+  startServices(argument: api_container_service_pb.BulkServiceOperationArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
+  startServices(argument: api_container_service_pb.BulkServiceOperationArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
+  stopServices(argument: api_container_service_pb.BulkServiceOperationArgs, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
+  stopServices(argument: api_container_service_pb.BulkServiceOperationArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
+  stopServices(argument: api_container_service_pb.BulkServiceOperationArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
+  removeServices(argument: api_container_service_pb.BulkServiceOperationArgs, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
+  removeServices(argument: api_container_service_pb.BulkServiceOperationArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
+  removeServices(argument: api_container_service_pb.BulkServiceOperationArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.BulkServiceOperationResponse>): grpc.ClientUnaryCall;
 }
@@ -4,6 +4,29 @@
 var grpc = require('@grpc/grpc-js');
 var api_container_service_pb = require('./api_container_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+
+function serialize_api_container_api_BulkServiceOperationArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.BulkServiceOperationArgs)) {
+    throw new Error('Expected argument of type api_container_api.BulkServiceOperationArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_BulkServiceOperationArgs(buffer_arg) {
+  return api_container_service_pb.BulkServiceOperationArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_BulkServiceOperationResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.BulkServiceOperationResponse)) {
+    throw new Error('Expected argument of type api_container_api.BulkServiceOperationResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_BulkServiceOperationResponse(buffer_arg) {
+  return api_container_service_pb.BulkServiceOperationResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
 
 function serialize_api_container_api_ConnectServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.ConnectServicesArgs)) {
@@ -71,6 +94,39 @@ function deserialize_api_container_api_GetExistingAndHistoricalServiceIdentifier
   return api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetFilesArtifactHistoryArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetFilesArtifactHistoryArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetFilesArtifactHistoryArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetFilesArtifactHistoryArgs(buffer_arg) {
+  return api_container_service_pb.GetFilesArtifactHistoryArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetFilesArtifactHistoryResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetFilesArtifactHistoryResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetFilesArtifactHistoryResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetFilesArtifactHistoryResponse(buffer_arg) {
+  return api_container_service_pb.GetFilesArtifactHistoryResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetServiceDependencyGraphResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServiceDependencyGraphResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetServiceDependencyGraphResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServiceDependencyGraphResponse(buffer_arg) {
+  return api_container_service_pb.GetServiceDependencyGraphResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.GetServicesArgs)) {
     throw new Error('Expected argument of type api_container_api.GetServicesArgs');
@@ -170,6 +226,28 @@ function deserialize_api_container_api_RunStarlarkScriptArgs(buffer_arg) {
   return api_container_service_pb.RunStarlarkScriptArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ServiceEvent(arg) {
+  if (!(arg instanceof api_container_service_pb.ServiceEvent)) {
+    throw new Error('Expected argument of type api_container_api.ServiceEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ServiceEvent(buffer_arg) {
+  return api_container_service_pb.ServiceEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_SetEnclaveEnvVarsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.SetEnclaveEnvVarsArgs)) {
+    throw new Error('Expected argument of type api_container_api.SetEnclaveEnvVarsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_SetEnclaveEnvVarsArgs(buffer_arg) {
+  return api_container_service_pb.SetEnclaveEnvVarsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_StarlarkPackagePlanYamlArgs(arg) {
   if (!(arg instanceof api_container_service_pb.StarlarkPackagePlanYamlArgs)) {
     throw new Error('Expected argument of type api_container_api.StarlarkPackagePlanYamlArgs');
@@ -291,6 +369,17 @@ function deserialize_api_container_api_WaitForHttpPostEndpointAvailabilityArgs(b
   return api_container_service_pb.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_WatchServiceEventsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.WatchServiceEventsArgs)) {
+    throw new Error('Expected argument of type api_container_api.WatchServiceEventsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_WatchServiceEventsArgs(buffer_arg) {
+  return api_container_service_pb.WatchServiceEventsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_google_protobuf_Empty(arg) {
   if (!(arg instanceof google_protobuf_empty_pb.Empty)) {
     throw new Error('Expected argument of type google.protobuf.Empty');
@@ -364,6 +453,31 @@ getExistingAndHistoricalServiceIdentifiers: {
     responseSerialize: serialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse,
     responseDeserialize: deserialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse,
   },
+  // Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+getServiceDependencyGraph: {
+    path: '/api_container_api.ApiContainerService/GetServiceDependencyGraph',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetServiceDependencyGraphResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetServiceDependencyGraphResponse,
+    responseDeserialize: deserialize_api_container_api_GetServiceDependencyGraphResponse,
+  },
+  // Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+  // a service config take precedence over the enclave ones
+setEnclaveEnvVars: {
+    path: '/api_container_api.ApiContainerService/SetEnclaveEnvVars',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.SetEnclaveEnvVarsArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_SetEnclaveEnvVarsArgs,
+    requestDeserialize: deserialize_api_container_api_SetEnclaveEnvVarsArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
   // Executes the given command inside a running container
 execCommand: {
     path: '/api_container_api.ApiContainerService/ExecCommand',
@@ -470,6 +584,18 @@ storeFilesArtifactFromService: {
     responseSerialize: serialize_api_container_api_InspectFilesArtifactContentsResponse,
     responseDeserialize: deserialize_api_container_api_InspectFilesArtifactContentsResponse,
   },
+  // Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+getFilesArtifactHistory: {
+    path: '/api_container_api.ApiContainerService/GetFilesArtifactHistory',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.GetFilesArtifactHistoryArgs,
+    responseType: api_container_service_pb.GetFilesArtifactHistoryResponse,
+    requestSerialize: serialize_api_container_api_GetFilesArtifactHistoryArgs,
+    requestDeserialize: deserialize_api_container_api_GetFilesArtifactHistoryArgs,
+    responseSerialize: serialize_api_container_api_GetFilesArtifactHistoryResponse,
+    responseDeserialize: deserialize_api_container_api_GetFilesArtifactHistoryResponse,
+  },
   // User services port forwarding
 connectServices: {
     path: '/api_container_api.ApiContainerService/ConnectServices',
@@ -518,6 +644,54 @@ getStarlarkPackagePlanYaml: {
     responseSerialize: serialize_api_container_api_PlanYaml,
     responseDeserialize: deserialize_api_container_api_PlanYaml,
   },
+  // Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+watchServiceEvents: {
+    path: '/api_container_api.ApiContainerService/WatchServiceEvents',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.WatchServiceEventsArgs,
+    responseType: api_container_service_pb.ServiceEvent,
+    requestSerialize: serialize_api_container_api_WatchServiceEventsArgs,
+    requestDeserialize: deserialize_api_container_api_WatchServiceEventsArgs,
+    responseSerialize: serialize_api_container_api_ServiceEvent,
+    responseDeserialize: deserialize_api_container_api_ServiceEvent,
+  },
+  // Starts either all the services or none of them: the ones that started are stopped back if any fails to
+startServices: {
+    path: '/api_container_api.ApiContainerService/StartServices',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.BulkServiceOperationArgs,
+    responseType: api_container_service_pb.BulkServiceOperationResponse,
+    requestSerialize: serialize_api_container_api_BulkServiceOperationArgs,
+    requestDeserialize: deserialize_api_container_api_BulkServiceOperationArgs,
+    responseSerialize: serialize_api_container_api_BulkServiceOperationResponse,
+    responseDeserialize: deserialize_api_container_api_BulkServiceOperationResponse,
+  },
+  // Stops either all the services or none of them: the ones that stopped are started back if any fails to
+stopServices: {
+    path: '/api_container_api.ApiContainerService/StopServices',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.BulkServiceOperationArgs,
+    responseType: api_container_service_pb.BulkServiceOperationResponse,
+    requestSerialize: serialize_api_container_api_BulkServiceOperationArgs,
+    requestDeserialize: deserialize_api_container_api_BulkServiceOperationArgs,
+    responseSerialize: serialize_api_container_api_BulkServiceOperationResponse,
+    responseDeserialize: deserialize_api_container_api_BulkServiceOperationResponse,
+  },
+  // Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+removeServices: {
+    path: '/api_container_api.ApiContainerService/RemoveServices',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.BulkServiceOperationArgs,
+    responseType: api_container_service_pb.BulkServiceOperationResponse,
+    requestSerialize: serialize_api_container_api_BulkServiceOperationArgs,
+    requestDeserialize: deserialize_api_container_api_BulkServiceOperationArgs,
+    responseSerialize: serialize_api_container_api_BulkServiceOperationResponse,
+    responseDeserialize: deserialize_api_container_api_BulkServiceOperationResponse,
+  },
 };
 
 exports.ApiContainerServiceClient = grpc.makeGenericClientConstructor(ApiContainerServiceService);
@@ -33,6 +33,20 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
 
+  getServiceDependencyGraph(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetServiceDependencyGraphResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServiceDependencyGraphResponse>;
+
+  setEnclaveEnvVars(
+    request: api_container_service_pb.SetEnclaveEnvVarsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
   execCommand(
     request: api_container_service_pb.ExecCommandArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -87,6 +101,13 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.InspectFilesArtifactContentsResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.InspectFilesArtifactContentsResponse>;
 
+  getFilesArtifactHistory(
+    request: api_container_service_pb.GetFilesArtifactHistoryArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetFilesArtifactHistoryResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetFilesArtifactHistoryResponse>;
+
   connectServices(
     request: api_container_service_pb.ConnectServicesArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -115,6 +136,32 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.PlanYaml) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.PlanYaml>;
 
+  watchServiceEvents(
+    request: api_container_service_pb.WatchServiceEventsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ServiceEvent>;
+
+  startServices(
+    request: api_container_service_pb.BulkServiceOperationArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.BulkServiceOperationResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.BulkServiceOperationResponse>;
+
+  stopServices(
+    request: api_container_service_pb.BulkServiceOperationArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.BulkServiceOperationResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.BulkServiceOperationResponse>;
+
+  removeServices(
+    request: api_container_service_pb.BulkServiceOperationArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.BulkServiceOperationResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.BulkServiceOperationResponse>;
+
 }
 
 export class ApiContainerServicePromiseClient {
@@ -142,6 +189,16 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
 
+  getServiceDependencyGraph(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetServiceDependencyGraphResponse>;
+
+  setEnclaveEnvVars(
+    request: api_container_service_pb.SetEnclaveEnvVarsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
   execCommand(
     request: api_container_service_pb.ExecCommandArgs,
     metadata?: grpcWeb.Metadata
@@ -182,6 +239,11 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.InspectFilesArtifactContentsResponse>;
 
+  getFilesArtifactHistory(
+    request: api_container_service_pb.GetFilesArtifactHistoryArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetFilesArtifactHistoryResponse>;
+
   connectServices(
     request: api_container_service_pb.ConnectServicesArgs,
     metadata?: grpcWeb.Metadata
@@ -202,5 +264,25 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.PlanYaml>;
 
+  watchServiceEvents(
+    request: api_container_service_pb.WatchServiceEventsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ServiceEvent>;
+
+  startServices(
+    request: api_container_service_pb.BulkServiceOperationArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.BulkServiceOperationResponse>;
+
+  stopServices(
+    request: api_container_service_pb.BulkServiceOperationArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.BulkServiceOperationResponse>;
+
+  removeServices(
+    request: api_container_service_pb.BulkServiceOperationArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.BulkServiceOperationResponse>;
+
 }
 
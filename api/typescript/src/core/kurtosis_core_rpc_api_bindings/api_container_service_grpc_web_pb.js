@@ -21,6 +21,8 @@ grpc.web = require('grpc-web');
 
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.api_container_api = require('./api_container_service_pb.js');
 
@@ -310,6 +312,128 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getExistingAn
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetServiceDependencyGraphResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetServiceDependencyGraph = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetServiceDependencyGraph',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetServiceDependencyGraphResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetServiceDependencyGraphResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetServiceDependencyGraphResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetServiceDependencyGraphResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getServiceDependencyGraph =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServiceDependencyGraph',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServiceDependencyGraph,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetServiceDependencyGraphResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getServiceDependencyGraph =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServiceDependencyGraph',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServiceDependencyGraph);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.SetEnclaveEnvVarsArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_SetEnclaveEnvVars = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/SetEnclaveEnvVars',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.SetEnclaveEnvVarsArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.SetEnclaveEnvVarsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.SetEnclaveEnvVarsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.setEnclaveEnvVars =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetEnclaveEnvVars',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetEnclaveEnvVars,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.SetEnclaveEnvVarsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.setEnclaveEnvVars =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetEnclaveEnvVars',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetEnclaveEnvVars);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -793,6 +917,67 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.inspectFilesA
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetFilesArtifactHistoryArgs,
+ *   !proto.api_container_api.GetFilesArtifactHistoryResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetFilesArtifactHistory = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetFilesArtifactHistory',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.GetFilesArtifactHistoryArgs,
+  proto.api_container_api.GetFilesArtifactHistoryResponse,
+  /**
+   * @param {!proto.api_container_api.GetFilesArtifactHistoryArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetFilesArtifactHistoryResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetFilesArtifactHistoryArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetFilesArtifactHistoryResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetFilesArtifactHistoryResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getFilesArtifactHistory =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetFilesArtifactHistory',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetFilesArtifactHistory,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetFilesArtifactHistoryArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetFilesArtifactHistoryResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getFilesArtifactHistory =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetFilesArtifactHistory',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetFilesArtifactHistory);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -1037,5 +1222,244 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkPa
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.WatchServiceEventsArgs,
+ *   !proto.api_container_api.ServiceEvent>}
+ */
+const methodDescriptor_ApiContainerService_WatchServiceEvents = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/WatchServiceEvents',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.WatchServiceEventsArgs,
+  proto.api_container_api.ServiceEvent,
+  /**
+   * @param {!proto.api_container_api.WatchServiceEventsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ServiceEvent.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.WatchServiceEventsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ServiceEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.watchServiceEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/WatchServiceEvents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WatchServiceEvents);
+};
+
+
+/**
+ * @param {!proto.api_container_api.WatchServiceEventsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ServiceEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.watchServiceEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/WatchServiceEvents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WatchServiceEvents);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.BulkServiceOperationArgs,
+ *   !proto.api_container_api.BulkServiceOperationResponse>}
+ */
+const methodDescriptor_ApiContainerService_StartServices = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/StartServices',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.BulkServiceOperationArgs,
+  proto.api_container_api.BulkServiceOperationResponse,
+  /**
+   * @param {!proto.api_container_api.BulkServiceOperationArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.BulkServiceOperationResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.BulkServiceOperationResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.BulkServiceOperationResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.startServices =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StartServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StartServices,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.BulkServiceOperationResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.startServices =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StartServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StartServices);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.BulkServiceOperationArgs,
+ *   !proto.api_container_api.BulkServiceOperationResponse>}
+ */
+const methodDescriptor_ApiContainerService_StopServices = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/StopServices',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.BulkServiceOperationArgs,
+  proto.api_container_api.BulkServiceOperationResponse,
+  /**
+   * @param {!proto.api_container_api.BulkServiceOperationArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.BulkServiceOperationResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.BulkServiceOperationResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.BulkServiceOperationResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.stopServices =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StopServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StopServices,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.BulkServiceOperationResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.stopServices =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StopServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StopServices);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.BulkServiceOperationArgs,
+ *   !proto.api_container_api.BulkServiceOperationResponse>}
+ */
+const methodDescriptor_ApiContainerService_RemoveServices = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RemoveServices',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.BulkServiceOperationArgs,
+  proto.api_container_api.BulkServiceOperationResponse,
+  /**
+   * @param {!proto.api_container_api.BulkServiceOperationArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.BulkServiceOperationResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.BulkServiceOperationResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.BulkServiceOperationResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.removeServices =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RemoveServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RemoveServices,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.BulkServiceOperationResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.removeServices =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RemoveServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RemoveServices);
+};
+
+
 module.exports = proto.api_container_api;
 
@@ -1,6 +1,7 @@
 import * as jspb from 'google-protobuf'
 
 import * as google_protobuf_empty_pb from 'google-protobuf/google/protobuf/empty_pb';
+import * as google_protobuf_timestamp_pb from 'google-protobuf/google/protobuf/timestamp_pb';
 
 
 export class Port extends jspb.Message {
@@ -247,6 +248,16 @@ export class ServiceInfo extends jspb.Message {
   hasTiniEnabled(): boolean;
   clearTiniEnabled(): ServiceInfo;
 
+  getHealth(): ServiceHealth | undefined;
+  setHealth(value?: ServiceHealth): ServiceInfo;
+  hasHealth(): boolean;
+  clearHealth(): ServiceInfo;
+
+  getLastCrash(): ServiceCrashReport | undefined;
+  setLastCrash(value?: ServiceCrashReport): ServiceInfo;
+  hasLastCrash(): boolean;
+  clearLastCrash(): ServiceInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceInfo.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
@@ -276,6 +287,8 @@ export namespace ServiceInfo {
     nodeSelectorsMap: Array<[string, string]>,
     labelsMap: Array<[string, string]>,
     tiniEnabled?: boolean,
+    health?: ServiceHealth.AsObject,
+    lastCrash?: ServiceCrashReport.AsObject,
   }
 
   export enum UserCase { 
@@ -287,6 +300,82 @@ export namespace ServiceInfo {
     _TINI_ENABLED_NOT_SET = 0,
     TINI_ENABLED = 19,
   }
+
+  export enum HealthCase { 
+    _HEALTH_NOT_SET = 0,
+    HEALTH = 20,
+  }
+
+  export enum LastCrashCase { 
+    _LAST_CRASH_NOT_SET = 0,
+    LAST_CRASH = 21,
+  }
+}
+
+export class ServiceHealth extends jspb.Message {
+  getIsHealthy(): boolean;
+  setIsHealthy(value: boolean): ServiceHealth;
+
+  getConsecutiveFailures(): number;
+  setConsecutiveFailures(value: number): ServiceHealth;
+
+  getLastError(): string;
+  setLastError(value: string): ServiceHealth;
+
+  getRestartCount(): number;
+  setRestartCount(value: number): ServiceHealth;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceHealth.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceHealth): ServiceHealth.AsObject;
+  static serializeBinaryToWriter(message: ServiceHealth, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceHealth;
+  static deserializeBinaryFromReader(message: ServiceHealth, reader: jspb.BinaryReader): ServiceHealth;
+}
+
+export namespace ServiceHealth {
+  export type AsObject = {
+    isHealthy: boolean,
+    consecutiveFailures: number,
+    lastError: string,
+    restartCount: number,
+  }
+}
+
+export class ServiceCrashReport extends jspb.Message {
+  getExitCode(): number;
+  setExitCode(value: number): ServiceCrashReport;
+
+  getIsOomKilled(): boolean;
+  setIsOomKilled(value: boolean): ServiceCrashReport;
+
+  getFinishedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setFinishedAt(value?: google_protobuf_timestamp_pb.Timestamp): ServiceCrashReport;
+  hasFinishedAt(): boolean;
+  clearFinishedAt(): ServiceCrashReport;
+
+  getReason(): string;
+  setReason(value: string): ServiceCrashReport;
+
+  getDiagnosticsFilesArtifactName(): string;
+  setDiagnosticsFilesArtifactName(value: string): ServiceCrashReport;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceCrashReport.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceCrashReport): ServiceCrashReport.AsObject;
+  static serializeBinaryToWriter(message: ServiceCrashReport, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceCrashReport;
+  static deserializeBinaryFromReader(message: ServiceCrashReport, reader: jspb.BinaryReader): ServiceCrashReport;
+}
+
+export namespace ServiceCrashReport {
+  export type AsObject = {
+    exitCode: number,
+    isOomKilled: boolean,
+    finishedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    reason: string,
+    diagnosticsFilesArtifactName: string,
+  }
 }
 
 export class RunStarlarkScriptArgs extends jspb.Message {
@@ -600,6 +689,16 @@ export class StarlarkRunResponseLine extends jspb.Message {
   hasInfo(): boolean;
   clearInfo(): StarlarkRunResponseLine;
 
+  getContainerImageTiming(): StarlarkContainerImageTiming | undefined;
+  setContainerImageTiming(value?: StarlarkContainerImageTiming): StarlarkRunResponseLine;
+  hasContainerImageTiming(): boolean;
+  clearContainerImageTiming(): StarlarkRunResponseLine;
+
+  getPlan(): StarlarkRunPlan | undefined;
+  setPlan(value?: StarlarkRunPlan): StarlarkRunResponseLine;
+  hasPlan(): boolean;
+  clearPlan(): StarlarkRunResponseLine;
+
   getRunResponseLineCase(): StarlarkRunResponseLine.RunResponseLineCase;
 
   serializeBinary(): Uint8Array;
@@ -619,6 +718,8 @@ export namespace StarlarkRunResponseLine {
     runFinishedEvent?: StarlarkRunFinishedEvent.AsObject,
     warning?: StarlarkWarning.AsObject,
     info?: StarlarkInfo.AsObject,
+    containerImageTiming?: StarlarkContainerImageTiming.AsObject,
+    plan?: StarlarkRunPlan.AsObject,
   }
 
   export enum RunResponseLineCase { 
@@ -630,6 +731,8 @@ export namespace StarlarkRunResponseLine {
     RUN_FINISHED_EVENT = 5,
     WARNING = 6,
     INFO = 7,
+    CONTAINER_IMAGE_TIMING = 8,
+    PLAN = 9,
   }
 }
 
@@ -715,6 +818,11 @@ export class StarlarkInstructionResult extends jspb.Message {
   getSerializedInstructionResult(): string;
   setSerializedInstructionResult(value: string): StarlarkInstructionResult;
 
+  getExecutionDurationMilliseconds(): number;
+  setExecutionDurationMilliseconds(value: number): StarlarkInstructionResult;
+  hasExecutionDurationMilliseconds(): boolean;
+  clearExecutionDurationMilliseconds(): StarlarkInstructionResult;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): StarlarkInstructionResult.AsObject;
   static toObject(includeInstance: boolean, msg: StarlarkInstructionResult): StarlarkInstructionResult.AsObject;
@@ -726,6 +834,84 @@ export class StarlarkInstructionResult extends jspb.Message {
 export namespace StarlarkInstructionResult {
   export type AsObject = {
     serializedInstructionResult: string,
+    executionDurationMilliseconds?: number,
+  }
+
+  export enum ExecutionDurationMillisecondsCase { 
+    _EXECUTION_DURATION_MILLISECONDS_NOT_SET = 0,
+    EXECUTION_DURATION_MILLISECONDS = 2,
+  }
+}
+
+export class StarlarkContainerImageTiming extends jspb.Message {
+  getImageName(): string;
+  setImageName(value: string): StarlarkContainerImageTiming;
+
+  getServiceNamesList(): Array<string>;
+  setServiceNamesList(value: Array<string>): StarlarkContainerImageTiming;
+  clearServiceNamesList(): StarlarkContainerImageTiming;
+  addServiceNames(value: string, index?: number): StarlarkContainerImageTiming;
+
+  getDurationMilliseconds(): number;
+  setDurationMilliseconds(value: number): StarlarkContainerImageTiming;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkContainerImageTiming.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkContainerImageTiming): StarlarkContainerImageTiming.AsObject;
+  static serializeBinaryToWriter(message: StarlarkContainerImageTiming, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkContainerImageTiming;
+  static deserializeBinaryFromReader(message: StarlarkContainerImageTiming, reader: jspb.BinaryReader): StarlarkContainerImageTiming;
+}
+
+export namespace StarlarkContainerImageTiming {
+  export type AsObject = {
+    imageName: string,
+    serviceNamesList: Array<string>,
+    durationMilliseconds: number,
+  }
+}
+
+export class StarlarkRunPlan extends jspb.Message {
+  getInstructionsList(): Array<StarlarkPlannedInstruction>;
+  setInstructionsList(value: Array<StarlarkPlannedInstruction>): StarlarkRunPlan;
+  clearInstructionsList(): StarlarkRunPlan;
+  addInstructions(value?: StarlarkPlannedInstruction, index?: number): StarlarkPlannedInstruction;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkRunPlan.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkRunPlan): StarlarkRunPlan.AsObject;
+  static serializeBinaryToWriter(message: StarlarkRunPlan, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkRunPlan;
+  static deserializeBinaryFromReader(message: StarlarkRunPlan, reader: jspb.BinaryReader): StarlarkRunPlan;
+}
+
+export namespace StarlarkRunPlan {
+  export type AsObject = {
+    instructionsList: Array<StarlarkPlannedInstruction.AsObject>,
+  }
+}
+
+export class StarlarkPlannedInstruction extends jspb.Message {
+  getInstruction(): StarlarkInstruction | undefined;
+  setInstruction(value?: StarlarkInstruction): StarlarkPlannedInstruction;
+  hasInstruction(): boolean;
+  clearInstruction(): StarlarkPlannedInstruction;
+
+  getSerializedReturnedValue(): string;
+  setSerializedReturnedValue(value: string): StarlarkPlannedInstruction;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkPlannedInstruction.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkPlannedInstruction): StarlarkPlannedInstruction.AsObject;
+  static serializeBinaryToWriter(message: StarlarkPlannedInstruction, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkPlannedInstruction;
+  static deserializeBinaryFromReader(message: StarlarkPlannedInstruction, reader: jspb.BinaryReader): StarlarkPlannedInstruction;
+}
+
+export namespace StarlarkPlannedInstruction {
+  export type AsObject = {
+    instruction?: StarlarkInstruction.AsObject,
+    serializedReturnedValue: string,
   }
 }
 
@@ -869,6 +1055,12 @@ export class StarlarkExecutionError extends jspb.Message {
   getErrorMessage(): string;
   setErrorMessage(value: string): StarlarkExecutionError;
 
+  getBackendErrorKind(): BackendErrorKind;
+  setBackendErrorKind(value: BackendErrorKind): StarlarkExecutionError;
+
+  getRemediationHint(): string;
+  setRemediationHint(value: string): StarlarkExecutionError;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): StarlarkExecutionError.AsObject;
   static toObject(includeInstance: boolean, msg: StarlarkExecutionError): StarlarkExecutionError.AsObject;
@@ -880,6 +1072,8 @@ export class StarlarkExecutionError extends jspb.Message {
 export namespace StarlarkExecutionError {
   export type AsObject = {
     errorMessage: string,
+    backendErrorKind: BackendErrorKind,
+    remediationHint: string,
   }
 }
 
@@ -944,6 +1138,24 @@ export class GetServicesArgs extends jspb.Message {
   getServiceIdentifiersMap(): jspb.Map<string, boolean>;
   clearServiceIdentifiersMap(): GetServicesArgs;
 
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): GetServicesArgs;
+
+  getStatusesList(): Array<ServiceStatus>;
+  setStatusesList(value: Array<ServiceStatus>): GetServicesArgs;
+  clearStatusesList(): GetServicesArgs;
+  addStatuses(value: ServiceStatus, index?: number): GetServicesArgs;
+
+  getPageSize(): number;
+  setPageSize(value: number): GetServicesArgs;
+  hasPageSize(): boolean;
+  clearPageSize(): GetServicesArgs;
+
+  getPageToken(): string;
+  setPageToken(value: string): GetServicesArgs;
+  hasPageToken(): boolean;
+  clearPageToken(): GetServicesArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetServicesArgs.AsObject;
   static toObject(includeInstance: boolean, msg: GetServicesArgs): GetServicesArgs.AsObject;
@@ -955,6 +1167,20 @@ export class GetServicesArgs extends jspb.Message {
 export namespace GetServicesArgs {
   export type AsObject = {
     serviceIdentifiersMap: Array<[string, boolean]>,
+    labelsMap: Array<[string, string]>,
+    statusesList: Array<ServiceStatus>,
+    pageSize?: number,
+    pageToken?: string,
+  }
+
+  export enum PageSizeCase { 
+    _PAGE_SIZE_NOT_SET = 0,
+    PAGE_SIZE = 4,
+  }
+
+  export enum PageTokenCase { 
+    _PAGE_TOKEN_NOT_SET = 0,
+    PAGE_TOKEN = 5,
   }
 }
 
@@ -962,6 +1188,11 @@ export class GetServicesResponse extends jspb.Message {
   getServiceInfoMap(): jspb.Map<string, ServiceInfo>;
   clearServiceInfoMap(): GetServicesResponse;
 
+  getNextPageToken(): string;
+  setNextPageToken(value: string): GetServicesResponse;
+  hasNextPageToken(): boolean;
+  clearNextPageToken(): GetServicesResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetServicesResponse.AsObject;
   static toObject(includeInstance: boolean, msg: GetServicesResponse): GetServicesResponse.AsObject;
@@ -973,6 +1204,12 @@ export class GetServicesResponse extends jspb.Message {
 export namespace GetServicesResponse {
   export type AsObject = {
     serviceInfoMap: Array<[string, ServiceInfo.AsObject]>,
+    nextPageToken?: string,
+  }
+
+  export enum NextPageTokenCase { 
+    _NEXT_PAGE_TOKEN_NOT_SET = 0,
+    NEXT_PAGE_TOKEN = 2,
   }
 }
 
@@ -1022,6 +1259,98 @@ export namespace GetExistingAndHistoricalServiceIdentifiersResponse {
   }
 }
 
+export class ServiceDependencyNode extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): ServiceDependencyNode;
+
+  getHasReadyConditions(): boolean;
+  setHasReadyConditions(value: boolean): ServiceDependencyNode;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceDependencyNode.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceDependencyNode): ServiceDependencyNode.AsObject;
+  static serializeBinaryToWriter(message: ServiceDependencyNode, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceDependencyNode;
+  static deserializeBinaryFromReader(message: ServiceDependencyNode, reader: jspb.BinaryReader): ServiceDependencyNode;
+}
+
+export namespace ServiceDependencyNode {
+  export type AsObject = {
+    serviceName: string,
+    hasReadyConditions: boolean,
+  }
+}
+
+export class ServiceDependencyEdge extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): ServiceDependencyEdge;
+
+  getDependencyServiceName(): string;
+  setDependencyServiceName(value: string): ServiceDependencyEdge;
+
+  getKind(): ServiceDependencyKind;
+  setKind(value: ServiceDependencyKind): ServiceDependencyEdge;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceDependencyEdge.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceDependencyEdge): ServiceDependencyEdge.AsObject;
+  static serializeBinaryToWriter(message: ServiceDependencyEdge, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceDependencyEdge;
+  static deserializeBinaryFromReader(message: ServiceDependencyEdge, reader: jspb.BinaryReader): ServiceDependencyEdge;
+}
+
+export namespace ServiceDependencyEdge {
+  export type AsObject = {
+    serviceName: string,
+    dependencyServiceName: string,
+    kind: ServiceDependencyKind,
+  }
+}
+
+export class GetServiceDependencyGraphResponse extends jspb.Message {
+  getNodesList(): Array<ServiceDependencyNode>;
+  setNodesList(value: Array<ServiceDependencyNode>): GetServiceDependencyGraphResponse;
+  clearNodesList(): GetServiceDependencyGraphResponse;
+  addNodes(value?: ServiceDependencyNode, index?: number): ServiceDependencyNode;
+
+  getEdgesList(): Array<ServiceDependencyEdge>;
+  setEdgesList(value: Array<ServiceDependencyEdge>): GetServiceDependencyGraphResponse;
+  clearEdgesList(): GetServiceDependencyGraphResponse;
+  addEdges(value?: ServiceDependencyEdge, index?: number): ServiceDependencyEdge;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceDependencyGraphResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceDependencyGraphResponse): GetServiceDependencyGraphResponse.AsObject;
+  static serializeBinaryToWriter(message: GetServiceDependencyGraphResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceDependencyGraphResponse;
+  static deserializeBinaryFromReader(message: GetServiceDependencyGraphResponse, reader: jspb.BinaryReader): GetServiceDependencyGraphResponse;
+}
+
+export namespace GetServiceDependencyGraphResponse {
+  export type AsObject = {
+    nodesList: Array<ServiceDependencyNode.AsObject>,
+    edgesList: Array<ServiceDependencyEdge.AsObject>,
+  }
+}
+
+export class SetEnclaveEnvVarsArgs extends jspb.Message {
+  getEnvVarsMap(): jspb.Map<string, string>;
+  clearEnvVarsMap(): SetEnclaveEnvVarsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetEnclaveEnvVarsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: SetEnclaveEnvVarsArgs): SetEnclaveEnvVarsArgs.AsObject;
+  static serializeBinaryToWriter(message: SetEnclaveEnvVarsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetEnclaveEnvVarsArgs;
+  static deserializeBinaryFromReader(message: SetEnclaveEnvVarsArgs, reader: jspb.BinaryReader): SetEnclaveEnvVarsArgs;
+}
+
+export namespace SetEnclaveEnvVarsArgs {
+  export type AsObject = {
+    envVarsMap: Array<[string, string]>,
+  }
+}
+
 export class ExecCommandArgs extends jspb.Message {
   getServiceIdentifier(): string;
   setServiceIdentifier(value: string): ExecCommandArgs;
@@ -1328,6 +1657,26 @@ export class StoreWebFilesArtifactArgs extends jspb.Message {
   getName(): string;
   setName(value: string): StoreWebFilesArtifactArgs;
 
+  getHeadersList(): Array<HttpHeader>;
+  setHeadersList(value: Array<HttpHeader>): StoreWebFilesArtifactArgs;
+  clearHeadersList(): StoreWebFilesArtifactArgs;
+  addHeaders(value?: HttpHeader, index?: number): HttpHeader;
+
+  getBasicAuthUsername(): string;
+  setBasicAuthUsername(value: string): StoreWebFilesArtifactArgs;
+  hasBasicAuthUsername(): boolean;
+  clearBasicAuthUsername(): StoreWebFilesArtifactArgs;
+
+  getBasicAuthPassword(): string;
+  setBasicAuthPassword(value: string): StoreWebFilesArtifactArgs;
+  hasBasicAuthPassword(): boolean;
+  clearBasicAuthPassword(): StoreWebFilesArtifactArgs;
+
+  getContentSha256(): string;
+  setContentSha256(value: string): StoreWebFilesArtifactArgs;
+  hasContentSha256(): boolean;
+  clearContentSha256(): StoreWebFilesArtifactArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): StoreWebFilesArtifactArgs.AsObject;
   static toObject(includeInstance: boolean, msg: StoreWebFilesArtifactArgs): StoreWebFilesArtifactArgs.AsObject;
@@ -1340,6 +1689,47 @@ export namespace StoreWebFilesArtifactArgs {
   export type AsObject = {
     url: string,
     name: string,
+    headersList: Array<HttpHeader.AsObject>,
+    basicAuthUsername?: string,
+    basicAuthPassword?: string,
+    contentSha256?: string,
+  }
+
+  export enum BasicAuthUsernameCase { 
+    _BASIC_AUTH_USERNAME_NOT_SET = 0,
+    BASIC_AUTH_USERNAME = 4,
+  }
+
+  export enum BasicAuthPasswordCase { 
+    _BASIC_AUTH_PASSWORD_NOT_SET = 0,
+    BASIC_AUTH_PASSWORD = 5,
+  }
+
+  export enum ContentSha256Case { 
+    _CONTENT_SHA256_NOT_SET = 0,
+    CONTENT_SHA256 = 6,
+  }
+}
+
+export class HttpHeader extends jspb.Message {
+  getName(): string;
+  setName(value: string): HttpHeader;
+
+  getValue(): string;
+  setValue(value: string): HttpHeader;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpHeader.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpHeader): HttpHeader.AsObject;
+  static serializeBinaryToWriter(message: HttpHeader, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpHeader;
+  static deserializeBinaryFromReader(message: HttpHeader, reader: jspb.BinaryReader): HttpHeader;
+}
+
+export namespace HttpHeader {
+  export type AsObject = {
+    name: string,
+    value: string,
   }
 }
 
@@ -1473,6 +1863,9 @@ export class InspectFilesArtifactContentsResponse extends jspb.Message {
   clearFileDescriptionsList(): InspectFilesArtifactContentsResponse;
   addFileDescriptions(value?: FileArtifactContentsFileDescription, index?: number): FileArtifactContentsFileDescription;
 
+  getContentSha256(): string;
+  setContentSha256(value: string): InspectFilesArtifactContentsResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): InspectFilesArtifactContentsResponse.AsObject;
   static toObject(includeInstance: boolean, msg: InspectFilesArtifactContentsResponse): InspectFilesArtifactContentsResponse.AsObject;
@@ -1484,6 +1877,7 @@ export class InspectFilesArtifactContentsResponse extends jspb.Message {
 export namespace InspectFilesArtifactContentsResponse {
   export type AsObject = {
     fileDescriptionsList: Array<FileArtifactContentsFileDescription.AsObject>,
+    contentSha256: string,
   }
 }
 
@@ -1520,6 +1914,80 @@ export namespace FileArtifactContentsFileDescription {
   }
 }
 
+export class GetFilesArtifactHistoryArgs extends jspb.Message {
+  getIdentifier(): string;
+  setIdentifier(value: string): GetFilesArtifactHistoryArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetFilesArtifactHistoryArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetFilesArtifactHistoryArgs): GetFilesArtifactHistoryArgs.AsObject;
+  static serializeBinaryToWriter(message: GetFilesArtifactHistoryArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetFilesArtifactHistoryArgs;
+  static deserializeBinaryFromReader(message: GetFilesArtifactHistoryArgs, reader: jspb.BinaryReader): GetFilesArtifactHistoryArgs;
+}
+
+export namespace GetFilesArtifactHistoryArgs {
+  export type AsObject = {
+    identifier: string,
+  }
+}
+
+export class GetFilesArtifactHistoryResponse extends jspb.Message {
+  getFileName(): string;
+  setFileName(value: string): GetFilesArtifactHistoryResponse;
+
+  getVersionsList(): Array<FilesArtifactVersion>;
+  setVersionsList(value: Array<FilesArtifactVersion>): GetFilesArtifactHistoryResponse;
+  clearVersionsList(): GetFilesArtifactHistoryResponse;
+  addVersions(value?: FilesArtifactVersion, index?: number): FilesArtifactVersion;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetFilesArtifactHistoryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetFilesArtifactHistoryResponse): GetFilesArtifactHistoryResponse.AsObject;
+  static serializeBinaryToWriter(message: GetFilesArtifactHistoryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetFilesArtifactHistoryResponse;
+  static deserializeBinaryFromReader(message: GetFilesArtifactHistoryResponse, reader: jspb.BinaryReader): GetFilesArtifactHistoryResponse;
+}
+
+export namespace GetFilesArtifactHistoryResponse {
+  export type AsObject = {
+    fileName: string,
+    versionsList: Array<FilesArtifactVersion.AsObject>,
+  }
+}
+
+export class FilesArtifactVersion extends jspb.Message {
+  getVersion(): number;
+  setVersion(value: number): FilesArtifactVersion;
+
+  getFileUuid(): string;
+  setFileUuid(value: string): FilesArtifactVersion;
+
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): FilesArtifactVersion;
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): FilesArtifactVersion;
+
+  getIsLatest(): boolean;
+  setIsLatest(value: boolean): FilesArtifactVersion;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FilesArtifactVersion.AsObject;
+  static toObject(includeInstance: boolean, msg: FilesArtifactVersion): FilesArtifactVersion.AsObject;
+  static serializeBinaryToWriter(message: FilesArtifactVersion, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FilesArtifactVersion;
+  static deserializeBinaryFromReader(message: FilesArtifactVersion, reader: jspb.BinaryReader): FilesArtifactVersion;
+}
+
+export namespace FilesArtifactVersion {
+  export type AsObject = {
+    version: number,
+    fileUuid: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    isLatest: boolean,
+  }
+}
+
 export class ConnectServicesArgs extends jspb.Message {
   getConnect(): Connect;
   setConnect(value: Connect): ConnectServicesArgs;
@@ -1724,6 +2192,121 @@ export namespace StarlarkPackagePlanYamlArgs {
   }
 }
 
+export class WatchServiceEventsArgs extends jspb.Message {
+  getServiceNamesList(): Array<string>;
+  setServiceNamesList(value: Array<string>): WatchServiceEventsArgs;
+  clearServiceNamesList(): WatchServiceEventsArgs;
+  addServiceNames(value: string, index?: number): WatchServiceEventsArgs;
+
+  getEventTypesList(): Array<ServiceEventType>;
+  setEventTypesList(value: Array<ServiceEventType>): WatchServiceEventsArgs;
+  clearEventTypesList(): WatchServiceEventsArgs;
+  addEventTypes(value: ServiceEventType, index?: number): WatchServiceEventsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WatchServiceEventsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: WatchServiceEventsArgs): WatchServiceEventsArgs.AsObject;
+  static serializeBinaryToWriter(message: WatchServiceEventsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WatchServiceEventsArgs;
+  static deserializeBinaryFromReader(message: WatchServiceEventsArgs, reader: jspb.BinaryReader): WatchServiceEventsArgs;
+}
+
+export namespace WatchServiceEventsArgs {
+  export type AsObject = {
+    serviceNamesList: Array<string>,
+    eventTypesList: Array<ServiceEventType>,
+  }
+}
+
+export class ServiceEvent extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): ServiceEvent;
+
+  getServiceUuid(): string;
+  setServiceUuid(value: string): ServiceEvent;
+
+  getEventType(): ServiceEventType;
+  setEventType(value: ServiceEventType): ServiceEvent;
+
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): ServiceEvent;
+  hasTimestamp(): boolean;
+  clearTimestamp(): ServiceEvent;
+
+  getMessage(): string;
+  setMessage(value: string): ServiceEvent;
+  hasMessage(): boolean;
+  clearMessage(): ServiceEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceEvent): ServiceEvent.AsObject;
+  static serializeBinaryToWriter(message: ServiceEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceEvent;
+  static deserializeBinaryFromReader(message: ServiceEvent, reader: jspb.BinaryReader): ServiceEvent;
+}
+
+export namespace ServiceEvent {
+  export type AsObject = {
+    serviceName: string,
+    serviceUuid: string,
+    eventType: ServiceEventType,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    message?: string,
+  }
+
+  export enum MessageCase { 
+    _MESSAGE_NOT_SET = 0,
+    MESSAGE = 5,
+  }
+}
+
+export class BulkServiceOperationArgs extends jspb.Message {
+  getServiceIdentifiersList(): Array<string>;
+  setServiceIdentifiersList(value: Array<string>): BulkServiceOperationArgs;
+  clearServiceIdentifiersList(): BulkServiceOperationArgs;
+  addServiceIdentifiers(value: string, index?: number): BulkServiceOperationArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BulkServiceOperationArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: BulkServiceOperationArgs): BulkServiceOperationArgs.AsObject;
+  static serializeBinaryToWriter(message: BulkServiceOperationArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BulkServiceOperationArgs;
+  static deserializeBinaryFromReader(message: BulkServiceOperationArgs, reader: jspb.BinaryReader): BulkServiceOperationArgs;
+}
+
+export namespace BulkServiceOperationArgs {
+  export type AsObject = {
+    serviceIdentifiersList: Array<string>,
+  }
+}
+
+export class BulkServiceOperationResponse extends jspb.Message {
+  getIsApplied(): boolean;
+  setIsApplied(value: boolean): BulkServiceOperationResponse;
+
+  getFailedServicesMap(): jspb.Map<string, string>;
+  clearFailedServicesMap(): BulkServiceOperationResponse;
+
+  getFailedRollbacksMap(): jspb.Map<string, string>;
+  clearFailedRollbacksMap(): BulkServiceOperationResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): BulkServiceOperationResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: BulkServiceOperationResponse): BulkServiceOperationResponse.AsObject;
+  static serializeBinaryToWriter(message: BulkServiceOperationResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): BulkServiceOperationResponse;
+  static deserializeBinaryFromReader(message: BulkServiceOperationResponse, reader: jspb.BinaryReader): BulkServiceOperationResponse;
+}
+
+export namespace BulkServiceOperationResponse {
+  export type AsObject = {
+    isApplied: boolean,
+    failedServicesMap: Array<[string, string]>,
+    failedRollbacksMap: Array<[string, string]>,
+  }
+}
+
 export enum ServiceStatus { 
   STOPPED = 0,
   RUNNING = 1,
@@ -1740,7 +2323,25 @@ export enum Connect {
 export enum KurtosisFeatureFlag { 
   NO_INSTRUCTIONS_CACHING = 0,
 }
+export enum BackendErrorKind { 
+  NO_BACKEND_ERROR = 0,
+  IMAGE_PULL_BACK_OFF = 1,
+  INSUFFICIENT_RESOURCES = 2,
+  RBAC_FORBIDDEN = 3,
+  QUOTA_EXCEEDED = 4,
+  VOLUME_PROVISIONING_FAILED = 5,
+}
+export enum ServiceDependencyKind { 
+  EXPLICIT = 0,
+  RUNTIME_VALUE = 1,
+}
 export enum RestartPolicy { 
   NEVER = 0,
   ALWAYS = 1,
 }
+export enum ServiceEventType { 
+  SERVICE_STARTED = 0,
+  SERVICE_READY = 1,
+  SERVICE_STOPPED = 2,
+  SERVICE_CRASHED = 3,
+}
@@ -23,6 +23,11 @@ var global = (function() {
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.api_container_api.BackendErrorKind', null, global);
+goog.exportSymbol('proto.api_container_api.BulkServiceOperationArgs', null, global);
+goog.exportSymbol('proto.api_container_api.BulkServiceOperationResponse', null, global);
 goog.exportSymbol('proto.api_container_api.Connect', null, global);
 goog.exportSymbol('proto.api_container_api.ConnectServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ConnectServicesResponse', null, global);
@@ -34,11 +39,16 @@ goog.exportSymbol('proto.api_container_api.ExecCommandArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandResponse', null, global);
 goog.exportSymbol('proto.api_container_api.FileArtifactContentsFileDescription', null, global);
 goog.exportSymbol('proto.api_container_api.FilesArtifactNameAndUuid', null, global);
+goog.exportSymbol('proto.api_container_api.FilesArtifactVersion', null, global);
 goog.exportSymbol('proto.api_container_api.FilesArtifactsList', null, global);
 goog.exportSymbol('proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetFilesArtifactHistoryArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetFilesArtifactHistoryResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetServiceDependencyGraphResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetStarlarkRunResponse', null, global);
+goog.exportSymbol('proto.api_container_api.HttpHeader', null, global);
 goog.exportSymbol('proto.api_container_api.ImageDownloadMode', null, global);
 goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsRequest', null, global);
 goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsResponse', null, global);
@@ -51,9 +61,18 @@ goog.exportSymbol('proto.api_container_api.RestartPolicy', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkScriptArgs', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceCrashReport', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceDependencyEdge', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceDependencyKind', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceDependencyNode', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceEvent', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceEventType', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceHealth', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceIdentifiers', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceInfo', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceStatus', null, global);
+goog.exportSymbol('proto.api_container_api.SetEnclaveEnvVarsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkContainerImageTiming', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError.ErrorCase', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkExecutionError', null, global);
@@ -64,7 +83,9 @@ goog.exportSymbol('proto.api_container_api.StarlarkInstructionPosition', null, g
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionResult', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInterpretationError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkPackagePlanYamlArgs', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkPlannedInstruction', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunFinishedEvent', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkRunPlan', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunProgress', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase', null, global);
@@ -81,6 +102,7 @@ goog.exportSymbol('proto.api_container_api.UploadFilesArtifactResponse', null, g
 goog.exportSymbol('proto.api_container_api.User', null, global);
 goog.exportSymbol('proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs', null, global);
 goog.exportSymbol('proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs', null, global);
+goog.exportSymbol('proto.api_container_api.WatchServiceEventsArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -207,6 +229,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.ServiceInfo.displayName = 'proto.api_container_api.ServiceInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceHealth = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceHealth, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceHealth.displayName = 'proto.api_container_api.ServiceHealth';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceCrashReport = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceCrashReport, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceCrashReport.displayName = 'proto.api_container_api.ServiceCrashReport';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -354,6 +418,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkInstructionResult.displayName = 'proto.api_container_api.StarlarkInstructionResult';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkContainerImageTiming = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.StarlarkContainerImageTiming.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.StarlarkContainerImageTiming, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkContainerImageTiming.displayName = 'proto.api_container_api.StarlarkContainerImageTiming';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkRunPlan = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.StarlarkRunPlan.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.StarlarkRunPlan, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkRunPlan.displayName = 'proto.api_container_api.StarlarkRunPlan';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkPlannedInstruction = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkPlannedInstruction, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkPlannedInstruction.displayName = 'proto.api_container_api.StarlarkPlannedInstruction';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -533,7 +660,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.api_container_api.GetServicesArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetServicesArgs.repeatedFields_, null);
 };
 goog.inherits(proto.api_container_api.GetServicesArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -606,6 +733,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.displayName = 'proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceDependencyNode = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceDependencyNode, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceDependencyNode.displayName = 'proto.api_container_api.ServiceDependencyNode';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceDependencyEdge = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceDependencyEdge, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceDependencyEdge.displayName = 'proto.api_container_api.ServiceDependencyEdge';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetServiceDependencyGraphResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetServiceDependencyGraphResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetServiceDependencyGraphResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetServiceDependencyGraphResponse.displayName = 'proto.api_container_api.GetServiceDependencyGraphResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.SetEnclaveEnvVarsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.SetEnclaveEnvVarsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.SetEnclaveEnvVarsArgs.displayName = 'proto.api_container_api.SetEnclaveEnvVarsArgs';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -785,7 +996,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.api_container_api.StoreWebFilesArtifactArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.StoreWebFilesArtifactArgs.repeatedFields_, null);
 };
 goog.inherits(proto.api_container_api.StoreWebFilesArtifactArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -795,6 +1006,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StoreWebFilesArtifactArgs.displayName = 'proto.api_container_api.StoreWebFilesArtifactArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpHeader = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.HttpHeader, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpHeader.displayName = 'proto.api_container_api.HttpHeader';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -963,6 +1195,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.FileArtifactContentsFileDescription.displayName = 'proto.api_container_api.FileArtifactContentsFileDescription';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetFilesArtifactHistoryArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetFilesArtifactHistoryArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetFilesArtifactHistoryArgs.displayName = 'proto.api_container_api.GetFilesArtifactHistoryArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetFilesArtifactHistoryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetFilesArtifactHistoryResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetFilesArtifactHistoryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetFilesArtifactHistoryResponse.displayName = 'proto.api_container_api.GetFilesArtifactHistoryResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.FilesArtifactVersion = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.FilesArtifactVersion, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.FilesArtifactVersion.displayName = 'proto.api_container_api.FilesArtifactVersion';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1089,24 +1384,108 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkPackagePlanYamlArgs.displayName = 'proto.api_container_api.StarlarkPackagePlanYamlArgs';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+proto.api_container_api.WatchServiceEventsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.WatchServiceEventsArgs.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.WatchServiceEventsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.WatchServiceEventsArgs.displayName = 'proto.api_container_api.WatchServiceEventsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceEvent.displayName = 'proto.api_container_api.ServiceEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.BulkServiceOperationArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.BulkServiceOperationArgs.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.BulkServiceOperationArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.BulkServiceOperationArgs.displayName = 'proto.api_container_api.BulkServiceOperationArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.BulkServiceOperationResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.BulkServiceOperationResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.BulkServiceOperationResponse.displayName = 'proto.api_container_api.BulkServiceOperationResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
 };
 
 
@@ -2345,7 +2724,9 @@ proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
     proto.api_container_api.Toleration.toObject, includeInstance),
     nodeSelectorsMap: (f = msg.getNodeSelectorsMap()) ? f.toObject(includeInstance, undefined) : [],
     labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
-    tiniEnabled: jspb.Message.getBooleanFieldWithDefault(msg, 19, false)
+    tiniEnabled: jspb.Message.getBooleanFieldWithDefault(msg, 19, false),
+    health: (f = msg.getHealth()) && proto.api_container_api.ServiceHealth.toObject(includeInstance, f),
+    lastCrash: (f = msg.getLastCrash()) && proto.api_container_api.ServiceCrashReport.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2471,6 +2852,16 @@ proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg,
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setTiniEnabled(value);
       break;
+    case 20:
+      var value = new proto.api_container_api.ServiceHealth;
+      reader.readMessage(value,proto.api_container_api.ServiceHealth.deserializeBinaryFromReader);
+      msg.setHealth(value);
+      break;
+    case 21:
+      var value = new proto.api_container_api.ServiceCrashReport;
+      reader.readMessage(value,proto.api_container_api.ServiceCrashReport.deserializeBinaryFromReader);
+      msg.setLastCrash(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2621,6 +3012,22 @@ proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getHealth();
+  if (f != null) {
+    writer.writeMessage(
+      20,
+      f,
+      proto.api_container_api.ServiceHealth.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastCrash();
+  if (f != null) {
+    writer.writeMessage(
+      21,
+      f,
+      proto.api_container_api.ServiceCrashReport.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -3062,13 +3469,81 @@ proto.api_container_api.ServiceInfo.prototype.hasTiniEnabled = function() {
 };
 
 
+/**
+ * optional ServiceHealth health = 20;
+ * @return {?proto.api_container_api.ServiceHealth}
+ */
+proto.api_container_api.ServiceInfo.prototype.getHealth = function() {
+  return /** @type{?proto.api_container_api.ServiceHealth} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ServiceHealth, 20));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {?proto.api_container_api.ServiceHealth|undefined} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+*/
+proto.api_container_api.ServiceInfo.prototype.setHealth = function(value) {
+  return jspb.Message.setWrapperField(this, 20, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.repeatedFields_ = [6];
+proto.api_container_api.ServiceInfo.prototype.clearHealth = function() {
+  return this.setHealth(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceInfo.prototype.hasHealth = function() {
+  return jspb.Message.getField(this, 20) != null;
+};
+
+
+/**
+ * optional ServiceCrashReport last_crash = 21;
+ * @return {?proto.api_container_api.ServiceCrashReport}
+ */
+proto.api_container_api.ServiceInfo.prototype.getLastCrash = function() {
+  return /** @type{?proto.api_container_api.ServiceCrashReport} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ServiceCrashReport, 21));
+};
+
+
+/**
+ * @param {?proto.api_container_api.ServiceCrashReport|undefined} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+*/
+proto.api_container_api.ServiceInfo.prototype.setLastCrash = function(value) {
+  return jspb.Message.setWrapperField(this, 21, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearLastCrash = function() {
+  return this.setLastCrash(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceInfo.prototype.hasLastCrash = function() {
+  return jspb.Message.getField(this, 21) != null;
+};
+
+
+
 
 
 
@@ -3085,8 +3560,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceHealth.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceHealth.toObject(opt_includeInstance, this);
 };
 
 
@@ -3095,22 +3570,16 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceHealth} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceHealth.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
-    cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    cloudUserId: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    nonBlockingMode: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
+    isHealthy: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    consecutiveFailures: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    lastError: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    restartCount: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -3124,23 +3593,23 @@ proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * @return {!proto.api_container_api.ServiceHealth}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceHealth.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
-  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceHealth;
+  return proto.api_container_api.ServiceHealth.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceHealth} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * @return {!proto.api_container_api.ServiceHealth}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceHealth.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3148,46 +3617,20 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsHealthy(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setConsecutiveFailures(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryRun(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
-      break;
-    case 6:
-      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addExperimentalFeatures(values[i]);
-      }
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCloudInstanceId(value);
-      break;
-    case 8:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCloudUserId(value);
+      msg.setLastError(value);
       break;
-    case 9:
-      var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
-      msg.setImageDownloadMode(value);
-      break;
-    case 10:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setNonBlockingMode(value);
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRestartCount(value);
       break;
     default:
       reader.skipField();
@@ -3202,9 +3645,9 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceHealth.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceHealth.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3212,79 +3655,37 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
+ * @param {!proto.api_container_api.ServiceHealth} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServiceHealth.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedScript();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getIsHealthy();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
+  f = message.getConsecutiveFailures();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getExperimentalFeaturesList();
+  f = message.getLastError();
   if (f.length > 0) {
-    writer.writePackedEnum(
-      6,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
     writer.writeString(
-      7,
+      3,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 8));
-  if (f != null) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getField(message, 9));
-  if (f != null) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 10));
-  if (f != null) {
-    writer.writeBool(
-      10,
+  f = message.getRestartCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
       f
     );
   }
@@ -3292,381 +3693,353 @@ proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function
 
 
 /**
- * optional string serialized_script = 1;
- * @return {string}
+ * optional bool is_healthy = 1;
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.ServiceHealth.prototype.getIsHealthy = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ServiceHealth.prototype.setIsHealthy = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_params = 2;
- * @return {string}
+ * optional uint32 consecutive_failures = 2;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.ServiceHealth.prototype.getConsecutiveFailures = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.ServiceHealth.prototype.setConsecutiveFailures = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * optional string last_error = 3;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearSerializedParams = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.ServiceHealth.prototype.getLastError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasSerializedParams = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.ServiceHealth.prototype.setLastError = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional bool dry_run = 3;
- * @return {boolean}
+ * optional uint32 restart_count = 4;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.ServiceHealth.prototype.getRestartCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.api_container_api.ServiceHealth.prototype.setRestartCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.api_container_api.ServiceCrashReport.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceCrashReport.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceCrashReport} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.ServiceCrashReport.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    isOomKilled: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    finishedAt: (f = msg.getFinishedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    reason: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    diagnosticsFilesArtifactName: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional int32 parallelism = 4;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceCrashReport}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.ServiceCrashReport.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceCrashReport;
+  return proto.api_container_api.ServiceCrashReport.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceCrashReport} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceCrashReport}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.api_container_api.ServiceCrashReport.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsOomKilled(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setFinishedAt(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDiagnosticsFilesArtifactName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.ServiceCrashReport.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceCrashReport.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceCrashReport} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.ServiceCrashReport.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getIsOomKilled();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getFinishedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDiagnosticsFilesArtifactName();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string main_function_name = 5;
- * @return {string}
+ * optional int32 exit_code = 1;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.ServiceCrashReport.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceCrashReport} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.api_container_api.ServiceCrashReport.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * optional bool is_oom_killed = 2;
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearMainFunctionName = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.ServiceCrashReport.prototype.getIsOomKilled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.ServiceCrashReport} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasMainFunctionName = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.ServiceCrashReport.prototype.setIsOomKilled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * repeated KurtosisFeatureFlag experimental_features = 6;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ * optional google.protobuf.Timestamp finished_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 6));
+proto.api_container_api.ServiceCrashReport.prototype.getFinishedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 6, value || []);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.ServiceCrashReport} returns this
+*/
+proto.api_container_api.ServiceCrashReport.prototype.setFinishedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceCrashReport} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+proto.api_container_api.ServiceCrashReport.prototype.clearFinishedAt = function() {
+  return this.setFinishedAt(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+proto.api_container_api.ServiceCrashReport.prototype.hasFinishedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional string cloud_instance_id = 7;
+ * optional string reason = 4;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getCloudInstanceId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.ServiceCrashReport.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @return {!proto.api_container_api.ServiceCrashReport} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setCloudInstanceId = function(value) {
-  return jspb.Message.setField(this, 7, value);
+proto.api_container_api.ServiceCrashReport.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * optional string diagnostics_files_artifact_name = 5;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearCloudInstanceId = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.ServiceCrashReport.prototype.getDiagnosticsFilesArtifactName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceCrashReport} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasCloudInstanceId = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.ServiceCrashReport.prototype.setDiagnosticsFilesArtifactName = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
+
 /**
- * optional string cloud_user_id = 8;
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getCloudUserId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setCloudUserId = function(value) {
-  return jspb.Message.setField(this, 8, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearCloudUserId = function() {
-  return jspb.Message.setField(this, 8, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasCloudUserId = function() {
-  return jspb.Message.getField(this, 8) != null;
-};
-
-
-/**
- * optional ImageDownloadMode image_download_mode = 9;
- * @return {!proto.api_container_api.ImageDownloadMode}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getImageDownloadMode = function() {
-  return /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
-};
-
-
-/**
- * @param {!proto.api_container_api.ImageDownloadMode} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setImageDownloadMode = function(value) {
-  return jspb.Message.setField(this, 9, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearImageDownloadMode = function() {
-  return jspb.Message.setField(this, 9, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasImageDownloadMode = function() {
-  return jspb.Message.getField(this, 9) != null;
-};
-
-
-/**
- * optional bool non_blocking_mode = 10;
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getNonBlockingMode = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setNonBlockingMode = function(value) {
-  return jspb.Message.setField(this, 10, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearNonBlockingMode = function() {
-  return jspb.Message.setField(this, 10, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasNonBlockingMode = function() {
-  return jspb.Message.getField(this, 10) != null;
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.RunStarlarkPackageArgs.repeatedFields_ = [11];
-
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
-
-/**
- * @enum {number}
- */
-proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
-  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
-  LOCAL: 3,
-  REMOTE: 4
-};
-
-/**
- * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
-  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
-};
+proto.api_container_api.RunStarlarkScriptArgs.repeatedFields_ = [6];
 
 
 
@@ -3683,8 +4056,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3693,27 +4066,22 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    local: msg.getLocal_asB64(),
-    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    clonePackage: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
-    cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 12, ""),
-    cloudUserId: jspb.Message.getFieldWithDefault(msg, 13, ""),
-    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 14, 0),
-    nonBlockingMode: jspb.Message.getBooleanFieldWithDefault(msg, 15, false),
-    githubAuthToken: jspb.Message.getFieldWithDefault(msg, 16, "")
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    cloudUserId: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    nonBlockingMode: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
   };
 
   if (includeInstance) {
@@ -3727,23 +4095,23 @@ proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
-  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
+  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3752,66 +4120,46 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
-      break;
-    case 3:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setLocal(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRemote(value);
+      msg.setSerializedScript(value);
       break;
-    case 5:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
       msg.setSerializedParams(value);
       break;
-    case 6:
+    case 3:
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setDryRun(value);
       break;
-    case 7:
+    case 4:
       var value = /** @type {number} */ (reader.readInt32());
       msg.setParallelism(value);
       break;
-    case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setClonePackage(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRelativePathToMainFile(value);
-      break;
-    case 10:
+    case 5:
       var value = /** @type {string} */ (reader.readString());
       msg.setMainFunctionName(value);
       break;
-    case 11:
+    case 6:
       var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
       for (var i = 0; i < values.length; i++) {
         msg.addExperimentalFeatures(values[i]);
       }
       break;
-    case 12:
+    case 7:
       var value = /** @type {string} */ (reader.readString());
       msg.setCloudInstanceId(value);
       break;
-    case 13:
+    case 8:
       var value = /** @type {string} */ (reader.readString());
       msg.setCloudUserId(value);
       break;
-    case 14:
+    case 9:
       var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
       msg.setImageDownloadMode(value);
       break;
-    case 15:
+    case 10:
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setNonBlockingMode(value);
       break;
-    case 16:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setGithubAuthToken(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -3825,9 +4173,9 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3835,29 +4183,36 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
+  f = message.getSerializedScript();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
   if (f != null) {
-    writer.writeBytes(
-      3,
+    writer.writeString(
+      2,
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
   if (f != null) {
     writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeInt32(
       4,
       f
     );
@@ -3869,80 +4224,38 @@ proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = functio
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeBool(
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
       6,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeInt32(
-      7,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
-  if (f != null) {
-    writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 9));
-  if (f != null) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 10));
-  if (f != null) {
-    writer.writeString(
-      10,
-      f
-    );
-  }
-  f = message.getExperimentalFeaturesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      11,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 12));
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
   if (f != null) {
     writer.writeString(
-      12,
+      7,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 13));
+  f = /** @type {string} */ (jspb.Message.getField(message, 8));
   if (f != null) {
     writer.writeString(
-      13,
+      8,
       f
     );
   }
-  f = /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getField(message, 14));
+  f = /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getField(message, 9));
   if (f != null) {
     writer.writeEnum(
-      14,
+      9,
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 15));
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 10));
   if (f != null) {
     writer.writeBool(
-      15,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 16));
-  if (f != null) {
-    writer.writeString(
-      16,
+      10,
       f
     );
   }
@@ -3950,71 +4263,83 @@ proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = functio
 
 
 /**
- * optional string package_id = 1;
+ * optional string serialized_script = 1;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bytes local = 3;
+ * optional string serialized_params = 2;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional bytes local = 3;
- * This is a type-conversion wrapper around `getLocal()`
- * @return {string}
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getLocal()));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional bytes local = 3;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getLocal()`
- * @return {!Uint8Array}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getLocal()));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearSerializedParams = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasSerializedParams = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool dry_run = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
@@ -4022,35 +4347,35 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional bool remote = 4;
- * @return {boolean}
+ * optional int32 parallelism = 4;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
@@ -4058,34 +4383,34 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function(
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
   return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional string serialized_params = 5;
+ * optional string main_function_name = 5;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getMainFunctionName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setMainFunctionName = function(value) {
   return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearSerializedParams = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearMainFunctionName = function() {
   return jspb.Message.setField(this, 5, undefined);
 };
 
@@ -4094,70 +4419,71 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearSerializedParams =
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasSerializedParams = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasMainFunctionName = function() {
   return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional bool dry_run = 6;
- * @return {boolean}
+ * repeated KurtosisFeatureFlag experimental_features = 6;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.api_container_api.RunStarlarkScriptArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
 };
 
 
 /**
- * optional int32 parallelism = 7;
- * @return {number}
+ * optional string cloud_instance_id = 7;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getCloudInstanceId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setCloudInstanceId = function(value) {
   return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearCloudInstanceId = function() {
   return jspb.Message.setField(this, 7, undefined);
 };
 
@@ -4166,34 +4492,34 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = func
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasCloudInstanceId = function() {
   return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * optional bool clone_package = 8;
- * @return {boolean}
+ * optional string cloud_user_id = 8;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getClonePackage = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getCloudUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setClonePackage = function(value) {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setCloudUserId = function(value) {
   return jspb.Message.setField(this, 8, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearClonePackage = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearCloudUserId = function() {
   return jspb.Message.setField(this, 8, undefined);
 };
 
@@ -4202,34 +4528,34 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearClonePackage = fun
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasClonePackage = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasCloudUserId = function() {
   return jspb.Message.getField(this, 8) != null;
 };
 
 
 /**
- * optional string relative_path_to_main_file = 9;
- * @return {string}
+ * optional ImageDownloadMode image_download_mode = 9;
+ * @return {!proto.api_container_api.ImageDownloadMode}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getRelativePathToMainFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getImageDownloadMode = function() {
+  return /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {!proto.api_container_api.ImageDownloadMode} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setRelativePathToMainFile = function(value) {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setImageDownloadMode = function(value) {
   return jspb.Message.setField(this, 9, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRelativePathToMainFile = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearImageDownloadMode = function() {
   return jspb.Message.setField(this, 9, undefined);
 };
 
@@ -4238,34 +4564,34 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRelativePathToMain
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRelativePathToMainFile = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasImageDownloadMode = function() {
   return jspb.Message.getField(this, 9) != null;
 };
 
 
 /**
- * optional string main_function_name = 10;
- * @return {string}
+ * optional bool non_blocking_mode = 10;
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getNonBlockingMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setMainFunctionName = function(value) {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setNonBlockingMode = function(value) {
   return jspb.Message.setField(this, 10, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearMainFunctionName = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearNonBlockingMode = function() {
   return jspb.Message.setField(this, 10, undefined);
 };
 
@@ -4274,135 +4600,383 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearMainFunctionName =
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasMainFunctionName = function() {
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasNonBlockingMode = function() {
   return jspb.Message.getField(this, 10) != null;
 };
 
 
-/**
- * repeated KurtosisFeatureFlag experimental_features = 11;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 11));
-};
-
 
 /**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 11, value || []);
-};
-
+proto.api_container_api.RunStarlarkPackageArgs.repeatedFields_ = [11];
 
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
-};
-
+proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @enum {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
+  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
+  LOCAL: 3,
+  REMOTE: 4
 };
 
-
 /**
- * optional string cloud_instance_id = 12;
- * @return {string}
+ * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getCloudInstanceId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
+  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
 };
 
 
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setCloudInstanceId = function(value) {
-  return jspb.Message.setField(this, 12, value);
-};
-
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearCloudInstanceId = function() {
-  return jspb.Message.setField(this, 12, undefined);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasCloudInstanceId = function() {
-  return jspb.Message.getField(this, 12) != null;
-};
-
+proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    local: msg.getLocal_asB64(),
+    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    clonePackage: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
+    cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    cloudUserId: jspb.Message.getFieldWithDefault(msg, 13, ""),
+    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    nonBlockingMode: jspb.Message.getBooleanFieldWithDefault(msg, 15, false),
+    githubAuthToken: jspb.Message.getFieldWithDefault(msg, 16, "")
+  };
 
-/**
- * optional string cloud_user_id = 13;
- * @return {string}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getCloudUserId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setCloudUserId = function(value) {
-  return jspb.Message.setField(this, 13, value);
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
+  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearCloudUserId = function() {
-  return jspb.Message.setField(this, 13, undefined);
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setLocal(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemote(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setClonePackage(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelativePathToMainFile(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
+      break;
+    case 11:
+      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExperimentalFeatures(values[i]);
+      }
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloudInstanceId(value);
+      break;
+    case 13:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloudUserId(value);
+      break;
+    case 14:
+      var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
+      msg.setImageDownloadMode(value);
+      break;
+    case 15:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNonBlockingMode(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGithubAuthToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasCloudUserId = function() {
-  return jspb.Message.getField(this, 13) != null;
+proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional ImageDownloadMode image_download_mode = 14;
- * @return {!proto.api_container_api.ImageDownloadMode}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getImageDownloadMode = function() {
-  return /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
-};
-
-
+proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPackageId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      11,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 12));
+  if (f != null) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 13));
+  if (f != null) {
+    writer.writeString(
+      13,
+      f
+    );
+  }
+  f = /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getField(message, 14));
+  if (f != null) {
+    writer.writeEnum(
+      14,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 15));
+  if (f != null) {
+    writer.writeBool(
+      15,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 16));
+  if (f != null) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+};
+
+
 /**
- * @param {!proto.api_container_api.ImageDownloadMode} value
+ * optional string package_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
  * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setImageDownloadMode = function(value) {
-  return jspb.Message.setField(this, 14, value);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes local = 3;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getLocal()));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getLocal()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
 };
 
 
@@ -4410,8 +4984,8 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.setImageDownloadMode =
  * Clears the field making it undefined.
  * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearImageDownloadMode = function() {
-  return jspb.Message.setField(this, 14, undefined);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
 };
 
 
@@ -4419,17 +4993,17 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearImageDownloadMode
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasImageDownloadMode = function() {
-  return jspb.Message.getField(this, 14) != null;
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional bool non_blocking_mode = 15;
+ * optional bool remote = 4;
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getNonBlockingMode = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 15, false));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
@@ -4437,8 +5011,8 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.getNonBlockingMode = fu
  * @param {boolean} value
  * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setNonBlockingMode = function(value) {
-  return jspb.Message.setField(this, 15, value);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
 };
 
 
@@ -4446,8 +5020,8 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.setNonBlockingMode = fu
  * Clears the field making it undefined.
  * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearNonBlockingMode = function() {
-  return jspb.Message.setField(this, 15, undefined);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
 };
 
 
@@ -4455,17 +5029,17 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearNonBlockingMode =
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasNonBlockingMode = function() {
-  return jspb.Message.getField(this, 15) != null;
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional string github_auth_token = 16;
+ * optional string serialized_params = 5;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getGithubAuthToken = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
@@ -4473,8 +5047,8 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.getGithubAuthToken = fu
  * @param {string} value
  * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setGithubAuthToken = function(value) {
-  return jspb.Message.setField(this, 16, value);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
@@ -4482,8 +5056,8 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.setGithubAuthToken = fu
  * Clears the field making it undefined.
  * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearGithubAuthToken = function() {
-  return jspb.Message.setField(this, 16, undefined);
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearSerializedParams = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
@@ -4491,49 +5065,3144 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearGithubAuthToken =
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasGithubAuthToken = function() {
-  return jspb.Message.getField(this, 16) != null;
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasSerializedParams = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
-
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * optional bool dry_run = 6;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6,7]];
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
 
 /**
- * @enum {number}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
-  RUN_RESPONSE_LINE_NOT_SET: 0,
-  INSTRUCTION: 1,
-  ERROR: 2,
-  PROGRESS_INFO: 3,
-  INSTRUCTION_RESULT: 4,
-  RUN_FINISHED_EVENT: 5,
-  WARNING: 6,
-  INFO: 7
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
+
 /**
- * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
-  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * optional int32 parallelism = 7;
+ * @return {number}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional bool clone_package = 8;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getClonePackage = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setClonePackage = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearClonePackage = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasClonePackage = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional string relative_path_to_main_file = 9;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRelativePathToMainFile = function() {
+  return jspb.Message.setField(this, 9, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRelativePathToMainFile = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional string main_function_name = 10;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearMainFunctionName = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasMainFunctionName = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * repeated KurtosisFeatureFlag experimental_features = 11;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 11));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 11, value || []);
+};
+
+
+/**
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
+};
+
+
+/**
+ * optional string cloud_instance_id = 12;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getCloudInstanceId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setCloudInstanceId = function(value) {
+  return jspb.Message.setField(this, 12, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearCloudInstanceId = function() {
+  return jspb.Message.setField(this, 12, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasCloudInstanceId = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional string cloud_user_id = 13;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getCloudUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setCloudUserId = function(value) {
+  return jspb.Message.setField(this, 13, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearCloudUserId = function() {
+  return jspb.Message.setField(this, 13, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasCloudUserId = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * optional ImageDownloadMode image_download_mode = 14;
+ * @return {!proto.api_container_api.ImageDownloadMode}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getImageDownloadMode = function() {
+  return /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.ImageDownloadMode} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setImageDownloadMode = function(value) {
+  return jspb.Message.setField(this, 14, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearImageDownloadMode = function() {
+  return jspb.Message.setField(this, 14, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasImageDownloadMode = function() {
+  return jspb.Message.getField(this, 14) != null;
+};
+
+
+/**
+ * optional bool non_blocking_mode = 15;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getNonBlockingMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 15, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setNonBlockingMode = function(value) {
+  return jspb.Message.setField(this, 15, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearNonBlockingMode = function() {
+  return jspb.Message.setField(this, 15, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasNonBlockingMode = function() {
+  return jspb.Message.getField(this, 15) != null;
+};
+
+
+/**
+ * optional string github_auth_token = 16;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getGithubAuthToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setGithubAuthToken = function(value) {
+  return jspb.Message.setField(this, 16, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearGithubAuthToken = function() {
+  return jspb.Message.setField(this, 16, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasGithubAuthToken = function() {
+  return jspb.Message.getField(this, 16) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6,7,8,9]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
+  RUN_RESPONSE_LINE_NOT_SET: 0,
+  INSTRUCTION: 1,
+  ERROR: 2,
+  PROGRESS_INFO: 3,
+  INSTRUCTION_RESULT: 4,
+  RUN_FINISHED_EVENT: 5,
+  WARNING: 6,
+  INFO: 7,
+  CONTAINER_IMAGE_TIMING: 8,
+  PLAN: 9
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
+  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
+    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
+    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
+    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
+    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
+    warning: (f = msg.getWarning()) && proto.api_container_api.StarlarkWarning.toObject(includeInstance, f),
+    info: (f = msg.getInfo()) && proto.api_container_api.StarlarkInfo.toObject(includeInstance, f),
+    containerImageTiming: (f = msg.getContainerImageTiming()) && proto.api_container_api.StarlarkContainerImageTiming.toObject(includeInstance, f),
+    plan: (f = msg.getPlan()) && proto.api_container_api.StarlarkRunPlan.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunResponseLine;
+  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.setInstruction(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkError;
+      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
+      msg.setError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkRunProgress;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
+      msg.setProgressInfo(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.StarlarkInstructionResult;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
+      msg.setInstructionResult(value);
+      break;
+    case 5:
+      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
+      msg.setRunFinishedEvent(value);
+      break;
+    case 6:
+      var value = new proto.api_container_api.StarlarkWarning;
+      reader.readMessage(value,proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader);
+      msg.setWarning(value);
+      break;
+    case 7:
+      var value = new proto.api_container_api.StarlarkInfo;
+      reader.readMessage(value,proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader);
+      msg.setInfo(value);
+      break;
+    case 8:
+      var value = new proto.api_container_api.StarlarkContainerImageTiming;
+      reader.readMessage(value,proto.api_container_api.StarlarkContainerImageTiming.deserializeBinaryFromReader);
+      msg.setContainerImageTiming(value);
+      break;
+    case 9:
+      var value = new proto.api_container_api.StarlarkRunPlan;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunPlan.deserializeBinaryFromReader);
+      msg.setPlan(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInstruction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkError.serializeBinaryToWriter
+    );
+  }
+  f = message.getProgressInfo();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionResult();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getRunFinishedEvent();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getWarning();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.api_container_api.StarlarkWarning.serializeBinaryToWriter
+    );
+  }
+  f = message.getInfo();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.api_container_api.StarlarkInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getContainerImageTiming();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.api_container_api.StarlarkContainerImageTiming.serializeBinaryToWriter
+    );
+  }
+  f = message.getPlan();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.api_container_api.StarlarkRunPlan.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstruction instruction = 1;
+ * @return {?proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
+  return this.setInstruction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkError error = 2;
+ * @return {?proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
+  return /** @type{?proto.api_container_api.StarlarkError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkError|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
+  return this.setError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkRunProgress progress_info = 3;
+ * @return {?proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
+  return this.setProgressInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional StarlarkInstructionResult instruction_result = 4;
+ * @return {?proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
+  return this.setInstructionResult(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional StarlarkRunFinishedEvent run_finished_event = 5;
+ * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
+  return this.setRunFinishedEvent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional StarlarkWarning warning = 6;
+ * @return {?proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getWarning = function() {
+  return /** @type{?proto.api_container_api.StarlarkWarning} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkWarning, 6));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkWarning|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setWarning = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearWarning = function() {
+  return this.setWarning(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasWarning = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional StarlarkInfo info = 7;
+ * @return {?proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInfo, 7));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInfo|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 7, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInfo = function() {
+  return this.setInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInfo = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional StarlarkContainerImageTiming container_image_timing = 8;
+ * @return {?proto.api_container_api.StarlarkContainerImageTiming}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getContainerImageTiming = function() {
+  return /** @type{?proto.api_container_api.StarlarkContainerImageTiming} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkContainerImageTiming, 8));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkContainerImageTiming|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setContainerImageTiming = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearContainerImageTiming = function() {
+  return this.setContainerImageTiming(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasContainerImageTiming = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional StarlarkRunPlan plan = 9;
+ * @return {?proto.api_container_api.StarlarkRunPlan}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getPlan = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunPlan} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunPlan, 9));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunPlan|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setPlan = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 9, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearPlan = function() {
+  return this.setPlan(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasPlan = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    infoMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInfo;
+  return proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInfoMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInfoMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string info_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInfo.prototype.getInfoMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInfo} returns this
+ */
+proto.api_container_api.StarlarkInfo.prototype.setInfoMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkWarning.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkWarning} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    warningMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkWarning.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkWarning;
+  return proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkWarning} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWarningMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkWarning.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkWarning} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWarningMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string warning_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkWarning.prototype.getWarningMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkWarning} returns this
+ */
+proto.api_container_api.StarlarkWarning.prototype.setWarningMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
+    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
+    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
+    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    isSkipped: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    description: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstruction;
+  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstructionPosition;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInstructionName(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkInstructionArg;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
+      msg.addArguments(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutableInstruction(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsSkipped(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getArgumentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutableInstruction();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getIsSkipped();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstructionPosition position = 1;
+ * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string instruction_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated StarlarkInstructionArg arguments = 3;
+ * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
+  return this.setArgumentsList([]);
+};
+
+
+/**
+ * optional string executable_instruction = 4;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool is_skipped = 5;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getIsSkipped = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setIsSkipped = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional string description = 6;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    executionDurationMilliseconds: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionResult;
+  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedInstructionResult(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setExecutionDurationMilliseconds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedInstructionResult();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_instruction_result = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 execution_duration_milliseconds = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.getExecutionDurationMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.setExecutionDurationMilliseconds = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.clearExecutionDurationMilliseconds = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.hasExecutionDurationMilliseconds = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkContainerImageTiming.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkContainerImageTiming.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkContainerImageTiming} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkContainerImageTiming.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    imageName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceNamesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    durationMilliseconds: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkContainerImageTiming}
+ */
+proto.api_container_api.StarlarkContainerImageTiming.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkContainerImageTiming;
+  return proto.api_container_api.StarlarkContainerImageTiming.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkContainerImageTiming} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkContainerImageTiming}
+ */
+proto.api_container_api.StarlarkContainerImageTiming.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setImageName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addServiceNames(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDurationMilliseconds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkContainerImageTiming.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkContainerImageTiming} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkContainerImageTiming.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getImageName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getDurationMilliseconds();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string image_name = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.getImageName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkContainerImageTiming} returns this
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.setImageName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string service_names = 2;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.getServiceNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.StarlarkContainerImageTiming} returns this
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.setServiceNamesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkContainerImageTiming} returns this
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.addServiceNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkContainerImageTiming} returns this
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.clearServiceNamesList = function() {
+  return this.setServiceNamesList([]);
+};
+
+
+/**
+ * optional uint64 duration_milliseconds = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.getDurationMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkContainerImageTiming} returns this
+ */
+proto.api_container_api.StarlarkContainerImageTiming.prototype.setDurationMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunPlan.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunPlan.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunPlan.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunPlan} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunPlan.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    instructionsList: jspb.Message.toObjectList(msg.getInstructionsList(),
+    proto.api_container_api.StarlarkPlannedInstruction.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunPlan}
+ */
+proto.api_container_api.StarlarkRunPlan.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunPlan;
+  return proto.api_container_api.StarlarkRunPlan.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunPlan} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunPlan}
+ */
+proto.api_container_api.StarlarkRunPlan.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkPlannedInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkPlannedInstruction.deserializeBinaryFromReader);
+      msg.addInstructions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunPlan.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunPlan.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunPlan} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunPlan.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInstructionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkPlannedInstruction.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated StarlarkPlannedInstruction instructions = 1;
+ * @return {!Array<!proto.api_container_api.StarlarkPlannedInstruction>}
+ */
+proto.api_container_api.StarlarkRunPlan.prototype.getInstructionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkPlannedInstruction>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkPlannedInstruction, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkPlannedInstruction>} value
+ * @return {!proto.api_container_api.StarlarkRunPlan} returns this
+*/
+proto.api_container_api.StarlarkRunPlan.prototype.setInstructionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkPlannedInstruction=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkPlannedInstruction}
+ */
+proto.api_container_api.StarlarkRunPlan.prototype.addInstructions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.StarlarkPlannedInstruction, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunPlan} returns this
+ */
+proto.api_container_api.StarlarkRunPlan.prototype.clearInstructionsList = function() {
+  return this.setInstructionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkPlannedInstruction.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkPlannedInstruction.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkPlannedInstruction} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkPlannedInstruction.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
+    serializedReturnedValue: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkPlannedInstruction}
+ */
+proto.api_container_api.StarlarkPlannedInstruction.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkPlannedInstruction;
+  return proto.api_container_api.StarlarkPlannedInstruction.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkPlannedInstruction} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkPlannedInstruction}
+ */
+proto.api_container_api.StarlarkPlannedInstruction.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.setInstruction(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedReturnedValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkPlannedInstruction.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkPlannedInstruction.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkPlannedInstruction} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkPlannedInstruction.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInstruction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+    );
+  }
+  f = message.getSerializedReturnedValue();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstruction instruction = 1;
+ * @return {?proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkPlannedInstruction.prototype.getInstruction = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
+ * @return {!proto.api_container_api.StarlarkPlannedInstruction} returns this
+*/
+proto.api_container_api.StarlarkPlannedInstruction.prototype.setInstruction = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkPlannedInstruction} returns this
+ */
+proto.api_container_api.StarlarkPlannedInstruction.prototype.clearInstruction = function() {
+  return this.setInstruction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkPlannedInstruction.prototype.hasInstruction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string serialized_returned_value = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkPlannedInstruction.prototype.getSerializedReturnedValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkPlannedInstruction} returns this
+ */
+proto.api_container_api.StarlarkPlannedInstruction.prototype.setSerializedReturnedValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionArg;
+  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedArgValue(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArgName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRepresentative(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedArgValue();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getIsRepresentative();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_arg_value = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string arg_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool is_representative = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionPosition;
+  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLine(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setColumn(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string filename = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 line = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 column = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkError.ErrorCase = {
+  ERROR_NOT_SET: 0,
+  INTERPRETATION_ERROR: 1,
+  VALIDATION_ERROR: 2,
+  EXECUTION_ERROR: 3
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ */
+proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
+  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
+    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
+    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkError;
+  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setInterpretationError(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkValidationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
+      msg.setValidationError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkExecutionError;
+      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
+      msg.setExecutionError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInterpretationError();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getValidationError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutionError();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInterpretationError interpretation_error = 1;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
+  return this.setInterpretationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkValidationError validation_error = 2;
+ * @return {?proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
+  return this.setValidationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkExecutionError execution_error = 3;
+ * @return {?proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
+  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
+  return this.setExecutionError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInterpretationError;
+  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
  * Optional fields that are not set will be set to undefined.
  * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
  * For the list of reserved names please see:
@@ -4543,8 +8212,138 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkValidationError;
+  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ */
+proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
 };
 
 
@@ -4553,19 +8352,15 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
   var f, obj = {
-    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
-    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
-    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
-    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
-    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
-    warning: (f = msg.getWarning()) && proto.api_container_api.StarlarkWarning.toObject(includeInstance, f),
-    info: (f = msg.getInfo()) && proto.api_container_api.StarlarkInfo.toObject(includeInstance, f)
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    backendErrorKind: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    remediationHint: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -4579,23 +8374,23 @@ proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.StarlarkExecutionError}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunResponseLine;
-  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkExecutionError;
+  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.StarlarkExecutionError}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4603,39 +8398,16 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstruction;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
-      msg.setInstruction(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
       break;
     case 2:
-      var value = new proto.api_container_api.StarlarkError;
-      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
-      msg.setError(value);
+      var value = /** @type {!proto.api_container_api.BackendErrorKind} */ (reader.readEnum());
+      msg.setBackendErrorKind(value);
       break;
     case 3:
-      var value = new proto.api_container_api.StarlarkRunProgress;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
-      msg.setProgressInfo(value);
-      break;
-    case 4:
-      var value = new proto.api_container_api.StarlarkInstructionResult;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
-      msg.setInstructionResult(value);
-      break;
-    case 5:
-      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
-      msg.setRunFinishedEvent(value);
-      break;
-    case 6:
-      var value = new proto.api_container_api.StarlarkWarning;
-      reader.readMessage(value,proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader);
-      msg.setWarning(value);
-      break;
-    case 7:
-      var value = new proto.api_container_api.StarlarkInfo;
-      reader.readMessage(value,proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader);
-      msg.setInfo(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRemediationHint(value);
       break;
     default:
       reader.skipField();
@@ -4650,9 +8422,9 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4660,318 +8432,473 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!proto.api_container_api.StarlarkExecutionError} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInstruction();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getBackendErrorKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
-      f,
-      proto.api_container_api.StarlarkError.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getProgressInfo();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getRemediationHint();
+  if (f.length > 0) {
+    writer.writeString(
       3,
-      f,
-      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
-    );
-  }
-  f = message.getInstructionResult();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
-    );
-  }
-  f = message.getRunFinishedEvent();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
-    );
-  }
-  f = message.getWarning();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.api_container_api.StarlarkWarning.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getInfo();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.api_container_api.StarlarkInfo.serializeBinaryToWriter
-    );
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional BackendErrorKind backend_error_kind = 2;
+ * @return {!proto.api_container_api.BackendErrorKind}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.getBackendErrorKind = function() {
+  return /** @type {!proto.api_container_api.BackendErrorKind} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.BackendErrorKind} value
+ * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.setBackendErrorKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string remediation_hint = 3;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.getRemediationHint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.setRemediationHint = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
   }
+  return obj;
 };
+}
 
 
 /**
- * optional StarlarkInstruction instruction = 1;
- * @return {?proto.api_container_api.StarlarkInstruction}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunProgress}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunProgress;
+  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCurrentStepInfo(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotalSteps(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCurrentStepNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
-  return this.setInstruction(undefined);
+proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCurrentStepInfoList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTotalSteps();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getCurrentStepNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional StarlarkError error = 2;
- * @return {?proto.api_container_api.StarlarkError}
+ * repeated string current_step_info = 1;
+ * @return {!Array<string>}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
-  return /** @type{?proto.api_container_api.StarlarkError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkError|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
-  return this.setError(undefined);
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * optional StarlarkRunProgress progress_info = 3;
- * @return {?proto.api_container_api.StarlarkRunProgress}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
+  return this.setCurrentStepInfoList([]);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * optional uint32 total_steps = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
-  return this.setProgressInfo(undefined);
+proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional uint32 current_step_number = 3;
+ * @return {number}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * optional StarlarkInstructionResult instruction_result = 4;
- * @return {?proto.api_container_api.StarlarkInstructionResult}
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
-/**
- * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
-  return this.setInstructionResult(undefined);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    isRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional StarlarkRunFinishedEvent run_finished_event = 5;
- * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
+  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRunSuccessful(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedOutput(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
-  return this.setRunFinishedEvent(undefined);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIsRunSuccessful();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional StarlarkWarning warning = 6;
- * @return {?proto.api_container_api.StarlarkWarning}
+ * optional bool is_run_successful = 1;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getWarning = function() {
-  return /** @type{?proto.api_container_api.StarlarkWarning} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkWarning, 6));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkWarning|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setWarning = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsRunSuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearWarning = function() {
-  return this.setWarning(undefined);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsRunSuccessful = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string serialized_output = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasWarning = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional StarlarkInfo info = 7;
- * @return {?proto.api_container_api.StarlarkInfo}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkInfo} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInfo, 7));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInfo|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 7, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInfo = function() {
-  return this.setInfo(undefined);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
@@ -4979,11 +8906,17 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.clearInfo = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInfo = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetServicesArgs.repeatedFields_ = [3];
 
 
 
@@ -5000,8 +8933,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInfo.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5010,13 +8943,17 @@ proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInfo} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    infoMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : [],
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    pageSize: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -5030,23 +8967,23 @@ proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInfo}
+ * @return {!proto.api_container_api.GetServicesArgs}
  */
-proto.api_container_api.StarlarkInfo.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInfo;
-  return proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServicesArgs;
+  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInfo} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInfo}
+ * @return {!proto.api_container_api.GetServicesArgs}
  */
-proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5054,8 +8991,30 @@ proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 2:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 3:
+      var values = /** @type {!Array<!proto.api_container_api.ServiceStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPageSize(value);
+      break;
+    case 5:
       var value = /** @type {string} */ (reader.readString());
-      msg.setInfoMessage(value);
+      msg.setPageToken(value);
       break;
     default:
       reader.skipField();
@@ -5070,9 +9029,9 @@ proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
+proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInfo.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5080,16 +9039,38 @@ proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInfo} message
+ * @param {!proto.api_container_api.GetServicesArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInfo.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInfoMessage();
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getStatusesList();
   if (f.length > 0) {
+    writer.writePackedEnum(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
     writer.writeString(
-      1,
+      5,
       f
     );
   }
@@ -5097,160 +9078,158 @@ proto.api_container_api.StarlarkInfo.serializeBinaryToWriter = function(message,
 
 
 /**
- * optional string info_message = 1;
- * @return {string}
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.StarlarkInfo.prototype.getInfoMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInfo} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.StarlarkInfo.prototype.setInfoMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
+proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
+
 
+/**
+ * map<string, string> labels = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.GetServicesArgs.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
 
 
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
+ */
+proto.api_container_api.GetServicesArgs.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * repeated ServiceStatus statuses = 3;
+ * @return {!Array<!proto.api_container_api.ServiceStatus>}
  */
-proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkWarning.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServicesArgs.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.api_container_api.ServiceStatus>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkWarning} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!Array<!proto.api_container_api.ServiceStatus>} value
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    warningMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.GetServicesArgs.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkWarning}
+ * @param {!proto.api_container_api.ServiceStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.StarlarkWarning.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkWarning;
-  return proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.GetServicesArgs.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkWarning} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkWarning}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setWarningMessage(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.GetServicesArgs.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
+};
+
+
+/**
+ * optional uint32 page_size = 4;
+ * @return {number}
+ */
+proto.api_container_api.GetServicesArgs.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {number} value
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkWarning.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.GetServicesArgs.prototype.setPageSize = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkWarning} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getWarningMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.GetServicesArgs.prototype.clearPageSize = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
 /**
- * optional string warning_message = 1;
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetServicesArgs.prototype.hasPageSize = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string page_token = 5;
  * @return {string}
  */
-proto.api_container_api.StarlarkWarning.prototype.getWarningMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetServicesArgs.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkWarning} returns this
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.StarlarkWarning.prototype.setWarningMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetServicesArgs.prototype.setPageToken = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
+ */
+proto.api_container_api.GetServicesArgs.prototype.clearPageToken = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+proto.api_container_api.GetServicesArgs.prototype.hasPageToken = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
 
 
 
@@ -5267,8 +9246,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5277,19 +9256,14 @@ proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
-    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
-    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
-    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    isSkipped: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    description: jspb.Message.getFieldWithDefault(msg, 6, "")
+    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : [],
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5303,23 +9277,23 @@ proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.GetServicesResponse}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstruction;
-  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServicesResponse;
+  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.GetServicesResponse}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5327,30 +9301,14 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstructionPosition;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
-      msg.setPosition(value);
+      var value = msg.getServiceInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
+         });
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setInstructionName(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkInstructionArg;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
-      msg.addArguments(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setExecutableInstruction(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsSkipped(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
+      msg.setNextPageToken(value);
       break;
     default:
       reader.skipField();
@@ -5365,9 +9323,9 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5375,84 +9333,72 @@ proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!proto.api_container_api.GetServicesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPosition();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
-    );
+  f = message.getServiceInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
   }
-  f = message.getInstructionName();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getArgumentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
-    );
-  }
-  f = message.getExecutableInstruction();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getIsSkipped();
-  if (f) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
 };
 
 
 /**
- * optional StarlarkInstructionPosition position = 1;
- * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ * map<string, ServiceInfo> service_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceInfo));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesResponse} returns this
+ */
+proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
+  this.getServiceInfoMap().clear();
+  return this;};
+
+
+/**
+ * optional string next_page_token = 2;
+ * @return {string}
+ */
+proto.api_container_api.GetServicesResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.GetServicesResponse} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
-  return this.setPosition(undefined);
+proto.api_container_api.GetServicesResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.GetServicesResponse} returns this
+ */
+proto.api_container_api.GetServicesResponse.prototype.clearNextPageToken = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
@@ -5460,122 +9406,209 @@ proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.GetServicesResponse.prototype.hasNextPageToken = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string instruction_name = 2;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated StarlarkInstructionArg arguments = 3;
- * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
-  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceIdentifiers;
+  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceIdentifiers}
+ */
+proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
-  return this.setArgumentsList([]);
+proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string executable_instruction = 4;
+ * optional string service_uuid = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool is_skipped = 5;
- * @return {boolean}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getIsSkipped = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setIsSkipped = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string description = 6;
+ * optional string shortened_uuid = 3;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5591,8 +9624,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5601,13 +9634,14 @@ proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5621,23 +9655,23 @@ proto.api_container_api.StarlarkInstructionResult.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionResult;
-  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5645,8 +9679,9 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedInstructionResult(value);
+      var value = new proto.api_container_api.ServiceIdentifiers;
+      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
       break;
     default:
       reader.skipField();
@@ -5661,9 +9696,9 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5671,37 +9706,58 @@ proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedInstructionResult();
+  f = message.getAllidentifiersList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string serialized_instruction_result = 1;
- * @return {string}
+ * repeated ServiceIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+*/
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
 };
 
 
@@ -5721,8 +9777,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceDependencyNode.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceDependencyNode.toObject(opt_includeInstance, this);
 };
 
 
@@ -5731,15 +9787,14 @@ proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceDependencyNode} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceDependencyNode.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    serviceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    hasReadyConditions: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -5753,23 +9808,23 @@ proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.ServiceDependencyNode}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceDependencyNode.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionArg;
-  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceDependencyNode;
+  return proto.api_container_api.ServiceDependencyNode.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceDependencyNode} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.ServiceDependencyNode}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceDependencyNode.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5778,15 +9833,11 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedArgValue(value);
+      msg.setServiceName(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setArgName(value);
-      break;
-    case 3:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRepresentative(value);
+      msg.setHasReadyConditions(value);
       break;
     default:
       reader.skipField();
@@ -5801,9 +9852,9 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceDependencyNode.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceDependencyNode.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5811,30 +9862,23 @@ proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!proto.api_container_api.ServiceDependencyNode} message
  * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSerializedArgValue();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceDependencyNode.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceName();
+  if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
-  f = message.getIsRepresentative();
+  f = message.getHasReadyConditions();
   if (f) {
     writer.writeBool(
-      3,
+      2,
       f
     );
   }
@@ -5842,74 +9886,38 @@ proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = functio
 
 
 /**
- * optional string serialized_arg_value = 1;
+ * optional string service_name = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
+proto.api_container_api.ServiceDependencyNode.prototype.getServiceName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @return {!proto.api_container_api.ServiceDependencyNode} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
+proto.api_container_api.ServiceDependencyNode.prototype.setServiceName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string arg_name = 2;
- * @return {string}
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional bool is_representative = 3;
+ * optional bool has_ready_conditions = 2;
  * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.ServiceDependencyNode.prototype.getHasReadyConditions = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @return {!proto.api_container_api.ServiceDependencyNode} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.api_container_api.ServiceDependencyNode.prototype.setHasReadyConditions = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
@@ -5929,8 +9937,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceDependencyEdge.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceDependencyEdge.toObject(opt_includeInstance, this);
 };
 
 
@@ -5939,15 +9947,15 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceDependencyEdge} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceDependencyEdge.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    serviceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    dependencyServiceName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -5961,23 +9969,23 @@ proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.ServiceDependencyEdge}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceDependencyEdge.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionPosition;
-  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceDependencyEdge;
+  return proto.api_container_api.ServiceDependencyEdge.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceDependencyEdge} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.ServiceDependencyEdge}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceDependencyEdge.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5986,15 +9994,15 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
+      msg.setServiceName(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLine(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDependencyServiceName(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setColumn(value);
+      var value = /** @type {!proto.api_container_api.ServiceDependencyKind} */ (reader.readEnum());
+      msg.setKind(value);
       break;
     default:
       reader.skipField();
@@ -6009,9 +10017,9 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceDependencyEdge.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceDependencyEdge.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6019,29 +10027,29 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!proto.api_container_api.ServiceDependencyEdge} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServiceDependencyEdge.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFilename();
+  f = message.getServiceName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getLine();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getDependencyServiceName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getColumn();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
       3,
       f
     );
@@ -6050,86 +10058,66 @@ proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = fu
 
 
 /**
- * optional string filename = 1;
+ * optional string service_name = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
+proto.api_container_api.ServiceDependencyEdge.prototype.getServiceName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @return {!proto.api_container_api.ServiceDependencyEdge} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
+proto.api_container_api.ServiceDependencyEdge.prototype.setServiceName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 line = 2;
- * @return {number}
+ * optional string dependency_service_name = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.ServiceDependencyEdge.prototype.getDependencyServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceDependencyEdge} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.ServiceDependencyEdge.prototype.setDependencyServiceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional int32 column = 3;
- * @return {number}
+ * optional ServiceDependencyKind kind = 3;
+ * @return {!proto.api_container_api.ServiceDependencyKind}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.ServiceDependencyEdge.prototype.getKind = function() {
+  return /** @type {!proto.api_container_api.ServiceDependencyKind} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @param {!proto.api_container_api.ServiceDependencyKind} value
+ * @return {!proto.api_container_api.ServiceDependencyEdge} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.ServiceDependencyEdge.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
 
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
-
-/**
- * @enum {number}
- */
-proto.api_container_api.StarlarkError.ErrorCase = {
-  ERROR_NOT_SET: 0,
-  INTERPRETATION_ERROR: 1,
-  VALIDATION_ERROR: 2,
-  EXECUTION_ERROR: 3
-};
-
-/**
- * @return {proto.api_container_api.StarlarkError.ErrorCase}
- */
-proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
-  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
-};
+proto.api_container_api.GetServiceDependencyGraphResponse.repeatedFields_ = [1,2];
 
 
 
@@ -6146,8 +10134,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServiceDependencyGraphResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6156,15 +10144,16 @@ proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServiceDependencyGraphResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServiceDependencyGraphResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
-    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
-    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+    nodesList: jspb.Message.toObjectList(msg.getNodesList(),
+    proto.api_container_api.ServiceDependencyNode.toObject, includeInstance),
+    edgesList: jspb.Message.toObjectList(msg.getEdgesList(),
+    proto.api_container_api.ServiceDependencyEdge.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6178,23 +10167,23 @@ proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.GetServiceDependencyGraphResponse}
  */
-proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServiceDependencyGraphResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkError;
-  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServiceDependencyGraphResponse;
+  return proto.api_container_api.GetServiceDependencyGraphResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServiceDependencyGraphResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.GetServiceDependencyGraphResponse}
  */
-proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServiceDependencyGraphResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6202,19 +10191,14 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInterpretationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
-      msg.setInterpretationError(value);
+      var value = new proto.api_container_api.ServiceDependencyNode;
+      reader.readMessage(value,proto.api_container_api.ServiceDependencyNode.deserializeBinaryFromReader);
+      msg.addNodes(value);
       break;
     case 2:
-      var value = new proto.api_container_api.StarlarkValidationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
-      msg.setValidationError(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkExecutionError;
-      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
-      msg.setExecutionError(value);
+      var value = new proto.api_container_api.ServiceDependencyEdge;
+      reader.readMessage(value,proto.api_container_api.ServiceDependencyEdge.deserializeBinaryFromReader);
+      msg.addEdges(value);
       break;
     default:
       reader.skipField();
@@ -6229,9 +10213,9 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServiceDependencyGraphResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6239,150 +10223,247 @@ proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!proto.api_container_api.GetServiceDependencyGraphResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServiceDependencyGraphResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInterpretationError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getNodesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
       f,
-      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+      proto.api_container_api.ServiceDependencyNode.serializeBinaryToWriter
     );
   }
-  f = message.getValidationError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getEdgesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
       f,
-      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
-    );
-  }
-  f = message.getExecutionError();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+      proto.api_container_api.ServiceDependencyEdge.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional StarlarkInterpretationError interpretation_error = 1;
- * @return {?proto.api_container_api.StarlarkInterpretationError}
+ * repeated ServiceDependencyNode nodes = 1;
+ * @return {!Array<!proto.api_container_api.ServiceDependencyNode>}
  */
-proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.getNodesList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceDependencyNode>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceDependencyNode, 1));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * @param {!Array<!proto.api_container_api.ServiceDependencyNode>} value
+ * @return {!proto.api_container_api.GetServiceDependencyGraphResponse} returns this
 */
-proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.setNodesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * @param {!proto.api_container_api.ServiceDependencyNode=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceDependencyNode}
  */
-proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
-  return this.setInterpretationError(undefined);
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.addNodes = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceDependencyNode, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetServiceDependencyGraphResponse} returns this
  */
-proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.clearNodesList = function() {
+  return this.setNodesList([]);
 };
 
 
 /**
- * optional StarlarkValidationError validation_error = 2;
- * @return {?proto.api_container_api.StarlarkValidationError}
+ * repeated ServiceDependencyEdge edges = 2;
+ * @return {!Array<!proto.api_container_api.ServiceDependencyEdge>}
+ */
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.getEdgesList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceDependencyEdge>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceDependencyEdge, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.ServiceDependencyEdge>} value
+ * @return {!proto.api_container_api.GetServiceDependencyGraphResponse} returns this
+*/
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.setEdgesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceDependencyEdge=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceDependencyEdge}
+ */
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.addEdges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.ServiceDependencyEdge, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetServiceDependencyGraphResponse} returns this
+ */
+proto.api_container_api.GetServiceDependencyGraphResponse.prototype.clearEdgesList = function() {
+  return this.setEdgesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.SetEnclaveEnvVarsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.SetEnclaveEnvVarsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.SetEnclaveEnvVarsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
-};
-
+proto.api_container_api.SetEnclaveEnvVarsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    envVarsMap: (f = msg.getEnvVarsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
 
-/**
- * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.SetEnclaveEnvVarsArgs}
  */
-proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
-  return this.setValidationError(undefined);
+proto.api_container_api.SetEnclaveEnvVarsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.SetEnclaveEnvVarsArgs;
+  return proto.api_container_api.SetEnclaveEnvVarsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.SetEnclaveEnvVarsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.SetEnclaveEnvVarsArgs}
  */
-proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.SetEnclaveEnvVarsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getEnvVarsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional StarlarkExecutionError execution_error = 3;
- * @return {?proto.api_container_api.StarlarkExecutionError}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
-  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+proto.api_container_api.SetEnclaveEnvVarsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.SetEnclaveEnvVarsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.SetEnclaveEnvVarsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.SetEnclaveEnvVarsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnvVarsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * map<string, string> env_vars = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
-  return this.setExecutionError(undefined);
+proto.api_container_api.SetEnclaveEnvVarsArgs.prototype.getEnvVarsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.SetEnclaveEnvVarsArgs} returns this
  */
-proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
+proto.api_container_api.SetEnclaveEnvVarsArgs.prototype.clearEnvVarsMap = function() {
+  this.getEnvVarsMap().clear();
+  return this;};
+
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
 
 
 
@@ -6399,8 +10480,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6409,13 +10490,14 @@ proto.api_container_api.StarlarkInterpretationError.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6429,23 +10511,23 @@ proto.api_container_api.StarlarkInterpretationError.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInterpretationError;
-  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandArgs;
+  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6454,7 +10536,11 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCommandArgs(value);
       break;
     default:
       reader.skipField();
@@ -6469,9 +10555,9 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6479,40 +10565,84 @@ proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!proto.api_container_api.ExecCommandArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getCommandArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
+proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * repeated string command_args = 2;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
+  return this.setCommandArgsList([]);
+};
+
+
 
 
 
@@ -6529,8 +10659,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6539,13 +10669,14 @@ proto.api_container_api.StarlarkValidationError.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -6559,23 +10690,23 @@ proto.api_container_api.StarlarkValidationError.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkValidationError;
-  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandResponse;
+  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6583,8 +10714,12 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setLogOutput(value);
       break;
     default:
       reader.skipField();
@@ -6599,9 +10734,9 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6609,16 +10744,23 @@ proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!proto.api_container_api.ExecCommandResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getLogOutput();
   if (f.length > 0) {
     writer.writeString(
-      1,
+      2,
       f
     );
   }
@@ -6626,20 +10768,38 @@ proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = functi
 
 
 /**
- * optional string error_message = 1;
+ * optional int32 exit_code = 1;
+ * @return {number}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ */
+proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string log_output = 2;
  * @return {string}
  */
-proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
  */
-proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -6659,8 +10819,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6669,13 +10829,19 @@ proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -6689,23 +10855,23 @@ proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkExecutionError;
-  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6714,7 +10880,31 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -6729,9 +10919,9 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6739,253 +10929,277 @@ proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional uint32 port = 2;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
 
 
+/**
+ * optional string path = 3;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
-  };
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearPath = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasPath = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * optional uint32 initial_delay_milliseconds = 4;
+ * @return {number}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunProgress;
-  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearInitialDelayMilliseconds = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasInitialDelayMilliseconds = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional uint32 retries = 5;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addCurrentStepInfo(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setTotalSteps(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCurrentStepNumber(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearRetries = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunProgress} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getCurrentStepInfoList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
-  f = message.getTotalSteps();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getCurrentStepNumber();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasRetries = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * repeated string current_step_info = 1;
- * @return {!Array<string>}
+ * optional uint32 retries_delay_milliseconds = 6;
+ * @return {number}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearRetriesDelayMilliseconds = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
-  return this.setCurrentStepInfoList([]);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasRetriesDelayMilliseconds = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional uint32 total_steps = 2;
- * @return {number}
+ * optional string body_text = 7;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * optional uint32 current_step_number = 3;
- * @return {number}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearBodyText = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasBodyText = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
@@ -7005,8 +11219,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7015,14 +11229,20 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -7036,23 +11256,23 @@ proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
-  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7060,101 +11280,185 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRunSuccessful(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
       break;
     case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedOutput(value);
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestBody(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
       break;
     }
   }
-  return msg;
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getIsRunSuccessful();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool is_run_successful = 1;
- * @return {boolean}
+ * optional uint32 port = 2;
+ * @return {number}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsRunSuccessful = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsRunSuccessful = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string serialized_output = 2;
+ * optional string path = 3;
  * @return {string}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearPath = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
@@ -7162,275 +11466,189 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasPath = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
+/**
+ * optional string request_body = 4;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRequestBody = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesArgs;
-  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRequestBody = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * optional uint32 initial_delay_milliseconds = 5;
+ * @return {number}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = msg.getServiceIdentifiersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getServiceIdentifiersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearInitialDelayMilliseconds = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
 /**
- * map<string, bool> service_identifiers = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasInitialDelayMilliseconds = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesArgs} returns this
+ * optional uint32 retries = 6;
+ * @return {number}
  */
-proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
-  this.getServiceIdentifiersMap().clear();
-  return this;};
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
 
 
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
 
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRetries = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRetries = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional uint32 retries_delay_milliseconds = 7;
+ * @return {number}
  */
-proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
-  };
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesResponse;
-  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRetriesDelayMilliseconds = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = msg.getServiceInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
-         });
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRetriesDelayMilliseconds = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string body_text = 8;
+ * @return {string}
  */
-proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getServiceInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
-  }
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setField(this, 8, value);
 };
 
 
 /**
- * map<string, ServiceInfo> service_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceInfo));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearBodyText = function() {
+  return jspb.Message.setField(this, 8, undefined);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
-  this.getServiceInfoMap().clear();
-  return this;};
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasBodyText = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
 
 
 
@@ -7449,8 +11667,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
+proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StreamedDataChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -7459,15 +11677,15 @@ proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StreamedDataChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    data: msg.getData_asB64(),
+    previousChunkHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    metadata: (f = msg.getMetadata()) && proto.api_container_api.DataChunkMetadata.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -7481,23 +11699,23 @@ proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.StreamedDataChunk}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+proto.api_container_api.StreamedDataChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceIdentifiers;
-  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StreamedDataChunk;
+  return proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StreamedDataChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.StreamedDataChunk}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7505,16 +11723,17 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setPreviousChunkHash(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      var value = new proto.api_container_api.DataChunkMetadata;
+      reader.readMessage(value,proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
       break;
     default:
       reader.skipField();
@@ -7529,9 +11748,9 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7539,98 +11758,135 @@ proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!proto.api_container_api.StreamedDataChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
+  f = message.getData_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getPreviousChunkHash();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string service_uuid = 1;
+ * optional bytes data = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+proto.api_container_api.StreamedDataChunk.prototype.getData = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StreamedDataChunk.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
 };
 
 
 /**
- * optional string name = 2;
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StreamedDataChunk.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ */
+proto.api_container_api.StreamedDataChunk.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional string previous_chunk_hash = 2;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+proto.api_container_api.StreamedDataChunk.prototype.getPreviousChunkHash = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+proto.api_container_api.StreamedDataChunk.prototype.setPreviousChunkHash = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string shortened_uuid = 3;
- * @return {string}
+ * optional DataChunkMetadata metadata = 3;
+ * @return {?proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StreamedDataChunk.prototype.getMetadata = function() {
+  return /** @type{?proto.api_container_api.DataChunkMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.DataChunkMetadata, 3));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @param {?proto.api_container_api.DataChunkMetadata|undefined} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
+*/
+proto.api_container_api.StreamedDataChunk.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.StreamedDataChunk.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StreamedDataChunk.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7646,8 +11902,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DataChunkMetadata.toObject(opt_includeInstance, this);
 };
 
 
@@ -7656,14 +11912,13 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DataChunkMetadata} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -7677,23 +11932,23 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.DataChunkMetadata.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DataChunkMetadata;
+  return proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DataChunkMetadata} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7701,9 +11956,8 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.ServiceIdentifiers;
-      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -7718,9 +11972,9 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7728,69 +11982,41 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!proto.api_container_api.DataChunkMetadata} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated ServiceIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
- */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
-};
-
-
-/**
- * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
-*/
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
+proto.api_container_api.DataChunkMetadata.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.DataChunkMetadata} returns this
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.api_container_api.DataChunkMetadata.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -7806,8 +12032,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7816,14 +12042,14 @@ proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7837,23 +12063,23 @@ proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandArgs;
-  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
+  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7862,11 +12088,11 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setUuid(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.addCommandArgs(value);
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -7881,9 +12107,9 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7891,22 +12117,22 @@ proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandArgs} message
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getCommandArgsList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       2,
       f
     );
@@ -7915,57 +12141,38 @@ proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(messa
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated string command_args = 2;
- * @return {!Array<string>}
- */
-proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
-  return this.setCommandArgsList([]);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -7985,8 +12192,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7995,14 +12202,13 @@ proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8016,23 +12222,23 @@ proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandResponse;
-  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
+  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8040,12 +12246,8 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setExitCode(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setLogOutput(value);
+      msg.setIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -8060,9 +12262,9 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8070,23 +12272,16 @@ proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandResponse} message
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getExitCode();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
-  f = message.getLogOutput();
+  f = message.getIdentifier();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -8094,41 +12289,32 @@ proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(m
 
 
 /**
- * optional int32 exit_code = 1;
- * @return {number}
+ * optional string identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string log_output = 2;
- * @return {string}
- */
-proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
+
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
+proto.api_container_api.StoreWebFilesArtifactArgs.repeatedFields_ = [3];
 
 
 
@@ -8145,8 +12331,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8155,19 +12341,19 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    headersList: jspb.Message.toObjectList(msg.getHeadersList(),
+    proto.api_container_api.HttpHeader.toObject, includeInstance),
+    basicAuthUsername: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    basicAuthPassword: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    contentSha256: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -8181,23 +12367,23 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
+  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8206,31 +12392,28 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setUrl(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      var value = new proto.api_container_api.HttpHeader;
+      reader.readMessage(value,proto.api_container_api.HttpHeader.deserializeBinaryFromReader);
+      msg.addHeaders(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBasicAuthUsername(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBasicAuthPassword(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
-      break;
-    case 7:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      msg.setContentSha256(value);
       break;
     default:
       reader.skipField();
@@ -8245,9 +12428,9 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8255,58 +12438,52 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getUrl();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeString(
+  f = message.getHeadersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       3,
-      f
+      f,
+      proto.api_container_api.HttpHeader.serializeBinaryToWriter
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  f = /** @type {string} */ (jspb.Message.getField(message, 4));
   if (f != null) {
-    writer.writeUint32(
+    writer.writeString(
       4,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
   if (f != null) {
-    writer.writeUint32(
+    writer.writeString(
       5,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  f = /** @type {string} */ (jspb.Message.getField(message, 6));
   if (f != null) {
     writer.writeString(
-      7,
+      6,
       f
     );
   }
@@ -8314,218 +12491,475 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryTo
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string url = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string path = 3;
+ * repeated HttpHeader headers = 3;
+ * @return {!Array<!proto.api_container_api.HttpHeader>}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getHeadersList = function() {
+  return /** @type{!Array<!proto.api_container_api.HttpHeader>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.HttpHeader, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.HttpHeader>} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+*/
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setHeadersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.HttpHeader=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.HttpHeader}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.addHeaders = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.HttpHeader, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.clearHeadersList = function() {
+  return this.setHeadersList([]);
+};
+
+
+/**
+ * optional string basic_auth_username = 4;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getBasicAuthUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setBasicAuthUsername = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.clearBasicAuthUsername = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.hasBasicAuthUsername = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string basic_auth_password = 5;
+ * @return {string}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getBasicAuthPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setBasicAuthPassword = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.clearBasicAuthPassword = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.hasBasicAuthPassword = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string content_sha256 = 6;
+ * @return {string}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getContentSha256 = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setContentSha256 = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.clearContentSha256 = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.hasContentSha256 = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.api_container_api.HttpHeader.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpHeader.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.HttpHeader} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearPath = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.api_container_api.HttpHeader.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.HttpHeader}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasPath = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.HttpHeader.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.HttpHeader;
+  return proto.api_container_api.HttpHeader.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional uint32 initial_delay_milliseconds = 4;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.HttpHeader} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.HttpHeader}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.HttpHeader.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.api_container_api.HttpHeader.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.HttpHeader.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.HttpHeader} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearInitialDelayMilliseconds = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.HttpHeader.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasInitialDelayMilliseconds = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.HttpHeader.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional uint32 retries = 5;
- * @return {number}
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpHeader} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.HttpHeader.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * optional string value = 2;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.api_container_api.HttpHeader.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpHeader} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearRetries = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.HttpHeader.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasRetries = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional uint32 retries_delay_milliseconds = 6;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearRetriesDelayMilliseconds = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
+  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasRetriesDelayMilliseconds = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string body_text = 7;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setField(this, 7, value);
+proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearBodyText = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasBodyText = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -8545,8 +12979,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8555,20 +12989,15 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObje
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -8582,23 +13011,23 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = funct
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8610,32 +13039,12 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
       msg.setServiceIdentifier(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRequestBody(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
+      msg.setSourcePath(value);
       break;
-    case 8:
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -8650,9 +13059,9 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8660,11 +13069,11 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serial
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getServiceIdentifier();
   if (f.length > 0) {
@@ -8673,55 +13082,20 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryT
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getSourcePath();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
+  f = message.getName();
+  if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeUint32(
-      7,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 8));
-  if (f != null) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
 };
 
 
@@ -8729,251 +13103,183 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryT
  * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string path = 3;
+ * optional string source_path = 2;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearPath = function() {
-  return jspb.Message.setField(this, 3, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasPath = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string request_body = 4;
+ * optional string name = 3;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
-  return jspb.Message.setField(this, 4, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRequestBody = function() {
-  return jspb.Message.setField(this, 4, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRequestBody = function() {
-  return jspb.Message.getField(this, 4) != null;
-};
-
-
-/**
- * optional uint32 initial_delay_milliseconds = 5;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setField(this, 5, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearInitialDelayMilliseconds = function() {
-  return jspb.Message.setField(this, 5, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasInitialDelayMilliseconds = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
-
-
-/**
- * optional uint32 retries = 6;
- * @return {number}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setField(this, 6, value);
-};
-
 
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRetries = function() {
-  return jspb.Message.setField(this, 6, undefined);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRetries = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 7;
- * @return {number}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
-};
-
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
 
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setField(this, 7, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRetriesDelayMilliseconds = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRetriesDelayMilliseconds = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string body_text = 8;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setField(this, 8, value);
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearBodyText = function() {
-  return jspb.Message.setField(this, 8, undefined);
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasBodyText = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -8993,8 +13299,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StreamedDataChunk.toObject(opt_includeInstance, this);
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
 };
 
 
@@ -9003,15 +13309,14 @@ proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StreamedDataChunk} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, msg) {
+proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: msg.getData_asB64(),
-    previousChunkHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    metadata: (f = msg.getMetadata()) && proto.api_container_api.DataChunkMetadata.toObject(includeInstance, f)
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9025,23 +13330,23 @@ proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StreamedDataChunk}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.StreamedDataChunk.deserializeBinary = function(bytes) {
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StreamedDataChunk;
-  return proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
+  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StreamedDataChunk} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StreamedDataChunk}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9049,17 +13354,12 @@ proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPreviousChunkHash(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.DataChunkMetadata;
-      reader.readMessage(value,proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      msg.setFileuuid(value);
       break;
     default:
       reader.skipField();
@@ -9074,9 +13374,9 @@ proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function() {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9084,131 +13384,222 @@ proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StreamedDataChunk} message
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getData_asU8();
+  f = message.getFilename();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getPreviousChunkHash();
+  f = message.getFileuuid();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional bytes data = 1;
+ * optional string fileName = 1;
  * @return {string}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getData = function() {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string fileUuid = 2;
  * @return {string}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
+    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional bytes data = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
  */
-proto.api_container_api.StreamedDataChunk.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.addFileNamesAndUuids(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string previous_chunk_hash = 2;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getPreviousChunkHash = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StreamedDataChunk.prototype.setPreviousChunkHash = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFileNamesAndUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional DataChunkMetadata metadata = 3;
- * @return {?proto.api_container_api.DataChunkMetadata}
+ * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
+ * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getMetadata = function() {
-  return /** @type{?proto.api_container_api.DataChunkMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.DataChunkMetadata, 3));
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
 };
 
 
 /**
- * @param {?proto.api_container_api.DataChunkMetadata|undefined} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
 */
-proto.api_container_api.StreamedDataChunk.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.StreamedDataChunk.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
  */
-proto.api_container_api.StreamedDataChunk.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
+  return this.setFileNamesAndUuidsList([]);
 };
 
 
@@ -9228,8 +13619,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DataChunkMetadata.toObject(opt_includeInstance, this);
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -9238,13 +13629,13 @@ proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DataChunkMetadata} msg The msg instance to transform.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, msg) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+    fileNamesAndUuid: (f = msg.getFileNamesAndUuid()) && proto.api_container_api.FilesArtifactNameAndUuid.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -9258,23 +13649,23 @@ proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DataChunkMetadata}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
  */
-proto.api_container_api.DataChunkMetadata.deserializeBinary = function(bytes) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DataChunkMetadata;
-  return proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsRequest;
+  return proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.DataChunkMetadata} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DataChunkMetadata}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
  */
-proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9282,8 +13673,9 @@ proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.setFileNamesAndUuid(value);
       break;
     default:
       reader.skipField();
@@ -9298,9 +13690,9 @@ proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function() {
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9308,41 +13700,68 @@ proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DataChunkMetadata} message
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFileNamesAndUuid();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional FilesArtifactNameAndUuid file_names_and_uuid = 1;
+ * @return {?proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.DataChunkMetadata.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getFileNamesAndUuid = function() {
+  return /** @type{?proto.api_container_api.FilesArtifactNameAndUuid} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.DataChunkMetadata} returns this
+ * @param {?proto.api_container_api.FilesArtifactNameAndUuid|undefined} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setFileNamesAndUuid = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
  */
-proto.api_container_api.DataChunkMetadata.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileNamesAndUuid = function() {
+  return this.setFileNamesAndUuid(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNamesAndUuid = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9358,8 +13777,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9368,14 +13787,15 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
+    proto.api_container_api.FileArtifactContentsFileDescription.toObject, includeInstance),
+    contentSha256: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9389,23 +13809,23 @@ proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
-  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9413,12 +13833,13 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = new proto.api_container_api.FileArtifactContentsFileDescription;
+      reader.readMessage(value,proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader);
+      msg.addFileDescriptions(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setContentSha256(value);
       break;
     default:
       reader.skipField();
@@ -9433,9 +13854,9 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9443,20 +13864,21 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getFileDescriptionsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter
     );
   }
-  f = message.getName();
+  f = message.getContentSha256();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -9467,37 +13889,57 @@ proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = fu
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
+ * repeated FileArtifactContentsFileDescription file_descriptions = 1;
+ * @return {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>}
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FileArtifactContentsFileDescription, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FileArtifactContentsFileDescription, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
+  return this.setFileDescriptionsList([]);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string content_sha256 = 2;
  * @return {string}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getContentSha256 = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setContentSha256 = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -9518,8 +13960,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FileArtifactContentsFileDescription.toObject(opt_includeInstance, this);
 };
 
 
@@ -9528,13 +13970,15 @@ proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
   var f, obj = {
-    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    textPreview: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -9548,23 +13992,23 @@ proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
-  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FileArtifactContentsFileDescription;
+  return proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9573,7 +14017,15 @@ proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setIdentifier(value);
+      msg.setPath(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTextPreview(value);
       break;
     default:
       reader.skipField();
@@ -9588,9 +14040,9 @@ proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9598,40 +14050,108 @@ proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIdentifier();
+  f = message.getPath();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string identifier = 1;
+ * optional string path = 1;
  * @return {string}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getPath = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setPath = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 size = 2;
+ * @return {number}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string text_preview = 3;
+ * @return {string}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getTextPreview = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setTextPreview = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextPreview = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.hasTextPreview = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -9648,8 +14168,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetFilesArtifactHistoryArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetFilesArtifactHistoryArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -9658,14 +14178,13 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetFilesArtifactHistoryArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetFilesArtifactHistoryArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -9679,23 +14198,23 @@ proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.GetFilesArtifactHistoryArgs}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetFilesArtifactHistoryArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
-  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetFilesArtifactHistoryArgs;
+  return proto.api_container_api.GetFilesArtifactHistoryArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetFilesArtifactHistoryArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.GetFilesArtifactHistoryArgs}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetFilesArtifactHistoryArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9704,11 +14223,7 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -9723,9 +14238,9 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetFilesArtifactHistoryArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetFilesArtifactHistoryArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9733,65 +14248,47 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
+ * @param {!proto.api_container_api.GetFilesArtifactHistoryArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetFilesArtifactHistoryArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
+  f = message.getIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string url = 1;
+ * optional string identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+proto.api_container_api.GetFilesArtifactHistoryArgs.prototype.getIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.GetFilesArtifactHistoryArgs} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+proto.api_container_api.GetFilesArtifactHistoryArgs.prototype.setIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string name = 2;
- * @return {string}
- */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
+proto.api_container_api.GetFilesArtifactHistoryResponse.repeatedFields_ = [2];
 
 
 
@@ -9808,8 +14305,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetFilesArtifactHistoryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetFilesArtifactHistoryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9818,13 +14315,15 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetFilesArtifactHistoryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetFilesArtifactHistoryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    fileName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    versionsList: jspb.Message.toObjectList(msg.getVersionsList(),
+    proto.api_container_api.FilesArtifactVersion.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9838,23 +14337,23 @@ proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.GetFilesArtifactHistoryResponse}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GetFilesArtifactHistoryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
-  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetFilesArtifactHistoryResponse;
+  return proto.api_container_api.GetFilesArtifactHistoryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetFilesArtifactHistoryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.GetFilesArtifactHistoryResponse}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetFilesArtifactHistoryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9863,7 +14362,12 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setFileName(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.FilesArtifactVersion;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactVersion.deserializeBinaryFromReader);
+      msg.addVersions(value);
       break;
     default:
       reader.skipField();
@@ -9878,9 +14382,9 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GetFilesArtifactHistoryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetFilesArtifactHistoryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9888,37 +14392,83 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
+ * @param {!proto.api_container_api.GetFilesArtifactHistoryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetFilesArtifactHistoryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getFileName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getVersionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.api_container_api.FilesArtifactVersion.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string uuid = 1;
+ * optional string file_name = 1;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
+proto.api_container_api.GetFilesArtifactHistoryResponse.prototype.getFileName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
+ * @return {!proto.api_container_api.GetFilesArtifactHistoryResponse} returns this
+ */
+proto.api_container_api.GetFilesArtifactHistoryResponse.prototype.setFileName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated FilesArtifactVersion versions = 2;
+ * @return {!Array<!proto.api_container_api.FilesArtifactVersion>}
+ */
+proto.api_container_api.GetFilesArtifactHistoryResponse.prototype.getVersionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FilesArtifactVersion>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactVersion, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.FilesArtifactVersion>} value
+ * @return {!proto.api_container_api.GetFilesArtifactHistoryResponse} returns this
+*/
+proto.api_container_api.GetFilesArtifactHistoryResponse.prototype.setVersionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.FilesArtifactVersion=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FilesArtifactVersion}
+ */
+proto.api_container_api.GetFilesArtifactHistoryResponse.prototype.addVersions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.FilesArtifactVersion, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetFilesArtifactHistoryResponse} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetFilesArtifactHistoryResponse.prototype.clearVersionsList = function() {
+  return this.setVersionsList([]);
 };
 
 
@@ -9938,8 +14488,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.FilesArtifactVersion.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactVersion.toObject(opt_includeInstance, this);
 };
 
 
@@ -9948,15 +14498,16 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FilesArtifactVersion} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.FilesArtifactVersion.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 3, "")
+    version: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    fileUuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    isLatest: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -9970,23 +14521,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.FilesArtifactVersion}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.FilesArtifactVersion.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FilesArtifactVersion;
+  return proto.api_container_api.FilesArtifactVersion.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FilesArtifactVersion} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.FilesArtifactVersion}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FilesArtifactVersion.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9994,16 +14545,21 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setVersion(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSourcePath(value);
+      msg.setFileUuid(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsLatest(value);
       break;
     default:
       reader.skipField();
@@ -10018,9 +14574,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.FilesArtifactVersion.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FilesArtifactVersion.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10028,30 +14584,38 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
+ * @param {!proto.api_container_api.FilesArtifactVersion} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FilesArtifactVersion.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getSourcePath();
+  f = message.getFileUuid();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
       3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getIsLatest();
+  if (f) {
+    writer.writeBool(
+      4,
       f
     );
   }
@@ -10059,56 +14623,93 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWrite
 
 
 /**
- * optional string service_identifier = 1;
- * @return {string}
+ * optional uint32 version = 1;
+ * @return {number}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.FilesArtifactVersion.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.FilesArtifactVersion.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string source_path = 2;
+ * optional string file_uuid = 2;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
+proto.api_container_api.FilesArtifactVersion.prototype.getFileUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
+proto.api_container_api.FilesArtifactVersion.prototype.setFileUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string name = 3;
- * @return {string}
+ * optional google.protobuf.Timestamp created_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.FilesArtifactVersion.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
+*/
+proto.api_container_api.FilesArtifactVersion.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.FilesArtifactVersion.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional bool is_latest = 4;
+ * @return {boolean}
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.getIsLatest = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.FilesArtifactVersion} returns this
+ */
+proto.api_container_api.FilesArtifactVersion.prototype.setIsLatest = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
@@ -10128,8 +14729,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -10138,13 +14739,13 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ConnectServicesArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    connect: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -10158,23 +14759,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.ConnectServicesArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.ConnectServicesArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ConnectServicesArgs;
+  return proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ConnectServicesArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.ConnectServicesArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10182,8 +14783,8 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = /** @type {!proto.api_container_api.Connect} */ (reader.readEnum());
+      msg.setConnect(value);
       break;
     default:
       reader.skipField();
@@ -10198,9 +14799,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10208,15 +14809,15 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!proto.api_container_api.ConnectServicesArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getConnect();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
@@ -10225,20 +14826,20 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToW
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
+ * optional Connect connect = 1;
+ * @return {!proto.api_container_api.Connect}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.ConnectServicesArgs.prototype.getConnect = function() {
+  return /** @type {!proto.api_container_api.Connect} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
+ * @param {!proto.api_container_api.Connect} value
+ * @return {!proto.api_container_api.ConnectServicesArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ConnectServicesArgs.prototype.setConnect = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
@@ -10258,8 +14859,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
+proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10268,14 +14869,13 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ConnectServicesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
+proto.api_container_api.ConnectServicesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+
   };
 
   if (includeInstance) {
@@ -10289,116 +14889,58 @@ proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.ConnectServicesResponse}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
+proto.api_container_api.ConnectServicesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
-  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ConnectServicesResponse;
+  return proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ConnectServicesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
- */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFileuuid(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getFilename();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getFileuuid();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string fileName = 1;
- * @return {string}
- */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
- */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @return {!proto.api_container_api.ConnectServicesResponse}
+ */
+proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string fileUuid = 2;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ConnectServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -10408,7 +14950,7 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = functio
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
+proto.api_container_api.GetStarlarkRunResponse.repeatedFields_ = [7];
 
 
 
@@ -10425,8 +14967,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10435,14 +14977,21 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
-    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+    restartPolicy: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    initialSerializedParams: jspb.Message.getFieldWithDefault(msg, 9, "")
   };
 
   if (includeInstance) {
@@ -10456,23 +15005,23 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.GetStarlarkRunResponse}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GetStarlarkRunResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetStarlarkRunResponse;
+  return proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.GetStarlarkRunResponse}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10480,9 +15029,42 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.addFileNamesAndUuids(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedScript(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelativePathToMainFile(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
+      break;
+    case 7:
+      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExperimentalFeatures(values[i]);
+      }
+      break;
+    case 8:
+      var value = /** @type {!proto.api_container_api.RestartPolicy} */ (reader.readEnum());
+      msg.setRestartPolicy(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInitialSerializedParams(value);
       break;
     default:
       reader.skipField();
@@ -10497,9 +15079,9 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10507,369 +15089,274 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileNamesAndUuidsList();
+  f = message.getPackageId();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getSerializedScript();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getParallelism();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getRelativePathToMainFile();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getMainFunctionName();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      7,
+      f
+    );
+  }
+  f = message.getRestartPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeString(
+      9,
+      f
     );
   }
 };
 
 
 /**
- * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
- * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
- */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
-};
-
-
-/**
- * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
-*/
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
- */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ * optional string package_id = 1;
+ * @return {string}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
-  return this.setFileNamesAndUuidsList([]);
+proto.api_container_api.GetStarlarkRunResponse.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.InspectFilesArtifactContentsRequest.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunResponse.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string serialized_script = 2;
+ * @return {string}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    fileNamesAndUuid: (f = msg.getFileNamesAndUuid()) && proto.api_container_api.FilesArtifactNameAndUuid.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.InspectFilesArtifactContentsRequest;
-  return proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ * optional string serialized_params = 3;
+ * @return {string}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.setFileNamesAndUuid(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional int32 parallelism = 4;
+ * @return {number}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getFileNamesAndUuid();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
-    );
-  }
+proto.api_container_api.GetStarlarkRunResponse.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * optional FilesArtifactNameAndUuid file_names_and_uuid = 1;
- * @return {?proto.api_container_api.FilesArtifactNameAndUuid}
+ * @param {number} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getFileNamesAndUuid = function() {
-  return /** @type{?proto.api_container_api.FilesArtifactNameAndUuid} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+proto.api_container_api.GetStarlarkRunResponse.prototype.setParallelism = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * @param {?proto.api_container_api.FilesArtifactNameAndUuid|undefined} value
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
-*/
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setFileNamesAndUuid = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * optional string relative_path_to_main_file = 5;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileNamesAndUuid = function() {
-  return this.setFileNamesAndUuid(undefined);
+proto.api_container_api.GetStarlarkRunResponse.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string main_function_name = 6;
+ * @return {string}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNamesAndUuid = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.GetStarlarkRunResponse.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
-
+proto.api_container_api.GetStarlarkRunResponse.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * repeated KurtosisFeatureFlag experimental_features = 7;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunResponse.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 7));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
-    proto.api_container_api.FileArtifactContentsFileDescription.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.GetStarlarkRunResponse.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
-  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.GetStarlarkRunResponse.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.api_container_api.FileArtifactContentsFileDescription;
-      reader.readMessage(value,proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader);
-      msg.addFileDescriptions(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.GetStarlarkRunResponse.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional RestartPolicy restart_policy = 8;
+ * @return {!proto.api_container_api.RestartPolicy}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.GetStarlarkRunResponse.prototype.getRestartPolicy = function() {
+  return /** @type {!proto.api_container_api.RestartPolicy} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.api_container_api.RestartPolicy} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getFileDescriptionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter
-    );
-  }
+proto.api_container_api.GetStarlarkRunResponse.prototype.setRestartPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
 };
 
 
 /**
- * repeated FileArtifactContentsFileDescription file_descriptions = 1;
- * @return {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>}
+ * optional string initial_serialized_params = 9;
+ * @return {string}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FileArtifactContentsFileDescription, 1));
+proto.api_container_api.GetStarlarkRunResponse.prototype.getInitialSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} value
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
-*/
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setInitialSerializedParams = function(value) {
+  return jspb.Message.setField(this, 9, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FileArtifactContentsFileDescription, opt_index);
+proto.api_container_api.GetStarlarkRunResponse.prototype.clearInitialSerializedParams = function() {
+  return jspb.Message.setField(this, 9, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
- */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
-  return this.setFileDescriptionsList([]);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.hasInitialSerializedParams = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
@@ -10889,8 +15376,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FileArtifactContentsFileDescription.toObject(opt_includeInstance, this);
+proto.api_container_api.PlanYaml.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PlanYaml.toObject(opt_includeInstance, this);
 };
 
 
@@ -10899,15 +15386,13 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PlanYaml} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
+proto.api_container_api.PlanYaml.toObject = function(includeInstance, msg) {
   var f, obj = {
-    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    textPreview: jspb.Message.getFieldWithDefault(msg, 3, "")
+    planYaml: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -10921,23 +15406,23 @@ proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.PlanYaml}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinary = function(bytes) {
+proto.api_container_api.PlanYaml.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FileArtifactContentsFileDescription;
-  return proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PlanYaml;
+  return proto.api_container_api.PlanYaml.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PlanYaml} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.PlanYaml}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PlanYaml.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10946,15 +15431,7 @@ proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFro
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setSize(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTextPreview(value);
+      msg.setPlanYaml(value);
       break;
     default:
       reader.skipField();
@@ -10969,9 +15446,9 @@ proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeBinary = function() {
+proto.api_container_api.PlanYaml.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PlanYaml.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10979,108 +15456,40 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} message
+ * @param {!proto.api_container_api.PlanYaml} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PlanYaml.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPath();
+  f = message.getPlanYaml();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSize();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string path = 1;
+ * optional string plan_yaml = 1;
  * @return {string}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getPath = function() {
+proto.api_container_api.PlanYaml.prototype.getPlanYaml = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ * @return {!proto.api_container_api.PlanYaml} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setPath = function(value) {
+proto.api_container_api.PlanYaml.prototype.setPlanYaml = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional uint64 size = 2;
- * @return {number}
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string text_preview = 3;
- * @return {string}
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getTextPreview = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setTextPreview = function(value) {
-  return jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextPreview = function() {
-  return jspb.Message.setField(this, 3, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.hasTextPreview = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
 
 
 
@@ -11097,8 +15506,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ConnectServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -11107,13 +15516,15 @@ proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ConnectServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    connect: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -11127,23 +15538,23 @@ proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ConnectServicesArgs}
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs}
  */
-proto.api_container_api.ConnectServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ConnectServicesArgs;
-  return proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkScriptPlanYamlArgs;
+  return proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ConnectServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ConnectServicesArgs}
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs}
  */
-proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11151,8 +15562,16 @@ proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.api_container_api.Connect} */ (reader.readEnum());
-      msg.setConnect(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedScript(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
       break;
     default:
       reader.skipField();
@@ -11167,9 +15586,9 @@ proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11177,149 +15596,127 @@ proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ConnectServicesArgs} message
+ * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getConnect();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getSerializedScript();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional Connect connect = 1;
- * @return {!proto.api_container_api.Connect}
+ * optional string serialized_script = 1;
+ * @return {string}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.getConnect = function() {
-  return /** @type {!proto.api_container_api.Connect} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.api_container_api.Connect} value
- * @return {!proto.api_container_api.ConnectServicesArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
  */
-proto.api_container_api.ConnectServicesArgs.prototype.setConnect = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string serialized_params = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
  */
-proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ConnectServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ConnectServicesResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
  */
-proto.api_container_api.ConnectServicesResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearSerializedParams = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.hasSerializedParams = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ConnectServicesResponse}
+ * optional string main_function_name = 5;
+ * @return {string}
  */
-proto.api_container_api.ConnectServicesResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ConnectServicesResponse;
-  return proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.ConnectServicesResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ConnectServicesResponse}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
  */
-proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
  */
-proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearMainFunctionName = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ConnectServicesResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.hasMainFunctionName = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.GetStarlarkRunResponse.repeatedFields_ = [7];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -11335,8 +15732,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetStarlarkRunResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -11345,21 +15742,17 @@ proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
     packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
-    restartPolicy: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    initialSerializedParams: jspb.Message.getFieldWithDefault(msg, 9, "")
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isRemote: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -11373,23 +15766,23 @@ proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetStarlarkRunResponse}
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs}
  */
-proto.api_container_api.GetStarlarkRunResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetStarlarkRunResponse;
-  return proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkPackagePlanYamlArgs;
+  return proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetStarlarkRunResponse}
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs}
  */
-proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11402,38 +15795,20 @@ proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = fun
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
+      msg.setSerializedParams(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRemote(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
-      break;
-    case 5:
       var value = /** @type {string} */ (reader.readString());
       msg.setRelativePathToMainFile(value);
       break;
-    case 6:
+    case 5:
       var value = /** @type {string} */ (reader.readString());
       msg.setMainFunctionName(value);
       break;
-    case 7:
-      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addExperimentalFeatures(values[i]);
-      }
-      break;
-    case 8:
-      var value = /** @type {!proto.api_container_api.RestartPolicy} */ (reader.readEnum());
-      msg.setRestartPolicy(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setInitialSerializedParams(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -11447,9 +15822,9 @@ proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11457,11 +15832,11 @@ proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetStarlarkRunResponse} message
+ * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getPackageId();
   if (f.length > 0) {
@@ -11470,59 +15845,31 @@ proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = functio
       f
     );
   }
-  f = message.getSerializedScript();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getSerializedParams();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getIsRemote();
+  if (f) {
+    writer.writeBool(
       3,
       f
     );
   }
-  f = message.getParallelism();
-  if (f !== 0) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-  f = message.getRelativePathToMainFile();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getMainFunctionName();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
     writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getExperimentalFeaturesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      7,
-      f
-    );
-  }
-  f = message.getRestartPolicy();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      8,
+      4,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 9));
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
   if (f != null) {
     writer.writeString(
-      9,
+      5,
       f
     );
   }
@@ -11533,189 +15880,134 @@ proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = functio
  * optional string package_id = 1;
  * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getPackageId = function() {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getPackageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setPackageId = function(value) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setPackageId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_script = 2;
+ * optional string serialized_params = 2;
  * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedScript = function() {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getSerializedParams = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
- */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedScript = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional string serialized_params = 3;
- * @return {string}
- */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
- */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional int32 parallelism = 4;
- * @return {number}
- */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
- */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setParallelism = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-/**
- * optional string relative_path_to_main_file = 5;
- * @return {string}
- */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getRelativePathToMainFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setRelativePathToMainFile = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional string main_function_name = 6;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearSerializedParams = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasSerializedParams = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * repeated KurtosisFeatureFlag experimental_features = 7;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ * optional bool is_remote = 3;
+ * @return {boolean}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 7));
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getIsRemote = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 7, value || []);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setIsRemote = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * optional string relative_path_to_main_file = 4;
+ * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional RestartPolicy restart_policy = 8;
- * @return {!proto.api_container_api.RestartPolicy}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getRestartPolicy = function() {
-  return /** @type {!proto.api_container_api.RestartPolicy} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearRelativePathToMainFile = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
 /**
- * @param {!proto.api_container_api.RestartPolicy} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setRestartPolicy = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasRelativePathToMainFile = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional string initial_serialized_params = 9;
+ * optional string main_function_name = 5;
  * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getInitialSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setInitialSerializedParams = function(value) {
-  return jspb.Message.setField(this, 9, value);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.clearInitialSerializedParams = function() {
-  return jspb.Message.setField(this, 9, undefined);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearMainFunctionName = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
@@ -11723,12 +16015,19 @@ proto.api_container_api.GetStarlarkRunResponse.prototype.clearInitialSerializedP
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.hasInitialSerializedParams = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasMainFunctionName = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.WatchServiceEventsArgs.repeatedFields_ = [1,2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -11744,8 +16043,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.PlanYaml.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PlanYaml.toObject(opt_includeInstance, this);
+proto.api_container_api.WatchServiceEventsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WatchServiceEventsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -11754,13 +16053,14 @@ proto.api_container_api.PlanYaml.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PlanYaml} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WatchServiceEventsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PlanYaml.toObject = function(includeInstance, msg) {
+proto.api_container_api.WatchServiceEventsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    planYaml: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceNamesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    eventTypesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -11774,23 +16074,23 @@ proto.api_container_api.PlanYaml.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PlanYaml}
+ * @return {!proto.api_container_api.WatchServiceEventsArgs}
  */
-proto.api_container_api.PlanYaml.deserializeBinary = function(bytes) {
+proto.api_container_api.WatchServiceEventsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PlanYaml;
-  return proto.api_container_api.PlanYaml.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WatchServiceEventsArgs;
+  return proto.api_container_api.WatchServiceEventsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.PlanYaml} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WatchServiceEventsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PlanYaml}
+ * @return {!proto.api_container_api.WatchServiceEventsArgs}
  */
-proto.api_container_api.PlanYaml.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WatchServiceEventsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11799,7 +16099,13 @@ proto.api_container_api.PlanYaml.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPlanYaml(value);
+      msg.addServiceNames(value);
+      break;
+    case 2:
+      var values = /** @type {!Array<!proto.api_container_api.ServiceEventType>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addEventTypes(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -11814,9 +16120,9 @@ proto.api_container_api.PlanYaml.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.PlanYaml.prototype.serializeBinary = function() {
+proto.api_container_api.WatchServiceEventsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PlanYaml.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WatchServiceEventsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11824,37 +16130,100 @@ proto.api_container_api.PlanYaml.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PlanYaml} message
+ * @param {!proto.api_container_api.WatchServiceEventsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PlanYaml.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WatchServiceEventsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPlanYaml();
+  f = message.getServiceNamesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
+  f = message.getEventTypesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string plan_yaml = 1;
- * @return {string}
+ * repeated string service_names = 1;
+ * @return {!Array<string>}
  */
-proto.api_container_api.PlanYaml.prototype.getPlanYaml = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.WatchServiceEventsArgs.prototype.getServiceNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.WatchServiceEventsArgs} returns this
+ */
+proto.api_container_api.WatchServiceEventsArgs.prototype.setServiceNamesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.PlanYaml} returns this
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.WatchServiceEventsArgs} returns this
  */
-proto.api_container_api.PlanYaml.prototype.setPlanYaml = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.WatchServiceEventsArgs.prototype.addServiceNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.WatchServiceEventsArgs} returns this
+ */
+proto.api_container_api.WatchServiceEventsArgs.prototype.clearServiceNamesList = function() {
+  return this.setServiceNamesList([]);
+};
+
+
+/**
+ * repeated ServiceEventType event_types = 2;
+ * @return {!Array<!proto.api_container_api.ServiceEventType>}
+ */
+proto.api_container_api.WatchServiceEventsArgs.prototype.getEventTypesList = function() {
+  return /** @type {!Array<!proto.api_container_api.ServiceEventType>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.ServiceEventType>} value
+ * @return {!proto.api_container_api.WatchServiceEventsArgs} returns this
+ */
+proto.api_container_api.WatchServiceEventsArgs.prototype.setEventTypesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceEventType} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.WatchServiceEventsArgs} returns this
+ */
+proto.api_container_api.WatchServiceEventsArgs.prototype.addEventTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.WatchServiceEventsArgs} returns this
+ */
+proto.api_container_api.WatchServiceEventsArgs.prototype.clearEventTypesList = function() {
+  return this.setEventTypesList([]);
 };
 
 
@@ -11874,8 +16243,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -11884,15 +16253,17 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, "")
+    serviceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    eventType: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    message: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -11906,23 +16277,23 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs}
+ * @return {!proto.api_container_api.ServiceEvent}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkScriptPlanYamlArgs;
-  return proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceEvent;
+  return proto.api_container_api.ServiceEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs}
+ * @return {!proto.api_container_api.ServiceEvent}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11931,15 +16302,24 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
+      msg.setServiceName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
+      msg.setServiceUuid(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.api_container_api.ServiceEventType} */ (reader.readEnum());
+      msg.setEventType(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
       break;
     case 5:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
+      msg.setMessage(value);
       break;
     default:
       reader.skipField();
@@ -11954,9 +16334,9 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11964,26 +16344,41 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} message
+ * @param {!proto.api_container_api.ServiceEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServiceEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedScript();
+  f = message.getServiceName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getServiceUuid();
+  if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getEventType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
   f = /** @type {string} */ (jspb.Message.getField(message, 5));
   if (f != null) {
     writer.writeString(
@@ -11995,47 +16390,84 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter = fun
 
 
 /**
- * optional string serialized_script = 1;
+ * optional string service_name = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getSerializedScript = function() {
+proto.api_container_api.ServiceEvent.prototype.getServiceName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ * @return {!proto.api_container_api.ServiceEvent} returns this
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setSerializedScript = function(value) {
+proto.api_container_api.ServiceEvent.prototype.setServiceName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_params = 2;
+ * optional string service_uuid = 2;
  * @return {string}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getSerializedParams = function() {
+proto.api_container_api.ServiceEvent.prototype.getServiceUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ * @return {!proto.api_container_api.ServiceEvent} returns this
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.ServiceEvent.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ * optional ServiceEventType event_type = 3;
+ * @return {!proto.api_container_api.ServiceEventType}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearSerializedParams = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.ServiceEvent.prototype.getEventType = function() {
+  return /** @type {!proto.api_container_api.ServiceEventType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceEventType} value
+ * @return {!proto.api_container_api.ServiceEvent} returns this
+ */
+proto.api_container_api.ServiceEvent.prototype.setEventType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.ServiceEvent.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.ServiceEvent} returns this
+*/
+proto.api_container_api.ServiceEvent.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceEvent} returns this
+ */
+proto.api_container_api.ServiceEvent.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
 };
 
 
@@ -12043,34 +16475,34 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearSerializedPara
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.hasSerializedParams = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.ServiceEvent.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional string main_function_name = 5;
+ * optional string message = 5;
  * @return {string}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getMainFunctionName = function() {
+proto.api_container_api.ServiceEvent.prototype.getMessage = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ * @return {!proto.api_container_api.ServiceEvent} returns this
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setMainFunctionName = function(value) {
+proto.api_container_api.ServiceEvent.prototype.setMessage = function(value) {
   return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ * @return {!proto.api_container_api.ServiceEvent} returns this
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearMainFunctionName = function() {
+proto.api_container_api.ServiceEvent.prototype.clearMessage = function() {
   return jspb.Message.setField(this, 5, undefined);
 };
 
@@ -12079,12 +16511,19 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearMainFunctionNa
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.hasMainFunctionName = function() {
+proto.api_container_api.ServiceEvent.prototype.hasMessage = function() {
   return jspb.Message.getField(this, 5) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.BulkServiceOperationArgs.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -12100,8 +16539,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.BulkServiceOperationArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.BulkServiceOperationArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -12110,17 +16549,13 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.BulkServiceOperationArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    isRemote: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, "")
+    serviceIdentifiersList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -12134,23 +16569,23 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs}
+ * @return {!proto.api_container_api.BulkServiceOperationArgs}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.BulkServiceOperationArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkPackagePlanYamlArgs;
-  return proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.BulkServiceOperationArgs;
+  return proto.api_container_api.BulkServiceOperationArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs}
+ * @return {!proto.api_container_api.BulkServiceOperationArgs}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.BulkServiceOperationArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -12159,23 +16594,7 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRemote(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRelativePathToMainFile(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
+      msg.addServiceIdentifiers(value);
       break;
     default:
       reader.skipField();
@@ -12190,9 +16609,9 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.serializeBinary = function() {
+proto.api_container_api.BulkServiceOperationArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.BulkServiceOperationArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -12200,192 +16619,253 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} message
+ * @param {!proto.api_container_api.BulkServiceOperationArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.BulkServiceOperationArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
+  f = message.getServiceIdentifiersList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getIsRemote();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string package_id = 1;
- * @return {string}
+ * repeated string service_identifiers = 1;
+ * @return {!Array<string>}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getPackageId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.BulkServiceOperationArgs.prototype.getServiceIdentifiersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.BulkServiceOperationArgs} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setPackageId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.BulkServiceOperationArgs.prototype.setServiceIdentifiersList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * optional string serialized_params = 2;
- * @return {string}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.BulkServiceOperationArgs} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.BulkServiceOperationArgs.prototype.addServiceIdentifiers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.BulkServiceOperationArgs} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.BulkServiceOperationArgs.prototype.clearServiceIdentifiersList = function() {
+  return this.setServiceIdentifiersList([]);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearSerializedParams = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.BulkServiceOperationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.BulkServiceOperationResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.BulkServiceOperationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasSerializedParams = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.BulkServiceOperationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    isApplied: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    failedServicesMap: (f = msg.getFailedServicesMap()) ? f.toObject(includeInstance, undefined) : [],
+    failedRollbacksMap: (f = msg.getFailedRollbacksMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional bool is_remote = 3;
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.BulkServiceOperationResponse}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getIsRemote = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.BulkServiceOperationResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.BulkServiceOperationResponse;
+  return proto.api_container_api.BulkServiceOperationResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.BulkServiceOperationResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.BulkServiceOperationResponse}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setIsRemote = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.api_container_api.BulkServiceOperationResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsApplied(value);
+      break;
+    case 2:
+      var value = msg.getFailedServicesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 3:
+      var value = msg.getFailedRollbacksMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string relative_path_to_main_file = 4;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getRelativePathToMainFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.BulkServiceOperationResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.BulkServiceOperationResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.BulkServiceOperationResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setRelativePathToMainFile = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.api_container_api.BulkServiceOperationResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIsApplied();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getFailedServicesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getFailedRollbacksMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * optional bool is_applied = 1;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearRelativePathToMainFile = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.BulkServiceOperationResponse.prototype.getIsApplied = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.BulkServiceOperationResponse} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasRelativePathToMainFile = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.BulkServiceOperationResponse.prototype.setIsApplied = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * optional string main_function_name = 5;
- * @return {string}
+ * map<string, string> failed_services = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.BulkServiceOperationResponse.prototype.getFailedServicesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.BulkServiceOperationResponse} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setField(this, 5, value);
-};
+proto.api_container_api.BulkServiceOperationResponse.prototype.clearFailedServicesMap = function() {
+  this.getFailedServicesMap().clear();
+  return this;};
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * map<string, string> failed_rollbacks = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearMainFunctionName = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.BulkServiceOperationResponse.prototype.getFailedRollbacksMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.BulkServiceOperationResponse} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasMainFunctionName = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
+proto.api_container_api.BulkServiceOperationResponse.prototype.clearFailedRollbacksMap = function() {
+  this.getFailedRollbacksMap().clear();
+  return this;};
 
 
 /**
@@ -12420,6 +16900,26 @@ proto.api_container_api.KurtosisFeatureFlag = {
   NO_INSTRUCTIONS_CACHING: 0
 };
 
+/**
+ * @enum {number}
+ */
+proto.api_container_api.BackendErrorKind = {
+  NO_BACKEND_ERROR: 0,
+  IMAGE_PULL_BACK_OFF: 1,
+  INSUFFICIENT_RESOURCES: 2,
+  RBAC_FORBIDDEN: 3,
+  QUOTA_EXCEEDED: 4,
+  VOLUME_PROVISIONING_FAILED: 5
+};
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.ServiceDependencyKind = {
+  EXPLICIT: 0,
+  RUNTIME_VALUE: 1
+};
+
 /**
  * @enum {number}
  */
@@ -12428,4 +16928,14 @@ proto.api_container_api.RestartPolicy = {
   ALWAYS: 1
 };
 
+/**
+ * @enum {number}
+ */
+proto.api_container_api.ServiceEventType = {
+  SERVICE_STARTED: 0,
+  SERVICE_READY: 1,
+  SERVICE_STOPPED: 2,
+  SERVICE_CRASHED: 3
+};
+
 goog.object.extend(exports, proto.api_container_api);
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { BulkServiceOperationArgs, BulkServiceOperationResponse, ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetFilesArtifactHistoryArgs, GetFilesArtifactHistoryResponse, GetServiceDependencyGraphResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, ServiceEvent, SetEnclaveEnvVarsArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs, WatchServiceEventsArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -67,6 +67,29 @@ export declare const ApiContainerService: {
       readonly O: typeof GetExistingAndHistoricalServiceIdentifiersResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetServiceDependencyGraph
+     */
+    readonly getServiceDependencyGraph: {
+      readonly name: "GetServiceDependencyGraph",
+      readonly I: typeof Empty,
+      readonly O: typeof GetServiceDependencyGraphResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+     * a service config take precedence over the enclave ones
+     *
+     * @generated from rpc api_container_api.ApiContainerService.SetEnclaveEnvVars
+     */
+    readonly setEnclaveEnvVars: {
+      readonly name: "SetEnclaveEnvVars",
+      readonly I: typeof SetEnclaveEnvVarsArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Executes the given command inside a running container
      *
@@ -162,6 +185,17 @@ export declare const ApiContainerService: {
       readonly O: typeof InspectFilesArtifactContentsResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetFilesArtifactHistory
+     */
+    readonly getFilesArtifactHistory: {
+      readonly name: "GetFilesArtifactHistory",
+      readonly I: typeof GetFilesArtifactHistoryArgs,
+      readonly O: typeof GetFilesArtifactHistoryResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * User services port forwarding
      *
@@ -206,6 +240,50 @@ export declare const ApiContainerService: {
       readonly O: typeof PlanYaml,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+     *
+     * @generated from rpc api_container_api.ApiContainerService.WatchServiceEvents
+     */
+    readonly watchServiceEvents: {
+      readonly name: "WatchServiceEvents",
+      readonly I: typeof WatchServiceEventsArgs,
+      readonly O: typeof ServiceEvent,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Starts either all the services or none of them: the ones that started are stopped back if any fails to
+     *
+     * @generated from rpc api_container_api.ApiContainerService.StartServices
+     */
+    readonly startServices: {
+      readonly name: "StartServices",
+      readonly I: typeof BulkServiceOperationArgs,
+      readonly O: typeof BulkServiceOperationResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Stops either all the services or none of them: the ones that stopped are started back if any fails to
+     *
+     * @generated from rpc api_container_api.ApiContainerService.StopServices
+     */
+    readonly stopServices: {
+      readonly name: "StopServices",
+      readonly I: typeof BulkServiceOperationArgs,
+      readonly O: typeof BulkServiceOperationResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RemoveServices
+     */
+    readonly removeServices: {
+      readonly name: "RemoveServices",
+      readonly I: typeof BulkServiceOperationArgs,
+      readonly O: typeof BulkServiceOperationResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
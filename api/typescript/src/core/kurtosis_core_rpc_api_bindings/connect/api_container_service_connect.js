@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { BulkServiceOperationArgs, BulkServiceOperationResponse, ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetFilesArtifactHistoryArgs, GetFilesArtifactHistoryResponse, GetServiceDependencyGraphResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, ServiceEvent, SetEnclaveEnvVarsArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs, WatchServiceEventsArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -67,6 +67,29 @@ export const ApiContainerService = {
       O: GetExistingAndHistoricalServiceIdentifiersResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Returns the dependencies between the services of the enclave, as declared or implied by the plan that added them
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetServiceDependencyGraph
+     */
+    getServiceDependencyGraph: {
+      name: "GetServiceDependencyGraph",
+      I: Empty,
+      O: GetServiceDependencyGraphResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Sets environment variables that get injected into every service added to the enclave from then on. Env vars set on
+     * a service config take precedence over the enclave ones
+     *
+     * @generated from rpc api_container_api.ApiContainerService.SetEnclaveEnvVars
+     */
+    setEnclaveEnvVars: {
+      name: "SetEnclaveEnvVars",
+      I: SetEnclaveEnvVarsArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
     /**
      * Executes the given command inside a running container
      *
@@ -162,6 +185,17 @@ export const ApiContainerService = {
       O: InspectFilesArtifactContentsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Lists the versions of a files artifact, accessible with '<identifier>@<version>'
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetFilesArtifactHistory
+     */
+    getFilesArtifactHistory: {
+      name: "GetFilesArtifactHistory",
+      I: GetFilesArtifactHistoryArgs,
+      O: GetFilesArtifactHistoryResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * User services port forwarding
      *
@@ -206,6 +240,50 @@ export const ApiContainerService = {
       O: PlanYaml,
       kind: MethodKind.Unary,
     },
+    /**
+     * Streams the lifecycle events of the services, from the moment of the call until it gets cancelled
+     *
+     * @generated from rpc api_container_api.ApiContainerService.WatchServiceEvents
+     */
+    watchServiceEvents: {
+      name: "WatchServiceEvents",
+      I: WatchServiceEventsArgs,
+      O: ServiceEvent,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Starts either all the services or none of them: the ones that started are stopped back if any fails to
+     *
+     * @generated from rpc api_container_api.ApiContainerService.StartServices
+     */
+    startServices: {
+      name: "StartServices",
+      I: BulkServiceOperationArgs,
+      O: BulkServiceOperationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Stops either all the services or none of them: the ones that stopped are started back if any fails to
+     *
+     * @generated from rpc api_container_api.ApiContainerService.StopServices
+     */
+    stopServices: {
+      name: "StopServices",
+      I: BulkServiceOperationArgs,
+      O: BulkServiceOperationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Removes either all the services or none of them: they are all stopped first, and the ones that stopped are started back if any fails to
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RemoveServices
+     */
+    removeServices: {
+      name: "RemoveServices",
+      I: BulkServiceOperationArgs,
+      O: BulkServiceOperationResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
 /**
@@ -75,6 +75,72 @@ export declare enum KurtosisFeatureFlag {
   NO_INSTRUCTIONS_CACHING = 0,
 }
 
+/**
+ * Known problems of the container engine that executions can fail because of
+ *
+ * @generated from enum api_container_api.BackendErrorKind
+ */
+export declare enum BackendErrorKind {
+  /**
+   * @generated from enum value: NO_BACKEND_ERROR = 0;
+   */
+  NO_BACKEND_ERROR = 0,
+
+  /**
+   * An image can't be pulled, e.g. because it doesn't exist or the registry refuses access to it
+   *
+   * @generated from enum value: IMAGE_PULL_BACK_OFF = 1;
+   */
+  IMAGE_PULL_BACK_OFF = 1,
+
+  /**
+   * The cluster doesn't have enough CPU or memory left to run a service
+   *
+   * @generated from enum value: INSUFFICIENT_RESOURCES = 2;
+   */
+  INSUFFICIENT_RESOURCES = 2,
+
+  /**
+   * The credentials Kurtosis uses aren't allowed to perform an operation on the cluster
+   *
+   * @generated from enum value: RBAC_FORBIDDEN = 3;
+   */
+  RBAC_FORBIDDEN = 3,
+
+  /**
+   * An operation would go over the quota of the enclave or of its namespace
+   *
+   * @generated from enum value: QUOTA_EXCEEDED = 4;
+   */
+  QUOTA_EXCEEDED = 4,
+
+  /**
+   * A volume of a service can't be provisioned
+   *
+   * @generated from enum value: VOLUME_PROVISIONING_FAILED = 5;
+   */
+  VOLUME_PROVISIONING_FAILED = 5,
+}
+
+/**
+ * @generated from enum api_container_api.ServiceDependencyKind
+ */
+export declare enum ServiceDependencyKind {
+  /**
+   * The dependency was listed in the 'depends_on' attribute of the service config
+   *
+   * @generated from enum value: EXPLICIT = 0;
+   */
+  EXPLICIT = 0,
+
+  /**
+   * The service config references a runtime value (IP address, hostname...) of the dependency
+   *
+   * @generated from enum value: RUNTIME_VALUE = 1;
+   */
+  RUNTIME_VALUE = 1,
+}
+
 /**
  * @generated from enum api_container_api.RestartPolicy
  */
@@ -90,6 +156,43 @@ export declare enum RestartPolicy {
   ALWAYS = 1,
 }
 
+/**
+ * ==============================================================================================
+ *                                     Service Events
+ * ==============================================================================================
+ *
+ * @generated from enum api_container_api.ServiceEventType
+ */
+export declare enum ServiceEventType {
+  /**
+   * The container of the service started, including after an update or a restart
+   *
+   * @generated from enum value: SERVICE_STARTED = 0;
+   */
+  SERVICE_STARTED = 0,
+
+  /**
+   * The service passed its ready conditions, or started if it has none
+   *
+   * @generated from enum value: SERVICE_READY = 1;
+   */
+  SERVICE_READY = 1,
+
+  /**
+   * The service was stopped or removed
+   *
+   * @generated from enum value: SERVICE_STOPPED = 2;
+   */
+  SERVICE_STOPPED = 2,
+
+  /**
+   * The container of the service exited with an error or ran out of memory
+   *
+   * @generated from enum value: SERVICE_CRASHED = 3;
+   */
+  SERVICE_CRASHED = 3,
+}
+
 /**
  * ==============================================================================================
  *                           Shared Objects (Used By Multiple Endpoints)
@@ -469,6 +572,22 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
    */
   tiniEnabled?: boolean;
 
+  /**
+   * Result of the checks of the service's ready conditions, which keep running after the service started
+   * Not set for services without ready conditions
+   *
+   * @generated from field: optional api_container_api.ServiceHealth health = 20;
+   */
+  health?: ServiceHealth;
+
+  /**
+   * How the service last crashed, i.e. exited with an error or was OOM-killed, since it started
+   * Not set for services that didn't crash
+   *
+   * @generated from field: optional api_container_api.ServiceCrashReport last_crash = 21;
+   */
+  lastCrash?: ServiceCrashReport;
+
   constructor(data?: PartialMessage<ServiceInfo>);
 
   static readonly runtime: typeof proto3;
@@ -484,6 +603,100 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
   static equals(a: ServiceInfo | PlainMessage<ServiceInfo> | undefined, b: ServiceInfo | PlainMessage<ServiceInfo> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.ServiceHealth
+ */
+export declare class ServiceHealth extends Message<ServiceHealth> {
+  /**
+   * False once the ready conditions failed several consecutive checks, until they pass again
+   *
+   * @generated from field: bool is_healthy = 1;
+   */
+  isHealthy: boolean;
+
+  /**
+   * @generated from field: uint32 consecutive_failures = 2;
+   */
+  consecutiveFailures: number;
+
+  /**
+   * Error of the last failed check; empty if the last check passed
+   *
+   * @generated from field: string last_error = 3;
+   */
+  lastError: string;
+
+  /**
+   * Number of times the service got restarted because it was unhealthy, as per its restart policy
+   *
+   * @generated from field: uint32 restart_count = 4;
+   */
+  restartCount: number;
+
+  constructor(data?: PartialMessage<ServiceHealth>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceHealth";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceHealth;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceHealth;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceHealth;
+
+  static equals(a: ServiceHealth | PlainMessage<ServiceHealth> | undefined, b: ServiceHealth | PlainMessage<ServiceHealth> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServiceCrashReport
+ */
+export declare class ServiceCrashReport extends Message<ServiceCrashReport> {
+  /**
+   * @generated from field: int32 exit_code = 1;
+   */
+  exitCode: number;
+
+  /**
+   * @generated from field: bool is_oom_killed = 2;
+   */
+  isOomKilled: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp finished_at = 3;
+   */
+  finishedAt?: Timestamp;
+
+  /**
+   * Why the container runtime says the service exited, e.g. 'OOMKilled'; may be empty
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  /**
+   * Files artifact holding the exit state, last log lines, backend state and crash dump of the service
+   * Empty if they couldn't be captured
+   *
+   * @generated from field: string diagnostics_files_artifact_name = 5;
+   */
+  diagnosticsFilesArtifactName: string;
+
+  constructor(data?: PartialMessage<ServiceCrashReport>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceCrashReport";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceCrashReport;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceCrashReport;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceCrashReport;
+
+  static equals(a: ServiceCrashReport | PlainMessage<ServiceCrashReport> | undefined, b: ServiceCrashReport | PlainMessage<ServiceCrashReport> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.RunStarlarkScriptArgs
  */
@@ -757,6 +970,18 @@ export declare class StarlarkRunResponseLine extends Message<StarlarkRunResponse
      */
     value: StarlarkInfo;
     case: "info";
+  } | {
+    /**
+     * @generated from field: api_container_api.StarlarkContainerImageTiming container_image_timing = 8;
+     */
+    value: StarlarkContainerImageTiming;
+    case: "containerImageTiming";
+  } | {
+    /**
+     * @generated from field: api_container_api.StarlarkRunPlan plan = 9;
+     */
+    value: StarlarkRunPlan;
+    case: "plan";
   } | { case: undefined; value?: undefined };
 
   constructor(data?: PartialMessage<StarlarkRunResponseLine>);
@@ -880,6 +1105,13 @@ export declare class StarlarkInstructionResult extends Message<StarlarkInstructi
    */
   serializedInstructionResult: string;
 
+  /**
+   * Wall time spent executing the instruction. Not set for instructions skipped because they already ran in the enclave
+   *
+   * @generated from field: optional uint64 execution_duration_milliseconds = 2;
+   */
+  executionDurationMilliseconds?: bigint;
+
   constructor(data?: PartialMessage<StarlarkInstructionResult>);
 
   static readonly runtime: typeof proto3;
@@ -895,6 +1127,104 @@ export declare class StarlarkInstructionResult extends Message<StarlarkInstructi
   static equals(a: StarlarkInstructionResult | PlainMessage<StarlarkInstructionResult> | undefined, b: StarlarkInstructionResult | PlainMessage<StarlarkInstructionResult> | undefined): boolean;
 }
 
+/**
+ * Wall time spent pulling or building a container image before the run starts executing instructions
+ *
+ * @generated from message api_container_api.StarlarkContainerImageTiming
+ */
+export declare class StarlarkContainerImageTiming extends Message<StarlarkContainerImageTiming> {
+  /**
+   * @generated from field: string image_name = 1;
+   */
+  imageName: string;
+
+  /**
+   * The services of the run using this image
+   *
+   * @generated from field: repeated string service_names = 2;
+   */
+  serviceNames: string[];
+
+  /**
+   * @generated from field: uint64 duration_milliseconds = 3;
+   */
+  durationMilliseconds: bigint;
+
+  constructor(data?: PartialMessage<StarlarkContainerImageTiming>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkContainerImageTiming";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkContainerImageTiming;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkContainerImageTiming;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkContainerImageTiming;
+
+  static equals(a: StarlarkContainerImageTiming | PlainMessage<StarlarkContainerImageTiming> | undefined, b: StarlarkContainerImageTiming | PlainMessage<StarlarkContainerImageTiming> | undefined): boolean;
+}
+
+/**
+ * The full plan of a dry run, sent once all its instructions were streamed
+ *
+ * @generated from message api_container_api.StarlarkRunPlan
+ */
+export declare class StarlarkRunPlan extends Message<StarlarkRunPlan> {
+  /**
+   * @generated from field: repeated api_container_api.StarlarkPlannedInstruction instructions = 1;
+   */
+  instructions: StarlarkPlannedInstruction[];
+
+  constructor(data?: PartialMessage<StarlarkRunPlan>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkRunPlan";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkRunPlan;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkRunPlan;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkRunPlan;
+
+  static equals(a: StarlarkRunPlan | PlainMessage<StarlarkRunPlan> | undefined, b: StarlarkRunPlan | PlainMessage<StarlarkRunPlan> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StarlarkPlannedInstruction
+ */
+export declare class StarlarkPlannedInstruction extends Message<StarlarkPlannedInstruction> {
+  /**
+   * The type, position and arguments of the instruction, as streamed during the run
+   *
+   * @generated from field: api_container_api.StarlarkInstruction instruction = 1;
+   */
+  instruction?: StarlarkInstruction;
+
+  /**
+   * The value the instruction returns to the script, serialized as Starlark. The runtime values it produces, like the
+   * IP address of a service, are placeholders that only get resolved when the instruction is executed
+   *
+   * @generated from field: string serialized_returned_value = 2;
+   */
+  serializedReturnedValue: string;
+
+  constructor(data?: PartialMessage<StarlarkPlannedInstruction>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkPlannedInstruction";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkPlannedInstruction;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkPlannedInstruction;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkPlannedInstruction;
+
+  static equals(a: StarlarkPlannedInstruction | PlainMessage<StarlarkPlannedInstruction> | undefined, b: StarlarkPlannedInstruction | PlainMessage<StarlarkPlannedInstruction> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.StarlarkInstructionArg
  */
@@ -1062,6 +1392,20 @@ export declare class StarlarkExecutionError extends Message<StarlarkExecutionErr
    */
   errorMessage: string;
 
+  /**
+   * Set when the execution failed because of a known problem of the container engine, e.g. an image that can't be pulled
+   *
+   * @generated from field: api_container_api.BackendErrorKind backend_error_kind = 2;
+   */
+  backendErrorKind: BackendErrorKind;
+
+  /**
+   * What the user can do to fix the problem; empty if backend_error_kind isn't set
+   *
+   * @generated from field: string remediation_hint = 3;
+   */
+  remediationHint: string;
+
   constructor(data?: PartialMessage<StarlarkExecutionError>);
 
   static readonly runtime: typeof proto3;
@@ -1156,6 +1500,34 @@ export declare class GetServicesArgs extends Message<GetServicesArgs> {
    */
   serviceIdentifiers: { [key: string]: boolean };
 
+  /**
+   * Only the services having all these labels
+   *
+   * @generated from field: map<string, string> labels = 2;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * Only the services in one of these statuses; services in any status if empty
+   *
+   * @generated from field: repeated api_container_api.ServiceStatus statuses = 3;
+   */
+  statuses: ServiceStatus[];
+
+  /**
+   * The maximum number of services to return, ordered by name; all of them if not set or 0
+   *
+   * @generated from field: optional uint32 page_size = 4;
+   */
+  pageSize?: number;
+
+  /**
+   * The next_page_token of the previous response, to get the page after it; the first page if not set
+   *
+   * @generated from field: optional string page_token = 5;
+   */
+  pageToken?: string;
+
   constructor(data?: PartialMessage<GetServicesArgs>);
 
   static readonly runtime: typeof proto3;
@@ -1182,6 +1554,13 @@ export declare class GetServicesResponse extends Message<GetServicesResponse> {
    */
   serviceInfo: { [key: string]: ServiceInfo };
 
+  /**
+   * To pass as page_token to get the next page; not set if this is the last page
+   *
+   * @generated from field: optional string next_page_token = 2;
+   */
+  nextPageToken?: string;
+
   constructor(data?: PartialMessage<GetServicesResponse>);
 
   static readonly runtime: typeof proto3;
@@ -1263,6 +1642,138 @@ export declare class GetExistingAndHistoricalServiceIdentifiersResponse extends
   static equals(a: GetExistingAndHistoricalServiceIdentifiersResponse | PlainMessage<GetExistingAndHistoricalServiceIdentifiersResponse> | undefined, b: GetExistingAndHistoricalServiceIdentifiersResponse | PlainMessage<GetExistingAndHistoricalServiceIdentifiersResponse> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                     Get Service Dependency Graph
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ServiceDependencyNode
+ */
+export declare class ServiceDependencyNode extends Message<ServiceDependencyNode> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName: string;
+
+  /**
+   * Whether the service had ready conditions, in which case services depending on it were only started once it was ready
+   *
+   * @generated from field: bool has_ready_conditions = 2;
+   */
+  hasReadyConditions: boolean;
+
+  constructor(data?: PartialMessage<ServiceDependencyNode>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceDependencyNode";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceDependencyNode;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceDependencyNode;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceDependencyNode;
+
+  static equals(a: ServiceDependencyNode | PlainMessage<ServiceDependencyNode> | undefined, b: ServiceDependencyNode | PlainMessage<ServiceDependencyNode> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServiceDependencyEdge
+ */
+export declare class ServiceDependencyEdge extends Message<ServiceDependencyEdge> {
+  /**
+   * Name of the service that depends on the other one
+   *
+   * @generated from field: string service_name = 1;
+   */
+  serviceName: string;
+
+  /**
+   * Name of the service it depends on
+   *
+   * @generated from field: string dependency_service_name = 2;
+   */
+  dependencyServiceName: string;
+
+  /**
+   * @generated from field: api_container_api.ServiceDependencyKind kind = 3;
+   */
+  kind: ServiceDependencyKind;
+
+  constructor(data?: PartialMessage<ServiceDependencyEdge>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceDependencyEdge";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceDependencyEdge;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceDependencyEdge;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceDependencyEdge;
+
+  static equals(a: ServiceDependencyEdge | PlainMessage<ServiceDependencyEdge> | undefined, b: ServiceDependencyEdge | PlainMessage<ServiceDependencyEdge> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetServiceDependencyGraphResponse
+ */
+export declare class GetServiceDependencyGraphResponse extends Message<GetServiceDependencyGraphResponse> {
+  /**
+   * @generated from field: repeated api_container_api.ServiceDependencyNode nodes = 1;
+   */
+  nodes: ServiceDependencyNode[];
+
+  /**
+   * @generated from field: repeated api_container_api.ServiceDependencyEdge edges = 2;
+   */
+  edges: ServiceDependencyEdge[];
+
+  constructor(data?: PartialMessage<GetServiceDependencyGraphResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetServiceDependencyGraphResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServiceDependencyGraphResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServiceDependencyGraphResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServiceDependencyGraphResponse;
+
+  static equals(a: GetServiceDependencyGraphResponse | PlainMessage<GetServiceDependencyGraphResponse> | undefined, b: GetServiceDependencyGraphResponse | PlainMessage<GetServiceDependencyGraphResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                     Set Enclave Env Vars
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.SetEnclaveEnvVarsArgs
+ */
+export declare class SetEnclaveEnvVarsArgs extends Message<SetEnclaveEnvVarsArgs> {
+  /**
+   * Env vars to upsert, env vars already set in the enclave and absent from this map are left untouched
+   *
+   * @generated from field: map<string, string> env_vars = 1;
+   */
+  envVars: { [key: string]: string };
+
+  constructor(data?: PartialMessage<SetEnclaveEnvVarsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.SetEnclaveEnvVarsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetEnclaveEnvVarsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetEnclaveEnvVarsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetEnclaveEnvVarsArgs;
+
+  static equals(a: SetEnclaveEnvVarsArgs | PlainMessage<SetEnclaveEnvVarsArgs> | undefined, b: SetEnclaveEnvVarsArgs | PlainMessage<SetEnclaveEnvVarsArgs> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                          Exec Command
@@ -1639,6 +2150,33 @@ export declare class StoreWebFilesArtifactArgs extends Message<StoreWebFilesArti
    */
   name: string;
 
+  /**
+   * Headers sent along with the request (e.g. a token for the host serving the file)
+   *
+   * @generated from field: repeated api_container_api.HttpHeader headers = 3;
+   */
+  headers: HttpHeader[];
+
+  /**
+   * Credentials for HTTP basic authentication, if the URL requires it
+   *
+   * @generated from field: optional string basic_auth_username = 4;
+   */
+  basicAuthUsername?: string;
+
+  /**
+   * @generated from field: optional string basic_auth_password = 5;
+   */
+  basicAuthPassword?: string;
+
+  /**
+   * Hex-encoded SHA-256 that the downloaded content must have. When set, content already downloaded with this checksum
+   * is reused instead of downloading it again
+   *
+   * @generated from field: optional string content_sha256 = 6;
+   */
+  contentSha256?: string;
+
   constructor(data?: PartialMessage<StoreWebFilesArtifactArgs>);
 
   static readonly runtime: typeof proto3;
@@ -1654,6 +2192,35 @@ export declare class StoreWebFilesArtifactArgs extends Message<StoreWebFilesArti
   static equals(a: StoreWebFilesArtifactArgs | PlainMessage<StoreWebFilesArtifactArgs> | undefined, b: StoreWebFilesArtifactArgs | PlainMessage<StoreWebFilesArtifactArgs> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.HttpHeader
+ */
+export declare class HttpHeader extends Message<HttpHeader> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+
+  constructor(data?: PartialMessage<HttpHeader>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.HttpHeader";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HttpHeader;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HttpHeader;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HttpHeader;
+
+  static equals(a: HttpHeader | PlainMessage<HttpHeader> | undefined, b: HttpHeader | PlainMessage<HttpHeader> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.StoreWebFilesArtifactResponse
  */
@@ -1836,6 +2403,13 @@ export declare class InspectFilesArtifactContentsResponse extends Message<Inspec
    */
   fileDescriptions: FileArtifactContentsFileDescription[];
 
+  /**
+   * Hex-encoded SHA-256 of the files artifact content; empty for artifacts stored before checksums were recorded
+   *
+   * @generated from field: string content_sha256 = 2;
+   */
+  contentSha256: string;
+
   constructor(data?: PartialMessage<InspectFilesArtifactContentsResponse>);
 
   static readonly runtime: typeof proto3;
@@ -1891,6 +2465,106 @@ export declare class FileArtifactContentsFileDescription extends Message<FileArt
   static equals(a: FileArtifactContentsFileDescription | PlainMessage<FileArtifactContentsFileDescription> | undefined, b: FileArtifactContentsFileDescription | PlainMessage<FileArtifactContentsFileDescription> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.GetFilesArtifactHistoryArgs
+ */
+export declare class GetFilesArtifactHistoryArgs extends Message<GetFilesArtifactHistoryArgs> {
+  /**
+   * UUID, shortened UUID or name of the files artifact, or of any of its versions
+   *
+   * @generated from field: string identifier = 1;
+   */
+  identifier: string;
+
+  constructor(data?: PartialMessage<GetFilesArtifactHistoryArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetFilesArtifactHistoryArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFilesArtifactHistoryArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFilesArtifactHistoryArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFilesArtifactHistoryArgs;
+
+  static equals(a: GetFilesArtifactHistoryArgs | PlainMessage<GetFilesArtifactHistoryArgs> | undefined, b: GetFilesArtifactHistoryArgs | PlainMessage<GetFilesArtifactHistoryArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetFilesArtifactHistoryResponse
+ */
+export declare class GetFilesArtifactHistoryResponse extends Message<GetFilesArtifactHistoryResponse> {
+  /**
+   * @generated from field: string file_name = 1;
+   */
+  fileName: string;
+
+  /**
+   * Versions of the files artifact, oldest first
+   *
+   * @generated from field: repeated api_container_api.FilesArtifactVersion versions = 2;
+   */
+  versions: FilesArtifactVersion[];
+
+  constructor(data?: PartialMessage<GetFilesArtifactHistoryResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetFilesArtifactHistoryResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFilesArtifactHistoryResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFilesArtifactHistoryResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFilesArtifactHistoryResponse;
+
+  static equals(a: GetFilesArtifactHistoryResponse | PlainMessage<GetFilesArtifactHistoryResponse> | undefined, b: GetFilesArtifactHistoryResponse | PlainMessage<GetFilesArtifactHistoryResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.FilesArtifactVersion
+ */
+export declare class FilesArtifactVersion extends Message<FilesArtifactVersion> {
+  /**
+   * @generated from field: uint32 version = 1;
+   */
+  version: number;
+
+  /**
+   * UUID under which the content of this version is stored; the latest version shares the UUID of the files artifact
+   *
+   * @generated from field: string file_uuid = 2;
+   */
+  fileUuid: string;
+
+  /**
+   * Unset for versions stored before versions were tracked
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: bool is_latest = 4;
+   */
+  isLatest: boolean;
+
+  constructor(data?: PartialMessage<FilesArtifactVersion>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.FilesArtifactVersion";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FilesArtifactVersion;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FilesArtifactVersion;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FilesArtifactVersion;
+
+  static equals(a: FilesArtifactVersion | PlainMessage<FilesArtifactVersion> | undefined, b: FilesArtifactVersion | PlainMessage<FilesArtifactVersion> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.ConnectServicesArgs
  */
@@ -2113,3 +2787,152 @@ export declare class StarlarkPackagePlanYamlArgs extends Message<StarlarkPackage
   static equals(a: StarlarkPackagePlanYamlArgs | PlainMessage<StarlarkPackagePlanYamlArgs> | undefined, b: StarlarkPackagePlanYamlArgs | PlainMessage<StarlarkPackagePlanYamlArgs> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.WatchServiceEventsArgs
+ */
+export declare class WatchServiceEventsArgs extends Message<WatchServiceEventsArgs> {
+  /**
+   * Only the events of these services; the events of all services if empty
+   *
+   * @generated from field: repeated string service_names = 1;
+   */
+  serviceNames: string[];
+
+  /**
+   * Only the events of these types; the events of all types if empty
+   *
+   * @generated from field: repeated api_container_api.ServiceEventType event_types = 2;
+   */
+  eventTypes: ServiceEventType[];
+
+  constructor(data?: PartialMessage<WatchServiceEventsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.WatchServiceEventsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchServiceEventsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchServiceEventsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchServiceEventsArgs;
+
+  static equals(a: WatchServiceEventsArgs | PlainMessage<WatchServiceEventsArgs> | undefined, b: WatchServiceEventsArgs | PlainMessage<WatchServiceEventsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServiceEvent
+ */
+export declare class ServiceEvent extends Message<ServiceEvent> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName: string;
+
+  /**
+   * @generated from field: string service_uuid = 2;
+   */
+  serviceUuid: string;
+
+  /**
+   * @generated from field: api_container_api.ServiceEventType event_type = 3;
+   */
+  eventType: ServiceEventType;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 4;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * Details about the event, e.g. the exit code of a crashed service
+   *
+   * @generated from field: optional string message = 5;
+   */
+  message?: string;
+
+  constructor(data?: PartialMessage<ServiceEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceEvent;
+
+  static equals(a: ServiceEvent | PlainMessage<ServiceEvent> | undefined, b: ServiceEvent | PlainMessage<ServiceEvent> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                  Bulk Service Operations
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.BulkServiceOperationArgs
+ */
+export declare class BulkServiceOperationArgs extends Message<BulkServiceOperationArgs> {
+  /**
+   * The UUIDs, shortened UUIDs or names of the services
+   *
+   * @generated from field: repeated string service_identifiers = 1;
+   */
+  serviceIdentifiers: string[];
+
+  constructor(data?: PartialMessage<BulkServiceOperationArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.BulkServiceOperationArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkServiceOperationArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkServiceOperationArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkServiceOperationArgs;
+
+  static equals(a: BulkServiceOperationArgs | PlainMessage<BulkServiceOperationArgs> | undefined, b: BulkServiceOperationArgs | PlainMessage<BulkServiceOperationArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.BulkServiceOperationResponse
+ */
+export declare class BulkServiceOperationResponse extends Message<BulkServiceOperationResponse> {
+  /**
+   * Whether all the services went through the operation; if not, none of them did, except the ones in failed_rollbacks
+   *
+   * @generated from field: bool is_applied = 1;
+   */
+  isApplied: boolean;
+
+  /**
+   * The errors of the services the operation failed for, by the identifier the service was asked with
+   *
+   * @generated from field: map<string, string> failed_services = 2;
+   */
+  failedServices: { [key: string]: string };
+
+  /**
+   * The errors of the services the operation went through for but couldn't be undone for, by the identifier the service was asked with
+   *
+   * @generated from field: map<string, string> failed_rollbacks = 3;
+   */
+  failedRollbacks: { [key: string]: string };
+
+  constructor(data?: PartialMessage<BulkServiceOperationResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.BulkServiceOperationResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkServiceOperationResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkServiceOperationResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkServiceOperationResponse;
+
+  static equals(a: BulkServiceOperationResponse | PlainMessage<BulkServiceOperationResponse> | undefined, b: BulkServiceOperationResponse | PlainMessage<BulkServiceOperationResponse> | undefined): boolean;
+}
+
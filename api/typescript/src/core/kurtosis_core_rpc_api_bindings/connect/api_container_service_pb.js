@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { proto3 } from "@bufbuild/protobuf";
+import { proto3, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum api_container_api.ServiceStatus
@@ -51,6 +51,34 @@ export const KurtosisFeatureFlag = proto3.makeEnum(
   ],
 );
 
+/**
+ * Known problems of the container engine that executions can fail because of
+ *
+ * @generated from enum api_container_api.BackendErrorKind
+ */
+export const BackendErrorKind = proto3.makeEnum(
+  "api_container_api.BackendErrorKind",
+  [
+    {no: 0, name: "NO_BACKEND_ERROR"},
+    {no: 1, name: "IMAGE_PULL_BACK_OFF"},
+    {no: 2, name: "INSUFFICIENT_RESOURCES"},
+    {no: 3, name: "RBAC_FORBIDDEN"},
+    {no: 4, name: "QUOTA_EXCEEDED"},
+    {no: 5, name: "VOLUME_PROVISIONING_FAILED"},
+  ],
+);
+
+/**
+ * @generated from enum api_container_api.ServiceDependencyKind
+ */
+export const ServiceDependencyKind = proto3.makeEnum(
+  "api_container_api.ServiceDependencyKind",
+  [
+    {no: 0, name: "EXPLICIT"},
+    {no: 1, name: "RUNTIME_VALUE"},
+  ],
+);
+
 /**
  * @generated from enum api_container_api.RestartPolicy
  */
@@ -62,6 +90,23 @@ export const RestartPolicy = proto3.makeEnum(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                     Service Events
+ * ==============================================================================================
+ *
+ * @generated from enum api_container_api.ServiceEventType
+ */
+export const ServiceEventType = proto3.makeEnum(
+  "api_container_api.ServiceEventType",
+  [
+    {no: 0, name: "SERVICE_STARTED"},
+    {no: 1, name: "SERVICE_READY"},
+    {no: 2, name: "SERVICE_STOPPED"},
+    {no: 3, name: "SERVICE_CRASHED"},
+  ],
+);
+
 /**
  * ==============================================================================================
  *                           Shared Objects (Used By Multiple Endpoints)
@@ -183,6 +228,35 @@ export const ServiceInfo = proto3.makeMessageType(
     { no: 17, name: "node_selectors", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 18, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 19, name: "tini_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 20, name: "health", kind: "message", T: ServiceHealth, opt: true },
+    { no: 21, name: "last_crash", kind: "message", T: ServiceCrashReport, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceHealth
+ */
+export const ServiceHealth = proto3.makeMessageType(
+  "api_container_api.ServiceHealth",
+  () => [
+    { no: 1, name: "is_healthy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "consecutive_failures", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "restart_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceCrashReport
+ */
+export const ServiceCrashReport = proto3.makeMessageType(
+  "api_container_api.ServiceCrashReport",
+  () => [
+    { no: 1, name: "exit_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "is_oom_killed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "finished_at", kind: "message", T: Timestamp },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "diagnostics_files_artifact_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -246,6 +320,8 @@ export const StarlarkRunResponseLine = proto3.makeMessageType(
     { no: 5, name: "run_finished_event", kind: "message", T: StarlarkRunFinishedEvent, oneof: "run_response_line" },
     { no: 6, name: "warning", kind: "message", T: StarlarkWarning, oneof: "run_response_line" },
     { no: 7, name: "info", kind: "message", T: StarlarkInfo, oneof: "run_response_line" },
+    { no: 8, name: "container_image_timing", kind: "message", T: StarlarkContainerImageTiming, oneof: "run_response_line" },
+    { no: 9, name: "plan", kind: "message", T: StarlarkRunPlan, oneof: "run_response_line" },
   ],
 );
 
@@ -291,6 +367,44 @@ export const StarlarkInstructionResult = proto3.makeMessageType(
   "api_container_api.StarlarkInstructionResult",
   () => [
     { no: 1, name: "serialized_instruction_result", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "execution_duration_milliseconds", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+  ],
+);
+
+/**
+ * Wall time spent pulling or building a container image before the run starts executing instructions
+ *
+ * @generated from message api_container_api.StarlarkContainerImageTiming
+ */
+export const StarlarkContainerImageTiming = proto3.makeMessageType(
+  "api_container_api.StarlarkContainerImageTiming",
+  () => [
+    { no: 1, name: "image_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "duration_milliseconds", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * The full plan of a dry run, sent once all its instructions were streamed
+ *
+ * @generated from message api_container_api.StarlarkRunPlan
+ */
+export const StarlarkRunPlan = proto3.makeMessageType(
+  "api_container_api.StarlarkRunPlan",
+  () => [
+    { no: 1, name: "instructions", kind: "message", T: StarlarkPlannedInstruction, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StarlarkPlannedInstruction
+ */
+export const StarlarkPlannedInstruction = proto3.makeMessageType(
+  "api_container_api.StarlarkPlannedInstruction",
+  () => [
+    { no: 1, name: "instruction", kind: "message", T: StarlarkInstruction },
+    { no: 2, name: "serialized_returned_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -357,6 +471,8 @@ export const StarlarkExecutionError = proto3.makeMessageType(
   "api_container_api.StarlarkExecutionError",
   () => [
     { no: 1, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "backend_error_kind", kind: "enum", T: proto3.getEnumType(BackendErrorKind) },
+    { no: 3, name: "remediation_hint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -394,6 +510,10 @@ export const GetServicesArgs = proto3.makeMessageType(
   "api_container_api.GetServicesArgs",
   () => [
     { no: 1, name: "service_identifiers", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 8 /* ScalarType.BOOL */} },
+    { no: 2, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 3, name: "statuses", kind: "enum", T: proto3.getEnumType(ServiceStatus), repeated: true },
+    { no: 4, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 5, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ],
 );
 
@@ -404,6 +524,7 @@ export const GetServicesResponse = proto3.makeMessageType(
   "api_container_api.GetServicesResponse",
   () => [
     { no: 1, name: "service_info", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ServiceInfo} },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ],
 );
 
@@ -431,6 +552,58 @@ export const GetExistingAndHistoricalServiceIdentifiersResponse = proto3.makeMes
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                     Get Service Dependency Graph
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ServiceDependencyNode
+ */
+export const ServiceDependencyNode = proto3.makeMessageType(
+  "api_container_api.ServiceDependencyNode",
+  () => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "has_ready_conditions", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceDependencyEdge
+ */
+export const ServiceDependencyEdge = proto3.makeMessageType(
+  "api_container_api.ServiceDependencyEdge",
+  () => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "dependency_service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "kind", kind: "enum", T: proto3.getEnumType(ServiceDependencyKind) },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetServiceDependencyGraphResponse
+ */
+export const GetServiceDependencyGraphResponse = proto3.makeMessageType(
+  "api_container_api.GetServiceDependencyGraphResponse",
+  () => [
+    { no: 1, name: "nodes", kind: "message", T: ServiceDependencyNode, repeated: true },
+    { no: 2, name: "edges", kind: "message", T: ServiceDependencyEdge, repeated: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                     Set Enclave Env Vars
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.SetEnclaveEnvVarsArgs
+ */
+export const SetEnclaveEnvVarsArgs = proto3.makeMessageType(
+  "api_container_api.SetEnclaveEnvVarsArgs",
+  () => [
+    { no: 1, name: "env_vars", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                          Exec Command
@@ -565,6 +738,21 @@ export const StoreWebFilesArtifactArgs = proto3.makeMessageType(
   () => [
     { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "headers", kind: "message", T: HttpHeader, repeated: true },
+    { no: 4, name: "basic_auth_username", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "basic_auth_password", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "content_sha256", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.HttpHeader
+ */
+export const HttpHeader = proto3.makeMessageType(
+  "api_container_api.HttpHeader",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -638,6 +826,7 @@ export const InspectFilesArtifactContentsResponse = proto3.makeMessageType(
   "api_container_api.InspectFilesArtifactContentsResponse",
   () => [
     { no: 1, name: "file_descriptions", kind: "message", T: FileArtifactContentsFileDescription, repeated: true },
+    { no: 2, name: "content_sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -653,6 +842,40 @@ export const FileArtifactContentsFileDescription = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message api_container_api.GetFilesArtifactHistoryArgs
+ */
+export const GetFilesArtifactHistoryArgs = proto3.makeMessageType(
+  "api_container_api.GetFilesArtifactHistoryArgs",
+  () => [
+    { no: 1, name: "identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetFilesArtifactHistoryResponse
+ */
+export const GetFilesArtifactHistoryResponse = proto3.makeMessageType(
+  "api_container_api.GetFilesArtifactHistoryResponse",
+  () => [
+    { no: 1, name: "file_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "versions", kind: "message", T: FilesArtifactVersion, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.FilesArtifactVersion
+ */
+export const FilesArtifactVersion = proto3.makeMessageType(
+  "api_container_api.FilesArtifactVersion",
+  () => [
+    { no: 1, name: "version", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "file_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "created_at", kind: "message", T: Timestamp },
+    { no: 4, name: "is_latest", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
 /**
  * @generated from message api_container_api.ConnectServicesArgs
  */
@@ -725,3 +948,54 @@ export const StarlarkPackagePlanYamlArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message api_container_api.WatchServiceEventsArgs
+ */
+export const WatchServiceEventsArgs = proto3.makeMessageType(
+  "api_container_api.WatchServiceEventsArgs",
+  () => [
+    { no: 1, name: "service_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "event_types", kind: "enum", T: proto3.getEnumType(ServiceEventType), repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceEvent
+ */
+export const ServiceEvent = proto3.makeMessageType(
+  "api_container_api.ServiceEvent",
+  () => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_type", kind: "enum", T: proto3.getEnumType(ServiceEventType) },
+    { no: 4, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 5, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                  Bulk Service Operations
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.BulkServiceOperationArgs
+ */
+export const BulkServiceOperationArgs = proto3.makeMessageType(
+  "api_container_api.BulkServiceOperationArgs",
+  () => [
+    { no: 1, name: "service_identifiers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.BulkServiceOperationResponse
+ */
+export const BulkServiceOperationResponse = proto3.makeMessageType(
+  "api_container_api.BulkServiceOperationResponse",
+  () => [
+    { no: 1, name: "is_applied", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "failed_services", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 3, name: "failed_rollbacks", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ],
+);
+
@@ -14,6 +14,9 @@ import {
     ServiceInfo,
     GetServicesResponse,
     DownloadFilesArtifactArgs,
+    ServiceEventType,
+    WatchServiceEventsArgs,
+    BulkServiceOperationArgs,
 } from '../kurtosis_core_rpc_api_bindings/api_container_service_pb';
 import { ServiceName } from './services/service';
 
@@ -131,3 +134,22 @@ export function newDownloadFilesArtifactArgs(identifier: string): DownloadFilesA
     result.setIdentifier(identifier);
     return result;
 }
+
+// ==============================================================================================
+//                                     Service Events
+// ==============================================================================================
+export function newWatchServiceEventsArgs(serviceNames: ServiceName[], eventTypes: ServiceEventType[]): WatchServiceEventsArgs {
+    const result: WatchServiceEventsArgs = new WatchServiceEventsArgs();
+    result.setServiceNamesList(serviceNames);
+    result.setEventTypesList(eventTypes);
+    return result;
+}
+
+// ==============================================================================================
+//                                  Bulk Service Operations
+// ==============================================================================================
+export function newBulkServiceOperationArgs(serviceIdentifiers: string[]): BulkServiceOperationArgs {
+    const result: BulkServiceOperationArgs = new BulkServiceOperationArgs();
+    result.setServiceIdentifiersList(serviceIdentifiers);
+    return result;
+}
@@ -15,6 +15,8 @@ import {
     newDownloadFilesArtifactArgs,
     newGetServicesArgs,
     newStoreWebFilesArtifactArgs,
+    newWatchServiceEventsArgs,
+    newBulkServiceOperationArgs,
 } from "../constructor_calls";
 import type { FilesArtifactUUID } from "./files_artifact";
 import type { ServiceName, ServiceUUID } from "../services/service";
@@ -32,6 +34,8 @@ import {
     ConnectServicesResponse,
     Connect,
     GetStarlarkRunResponse,
+    ServiceEventType,
+    BulkServiceOperationResponse,
 } from "../../kurtosis_core_rpc_api_bindings/api_container_service_pb";
 import * as path from "path";
 import * as fs from 'fs';
@@ -406,6 +410,47 @@ export class EnclaveContext {
         return ok(response)
     }
 
+    // Streams ServiceEvent objects for the lifecycle events (started, ready, stopped, crashed) of the services of the enclave,
+    // until the stream gets destroyed; an empty list of service names or event types means all of them
+    public async watchServiceEvents(serviceNames: ServiceName[], eventTypes: ServiceEventType[]): Promise<Result<Readable, Error>> {
+        const args = newWatchServiceEventsArgs(serviceNames, eventTypes)
+        const watchServiceEventsResult = await this.backend.watchServiceEvents(args)
+        if (watchServiceEventsResult.isErr()) {
+            return err(new Error(`Unexpected error happened watching the events of the services \n${watchServiceEventsResult.error}`))
+        }
+        return ok(watchServiceEventsResult.value)
+    }
+
+    // Starts either all the given services or none of them; the response reports the services that failed to start
+    public async startServices(serviceIdentifiers: string[]): Promise<Result<BulkServiceOperationResponse, Error>> {
+        const args = newBulkServiceOperationArgs(serviceIdentifiers)
+        const startServicesResult = await this.backend.startServices(args)
+        if (startServicesResult.isErr()) {
+            return err(new Error(`Unexpected error happened starting the services \n${startServicesResult.error}`))
+        }
+        return ok(startServicesResult.value)
+    }
+
+    // Stops either all the given services or none of them; the response reports the services that failed to stop
+    public async stopServices(serviceIdentifiers: string[]): Promise<Result<BulkServiceOperationResponse, Error>> {
+        const args = newBulkServiceOperationArgs(serviceIdentifiers)
+        const stopServicesResult = await this.backend.stopServices(args)
+        if (stopServicesResult.isErr()) {
+            return err(new Error(`Unexpected error happened stopping the services \n${stopServicesResult.error}`))
+        }
+        return ok(stopServicesResult.value)
+    }
+
+    // Removes either all the given services or none of them; the response reports the services that failed to be removed
+    public async removeServices(serviceIdentifiers: string[]): Promise<Result<BulkServiceOperationResponse, Error>> {
+        const args = newBulkServiceOperationArgs(serviceIdentifiers)
+        const removeServicesResult = await this.backend.removeServices(args)
+        if (removeServicesResult.isErr()) {
+            return err(new Error(`Unexpected error happened removing the services \n${removeServicesResult.error}`))
+        }
+        return ok(removeServicesResult.value)
+    }
+
     // ====================================================================================================
     //                                       Private helper functions
     // ====================================================================================================
@@ -5,6 +5,8 @@
 
 import { Result } from "neverthrow";
 import {
+    BulkServiceOperationArgs,
+    BulkServiceOperationResponse,
     ConnectServicesArgs,
     ConnectServicesResponse,
     DownloadFilesArtifactArgs,
@@ -21,7 +23,8 @@ import {
     StoreWebFilesArtifactResponse,
     UploadFilesArtifactResponse,
     WaitForHttpGetEndpointAvailabilityArgs,
-    WaitForHttpPostEndpointAvailabilityArgs
+    WaitForHttpPostEndpointAvailabilityArgs,
+    WatchServiceEventsArgs
 } from "../../kurtosis_core_rpc_api_bindings/api_container_service_pb";
 import { EnclaveUUID } from "./enclave_context";
 import {Readable} from "stream";
@@ -43,4 +46,8 @@ export interface GenericApiContainerClient {
     getAllFilesArtifactNamesAndUuids(): Promise<Result<ListFilesArtifactNamesAndUuidsResponse, Error>>
     connectServices(connectServicesArgs: ConnectServicesArgs): Promise<Result<ConnectServicesResponse, Error>>
     getStarlarkRun(): Promise<Result<GetStarlarkRunResponse, Error>>
+    watchServiceEvents(watchServiceEventsArgs: WatchServiceEventsArgs): Promise<Result<Readable, Error>>
+    startServices(bulkServiceOperationArgs: BulkServiceOperationArgs): Promise<Result<BulkServiceOperationResponse, Error>>
+    stopServices(bulkServiceOperationArgs: BulkServiceOperationArgs): Promise<Result<BulkServiceOperationResponse, Error>>
+    removeServices(bulkServiceOperationArgs: BulkServiceOperationArgs): Promise<Result<BulkServiceOperationResponse, Error>>
 }
@@ -2,6 +2,8 @@ import {ok, err, Result, Err} from "neverthrow";
 import type {ClientReadableStream, ServiceError} from "@grpc/grpc-js";
 import * as google_protobuf_empty_pb from "google-protobuf/google/protobuf/empty_pb";
 import {
+    BulkServiceOperationArgs,
+    BulkServiceOperationResponse,
     ConnectServicesArgs,
     ConnectServicesResponse,
     DataChunkMetadata,
@@ -13,6 +15,7 @@ import {
     GetServicesResponse,
     GetStarlarkRunResponse,
     ListFilesArtifactNamesAndUuidsResponse,
+    ServiceEvent,
     RunStarlarkPackageArgs,
     RunStarlarkScriptArgs,
     StarlarkRunResponseLine,
@@ -22,6 +25,7 @@ import {
     UploadFilesArtifactResponse,
     WaitForHttpGetEndpointAvailabilityArgs,
     WaitForHttpPostEndpointAvailabilityArgs,
+    WatchServiceEventsArgs,
 } from "../../kurtosis_core_rpc_api_bindings/api_container_service_pb";
 import type { ApiContainerServiceClient as ApiContainerServiceClientNode } from "../../kurtosis_core_rpc_api_bindings/api_container_service_grpc_pb";
 import { GenericApiContainerClient } from "./generic_api_container_client";
@@ -417,4 +421,78 @@ export class GrpcNodeApiContainerClient implements GenericApiContainerClient {
         const getStarlarkRunResponse = getStarlarkRunResponseResult.value;
         return ok(getStarlarkRunResponse)
     }
+
+    public async watchServiceEvents(watchServiceEventsArgs: WatchServiceEventsArgs): Promise<Result<Readable, Error>> {
+        const promiseWatchServiceEvents: Promise<Result<ClientReadableStream<ServiceEvent>, Error>> = new Promise((resolve, _unusedReject) => {
+            resolve(ok(this.client.watchServiceEvents(watchServiceEventsArgs)))
+        })
+        const watchServiceEventsResult: Result<Readable, Error> = await promiseWatchServiceEvents;
+        if (watchServiceEventsResult.isErr()) {
+            return err(watchServiceEventsResult.error)
+        }
+        return ok(watchServiceEventsResult.value)
+    }
+
+    public async startServices(bulkServiceOperationArgs: BulkServiceOperationArgs): Promise<Result<BulkServiceOperationResponse, Error>> {
+        const promiseStartServices: Promise<Result<BulkServiceOperationResponse, Error>> = new Promise((resolve, _unusedReject) => {
+            this.client.startServices(bulkServiceOperationArgs, (error: ServiceError | null, response?: BulkServiceOperationResponse) => {
+                if (error === null) {
+                    if (!response) {
+                        resolve(err(new Error("No error was encountered but the response was still falsy; this should never happen")));
+                    } else {
+                        resolve(ok(response!));
+                    }
+                } else {
+                    resolve(err(error));
+                }
+            })
+        });
+        const startServicesResponseResult: Result<BulkServiceOperationResponse, Error> = await promiseStartServices;
+        if (startServicesResponseResult.isErr()) {
+            return err(startServicesResponseResult.error)
+        }
+        return ok(startServicesResponseResult.value)
+    }
+
+    public async stopServices(bulkServiceOperationArgs: BulkServiceOperationArgs): Promise<Result<BulkServiceOperationResponse, Error>> {
+        const promiseStopServices: Promise<Result<BulkServiceOperationResponse, Error>> = new Promise((resolve, _unusedReject) => {
+            this.client.stopServices(bulkServiceOperationArgs, (error: ServiceError | null, response?: BulkServiceOperationResponse) => {
+                if (error === null) {
+                    if (!response) {
+                        resolve(err(new Error("No error was encountered but the response was still falsy; this should never happen")));
+                    } else {
+                        resolve(ok(response!));
+                    }
+                } else {
+                    resolve(err(error));
+                }
+            })
+        });
+        const stopServicesResponseResult: Result<BulkServiceOperationResponse, Error> = await promiseStopServices;
+        if (stopServicesResponseResult.isErr()) {
+            return err(stopServicesResponseResult.error)
+        }
+        return ok(stopServicesResponseResult.value)
+    }
+
+    public async removeServices(bulkServiceOperationArgs: BulkServiceOperationArgs): Promise<Result<BulkServiceOperationResponse, Error>> {
+        const promiseRemoveServices: Promise<Result<BulkServiceOperationResponse, Error>> = new Promise((resolve, _unusedReject) => {
+            this.client.removeServices(bulkServiceOperationArgs, (error: ServiceError | null, response?: BulkServiceOperationResponse) => {
+                if (error === null) {
+                    if (!response) {
+                        resolve(err(new Error("No error was encountered but the response was still falsy; this should never happen")));
+                    } else {
+                        resolve(ok(response!));
+                    }
+                } else {
+                    resolve(err(error));
+                }
+            })
+        });
+        const removeServicesResponseResult: Result<BulkServiceOperationResponse, Error> = await promiseRemoveServices;
+        if (removeServicesResponseResult.isErr()) {
+            return err(removeServicesResponseResult.error)
+        }
+        return ok(removeServicesResponseResult.value)
+    }
 }
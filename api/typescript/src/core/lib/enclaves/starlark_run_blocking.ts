@@ -2,7 +2,7 @@ import {Readable} from "stream";
 import {
     StarlarkExecutionError,
     StarlarkInstruction,
-    StarlarkInterpretationError, StarlarkRunResponseLine, StarlarkValidationError
+    StarlarkInterpretationError, StarlarkRunPlan, StarlarkRunResponseLine, StarlarkValidationError
 } from "../../kurtosis_core_rpc_api_bindings/api_container_service_pb";
 
 const STARLARK_RUN_OUTPUT_LINE_SPLIT = "\n"
@@ -13,7 +13,9 @@ export class StarlarkRunResult {
         public readonly instructions: Array<StarlarkInstruction>,
         public readonly interpretationError: StarlarkInterpretationError | undefined,
         public readonly validationErrors: Array<StarlarkValidationError>,
-        public readonly executionError: StarlarkExecutionError | undefined
+        public readonly executionError: StarlarkExecutionError | undefined,
+        // Only set for dry runs, which send the full plan once all the instructions were sent
+        public readonly plan: StarlarkRunPlan | undefined,
     ){}
 }
 
@@ -23,6 +25,7 @@ export async function readStreamContentUntilClosed(responseLines: Readable): Pro
     let validationErrors: Array<StarlarkValidationError> = []
     let executionError: StarlarkExecutionError | undefined
     let instructions: Array<StarlarkInstruction> = []
+    let plan: StarlarkRunPlan | undefined
 
     return new Promise((resolve, error) => {
         responseLines.on('data', (responseLine: StarlarkRunResponseLine) => {
@@ -43,6 +46,8 @@ export async function readStreamContentUntilClosed(responseLines: Readable): Pro
                 if (runFinishedEvent.getIsRunSuccessful() && runFinishedEvent.getSerializedOutput() != "")  {
                         scriptOutput += runFinishedEvent.getSerializedOutput() + STARLARK_RUN_OUTPUT_LINE_SPLIT
                 }
+            } else if (responseLine.getPlan() !== undefined) {
+                plan = responseLine.getPlan()
             }
         })
         responseLines.on('error', function () {
@@ -54,7 +59,7 @@ export async function readStreamContentUntilClosed(responseLines: Readable): Pro
         responseLines.on('end', function () {
             if (!responseLines.destroyed) {
                 responseLines.destroy();
-                resolve(new StarlarkRunResult(scriptOutput, instructions, interpretationError, validationErrors, executionError))
+                resolve(new StarlarkRunResult(scriptOutput, instructions, interpretationError, validationErrors, executionError, plan))
             }
         });
     })
@@ -1,9 +1,15 @@
 import { err, ok, Result } from 'neverthrow';
 import { newExecCommandArgs } from '../constructor_calls';
 import type { ExecCommandArgs, ServiceStatus, Container } from '../../kurtosis_core_rpc_api_bindings/api_container_service_pb';
-import type { PortSpec } from './port_spec';
+import { PortSpec, TransportProtocol } from './port_spec';
 import type { ServiceName, ServiceUUID } from './service';
 import { GenericApiContainerClient } from '../enclaves/generic_api_container_client';
+import * as http from "http";
+import * as net from "net";
+
+// The same defaults as the ready conditions of the services added through Starlark
+export const DEFAULT_READINESS_CHECK_INTERVAL_MS = 1000;
+export const DEFAULT_READINESS_CHECK_TIMEOUT_MS = 15 * 60 * 1000;
 
 // Docs available at https://docs.kurtosis.com/sdk/#servicecontext
 export class ServiceContext {
@@ -71,4 +77,151 @@ export class ServiceContext {
         const execCommandResponse = execCommandResponseResult.value
         return ok([execCommandResponse.getExitCode(), execCommandResponse.getLogOutput()]);
     }
+
+    // Polls the public port with the given ID until it accepts TCP connections, so it's only usable on the services
+    // that got a public IP address and ports
+    public async waitForPortOpen(
+        portId: string,
+        intervalMs: number = DEFAULT_READINESS_CHECK_INTERVAL_MS,
+        timeoutMs: number = DEFAULT_READINESS_CHECK_TIMEOUT_MS,
+    ): Promise<Result<null, Error>> {
+        const publicPortResult = this.getPublicTcpPort(portId)
+        if (publicPortResult.isErr()) {
+            return err(publicPortResult.error)
+        }
+        const publicPort = publicPortResult.value
+        const waitResult = await waitUntilReady(
+            () => checkPortOpen(this.publicIpAddress, publicPort.number, intervalMs),
+            intervalMs,
+            timeoutMs,
+        )
+        if (waitResult.isErr()) {
+            return err(new Error(`Port '${portId}' of service '${this.serviceName}' didn't open\n${waitResult.error}`))
+        }
+        return ok(null)
+    }
+
+    // Polls the endpoint of the public port with the given ID, sending it the body if it's not empty, until it responds
+    // with the expected status code; like waitForPortOpen, it's only usable on the services with public ports
+    public async waitForHttp(
+        portId: string,
+        method: string,
+        endpoint: string,
+        body: string,
+        expectedStatusCode: number,
+        intervalMs: number = DEFAULT_READINESS_CHECK_INTERVAL_MS,
+        timeoutMs: number = DEFAULT_READINESS_CHECK_TIMEOUT_MS,
+    ): Promise<Result<null, Error>> {
+        const publicPortResult = this.getPublicTcpPort(portId)
+        if (publicPortResult.isErr()) {
+            return err(publicPortResult.error)
+        }
+        const url = `http://${this.publicIpAddress}:${publicPortResult.value.number}${endpoint}`
+        const waitResult = await waitUntilReady(
+            () => checkHttpStatusCode(url, method, body, expectedStatusCode, intervalMs),
+            intervalMs,
+            timeoutMs,
+        )
+        if (waitResult.isErr()) {
+            return err(new Error(`Endpoint '${endpoint}' of port '${portId}' of service '${this.serviceName}' didn't become available\n${waitResult.error}`))
+        }
+        return ok(null)
+    }
+
+    // Runs the command in the service until it exits with the expected exit code
+    public async waitForExec(
+        command: string[],
+        expectedExitCode: number,
+        intervalMs: number = DEFAULT_READINESS_CHECK_INTERVAL_MS,
+        timeoutMs: number = DEFAULT_READINESS_CHECK_TIMEOUT_MS,
+    ): Promise<Result<null, Error>> {
+        const waitResult = await waitUntilReady(
+            async () => {
+                const execCommandResult = await this.execCommand(command)
+                if (execCommandResult.isErr()) {
+                    return err(execCommandResult.error)
+                }
+                const [exitCode, logOutput] = execCommandResult.value
+                if (exitCode !== expectedExitCode) {
+                    return err(new Error(`Expected command '${command}' to exit with code '${expectedExitCode}' but it exited with '${exitCode}' and output:\n${logOutput}`))
+                }
+                return ok(null)
+            },
+            intervalMs,
+            timeoutMs,
+        )
+        if (waitResult.isErr()) {
+            return err(new Error(`Command '${command}' didn't succeed on service '${this.serviceName}'\n${waitResult.error}`))
+        }
+        return ok(null)
+    }
+
+    private getPublicTcpPort(portId: string): Result<PortSpec, Error> {
+        const publicPort = this.publicPorts.get(portId)
+        if (publicPort === undefined || this.publicIpAddress === "") {
+            return err(new Error(`Service '${this.serviceName}' has no public port with ID '${portId}'`))
+        }
+        if (publicPort.transportProtocol !== TransportProtocol.TCP) {
+            return err(new Error(`Port '${portId}' of service '${this.serviceName}' isn't a TCP port, which is the only kind that can be checked`))
+        }
+        return ok(publicPort)
+    }
+}
+
+// Runs the check every interval until it passes, and returns the error of its last run if it didn't pass before the timeout
+async function waitUntilReady(
+    check: () => Promise<Result<null, Error>>,
+    intervalMs: number,
+    timeoutMs: number,
+): Promise<Result<null, Error>> {
+    const deadline = Date.now() + timeoutMs
+    for (;;) {
+        const checkResult = await check()
+        if (checkResult.isOk()) {
+            return ok(null)
+        }
+        if (Date.now() + intervalMs > deadline) {
+            return err(new Error(`The check still didn't pass after ${timeoutMs}ms\n${checkResult.error}`))
+        }
+        await new Promise((resolve) => setTimeout(resolve, intervalMs))
+    }
+}
+
+function checkPortOpen(host: string, port: number, timeoutMs: number): Promise<Result<null, Error>> {
+    return new Promise((resolve) => {
+        const socket = net.connect({host, port})
+        socket.setTimeout(timeoutMs)
+        socket.once("connect", () => {
+            socket.destroy()
+            resolve(ok(null))
+        })
+        socket.once("timeout", () => {
+            socket.destroy()
+            resolve(err(new Error(`Timed out connecting to '${host}:${port}'`)))
+        })
+        socket.once("error", (error: Error) => {
+            socket.destroy()
+            resolve(err(error))
+        })
+    })
+}
+
+function checkHttpStatusCode(url: string, method: string, body: string, expectedStatusCode: number, timeoutMs: number): Promise<Result<null, Error>> {
+    return new Promise((resolve) => {
+        const request = http.request(url, {method, timeout: timeoutMs}, (response) => {
+            // Draining the body frees the socket for the next attempts
+            response.resume()
+            if (response.statusCode !== expectedStatusCode) {
+                resolve(err(new Error(`Expected the '${method}' request to '${url}' to respond with status code '${expectedStatusCode}' but it responded with '${response.statusCode}'`)))
+                return
+            }
+            resolve(ok(null))
+        })
+        request.on("timeout", () => request.destroy(new Error(`Timed out sending the '${method}' request to '${url}'`)))
+        request.on("error", (error: Error) => resolve(err(error)))
+        if (body !== "") {
+            request.write(body)
+        }
+        request.end()
+    })
 }
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { ApiContainerTlsCredentials, CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetApiContainerTlsCredentialsArgs, GetAuditLogArgs, GetAuditLogResponse, GetEnclavesResponse, GetEngineHealthResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, GetServiceResourceUsageArgs, GetServiceResourceUsageResponse, ListEnclavesArgs, ListEnclavesResponse, NegotiateApiVersionArgs, NegotiateApiVersionResponse, ShareEnclaveArgs, ShareEnclaveResponse, StopEnclaveArgs, UpdateEngineConfigArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -23,6 +23,52 @@ export declare const EngineService: {
       readonly O: typeof GetEngineInfoResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Tells whether the engine API matches the one a client (CLI or SDK) was built for, so that version skew is reported
+     * clearly before other calls fail in confusing ways
+     *
+     * @generated from rpc engine_api.EngineService.NegotiateApiVersion
+     */
+    readonly negotiateApiVersion: {
+      readonly name: "NegotiateApiVersion",
+      readonly I: typeof NegotiateApiVersionArgs,
+      readonly O: typeof NegotiateApiVersionResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Applies a new engine config, e.g. new auth tokens, to every engine replica without restarting them; only admins can do it
+     *
+     * @generated from rpc engine_api.EngineService.UpdateEngineConfig
+     */
+    readonly updateEngineConfig: {
+      readonly name: "UpdateEngineConfig",
+      readonly I: typeof UpdateEngineConfigArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the mutating calls made to the engine APIs, who made them and when; only admins can do it
+     *
+     * @generated from rpc engine_api.EngineService.GetAuditLog
+     */
+    readonly getAuditLog: {
+      readonly name: "GetAuditLog",
+      readonly I: typeof GetAuditLogArgs,
+      readonly O: typeof GetAuditLogResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the health of the subsystems the engine relies on (backend, logs aggregator and collectors, state store and
+     * artifacts store), so that e.g. missing logs are noticed before they are needed
+     *
+     * @generated from rpc engine_api.EngineService.GetEngineHealth
+     */
+    readonly getEngineHealth: {
+      readonly name: "GetEngineHealth",
+      readonly I: typeof Empty,
+      readonly O: typeof GetEngineHealthResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * ==============================================================================================
      *                                   Enclave Management
@@ -48,6 +94,17 @@ export declare const EngineService: {
       readonly O: typeof GetEnclavesResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Returns a page of the existing enclaves, the oldest first, optionally only the ones in some statuses or of an owner
+     *
+     * @generated from rpc engine_api.EngineService.ListEnclaves
+     */
+    readonly listEnclaves: {
+      readonly name: "ListEnclaves",
+      readonly I: typeof ListEnclavesArgs,
+      readonly O: typeof ListEnclavesResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Returns information about all existing & historical enclaves
      *
@@ -81,6 +138,29 @@ export declare const EngineService: {
       readonly O: typeof Empty,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Grants or revokes access to an enclave; only the owner of the enclave and admins can do it
+     *
+     * @generated from rpc engine_api.EngineService.ShareEnclave
+     */
+    readonly shareEnclave: {
+      readonly name: "ShareEnclave",
+      readonly I: typeof ShareEnclaveArgs,
+      readonly O: typeof ShareEnclaveResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Issues the caller a short-lived certificate to call the API container of an enclave requiring mutual TLS with;
+     * only the callers that can change the enclave get one
+     *
+     * @generated from rpc engine_api.EngineService.GetApiContainerTlsCredentials
+     */
+    readonly getApiContainerTlsCredentials: {
+      readonly name: "GetApiContainerTlsCredentials",
+      readonly I: typeof GetApiContainerTlsCredentialsArgs,
+      readonly O: typeof ApiContainerTlsCredentials,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Gets rid of old enclaves
      *
@@ -103,6 +183,17 @@ export declare const EngineService: {
       readonly O: typeof GetServiceLogsResponse,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Returns the CPU, memory and network usage the engine sampled lately for each running service of an enclave
+     *
+     * @generated from rpc engine_api.EngineService.GetServiceResourceUsage
+     */
+    readonly getServiceResourceUsage: {
+      readonly name: "GetServiceResourceUsage",
+      readonly I: typeof GetServiceResourceUsageArgs,
+      readonly O: typeof GetServiceResourceUsageResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
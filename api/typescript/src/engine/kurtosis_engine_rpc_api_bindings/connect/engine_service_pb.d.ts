@@ -6,6 +6,32 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.SubsystemHealthStatus
+ */
+export declare enum SubsystemHealthStatus {
+  /**
+   * @generated from enum value: SubsystemHealthStatus_HEALTHY = 0;
+   */
+  SubsystemHealthStatus_HEALTHY = 0,
+
+  /**
+   * The engine works, but not fully, e.g. the logs of some services aren't collected
+   *
+   * @generated from enum value: SubsystemHealthStatus_DEGRADED = 1;
+   */
+  SubsystemHealthStatus_DEGRADED = 1,
+
+  /**
+   * The engine can't do its job, e.g. no logs are stored at all
+   *
+   * @generated from enum value: SubsystemHealthStatus_UNHEALTHY = 2;
+   */
+  SubsystemHealthStatus_UNHEALTHY = 2,
+}
+
 /**
  * @generated from enum engine_api.EnclaveMode
  */
@@ -127,6 +153,20 @@ export declare class GetEngineInfoResponse extends Message<GetEngineInfoResponse
    */
   engineVersion: string;
 
+  /**
+   * Whether the engine restricts its TLS connections, and the ones of the API containers, to the FIPS-approved parameters
+   *
+   * @generated from field: bool fips_mode = 2;
+   */
+  fipsMode: boolean;
+
+  /**
+   * Whether the engine was built with the FIPS 140 validated crypto module
+   *
+   * @generated from field: bool validated_crypto = 3;
+   */
+  validatedCrypto: boolean;
+
   constructor(data?: PartialMessage<GetEngineInfoResponse>);
 
   static readonly runtime: typeof proto3;
@@ -142,6 +182,316 @@ export declare class GetEngineInfoResponse extends Message<GetEngineInfoResponse
   static equals(a: GetEngineInfoResponse | PlainMessage<GetEngineInfoResponse> | undefined, b: GetEngineInfoResponse | PlainMessage<GetEngineInfoResponse> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                     Negotiate API Version
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.NegotiateApiVersionArgs
+ */
+export declare class NegotiateApiVersionArgs extends Message<NegotiateApiVersionArgs> {
+  /**
+   * The Kurtosis version the client was built for, e.g. '0.90.1'
+   *
+   * @generated from field: string client_version = 1;
+   */
+  clientVersion: string;
+
+  constructor(data?: PartialMessage<NegotiateApiVersionArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.NegotiateApiVersionArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NegotiateApiVersionArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NegotiateApiVersionArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NegotiateApiVersionArgs;
+
+  static equals(a: NegotiateApiVersionArgs | PlainMessage<NegotiateApiVersionArgs> | undefined, b: NegotiateApiVersionArgs | PlainMessage<NegotiateApiVersionArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.NegotiateApiVersionResponse
+ */
+export declare class NegotiateApiVersionResponse extends Message<NegotiateApiVersionResponse> {
+  /**
+   * Version of the engine server
+   *
+   * @generated from field: string engine_version = 1;
+   */
+  engineVersion: string;
+
+  /**
+   * False if the client and the engine APIs may differ, i.e. their major or minor versions differ
+   *
+   * @generated from field: bool is_compatible = 2;
+   */
+  isCompatible: boolean;
+
+  /**
+   * What to do about the version skew; empty if the versions are compatible
+   *
+   * @generated from field: string message = 3;
+   */
+  message: string;
+
+  constructor(data?: PartialMessage<NegotiateApiVersionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.NegotiateApiVersionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NegotiateApiVersionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NegotiateApiVersionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NegotiateApiVersionResponse;
+
+  static equals(a: NegotiateApiVersionResponse | PlainMessage<NegotiateApiVersionResponse> | undefined, b: NegotiateApiVersionResponse | PlainMessage<NegotiateApiVersionResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Get Engine Health
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetEngineHealthResponse
+ */
+export declare class GetEngineHealthResponse extends Message<GetEngineHealthResponse> {
+  /**
+   * The worst status of the subsystems
+   *
+   * @generated from field: engine_api.SubsystemHealthStatus status = 1;
+   */
+  status: SubsystemHealthStatus;
+
+  /**
+   * @generated from field: repeated engine_api.SubsystemHealth subsystems = 2;
+   */
+  subsystems: SubsystemHealth[];
+
+  constructor(data?: PartialMessage<GetEngineHealthResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEngineHealthResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEngineHealthResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEngineHealthResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEngineHealthResponse;
+
+  static equals(a: GetEngineHealthResponse | PlainMessage<GetEngineHealthResponse> | undefined, b: GetEngineHealthResponse | PlainMessage<GetEngineHealthResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.SubsystemHealth
+ */
+export declare class SubsystemHealth extends Message<SubsystemHealth> {
+  /**
+   * The name of the subsystem, e.g. "logs-aggregator"
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The part of the subsystem this health is about, e.g. the node of a logs collector; empty if the subsystem has a
+   * single part
+   *
+   * @generated from field: string instance = 2;
+   */
+  instance: string;
+
+  /**
+   * @generated from field: engine_api.SubsystemHealthStatus status = 3;
+   */
+  status: SubsystemHealthStatus;
+
+  /**
+   * What's wrong with the subsystem, or how it's set up if it's healthy
+   *
+   * @generated from field: string message = 4;
+   */
+  message: string;
+
+  constructor(data?: PartialMessage<SubsystemHealth>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.SubsystemHealth";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubsystemHealth;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubsystemHealth;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubsystemHealth;
+
+  static equals(a: SubsystemHealth | PlainMessage<SubsystemHealth> | undefined, b: SubsystemHealth | PlainMessage<SubsystemHealth> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                     Update Engine Config
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.UpdateEngineConfigArgs
+ */
+export declare class UpdateEngineConfigArgs extends Message<UpdateEngineConfigArgs> {
+  /**
+   * The part of the engine config that can change while the engine runs (log retention period, enclave quota, auth tokens and default enclave TTL), as JSON
+   *
+   * @generated from field: string config_json = 1;
+   */
+  configJson: string;
+
+  constructor(data?: PartialMessage<UpdateEngineConfigArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.UpdateEngineConfigArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEngineConfigArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEngineConfigArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEngineConfigArgs;
+
+  static equals(a: UpdateEngineConfigArgs | PlainMessage<UpdateEngineConfigArgs> | undefined, b: UpdateEngineConfigArgs | PlainMessage<UpdateEngineConfigArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                        Get Audit Log
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetAuditLogArgs
+ */
+export declare class GetAuditLogArgs extends Message<GetAuditLogArgs> {
+  /**
+   * Only returns the operations done at or after this time; all of them if unset
+   *
+   * @generated from field: google.protobuf.Timestamp since = 1;
+   */
+  since?: Timestamp;
+
+  /**
+   * Only returns the operations done by this principal, e.g. 'token:ci'
+   *
+   * @generated from field: optional string principal = 2;
+   */
+  principal?: string;
+
+  /**
+   * Only returns the latest operations, up to this number
+   *
+   * @generated from field: optional uint32 limit = 3;
+   */
+  limit?: number;
+
+  constructor(data?: PartialMessage<GetAuditLogArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetAuditLogArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditLogArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditLogArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditLogArgs;
+
+  static equals(a: GetAuditLogArgs | PlainMessage<GetAuditLogArgs> | undefined, b: GetAuditLogArgs | PlainMessage<GetAuditLogArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.AuditLogEntry
+ */
+export declare class AuditLogEntry extends Message<AuditLogEntry> {
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 1;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * Who made the call, e.g. 'token:ci' or 'oidc:jane@example.com'; 'unauthenticated' if the engine doesn't require authentication
+   *
+   * @generated from field: string principal = 2;
+   */
+  principal: string;
+
+  /**
+   * The engine API the call was made to, 'grpc' or 'rest'
+   *
+   * @generated from field: string api = 3;
+   */
+  api: string;
+
+  /**
+   * The called procedure, e.g. '/engine_api.EngineService/DestroyEnclave', or the method and route of REST calls
+   *
+   * @generated from field: string operation = 4;
+   */
+  operation: string;
+
+  /**
+   * SHA-256 of the arguments of the call, so that they can be matched without storing secrets
+   *
+   * @generated from field: string args_digest = 5;
+   */
+  argsDigest: string;
+
+  /**
+   * 'ok', or the error code of the call
+   *
+   * @generated from field: string result = 6;
+   */
+  result: string;
+
+  constructor(data?: PartialMessage<AuditLogEntry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.AuditLogEntry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditLogEntry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditLogEntry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditLogEntry;
+
+  static equals(a: AuditLogEntry | PlainMessage<AuditLogEntry> | undefined, b: AuditLogEntry | PlainMessage<AuditLogEntry> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.GetAuditLogResponse
+ */
+export declare class GetAuditLogResponse extends Message<GetAuditLogResponse> {
+  /**
+   * Ordered from the oldest to the latest
+   *
+   * @generated from field: repeated engine_api.AuditLogEntry entries = 1;
+   */
+  entries: AuditLogEntry[];
+
+  constructor(data?: PartialMessage<GetAuditLogResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetAuditLogResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditLogResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditLogResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditLogResponse;
+
+  static equals(a: GetAuditLogResponse | PlainMessage<GetAuditLogResponse> | undefined, b: GetAuditLogResponse | PlainMessage<GetAuditLogResponse> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                        Create Enclave
@@ -185,6 +535,41 @@ export declare class CreateEnclaveArgs extends Message<CreateEnclaveArgs> {
    */
   shouldApicRunInDebugMode?: boolean;
 
+  /**
+   * How long the enclave lives before the engine destroys it, as a duration string like '4h' or '30m'. If blank, the engine's default TTL applies, and the enclave lives until it's removed if there's none
+   *
+   * @generated from field: optional string ttl = 6;
+   */
+  ttl?: string;
+
+  /**
+   * The Kubernetes cluster to create the enclave in, when the engine spans several clusters. If blank, the engine puts the enclave on the cluster with the fewest enclaves
+   *
+   * @generated from field: optional string cluster_name = 7;
+   */
+  clusterName?: string;
+
+  /**
+   * Whether the enclave is created without a logs collector, so that it doesn't carry the logging footprint. The logs of its services are then read straight from the container engine, and aren't kept once the services are removed
+   *
+   * @generated from field: optional bool should_skip_logs_collection = 8;
+   */
+  shouldSkipLogsCollection?: boolean;
+
+  /**
+   * Whether the services of the enclave can only reach the destinations of the egress allowlist, besides the enclave itself and the DNS servers
+   *
+   * @generated from field: optional bool should_restrict_egress = 9;
+   */
+  shouldRestrictEgress?: boolean;
+
+  /**
+   * The CIDRs, IP addresses and domains the services of the enclave can reach when the egress is restricted. Setting any restricts the egress. The domains get resolved once, when the enclave gets created
+   *
+   * @generated from field: repeated string egress_allowlist = 10;
+   */
+  egressAllowlist: string[];
+
   constructor(data?: PartialMessage<CreateEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
@@ -375,6 +760,35 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
    */
   mode: EnclaveMode;
 
+  /**
+   * Who created the enclave, e.g. 'token:ci' or 'oidc:jane'. Not present if the engine doesn't require authentication
+   *
+   * @generated from field: optional string owner = 10;
+   */
+  owner?: string;
+
+  /**
+   * When the engine will destroy the enclave. Not present if the enclave has no TTL
+   *
+   * @generated from field: optional google.protobuf.Timestamp expiration_time = 11;
+   */
+  expirationTime?: Timestamp;
+
+  /**
+   * The Kubernetes cluster the enclave runs in. Not present if the engine doesn't span several clusters
+   *
+   * @generated from field: optional string cluster_name = 12;
+   */
+  clusterName?: string;
+
+  /**
+   * Whether the API container only accepts the calls authenticated with a certificate issued for its enclave, which
+   * the caller gets from GetApiContainerTlsCredentials
+   *
+   * @generated from field: bool is_api_container_mtls_required = 14;
+   */
+  isApiContainerMtlsRequired: boolean;
+
   constructor(data?: PartialMessage<EnclaveInfo>);
 
   static readonly runtime: typeof proto3;
@@ -390,6 +804,52 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
   static equals(a: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined, b: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined): boolean;
 }
 
+/**
+ * The credentials of a client of an API container, issued by the certificate authority the engine creates for its
+ * enclave. Every field is PEM-encoded
+ *
+ * @generated from message engine_api.ApiContainerTlsCredentials
+ */
+export declare class ApiContainerTlsCredentials extends Message<ApiContainerTlsCredentials> {
+  /**
+   * Certificate of the authority of the enclave, which also issued the certificate of the API container
+   *
+   * @generated from field: string certificate_authority = 1;
+   */
+  certificateAuthority: string;
+
+  /**
+   * @generated from field: string client_certificate = 2;
+   */
+  clientCertificate: string;
+
+  /**
+   * @generated from field: string client_key = 3;
+   */
+  clientKey: string;
+
+  /**
+   * Whether the connections to the API container must be restricted to the FIPS-approved TLS parameters
+   *
+   * @generated from field: bool fips_mode = 4;
+   */
+  fipsMode: boolean;
+
+  constructor(data?: PartialMessage<ApiContainerTlsCredentials>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ApiContainerTlsCredentials";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApiContainerTlsCredentials;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApiContainerTlsCredentials;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApiContainerTlsCredentials;
+
+  static equals(a: ApiContainerTlsCredentials | PlainMessage<ApiContainerTlsCredentials> | undefined, b: ApiContainerTlsCredentials | PlainMessage<ApiContainerTlsCredentials> | undefined): boolean;
+}
+
 /**
  * @generated from message engine_api.GetEnclavesResponse
  */
@@ -416,6 +876,86 @@ export declare class GetEnclavesResponse extends Message<GetEnclavesResponse> {
   static equals(a: GetEnclavesResponse | PlainMessage<GetEnclavesResponse> | undefined, b: GetEnclavesResponse | PlainMessage<GetEnclavesResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.ListEnclavesArgs
+ */
+export declare class ListEnclavesArgs extends Message<ListEnclavesArgs> {
+  /**
+   * Only the enclaves in one of these statuses; enclaves in any status if empty
+   *
+   * @generated from field: repeated engine_api.EnclaveContainersStatus statuses = 1;
+   */
+  statuses: EnclaveContainersStatus[];
+
+  /**
+   * Only the enclaves created by this owner, e.g. 'token:ci'
+   *
+   * @generated from field: optional string owner = 2;
+   */
+  owner?: string;
+
+  /**
+   * The maximum number of enclaves to return; all of them if not set or 0
+   *
+   * @generated from field: optional uint32 page_size = 3;
+   */
+  pageSize?: number;
+
+  /**
+   * The next_page_token of the previous response, to get the page after it; the first page if not set
+   *
+   * @generated from field: optional string page_token = 4;
+   */
+  pageToken?: string;
+
+  constructor(data?: PartialMessage<ListEnclavesArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ListEnclavesArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEnclavesArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEnclavesArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEnclavesArgs;
+
+  static equals(a: ListEnclavesArgs | PlainMessage<ListEnclavesArgs> | undefined, b: ListEnclavesArgs | PlainMessage<ListEnclavesArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ListEnclavesResponse
+ */
+export declare class ListEnclavesResponse extends Message<ListEnclavesResponse> {
+  /**
+   * The enclaves of the page, the oldest first
+   *
+   * @generated from field: repeated engine_api.EnclaveInfo enclave_infos = 1;
+   */
+  enclaveInfos: EnclaveInfo[];
+
+  /**
+   * To pass as page_token to get the next page; not set if this is the last page
+   *
+   * @generated from field: optional string next_page_token = 2;
+   */
+  nextPageToken?: string;
+
+  constructor(data?: PartialMessage<ListEnclavesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ListEnclavesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEnclavesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEnclavesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEnclavesResponse;
+
+  static equals(a: ListEnclavesResponse | PlainMessage<ListEnclavesResponse> | undefined, b: ListEnclavesResponse | PlainMessage<ListEnclavesResponse> | undefined): boolean;
+}
+
 /**
  * An enclave identifier is a collection of uuid, name and shortened uuid
  *
@@ -542,6 +1082,107 @@ export declare class DestroyEnclaveArgs extends Message<DestroyEnclaveArgs> {
   static equals(a: DestroyEnclaveArgs | PlainMessage<DestroyEnclaveArgs> | undefined, b: DestroyEnclaveArgs | PlainMessage<DestroyEnclaveArgs> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.ShareEnclaveArgs
+ */
+export declare class ShareEnclaveArgs extends Message<ShareEnclaveArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to share
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  /**
+   * Who gets access to the enclave, e.g. 'token:ci' or 'oidc:jane'
+   *
+   * @generated from field: string principal = 2;
+   */
+  principal: string;
+
+  /**
+   * If true, the access of the principal is revoked instead
+   *
+   * @generated from field: optional bool revoke = 3;
+   */
+  revoke?: boolean;
+
+  constructor(data?: PartialMessage<ShareEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ShareEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ShareEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ShareEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ShareEnclaveArgs;
+
+  static equals(a: ShareEnclaveArgs | PlainMessage<ShareEnclaveArgs> | undefined, b: ShareEnclaveArgs | PlainMessage<ShareEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ShareEnclaveResponse
+ */
+export declare class ShareEnclaveResponse extends Message<ShareEnclaveResponse> {
+  /**
+   * @generated from field: string owner = 1;
+   */
+  owner: string;
+
+  /**
+   * Who the enclave is shared with, besides its owner and admins
+   *
+   * @generated from field: repeated string grantees = 2;
+   */
+  grantees: string[];
+
+  constructor(data?: PartialMessage<ShareEnclaveResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ShareEnclaveResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ShareEnclaveResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ShareEnclaveResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ShareEnclaveResponse;
+
+  static equals(a: ShareEnclaveResponse | PlainMessage<ShareEnclaveResponse> | undefined, b: ShareEnclaveResponse | PlainMessage<ShareEnclaveResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                               Get API Container TLS Credentials
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetApiContainerTlsCredentialsArgs
+ */
+export declare class GetApiContainerTlsCredentialsArgs extends Message<GetApiContainerTlsCredentialsArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave whose API container is called
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  constructor(data?: PartialMessage<GetApiContainerTlsCredentialsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetApiContainerTlsCredentialsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetApiContainerTlsCredentialsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetApiContainerTlsCredentialsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetApiContainerTlsCredentialsArgs;
+
+  static equals(a: GetApiContainerTlsCredentialsArgs | PlainMessage<GetApiContainerTlsCredentialsArgs> | undefined, b: GetApiContainerTlsCredentialsArgs | PlainMessage<GetApiContainerTlsCredentialsArgs> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                       Create Enclave
@@ -755,6 +1396,148 @@ export declare class LogLine extends Message<LogLine> {
   static equals(a: LogLine | PlainMessage<LogLine> | undefined, b: LogLine | PlainMessage<LogLine> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                Get User Service Resource Usage
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetServiceResourceUsageArgs
+ */
+export declare class GetServiceResourceUsageArgs extends Message<GetServiceResourceUsageArgs> {
+  /**
+   * The identifier of the user service's Kurtosis Enclave
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  /**
+   * Only returns the samples taken at or after this time; all the retained ones if unset
+   *
+   * @generated from field: google.protobuf.Timestamp since = 2;
+   */
+  since?: Timestamp;
+
+  constructor(data?: PartialMessage<GetServiceResourceUsageArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetServiceResourceUsageArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServiceResourceUsageArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServiceResourceUsageArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServiceResourceUsageArgs;
+
+  static equals(a: GetServiceResourceUsageArgs | PlainMessage<GetServiceResourceUsageArgs> | undefined, b: GetServiceResourceUsageArgs | PlainMessage<GetServiceResourceUsageArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.GetServiceResourceUsageResponse
+ */
+export declare class GetServiceResourceUsageResponse extends Message<GetServiceResourceUsageResponse> {
+  /**
+   * The samples grouped by service UUIDs; services that have no samples yet, e.g. because they just started, are left out
+   *
+   * @generated from field: map<string, engine_api.ResourceUsageSeries> resource_usage_by_service_uuid = 1;
+   */
+  resourceUsageByServiceUuid: { [key: string]: ResourceUsageSeries };
+
+  /**
+   * How often the engine samples the services
+   *
+   * @generated from field: uint32 sampling_interval_seconds = 2;
+   */
+  samplingIntervalSeconds: number;
+
+  constructor(data?: PartialMessage<GetServiceResourceUsageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetServiceResourceUsageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServiceResourceUsageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServiceResourceUsageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServiceResourceUsageResponse;
+
+  static equals(a: GetServiceResourceUsageResponse | PlainMessage<GetServiceResourceUsageResponse> | undefined, b: GetServiceResourceUsageResponse | PlainMessage<GetServiceResourceUsageResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ResourceUsageSeries
+ */
+export declare class ResourceUsageSeries extends Message<ResourceUsageSeries> {
+  /**
+   * Ordered from the oldest to the latest
+   *
+   * @generated from field: repeated engine_api.ResourceUsageSample samples = 1;
+   */
+  samples: ResourceUsageSample[];
+
+  constructor(data?: PartialMessage<ResourceUsageSeries>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ResourceUsageSeries";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceUsageSeries;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceUsageSeries;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceUsageSeries;
+
+  static equals(a: ResourceUsageSeries | PlainMessage<ResourceUsageSeries> | undefined, b: ResourceUsageSeries | PlainMessage<ResourceUsageSeries> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ResourceUsageSample
+ */
+export declare class ResourceUsageSample extends Message<ResourceUsageSample> {
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 1;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: uint64 cpu_milli_cores = 2;
+   */
+  cpuMilliCores: bigint;
+
+  /**
+   * @generated from field: uint64 memory_bytes = 3;
+   */
+  memoryBytes: bigint;
+
+  /**
+   * The bytes the service received and sent over the network since it started; unset if the backend doesn't report them
+   *
+   * @generated from field: optional uint64 network_rx_bytes = 4;
+   */
+  networkRxBytes?: bigint;
+
+  /**
+   * @generated from field: optional uint64 network_tx_bytes = 5;
+   */
+  networkTxBytes?: bigint;
+
+  constructor(data?: PartialMessage<ResourceUsageSample>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ResourceUsageSample";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceUsageSample;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceUsageSample;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceUsageSample;
+
+  static equals(a: ResourceUsageSample | PlainMessage<ResourceUsageSample> | undefined, b: ResourceUsageSample | PlainMessage<ResourceUsageSample> | undefined): boolean;
+}
+
 /**
  * @generated from message engine_api.LogLineFilter
  */
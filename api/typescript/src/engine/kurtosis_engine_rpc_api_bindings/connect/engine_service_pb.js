@@ -5,6 +5,20 @@
 
 import { proto3, Timestamp } from "@bufbuild/protobuf";
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.SubsystemHealthStatus
+ */
+export const SubsystemHealthStatus = proto3.makeEnum(
+  "engine_api.SubsystemHealthStatus",
+  [
+    {no: 0, name: "SubsystemHealthStatus_HEALTHY"},
+    {no: 1, name: "SubsystemHealthStatus_DEGRADED"},
+    {no: 2, name: "SubsystemHealthStatus_UNHEALTHY"},
+  ],
+);
+
 /**
  * @generated from enum engine_api.EnclaveMode
  */
@@ -75,6 +89,117 @@ export const GetEngineInfoResponse = proto3.makeMessageType(
   "engine_api.GetEngineInfoResponse",
   () => [
     { no: 1, name: "engine_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "fips_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "validated_crypto", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                     Negotiate API Version
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.NegotiateApiVersionArgs
+ */
+export const NegotiateApiVersionArgs = proto3.makeMessageType(
+  "engine_api.NegotiateApiVersionArgs",
+  () => [
+    { no: 1, name: "client_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.NegotiateApiVersionResponse
+ */
+export const NegotiateApiVersionResponse = proto3.makeMessageType(
+  "engine_api.NegotiateApiVersionResponse",
+  () => [
+    { no: 1, name: "engine_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "is_compatible", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Get Engine Health
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetEngineHealthResponse
+ */
+export const GetEngineHealthResponse = proto3.makeMessageType(
+  "engine_api.GetEngineHealthResponse",
+  () => [
+    { no: 1, name: "status", kind: "enum", T: proto3.getEnumType(SubsystemHealthStatus) },
+    { no: 2, name: "subsystems", kind: "message", T: SubsystemHealth, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.SubsystemHealth
+ */
+export const SubsystemHealth = proto3.makeMessageType(
+  "engine_api.SubsystemHealth",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(SubsystemHealthStatus) },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                     Update Engine Config
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.UpdateEngineConfigArgs
+ */
+export const UpdateEngineConfigArgs = proto3.makeMessageType(
+  "engine_api.UpdateEngineConfigArgs",
+  () => [
+    { no: 1, name: "config_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                        Get Audit Log
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetAuditLogArgs
+ */
+export const GetAuditLogArgs = proto3.makeMessageType(
+  "engine_api.GetAuditLogArgs",
+  () => [
+    { no: 1, name: "since", kind: "message", T: Timestamp },
+    { no: 2, name: "principal", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.AuditLogEntry
+ */
+export const AuditLogEntry = proto3.makeMessageType(
+  "engine_api.AuditLogEntry",
+  () => [
+    { no: 1, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 2, name: "principal", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "api", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "operation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "args_digest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "result", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetAuditLogResponse
+ */
+export const GetAuditLogResponse = proto3.makeMessageType(
+  "engine_api.GetAuditLogResponse",
+  () => [
+    { no: 1, name: "entries", kind: "message", T: AuditLogEntry, repeated: true },
   ],
 );
 
@@ -93,6 +218,11 @@ export const CreateEnclaveArgs = proto3.makeMessageType(
     { no: 3, name: "api_container_log_level", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode), opt: true },
     { no: 5, name: "should_apic_run_in_debug_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 6, name: "ttl", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "should_skip_logs_collection", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 9, name: "should_restrict_egress", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 10, name: "egress_allowlist", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ],
 );
 
@@ -149,6 +279,26 @@ export const EnclaveInfo = proto3.makeMessageType(
     { no: 7, name: "api_container_host_machine_info", kind: "message", T: EnclaveAPIContainerHostMachineInfo },
     { no: 8, name: "creation_time", kind: "message", T: Timestamp },
     { no: 9, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode) },
+    { no: 10, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 11, name: "expiration_time", kind: "message", T: Timestamp, opt: true },
+    { no: 12, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 14, name: "is_api_container_mtls_required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * The credentials of a client of an API container, issued by the certificate authority the engine creates for its
+ * enclave. Every field is PEM-encoded
+ *
+ * @generated from message engine_api.ApiContainerTlsCredentials
+ */
+export const ApiContainerTlsCredentials = proto3.makeMessageType(
+  "engine_api.ApiContainerTlsCredentials",
+  () => [
+    { no: 1, name: "certificate_authority", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "client_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "client_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "fips_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -162,6 +312,30 @@ export const GetEnclavesResponse = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message engine_api.ListEnclavesArgs
+ */
+export const ListEnclavesArgs = proto3.makeMessageType(
+  "engine_api.ListEnclavesArgs",
+  () => [
+    { no: 1, name: "statuses", kind: "enum", T: proto3.getEnumType(EnclaveContainersStatus), repeated: true },
+    { no: 2, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ListEnclavesResponse
+ */
+export const ListEnclavesResponse = proto3.makeMessageType(
+  "engine_api.ListEnclavesResponse",
+  () => [
+    { no: 1, name: "enclave_infos", kind: "message", T: EnclaveInfo, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ],
+);
+
 /**
  * An enclave identifier is a collection of uuid, name and shortened uuid
  *
@@ -214,6 +388,43 @@ export const DestroyEnclaveArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message engine_api.ShareEnclaveArgs
+ */
+export const ShareEnclaveArgs = proto3.makeMessageType(
+  "engine_api.ShareEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "principal", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "revoke", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ShareEnclaveResponse
+ */
+export const ShareEnclaveResponse = proto3.makeMessageType(
+  "engine_api.ShareEnclaveResponse",
+  () => [
+    { no: 1, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "grantees", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                               Get API Container TLS Credentials
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetApiContainerTlsCredentialsArgs
+ */
+export const GetApiContainerTlsCredentialsArgs = proto3.makeMessageType(
+  "engine_api.GetApiContainerTlsCredentialsArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                       Create Enclave
@@ -290,6 +501,56 @@ export const LogLine = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                Get User Service Resource Usage
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetServiceResourceUsageArgs
+ */
+export const GetServiceResourceUsageArgs = proto3.makeMessageType(
+  "engine_api.GetServiceResourceUsageArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "since", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetServiceResourceUsageResponse
+ */
+export const GetServiceResourceUsageResponse = proto3.makeMessageType(
+  "engine_api.GetServiceResourceUsageResponse",
+  () => [
+    { no: 1, name: "resource_usage_by_service_uuid", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ResourceUsageSeries} },
+    { no: 2, name: "sampling_interval_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ResourceUsageSeries
+ */
+export const ResourceUsageSeries = proto3.makeMessageType(
+  "engine_api.ResourceUsageSeries",
+  () => [
+    { no: 1, name: "samples", kind: "message", T: ResourceUsageSample, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ResourceUsageSample
+ */
+export const ResourceUsageSample = proto3.makeMessageType(
+  "engine_api.ResourceUsageSample",
+  () => [
+    { no: 1, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 2, name: "cpu_milli_cores", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "memory_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "network_rx_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 5, name: "network_tx_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+  ],
+);
+
 /**
  * @generated from message engine_api.LogLineFilter
  */
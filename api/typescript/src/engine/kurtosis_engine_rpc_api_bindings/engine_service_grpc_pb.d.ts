@@ -9,26 +9,42 @@ import * as grpc from "@grpc/grpc-js";
 
 interface IEngineServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
   getEngineInfo: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEngineInfoResponse>;
+  negotiateApiVersion: grpc.MethodDefinition<engine_service_pb.NegotiateApiVersionArgs, engine_service_pb.NegotiateApiVersionResponse>;
+  updateEngineConfig: grpc.MethodDefinition<engine_service_pb.UpdateEngineConfigArgs, google_protobuf_empty_pb.Empty>;
+  getAuditLog: grpc.MethodDefinition<engine_service_pb.GetAuditLogArgs, engine_service_pb.GetAuditLogResponse>;
+  getEngineHealth: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEngineHealthResponse>;
   createEnclave: grpc.MethodDefinition<engine_service_pb.CreateEnclaveArgs, engine_service_pb.CreateEnclaveResponse>;
   getEnclaves: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
+  listEnclaves: grpc.MethodDefinition<engine_service_pb.ListEnclavesArgs, engine_service_pb.ListEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.MethodDefinition<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
   destroyEnclave: grpc.MethodDefinition<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  shareEnclave: grpc.MethodDefinition<engine_service_pb.ShareEnclaveArgs, engine_service_pb.ShareEnclaveResponse>;
+  getApiContainerTlsCredentials: grpc.MethodDefinition<engine_service_pb.GetApiContainerTlsCredentialsArgs, engine_service_pb.ApiContainerTlsCredentials>;
   clean: grpc.MethodDefinition<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.MethodDefinition<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  getServiceResourceUsage: grpc.MethodDefinition<engine_service_pb.GetServiceResourceUsageArgs, engine_service_pb.GetServiceResourceUsageResponse>;
 }
 
 export const EngineServiceService: IEngineServiceService;
 
 export interface IEngineServiceServer extends grpc.UntypedServiceImplementation {
   getEngineInfo: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEngineInfoResponse>;
+  negotiateApiVersion: grpc.handleUnaryCall<engine_service_pb.NegotiateApiVersionArgs, engine_service_pb.NegotiateApiVersionResponse>;
+  updateEngineConfig: grpc.handleUnaryCall<engine_service_pb.UpdateEngineConfigArgs, google_protobuf_empty_pb.Empty>;
+  getAuditLog: grpc.handleUnaryCall<engine_service_pb.GetAuditLogArgs, engine_service_pb.GetAuditLogResponse>;
+  getEngineHealth: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEngineHealthResponse>;
   createEnclave: grpc.handleUnaryCall<engine_service_pb.CreateEnclaveArgs, engine_service_pb.CreateEnclaveResponse>;
   getEnclaves: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
+  listEnclaves: grpc.handleUnaryCall<engine_service_pb.ListEnclavesArgs, engine_service_pb.ListEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.handleUnaryCall<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
   destroyEnclave: grpc.handleUnaryCall<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  shareEnclave: grpc.handleUnaryCall<engine_service_pb.ShareEnclaveArgs, engine_service_pb.ShareEnclaveResponse>;
+  getApiContainerTlsCredentials: grpc.handleUnaryCall<engine_service_pb.GetApiContainerTlsCredentialsArgs, engine_service_pb.ApiContainerTlsCredentials>;
   clean: grpc.handleUnaryCall<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.handleServerStreamingCall<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  getServiceResourceUsage: grpc.handleUnaryCall<engine_service_pb.GetServiceResourceUsageArgs, engine_service_pb.GetServiceResourceUsageResponse>;
 }
 
 export class EngineServiceClient extends grpc.Client {
@@ -36,12 +52,27 @@ export class EngineServiceClient extends grpc.Client {
   getEngineInfo(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetEngineInfoResponse>): grpc.ClientUnaryCall;
   getEngineInfo(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEngineInfoResponse>): grpc.ClientUnaryCall;
   getEngineInfo(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEngineInfoResponse>): grpc.ClientUnaryCall;
+  negotiateApiVersion(argument: engine_service_pb.NegotiateApiVersionArgs, callback: grpc.requestCallback<engine_service_pb.NegotiateApiVersionResponse>): grpc.ClientUnaryCall;
+  negotiateApiVersion(argument: engine_service_pb.NegotiateApiVersionArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.NegotiateApiVersionResponse>): grpc.ClientUnaryCall;
+  negotiateApiVersion(argument: engine_service_pb.NegotiateApiVersionArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.NegotiateApiVersionResponse>): grpc.ClientUnaryCall;
+  updateEngineConfig(argument: engine_service_pb.UpdateEngineConfigArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  updateEngineConfig(argument: engine_service_pb.UpdateEngineConfigArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  updateEngineConfig(argument: engine_service_pb.UpdateEngineConfigArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  getAuditLog(argument: engine_service_pb.GetAuditLogArgs, callback: grpc.requestCallback<engine_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  getAuditLog(argument: engine_service_pb.GetAuditLogArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  getAuditLog(argument: engine_service_pb.GetAuditLogArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  getEngineHealth(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetEngineHealthResponse>): grpc.ClientUnaryCall;
+  getEngineHealth(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEngineHealthResponse>): grpc.ClientUnaryCall;
+  getEngineHealth(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEngineHealthResponse>): grpc.ClientUnaryCall;
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
   getEnclaves(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
   getEnclaves(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
   getEnclaves(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
+  listEnclaves(argument: engine_service_pb.ListEnclavesArgs, callback: grpc.requestCallback<engine_service_pb.ListEnclavesResponse>): grpc.ClientUnaryCall;
+  listEnclaves(argument: engine_service_pb.ListEnclavesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ListEnclavesResponse>): grpc.ClientUnaryCall;
+  listEnclaves(argument: engine_service_pb.ListEnclavesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ListEnclavesResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalEnclaveIdentifiers(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalEnclaveIdentifiers(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalEnclaveIdentifiers(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>): grpc.ClientUnaryCall;
@@ -51,9 +82,18 @@ export class EngineServiceClient extends grpc.Client {
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  shareEnclave(argument: engine_service_pb.ShareEnclaveArgs, callback: grpc.requestCallback<engine_service_pb.ShareEnclaveResponse>): grpc.ClientUnaryCall;
+  shareEnclave(argument: engine_service_pb.ShareEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ShareEnclaveResponse>): grpc.ClientUnaryCall;
+  shareEnclave(argument: engine_service_pb.ShareEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ShareEnclaveResponse>): grpc.ClientUnaryCall;
+  getApiContainerTlsCredentials(argument: engine_service_pb.GetApiContainerTlsCredentialsArgs, callback: grpc.requestCallback<engine_service_pb.ApiContainerTlsCredentials>): grpc.ClientUnaryCall;
+  getApiContainerTlsCredentials(argument: engine_service_pb.GetApiContainerTlsCredentialsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ApiContainerTlsCredentials>): grpc.ClientUnaryCall;
+  getApiContainerTlsCredentials(argument: engine_service_pb.GetApiContainerTlsCredentialsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ApiContainerTlsCredentials>): grpc.ClientUnaryCall;
   clean(argument: engine_service_pb.CleanArgs, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   clean(argument: engine_service_pb.CleanArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   clean(argument: engine_service_pb.CleanArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
+  getServiceResourceUsage(argument: engine_service_pb.GetServiceResourceUsageArgs, callback: grpc.requestCallback<engine_service_pb.GetServiceResourceUsageResponse>): grpc.ClientUnaryCall;
+  getServiceResourceUsage(argument: engine_service_pb.GetServiceResourceUsageArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetServiceResourceUsageResponse>): grpc.ClientUnaryCall;
+  getServiceResourceUsage(argument: engine_service_pb.GetServiceResourceUsageArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetServiceResourceUsageResponse>): grpc.ClientUnaryCall;
 }
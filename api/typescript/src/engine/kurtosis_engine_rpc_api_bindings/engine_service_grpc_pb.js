@@ -6,6 +6,17 @@ var engine_service_pb = require('./engine_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 
+function serialize_engine_api_ApiContainerTlsCredentials(arg) {
+  if (!(arg instanceof engine_service_pb.ApiContainerTlsCredentials)) {
+    throw new Error('Expected argument of type engine_api.ApiContainerTlsCredentials');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ApiContainerTlsCredentials(buffer_arg) {
+  return engine_service_pb.ApiContainerTlsCredentials.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_CleanArgs(arg) {
   if (!(arg instanceof engine_service_pb.CleanArgs)) {
     throw new Error('Expected argument of type engine_api.CleanArgs');
@@ -61,6 +72,39 @@ function deserialize_engine_api_DestroyEnclaveArgs(buffer_arg) {
   return engine_service_pb.DestroyEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_GetApiContainerTlsCredentialsArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetApiContainerTlsCredentialsArgs)) {
+    throw new Error('Expected argument of type engine_api.GetApiContainerTlsCredentialsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetApiContainerTlsCredentialsArgs(buffer_arg) {
+  return engine_service_pb.GetApiContainerTlsCredentialsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetAuditLogArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetAuditLogArgs)) {
+    throw new Error('Expected argument of type engine_api.GetAuditLogArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetAuditLogArgs(buffer_arg) {
+  return engine_service_pb.GetAuditLogArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetAuditLogResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetAuditLogResponse)) {
+    throw new Error('Expected argument of type engine_api.GetAuditLogResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetAuditLogResponse(buffer_arg) {
+  return engine_service_pb.GetAuditLogResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetEnclavesResponse(arg) {
   if (!(arg instanceof engine_service_pb.GetEnclavesResponse)) {
     throw new Error('Expected argument of type engine_api.GetEnclavesResponse');
@@ -72,6 +116,17 @@ function deserialize_engine_api_GetEnclavesResponse(buffer_arg) {
   return engine_service_pb.GetEnclavesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_GetEngineHealthResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetEngineHealthResponse)) {
+    throw new Error('Expected argument of type engine_api.GetEngineHealthResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEngineHealthResponse(buffer_arg) {
+  return engine_service_pb.GetEngineHealthResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetEngineInfoResponse(arg) {
   if (!(arg instanceof engine_service_pb.GetEngineInfoResponse)) {
     throw new Error('Expected argument of type engine_api.GetEngineInfoResponse');
@@ -116,6 +171,94 @@ function deserialize_engine_api_GetServiceLogsResponse(buffer_arg) {
   return engine_service_pb.GetServiceLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_GetServiceResourceUsageArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetServiceResourceUsageArgs)) {
+    throw new Error('Expected argument of type engine_api.GetServiceResourceUsageArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetServiceResourceUsageArgs(buffer_arg) {
+  return engine_service_pb.GetServiceResourceUsageArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetServiceResourceUsageResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetServiceResourceUsageResponse)) {
+    throw new Error('Expected argument of type engine_api.GetServiceResourceUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetServiceResourceUsageResponse(buffer_arg) {
+  return engine_service_pb.GetServiceResourceUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ListEnclavesArgs(arg) {
+  if (!(arg instanceof engine_service_pb.ListEnclavesArgs)) {
+    throw new Error('Expected argument of type engine_api.ListEnclavesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ListEnclavesArgs(buffer_arg) {
+  return engine_service_pb.ListEnclavesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ListEnclavesResponse(arg) {
+  if (!(arg instanceof engine_service_pb.ListEnclavesResponse)) {
+    throw new Error('Expected argument of type engine_api.ListEnclavesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ListEnclavesResponse(buffer_arg) {
+  return engine_service_pb.ListEnclavesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_NegotiateApiVersionArgs(arg) {
+  if (!(arg instanceof engine_service_pb.NegotiateApiVersionArgs)) {
+    throw new Error('Expected argument of type engine_api.NegotiateApiVersionArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_NegotiateApiVersionArgs(buffer_arg) {
+  return engine_service_pb.NegotiateApiVersionArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_NegotiateApiVersionResponse(arg) {
+  if (!(arg instanceof engine_service_pb.NegotiateApiVersionResponse)) {
+    throw new Error('Expected argument of type engine_api.NegotiateApiVersionResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_NegotiateApiVersionResponse(buffer_arg) {
+  return engine_service_pb.NegotiateApiVersionResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ShareEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.ShareEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.ShareEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ShareEnclaveArgs(buffer_arg) {
+  return engine_service_pb.ShareEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ShareEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.ShareEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.ShareEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ShareEnclaveResponse(buffer_arg) {
+  return engine_service_pb.ShareEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_StopEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.StopEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.StopEnclaveArgs');
@@ -127,6 +270,17 @@ function deserialize_engine_api_StopEnclaveArgs(buffer_arg) {
   return engine_service_pb.StopEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_UpdateEngineConfigArgs(arg) {
+  if (!(arg instanceof engine_service_pb.UpdateEngineConfigArgs)) {
+    throw new Error('Expected argument of type engine_api.UpdateEngineConfigArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_UpdateEngineConfigArgs(buffer_arg) {
+  return engine_service_pb.UpdateEngineConfigArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_google_protobuf_Empty(arg) {
   if (!(arg instanceof google_protobuf_empty_pb.Empty)) {
     throw new Error('Expected argument of type google.protobuf.Empty');
@@ -152,6 +306,56 @@ getEngineInfo: {
     responseSerialize: serialize_engine_api_GetEngineInfoResponse,
     responseDeserialize: deserialize_engine_api_GetEngineInfoResponse,
   },
+  // Tells whether the engine API matches the one a client (CLI or SDK) was built for, so that version skew is reported
+// clearly before other calls fail in confusing ways
+negotiateApiVersion: {
+    path: '/engine_api.EngineService/NegotiateApiVersion',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.NegotiateApiVersionArgs,
+    responseType: engine_service_pb.NegotiateApiVersionResponse,
+    requestSerialize: serialize_engine_api_NegotiateApiVersionArgs,
+    requestDeserialize: deserialize_engine_api_NegotiateApiVersionArgs,
+    responseSerialize: serialize_engine_api_NegotiateApiVersionResponse,
+    responseDeserialize: deserialize_engine_api_NegotiateApiVersionResponse,
+  },
+  // Applies a new engine config, e.g. new auth tokens, to every engine replica without restarting them; only admins can do it
+updateEngineConfig: {
+    path: '/engine_api.EngineService/UpdateEngineConfig',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.UpdateEngineConfigArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_UpdateEngineConfigArgs,
+    requestDeserialize: deserialize_engine_api_UpdateEngineConfigArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Returns the mutating calls made to the engine APIs, who made them and when; only admins can do it
+getAuditLog: {
+    path: '/engine_api.EngineService/GetAuditLog',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.GetAuditLogArgs,
+    responseType: engine_service_pb.GetAuditLogResponse,
+    requestSerialize: serialize_engine_api_GetAuditLogArgs,
+    requestDeserialize: deserialize_engine_api_GetAuditLogArgs,
+    responseSerialize: serialize_engine_api_GetAuditLogResponse,
+    responseDeserialize: deserialize_engine_api_GetAuditLogResponse,
+  },
+  // Returns the health of the subsystems the engine relies on (backend, logs aggregator and collectors, state store and
+// artifacts store), so that e.g. missing logs are noticed before they are needed
+getEngineHealth: {
+    path: '/engine_api.EngineService/GetEngineHealth',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: engine_service_pb.GetEngineHealthResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_engine_api_GetEngineHealthResponse,
+    responseDeserialize: deserialize_engine_api_GetEngineHealthResponse,
+  },
   // ==============================================================================================
 //                                   Enclave Management
 // ==============================================================================================
@@ -179,6 +383,18 @@ getEnclaves: {
     responseSerialize: serialize_engine_api_GetEnclavesResponse,
     responseDeserialize: deserialize_engine_api_GetEnclavesResponse,
   },
+  // Returns a page of the existing enclaves, the oldest first, optionally only the ones in some statuses or of an owner
+listEnclaves: {
+    path: '/engine_api.EngineService/ListEnclaves',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.ListEnclavesArgs,
+    responseType: engine_service_pb.ListEnclavesResponse,
+    requestSerialize: serialize_engine_api_ListEnclavesArgs,
+    requestDeserialize: deserialize_engine_api_ListEnclavesArgs,
+    responseSerialize: serialize_engine_api_ListEnclavesResponse,
+    responseDeserialize: deserialize_engine_api_ListEnclavesResponse,
+  },
   // Returns information about all existing & historical enclaves
 getExistingAndHistoricalEnclaveIdentifiers: {
     path: '/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers',
@@ -215,6 +431,31 @@ destroyEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Grants or revokes access to an enclave; only the owner of the enclave and admins can do it
+shareEnclave: {
+    path: '/engine_api.EngineService/ShareEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.ShareEnclaveArgs,
+    responseType: engine_service_pb.ShareEnclaveResponse,
+    requestSerialize: serialize_engine_api_ShareEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_ShareEnclaveArgs,
+    responseSerialize: serialize_engine_api_ShareEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_ShareEnclaveResponse,
+  },
+  // Issues the caller a short-lived certificate to call the API container of an enclave requiring mutual TLS with;
+// only the callers that can change the enclave get one
+getApiContainerTlsCredentials: {
+    path: '/engine_api.EngineService/GetApiContainerTlsCredentials',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.GetApiContainerTlsCredentialsArgs,
+    responseType: engine_service_pb.ApiContainerTlsCredentials,
+    requestSerialize: serialize_engine_api_GetApiContainerTlsCredentialsArgs,
+    requestDeserialize: deserialize_engine_api_GetApiContainerTlsCredentialsArgs,
+    responseSerialize: serialize_engine_api_ApiContainerTlsCredentials,
+    responseDeserialize: deserialize_engine_api_ApiContainerTlsCredentials,
+  },
   // Gets rid of old enclaves
 clean: {
     path: '/engine_api.EngineService/Clean',
@@ -239,6 +480,18 @@ getServiceLogs: {
     responseSerialize: serialize_engine_api_GetServiceLogsResponse,
     responseDeserialize: deserialize_engine_api_GetServiceLogsResponse,
   },
+  // Returns the CPU, memory and network usage the engine sampled lately for each running service of an enclave
+getServiceResourceUsage: {
+    path: '/engine_api.EngineService/GetServiceResourceUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.GetServiceResourceUsageArgs,
+    responseType: engine_service_pb.GetServiceResourceUsageResponse,
+    requestSerialize: serialize_engine_api_GetServiceResourceUsageArgs,
+    requestDeserialize: deserialize_engine_api_GetServiceResourceUsageArgs,
+    responseSerialize: serialize_engine_api_GetServiceResourceUsageResponse,
+    responseDeserialize: deserialize_engine_api_GetServiceResourceUsageResponse,
+  },
 };
 
 exports.EngineServiceClient = grpc.makeGenericClientConstructor(EngineServiceService);
@@ -16,6 +16,34 @@ export class EngineServiceClient {
                response: engine_service_pb.GetEngineInfoResponse) => void
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetEngineInfoResponse>;
 
+  negotiateApiVersion(
+    request: engine_service_pb.NegotiateApiVersionArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.NegotiateApiVersionResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.NegotiateApiVersionResponse>;
+
+  updateEngineConfig(
+    request: engine_service_pb.UpdateEngineConfigArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  getAuditLog(
+    request: engine_service_pb.GetAuditLogArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetAuditLogResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetAuditLogResponse>;
+
+  getEngineHealth(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetEngineHealthResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetEngineHealthResponse>;
+
   createEnclave(
     request: engine_service_pb.CreateEnclaveArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -30,6 +58,13 @@ export class EngineServiceClient {
                response: engine_service_pb.GetEnclavesResponse) => void
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetEnclavesResponse>;
 
+  listEnclaves(
+    request: engine_service_pb.ListEnclavesArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.ListEnclavesResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.ListEnclavesResponse>;
+
   getExistingAndHistoricalEnclaveIdentifiers(
     request: google_protobuf_empty_pb.Empty,
     metadata: grpcWeb.Metadata | undefined,
@@ -51,6 +86,20 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  shareEnclave(
+    request: engine_service_pb.ShareEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.ShareEnclaveResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.ShareEnclaveResponse>;
+
+  getApiContainerTlsCredentials(
+    request: engine_service_pb.GetApiContainerTlsCredentialsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.ApiContainerTlsCredentials) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.ApiContainerTlsCredentials>;
+
   clean(
     request: engine_service_pb.CleanArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -63,6 +112,13 @@ export class EngineServiceClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  getServiceResourceUsage(
+    request: engine_service_pb.GetServiceResourceUsageArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetServiceResourceUsageResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceResourceUsageResponse>;
+
 }
 
 export class EngineServicePromiseClient {
@@ -75,6 +131,26 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.GetEngineInfoResponse>;
 
+  negotiateApiVersion(
+    request: engine_service_pb.NegotiateApiVersionArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.NegotiateApiVersionResponse>;
+
+  updateEngineConfig(
+    request: engine_service_pb.UpdateEngineConfigArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  getAuditLog(
+    request: engine_service_pb.GetAuditLogArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetAuditLogResponse>;
+
+  getEngineHealth(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetEngineHealthResponse>;
+
   createEnclave(
     request: engine_service_pb.CreateEnclaveArgs,
     metadata?: grpcWeb.Metadata
@@ -85,6 +161,11 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.GetEnclavesResponse>;
 
+  listEnclaves(
+    request: engine_service_pb.ListEnclavesArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.ListEnclavesResponse>;
+
   getExistingAndHistoricalEnclaveIdentifiers(
     request: google_protobuf_empty_pb.Empty,
     metadata?: grpcWeb.Metadata
@@ -100,6 +181,16 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  shareEnclave(
+    request: engine_service_pb.ShareEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.ShareEnclaveResponse>;
+
+  getApiContainerTlsCredentials(
+    request: engine_service_pb.GetApiContainerTlsCredentialsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.ApiContainerTlsCredentials>;
+
   clean(
     request: engine_service_pb.CleanArgs,
     metadata?: grpcWeb.Metadata
@@ -110,5 +201,10 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  getServiceResourceUsage(
+    request: engine_service_pb.GetServiceResourceUsageArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetServiceResourceUsageResponse>;
+
 }
 
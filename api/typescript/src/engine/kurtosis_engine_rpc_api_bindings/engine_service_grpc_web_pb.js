@@ -139,6 +139,250 @@ proto.engine_api.EngineServicePromiseClient.prototype.getEngineInfo =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.NegotiateApiVersionArgs,
+ *   !proto.engine_api.NegotiateApiVersionResponse>}
+ */
+const methodDescriptor_EngineService_NegotiateApiVersion = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/NegotiateApiVersion',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.NegotiateApiVersionArgs,
+  proto.engine_api.NegotiateApiVersionResponse,
+  /**
+   * @param {!proto.engine_api.NegotiateApiVersionArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.NegotiateApiVersionResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.NegotiateApiVersionArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.NegotiateApiVersionResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.NegotiateApiVersionResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.negotiateApiVersion =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/NegotiateApiVersion',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_NegotiateApiVersion,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.NegotiateApiVersionArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.NegotiateApiVersionResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.negotiateApiVersion =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/NegotiateApiVersion',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_NegotiateApiVersion);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.UpdateEngineConfigArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_UpdateEngineConfig = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/UpdateEngineConfig',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.UpdateEngineConfigArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.UpdateEngineConfigArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.UpdateEngineConfigArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.updateEngineConfig =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/UpdateEngineConfig',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_UpdateEngineConfig,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.UpdateEngineConfigArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.updateEngineConfig =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/UpdateEngineConfig',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_UpdateEngineConfig);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.GetAuditLogArgs,
+ *   !proto.engine_api.GetAuditLogResponse>}
+ */
+const methodDescriptor_EngineService_GetAuditLog = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetAuditLog',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.GetAuditLogArgs,
+  proto.engine_api.GetAuditLogResponse,
+  /**
+   * @param {!proto.engine_api.GetAuditLogArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetAuditLogResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.GetAuditLogArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetAuditLogResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetAuditLogResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getAuditLog =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetAuditLog',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetAuditLog,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.GetAuditLogArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetAuditLogResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getAuditLog =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetAuditLog',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetAuditLog);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetEngineHealthResponse>}
+ */
+const methodDescriptor_EngineService_GetEngineHealth = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetEngineHealth',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetEngineHealthResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetEngineHealthResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetEngineHealthResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetEngineHealthResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getEngineHealth =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetEngineHealth',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEngineHealth,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetEngineHealthResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getEngineHealth =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetEngineHealth',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEngineHealth);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -261,6 +505,67 @@ proto.engine_api.EngineServicePromiseClient.prototype.getEnclaves =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.ListEnclavesArgs,
+ *   !proto.engine_api.ListEnclavesResponse>}
+ */
+const methodDescriptor_EngineService_ListEnclaves = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/ListEnclaves',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.ListEnclavesArgs,
+  proto.engine_api.ListEnclavesResponse,
+  /**
+   * @param {!proto.engine_api.ListEnclavesArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.ListEnclavesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.ListEnclavesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.ListEnclavesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.ListEnclavesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.listEnclaves =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/ListEnclaves',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ListEnclaves,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.ListEnclavesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.ListEnclavesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.listEnclaves =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/ListEnclaves',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ListEnclaves);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -444,6 +749,128 @@ proto.engine_api.EngineServicePromiseClient.prototype.destroyEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.ShareEnclaveArgs,
+ *   !proto.engine_api.ShareEnclaveResponse>}
+ */
+const methodDescriptor_EngineService_ShareEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/ShareEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.ShareEnclaveArgs,
+  proto.engine_api.ShareEnclaveResponse,
+  /**
+   * @param {!proto.engine_api.ShareEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.ShareEnclaveResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.ShareEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.ShareEnclaveResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.ShareEnclaveResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.shareEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/ShareEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ShareEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.ShareEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.ShareEnclaveResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.shareEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/ShareEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ShareEnclave);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.GetApiContainerTlsCredentialsArgs,
+ *   !proto.engine_api.ApiContainerTlsCredentials>}
+ */
+const methodDescriptor_EngineService_GetApiContainerTlsCredentials = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetApiContainerTlsCredentials',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.GetApiContainerTlsCredentialsArgs,
+  proto.engine_api.ApiContainerTlsCredentials,
+  /**
+   * @param {!proto.engine_api.GetApiContainerTlsCredentialsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.ApiContainerTlsCredentials.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.GetApiContainerTlsCredentialsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.ApiContainerTlsCredentials)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.ApiContainerTlsCredentials>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getApiContainerTlsCredentials =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetApiContainerTlsCredentials',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetApiContainerTlsCredentials,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.GetApiContainerTlsCredentialsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.ApiContainerTlsCredentials>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getApiContainerTlsCredentials =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetApiContainerTlsCredentials',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetApiContainerTlsCredentials);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -561,5 +988,66 @@ proto.engine_api.EngineServicePromiseClient.prototype.getServiceLogs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.GetServiceResourceUsageArgs,
+ *   !proto.engine_api.GetServiceResourceUsageResponse>}
+ */
+const methodDescriptor_EngineService_GetServiceResourceUsage = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetServiceResourceUsage',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.GetServiceResourceUsageArgs,
+  proto.engine_api.GetServiceResourceUsageResponse,
+  /**
+   * @param {!proto.engine_api.GetServiceResourceUsageArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetServiceResourceUsageResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.GetServiceResourceUsageArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetServiceResourceUsageResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetServiceResourceUsageResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getServiceResourceUsage =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetServiceResourceUsage',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetServiceResourceUsage,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.GetServiceResourceUsageArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetServiceResourceUsageResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getServiceResourceUsage =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetServiceResourceUsage',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetServiceResourceUsage);
+};
+
+
 module.exports = proto.engine_api;
 
@@ -8,6 +8,12 @@ export class GetEngineInfoResponse extends jspb.Message {
   getEngineVersion(): string;
   setEngineVersion(value: string): GetEngineInfoResponse;
 
+  getFipsMode(): boolean;
+  setFipsMode(value: boolean): GetEngineInfoResponse;
+
+  getValidatedCrypto(): boolean;
+  setValidatedCrypto(value: boolean): GetEngineInfoResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetEngineInfoResponse.AsObject;
   static toObject(includeInstance: boolean, msg: GetEngineInfoResponse): GetEngineInfoResponse.AsObject;
@@ -19,6 +25,226 @@ export class GetEngineInfoResponse extends jspb.Message {
 export namespace GetEngineInfoResponse {
   export type AsObject = {
     engineVersion: string,
+    fipsMode: boolean,
+    validatedCrypto: boolean,
+  }
+}
+
+export class NegotiateApiVersionArgs extends jspb.Message {
+  getClientVersion(): string;
+  setClientVersion(value: string): NegotiateApiVersionArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NegotiateApiVersionArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: NegotiateApiVersionArgs): NegotiateApiVersionArgs.AsObject;
+  static serializeBinaryToWriter(message: NegotiateApiVersionArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NegotiateApiVersionArgs;
+  static deserializeBinaryFromReader(message: NegotiateApiVersionArgs, reader: jspb.BinaryReader): NegotiateApiVersionArgs;
+}
+
+export namespace NegotiateApiVersionArgs {
+  export type AsObject = {
+    clientVersion: string,
+  }
+}
+
+export class NegotiateApiVersionResponse extends jspb.Message {
+  getEngineVersion(): string;
+  setEngineVersion(value: string): NegotiateApiVersionResponse;
+
+  getIsCompatible(): boolean;
+  setIsCompatible(value: boolean): NegotiateApiVersionResponse;
+
+  getMessage(): string;
+  setMessage(value: string): NegotiateApiVersionResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NegotiateApiVersionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: NegotiateApiVersionResponse): NegotiateApiVersionResponse.AsObject;
+  static serializeBinaryToWriter(message: NegotiateApiVersionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NegotiateApiVersionResponse;
+  static deserializeBinaryFromReader(message: NegotiateApiVersionResponse, reader: jspb.BinaryReader): NegotiateApiVersionResponse;
+}
+
+export namespace NegotiateApiVersionResponse {
+  export type AsObject = {
+    engineVersion: string,
+    isCompatible: boolean,
+    message: string,
+  }
+}
+
+export class GetEngineHealthResponse extends jspb.Message {
+  getStatus(): SubsystemHealthStatus;
+  setStatus(value: SubsystemHealthStatus): GetEngineHealthResponse;
+
+  getSubsystemsList(): Array<SubsystemHealth>;
+  setSubsystemsList(value: Array<SubsystemHealth>): GetEngineHealthResponse;
+  clearSubsystemsList(): GetEngineHealthResponse;
+  addSubsystems(value?: SubsystemHealth, index?: number): SubsystemHealth;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEngineHealthResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEngineHealthResponse): GetEngineHealthResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEngineHealthResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEngineHealthResponse;
+  static deserializeBinaryFromReader(message: GetEngineHealthResponse, reader: jspb.BinaryReader): GetEngineHealthResponse;
+}
+
+export namespace GetEngineHealthResponse {
+  export type AsObject = {
+    status: SubsystemHealthStatus,
+    subsystemsList: Array<SubsystemHealth.AsObject>,
+  }
+}
+
+export class SubsystemHealth extends jspb.Message {
+  getName(): string;
+  setName(value: string): SubsystemHealth;
+
+  getInstance(): string;
+  setInstance(value: string): SubsystemHealth;
+
+  getStatus(): SubsystemHealthStatus;
+  setStatus(value: SubsystemHealthStatus): SubsystemHealth;
+
+  getMessage(): string;
+  setMessage(value: string): SubsystemHealth;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SubsystemHealth.AsObject;
+  static toObject(includeInstance: boolean, msg: SubsystemHealth): SubsystemHealth.AsObject;
+  static serializeBinaryToWriter(message: SubsystemHealth, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubsystemHealth;
+  static deserializeBinaryFromReader(message: SubsystemHealth, reader: jspb.BinaryReader): SubsystemHealth;
+}
+
+export namespace SubsystemHealth {
+  export type AsObject = {
+    name: string,
+    instance: string,
+    status: SubsystemHealthStatus,
+    message: string,
+  }
+}
+
+export class UpdateEngineConfigArgs extends jspb.Message {
+  getConfigJson(): string;
+  setConfigJson(value: string): UpdateEngineConfigArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateEngineConfigArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateEngineConfigArgs): UpdateEngineConfigArgs.AsObject;
+  static serializeBinaryToWriter(message: UpdateEngineConfigArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateEngineConfigArgs;
+  static deserializeBinaryFromReader(message: UpdateEngineConfigArgs, reader: jspb.BinaryReader): UpdateEngineConfigArgs;
+}
+
+export namespace UpdateEngineConfigArgs {
+  export type AsObject = {
+    configJson: string,
+  }
+}
+
+export class GetAuditLogArgs extends jspb.Message {
+  getSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSince(value?: google_protobuf_timestamp_pb.Timestamp): GetAuditLogArgs;
+  hasSince(): boolean;
+  clearSince(): GetAuditLogArgs;
+
+  getPrincipal(): string;
+  setPrincipal(value: string): GetAuditLogArgs;
+  hasPrincipal(): boolean;
+  clearPrincipal(): GetAuditLogArgs;
+
+  getLimit(): number;
+  setLimit(value: number): GetAuditLogArgs;
+  hasLimit(): boolean;
+  clearLimit(): GetAuditLogArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAuditLogArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAuditLogArgs): GetAuditLogArgs.AsObject;
+  static serializeBinaryToWriter(message: GetAuditLogArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAuditLogArgs;
+  static deserializeBinaryFromReader(message: GetAuditLogArgs, reader: jspb.BinaryReader): GetAuditLogArgs;
+}
+
+export namespace GetAuditLogArgs {
+  export type AsObject = {
+    since?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    principal?: string,
+    limit?: number,
+  }
+
+  export enum PrincipalCase { 
+    _PRINCIPAL_NOT_SET = 0,
+    PRINCIPAL = 2,
+  }
+
+  export enum LimitCase { 
+    _LIMIT_NOT_SET = 0,
+    LIMIT = 3,
+  }
+}
+
+export class AuditLogEntry extends jspb.Message {
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): AuditLogEntry;
+  hasTimestamp(): boolean;
+  clearTimestamp(): AuditLogEntry;
+
+  getPrincipal(): string;
+  setPrincipal(value: string): AuditLogEntry;
+
+  getApi(): string;
+  setApi(value: string): AuditLogEntry;
+
+  getOperation(): string;
+  setOperation(value: string): AuditLogEntry;
+
+  getArgsDigest(): string;
+  setArgsDigest(value: string): AuditLogEntry;
+
+  getResult(): string;
+  setResult(value: string): AuditLogEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuditLogEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: AuditLogEntry): AuditLogEntry.AsObject;
+  static serializeBinaryToWriter(message: AuditLogEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuditLogEntry;
+  static deserializeBinaryFromReader(message: AuditLogEntry, reader: jspb.BinaryReader): AuditLogEntry;
+}
+
+export namespace AuditLogEntry {
+  export type AsObject = {
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    principal: string,
+    api: string,
+    operation: string,
+    argsDigest: string,
+    result: string,
+  }
+}
+
+export class GetAuditLogResponse extends jspb.Message {
+  getEntriesList(): Array<AuditLogEntry>;
+  setEntriesList(value: Array<AuditLogEntry>): GetAuditLogResponse;
+  clearEntriesList(): GetAuditLogResponse;
+  addEntries(value?: AuditLogEntry, index?: number): AuditLogEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAuditLogResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAuditLogResponse): GetAuditLogResponse.AsObject;
+  static serializeBinaryToWriter(message: GetAuditLogResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAuditLogResponse;
+  static deserializeBinaryFromReader(message: GetAuditLogResponse, reader: jspb.BinaryReader): GetAuditLogResponse;
+}
+
+export namespace GetAuditLogResponse {
+  export type AsObject = {
+    entriesList: Array<AuditLogEntry.AsObject>,
   }
 }
 
@@ -48,6 +274,31 @@ export class CreateEnclaveArgs extends jspb.Message {
   hasShouldApicRunInDebugMode(): boolean;
   clearShouldApicRunInDebugMode(): CreateEnclaveArgs;
 
+  getTtl(): string;
+  setTtl(value: string): CreateEnclaveArgs;
+  hasTtl(): boolean;
+  clearTtl(): CreateEnclaveArgs;
+
+  getClusterName(): string;
+  setClusterName(value: string): CreateEnclaveArgs;
+  hasClusterName(): boolean;
+  clearClusterName(): CreateEnclaveArgs;
+
+  getShouldSkipLogsCollection(): boolean;
+  setShouldSkipLogsCollection(value: boolean): CreateEnclaveArgs;
+  hasShouldSkipLogsCollection(): boolean;
+  clearShouldSkipLogsCollection(): CreateEnclaveArgs;
+
+  getShouldRestrictEgress(): boolean;
+  setShouldRestrictEgress(value: boolean): CreateEnclaveArgs;
+  hasShouldRestrictEgress(): boolean;
+  clearShouldRestrictEgress(): CreateEnclaveArgs;
+
+  getEgressAllowlistList(): Array<string>;
+  setEgressAllowlistList(value: Array<string>): CreateEnclaveArgs;
+  clearEgressAllowlistList(): CreateEnclaveArgs;
+  addEgressAllowlist(value: string, index?: number): CreateEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CreateEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CreateEnclaveArgs): CreateEnclaveArgs.AsObject;
@@ -63,6 +314,11 @@ export namespace CreateEnclaveArgs {
     apiContainerLogLevel?: string,
     mode?: EnclaveMode,
     shouldApicRunInDebugMode?: boolean,
+    ttl?: string,
+    clusterName?: string,
+    shouldSkipLogsCollection?: boolean,
+    shouldRestrictEgress?: boolean,
+    egressAllowlistList: Array<string>,
   }
 
   export enum EnclaveNameCase { 
@@ -89,6 +345,26 @@ export namespace CreateEnclaveArgs {
     _SHOULD_APIC_RUN_IN_DEBUG_MODE_NOT_SET = 0,
     SHOULD_APIC_RUN_IN_DEBUG_MODE = 5,
   }
+
+  export enum TtlCase { 
+    _TTL_NOT_SET = 0,
+    TTL = 6,
+  }
+
+  export enum ClusterNameCase { 
+    _CLUSTER_NAME_NOT_SET = 0,
+    CLUSTER_NAME = 7,
+  }
+
+  export enum ShouldSkipLogsCollectionCase { 
+    _SHOULD_SKIP_LOGS_COLLECTION_NOT_SET = 0,
+    SHOULD_SKIP_LOGS_COLLECTION = 8,
+  }
+
+  export enum ShouldRestrictEgressCase { 
+    _SHOULD_RESTRICT_EGRESS_NOT_SET = 0,
+    SHOULD_RESTRICT_EGRESS = 9,
+  }
 }
 
 export class CreateEnclaveResponse extends jspb.Message {
@@ -197,6 +473,24 @@ export class EnclaveInfo extends jspb.Message {
   getMode(): EnclaveMode;
   setMode(value: EnclaveMode): EnclaveInfo;
 
+  getOwner(): string;
+  setOwner(value: string): EnclaveInfo;
+  hasOwner(): boolean;
+  clearOwner(): EnclaveInfo;
+
+  getExpirationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpirationTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveInfo;
+  hasExpirationTime(): boolean;
+  clearExpirationTime(): EnclaveInfo;
+
+  getClusterName(): string;
+  setClusterName(value: string): EnclaveInfo;
+  hasClusterName(): boolean;
+  clearClusterName(): EnclaveInfo;
+
+  getIsApiContainerMtlsRequired(): boolean;
+  setIsApiContainerMtlsRequired(value: boolean): EnclaveInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnclaveInfo.AsObject;
   static toObject(includeInstance: boolean, msg: EnclaveInfo): EnclaveInfo.AsObject;
@@ -216,6 +510,55 @@ export namespace EnclaveInfo {
     apiContainerHostMachineInfo?: EnclaveAPIContainerHostMachineInfo.AsObject,
     creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     mode: EnclaveMode,
+    owner?: string,
+    expirationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    clusterName?: string,
+    isApiContainerMtlsRequired: boolean,
+  }
+
+  export enum OwnerCase { 
+    _OWNER_NOT_SET = 0,
+    OWNER = 10,
+  }
+
+  export enum ExpirationTimeCase { 
+    _EXPIRATION_TIME_NOT_SET = 0,
+    EXPIRATION_TIME = 11,
+  }
+
+  export enum ClusterNameCase { 
+    _CLUSTER_NAME_NOT_SET = 0,
+    CLUSTER_NAME = 12,
+  }
+}
+
+export class ApiContainerTlsCredentials extends jspb.Message {
+  getCertificateAuthority(): string;
+  setCertificateAuthority(value: string): ApiContainerTlsCredentials;
+
+  getClientCertificate(): string;
+  setClientCertificate(value: string): ApiContainerTlsCredentials;
+
+  getClientKey(): string;
+  setClientKey(value: string): ApiContainerTlsCredentials;
+
+  getFipsMode(): boolean;
+  setFipsMode(value: boolean): ApiContainerTlsCredentials;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ApiContainerTlsCredentials.AsObject;
+  static toObject(includeInstance: boolean, msg: ApiContainerTlsCredentials): ApiContainerTlsCredentials.AsObject;
+  static serializeBinaryToWriter(message: ApiContainerTlsCredentials, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ApiContainerTlsCredentials;
+  static deserializeBinaryFromReader(message: ApiContainerTlsCredentials, reader: jspb.BinaryReader): ApiContainerTlsCredentials;
+}
+
+export namespace ApiContainerTlsCredentials {
+  export type AsObject = {
+    certificateAuthority: string,
+    clientCertificate: string,
+    clientKey: string,
+    fipsMode: boolean,
   }
 }
 
@@ -237,6 +580,90 @@ export namespace GetEnclavesResponse {
   }
 }
 
+export class ListEnclavesArgs extends jspb.Message {
+  getStatusesList(): Array<EnclaveContainersStatus>;
+  setStatusesList(value: Array<EnclaveContainersStatus>): ListEnclavesArgs;
+  clearStatusesList(): ListEnclavesArgs;
+  addStatuses(value: EnclaveContainersStatus, index?: number): ListEnclavesArgs;
+
+  getOwner(): string;
+  setOwner(value: string): ListEnclavesArgs;
+  hasOwner(): boolean;
+  clearOwner(): ListEnclavesArgs;
+
+  getPageSize(): number;
+  setPageSize(value: number): ListEnclavesArgs;
+  hasPageSize(): boolean;
+  clearPageSize(): ListEnclavesArgs;
+
+  getPageToken(): string;
+  setPageToken(value: string): ListEnclavesArgs;
+  hasPageToken(): boolean;
+  clearPageToken(): ListEnclavesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListEnclavesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ListEnclavesArgs): ListEnclavesArgs.AsObject;
+  static serializeBinaryToWriter(message: ListEnclavesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListEnclavesArgs;
+  static deserializeBinaryFromReader(message: ListEnclavesArgs, reader: jspb.BinaryReader): ListEnclavesArgs;
+}
+
+export namespace ListEnclavesArgs {
+  export type AsObject = {
+    statusesList: Array<EnclaveContainersStatus>,
+    owner?: string,
+    pageSize?: number,
+    pageToken?: string,
+  }
+
+  export enum OwnerCase { 
+    _OWNER_NOT_SET = 0,
+    OWNER = 2,
+  }
+
+  export enum PageSizeCase { 
+    _PAGE_SIZE_NOT_SET = 0,
+    PAGE_SIZE = 3,
+  }
+
+  export enum PageTokenCase { 
+    _PAGE_TOKEN_NOT_SET = 0,
+    PAGE_TOKEN = 4,
+  }
+}
+
+export class ListEnclavesResponse extends jspb.Message {
+  getEnclaveInfosList(): Array<EnclaveInfo>;
+  setEnclaveInfosList(value: Array<EnclaveInfo>): ListEnclavesResponse;
+  clearEnclaveInfosList(): ListEnclavesResponse;
+  addEnclaveInfos(value?: EnclaveInfo, index?: number): EnclaveInfo;
+
+  getNextPageToken(): string;
+  setNextPageToken(value: string): ListEnclavesResponse;
+  hasNextPageToken(): boolean;
+  clearNextPageToken(): ListEnclavesResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListEnclavesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ListEnclavesResponse): ListEnclavesResponse.AsObject;
+  static serializeBinaryToWriter(message: ListEnclavesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListEnclavesResponse;
+  static deserializeBinaryFromReader(message: ListEnclavesResponse, reader: jspb.BinaryReader): ListEnclavesResponse;
+}
+
+export namespace ListEnclavesResponse {
+  export type AsObject = {
+    enclaveInfosList: Array<EnclaveInfo.AsObject>,
+    nextPageToken?: string,
+  }
+
+  export enum NextPageTokenCase { 
+    _NEXT_PAGE_TOKEN_NOT_SET = 0,
+    NEXT_PAGE_TOKEN = 2,
+  }
+}
+
 export class EnclaveIdentifiers extends jspb.Message {
   getEnclaveUuid(): string;
   setEnclaveUuid(value: string): EnclaveIdentifiers;
@@ -319,6 +746,81 @@ export namespace DestroyEnclaveArgs {
   }
 }
 
+export class ShareEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): ShareEnclaveArgs;
+
+  getPrincipal(): string;
+  setPrincipal(value: string): ShareEnclaveArgs;
+
+  getRevoke(): boolean;
+  setRevoke(value: boolean): ShareEnclaveArgs;
+  hasRevoke(): boolean;
+  clearRevoke(): ShareEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ShareEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ShareEnclaveArgs): ShareEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: ShareEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ShareEnclaveArgs;
+  static deserializeBinaryFromReader(message: ShareEnclaveArgs, reader: jspb.BinaryReader): ShareEnclaveArgs;
+}
+
+export namespace ShareEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    principal: string,
+    revoke?: boolean,
+  }
+
+  export enum RevokeCase { 
+    _REVOKE_NOT_SET = 0,
+    REVOKE = 3,
+  }
+}
+
+export class ShareEnclaveResponse extends jspb.Message {
+  getOwner(): string;
+  setOwner(value: string): ShareEnclaveResponse;
+
+  getGranteesList(): Array<string>;
+  setGranteesList(value: Array<string>): ShareEnclaveResponse;
+  clearGranteesList(): ShareEnclaveResponse;
+  addGrantees(value: string, index?: number): ShareEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ShareEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ShareEnclaveResponse): ShareEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: ShareEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ShareEnclaveResponse;
+  static deserializeBinaryFromReader(message: ShareEnclaveResponse, reader: jspb.BinaryReader): ShareEnclaveResponse;
+}
+
+export namespace ShareEnclaveResponse {
+  export type AsObject = {
+    owner: string,
+    granteesList: Array<string>,
+  }
+}
+
+export class GetApiContainerTlsCredentialsArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): GetApiContainerTlsCredentialsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetApiContainerTlsCredentialsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetApiContainerTlsCredentialsArgs): GetApiContainerTlsCredentialsArgs.AsObject;
+  static serializeBinaryToWriter(message: GetApiContainerTlsCredentialsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetApiContainerTlsCredentialsArgs;
+  static deserializeBinaryFromReader(message: GetApiContainerTlsCredentialsArgs, reader: jspb.BinaryReader): GetApiContainerTlsCredentialsArgs;
+}
+
+export namespace GetApiContainerTlsCredentialsArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
 export class CleanArgs extends jspb.Message {
   getShouldCleanAll(): boolean;
   setShouldCleanAll(value: boolean): CleanArgs;
@@ -517,6 +1019,127 @@ export namespace LogLineFilter {
   }
 }
 
+export class GetServiceResourceUsageArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): GetServiceResourceUsageArgs;
+
+  getSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSince(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceResourceUsageArgs;
+  hasSince(): boolean;
+  clearSince(): GetServiceResourceUsageArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceResourceUsageArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceResourceUsageArgs): GetServiceResourceUsageArgs.AsObject;
+  static serializeBinaryToWriter(message: GetServiceResourceUsageArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceResourceUsageArgs;
+  static deserializeBinaryFromReader(message: GetServiceResourceUsageArgs, reader: jspb.BinaryReader): GetServiceResourceUsageArgs;
+}
+
+export namespace GetServiceResourceUsageArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    since?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class GetServiceResourceUsageResponse extends jspb.Message {
+  getResourceUsageByServiceUuidMap(): jspb.Map<string, ResourceUsageSeries>;
+  clearResourceUsageByServiceUuidMap(): GetServiceResourceUsageResponse;
+
+  getSamplingIntervalSeconds(): number;
+  setSamplingIntervalSeconds(value: number): GetServiceResourceUsageResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceResourceUsageResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceResourceUsageResponse): GetServiceResourceUsageResponse.AsObject;
+  static serializeBinaryToWriter(message: GetServiceResourceUsageResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceResourceUsageResponse;
+  static deserializeBinaryFromReader(message: GetServiceResourceUsageResponse, reader: jspb.BinaryReader): GetServiceResourceUsageResponse;
+}
+
+export namespace GetServiceResourceUsageResponse {
+  export type AsObject = {
+    resourceUsageByServiceUuidMap: Array<[string, ResourceUsageSeries.AsObject]>,
+    samplingIntervalSeconds: number,
+  }
+}
+
+export class ResourceUsageSeries extends jspb.Message {
+  getSamplesList(): Array<ResourceUsageSample>;
+  setSamplesList(value: Array<ResourceUsageSample>): ResourceUsageSeries;
+  clearSamplesList(): ResourceUsageSeries;
+  addSamples(value?: ResourceUsageSample, index?: number): ResourceUsageSample;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResourceUsageSeries.AsObject;
+  static toObject(includeInstance: boolean, msg: ResourceUsageSeries): ResourceUsageSeries.AsObject;
+  static serializeBinaryToWriter(message: ResourceUsageSeries, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResourceUsageSeries;
+  static deserializeBinaryFromReader(message: ResourceUsageSeries, reader: jspb.BinaryReader): ResourceUsageSeries;
+}
+
+export namespace ResourceUsageSeries {
+  export type AsObject = {
+    samplesList: Array<ResourceUsageSample.AsObject>,
+  }
+}
+
+export class ResourceUsageSample extends jspb.Message {
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): ResourceUsageSample;
+  hasTimestamp(): boolean;
+  clearTimestamp(): ResourceUsageSample;
+
+  getCpuMilliCores(): number;
+  setCpuMilliCores(value: number): ResourceUsageSample;
+
+  getMemoryBytes(): number;
+  setMemoryBytes(value: number): ResourceUsageSample;
+
+  getNetworkRxBytes(): number;
+  setNetworkRxBytes(value: number): ResourceUsageSample;
+  hasNetworkRxBytes(): boolean;
+  clearNetworkRxBytes(): ResourceUsageSample;
+
+  getNetworkTxBytes(): number;
+  setNetworkTxBytes(value: number): ResourceUsageSample;
+  hasNetworkTxBytes(): boolean;
+  clearNetworkTxBytes(): ResourceUsageSample;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResourceUsageSample.AsObject;
+  static toObject(includeInstance: boolean, msg: ResourceUsageSample): ResourceUsageSample.AsObject;
+  static serializeBinaryToWriter(message: ResourceUsageSample, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResourceUsageSample;
+  static deserializeBinaryFromReader(message: ResourceUsageSample, reader: jspb.BinaryReader): ResourceUsageSample;
+}
+
+export namespace ResourceUsageSample {
+  export type AsObject = {
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    cpuMilliCores: number,
+    memoryBytes: number,
+    networkRxBytes?: number,
+    networkTxBytes?: number,
+  }
+
+  export enum NetworkRxBytesCase { 
+    _NETWORK_RX_BYTES_NOT_SET = 0,
+    NETWORK_RX_BYTES = 4,
+  }
+
+  export enum NetworkTxBytesCase { 
+    _NETWORK_TX_BYTES_NOT_SET = 0,
+    NETWORK_TX_BYTES = 5,
+  }
+}
+
+export enum SubsystemHealthStatus { 
+  SUBSYSTEMHEALTHSTATUS_HEALTHY = 0,
+  SUBSYSTEMHEALTHSTATUS_DEGRADED = 1,
+  SUBSYSTEMHEALTHSTATUS_UNHEALTHY = 2,
+}
 export enum EnclaveMode { 
   TEST = 0,
   PRODUCTION = 1,
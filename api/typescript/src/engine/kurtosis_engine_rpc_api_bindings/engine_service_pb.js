@@ -25,6 +25,8 @@ var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb
 goog.object.extend(proto, google_protobuf_empty_pb);
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.engine_api.ApiContainerTlsCredentials', null, global);
+goog.exportSymbol('proto.engine_api.AuditLogEntry', null, global);
 goog.exportSymbol('proto.engine_api.CleanArgs', null, global);
 goog.exportSymbol('proto.engine_api.CleanResponse', null, global);
 goog.exportSymbol('proto.engine_api.CreateEnclaveArgs', null, global);
@@ -38,15 +40,32 @@ goog.exportSymbol('proto.engine_api.EnclaveIdentifiers', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveMode', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveNameAndUuid', null, global);
+goog.exportSymbol('proto.engine_api.GetApiContainerTlsCredentialsArgs', null, global);
+goog.exportSymbol('proto.engine_api.GetAuditLogArgs', null, global);
+goog.exportSymbol('proto.engine_api.GetAuditLogResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetEngineHealthResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEngineInfoResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetServiceResourceUsageArgs', null, global);
+goog.exportSymbol('proto.engine_api.GetServiceResourceUsageResponse', null, global);
+goog.exportSymbol('proto.engine_api.ListEnclavesArgs', null, global);
+goog.exportSymbol('proto.engine_api.ListEnclavesResponse', null, global);
 goog.exportSymbol('proto.engine_api.LogLine', null, global);
 goog.exportSymbol('proto.engine_api.LogLineFilter', null, global);
 goog.exportSymbol('proto.engine_api.LogLineOperator', null, global);
+goog.exportSymbol('proto.engine_api.NegotiateApiVersionArgs', null, global);
+goog.exportSymbol('proto.engine_api.NegotiateApiVersionResponse', null, global);
+goog.exportSymbol('proto.engine_api.ResourceUsageSample', null, global);
+goog.exportSymbol('proto.engine_api.ResourceUsageSeries', null, global);
+goog.exportSymbol('proto.engine_api.ShareEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.ShareEnclaveResponse', null, global);
 goog.exportSymbol('proto.engine_api.StopEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.SubsystemHealth', null, global);
+goog.exportSymbol('proto.engine_api.SubsystemHealthStatus', null, global);
+goog.exportSymbol('proto.engine_api.UpdateEngineConfigArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -78,9 +97,177 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.engine_api.CreateEnclaveArgs = function(opt_data) {
+proto.engine_api.NegotiateApiVersionArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.NegotiateApiVersionArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.NegotiateApiVersionArgs.displayName = 'proto.engine_api.NegotiateApiVersionArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.NegotiateApiVersionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.NegotiateApiVersionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.NegotiateApiVersionResponse.displayName = 'proto.engine_api.NegotiateApiVersionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEngineHealthResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.GetEngineHealthResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.GetEngineHealthResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEngineHealthResponse.displayName = 'proto.engine_api.GetEngineHealthResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.SubsystemHealth = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.SubsystemHealth, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.SubsystemHealth.displayName = 'proto.engine_api.SubsystemHealth';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.UpdateEngineConfigArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.UpdateEngineConfigArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.UpdateEngineConfigArgs.displayName = 'proto.engine_api.UpdateEngineConfigArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetAuditLogArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetAuditLogArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetAuditLogArgs.displayName = 'proto.engine_api.GetAuditLogArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.AuditLogEntry = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.engine_api.AuditLogEntry, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.AuditLogEntry.displayName = 'proto.engine_api.AuditLogEntry';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetAuditLogResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.GetAuditLogResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.GetAuditLogResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetAuditLogResponse.displayName = 'proto.engine_api.GetAuditLogResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.CreateEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.CreateEnclaveArgs.repeatedFields_, null);
+};
 goog.inherits(proto.engine_api.CreateEnclaveArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
@@ -173,6 +360,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.EnclaveInfo.displayName = 'proto.engine_api.EnclaveInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ApiContainerTlsCredentials = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ApiContainerTlsCredentials, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ApiContainerTlsCredentials.displayName = 'proto.engine_api.ApiContainerTlsCredentials';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -194,6 +402,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.GetEnclavesResponse.displayName = 'proto.engine_api.GetEnclavesResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ListEnclavesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.ListEnclavesArgs.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.ListEnclavesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ListEnclavesArgs.displayName = 'proto.engine_api.ListEnclavesArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ListEnclavesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.ListEnclavesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.ListEnclavesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ListEnclavesResponse.displayName = 'proto.engine_api.ListEnclavesResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -278,6 +528,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.DestroyEnclaveArgs.displayName = 'proto.engine_api.DestroyEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ShareEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ShareEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ShareEnclaveArgs.displayName = 'proto.engine_api.ShareEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ShareEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.ShareEnclaveResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.ShareEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ShareEnclaveResponse.displayName = 'proto.engine_api.ShareEnclaveResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetApiContainerTlsCredentialsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetApiContainerTlsCredentialsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetApiContainerTlsCredentialsArgs.displayName = 'proto.engine_api.GetApiContainerTlsCredentialsArgs';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -425,15 +738,99 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.LogLineFilter.displayName = 'proto.engine_api.LogLineFilter';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetServiceResourceUsageArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetServiceResourceUsageArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetServiceResourceUsageArgs.displayName = 'proto.engine_api.GetServiceResourceUsageArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetServiceResourceUsageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetServiceResourceUsageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetServiceResourceUsageResponse.displayName = 'proto.engine_api.GetServiceResourceUsageResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ResourceUsageSeries = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.ResourceUsageSeries.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.ResourceUsageSeries, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ResourceUsageSeries.displayName = 'proto.engine_api.ResourceUsageSeries';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ResourceUsageSample = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ResourceUsageSample, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ResourceUsageSample.displayName = 'proto.engine_api.ResourceUsageSample';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
  * For the list of reserved names please see:
  *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
  * @param {boolean=} opt_includeInstance Deprecated. whether to include the
@@ -457,7 +854,9 @@ proto.engine_api.GetEngineInfoResponse.prototype.toObject = function(opt_include
  */
 proto.engine_api.GetEngineInfoResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, "")
+    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    fipsMode: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    validatedCrypto: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -498,6 +897,14 @@ proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader = function(ms
       var value = /** @type {string} */ (reader.readString());
       msg.setEngineVersion(value);
       break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFipsMode(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setValidatedCrypto(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -534,6 +941,20 @@ proto.engine_api.GetEngineInfoResponse.serializeBinaryToWriter = function(messag
       f
     );
   }
+  f = message.getFipsMode();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getValidatedCrypto();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -555,6 +976,40 @@ proto.engine_api.GetEngineInfoResponse.prototype.setEngineVersion = function(val
 };
 
 
+/**
+ * optional bool fips_mode = 2;
+ * @return {boolean}
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.getFipsMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.setFipsMode = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool validated_crypto = 3;
+ * @return {boolean}
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.getValidatedCrypto = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.setValidatedCrypto = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
 
 
 
@@ -571,8 +1026,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.NegotiateApiVersionArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.NegotiateApiVersionArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -581,17 +1036,13 @@ proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.NegotiateApiVersionArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.NegotiateApiVersionArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    shouldApicRunInDebugMode: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    clientVersion: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -605,23 +1056,23 @@ proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveArgs}
+ * @return {!proto.engine_api.NegotiateApiVersionArgs}
  */
-proto.engine_api.CreateEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.NegotiateApiVersionArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveArgs;
-  return proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.NegotiateApiVersionArgs;
+  return proto.engine_api.NegotiateApiVersionArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.NegotiateApiVersionArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveArgs}
+ * @return {!proto.engine_api.NegotiateApiVersionArgs}
  */
-proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.NegotiateApiVersionArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -630,23 +1081,7 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApiContainerVersionTag(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApiContainerLogLevel(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
-      msg.setMode(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldApicRunInDebugMode(value);
+      msg.setClientVersion(value);
       break;
     default:
       reader.skipField();
@@ -661,9 +1096,9 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.NegotiateApiVersionArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.NegotiateApiVersionArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -671,230 +1106,236 @@ proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveArgs} message
+ * @param {!proto.engine_api.NegotiateApiVersionArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.NegotiateApiVersionArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {string} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
+  f = message.getClientVersion();
+  if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string enclave_name = 1;
+ * optional string client_version = 1;
  * @return {string}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getEnclaveName = function() {
+proto.engine_api.NegotiateApiVersionArgs.prototype.getClientVersion = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @return {!proto.engine_api.NegotiateApiVersionArgs} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setEnclaveName = function(value) {
-  return jspb.Message.setField(this, 1, value);
+proto.engine_api.NegotiateApiVersionArgs.prototype.setClientVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearEnclaveName = function() {
-  return jspb.Message.setField(this, 1, undefined);
-};
-
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasEnclaveName = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.engine_api.NegotiateApiVersionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.NegotiateApiVersionResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional string api_container_version_tag = 2;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.NegotiateApiVersionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerVersionTag = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
+proto.engine_api.NegotiateApiVersionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    isCompatible: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    message: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
 
-/**
- * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
-  return jspb.Message.setField(this, 2, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.NegotiateApiVersionResponse}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerVersionTag = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.engine_api.NegotiateApiVersionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.NegotiateApiVersionResponse;
+  return proto.engine_api.NegotiateApiVersionResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.NegotiateApiVersionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.NegotiateApiVersionResponse}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerVersionTag = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional string api_container_log_level = 3;
- * @return {string}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerLogLevel = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.engine_api.NegotiateApiVersionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEngineVersion(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsCompatible(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerLogLevel = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.engine_api.NegotiateApiVersionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.NegotiateApiVersionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.NegotiateApiVersionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerLogLevel = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.engine_api.NegotiateApiVersionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEngineVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getIsCompatible();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional EnclaveMode mode = 4;
- * @return {!proto.engine_api.EnclaveMode}
+ * optional string engine_version = 1;
+ * @return {string}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getMode = function() {
-  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.engine_api.NegotiateApiVersionResponse.prototype.getEngineVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveMode} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.NegotiateApiVersionResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.engine_api.NegotiateApiVersionResponse.prototype.setEngineVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * optional bool is_compatible = 2;
+ * @return {boolean}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.clearMode = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.engine_api.NegotiateApiVersionResponse.prototype.getIsCompatible = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {boolean} value
+ * @return {!proto.engine_api.NegotiateApiVersionResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasMode = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.engine_api.NegotiateApiVersionResponse.prototype.setIsCompatible = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * optional bool should_apic_run_in_debug_mode = 5;
- * @return {boolean}
+ * optional string message = 3;
+ * @return {string}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getShouldApicRunInDebugMode = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.engine_api.NegotiateApiVersionResponse.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.NegotiateApiVersionResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setShouldApicRunInDebugMode = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.engine_api.NegotiateApiVersionResponse.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearShouldApicRunInDebugMode = function() {
-  return jspb.Message.setField(this, 5, undefined);
-};
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasShouldApicRunInDebugMode = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
-
-
+proto.engine_api.GetEngineHealthResponse.repeatedFields_ = [2];
 
 
 
@@ -911,8 +1352,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+proto.engine_api.GetEngineHealthResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEngineHealthResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -921,13 +1362,15 @@ proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetEngineHealthResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.GetEngineHealthResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+    status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    subsystemsList: jspb.Message.toObjectList(msg.getSubsystemsList(),
+    proto.engine_api.SubsystemHealth.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -941,23 +1384,23 @@ proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.GetEngineHealthResponse}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+proto.engine_api.GetEngineHealthResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveResponse;
-  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetEngineHealthResponse;
+  return proto.engine_api.GetEngineHealthResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetEngineHealthResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.GetEngineHealthResponse}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetEngineHealthResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -965,9 +1408,13 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
-      msg.setEnclaveInfo(value);
+      var value = /** @type {!proto.engine_api.SubsystemHealthStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = new proto.engine_api.SubsystemHealth;
+      reader.readMessage(value,proto.engine_api.SubsystemHealth.deserializeBinaryFromReader);
+      msg.addSubsystems(value);
       break;
     default:
       reader.skipField();
@@ -982,9 +1429,9 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+proto.engine_api.GetEngineHealthResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetEngineHealthResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -992,60 +1439,84 @@ proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveResponse} message
+ * @param {!proto.engine_api.GetEngineHealthResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetEngineHealthResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveInfo();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
+      f
+    );
+  }
+  f = message.getSubsystemsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
       f,
-      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+      proto.engine_api.SubsystemHealth.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional EnclaveInfo enclave_info = 1;
- * @return {?proto.engine_api.EnclaveInfo}
+ * optional SubsystemHealthStatus status = 1;
+ * @return {!proto.engine_api.SubsystemHealthStatus}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.getEnclaveInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
+proto.engine_api.GetEngineHealthResponse.prototype.getStatus = function() {
+  return /** @type {!proto.engine_api.SubsystemHealthStatus} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {?proto.engine_api.EnclaveInfo|undefined} value
- * @return {!proto.engine_api.CreateEnclaveResponse} returns this
-*/
-proto.engine_api.CreateEnclaveResponse.prototype.setEnclaveInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {!proto.engine_api.SubsystemHealthStatus} value
+ * @return {!proto.engine_api.GetEngineHealthResponse} returns this
+ */
+proto.engine_api.GetEngineHealthResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveResponse} returns this
+ * repeated SubsystemHealth subsystems = 2;
+ * @return {!Array<!proto.engine_api.SubsystemHealth>}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.clearEnclaveInfo = function() {
-  return this.setEnclaveInfo(undefined);
+proto.engine_api.GetEngineHealthResponse.prototype.getSubsystemsList = function() {
+  return /** @type{!Array<!proto.engine_api.SubsystemHealth>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.SubsystemHealth, 2));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<!proto.engine_api.SubsystemHealth>} value
+ * @return {!proto.engine_api.GetEngineHealthResponse} returns this
+*/
+proto.engine_api.GetEngineHealthResponse.prototype.setSubsystemsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.SubsystemHealth=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.SubsystemHealth}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.hasEnclaveInfo = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.engine_api.GetEngineHealthResponse.prototype.addSubsystems = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.engine_api.SubsystemHealth, opt_index);
 };
 
 
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetEngineHealthResponse} returns this
+ */
+proto.engine_api.GetEngineHealthResponse.prototype.clearSubsystemsList = function() {
+  return this.setSubsystemsList([]);
+};
 
 
 
@@ -1062,8 +1533,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveAPIContainerInfo.toObject(opt_includeInstance, this);
+proto.engine_api.SubsystemHealth.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.SubsystemHealth.toObject(opt_includeInstance, this);
 };
 
 
@@ -1072,16 +1543,16 @@ proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The msg instance to transform.
+ * @param {!proto.engine_api.SubsystemHealth} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, msg) {
+proto.engine_api.SubsystemHealth.toObject = function(includeInstance, msg) {
   var f, obj = {
-    containerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    ipInsideEnclave: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    grpcPortInsideEnclave: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    bridgeIpAddress: jspb.Message.getFieldWithDefault(msg, 6, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    instance: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    message: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -1095,23 +1566,23 @@ proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ * @return {!proto.engine_api.SubsystemHealth}
  */
-proto.engine_api.EnclaveAPIContainerInfo.deserializeBinary = function(bytes) {
+proto.engine_api.SubsystemHealth.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveAPIContainerInfo;
-  return proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.SubsystemHealth;
+  return proto.engine_api.SubsystemHealth.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The message object to deserialize into.
+ * @param {!proto.engine_api.SubsystemHealth} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ * @return {!proto.engine_api.SubsystemHealth}
  */
-proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.SubsystemHealth.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1120,19 +1591,19 @@ proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setContainerId(value);
+      msg.setName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setIpInsideEnclave(value);
+      msg.setInstance(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setGrpcPortInsideEnclave(value);
+      var value = /** @type {!proto.engine_api.SubsystemHealthStatus} */ (reader.readEnum());
+      msg.setStatus(value);
       break;
-    case 6:
+    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBridgeIpAddress(value);
+      msg.setMessage(value);
       break;
     default:
       reader.skipField();
@@ -1147,9 +1618,9 @@ proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function() {
+proto.engine_api.SubsystemHealth.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter(this, writer);
+  proto.engine_api.SubsystemHealth.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1157,37 +1628,37 @@ proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} message
+ * @param {!proto.engine_api.SubsystemHealth} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.SubsystemHealth.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getContainerId();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getIpInsideEnclave();
+  f = message.getInstance();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getGrpcPortInsideEnclave();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
       3,
       f
     );
   }
-  f = message.getBridgeIpAddress();
+  f = message.getMessage();
   if (f.length > 0) {
     writer.writeString(
-      6,
+      4,
       f
     );
   }
@@ -1195,80 +1666,76 @@ proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter = function(mess
 
 
 /**
- * optional string container_id = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getContainerId = function() {
+proto.engine_api.SubsystemHealth.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @return {!proto.engine_api.SubsystemHealth} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setContainerId = function(value) {
+proto.engine_api.SubsystemHealth.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string ip_inside_enclave = 2;
+ * optional string instance = 2;
  * @return {string}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getIpInsideEnclave = function() {
+proto.engine_api.SubsystemHealth.prototype.getInstance = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @return {!proto.engine_api.SubsystemHealth} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setIpInsideEnclave = function(value) {
+proto.engine_api.SubsystemHealth.prototype.setInstance = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional uint32 grpc_port_inside_enclave = 3;
- * @return {number}
+ * optional SubsystemHealthStatus status = 3;
+ * @return {!proto.engine_api.SubsystemHealthStatus}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getGrpcPortInsideEnclave = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.engine_api.SubsystemHealth.prototype.getStatus = function() {
+  return /** @type {!proto.engine_api.SubsystemHealthStatus} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @param {!proto.engine_api.SubsystemHealthStatus} value
+ * @return {!proto.engine_api.SubsystemHealth} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setGrpcPortInsideEnclave = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.engine_api.SubsystemHealth.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
 /**
- * optional string bridge_ip_address = 6;
+ * optional string message = 4;
  * @return {string}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getBridgeIpAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.engine_api.SubsystemHealth.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @return {!proto.engine_api.SubsystemHealth} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setBridgeIpAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.engine_api.SubsystemHealth.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
-
-
-
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1282,8 +1749,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(opt_includeInstance, this);
+proto.engine_api.UpdateEngineConfigArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.UpdateEngineConfigArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -1292,14 +1759,13 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The msg instance to transform.
+ * @param {!proto.engine_api.UpdateEngineConfigArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject = function(includeInstance, msg) {
+proto.engine_api.UpdateEngineConfigArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ipOnHostMachine: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    grpcPortOnHostMachine: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    configJson: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -1313,36 +1779,32 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * @return {!proto.engine_api.UpdateEngineConfigArgs}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinary = function(bytes) {
+proto.engine_api.UpdateEngineConfigArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
-  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.UpdateEngineConfigArgs;
+  return proto.engine_api.UpdateEngineConfigArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The message object to deserialize into.
+ * @param {!proto.engine_api.UpdateEngineConfigArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * @return {!proto.engine_api.UpdateEngineConfigArgs}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.UpdateEngineConfigArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 4:
+    case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setIpOnHostMachine(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setGrpcPortOnHostMachine(value);
+      msg.setConfigJson(value);
       break;
     default:
       reader.skipField();
@@ -1357,9 +1819,9 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.serializeBinary = function() {
+proto.engine_api.UpdateEngineConfigArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter(this, writer);
+  proto.engine_api.UpdateEngineConfigArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1367,23 +1829,16 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} message
+ * @param {!proto.engine_api.UpdateEngineConfigArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.UpdateEngineConfigArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIpOnHostMachine();
+  f = message.getConfigJson();
   if (f.length > 0) {
     writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getGrpcPortOnHostMachine();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
+      1,
       f
     );
   }
@@ -1391,38 +1846,20 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter = fu
 
 
 /**
- * optional string ip_on_host_machine = 4;
+ * optional string config_json = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getIpOnHostMachine = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.engine_api.UpdateEngineConfigArgs.prototype.getConfigJson = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
- */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setIpOnHostMachine = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional uint32 grpc_port_on_host_machine = 5;
- * @return {number}
- */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getGrpcPortOnHostMachine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ * @return {!proto.engine_api.UpdateEngineConfigArgs} returns this
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setGrpcPortOnHostMachine = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.engine_api.UpdateEngineConfigArgs.prototype.setConfigJson = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -1442,8 +1879,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveInfo.toObject(opt_includeInstance, this);
+proto.engine_api.GetAuditLogArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetAuditLogArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -1452,21 +1889,15 @@ proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveInfo} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetAuditLogArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
+proto.engine_api.GetAuditLogArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    containersStatus: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    apiContainerStatus: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
-    apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
-    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    mode: jspb.Message.getFieldWithDefault(msg, 9, 0)
+    since: (f = msg.getSince()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    principal: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    limit: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -1480,23 +1911,23 @@ proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveInfo}
+ * @return {!proto.engine_api.GetAuditLogArgs}
  */
-proto.engine_api.EnclaveInfo.deserializeBinary = function(bytes) {
+proto.engine_api.GetAuditLogArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveInfo;
-  return proto.engine_api.EnclaveInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetAuditLogArgs;
+  return proto.engine_api.GetAuditLogArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveInfo} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetAuditLogArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveInfo}
+ * @return {!proto.engine_api.GetAuditLogArgs}
  */
-proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetAuditLogArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1504,43 +1935,17 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveUuid(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setSince(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setPrincipal(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.engine_api.EnclaveContainersStatus} */ (reader.readEnum());
-      msg.setContainersStatus(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (reader.readEnum());
-      msg.setApiContainerStatus(value);
-      break;
-    case 6:
-      var value = new proto.engine_api.EnclaveAPIContainerInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader);
-      msg.setApiContainerInfo(value);
-      break;
-    case 7:
-      var value = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader);
-      msg.setApiContainerHostMachineInfo(value);
-      break;
-    case 8:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setCreationTime(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLimit(value);
       break;
     default:
       reader.skipField();
@@ -1555,9 +1960,9 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
+proto.engine_api.GetAuditLogArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveInfo.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetAuditLogArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1565,75 +1970,31 @@ proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveInfo} message
+ * @param {!proto.engine_api.GetAuditLogArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetAuditLogArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getContainersStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-  f = message.getApiContainerStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
-  f = message.getApiContainerInfo();
+  f = message.getSince();
   if (f != null) {
     writer.writeMessage(
-      6,
+      1,
       f,
-      proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getApiContainerHostMachineInfo();
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
   if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter
+    writer.writeString(
+      2,
+      f
     );
   }
-  f = message.getCreationTime();
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
   if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getMode();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
+    writer.writeUint32(
+      3,
       f
     );
   }
@@ -1641,194 +2002,313 @@ proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer)
 
 
 /**
- * optional string enclave_uuid = 1;
- * @return {string}
+ * optional google.protobuf.Timestamp since = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.EnclaveInfo.prototype.getEnclaveUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.GetAuditLogArgs.prototype.getSince = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
- */
-proto.engine_api.EnclaveInfo.prototype.setEnclaveUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
+*/
+proto.engine_api.GetAuditLogArgs.prototype.setSince = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.GetAuditLogArgs.prototype.clearSince = function() {
+  return this.setSince(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.GetAuditLogArgs.prototype.hasSince = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional string shortened_uuid = 3;
+ * optional string principal = 2;
  * @return {string}
  */
-proto.engine_api.EnclaveInfo.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.engine_api.GetAuditLogArgs.prototype.getPrincipal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.engine_api.GetAuditLogArgs.prototype.setPrincipal = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional EnclaveContainersStatus containers_status = 4;
- * @return {!proto.engine_api.EnclaveContainersStatus}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.getContainersStatus = function() {
-  return /** @type {!proto.engine_api.EnclaveContainersStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.engine_api.GetAuditLogArgs.prototype.clearPrincipal = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveContainersStatus} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.setContainersStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.engine_api.GetAuditLogArgs.prototype.hasPrincipal = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional EnclaveAPIContainerStatus api_container_status = 5;
- * @return {!proto.engine_api.EnclaveAPIContainerStatus}
+ * optional uint32 limit = 3;
+ * @return {number}
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerStatus = function() {
-  return /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.engine_api.GetAuditLogArgs.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveAPIContainerStatus} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setApiContainerStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.engine_api.GetAuditLogArgs.prototype.setLimit = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional EnclaveAPIContainerInfo api_container_info = 6;
- * @return {?proto.engine_api.EnclaveAPIContainerInfo}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveAPIContainerInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerInfo, 6));
+proto.engine_api.GetAuditLogArgs.prototype.clearLimit = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
 /**
- * @param {?proto.engine_api.EnclaveAPIContainerInfo|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
-*/
-proto.engine_api.EnclaveInfo.prototype.setApiContainerInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetAuditLogArgs.prototype.hasLimit = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.engine_api.EnclaveInfo.prototype.clearApiContainerInfo = function() {
-  return this.setApiContainerInfo(undefined);
+proto.engine_api.AuditLogEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.AuditLogEntry.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.AuditLogEntry} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveInfo.prototype.hasApiContainerInfo = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.engine_api.AuditLogEntry.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    principal: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    api: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    operation: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    argsDigest: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    result: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional EnclaveAPIContainerHostMachineInfo api_container_host_machine_info = 7;
- * @return {?proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.AuditLogEntry}
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerHostMachineInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveAPIContainerHostMachineInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerHostMachineInfo, 7));
+proto.engine_api.AuditLogEntry.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.AuditLogEntry;
+  return proto.engine_api.AuditLogEntry.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.engine_api.EnclaveAPIContainerHostMachineInfo|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
-*/
-proto.engine_api.EnclaveInfo.prototype.setApiContainerHostMachineInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.AuditLogEntry} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.AuditLogEntry}
+ */
+proto.engine_api.AuditLogEntry.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrincipal(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApi(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOperation(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArgsDigest(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResult(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveInfo.prototype.clearApiContainerHostMachineInfo = function() {
-  return this.setApiContainerHostMachineInfo(undefined);
+proto.engine_api.AuditLogEntry.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.AuditLogEntry.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.AuditLogEntry} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveInfo.prototype.hasApiContainerHostMachineInfo = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.engine_api.AuditLogEntry.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getPrincipal();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getApi();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getOperation();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getArgsDigest();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getResult();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * optional google.protobuf.Timestamp creation_time = 8;
+ * optional google.protobuf.Timestamp timestamp = 1;
  * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.EnclaveInfo.prototype.getCreationTime = function() {
+proto.engine_api.AuditLogEntry.prototype.getTimestamp = function() {
   return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
  * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @return {!proto.engine_api.AuditLogEntry} returns this
 */
-proto.engine_api.EnclaveInfo.prototype.setCreationTime = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+proto.engine_api.AuditLogEntry.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.clearCreationTime = function() {
-  return this.setCreationTime(undefined);
+proto.engine_api.AuditLogEntry.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
 };
 
 
@@ -1836,30 +2316,109 @@ proto.engine_api.EnclaveInfo.prototype.clearCreationTime = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.hasCreationTime = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.engine_api.AuditLogEntry.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional EnclaveMode mode = 9;
- * @return {!proto.engine_api.EnclaveMode}
+ * optional string principal = 2;
+ * @return {string}
  */
-proto.engine_api.EnclaveInfo.prototype.getMode = function() {
-  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.engine_api.AuditLogEntry.prototype.getPrincipal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveMode} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
+proto.engine_api.AuditLogEntry.prototype.setPrincipal = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string api = 3;
+ * @return {string}
+ */
+proto.engine_api.AuditLogEntry.prototype.getApi = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
+ */
+proto.engine_api.AuditLogEntry.prototype.setApi = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string operation = 4;
+ * @return {string}
+ */
+proto.engine_api.AuditLogEntry.prototype.getOperation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
+ */
+proto.engine_api.AuditLogEntry.prototype.setOperation = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string args_digest = 5;
+ * @return {string}
+ */
+proto.engine_api.AuditLogEntry.prototype.getArgsDigest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
+ */
+proto.engine_api.AuditLogEntry.prototype.setArgsDigest = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string result = 6;
+ * @return {string}
+ */
+proto.engine_api.AuditLogEntry.prototype.getResult = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
+ */
+proto.engine_api.AuditLogEntry.prototype.setResult = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetAuditLogResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1875,8 +2434,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+proto.engine_api.GetAuditLogResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetAuditLogResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1885,13 +2444,14 @@ proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetAuditLogResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.GetAuditLogResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
+    entriesList: jspb.Message.toObjectList(msg.getEntriesList(),
+    proto.engine_api.AuditLogEntry.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1905,23 +2465,23 @@ proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetEnclavesResponse}
+ * @return {!proto.engine_api.GetAuditLogResponse}
  */
-proto.engine_api.GetEnclavesResponse.deserializeBinary = function(bytes) {
+proto.engine_api.GetAuditLogResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetEnclavesResponse;
-  return proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetAuditLogResponse;
+  return proto.engine_api.GetAuditLogResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetEnclavesResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetAuditLogResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetEnclavesResponse}
+ * @return {!proto.engine_api.GetAuditLogResponse}
  */
-proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetAuditLogResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1929,10 +2489,3761 @@ proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getEnclaveInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.EnclaveInfo.deserializeBinaryFromReader, "", new proto.engine_api.EnclaveInfo());
-         });
+      var value = new proto.engine_api.AuditLogEntry;
+      reader.readMessage(value,proto.engine_api.AuditLogEntry.deserializeBinaryFromReader);
+      msg.addEntries(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetAuditLogResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetAuditLogResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetAuditLogResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetAuditLogResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.AuditLogEntry.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated AuditLogEntry entries = 1;
+ * @return {!Array<!proto.engine_api.AuditLogEntry>}
+ */
+proto.engine_api.GetAuditLogResponse.prototype.getEntriesList = function() {
+  return /** @type{!Array<!proto.engine_api.AuditLogEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.AuditLogEntry, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.AuditLogEntry>} value
+ * @return {!proto.engine_api.GetAuditLogResponse} returns this
+*/
+proto.engine_api.GetAuditLogResponse.prototype.setEntriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.AuditLogEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.AuditLogEntry}
+ */
+proto.engine_api.GetAuditLogResponse.prototype.addEntries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.AuditLogEntry, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetAuditLogResponse} returns this
+ */
+proto.engine_api.GetAuditLogResponse.prototype.clearEntriesList = function() {
+  return this.setEntriesList([]);
+};
+
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CreateEnclaveArgs.repeatedFields_ = [10];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CreateEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    shouldApicRunInDebugMode: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    ttl: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    clusterName: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    shouldSkipLogsCollection: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    shouldRestrictEgress: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    egressAllowlistList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CreateEnclaveArgs}
+ */
+proto.engine_api.CreateEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CreateEnclaveArgs;
+  return proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CreateEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CreateEnclaveArgs}
+ */
+proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerVersionTag(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerLogLevel(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldApicRunInDebugMode(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTtl(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClusterName(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldSkipLogsCollection(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldRestrictEgress(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEgressAllowlist(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CreateEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getEgressAllowlistList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_name = 1;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearEnclaveName = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasEnclaveName = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string api_container_version_tag = 2;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerVersionTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerVersionTag = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerVersionTag = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string api_container_log_level = 3;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerLogLevel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerLogLevel = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerLogLevel = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional EnclaveMode mode = 4;
+ * @return {!proto.engine_api.EnclaveMode}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getMode = function() {
+  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveMode} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearMode = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasMode = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional bool should_apic_run_in_debug_mode = 5;
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getShouldApicRunInDebugMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setShouldApicRunInDebugMode = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearShouldApicRunInDebugMode = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasShouldApicRunInDebugMode = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string ttl = 6;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getTtl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setTtl = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearTtl = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasTtl = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional string cluster_name = 7;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getClusterName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setClusterName = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearClusterName = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasClusterName = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional bool should_skip_logs_collection = 8;
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getShouldSkipLogsCollection = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setShouldSkipLogsCollection = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearShouldSkipLogsCollection = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasShouldSkipLogsCollection = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional bool should_restrict_egress = 9;
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getShouldRestrictEgress = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setShouldRestrictEgress = function(value) {
+  return jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearShouldRestrictEgress = function() {
+  return jspb.Message.setField(this, 9, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasShouldRestrictEgress = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * repeated string egress_allowlist = 10;
+ * @return {!Array<string>}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getEgressAllowlistList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setEgressAllowlistList = function(value) {
+  return jspb.Message.setField(this, 10, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.addEgressAllowlist = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearEgressAllowlistList = function() {
+  return this.setEgressAllowlistList([]);
+};
+
+
+
+
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CreateEnclaveResponse;
+  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CreateEnclaveResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfo();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional EnclaveInfo enclave_info = 1;
+ * @return {?proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.getEnclaveInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveInfo|undefined} value
+ * @return {!proto.engine_api.CreateEnclaveResponse} returns this
+*/
+proto.engine_api.CreateEnclaveResponse.prototype.setEnclaveInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveResponse} returns this
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.clearEnclaveInfo = function() {
+  return this.setEnclaveInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.hasEnclaveInfo = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveAPIContainerInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    containerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ipInsideEnclave: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    grpcPortInsideEnclave: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    bridgeIpAddress: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveAPIContainerInfo;
+  return proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContainerId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIpInsideEnclave(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGrpcPortInsideEnclave(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBridgeIpAddress(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContainerId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getIpInsideEnclave();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getGrpcPortInsideEnclave();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getBridgeIpAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string container_id = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getContainerId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setContainerId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string ip_inside_enclave = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getIpInsideEnclave = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setIpInsideEnclave = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 grpc_port_inside_enclave = 3;
+ * @return {number}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getGrpcPortInsideEnclave = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setGrpcPortInsideEnclave = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string bridge_ip_address = 6;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getBridgeIpAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setBridgeIpAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ipOnHostMachine: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    grpcPortOnHostMachine: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
+  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIpOnHostMachine(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGrpcPortOnHostMachine(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIpOnHostMachine();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getGrpcPortOnHostMachine();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ip_on_host_machine = 4;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getIpOnHostMachine = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setIpOnHostMachine = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 grpc_port_on_host_machine = 5;
+ * @return {number}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getGrpcPortOnHostMachine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setGrpcPortOnHostMachine = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    containersStatus: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    apiContainerStatus: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
+    apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
+    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    mode: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    owner: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    expirationTime: (f = msg.getExpirationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    clusterName: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    isApiContainerMtlsRequired: jspb.Message.getBooleanFieldWithDefault(msg, 14, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.EnclaveInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveInfo;
+  return proto.engine_api.EnclaveInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.engine_api.EnclaveContainersStatus} */ (reader.readEnum());
+      msg.setContainersStatus(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (reader.readEnum());
+      msg.setApiContainerStatus(value);
+      break;
+    case 6:
+      var value = new proto.engine_api.EnclaveAPIContainerInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader);
+      msg.setApiContainerInfo(value);
+      break;
+    case 7:
+      var value = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader);
+      msg.setApiContainerHostMachineInfo(value);
+      break;
+    case 8:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreationTime(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    case 11:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpirationTime(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClusterName(value);
+      break;
+    case 14:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsApiContainerMtlsRequired(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getContainersStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getApiContainerStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getApiContainerInfo();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getApiContainerHostMachineInfo();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreationTime();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getExpirationTime();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 12));
+  if (f != null) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getIsApiContainerMtlsRequired();
+  if (f) {
+    writer.writeBool(
+      14,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_uuid = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional EnclaveContainersStatus containers_status = 4;
+ * @return {!proto.engine_api.EnclaveContainersStatus}
+ */
+proto.engine_api.EnclaveInfo.prototype.getContainersStatus = function() {
+  return /** @type {!proto.engine_api.EnclaveContainersStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveContainersStatus} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setContainersStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional EnclaveAPIContainerStatus api_container_status = 5;
+ * @return {!proto.engine_api.EnclaveAPIContainerStatus}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerStatus = function() {
+  return /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveAPIContainerStatus} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setApiContainerStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional EnclaveAPIContainerInfo api_container_info = 6;
+ * @return {?proto.engine_api.EnclaveAPIContainerInfo}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveAPIContainerInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerInfo, 6));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveAPIContainerInfo|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setApiContainerInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearApiContainerInfo = function() {
+  return this.setApiContainerInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasApiContainerInfo = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional EnclaveAPIContainerHostMachineInfo api_container_host_machine_info = 7;
+ * @return {?proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerHostMachineInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveAPIContainerHostMachineInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerHostMachineInfo, 7));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveAPIContainerHostMachineInfo|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setApiContainerHostMachineInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearApiContainerHostMachineInfo = function() {
+  return this.setApiContainerHostMachineInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasApiContainerHostMachineInfo = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp creation_time = 8;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveInfo.prototype.getCreationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setCreationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearCreationTime = function() {
+  return this.setCreationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasCreationTime = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional EnclaveMode mode = 9;
+ * @return {!proto.engine_api.EnclaveMode}
+ */
+proto.engine_api.EnclaveInfo.prototype.getMode = function() {
+  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveMode} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional string owner = 10;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setOwner = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearOwner = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasOwner = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expiration_time = 11;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveInfo.prototype.getExpirationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 11));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setExpirationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearExpirationTime = function() {
+  return this.setExpirationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasExpirationTime = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * optional string cluster_name = 12;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getClusterName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setClusterName = function(value) {
+  return jspb.Message.setField(this, 12, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearClusterName = function() {
+  return jspb.Message.setField(this, 12, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasClusterName = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional bool is_api_container_mtls_required = 14;
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.getIsApiContainerMtlsRequired = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 14, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setIsApiContainerMtlsRequired = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 14, value);
+};
+
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ApiContainerTlsCredentials.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ApiContainerTlsCredentials} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ApiContainerTlsCredentials.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    certificateAuthority: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    clientCertificate: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    clientKey: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    fipsMode: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ApiContainerTlsCredentials}
+ */
+proto.engine_api.ApiContainerTlsCredentials.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ApiContainerTlsCredentials;
+  return proto.engine_api.ApiContainerTlsCredentials.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ApiContainerTlsCredentials} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ApiContainerTlsCredentials}
+ */
+proto.engine_api.ApiContainerTlsCredentials.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCertificateAuthority(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClientCertificate(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClientKey(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFipsMode(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ApiContainerTlsCredentials.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ApiContainerTlsCredentials} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ApiContainerTlsCredentials.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCertificateAuthority();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getClientCertificate();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getClientKey();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getFipsMode();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string certificate_authority = 1;
+ * @return {string}
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.getCertificateAuthority = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ApiContainerTlsCredentials} returns this
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.setCertificateAuthority = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string client_certificate = 2;
+ * @return {string}
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.getClientCertificate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ApiContainerTlsCredentials} returns this
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.setClientCertificate = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string client_key = 3;
+ * @return {string}
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.getClientKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ApiContainerTlsCredentials} returns this
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.setClientKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool fips_mode = 4;
+ * @return {boolean}
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.getFipsMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.ApiContainerTlsCredentials} returns this
+ */
+proto.engine_api.ApiContainerTlsCredentials.prototype.setFipsMode = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesResponse}
+ */
+proto.engine_api.GetEnclavesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEnclavesResponse;
+  return proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEnclavesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEnclavesResponse}
+ */
+proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getEnclaveInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.EnclaveInfo.deserializeBinaryFromReader, "", new proto.engine_api.EnclaveInfo());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetEnclavesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.EnclaveInfo.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * map<string, EnclaveInfo> enclave_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.EnclaveInfo>}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.getEnclaveInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.EnclaveInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.EnclaveInfo));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetEnclavesResponse} returns this
+ */
+proto.engine_api.GetEnclavesResponse.prototype.clearEnclaveInfoMap = function() {
+  this.getEnclaveInfoMap().clear();
+  return this;};
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.ListEnclavesArgs.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ListEnclavesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ListEnclavesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ListEnclavesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    owner: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ListEnclavesArgs}
+ */
+proto.engine_api.ListEnclavesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ListEnclavesArgs;
+  return proto.engine_api.ListEnclavesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ListEnclavesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ListEnclavesArgs}
+ */
+proto.engine_api.ListEnclavesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var values = /** @type {!Array<!proto.engine_api.EnclaveContainersStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPageSize(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ListEnclavesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ListEnclavesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ListEnclavesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveContainersStatus statuses = 1;
+ * @return {!Array<!proto.engine_api.EnclaveContainersStatus>}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.engine_api.EnclaveContainersStatus>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveContainersStatus>} value
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveContainersStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
+};
+
+
+/**
+ * optional string owner = 2;
+ * @return {string}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.setOwner = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.clearOwner = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.hasOwner = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional uint32 page_size = 3;
+ * @return {number}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.setPageSize = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.clearPageSize = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.hasPageSize = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional string page_token = 4;
+ * @return {string}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.setPageToken = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.ListEnclavesArgs} returns this
+ */
+proto.engine_api.ListEnclavesArgs.prototype.clearPageToken = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ListEnclavesArgs.prototype.hasPageToken = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.ListEnclavesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ListEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ListEnclavesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ListEnclavesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ListEnclavesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfosList: jspb.Message.toObjectList(msg.getEnclaveInfosList(),
+    proto.engine_api.EnclaveInfo.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ListEnclavesResponse}
+ */
+proto.engine_api.ListEnclavesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ListEnclavesResponse;
+  return proto.engine_api.ListEnclavesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ListEnclavesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ListEnclavesResponse}
+ */
+proto.engine_api.ListEnclavesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.addEnclaveInfos(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ListEnclavesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ListEnclavesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ListEnclavesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ListEnclavesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfosList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveInfo enclave_infos = 1;
+ * @return {!Array<!proto.engine_api.EnclaveInfo>}
+ */
+proto.engine_api.ListEnclavesResponse.prototype.getEnclaveInfosList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveInfo, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveInfo>} value
+ * @return {!proto.engine_api.ListEnclavesResponse} returns this
+*/
+proto.engine_api.ListEnclavesResponse.prototype.setEnclaveInfosList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.ListEnclavesResponse.prototype.addEnclaveInfos = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.ListEnclavesResponse} returns this
+ */
+proto.engine_api.ListEnclavesResponse.prototype.clearEnclaveInfosList = function() {
+  return this.setEnclaveInfosList([]);
+};
+
+
+/**
+ * optional string next_page_token = 2;
+ * @return {string}
+ */
+proto.engine_api.ListEnclavesResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ListEnclavesResponse} returns this
+ */
+proto.engine_api.ListEnclavesResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.ListEnclavesResponse} returns this
+ */
+proto.engine_api.ListEnclavesResponse.prototype.clearNextPageToken = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ListEnclavesResponse.prototype.hasNextPageToken = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveIdentifiers.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveIdentifiers} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.EnclaveIdentifiers.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveIdentifiers;
+  return proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveIdentifiers} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveIdentifiers} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_uuid = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.engine_api.EnclaveIdentifiers.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse;
+  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveIdentifiers;
+      reader.readMessage(value,proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAllidentifiersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.engine_api.EnclaveIdentifiers>}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveIdentifiers, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveIdentifiers>} value
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+*/
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveIdentifiers, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StopEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.StopEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.StopEnclaveArgs}
+ */
+proto.engine_api.StopEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.StopEnclaveArgs;
+  return proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.StopEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.StopEnclaveArgs}
+ */
+proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.StopEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.StopEnclaveArgs} returns this
+ */
+proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.DestroyEnclaveArgs;
+  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ShareEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ShareEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ShareEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    principal: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    revoke: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ShareEnclaveArgs}
+ */
+proto.engine_api.ShareEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ShareEnclaveArgs;
+  return proto.engine_api.ShareEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ShareEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ShareEnclaveArgs}
+ */
+proto.engine_api.ShareEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrincipal(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRevoke(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ShareEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ShareEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ShareEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPrincipal();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ShareEnclaveArgs} returns this
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string principal = 2;
+ * @return {string}
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.getPrincipal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ShareEnclaveArgs} returns this
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.setPrincipal = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool revoke = 3;
+ * @return {boolean}
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.getRevoke = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.ShareEnclaveArgs} returns this
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.setRevoke = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.ShareEnclaveArgs} returns this
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.clearRevoke = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ShareEnclaveArgs.prototype.hasRevoke = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.ShareEnclaveResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ShareEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ShareEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ShareEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ShareEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    owner: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    granteesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ShareEnclaveResponse}
+ */
+proto.engine_api.ShareEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ShareEnclaveResponse;
+  return proto.engine_api.ShareEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ShareEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ShareEnclaveResponse}
+ */
+proto.engine_api.ShareEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addGrantees(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ShareEnclaveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ShareEnclaveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ShareEnclaveResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ShareEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getGranteesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string owner = 1;
+ * @return {string}
+ */
+proto.engine_api.ShareEnclaveResponse.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ShareEnclaveResponse} returns this
+ */
+proto.engine_api.ShareEnclaveResponse.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string grantees = 2;
+ * @return {!Array<string>}
+ */
+proto.engine_api.ShareEnclaveResponse.prototype.getGranteesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.ShareEnclaveResponse} returns this
+ */
+proto.engine_api.ShareEnclaveResponse.prototype.setGranteesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.ShareEnclaveResponse} returns this
+ */
+proto.engine_api.ShareEnclaveResponse.prototype.addGrantees = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.ShareEnclaveResponse} returns this
+ */
+proto.engine_api.ShareEnclaveResponse.prototype.clearGranteesList = function() {
+  return this.setGranteesList([]);
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetApiContainerTlsCredentialsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetApiContainerTlsCredentialsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetApiContainerTlsCredentialsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetApiContainerTlsCredentialsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetApiContainerTlsCredentialsArgs}
+ */
+proto.engine_api.GetApiContainerTlsCredentialsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetApiContainerTlsCredentialsArgs;
+  return proto.engine_api.GetApiContainerTlsCredentialsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetApiContainerTlsCredentialsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetApiContainerTlsCredentialsArgs}
+ */
+proto.engine_api.GetApiContainerTlsCredentialsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -1947,9 +6258,9 @@ proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetEnclavesResponse.prototype.serializeBinary = function() {
+proto.engine_api.GetApiContainerTlsCredentialsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetApiContainerTlsCredentialsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1957,39 +6268,38 @@ proto.engine_api.GetEnclavesResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetEnclavesResponse} message
+ * @param {!proto.engine_api.GetApiContainerTlsCredentialsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetApiContainerTlsCredentialsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.EnclaveInfo.serializeBinaryToWriter);
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, EnclaveInfo> enclave_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.EnclaveInfo>}
+ * optional string enclave_identifier = 1;
+ * @return {string}
  */
-proto.engine_api.GetEnclavesResponse.prototype.getEnclaveInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.EnclaveInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.EnclaveInfo));
+proto.engine_api.GetApiContainerTlsCredentialsArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetEnclavesResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.GetApiContainerTlsCredentialsArgs} returns this
  */
-proto.engine_api.GetEnclavesResponse.prototype.clearEnclaveInfoMap = function() {
-  this.getEnclaveInfoMap().clear();
-  return this;};
+proto.engine_api.GetApiContainerTlsCredentialsArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 
@@ -2008,8 +6318,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveIdentifiers.toObject(opt_includeInstance, this);
+proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2018,15 +6328,13 @@ proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveIdentifiers} msg The msg instance to transform.
+ * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
+proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -2040,23 +6348,23 @@ proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.CleanArgs}
  */
-proto.engine_api.EnclaveIdentifiers.deserializeBinary = function(bytes) {
+proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveIdentifiers;
-  return proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CleanArgs;
+  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.CleanArgs}
  */
-proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2064,16 +6372,8 @@ proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveUuid(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldCleanAll(value);
       break;
     default:
       reader.skipField();
@@ -2088,9 +6388,9 @@ proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
+proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2098,98 +6398,59 @@ proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveIdentifiers} message
+ * @param {!proto.engine_api.CleanArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeBool(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string enclave_uuid = 1;
- * @return {string}
- */
-proto.engine_api.EnclaveIdentifiers.prototype.getEnclaveUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
- */
-proto.engine_api.EnclaveIdentifiers.prototype.setEnclaveUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * optional bool should_clean_all = 1;
+ * @return {boolean}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ * @param {boolean} value
+ * @return {!proto.engine_api.CleanArgs} returns this
  */
-proto.engine_api.EnclaveIdentifiers.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
+  return jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional string shortened_uuid = 3;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CleanArgs} returns this
  */
-proto.engine_api.EnclaveIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.engine_api.CleanArgs.prototype.clearShouldCleanAll = function() {
+  return jspb.Message.setField(this, 1, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.engine_api.CleanArgs.prototype.hasShouldCleanAll = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2205,8 +6466,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
 };
 
 
@@ -2215,14 +6476,14 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.to
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.engine_api.EnclaveIdentifiers.toObject, includeInstance)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2236,23 +6497,23 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse;
-  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveNameAndUuid;
+  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2260,9 +6521,12 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeB
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveIdentifiers;
-      reader.readMessage(value,proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -2277,9 +6541,9 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeB
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.serializeBinary = function() {
+proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2287,62 +6551,73 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.se
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} message
+ * @param {!proto.engine_api.EnclaveNameAndUuid} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated EnclaveIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.engine_api.EnclaveIdentifiers>}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveIdentifiers, 1));
+proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveIdentifiers>} value
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
-*/
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveIdentifiers=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * optional string uuid = 2;
+ * @return {string}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveIdentifiers, opt_index);
+proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CleanResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2358,8 +6633,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.StopEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2368,13 +6643,14 @@ proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.StopEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
+    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2388,23 +6664,23 @@ proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.StopEnclaveArgs}
+ * @return {!proto.engine_api.CleanResponse}
  */
-proto.engine_api.StopEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.StopEnclaveArgs;
-  return proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CleanResponse;
+  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.StopEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.StopEnclaveArgs}
+ * @return {!proto.engine_api.CleanResponse}
  */
-proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2412,8 +6688,9 @@ proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      var value = new proto.engine_api.EnclaveNameAndUuid;
+      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
+      msg.addRemovedEnclaveNameAndUuids(value);
       break;
     default:
       reader.skipField();
@@ -2428,9 +6705,9 @@ proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2438,41 +6715,69 @@ proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.StopEnclaveArgs} message
+ * @param {!proto.engine_api.CleanResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
+  f = message.getRemovedEnclaveNameAndUuidsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string enclave_identifier = 1;
- * @return {string}
+ * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
+ * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
  */
-proto.engine_api.StopEnclaveArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.StopEnclaveArgs} returns this
+ * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
+ * @return {!proto.engine_api.CleanResponse} returns this
+*/
+proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
  */
-proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CleanResponse} returns this
+ */
+proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
+  return this.setRemovedEnclaveNameAndUuidsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2488,8 +6793,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2498,13 +6803,19 @@ proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
+    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
+    proto.engine_api.LogLineFilter.toObject, includeInstance),
+    returnAllLogs: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    numLogLines: jspb.Message.getFieldWithDefault(msg, 6, 0)
   };
 
   if (includeInstance) {
@@ -2518,23 +6829,23 @@ proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.GetServiceLogsArgs}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.DestroyEnclaveArgs;
-  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetServiceLogsArgs;
+  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.GetServiceLogsArgs}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2545,6 +6856,29 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
       var value = /** @type {string} */ (reader.readString());
       msg.setEnclaveIdentifier(value);
       break;
+    case 2:
+      var value = msg.getServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollowLogs(value);
+      break;
+    case 4:
+      var value = new proto.engine_api.LogLineFilter;
+      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
+      msg.addConjunctiveFilters(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnAllLogs(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumLogLines(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2558,9 +6892,9 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2568,11 +6902,11 @@ proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!proto.engine_api.GetServiceLogsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getEnclaveIdentifier();
   if (f.length > 0) {
@@ -2581,6 +6915,39 @@ proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getConjunctiveFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -2588,156 +6955,176 @@ proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message,
  * optional string enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * map<string, bool> service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
+  this.getServiceUuidSetMap().clear();
+  return this;};
+
+
+/**
+ * optional bool follow_logs = 3;
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearFollowLogs = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasFollowLogs = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * repeated LogLineFilter conjunctive_filters = 4;
+ * @return {!Array<!proto.engine_api.LogLineFilter>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
+  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.LogLineFilter>} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!proto.engine_api.LogLineFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLineFilter}
  */
-proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
+  return this.setConjunctiveFiltersList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanArgs}
+ * optional bool return_all_logs = 5;
+ * @return {boolean}
  */
-proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanArgs;
-  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+proto.engine_api.GetServiceLogsArgs.prototype.getReturnAllLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanArgs}
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldCleanAll(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.engine_api.GetServiceLogsArgs.prototype.setReturnAllLogs = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.engine_api.GetServiceLogsArgs.prototype.clearReturnAllLogs = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
+proto.engine_api.GetServiceLogsArgs.prototype.hasReturnAllLogs = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional bool should_clean_all = 1;
- * @return {boolean}
+ * optional uint32 num_log_lines = 6;
+ * @return {number}
  */
-proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.CleanArgs} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
-  return jspb.Message.setField(this, 1, value);
+proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.engine_api.CleanArgs} returns this
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.CleanArgs.prototype.clearShouldCleanAll = function() {
-  return jspb.Message.setField(this, 1, undefined);
+proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
@@ -2745,8 +7132,8 @@ proto.engine_api.CleanArgs.prototype.clearShouldCleanAll = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.CleanArgs.prototype.hasShouldCleanAll = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.engine_api.GetServiceLogsArgs.prototype.hasNumLogLines = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
@@ -2766,8 +7153,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2776,14 +7163,14 @@ proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
+    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -2797,23 +7184,23 @@ proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.GetServiceLogsResponse}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveNameAndUuid;
-  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetServiceLogsResponse;
+  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.GetServiceLogsResponse}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2821,12 +7208,16 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = msg.getServiceLogsByServiceUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
+         });
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = msg.getNotFoundServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
       break;
     default:
       reader.skipField();
@@ -2841,9 +7232,9 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2851,63 +7242,65 @@ proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!proto.engine_api.GetServiceLogsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
+  f = message.getServiceLogsByServiceUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
   }
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
+  f = message.getNotFoundServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * map<string, LogLine> service_logs_by_service_uuid = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.LogLine));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
+proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
+  this.getServiceLogsByServiceUuidMap().clear();
+  return this;};
 
 
 /**
- * optional string uuid = 2;
- * @return {string}
+ * map<string, bool> not_found_service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
+proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
+  this.getNotFoundServiceUuidSetMap().clear();
+  return this;};
 
 
 
@@ -2916,7 +7309,7 @@ proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.engine_api.CleanResponse.repeatedFields_ = [1];
+proto.engine_api.LogLine.repeatedFields_ = [1];
 
 
 
@@ -2933,8 +7326,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
 };
 
 
@@ -2943,14 +7336,14 @@ proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
   var f, obj = {
-    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
-    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2964,23 +7357,23 @@ proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.LogLine}
  */
-proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+proto.engine_api.LogLine.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanResponse;
-  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.LogLine;
+  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.LogLine}
  */
-proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2988,9 +7381,13 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveNameAndUuid;
-      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
-      msg.addRemovedEnclaveNameAndUuids(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLine(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
       break;
     default:
       reader.skipField();
@@ -3005,9 +7402,9 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+proto.engine_api.LogLine.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3015,68 +7412,104 @@ proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanResponse} message
+ * @param {!proto.engine_api.LogLine} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRemovedEnclaveNameAndUuidsList();
+  f = message.getLineList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeRepeatedString(
       1,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      2,
       f,
-      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
- * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ * repeated string line = 1;
+ * @return {!Array<string>}
  */
-proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+proto.engine_api.LogLine.prototype.getLineList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
- * @return {!proto.engine_api.CleanResponse} returns this
-*/
-proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.setLineList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.LogLine} returns this
  */
-proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.CleanResponse} returns this
+ * @return {!proto.engine_api.LogLine} returns this
  */
-proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
-  return this.setRemovedEnclaveNameAndUuidsList([]);
+proto.engine_api.LogLine.prototype.clearLineList = function() {
+  return this.setLineList([]);
 };
 
 
+/**
+ * optional google.protobuf.Timestamp timestamp = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.LogLine.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.LogLine} returns this
+*/
+proto.engine_api.LogLine.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.LogLine} returns this
  */
-proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+proto.engine_api.LogLine.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.LogLine.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
 
 
 
@@ -3093,8 +7526,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
 };
 
 
@@ -3103,19 +7536,14 @@ proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
-    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
-    proto.engine_api.LogLineFilter.toObject, includeInstance),
-    returnAllLogs: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    numLogLines: jspb.Message.getFieldWithDefault(msg, 6, 0)
+    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3129,23 +7557,23 @@ proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.LogLineFilter}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsArgs;
-  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.LogLineFilter;
+  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.LogLineFilter}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3153,31 +7581,12 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
+      msg.setOperator(value);
       break;
     case 2:
-      var value = msg.getServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setFollowLogs(value);
-      break;
-    case 4:
-      var value = new proto.engine_api.LogLineFilter;
-      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
-      msg.addConjunctiveFilters(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReturnAllLogs(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setNumLogLines(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTextPattern(value);
       break;
     default:
       reader.skipField();
@@ -3192,9 +7601,9 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3202,229 +7611,232 @@ proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!proto.engine_api.LogLineFilter} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getOperator();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getConjunctiveFiltersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      proto.engine_api.LogLineFilter.serializeBinaryToWriter
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string enclave_identifier = 1;
- * @return {string}
- */
-proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
-proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * map<string, bool> service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
- */
-proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+  f = message.getTextPattern();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * optional LogLineOperator operator = 1;
+ * @return {!proto.engine_api.LogLineOperator}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
-  this.getServiceUuidSetMap().clear();
-  return this;};
+proto.engine_api.LogLineFilter.prototype.getOperator = function() {
+  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
 
 
 /**
- * optional bool follow_logs = 3;
- * @return {boolean}
+ * @param {!proto.engine_api.LogLineOperator} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * optional string text_pattern = 2;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearFollowLogs = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasFollowLogs = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.engine_api.GetServiceResourceUsageArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceResourceUsageArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * repeated LogLineFilter conjunctive_filters = 4;
- * @return {!Array<!proto.engine_api.LogLineFilter>}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceResourceUsageArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
-  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
-};
-
+proto.engine_api.GetServiceResourceUsageArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    since: (f = msg.getSince()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
 
-/**
- * @param {!Array<!proto.engine_api.LogLineFilter>} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
-*/
-proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!proto.engine_api.LogLineFilter=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLineFilter}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceResourceUsageArgs}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+proto.engine_api.GetServiceResourceUsageArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceResourceUsageArgs;
+  return proto.engine_api.GetServiceResourceUsageArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceResourceUsageArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceResourceUsageArgs}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
-  return this.setConjunctiveFiltersList([]);
+proto.engine_api.GetServiceResourceUsageArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setSince(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bool return_all_logs = 5;
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getReturnAllLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.engine_api.GetServiceResourceUsageArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceResourceUsageArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetServiceResourceUsageArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setReturnAllLogs = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.engine_api.GetServiceResourceUsageArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSince();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * optional string enclave_identifier = 1;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearReturnAllLogs = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.engine_api.GetServiceResourceUsageArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.engine_api.GetServiceResourceUsageArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasReturnAllLogs = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.engine_api.GetServiceResourceUsageArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 num_log_lines = 6;
- * @return {number}
+ * optional google.protobuf.Timestamp since = 2;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.engine_api.GetServiceResourceUsageArgs.prototype.getSince = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
-proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
-  return jspb.Message.setField(this, 6, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetServiceResourceUsageArgs} returns this
+*/
+proto.engine_api.GetServiceResourceUsageArgs.prototype.setSince = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetServiceResourceUsageArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.engine_api.GetServiceResourceUsageArgs.prototype.clearSince = function() {
+  return this.setSince(undefined);
 };
 
 
@@ -3432,14 +7844,12 @@ proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasNumLogLines = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.engine_api.GetServiceResourceUsageArgs.prototype.hasSince = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 
-
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -3453,8 +7863,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+proto.engine_api.GetServiceResourceUsageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceResourceUsageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3463,14 +7873,14 @@ proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetServiceResourceUsageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.GetServiceResourceUsageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
-    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+    resourceUsageByServiceUuidMap: (f = msg.getResourceUsageByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.ResourceUsageSeries.toObject) : [],
+    samplingIntervalSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3484,23 +7894,23 @@ proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.GetServiceResourceUsageResponse}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+proto.engine_api.GetServiceResourceUsageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsResponse;
-  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetServiceResourceUsageResponse;
+  return proto.engine_api.GetServiceResourceUsageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetServiceResourceUsageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.GetServiceResourceUsageResponse}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetServiceResourceUsageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3508,16 +7918,14 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceLogsByServiceUuidMap();
+      var value = msg.getResourceUsageByServiceUuidMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.ResourceUsageSeries.deserializeBinaryFromReader, "", new proto.engine_api.ResourceUsageSeries());
          });
       break;
     case 2:
-      var value = msg.getNotFoundServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSamplingIntervalSeconds(value);
       break;
     default:
       reader.skipField();
@@ -3532,9 +7940,9 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+proto.engine_api.GetServiceResourceUsageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetServiceResourceUsageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3542,74 +7950,71 @@ proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!proto.engine_api.GetServiceResourceUsageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetServiceResourceUsageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceLogsByServiceUuidMap(true);
+  f = message.getResourceUsageByServiceUuidMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.ResourceUsageSeries.serializeBinaryToWriter);
   }
-  f = message.getNotFoundServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getSamplingIntervalSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, LogLine> service_logs_by_service_uuid = 1;
+ * map<string, ResourceUsageSeries> resource_usage_by_service_uuid = 1;
  * @param {boolean=} opt_noLazyCreate Do not create the map if
  * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
+ * @return {!jspb.Map<string,!proto.engine_api.ResourceUsageSeries>}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
+proto.engine_api.GetServiceResourceUsageResponse.prototype.getResourceUsageByServiceUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.ResourceUsageSeries>} */ (
       jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.LogLine));
+      proto.engine_api.ResourceUsageSeries));
 };
 
 
 /**
  * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * @return {!proto.engine_api.GetServiceResourceUsageResponse} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
-  this.getServiceLogsByServiceUuidMap().clear();
+proto.engine_api.GetServiceResourceUsageResponse.prototype.clearResourceUsageByServiceUuidMap = function() {
+  this.getResourceUsageByServiceUuidMap().clear();
   return this;};
 
 
 /**
- * map<string, bool> not_found_service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * optional uint32 sampling_interval_seconds = 2;
+ * @return {number}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.engine_api.GetServiceResourceUsageResponse.prototype.getSamplingIntervalSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.GetServiceResourceUsageResponse} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
-  this.getNotFoundServiceUuidSetMap().clear();
-  return this;};
-
-
+proto.engine_api.GetServiceResourceUsageResponse.prototype.setSamplingIntervalSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.engine_api.LogLine.repeatedFields_ = [1];
+proto.engine_api.ResourceUsageSeries.repeatedFields_ = [1];
 
 
 
@@ -3626,8 +8031,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+proto.engine_api.ResourceUsageSeries.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ResourceUsageSeries.toObject(opt_includeInstance, this);
 };
 
 
@@ -3636,14 +8041,14 @@ proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
+ * @param {!proto.engine_api.ResourceUsageSeries} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
+proto.engine_api.ResourceUsageSeries.toObject = function(includeInstance, msg) {
   var f, obj = {
-    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+    samplesList: jspb.Message.toObjectList(msg.getSamplesList(),
+    proto.engine_api.ResourceUsageSample.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3657,23 +8062,23 @@ proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.ResourceUsageSeries}
  */
-proto.engine_api.LogLine.deserializeBinary = function(bytes) {
+proto.engine_api.ResourceUsageSeries.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLine;
-  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.ResourceUsageSeries;
+  return proto.engine_api.ResourceUsageSeries.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
+ * @param {!proto.engine_api.ResourceUsageSeries} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.ResourceUsageSeries}
  */
-proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.ResourceUsageSeries.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3681,13 +8086,9 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addLine(value);
-      break;
-    case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setTimestamp(value);
+      var value = new proto.engine_api.ResourceUsageSample;
+      reader.readMessage(value,proto.engine_api.ResourceUsageSample.deserializeBinaryFromReader);
+      msg.addSamples(value);
       break;
     default:
       reader.skipField();
@@ -3702,9 +8103,9 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLine.prototype.serializeBinary = function() {
+proto.engine_api.ResourceUsageSeries.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
+  proto.engine_api.ResourceUsageSeries.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3712,107 +8113,62 @@ proto.engine_api.LogLine.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLine} message
+ * @param {!proto.engine_api.ResourceUsageSeries} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.ResourceUsageSeries.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLineList();
+  f = message.getSamplesList();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = message.getTimestamp();
-  if (f != null) {
-    writer.writeMessage(
-      2,
       f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      proto.engine_api.ResourceUsageSample.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated string line = 1;
- * @return {!Array<string>}
+ * repeated ResourceUsageSample samples = 1;
+ * @return {!Array<!proto.engine_api.ResourceUsageSample>}
  */
-proto.engine_api.LogLine.prototype.getLineList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.engine_api.ResourceUsageSeries.prototype.getSamplesList = function() {
+  return /** @type{!Array<!proto.engine_api.ResourceUsageSample>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.ResourceUsageSample, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.engine_api.LogLine} returns this
- */
-proto.engine_api.LogLine.prototype.setLineList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+ * @param {!Array<!proto.engine_api.ResourceUsageSample>} value
+ * @return {!proto.engine_api.ResourceUsageSeries} returns this
+*/
+proto.engine_api.ResourceUsageSeries.prototype.setSamplesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
+ * @param {!proto.engine_api.ResourceUsageSample=} opt_value
  * @param {number=} opt_index
- * @return {!proto.engine_api.LogLine} returns this
+ * @return {!proto.engine_api.ResourceUsageSample}
  */
-proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.engine_api.ResourceUsageSeries.prototype.addSamples = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.ResourceUsageSample, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.LogLine} returns this
- */
-proto.engine_api.LogLine.prototype.clearLineList = function() {
-  return this.setLineList([]);
-};
-
-
-/**
- * optional google.protobuf.Timestamp timestamp = 2;
- * @return {?proto.google.protobuf.Timestamp}
- */
-proto.engine_api.LogLine.prototype.getTimestamp = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.engine_api.LogLine} returns this
-*/
-proto.engine_api.LogLine.prototype.setTimestamp = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.LogLine} returns this
- */
-proto.engine_api.LogLine.prototype.clearTimestamp = function() {
-  return this.setTimestamp(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.engine_api.ResourceUsageSeries} returns this
  */
-proto.engine_api.LogLine.prototype.hasTimestamp = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.engine_api.ResourceUsageSeries.prototype.clearSamplesList = function() {
+  return this.setSamplesList([]);
 };
 
 
 
-
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -3826,8 +8182,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+proto.engine_api.ResourceUsageSample.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ResourceUsageSample.toObject(opt_includeInstance, this);
 };
 
 
@@ -3836,14 +8192,17 @@ proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
+ * @param {!proto.engine_api.ResourceUsageSample} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
+proto.engine_api.ResourceUsageSample.toObject = function(includeInstance, msg) {
   var f, obj = {
-    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    cpuMilliCores: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    memoryBytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    networkRxBytes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    networkTxBytes: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -3857,23 +8216,23 @@ proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.ResourceUsageSample}
  */
-proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
+proto.engine_api.ResourceUsageSample.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLineFilter;
-  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.ResourceUsageSample;
+  return proto.engine_api.ResourceUsageSample.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
+ * @param {!proto.engine_api.ResourceUsageSample} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.ResourceUsageSample}
  */
-proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.ResourceUsageSample.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3881,12 +8240,25 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
-      msg.setOperator(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTextPattern(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCpuMilliCores(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryBytes(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNetworkRxBytes(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNetworkTxBytes(value);
       break;
     default:
       reader.skipField();
@@ -3901,9 +8273,9 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
+proto.engine_api.ResourceUsageSample.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
+  proto.engine_api.ResourceUsageSample.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3911,65 +8283,205 @@ proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLineFilter} message
+ * @param {!proto.engine_api.ResourceUsageSample} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.ResourceUsageSample.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOperator();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getTextPattern();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCpuMilliCores();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
+  f = message.getMemoryBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional LogLineOperator operator = 1;
- * @return {!proto.engine_api.LogLineOperator}
+ * optional google.protobuf.Timestamp timestamp = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.LogLineFilter.prototype.getOperator = function() {
-  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.engine_api.ResourceUsageSample.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineOperator} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.ResourceUsageSample} returns this
+*/
+proto.engine_api.ResourceUsageSample.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.ResourceUsageSample} returns this
  */
-proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.engine_api.ResourceUsageSample.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
 };
 
 
 /**
- * optional string text_pattern = 2;
- * @return {string}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.ResourceUsageSample.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * optional uint64 cpu_milli_cores = 2;
+ * @return {number}
  */
-proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.ResourceUsageSample.prototype.getCpuMilliCores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceUsageSample} returns this
+ */
+proto.engine_api.ResourceUsageSample.prototype.setCpuMilliCores = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 memory_bytes = 3;
+ * @return {number}
+ */
+proto.engine_api.ResourceUsageSample.prototype.getMemoryBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceUsageSample} returns this
+ */
+proto.engine_api.ResourceUsageSample.prototype.setMemoryBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 network_rx_bytes = 4;
+ * @return {number}
+ */
+proto.engine_api.ResourceUsageSample.prototype.getNetworkRxBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceUsageSample} returns this
+ */
+proto.engine_api.ResourceUsageSample.prototype.setNetworkRxBytes = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.ResourceUsageSample} returns this
+ */
+proto.engine_api.ResourceUsageSample.prototype.clearNetworkRxBytes = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ResourceUsageSample.prototype.hasNetworkRxBytes = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional uint64 network_tx_bytes = 5;
+ * @return {number}
+ */
+proto.engine_api.ResourceUsageSample.prototype.getNetworkTxBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceUsageSample} returns this
+ */
+proto.engine_api.ResourceUsageSample.prototype.setNetworkTxBytes = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.ResourceUsageSample} returns this
+ */
+proto.engine_api.ResourceUsageSample.prototype.clearNetworkTxBytes = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ResourceUsageSample.prototype.hasNetworkTxBytes = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.SubsystemHealthStatus = {
+  SUBSYSTEMHEALTHSTATUS_HEALTHY: 0,
+  SUBSYSTEMHEALTHSTATUS_DEGRADED: 1,
+  SUBSYSTEMHEALTHSTATUS_UNHEALTHY: 2
+};
+
 /**
  * @enum {number}
  */
@@ -0,0 +1,173 @@
+import {err, ok, Result} from "neverthrow";
+import {ServiceUUID} from "../../../core/lib/services/service";
+import {ServiceLog} from "./service_log";
+import {ServiceLogsStreamContent} from "./service_logs_stream_content";
+
+export const DEFAULT_REST_API_ENGINE_SERVER_PORT_NUM: number = 9779;
+
+const DEFAULT_ENGINE_REST_API_URL: string = `ws://localhost:${DEFAULT_REST_API_ENGINE_SERVER_PORT_NUM}`;
+
+const API_PATH: string = "/api";
+
+const SERVICE_UUID_SET_QUERY_PARAM: string = "service_uuid_set";
+const FOLLOW_LOGS_QUERY_PARAM: string = "follow_logs";
+const RETURN_ALL_LOGS_QUERY_PARAM: string = "return_all_logs";
+const NUM_LOG_LINES_QUERY_PARAM: string = "num_log_lines";
+
+const ERROR_RESPONSE_INFO_TYPE: string = "ERROR";
+
+// Browsers can't set headers on WebSocket connections, so the token is sent base64url-encoded in a subprotocol instead,
+// next to the subprotocol the engine selects for the connection to be accepted
+const ENGINE_WEBSOCKET_SUBPROTOCOL: string = "kurtosis.engine.v1";
+const BEARER_TOKEN_SUBPROTOCOL_PREFIX: string = "base64url.bearer.kurtosis.engine.";
+
+// Sent by the engine in place of service logs to report a problem, e.g. a stream that failed
+interface ResponseInfoMessage {
+    code: number,
+    message: string,
+    type: string,
+}
+
+interface ServiceLogsMessage {
+    service_logs_by_service_uuid?: {[serviceUuid: string]: {line: string[], timestamp?: string}},
+    not_found_service_uuid_set?: string[],
+}
+
+export interface ServiceLogsCallbacks {
+    // Called with the new log lines of the services, as they are written
+    onContent: (serviceLogsStreamContent: ServiceLogsStreamContent) => void,
+
+    // Called when the stream fails; the stream ends right after
+    onError: (error: Error) => void,
+
+    // Called when the stream ends, because it was closed or because the engine ended it
+    onEnd: () => void,
+}
+
+// A stream of service logs; closing it stops following the logs
+export class ServiceLogsSubscription {
+    private readonly webSocket: WebSocket;
+
+    constructor(webSocket: WebSocket) {
+        this.webSocket = webSocket;
+    }
+
+    public close(): void {
+        this.webSocket.close();
+    }
+}
+
+// Follows service logs through the WebSocket endpoint of the engine REST API rather than gRPC, with the WebSocket
+// implementation of the environment, so that web dashboards can tail logs without a gRPC-web proxy. It works in
+// browsers, and in Node versions providing a global WebSocket
+export class WebSocketServiceLogsClient {
+    private readonly engineRestApiUrl: string;
+
+    private readonly engineToken: string | undefined;
+
+    // The URL of the engine REST API, using the ws or wss scheme, e.g. 'ws://localhost:9779', and the token to
+    // authenticate with if the engine requires one
+    constructor(engineRestApiUrl: string = DEFAULT_ENGINE_REST_API_URL, engineToken?: string) {
+        this.engineRestApiUrl = engineRestApiUrl;
+        this.engineToken = engineToken;
+    }
+
+    // Unlike KurtosisContext.getServiceLogs, it doesn't filter the log lines, as the engine can't take filters from the
+    // URL of a WebSocket connection
+    public followServiceLogs(
+        enclaveIdentifier: string,
+        serviceUuids: Set<ServiceUUID>,
+        shouldReturnAllLogs: boolean,
+        numLogLines: number,
+        callbacks: ServiceLogsCallbacks,
+    ): Result<ServiceLogsSubscription, Error> {
+        if (typeof WebSocket === "undefined") {
+            return err(new Error("No WebSocket implementation is available in this environment; use KurtosisContext.getServiceLogs instead"));
+        }
+
+        const url: string = this.getServiceLogsUrl(enclaveIdentifier, serviceUuids, shouldReturnAllLogs, numLogLines);
+        let webSocket: WebSocket;
+        try {
+            webSocket = new WebSocket(url, this.getSubprotocols());
+        } catch (error) {
+            return err(new Error(`An error occurred opening a WebSocket connection to '${url}' to follow the logs of services '${Array.from(serviceUuids)}' in enclave '${enclaveIdentifier}'. Error:\n${error}`));
+        }
+
+        let hasFailed: boolean = false;
+        webSocket.onmessage = (event: MessageEvent) => {
+            let message: ServiceLogsMessage | ResponseInfoMessage;
+            try {
+                message = JSON.parse(event.data);
+            } catch (error) {
+                hasFailed = true;
+                callbacks.onError(new Error(`An error occurred parsing service logs message '${event.data}'. Error:\n${error}`));
+                webSocket.close();
+                return;
+            }
+            if (isResponseInfoMessage(message)) {
+                if (message.type === ERROR_RESPONSE_INFO_TYPE) {
+                    hasFailed = true;
+                    callbacks.onError(new Error(`The engine failed streaming the logs of services '${Array.from(serviceUuids)}' in enclave '${enclaveIdentifier}': ${message.message}`));
+                    webSocket.close();
+                }
+                return;
+            }
+            callbacks.onContent(newServiceLogsStreamContent(message));
+        };
+        webSocket.onerror = () => {
+            // The browsers don't tell what went wrong, e.g. to prevent scanning networks
+            hasFailed = true;
+            callbacks.onError(new Error(`An error occurred on the WebSocket connection to '${url}'; check that the engine REST API can be reached, allows the origin of this page, and accepts the engine token if it requires one`));
+        };
+        webSocket.onclose = () => {
+            if (!hasFailed) {
+                callbacks.onEnd();
+            }
+        };
+
+        return ok(new ServiceLogsSubscription(webSocket));
+    }
+
+    private getSubprotocols(): string[] {
+        if (!this.engineToken) {
+            return [];
+        }
+        return [ENGINE_WEBSOCKET_SUBPROTOCOL, BEARER_TOKEN_SUBPROTOCOL_PREFIX + encodeBase64Url(this.engineToken)];
+    }
+
+    private getServiceLogsUrl(enclaveIdentifier: string, serviceUuids: Set<ServiceUUID>, shouldReturnAllLogs: boolean, numLogLines: number): string {
+        const queryParams: string[] = [];
+        serviceUuids.forEach((serviceUuid: ServiceUUID) => {
+            queryParams.push(`${SERVICE_UUID_SET_QUERY_PARAM}=${encodeURIComponent(serviceUuid)}`);
+        });
+        queryParams.push(`${FOLLOW_LOGS_QUERY_PARAM}=true`);
+        queryParams.push(`${RETURN_ALL_LOGS_QUERY_PARAM}=${shouldReturnAllLogs}`);
+        queryParams.push(`${NUM_LOG_LINES_QUERY_PARAM}=${numLogLines}`);
+        return `${this.engineRestApiUrl}${API_PATH}/enclaves/${encodeURIComponent(enclaveIdentifier)}/logs?${queryParams.join("&")}`;
+    }
+}
+
+function encodeBase64Url(value: string): string {
+    let binaryValue: string = "";
+    new TextEncoder().encode(value).forEach((byte: number) => {
+        binaryValue += String.fromCharCode(byte);
+    });
+    return btoa(binaryValue).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+}
+
+function isResponseInfoMessage(message: ServiceLogsMessage | ResponseInfoMessage): message is ResponseInfoMessage {
+    return (message as ResponseInfoMessage).type !== undefined && (message as ResponseInfoMessage).message !== undefined;
+}
+
+function newServiceLogsStreamContent(message: ServiceLogsMessage): ServiceLogsStreamContent {
+    const serviceLogsByServiceUuids: Map<ServiceUUID, Array<ServiceLog>> = new Map<ServiceUUID, Array<ServiceLog>>();
+    const serviceLogsByServiceUuid = message.service_logs_by_service_uuid || {};
+    Object.keys(serviceLogsByServiceUuid).forEach((serviceUuid: string) => {
+        const serviceLogs: Array<ServiceLog> = serviceLogsByServiceUuid[serviceUuid].line.map((logLine: string) => new ServiceLog(logLine));
+        serviceLogsByServiceUuids.set(serviceUuid, serviceLogs);
+    });
+
+    const notFoundServiceUuids: Set<ServiceUUID> = new Set<ServiceUUID>(message.not_found_service_uuid_set || []);
+
+    return new ServiceLogsStreamContent(serviceLogsByServiceUuids, notFoundServiceUuids);
+}
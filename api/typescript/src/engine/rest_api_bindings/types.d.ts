@@ -233,6 +233,34 @@ export interface paths {
       };
     };
   };
+  "/enclaves/{enclave_identifier}/starlark/packages/{package_id}/plan": {
+    /**
+     * Get the plan of a Starlark package
+     * @description Interprets a Starlark package without executing it and returns the plan of what it would do as a YAML document
+     */
+    post: {
+      parameters: {
+        path: {
+          enclave_identifier: components["parameters"]["enclave_identifier"];
+          package_id: components["parameters"]["package_id"];
+        };
+      };
+      requestBody: {
+        content: {
+          "application/json": components["schemas"]["StarlarkPackagePlanYaml"];
+        };
+      };
+      responses: {
+        /** @description Successful request */
+        200: {
+          content: {
+            "application/json": components["schemas"]["PlanYaml"];
+          };
+        };
+        default: components["responses"]["NotOk"];
+      };
+    };
+  };
   "/enclaves/{enclave_identifier}/starlark/scripts": {
     /**
      * Executes a Starlark script on the user's behalf
@@ -266,6 +294,33 @@ export interface paths {
       };
     };
   };
+  "/enclaves/{enclave_identifier}/starlark/scripts/plan": {
+    /**
+     * Get the plan of a Starlark script
+     * @description Interprets a Starlark script without executing it and returns the plan of what it would do as a YAML document
+     */
+    post: {
+      parameters: {
+        path: {
+          enclave_identifier: components["parameters"]["enclave_identifier"];
+        };
+      };
+      requestBody: {
+        content: {
+          "application/json": components["schemas"]["StarlarkScriptPlanYaml"];
+        };
+      };
+      responses: {
+        /** @description Successful request */
+        200: {
+          content: {
+            "application/json": components["schemas"]["PlanYaml"];
+          };
+        };
+        default: components["responses"]["NotOk"];
+      };
+    };
+  };
   "/enclaves/{enclave_identifier}/services/{service_identifier}": {
     /** Returns detailed information about a specific service */
     get: {
@@ -446,6 +501,29 @@ export interface paths {
       };
     };
   };
+  "/enclaves/{enclave_identifier}/artifacts/{artifact_identifier}/history": {
+    /**
+     * Get the version history of a files artifact
+     * @description Lists the versions of a files artifact, oldest first, the last one being the current content
+     */
+    get: {
+      parameters: {
+        path: {
+          enclave_identifier: components["parameters"]["enclave_identifier"];
+          artifact_identifier: components["parameters"]["artifact_identifier"];
+        };
+      };
+      responses: {
+        /** @description Successful request */
+        200: {
+          content: {
+            "application/json": components["schemas"]["FilesArtifactHistory"];
+          };
+        };
+        default: components["responses"]["NotOk"];
+      };
+    };
+  };
   "/enclaves/{enclave_identifier}/artifacts/local-file": {
     /** Uploads local file artifact to the Kurtosis File System */
     post: {
@@ -826,6 +904,36 @@ export interface components {
       /** @description Defaults to empty */
       github_auth_token?: string;
     };
+    StarlarkScriptPlanYaml: {
+      serialized_script: string;
+      /** @description Parameters data for the Starlark script main function */
+      params?: {
+        [key: string]: unknown;
+      };
+      /** @description The name of the main function, the default value is "run" */
+      main_function_name?: string;
+    };
+    StarlarkPackagePlanYaml: {
+      /** @description Parameters data for the Starlark package main function */
+      params?: {
+        [key: string]: unknown;
+      };
+      /**
+       * @description Whether the package should be cloned or not.
+       * If false, then the package will be pulled from the APIC local package store. If it's a local package then is must
+       * have been uploaded using UploadStarlarkPackage prior to getting its plan.
+       * If true, then the package will be cloned from GitHub
+       */
+      clone_package?: boolean;
+      /** @description The relative main file filepath, the default value is the "main.star" file in the root of a package */
+      relative_path_to_main_file?: string;
+      /** @description The name of the main function, the default value is "run" */
+      main_function_name?: string;
+    };
+    PlanYaml: {
+      /** @description The plan of the Starlark run, as a YAML document */
+      plan_yaml: string;
+    };
     /**
      * @description 0 - NO_INSTRUCTIONS_CACHING
      * @enum {string}
@@ -949,6 +1057,34 @@ export interface components {
       url: string;
       /** @description The name of the files artifact */
       name: string;
+      /** @description Headers sent along with the request (e.g. a token for the host serving the file) */
+      headers?: components["schemas"]["HttpHeader"][];
+      /** @description Username for HTTP basic authentication, if the URL requires it */
+      basic_auth_username?: string;
+      /** @description Password for HTTP basic authentication, if the URL requires it */
+      basic_auth_password?: string;
+      /**
+       * @description Hex-encoded SHA-256 that the downloaded content must have. When set, content already downloaded with this
+       * checksum is reused instead of downloading it again
+       */
+      content_sha256?: string;
+    };
+    HttpHeader: {
+      name: string;
+      value: string;
+    };
+    FilesArtifactHistory: {
+      file_name: string;
+      /** @description Versions of the files artifact, oldest first */
+      versions: components["schemas"]["FilesArtifactVersion"][];
+    };
+    FilesArtifactVersion: {
+      /** Format: int64 */
+      version: number;
+      /** @description UUID under which the content of this version is stored; the latest version shares the UUID of the files artifact */
+      file_uuid: string;
+      created_at?: components["schemas"]["Timestamp"];
+      is_latest: boolean;
     };
     StoreFilesArtifactFromService: {
       /** @description The absolute source path where the source files will be copied from */
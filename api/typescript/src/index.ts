@@ -3,7 +3,7 @@ export { KURTOSIS_VERSION } from "./kurtosis_version/kurtosis_version";
 
 // Services
 export type { ServiceName, ServiceUUID } from "./core/lib/services/service";
-export { ServiceContext } from "./core/lib/services/service_context";
+export { ServiceContext, DEFAULT_READINESS_CHECK_INTERVAL_MS, DEFAULT_READINESS_CHECK_TIMEOUT_MS } from "./core/lib/services/service_context";
 export { PortSpec, TransportProtocol } from "./core/lib/services/port_spec"
 
 // Enclaves
@@ -20,5 +20,6 @@ export { KurtosisContext, DEFAULT_GRPC_ENGINE_SERVER_PORT_NUM } from "./engine/l
 export {ServiceLogsStreamContent} from "./engine/lib/kurtosis_context/service_logs_stream_content";
 export {ServiceLog} from "./engine/lib/kurtosis_context/service_log";
 export { LogLineFilter } from "./engine/lib/kurtosis_context/log_line_filter";
+export { WebSocketServiceLogsClient, ServiceLogsSubscription, ServiceLogsCallbacks, DEFAULT_REST_API_ENGINE_SERVER_PORT_NUM } from "./engine/lib/kurtosis_context/websocket_service_logs_client";
 
 export { EnclaveAPIContainerHostMachineInfo } from "./engine/kurtosis_engine_rpc_api_bindings/engine_service_pb"
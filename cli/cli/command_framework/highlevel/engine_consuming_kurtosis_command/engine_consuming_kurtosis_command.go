@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_client_factory"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/ssh_tunnel_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -167,6 +168,19 @@ func (cmd *EngineConsumingKurtosisCommand) getSetupFunc() func(context.Context)
 					return nil, stacktrace.Propagate(err, "Unable to forward the remote engine port to the local machine")
 				}
 			}
+			if store.IsSsh(currentContext) {
+				// The tunnel doesn't survive e.g. a reboot, so it gets restarted for the engine to be reachable
+				isSshTunnelRunning, err := ssh_tunnel_manager.IsRunning()
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred checking whether the SSH tunnel of context '%s' is running", currentContext.GetName())
+				}
+				if !isSshTunnelRunning {
+					logrus.Infof("Tunneling the ports of the remote host of context '%s'", currentContext.GetName())
+					if _, err := ssh_tunnel_manager.StartInBackground(); err != nil {
+						return nil, stacktrace.Propagate(err, "An error occurred tunneling the ports of the remote host of context '%s'", currentContext.GetName())
+					}
+				}
+			}
 		} else {
 			logrus.Warnf("Unable to retrieve current Kurtosis context. This is not critical, it will assume using Kurtosis default context for now.")
 		}
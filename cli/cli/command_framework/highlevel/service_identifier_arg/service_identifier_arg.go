@@ -26,10 +26,16 @@ func NewServiceIdentifierArg(
 
 	validate := getValidationFunc(serviceIdentifierArgKey, isGreedy, enclaveIdentifierArgKey)
 
+	// an optional greedy arg that wasn't provided defaults to an empty list of services
+	var defaultValue interface{} = ""
+	if isGreedy {
+		defaultValue = []string{}
+	}
+
 	return &args.ArgConfig{
 		Key:                   serviceIdentifierArgKey,
 		IsOptional:            isOptional,
-		DefaultValue:          "",
+		DefaultValue:          defaultValue,
 		IsGreedy:              isGreedy,
 		ArgCompletionProvider: args.NewManualCompletionsProvider(getCompletionsOfActiveServices(enclaveIdentifierArgKey)),
 		ValidationFunc:        validate,
@@ -57,3 +57,8 @@ func (flags *ParsedFlags) GetBool(name string) (bool, error) {
 	}
 	return value, nil
 }
+
+// IsSet returns whether the flag was passed on the command line, as opposed to having its default value
+func (flags *ParsedFlags) IsSet(name string) bool {
+	return flags.cmdFlagsSet.Changed(name)
+}
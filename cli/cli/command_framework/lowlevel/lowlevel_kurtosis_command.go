@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -262,7 +263,7 @@ func (kurtosisCmd *LowlevelKurtosisCommand) MustGetCobraCommand() *cobra.Command
 	}
 
 	// Prepare the run function to be slotted into the Cobra command, which will do both arg validation & logic execution
-	cobraRunFunc := func(cmd *cobra.Command, allArgs []string) error {
+	cobraRunFunc := func(cmd *cobra.Command, allArgs []string) (resultErr error) {
 		parsedFlags := flags.NewParsedFlags(cmd.Flags())
 
 		parsedArgs, err := args.ParseArgsForValidation(kurtosisCmd.Args, allArgs)
@@ -275,7 +276,11 @@ func (kurtosisCmd *LowlevelKurtosisCommand) MustGetCobraCommand() *cobra.Command
 			return err
 		}
 
-		ctx := context.Background()
+		// The span of the command is the root of the trace of the calls it makes to the engine and the API containers
+		ctx, span := tracing.StartSpan(cmd.Context(), cmd.CommandPath())
+		defer func() {
+			tracing.EndSpan(span, resultErr)
+		}()
 		if kurtosisCmd.PreValidationAndRunFunc != nil {
 			newCtx, err := kurtosisCmd.PreValidationAndRunFunc(ctx)
 			if err != nil {
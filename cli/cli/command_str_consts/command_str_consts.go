@@ -11,6 +11,8 @@ import (
 var KurtosisCmdStr = path.Base(os.Args[0])
 
 const (
+	AirgapCmdStr            = "airgap"
+	AirgapExportCmdStr      = "export"
 	Analytics               = "analytics"
 	CleanCmdStr             = "clean"
 	CloudAddCmdStr          = "add"
@@ -22,11 +24,14 @@ const (
 	ClusterLsCmdStr         = "ls"
 	ContextCmdStr           = "context"
 	ContextAddCmdStr        = "add"
+	ContextDefaultsCmdStr   = "defaults"
 	ContextLsCmdStr         = "ls"
 	ContextRmCmdStr         = "rm"
 	ContextSetCmdStr        = "set"
+	ContextTunnelCmdStr     = "tunnel"
 	DiscordCmdStr           = "discord"
 	DocsCmdStr              = "docs"
+	DoctorCmdStr            = "doctor"
 	EnclaveCmdStr           = "enclave"
 	EnclaveInspectCmdStr    = "inspect"
 	EnclaveLsCmdStr         = "ls"
@@ -35,16 +40,30 @@ const (
 	EnclaveRmCmdStr         = "rm"
 	EnclaveDumpCmdStr       = "dump"
 	EnclaveConnectCmdStr    = "connect"
+	EnclaveShareCmdStr      = "share"
+	EnclaveGraphCmdStr      = "graph"
+	EnclaveExportCmdStr     = "export"
+	EnclaveLogFiltersCmdStr = "logs-filters"
+	ExportComposeCmdStr     = "compose"
+	ExportKubernetesCmdStr  = "kubernetes"
 	EngineCmdStr            = "engine"
 	EngineLogsCmdStr        = "logs"
 	EngineStartCmdStr       = "start"
 	EngineStatusCmdStr      = "status"
 	EngineStopCmdStr        = "stop"
 	EngineRestartCmdStr     = "restart"
+	EngineReloadCmdStr      = "reload"
+	EngineAuditCmdStr       = "audit"
+	EngineLoginCmdStr       = "login"
+	EngineLogoutCmdStr      = "logout"
+	EngineSystemLogsCmdStr  = "system-logs"
 	FeedbackCmdStr          = "feedback"
 	FilesCmdStr             = "files"
 	FilesUploadCmdStr       = "upload"
 	FilesInspectCmdStr      = "inspect"
+	FilesHistoryCmdStr      = "history"
+	FilesLsCmdStr           = "ls"
+	FilesCatCmdStr          = "cat"
 	FilesDownloadCmdStr     = "download"
 	FilesStoreWebCmdStr     = "storeweb"
 	FilesStoreServiceCmdStr = "storeservice"
@@ -57,15 +76,18 @@ const (
 	PortalStopCmdStr        = "stop"
 	ServiceCmdStr           = "service"
 	ServiceAddCmdStr        = "add"
+	ServiceAdoptCmdStr      = "adopt"
 	ServiceExecCmdStr       = "exec"
 	ServiceLogsCmdStr       = "logs"
 	ServiceRmCmdStr         = "rm"
 	ServiceShellCmdStr      = "shell"
+	ServiceSyncCmdStr       = "sync"
 	ServiceStartCmdStr      = "start"
 	ServiceStopCmdStr       = "stop"
 	ServiceInspectCmdStr    = "inspect"
 	ServiceUpdateCmdStr     = "update"
 	StarlarkRunCmdStr       = "run"
+	ReplCmdStr              = "repl"
 	TwitterCmdStr           = "twitter"
 	ConfigCmdStr            = "config"
 	PathCmdStr              = "path"
@@ -74,8 +96,12 @@ const (
 	GatewayCmdStr           = "gateway"
 	PackageCmdStr           = "package"
 	InitCmdStr              = "init"
+	PackageTestCmdStr       = "test"
 	PortCmdStr              = "port"
 	PortPrintCmdStr         = "print"
+	PortForwardCmdStr       = "forward"
+	PortForwardStatusCmdStr = "status"
+	PortForwardStopCmdStr   = "stop"
 	WebCmdStr               = "web"
 	GitHubCmdStr            = "github"
 	GitHubLoginCmdStr       = "login"
@@ -0,0 +1,19 @@
+package airgap
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/airgap/export"
+	"github.com/spf13/cobra"
+)
+
+// AirgapCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var AirgapCmd = &cobra.Command{
+	Use:   command_str_consts.AirgapCmdStr,
+	Short: "Prepare the mirrors of air-gapped clusters",
+	RunE:  nil,
+}
+
+func init() {
+	AirgapCmd.AddCommand(export.ExportCmd.MustGetCobraCommand())
+}
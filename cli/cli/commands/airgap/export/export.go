@@ -0,0 +1,338 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/client"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/github_auth_store"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	packageIdsArgKey        = "package-ids"
+	packageIdsArgIsOptional = true
+	packageIdsArgIsGreedy   = true
+
+	outputDirFlagKey      = "output"
+	outputDirFlagShortKey = "o"
+	defaultOutputDir      = "kurtosis-airgap-bundle"
+
+	imagesFlagKey      = "images"
+	defaultImages      = ""
+	imagesSeparator    = ","
+	imageMirrorFlagKey = "image-mirror"
+	defaultImageMirror = ""
+
+	imagesArchiveFilename = "images.tar"
+	packagesDirname       = "packages"
+	manifestFilename      = "manifest.json"
+	gitRepositorySuffix   = ".git"
+
+	outputDirPerms    = 0755
+	manifestFilePerms = 0644
+
+	isBareClone = true
+
+	githubAuthUsername = "token"
+)
+
+var packageIdsDefaultValue = []string{}
+
+// The images the engine and the API containers start on their own, which must be on the image mirror whatever the
+// plans run
+var systemImages = []string{
+	"kurtosistech/engine:" + kurtosis_version.KurtosisVersion,
+	"kurtosistech/core:" + kurtosis_version.KurtosisVersion,
+	"kurtosistech/files-artifacts-expander:" + kurtosis_version.KurtosisVersion,
+	"timberio/vector:0.45.0-debian",
+	"fluent/fluent-bit:4.0.0",
+	"fluent/fluent-bit:4.0.0-debug",
+	"traefik:2.10.6",
+	"alpine:3.17",
+	"busybox",
+	"badouralix/curl-jq",
+	"python:3.11-alpine",
+}
+
+// ExportCmd we only fill in the required struct fields, hence the others remain nil
+// nolint: exhaustruct
+var ExportCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.AirgapExportCmdStr,
+	ShortDescription: "Exports what the mirrors of an air-gapped cluster need",
+	LongDescription: "Exports the images Kurtosis starts on its own, the images passed with '--" + imagesFlagKey + "' and " +
+		"the given packages to a bundle that can be carried to an air-gapped network. The images are saved to '" +
+		imagesArchiveFilename + "', to be loaded with 'docker load' and pushed to the image mirror; with '--" +
+		imageMirrorFlagKey + "' they're tagged with their name on the mirror so that 'docker push' is enough. The " +
+		"packages are mirror-cloned to '" + packagesDirname + "/<author>/<repository>.git', to be pushed to the package " +
+		"mirror with 'git push --mirror'. The dependencies of the packages aren't exported unless they're given too",
+	Args: []*args.ArgConfig{
+		{
+			Key:          packageIdsArgKey,
+			DefaultValue: packageIdsDefaultValue,
+			IsOptional:   packageIdsArgIsOptional,
+			IsGreedy:     packageIdsArgIsGreedy,
+		},
+	},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       outputDirFlagKey,
+			Usage:     "The directory the bundle is written to",
+			Shorthand: outputDirFlagShortKey,
+			Type:      flags.FlagType_String,
+			Default:   defaultOutputDir,
+		},
+		{
+			Key:       imagesFlagKey,
+			Shorthand: "",
+			Usage:     "The comma-separated images the plans use, exported in addition to the images Kurtosis starts on its own",
+			Type:      flags.FlagType_String,
+			Default:   defaultImages,
+		},
+		{
+			Key:       imageMirrorFlagKey,
+			Shorthand: "",
+			Usage:     "The image mirror of the air-gapped cluster, e.g. 'registry.example.com/kurtosis', to tag the exported images for",
+			Type:      flags.FlagType_String,
+			Default:   defaultImageMirror,
+		},
+	},
+	RunFunc: run,
+}
+
+type exportedImage struct {
+	Image string `json:"image"`
+	// Empty if the bundle wasn't exported for an image mirror
+	MirroredImage string `json:"mirroredImage,omitempty"`
+}
+
+type exportedPackage struct {
+	PackageId string `json:"packageId"`
+	// Relative to the bundle
+	Path string `json:"path"`
+}
+
+type manifest struct {
+	KurtosisVersion string             `json:"kurtosisVersion"`
+	ImagesArchive   string             `json:"imagesArchive"`
+	Images          []*exportedImage   `json:"images"`
+	Packages        []*exportedPackage `json:"packages"`
+}
+
+func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageIds, err := args.GetGreedyArg(packageIdsArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument with key '%v'", packageIdsArgKey)
+	}
+	outputDir, err := flags.GetString(outputDirFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", outputDirFlagKey)
+	}
+	extraImagesStr, err := flags.GetString(imagesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", imagesFlagKey)
+	}
+	imageMirror, err := flags.GetString(imageMirrorFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", imageMirrorFlagKey)
+	}
+
+	if err := os.MkdirAll(outputDir, outputDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the output directory '%v'", outputDir)
+	}
+
+	images := getImagesToExport(extraImagesStr, imageMirror)
+	if err := exportImages(ctx, images, path.Join(outputDir, imagesArchiveFilename)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting the images")
+	}
+
+	packages := []*exportedPackage{}
+	for _, packageId := range packageIds {
+		exportedPkg, err := exportPackage(packageId, outputDir)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred exporting package '%v'", packageId)
+		}
+		packages = append(packages, exportedPkg)
+	}
+
+	bundleManifest := &manifest{
+		KurtosisVersion: kurtosis_version.KurtosisVersion,
+		ImagesArchive:   imagesArchiveFilename,
+		Images:          images,
+		Packages:        packages,
+	}
+	manifestBytes, err := json.MarshalIndent(bundleManifest, "", "  ")
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the manifest of the bundle")
+	}
+	if err := os.WriteFile(path.Join(outputDir, manifestFilename), manifestBytes, manifestFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the manifest of the bundle")
+	}
+
+	out.PrintOutLn(fmt.Sprintf("Exported %v images and %v packages to '%v'", len(images), len(packages), outputDir))
+	out.PrintOutLn(fmt.Sprintf("Load the images with 'docker load -i %v' and push them to the image mirror", path.Join(outputDir, imagesArchiveFilename)))
+	if len(packages) > 0 {
+		out.PrintOutLn(fmt.Sprintf("Push each repository of '%v' to the package mirror with 'git push --mirror'", path.Join(outputDir, packagesDirname)))
+	}
+	return nil
+}
+
+// getImagesToExport returns the images Kurtosis starts on its own and the extra ones, without duplicates, with their
+// name on the image mirror if there's one
+func getImagesToExport(extraImagesStr string, imageMirror string) []*exportedImage {
+	imageNames := map[string]bool{}
+	for _, imageName := range systemImages {
+		imageNames[imageName] = true
+	}
+	for _, imageName := range strings.Split(extraImagesStr, imagesSeparator) {
+		if trimmedImageName := strings.TrimSpace(imageName); trimmedImageName != "" {
+			imageNames[trimmedImageName] = true
+		}
+	}
+
+	// The package mirror doesn't matter to name the images
+	mirrorConfig := air_gap.AirGapConfig{
+		ImageMirror:   imageMirror,
+		PackageMirror: "",
+	}
+	images := []*exportedImage{}
+	for imageName := range imageNames {
+		mirroredImageRef, _ := mirrorConfig.GetMirroredImageRef(imageName)
+		images = append(images, &exportedImage{
+			Image:         imageName,
+			MirroredImage: mirroredImageRef,
+		})
+	}
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Image < images[j].Image
+	})
+	return images
+}
+
+func exportImages(ctx context.Context, images []*exportedImage, imagesArchiveFilepath string) error {
+	kurtosisBackend, err := backend_creator.GetDockerKurtosisBackend(backend_creator.NoAPIContainerModeArgs, configs.NoRemoteBackendConfig, air_gap.NewDisabledAirGapConfig())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving Docker Kurtosis Backend")
+	}
+	dockerClient, err := client.NewClientWithOpts(backend_creator.GetLocalDockerClientOpts()...)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the Docker client")
+	}
+	defer dockerClient.Close()
+
+	imageRefsToSave := []string{}
+	for _, image := range images {
+		logrus.Infof("Pulling image '%v'", image.Image)
+		if _, _, err := kurtosisBackend.FetchImage(ctx, image.Image, nil, image_download_mode.ImageDownloadMode_Missing); err != nil {
+			return stacktrace.Propagate(err, "An error occurred pulling image '%v'", image.Image)
+		}
+		if image.MirroredImage == "" {
+			imageRefsToSave = append(imageRefsToSave, image.Image)
+			continue
+		}
+		if err := dockerClient.ImageTag(ctx, image.Image, image.MirroredImage); err != nil {
+			return stacktrace.Propagate(err, "An error occurred tagging image '%v' as '%v'", image.Image, image.MirroredImage)
+		}
+		imageRefsToSave = append(imageRefsToSave, image.MirroredImage)
+	}
+
+	logrus.Infof("Saving the images to '%v'", imagesArchiveFilepath)
+	imagesArchiveReader, err := dockerClient.ImageSave(ctx, imageRefsToSave)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the images")
+	}
+	defer imagesArchiveReader.Close()
+	imagesArchiveFile, err := os.Create(imagesArchiveFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the images archive '%v'", imagesArchiveFilepath)
+	}
+	defer imagesArchiveFile.Close()
+	if _, err := io.Copy(imagesArchiveFile, imagesArchiveReader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the images archive '%v'", imagesArchiveFilepath)
+	}
+	return nil
+}
+
+// exportPackage mirror-clones the repository of the package to where the package mirror expects it, relative to the
+// packages directory of the bundle
+func exportPackage(packageId string, outputDir string) (*exportedPackage, error) {
+	parsedUrl, err := shared_utils.ParseGitURL(packageId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing package ID '%v'", packageId)
+	}
+	relativeRepositoryPath := path.Join(packagesDirname, parsedUrl.GetRelativeRepoPath()+gitRepositorySuffix)
+	repositoryPath := path.Join(outputDir, relativeRepositoryPath)
+	if err := os.RemoveAll(repositoryPath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing the previous export of package '%v' at '%v'", packageId, repositoryPath)
+	}
+
+	logrus.Infof("Cloning package '%v'", packageId)
+	_, err = git.PlainClone(repositoryPath, isBareClone, &git.CloneOptions{
+		URL:               parsedUrl.GetGitURL(),
+		Auth:              getGitHubAuth(),
+		RemoteName:        "",
+		ReferenceName:     "",
+		SingleBranch:      false,
+		Mirror:            true,
+		NoCheckout:        false,
+		Depth:             0,
+		RecurseSubmodules: 0,
+		ShallowSubmodules: false,
+		Progress:          nil,
+		Tags:              0,
+		InsecureSkipTLS:   false,
+		CABundle:          nil,
+		ProxyOptions: transport.ProxyOptions{
+			URL:      "",
+			Username: "",
+			Password: "",
+		},
+		Shared: false,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning '%v' to '%v'", parsedUrl.GetGitURL(), repositoryPath)
+	}
+	return &exportedPackage{
+		PackageId: packageId,
+		Path:      relativeRepositoryPath,
+	}, nil
+}
+
+// getGitHubAuth returns the credentials of the user logged into GitHub with 'kurtosis github login', so that private
+// packages can be exported too, or nil if there's none
+func getGitHubAuth() *http.BasicAuth {
+	githubAuthStore, err := github_auth_store.GetGitHubAuthStore()
+	if err != nil {
+		logrus.Debugf("An error occurred getting the GitHub auth store; cloning without credentials\n%v", err)
+		return nil
+	}
+	authToken, err := githubAuthStore.GetAuthToken()
+	if err != nil || authToken == "" {
+		return nil
+	}
+	return &http.BasicAuth{
+		Username: githubAuthUsername,
+		Password: authToken,
+	}
+}
@@ -0,0 +1,32 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetImagesToExport_AddsTheExtraImagesOnce(t *testing.T) {
+	images := getImagesToExport(" postgres:16 ,busybox,,postgres:16", defaultImageMirror)
+	require.Len(t, images, len(systemImages)+1)
+
+	imageNames := map[string]bool{}
+	for _, image := range images {
+		imageNames[image.Image] = true
+		require.Empty(t, image.MirroredImage)
+	}
+	require.True(t, imageNames["postgres:16"])
+	for _, systemImage := range systemImages {
+		require.True(t, imageNames[systemImage])
+	}
+}
+
+func TestGetImagesToExport_TagsTheImagesForTheMirror(t *testing.T) {
+	images := getImagesToExport("ghcr.io/some-org/some-image:1.0.0", "registry.example.com/kurtosis")
+	mirroredImages := map[string]string{}
+	for _, image := range images {
+		mirroredImages[image.Image] = image.MirroredImage
+	}
+	require.Equal(t, "registry.example.com/kurtosis/library/busybox:latest", mirroredImages["busybox"])
+	require.Equal(t, "registry.example.com/kurtosis/some-org/some-image:1.0.0", mirroredImages["ghcr.io/some-org/some-image:1.0.0"])
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
@@ -25,6 +26,10 @@ const (
 	enableDisableDelimiter = "|"
 
 	enableDisableStatus = enableSendingMetrics + enableDisableDelimiter + disableSendingMetrics + enableDisableDelimiter + printMetricsId
+
+	metricsCategoryArgKey = "category"
+	// Means the election applies to all the metrics
+	allMetricsCategories = ""
 )
 
 var validMetricsSendingToggleValue = map[string]bool{
@@ -36,13 +41,23 @@ var validMetricsSendingToggleValue = map[string]bool{
 var AnalyticsCmd = &lowlevel.LowlevelKurtosisCommand{
 	CommandStr:       command_str_consts.Analytics,
 	ShortDescription: "Control Kurtosis's anonymous aggregate user behavior analytics",
-	LongDescription:  "Control Kurtosis's anonymous aggregate user behavior analytics. Read more at\n" + user_support_constants.MetricsPhilosophyDocs,
+	LongDescription: "Control Kurtosis's anonymous aggregate user behavior analytics. Passing a category after 'enable' or " +
+		"'disable' only toggles the metrics of that category, e.g. 'kurtosis analytics disable run'. Read more at\n" +
+		user_support_constants.MetricsPhilosophyDocs,
 	Args: []*args.ArgConfig{
 		// the enableDisableStatus would appear as the name of the argument
 		set_selection_arg.NewSetSelectionArg(
 			enableDisableStatus,
 			validMetricsSendingToggleValue,
 		),
+		{
+			Key:                   metricsCategoryArgKey,
+			IsOptional:            true,
+			DefaultValue:          allMetricsCategories,
+			IsGreedy:              false,
+			ArgCompletionProvider: args.NewManualCompletionsProvider(getMetricsCategoryCompletions),
+			ValidationFunc:        validateMetricsCategory,
+		},
 	},
 	Flags:                    nil,
 	PreValidationAndRunFunc:  nil,
@@ -55,6 +70,10 @@ func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) e
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but none was found; this is a bug in Kurtosis!", enableDisableStatus)
 	}
+	metricsCategoryStr, err := args.GetNonGreedyArg(metricsCategoryArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but none was found; this is a bug in Kurtosis!", metricsCategoryArgKey)
+	}
 
 	// this client will send events regardless of the current metrics election
 	segmentMetricsClient, segmentMetricsClientCloser, err := metrics_client_factory.GetSegmentClient()
@@ -83,6 +102,10 @@ func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) e
 		return nil
 	}
 
+	if metricsCategoryStr != allMetricsCategories {
+		return toggleMetricsCategory(metrics_client.Category(metricsCategoryStr), didUserAcceptSendingMetrics)
+	}
+
 	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
 	var kurtosisConfig *resolved_config.KurtosisConfig
 
@@ -117,6 +140,66 @@ func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) e
 	return nil
 }
 
+// toggleMetricsCategory enables or disables a single category of metrics, which only matters if the user accepted
+// sending metrics, so it requires the config where that election is stored
+func toggleMetricsCategory(category metrics_client.Category, isEnabled bool) error {
+	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
+	hasConfig, err := kurtosisConfigStore.HasConfig()
+	if err != nil {
+		return stacktrace.NewError("An error occurred while determining whether configuration already exists")
+	}
+	if !hasConfig {
+		return stacktrace.NewError("Can't toggle the '%v' metrics as the Kurtosis config doesn't exist yet; run 'kurtosis %v %v' or 'kurtosis %v %v' first", category, command_str_consts.Analytics, enableSendingMetrics, command_str_consts.Analytics, disableSendingMetrics)
+	}
+	kurtosisConfig, err := kurtosisConfigStore.GetConfig()
+	if err != nil {
+		return stacktrace.NewError("An error occurred while fetching stored configuration")
+	}
+	kurtosisConfig = resolved_config.NewKurtosisConfigWithMetricsCategorySetFromExistingConfig(kurtosisConfig, category, isEnabled)
+	if err := kurtosisConfigStore.SetConfig(kurtosisConfig); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting analytics configuration")
+	}
+
+	if isEnabled {
+		logrus.Infof("Analytics tracking of the '%v' category is now %vd", category, enableSendingMetrics)
+		if !kurtosisConfig.GetShouldSendMetrics() {
+			logrus.Warnf("Analytics tracking is disabled as a whole, so no metrics will be sent until it's enabled with 'kurtosis %v %v'", command_str_consts.Analytics, enableSendingMetrics)
+		}
+	} else {
+		logrus.Infof("Analytics tracking of the '%v' category is now %vd", category, disableSendingMetrics)
+	}
+	return nil
+}
+
+func getMetricsCategoryCompletions(ctx context.Context, flags *flags.ParsedFlags, previousArgs *args.ParsedArgs) ([]string, error) {
+	categories := []string{}
+	for _, category := range metrics_client.GetAllCategories() {
+		categories = append(categories, string(category))
+	}
+	return categories, nil
+}
+
+func validateMetricsCategory(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	metricsCategoryStr, err := args.GetNonGreedyArg(metricsCategoryArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but didn't find one", metricsCategoryArgKey)
+	}
+	if metricsCategoryStr == allMetricsCategories {
+		return nil
+	}
+	didUserAcceptSendingMetricsStr, err := args.GetNonGreedyArg(enableDisableStatus)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but didn't find one", enableDisableStatus)
+	}
+	if didUserAcceptSendingMetricsStr == printMetricsId {
+		return stacktrace.NewError("A metrics category can only be passed along with '%v' or '%v'", enableSendingMetrics, disableSendingMetrics)
+	}
+	if !metrics_client.IsValidCategory(metrics_client.Category(metricsCategoryStr)) {
+		return stacktrace.NewError("Value for arg '%v' was '%v', but must be one of %v", metricsCategoryArgKey, metricsCategoryStr, metrics_client.GetAllCategories())
+	}
+	return nil
+}
+
 func didUserAcceptSendingMetricsFunc(didUserAcceptSendingMetricsStr string) (bool, bool, error) {
 	var didUserAcceptSendingMetricsBool bool
 	justPrintMetricsId := false
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_destroyer"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
@@ -16,14 +17,28 @@ import (
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"sort"
 	"strings"
+	"time"
 )
 
 const (
 	shouldCleanRunningEnclavesFlagKey = "all"
 	defaultShouldCleanRunningEnclaves = "false"
 
+	dryRunFlagKey       = "dry-run"
+	defaultShouldDryRun = "false"
+
+	olderThanFlagKey = "older-than"
+	defaultOlderThan = ""
+
+	stoppedOnlyFlagKey       = "stopped-only"
+	defaultShouldStoppedOnly = "false"
+
+	enclaveFiltersFlagKey = "filter"
+	defaultEnclaveFilters = ""
+
 	// Titles of the cleaning phases
 	// Should be lowercased as they'll go into a string like "Cleaning XXXXX...."
 	oldEngineCleaningPhaseTitle = "old Kurtosis engine containers"
@@ -42,8 +57,14 @@ var CleanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand
 	CommandStr:       command_str_consts.CleanCmdStr,
 	ShortDescription: "Cleans up Kurtosis leftover artifacts",
 	LongDescription: fmt.Sprintf(
-		"Removes stopped enclaves (and live ones if the '%v' flag is set), as well as stopped engine containers",
+		"Removes stopped enclaves (and live ones if the '%v' flag is set), as well as stopped engine containers and "+
+			"unused Kurtosis images. The '%v', '%v' and '%v' flags narrow down the enclaves to remove, and leave engine "+
+			"containers and images alone. The '%v' flag prints what would be removed without removing anything",
 		shouldCleanRunningEnclavesFlagKey,
+		olderThanFlagKey,
+		stoppedOnlyFlagKey,
+		enclaveFiltersFlagKey,
+		dryRunFlagKey,
 	),
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
@@ -55,6 +76,40 @@ var CleanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldCleanRunningEnclaves,
 		},
+		{
+			Key:     dryRunFlagKey,
+			Usage:   "If set, prints what would be removed without removing anything",
+			Type:    flags.FlagType_Bool,
+			Default: defaultShouldDryRun,
+		},
+		{
+			Key:     olderThanFlagKey,
+			Usage:   "Only removes enclaves created longer ago than this duration, e.g. '24h'",
+			Type:    flags.FlagType_String,
+			Default: defaultOlderThan,
+		},
+		{
+			Key: stoppedOnlyFlagKey,
+			Usage: fmt.Sprintf(
+				"Only removes stopped enclaves, leaving engine containers and images alone; can't be combined with '%v'",
+				shouldCleanRunningEnclavesFlagKey,
+			),
+			Type:    flags.FlagType_Bool,
+			Default: defaultShouldStoppedOnly,
+		},
+		{
+			Key: enclaveFiltersFlagKey,
+			Usage: fmt.Sprintf(
+				"Only removes the enclaves matching these comma-separated KEY=VALUE filters, where KEY is '%v' (a glob pattern), '%v' or '%v' ('%v' or '%v'), e.g. 'name=test-*,owner=token:ci'",
+				nameEnclaveFilterKey,
+				ownerEnclaveFilterKey,
+				modeEnclaveFilterKey,
+				testEnclaveModeFilterValue,
+				productionEnclaveModeFilterValue,
+			),
+			Type:    flags.FlagType_String,
+			Default: defaultEnclaveFilters,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -70,28 +125,68 @@ func run(
 ) error {
 	shouldCleanAll, err := flags.GetBool(shouldCleanRunningEnclavesFlagKey)
 	if err != nil {
-		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", shouldCleanAll)
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", shouldCleanRunningEnclavesFlagKey)
 	}
+	shouldDryRun, err := flags.GetBool(dryRunFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", dryRunFlagKey)
+	}
+	olderThanStr, err := flags.GetString(olderThanFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", olderThanFlagKey)
+	}
+	shouldCleanStoppedOnly, err := flags.GetBool(stoppedOnlyFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", stoppedOnlyFlagKey)
+	}
+	enclaveFiltersStr, err := flags.GetString(enclaveFiltersFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclaveFiltersFlagKey)
+	}
+	if shouldCleanAll && shouldCleanStoppedOnly {
+		return stacktrace.NewError("The '%v' and '%v' flags can't be combined", shouldCleanRunningEnclavesFlagKey, stoppedOnlyFlagKey)
+	}
+	selector, err := newEnclaveSelector(shouldCleanAll, olderThanStr, enclaveFiltersStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the enclave selectors")
+	}
+	isNarrowed := shouldCleanStoppedOnly || selector.isNarrowed()
 
 	// Map of cleaning_phase_title -> (successfully_destroyed_object_id, object_destruction_errors, clean_error)
 	cleaningPhaseFunctions := map[string]func() ([]string, []error, error){
-		oldEngineCleaningPhaseTitle: func() ([]string, []error, error) {
-			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
-			return cleanStoppedEngineContainers(ctx, kurtosisBackend)
-		},
 		enclavesCleaningPhaseTitle: func() ([]string, []error, error) {
 			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
+			if isNarrowed || shouldDryRun {
+				return cleanSelectedEnclaves(ctx, engineClient, selector, shouldDryRun)
+			}
 			return cleanEnclaves(ctx, engineClient, shouldCleanAll)
 		},
-		unusedImagesPhaseTitle: func() ([]string, []error, error) {
+	}
+	// The selectors only apply to enclaves, so narrowing them down leaves everything else alone
+	if !isNarrowed {
+		cleaningPhaseFunctions[oldEngineCleaningPhaseTitle] = func() ([]string, []error, error) {
 			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
+			if shouldDryRun {
+				return listStoppedEngineContainers(ctx, kurtosisBackend)
+			}
+			return cleanStoppedEngineContainers(ctx, kurtosisBackend)
+		}
+		cleaningPhaseFunctions[unusedImagesPhaseTitle] = func() ([]string, []error, error) {
+			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
+			if shouldDryRun {
+				return listUnusedImages(ctx, kurtosisBackend)
+			}
 			return cleanUnusedImages(ctx, kurtosisBackend)
-		},
+		}
 	}
 
 	phasesWithErrors := []string{}
 	for phaseTitle, cleaningFunc := range cleaningPhaseFunctions {
-		logrus.Infof("Cleaning %v...", phaseTitle)
+		if shouldDryRun {
+			logrus.Infof("Finding %v to clean...", phaseTitle)
+		} else {
+			logrus.Infof("Cleaning %v...", phaseTitle)
+		}
 		successfullyRemovedArtifactUuids, removalErrors, err := cleaningFunc()
 		if err != nil {
 			logrus.Errorf("Errors occurred cleaning %v:\n%v", phaseTitle, err)
@@ -100,7 +195,11 @@ func run(
 		}
 
 		if len(successfullyRemovedArtifactUuids) > 0 {
-			logrus.Infof("Successfully removed the following %v:", phaseTitle)
+			if shouldDryRun {
+				logrus.Infof("The following %v would be removed:", phaseTitle)
+			} else {
+				logrus.Infof("Successfully removed the following %v:", phaseTitle)
+			}
 			sort.Strings(successfullyRemovedArtifactUuids)
 			for _, successfulArtifactUuid := range successfullyRemovedArtifactUuids {
 				out.PrintOutLn(successfulArtifactUuid)
@@ -116,13 +215,18 @@ func run(
 			phasesWithErrors = append(phasesWithErrors, phaseTitle)
 			continue
 		}
-		logrus.Infof("Successfully cleaned %v", phaseTitle)
+		if !shouldDryRun {
+			logrus.Infof("Successfully cleaned %v", phaseTitle)
+		}
 	}
 
 	if len(phasesWithErrors) > 0 {
 		errorStr := "Errors occurred cleaning " + strings.Join(phasesWithErrors, ", ")
 		return errors.New(errorStr)
 	}
+	if shouldDryRun {
+		logrus.Infof("Dry run; nothing was removed. Run the command again without '--%v' to remove the above", dryRunFlagKey)
+	}
 	return nil
 }
 
@@ -133,13 +237,7 @@ func run(
 // ====================================================================================================
 
 func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) ([]string, []error, error) {
-
-	engineFilters := &engine.EngineFilters{
-		GUIDs: nil,
-		Statuses: map[container.ContainerStatus]bool{
-			container.ContainerStatus_Stopped: true,
-		},
-	}
+	engineFilters := getStoppedEngineFilters()
 
 	successfulEngineGuids, erroredEngineGuids, err := kurtosisBackend.DestroyEngines(ctx, engineFilters)
 	if err != nil {
@@ -163,6 +261,28 @@ func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_i
 	return successfulEngineContainerNames, removeEngineErrors, nil
 }
 
+func listStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) ([]string, []error, error) {
+	engineFilters := getStoppedEngineFilters()
+	stoppedEngines, err := kurtosisBackend.GetEngines(ctx, engineFilters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting engines using filters '%+v'", engineFilters)
+	}
+	stoppedEngineContainerNames := []string{}
+	for engineGuid := range stoppedEngines {
+		stoppedEngineContainerNames = append(stoppedEngineContainerNames, kurtosisEngineGuidPrefix+string(engineGuid))
+	}
+	return stoppedEngineContainerNames, nil, nil
+}
+
+func getStoppedEngineFilters() *engine.EngineFilters {
+	return &engine.EngineFilters{
+		GUIDs: nil,
+		Statuses: map[container.ContainerStatus]bool{
+			container.ContainerStatus_Stopped: true,
+		},
+	}
+}
+
 func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool) ([]string, []error, error) {
 	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{ShouldCleanAll: &shouldCleanAll}
 	cleanResp, err := engineClient.Clean(ctx, cleanArgs)
@@ -177,6 +297,51 @@ func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bin
 	return successfullyDestroyedEnclaveUuidsAndNames, nil, nil
 }
 
+// cleanSelectedEnclaves removes the enclaves the selector picks in parallel, or only returns them if it's a dry run
+func cleanSelectedEnclaves(
+	ctx context.Context,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	selector *enclaveSelector,
+	shouldDryRun bool,
+) ([]string, []error, error) {
+	getEnclavesResp, err := engineClient.GetEnclaves(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	now := time.Now()
+	selectedEnclaveUuids := []string{}
+	for enclaveUuid, enclaveInfo := range getEnclavesResp.GetEnclaveInfo() {
+		if selector.matches(enclaveInfo, now) {
+			selectedEnclaveUuids = append(selectedEnclaveUuids, enclaveUuid)
+		}
+	}
+
+	destructionErrors := map[string]error{}
+	if !shouldDryRun {
+		destructionErrors = enclave_destroyer.DestroyEnclavesInParallel(selectedEnclaveUuids, func(enclaveUuid string) error {
+			destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{EnclaveIdentifier: enclaveUuid}
+			if _, err := engineClient.DestroyEnclave(ctx, destroyEnclaveArgs); err != nil {
+				return stacktrace.Propagate(err, "An error occurred destroying enclave '%v'", getEnclavesResp.GetEnclaveInfo()[enclaveUuid].GetName())
+			}
+			return nil
+		})
+	}
+
+	selectedEnclaveUuidsAndNames := []string{}
+	removalErrors := []error{}
+	for _, enclaveUuid := range selectedEnclaveUuids {
+		if destructionErr, found := destructionErrors[enclaveUuid]; found {
+			removalErrors = append(removalErrors, destructionErr)
+			continue
+		}
+		selectedEnclaveUuidsAndNames = append(selectedEnclaveUuidsAndNames, formattedUuidAndName(&kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid{
+			Name: getEnclavesResp.GetEnclaveInfo()[enclaveUuid].GetName(),
+			Uuid: enclaveUuid,
+		}))
+	}
+	return selectedEnclaveUuidsAndNames, removalErrors, nil
+}
+
 func formattedUuidAndName(enclaveUuidWithName *kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid) string {
 	return fmt.Sprintf("%v%v%v", enclaveUuidWithName.Uuid, uuidAndNameDelimiter, enclaveUuidWithName.Name)
 }
@@ -185,3 +350,8 @@ func cleanUnusedImages(ctx context.Context, kurtosisBackend backend_interface.Ku
 	cleanedImages, cleanErr := kurtosisBackend.PruneUnusedImages(ctx)
 	return cleanedImages, nil, cleanErr
 }
+
+func listUnusedImages(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) ([]string, []error, error) {
+	unusedImages, err := kurtosisBackend.ListUnusedImages(ctx)
+	return unusedImages, nil, err
+}
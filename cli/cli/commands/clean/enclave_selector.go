@@ -0,0 +1,123 @@
+package clean
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveFiltersSeparator        = ","
+	enclaveFilterKeyValueSeparator = "="
+
+	nameEnclaveFilterKey  = "name"
+	ownerEnclaveFilterKey = "owner"
+	modeEnclaveFilterKey  = "mode"
+
+	testEnclaveModeFilterValue       = "test"
+	productionEnclaveModeFilterValue = "production"
+)
+
+var enclaveModesByFilterValue = map[string]kurtosis_engine_rpc_api_bindings.EnclaveMode{
+	testEnclaveModeFilterValue:       kurtosis_engine_rpc_api_bindings.EnclaveMode_TEST,
+	productionEnclaveModeFilterValue: kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION,
+}
+
+// enclaveSelector picks the enclaves 'kurtosis clean' removes when it's run with selectors or in dry-run mode
+type enclaveSelector struct {
+	shouldSelectRunning bool
+
+	// Zero if the age of the enclaves doesn't matter
+	olderThan time.Duration
+
+	// Glob pattern matched against the enclave name; empty if any name matches
+	namePattern string
+
+	// Empty if any owner matches
+	owner string
+
+	// Nil if any mode matches
+	mode *kurtosis_engine_rpc_api_bindings.EnclaveMode
+}
+
+// newEnclaveSelector parses the value of the older-than flag, a duration like '24h', and the value of the filter flag,
+// comma-separated KEY=VALUE pairs like 'name=test-*,owner=token:ci'; both can be empty
+func newEnclaveSelector(shouldSelectRunning bool, olderThanStr string, filtersStr string) (*enclaveSelector, error) {
+	selector := &enclaveSelector{
+		shouldSelectRunning: shouldSelectRunning,
+		olderThan:           0,
+		namePattern:         "",
+		owner:               "",
+		mode:                nil,
+	}
+	if olderThanStr != "" {
+		olderThan, err := time.ParseDuration(olderThanStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing '%v' into a duration, e.g. '24h'", olderThanStr)
+		}
+		if olderThan <= 0 {
+			return nil, stacktrace.NewError("The age must be positive, but was '%v'", olderThanStr)
+		}
+		selector.olderThan = olderThan
+	}
+	if filtersStr == "" {
+		return selector, nil
+	}
+	for _, filterStr := range strings.Split(filtersStr, enclaveFiltersSeparator) {
+		key, value, found := strings.Cut(strings.TrimSpace(filterStr), enclaveFilterKeyValueSeparator)
+		if !found || value == "" {
+			return nil, stacktrace.NewError("Filter '%v' must have the form KEY=VALUE", filterStr)
+		}
+		switch key {
+		case nameEnclaveFilterKey:
+			if _, err := path.Match(value, ""); err != nil {
+				return nil, stacktrace.Propagate(err, "The name filter '%v' isn't a valid glob pattern", value)
+			}
+			selector.namePattern = value
+		case ownerEnclaveFilterKey:
+			selector.owner = value
+		case modeEnclaveFilterKey:
+			mode, found := enclaveModesByFilterValue[value]
+			if !found {
+				return nil, stacktrace.NewError("The mode filter must be '%v' or '%v', but was '%v'", testEnclaveModeFilterValue, productionEnclaveModeFilterValue, value)
+			}
+			selector.mode = &mode
+		default:
+			return nil, stacktrace.NewError("Filter key '%v' isn't one of '%v', '%v' or '%v'", key, nameEnclaveFilterKey, ownerEnclaveFilterKey, modeEnclaveFilterKey)
+		}
+	}
+	return selector, nil
+}
+
+// isNarrowed returns true if the selector picks fewer enclaves than a plain 'kurtosis clean' would
+func (selector *enclaveSelector) isNarrowed() bool {
+	return selector.olderThan != 0 || selector.namePattern != "" || selector.owner != "" || selector.mode != nil
+}
+
+func (selector *enclaveSelector) matches(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, now time.Time) bool {
+	// Same statuses as the engine removes on clean
+	if enclaveInfo.GetContainersStatus() == kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING && !selector.shouldSelectRunning {
+		return false
+	}
+	if selector.olderThan != 0 {
+		if enclaveInfo.GetCreationTime() == nil || now.Sub(enclaveInfo.GetCreationTime().AsTime()) < selector.olderThan {
+			return false
+		}
+	}
+	if selector.namePattern != "" {
+		// The pattern was validated when the selector was created
+		if isMatch, _ := path.Match(selector.namePattern, enclaveInfo.GetName()); !isMatch {
+			return false
+		}
+	}
+	if selector.owner != "" && enclaveInfo.GetOwner() != selector.owner {
+		return false
+	}
+	if selector.mode != nil && enclaveInfo.GetMode() != *selector.mode {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package clean
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var now = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestEnclaveInfo(name string, status kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus, age time.Duration, owner string) *kurtosis_engine_rpc_api_bindings.EnclaveInfo {
+	// nolint: exhaustruct
+	return &kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		Name:             name,
+		ContainersStatus: status,
+		CreationTime:     timestamppb.New(now.Add(-age)),
+		Mode:             kurtosis_engine_rpc_api_bindings.EnclaveMode_TEST,
+		Owner:            &owner,
+	}
+}
+
+func TestEnclaveSelector_DefaultSelectsStoppedAndEmptyEnclaves(t *testing.T) {
+	selector, err := newEnclaveSelector(false, "", "")
+	require.NoError(t, err)
+	require.False(t, selector.isNarrowed())
+
+	require.True(t, selector.matches(newTestEnclaveInfo("a", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED, time.Hour, ""), now))
+	require.True(t, selector.matches(newTestEnclaveInfo("b", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_EMPTY, time.Hour, ""), now))
+	require.False(t, selector.matches(newTestEnclaveInfo("c", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING, time.Hour, ""), now))
+
+	selectorWithRunning, err := newEnclaveSelector(true, "", "")
+	require.NoError(t, err)
+	require.True(t, selectorWithRunning.matches(newTestEnclaveInfo("c", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING, time.Hour, ""), now))
+}
+
+func TestEnclaveSelector_OlderThan(t *testing.T) {
+	selector, err := newEnclaveSelector(false, "24h", "")
+	require.NoError(t, err)
+	require.True(t, selector.isNarrowed())
+
+	require.True(t, selector.matches(newTestEnclaveInfo("old", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED, 25*time.Hour, ""), now))
+	require.False(t, selector.matches(newTestEnclaveInfo("new", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED, time.Hour, ""), now))
+}
+
+func TestEnclaveSelector_Filters(t *testing.T) {
+	selector, err := newEnclaveSelector(true, "", "name=ci-*,owner=token:ci,mode=test")
+	require.NoError(t, err)
+	require.True(t, selector.isNarrowed())
+
+	require.True(t, selector.matches(newTestEnclaveInfo("ci-123", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING, time.Hour, "token:ci"), now))
+	require.False(t, selector.matches(newTestEnclaveInfo("dev-123", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING, time.Hour, "token:ci"), now))
+	require.False(t, selector.matches(newTestEnclaveInfo("ci-123", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING, time.Hour, "oidc:jane"), now))
+
+	productionEnclave := newTestEnclaveInfo("ci-123", kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING, time.Hour, "token:ci")
+	productionEnclave.Mode = kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION
+	require.False(t, selector.matches(productionEnclave, now))
+}
+
+func TestEnclaveSelector_InvalidSelectors(t *testing.T) {
+	_, err := newEnclaveSelector(false, "yesterday", "")
+	require.Error(t, err)
+	_, err = newEnclaveSelector(false, "-1h", "")
+	require.Error(t, err)
+	_, err = newEnclaveSelector(false, "", "name")
+	require.Error(t, err)
+	_, err = newEnclaveSelector(false, "", "label=foo")
+	require.Error(t, err)
+	_, err = newEnclaveSelector(false, "", "mode=staging")
+	require.Error(t, err)
+	_, err = newEnclaveSelector(false, "", "name=[")
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_cluster_setting"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
@@ -20,6 +21,12 @@ const (
 	isCurrentClusterStrIndicator = "*"
 )
 
+// clusterOutput is how a cluster is printed with the output format flag
+type clusterOutput struct {
+	Name      string `json:"name" yaml:"name"`
+	IsCurrent bool   `json:"is_current" yaml:"is_current"`
+}
+
 var LsCmd = &lowlevel.LowlevelKurtosisCommand{
 	CommandStr:               command_str_consts.ClusterLsCmdStr,
 	ShortDescription:         "List valid clusters",
@@ -37,6 +44,29 @@ func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) e
 		return stacktrace.Propagate(err, "Failed to get Kurtosis cluster list")
 	}
 
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
+	if outputFormat.IsStructured() {
+		clustersOutput := []clusterOutput{}
+		for _, clusterName := range clusterList {
+			clustersOutput = append(clustersOutput, clusterOutput{
+				Name:      clusterName,
+				IsCurrent: isCurrentCluster(clusterName),
+			})
+		}
+		if err = output_printers.PrintStructuredOutput(outputFormat, clustersOutput); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the clusters as '%v'", outputFormat)
+		}
+		return nil
+	}
+
 	tablePrinter := output_printers.NewTablePrinter(clusterCurrentColumnHeader, clusterNameColumnHeader)
 
 	for _, clusterName := range clusterList {
@@ -65,7 +65,7 @@ func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) e
 	if err != nil {
 		return stacktrace.Propagate(err, "tried fetching the current Kurtosis context but failed, we can't switch clusters without this information. This is a bug in Kurtosis")
 	}
-	if store.IsRemote(currentKurtosisContext) {
+	if store.IsRemote(currentKurtosisContext) || store.IsSsh(currentKurtosisContext) {
 		return stacktrace.NewError("Switching clusters on a remote context is not a permitted operation, please switch to the local context using `kurtosis %s %s default` before switching clusters", command_str_consts.ContextCmdStr, command_str_consts.ContextSetCmdStr)
 	}
 
@@ -0,0 +1,81 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+)
+
+const (
+	dockerDaemonCheckName = "Docker daemon"
+
+	dockerRequestTimeout = 10 * time.Second
+
+	dockerLabelFilterKey    = "label"
+	dockerLabelFilterFormat = "%v=%v"
+)
+
+// runDockerChecks checks that the Docker daemon is reachable, and returns the images of the API containers if it is
+func runDockerChecks(ctx context.Context) ([]*finding, []*apiContainerImage, bool) {
+	dockerClient, err := client.NewClientWithOpts(backend_creator.GetLocalDockerClientOpts()...)
+	if err != nil {
+		return []*finding{
+			newFailureFinding(
+				dockerDaemonCheckName,
+				fmt.Sprintf("The Docker client can't be created: %v", err),
+				fmt.Sprintf("Check the value of the '%v' environment variable", client.EnvOverrideHost),
+			),
+		}, nil, false
+	}
+	defer dockerClient.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, dockerRequestTimeout)
+	defer cancel()
+	serverVersion, err := dockerClient.ServerVersion(timeoutCtx)
+	if err != nil {
+		return []*finding{
+			newFailureFinding(
+				dockerDaemonCheckName,
+				fmt.Sprintf("The Docker daemon at '%v' can't be reached: %v", dockerClient.DaemonHost(), err),
+				fmt.Sprintf("Start Docker, or set the '%v' environment variable to the address of a running Docker daemon", client.EnvOverrideHost),
+			),
+		}, nil, false
+	}
+	findings := []*finding{
+		newOkFinding(dockerDaemonCheckName, fmt.Sprintf("Docker %v is reachable at '%v' (API version %v, %v/%v)", serverVersion.Version, dockerClient.DaemonHost(), serverVersion.APIVersion, serverVersion.Os, serverVersion.Arch)),
+	}
+
+	apiContainerFilters := filters.NewArgs(
+		filters.Arg(dockerLabelFilterKey, fmt.Sprintf(dockerLabelFilterFormat, docker_label_key.AppIDDockerLabelKey.GetString(), label_value_consts.AppIDDockerLabelValue.GetString())),
+		filters.Arg(dockerLabelFilterKey, fmt.Sprintf(dockerLabelFilterFormat, docker_label_key.ContainerTypeDockerLabelKey.GetString(), label_value_consts.APIContainerContainerTypeDockerLabelValue.GetString())),
+	)
+	// nolint: exhaustruct
+	apiContainers, err := dockerClient.ContainerList(timeoutCtx, types.ContainerListOptions{
+		All:     false,
+		Filters: apiContainerFilters,
+	})
+	if err != nil {
+		findings = append(findings, newWarningFinding(
+			apiContainerVersionsCheckName,
+			fmt.Sprintf("The API containers can't be listed: %v", err),
+			"Check that the user can list containers with 'docker ps'",
+		))
+		return findings, nil, true
+	}
+	apiContainerImages := []*apiContainerImage{}
+	for _, apiContainer := range apiContainers {
+		apiContainerImages = append(apiContainerImages, &apiContainerImage{
+			enclaveUuid: enclave.EnclaveUUID(apiContainer.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]),
+			image:       apiContainer.Image,
+		})
+	}
+	return findings, apiContainerImages, true
+}
@@ -0,0 +1,125 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	kurtosisConfigCheckName = "Kurtosis config"
+
+	okFindingSymbol      = "✓"
+	warningFindingSymbol = "!"
+	failureFindingSymbol = "✗"
+
+	remedyIndent = "    "
+)
+
+var DoctorCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.DoctorCmdStr,
+	ShortDescription: "Diagnoses the Kurtosis setup",
+	LongDescription: "Checks that the cluster of the Kurtosis config is reachable, that the Kubernetes user has the " +
+		"permissions Kurtosis needs, that the storage class exists, that the engine and the API containers run the " +
+		"version of the CLI, that they run in FIPS mode if the config enables it, and that the logs aggregator and collectors run, and prints how to fix what isn't right",
+	Flags:                    []*flags.FlagConfig{},
+	Args:                     []*args.ArgConfig{},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(ctx context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error {
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
+	findings := runChecks(ctx)
+
+	if outputFormat.IsStructured() {
+		if err = output_printers.PrintStructuredOutput(outputFormat, findings); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the findings as '%v'", outputFormat)
+		}
+	} else {
+		printFindings(findings)
+	}
+
+	numberOfFailures := 0
+	for _, finding := range findings {
+		if finding.Severity == findingSeverity_Failure {
+			numberOfFailures++
+		}
+	}
+	if numberOfFailures > 0 {
+		return stacktrace.NewError("%d check(s) failed; see above for how to fix them", numberOfFailures)
+	}
+	return nil
+}
+
+func runChecks(ctx context.Context) []*finding {
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return []*finding{
+			newFailureFinding(
+				kurtosisConfigCheckName,
+				fmt.Sprintf("The Kurtosis config can't be read: %v", err),
+				"Fix the config file printed by 'kurtosis config path', or select another cluster with 'kurtosis cluster set'",
+			),
+		}
+	}
+
+	var findings []*finding
+	var apiContainerImages []*apiContainerImage
+	var isClusterReachable bool
+	switch clusterConfig.GetClusterType() {
+	case resolved_config.KurtosisClusterType_Docker:
+		findings, apiContainerImages, isClusterReachable = runDockerChecks(ctx)
+	case resolved_config.KurtosisClusterType_Kubernetes:
+		findings, apiContainerImages, isClusterReachable = runKubernetesChecks(ctx, clusterConfig.GetKubernetesStorageClass())
+	default:
+		return []*finding{
+			newFailureFinding(
+				kurtosisConfigCheckName,
+				fmt.Sprintf("The cluster has unrecognized type '%v'", clusterConfig.GetClusterType()),
+				"Select a Docker or Kubernetes cluster with 'kurtosis cluster set'",
+			),
+		}
+	}
+	if !isClusterReachable {
+		return findings
+	}
+	return append(findings, runKurtosisChecks(ctx, clusterConfig, apiContainerImages)...)
+}
+
+func printFindings(findings []*finding) {
+	for _, finding := range findings {
+		symbol := okFindingSymbol
+		switch finding.Severity {
+		case findingSeverity_Warning:
+			symbol = warningFindingSymbol
+		case findingSeverity_Failure:
+			symbol = failureFindingSymbol
+		case findingSeverity_Ok:
+		}
+		out.PrintOutLn(fmt.Sprintf("%v %v: %v", symbol, finding.Check, finding.Message))
+		if finding.Remedy != "" {
+			out.PrintOutLn(remedyIndent + strings.ReplaceAll(finding.Remedy, "\n", "\n"+remedyIndent))
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package doctor
+
+type findingSeverity string
+
+const (
+	findingSeverity_Ok      findingSeverity = "ok"
+	findingSeverity_Warning findingSeverity = "warning"
+	findingSeverity_Failure findingSeverity = "failure"
+)
+
+// finding is the outcome of a check; warnings are things that may go wrong later, and failures things that will
+type finding struct {
+	Check    string          `json:"check" yaml:"check"`
+	Severity findingSeverity `json:"severity" yaml:"severity"`
+	Message  string          `json:"message" yaml:"message"`
+
+	// How to fix what the check found; empty if the check passed
+	Remedy string `json:"remedy,omitempty" yaml:"remedy,omitempty"`
+}
+
+func newOkFinding(check string, message string) *finding {
+	return &finding{
+		Check:    check,
+		Severity: findingSeverity_Ok,
+		Message:  message,
+		Remedy:   "",
+	}
+}
+
+func newWarningFinding(check string, message string, remedy string) *finding {
+	return &finding{
+		Check:    check,
+		Severity: findingSeverity_Warning,
+		Message:  message,
+		Remedy:   remedy,
+	}
+}
+
+func newFailureFinding(check string, message string, remedy string) *finding {
+	return &finding{
+		Check:    check,
+		Severity: findingSeverity_Failure,
+		Message:  message,
+		Remedy:   remedy,
+	}
+}
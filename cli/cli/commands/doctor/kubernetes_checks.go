@@ -0,0 +1,229 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kubernetes_manager_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	kubernetesClusterCheckName      = "Kubernetes cluster"
+	kubernetesPermissionsCheckName  = "Kubernetes permissions"
+	kubernetesStorageClassCheckName = "Kubernetes storage class"
+
+	kubernetesRequestTimeout = 10 * time.Second
+
+	kubernetesCoreApiGroup         = ""
+	kubernetesAppsApiGroup         = "apps"
+	kubernetesCoordinationApiGroup = "coordination.k8s.io"
+
+	kubernetesSubresourceSeparator = "/"
+
+	// Checks the permissions in every namespace, as Kurtosis creates a namespace per enclave
+	allKubernetesNamespaces = ""
+
+	listSeparator = ", "
+)
+
+type kubernetesPermission struct {
+	verb     string
+	apiGroup string
+	// Can be a subresource, e.g. 'pods/exec'
+	resource string
+}
+
+// The permissions the CLI needs to start the engine. The engine is granted a cluster role with these permissions, which
+// the user needs to hold to be allowed to create it
+var requiredKubernetesPermissions = []kubernetesPermission{
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.NamespacesKubernetesResource},
+	{verb: kubernetes_manager_consts.DeleteKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.NamespacesKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.ServiceAccountsKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetes_manager_consts.RbacAuthorizationApiGroup, resource: kubernetes_manager_consts.ClusterRolesKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetes_manager_consts.RbacAuthorizationApiGroup, resource: kubernetes_manager_consts.ClusterRoleBindingsKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetes_manager_consts.RbacAuthorizationApiGroup, resource: kubernetes_manager_consts.RolesKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.PodsKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.PodExecsKubernetesResource},
+	{verb: kubernetes_manager_consts.GetKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.PodLogsKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.ServicesKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.PersistentVolumeClaimsKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.ConfigMapsKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.ResourceQuotasKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesAppsApiGroup, resource: kubernetes_manager_consts.DeploymentsKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesAppsApiGroup, resource: kubernetes_manager_consts.DaemonSetsKubernetesResource},
+	{verb: kubernetes_manager_consts.CreateKubernetesVerb, apiGroup: kubernetesCoordinationApiGroup, resource: kubernetes_manager_consts.LeasesKubernetesResource},
+	{verb: kubernetes_manager_consts.ListKubernetesVerb, apiGroup: kubernetesCoreApiGroup, resource: kubernetes_manager_consts.NodesKubernetesResource},
+}
+
+// runKubernetesChecks checks that the cluster of the current kubeconfig context is reachable, that the user can start
+// the engine on it and that the storage class exists, and returns the images of the API containers if it's reachable
+func runKubernetesChecks(ctx context.Context, storageClass string) ([]*finding, []*apiContainerImage, bool) {
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		nil, // empty overrides
+	)
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return []*finding{
+			newFailureFinding(
+				kubernetesClusterCheckName,
+				fmt.Sprintf("The kubeconfig can't be read: %v", err),
+				fmt.Sprintf("Check the '%v' environment variable, or the kubeconfig at '%v'", clientcmd.RecommendedConfigPathEnvVar, clientcmd.RecommendedHomeFile),
+			),
+		}, nil, false
+	}
+	kubernetesConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return []*finding{
+			newFailureFinding(
+				kubernetesClusterCheckName,
+				fmt.Sprintf("The kubeconfig context '%v' is invalid: %v", rawConfig.CurrentContext, err),
+				"Select a valid context with 'kubectl config use-context <CONTEXT>'",
+			),
+		}, nil, false
+	}
+	kubernetesConfig.Timeout = kubernetesRequestTimeout
+	clientSet, err := kubernetes.NewForConfig(kubernetesConfig)
+	if err != nil {
+		return []*finding{
+			newFailureFinding(
+				kubernetesClusterCheckName,
+				fmt.Sprintf("The Kubernetes client can't be created for context '%v': %v", rawConfig.CurrentContext, err),
+				"Check the cluster and the credentials of the context with 'kubectl config view --minify'",
+			),
+		}, nil, false
+	}
+	serverVersion, err := clientSet.Discovery().ServerVersion()
+	if err != nil {
+		return []*finding{
+			newFailureFinding(
+				kubernetesClusterCheckName,
+				fmt.Sprintf("The cluster of context '%v' at '%v' can't be reached: %v", rawConfig.CurrentContext, kubernetesConfig.Host, err),
+				"Check that the cluster runs and that 'kubectl get nodes' works, or select another context with 'kubectl config use-context <CONTEXT>'",
+			),
+		}, nil, false
+	}
+
+	findings := []*finding{
+		newOkFinding(kubernetesClusterCheckName, fmt.Sprintf("Kubernetes %v is reachable at '%v' through context '%v'", serverVersion.GitVersion, kubernetesConfig.Host, rawConfig.CurrentContext)),
+		checkKubernetesPermissions(ctx, clientSet),
+		checkKubernetesStorageClass(ctx, clientSet, storageClass),
+	}
+
+	apiContainerSelector := labels.SelectorFromSet(map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.APIContainerKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	})
+	// nolint: exhaustruct
+	apiContainerPods, err := clientSet.CoreV1().Pods(allKubernetesNamespaces).List(ctx, metav1.ListOptions{
+		LabelSelector: apiContainerSelector.String(),
+	})
+	if err != nil {
+		findings = append(findings, newWarningFinding(
+			apiContainerVersionsCheckName,
+			fmt.Sprintf("The API container pods can't be listed: %v", err),
+			"Check that the user of the context can list pods in every namespace",
+		))
+		return findings, nil, true
+	}
+	apiContainerImages := []*apiContainerImage{}
+	for _, pod := range apiContainerPods.Items {
+		if len(pod.Spec.Containers) == 0 {
+			continue
+		}
+		apiContainerImages = append(apiContainerImages, &apiContainerImage{
+			enclaveUuid: enclave.EnclaveUUID(pod.Labels[kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString()]),
+			image:       pod.Spec.Containers[0].Image,
+		})
+	}
+	return findings, apiContainerImages, true
+}
+
+func checkKubernetesPermissions(ctx context.Context, clientSet *kubernetes.Clientset) *finding {
+	deniedPermissions := []string{}
+	for _, permission := range requiredKubernetesPermissions {
+		resource, subresource, _ := strings.Cut(permission.resource, kubernetesSubresourceSeparator)
+		// nolint: exhaustruct
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace:   allKubernetesNamespaces,
+					Verb:        permission.verb,
+					Group:       permission.apiGroup,
+					Resource:    resource,
+					Subresource: subresource,
+				},
+			},
+		}
+		// nolint: exhaustruct
+		result, err := clientSet.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+		if err != nil {
+			return newWarningFinding(
+				kubernetesPermissionsCheckName,
+				fmt.Sprintf("The permissions of the user can't be checked: %v", err),
+				"Check the permissions with 'kubectl auth can-i --list'",
+			)
+		}
+		if !result.Status.Allowed {
+			deniedPermissions = append(deniedPermissions, formatKubernetesPermission(permission))
+		}
+	}
+	if len(deniedPermissions) > 0 {
+		return newFailureFinding(
+			kubernetesPermissionsCheckName,
+			fmt.Sprintf("The user isn't allowed to %v in every namespace; starting the engine will fail", strings.Join(deniedPermissions, listSeparator)),
+			"Ask a cluster admin to grant these permissions to the user of the context, e.g. through a ClusterRoleBinding",
+		)
+	}
+	return newOkFinding(kubernetesPermissionsCheckName, fmt.Sprintf("The user has the %d permissions Kurtosis needs", len(requiredKubernetesPermissions)))
+}
+
+func checkKubernetesStorageClass(ctx context.Context, clientSet *kubernetes.Clientset, storageClass string) *finding {
+	// nolint: exhaustruct
+	_, err := clientSet.StorageV1().StorageClasses().Get(ctx, storageClass, metav1.GetOptions{})
+	if err == nil {
+		return newOkFinding(kubernetesStorageClassCheckName, fmt.Sprintf("Storage class '%v' exists", storageClass))
+	}
+	if !apierrors.IsNotFound(err) {
+		return newWarningFinding(
+			kubernetesStorageClassCheckName,
+			fmt.Sprintf("Storage class '%v' can't be checked: %v", storageClass, err),
+			"Check that it exists with 'kubectl get storageclass'",
+		)
+	}
+
+	remedy := "Set 'storage-class' in the cluster config of the Kurtosis config to an existing storage class"
+	// nolint: exhaustruct
+	existingStorageClasses, err := clientSet.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err == nil && len(existingStorageClasses.Items) > 0 {
+		existingStorageClassNames := []string{}
+		for _, existingStorageClass := range existingStorageClasses.Items {
+			existingStorageClassNames = append(existingStorageClassNames, existingStorageClass.Name)
+		}
+		remedy = fmt.Sprintf("%v; the cluster has: %v", remedy, strings.Join(existingStorageClassNames, listSeparator))
+	}
+	return newFailureFinding(
+		kubernetesStorageClassCheckName,
+		fmt.Sprintf("Storage class '%v' of the Kurtosis config doesn't exist; creating enclaves will fail", storageClass),
+		remedy,
+	)
+}
+
+func formatKubernetesPermission(permission kubernetesPermission) string {
+	resource := permission.resource
+	if permission.apiGroup != kubernetesCoreApiGroup {
+		resource = resource + "." + permission.apiGroup
+	}
+	return permission.verb + " " + resource
+}
@@ -0,0 +1,241 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/fips_mode"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
+)
+
+const (
+	engineCheckName               = "Engine"
+	apiContainerVersionsCheckName = "API container versions"
+	logsAggregatorCheckName       = "Logs aggregator"
+	logsCollectorsCheckName       = "Logs collectors"
+	cliFipsModeCheckName          = "CLI FIPS mode"
+	engineFipsModeCheckName       = "Engine FIPS mode"
+
+	imageTagSeparator     = ":"
+	imagePathSeparator    = "/"
+	imageDigestSeparator  = "@"
+	unknownImageTagString = "<unknown>"
+)
+
+// apiContainerImage is the image a running API container was started from; its tag is the version of the API container
+type apiContainerImage struct {
+	enclaveUuid enclave.EnclaveUUID
+	image       string
+}
+
+// runKurtosisChecks checks that the engine and the API containers run the version of the CLI, that they run in FIPS
+// mode if the config enables it, and that the logs pipeline is up
+func runKurtosisChecks(ctx context.Context, clusterConfig *resolved_config.KurtosisClusterConfig, apiContainerImages []*apiContainerImage) []*finding {
+	findings := []*finding{}
+
+	engineManager, err := engine_manager.NewEngineManager(ctx)
+	if err != nil {
+		return append(findings, newFailureFinding(
+			engineCheckName,
+			fmt.Sprintf("The engine status can't be fetched: %v", err),
+			"Fix the cluster findings above, then run 'kurtosis doctor' again",
+		))
+	}
+	engineStatus, _, engineVersion, err := engineManager.GetEngineStatus(ctx)
+	if err != nil {
+		return append(findings, newFailureFinding(
+			engineCheckName,
+			fmt.Sprintf("The engine status can't be fetched: %v", err),
+			"Fix the cluster findings above, then run 'kurtosis doctor' again",
+		))
+	}
+	switch engineStatus {
+	case engine_manager.EngineStatus_Stopped:
+		findings = append(findings, newWarningFinding(
+			engineCheckName,
+			"No engine is running; Kurtosis commands will start one",
+			"Start it now with 'kurtosis engine start' to surface startup errors",
+		))
+	case engine_manager.EngineStatus_ContainerRunningButServerNotResponding:
+		findings = append(findings, newFailureFinding(
+			engineCheckName,
+			"The engine container is running but the engine server isn't responding",
+			"Check why with 'kurtosis engine logs', then run 'kurtosis engine restart'",
+		))
+	case engine_manager.EngineStatus_Running:
+		if engineVersion != kurtosis_version.KurtosisVersion {
+			findings = append(findings, newFailureFinding(
+				engineCheckName,
+				fmt.Sprintf("The engine runs version %v but the CLI is version %v", engineVersion, kurtosis_version.KurtosisVersion),
+				"Run 'kurtosis engine restart' to start an engine of the version of the CLI",
+			))
+		} else {
+			findings = append(findings, newOkFinding(engineCheckName, fmt.Sprintf("The engine runs version %v, the version of the CLI", engineVersion)))
+		}
+	}
+
+	if clusterConfig.IsFipsModeEnabled() {
+		findings = append(findings, checkCliFipsMode())
+		if engineStatus == engine_manager.EngineStatus_Running {
+			findings = append(findings, checkEngineFipsMode(ctx, engineManager))
+		}
+	}
+
+	findings = append(findings, checkApiContainerVersions(apiContainerImages))
+
+	kurtosisBackend, err := clusterConfig.GetKurtosisBackend(ctx)
+	if err != nil {
+		return append(findings, newWarningFinding(
+			logsAggregatorCheckName,
+			fmt.Sprintf("The logs pipeline can't be checked: %v", err),
+			"Fix the cluster findings above, then run 'kurtosis doctor' again",
+		))
+	}
+	// The engine starts the logs pipeline, so it's only expected to be up when the engine runs
+	if engineStatus != engine_manager.EngineStatus_Running {
+		return findings
+	}
+	findings = append(findings, checkLogsAggregator(ctx, kurtosisBackend))
+	return append(findings, checkLogsCollectors(ctx, kurtosisBackend))
+}
+
+func checkApiContainerVersions(apiContainerImages []*apiContainerImage) *finding {
+	outdatedApiContainers := []string{}
+	for _, apiContainer := range apiContainerImages {
+		apiContainerVersion := getImageTag(apiContainer.image)
+		if apiContainerVersion != kurtosis_version.KurtosisVersion {
+			outdatedApiContainers = append(outdatedApiContainers, fmt.Sprintf("%v (version %v)", apiContainer.enclaveUuid, apiContainerVersion))
+		}
+	}
+	if len(outdatedApiContainers) > 0 {
+		return newWarningFinding(
+			apiContainerVersionsCheckName,
+			fmt.Sprintf("The API containers of enclaves %v don't run version %v of the CLI; new features may fail in them", strings.Join(outdatedApiContainers, listSeparator), kurtosis_version.KurtosisVersion),
+			"Run 'kurtosis engine restart --restart-api-containers', or recreate the enclaves",
+		)
+	}
+	return newOkFinding(apiContainerVersionsCheckName, fmt.Sprintf("The %d running API container(s) run the version of the CLI", len(apiContainerImages)))
+}
+
+func checkCliFipsMode() *finding {
+	if !fips_mode.IsValidatedCryptoInUse() {
+		return newWarningFinding(
+			cliFipsModeCheckName,
+			"The CLI isn't a FIPS build; its connections to the API containers are restricted to the FIPS-approved cipher suites, but don't use validated crypto",
+			"Install a CLI built with KURTOSIS_FIPS_BUILD=true",
+		)
+	}
+	return newOkFinding(cliFipsModeCheckName, "The CLI uses validated crypto")
+}
+
+func checkEngineFipsMode(ctx context.Context, engineManager *engine_manager.EngineManager) *finding {
+	engineInfo, err := engineManager.GetEngineInfo(ctx)
+	if err != nil {
+		return newWarningFinding(
+			engineFipsModeCheckName,
+			fmt.Sprintf("The FIPS mode of the engine can't be fetched: %v", err),
+			"Run 'kurtosis doctor' again; if it keeps failing, run 'kurtosis engine restart'",
+		)
+	}
+	return getEngineFipsModeFinding(engineInfo)
+}
+
+func getEngineFipsModeFinding(engineInfo *kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse) *finding {
+	if !engineInfo.GetFipsMode() {
+		return newFailureFinding(
+			engineFipsModeCheckName,
+			"The config enables fips-mode but the engine doesn't run in FIPS mode; its TLS connections and those of the API containers aren't restricted",
+			"Run 'kurtosis engine restart' to start the engine in FIPS mode",
+		)
+	}
+	if !engineInfo.GetValidatedCrypto() {
+		return newWarningFinding(
+			engineFipsModeCheckName,
+			"The engine runs in FIPS mode but its image isn't a FIPS build, so it doesn't use validated crypto",
+			"Run the engine and the API containers from images built with KURTOSIS_FIPS_BUILD=true",
+		)
+	}
+	return newOkFinding(engineFipsModeCheckName, "The engine runs in FIPS mode with validated crypto")
+}
+
+func checkLogsAggregator(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) *finding {
+	maybeLogsAggregator, err := kurtosisBackend.GetLogsAggregator(ctx)
+	if err != nil {
+		return newWarningFinding(
+			logsAggregatorCheckName,
+			fmt.Sprintf("The logs aggregator can't be fetched: %v", err),
+			"Run 'kurtosis doctor' again; if it keeps failing, run 'kurtosis engine restart'",
+		)
+	}
+	if maybeLogsAggregator == nil {
+		return newFailureFinding(
+			logsAggregatorCheckName,
+			"The engine runs but the logs aggregator doesn't exist; service logs won't be stored",
+			"Run 'kurtosis engine restart' to recreate it",
+		)
+	}
+	if maybeLogsAggregator.GetStatus() != container.ContainerStatus_Running {
+		return newFailureFinding(
+			logsAggregatorCheckName,
+			fmt.Sprintf("The logs aggregator is %v; service logs won't be stored", maybeLogsAggregator.GetStatus()),
+			"Run 'kurtosis engine restart' to recreate it",
+		)
+	}
+	return newOkFinding(logsAggregatorCheckName, "The logs aggregator is running")
+}
+
+func checkLogsCollectors(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) *finding {
+	runningEnclaveFilters := &enclave.EnclaveFilters{
+		UUIDs: nil,
+		Statuses: map[enclave.EnclaveStatus]bool{
+			enclave.EnclaveStatus_Running: true,
+		},
+	}
+	runningEnclaves, err := kurtosisBackend.GetEnclaves(ctx, runningEnclaveFilters)
+	if err != nil {
+		return newWarningFinding(
+			logsCollectorsCheckName,
+			fmt.Sprintf("The running enclaves can't be listed: %v", err),
+			"Run 'kurtosis doctor' again; if it keeps failing, check the enclaves with 'kurtosis enclave ls'",
+		)
+	}
+	enclavesWithoutLogsCollector := []string{}
+	for enclaveUuid, runningEnclave := range runningEnclaves {
+		maybeLogsCollector, err := kurtosisBackend.GetLogsCollectorForEnclave(ctx, enclaveUuid)
+		if err != nil {
+			return newWarningFinding(
+				logsCollectorsCheckName,
+				fmt.Sprintf("The logs collector of enclave '%v' can't be fetched: %v", runningEnclave.GetName(), err),
+				"Run 'kurtosis doctor' again; if it keeps failing, recreate the enclave",
+			)
+		}
+		if maybeLogsCollector == nil || maybeLogsCollector.GetStatus() != container.ContainerStatus_Running {
+			enclavesWithoutLogsCollector = append(enclavesWithoutLogsCollector, runningEnclave.GetName())
+		}
+	}
+	if len(enclavesWithoutLogsCollector) > 0 {
+		return newFailureFinding(
+			logsCollectorsCheckName,
+			fmt.Sprintf("Enclaves %v have no running logs collector; the logs of their services won't be stored", strings.Join(enclavesWithoutLogsCollector, listSeparator)),
+			"Recreate the enclaves, or run 'kurtosis engine restart --restart-api-containers'",
+		)
+	}
+	return newOkFinding(logsCollectorsCheckName, fmt.Sprintf("The %d running enclave(s) have a running logs collector", len(runningEnclaves)))
+}
+
+// getImageTag returns the tag of an image reference like 'kurtosistech/core:1.2.3'
+func getImageTag(image string) string {
+	imageWithoutDigest, _, _ := strings.Cut(image, imageDigestSeparator)
+	lastTagSeparatorIndex := strings.LastIndex(imageWithoutDigest, imageTagSeparator)
+	if lastTagSeparatorIndex == -1 || lastTagSeparatorIndex < strings.LastIndex(imageWithoutDigest, imagePathSeparator) {
+		return unknownImageTagString
+	}
+	return imageWithoutDigest[lastTagSeparatorIndex+1:]
+}
@@ -0,0 +1,27 @@
+package doctor
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetImageTag(t *testing.T) {
+	require.Equal(t, "1.2.3", getImageTag("kurtosistech/core:1.2.3"))
+	require.Equal(t, "1.2.3", getImageTag("registry.example.com:5000/kurtosistech/core:1.2.3"))
+	require.Equal(t, "1.2.3", getImageTag("kurtosistech/core:1.2.3@sha256:0123456789abcdef"))
+	require.Equal(t, unknownImageTagString, getImageTag("registry.example.com:5000/kurtosistech/core"))
+	require.Equal(t, unknownImageTagString, getImageTag("0123456789abcdef"))
+}
+
+func TestGetEngineFipsModeFinding(t *testing.T) {
+	notInFipsMode := &kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{EngineVersion: "1.2.3", FipsMode: false, ValidatedCrypto: false}
+	require.Equal(t, findingSeverity_Failure, getEngineFipsModeFinding(notInFipsMode).Severity)
+
+	withoutValidatedCrypto := &kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{EngineVersion: "1.2.3", FipsMode: true, ValidatedCrypto: false}
+	require.Equal(t, findingSeverity_Warning, getEngineFipsModeFinding(withoutValidatedCrypto).Severity)
+
+	withValidatedCrypto := &kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{EngineVersion: "1.2.3", FipsMode: true, ValidatedCrypto: true}
+	require.Equal(t, findingSeverity_Ok, getEngineFipsModeFinding(withValidatedCrypto).Severity)
+}
@@ -5,15 +5,20 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	enclave_consts "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/context_defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
+	engine_args "github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -26,6 +31,19 @@ const (
 	apiContainerLogLevelFlagKey  = "api-container-log-level"
 	enclaveNameFlagKey           = "name"
 	enclaveProductionModeFlagKey = "production"
+	enclaveTtlFlagKey            = "ttl"
+	enclaveClusterFlagKey        = "cluster"
+	enclaveNoLogsFlagKey         = "no-logs"
+	restrictEgressFlagKey        = "restrict-egress"
+	egressAllowFlagKey           = "egress-allow"
+
+	egressAllowlistSeparator = ","
+
+	// Signifies that the engine's default enclave TTL should be used
+	defaultEnclaveTtlKeyword = ""
+
+	// Signifies that the engine should pick the cluster of the enclave
+	autoPlaceEnclaveClusterKeyword = ""
 
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
@@ -77,6 +95,48 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 			Type:      flags.FlagType_Bool,
 			Default:   "false",
 		},
+		{
+			Key: service_helpers.EnvvarsFlagKey,
+			Usage: fmt.Sprintf(
+				"Environment variables injected into every service added to the enclave, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\". "+
+					"Environment variables set on a service config take precedence over these",
+				service_helpers.EnvvarKeyValueDelimiter,
+				service_helpers.EnvvarDeclarationsDelimiter,
+				service_helpers.EnvvarKeyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
+		{
+			Key:     enclaveTtlFlagKey,
+			Usage:   "How long the enclave lives before the engine destroys it, e.g. '4h' or '30m' (emptystring uses the default TTL of the cluster config, if any)",
+			Type:    flags.FlagType_String,
+			Default: defaultEnclaveTtlKeyword,
+		},
+		{
+			Key:     enclaveClusterFlagKey,
+			Usage:   "The Kubernetes cluster to create the enclave in, when the engine spans several clusters (emptystring puts the enclave on the cluster with the fewest enclaves)",
+			Type:    flags.FlagType_String,
+			Default: autoPlaceEnclaveClusterKeyword,
+		},
+		{
+			Key:     enclaveNoLogsFlagKey,
+			Usage:   "If enabled, no logs collector is started for the enclave and the logs of its services are read straight from the container engine, which saves resources but loses the logs of services that get removed",
+			Type:    flags.FlagType_Bool,
+			Default: "false",
+		},
+		{
+			Key:     restrictEgressFlagKey,
+			Usage:   fmt.Sprintf("If enabled, the services of the enclave can only reach the enclave itself, the DNS servers and the destinations passed with '--%v'", egressAllowFlagKey),
+			Type:    flags.FlagType_Bool,
+			Default: "false",
+		},
+		{
+			Key:     egressAllowFlagKey,
+			Usage:   fmt.Sprintf("Comma-separated CIDRs, IP addresses and domains the services of the enclave can reach (e.g. '10.0.0.0/8,github.com'). Implies '--%v'", restrictEgressFlagKey),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
 	},
 }
 
@@ -114,6 +174,56 @@ func run(
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis ", enclaveNameFlagKey)
 	}
+	enclaveNamePrefix := context_defaults.GetCurrentContextDefaults().GetEnclaveNamePrefix()
+	if enclaveName == autogenerateEnclaveNameKeyword && enclaveNamePrefix != "" {
+		enclaveName = context_defaults.GenerateEnclaveName(enclaveNamePrefix)
+	}
+
+	enclaveTtl, err := flags.GetString(enclaveTtlFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave TTL using flag with key '%v'; this is a bug in Kurtosis", enclaveTtlFlagKey)
+	}
+	if _, err = engine_args.ParseEnclaveTtl(enclaveTtl); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the enclave TTL passed with flag '%v'", enclaveTtlFlagKey)
+	}
+
+	enclaveCluster, err := flags.GetString(enclaveClusterFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave cluster using flag with key '%v'; this is a bug in Kurtosis", enclaveClusterFlagKey)
+	}
+
+	shouldSkipLogsCollection, err := flags.GetBool(enclaveNoLogsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting whether to skip logs collection using flag with key '%v'; this is a bug in Kurtosis", enclaveNoLogsFlagKey)
+	}
+
+	shouldRestrictEgress, err := flags.GetBool(restrictEgressFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting whether to restrict the egress using flag with key '%v'; this is a bug in Kurtosis", restrictEgressFlagKey)
+	}
+	egressAllowStr, err := flags.GetString(egressAllowFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the egress allowlist using flag with key '%v'; this is a bug in Kurtosis", egressAllowFlagKey)
+	}
+	egressAllowlist := []string{}
+	for _, allowedDestination := range strings.Split(egressAllowStr, egressAllowlistSeparator) {
+		if trimmedAllowedDestination := strings.TrimSpace(allowedDestination); trimmedAllowedDestination != "" {
+			egressAllowlist = append(egressAllowlist, trimmedAllowedDestination)
+		}
+	}
+	// Validated before starting the engine so that a typo doesn't cost an enclave creation
+	if _, err := egress_policy.NewEgressPolicyFromAllowlist(egressAllowlist); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the egress allowlist passed with flag '%v'", egressAllowFlagKey)
+	}
+
+	envVarsStr, err := flags.GetString(service_helpers.EnvvarsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave env vars using flag with key '%v'; this is a bug in Kurtosis", service_helpers.EnvvarsFlagKey)
+	}
+	enclaveEnvVars, err := service_helpers.ParseEnvVarsStr(envVarsStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the enclave env vars passed with flag '%v'", service_helpers.EnvvarsFlagKey)
+	}
 
 	dontRestartAPIContainers := false
 	engineManager, err := engine_manager.NewEngineManager(ctx)
@@ -149,6 +259,11 @@ func run(
 		ApiContainerLogLevel:     &kurtosisLogLevelStr,
 		Mode:                     &mode,
 		ShouldApicRunInDebugMode: &shouldApicRunInDebugMode,
+		Ttl:                      &enclaveTtl,
+		ClusterName:              &enclaveCluster,
+		ShouldSkipLogsCollection: &shouldSkipLogsCollection,
+		ShouldRestrictEgress:     &shouldRestrictEgress,
+		EgressAllowlist:          egressAllowlist,
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
@@ -160,5 +275,19 @@ func run(
 
 	defer output_printers.PrintEnclaveName(enclaveName)
 
+	if len(enclaveEnvVars) > 0 {
+		kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+		}
+		enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveName)
+		}
+		if err = enclaveCtx.SetEnclaveEnvVars(ctx, enclaveEnvVars); err != nil {
+			return stacktrace.Propagate(err, "Enclave '%v' was created but an error occurred setting its env vars", enclaveName)
+		}
+	}
+
 	return nil
 }
@@ -2,7 +2,11 @@ package dump
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
@@ -16,7 +20,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/mholt/archiver"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
 	"os"
 	"path"
 )
@@ -35,17 +41,54 @@ const (
 	enclaveDumpSeparator  = "--"
 	outputDirIsOptional   = true
 
+	archiveFlagKey     = "archive"
+	archiveFlagDefault = "false"
+	archiveExtension   = ".tgz"
+
 	filesArtifactDestinationDirPermission = 0o777
 	filesArtifactFolderName               = "files"
+
+	engineLogsFolderName          = "engine"
+	starlarkRunFilename           = "starlark-run.json"
+	planYamlFilename              = "plan.yaml"
+	filesArtifactsListingFilename = "files-artifacts.json"
+	createdFilePerms              = 0o644
+
+	jsonIndent = "  "
+
+	// Package ID the API container records for standalone scripts, which don't belong to a package
+	standaloneScriptPackageId = "DEFAULT_PACKAGE_ID_FOR_SCRIPT"
 )
 
+type filesArtifactListingEntry struct {
+	Name          string                          `json:"name"`
+	Uuid          string                          `json:"uuid"`
+	ContentSha256 string                          `json:"contentSha256,omitempty"`
+	Files         []filesArtifactFileListingEntry `json:"files"`
+}
+
+type filesArtifactFileListingEntry struct {
+	Path string `json:"path"`
+	Size uint64 `json:"size"`
+}
+
 var EnclaveDumpCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
-	CommandStr:                command_str_consts.EnclaveDumpCmdStr,
-	ShortDescription:          "Dumps information about an enclave to disk",
-	LongDescription:           "Dumps all information about the enclave to the given directory",
+	CommandStr:       command_str_consts.EnclaveDumpCmdStr,
+	ShortDescription: "Dumps information about an enclave to disk",
+	LongDescription: "Dumps all information about the enclave to the given directory, for debugging: the logs and " +
+		"the Docker inspect output or Kubernetes manifests and pod events of its containers (API container included), " +
+		"the engine logs, the Starlark run that created the enclave along with its plan, and the listing and content " +
+		"of its files artifacts",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
-	Flags:                     nil,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     archiveFlagKey,
+			Usage:   "If true, also packs the dump into a single '<output-dirpath>" + archiveExtension + "' archive, e.g. to attach it to a bug report",
+			Type:    flags.FlagType_Bool,
+			Default: archiveFlagDefault,
+		},
+	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
 			enclaveIdentifierArgKey,
@@ -68,7 +111,7 @@ func run(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	_ metrics_client.MetricsClient,
-	_ *flags.ParsedFlags,
+	flags *flags.ParsedFlags,
 	args *args.ParsedArgs,
 ) error {
 	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
@@ -79,6 +122,10 @@ func run(
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting output dirpath using arg key '%v'", outputDirpathArg)
 	}
+	shouldArchive, err := flags.GetBool(archiveFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", archiveFlagKey)
+	}
 
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
@@ -100,24 +147,53 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred dumping enclave '%v' to '%v'", enclaveIdentifier, enclaveOutputDirpath)
 	}
 
-	if enclaveInfo.ApiContainerStatus != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
-		logrus.Debugf("Couldn't dump file information as the enclave '%v' is not running", enclaveIdentifier)
-		logrus.Infof("Dumped enclave '%v' to directory '%v'", enclaveIdentifier, enclaveOutputDirpath)
+	// The engine logs are only there to help debugging, so the dump goes on without them
+	engineLogsDirpath := path.Join(enclaveOutputDirpath, engineLogsFolderName)
+	if err = kurtosisBackend.GetEngineLogs(ctx, engineLogsDirpath); err != nil {
+		logrus.Warnf("An error occurred dumping the engine logs to '%v'; the dump won't include them:\n%v", engineLogsDirpath, err)
+	}
+
+	if enclaveInfo.ApiContainerStatus == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		if err = dumpApiContainerInfo(ctx, kurtosisCtx, enclaveIdentifier, enclaveOutputDirpath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred dumping the information held by the API container of enclave '%v'", enclaveIdentifier)
+		}
+	} else {
+		logrus.Debugf("Couldn't dump the Starlark run and the files artifacts as the enclave '%v' is not running", enclaveIdentifier)
+	}
+
+	if shouldArchive {
+		archiveFilepath := enclaveOutputDirpath + archiveExtension
+		if err = archiver.Archive([]string{enclaveOutputDirpath}, archiveFilepath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred packing the dump of enclave '%v' into archive '%v'", enclaveIdentifier, archiveFilepath)
+		}
+		logrus.Infof("Dumped enclave '%v' to directory '%v' and archive '%v'", enclaveIdentifier, enclaveOutputDirpath, archiveFilepath)
 		return nil
 	}
 
+	logrus.Infof("Dumped enclave '%v' to directory '%v'", enclaveIdentifier, enclaveOutputDirpath)
+	return nil
+}
+
+func dumpApiContainerInfo(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, enclaveOutputDirpath string) error {
 	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while retrieving enclave context for enclave with identifier '%v'", enclaveIdentifier)
 	}
 
+	if err = dumpStarlarkRun(ctx, enclaveCtx, enclaveOutputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the Starlark run of enclave '%v'", enclaveIdentifier)
+	}
+
 	filesInEnclave, err := enclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while fetching files artifact in enclave '%v'", enclaveIdentifier)
 	}
 
+	if err = dumpFilesArtifactsListing(ctx, enclaveCtx, filesInEnclave, enclaveOutputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the listing of the files artifacts of enclave '%v'", enclaveIdentifier)
+	}
+
 	if len(filesInEnclave) == 0 {
-		logrus.Infof("Dumped enclave '%v' to directory '%v'", enclaveIdentifier, enclaveOutputDirpath)
 		return nil
 	}
 
@@ -135,7 +211,89 @@ func run(
 			return stacktrace.Propagate(err, "An error occurred while downloading and extracting file '%v'", fileNameAndUuid.GetFileName())
 		}
 	}
+	return nil
+}
+
+// dumpStarlarkRun writes the last Starlark run of the enclave, i.e. the script or package and the params that created
+// it, along with its plan
+func dumpStarlarkRun(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, enclaveOutputDirpath string) error {
+	starlarkRun, err := enclaveCtx.GetStarlarkRun(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the last Starlark run of the enclave")
+	}
+	if starlarkRun.GetPackageId() == "" && starlarkRun.GetSerializedScript() == "" {
+		logrus.Debugf("Nothing was run in the enclave yet, so there's no Starlark run to dump")
+		return nil
+	}
+
+	serializedStarlarkRun, err := protojson.MarshalOptions{Multiline: true, Indent: jsonIndent}.Marshal(starlarkRun)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the last Starlark run of the enclave")
+	}
+	starlarkRunFilepath := path.Join(enclaveOutputDirpath, starlarkRunFilename)
+	if err = os.WriteFile(starlarkRunFilepath, serializedStarlarkRun, createdFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the last Starlark run of the enclave to '%v'", starlarkRunFilepath)
+	}
+
+	// Getting the plan interprets the script or package again, which can fail for reasons unrelated to the enclave, e.g.
+	// a local package that can't be resolved remotely; the Starlark run above is enough to reproduce it anyway
+	var planYaml string
+	if starlarkRun.GetPackageId() == standaloneScriptPackageId {
+		plan, err := enclaveCtx.GetStarlarkScriptPlanYaml(ctx, starlarkRun.GetSerializedScript(), starlarkRun.GetSerializedParams())
+		if err != nil {
+			logrus.Warnf("An error occurred getting the plan of the script run in the enclave; the dump won't include it:\n%v", err)
+			return nil
+		}
+		planYaml = plan.GetPlanYaml()
+	} else {
+		plan, err := enclaveCtx.GetStarlarkRemotePackagePlanYaml(ctx, starlarkRun.GetPackageId(), starlarkRun.GetSerializedParams())
+		if err != nil {
+			logrus.Warnf("An error occurred getting the plan of package '%v' run in the enclave; the dump won't include it:\n%v", starlarkRun.GetPackageId(), err)
+			return nil
+		}
+		planYaml = plan.GetPlanYaml()
+	}
+	planYamlFilepath := path.Join(enclaveOutputDirpath, planYamlFilename)
+	if err = os.WriteFile(planYamlFilepath, []byte(planYaml), createdFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the plan of the enclave to '%v'", planYamlFilepath)
+	}
+	return nil
+}
 
-	logrus.Infof("Dumped enclave '%v' to directory '%v'", enclaveIdentifier, enclaveOutputDirpath)
+func dumpFilesArtifactsListing(
+	ctx context.Context,
+	enclaveCtx *enclaves.EnclaveContext,
+	filesInEnclave []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid,
+	enclaveOutputDirpath string,
+) error {
+	listing := []filesArtifactListingEntry{}
+	for _, fileNameAndUuid := range filesInEnclave {
+		artifactContents, err := enclaveCtx.InspectFilesArtifact(ctx, services.FileArtifactName(fileNameAndUuid.GetFileName()))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred inspecting files artifact '%v'", fileNameAndUuid.GetFileName())
+		}
+		fileEntries := []filesArtifactFileListingEntry{}
+		for _, fileDescription := range artifactContents.GetFileDescriptions() {
+			fileEntries = append(fileEntries, filesArtifactFileListingEntry{
+				Path: fileDescription.GetPath(),
+				Size: fileDescription.GetSize(),
+			})
+		}
+		listing = append(listing, filesArtifactListingEntry{
+			Name:          fileNameAndUuid.GetFileName(),
+			Uuid:          fileNameAndUuid.GetFileUuid(),
+			ContentSha256: artifactContents.GetContentSha256(),
+			Files:         fileEntries,
+		})
+	}
+
+	serializedListing, err := json.MarshalIndent(listing, "", jsonIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the listing of the files artifacts")
+	}
+	listingFilepath := path.Join(enclaveOutputDirpath, filesArtifactsListingFilename)
+	if err = os.WriteFile(listingFilepath, serializedListing, createdFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the listing of the files artifacts to '%v'", listingFilepath)
+	}
 	return nil
 }
@@ -10,9 +10,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/add"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/connect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/dump"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/export"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/graph"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/logs_filters"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/ls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/rm"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/share"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/stop"
 	"github.com/spf13/cobra"
 )
@@ -33,4 +37,8 @@ func init() {
 	EnclaveCmd.AddCommand(rm.EnclaveRmCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(dump.EnclaveDumpCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(connect.EnclaveConnectCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(share.EnclaveShareCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(graph.EnclaveGraphCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(export.EnclaveExportCmd)
+	EnclaveCmd.AddCommand(logs_filters.EnclaveLogsFiltersCmd.MustGetCobraCommand())
 }
@@ -0,0 +1,220 @@
+package compose
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/export/enclave_export"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	millicpusPerCpu  = 1000
+	megabytesSuffix  = "m"
+	headerLinePrefix = "# "
+	yamlIndent       = 2
+)
+
+var EnclaveExportComposeCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ExportComposeCmdStr,
+	ShortDescription: "Exports an enclave to a docker-compose file",
+	LongDescription: "Prints a docker-compose.yml approximating the services of an enclave: their images, entrypoints, " +
+		"commands, environment variables, ports, resources and dependencies, so that the environment can be started " +
+		"without Kurtosis. The private IP addresses of the services are replaced with their names, as that's how " +
+		"services reach each other in docker-compose. What can't be exported, like the files artifacts mounted in " +
+		"the services, is listed in the comments at the top of the file.",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+type composeFile struct {
+	Services map[string]*composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image       string            `yaml:"image"`
+	Entrypoint  []string          `yaml:"entrypoint,omitempty"`
+	Command     []string          `yaml:"command,omitempty"`
+	Environment map[string]string `yaml:"environment,omitempty"`
+	Ports       []string          `yaml:"ports,omitempty"`
+	Expose      []string          `yaml:"expose,omitempty"`
+	DependsOn   []string          `yaml:"depends_on,omitempty"`
+	User        string            `yaml:"user,omitempty"`
+	Cpus        float64           `yaml:"cpus,omitempty"`
+	MemLimit    string            `yaml:"mem_limit,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	enclaveToExport, err := enclave_export.GetEnclaveToExport(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to export", enclaveIdentifier)
+	}
+
+	renderedCompose, err := renderCompose(enclaveToExport.EnclaveName, enclaveToExport.ServiceInfos, enclaveToExport.DependencyGraph)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred rendering enclave '%v' as a docker-compose file", enclaveIdentifier)
+	}
+	out.PrintOutLn(strings.TrimSuffix(renderedCompose, "\n"))
+	return nil
+}
+
+// renderCompose returns the docker-compose.yml approximating the services, preceded by comments listing what couldn't
+// be exported
+func renderCompose(
+	enclaveName string,
+	serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+	dependencyGraph *kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse,
+) (string, error) {
+	compose, warnings := buildCompose(serviceInfos, dependencyGraph)
+
+	rendered := strings.Builder{}
+	rendered.WriteString(fmt.Sprintf("%sExported from Kurtosis enclave '%s'; this approximates the enclave and might need adjustments\n", headerLinePrefix, enclaveName))
+	for _, warning := range warnings {
+		rendered.WriteString(headerLinePrefix + warning + "\n")
+	}
+	yamlEncoder := yaml.NewEncoder(&rendered)
+	yamlEncoder.SetIndent(yamlIndent)
+	if err := yamlEncoder.Encode(compose); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred marshalling the docker-compose file")
+	}
+	if err := yamlEncoder.Close(); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred marshalling the docker-compose file")
+	}
+	return rendered.String(), nil
+}
+
+// buildCompose converts the services to docker-compose services, returning what couldn't be converted as warnings
+func buildCompose(
+	serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+	dependencyGraph *kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse,
+) (*composeFile, []string) {
+	// Services reach each other by name in docker-compose, rather than by IP address
+	replaceIpAddrs := enclave_export.NewPrivateIpAddrReplacer(serviceInfos)
+	serviceNameToDependencies := enclave_export.GetServiceNameToDependencies(dependencyGraph)
+
+	warnings := []string{}
+	compose := &composeFile{
+		Services: map[string]*composeService{},
+	}
+	for _, serviceInfo := range user_services.GetSortedUserServiceSliceFromUserServiceMap(serviceInfos) {
+		serviceName := serviceInfo.GetName()
+		container := serviceInfo.GetContainer()
+		service := &composeService{
+			Image:       container.GetImageName(),
+			Entrypoint:  replaceAllIpAddrs(container.GetEntrypointArgs(), replaceIpAddrs),
+			Command:     replaceAllIpAddrs(container.GetCmdArgs(), replaceIpAddrs),
+			Environment: map[string]string{},
+			Ports:       getPublishedPorts(serviceInfo),
+			Expose:      getExposedPorts(serviceInfo),
+			DependsOn:   serviceNameToDependencies[serviceName],
+			User:        "",
+			Cpus:        0,
+			MemLimit:    "",
+			Labels:      serviceInfo.GetLabels(),
+		}
+		for envVarKey, envVarValue := range container.GetEnvVars() {
+			service.Environment[envVarKey] = replaceIpAddrs(envVarValue)
+		}
+		if serviceInfo.User != nil {
+			service.User = fmt.Sprintf("%d:%d", serviceInfo.GetUser().GetUid(), serviceInfo.GetUser().GetGid())
+		}
+		if serviceInfo.GetMaxMillicpus() > 0 {
+			service.Cpus = float64(serviceInfo.GetMaxMillicpus()) / millicpusPerCpu
+		}
+		if serviceInfo.GetMaxMemoryMegabytes() > 0 {
+			service.MemLimit = fmt.Sprintf("%d%s", serviceInfo.GetMaxMemoryMegabytes(), megabytesSuffix)
+		}
+		compose.Services[serviceName] = service
+
+		for _, dirPath := range enclave_export.GetSortedMountDirPaths(serviceInfo) {
+			filesArtifacts := serviceInfo.GetServiceDirPathsToFilesArtifactsList()[dirPath].GetFilesArtifactsIdentifiers()
+			warnings = append(warnings, fmt.Sprintf(
+				"Service '%s' mounts files artifacts '%s' at '%s', which aren't exported; download them with 'kurtosis files download' and mount them as volumes",
+				serviceName, strings.Join(filesArtifacts, "', '"), dirPath))
+		}
+	}
+	return compose, warnings
+}
+
+func replaceAllIpAddrs(values []string, replaceIpAddrs func(string) string) []string {
+	replacedValues := []string{}
+	for _, value := range values {
+		replacedValues = append(replacedValues, replaceIpAddrs(value))
+	}
+	return replacedValues
+}
+
+// getPublishedPorts returns the ports published on the host, on the same public ports as in the enclave
+func getPublishedPorts(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) []string {
+	publishedPorts := []string{}
+	for portId, privatePort := range serviceInfo.GetPrivatePorts() {
+		publicPort, found := serviceInfo.GetMaybePublicPorts()[portId]
+		if !found {
+			continue
+		}
+		publishedPorts = append(publishedPorts, fmt.Sprintf("%d:%d/%s", publicPort.GetNumber(), privatePort.GetNumber(), getProtocolStr(privatePort)))
+	}
+	sort.Strings(publishedPorts)
+	return publishedPorts
+}
+
+// getExposedPorts returns the ports only reachable by the other services
+func getExposedPorts(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) []string {
+	exposedPorts := []string{}
+	for portId, privatePort := range serviceInfo.GetPrivatePorts() {
+		if _, found := serviceInfo.GetMaybePublicPorts()[portId]; found {
+			continue
+		}
+		exposedPorts = append(exposedPorts, fmt.Sprintf("%d/%s", privatePort.GetNumber(), getProtocolStr(privatePort)))
+	}
+	sort.Strings(exposedPorts)
+	return exposedPorts
+}
+
+func getProtocolStr(port *kurtosis_core_rpc_api_bindings.Port) string {
+	return strings.ToLower(port.GetTransportProtocol().String())
+}
@@ -0,0 +1,85 @@
+package compose
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderCompose(t *testing.T) {
+	expectedCompose := `# Exported from Kurtosis enclave 'test-enclave'; this approximates the enclave and might need adjustments
+# Service 'api' mounts files artifacts 'api-config' at '/config', which aren't exported; download them with 'kurtosis files download' and mount them as volumes
+services:
+  api:
+    image: api:latest
+    command:
+      - --db
+      - postgres://database:5432
+    environment:
+      DB_HOST: database
+    ports:
+      - 49153:8080/tcp
+    depends_on:
+      - database
+    cpus: 0.5
+    mem_limit: 512m
+  database:
+    image: postgres:16
+    expose:
+      - 5432/tcp
+    user: 999:999
+`
+	rendered, err := renderCompose("test-enclave", getServiceInfosForTest(), getDependencyGraphForTest())
+	require.NoError(t, err)
+	require.Equal(t, expectedCompose, rendered)
+}
+
+func getServiceInfosForTest() map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo {
+	return map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"api": {
+			Name:          "api",
+			PrivateIpAddr: "172.16.0.4",
+			PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+				"http": {Number: 8080, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP},
+			},
+			MaybePublicPorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+				"http": {Number: 49153, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP},
+			},
+			Container: &kurtosis_core_rpc_api_bindings.Container{
+				ImageName: "api:latest",
+				CmdArgs:   []string{"--db", "postgres://172.16.0.3:5432"},
+				EnvVars:   map[string]string{"DB_HOST": "172.16.0.3"},
+			},
+			ServiceDirPathsToFilesArtifactsList: map[string]*kurtosis_core_rpc_api_bindings.FilesArtifactsList{
+				"/config": {FilesArtifactsIdentifiers: []string{"api-config"}},
+			},
+			MaxMillicpus:       500,
+			MaxMemoryMegabytes: 512,
+		},
+		"database": {
+			Name:          "database",
+			PrivateIpAddr: "172.16.0.3",
+			PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+				"postgres": {Number: 5432, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP},
+			},
+			Container: &kurtosis_core_rpc_api_bindings.Container{
+				ImageName: "postgres:16",
+			},
+			User: &kurtosis_core_rpc_api_bindings.User{Uid: 999, Gid: 999},
+		},
+	}
+}
+
+func getDependencyGraphForTest() *kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse {
+	return &kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse{
+		Nodes: []*kurtosis_core_rpc_api_bindings.ServiceDependencyNode{
+			{ServiceName: "api", HasReadyConditions: false},
+			{ServiceName: "database", HasReadyConditions: true},
+		},
+		Edges: []*kurtosis_core_rpc_api_bindings.ServiceDependencyEdge{
+			{ServiceName: "api", DependencyServiceName: "database", Kind: kurtosis_core_rpc_api_bindings.ServiceDependencyKind_RUNTIME_VALUE},
+			{ServiceName: "api", DependencyServiceName: "database", Kind: kurtosis_core_rpc_api_bindings.ServiceDependencyKind_EXPLICIT},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package enclave_export
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// EnclaveToExport holds what the exporters convert to other formats
+type EnclaveToExport struct {
+	EnclaveName     string
+	ServiceInfos    map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo
+	DependencyGraph *kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse
+	EnclaveCtx      *enclaves.EnclaveContext
+}
+
+func GetEnclaveToExport(ctx context.Context, enclaveIdentifier string) (*EnclaveToExport, error) {
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	allServices := map[string]bool{}
+	serviceInfos, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, kurtosisCtx, enclaveInfo, allServices)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveIdentifier)
+	}
+	dependencyGraph, err := enclaveCtx.GetServiceDependencyGraph(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service dependency graph of enclave '%v'", enclaveIdentifier)
+	}
+	return &EnclaveToExport{
+		EnclaveName:     enclaveInfo.GetName(),
+		ServiceInfos:    serviceInfos,
+		DependencyGraph: dependencyGraph,
+		EnclaveCtx:      enclaveCtx,
+	}, nil
+}
+
+// NewPrivateIpAddrReplacer returns a function replacing the private IP addresses of the services with their names, as
+// that's how the services reach each other outside of Kurtosis
+func NewPrivateIpAddrReplacer(serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo) func(string) string {
+	privateIpAddrToServiceName := map[string]string{}
+	for _, serviceInfo := range serviceInfos {
+		if serviceInfo.GetPrivateIpAddr() != "" {
+			privateIpAddrToServiceName[serviceInfo.GetPrivateIpAddr()] = serviceInfo.GetName()
+		}
+	}
+	return func(value string) string {
+		for ipAddr, serviceName := range privateIpAddrToServiceName {
+			value = strings.ReplaceAll(value, ipAddr, serviceName)
+		}
+		return value
+	}
+}
+
+// GetServiceNameToDependencies returns the sorted names of the services every service depends on, whatever the kind
+// of the dependency
+func GetServiceNameToDependencies(dependencyGraph *kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse) map[string][]string {
+	serviceNameToUniqueDependencies := map[string]map[string]bool{}
+	for _, edge := range dependencyGraph.GetEdges() {
+		if _, found := serviceNameToUniqueDependencies[edge.GetServiceName()]; !found {
+			serviceNameToUniqueDependencies[edge.GetServiceName()] = map[string]bool{}
+		}
+		serviceNameToUniqueDependencies[edge.GetServiceName()][edge.GetDependencyServiceName()] = true
+	}
+	serviceNameToDependencies := map[string][]string{}
+	for serviceName, uniqueDependencies := range serviceNameToUniqueDependencies {
+		dependencies := []string{}
+		for dependency := range uniqueDependencies {
+			dependencies = append(dependencies, dependency)
+		}
+		sort.Strings(dependencies)
+		serviceNameToDependencies[serviceName] = dependencies
+	}
+	return serviceNameToDependencies
+}
+
+// GetSortedMountDirPaths returns the directories the files artifacts are mounted at in the service, sorted
+func GetSortedMountDirPaths(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) []string {
+	mountDirPaths := []string{}
+	for dirPath := range serviceInfo.GetServiceDirPathsToFilesArtifactsList() {
+		mountDirPaths = append(mountDirPaths, dirPath)
+	}
+	sort.Strings(mountDirPaths)
+	return mountDirPaths
+}
@@ -0,0 +1,21 @@
+package export
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/export/compose"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/export/kubernetes"
+	"github.com/spf13/cobra"
+)
+
+// EnclaveExportCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var EnclaveExportCmd = &cobra.Command{
+	Use:   command_str_consts.EnclaveExportCmdStr,
+	Short: "Export an enclave to other formats",
+	RunE:  nil,
+}
+
+func init() {
+	EnclaveExportCmd.AddCommand(compose.EnclaveExportComposeCmd.MustGetCobraCommand())
+	EnclaveExportCmd.AddCommand(kubernetes.EnclaveExportKubernetesCmd.MustGetCobraCommand())
+}
@@ -0,0 +1,110 @@
+package kubernetes
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	chartFileName          = "Chart.yaml"
+	valuesFileName         = "values.yaml"
+	templatesDirName       = "templates"
+	configMapsTemplateName = "configmaps.yaml"
+	templateFileExtension  = ".yaml"
+
+	chartApiVersion = "v2"
+	chartType       = "application"
+	chartVersion    = "0.1.0"
+
+	valuesServicesKey = "services"
+	valuesImageKey    = "image"
+	valuesReplicasKey = "replicas"
+
+	// Replaced with template actions once rendered, as the YAML marshaller would quote them
+	valuePlaceholderFormat = "KURTOSIS_EXPORT_VALUE_PLACEHOLDER_%d"
+	valueTemplateFormat    = `{{ index .Values.%s %q %q }}`
+
+	templateActionStart        = "{{"
+	escapedTemplateActionStart = `{{ "{{" }}`
+)
+
+// renderHelmChart returns the files of a Helm chart deploying the manifests, by path relative to the chart directory.
+// The images and the replicas of the services are values of the chart
+func renderHelmChart(chartName string, enclaveName string, manifests *manifests, header string) (map[string]string, error) {
+	chart := map[string]interface{}{
+		"apiVersion":  chartApiVersion,
+		"name":        chartName,
+		"description": fmt.Sprintf("Exported from Kurtosis enclave '%s'", enclaveName),
+		"type":        chartType,
+		"version":     chartVersion,
+	}
+	chartYaml, err := yaml.Marshal(chart)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred marshalling the chart metadata")
+	}
+	chartFiles := map[string]string{
+		chartFileName: string(chartYaml),
+	}
+
+	if len(manifests.configMaps) > 0 {
+		configMapDocuments := []string{}
+		for _, configMap := range manifests.configMaps {
+			document, err := marshalManifest(configMap)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred marshalling ConfigMap '%s'", configMap.GetName())
+			}
+			configMapDocuments = append(configMapDocuments, escapeTemplateActions(document))
+		}
+		chartFiles[path.Join(templatesDirName, configMapsTemplateName)] = strings.Join(configMapDocuments, yamlDocumentSeparator)
+	}
+
+	serviceValues := map[string]interface{}{}
+	for serviceIndex, serviceName := range manifests.serviceNames {
+		deployment := manifests.deployments[serviceName]
+		serviceValues[serviceName] = map[string]interface{}{
+			valuesImageKey:    deployment.Spec.Template.Spec.Containers[0].Image,
+			valuesReplicasKey: *deployment.Spec.Replicas,
+		}
+
+		deploymentFields, err := getManifestFields(deployment)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the fields of the Deployment of service '%s'", serviceName)
+		}
+		imagePlaceholder := fmt.Sprintf(valuePlaceholderFormat, 2*serviceIndex)
+		replicasPlaceholder := fmt.Sprintf(valuePlaceholderFormat, 2*serviceIndex+1)
+		deploymentSpec := deploymentFields["spec"].(map[string]interface{})
+		deploymentSpec[valuesReplicasKey] = replicasPlaceholder
+		podSpec := deploymentSpec["template"].(map[string]interface{})["spec"].(map[string]interface{})
+		podSpec["containers"].([]interface{})[0].(map[string]interface{})[valuesImageKey] = imagePlaceholder
+		deploymentDocument, err := marshalManifestFields(deploymentFields)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred marshalling the Deployment of service '%s'", serviceName)
+		}
+		serviceDocument, err := marshalManifest(manifests.services[serviceName])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred marshalling the Service of service '%s'", serviceName)
+		}
+
+		serviceTemplate := escapeTemplateActions(deploymentDocument + yamlDocumentSeparator + serviceDocument)
+		serviceTemplate = strings.ReplaceAll(serviceTemplate, imagePlaceholder, fmt.Sprintf(valueTemplateFormat, valuesServicesKey, serviceName, valuesImageKey))
+		serviceTemplate = strings.ReplaceAll(serviceTemplate, replicasPlaceholder, fmt.Sprintf(valueTemplateFormat, valuesServicesKey, serviceName, valuesReplicasKey))
+		chartFiles[path.Join(templatesDirName, serviceName+templateFileExtension)] = serviceTemplate
+	}
+
+	valuesYaml, err := yaml.Marshal(map[string]interface{}{valuesServicesKey: serviceValues})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred marshalling the chart values")
+	}
+	chartFiles[valuesFileName] = header + string(valuesYaml)
+	return chartFiles, nil
+}
+
+// escapeTemplateActions keeps Helm from interpreting what looks like template actions in the manifests, e.g. in the
+// content of files artifacts that are templates themselves
+func escapeTemplateActions(document string) string {
+	return strings.ReplaceAll(document, templateActionStart, escapedTemplateActionStart)
+}
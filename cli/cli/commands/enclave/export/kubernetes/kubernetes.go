@@ -0,0 +1,226 @@
+package kubernetes
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/export/enclave_export"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	helmChartDirFlagKey = "helm-chart-dir"
+	defaultHelmChartDir = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	headerLinePrefix = "# "
+
+	chartDirPerms  = 0755
+	chartFilePerms = 0644
+
+	currentDirPrefix = "./"
+	pathSeparator    = "/"
+)
+
+var EnclaveExportKubernetesCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ExportKubernetesCmdStr,
+	ShortDescription: "Exports an enclave to Kubernetes manifests or a Helm chart",
+	LongDescription: "Prints Kubernetes manifests approximating the services of an enclave: a Deployment and a " +
+		"ClusterIP Service per service, and a ConfigMap per files artifact mounted in the services, so that a " +
+		"topology validated with Kurtosis can be promoted towards staging deployments. The private IP addresses of " +
+		"the services are replaced with their names, as that's how they reach each other through their Services. " +
+		"What can't be exported is listed in the comments at the top of the output. With the '" + helmChartDirFlagKey +
+		"' flag, a Helm chart is written to the given directory instead, with the images and replicas of the " +
+		"services as values.",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     helmChartDirFlagKey,
+			Usage:   "Directory to write a Helm chart to, instead of printing the manifests",
+			Type:    flags.FlagType_String,
+			Default: defaultHelmChartDir,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+	helmChartDir, err := flags.GetString(helmChartDirFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Helm chart directory using flag key '%v'", helmChartDirFlagKey)
+	}
+
+	enclaveToExport, err := enclave_export.GetEnclaveToExport(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to export", enclaveIdentifier)
+	}
+	filesArtifactNameToFiles, err := downloadMountedFilesArtifacts(ctx, enclaveToExport)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading the files artifacts mounted in the services of enclave '%v'", enclaveIdentifier)
+	}
+
+	enclaveManifests, warnings := buildManifests(enclaveToExport.EnclaveName, enclaveToExport.ServiceInfos, filesArtifactNameToFiles)
+	header := getHeader(enclaveToExport.EnclaveName, warnings)
+	if helmChartDir == defaultHelmChartDir {
+		renderedManifests, err := enclaveManifests.render(header)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred rendering enclave '%v' as Kubernetes manifests", enclaveIdentifier)
+		}
+		out.PrintOutLn(strings.TrimSuffix(renderedManifests, "\n"))
+		return nil
+	}
+
+	chartFiles, err := renderHelmChart(sanitizeDnsLabel(enclaveToExport.EnclaveName), enclaveToExport.EnclaveName, enclaveManifests, header)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred rendering enclave '%v' as a Helm chart", enclaveIdentifier)
+	}
+	if err := writeChartFiles(helmChartDir, chartFiles); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the Helm chart of enclave '%v' to '%v'", enclaveIdentifier, helmChartDir)
+	}
+	out.PrintOutLn(fmt.Sprintf("Helm chart of enclave '%s' written to '%s'", enclaveToExport.EnclaveName, helmChartDir))
+	for _, warning := range warnings {
+		out.PrintOutLn(warning)
+	}
+	return nil
+}
+
+func getHeader(enclaveName string, warnings []string) string {
+	header := strings.Builder{}
+	header.WriteString(fmt.Sprintf("%sExported from Kurtosis enclave '%s'; this approximates the enclave and might need adjustments\n", headerLinePrefix, enclaveName))
+	for _, warning := range warnings {
+		header.WriteString(headerLinePrefix + warning + "\n")
+	}
+	return header.String()
+}
+
+// downloadMountedFilesArtifacts returns the files of every files artifact mounted in the services, by path inside the
+// files artifact
+func downloadMountedFilesArtifacts(ctx context.Context, enclaveToExport *enclave_export.EnclaveToExport) (map[string]map[string][]byte, error) {
+	filesArtifactNameToFiles := map[string]map[string][]byte{}
+	for _, serviceInfo := range enclaveToExport.ServiceInfos {
+		for _, filesArtifacts := range serviceInfo.GetServiceDirPathsToFilesArtifactsList() {
+			for _, filesArtifactName := range filesArtifacts.GetFilesArtifactsIdentifiers() {
+				if _, found := filesArtifactNameToFiles[filesArtifactName]; found {
+					continue
+				}
+				files, err := downloadFilesArtifact(ctx, enclaveToExport.EnclaveCtx, filesArtifactName)
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", filesArtifactName)
+				}
+				filesArtifactNameToFiles[filesArtifactName] = files
+			}
+		}
+	}
+	return filesArtifactNameToFiles, nil
+}
+
+func downloadFilesArtifact(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, filesArtifactName string) (map[string][]byte, error) {
+	filesArtifactTgz, err := enclaveCtx.DownloadFilesArtifact(ctx, filesArtifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading the content of files artifact '%v'", filesArtifactName)
+	}
+	files, err := readFilesFromTgz(bytes.NewReader(filesArtifactTgz))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the files of files artifact '%v'", filesArtifactName)
+	}
+	return files, nil
+}
+
+// readFilesFromTgz returns the content of the regular files in the gzipped tarball, by path
+func readFilesFromTgz(tgzReader io.Reader) (map[string][]byte, error) {
+	gzipReader, err := gzip.NewReader(tgzReader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the gzip reader for the files artifact")
+	}
+	defer gzipReader.Close()
+
+	files := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the next entry of the files artifact")
+		}
+		if !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the content of file '%v'", header.Name)
+		}
+		files[normalizePath(header.Name)] = content
+	}
+	return files, nil
+}
+
+func writeChartFiles(chartDir string, chartFiles map[string]string) error {
+	chartFilePaths := []string{}
+	for chartFilePath := range chartFiles {
+		chartFilePaths = append(chartFilePaths, chartFilePath)
+	}
+	sort.Strings(chartFilePaths)
+	for _, chartFilePath := range chartFilePaths {
+		filePath := filepath.Join(chartDir, filepath.FromSlash(chartFilePath))
+		if err := os.MkdirAll(filepath.Dir(filePath), chartDirPerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the directory of chart file '%v'", filePath)
+		}
+		if err := os.WriteFile(filePath, []byte(chartFiles[chartFilePath]), chartFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing chart file '%v'", filePath)
+		}
+	}
+	return nil
+}
+
+// normalizePath turns the paths of the files artifact into relative paths, e.g. './dir/file' into 'dir/file'
+func normalizePath(pathToNormalize string) string {
+	cleanedPath := path.Clean(strings.TrimPrefix(pathToNormalize, currentDirPrefix))
+	return strings.Trim(cleanedPath, pathSeparator)
+}
@@ -0,0 +1,233 @@
+package kubernetes
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderManifests(t *testing.T) {
+	expectedManifests := `# Exported from Kurtosis enclave 'test-enclave'; this approximates the enclave and might need adjustments
+# Service 'api' has public ports, which are only reachable inside the cluster; use 'kubectl port-forward' or an Ingress to reach them
+apiVersion: v1
+binaryData:
+  certs_ca.der: //4A
+data:
+  config.yml: |
+    greeting: '{{ .Greeting }}'
+kind: ConfigMap
+metadata:
+  labels:
+    app.kubernetes.io/instance: test-enclave
+  name: api-config
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    app.kubernetes.io/instance: test-enclave
+  name: api
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app.kubernetes.io/instance: test-enclave
+      app.kubernetes.io/name: api
+  strategy: {}
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/instance: test-enclave
+        app.kubernetes.io/name: api
+    spec:
+      containers:
+      - args:
+        - --db
+        - postgres://database:5432
+        env:
+        - name: DB_HOST
+          value: database
+        image: api:latest
+        name: api
+        ports:
+        - containerPort: 8080
+          protocol: TCP
+        resources:
+          limits:
+            cpu: 500m
+            memory: 512M
+          requests:
+            cpu: 100m
+        volumeMounts:
+        - mountPath: /config
+          name: files-0
+      volumes:
+      - name: files-0
+        projected:
+          sources:
+          - configMap:
+              items:
+              - key: certs_ca.der
+                path: certs/ca.der
+              - key: config.yml
+                path: config.yml
+              name: api-config
+---
+apiVersion: v1
+kind: Service
+metadata:
+  labels:
+    app.kubernetes.io/instance: test-enclave
+  name: api
+spec:
+  ports:
+  - name: http
+    port: 8080
+    protocol: TCP
+    targetPort: 8080
+  selector:
+    app.kubernetes.io/instance: test-enclave
+    app.kubernetes.io/name: api
+  type: ClusterIP
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    app.kubernetes.io/instance: test-enclave
+  name: database
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app.kubernetes.io/instance: test-enclave
+      app.kubernetes.io/name: database
+  strategy: {}
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/instance: test-enclave
+        app.kubernetes.io/name: database
+    spec:
+      containers:
+      - image: postgres:16
+        name: database
+        ports:
+        - containerPort: 5432
+          protocol: TCP
+        resources: {}
+        securityContext:
+          runAsGroup: 999
+          runAsUser: 999
+---
+apiVersion: v1
+kind: Service
+metadata:
+  labels:
+    app.kubernetes.io/instance: test-enclave
+  name: database
+spec:
+  ports:
+  - name: postgres
+    port: 5432
+    protocol: TCP
+    targetPort: 5432
+  selector:
+    app.kubernetes.io/instance: test-enclave
+    app.kubernetes.io/name: database
+  type: ClusterIP
+`
+	manifests, warnings := buildManifests("test-enclave", getServiceInfosForTest(), getFilesArtifactsForTest())
+	rendered, err := manifests.render(getHeader("test-enclave", warnings))
+	require.NoError(t, err)
+	require.Equal(t, expectedManifests, rendered)
+}
+
+func TestRenderHelmChart(t *testing.T) {
+	expectedValues := `# Exported from Kurtosis enclave 'test-enclave'; this approximates the enclave and might need adjustments
+services:
+  api:
+    image: api:latest
+    replicas: 1
+  database:
+    image: postgres:16
+    replicas: 1
+`
+	manifests, _ := buildManifests("test-enclave", getServiceInfosForTest(), getFilesArtifactsForTest())
+	chartFiles, err := renderHelmChart("test-enclave", "test-enclave", manifests, getHeader("test-enclave", []string{}))
+	require.NoError(t, err)
+	require.Len(t, chartFiles, 5)
+	require.Contains(t, chartFiles["Chart.yaml"], "name: test-enclave\n")
+	require.Equal(t, expectedValues, chartFiles["values.yaml"])
+	require.Contains(t, chartFiles["templates/api.yaml"], `image: {{ index .Values.services "api" "image" }}`)
+	require.Contains(t, chartFiles["templates/api.yaml"], `replicas: {{ index .Values.services "api" "replicas" }}`)
+	// The files artifacts mustn't be rendered as templates by Helm
+	require.Contains(t, chartFiles["templates/configmaps.yaml"], `greeting: '{{ "{{" }} .Greeting }}'`)
+}
+
+func TestReadFilesFromTgz(t *testing.T) {
+	tgz := bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(&tgz)
+	tarWriter := tar.NewWriter(gzipWriter)
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: "./certs/", Typeflag: tar.TypeDir, Mode: 0755}))
+	content := []byte("ca")
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: "./certs/ca.pem", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}))
+	_, err := tarWriter.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	files, err := readFilesFromTgz(&tgz)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"certs/ca.pem": content}, files)
+}
+
+func getServiceInfosForTest() map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo {
+	return map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"api": {
+			Name:          "api",
+			PrivateIpAddr: "172.16.0.4",
+			PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+				"http": {Number: 8080, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP},
+			},
+			MaybePublicPorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+				"http": {Number: 49153, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP},
+			},
+			Container: &kurtosis_core_rpc_api_bindings.Container{
+				ImageName: "api:latest",
+				CmdArgs:   []string{"--db", "postgres://172.16.0.3:5432"},
+				EnvVars:   map[string]string{"DB_HOST": "172.16.0.3"},
+			},
+			ServiceDirPathsToFilesArtifactsList: map[string]*kurtosis_core_rpc_api_bindings.FilesArtifactsList{
+				"/config": {FilesArtifactsIdentifiers: []string{"api-config"}},
+			},
+			MaxMillicpus:       500,
+			MinMillicpus:       100,
+			MaxMemoryMegabytes: 512,
+		},
+		"database": {
+			Name:          "database",
+			PrivateIpAddr: "172.16.0.3",
+			PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+				"postgres": {Number: 5432, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP},
+			},
+			Container: &kurtosis_core_rpc_api_bindings.Container{
+				ImageName: "postgres:16",
+			},
+			User: &kurtosis_core_rpc_api_bindings.User{Uid: 999, Gid: 999},
+		},
+	}
+}
+
+func getFilesArtifactsForTest() map[string]map[string][]byte {
+	return map[string]map[string][]byte{
+		"api-config": {
+			"config.yml":   []byte("greeting: '{{ .Greeting }}'\n"),
+			"certs/ca.der": {0xff, 0xfe, 0x00},
+		},
+	}
+}
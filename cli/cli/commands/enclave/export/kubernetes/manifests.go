@@ -0,0 +1,457 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ghodss/yaml"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/export/enclave_export"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/stacktrace"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	appNameLabelKey     = "app.kubernetes.io/name"
+	appInstanceLabelKey = "app.kubernetes.io/instance"
+
+	defaultReplicas = 1
+
+	filesVolumeNamePrefix = "files-"
+
+	// Kubernetes refuses ConfigMaps bigger than this
+	maxConfigMapSizeBytes = 1024 * 1024
+
+	// The longest name Kubernetes accepts for Services, ConfigMaps and port names being DNS labels
+	maxDnsLabelLength = 63
+
+	yamlDocumentSeparator = "---\n"
+
+	invalidDnsLabelCharReplacement     = "-"
+	invalidConfigMapKeyCharReplacement = "_"
+
+	// Fields Kubernetes fills in, which are rendered empty otherwise
+	creationTimestampFieldName = "creationTimestamp"
+	statusFieldName            = "status"
+)
+
+var (
+	invalidDnsLabelCharsRegex     = regexp.MustCompile("[^a-z0-9-]+")
+	invalidConfigMapKeyCharsRegex = regexp.MustCompile("[^-._a-zA-Z0-9]+")
+
+	transportProtocolToKubernetesProtocol = map[kurtosis_core_rpc_api_bindings.Port_TransportProtocol]apiv1.Protocol{
+		kurtosis_core_rpc_api_bindings.Port_TCP:  apiv1.ProtocolTCP,
+		kurtosis_core_rpc_api_bindings.Port_UDP:  apiv1.ProtocolUDP,
+		kurtosis_core_rpc_api_bindings.Port_SCTP: apiv1.ProtocolSCTP,
+	}
+)
+
+// manifests holds the Kubernetes objects approximating an enclave
+type manifests struct {
+	configMaps []*apiv1.ConfigMap
+
+	// Deployment and Service of every service, by service name
+	deployments map[string]*appsv1.Deployment
+	services    map[string]*apiv1.Service
+
+	// Sorted, so that the manifests are always rendered in the same order
+	serviceNames []string
+}
+
+// buildManifests converts the services of the enclave to a Deployment and a ClusterIP Service each, and the files
+// artifacts they mount to ConfigMaps. It returns what couldn't be converted as warnings
+func buildManifests(
+	enclaveName string,
+	serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+	filesArtifactNameToFiles map[string]map[string][]byte,
+) (*manifests, []string) {
+	// Services reach each other through their Kubernetes Service, named after them, rather than by IP address
+	replaceIpAddrs := enclave_export.NewPrivateIpAddrReplacer(serviceInfos)
+
+	warnings := []string{}
+	result := &manifests{
+		configMaps:   []*apiv1.ConfigMap{},
+		deployments:  map[string]*appsv1.Deployment{},
+		services:     map[string]*apiv1.Service{},
+		serviceNames: []string{},
+	}
+
+	filesArtifactNames := []string{}
+	for filesArtifactName := range filesArtifactNameToFiles {
+		filesArtifactNames = append(filesArtifactNames, filesArtifactName)
+	}
+	sort.Strings(filesArtifactNames)
+	filesArtifactNameToConfigMapItems := map[string][]apiv1.KeyToPath{}
+	for _, filesArtifactName := range filesArtifactNames {
+		configMap, items := buildConfigMap(enclaveName, filesArtifactName, filesArtifactNameToFiles[filesArtifactName])
+		if configMap.Size() > maxConfigMapSizeBytes {
+			warnings = append(warnings, fmt.Sprintf(
+				"Files artifact '%s' is bigger than the %d bytes a ConfigMap can hold, so Kubernetes will refuse its ConfigMap '%s'; mount it from a volume instead",
+				filesArtifactName, maxConfigMapSizeBytes, configMap.GetName()))
+		}
+		result.configMaps = append(result.configMaps, configMap)
+		filesArtifactNameToConfigMapItems[filesArtifactName] = items
+	}
+
+	for _, serviceInfo := range user_services.GetSortedUserServiceSliceFromUserServiceMap(serviceInfos) {
+		serviceName := serviceInfo.GetName()
+		result.serviceNames = append(result.serviceNames, serviceName)
+		result.deployments[serviceName] = buildDeployment(enclaveName, serviceInfo, replaceIpAddrs, filesArtifactNameToConfigMapItems)
+		result.services[serviceName] = buildService(enclaveName, serviceInfo)
+
+		if len(serviceInfo.GetMaybePublicPorts()) > 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"Service '%s' has public ports, which are only reachable inside the cluster; use 'kubectl port-forward' or an Ingress to reach them",
+				serviceName))
+		}
+		for _, dirPath := range enclave_export.GetSortedMountDirPaths(serviceInfo) {
+			for _, filesArtifactName := range serviceInfo.GetServiceDirPathsToFilesArtifactsList()[dirPath].GetFilesArtifactsIdentifiers() {
+				if _, found := filesArtifactNameToFiles[filesArtifactName]; !found {
+					warnings = append(warnings, fmt.Sprintf(
+						"Service '%s' mounts files artifact '%s' at '%s', which couldn't be exported",
+						serviceName, filesArtifactName, dirPath))
+				}
+			}
+		}
+	}
+	return result, warnings
+}
+
+// render returns the manifests as a multi-document YAML, preceded by comments listing what couldn't be exported
+func (manifests *manifests) render(header string) (string, error) {
+	documents := []string{}
+	for _, configMap := range manifests.configMaps {
+		document, err := marshalManifest(configMap)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred marshalling ConfigMap '%s'", configMap.GetName())
+		}
+		documents = append(documents, document)
+	}
+	for _, serviceName := range manifests.serviceNames {
+		serviceDocuments, err := manifests.renderService(serviceName)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred rendering the manifests of service '%s'", serviceName)
+		}
+		documents = append(documents, serviceDocuments)
+	}
+	return header + strings.Join(documents, yamlDocumentSeparator), nil
+}
+
+// renderService returns the Deployment and the Service of a service as a multi-document YAML
+func (manifests *manifests) renderService(serviceName string) (string, error) {
+	deploymentDocument, err := marshalManifest(manifests.deployments[serviceName])
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred marshalling the Deployment of service '%s'", serviceName)
+	}
+	serviceDocument, err := marshalManifest(manifests.services[serviceName])
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred marshalling the Service of service '%s'", serviceName)
+	}
+	return deploymentDocument + yamlDocumentSeparator + serviceDocument, nil
+}
+
+// buildConfigMap converts a files artifact to a ConfigMap, with the items mapping its keys back to the paths of the
+// files, as ConfigMap keys can't contain directories
+func buildConfigMap(enclaveName string, filesArtifactName string, filePathToContent map[string][]byte) (*apiv1.ConfigMap, []apiv1.KeyToPath) {
+	filePaths := []string{}
+	for filePath := range filePathToContent {
+		filePaths = append(filePaths, filePath)
+	}
+	sort.Strings(filePaths)
+
+	configMap := &apiv1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: apiv1.SchemeGroupVersion.String()},
+		ObjectMeta: newObjectMeta(getConfigMapName(filesArtifactName), enclaveName, nil),
+		Immutable:  nil,
+		Data:       map[string]string{},
+		BinaryData: map[string][]byte{},
+	}
+	items := []apiv1.KeyToPath{}
+	usedKeys := map[string]bool{}
+	for _, filePath := range filePaths {
+		key := getConfigMapKey(filePath, usedKeys)
+		usedKeys[key] = true
+		content := filePathToContent[filePath]
+		if utf8.Valid(content) {
+			configMap.Data[key] = string(content)
+		} else {
+			configMap.BinaryData[key] = content
+		}
+		items = append(items, apiv1.KeyToPath{Key: key, Path: filePath, Mode: nil})
+	}
+	return configMap, items
+}
+
+func buildDeployment(
+	enclaveName string,
+	serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo,
+	replaceIpAddrs func(string) string,
+	filesArtifactNameToConfigMapItems map[string][]apiv1.KeyToPath,
+) *appsv1.Deployment {
+	serviceName := serviceInfo.GetName()
+	container := serviceInfo.GetContainer()
+
+	podLabels := map[string]string{}
+	for labelKey, labelValue := range serviceInfo.GetLabels() {
+		podLabels[labelKey] = labelValue
+	}
+	for labelKey, labelValue := range getSelectorLabels(enclaveName, serviceName) {
+		podLabels[labelKey] = labelValue
+	}
+
+	envVars := []apiv1.EnvVar{}
+	for envVarKey, envVarValue := range container.GetEnvVars() {
+		envVars = append(envVars, apiv1.EnvVar{Name: envVarKey, Value: replaceIpAddrs(envVarValue), ValueFrom: nil})
+	}
+	sort.Slice(envVars, func(i, j int) bool {
+		return envVars[i].Name < envVars[j].Name
+	})
+
+	containerPorts := []apiv1.ContainerPort{}
+	for _, portId := range getSortedPortIds(serviceInfo) {
+		privatePort := serviceInfo.GetPrivatePorts()[portId]
+		// nolint: exhaustruct
+		containerPorts = append(containerPorts, apiv1.ContainerPort{
+			ContainerPort: int32(privatePort.GetNumber()),
+			Protocol:      transportProtocolToKubernetesProtocol[privatePort.GetTransportProtocol()],
+		})
+	}
+
+	volumes := []apiv1.Volume{}
+	volumeMounts := []apiv1.VolumeMount{}
+	for volumeIndex, dirPath := range enclave_export.GetSortedMountDirPaths(serviceInfo) {
+		volumeName := fmt.Sprintf("%s%d", filesVolumeNamePrefix, volumeIndex)
+		// Projecting every files artifact mounted at the same directory into a single volume
+		volumeSources := []apiv1.VolumeProjection{}
+		for _, filesArtifactName := range serviceInfo.GetServiceDirPathsToFilesArtifactsList()[dirPath].GetFilesArtifactsIdentifiers() {
+			// nolint: exhaustruct
+			volumeSources = append(volumeSources, apiv1.VolumeProjection{
+				ConfigMap: &apiv1.ConfigMapProjection{
+					LocalObjectReference: apiv1.LocalObjectReference{Name: getConfigMapName(filesArtifactName)},
+					Items:                filesArtifactNameToConfigMapItems[filesArtifactName],
+					Optional:             nil,
+				},
+			})
+		}
+		// nolint: exhaustruct
+		volumes = append(volumes, apiv1.Volume{
+			Name: volumeName,
+			VolumeSource: apiv1.VolumeSource{
+				Projected: &apiv1.ProjectedVolumeSource{Sources: volumeSources, DefaultMode: nil},
+			},
+		})
+		// nolint: exhaustruct
+		volumeMounts = append(volumeMounts, apiv1.VolumeMount{Name: volumeName, MountPath: dirPath})
+	}
+
+	// nolint: exhaustruct
+	podContainer := apiv1.Container{
+		Name:         serviceName,
+		Image:        container.GetImageName(),
+		Command:      replaceAllIpAddrs(container.GetEntrypointArgs(), replaceIpAddrs),
+		Args:         replaceAllIpAddrs(container.GetCmdArgs(), replaceIpAddrs),
+		Ports:        containerPorts,
+		Env:          envVars,
+		Resources:    getResourceRequirements(serviceInfo),
+		VolumeMounts: volumeMounts,
+	}
+	if serviceInfo.User != nil {
+		uid := int64(serviceInfo.GetUser().GetUid())
+		gid := int64(serviceInfo.GetUser().GetGid())
+		// nolint: exhaustruct
+		podContainer.SecurityContext = &apiv1.SecurityContext{RunAsUser: &uid, RunAsGroup: &gid}
+	}
+
+	tolerations := []apiv1.Toleration{}
+	for _, toleration := range serviceInfo.GetTolerations() {
+		kubernetesToleration := apiv1.Toleration{
+			Key:               toleration.GetKey(),
+			Operator:          apiv1.TolerationOperator(toleration.GetOperator()),
+			Value:             toleration.GetValue(),
+			Effect:            apiv1.TaintEffect(toleration.GetEffect()),
+			TolerationSeconds: nil,
+		}
+		if toleration.GetTolerationSeconds() > 0 {
+			tolerationSeconds := toleration.GetTolerationSeconds()
+			kubernetesToleration.TolerationSeconds = &tolerationSeconds
+		}
+		tolerations = append(tolerations, kubernetesToleration)
+	}
+
+	replicas := int32(defaultReplicas)
+	// nolint: exhaustruct
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: appsv1.SchemeGroupVersion.String()},
+		ObjectMeta: newObjectMeta(serviceName, enclaveName, serviceInfo.GetLabels()),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: getSelectorLabels(enclaveName, serviceName), MatchExpressions: nil},
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				// nolint: exhaustruct
+				Spec: apiv1.PodSpec{
+					Containers:   []apiv1.Container{podContainer},
+					Volumes:      volumes,
+					NodeSelector: serviceInfo.GetNodeSelectors(),
+					Tolerations:  tolerations,
+				},
+			},
+		},
+	}
+}
+
+func buildService(enclaveName string, serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) *apiv1.Service {
+	servicePorts := []apiv1.ServicePort{}
+	for _, portId := range getSortedPortIds(serviceInfo) {
+		privatePort := serviceInfo.GetPrivatePorts()[portId]
+		// nolint: exhaustruct
+		servicePorts = append(servicePorts, apiv1.ServicePort{
+			Name:       sanitizeDnsLabel(portId),
+			Protocol:   transportProtocolToKubernetesProtocol[privatePort.GetTransportProtocol()],
+			Port:       int32(privatePort.GetNumber()),
+			TargetPort: intstr.FromInt(int(privatePort.GetNumber())),
+		})
+	}
+	// nolint: exhaustruct
+	return &apiv1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: apiv1.SchemeGroupVersion.String()},
+		ObjectMeta: newObjectMeta(serviceInfo.GetName(), enclaveName, serviceInfo.GetLabels()),
+		// nolint: exhaustruct
+		Spec: apiv1.ServiceSpec{
+			Type:     apiv1.ServiceTypeClusterIP,
+			Selector: getSelectorLabels(enclaveName, serviceInfo.GetName()),
+			Ports:    servicePorts,
+		},
+	}
+}
+
+// marshalManifest returns the object as YAML, without the fields only Kubernetes fills in
+func marshalManifest(object interface{}) (string, error) {
+	fields, err := getManifestFields(object)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the fields of the object")
+	}
+	return marshalManifestFields(fields)
+}
+
+// getManifestFields returns the fields of the object as they're rendered, without the ones only Kubernetes fills in
+func getManifestFields(object interface{}) (map[string]interface{}, error) {
+	objectJson, err := json.Marshal(object)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred marshalling the object to JSON")
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(objectJson, &fields); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred unmarshalling the object from JSON")
+	}
+	delete(fields, statusFieldName)
+	removeCreationTimestamps(fields)
+	return fields, nil
+}
+
+func marshalManifestFields(fields map[string]interface{}) (string, error) {
+	fieldsYaml, err := yaml.Marshal(fields)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred marshalling the object to YAML")
+	}
+	return string(fieldsYaml), nil
+}
+
+func removeCreationTimestamps(fields map[string]interface{}) {
+	for fieldName, fieldValue := range fields {
+		if fieldName == creationTimestampFieldName && fieldValue == nil {
+			delete(fields, fieldName)
+			continue
+		}
+		if nestedFields, ok := fieldValue.(map[string]interface{}); ok {
+			removeCreationTimestamps(nestedFields)
+		}
+	}
+}
+
+func newObjectMeta(name string, enclaveName string, labels map[string]string) metav1.ObjectMeta {
+	objectLabels := map[string]string{}
+	for labelKey, labelValue := range labels {
+		objectLabels[labelKey] = labelValue
+	}
+	objectLabels[appInstanceLabelKey] = enclaveName
+	// nolint: exhaustruct
+	return metav1.ObjectMeta{
+		Name:   name,
+		Labels: objectLabels,
+	}
+}
+
+func getSelectorLabels(enclaveName string, serviceName string) map[string]string {
+	return map[string]string{
+		appNameLabelKey:     serviceName,
+		appInstanceLabelKey: enclaveName,
+	}
+}
+
+func getResourceRequirements(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) apiv1.ResourceRequirements {
+	limits := apiv1.ResourceList{}
+	requests := apiv1.ResourceList{}
+	if serviceInfo.GetMaxMillicpus() > 0 {
+		limits[apiv1.ResourceCPU] = *resource.NewMilliQuantity(int64(serviceInfo.GetMaxMillicpus()), resource.DecimalSI)
+	}
+	if serviceInfo.GetMinMillicpus() > 0 {
+		requests[apiv1.ResourceCPU] = *resource.NewMilliQuantity(int64(serviceInfo.GetMinMillicpus()), resource.DecimalSI)
+	}
+	if serviceInfo.GetMaxMemoryMegabytes() > 0 {
+		limits[apiv1.ResourceMemory] = *resource.NewScaledQuantity(int64(serviceInfo.GetMaxMemoryMegabytes()), resource.Mega)
+	}
+	if serviceInfo.GetMinMemoryMegabytes() > 0 {
+		requests[apiv1.ResourceMemory] = *resource.NewScaledQuantity(int64(serviceInfo.GetMinMemoryMegabytes()), resource.Mega)
+	}
+	return apiv1.ResourceRequirements{Limits: limits, Requests: requests, Claims: nil}
+}
+
+func getSortedPortIds(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) []string {
+	portIds := []string{}
+	for portId := range serviceInfo.GetPrivatePorts() {
+		portIds = append(portIds, portId)
+	}
+	sort.Strings(portIds)
+	return portIds
+}
+
+func replaceAllIpAddrs(values []string, replaceIpAddrs func(string) string) []string {
+	replacedValues := []string{}
+	for _, value := range values {
+		replacedValues = append(replacedValues, replaceIpAddrs(value))
+	}
+	return replacedValues
+}
+
+func getConfigMapName(filesArtifactName string) string {
+	return sanitizeDnsLabel(filesArtifactName)
+}
+
+// getConfigMapKey returns a key for the file that ConfigMaps accept and that isn't used yet
+func getConfigMapKey(filePath string, usedKeys map[string]bool) string {
+	key := invalidConfigMapKeyCharsRegex.ReplaceAllString(filePath, invalidConfigMapKeyCharReplacement)
+	uniqueKey := key
+	for suffix := 1; usedKeys[uniqueKey]; suffix++ {
+		uniqueKey = fmt.Sprintf("%s%s%d", key, invalidConfigMapKeyCharReplacement, suffix)
+	}
+	return uniqueKey
+}
+
+// sanitizeDnsLabel turns the name into one Kubernetes accepts for objects and ports
+func sanitizeDnsLabel(name string) string {
+	sanitizedName := invalidDnsLabelCharsRegex.ReplaceAllString(strings.ToLower(name), invalidDnsLabelCharReplacement)
+	if len(sanitizedName) > maxDnsLabelLength {
+		sanitizedName = sanitizedName[:maxDnsLabelLength]
+	}
+	return strings.Trim(sanitizedName, invalidDnsLabelCharReplacement)
+}
@@ -0,0 +1,188 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	outputFormatKey          = "output"
+	outputFormatKeyShorthand = "o"
+	dotOutputFormat          = "dot"
+	jsonOutputFormat         = "json"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	explicitDependencyKind     = "explicit"
+	runtimeValueDependencyKind = "runtime_value"
+
+	// runtime value dependencies are implied by the service config, so they're drawn lighter than explicit ones
+	explicitEdgeStyle     = "solid"
+	runtimeValueEdgeStyle = "dashed"
+	// services with ready conditions get a double border, services depending on them were only started once they were ready
+	readyConditionsNodePeripheries = 2
+	defaultNodePeripheries         = 1
+)
+
+var EnclaveGraphCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.EnclaveGraphCmdStr,
+	ShortDescription:          "Prints the service dependency graph of an enclave",
+	LongDescription:           "Prints the dependencies between the services of an enclave, either as a Graphviz DOT graph or as JSON. A service depends on another one if it lists it in the 'depends_on' attribute of its ServiceConfig, or if its config references a runtime value of the other service like its IP address or hostname. In the DOT output, edges go from a service to the services it depends on; runtime value dependencies are dashed, and services with ready conditions have a double border.",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       outputFormatKey,
+			Shorthand: outputFormatKeyShorthand,
+			Usage:     fmt.Sprintf("Format to output the graph (%v or %v)", dotOutputFormat, jsonOutputFormat),
+			Type:      flags.FlagType_String,
+			Default:   dotOutputFormat,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+type serviceNodeJson struct {
+	Name               string `json:"name"`
+	HasReadyConditions bool   `json:"has_ready_conditions"`
+}
+
+type dependencyEdgeJson struct {
+	Service   string `json:"service"`
+	DependsOn string `json:"depends_on"`
+	Kind      string `json:"kind"`
+}
+
+type dependencyGraphJson struct {
+	Services     []serviceNodeJson    `json:"services"`
+	Dependencies []dependencyEdgeJson `json:"dependencies"`
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	outputFormat, err := flags.GetString(outputFormatKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", outputFormatKey)
+	}
+	outputFormat = strings.ToLower(strings.TrimSpace(outputFormat))
+	if outputFormat != dotOutputFormat && outputFormat != jsonOutputFormat {
+		return stacktrace.NewError("Invalid output format '%s'; must be '%v' or '%v'", outputFormat, dotOutputFormat, jsonOutputFormat)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	dependencyGraph, err := enclaveCtx.GetServiceDependencyGraph(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service dependency graph of enclave '%v'", enclaveIdentifier)
+	}
+
+	var renderedGraph string
+	switch outputFormat {
+	case jsonOutputFormat:
+		renderedGraph, err = renderJson(dependencyGraph)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred rendering the service dependency graph of enclave '%v' as JSON", enclaveIdentifier)
+		}
+	default:
+		renderedGraph = renderDot(enclaveCtx.GetEnclaveName(), dependencyGraph)
+	}
+	out.PrintOutLn(renderedGraph)
+	return nil
+}
+
+func renderDot(enclaveName string, dependencyGraph *kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse) string {
+	dot := strings.Builder{}
+	dot.WriteString(fmt.Sprintf("digraph %q {\n", enclaveName))
+	for _, node := range dependencyGraph.GetNodes() {
+		peripheries := defaultNodePeripheries
+		if node.GetHasReadyConditions() {
+			peripheries = readyConditionsNodePeripheries
+		}
+		dot.WriteString(fmt.Sprintf("  %q [peripheries=%d];\n", node.GetServiceName(), peripheries))
+	}
+	for _, edge := range dependencyGraph.GetEdges() {
+		style := explicitEdgeStyle
+		if edge.GetKind() == kurtosis_core_rpc_api_bindings.ServiceDependencyKind_RUNTIME_VALUE {
+			style = runtimeValueEdgeStyle
+		}
+		dot.WriteString(fmt.Sprintf("  %q -> %q [style=%s];\n", edge.GetServiceName(), edge.GetDependencyServiceName(), style))
+	}
+	dot.WriteString("}")
+	return dot.String()
+}
+
+func renderJson(dependencyGraph *kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse) (string, error) {
+	graphJson := dependencyGraphJson{
+		Services:     []serviceNodeJson{},
+		Dependencies: []dependencyEdgeJson{},
+	}
+	for _, node := range dependencyGraph.GetNodes() {
+		graphJson.Services = append(graphJson.Services, serviceNodeJson{
+			Name:               node.GetServiceName(),
+			HasReadyConditions: node.GetHasReadyConditions(),
+		})
+	}
+	for _, edge := range dependencyGraph.GetEdges() {
+		kind := explicitDependencyKind
+		if edge.GetKind() == kurtosis_core_rpc_api_bindings.ServiceDependencyKind_RUNTIME_VALUE {
+			kind = runtimeValueDependencyKind
+		}
+		graphJson.Dependencies = append(graphJson.Dependencies, dependencyEdgeJson{
+			Service:   edge.GetServiceName(),
+			DependsOn: edge.GetDependencyServiceName(),
+			Kind:      kind,
+		})
+	}
+	jsonBytes, err := json.MarshalIndent(graphJson, "", "  ")
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred marshalling the service dependency graph")
+	}
+	return string(jsonBytes), nil
+}
@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderDot(t *testing.T) {
+	expectedDot := `digraph "test-enclave" {
+  "api" [peripheries=1];
+  "database" [peripheries=2];
+  "frontend" [peripheries=1];
+  "api" -> "database" [style=dashed];
+  "frontend" -> "api" [style=solid];
+}`
+	require.Equal(t, expectedDot, renderDot("test-enclave", getDependencyGraphForTest()))
+}
+
+func TestRenderJson(t *testing.T) {
+	expectedJson := `{
+  "services": [
+    {
+      "name": "api",
+      "has_ready_conditions": false
+    },
+    {
+      "name": "database",
+      "has_ready_conditions": true
+    },
+    {
+      "name": "frontend",
+      "has_ready_conditions": false
+    }
+  ],
+  "dependencies": [
+    {
+      "service": "api",
+      "depends_on": "database",
+      "kind": "runtime_value"
+    },
+    {
+      "service": "frontend",
+      "depends_on": "api",
+      "kind": "explicit"
+    }
+  ]
+}`
+	renderedJson, err := renderJson(getDependencyGraphForTest())
+	require.NoError(t, err)
+	require.Equal(t, expectedJson, renderedJson)
+}
+
+func TestRenderJson_EmptyGraph(t *testing.T) {
+	renderedJson, err := renderJson(&kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse{}) //nolint:exhaustruct
+	require.NoError(t, err)
+	require.Equal(t, "{\n  \"services\": [],\n  \"dependencies\": []\n}", renderedJson)
+}
+
+func getDependencyGraphForTest() *kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse {
+	return &kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse{ //nolint:exhaustruct
+		Nodes: []*kurtosis_core_rpc_api_bindings.ServiceDependencyNode{
+			{ServiceName: "api", HasReadyConditions: false},      //nolint:exhaustruct
+			{ServiceName: "database", HasReadyConditions: true},  //nolint:exhaustruct
+			{ServiceName: "frontend", HasReadyConditions: false}, //nolint:exhaustruct
+		},
+		Edges: []*kurtosis_core_rpc_api_bindings.ServiceDependencyEdge{
+			{ServiceName: "api", DependencyServiceName: "database", Kind: kurtosis_core_rpc_api_bindings.ServiceDependencyKind_RUNTIME_VALUE}, //nolint:exhaustruct
+			{ServiceName: "frontend", DependencyServiceName: "api", Kind: kurtosis_core_rpc_api_bindings.ServiceDependencyKind_EXPLICIT},      //nolint:exhaustruct
+		},
+	}
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -38,10 +40,16 @@ const (
 	enclaveStatusTitleName       = "Status"
 	enclaveCreationTimeTitleName = "Creation Time"
 	flagsTitleName               = "Flags"
+	ownerTitleName               = "Owner"
+	clusterTitleName             = "Cluster"
+	expirationTimeTitleName      = "Expires"
 
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
+	metricsFlagKey        = "metrics"
+	metricsFlagKeyDefault = "false"
+
 	headerWidthChars = 100
 	headerPadChar    = "="
 
@@ -62,7 +70,7 @@ var enclaveObjectPrintingFuncs = map[string]func(ctx context.Context, kurtosisCt
 var EnclaveInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveInspectCmdStr,
 	ShortDescription:          "Inspect an enclave",
-	LongDescription:           "List information about the enclave's status and contents",
+	LongDescription:           "List information about the enclave's status and contents; pass '--" + defaults.OutputFormatFlagKey + " json' or '--" + defaults.OutputFormatFlagKey + " yaml' to get it in a machine-readable format",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -72,6 +80,12 @@ var EnclaveInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtos
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		{
+			Key:     metricsFlagKey,
+			Usage:   "If true then Kurtosis also prints the current CPU and memory usage of each running service, and its peak memory usage when it can be found, next to the resources set in its config. On Kubernetes, this needs metrics-server in the cluster. Default false.",
+			Type:    flags.FlagType_Bool,
+			Default: metricsFlagKeyDefault,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -87,7 +101,7 @@ var EnclaveInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtos
 func run(
 	ctx context.Context,
 	kurtosisBackend backend_interface.KurtosisBackend,
-	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	_ metrics_client.MetricsClient,
 	flags *flags.ParsedFlags,
 	args *args.ParsedArgs,
@@ -102,15 +116,48 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
+	showMetrics, err := flags.GetBool(metricsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", metricsFlagKey)
+	}
+	// the resource usage is only asked to the backend when it's needed, as sampling it takes a while
+	var maybeKurtosisBackend backend_interface.KurtosisBackend
+	var maybeEngineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient
+	if showMetrics {
+		maybeKurtosisBackend = kurtosisBackend
+		maybeEngineClient = engineClient
+	}
+
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
+	if outputFormat.IsStructured() {
+		if err = printEnclaveInspectStructured(ctx, kurtosisCtx, maybeKurtosisBackend, maybeEngineClient, enclaveIdentifier, outputFormat); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing enclave '%v' as '%v'", enclaveIdentifier, outputFormat)
+		}
+		return nil
+	}
+
 	if err = PrintEnclaveInspect(ctx, kurtosisCtx, enclaveIdentifier, showFullUuids); err != nil {
 		// this is already wrapped up
 		return err
 	}
+	if maybeKurtosisBackend != nil {
+		if err = printEnclaveResourceUsage(ctx, kurtosisCtx, maybeKurtosisBackend, enclaveIdentifier, showFullUuids); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the resource usage of the services of enclave '%v'", enclaveIdentifier)
+		}
+	}
 	return nil
 }
 
@@ -152,6 +199,21 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 
 	keyValuePrinter.AddPair(flagsTitleName, allEnclaveFlagsStr)
 
+	// Add expiration time row, only if the enclave has a TTL
+	if enclaveInfo.ExpirationTime != nil {
+		keyValuePrinter.AddPair(expirationTimeTitleName, enclaveInfo.GetExpirationTime().AsTime().Local().Format(time.RFC1123))
+	}
+
+	// Add owner row, only known if the engine requires authentication
+	if enclaveInfo.Owner != nil {
+		keyValuePrinter.AddPair(ownerTitleName, enclaveInfo.GetOwner())
+	}
+
+	// Add cluster row, only known if the engine spans several clusters
+	if enclaveInfo.ClusterName != nil {
+		keyValuePrinter.AddPair(clusterTitleName, enclaveInfo.GetClusterName())
+	}
+
 	isApiContainerRunning := enclaveInfo.GetApiContainerStatus() == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING
 
 	keyValuePrinter.Print()
@@ -175,11 +237,7 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 			return stacktrace.NewError("No printing function found for enclave object '%v'; this is a bug in Kurtosis!", header)
 		}
 
-		numRunesInHeader := utf8.RuneLen(' ') + utf8.RuneCountInString(header) + utf8.RuneLen(' ') // there will be a space before and after the header
-		numPadChars := (headerWidthChars - numRunesInHeader) / 2                                   //nolint:mnd
-		padStr := strings.Repeat(headerPadChar, numPadChars)
-		fmt.Printf("%v %v %v\n", padStr, header, padStr)
-
+		printHeader(header)
 		if err := printingFunc(ctx, kurtosisCtx, enclaveInfo, showFullUuids, isApiContainerRunning); err != nil {
 			logrus.Error(err)
 			headersWithPrintErrs = append(headersWithPrintErrs, header)
@@ -197,6 +255,40 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 	return nil
 }
 
+func printEnclaveResourceUsage(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, kurtosisBackend backend_interface.KurtosisBackend, enclaveIdentifier string, showFullUuids bool) error {
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+	// the services and the resources set in their config are only known by the API container
+	if enclaveInfo.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		return nil
+	}
+	allServicesMap := map[string]bool{}
+	userServices, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, kurtosisCtx, enclaveInfo, allServicesMap)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	serviceResourceUsage, err := getServiceResourceUsage(ctx, kurtosisBackend, enclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the resource usage of the services of enclave '%v'", enclaveInfo.GetName())
+	}
+
+	printHeader(resourceUsageHeader)
+	if err = printServiceResourceUsage(user_services.GetSortedUserServiceSliceFromUserServiceMap(userServices), serviceResourceUsage, showFullUuids); err != nil {
+		return stacktrace.Propagate(err, "An error occurred printing the resource usage of the services of enclave '%v'", enclaveInfo.GetName())
+	}
+	fmt.Println("")
+	return nil
+}
+
+func printHeader(header string) {
+	numRunesInHeader := utf8.RuneLen(' ') + utf8.RuneCountInString(header) + utf8.RuneLen(' ') // there will be a space before and after the header
+	numPadChars := (headerWidthChars - numRunesInHeader) / 2                                   //nolint:mnd
+	padStr := strings.Repeat(headerPadChar, numPadChars)
+	fmt.Printf("%v %v %v\n", padStr, header, padStr)
+}
+
 func getAllEnclaveFlagsStr(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) string {
 	allEnclaveFragsStr := ""
 
@@ -0,0 +1,107 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	resourceUsageHeader = "Resource Usage"
+
+	// The units are the ones of the resources of ServiceConfig, so the numbers can be copied to it
+	resourceUsageCpuColHeader          = "CPU (millicores)"
+	resourceUsageCpuLimitsColHeader    = "CPU Min/Max"
+	resourceUsageMemoryColHeader       = "Memory (MB)"
+	resourceUsagePeakMemoryColHeader   = "Peak Memory (MB)"
+	resourceUsageMemoryLimitsColHeader = "Memory Min/Max"
+
+	bytesInMegaBytes = 1000000
+
+	unknownResourceUsageStr = "-"
+)
+
+// getServiceResourceUsage gets the resource usage of the running services of the enclave, keyed by service UUID. The
+// services it can't get it for are logged and left out, as their status is enough to tell them apart
+func getServiceResourceUsage(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (map[string]*service.ResourceUsage, error) {
+	runningServicesFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: nil,
+		Statuses: map[container.ContainerStatus]bool{
+			container.ContainerStatus_Running: true,
+		},
+	}
+	resourceUsageByServiceUuid, erroredServices, err := kurtosisBackend.GetUserServiceResourceUsage(ctx, enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid()), runningServicesFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the resource usage of the services of enclave '%v'", enclaveInfo.GetName())
+	}
+	for serviceUuid, serviceErr := range erroredServices {
+		logrus.Warnf("Couldn't get the resource usage of service '%v':\n%v", serviceUuid, serviceErr)
+	}
+
+	serviceResourceUsage := map[string]*service.ResourceUsage{}
+	for serviceUuid, resourceUsage := range resourceUsageByServiceUuid {
+		serviceResourceUsage[string(serviceUuid)] = resourceUsage
+	}
+	return serviceResourceUsage, nil
+}
+
+func printServiceResourceUsage(sortedUserServices []*kurtosis_core_rpc_api_bindings.ServiceInfo, serviceResourceUsage map[string]*service.ResourceUsage, showFullUuids bool) error {
+	tablePrinter := output_printers.NewTablePrinter(
+		userServiceUUIDColHeader,
+		userServiceNameColHeader,
+		resourceUsageCpuColHeader,
+		resourceUsageCpuLimitsColHeader,
+		resourceUsageMemoryColHeader,
+		resourceUsagePeakMemoryColHeader,
+		resourceUsageMemoryLimitsColHeader,
+	)
+	for _, userService := range sortedUserServices {
+		uuidToPrint := userService.GetShortenedUuid()
+		if showFullUuids {
+			uuidToPrint = userService.GetServiceUuid()
+		}
+
+		cpuStr, memoryStr, peakMemoryStr := unknownResourceUsageStr, unknownResourceUsageStr, unknownResourceUsageStr
+		if resourceUsage, found := serviceResourceUsage[userService.GetServiceUuid()]; found {
+			cpuStr = fmt.Sprintf("%d", resourceUsage.GetCpuMilliCores())
+			memoryStr = getMegaBytesStr(resourceUsage.GetMemoryBytes())
+			if maybePeakMemoryBytes := resourceUsage.GetMaybePeakMemoryBytes(); maybePeakMemoryBytes != nil {
+				peakMemoryStr = getMegaBytesStr(*maybePeakMemoryBytes)
+			}
+		}
+		cpuLimitsStr := getResourceLimitsStr(userService.GetMinMillicpus(), userService.GetMaxMillicpus())
+		memoryLimitsStr := getResourceLimitsStr(userService.GetMinMemoryMegabytes(), userService.GetMaxMemoryMegabytes())
+
+		if err := tablePrinter.AddRow(uuidToPrint, userService.GetName(), cpuStr, cpuLimitsStr, memoryStr, peakMemoryStr, memoryLimitsStr); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding the resource usage row of service '%v' to the table printer", userService.GetName())
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+func getMegaBytesStr(bytes uint64) string {
+	return fmt.Sprintf("%.1f", float64(bytes)/bytesInMegaBytes)
+}
+
+// getResourceLimitsStr prints the min and max of a resource of a service, where 0 means it wasn't set
+func getResourceLimitsStr(min uint32, max uint32) string {
+	minStr, maxStr := unknownResourceUsageStr, unknownResourceUsageStr
+	if min != 0 {
+		minStr = fmt.Sprintf("%d", min)
+	}
+	if max != 0 {
+		maxStr = fmt.Sprintf("%d", max)
+	}
+	return minStr + "/" + maxStr
+}
@@ -0,0 +1,18 @@
+package inspect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResourceLimitsStr(t *testing.T) {
+	require.Equal(t, "100/1000", getResourceLimitsStr(100, 1000))
+	require.Equal(t, "-/512", getResourceLimitsStr(0, 512))
+	require.Equal(t, "-/-", getResourceLimitsStr(0, 0))
+}
+
+func TestGetMegaBytesStr(t *testing.T) {
+	require.Equal(t, "104.9", getMegaBytesStr(104857600))
+	require.Equal(t, "0.0", getMegaBytesStr(0))
+}
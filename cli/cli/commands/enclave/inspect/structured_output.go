@@ -0,0 +1,253 @@
+package inspect
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// The structures below are what the command prints with the output format flag. They're part of the CLI interface
+// that scripts rely on, so fields should only ever be added to them. UUIDs are always full, times are in RFC 3339
+type enclaveInspectOutput struct {
+	Uuid           string                `json:"uuid" yaml:"uuid"`
+	ShortenedUuid  string                `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name           string                `json:"name" yaml:"name"`
+	Status         string                `json:"status" yaml:"status"`
+	Mode           string                `json:"mode" yaml:"mode"`
+	CreationTime   string                `json:"creation_time" yaml:"creation_time"`
+	ExpirationTime string                `json:"expiration_time,omitempty" yaml:"expiration_time,omitempty"`
+	Owner          string                `json:"owner,omitempty" yaml:"owner,omitempty"`
+	Cluster        string                `json:"cluster,omitempty" yaml:"cluster,omitempty"`
+	Services       []serviceOutput       `json:"services" yaml:"services"`
+	FilesArtifacts []filesArtifactOutput `json:"files_artifacts" yaml:"files_artifacts"`
+}
+
+type serviceOutput struct {
+	Uuid          string               `json:"uuid" yaml:"uuid"`
+	ShortenedUuid string               `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name          string               `json:"name" yaml:"name"`
+	Status        string               `json:"status" yaml:"status"`
+	Ports         []portOutput         `json:"ports" yaml:"ports"`
+	Health        *serviceHealthOutput `json:"health,omitempty" yaml:"health,omitempty"`
+	// Only set with the metrics flag, for the running services
+	ResourceUsage *serviceResourceUsageOutput `json:"resource_usage,omitempty" yaml:"resource_usage,omitempty"`
+	// Only set with the metrics flag; the samples the engine took in the last few minutes, from the oldest to the latest
+	ResourceUsageHistory []resourceUsageSampleOutput `json:"resource_usage_history,omitempty" yaml:"resource_usage_history,omitempty"`
+}
+
+type portOutput struct {
+	Name                string `json:"name" yaml:"name"`
+	Number              uint32 `json:"number" yaml:"number"`
+	TransportProtocol   string `json:"transport_protocol" yaml:"transport_protocol"`
+	ApplicationProtocol string `json:"application_protocol,omitempty" yaml:"application_protocol,omitempty"`
+	PublicIpAddr        string `json:"public_ip_addr,omitempty" yaml:"public_ip_addr,omitempty"`
+	PublicNumber        uint32 `json:"public_number,omitempty" yaml:"public_number,omitempty"`
+}
+
+type serviceHealthOutput struct {
+	IsHealthy           bool   `json:"is_healthy" yaml:"is_healthy"`
+	ConsecutiveFailures uint32 `json:"consecutive_failures" yaml:"consecutive_failures"`
+	RestartCount        uint32 `json:"restart_count" yaml:"restart_count"`
+	LastError           string `json:"last_error,omitempty" yaml:"last_error,omitempty"`
+}
+
+type serviceResourceUsageOutput struct {
+	CpuMillicores   uint64  `json:"cpu_millicores" yaml:"cpu_millicores"`
+	MemoryBytes     uint64  `json:"memory_bytes" yaml:"memory_bytes"`
+	PeakMemoryBytes *uint64 `json:"peak_memory_bytes,omitempty" yaml:"peak_memory_bytes,omitempty"`
+	NetworkRxBytes  *uint64 `json:"network_rx_bytes,omitempty" yaml:"network_rx_bytes,omitempty"`
+	NetworkTxBytes  *uint64 `json:"network_tx_bytes,omitempty" yaml:"network_tx_bytes,omitempty"`
+}
+
+type resourceUsageSampleOutput struct {
+	Time           string  `json:"time" yaml:"time"`
+	CpuMillicores  uint64  `json:"cpu_millicores" yaml:"cpu_millicores"`
+	MemoryBytes    uint64  `json:"memory_bytes" yaml:"memory_bytes"`
+	NetworkRxBytes *uint64 `json:"network_rx_bytes,omitempty" yaml:"network_rx_bytes,omitempty"`
+	NetworkTxBytes *uint64 `json:"network_tx_bytes,omitempty" yaml:"network_tx_bytes,omitempty"`
+}
+
+type filesArtifactOutput struct {
+	Uuid          string `json:"uuid" yaml:"uuid"`
+	ShortenedUuid string `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name          string `json:"name" yaml:"name"`
+}
+
+// printEnclaveInspectStructured adds the resource usage of the services to the output if a backend to get it from is
+// passed, and the history of their resource usage if an engine client is passed
+func printEnclaveInspectStructured(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, maybeKurtosisBackend backend_interface.KurtosisBackend, maybeEngineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, enclaveIdentifier string, outputFormat output_printers.OutputFormat) error {
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusPlainStringifier(enclaveInfo.GetContainersStatus())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status")
+	}
+
+	enclaveOutput := enclaveInspectOutput{
+		Uuid:           enclaveInfo.GetEnclaveUuid(),
+		ShortenedUuid:  enclaveInfo.GetShortenedUuid(),
+		Name:           enclaveInfo.GetName(),
+		Status:         enclaveStatus,
+		Mode:           strings.ToLower(enclaveInfo.GetMode().String()),
+		CreationTime:   "",
+		ExpirationTime: "",
+		Owner:          enclaveInfo.GetOwner(),
+		Cluster:        enclaveInfo.GetClusterName(),
+		Services:       []serviceOutput{},
+		FilesArtifacts: []filesArtifactOutput{},
+	}
+	if enclaveInfo.GetCreationTime() != nil {
+		enclaveOutput.CreationTime = enclaveInfo.GetCreationTime().AsTime().UTC().Format(time.RFC3339)
+	}
+	if enclaveInfo.GetExpirationTime() != nil {
+		enclaveOutput.ExpirationTime = enclaveInfo.GetExpirationTime().AsTime().UTC().Format(time.RFC3339)
+	}
+
+	// services and files artifacts are only known by the API container
+	if enclaveInfo.GetApiContainerStatus() == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		allServicesMap := map[string]bool{}
+		userServices, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, kurtosisCtx, enclaveInfo, allServicesMap)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+		}
+		serviceResourceUsage := map[string]*service.ResourceUsage{}
+		if maybeKurtosisBackend != nil {
+			serviceResourceUsage, err = getServiceResourceUsage(ctx, maybeKurtosisBackend, enclaveInfo)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the resource usage of the services of enclave '%v'", enclaveInfo.GetName())
+			}
+		}
+		resourceUsageHistory := map[string]*kurtosis_engine_rpc_api_bindings.ResourceUsageSeries{}
+		if maybeEngineClient != nil {
+			getServiceResourceUsageArgs := &kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs{
+				EnclaveIdentifier: enclaveInfo.GetEnclaveUuid(),
+				Since:             nil,
+			}
+			response, err := maybeEngineClient.GetServiceResourceUsage(ctx, getServiceResourceUsageArgs)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the resource usage history of the services of enclave '%v' from the engine", enclaveInfo.GetName())
+			}
+			resourceUsageHistory = response.GetResourceUsageByServiceUuid()
+		}
+		for _, userService := range user_services.GetSortedUserServiceSliceFromUserServiceMap(userServices) {
+			userServiceOutput := newServiceOutput(userService)
+			if resourceUsage, found := serviceResourceUsage[userService.GetServiceUuid()]; found {
+				userServiceOutput.ResourceUsage = newServiceResourceUsageOutput(resourceUsage)
+			}
+			if series, found := resourceUsageHistory[userService.GetServiceUuid()]; found {
+				userServiceOutput.ResourceUsageHistory = newResourceUsageHistoryOutput(series)
+			}
+			enclaveOutput.Services = append(enclaveOutput.Services, userServiceOutput)
+		}
+
+		enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveInfo.GetName())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while fetching enclave with name '%v'", enclaveInfo.GetName())
+		}
+		filesArtifactsNamesAndUuids, err := enclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while fetching files artifacts name and uuids for enclave '%v'", enclaveInfo.GetName())
+		}
+		for _, filesArtifactNameAndUuid := range sortFileNamesAndUuids(filesArtifactsNamesAndUuids) {
+			enclaveOutput.FilesArtifacts = append(enclaveOutput.FilesArtifacts, filesArtifactOutput{
+				Uuid:          filesArtifactNameAndUuid.GetFileUuid(),
+				ShortenedUuid: uuid_generator.ShortenedUUIDString(filesArtifactNameAndUuid.GetFileUuid()),
+				Name:          filesArtifactNameAndUuid.GetFileName(),
+			})
+		}
+	}
+
+	if err = output_printers.PrintStructuredOutput(outputFormat, enclaveOutput); err != nil {
+		return stacktrace.Propagate(err, "An error occurred printing enclave '%v'", enclaveIdentifier)
+	}
+	return nil
+}
+
+func newServiceOutput(userService *kurtosis_core_rpc_api_bindings.ServiceInfo) serviceOutput {
+	portsOutput := []portOutput{}
+	for portName, privatePort := range userService.GetPrivatePorts() {
+		port := portOutput{
+			Name:                portName,
+			Number:              privatePort.GetNumber(),
+			TransportProtocol:   strings.ToLower(privatePort.GetTransportProtocol().String()),
+			ApplicationProtocol: privatePort.GetMaybeApplicationProtocol(),
+			PublicIpAddr:        "",
+			PublicNumber:        0,
+		}
+		if publicPort, found := userService.GetMaybePublicPorts()[portName]; found {
+			port.PublicIpAddr = userService.GetMaybePublicIpAddr()
+			port.PublicNumber = publicPort.GetNumber()
+		}
+		portsOutput = append(portsOutput, port)
+	}
+	sort.Slice(portsOutput, func(i, j int) bool {
+		return portsOutput[i].Name < portsOutput[j].Name
+	})
+
+	var healthOutput *serviceHealthOutput
+	if serviceHealth := userService.GetHealth(); serviceHealth != nil {
+		healthOutput = &serviceHealthOutput{
+			IsHealthy:           serviceHealth.GetIsHealthy(),
+			ConsecutiveFailures: serviceHealth.GetConsecutiveFailures(),
+			RestartCount:        serviceHealth.GetRestartCount(),
+			LastError:           serviceHealth.GetLastError(),
+		}
+	}
+
+	return serviceOutput{
+		Uuid:                 userService.GetServiceUuid(),
+		ShortenedUuid:        userService.GetShortenedUuid(),
+		Name:                 userService.GetName(),
+		Status:               userService.GetContainer().GetStatus().String(),
+		Ports:                portsOutput,
+		Health:               healthOutput,
+		ResourceUsage:        nil,
+		ResourceUsageHistory: nil,
+	}
+}
+
+func newServiceResourceUsageOutput(resourceUsage *service.ResourceUsage) *serviceResourceUsageOutput {
+	resourceUsageOutput := &serviceResourceUsageOutput{
+		CpuMillicores:   uint64(resourceUsage.GetCpuMilliCores()),
+		MemoryBytes:     resourceUsage.GetMemoryBytes(),
+		PeakMemoryBytes: resourceUsage.GetMaybePeakMemoryBytes(),
+		NetworkRxBytes:  nil,
+		NetworkTxBytes:  nil,
+	}
+	if networkUsage := resourceUsage.GetMaybeNetworkUsage(); networkUsage != nil {
+		rxBytes := networkUsage.GetRxBytes()
+		txBytes := networkUsage.GetTxBytes()
+		resourceUsageOutput.NetworkRxBytes = &rxBytes
+		resourceUsageOutput.NetworkTxBytes = &txBytes
+	}
+	return resourceUsageOutput
+}
+
+func newResourceUsageHistoryOutput(series *kurtosis_engine_rpc_api_bindings.ResourceUsageSeries) []resourceUsageSampleOutput {
+	samplesOutput := []resourceUsageSampleOutput{}
+	for _, sample := range series.GetSamples() {
+		samplesOutput = append(samplesOutput, resourceUsageSampleOutput{
+			Time:           sample.GetTimestamp().AsTime().UTC().Format(time.RFC3339),
+			CpuMillicores:  sample.GetCpuMilliCores(),
+			MemoryBytes:    sample.GetMemoryBytes(),
+			NetworkRxBytes: sample.NetworkRxBytes,
+			NetworkTxBytes: sample.NetworkTxBytes,
+		})
+	}
+	return samplesOutput
+}
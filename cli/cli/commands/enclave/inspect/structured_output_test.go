@@ -0,0 +1,48 @@
+package inspect
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServiceOutput(t *testing.T) {
+	userService := &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		ServiceUuid:   "0123456789ab",
+		ShortenedUuid: "0123456",
+		Name:          "postgres",
+		PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"postgres": {Number: 5432, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP, MaybeApplicationProtocol: "postgresql"},
+			"metrics":  {Number: 9187, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP},
+		},
+		MaybePublicIpAddr: "127.0.0.1",
+		MaybePublicPorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"postgres": {Number: 60000, TransportProtocol: kurtosis_core_rpc_api_bindings.Port_TCP},
+		},
+		Container: &kurtosis_core_rpc_api_bindings.Container{Status: kurtosis_core_rpc_api_bindings.Container_RUNNING},
+		Health:    &kurtosis_core_rpc_api_bindings.ServiceHealth{IsHealthy: false, ConsecutiveFailures: 3, LastError: "connection refused"},
+	}
+
+	serviceOutput := newServiceOutput(userService)
+	require.Equal(t, "0123456789ab", serviceOutput.Uuid)
+	require.Equal(t, "RUNNING", serviceOutput.Status)
+	require.Equal(t, []portOutput{
+		{Name: "metrics", Number: 9187, TransportProtocol: "tcp", ApplicationProtocol: "", PublicIpAddr: "", PublicNumber: 0},
+		{Name: "postgres", Number: 5432, TransportProtocol: "tcp", ApplicationProtocol: "postgresql", PublicIpAddr: "127.0.0.1", PublicNumber: 60000},
+	}, serviceOutput.Ports)
+	require.Equal(t, &serviceHealthOutput{IsHealthy: false, ConsecutiveFailures: 3, RestartCount: 0, LastError: "connection refused"}, serviceOutput.Health)
+}
+
+func TestNewServiceOutput_NoPortsNorReadyConditions(t *testing.T) {
+	userService := &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		Name:      "worker",
+		Container: &kurtosis_core_rpc_api_bindings.Container{Status: kurtosis_core_rpc_api_bindings.Container_STOPPED},
+	}
+
+	serviceOutput := newServiceOutput(userService)
+	require.Equal(t, "STOPPED", serviceOutput.Status)
+	require.Empty(t, serviceOutput.Ports)
+	require.NotNil(t, serviceOutput.Ports)
+	require.Nil(t, serviceOutput.Health)
+}
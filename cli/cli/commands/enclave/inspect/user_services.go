@@ -2,6 +2,8 @@ package inspect
 
 import (
 	"context"
+	"fmt"
+	"github.com/fatih/color"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
@@ -16,14 +18,19 @@ const (
 	userServiceNameColHeader   = "Name"
 	userServicePortsColHeader  = "Ports"
 	userServiceStatusColHeader = "Status"
+
+	// Appended to the status of the services failing their ready conditions after they started
+	unhealthyServiceMarker = "(unhealthy)"
 )
 
-func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuids bool, isAPIContainerRunning bool) error {
+var colorizeUnhealthy = color.New(color.FgRed).SprintFunc()
+
+func printUserServices(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuids bool, isAPIContainerRunning bool) error {
 	userServices := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
 	if isAPIContainerRunning {
 		var err error
 		allServicesMap := map[string]bool{}
-		userServices, err = user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, allServicesMap)
+		userServices, err = user_services.GetUserServiceInfoMapFromAPIContainer(ctx, kurtosisCtx, enclaveInfo, allServicesMap)
 		if err != nil {
 			return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
 		}
@@ -46,6 +53,9 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 
 		containerStatus := userService.GetContainer().GetStatus()
 		containerStatusStr := container_status_stringifier.ContainerStatusStringifier(containerStatus)
+		if serviceHealth := userService.GetHealth(); serviceHealth != nil && !serviceHealth.GetIsHealthy() {
+			containerStatusStr = fmt.Sprintf("%s %s", containerStatusStr, colorizeUnhealthy(unhealthyServiceMarker))
+		}
 
 		portBindingLines, err := user_services.GetUserServicePortBindingStrings(userService)
 		if err != nil {
@@ -0,0 +1,172 @@
+package logs_filters
+
+import (
+	"context"
+	"os"
+
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	filtersFileFlagKey     = "filters-file"
+	filtersFileFlagDefault = ""
+
+	clearFlagKey     = "clear"
+	clearFlagDefault = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveLogsFiltersCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveLogFiltersCmdStr,
+	ShortDescription: "Sets the logs filters of an enclave",
+	LongDescription: "Registers Fluent Bit filters applied to the logs of the services of the enclave only, on top of " +
+		"the logs collector filters of the Kurtosis config, e.g. to redact or enrich them. The filters replace the ones " +
+		"previously registered by the enclave and are applied by hot-reloading the logs collector, without restarting it " +
+		"or the engine",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key: filtersFileFlagKey,
+			Usage: "YAML file with the list of filters, in the format of the logs collector filters of the Kurtosis " +
+				"config but without 'match', as the filters only match the logs of the services of the enclave",
+			Type:    flags.FlagType_String,
+			Default: filtersFileFlagDefault,
+		},
+		{
+			Key:     clearFlagKey,
+			Usage:   "If true, removes the filters registered by the enclave",
+			Type:    flags.FlagType_Bool,
+			Default: clearFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	filtersFilepath, err := flags.GetString(filtersFileFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", filtersFileFlagKey)
+	}
+	shouldClear, err := flags.GetBool(clearFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", clearFlagKey)
+	}
+	if (filtersFilepath == "") == !shouldClear {
+		return stacktrace.NewError("Exactly one of the '--%v' and '--%v' flags needs to be set", filtersFileFlagKey, clearFlagKey)
+	}
+
+	var filters []logs_collector.Filter
+	if !shouldClear {
+		filters, err = readFilters(filtersFilepath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the filters from '%v'", filtersFilepath)
+		}
+		if len(filters) == 0 {
+			return stacktrace.NewError("The filters file '%v' has no filters; use '--%v' to remove the filters of the enclave", filtersFilepath, clearFlagKey)
+		}
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+	enclaveUuid := enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())
+
+	if err := resolveFiltersServices(ctx, kurtosisCtx, enclaveIdentifier, filters); err != nil {
+		return stacktrace.Propagate(err, "An error occurred resolving the services the filters of enclave '%v' are restricted to", enclaveIdentifier)
+	}
+
+	logrus.Infof("Updating the logs filters of enclave '%v'...", enclaveIdentifier)
+	if err := kurtosisBackend.UpdateLogsCollectorFiltersForEnclave(ctx, enclaveUuid, filters); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the logs filters of enclave '%v'", enclaveIdentifier)
+	}
+	if shouldClear {
+		logrus.Infof("Removed the logs filters of enclave '%v'", enclaveIdentifier)
+	} else {
+		logrus.Infof("Applied %d logs filters to enclave '%v'", len(filters), enclaveIdentifier)
+	}
+	return nil
+}
+
+func readFilters(filtersFilepath string) ([]logs_collector.Filter, error) {
+	filtersFileContent, err := os.ReadFile(filtersFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading file '%v'", filtersFilepath)
+	}
+	var filters []logs_collector.Filter
+	if err := yaml.Unmarshal(filtersFileContent, &filters); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the filters in file '%v'", filtersFilepath)
+	}
+	if err := logs_collector.ValidateEnclaveFilters(filters); err != nil {
+		return nil, stacktrace.Propagate(err, "The filters in file '%v' are invalid", filtersFilepath)
+	}
+	return filters, nil
+}
+
+// resolveFiltersServices replaces the service identifiers the filters implemented by Kurtosis are restricted to with
+// the UUIDs of the services, which is what the logs collector sees
+func resolveFiltersServices(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, filters []logs_collector.Filter) error {
+	for _, filter := range filters {
+		if filter.Name != logs_collector.SampleFilterName && filter.Name != logs_collector.DedupeFilterName {
+			continue
+		}
+		for paramIdx, param := range filter.Params {
+			if param.Key != logs_collector.ServiceParamKey {
+				continue
+			}
+			enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+			}
+			serviceCtx, err := enclaveCtx.GetServiceContext(param.Value)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting service '%v' that filter '%v' is restricted to", param.Value, filter.Name)
+			}
+			filter.Params[paramIdx].Value = string(serviceCtx.GetServiceUUID())
+		}
+	}
+	return nil
+}
@@ -13,12 +13,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
-	"sort"
 	"strings"
 	"time"
 )
@@ -28,6 +29,7 @@ const (
 	enclaveStatusColumnHeader       = "Status"
 	enclaveNameColumnHeader         = "Name"
 	enclaveCreationTimeColumnHeader = "Creation Time"
+	enclaveClusterColumnHeader      = "Cluster"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -35,13 +37,33 @@ const (
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
+	statusFlagKey     = "status"
+	statusFlagDefault = ""
+	statusesSeparator = ","
+
+	limitFlagKey     = "limit"
+	limitFlagDefault = "0"
+
+	pageTokenFlagKey     = "page-token"
+	pageTokenFlagDefault = ""
+
 	emptyTimeForOldEnclaves = ""
 )
 
+// enclaveOutput is how an enclave is printed with the output format flag; UUIDs are always full
+type enclaveOutput struct {
+	Uuid          string `json:"uuid" yaml:"uuid"`
+	ShortenedUuid string `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name          string `json:"name" yaml:"name"`
+	Status        string `json:"status" yaml:"status"`
+	CreationTime  string `json:"creation_time,omitempty" yaml:"creation_time,omitempty"`
+	Cluster       string `json:"cluster,omitempty" yaml:"cluster,omitempty"`
+}
+
 var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveLsCmdStr,
 	ShortDescription:          "Lists enclaves",
-	LongDescription:           "Lists the enclaves running in the Kurtosis engine",
+	LongDescription:           "Lists the enclaves running in the Kurtosis engine; pass '--" + defaults.OutputFormatFlagKey + " json' or '--" + defaults.OutputFormatFlagKey + " yaml' to get them in a machine-readable format",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -51,6 +73,24 @@ var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		{
+			Key:     statusFlagKey,
+			Usage:   "Only lists the enclaves in these statuses, separated by commas, e.g. 'running,stopped'. Lists enclaves in any status if empty.",
+			Type:    flags.FlagType_String,
+			Default: statusFlagDefault,
+		},
+		{
+			Key:     limitFlagKey,
+			Usage:   "The maximum number of enclaves to list, the oldest first; lists them all if 0. The command prints how to list the next ones if there are more.",
+			Type:    flags.FlagType_Uint32,
+			Default: limitFlagDefault,
+		},
+		{
+			Key:     pageTokenFlagKey,
+			Usage:   "Lists the enclaves after the ones a previous call with '--" + limitFlagKey + "' listed, using the page token that call printed.",
+			Type:    flags.FlagType_String,
+			Default: pageTokenFlagDefault,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -69,7 +109,26 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
-	enclaves, err := kurtosisCtx.GetEnclaves(ctx)
+	statusesStr, err := flags.GetString(statusFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", statusFlagKey)
+	}
+	statuses, err := parseEnclaveStatuses(statusesStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", statusFlagKey)
+	}
+
+	limit, err := flags.GetUint32(limitFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", limitFlagKey)
+	}
+
+	pageToken, err := flags.GetString(pageTokenFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", pageTokenFlagKey)
+	}
+
+	enclaveInfos, nextPageToken, err := kurtosisCtx.ListEnclaves(ctx, statuses, limit, pageToken)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting enclaves")
 	}
@@ -79,31 +138,37 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
-	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader)
-	orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap := getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(enclaves.GetEnclavesByUuid())
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
 
-	//TODO remove this iteration after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
-	//This is for retro-compatibility, for those old enclave did not track enclave's creation time
-	for _, enclaveInfo := range enclaveWithoutCreationTimeInfoMap {
-		enclaveUuid := enclaveInfo.GetEnclaveUuid()
-		uuidToPrint := enclaveInfo.GetShortenedUuid()
-		if showFullUuids {
-			uuidToPrint = enclaveUuid
-		}
-
-		enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusStringifier(enclaveInfo.GetContainersStatus())
-		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
+	if outputFormat.IsStructured() {
+		if err = printEnclavesStructured(enclaveInfos, outputFormat); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the enclaves as '%v'", outputFormat)
 		}
+		printNextPageHint(nextPageToken)
+		return nil
+	}
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves); err != nil {
-			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
+	// The cluster column is only worth showing when the engine spans several clusters
+	shouldShowCluster := false
+	for _, enclaveInfo := range enclaveInfos {
+		if enclaveInfo.GetClusterName() != "" {
+			shouldShowCluster = true
 		}
 	}
-	//Retro-compatibility ends
-
-	for _, enclaveInfo := range orderedEnclaveInfoMaps {
-
+	columnHeaders := []string{enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader}
+	if shouldShowCluster {
+		columnHeaders = append(columnHeaders, enclaveClusterColumnHeader)
+	}
+	tablePrinter := output_printers.NewTablePrinter(columnHeaders...)
+	// The engine returns the oldest enclaves first
+	for _, enclaveInfo := range enclaveInfos {
 		enclaveUuid := enclaveInfo.GetEnclaveUuid()
 		uuidToPrint := enclaveInfo.GetShortenedUuid()
 		if showFullUuids {
@@ -115,60 +180,74 @@ func run(
 			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
 		}
 
-		// The extra space is a hack till we figure out the table printer color + formatting story
-		enclaveCreationTime := " " + enclaveInfo.CreationTime.AsTime().Local().Format(time.RFC1123)
-
-		enclaveName := enclaveInfo.GetName()
+		//TODO remove this condition after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
+		//This is for retro-compatibility, for those old enclave did not track enclave's creation time
+		enclaveCreationTime := emptyTimeForOldEnclaves
+		if enclaveInfo.GetCreationTime() != nil {
+			// The extra space is a hack till we figure out the table printer color + formatting story
+			enclaveCreationTime = " " + enclaveInfo.GetCreationTime().AsTime().Local().Format(time.RFC1123)
+		}
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime); err != nil {
+		row := []string{uuidToPrint, enclaveInfo.GetName(), enclaveStatus, enclaveCreationTime}
+		if shouldShowCluster {
+			row = append(row, enclaveInfo.GetClusterName())
+		}
+		if err := tablePrinter.AddRow(row...); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
 
 	tablePrinter.Print()
+	printNextPageHint(nextPageToken)
 
 	return nil
 }
 
-func getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(
-	enclaveInfoMap map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
-) (
-	[]*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
-	map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
-) {
-
-	orderedEnclaveInfoMaps := []*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
-
-	enclaveWithoutCreationTimeInfoMap := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
-
-	for enclaveIdStr, enclaveInfo := range enclaveInfoMap {
-		//TODO remove this condition after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
-		//This is for retro-compatibility, for those old enclave did not track enclave's creation time
-		if enclaveInfo.GetCreationTime() == nil {
-			enclaveWithoutCreationTimeInfoMap[enclaveIdStr] = enclaveInfo
-			continue
+func printEnclavesStructured(enclaveInfos []*kurtosis_engine_rpc_api_bindings.EnclaveInfo, outputFormat output_printers.OutputFormat) error {
+	enclavesOutput := []enclaveOutput{}
+	for _, enclaveInfo := range enclaveInfos {
+		enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusPlainStringifier(enclaveInfo.GetContainersStatus())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
+		}
+		creationTime := ""
+		if enclaveInfo.GetCreationTime() != nil {
+			creationTime = enclaveInfo.GetCreationTime().AsTime().UTC().Format(time.RFC3339)
 		}
-		orderedEnclaveInfoMaps = append(orderedEnclaveInfoMaps, enclaveInfo)
+		enclavesOutput = append(enclavesOutput, enclaveOutput{
+			Uuid:          enclaveInfo.GetEnclaveUuid(),
+			ShortenedUuid: enclaveInfo.GetShortenedUuid(),
+			Name:          enclaveInfo.GetName(),
+			Status:        enclaveStatus,
+			CreationTime:  creationTime,
+			Cluster:       enclaveInfo.GetClusterName(),
+		})
 	}
+	if err := output_printers.PrintStructuredOutput(outputFormat, enclavesOutput); err != nil {
+		return stacktrace.Propagate(err, "An error occurred printing the enclaves")
+	}
+	return nil
+}
 
-	sort.Slice(orderedEnclaveInfoMaps, func(firstItemIndex, secondItemIndex int) bool {
-
-		firstItemEnclaveCreationTime := orderedEnclaveInfoMaps[firstItemIndex].CreationTime.AsTime()
-		secondItemEnclaveCreationTime := orderedEnclaveInfoMaps[secondItemIndex].CreationTime.AsTime()
-
-		//First order by creation time
-		if firstItemEnclaveCreationTime.Before(secondItemEnclaveCreationTime) {
-			return true
-		} else if firstItemEnclaveCreationTime.After(secondItemEnclaveCreationTime) {
-			return false
+func parseEnclaveStatuses(statusesStr string) ([]kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus, error) {
+	statuses := []kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus{}
+	if statusesStr == "" {
+		return statuses, nil
+	}
+	for _, statusStr := range strings.Split(statusesStr, statusesSeparator) {
+		status, err := enclave_status_stringifier.ParseEnclaveContainersStatus(strings.TrimSpace(statusStr))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave status '%v'", statusStr)
 		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
 
-		//If creation time is equal order by enclave name
-		firstItemEnclaveNameStr := orderedEnclaveInfoMaps[firstItemIndex].GetName()
-		secondItemEnclaveNameStr := orderedEnclaveInfoMaps[secondItemIndex].GetName()
-
-		return strings.Compare(firstItemEnclaveNameStr, secondItemEnclaveNameStr) <= 0
-	})
-
-	return orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap
+// printNextPageHint goes to stderr so that the structured output stays parseable
+func printNextPageHint(nextPageToken string) {
+	if nextPageToken == "" {
+		return
+	}
+	out.PrintErrLn("There are more enclaves; pass '--" + pageTokenFlagKey + " " + nextPageToken + "' to list the next ones")
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_destroyer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -89,9 +90,13 @@ func run(
 
 	logrus.Info("Destroying enclaves...")
 
+	destructionErrors := enclave_destroyer.DestroyEnclavesInParallel(enclaveIdsToDestroy, func(enclaveId string) error {
+		return destroyEnclave(ctx, kurtosisCtx, enclaveId, shouldForceRemove)
+	})
+
 	enclaveDestructionErrorStrs := []string{}
 	for _, enclaveId := range enclaveIdsToDestroy {
-		if err := destroyEnclave(ctx, kurtosisCtx, enclaveId, shouldForceRemove); err != nil {
+		if err, found := destructionErrors[enclaveId]; found {
 			enclaveDestructionErrorStrs = append(
 				enclaveDestructionErrorStrs,
 				fmt.Sprintf(
@@ -0,0 +1,114 @@
+package share
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	principalArgKey        = "principal"
+	isPrincipalArgOptional = false
+	isPrincipalArgGreedy   = false
+	principalArgDefault    = ""
+
+	shouldRevokeFlagKey = "revoke"
+	defaultShouldRevoke = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	granteesSeparator = ", "
+	noGrantees        = "nobody else"
+)
+
+var EnclaveShareCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveShareCmdStr,
+	ShortDescription: "Shares an enclave with another principal",
+	LongDescription: "Lets another principal inspect, run against and remove an enclave, or stops letting it with --revoke. " +
+		"Principals are named after the token they authenticate with, 'token:<name>' for static tokens and 'oidc:<subject>' for OIDC tokens. " +
+		"Only the owner of the enclave, the principal that created it, and admins can share it; it has no effect if the engine doesn't require authentication.",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     shouldRevokeFlagKey,
+			Usage:   "Stops sharing the enclave with the principal instead",
+			Type:    flags.FlagType_Bool,
+			Default: defaultShouldRevoke,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:                   principalArgKey,
+			IsOptional:            isPrincipalArgOptional,
+			DefaultValue:          principalArgDefault,
+			IsGreedy:              isPrincipalArgGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	principal, err := args.GetNonGreedyArg(principalArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the principal using key '%v'", principalArgKey)
+	}
+
+	shouldRevoke, err := flags.GetBool(shouldRevokeFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", shouldRevokeFlagKey)
+	}
+
+	shareArgs := &kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		Principal:         principal,
+		Revoke:            &shouldRevoke,
+	}
+	response, err := engineClient.ShareEnclave(ctx, shareArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sharing enclave '%v' with '%v'", enclaveIdentifier, principal)
+	}
+
+	grantees := noGrantees
+	if len(response.GetGrantees()) > 0 {
+		grantees = strings.Join(response.GetGrantees(), granteesSeparator)
+	}
+	out.PrintOutLn(fmt.Sprintf("Enclave '%v' is owned by '%v' and shared with %v", enclaveIdentifier, response.GetOwner(), grantees))
+	return nil
+}
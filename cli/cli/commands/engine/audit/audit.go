@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	sinceFlagKey     = "since"
+	defaultSince     = "24h"
+	principalFlagKey = "principal"
+	defaultPrincipal = ""
+	limitFlagKey     = "limit"
+	defaultLimit     = "100"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	timestampColumnHeader  = "Timestamp"
+	principalColumnHeader  = "Principal"
+	apiColumnHeader        = "API"
+	operationColumnHeader  = "Operation"
+	resultColumnHeader     = "Result"
+	argsDigestColumnHeader = "Args Digest"
+)
+
+var EngineAuditCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EngineAuditCmdStr,
+	ShortDescription: "Shows the audit log of the engine",
+	LongDescription: "Lists the calls to the engine APIs that changed the engine or its enclaves: who made them, when, " +
+		"the digest of their arguments and their result. Only admins can read the audit log of an engine that requires authentication.",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     sinceFlagKey,
+			Usage:   "Only shows the calls made within this duration, e.g. '1h' or '168h'; blank shows them all",
+			Type:    flags.FlagType_String,
+			Default: defaultSince,
+		},
+		{
+			Key:     principalFlagKey,
+			Usage:   "Only shows the calls made by this principal, e.g. 'token:ci'",
+			Type:    flags.FlagType_String,
+			Default: defaultPrincipal,
+		},
+		{
+			Key:     limitFlagKey,
+			Usage:   "Only shows the latest calls, up to this number; 0 shows them all",
+			Type:    flags.FlagType_Uint32,
+			Default: defaultLimit,
+		},
+	},
+	Args:    nil,
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	sinceStr, err := flags.GetString(sinceFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", sinceFlagKey)
+	}
+	principal, err := flags.GetString(principalFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", principalFlagKey)
+	}
+	limit, err := flags.GetUint32(limitFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", limitFlagKey)
+	}
+
+	getAuditLogArgs := &kurtosis_engine_rpc_api_bindings.GetAuditLogArgs{
+		Since:     nil,
+		Principal: nil,
+		Limit:     &limit,
+	}
+	if sinceStr != "" {
+		since, err := time.ParseDuration(sinceStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing '%v' into a duration, e.g. '24h'", sinceStr)
+		}
+		getAuditLogArgs.Since = timestamppb.New(time.Now().Add(-since))
+	}
+	if principal != "" {
+		getAuditLogArgs.Principal = &principal
+	}
+	response, err := engineClient.GetAuditLog(ctx, getAuditLogArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the audit log of the engine")
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(timestampColumnHeader, principalColumnHeader, apiColumnHeader, operationColumnHeader, resultColumnHeader, argsDigestColumnHeader)
+	for _, entry := range response.GetEntries() {
+		timestamp := entry.GetTimestamp().AsTime().Local().Format(time.RFC3339)
+		if err := tablePrinter.AddRow(timestamp, entry.GetPrincipal(), entry.GetApi(), entry.GetOperation(), entry.GetResult(), entry.GetArgsDigest()); err != nil {
+			return stacktrace.NewError("An error occurred adding row for the call to '%v' to the table printer", entry.GetOperation())
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
@@ -2,11 +2,16 @@ package engine
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/audit"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/login"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/logout"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/logs"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/reload"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/restart"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/start"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/status"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/stop"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/system_logs"
 	"github.com/spf13/cobra"
 )
 
@@ -23,5 +28,10 @@ func init() {
 	EngineCmd.AddCommand(status.StatusCmd)
 	EngineCmd.AddCommand(stop.StopCmd)
 	EngineCmd.AddCommand(restart.RestartCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(reload.ReloadCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(audit.EngineAuditCmd.MustGetCobraCommand())
 	EngineCmd.AddCommand(logs.EngineLogsCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(login.EngineLoginCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(logout.EngineLogoutCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(system_logs.EngineSystemLogsCmd.MustGetCobraCommand())
 }
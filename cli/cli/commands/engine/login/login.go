@@ -0,0 +1,59 @@
+package login
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_login"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+var EngineLoginCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.EngineLoginCmdStr,
+	ShortDescription: "Logs in to the engine with the OpenID Connect provider of the cluster",
+	LongDescription: "Logs in to the OpenID Connect provider configured in the 'engine-auth' (or else 'enclave-manager-auth') " +
+		"section of the cluster with the device flow: the command prints a link to confirm the login in a browser, then " +
+		"keeps the ID token and refreshes it as needed. The CLI sends it to the engine and the enclave manager of the " +
+		"cluster, which grant the scopes of the token and of the groups of the user.",
+	Args:                     nil,
+	Flags:                    nil,
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(ctx context.Context, _ *flags.ParsedFlags, _ *args.ParsedArgs) error {
+	clusterName, err := kurtosis_config_getter.GetKurtosisClusterName()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the name of the current cluster")
+	}
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the config of cluster '%v'", clusterName)
+	}
+	oidcConfig := clusterConfig.GetEngineAuthConfig().Oidc
+	if oidcConfig == nil {
+		oidcConfig = clusterConfig.GetEnclaveManagerAuthConfig().Oidc
+	}
+	if oidcConfig == nil {
+		return stacktrace.NewError("Cluster '%v' has no OpenID Connect provider in its 'engine-auth' or 'enclave-manager-auth' config to log in with", clusterName)
+	}
+
+	login, err := engine_login.RunDeviceFlow(ctx, oidcConfig.IssuerUrl, oidcConfig.Audience, func(verificationUri string, userCode string) {
+		out.PrintOutLn(fmt.Sprintf("Open %v in a browser and confirm code %v to log in", verificationUri, userCode))
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred logging in with OpenID Connect provider '%v'", oidcConfig.IssuerUrl)
+	}
+	if err = engine_login.SaveEngineLogin(clusterName, login); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the login for cluster '%v'", clusterName)
+	}
+	out.PrintOutLn(fmt.Sprintf("Logged in to cluster '%v' as '%v'", clusterName, login.Username))
+	return nil
+}
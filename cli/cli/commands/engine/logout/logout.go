@@ -0,0 +1,43 @@
+package logout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_login"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+var EngineLogoutCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:               command_str_consts.EngineLogoutCmdStr,
+	ShortDescription:         "Logs out of the engine of the cluster",
+	LongDescription:          "Forgets the tokens 'kurtosis engine login' got for the current cluster. The session at the OpenID Connect provider is left alone.",
+	Args:                     nil,
+	Flags:                    nil,
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, _ *flags.ParsedFlags, _ *args.ParsedArgs) error {
+	clusterName, err := kurtosis_config_getter.GetKurtosisClusterName()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the name of the current cluster")
+	}
+	wasLoggedIn, err := engine_login.RemoveEngineLogin(clusterName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the login for cluster '%v'", clusterName)
+	}
+	if !wasLoggedIn {
+		out.PrintOutLn(fmt.Sprintf("Nobody is logged in to cluster '%v'", clusterName))
+		return nil
+	}
+	out.PrintOutLn(fmt.Sprintf("Logged out of cluster '%v'", clusterName))
+	return nil
+}
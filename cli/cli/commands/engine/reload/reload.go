@@ -0,0 +1,65 @@
+package reload
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	logRetentionPeriodFlagKey = "log-retention-period"
+)
+
+var ReloadCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.EngineReloadCmdStr,
+	ShortDescription: "Reload the Kurtosis engine config",
+	LongDescription: "Applies the logs aggregator sinks, engine auth tokens, enclave quota and default enclave TTL of the Kurtosis config, " +
+		"and the log retention period, to the running engine without restarting it, so that active log streams and port forwards are kept. " +
+		"Enabling or disabling engine auth still requires restarting the engine",
+	Args: nil,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       logRetentionPeriodFlagKey,
+			Usage:     "The length of time that Kurtosis should keep logs for. Eg. if set to 168h, Kurtosis will remove all logs beyond 1 week. You can specify hours using 'h' however Kurtosis currently only supports setting retention on a weekly basis.",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaults.DefaultLogRetentionPeriod,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error {
+	ctx := context.Background()
+
+	logRetentionPeriodStr, err := flags.GetString(logRetentionPeriodFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the log retention period string from flag: '%v'", logRetentionPeriodFlagKey)
+	}
+	_, err = time.ParseDuration(logRetentionPeriodStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing provided log retention period '%v' into a duration. Ensure the provided value has the proper format of hours using 'h'.", logRetentionPeriodStr)
+	}
+
+	engineManager, err := engine_manager.NewEngineManager(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating an engine manager.")
+	}
+
+	logrus.Infof("Reloading Kurtosis engine config...")
+	if err := engineManager.ReloadEngineConfig(ctx, logRetentionPeriodStr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reloading the Kurtosis engine config")
+	}
+	logrus.Infof("Engine config reloaded successfully")
+	return nil
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/common"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
@@ -66,7 +65,7 @@ var RestartCmd = &lowlevel.LowlevelKurtosisCommand{
 		{
 			Key: enclavePoolSizeFlagKey,
 			Usage: fmt.Sprintf(
-				"The enclave pool size, the default value is '%v' which means it will be disabled. CAUTION: This is only available for Kubernetes, and this command will fail if you want to use it for Docker.",
+				"The number of idle enclaves the engine keeps ready, so that creating an enclave is near-instant. The default value is '%v', which falls back to the 'enclave-pool-size' of the cluster config. On Docker, where enclaves can't be renamed, the engine only pre-pulls the API container image.",
 				defaults.DefaultEngineEnclavePoolSize,
 			),
 			Shorthand: "",
@@ -115,10 +114,6 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 		return stacktrace.Propagate(err, "Expected a integer flag with key '%v' but none was found; this is an error in Kurtosis!", enclavePoolSizeFlagKey)
 	}
 
-	if err := common.ValidateEnclavePoolSizeFlag(enclavePoolSize); err != nil {
-		return stacktrace.Propagate(err, "An error occurred validating the '%v' flag", enclavePoolSizeFlagKey)
-	}
-
 	logrus.Infof("Restarting Kurtosis engine...")
 
 	logLevelStr, err := flags.GetString(logLevelFlagKey)
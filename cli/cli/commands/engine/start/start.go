@@ -2,6 +2,7 @@ package start
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -11,10 +12,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/common"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -36,6 +37,11 @@ const (
 
 	domainFlagKey = "domain"
 	defaultDomain = ""
+
+	dryRunFlagKey = "dry-run"
+	defaultDryRun = "false"
+
+	planJsonIndent = "  "
 )
 
 var StartCmd = &lowlevel.LowlevelKurtosisCommand{
@@ -67,7 +73,7 @@ var StartCmd = &lowlevel.LowlevelKurtosisCommand{
 		{
 			Key: enclavePoolSizeFlagKey,
 			Usage: fmt.Sprintf(
-				"The enclave pool size, the default value is '%v' which means it will be disabled. CAUTION: This is only available for Kubernetes, and this command will fail if you want to use it for Docker.",
+				"The number of idle enclaves the engine keeps ready, so that creating an enclave is near-instant. The default value is '%v', which falls back to the 'enclave-pool-size' of the cluster config. On Docker, where enclaves can't be renamed, the engine only pre-pulls the API container image.",
 				defaults.DefaultEngineEnclavePoolSize,
 			),
 			Shorthand: "",
@@ -102,6 +108,13 @@ var StartCmd = &lowlevel.LowlevelKurtosisCommand{
 			Type:      flags.FlagType_String,
 			Default:   defaults.DefaultLogRetentionPeriod,
 		},
+		{
+			Key:       dryRunFlagKey,
+			Usage:     "If set, prints the operations starting the engine would perform on the cluster as JSON, without performing them",
+			Shorthand: "",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultDryRun,
+		},
 	},
 	PreValidationAndRunFunc:  nil,
 	RunFunc:                  run,
@@ -116,10 +129,6 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 		return stacktrace.Propagate(err, "Expected a integer flag with key '%v' but none was found; this is an error in Kurtosis!", enclavePoolSizeFlagKey)
 	}
 
-	if err := common.ValidateEnclavePoolSizeFlag(enclavePoolSize); err != nil {
-		return stacktrace.Propagate(err, "An error occurred validating the '%v' flag", enclavePoolSizeFlagKey)
-	}
-
 	logLevelStr, err := flags.GetString(logLevelFlagKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while getting the Kurtosis engine log level using flag with key '%v'; this is a bug in Kurtosis", logLevelFlagKey)
@@ -172,6 +181,19 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 		return stacktrace.Propagate(err, "An error occurred parsing provided log retention period '%v' into a duration. Ensure the provided value has the proper format of hours using 'h'.", logRetentionPeriodStr)
 	}
 
+	shouldDryRun, err := flags.GetBool(dryRunFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", dryRunFlagKey)
+	}
+	if shouldDryRun {
+		shouldStartInDebugMode := defaults.DefaultEnableDebugMode
+		if engineVersion == defaultEngineVersion && isDebugMode {
+			engineVersion = fmt.Sprintf("%s-%s", kurtosis_version.KurtosisVersion, defaults.DefaultKurtosisContainerDebugImageNameSuffix)
+			shouldStartInDebugMode = true
+		}
+		return printEngineStartPlan(ctx, engineManager, engineVersion, logLevel, enclavePoolSize, shouldStartInDebugMode, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr)
+	}
+
 	if engineVersion == defaultEngineVersion && isDebugMode {
 		engineDebugVersion := fmt.Sprintf("%s-%s", kurtosis_version.KurtosisVersion, defaults.DefaultKurtosisContainerDebugImageNameSuffix)
 		logrus.Infof("Starting Kurtosis engine in debug mode from image '%v%v%v'...", kurtosisTechEngineImagePrefix, imageVersionDelimiter, engineDebugVersion)
@@ -196,3 +218,27 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 
 	return nil
 }
+
+func printEngineStartPlan(
+	ctx context.Context,
+	engineManager *engine_manager.EngineManager,
+	engineVersion string,
+	logLevel logrus.Level,
+	enclavePoolSize uint8,
+	shouldStartInDebugMode bool,
+	githubAuthTokenOverride string,
+	shouldRestartAPIContainers bool,
+	domain string,
+	logRetentionPeriodStr string,
+) error {
+	plan, err := engineManager.PlanEngineStart(ctx, engineVersion, logLevel, enclavePoolSize, shouldStartInDebugMode, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr, defaults.DefaultLogsSinks)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred planning the start of the Kurtosis engine")
+	}
+	serializedPlan, err := json.MarshalIndent(plan, "", planJsonIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the plan of the start of the Kurtosis engine")
+	}
+	out.PrintOutLn(string(serializedPlan))
+	return nil
+}
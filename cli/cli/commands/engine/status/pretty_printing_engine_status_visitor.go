@@ -2,23 +2,38 @@ package status
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
 )
 
 const (
 	engineVersionInfoLabel = "Version"
+	engineHealthInfoLabel  = "Health"
+
+	subsystemColumnHeader = "Subsystem"
+	instanceColumnHeader  = "Instance"
+	statusColumnHeader    = "Status"
+	messageColumnHeader   = "Message"
+
+	subsystemHealthStatusPrefix = "SubsystemHealthStatus_"
 )
 
 // Pretty printer of engine status that will compile-break any time a new engine status is added
 type prettyPrintingEngineStatusVisitor struct {
 	// Will only be filled in if the engine is running
 	maybeApiVersion string
+
+	// Will only be filled in if the engine is running and the detailed status was requested
+	maybeEngineHealth *kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse
 }
 
-func newPrettyPrintingEngineStatusVisitor(maybeApiVersion string) *prettyPrintingEngineStatusVisitor {
-	return &prettyPrintingEngineStatusVisitor{maybeApiVersion: maybeApiVersion}
+func newPrettyPrintingEngineStatusVisitor(maybeApiVersion string, maybeEngineHealth *kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse) *prettyPrintingEngineStatusVisitor {
+	return &prettyPrintingEngineStatusVisitor{maybeApiVersion: maybeApiVersion, maybeEngineHealth: maybeEngineHealth}
 }
 
 func (p *prettyPrintingEngineStatusVisitor) VisitStopped() error {
@@ -36,8 +51,28 @@ func (p *prettyPrintingEngineStatusVisitor) VisitContainerRunningButServerNotRes
 func (p *prettyPrintingEngineStatusVisitor) VisitRunning() error {
 	keyValuePrinter := output_printers.NewKeyValuePrinter()
 	keyValuePrinter.AddPair(engineVersionInfoLabel, p.maybeApiVersion)
+	if p.maybeEngineHealth != nil {
+		keyValuePrinter.AddPair(engineHealthInfoLabel, getHealthStatusStr(p.maybeEngineHealth.GetStatus()))
+	}
 
 	out.PrintOutLn("A Kurtosis engine is running with the following info:")
 	keyValuePrinter.Print()
+	if p.maybeEngineHealth == nil {
+		return nil
+	}
+
+	out.PrintOutLn("")
+	tablePrinter := output_printers.NewTablePrinter(subsystemColumnHeader, instanceColumnHeader, statusColumnHeader, messageColumnHeader)
+	for _, subsystemHealth := range p.maybeEngineHealth.GetSubsystems() {
+		if err := tablePrinter.AddRow(subsystemHealth.GetName(), subsystemHealth.GetInstance(), getHealthStatusStr(subsystemHealth.GetStatus()), subsystemHealth.GetMessage()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding row for subsystem '%v' to the table printer", subsystemHealth.GetName())
+		}
+	}
+	tablePrinter.Print()
 	return nil
 }
+
+// getHealthStatusStr turns e.g. SubsystemHealthStatus_DEGRADED into 'degraded'
+func getHealthStatusStr(status kurtosis_engine_rpc_api_bindings.SubsystemHealthStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), subsystemHealthStatusPrefix))
+}
@@ -2,12 +2,19 @@ package status
 
 import (
 	"context"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/spf13/cobra"
 )
 
+const (
+	detailedFlagKey = "detailed"
+	defaultDetailed = false
+)
+
 // StatusCmd Suppressing exhaustruct requirement because this struct has ~40 properties
 // nolint: exhaustruct
 var StatusCmd = &cobra.Command{
@@ -16,9 +23,18 @@ var StatusCmd = &cobra.Command{
 	RunE:  run,
 }
 
+func init() {
+	StatusCmd.Flags().Bool(detailedFlagKey, defaultDetailed, "Also reports the health of the subsystems the engine relies on, e.g. its logs aggregator, logs collectors and artifacts store")
+}
+
 func run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	isDetailed, err := cmd.Flags().GetBool(detailedFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", detailedFlagKey)
+	}
+
 	engineManager, err := engine_manager.NewEngineManager(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating an engine manager")
@@ -28,7 +44,14 @@ func run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis engine status")
 	}
-	prettyPrintingStatusVisitor := newPrettyPrintingEngineStatusVisitor(maybeApiVersion)
+	var maybeEngineHealth *kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse
+	if isDetailed && status == engine_manager.EngineStatus_Running {
+		maybeEngineHealth, err = engineManager.GetEngineHealth(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the health of the Kurtosis engine")
+		}
+	}
+	prettyPrintingStatusVisitor := newPrettyPrintingEngineStatusVisitor(maybeApiVersion, maybeEngineHealth)
 	if err := status.Accept(prettyPrintingStatusVisitor); err != nil {
 		return stacktrace.Propagate(err, "An error occurred printing the engine status")
 	}
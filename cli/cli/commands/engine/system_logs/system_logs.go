@@ -0,0 +1,172 @@
+package system_logs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	nodeNameArgKey        = "node"
+	isNodeNameArgOptional = false
+	isNodeNameArgGreedy   = true
+
+	shouldFollowLogsFlagKey = "follow"
+	returnNumLogsFlagKey    = "num"
+	returnAllLogsFlagKey    = "all"
+	matchTextFilterFlagKey  = "match"
+
+	defaultMatchTextFilterFlagValue = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	interruptChanBufferSize = 5
+
+	defaultNumLogLines = 200
+)
+
+var defaultShouldFollowLogs = strconv.FormatBool(false)
+var defaultShouldReturnAllLogs = strconv.FormatBool(false)
+var defaultNumLogLinesFlagValue = strconv.Itoa(defaultNumLogLines)
+
+var EngineSystemLogsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EngineSystemLogsCmdStr,
+	ShortDescription: "Get the system logs of Kubernetes nodes",
+	LongDescription: "Show the logs the host services of Kubernetes nodes (e.g. the kubelet and the container runtime) wrote " +
+		"to their journal, which the logs collectors collect when the system logs are enabled in the cluster config",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       shouldFollowLogsFlagKey,
+			Usage:     "Continues to follow the logs until stopped",
+			Shorthand: "f",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultShouldFollowLogs,
+		},
+		{
+			Key:       returnAllLogsFlagKey,
+			Usage:     "Gets all logs.",
+			Shorthand: "a",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultShouldReturnAllLogs,
+		},
+		{
+			Key:       returnNumLogsFlagKey,
+			Usage:     "Get the last X log lines.",
+			Shorthand: "n",
+			Type:      flags.FlagType_Uint32,
+			Default:   defaultNumLogLinesFlagValue,
+		},
+		{
+			Key:     matchTextFilterFlagKey,
+			Usage:   "Filter the log lines returning only those containing this match.",
+			Default: defaultMatchTextFilterFlagValue,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key:          nodeNameArgKey,
+			IsOptional:   isNodeNameArgOptional,
+			DefaultValue: nil,
+			IsGreedy:     isNodeNameArgGreedy,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	nodeNames, err := args.GetGreedyArg(nodeNameArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the node names using arg key '%v'", nodeNameArgKey)
+	}
+
+	shouldFollowLogs, err := flags.GetBool(shouldFollowLogsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the should-follow-logs flag using key '%v'", shouldFollowLogsFlagKey)
+	}
+
+	shouldReturnAllLogs, err := flags.GetBool(returnAllLogsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the 'all' flag using key '%v'", returnAllLogsFlagKey)
+	}
+
+	numLogLines, err := flags.GetUint32(returnNumLogsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the 'num' flag using key '%v'", returnNumLogsFlagKey)
+	}
+
+	matchTextStr, err := flags.GetString(matchTextFilterFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the match flag using key '%v'", matchTextFilterFlagKey)
+	}
+
+	var logLineFilter *kurtosis_context.LogLineFilter
+	if matchTextStr != defaultMatchTextFilterFlagValue {
+		logLineFilter = kurtosis_context.NewDoesContainTextLogLineFilter(matchTextStr)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	// the system logs of the nodes are stored like the logs of services, under the names of the nodes
+	nodeNamesSet := map[services.ServiceUUID]bool{}
+	for _, nodeName := range nodeNames {
+		nodeNamesSet[services.ServiceUUID(nodeName)] = true
+	}
+
+	systemLogsStreamContentChan, cancelStreamSystemLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, logs_collector.SystemLogsStreamId, nodeNamesSet, shouldFollowLogs, shouldReturnAllLogs, numLogLines, logLineFilter)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the system logs of nodes '%+v' with follow logs value '%v'", nodeNames, shouldFollowLogs)
+	}
+	defer cancelStreamSystemLogsFunc()
+
+	// This channel will receive a signal when the user presses an interrupt
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+
+	for {
+		select {
+		case systemLogsStreamContent, isChanOpen := <-systemLogsStreamContentChan:
+			if !isChanOpen {
+				return nil
+			}
+
+			systemLogsByNodeName := systemLogsStreamContent.GetServiceLogsByServiceUuids()
+			for _, nodeName := range nodeNames {
+				for _, systemLog := range systemLogsByNodeName[services.ServiceUUID(nodeName)] {
+					out.PrintOutLn(fmt.Sprintf("[%v] %v", nodeName, systemLog.GetContent()))
+				}
+			}
+		case <-interruptChan:
+			logrus.Debugf("Received signal interruption in engine system logs Kurtosis CLI command")
+			return nil
+		}
+	}
+}
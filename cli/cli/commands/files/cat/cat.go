@@ -0,0 +1,160 @@
+package cat
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/artifact_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	artifactIdentifierArgKey        = "artifact-identifier"
+	isArtifactIdentifierArgOptional = false
+	isArtifactIdentifierArgGreedy   = false
+
+	filePathArgKey        = "file-path"
+	emptyFilePath         = ""
+	isFilePathArgOptional = false
+	isFilePathArgGreedy   = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	currentDirPrefix = "./"
+	pathSeparator    = "/"
+)
+
+var FilesCatCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.FilesCatCmdStr,
+	ShortDescription:          "Prints a file of a files artifact",
+	LongDescription:           "Prints the full content of a file of a files artifact to STDOUT, straight from the enclave filestore and without adding the artifact to any service or extracting it to disk",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		artifact_identifier_arg.NewArtifactIdentifierArg(
+			artifactIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isArtifactIdentifierArgOptional,
+			isArtifactIdentifierArgGreedy,
+		),
+		{
+			Key:                   filePathArgKey,
+			IsOptional:            isFilePathArgOptional,
+			IsGreedy:              isFilePathArgGreedy,
+			DefaultValue:          emptyFilePath,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave ID using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the artifact identifier using key '%v'", artifactIdentifierArgKey)
+	}
+
+	filePath, err := args.GetNonGreedyArg(filePathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the file path using key '%v'", filePathArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	// The artifact is downloaded fully before anything gets printed so that its integrity is verified first
+	filesArtifactBytes, err := enclaveCtx.DownloadFilesArtifact(ctx, artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v' from enclave '%v'", artifactIdentifier, enclaveIdentifier)
+	}
+
+	if err = writeFileFromTgz(bytes.NewReader(filesArtifactBytes), filePath, os.Stdout); err != nil {
+		return stacktrace.Propagate(err, "An error occurred printing file '%v' of files artifact '%v' from enclave '%v'", filePath, artifactIdentifier, enclaveIdentifier)
+	}
+	return nil
+}
+
+// writeFileFromTgz copies the content of the regular file at filePath inside the gzipped tarball to the output
+func writeFileFromTgz(tgzReader io.Reader, filePath string, output io.Writer) error {
+	gzipReader, err := gzip.NewReader(tgzReader)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the gzip reader for the files artifact")
+	}
+	defer gzipReader.Close()
+
+	pathToFind := normalizePath(filePath)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the next entry of the files artifact")
+		}
+		if normalizePath(header.Name) != pathToFind {
+			continue
+		}
+		if !header.FileInfo().Mode().IsRegular() {
+			return stacktrace.NewError("'%v' is not a regular file in the files artifact; use 'files ls' to list the content of directories", filePath)
+		}
+		if _, err = io.Copy(output, tarReader); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the content of file '%v'", filePath)
+		}
+		return nil
+	}
+	return stacktrace.NewError("No file '%v' was found in the files artifact", filePath)
+}
+
+// normalizePath makes paths from the artifact and from the user comparable, e.g. './dir/file' and 'dir/file' are the same
+func normalizePath(pathToNormalize string) string {
+	cleanedPath := path.Clean(strings.TrimPrefix(pathToNormalize, currentDirPrefix))
+	return strings.Trim(cleanedPath, pathSeparator)
+}
@@ -0,0 +1,51 @@
+package cat
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileFromTgz(t *testing.T) {
+	tgz := buildTgz(t)
+
+	output := &bytes.Buffer{}
+	require.NoError(t, writeFileFromTgz(bytes.NewReader(tgz), "config/app.yaml", output))
+	require.Equal(t, "port: 8080\n", output.String())
+
+	output.Reset()
+	require.NoError(t, writeFileFromTgz(bytes.NewReader(tgz), "./README.md", output))
+	require.Equal(t, "hello\n", output.String())
+}
+
+func TestWriteFileFromTgz_MissingFile(t *testing.T) {
+	err := writeFileFromTgz(bytes.NewReader(buildTgz(t)), "config/missing.yaml", &bytes.Buffer{})
+	require.Error(t, err)
+}
+
+func TestWriteFileFromTgz_Directory(t *testing.T) {
+	err := writeFileFromTgz(bytes.NewReader(buildTgz(t)), "config", &bytes.Buffer{})
+	require.Error(t, err)
+}
+
+func buildTgz(t *testing.T) []byte {
+	buffer := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "config/", Mode: 0o755}))
+	for name, content := range map[string]string{
+		"config/app.yaml": "port: 8080\n",
+		"README.md":       "hello\n",
+	} {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buffer.Bytes()
+}
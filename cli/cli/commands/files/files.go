@@ -2,8 +2,11 @@ package files
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/cat"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/download"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/history"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/ls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/rendertemplate"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/storeservice"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/storeweb"
@@ -26,5 +29,8 @@ func init() {
 	FilesCmd.AddCommand(storeservice.FilesStoreServiceCmd.MustGetCobraCommand())
 	FilesCmd.AddCommand(rendertemplate.RenderTemplateCommand.MustGetCobraCommand())
 	FilesCmd.AddCommand(inspect.FilesInspectCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(history.FilesHistoryCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(ls.FilesLsCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(cat.FilesCatCmd.MustGetCobraCommand())
 	FilesCmd.AddCommand(download.FilesUploadCmd.MustGetCobraCommand())
 }
@@ -0,0 +1,140 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/artifact_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	artifactIdentifierArgKey        = "artifact-identifier"
+	isArtifactIdentifierArgOptional = false
+	isArtifactIdentifierArgGreedy   = false
+
+	fullUuidsFlagKey       = "full-uuids"
+	fullUuidFlagKeyDefault = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	versionColumnHeader      = "Version"
+	uuidColumnHeader         = "UUID"
+	creationTimeColumnHeader = "Creation Time"
+	latestColumnHeader       = "Latest"
+
+	latestVersionMarker = "*"
+	notLatestMarker     = ""
+	// Versions stored before versions were tracked have no creation time
+	unknownCreationTime = "-"
+)
+
+var FilesHistoryCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.FilesHistoryCmdStr,
+	ShortDescription:          "Lists the versions of a files artifact",
+	LongDescription:           "Lists the versions of a files artifact, oldest first. Files artifacts get a new version every time their content is regenerated under the same name; any version can be used wherever a files artifact identifier is expected with '<identifier>@<version>', or '<identifier>@latest' for the latest one. Only the last versions are kept, the oldest ones are dropped automatically.",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     fullUuidsFlagKey,
+			Usage:   "If true then Kurtosis prints full UUIDs instead of shortened UUIDs. Default false.",
+			Type:    flags.FlagType_Bool,
+			Default: fullUuidFlagKeyDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		artifact_identifier_arg.NewArtifactIdentifierArg(
+			artifactIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isArtifactIdentifierArgOptional,
+			isArtifactIdentifierArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the artifact identifier using key '%v'", artifactIdentifierArgKey)
+	}
+
+	showFullUuids, err := flags.GetBool(fullUuidsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	history, err := enclaveCtx.GetFilesArtifactHistory(ctx, artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the history of files artifact '%v' in enclave '%v'", artifactIdentifier, enclaveIdentifier)
+	}
+
+	out.PrintOutLn(fmt.Sprintf("Versions of files artifact '%v':", history.GetFileName()))
+	tablePrinter := output_printers.NewTablePrinter(versionColumnHeader, uuidColumnHeader, creationTimeColumnHeader, latestColumnHeader)
+	for _, version := range history.GetVersions() {
+		uuidToPrint := version.GetFileUuid()
+		if !showFullUuids {
+			uuidToPrint = uuid_generator.ShortenedUUIDString(uuidToPrint)
+		}
+		creationTime := unknownCreationTime
+		if version.GetCreatedAt() != nil {
+			creationTime = version.GetCreatedAt().AsTime().Local().Format(time.RFC1123)
+		}
+		latestMarker := notLatestMarker
+		if version.GetIsLatest() {
+			latestMarker = latestVersionMarker
+		}
+		if err := tablePrinter.AddRow(fmt.Sprintf("%v", version.GetVersion()), uuidToPrint, creationTime, latestMarker); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding row for version '%v' of files artifact '%v' to the table printer", version.GetVersion(), history.GetFileName())
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
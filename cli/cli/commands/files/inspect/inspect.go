@@ -17,6 +17,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -46,6 +48,21 @@ const (
 	byteGroup             = 1024
 )
 
+// filesArtifactOutput is how the artifact is printed with the output format flag when no file path is given
+type filesArtifactOutput struct {
+	Name          string       `json:"name" yaml:"name"`
+	ContentSha256 string       `json:"content_sha256,omitempty" yaml:"content_sha256,omitempty"`
+	Files         []fileOutput `json:"files" yaml:"files"`
+}
+
+// fileOutput is how a file is printed with the output format flag; the text preview is only set when a file path is
+// given and the file is small enough to be previewed
+type fileOutput struct {
+	Path        string `json:"path" yaml:"path"`
+	Size        uint64 `json:"size" yaml:"size"`
+	TextPreview string `json:"text_preview,omitempty" yaml:"text_preview,omitempty"`
+}
+
 var sizeSuffix = []byte{'K', 'M', 'G', 'T', 'P'}
 
 var FilesInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -112,13 +129,32 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the file path")
 	}
 
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
 	filesInspectResponse, err := enclaveCtx.InspectFilesArtifact(ctx, services.FileArtifactName(artifactIdentifierName))
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred inspecting files from artifact identifier '%v', enclave '%v'", artifactIdentifierName, enclaveIdentifier)
 	}
 	fileDescriptions := filesInspectResponse.GetFileDescriptions()
 
+	if outputFormat.IsStructured() {
+		if err = printFilesArtifactStructured(artifactIdentifierName, filesInspectResponse, filePath, outputFormat); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing files artifact '%v' as '%v'", artifactIdentifierName, outputFormat)
+		}
+		return nil
+	}
+
 	if filePath == "" {
+		if contentSha256 := filesInspectResponse.GetContentSha256(); contentSha256 != "" {
+			out.PrintErrLn(fmt.Sprintf("Artifact '%v' SHA-256: %v", artifactIdentifierName, contentSha256))
+		}
 		out.PrintErrLn(fmt.Sprintf("Artifact '%v' contents:\n", artifactIdentifierName))
 		out.PrintOutLn(buildTree(fileDescriptions))
 		return nil
@@ -134,6 +170,49 @@ func run(
 	return nil
 }
 
+func printFilesArtifactStructured(
+	artifactIdentifierName string,
+	filesInspectResponse *kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse,
+	filePath string,
+	outputFormat output_printers.OutputFormat,
+) error {
+	if filePath == "" {
+		artifactOutput := filesArtifactOutput{
+			Name:          artifactIdentifierName,
+			ContentSha256: filesInspectResponse.GetContentSha256(),
+			Files:         []fileOutput{},
+		}
+		for _, fileDescription := range filesInspectResponse.GetFileDescriptions() {
+			artifactOutput.Files = append(artifactOutput.Files, fileOutput{
+				Path:        fileDescription.GetPath(),
+				Size:        fileDescription.GetSize(),
+				TextPreview: "",
+			})
+		}
+		if err := output_printers.PrintStructuredOutput(outputFormat, artifactOutput); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the contents of files artifact '%v'", artifactIdentifierName)
+		}
+		return nil
+	}
+
+	fileDescriptions := filesInspectResponse.GetFileDescriptions()
+	index := slices.IndexFunc(fileDescriptions, func(desc *kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription) bool {
+		return desc.GetPath() == filePath
+	})
+	if index == -1 {
+		return stacktrace.NewError("An error finding file '%v' on artifact identifier '%v'", filePath, artifactIdentifierName)
+	}
+	fileDescription := fileDescriptions[index]
+	if err := output_printers.PrintStructuredOutput(outputFormat, fileOutput{
+		Path:        fileDescription.GetPath(),
+		Size:        fileDescription.GetSize(),
+		TextPreview: fileDescription.GetTextPreview(),
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred printing file '%v' of files artifact '%v'", filePath, artifactIdentifierName)
+	}
+	return nil
+}
+
 // This structure helps assemble a file tree compatible with treeprint lib
 type treeMap struct {
 	internalMap map[string]*treeMap
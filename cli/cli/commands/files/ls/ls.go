@@ -0,0 +1,253 @@
+package ls
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/artifact_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	artifactIdentifierArgKey        = "artifact-identifier"
+	isArtifactIdentifierArgOptional = false
+	isArtifactIdentifierArgGreedy   = false
+
+	pathArgKey        = "path"
+	rootPath          = ""
+	isPathArgOptional = true
+	isPathArgGreedy   = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	nameColumnHeader = "Name"
+	sizeColumnHeader = "Size"
+
+	pathSeparator      = "/"
+	currentDirPrefix   = "./"
+	cleanedCurrentDir  = "."
+	directorySizeStr   = "-"
+	maxPathPartsToKeep = 2
+)
+
+var FilesLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.FilesLsCmdStr,
+	ShortDescription:          "Lists the contents of a files artifact",
+	LongDescription:           "Lists the files and directories at the given path of a files artifact (the root of the artifact if no path is given), straight from the enclave filestore and without adding the artifact to any service",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		artifact_identifier_arg.NewArtifactIdentifierArg(
+			artifactIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isArtifactIdentifierArgOptional,
+			isArtifactIdentifierArgGreedy,
+		),
+		{
+			Key:                   pathArgKey,
+			IsOptional:            isPathArgOptional,
+			IsGreedy:              isPathArgGreedy,
+			DefaultValue:          rootPath,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	RunFunc: run,
+}
+
+// lsEntryOutput is how an entry is printed with the output format flag; directories have no size
+type lsEntryOutput struct {
+	Name        string `json:"name" yaml:"name"`
+	IsDirectory bool   `json:"is_directory" yaml:"is_directory"`
+	Size        uint64 `json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+// lsEntry is one line of the listing; directories have their name suffixed with a '/' and no size
+type lsEntry struct {
+	name        string
+	isDirectory bool
+	size        uint64
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave ID using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the artifact identifier using key '%v'", artifactIdentifierArgKey)
+	}
+
+	pathToList, err := args.GetNonGreedyArg(pathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the path to list using key '%v'", pathArgKey)
+	}
+
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	filesInspectResponse, err := enclaveCtx.InspectFilesArtifact(ctx, services.FileArtifactName(artifactIdentifier))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting files artifact '%v' in enclave '%v'", artifactIdentifier, enclaveIdentifier)
+	}
+
+	entries, found := listEntries(filesInspectResponse.GetFileDescriptions(), pathToList)
+	if !found {
+		return stacktrace.NewError("No file or directory '%v' was found in files artifact '%v' of enclave '%v'", pathToList, artifactIdentifier, enclaveIdentifier)
+	}
+
+	if outputFormat.IsStructured() {
+		entriesOutput := []lsEntryOutput{}
+		for _, entry := range entries {
+			entriesOutput = append(entriesOutput, lsEntryOutput{
+				Name:        entry.name,
+				IsDirectory: entry.isDirectory,
+				Size:        entry.size,
+			})
+		}
+		if err = output_printers.PrintStructuredOutput(outputFormat, entriesOutput); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the contents of files artifact '%v' as '%v'", artifactIdentifier, outputFormat)
+		}
+		return nil
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(nameColumnHeader, sizeColumnHeader)
+	for _, entry := range entries {
+		nameToPrint := entry.name
+		sizeToPrint := directorySizeStr
+		if entry.isDirectory {
+			nameToPrint = entry.name + pathSeparator
+		} else {
+			sizeToPrint = fmt.Sprintf("%v", entry.size)
+		}
+		if err := tablePrinter.AddRow(nameToPrint, sizeToPrint); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding row for '%v' to the table printer", entry.name)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+// listEntries returns the direct children of the directory at pathToList, sorted by name, the same way 'ls' would. If
+// pathToList is a file, the file itself is returned. The boolean is false if nothing exists at pathToList
+func listEntries(fileDescriptions []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription, pathToList string) ([]*lsEntry, bool) {
+	cleanedPathToList := normalizePath(pathToList)
+
+	entriesByName := map[string]*lsEntry{}
+	found := cleanedPathToList == rootPath
+	for _, fileDescription := range fileDescriptions {
+		isDirectory := strings.HasSuffix(fileDescription.GetPath(), pathSeparator)
+		filePath := normalizePath(fileDescription.GetPath())
+
+		if filePath == cleanedPathToList {
+			if !isDirectory {
+				return []*lsEntry{{
+					name:        path.Base(filePath),
+					isDirectory: false,
+					size:        fileDescription.GetSize(),
+				}}, true
+			}
+			found = true
+			continue
+		}
+
+		relativePath := filePath
+		if cleanedPathToList != rootPath {
+			dirPrefix := cleanedPathToList + pathSeparator
+			if !strings.HasPrefix(filePath, dirPrefix) {
+				continue
+			}
+			relativePath = strings.TrimPrefix(filePath, dirPrefix)
+		}
+		found = true
+
+		// only the first level below the listed directory is shown, deeper files show up as their parent directory
+		pathParts := strings.SplitN(relativePath, pathSeparator, maxPathPartsToKeep)
+		entryName := pathParts[0]
+		if len(pathParts) > 1 || isDirectory {
+			entriesByName[entryName] = &lsEntry{
+				name:        entryName,
+				isDirectory: true,
+				size:        0,
+			}
+			continue
+		}
+		entriesByName[entryName] = &lsEntry{
+			name:        entryName,
+			isDirectory: false,
+			size:        fileDescription.GetSize(),
+		}
+	}
+
+	entries := []*lsEntry{}
+	for _, entry := range entriesByName {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+	return entries, found
+}
+
+// normalizePath makes paths from the artifact and from the user comparable, e.g. './dir/' and 'dir' are the same
+func normalizePath(pathToNormalize string) string {
+	cleanedPath := path.Clean(strings.TrimPrefix(pathToNormalize, currentDirPrefix))
+	cleanedPath = strings.Trim(cleanedPath, pathSeparator)
+	if cleanedPath == cleanedCurrentDir {
+		return rootPath
+	}
+	return cleanedPath
+}
@@ -0,0 +1,54 @@
+package ls
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+var fileDescriptions = []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription{
+	{Path: "config/", Size: 0},
+	{Path: "config/app.yaml", Size: 12},
+	{Path: "config/nested/deep.txt", Size: 3},
+	{Path: "empty/", Size: 0},
+	{Path: "README.md", Size: 6},
+}
+
+func TestListEntries_Root(t *testing.T) {
+	entries, found := listEntries(fileDescriptions, "")
+	require.True(t, found)
+	require.Equal(t, []*lsEntry{
+		{name: "README.md", isDirectory: false, size: 6},
+		{name: "config", isDirectory: true, size: 0},
+		{name: "empty", isDirectory: true, size: 0},
+	}, entries)
+}
+
+func TestListEntries_Directory(t *testing.T) {
+	entries, found := listEntries(fileDescriptions, "./config/")
+	require.True(t, found)
+	require.Equal(t, []*lsEntry{
+		{name: "app.yaml", isDirectory: false, size: 12},
+		{name: "nested", isDirectory: true, size: 0},
+	}, entries)
+}
+
+func TestListEntries_EmptyDirectory(t *testing.T) {
+	entries, found := listEntries(fileDescriptions, "empty")
+	require.True(t, found)
+	require.Empty(t, entries)
+}
+
+func TestListEntries_File(t *testing.T) {
+	entries, found := listEntries(fileDescriptions, "config/nested/deep.txt")
+	require.True(t, found)
+	require.Equal(t, []*lsEntry{
+		{name: "deep.txt", isDirectory: false, size: 3},
+	}, entries)
+}
+
+func TestListEntries_NotFound(t *testing.T) {
+	_, found := listEntries(fileDescriptions, "conf")
+	require.False(t, found)
+}
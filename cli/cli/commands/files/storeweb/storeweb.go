@@ -3,6 +3,7 @@ package storeweb
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
@@ -14,6 +15,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"strings"
 	"time"
 )
 
@@ -27,6 +29,17 @@ const (
 	nameFlagKey = "name"
 	defaultName = ""
 
+	headersFlagKey              = "headers"
+	headerDeclarationsDelimiter = ","
+	headerNameValueDelimiter    = "="
+	expectedNumHeaderComponents = 2
+
+	basicAuthFlagKey               = "basic-auth"
+	basicAuthCredentialsDelimiter  = ":"
+	expectedNumBasicAuthComponents = 2
+
+	sha256FlagKey = "sha256"
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 
@@ -52,6 +65,29 @@ var FilesStoreWebCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosi
 			Type:    flags.FlagType_String,
 			Default: defaultName,
 		},
+		{
+			Key: headersFlagKey,
+			Usage: fmt.Sprintf(
+				"Headers to send along with the request, in the form \"NAME1%vVALUE1%vNAME2%vVALUE2\"",
+				headerNameValueDelimiter,
+				headerDeclarationsDelimiter,
+				headerNameValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
+		{
+			Key:     basicAuthFlagKey,
+			Usage:   fmt.Sprintf("Credentials for HTTP basic authentication, in the form \"USERNAME%vPASSWORD\"", basicAuthCredentialsDelimiter),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
+		{
+			Key:     sha256FlagKey,
+			Usage:   "Hex-encoded SHA-256 the downloaded file must have. Files already downloaded in the enclave with this checksum are reused instead of being downloaded again",
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -102,7 +138,12 @@ func run(
 	if artifactName == defaultName {
 		artifactName = fmt.Sprintf(artifactNamePrefix, time.Now().Unix())
 	}
-	filesArtifactUuid, err := enclaveCtx.StoreWebFiles(ctx, url, artifactName)
+	storeWebFilesOpts, err := getStoreWebFilesOpts(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the download options")
+	}
+
+	filesArtifactUuid, err := enclaveCtx.StoreWebFiles(ctx, url, artifactName, storeWebFilesOpts...)
 	if err != nil {
 		return stacktrace.Propagate(
 			err,
@@ -114,3 +155,43 @@ func run(
 	logrus.Infof("Files package '%v' stored with UUID: %v", artifactName, filesArtifactUuid)
 	return nil
 }
+
+func getStoreWebFilesOpts(flags *flags.ParsedFlags) ([]enclaves.StoreWebFilesOption, error) {
+	opts := []enclaves.StoreWebFilesOption{}
+
+	headersStr, err := flags.GetString(headersFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the headers using key '%v'", headersFlagKey)
+	}
+	for _, headerDeclarationStr := range strings.Split(headersStr, headerDeclarationsDelimiter) {
+		if len(strings.TrimSpace(headerDeclarationStr)) == 0 {
+			continue
+		}
+		headerComponents := strings.SplitN(headerDeclarationStr, headerNameValueDelimiter, expectedNumHeaderComponents)
+		if len(headerComponents) < expectedNumHeaderComponents {
+			return nil, stacktrace.NewError("Header declaration string '%v' must be of the form NAME%vVALUE", headerDeclarationStr, headerNameValueDelimiter)
+		}
+		opts = append(opts, enclaves.WithHeader(strings.TrimSpace(headerComponents[0]), headerComponents[1]))
+	}
+
+	basicAuthStr, err := flags.GetString(basicAuthFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the basic auth credentials using key '%v'", basicAuthFlagKey)
+	}
+	if basicAuthStr != "" {
+		basicAuthComponents := strings.SplitN(basicAuthStr, basicAuthCredentialsDelimiter, expectedNumBasicAuthComponents)
+		if len(basicAuthComponents) < expectedNumBasicAuthComponents {
+			return nil, stacktrace.NewError("Basic auth credentials must be of the form USERNAME%vPASSWORD", basicAuthCredentialsDelimiter)
+		}
+		opts = append(opts, enclaves.WithBasicAuth(basicAuthComponents[0], basicAuthComponents[1]))
+	}
+
+	contentSha256, err := flags.GetString(sha256FlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the SHA-256 using key '%v'", sha256FlagKey)
+	}
+	if contentSha256 != "" {
+		opts = append(opts, enclaves.WithContentSha256(contentSha256))
+	}
+	return opts, nil
+}
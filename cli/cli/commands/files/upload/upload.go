@@ -2,6 +2,7 @@ package upload
 
 import (
 	"context"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
@@ -10,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/files"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -94,7 +96,9 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the name to be given to the produced artifact")
 	}
 
-	filesArtifactUuid, fileArtifactName, err := enclaveCtx.UploadFiles(path, artifactName)
+	progressReporter, finishProgressReporting := files.NewTransferProgressReporter(fmt.Sprintf("Uploading '%v'", path))
+	filesArtifactUuid, fileArtifactName, err := enclaveCtx.UploadFilesWithProgressReporter(path, artifactName, progressReporter)
+	finishProgressReporting()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred uploading files at path '%v' to enclave '%v'", path, enclaveIdentifier)
 	}
@@ -11,6 +11,11 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 )
 
+const (
+	statusPortFlagKey = "status-port"
+	defaultStatusPort = 9715
+)
+
 // GatewayCmd Suppressing exhaustruct requirement because this struct has ~40 properties
 // nolint: exhaustruct
 var GatewayCmd = &cobra.Command{
@@ -20,11 +25,17 @@ var GatewayCmd = &cobra.Command{
 }
 
 func init() {
+	GatewayCmd.Flags().Uint16(statusPortFlagKey, defaultStatusPort, "The local port to serve the status of the connections of the gateway on, over HTTP")
 }
 
 func run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	statusPort, err := cmd.Flags().GetUint16(statusPortFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", statusPortFlagKey)
+	}
+
 	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to get Kurtosis cluster configuration, instead a non-nil error was returned")
@@ -48,7 +59,7 @@ func run(cmd *cobra.Command, args []string) error {
 		return stacktrace.Propagate(err, "Expected to be able to instantiate a gateway connection provider, instead a non-nil error was returned")
 	}
 
-	if err := engine_gateway.RunEngineGatewayUntilInterrupted(kurtosisBackend, connectionProvider); err != nil {
+	if err := engine_gateway.RunEngineGatewayUntilInterrupted(kurtosisBackend, connectionProvider, statusPort); err != nil {
 		return stacktrace.Propagate(err, "An error occurred running the engine gateway server.")
 	}
 	return nil
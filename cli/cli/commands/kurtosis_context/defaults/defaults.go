@@ -0,0 +1,216 @@
+package defaults
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/context_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/context_defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	contexts_config_api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	contextIdentifierArgKey      = "context"
+	contextIdentifierArgIsGreedy = false
+
+	clusterFlagKey           = "cluster"
+	enclaveNamePrefixFlagKey = "enclave-name-prefix"
+	packageArgsFileFlagKey   = "args-file"
+	verbosityFlagKey         = "verbosity"
+
+	unsetDefaultFlagValue = ""
+
+	defaultColumnHeader      = "Default"
+	defaultValueColumnHeader = "Value"
+	unsetDefaultStrToDisplay = "-"
+)
+
+var ContextDefaultsCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.ContextDefaultsCmdStr,
+	ShortDescription: "Sets the defaults of a Kurtosis context",
+	LongDescription: fmt.Sprintf("Sets the defaults the CLI applies while the context is the current one, so that they "+
+		"don't need to be repeated as flags: the cluster to switch to when setting the context with '%s %s %s', the "+
+		"prefix of the names of the enclaves created without a name, and the args file and verbosity of '%s %s' when "+
+		"not given. Only the defaults passed as flags are changed, and passing an empty value removes a default. "+
+		"Without flags, the defaults of the context are printed",
+		command_str_consts.KurtosisCmdStr, command_str_consts.ContextCmdStr, command_str_consts.ContextSetCmdStr,
+		command_str_consts.KurtosisCmdStr, command_str_consts.StarlarkRunCmdStr),
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       clusterFlagKey,
+			Usage:     "The cluster from the Kurtosis config file to switch to when setting the context. Only applies to contexts that aren't remote",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   unsetDefaultFlagValue,
+		},
+		{
+			Key:       enclaveNamePrefixFlagKey,
+			Usage:     "The prefix of the random names given to the enclaves created without a name",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   unsetDefaultFlagValue,
+		},
+		{
+			Key:       packageArgsFileFlagKey,
+			Usage:     fmt.Sprintf("The filepath or URL of the args file used by '%s %s' when no args are given", command_str_consts.KurtosisCmdStr, command_str_consts.StarlarkRunCmdStr),
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   unsetDefaultFlagValue,
+		},
+		{
+			Key:       verbosityFlagKey,
+			Usage:     fmt.Sprintf("The verbosity of '%s %s' when not given", command_str_consts.KurtosisCmdStr, command_str_consts.StarlarkRunCmdStr),
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   unsetDefaultFlagValue,
+		},
+	},
+	Args: []*args.ArgConfig{
+		context_id_arg.NewContextIdentifierArg(store.GetContextsConfigStore(), contextIdentifierArgKey, contextIdentifierArgIsGreedy),
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	contextIdentifier, err := args.GetNonGreedyArg(contextIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for context identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", contextIdentifierArgKey)
+	}
+
+	contextsConfigStore := store.GetContextsConfigStore()
+	kurtosisContext, err := getContext(contextsConfigStore, contextIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting context '%s'", contextIdentifier)
+	}
+
+	contextDefaults := kurtosisContext.GetDefaults()
+	if contextDefaults == nil {
+		// nolint: exhaustruct
+		contextDefaults = &generated.ContextDefaults{}
+	}
+	clusterName, isClusterNameSet, err := getDefaultFlag(flags, clusterFlagKey, contextDefaults.GetClusterName())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the default cluster")
+	}
+	enclaveNamePrefix, isEnclaveNamePrefixSet, err := getDefaultFlag(flags, enclaveNamePrefixFlagKey, contextDefaults.GetEnclaveNamePrefix())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the default enclave name prefix")
+	}
+	packageArgsFile, isPackageArgsFileSet, err := getDefaultFlag(flags, packageArgsFileFlagKey, contextDefaults.GetPackageArgsFile())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the default args file")
+	}
+	verbosity, isVerbositySet, err := getDefaultFlag(flags, verbosityFlagKey, contextDefaults.GetVerbosity())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the default verbosity")
+	}
+
+	if !isClusterNameSet && !isEnclaveNamePrefixSet && !isPackageArgsFileSet && !isVerbositySet {
+		return printDefaults(contextDefaults)
+	}
+
+	if isClusterNameSet && clusterName != unsetDefaultFlagValue {
+		if store.IsRemote(kurtosisContext) || store.IsSsh(kurtosisContext) {
+			return stacktrace.NewError("Context '%s' is a remote context, which doesn't use the clusters of the Kurtosis config file", contextIdentifier)
+		}
+		if err = context_defaults.ValidateClusterName(clusterName); err != nil {
+			return stacktrace.Propagate(err, "'%s' is not a valid default cluster", clusterName)
+		}
+	}
+	if isVerbositySet && verbosity != unsetDefaultFlagValue {
+		if err = context_defaults.ValidateVerbosity(verbosity); err != nil {
+			return stacktrace.Propagate(err, "'%s' is not a valid default verbosity", verbosity)
+		}
+	}
+
+	newContextDefaults := contexts_config_api.NewContextDefaults(
+		getOptionalString(clusterName),
+		getOptionalString(enclaveNamePrefix),
+		getOptionalString(packageArgsFile),
+		getOptionalString(verbosity),
+	)
+	if clusterName == unsetDefaultFlagValue && enclaveNamePrefix == unsetDefaultFlagValue && packageArgsFile == unsetDefaultFlagValue && verbosity == unsetDefaultFlagValue {
+		newContextDefaults = nil
+	}
+	if err = contextsConfigStore.SetContextDefaults(kurtosisContext.GetUuid(), newContextDefaults); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the defaults of context '%s'", contextIdentifier)
+	}
+	logrus.Infof("Defaults of context '%s' updated", contextIdentifier)
+	if isClusterNameSet && clusterName != unsetDefaultFlagValue {
+		logrus.Infof("The default cluster applies when the context gets set with '%s %s %s'",
+			command_str_consts.KurtosisCmdStr, command_str_consts.ContextCmdStr, command_str_consts.ContextSetCmdStr)
+	}
+	return nil
+}
+
+func getContext(contextsConfigStore store.ContextsConfigStore, contextIdentifier string) (*generated.KurtosisContext, error) {
+	contextsMatchingIdentifiers, err := context_id_arg.GetContextUuidForContextIdentifier(contextsConfigStore, []string{contextIdentifier})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error searching for context matching context identifier: '%s'", contextIdentifier)
+	}
+	contextUuid, found := contextsMatchingIdentifiers[contextIdentifier]
+	if !found {
+		return nil, stacktrace.NewError("No context matching identifier '%s' could be found", contextIdentifier)
+	}
+	contextsConfig, err := contextsConfigStore.GetKurtosisContextsConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error retrieving currently configured contexts")
+	}
+	for _, kurtosisContext := range contextsConfig.GetContexts() {
+		if kurtosisContext.GetUuid().GetValue() == contextUuid.GetValue() {
+			return kurtosisContext, nil
+		}
+	}
+	return nil, stacktrace.NewError("No context with UUID '%s' could be found", contextUuid.GetValue())
+}
+
+// getDefaultFlag returns the value of the flag if it's set, or the current default otherwise
+func getDefaultFlag(flags *flags.ParsedFlags, flagKey string, currentDefault string) (string, bool, error) {
+	if !flags.IsSet(flagKey) {
+		return currentDefault, false, nil
+	}
+	value, err := flags.GetString(flagKey)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", flagKey)
+	}
+	return value, true, nil
+}
+
+func getOptionalString(value string) *string {
+	if value == unsetDefaultFlagValue {
+		return nil
+	}
+	return &value
+}
+
+func printDefaults(contextDefaults *generated.ContextDefaults) error {
+	tablePrinter := output_printers.NewTablePrinter(defaultColumnHeader, defaultValueColumnHeader)
+	defaultRows := [][]string{
+		{clusterFlagKey, contextDefaults.GetClusterName()},
+		{enclaveNamePrefixFlagKey, contextDefaults.GetEnclaveNamePrefix()},
+		{packageArgsFileFlagKey, contextDefaults.GetPackageArgsFile()},
+		{verbosityFlagKey, contextDefaults.GetVerbosity()},
+	}
+	for _, defaultRow := range defaultRows {
+		valueToDisplay := defaultRow[1]
+		if valueToDisplay == unsetDefaultFlagValue {
+			valueToDisplay = unsetDefaultStrToDisplay
+		}
+		if err := tablePrinter.AddRow(defaultRow[0], valueToDisplay); err != nil {
+			return stacktrace.Propagate(err, "Error adding default '%s' to the table to be displayed", defaultRow[0])
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
@@ -3,9 +3,11 @@ package kurtosis_context
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/ls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/rm"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/set"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/tunnel"
 	"github.com/spf13/cobra"
 )
 
@@ -19,7 +21,9 @@ var ContextCmd = &cobra.Command{
 
 func init() {
 	ContextCmd.AddCommand(add.ContextAddCmd.MustGetCobraCommand())
+	ContextCmd.AddCommand(defaults.ContextDefaultsCmd.MustGetCobraCommand())
 	ContextCmd.AddCommand(ls.ContextLsCmd.MustGetCobraCommand())
 	ContextCmd.AddCommand(rm.ContextRmCmd.MustGetCobraCommand())
 	ContextCmd.AddCommand(set.ContextSetCmd.MustGetCobraCommand())
+	ContextCmd.AddCommand(tunnel.ContextTunnelCmd.MustGetCobraCommand())
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	contexts_config_api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang"
@@ -27,6 +28,14 @@ const (
 	defaultRemoteValueForLocalContext = "-"
 )
 
+// contextOutput is how a context is printed with the output format flag; local contexts have no remote host
+type contextOutput struct {
+	Uuid       string `json:"uuid" yaml:"uuid"`
+	Name       string `json:"name" yaml:"name"`
+	IsCurrent  bool   `json:"is_current" yaml:"is_current"`
+	RemoteHost string `json:"remote_host,omitempty" yaml:"remote_host,omitempty"`
+}
+
 var ContextLsCmd = &lowlevel.LowlevelKurtosisCommand{
 	CommandStr:       command_str_consts.ContextLsCmdStr,
 	ShortDescription: "Lists Kurtosis contexts",
@@ -51,6 +60,15 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
 	contextsConfigStore := store.GetContextsConfigStore()
 	contextsConfig, err := contextsConfigStore.GetKurtosisContextsConfig()
 	if err != nil {
@@ -58,6 +76,7 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 	}
 	currentContextUuid := contextsConfig.GetCurrentContextUuid()
 
+	contextsOutput := []contextOutput{}
 	tablePrinter := output_printers.NewTablePrinter(contextCurrentColumnHeader, contextUuidColumnHeader, contextNameColumnHeader, contextRemoteColumnHeader)
 	for _, kurtosisContext := range contextsConfig.GetContexts() {
 		var isCurrentIndicator string
@@ -82,6 +101,10 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 				remoteStrToDisplay = remoteContext.Host
 				return nil, nil
 			},
+			VisitSshContextV0: func(sshContext *contexts_config_generated_api.SshContextV0) (*struct{}, error) {
+				remoteStrToDisplay = sshContext.Host
+				return nil, nil
+			},
 		}
 		if _, err = contexts_config_api.Visit[struct{}](kurtosisContext, contextVisitorForRemoteString); err != nil {
 			return stacktrace.Propagate(err, "Unexpected error extracting remote information from the context")
@@ -89,10 +112,30 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 
 		contextNameToDisplay := kurtosisContext.GetName()
 
+		if outputFormat.IsStructured() {
+			remoteHost := remoteStrToDisplay
+			if remoteHost == defaultRemoteValueForLocalContext {
+				remoteHost = ""
+			}
+			contextsOutput = append(contextsOutput, contextOutput{
+				Uuid:       kurtosisContext.GetUuid().GetValue(),
+				Name:       contextNameToDisplay,
+				IsCurrent:  isCurrentIndicator == isCurrentContextStrIndicator,
+				RemoteHost: remoteHost,
+			})
+			continue
+		}
+
 		if err = tablePrinter.AddRow(isCurrentIndicator, contextUuidToDisplay, contextNameToDisplay, remoteStrToDisplay); err != nil {
 			return stacktrace.Propagate(err, "Error adding context to the table to be displayed")
 		}
 	}
+	if outputFormat.IsStructured() {
+		if err = output_printers.PrintStructuredOutput(outputFormat, contextsOutput); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the contexts as '%v'", outputFormat)
+		}
+		return nil
+	}
 	tablePrinter.Print()
 	return nil
 }
@@ -10,8 +10,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/context_defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/ssh_tunnel_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_cluster_setting"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -31,8 +34,10 @@ var ContextSetCmd = &lowlevel.LowlevelKurtosisCommand{
 	ShortDescription: "Sets the active Kurtosis context",
 	LongDescription: fmt.Sprintf("Sets the active Kurtosis context. The context needs to be added "+
 		"first using the `%s` command. When setting a remote context, the connection will be established with "+
-		"the remote Kurtosis server. Kurtosis Portal needs to be running for this. If the remote server can't be "+
-		"reached, the context will remain unchanged.", command_str_consts.ContextAddCmdStr),
+		"the remote Kurtosis server. Kurtosis Portal needs to be running for this. When setting an SSH context, the "+
+		"ports of the remote host are tunneled to this computer by a process running in the background. If the context "+
+		"has a default cluster, set with the `%s` command, the cluster is switched to it. If the remote "+
+		"server can't be reached, the context will remain unchanged.", command_str_consts.ContextAddCmdStr, command_str_consts.ContextDefaultsCmdStr),
 	Flags: []*flags.FlagConfig{},
 	Args: []*args.ArgConfig{
 		context_id_arg.NewContextIdentifierArg(store.GetContextsConfigStore(), contextIdentifierArgKey, contextIdentifierArgIsGreedy),
@@ -64,7 +69,8 @@ func SetContext(
 		return stacktrace.NewError("An error occurred retrieving current context prior to setting to the new one '%s'", contextIdentifier)
 	}
 
-	if !store.IsRemote(contextPriorToSet) {
+	// The engine of an SSH context runs on a host shared with other users, so it's left running
+	if !store.IsRemote(contextPriorToSet) && !store.IsSsh(contextPriorToSet) {
 		engineManager, err := engine_manager.NewEngineManager(ctx)
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred creating an engine manager.")
@@ -117,6 +123,17 @@ func SetContext(
 		return stacktrace.Propagate(err, "Error retrieving context info for context '%s' after setting it", contextIdentifier)
 	}
 
+	if store.IsSsh(currentContext) {
+		// The engine is reached through the tunnel, so it must be up before the engine gets started below
+		if _, err := ssh_tunnel_manager.StartInBackground(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred tunneling the ports of the remote host of context '%s'", contextIdentifier)
+		}
+	} else {
+		if err := ssh_tunnel_manager.StopExisting(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred stopping the SSH tunnel of the previous context")
+		}
+	}
+
 	portalManager := portal_manager.NewPortalManager()
 	if store.IsRemote(currentContext) {
 		if err := portalManager.StartRequiredVersion(ctx); err != nil {
@@ -139,6 +156,16 @@ func SetContext(
 		}
 	}
 
+	if defaultClusterName := currentContext.GetDefaults().GetClusterName(); defaultClusterName != "" && !store.IsRemote(currentContext) && !store.IsSsh(currentContext) {
+		if err := context_defaults.ValidateClusterName(defaultClusterName); err != nil {
+			return stacktrace.Propagate(err, "Default cluster '%s' of context '%s' is not valid", defaultClusterName, contextIdentifier)
+		}
+		if err := kurtosis_cluster_setting.GetKurtosisClusterSettingStore().SetClusterSetting(defaultClusterName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred setting the cluster to '%s', the default cluster of context '%s'", defaultClusterName, contextIdentifier)
+		}
+		logrus.Infof("Cluster set to '%s', the default cluster of the context", defaultClusterName)
+	}
+
 	logrus.Infof("Context set to '%s', Kurtosis engine will now be restarted", contextIdentifier)
 
 	// Instantiate the engine manager after storing the new context so the manager can read it.
@@ -0,0 +1,61 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/ssh_tunnel_manager"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+var ContextTunnelCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.ContextTunnelCmdStr,
+	ShortDescription: "Tunnels the ports of the remote host of the current SSH context",
+	LongDescription: fmt.Sprintf("Tunnels the ports Kurtosis publishes on the remote host of the current SSH context, "+
+		"like the engine and API container ports and the public ports of the services, to the same ports on this "+
+		"computer until interrupted. '%s %s %s' runs it in the background when setting an SSH context, so this is "+
+		"only needed to troubleshoot the tunnel in a terminal", command_str_consts.KurtosisCmdStr,
+		command_str_consts.ContextCmdStr, command_str_consts.ContextSetCmdStr),
+	Flags:                    []*flags.FlagConfig{},
+	Args:                     []*args.ArgConfig{},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(ctx context.Context, _ *flags.ParsedFlags, _ *args.ParsedArgs) error {
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving the current context")
+	}
+	if !store.IsSsh(currentContext) {
+		return stacktrace.NewError("The current context '%s' isn't an SSH context, so there's nothing to tunnel", currentContext.GetName())
+	}
+
+	pid := os.Getpid()
+	defer func() {
+		if err := ssh_tunnel_manager.RemovePid(pid); err != nil {
+			logrus.Warnf("The SSH tunnel stopped but its PID file couldn't be removed:\n%v", err)
+		}
+	}()
+
+	ctxUntilInterrupted, stopNotifying := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopNotifying()
+	sshTunnel := ssh_tunnel_manager.NewSshTunnel(currentContext.GetSshContextV0())
+	sshTunnel.Run(ctxUntilInterrupted, func() {
+		// Recording the process once the ports are mirrored, which is what starting it in the background waits for
+		if err := ssh_tunnel_manager.SavePid(pid); err != nil {
+			logrus.Warnf("The ports are mirrored but the PID of the SSH tunnel couldn't be saved; Kurtosis won't know it runs:\n%v", err)
+		}
+	})
+	return nil
+}
@@ -15,6 +15,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/lint/static_analysis"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
@@ -32,6 +36,9 @@ const (
 	checkDocStringFlagShortKey = "c"
 	checkDocStringDefaultValue = "false"
 
+	skipFormatCheckFlagKey      = "skip-format-check"
+	skipFormatCheckDefaultValue = "false"
+
 	pyBlackDockerImage      = "pyfound/black:23.9.1"
 	dockerRunCmd            = "run"
 	removeContainerOnExit   = "--rm"
@@ -63,7 +70,11 @@ var dockerRunSuffix = []string{dockerWorkDirFlag, lintVolumeName, pyBlackDockerI
 var LintCmd = &lowlevel.LowlevelKurtosisCommand{
 	CommandStr:       command_str_consts.KurtosisLintCmdStr,
 	ShortDescription: "Lints the Kurtosis package or file",
-	LongDescription:  "Lints the Kurtosis package or file",
+	LongDescription: "Lints the Kurtosis package or file: checks the instruction arguments, unused imports and " +
+		"variables, kurtosis.yml files, deprecated APIs and obvious type errors without running anything, then checks " +
+		"the formatting of the Starlark files. Pass '--" + defaults.OutputFormatFlagKey + " json' or '--" +
+		defaults.OutputFormatFlagKey + " yaml' to get the findings of the checks in a machine-readable format for " +
+		"editors, in which case the formatting isn't checked",
 
 	Args: []*args.ArgConfig{
 		{
@@ -90,6 +101,13 @@ var LintCmd = &lowlevel.LowlevelKurtosisCommand{
 			Type:      flags.FlagType_Bool,
 			Default:   checkDocStringDefaultValue,
 		},
+		{
+			Key:       skipFormatCheckFlagKey,
+			Usage:     fmt.Sprintf("Use this flag to only run the static checks, without checking the formatting which uses the '%v' image", pyBlackDockerImage),
+			Shorthand: "",
+			Type:      flags.FlagType_Bool,
+			Default:   skipFormatCheckDefaultValue,
+		},
 	},
 
 	RunFunc: run,
@@ -105,21 +123,71 @@ func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) err
 	if err != nil {
 		return stacktrace.Propagate(err, "an error occurred getting the value of flag '%v'", formatFlag)
 	}
-	if !formatFlag {
-		dockerRunSuffix = append(dockerRunSuffix, checkFlagForBlack)
-	}
 
 	checkDocStringFlag, err := flags.GetBool(checkDocStringFlagKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "an error occurred getting the value of the flag '%v'", checkDocStringFlagKey)
 	}
 
+	skipFormatCheckFlag, err := flags.GetBool(skipFormatCheckFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of the flag '%v'", skipFormatCheckFlagKey)
+	}
+
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
 	if checkDocStringFlag {
 		if err = validateDocString(fileOrDirToLintArg); err != nil {
 			return stacktrace.Propagate(err, "an error occurred while running the doc string validator")
 		}
 	}
 
+	findings, err := static_analysis.AnalyzePaths(fileOrDirToLintArg)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred running the static checks on '%v'", fileOrDirToLintArg)
+	}
+	numberOfErrors := 0
+	for _, finding := range findings {
+		if finding.Severity == static_analysis.Severity_Error {
+			numberOfErrors++
+		}
+	}
+
+	// the formatter output isn't machine-readable, so it only runs for humans
+	if outputFormat.IsStructured() {
+		if err = output_printers.PrintStructuredOutput(outputFormat, findings); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the findings as '%v'", outputFormat)
+		}
+	} else {
+		for _, finding := range findings {
+			out.PrintOutLn(finding.String())
+		}
+		if !skipFormatCheckFlag {
+			if err = runFormatCheck(fileOrDirToLintArg, formatFlag); err != nil {
+				return stacktrace.Propagate(err, "an error occurred checking the formatting of '%v'", fileOrDirToLintArg)
+			}
+		}
+	}
+
+	if numberOfErrors > 0 {
+		return stacktrace.NewError("linting failed, %d error(s) were found that would make the package fail to run", numberOfErrors)
+	}
+	return nil
+}
+
+func runFormatCheck(fileOrDirToLintArg []string, formatFlag bool) error {
+	blackArgs := append([]string{}, dockerRunSuffix...)
+	if !formatFlag {
+		blackArgs = append(blackArgs, checkFlagForBlack)
+	}
+
 	logrus.Infof("This depends on '%v'; first run may take a while as we might have to download it", pyBlackDockerImage)
 
 	if _, err := exec.LookPath(dockerBinary); err != nil {
@@ -137,9 +205,10 @@ func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) err
 		if err != nil {
 			return stacktrace.Propagate(err, "an error occurred while attempting to parse the volume to mount and file to lint for path '%v'", fileOrDirToLint)
 		}
-		commandArgs := append(dockerRunPrefix, volumeToMount+dirVolumeSeparator+lintVolumeName)
-		dockerRunSuffix = append(dockerRunSuffix, pathToLint)
-		commandArgs = append(commandArgs, dockerRunSuffix...)
+		commandArgs := append([]string{}, dockerRunPrefix...)
+		commandArgs = append(commandArgs, volumeToMount+dirVolumeSeparator+lintVolumeName)
+		commandArgs = append(commandArgs, blackArgs...)
+		commandArgs = append(commandArgs, pathToLint)
 		cmd := exec.Command(dockerBinary, commandArgs...)
 		logrus.Debugf("Running command '%v'", cmd.String())
 		cmdOutput, err := cmd.CombinedOutput()
@@ -0,0 +1,219 @@
+package static_analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_builtins"
+	"go.starlark.net/resolve"
+	"go.starlark.net/syntax"
+)
+
+const (
+	// packages receive the plan as the first argument of their run function, and always name it like this
+	planObjectName = "plan"
+
+	unsupportedPrintBuiltinName = "print"
+
+	argumentNamesSeparator = "', '"
+
+	boolTypeName  = "bool"
+	noneTypeName  = "NoneType"
+	dictTypeName  = "dict"
+	listTypeName  = "list"
+	tupleTypeName = "tuple"
+)
+
+var literalTokenToTypeName = map[syntax.Token]string{
+	syntax.STRING: "string",
+	syntax.BYTES:  "bytes",
+	syntax.INT:    "int",
+	syntax.FLOAT:  "float",
+}
+
+var universalNameToTypeName = map[string]string{
+	"True":  boolTypeName,
+	"False": boolTypeName,
+	"None":  noneTypeName,
+}
+
+// analyzeBuiltinCalls checks the calls to the plan instructions and to the global Kurtosis builtins against their
+// signatures: what would fail at interpretation is an error, and what's deprecated a warning
+func analyzeBuiltinCalls(file *syntax.File) []*Finding {
+	findings := []*Finding{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		call, ok := node.(*syntax.CallExpr)
+		if !ok {
+			return true
+		}
+		switch fn := call.Fn.(type) {
+		case *syntax.DotExpr:
+			receiver, ok := fn.X.(*syntax.Ident)
+			if !ok || receiver.Name != planObjectName {
+				return true
+			}
+			planInstruction, found := getPlanInstruction(fn.Name.Name)
+			if !found {
+				findings = append(findings, newFinding(fn.Name.NamePos, Severity_Error, unknownInstructionCheck, "'%s.%s' isn't a Kurtosis instruction", planObjectName, fn.Name.Name))
+				return true
+			}
+			findings = append(findings, analyzeBuiltinCall(call, fn.Name.NamePos, planObjectName+"."+planInstruction.Name, planInstruction)...)
+		case *syntax.Ident:
+			if !isPredeclaredBinding(fn) {
+				return true
+			}
+			if fn.Name == unsupportedPrintBuiltinName {
+				findings = append(findings, newFinding(fn.NamePos, Severity_Error, unsupportedBuiltinCheck, "'%s' isn't supported in Kurtosis; use '%s.%s' instead", fn.Name, planObjectName, fn.Name))
+				return true
+			}
+			if globalBuiltin, found := getGlobalBuiltin(fn.Name); found {
+				findings = append(findings, analyzeBuiltinCall(call, fn.NamePos, globalBuiltin.Name, globalBuiltin)...)
+			}
+		}
+		return true
+	})
+	return findings
+}
+
+func analyzeBuiltinCall(call *syntax.CallExpr, position syntax.Position, displayName string, builtin *starlark_builtins.Builtin) []*Finding {
+	findings := []*Finding{}
+	if builtin.DeprecationMitigation != "" {
+		findings = append(findings, newFinding(position, Severity_Warning, deprecationCheck, "'%s' is deprecated. %s", displayName, builtin.DeprecationMitigation))
+	}
+
+	// with *args or **kwargs, which arguments are passed can't be known without interpreting
+	hasUnpackedArguments := false
+	numberOfPositionalArguments := 0
+	passedArgumentNameToValue := map[string]syntax.Expr{}
+	for _, callArgument := range call.Args {
+		switch callArgument := callArgument.(type) {
+		case *syntax.UnaryExpr:
+			if callArgument.Op == syntax.STAR || callArgument.Op == syntax.STARSTAR {
+				hasUnpackedArguments = true
+				continue
+			}
+		case *syntax.BinaryExpr:
+			if callArgument.Op == syntax.EQ {
+				argumentName, ok := callArgument.X.(*syntax.Ident)
+				if !ok {
+					continue
+				}
+				if _, found := getArgument(builtin, argumentName.Name); !found {
+					findings = append(findings, newFinding(argumentName.NamePos, Severity_Error, unknownArgumentCheck, "'%s' isn't an argument of '%s'; %s", argumentName.Name, displayName, getArgumentsDescription(builtin)))
+					continue
+				}
+				passedArgumentNameToValue[argumentName.Name] = callArgument.Y
+				continue
+			}
+		}
+		if numberOfPositionalArguments >= len(builtin.Arguments) {
+			start, _ := callArgument.Span()
+			findings = append(findings, newFinding(start, Severity_Error, tooManyArgumentsCheck, "'%s' takes at most %d positional argument(s)", displayName, len(builtin.Arguments)))
+		} else {
+			passedArgumentNameToValue[builtin.Arguments[numberOfPositionalArguments].Name] = callArgument
+		}
+		numberOfPositionalArguments++
+	}
+
+	for _, argument := range builtin.Arguments {
+		value, isPassed := passedArgumentNameToValue[argument.Name]
+		if !isPassed {
+			if !argument.IsOptional && !hasUnpackedArguments {
+				findings = append(findings, newFinding(position, Severity_Error, missingArgumentCheck, "'%s' is missing required argument '%s'", displayName, argument.Name))
+			}
+			continue
+		}
+		valuePosition, _ := value.Span()
+		if argument.DeprecationMitigation != "" {
+			findings = append(findings, newFinding(valuePosition, Severity_Warning, deprecationCheck, "Argument '%s' of '%s' is deprecated. %s", argument.Name, displayName, argument.DeprecationMitigation))
+		}
+		if valueTypeName := getLiteralTypeName(value); argument.TypeName != "" && valueTypeName != "" && valueTypeName != argument.TypeName {
+			findings = append(findings, newFinding(valuePosition, Severity_Error, argumentTypeCheck, "Argument '%s' of '%s' must be a '%s' but got a '%s'", argument.Name, displayName, argument.TypeName, valueTypeName))
+		}
+	}
+	return findings
+}
+
+// getLiteralTypeName returns the type of the value if it's obvious without interpreting, e.g. for a string literal or
+// a ServiceConfig(...) call, or an empty string
+func getLiteralTypeName(value syntax.Expr) string {
+	switch value := value.(type) {
+	case *syntax.ParenExpr:
+		return getLiteralTypeName(value.X)
+	case *syntax.Literal:
+		return literalTokenToTypeName[value.Token]
+	case *syntax.ListExpr:
+		return listTypeName
+	case *syntax.DictExpr:
+		return dictTypeName
+	case *syntax.TupleExpr:
+		return tupleTypeName
+	case *syntax.Comprehension:
+		if value.Curly {
+			return dictTypeName
+		}
+		return listTypeName
+	case *syntax.Ident:
+		if binding, ok := value.Binding.(*resolve.Binding); ok && binding.Scope == resolve.Universal {
+			return universalNameToTypeName[value.Name]
+		}
+	case *syntax.CallExpr:
+		if fn, ok := value.Fn.(*syntax.Ident); ok && isPredeclaredBinding(fn) && isTypeConstructorName(fn.Name) {
+			return fn.Name
+		}
+	}
+	return ""
+}
+
+// isPredeclaredBinding is false for the names packages redefine, which are then not the Kurtosis builtins anymore
+func isPredeclaredBinding(ident *syntax.Ident) bool {
+	binding, ok := ident.Binding.(*resolve.Binding)
+	return ok && binding.Scope == resolve.Predeclared
+}
+
+// isTypeConstructorName relies on the Kurtosis type constructors being the only capitalized global builtins, as the
+// helpers are functions
+func isTypeConstructorName(name string) bool {
+	if _, found := getGlobalBuiltin(name); !found {
+		return false
+	}
+	return strings.ToUpper(name[:1]) == name[:1]
+}
+
+func getPlanInstruction(name string) (*starlark_builtins.Builtin, bool) {
+	for _, planInstruction := range starlark_builtins.GetPlanInstructions() {
+		if planInstruction.Name == name {
+			return planInstruction, true
+		}
+	}
+	return nil, false
+}
+
+func getGlobalBuiltin(name string) (*starlark_builtins.Builtin, bool) {
+	for _, globalBuiltin := range starlark_builtins.GetGlobalBuiltins() {
+		if globalBuiltin.Name == name {
+			return globalBuiltin, true
+		}
+	}
+	return nil, false
+}
+
+func getArgument(builtin *starlark_builtins.Builtin, name string) (*starlark_builtins.Argument, bool) {
+	for _, argument := range builtin.Arguments {
+		if argument.Name == name {
+			return argument, true
+		}
+	}
+	return nil, false
+}
+
+func getArgumentsDescription(builtin *starlark_builtins.Builtin) string {
+	if len(builtin.Arguments) == 0 {
+		return "it takes no arguments"
+	}
+	argumentNames := []string{}
+	for _, argument := range builtin.Arguments {
+		argumentNames = append(argumentNames, argument.Name)
+	}
+	return fmt.Sprintf("its arguments are '%s'", strings.Join(argumentNames, argumentNamesSeparator))
+}
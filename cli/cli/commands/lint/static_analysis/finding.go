@@ -0,0 +1,56 @@
+package static_analysis
+
+import (
+	"fmt"
+
+	"go.starlark.net/syntax"
+)
+
+type Severity string
+
+const (
+	Severity_Error   Severity = "error"
+	Severity_Warning Severity = "warning"
+)
+
+// The checks a finding can come from, so that editors can tell them apart
+const (
+	syntaxCheck             = "syntax"
+	nameResolutionCheck     = "name-resolution"
+	unknownInstructionCheck = "unknown-instruction"
+	unsupportedBuiltinCheck = "unsupported-builtin"
+	unknownArgumentCheck    = "unknown-argument"
+	missingArgumentCheck    = "missing-argument"
+	tooManyArgumentsCheck   = "too-many-arguments"
+	argumentTypeCheck       = "argument-type"
+	deprecationCheck        = "deprecation"
+	unusedImportCheck       = "unused-import"
+	unusedVariableCheck     = "unused-variable"
+	kurtosisYmlCheck        = "kurtosis-yml"
+)
+
+// Finding is an issue found in a file of a package; errors will make the package fail to run, warnings won't
+type Finding struct {
+	Path     string   `json:"path" yaml:"path"`
+	Line     int      `json:"line" yaml:"line"`
+	Column   int      `json:"column" yaml:"column"`
+	Severity Severity `json:"severity" yaml:"severity"`
+	Check    string   `json:"check" yaml:"check"`
+	Message  string   `json:"message" yaml:"message"`
+}
+
+func newFinding(position syntax.Position, severity Severity, check string, messageFormat string, messageArgs ...interface{}) *Finding {
+	return &Finding{
+		Path:     position.Filename(),
+		Line:     int(position.Line),
+		Column:   int(position.Col),
+		Severity: severity,
+		Check:    check,
+		Message:  fmt.Sprintf(messageFormat, messageArgs...),
+	}
+}
+
+// String formats the finding like compilers do, which editors know how to parse
+func (finding *Finding) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s [%s]", finding.Path, finding.Line, finding.Column, finding.Severity, finding.Message, finding.Check)
+}
@@ -0,0 +1,77 @@
+package static_analysis
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/syntax"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	kurtosisYmlNameKey        = "name"
+	kurtosisYmlDescriptionKey = "description"
+	kurtosisYmlReplaceKey     = "replace"
+	kurtosisYmlArgsKey        = "args"
+
+	firstLine   = 1
+	firstColumn = 1
+)
+
+// the keys the API container accepts; it refuses kurtosis.yml files with other keys
+var kurtosisYmlKeys = map[string]bool{
+	kurtosisYmlNameKey:        true,
+	kurtosisYmlDescriptionKey: true,
+	kurtosisYmlReplaceKey:     true,
+	kurtosisYmlArgsKey:        true,
+}
+
+// analyzeKurtosisYml checks that the kurtosis.yml only has known keys, has a valid package name, and declares
+// well-formed arguments
+func analyzeKurtosisYml(filePath string, fileContent []byte) []*Finding {
+	findingAt := func(line int, column int, messageFormat string, messageArgs ...interface{}) *Finding {
+		return newFinding(syntax.MakePosition(&filePath, int32(line), int32(column)), Severity_Error, kurtosisYmlCheck, messageFormat, messageArgs...)
+	}
+
+	document := &yaml.Node{} // nolint: exhaustruct
+	if err := yaml.Unmarshal(fileContent, document); err != nil {
+		return []*Finding{findingAt(firstLine, firstColumn, "The file isn't valid YAML: %v", err)}
+	}
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return []*Finding{findingAt(firstLine, firstColumn, "The file must be a map with at least the '%s' key", kurtosisYmlNameKey)}
+	}
+	root := document.Content[0]
+
+	findings := []*Finding{}
+	keyToNode := map[string]*yaml.Node{}
+	// the content of a mapping node alternates keys and values
+	for keyIdx := 0; keyIdx+1 < len(root.Content); keyIdx += 2 {
+		key := root.Content[keyIdx]
+		if !kurtosisYmlKeys[key.Value] {
+			findings = append(findings, findingAt(key.Line, key.Column, "'%s' isn't a kurtosis.yml key; the keys are '%s', '%s', '%s' and '%s'", key.Value, kurtosisYmlNameKey, kurtosisYmlDescriptionKey, kurtosisYmlReplaceKey, kurtosisYmlArgsKey))
+			continue
+		}
+		keyToNode[key.Value] = key
+	}
+
+	nameKey, found := keyToNode[kurtosisYmlNameKey]
+	if !found {
+		findings = append(findings, findingAt(root.Line, root.Column, "The '%s' key is missing; it's the locator of the package, e.g. 'github.com/my-org/my-package'", kurtosisYmlNameKey))
+	}
+
+	kurtosisYml := &enclaves.KurtosisYaml{} // nolint: exhaustruct
+	if err := root.Decode(kurtosisYml); err != nil {
+		return append(findings, findingAt(root.Line, root.Column, "The file doesn't match the kurtosis.yml format: %v", err))
+	}
+	if found {
+		if _, err := shared_utils.ParseGitURL(kurtosisYml.PackageName); err != nil {
+			findings = append(findings, findingAt(nameKey.Line, nameKey.Column, "'%s' isn't a valid package locator, e.g. 'github.com/my-org/my-package'", kurtosisYml.PackageName))
+		}
+	}
+	if argsKey, found := keyToNode[kurtosisYmlArgsKey]; found {
+		if err := kurtosisYml.PackageArgs.Validate(); err != nil {
+			findings = append(findings, findingAt(argsKey.Line, argsKey.Column, "The '%s' section is invalid: %v", kurtosisYmlArgsKey, stacktrace.RootCause(err)))
+		}
+	}
+	return findings
+}
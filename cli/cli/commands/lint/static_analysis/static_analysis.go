@@ -0,0 +1,131 @@
+package static_analysis
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_builtins"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/resolve"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+const (
+	starlarkFileExtension = ".star"
+	kurtosisYmlFilename   = "kurtosis.yml"
+	hiddenDirPrefix       = "."
+
+	noParseMode syntax.Mode = 0
+)
+
+// AnalyzePaths checks the Starlark files and kurtosis.yml files at the paths, walking the directories, without
+// interpreting anything. The findings are sorted by path and position
+func AnalyzePaths(paths []string) ([]*Finding, error) {
+	filePaths, err := getFilePathsToAnalyze(paths)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files to analyze in '%v'", paths)
+	}
+
+	findings := []*Finding{}
+	for _, filePath := range filePaths {
+		fileContent, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading file '%v'", filePath)
+		}
+		if filepath.Base(filePath) == kurtosisYmlFilename {
+			findings = append(findings, analyzeKurtosisYml(filePath, fileContent)...)
+		} else {
+			findings = append(findings, analyzeStarlarkFile(filePath, fileContent)...)
+		}
+	}
+	sortFindings(findings)
+	return findings, nil
+}
+
+// getFilePathsToAnalyze returns the files passed explicitly, and the Starlark and kurtosis.yml files in the
+// directories, skipping the hidden ones like .git
+func getFilePathsToAnalyze(paths []string) ([]string, error) {
+	filePaths := []string{}
+	for _, path := range paths {
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred verifying that '%v' exists", path)
+		}
+		if !fileInfo.IsDir() {
+			filePaths = append(filePaths, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(walkedPath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				if walkedPath != path && strings.HasPrefix(entry.Name(), hiddenDirPrefix) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if filepath.Ext(walkedPath) == starlarkFileExtension || entry.Name() == kurtosisYmlFilename {
+				filePaths = append(filePaths, walkedPath)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred walking directory '%v'", path)
+		}
+	}
+	return filePaths, nil
+}
+
+func analyzeStarlarkFile(filePath string, fileContent []byte) []*Finding {
+	file, err := syntax.Parse(filePath, fileContent, noParseMode)
+	if err != nil {
+		if syntaxErr, ok := err.(syntax.Error); ok {
+			return []*Finding{newFinding(syntaxErr.Pos, Severity_Error, syntaxCheck, "%s", syntaxErr.Msg)}
+		}
+		return []*Finding{newFinding(syntax.MakePosition(&filePath, 1, 1), Severity_Error, syntaxCheck, "%s", err.Error())}
+	}
+
+	findings := []*Finding{}
+	// the bindings of the identifiers are set even when some names can't be resolved, so the other checks still run
+	if err = resolve.File(file, isPredeclared, starlark.Universe.Has); err != nil {
+		if resolveErrs, ok := err.(resolve.ErrorList); ok {
+			for _, resolveErr := range resolveErrs {
+				findings = append(findings, newFinding(resolveErr.Pos, Severity_Error, nameResolutionCheck, "%s", resolveErr.Msg))
+			}
+		} else {
+			findings = append(findings, newFinding(syntax.MakePosition(&filePath, 1, 1), Severity_Error, nameResolutionCheck, "%s", err.Error()))
+		}
+	}
+	findings = append(findings, analyzeBuiltinCalls(file)...)
+	findings = append(findings, analyzeUnusedBindings(file)...)
+	return findings
+}
+
+func isPredeclared(name string) bool {
+	if _, found := getGlobalBuiltin(name); found {
+		return true
+	}
+	for _, predeclaredName := range starlark_builtins.GetOtherPredeclaredNames() {
+		if name == predeclaredName {
+			return true
+		}
+	}
+	return false
+}
+
+func sortFindings(findings []*Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Path != findings[j].Path {
+			return findings[i].Path < findings[j].Path
+		}
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+		return findings[i].Column < findings[j].Column
+	})
+}
@@ -0,0 +1,135 @@
+package static_analysis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testStarlarkFilePath = "main.star"
+
+func TestAnalyzeStarlarkFile_ValidPackage(t *testing.T) {
+	script := `lib = import_module("github.com/my-org/my-package/lib.star")
+
+def run(plan, args = {}):
+    config = ServiceConfig(image = "nginx", ports = {"http": PortSpec(number = 80)})
+    service = plan.add_service("nginx", config)
+    for name, value in args.items():
+        plan.print("{}={}".format(name, value))
+    lib.wait(plan, service.name)
+    _ignored = plan.get_services()
+    return service
+`
+	require.Empty(t, getFindingStrs(analyzeStarlarkFile(testStarlarkFilePath, []byte(script))))
+}
+
+func TestAnalyzeStarlarkFile_BuiltinCalls(t *testing.T) {
+	script := `def run(plan):
+    plan.add_service(name = "nginx", image = "nginx")
+    plan.add_service("nginx", "nginx")
+    plan.upload_files("file.txt", "name", "extra")
+    plan.not_an_instruction()
+    PortSpec(number = "80")
+    ServiceConfig(image = "nginx", cpu_allocation = 1000)
+    plan.exec(service_name = "nginx", **{"recipe": ExecRecipe(command = ["ls"])})
+    print("hello")
+`
+	require.Equal(t, []string{
+		"main.star:2:10: error: 'plan.add_service' is missing required argument 'config' [missing-argument]",
+		"main.star:2:38: error: 'image' isn't an argument of 'plan.add_service'; its arguments are 'name', 'config' [unknown-argument]",
+		"main.star:3:31: error: Argument 'config' of 'plan.add_service' must be a 'ServiceConfig' but got a 'string' [argument-type]",
+		"main.star:4:43: error: 'plan.upload_files' takes at most 2 positional argument(s) [too-many-arguments]",
+		"main.star:5:10: error: 'plan.not_an_instruction' isn't a Kurtosis instruction [unknown-instruction]",
+		"main.star:6:23: error: Argument 'number' of 'PortSpec' must be a 'int' but got a 'string' [argument-type]",
+		"main.star:7:53: warning: Argument 'cpu_allocation' of 'ServiceConfig' is deprecated. This field is being deprecated in favour of `max_cpu` to set a maximum cpu a container can use [deprecation]",
+		"main.star:9:5: error: 'print' isn't supported in Kurtosis; use 'plan.print' instead [unsupported-builtin]",
+	}, getFindingStrs(analyzeStarlarkFile(testStarlarkFilePath, []byte(script))))
+}
+
+func TestAnalyzeStarlarkFile_UnusedBindings(t *testing.T) {
+	script := `lib = import_module("github.com/my-org/my-package/lib.star")
+load("github.com/my-org/my-package/other.star", "helper")
+
+def run(plan):
+    unused = plan.get_services()
+    first, second = ("a", "b")
+    counter = 0
+    counter += 1
+    used = "nginx"
+    plan.get_service(used)
+
+    def nested():
+        return first
+    return nested
+`
+	require.Equal(t, []string{
+		"main.star:1:1: warning: Module 'lib' is imported but never used [unused-import]",
+		"main.star:2:50: warning: 'helper' is loaded but never used [unused-import]",
+		"main.star:5:5: warning: Variable 'unused' is assigned but never used [unused-variable]",
+	}, getFindingStrs(analyzeStarlarkFile(testStarlarkFilePath, []byte(script))))
+}
+
+func TestAnalyzeStarlarkFile_NameResolutionAndSyntax(t *testing.T) {
+	require.Equal(t, []string{
+		"main.star:2:5: error: undefined: undefined_function [name-resolution]",
+	}, getFindingStrs(analyzeStarlarkFile(testStarlarkFilePath, []byte("def run(plan):\n    undefined_function()\n"))))
+
+	require.Equal(t, []string{
+		"main.star:2:1: error: got newline, want ':' [syntax]",
+	}, getFindingStrs(analyzeStarlarkFile(testStarlarkFilePath, []byte("def run(plan)\n    pass\n"))))
+}
+
+func TestAnalyzeStarlarkFile_RedefinedBuiltinsAreNotChecked(t *testing.T) {
+	script := `def PortSpec(port):
+    return port
+
+def run(plan):
+    PortSpec(port = "80")
+`
+	require.Empty(t, getFindingStrs(analyzeStarlarkFile(testStarlarkFilePath, []byte(script))))
+}
+
+func TestAnalyzeKurtosisYml(t *testing.T) {
+	require.Empty(t, getFindingStrs(analyzeKurtosisYml(kurtosisYmlFilename, []byte("name: github.com/my-org/my-package\ndescription: My package\n"))))
+
+	kurtosisYml := `description: My package
+replaces: {}
+args:
+  - name: count
+    type: number
+`
+	require.Equal(t, []string{
+		"kurtosis.yml:1:1: error: The 'name' key is missing; it's the locator of the package, e.g. 'github.com/my-org/my-package' [kurtosis-yml]",
+		"kurtosis.yml:2:1: error: 'replaces' isn't a kurtosis.yml key; the keys are 'name', 'description', 'replace' and 'args' [kurtosis-yml]",
+		"kurtosis.yml:3:1: error: The 'args' section is invalid: Package argument 'count' has type 'number' which isn't one of the supported types (string, int, float, bool, list, dict) [kurtosis-yml]",
+	}, getFindingStrs(analyzeKurtosisYml(kurtosisYmlFilename, []byte(kurtosisYml))))
+
+	require.Equal(t, []string{
+		"kurtosis.yml:1:1: error: 'my-package' isn't a valid package locator, e.g. 'github.com/my-org/my-package' [kurtosis-yml]",
+	}, getFindingStrs(analyzeKurtosisYml(kurtosisYmlFilename, []byte("name: my-package\n"))))
+}
+
+func TestAnalyzePaths_SkipsHiddenDirs(t *testing.T) {
+	packageDirPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirPath, kurtosisYmlFilename), []byte("name: github.com/my-org/my-package\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirPath, "main.star"), []byte("def run(plan):\n    plan.print()\n"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(packageDirPath, ".git"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirPath, ".git", "hook.star"), []byte("not starlark"), 0644))
+
+	findings, err := AnalyzePaths([]string{packageDirPath})
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	require.Equal(t, filepath.Join(packageDirPath, "main.star"), findings[0].Path)
+	require.Equal(t, missingArgumentCheck, findings[0].Check)
+}
+
+func getFindingStrs(findings []*Finding) []string {
+	sortFindings(findings)
+	findingStrs := []string{}
+	for _, finding := range findings {
+		findingStrs = append(findingStrs, finding.String())
+	}
+	return findingStrs
+}
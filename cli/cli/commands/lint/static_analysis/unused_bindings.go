@@ -0,0 +1,189 @@
+package static_analysis
+
+import (
+	"strings"
+
+	"go.starlark.net/resolve"
+	"go.starlark.net/syntax"
+)
+
+const (
+	importModuleBuiltinName = "import_module"
+
+	// like in Python, names starting with an underscore are meant to be unused
+	unusedNamePrefix = "_"
+)
+
+// analyzeUnusedBindings warns about the modules imported but never used, and the variables of functions assigned
+// but never read. Variables unpacked from tuples aren't reported, as it's common to only need some of them
+func analyzeUnusedBindings(file *syntax.File) []*Finding {
+	bindingToNumberOfUses := getBindingToNumberOfUses(file)
+
+	findings := []*Finding{}
+	for _, stmt := range file.Stmts {
+		switch stmt := stmt.(type) {
+		case *syntax.LoadStmt:
+			for _, loadedName := range stmt.To {
+				if isUnused(loadedName, bindingToNumberOfUses) {
+					findings = append(findings, newFinding(loadedName.NamePos, Severity_Warning, unusedImportCheck, "'%s' is loaded but never used", loadedName.Name))
+				}
+			}
+		case *syntax.AssignStmt:
+			if moduleName, ok := getImportedModuleName(stmt); ok && isUnused(moduleName, bindingToNumberOfUses) {
+				findings = append(findings, newFinding(moduleName.NamePos, Severity_Warning, unusedImportCheck, "Module '%s' is imported but never used", moduleName.Name))
+			}
+		}
+	}
+
+	reportedBindings := map[*resolve.Binding]bool{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		def, ok := node.(*syntax.DefStmt)
+		if !ok {
+			return true
+		}
+		for _, stmt := range def.Body {
+			syntax.Walk(stmt, func(node syntax.Node) bool {
+				assign, ok := node.(*syntax.AssignStmt)
+				if !ok || assign.Op != syntax.EQ {
+					return true
+				}
+				variable, ok := assign.LHS.(*syntax.Ident)
+				if !ok {
+					return true
+				}
+				// cells are read by nested functions through bindings of their own, so they're never unused
+				binding, ok := variable.Binding.(*resolve.Binding)
+				if !ok || binding.Scope != resolve.Local || reportedBindings[binding] || !isUnused(variable, bindingToNumberOfUses) {
+					return true
+				}
+				reportedBindings[binding] = true
+				findings = append(findings, newFinding(variable.NamePos, Severity_Warning, unusedVariableCheck, "Variable '%s' is assigned but never used", variable.Name))
+				return true
+			})
+		}
+		// nested functions were walked with the body of this one
+		return false
+	})
+	return findings
+}
+
+// getBindingToNumberOfUses counts the occurrences of each binding where its value is read, i.e. all but where it's
+// bound
+func getBindingToNumberOfUses(file *syntax.File) map[*resolve.Binding]int {
+	bindingToNumberOfUses := map[*resolve.Binding]int{}
+	// the names loaded without alias are both the From and the To of load statements
+	countedIdents := map[*syntax.Ident]bool{}
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if ident, ok := node.(*syntax.Ident); ok && !countedIdents[ident] {
+			countedIdents[ident] = true
+			if binding, ok := ident.Binding.(*resolve.Binding); ok {
+				bindingToNumberOfUses[binding]++
+			}
+		}
+		return true
+	})
+	syntax.Walk(file, func(node syntax.Node) bool {
+		for _, boundIdent := range getBoundIdents(node) {
+			if binding, ok := boundIdent.Binding.(*resolve.Binding); ok {
+				bindingToNumberOfUses[binding]--
+			}
+		}
+		return true
+	})
+	return bindingToNumberOfUses
+}
+
+// getBoundIdents returns the identifiers the node binds; augmented assignments like 'x += 1' also read the variable so
+// their identifier isn't returned
+func getBoundIdents(node syntax.Node) []*syntax.Ident {
+	boundIdents := []*syntax.Ident{}
+	switch node := node.(type) {
+	case *syntax.AssignStmt:
+		if node.Op == syntax.EQ {
+			boundIdents = append(boundIdents, getAssignedIdents(node.LHS)...)
+		}
+	case *syntax.ForStmt:
+		boundIdents = append(boundIdents, getAssignedIdents(node.Vars)...)
+	case *syntax.ForClause:
+		boundIdents = append(boundIdents, getAssignedIdents(node.Vars)...)
+	case *syntax.LoadStmt:
+		boundIdents = append(boundIdents, node.To...)
+	case *syntax.DefStmt:
+		boundIdents = append(boundIdents, node.Name)
+		boundIdents = append(boundIdents, getParamIdents(node.Params)...)
+	case *syntax.LambdaExpr:
+		boundIdents = append(boundIdents, getParamIdents(node.Params)...)
+	}
+	return boundIdents
+}
+
+func getAssignedIdents(lhs syntax.Expr) []*syntax.Ident {
+	switch lhs := lhs.(type) {
+	case *syntax.Ident:
+		return []*syntax.Ident{lhs}
+	case *syntax.ParenExpr:
+		return getAssignedIdents(lhs.X)
+	case *syntax.TupleExpr:
+		return getAssignedIdentsOfAll(lhs.List)
+	case *syntax.ListExpr:
+		return getAssignedIdentsOfAll(lhs.List)
+	}
+	// e.g. 'x[0] = 1' or 'x.y = 1', which read x
+	return []*syntax.Ident{}
+}
+
+func getAssignedIdentsOfAll(lhsList []syntax.Expr) []*syntax.Ident {
+	assignedIdents := []*syntax.Ident{}
+	for _, lhs := range lhsList {
+		assignedIdents = append(assignedIdents, getAssignedIdents(lhs)...)
+	}
+	return assignedIdents
+}
+
+// getParamIdents returns the names of the parameters, which can be 'x', 'x=default', '*x' or '**x'
+func getParamIdents(params []syntax.Expr) []*syntax.Ident {
+	paramIdents := []*syntax.Ident{}
+	for _, param := range params {
+		switch param := param.(type) {
+		case *syntax.Ident:
+			paramIdents = append(paramIdents, param)
+		case *syntax.BinaryExpr:
+			if paramIdent, ok := param.X.(*syntax.Ident); ok {
+				paramIdents = append(paramIdents, paramIdent)
+			}
+		case *syntax.UnaryExpr:
+			if paramIdent, ok := param.X.(*syntax.Ident); ok {
+				paramIdents = append(paramIdents, paramIdent)
+			}
+		}
+	}
+	return paramIdents
+}
+
+// getImportedModuleName returns x for 'x = import_module(...)'
+func getImportedModuleName(assign *syntax.AssignStmt) (*syntax.Ident, bool) {
+	moduleName, ok := assign.LHS.(*syntax.Ident)
+	if !ok || assign.Op != syntax.EQ {
+		return nil, false
+	}
+	call, ok := assign.RHS.(*syntax.CallExpr)
+	if !ok {
+		return nil, false
+	}
+	fn, ok := call.Fn.(*syntax.Ident)
+	if !ok || fn.Name != importModuleBuiltinName || !isPredeclaredBinding(fn) {
+		return nil, false
+	}
+	return moduleName, true
+}
+
+func isUnused(ident *syntax.Ident, bindingToNumberOfUses map[*resolve.Binding]int) bool {
+	if strings.HasPrefix(ident.Name, unusedNamePrefix) {
+		return false
+	}
+	binding, ok := ident.Binding.(*resolve.Binding)
+	if !ok {
+		return false
+	}
+	return bindingToNumberOfUses[binding] <= 0
+}
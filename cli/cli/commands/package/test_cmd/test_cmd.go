@@ -0,0 +1,187 @@
+package test_cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/context_defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	packageDirArgKey          = "package-dir"
+	packageDirArgDefaultValue = "."
+	packageDirArgIsOptional   = true
+	packageDirArgIsGreedy     = false
+
+	kurtosisYmlFilename = "kurtosis.yml"
+
+	testEnclaveNamePrefix = "test-"
+
+	passedTestStatus = "PASS"
+	failedTestStatus = "FAIL"
+
+	testDurationPrecision = time.Millisecond
+)
+
+// TestCmd we only fill in the required struct fields, hence the others remain nil
+// nolint: exhaustruct
+var TestCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PackageTestCmdStr,
+	ShortDescription: "Runs the tests of a Kurtosis package",
+	LongDescription: "Runs the tests of the Kurtosis package in the given directory. Tests are the top-level functions " +
+		"named 'test_*' of the '*_test.star' files of the package; they receive the plan like the run function does and " +
+		"can check values with 'assert_eq(actual, expected, msg)' or fail with 'fail(msg)'. Each test runs in a new " +
+		"enclave, which is destroyed once the test is done",
+	Args: []*args.ArgConfig{
+		{
+			Key:            packageDirArgKey,
+			DefaultValue:   packageDirArgDefaultValue,
+			IsOptional:     packageDirArgIsOptional,
+			IsGreedy:       packageDirArgIsGreedy,
+			ValidationFunc: validatePackageDirArg,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+type testResult struct {
+	File     string `json:"file" yaml:"file"`
+	Function string `json:"function" yaml:"function"`
+	Passed   bool   `json:"passed" yaml:"passed"`
+	Duration string `json:"duration" yaml:"duration"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDirArg, err := args.GetNonGreedyArg(packageDirArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of argument with key '%v'", packageDirArgKey)
+	}
+
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
+	packageRootPath, err := filepath.Abs(packageDirArg)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the absolute path of package directory '%v'", packageDirArg)
+	}
+
+	tests, err := discoverTests(packageRootPath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred discovering the tests of the package in '%v'", packageRootPath)
+	}
+	if len(tests) == 0 {
+		logrus.Warnf("No tests were found; tests are the top-level '%s*' functions of the '*%s' files of the package", testFunctionNamePrefix, testFileSuffix)
+		return nil
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	results := []*testResult{}
+	numberOfFailedTests := 0
+	for _, test := range tests {
+		result, err := runTest(ctx, kurtosisCtx, packageRootPath, test)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred running test '%v'", test)
+		}
+		if !result.Passed {
+			numberOfFailedTests++
+		}
+		if !outputFormat.IsStructured() {
+			printTestResult(test, result)
+		}
+		results = append(results, result)
+	}
+
+	if outputFormat.IsStructured() {
+		if err = output_printers.PrintStructuredOutput(outputFormat, results); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the test results as '%v'", outputFormat)
+		}
+	} else {
+		out.PrintOutLn(fmt.Sprintf("\n%d passed, %d failed", len(results)-numberOfFailedTests, numberOfFailedTests))
+	}
+
+	if numberOfFailedTests > 0 {
+		return stacktrace.NewError("%d out of %d test(s) failed", numberOfFailedTests, len(results))
+	}
+	return nil
+}
+
+// runTest runs the test in a new enclave, so tests can't see the services of each other. An error is only returned
+// when the test couldn't be run; a test that fails is a result like one that passes
+func runTest(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, packageRootPath string, test *packageTest) (*testResult, error) {
+	enclaveName := context_defaults.GenerateEnclaveName(testEnclaveNamePrefix)
+	logrus.Infof("Running test '%v' in enclave '%v'...", test, enclaveName)
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, enclaveName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v' to run test '%v'", enclaveName, test)
+	}
+	defer func() {
+		// the enclave is destroyed even when the test was interrupted, so the context of the command can't be used
+		if err := kurtosisCtx.DestroyEnclave(context.Background(), enclaveName); err != nil {
+			logrus.Errorf("An error occurred destroying enclave '%v' of test '%v'; you'll have to remove it manually with 'kurtosis %s %s %s'. Error was:\n%v", enclaveName, test, command_str_consts.EnclaveCmdStr, command_str_consts.EnclaveRmCmdStr, enclaveName, err)
+		}
+	}()
+
+	runConfig := starlark_run_config.NewRunStarlarkConfig(
+		starlark_run_config.WithRelativePathToMainFile(test.RelativeFilePath),
+		starlark_run_config.WithMainFunctionName(test.FunctionName),
+	)
+	startTime := time.Now()
+	_, runErr := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageRootPath, runConfig)
+	result := &testResult{
+		File:     test.RelativeFilePath,
+		Function: test.FunctionName,
+		Passed:   runErr == nil,
+		Duration: time.Since(startTime).Round(testDurationPrecision).String(),
+		Error:    "",
+	}
+	if runErr != nil {
+		result.Error = stacktrace.RootCause(runErr).Error()
+	}
+	return result, nil
+}
+
+func printTestResult(test *packageTest, result *testResult) {
+	if result.Passed {
+		out.PrintOutLn(fmt.Sprintf("%s %v (%s)", passedTestStatus, test, result.Duration))
+		return
+	}
+	out.PrintOutLn(fmt.Sprintf("%s %v (%s)\n%s", failedTestStatus, test, result.Duration, result.Error))
+}
+
+func validatePackageDirArg(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDirArg, err := args.GetNonGreedyArg(packageDirArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of argument with key '%v'", packageDirArgKey)
+	}
+	if _, err := os.Stat(filepath.Join(packageDirArg, kurtosisYmlFilename)); err != nil {
+		return stacktrace.Propagate(err, "'%v' isn't the root directory of a Kurtosis package, as it has no '%s'", packageDirArg, kurtosisYmlFilename)
+	}
+	return nil
+}
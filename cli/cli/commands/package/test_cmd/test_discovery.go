@@ -0,0 +1,92 @@
+package test_cmd
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/syntax"
+)
+
+const (
+	testFileSuffix         = "_test.star"
+	testFunctionNamePrefix = "test_"
+
+	hiddenDirPrefix = "."
+
+	noParseMode syntax.Mode = 0
+)
+
+// packageTest is a test function of a test file, which runs as the main function of the package in its own enclave
+type packageTest struct {
+	// relative to the root of the package, as the API container expects it
+	RelativeFilePath string
+
+	FunctionName string
+}
+
+func (test *packageTest) String() string {
+	return test.RelativeFilePath + "::" + test.FunctionName
+}
+
+// discoverTests returns the 'test_*' functions defined at the top level of the '*_test.star' files of the package, in
+// the order they're defined in, with the files sorted by path
+func discoverTests(packageRootPath string) ([]*packageTest, error) {
+	tests := []*packageTest{}
+	err := filepath.WalkDir(packageRootPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != packageRootPath && strings.HasPrefix(entry.Name(), hiddenDirPrefix) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(entry.Name(), testFileSuffix) {
+			return nil
+		}
+		relativeFilePath, err := filepath.Rel(packageRootPath, path)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to the package root '%v'", path, packageRootPath)
+		}
+		fileContent, err := os.ReadFile(path)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading test file '%v'", path)
+		}
+		functionNames, err := getTestFunctionNames(relativeFilePath, fileContent)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred finding the tests of '%v'", path)
+		}
+		for _, functionName := range functionNames {
+			tests = append(tests, &packageTest{
+				RelativeFilePath: filepath.ToSlash(relativeFilePath),
+				FunctionName:     functionName,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred looking for test files in '%v'", packageRootPath)
+	}
+	return tests, nil
+}
+
+// getTestFunctionNames parses the file rather than interpreting it, so discovering tests doesn't need an enclave
+func getTestFunctionNames(filePath string, fileContent []byte) ([]string, error) {
+	file, err := syntax.Parse(filePath, fileContent, noParseMode)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Test file '%v' isn't valid Starlark", filePath)
+	}
+	functionNames := []string{}
+	for _, stmt := range file.Stmts {
+		def, ok := stmt.(*syntax.DefStmt)
+		if !ok || !strings.HasPrefix(def.Name.Name, testFunctionNamePrefix) {
+			continue
+		}
+		functionNames = append(functionNames, def.Name.Name)
+	}
+	return functionNames, nil
+}
@@ -0,0 +1,49 @@
+package test_cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverTests(t *testing.T) {
+	packageRootPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(packageRootPath, "main.star"), []byte("def test_not_in_a_test_file(plan):\n    pass\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(packageRootPath, "main_test.star"), []byte(`def test_add_service(plan):
+    assert_eq(1, 1)
+
+def helper(plan):
+    def test_nested(plan):
+        pass
+
+def test_upload_files(plan):
+    pass
+`), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(packageRootPath, "lib"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(packageRootPath, "lib", "lib_test.star"), []byte("def test_lib(plan):\n    pass\n"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(packageRootPath, ".git"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(packageRootPath, ".git", "hidden_test.star"), []byte("not starlark"), 0644))
+
+	tests, err := discoverTests(packageRootPath)
+	require.NoError(t, err)
+	testStrs := []string{}
+	for _, test := range tests {
+		testStrs = append(testStrs, test.String())
+	}
+	require.Equal(t, []string{
+		"lib/lib_test.star::test_lib",
+		"main_test.star::test_add_service",
+		"main_test.star::test_upload_files",
+	}, testStrs)
+}
+
+func TestDiscoverTests_InvalidTestFile(t *testing.T) {
+	packageRootPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(packageRootPath, "main_test.star"), []byte("def test_add_service(plan)\n    pass\n"), 0644))
+
+	_, err := discoverTests(packageRootPath)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Test file 'main_test.star' isn't valid Starlark")
+}
@@ -0,0 +1,264 @@
+package forward
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"syscall"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/port_forward_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifiersArgKey        = "services"
+	isServiceIdentifiersArgOptional = true
+	isServiceIdentifiersArgGreedy   = true
+
+	portIdFlagKey     = "port-id"
+	portIdFlagDefault = ""
+
+	backgroundFlagKey     = "background"
+	backgroundFlagDefault = "false"
+
+	// The public ports of the services are bound on the host running Kurtosis, or on the gateway on Kubernetes
+	publicPortsIpAddress = "127.0.0.1"
+
+	serviceColumnHeader       = "Service"
+	portIdColumnHeader        = "Port ID"
+	localAddressColumnHeader  = "Local Address"
+	remoteAddressColumnHeader = "Remote Address"
+)
+
+var PortForwardCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.PortForwardCmdStr,
+	ShortDescription: "Forwards the ports of services to local ports",
+	LongDescription: "Forwards all the TCP ports of the given services, or of all the services of the enclave if none is given, " +
+		"to local ports. Each port is forwarded to the local port with the same number as the port of the service when " +
+		"it's available, and to a random local port otherwise. The ports are forwarded until the command is interrupted, " +
+		"or in the background with '--" + backgroundFlagKey + "' until '" + command_str_consts.KurtosisCmdStr + " " +
+		command_str_consts.PortCmdStr + " " + command_str_consts.PortForwardCmdStr + " " + command_str_consts.PortForwardStopCmdStr + "' is run",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     portIdFlagKey,
+			Usage:   "Only forward the port with this ID, e.g. to forward a single port of a service",
+			Type:    flags.FlagType_String,
+			Default: portIdFlagDefault,
+		},
+		{
+			Key:     backgroundFlagKey,
+			Usage:   "Keep forwarding the ports in the background after the command returns",
+			Type:    flags.FlagType_Bool,
+			Default: backgroundFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifiersArgKey,
+			enclaveIdentifierArgKey,
+			isServiceIdentifiersArgOptional,
+			isServiceIdentifiersArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifiers, err := args.GetGreedyArg(serviceIdentifiersArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifiers using arg key '%v'", serviceIdentifiersArgKey)
+	}
+
+	portId, err := flags.GetString(portIdFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", portIdFlagKey)
+	}
+
+	isBackground, err := flags.GetBool(backgroundFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", backgroundFlagKey)
+	}
+
+	if isBackground {
+		// The ports are forwarded by a new process running this same command in the foreground
+		backgroundCliArgs := []string{command_str_consts.PortCmdStr, command_str_consts.PortForwardCmdStr, enclaveIdentifier}
+		backgroundCliArgs = append(backgroundCliArgs, serviceIdentifiers...)
+		if portId != portIdFlagDefault {
+			backgroundCliArgs = append(backgroundCliArgs, fmt.Sprintf("--%s=%s", portIdFlagKey, portId))
+		}
+		session, err := port_forward_manager.StartSessionInBackground(backgroundCliArgs)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred forwarding the ports of enclave '%v' in the background", enclaveIdentifier)
+		}
+		printForwardedPorts(session.ForwardedPorts)
+		out.PrintOutLn(fmt.Sprintf(
+			"Forwarding the ports in the background on PID %d. Run '%s %s %s %s %s' to stop it",
+			session.Pid,
+			command_str_consts.KurtosisCmdStr,
+			command_str_consts.PortCmdStr,
+			command_str_consts.PortForwardCmdStr,
+			command_str_consts.PortForwardStopCmdStr,
+			enclaveIdentifier,
+		))
+		return nil
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	portForwarder, err := forwardServicePorts(enclaveCtx, serviceIdentifiers, portId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred forwarding the ports of enclave '%v'", enclaveIdentifier)
+	}
+	defer portForwarder.Stop()
+
+	session := &port_forward_manager.PortForwardSession{
+		Pid:            os.Getpid(),
+		EnclaveName:    enclaveCtx.GetEnclaveName(),
+		EnclaveUuid:    string(enclaveCtx.GetEnclaveUuid()),
+		IsBackground:   false,
+		LogFilePath:    "",
+		ForwardedPorts: portForwarder.GetForwardedPorts(),
+	}
+	if err := port_forward_manager.SaveSession(session); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the port forward session")
+	}
+	defer func() {
+		if err := port_forward_manager.RemoveSession(session.Pid); err != nil {
+			logrus.Warnf("The ports are not forwarded anymore but the port forward session couldn't be removed, it will be cleaned up on the next '%s %s %s %s'", command_str_consts.KurtosisCmdStr, command_str_consts.PortCmdStr, command_str_consts.PortForwardCmdStr, command_str_consts.PortForwardStatusCmdStr)
+			logrus.Debugf("Error was: %v", err.Error())
+		}
+	}()
+
+	printForwardedPorts(session.ForwardedPorts)
+	out.PrintOutLn("Forwarding the ports, press Ctrl+C to stop")
+
+	ctxUntilInterrupted, stopNotifying := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopNotifying()
+	portForwarder.Run(ctxUntilInterrupted)
+	return nil
+}
+
+// forwardServicePorts starts listening locally for the TCP ports of the services, or all the services of the enclave
+// if none is given
+func forwardServicePorts(enclaveCtx *enclaves.EnclaveContext, serviceIdentifiers []string, portId string) (*port_forward_manager.PortForwarder, error) {
+	serviceIdentifiersToGet := map[string]bool{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceIdentifiersToGet[serviceIdentifier] = true
+	}
+	serviceContexts, err := enclaveCtx.GetServiceContexts(serviceIdentifiersToGet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services '%v'", serviceIdentifiers)
+	}
+	serviceNames := []string{}
+	for serviceName := range serviceContexts {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	sort.Strings(serviceNames)
+
+	portForwarder := port_forward_manager.NewPortForwarder()
+	for _, serviceName := range serviceNames {
+		serviceCtx := serviceContexts[services.ServiceName(serviceName)]
+		publicPorts := serviceCtx.GetPublicPorts()
+		for _, servicePortId := range getSortedPortIds(serviceCtx.GetPrivatePorts()) {
+			if portId != portIdFlagDefault && servicePortId != portId {
+				continue
+			}
+			privatePort := serviceCtx.GetPrivatePorts()[servicePortId]
+			if privatePort.GetTransportProtocol() != services.TransportProtocol_TCP {
+				logrus.Warnf("Skipping port '%v' of service '%v' as only TCP ports can be forwarded", servicePortId, serviceName)
+				continue
+			}
+			publicPort, found := publicPorts[servicePortId]
+			if !found {
+				logrus.Warnf("Skipping port '%v' of service '%v' as it's not reachable from outside the enclave", servicePortId, serviceName)
+				continue
+			}
+			remoteAddress := net.JoinHostPort(publicPortsIpAddress, strconv.Itoa(int(publicPort.GetNumber())))
+			if _, err := portForwarder.AddForward(serviceName, servicePortId, privatePort.GetNumber(), remoteAddress); err != nil {
+				portForwarder.Stop()
+				return nil, stacktrace.Propagate(err, "An error occurred forwarding port '%v' of service '%v'", servicePortId, serviceName)
+			}
+		}
+	}
+
+	if len(portForwarder.GetForwardedPorts()) == 0 {
+		if portId != portIdFlagDefault {
+			return nil, stacktrace.NewError("None of the services '%v' has a TCP port with ID '%v' to forward", serviceNames, portId)
+		}
+		return nil, stacktrace.NewError("None of the services '%v' has a TCP port to forward", serviceNames)
+	}
+	return portForwarder, nil
+}
+
+func getSortedPortIds(ports map[string]*services.PortSpec) []string {
+	portIds := []string{}
+	for portId := range ports {
+		portIds = append(portIds, portId)
+	}
+	sort.Strings(portIds)
+	return portIds
+}
+
+func printForwardedPorts(forwardedPorts []*port_forward_manager.ForwardedPort) {
+	tablePrinter := output_printers.NewTablePrinter(serviceColumnHeader, portIdColumnHeader, localAddressColumnHeader, remoteAddressColumnHeader)
+	for _, forwardedPort := range forwardedPorts {
+		if err := tablePrinter.AddRow(forwardedPort.ServiceName, forwardedPort.PortId, forwardedPort.GetLocalAddress(), forwardedPort.RemoteAddress); err != nil {
+			logrus.Errorf("An error occurred adding the row of port '%v' of service '%v' to the table:\n%v", forwardedPort.PortId, forwardedPort.ServiceName, err)
+		}
+	}
+	tablePrinter.Print()
+}
@@ -0,0 +1,82 @@
+package status
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/port_forward_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	pidColumnHeader          = "PID"
+	enclaveColumnHeader      = "Enclave"
+	modeColumnHeader         = "Mode"
+	serviceColumnHeader      = "Service"
+	portIdColumnHeader       = "Port ID"
+	localAddressColumnHeader = "Local Address"
+
+	backgroundModeStr = "background"
+	foregroundModeStr = "foreground"
+)
+
+var PortForwardStatusCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:               command_str_consts.PortForwardStatusCmdStr,
+	ShortDescription:         "Lists the forwarded ports",
+	LongDescription:          "Lists the ports currently forwarded by port forward commands, whether they run in the background or in another terminal",
+	Flags:                    []*flags.FlagConfig{},
+	Args:                     []*args.ArgConfig{},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error {
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+
+	sessions, err := port_forward_manager.GetSessions()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the port forward sessions")
+	}
+
+	if outputFormat.IsStructured() {
+		if err = output_printers.PrintStructuredOutput(outputFormat, sessions); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the port forward sessions as '%v'", outputFormat)
+		}
+		return nil
+	}
+
+	if len(sessions) == 0 {
+		out.PrintOutLn("No ports are being forwarded")
+		return nil
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(pidColumnHeader, enclaveColumnHeader, modeColumnHeader, serviceColumnHeader, portIdColumnHeader, localAddressColumnHeader)
+	for _, session := range sessions {
+		mode := foregroundModeStr
+		if session.IsBackground {
+			mode = backgroundModeStr
+		}
+		for _, forwardedPort := range session.ForwardedPorts {
+			if err = tablePrinter.AddRow(strconv.Itoa(session.Pid), session.EnclaveName, mode, forwardedPort.ServiceName, forwardedPort.PortId, forwardedPort.GetLocalAddress()); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding port '%v' of service '%v' to the table", forwardedPort.PortId, forwardedPort.ServiceName)
+			}
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
@@ -0,0 +1,74 @@
+package stop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/port_forward_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Not validated against the running enclaves, so that the ports of an enclave that was removed can be stopped too
+	enclaveIdentifierArgKey     = "enclave"
+	isEnclaveIdArgOptional      = true
+	isEnclaveIdArgGreedy        = false
+	enclaveIdentifierArgDefault = ""
+)
+
+var PortForwardStopCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PortForwardStopCmdStr,
+	ShortDescription: "Stops forwarding ports",
+	LongDescription:  "Stops the port forwards of the given enclave, or all of them if no enclave is given",
+	Flags:            []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		{
+			Key:                   enclaveIdentifierArgKey,
+			IsOptional:            isEnclaveIdArgOptional,
+			DefaultValue:          enclaveIdentifierArgDefault,
+			IsGreedy:              isEnclaveIdArgGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	sessions, err := port_forward_manager.GetSessions()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the port forward sessions")
+	}
+
+	numberOfStoppedSessions := 0
+	for _, session := range sessions {
+		if enclaveIdentifier != enclaveIdentifierArgDefault && !session.IsForEnclave(enclaveIdentifier) {
+			continue
+		}
+		if err := port_forward_manager.StopSession(session); err != nil {
+			return stacktrace.Propagate(err, "An error occurred stopping the port forward of enclave '%v' on PID %d", session.EnclaveName, session.Pid)
+		}
+		logrus.Debugf("Stopped the port forward of enclave '%v' on PID %d", session.EnclaveName, session.Pid)
+		numberOfStoppedSessions++
+	}
+
+	if numberOfStoppedSessions == 0 {
+		out.PrintOutLn("No port forward to stop")
+		return nil
+	}
+	out.PrintOutLn(fmt.Sprintf("Stopped %d port forward(s)", numberOfStoppedSessions))
+	return nil
+}
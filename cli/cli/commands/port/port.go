@@ -2,6 +2,9 @@ package port
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/forward"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/forward/status"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/forward/stop"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/print"
 	"github.com/spf13/cobra"
 )
@@ -16,4 +19,10 @@ var PortCmd = &cobra.Command{
 
 func init() {
 	PortCmd.AddCommand(print.PortPrintCmd.MustGetCobraCommand())
+
+	// status and stop manage the ports forwarded by any port forward command, so they sit under it
+	portForwardCmd := forward.PortForwardCmd.MustGetCobraCommand()
+	portForwardCmd.AddCommand(status.PortForwardStatusCmd.MustGetCobraCommand())
+	portForwardCmd.AddCommand(stop.PortForwardStopCmd.MustGetCobraCommand())
+	PortCmd.AddCommand(portForwardCmd)
 }
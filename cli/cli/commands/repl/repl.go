@@ -0,0 +1,207 @@
+package repl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	command_args_run "github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	firstLinePrompt        = ">>> "
+	continuationLinePrompt = "... "
+
+	interruptChanBufferSize = 5
+
+	replVerbosity = command_args_run.Brief
+	isDryRun      = false
+)
+
+var ReplCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ReplCmdStr,
+	ShortDescription: "Interprets Starlark interactively against an enclave",
+	LongDescription: "Starts an interactive session interpreting Starlark against the given enclave, the same way as a " +
+		"script passed to '" + command_str_consts.KurtosisCmdStr + " " + command_str_consts.StarlarkRunCmdStr + "'. " +
+		"Every entry is run as soon as it's complete, with the 'plan' object and all the variables defined before it " +
+		"available. The services already in the enclave can be referenced with 'plan.get_service(name)'",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	if err := printWelcomeMessage(enclaveCtx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred printing the welcome message of the REPL")
+	}
+
+	session := newReplSession()
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		entry, err := readEntry(reader, printPrompt)
+		if err == io.EOF {
+			out.PrintOutLn("")
+			return nil
+		}
+		if err != nil {
+			out.PrintErrLn(output_printers.FormatError(out.GetErrorMessageToBeDisplayedOnCli(err).Error()))
+			continue
+		}
+		if entry.isEmpty {
+			continue
+		}
+
+		isEntrySuccessful, err := runEntry(ctx, enclaveCtx, session.getScriptWithEntry(entry))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred running the entry in enclave '%v'", enclaveIdentifier)
+		}
+		// Only the entries that ran successfully are kept, so that a mistake doesn't prevent running the next ones
+		if isEntrySuccessful {
+			session.addEntry(entry)
+		}
+	}
+}
+
+func printWelcomeMessage(enclaveCtx *enclaves.EnclaveContext) error {
+	serviceNamesToUuids, err := enclaveCtx.GetServices()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services in enclave '%v'", enclaveCtx.GetEnclaveName())
+	}
+	serviceNames := []string{}
+	for serviceName := range serviceNamesToUuids {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	sort.Strings(serviceNames)
+
+	out.PrintOutLn(fmt.Sprintf("Interpreting Starlark against enclave '%v'. Press Ctrl+D to exit.", enclaveCtx.GetEnclaveName()))
+	if len(serviceNames) > 0 {
+		out.PrintOutLn(fmt.Sprintf("Services in the enclave, available through plan.get_service(name): %v", strings.Join(serviceNames, ", ")))
+	}
+	return nil
+}
+
+func printPrompt(isFirstLine bool) {
+	prompt := continuationLinePrompt
+	if isFirstLine {
+		prompt = firstLinePrompt
+	}
+	fmt.Fprint(out.GetOut(), prompt)
+}
+
+// runEntry runs the script and prints its output, leaving out the instructions that already ran as part of the
+// previous entries. It returns whether the run was successful
+func runEntry(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, script string) (bool, error) {
+	responseLineChan, cancelFunc, err := enclaveCtx.RunStarlarkScript(ctx, script, starlark_run_config.NewRunStarlarkConfig())
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred running the Starlark script")
+	}
+	defer cancelFunc()
+
+	// Interrupting a run brings back the prompt instead of exiting the REPL
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+	defer signal.Stop(interruptChan)
+
+	printer := output_printers.NewExecutionPrinter()
+	if err := printer.Start(); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred starting the printer")
+	}
+	defer printer.Stop()
+
+	isRunSuccessful := false
+	isPreviousInstructionSkipped := false
+	for {
+		select {
+		case responseLine, isChanOpen := <-responseLineChan:
+			if !isChanOpen {
+				return isRunSuccessful, nil
+			}
+			if responseLine.GetRunFinishedEvent() != nil {
+				isRunSuccessful = responseLine.GetRunFinishedEvent().GetIsRunSuccessful()
+				continue
+			}
+			if !shouldPrintResponseLine(responseLine, isPreviousInstructionSkipped) {
+				isPreviousInstructionSkipped = responseLine.GetInstruction() != nil
+				continue
+			}
+			isPreviousInstructionSkipped = false
+			if err := printer.PrintKurtosisExecutionResponseLineToStdOut(responseLine, replVerbosity, isDryRun); err != nil {
+				logrus.Errorf("An error occurred printing the output of the entry, the output printed here is incomplete. Error was:\n%v", err.Error())
+			}
+		case <-interruptChan:
+			out.PrintOutLn("")
+			logrus.Warnf("Interrupted the entry. Note that its execution continues in the enclave")
+			return false, nil
+		}
+	}
+}
+
+// shouldPrintResponseLine leaves out the instructions replayed from the previous entries along with their result
+func shouldPrintResponseLine(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, isPreviousInstructionSkipped bool) bool {
+	if responseLine.GetInstruction() != nil {
+		return !responseLine.GetInstruction().GetIsSkipped()
+	}
+	if responseLine.GetInstructionResult() != nil {
+		return !isPreviousInstructionSkipped
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+package repl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/syntax"
+)
+
+const (
+	replFilename = "<repl>"
+
+	mainFunctionSignature = "def run(plan):"
+	statementIndentation  = "    "
+	// The body of the main function can't be empty, this keeps the script valid until a statement is entered
+	passStatement = "pass"
+
+	planParamName        = "plan"
+	planPrintInstruction = "plan.print"
+
+	newlineChar = "\n"
+)
+
+// replEntry is a single unit of input of the REPL, i.e. a blank line, a compound statement (def, for, if...) or a
+// list of simple statements
+type replEntry struct {
+	source string
+
+	isLoadStatement bool
+
+	isEmpty bool
+}
+
+// replSession keeps the entries that ran successfully during the session, so that every new entry is run with all the
+// variables and functions defined before it. Because the enclave skips the instructions it has already run, replaying
+// the previous entries doesn't run their instructions again
+type replSession struct {
+	loadStatements []string
+
+	statements []string
+}
+
+func newReplSession() *replSession {
+	return &replSession{
+		loadStatements: []string{},
+		statements:     []string{},
+	}
+}
+
+// getScriptWithEntry returns the script to run for the entry: the previous entries followed by this one
+func (session *replSession) getScriptWithEntry(entry *replEntry) string {
+	loadStatements := session.loadStatements
+	statements := session.statements
+	if entry.isLoadStatement {
+		loadStatements = append(loadStatements[:len(loadStatements):len(loadStatements)], entry.source)
+	} else {
+		statements = append(statements[:len(statements):len(statements)], entry.source)
+	}
+
+	script := strings.Builder{}
+	for _, loadStatement := range loadStatements {
+		script.WriteString(loadStatement)
+		script.WriteString(newlineChar)
+	}
+	script.WriteString(mainFunctionSignature)
+	script.WriteString(newlineChar)
+	script.WriteString(statementIndentation)
+	script.WriteString(passStatement)
+	script.WriteString(newlineChar)
+	for _, statement := range statements {
+		for _, line := range strings.Split(strings.TrimRight(statement, newlineChar), newlineChar) {
+			script.WriteString(statementIndentation)
+			script.WriteString(line)
+			script.WriteString(newlineChar)
+		}
+	}
+	return script.String()
+}
+
+func (session *replSession) addEntry(entry *replEntry) {
+	if entry.isLoadStatement {
+		session.loadStatements = append(session.loadStatements, entry.source)
+		return
+	}
+	session.statements = append(session.statements, entry.source)
+}
+
+// readEntry reads lines from the reader until they form a complete entry, calling printPrompt before reading each
+// line with whether it's the first line of the entry. It returns io.EOF once the input is exhausted
+func readEntry(reader *bufio.Reader, printPrompt func(isFirstLine bool)) (*replEntry, error) {
+	isFirstLine := true
+	isEof := false
+	lines := []string{}
+	readline := func() ([]byte, error) {
+		printPrompt(isFirstLine)
+		isFirstLine = false
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, stacktrace.Propagate(err, "An error occurred reading a line of input")
+		}
+		if err == io.EOF {
+			if line == "" {
+				isEof = true
+				return nil, io.EOF
+			}
+			line = line + newlineChar
+		}
+		lines = append(lines, line)
+		return []byte(line), nil
+	}
+
+	parsedEntry, err := syntax.ParseCompoundStmt(replFilename, readline)
+	if err != nil {
+		if isEof {
+			return nil, io.EOF
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the entered Starlark")
+	}
+	if len(parsedEntry.Stmts) == 0 {
+		return &replEntry{
+			source:          "",
+			isLoadStatement: false,
+			isEmpty:         true,
+		}, nil
+	}
+
+	source := strings.TrimRight(strings.Join(lines, ""), newlineChar)
+	if _, isLoadStatement := parsedEntry.Stmts[0].(*syntax.LoadStmt); isLoadStatement {
+		return &replEntry{
+			source:          source,
+			isLoadStatement: true,
+			isEmpty:         false,
+		}, nil
+	}
+	// Like in any REPL, entering an expression prints its value. Calls to the plan are left untouched as their
+	// instruction already prints its result
+	if expression := getSoleExpression(parsedEntry); expression != nil && !isPlanCall(expression) {
+		source = fmt.Sprintf("%s(%s)", planPrintInstruction, getSourceOfExpression(lines, expression))
+	}
+	return &replEntry{
+		source:          source,
+		isLoadStatement: false,
+		isEmpty:         false,
+	}, nil
+}
+
+func getSoleExpression(parsedEntry *syntax.File) syntax.Expr {
+	if len(parsedEntry.Stmts) != 1 {
+		return nil
+	}
+	expressionStatement, isExpressionStatement := parsedEntry.Stmts[0].(*syntax.ExprStmt)
+	if !isExpressionStatement {
+		return nil
+	}
+	return expressionStatement.X
+}
+
+// getSourceOfExpression returns the source of the expression alone, i.e. without the comments around it
+func getSourceOfExpression(lines []string, expression syntax.Expr) string {
+	start, end := expression.Span()
+	expressionLines := []string{}
+	for lineNumber := start.Line; lineNumber <= end.Line; lineNumber++ {
+		line := []rune(strings.TrimRight(lines[lineNumber-1], newlineChar))
+		lineEnd := len(line)
+		if lineNumber == end.Line {
+			lineEnd = int(end.Col) - 1
+		}
+		lineStart := 0
+		if lineNumber == start.Line {
+			lineStart = int(start.Col) - 1
+		}
+		expressionLines = append(expressionLines, string(line[lineStart:lineEnd]))
+	}
+	return strings.Join(expressionLines, newlineChar)
+}
+
+func isPlanCall(expression syntax.Expr) bool {
+	call, isCall := expression.(*syntax.CallExpr)
+	if !isCall {
+		return false
+	}
+	attribute, isAttribute := call.Fn.(*syntax.DotExpr)
+	if !isAttribute {
+		return false
+	}
+	receiver, isIdentifier := attribute.X.(*syntax.Ident)
+	return isIdentifier && receiver.Name == planParamName
+}
@@ -0,0 +1,71 @@
+package repl
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func noPrompt(_ bool) {}
+
+func readAllEntries(t *testing.T, input string) []*replEntry {
+	reader := bufio.NewReader(strings.NewReader(input))
+	entries := []*replEntry{}
+	for {
+		entry, err := readEntry(reader, noPrompt)
+		if err == io.EOF {
+			return entries
+		}
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+}
+
+func TestReadEntry_SimpleStatementsAndBlocks(t *testing.T) {
+	entries := readAllEntries(t, "x = 1\n\nfor i in range(2):\n    plan.print(i)\n\nplan.print(x)\n")
+	require.Len(t, entries, 4)
+	require.Equal(t, "x = 1", entries[0].source)
+	require.True(t, entries[1].isEmpty)
+	require.Equal(t, "for i in range(2):\n    plan.print(i)", entries[2].source)
+	require.Equal(t, "plan.print(x)", entries[3].source)
+}
+
+func TestReadEntry_ExpressionIsPrinted(t *testing.T) {
+	entries := readAllEntries(t, "x + 1  # a comment\n")
+	require.Len(t, entries, 1)
+	require.Equal(t, "plan.print(x + 1)", entries[0].source)
+}
+
+func TestReadEntry_LoadStatement(t *testing.T) {
+	entries := readAllEntries(t, "lib = import_module(\"github.com/foo/bar/lib.star\")\nload(\"github.com/foo/bar/main.star\", \"helper\")\n")
+	require.Len(t, entries, 2)
+	require.False(t, entries[0].isLoadStatement)
+	require.True(t, entries[1].isLoadStatement)
+}
+
+func TestReadEntry_SyntaxError(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader("x = = 1\n"))
+	_, err := readEntry(reader, noPrompt)
+	require.Error(t, err)
+}
+
+func TestGetScriptWithEntry(t *testing.T) {
+	session := newReplSession()
+	session.addEntry(&replEntry{source: "x = 1", isLoadStatement: false, isEmpty: false})
+	session.addEntry(&replEntry{source: "load(\"lib.star\", \"helper\")", isLoadStatement: true, isEmpty: false})
+
+	script := session.getScriptWithEntry(&replEntry{source: "if x:\n    plan.print(x)", isLoadStatement: false, isEmpty: false})
+	expectedScript := `load("lib.star", "helper")
+def run(plan):
+    pass
+    x = 1
+    if x:
+        plan.print(x)
+`
+	require.Equal(t, expectedScript, script)
+	// the entry is only kept once it's added
+	require.Len(t, session.statements, 1)
+}
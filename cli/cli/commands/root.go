@@ -6,6 +6,7 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/grafloki"
@@ -16,7 +17,10 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	api_kurtosis_context "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/grpc_compression"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/airgap"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/analytics"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/clean"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/cloud"
@@ -24,6 +28,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/discord"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/docs"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/doctor"
 	kurtosisdump "github.com/kurtosis-tech/kurtosis/cli/cli/commands/dump"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine"
@@ -38,15 +43,19 @@ import (
 	_package "github.com/kurtosis-tech/kurtosis/cli/cli/commands/package"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/portal"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/repl"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/twitter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/web"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_login"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_send_metrics_election"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
@@ -80,6 +89,8 @@ const (
 	getLatestCLIReleaseCacheFilePermissions os.FileMode = 0644
 
 	optionalSemverPrefix = "v"
+
+	engineLoginRefreshTimeout = 30 * time.Second
 )
 
 type GitHubReleaseReponse struct {
@@ -115,7 +126,14 @@ func init() {
 		defaults.DefaultEnableDebugMode,
 		"Whether should enable Kurtosis in debug mode. The debug mode will use the Kurtosis container debug images version (only enabled for the engine server so far)",
 	)
+	RootCmd.PersistentFlags().StringP(
+		defaults.OutputFormatFlagKey,
+		defaults.OutputFormatFlagShorthand,
+		defaults.DefaultOutputFormat,
+		"Prints the result of the ls and inspect commands as machine-readable 'json' or 'yaml' instead of tables",
+	)
 
+	RootCmd.AddCommand(airgap.AirgapCmd)
 	RootCmd.AddCommand(analytics.AnalyticsCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(clean.CleanCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(cluster.ClusterCmd)
@@ -125,6 +143,7 @@ func init() {
 	RootCmd.AddCommand(config.ConfigCmd)
 	RootCmd.AddCommand(discord.DiscordCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(docs.DocsCmd.MustGetCobraCommand())
+	RootCmd.AddCommand(doctor.DoctorCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(enclave.EnclaveCmd)
 	RootCmd.AddCommand(engine.EngineCmd)
 	RootCmd.AddCommand(feedback.FeedbackCmd.MustGetCobraCommand())
@@ -134,6 +153,7 @@ func init() {
 	RootCmd.AddCommand(lint.LintCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(port.PortCmd)
 	RootCmd.AddCommand(portal.PortalCmd)
+	RootCmd.AddCommand(repl.ReplCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(run.StarlarkRunCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(service.ServiceCmd)
 	RootCmd.AddCommand(_import.ImportCmd.MustGetCobraCommand())
@@ -160,11 +180,73 @@ func globalSetup(cmd *cobra.Command, args []string) error {
 		//We don't want to interrupt users flow if something fails when tracking metrics
 		logrus.Debugf("An error occurred tracking user consent to send metrics election\n%v", err)
 	}
+	if err := setGrpcCompressionFromConfig(); err != nil {
+		//The calls work the same without compression, so we don't want to interrupt users flow either
+		logrus.Debugf("An error occurred setting the gRPC compression from the Kurtosis config\n%v", err)
+	}
+	if err := setEngineTokenFromLogin(); err != nil {
+		//Engines that don't require authentication don't need the token, so we only warn
+		logrus.Warnf("The ID token of 'kurtosis %v %v' couldn't be used; you may need to log in again\n%v", command_str_consts.EngineCmdStr, command_str_consts.EngineLoginCmdStr, err)
+	}
 
 	printKurtosisCommandToFile(cmd, args)
 	return nil
 }
 
+// setGrpcCompressionFromConfig makes the gRPC clients compress their streaming calls with the compression set in the
+// Kurtosis config, unless the environment variable picking it is already set. The config isn't created if it doesn't
+// exist yet, so that commands not needing it don't ask the user for the metrics election
+func setGrpcCompressionFromConfig() error {
+	if _, isSet := os.LookupEnv(api_kurtosis_context.GrpcCompressionEnvVar); isSet {
+		return nil
+	}
+	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
+	hasConfig, err := kurtosisConfigStore.HasConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking if the Kurtosis config exists")
+	}
+	if !hasConfig {
+		return nil
+	}
+	kurtosisConfig, err := kurtosisConfigStore.GetConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis config")
+	}
+	grpcCompression := kurtosisConfig.GetGrpcCompression()
+	if grpcCompression == grpc_compression.NoCompression {
+		return nil
+	}
+	if err = os.Setenv(api_kurtosis_context.GrpcCompressionEnvVar, string(grpcCompression)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the '%v' environment variable", api_kurtosis_context.GrpcCompressionEnvVar)
+	}
+	return nil
+}
+
+// setEngineTokenFromLogin makes the CLI send the ID token 'kurtosis engine login' got for the current cluster to its
+// engine, unless the environment variable holding the engine token is already set
+func setEngineTokenFromLogin() error {
+	if _, isSet := os.LookupEnv(api_kurtosis_context.EngineTokenEnvVar); isSet {
+		return nil
+	}
+	clusterName, err := kurtosis_config_getter.GetKurtosisClusterName()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the name of the current cluster")
+	}
+	ctx, cancelFunc := context.WithTimeout(context.Background(), engineLoginRefreshTimeout)
+	defer cancelFunc()
+	idToken, err := engine_login.GetFreshIdToken(ctx, clusterName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the ID token for cluster '%v'", clusterName)
+	}
+	if idToken == "" {
+		return nil
+	}
+	if err = os.Setenv(api_kurtosis_context.EngineTokenEnvVar, idToken); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the '%v' environment variable", api_kurtosis_context.EngineTokenEnvVar)
+	}
+	return nil
+}
+
 func printKurtosisCommandToFile(cmd *cobra.Command, args []string) {
 	fileLogger := out.GetFileLogger()
 	flagsSetByUsers := getFlagsSetByUsers(cmd.Flags())
@@ -205,6 +287,10 @@ func checkCLIVersion(cmd *cobra.Command) {
 	if !shouldPesterUsersAboutVersions() {
 		return
 	}
+	if isClusterAirGapped() {
+		// GitHub, where the latest version is read from, is unreachable
+		return
+	}
 
 	isLatestVersion, latestVersion, err := isLatestCLIVersion()
 	if err != nil {
@@ -404,6 +490,21 @@ func getLatestCLIReleaseVersionFromCacheFile(filepath string) (string, error) {
 	return latestReleaseVersion, nil
 }
 
+// isClusterAirGapped returns whether the current cluster is air-gapped. The config isn't created if it doesn't exist
+// yet, and if the check fails we assume it isn't.
+func isClusterAirGapped() bool {
+	hasConfig, err := kurtosis_config.GetKurtosisConfigStore().HasConfig()
+	if err != nil || !hasConfig {
+		return false
+	}
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		logrus.Debugf("Tried getting the Kurtosis cluster config to check if it's air-gapped but failed with error \n'%s'", err)
+		return false
+	}
+	return clusterConfig.GetAirGapConfig().IsEnabled()
+}
+
 // if the check ever fails we just return true and check for versions anyway
 func shouldPesterUsersAboutVersions() bool {
 	lastPesteredUsersAboutVersionsFilepath, err := host_machine_directories.GetLastPesteredUserAboutOldVersionsFilepath()
@@ -5,11 +5,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	yaml_convert "github.com/ghodss/yaml"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
@@ -27,6 +31,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_args_schema"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
@@ -37,8 +42,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/context_defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/interactive_terminal_decider"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/observability"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/prompt_displayer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -118,6 +127,18 @@ const (
 	nonBlockingModeFlagKey = "non-blocking-tasks"
 	defaultBlockingMode    = "false"
 
+	argsHelpFlagKey = "args-help"
+	argsHelpDefault = "false"
+
+	profileFlagKey = "profile"
+	profileDefault = "false"
+
+	watchFlagKey = "watch"
+	watchDefault = "false"
+
+	observabilityFlagKey = "observability"
+	observabilityDefault = "false"
+
 	httpProtocolRegexStr           = "^(http|https)://"
 	shouldCloneNormalRepo          = false
 	packageReplaceKeyInKurtosisYml = "replace:"
@@ -239,6 +260,32 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:    flags.FlagType_Bool,
 			Default: defaultBlockingMode,
 		},
+		{
+			Key:     argsHelpFlagKey,
+			Usage:   "If true, prints the arguments the local package declares in its " + kurtosisYMLFilePath + " and exits without running it.",
+			Type:    flags.FlagType_Bool,
+			Default: argsHelpDefault,
+		},
+		{
+			Key:     profileFlagKey,
+			Usage:   "If true, prints how long each instruction and each container image pull took once the run is over, slowest first.",
+			Type:    flags.FlagType_Bool,
+			Default: profileDefault,
+		},
+		{
+			Key: watchFlagKey,
+			Usage: "If true, keeps watching the directory of the local script or package after the run, and runs it again in " +
+				"the same enclave whenever a file changes. Instructions that didn't change since the previous run are skipped. Stop with Ctrl+C.",
+			Type:    flags.FlagType_Bool,
+			Default: watchDefault,
+		},
+		{
+			Key: observabilityFlagKey,
+			Usage: "If true, adds a Prometheus and a Grafana to the enclave once the run is over. Prometheus scrapes the '/metrics' " +
+				"path of the ports of the services whose ID ends with 'metrics', and Grafana has a dashboard of them with a filter for each service label.",
+			Type:    flags.FlagType_Bool,
+			Default: observabilityDefault,
+		},
 	},
 	Args: []*args.ArgConfig{
 		// TODO add a `Usage` description here when ArgConfig supports it
@@ -277,6 +324,10 @@ func run(
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using flag key '%s'", enclaveIdentifierFlagKey)
 	}
+	contextDefaults := context_defaults.GetCurrentContextDefaults()
+	if userRequestedEnclaveIdentifier == autogenerateEnclaveIdentifierKeyword && contextDefaults.GetEnclaveNamePrefix() != "" {
+		userRequestedEnclaveIdentifier = context_defaults.GenerateEnclaveName(contextDefaults.GetEnclaveNamePrefix())
+	}
 
 	starlarkScriptOrPackagePath, err := args.GetNonGreedyArg(scriptOrPackagePathKey)
 	if err != nil {
@@ -304,7 +355,7 @@ func run(
 	}
 	castedParallelism := int32(parallelism)
 
-	verbosity, err := parseVerbosityFlag(flags)
+	verbosity, err := parseVerbosityFlag(flags, contextDefaults.GetVerbosity())
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the verbosity using flag key '%s'", verbosityFlagKey)
 	}
@@ -353,12 +404,35 @@ func run(
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", packageArgsFileFlagKey)
 	}
+	if !flags.IsSet(packageArgsFileFlagKey) && contextDefaults.GetPackageArgsFile() != "" {
+		packageArgsFile = contextDefaults.GetPackageArgsFile()
+	}
 
 	nonBlockingMode, err := flags.GetBool(nonBlockingModeFlagKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", nonBlockingModeFlagKey)
 	}
 
+	showProfile, err := flags.GetBool(profileFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", profileFlagKey)
+	}
+
+	showArgsHelp, err := flags.GetBool(argsHelpFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", argsHelpFlagKey)
+	}
+
+	shouldWatch, err := flags.GetBool(watchFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", watchFlagKey)
+	}
+
+	withObservability, err := flags.GetBool(observabilityFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", observabilityFlagKey)
+	}
+
 	if packageArgs == inputArgsAreEmptyBracesByDefault && packageArgsFile != packageArgsFileDefaultValue {
 		logrus.Debugf("'%v' is empty but '%v' is provided so we will go with the '%v' value", inputArgsArgKey, packageArgsFileFlagKey, packageArgsFileFlagKey)
 		packageArgs, err = getArgsFromFilepathOrURL(packageArgsFile)
@@ -369,6 +443,28 @@ func run(
 		logrus.Debugf("'%v' arg is not empty; ignoring value of '%v' flag as '%v' arg takes precedence", inputArgsArgKey, packageArgsFileFlagKey, inputArgsArgKey)
 	}
 
+	isRemotePackage := strings.HasPrefix(starlarkScriptOrPackagePath, githubDomainPrefix)
+
+	if showArgsHelp {
+		return printPackageArgsHelp(starlarkScriptOrPackagePath, isRemotePackage)
+	}
+
+	if shouldWatch && isRemotePackage {
+		return stacktrace.NewError("The '%v' flag only works with local scripts and packages, but '%v' is a remote package", watchFlagKey, starlarkScriptOrPackagePath)
+	}
+	if shouldWatch && isDependenciesOnly {
+		return stacktrace.NewError("The '%v' and '%v' flags can't be combined", watchFlagKey, dependenciesFlagKey)
+	}
+
+	// the args schema only describes the default entrypoint of the package, so we only prompt for it
+	isDefaultEntrypoint := relativePathToTheMainFile == mainFileDefaultValue && mainFunctionName == mainFunctionNameDefaultValue
+	if isDefaultEntrypoint && !isRemotePackage && !isDependenciesOnly && interactive_terminal_decider.IsInteractiveTerminal() {
+		packageArgs, err = promptForMissingRequiredPackageArgs(starlarkScriptOrPackagePath, packageArgs)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred prompting for the missing required package args")
+		}
+	}
+
 	starlarkRunConfig := starlark_run_config.NewRunStarlarkConfig(
 		starlark_run_config.WithDryRun(dryRun),
 		starlark_run_config.WithParallelism(castedParallelism),
@@ -402,8 +498,6 @@ func run(
 		defer output_printers.PrintEnclaveName(enclaveCtx.GetEnclaveName())
 	}
 
-	isRemotePackage := strings.HasPrefix(starlarkScriptOrPackagePath, githubDomainPrefix)
-
 	if isDependenciesOnly {
 		dependencyYaml, err := getPackageDependencyYaml(ctx, enclaveCtx, starlarkScriptOrPackagePath, isRemotePackage, packageArgs)
 		if err != nil {
@@ -429,13 +523,19 @@ func run(
 		}
 
 		if pullDependencies {
+			clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the Kurtosis cluster config")
+			}
+			airGapConfig := clusterConfig.GetAirGapConfig()
+
 			// errors below already wrapped w propagate
-			err = pullImagesLocally(ctx, pkgDeps.Images)
+			err = pullImagesLocally(ctx, pkgDeps.Images, airGapConfig)
 			if err != nil {
 				return err
 			}
 
-			packageNamesToLocalFilepaths, err := pullPackagesLocally(pkgDeps.Packages)
+			packageNamesToLocalFilepaths, err := pullPackagesLocally(pkgDeps.Packages, airGapConfig)
 			if err != nil {
 				return err
 			}
@@ -448,47 +548,50 @@ func run(
 		return nil
 	}
 
-	var responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
-	var cancelFunc context.CancelFunc
-	var errRunningKurtosis error
-
 	connect := kurtosis_core_rpc_api_bindings.Connect_CONNECT
 	if noConnect {
 		connect = kurtosis_core_rpc_api_bindings.Connect_NO_CONNECT
 	}
 
-	if isRemotePackage {
-		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, starlarkRunConfig)
-	} else {
-		fileOrDir, err := os.Stat(starlarkScriptOrPackagePath)
-		if err != nil {
-			return stacktrace.Propagate(err, "There was an error reading file or package from disk at '%v'", starlarkScriptOrPackagePath)
-		}
+	responseLineChan, cancelFunc, errRunningKurtosis := startExecution(ctx, enclaveCtx, starlarkScriptOrPackagePath, isRemotePackage, starlarkRunConfig)
+	if errRunningKurtosis != nil {
+		return stacktrace.Propagate(errRunningKurtosis, "An error starting the Kurtosis code execution '%v'", starlarkScriptOrPackagePath)
+	}
 
-		if isStandaloneScript(fileOrDir, kurtosisYMLFilePath) {
-			if !strings.HasSuffix(starlarkScriptOrPackagePath, starlarkExtension) {
-				return stacktrace.NewError("Expected a script with a '%s' extension but got file '%v' with a different extension", starlarkExtension, starlarkScriptOrPackagePath)
+	errRunningKurtosis = ReadAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun, showProfile)
+	if errRunningKurtosis == nil && withObservability && !dryRun {
+		errRunningKurtosis = deployObservability(ctx, kurtosisCtx, enclaveCtx)
+	}
+
+	if shouldWatch {
+		if errRunningKurtosis != nil {
+			logrus.Errorf("The run failed; it will run again on the next change:\n%v", errRunningKurtosis)
+		}
+		if err = enclaveCtx.ConnectServices(ctx, connect); err != nil {
+			logrus.Warnf("An error occurred configuring the user services port forwarding\nError was: %v", err)
+		}
+		// Running the package again in the same enclave only executes the instructions that changed
+		errRunningKurtosis = watchAndRerun(ctx, starlarkScriptOrPackagePath, func() error {
+			rerunResponseLineChan, rerunCancelFunc, err := startExecution(ctx, enclaveCtx, starlarkScriptOrPackagePath, isRemotePackage, starlarkRunConfig)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error starting the Kurtosis code execution '%v'", starlarkScriptOrPackagePath)
 			}
-			responseLineChan, cancelFunc, errRunningKurtosis = executeScript(ctx, enclaveCtx, starlarkScriptOrPackagePath, starlarkRunConfig)
-		} else {
-			// if the path is a file with `kurtosis.yml` at the end it's a module dir
-			// we remove the `kurtosis.yml` to get just the Dir containing the module
-			if isKurtosisYMLFileInPackageDir(fileOrDir, kurtosisYMLFilePath) {
-				starlarkScriptOrPackagePath = path.Dir(starlarkScriptOrPackagePath)
+			if err = ReadAndPrintResponseLinesUntilClosed(rerunResponseLineChan, rerunCancelFunc, verbosity, dryRun, showProfile); err != nil {
+				return err
 			}
-			// we pass the sanitized path and look for a Kurtosis YML within it to get the package name
-			if err != nil {
-				return stacktrace.Propagate(err, "Tried parsing Kurtosis YML at '%v' to get package name but failed", starlarkScriptOrPackagePath)
+			if withObservability && !dryRun {
+				// the services added by the new run get scraped too
+				if err = deployObservability(ctx, kurtosisCtx, enclaveCtx); err != nil {
+					return err
+				}
 			}
-			responseLineChan, cancelFunc, errRunningKurtosis = executePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, starlarkRunConfig)
-		}
-	}
-	if errRunningKurtosis != nil {
-		return stacktrace.Propagate(errRunningKurtosis, "An error starting the Kurtosis code execution '%v'", starlarkScriptOrPackagePath)
+			if err = enclaveCtx.ConnectServices(ctx, connect); err != nil {
+				logrus.Warnf("An error occurred configuring the user services port forwarding\nError was: %v", err)
+			}
+			return nil
+		})
 	}
 
-	errRunningKurtosis = ReadAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun)
-
 	if err = enclaveCtx.ConnectServices(ctx, connect); err != nil {
 		logrus.Warnf("An error occurred configuring the user services port forwarding\nError was: %v", err)
 	}
@@ -562,6 +665,36 @@ func run(
 //	Private Helper Functions
 //
 // ====================================================================================================
+// startExecution runs the standalone script, the local package or the remote package in the enclave
+func startExecution(
+	ctx context.Context,
+	enclaveCtx *enclaves.EnclaveContext,
+	starlarkScriptOrPackagePath string,
+	isRemotePackage bool,
+	runConfig *starlark_run_config.StarlarkRunConfig,
+) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+	if isRemotePackage {
+		return executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, runConfig)
+	}
+	fileOrDir, err := os.Stat(starlarkScriptOrPackagePath)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "There was an error reading file or package from disk at '%v'", starlarkScriptOrPackagePath)
+	}
+
+	if isStandaloneScript(fileOrDir, kurtosisYMLFilePath) {
+		if !strings.HasSuffix(starlarkScriptOrPackagePath, starlarkExtension) {
+			return nil, nil, stacktrace.NewError("Expected a script with a '%s' extension but got file '%v' with a different extension", starlarkExtension, starlarkScriptOrPackagePath)
+		}
+		return executeScript(ctx, enclaveCtx, starlarkScriptOrPackagePath, runConfig)
+	}
+	// if the path is a file with `kurtosis.yml` at the end it's a module dir
+	// we remove the `kurtosis.yml` to get just the Dir containing the module
+	if isKurtosisYMLFileInPackageDir(fileOrDir, kurtosisYMLFilePath) {
+		starlarkScriptOrPackagePath = path.Dir(starlarkScriptOrPackagePath)
+	}
+	return executePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, runConfig)
+}
+
 func executeScript(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, scriptPath string, runConfig *starlark_run_config.StarlarkRunConfig) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	fileContentBytes, err := os.ReadFile(scriptPath)
 	if err != nil {
@@ -596,13 +729,14 @@ func executeRemotePackage(
 }
 
 // ReadAndPrintResponseLinesUntilClosed TODO(victor.colombo): Extract this to somewhere reasonable
-func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, cancelFunc context.CancelFunc, verbosity command_args_run.Verbosity, dryRun bool) error {
+func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, cancelFunc context.CancelFunc, verbosity command_args_run.Verbosity, dryRun bool, showProfile bool) error {
 	defer cancelFunc()
 
 	// This channel will receive a signal when the user presses an interrupt
 	interruptChan := make(chan os.Signal, interruptChanBufferSize)
 	signal.Notify(interruptChan, os.Interrupt)
 	defer close(interruptChan)
+	defer signal.Stop(interruptChan)
 
 	printer := output_printers.NewExecutionPrinter()
 	if err := printer.Start(); err != nil {
@@ -610,11 +744,17 @@ func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core
 	}
 	defer printer.Stop()
 
+	runProfile := output_printers.NewRunProfile()
 	isRunSuccessful := false // defaults to false such that we fail loudly if something unexpected happens
 	for {
 		select {
 		case responseLine, isChanOpen := <-responseLineChan:
 			if !isChanOpen {
+				if showProfile {
+					// the profile is also useful when the run failed, e.g. to spot an instruction that timed out
+					printer.Stop()
+					out.PrintOutLn(runProfile.String())
+				}
 				if !isRunSuccessful && !dryRun {
 					// This error thrown by the APIC is not informative right now as it just tells the user to look at errors
 					// in the above log. For this reason we're ignoring it and returning nil. This is exceptional to not clutter
@@ -623,6 +763,7 @@ func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core
 				}
 				return nil
 			}
+			runProfile.AddResponseLine(responseLine)
 			err := printer.PrintKurtosisExecutionResponseLineToStdOut(responseLine, verbosity, dryRun)
 			if err != nil {
 				logrus.Errorf("An error occurred trying to write the output of Starlark execution to stdout. The script execution will continue, but the output printed here is incomplete. Error was: \n%s", err.Error())
@@ -637,6 +778,17 @@ func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core
 	}
 }
 
+func deployObservability(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveCtx *enclaves.EnclaveContext) error {
+	grafanaUrl, err := observability.DeployObservability(ctx, kurtosisCtx, enclaveCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "The run succeeded but an error occurred adding Prometheus and Grafana to enclave '%v'", enclaveCtx.GetEnclaveName())
+	}
+	if grafanaUrl != "" {
+		out.PrintOutLn(fmt.Sprintf("Grafana dashboard of the enclave: %v", grafanaUrl))
+	}
+	return nil
+}
+
 func getOrCreateEnclaveContext(
 	ctx context.Context,
 	enclaveIdentifierOrName string,
@@ -717,6 +869,98 @@ func getPackageDependencyYaml(
 	return packageYaml, nil
 }
 
+// getLocalPackageArgsSchema returns the args schema declared in the kurtosis.yml of a local package; standalone
+// scripts and Compose packages have none
+func getLocalPackageArgsSchema(starlarkScriptOrPackagePath string) (package_args_schema.PackageArgsSchema, error) {
+	fileOrDir, err := os.Stat(starlarkScriptOrPackagePath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "There was an error reading file or package from disk at '%v'", starlarkScriptOrPackagePath)
+	}
+	if isStandaloneScript(fileOrDir, kurtosisYMLFilePath) {
+		return nil, nil
+	}
+
+	kurtosisYmlPath := starlarkScriptOrPackagePath
+	if fileOrDir.IsDir() {
+		kurtosisYmlPath = path.Join(starlarkScriptOrPackagePath, kurtosisYMLFilePath)
+		if _, err = os.Stat(kurtosisYmlPath); errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+	}
+	kurtosisYml, err := enclaves.ParseKurtosisYaml(kurtosisYmlPath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the '%v' at '%v'", kurtosisYMLFilePath, kurtosisYmlPath)
+	}
+	return kurtosisYml.PackageArgs, nil
+}
+
+func printPackageArgsHelp(starlarkScriptOrPackagePath string, isRemotePackage bool) error {
+	if isRemotePackage {
+		return stacktrace.NewError("The '%v' flag only supports local packages; clone '%v' and point the command at the clone to see its arguments", argsHelpFlagKey, starlarkScriptOrPackagePath)
+	}
+	packageArgsSchema, err := getLocalPackageArgsSchema(starlarkScriptOrPackagePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the args schema of the package at '%v'", starlarkScriptOrPackagePath)
+	}
+	if len(packageArgsSchema) == 0 {
+		out.PrintOutLn(fmt.Sprintf("'%v' doesn't declare its arguments in a '%v'", starlarkScriptOrPackagePath, kurtosisYMLFilePath))
+		return nil
+	}
+	out.PrintOutLn(packageArgsSchema.FormatHelp())
+	return nil
+}
+
+// promptForMissingRequiredPackageArgs asks the user for the required args declared by the package that are missing
+// from the serialized args, and returns the args with the answers added
+func promptForMissingRequiredPackageArgs(starlarkScriptOrPackagePath string, serializedArgs string) (string, error) {
+	packageArgsSchema, err := getLocalPackageArgsSchema(starlarkScriptOrPackagePath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the args schema of the package at '%v'", starlarkScriptOrPackagePath)
+	}
+	if len(packageArgsSchema) == 0 {
+		return serializedArgs, nil
+	}
+
+	serializedJsonArgs, err := yaml_convert.YAMLToJSON([]byte(serializedArgs))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred converting the package args to JSON")
+	}
+	packageArgs := map[string]interface{}{}
+	if err = json.Unmarshal(serializedJsonArgs, &packageArgs); err != nil {
+		// args that aren't a dictionary are reported by the enclave, which knows the full story
+		return serializedArgs, nil
+	}
+
+	missingRequiredArgs := packageArgsSchema.GetMissingRequiredArgs(packageArgs)
+	if len(missingRequiredArgs) == 0 {
+		return serializedArgs, nil
+	}
+	for _, missingArg := range missingRequiredArgs {
+		label := fmt.Sprintf("%s (%s)", missingArg.Name, missingArg.Type)
+		if len(missingArg.Enum) > 0 {
+			label = fmt.Sprintf("%s, one of %s", label, missingArg.FormatEnum())
+		}
+		argToPrompt := missingArg
+		userInput, err := prompt_displayer.DisplayInputPromptAndGetResult(label, func(input string) error {
+			if _, err := argToPrompt.ParseValue(input); err != nil {
+				return fmt.Errorf("not a valid '%s' value for this argument", argToPrompt.Type)
+			}
+			return nil
+		})
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred getting a value for package argument '%v'", missingArg.Name)
+		}
+		// already validated by the prompt
+		packageArgs[missingArg.Name], _ = missingArg.ParseValue(userInput)
+	}
+
+	serializedArgsWithAnswers, err := json.Marshal(packageArgs)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the package args")
+	}
+	return string(serializedArgsWithAnswers), nil
+}
+
 // validatePackageArgs just validates the args is a valid JSON or YAML string
 func validatePackageArgs(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
 	serializedArgs, err := args.GetNonGreedyArg(inputArgsArgKey)
@@ -726,12 +970,16 @@ func validatePackageArgs(_ context.Context, _ *flags.ParsedFlags, args *args.Par
 	return validateSerializedArgs(serializedArgs)
 }
 
-// parseVerbosityFlag Get the verbosity flag is present, and parse it to a valid Verbosity value
-func parseVerbosityFlag(flags *flags.ParsedFlags) (command_args_run.Verbosity, error) {
+// parseVerbosityFlag Get the verbosity flag is present, and parse it to a valid Verbosity value. The default verbosity
+// of the current context, if any, is used when the flag isn't set
+func parseVerbosityFlag(flags *flags.ParsedFlags, contextDefaultVerbosity string) (command_args_run.Verbosity, error) {
 	verbosityStr, err := flags.GetString(verbosityFlagKey)
 	if err != nil {
 		return 0, stacktrace.Propagate(err, "An error occurred getting the verbosity using flag key '%s'", verbosityFlagKey)
 	}
+	if !flags.IsSet(verbosityFlagKey) && contextDefaultVerbosity != "" {
+		verbosityStr = contextDefaultVerbosity
+	}
 	verbosity, err := command_args_run.VerbosityString(verbosityStr)
 	if err != nil {
 		return 0, stacktrace.Propagate(err, "Invalid verbosity value: '%s'. Possible values are %s", verbosityStr, strings.Join(command_args_run.VerbosityStrings(), ", "))
@@ -863,8 +1111,8 @@ func isHttpUrl(maybeHttpUrl string) bool {
 	return httpProtocolRegex.MatchString(maybeHttpUrl)
 }
 
-func pullImagesLocally(ctx context.Context, images []string) error {
-	kurtosisBackend, err := backend_creator.GetDockerKurtosisBackend(backend_creator.NoAPIContainerModeArgs, configs.NoRemoteBackendConfig)
+func pullImagesLocally(ctx context.Context, images []string, airGapConfig air_gap.AirGapConfig) error {
+	kurtosisBackend, err := backend_creator.GetDockerKurtosisBackend(backend_creator.NoAPIContainerModeArgs, configs.NoRemoteBackendConfig, airGapConfig)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred retrieving Docker Kurtosis Backend")
 	}
@@ -877,7 +1125,7 @@ func pullImagesLocally(ctx context.Context, images []string) error {
 	return nil
 }
 
-func pullPackagesLocally(packageDependencies []string) (map[string]string, error) {
+func pullPackagesLocally(packageDependencies []string, airGapConfig air_gap.AirGapConfig) (map[string]string, error) {
 	localPackagesToRelativeFilepaths := map[string]string{}
 
 	workingDirectory, err := os.Getwd()
@@ -916,6 +1164,11 @@ func pullPackagesLocally(packageDependencies []string) (map[string]string, error
 		if !strings.HasSuffix(".git", dependency) {
 			repoUrl += ".git"
 		}
+		if parsedUrl, err := shared_utils.ParseGitURL(dependency); err == nil {
+			if mirroredRepoUrl, isMirrored := airGapConfig.GetMirroredPackageGitUrl(parsedUrl.GetRelativeRepoPath()); isMirrored {
+				repoUrl = mirroredRepoUrl
+			}
+		}
 		localPackagePath := fmt.Sprintf("%s/%s", parentCwd, packageName)
 		_, err := git.PlainClone(localPackagePath, shouldCloneNormalRepo, &git.CloneOptions{
 			URL:               repoUrl,
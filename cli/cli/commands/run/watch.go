@@ -0,0 +1,140 @@
+package run
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	watchPollInterval = time.Second
+
+	// Changing the Git metadata, e.g. by committing, doesn't change the package
+	gitDirname = ".git"
+)
+
+// watchedFileState is what gets compared between two snapshots to decide whether the package changed
+type watchedFileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// watchedDirSnapshot maps the path of every file of the watched directory, relative to it, to its state
+type watchedDirSnapshot map[string]watchedFileState
+
+// watchAndRerun calls rerun every time a file of the directory of the local script or package changes, until the user
+// interrupts it or the context is cancelled. Errors of rerun are logged, so that a broken edit doesn't stop the watch
+func watchAndRerun(ctx context.Context, scriptOrPackagePath string, rerun func() error) error {
+	watchedDirpath, err := getWatchedDirpath(scriptOrPackagePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the directory to watch for '%v'", scriptOrPackagePath)
+	}
+	snapshot, err := takeWatchedDirSnapshot(watchedDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred taking a snapshot of '%v'", watchedDirpath)
+	}
+
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+	defer signal.Stop(interruptChan)
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	logrus.Infof("Watching '%v' for changes; press Ctrl+C to stop", watchedDirpath)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-interruptChan:
+			return nil
+		case <-ticker.C:
+			currentSnapshot, err := takeWatchedDirSnapshot(watchedDirpath)
+			if err != nil {
+				logrus.Warnf("An error occurred taking a snapshot of '%v', retrying in %v:\n%v", watchedDirpath, watchPollInterval, err)
+				continue
+			}
+			if isSameWatchedDirSnapshot(snapshot, currentSnapshot) {
+				continue
+			}
+			snapshot = currentSnapshot
+
+			logrus.Infof("Detected changes in '%v', running it again", watchedDirpath)
+			if err = rerun(); err != nil {
+				logrus.Errorf("The run failed; it will run again on the next change:\n%v", err)
+			}
+			// The files may have been changed while it ran, in which case it runs again on the next tick
+			logrus.Infof("Watching '%v' for changes; press Ctrl+C to stop", watchedDirpath)
+		}
+	}
+}
+
+// getWatchedDirpath returns the package directory, or the directory of a standalone script
+func getWatchedDirpath(scriptOrPackagePath string) (string, error) {
+	fileInfo, err := os.Stat(scriptOrPackagePath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the info of '%v'", scriptOrPackagePath)
+	}
+	if fileInfo.IsDir() {
+		return scriptOrPackagePath, nil
+	}
+	return filepath.Dir(scriptOrPackagePath), nil
+}
+
+func takeWatchedDirSnapshot(watchedDirpath string) (watchedDirSnapshot, error) {
+	snapshot := watchedDirSnapshot{}
+	walkErr := filepath.WalkDir(watchedDirpath, func(filepathToVisit string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			// the path got removed after its parent directory got listed; the next snapshot won't have it either
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if dirEntry.IsDir() {
+			if dirEntry.Name() == gitDirname {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		fileInfo, err := dirEntry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return stacktrace.Propagate(err, "An error occurred getting the info of '%v'", filepathToVisit)
+		}
+		relativeFilepath, err := filepath.Rel(watchedDirpath, filepathToVisit)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", filepathToVisit, watchedDirpath)
+		}
+		snapshot[relativeFilepath] = watchedFileState{
+			size:    fileInfo.Size(),
+			modTime: fileInfo.ModTime(),
+		}
+		return nil
+	})
+	if walkErr != nil {
+		return nil, stacktrace.Propagate(walkErr, "An error occurred walking directory '%v'", watchedDirpath)
+	}
+	return snapshot, nil
+}
+
+func isSameWatchedDirSnapshot(previousSnapshot watchedDirSnapshot, currentSnapshot watchedDirSnapshot) bool {
+	if len(previousSnapshot) != len(currentSnapshot) {
+		return false
+	}
+	for relativeFilepath, currentState := range currentSnapshot {
+		previousState, found := previousSnapshot[relativeFilepath]
+		if !found || previousState.size != currentState.size || !previousState.modTime.Equal(currentState.modTime) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package run
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTakeWatchedDirSnapshot_DetectsChanges(t *testing.T) {
+	packageDirpath := t.TempDir()
+	mainFilepath := filepath.Join(packageDirpath, "main.star")
+	require.NoError(t, os.WriteFile(mainFilepath, []byte("def run(plan):\n    pass\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(packageDirpath, gitDirname), 0755))
+
+	snapshot, err := takeWatchedDirSnapshot(packageDirpath)
+	require.NoError(t, err)
+	require.Len(t, snapshot, 1)
+
+	unchangedSnapshot, err := takeWatchedDirSnapshot(packageDirpath)
+	require.NoError(t, err)
+	require.True(t, isSameWatchedDirSnapshot(snapshot, unchangedSnapshot))
+
+	// Git metadata isn't part of the package
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, gitDirname, "HEAD"), []byte("ref: refs/heads/main\n"), 0644))
+	gitChangedSnapshot, err := takeWatchedDirSnapshot(packageDirpath)
+	require.NoError(t, err)
+	require.True(t, isSameWatchedDirSnapshot(snapshot, gitChangedSnapshot))
+
+	modifiedTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(mainFilepath, modifiedTime, modifiedTime))
+	modifiedSnapshot, err := takeWatchedDirSnapshot(packageDirpath)
+	require.NoError(t, err)
+	require.False(t, isSameWatchedDirSnapshot(snapshot, modifiedSnapshot))
+
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, "lib.star"), []byte(""), 0644))
+	addedSnapshot, err := takeWatchedDirSnapshot(packageDirpath)
+	require.NoError(t, err)
+	require.False(t, isSameWatchedDirSnapshot(modifiedSnapshot, addedSnapshot))
+}
+
+func TestGetWatchedDirpath(t *testing.T) {
+	packageDirpath := t.TempDir()
+	scriptFilepath := filepath.Join(packageDirpath, "script.star")
+	require.NoError(t, os.WriteFile(scriptFilepath, []byte(""), 0644))
+
+	watchedDirpath, err := getWatchedDirpath(packageDirpath)
+	require.NoError(t, err)
+	require.Equal(t, packageDirpath, watchedDirpath)
+
+	watchedDirpath, err = getWatchedDirpath(scriptFilepath)
+	require.NoError(t, err)
+	require.Equal(t, packageDirpath, watchedDirpath)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
 	"io"
 	"os"
@@ -19,6 +18,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -184,8 +184,8 @@ var ServiceAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 				command_str_consts.KurtosisCmdStr,
 				command_str_consts.ServiceCmdStr,
 				command_str_consts.ServiceInspectCmdStr,
-				inspect.OutputFormatKeyShorthand,
-				inspect.JsonOutputFormat,
+				defaults.OutputFormatFlagShorthand,
+				output_printers.OutputFormat_Json,
 			),
 			Type:    flags.FlagType_String,
 			Default: JsonConfigFlagKeyDefault,
@@ -0,0 +1,155 @@
+package adopt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	containerIdentifierArgKey = "container-id-or-pod"
+
+	serviceNameFlagKey     = "name"
+	defaultServiceName     = ""
+	keepOriginalFlagKey    = "keep-original"
+	defaultKeepOriginalStr = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ServiceAdoptCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceAdoptCmdStr,
+	ShortDescription: "Adopts a container started outside Kurtosis into an enclave",
+	LongDescription: fmt.Sprintf("Makes a running container, or pod on Kubernetes, started outside Kurtosis a service "+
+		"of the given enclave, so that its logs, inspection, exec and teardown are handled by Kurtosis like for the "+
+		"other services. Containers can't be relabelled while they run, so the service is started with the image, "+
+		"entrypoint, command, environment variables, ports, user and resources of the container, which is then removed. "+
+		"What the service won't have, like the volumes mounted in the container, is listed before it starts. The "+
+		"container is identified by its ID or name on Docker, and as '[NAMESPACE/]POD' on Kubernetes, in the cluster "+
+		"of the current kubeconfig context. Use '--%s' to keep the container running alongside the service",
+		keepOriginalFlagKey),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key: containerIdentifierArgKey,
+		},
+	},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     serviceNameFlagKey,
+			Usage:   "Name of the service, defaults to the name of the container or pod",
+			Type:    flags.FlagType_String,
+			Default: defaultServiceName,
+		},
+		{
+			Key:     keepOriginalFlagKey,
+			Usage:   "Keep the container or pod running once the service replaced it",
+			Type:    flags.FlagType_Bool,
+			Default: defaultKeepOriginalStr,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+	containerIdentifier, err := args.GetNonGreedyArg(containerIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the container identifier value using key '%v'", containerIdentifierArgKey)
+	}
+	serviceName, err := flags.GetString(serviceNameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service name using flag key '%v'", serviceNameFlagKey)
+	}
+	keepOriginal, err := flags.GetBool(keepOriginalFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the keep original flag using key '%v'", keepOriginalFlagKey)
+	}
+
+	adoptedContainer, err := getExternalContainer(ctx, containerIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting container '%v' to adopt", containerIdentifier)
+	}
+	defer adoptedContainer.closeFunc()
+	if serviceName == defaultServiceName {
+		serviceName = adoptedContainer.name
+	}
+	for _, warning := range adoptedContainer.warnings {
+		logrus.Warn(warning)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+	addServiceStarlark := service_helpers.GetAddServiceStarlarkScript(serviceName, adoptedContainer.getServiceConfigStarlark())
+	if _, err := service_helpers.RunAddServiceStarlarkScript(ctx, serviceName, enclaveIdentifier, addServiceStarlark, enclaveCtx); err != nil {
+		return err // already wrapped
+	}
+
+	// Removing the container only once the service runs, so that it keeps running if adopting it fails
+	if !keepOriginal && adoptedContainer.removeFunc != nil {
+		if err := adoptedContainer.removeFunc(ctx); err != nil {
+			return stacktrace.Propagate(err, "Service '%v' was started in enclave '%v' but container '%v' couldn't be removed; remove it manually", serviceName, enclaveIdentifier, containerIdentifier)
+		}
+	}
+	out.PrintOutLn(fmt.Sprintf("Container '%v' adopted as service '%v' of enclave '%v'", containerIdentifier, serviceName, enclaveIdentifier))
+	return nil
+}
+
+// getExternalContainer returns the container to adopt from the cluster Kurtosis runs on
+func getExternalContainer(ctx context.Context, containerIdentifier string) (*externalContainer, error) {
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Kurtosis cluster config")
+	}
+	switch clusterConfig.GetClusterType() {
+	case resolved_config.KurtosisClusterType_Docker:
+		return getDockerContainer(ctx, containerIdentifier)
+	case resolved_config.KurtosisClusterType_Kubernetes:
+		return getKubernetesPod(ctx, containerIdentifier)
+	default:
+		return nil, stacktrace.NewError("Containers can't be adopted on clusters of type '%v'", clusterConfig.GetClusterType())
+	}
+}
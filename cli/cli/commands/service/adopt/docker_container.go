@@ -0,0 +1,207 @@
+package adopt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/ssh_tunnel_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	dockerContainerNamePrefix = "/"
+
+	dockerEnvVarSeparator = "="
+	dockerEnvVarParts     = 2
+
+	dockerUserGroupSeparator = ":"
+	dockerUidAndGidParts     = 2
+
+	dockerIdNumberBase = 10
+	dockerIdBits       = 32
+
+	nanoCpusPerMillicpu = 1_000_000
+	bytesPerMegabyte    = 1_000_000
+
+	noApplicationProtocol = ""
+	noWaitTimeout         = ""
+)
+
+var dockerProtocolToTransportProtocol = map[string]kurtosis_core_rpc_api_bindings.Port_TransportProtocol{
+	"tcp":  kurtosis_core_rpc_api_bindings.Port_TCP,
+	"udp":  kurtosis_core_rpc_api_bindings.Port_UDP,
+	"sctp": kurtosis_core_rpc_api_bindings.Port_SCTP,
+}
+
+// getDockerContainer returns the Docker container with the given ID or name, from the Docker daemon Kurtosis runs on
+func getDockerContainer(ctx context.Context, containerIdentifier string) (*externalContainer, error) {
+	dockerClientOpts, closeFunc, err := getCurrentContextDockerClientOpts()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the options to connect to the Docker daemon")
+	}
+	dockerClient, err := client.NewClientWithOpts(dockerClientOpts...)
+	if err != nil {
+		closeFunc()
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Docker client")
+	}
+
+	containerJson, err := dockerClient.ContainerInspect(ctx, containerIdentifier)
+	if err != nil {
+		closeDockerClient(dockerClient, closeFunc)
+		return nil, stacktrace.Propagate(err, "An error occurred inspecting Docker container '%v'", containerIdentifier)
+	}
+	adoptedContainer, err := newExternalContainerFromDockerContainer(containerJson)
+	if err != nil {
+		closeDockerClient(dockerClient, closeFunc)
+		return nil, stacktrace.Propagate(err, "Docker container '%v' can't be adopted", containerIdentifier)
+	}
+	adoptedContainer.closeFunc = func() {
+		closeDockerClient(dockerClient, closeFunc)
+	}
+	adoptedContainer.removeFunc = func(ctx context.Context) error {
+		// nolint: exhaustruct
+		if err := dockerClient.ContainerStop(ctx, containerJson.ID, container.StopOptions{}); err != nil {
+			return stacktrace.Propagate(err, "An error occurred stopping Docker container '%v'", containerIdentifier)
+		}
+		// nolint: exhaustruct
+		if err := dockerClient.ContainerRemove(ctx, containerJson.ID, types.ContainerRemoveOptions{}); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing Docker container '%v'", containerIdentifier)
+		}
+		return nil
+	}
+	return adoptedContainer, nil
+}
+
+func newExternalContainerFromDockerContainer(containerJson types.ContainerJSON) (*externalContainer, error) {
+	if containerJson.Config == nil || containerJson.HostConfig == nil {
+		return nil, stacktrace.NewError("The Docker daemon didn't return the config of the container")
+	}
+	if _, found := containerJson.Config.Labels[docker_label_key.AppIDDockerLabelKey.GetString()]; found {
+		return nil, stacktrace.NewError("The container is already managed by Kurtosis")
+	}
+	if containerJson.State == nil || !containerJson.State.Running {
+		return nil, stacktrace.NewError("The container isn't running")
+	}
+
+	envVars := map[string]string{}
+	for _, envVar := range containerJson.Config.Env {
+		envVarParts := strings.SplitN(envVar, dockerEnvVarSeparator, dockerEnvVarParts)
+		if len(envVarParts) != dockerEnvVarParts {
+			continue
+		}
+		envVars[envVarParts[0]] = envVarParts[1]
+	}
+
+	ports := map[string]*kurtosis_core_rpc_api_bindings.Port{}
+	for dockerPort := range containerJson.Config.ExposedPorts {
+		transportProtocol, found := dockerProtocolToTransportProtocol[dockerPort.Proto()]
+		if !found {
+			return nil, stacktrace.NewError("Port '%v' of the container has unsupported protocol '%v'", dockerPort, dockerPort.Proto())
+		}
+		portNumber := uint32(dockerPort.Int())
+		ports[newPortId(transportProtocol, portNumber)] = binding_constructors.NewPort(portNumber, transportProtocol, noApplicationProtocol, noWaitTimeout)
+	}
+
+	warnings := []string{}
+	user, err := parseDockerUser(containerJson.Config.User)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("The container runs as user '%v', which isn't a UID, so the service will run as the user of its image", containerJson.Config.User))
+	}
+	mountDestinations := []string{}
+	for _, mount := range containerJson.Mounts {
+		mountDestinations = append(mountDestinations, mount.Destination)
+	}
+	sort.Strings(mountDestinations)
+	for _, mountDestination := range mountDestinations {
+		warnings = append(warnings, fmt.Sprintf("The volume mounted at '%v' in the container won't be mounted in the service", mountDestination))
+	}
+	if len(containerJson.HostConfig.PortBindings) > 0 {
+		warnings = append(warnings, "The ports the container published on the host will be published on other ports by the service")
+	}
+
+	return &externalContainer{
+		name:               sanitizeServiceName(strings.TrimPrefix(containerJson.Name, dockerContainerNamePrefix)),
+		image:              containerJson.Config.Image,
+		entrypoint:         containerJson.Config.Entrypoint,
+		cmd:                containerJson.Config.Cmd,
+		envVars:            envVars,
+		ports:              ports,
+		user:               user,
+		maxMillicpus:       uint32(containerJson.HostConfig.NanoCPUs / nanoCpusPerMillicpu),
+		minMillicpus:       0,
+		maxMemoryMegabytes: uint32(containerJson.HostConfig.Memory / bytesPerMegabyte),
+		minMemoryMegabytes: uint32(containerJson.HostConfig.MemoryReservation / bytesPerMegabyte),
+		tolerations:        nil,
+		nodeSelectors:      map[string]string{},
+		warnings:           warnings,
+		removeFunc:         nil,
+		closeFunc:          func() {},
+	}, nil
+}
+
+// parseDockerUser returns the user of the container, nil if it runs as the user of its image, or an error if it's a
+// username as services can only run as a UID
+func parseDockerUser(dockerUser string) (*services.User, error) {
+	if dockerUser == "" {
+		return nil, nil
+	}
+	userParts := strings.SplitN(dockerUser, dockerUserGroupSeparator, dockerUidAndGidParts)
+	uid, err := strconv.ParseUint(userParts[0], dockerIdNumberBase, dockerIdBits)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "User '%v' isn't a UID", userParts[0])
+	}
+	user := &services.User{UID: uint32(uid), GID: 0}
+	if len(userParts) == dockerUidAndGidParts {
+		gid, err := strconv.ParseUint(userParts[1], dockerIdNumberBase, dockerIdBits)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Group '%v' isn't a GID", userParts[1])
+		}
+		user.GID = uint32(gid)
+	}
+	return user, nil
+}
+
+// getCurrentContextDockerClientOpts returns the options to connect to the Docker daemon Kurtosis runs on, which is on
+// the remote host for SSH contexts, and a function to call once done with the daemon
+func getCurrentContextDockerClientOpts() ([]client.Opt, func(), error) {
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred retrieving the current context")
+	}
+	if store.IsRemote(currentContext) {
+		return nil, nil, stacktrace.NewError("Containers can't be adopted with remote context '%v', as its Docker daemon can't be reached", currentContext.GetName())
+	}
+	if !store.IsSsh(currentContext) {
+		return backend_creator.GetLocalDockerClientOpts(), func() {}, nil
+	}
+	sshConnection, err := ssh_tunnel_manager.NewSshConnection(currentContext.GetSshContextV0())
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred connecting over SSH to the remote host of context '%v'", currentContext.GetName())
+	}
+	closeFunc := func() {
+		if err := sshConnection.Close(); err != nil {
+			logrus.Debugf("An error occurred closing the SSH connection:\n%v", err)
+		}
+	}
+	return sshConnection.GetDockerClientOpts(), closeFunc, nil
+}
+
+func closeDockerClient(dockerClient *client.Client, closeFunc func()) {
+	if err := dockerClient.Close(); err != nil {
+		logrus.Debugf("An error occurred closing the Docker client:\n%v", err)
+	}
+	closeFunc()
+}
@@ -0,0 +1,79 @@
+package adopt
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExternalContainerFromDockerContainer(t *testing.T) {
+	containerJson := getDockerContainerForTest()
+	adoptedContainer, err := newExternalContainerFromDockerContainer(containerJson)
+	require.NoError(t, err)
+	require.Equal(t, "my-db-1", adoptedContainer.name)
+	require.Equal(t, "postgres:16", adoptedContainer.image)
+	require.Equal(t, []string{"docker-entrypoint.sh"}, adoptedContainer.entrypoint)
+	require.Equal(t, []string{"postgres"}, adoptedContainer.cmd)
+	require.Equal(t, map[string]string{"POSTGRES_PASSWORD": "pass=word"}, adoptedContainer.envVars)
+	require.Len(t, adoptedContainer.ports, 1)
+	require.Equal(t, uint32(5432), adoptedContainer.ports["tcp-5432"].GetNumber())
+	require.Equal(t, kurtosis_core_rpc_api_bindings.Port_TCP, adoptedContainer.ports["tcp-5432"].GetTransportProtocol())
+	require.Equal(t, &services.User{UID: 999, GID: 998}, adoptedContainer.user)
+	require.Equal(t, uint32(500), adoptedContainer.maxMillicpus)
+	require.Equal(t, uint32(512), adoptedContainer.maxMemoryMegabytes)
+	require.Equal(t, []string{"The volume mounted at '/var/lib/postgresql/data' in the container won't be mounted in the service"}, adoptedContainer.warnings)
+}
+
+func TestNewExternalContainerFromDockerContainer_KurtosisContainerIsRefused(t *testing.T) {
+	containerJson := getDockerContainerForTest()
+	containerJson.Config.Labels = map[string]string{"com.kurtosistech.app-id": "kurtosis"}
+	_, err := newExternalContainerFromDockerContainer(containerJson)
+	require.Error(t, err)
+}
+
+func TestNewExternalContainerFromDockerContainer_StoppedContainerIsRefused(t *testing.T) {
+	containerJson := getDockerContainerForTest()
+	containerJson.State.Running = false
+	_, err := newExternalContainerFromDockerContainer(containerJson)
+	require.Error(t, err)
+}
+
+func TestParseDockerUser(t *testing.T) {
+	user, err := parseDockerUser("")
+	require.NoError(t, err)
+	require.Nil(t, user)
+
+	user, err = parseDockerUser("1000")
+	require.NoError(t, err)
+	require.Equal(t, &services.User{UID: 1000, GID: 0}, user)
+
+	_, err = parseDockerUser("postgres")
+	require.Error(t, err)
+}
+
+func getDockerContainerForTest() types.ContainerJSON {
+	// nolint: exhaustruct
+	return types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			Name:  "/my_db.1",
+			State: &types.ContainerState{Running: true},
+			HostConfig: &container.HostConfig{
+				Resources: container.Resources{NanoCPUs: 500_000_000, Memory: 512_000_000},
+			},
+		},
+		Mounts: []types.MountPoint{{Destination: "/var/lib/postgresql/data"}},
+		Config: &container.Config{
+			Image:        "postgres:16",
+			Entrypoint:   []string{"docker-entrypoint.sh"},
+			Cmd:          []string{"postgres"},
+			Env:          []string{"POSTGRES_PASSWORD=pass=word"},
+			ExposedPorts: nat.PortSet{"5432/tcp": struct{}{}},
+			User:         "999:998",
+		},
+	}
+}
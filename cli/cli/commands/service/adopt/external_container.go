@@ -0,0 +1,95 @@
+package adopt
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+)
+
+const (
+	// e.g. 'tcp-8080', as the ports of containers started outside Kurtosis have no IDs
+	portIdFormat = "%s-%d"
+
+	invalidServiceNameCharReplacement = "-"
+
+	// The service starts like the container did, so Tini isn't added
+	isTiniEnabled = false
+
+	noPrivateIpAddrPlaceholder = ""
+)
+
+var invalidServiceNameCharsRegex = regexp.MustCompile("[^a-z0-9-]+")
+
+// externalContainer is what's needed to start a container, or the container of a pod, started outside Kurtosis as a
+// service of an enclave
+type externalContainer struct {
+	// The default name of the service
+	name string
+
+	image      string
+	entrypoint []string
+	cmd        []string
+	envVars    map[string]string
+	ports      map[string]*kurtosis_core_rpc_api_bindings.Port
+
+	// Nil if the container runs as the user of its image
+	user *services.User
+
+	// 0 if not set
+	maxMillicpus       uint32
+	minMillicpus       uint32
+	maxMemoryMegabytes uint32
+	minMemoryMegabytes uint32
+
+	tolerations   []services.Toleration
+	nodeSelectors map[string]string
+
+	// What the service won't have, e.g. the volumes mounted in the container
+	warnings []string
+
+	// Removes the container once the service replaced it. Nil if Kurtosis mustn't remove it, e.g. as it would be
+	// recreated by whatever created it
+	removeFunc func(ctx context.Context) error
+
+	// Releases the connection to the container runtime
+	closeFunc func()
+}
+
+func (container *externalContainer) getServiceConfigStarlark() string {
+	return services.GetFullServiceConfigStarlark(
+		container.image,
+		container.ports,
+		map[string][]string{}, // no files artifacts
+		container.entrypoint,
+		container.cmd,
+		container.envVars,
+		container.maxMillicpus,
+		container.maxMemoryMegabytes,
+		container.minMillicpus,
+		container.minMemoryMegabytes,
+		container.user,
+		container.tolerations,
+		container.nodeSelectors,
+		map[string]string{}, // no labels, as those of the container are often invalid for services
+		pointerToBool(isTiniEnabled),
+		noPrivateIpAddrPlaceholder,
+	)
+}
+
+func newPortId(transportProtocol kurtosis_core_rpc_api_bindings.Port_TransportProtocol, portNumber uint32) string {
+	return fmt.Sprintf(portIdFormat, strings.ToLower(transportProtocol.String()), portNumber)
+}
+
+// sanitizeServiceName turns the name of a container or pod into one services can have, e.g. 'my_db.1' into 'my-db-1'
+func sanitizeServiceName(name string) string {
+	sanitizedName := invalidServiceNameCharsRegex.ReplaceAllString(strings.ToLower(name), invalidServiceNameCharReplacement)
+	return strings.Trim(sanitizedName, invalidServiceNameCharReplacement)
+}
+
+func pointerToBool(value bool) *bool {
+	return &value
+}
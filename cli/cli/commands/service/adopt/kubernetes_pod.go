@@ -0,0 +1,180 @@
+package adopt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	namespacePodSeparator = "/"
+	namespaceAndPodParts  = 2
+
+	// Mounted in every pod by Kubernetes, so not worth a warning
+	serviceAccountMountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
+)
+
+var kubernetesProtocolToTransportProtocol = map[apiv1.Protocol]kurtosis_core_rpc_api_bindings.Port_TransportProtocol{
+	apiv1.ProtocolTCP:  kurtosis_core_rpc_api_bindings.Port_TCP,
+	apiv1.ProtocolUDP:  kurtosis_core_rpc_api_bindings.Port_UDP,
+	apiv1.ProtocolSCTP: kurtosis_core_rpc_api_bindings.Port_SCTP,
+}
+
+// getKubernetesPod returns the pod identified as 'NAMESPACE/POD', or 'POD' in the namespace of the current kubeconfig
+// context, from the cluster of the current kubeconfig context
+func getKubernetesPod(ctx context.Context, podIdentifier string) (*externalContainer, error) {
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		nil, // empty overrides
+	)
+	namespace, podName, err := parsePodIdentifier(podIdentifier, clientConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing pod identifier '%v'", podIdentifier)
+	}
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the config of the current kubeconfig context")
+	}
+	kubernetesClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes client")
+	}
+
+	pod, err := kubernetesClient.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{}) // nolint: exhaustruct
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting pod '%v' in namespace '%v'", podName, namespace)
+	}
+	adoptedContainer, err := newExternalContainerFromKubernetesPod(pod)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Pod '%v' in namespace '%v' can't be adopted", podName, namespace)
+	}
+	if len(pod.GetOwnerReferences()) == 0 {
+		adoptedContainer.removeFunc = func(ctx context.Context) error {
+			// nolint: exhaustruct
+			if err := kubernetesClient.CoreV1().Pods(namespace).Delete(ctx, podName, metav1.DeleteOptions{}); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deleting pod '%v' in namespace '%v'", podName, namespace)
+			}
+			return nil
+		}
+	}
+	return adoptedContainer, nil
+}
+
+func parsePodIdentifier(podIdentifier string, clientConfig clientcmd.ClientConfig) (string, string, error) {
+	identifierParts := strings.SplitN(podIdentifier, namespacePodSeparator, namespaceAndPodParts)
+	if len(identifierParts) == namespaceAndPodParts {
+		return identifierParts[0], identifierParts[1], nil
+	}
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred getting the namespace of the current kubeconfig context")
+	}
+	return namespace, podIdentifier, nil
+}
+
+func newExternalContainerFromKubernetesPod(pod *apiv1.Pod) (*externalContainer, error) {
+	if _, found := pod.GetLabels()[kubernetes_label_key.AppIDKubernetesLabelKey.GetString()]; found {
+		return nil, stacktrace.NewError("The pod is already managed by Kurtosis")
+	}
+	if pod.Status.Phase != apiv1.PodRunning {
+		return nil, stacktrace.NewError("The pod isn't running, its phase is '%v'", pod.Status.Phase)
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return nil, stacktrace.NewError("The pod has no containers")
+	}
+
+	warnings := []string{}
+	podContainer := pod.Spec.Containers[0]
+	for _, sidecarContainer := range pod.Spec.Containers[1:] {
+		warnings = append(warnings, fmt.Sprintf("Only container '%v' of the pod is adopted, container '%v' isn't", podContainer.Name, sidecarContainer.Name))
+	}
+	for _, ownerReference := range pod.GetOwnerReferences() {
+		warnings = append(warnings, fmt.Sprintf(
+			"The pod is managed by %v '%v', which would recreate it, so it's kept; scale down or delete the %v to stop it",
+			ownerReference.Kind, ownerReference.Name, ownerReference.Kind))
+	}
+
+	envVars := map[string]string{}
+	for _, envVar := range podContainer.Env {
+		if envVar.ValueFrom != nil {
+			warnings = append(warnings, fmt.Sprintf("Environment variable '%v' is set from a reference, which isn't resolved, so the service won't have it", envVar.Name))
+			continue
+		}
+		envVars[envVar.Name] = envVar.Value
+	}
+	if len(podContainer.EnvFrom) > 0 {
+		warnings = append(warnings, "The environment variables set from ConfigMaps and Secrets aren't resolved, so the service won't have them")
+	}
+
+	ports := map[string]*kurtosis_core_rpc_api_bindings.Port{}
+	for _, containerPort := range podContainer.Ports {
+		transportProtocol, found := kubernetesProtocolToTransportProtocol[containerPort.Protocol]
+		if !found {
+			// The protocol is TCP when not set
+			transportProtocol = kurtosis_core_rpc_api_bindings.Port_TCP
+		}
+		portNumber := uint32(containerPort.ContainerPort)
+		ports[newPortId(transportProtocol, portNumber)] = binding_constructors.NewPort(portNumber, transportProtocol, noApplicationProtocol, noWaitTimeout)
+	}
+
+	for _, volumeMount := range podContainer.VolumeMounts {
+		if volumeMount.MountPath == serviceAccountMountPath {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("Volume '%v' mounted at '%v' in the pod won't be mounted in the service", volumeMount.Name, volumeMount.MountPath))
+	}
+
+	var user *services.User
+	if podContainer.SecurityContext != nil && podContainer.SecurityContext.RunAsUser != nil {
+		user = &services.User{UID: uint32(*podContainer.SecurityContext.RunAsUser), GID: 0}
+		if podContainer.SecurityContext.RunAsGroup != nil {
+			user.GID = uint32(*podContainer.SecurityContext.RunAsGroup)
+		}
+	}
+
+	tolerations := []services.Toleration{}
+	for _, toleration := range pod.Spec.Tolerations {
+		serviceToleration := services.Toleration{
+			Key:               toleration.Key,
+			Value:             toleration.Value,
+			Operator:          string(toleration.Operator),
+			Effect:            string(toleration.Effect),
+			TolerationSeconds: 0,
+		}
+		if toleration.TolerationSeconds != nil {
+			serviceToleration.TolerationSeconds = *toleration.TolerationSeconds
+		}
+		tolerations = append(tolerations, serviceToleration)
+	}
+
+	resourceLimits := podContainer.Resources.Limits
+	resourceRequests := podContainer.Resources.Requests
+	return &externalContainer{
+		name:               sanitizeServiceName(pod.GetName()),
+		image:              podContainer.Image,
+		entrypoint:         podContainer.Command,
+		cmd:                podContainer.Args,
+		envVars:            envVars,
+		ports:              ports,
+		user:               user,
+		maxMillicpus:       uint32(resourceLimits.Cpu().MilliValue()),
+		minMillicpus:       uint32(resourceRequests.Cpu().MilliValue()),
+		maxMemoryMegabytes: uint32(resourceLimits.Memory().Value() / bytesPerMegabyte),
+		minMemoryMegabytes: uint32(resourceRequests.Memory().Value() / bytesPerMegabyte),
+		tolerations:        tolerations,
+		nodeSelectors:      pod.Spec.NodeSelector,
+		warnings:           warnings,
+		removeFunc:         nil,
+		closeFunc:          func() {},
+	}, nil
+}
@@ -0,0 +1,68 @@
+package adopt
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewExternalContainerFromKubernetesPod(t *testing.T) {
+	adoptedContainer, err := newExternalContainerFromKubernetesPod(getKubernetesPodForTest())
+	require.NoError(t, err)
+	require.Equal(t, "api", adoptedContainer.name)
+	require.Equal(t, "api:latest", adoptedContainer.image)
+	require.Equal(t, []string{"/api"}, adoptedContainer.entrypoint)
+	require.Equal(t, []string{"--verbose"}, adoptedContainer.cmd)
+	require.Equal(t, map[string]string{"LOG_LEVEL": "debug"}, adoptedContainer.envVars)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.Port_UDP, adoptedContainer.ports["udp-9000"].GetTransportProtocol())
+	require.Equal(t, &services.User{UID: 1000, GID: 0}, adoptedContainer.user)
+	require.Equal(t, uint32(250), adoptedContainer.maxMillicpus)
+	require.Equal(t, uint32(256), adoptedContainer.minMemoryMegabytes)
+	require.Equal(t, []string{
+		"Only container 'api' of the pod is adopted, container 'proxy' isn't",
+		"Environment variable 'TOKEN' is set from a reference, which isn't resolved, so the service won't have it",
+	}, adoptedContainer.warnings)
+}
+
+func TestNewExternalContainerFromKubernetesPod_PendingPodIsRefused(t *testing.T) {
+	pod := getKubernetesPodForTest()
+	pod.Status.Phase = apiv1.PodPending
+	_, err := newExternalContainerFromKubernetesPod(pod)
+	require.Error(t, err)
+}
+
+func getKubernetesPodForTest() *apiv1.Pod {
+	uid := int64(1000)
+	// nolint: exhaustruct
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "api"},
+		Spec: apiv1.PodSpec{
+			Containers: []apiv1.Container{
+				{
+					Name:    "api",
+					Image:   "api:latest",
+					Command: []string{"/api"},
+					Args:    []string{"--verbose"},
+					Env: []apiv1.EnvVar{
+						{Name: "LOG_LEVEL", Value: "debug"},
+						{Name: "TOKEN", ValueFrom: &apiv1.EnvVarSource{}},
+					},
+					Ports: []apiv1.ContainerPort{{ContainerPort: 9000, Protocol: apiv1.ProtocolUDP}},
+					Resources: apiv1.ResourceRequirements{
+						Limits:   apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("250m")},
+						Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("256M")},
+					},
+					VolumeMounts:    []apiv1.VolumeMount{{Name: "token", MountPath: serviceAccountMountPath}},
+					SecurityContext: &apiv1.SecurityContext{RunAsUser: &uid},
+				},
+				{Name: "proxy", Image: "envoy:latest"},
+			},
+		},
+		Status: apiv1.PodStatus{Phase: apiv1.PodRunning},
+	}
+}
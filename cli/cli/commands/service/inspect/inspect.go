@@ -7,12 +7,11 @@ package inspect
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
-	"gopkg.in/yaml.v3"
-	"strings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
@@ -29,6 +28,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
+	"time"
 )
 
 const (
@@ -43,15 +43,11 @@ const (
 	fullUuidFlagKey        = "full-uuid"
 	fullUuidFlagKeyDefault = "false"
 
-	outputFormatKey          = "output"
-	OutputFormatKeyShorthand = "o"
-	stdoutOutputFormat       = ""
-	yamlOutputFormat         = "yaml"
-	JsonOutputFormat         = "json"
-
 	ServiceNameTitleName           = "Name"
 	ServiceUUIDTitleName           = "UUID"
 	ServiceStatusTitleName         = "Status"
+	ServiceHealthTitleName         = "Health"
+	ServiceLastCrashTitleName      = "Last crash"
 	ServiceImageTitleName          = "Image"
 	ServicePortsTitleName          = "Ports"
 	ServiceEntrypointArgsTitleName = "ENTRYPOINT"
@@ -65,7 +61,7 @@ const (
 var ServiceInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.ServiceInspectCmdStr,
 	ShortDescription:          "Inspect a service",
-	LongDescription:           "List information about the service's status and contents",
+	LongDescription:           "List information about the service's status and contents; pass '--" + defaults.OutputFormatFlagKey + " json' or '--" + defaults.OutputFormatFlagKey + " yaml' to get the service config instead, in the format 'service add' and 'service update' accept",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -75,13 +71,6 @@ var ServiceInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtos
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
-		{
-			Key:       outputFormatKey,
-			Shorthand: OutputFormatKeyShorthand,
-			Usage:     "Format to output the result (yaml or json)",
-			Type:      flags.FlagType_String,
-			Default:   stdoutOutputFormat,
-		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -123,13 +112,13 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidFlagKey)
 	}
 
-	outputFormat, err := flags.GetString(outputFormatKey)
+	outputFormatStr, err := flags.GetString(defaults.OutputFormatFlagKey)
 	if err != nil {
-		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", outputFormatKey)
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
 	}
-	outputFormat = strings.ToLower(strings.TrimSpace(outputFormat))
-	if outputFormat != "" && outputFormat != JsonOutputFormat && outputFormat != yamlOutputFormat {
-		return stacktrace.NewError("Invalid output format '%s'; must be '%v' or '%v'", outputFormat, JsonOutputFormat, yamlOutputFormat)
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", defaults.OutputFormatFlagKey)
 	}
 
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
@@ -149,27 +138,17 @@ func run(
 	return nil
 }
 
-func PrintServiceInspect(userService *kurtosis_core_rpc_api_bindings.ServiceInfo, userServiceConfig *services.ServiceConfig, showFullUuid bool, outputFormat string) error {
-	switch outputFormat {
-	case JsonOutputFormat:
-		jsonServiceConfigStr, err := json.MarshalIndent(userServiceConfig, "", "  ")
-		if err != nil {
-			return stacktrace.Propagate(err, "Failed to marshal service info to %s", outputFormat)
-		}
-		out.PrintOutLn(string(jsonServiceConfigStr))
-	case yamlOutputFormat:
-		yamlServiceConfigStr, err := yaml.Marshal(userServiceConfig)
-		if err != nil {
+// PrintServiceInspect prints the service config with structured output formats, rather than the service info, so that
+// the output can be fed back to 'service add' and 'service update'
+func PrintServiceInspect(userService *kurtosis_core_rpc_api_bindings.ServiceInfo, userServiceConfig *services.ServiceConfig, showFullUuid bool, outputFormat output_printers.OutputFormat) error {
+	if outputFormat.IsStructured() {
+		if err := output_printers.PrintStructuredOutput(outputFormat, userServiceConfig); err != nil {
 			return stacktrace.Propagate(err, "Failed to marshal service info to %s", outputFormat)
 		}
-		out.PrintOutLn(string(yamlServiceConfigStr))
-	case stdoutOutputFormat:
-		err := printlnServiceInfo(userService, showFullUuid)
-		if err != nil {
-			return stacktrace.Propagate(err, "Failed to print service info to stdout.")
-		}
-	default:
-		return stacktrace.NewError("Unsupported output format '%v'", outputFormat)
+		return nil
+	}
+	if err := printlnServiceInfo(userService, showFullUuid); err != nil {
+		return stacktrace.Propagate(err, "Failed to print service info to stdout.")
 	}
 	return nil
 }
@@ -187,6 +166,21 @@ func printlnServiceInfo(userService *kurtosis_core_rpc_api_bindings.ServiceInfo,
 	serviceStatusStr := service_status_stringifier.ServiceStatusStringifier(serviceStatus)
 	out.PrintOutLn(fmt.Sprintf("%s: %s", ServiceStatusTitleName, serviceStatusStr))
 
+	// Only services with ready conditions have their health checked
+	if serviceHealth := userService.GetHealth(); serviceHealth != nil {
+		out.PrintOutLn(fmt.Sprintf("%s: %s", ServiceHealthTitleName, getServiceHealthStr(serviceHealth)))
+		if serviceHealth.GetLastError() != "" {
+			out.PrintOutLn(fmt.Sprintf("  Last error: %s", serviceHealth.GetLastError()))
+		}
+	}
+
+	if lastCrash := userService.GetLastCrash(); lastCrash != nil {
+		out.PrintOutLn(fmt.Sprintf("%s: %s", ServiceLastCrashTitleName, getServiceLastCrashStr(lastCrash)))
+		if lastCrash.GetDiagnosticsFilesArtifactName() != "" {
+			out.PrintOutLn(fmt.Sprintf("  Diagnostics files artifact: %s", lastCrash.GetDiagnosticsFilesArtifactName()))
+		}
+	}
+
 	out.PrintOutLn(fmt.Sprintf("%s: %s", ServiceImageTitleName, userService.GetContainer().GetImageName()))
 
 	out.PrintOutLn(fmt.Sprintf("%s:", ServicePortsTitleName))
@@ -214,3 +208,30 @@ func printlnServiceInfo(userService *kurtosis_core_rpc_api_bindings.ServiceInfo,
 	}
 	return nil
 }
+
+func getServiceHealthStr(serviceHealth *kurtosis_core_rpc_api_bindings.ServiceHealth) string {
+	healthStr := "HEALTHY"
+	if !serviceHealth.GetIsHealthy() {
+		healthStr = "UNHEALTHY"
+	}
+	if serviceHealth.GetConsecutiveFailures() > 0 {
+		healthStr = fmt.Sprintf("%s (%d consecutive failed checks)", healthStr, serviceHealth.GetConsecutiveFailures())
+	}
+	if serviceHealth.GetRestartCount() > 0 {
+		healthStr = fmt.Sprintf("%s, restarted %d times", healthStr, serviceHealth.GetRestartCount())
+	}
+	return healthStr
+}
+
+func getServiceLastCrashStr(lastCrash *kurtosis_core_rpc_api_bindings.ServiceCrashReport) string {
+	lastCrashStr := fmt.Sprintf("exit code %d", lastCrash.GetExitCode())
+	if lastCrash.GetIsOomKilled() {
+		lastCrashStr = fmt.Sprintf("%s, OOM-killed", lastCrashStr)
+	} else if lastCrash.GetReason() != "" {
+		lastCrashStr = fmt.Sprintf("%s (%s)", lastCrashStr, lastCrash.GetReason())
+	}
+	if lastCrash.GetFinishedAt() != nil {
+		lastCrashStr = fmt.Sprintf("%s at %s", lastCrashStr, lastCrash.GetFinishedAt().AsTime().Local().Format(time.RFC3339))
+	}
+	return lastCrashStr
+}
@@ -8,6 +8,7 @@ package service
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/adopt"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/exec"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/logs"
@@ -15,6 +16,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/shell"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/start"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/stop"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/sync"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/update"
 	"github.com/spf13/cobra"
 )
@@ -29,12 +31,14 @@ var ServiceCmd = &cobra.Command{
 
 func init() {
 	ServiceCmd.AddCommand(add.ServiceAddCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(adopt.ServiceAdoptCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(exec.ServiceShellCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(logs.ServiceLogsCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(rm.ServiceRmCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(shell.ServiceShellCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(start.ServiceStartCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(stop.ServiceStopCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(sync.ServiceSyncCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(inspect.ServiceInspectCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(update.ServiceUpdateCmd.MustGetCobraCommand())
 }
@@ -87,7 +87,7 @@ func GetServiceInfo(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisC
 		serviceMap := map[string]bool{
 			serviceIdentifier: true,
 		}
-		userServices, err = user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, serviceMap)
+		userServices, err = user_services.GetUserServiceInfoMapFromAPIContainer(ctx, kurtosisCtx, enclaveInfo, serviceMap)
 		if err != nil {
 			return nil, nil, stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
 		}
@@ -7,6 +7,10 @@ package shell
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
@@ -15,11 +19,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -33,15 +39,68 @@ const (
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
+
+	shellFlagKey     = "shell"
+	shellFlagDefault = ""
+
+	containerUserFlagKey      = "user"
+	containerUserFlagShortKey = "u"
+	containerUserFlagDefault  = ""
+
+	envVarsFlagDefault = ""
+
+	reconnectAttemptsFlagKey     = "reconnect-attempts"
+	reconnectAttemptsFlagDefault = "5"
+
+	binShCommand     = "sh"
+	binShCommandFlag = "-c"
+	shellQuote       = "'"
+
+	initialReconnectBackoff = 1 * time.Second
+	maxReconnectBackoff     = 16 * time.Second
+	reconnectBackoffFactor  = 2
+	// A session that lasted this long is considered to have been reconnected successfully, so the attempts start over
+	minSessionDurationToResetReconnectAttempts = 30 * time.Second
 )
 
 var ServiceShellCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.ServiceShellCmdStr,
 	ShortDescription:          "Gets a shell on a service",
-	LongDescription:           "Starts a shell on the specified service",
+	LongDescription:           "Starts a shell on the specified service, by default bash if the service has it and sh otherwise. If the connection to the service drops, e.g. on a network blip, a new shell is started on it",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
-	Flags:                     []*flags.FlagConfig{},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     shellFlagKey,
+			Usage:   "The shell binary to start, e.g. 'zsh' or '/bin/ash'. Defaults to bash if the service has it and sh otherwise",
+			Type:    flags.FlagType_String,
+			Default: shellFlagDefault,
+		},
+		{
+			Key:       containerUserFlagKey,
+			Usage:     "The user to start the shell as. Defaults to the user of the service container; not supported on Kubernetes",
+			Shorthand: containerUserFlagShortKey,
+			Type:      flags.FlagType_String,
+			Default:   containerUserFlagDefault,
+		},
+		{
+			Key: service_helpers.EnvvarsFlagKey,
+			Usage: fmt.Sprintf(
+				"String containing environment variables to set in the shell, on top of the ones of the service, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\"",
+				service_helpers.EnvvarKeyValueDelimiter,
+				service_helpers.EnvvarDeclarationsDelimiter,
+				service_helpers.EnvvarKeyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: envVarsFlagDefault,
+		},
+		{
+			Key:     reconnectAttemptsFlagKey,
+			Usage:   "How many times to try starting a new shell when the connection to the service drops; 0 disables reconnecting",
+			Type:    flags.FlagType_Uint32,
+			Default: reconnectAttemptsFlagDefault,
+		},
+	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
 			enclaveIdentifierArgKey,
@@ -95,9 +154,128 @@ func run(
 	}
 	serviceUuid := service.ServiceUUID(serviceCtx.GetServiceUUID())
 
-	if err = kurtosisBackend.GetShellOnUserService(ctx, enclaveUuid, serviceUuid); err != nil {
+	shell, err := flags.GetString(shellFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", shellFlagKey)
+	}
+	containerUser, err := flags.GetString(containerUserFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", containerUserFlagKey)
+	}
+	envVarsStr, err := flags.GetString(service_helpers.EnvvarsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", service_helpers.EnvvarsFlagKey)
+	}
+	envVars, err := service_helpers.ParseEnvVarsStr(envVarsStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing environment variables string '%v'", envVarsStr)
+	}
+	maxReconnectAttempts, err := flags.GetUint32(reconnectAttemptsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", reconnectAttemptsFlagKey)
+	}
+
+	if shell != "" {
+		if err = ensureShellExistsOnService(ctx, kurtosisBackend, enclaveUuid, serviceUuid, shell, containerUser); err != nil {
+			return stacktrace.Propagate(err, "An error occurred checking that shell '%v' exists on service '%v'", shell, serviceIdentifier)
+		}
+	}
+
+	shellOptions := service.NewShellOptions(shell, containerUser, envVars)
+	if err = runShellWithReconnects(ctx, kurtosisBackend, enclaveUuid, serviceUuid, shellOptions, maxReconnectAttempts); err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting shell on user service with UUID '%v' in enclave '%v'", serviceUuid, enclaveIdentifier)
 	}
+	return nil
+}
+
+// runShellWithReconnects starts a new shell every time the session is disconnected, backing off between attempts.
+// Note that the input typed right after a reconnection can be read by the previous session, which is gone, so the
+// first keystroke might need to be typed again
+func runShellWithReconnects(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	shellOptions *service.ShellOptions,
+	maxReconnectAttempts uint32,
+) error {
+	reconnectAttempts := uint32(0)
+	for {
+		sessionStartTime := time.Now()
+		isSessionDisconnected, err := kurtosisBackend.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, shellOptions)
+		if err != nil && reconnectAttempts == 0 {
+			return stacktrace.Propagate(err, "An error occurred getting a shell on service with UUID '%v'", serviceUuid)
+		}
+		if err == nil && !isSessionDisconnected {
+			return nil
+		}
+		if err != nil {
+			// failing to start the shell again is most likely the connection still being down
+			logrus.Debugf("An error occurred starting a new shell on service with UUID '%v':\n%v", serviceUuid, err)
+		}
 
+		if time.Since(sessionStartTime) >= minSessionDurationToResetReconnectAttempts {
+			reconnectAttempts = 0
+		}
+		if reconnectAttempts >= maxReconnectAttempts {
+			return stacktrace.NewError("The shell session on service with UUID '%v' was disconnected and couldn't be started again after %v attempts", serviceUuid, reconnectAttempts)
+		}
+		reconnectAttempts++
+		backoff := getReconnectBackoff(reconnectAttempts)
+		logrus.Warnf("The shell session was disconnected; starting a new shell in %v (attempt %v of %v)...", backoff, reconnectAttempts, maxReconnectAttempts)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return stacktrace.Propagate(ctx.Err(), "Interrupted while waiting to start a new shell on service with UUID '%v'", serviceUuid)
+		}
+	}
+}
+
+// getReconnectBackoff doubles the time to wait before each attempt, up to a maximum
+func getReconnectBackoff(reconnectAttempt uint32) time.Duration {
+	backoff := initialReconnectBackoff
+	for i := uint32(1); i < reconnectAttempt && backoff < maxReconnectBackoff; i++ {
+		backoff *= reconnectBackoffFactor
+	}
+	if backoff > maxReconnectBackoff {
+		return maxReconnectBackoff
+	}
+	return backoff
+}
+
+// ensureShellExistsOnService fails early with a clear error when the shell doesn't exist, rather than having the
+// session end right away
+func ensureShellExistsOnService(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	shell string,
+	containerUser string,
+) error {
+	if strings.Contains(shell, shellQuote) {
+		return stacktrace.NewError("Shell '%v' is not valid, it can't contain quotes", shell)
+	}
+	checkShellCommand := fmt.Sprintf("command -v %v%v%v", shellQuote, shell, shellQuote)
+	results, resultErrors, err := kurtosisBackend.RunUserServiceExecCommands(ctx, enclaveUuid, containerUser, map[service.ServiceUUID][]string{
+		serviceUuid: {
+			binShCommand,
+			binShCommandFlag,
+			checkShellCommand,
+		},
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running '%v' on service with UUID '%v'", checkShellCommand, serviceUuid)
+	}
+	if err, found := resultErrors[serviceUuid]; found {
+		return stacktrace.Propagate(err, "An error occurred running '%v' on service with UUID '%v'", checkShellCommand, serviceUuid)
+	}
+	result, found := results[serviceUuid]
+	if !found {
+		return stacktrace.NewError("The result of running '%v' on service with UUID '%v' is unknown; this is a bug in Kurtosis", checkShellCommand, serviceUuid)
+	}
+	if result.GetExitCode() != 0 {
+		return stacktrace.NewError("Shell '%v' wasn't found on service with UUID '%v'", shell, serviceUuid)
+	}
 	return nil
 }
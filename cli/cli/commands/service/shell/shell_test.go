@@ -0,0 +1,16 @@
+package shell
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReconnectBackoff(t *testing.T) {
+	require.Equal(t, 1*time.Second, getReconnectBackoff(1))
+	require.Equal(t, 2*time.Second, getReconnectBackoff(2))
+	require.Equal(t, 8*time.Second, getReconnectBackoff(4))
+	require.Equal(t, maxReconnectBackoff, getReconnectBackoff(5))
+	require.Equal(t, maxReconnectBackoff, getReconnectBackoff(100))
+}
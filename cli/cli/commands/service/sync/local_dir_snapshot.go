@@ -0,0 +1,244 @@
+package sync
+
+import (
+	"archive/tar"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	tarDirSuffix = "/"
+	noLinkTarget = ""
+)
+
+// localPathState is what gets compared between two snapshots to decide whether a path needs to be synced again
+type localPathState struct {
+	mode    fs.FileMode
+	size    int64
+	modTime time.Time
+}
+
+// localDirSnapshot maps the slash-separated path of every file, directory and symlink relative to the synced
+// directory to its state
+type localDirSnapshot map[string]*localPathState
+
+// takeLocalDirSnapshot walks the local directory, skipping the paths whose name or relative path matches one of the
+// exclude patterns (and everything below excluded directories)
+func takeLocalDirSnapshot(localDirpath string, excludePatterns []string) (localDirSnapshot, error) {
+	snapshot := localDirSnapshot{}
+	walkErr := filepath.WalkDir(localDirpath, func(filepathToVisit string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			// the path got removed after its parent directory got listed; the next snapshot won't have it either
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if filepathToVisit == localDirpath {
+			return nil
+		}
+		relativeFilepath, err := filepath.Rel(localDirpath, filepathToVisit)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", filepathToVisit, localDirpath)
+		}
+		relativePath := filepath.ToSlash(relativeFilepath)
+
+		isExcluded, err := isPathExcluded(relativePath, excludePatterns)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred checking whether '%v' is excluded", relativePath)
+		}
+		if isExcluded {
+			if dirEntry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		fileType := dirEntry.Type()
+		if !fileType.IsRegular() && !fileType.IsDir() && fileType&fs.ModeSymlink == 0 {
+			// sockets, pipes and devices only make sense on the machine where they were created
+			return nil
+		}
+		fileInfo, err := dirEntry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return stacktrace.Propagate(err, "An error occurred getting the info of '%v'", filepathToVisit)
+		}
+		snapshot[relativePath] = &localPathState{
+			mode:    fileInfo.Mode(),
+			size:    fileInfo.Size(),
+			modTime: fileInfo.ModTime(),
+		}
+		return nil
+	})
+	if walkErr != nil {
+		return nil, stacktrace.Propagate(walkErr, "An error occurred walking local directory '%v'", localDirpath)
+	}
+	return snapshot, nil
+}
+
+// diffLocalDirSnapshots returns the paths that are new or modified in the current snapshot, and the paths that are gone
+// from it. Removed paths below a removed directory are left out as removing the directory takes care of them
+func diffLocalDirSnapshots(previousSnapshot localDirSnapshot, currentSnapshot localDirSnapshot) ([]string, []string) {
+	changedPaths := []string{}
+	for relativePath, currentState := range currentSnapshot {
+		previousState, found := previousSnapshot[relativePath]
+		if found && isSameState(previousState, currentState) {
+			continue
+		}
+		changedPaths = append(changedPaths, relativePath)
+	}
+	sort.Strings(changedPaths)
+
+	removedPaths := []string{}
+	for relativePath := range previousSnapshot {
+		if _, found := currentSnapshot[relativePath]; found {
+			continue
+		}
+		if isParentDirRemoved(relativePath, previousSnapshot, currentSnapshot) {
+			continue
+		}
+		removedPaths = append(removedPaths, relativePath)
+	}
+	sort.Strings(removedPaths)
+
+	return changedPaths, removedPaths
+}
+
+// writeLocalPathsAsTar writes the given paths of the local directory to the output as a TAR, with the names relative
+// to the local directory. Paths that no longer exist are skipped; the next snapshot will report them as removed
+func writeLocalPathsAsTar(localDirpath string, relativePaths []string, output io.Writer) error {
+	tarWriter := tar.NewWriter(output)
+	for _, relativePath := range relativePaths {
+		if err := writeLocalPathToTar(localDirpath, relativePath, tarWriter); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding '%v' to the TAR", relativePath)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the TAR")
+	}
+	return nil
+}
+
+func writeLocalPathToTar(localDirpath string, relativePath string, tarWriter *tar.Writer) error {
+	localFilepath := filepath.Join(localDirpath, filepath.FromSlash(relativePath))
+	fileInfo, err := os.Lstat(localFilepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "An error occurred getting the info of '%v'", localFilepath)
+	}
+
+	linkTarget := noLinkTarget
+	if fileInfo.Mode()&fs.ModeSymlink != 0 {
+		linkTarget, err = os.Readlink(localFilepath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the target of symlink '%v'", localFilepath)
+		}
+	}
+	header, err := tar.FileInfoHeader(fileInfo, linkTarget)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the TAR header of '%v'", localFilepath)
+	}
+	header.Name = relativePath
+	if fileInfo.IsDir() {
+		header.Name += tarDirSuffix
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the TAR header of '%v'", localFilepath)
+		}
+		return nil
+	}
+
+	file, err := os.Open(localFilepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "An error occurred opening '%v'", localFilepath)
+	}
+	defer file.Close()
+	if err = tarWriter.WriteHeader(header); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the TAR header of '%v'", localFilepath)
+	}
+	// the file can be written to while it gets synced, so no more than the size in the header is copied; the next
+	// snapshot will pick up the new content
+	copiedBytes, err := io.CopyN(tarWriter, file, header.Size)
+	if err != nil && err != io.EOF {
+		return stacktrace.Propagate(err, "An error occurred writing the content of '%v' to the TAR", localFilepath)
+	}
+	if copiedBytes < header.Size {
+		// the file got truncated in the meantime, the entry is padded to keep the TAR valid
+		if _, err = io.CopyN(tarWriter, zeroReader{}, header.Size-copiedBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred padding the content of '%v' in the TAR", localFilepath)
+		}
+	}
+	return nil
+}
+
+func isPathExcluded(relativePath string, excludePatterns []string) (bool, error) {
+	for _, excludePattern := range excludePatterns {
+		for _, pathToMatch := range []string{relativePath, path.Base(relativePath)} {
+			isMatch, err := path.Match(excludePattern, pathToMatch)
+			if err != nil {
+				return false, stacktrace.Propagate(err, "Exclude pattern '%v' is invalid", excludePattern)
+			}
+			if isMatch {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func isSameState(previousState *localPathState, currentState *localPathState) bool {
+	return previousState.mode == currentState.mode &&
+		previousState.size == currentState.size &&
+		previousState.modTime.Equal(currentState.modTime)
+}
+
+func isParentDirRemoved(relativePath string, previousSnapshot localDirSnapshot, currentSnapshot localDirSnapshot) bool {
+	for parentPath := path.Dir(relativePath); parentPath != "."; parentPath = path.Dir(parentPath) {
+		_, wasPresent := previousSnapshot[parentPath]
+		_, isPresent := currentSnapshot[parentPath]
+		if wasPresent && !isPresent {
+			return true
+		}
+	}
+	return false
+}
+
+// splitExcludePatterns turns the comma-separated value of the exclude flag into patterns
+func splitExcludePatterns(excludeFlagValue string) []string {
+	excludePatterns := []string{}
+	for _, excludePattern := range strings.Split(excludeFlagValue, excludePatternsSeparator) {
+		trimmedPattern := strings.TrimSpace(excludePattern)
+		if trimmedPattern == "" {
+			continue
+		}
+		excludePatterns = append(excludePatterns, strings.Trim(trimmedPattern, tarDirSuffix))
+	}
+	return excludePatterns
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for idx := range p {
+		p[idx] = 0
+	}
+	return len(p), nil
+}
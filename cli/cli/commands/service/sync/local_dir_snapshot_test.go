@@ -0,0 +1,98 @@
+package sync
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFileMode = 0o644
+	testDirMode  = 0o755
+)
+
+func TestTakeLocalDirSnapshot_SkipsExcludedPaths(t *testing.T) {
+	localDirpath := t.TempDir()
+	writeTestFile(t, localDirpath, "main.go", "package main")
+	writeTestFile(t, localDirpath, "src/lib.go", "package src")
+	writeTestFile(t, localDirpath, ".git/HEAD", "ref: refs/heads/main")
+	writeTestFile(t, localDirpath, "src/debug.log", "debug")
+
+	snapshot, err := takeLocalDirSnapshot(localDirpath, splitExcludePatterns(".git/, *.log"))
+	require.NoError(t, err)
+
+	snapshotPaths := []string{}
+	for relativePath := range snapshot {
+		snapshotPaths = append(snapshotPaths, relativePath)
+	}
+	require.ElementsMatch(t, []string{"main.go", "src", "src/lib.go"}, snapshotPaths)
+}
+
+func TestDiffLocalDirSnapshots(t *testing.T) {
+	modTime := time.Now()
+	previousSnapshot := localDirSnapshot{
+		"unchanged.go":      {mode: testFileMode, size: 1, modTime: modTime},
+		"modified.go":       {mode: testFileMode, size: 1, modTime: modTime},
+		"removed.go":        {mode: testFileMode, size: 1, modTime: modTime},
+		"removed_dir":       {mode: os.ModeDir | testDirMode, size: 0, modTime: modTime},
+		"removed_dir/file":  {mode: testFileMode, size: 1, modTime: modTime},
+		"kept_dir":          {mode: os.ModeDir | testDirMode, size: 0, modTime: modTime},
+		"kept_dir/removed":  {mode: testFileMode, size: 1, modTime: modTime},
+		"kept_dir/modified": {mode: testFileMode, size: 1, modTime: modTime},
+	}
+	currentSnapshot := localDirSnapshot{
+		"unchanged.go":      {mode: testFileMode, size: 1, modTime: modTime},
+		"modified.go":       {mode: testFileMode, size: 1, modTime: modTime.Add(time.Second)},
+		"added.go":          {mode: testFileMode, size: 1, modTime: modTime},
+		"kept_dir":          {mode: os.ModeDir | testDirMode, size: 0, modTime: modTime},
+		"kept_dir/modified": {mode: testFileMode, size: 2, modTime: modTime},
+	}
+
+	changedPaths, removedPaths := diffLocalDirSnapshots(previousSnapshot, currentSnapshot)
+	require.Equal(t, []string{"added.go", "kept_dir/modified", "modified.go"}, changedPaths)
+	require.Equal(t, []string{"kept_dir/removed", "removed.go", "removed_dir"}, removedPaths)
+}
+
+func TestWriteLocalPathsAsTar(t *testing.T) {
+	localDirpath := t.TempDir()
+	writeTestFile(t, localDirpath, "src/lib.go", "package src")
+	require.NoError(t, os.Symlink("src/lib.go", filepath.Join(localDirpath, "link.go")))
+
+	tarContent := &bytes.Buffer{}
+	require.NoError(t, writeLocalPathsAsTar(localDirpath, []string{"link.go", "src", "src/lib.go", "gone.go"}, tarContent))
+
+	tarReader := tar.NewReader(tarContent)
+	header, err := tarReader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "link.go", header.Name)
+	require.Equal(t, byte(tar.TypeSymlink), header.Typeflag)
+	require.Equal(t, "src/lib.go", header.Linkname)
+
+	header, err = tarReader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "src/", header.Name)
+	require.Equal(t, byte(tar.TypeDir), header.Typeflag)
+
+	header, err = tarReader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "src/lib.go", header.Name)
+	content, err := io.ReadAll(tarReader)
+	require.NoError(t, err)
+	require.Equal(t, "package src", string(content))
+
+	// gone.go doesn't exist, so it's skipped
+	_, err = tarReader.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func writeTestFile(t *testing.T, dirpath string, relativeFilepath string, content string) {
+	filepathToWrite := filepath.Join(dirpath, relativeFilepath)
+	require.NoError(t, os.MkdirAll(filepath.Dir(filepathToWrite), testDirMode))
+	require.NoError(t, os.WriteFile(filepathToWrite, []byte(content), testFileMode))
+}
@@ -0,0 +1,306 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey  = "service"
+	isServiceGuidArgOptional = false
+	isServiceGuidArgGreedy   = false
+
+	localDirpathArgKey        = "local-dirpath"
+	isLocalDirpathArgOptional = false
+	isLocalDirpathArgGreedy   = false
+
+	serviceDirpathArgKey        = "service-dirpath"
+	isServiceDirpathArgOptional = false
+	isServiceDirpathArgGreedy   = false
+
+	intervalFlagKey     = "interval"
+	defaultIntervalStr  = "1s"
+	excludeFlagKey      = "exclude"
+	defaultExcludeValue = ".git"
+
+	excludePatternsSeparator = ","
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	interruptChanBufferSize = 5
+
+	// the removal is run as the default user of the container, which is the one the files get copied as
+	defaultContainerUser = ""
+	successExitCode      = 0
+)
+
+// the paths to remove get appended to this command
+var removePathsCommand = []string{"rm", "-rf", "--"}
+
+var ServiceSyncCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceSyncCmdStr,
+	ShortDescription: "Live-syncs a local directory into a service",
+	LongDescription: "Copies a local directory into a directory of a running service and keeps it in sync until interrupted: " +
+		"files created or modified locally are copied to the service and files removed locally are removed from it, " +
+		"so code changes get picked up without re-uploading files artifacts and restarting the service. " +
+		"Meant for development; changes made to the directory inside the service are not synced back",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       intervalFlagKey,
+			Usage:     "how often the local directory is checked for changes, as a duration (e.g. '500ms', '2s')",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultIntervalStr,
+		},
+		{
+			Key: excludeFlagKey,
+			Usage: fmt.Sprintf(
+				"comma-separated glob patterns of the paths that don't get synced, matched against both the name and the path relative to the local directory (e.g. '%v,node_modules,*.log')",
+				defaultExcludeValue,
+			),
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultExcludeValue,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isServiceGuidArgOptional,
+			isServiceGuidArgGreedy,
+		),
+		{
+			Key:        localDirpathArgKey,
+			IsOptional: isLocalDirpathArgOptional,
+			IsGreedy:   isLocalDirpathArgGreedy,
+		},
+		{
+			Key:        serviceDirpathArgKey,
+			IsOptional: isServiceDirpathArgOptional,
+			IsGreedy:   isServiceDirpathArgGreedy,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier using arg key '%v'", serviceIdentifierArgKey)
+	}
+
+	localDirpath, err := args.GetNonGreedyArg(localDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the local dirpath using arg key '%v'", localDirpathArgKey)
+	}
+	localDirpath, err = filepath.Abs(localDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the absolute path of local directory '%v'", localDirpath)
+	}
+	localDirInfo, err := os.Stat(localDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the info of local directory '%v'", localDirpath)
+	}
+	if !localDirInfo.IsDir() {
+		return stacktrace.NewError("Local path '%v' isn't a directory; only directories can be synced", localDirpath)
+	}
+
+	serviceDirpath, err := args.GetNonGreedyArg(serviceDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service dirpath using arg key '%v'", serviceDirpathArgKey)
+	}
+	if !path.IsAbs(serviceDirpath) {
+		return stacktrace.NewError("Service dirpath '%v' must be absolute", serviceDirpath)
+	}
+	serviceDirpath = path.Clean(serviceDirpath)
+
+	intervalStr, err := flags.GetString(intervalFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the interval using flag key '%v'", intervalFlagKey)
+	}
+	interval, err := time.ParseDuration(intervalStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing interval '%v'", intervalStr)
+	}
+	if interval <= 0 {
+		return stacktrace.NewError("The interval must be positive but was '%v'", intervalStr)
+	}
+
+	excludeFlagValue, err := flags.GetString(excludeFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the exclude patterns using flag key '%v'", excludeFlagKey)
+	}
+	excludePatterns := splitExcludePatterns(excludeFlagValue)
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting enclave context for enclave with identifier '%v' exists", enclaveIdentifier)
+	}
+	enclaveUuid := enclave.EnclaveUUID(enclaveCtx.GetEnclaveUuid())
+
+	serviceCtx, err := enclaveCtx.GetServiceContext(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting service context for service with identifier '%v'", serviceIdentifier)
+	}
+	serviceUuid := service.ServiceUUID(serviceCtx.GetServiceUUID())
+
+	// the initial sync copies everything, and has to succeed for the sync to start
+	syncedSnapshot, err := syncChanges(ctx, kurtosisBackend, enclaveUuid, serviceUuid, localDirpath, serviceDirpath, excludePatterns, localDirSnapshot{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred doing the initial sync of local directory '%v' to '%v' on service '%v'", localDirpath, serviceDirpath, serviceIdentifier)
+	}
+	out.PrintOutLn(fmt.Sprintf("Syncing local directory '%v' to '%v' on service '%v'; press Ctrl+C to stop", localDirpath, serviceDirpath, serviceIdentifier))
+
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+	defer signal.Stop(interruptChan)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			newSyncedSnapshot, err := syncChanges(ctx, kurtosisBackend, enclaveUuid, serviceUuid, localDirpath, serviceDirpath, excludePatterns, syncedSnapshot)
+			if err != nil {
+				// the service may just be restarting, so the changes are retried on the next tick
+				logrus.Warnf("An error occurred syncing local directory '%v' to service '%v', retrying in %v:\n%v", localDirpath, serviceIdentifier, interval, err)
+				continue
+			}
+			syncedSnapshot = newSyncedSnapshot
+		case <-interruptChan:
+			logrus.Debugf("Received signal interruption in service sync Kurtosis CLI command")
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// syncChanges applies to the service the changes of the local directory since the synced snapshot, and returns the
+// snapshot that is now in sync
+func syncChanges(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	localDirpath string,
+	serviceDirpath string,
+	excludePatterns []string,
+	syncedSnapshot localDirSnapshot,
+) (localDirSnapshot, error) {
+	currentSnapshot, err := takeLocalDirSnapshot(localDirpath, excludePatterns)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred taking a snapshot of local directory '%v'", localDirpath)
+	}
+	changedPaths, removedPaths := diffLocalDirSnapshots(syncedSnapshot, currentSnapshot)
+
+	if len(removedPaths) > 0 {
+		if err = removePathsFromService(ctx, kurtosisBackend, enclaveUuid, serviceUuid, serviceDirpath, removedPaths); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred removing '%v' from the service", removedPaths)
+		}
+	}
+
+	// the directory itself is always copied on the initial sync so that it gets created even if it's empty
+	if len(changedPaths) > 0 || len(syncedSnapshot) == 0 {
+		tarReader, tarWriter := io.Pipe()
+		go func() {
+			tarWriter.CloseWithError(writeLocalPathsAsTar(localDirpath, changedPaths, tarWriter))
+		}()
+		err = kurtosisBackend.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, serviceDirpath, tarReader)
+		// unblocks the TAR writer if the copy stopped reading early
+		tarReader.Close()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred copying '%v' to '%v' on the service", changedPaths, serviceDirpath)
+		}
+	}
+
+	if len(changedPaths) > 0 || len(removedPaths) > 0 {
+		logrus.Infof("Synced %v changed and %v removed paths", len(changedPaths), len(removedPaths))
+	}
+	return currentSnapshot, nil
+}
+
+func removePathsFromService(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	serviceDirpath string,
+	relativePaths []string,
+) error {
+	command := append([]string{}, removePathsCommand...)
+	for _, relativePath := range relativePaths {
+		command = append(command, path.Join(serviceDirpath, relativePath))
+	}
+
+	results, resultErrors, err := kurtosisBackend.RunUserServiceExecCommands(ctx, enclaveUuid, defaultContainerUser, map[service.ServiceUUID][]string{
+		serviceUuid: command,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running command '%v' on service '%v'", command, serviceUuid)
+	}
+	if err, found := resultErrors[serviceUuid]; found {
+		return stacktrace.Propagate(err, "An error occurred running command '%v' on service '%v'", command, serviceUuid)
+	}
+	result, found := results[serviceUuid]
+	if !found {
+		return stacktrace.NewError("The result of command '%v' on service '%v' wasn't returned neither as a success nor a failure; this is a bug in Kurtosis", command, serviceUuid)
+	}
+	if result.GetExitCode() != successExitCode {
+		return stacktrace.NewError("Command '%v' on service '%v' returned non-zero exit code '%v' with output:\n%v", command, serviceUuid, result.GetExitCode(), result.GetOutput())
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package update
 import (
 	"context"
 	"fmt"
+	"github.com/google/shlex"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
@@ -55,9 +56,11 @@ var (
 )
 
 var ServiceUpdateCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
-	CommandStr:                command_str_consts.ServiceUpdateCmdStr,
-	ShortDescription:          "Update a service",
-	LongDescription:           "Update a service",
+	CommandStr:       command_str_consts.ServiceUpdateCmdStr,
+	ShortDescription: "Update a service",
+	LongDescription: "Updates the image, ENTRYPOINT, CMD, env vars, ports or files of a running service without running " +
+		"a package; the rest of the service config, like its resources, user and labels, stays as-is. The service is " +
+		"restarted with the updated config",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -68,16 +71,14 @@ var ServiceUpdateCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosi
 			Default: "",
 		},
 		{
-			Key:   service_helpers.CmdKey,
-			Usage: "CMD to run on the service once it is restarted by the update.",
-			// TODO Make this a string list
+			Key:     service_helpers.CmdKey,
+			Usage:   "CMD to run on the service once it is restarted by the update, split into arguments like a shell does, e.g. \"sh -c 'sleep 10 && echo done'\"",
 			Type:    flags.FlagType_String,
 			Default: "",
 		},
 		{
-			Key:   service_helpers.EntrypointFlagKey,
-			Usage: "ENTRYPOINT binary that will be used when running the container, overriding the image's default ENTRYPOINT",
-			// TODO Make this a string list
+			Key:     service_helpers.EntrypointFlagKey,
+			Usage:   "ENTRYPOINT that will be used when running the container, overriding the image's default ENTRYPOINT, split into arguments like a shell does",
 			Type:    flags.FlagType_String,
 			Default: "",
 		},
@@ -161,8 +162,7 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for non-greedy enclave identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", enclaveIdentifierArgKey)
 	}
 
-	// TODO: need to enforce this is always service name, and not service uuid or short uuid
-	serviceName, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a value for non-greedy enclave identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", serviceIdentifierArgKey)
 	}
@@ -186,7 +186,7 @@ func run(
 		}
 	}()
 
-	err = metricsClient.TrackServiceUpdate(enclaveIdentifier, serviceName)
+	err = metricsClient.TrackServiceUpdate(enclaveIdentifier, serviceIdentifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking service update metric.")
 	}
@@ -240,10 +240,15 @@ func run(
 		)
 	}
 
-	_, currServiceConfig, err := service_helpers.GetServiceInfo(ctx, kurtosisCtx, enclaveIdentifier, serviceName)
+	currServiceInfo, currServiceConfig, err := service_helpers.GetServiceInfo(ctx, kurtosisCtx, enclaveIdentifier, serviceIdentifier)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting service info of service '%v' in enclave '%v'.", serviceName, enclaveIdentifier)
+		return stacktrace.Propagate(err, "An error occurred getting service info of service '%v' in enclave '%v'.", serviceIdentifier, enclaveIdentifier)
 	}
+	if currServiceInfo == nil {
+		return stacktrace.NewError("No service with identifier '%v' was found in enclave '%v'", serviceIdentifier, enclaveIdentifier)
+	}
+	// the service is replaced by adding a service with the same name, so a UUID must be resolved to the name
+	serviceName := currServiceInfo.GetName()
 
 	updatedServiceConfig := createUpdatedServiceConfigFromOverrides(overridesServiceConfig, currServiceConfig)
 
@@ -307,12 +312,18 @@ func parseOverridesServiceConfigFromFlags(
 
 	var overrideCmd []string
 	if cmd != "" {
-		overrideCmd = []string{cmd}
+		overrideCmd, err = shlex.Split(cmd)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred splitting cmd '%v' into arguments", cmd)
+		}
 	}
 
 	var overrideEntrypoint []string
 	if entrypoint != "" {
-		overrideEntrypoint = []string{entrypoint}
+		overrideEntrypoint, err = shlex.Split(entrypoint)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred splitting entrypoint '%v' into arguments", entrypoint)
+		}
 	}
 
 	var overrideEnvVars map[string]string
@@ -412,6 +423,7 @@ func createUpdatedServiceConfigFromOverrides(overridesServiceConfig, currService
 		updatedFilesArtifactsMountpoint[key] = val
 	}
 
+	// the fields that can't be overridden are kept, otherwise the update would reset them to their defaults
 	return &services.ServiceConfig{
 		Image:                       updatedImage,
 		PrivatePorts:                updatedPorts,
@@ -420,15 +432,15 @@ func createUpdatedServiceConfigFromOverrides(overridesServiceConfig, currService
 		Entrypoint:                  updatedEntrypoint,
 		Cmd:                         updatedCmd,
 		EnvVars:                     updatedEnvVarsMap,
-		PrivateIPAddressPlaceholder: "",
-		MaxMillicpus:                0,
-		MinMillicpus:                0,
-		MaxMemory:                   0,
-		MinMemory:                   0,
-		User:                        nil,
-		Tolerations:                 nil,
-		Labels:                      nil,
-		NodeSelectors:               nil,
-		TiniEnabled:                 nil,
+		PrivateIPAddressPlaceholder: currServiceConfig.PrivateIPAddressPlaceholder,
+		MaxMillicpus:                currServiceConfig.MaxMillicpus,
+		MinMillicpus:                currServiceConfig.MinMillicpus,
+		MaxMemory:                   currServiceConfig.MaxMemory,
+		MinMemory:                   currServiceConfig.MinMemory,
+		User:                        currServiceConfig.User,
+		Tolerations:                 currServiceConfig.Tolerations,
+		Labels:                      currServiceConfig.Labels,
+		NodeSelectors:               currServiceConfig.NodeSelectors,
+		TiniEnabled:                 currServiceConfig.TiniEnabled,
 	}
 }
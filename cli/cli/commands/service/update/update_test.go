@@ -268,3 +268,51 @@ func TestCreateUpdatedServiceConfigFromOverrides(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateUpdatedServiceConfigFromOverrides_KeepsFieldsThatCantBeOverridden(t *testing.T) {
+	isTiniEnabled := true
+	currConfig := &services.ServiceConfig{
+		Image:                       "old-image",
+		PrivatePorts:                nil,
+		PublicPorts:                 nil,
+		Files:                       nil,
+		Entrypoint:                  nil,
+		Cmd:                         nil,
+		EnvVars:                     nil,
+		PrivateIPAddressPlaceholder: "<IP_ADDRESS>",
+		MaxMillicpus:                2000,
+		MinMillicpus:                500,
+		MaxMemory:                   1024,
+		MinMemory:                   256,
+		User:                        &services.User{UID: 1000, GID: 1000},
+		Tolerations:                 []services.Toleration{{Key: "key", Operator: "Equal", Value: "value", Effect: "NoSchedule", TolerationSeconds: 0}},
+		Labels:                      map[string]string{"team": "infra"},
+		NodeSelectors:               map[string]string{"disk": "ssd"},
+		TiniEnabled:                 &isTiniEnabled,
+	}
+	overrideConfig, err := parseOverridesServiceConfigFromFlags("new-image", "", "", "", "", "")
+	require.NoError(t, err)
+
+	updated := createUpdatedServiceConfigFromOverrides(overrideConfig, currConfig)
+	require.Equal(t, "new-image", updated.Image)
+	require.Equal(t, currConfig.PrivateIPAddressPlaceholder, updated.PrivateIPAddressPlaceholder)
+	require.Equal(t, currConfig.MaxMillicpus, updated.MaxMillicpus)
+	require.Equal(t, currConfig.MinMillicpus, updated.MinMillicpus)
+	require.Equal(t, currConfig.MaxMemory, updated.MaxMemory)
+	require.Equal(t, currConfig.MinMemory, updated.MinMemory)
+	require.Equal(t, currConfig.User, updated.User)
+	require.Equal(t, currConfig.Tolerations, updated.Tolerations)
+	require.Equal(t, currConfig.Labels, updated.Labels)
+	require.Equal(t, currConfig.NodeSelectors, updated.NodeSelectors)
+	require.Equal(t, currConfig.TiniEnabled, updated.TiniEnabled)
+}
+
+func TestParseOverridesServiceConfigFromFlags_SplitsCmdAndEntrypoint(t *testing.T) {
+	overrideConfig, err := parseOverridesServiceConfigFromFlags("", "/bin/sh -c", `sleep 10 && echo "all done"`, "", "", "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/bin/sh", "-c"}, overrideConfig.Entrypoint)
+	require.Equal(t, []string{"sleep", "10", "&&", "echo", "all done"}, overrideConfig.Cmd)
+
+	_, err = parseOverridesServiceConfigFromFlags("", "", `echo "unterminated`, "", "", "")
+	require.Error(t, err)
+}
@@ -24,6 +24,12 @@ const (
 	DefaultEnableDebugMode                       = false
 	DefaultKurtosisContainerDebugImageNameSuffix = "debug"
 
+	// This is the persistent flag key used, across all the ls and inspect CLI commands, to print machine-readable output
+	// instead of the tables meant for humans
+	OutputFormatFlagKey       = "output"
+	OutputFormatFlagShorthand = "o"
+	DefaultOutputFormat       = ""
+
 	DefaultGitHubAuthTokenOverride = ""
 
 	DefaultDomain = ""
@@ -0,0 +1,55 @@
+package context_defaults
+
+import (
+	"strings"
+
+	command_args_run "github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/name_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// GetCurrentContextDefaults returns the defaults of the current context. They're empty if it has none, or if it can't be
+// read, in which case the commands run as if the context had no defaults
+func GetCurrentContextDefaults() *generated.ContextDefaults {
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		logrus.Debugf("Unable to retrieve the current Kurtosis context, its defaults won't be applied. Error was:\n%v", err)
+		// nolint: exhaustruct
+		return &generated.ContextDefaults{}
+	}
+	if currentContext.GetDefaults() == nil {
+		// nolint: exhaustruct
+		return &generated.ContextDefaults{}
+	}
+	return currentContext.GetDefaults()
+}
+
+// GenerateEnclaveName returns a random enclave name starting with the given prefix, for the enclave name prefix of a
+// context to apply to the enclaves created without a name
+func GenerateEnclaveName(enclaveNamePrefix string) string {
+	return enclaveNamePrefix + name_generator.GenerateNatureThemeNameForEnclave()
+}
+
+// ValidateClusterName checks that the default cluster of a context is defined in the Kurtosis config file
+func ValidateClusterName(clusterName string) error {
+	kurtosisConfig, err := kurtosis_config.NewKurtosisConfigProvider(kurtosis_config.GetKurtosisConfigStore()).GetOrInitializeConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get or initialize Kurtosis configuration when validating cluster name '%v'.", clusterName)
+	}
+	if _, found := kurtosisConfig.GetKurtosisClusters()[clusterName]; !found {
+		return stacktrace.NewError("Cluster '%v' isn't defined in the Kurtosis config file", clusterName)
+	}
+	return nil
+}
+
+// ValidateVerbosity checks that the default verbosity of a context is one 'kurtosis run' accepts
+func ValidateVerbosity(verbosity string) error {
+	if _, err := command_args_run.VerbosityString(verbosity); err != nil {
+		return stacktrace.Propagate(err, "Invalid verbosity value: '%s'. Possible values are %s", verbosity, strings.Join(command_args_run.VerbosityStrings(), ", "))
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package context_defaults
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateEnclaveName(t *testing.T) {
+	enclaveName := GenerateEnclaveName("ci-")
+	require.True(t, strings.HasPrefix(enclaveName, "ci-"))
+	require.Greater(t, len(enclaveName), len("ci-"))
+}
+
+func TestValidateVerbosity(t *testing.T) {
+	require.NoError(t, ValidateVerbosity("brief"))
+	require.Error(t, ValidateVerbosity("chatty"))
+}
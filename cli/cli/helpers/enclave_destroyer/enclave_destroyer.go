@@ -0,0 +1,46 @@
+package enclave_destroyer
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Bounds the engine calls in flight, as each one tears down a whole enclave in the container engine
+	maxConcurrentEnclaveDestructions = 8
+)
+
+// DestroyEnclavesInParallel destroys the enclaves with the function, a bounded number of them at a time, logging the
+// progress as each one finishes. It returns the errors of the enclaves that couldn't be destroyed
+func DestroyEnclavesInParallel(enclaveIdentifiers []string, destroyEnclaveFunc func(enclaveIdentifier string) error) map[string]error {
+	mutex := &sync.Mutex{}
+	destructionErrors := map[string]error{}
+	numFinishedDestructions := 0
+
+	concurrencySemaphore := make(chan struct{}, maxConcurrentEnclaveDestructions)
+	waitGroup := &sync.WaitGroup{}
+	for _, enclaveIdentifier := range enclaveIdentifiers {
+		waitGroup.Add(1)
+		concurrencySemaphore <- struct{}{}
+		go func(enclaveIdentifier string) {
+			defer func() {
+				<-concurrencySemaphore
+				waitGroup.Done()
+			}()
+			err := destroyEnclaveFunc(enclaveIdentifier)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			numFinishedDestructions++
+			if err != nil {
+				destructionErrors[enclaveIdentifier] = err
+				logrus.Warnf("Failed to destroy enclave '%v' (%v/%v)", enclaveIdentifier, numFinishedDestructions, len(enclaveIdentifiers))
+				return
+			}
+			logrus.Infof("Destroyed enclave '%v' (%v/%v)", enclaveIdentifier, numFinishedDestructions, len(enclaveIdentifiers))
+		}(enclaveIdentifier)
+	}
+	waitGroup.Wait()
+	return destructionErrors
+}
@@ -0,0 +1,53 @@
+package enclave_destroyer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNumEnclaves = 30
+)
+
+func TestDestroyEnclavesInParallel_ReturnsTheErrorsOfTheFailedEnclaves(t *testing.T) {
+	destroyErr := errors.New("destroy failed")
+	destructionErrors := DestroyEnclavesInParallel([]string{"ok", "failing"}, func(enclaveIdentifier string) error {
+		if enclaveIdentifier == "failing" {
+			return destroyErr
+		}
+		return nil
+	})
+	require.Equal(t, map[string]error{"failing": destroyErr}, destructionErrors)
+}
+
+func TestDestroyEnclavesInParallel_BoundsTheConcurrentDestructions(t *testing.T) {
+	enclaveIdentifiers := []string{}
+	for i := 0; i < testNumEnclaves; i++ {
+		enclaveIdentifiers = append(enclaveIdentifiers, fmt.Sprintf("enclave-%v", i))
+	}
+
+	mutex := &sync.Mutex{}
+	destroyedEnclaves := map[string]bool{}
+	numConcurrentDestructions := 0
+	maxSeenConcurrentDestructions := 0
+	destructionErrors := DestroyEnclavesInParallel(enclaveIdentifiers, func(enclaveIdentifier string) error {
+		mutex.Lock()
+		numConcurrentDestructions++
+		maxSeenConcurrentDestructions = max(maxSeenConcurrentDestructions, numConcurrentDestructions)
+		destroyedEnclaves[enclaveIdentifier] = true
+		mutex.Unlock()
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		numConcurrentDestructions--
+		return nil
+	})
+
+	require.Empty(t, destructionErrors)
+	require.Len(t, destroyedEnclaves, testNumEnclaves)
+	require.LessOrEqual(t, maxSeenConcurrentDestructions, maxConcurrentEnclaveDestructions)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/stacktrace"
+	"strings"
 )
 
 var (
@@ -13,14 +14,47 @@ var (
 )
 
 func EnclaveContainersStatusStringifier(enclaveStatus kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus) (string, error) {
+	enclaveStatusStr, err := EnclaveContainersStatusPlainStringifier(enclaveStatus)
+	if err != nil {
+		return "", err
+	}
+	switch enclaveStatus {
+	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_EMPTY:
+		return colorizeEmpty(enclaveStatusStr), nil
+	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING:
+		return colorizeRunning(enclaveStatusStr), nil
+	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED:
+		return colorizeStopped(enclaveStatusStr), nil
+	default:
+		return enclaveStatusStr, nil
+	}
+}
+
+// EnclaveContainersStatusPlainStringifier returns the status without colors, for machine-readable output
+func EnclaveContainersStatusPlainStringifier(enclaveStatus kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus) (string, error) {
 	switch enclaveStatus {
 	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_EMPTY:
-		return colorizeEmpty("EMPTY"), nil
+		return "EMPTY", nil
 	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING:
-		return colorizeRunning("RUNNING"), nil
+		return "RUNNING", nil
 	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED:
-		return colorizeStopped("STOPPED"), nil
+		return "STOPPED", nil
 	default:
 		return "", stacktrace.NewError("Unrecognized enclave status '%v'; this is a bug in Kurtosis", enclaveStatus)
 	}
 }
+
+// ParseEnclaveContainersStatus is the reverse of EnclaveContainersStatusPlainStringifier, ignoring the case
+func ParseEnclaveContainersStatus(enclaveStatusStr string) (kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus, error) {
+	for enclaveStatusValue := range kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_name {
+		enclaveStatus := kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus(enclaveStatusValue)
+		candidateEnclaveStatusStr, err := EnclaveContainersStatusPlainStringifier(enclaveStatus)
+		if err != nil {
+			return 0, err
+		}
+		if strings.EqualFold(candidateEnclaveStatusStr, enclaveStatusStr) {
+			return enclaveStatus, nil
+		}
+	}
+	return 0, stacktrace.NewError("Unrecognized enclave status '%v'; it should be one of 'running', 'stopped' or 'empty'", enclaveStatusStr)
+}
@@ -12,3 +12,12 @@ func TestAllEnclaveContainersStatusAreCovered(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func TestParseEnclaveContainersStatus(t *testing.T) {
+	enclaveStatus, err := ParseEnclaveContainersStatus("running")
+	assert.Nil(t, err)
+	assert.Equal(t, kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING, enclaveStatus)
+
+	_, err = ParseEnclaveContainersStatus("paused")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,294 @@
+package engine_login
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+	deviceCodeGrantType   = "urn:ietf:params:oauth:grant-type:device_code"
+	refreshTokenGrantType = "refresh_token"
+
+	// 'groups' isn't a standard scope, but the providers listing the groups in the ID token only do it when asked
+	deviceFlowScopes  = "openid profile email groups offline_access"
+	refreshFlowScopes = "openid"
+
+	authorizationPendingError = "authorization_pending"
+	slowDownError             = "slow_down"
+
+	// As RFC 8628 says, when the provider doesn't give the interval, and the amount it is raised by on 'slow_down'
+	defaultPollInterval  = 5 * time.Second
+	slowDownPollInterval = 5 * time.Second
+
+	httpRequestTimeout = 30 * time.Second
+	maxResponseBytes   = 1 << 20
+
+	jwtNumParts     = 3
+	jwtPayloadIndex = 1
+
+	contentTypeHeader     = "Content-Type"
+	formUrlEncodedContent = "application/x-www-form-urlencoded"
+	acceptHeader          = "Accept"
+	jsonContent           = "application/json"
+)
+
+type oidcDiscoveryDocument struct {
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+}
+
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationUri         string `json:"verification_uri"`
+	VerificationUriComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+type tokenResponse struct {
+	IdToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+	Error        string `json:"error"`
+	Description  string `json:"error_description"`
+}
+
+type idTokenClaims struct {
+	Subject           string `json:"sub"`
+	Email             string `json:"email"`
+	PreferredUsername string `json:"preferred_username"`
+	ExpiresAt         int64  `json:"exp"`
+}
+
+// PrintVerificationFunc shows the user where to confirm the login, and the code to confirm it with
+type PrintVerificationFunc func(verificationUri string, userCode string)
+
+// RunDeviceFlow logs in to the OpenID Connect provider with the OAuth 2.0 device authorization grant (RFC 8628), which
+// lets the user confirm the login in a browser that doesn't have to run on this machine. The client ID must be the
+// audience the engine and the enclave manager expect, as the ID token is what is sent to them.
+func RunDeviceFlow(ctx context.Context, issuerUrl string, clientId string, printVerification PrintVerificationFunc) (*EngineLogin, error) {
+	// nolint:exhaustruct
+	httpClient := &http.Client{Timeout: httpRequestTimeout}
+
+	discoveryDocument := &oidcDiscoveryDocument{
+		DeviceAuthorizationEndpoint: "",
+		TokenEndpoint:               "",
+	}
+	discoveryUrl := strings.TrimSuffix(issuerUrl, "/") + oidcDiscoveryPath
+	if err := getJson(ctx, httpClient, discoveryUrl, discoveryDocument); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the OIDC discovery document of '%v'", issuerUrl)
+	}
+	if discoveryDocument.DeviceAuthorizationEndpoint == "" || discoveryDocument.TokenEndpoint == "" {
+		return nil, stacktrace.NewError("OIDC provider '%v' doesn't support the device authorization grant", issuerUrl)
+	}
+
+	deviceAuthorization := &deviceAuthorizationResponse{
+		DeviceCode:              "",
+		UserCode:                "",
+		VerificationUri:         "",
+		VerificationUriComplete: "",
+		ExpiresIn:               0,
+		Interval:                0,
+	}
+	statusCode, err := postForm(ctx, httpClient, discoveryDocument.DeviceAuthorizationEndpoint, url.Values{
+		"client_id": {clientId},
+		"scope":     {deviceFlowScopes},
+	}, deviceAuthorization)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred requesting a device code from '%v'", discoveryDocument.DeviceAuthorizationEndpoint)
+	}
+	if statusCode != http.StatusOK || deviceAuthorization.DeviceCode == "" {
+		return nil, stacktrace.NewError("OIDC provider '%v' didn't give a device code for client '%v' (status %v)", issuerUrl, clientId, statusCode)
+	}
+	verificationUri := deviceAuthorization.VerificationUriComplete
+	if verificationUri == "" {
+		verificationUri = deviceAuthorization.VerificationUri
+	}
+	printVerification(verificationUri, deviceAuthorization.UserCode)
+
+	pollInterval := defaultPollInterval
+	if deviceAuthorization.Interval > 0 {
+		pollInterval = time.Duration(deviceAuthorization.Interval) * time.Second
+	}
+	pollCtx := ctx
+	if deviceAuthorization.ExpiresIn > 0 {
+		var cancelFunc context.CancelFunc
+		pollCtx, cancelFunc = context.WithTimeout(ctx, time.Duration(deviceAuthorization.ExpiresIn)*time.Second)
+		defer cancelFunc()
+	}
+	for {
+		select {
+		case <-pollCtx.Done():
+			return nil, stacktrace.NewError("The login wasn't confirmed before the device code expired")
+		case <-time.After(pollInterval):
+		}
+
+		tokens, err := requestTokens(pollCtx, httpClient, discoveryDocument.TokenEndpoint, url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {deviceAuthorization.DeviceCode},
+			"client_id":   {clientId},
+		})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred polling '%v' for the tokens", discoveryDocument.TokenEndpoint)
+		}
+		switch tokens.Error {
+		case "":
+			return newEngineLogin(issuerUrl, clientId, discoveryDocument.TokenEndpoint, tokens)
+		case authorizationPendingError:
+			continue
+		case slowDownError:
+			pollInterval += slowDownPollInterval
+			continue
+		default:
+			return nil, stacktrace.NewError("OIDC provider '%v' refused the login: %v %v", issuerUrl, tokens.Error, tokens.Description)
+		}
+	}
+}
+
+// Refresh gets a new ID token with the refresh token of the login
+func Refresh(ctx context.Context, login *EngineLogin) (*EngineLogin, error) {
+	if login.RefreshToken == "" {
+		return nil, stacktrace.NewError("The login to '%v' has no refresh token; 'kurtosis engine login' needs to run again", login.IssuerUrl)
+	}
+	// nolint:exhaustruct
+	httpClient := &http.Client{Timeout: httpRequestTimeout}
+	tokens, err := requestTokens(ctx, httpClient, login.TokenEndpoint, url.Values{
+		"grant_type":    {refreshTokenGrantType},
+		"refresh_token": {login.RefreshToken},
+		"client_id":     {login.ClientId},
+		"scope":         {refreshFlowScopes},
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred refreshing the ID token with '%v'", login.TokenEndpoint)
+	}
+	if tokens.Error != "" {
+		return nil, stacktrace.NewError("OIDC provider '%v' refused to refresh the ID token: %v %v", login.IssuerUrl, tokens.Error, tokens.Description)
+	}
+	if tokens.RefreshToken == "" {
+		// Providers that don't rotate the refresh tokens leave it out
+		tokens.RefreshToken = login.RefreshToken
+	}
+	return newEngineLogin(login.IssuerUrl, login.ClientId, login.TokenEndpoint, tokens)
+}
+
+func newEngineLogin(issuerUrl string, clientId string, tokenEndpoint string, tokens *tokenResponse) (*EngineLogin, error) {
+	if tokens.IdToken == "" {
+		return nil, stacktrace.NewError("OIDC provider '%v' didn't return an ID token; the 'openid' scope may not be allowed for client '%v'", issuerUrl, clientId)
+	}
+	// The engine checks the signature; the claims are only read here to tell who logged in and until when
+	claims, err := getUnverifiedIdTokenClaims(tokens.IdToken)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the claims of the ID token")
+	}
+	username := claims.PreferredUsername
+	if username == "" {
+		username = claims.Email
+	}
+	if username == "" {
+		username = claims.Subject
+	}
+	return &EngineLogin{
+		IssuerUrl:     issuerUrl,
+		ClientId:      clientId,
+		TokenEndpoint: tokenEndpoint,
+		Username:      username,
+		IdToken:       tokens.IdToken,
+		RefreshToken:  tokens.RefreshToken,
+		ExpiresAt:     time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
+
+func getUnverifiedIdTokenClaims(idToken string) (*idTokenClaims, error) {
+	tokenParts := strings.Split(idToken, ".")
+	if len(tokenParts) != jwtNumParts {
+		return nil, stacktrace.NewError("The ID token isn't a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(tokenParts[jwtPayloadIndex])
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the payload of the ID token")
+	}
+	claims := &idTokenClaims{
+		Subject:           "",
+		Email:             "",
+		PreferredUsername: "",
+		ExpiresAt:         0,
+	}
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the payload of the ID token")
+	}
+	if claims.ExpiresAt == 0 {
+		return nil, stacktrace.NewError("The ID token has no expiration time")
+	}
+	return claims, nil
+}
+
+// requestTokens returns the error of the token endpoint in the response, as the device flow expects some of them
+func requestTokens(ctx context.Context, httpClient *http.Client, tokenEndpoint string, form url.Values) (*tokenResponse, error) {
+	tokens := &tokenResponse{
+		IdToken:      "",
+		RefreshToken: "",
+		Error:        "",
+		Description:  "",
+	}
+	statusCode, err := postForm(ctx, httpClient, tokenEndpoint, form, tokens)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling the token endpoint")
+	}
+	if statusCode != http.StatusOK && tokens.Error == "" {
+		return nil, stacktrace.NewError("The token endpoint answered with status %v", statusCode)
+	}
+	return tokens, nil
+}
+
+func postForm(ctx context.Context, httpClient *http.Client, endpoint string, form url.Values, result interface{}) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred creating the request to '%v'", endpoint)
+	}
+	request.Header.Set(contentTypeHeader, formUrlEncodedContent)
+	request.Header.Set(acceptHeader, jsonContent)
+	return doJsonRequest(httpClient, request, result)
+}
+
+func getJson(ctx context.Context, httpClient *http.Client, endpoint string, result interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the request to '%v'", endpoint)
+	}
+	request.Header.Set(acceptHeader, jsonContent)
+	statusCode, err := doJsonRequest(httpClient, request, result)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting '%v'", endpoint)
+	}
+	if statusCode != http.StatusOK {
+		return stacktrace.NewError("'%v' answered with status %v", endpoint, statusCode)
+	}
+	return nil
+}
+
+// doJsonRequest decodes the body whatever the status code, as the OAuth endpoints explain their errors in it
+func doJsonRequest(httpClient *http.Client, request *http.Request, result interface{}) (int, error) {
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred calling '%v'", request.URL)
+	}
+	defer response.Body.Close()
+	decoder := json.NewDecoder(io.LimitReader(response.Body, maxResponseBytes))
+	if err = decoder.Decode(result); err != nil {
+		if response.StatusCode != http.StatusOK {
+			return response.StatusCode, nil
+		}
+		return 0, stacktrace.Propagate(err, "An error occurred decoding the response of '%v'", request.URL)
+	}
+	return response.StatusCode, nil
+}
@@ -0,0 +1,151 @@
+package engine_login
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// The file holds tokens, so only the user can read it
+	engineLoginsFilePerms = 0600
+
+	// The ID token is refreshed a bit before it expires, so that it doesn't expire on the way to the engine
+	idTokenExpirationMargin = time.Minute
+)
+
+// EngineLogin holds the tokens 'kurtosis engine login' got from the OpenID Connect provider of a cluster
+type EngineLogin struct {
+	IssuerUrl     string    `json:"issuerUrl"`
+	ClientId      string    `json:"clientId"`
+	TokenEndpoint string    `json:"tokenEndpoint"`
+	Username      string    `json:"username"`
+	IdToken       string    `json:"idToken"`
+	RefreshToken  string    `json:"refreshToken"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
+
+// GetEngineLogin returns the login of the cluster, or nil if nobody logged in to it
+func GetEngineLogin(clusterName string) (*EngineLogin, error) {
+	filePath, err := host_machine_directories.GetEngineLoginsFilePath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine logins file path")
+	}
+	loginsByCluster, err := readEngineLogins(filePath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the engine logins")
+	}
+	return loginsByCluster[clusterName], nil
+}
+
+// SaveEngineLogin replaces the login of the cluster
+func SaveEngineLogin(clusterName string, login *EngineLogin) error {
+	filePath, err := host_machine_directories.GetEngineLoginsFilePath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the engine logins file path")
+	}
+	return saveEngineLogin(filePath, clusterName, login)
+}
+
+// RemoveEngineLogin forgets the login of the cluster, and returns false if nobody was logged in to it
+func RemoveEngineLogin(clusterName string) (bool, error) {
+	filePath, err := host_machine_directories.GetEngineLoginsFilePath()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the engine logins file path")
+	}
+	return removeEngineLogin(filePath, clusterName)
+}
+
+// GetFreshIdToken returns the ID token of the login of the cluster, refreshing it first if it expired. Returns an empty
+// string if nobody logged in to the cluster.
+func GetFreshIdToken(ctx context.Context, clusterName string) (string, error) {
+	filePath, err := host_machine_directories.GetEngineLoginsFilePath()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine logins file path")
+	}
+	return getFreshIdToken(ctx, filePath, clusterName)
+}
+
+func getFreshIdToken(ctx context.Context, filePath string, clusterName string) (string, error) {
+	loginsByCluster, err := readEngineLogins(filePath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading the engine logins")
+	}
+	login, found := loginsByCluster[clusterName]
+	if !found {
+		return "", nil
+	}
+	if time.Now().Add(idTokenExpirationMargin).Before(login.ExpiresAt) {
+		return login.IdToken, nil
+	}
+	refreshedLogin, err := Refresh(ctx, login)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "The ID token of '%v' for cluster '%v' expired and couldn't be refreshed", login.Username, clusterName)
+	}
+	if err = saveEngineLogin(filePath, clusterName, refreshedLogin); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred saving the refreshed login for cluster '%v'", clusterName)
+	}
+	return refreshedLogin.IdToken, nil
+}
+
+func saveEngineLogin(filePath string, clusterName string, login *EngineLogin) error {
+	loginsByCluster, err := readEngineLogins(filePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the engine logins")
+	}
+	loginsByCluster[clusterName] = login
+	if err = writeEngineLogins(filePath, loginsByCluster); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the engine logins")
+	}
+	return nil
+}
+
+func removeEngineLogin(filePath string, clusterName string) (bool, error) {
+	loginsByCluster, err := readEngineLogins(filePath)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred reading the engine logins")
+	}
+	if _, found := loginsByCluster[clusterName]; !found {
+		return false, nil
+	}
+	delete(loginsByCluster, clusterName)
+	if err = writeEngineLogins(filePath, loginsByCluster); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred writing the engine logins")
+	}
+	return true, nil
+}
+
+func readEngineLogins(filePath string) (map[string]*EngineLogin, error) {
+	loginsByCluster := map[string]*EngineLogin{}
+	fileContent, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return loginsByCluster, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading engine logins file '%v'", filePath)
+	}
+	if err = json.Unmarshal(fileContent, &loginsByCluster); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing engine logins file '%v'", filePath)
+	}
+	return loginsByCluster, nil
+}
+
+func writeEngineLogins(filePath string, loginsByCluster map[string]*EngineLogin) error {
+	fileContent, err := json.Marshal(loginsByCluster)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the engine logins")
+	}
+	if err = os.WriteFile(filePath, fileContent, engineLoginsFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing engine logins file '%v'", filePath)
+	}
+	// WriteFile leaves the permissions of an existing file alone
+	if err = os.Chmod(filePath, engineLoginsFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restricting the permissions of engine logins file '%v'", filePath)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package engine_login
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientId     = "kurtosis"
+	testDeviceCode   = "device-code"
+	testUserCode     = "ABCD-EFGH"
+	testRefreshToken = "refresh-token"
+	testClusterName  = "docker"
+)
+
+// startTestOidcProvider answers the device flow with 'authorization_pending' once, then with an ID token expiring at
+// the given time; refreshing gives an ID token valid for an hour
+func startTestOidcProvider(t *testing.T, firstIdTokenExpiresAt time.Time) (string, *int32) {
+	numRefreshes := new(int32)
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(oidcDiscoveryPath, func(writer http.ResponseWriter, _ *http.Request) {
+		writeTestJson(writer, http.StatusOK, map[string]string{
+			"device_authorization_endpoint": server.URL + "/device",
+			"token_endpoint":                server.URL + "/token",
+		})
+	})
+	mux.HandleFunc("/device", func(writer http.ResponseWriter, request *http.Request) {
+		require.NoError(t, request.ParseForm())
+		require.Equal(t, testClientId, request.Form.Get("client_id"))
+		writeTestJson(writer, http.StatusOK, map[string]interface{}{
+			"device_code":      testDeviceCode,
+			"user_code":        testUserCode,
+			"verification_uri": server.URL + "/verify",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	numPolls := 0
+	mux.HandleFunc("/token", func(writer http.ResponseWriter, request *http.Request) {
+		require.NoError(t, request.ParseForm())
+		switch request.Form.Get("grant_type") {
+		case deviceCodeGrantType:
+			require.Equal(t, testDeviceCode, request.Form.Get("device_code"))
+			numPolls++
+			if numPolls == 1 {
+				writeTestJson(writer, http.StatusBadRequest, map[string]string{"error": authorizationPendingError})
+				return
+			}
+			writeTestJson(writer, http.StatusOK, map[string]string{
+				"id_token":      newTestIdToken(t, firstIdTokenExpiresAt),
+				"refresh_token": testRefreshToken,
+			})
+		case refreshTokenGrantType:
+			require.Equal(t, testRefreshToken, request.Form.Get("refresh_token"))
+			atomic.AddInt32(numRefreshes, 1)
+			writeTestJson(writer, http.StatusOK, map[string]string{
+				"id_token": newTestIdToken(t, time.Now().Add(time.Hour)),
+			})
+		default:
+			writeTestJson(writer, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		}
+	})
+	return server.URL, numRefreshes
+}
+
+func TestRunDeviceFlow(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	issuerUrl, _ := startTestOidcProvider(t, expiresAt)
+
+	var printedUserCode string
+	login, err := RunDeviceFlow(context.Background(), issuerUrl, testClientId, func(_ string, userCode string) {
+		printedUserCode = userCode
+	})
+	require.NoError(t, err)
+	require.Equal(t, testUserCode, printedUserCode)
+	require.Equal(t, "jane@example.com", login.Username)
+	require.Equal(t, testRefreshToken, login.RefreshToken)
+	require.Equal(t, issuerUrl+"/token", login.TokenEndpoint)
+	require.True(t, expiresAt.Equal(login.ExpiresAt))
+}
+
+func TestGetFreshIdToken_RefreshesExpiredTokens(t *testing.T) {
+	issuerUrl, numRefreshes := startTestOidcProvider(t, time.Now().Add(-time.Minute))
+	filePath := filepath.Join(t.TempDir(), "engine-logins.json")
+	ctx := context.Background()
+
+	idToken, err := getFreshIdToken(ctx, filePath, testClusterName)
+	require.NoError(t, err)
+	require.Empty(t, idToken)
+
+	login, err := RunDeviceFlow(ctx, issuerUrl, testClientId, func(string, string) {})
+	require.NoError(t, err)
+	require.NoError(t, saveEngineLogin(filePath, testClusterName, login))
+	fileInfo, err := os.Stat(filePath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(engineLoginsFilePerms), fileInfo.Mode().Perm())
+
+	idToken, err = getFreshIdToken(ctx, filePath, testClusterName)
+	require.NoError(t, err)
+	require.NotEqual(t, login.IdToken, idToken)
+	require.Equal(t, int32(1), atomic.LoadInt32(numRefreshes))
+
+	// The refreshed token is saved, and the refresh token the provider didn't rotate is kept
+	idTokenAgain, err := getFreshIdToken(ctx, filePath, testClusterName)
+	require.NoError(t, err)
+	require.Equal(t, idToken, idTokenAgain)
+	require.Equal(t, int32(1), atomic.LoadInt32(numRefreshes))
+	loginsByCluster, err := readEngineLogins(filePath)
+	require.NoError(t, err)
+	require.Equal(t, testRefreshToken, loginsByCluster[testClusterName].RefreshToken)
+
+	wasLoggedIn, err := removeEngineLogin(filePath, testClusterName)
+	require.NoError(t, err)
+	require.True(t, wasLoggedIn)
+	wasLoggedIn, err = removeEngineLogin(filePath, testClusterName)
+	require.NoError(t, err)
+	require.False(t, wasLoggedIn)
+}
+
+// newTestIdToken returns an unsigned JWT, as the CLI doesn't check the signature
+func newTestIdToken(t *testing.T, expiresAt time.Time) string {
+	payload, err := json.Marshal(map[string]interface{}{
+		"sub":   "jane",
+		"email": "jane@example.com",
+		"exp":   expiresAt.Unix(),
+		"iat":   time.Now().UnixNano(),
+	})
+	require.NoError(t, err)
+	encoding := base64.RawURLEncoding
+	return fmt.Sprintf("%v.%v.%v", encoding.EncodeToString([]byte(`{"alg":"none"}`)), encoding.EncodeToString(payload), encoding.EncodeToString([]byte("signature")))
+}
+
+func writeTestJson(writer http.ResponseWriter, statusCode int, body interface{}) {
+	writer.Header().Set(contentTypeHeader, jsonContent)
+	writer.WriteHeader(statusCode)
+	_ = json.NewEncoder(writer).Encode(body)
+}
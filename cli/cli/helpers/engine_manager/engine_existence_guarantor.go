@@ -5,8 +5,15 @@ import (
 	"fmt"
 
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/github_auth_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
@@ -16,6 +23,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -102,9 +110,59 @@ type engineExistenceGuarantor struct {
 	// If set to true, engine will not store logs in a persistent volume
 	shouldEnablePersistentVolumeLogsCollection bool
 
+	// The key the logs aggregator encrypts the logs it stores with, if any
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig
+
 	logsCollectorFilters []logs_collector.Filter
 
-	logsCollectorParsers []logs_collector.Parser
+	logsCollectorParsers                  []logs_collector.Parser
+	logsCollectorSystemLogsConfig         logs_collector.SystemLogsConfig
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig
+	logsCollectorBufferConfig             logs_collector.BufferConfig
+
+	// Where the API containers of the enclaves store the content of files artifacts
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig
+
+	// How the API containers of the enclaves cache the images they build and pull
+	imageCacheConfig image_cache.ImageCacheConfig
+
+	enclaveQuota enclave_quota.EnclaveQuota
+
+	// Who can call the engine APIs
+	authConfig args.EngineAuthConfig
+
+	// Who can log in to the enclave manager UI
+	enclaveManagerAuthConfig args.EnclaveManagerAuthConfig
+
+	// TTL of the enclaves created without one; enclaves don't expire if empty
+	defaultEnclaveTtl string
+
+	// Where the product metrics are sent to, and which of them
+	metricsSinkConfig metrics_client.SinkConfig
+
+	// Where the engine keeps the state that must survive its restarts
+	stateStoreConfig args.StateStoreConfig
+
+	// How many log lines the engine buffers for each logs stream, and what it does when its client falls behind
+	logStreamingConfig args.LogStreamingConfig
+
+	// Whether the API containers only accept the calls authenticated with a certificate the engine issued
+	shouldRequireApiContainerMtls bool
+
+	// Where the API containers read the secrets that Starlark references from
+	secretsProviderConfig secrets_provider.SecretsProviderConfig
+
+	// Who the images of the plans must be signed by to be started
+	imageVerificationConfig image_verification.ImageVerificationConfig
+
+	// The internal mirrors the images and the packages are resolved from in air-gapped mode
+	airGapConfig air_gap.AirGapConfig
+
+	// The hook the uploaded files artifacts are scanned with before being stored
+	contentScanningConfig content_scanning.ContentScanningConfig
+
+	// Whether the TLS connections are restricted to the FIPS-approved parameters
+	isFipsModeEnabled bool
 }
 
 func newEngineExistenceGuarantorWithDefaultVersion(
@@ -127,8 +185,27 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	logRetentionPeriod string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	authConfig args.EngineAuthConfig,
+	enclaveManagerAuthConfig args.EnclaveManagerAuthConfig,
+	defaultEnclaveTtl string,
+	metricsSinkConfig metrics_client.SinkConfig,
+	stateStoreConfig args.StateStoreConfig,
+	logStreamingConfig args.LogStreamingConfig,
+	shouldRequireApiContainerMtls bool,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
+	isFipsModeEnabled bool,
 ) *engineExistenceGuarantor {
 	return newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
@@ -151,8 +228,27 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		logRetentionPeriod,
 		sinks,
 		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
+		artifactsStoreConfig,
+		imageCacheConfig,
+		enclaveQuota,
+		authConfig,
+		enclaveManagerAuthConfig,
+		defaultEnclaveTtl,
+		metricsSinkConfig,
+		stateStoreConfig,
+		logStreamingConfig,
+		shouldRequireApiContainerMtls,
+		secretsProviderConfig,
+		imageVerificationConfig,
+		airGapConfig,
+		contentScanningConfig,
+		isFipsModeEnabled,
 	)
 }
 
@@ -177,8 +273,27 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	logRetentionPeriod string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	authConfig args.EngineAuthConfig,
+	enclaveManagerAuthConfig args.EnclaveManagerAuthConfig,
+	defaultEnclaveTtl string,
+	metricsSinkConfig metrics_client.SinkConfig,
+	stateStoreConfig args.StateStoreConfig,
+	logStreamingConfig args.LogStreamingConfig,
+	shouldRequireApiContainerMtls bool,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
+	isFipsModeEnabled bool,
 ) *engineExistenceGuarantor {
 	return &engineExistenceGuarantor{
 		ctx:                                  ctx,
@@ -203,8 +318,27 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		logRetentionPeriod:                        logRetentionPeriod,
 		sinks:                                     sinks,
 		shouldEnablePersistentVolumeLogsCollection: shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig:                       logsEncryptionConfig,
 		logsCollectorFilters:                       logsCollectorFilters,
 		logsCollectorParsers:                       logsCollectorParsers,
+		logsCollectorSystemLogsConfig:              logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig:      logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig:                  logsCollectorBufferConfig,
+		artifactsStoreConfig:                       artifactsStoreConfig,
+		imageCacheConfig:                           imageCacheConfig,
+		enclaveQuota:                               enclaveQuota,
+		authConfig:                                 authConfig,
+		enclaveManagerAuthConfig:                   enclaveManagerAuthConfig,
+		defaultEnclaveTtl:                          defaultEnclaveTtl,
+		metricsSinkConfig:                          metricsSinkConfig,
+		stateStoreConfig:                           stateStoreConfig,
+		logStreamingConfig:                         logStreamingConfig,
+		shouldRequireApiContainerMtls:              shouldRequireApiContainerMtls,
+		secretsProviderConfig:                      secretsProviderConfig,
+		imageVerificationConfig:                    imageVerificationConfig,
+		airGapConfig:                               airGapConfig,
+		contentScanningConfig:                      contentScanningConfig,
+		isFipsModeEnabled:                          isFipsModeEnabled,
 	}
 }
 
@@ -268,8 +402,27 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.logRetentionPeriod,
 			guarantor.sinks,
 			guarantor.shouldEnablePersistentVolumeLogsCollection,
+			guarantor.logsEncryptionConfig,
 			guarantor.logsCollectorFilters,
 			guarantor.logsCollectorParsers,
+			guarantor.logsCollectorSystemLogsConfig,
+			guarantor.logsCollectorKubernetesMetadataConfig,
+			guarantor.logsCollectorBufferConfig,
+			guarantor.artifactsStoreConfig,
+			guarantor.imageCacheConfig,
+			guarantor.enclaveQuota,
+			guarantor.authConfig,
+			guarantor.enclaveManagerAuthConfig,
+			guarantor.defaultEnclaveTtl,
+			guarantor.metricsSinkConfig,
+			guarantor.stateStoreConfig,
+			guarantor.logStreamingConfig,
+			guarantor.shouldRequireApiContainerMtls,
+			guarantor.secretsProviderConfig,
+			guarantor.imageVerificationConfig,
+			guarantor.airGapConfig,
+			guarantor.contentScanningConfig,
+			guarantor.isFipsModeEnabled,
 		)
 	} else {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithCustomVersion(
@@ -294,8 +447,27 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.logRetentionPeriod,
 			guarantor.sinks,
 			guarantor.shouldEnablePersistentVolumeLogsCollection,
+			guarantor.logsEncryptionConfig,
 			guarantor.logsCollectorFilters,
 			guarantor.logsCollectorParsers,
+			guarantor.logsCollectorSystemLogsConfig,
+			guarantor.logsCollectorKubernetesMetadataConfig,
+			guarantor.logsCollectorBufferConfig,
+			guarantor.artifactsStoreConfig,
+			guarantor.imageCacheConfig,
+			guarantor.enclaveQuota,
+			guarantor.authConfig,
+			guarantor.enclaveManagerAuthConfig,
+			guarantor.defaultEnclaveTtl,
+			guarantor.metricsSinkConfig,
+			guarantor.stateStoreConfig,
+			guarantor.logStreamingConfig,
+			guarantor.shouldRequireApiContainerMtls,
+			guarantor.secretsProviderConfig,
+			guarantor.imageVerificationConfig,
+			guarantor.airGapConfig,
+			guarantor.contentScanningConfig,
+			guarantor.isFipsModeEnabled,
 		)
 	}
 	if engineLaunchErr != nil {
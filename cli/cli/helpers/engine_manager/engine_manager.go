@@ -2,6 +2,7 @@ package engine_manager
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -17,11 +18,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_cluster_setting"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/planning"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -43,6 +47,7 @@ const (
 type EngineManager struct {
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	shouldSendMetrics                         bool
+	metricsSinkConfig                         metrics_client.SinkConfig
 	engineServerKurtosisBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier
 	clusterConfig                             *resolved_config.KurtosisClusterConfig
 	onBastionHost                             bool
@@ -103,6 +108,7 @@ func NewEngineManager(ctx context.Context) (*EngineManager, error) {
 	return &EngineManager{
 		kurtosisBackend:   kurtosisBackend,
 		shouldSendMetrics: kurtosisConfig.GetShouldSendMetrics(),
+		metricsSinkConfig: kurtosisConfig.GetMetricsSinkConfig(),
 		engineServerKurtosisBackendConfigSupplier: engineBackendConfigSupplier,
 		clusterConfig:      clusterConfig,
 		onBastionHost:      onBastionHost,
@@ -178,6 +184,42 @@ func (manager *EngineManager) GetEngineStatus(
 	return EngineStatus_Running, runningEngineIpAndPort, engineInfo.GetEngineVersion(), nil
 }
 
+// GetEngineInfo returns the version of the running engine, and whether it runs in FIPS mode and with validated crypto
+func (manager *EngineManager) GetEngineInfo(ctx context.Context) (*kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse, error) {
+	engineClient, engineClientCloseFunc, err := getEngineClientFromHostMachineIpAndPort(getDefaultKurtosisEngineLocalhostMachineIpAndPort())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a client to the engine")
+	}
+	defer func() {
+		if err = engineClientCloseFunc(); err != nil {
+			logrus.Warnf("Error closing the engine client:\n'%v'", err)
+		}
+	}()
+	engineInfo, err := engineClient.GetEngineInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the info of the engine")
+	}
+	return engineInfo, nil
+}
+
+// GetEngineHealth returns the health of the subsystems the running engine relies on, e.g. its logs aggregator
+func (manager *EngineManager) GetEngineHealth(ctx context.Context) (*kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse, error) {
+	engineClient, engineClientCloseFunc, err := getEngineClientFromHostMachineIpAndPort(getDefaultKurtosisEngineLocalhostMachineIpAndPort())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a client to the engine")
+	}
+	defer func() {
+		if err = engineClientCloseFunc(); err != nil {
+			logrus.Warnf("Error closing the engine client:\n'%v'", err)
+		}
+	}()
+	engineHealth, err := engineClient.GetEngineHealth(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the health of the engine")
+	}
+	return engineHealth, nil
+}
+
 // StartEngineIdempotentlyWithDefaultVersion Starts an engine if one doesn't exist already, and returns a client to it
 func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 	ctx context.Context,
@@ -192,6 +234,10 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 	kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	func() error, error,
 ) {
+	poolSize = manager.getEnclavePoolSize(poolSize)
+	if err := manager.validatePoolSizeForEngineReplicas(poolSize); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred validating the enclave pool size")
+	}
 	status, maybeHostMachinePortBinding, engineVersion, err := manager.GetEngineStatus(ctx)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred retrieving the Kurtosis engine status, which is necessary for creating a connection to the engine")
@@ -212,6 +258,11 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 	}
 	additionalSinks = combineSinks(additionalSinks, lokiSink)
 
+	secretsProviderConfig, err := manager.clusterConfig.GetSecretsProviderConfig()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the secrets provider config of the cluster")
+	}
+
 	engineGuarantor := newEngineExistenceGuarantorWithDefaultVersion(
 		ctx,
 		maybeHostMachinePortBinding,
@@ -232,8 +283,27 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 		logRetentionPeriodStr,
 		combineSinks(additionalSinks, manager.clusterConfig.GetLogsAggregatorConfig().Sinks),
 		manager.clusterConfig.ShouldEnableDefaultLogsSink(),
+		manager.clusterConfig.GetLogsEncryptionConfig(),
 		manager.clusterConfig.GetLogsCollectorConfig().Filters,
 		manager.clusterConfig.GetLogsCollectorConfig().Parsers,
+		manager.clusterConfig.GetLogsCollectorConfig().SystemLogs,
+		manager.clusterConfig.GetLogsCollectorConfig().KubernetesMetadata,
+		manager.clusterConfig.GetLogsCollectorConfig().Buffer,
+		manager.clusterConfig.GetArtifactsStoreConfig(),
+		manager.clusterConfig.GetImageCacheConfig(),
+		manager.clusterConfig.GetEnclaveQuota(),
+		manager.clusterConfig.GetEngineAuthConfig(),
+		manager.clusterConfig.GetEnclaveManagerAuthConfig(),
+		manager.clusterConfig.GetDefaultEnclaveTtl(),
+		manager.metricsSinkConfig,
+		manager.clusterConfig.GetStateStoreConfig(),
+		manager.clusterConfig.GetLogStreamingConfig(),
+		manager.clusterConfig.ShouldRequireApiContainerMtls(),
+		secretsProviderConfig,
+		manager.clusterConfig.GetImageVerificationConfig(),
+		manager.clusterConfig.GetAirGapConfig(),
+		manager.clusterConfig.GetContentScanningConfig(),
+		manager.clusterConfig.IsFipsModeEnabled(),
 	)
 	// TODO Need to handle the Kubernetes case, where a gateway needs to be started after the engine is started but
 	//  before we can return an EngineClient
@@ -259,6 +329,10 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 	kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	func() error, error,
 ) {
+	poolSize = manager.getEnclavePoolSize(poolSize)
+	if err := manager.validatePoolSizeForEngineReplicas(poolSize); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred validating the enclave pool size")
+	}
 	status, maybeHostMachinePortBinding, engineVersion, err := manager.GetEngineStatus(ctx)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred retrieving the Kurtosis engine status, which is necessary for creating a connection to the engine")
@@ -279,6 +353,11 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 	}
 	additionalSinks = combineSinks(additionalSinks, lokiSink)
 
+	secretsProviderConfig, err := manager.clusterConfig.GetSecretsProviderConfig()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the secrets provider config of the cluster")
+	}
+
 	engineGuarantor := newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
 		maybeHostMachinePortBinding,
@@ -300,8 +379,27 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 		logRetentionPeriodStr,
 		combineSinks(manager.clusterConfig.GetLogsAggregatorConfig().Sinks, additionalSinks),
 		manager.clusterConfig.ShouldEnableDefaultLogsSink(),
+		manager.clusterConfig.GetLogsEncryptionConfig(),
 		manager.clusterConfig.GetLogsCollectorConfig().Filters,
 		manager.clusterConfig.GetLogsCollectorConfig().Parsers,
+		manager.clusterConfig.GetLogsCollectorConfig().SystemLogs,
+		manager.clusterConfig.GetLogsCollectorConfig().KubernetesMetadata,
+		manager.clusterConfig.GetLogsCollectorConfig().Buffer,
+		manager.clusterConfig.GetArtifactsStoreConfig(),
+		manager.clusterConfig.GetImageCacheConfig(),
+		manager.clusterConfig.GetEnclaveQuota(),
+		manager.clusterConfig.GetEngineAuthConfig(),
+		manager.clusterConfig.GetEnclaveManagerAuthConfig(),
+		manager.clusterConfig.GetDefaultEnclaveTtl(),
+		manager.metricsSinkConfig,
+		manager.clusterConfig.GetStateStoreConfig(),
+		manager.clusterConfig.GetLogStreamingConfig(),
+		manager.clusterConfig.ShouldRequireApiContainerMtls(),
+		secretsProviderConfig,
+		manager.clusterConfig.GetImageVerificationConfig(),
+		manager.clusterConfig.GetAirGapConfig(),
+		manager.clusterConfig.GetContentScanningConfig(),
+		manager.clusterConfig.IsFipsModeEnabled(),
 	)
 	engineClient, engineClientCloseFunc, err := manager.startEngineWithGuarantor(ctx, status, engineGuarantor)
 	if err != nil {
@@ -310,6 +408,86 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 	return engineClient, engineClientCloseFunc, nil
 }
 
+// PlanEngineStart returns the operations that starting the engine would perform on the cluster, without performing them,
+// so that what Kurtosis will touch can be reviewed beforehand. The plan is empty if an engine is already running. An
+// empty image version tag plans the start of the default version
+// NOTE: Grafana and Loki aren't started through the Kurtosis backend, so they're never part of the plan
+func (manager *EngineManager) PlanEngineStart(
+	ctx context.Context,
+	engineImageVersionTag string,
+	logLevel logrus.Level,
+	poolSize uint8,
+	shouldStartInDebugMode bool,
+	githubAuthTokenOverride string,
+	restartAPIContainers bool,
+	domain string,
+	logRetentionPeriodStr string,
+	additionalSinks logs_aggregator.Sinks,
+) (*planning.Plan, error) {
+	poolSize = manager.getEnclavePoolSize(poolSize)
+	if err := manager.validatePoolSizeForEngineReplicas(poolSize); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the enclave pool size")
+	}
+	status, maybeHostMachinePortBinding, engineVersion, err := manager.GetEngineStatus(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving the Kurtosis engine status, which is necessary for planning the start of the engine")
+	}
+
+	secretsProviderConfig, err := manager.clusterConfig.GetSecretsProviderConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the secrets provider config of the cluster")
+	}
+
+	planningBackend := planning.NewPlanningKurtosisBackend(manager.kurtosisBackend)
+	engineGuarantor := newEngineExistenceGuarantorWithCustomVersion(
+		ctx,
+		maybeHostMachinePortBinding,
+		planningBackend,
+		manager.shouldSendMetrics,
+		manager.engineServerKurtosisBackendConfigSupplier,
+		engineImageVersionTag,
+		logLevel,
+		engineVersion,
+		manager.clusterConfig.GetClusterType(),
+		manager.onBastionHost,
+		poolSize,
+		manager.enclaveEnvVars,
+		manager.allowedCORSOrigins,
+		shouldStartInDebugMode,
+		githubAuthTokenOverride,
+		restartAPIContainers,
+		domain,
+		logRetentionPeriodStr,
+		combineSinks(manager.clusterConfig.GetLogsAggregatorConfig().Sinks, additionalSinks),
+		manager.clusterConfig.ShouldEnableDefaultLogsSink(),
+		manager.clusterConfig.GetLogsEncryptionConfig(),
+		manager.clusterConfig.GetLogsCollectorConfig().Filters,
+		manager.clusterConfig.GetLogsCollectorConfig().Parsers,
+		manager.clusterConfig.GetLogsCollectorConfig().SystemLogs,
+		manager.clusterConfig.GetLogsCollectorConfig().KubernetesMetadata,
+		manager.clusterConfig.GetLogsCollectorConfig().Buffer,
+		manager.clusterConfig.GetArtifactsStoreConfig(),
+		manager.clusterConfig.GetImageCacheConfig(),
+		manager.clusterConfig.GetEnclaveQuota(),
+		manager.clusterConfig.GetEngineAuthConfig(),
+		manager.clusterConfig.GetEnclaveManagerAuthConfig(),
+		manager.clusterConfig.GetDefaultEnclaveTtl(),
+		manager.metricsSinkConfig,
+		manager.clusterConfig.GetStateStoreConfig(),
+		manager.clusterConfig.GetLogStreamingConfig(),
+		manager.clusterConfig.ShouldRequireApiContainerMtls(),
+		secretsProviderConfig,
+		manager.clusterConfig.GetImageVerificationConfig(),
+		manager.clusterConfig.GetAirGapConfig(),
+		manager.clusterConfig.GetContentScanningConfig(),
+		manager.clusterConfig.IsFipsModeEnabled(),
+	)
+	if err := status.Accept(engineGuarantor); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred planning the start of the Kurtosis engine")
+	}
+	return planningBackend.GetPlan(), nil
+}
+
 // StopEngineIdempotently Stops the engine if it's running, doing nothing if not
 func (manager *EngineManager) StopEngineIdempotently(ctx context.Context) error {
 
@@ -442,6 +620,59 @@ func (manager *EngineManager) RestartEngineIdempotently(ctx context.Context,
 	return engineClient, engineClientCloseFunc, nil
 }
 
+// ReloadEngineConfig applies the sinks, engine auth, enclave quota and default enclave TTL of the Kurtosis config, and
+// the given log retention period, to the running engine without restarting it, so the active log streams and port
+// forwards survive
+func (manager *EngineManager) ReloadEngineConfig(ctx context.Context, logRetentionPeriodStr string) error {
+	status, maybeHostMachinePortBinding, _, err := manager.GetEngineStatus(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving the Kurtosis engine status")
+	}
+	if status != EngineStatus_Running {
+		return stacktrace.NewError("Couldn't reload the engine config because the engine isn't running (status '%v'); start it with '%v %v %v' instead", status, command_str_consts.KurtosisCmdStr, command_str_consts.EngineCmdStr, command_str_consts.EngineStartCmdStr)
+	}
+
+	engineConfig := args.NewReloadableEngineConfig(
+		logRetentionPeriodStr,
+		manager.clusterConfig.GetEnclaveQuota(),
+		manager.clusterConfig.GetEngineAuthConfig(),
+		manager.clusterConfig.GetDefaultEnclaveTtl(),
+	)
+	if err := engineConfig.Validate(); err != nil {
+		return stacktrace.Propagate(err, "The engine config is invalid")
+	}
+	engineConfigJson, err := json.Marshal(engineConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the engine config")
+	}
+	engineClient, engineClientCloseFunc, err := getEngineClientFromHostMachineIpAndPort(maybeHostMachinePortBinding)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the engine")
+	}
+	defer func() {
+		if err = engineClientCloseFunc(); err != nil {
+			logrus.Warnf("Error closing the engine client:\n'%v'", err)
+		}
+	}()
+	updateEngineConfigArgs := &kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs{ConfigJson: string(engineConfigJson)}
+	if _, err := engineClient.UpdateEngineConfig(ctx, updateEngineConfigArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the engine config")
+	}
+
+	var lokiSink logs_aggregator.Sinks
+	if manager.clusterConfig.GetGraflokiConfig().ShouldStartBeforeEngine {
+		lokiSink, _, err = grafloki.StartGrafloki(ctx, manager.clusterConfig.GetClusterType(), manager.clusterConfig.GetGraflokiConfig())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting Grafana and Loki")
+		}
+	}
+	sinks := combineSinks(manager.clusterConfig.GetLogsAggregatorConfig().Sinks, lokiSink)
+	if err := manager.kurtosisBackend.UpdateLogsAggregatorSinks(ctx, sinks, manager.clusterConfig.ShouldEnableDefaultLogsSink(), manager.clusterConfig.GetLogsEncryptionConfig()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the sinks of the logs aggregator")
+	}
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -494,7 +725,10 @@ func (manager *EngineManager) startEngineWithGuarantor(ctx context.Context, curr
 
 func getEngineClientFromHostMachineIpAndPort(hostMachineIpAndPort *hostMachineIpAndPort) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	url := hostMachineIpAndPort.GetURL()
-	conn, err := grpc.Dial(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, kurtosis_context.GetEngineTokenDialOptions()...)
+	dialOptions = append(dialOptions, kurtosis_context.GetTracingDialOptions()...)
+	dialOptions = append(dialOptions, kurtosis_context.GetCompressionDialOptions()...)
+	conn, err := grpc.Dial(url, dialOptions...)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred dialling Kurtosis engine at URL '%v'", url)
 	}
@@ -575,3 +809,25 @@ func combineSinks(sinks logs_aggregator.Sinks, sinksToAdd logs_aggregator.Sinks)
 	}
 	return combinedSinks
 }
+
+// getEnclavePoolSize falls back to the pool size of the cluster config if none was requested
+func (manager *EngineManager) getEnclavePoolSize(requestedPoolSize uint8) uint8 {
+	if requestedPoolSize > 0 {
+		return requestedPoolSize
+	}
+	return manager.clusterConfig.GetEnclavePoolSize()
+}
+
+// The enclave pool destroys the idle enclaves it finds when it starts, so the replicas of an engine would destroy
+// the ones of each other
+func (manager *EngineManager) validatePoolSizeForEngineReplicas(poolSize uint8) error {
+	engineReplicas := manager.clusterConfig.GetEngineReplicas()
+	if poolSize > 0 && engineReplicas > 1 {
+		return stacktrace.NewError(
+			"An enclave pool of size '%v' can't be used with '%v' engine replicas; set the pool size to 0 or run a single engine replica",
+			poolSize,
+			engineReplicas,
+		)
+	}
+	return nil
+}
@@ -16,30 +16,21 @@ const (
 
 	defaultTmpDir = ""
 	tmpDirPattern = "tmp-dir-for-download-*"
+
+	partialDownloadFilePatternSuffix = ".partial-*"
 )
 
 func DownloadFilesArtifactToLocation(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, artifactIdentifier string, absoluteDestinationPath string) error {
-
-	artifactBytes, err := enclaveCtx.DownloadFilesArtifact(ctx, artifactIdentifier)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred downloading files with identifier '%v' from enclave '%v'", artifactIdentifier, enclaveCtx.GetEnclaveName())
-	}
-
 	fileNameToWriteTo := fmt.Sprintf("%v%v", artifactIdentifier, filesArtifactExtension)
 	destinationPathToDownloadFileTo := path.Join(absoluteDestinationPath, fileNameToWriteTo)
 
-	err = os.WriteFile(destinationPathToDownloadFileTo, artifactBytes, filesArtifactPermission)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while writing bytes to file '%v' with permission '%v'", destinationPathToDownloadFileTo, filesArtifactPermission)
+	if err := downloadFilesArtifactToFile(ctx, enclaveCtx, artifactIdentifier, destinationPathToDownloadFileTo); err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files with identifier '%v' from enclave '%v' to '%v'", artifactIdentifier, enclaveCtx.GetEnclaveName(), destinationPathToDownloadFileTo)
 	}
 	return nil
 }
 
 func DownloadAndExtractFilesArtifact(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, artifactIdentifier string, absoluteDestinationPath string) error {
-	artifactBytes, err := enclaveCtx.DownloadFilesArtifact(ctx, artifactIdentifier)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred downloading files with identifier '%v' from enclave '%v'", artifactIdentifier, enclaveCtx.GetEnclaveName())
-	}
 	fileNameToWriteTo := fmt.Sprintf("%v%v", artifactIdentifier, filesArtifactExtension)
 
 	tmpDirPath, err := os.MkdirTemp(defaultTmpDir, tmpDirPattern)
@@ -53,9 +44,8 @@ func DownloadAndExtractFilesArtifact(ctx context.Context, enclaveCtx *enclaves.E
 		}
 	}()
 	tmpFileToWriteTo := path.Join(tmpDirPath, fileNameToWriteTo)
-	err = os.WriteFile(tmpFileToWriteTo, artifactBytes, filesArtifactPermission)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while writing bytes to file '%v' with permission '%v'", tmpDirPath, filesArtifactPermission)
+	if err = downloadFilesArtifactToFile(ctx, enclaveCtx, artifactIdentifier, tmpFileToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files with identifier '%v' from enclave '%v' to '%v'", artifactIdentifier, enclaveCtx.GetEnclaveName(), tmpFileToWriteTo)
 	}
 	err = archiver.Unarchive(tmpFileToWriteTo, absoluteDestinationPath)
 	if err != nil {
@@ -65,3 +55,42 @@ func DownloadAndExtractFilesArtifact(ctx context.Context, enclaveCtx *enclaves.E
 	shouldCleanupTmpDir = true
 	return nil
 }
+
+// downloadFilesArtifactToFile streams the artifact straight to disk so that large artifacts are never fully held in
+// memory. The artifact is streamed to a temporary file next to the destination, which is only renamed to the
+// destination once the download succeeded, so that a failed download never leaves a truncated artifact behind.
+func downloadFilesArtifactToFile(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, artifactIdentifier string, destinationFilepath string) error {
+	partialFile, err := os.CreateTemp(path.Dir(destinationFilepath), path.Base(destinationFilepath)+partialDownloadFilePatternSuffix)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary file next to '%v' to download files artifact '%v' to", destinationFilepath, artifactIdentifier)
+	}
+	partialFilepath := partialFile.Name()
+	shouldRemovePartialFile := true
+	defer func() {
+		partialFile.Close()
+		if shouldRemovePartialFile {
+			os.Remove(partialFilepath)
+		}
+	}()
+
+	progressReporter, finishProgressReporting := NewTransferProgressReporter(fmt.Sprintf("Downloading files artifact '%v'", artifactIdentifier))
+	err = enclaveCtx.DownloadFilesArtifactToWriter(ctx, artifactIdentifier, partialFile, progressReporter)
+	finishProgressReporting()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", artifactIdentifier)
+	}
+	if err = partialFile.Sync(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred flushing files artifact '%v' to '%v'", artifactIdentifier, partialFilepath)
+	}
+	if err = partialFile.Chmod(filesArtifactPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting permission '%v' on '%v'", filesArtifactPermission, partialFilepath)
+	}
+	if err = partialFile.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing '%v'", partialFilepath)
+	}
+	if err = os.Rename(partialFilepath, destinationFilepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving the downloaded files artifact '%v' from '%v' to '%v'", artifactIdentifier, partialFilepath, destinationFilepath)
+	}
+	shouldRemovePartialFile = false
+	return nil
+}
@@ -0,0 +1,67 @@
+package files
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/interactive_terminal_decider"
+	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Progress is printed at most once per interval so that multi-GB transfers don't flood the output
+	progressReportingInterval = 1 * time.Second
+
+	bytesInMegabyte   = 1024 * 1024
+	percentMultiplier = 100
+)
+
+// NewTransferProgressReporter returns a progress reporter printing how much of the files artifact has been transferred
+// so far. On interactive terminals the progress is printed on a single line that gets overwritten; elsewhere it's
+// logged at most once per progressReportingInterval.
+// The returned function must be called once the transfer is over to terminate the progress line.
+func NewTransferProgressReporter(transferDescription string) (grpc_file_streaming.ProgressReporter, func()) {
+	isInteractive := interactive_terminal_decider.IsInteractiveTerminal()
+	var mutex sync.Mutex
+	var lastReportTime time.Time
+	hasReported := false
+
+	reporter := func(transferredBytes uint64, totalBytes uint64) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		isComplete := totalBytes != grpc_file_streaming.UnknownContentSize && transferredBytes >= totalBytes
+		if !isComplete && time.Since(lastReportTime) < progressReportingInterval {
+			return
+		}
+		lastReportTime = time.Now()
+		hasReported = true
+
+		progressStr := formatTransferProgress(transferredBytes, totalBytes)
+		if isInteractive {
+			fmt.Fprintf(os.Stderr, "\r%s: %s", transferDescription, progressStr)
+			return
+		}
+		logrus.Infof("%s: %s", transferDescription, progressStr)
+	}
+	finish := func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if isInteractive && hasReported {
+			fmt.Fprintln(os.Stderr)
+		}
+	}
+	return reporter, finish
+}
+
+func formatTransferProgress(transferredBytes uint64, totalBytes uint64) string {
+	transferredMegabytes := float64(transferredBytes) / bytesInMegabyte
+	if totalBytes == grpc_file_streaming.UnknownContentSize {
+		return fmt.Sprintf("%.1f MB", transferredMegabytes)
+	}
+	totalMegabytes := float64(totalBytes) / bytesInMegabyte
+	percentage := float64(transferredBytes) * percentMultiplier / float64(totalBytes)
+	return fmt.Sprintf("%.1f/%.1f MB (%.0f%%)", transferredMegabytes, totalMegabytes, percentage)
+}
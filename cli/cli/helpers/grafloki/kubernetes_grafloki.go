@@ -30,11 +30,14 @@ const (
 	lokiProbeTimeoutSeconds              = 10
 
 	// takes around 30 seconds for loki pod to become ready
-	lokiDeploymentMaxRetries    = 60
-	lokiDeploymentRetryInterval = 1 * time.Second
-	defaultStorageClass         = ""
+	lokiDeploymentTimeout     = 60 * time.Second
+	defaultStorageClass       = ""
+	graflokiNumReplicas       = int32(1)
+	defaultServiceAccountName = ""
 )
 
+var noNodeSelectors map[string]string = nil
+
 var lokiLabels = map[string]string{
 	kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): lokiDeploymentName,
 }
@@ -168,7 +171,10 @@ func createGrafanaAndLokiDeployments(ctx context.Context, k8sManager *kubernetes
 			NodeAffinity:    nil,
 			PodAffinity:     nil,
 			PodAntiAffinity: nil,
-		})
+		},
+		graflokiNumReplicas,
+		defaultServiceAccountName,
+		noNodeSelectors)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "An error occurred creating Loki deployment.")
 	}
@@ -185,7 +191,7 @@ func createGrafanaAndLokiDeployments(ctx context.Context, k8sManager *kubernetes
 		}
 	}()
 	logrus.Infof("Waiting for Loki deployment to come online (can take around 30s)... ")
-	if err := k8sManager.WaitForPodManagedByDeployment(ctx, lokiDeployment, lokiDeploymentMaxRetries, lokiDeploymentRetryInterval); err != nil {
+	if err := k8sManager.WaitForPodManagedByDeployment(ctx, lokiDeployment, lokiDeploymentTimeout); err != nil {
 		return "", nil, stacktrace.Propagate(err, "An error occurred while waiting for pod managed by Loki deployment '%v' to come online.", lokiDeploymentName)
 	}
 
@@ -383,7 +389,10 @@ func createGrafanaAndLokiDeployments(ctx context.Context, k8sManager *kubernetes
 			NodeAffinity:    nil,
 			PodAffinity:     nil,
 			PodAntiAffinity: nil,
-		})
+		},
+		graflokiNumReplicas,
+		defaultServiceAccountName,
+		noNodeSelectors)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "An error occurred creating Grafana deployment.")
 	}
@@ -506,7 +515,7 @@ func getKubernetesManager() (*kubernetes_manager.KubernetesManager, error) {
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create Kubernetes client set using Kubernetes config '%+v', instead a non nil error was returned", kubernetesConfig)
 	}
-	k8sManager := kubernetes_manager.NewKubernetesManager(clientSet, kubernetesConfig, defaultStorageClass)
+	k8sManager := kubernetes_manager.NewKubernetesManager(clientSet, kubernetesConfig, defaultStorageClass, kubernetes_manager.PodSecurityStandard_Privileged)
 	return k8sManager, nil
 }
 
@@ -3,7 +3,9 @@ package host_machine_directories
 import (
 	"github.com/adrg/xdg"
 	"github.com/kurtosis-tech/stacktrace"
+	"os"
 	"path"
+	"strconv"
 )
 
 const (
@@ -20,6 +22,8 @@ const (
 	githubUsernameFilename  = "github-username"
 	githubAuthTokenFilename = "github-auth-token"
 
+	engineLoginsFilename = "engine-logins.json"
+
 	userSendMetricsElection = "user-send-metrics-election"
 
 	LastPesteredUserAboutOldVersionFilename = "last-pestered-user-about-old-version"
@@ -29,9 +33,17 @@ const (
 	portalVersionFilename = "kurtosis-portal.version"
 	portalPidFilename     = "kurtosis-portal.pid"
 
+	sshTunnelPidFilename = "ssh-tunnel.pid"
+	sshTunnelLogFilename = "ssh-tunnel.log"
+
+	portForwardSessionFileExtension = ".json"
+	portForwardLogFileExtension     = ".log"
+
 	// ------------ Names of dirs inside Kurtosis directory --------------
 	engineDataDirname      = "engine-data"
 	portalSubDirname       = "portal"
+	portForwardSubDirname  = "port-forward"
+	sshTunnelSubDirname    = "ssh-tunnel"
 	kurtosisCliLogsDirname = "cli"
 )
 
@@ -168,6 +180,63 @@ func GetGitHubAuthTokenFilePath() (string, error) {
 	return githubAuthTokenFilePath, nil
 }
 
+// GetEngineLoginsFilePath returns the file holding the tokens 'kurtosis engine login' got for each cluster
+func GetEngineLoginsFilePath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(engineLoginsFilename)
+	engineLoginsFilePath, err := xdg.StateFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine logins file path using '%s'", xdgRelFilepath)
+	}
+	return engineLoginsFilePath, nil
+}
+
+// GetPortForwardSessionsDirPath returns the directory containing the files of the running port forwards, named after
+// the PID of the process forwarding the ports
+func GetPortForwardSessionsDirPath() (string, error) {
+	// xdg only hands out file paths, so the directory is derived from the path of a session file
+	portForwardSessionFilePath, err := GetPortForwardSessionFilePath(os.Getpid())
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the directory of the port forward session files")
+	}
+	return path.Dir(portForwardSessionFilePath), nil
+}
+
+func GetPortForwardSessionFilePath(pid int) (string, error) {
+	xdgRelFilepath := getRelativeFilepathForPortForwardForXDG(strconv.Itoa(pid) + portForwardSessionFileExtension)
+	portForwardSessionFilePath, err := xdg.StateFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the port forward session file path using '%s'", xdgRelFilepath)
+	}
+	return portForwardSessionFilePath, nil
+}
+
+func GetPortForwardLogFilePath(pid int) (string, error) {
+	xdgRelFilepath := getRelativeFilepathForPortForwardForXDG(strconv.Itoa(pid) + portForwardLogFileExtension)
+	portForwardLogFilePath, err := xdg.StateFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the port forward log file path using '%s'", xdgRelFilepath)
+	}
+	return portForwardLogFilePath, nil
+}
+
+func GetSshTunnelPidFilePath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForSshTunnelForXDG(sshTunnelPidFilename)
+	sshTunnelPidFilePath, err := xdg.StateFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the SSH tunnel PID file path using '%s'", xdgRelFilepath)
+	}
+	return sshTunnelPidFilePath, nil
+}
+
+func GetSshTunnelLogFilePath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForSshTunnelForXDG(sshTunnelLogFilename)
+	sshTunnelLogFilePath, err := xdg.StateFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the SSH tunnel log file path using '%s'", xdgRelFilepath)
+	}
+	return sshTunnelLogFilePath, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -182,6 +251,14 @@ func getRelativeFilepathForPortalForXDG(filepathRelativeToKurtosisPortalDir stri
 	return path.Join(applicationDirname, portalSubDirname, filepathRelativeToKurtosisPortalDir)
 }
 
+func getRelativeFilepathForPortForwardForXDG(filepathRelativeToPortForwardDir string) string {
+	return path.Join(applicationDirname, portForwardSubDirname, filepathRelativeToPortForwardDir)
+}
+
+func getRelativeFilepathForSshTunnelForXDG(filepathRelativeToSshTunnelDir string) string {
+	return path.Join(applicationDirname, sshTunnelSubDirname, filepathRelativeToSshTunnelDir)
+}
+
 func getRelativeFilePathForKurtosisCliLogs() string {
 	return path.Join(applicationDirname, kurtosisCliLogsDirname)
 }
@@ -11,7 +11,8 @@ const (
 	defaultClusterName = resolved_config.DefaultDockerClusterName
 )
 
-func getKurtosisClusterName() (string, error) {
+// GetKurtosisClusterName returns the name of the cluster the CLI is set to; it doesn't create the Kurtosis config
+func GetKurtosisClusterName() (string, error) {
 	clusterSettingStore := kurtosis_cluster_setting.GetKurtosisClusterSettingStore()
 
 	isClusterSet, err := clusterSettingStore.HasClusterSetting()
@@ -42,7 +43,7 @@ func getKurtosisConfig() (*resolved_config.KurtosisConfig, error) {
 }
 
 func GetKurtosisClusterConfig() (*resolved_config.KurtosisClusterConfig, error) {
-	clusterName, err := getKurtosisClusterName()
+	clusterName, err := GetKurtosisClusterName()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get Kurtosis Cluster Name from Kurtosis settings, instead a non-nil error was returned")
 	}
@@ -25,23 +25,18 @@ func GetMetricsClient() (metrics_client.MetricsClient, func() error, error) {
 		return nil, nil, stacktrace.Propagate(err, "an error occurred while getting metrics user id and cluster type")
 	}
 
-	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
-	hasConfig, err := kurtosisConfigStore.HasConfig()
+	maybeKurtosisConfig, err := getMaybeKurtosisConfig()
 	if err != nil {
-		return nil, nil, stacktrace.NewError("An error occurred while determining whether configuration already exists")
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the Kurtosis config")
 	}
 
 	maybeCloudUserId, maybeCloudInstanceId := metrics_cloud_user_instance_id_helper.GetMaybeCloudUserAndInstanceID()
 
-	var sendUserMetrics bool
-	if hasConfig {
-		kurtosisConfig, err := kurtosisConfigStore.GetConfig()
-		if err != nil {
-			return nil, nil, stacktrace.NewError("An error occurred while fetching stored configuration")
-		}
-		sendUserMetrics = kurtosisConfig.GetShouldSendMetrics()
-	} else {
-		sendUserMetrics = defaults.SendMetricsByDefault
+	sendUserMetrics := defaults.SendMetricsByDefault
+	metricsSinkConfig := metrics_client.NewDefaultSinkConfig()
+	if maybeKurtosisConfig != nil {
+		sendUserMetrics = maybeKurtosisConfig.GetShouldSendMetrics()
+		metricsSinkConfig = maybeKurtosisConfig.GetMetricsSinkConfig()
 	}
 
 	logger := logrus.StandardLogger()
@@ -55,7 +50,7 @@ func GetMetricsClient() (metrics_client.MetricsClient, func() error, error) {
 			shouldFlushMetricsClientQueueOnEachEvent,
 			metrics_client.DoNothingMetricsClientCallback{},
 			analytics_logger.ConvertLogrusLoggerToAnalyticsLogger(logger),
-			metrics_client.IsCI(), maybeCloudUserId, maybeCloudInstanceId),
+			metrics_client.IsCI(), maybeCloudUserId, maybeCloudInstanceId, metricsSinkConfig),
 	)
 
 	if err != nil {
@@ -74,6 +69,15 @@ func GetSegmentClient() (metrics_client.MetricsClient, func() error, error) {
 	// this is force set to true in order to get the segment client
 	sendUserMetrics := true
 
+	maybeKurtosisConfig, err := getMaybeKurtosisConfig()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the Kurtosis config")
+	}
+	metricsSinkConfig := metrics_client.NewDefaultSinkConfig()
+	if maybeKurtosisConfig != nil {
+		metricsSinkConfig = maybeKurtosisConfig.GetMetricsSinkConfig()
+	}
+
 	maybeCloudUserId, maybeCloudInstanceId := metrics_cloud_user_instance_id_helper.GetMaybeCloudUserAndInstanceID()
 
 	logger := logrus.StandardLogger()
@@ -86,7 +90,7 @@ func GetSegmentClient() (metrics_client.MetricsClient, func() error, error) {
 			shouldFlushMetricsClientQueueOnEachEvent,
 			metrics_client.DoNothingMetricsClientCallback{},
 			analytics_logger.ConvertLogrusLoggerToAnalyticsLogger(logger),
-			metrics_client.IsCI(), maybeCloudUserId, maybeCloudInstanceId),
+			metrics_client.IsCI(), maybeCloudUserId, maybeCloudInstanceId, metricsSinkConfig),
 	)
 
 	if err != nil {
@@ -96,6 +100,23 @@ func GetSegmentClient() (metrics_client.MetricsClient, func() error, error) {
 	return metricsClient, metricsClientCloseFunc, nil
 }
 
+// getMaybeKurtosisConfig returns the Kurtosis config, or nil if the user hasn't created it yet
+func getMaybeKurtosisConfig() (*resolved_config.KurtosisConfig, error) {
+	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
+	hasConfig, err := kurtosisConfigStore.HasConfig()
+	if err != nil {
+		return nil, stacktrace.NewError("An error occurred while determining whether configuration already exists")
+	}
+	if !hasConfig {
+		return nil, nil
+	}
+	kurtosisConfig, err := kurtosisConfigStore.GetConfig()
+	if err != nil {
+		return nil, stacktrace.NewError("An error occurred while fetching stored configuration")
+	}
+	return kurtosisConfig, nil
+}
+
 func getMetricsUserIdAndClusterType() (string, string, error) {
 	clusterSettingStore := kurtosis_cluster_setting.GetKurtosisClusterSettingStore()
 	isClusterSet, err := clusterSettingStore.HasClusterSetting()
@@ -0,0 +1,185 @@
+package observability
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// The names are prefixed so they don't collide with the Prometheus or Grafana services of the packages themselves
+	prometheusServiceName = "kurtosis-prometheus"
+	grafanaServiceName    = "kurtosis-grafana"
+
+	prometheusImage = "prom/prometheus:v2.53.0"
+	prometheusPort  = 9090
+
+	grafanaImage = "grafana/grafana:11.6.0"
+	grafanaPort  = 3000
+
+	httpPortId = "http"
+
+	grafanaDatasourcesDirpath = "/etc/grafana/provisioning/datasources"
+	grafanaDashboardsDirpath  = "/etc/grafana/provisioning/dashboards"
+
+	// The configs are passed to the script as args and rendered as is, as they can contain Go template delimiters,
+	// e.g. in the legends of the dashboards
+	observabilityScript = `
+PROMETHEUS_CONFIG_DIRPATH = "/etc/prometheus"
+
+def run(plan, args):
+    prometheus_config = plan.render_templates(
+        name = "kurtosis-prometheus-config",
+        config = {"prometheus.yml": as_is(args["prometheus_config"])},
+    )
+    plan.add_service(
+        name = args["prometheus_service_name"],
+        config = ServiceConfig(
+            image = args["prometheus_image"],
+            ports = {args["http_port_id"]: PortSpec(number = args["prometheus_port"], application_protocol = "http")},
+            files = {PROMETHEUS_CONFIG_DIRPATH: prometheus_config},
+            cmd = [
+                "--config.file=" + PROMETHEUS_CONFIG_DIRPATH + "/prometheus.yml",
+                "--storage.tsdb.path=/prometheus",
+            ],
+        ),
+    )
+
+    grafana_datasources = plan.render_templates(
+        name = "kurtosis-grafana-datasources",
+        config = {"prometheus.yml": as_is(args["grafana_datasources"])},
+    )
+    grafana_dashboards = plan.render_templates(
+        name = "kurtosis-grafana-dashboards",
+        config = {
+            "dashboards.yml": as_is(args["grafana_dashboard_providers"]),
+            "enclave.json": as_is(args["grafana_dashboard"]),
+        },
+    )
+    plan.add_service(
+        name = args["grafana_service_name"],
+        config = ServiceConfig(
+            image = args["grafana_image"],
+            ports = {args["http_port_id"]: PortSpec(number = args["grafana_port"], application_protocol = "http")},
+            files = {
+                args["grafana_datasources_dirpath"]: grafana_datasources,
+                args["grafana_dashboards_dirpath"]: grafana_dashboards,
+            },
+            env_vars = {
+                "GF_AUTH_ANONYMOUS_ENABLED": "true",
+                "GF_AUTH_ANONYMOUS_ORG_ROLE": "Admin",
+                "GF_DASHBOARDS_DEFAULT_HOME_DASHBOARD_PATH": args["grafana_dashboards_dirpath"] + "/enclave.json",
+            },
+        ),
+    )
+
+def as_is(content):
+    return struct(template = "{{ .content }}", data = {"content": content})
+`
+)
+
+type observabilityScriptArgs struct {
+	HttpPortId                string `json:"http_port_id"`
+	PrometheusServiceName     string `json:"prometheus_service_name"`
+	PrometheusImage           string `json:"prometheus_image"`
+	PrometheusPort            int    `json:"prometheus_port"`
+	PrometheusConfig          string `json:"prometheus_config"`
+	GrafanaServiceName        string `json:"grafana_service_name"`
+	GrafanaImage              string `json:"grafana_image"`
+	GrafanaPort               int    `json:"grafana_port"`
+	GrafanaDatasourcesDirpath string `json:"grafana_datasources_dirpath"`
+	GrafanaDashboardsDirpath  string `json:"grafana_dashboards_dirpath"`
+	GrafanaDatasources        string `json:"grafana_datasources"`
+	GrafanaDashboardProviders string `json:"grafana_dashboard_providers"`
+	GrafanaDashboard          string `json:"grafana_dashboard"`
+}
+
+// DeployObservability adds a Prometheus scraping the metrics ports of the services of the enclave, and a Grafana with
+// a dashboard of them, to the enclave. It can be called again after services were added, to scrape them too. It
+// returns the URL of Grafana on the host machine, or an empty string if Grafana isn't reachable from it
+func DeployObservability(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveCtx *enclaves.EnclaveContext) (string, error) {
+	enclaveName := enclaveCtx.GetEnclaveName()
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the enclave info of enclave '%v'", enclaveName)
+	}
+	allServices := map[string]bool{}
+	serviceInfos, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, kurtosisCtx, enclaveInfo, allServices)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveName)
+	}
+
+	scrapeTargets := getScrapeTargets(serviceInfos)
+	if len(scrapeTargets) == 0 {
+		logrus.Warnf("None of the services of enclave '%v' has a port with an ID ending with '%v', so Prometheus won't scrape any", enclaveName, metricsPortId)
+	}
+
+	serializedScriptArgs, err := getSerializedObservabilityScriptArgs(enclaveName, scrapeTargets)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the configs of Prometheus and Grafana for enclave '%v'", enclaveName)
+	}
+	logrus.Infof("Deploying Prometheus and Grafana in enclave '%v', scraping %d metrics port(s)...", enclaveName, len(scrapeTargets))
+	if _, err = enclaveCtx.RunStarlarkScriptBlocking(ctx, observabilityScript, starlark_run_config.NewRunStarlarkConfig(starlark_run_config.WithSerializedParams(serializedScriptArgs))); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred deploying Prometheus and Grafana in enclave '%v'", enclaveName)
+	}
+
+	grafanaServiceCtx, err := enclaveCtx.GetServiceContext(grafanaServiceName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the '%v' service that was just added to enclave '%v'", grafanaServiceName, enclaveName)
+	}
+	grafanaPublicPort, found := grafanaServiceCtx.GetPublicPorts()[httpPortId]
+	if !found {
+		return "", nil
+	}
+	return fmt.Sprintf("http://%v:%v", grafanaServiceCtx.GetMaybePublicIPAddress(), grafanaPublicPort.GetNumber()), nil
+}
+
+func getSerializedObservabilityScriptArgs(enclaveName string, scrapeTargets []*scrapeTarget) (string, error) {
+	prometheusConfig, err := getPrometheusConfig(scrapeTargets)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the Prometheus config")
+	}
+	grafanaDatasources, err := getGrafanaDatasources()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the Grafana datasources")
+	}
+	grafanaDashboardProviders, err := getGrafanaDashboardProviders()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the Grafana dashboard providers")
+	}
+	grafanaDashboard, err := getGrafanaDashboard(enclaveName, scrapeTargets)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the Grafana dashboard")
+	}
+	scriptArgs := observabilityScriptArgs{
+		HttpPortId:                httpPortId,
+		PrometheusServiceName:     prometheusServiceName,
+		PrometheusImage:           prometheusImage,
+		PrometheusPort:            prometheusPort,
+		PrometheusConfig:          prometheusConfig,
+		GrafanaServiceName:        grafanaServiceName,
+		GrafanaImage:              grafanaImage,
+		GrafanaPort:               grafanaPort,
+		GrafanaDatasourcesDirpath: grafanaDatasourcesDirpath,
+		GrafanaDashboardsDirpath:  grafanaDashboardsDirpath,
+		GrafanaDatasources:        grafanaDatasources,
+		GrafanaDashboardProviders: grafanaDashboardProviders,
+		GrafanaDashboard:          grafanaDashboard,
+	}
+	serializedScriptArgs, err := json.Marshal(scriptArgs)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the args of the observability script")
+	}
+	return string(serializedScriptArgs), nil
+}
+
+func isObservabilityService(serviceName string) bool {
+	return serviceName == prometheusServiceName || serviceName == grafanaServiceName
+}
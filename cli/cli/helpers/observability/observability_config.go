@@ -0,0 +1,358 @@
+package observability
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// A port is scraped if its ID is 'metrics' or ends with it, e.g. 'metrics', 'prometheus-metrics' or 'node_metrics'
+	metricsPortId = "metrics"
+	metricsPath   = "/metrics"
+
+	scrapeInterval = "15s"
+
+	// Prometheus labels every target with the name of the service exposing it, which the dashboards filter on
+	serviceNameLabel = "kurtosis_service"
+
+	prometheusDatasourceName = "Prometheus"
+	prometheusDatasourceUid  = "kurtosis-prometheus"
+
+	dashboardsProviderName = "Kurtosis"
+	dashboardUid           = "kurtosis-enclave"
+	dashboardRefresh       = "15s"
+	dashboardTimeRange     = "now-15m"
+	dashboardTimeRangeEnd  = "now"
+	dashboardSchemaVersion = 39
+
+	allValuesRegex = ".*"
+
+	panelWidth  = 12
+	panelHeight = 8
+	// Grafana lays the dashboards out on a grid that is 24 columns wide
+	panelsPerRow = 2
+)
+
+var invalidPrometheusLabelNameCharsRegex = regexp.MustCompile("[^a-zA-Z0-9_]")
+
+type scrapeTarget struct {
+	serviceName string
+	portNumber  uint32
+	// The labels of the service, as valid Prometheus label names
+	labels map[string]string
+}
+
+// getScrapeTargets returns the metrics ports of the services, sorted by service name and port number so the configs
+// are stable from one run to the next
+func getScrapeTargets(serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo) []*scrapeTarget {
+	scrapeTargets := []*scrapeTarget{}
+	for _, serviceInfo := range serviceInfos {
+		if isObservabilityService(serviceInfo.GetName()) {
+			continue
+		}
+		for portId, port := range serviceInfo.GetPrivatePorts() {
+			if !strings.HasSuffix(portId, metricsPortId) || port.GetTransportProtocol() != kurtosis_core_rpc_api_bindings.Port_TCP {
+				continue
+			}
+			labels := map[string]string{}
+			for labelKey, labelValue := range serviceInfo.GetLabels() {
+				labels[toPrometheusLabelName(labelKey)] = labelValue
+			}
+			scrapeTargets = append(scrapeTargets, &scrapeTarget{
+				serviceName: serviceInfo.GetName(),
+				portNumber:  port.GetNumber(),
+				labels:      labels,
+			})
+		}
+	}
+	sort.Slice(scrapeTargets, func(i, j int) bool {
+		if scrapeTargets[i].serviceName != scrapeTargets[j].serviceName {
+			return scrapeTargets[i].serviceName < scrapeTargets[j].serviceName
+		}
+		return scrapeTargets[i].portNumber < scrapeTargets[j].portNumber
+	})
+	return scrapeTargets
+}
+
+// toPrometheusLabelName turns a service label key like 'app.kubernetes.io/component' into a valid Prometheus label
+// name like 'app_kubernetes_io_component'
+func toPrometheusLabelName(labelKey string) string {
+	labelName := invalidPrometheusLabelNameCharsRegex.ReplaceAllString(labelKey, "_")
+	if labelName == "" || (labelName[0] >= '0' && labelName[0] <= '9') {
+		labelName = "_" + labelName
+	}
+	return labelName
+}
+
+type prometheusConfig struct {
+	Global        prometheusGlobalConfig   `yaml:"global"`
+	ScrapeConfigs []prometheusScrapeConfig `yaml:"scrape_configs"`
+}
+
+type prometheusGlobalConfig struct {
+	ScrapeInterval string `yaml:"scrape_interval"`
+}
+
+type prometheusScrapeConfig struct {
+	JobName       string                   `yaml:"job_name"`
+	MetricsPath   string                   `yaml:"metrics_path"`
+	StaticConfigs []prometheusStaticConfig `yaml:"static_configs"`
+}
+
+type prometheusStaticConfig struct {
+	Targets []string          `yaml:"targets"`
+	Labels  map[string]string `yaml:"labels"`
+}
+
+func getPrometheusConfig(scrapeTargets []*scrapeTarget) (string, error) {
+	scrapeConfigs := []prometheusScrapeConfig{}
+	for _, target := range scrapeTargets {
+		labels := map[string]string{}
+		for labelName, labelValue := range target.labels {
+			labels[labelName] = labelValue
+		}
+		labels[serviceNameLabel] = target.serviceName
+		scrapeConfigs = append(scrapeConfigs, prometheusScrapeConfig{
+			JobName:     fmt.Sprintf("%v-%v", target.serviceName, target.portNumber),
+			MetricsPath: metricsPath,
+			StaticConfigs: []prometheusStaticConfig{
+				{
+					// services reach each other by name inside the enclave
+					Targets: []string{fmt.Sprintf("%v:%v", target.serviceName, target.portNumber)},
+					Labels:  labels,
+				},
+			},
+		})
+	}
+	config := prometheusConfig{
+		Global:        prometheusGlobalConfig{ScrapeInterval: scrapeInterval},
+		ScrapeConfigs: scrapeConfigs,
+	}
+	configYaml, err := yaml.Marshal(config)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the Prometheus config to YAML")
+	}
+	return string(configYaml), nil
+}
+
+type grafanaDatasources struct {
+	ApiVersion  int64               `yaml:"apiVersion"`
+	Datasources []grafanaDatasource `yaml:"datasources"`
+}
+
+type grafanaDatasource struct {
+	Name      string `yaml:"name"`
+	Uid       string `yaml:"uid"`
+	Type_     string `yaml:"type"`
+	Access    string `yaml:"access"`
+	Url       string `yaml:"url"`
+	IsDefault bool   `yaml:"isDefault"`
+	Editable  bool   `yaml:"editable"`
+}
+
+func getGrafanaDatasources() (string, error) {
+	datasources := grafanaDatasources{
+		ApiVersion: 1,
+		Datasources: []grafanaDatasource{
+			{
+				Name:      prometheusDatasourceName,
+				Uid:       prometheusDatasourceUid,
+				Type_:     "prometheus",
+				Access:    "proxy",
+				Url:       fmt.Sprintf("http://%v:%v", prometheusServiceName, prometheusPort),
+				IsDefault: true,
+				Editable:  true,
+			},
+		},
+	}
+	datasourcesYaml, err := yaml.Marshal(datasources)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the Grafana datasources to YAML")
+	}
+	return string(datasourcesYaml), nil
+}
+
+type grafanaDashboardProviders struct {
+	ApiVersion int64                      `yaml:"apiVersion"`
+	Providers  []grafanaDashboardProvider `yaml:"providers"`
+}
+
+type grafanaDashboardProvider struct {
+	Name    string                          `yaml:"name"`
+	Type_   string                          `yaml:"type"`
+	Options grafanaDashboardProviderOptions `yaml:"options"`
+}
+
+type grafanaDashboardProviderOptions struct {
+	Path string `yaml:"path"`
+}
+
+func getGrafanaDashboardProviders() (string, error) {
+	providers := grafanaDashboardProviders{
+		ApiVersion: 1,
+		Providers: []grafanaDashboardProvider{
+			{
+				Name:    dashboardsProviderName,
+				Type_:   "file",
+				Options: grafanaDashboardProviderOptions{Path: grafanaDashboardsDirpath},
+			},
+		},
+	}
+	providersYaml, err := yaml.Marshal(providers)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the Grafana dashboard providers to YAML")
+	}
+	return string(providersYaml), nil
+}
+
+type grafanaDashboard struct {
+	Uid           string                  `json:"uid"`
+	Title         string                  `json:"title"`
+	Refresh       string                  `json:"refresh"`
+	SchemaVersion int                     `json:"schemaVersion"`
+	Time          grafanaTimeRange        `json:"time"`
+	Templating    grafanaTemplating       `json:"templating"`
+	Panels        []grafanaDashboardPanel `json:"panels"`
+}
+
+type grafanaTimeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type grafanaTemplating struct {
+	List []grafanaTemplateVariable `json:"list"`
+}
+
+type grafanaTemplateVariable struct {
+	Name       string               `json:"name"`
+	Label      string               `json:"label"`
+	Type_      string               `json:"type"`
+	Datasource grafanaDatasourceRef `json:"datasource"`
+	Query      string               `json:"query"`
+	Multi      bool                 `json:"multi"`
+	IncludeAll bool                 `json:"includeAll"`
+	AllValue   string               `json:"allValue"`
+	Refresh    int                  `json:"refresh"`
+}
+
+type grafanaDatasourceRef struct {
+	Type_ string `json:"type"`
+	Uid   string `json:"uid"`
+}
+
+type grafanaDashboardPanel struct {
+	Id         int                  `json:"id"`
+	Title      string               `json:"title"`
+	Type_      string               `json:"type"`
+	Datasource grafanaDatasourceRef `json:"datasource"`
+	GridPos    grafanaGridPos       `json:"gridPos"`
+	Targets    []grafanaPanelTarget `json:"targets"`
+}
+
+type grafanaGridPos struct {
+	H int `json:"h"`
+	W int `json:"w"`
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type grafanaPanelTarget struct {
+	RefId        string `json:"refId"`
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat"`
+}
+
+// getGrafanaDashboard returns a dashboard of the scraped services with a variable for the service name and for each
+// label of the services, so the panels can be narrowed down to the services of a given label, e.g. a role or a client
+func getGrafanaDashboard(enclaveName string, scrapeTargets []*scrapeTarget) (string, error) {
+	datasourceRef := grafanaDatasourceRef{Type_: "prometheus", Uid: prometheusDatasourceUid}
+
+	labelNames := []string{serviceNameLabel}
+	seenLabelNames := map[string]bool{serviceNameLabel: true}
+	for _, target := range scrapeTargets {
+		targetLabelNames := []string{}
+		for labelName := range target.labels {
+			targetLabelNames = append(targetLabelNames, labelName)
+		}
+		sort.Strings(targetLabelNames)
+		for _, labelName := range targetLabelNames {
+			if !seenLabelNames[labelName] {
+				seenLabelNames[labelName] = true
+				labelNames = append(labelNames, labelName)
+			}
+		}
+	}
+
+	variables := []grafanaTemplateVariable{}
+	selectorMatchers := []string{}
+	for _, labelName := range labelNames {
+		variables = append(variables, grafanaTemplateVariable{
+			Name:       labelName,
+			Label:      labelName,
+			Type_:      "query",
+			Datasource: datasourceRef,
+			Query:      fmt.Sprintf("label_values(up, %v)", labelName),
+			Multi:      true,
+			IncludeAll: true,
+			// matches the services that don't have the label too
+			AllValue: allValuesRegex,
+			// refreshes the values when the dashboard is loaded, as services come and go
+			Refresh: 1,
+		})
+		selectorMatchers = append(selectorMatchers, fmt.Sprintf("%v=~\"$%v\"", labelName, labelName))
+	}
+	selector := fmt.Sprintf("{%v}", strings.Join(selectorMatchers, ","))
+	legendFormat := fmt.Sprintf("{{%v}} {{instance}}", serviceNameLabel)
+
+	panelQueries := []struct {
+		title string
+		expr  string
+	}{
+		{title: "Services up", expr: "up" + selector},
+		{title: "Samples scraped", expr: "scrape_samples_scraped" + selector},
+		{title: "Scrape duration (seconds)", expr: "scrape_duration_seconds" + selector},
+		{title: "Process CPU (cores)", expr: fmt.Sprintf("rate(process_cpu_seconds_total%v[1m])", selector)},
+		{title: "Process resident memory (bytes)", expr: "process_resident_memory_bytes" + selector},
+	}
+	panels := []grafanaDashboardPanel{}
+	for idx, panelQuery := range panelQueries {
+		panels = append(panels, grafanaDashboardPanel{
+			Id:         idx + 1,
+			Title:      panelQuery.title,
+			Type_:      "timeseries",
+			Datasource: datasourceRef,
+			GridPos: grafanaGridPos{
+				H: panelHeight,
+				W: panelWidth,
+				X: (idx % panelsPerRow) * panelWidth,
+				Y: (idx / panelsPerRow) * panelHeight,
+			},
+			Targets: []grafanaPanelTarget{
+				{RefId: "A", Expr: panelQuery.expr, LegendFormat: legendFormat},
+			},
+		})
+	}
+
+	dashboard := grafanaDashboard{
+		Uid:           dashboardUid,
+		Title:         fmt.Sprintf("Enclave %v", enclaveName),
+		Refresh:       dashboardRefresh,
+		SchemaVersion: dashboardSchemaVersion,
+		Time:          grafanaTimeRange{From: dashboardTimeRange, To: dashboardTimeRangeEnd},
+		Templating:    grafanaTemplating{List: variables},
+		Panels:        panels,
+	}
+	dashboardJson, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the Grafana dashboard of enclave '%v' to JSON", enclaveName)
+	}
+	return string(dashboardJson), nil
+}
@@ -0,0 +1,98 @@
+package observability
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServiceInfo(name string, ports map[string]*kurtosis_core_rpc_api_bindings.Port, labels map[string]string) *kurtosis_core_rpc_api_bindings.ServiceInfo {
+	// nolint: exhaustruct
+	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		Name:         name,
+		PrivatePorts: ports,
+		Labels:       labels,
+	}
+}
+
+func newTestPort(number uint32, transportProtocol kurtosis_core_rpc_api_bindings.Port_TransportProtocol) *kurtosis_core_rpc_api_bindings.Port {
+	// nolint: exhaustruct
+	return &kurtosis_core_rpc_api_bindings.Port{
+		Number:            number,
+		TransportProtocol: transportProtocol,
+	}
+}
+
+func TestGetScrapeTargets(t *testing.T) {
+	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"el-1": newTestServiceInfo("el-1", map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"rpc":     newTestPort(8545, kurtosis_core_rpc_api_bindings.Port_TCP),
+			"metrics": newTestPort(9001, kurtosis_core_rpc_api_bindings.Port_TCP),
+		}, map[string]string{"app.kubernetes.io/component": "execution", "1st": "yes"}),
+		"cl-1": newTestServiceInfo("cl-1", map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"node-metrics":      newTestPort(5054, kurtosis_core_rpc_api_bindings.Port_TCP),
+			"validator-metrics": newTestPort(5064, kurtosis_core_rpc_api_bindings.Port_TCP),
+			"discovery-metrics": newTestPort(9000, kurtosis_core_rpc_api_bindings.Port_UDP),
+		}, nil),
+		"db": newTestServiceInfo("db", map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"postgres": newTestPort(5432, kurtosis_core_rpc_api_bindings.Port_TCP),
+		}, nil),
+		prometheusServiceName: newTestServiceInfo(prometheusServiceName, map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"metrics": newTestPort(prometheusPort, kurtosis_core_rpc_api_bindings.Port_TCP),
+		}, nil),
+	}
+
+	scrapeTargets := getScrapeTargets(serviceInfos)
+	require.Equal(t, []*scrapeTarget{
+		{serviceName: "cl-1", portNumber: 5054, labels: map[string]string{}},
+		{serviceName: "cl-1", portNumber: 5064, labels: map[string]string{}},
+		{serviceName: "el-1", portNumber: 9001, labels: map[string]string{"app_kubernetes_io_component": "execution", "_1st": "yes"}},
+	}, scrapeTargets)
+}
+
+func TestGetPrometheusConfig(t *testing.T) {
+	scrapeTargets := []*scrapeTarget{
+		{serviceName: "el-1", portNumber: 9001, labels: map[string]string{"role": "execution"}},
+	}
+
+	configYaml, err := getPrometheusConfig(scrapeTargets)
+	require.NoError(t, err)
+
+	var config prometheusConfig
+	require.NoError(t, yaml.Unmarshal([]byte(configYaml), &config))
+	require.Equal(t, []prometheusScrapeConfig{
+		{
+			JobName:     "el-1-9001",
+			MetricsPath: metricsPath,
+			StaticConfigs: []prometheusStaticConfig{
+				{
+					Targets: []string{"el-1:9001"},
+					Labels:  map[string]string{"role": "execution", serviceNameLabel: "el-1"},
+				},
+			},
+		},
+	}, config.ScrapeConfigs)
+}
+
+func TestGetGrafanaDashboard_HasAVariablePerLabel(t *testing.T) {
+	scrapeTargets := []*scrapeTarget{
+		{serviceName: "el-1", portNumber: 9001, labels: map[string]string{"role": "execution", "client": "geth"}},
+		{serviceName: "cl-1", portNumber: 5054, labels: map[string]string{"role": "consensus"}},
+	}
+
+	dashboardJson, err := getGrafanaDashboard("my-enclave", scrapeTargets)
+	require.NoError(t, err)
+
+	var dashboard grafanaDashboard
+	require.NoError(t, json.Unmarshal([]byte(dashboardJson), &dashboard))
+	require.Equal(t, "Enclave my-enclave", dashboard.Title)
+	variableNames := []string{}
+	for _, variable := range dashboard.Templating.List {
+		variableNames = append(variableNames, variable.Name)
+	}
+	require.Equal(t, []string{serviceNameLabel, "client", "role"}, variableNames)
+	require.Equal(t, `up{kurtosis_service=~"$kurtosis_service",client=~"$client",role=~"$role"}`, dashboard.Panels[0].Targets[0].Expr)
+}
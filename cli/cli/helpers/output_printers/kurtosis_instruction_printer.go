@@ -30,6 +30,8 @@ const (
 
 	codeCommentPrefix = "# "
 
+	remediationHintPrefix = "Hint: "
+
 	newlineChar = "\n"
 )
 
@@ -123,6 +125,7 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 		}
 	} else if responseLine.GetError() != nil {
 		var errorMsg string
+		var remediationHint string
 		if responseLine.GetError().GetInterpretationError() != nil {
 			errorMsg = fmt.Sprintf("There was an error interpreting Starlark code \n%v", responseLine.GetError().GetInterpretationError().GetErrorMessage())
 		} else if responseLine.GetError().GetValidationError() != nil {
@@ -131,11 +134,19 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 			errorMsgWithStackTrace := errors.New(responseLine.GetError().GetExecutionError().GetErrorMessage())
 			cleanedErrorFromStarlark := out.GetErrorMessageToBeDisplayedOnCli(errorMsgWithStackTrace)
 			errorMsg = fmt.Sprintf("There was an error executing Starlark code \n%v", cleanedErrorFromStarlark)
+			remediationHint = responseLine.GetError().GetExecutionError().GetRemediationHint()
 		}
 		formattedError := FormatError(errorMsg)
 		if err := printer.printPersistentLineToStdOut(formattedError); err != nil {
 			return stacktrace.Propagate(err, "An error happened executing Starlark code but the error couldn't be printed to the CLI output. Error message was: \n%v", errorMsg)
 		}
+		// Errors caused by a known problem of the container engine come with what the user can do about it
+		if remediationHint != "" {
+			formattedRemediationHint := formatInfo(fmt.Sprintf("%s%s", remediationHintPrefix, remediationHint))
+			if err := printer.printPersistentLineToStdOut(formattedRemediationHint); err != nil {
+				return stacktrace.Propagate(err, "Error printing the remediation hint of the error: %v", remediationHint)
+			}
+		}
 	} else if responseLine.GetProgressInfo() != nil {
 		if printer.isSpinnerBeingUsed {
 			progress := responseLine.GetProgressInfo()
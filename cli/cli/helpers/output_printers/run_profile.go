@@ -0,0 +1,112 @@
+package output_printers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+const (
+	runProfileHeader            = "Run profile (slowest first):"
+	runProfileTotalLabel        = "Total"
+	runProfileEmptyMsg          = "No timing information was received for this run"
+	runProfileDurationPrecision = time.Millisecond
+
+	containerImageStepFormat          = "image %s"
+	containerImageServicesStepFormat  = "image %s (services: %s)"
+	instructionStepFormat             = "%s (from %s[%d:%d])"
+	containerImageServiceNamesJoinStr = ", "
+)
+
+type runProfileStep struct {
+	description string
+	duration    time.Duration
+}
+
+// RunProfile collects the timings the enclave sends during a Starlark run, i.e. the wall time of each executed
+// instruction and of each container image pull or build, so they can be printed as a breakdown at the end of the run
+type RunProfile struct {
+	lastReceivedInstruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction
+
+	instructionSteps    []*runProfileStep
+	containerImageSteps []*runProfileStep
+}
+
+func NewRunProfile() *RunProfile {
+	return &RunProfile{
+		lastReceivedInstruction: nil,
+		instructionSteps:        nil,
+		containerImageSteps:     nil,
+	}
+}
+
+// AddResponseLine records the timing carried by the response line, if any. Instructions are executed one after the
+// other, so an instruction result always belongs to the last instruction received
+func (profile *RunProfile) AddResponseLine(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) {
+	if instruction := responseLine.GetInstruction(); instruction != nil {
+		profile.lastReceivedInstruction = instruction
+	} else if instructionResult := responseLine.GetInstructionResult(); instructionResult != nil {
+		if instructionResult.ExecutionDurationMilliseconds == nil || profile.lastReceivedInstruction == nil {
+			return
+		}
+		profile.instructionSteps = append(profile.instructionSteps, &runProfileStep{
+			description: formatInstructionStep(profile.lastReceivedInstruction),
+			duration:    time.Duration(instructionResult.GetExecutionDurationMilliseconds()) * time.Millisecond,
+		})
+	} else if containerImageTiming := responseLine.GetContainerImageTiming(); containerImageTiming != nil {
+		profile.containerImageSteps = append(profile.containerImageSteps, &runProfileStep{
+			description: formatContainerImageStep(containerImageTiming),
+			duration:    time.Duration(containerImageTiming.GetDurationMilliseconds()) * time.Millisecond,
+		})
+	}
+}
+
+// String renders the breakdown, sorted from the slowest step to the fastest. The total only accounts for the
+// instructions, as container images are fetched concurrently before they execute
+func (profile *RunProfile) String() string {
+	allSteps := append(append([]*runProfileStep{}, profile.instructionSteps...), profile.containerImageSteps...)
+	if len(allSteps) == 0 {
+		return runProfileEmptyMsg
+	}
+	sort.SliceStable(allSteps, func(i, j int) bool {
+		return allSteps[i].duration > allSteps[j].duration
+	})
+
+	totalInstructionsDuration := time.Duration(0)
+	for _, step := range profile.instructionSteps {
+		totalInstructionsDuration += step.duration
+	}
+
+	profileStr := &strings.Builder{}
+	profileStr.WriteString(runProfileHeader + newlineChar)
+	tabWriter := tabwriter.NewWriter(profileStr, tabWriterMinwidth, tabWriterTabwidth, tabWriterPadding, tabWriterPadchar, tabWriterFlags)
+	for _, step := range allSteps {
+		fmt.Fprintf(tabWriter, "%s\t%s\n", step.duration.Round(runProfileDurationPrecision), step.description)
+	}
+	fmt.Fprintf(tabWriter, "%s\t%s", totalInstructionsDuration.Round(runProfileDurationPrecision), runProfileTotalLabel)
+	// writing to a strings.Builder can't fail
+	_ = tabWriter.Flush()
+	return profileStr.String()
+}
+
+func formatInstructionStep(instruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction) string {
+	readableInstruction := strings.TrimPrefix(formatInstructionToReadableString(instruction, false), instructionPrefixString)
+	return fmt.Sprintf(
+		instructionStepFormat,
+		readableInstruction,
+		instruction.GetPosition().GetFilename(),
+		instruction.GetPosition().GetLine(),
+		instruction.GetPosition().GetColumn(),
+	)
+}
+
+func formatContainerImageStep(containerImageTiming *kurtosis_core_rpc_api_bindings.StarlarkContainerImageTiming) string {
+	if len(containerImageTiming.GetServiceNames()) == 0 {
+		return fmt.Sprintf(containerImageStepFormat, containerImageTiming.GetImageName())
+	}
+	return fmt.Sprintf(containerImageServicesStepFormat, containerImageTiming.GetImageName(), strings.Join(containerImageTiming.GetServiceNames(), containerImageServiceNamesJoinStr))
+}
@@ -0,0 +1,35 @@
+package output_printers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunProfile_SortsStepsFromSlowestToFastest(t *testing.T) {
+	profile := NewRunProfile()
+
+	profile.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromContainerImageTiming("postgres:16", []string{"db", "replica"}, 2500*time.Millisecond))
+	profile.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromContainerImageTiming("busybox", nil, 10*time.Millisecond))
+	profile.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstruction(testInstruction()))
+	profile.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromTimedInstructionResult("done", 4*time.Second))
+	profile.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstruction(testInstruction()))
+	// skipped instructions don't carry a duration
+	profile.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult("skipped"))
+	profile.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstruction(testInstruction()))
+	profile.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromTimedInstructionResult("done", 1500*time.Millisecond))
+
+	expectedProfile := `Run profile (slowest first):
+4s     my_instruction foo kwarg1=serviceA (from dummyFile[12:4])
+2.5s   image postgres:16 (services: db, replica)
+1.5s   my_instruction foo kwarg1=serviceA (from dummyFile[12:4])
+10ms   image busybox
+5.5s   Total`
+	require.Equal(t, expectedProfile, profile.String())
+}
+
+func TestRunProfile_Empty(t *testing.T) {
+	require.Equal(t, runProfileEmptyMsg, NewRunProfile().String())
+}
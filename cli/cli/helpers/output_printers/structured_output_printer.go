@@ -0,0 +1,66 @@
+package output_printers
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormat is the format the ls and inspect commands print their result in
+type OutputFormat string
+
+const (
+	// OutputFormat_Text is the human-readable output, i.e. tables and key-value pairs
+	OutputFormat_Text OutputFormat = ""
+	OutputFormat_Json OutputFormat = "json"
+	OutputFormat_Yaml OutputFormat = "yaml"
+
+	jsonIndent = "  "
+)
+
+// ParseOutputFormat validates the value of the output format flag, which is case-insensitive
+func ParseOutputFormat(outputFormatStr string) (OutputFormat, error) {
+	outputFormat := OutputFormat(strings.ToLower(strings.TrimSpace(outputFormatStr)))
+	switch outputFormat {
+	case OutputFormat_Text, OutputFormat_Json, OutputFormat_Yaml:
+		return outputFormat, nil
+	}
+	return OutputFormat_Text, stacktrace.NewError("Invalid output format '%v'; must be '%v' or '%v'", outputFormatStr, OutputFormat_Json, OutputFormat_Yaml)
+}
+
+func (outputFormat OutputFormat) IsStructured() bool {
+	return outputFormat != OutputFormat_Text
+}
+
+// PrintStructuredOutput prints the value as JSON or YAML to stdout. The value is expected to be one of the output
+// structures of the commands, whose fields are tagged for both formats so that scripts get the same keys in both
+func PrintStructuredOutput(outputFormat OutputFormat, value interface{}) error {
+	serializedValue, err := serializeStructuredOutput(outputFormat, value)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the output to '%v'", outputFormat)
+	}
+	out.PrintOutLn(serializedValue)
+	return nil
+}
+
+func serializeStructuredOutput(outputFormat OutputFormat, value interface{}) (string, error) {
+	switch outputFormat {
+	case OutputFormat_Json:
+		serializedValue, err := json.MarshalIndent(value, "", jsonIndent)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred marshalling the output to JSON")
+		}
+		return string(serializedValue), nil
+	case OutputFormat_Yaml:
+		serializedValue, err := yaml.Marshal(value)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred marshalling the output to YAML")
+		}
+		return strings.TrimSuffix(string(serializedValue), "\n"), nil
+	case OutputFormat_Text:
+	}
+	return "", stacktrace.NewError("Output format '%v' isn't a structured output format; this is a bug in Kurtosis", outputFormat)
+}
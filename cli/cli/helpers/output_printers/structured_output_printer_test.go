@@ -0,0 +1,46 @@
+package output_printers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testOutput struct {
+	Name  string   `json:"name" yaml:"name"`
+	Ports []uint32 `json:"ports" yaml:"ports"`
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	outputFormat, err := ParseOutputFormat("")
+	require.NoError(t, err)
+	require.Equal(t, OutputFormat_Text, outputFormat)
+	require.False(t, outputFormat.IsStructured())
+
+	outputFormat, err = ParseOutputFormat(" JSON ")
+	require.NoError(t, err)
+	require.Equal(t, OutputFormat_Json, outputFormat)
+	require.True(t, outputFormat.IsStructured())
+
+	outputFormat, err = ParseOutputFormat("yaml")
+	require.NoError(t, err)
+	require.Equal(t, OutputFormat_Yaml, outputFormat)
+
+	_, err = ParseOutputFormat("table")
+	require.Error(t, err)
+}
+
+func TestSerializeStructuredOutput(t *testing.T) {
+	value := testOutput{Name: "postgres", Ports: []uint32{5432}}
+
+	serializedJson, err := serializeStructuredOutput(OutputFormat_Json, value)
+	require.NoError(t, err)
+	require.Equal(t, "{\n  \"name\": \"postgres\",\n  \"ports\": [\n    5432\n  ]\n}", serializedJson)
+
+	serializedYaml, err := serializeStructuredOutput(OutputFormat_Yaml, value)
+	require.NoError(t, err)
+	require.Equal(t, "name: postgres\nports:\n    - 5432", serializedYaml)
+
+	_, err = serializeStructuredOutput(OutputFormat_Text, value)
+	require.Error(t, err)
+}
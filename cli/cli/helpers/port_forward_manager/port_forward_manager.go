@@ -0,0 +1,295 @@
+package port_forward_manager
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	sessionFileMode = 0600
+
+	processPingSignal = 0
+
+	sessionFileExtension = ".json"
+
+	// Waiting for the background process to write its session file, which it does once it listens on all the ports
+	backgroundSessionRetries                  = 50
+	backgroundSessionRetriesDelayMilliseconds = 200
+
+	processExitedChanBufferSize = 1
+
+	stopRetries                  = 25
+	stopRetriesDelayMilliseconds = 200
+)
+
+// ForwardedPort is a local port forwarded to the port of a service
+type ForwardedPort struct {
+	ServiceName string `json:"service_name" yaml:"service_name"`
+
+	PortId string `json:"port_id" yaml:"port_id"`
+
+	LocalPort uint16 `json:"local_port" yaml:"local_port"`
+
+	RemoteAddress string `json:"remote_address" yaml:"remote_address"`
+}
+
+// PortForwardSession is a process forwarding ports of an enclave, running either in the foreground of a terminal or
+// in the background
+type PortForwardSession struct {
+	Pid int `json:"pid" yaml:"pid"`
+
+	EnclaveName string `json:"enclave_name" yaml:"enclave_name"`
+
+	EnclaveUuid string `json:"enclave_uuid" yaml:"enclave_uuid"`
+
+	IsBackground bool `json:"is_background" yaml:"is_background"`
+
+	// Only set for background sessions, which have no terminal to print to
+	LogFilePath string `json:"log_file_path,omitempty" yaml:"log_file_path,omitempty"`
+
+	ForwardedPorts []*ForwardedPort `json:"forwarded_ports" yaml:"forwarded_ports"`
+}
+
+// IsForEnclave returns whether the session forwards the ports of the enclave with the given name, UUID or shortened UUID
+func (session *PortForwardSession) IsForEnclave(enclaveIdentifier string) bool {
+	return enclaveIdentifier == session.EnclaveName ||
+		enclaveIdentifier == session.EnclaveUuid ||
+		enclaveIdentifier == uuid_generator.ShortenedUUIDString(session.EnclaveUuid)
+}
+
+// SaveSession persists the session so that it's listed by GetSessions
+func SaveSession(session *PortForwardSession) error {
+	sessionFilePath, err := host_machine_directories.GetPortForwardSessionFilePath(session.Pid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the file path of the port forward session")
+	}
+	serializedSession, err := json.Marshal(session)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing port forward session '%d'", session.Pid)
+	}
+	// Writing to a temporary file first so that a session is never read half written
+	tempSessionFilePath := sessionFilePath + ".tmp"
+	if err := os.WriteFile(tempSessionFilePath, serializedSession, sessionFileMode); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing port forward session file '%v'", tempSessionFilePath)
+	}
+	if err := os.Rename(tempSessionFilePath, sessionFilePath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving port forward session file '%v' to '%v'", tempSessionFilePath, sessionFilePath)
+	}
+	return nil
+}
+
+// RemoveSession removes the session file of the process with the given PID, if any
+func RemoveSession(pid int) error {
+	sessionFilePath, err := host_machine_directories.GetPortForwardSessionFilePath(pid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the file path of port forward session '%d'", pid)
+	}
+	if err := os.Remove(sessionFilePath); err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "An error occurred removing port forward session file '%v'", sessionFilePath)
+	}
+	return nil
+}
+
+// GetSessions returns the port forward sessions whose process is still running, sorted by PID. The files of the
+// sessions whose process died without cleaning up are removed
+func GetSessions() ([]*PortForwardSession, error) {
+	sessionsDirPath, err := host_machine_directories.GetPortForwardSessionsDirPath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the directory of the port forward sessions")
+	}
+	sessionFiles, err := os.ReadDir(sessionsDirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*PortForwardSession{}, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred listing the port forward sessions in '%v'", sessionsDirPath)
+	}
+
+	sessions := []*PortForwardSession{}
+	for _, sessionFile := range sessionFiles {
+		if sessionFile.IsDir() || path.Ext(sessionFile.Name()) != sessionFileExtension {
+			continue
+		}
+		pid, err := strconv.Atoi(strings.TrimSuffix(sessionFile.Name(), sessionFileExtension))
+		if err != nil {
+			logrus.Debugf("Ignoring file '%v' in the port forward sessions directory as it's not named after a PID", sessionFile.Name())
+			continue
+		}
+		session, err := getSession(pid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading port forward session '%d'", pid)
+		}
+		if session == nil {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Pid < sessions[j].Pid
+	})
+	return sessions, nil
+}
+
+// StartSessionInBackground runs the CLI with the given arguments in a new process, which is expected to forward the
+// ports and save its session. It returns the session once it's been saved
+func StartSessionInBackground(cliArgs []string) (*PortForwardSession, error) {
+	cliBinaryFilePath, err := os.Executable()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the path of the Kurtosis CLI binary")
+	}
+	// The background process doesn't know its log file, so it's named after this process and recorded in the session
+	// once it's saved
+	logFilePath, err := host_machine_directories.GetPortForwardLogFilePath(os.Getpid())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the path of the port forward log file")
+	}
+	logFile, err := os.Create(logFilePath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating port forward log file '%v'", logFilePath)
+	}
+	defer logFile.Close()
+
+	backgroundCmd := exec.Command(cliBinaryFilePath, cliArgs...)
+	backgroundCmd.Stdout = logFile
+	backgroundCmd.Stderr = logFile
+	if err := backgroundCmd.Start(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the port forward in the background")
+	}
+	backgroundPid := backgroundCmd.Process.Pid
+	// Reaping the process as soon as it exits, so that it's not seen as running while waiting for its session
+	processExitedChan := make(chan error, processExitedChanBufferSize)
+	go func() {
+		processExitedChan <- backgroundCmd.Wait()
+	}()
+
+	for i := 0; i < backgroundSessionRetries; i++ {
+		select {
+		case <-processExitedChan:
+			return nil, stacktrace.NewError("The port forward process exited before forwarding the ports, see its logs in '%v'", logFilePath)
+		default:
+		}
+		session, err := getSession(backgroundPid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the session of the port forward process with PID '%d'", backgroundPid)
+		}
+		if session != nil {
+			session.IsBackground = true
+			session.LogFilePath = logFilePath
+			if err := SaveSession(session); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred saving the session of the port forward process with PID '%d'", backgroundPid)
+			}
+			return session, nil
+		}
+		time.Sleep(time.Duration(backgroundSessionRetriesDelayMilliseconds) * time.Millisecond)
+	}
+	if err := backgroundCmd.Process.Kill(); err != nil {
+		logrus.Warnf("The port forward process with PID '%d' couldn't be killed after it didn't forward the ports in time, it might need to be killed manually", backgroundPid)
+	}
+	return nil, stacktrace.NewError("The port forward process with PID '%d' didn't forward the ports in time, see its logs in '%v'", backgroundPid, logFilePath)
+}
+
+// StopSession stops the process of the session and removes its file
+func StopSession(session *PortForwardSession) error {
+	process, err := getRunningProcessFromPID(session.Pid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the port forward process with PID '%d'", session.Pid)
+	}
+	if process != nil {
+		if err := process.Signal(syscall.SIGINT); err != nil {
+			return stacktrace.Propagate(err, "An error occurred stopping the port forward process with PID '%d'", session.Pid)
+		}
+		if err := waitForTermination(session.Pid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred waiting for the port forward process with PID '%d' to stop", session.Pid)
+		}
+	}
+	// the process removes its session when it stops, this only cleans up after a process that couldn't
+	if err := RemoveSession(session.Pid); err != nil {
+		return stacktrace.Propagate(err, "The port forward process with PID '%d' was stopped but its session couldn't be removed", session.Pid)
+	}
+	if session.LogFilePath != "" {
+		if err := os.Remove(session.LogFilePath); err != nil && !os.IsNotExist(err) {
+			logrus.Warnf("The port forward process with PID '%d' was stopped but its log file '%v' couldn't be removed", session.Pid, session.LogFilePath)
+		}
+	}
+	return nil
+}
+
+// getSession returns the session of the process with the given PID, or nil if there's none or its process isn't
+// running anymore, in which case its file is removed
+func getSession(pid int) (*PortForwardSession, error) {
+	sessionFilePath, err := host_machine_directories.GetPortForwardSessionFilePath(pid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the file path of port forward session '%d'", pid)
+	}
+	serializedSession, err := os.ReadFile(sessionFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred reading port forward session file '%v'", sessionFilePath)
+	}
+
+	process, err := getRunningProcessFromPID(pid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the port forward process with PID '%d'", pid)
+	}
+	if process == nil {
+		logrus.Debugf("The port forward process with PID '%d' isn't running anymore, removing its session", pid)
+		if err := RemoveSession(pid); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred removing the session of stopped port forward process '%d'", pid)
+		}
+		return nil, nil
+	}
+
+	session := &PortForwardSession{
+		Pid:            0,
+		EnclaveName:    "",
+		EnclaveUuid:    "",
+		IsBackground:   false,
+		LogFilePath:    "",
+		ForwardedPorts: nil,
+	}
+	if err := json.Unmarshal(serializedSession, session); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing port forward session file '%v'", sessionFilePath)
+	}
+	return session, nil
+}
+
+// getRunningProcessFromPID returns the process with the given PID, or nil if it's not running
+func getRunningProcessFromPID(pid int) (*os.Process, error) {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		// this should never happen on Unix system, see FindProcess docs
+		return nil, stacktrace.Propagate(err, "Unexpected error getting process attached to PID '%d'", pid)
+	}
+	if err = process.Signal(syscall.Signal(processPingSignal)); err != nil {
+		return nil, nil
+	}
+	return process, nil
+}
+
+func waitForTermination(pid int) error {
+	for i := 0; i < stopRetries; i++ {
+		process, err := getRunningProcessFromPID(pid)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unexpected error getting process from pid '%d' while waiting for termination", pid)
+		}
+		if process == nil {
+			return nil
+		}
+		time.Sleep(time.Duration(stopRetriesDelayMilliseconds) * time.Millisecond)
+	}
+	return stacktrace.NewError("Port forward process with PID '%d' did not terminate after %d retries", pid, stopRetries)
+}
@@ -0,0 +1,136 @@
+package port_forward_manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	localhostIpAddress = "127.0.0.1"
+
+	tcpNetwork = "tcp"
+
+	// Letting the OS pick the local port when the preferred one is taken
+	anyLocalPort = 0
+
+	numberOfProxyCopyDirections = 2
+)
+
+// PortForwarder forwards local TCP ports to the ports of services, accepting connections until it's stopped
+type PortForwarder struct {
+	listeners []net.Listener
+
+	forwardedPorts []*ForwardedPort
+}
+
+func NewPortForwarder() *PortForwarder {
+	return &PortForwarder{
+		listeners:      []net.Listener{},
+		forwardedPorts: []*ForwardedPort{},
+	}
+}
+
+// AddForward starts listening locally for the port of the service, on the preferred local port if it's available and
+// on a port picked by the OS otherwise
+func (forwarder *PortForwarder) AddForward(serviceName string, portId string, preferredLocalPort uint16, remoteAddress string) (*ForwardedPort, error) {
+	listener, err := net.Listen(tcpNetwork, net.JoinHostPort(localhostIpAddress, strconv.Itoa(int(preferredLocalPort))))
+	if err != nil {
+		logrus.Debugf("Local port '%d' isn't available to forward port '%v' of service '%v', letting the OS pick one instead. Error was:\n%v", preferredLocalPort, portId, serviceName, err)
+		listener, err = net.Listen(tcpNetwork, net.JoinHostPort(localhostIpAddress, strconv.Itoa(anyLocalPort)))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred listening on a local port to forward port '%v' of service '%v'", portId, serviceName)
+		}
+	}
+	localTcpAddress, ok := listener.Addr().(*net.TCPAddr)
+	if !ok {
+		listener.Close()
+		return nil, stacktrace.NewError("Expected the local address '%v' to be a TCP address", listener.Addr())
+	}
+
+	forwardedPort := &ForwardedPort{
+		ServiceName:   serviceName,
+		PortId:        portId,
+		LocalPort:     uint16(localTcpAddress.Port),
+		RemoteAddress: remoteAddress,
+	}
+	forwarder.listeners = append(forwarder.listeners, listener)
+	forwarder.forwardedPorts = append(forwarder.forwardedPorts, forwardedPort)
+	return forwardedPort, nil
+}
+
+func (forwarder *PortForwarder) GetForwardedPorts() []*ForwardedPort {
+	return forwarder.forwardedPorts
+}
+
+// Run forwards the connections made to the local ports until the context is cancelled
+func (forwarder *PortForwarder) Run(ctx context.Context) {
+	waitGroup := &sync.WaitGroup{}
+	for idx, listener := range forwarder.listeners {
+		waitGroup.Add(1)
+		go func(listener net.Listener, forwardedPort *ForwardedPort) {
+			defer waitGroup.Done()
+			acceptConnectionsUntilClosed(listener, forwardedPort)
+		}(listener, forwarder.forwardedPorts[idx])
+	}
+
+	<-ctx.Done()
+	forwarder.Stop()
+	waitGroup.Wait()
+}
+
+// Stop stops listening on the local ports. Connections already established are left to finish on their own
+func (forwarder *PortForwarder) Stop() {
+	for _, listener := range forwarder.listeners {
+		if err := listener.Close(); err != nil {
+			logrus.Debugf("An error occurred closing listener '%v':\n%v", listener.Addr(), err)
+		}
+	}
+}
+
+func acceptConnectionsUntilClosed(listener net.Listener, forwardedPort *ForwardedPort) {
+	for {
+		localConnection, err := listener.Accept()
+		if err != nil {
+			// this is how a closed listener shows up
+			logrus.Debugf("Stopped accepting connections on local port '%d':\n%v", forwardedPort.LocalPort, err)
+			return
+		}
+		go proxyConnection(localConnection, forwardedPort)
+	}
+}
+
+func proxyConnection(localConnection net.Conn, forwardedPort *ForwardedPort) {
+	defer localConnection.Close()
+	remoteConnection, err := net.Dial(tcpNetwork, forwardedPort.RemoteAddress)
+	if err != nil {
+		logrus.Errorf("An error occurred connecting to port '%v' of service '%v' at '%v':\n%v", forwardedPort.PortId, forwardedPort.ServiceName, forwardedPort.RemoteAddress, err)
+		return
+	}
+	defer remoteConnection.Close()
+
+	// Whichever side closes first ends the connection for both
+	copyDoneChan := make(chan error, numberOfProxyCopyDirections)
+	go func() {
+		_, err := io.Copy(remoteConnection, localConnection)
+		copyDoneChan <- err
+	}()
+	go func() {
+		_, err := io.Copy(localConnection, remoteConnection)
+		copyDoneChan <- err
+	}()
+	if err := <-copyDoneChan; err != nil {
+		logrus.Debugf("Connection forwarded to port '%v' of service '%v' ended with an error:\n%v", forwardedPort.PortId, forwardedPort.ServiceName, err)
+	}
+}
+
+// GetLocalAddress returns the address to reach the forwarded port on
+func (forwardedPort *ForwardedPort) GetLocalAddress() string {
+	return fmt.Sprintf("%s:%d", localhostIpAddress, forwardedPort.LocalPort)
+}
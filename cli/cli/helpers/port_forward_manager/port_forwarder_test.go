@@ -0,0 +1,102 @@
+package port_forward_manager
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName = "postgres"
+	testPortId      = "db"
+	testMessage     = "hello\n"
+)
+
+func TestPortForwarder_ForwardsConnections(t *testing.T) {
+	remoteAddress := startEchoServer(t)
+
+	forwarder := NewPortForwarder()
+	forwardedPort, err := forwarder.AddForward(testServiceName, testPortId, anyLocalPort, remoteAddress)
+	require.NoError(t, err)
+	require.NotZero(t, forwardedPort.LocalPort)
+	require.Equal(t, remoteAddress, forwardedPort.RemoteAddress)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runDoneChan := make(chan struct{})
+	go func() {
+		forwarder.Run(ctx)
+		close(runDoneChan)
+	}()
+
+	connection, err := net.Dial(tcpNetwork, forwardedPort.GetLocalAddress())
+	require.NoError(t, err)
+	defer connection.Close()
+	_, err = connection.Write([]byte(testMessage))
+	require.NoError(t, err)
+	echoedMessage, err := bufio.NewReader(connection).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, testMessage, echoedMessage)
+
+	cancel()
+	<-runDoneChan
+	_, err = net.Dial(tcpNetwork, forwardedPort.GetLocalAddress())
+	require.Error(t, err)
+}
+
+func TestPortForwarder_FallsBackToRandomPortWhenTaken(t *testing.T) {
+	takenListener, err := net.Listen(tcpNetwork, net.JoinHostPort(localhostIpAddress, strconv.Itoa(anyLocalPort)))
+	require.NoError(t, err)
+	defer takenListener.Close()
+	takenPort := uint16(takenListener.Addr().(*net.TCPAddr).Port)
+
+	forwarder := NewPortForwarder()
+	defer forwarder.Stop()
+	forwardedPort, err := forwarder.AddForward(testServiceName, testPortId, takenPort, "")
+	require.NoError(t, err)
+	require.NotEqual(t, takenPort, forwardedPort.LocalPort)
+	require.Len(t, forwarder.GetForwardedPorts(), 1)
+}
+
+func TestPortForwardSession_IsForEnclave(t *testing.T) {
+	session := &PortForwardSession{
+		Pid:            1,
+		EnclaveName:    "my-enclave",
+		EnclaveUuid:    "0123456789ab4cdef0123456789abcde",
+		IsBackground:   false,
+		LogFilePath:    "",
+		ForwardedPorts: nil,
+	}
+	require.True(t, session.IsForEnclave("my-enclave"))
+	require.True(t, session.IsForEnclave("0123456789ab4cdef0123456789abcde"))
+	require.True(t, session.IsForEnclave("0123456789ab"))
+	require.False(t, session.IsForEnclave("other-enclave"))
+}
+
+func startEchoServer(t *testing.T) string {
+	listener, err := net.Listen(tcpNetwork, net.JoinHostPort(localhostIpAddress, strconv.Itoa(anyLocalPort)))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		listener.Close()
+	})
+	go func() {
+		for {
+			connection, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer connection.Close()
+				line, err := bufio.NewReader(connection).ReadString('\n')
+				if err != nil {
+					return
+				}
+				_, _ = connection.Write([]byte(line))
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
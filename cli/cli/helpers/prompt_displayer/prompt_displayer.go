@@ -40,6 +40,36 @@ func DisplayConfirmationPromptAndGetBooleanResult(label string, defaultValue str
 	return userInput, nil
 }
 
+// DisplayInputPromptAndGetResult asks the user for a free-form value, re-prompting until [validate] accepts it
+func DisplayInputPromptAndGetResult(label string, validate func(input string) error) (string, error) {
+	if len(label) > maxLabelLength {
+		return "", stacktrace.NewError("Label '%v' is longer than the maximum allowed characters, '%v'", label, maxLabelLength)
+	}
+
+	prompt := promptui.Prompt{
+		Label:       label,
+		Default:     "",
+		AllowEdit:   false,
+		Validate:    validate,
+		Mask:        0,
+		HideEntered: false,
+		Templates:   nil,
+		IsConfirm:   false,
+		IsVimMode:   false,
+		Pointer:     nil,
+		Stdin:       nil,
+		Stdout:      nil,
+	}
+
+	userInput, err := prompt.Run()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred displaying the prompt")
+	}
+	logrus.Debugf("User input: '%v'", userInput)
+
+	return userInput, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -0,0 +1,245 @@
+package ssh_tunnel_manager
+
+import (
+	"context"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/stacktrace"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	defaultSshPort          = 22
+	defaultDockerSocketPath = "/var/run/docker.sock"
+
+	sshAuthSockEnvVar          = "SSH_AUTH_SOCK"
+	knownHostsRelativeFilepath = ".ssh/known_hosts"
+	homeDirPrefix              = "~/"
+
+	jumpHostUserSeparator = "@"
+
+	portNumberBase    = 10
+	portNumberBitSize = 16
+
+	tcpNetwork  = "tcp"
+	unixNetwork = "unix"
+
+	unixSocketPrefix = "unix://"
+
+	sshDialTimeout = 15 * time.Second
+)
+
+// SshConnection is a connection to the remote host of an SSH context, through which the Docker daemon and the ports of
+// the remote host are reached
+type SshConnection struct {
+	client *ssh.Client
+
+	// Nil if the remote host is connected to directly
+	jumpHostClient *ssh.Client
+
+	dockerSocketPath string
+}
+
+// NewSshConnection connects to the remote host of the SSH context, through its jump host if it has one. The keys of
+// both hosts are checked against the known_hosts file of the user, so they must have been connected to with ssh before
+func NewSshConnection(sshContext *generated.SshContextV0) (*SshConnection, error) {
+	authMethod, closeAuthMethodFunc, err := getAuthMethod(sshContext)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the method to authenticate to '%v' with", sshContext.GetHost())
+	}
+	// The auth method is only used during the handshakes
+	defer closeAuthMethodFunc()
+
+	hostKeyCallback, err := getKnownHostsCallback()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the known hosts")
+	}
+
+	port := sshContext.GetPort()
+	if port == 0 {
+		port = defaultSshPort
+	}
+	address := net.JoinHostPort(sshContext.GetHost(), strconv.Itoa(int(port)))
+	config := newSshClientConfig(sshContext.GetUser(), authMethod, hostKeyCallback)
+
+	dockerSocketPath := sshContext.GetDockerSocketPath()
+	if dockerSocketPath == "" {
+		dockerSocketPath = defaultDockerSocketPath
+	}
+
+	if sshContext.JumpHost == nil {
+		sshClient, err := ssh.Dial(tcpNetwork, address, config)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred connecting to '%v' over SSH", address)
+		}
+		return &SshConnection{
+			client:           sshClient,
+			jumpHostClient:   nil,
+			dockerSocketPath: dockerSocketPath,
+		}, nil
+	}
+
+	jumpHostUser, jumpHostAddress, err := parseJumpHost(sshContext.GetJumpHost(), sshContext.GetUser())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing jump host '%v'", sshContext.GetJumpHost())
+	}
+	jumpHostClient, err := ssh.Dial(tcpNetwork, jumpHostAddress, newSshClientConfig(jumpHostUser, authMethod, hostKeyCallback))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to jump host '%v' over SSH", jumpHostAddress)
+	}
+	shouldCloseJumpHostClient := true
+	defer func() {
+		if shouldCloseJumpHostClient {
+			jumpHostClient.Close()
+		}
+	}()
+	connThroughJumpHost, err := jumpHostClient.Dial(tcpNetwork, address)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reaching '%v' from jump host '%v'", address, jumpHostAddress)
+	}
+	sshClientConn, newChannels, requests, err := ssh.NewClientConn(connThroughJumpHost, address, config)
+	if err != nil {
+		connThroughJumpHost.Close()
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to '%v' over SSH through jump host '%v'", address, jumpHostAddress)
+	}
+	shouldCloseJumpHostClient = false
+	return &SshConnection{
+		client:           ssh.NewClient(sshClientConn, newChannels, requests),
+		jumpHostClient:   jumpHostClient,
+		dockerSocketPath: dockerSocketPath,
+	}, nil
+}
+
+// DialRemoteLocalhost connects to a port listening on the loopback interface of the remote host
+func (connection *SshConnection) DialRemoteLocalhost(port uint16) (net.Conn, error) {
+	remoteAddress := net.JoinHostPort(localhostIpAddress, strconv.Itoa(int(port)))
+	conn, err := connection.client.Dial(tcpNetwork, remoteAddress)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to '%v' on the remote host", remoteAddress)
+	}
+	return conn, nil
+}
+
+// GetDockerClientOpts returns the options of a client of the Docker daemon of the remote host, reached through the SSH
+// connection
+func (connection *SshConnection) GetDockerClientOpts() []client.Opt {
+	return []client.Opt{
+		// WithHost sets up a dialer for the socket, so it must come before the dialer through the SSH connection
+		client.WithHost(unixSocketPrefix + connection.dockerSocketPath),
+		client.WithDialContext(func(_ context.Context, _ string, _ string) (net.Conn, error) {
+			return connection.client.Dial(unixNetwork, connection.dockerSocketPath)
+		}),
+		client.WithAPIVersionNegotiation(),
+	}
+}
+
+func (connection *SshConnection) Close() error {
+	if err := connection.client.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the SSH connection to the remote host")
+	}
+	if connection.jumpHostClient != nil {
+		if err := connection.jumpHostClient.Close(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred closing the SSH connection to the jump host")
+		}
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func newSshClientConfig(user string, authMethod ssh.AuthMethod, hostKeyCallback ssh.HostKeyCallback) *ssh.ClientConfig {
+	// nolint: exhaustruct
+	return &ssh.ClientConfig{
+		User:            user,
+		Auth:            []ssh.AuthMethod{authMethod},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sshDialTimeout,
+	}
+}
+
+// getAuthMethod returns the identity file of the context if it has one, and the keys of the local SSH agent otherwise,
+// along with a function to release the agent once the connection is established
+func getAuthMethod(sshContext *generated.SshContextV0) (ssh.AuthMethod, func(), error) {
+	if sshContext.IdentityFile != nil {
+		identityFilepath := sshContext.GetIdentityFile()
+		if strings.HasPrefix(identityFilepath, homeDirPrefix) {
+			userHomeDirpath, err := os.UserHomeDir()
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred getting the home directory of the user to expand identity file '%v'", identityFilepath)
+			}
+			identityFilepath = path.Join(userHomeDirpath, strings.TrimPrefix(identityFilepath, homeDirPrefix))
+		}
+		privateKeyBytes, err := os.ReadFile(identityFilepath)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred reading identity file '%v'", identityFilepath)
+		}
+		signer, err := ssh.ParsePrivateKey(privateKeyBytes)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred parsing identity file '%v'; keys protected by a passphrase must be added to the SSH agent instead", sshContext.GetIdentityFile())
+		}
+		return ssh.PublicKeys(signer), func() {}, nil
+	}
+
+	agentSocketPath := os.Getenv(sshAuthSockEnvVar)
+	if agentSocketPath == "" {
+		return nil, nil, stacktrace.NewError("The context has no identity file and no SSH agent is running, as '%v' isn't set; either add an identity file to the context or start an SSH agent with the key of the remote host", sshAuthSockEnvVar)
+	}
+	agentConn, err := net.Dial(unixNetwork, agentSocketPath)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred connecting to the SSH agent at '%v'", agentSocketPath)
+	}
+	return ssh.PublicKeysCallback(agent.NewClient(agentConn).Signers), func() { agentConn.Close() }, nil
+}
+
+func getKnownHostsCallback() (ssh.HostKeyCallback, error) {
+	userHomeDirpath, err := os.UserHomeDir()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the home directory of the user")
+	}
+	knownHostsFilepath := path.Join(userHomeDirpath, knownHostsRelativeFilepath)
+	hostKeyCallback, err := knownhosts.New(knownHostsFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading known hosts file '%v'", knownHostsFilepath)
+	}
+	return hostKeyCallback, nil
+}
+
+// parseJumpHost splits a jump host of the form [user@]host[:port] into the user to log in as, which defaults to the user
+// of the context, and the address to connect to
+func parseJumpHost(jumpHost string, defaultUser string) (string, string, error) {
+	user := defaultUser
+	hostAndPort := jumpHost
+	if maybeUser, maybeHostAndPort, found := strings.Cut(jumpHost, jumpHostUserSeparator); found {
+		user = maybeUser
+		hostAndPort = maybeHostAndPort
+	}
+	if user == "" {
+		return "", "", stacktrace.NewError("Jump host '%v' has an empty user", jumpHost)
+	}
+
+	host, port, err := net.SplitHostPort(hostAndPort)
+	if err != nil {
+		// No port was given
+		host = hostAndPort
+		port = strconv.Itoa(defaultSshPort)
+	}
+	if host == "" {
+		return "", "", stacktrace.NewError("Jump host '%v' has an empty host", jumpHost)
+	}
+	if _, err := strconv.ParseUint(port, portNumberBase, portNumberBitSize); err != nil {
+		return "", "", stacktrace.Propagate(err, "Jump host '%v' has an invalid port '%v'", jumpHost, port)
+	}
+	return user, net.JoinHostPort(host, port), nil
+}
@@ -0,0 +1,33 @@
+package ssh_tunnel_manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJumpHost(t *testing.T) {
+	user, address, err := parseJumpHost("bastion.example.com", "kurtosis")
+	require.NoError(t, err)
+	require.Equal(t, "kurtosis", user)
+	require.Equal(t, "bastion.example.com:22", address)
+
+	user, address, err = parseJumpHost("jane@bastion.example.com:2222", "kurtosis")
+	require.NoError(t, err)
+	require.Equal(t, "jane", user)
+	require.Equal(t, "bastion.example.com:2222", address)
+
+	user, address, err = parseJumpHost("[2001:db8::1]:2222", "kurtosis")
+	require.NoError(t, err)
+	require.Equal(t, "kurtosis", user)
+	require.Equal(t, "[2001:db8::1]:2222", address)
+}
+
+func TestParseJumpHost_Invalid(t *testing.T) {
+	_, _, err := parseJumpHost("@bastion.example.com", "kurtosis")
+	require.Error(t, err)
+	_, _, err = parseJumpHost("jane@", "kurtosis")
+	require.Error(t, err)
+	_, _, err = parseJumpHost("bastion.example.com:ssh", "kurtosis")
+	require.Error(t, err)
+}
@@ -0,0 +1,258 @@
+package ssh_tunnel_manager
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	localhostIpAddress = "127.0.0.1"
+
+	tcpPortType = "tcp"
+
+	// The ports are synced when a Kurtosis container starts or stops, this catches up on missed events and retries
+	// after the connection got lost
+	syncInterval = 5 * time.Second
+
+	dockerLabelFilterKey     = "label"
+	dockerTypeFilterKey      = "type"
+	dockerEventFilterKey     = "event"
+	dockerContainerEventType = "container"
+	dockerStartEvent         = "start"
+	dockerDieEvent           = "die"
+
+	labelKeyValueSeparator = "="
+
+	numberOfProxyCopyDirections = 2
+)
+
+// SshTunnel mirrors the ports published by the Kurtosis containers of the remote host of an SSH context, i.e. the
+// engine gRPC port, the API container ports and the public ports of the services, to the same ports on the local
+// computer. As the Docker backend reports these ports on 127.0.0.1, they can then be used as if Kurtosis ran locally
+type SshTunnel struct {
+	sshContext *generated.SshContextV0
+
+	// Guards connection, which the proxied connections read while the ports are synced
+	mutex *sync.Mutex
+
+	// Nil until connected, and after the connection got lost
+	connection *SshConnection
+
+	// Local listener of every mirrored port, by port number
+	listeners map[uint16]net.Listener
+}
+
+func NewSshTunnel(sshContext *generated.SshContextV0) *SshTunnel {
+	return &SshTunnel{
+		sshContext: sshContext,
+		mutex:      &sync.Mutex{},
+		connection: nil,
+		listeners:  map[uint16]net.Listener{},
+	}
+}
+
+// Run mirrors the ports until the context is cancelled, reconnecting to the remote host if the connection gets lost.
+// onConnectedFunc is called every time the ports got mirrored after connecting
+func (tunnel *SshTunnel) Run(ctx context.Context, onConnectedFunc func()) {
+	defer tunnel.close()
+
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+	for {
+		if err := tunnel.runUntilDisconnected(ctx, ticker, onConnectedFunc); err != nil {
+			logrus.Warnf("The SSH tunnel got disconnected from the remote host, reconnecting in %v:\n%v", syncInterval, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runUntilDisconnected connects to the remote host and syncs the mirrored ports every time a Kurtosis container starts
+// or stops, until the connection fails or the context is cancelled
+func (tunnel *SshTunnel) runUntilDisconnected(ctx context.Context, ticker *time.Ticker, onConnectedFunc func()) error {
+	connection, err := NewSshConnection(tunnel.sshContext)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the remote host")
+	}
+	tunnel.setConnection(connection)
+	defer func() {
+		tunnel.setConnection(nil)
+		if err := connection.Close(); err != nil {
+			logrus.Debugf("An error occurred closing the SSH connection:\n%v", err)
+		}
+	}()
+
+	dockerClient, err := client.NewClientWithOpts(connection.GetDockerClientOpts()...)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a client of the Docker daemon of the remote host")
+	}
+	defer dockerClient.Close()
+
+	ctxUntilDisconnected, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+	eventFilters := filters.NewArgs(
+		filters.Arg(dockerTypeFilterKey, dockerContainerEventType),
+		filters.Arg(dockerEventFilterKey, dockerStartEvent),
+		filters.Arg(dockerEventFilterKey, dockerDieEvent),
+		filters.Arg(dockerLabelFilterKey, getKurtosisContainersLabelFilter()),
+	)
+	// nolint: exhaustruct
+	eventsChan, eventErrorsChan := dockerClient.Events(ctxUntilDisconnected, types.EventsOptions{Filters: eventFilters})
+
+	if err := tunnel.syncMirroredPorts(ctxUntilDisconnected, dockerClient); err != nil {
+		return stacktrace.Propagate(err, "An error occurred mirroring the ports")
+	}
+	logrus.Infof("Connected to the remote host, mirroring the ports of its Kurtosis containers")
+	onConnectedFunc()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-eventErrorsChan:
+			return stacktrace.Propagate(err, "An error occurred watching the Kurtosis containers of the remote host")
+		case <-eventsChan:
+		case <-ticker.C:
+		}
+		if err := tunnel.syncMirroredPorts(ctxUntilDisconnected, dockerClient); err != nil {
+			return stacktrace.Propagate(err, "An error occurred syncing the mirrored ports")
+		}
+	}
+}
+
+// syncMirroredPorts listens locally on the ports the Kurtosis containers publish, and stops listening on the ports they
+// don't publish anymore
+func (tunnel *SshTunnel) syncMirroredPorts(ctx context.Context, dockerClient *client.Client) error {
+	containerFilters := filters.NewArgs(filters.Arg(dockerLabelFilterKey, getKurtosisContainersLabelFilter()))
+	// nolint: exhaustruct
+	containers, err := dockerClient.ContainerList(ctx, types.ContainerListOptions{Filters: containerFilters})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the Kurtosis containers of the remote host")
+	}
+	publishedPorts := getPublishedTcpPorts(containers)
+
+	for port, listener := range tunnel.listeners {
+		if publishedPorts[port] {
+			continue
+		}
+		if err := listener.Close(); err != nil {
+			logrus.Debugf("An error occurred closing the listener of port '%d':\n%v", port, err)
+		}
+		delete(tunnel.listeners, port)
+		logrus.Infof("Stopped mirroring port %d", port)
+	}
+	for port := range publishedPorts {
+		if _, found := tunnel.listeners[port]; found {
+			continue
+		}
+		listener, err := net.Listen(tcpNetwork, net.JoinHostPort(localhostIpAddress, strconv.Itoa(int(port))))
+		if err != nil {
+			// Retried on the next sync, in case whatever holds the port releases it
+			logrus.Warnf("Port %d of the remote host can't be mirrored as it's already taken on this computer:\n%v", port, err)
+			continue
+		}
+		tunnel.listeners[port] = listener
+		go tunnel.acceptConnectionsUntilClosed(listener, port)
+		logrus.Infof("Mirroring port %d", port)
+	}
+	return nil
+}
+
+func (tunnel *SshTunnel) acceptConnectionsUntilClosed(listener net.Listener, port uint16) {
+	for {
+		localConnection, err := listener.Accept()
+		if err != nil {
+			// this is how a closed listener shows up
+			logrus.Debugf("Stopped accepting connections on local port '%d':\n%v", port, err)
+			return
+		}
+		go tunnel.proxyConnection(localConnection, port)
+	}
+}
+
+func (tunnel *SshTunnel) proxyConnection(localConnection net.Conn, port uint16) {
+	defer localConnection.Close()
+	connection := tunnel.getConnection()
+	if connection == nil {
+		logrus.Debugf("Dropping a connection to port '%d' as the SSH tunnel is reconnecting to the remote host", port)
+		return
+	}
+	remoteConnection, err := connection.DialRemoteLocalhost(port)
+	if err != nil {
+		logrus.Errorf("An error occurred connecting to port '%d' of the remote host:\n%v", port, err)
+		return
+	}
+	defer remoteConnection.Close()
+
+	// Whichever side closes first ends the connection for both
+	copyDoneChan := make(chan error, numberOfProxyCopyDirections)
+	go func() {
+		_, err := io.Copy(remoteConnection, localConnection)
+		copyDoneChan <- err
+	}()
+	go func() {
+		_, err := io.Copy(localConnection, remoteConnection)
+		copyDoneChan <- err
+	}()
+	if err := <-copyDoneChan; err != nil {
+		logrus.Debugf("Connection to port '%d' of the remote host ended with an error:\n%v", port, err)
+	}
+}
+
+func (tunnel *SshTunnel) getConnection() *SshConnection {
+	tunnel.mutex.Lock()
+	defer tunnel.mutex.Unlock()
+	return tunnel.connection
+}
+
+func (tunnel *SshTunnel) setConnection(connection *SshConnection) {
+	tunnel.mutex.Lock()
+	defer tunnel.mutex.Unlock()
+	tunnel.connection = connection
+}
+
+func (tunnel *SshTunnel) close() {
+	for port, listener := range tunnel.listeners {
+		if err := listener.Close(); err != nil {
+			logrus.Debugf("An error occurred closing the listener of port '%d':\n%v", port, err)
+		}
+	}
+	tunnel.listeners = map[uint16]net.Listener{}
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getKurtosisContainersLabelFilter() string {
+	return docker_label_key.AppIDDockerLabelKey.GetString() + labelKeyValueSeparator + label_value_consts.AppIDDockerLabelValue.GetString()
+}
+
+func getPublishedTcpPorts(containers []types.Container) map[uint16]bool {
+	publishedPorts := map[uint16]bool{}
+	for _, container := range containers {
+		for _, port := range container.Ports {
+			if port.Type != tcpPortType || port.PublicPort == 0 {
+				continue
+			}
+			publishedPorts[port.PublicPort] = true
+		}
+	}
+	return publishedPorts
+}
@@ -0,0 +1,225 @@
+package ssh_tunnel_manager
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	pidFileMode = 0600
+
+	processPingSignal = 0
+
+	pidNumberBase = 10
+
+	defaultPIDForStoppedProcess = 0
+
+	processExitedChanBufferSize = 1
+
+	// Waiting for the background process to write its PID file, which it does once it mirrors the ports of the remote
+	// host. Connecting over SSH can take a while, especially through a jump host
+	startRetries                  = 100
+	startRetriesDelayMilliseconds = 200
+
+	stopRetries                  = 25
+	stopRetriesDelayMilliseconds = 200
+)
+
+// StartInBackground stops the SSH tunnel process if one is running, then runs the SSH tunnel of the current context in
+// a new process. It returns the PID of the new process once it mirrors the ports of the remote host
+func StartInBackground() (int, error) {
+	if err := StopExisting(); err != nil {
+		return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "An error occurred stopping the SSH tunnel process that was already running")
+	}
+
+	cliBinaryFilePath, err := os.Executable()
+	if err != nil {
+		return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "An error occurred getting the path of the Kurtosis CLI binary")
+	}
+	logFilePath, err := host_machine_directories.GetSshTunnelLogFilePath()
+	if err != nil {
+		return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "An error occurred getting the path of the SSH tunnel log file")
+	}
+	// Create will truncate the file if it already exists.
+	logFile, err := os.Create(logFilePath)
+	if err != nil {
+		return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "An error occurred creating SSH tunnel log file '%v'", logFilePath)
+	}
+	defer logFile.Close()
+
+	backgroundCmd := exec.Command(cliBinaryFilePath, command_str_consts.ContextCmdStr, command_str_consts.ContextTunnelCmdStr)
+	backgroundCmd.Stdout = logFile
+	backgroundCmd.Stderr = logFile
+	if err := backgroundCmd.Start(); err != nil {
+		return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "An error occurred starting the SSH tunnel in the background")
+	}
+	backgroundPid := backgroundCmd.Process.Pid
+	// Reaping the process as soon as it exits, so that it's not seen as running while waiting for its PID file
+	processExitedChan := make(chan error, processExitedChanBufferSize)
+	go func() {
+		processExitedChan <- backgroundCmd.Wait()
+	}()
+
+	for i := 0; i < startRetries; i++ {
+		select {
+		case <-processExitedChan:
+			return defaultPIDForStoppedProcess, stacktrace.NewError("The SSH tunnel process exited before mirroring the ports of the remote host, see its logs in '%v'", logFilePath)
+		default:
+		}
+		pid, err := getPIDFromPidFile()
+		if err != nil {
+			return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "An error occurred reading the PID file of the SSH tunnel")
+		}
+		if pid == backgroundPid {
+			return backgroundPid, nil
+		}
+		time.Sleep(time.Duration(startRetriesDelayMilliseconds) * time.Millisecond)
+	}
+	if err := backgroundCmd.Process.Kill(); err != nil {
+		logrus.Warnf("The SSH tunnel process with PID '%d' couldn't be killed after it didn't connect in time, it might need to be killed manually", backgroundPid)
+	}
+	return defaultPIDForStoppedProcess, stacktrace.NewError("The SSH tunnel process with PID '%d' didn't connect to the remote host in time, see its logs in '%v'", backgroundPid, logFilePath)
+}
+
+// IsRunning returns whether an SSH tunnel process is running, in the background or in a terminal
+func IsRunning() (bool, error) {
+	process, err := getRunningProcess()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the SSH tunnel process")
+	}
+	return process != nil, nil
+}
+
+// StopExisting stops the SSH tunnel process, if any, and removes its PID file
+func StopExisting() error {
+	process, err := getRunningProcess()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the SSH tunnel process")
+	}
+	if process != nil {
+		if err := process.Signal(syscall.SIGINT); err != nil {
+			return stacktrace.Propagate(err, "An error occurred stopping the SSH tunnel process with PID '%d'", process.Pid)
+		}
+		if err := waitForTermination(process.Pid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred waiting for the SSH tunnel process with PID '%d' to stop", process.Pid)
+		}
+	}
+	pidFilePath, err := host_machine_directories.GetSshTunnelPidFilePath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the path of the SSH tunnel PID file")
+	}
+	if err := os.Remove(pidFilePath); err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "The SSH tunnel process was stopped but its PID file '%v' couldn't be removed", pidFilePath)
+	}
+	return nil
+}
+
+// SavePid records the process running the SSH tunnel, which it does once it mirrors the ports of the remote host
+func SavePid(pid int) error {
+	pidFilePath, err := host_machine_directories.GetSshTunnelPidFilePath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the path of the SSH tunnel PID file")
+	}
+	if err := os.WriteFile(pidFilePath, []byte(strconv.Itoa(pid)), pidFileMode); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing SSH tunnel PID file '%v'", pidFilePath)
+	}
+	return nil
+}
+
+// RemovePid removes the PID file if it records the process with the given PID, so that a process stopping doesn't
+// remove the PID file of the process that replaced it
+func RemovePid(pid int) error {
+	recordedPid, err := getPIDFromPidFile()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the PID file of the SSH tunnel")
+	}
+	if recordedPid != pid {
+		return nil
+	}
+	pidFilePath, err := host_machine_directories.GetSshTunnelPidFilePath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the path of the SSH tunnel PID file")
+	}
+	if err := os.Remove(pidFilePath); err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "An error occurred removing SSH tunnel PID file '%v'", pidFilePath)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+// getPIDFromPidFile returns the PID recorded in the PID file, or 0 if there's none. It doesn't check whether the process
+// is running
+func getPIDFromPidFile() (int, error) {
+	pidFilePath, err := host_machine_directories.GetSshTunnelPidFilePath()
+	if err != nil {
+		return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "An error occurred getting the path of the SSH tunnel PID file")
+	}
+	pidFileContent, err := os.ReadFile(pidFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return defaultPIDForStoppedProcess, nil
+		}
+		return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "An error occurred reading SSH tunnel PID file '%v'", pidFilePath)
+	}
+	pidFileRawContent := strings.TrimSpace(string(pidFileContent))
+	if pidFileRawContent == "" {
+		return defaultPIDForStoppedProcess, nil
+	}
+	pid, err := strconv.ParseInt(pidFileRawContent, pidNumberBase, strconv.IntSize)
+	if err != nil {
+		return defaultPIDForStoppedProcess, stacktrace.Propagate(err, "Unable to parse SSH tunnel PID file content. Was expecting a single PID number, got: '%s'", pidFileRawContent)
+	}
+	return int(pid), nil
+}
+
+// getRunningProcess returns the process recorded in the PID file, or nil if there's none or it's not running anymore
+func getRunningProcess() (*os.Process, error) {
+	pid, err := getPIDFromPidFile()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the PID file of the SSH tunnel")
+	}
+	if pid == defaultPIDForStoppedProcess {
+		return nil, nil
+	}
+	return getRunningProcessFromPID(pid)
+}
+
+// getRunningProcessFromPID returns the process with the given PID, or nil if it's not running
+func getRunningProcessFromPID(pid int) (*os.Process, error) {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		// this should never happen on Unix system, see FindProcess docs
+		return nil, stacktrace.Propagate(err, "Unexpected error getting process attached to PID '%d'", pid)
+	}
+	if err = process.Signal(syscall.Signal(processPingSignal)); err != nil {
+		return nil, nil
+	}
+	return process, nil
+}
+
+func waitForTermination(pid int) error {
+	for i := 0; i < stopRetries; i++ {
+		process, err := getRunningProcessFromPID(pid)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unexpected error getting process from pid '%d' while waiting for termination", pid)
+		}
+		if process == nil {
+			return nil
+		}
+		time.Sleep(time.Duration(stopRetriesDelayMilliseconds) * time.Millisecond)
+	}
+	return stacktrace.NewError("SSH tunnel process with PID '%d' did not terminate after %d retries", pid, stopRetries)
+}
@@ -0,0 +1,31 @@
+package ssh_tunnel_manager
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPublishedTcpPorts(t *testing.T) {
+	// nolint: exhaustruct
+	containers := []types.Container{
+		{
+			Ports: []types.Port{
+				{PrivatePort: 9710, PublicPort: 9710, Type: "tcp"},
+				{PrivatePort: 9711, PublicPort: 9711, Type: "tcp"},
+			},
+		},
+		{
+			Ports: []types.Port{
+				{PrivatePort: 8545, PublicPort: 32768, Type: "tcp"},
+				// Not published
+				{PrivatePort: 8551, Type: "tcp"},
+				// Only TCP ports are tunneled
+				{PrivatePort: 30303, PublicPort: 32769, Type: "udp"},
+			},
+		},
+	}
+
+	require.Equal(t, map[uint16]bool{9710: true, 9711: true, 32768: true}, getPublishedTcpPorts(containers))
+}
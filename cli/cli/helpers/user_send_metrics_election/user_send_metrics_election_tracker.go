@@ -69,6 +69,8 @@ func SendAnyBackloggedUserMetricsElectionEvent() error {
 				metrics_client.IsCI(),
 				maybeCloudUserID,
 				maybeCloudInstanceID,
+				// The election is made while the config is being initialized, before the user can set a metrics sink in it
+				metrics_client.NewDefaultSinkConfig(),
 			),
 		)
 		if err != nil {
@@ -6,10 +6,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_liveness_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"sort"
 	"strings"
 )
@@ -94,7 +94,7 @@ func GetUserServicePortBindingStrings(userService *kurtosis_core_rpc_api_binding
 	return result, nil
 }
 
-func GetUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, filterServiceIdentifiers map[string]bool) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+func GetUserServiceInfoMapFromAPIContainer(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, filterServiceIdentifiers map[string]bool) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	apicHostMachineIp, apicHostMachineGrpcPort, err := enclave_liveness_validator.ValidateEnclaveLiveness(enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred verifying that the enclave was running")
@@ -105,7 +105,13 @@ func GetUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kur
 		apicHostMachineIp,
 		apicHostMachineGrpcPort,
 	)
-	conn, err := grpc.Dial(apiContainerHostGrpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	transportCredentials, err := kurtosisCtx.GetApiContainerTransportCredentials(ctx, enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the credentials of the API container of enclave '%v'", enclaveInfo.EnclaveUuid)
+	}
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}, kurtosis_context.GetTracingDialOptions()...)
+	dialOptions = append(dialOptions, kurtosis_context.GetCompressionDialOptions()...)
+	conn, err := grpc.Dial(apiContainerHostGrpcUrl, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
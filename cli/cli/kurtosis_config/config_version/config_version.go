@@ -12,4 +12,5 @@ const (
 	ConfigVersion_v4 // adds engine-node-name to KubernetesClusterConfig
 	ConfigVersion_v5 // adds GrafanaLokiConfig to KurtosisClusterConfig
 	ConfigVersion_v6 // adds logs collector config
+	ConfigVersion_v7 // adds artifacts store config
 )
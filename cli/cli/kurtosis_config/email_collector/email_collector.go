@@ -57,7 +57,9 @@ func logUserEmailAddressAsMetric(userEmail string) {
 			analytics_logger.ConvertLogrusLoggerToAnalyticsLogger(logger),
 			metrics_client.IsCI(),
 			maybeCloudUserID,
-			maybeCloudInstanceID),
+			maybeCloudInstanceID,
+			// Like the cluster, the user can't have configured a metrics sink before the first install
+			metrics_client.NewDefaultSinkConfig()),
 	)
 	if err != nil {
 		logrus.Debugf("tried creating a metrics client but failed with error:\n%v", err)
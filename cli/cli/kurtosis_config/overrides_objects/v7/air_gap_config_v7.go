@@ -0,0 +1,19 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// AirGapConfigV7 is where the images and the packages are resolved from when the cluster can't reach docker.io nor
+// github.com
+type AirGapConfigV7 struct {
+	// The repository the images are pulled from, e.g. 'registry.example.com/kurtosis'
+	ImageMirror *string `yaml:"image-mirror,omitempty"`
+	// The git server the packages are cloned from, e.g. 'https://git.example.com/kurtosis'
+	PackageMirror *string `yaml:"package-mirror,omitempty"`
+}
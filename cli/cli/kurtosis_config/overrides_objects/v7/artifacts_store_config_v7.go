@@ -0,0 +1,27 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// ArtifactsStoreConfigV7 is the configuration of the store holding the content of files artifacts.
+// By default, files artifacts live on the enclave data volume of each API container; setting a remote store (s3 or gcs)
+// mirrors them to a bucket so they survive the loss of that volume.
+type ArtifactsStoreConfigV7 struct {
+	// One of 'local', 's3' or 'gcs'
+	Type            string `yaml:"type,omitempty"`
+	Bucket          string `yaml:"bucket,omitempty"`
+	Prefix          string `yaml:"prefix,omitempty"`
+	Region          string `yaml:"region,omitempty"`
+	Endpoint        string `yaml:"endpoint,omitempty"`
+	AccessKeyID     string `yaml:"access-key-id,omitempty"`
+	SecretAccessKey string `yaml:"secret-access-key,omitempty"`
+	UsePathStyle    bool   `yaml:"use-path-style,omitempty"`
+	// Total size, in bytes, that the files artifacts of a single enclave can take up; unlimited if unset
+	MaxBytesPerEnclave uint64 `yaml:"max-bytes-per-enclave,omitempty"`
+}
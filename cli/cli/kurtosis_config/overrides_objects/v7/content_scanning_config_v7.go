@@ -0,0 +1,22 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// ContentScanningConfigV7 is the hook the files artifacts uploaded to the enclaves are scanned with before being stored;
+// either a webhook or a command, not both
+type ContentScanningConfigV7 struct {
+	// POSTed the gzipped tarball of the artifact; any non-2xx response rejects the artifact
+	Webhook *string `yaml:"webhook,omitempty"`
+	// Run in the API container with the path of the gzipped tarball of the artifact as last argument; a non-zero exit
+	// code rejects the artifact
+	Exec []string `yaml:"exec,omitempty"`
+	// How long a scan can take before the artifact is rejected (default: 60)
+	TimeoutSeconds *uint32 `yaml:"timeout-seconds,omitempty"`
+}
@@ -0,0 +1,27 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// EnclaveManagerAuthConfigV7 restricts who can use the enclave manager UI served by 'kurtosis web'. By default, anyone
+// who can reach the enclave manager ports has full control of the engine; once users or an OIDC provider are
+// configured, the UI asks to log in.
+type EnclaveManagerAuthConfigV7 struct {
+	Users []EnclaveManagerUserConfigV7 `yaml:"users,omitempty"`
+	// Lets users log in with an ID token issued by this provider
+	Oidc *OidcConfigV7 `yaml:"oidc,omitempty"`
+}
+
+type EnclaveManagerUserConfigV7 struct {
+	Username string `yaml:"username,omitempty"`
+	// bcrypt hash of the password, e.g. the output of 'htpasswd -nbBC 10 "" <password> | tr -d ":\n"'
+	PasswordBcrypt string `yaml:"password-bcrypt,omitempty"`
+	// Any of 'read', 'write' and 'admin'
+	Scopes []string `yaml:"scopes,omitempty"`
+}
@@ -0,0 +1,18 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// EnclaveQuotaConfigV7 caps the resources the services of each enclave can claim; every limit left unset is unlimited.
+// Once CPU or memory is capped, every service has to set the corresponding max_cpu or max_memory.
+type EnclaveQuotaConfigV7 struct {
+	MaxServices        uint32 `yaml:"max-services,omitempty"`
+	MaxCpuMilliCores   uint64 `yaml:"max-cpu-millicores,omitempty"`
+	MaxMemoryMegabytes uint64 `yaml:"max-memory-megabytes,omitempty"`
+}
@@ -0,0 +1,36 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// EngineAuthConfigV7 restricts who can call the engine gRPC and REST APIs. By default, anyone who can reach the engine
+// ports can use it; once static tokens or an OIDC provider are configured, every call needs a bearer token.
+type EngineAuthConfigV7 struct {
+	StaticTokens []StaticTokenConfigV7 `yaml:"static-tokens,omitempty"`
+	Oidc         *OidcConfigV7         `yaml:"oidc,omitempty"`
+}
+
+type StaticTokenConfigV7 struct {
+	Name string `yaml:"name,omitempty"`
+	// Hex-encoded SHA-256 of the token, e.g. the output of 'echo -n <token> | sha256sum'
+	TokenSha256 string `yaml:"token-sha256,omitempty"`
+	// Any of 'read', 'write' and 'admin'
+	Scopes []string `yaml:"scopes,omitempty"`
+}
+
+type OidcConfigV7 struct {
+	IssuerUrl string `yaml:"issuer-url,omitempty"`
+	Audience  string `yaml:"audience,omitempty"`
+	// Claim listing the engine scopes granted by the token; defaults to 'scope'
+	ScopesClaim string `yaml:"scopes-claim,omitempty"`
+	// Claim listing the groups of the user at the provider; defaults to 'groups'
+	GroupsClaim string `yaml:"groups-claim,omitempty"`
+	// The engine scopes granted to the members of each group, e.g. 'platform-team: [admin]'
+	GroupScopes map[string][]string `yaml:"group-scopes,omitempty"`
+}
@@ -0,0 +1,25 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// ImageCacheConfigV7 is the configuration of the cache of the images built and pulled by the enclaves.
+// By default, images built from unchanged content are reused on the same container engine; setting a registry cache
+// also pushes built and pulled images to a repository so that other container engines (e.g. CI runners) can reuse them.
+type ImageCacheConfigV7 struct {
+	// One of 'local', 'registry' or 'none'
+	Type       string `yaml:"type,omitempty"`
+	Repository string `yaml:"repository,omitempty"`
+	Username   string `yaml:"username,omitempty"`
+	Password   string `yaml:"password,omitempty"`
+	// Registries, e.g. 'docker.io', mapped to the pull-through cache proxying them, e.g. 'harbor.example.com/dockerhub-proxy'
+	PullThroughCaches map[string]string `yaml:"pull-through-caches,omitempty"`
+	// How many distinct images an enclave pulls at once
+	MaxConcurrentPulls uint16 `yaml:"max-concurrent-pulls,omitempty"`
+}
@@ -0,0 +1,28 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// ImageVerificationConfigV7 is who the images of the plans must be signed by, with cosign, to be started
+type ImageVerificationConfigV7 struct {
+	// An image must be signed with the key of one of them
+	AllowedSigners []AllowedSignerConfigV7 `yaml:"allowed-signers,omitempty"`
+	// Predicate types of the attestations an image must carry, e.g. 'https://slsa.dev/provenance/v1'
+	RequiredAttestations []string `yaml:"required-attestations,omitempty"`
+	// Lets the plans build images, which can't be signed (default: false)
+	AllowLocallyBuiltImages *bool `yaml:"allow-locally-built-images,omitempty"`
+}
+
+type AllowedSignerConfigV7 struct {
+	Name string `yaml:"name,omitempty"`
+	// The PEM-encoded public key of the signer, either inline or in a file such as the 'cosign.pub' written by
+	// 'cosign generate-key-pair'
+	PublicKey     string `yaml:"public-key,omitempty"`
+	PublicKeyFile string `yaml:"public-key-file,omitempty"`
+}
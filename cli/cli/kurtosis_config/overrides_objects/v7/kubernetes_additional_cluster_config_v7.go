@@ -0,0 +1,16 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// KubernetesAdditionalClusterConfigV7 is another Kubernetes cluster the engine can create enclaves in, reached through
+// a context of the local kubeconfig whose credentials get handed to the engine
+type KubernetesAdditionalClusterConfigV7 struct {
+	KubernetesContext *string `yaml:"kubernetes-context,omitempty"`
+}
@@ -0,0 +1,44 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type KubernetesClusterConfigV7 struct {
+	KubernetesClusterName  *string `yaml:"kubernetes-cluster-name,omitempty"`
+	StorageClass           *string `yaml:"storage-class,omitempty"`
+	EnclaveSizeInMegabytes *uint   `yaml:"enclave-size-in-megabytes,omitempty"`
+	EngineNodeName         *string `yaml:"engine-node-name,omitempty"`
+
+	// EngineReplicas is the number of engine replicas to run; the replicas elect a leader and share their state so that
+	// losing one of them doesn't interrupt the clients. Defaults to 1 if omitted.
+	EngineReplicas *int32 `yaml:"engine-replicas,omitempty"`
+
+	// ClientQPS and ClientBurst set the client-side rate limits of the requests sent to the Kubernetes API server by the
+	// CLI, the engine and the API containers; the client-go defaults are used if omitted
+	ClientQPS   *float32 `yaml:"client-qps,omitempty"`
+	ClientBurst *int     `yaml:"client-burst,omitempty"`
+
+	// ClientMaxRetries is how many times a request throttled by the Kubernetes API server or rejected because of a
+	// conflict gets retried with exponential backoff; 0 disables the retries. Defaults to 5 if omitted.
+	ClientMaxRetries *int `yaml:"client-max-retries,omitempty"`
+
+	// ObjectLabels and ObjectAnnotations get added to every object Kurtosis creates in the cluster, including the logs
+	// aggregator and collector ones, e.g. to enforce the labeling conventions of an organization; their keys can't be
+	// under the kurtosistech.com domain
+	ObjectLabels      map[string]string `yaml:"object-labels,omitempty"`
+	ObjectAnnotations map[string]string `yaml:"object-annotations,omitempty"`
+
+	// PodSecurityStandard is the Kubernetes Pod Security Standard profile, 'privileged' or 'restricted', that
+	// everything Kurtosis creates in the cluster complies with; the default is 'privileged'
+	PodSecurityStandard *string `yaml:"pod-security-standard,omitempty"`
+
+	// AdditionalClusters are other clusters the engine can create enclaves in, keyed by the name used to pick one of
+	// them when creating an enclave; the cluster the engine runs in goes by the Kubernetes cluster name
+	AdditionalClusters map[string]*KubernetesAdditionalClusterConfigV7 `yaml:"additional-clusters,omitempty"`
+}
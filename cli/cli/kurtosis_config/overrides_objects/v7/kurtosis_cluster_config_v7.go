@@ -0,0 +1,68 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type KurtosisClusterConfigV7 struct {
+	Type *string `yaml:"type,omitempty"`
+	// If we ever get another type of cluster that has configuration, this will need to be polymorphically deserialized
+	Config             *KubernetesClusterConfigV7  `yaml:"config,omitempty"`
+	LogsAggregator     *LogsAggregatorConfigV7     `yaml:"logs-aggregator,omitempty"`
+	LogsCollector      *LogsCollectorConfigV7      `yaml:"logs-collector,omitempty"`
+	GrafanaLokiConfig  *GrafanaLokiConfigV7        `yaml:"grafana-loki,omitempty"`
+	ArtifactsStore     *ArtifactsStoreConfigV7     `yaml:"artifacts-store,omitempty"`
+	ImageCache         *ImageCacheConfigV7         `yaml:"image-cache,omitempty"`
+	EngineAuth         *EngineAuthConfigV7         `yaml:"engine-auth,omitempty"`
+	EnclaveManagerAuth *EnclaveManagerAuthConfigV7 `yaml:"enclave-manager-auth,omitempty"`
+	EnclaveQuota       *EnclaveQuotaConfigV7       `yaml:"enclave-quota,omitempty"`
+	StateStore         *StateStoreConfigV7         `yaml:"state-store,omitempty"`
+	LogStreaming       *LogStreamingConfigV7       `yaml:"log-streaming,omitempty"`
+
+	// DefaultEnclaveTtl is how long enclaves created without a TTL live before the engine destroys them, e.g. '4h'.
+	// Enclaves don't expire if omitted.
+	DefaultEnclaveTtl *string `yaml:"default-enclave-ttl,omitempty"`
+
+	// EnclavePoolSize is the number of idle enclaves the engine keeps ready to be handed out by 'kurtosis enclave add',
+	// used when the engine is started without the '--enclave-pool-size' flag. On Docker, the engine only pre-pulls the
+	// API container image instead.
+	EnclavePoolSize *uint8 `yaml:"enclave-pool-size,omitempty"`
+
+	// ShouldEnableDefaultLogsSink controls use of PersistentVolumeLogsDB (default: true) as the storage location for logs.
+	// Useful for saving storage when using custom or Grafana Loki-based logging.
+	ShouldEnableDefaultLogsSink *bool `yaml:"should-enable-default-logs-sink,omitempty"`
+
+	// ApiContainerMtls makes the API containers only accept the calls authenticated with a certificate the engine issued
+	// for their enclave (default: false). Clients older than the engine can't call API containers that require it.
+	ApiContainerMtls *bool `yaml:"api-container-mtls,omitempty"`
+
+	// SecretsProvider is where the secrets that Starlark references with kurtosis.secret are read from. The secrets
+	// are resolved when the services are started, so they never appear in the plans nor in the enclave dumps.
+	SecretsProvider *SecretsProviderConfigV7 `yaml:"secrets-provider,omitempty"`
+
+	// ImageVerification makes the enclaves only start the images signed by one of the allowed signers, rejecting the
+	// plans using any other image before anything is started
+	ImageVerification *ImageVerificationConfigV7 `yaml:"image-verification,omitempty"`
+
+	// AirGap makes the engine and the enclaves resolve the images and the packages only from internal mirrors, never
+	// reaching docker.io nor github.com. 'kurtosis airgap export' exports what the mirrors need.
+	AirGap *AirGapConfigV7 `yaml:"air-gap,omitempty"`
+
+	// LogsEncryption makes the logs aggregator encrypt the logs it stores on the logs volume, so that the output of the
+	// services isn't stored in plaintext. The engine decrypts them when they're streamed.
+	LogsEncryption *LogsEncryptionConfigV7 `yaml:"logs-encryption,omitempty"`
+
+	// ContentScanning makes the enclaves scan the files artifacts uploaded to them with a webhook or a command before
+	// storing them, refusing the artifacts the scanner rejects
+	ContentScanning *ContentScanningConfigV7 `yaml:"content-scanning,omitempty"`
+
+	// FipsMode restricts the TLS connections of the engine, of the API containers and of the SDKs calling them to the
+	// FIPS-approved cipher suites (default: false). It requires api-container-mtls; 'kurtosis doctor' verifies that
+	// the engine runs in FIPS mode and with validated crypto.
+	FipsMode *bool `yaml:"fips-mode,omitempty"`
+}
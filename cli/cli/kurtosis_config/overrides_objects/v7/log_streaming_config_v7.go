@@ -0,0 +1,19 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// LogStreamingConfigV7 bounds the log lines the engine buffers for each service logs stream, so that clients that can't
+// keep up with high-volume services don't grow the engine memory.
+type LogStreamingConfigV7 struct {
+	// The maximum number of log lines buffered for each stream; 10000 if omitted
+	BufferSize *uint32 `yaml:"buffer-size,omitempty"`
+	// One of 'block', 'drop-oldest' or 'disconnect'; 'block' if omitted
+	SlowConsumerPolicy *string `yaml:"slow-consumer-policy,omitempty"`
+}
@@ -0,0 +1,53 @@
+package v7
+
+import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// LogsCollectorConfigV7 is the configuration for the logs collector.
+// Kurtosis leverages a logs collector and logs aggregator to collect, aggregate, and logs from services in enclaves.
+// The logs collector picks up logs from services in enclaves and sends them to the logs aggregator.
+type LogsCollectorConfigV7 struct {
+	Parsers []logs_collector.Parser `yaml:"parsers,omitempty"`
+	Filters []logs_collector.Filter `yaml:"filters,omitempty"`
+
+	// If set, the logs collectors also collect the logs of the host services of the Kubernetes nodes
+	SystemLogs *SystemLogsConfigV7 `yaml:"system-logs,omitempty"`
+
+	// The metadata of the pods of the services attached to their log lines on Kubernetes
+	KubernetesMetadata *KubernetesMetadataConfigV7 `yaml:"kubernetes-metadata,omitempty"`
+
+	// How the logs collectors buffer the records they collect
+	Buffer *BufferConfigV7 `yaml:"buffer,omitempty"`
+}
+
+type SystemLogsConfigV7 struct {
+	// The systemd units whose logs are collected; the kubelet and the container runtime if empty
+	SystemdUnits []string `yaml:"systemd-units,omitempty"`
+}
+
+type KubernetesMetadataConfigV7 struct {
+	// The metadata fields attached, e.g. 'host' for the name of the node of the pod
+	Fields []string `yaml:"fields,omitempty"`
+
+	// The keys of the labels of the pods attached
+	Labels []string `yaml:"labels,omitempty"`
+}
+
+type BufferConfigV7 struct {
+	// The memory the records of an input can take up before it's paused, e.g. '50MB'
+	MemBufLimit string `yaml:"mem-buf-limit,omitempty"`
+
+	// Where the records are buffered, 'memory' or 'filesystem'
+	StorageType string `yaml:"storage-type,omitempty"`
+
+	// How many chunks of records buffered on the filesystem can be in memory at once
+	MaxChunksUp uint32 `yaml:"max-chunks-up,omitempty"`
+}
@@ -0,0 +1,23 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// LogsEncryptionConfigV7 is the key the logs aggregator encrypts the logs it stores with. The key is given with a file
+// on Docker and with a Secret on Kubernetes.
+type LogsEncryptionConfigV7 struct {
+	// The file on the machine of the CLI holding the base64-encoded 32-byte key, only supported on Docker
+	KeyFile *string `yaml:"key-file,omitempty"`
+	// The Kubernetes Secret holding the base64-encoded 32-byte key, only supported on Kubernetes
+	KeySecretName *string `yaml:"key-secret-name,omitempty"`
+	// The namespace of the Secret
+	KeySecretNamespace *string `yaml:"key-secret-namespace,omitempty"`
+	// The key of the Secret data holding the key, 'key' if omitted
+	KeySecretKey *string `yaml:"key-secret-key,omitempty"`
+}
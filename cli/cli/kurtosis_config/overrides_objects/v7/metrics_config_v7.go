@@ -0,0 +1,22 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// MetricsConfigV7 is where the product metrics are sent and which of them are, when 'should-send-metrics' is true.
+// By default, all of them are sent to Kurtosis.
+type MetricsConfigV7 struct {
+	// Base URL of a collector implementing the Segment HTTP tracking API, e.g. a self-hosted RudderStack, to send the
+	// metrics to instead of Kurtosis
+	Endpoint *string `yaml:"endpoint,omitempty"`
+	// Write key of the source in that collector
+	WriteKey *string `yaml:"write-key,omitempty"`
+	// Any of 'install', 'enclave', 'run' and 'service'
+	DisabledCategories []string `yaml:"disabled-categories,omitempty"`
+}
@@ -0,0 +1,26 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// SecretsProviderConfigV7 is where the secrets that Starlark references with kurtosis.secret are read from.
+// Without it, the packages referencing a secret fail to run.
+type SecretsProviderConfigV7 struct {
+	// One of 'none', 'kubernetes', 'vault' or 'env-file'
+	Type string `yaml:"type,omitempty"`
+	// Namespace holding the Kubernetes Secrets, for the 'kubernetes' type
+	Namespace string `yaml:"namespace,omitempty"`
+	// Address and token of the Vault server, and path its KV version 2 secrets engine is mounted at (default: 'secret'),
+	// for the 'vault' type
+	VaultAddress string `yaml:"vault-address,omitempty"`
+	VaultToken   string `yaml:"vault-token,omitempty"`
+	VaultMount   string `yaml:"vault-mount,omitempty"`
+	// Path of a file of KEY=VALUE lines, for the 'env-file' type. It's read when the engine starts
+	EnvFile string `yaml:"env-file,omitempty"`
+}
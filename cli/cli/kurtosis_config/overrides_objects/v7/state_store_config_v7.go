@@ -0,0 +1,20 @@
+package v7
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// StateStoreConfigV7 is where the engine keeps the state that must survive its restarts, e.g. who owns each enclave.
+// By default it's a file on Docker and a ConfigMap on Kubernetes; engines managing hundreds of enclaves can keep it in a
+// SQLite (Docker only) or Postgres database instead.
+type StateStoreConfigV7 struct {
+	// One of 'sqlite' or 'postgres'
+	Type string `yaml:"type,omitempty"`
+	// For SQLite, the path of the database file in the engine container. For Postgres, the connection URL
+	Url string `yaml:"url,omitempty"`
+}
@@ -2,17 +2,31 @@ package resolved_config
 
 import (
 	"context"
+	"os"
 	"strings"
 
-	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	"github.com/joho/godotenv"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/ssh_tunnel_manager"
+	v7 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v7"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/multi_cluster"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
 )
@@ -21,8 +35,20 @@ const (
 	defaultKubernetesEnclaveDataVolumeSizeInMegabytes = uint(1024)
 	// this will schedule engine on node selected by k8s scheduler
 	defaultEngineNodeName = ""
+	defaultEngineReplicas = int32(1)
+
+	// No enclave pool
+	defaultEnclavePoolSize = uint8(0)
+
+	// The SDKs that predate it call the API containers without TLS
+	defaultShouldRequireApiContainerMtls = false
+
+	defaultIsFipsModeEnabled = false
 )
 
+// The host services whose logs are collected when the system logs are enabled without naming any
+var defaultSystemdUnits = []string{"kubelet.service", "containerd.service"}
+
 type kurtosisBackendSupplier func(ctx context.Context) (backend_interface.KurtosisBackend, error)
 
 type KurtosisClusterConfig struct {
@@ -32,7 +58,37 @@ type KurtosisClusterConfig struct {
 	logsAggregator              LogsAggregatorConfig
 	logsCollector               LogsCollectorConfig
 	graflokiConfig              GrafanaLokiConfig
+	artifactsStoreConfig        artifacts_store.ArtifactsStoreConfig
+	imageCacheConfig            image_cache.ImageCacheConfig
+	engineAuthConfig            args.EngineAuthConfig
+	enclaveManagerAuthConfig    args.EnclaveManagerAuthConfig
+	enclaveQuota                enclave_quota.EnclaveQuota
+	stateStoreConfig            args.StateStoreConfig
+	logStreamingConfig          args.LogStreamingConfig
+	defaultEnclaveTtl           string
+	engineReplicas              int32
+	enclavePoolSize             uint8
 	shouldEnableDefaultLogsSink bool
+
+	shouldRequireApiContainerMtls bool
+
+	secretsProviderConfig secrets_provider.SecretsProviderConfig
+	// Empty unless the secrets are read from an env file, which is read when the engine starts rather than every time
+	// the config is loaded
+	secretsEnvFilepath string
+
+	imageVerificationConfig image_verification.ImageVerificationConfig
+
+	airGapConfig air_gap.AirGapConfig
+
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig
+
+	contentScanningConfig content_scanning.ContentScanningConfig
+
+	isFipsModeEnabled bool
+
+	// Empty if the cluster isn't a Kubernetes cluster
+	kubernetesStorageClass string
 }
 
 type LogsAggregatorConfig struct {
@@ -42,6 +98,12 @@ type LogsAggregatorConfig struct {
 type LogsCollectorConfig struct {
 	Filters []logs_collector.Filter
 	Parsers []logs_collector.Parser
+
+	SystemLogs logs_collector.SystemLogsConfig
+
+	KubernetesMetadata logs_collector.KubernetesMetadataConfig
+
+	Buffer logs_collector.BufferConfig
 }
 
 type GrafanaLokiConfig struct {
@@ -50,7 +112,7 @@ type GrafanaLokiConfig struct {
 	LokiImage               string
 }
 
-func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v6.KurtosisClusterConfigV6) (*KurtosisClusterConfig, error) {
+func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v7.KurtosisClusterConfigV7) (*KurtosisClusterConfig, error) {
 	if overrides.Type == nil {
 		return nil, stacktrace.NewError("Kurtosis cluster must have a defined type")
 	}
@@ -66,7 +128,28 @@ func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v6.Kurto
 		)
 	}
 
-	backendSupplier, engineBackendConfigSupplier, err := getSuppliers(clusterId, clusterType, overrides.Config)
+	engineReplicas := defaultEngineReplicas
+	if overrides.Config != nil && overrides.Config.EngineReplicas != nil {
+		engineReplicas = *overrides.Config.EngineReplicas
+		if engineReplicas < 1 {
+			return nil, stacktrace.NewError("Cluster '%v' has '%v' engine replicas but it needs at least one", clusterId, engineReplicas)
+		}
+	}
+
+	airGapConfig := air_gap.NewDisabledAirGapConfig()
+	if overrides.AirGap != nil {
+		if overrides.AirGap.ImageMirror != nil {
+			airGapConfig.ImageMirror = *overrides.AirGap.ImageMirror
+		}
+		if overrides.AirGap.PackageMirror != nil {
+			airGapConfig.PackageMirror = *overrides.AirGap.PackageMirror
+		}
+		if err := airGapConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid air gap config", clusterId)
+		}
+	}
+
+	backendSupplier, engineBackendConfigSupplier, err := getSuppliers(clusterId, clusterType, overrides.Config, engineReplicas, airGapConfig)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the suppliers that cluster '%v' will use", clusterId)
 	}
@@ -90,13 +173,46 @@ func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v6.Kurto
 	}
 
 	logsCollector := LogsCollectorConfig{
-		Filters: nil,
-		Parsers: nil,
+		Filters:            nil,
+		Parsers:            nil,
+		SystemLogs:         logs_collector.NewDisabledSystemLogsConfig(),
+		KubernetesMetadata: logs_collector.NewEmptyKubernetesMetadataConfig(),
+		Buffer:             logs_collector.NewDefaultBufferConfig(),
 	}
 
 	if overrides.LogsCollector != nil {
 		logsCollector.Filters = overrides.LogsCollector.Filters
 		logsCollector.Parsers = overrides.LogsCollector.Parsers
+		if overrides.LogsCollector.SystemLogs != nil {
+			if clusterType != KurtosisClusterType_Kubernetes {
+				return nil, stacktrace.NewError("Cluster '%v' collects the system logs of the nodes, which is only supported on Kubernetes", clusterId)
+			}
+			logsCollector.SystemLogs.SystemdUnits = defaultSystemdUnits
+			if len(overrides.LogsCollector.SystemLogs.SystemdUnits) > 0 {
+				logsCollector.SystemLogs.SystemdUnits = overrides.LogsCollector.SystemLogs.SystemdUnits
+			}
+			if err := logsCollector.SystemLogs.Validate(); err != nil {
+				return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid system logs config", clusterId)
+			}
+		}
+		if overrides.LogsCollector.KubernetesMetadata != nil {
+			if clusterType != KurtosisClusterType_Kubernetes {
+				return nil, stacktrace.NewError("Cluster '%v' attaches Kubernetes metadata to the logs, which is only supported on Kubernetes", clusterId)
+			}
+			logsCollector.KubernetesMetadata.Fields = overrides.LogsCollector.KubernetesMetadata.Fields
+			logsCollector.KubernetesMetadata.Labels = overrides.LogsCollector.KubernetesMetadata.Labels
+			if err := logsCollector.KubernetesMetadata.Validate(); err != nil {
+				return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid Kubernetes metadata config", clusterId)
+			}
+		}
+		if overrides.LogsCollector.Buffer != nil {
+			logsCollector.Buffer.MemBufLimit = overrides.LogsCollector.Buffer.MemBufLimit
+			logsCollector.Buffer.StorageType = overrides.LogsCollector.Buffer.StorageType
+			logsCollector.Buffer.MaxChunksUp = overrides.LogsCollector.Buffer.MaxChunksUp
+			if err := logsCollector.Buffer.Validate(); err != nil {
+				return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid logs collector buffer config", clusterId)
+			}
+		}
 	}
 
 	var grafloki GrafanaLokiConfig
@@ -108,19 +224,262 @@ func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v6.Kurto
 		}
 	}
 
+	artifactsStoreConfig := artifacts_store.NewLocalArtifactsStoreConfig()
+	if overrides.ArtifactsStore != nil {
+		artifactsStoreConfig = artifacts_store.ArtifactsStoreConfig{
+			Type:               artifacts_store.ArtifactsStoreType(overrides.ArtifactsStore.Type),
+			Bucket:             overrides.ArtifactsStore.Bucket,
+			Prefix:             overrides.ArtifactsStore.Prefix,
+			Region:             overrides.ArtifactsStore.Region,
+			Endpoint:           overrides.ArtifactsStore.Endpoint,
+			AccessKeyID:        overrides.ArtifactsStore.AccessKeyID,
+			SecretAccessKey:    overrides.ArtifactsStore.SecretAccessKey,
+			UsePathStyle:       overrides.ArtifactsStore.UsePathStyle,
+			MaxBytesPerEnclave: overrides.ArtifactsStore.MaxBytesPerEnclave,
+		}
+		if err := artifactsStoreConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid artifacts store config", clusterId)
+		}
+	}
+
+	imageCacheConfig := image_cache.NewLocalImageCacheConfig()
+	if overrides.ImageCache != nil {
+		imageCacheConfig = image_cache.ImageCacheConfig{
+			Type:               image_cache.ImageCacheType(overrides.ImageCache.Type),
+			Repository:         overrides.ImageCache.Repository,
+			Username:           overrides.ImageCache.Username,
+			Password:           overrides.ImageCache.Password,
+			PullThroughCaches:  overrides.ImageCache.PullThroughCaches,
+			MaxConcurrentPulls: overrides.ImageCache.MaxConcurrentPulls,
+		}
+		if err := imageCacheConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid image cache config", clusterId)
+		}
+	}
+
+	engineAuthConfig := args.NewDisabledEngineAuthConfig()
+	if overrides.EngineAuth != nil {
+		for _, staticToken := range overrides.EngineAuth.StaticTokens {
+			engineAuthConfig.StaticTokens = append(engineAuthConfig.StaticTokens, args.StaticTokenConfig{
+				Name:        staticToken.Name,
+				TokenSha256: staticToken.TokenSha256,
+				Scopes:      staticToken.Scopes,
+			})
+		}
+		if overrides.EngineAuth.Oidc != nil {
+			engineAuthConfig.Oidc = &args.OidcConfig{
+				IssuerUrl:   overrides.EngineAuth.Oidc.IssuerUrl,
+				Audience:    overrides.EngineAuth.Oidc.Audience,
+				ScopesClaim: overrides.EngineAuth.Oidc.ScopesClaim,
+				GroupsClaim: overrides.EngineAuth.Oidc.GroupsClaim,
+				GroupScopes: overrides.EngineAuth.Oidc.GroupScopes,
+			}
+		}
+		if err := engineAuthConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid engine auth config", clusterId)
+		}
+	}
+
+	enclaveManagerAuthConfig := args.NewDisabledEnclaveManagerAuthConfig()
+	if overrides.EnclaveManagerAuth != nil {
+		for _, user := range overrides.EnclaveManagerAuth.Users {
+			enclaveManagerAuthConfig.Users = append(enclaveManagerAuthConfig.Users, args.EnclaveManagerUserConfig{
+				Username:       user.Username,
+				PasswordBcrypt: user.PasswordBcrypt,
+				Scopes:         user.Scopes,
+			})
+		}
+		if overrides.EnclaveManagerAuth.Oidc != nil {
+			enclaveManagerAuthConfig.Oidc = &args.OidcConfig{
+				IssuerUrl:   overrides.EnclaveManagerAuth.Oidc.IssuerUrl,
+				Audience:    overrides.EnclaveManagerAuth.Oidc.Audience,
+				ScopesClaim: overrides.EnclaveManagerAuth.Oidc.ScopesClaim,
+				GroupsClaim: overrides.EnclaveManagerAuth.Oidc.GroupsClaim,
+				GroupScopes: overrides.EnclaveManagerAuth.Oidc.GroupScopes,
+			}
+		}
+		if err := enclaveManagerAuthConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid enclave manager auth config", clusterId)
+		}
+	}
+
+	enclaveQuota := enclave_quota.NewUnlimitedEnclaveQuota()
+	if overrides.EnclaveQuota != nil {
+		enclaveQuota = enclave_quota.EnclaveQuota{
+			MaxServices:        overrides.EnclaveQuota.MaxServices,
+			MaxCpuMilliCores:   overrides.EnclaveQuota.MaxCpuMilliCores,
+			MaxMemoryMegabytes: overrides.EnclaveQuota.MaxMemoryMegabytes,
+		}
+	}
+
+	stateStoreConfig := args.NewDefaultStateStoreConfig()
+	if overrides.StateStore != nil {
+		stateStoreConfig = args.StateStoreConfig{
+			Type: args.StateStoreType(overrides.StateStore.Type),
+			Url:  overrides.StateStore.Url,
+		}
+		if err := stateStoreConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid state store config", clusterId)
+		}
+		if stateStoreConfig.Type == args.StateStoreType_Sqlite && clusterType != KurtosisClusterType_Docker {
+			return nil, stacktrace.NewError("Cluster '%v' keeps the engine state in SQLite, which is only supported on Docker; use Postgres instead", clusterId)
+		}
+	}
+
+	logStreamingConfig := args.NewDefaultLogStreamingConfig()
+	if overrides.LogStreaming != nil {
+		if overrides.LogStreaming.BufferSize != nil {
+			logStreamingConfig.BufferSize = *overrides.LogStreaming.BufferSize
+		}
+		if overrides.LogStreaming.SlowConsumerPolicy != nil {
+			logStreamingConfig.SlowConsumerPolicy = args.SlowLogConsumerPolicy(*overrides.LogStreaming.SlowConsumerPolicy)
+		}
+		if err := logStreamingConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid log streaming config", clusterId)
+		}
+	}
+
+	defaultEnclaveTtl := ""
+	if overrides.DefaultEnclaveTtl != nil {
+		if _, err := args.ParseEnclaveTtl(*overrides.DefaultEnclaveTtl); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid default enclave TTL", clusterId)
+		}
+		defaultEnclaveTtl = *overrides.DefaultEnclaveTtl
+	}
+
+	kubernetesStorageClass := ""
+	if clusterType == KurtosisClusterType_Kubernetes && overrides.Config != nil && overrides.Config.StorageClass != nil {
+		kubernetesStorageClass = *overrides.Config.StorageClass
+	}
+
+	enclavePoolSize := defaultEnclavePoolSize
+	if overrides.EnclavePoolSize != nil {
+		enclavePoolSize = *overrides.EnclavePoolSize
+		// The enclave pool destroys the idle enclaves it finds when it starts, so the replicas of an engine would
+		// destroy the ones of each other
+		if enclavePoolSize > 0 && engineReplicas > 1 {
+			return nil, stacktrace.NewError("Cluster '%v' has an enclave pool of size '%v' but it can't be used with '%v' engine replicas", clusterId, enclavePoolSize, engineReplicas)
+		}
+	}
+
 	shouldEnableDefaultLogsSink := DefaultShouldEnableDefaultLogsSink
 	if overrides.ShouldEnableDefaultLogsSink != nil {
 		shouldEnableDefaultLogsSink = *overrides.ShouldEnableDefaultLogsSink
 	}
 
+	shouldRequireApiContainerMtls := defaultShouldRequireApiContainerMtls
+	if overrides.ApiContainerMtls != nil {
+		shouldRequireApiContainerMtls = *overrides.ApiContainerMtls
+	}
+
+	secretsProviderConfig := secrets_provider.NewNoSecretsProviderConfig()
+	secretsEnvFilepath := ""
+	if overrides.SecretsProvider != nil {
+		secretsProviderConfig = secrets_provider.SecretsProviderConfig{
+			Type:           secrets_provider.SecretsProviderType(overrides.SecretsProvider.Type),
+			Namespace:      overrides.SecretsProvider.Namespace,
+			VaultAddress:   overrides.SecretsProvider.VaultAddress,
+			VaultToken:     overrides.SecretsProvider.VaultToken,
+			VaultMount:     overrides.SecretsProvider.VaultMount,
+			EnvFileSecrets: nil,
+		}
+		if err := secretsProviderConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid secrets provider config", clusterId)
+		}
+		if secretsProviderConfig.Type == secrets_provider.SecretsProviderType_Kubernetes && clusterType != KurtosisClusterType_Kubernetes {
+			return nil, stacktrace.NewError("Cluster '%v' reads the secrets from Kubernetes Secrets, which is only supported on Kubernetes", clusterId)
+		}
+		if secretsProviderConfig.Type == secrets_provider.SecretsProviderType_EnvFile {
+			if strings.TrimSpace(overrides.SecretsProvider.EnvFile) == "" {
+				return nil, stacktrace.NewError("Cluster '%v' reads the secrets from an env file but doesn't set its path", clusterId)
+			}
+			secretsEnvFilepath = overrides.SecretsProvider.EnvFile
+		}
+	}
+
+	imageVerificationConfig := image_verification.NewDisabledImageVerificationConfig()
+	if overrides.ImageVerification != nil {
+		for _, signerOverrides := range overrides.ImageVerification.AllowedSigners {
+			publicKey := signerOverrides.PublicKey
+			if signerOverrides.PublicKeyFile != "" {
+				if publicKey != "" {
+					return nil, stacktrace.NewError("Allowed signer '%v' of cluster '%v' sets both a public key and a public key file", signerOverrides.Name, clusterId)
+				}
+				publicKeyBytes, err := os.ReadFile(signerOverrides.PublicKeyFile)
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred reading the public key of allowed signer '%v' of cluster '%v' from '%v'", signerOverrides.Name, clusterId, signerOverrides.PublicKeyFile)
+				}
+				publicKey = string(publicKeyBytes)
+			}
+			imageVerificationConfig.AllowedSigners = append(imageVerificationConfig.AllowedSigners, image_verification.AllowedSigner{
+				Name:      signerOverrides.Name,
+				PublicKey: publicKey,
+			})
+		}
+		imageVerificationConfig.RequiredAttestations = overrides.ImageVerification.RequiredAttestations
+		if overrides.ImageVerification.AllowLocallyBuiltImages != nil {
+			imageVerificationConfig.AllowLocallyBuiltImages = *overrides.ImageVerification.AllowLocallyBuiltImages
+		}
+		if err := imageVerificationConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid image verification config", clusterId)
+		}
+	}
+
+	logsEncryptionConfig, err := getLogsEncryptionConfig(clusterId, clusterType, overrides.LogsEncryption)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs encryption config of cluster '%v'", clusterId)
+	}
+
+	contentScanningConfig := content_scanning.NewDisabledContentScanningConfig()
+	if overrides.ContentScanning != nil {
+		if overrides.ContentScanning.Webhook != nil {
+			contentScanningConfig.WebhookUrl = *overrides.ContentScanning.Webhook
+		}
+		contentScanningConfig.ExecCommand = overrides.ContentScanning.Exec
+		if overrides.ContentScanning.TimeoutSeconds != nil {
+			contentScanningConfig.TimeoutSeconds = *overrides.ContentScanning.TimeoutSeconds
+		}
+		if err := contentScanningConfig.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid content scanning config", clusterId)
+		}
+	}
+
+	isFipsModeEnabled := defaultIsFipsModeEnabled
+	if overrides.FipsMode != nil {
+		isFipsModeEnabled = *overrides.FipsMode
+	}
+	// The SDKs only learn that they must restrict their connections with the credentials of the API containers
+	if isFipsModeEnabled && !shouldRequireApiContainerMtls {
+		return nil, stacktrace.NewError("Cluster '%v' enables fips-mode, which requires api-container-mtls to be enabled too", clusterId)
+	}
+
 	return &KurtosisClusterConfig{
-		kurtosisBackendSupplier:     backendSupplier,
-		engineBackendConfigSupplier: engineBackendConfigSupplier,
-		clusterType:                 clusterType,
-		logsAggregator:              logsAggregator,
-		logsCollector:               logsCollector,
-		graflokiConfig:              grafloki,
-		shouldEnableDefaultLogsSink: shouldEnableDefaultLogsSink,
+		kurtosisBackendSupplier:       backendSupplier,
+		engineBackendConfigSupplier:   engineBackendConfigSupplier,
+		clusterType:                   clusterType,
+		logsAggregator:                logsAggregator,
+		logsCollector:                 logsCollector,
+		graflokiConfig:                grafloki,
+		artifactsStoreConfig:          artifactsStoreConfig,
+		imageCacheConfig:              imageCacheConfig,
+		engineAuthConfig:              engineAuthConfig,
+		enclaveManagerAuthConfig:      enclaveManagerAuthConfig,
+		enclaveQuota:                  enclaveQuota,
+		stateStoreConfig:              stateStoreConfig,
+		logStreamingConfig:            logStreamingConfig,
+		defaultEnclaveTtl:             defaultEnclaveTtl,
+		engineReplicas:                engineReplicas,
+		enclavePoolSize:               enclavePoolSize,
+		shouldEnableDefaultLogsSink:   shouldEnableDefaultLogsSink,
+		shouldRequireApiContainerMtls: shouldRequireApiContainerMtls,
+		secretsProviderConfig:         secretsProviderConfig,
+		secretsEnvFilepath:            secretsEnvFilepath,
+		imageVerificationConfig:       imageVerificationConfig,
+		airGapConfig:                  airGapConfig,
+		logsEncryptionConfig:          logsEncryptionConfig,
+		contentScanningConfig:         contentScanningConfig,
+		isFipsModeEnabled:             isFipsModeEnabled,
+		kubernetesStorageClass:        kubernetesStorageClass,
 	}, nil
 }
 
@@ -152,16 +511,149 @@ func (clusterConfig *KurtosisClusterConfig) GetGraflokiConfig() GrafanaLokiConfi
 	return clusterConfig.graflokiConfig
 }
 
+func (clusterConfig *KurtosisClusterConfig) GetArtifactsStoreConfig() artifacts_store.ArtifactsStoreConfig {
+	return clusterConfig.artifactsStoreConfig
+}
+
+func (clusterConfig *KurtosisClusterConfig) GetImageCacheConfig() image_cache.ImageCacheConfig {
+	return clusterConfig.imageCacheConfig
+}
+
+func (clusterConfig *KurtosisClusterConfig) GetEngineAuthConfig() args.EngineAuthConfig {
+	return clusterConfig.engineAuthConfig
+}
+
+func (clusterConfig *KurtosisClusterConfig) GetEnclaveManagerAuthConfig() args.EnclaveManagerAuthConfig {
+	return clusterConfig.enclaveManagerAuthConfig
+}
+
+func (clusterConfig *KurtosisClusterConfig) GetEnclaveQuota() enclave_quota.EnclaveQuota {
+	return clusterConfig.enclaveQuota
+}
+
+func (clusterConfig *KurtosisClusterConfig) GetStateStoreConfig() args.StateStoreConfig {
+	return clusterConfig.stateStoreConfig
+}
+
+func (clusterConfig *KurtosisClusterConfig) GetLogStreamingConfig() args.LogStreamingConfig {
+	return clusterConfig.logStreamingConfig
+}
+
+// GetEngineReplicas returns the number of engine replicas to run, which is always 1 outside of Kubernetes
+func (clusterConfig *KurtosisClusterConfig) GetEngineReplicas() int32 {
+	return clusterConfig.engineReplicas
+}
+
+// GetEnclavePoolSize returns the size of the enclave pool of the engines started without an explicit one
+func (clusterConfig *KurtosisClusterConfig) GetEnclavePoolSize() uint8 {
+	return clusterConfig.enclavePoolSize
+}
+
+// GetDefaultEnclaveTtl returns the TTL of the enclaves created without one, or an empty string if they don't expire
+func (clusterConfig *KurtosisClusterConfig) GetDefaultEnclaveTtl() string {
+	return clusterConfig.defaultEnclaveTtl
+}
+
+// GetKubernetesStorageClass returns the storage class the enclave volumes are created with, or an empty string if the
+// cluster isn't a Kubernetes cluster
+func (clusterConfig *KurtosisClusterConfig) GetKubernetesStorageClass() string {
+	return clusterConfig.kubernetesStorageClass
+}
+
 func (clusterConfig *KurtosisClusterConfig) ShouldEnableDefaultLogsSink() bool {
 	return clusterConfig.shouldEnableDefaultLogsSink
 }
 
+// ShouldRequireApiContainerMtls returns whether the API containers only accept the calls authenticated with a
+// certificate the engine issued for their enclave
+func (clusterConfig *KurtosisClusterConfig) ShouldRequireApiContainerMtls() bool {
+	return clusterConfig.shouldRequireApiContainerMtls
+}
+
+// GetSecretsProviderConfig returns where the API containers read the secrets from, reading the env file of the
+// secrets if they come from one
+func (clusterConfig *KurtosisClusterConfig) GetSecretsProviderConfig() (secrets_provider.SecretsProviderConfig, error) {
+	secretsProviderConfig := clusterConfig.secretsProviderConfig
+	if clusterConfig.secretsEnvFilepath == "" {
+		return secretsProviderConfig, nil
+	}
+	envFileSecrets, err := godotenv.Read(clusterConfig.secretsEnvFilepath)
+	if err != nil {
+		return secrets_provider.NewNoSecretsProviderConfig(), stacktrace.Propagate(err, "An error occurred reading the secrets from env file '%v'", clusterConfig.secretsEnvFilepath)
+	}
+	secretsProviderConfig.EnvFileSecrets = envFileSecrets
+	return secretsProviderConfig, nil
+}
+
+// GetImageVerificationConfig returns who the images of the plans must be signed by to be started
+func (clusterConfig *KurtosisClusterConfig) GetImageVerificationConfig() image_verification.ImageVerificationConfig {
+	return clusterConfig.imageVerificationConfig
+}
+
+// GetAirGapConfig returns the internal mirrors the images and the packages are resolved from in air-gapped mode
+func (clusterConfig *KurtosisClusterConfig) GetAirGapConfig() air_gap.AirGapConfig {
+	return clusterConfig.airGapConfig
+}
+
+// GetLogsEncryptionConfig returns the key the logs aggregator encrypts the logs it stores with
+func (clusterConfig *KurtosisClusterConfig) GetLogsEncryptionConfig() logs_aggregator.LogsEncryptionConfig {
+	return clusterConfig.logsEncryptionConfig
+}
+
+// GetContentScanningConfig returns the hook the uploaded files artifacts are scanned with before being stored
+func (clusterConfig *KurtosisClusterConfig) GetContentScanningConfig() content_scanning.ContentScanningConfig {
+	return clusterConfig.contentScanningConfig
+}
+
+// IsFipsModeEnabled returns whether the TLS connections are restricted to the FIPS-approved parameters
+func (clusterConfig *KurtosisClusterConfig) IsFipsModeEnabled() bool {
+	return clusterConfig.isFipsModeEnabled
+}
+
 // ====================================================================================================
 //
 //	Private Helpers
 //
 // ====================================================================================================
-func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v6.KubernetesClusterConfigV6) (
+// getLogsEncryptionConfig reads the key from its file on Docker, which has no secrets store, while on Kubernetes the
+// key only ever goes from its Secret to the containers
+func getLogsEncryptionConfig(clusterId string, clusterType KurtosisClusterType, overrides *v7.LogsEncryptionConfigV7) (logs_aggregator.LogsEncryptionConfig, error) {
+	logsEncryptionConfig := logs_aggregator.NewDisabledLogsEncryptionConfig()
+	if overrides == nil {
+		return logsEncryptionConfig, nil
+	}
+	if overrides.KeyFile != nil {
+		if clusterType == KurtosisClusterType_Kubernetes {
+			return logsEncryptionConfig, stacktrace.NewError("Cluster '%v' is a Kubernetes cluster, whose logs encryption key must be given with a Secret rather than a key file", clusterId)
+		}
+		keyBytes, err := os.ReadFile(*overrides.KeyFile)
+		if err != nil {
+			return logsEncryptionConfig, stacktrace.Propagate(err, "An error occurred reading the logs encryption key of cluster '%v' from '%v'", clusterId, *overrides.KeyFile)
+		}
+		logsEncryptionConfig.Key = strings.TrimSpace(string(keyBytes))
+		if logsEncryptionConfig.Key == "" {
+			return logsEncryptionConfig, stacktrace.NewError("The logs encryption key file '%v' of cluster '%v' is empty", *overrides.KeyFile, clusterId)
+		}
+	}
+	if overrides.KeySecretName != nil {
+		if clusterType != KurtosisClusterType_Kubernetes {
+			return logsEncryptionConfig, stacktrace.NewError("Cluster '%v' reads the logs encryption key from a Kubernetes Secret, which is only supported on Kubernetes", clusterId)
+		}
+		logsEncryptionConfig.KeySecretName = *overrides.KeySecretName
+	}
+	if overrides.KeySecretNamespace != nil {
+		logsEncryptionConfig.KeySecretNamespace = *overrides.KeySecretNamespace
+	}
+	if overrides.KeySecretKey != nil {
+		logsEncryptionConfig.KeySecretKey = *overrides.KeySecretKey
+	}
+	if err := logsEncryptionConfig.Validate(); err != nil {
+		return logsEncryptionConfig, stacktrace.Propagate(err, "Cluster '%v' has an invalid logs encryption config", clusterId)
+	}
+	return logsEncryptionConfig, nil
+}
+
+func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v7.KubernetesClusterConfigV7, engineReplicas int32, airGapConfig air_gap.AirGapConfig) (
 	kurtosisBackendSupplier,
 	engine_server_launcher.KurtosisBackendConfigSupplier,
 	error,
@@ -184,12 +676,24 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred retrieving the current context")
 			}
+			if store.IsSsh(currentContext) {
+				// The connection is used for as long as the backend, i.e. until the CLI exits
+				sshConnection, err := ssh_tunnel_manager.NewSshConnection(currentContext.GetSshContextV0())
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred connecting over SSH to the remote host of context '%s'", currentContext.GetName())
+				}
+				backend, err := backend_creator.GetDockerKurtosisBackendWithClientOpts(backend_creator.NoAPIContainerModeArgs, sshConnection.GetDockerClientOpts(), airGapConfig)
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred creating the Docker Kurtosis backend of the remote host of context '%s'", currentContext.GetName())
+				}
+				return backend, nil
+			}
 			if store.IsRemote(currentContext) {
 				remoteBackendConfigMaybe = configs.NewRemoteBackendConfigFromRemoteContext(currentContext.GetRemoteContextV0())
 			}
 			// Get a local or remote docker backend based on the existence of the remote backend config.
 			// We do not pass APIC mode args since we are dealing with the engine here.
-			backend, err := backend_creator.GetDockerKurtosisBackend(backend_creator.NoAPIContainerModeArgs, remoteBackendConfigMaybe)
+			backend, err := backend_creator.GetDockerKurtosisBackend(backend_creator.NoAPIContainerModeArgs, remoteBackendConfigMaybe, airGapConfig)
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred creating the Docker Kurtosis backend")
 			}
@@ -213,8 +717,7 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			)
 		}
 
-		// TODO Use the Kubernetes cluster name when constructing the KubernetesBackend!
-		_ = *kubernetesConfig.KubernetesClusterName
+		kubernetesClusterName := *kubernetesConfig.KubernetesClusterName
 
 		if kubernetesConfig.StorageClass == nil {
 			return nil, nil, stacktrace.NewError(
@@ -235,8 +738,39 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			engineNodeName = *kubernetesConfig.EngineNodeName
 		}
 
+		clientConfig := getKubernetesClientConfig(kubernetesConfig)
+		if err := clientConfig.Validate(); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid Kubernetes client config", clusterId)
+		}
+
+		objAttrsProvider, err := object_attributes_provider.GetKubernetesObjectAttributesProviderWithMandatoryAttributes(kubernetesConfig.ObjectLabels, kubernetesConfig.ObjectAnnotations)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Cluster '%v' has invalid object labels or annotations", clusterId)
+		}
+
+		podSecurityStandard := kubernetes_manager.PodSecurityStandard_Privileged
+		if kubernetesConfig.PodSecurityStandard != nil {
+			podSecurityStandard = kubernetes_manager.PodSecurityStandard(*kubernetesConfig.PodSecurityStandard)
+		}
+		if err := podSecurityStandard.Validate(); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid Pod Security Standard", clusterId)
+		}
+
+		additionalClusterContexts, err := getAdditionalClusterContexts(kubernetesClusterName, kubernetesConfig.AdditionalClusters)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Cluster '%v' has invalid additional clusters", clusterId)
+		}
+		additionalClusterKubeconfigs := map[string]string{}
+		for additionalClusterName, kubernetesContext := range additionalClusterContexts {
+			kubeconfig, err := kubernetes_kurtosis_backend.GetFlattenedKubeconfigForContext(kubernetesContext)
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred getting the kubeconfig of additional cluster '%v' of cluster '%v'", additionalClusterName, clusterId)
+			}
+			additionalClusterKubeconfigs[additionalClusterName] = kubeconfig
+		}
+
 		backendSupplier = func(ctx context.Context) (backend_interface.KurtosisBackend, error) {
-			backend, err := kubernetes_kurtosis_backend.GetCLIBackend(ctx, *kubernetesConfig.StorageClass, engineNodeName)
+			backend, err := kubernetes_kurtosis_backend.GetCLIBackend(ctx, *kubernetesConfig.StorageClass, engineNodeName, engineReplicas, clientConfig, podSecurityStandard, airGapConfig, objAttrsProvider)
 			if err != nil {
 				return nil, stacktrace.Propagate(
 					err,
@@ -246,10 +780,39 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 					enclaveDataVolumeSizeInMb,
 				)
 			}
-			return backend, nil
+			if len(additionalClusterContexts) == 0 {
+				return backend, nil
+			}
+
+			backendsByCluster := map[string]backend_interface.KurtosisBackend{
+				kubernetesClusterName: backend,
+			}
+			for additionalClusterName, kubernetesContext := range additionalClusterContexts {
+				additionalClusterBackend, err := kubernetes_kurtosis_backend.GetCLIBackendForKubernetesContext(ctx, kubernetesContext, storageClass, engineNodeName, engineReplicas, clientConfig, podSecurityStandard, airGapConfig, objAttrsProvider)
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred getting Kurtosis Kubernetes backend for CLI from additional cluster '%v' of cluster '%v'", additionalClusterName, clusterId)
+				}
+				backendsByCluster[additionalClusterName] = additionalClusterBackend
+			}
+			multiClusterBackend, err := multi_cluster.NewMultiClusterKurtosisBackend(kubernetesClusterName, backendsByCluster)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating the multi-cluster Kurtosis backend for CLI from cluster '%v'", clusterId)
+			}
+			return multiClusterBackend, nil
 		}
 
-		engineConfigSupplier = engine_server_launcher.NewKubernetesKurtosisBackendConfigSupplier(storageClass, enclaveDataVolumeSizeInMb)
+		engineConfigSupplier = engine_server_launcher.NewKubernetesKurtosisBackendConfigSupplier(
+			storageClass,
+			enclaveDataVolumeSizeInMb,
+			clientConfig.QPS,
+			clientConfig.Burst,
+			clientConfig.MaxRetries,
+			kubernetesConfig.ObjectLabels,
+			kubernetesConfig.ObjectAnnotations,
+			string(podSecurityStandard),
+			kubernetesClusterName,
+			additionalClusterKubeconfigs,
+		)
 	default:
 		// This should never happen because we enforce this via unit tests
 		return nil, nil, stacktrace.NewError(
@@ -260,3 +823,36 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 	}
 	return backendSupplier, engineConfigSupplier, nil
 }
+
+// getAdditionalClusterContexts returns the kubeconfig context of each additional cluster keyed by its name
+func getAdditionalClusterContexts(kubernetesClusterName string, additionalClusters map[string]*v7.KubernetesAdditionalClusterConfigV7) (map[string]string, error) {
+	result := map[string]string{}
+	for additionalClusterName, additionalClusterConfig := range additionalClusters {
+		if additionalClusterName == "" {
+			return nil, stacktrace.NewError("An additional cluster has an empty name")
+		}
+		if additionalClusterName == kubernetesClusterName {
+			return nil, stacktrace.NewError("Additional cluster '%v' has the same name as the Kubernetes cluster the engine runs in", additionalClusterName)
+		}
+		if additionalClusterConfig == nil || additionalClusterConfig.KubernetesContext == nil || *additionalClusterConfig.KubernetesContext == "" {
+			return nil, stacktrace.NewError("Additional cluster '%v' has no Kubernetes context", additionalClusterName)
+		}
+		result[additionalClusterName] = *additionalClusterConfig.KubernetesContext
+	}
+	return result, nil
+}
+
+// getKubernetesClientConfig overrides the defaults of the Kubernetes client with the values set in the cluster config
+func getKubernetesClientConfig(kubernetesConfig *v7.KubernetesClusterConfigV7) kubernetes_manager.ClientConfig {
+	clientConfig := kubernetes_manager.DefaultClientConfig()
+	if kubernetesConfig.ClientQPS != nil {
+		clientConfig.QPS = *kubernetesConfig.ClientQPS
+	}
+	if kubernetesConfig.ClientBurst != nil {
+		clientConfig.Burst = *kubernetesConfig.ClientBurst
+	}
+	if kubernetesConfig.ClientMaxRetries != nil {
+		clientConfig.MaxRetries = *kubernetesConfig.ClientMaxRetries
+	}
+	return clientConfig
+}
@@ -1,8 +1,10 @@
 package resolved_config
 
 import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/grpc_compression"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
-	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	v7 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v7"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -42,11 +44,13 @@ the overrides on top of the default config.
 */
 type KurtosisConfig struct {
 	// Only necessary to store for when we serialize overrides
-	overrides *v6.KurtosisConfigV6
+	overrides *v7.KurtosisConfigV7
 
 	shouldSendMetrics bool
 	clusters          map[string]*KurtosisClusterConfig
 	cloudConfig       *KurtosisCloudConfig
+	metricsSinkConfig metrics_client.SinkConfig
+	grpcCompression   grpc_compression.Compression
 }
 
 // NewKurtosisConfigFromOverrides constructs a new KurtosisConfig that uses the given overrides
@@ -63,6 +67,8 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		shouldSendMetrics: false,
 		clusters:          nil,
 		cloudConfig:       nil,
+		metricsSinkConfig: metrics_client.NewDefaultSinkConfig(),
+		grpcCompression:   grpc_compression.NoCompression,
 	}
 
 	// Get latest config version
@@ -130,21 +136,49 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		}
 	}
 
+	metricsSinkConfig := metrics_client.NewDefaultSinkConfig()
+	if overrides.Metrics != nil {
+		if overrides.Metrics.Endpoint != nil {
+			metricsSinkConfig.Endpoint = *overrides.Metrics.Endpoint
+		}
+		if overrides.Metrics.WriteKey != nil {
+			metricsSinkConfig.WriteKey = *overrides.Metrics.WriteKey
+		}
+		for _, disabledCategory := range overrides.Metrics.DisabledCategories {
+			metricsSinkConfig.DisabledCategories = append(metricsSinkConfig.DisabledCategories, metrics_client.Category(disabledCategory))
+		}
+	}
+	if err = metricsSinkConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the metrics config")
+	}
+
+	grpcCompression := grpc_compression.NoCompression
+	if overrides.GrpcCompression != nil {
+		grpcCompression, err = grpc_compression.ParseCompression(*overrides.GrpcCompression)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the gRPC compression")
+		}
+	}
+
 	return &KurtosisConfig{
 		overrides:         overrides,
 		shouldSendMetrics: shouldSendMetrics,
 		clusters:          allClusterConfigs,
 		cloudConfig:       cloudConfig,
+		metricsSinkConfig: metricsSinkConfig,
+		grpcCompression:   grpcCompression,
 	}, nil
 }
 
 // NOTE: We probably want to remove this function entirely
 func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfig, error) {
-	overrides := &v6.KurtosisConfigV6{
+	overrides := &v7.KurtosisConfigV7{
 		ConfigVersion:     0,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		Metrics:           nil,
+		GrpcCompression:   nil,
 	}
 	result, err := NewKurtosisConfigFromOverrides(overrides)
 	if err != nil {
@@ -159,20 +193,65 @@ func NewKurtosisConfigWithMetricsSetFromExistingConfig(config *KurtosisConfig, s
 		shouldSendMetrics: shouldSendMetrics,
 		clusters:          config.clusters,
 		cloudConfig:       config.cloudConfig,
+		metricsSinkConfig: config.metricsSinkConfig,
+		grpcCompression:   config.grpcCompression,
 	}
 	newConfig.overrides.ShouldSendMetrics = &shouldSendMetrics
 	return newConfig
 }
 
+// NewKurtosisConfigWithMetricsCategorySetFromExistingConfig enables or disables sending a single category of metrics,
+// leaving the election about sending metrics at all as it is
+func NewKurtosisConfigWithMetricsCategorySetFromExistingConfig(config *KurtosisConfig, category metrics_client.Category, isEnabled bool) *KurtosisConfig {
+	disabledCategories := []metrics_client.Category{}
+	disabledCategoriesOverrides := []string{}
+	for _, disabledCategory := range config.metricsSinkConfig.DisabledCategories {
+		if disabledCategory == category {
+			continue
+		}
+		disabledCategories = append(disabledCategories, disabledCategory)
+		disabledCategoriesOverrides = append(disabledCategoriesOverrides, string(disabledCategory))
+	}
+	if !isEnabled {
+		disabledCategories = append(disabledCategories, category)
+		disabledCategoriesOverrides = append(disabledCategoriesOverrides, string(category))
+	}
+
+	metricsSinkConfig := config.metricsSinkConfig
+	metricsSinkConfig.DisabledCategories = disabledCategories
+	newConfig := &KurtosisConfig{
+		overrides:         config.overrides,
+		shouldSendMetrics: config.shouldSendMetrics,
+		clusters:          config.clusters,
+		cloudConfig:       config.cloudConfig,
+		metricsSinkConfig: metricsSinkConfig,
+		grpcCompression:   config.grpcCompression,
+	}
+	if newConfig.overrides.Metrics == nil {
+		newConfig.overrides.Metrics = &v7.MetricsConfigV7{
+			Endpoint:           nil,
+			WriteKey:           nil,
+			DisabledCategories: nil,
+		}
+	}
+	newConfig.overrides.Metrics.DisabledCategories = disabledCategoriesOverrides
+	return newConfig
+}
+
 func (kurtosisConfig *KurtosisConfig) GetShouldSendMetrics() bool {
 	return kurtosisConfig.shouldSendMetrics
 }
 
+// GetMetricsSinkConfig returns where the metrics are sent and which of them are, if the user accepted sending them
+func (kurtosisConfig *KurtosisConfig) GetMetricsSinkConfig() metrics_client.SinkConfig {
+	return kurtosisConfig.metricsSinkConfig
+}
+
 func (kurtosisConfig *KurtosisConfig) GetKurtosisClusters() map[string]*KurtosisClusterConfig {
 	return kurtosisConfig.clusters
 }
 
-func (kurtosisConfig *KurtosisConfig) GetOverrides() *v6.KurtosisConfigV6 {
+func (kurtosisConfig *KurtosisConfig) GetOverrides() *v7.KurtosisConfigV7 {
 	return kurtosisConfig.overrides
 }
 
@@ -180,21 +259,26 @@ func (kurtosisConfig *KurtosisConfig) GetCloudConfig() *KurtosisCloudConfig {
 	return kurtosisConfig.cloudConfig
 }
 
+// GetGrpcCompression returns the compression of the streaming calls to the engine and the API containers, if any
+func (kurtosisConfig *KurtosisConfig) GetGrpcCompression() grpc_compression.Compression {
+	return kurtosisConfig.grpcCompression
+}
+
 // ====================================================================================================
 //
 //	Private Helpers
 //
 // ====================================================================================================
 // This is a separate helper function so that we can use it to ensure that the
-func castUncastedOverrides(uncastedOverrides interface{}) (*v6.KurtosisConfigV6, error) {
-	castedOverrides, ok := uncastedOverrides.(*v6.KurtosisConfigV6)
+func castUncastedOverrides(uncastedOverrides interface{}) (*v7.KurtosisConfigV7, error) {
+	castedOverrides, ok := uncastedOverrides.(*v7.KurtosisConfigV7)
 	if !ok {
 		return nil, stacktrace.NewError("An error occurred casting the uncasted config overrides to the right version")
 	}
 	return castedOverrides, nil
 }
 
-func getDefaultKurtosisClusterConfigOverrides() map[string]*v6.KurtosisClusterConfigV6 {
+func getDefaultKurtosisClusterConfigOverrides() map[string]*v7.KurtosisClusterConfigV7 {
 	dockerClusterType := KurtosisClusterType_Docker.String()
 	minikubeClusterType := KurtosisClusterType_Kubernetes.String()
 	minikubeKubernetesClusterName := defaultMinikubeClusterKubernetesClusterNameStr
@@ -203,25 +287,35 @@ func getDefaultKurtosisClusterConfigOverrides() map[string]*v6.KurtosisClusterCo
 	minikubeEngineNodeName := defaultMinikubeEngineNodeName
 	shouldEnableDefaultLogsSink := DefaultShouldEnableDefaultLogsSink
 
-	result := map[string]*v6.KurtosisClusterConfigV6{
+	result := map[string]*v7.KurtosisClusterConfigV7{
 		DefaultDockerClusterName: {
 			Type:              &dockerClusterType,
 			Config:            nil, // Must be nil for Docker
 			LogsAggregator:    nil,
 			LogsCollector:     nil,
 			GrafanaLokiConfig: nil,
+			ArtifactsStore:    nil,
 		},
 		defaultMinikubeClusterName: {
 			Type: &minikubeClusterType,
-			Config: &v6.KubernetesClusterConfigV6{
+			Config: &v7.KubernetesClusterConfigV7{
 				KubernetesClusterName:  &minikubeKubernetesClusterName,
 				StorageClass:           &minikubeStorageClass,
 				EnclaveSizeInMegabytes: &minikubeEnclaveDataVolSizeMB,
 				EngineNodeName:         &minikubeEngineNodeName,
+				EngineReplicas:         nil,
+				ClientQPS:              nil,
+				ClientBurst:            nil,
+				ClientMaxRetries:       nil,
+				ObjectLabels:           nil,
+				ObjectAnnotations:      nil,
+				PodSecurityStandard:    nil,
+				AdditionalClusters:     nil,
 			},
 			LogsAggregator:              nil,
 			LogsCollector:               nil,
 			GrafanaLokiConfig:           nil,
+			ArtifactsStore:              nil,
 			ShouldEnableDefaultLogsSink: &shouldEnableDefaultLogsSink,
 		},
 	}
@@ -4,10 +4,12 @@ import (
 	"sort"
 	"testing"
 
-	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	v7 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v7"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/grpc_compression"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,24 +54,26 @@ func TestNewKurtosisConfigFromRequiredFields_MetricsElectionIsSent(t *testing.T)
 }
 
 func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
-	_, err := NewKurtosisConfigFromOverrides(&v6.KurtosisConfigV6{
+	_, err := NewKurtosisConfigFromOverrides(&v7.KurtosisConfigV7{
 		ConfigVersion:     0,
 		ShouldSendMetrics: nil,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		Metrics:           nil,
 	})
 	// You can not initialize a Kurtosis config with empty overrides - it needs at least `ShouldSendMetrics`
 	require.Error(t, err)
 }
 
 func TestNewKurtosisConfigJustMetrics(t *testing.T) {
-	version := config_version.ConfigVersion_v6
+	version := config_version.ConfigVersion_v7
 	shouldSendMetrics := true
-	originalOverrides := v6.KurtosisConfigV6{
+	originalOverrides := v7.KurtosisConfigV7{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		Metrics:           nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	// You can not initialize a Kurtosis config with empty originalOverrides - it needs at least `ShouldSendMetrics`
@@ -93,18 +97,19 @@ func TestNewKurtosisConfigOverridesAreLatestVersion(t *testing.T) {
 }
 
 func TestCloudConfigOverridesApiUrl(t *testing.T) {
-	version := config_version.ConfigVersion_v6
+	version := config_version.ConfigVersion_v7
 	shouldSendMetrics := true
 	apiUrl := "test.com"
-	originalOverrides := v6.KurtosisConfigV6{
+	originalOverrides := v7.KurtosisConfigV7{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
-		CloudConfig: &v6.KurtosisCloudConfigV6{
+		CloudConfig: &v7.KurtosisCloudConfigV7{
 			ApiUrl:           &apiUrl,
 			Port:             nil,
 			CertificateChain: nil,
 		},
+		Metrics: nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	require.NoError(t, err)
@@ -121,3 +126,69 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	require.Equal(t, DefaultCloudConfigPort, config.GetCloudConfig().Port)
 	require.Equal(t, DefaultCertificateChain, config.GetCloudConfig().CertificateChain)
 }
+
+func TestMetricsOverrides(t *testing.T) {
+	shouldSendMetrics := true
+	endpoint := "https://collector.example.com"
+	writeKey := "write-key"
+	originalOverrides := v7.KurtosisConfigV7{
+		ConfigVersion:     config_version.ConfigVersion_v7,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Metrics: &v7.MetricsConfigV7{
+			Endpoint:           &endpoint,
+			WriteKey:           &writeKey,
+			DisabledCategories: []string{string(metrics_client.Category_Run)},
+		},
+	}
+	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.NoError(t, err)
+
+	metricsSinkConfig := config.GetMetricsSinkConfig()
+	require.Equal(t, endpoint, metricsSinkConfig.Endpoint)
+	require.Equal(t, writeKey, metricsSinkConfig.WriteKey)
+	require.False(t, metricsSinkConfig.IsCategoryEnabled(metrics_client.Category_Run))
+	require.True(t, metricsSinkConfig.IsCategoryEnabled(metrics_client.Category_Enclave))
+
+	config = NewKurtosisConfigWithMetricsCategorySetFromExistingConfig(config, metrics_client.Category_Run, true)
+	config = NewKurtosisConfigWithMetricsCategorySetFromExistingConfig(config, metrics_client.Category_Service, false)
+	require.True(t, config.GetMetricsSinkConfig().IsCategoryEnabled(metrics_client.Category_Run))
+	require.False(t, config.GetMetricsSinkConfig().IsCategoryEnabled(metrics_client.Category_Service))
+	require.Equal(t, []string{string(metrics_client.Category_Service)}, config.GetOverrides().Metrics.DisabledCategories)
+	require.Equal(t, shouldSendMetrics, config.GetShouldSendMetrics())
+
+	unknownCategoryOverrides := originalOverrides
+	unknownCategoryOverrides.Metrics = &v7.MetricsConfigV7{
+		Endpoint:           nil,
+		WriteKey:           nil,
+		DisabledCategories: []string{"unknown"},
+	}
+	_, err = NewKurtosisConfigFromOverrides(&unknownCategoryOverrides)
+	require.Error(t, err)
+}
+
+func TestGrpcCompressionOverrides(t *testing.T) {
+	shouldSendMetrics := true
+	config, err := NewKurtosisConfigFromRequiredFields(shouldSendMetrics)
+	require.NoError(t, err)
+	require.Equal(t, grpc_compression.NoCompression, config.GetGrpcCompression())
+
+	grpcCompression := "zstd"
+	overrides := v7.KurtosisConfigV7{
+		ConfigVersion:     config_version.ConfigVersion_v7,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Metrics:           nil,
+		GrpcCompression:   &grpcCompression,
+	}
+	config, err = NewKurtosisConfigFromOverrides(&overrides)
+	require.NoError(t, err)
+	require.Equal(t, grpc_compression.ZstdCompression, config.GetGrpcCompression())
+
+	unsupportedGrpcCompression := "brotli"
+	overrides.GrpcCompression = &unsupportedGrpcCompression
+	_, err = NewKurtosisConfigFromOverrides(&overrides)
+	require.Error(t, err)
+}
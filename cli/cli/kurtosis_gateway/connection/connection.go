@@ -3,16 +3,18 @@ package connection
 import (
 	"bytes"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	k8s_rest "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 )
 
@@ -25,11 +27,32 @@ const (
 	portForwardTimeBetweenRetries = 5 * time.Second
 )
 
+// ConnectionState describes whether the port forwarding of a connection currently works
+type ConnectionState string
+
+const (
+	ConnectionState_Connected    ConnectionState = "CONNECTED"
+	ConnectionState_Reconnecting ConnectionState = "RECONNECTING"
+	// The connection can't be used anymore, it has to be replaced by a new one
+	ConnectionState_Failed  ConnectionState = "FAILED"
+	ConnectionState_Stopped ConnectionState = "STOPPED"
+)
+
+// ConnectionStatus is a point-in-time view of the health of a connection
+type ConnectionStatus struct {
+	State ConnectionState
+	// Number of times the connection got re-established after being lost
+	Reconnects uint32
+	// Error that caused the last loss of connection or failed reconnection attempt, nil if none
+	LastError error
+}
+
 // GatewayConnectionToKurtosis represents a connection on localhost that can be used by the gateway to communicate with Kurtosis in the cluster
 type GatewayConnectionToKurtosis interface {
 	// GetLocalPorts returns a map keyed with an identifier string describing local ports being forwarded
 	GetLocalPorts() map[string]*port_spec.PortSpec
-	GetGrpcClientConn() (*grpc.ClientConn, error)
+	GetGrpcClientConn(transportCredentials credentials.TransportCredentials) (*grpc.ClientConn, error)
+	GetStatus() ConnectionStatus
 	Stop()
 }
 
@@ -47,11 +70,31 @@ type gatewayConnectionToKurtosisImpl struct {
 	remotePortNumberToPortSpecIdMap map[uint16]string
 
 	urlString string
+
+	// Guards status, which the port forwarding goroutine updates
+	statusMutex *sync.Mutex
+	status      ConnectionStatus
+}
+
+// podProxyEndpointSupplier returns the URL of the portforward endpoint of the pod to connect to; it gets called again on
+// every reconnection so that the connection can move to another pod, e.g. another engine replica
+type podProxyEndpointSupplier func() (*url.URL, error)
+
+// fixedPodProxyEndpoint always connects to the same pod
+func fixedPodProxyEndpoint(podProxyEndpointUrl *url.URL) podProxyEndpointSupplier {
+	return func() (*url.URL, error) {
+		return podProxyEndpointUrl, nil
+	}
 }
 
 // newLocalPortToPodPortConnection binds a random local port to the remote port keyed with an identifier string
 // remotePortSpecs is a map keyed with an identifier string of port specs on the remote pod to forward requests to
-func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podProxyEndpointUrl *url.URL, remotePortSpecs map[string]*port_spec.PortSpec) (*gatewayConnectionToKurtosisImpl, error) {
+func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, getPodProxyEndpointUrl podProxyEndpointSupplier, remotePortSpecs map[string]*port_spec.PortSpec) (*gatewayConnectionToKurtosisImpl, error) {
+	podProxyEndpointUrl, err := getPodProxyEndpointUrl()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the portforward endpoint of the pod to connect to")
+	}
+
 	var portforwardStdOut bytes.Buffer
 	var portforwardStdErr bytes.Buffer
 	portforwardStopChannel := make(chan struct{}, 1)
@@ -85,6 +128,12 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 		stopChannel:                     stopChannel,
 		remotePortNumberToPortSpecIdMap: remotePortNumberToPortSpecIdMapping,
 		urlString:                       podProxyEndpointUrl.String(),
+		statusMutex:                     &sync.Mutex{},
+		status: ConnectionStatus{
+			State:      ConnectionState_Connected,
+			Reconnects: 0,
+			LastError:  nil,
+		},
 	}
 
 	// Connection to pod portforwarder endpoint
@@ -93,12 +142,12 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 		return nil, stacktrace.Propagate(err, "An error occurred creating a SPDY round-tripper for the Kubernetes REST config")
 	}
 	dialerMethod := "POST"
-	dialer := spdy.NewDialer(upgrader, &http.Client{
+	httpClient := &http.Client{
 		Transport:     transport,
 		CheckRedirect: nil,
 		Jar:           nil,
 		Timeout:       0,
-	}, dialerMethod, podProxyEndpointUrl)
+	}
 
 	// Start forwarding ports asynchronously with reconnect logic.
 	// The reconnect logic tries to reconnect after a working connection is lost.
@@ -107,14 +156,31 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 		retries := 0
 		readyChannel := portforwardReadyChannel
 		for {
+			if retries > 0 {
+				// The pod might be gone for good, e.g. an engine replica on a node that failed, so look it up again
+				if newPodProxyEndpointUrl, err := getPodProxyEndpointUrl(); err != nil {
+					logrus.Debugf("Error getting the portforward endpoint of the pod to reconnect to, retrying with '%v':\n%v", podProxyEndpointUrl.String(), err)
+				} else {
+					podProxyEndpointUrl = newPodProxyEndpointUrl
+				}
+			}
+			dialer := spdy.NewDialer(upgrader, httpClient, dialerMethod, podProxyEndpointUrl)
 			connection.portforwarder, err = portforward.NewOnAddresses(dialer, portForwardAddresses, portStrings, portforwardStopChannel, readyChannel, &portforwardStdOut, &portforwardStdErr)
 			if err != nil {
 				// Addresses or ports cannot be parsed so there is nothing else to try
 				logrus.Errorf("An error occured parsing the port forwarder addresses or ports:\n%v", err)
+				connection.setFailed(err)
 				return
 			} else {
 				logrus.Debugf("Trying to forward ports for pod: %s", podProxyEndpointUrl.String())
-				if err = connection.portforwarder.ForwardPorts(); err != nil {
+				attemptDoneChannel := make(chan struct{})
+				if retries > 0 {
+					go connection.markConnectedOnceReady(readyChannel, attemptDoneChannel, podProxyEndpointUrl.String())
+				}
+				err = connection.portforwarder.ForwardPorts()
+				close(attemptDoneChannel)
+				if err != nil {
+					connection.setReconnecting(err)
 					if err == portforward.ErrLostConnectionToPod {
 						logrus.Infof("Lost connection to pod: %s", podProxyEndpointUrl.String())
 						retries = 0
@@ -122,6 +188,7 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 						ports, err := connection.portforwarder.GetPorts()
 						if err != nil {
 							logrus.Errorf("An error occured retrieving the local ports to remote ports mapping for our portforwarder:\n%v", err)
+							connection.setFailed(err)
 							return
 						}
 						portStrings = nil
@@ -133,6 +200,7 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 						if retries == 0 {
 							// Exit the retry logic if the first try to connect fails
 							logrus.Errorf("Expected to be able to start forwarding local ports to remote ports, instead our portforwarder has returned a non-nil err:\n%v", err)
+							connection.setFailed(err)
 							return
 						}
 						logrus.Debugf("Error trying to forward ports:\n%v", err)
@@ -159,7 +227,7 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 	select {
 	case <-portforwardReadyChannel:
 	case <-time.After(portForwardTimeoutDuration):
-		return nil, stacktrace.NewError("Expected Kubernetes portforwarder to open local ports to the pod exposed by the portforward api at URL '%v', instead the Kubernetes portforwarder timed out binding local ports", podProxyEndpointUrl)
+		return nil, stacktrace.NewError("Expected Kubernetes portforwarder to open local ports to the pod exposed by the portforward api at URL '%v', instead the Kubernetes portforwarder timed out binding local ports", connection.urlString)
 	}
 	// Get local forwarded ports
 	forwardedPorts, err := connection.portforwarder.GetPorts()
@@ -192,6 +260,9 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 
 func (connection *gatewayConnectionToKurtosisImpl) Stop() {
 	logrus.Infof("Closing connection to pod: %s", connection.urlString)
+	connection.statusMutex.Lock()
+	connection.status.State = ConnectionState_Stopped
+	connection.statusMutex.Unlock()
 	close(connection.stopChannel)
 	connection.portforwarder.Close()
 	close(connection.portforwarderStopChannel)
@@ -201,9 +272,10 @@ func (connection *gatewayConnectionToKurtosisImpl) GetLocalPorts() map[string]*p
 	return connection.localPorts
 }
 
-// GetGrpcClientConn returns a client conn dialed in to the local port
+// GetGrpcClientConn returns a client conn dialed in to the local port with the given transport credentials, which the
+// API containers that require mutual TLS authenticate the gateway with
 // It is the caller's responsibility to call resultClientConn.close()
-func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultClientConn *grpc.ClientConn, resultErr error) {
+func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn(transportCredentials credentials.TransportCredentials) (resultClientConn *grpc.ClientConn, resultErr error) {
 	localPorts := connection.GetLocalPorts()
 	localGrpcPort, isFound := localPorts[grpcPortId]
 	if !isFound {
@@ -211,10 +283,56 @@ func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultCl
 	}
 	localGrpcPortNum := localPorts[grpcPortId].GetNumber()
 	localGrpcServerAddress := fmt.Sprintf("%v:%v", localHostIpStr, localGrpcPortNum)
-	grpcConnection, err := grpc.Dial(localGrpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// The token is only checked by engines, API containers ignore it
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}, kurtosis_context.GetEngineTokenDialOptions()...)
+	grpcConnection, err := grpc.Dial(localGrpcServerAddress, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", localGrpcServerAddress)
 	}
 
 	return grpcConnection, nil
 }
+
+func (connection *gatewayConnectionToKurtosisImpl) GetStatus() ConnectionStatus {
+	connection.statusMutex.Lock()
+	defer connection.statusMutex.Unlock()
+	return connection.status
+}
+
+// markConnectedOnceReady marks the connection as connected again once the port forwarder of a reconnection attempt
+// listens, which it signals by closing its ready channel, unless the attempt fails before
+func (connection *gatewayConnectionToKurtosisImpl) markConnectedOnceReady(readyChannel chan struct{}, attemptDoneChannel chan struct{}, urlString string) {
+	select {
+	case <-readyChannel:
+	case <-attemptDoneChannel:
+		return
+	}
+	connection.statusMutex.Lock()
+	defer connection.statusMutex.Unlock()
+	if connection.status.State != ConnectionState_Reconnecting {
+		return
+	}
+	connection.status.State = ConnectionState_Connected
+	connection.status.Reconnects += 1
+	logrus.Infof("Reconnected to pod: %s", urlString)
+}
+
+func (connection *gatewayConnectionToKurtosisImpl) setReconnecting(err error) {
+	connection.statusMutex.Lock()
+	defer connection.statusMutex.Unlock()
+	if connection.status.State == ConnectionState_Stopped {
+		return
+	}
+	connection.status.State = ConnectionState_Reconnecting
+	connection.status.LastError = err
+}
+
+func (connection *gatewayConnectionToKurtosisImpl) setFailed(err error) {
+	connection.statusMutex.Lock()
+	defer connection.statusMutex.Unlock()
+	if connection.status.State == ConnectionState_Stopped {
+		return
+	}
+	connection.status.State = ConnectionState_Failed
+	connection.status.LastError = err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"net/url"
+	"sync"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
@@ -30,9 +31,12 @@ const (
 var noWait *port_spec.Wait = nil
 
 type GatewayConnectionProvider struct {
-	config                          *restclient.Config
-	kubernetesManager               *kubernetes_manager.KubernetesManager
-	providerContext                 context.Context
+	config            *restclient.Config
+	kubernetesManager *kubernetes_manager.KubernetesManager
+	providerContext   context.Context
+
+	// Guards enclaveIdToEnclaveNamespaceName, as the connections to the enclaves get created concurrently
+	mutex                           *sync.Mutex
 	enclaveIdToEnclaveNamespaceName map[string]string
 }
 
@@ -45,12 +49,13 @@ func NewGatewayConnectionProvider(ctx context.Context, kubernetesConfig *restcli
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get config for Kubernetes client set, instead a non nil error was returned")
 	}
-	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, kubernetesConfig, emptyStorageClassName)
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, kubernetesConfig, emptyStorageClassName, kubernetes_manager.PodSecurityStandard_Privileged)
 
 	return &GatewayConnectionProvider{
 		config:                          kubernetesConfig,
 		kubernetesManager:               kubernetesManager,
 		providerContext:                 ctx,
+		mutex:                           &sync.Mutex{},
 		enclaveIdToEnclaveNamespaceName: map[string]string{},
 	}, nil
 }
@@ -64,11 +69,15 @@ func (provider *GatewayConnectionProvider) ForEngine(engine *engine.Engine) (Gat
 	enginePorts := map[string]*port_spec.PortSpec{
 		grpcPortIdStr: enginePublicGrpcPortSpec,
 	}
-	podPortforwardEndpoint, err := provider.getEnginePodPortforwardEndpoint(engine.GetGUID())
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Expected to be able to find an api endpoint for Kubernetes portforward to engine '%v', instead a non-nil error was returned", engine.GetGUID())
+	// Looked up on every reconnection so that the connection moves to another replica if the engine pod goes away
+	getPodPortforwardEndpoint := func() (*url.URL, error) {
+		podPortforwardEndpoint, err := provider.getEnginePodPortforwardEndpoint(engine.GetGUID())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Expected to be able to find an api endpoint for Kubernetes portforward to engine '%v', instead a non-nil error was returned", engine.GetGUID())
+		}
+		return podPortforwardEndpoint, nil
 	}
-	engineConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, enginePorts)
+	engineConnection, err := newLocalPortToPodPortConnection(provider.config, getPodPortforwardEndpoint, enginePorts)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get a connection to engine '%v', instead a non-nil error was returned", engine.GetGUID())
 	}
@@ -87,11 +96,15 @@ func (provider *GatewayConnectionProvider) ForEnclaveApiContainer(enclaveInfo *k
 		grpcPortIdStr: apiContainerGrpcPortSpec,
 	}
 	enclaveId := enclaveInfo.GetEnclaveUuid()
-	podPortforwardEndpoint, err := provider.getApiContainerPodPortforwardEndpoint(enclaveId)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Expected to be able to get an endpoint for portforwarding to the API Container in enclave '%v', instead a non-nil error was returned", enclaveId)
+	// Looked up on every reconnection so that the connection follows the API container if its pod gets replaced
+	getPodPortforwardEndpoint := func() (*url.URL, error) {
+		podPortforwardEndpoint, err := provider.getApiContainerPodPortforwardEndpoint(enclaveId)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Expected to be able to get an endpoint for portforwarding to the API Container in enclave '%v', instead a non-nil error was returned", enclaveId)
+		}
+		return podPortforwardEndpoint, nil
 	}
-	apiContainerConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, apiContainerPorts)
+	apiContainerConnection, err := newLocalPortToPodPortConnection(provider.config, getPodPortforwardEndpoint, apiContainerPorts)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to connect to api container in enclave '%v', instead a non-nil error was returned", enclaveId)
 	}
@@ -105,7 +118,7 @@ func (provider *GatewayConnectionProvider) ForUserServiceIfRunning(enclaveId str
 		return nil, stacktrace.Propagate(err, "an error occurred while getting the enclave namespace name")
 	}
 	podPortforwardEndpoint := provider.getUserServicePortForwardEndpoint(enclaveNamespaceName, serviceName)
-	userServiceConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, servicePortSpecs)
+	userServiceConnection, err := newLocalPortToPodPortConnection(provider.config, fixedPodProxyEndpoint(podPortforwardEndpoint), servicePortSpecs)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to connect to user service with name '%v', instead a non-nil error was returned", serviceName)
 	}
@@ -133,9 +146,10 @@ func (provider *GatewayConnectionProvider) getEnginePodPortforwardEndpoint(engin
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get the names of running engine pods with labels '%+v', instead a non-nil error was returned", engineLabels)
 	}
-	if len(runningEnginePodNames) != 1 {
-		return nil, stacktrace.NewError("Expected to find exactly 1 running Kurtosis Engine pod, instead found '%v'", len(runningEnginePodNames))
+	if len(runningEnginePodNames) == 0 {
+		return nil, stacktrace.NewError("Expected to find at least 1 running Kurtosis Engine pod, instead found none")
 	}
+	// Any of the engine replicas can serve the requests
 	enginePodName := runningEnginePodNames[0]
 
 	return provider.kubernetesManager.GetPodPortforwardEndpointUrl(engineNamespaceName, enginePodName), nil
@@ -188,6 +202,8 @@ func (provider *GatewayConnectionProvider) getRunningPodNamesByLabels(namespace
 // TODO - this function shouldn't exist when kt- + enclave name is the namespace inside kurtosis
 // https://github.com/kurtosis-tech/kurtosis/issues/1203 - till then we cache it
 func (provider *GatewayConnectionProvider) getEnclaveNamespaceNameForEnclaveId(enclaveId string) (string, error) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
 	enclaveNamespaceName, found := provider.enclaveIdToEnclaveNamespaceName[enclaveId]
 	if found {
 		return enclaveNamespaceName, nil
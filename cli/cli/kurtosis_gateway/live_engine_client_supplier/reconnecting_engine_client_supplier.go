@@ -2,6 +2,7 @@ package live_engine_client_supplier
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
@@ -12,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -36,6 +38,8 @@ type LiveEngineClientSupplier struct {
 
 	connectionProvider *connection.GatewayConnectionProvider
 
+	// Guards currentInfo, which gets replaced while the gateway serves requests
+	mutex       *sync.Mutex
 	currentInfo *engineInfo
 
 	stopUpdaterSignalChan chan interface{}
@@ -47,6 +51,7 @@ func NewLiveEngineClientSupplier(
 ) *LiveEngineClientSupplier {
 	return &LiveEngineClientSupplier{
 		kubernetesBackend:     kurtosisBackend,
+		mutex:                 &sync.Mutex{},
 		currentInfo:           nil,
 		stopUpdaterSignalChan: nil,
 		connectionProvider:    connectionProvider,
@@ -90,12 +95,25 @@ func (supplier *LiveEngineClientSupplier) Start() error {
 
 // NOTE: Do not save this value!! Just use it as a point-in-time piece of info
 func (supplier *LiveEngineClientSupplier) GetEngineClient() (kurtosis_engine_rpc_api_bindings.EngineServiceClient, error) {
+	supplier.mutex.Lock()
+	defer supplier.mutex.Unlock()
 	if supplier.currentInfo == nil {
 		return nil, stacktrace.NewError("Expected to have info about a running live engine, instead no info was found")
 	}
 	return supplier.currentInfo.engineClient, nil
 }
 
+// GetEngineConnectionStatus returns the ID of the engine the supplier is connected to and the status of the connection,
+// or false if it isn't connected to any engine
+func (supplier *LiveEngineClientSupplier) GetEngineConnectionStatus() (engine.EngineGUID, connection.ConnectionStatus, bool) {
+	supplier.mutex.Lock()
+	defer supplier.mutex.Unlock()
+	if supplier.currentInfo == nil {
+		return "", connection.ConnectionStatus{State: connection.ConnectionState_Stopped, Reconnects: 0, LastError: nil}, false
+	}
+	return supplier.currentInfo.engineGuid, supplier.currentInfo.proxyConn.GetStatus(), true
+}
+
 func (supplier *LiveEngineClientSupplier) Stop() {
 	if supplier.stopUpdaterSignalChan == nil {
 		return
@@ -104,9 +122,11 @@ func (supplier *LiveEngineClientSupplier) Stop() {
 }
 
 // This function will gather the current state of engines in the cluster and compare it with the current state of the
-// supplier. If necessary, the supplier's currently-tracked engine will be supplanted with the new running engine.
-// NOT thread-safe!
+// supplier. If necessary, the supplier's currently-tracked engine will be supplanted with the new running engine, which
+// is also done when the connection to the currently-tracked engine failed for good.
 func (supplier *LiveEngineClientSupplier) replaceEngineIfNecessaryBestEffort() {
+	supplier.mutex.Lock()
+	defer supplier.mutex.Unlock()
 	runningEngineFilters := &engine.EngineFilters{
 		GUIDs: nil,
 		Statuses: map[container.ContainerStatus]bool{
@@ -142,12 +162,15 @@ func (supplier *LiveEngineClientSupplier) replaceEngineIfNecessaryBestEffort() {
 		return
 	}
 
-	// No need to replace if it's the one we're already connected to
+	// No need to replace if it's the one we're already connected to, unless the connection can't recover
 	if supplier.currentInfo.engineGuid == runningEngine.GetGUID() {
-		return
+		if supplier.currentInfo.proxyConn.GetStatus().State != connection.ConnectionState_Failed {
+			return
+		}
+		logrus.Warnf("The connection to engine '%v' failed, connecting to it again", runningEngine.GetGUID())
 	}
 
-	// If we get here, we must: a) have an engine that b) doesn't match the currently-running engine
+	// If we get here, we must: a) have an engine that b) doesn't match the currently-running engine or has a failed connection
 	if err := supplier.replaceCurrentEngineInfo(runningEngine); err != nil {
 		logrus.Errorf("An error occurred connecting to engine '%v':\n%v", runningEngine.GetGUID(), err)
 	}
@@ -166,7 +189,7 @@ func (supplier *LiveEngineClientSupplier) replaceCurrentEngineInfo(newEngine *en
 		}
 	}()
 	// Create an engine client that sends requests to our new client
-	newGrpcConnection, err := newProxyConn.GetGrpcClientConn()
+	newGrpcConnection, err := newProxyConn.GetGrpcClientConn(insecure.NewCredentials())
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to get a GRPC client connection to engine '%v', instead a non-nil error was returned", newEngine.GetGUID())
 	}
@@ -8,6 +8,7 @@ import (
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"time"
 )
 
@@ -15,15 +16,14 @@ const (
 	grpcServerStopGracePeriod = 5 * time.Second
 )
 
-func RunApiContainerGatewayUntilStopped(connectionProvider *connection.GatewayConnectionProvider, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, gatewayPort uint16, gatewayStopChannel chan struct{}) error {
-	apiContainerConnection, err := connectionProvider.ForEnclaveApiContainer(enclaveInfo)
-	if err != nil {
-		return stacktrace.Propagate(err, "Expected to be able to start forwarding ports to an enclave API container, instead a non nil error was returned")
-	}
+// RunApiContainerGatewayUntilStopped serves the API container of the enclave through the given connection to it, which
+// gets stopped when the gateway stops. The gateway calls the API container with the given transport credentials, while
+// its own clients call it without TLS as it only listens on the host machine
+func RunApiContainerGatewayUntilStopped(connectionProvider *connection.GatewayConnectionProvider, apiContainerConnection connection.GatewayConnectionToKurtosis, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, apiContainerTransportCredentials credentials.TransportCredentials, gatewayPort uint16, gatewayStopChannel chan struct{}) error {
 	defer apiContainerConnection.Stop()
 
 	// Dial in to our locally forwarded port
-	apiContainerGrpcClientConn, err := apiContainerConnection.GetGrpcClientConn()
+	apiContainerGrpcClientConn, err := apiContainerConnection.GetGrpcClientConn(apiContainerTransportCredentials)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to create a grpc client connection to the forwarded API container port, instead a non nil error was returned")
 	}
@@ -1,10 +1,16 @@
 package engine_gateway
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/live_engine_client_supplier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/engine_gateway"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/gateway_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
@@ -17,21 +23,53 @@ const (
 	localHostIpStr            = "127.0.0.1"
 	engineGatewayPort         = 9710
 	grpcServerStopGracePeriod = 5 * time.Second
+
+	statusServerReadHeaderTimeout = 5 * time.Second
+	statusServerStopGracePeriod   = 5 * time.Second
 )
 
-func RunEngineGatewayUntilInterrupted(kurtosisBackend backend_interface.KurtosisBackend, connectionProvider *connection.GatewayConnectionProvider) error {
+// RunEngineGatewayUntilInterrupted serves the engine, and the API containers of its enclaves, on localhost, along with
+// the status of the connections of the gateway over HTTP on the status port
+func RunEngineGatewayUntilInterrupted(kurtosisBackend backend_interface.KurtosisBackend, connectionProvider *connection.GatewayConnectionProvider, statusPort uint16) error {
 	engineClientSupplier := live_engine_client_supplier.NewLiveEngineClientSupplier(kurtosisBackend, connectionProvider)
 	if err := engineClientSupplier.Start(); err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to start supplier for live Kurtosis engine clients, instead a non-nil error was returned")
 	}
+	defer engineClientSupplier.Stop()
 	engineGatewayServer, gatewayCloseFunc := engine_gateway.NewEngineGatewayServiceServer(connectionProvider, engineClientSupplier)
 	defer gatewayCloseFunc()
+
+	getEngineConnectionStatus := func() (string, connection.ConnectionStatus, bool) {
+		engineGuid, engineConnectionStatus, isConnected := engineClientSupplier.GetEngineConnectionStatus()
+		return string(engineGuid), engineConnectionStatus, isConnected
+	}
+	statusServeMux := http.NewServeMux()
+	statusServeMux.Handle(gateway_status.StatusPath, gateway_status.NewGatewayStatusHandler(getEngineConnectionStatus, engineGatewayServer.GetApiContainerGatewaysStatus))
+	statusServer := &http.Server{ // nolint: exhaustruct
+		Addr:              fmt.Sprintf("%v:%v", localHostIpStr, statusPort),
+		Handler:           statusServeMux,
+		ReadHeaderTimeout: statusServerReadHeaderTimeout,
+	}
+	go func() {
+		if err := statusServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.Warnf("The status of the gateway can't be served on local port '%v':\n%v", statusPort, err)
+		}
+	}()
+	defer func() {
+		ctxWithTimeout, cancelFunc := context.WithTimeout(context.Background(), statusServerStopGracePeriod)
+		defer cancelFunc()
+		if err := statusServer.Shutdown(ctxWithTimeout); err != nil {
+			logrus.Debugf("An error occurred stopping the gateway status server:\n%v", err)
+		}
+	}()
+
 	engineGatewayServiceRegistrationFunc := func(grpcServer *grpc.Server) {
 		kurtosis_engine_rpc_api_bindings.RegisterEngineServiceServer(grpcServer, engineGatewayServer)
 	}
 	// Print information to the user
 	logrus.Infof("Starting Kurtosis gateway on local port '%v'", engineGatewayPort)
 	logrus.Infof("You can use this gateway as a drop-in replacement for Kurtosis engine. To connect to the gateway, send a request to '%v:%v'", localHostIpStr, engineGatewayPort)
+	logrus.Infof("The status of the connections of the gateway is served at 'http://%v:%v%v'", localHostIpStr, statusPort, gateway_status.StatusPath)
 	logrus.Infof("To kill the running gateway, press CTRL+C")
 
 	engineGatewayGrpcServer := minimal_grpc_server.NewMinimalGRPCServer(
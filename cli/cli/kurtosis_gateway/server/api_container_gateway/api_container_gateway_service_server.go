@@ -138,6 +138,24 @@ func (service *ApiContainerGatewayServiceServer) GetExistingAndHistoricalService
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) GetServiceDependencyGraph(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetServiceDependencyGraph(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) SetEnclaveEnvVars(ctx context.Context, args *kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.SetEnclaveEnvVars(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+
+	return remoteApiContainerResponse, nil
+}
+
 func (service *ApiContainerGatewayServiceServer) ExecCommand(ctx context.Context, args *kurtosis_core_rpc_api_bindings.ExecCommandArgs) (*kurtosis_core_rpc_api_bindings.ExecCommandResponse, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.ExecCommand(ctx, args)
 	if err != nil {
@@ -200,11 +218,27 @@ func (service *ApiContainerGatewayServiceServer) InspectFilesArtifactContents(ct
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) GetFilesArtifactHistory(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs) (*kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetFilesArtifactHistory(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
 func (service *ApiContainerGatewayServiceServer) DownloadFilesArtifact(args *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_DownloadFilesArtifactServer) error {
 	client, err := service.remoteApiContainerClient.DownloadFilesArtifact(server.Context(), args)
 	if err != nil {
 		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
 	}
+	// Forward the headers so that the content checksum advertised by the APIC reaches the client
+	header, err := client.Header()
+	if err != nil {
+		return stacktrace.Propagate(err, "Error reading the headers of DownloadFilesArtifact on gateway")
+	}
+	if err := server.SetHeader(header); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the headers of DownloadFilesArtifact on gateway")
+	}
 	if err := forwardDataChunkStream(client, server); err != nil {
 		return stacktrace.Propagate(err, "Error forwarding stream from DownloadFilesArtifactV2 on gateway")
 	}
@@ -246,6 +280,41 @@ func (service *ApiContainerGatewayServiceServer) GetStarlarkPackagePlanYaml(ctx
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) WatchServiceEvents(args *kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_WatchServiceEventsServer) error {
+	streamToReadFrom, err := service.remoteApiContainerClient.WatchServiceEvents(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.ServiceEvent](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from WatchServiceEvents on gateway")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) StartServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.StartServices(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) StopServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.StopServices(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RemoveServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RemoveServices(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
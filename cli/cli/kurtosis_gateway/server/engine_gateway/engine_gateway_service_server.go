@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/live_engine_client_supplier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/port_utils"
@@ -12,8 +13,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/common"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	restclient "k8s.io/client-go/rest"
 	"sync"
@@ -27,6 +31,14 @@ const (
 	waitForGatewayGrpcReady = true
 
 	apiContainerGatewayHealthcheckTimeout = 5 * time.Second
+
+	// Asks the kernel for a free port when starting a gateway
+	freeGatewayPort = 0
+
+	// How often the gateways get started, restarted and killed to match the enclaves of the engine
+	apiContainerGatewaysSyncInterval = 5 * time.Second
+	apiContainerGatewaysSyncTimeout  = 10 * time.Second
+	apiContainerGatewayStopTimeout   = 10 * time.Second
 )
 
 type EngineGatewayServiceServer struct {
@@ -40,31 +52,65 @@ type EngineGatewayServiceServer struct {
 
 	mutex                        *sync.Mutex
 	enclaveIdToRunningGatewayMap map[string]*runningApiContainerGateway
+
+	stopSyncSignalChan chan interface{}
 }
 
 type runningApiContainerGateway struct {
+	enclaveName string
 	// info about the api container on the host machine
 	hostMachineInfo *kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerHostMachineInfo
+	// port forwarded connection the gateway sends the requests through
+	apiContainerConnection connection.GatewayConnectionToKurtosis
+	// closed once the gateway server stopped, whether it got closed or it failed
+	stoppedChan chan struct{}
 	// closeGatewayFunc
 	closeFunc func()
 }
 
+// ApiContainerGatewayStatus is a point-in-time view of the gateway to the API container of an enclave
+type ApiContainerGatewayStatus struct {
+	EnclaveUuid string
+	EnclaveName string
+	// Port on localhost the gateway serves the API container on
+	LocalGrpcPort uint32
+	Connection    connection.ConnectionStatus
+}
+
 // NewEngineGatewayServiceServer returns a EngineGatewayServiceServer
 // runningEngine is a kurtosis engine running a cluster that can be reached through clients configured with kubernetesConfig
+// The server keeps a gateway running for the API container of every running enclave, restarting the gateways whose
+// connection failed on the same local port, until gatewayCloseFunc gets called
 func NewEngineGatewayServiceServer(connectionProvider *connection.GatewayConnectionProvider, engineClientSupplier *live_engine_client_supplier.LiveEngineClientSupplier) (resultGatewayService *EngineGatewayServiceServer, gatewayCloseFunc func()) {
 	// We start out with no enclave api-container gateways runnings
 	runningApiContainers := map[string]*runningApiContainerGateway{}
 
+	stopSyncSignalChan := make(chan interface{})
 	service := &EngineGatewayServiceServer{
 		engineClientSupplier:         engineClientSupplier,
 		kubernetesConfig:             nil,
 		connectionProvider:           connectionProvider,
 		mutex:                        &sync.Mutex{},
 		enclaveIdToRunningGatewayMap: runningApiContainers,
+		stopSyncSignalChan:           stopSyncSignalChan,
 	}
+	go func() {
+		poller := time.NewTicker(apiContainerGatewaysSyncInterval)
+		defer poller.Stop()
+
+		for {
+			select {
+			case <-poller.C:
+				service.syncApiContainerGatewaysBestEffort()
+			case <-stopSyncSignalChan:
+				return
+			}
+		}
+	}()
 	closeFunc := func() {
+		close(stopSyncSignalChan)
 		// Kill the running enclave gateways
-		for enclaveId := range service.enclaveIdToRunningGatewayMap {
+		for enclaveId := range service.getRunningGateways() {
 			service.idempotentKillRunningGatewayForEnclaveId(enclaveId)
 		}
 	}
@@ -72,6 +118,24 @@ func NewEngineGatewayServiceServer(connectionProvider *connection.GatewayConnect
 	return service, closeFunc
 }
 
+// GetApiContainerGatewaysStatus returns the status of the gateways to the API containers of the enclaves
+func (service *EngineGatewayServiceServer) GetApiContainerGatewaysStatus() []*ApiContainerGatewayStatus {
+	gatewaysStatus := []*ApiContainerGatewayStatus{}
+	for enclaveId, runningGateway := range service.getRunningGateways() {
+		connectionStatus := runningGateway.apiContainerConnection.GetStatus()
+		if !runningGateway.isServing() {
+			connectionStatus.State = connection.ConnectionState_Failed
+		}
+		gatewaysStatus = append(gatewaysStatus, &ApiContainerGatewayStatus{
+			EnclaveUuid:   enclaveId,
+			EnclaveName:   runningGateway.enclaveName,
+			LocalGrpcPort: runningGateway.hostMachineInfo.GetGrpcPortOnHostMachine(),
+			Connection:    connectionStatus,
+		})
+	}
+	return gatewaysStatus
+}
+
 func (service *EngineGatewayServiceServer) CreateEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
@@ -97,7 +161,7 @@ func (service *EngineGatewayServiceServer) CreateEnclave(ctx context.Context, ar
 	createdEnclaveInfo := remoteEngineResponse.GetEnclaveInfo()
 	createdEnclaveId := createdEnclaveInfo.GetEnclaveUuid()
 
-	runningApiContainerGateway, err := service.startRunningGatewayForEnclave(createdEnclaveInfo)
+	runningApiContainerGateway, err := service.startRunningGatewayForEnclave(createdEnclaveInfo, freeGatewayPort)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to start a local gateway for enclave '%v', instead a non-nil err was returned", createdEnclaveId)
 	}
@@ -113,6 +177,8 @@ func (service *EngineGatewayServiceServer) CreateEnclave(ctx context.Context, ar
 		return nil, stacktrace.NewError("Expected the response from the remote engine to have info on the enclave '%v', instead no enclave information was found.", createdEnclaveId)
 	}
 	remoteEngineResponse.EnclaveInfo.ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+	// The gateway authenticates to the API container itself, its clients call it without TLS
+	remoteEngineResponse.EnclaveInfo.IsApiContainerMtlsRequired = false
 
 	cleanUpEnclave = false
 	cleanupRunningApiContainerGateway = false
@@ -128,27 +194,24 @@ func (service *EngineGatewayServiceServer) GetEnclaves(ctx context.Context, in *
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves from the remote engine")
 	}
-	responseEnclaves := remoteEngineResponse.GetEnclaveInfo()
-	cleanUpRunningGateways := true
-	for enclaveId, enclaveInfo := range responseEnclaves {
-		var runningApiContainerGateway *runningApiContainerGateway
-		runningApiContainerGateway, isRunning := service.enclaveIdToRunningGatewayMap[enclaveId]
-		// If the gateway isn't running, start it
-		if !isRunning {
-			runningApiContainerGateway, err = service.startRunningGatewayForEnclave(enclaveInfo)
-			defer func() {
-				if cleanUpRunningGateways {
-					service.idempotentKillRunningGatewayForEnclaveId(enclaveId)
-				}
-			}()
-			if err != nil {
-				return nil, stacktrace.Propagate(err, "Expected to be able to start a local gateway for enclave '%v', instead a non-nil error was returned", enclaveId)
-			}
-		}
-		remoteEngineResponse.EnclaveInfo[enclaveId].ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+	if err = service.pointEnclavesToLocalGateways(maps.Values(remoteEngineResponse.GetEnclaveInfo())); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred pointing the enclaves to local gateways")
 	}
+	return remoteEngineResponse, nil
+}
 
-	cleanUpRunningGateways = false
+func (service *EngineGatewayServiceServer) ListEnclaves(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.ListEnclavesArgs) (*kurtosis_engine_rpc_api_bindings.ListEnclavesResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.ListEnclaves(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing enclaves from the remote engine")
+	}
+	if err = service.pointEnclavesToLocalGateways(remoteEngineResponse.GetEnclaveInfos()); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred pointing the enclaves to local gateways")
+	}
 	return remoteEngineResponse, nil
 }
 
@@ -193,6 +256,30 @@ func (service *EngineGatewayServiceServer) DestroyEnclave(ctx context.Context, a
 	return &emptypb.Empty{}, nil
 }
 
+func (service *EngineGatewayServiceServer) ShareEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.ShareEnclave(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to share enclave '%v' with '%v'", args.GetEnclaveIdentifier(), args.GetPrincipal())
+	}
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) GetApiContainerTlsCredentials(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs) (*kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetApiContainerTlsCredentials(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the credentials of the API container of enclave '%v' through the remote engine", args.GetEnclaveIdentifier())
+	}
+	return remoteEngineResponse, nil
+}
+
 func (service *EngineGatewayServiceServer) Clean(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.CleanArgs) (*kurtosis_engine_rpc_api_bindings.CleanResponse, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
@@ -221,6 +308,70 @@ func (service *EngineGatewayServiceServer) GetEngineInfo(ctx context.Context, em
 	return remoteEngineResponse, nil
 }
 
+func (service *EngineGatewayServiceServer) NegotiateApiVersion(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs) (*kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.NegotiateApiVersion(ctx, args)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			// Returned as is, so that the client knows that the remote engine is older than the negotiation
+			return nil, err
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred negotiating the API version with the remote engine")
+	}
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) GetEngineHealth(ctx context.Context, emptyArgs *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetEngineHealth(ctx, emptyArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine health through the remote engine")
+	}
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) UpdateEngineConfig(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs) (*emptypb.Empty, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.UpdateEngineConfig(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the engine config through the remote engine")
+	}
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) GetAuditLog(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.GetAuditLogArgs) (*kurtosis_engine_rpc_api_bindings.GetAuditLogResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetAuditLog(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the audit log through the remote engine")
+	}
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) GetServiceResourceUsage(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs) (*kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetServiceResourceUsage(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the resource usage of the services of enclave '%v' through the remote engine", args.GetEnclaveIdentifier())
+	}
+	return remoteEngineResponse, nil
+}
+
 func (service *EngineGatewayServiceServer) GetServiceLogs(
 	args *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs,
 	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_GetServiceLogsServer,
@@ -240,22 +391,44 @@ func (service *EngineGatewayServiceServer) GetServiceLogs(
 }
 
 // Private functions for managing our running enclave api container gateways
-func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*runningApiContainerGateway, error) {
+// startRunningGatewayForEnclave starts a gateway to the API container of the enclave on the given local port, or on a
+// free one if it's freeGatewayPort. It returns the running gateway if there's one already
+func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, gatewayPortNum uint16) (*runningApiContainerGateway, error) {
 	service.mutex.Lock()
 	defer service.mutex.Unlock()
 	enclaveId := enclaveInfo.GetEnclaveUuid()
-	// Ask the kernel for a free open TCP port
-	gatewayPortSpec, err := port_utils.GetFreeTcpPort(localHostIpStr)
+	if runningGateway, isRunning := service.enclaveIdToRunningGatewayMap[enclaveId]; isRunning {
+		return runningGateway, nil
+	}
+	if gatewayPortNum == freeGatewayPort {
+		// Ask the kernel for a free open TCP port
+		gatewayPortSpec, err := port_utils.GetFreeTcpPort(localHostIpStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Expected to be able to get a free, open TCP port on host, instead a non-nil error was returned")
+		}
+		gatewayPortNum = gatewayPortSpec.GetNumber()
+	}
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	// Taken before the gateway starts, as the callers then tell their clients to call the gateway without TLS
+	apiContainerTransportCredentials, err := kurtosis_context.GetApiContainerTransportCredentials(context.Background(), remoteEngineClient, enclaveInfo)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Expected to be able to get a free, open TCP port on host, instead a non-nil error was returned")
+		return nil, stacktrace.Propagate(err, "Expected to be able to get the credentials of the API container of enclave '%v', instead a non nil error was returned", enclaveId)
+	}
+	apiContainerConnection, err := service.connectionProvider.ForEnclaveApiContainer(enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to start forwarding ports to the API container of enclave '%v', instead a non nil error was returned", enclaveId)
 	}
 	// Channel for messages to stop the running server
 	gatewayStopChannel := make(chan struct{}, 1)
+	gatewayStoppedChannel := make(chan struct{})
 
 	// Info for how to connect to the api container through the gateway running on host machine
 	apiContainerHostMachineInfo := &kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerHostMachineInfo{
 		IpOnHostMachine:       localHostIpStr,
-		GrpcPortOnHostMachine: uint32(gatewayPortSpec.GetNumber()),
+		GrpcPortOnHostMachine: uint32(gatewayPortNum),
 		// TODO proxy endpoint for gateway
 	}
 
@@ -268,7 +441,8 @@ func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclave
 	// TODO: Modify MinimalGrpcServer.RunUntilStopped to take in a `ReadyChannel` to communicate when a GRPC server is ready to serve
 	// Currently, we have to make a health check request to verify that the API container gateway is ready
 	go func() {
-		if err := api_container_gateway.RunApiContainerGatewayUntilStopped(service.connectionProvider, enclaveInfo, gatewayPortSpec.GetNumber(), gatewayStopChannel); err != nil {
+		defer close(gatewayStoppedChannel)
+		if err := api_container_gateway.RunApiContainerGatewayUntilStopped(service.connectionProvider, apiContainerConnection, enclaveInfo, apiContainerTransportCredentials, gatewayPortNum, gatewayStopChannel); err != nil {
 			logrus.Warnf("Expected to run api container gateway until stopped, but the server exited prematurely with a non-nil error: '%v'", err)
 		}
 	}()
@@ -284,8 +458,11 @@ func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclave
 	}
 
 	runningGatewayInfo := &runningApiContainerGateway{
-		closeFunc:       gatewayStopFunc,
-		hostMachineInfo: apiContainerHostMachineInfo,
+		enclaveName:            enclaveInfo.GetName(),
+		closeFunc:              gatewayStopFunc,
+		hostMachineInfo:        apiContainerHostMachineInfo,
+		apiContainerConnection: apiContainerConnection,
+		stoppedChan:            gatewayStoppedChannel,
 	}
 	// Store information about our running gateway
 	service.enclaveIdToRunningGatewayMap[enclaveId] = runningGatewayInfo
@@ -301,6 +478,115 @@ func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclave
 	return runningGatewayInfo, nil
 }
 
+// syncApiContainerGatewaysBestEffort starts the gateways of the running enclaves that have none, restarts the gateways
+// that can't reach their API container anymore on the same local port, so that the clients using them keep working, and
+// kills the gateways of the enclaves that stopped or got destroyed
+func (service *EngineGatewayServiceServer) syncApiContainerGatewaysBestEffort() {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		logrus.Debugf("Not syncing the API container gateways as no engine is connected:\n%v", err)
+		return
+	}
+	ctxWithTimeout, cancelFunc := context.WithTimeout(context.Background(), apiContainerGatewaysSyncTimeout)
+	defer cancelFunc()
+	remoteEngineResponse, err := remoteEngineClient.GetEnclaves(ctxWithTimeout, &emptypb.Empty{})
+	if err != nil {
+		logrus.Warnf("An error occurred getting the enclaves from the remote engine to sync their gateways:\n%v", err)
+		return
+	}
+	enclaves := remoteEngineResponse.GetEnclaveInfo()
+
+	for enclaveId, runningGateway := range service.getRunningGateways() {
+		enclaveInfo, found := enclaves[enclaveId]
+		if !found || enclaveInfo.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+			service.idempotentKillRunningGatewayForEnclaveId(enclaveId)
+			continue
+		}
+		if runningGateway.isServing() && runningGateway.apiContainerConnection.GetStatus().State != connection.ConnectionState_Failed {
+			continue
+		}
+		gatewayPortNum := uint16(runningGateway.hostMachineInfo.GetGrpcPortOnHostMachine())
+		logrus.Warnf("The gateway for enclave '%v' lost its connection to the API container, restarting it on port '%v'", enclaveId, gatewayPortNum)
+		service.idempotentKillRunningGatewayForEnclaveId(enclaveId)
+		select {
+		case <-runningGateway.stoppedChan:
+		case <-time.After(apiContainerGatewayStopTimeout):
+			logrus.Warnf("The gateway for enclave '%v' didn't stop in time, its port might not be reusable yet", enclaveId)
+		}
+		if _, err := service.startRunningGatewayForEnclave(enclaveInfo, gatewayPortNum); err != nil {
+			logrus.Warnf("An error occurred restarting the gateway for enclave '%v', retrying in '%v':\n%v", enclaveId, apiContainerGatewaysSyncInterval, err)
+		}
+	}
+
+	runningGateways := service.getRunningGateways()
+	for enclaveId, enclaveInfo := range enclaves {
+		if enclaveInfo.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+			continue
+		}
+		if _, isRunning := runningGateways[enclaveId]; isRunning {
+			continue
+		}
+		if _, err := service.startRunningGatewayForEnclave(enclaveInfo, freeGatewayPort); err != nil {
+			logrus.Warnf("An error occurred starting a gateway for enclave '%v', retrying in '%v':\n%v", enclaveId, apiContainerGatewaysSyncInterval, err)
+		}
+	}
+}
+
+// getRunningGateways returns a copy of the running gateways, keyed by enclave ID
+// pointEnclavesToLocalGateways starts a local gateway for each enclave whose API container runs, unless one is running
+// already, and replaces where the API container can be reached with where the gateway can
+func (service *EngineGatewayServiceServer) pointEnclavesToLocalGateways(enclaveInfos []*kurtosis_engine_rpc_api_bindings.EnclaveInfo) error {
+	cleanUpRunningGateways := true
+	runningGateways := service.getRunningGateways()
+	for _, enclaveInfo := range enclaveInfos {
+		enclaveId := enclaveInfo.GetEnclaveUuid()
+		// There's nothing to connect to in the enclaves whose API container doesn't run
+		if enclaveInfo.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+			continue
+		}
+		var runningApiContainerGateway *runningApiContainerGateway
+		runningApiContainerGateway, isRunning := runningGateways[enclaveId]
+		// If the gateway isn't running, start it
+		if !isRunning {
+			var err error
+			runningApiContainerGateway, err = service.startRunningGatewayForEnclave(enclaveInfo, freeGatewayPort)
+			defer func() {
+				if cleanUpRunningGateways {
+					service.idempotentKillRunningGatewayForEnclaveId(enclaveId)
+				}
+			}()
+			if err != nil {
+				return stacktrace.Propagate(err, "Expected to be able to start a local gateway for enclave '%v', instead a non-nil error was returned", enclaveId)
+			}
+		}
+		enclaveInfo.ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+		enclaveInfo.IsApiContainerMtlsRequired = false
+	}
+
+	cleanUpRunningGateways = false
+	return nil
+}
+
+func (service *EngineGatewayServiceServer) getRunningGateways() map[string]*runningApiContainerGateway {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+	runningGateways := make(map[string]*runningApiContainerGateway, len(service.enclaveIdToRunningGatewayMap))
+	for enclaveId, runningGateway := range service.enclaveIdToRunningGatewayMap {
+		runningGateways[enclaveId] = runningGateway
+	}
+	return runningGateways
+}
+
+// isServing returns whether the gateway server still runs
+func (gateway *runningApiContainerGateway) isServing() bool {
+	select {
+	case <-gateway.stoppedChan:
+		return false
+	default:
+		return true
+	}
+}
+
 // Calls `GetServices` and waits for the gateway to be ready
 func waitForGatewayReady(apiContainerHostMachineInfo *kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerHostMachineInfo) error {
 	backgroundCtx := context.Background()
@@ -0,0 +1,110 @@
+package gateway_status
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/engine_gateway"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	StatusPath = "/status"
+
+	contentTypeHeaderKey = "Content-Type"
+	jsonContentType      = "application/json"
+)
+
+type connectionStatusResponse struct {
+	State      connection.ConnectionState `json:"state"`
+	Reconnects uint32                     `json:"reconnects"`
+	LastError  string                     `json:"last_error,omitempty"`
+}
+
+type engineStatusResponse struct {
+	EngineId   string                    `json:"engine_id,omitempty"`
+	Connection *connectionStatusResponse `json:"connection"`
+}
+
+type enclaveStatusResponse struct {
+	EnclaveUuid   string                    `json:"enclave_uuid"`
+	EnclaveName   string                    `json:"enclave_name"`
+	LocalGrpcPort uint32                    `json:"local_grpc_port"`
+	Connection    *connectionStatusResponse `json:"connection"`
+}
+
+type gatewayStatusResponse struct {
+	// Whether the engine and the API containers of all the enclaves can be reached through the gateway
+	Healthy  bool                     `json:"healthy"`
+	Engine   *engineStatusResponse    `json:"engine"`
+	Enclaves []*enclaveStatusResponse `json:"enclaves"`
+}
+
+// EngineConnectionStatusSupplier returns the ID of the engine the gateway is connected to and the status of the
+// connection, or false if it isn't connected to any engine
+type EngineConnectionStatusSupplier func() (string, connection.ConnectionStatus, bool)
+
+// ApiContainerGatewaysStatusSupplier returns the status of the gateways to the API containers of the enclaves
+type ApiContainerGatewaysStatusSupplier func() []*engine_gateway.ApiContainerGatewayStatus
+
+// NewGatewayStatusHandler returns a handler that serves the status of the connections of the gateway as JSON. It
+// responds with 503 when any of them doesn't work, so that it can be used as a health check
+func NewGatewayStatusHandler(getEngineConnectionStatus EngineConnectionStatusSupplier, getApiContainerGatewaysStatus ApiContainerGatewaysStatusSupplier) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		response := getGatewayStatus(getEngineConnectionStatus, getApiContainerGatewaysStatus)
+		writer.Header().Set(contentTypeHeaderKey, jsonContentType)
+		if response.Healthy {
+			writer.WriteHeader(http.StatusOK)
+		} else {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(writer).Encode(response); err != nil {
+			logrus.Debugf("An error occurred writing the gateway status response:\n%v", err)
+		}
+	})
+}
+
+func getGatewayStatus(getEngineConnectionStatus EngineConnectionStatusSupplier, getApiContainerGatewaysStatus ApiContainerGatewaysStatusSupplier) *gatewayStatusResponse {
+	engineId, engineConnectionStatus, isEngineConnected := getEngineConnectionStatus()
+	isHealthy := isEngineConnected && engineConnectionStatus.State == connection.ConnectionState_Connected
+	engineStatus := &engineStatusResponse{
+		EngineId:   engineId,
+		Connection: newConnectionStatusResponse(engineConnectionStatus),
+	}
+
+	enclavesStatus := []*enclaveStatusResponse{}
+	for _, gatewayStatus := range getApiContainerGatewaysStatus() {
+		if gatewayStatus.Connection.State != connection.ConnectionState_Connected {
+			isHealthy = false
+		}
+		enclavesStatus = append(enclavesStatus, &enclaveStatusResponse{
+			EnclaveUuid:   gatewayStatus.EnclaveUuid,
+			EnclaveName:   gatewayStatus.EnclaveName,
+			LocalGrpcPort: gatewayStatus.LocalGrpcPort,
+			Connection:    newConnectionStatusResponse(gatewayStatus.Connection),
+		})
+	}
+	sort.Slice(enclavesStatus, func(i, j int) bool {
+		return enclavesStatus[i].EnclaveName < enclavesStatus[j].EnclaveName
+	})
+
+	return &gatewayStatusResponse{
+		Healthy:  isHealthy,
+		Engine:   engineStatus,
+		Enclaves: enclavesStatus,
+	}
+}
+
+func newConnectionStatusResponse(connectionStatus connection.ConnectionStatus) *connectionStatusResponse {
+	lastErrorStr := ""
+	if connectionStatus.LastError != nil {
+		lastErrorStr = connectionStatus.LastError.Error()
+	}
+	return &connectionStatusResponse{
+		State:      connectionStatus.State,
+		Reconnects: connectionStatus.Reconnects,
+		LastError:  lastErrorStr,
+	}
+}
@@ -0,0 +1,91 @@
+package gateway_status
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/engine_gateway"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	engineId = "engine-id"
+)
+
+var connectedStatus = connection.ConnectionStatus{
+	State:      connection.ConnectionState_Connected,
+	Reconnects: 1,
+	LastError:  errors.New("lost connection to pod"),
+}
+
+func TestGatewayStatusHandler_Healthy(t *testing.T) {
+	getEngineConnectionStatus := func() (string, connection.ConnectionStatus, bool) {
+		return engineId, connectedStatus, true
+	}
+	getApiContainerGatewaysStatus := func() []*engine_gateway.ApiContainerGatewayStatus {
+		return []*engine_gateway.ApiContainerGatewayStatus{
+			{EnclaveUuid: "uuid-2", EnclaveName: "zebra", LocalGrpcPort: 40002, Connection: connectedStatus},
+			{EnclaveUuid: "uuid-1", EnclaveName: "ant", LocalGrpcPort: 40001, Connection: connectedStatus},
+		}
+	}
+
+	response := serveStatus(t, getEngineConnectionStatus, getApiContainerGatewaysStatus, http.StatusOK)
+	require.True(t, response.Healthy)
+	require.Equal(t, engineId, response.Engine.EngineId)
+	require.Equal(t, uint32(1), response.Engine.Connection.Reconnects)
+	require.Equal(t, "lost connection to pod", response.Engine.Connection.LastError)
+	require.Len(t, response.Enclaves, 2)
+	require.Equal(t, "ant", response.Enclaves[0].EnclaveName)
+	require.Equal(t, uint32(40001), response.Enclaves[0].LocalGrpcPort)
+	require.Equal(t, "zebra", response.Enclaves[1].EnclaveName)
+}
+
+func TestGatewayStatusHandler_UnhealthyWhenAnEnclaveIsReconnecting(t *testing.T) {
+	getEngineConnectionStatus := func() (string, connection.ConnectionStatus, bool) {
+		return engineId, connectedStatus, true
+	}
+	reconnectingStatus := connection.ConnectionStatus{
+		State:      connection.ConnectionState_Reconnecting,
+		Reconnects: 0,
+		LastError:  errors.New("lost connection to pod"),
+	}
+	getApiContainerGatewaysStatus := func() []*engine_gateway.ApiContainerGatewayStatus {
+		return []*engine_gateway.ApiContainerGatewayStatus{
+			{EnclaveUuid: "uuid-1", EnclaveName: "ant", LocalGrpcPort: 40001, Connection: reconnectingStatus},
+		}
+	}
+
+	response := serveStatus(t, getEngineConnectionStatus, getApiContainerGatewaysStatus, http.StatusServiceUnavailable)
+	require.False(t, response.Healthy)
+	require.Equal(t, connection.ConnectionState_Reconnecting, response.Enclaves[0].Connection.State)
+}
+
+func TestGatewayStatusHandler_UnhealthyWithoutEngine(t *testing.T) {
+	getEngineConnectionStatus := func() (string, connection.ConnectionStatus, bool) {
+		return "", connection.ConnectionStatus{State: connection.ConnectionState_Stopped, Reconnects: 0, LastError: nil}, false
+	}
+	getApiContainerGatewaysStatus := func() []*engine_gateway.ApiContainerGatewayStatus {
+		return nil
+	}
+
+	response := serveStatus(t, getEngineConnectionStatus, getApiContainerGatewaysStatus, http.StatusServiceUnavailable)
+	require.False(t, response.Healthy)
+	require.Empty(t, response.Engine.EngineId)
+	require.Empty(t, response.Enclaves)
+}
+
+func serveStatus(t *testing.T, getEngineConnectionStatus EngineConnectionStatusSupplier, getApiContainerGatewaysStatus ApiContainerGatewaysStatusSupplier, expectedStatusCode int) *gatewayStatusResponse {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, StatusPath, nil)
+	NewGatewayStatusHandler(getEngineConnectionStatus, getApiContainerGatewaysStatus).ServeHTTP(recorder, request)
+	require.Equal(t, expectedStatusCode, recorder.Code)
+	require.Equal(t, jsonContentType, recorder.Header().Get(contentTypeHeaderKey))
+
+	response := &gatewayStatusResponse{} // nolint: exhaustruct
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+	return response
+}
@@ -6,12 +6,14 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"os"
@@ -27,6 +29,9 @@ const (
 
 	errorPrefix     = "Error: "
 	commandNotFound = "unknown command"
+
+	// Names the CLI in the traces it exports
+	cliTracingServiceName = "kurtosis-cli"
 )
 
 func main() {
@@ -49,7 +54,17 @@ func main() {
 		CallerPrettyfier:          nil,
 	})
 
-	err := commands.RootCmd.Execute()
+	ctx := context.Background()
+	shutdownTracing, err := tracing.InitTracing(ctx, cliTracingServiceName)
+	if err != nil {
+		// tracing is a debugging aid, so the CLI runs without it rather than failing
+		logrus.Warnf("An error occurred initializing the tracing of the CLI; the commands won't be traced:\n%v", err)
+		shutdownTracing = func(_ context.Context) {}
+	}
+
+	err = commands.RootCmd.ExecuteContext(ctx)
+	// os.Exit doesn't run the deferred functions, so the spans are flushed here
+	shutdownTracing(ctx)
 	exitCode := extractExitCodeAfterExecution(err)
 	os.Exit(exitCode)
 }
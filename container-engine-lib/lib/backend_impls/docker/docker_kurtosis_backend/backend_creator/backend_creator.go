@@ -18,8 +18,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/metrics_reporting"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
 	"github.com/kurtosis-tech/stacktrace"
@@ -47,6 +49,8 @@ type APIContainerModeArgs struct {
 	EnclaveID      enclave.EnclaveUUID
 	APIContainerIP net.IP
 	IsProduction   bool
+	// How the images built and pulled by the API container are cached across enclaves and runs
+	ImageCacheConfig image_cache.ImageCacheConfig
 }
 
 var (
@@ -56,19 +60,21 @@ var (
 // GetDockerKurtosisBackend is the entrypoint method we expect users of container-engine-lib to call
 // It creates a local or remote docker backend based on the existence of a remote backend config.
 // ONLY the API container should pass in the extra API container args, which will unlock extra API container functionality
+// In air-gapped mode, the images are only pulled from the mirror of the air gap config.
 func GetDockerKurtosisBackend(
 	optionalApiContainerModeArgs *APIContainerModeArgs,
 	optionalRemoteBackendConfig *configs.KurtosisRemoteBackendConfig,
+	airGapConfig air_gap.AirGapConfig,
 ) (backend_interface.KurtosisBackend, error) {
 	var kurtosisBackend backend_interface.KurtosisBackend
 	var err error
 	if optionalRemoteBackendConfig != nil {
-		kurtosisBackend, err = getRemoteDockerKurtosisBackend(optionalApiContainerModeArgs, optionalRemoteBackendConfig)
+		kurtosisBackend, err = getRemoteDockerKurtosisBackend(optionalApiContainerModeArgs, optionalRemoteBackendConfig, airGapConfig)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating a remote Docker backend")
 		}
 	} else {
-		kurtosisBackend, err = getLocalDockerKurtosisBackend(optionalApiContainerModeArgs)
+		kurtosisBackend, err = getLocalDockerKurtosisBackend(optionalApiContainerModeArgs, airGapConfig)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating a local Docker backend")
 		}
@@ -76,10 +82,36 @@ func GetDockerKurtosisBackend(
 	return kurtosisBackend, nil
 }
 
+// GetDockerKurtosisBackendWithClientOpts creates a Docker backend on the daemon the given client options connect to, for
+// daemons neither local nor reachable on an endpoint, e.g. one reached through an SSH connection
+func GetDockerKurtosisBackendWithClientOpts(
+	optionalApiContainerModeArgs *APIContainerModeArgs,
+	dockerClientOpts []client.Opt,
+	airGapConfig air_gap.AirGapConfig,
+) (backend_interface.KurtosisBackend, error) {
+	kurtosisBackend, err := getDockerKurtosisBackend(dockerClientOpts, optionalApiContainerModeArgs, airGapConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker backend with the given client options")
+	}
+	return kurtosisBackend, nil
+}
+
 // getLocalDockerKurtosisBackend is a Docker backend running locally
 func getLocalDockerKurtosisBackend(
 	optionalApiContainerModeArgs *APIContainerModeArgs,
+	airGapConfig air_gap.AirGapConfig,
 ) (backend_interface.KurtosisBackend, error) {
+	dockerClientOpts := GetLocalDockerClientOpts()
+
+	localDockerBackend, err := getDockerKurtosisBackend(dockerClientOpts, optionalApiContainerModeArgs, airGapConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to build local Kurtosis Docker backend")
+	}
+	return localDockerBackend, nil
+}
+
+// GetLocalDockerClientOpts returns the options of a client of the Docker daemon the local Docker backend runs on
+func GetLocalDockerClientOpts() []client.Opt {
 	dockerClientOpts := []client.Opt{
 		client.WithAPIVersionNegotiation(),
 	}
@@ -109,25 +141,21 @@ func getLocalDockerKurtosisBackend(
 			client.EnvOverrideHost)
 		dockerClientOpts = append(dockerClientOpts, client.FromEnv)
 	}
-
-	localDockerBackend, err := getDockerKurtosisBackend(dockerClientOpts, optionalApiContainerModeArgs)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Unable to build local Kurtosis Docker backend")
-	}
-	return localDockerBackend, nil
+	return dockerClientOpts
 }
 
 // getRemoteDockerKurtosisBackend is a Docker backend running on a remote host
 func getRemoteDockerKurtosisBackend(
 	optionalApiContainerModeArgs *APIContainerModeArgs,
 	remoteBackendConfig *configs.KurtosisRemoteBackendConfig,
+	airGapConfig air_gap.AirGapConfig,
 ) (backend_interface.KurtosisBackend, error) {
 	remoteDockerClientOpts, cleanCertFilesFunc, err := buildRemoteDockerClientOpts(remoteBackendConfig)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error building client configuration for Docker remote backend")
 	}
 	defer cleanCertFilesFunc()
-	kurtosisRemoteBackend, err := getDockerKurtosisBackend(remoteDockerClientOpts, optionalApiContainerModeArgs)
+	kurtosisRemoteBackend, err := getDockerKurtosisBackend(remoteDockerClientOpts, optionalApiContainerModeArgs, airGapConfig)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error building Kurtosis remote Docker backend")
 	}
@@ -192,11 +220,13 @@ func writeTlsConfigToTempDir(ca []byte, cert []byte, key []byte) (string, func()
 func getDockerKurtosisBackend(
 	dockerClientOpts []client.Opt,
 	optionalApiContainerModeArgs *APIContainerModeArgs,
+	airGapConfig air_gap.AirGapConfig,
 ) (backend_interface.KurtosisBackend, error) {
 	dockerManager, err := docker_manager.CreateDockerManager(dockerClientOpts)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred building docker manager")
 	}
+	dockerManager.SetAirGapConfig(airGapConfig)
 
 	// If running within the API container context, detect the network that the API container is running inside
 	// so, we can create the free IP address trackers
@@ -206,6 +236,7 @@ func getDockerKurtosisBackend(
 	var serviceRegistrationRepository *service_registration.ServiceRegistrationRepository
 	if optionalApiContainerModeArgs != nil {
 		productionMode = optionalApiContainerModeArgs.IsProduction
+		dockerManager.SetImageCacheConfig(optionalApiContainerModeArgs.ImageCacheConfig)
 		// using the noEnclaveDatabaseDirpath because at this point we know that the enclave database has been created, so we are getting it from this call
 		noEnclaveDatabaseDirpath := ""
 		enclaveDb, err := enclave_db.GetOrCreateEnclaveDatabase(noEnclaveDatabaseDirpath)
@@ -35,6 +35,9 @@ const (
 	GitHubAuthStorageDirPath   = "/kurtosis-data/github-auth/"
 	DockerConfigStorageDirPath = "/root/.docker/"
 
+	// Shared by the API containers of all the enclaves, so that they reuse the Starlark modules compiled by each other
+	StarlarkProgramCacheDirPath = "/kurtosis-data/starlark-program-cache/"
+
 	EmptyApplicationURL = ""
 )
 
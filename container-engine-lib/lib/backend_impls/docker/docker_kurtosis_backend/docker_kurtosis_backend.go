@@ -3,8 +3,11 @@ package docker_kurtosis_backend
 import (
 	"context"
 	"io"
+	"net"
 	"sync"
 
+	dockertypes "github.com/docker/docker/api/types"
+
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
@@ -94,12 +97,9 @@ func (backend *DockerKurtosisBackend) FetchImage(ctx context.Context, image stri
 
 func (backend *DockerKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
 	prunedImages, err := backend.dockerManager.PruneUnusedImages(ctx)
-	prunedImageNames := []string{}
-	for _, prunedImage := range prunedImages {
-		if lenPrunedImageTags := len(prunedImage.RepoTags); lenPrunedImageTags != 1 {
-			return nil, stacktrace.NewError("Expected exactly one repo tag, but found %d (%v). This is a bug in Kurtosis.", lenPrunedImageTags, prunedImage.RepoTags)
-		}
-		prunedImageNames = append(prunedImageNames, prunedImage.RepoTags[0])
+	prunedImageNames, namesErr := getImageNames(prunedImages)
+	if namesErr != nil {
+		return nil, stacktrace.Propagate(namesErr, "An error occurred getting the names of the pruned images")
 	}
 	if err != nil {
 		return prunedImageNames, stacktrace.Propagate(err, "An error occurred pruning image from kurtosis backend")
@@ -107,6 +107,18 @@ func (backend *DockerKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]
 	return prunedImageNames, nil
 }
 
+func (backend *DockerKurtosisBackend) ListUnusedImages(ctx context.Context) ([]string, error) {
+	unusedImages, err := backend.dockerManager.ListUnusedImages(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the unused images")
+	}
+	unusedImageNames, err := getImageNames(unusedImages)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the names of the unused images")
+	}
+	return unusedImageNames, nil
+}
+
 func (backend *DockerKurtosisBackend) CreateEngine(
 	ctx context.Context,
 	imageOrgAndRepo string,
@@ -117,8 +129,12 @@ func (backend *DockerKurtosisBackend) CreateEngine(
 	gitAuthToken string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	logsCollectorFilters []logs_collector.Filter, // ignored on docker backend for create engine
 	logsCollectorParsers []logs_collector.Parser, // ignored on docker backend for create engine
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig, // ignored on docker backend for create engine
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig, // ignored on docker backend for create engine
+	logsCollectorBufferConfig logs_collector.BufferConfig, // ignored on docker backend for create engine
 ) (
 	*engine.Engine,
 	error,
@@ -133,6 +149,7 @@ func (backend *DockerKurtosisBackend) CreateEngine(
 		gitAuthToken,
 		sinks,
 		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
 		backend.dockerManager,
 		backend.objAttrsProvider,
 	)
@@ -230,13 +247,21 @@ func (backend *DockerKurtosisBackend) StartRegisteredUserServices(ctx context.Co
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs collector")
 	}
-	if logsCollector == nil || logsCollector.GetStatus() != container.ContainerStatus_Running {
-		return nil, nil, stacktrace.NewError("The user services can't be started because no logs collector is running for it to send logs to.")
-	}
-
-	logsCollectorIpAddressInEnclaveNetwork := logsCollector.GetEnclaveNetworkIpAddress()
-	if logsCollectorIpAddressInEnclaveNetwork == nil {
-		return nil, nil, stacktrace.NewError("Expected the logs collector to have an ip address in the enclave network but it does not.")
+	// Enclaves created without logs collection have no logs collector at all, their services keep their logs in the
+	// container engine instead
+	var logsCollectorIpAddressInEnclaveNetwork net.IP
+	var logsCollectorHttpPortNum uint16
+	var logsCollectorHealthCheckEndpoint string
+	if logsCollector != nil {
+		if logsCollector.GetStatus() != container.ContainerStatus_Running {
+			return nil, nil, stacktrace.NewError("The user services can't be started because no logs collector is running for it to send logs to.")
+		}
+		logsCollectorIpAddressInEnclaveNetwork = logsCollector.GetEnclaveNetworkIpAddress()
+		if logsCollectorIpAddressInEnclaveNetwork == nil {
+			return nil, nil, stacktrace.NewError("Expected the logs collector to have an ip address in the enclave network but it does not.")
+		}
+		logsCollectorHttpPortNum = logsCollector.GetPrivateHttpPort().GetNumber()
+		logsCollectorHealthCheckEndpoint = fluentbit.NewFluentbitLogsCollectorContainer().GetHttpHealthCheckEndpoint()
 	}
 
 	var restartPolicy docker_manager.RestartPolicy = docker_manager.NoRestart
@@ -251,8 +276,8 @@ func (backend *DockerKurtosisBackend) StartRegisteredUserServices(ctx context.Co
 		backend.serviceRegistrationRepository,
 		logsCollector,
 		logsCollectorIpAddressInEnclaveNetwork,
-		logsCollector.GetPrivateHttpPort().GetNumber(),
-		fluentbit.NewFluentbitLogsCollectorContainer().GetHttpHealthCheckEndpoint(),
+		logsCollectorHttpPortNum,
+		logsCollectorHealthCheckEndpoint,
 		backend.objAttrsProvider,
 		freeIpAddrProviderForEnclave,
 		backend.dockerManager,
@@ -300,6 +325,30 @@ func (backend *DockerKurtosisBackend) GetUserServiceLogs(
 	return user_service_functions.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) GetUserServiceResourceUsage(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ResourceUsage,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.GetUserServiceResourceUsage(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) GetUserServiceExitStates(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ExitState,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.GetUserServiceExitStates(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
 // NOTE: This function will block while the exec is ongoing; if we need more perf we can make it async
 func (backend *DockerKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
@@ -323,8 +372,8 @@ func (backend *DockerKurtosisBackend) RunUserServiceExecCommandWithStreamedOutpu
 	return user_service_functions.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd, backend.dockerManager)
 }
 
-func (backend *DockerKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) error {
-	return user_service_functions.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
+func (backend *DockerKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions) (isSessionDisconnected bool, resultErr error) {
+	return user_service_functions.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, shellOptions, backend.dockerManager)
 }
 
 // It returns io.ReadCloser which is a tar stream. It's up to the caller to close the reader.
@@ -338,6 +387,52 @@ func (backend *DockerKurtosisBackend) CopyFilesFromUserService(
 	return user_service_functions.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnContainer, output, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnContainer string,
+	tarContent io.Reader,
+) error {
+	return user_service_functions.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpathOnContainer, tarContent, backend.dockerManager)
+}
+
+// CopyFilesFromImage creates a container from the image without ever starting it, and copies the files from it
+func (backend *DockerKurtosisBackend) CopyFilesFromImage(
+	ctx context.Context,
+	image string,
+	srcPathOnImage string,
+	output io.Writer,
+) error {
+	// No registry spec here, the image is expected to be public or already available locally
+	if _, _, err := backend.dockerManager.FetchImage(ctx, image, nil, image_download_mode.ImageDownloadMode_Missing); err != nil {
+		return stacktrace.Propagate(err, "An error occurred fetching image '%v'", image)
+	}
+
+	containerId, err := backend.dockerManager.CreateContainerWithoutStarting(ctx, image)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a container from image '%v'", image)
+	}
+	defer func() {
+		// The context might already be cancelled here, but the container has to be removed regardless
+		if err := backend.dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("An error occurred removing container '%v' created to copy files from image '%v'. Error was:\n%v", containerId, image, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the container with ID '%v'!!!!!!", containerId)
+		}
+	}()
+
+	tarStreamReadCloser, err := backend.dockerManager.CopyFromContainer(ctx, containerId, srcPathOnImage)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content from source path '%v' in image '%v'", srcPathOnImage, image)
+	}
+	defer tarStreamReadCloser.Close()
+
+	if numBytesCopied, err := io.Copy(output, tarStreamReadCloser); err != nil {
+		return stacktrace.Propagate(err, "'%v' bytes copied before an error occurred copying the bytes of TAR'd up files at '%v' in image '%v' to the output", numBytesCopied, srcPathOnImage, image)
+	}
+	return nil
+}
+
 func (backend *DockerKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -395,6 +490,7 @@ func (backend *DockerKurtosisBackend) CreateLogsAggregator(
 		httpPortNum,
 		sinks,
 		defaultShouldEnablePersistentVolumeLogsCollection,
+		logs_aggregator.NewDisabledLogsEncryptionConfig(),
 		backend.dockerManager,
 		backend.objAttrsProvider,
 	)
@@ -424,6 +520,28 @@ func (backend *DockerKurtosisBackend) DestroyLogsAggregator(ctx context.Context)
 	return nil
 }
 
+func (backend *DockerKurtosisBackend) UpdateLogsAggregatorSinks(
+	ctx context.Context,
+	sinks logs_aggregator.Sinks,
+	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
+) error {
+	logsAggregatorContainer := vector.NewVectorLogsAggregatorContainer() //Declaring the implementation
+
+	if err := logs_aggregator_functions.UpdateLogsAggregatorSinks(
+		ctx,
+		logsAggregatorContainer,
+		sinks,
+		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
+		backend.dockerManager,
+		backend.objAttrsProvider,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the sinks of the logs aggregator")
+	}
+	return nil
+}
+
 func (backend *DockerKurtosisBackend) CreateLogsCollectorForEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -431,6 +549,9 @@ func (backend *DockerKurtosisBackend) CreateLogsCollectorForEnclave(
 	logsCollectorHttpPortNumber uint16,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig, // ignored on docker backend, whose hosts have no nodes
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig, // ignored on docker backend, whose hosts have no nodes
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) (
 	*logs_collector.LogsCollector,
 	error,
@@ -468,6 +589,7 @@ func (backend *DockerKurtosisBackend) CreateLogsCollectorForEnclave(
 		logsAggregator,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorBufferConfig,
 		backend.dockerManager,
 		backend.objAttrsProvider,
 	)
@@ -492,6 +614,14 @@ func (backend *DockerKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Con
 	return maybeLogsCollector, nil
 }
 
+func (backend *DockerKurtosisBackend) GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error) {
+	logsCollectorStatuses, err := logs_collector_functions.GetLogsCollectorStatuses(ctx, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the statuses of the logs collectors")
+	}
+	return logsCollectorStatuses, nil
+}
+
 func (backend *DockerKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
 
 	if err := logs_collector_functions.DestroyLogsCollector(ctx, enclaveUuid, backend.dockerManager); err != nil {
@@ -501,6 +631,18 @@ func (backend *DockerKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context
 	return nil
 }
 
+func (backend *DockerKurtosisBackend) UpdateLogsCollectorFiltersForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters []logs_collector.Filter) error {
+	if err := logs_collector.ValidateEnclaveFilters(filters); err != nil {
+		return stacktrace.Propagate(err, "The logs collector filters of enclave '%v' are invalid", enclaveUuid)
+	}
+	logsCollectorContainer := fluentbit.NewFluentbitLogsCollectorContainer()
+
+	if err := logs_collector_functions.UpdateLogsCollectorEnclaveFilters(ctx, enclaveUuid, filters, logsCollectorContainer, backend.dockerManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the filters of the logs collector of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
 func (backend *DockerKurtosisBackend) CreateReverseProxy(ctx context.Context, engineGuid engine.EngineGUID) (*reverse_proxy.ReverseProxy, error) {
 	reverseProxyContainer := traefik.NewTraefikReverseProxyContainer()
 
@@ -656,3 +798,34 @@ func (backend *DockerKurtosisBackend) getDockerConfigStorageVolume(ctx context.C
 	volume := foundVolumes[0]
 	return volume.Name, nil
 }
+
+// Returns an empty name if the engine was started before the Starlark program cache volume existed, in which case each
+// API container only caches the programs in its enclave data volume
+func (backend *DockerKurtosisBackend) getStarlarkProgramCacheVolume(ctx context.Context) (string, error) {
+	volumeSearchLabels := map[string]string{
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): label_value_consts.StarlarkProgramCacheVolumeTypeDockerLabelValue.GetString(),
+	}
+	foundVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, volumeSearchLabels)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting Starlark program cache volumes matching labels '%+v'", volumeSearchLabels)
+	}
+	if len(foundVolumes) > 1 {
+		return "", stacktrace.NewError("Found multiple Starlark program cache volumes. This should never happen")
+	}
+	if len(foundVolumes) == 0 {
+		return "", nil
+	}
+	volume := foundVolumes[0]
+	return volume.Name, nil
+}
+
+func getImageNames(images []dockertypes.ImageSummary) ([]string, error) {
+	imageNames := []string{}
+	for _, image := range images {
+		if lenImageTags := len(image.RepoTags); lenImageTags != 1 {
+			return nil, stacktrace.NewError("Expected exactly one repo tag, but found %d (%v). This is a bug in Kurtosis.", lenImageTags, image.RepoTags)
+		}
+		imageNames = append(imageNames, image.RepoTags[0])
+	}
+	return imageNames, nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/network_helpers"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -87,6 +88,11 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 		return nil, stacktrace.Propagate(err, "An error occurred getting the Docker config storage volume name.")
 	}
 
+	maybeStarlarkProgramCacheVolumeName, err := backend.getStarlarkProgramCacheVolume(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Starlark program cache volume name.")
+	}
+
 	// Get the Docker network ID where we'll start the new API container
 	enclaveNetwork, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid)
 	if err != nil {
@@ -201,11 +207,20 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 		githubAuthStorageVolumeName:   consts.GitHubAuthStorageDirPath,
 		dockerConfigStorageVolumeName: consts.DockerConfigStorageDirPath,
 	}
+	if maybeStarlarkProgramCacheVolumeName != "" {
+		volumeMounts[maybeStarlarkProgramCacheVolumeName] = consts.StarlarkProgramCacheDirPath
+	}
 
 	labelStrs := map[string]string{}
 	for labelKey, labelValue := range apiContainerAttrs.GetLabels() {
 		labelStrs[labelKey.GetString()] = labelValue.GetString()
 	}
+	// The arguments of the API container carry the credentials of the stores and of the secrets provider it uses
+	customEnvVarNames := []string{}
+	for customEnvVarName := range customEnvVars {
+		customEnvVarNames = append(customEnvVarNames, customEnvVarName)
+	}
+	labelStrs[docker_label_key.SecretEnvVarsDockerLabelKey.GetString()] = secrets_provider.SerializeSecretEnvVarNames(customEnvVarNames)
 	// TODO: configure the APIContainer to send the logs to the Fluentbit logs collector server
 
 	createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
@@ -2,11 +2,13 @@ package docker_kurtosis_backend
 
 import (
 	"context"
+	"net"
 	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types/volume"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/egress_policy_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
@@ -15,7 +17,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
@@ -353,7 +358,17 @@ func (backend *DockerKurtosisBackend) DestroyEnclaves(
 
 	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
 
-	successfulContainerRemovalEnclaveUuids, erroredContainerRemovalEnclaveUuids, err := destroyContainersInEnclaves(ctx, backend.dockerManager, matchingNetworkInfo)
+	// The egress policy rules outlive the enclave containers, so they must go before the enclave subnet can be reused
+	enclavesToDestroyContainers := map[enclave.EnclaveUUID]*matchingNetworkInformation{}
+	for enclaveUuid, networkInfo := range matchingNetworkInfo {
+		if err := egress_policy_functions.RemoveEgressPolicyRules(ctx, enclaveUuid, networkInfo.dockerNetwork.GetIpAndMask(), networkInfo.containers, backend.dockerManager); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred removing the egress policy rules of enclave '%v'", enclaveUuid)
+			continue
+		}
+		enclavesToDestroyContainers[enclaveUuid] = networkInfo
+	}
+
+	successfulContainerRemovalEnclaveUuids, erroredContainerRemovalEnclaveUuids, err := destroyContainersInEnclaves(ctx, backend.dockerManager, enclavesToDestroyContainers)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying containers in enclaves matching filters '%+v'", filters)
 	}
@@ -425,6 +440,60 @@ func (backend *DockerKurtosisBackend) UpdateEnclave(
 	return stacktrace.NewError("UpdateEnclave isn't implemented for Docker yet")
 }
 
+// Docker has no way to cap the resources of a whole network, so enclave quotas are only enforced by the API container
+func (backend *DockerKurtosisBackend) CreateEnclaveQuota(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	quota enclave_quota.EnclaveQuota,
+) error {
+	return nil
+}
+
+// CreateEnclaveEgressPolicy starts a container installing iptables rules on the Docker host that reject the traffic
+// leaving the enclave network, unless it goes to the allowed CIDRs or to DNS servers. The allowed domains get resolved
+// to CIDRs once, when the policy gets created. The API container and the logs collector are left unrestricted
+func (backend *DockerKurtosisBackend) CreateEnclaveEgressPolicy(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	egressPolicy *egress_policy.EgressPolicy,
+) error {
+	if egressPolicy == nil {
+		return nil
+	}
+
+	allowedCidrs, err := egressPolicy.ResolveAllowedCidrs(ctx, net.DefaultResolver)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred resolving the CIDRs allowed by the egress policy of enclave '%v'", enclaveUuid)
+	}
+
+	exemptedSourceIps := []net.IP{}
+	apiContainerFilters := &api_container.APIContainerFilters{
+		EnclaveIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		Statuses: nil,
+	}
+	apiContainers, err := backend.GetAPIContainers(ctx, apiContainerFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+	for _, apiContainer := range apiContainers {
+		exemptedSourceIps = append(exemptedSourceIps, apiContainer.GetPrivateIPAddress())
+	}
+	maybeLogsCollector, err := backend.GetLogsCollectorForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs collector of enclave '%v'", enclaveUuid)
+	}
+	if maybeLogsCollector != nil && maybeLogsCollector.GetEnclaveNetworkIpAddress() != nil {
+		exemptedSourceIps = append(exemptedSourceIps, maybeLogsCollector.GetEnclaveNetworkIpAddress())
+	}
+
+	if err := egress_policy_functions.CreateEgressPolicyEnforcer(ctx, enclaveUuid, exemptedSourceIps, allowedCidrs, backend.objAttrsProvider, backend.dockerManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the egress policy enforcer of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -605,25 +674,31 @@ func (backend *DockerKurtosisBackend) disconnectExternalContainersFromEnclaveNet
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
-func getAllEnclaveVolumes(
+// getVolumesByEnclave lists the volumes of the enclaves with a single call, rather than one per enclave, which adds up
+// when destroying many enclaves
+func getVolumesByEnclave(
 	ctx context.Context,
 	dockerManager *docker_manager.DockerManager,
-	enclaveUuid enclave.EnclaveUUID,
-) ([]*volume.Volume, error) {
-
-	var volumes []*volume.Volume
-
+	enclaveUuids map[enclave.EnclaveUUID]bool,
+) (map[enclave.EnclaveUUID][]*volume.Volume, error) {
 	searchLabels := map[string]string{
-		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
-		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+		docker_label_key.AppIDDockerLabelKey.GetString(): label_value_consts.AppIDDockerLabelValue.GetString(),
 	}
 
 	volumes, err := dockerManager.GetVolumesByLabels(ctx, searchLabels)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting the volumes for enclave '%v' by labels '%+v'", enclaveUuid, searchLabels)
+		return nil, stacktrace.Propagate(err, "An error occurred getting the volumes by labels '%+v'", searchLabels)
 	}
 
-	return volumes, nil
+	result := map[enclave.EnclaveUUID][]*volume.Volume{}
+	for _, enclaveVolume := range volumes {
+		enclaveUuid := enclave.EnclaveUUID(enclaveVolume.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()])
+		if _, found := enclaveUuids[enclaveUuid]; !found {
+			continue
+		}
+		result[enclaveUuid] = append(result[enclaveUuid], enclaveVolume)
+	}
+	return result, nil
 }
 
 func destroyContainersInEnclaves(
@@ -706,13 +781,12 @@ func destroyVolumesInEnclaves(
 	// After we've tried to destroy all the containers from the enclaves, take the successful ones and destroy their volumes
 	enclaveUuidsForVolumeIdsToRemove := map[string]enclave.EnclaveUUID{}
 	volumeIdsToRemove := map[string]bool{}
-	for enclaveUuid := range enclaves {
-		enclaveVolumeIds, err := getAllEnclaveVolumes(ctx, dockerManager, enclaveUuid)
-		if err != nil {
-			return nil, nil, stacktrace.Propagate(err, "An error occurred getting the volumes for enclave '%v'", enclaveUuid)
-		}
-
-		for _, volume := range enclaveVolumeIds {
+	volumesByEnclave, err := getVolumesByEnclave(ctx, dockerManager, enclaves)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the volumes for enclaves '%+v'", enclaves)
+	}
+	for enclaveUuid, enclaveVolumes := range volumesByEnclave {
+		for _, volume := range enclaveVolumes {
 			volumeId := volume.Name
 			enclaveUuidsForVolumeIdsToRemove[volumeId] = enclaveUuid
 			volumeIdsToRemove[volumeId] = true
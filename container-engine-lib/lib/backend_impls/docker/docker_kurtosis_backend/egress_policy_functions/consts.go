@@ -0,0 +1,23 @@
+package egress_policy_functions
+
+const (
+	// The enforcer only needs a shell and the iptables binaries, which get installed when the rules get applied
+	enforcerContainerImage = "alpine:3.17"
+
+	shBinaryFilepath = "/bin/sh"
+	shCmdFlag        = "-c"
+
+	// Keeps the enforcer around so the rules can be removed from it when the enclave gets destroyed
+	sleepForeverCmd = "trap 'exit 0' TERM; while true; do sleep 3600 & wait $!; done"
+
+	rulesCmdSuccessExitCode = 0
+
+	// Docker evaluates this chain before its own forwarding rules, and never flushes it
+	dockerUserChainName = "DOCKER-USER"
+
+	// iptables caps chain names to 28 characters
+	enforcerChainNamePrefix     = "KT-EGRESS-"
+	enforcerChainNameUuidLength = 12
+
+	dnsPortNum = 53
+)
@@ -0,0 +1,109 @@
+package egress_policy_functions
+
+import (
+	"bytes"
+	"context"
+	"net"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateEgressPolicyEnforcer starts the container holding the iptables rules that restrict the outbound traffic of the
+// enclave to the allowed CIDRs. The container shares the network namespace of the Docker host, where the rules live
+func CreateEgressPolicyEnforcer(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	exemptedSourceIps []net.IP,
+	allowedCidrs []*net.IPNet,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the object attributes provider for enclave '%v'", enclaveUuid)
+	}
+	enforcerAttrs, err := enclaveObjAttrsProvider.ForEgressPolicyEnforcerContainer()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the egress policy enforcer container attributes for enclave '%v'", enclaveUuid)
+	}
+	containerName := enforcerAttrs.GetName().GetString()
+	containerLabelStrs := map[string]string{}
+	for labelKey, labelValue := range enforcerAttrs.GetLabels() {
+		containerLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		enforcerContainerImage,
+		containerName,
+		enclaveNetwork.GetId(),
+	).WithNetworkMode(
+		docker_manager.HostNetworkMode,
+	).WithAddedCapabilities(map[docker_manager.ContainerCapability]bool{
+		docker_manager.NetAdmin: true,
+	}).WithEntrypointArgs([]string{
+		shBinaryFilepath,
+		shCmdFlag,
+		sleepForeverCmd,
+	}).WithLabels(
+		containerLabelStrs,
+	).WithFetchingLatestImageIfMissing().Build()
+
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the egress policy enforcer container with these args '%+v'", createAndStartArgs)
+	}
+	shouldRemoveContainer := true
+	defer func() {
+		if shouldRemoveContainer {
+			if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+				logrus.Errorf(
+					"Creating the egress policy enforcer of enclave '%v' didn't complete successfully so we tried to "+
+						"remove the container we started, but doing so exited with an error:\n%v",
+					enclaveUuid,
+					err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the container with ID '%v'!!!!!!", containerId)
+			}
+		}
+	}()
+
+	applyRulesScript := getApplyRulesScript(enclaveUuid, enclaveNetwork.GetIpAndMask(), exemptedSourceIps, allowedCidrs)
+	if err := runRulesScript(ctx, dockerManager, containerId, applyRulesScript); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the egress policy rules of enclave '%v'", enclaveUuid)
+	}
+
+	shouldRemoveContainer = false
+	return nil
+}
+
+func runRulesScript(ctx context.Context, dockerManager *docker_manager.DockerManager, containerId string, script string) error {
+	execCmd := []string{
+		shBinaryFilepath,
+		shCmdFlag,
+		script,
+	}
+	outputBuffer := &bytes.Buffer{}
+	exitCode, err := dockerManager.RunUserServiceExecCommands(ctx, containerId, "", execCmd, outputBuffer)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the rules script in the egress policy enforcer container with ID '%v'", containerId)
+	}
+	if exitCode != rulesCmdSuccessExitCode {
+		return stacktrace.NewError(
+			"The rules script in the egress policy enforcer container with ID '%v' exited with non-%v exit code '%v' and logs:\n%v",
+			containerId,
+			rulesCmdSuccessExitCode,
+			exitCode,
+			outputBuffer.String(),
+		)
+	}
+	return nil
+}
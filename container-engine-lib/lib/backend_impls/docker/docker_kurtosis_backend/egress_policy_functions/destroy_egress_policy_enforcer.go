@@ -0,0 +1,49 @@
+package egress_policy_functions
+
+import (
+	"context"
+	"net"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// RemoveEgressPolicyRules removes the iptables rules of the enclave using its enforcer container, if any among the
+// given enclave containers. The container itself gets removed along with the rest of the enclave containers
+func RemoveEgressPolicyRules(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveSubnet *net.IPNet,
+	enclaveContainers []*types.Container,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	for _, enclaveContainer := range enclaveContainers {
+		containerType, found := enclaveContainer.GetLabels()[docker_label_key.ContainerTypeDockerLabelKey.GetString()]
+		if !found || containerType != label_value_consts.EgressPolicyEnforcerContainerTypeDockerLabelValue.GetString() {
+			continue
+		}
+		containerId := enclaveContainer.GetId()
+
+		// A stopped enclave has a stopped enforcer, but its rules are still in place
+		isContainerRunning, found := consts.IsContainerRunningDeterminer[enclaveContainer.GetStatus()]
+		if !found {
+			return stacktrace.NewError("No is-running designation found for egress policy enforcer container status '%v'; this is a bug in Kurtosis!", enclaveContainer.GetStatus().String())
+		}
+		if !isContainerRunning {
+			if err := dockerManager.StartContainer(ctx, containerId); err != nil {
+				return stacktrace.Propagate(err, "An error occurred starting the egress policy enforcer container with ID '%v' of enclave '%v'", containerId, enclaveUuid)
+			}
+		}
+
+		removeRulesScript := getRemoveRulesScript(enclaveUuid, enclaveSubnet)
+		if err := runRulesScript(ctx, dockerManager, containerId, removeRulesScript); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the egress policy rules of enclave '%v'", enclaveUuid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package egress_policy_functions
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+)
+
+// The host may use either the legacy or the nftables flavour of iptables; the one Docker wrote its chains with is the
+// one holding the DOCKER-USER chain
+var selectIptablesBinaryScript = strings.Join([]string{
+	"command -v iptables-legacy >/dev/null 2>&1 || apk add --no-cache iptables >/dev/null",
+	"IPT=iptables-legacy",
+	fmt.Sprintf("iptables-nft -S %s >/dev/null 2>&1 && IPT=iptables-nft", dockerUserChainName),
+}, "\n")
+
+// getApplyRulesScript returns a script creating a chain that rejects the traffic leaving the enclave subnet, unless it
+// goes to an allowed CIDR or a DNS server, comes from one of the exempted sources (e.g. the API container), or belongs
+// to a connection opened from the outside. The script is idempotent so it can be run again on the same enclave
+func getApplyRulesScript(
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveSubnet *net.IPNet,
+	exemptedSourceIps []net.IP,
+	allowedCidrs []*net.IPNet,
+) string {
+	chainName := getChainName(enclaveUuid)
+	subnetStr := enclaveSubnet.String()
+
+	lines := []string{
+		"set -e",
+		selectIptablesBinaryScript,
+		fmt.Sprintf("$IPT -N %s 2>/dev/null || $IPT -F %s", chainName, chainName),
+		fmt.Sprintf("$IPT -A %s -m conntrack --ctstate ESTABLISHED,RELATED -j RETURN", chainName),
+		fmt.Sprintf("$IPT -A %s -d %s -j RETURN", chainName, subnetStr),
+	}
+	for _, exemptedSourceIp := range exemptedSourceIps {
+		lines = append(lines, fmt.Sprintf("$IPT -A %s -s %s -j RETURN", chainName, exemptedSourceIp.String()))
+	}
+	lines = append(
+		lines,
+		fmt.Sprintf("$IPT -A %s -p udp --dport %d -j RETURN", chainName, dnsPortNum),
+		fmt.Sprintf("$IPT -A %s -p tcp --dport %d -j RETURN", chainName, dnsPortNum),
+	)
+	for _, allowedCidr := range allowedCidrs {
+		// The enclave networks are IPv4 only, so IPv6 destinations can't be reached from them anyway
+		if allowedCidr.IP.To4() == nil {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("$IPT -A %s -d %s -j RETURN", chainName, allowedCidr.String()))
+	}
+	lines = append(
+		lines,
+		fmt.Sprintf("$IPT -A %s -j REJECT", chainName),
+		fmt.Sprintf(
+			"$IPT -C %s -s %s -j %s 2>/dev/null || $IPT -I %s -s %s -j %s",
+			dockerUserChainName, subnetStr, chainName,
+			dockerUserChainName, subnetStr, chainName,
+		),
+	)
+	return strings.Join(lines, "\n")
+}
+
+// getRemoveRulesScript returns a script undoing the one of getApplyRulesScript, which must happen before the enclave
+// subnet gets handed to another network
+func getRemoveRulesScript(enclaveUuid enclave.EnclaveUUID, enclaveSubnet *net.IPNet) string {
+	chainName := getChainName(enclaveUuid)
+	lines := []string{
+		selectIptablesBinaryScript,
+		fmt.Sprintf("while $IPT -D %s -s %s -j %s 2>/dev/null; do :; done", dockerUserChainName, enclaveSubnet.String(), chainName),
+		fmt.Sprintf("$IPT -F %s 2>/dev/null || true", chainName),
+		fmt.Sprintf("$IPT -X %s 2>/dev/null || true", chainName),
+	}
+	return strings.Join(lines, "\n")
+}
+
+func getChainName(enclaveUuid enclave.EnclaveUUID) string {
+	uuidStr := string(enclaveUuid)
+	if len(uuidStr) > enforcerChainNameUuidLength {
+		uuidStr = uuidStr[:enforcerChainNameUuidLength]
+	}
+	return enforcerChainNamePrefix + uuidStr
+}
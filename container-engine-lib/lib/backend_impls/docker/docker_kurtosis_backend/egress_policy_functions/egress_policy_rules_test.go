@@ -0,0 +1,53 @@
+package egress_policy_functions
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("65d2fb6d673249b8b4a91a2778854d3e")
+)
+
+func TestGetApplyRulesScript(t *testing.T) {
+	_, enclaveSubnet, err := net.ParseCIDR("172.16.4.0/22")
+	require.NoError(t, err)
+	_, allowedIpv4Cidr, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	_, allowedIpv6Cidr, err := net.ParseCIDR("2001:db8::/32")
+	require.NoError(t, err)
+	apiContainerIp := net.ParseIP("172.16.4.3")
+
+	script := getApplyRulesScript(testEnclaveUuid, enclaveSubnet, []net.IP{apiContainerIp}, []*net.IPNet{allowedIpv4Cidr, allowedIpv6Cidr})
+	lines := strings.Split(script, "\n")
+
+	require.Contains(t, lines, "$IPT -N KT-EGRESS-65d2fb6d6732 2>/dev/null || $IPT -F KT-EGRESS-65d2fb6d6732")
+	require.Contains(t, lines, "$IPT -A KT-EGRESS-65d2fb6d6732 -d 172.16.4.0/22 -j RETURN")
+	require.Contains(t, lines, "$IPT -A KT-EGRESS-65d2fb6d6732 -s 172.16.4.3 -j RETURN")
+	require.Contains(t, lines, "$IPT -A KT-EGRESS-65d2fb6d6732 -p udp --dport 53 -j RETURN")
+	require.Contains(t, lines, "$IPT -A KT-EGRESS-65d2fb6d6732 -d 10.0.0.0/8 -j RETURN")
+	require.NotContains(t, script, "2001:db8::/32")
+
+	// The reject must come after every allowed destination, and the jump after the chain is complete
+	require.Equal(t, "$IPT -A KT-EGRESS-65d2fb6d6732 -j REJECT", lines[len(lines)-2])
+	require.Equal(
+		t,
+		"$IPT -C DOCKER-USER -s 172.16.4.0/22 -j KT-EGRESS-65d2fb6d6732 2>/dev/null || $IPT -I DOCKER-USER -s 172.16.4.0/22 -j KT-EGRESS-65d2fb6d6732",
+		lines[len(lines)-1],
+	)
+}
+
+func TestGetRemoveRulesScript(t *testing.T) {
+	_, enclaveSubnet, err := net.ParseCIDR("172.16.4.0/22")
+	require.NoError(t, err)
+
+	script := getRemoveRulesScript(testEnclaveUuid, enclaveSubnet)
+	lines := strings.Split(script, "\n")
+
+	require.Contains(t, lines, "while $IPT -D DOCKER-USER -s 172.16.4.0/22 -j KT-EGRESS-65d2fb6d6732 2>/dev/null; do :; done")
+	require.Equal(t, "$IPT -X KT-EGRESS-65d2fb6d6732 2>/dev/null || true", lines[len(lines)-1])
+}
@@ -47,6 +47,7 @@ func CreateEngine(
 	gitAuthToken string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	dockerManager *docker_manager.DockerManager,
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 ) (
@@ -109,6 +110,7 @@ func CreateEngine(
 		defaultHttpLogsAggregatorPortNum,
 		sinks,
 		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
 		dockerManager,
 		objAttrsProvider)
 	if err != nil {
@@ -277,6 +279,19 @@ func CreateEngine(
 		return nil, stacktrace.Propagate(err, "An error occurred creating Docker config storage.")
 	}
 
+	// Created idempotently like the volumes above; only the API containers mount it
+	starlarkProgramCacheVolObjAttrs, err := objAttrsProvider.ForStarlarkProgramCacheVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving object attributes for the Starlark program cache.")
+	}
+	starlarkProgramCacheVolLabelStrs := map[string]string{}
+	for labelKey, labelValue := range starlarkProgramCacheVolObjAttrs.GetLabels() {
+		starlarkProgramCacheVolLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	if err = dockerManager.CreateVolume(ctx, starlarkProgramCacheVolObjAttrs.GetName().GetString(), starlarkProgramCacheVolLabelStrs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Starlark program cache volume.")
+	}
+
 	bindMounts := map[string]string{
 		// Necessary so that the engine server can interact with the Docker engine
 		consts.DockerSocketFilepath: consts.DockerSocketFilepath,
@@ -304,12 +319,21 @@ func CreateEngine(
 		labelStrs[labelKey.GetString()] = labelValue.GetString()
 	}
 
+	// The engine decrypts the stored logs it streams with the key the logs aggregator encrypts them with
+	engineEnvVars := map[string]string{}
+	for key, value := range envVars {
+		engineEnvVars[key] = value
+	}
+	if logsEncryptionConfig.Key != "" {
+		engineEnvVars[logs_aggregator.LogsEncryptionKeyEnvVar] = logsEncryptionConfig.Key
+	}
+
 	createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
 		containerImageAndTag,
 		engineAttrs.GetName().GetString(),
 		targetNetworkId,
 	).WithEnvironmentVariables(
-		envVars,
+		engineEnvVars,
 	).WithBindMounts(
 		bindMounts,
 	).WithVolumeMounts(
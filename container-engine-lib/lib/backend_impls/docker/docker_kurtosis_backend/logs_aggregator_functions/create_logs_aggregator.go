@@ -23,6 +23,7 @@ func CreateLogsAggregator(
 	logsAggregatorHttpPortNumber uint16,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	dockerManager *docker_manager.DockerManager,
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 ) (
@@ -67,6 +68,7 @@ func CreateLogsAggregator(
 		logsAggregatorHttpPortId,
 		targetNetworkId,
 		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
 		objAttrsProvider,
 		dockerManager)
 	if err != nil {
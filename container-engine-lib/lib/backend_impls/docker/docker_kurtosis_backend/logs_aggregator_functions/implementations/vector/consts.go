@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 )
 
@@ -28,7 +29,16 @@ const (
 	fluentBitSourceType      = "fluent"
 	fluentBitSourceIpAddress = "0.0.0.0"
 	fileSinkType             = "file"
-	bufferSize               = 268435488 // 256 MB is min for vector
+	remapTransformType       = "remap"
+
+	// Encrypts the 'log' field of each event under a new nonce of logs_aggregator.LogsEncryptionNonceNumBytes bytes,
+	// stored next to it. The key is read from the environment so that it's never written in the config.
+	logsEncryptionTransformId = "kurtosis_logs_encryption"
+	logsEncryptionVrlSource   = `key = decode_base64!(get_env_var!("` + logs_aggregator.LogsEncryptionKeyEnvVar + `"))
+nonce = random_bytes(12)
+.log = encode_base64(encrypt!(to_string(.log) ?? "", "CHACHA20-POLY1305", key, iv: nonce))
+.` + logs_aggregator.EncryptedLogNonceLabel + ` = encode_base64(nonce)`
+	bufferSize = 268435488 // 256 MB is min for vector
 
 	////////////////////////--FINISH--VECTOR CONFIGURATION SECTION--/////////////////////////////
 )
@@ -8,10 +8,11 @@ import (
 )
 
 type VectorConfig struct {
-	DataDir string                            `yaml:"data_dir"`
-	Api     *VectorApiConfig                  `yaml:"api"`
-	Sources map[string]map[string]interface{} `yaml:"sources,omitempty"`
-	Sinks   map[string]map[string]interface{} `yaml:"sinks,omitempty"`
+	DataDir    string                            `yaml:"data_dir"`
+	Api        *VectorApiConfig                  `yaml:"api"`
+	Sources    map[string]map[string]interface{} `yaml:"sources,omitempty"`
+	Transforms map[string]map[string]interface{} `yaml:"transforms,omitempty"`
+	Sinks      map[string]map[string]interface{} `yaml:"sinks,omitempty"`
 }
 
 type VectorApiConfig struct {
@@ -24,13 +25,27 @@ func newVectorConfig(
 	httpPortNumber uint16,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 ) *VectorConfig {
 	reconciledSinks := map[string]map[string]interface{}{}
+	transforms := map[string]map[string]interface{}{}
 
 	if shouldEnablePersistentVolumeLogsCollection {
+		// Only the logs stored on the logs volume are encrypted; the other sinks get them as they are collected
+		persistentVolumeSinkInput := defaultSourceId
+		if logsEncryptionConfig.IsEnabled() {
+			transforms[logsEncryptionTransformId] = map[string]interface{}{
+				"type":   remapTransformType,
+				"inputs": []string{defaultSourceId},
+				"source": logsEncryptionVrlSource,
+				// A log line that couldn't be encrypted is dropped rather than stored in plaintext
+				"drop_on_error": true,
+			}
+			persistentVolumeSinkInput = logsEncryptionTransformId
+		}
 		reconciledSinks[logs_aggregator.DefaultSinkId] = map[string]interface{}{
 			"type":   fileSinkType,
-			"inputs": []string{defaultSourceId},
+			"inputs": []string{persistentVolumeSinkInput},
 			"path":   uuidLogsFilepath,
 			"encoding": map[string]interface{}{
 				"codec": "json",
@@ -67,6 +82,7 @@ func newVectorConfig(
 				"address": fmt.Sprintf("%s:%s", fluentBitSourceIpAddress, strconv.Itoa(int(listeningPortNumber))),
 			},
 		},
-		Sinks: reconciledSinks,
+		Transforms: transforms,
+		Sinks:      reconciledSinks,
 	}
 }
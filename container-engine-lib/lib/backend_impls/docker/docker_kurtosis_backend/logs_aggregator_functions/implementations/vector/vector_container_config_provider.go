@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/stacktrace"
 )
@@ -15,12 +16,14 @@ const (
 )
 
 type vectorContainerConfigProvider struct {
-	httpPortNumber uint16
+	httpPortNumber       uint16
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig
 }
 
-func newVectorContainerConfigProvider(httpPortNumber uint16) *vectorContainerConfigProvider {
+func newVectorContainerConfigProvider(httpPortNumber uint16, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig) *vectorContainerConfigProvider {
 	return &vectorContainerConfigProvider{
-		httpPortNumber: httpPortNumber,
+		httpPortNumber:       httpPortNumber,
+		logsEncryptionConfig: logsEncryptionConfig,
 	}
 }
 
@@ -68,6 +71,12 @@ func (vector *vectorContainerConfigProvider) GetContainerArgs(
 	// Thus, instruct docker to restart the container if it exits with non-zero status code for whatever reason
 	restartPolicy := docker_manager.RestartPolicy(docker_manager.RestartAlways)
 
+	// The encryption transform reads the key from the environment, so that it isn't written to the config volume
+	envVars := map[string]string{}
+	if vector.logsEncryptionConfig.Key != "" {
+		envVars[logs_aggregator.LogsEncryptionKeyEnvVar] = vector.logsEncryptionConfig.Key
+	}
+
 	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
 		containerImage,
 		containerName,
@@ -84,6 +93,8 @@ func (vector *vectorContainerConfigProvider) GetContainerArgs(
 		overrideCmd,
 	).WithRestartPolicy(
 		restartPolicy,
+	).WithEnvironmentVariables(
+		envVars,
 	).Build()
 
 	return createAndStartArgs, nil
@@ -1,7 +1,10 @@
 package vector
 
+import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+
 func createVectorContainerConfigProvider(
 	httpPortNumber uint16,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 ) *vectorContainerConfigProvider {
-	return newVectorContainerConfigProvider(httpPortNumber)
+	return newVectorContainerConfigProvider(httpPortNumber, logsEncryptionConfig)
 }
@@ -36,11 +36,12 @@ func (vector *vectorLogsAggregatorContainer) CreateAndStart(
 	logsAggregatorHttpPortId string,
 	targetNetworkId string,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 	dockerManager *docker_manager.DockerManager,
 ) (string, map[string]string, func(), error) {
-	vectorConfigurationCreatorObj := createVectorConfigurationCreatorForKurtosis(logsListeningPortNumber, httpPortNumber, sinks, shouldEnablePersistentVolumeLogsCollection)
-	vectorContainerConfigProviderObj := createVectorContainerConfigProvider(httpPortNumber)
+	vectorConfigurationCreatorObj := createVectorConfigurationCreatorForKurtosis(logsListeningPortNumber, httpPortNumber, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig)
+	vectorContainerConfigProviderObj := createVectorContainerConfigProvider(httpPortNumber, logsEncryptionConfig)
 
 	// Start vector
 
@@ -20,6 +20,7 @@ type LogsAggregatorContainer interface {
 		logsAggregatorHttpPortId string,
 		targetNetworkId string,
 		shouldEnablePersistentVolumeLogsCollection bool,
+		logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 		objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 		dockerManager *docker_manager.DockerManager,
 	) (string, map[string]string, func(), error)
@@ -0,0 +1,52 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateLogsAggregatorSinks recreates the logs aggregator container with the new sinks
+// The data volume, and so the disk buffers of the sinks, is kept and the logs collectors retry sending the logs
+// they collect while the logs aggregator is down, so no logs get lost
+func UpdateLogsAggregatorSinks(
+	ctx context.Context,
+	logsAggregatorContainer LogsAggregatorContainer,
+	sinks logs_aggregator.Sinks,
+	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
+	dockerManager *docker_manager.DockerManager,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+) error {
+	maybeLogsAggregator, err := GetLogsAggregator(ctx, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs aggregator")
+	}
+	if maybeLogsAggregator == nil {
+		return stacktrace.NewError("Couldn't update the sinks of the logs aggregator because no logs aggregator is running; start the engine first")
+	}
+	httpPortNum := maybeLogsAggregator.GetPrivateHttpPort().GetNumber()
+
+	if err := DestroyLogsAggregator(ctx, dockerManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the logs aggregator before recreating it with the new sinks")
+	}
+
+	if _, _, err := CreateLogsAggregator(
+		ctx,
+		logsAggregatorContainer,
+		httpPortNum,
+		sinks,
+		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
+		dockerManager,
+		objAttrsProvider,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recreating the logs aggregator with the new sinks; restart the engine to recreate it")
+	}
+	logrus.Debugf("Recreated the logs aggregator with sinks '%+v'", sinks)
+	return nil
+}
@@ -34,6 +34,7 @@ func CreateLogsCollectorForEnclave(
 	logsAggregator *logs_aggregator.LogsAggregator,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 	dockerManager *docker_manager.DockerManager,
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 ) (
@@ -72,6 +73,7 @@ func CreateLogsCollectorForEnclave(
 		logsCollectorHttpPortId,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorBufferConfig,
 		enclaveNetwork.GetId(),
 		objAttrsProvider,
 		dockerManager,
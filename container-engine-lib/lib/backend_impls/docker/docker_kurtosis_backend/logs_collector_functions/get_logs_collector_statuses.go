@@ -0,0 +1,46 @@
+package logs_collector_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// GetLogsCollectorStatuses returns the status of the logs collector of every enclave, keyed by enclave UUID
+func GetLogsCollectorStatuses(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+) (map[string]container.ContainerStatus, error) {
+	logsCollectorContainerSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.LogsCollectorTypeDockerLabelValue.GetString(),
+	}
+	logsCollectorContainers, err := dockerManager.GetContainersByLabels(ctx, logsCollectorContainerSearchLabels, shouldShowStoppedLogsCollectorContainers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred fetching logs collector containers using labels: %+v", logsCollectorContainerSearchLabels)
+	}
+
+	logsCollectorStatuses := map[string]container.ContainerStatus{}
+	for _, logsCollectorContainer := range logsCollectorContainers {
+		enclaveUuid, found := logsCollectorContainer.GetLabels()[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on logs collector container '%v' but none was found", docker_label_key.EnclaveUUIDDockerLabelKey.GetString(), logsCollectorContainer.GetId())
+		}
+		isContainerRunning, found := consts.IsContainerRunningDeterminer[logsCollectorContainer.GetStatus()]
+		if !found {
+			// This should never happen because we enforce completeness in a unit test
+			return nil, stacktrace.NewError("No is-running designation found for logs collector container status '%v'; this is a bug in Kurtosis!", logsCollectorContainer.GetStatus().String())
+		}
+		if isContainerRunning {
+			logsCollectorStatuses[enclaveUuid] = container.ContainerStatus_Running
+		} else {
+			logsCollectorStatuses[enclaveUuid] = container.ContainerStatus_Stopped
+		}
+	}
+	return logsCollectorStatuses, nil
+}
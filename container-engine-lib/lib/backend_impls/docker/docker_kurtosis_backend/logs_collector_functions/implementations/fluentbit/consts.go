@@ -15,6 +15,8 @@ const (
 	configDirpathInContainer        = rootDirpath + "/etc"
 	configFilepathInContainer       = configDirpathInContainer + "/fluent-bit.conf"
 	parserConfigFilepathInContainer = configDirpathInContainer + "/kurtosis-parsers.conf" // create an additional parsers file for ones defined by users in kurtosis config
+	// the filters registered by the enclave on top of the ones of the logs collector, which can change while it runs
+	enclaveFiltersConfigFilepathInContainer = configDirpathInContainer + "/enclave-filters.conf"
 
 	//these two values are used for configuring the filesystem buffer. See more here: https://docs.fluentbit.io/manual/administration/buffering-and-storage#filesystem-buffering-to-the-rescue
 	filesystemBufferStorageDirpath = configDirpathInContainer + "/storage/"
@@ -28,13 +30,20 @@ const (
 	http_listen {{.Service.HttpServerHost}}
 	http_port {{.Service.HttpServerPort}}
 	storage.path {{.Service.StoragePath}}
+{{- if .Service.StorageMaxChunksUp}}
+	storage.max_chunks_up {{.Service.StorageMaxChunksUp}}
+{{- end}}
 	parsers_file /fluent-bit/etc/parsers.conf
 	parsers_file {{.Service.KurtosisParsersConfigFilepath}}
+	hot_reload {{.Service.HotReloadEnabled}}
 [INPUT]
 	name {{.Input.Name}}
 	listen {{.Input.Listen}}
 	port {{.Input.Port}}
 	storage.type {{.Input.StorageType}}
+{{- if .Input.MemBufLimit}}
+	mem_buf_limit {{.Input.MemBufLimit}}
+{{- end}}
 {{- range .Filters}}
 [FILTER]
 	name {{.Name}}
@@ -42,6 +51,7 @@ const (
 {{- range .Params}}
 	{{.Key}} {{.Value}}
 {{- end}}{{end}}
+@INCLUDE {{.EnclaveFiltersConfigFilepath}}
 [OUTPUT]
 	name {{.Output.Name}}
 	match {{.Output.Match}}
@@ -49,6 +59,18 @@ const (
 	port {{.Output.Port}}
 `
 
+	// every record reaching the logs collector of an enclave comes from one of its services, so the enclave filters
+	// match all of them
+	enclaveFiltersConfigFileTemplateName = "fluentbitEnclaveFiltersConfigFileTemplate"
+	enclaveFiltersConfigFileTemplate     = `{{- range .}}
+[FILTER]
+	name {{.Name}}
+	match *
+{{- range .Params}}
+	{{.Key}} {{.Value}}
+{{- end}}{{end}}
+`
+
 	parserConfigFileTemplateName = "fluentbitParserConfigFileTemplate"
 	parserConfigFileTemplate     = `{{- range .Parsers}}[PARSER]
 {{- range $key, $value := . }}
@@ -62,6 +84,7 @@ const (
 	////////////////////////--FLUENTBIT CONFIGURATION SECTION--/////////////////////////////
 	logLevel               = "debug"
 	httpServerEnabledValue = "On"
+	hotReloadEnabledValue  = "On"
 	httpServerLocalhost    = "0.0.0.0"
 	inputName              = "forward"
 	inputListenIP          = "0.0.0.0"
@@ -10,7 +10,9 @@ type Service struct {
 	HttpServerHost                string
 	HttpServerPort                uint16
 	StoragePath                   string
+	StorageMaxChunksUp            uint32
 	KurtosisParsersConfigFilepath string
+	HotReloadEnabled              string
 }
 
 type Input struct {
@@ -18,6 +20,7 @@ type Input struct {
 	Listen      string
 	Port        uint16
 	StorageType string
+	MemBufLimit string
 }
 
 type Output struct {
@@ -28,10 +31,11 @@ type Output struct {
 }
 
 type FluentbitConfig struct {
-	Service *Service
-	Input   *Input
-	Filters []logs_collector.Filter
-	Output  *Output
+	Service                      *Service
+	Input                        *Input
+	Filters                      []logs_collector.Filter
+	EnclaveFiltersConfigFilepath string
+	Output                       *Output
 }
 
 type ParserConfig struct {
@@ -45,6 +49,7 @@ func newFluentbitConfigForKurtosisCentralizedLogs(
 	httpPortNumber uint16,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) (*FluentbitConfig, *ParserConfig) {
 	return &FluentbitConfig{
 			Service: &Service{
@@ -53,15 +58,19 @@ func newFluentbitConfigForKurtosisCentralizedLogs(
 				HttpServerHost:                httpServerLocalhost,
 				HttpServerPort:                httpPortNumber,
 				StoragePath:                   filesystemBufferStorageDirpath,
+				StorageMaxChunksUp:            logsCollectorBufferConfig.MaxChunksUp,
 				KurtosisParsersConfigFilepath: parserConfigFilepathInContainer,
+				HotReloadEnabled:              hotReloadEnabledValue,
 			},
 			Input: &Input{
 				Name:        inputName,
 				Listen:      inputListenIP,
 				Port:        tcpPortNumber,
-				StorageType: inputFilesystemStorageType,
+				StorageType: getInputStorageType(logsCollectorBufferConfig),
+				MemBufLimit: logsCollectorBufferConfig.MemBufLimit,
 			},
-			Filters: logsCollectorFilters,
+			Filters:                      logsCollectorFilters,
+			EnclaveFiltersConfigFilepath: enclaveFiltersConfigFilepathInContainer,
 			Output: &Output{
 				Name:  vectorOutputTypeName,
 				Match: matchAllRegex,
@@ -72,3 +81,11 @@ func newFluentbitConfigForKurtosisCentralizedLogs(
 			Parsers: logsCollectorParsers,
 		}
 }
+
+// the records are buffered on the filesystem unless the logs collector config says otherwise
+func getInputStorageType(logsCollectorBufferConfig logs_collector.BufferConfig) string {
+	if logsCollectorBufferConfig.StorageType == "" {
+		return inputFilesystemStorageType
+	}
+	return logsCollectorBufferConfig.StorageType
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -24,6 +26,7 @@ const (
 	printfCmdName    = "printf"
 	echoCmdName      = "echo"
 	echoNewLineFlag  = "-e"
+	touchCmdName     = "touch"
 
 	configFileCreationSuccessExitCode = 0
 
@@ -48,7 +51,30 @@ func (fluent *fluentbitConfigurationCreator) CreateConfiguration(
 	volumeName string,
 	dockerManager *docker_manager.DockerManager,
 ) error {
+	if err := runInConfiguratorContainer(ctx, targetNetworkId, volumeName, dockerManager, func(containerId string) error {
+		return fluent.createFluentbitConfigFileInVolume(
+			ctx,
+			dockerManager,
+			containerId,
+			configFileCreationCmdMaxRetries,
+			configFileCreationCmdDelayInRetries,
+		)
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the Fluentbit config file into the volume")
+	}
 
+	return nil
+}
+
+// runInConfiguratorContainer starts a short-lived container with the logs collector config volume mounted, to write
+// the config files into it, and removes it once the given function returns
+func runInConfiguratorContainer(
+	ctx context.Context,
+	targetNetworkId string,
+	volumeName string,
+	dockerManager *docker_manager.DockerManager,
+	configure func(containerId string) error,
+) error {
 	entrypointArgs := []string{
 		shBinaryFilepath,
 		shCmdFlag,
@@ -92,14 +118,8 @@ func (fluent *fluentbitConfigurationCreator) CreateConfiguration(
 		}
 	}()
 
-	if err := fluent.createFluentbitConfigFileInVolume(
-		ctx,
-		dockerManager,
-		containerId,
-		configFileCreationCmdMaxRetries,
-		configFileCreationCmdDelayInRetries,
-	); err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating the Fluentbit config file into the volume")
+	if err := configure(containerId); err != nil {
+		return stacktrace.Propagate(err, "An error occurred configuring the Fluentbit config volume '%v' from configurator container '%v'", volumeName, containerId)
 	}
 
 	return nil
@@ -122,8 +142,10 @@ func (fluent *fluentbitConfigurationCreator) createFluentbitConfigFileInVolume(
 		return stacktrace.Propagate(err, "An error occurred getting the Fluentbit parser config file content")
 	}
 
+	// the enclave filters config file is only created if missing, as it has to be included but is written separately,
+	// and it shouldn't be reset if the logs collector is recreated on top of an existing volume
 	commandStr := fmt.Sprintf(
-		"%v '%v' > %v && %v %v '%v' > %v",
+		"%v '%v' > %v && %v %v '%v' > %v && %v %v",
 		printfCmdName,
 		configFileContentStr,
 		configFilepathInContainer,
@@ -131,6 +153,8 @@ func (fluent *fluentbitConfigurationCreator) createFluentbitConfigFileInVolume(
 		echoNewLineFlag,
 		parserConfigFileContentStr,
 		parserConfigFilepathInContainer,
+		touchCmdName,
+		enclaveFiltersConfigFilepathInContainer,
 	)
 
 	execCmd := []string{
@@ -182,9 +206,16 @@ func (fluent *fluentbitConfigurationCreator) getConfigFileContent() (string, err
 		return "", stacktrace.Propagate(err, "An error occurred parsing Fluentbit config template '%v'", configFileTemplate)
 	}
 
+	expandedFilters, err := logs_collector.ExpandBuiltInFilters(fluent.config.Filters, docker_label_key.LogsServiceUUIDDockerLabelKey.GetString())
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred expanding the filters implemented by Kurtosis in the Fluentbit filters")
+	}
+	config := *fluent.config
+	config.Filters = expandedFilters
+
 	templateStrBuffer := &bytes.Buffer{}
 
-	if err := cngFileTemplate.Execute(templateStrBuffer, fluent.config); err != nil {
+	if err := cngFileTemplate.Execute(templateStrBuffer, config); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred executing the Fluentbit config file template")
 	}
 
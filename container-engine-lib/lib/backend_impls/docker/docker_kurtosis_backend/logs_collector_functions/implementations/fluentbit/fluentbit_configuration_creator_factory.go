@@ -11,8 +11,9 @@ func createFluentbitConfigurationCreatorForKurtosis(
 	httpPortNumber uint16,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) *fluentbitConfigurationCreator {
-	config, parserConfig := newFluentbitConfigForKurtosisCentralizedLogs(logsAggregatorHost, logsAggregatorPort, tcpPortNumber, httpPortNumber, logsCollectorFilters, logsCollectorParsers)
+	config, parserConfig := newFluentbitConfigForKurtosisCentralizedLogs(logsAggregatorHost, logsAggregatorPort, tcpPortNumber, httpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorBufferConfig)
 	fluentbitContainerConfigCreator := newFluentbitConfigurationCreator(config, parserConfig)
 	return fluentbitContainerConfigCreator
 }
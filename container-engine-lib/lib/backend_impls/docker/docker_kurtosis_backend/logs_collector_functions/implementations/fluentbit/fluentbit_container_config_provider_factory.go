@@ -11,7 +11,8 @@ func createFluentbitContainerConfigProviderForKurtosis(
 	httpPortNumber uint16,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) *fluentbitContainerConfigProvider {
-	configCreator := createFluentbitConfigurationCreatorForKurtosis(logsAggregatorHost, logsAggregatorPort, tcpPortNumber, httpPortNumber, logsCollectorFilters, logsCollectorParsers)
+	configCreator := createFluentbitConfigurationCreatorForKurtosis(logsAggregatorHost, logsAggregatorPort, tcpPortNumber, httpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorBufferConfig)
 	return newFluentbitContainerConfigProvider(configCreator.config, tcpPortNumber, httpPortNumber)
 }
@@ -0,0 +1,118 @@
+package fluentbit
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"text/template"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Fluentbit reloads its config when receiving this signal, as long as hot reload is enabled
+	hotReloadSignal = "SIGHUP"
+
+	enclaveFiltersIncludeCheckSuccessExitCode = 0
+	enclaveFiltersWriteSuccessExitCode        = 0
+)
+
+// UpdateEnclaveFilters replaces the enclave filters config file in the logs collector config volume and makes the
+// running logs collector reload its config to apply them
+func (fluentbitContainer *fluentbitLogsCollectorContainer) UpdateEnclaveFilters(
+	ctx context.Context,
+	logsCollectorContainerId string,
+	volumeName string,
+	targetNetworkId string,
+	enclaveFilters []logs_collector.Filter,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	enclaveFiltersConfigFileContentStr, err := getEnclaveFiltersConfigFileContent(enclaveFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Fluentbit enclave filters config file content")
+	}
+
+	if err := runInConfiguratorContainer(ctx, targetNetworkId, volumeName, dockerManager, func(containerId string) error {
+		return writeEnclaveFiltersConfigFileInVolume(ctx, dockerManager, containerId, enclaveFiltersConfigFileContentStr)
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the Fluentbit enclave filters config file into the volume")
+	}
+
+	if err := dockerManager.SignalContainer(ctx, logsCollectorContainerId, hotReloadSignal); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reloading the config of the logs collector container '%v'", logsCollectorContainerId)
+	}
+	logrus.Debugf("Reloaded the config of the logs collector container '%v' with enclave filters:\n%v", logsCollectorContainerId, enclaveFiltersConfigFileContentStr)
+	return nil
+}
+
+func writeEnclaveFiltersConfigFileInVolume(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+	containerId string,
+	enclaveFiltersConfigFileContentStr string,
+) error {
+	// logs collectors created before enclave filters existed don't include them, so writing them would silently do nothing
+	includeCheckCmd := []string{
+		shBinaryFilepath,
+		shCmdFlag,
+		fmt.Sprintf("grep -qF '@INCLUDE %v' %v", enclaveFiltersConfigFilepathInContainer, configFilepathInContainer),
+	}
+	includeCheckOutput := &bytes.Buffer{}
+	exitCode, err := dockerManager.RunUserServiceExecCommands(ctx, containerId, "", includeCheckCmd, includeCheckOutput)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking that the Fluentbit config includes the enclave filters")
+	}
+	if exitCode != enclaveFiltersIncludeCheckSuccessExitCode {
+		return stacktrace.NewError(
+			"The config of the logs collector doesn't include the enclave filters, which means it was created by an " +
+				"older version of Kurtosis; the enclave needs to be recreated for its logs to be filtered")
+	}
+
+	// the content is base64-encoded so that no quote or escape sequence in the filter params is interpreted by the shell
+	writeCmd := []string{
+		shBinaryFilepath,
+		shCmdFlag,
+		fmt.Sprintf(
+			"echo '%v' | base64 -d > %v",
+			base64.StdEncoding.EncodeToString([]byte(enclaveFiltersConfigFileContentStr)),
+			enclaveFiltersConfigFilepathInContainer,
+		),
+	}
+	writeOutput := &bytes.Buffer{}
+	exitCode, err = dockerManager.RunUserServiceExecCommands(ctx, containerId, "", writeCmd, writeOutput)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the Fluentbit enclave filters config file")
+	}
+	if exitCode != enclaveFiltersWriteSuccessExitCode {
+		return stacktrace.NewError(
+			"Writing the Fluentbit enclave filters config file exited with non-%v exit code '%v' and logs:\n%v",
+			enclaveFiltersWriteSuccessExitCode,
+			exitCode,
+			writeOutput.String(),
+		)
+	}
+	return nil
+}
+
+func getEnclaveFiltersConfigFileContent(enclaveFilters []logs_collector.Filter) (string, error) {
+	enclaveFiltersCfgFileTemplate, err := template.New(enclaveFiltersConfigFileTemplateName).Parse(enclaveFiltersConfigFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing Fluentbit enclave filters config template '%v'", enclaveFiltersConfigFileTemplate)
+	}
+
+	expandedEnclaveFilters, err := logs_collector.ExpandBuiltInFilters(enclaveFilters, docker_label_key.LogsServiceUUIDDockerLabelKey.GetString())
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred expanding the filters implemented by Kurtosis in the Fluentbit enclave filters")
+	}
+
+	templateStrBuffer := &bytes.Buffer{}
+	if err := enclaveFiltersCfgFileTemplate.Execute(templateStrBuffer, expandedEnclaveFilters); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred executing the Fluentbit enclave filters config file template")
+	}
+	return templateStrBuffer.String(), nil
+}
@@ -22,6 +22,7 @@ type LogsCollectorContainer interface {
 		logsCollectorHttpPortId string,
 		logsCollectorFilters []logs_collector.Filter,
 		logsCollectorParsers []logs_collector.Parser,
+		logsCollectorBufferConfig logs_collector.BufferConfig,
 		targetNetworkId string,
 		objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 		dockerManager *docker_manager.DockerManager,
@@ -29,4 +30,15 @@ type LogsCollectorContainer interface {
 
 	// GetHttpHealthCheckEndpoint returns endpoint for verifying the availability of the logs collector application on container
 	GetHttpHealthCheckEndpoint() string
+
+	// UpdateEnclaveFilters replaces the filters applied on top of the logs collector filters, reloading the running
+	// logs collector container config instead of restarting it
+	UpdateEnclaveFilters(
+		ctx context.Context,
+		logsCollectorContainerId string,
+		volumeName string,
+		targetNetworkId string,
+		enclaveFilters []logs_collector.Filter,
+		dockerManager *docker_manager.DockerManager,
+	) error
 }
@@ -0,0 +1,54 @@
+package logs_collector_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// UpdateLogsCollectorEnclaveFilters replaces the filters the logs collector of the enclave applies on top of its own,
+// without restarting it; as there's one logs collector per enclave, they apply to all the logs it receives
+func UpdateLogsCollectorEnclaveFilters(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveFilters []logs_collector.Filter,
+	logsCollectorContainer LogsCollectorContainer,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while retrieving the network id for the enclave '%v'", enclaveUuid)
+	}
+
+	maybeLogsCollector, maybeLogsCollectorContainerId, err := getLogsCollectorObjectAndContainerId(ctx, enclaveUuid, enclaveNetwork, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs collector for enclave '%v'", enclaveUuid)
+	}
+	if maybeLogsCollector == nil {
+		return stacktrace.NewError("No logs collector was found for enclave '%v'", enclaveUuid)
+	}
+
+	logsCollectorVolumeName, err := getEnclaveLogsCollectorVolumeName(ctx, dockerManager, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting logs collector volume for enclave '%v'", enclaveUuid)
+	}
+	if logsCollectorVolumeName == "" {
+		return stacktrace.NewError("No logs collector volume was found for enclave '%v'", enclaveUuid)
+	}
+
+	if err := logsCollectorContainer.UpdateEnclaveFilters(
+		ctx,
+		maybeLogsCollectorContainerId,
+		logsCollectorVolumeName,
+		enclaveNetwork.GetId(),
+		enclaveFilters,
+		dockerManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the filters of the logs collector container '%v'", maybeLogsCollectorContainerId)
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
 
+	dockertypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
 	"github.com/gammazero/workerpool"
@@ -26,6 +27,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -652,13 +654,28 @@ func getUserServiceObjsFromDockerResources(
 				serviceContainer.GetImageName(),
 				serviceContainer.GetEntrypointArgs(),
 				serviceContainer.GetCmdArgs(),
-				serviceContainer.GetEnvVars(),
+				// The secrets the service was started with aren't handed out to the callers inspecting it
+				secrets_provider.RedactEnvVars(
+					serviceContainer.GetEnvVars(),
+					secrets_provider.DeserializeSecretEnvVarNames(serviceContainer.GetLabels()[docker_label_key.SecretEnvVarsDockerLabelKey.GetString()]),
+				),
 			),
 		)
 	}
 	return result, nil
 }
 
+// redactSecretEnvVars replaces the value of the environment variables the container labels as carrying secrets, and
+// of the arguments of the Kurtosis containers, so that they don't end up in the enclave dumps
+func redactSecretEnvVars(inspectResult dockertypes.ContainerJSON) {
+	if inspectResult.Config == nil {
+		return
+	}
+	serializedSecretEnvVarNames := inspectResult.Config.Labels[docker_label_key.SecretEnvVarsDockerLabelKey.GetString()]
+	secretEnvVarNames := secrets_provider.GetDumpRedactedEnvVarNames(serializedSecretEnvVarNames)
+	inspectResult.Config.Env = secrets_provider.RedactEnvVarAssignments(inspectResult.Config.Env, secretEnvVarNames)
+}
+
 func createDumpContainerJob(
 	ctx context.Context,
 	dockerManager *docker_manager.DockerManager,
@@ -706,6 +723,7 @@ func dumpContainerInfo(
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred inspecting container with ID '%v'", containerId)
 	}
+	redactSecretEnvVars(inspectResult)
 	jsonSerializedInspectResultBytes, err := json.MarshalIndent(inspectResult, containerSpecJsonSerializationPrefix, containerSpecJsonSerializationIndent)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred serializing the results of inspecting container with ID '%v' to JSON", containerId)
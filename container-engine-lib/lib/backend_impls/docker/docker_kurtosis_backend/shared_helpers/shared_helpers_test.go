@@ -0,0 +1,47 @@
+package shared_helpers
+
+import (
+	"encoding/json"
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRedactSecretEnvVars(t *testing.T) {
+	inspectResult := dockertypes.ContainerJSON{
+		Config: &container.Config{
+			Env: []string{
+				"API_KEY=not-a-real-api-key",
+				"LOG_LEVEL=debug",
+			},
+			Labels: map[string]string{
+				docker_label_key.SecretEnvVarsDockerLabelKey.GetString(): secrets_provider.SerializeSecretEnvVarNames([]string{"API_KEY"}),
+			},
+		},
+	}
+
+	redactSecretEnvVars(inspectResult)
+	dumpedContainerInfo, err := json.Marshal(inspectResult)
+	require.NoError(t, err)
+	require.NotContains(t, string(dumpedContainerInfo), "not-a-real-api-key")
+	require.Contains(t, string(dumpedContainerInfo), "LOG_LEVEL=debug")
+}
+
+func TestRedactSecretEnvVars_RedactsArgsWithoutLabel(t *testing.T) {
+	inspectResult := dockertypes.ContainerJSON{
+		Config: &container.Config{
+			Env: []string{
+				secrets_provider.SerializedArgsEnvVarName + `={"secretsProviderConfig":{"vault":{"token":"hvs.not-a-real-vault-token"}},"tlsKey":"not-a-real-tls-key"}`,
+			},
+		},
+	}
+
+	redactSecretEnvVars(inspectResult)
+	dumpedContainerInfo, err := json.Marshal(inspectResult)
+	require.NoError(t, err)
+	require.NotContains(t, string(dumpedContainerInfo), "not-a-real-vault-token")
+	require.NotContains(t, string(dumpedContainerInfo), "not-a-real-tls-key")
+}
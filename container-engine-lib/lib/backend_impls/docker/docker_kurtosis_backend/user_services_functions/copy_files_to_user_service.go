@@ -0,0 +1,123 @@
+package user_service_functions
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"path"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	containerRootDirpath = "/"
+	tarDirSuffix         = "/"
+	createdDirMode       = 0o755
+)
+
+// CopyFilesToUserService extracts the TAR'd files into the directory at dstDirpathOnContainer, creating it if needed.
+// Docker only extracts into existing directories, so the content is extracted into the closest existing parent with
+// the missing directories prepended to it; this way no binary (mkdir, tar, ...) is needed in the container.
+func CopyFilesToUserService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnContainer string,
+	tarContent io.Reader,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	_, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service with UUID '%v' in enclave with ID '%v'", serviceUuid, enclaveId)
+	}
+	container := serviceDockerResources.ServiceContainer
+
+	existingDirpath := path.Clean(dstDirpathOnContainer)
+	missingDirpath := ""
+	for existingDirpath != containerRootDirpath {
+		doesDirExist, err := dockerManager.DoesPathExistInContainer(ctx, container.GetId(), existingDirpath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred checking whether '%v' exists on service '%v'", existingDirpath, serviceUuid)
+		}
+		if doesDirExist {
+			break
+		}
+		missingDirpath = path.Join(path.Base(existingDirpath), missingDirpath)
+		existingDirpath = path.Dir(existingDirpath)
+	}
+
+	contentToCopy := tarContent
+	if missingDirpath != "" {
+		pipeReader, pipeWriter := io.Pipe()
+		go func() {
+			pipeWriter.CloseWithError(prefixTarEntries(tarContent, missingDirpath, pipeWriter))
+		}()
+		defer pipeReader.Close()
+		contentToCopy = pipeReader
+	}
+
+	if err = dockerManager.CopyToContainer(ctx, container.GetId(), existingDirpath, contentToCopy); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying content to '%v' in container '%v' for user service '%v' in enclave '%v'",
+			dstDirpathOnContainer,
+			container.GetName(),
+			serviceUuid,
+			enclaveId,
+		)
+	}
+	return nil
+}
+
+// prefixTarEntries rewrites the TAR read from tarContent to output with every entry moved under the relative dirpath,
+// preceded by entries for dirpath and its parents so that they get created
+func prefixTarEntries(tarContent io.Reader, relativeDirpath string, output io.Writer) error {
+	tarReader := tar.NewReader(tarContent)
+	tarWriter := tar.NewWriter(output)
+
+	var parentDirpaths []string
+	for dirpath := relativeDirpath; dirpath != "."; dirpath = path.Dir(dirpath) {
+		parentDirpaths = append([]string{dirpath}, parentDirpaths...)
+	}
+	for _, parentDirpath := range parentDirpaths {
+		//nolint:exhaustruct
+		dirHeader := &tar.Header{
+			Typeflag: tar.TypeDir,
+			Name:     parentDirpath + tarDirSuffix,
+			Mode:     createdDirMode,
+		}
+		if err := tarWriter.WriteHeader(dirHeader); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the TAR entry of directory '%v'", parentDirpath)
+		}
+	}
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the next entry of the TAR content")
+		}
+		header.Name = path.Join(relativeDirpath, header.Name)
+		if header.Typeflag == tar.TypeDir {
+			header.Name += tarDirSuffix
+		}
+		if header.Typeflag == tar.TypeLink {
+			header.Linkname = path.Join(relativeDirpath, header.Linkname)
+		}
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the TAR entry of '%v'", header.Name)
+		}
+		if _, err = io.Copy(tarWriter, tarReader); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the content of '%v'", header.Name)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the TAR content")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package user_service_functions
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixTarEntries(t *testing.T) {
+	tarContent := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(tarContent)
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "src/", Mode: 0o755}))
+	fileContent := "package main\n"
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "src/main.go", Mode: 0o644, Size: int64(len(fileContent))}))
+	_, err := tarWriter.Write([]byte(fileContent))
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+
+	prefixedTarContent := &bytes.Buffer{}
+	require.NoError(t, prefixTarEntries(tarContent, "app/code", prefixedTarContent))
+
+	tarReader := tar.NewReader(prefixedTarContent)
+	var entryNames []string
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		entryNames = append(entryNames, header.Name)
+		if header.Name == "app/code/src/main.go" {
+			content, err := io.ReadAll(tarReader)
+			require.NoError(t, err)
+			require.Equal(t, fileContent, string(content))
+		}
+	}
+	require.Equal(t, []string{"app/", "app/code/", "app/code/src/", "app/code/src/main.go"}, entryNames)
+}
@@ -7,33 +7,32 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
 	terminal "golang.org/x/term"
 	"io"
 	"os"
+	"time"
 )
 
-// We'll try to use the nicer-to-use shells first before we drop down to the lower shells
-var commandToRunWhenCreatingUserServiceShell = []string{
-	"sh",
-	"-c",
-	`if command -v 'bash' > /dev/null; then
-		echo "Found bash on container; creating bash shell..."; bash; 
-       else 
-		echo "No bash found on container; dropping down to sh shell..."; sh; 
-	fi`,
-}
+const (
+	// Docker can take a moment to flag the exec as exited after its stream is closed
+	maxShellExitChecks          = 5
+	timeBetweenShellExitChecks  = 200 * time.Millisecond
+	shellOutputCopyResultBuffer = 1
+)
 
-func GetShellOnUserService(ctx context.Context, enclaveId enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dockerManager *docker_manager.DockerManager) error {
+func GetShellOnUserService(ctx context.Context, enclaveId enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions, dockerManager *docker_manager.DockerManager) (bool, error) {
 	_, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting service object and Docker resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
+		return false, stacktrace.Propagate(err, "An error occurred getting service object and Docker resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
 	}
 	container := serviceDockerResources.ServiceContainer
 
-	hijackedResponse, err := dockerManager.CreateContainerExec(ctx, container.GetId(), commandToRunWhenCreatingUserServiceShell)
+	execId, hijackedResponse, err := dockerManager.CreateContainerExec(ctx, container.GetId(), shellOptions.GetShellCommand(), shellOptions.GetUser(), shellOptions.GetEnvVars())
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting a shell on user service with UUID '%v' in enclave '%v'", serviceUuid, enclaveId)
+		return false, stacktrace.Propagate(err, "An error occurred getting a shell on user service with UUID '%v' in enclave '%v'", serviceUuid, enclaveId)
 	}
+	defer hijackedResponse.Close()
 
 	newConnection := hijackedResponse.Conn
 
@@ -41,13 +40,12 @@ func GetShellOnUserService(ctx context.Context, enclaveId enclave.EnclaveUUID, s
 
 	// From this point on down, I don't know why it works.... but it does
 	// I just followed the solution here: https://stackoverflow.com/questions/58732588/accept-user-input-os-stdin-to-container-using-golang-docker-sdk-interactive-co
-	// This channel is being used to know the user exited the ContainerExec
-	finishChan := make(chan bool)
+	// This channel is being used to know the user exited the ContainerExec, or that the connection broke
+	finishChan := make(chan error, shellOutputCopyResultBuffer)
 	// TODO(victor.colombo): Decide what to do with errors that happen inside these go routines
 	go func() {
-		//nolint:errcheck
-		io.Copy(os.Stdout, newReader)
-		finishChan <- true
+		_, copyErr := io.Copy(os.Stdout, newReader)
+		finishChan <- copyErr
 	}()
 
 	//nolint:errcheck
@@ -61,13 +59,28 @@ func GetShellOnUserService(ctx context.Context, enclaveId enclave.EnclaveUUID, s
 		oldState, err = terminal.MakeRaw(stdinFd)
 		if err != nil {
 			// print error
-			return stacktrace.Propagate(err, "An error occurred making STDIN stream raw")
+			return false, stacktrace.Propagate(err, "An error occurred making STDIN stream raw")
 		}
 		//nolint:errcheck
 		defer terminal.Restore(stdinFd, oldState)
 	}
 
-	<-finishChan
+	if copyErr := <-finishChan; copyErr != nil {
+		logrus.Debugf("Reading the output of the shell on user service with UUID '%v' failed, considering it as disconnected:\n%v", serviceUuid, copyErr)
+		return true, nil
+	}
 
-	return nil
+	// The connection also ends cleanly when it's closed on the daemon side, in which case the shell is still running
+	for i := 0; i < maxShellExitChecks; i++ {
+		isShellRunning, err := dockerManager.IsContainerExecRunning(ctx, execId)
+		if err != nil {
+			logrus.Debugf("Couldn't check whether the shell on user service with UUID '%v' exited, considering it as disconnected:\n%v", serviceUuid, err)
+			return true, nil
+		}
+		if !isShellRunning {
+			return false, nil
+		}
+		time.Sleep(timeBetweenShellExitChecks)
+	}
+	return true, nil
 }
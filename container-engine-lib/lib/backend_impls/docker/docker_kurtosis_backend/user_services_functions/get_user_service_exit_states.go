@@ -0,0 +1,85 @@
+package user_service_functions
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Docker doesn't give a reason for a container exiting apart from the OOM killer and errors starting it
+	oomKilledExitReason = "OOMKilled"
+
+	exitedContainerStatus = "exited"
+	deadContainerStatus   = "dead"
+)
+
+func GetUserServiceExitStates(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]*service.ExitState,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	_, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveId, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	successfulExitStates := map[service.ServiceUUID]*service.ExitState{}
+	erroredUserServices := map[service.ServiceUUID]error{}
+	for serviceUuid, resourcesForService := range allDockerResources {
+		if resourcesForService.ServiceContainer == nil {
+			continue
+		}
+		containerId := resourcesForService.ServiceContainer.GetId()
+		containerJson, err := dockerManager.InspectContainer(ctx, containerId)
+		if err != nil {
+			erroredUserServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred inspecting container '%v' of service '%v'", containerId, serviceUuid)
+			continue
+		}
+		if containerJson.ContainerJSONBase == nil || containerJson.State == nil {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Inspecting container '%v' of service '%v' didn't return its state", containerId, serviceUuid)
+			continue
+		}
+		exitState, hasExited, err := getExitState(containerJson.State)
+		if err != nil {
+			erroredUserServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the exit state of container '%v' of service '%v'", containerId, serviceUuid)
+			continue
+		}
+		if hasExited {
+			successfulExitStates[serviceUuid] = exitState
+		}
+	}
+	return successfulExitStates, erroredUserServices, nil
+}
+
+// getExitState returns false if the container hasn't exited, e.g. because it's still running or was only created
+func getExitState(containerState *types.ContainerState) (*service.ExitState, bool, error) {
+	if containerState.Status != exitedContainerStatus && containerState.Status != deadContainerStatus {
+		return nil, false, nil
+	}
+	finishedAt, err := time.Parse(time.RFC3339Nano, containerState.FinishedAt)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred parsing the time the container finished at '%v'", containerState.FinishedAt)
+	}
+	reason := containerState.Error
+	if containerState.OOMKilled {
+		reason = oomKilledExitReason
+	}
+	serializedContainerState, err := json.Marshal(containerState)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred serializing the container state '%+v'", containerState)
+	}
+	return service.NewExitState(int32(containerState.ExitCode), containerState.OOMKilled, finishedAt, reason, serializedContainerState), true, nil
+}
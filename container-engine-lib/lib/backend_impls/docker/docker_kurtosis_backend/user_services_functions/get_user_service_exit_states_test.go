@@ -0,0 +1,54 @@
+package user_service_functions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExitState_OomKilled(t *testing.T) {
+	// nolint: exhaustruct
+	containerState := &types.ContainerState{
+		Status:     exitedContainerStatus,
+		OOMKilled:  true,
+		ExitCode:   137,
+		FinishedAt: "2024-03-01T10:20:30.123456789Z",
+	}
+	exitState, hasExited, err := getExitState(containerState)
+	require.NoError(t, err)
+	require.True(t, hasExited)
+	require.Equal(t, int32(137), exitState.GetExitCode())
+	require.True(t, exitState.IsOomKilled())
+	require.True(t, exitState.IsCrash())
+	require.Equal(t, oomKilledExitReason, exitState.GetReason())
+	require.Equal(t, time.Date(2024, time.March, 1, 10, 20, 30, 123456789, time.UTC), exitState.GetFinishedAt())
+	require.Contains(t, string(exitState.GetSerializedBackendState()), `"OOMKilled":true`)
+}
+
+func TestGetExitState_ExitedCleanly(t *testing.T) {
+	// nolint: exhaustruct
+	containerState := &types.ContainerState{
+		Status:     exitedContainerStatus,
+		ExitCode:   0,
+		FinishedAt: "2024-03-01T10:20:30Z",
+	}
+	exitState, hasExited, err := getExitState(containerState)
+	require.NoError(t, err)
+	require.True(t, hasExited)
+	require.False(t, exitState.IsCrash())
+}
+
+func TestGetExitState_StillRunning(t *testing.T) {
+	// nolint: exhaustruct
+	containerState := &types.ContainerState{
+		Status:     "running",
+		Running:    true,
+		FinishedAt: "0001-01-01T00:00:00Z",
+	}
+	exitState, hasExited, err := getExitState(containerState)
+	require.NoError(t, err)
+	require.False(t, hasExited)
+	require.Nil(t, exitState)
+}
@@ -0,0 +1,153 @@
+package user_service_functions
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+
+	"github.com/docker/docker/api/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	coresToMilliCores = 1000
+
+	// The page cache the kernel can reclaim is counted in the memory usage, so it's subtracted from it like 'docker stats'
+	// does, under the key of cgroup v2 or the one of cgroup v1
+	inactiveFileMemoryStatKey      = "inactive_file"
+	totalInactiveFileMemoryStatKey = "total_inactive_file"
+
+	readPeakMemoryUsageContainerUser   = ""
+	readPeakMemoryUsageSuccessExitCode = 0
+)
+
+func GetUserServiceResourceUsage(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]*service.ResourceUsage,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	allServiceObjs, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveId, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	successfulResourceUsage := map[service.ServiceUUID]*service.ResourceUsage{}
+	erroredUserServices := map[service.ServiceUUID]error{}
+	// Docker takes a second to sample the CPU usage of each container, so the services are sampled in parallel
+	resourceUsageOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for serviceUuid, serviceObj := range allServiceObjs {
+		resourcesForService, found := allDockerResources[serviceUuid]
+		if !found || resourcesForService.ServiceContainer == nil || serviceObj.GetContainer() == nil {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get the resource usage of service '%v' as it has no container", serviceUuid)
+			continue
+		}
+		if serviceObj.GetContainer().GetStatus() != container.ContainerStatus_Running {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get the resource usage of service '%v' as it isn't running", serviceUuid)
+			continue
+		}
+		resourceUsageOperations[operation_parallelizer.OperationID(serviceUuid)] = createGetResourceUsageOperation(ctx, resourcesForService.ServiceContainer.GetId(), dockerManager)
+	}
+
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(resourceUsageOperations)
+	for operationId, operationResult := range successfulOperations {
+		serviceUuid := service.ServiceUUID(operationId)
+		resourceUsage, ok := operationResult.(*service.ResourceUsage)
+		if !ok {
+			return nil, nil, stacktrace.NewError("The resource usage of service '%v' is of an unexpected type ('%v'); this is a bug in Kurtosis", serviceUuid, reflect.TypeOf(operationResult))
+		}
+		successfulResourceUsage[serviceUuid] = resourceUsage
+	}
+	for operationId, err := range failedOperations {
+		erroredUserServices[service.ServiceUUID(operationId)] = err
+	}
+	return successfulResourceUsage, erroredUserServices, nil
+}
+
+func createGetResourceUsageOperation(ctx context.Context, containerId string, dockerManager *docker_manager.DockerManager) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
+		containerStats, err := dockerManager.GetContainerStats(ctx, containerId)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the stats of container '%v'", containerId)
+		}
+		maybePeakMemoryBytes := getMaybePeakMemoryBytes(ctx, containerId, &containerStats.Stats, dockerManager)
+		return service.NewResourceUsage(getCpuMilliCores(&containerStats.Stats), getMemoryBytes(&containerStats.Stats), maybePeakMemoryBytes, getMaybeNetworkUsage(containerStats)), nil
+	}
+}
+
+func getCpuMilliCores(containerStats *types.Stats) compute_resources.CpuMilliCores {
+	// Without a previous sample, e.g. if the container just started, the deltas would cover the whole uptime of the host
+	if containerStats.PreCPUStats.SystemUsage == 0 {
+		return 0
+	}
+	cpuDelta := float64(containerStats.CPUStats.CPUUsage.TotalUsage) - float64(containerStats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(containerStats.CPUStats.SystemUsage) - float64(containerStats.PreCPUStats.SystemUsage)
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+	onlineCpus := float64(containerStats.CPUStats.OnlineCPUs)
+	if onlineCpus == 0 {
+		onlineCpus = float64(len(containerStats.CPUStats.CPUUsage.PercpuUsage))
+	}
+	return compute_resources.CpuMilliCores(cpuDelta / systemDelta * onlineCpus * coresToMilliCores)
+}
+
+func getMemoryBytes(containerStats *types.Stats) uint64 {
+	memoryBytes := containerStats.MemoryStats.Usage
+	inactiveFileBytes, found := containerStats.MemoryStats.Stats[inactiveFileMemoryStatKey]
+	if !found {
+		inactiveFileBytes = containerStats.MemoryStats.Stats[totalInactiveFileMemoryStatKey]
+	}
+	if inactiveFileBytes < memoryBytes {
+		return memoryBytes - inactiveFileBytes
+	}
+	return memoryBytes
+}
+
+// getMaybeNetworkUsage adds up the traffic of all the networks the container is connected to; it's nil for containers
+// that have no network of their own, e.g. the ones sharing the network of the host
+func getMaybeNetworkUsage(containerStats *types.StatsJSON) *service.NetworkUsage {
+	if len(containerStats.Networks) == 0 {
+		return nil
+	}
+	rxBytes := uint64(0)
+	txBytes := uint64(0)
+	for _, networkStats := range containerStats.Networks {
+		rxBytes += networkStats.RxBytes
+		txBytes += networkStats.TxBytes
+	}
+	return service.NewNetworkUsage(rxBytes, txBytes)
+}
+
+// getMaybePeakMemoryBytes returns the peak memory usage Docker reports on cgroup v1, and otherwise reads it from the
+// cgroup of the container, which is best effort as it needs a shell in the container
+func getMaybePeakMemoryBytes(ctx context.Context, containerId string, containerStats *types.Stats, dockerManager *docker_manager.DockerManager) *uint64 {
+	if containerStats.MemoryStats.MaxUsage != 0 {
+		peakMemoryBytes := containerStats.MemoryStats.MaxUsage
+		return &peakMemoryBytes
+	}
+	readPeakMemoryUsageOutput := &bytes.Buffer{}
+	exitCode, err := dockerManager.RunUserServiceExecCommands(ctx, containerId, readPeakMemoryUsageContainerUser, service.GetReadPeakMemoryUsageCommand(), readPeakMemoryUsageOutput)
+	if err != nil || exitCode != readPeakMemoryUsageSuccessExitCode {
+		logrus.Debugf("Couldn't read the peak memory usage of container '%v' from its cgroup; exit code '%v', output '%v', error:\n%v", containerId, exitCode, readPeakMemoryUsageOutput.String(), err)
+		return nil
+	}
+	peakMemoryBytes, err := service.ParsePeakMemoryUsage(readPeakMemoryUsageOutput.String())
+	if err != nil {
+		logrus.Debugf("Couldn't parse the peak memory usage of container '%v':\n%v", containerId, err)
+		return nil
+	}
+	return &peakMemoryBytes
+}
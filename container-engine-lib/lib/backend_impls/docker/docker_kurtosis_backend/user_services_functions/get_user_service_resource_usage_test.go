@@ -0,0 +1,76 @@
+package user_service_functions
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCpuMilliCores(t *testing.T) {
+	// nolint: exhaustruct
+	containerStats := &types.Stats{
+		CPUStats: types.CPUStats{
+			CPUUsage:    types.CPUUsage{TotalUsage: 1_500_000_000},
+			SystemUsage: 20_000_000_000,
+			OnlineCPUs:  4,
+		},
+		PreCPUStats: types.CPUStats{
+			CPUUsage:    types.CPUUsage{TotalUsage: 1_000_000_000},
+			SystemUsage: 16_000_000_000,
+			OnlineCPUs:  4,
+		},
+	}
+	// Half a core was used out of the 4 cores that were available during the sample
+	require.Equal(t, compute_resources.CpuMilliCores(500), getCpuMilliCores(containerStats))
+}
+
+func TestGetCpuMilliCores_NoPreviousSample(t *testing.T) {
+	// nolint: exhaustruct
+	containerStats := &types.Stats{
+		CPUStats: types.CPUStats{
+			CPUUsage:    types.CPUUsage{TotalUsage: 1_500_000_000},
+			SystemUsage: 20_000_000_000,
+			OnlineCPUs:  4,
+		},
+	}
+	require.Equal(t, compute_resources.CpuMilliCores(0), getCpuMilliCores(containerStats))
+}
+
+func TestGetMemoryBytes_SubtractsInactiveFiles(t *testing.T) {
+	// nolint: exhaustruct
+	cgroupV2Stats := &types.Stats{
+		MemoryStats: types.MemoryStats{
+			Usage: 100_000,
+			Stats: map[string]uint64{inactiveFileMemoryStatKey: 30_000},
+		},
+	}
+	require.Equal(t, uint64(70_000), getMemoryBytes(cgroupV2Stats))
+
+	// nolint: exhaustruct
+	cgroupV1Stats := &types.Stats{
+		MemoryStats: types.MemoryStats{
+			Usage: 100_000,
+			Stats: map[string]uint64{totalInactiveFileMemoryStatKey: 40_000},
+		},
+	}
+	require.Equal(t, uint64(60_000), getMemoryBytes(cgroupV1Stats))
+}
+
+func TestGetMaybeNetworkUsage_AddsUpAllNetworks(t *testing.T) {
+	// nolint: exhaustruct
+	containerStats := &types.StatsJSON{
+		Networks: map[string]types.NetworkStats{
+			"eth0": {RxBytes: 1_000, TxBytes: 200},
+			"eth1": {RxBytes: 500, TxBytes: 50},
+		},
+	}
+	networkUsage := getMaybeNetworkUsage(containerStats)
+	require.NotNil(t, networkUsage)
+	require.Equal(t, uint64(1_500), networkUsage.GetRxBytes())
+	require.Equal(t, uint64(250), networkUsage.GetTxBytes())
+
+	// nolint: exhaustruct
+	require.Nil(t, getMaybeNetworkUsage(&types.StatsJSON{}))
+}
@@ -19,8 +19,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
@@ -121,6 +123,7 @@ func StartRegisteredUserServices(
 	enclaveUuid enclave.EnclaveUUID,
 	services map[service.ServiceUUID]*service.ServiceConfig,
 	serviceRegistrationRepository *service_registration.ServiceRegistrationRepository,
+	// nil if the enclave was created without logs collection
 	logsCollector *logs_collector.LogsCollector,
 	// these values will be used to conduct a health check on the fluent log collector in this enclave
 	// to ensure the services logs are captured when it starts
@@ -205,17 +208,23 @@ func StartRegisteredUserServices(
 		return nil, nil, stacktrace.Propagate(err, "Couldn't get an object attribute provider for enclave '%v'", enclaveUuid)
 	}
 
-	// Check if the logs collector is available
-	// As the container logs are sent asynchronously we'd not know whether they're being received by the collector and there would be no errors if the collector never comes up
-	// The least we can do is check if the collector server is healthy before starting the user service, if in case it gets shut down later we can't do much about it anyway.
-	if err = availability_checker.WaitForAvailability(logsCollectorIpAddr, logsCollectorPortNum, logsCollectorAvailabilityEndpoint); err != nil {
-		return nil, nil,
-			stacktrace.Propagate(err, "An error occurred while waiting to see if the logs collector was available.")
-	}
+	// An empty address means the enclave has no logs collector, so the containers keep the default logging driver
+	logsCollectorEnclaveAddr := ""
+	if logsCollector != nil {
+		// Check if the logs collector is available
+		// As the container logs are sent asynchronously we'd not know whether they're being received by the collector and there would be no errors if the collector never comes up
+		// The least we can do is check if the collector server is healthy before starting the user service, if in case it gets shut down later we can't do much about it anyway.
+		if err = availability_checker.WaitForAvailability(logsCollectorIpAddr, logsCollectorPortNum, logsCollectorAvailabilityEndpoint); err != nil {
+			return nil, nil,
+				stacktrace.Propagate(err, "An error occurred while waiting to see if the logs collector was available.")
+		}
 
-	logsCollectorEnclaveAddr, err := logsCollector.GetEnclaveNetworkAddressString()
-	if err != nil {
-		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the private TCP address")
+		logsCollectorEnclaveAddr, err = logsCollector.GetEnclaveNetworkAddressString()
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting the private TCP address")
+		}
+	} else {
+		logrus.Debugf("Enclave '%v' has no logs collector, the logs of its services are kept by the container engine", enclaveUuid)
 	}
 
 	// The following docker labels will be added into the logs stream which is necessary for filtering, retrieving persisted logs
@@ -656,6 +665,9 @@ func createStartServiceOperation(
 		for labelKey, labelValue := range containerAttrs.GetLabels() {
 			labelStrs[labelKey.GetString()] = labelValue.GetString()
 		}
+		if secretEnvVars := serviceConfig.GetSecretEnvVars(); len(secretEnvVars) > 0 {
+			labelStrs[docker_label_key.SecretEnvVarsDockerLabelKey.GetString()] = secrets_provider.SerializeSecretEnvVarNames(secretEnvVars)
+		}
 
 		dockerUsedPorts := map[nat.Port]docker_manager.PortPublishSpec{}
 		for portId, privatePortSpec := range privatePorts {
@@ -675,16 +687,15 @@ func createStartServiceOperation(
 			}
 		}
 
-		if logsCollectorAddress == "" {
-			return nil, stacktrace.NewError("Expected to have a logs collector server address value to send the user service logs, but it is empty")
+		// The container will be configured to send the logs to the Fluentbit logs collector server, if the enclave has one
+		var loggingDriverCnfg docker_manager.LoggingDriver
+		if logsCollectorAddress != "" {
+			loggingDriverCnfg = docker_manager.NewFluentdLoggingDriver(
+				logsCollectorAddress,
+				logsCollectorLabels,
+			)
 		}
 
-		// The container will be configured to send the logs to the Fluentbit logs collector server
-		fluentdLoggingDriverCnfg := docker_manager.NewFluentdLoggingDriver(
-			logsCollectorAddress,
-			logsCollectorLabels,
-		)
-
 		createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
 			containerImageName,
 			containerName.GetString(),
@@ -710,7 +721,7 @@ func createStartServiceOperation(
 		).WithVolumeMounts(
 			volumeMounts,
 		).WithLoggingDriver(
-			fluentdLoggingDriverCnfg,
+			loggingDriverCnfg,
 		).WithRestartPolicy(
 			restartPolicy,
 		).WithUser(
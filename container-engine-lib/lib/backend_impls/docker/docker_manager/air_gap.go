@@ -0,0 +1,37 @@
+package docker_manager
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// SetAirGapConfig makes this manager pull the images from the air-gapped mirror only, never from their registry
+func (manager *DockerManager) SetAirGapConfig(airGapConfig air_gap.AirGapConfig) {
+	manager.airGapConfig = airGapConfig
+}
+
+// pullImageFromAirGapMirror pulls [imageName] from the air-gapped mirror as [mirroredImageRef] and tags it as
+// [imageName], so that the containers are created with the name the user knows. Unlike the pull-through caches, there's
+// no falling back to the registry of the image, which is unreachable in air-gapped mode.
+func (manager *DockerManager) pullImageFromAirGapMirror(ctx context.Context, imageName string, mirroredImageRef string) error {
+	if _, err := manager.dockerClient.Ping(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred communicating with docker engine")
+	}
+	logrus.Infof("Pulling image '%s' from the air-gapped mirror as '%s'", imageName, mirroredImageRef)
+	// The credentials of the registry of the image don't apply to the mirror, which uses those of the Docker config file
+	err, retryWithLinuxAmd64 := pullImage(manager.dockerClientNoTimeout, mirroredImageRef, nil, defaultPlatform)
+	if err != nil && retryWithLinuxAmd64 {
+		logrus.Debugf("Retrying pulling image '%s' for '%s'", mirroredImageRef, linuxAmd64)
+		err, _ = pullImage(manager.dockerClientNoTimeout, mirroredImageRef, nil, linuxAmd64)
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "Tried pulling image '%v' from the air-gapped mirror as '%v' but failed; export it with 'kurtosis airgap export' and push it to the mirror", imageName, mirroredImageRef)
+	}
+	if err := manager.dockerClient.ImageTag(ctx, mirroredImageRef, imageName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tagging image '%v' pulled from the air-gapped mirror as '%v'", mirroredImageRef, imageName)
+	}
+	return nil
+}
@@ -36,23 +36,33 @@ import (
 	kurtosis_sdk_version "github.com/kurtosis-tech/kurtosis/api/golang/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	docker_manager_types "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/backend_errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/concurrent_writer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_utils"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	path_compression "github.com/kurtosis-tech/kurtosis/path-compression"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/singleflight"
 
 	bksession "github.com/moby/buildkit/session"
 )
 
 const (
+	spanNamePrefix            = "DockerManager."
+	imageAttributeKey         = "kurtosis.image"
+	containerNameAttributeKey = "kurtosis.container.name"
+
 	dockerClientTimeout = 30 * time.Second
 	// We use a bridge network because, as of 2020-08-01, we're only running locally; however, this may need to change
 	//  at some point in the future
@@ -147,9 +157,13 @@ const (
 	architectureErrorString = "no matching manifest for linux/arm64/v8"
 
 	onlyReturnContainerIds = true
-	coresToMilliCores      = 1000
-	bytesInMegaBytes       = 1000000
-	dontStreamStats        = false
+
+	// Containers created only to read the files of their image are never started, so their entrypoint is never
+	// executed; it just needs to be set for images that don't define any (e.g. images built FROM scratch)
+	neverExecutedContainerEntrypoint = "/kurtosis-never-executed"
+	coresToMilliCores                = 1000
+	bytesInMegaBytes                 = 1000000
+	dontStreamStats                  = false
 
 	kurtosisTagPrefix = "kurtosistech/"
 
@@ -188,6 +202,15 @@ type DockerManager struct {
 	// We need to use a specific docker client with no timeout for long-running requests on docker, such as tailing
 	// service logs for a long time, or even downloading large container images than can take longer than the timeout
 	dockerClientNoTimeout *client.Client
+
+	// How images built and pulled through this manager are cached across enclaves and runs
+	imageCacheConfig image_cache.ImageCacheConfig
+
+	// The mirror the images are pulled from instead of their registry in air-gapped mode
+	airGapConfig air_gap.AirGapConfig
+
+	// Pulls in progress by normalized image reference, so that concurrent pulls of the same image share a single pull
+	imagePulls *singleflight.Group
 }
 
 /*
@@ -215,6 +238,9 @@ func CreateDockerManager(dockerClientOpts []client.Opt) (*DockerManager, error)
 	return &DockerManager{
 		dockerClient:          dockerClient,
 		dockerClientNoTimeout: dockerClientNoTimeout,
+		imageCacheConfig:      image_cache.NewLocalImageCacheConfig(),
+		airGapConfig:          air_gap.NewDisabledAirGapConfig(),
+		imagePulls:            new(singleflight.Group),
 	}, nil
 }
 
@@ -555,7 +581,11 @@ Returns:
 func (manager *DockerManager) CreateAndStartContainer(
 	ctx context.Context,
 	args *CreateAndStartContainerArgs,
-) (string, map[nat.Port]*nat.PortBinding, error) {
+) (_ string, _ map[nat.Port]*nat.PortBinding, resultErr error) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"CreateAndStartContainer", attribute.String(containerNameAttributeKey, args.name), attribute.String(imageAttributeKey, args.dockerImage))
+	defer func() {
+		tracing.EndSpan(span, resultErr)
+	}()
 
 	// If the user passed in a Docker image that doesn't have a tag separator (indicating no tag was specified), manually append
 	//  the Docker default tag so that when we search for the image we're searching for a very specific image
@@ -929,6 +959,23 @@ func (manager *DockerManager) KillContainer(ctx context.Context, containerId str
 	return nil
 }
 
+/*
+SignalContainer
+Sends the given signal (e.g. "SIGHUP") to the main process of the running container with the given ID
+
+Args:
+
+	context: The context that the signal is sent in
+	containerId: ID of Docker container to signal
+	signal: Name or number of the signal to send
+*/
+func (manager *DockerManager) SignalContainer(ctx context.Context, containerId string, signal string) error {
+	if err := manager.dockerClient.ContainerKill(ctx, containerId, signal); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending signal '%v' to container '%v'", signal, containerId)
+	}
+	return nil
+}
+
 /*
 RemoveContainer
 Removes the container with the given ID, deleting it permanently
@@ -985,6 +1032,22 @@ func (manager *DockerManager) WaitForExit(context context.Context, containerId s
 	return
 }
 
+// GetContainerStats gets a single sample of the resource usage of the given container. Docker takes two measurements
+// a second apart to fill it, so the CPU usage can be computed from the difference between the CPU and the PreCPU stats
+func (manager *DockerManager) GetContainerStats(ctx context.Context, containerId string) (*types.StatsJSON, error) {
+	containerStatsResponse, err := manager.dockerClient.ContainerStats(ctx, containerId, dontStreamStats)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of container '%v'", containerId)
+	}
+	defer containerStatsResponse.Body.Close()
+
+	var containerStats types.StatsJSON
+	if err = json.NewDecoder(containerStatsResponse.Body).Decode(&containerStats); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the stats of container '%v'", containerId)
+	}
+	return &containerStats, nil
+}
+
 /*
 GetContainerLogs gets the logs for the given container as a io.ReadCloser. The caller is responsible for closing the ReadCloser!!!
 
@@ -1275,13 +1338,14 @@ func (manager *DockerManager) FetchImageIfMissing(ctx context.Context, dockerIma
 	}
 	logrus.Tracef("Is image available locally?: %v", doesImageExistLocally)
 
-	if !doesImageExistLocally {
+	if !doesImageExistLocally && !manager.pullImageFromPullCache(ctx, dockerImage) {
 		logrus.Tracef("Image doesn't exist locally, so attempting to pull it...")
 		err = manager.pullImage(ctx, dockerImage, registrySpec)
 		if err != nil {
 			return false, stacktrace.Propagate(err, "Failed to pull Docker image '%v' from remote image repository", dockerImage)
 		}
 		logrus.Tracef("Image successfully pulled from remote to local")
+		manager.pushImageToPullCache(ctx, dockerImage)
 	}
 
 	return !doesImageExistLocally, nil
@@ -1312,12 +1376,15 @@ func (manager *DockerManager) FetchLatestImage(ctx context.Context, dockerImage
 			logrus.Tracef("Failed to pull Docker image '%v' from remote image repository. Going to use available local image.", dockerImage)
 		} else {
 			logrus.Tracef("Latest image successfully pulled from remote to local.")
+			manager.pushImageToPullCache(ctx, dockerImage)
 		}
 	} else {
+		// The latest image is explicitly requested, so the mirror in the image cache is only refreshed, never consulted
 		err = manager.pullImage(ctx, dockerImage, registrySpec)
 		if err != nil {
 			return stacktrace.Propagate(err, "Failed to pull Docker image '%v' from remote image repository.", dockerImage)
 		}
+		manager.pushImageToPullCache(ctx, dockerImage)
 	}
 
 	return nil
@@ -1402,6 +1469,15 @@ func (manager *DockerManager) NixBuild(ctx context.Context, nixBuildSpec *nix_bu
 }
 
 func (manager *DockerManager) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
+	buildCacheKey := manager.getBuildCacheKey(imageName, imageBuildSpec)
+	if buildCacheKey != "" && manager.loadImageFromBuildCache(ctx, imageName, buildCacheKey) {
+		imageArch, err := manager.getImagePlatform(ctx, imageName)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred attempting to get image platform for cached image '%v'.", imageName)
+		}
+		return imageArch, nil
+	}
+
 	buildContextDirPath := imageBuildSpec.GetBuildContextDir()
 	buildContextTarReader, err := getBuildContextReader(buildContextDirPath)
 	if err != nil {
@@ -1444,6 +1520,10 @@ func (manager *DockerManager) BuildImage(ctx context.Context, imageName string,
 		value := v // Go uses a single variable for loop iterations which lead to unexpected behaviours.
 		buildArgsMapStringStringPtr[k] = &value
 	}
+	imageLabels := map[string]string{}
+	if buildCacheKey != "" {
+		imageLabels[imageBuildCacheKeyLabelKey] = buildCacheKey
+	}
 	imageBuildOpts := types.ImageBuildOptions{
 		Tags:           []string{imageName},
 		SuppressOutput: false,
@@ -1469,7 +1549,7 @@ func (manager *DockerManager) BuildImage(ctx context.Context, imageName string,
 		AuthConfigs:    map[string]registry.AuthConfig{},
 		Context:        buildContextTarReader,
 		// 0.0.0 label is a hack so that images by internal testsuite are cleaned up by kurtosis clean/PruneUnusedImages
-		Labels:      map[string]string{},
+		Labels:      imageLabels,
 		Squash:      false,
 		CacheFrom:   []string{},
 		SecurityOpt: []string{},
@@ -1513,6 +1593,7 @@ func (manager *DockerManager) BuildImage(ctx context.Context, imageName string,
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred attempting to get image platform for '%v'.", imageName)
 	}
+	manager.pushImageToBuildCache(ctx, imageName, buildCacheKey)
 
 	return imageArch, nil
 }
@@ -1526,9 +1607,16 @@ func getBuildContextReader(contextDirPath string) (io.Reader, error) {
 	return buildContext, nil
 }
 
-func (manager *DockerManager) CreateContainerExec(context context.Context, containerId string, cmd []string) (*types.HijackedResponse, error) {
+// CreateContainerExec starts the command in the container, attached to a TTY, and returns the exec ID along with the
+// connection to it; an empty user runs the command as the user of the container
+func (manager *DockerManager) CreateContainerExec(context context.Context, containerId string, cmd []string, user string, envVars map[string]string) (string, *types.HijackedResponse, error) {
+	envVarsSlice := make([]string, 0, len(envVars))
+	for key, val := range envVars {
+		envVarsSlice = append(envVarsSlice, fmt.Sprintf("%v=%v", key, val))
+	}
+
 	config := types.ExecConfig{
-		User:         "",
+		User:         user,
 		Privileged:   false,
 		Tty:          shouldAttachStandardStreamsToTtyWhenCreatingContainerExec,
 		ConsoleSize:  nil,
@@ -1537,19 +1625,19 @@ func (manager *DockerManager) CreateContainerExec(context context.Context, conta
 		AttachStdout: shouldAttachStdoutWhenCreatingContainerExec,
 		Detach:       shouldExecuteInDetachModeWhenCreatingContainerExec,
 		DetachKeys:   "",
-		Env:          nil,
+		Env:          envVarsSlice,
 		WorkingDir:   "",
 		Cmd:          cmd,
 	}
 
 	response, err := manager.dockerClient.ContainerExecCreate(context, containerId, config)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "an error occurred while creating the ContainerExec in container with ID '%v'", containerId)
+		return "", nil, stacktrace.Propagate(err, "an error occurred while creating the ContainerExec in container with ID '%v'", containerId)
 	}
 
 	execID := response.ID
 	if execID == "" {
-		return nil, stacktrace.NewError("the Exec ID was empty")
+		return "", nil, stacktrace.NewError("the Exec ID was empty")
 	}
 
 	execStartCheck := types.ExecStartCheck{
@@ -1560,10 +1648,19 @@ func (manager *DockerManager) CreateContainerExec(context context.Context, conta
 
 	hijackedResponse, err := manager.dockerClient.ContainerExecAttach(context, execID, execStartCheck)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "There was an error while attaching connection to the execution process with ID '%v' in container with ID '%v'", execID, containerId)
+		return "", nil, stacktrace.Propagate(err, "There was an error while attaching connection to the execution process with ID '%v' in container with ID '%v'", execID, containerId)
 	}
 
-	return &hijackedResponse, nil
+	return execID, &hijackedResponse, nil
+}
+
+// IsContainerExecRunning returns whether the process of the exec is still running in its container
+func (manager *DockerManager) IsContainerExecRunning(context context.Context, execId string) (bool, error) {
+	inspectResponse, err := manager.dockerClient.ContainerExecInspect(context, execId)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred inspecting exec with ID '%v'", execId)
+	}
+	return inspectResponse.Running, nil
 }
 
 // CopyFromContainer returns a io.ReadCloser representing the bytes of the TAR'd files at srcPath
@@ -1595,6 +1692,71 @@ func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId
 	return tarStreamReadCloser, nil
 }
 
+// DoesPathExistInContainer returns true if a file or a directory exists at the given path of the container
+func (manager *DockerManager) DoesPathExistInContainer(ctx context.Context, containerId string, pathInContainer string) (bool, error) {
+	if _, err := manager.dockerClient.ContainerStatPath(ctx, containerId, pathInContainer); err != nil {
+		if client.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "An error occurred checking whether '%v' exists in container with ID '%v'", pathInContainer, containerId)
+	}
+	return true, nil
+}
+
+// CopyToContainer extracts the TAR'd files of the content into the directory at dstPath of the container, which must
+// already exist
+func (manager *DockerManager) CopyToContainer(ctx context.Context, containerId string, dstPath string, content io.Reader) error {
+	copyOptions := types.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: false,
+		CopyUIDGID:                false,
+	}
+	if err := manager.dockerClientNoTimeout.CopyToContainer(ctx, containerId, dstPath, content, copyOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to '%v' in container with ID '%v'", dstPath, containerId)
+	}
+	return nil
+}
+
+// CreateContainerWithoutStarting creates a container from the given image that is never started, which is useful to
+// read the files baked into the image with CopyFromContainer. The caller is responsible for removing the container
+func (manager *DockerManager) CreateContainerWithoutStarting(ctx context.Context, dockerImage string) (string, error) {
+	containerConfig := &container.Config{
+		Hostname:        "",
+		Domainname:      "",
+		User:            "",
+		AttachStdin:     false,
+		AttachStdout:    false,
+		AttachStderr:    false,
+		ExposedPorts:    nil,
+		Tty:             false,
+		OpenStdin:       false,
+		StdinOnce:       false,
+		Env:             nil,
+		Cmd:             nil,
+		Healthcheck:     nil,
+		ArgsEscaped:     false,
+		Image:           dockerImage,
+		Volumes:         nil,
+		WorkingDir:      "",
+		Entrypoint:      []string{neverExecutedContainerEntrypoint},
+		NetworkDisabled: true,
+		MacAddress:      "",
+		OnBuild:         nil,
+		Labels:          nil,
+		StopSignal:      "",
+		StopTimeout:     nil,
+		Shell:           nil,
+	}
+	containerCreateResp, err := manager.dockerClient.ContainerCreate(ctx, containerConfig, nil, nil, nil, "")
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Could not create Docker container from image '%v'", dockerImage)
+	}
+	if containerCreateResp.ID == "" {
+		return "", stacktrace.NewError("Creation of container from image '%v' succeeded without error, but we didn't get a container ID back - this is VERY strange!", dockerImage)
+	}
+	logrus.Debugf("Created container with ID '%v' from image '%v' without starting it", containerCreateResp.ID, dockerImage)
+	return containerCreateResp.ID, nil
+}
+
 // GetAvailableCPUAndMemory returns free memory in megabytes, free cpu in millicores, information on whether cpu information is complete
 func (manager *DockerManager) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, error) {
 	availableMemoryInBytes, availableCpuInMilliCores, err := getFreeMemoryAndCPU(ctx, manager.dockerClient)
@@ -1664,7 +1826,30 @@ func (manager *DockerManager) isImageAvailableLocally(imageName string) (bool, e
 	return numMatchingImages > 0, nil
 }
 
-func (manager *DockerManager) pullImage(context context.Context, imageName string, registrySpec *image_registry_spec.ImageRegistrySpec) error {
+// pullImage pulls the image from the air-gapped mirror in air-gapped mode, through the pull-through cache of its
+// registry if there's one, and from the registry otherwise. Callers pulling the same image at the same time, even under different names (e.g. 'nginx' and
+// 'docker.io/library/nginx:latest'), wait for a single pull and get its result.
+func (manager *DockerManager) pullImage(ctx context.Context, imageName string, registrySpec *image_registry_spec.ImageRegistrySpec) error {
+	_, err, isShared := manager.imagePulls.Do(image_utils.NormalizeImageReference(imageName), func() (interface{}, error) {
+		if mirroredImageRef, found := manager.airGapConfig.GetMirroredImageRef(imageName); found {
+			return nil, manager.pullImageFromAirGapMirror(ctx, imageName, mirroredImageRef)
+		}
+		if manager.pullImageThroughPullThroughCache(ctx, imageName) {
+			return nil, nil
+		}
+		return nil, manager.pullImageFromRegistry(ctx, imageName, registrySpec)
+	})
+	if isShared {
+		logrus.Debugf("Pull of image '%s' was shared with another pull of the same image", imageName)
+	}
+	return err
+}
+
+func (manager *DockerManager) pullImageFromRegistry(context context.Context, imageName string, registrySpec *image_registry_spec.ImageRegistrySpec) (resultErr error) {
+	context, span := tracing.StartSpan(context, spanNamePrefix+"PullImage", attribute.String(imageAttributeKey, imageName))
+	defer func() {
+		tracing.EndSpan(span, resultErr)
+	}()
 	// As we're using the docker client with no timeout to pull the image, we quickly check with the client that has
 	// a timeout whether the docker engine is reachable.
 	if _, err := manager.dockerClient.Ping(context); err != nil {
@@ -2356,7 +2541,7 @@ func pullImage(dockerClient *client.Client, imageName string, registrySpec *imag
 
 	out, err := dockerClient.ImagePull(pullImageCtx, imageName, imagePullOptions)
 	if err != nil {
-		return stacktrace.Propagate(err, "Tried pulling image '%v' with platform '%v' but failed", imageName, platform), false
+		return backend_errors.NewBackendError(backend_errors.ErrorKind_ImagePullBackOff, "Tried pulling image '%v' with platform '%v' but failed: %v", imageName, platform, err), false
 	}
 	defer out.Close()
 	logrus.Tracef("Finished pulling '%s' for platform '%s'. Analyzing response to check for errors", imageName, platform)
@@ -2371,7 +2556,7 @@ func pullImage(dockerClient *client.Client, imageName string, registrySpec *imag
 			return stacktrace.Propagate(err, "ImagePull for '%s' on platform '%s' failed with an unexpected error", imageName, platform), false
 		}
 		if jsonMessage.Error != nil {
-			return stacktrace.NewError("ImagePull failed with the following error '%v'", jsonMessage.Error.Message), strings.HasPrefix(jsonMessage.Error.Message, architectureErrorString)
+			return backend_errors.NewBackendError(backend_errors.ErrorKind_ImagePullBackOff, "ImagePull failed with the following error '%v'", jsonMessage.Error.Message), strings.HasPrefix(jsonMessage.Error.Message, architectureErrorString)
 		}
 	}
 	logrus.Tracef("No error pulling '%s' for platform '%s'. Returning", imageName, platform)
@@ -9,12 +9,16 @@ import "github.com/docker/docker/api/types/container"
 
 const (
 	defaultNetworkModeStr = "default"
+	hostNetworkModeStr    = "host"
 )
 
 type DockerManagerNetworkMode container.NetworkMode
 
 var DefaultNetworkMode = DockerManagerNetworkMode(defaultNetworkModeStr)
 
+// Containers in the host network mode share the network namespace of the Docker host, iptables rules included
+var HostNetworkMode = DockerManagerNetworkMode(hostNetworkModeStr)
+
 func NewContainerNetworkMode(containerId string) DockerManagerNetworkMode {
 	str := "container:" + containerId
 	return DockerManagerNetworkMode(str)
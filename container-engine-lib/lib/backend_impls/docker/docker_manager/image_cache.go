@@ -0,0 +1,240 @@
+package docker_manager
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
+	dockerregistry "github.com/docker/docker/registry"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Label set on every image built by Kurtosis, holding the key of the content it was built from so that a later build
+	// of the same content can reuse it
+	imageBuildCacheKeyLabelKey = "com.kurtosistech.image-build-cache-key"
+
+	imageLabelSearchFilterKey = "label"
+	labelKeyValueSeparator    = "="
+)
+
+// SetImageCacheConfig configures how the images built and pulled through this manager are cached
+func (manager *DockerManager) SetImageCacheConfig(imageCacheConfig image_cache.ImageCacheConfig) {
+	manager.imageCacheConfig = imageCacheConfig
+}
+
+// getBuildCacheKey returns the key of the content the image is built from, or an empty string if build caching is
+// disabled or the key couldn't be computed, in which case the image is simply built
+func (manager *DockerManager) getBuildCacheKey(imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) string {
+	if !manager.imageCacheConfig.IsEnabled() {
+		return ""
+	}
+	buildCacheKey, err := image_cache.ComputeBuildCacheKey(
+		imageBuildSpec.GetBuildContextDir(),
+		imageBuildSpec.GetBuildFile(),
+		imageBuildSpec.GetBuildArgs(),
+		imageBuildSpec.GetTargetStage(),
+	)
+	if err != nil {
+		logrus.Warnf("An error occurred computing the build cache key of image '%v'; it will be built without consulting the image cache:\n%v", imageName, err)
+		return ""
+	}
+	return buildCacheKey
+}
+
+// loadImageFromBuildCache tags a previously built image with the given build cache key as [imageName], pulling it from
+// the cache registry if it isn't available locally. Returns false if no such image could be found.
+func (manager *DockerManager) loadImageFromBuildCache(ctx context.Context, imageName string, buildCacheKey string) bool {
+	cachedImageId, err := manager.getLocalImageIdWithBuildCacheKey(ctx, buildCacheKey)
+	if err != nil {
+		logrus.Warnf("An error occurred looking up the local image cache for image '%v':\n%v", imageName, err)
+	}
+	if cachedImageId == "" && manager.imageCacheConfig.IsRegistryBacked() {
+		cacheImageRef := manager.imageCacheConfig.GetBuildCacheImageRef(buildCacheKey)
+		if err := manager.pullImageFromCacheRegistry(cacheImageRef); err != nil {
+			logrus.Debugf("Image '%v' isn't in the image cache registry yet:\n%v", cacheImageRef, err)
+			return false
+		}
+		cachedImageId = cacheImageRef
+	}
+	if cachedImageId == "" {
+		return false
+	}
+	if err := manager.dockerClient.ImageTag(ctx, cachedImageId, imageName); err != nil {
+		logrus.Warnf("An error occurred tagging cached image '%v' as '%v'; the image will be built instead:\n%v", cachedImageId, imageName, err)
+		return false
+	}
+	logrus.Infof("Reusing cached image for '%v' as its build context didn't change", imageName)
+	return true
+}
+
+func (manager *DockerManager) getLocalImageIdWithBuildCacheKey(ctx context.Context, buildCacheKey string) (string, error) {
+	labelFilter := filters.Arg(imageLabelSearchFilterKey, imageBuildCacheKeyLabelKey+labelKeyValueSeparator+buildCacheKey)
+	images, err := manager.dockerClient.ImageList(ctx, types.ImageListOptions{
+		All:            false,
+		Filters:        filters.NewArgs(labelFilter),
+		SharedSize:     false,
+		ContainerCount: false,
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred listing the images with build cache key '%v'", buildCacheKey)
+	}
+	if len(images) == 0 {
+		return "", nil
+	}
+	// Images built from the same content are interchangeable, so any of them will do
+	return images[0].ID, nil
+}
+
+// pushImageToBuildCache shares the freshly built image through the cache registry. This is best effort: failing to do so
+// only means that the next build of the same content elsewhere won't be able to reuse it
+func (manager *DockerManager) pushImageToBuildCache(ctx context.Context, imageName string, buildCacheKey string) {
+	if buildCacheKey == "" || !manager.imageCacheConfig.IsRegistryBacked() {
+		return
+	}
+	manager.pushImageToCacheRegistry(ctx, imageName, manager.imageCacheConfig.GetBuildCacheImageRef(buildCacheKey))
+}
+
+// pullImageFromPullCache pulls [imageName] from its mirror in the cache registry, returning false if the cache isn't
+// registry-backed or the image isn't mirrored yet
+func (manager *DockerManager) pullImageFromPullCache(ctx context.Context, imageName string) bool {
+	if !manager.imageCacheConfig.IsRegistryBacked() {
+		return false
+	}
+	cacheImageRef := manager.imageCacheConfig.GetPullCacheImageRef(imageName)
+	if err := manager.pullImageFromCacheRegistry(cacheImageRef); err != nil {
+		logrus.Debugf("Image '%v' isn't mirrored in the image cache registry as '%v' yet:\n%v", imageName, cacheImageRef, err)
+		return false
+	}
+	if err := manager.dockerClient.ImageTag(ctx, cacheImageRef, imageName); err != nil {
+		logrus.Warnf("An error occurred tagging cached image '%v' as '%v'; the image will be pulled from its origin instead:\n%v", cacheImageRef, imageName, err)
+		return false
+	}
+	logrus.Infof("Pulled image '%v' from the image cache registry", imageName)
+	return true
+}
+
+// pullImageThroughPullThroughCache pulls [imageName] through the pull-through cache of its registry, returning false if
+// its registry has none or the pull failed, in which case the image is to be pulled from its registry
+func (manager *DockerManager) pullImageThroughPullThroughCache(ctx context.Context, imageName string) bool {
+	pullThroughCacheImageRef, found := manager.imageCacheConfig.GetPullThroughCacheImageRef(imageName)
+	if !found {
+		return false
+	}
+	logrus.Infof("Pulling image '%s' through pull-through cache as '%s'", imageName, pullThroughCacheImageRef)
+	// The credentials of the registry of the image don't apply to the cache, which uses those of the Docker config file
+	if err, _ := pullImage(manager.dockerClientNoTimeout, pullThroughCacheImageRef, nil, defaultPlatform); err != nil {
+		logrus.Warnf("An error occurred pulling image '%v' through pull-through cache as '%v'; it will be pulled from its registry instead:\n%v", imageName, pullThroughCacheImageRef, err)
+		return false
+	}
+	if err := manager.dockerClient.ImageTag(ctx, pullThroughCacheImageRef, imageName); err != nil {
+		logrus.Warnf("An error occurred tagging image '%v' pulled through pull-through cache as '%v'; it will be pulled from its registry instead:\n%v", pullThroughCacheImageRef, imageName, err)
+		return false
+	}
+	return true
+}
+
+// pushImageToPullCache mirrors the freshly pulled [imageName] to the cache registry, best effort
+func (manager *DockerManager) pushImageToPullCache(ctx context.Context, imageName string) {
+	if !manager.imageCacheConfig.IsRegistryBacked() {
+		return
+	}
+	manager.pushImageToCacheRegistry(ctx, imageName, manager.imageCacheConfig.GetPullCacheImageRef(imageName))
+}
+
+func (manager *DockerManager) pullImageFromCacheRegistry(cacheImageRef string) error {
+	var registrySpec *image_registry_spec.ImageRegistrySpec
+	if manager.imageCacheConfig.Username != "" {
+		registrySpec = image_registry_spec.NewImageRegistrySpec(
+			cacheImageRef,
+			manager.imageCacheConfig.Username,
+			manager.imageCacheConfig.Password,
+			dockerregistry.ConvertToHostname(cacheImageRef),
+		)
+	}
+	err, _ := pullImage(manager.dockerClientNoTimeout, cacheImageRef, registrySpec, defaultPlatform)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred pulling image '%v' from the image cache registry", cacheImageRef)
+	}
+	return nil
+}
+
+func (manager *DockerManager) pushImageToCacheRegistry(ctx context.Context, imageName string, cacheImageRef string) {
+	if err := manager.dockerClient.ImageTag(ctx, imageName, cacheImageRef); err != nil {
+		logrus.Warnf("An error occurred tagging image '%v' as '%v' to push it to the image cache registry:\n%v", imageName, cacheImageRef, err)
+		return
+	}
+	logrus.Infof("Pushing image '%v' to the image cache registry as '%v'", imageName, cacheImageRef)
+	if err := pushImage(manager.dockerClientNoTimeout, cacheImageRef, manager.getCacheRegistryAuth(cacheImageRef)); err != nil {
+		logrus.Warnf("An error occurred pushing image '%v' to the image cache registry; later runs won't be able to reuse it:\n%v", cacheImageRef, err)
+	}
+}
+
+// getCacheRegistryAuth returns the encoded credentials to push to the cache registry, falling back to those of the
+// Docker config file if the image cache config doesn't hold any
+func (manager *DockerManager) getCacheRegistryAuth(cacheImageRef string) string {
+	authConfig := &registry.AuthConfig{
+		Username:      manager.imageCacheConfig.Username,
+		Password:      manager.imageCacheConfig.Password,
+		Auth:          "",
+		Email:         "",
+		ServerAddress: dockerregistry.ConvertToHostname(cacheImageRef),
+		IdentityToken: "",
+		RegistryToken: "",
+	}
+	if manager.imageCacheConfig.Username == "" {
+		dockerConfigAuth, err := GetAuthFromDockerConfig(cacheImageRef)
+		if err != nil {
+			logrus.Warnf("An error occurred while getting auth config for image cache registry of '%v', pushing without credentials: %v", cacheImageRef, err)
+		}
+		if dockerConfigAuth == nil {
+			return ""
+		}
+		authConfig = dockerConfigAuth
+	}
+	encodedAuthConfig, err := registry.EncodeAuthConfig(*authConfig)
+	if err != nil {
+		logrus.Warnf("An error occurred encoding the auth config of the image cache registry, pushing without credentials: %v", err)
+		return ""
+	}
+	return encodedAuthConfig
+}
+
+func pushImage(dockerClient *client.Client, imageRef string, registryAuth string) error {
+	// Own context for the same reason as pulls: a cancelled request shouldn't leave a half-pushed image behind
+	pushImageCtx := context.Background()
+	out, err := dockerClient.ImagePush(pushImageCtx, imageRef, types.ImagePushOptions{
+		All:           false,
+		RegistryAuth:  registryAuth,
+		PrivilegeFunc: nil,
+		Platform:      defaultPlatform,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred pushing image '%v'", imageRef)
+	}
+	defer out.Close()
+	responseDecoder := json.NewDecoder(out)
+	for {
+		jsonMessage := new(jsonmessage.JSONMessage)
+		err = responseDecoder.Decode(&jsonMessage)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "ImagePush for '%s' failed with an unexpected error", imageRef)
+		}
+		if jsonMessage.Error != nil {
+			return stacktrace.NewError("ImagePush failed with the following error '%v'", strings.TrimSpace(jsonMessage.Error.Message))
+		}
+	}
+}
@@ -34,6 +34,9 @@ const (
 
 	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
 
+	// The environment variables of the container that carry secrets, which are redacted from the enclave dumps
+	secretEnvVarsLabelKeyStr = labelNamespaceStr + "secret-env-vars"
+
 	// We create a duplicate of the enclave uuid and service uuid label key because:
 	// the logs aggregator (vector) needs the enclave uuid and service uuid label keys to create the filepath where logs are stored in persistent volume
 	// but vectors template syntax can't interpret the "com.kurtosistech." prefix, so we can't use the existing label keys
@@ -65,6 +68,7 @@ var EnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(enclaveIdLabelKeyStr
 var EnclaveNameDockerLabelKey = MustCreateNewDockerLabelKey(enclaveNameLabelKeyStr)
 var EnclaveCreationTimeLabelKey = MustCreateNewDockerLabelKey(enclaveCreationTime)
 var PrivateIPDockerLabelKey = MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
+var SecretEnvVarsDockerLabelKey = MustCreateNewDockerLabelKey(secretEnvVarsLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
 var LogsEnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsOnlyEnclaveUuidLabelKeyStr)
 var LogsServiceUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsOnlyServiceUuidDockerLabelKey)
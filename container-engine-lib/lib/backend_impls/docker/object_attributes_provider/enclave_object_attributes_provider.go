@@ -29,7 +29,8 @@ const (
 	artifactsExpanderContainerNameFragment = "files-artifacts-expander"
 	logsCollectorFragment                  = "kurtosis-logs-collector"
 	// The collector is per enclave so this is a suffix
-	logsCollectorVolumeFragment = logsCollectorFragment + "-vol"
+	logsCollectorVolumeFragment  = logsCollectorFragment + "-vol"
+	egressPolicyEnforcerFragment = "kurtosis-egress-policy-enforcer"
 
 	anyCharacterPrefixRegexToken = ".*"
 )
@@ -60,6 +61,7 @@ type DockerEnclaveObjectAttributesProvider interface {
 	) (DockerObjectAttributes, error)
 	ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error)
 	ForLogsCollectorVolume() (DockerObjectAttributes, error)
+	ForEgressPolicyEnforcerContainer() (DockerObjectAttributes, error)
 }
 
 // Private so it can't be instantiated
@@ -452,6 +454,25 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForLogsCollectorVolum
 	return objectAttributes, nil
 }
 
+// There's at most one egress policy enforcer per enclave, so its name only needs the enclave UUID
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEgressPolicyEnforcerContainer() (DockerObjectAttributes, error) {
+	name, err := provider.getNameForEnclaveObject([]string{egressPolicyEnforcerFragment})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the name for the egress policy enforcer container")
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+
+	labels[docker_label_key.ContainerTypeDockerLabelKey] = label_value_consts.EgressPolicyEnforcerContainerTypeDockerLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
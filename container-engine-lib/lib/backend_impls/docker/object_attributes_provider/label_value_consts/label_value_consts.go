@@ -21,6 +21,7 @@ const (
 	apiContainerContainerTypeLabelValueStr           = "api-container"
 	userServiceContainerTypeLabelValueStr            = "user-service"
 	filesArtifactsExpanderContainerTypeLabelValueStr = "files-artifacts-expander"
+	egressPolicyEnforcerContainerTypeLabelValueStr   = "egress-policy-enforcer"
 
 	enclaveDataVolumeTypeLabelValueStr            = "enclave-data"
 	filesArtifactExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
@@ -31,6 +32,7 @@ const (
 	logsCollectorVolumeTypeLabelValueStr          = "logs-collector-data"
 	githubAuthStorageVolumeTypeLabelValueStr      = "github-auth-storage"
 	dockerConfigStorageVolumeTypeLabelValueStr    = "docker-config-storage"
+	starlarkProgramCacheVolumeTypeLabelValueStr   = "starlark-program-cache"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -50,6 +52,7 @@ var ReverseProxyTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLab
 var APIContainerContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(apiContainerContainerTypeLabelValueStr)
 var UserServiceContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceContainerTypeLabelValueStr)
 var FilesArtifactExpanderContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactsExpanderContainerTypeLabelValueStr)
+var EgressPolicyEnforcerContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(egressPolicyEnforcerContainerTypeLabelValueStr)
 
 var EnclaveDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveDataVolumeTypeLabelValueStr)
 var FilesArtifactExpansionVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactExpansionVolumeTypeLabelValueStr)
@@ -60,3 +63,4 @@ var LogsStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDock
 var LogsCollectorVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsCollectorVolumeTypeLabelValueStr)
 var GitHubAuthStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(githubAuthStorageVolumeTypeLabelValueStr)
 var DockerConfigStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(dockerConfigStorageVolumeTypeLabelValueStr)
+var StarlarkProgramCacheVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(starlarkProgramCacheVolumeTypeLabelValueStr)
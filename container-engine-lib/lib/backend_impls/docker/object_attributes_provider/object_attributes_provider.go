@@ -24,6 +24,7 @@ const (
 	logsAggregatorConfigVolumeName = logsAggregatorName + "-config"
 	githubAuthStorageVolumeName    = "kurtosis-github-auth-storage"
 	dockerConfigStorageVolumeName  = "kurtosis-docker-config-storage"
+	starlarkProgramCacheVolumeName = "kurtosis-starlark-program-cache"
 	engineRESTAPIPortStr           = "engine-rest-api"
 	reverseProxyNamePrefix         = "kurtosis-reverse-proxy"
 )
@@ -44,6 +45,7 @@ type DockerObjectAttributesProvider interface {
 	ForReverseProxy(engineGuid engine.EngineGUID) (DockerObjectAttributes, error)
 	ForGitHubAuthStorageVolume() (DockerObjectAttributes, error)
 	ForDockerConfigStorageVolume() (DockerObjectAttributes, error)
+	ForStarlarkProgramCacheVolume() (DockerObjectAttributes, error)
 }
 
 func GetDockerObjectAttributesProvider() DockerObjectAttributesProvider {
@@ -241,6 +243,23 @@ func (provider *dockerObjectAttributesProviderImpl) ForDockerConfigStorageVolume
 	return objectAttributes, nil
 }
 
+func (provider *dockerObjectAttributesProviderImpl) ForStarlarkProgramCacheVolume() (DockerObjectAttributes, error) {
+	name, err := docker_object_name.CreateNewDockerObjectName(starlarkProgramCacheVolumeName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker object name object from string '%v'", starlarkProgramCacheVolumeName)
+	}
+
+	labels := map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue{
+		docker_label_key.VolumeTypeDockerLabelKey: label_value_consts.StarlarkProgramCacheVolumeTypeDockerLabelValue,
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+	return objectAttributes, nil
+}
+
 func (provider *dockerObjectAttributesProviderImpl) ForReverseProxy(engineGuid engine.EngineGUID) (DockerObjectAttributes, error) {
 
 	nameStr := strings.Join(
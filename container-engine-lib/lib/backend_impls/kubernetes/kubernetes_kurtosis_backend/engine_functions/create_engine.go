@@ -24,9 +24,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
 const (
@@ -34,18 +37,19 @@ const (
 
 	maxWaitForEngineContainerAvailabilityRetries         = 30
 	timeBetweenWaitForEngineContainerAvailabilityRetries = 1 * time.Second
+	engineContainerAvailabilityTimeout                   = maxWaitForEngineContainerAvailabilityRetries * timeBetweenWaitForEngineContainerAvailabilityRetries
 	httpApplicationProtocol                              = "http"
 	logsCollectorHttpPortNum                             = 9713
 	logsCollectorTcpPortNum                              = 9712
 	defaultHttpLogsAggregatorPortNum                     = 8686
 	logsVolumeName                                       = "logsdb"
+	podNameFieldPath                                     = "metadata.name"
+	podNamespaceFieldPath                                = "metadata.namespace"
 )
 
 var (
-	engineWait *port_spec.Wait = nil
-	// TODO add support for passing toleration to Engine
-	engineToleration          []apiv1.Toleration = nil
-	kurtosisEngineNodeNameKey                    = kubernetes_label_key.EngineNodeLabelKey.GetString()
+	engineWait                *port_spec.Wait = nil
+	kurtosisEngineNodeNameKey                 = kubernetes_label_key.EngineNodeLabelKey.GetString()
 )
 
 func CreateEngine(
@@ -58,9 +62,14 @@ func CreateEngine(
 	githubAuthToken string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 	engineNodeName string,
+	engineReplicas int32,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) (
@@ -185,22 +194,41 @@ func CreateEngine(
 
 	logsAggregatorDeployment := vector.NewVectorLogsAggregatorResourcesManager()
 
-	enginePod, enginePodLabels, err := createEnginePod(ctx, namespaceName, engineNodeSelectors, engineAttributesProvider, imageOrgAndRepo, imageVersionTag, envVars, privatePortSpecs, logsAggregatorDeployment.GetLogsBaseDirPath(), serviceAccount.Name, kubernetesManager)
+	// The engine decrypts the stored logs it streams with the key the logs aggregator encrypts them with
+	logsEncryptionKeyEnvVars, err := shared_helpers.GetLogsEncryptionKeyEnvVars(ctx, logsEncryptionConfig, namespaceName, kubernetesManager)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating the engine pod")
+		return nil, stacktrace.Propagate(err, "An error occurred giving the logs encryption key to the engine")
 	}
-	var shouldRemovePod = true
+
+	engineDeployment, enginePodLabels, err := createEngineDeployment(ctx, namespaceName, engineNodeSelectors, engineReplicas, engineAttributesProvider, imageOrgAndRepo, imageVersionTag, envVars, logsEncryptionKeyEnvVars, privatePortSpecs, logsAggregatorDeployment.GetLogsBaseDirPath(), serviceAccount.Name, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine deployment")
+	}
+	var shouldRemoveDeployment = true
 	defer func() {
-		if shouldRemovePod {
-			logrus.Debugf("Removing Kurtosis engine Kubernetes pod because something fails during the creation process...")
-			if err := kubernetesManager.RemovePod(ctx, enginePod); err != nil {
-				logrus.Errorf("Creating the engine didn't complete successfully, so we tried to delete Kubernetes pod '%v' that we created but an error was thrown:\n%v", enginePod.Name, err)
-				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove Kubernetes pod with name '%v'!!!!!!!", enginePod.Name)
+		if shouldRemoveDeployment {
+			logrus.Debugf("Removing Kurtosis engine Kubernetes deployment because something fails during the creation process...")
+			if err := kubernetesManager.RemoveDeployment(ctx, namespaceName, engineDeployment); err != nil {
+				logrus.Errorf("Creating the engine didn't complete successfully, so we tried to delete Kubernetes deployment '%v' that we created but an error was thrown:\n%v", engineDeployment.Name, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove Kubernetes deployment with name '%v'!!!!!!!", engineDeployment.Name)
 			}
-			logrus.Debugf("Removing Kurtosis engine Kubernetes pod succesfully removed")
+			logrus.Debugf("Removing Kurtosis engine Kubernetes deployment succesfully removed")
 		}
 	}()
 
+	if err := kubernetesManager.WaitForPodManagedByDeployment(ctx, engineDeployment, engineContainerAvailabilityTimeout); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for a pod of engine deployment '%v' to come online", engineDeployment.Name)
+	}
+	enginePods, err := kubernetesManager.GetPodsManagedByDeployment(ctx, engineDeployment)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pods of engine deployment '%v'", engineDeployment.Name)
+	}
+	if len(enginePods) == 0 {
+		return nil, stacktrace.NewError("Expected engine deployment '%v' to have at least one pod but it has none; this is a bug in Kurtosis", engineDeployment.Name)
+	}
+	// The other replicas run the same image so waiting on one of them is enough
+	enginePod := enginePods[0]
+
 	engineService, err := createEngineService(
 		ctx,
 		namespaceName,
@@ -249,7 +277,8 @@ func CreateEngine(
 		namespace:           namespace,
 		serviceAccount:      serviceAccount,
 		service:             engineService,
-		pod:                 enginePod,
+		deployment:          engineDeployment,
+		pods:                enginePods,
 		ingress:             engineIngress,
 		engineNodeSelectors: engineNodeSelectors,
 		engineNodeName:      engineNodeName,
@@ -291,42 +320,52 @@ func CreateEngine(
 			return nil, stacktrace.Propagate(err, "An error occurred waiting for the engine grpc proxy port '%v/%v' to become available", privateGrpcProxyPortSpec.GetTransportProtocol(), privateGrpcProxyPortSpec.GetNumber())
 		}*/
 
-	logrus.Infof("Starting the centralized logs components...")
-	logsAggregator, removeLogsAggregatorFunc, err := logs_aggregator_functions.CreateLogsAggregator(
-		ctx,
-		namespace.Name,
-		logsAggregatorDeployment,
-		defaultHttpLogsAggregatorPortNum,
-		sinks,
-		shouldEnablePersistentVolumeLogsCollection,
-		objAttrsProvider,
-		kubernetesManager,
-	)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator")
-	}
-	var shouldRemoveLogsAggregator = true
-	defer func() {
-		if shouldRemoveLogsAggregator {
-			removeLogsAggregatorFunc()
+	// The logs aggregator and collector read and write the logs on the nodes' filesystem, which the restricted Pod
+	// Security Standard forbids, so the logs of the services are streamed from Kubernetes and aren't kept once the
+	// services are removed
+	shouldRemoveLogsAggregator := false
+	shouldRemoveLogsCollector := false
+	if kubernetesManager.GetPodSecurityStandard().IsRestricted() {
+		logrus.Warnf("The centralized logs components aren't started under the restricted Pod Security Standard; the logs of the services are streamed from Kubernetes and are lost once the services are removed")
+	} else {
+		logrus.Infof("Starting the centralized logs components...")
+		logsAggregator, removeLogsAggregatorFunc, err := logs_aggregator_functions.CreateLogsAggregator(
+			ctx,
+			namespace.Name,
+			logsAggregatorDeployment,
+			defaultHttpLogsAggregatorPortNum,
+			sinks,
+			shouldEnablePersistentVolumeLogsCollection,
+			logsEncryptionConfig,
+			objAttrsProvider,
+			kubernetesManager,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator")
 		}
-	}()
+		shouldRemoveLogsAggregator = true
+		defer func() {
+			if shouldRemoveLogsAggregator {
+				removeLogsAggregatorFunc()
+			}
+		}()
 
-	logsCollectorDaemonSet := fluentbit.NewFluentbitLogsCollector()
+		logsCollectorDaemonSet := fluentbit.NewFluentbitLogsCollector()
 
-	// Unlike the DockerBackend, where the log collectors are deployed by the engine during enclave creation
-	// for k8s backend, the logs collector lifecycle gets managed with the engine's and is created during engine creation
-	_, removeLogsCollectorFunc, err := logs_collector_functions.CreateLogsCollector(ctx, logsCollectorTcpPortNum, logsCollectorHttpPortNum, logsCollectorDaemonSet, logsAggregator, logsCollectorFilters, logsCollectorParsers, kubernetesManager, objAttrsProvider)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector")
-	}
-	var shouldRemoveLogsCollector = true
-	defer func() {
-		if shouldRemoveLogsCollector {
-			removeLogsCollectorFunc()
+		// Unlike the DockerBackend, where the log collectors are deployed by the engine during enclave creation
+		// for k8s backend, the logs collector lifecycle gets managed with the engine's and is created during engine creation
+		_, removeLogsCollectorFunc, err := logs_collector_functions.CreateLogsCollector(ctx, logsCollectorTcpPortNum, logsCollectorHttpPortNum, logsCollectorDaemonSet, logsAggregator, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig, kubernetesManager, objAttrsProvider)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector")
 		}
-	}()
-	logrus.Infof("Centralized logs components started.")
+		shouldRemoveLogsCollector = true
+		defer func() {
+			if shouldRemoveLogsCollector {
+				removeLogsCollectorFunc()
+			}
+		}()
+		logrus.Infof("Centralized logs components started.")
+	}
 
 	shouldRemoveLogsCollector = false
 	shouldRemoveEngineNodeSelectors = false
@@ -335,7 +374,7 @@ func CreateEngine(
 	shouldRemoveServiceAccount = false
 	shouldRemoveClusterRole = false
 	shouldRemoveClusterRoleBinding = false
-	shouldRemovePod = false
+	shouldRemoveDeployment = false
 	shouldRemoveService = false
 	shouldRemoveIngress = false
 	return resultEngine, nil
@@ -441,6 +480,9 @@ func createEngineClusterRole(
 				kubernetes_manager_consts.DaemonSetsKubernetesResource,
 				kubernetes_manager_consts.DeploymentsKubernetesResource,
 				kubernetes_manager_consts.DeploymentsScaleKubernetesResource,
+				kubernetes_manager_consts.LeasesKubernetesResource,          // Necessary for the leader election between the engine replicas
+				kubernetes_manager_consts.ResourceQuotasKubernetesResource,  // Necessary to enforce the enclave quotas
+				kubernetes_manager_consts.NetworkPoliciesKubernetesResource, // Necessary to enforce the enclave egress policies
 			},
 		},
 		{
@@ -499,19 +541,21 @@ func createEngineClusterRoleBindings(
 	return clusterRoleBindings, nil
 }
 
-func createEnginePod(
+func createEngineDeployment(
 	ctx context.Context,
 	namespace string,
 	nodeSelectors map[string]string,
+	numReplicas int32,
 	engineAttributesProvider object_attributes_provider.KubernetesEngineObjectAttributesProvider,
 	imageOrgAndRepo string,
 	imageVersionTag string,
 	envVars map[string]string,
+	logsEncryptionKeyEnvVars []apiv1.EnvVar,
 	privatePorts map[string]*port_spec.PortSpec,
 	logsBaseDirPath string,
 	serviceAccountName string,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
-) (*apiv1.Pod, map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, error) {
+) (*appsv1.Deployment, map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, error) {
 	// Get Pod Attributes
 	enginePodAttributes, err := engineAttributesProvider.ForEnginePod()
 	if err != nil {
@@ -546,6 +590,13 @@ func createEnginePod(
 		}
 		engineContainerEnvVars = append(engineContainerEnvVars, envVar)
 	}
+	// The replicas need their own pod name and namespace to take part in the leader election
+	engineContainerEnvVars = append(
+		engineContainerEnvVars,
+		getEnvVarFromPodField(engine.PodNameEnvVar, podNameFieldPath),
+		getEnvVarFromPodField(engine.PodNamespaceEnvVar, podNamespaceFieldPath),
+	)
+	engineContainerEnvVars = append(engineContainerEnvVars, logsEncryptionKeyEnvVars...)
 	// nolint: exhaustruct
 	engineContainers := []apiv1.Container{
 		{
@@ -566,16 +617,43 @@ func createEnginePod(
 		},
 	}
 
+	logsVolumeSource := kubernetesManager.GetVolumeSourceForHostPath(logsBaseDirPath)
+	if kubernetesManager.GetPodSecurityStandard().IsRestricted() {
+		// The restricted standard forbids mounting the node's filesystem, and there's no logs aggregator writing the
+		// logs database there anyway, so the engine reads the logs of the services from Kubernetes instead
+		logsVolumeSource = kubernetesManager.GetVolumeSourceForEmptyDir()
+	}
 	engineVolumes := []apiv1.Volume{
 		{
 			Name:         logsVolumeName,
-			VolumeSource: kubernetesManager.GetVolumeSourceForHostPath(logsBaseDirPath),
+			VolumeSource: logsVolumeSource,
 		},
 	}
 	engineInitContainers := []apiv1.Container{}
 
-	// Create pods with engine containers and volumes in kubernetes
-	pod, err := kubernetesManager.CreatePod(
+	// The logs database is on the filesystem of the node the logs aggregator runs on, so all the engine replicas have
+	// to run on that same node to be able to read the logs; the replicas get rescheduled together if the node goes away
+	affinity := &apiv1.Affinity{
+		NodeAffinity: nil,
+		PodAffinity: &apiv1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []apiv1.PodAffinityTerm{
+				{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels:      enginePodLabelStrs,
+						MatchExpressions: nil,
+					},
+					Namespaces:        []string{namespace},
+					TopologyKey:       apiv1.LabelHostname,
+					NamespaceSelector: nil,
+				},
+			},
+			PreferredDuringSchedulingIgnoredDuringExecution: nil,
+		},
+		PodAntiAffinity: nil,
+	}
+
+	// Create the deployment running the engine replicas
+	deployment, err := kubernetesManager.CreateDeployment(
 		ctx,
 		namespace,
 		enginePodName,
@@ -584,14 +662,30 @@ func createEnginePod(
 		engineInitContainers,
 		engineContainers,
 		engineVolumes,
+		affinity,
+		numReplicas,
 		serviceAccountName,
-		apiv1.RestartPolicyNever,
-		engineToleration,
 		nodeSelectors)
 	if err != nil {
-		return nil, nil, stacktrace.Propagate(err, "An error occurred while creating the pod with name '%s' in namespace '%s' with image '%s'", enginePodName, namespace, containerImageAndTag)
+		return nil, nil, stacktrace.Propagate(err, "An error occurred while creating the deployment with name '%s' in namespace '%s' with image '%s'", enginePodName, namespace, containerImageAndTag)
+	}
+	return deployment, enginePodLabels, nil
+}
+
+func getEnvVarFromPodField(envVarName string, fieldPath string) apiv1.EnvVar {
+	return apiv1.EnvVar{
+		Name:  envVarName,
+		Value: "",
+		ValueFrom: &apiv1.EnvVarSource{
+			FieldRef: &apiv1.ObjectFieldSelector{
+				APIVersion: "",
+				FieldPath:  fieldPath,
+			},
+			ResourceFieldRef: nil,
+			ConfigMapKeyRef:  nil,
+			SecretKeyRef:     nil,
+		},
 	}
-	return pod, enginePodLabels, nil
 }
 
 func createEngineService(
@@ -652,6 +746,16 @@ func createEngineService(
 			privateRESTAPIPortSpec.GetNumber(),
 		)
 	}
+
+	// The Starlark runs started through the REST API are streamed from the replica that started them, so a client has
+	// to stick to the same replica
+	sessionAffinityConfigurator := func(updatesToApply *applyconfigurationsv1.ServiceApplyConfiguration) {
+		updatesToApply.WithSpec(applyconfigurationsv1.ServiceSpec().WithSessionAffinity(apiv1.ServiceAffinityClientIP))
+	}
+	service, err = kubernetesManager.UpdateService(ctx, namespace, engineServiceName, sessionAffinityConfigurator)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred making the clients of service '%s' in namespace '%s' stick to an engine replica", engineServiceName, namespace)
+	}
 	return service, nil
 }
 
@@ -1,6 +1,7 @@
 package engine_functions
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -20,8 +21,11 @@ type engineKubernetesResources struct {
 	// Should always be nil if namespace is nil
 	service *apiv1.Service
 
-	// Should always be nil if namespace is nil
-	pod *apiv1.Pod
+	// Should always be nil if namespace is nil; nil for engines started before the engine ran as a deployment
+	deployment *appsv1.Deployment
+
+	// The engine replicas; should always be empty if namespace is nil
+	pods []*apiv1.Pod
 
 	// Should always be nil if namespace is nil
 	ingress *netv1.Ingress
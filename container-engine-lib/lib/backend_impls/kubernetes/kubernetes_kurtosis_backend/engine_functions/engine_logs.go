@@ -57,7 +57,7 @@ func GetEngineLogs(
 		}
 		namespaceName := engineNamespace.GetName()
 
-		// get the engine's pod
+		// get the engine's pods
 		pods, err := kubernetes_resource_collectors.CollectMatchingPods(
 			ctx,
 			kubernetesManager,
@@ -79,24 +79,17 @@ func GetEngineLogs(
 
 		if len(podsForId) == 0 {
 			return stacktrace.NewError(
-				"Expected to find one engine pod in namespace '%v' for engine with GUID '%v' "+
+				"Expected to find at least one engine pod in namespace '%v' for engine with GUID '%v' "+
 					"but none was found",
 				namespaceName,
 				engineGuid,
 			)
 		}
-		if len(podsForId) > 1 {
-			return stacktrace.NewError(
-				"Expected at most one engine pod in namespace '%v' for engine with GUID '%v' "+
-					"but found '%v'",
-				namespaceName,
-				engineGuid,
-				len(pods),
-			)
-		}
 
-		podsToDump := []apiv1.Pod{
-			*podsForId[0],
+		// One pod per engine replica
+		podsToDump := []apiv1.Pod{}
+		for _, pod := range podsForId {
+			podsToDump = append(podsToDump, *pod)
 		}
 
 		if err = shared_helpers.DumpNamespacePods(ctx, kubernetesManager, engineNamespace, podsToDump, outputDirpath); err != nil {
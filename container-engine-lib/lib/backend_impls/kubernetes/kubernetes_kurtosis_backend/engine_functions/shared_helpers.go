@@ -9,9 +9,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_resource_collectors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/stacktrace"
+	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 )
@@ -20,9 +22,16 @@ func getEngineObjectsFromKubernetesResources(allResources map[engine.EngineGUID]
 	result := map[engine.EngineGUID]*engine.Engine{}
 
 	for engineGuid, resourcesForId := range allResources {
-		engineStatus, err := shared_helpers.GetContainerStatusFromPod(resourcesForId.pod)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred getting engine status from engine pod")
+		// The engine is running as long as one of its replicas is
+		engineStatus := container.ContainerStatus_Stopped
+		for _, pod := range resourcesForId.pods {
+			podStatus, err := shared_helpers.GetContainerStatusFromPod(pod)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting engine status from engine pod '%v'", pod.Name)
+			}
+			if podStatus == container.ContainerStatus_Running {
+				engineStatus = container.ContainerStatus_Running
+			}
 		}
 
 		// NOTE: We set these to nil because in Kubernetes we have no way of knowing what the public info is!
@@ -127,7 +136,8 @@ func getMatchingEngineKubernetesResources(
 				namespace:           nil,
 				serviceAccount:      nil,
 				service:             nil,
-				pod:                 nil,
+				deployment:          nil,
+				pods:                []*apiv1.Pod{},
 				ingress:             nil,
 				engineNodeName:      "",
 				engineNodeSelectors: map[string]string{},
@@ -165,7 +175,8 @@ func getMatchingEngineKubernetesResources(
 				namespace:           nil,
 				serviceAccount:      nil,
 				service:             nil,
-				pod:                 nil,
+				deployment:          nil,
+				pods:                []*apiv1.Pod{},
 				ingress:             nil,
 				engineNodeName:      "",
 				engineNodeSelectors: map[string]string{},
@@ -203,7 +214,8 @@ func getMatchingEngineKubernetesResources(
 				namespace:           nil,
 				serviceAccount:      nil,
 				service:             nil,
-				pod:                 nil,
+				deployment:          nil,
+				pods:                []*apiv1.Pod{},
 				ingress:             nil,
 				engineNodeName:      "",
 				engineNodeSelectors: map[string]string{},
@@ -278,8 +290,8 @@ func getMatchingEngineKubernetesResources(
 			service = servicesForId[0]
 		}
 
-		// Pods
-		pods, err := kubernetes_resource_collectors.CollectMatchingPods(
+		// Deployments
+		deployments, err := kubernetes_resource_collectors.CollectMatchingDeployments(
 			ctx,
 			kubernetesManager,
 			namespaceName,
@@ -290,20 +302,39 @@ func getMatchingEngineKubernetesResources(
 			},
 		)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred getting pods matching engine GUID '%v' in namespace '%v'", engineGuid, namespaceName)
+			return nil, stacktrace.Propagate(err, "An error occurred getting deployments matching engine GUID '%v' in namespace '%v'", engineGuid, namespaceName)
 		}
-		var pod *apiv1.Pod
-		if podsForId, found := pods[engineGuidStr]; found {
-			if len(podsForId) > 1 {
+		var deployment *appsv1.Deployment
+		if deploymentsForId, found := deployments[engineGuidStr]; found {
+			if len(deploymentsForId) > 1 {
 				return nil, stacktrace.NewError(
-					"Expected at most one engine pod in namespace '%v' for engine with GUID '%v' "+
+					"Expected at most one engine deployment in namespace '%v' for engine with GUID '%v' "+
 						"but found '%v'",
 					namespaceName,
 					engineGuid,
-					len(pods),
+					len(deployments),
 				)
 			}
-			pod = podsForId[0]
+			deployment = deploymentsForId[0]
+		}
+
+		// Pods, one per engine replica
+		pods, err := kubernetes_resource_collectors.CollectMatchingPods(
+			ctx,
+			kubernetesManager,
+			namespaceName,
+			engineMatchLabels,
+			kubernetes_label_key.IDKubernetesLabelKey.GetString(),
+			map[string]bool{
+				engineGuidStr: true,
+			},
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting pods matching engine GUID '%v' in namespace '%v'", engineGuid, namespaceName)
+		}
+		enginePods := []*apiv1.Pod{}
+		if podsForId, found := pods[engineGuidStr]; found {
+			enginePods = podsForId
 		}
 
 		// Ingress
@@ -350,7 +381,8 @@ func getMatchingEngineKubernetesResources(
 		}
 
 		engineResources.service = service
-		engineResources.pod = pod
+		engineResources.deployment = deployment
+		engineResources.pods = enginePods
 		engineResources.serviceAccount = serviceAccount
 		engineResources.ingress = ingress
 		engineResources.engineNodeSelectors = engineNodeSelectors
@@ -36,13 +36,13 @@ func StopEngines(
 		}
 		namespaceName := resources.namespace.Name
 
-		if resources.pod != nil {
-			podName := resources.pod.Name
-			if err := kubernetesManager.RemovePod(ctx, resources.pod); err != nil {
+		if resources.deployment != nil {
+			deploymentName := resources.deployment.Name
+			if err := kubernetesManager.RemoveDeployment(ctx, namespaceName, resources.deployment); err != nil {
 				erroredEngineGuids[engineGuid] = stacktrace.Propagate(
 					err,
-					"An error occurred removing pod '%v' in namespace '%v' for engine '%v'",
-					podName,
+					"An error occurred removing deployment '%v' in namespace '%v' for engine '%v'",
+					deploymentName,
 					namespaceName,
 					engineGuid,
 				)
@@ -50,6 +50,26 @@ func StopEngines(
 			}
 		}
 
+		// The pods of the deployment would get removed with it anyway, but engines started before the engine ran as a
+		// deployment only have a pod
+		var podRemovalErr error
+		for _, pod := range resources.pods {
+			if err := kubernetesManager.RemovePod(ctx, pod); err != nil {
+				podRemovalErr = stacktrace.Propagate(
+					err,
+					"An error occurred removing pod '%v' in namespace '%v' for engine '%v'",
+					pod.Name,
+					namespaceName,
+					engineGuid,
+				)
+				break
+			}
+		}
+		if podRemovalErr != nil {
+			erroredEngineGuids[engineGuid] = podRemovalErr
+			continue
+		}
+
 		if resources.engineNodeName != "" {
 			engineNodeName := resources.engineNodeName
 			engineNodeSelectors := resources.engineNodeSelectors
@@ -2,7 +2,9 @@ package kubernetes_kurtosis_backend
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sort"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions/implementations/vector"
@@ -39,7 +41,10 @@ const (
 	isResourceInformationComplete                      = false
 	noProductionMode                                   = false
 	anyNodeEngineNodeName                              = "" // engine can be scheduled by k8s on any node
+	defaultEngineReplicas                              = 1
 	defaultShouldTurnOffPersistentVolumeLogsCollection = false
+
+	envBinary = "env"
 )
 
 type KubernetesKurtosisBackend struct {
@@ -59,6 +64,9 @@ type KubernetesKurtosisBackend struct {
 
 	// Name of node that engine will get scheduled on via a node selector
 	engineNodeName string
+
+	// Number of engine replicas to run
+	engineReplicas int32
 }
 
 func (backend *KubernetesKurtosisBackend) DumpKurtosis(ctx context.Context, outputDirpath string) error {
@@ -74,8 +82,9 @@ func newKubernetesKurtosisBackend(
 	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
 	productionMoe bool,
 	engineNodeName string,
+	engineReplicas int32,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) *KubernetesKurtosisBackend {
-	objAttrsProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider()
 	return &KubernetesKurtosisBackend{
 		kubernetesManager:    kubernetesManager,
 		objAttrsProvider:     objAttrsProvider,
@@ -84,6 +93,7 @@ func newKubernetesKurtosisBackend(
 		apiContainerModeArgs: apiContainerModeArgs,
 		productionMode:       productionMoe,
 		engineNodeName:       engineNodeName,
+		engineReplicas:       engineReplicas,
 	}
 }
 
@@ -93,6 +103,7 @@ func NewAPIContainerKubernetesKurtosisBackend(
 	ownNamespaceName string,
 	storageClassName string,
 	productionMode bool,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) *KubernetesKurtosisBackend {
 	modeArgs := shared_helpers.NewApiContainerModeArgs(ownEnclaveUuid, ownNamespaceName, storageClassName)
 	return newKubernetesKurtosisBackend(
@@ -102,11 +113,14 @@ func NewAPIContainerKubernetesKurtosisBackend(
 		modeArgs,
 		productionMode,
 		anyNodeEngineNodeName,
+		defaultEngineReplicas,
+		objAttrsProvider,
 	)
 }
 
 func NewEngineServerKubernetesKurtosisBackend(
 	kubernetesManager *kubernetes_manager.KubernetesManager,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) *KubernetesKurtosisBackend {
 	modeArgs := &shared_helpers.EngineServerModeArgs{}
 	return newKubernetesKurtosisBackend(
@@ -116,12 +130,16 @@ func NewEngineServerKubernetesKurtosisBackend(
 		nil,
 		noProductionMode,
 		anyNodeEngineNodeName,
+		defaultEngineReplicas,
+		objAttrsProvider,
 	)
 }
 
 func NewCLIModeKubernetesKurtosisBackend(
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 	engineNodeName string,
+	engineReplicas int32,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) *KubernetesKurtosisBackend {
 	modeArgs := &shared_helpers.CliModeArgs{}
 	return newKubernetesKurtosisBackend(
@@ -131,6 +149,8 @@ func NewCLIModeKubernetesKurtosisBackend(
 		nil,
 		noProductionMode,
 		engineNodeName,
+		engineReplicas,
+		objAttrsProvider,
 	)
 }
 
@@ -144,6 +164,11 @@ func (backend *KubernetesKurtosisBackend) PruneUnusedImages(ctx context.Context)
 	return nil, nil
 }
 
+func (backend *KubernetesKurtosisBackend) ListUnusedImages(ctx context.Context) ([]string, error) {
+	logrus.Warnf("ListUnusedImages isn't implemented for Kubernetes yet")
+	return nil, nil
+}
+
 func (backend *KubernetesKurtosisBackend) CreateEngine(
 	ctx context.Context,
 	imageOrgAndRepo string,
@@ -154,8 +179,12 @@ func (backend *KubernetesKurtosisBackend) CreateEngine(
 	githubAuthToken string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) (
 	*engine.Engine,
 	error,
@@ -170,9 +199,14 @@ func (backend *KubernetesKurtosisBackend) CreateEngine(
 		githubAuthToken,
 		sinks,
 		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
 		backend.engineNodeName,
+		backend.engineReplicas,
 		backend.kubernetesManager,
 		backend.objAttrsProvider,
 	)
@@ -248,7 +282,8 @@ func (backend *KubernetesKurtosisBackend) RegisterUserServices(ctx context.Conte
 		backend.cliModeArgs,
 		backend.apiContainerModeArgs,
 		backend.engineServerModeArgs,
-		backend.kubernetesManager)
+		backend.kubernetesManager,
+		backend.objAttrsProvider)
 	if err != nil {
 		var serviceIds []service.ServiceName
 		for serviceId := range services {
@@ -300,6 +335,7 @@ func (backend *KubernetesKurtosisBackend) StartRegisteredUserServices(
 		backend.apiContainerModeArgs,
 		backend.engineServerModeArgs,
 		backend.kubernetesManager,
+		backend.objAttrsProvider,
 		restartPolicy)
 	if err != nil {
 		var serviceUuids []service.ServiceUUID
@@ -370,6 +406,36 @@ func (backend *KubernetesKurtosisBackend) GetUserServiceLogs(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) GetUserServiceResourceUsage(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (successfulUserServiceResourceUsage map[service.ServiceUUID]*service.ResourceUsage, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	return user_services_functions.GetUserServiceResourceUsage(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) GetUserServiceExitStates(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (successfulUserServiceExitStates map[service.ServiceUUID]*service.ExitState, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	return user_services_functions.GetUserServiceExitStates(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -411,13 +477,28 @@ func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommandWithStreamedO
 		backend.kubernetesManager)
 }
 
-func (backend *KubernetesKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error) {
+func (backend *KubernetesKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions) (isSessionDisconnected bool, resultErr error) {
+	// Kubernetes execs always run as the user of the container
+	if shellOptions.GetUser() != "" {
+		return false, stacktrace.NewError("--user not implemented for kurtosis backend")
+	}
 	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveUuid, serviceUuid, backend.cliModeArgs, backend.apiContainerModeArgs, backend.engineServerModeArgs, backend.kubernetesManager)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting user service object & Kubernetes resources for service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+		return false, stacktrace.Propagate(err, "An error occurred getting user service object & Kubernetes resources for service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
 	}
 	pod := objectAndResources.KubernetesResources.Pod
-	return backend.kubernetesManager.GetExecStream(ctx, pod)
+
+	// Kubernetes execs don't take env vars either, so they're set by running the shell through 'env'
+	shellCommand := shellOptions.GetShellCommand()
+	if len(shellOptions.GetEnvVars()) > 0 {
+		envVarDeclarations := []string{}
+		for envVarKey, envVarValue := range shellOptions.GetEnvVars() {
+			envVarDeclarations = append(envVarDeclarations, fmt.Sprintf("%v=%v", envVarKey, envVarValue))
+		}
+		sort.Strings(envVarDeclarations)
+		shellCommand = append(append([]string{envBinary}, envVarDeclarations...), shellCommand...)
+	}
+	return backend.kubernetesManager.GetExecStream(ctx, pod, shellCommand)
 }
 
 func (backend *KubernetesKurtosisBackend) CopyFilesFromUserService(
@@ -439,6 +520,35 @@ func (backend *KubernetesKurtosisBackend) CopyFilesFromUserService(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpath string,
+	tarContent io.Reader,
+) error {
+	return user_services_functions.CopyFilesToUserService(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		dstDirpath,
+		tarContent,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) CopyFilesFromImage(
+	ctx context.Context,
+	image string,
+	srcPathOnImage string,
+	output io.Writer,
+) error {
+	// TODO IMPLEMENT
+	return stacktrace.NewError("Copying files from an image isn't yet implemented in Kubernetes.")
+}
+
 func (backend *KubernetesKurtosisBackend) StopUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (resultSuccessfulGuids map[service.ServiceUUID]bool, resultErroredGuids map[service.ServiceUUID]error, resultErr error) {
 	return user_services_functions.StopUserServices(
 		ctx,
@@ -480,6 +590,9 @@ func (backend *KubernetesKurtosisBackend) GetLogsAggregator(
 }
 
 func (backend *KubernetesKurtosisBackend) CreateLogsAggregator(ctx context.Context, httpPortNum uint16, sinks logs_aggregator.Sinks) (*logs_aggregator.LogsAggregator, error) {
+	if backend.kubernetesManager.GetPodSecurityStandard().IsRestricted() {
+		return nil, stacktrace.NewError("The logs aggregator can't run under the restricted Pod Security Standard as it writes the logs on the nodes' filesystem")
+	}
 	logsAggregatorDeployment := vector.NewVectorLogsAggregatorResourcesManager()
 
 	logsAggregator, _, err := logs_aggregator_functions.CreateLogsAggregator(
@@ -489,6 +602,7 @@ func (backend *KubernetesKurtosisBackend) CreateLogsAggregator(ctx context.Conte
 		httpPortNum,
 		sinks,
 		defaultShouldTurnOffPersistentVolumeLogsCollection,
+		logs_aggregator.NewDisabledLogsEncryptionConfig(),
 		backend.objAttrsProvider,
 		backend.kubernetesManager)
 	if err != nil {
@@ -506,6 +620,26 @@ func (backend *KubernetesKurtosisBackend) DestroyLogsAggregator(ctx context.Cont
 	return nil
 }
 
+func (backend *KubernetesKurtosisBackend) UpdateLogsAggregatorSinks(ctx context.Context, sinks logs_aggregator.Sinks, shouldEnablePersistentVolumeLogsCollection bool, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig) error {
+	if backend.kubernetesManager.GetPodSecurityStandard().IsRestricted() {
+		logrus.Warnf("Ignoring the logs aggregator sinks as there's no logs aggregator under the restricted Pod Security Standard")
+		return nil
+	}
+	logsAggregatorDeployment := vector.NewVectorLogsAggregatorResourcesManager()
+
+	if err := logs_aggregator_functions.UpdateLogsAggregatorSinks(
+		ctx,
+		logsAggregatorDeployment,
+		sinks,
+		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
+		backend.objAttrsProvider,
+		backend.kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the sinks of the logs aggregator.")
+	}
+	return nil
+}
+
 func (backend *KubernetesKurtosisBackend) CreateLogsCollectorForEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -513,10 +647,18 @@ func (backend *KubernetesKurtosisBackend) CreateLogsCollectorForEnclave(
 	logsCollectorTcpPortNumber uint16,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) (
 	*logs_collector.LogsCollector,
 	error,
 ) {
+	if backend.kubernetesManager.GetPodSecurityStandard().IsRestricted() {
+		// The logs of the services are streamed from Kubernetes instead
+		logrus.Infof("Not creating a logs collector for enclave '%v' under the restricted Pod Security Standard", enclaveUuid)
+		return nil, nil
+	}
 	var logsAggregator *logs_aggregator.LogsAggregator
 	maybeLogsAggregator, err := logs_aggregator_functions.GetLogsAggregator(ctx, backend.kubernetesManager)
 	if err != nil {
@@ -550,6 +692,9 @@ func (backend *KubernetesKurtosisBackend) CreateLogsCollectorForEnclave(
 		logsAggregator,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
 		backend.kubernetesManager,
 		backend.objAttrsProvider,
 	)
@@ -571,7 +716,18 @@ func (backend *KubernetesKurtosisBackend) GetLogsCollectorForEnclave(ctx context
 	return maybeLogsCollector, nil
 }
 
+func (backend *KubernetesKurtosisBackend) GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error) {
+	logsCollectorStatuses, err := logs_collector_functions.GetLogsCollectorStatuses(ctx, backend.kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the statuses of the logs collectors")
+	}
+	return logsCollectorStatuses, nil
+}
+
 func (backend *KubernetesKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	if backend.kubernetesManager.GetPodSecurityStandard().IsRestricted() {
+		return nil
+	}
 	if err := logs_collector_functions.DestroyLogsCollector(ctx, backend.kubernetesManager); err != nil {
 		return stacktrace.Propagate(err, "An error occurred destroying logs collector.")
 	}
@@ -579,6 +735,31 @@ func (backend *KubernetesKurtosisBackend) DestroyLogsCollectorForEnclave(ctx con
 	return nil
 }
 
+func (backend *KubernetesKurtosisBackend) UpdateLogsCollectorFiltersForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters []logs_collector.Filter) error {
+	if backend.kubernetesManager.GetPodSecurityStandard().IsRestricted() {
+		return stacktrace.NewError("The logs of enclave '%v' can't be filtered as there's no logs collector under the restricted Pod Security Standard", enclaveUuid)
+	}
+	if err := logs_collector.ValidateEnclaveFilters(filters); err != nil {
+		return stacktrace.Propagate(err, "The logs collector filters of enclave '%v' are invalid", enclaveUuid)
+	}
+	enclaveNamespaceName, err := backend.getEnclaveNamespaceName(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the namespace of enclave '%v'", enclaveUuid)
+	}
+
+	if err := logs_collector_functions.UpdateLogsCollectorEnclaveFilters(
+		ctx,
+		enclaveUuid,
+		enclaveNamespaceName,
+		filters,
+		fluentbit.NewFluentbitLogsCollector(),
+		backend.kubernetesManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the filters of the logs collector for enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
 func (backend *KubernetesKurtosisBackend) GetReverseProxy(
 	ctx context.Context,
 ) (*reverse_proxy.ReverseProxy, error) {
@@ -10,11 +10,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	kubernetes_manager_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_resource_collectors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
@@ -133,6 +135,12 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 	apiContainerPodName := apiContainerPodAttributes.GetName().GetString()
 	apiContainerPodLabels := shared_helpers.GetStringMapFromLabelMap(apiContainerPodAttributes.GetLabels())
 	apiContainerPodAnnotations := shared_helpers.GetStringMapFromAnnotationMap(apiContainerPodAttributes.GetAnnotations())
+	// The arguments of the API container carry the credentials of the stores and of the secrets provider it uses
+	customEnvVarNames := []string{}
+	for customEnvVarName := range customEnvVars {
+		customEnvVarNames = append(customEnvVarNames, customEnvVarName)
+	}
+	apiContainerPodAnnotations[kubernetes_annotation_key_consts.SecretEnvVarsKubernetesAnnotationKey.GetString()] = secrets_provider.SerializeSecretEnvVarNames(customEnvVarNames)
 
 	// Get Service Attributes
 	apiContainerServiceAttributes, err := apiContainerAttributesProvider.ForApiContainerService(
@@ -268,6 +276,19 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 				kubernetes_manager_consts.NodesKubernetesResource,
 			},
 		},
+		{
+			// Necessary for the API container to read the secrets referenced by Starlark when the secrets provider of the
+			// cluster is Kubernetes, from the namespace picked in the config of the cluster
+			Verbs: []string{
+				kubernetes_manager_consts.GetKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.SecretsKubernetesResource,
+			},
+		},
 	}
 
 	apiContainerClusterRole, err := backend.kubernetesManager.CreateClusterRoles(ctx, clusterRoleName, clusterRolePolicyRules, clusterRoleLabels)
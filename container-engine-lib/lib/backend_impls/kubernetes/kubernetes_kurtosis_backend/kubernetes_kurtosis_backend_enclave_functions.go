@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions/implementations/fluentbit"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"net"
 	"strings"
 	"time"
 
@@ -16,17 +17,38 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
 
+const (
+	enclaveServicesResourceQuotaName = "kurtosis-enclave-services-quota"
+	enclaveComputeResourceQuotaName  = "kurtosis-enclave-compute-quota"
+
+	// The API container pod lives in the enclave namespace next to the user service pods
+	numApiContainerPodsInEnclave = 1
+
+	enclaveQuotaMegabytesToBytesFactor = 1_000_000
+
+	enclaveEgressNetworkPolicyName = "kurtosis-enclave-egress-policy"
+
+	// The user services keep resolving names through the cluster DNS, whichever the allowed destinations are
+	dnsPortNum = 53
+)
+
 // Any of these values being nil indicates that the resource doesn't exist
 type enclaveKubernetesResources struct {
 	// Will never be nil because enclaves are defined by namespaces
@@ -171,6 +193,97 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+// CreateEnclaveQuota maps the quota onto ResourceQuota objects in the enclave namespace. The number of services caps the
+// pods of the namespace, the API container included. CPU and memory cap the limits of the pods that set any, which
+// leaves the API container out; the API container requires every service to set them once capped
+func (backend *KubernetesKurtosisBackend) CreateEnclaveQuota(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	quota enclave_quota.EnclaveQuota,
+) error {
+	if quota.IsUnlimited() {
+		return nil
+	}
+
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot create the quota of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+	namespaceName := namespace.GetName()
+	resourceQuotaLabels := map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():       label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString(): string(enclaveUuid),
+	}
+
+	if quota.MaxServices != 0 {
+		servicesHardLimits := apiv1.ResourceList{
+			apiv1.ResourcePods: *resource.NewQuantity(int64(quota.MaxServices)+numApiContainerPodsInEnclave, resource.DecimalSI),
+		}
+		if _, err := backend.kubernetesManager.CreateResourceQuota(ctx, namespaceName, enclaveServicesResourceQuotaName, resourceQuotaLabels, servicesHardLimits, nil); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the resource quota capping the number of services of enclave '%v'", enclaveUuid)
+		}
+	}
+
+	computeHardLimits := apiv1.ResourceList{}
+	if quota.MaxCpuMilliCores != 0 {
+		computeHardLimits[apiv1.ResourceLimitsCPU] = *resource.NewMilliQuantity(int64(quota.MaxCpuMilliCores), resource.DecimalSI)
+	}
+	if quota.MaxMemoryMegabytes != 0 {
+		computeHardLimits[apiv1.ResourceLimitsMemory] = *resource.NewQuantity(int64(quota.MaxMemoryMegabytes*enclaveQuotaMegabytesToBytesFactor), resource.DecimalSI)
+	}
+	if len(computeHardLimits) > 0 {
+		computeScopes := []apiv1.ResourceQuotaScope{apiv1.ResourceQuotaScopeNotBestEffort}
+		if _, err := backend.kubernetesManager.CreateResourceQuota(ctx, namespaceName, enclaveComputeResourceQuotaName, resourceQuotaLabels, computeHardLimits, computeScopes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the resource quota capping the CPU and memory of enclave '%v'", enclaveUuid)
+		}
+	}
+
+	return nil
+}
+
+// CreateEnclaveEgressPolicy maps the egress policy onto a NetworkPolicy selecting the user service pods of the enclave
+// namespace. They can still reach the pods of the namespace and the cluster DNS, and otherwise only the allowed CIDRs;
+// the allowed domains get resolved to CIDRs once, when the policy gets created. The policy is only enforced if the
+// network plugin of the cluster supports NetworkPolicies
+func (backend *KubernetesKurtosisBackend) CreateEnclaveEgressPolicy(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	egressPolicy *egress_policy.EgressPolicy,
+) error {
+	if egressPolicy == nil {
+		return nil
+	}
+
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot create the egress policy of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+	namespaceName := namespace.GetName()
+
+	allowedCidrs, err := egressPolicy.ResolveAllowedCidrs(ctx, net.DefaultResolver)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred resolving the CIDRs allowed by the egress policy of enclave '%v'", enclaveUuid)
+	}
+
+	networkPolicyLabels := map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():       label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString(): string(enclaveUuid),
+	}
+	networkPolicySpec := getEgressNetworkPolicySpec(allowedCidrs)
+	if _, err := backend.kubernetesManager.CreateNetworkPolicy(ctx, namespaceName, enclaveEgressNetworkPolicyName, networkPolicyLabels, networkPolicySpec); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the network policy restricting the egress of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
 func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -267,6 +380,10 @@ func (backend *KubernetesKurtosisBackend) DumpEnclave(ctx context.Context, encla
 		return stacktrace.Propagate(err, "An error occurred dumping pods '%+v' in namespace '%v'", podsToDump, namespace.GetName())
 	}
 
+	if err = shared_helpers.DumpNamespaceManifests(namespace, kubernetesResources.services, podsToDump, outputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the manifests of namespace '%v'", namespace.GetName())
+	}
+
 	return nil
 }
 
@@ -283,54 +400,31 @@ func (backend *KubernetesKurtosisBackend) DestroyEnclaves(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave Kubernetes resources matching filters: %+v", filters)
 	}
 
+	// The enclaves are destroyed in parallel, as removing a namespace waits for all of its pods to be gone
+	destroyEnclaveOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for enclaveId, resources := range matchingResources {
+		destroyEnclaveOperations[operation_parallelizer.OperationID(enclaveId)] = backend.createDestroyEnclaveOperation(ctx, enclaveId, resources)
+	}
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(destroyEnclaveOperations)
+
 	successfulEnclaveIds := map[enclave.EnclaveUUID]bool{}
+	for operationId := range successfulOperations {
+		successfulEnclaveIds[enclave.EnclaveUUID(operationId)] = true
+	}
 	erroredEnclaveIds := map[enclave.EnclaveUUID]error{}
-	for enclaveId, resources := range matchingResources {
-		// Remove the namespace
-		if resources.namespace != nil {
-			namespaceName := resources.namespace.Name
-			if err := backend.kubernetesManager.RemoveNamespace(ctx, resources.namespace); err != nil {
-				erroredEnclaveIds[enclaveId] = stacktrace.Propagate(
-					err,
-					"An error occurred removing namespace '%v' for enclave '%v'",
-					namespaceName,
-					enclaveId,
-				)
-				continue
-			}
-		}
+	for operationId, operationErr := range failedOperations {
+		erroredEnclaveIds[enclave.EnclaveUUID(operationId)] = operationErr
+	}
 
-		// Remove custom API container Cluster Role Bindings
-		if resources.clusterRoleBindings != nil {
-			for _, clusterRoleBinding := range resources.clusterRoleBindings {
-				if err := backend.kubernetesManager.RemoveClusterRoleBindings(ctx, &clusterRoleBinding); err != nil {
-					erroredEnclaveIds[enclaveId] = stacktrace.Propagate(
-						err,
-						"An error occurred removing cluster role binding '%v' for enclave '%v'",
-						clusterRoleBinding.Name,
-						enclaveId,
-					)
-					continue
-				}
-			}
+	if len(successfulEnclaveIds) > 0 && !backend.kubernetesManager.GetPodSecurityStandard().IsRestricted() {
+		destroyedEnclaveUuids := make([]enclave.EnclaveUUID, 0, len(successfulEnclaveIds))
+		for enclaveUuid := range successfulEnclaveIds {
+			destroyedEnclaveUuids = append(destroyedEnclaveUuids, enclaveUuid)
 		}
-
-		// Remove custom API container Cluster Role
-		if resources.clusterRoles != nil {
-			for _, clusterRole := range resources.clusterRoles {
-				if err := backend.kubernetesManager.RemoveClusterRole(ctx, &clusterRole); err != nil {
-					erroredEnclaveIds[enclaveId] = stacktrace.Propagate(
-						err,
-						"An error occurred removing cluster role '%v' for enclave '%v'",
-						clusterRole.Name,
-						enclaveId,
-					)
-					continue
-				}
-			}
+		// the filters of the destroyed enclaves don't match any log anymore, so failing to remove them isn't fatal
+		if err := logs_collector_functions.RemoveLogsCollectorEnclavesFilters(ctx, destroyedEnclaveUuids, fluentbit.NewFluentbitLogsCollector(), backend.kubernetesManager); err != nil {
+			logrus.Warnf("An error occurred removing the logs collector filters of the destroyed enclaves:\n%v", err)
 		}
-
-		successfulEnclaveIds[enclaveId] = true
 	}
 
 	// if destroy is deleting ALL enclaves, now's a good time to clean up log stuff
@@ -549,6 +643,53 @@ func (backend *KubernetesKurtosisBackend) createGetEnclaveResourcesOperation(
 	}
 }
 
+func (backend *KubernetesKurtosisBackend) createDestroyEnclaveOperation(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	resources *enclaveKubernetesResources,
+) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
+		// Remove the namespace
+		if resources.namespace != nil {
+			namespaceName := resources.namespace.Name
+			if err := backend.kubernetesManager.RemoveNamespace(ctx, resources.namespace); err != nil {
+				return nil, stacktrace.Propagate(
+					err,
+					"An error occurred removing namespace '%v' for enclave '%v'",
+					namespaceName,
+					enclaveId,
+				)
+			}
+		}
+
+		// Remove custom API container Cluster Role Bindings
+		for _, clusterRoleBinding := range resources.clusterRoleBindings {
+			if err := backend.kubernetesManager.RemoveClusterRoleBindings(ctx, &clusterRoleBinding); err != nil {
+				return nil, stacktrace.Propagate(
+					err,
+					"An error occurred removing cluster role binding '%v' for enclave '%v'",
+					clusterRoleBinding.Name,
+					enclaveId,
+				)
+			}
+		}
+
+		// Remove custom API container Cluster Role
+		for _, clusterRole := range resources.clusterRoles {
+			if err := backend.kubernetesManager.RemoveClusterRole(ctx, &clusterRole); err != nil {
+				return nil, stacktrace.Propagate(
+					err,
+					"An error occurred removing cluster role '%v' for enclave '%v'",
+					clusterRole.Name,
+					enclaveId,
+				)
+			}
+		}
+
+		return nil, nil
+	}
+}
+
 func getEnclaveObjectsFromKubernetesResources(
 	allResources map[enclave.EnclaveUUID]*enclaveKubernetesResources,
 ) (
@@ -638,3 +779,48 @@ func getEnclaveNameFromEnclaveNamespace(namespace *apiv1.Namespace) string {
 
 	return enclaveCreationTimeStr
 }
+
+func getEgressNetworkPolicySpec(allowedCidrs []*net.IPNet) netv1.NetworkPolicySpec {
+	userServicePodSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		},
+		MatchExpressions: nil,
+	}
+
+	udpProtocol := apiv1.ProtocolUDP
+	tcpProtocol := apiv1.ProtocolTCP
+	dnsPort := intstr.FromInt(dnsPortNum)
+	// nolint: exhaustruct
+	egressRules := []netv1.NetworkPolicyEgressRule{
+		{
+			// An empty pod selector matches every pod of the enclave namespace
+			To: []netv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}},
+		},
+		{
+			Ports: []netv1.NetworkPolicyPort{
+				{Protocol: &udpProtocol, Port: &dnsPort},
+				{Protocol: &tcpProtocol, Port: &dnsPort},
+			},
+		},
+	}
+
+	if len(allowedCidrs) > 0 {
+		allowedPeers := []netv1.NetworkPolicyPeer{}
+		for _, allowedCidr := range allowedCidrs {
+			// nolint: exhaustruct
+			allowedPeers = append(allowedPeers, netv1.NetworkPolicyPeer{
+				IPBlock: &netv1.IPBlock{CIDR: allowedCidr.String()},
+			})
+		}
+		// nolint: exhaustruct
+		egressRules = append(egressRules, netv1.NetworkPolicyEgressRule{To: allowedPeers})
+	}
+
+	return netv1.NetworkPolicySpec{
+		PodSelector: userServicePodSelector,
+		Ingress:     nil,
+		Egress:      egressRules,
+		PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeEgress},
+	}
+}
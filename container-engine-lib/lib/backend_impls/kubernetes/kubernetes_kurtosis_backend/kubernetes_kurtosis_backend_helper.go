@@ -3,27 +3,53 @@ package kubernetes_kurtosis_backend
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/metrics_reporting"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/stacktrace"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"os"
 )
 
-func GetCLIBackend(ctx context.Context, storageClass string, engineNodeName string) (backend_interface.KurtosisBackend, error) {
+const (
+	// Uses the current context of the kubeconfig
+	currentKubernetesContext = ""
+)
+
+func GetCLIBackend(ctx context.Context, storageClass string, engineNodeName string, engineReplicas int32, clientConfig kubernetes_manager.ClientConfig, podSecurityStandard kubernetes_manager.PodSecurityStandard, airGapConfig air_gap.AirGapConfig, objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider) (backend_interface.KurtosisBackend, error) {
+	return GetCLIBackendForKubernetesContext(ctx, currentKubernetesContext, storageClass, engineNodeName, engineReplicas, clientConfig, podSecurityStandard, airGapConfig, objAttrsProvider)
+}
+
+// GetCLIBackendForKubernetesContext is GetCLIBackend for the cluster of the given context of the kubeconfig instead of
+// the current one, e.g. to reach the enclaves in the other clusters of a multi-cluster engine
+func GetCLIBackendForKubernetesContext(
+	ctx context.Context,
+	kubernetesContext string,
+	storageClass string,
+	engineNodeName string,
+	engineReplicas int32,
+	clientConfig kubernetes_manager.ClientConfig,
+	podSecurityStandard kubernetes_manager.PodSecurityStandard,
+	airGapConfig air_gap.AirGapConfig,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+) (backend_interface.KurtosisBackend, error) {
+	configOverrides := new(clientcmd.ConfigOverrides)
+	configOverrides.CurrentContext = kubernetesContext
 	kubernetesConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-		clientcmd.NewDefaultClientConfigLoadingRules(), nil,
+		clientcmd.NewDefaultClientConfigLoadingRules(), configOverrides,
 	).ClientConfig()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating kubernetes configuration")
 	}
 
 	backendSupplier := func(_ context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*KubernetesKurtosisBackend, error) {
-		return NewCLIModeKubernetesKurtosisBackend(kubernetesManager, engineNodeName), nil
+		return NewCLIModeKubernetesKurtosisBackend(kubernetesManager, engineNodeName, engineReplicas, objAttrsProvider), nil
 	}
 
 	wrappedBackend, err := getWrappedKubernetesKurtosisBackend(
@@ -31,6 +57,9 @@ func GetCLIBackend(ctx context.Context, storageClass string, engineNodeName stri
 		kubernetesConfig,
 		backendSupplier,
 		storageClass,
+		clientConfig,
+		podSecurityStandard,
+		airGapConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred wrapping the CLI Kubernetes backend")
@@ -40,7 +69,7 @@ func GetCLIBackend(ctx context.Context, storageClass string, engineNodeName stri
 }
 
 func GetEngineServerBackend(
-	ctx context.Context, storageClass string,
+	ctx context.Context, storageClass string, clientConfig kubernetes_manager.ClientConfig, podSecurityStandard kubernetes_manager.PodSecurityStandard, airGapConfig air_gap.AirGapConfig, objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) (backend_interface.KurtosisBackend, error) {
 	kubernetesConfig, err := rest.InClusterConfig()
 	if err != nil {
@@ -50,6 +79,7 @@ func GetEngineServerBackend(
 	backendSupplier := func(_ context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*KubernetesKurtosisBackend, error) {
 		return NewEngineServerKubernetesKurtosisBackend(
 			kubernetesManager,
+			objAttrsProvider,
 		), nil
 	}
 
@@ -58,6 +88,9 @@ func GetEngineServerBackend(
 		kubernetesConfig,
 		backendSupplier,
 		storageClass,
+		clientConfig,
+		podSecurityStandard,
+		airGapConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred wrapping the Kurtosis Engine Kubernetes backend")
@@ -66,10 +99,71 @@ func GetEngineServerBackend(
 	return wrappedBackend, nil
 }
 
+// GetEngineServerBackendForKubeconfig is GetEngineServerBackend for a cluster other than the one the engine runs in,
+// reached with the given kubeconfig
+func GetEngineServerBackendForKubeconfig(
+	ctx context.Context, kubeconfig string, storageClass string, clientConfig kubernetes_manager.ClientConfig, podSecurityStandard kubernetes_manager.PodSecurityStandard, airGapConfig air_gap.AirGapConfig, objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+) (backend_interface.KurtosisBackend, error) {
+	kubernetesConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Kubernetes config from the kubeconfig")
+	}
+
+	backendSupplier := func(_ context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*KubernetesKurtosisBackend, error) {
+		return NewEngineServerKubernetesKurtosisBackend(
+			kubernetesManager,
+			objAttrsProvider,
+		), nil
+	}
+
+	wrappedBackend, err := getWrappedKubernetesKurtosisBackend(
+		ctx,
+		kubernetesConfig,
+		backendSupplier,
+		storageClass,
+		clientConfig,
+		podSecurityStandard,
+		airGapConfig,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred wrapping the Kurtosis Engine Kubernetes backend")
+	}
+
+	return wrappedBackend, nil
+}
+
+// GetFlattenedKubeconfigForContext returns a kubeconfig holding only the given context of the local kubeconfig, with
+// the credentials embedded, so that the engine can reach the cluster of the context from inside its own cluster
+func GetFlattenedKubeconfigForContext(kubernetesContext string) (string, error) {
+	rawConfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred loading the kubeconfig")
+	}
+	if _, found := rawConfig.Contexts[kubernetesContext]; !found {
+		return "", stacktrace.NewError("Context '%v' doesn't exist in the kubeconfig", kubernetesContext)
+	}
+	rawConfig.CurrentContext = kubernetesContext
+	if err = clientcmdapi.MinifyConfig(rawConfig); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred keeping only context '%v' in the kubeconfig", kubernetesContext)
+	}
+	if err = clientcmdapi.FlattenConfig(rawConfig); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred embedding the credentials of context '%v' in the kubeconfig", kubernetesContext)
+	}
+	kubeconfig, err := clientcmd.Write(*rawConfig)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the kubeconfig of context '%v'", kubernetesContext)
+	}
+	return string(kubeconfig), nil
+}
+
 func GetApiContainerBackend(
 	ctx context.Context,
 	storageClass string,
 	productionMode bool,
+	clientConfig kubernetes_manager.ClientConfig,
+	podSecurityStandard kubernetes_manager.PodSecurityStandard,
+	airGapConfig air_gap.AirGapConfig,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) (backend_interface.KurtosisBackend, error) {
 	kubernetesConfig, err := rest.InClusterConfig()
 	if err != nil {
@@ -104,6 +198,7 @@ func GetApiContainerBackend(
 			namespaceName,
 			storageClass,
 			productionMode,
+			objAttrsProvider,
 		), nil
 	}
 
@@ -112,6 +207,9 @@ func GetApiContainerBackend(
 		kubernetesConfig,
 		backendSupplier,
 		storageClass,
+		clientConfig,
+		podSecurityStandard,
+		airGapConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred wrapping the APIC Kubernetes backend")
@@ -130,13 +228,28 @@ func getWrappedKubernetesKurtosisBackend(
 	kubernetesConfig *rest.Config,
 	kurtosisBackendSupplier func(context.Context, *kubernetes_manager.KubernetesManager) (*KubernetesKurtosisBackend, error),
 	storageClass string,
+	clientConfig kubernetes_manager.ClientConfig,
+	podSecurityStandard kubernetes_manager.PodSecurityStandard,
+	airGapConfig air_gap.AirGapConfig,
 ) (*metrics_reporting.MetricsReportingKurtosisBackend, error) {
+	if err := clientConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The Kubernetes client config is invalid")
+	}
+	if err := podSecurityStandard.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The Pod Security Standard is invalid")
+	}
+	if err := airGapConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The air gap config is invalid")
+	}
+	clientConfig.ApplyToRestConfig(kubernetesConfig)
+
 	clientSet, err := kubernetes.NewForConfig(kubernetesConfig)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create kubernetes client set using Kubernetes config '%+v', instead a non nil error was returned", kubernetesConfig)
 	}
 
-	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, kubernetesConfig, storageClass)
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, kubernetesConfig, storageClass, podSecurityStandard)
+	kubernetesManager.SetAirGapConfig(airGapConfig)
 
 	kubernetesBackend, err := kurtosisBackendSupplier(ctx, kubernetesManager)
 	if err != nil {
@@ -2,4 +2,7 @@ package logs_aggregator_functions
 
 const (
 	defaultLogsListeningPortNum = uint16(9714)
+
+	// Same as the HTTP port of the logs aggregator created along with the engine
+	defaultLogsAggregatorHttpPortNum = uint16(8686)
 )
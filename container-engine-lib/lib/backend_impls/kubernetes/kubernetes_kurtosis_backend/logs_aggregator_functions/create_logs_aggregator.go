@@ -17,6 +17,7 @@ func CreateLogsAggregator(
 	logsAggregatorHttpPortNumber uint16,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	objAttrProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 ) (*logs_aggregator.LogsAggregator, func(), error) {
@@ -41,6 +42,7 @@ func CreateLogsAggregator(
 			sinks,
 			logsAggregatorHttpPortNumber,
 			shouldEnablePersistentVolumeLogsCollection,
+			logsEncryptionConfig,
 			engineNamespace,
 			objAttrProvider,
 			kubernetesManager)
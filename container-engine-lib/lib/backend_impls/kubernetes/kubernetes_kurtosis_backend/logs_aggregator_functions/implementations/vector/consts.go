@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 )
 
 const (
@@ -30,8 +32,30 @@ const (
 	fluentBitSourceType      = "fluent"
 	fluentBitSourceIpAddress = "0.0.0.0"
 	fileSinkType             = "file"
+	remapTransformType       = "remap"
+	routeTransformType       = "route"
+	dedupeTransformType      = "dedupe"
+
+	// Every logs collector pod collects all the kubernetes events, so they're routed apart from the logs of the
+	// containers to drop their copies; the logs of the containers have no event id and would all be seen as copies
+	logSourceRouteTransformId         = "kurtosis_log_source_route"
+	kubernetesEventsRouteId           = "kubernetes_events"
+	unmatchedRouteId                  = "_unmatched"
+	kubernetesEventsDedupeTransformId = "kurtosis_kubernetes_events_dedupe"
+	// how many event ids are remembered, way more than the events a cluster reports while a copy is in flight
+	kubernetesEventsDedupeCacheNumEvents = 5000
+
+	// Encrypts the 'log' field of each event under a new nonce of logs_aggregator.LogsEncryptionNonceNumBytes bytes,
+	// stored next to it. The key is read from the environment so that it's never written in the config.
+	logsEncryptionTransformId = "kurtosis_logs_encryption"
+	logsEncryptionVrlSource   = `key = decode_base64!(get_env_var!("` + logs_aggregator.LogsEncryptionKeyEnvVar + `"))
+nonce = random_bytes(12)
+.log = encode_base64(encrypt!(to_string(.log) ?? "", "CHACHA20-POLY1305", key, iv: nonce))
+.` + logs_aggregator.EncryptedLogNonceLabel + ` = encode_base64(nonce)`
 )
 
 var (
+	kubernetesEventsRouteCondition = fmt.Sprintf(`.%v == "%v"`, logs_collector.LogSourceLabel, logs_collector.KubernetesEventLogSource)
+
 	uuidLogsFilepath = fmt.Sprintf("%s/%%G/%%V/{{ %v }}/{{ %v }}.json", kurtosisLogsMountPath, kubernetes_label_key.LogsEnclaveUUIDKubernetesLabelKey.GetString(), kubernetes_label_key.LogsServiceUUIDKubernetesLabelKey.GetString())
 )
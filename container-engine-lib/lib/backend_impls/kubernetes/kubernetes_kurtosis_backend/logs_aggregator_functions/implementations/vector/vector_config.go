@@ -5,13 +5,15 @@ import (
 	"strconv"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 )
 
 type VectorConfig struct {
-	DataDir string                            `yaml:"data_dir"`
-	Api     *VectorApiConfig                  `yaml:"api"`
-	Sources map[string]map[string]interface{} `yaml:"sources,omitempty"`
-	Sinks   map[string]map[string]interface{} `yaml:"sinks,omitempty"`
+	DataDir    string                            `yaml:"data_dir"`
+	Api        *VectorApiConfig                  `yaml:"api"`
+	Sources    map[string]map[string]interface{} `yaml:"sources,omitempty"`
+	Transforms map[string]map[string]interface{} `yaml:"transforms,omitempty"`
+	Sinks      map[string]map[string]interface{} `yaml:"sinks,omitempty"`
 }
 
 type VectorApiConfig struct {
@@ -24,13 +26,46 @@ func newVectorConfig(
 	httpPortNumber uint16,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 ) *VectorConfig {
 	reconciledSinks := map[string]map[string]interface{}{}
+	transforms := map[string]map[string]interface{}{
+		logSourceRouteTransformId: {
+			"type":   routeTransformType,
+			"inputs": []string{defaultSourceId},
+			"route": map[string]interface{}{
+				kubernetesEventsRouteId: kubernetesEventsRouteCondition,
+			},
+		},
+		kubernetesEventsDedupeTransformId: {
+			"type":   dedupeTransformType,
+			"inputs": []string{logSourceRouteTransformId + "." + kubernetesEventsRouteId},
+			"fields": map[string]interface{}{
+				"match": []string{logs_collector.KubernetesEventIdLabel},
+			},
+			"cache": map[string]interface{}{
+				"num_events": kubernetesEventsDedupeCacheNumEvents,
+			},
+		},
+	}
+	collectedLogsInputs := []string{logSourceRouteTransformId + "." + unmatchedRouteId, kubernetesEventsDedupeTransformId}
 
 	if shouldEnablePersistentVolumeLogsCollection {
+		// Only the logs stored on the logs volume are encrypted; the other sinks get them as they are collected
+		persistentVolumeSinkInputs := collectedLogsInputs
+		if logsEncryptionConfig.IsEnabled() {
+			transforms[logsEncryptionTransformId] = map[string]interface{}{
+				"type":   remapTransformType,
+				"inputs": collectedLogsInputs,
+				"source": logsEncryptionVrlSource,
+				// A log line that couldn't be encrypted is dropped rather than stored in plaintext
+				"drop_on_error": true,
+			}
+			persistentVolumeSinkInputs = []string{logsEncryptionTransformId}
+		}
 		reconciledSinks[logs_aggregator.DefaultSinkId] = map[string]interface{}{
 			"type":   fileSinkType,
-			"inputs": []string{defaultSourceId},
+			"inputs": persistentVolumeSinkInputs,
 			"path":   uuidLogsFilepath,
 			"encoding": map[string]interface{}{
 				"codec": "json",
@@ -52,7 +87,7 @@ func newVectorConfig(
 		}
 
 		// Add inputs field to sink configuration
-		reconciledSinks[sinkId]["inputs"] = []string{defaultSourceId}
+		reconciledSinks[sinkId]["inputs"] = collectedLogsInputs
 	}
 
 	return &VectorConfig{
@@ -67,6 +102,7 @@ func newVectorConfig(
 				"address": fmt.Sprintf("%s:%s", fluentBitSourceIpAddress, strconv.Itoa(int(listeningPortNumber))),
 			},
 		},
-		Sinks: reconciledSinks,
+		Transforms: transforms,
+		Sinks:      reconciledSinks,
 	}
 }
@@ -20,8 +20,7 @@ const (
 	validatorContainerName    = "logs-aggregator-validator"
 	validationCmdRetries      = 0
 	validatorJobTTLSeconds    = 5
-	validatorJobPollInterval  = 600 * time.Millisecond
-	validatorJobPollTimeout   = 30 * time.Second
+	validatorJobTimeout       = 30 * time.Second
 	validationSuccessExitCode = 0
 	validationFailedExitCode  = 78
 )
@@ -136,9 +135,9 @@ func (vector *vectorConfigurationCreator) CreateConfiguration(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating a job to validate logs aggregator configuration")
 	}
 
-	err = kubernetesManager.WaitForJobCompletion(ctx, job, validatorJobPollInterval, validatorJobPollTimeout)
+	err = kubernetesManager.WaitForJobCompletion(ctx, job, validatorJobTimeout)
 	if err != nil {
-		return nil, nil, stacktrace.Propagate(err, "An error occurred waiting for the logs aggregator validation job to finish, even after waiting %v", validatorJobPollTimeout)
+		return nil, nil, stacktrace.Propagate(err, "An error occurred waiting for the logs aggregator validation job to finish, even after waiting %v", validatorJobTimeout)
 	}
 
 	pods, err := kubernetesManager.GetPodsManagedByJob(ctx, job)
@@ -9,7 +9,8 @@ func createVectorConfigurationCreatorForKurtosis(
 	httpPortNumber uint16,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 ) *vectorConfigurationCreator {
-	config := newVectorConfig(listeningPortNumber, httpPortNumber, sinks, shouldEnablePersistentVolumeLogsCollection)
+	config := newVectorConfig(listeningPortNumber, httpPortNumber, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig)
 	return newVectorConfigurationCreator(config)
 }
@@ -21,10 +21,16 @@ import (
 )
 
 const (
-	retryInterval        = 1 * time.Second
-	maxRetries           = 30
-	preCleanNumReplicas  = 0
-	postCleanNumReplicas = 1
+	podAvailabilityTimeout = 30 * time.Second
+	preCleanNumReplicas    = 0
+	postCleanNumReplicas   = 1
+
+	logsAggregatorNumReplicas = 1
+	defaultServiceAccountName = ""
+)
+
+var (
+	noNodeSelectors map[string]string = nil
 )
 
 type vectorLogsAggregatorResourcesManager struct{}
@@ -39,6 +45,7 @@ func (logsAggregator *vectorLogsAggregatorResourcesManager) CreateAndStart(
 	sinks logs_aggregator.Sinks,
 	httpPortNumber uint16,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	engineNamespace string,
 	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
@@ -79,7 +86,7 @@ func (logsAggregator *vectorLogsAggregatorResourcesManager) CreateAndStart(
 		}
 	}()
 
-	vectorConfigurationCreatorObj := createVectorConfigurationCreatorForKurtosis(logsListeningPortNum, httpPortNumber, sinks, shouldEnablePersistentVolumeLogsCollection)
+	vectorConfigurationCreatorObj := createVectorConfigurationCreatorForKurtosis(logsListeningPortNum, httpPortNumber, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig)
 
 	configMap, removeConfigMapFunc, err := vectorConfigurationCreatorObj.CreateConfiguration(ctx, namespace.Name, logsAggregatorAttrProvider, kubernetesManager)
 	if err != nil {
@@ -92,7 +99,13 @@ func (logsAggregator *vectorLogsAggregatorResourcesManager) CreateAndStart(
 		}
 	}()
 
-	deployment, deploymentLabels, err := createLogsAggregatorDeployment(ctx, engineNamespace, namespace.Name, logsListeningPortNum, configMap.Name, logsAggregatorAttrProvider, kubernetesManager)
+	// The encryption transform reads the key from the environment, so that it isn't written to the config map
+	logsEncryptionKeyEnvVars, err := shared_helpers.GetLogsEncryptionKeyEnvVars(ctx, logsEncryptionConfig, namespace.Name, kubernetesManager)
+	if err != nil {
+		return nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred giving the logs encryption key to the logs aggregator")
+	}
+
+	deployment, deploymentLabels, err := createLogsAggregatorDeployment(ctx, engineNamespace, namespace.Name, logsListeningPortNum, configMap.Name, logsEncryptionKeyEnvVars, logsAggregatorAttrProvider, kubernetesManager)
 	if err != nil {
 		return nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred while trying to create daemon set for fluent bit logs collector.")
 	}
@@ -136,7 +149,7 @@ func (logsAggregator *vectorLogsAggregatorResourcesManager) CreateAndStart(
 		}
 	}()
 
-	if err = kubernetesManager.WaitForPodManagedByDeployment(ctx, deployment, maxRetries, retryInterval); err != nil {
+	if err = kubernetesManager.WaitForPodManagedByDeployment(ctx, deployment, podAvailabilityTimeout); err != nil {
 		return nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred waiting for active pod managed by logs aggregator deployment '%v'", deployment.Name)
 	}
 
@@ -160,6 +173,7 @@ func createLogsAggregatorDeployment(
 	namespace string,
 	logsListeningPort uint16,
 	configMapName string,
+	logsEncryptionKeyEnvVars []apiv1.EnvVar,
 	objAttrProvider object_attributes_provider.KubernetesLogsAggregatorObjectAttributesProvider,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 ) (
@@ -193,7 +207,7 @@ func createLogsAggregatorDeployment(
 				},
 			},
 			EnvFrom: nil,
-			Env:     nil,
+			Env:     logsEncryptionKeyEnvVars,
 			Resources: apiv1.ResourceRequirements{
 				Limits:   nil,
 				Requests: nil,
@@ -265,7 +279,7 @@ func createLogsAggregatorDeployment(
 						// Always schedule the logs aggregator pods to run on the same node as the engine pods
 						// they need to share a node's filesystem because aggregator writes to log files that engine reads from
 						MatchLabels: map[string]string{
-							// use resource label to match engine pods (which should only be one at any time)
+							// use resource label to match engine pods (the engine replicas all run on the same node)
 							kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.EngineKurtosisResourceTypeKubernetesLabelValue.GetString(),
 						},
 						MatchExpressions: nil,
@@ -290,6 +304,9 @@ func createLogsAggregatorDeployment(
 		containers,
 		volumes,
 		affinity,
+		logsAggregatorNumReplicas,
+		defaultServiceAccountName,
+		noNodeSelectors,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating deployment for vector logs aggregator.")
@@ -423,7 +440,7 @@ func (vector *vectorLogsAggregatorResourcesManager) Clean(ctx context.Context, l
 	}
 
 	// before continuing, ensure logs aggregator is up again
-	if err := kubernetesManager.WaitForPodManagedByDeployment(ctx, logsAggregatorDeployment, maxRetries, retryInterval); err != nil {
+	if err := kubernetesManager.WaitForPodManagedByDeployment(ctx, logsAggregatorDeployment, podAvailabilityTimeout); err != nil {
 		return stacktrace.Propagate(err, "An error occurred waiting for a pod managed by deployment '%v' to become available.", logsAggregatorDeployment.Name)
 	}
 
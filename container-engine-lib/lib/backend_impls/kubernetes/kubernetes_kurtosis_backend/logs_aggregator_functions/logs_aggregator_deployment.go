@@ -19,6 +19,7 @@ type LogsAggregatorResourcesManager interface {
 		sinks logs_aggregator.Sinks,
 		httpPortNumber uint16,
 		shouldEnablePersistentVolumeLogsCollection bool,
+		logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 		// Provided so deployment can be scheduled on same node as engine
 		engineNamespace string,
 		objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
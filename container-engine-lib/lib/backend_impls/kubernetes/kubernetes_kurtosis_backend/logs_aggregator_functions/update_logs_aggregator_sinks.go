@@ -0,0 +1,72 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// UpdateLogsAggregatorSinks recreates the logs aggregator resources with the new sinks, scheduling the new deployment
+// on the node of the engine like the previous one
+// The logs aggregator keeps its disk buffers on the node and the logs collectors retry sending the logs they collect
+// while the logs aggregator is down, so no logs get lost
+func UpdateLogsAggregatorSinks(
+	ctx context.Context,
+	logsAggregatorResourcesManager LogsAggregatorResourcesManager,
+	sinks logs_aggregator.Sinks,
+	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
+	objAttrProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	logsAggregatorObj, kubernetesResources, err := getLogsAggregatorObjAndResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs aggregator")
+	}
+	if logsAggregatorObj == nil {
+		return stacktrace.NewError("Couldn't update the sinks of the logs aggregator because no logs aggregator is running; start the engine first")
+	}
+	engineNamespace, err := getEngineNamespaceFromLogsAggregatorDeployment(kubernetesResources.deployment)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the engine namespace the logs aggregator is scheduled with")
+	}
+
+	if err := DestroyLogsAggregator(ctx, kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the logs aggregator before recreating it with the new sinks")
+	}
+
+	if _, _, err := CreateLogsAggregator(
+		ctx,
+		engineNamespace,
+		logsAggregatorResourcesManager,
+		defaultLogsAggregatorHttpPortNum,
+		sinks,
+		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
+		objAttrProvider,
+		kubernetesManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recreating the logs aggregator with the new sinks; restart the engine to recreate it")
+	}
+	logrus.Debugf("Recreated the logs aggregator with sinks '%+v'", sinks)
+	return nil
+}
+
+// The logs aggregator deployment has a pod affinity to the engine pods, in the engine namespace
+func getEngineNamespaceFromLogsAggregatorDeployment(deployment *appsv1.Deployment) (string, error) {
+	affinity := deployment.Spec.Template.Spec.Affinity
+	if affinity == nil || affinity.PodAffinity == nil {
+		return "", stacktrace.NewError("Logs aggregator deployment '%s' has no pod affinity to the engine pods", deployment.Name)
+	}
+	for _, term := range affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		if len(term.Namespaces) > 0 {
+			return term.Namespaces[0], nil
+		}
+	}
+	return "", stacktrace.NewError("Logs aggregator deployment '%s' has no pod affinity to the engine pods", deployment.Name)
+}
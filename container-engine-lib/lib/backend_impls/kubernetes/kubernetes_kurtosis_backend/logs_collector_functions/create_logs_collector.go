@@ -29,6 +29,9 @@ func CreateLogsCollector(
 	logsAggregator *logs_aggregator.LogsAggregator,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) (
@@ -61,6 +64,9 @@ func CreateLogsCollector(
 			logsCollectorHttpPortId,
 			logsCollectorFilters,
 			logsCollectorParsers,
+			logsCollectorSystemLogsConfig,
+			logsCollectorKubernetesMetadataConfig,
+			logsCollectorBufferConfig,
 			objAttrsProvider,
 			kubernetesManager,
 		)
@@ -0,0 +1,40 @@
+package logs_collector_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// GetLogsCollectorStatuses returns the status of the logs collector pod running on every node, keyed by node name.
+// Unlike the status of the logs collector, which is stopped as soon as one of its pods is, this tells which nodes
+// have their logs missing
+func GetLogsCollectorStatuses(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[string]container.ContainerStatus, error) {
+	kubernetesResources, err := getLogsCollectorKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes resources for logs collectors.")
+	}
+	logsCollectorStatuses := map[string]container.ContainerStatus{}
+	if kubernetesResources.daemonSet == nil {
+		return logsCollectorStatuses, nil
+	}
+
+	logsCollectorPods, err := kubernetesManager.GetPodsManagedByDaemonSet(ctx, kubernetesResources.daemonSet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting pods managed by logs collector daemon set '%v'.", kubernetesResources.daemonSet.Name)
+	}
+	for _, pod := range logsCollectorPods {
+		podStatus, err := shared_helpers.GetContainerStatusFromPod(pod)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred retrieving container status for logs collector pod '%v'", pod.Name)
+		}
+		logsCollectorStatuses[pod.Spec.NodeName] = podStatus
+	}
+	return logsCollectorStatuses, nil
+}
@@ -29,11 +29,32 @@ const (
 	fluentBitCheckpointDbVolumeName = "fluent-bit-db"
 	fluentBitCheckpointDbMountPath  = "/var/log/fluent-bit/db"
 
+	// the records buffered on the filesystem are kept next to the offsets of the log files, and removed along with them
+	// https://docs.fluentbit.io/manual/administration/buffering-and-storage
+	fluentBitStoragePath = fluentBitCheckpointDbMountPath + "/storage"
+
 	// assuming this as default k8s api server url - this might not be the case for very custom k8s environments so making this a variable
 	// in case it needs to be configured by the user down the line
 	k8sApiServerUrl = "https://kubernetes.default.svc:443"
 
+	// the logs of all the containers of the pods of the enclaves are collected, so that the logs of the init containers
+	// and of the sidecars of the services show up alongside the logs of their main container, which are the only ones
+	// not named in their records; the logs of the pods that aren't services, e.g. the API container, are dropped
 	fluentBitConfigFileName = "fluent-bit.conf"
+
+	// the logs are read from the files the kubelet writes for each run of each container, e.g.
+	// /var/log/pods/<namespace>_<pod>_<pod uid>/<container>/<restart count>.log, rather than from their links in
+	// /var/log/containers, so that the restart count of the container is known; the kubelet keeps the file of the
+	// previous run of a container that restarted, so the logs of a crashed container get collected
+	podLogsPath                 = varLogMountPath + "/pods/kt-*_*_*/*/*.log"
+	podLogsTagPrefix            = "kurtosis.var.log.pods."
+	podLogsTagParserName        = "kurtosis_pod_logs_tag"
+	podLogsParsersFileName      = "kurtosis-pod-logs-parsers.conf"
+	podLogsParsersConfigFileStr = `[PARSER]
+    Name   ` + podLogsTagParserName + `
+    Format regex
+    Regex  ^(?<namespace_name>[^_]+)_(?<pod_name>[^_]+)_(?<pod_id>[^.]+)\.(?<container_name>[^.]+)\.(?<restart_count>[0-9]+)\.log$
+`
 	fluentBitConfigTemplate = `
 [SERVICE]
     HTTP_Server       On
@@ -41,43 +62,86 @@ const (
     HTTP_PORT         {{ .HTTPPort }}
     Parsers_File      /fluent-bit/etc/parsers.conf
     Parsers_File      {{ .KurtosisParsersConfigFilepath }}
+    Parsers_File      {{ .KubernetesEventsParsersConfigFilepath }}
+    Parsers_File      {{ .PodLogsParsersConfigFilepath }}
+    Hot_Reload        On
+{{- if .StorageType }}
+    storage.path      {{ .StoragePath }}
+{{- end }}
+{{- if .StorageMaxChunksUp }}
+    storage.max_chunks_up {{ .StorageMaxChunksUp }}
+{{- end }}
 
 [INPUT]
     Name              tail
     Tag               kurtosis.*
-    Path              /var/log/containers/*_kt-*_{{ .UserServiceResourceStr }}-container-*.log
+    Path              {{ .PodLogsPath }}
     Parser            docker
     DB                {{ .CheckpointDbMountPath }}/fluent-bit.db
     DB.sync           normal
     Read_from_Head    true
     Refresh_Interval  10
+{{- if .MemBufLimit }}
+    Mem_Buf_Limit     {{ .MemBufLimit }}
+{{- end }}
+{{- if .StorageType }}
+    storage.type      {{ .StorageType }}
+{{- end }}
+
+{{- if .SystemdUnits }}
+
+[INPUT]
+    Name              systemd
+    Tag               {{ .SystemLogsTag }}
+    Path              {{ .JournalPath }}
+    {{- range .SystemdUnits }}
+    Systemd_Filter    _SYSTEMD_UNIT={{ . }}
+    {{- end }}
+    DB                {{ .CheckpointDbMountPath }}/systemd.db
+    DB.sync           normal
+    Read_From_Tail    On
+{{- end }}
+
+[INPUT]
+    Name              kubernetes_events
+    Tag               {{ .KubernetesEventsTag }}
+    Kube_URL          {{ .K8sApiServerURL }}
+    DB                {{ .CheckpointDbMountPath }}/kubernetes-events.db
+    DB.sync           normal
     
 [FILTER]
     Name              kubernetes
-    Match             *
+    Match             kurtosis.*
     Labels            On
     Annotations       Off
-    Kube_Tag_Prefix   kurtosis.var.log.containers.
+    Kube_Tag_Prefix   {{ .PodLogsTagPrefix }}
+    Regex_Parser      {{ .PodLogsTagParserName }}
     
 [FILTER]
     Name lua
-    Match *
+    Match kurtosis.*
     call flatten_kubernetes_labels
-    code function flatten_kubernetes_labels(tag, timestamp, record) record["{{ .LogsEnclaveUUIDLabel }}"] = record["kubernetes"]["labels"]["{{ .LogsEnclaveUUIDLabel }}"] record["{{ .LogsServiceUUIDLabel }}"] = record["kubernetes"]["labels"]["{{ .LogsServiceUUIDLabel }}"] return 1, timestamp, record end
+    code function flatten_kubernetes_labels(tag, timestamp, record) if record["kubernetes"] == nil or record["kubernetes"]["labels"] == nil or record["kubernetes"]["labels"]["{{ .LogsServiceUUIDLabel }}"] == nil then return -1, timestamp, record end if record["kubernetes"]["container_name"] ~= "{{ .UserServiceResourceStr }}-container" then record["{{ .ContainerNameLabel }}"] = record["kubernetes"]["container_name"] end record["{{ .LogsEnclaveUUIDLabel }}"] = record["kubernetes"]["labels"]["{{ .LogsEnclaveUUIDLabel }}"] record["{{ .LogsServiceUUIDLabel }}"] = record["kubernetes"]["labels"]["{{ .LogsServiceUUIDLabel }}"]{{ range .KubernetesMetadataFields }} record["{{ .RecordKey }}"] = record["kubernetes"]["{{ .MetadataKey }}"]{{ end }}{{ range .KubernetesMetadataLabels }} record["{{ .RecordKey }}"] = record["kubernetes"]["labels"]["{{ .MetadataKey }}"]{{ end }} return 1, timestamp, record end
     
 [FILTER]
     Name record_modifier
-    Match *
+    Match kurtosis.*
     Remove_key kubernetes
     
+[FILTER]
+    Name              lua
+    Match             kurtosis.*
+    Script            {{ .ContainerRestartsScriptFilepath }}
+    Call              mark_container_restarts
+    
 [FILTER]
     Name modify
-    Match *
+    Match kurtosis.*
     Rename time timestamp
     
 [FILTER]
     Name              kubernetes
-    Match             *
+    Match             kurtosis.*
     Kube_URL          {{ .K8sApiServerURL }}
     Merge_log         On
     Keep_Log          On
@@ -91,6 +155,42 @@ const (
     {{- range .Params}}
     {{.Key}} {{.Value}}
     {{- end}}{{end}}
+
+@INCLUDE {{ .EnclaveFiltersConfigFilepath }}
+{{- if .SystemdUnits }}
+
+[FILTER]
+    Name              lua
+    Match             {{ .SystemLogsTag }}
+    Script            {{ .SystemLogsScriptFilepath }}
+    Call              system_log_to_log_line
+{{- end }}
+
+[FILTER]
+    Name              grep
+    Match             {{ .KubernetesEventsTag }}
+    Regex             $involvedObject['kind'] ^Pod$
+    Regex             $involvedObject['namespace'] ^kt-
+
+[FILTER]
+    Name              rewrite_tag
+    Match             {{ .KubernetesEventsTag }}
+    Rule              $involvedObject['name'] ^.+$ {{ .KubernetesEventsTag }}.$involvedObject['namespace'].$involvedObject['name'] false
+
+[FILTER]
+    Name              kubernetes
+    Match             {{ .KubernetesEventsTag }}.*
+    Kube_URL          {{ .K8sApiServerURL }}
+    Kube_Tag_Prefix   {{ .KubernetesEventsTag }}.
+    Regex_Parser      {{ .KubernetesEventsTagParserName }}
+    Labels            On
+    Annotations       Off
+
+[FILTER]
+    Name              lua
+    Match             {{ .KubernetesEventsTag }}.*
+    Script            {{ .KubernetesEventsScriptFilepath }}
+    Call              kubernetes_event_to_log_line
     
 [OUTPUT]
     Name              stdout
@@ -104,6 +204,108 @@ const (
     Port              {{ .LogsAggregatorPortNum }}
     `
 
+	// the filters registered by each enclave live in their own config file, all of them being included through this one;
+	// they are scoped to the services of the enclave by matching the tag of their logs, which has their namespace
+	enclaveFiltersConfigFileName             = "enclave-filters.conf"
+	enclaveFiltersConfigFileNamePrefix       = "enclave-filters-"
+	enclaveFiltersConfigFileNameSuffix       = ".conf"
+	enclaveFiltersMatchTemplate              = podLogsTagPrefix + "%v_*"
+	enclaveFiltersIncludesConfigFileTemplate = `{{- range .}}@INCLUDE {{.}}
+{{ end }}`
+	enclaveFiltersConfigFileTemplate = `{{- range .Filters}}
+[FILTER]
+    Name              {{.Name}}
+    Match             {{$.Match}}
+    {{- range .Params}}
+    {{.Key}} {{.Value}}
+    {{- end}}
+{{ end }}`
+
+	// the events Kubernetes reports about the pods of the services are collected by every logs collector pod; they're
+	// tagged with the namespace and the name of their pod so that the kubernetes filter can look up the labels of the
+	// pod, which hold the UUIDs of the enclave and of the service the events get stored with
+	kubernetesEventsTag                  = "kurtosis_k8s_events"
+	kubernetesEventsTagParserName        = "kurtosis_k8s_events_tag"
+	kubernetesEventsParsersFileName      = "kurtosis-kubernetes-events-parsers.conf"
+	kubernetesEventsParsersConfigFileStr = `[PARSER]
+    Name   ` + kubernetesEventsTagParserName + `
+    Format regex
+    Regex  ^(?<namespace_name>[^.]+)\.(?<pod_name>[^.]+)$
+`
+	kubernetesEventsScriptFileName     = "kurtosis-kubernetes-events.lua"
+	kubernetesEventsScriptFileTemplate = `function kubernetes_event_to_log_line(tag, timestamp, record)
+    local kubernetes = record["kubernetes"]
+    if kubernetes == nil or kubernetes["labels"] == nil then
+        return -1, timestamp, record
+    end
+    local labels = kubernetes["labels"]
+    local service_uuid = labels["{{ .LogsServiceUUIDLabel }}"]
+    if service_uuid == nil then
+        return -1, timestamp, record
+    end
+    local metadata = record["metadata"] or {}
+    local log_line = {}
+    log_line["{{ .LogsEnclaveUUIDLabel }}"] = labels["{{ .LogsEnclaveUUIDLabel }}"]
+    log_line["{{ .LogsServiceUUIDLabel }}"] = service_uuid
+    log_line["log"] = (record["type"] or "") .. " " .. (record["reason"] or "") .. ": " .. (record["message"] or "")
+    log_line["timestamp"] = record["lastTimestamp"] or record["eventTime"] or metadata["creationTimestamp"]
+    log_line["{{ .LogSourceLabel }}"] = "{{ .KubernetesEventLogSource }}"
+    log_line["{{ .KubernetesEventIdLabel }}"] = (metadata["uid"] or "") .. "/" .. (metadata["resourceVersion"] or "")
+    return 2, timestamp, log_line
+end
+`
+
+	// the logs the host services of the node write to its journal are stored in the system logs stream, under the name
+	// of the node, which the logs collector pod gets through the downward API
+	systemLogsTag                = "kurtosis_system"
+	journalPath                  = varLogMountPath + "/journal"
+	nodeNameEnvVar               = "NODE_NAME"
+	systemLogsScriptFileName     = "kurtosis-system-logs.lua"
+	systemLogsScriptFileTemplate = `function system_log_to_log_line(tag, timestamp, record)
+    local log_line = {}
+    log_line["{{ .LogsEnclaveUUIDLabel }}"] = "{{ .SystemLogsStreamId }}"
+    log_line["{{ .LogsServiceUUIDLabel }}"] = os.getenv("{{ .NodeNameEnvVar }}")
+    log_line["log"] = (record["_SYSTEMD_UNIT"] or "") .. ": " .. (record["MESSAGE"] or "")
+    log_line["timestamp"] = os.date("!%Y-%m-%dT%H:%M:%S", math.floor(timestamp)) .. string.format(".%06dZ", math.floor((timestamp % 1) * 1000000))
+    log_line["{{ .LogSourceLabel }}"] = "{{ .SystemLogSource }}"
+    return 2, timestamp, log_line
+end
+`
+
+	// the restart count of the container is added to each of its log lines, and the first line collected from a run of
+	// a container after a restart is preceded by a line marking the restart, so that the logs of the crashed run stand
+	// apart from the logs of the fresh one; the highest restart count is kept per container, which a logs collector pod
+	// that restarts forgets, so it only marks the restarts it sees happen
+	containerRestartsScriptFileName     = "kurtosis-container-restarts.lua"
+	containerRestartsScriptFileTemplate = `local restart_counts = {}
+
+function mark_container_restarts(tag, timestamp, record)
+    local container, restart_count_str = string.match(tag, "^(.+)%.(%d+)%.log$")
+    if container == nil then
+        return 0, timestamp, record
+    end
+    local restart_count = tonumber(restart_count_str)
+    record["{{ .ContainerRestartCountLabel }}"] = restart_count_str
+    local previous_restart_count = restart_counts[container]
+    if previous_restart_count ~= nil and restart_count <= previous_restart_count then
+        return 1, timestamp, record
+    end
+    restart_counts[container] = restart_count
+    if previous_restart_count == nil then
+        return 1, timestamp, record
+    end
+    local restart_line = {}
+    restart_line["{{ .LogsEnclaveUUIDLabel }}"] = record["{{ .LogsEnclaveUUIDLabel }}"]
+    restart_line["{{ .LogsServiceUUIDLabel }}"] = record["{{ .LogsServiceUUIDLabel }}"]
+    restart_line["{{ .ContainerNameLabel }}"] = record["{{ .ContainerNameLabel }}"]
+    restart_line["{{ .ContainerRestartCountLabel }}"] = restart_count_str
+    restart_line["log"] = "Container restarted (restart " .. restart_count_str .. "); the lines before are the logs of its previous run"
+    restart_line["time"] = record["time"]
+    restart_line["{{ .LogSourceLabel }}"] = "{{ .ContainerRestartLogSource }}"
+    return 1, timestamp, {restart_line, record}
+end
+`
+
 	parsersFileName          = "kurtosis-parsers.conf"
 	parserConfigFileTemplate = `{{- range .Parsers}}[PARSER]
 {{- range $key, $value := . }}
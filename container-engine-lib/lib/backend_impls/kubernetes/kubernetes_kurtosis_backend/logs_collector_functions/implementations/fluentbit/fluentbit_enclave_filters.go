@@ -0,0 +1,234 @@
+package fluentbit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	// the kubelet only refreshes the config map volumes periodically (every minute by default) so the new config
+	// files can take a while to show up in the logs collector pods
+	configFilesPropagationTimeout           = 3 * time.Minute
+	timeBetweenConfigFilesPropagationChecks = 2 * time.Second
+
+	// fluent bit is the main process of the logs collector container, and reloads its config on SIGHUP when hot
+	// reload is enabled
+	hotReloadCmd             = "kill -HUP 1"
+	hotReloadSuccessExitCode = 0
+)
+
+// UpdateEnclaveFilters replaces the filters registered by the enclave in the logs collector config map, then waits for
+// the new config to reach every logs collector pod and hot-reloads them
+func (fluentbit *fluentbitLogsCollector) UpdateEnclaveFilters(
+	ctx context.Context,
+	logsCollectorDaemonSet *appsv1.DaemonSet,
+	logsCollectorConfigMap *apiv1.ConfigMap,
+	enclaveUuid string,
+	enclaveNamespaceName string,
+	enclaveFilters []logs_collector.Filter,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	enclaveFiltersConfigFilepath := getConfigFilepath(enclaveFiltersConfigFileName)
+	if !strings.Contains(logsCollectorConfigMap.Data[fluentBitConfigFileName], fmt.Sprintf("@INCLUDE %v", enclaveFiltersConfigFilepath)) {
+		return stacktrace.NewError(
+			"The config of the logs collector doesn't include the enclave filters, which means it was created by an " +
+				"older version of Kurtosis; the engine needs to be restarted to recreate it")
+	}
+
+	enclaveFiltersConfigStr, err := generateEnclaveFiltersConfigStr(enclaveNamespaceName, enclaveFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating the fluent bit filters config of enclave '%v'", enclaveUuid)
+	}
+
+	data := copyConfigMapData(logsCollectorConfigMap)
+	enclaveConfigFileName := getEnclaveFiltersConfigFileName(enclaveUuid)
+	if len(enclaveFilters) == 0 {
+		delete(data, enclaveConfigFileName)
+	} else {
+		data[enclaveConfigFileName] = enclaveFiltersConfigStr
+	}
+	if err := setEnclaveFiltersIncludes(data); err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating the fluent bit config including the filters of the enclaves")
+	}
+
+	if _, err := kubernetesManager.UpdateConfigMapData(ctx, logsCollectorConfigMap, data); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the logs collector config map '%v'", logsCollectorConfigMap.Name)
+	}
+
+	// the enclave config file is expected to be gone along with its include when removing the filters
+	expectedConfigFilesContent := data[enclaveFiltersConfigFileName] + data[enclaveConfigFileName]
+	checkConfigFilesCmd := []string{
+		"sh",
+		"-c",
+		fmt.Sprintf("cat %v; cat %v 2>/dev/null; true", enclaveFiltersConfigFilepath, getConfigFilepath(enclaveConfigFileName)),
+	}
+
+	pods, err := kubernetesManager.GetPodsManagedByDaemonSet(ctx, logsCollectorDaemonSet)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting pods managed by logs collector daemon set '%v'", logsCollectorDaemonSet.Name)
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase != apiv1.PodRunning {
+			// a pod starting later on mounts the updated config map
+			logrus.Debugf("Not reloading logs collector pod '%v' as it isn't running", pod.Name)
+			continue
+		}
+		if err := waitForConfigFilesContent(ctx, pod, checkConfigFilesCmd, expectedConfigFilesContent, kubernetesManager); err != nil {
+			return stacktrace.Propagate(err, "An error occurred waiting for the updated config to reach logs collector pod '%v'", pod.Name)
+		}
+		if err := reloadConfig(ctx, pod, kubernetesManager); err != nil {
+			return stacktrace.Propagate(err, "An error occurred reloading the config of logs collector pod '%v'", pod.Name)
+		}
+	}
+	return nil
+}
+
+// RemoveEnclavesFilters removes the filters registered by the given enclaves from the logs collector config map. The
+// logs collector pods aren't reloaded as the filters only matched the logs of the enclaves, which are gone
+func (fluentbit *fluentbitLogsCollector) RemoveEnclavesFilters(
+	ctx context.Context,
+	logsCollectorConfigMap *apiv1.ConfigMap,
+	enclaveUuids []string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	data := copyConfigMapData(logsCollectorConfigMap)
+	hasRemovedEnclaveFilters := false
+	for _, enclaveUuid := range enclaveUuids {
+		enclaveConfigFileName := getEnclaveFiltersConfigFileName(enclaveUuid)
+		if _, found := data[enclaveConfigFileName]; found {
+			delete(data, enclaveConfigFileName)
+			hasRemovedEnclaveFilters = true
+		}
+	}
+	if !hasRemovedEnclaveFilters {
+		return nil
+	}
+	if err := setEnclaveFiltersIncludes(data); err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating the fluent bit config including the filters of the enclaves")
+	}
+	if _, err := kubernetesManager.UpdateConfigMapData(ctx, logsCollectorConfigMap, data); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the logs collector config map '%v'", logsCollectorConfigMap.Name)
+	}
+	return nil
+}
+
+func waitForConfigFilesContent(
+	ctx context.Context,
+	pod *apiv1.Pod,
+	checkConfigFilesCmd []string,
+	expectedConfigFilesContent string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	deadline := time.Now().Add(configFilesPropagationTimeout)
+	for {
+		output := &bytes.Buffer{}
+		stderrOutput := &bytes.Buffer{}
+		_, err := kubernetesManager.RunExecCommandWithContext(ctx, pod.Namespace, pod.Name, fluentBitContainerName, checkConfigFilesCmd, output, stderrOutput)
+		if err == nil && output.String() == expectedConfigFilesContent {
+			return nil
+		}
+		if err != nil {
+			logrus.Debugf("An error occurred reading the config files of logs collector pod '%v':\n%v", pod.Name, err)
+		}
+		if time.Now().After(deadline) {
+			return stacktrace.NewError(
+				"The config files of logs collector pod '%v' weren't updated after %v; the last content read was:\n%v",
+				pod.Name,
+				configFilesPropagationTimeout,
+				output.String(),
+			)
+		}
+		time.Sleep(timeBetweenConfigFilesPropagationChecks)
+	}
+}
+
+func reloadConfig(ctx context.Context, pod *apiv1.Pod, kubernetesManager *kubernetes_manager.KubernetesManager) error {
+	output := &bytes.Buffer{}
+	stderrOutput := &bytes.Buffer{}
+	exitCode, err := kubernetesManager.RunExecCommandWithContext(ctx, pod.Namespace, pod.Name, fluentBitContainerName, []string{"sh", "-c", hotReloadCmd}, output, stderrOutput)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running '%v' in logs collector pod '%v'", hotReloadCmd, pod.Name)
+	}
+	if exitCode != hotReloadSuccessExitCode {
+		return stacktrace.NewError("Running '%v' in logs collector pod '%v' exited with code '%v' and output:\n%v", hotReloadCmd, pod.Name, exitCode, stderrOutput.String())
+	}
+	return nil
+}
+
+func copyConfigMapData(configMap *apiv1.ConfigMap) map[string]string {
+	data := make(map[string]string, len(configMap.Data))
+	for key, value := range configMap.Data {
+		data[key] = value
+	}
+	return data
+}
+
+// setEnclaveFiltersIncludes regenerates the config file including the config file of every enclave with filters
+func setEnclaveFiltersIncludes(data map[string]string) error {
+	enclaveConfigFilepaths := []string{}
+	for fileName := range data {
+		if strings.HasPrefix(fileName, enclaveFiltersConfigFileNamePrefix) && strings.HasSuffix(fileName, enclaveFiltersConfigFileNameSuffix) {
+			enclaveConfigFilepaths = append(enclaveConfigFilepaths, getConfigFilepath(fileName))
+		}
+	}
+	sort.Strings(enclaveConfigFilepaths)
+
+	tmpl, err := template.New("fluentBitEnclaveFiltersIncludesConfig").Parse(enclaveFiltersIncludesConfigFileTemplate)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing fluent bit enclave filters includes config template: %v", enclaveFiltersIncludesConfigFileTemplate)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, enclaveConfigFilepaths); err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating fluent bit enclave filters includes config string from filepaths: %v", enclaveConfigFilepaths)
+	}
+	data[enclaveFiltersConfigFileName] = buf.String()
+	return nil
+}
+
+func generateEnclaveFiltersConfigStr(enclaveNamespaceName string, enclaveFilters []logs_collector.Filter) (string, error) {
+	type FluentBitEnclaveFiltersConfigData struct {
+		Match   string
+		Filters []logs_collector.Filter
+	}
+
+	tmpl, err := template.New("fluentBitEnclaveFiltersConfig").Parse(enclaveFiltersConfigFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing fluent bit enclave filters config template: %v", enclaveFiltersConfigFileTemplate)
+	}
+
+	expandedEnclaveFilters, err := logs_collector.ExpandBuiltInFilters(enclaveFilters, kubernetes_label_key.LogsServiceUUIDKubernetesLabelKey.GetString())
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred expanding the filters implemented by Kurtosis in the fluent bit enclave filters")
+	}
+
+	fluentBitEnclaveFiltersConfigData := FluentBitEnclaveFiltersConfigData{
+		Match:   fmt.Sprintf(enclaveFiltersMatchTemplate, enclaveNamespaceName),
+		Filters: expandedEnclaveFilters,
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, fluentBitEnclaveFiltersConfigData); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating fluent bit enclave filters config string from data: %v", fluentBitEnclaveFiltersConfigData)
+	}
+	return buf.String(), nil
+}
+
+func getEnclaveFiltersConfigFileName(enclaveUuid string) string {
+	return enclaveFiltersConfigFileNamePrefix + enclaveUuid + enclaveFiltersConfigFileNameSuffix
+}
+
+func getConfigFilepath(configFileName string) string {
+	return fmt.Sprintf("%v/%v", fluentBitConfigMountPath, configFileName)
+}
@@ -0,0 +1,142 @@
+package fluentbit
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateEnclaveFiltersConfigStr(t *testing.T) {
+	filters := []logs_collector.Filter{
+		{
+			Name:  "grep",
+			Match: "",
+			Params: []logs_collector.FilterParam{
+				{Key: "Exclude", Value: "log ^DEBUG"},
+			},
+		},
+		{
+			Name:  "modify",
+			Match: "",
+			Params: []logs_collector.FilterParam{
+				{Key: "Add", Value: "team payments"},
+				{Key: "Remove", Value: "password"},
+			},
+		},
+	}
+
+	configStr, err := generateEnclaveFiltersConfigStr("kt-test-enclave", filters)
+	require.NoError(t, err)
+	expectedConfigStr := `
+[FILTER]
+    Name              grep
+    Match             kurtosis.var.log.pods.kt-test-enclave_*
+    Exclude log ^DEBUG
+
+[FILTER]
+    Name              modify
+    Match             kurtosis.var.log.pods.kt-test-enclave_*
+    Add team payments
+    Remove password
+`
+	require.Equal(t, expectedConfigStr, configStr)
+}
+
+func TestSetEnclaveFiltersIncludes(t *testing.T) {
+	data := map[string]string{
+		fluentBitConfigFileName:                      "[SERVICE]",
+		parsersFileName:                              "",
+		enclaveFiltersConfigFileName:                 "",
+		getEnclaveFiltersConfigFileName("enclave-b"): "[FILTER]",
+		getEnclaveFiltersConfigFileName("enclave-a"): "[FILTER]",
+	}
+
+	require.NoError(t, setEnclaveFiltersIncludes(data))
+	expectedIncludesStr := `@INCLUDE /fluent-bit/etc/conf/enclave-filters-enclave-a.conf
+@INCLUDE /fluent-bit/etc/conf/enclave-filters-enclave-b.conf
+`
+	require.Equal(t, expectedIncludesStr, data[enclaveFiltersConfigFileName])
+
+	delete(data, getEnclaveFiltersConfigFileName("enclave-a"))
+	delete(data, getEnclaveFiltersConfigFileName("enclave-b"))
+	require.NoError(t, setEnclaveFiltersIncludes(data))
+	require.Empty(t, data[enclaveFiltersConfigFileName])
+}
+
+func TestGenerateFluentBitConfigStr_IncludesEnclaveFilters(t *testing.T) {
+	configStr, err := generateFluentBitConfigStr(9713, "10.0.0.1", 9714, nil, logs_collector.NewDisabledSystemLogsConfig(), logs_collector.NewEmptyKubernetesMetadataConfig(), logs_collector.NewDefaultBufferConfig())
+	require.NoError(t, err)
+	require.Contains(t, configStr, "\n@INCLUDE /fluent-bit/etc/conf/enclave-filters.conf\n")
+	require.Contains(t, configStr, "Hot_Reload        On")
+}
+
+func TestGenerateFluentBitConfigStr_SystemLogs(t *testing.T) {
+	configStr, err := generateFluentBitConfigStr(9713, "10.0.0.1", 9714, nil, logs_collector.NewDisabledSystemLogsConfig(), logs_collector.NewEmptyKubernetesMetadataConfig(), logs_collector.NewDefaultBufferConfig())
+	require.NoError(t, err)
+	require.NotContains(t, configStr, "Name              systemd")
+
+	systemLogsConfig := logs_collector.SystemLogsConfig{SystemdUnits: []string{"kubelet.service", "containerd.service"}}
+	configStr, err = generateFluentBitConfigStr(9713, "10.0.0.1", 9714, nil, systemLogsConfig, logs_collector.NewEmptyKubernetesMetadataConfig(), logs_collector.NewDefaultBufferConfig())
+	require.NoError(t, err)
+	require.Contains(t, configStr, "Name              systemd")
+	require.Contains(t, configStr, "Systemd_Filter    _SYSTEMD_UNIT=kubelet.service\n    Systemd_Filter    _SYSTEMD_UNIT=containerd.service\n")
+	require.Contains(t, configStr, "Script            /fluent-bit/etc/conf/kurtosis-system-logs.lua")
+}
+
+func TestGenerateEnclaveFiltersConfigStr_BuiltInFilters(t *testing.T) {
+	filters := []logs_collector.Filter{
+		{
+			Name:   logs_collector.SampleFilterName,
+			Match:  "",
+			Params: []logs_collector.FilterParam{{Key: logs_collector.SampleRateParamKey, Value: "10"}},
+		},
+	}
+	configStr, err := generateEnclaveFiltersConfigStr("kt-enclave", filters)
+	require.NoError(t, err)
+	require.Contains(t, configStr, "Name              lua\n    Match             kurtosis.var.log.pods.kt-enclave_*\n    call kurtosis_sample\n")
+	require.Contains(t, configStr, `record["kurtosis_service_uuid"]`)
+}
+
+func TestGenerateFluentBitConfigStr_KubernetesMetadata(t *testing.T) {
+	kubernetesMetadataConfig := logs_collector.KubernetesMetadataConfig{
+		Fields: []string{"host", "pod_ip"},
+		Labels: []string{"app.kubernetes.io/name"},
+	}
+	configStr, err := generateFluentBitConfigStr(9713, "10.0.0.1", 9714, nil, logs_collector.NewDisabledSystemLogsConfig(), kubernetesMetadataConfig, logs_collector.NewDefaultBufferConfig())
+	require.NoError(t, err)
+	require.Contains(t, configStr, `record["kubernetes_host"] = record["kubernetes"]["host"] record["kubernetes_pod_ip"] = record["kubernetes"]["pod_ip"] `)
+	require.Contains(t, configStr, `record["kubernetes_label_app.kubernetes.io/name"] = record["kubernetes"]["labels"]["app.kubernetes.io/name"] return 1, timestamp, record end`)
+}
+
+func TestGenerateFluentBitConfigStr_InitContainersAndSidecars(t *testing.T) {
+	configStr, err := generateFluentBitConfigStr(9713, "10.0.0.1", 9714, nil, logs_collector.NewDisabledSystemLogsConfig(), logs_collector.NewEmptyKubernetesMetadataConfig(), logs_collector.NewDefaultBufferConfig())
+	require.NoError(t, err)
+	require.Contains(t, configStr, "Path              /var/log/pods/kt-*_*_*/*/*.log")
+	require.Contains(t, configStr, `if record["kubernetes"]["container_name"] ~= "user-service-container" then record["kurtosis_container_name"] = record["kubernetes"]["container_name"] end`)
+}
+
+func TestGenerateContainerRestartsScriptStr(t *testing.T) {
+	scriptStr, err := generateContainerRestartsScriptStr()
+	require.NoError(t, err)
+	require.Contains(t, scriptStr, `record["kurtosis_container_restart_count"] = restart_count_str`)
+	require.Contains(t, scriptStr, `restart_line["log_source"] = "container_restart"`)
+	require.Contains(t, scriptStr, `return 1, timestamp, {restart_line, record}`)
+}
+
+func TestGenerateFluentBitConfigStr_Buffer(t *testing.T) {
+	configStr, err := generateFluentBitConfigStr(9713, "10.0.0.1", 9714, nil, logs_collector.NewDisabledSystemLogsConfig(), logs_collector.NewEmptyKubernetesMetadataConfig(), logs_collector.NewDefaultBufferConfig())
+	require.NoError(t, err)
+	require.NotContains(t, configStr, "storage.")
+	require.NotContains(t, configStr, "Mem_Buf_Limit")
+
+	bufferConfig := logs_collector.BufferConfig{
+		MemBufLimit: "50MB",
+		StorageType: logs_collector.FilesystemStorageType,
+		MaxChunksUp: 64,
+	}
+	configStr, err = generateFluentBitConfigStr(9713, "10.0.0.1", 9714, nil, logs_collector.NewDisabledSystemLogsConfig(), logs_collector.NewEmptyKubernetesMetadataConfig(), bufferConfig)
+	require.NoError(t, err)
+	require.Contains(t, configStr, "    storage.path      /var/log/fluent-bit/db/storage\n    storage.max_chunks_up 64\n")
+	require.Contains(t, configStr, "    Mem_Buf_Limit     50MB\n    storage.type      filesystem\n")
+}
@@ -23,10 +23,9 @@ import (
 )
 
 const (
-	httpProtocolStr = "http"
-	emptyUrl        = ""
-	retryInterval   = 1 * time.Second
-	maxRetries      = 30
+	httpProtocolStr        = "http"
+	emptyUrl               = ""
+	podAvailabilityTimeout = 30 * time.Second
 )
 
 var noWait *port_spec.Wait = nil
@@ -47,6 +46,9 @@ func (fluentbit *fluentbitLogsCollector) CreateAndStart(
 	logsCollectorHttpPortId string,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 ) (
@@ -155,7 +157,7 @@ func (fluentbit *fluentbitLogsCollector) CreateAndStart(
 		}
 	}()
 
-	configMap, err := createLogsCollectorConfigMap(ctx, namespace.Name, httpPortNumber, logsAggregatorHost, logsAggregatorPort, logsCollectorFilters, logsCollectorParsers, logsCollectorAttrProvider, kubernetesManager)
+	configMap, err := createLogsCollectorConfigMap(ctx, namespace.Name, httpPortNumber, logsAggregatorHost, logsAggregatorPort, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig, logsCollectorAttrProvider, kubernetesManager)
 	if err != nil {
 		return nil, nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred while trying to create config map for fluent bit log collector.")
 	}
@@ -228,7 +230,7 @@ func (fluentbit *fluentbitLogsCollector) CreateAndStart(
 	}()
 
 	// wait until the first pod associated with this daemon set is online before returning
-	if err = waitForAtLeastOneActivePodManagedByDaemonSet(ctx, daemonSet, kubernetesManager); err != nil {
+	if err = kubernetesManager.WaitForPodManagedByDaemonSet(ctx, daemonSet, podAvailabilityTimeout); err != nil {
 		return nil, nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred waiting for at least one active pod managed by logs collector daemon set '%v'", daemonSet.Name)
 	}
 
@@ -287,7 +289,21 @@ func createLogsCollectorDaemonSet(
 			Ports:      ports,
 			WorkingDir: "",
 			EnvFrom:    nil,
-			Env:        nil,
+			Env: []apiv1.EnvVar{
+				{
+					Name:  nodeNameEnvVar,
+					Value: "",
+					ValueFrom: &apiv1.EnvVarSource{
+						FieldRef: &apiv1.ObjectFieldSelector{
+							APIVersion: "",
+							FieldPath:  "spec.nodeName",
+						},
+						ResourceFieldRef: nil,
+						ConfigMapKeyRef:  nil,
+						SecretKeyRef:     nil,
+					},
+				},
+			},
 			Resources: apiv1.ResourceRequirements{
 				Limits:   nil,
 				Requests: nil,
@@ -428,6 +444,9 @@ func createLogsCollectorConfigMap(
 	logsAggregatorPortNum uint16,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 	objAttrProvider object_attributes_provider.KubernetesLogsCollectorObjectAttributesProvider,
 	kubernetesManager *kubernetes_manager.KubernetesManager) (*apiv1.ConfigMap, error) {
 	configMapAttrProvider, err := objAttrProvider.ForLogsCollectorConfigMap()
@@ -443,6 +462,9 @@ func createLogsCollectorConfigMap(
 		logsAggregatorHost,
 		logsAggregatorPortNum,
 		logsCollectorFilters,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred generating fluent bit config string.")
@@ -455,16 +477,42 @@ func createLogsCollectorConfigMap(
 		return nil, stacktrace.Propagate(err, "An error occurred generating fluent bit parser config string.")
 	}
 
+	kubernetesEventsScriptStr, err := generateKubernetesEventsScriptStr()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the fluent bit script turning kubernetes events into log lines.")
+	}
+
+	containerRestartsScriptStr, err := generateContainerRestartsScriptStr()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the fluent bit script marking the restarts of the containers.")
+	}
+
+	configMapData := map[string]string{
+		fluentBitConfigFileName: fluentBitConfigStr,
+		parsersFileName:         fluentBitParserConfigStr,
+		// the kubernetes events collection is part of every logs collector, so it doesn't depend on the user parsers
+		kubernetesEventsParsersFileName: kubernetesEventsParsersConfigFileStr,
+		kubernetesEventsScriptFileName:  kubernetesEventsScriptStr,
+		podLogsParsersFileName:          podLogsParsersConfigFileStr,
+		containerRestartsScriptFileName: containerRestartsScriptStr,
+		// no enclave registered filters yet, but the file needs to exist to be included
+		enclaveFiltersConfigFileName: "",
+	}
+	if logsCollectorSystemLogsConfig.IsEnabled() {
+		systemLogsScriptStr, err := generateSystemLogsScriptStr()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred generating the fluent bit script turning system logs into log lines.")
+		}
+		configMapData[systemLogsScriptFileName] = systemLogsScriptStr
+	}
+
 	configMap, err := kubernetesManager.CreateConfigMap(
 		ctx,
 		namespace,
 		name,
 		labels,
 		annotations,
-		map[string]string{
-			fluentBitConfigFileName: fluentBitConfigStr,
-			parsersFileName:         fluentBitParserConfigStr,
-		},
+		configMapData,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating config map for fluent bit log collector config.")
@@ -478,22 +526,46 @@ func generateFluentBitConfigStr(
 	logsAggregatorHost string,
 	logsAggregatorPortNun uint16,
 	logsCollectorFilters []logs_collector.Filter,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) (
 	string,
 	error,
 ) {
 	type FluentBitConfigData struct {
-		HTTPPort                      uint16
-		KurtosisParsersConfigFilepath string
-		UserServiceResourceStr        string
-		CheckpointDbMountPath         string
-		LogsEnclaveUUIDLabel          string
-		LogsServiceUUIDLabel          string
-		LogsServiceNameLabel          string
-		K8sApiServerURL               string
-		LogsAggregatorHost            string
-		LogsAggregatorPortNum         uint16
-		Filters                       []logs_collector.Filter
+		HTTPPort                              uint16
+		KurtosisParsersConfigFilepath         string
+		UserServiceResourceStr                string
+		CheckpointDbMountPath                 string
+		LogsEnclaveUUIDLabel                  string
+		LogsServiceUUIDLabel                  string
+		LogsServiceNameLabel                  string
+		ContainerNameLabel                    string
+		K8sApiServerURL                       string
+		LogsAggregatorHost                    string
+		LogsAggregatorPortNum                 uint16
+		Filters                               []logs_collector.Filter
+		EnclaveFiltersConfigFilepath          string
+		KubernetesEventsTag                   string
+		KubernetesEventsTagParserName         string
+		KubernetesEventsParsersConfigFilepath string
+		PodLogsPath                           string
+		PodLogsTagPrefix                      string
+		PodLogsTagParserName                  string
+		PodLogsParsersConfigFilepath          string
+		ContainerRestartsScriptFilepath       string
+		KubernetesEventsScriptFilepath        string
+		SystemdUnits                          []string
+		SystemLogsTag                         string
+		JournalPath                           string
+		SystemLogsScriptFilepath              string
+		KubernetesMetadataFields              []kubernetesMetadataRecordKey
+		KubernetesMetadataLabels              []kubernetesMetadataRecordKey
+		MemBufLimit                           string
+		StorageType                           string
+		StoragePath                           string
+		StorageMaxChunksUp                    uint32
 	}
 
 	tmpl, err := template.New("fluentBitConfig").Parse(fluentBitConfigTemplate)
@@ -501,18 +573,44 @@ func generateFluentBitConfigStr(
 		return "", stacktrace.Propagate(err, "An error occurred parsing fluent bit config template: %v", fluentBitConfigTemplate)
 	}
 
+	expandedFilters, err := logs_collector.ExpandBuiltInFilters(logsCollectorFilters, kubernetes_label_key.LogsServiceUUIDKubernetesLabelKey.GetString())
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred expanding the filters implemented by Kurtosis in the fluent bit filters")
+	}
+
 	fluentBitConfigData := FluentBitConfigData{
-		HTTPPort:                      logsCollectorHttpPort,
-		UserServiceResourceStr:        label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
-		CheckpointDbMountPath:         fluentBitCheckpointDbMountPath,
-		LogsEnclaveUUIDLabel:          kubernetes_label_key.LogsEnclaveUUIDKubernetesLabelKey.GetString(),
-		LogsServiceUUIDLabel:          kubernetes_label_key.LogsServiceUUIDKubernetesLabelKey.GetString(),
-		LogsServiceNameLabel:          kubernetes_label_key.LogsServiceNameKubernetesLabelKey.GetString(),
-		K8sApiServerURL:               k8sApiServerUrl,
-		LogsAggregatorPortNum:         logsAggregatorPortNun,
-		LogsAggregatorHost:            logsAggregatorHost,
-		Filters:                       logsCollectorFilters,
-		KurtosisParsersConfigFilepath: fmt.Sprintf("%v/%v", fluentBitConfigMountPath, parsersFileName),
+		HTTPPort:                              logsCollectorHttpPort,
+		UserServiceResourceStr:                label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		CheckpointDbMountPath:                 fluentBitCheckpointDbMountPath,
+		LogsEnclaveUUIDLabel:                  kubernetes_label_key.LogsEnclaveUUIDKubernetesLabelKey.GetString(),
+		LogsServiceUUIDLabel:                  kubernetes_label_key.LogsServiceUUIDKubernetesLabelKey.GetString(),
+		LogsServiceNameLabel:                  kubernetes_label_key.LogsServiceNameKubernetesLabelKey.GetString(),
+		ContainerNameLabel:                    logs_collector.ContainerNameLabel,
+		K8sApiServerURL:                       k8sApiServerUrl,
+		LogsAggregatorPortNum:                 logsAggregatorPortNun,
+		LogsAggregatorHost:                    logsAggregatorHost,
+		Filters:                               expandedFilters,
+		KurtosisParsersConfigFilepath:         fmt.Sprintf("%v/%v", fluentBitConfigMountPath, parsersFileName),
+		EnclaveFiltersConfigFilepath:          getConfigFilepath(enclaveFiltersConfigFileName),
+		KubernetesEventsTag:                   kubernetesEventsTag,
+		KubernetesEventsTagParserName:         kubernetesEventsTagParserName,
+		KubernetesEventsParsersConfigFilepath: getConfigFilepath(kubernetesEventsParsersFileName),
+		PodLogsPath:                           podLogsPath,
+		PodLogsTagPrefix:                      podLogsTagPrefix,
+		PodLogsTagParserName:                  podLogsTagParserName,
+		PodLogsParsersConfigFilepath:          getConfigFilepath(podLogsParsersFileName),
+		ContainerRestartsScriptFilepath:       getConfigFilepath(containerRestartsScriptFileName),
+		KubernetesEventsScriptFilepath:        getConfigFilepath(kubernetesEventsScriptFileName),
+		SystemdUnits:                          logsCollectorSystemLogsConfig.SystemdUnits,
+		SystemLogsTag:                         systemLogsTag,
+		JournalPath:                           journalPath,
+		SystemLogsScriptFilepath:              getConfigFilepath(systemLogsScriptFileName),
+		KubernetesMetadataFields:              getKubernetesMetadataRecordKeys(logsCollectorKubernetesMetadataConfig.Fields, logs_collector.KubernetesMetadataFieldRecordKeyPrefix),
+		KubernetesMetadataLabels:              getKubernetesMetadataRecordKeys(logsCollectorKubernetesMetadataConfig.Labels, logs_collector.KubernetesMetadataLabelRecordKeyPrefix),
+		MemBufLimit:                           logsCollectorBufferConfig.MemBufLimit,
+		StorageType:                           logsCollectorBufferConfig.StorageType,
+		StoragePath:                           fluentBitStoragePath,
+		StorageMaxChunksUp:                    logsCollectorBufferConfig.MaxChunksUp,
 	}
 	var buf bytes.Buffer
 	err = tmpl.Execute(&buf, fluentBitConfigData)
@@ -525,6 +623,115 @@ func generateFluentBitConfigStr(
 	return buf.String(), nil
 }
 
+func generateKubernetesEventsScriptStr() (string, error) {
+	type KubernetesEventsScriptData struct {
+		LogsEnclaveUUIDLabel     string
+		LogsServiceUUIDLabel     string
+		LogSourceLabel           string
+		KubernetesEventLogSource string
+		KubernetesEventIdLabel   string
+	}
+
+	tmpl, err := template.New("kubernetesEventsScript").Parse(kubernetesEventsScriptFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing the kubernetes events script template: %v", kubernetesEventsScriptFileTemplate)
+	}
+
+	kubernetesEventsScriptData := KubernetesEventsScriptData{
+		LogsEnclaveUUIDLabel:     kubernetes_label_key.LogsEnclaveUUIDKubernetesLabelKey.GetString(),
+		LogsServiceUUIDLabel:     kubernetes_label_key.LogsServiceUUIDKubernetesLabelKey.GetString(),
+		LogSourceLabel:           logs_collector.LogSourceLabel,
+		KubernetesEventLogSource: logs_collector.KubernetesEventLogSource,
+		KubernetesEventIdLabel:   logs_collector.KubernetesEventIdLabel,
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, kubernetesEventsScriptData); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating the kubernetes events script from data: %v", kubernetesEventsScriptData)
+	}
+
+	return buf.String(), nil
+}
+
+func generateContainerRestartsScriptStr() (string, error) {
+	type ContainerRestartsScriptData struct {
+		LogsEnclaveUUIDLabel       string
+		LogsServiceUUIDLabel       string
+		ContainerNameLabel         string
+		ContainerRestartCountLabel string
+		LogSourceLabel             string
+		ContainerRestartLogSource  string
+	}
+
+	tmpl, err := template.New("containerRestartsScript").Parse(containerRestartsScriptFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing the container restarts script template: %v", containerRestartsScriptFileTemplate)
+	}
+
+	containerRestartsScriptData := ContainerRestartsScriptData{
+		LogsEnclaveUUIDLabel:       kubernetes_label_key.LogsEnclaveUUIDKubernetesLabelKey.GetString(),
+		LogsServiceUUIDLabel:       kubernetes_label_key.LogsServiceUUIDKubernetesLabelKey.GetString(),
+		ContainerNameLabel:         logs_collector.ContainerNameLabel,
+		ContainerRestartCountLabel: logs_collector.ContainerRestartCountLabel,
+		LogSourceLabel:             logs_collector.LogSourceLabel,
+		ContainerRestartLogSource:  logs_collector.ContainerRestartLogSource,
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, containerRestartsScriptData); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating the container restarts script from data: %v", containerRestartsScriptData)
+	}
+
+	return buf.String(), nil
+}
+
+// kubernetesMetadataRecordKey is a key of the metadata the kubernetes filter looks up about a pod, copied into the
+// record of the log lines of the pod
+type kubernetesMetadataRecordKey struct {
+	MetadataKey string
+	RecordKey   string
+}
+
+func getKubernetesMetadataRecordKeys(metadataKeys []string, recordKeyPrefix string) []kubernetesMetadataRecordKey {
+	recordKeys := []kubernetesMetadataRecordKey{}
+	for _, metadataKey := range metadataKeys {
+		recordKeys = append(recordKeys, kubernetesMetadataRecordKey{
+			MetadataKey: metadataKey,
+			RecordKey:   recordKeyPrefix + metadataKey,
+		})
+	}
+	return recordKeys
+}
+
+func generateSystemLogsScriptStr() (string, error) {
+	type SystemLogsScriptData struct {
+		LogsEnclaveUUIDLabel string
+		LogsServiceUUIDLabel string
+		SystemLogsStreamId   string
+		NodeNameEnvVar       string
+		LogSourceLabel       string
+		SystemLogSource      string
+	}
+
+	tmpl, err := template.New("systemLogsScript").Parse(systemLogsScriptFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing the system logs script template: %v", systemLogsScriptFileTemplate)
+	}
+
+	systemLogsScriptData := SystemLogsScriptData{
+		LogsEnclaveUUIDLabel: kubernetes_label_key.LogsEnclaveUUIDKubernetesLabelKey.GetString(),
+		LogsServiceUUIDLabel: kubernetes_label_key.LogsServiceUUIDKubernetesLabelKey.GetString(),
+		SystemLogsStreamId:   logs_collector.SystemLogsStreamId,
+		NodeNameEnvVar:       nodeNameEnvVar,
+		LogSourceLabel:       logs_collector.LogSourceLabel,
+		SystemLogSource:      logs_collector.SystemLogSource,
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, systemLogsScriptData); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating the system logs script from data: %v", systemLogsScriptData)
+	}
+
+	return buf.String(), nil
+}
+
 func generateFluentBitParserConfigStr(
 	logsCollectorParsers []logs_collector.Parser,
 ) (
@@ -575,36 +782,6 @@ func createLogsCollectorNamespace(
 	return namespaceObj, nil
 }
 
-func waitForAtLeastOneActivePodManagedByDaemonSet(ctx context.Context, logsCollectorDaemonSet *appsv1.DaemonSet, kubernetesManager *kubernetes_manager.KubernetesManager) error {
-	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(maxRetries)*retryInterval)
-	defer cancel()
-
-	ticker := time.NewTicker(retryInterval)
-	defer ticker.Stop()
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		select {
-		case <-timeoutCtx.Done():
-			return stacktrace.NewError(
-				"Timeout waiting for a pod managed by logs collector daemon set '%s' to come online",
-				logsCollectorDaemonSet.Name,
-			)
-		case <-ticker.C:
-			pods, err := kubernetesManager.GetPodsManagedByDaemonSet(ctx, logsCollectorDaemonSet)
-			if err != nil {
-				return stacktrace.Propagate(err, "An error occurred getting pods managed by logs collector daemon set '%v'", logsCollectorDaemonSet.Name)
-			}
-			if len(pods) > 0 && len(pods[0].Status.ContainerStatuses) > 0 && pods[0].Status.ContainerStatuses[0].Ready {
-				// found a pod with a running fluent bit container
-				return nil
-			}
-		}
-	}
-	return stacktrace.NewError(
-		"Exceeded max retries (%d) waiting for a pod managed by daemon set '%s' to come online",
-		maxRetries, logsCollectorDaemonSet.Name,
-	)
-}
-
 func createLogsCollectorServiceAccount(
 	ctx context.Context,
 	namespace string,
@@ -646,6 +823,13 @@ func createLogsCollectorClusterRole(
 			ResourceNames:   nil,
 			NonResourceURLs: nil,
 		},
+		{
+			Verbs:           []string{"get", "list", "watch"},
+			APIGroups:       []string{""},
+			Resources:       []string{"events"},
+			ResourceNames:   nil,
+			NonResourceURLs: nil,
+		},
 	}
 	clusterRoleObj, err := kubernetesManager.CreateClusterRoles(ctx, clusterRoleName, rules, clusterRoleLabels)
 	if err != nil {
@@ -758,7 +942,7 @@ func (fluentbit *fluentbitLogsCollector) Clean(
 	}
 
 	//before continuing, ensure logs collector is up again
-	if err := waitForAtLeastOneActivePodManagedByDaemonSet(ctx, logsCollectorDaemonSet, kubernetesManager); err != nil {
+	if err := kubernetesManager.WaitForPodManagedByDaemonSet(ctx, logsCollectorDaemonSet, podAvailabilityTimeout); err != nil {
 		return stacktrace.Propagate(err, "An error occurred waiting for at least one pod managed by daemon set '%v' has become available.", logsCollectorName)
 	}
 
@@ -22,6 +22,9 @@ type LogsCollectorDaemonSet interface {
 		logsCollectorHttpPortId string,
 		logsCollectorFilters []logs_collector.Filter,
 		logsCollectorParsers []logs_collector.Parser,
+		logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+		logsCollectorBufferConfig logs_collector.BufferConfig,
 		objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 		kubernetesManager *kubernetes_manager.KubernetesManager,
 	) (
@@ -38,6 +41,26 @@ type LogsCollectorDaemonSet interface {
 	// GetHttpHealthCheckEndpoint returns endpoint for verifying the availability of the logs collector application on pods managed by the daemon set
 	GetHttpHealthCheckEndpoint() string
 
+	// UpdateEnclaveFilters replaces the filters applied on top of the logs collector filters to the logs of the services
+	// of the enclave, hot-reloading the pods managed by the daemon set instead of restarting them
+	UpdateEnclaveFilters(
+		ctx context.Context,
+		logsCollectorDaemonSet *appsv1.DaemonSet,
+		logsCollectorConfigMap *apiv1.ConfigMap,
+		enclaveUuid string,
+		enclaveNamespaceName string,
+		enclaveFilters []logs_collector.Filter,
+		kubernetesManager *kubernetes_manager.KubernetesManager,
+	) error
+
+	// RemoveEnclavesFilters removes the filters registered by the enclaves, once they are destroyed
+	RemoveEnclavesFilters(
+		ctx context.Context,
+		logsCollectorConfigMap *apiv1.ConfigMap,
+		enclaveUuids []string,
+		kubernetesManager *kubernetes_manager.KubernetesManager,
+	) error
+
 	// Clean removes any resources the logs collector creates for durability of logs in the case of crashes (e.g. checkpoint dbs)
 	Clean(
 		ctx context.Context,
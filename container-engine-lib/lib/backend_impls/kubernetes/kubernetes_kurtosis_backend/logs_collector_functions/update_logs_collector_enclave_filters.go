@@ -0,0 +1,67 @@
+package logs_collector_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// UpdateLogsCollectorEnclaveFilters replaces the filters the logs collector applies on top of its own to the logs of the
+// services of the enclave, which live in the given namespace
+func UpdateLogsCollectorEnclaveFilters(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNamespaceName string,
+	enclaveFilters []logs_collector.Filter,
+	logsCollectorDaemonSet LogsCollectorDaemonSet,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	kubernetesResources, err := getLogsCollectorKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting Kubernetes resources for logs collectors.")
+	}
+	if kubernetesResources.daemonSet == nil || kubernetesResources.configMap == nil {
+		return stacktrace.NewError("No logs collector was found; the filters of enclave '%v' can't be applied", enclaveUuid)
+	}
+
+	if err := logsCollectorDaemonSet.UpdateEnclaveFilters(
+		ctx,
+		kubernetesResources.daemonSet,
+		kubernetesResources.configMap,
+		string(enclaveUuid),
+		enclaveNamespaceName,
+		enclaveFilters,
+		kubernetesManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the filters of enclave '%v' in logs collector daemon set '%v'", enclaveUuid, kubernetesResources.daemonSet.Name)
+	}
+	return nil
+}
+
+// RemoveLogsCollectorEnclavesFilters removes the filters registered by the given enclaves, if there's a logs collector
+func RemoveLogsCollectorEnclavesFilters(
+	ctx context.Context,
+	enclaveUuids []enclave.EnclaveUUID,
+	logsCollectorDaemonSet LogsCollectorDaemonSet,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	kubernetesResources, err := getLogsCollectorKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting Kubernetes resources for logs collectors.")
+	}
+	if kubernetesResources.configMap == nil {
+		return nil
+	}
+
+	enclaveUuidStrs := make([]string, 0, len(enclaveUuids))
+	for _, enclaveUuid := range enclaveUuids {
+		enclaveUuidStrs = append(enclaveUuidStrs, string(enclaveUuid))
+	}
+	if err := logsCollectorDaemonSet.RemoveEnclavesFilters(ctx, kubernetesResources.configMap, enclaveUuidStrs, kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the filters of enclaves '%v' from the logs collector config map '%v'", enclaveUuidStrs, kubernetesResources.configMap.Name)
+	}
+	return nil
+}
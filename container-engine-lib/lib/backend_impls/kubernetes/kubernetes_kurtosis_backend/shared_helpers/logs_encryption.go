@@ -0,0 +1,65 @@
+package shared_helpers
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// GetLogsEncryptionKeyEnvVars copies the Secret holding the logs encryption key to the namespace, as pods can only
+// read the Secrets of their namespace, and returns the environment variables giving the key to the containers of the
+// namespace. Returns no environment variables if the logs aren't encrypted.
+func GetLogsEncryptionKeyEnvVars(
+	ctx context.Context,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
+	namespace string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) ([]apiv1.EnvVar, error) {
+	if !logsEncryptionConfig.IsEnabled() {
+		return nil, nil
+	}
+	if logsEncryptionConfig.KeySecretName == "" {
+		return nil, stacktrace.NewError("The logs encryption key must be given through a Kubernetes Secret on Kubernetes")
+	}
+	secretKey := logsEncryptionConfig.GetKeySecretKey()
+
+	secret, err := kubernetesManager.GetSecret(ctx, logsEncryptionConfig.KeySecretNamespace, logsEncryptionConfig.KeySecretName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting Secret '%v' holding the logs encryption key in namespace '%v'", logsEncryptionConfig.KeySecretName, logsEncryptionConfig.KeySecretNamespace)
+	}
+	encodedKey, found := secret.Data[secretKey]
+	if !found {
+		return nil, stacktrace.NewError("Secret '%v' in namespace '%v' has no key '%v' holding the logs encryption key", logsEncryptionConfig.KeySecretName, logsEncryptionConfig.KeySecretNamespace, secretKey)
+	}
+	if _, err := logs_aggregator.DecodeLogsEncryptionKey(string(encodedKey)); err != nil {
+		return nil, stacktrace.Propagate(err, "Secret '%v' in namespace '%v' doesn't hold a valid logs encryption key", logsEncryptionConfig.KeySecretName, logsEncryptionConfig.KeySecretNamespace)
+	}
+
+	if _, err := kubernetesManager.CreateSecret(ctx, namespace, logs_aggregator.LogsEncryptionKeySecretCopyName, nil, map[string][]byte{
+		secretKey: encodedKey,
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying the logs encryption key to namespace '%v'", namespace)
+	}
+
+	return []apiv1.EnvVar{
+		{
+			Name:  logs_aggregator.LogsEncryptionKeyEnvVar,
+			Value: "",
+			ValueFrom: &apiv1.EnvVarSource{
+				FieldRef:         nil,
+				ResourceFieldRef: nil,
+				ConfigMapKeyRef:  nil,
+				SecretKeyRef: &apiv1.SecretKeySelector{
+					LocalObjectReference: apiv1.LocalObjectReference{
+						Name: logs_aggregator.LogsEncryptionKeySecretCopyName,
+					},
+					Key:      secretKey,
+					Optional: nil,
+				},
+			},
+		},
+	}, nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/concurrent_writer"
 	"github.com/kurtosis-tech/stacktrace"
@@ -34,6 +35,9 @@ import (
 	"github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -50,8 +54,19 @@ const (
 
 	// Name to give the file that we'll write for storing specs of pods, containers, etc.
 	podSpecFilename             = "spec.json"
+	podEventsFilename           = "events.json"
 	containerLogsFilenameSuffix = ".log"
 
+	// Name of the file holding the manifests of the namespace, its services and its pods, as a List that can be applied
+	// with kubectl
+	namespaceManifestsFilename = "manifests.json"
+
+	podKind          = "Pod"
+	serviceKind      = "Service"
+	namespaceKind    = "Namespace"
+	listKind         = "List"
+	coreV1ApiVersion = "v1"
+
 	// Permissions for the files & directories we create as a result of the dump
 	createdDirPerms  os.FileMode = 0755
 	createdFilePerms os.FileMode = 0644
@@ -504,6 +519,9 @@ func GetUserServiceObjectsFromKubernetesResources(
 		for _, env := range podContainer.Env {
 			podContainerEnvVars[env.Name] = env.Value
 		}
+		// The secrets the service was started with aren't handed out to the callers inspecting it
+		secretEnvVarNames := secrets_provider.DeserializeSecretEnvVarNames(resourcesToParse.Pod.Annotations[kubernetes_annotation_key_consts.SecretEnvVarsKubernetesAnnotationKey.GetString()])
+		podContainerEnvVars = secrets_provider.RedactEnvVars(podContainerEnvVars, secretEnvVarNames)
 
 		resultObj.Service = service.NewService(
 			serviceRegistrationObj,
@@ -769,6 +787,19 @@ func DumpNamespacePods(
 		return stacktrace.Propagate(err, "An error occurred creating output directory at '%v'", outputDirpath)
 	}
 
+	eventsByPodName := map[string][]apiv1.Event{}
+	namespaceEvents, err := kubernetesManager.GetEventsInNamespace(ctx, namespace.Name)
+	if err != nil {
+		// Events are only there to help debugging, and they expire anyway, so the dump goes on without them
+		logrus.Warnf("An error occurred getting the events in namespace '%v'; the dump won't include them:\n%v", namespace.Name, err)
+	} else {
+		for _, event := range namespaceEvents.Items {
+			if event.InvolvedObject.Kind == podKind {
+				eventsByPodName[event.InvolvedObject.Name] = append(eventsByPodName[event.InvolvedObject.Name], event)
+			}
+		}
+	}
+
 	workerPool := workerpool.New(numPodsToDumpAtOnce)
 	resultErrsChan := make(chan dumpPodResult, len(podsToDump))
 	for _, pod := range podsToDump {
@@ -790,6 +821,7 @@ func DumpNamespacePods(
 			kubernetesManager,
 			namespace.Name,
 			pod,
+			eventsByPodName[pod.Name],
 			outputDirpath,
 			resultErrsChan,
 		)
@@ -810,6 +842,53 @@ func DumpNamespacePods(
 	return nil
 }
 
+// DumpNamespaceManifests writes the manifests of the namespace and of its services and pods to the output directory, as
+// a single List that can be inspected or applied with kubectl
+func DumpNamespaceManifests(
+	namespace *apiv1.Namespace,
+	services []apiv1.Service,
+	pods []apiv1.Pod,
+	outputDirpath string,
+) error {
+	manifests := []runtime.RawExtension{}
+	addManifest := func(object runtime.Object, kind string) {
+		// Objects returned by list calls don't have their type set, which kubectl needs
+		object.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{Group: "", Version: coreV1ApiVersion, Kind: kind})
+		manifests = append(manifests, runtime.RawExtension{Raw: nil, Object: object})
+	}
+
+	addManifest(namespace.DeepCopy(), namespaceKind)
+	for _, service := range services {
+		addManifest(service.DeepCopy(), serviceKind)
+	}
+	for _, pod := range pods {
+		addManifest(pod.DeepCopy(), podKind)
+	}
+
+	manifestsList := &metav1.List{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       listKind,
+			APIVersion: coreV1ApiVersion,
+		},
+		ListMeta: metav1.ListMeta{
+			SelfLink:           "",
+			ResourceVersion:    "",
+			Continue:           "",
+			RemainingItemCount: nil,
+		},
+		Items: manifests,
+	}
+	jsonSerializedManifestsBytes, err := json.MarshalIndent(manifestsList, enclaveDumpJsonSerializationPrefix, enclaveDumpJsonSerializationIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the manifests of namespace '%v' to JSON", namespace.Name)
+	}
+	manifestsOutputFilepath := path.Join(outputDirpath, namespaceManifestsFilename)
+	if err := os.WriteFile(manifestsOutputFilepath, jsonSerializedManifestsBytes, createdFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the manifests of namespace '%v' to file '%v'", namespace.Name, manifestsOutputFilepath)
+	}
+	return nil
+}
+
 // This is a helper function that will take multiple errors, each identified by an ID, and format them together
 // If no errors are returned, this function returns nil
 func BuildCombinedError(errorsById map[string]error, titleStr string) error {
@@ -855,16 +934,36 @@ func getEnclaveMatchLabels() map[string]string {
 	return matchLabels
 }
 
+// getPodSpecWithRedactedSecretEnvVars returns the spec of the pod where the value of the environment variables the pod
+// annotates as carrying secrets, and of the arguments of the Kurtosis containers, is replaced, so that they don't end
+// up in the enclave dumps
+func getPodSpecWithRedactedSecretEnvVars(pod apiv1.Pod) apiv1.PodSpec {
+	serializedSecretEnvVarNames := pod.Annotations[kubernetes_annotation_key_consts.SecretEnvVarsKubernetesAnnotationKey.GetString()]
+	secretEnvVarNames := secrets_provider.GetDumpRedactedEnvVarNames(serializedSecretEnvVarNames)
+	podSpec := *pod.Spec.DeepCopy()
+	for _, containers := range [][]apiv1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for containerIndex := range containers {
+			for envVarIndex, envVar := range containers[containerIndex].Env {
+				if secretEnvVarNames[envVar.Name] {
+					containers[containerIndex].Env[envVarIndex].Value = secrets_provider.RedactedSecretValue
+				}
+			}
+		}
+	}
+	return podSpec
+}
+
 func createDumpPodJob(
 	ctx context.Context,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 	namespaceName string,
 	pod apiv1.Pod,
+	podEvents []apiv1.Event,
 	enclaveOutputDirpath string,
 	resultChan chan dumpPodResult,
 ) func() {
 	return func() {
-		if err := dumpPodInfo(ctx, kubernetesManager, namespaceName, pod, enclaveOutputDirpath); err != nil {
+		if err := dumpPodInfo(ctx, kubernetesManager, namespaceName, pod, podEvents, enclaveOutputDirpath); err != nil {
 			result := dumpPodResult{
 				podName: pod.Name,
 				err:     err,
@@ -879,6 +978,7 @@ func dumpPodInfo(
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 	namespaceName string,
 	pod apiv1.Pod,
+	podEvents []apiv1.Event,
 	enclaveOutputDirpath string,
 ) error {
 	podName := pod.Name
@@ -894,7 +994,7 @@ func dumpPodInfo(
 		)
 	}
 
-	jsonSerializedPodSpecBytes, err := json.MarshalIndent(pod.Spec, enclaveDumpJsonSerializationPrefix, enclaveDumpJsonSerializationIndent)
+	jsonSerializedPodSpecBytes, err := json.MarshalIndent(getPodSpecWithRedactedSecretEnvVars(pod), enclaveDumpJsonSerializationPrefix, enclaveDumpJsonSerializationIndent)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred serializing the spec of pod '%v' to JSON", podName)
 	}
@@ -908,6 +1008,20 @@ func dumpPodInfo(
 		)
 	}
 
+	jsonSerializedPodEventsBytes, err := json.MarshalIndent(podEvents, enclaveDumpJsonSerializationPrefix, enclaveDumpJsonSerializationIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the events of pod '%v' to JSON", podName)
+	}
+	podEventsOutputFilepath := path.Join(podOutputDirpath, podEventsFilename)
+	if err := os.WriteFile(podEventsOutputFilepath, jsonSerializedPodEventsBytes, createdFilePerms); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred writing the events of pod '%v' to file '%v'",
+			podName,
+			podEventsOutputFilepath,
+		)
+	}
+
 	for _, container := range pod.Spec.Containers {
 		containerName := container.Name
 
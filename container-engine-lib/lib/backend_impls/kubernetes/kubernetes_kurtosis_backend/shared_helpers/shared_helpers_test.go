@@ -1,8 +1,13 @@
 package shared_helpers
 
 import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
 )
 
@@ -45,3 +50,49 @@ func TestGetContainerPortsFromPortSpecs(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestGetPodSpecWithRedactedSecretEnvVars(t *testing.T) {
+	serializedArgs := `{"secretsProviderConfig":{"vault":{"token":"hvs.not-a-real-vault-token"}}}`
+	pod := apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				kubernetes_annotation_key_consts.SecretEnvVarsKubernetesAnnotationKey.GetString(): secrets_provider.SerializeSecretEnvVarNames([]string{"API_KEY"}),
+			},
+		},
+		Spec: apiv1.PodSpec{
+			InitContainers: []apiv1.Container{{
+				Env: []apiv1.EnvVar{{Name: "API_KEY", Value: "not-a-real-api-key"}},
+			}},
+			Containers: []apiv1.Container{{
+				Env: []apiv1.EnvVar{
+					{Name: secrets_provider.SerializedArgsEnvVarName, Value: serializedArgs},
+					{Name: "LOG_LEVEL", Value: "debug"},
+				},
+			}},
+		},
+	}
+
+	redactedPodSpec := getPodSpecWithRedactedSecretEnvVars(pod)
+	dumpedPodSpec, err := json.Marshal(redactedPodSpec)
+	require.NoError(t, err)
+	require.NotContains(t, string(dumpedPodSpec), "not-a-real-vault-token")
+	require.NotContains(t, string(dumpedPodSpec), "not-a-real-api-key")
+	require.Contains(t, string(dumpedPodSpec), "debug")
+
+	// The pod itself is left untouched
+	require.Equal(t, serializedArgs, pod.Spec.Containers[0].Env[0].Value)
+}
+
+func TestGetPodSpecWithRedactedSecretEnvVars_RedactsArgsWithoutAnnotation(t *testing.T) {
+	pod := apiv1.Pod{
+		Spec: apiv1.PodSpec{
+			Containers: []apiv1.Container{{
+				Env: []apiv1.EnvVar{{Name: secrets_provider.SerializedArgsEnvVarName, Value: "not-a-real-s3-secret-key"}},
+			}},
+		},
+	}
+
+	dumpedPodSpec, err := json.Marshal(getPodSpecWithRedactedSecretEnvVars(pod))
+	require.NoError(t, err)
+	require.NotContains(t, string(dumpedPodSpec), "not-a-real-s3-secret-key")
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -32,47 +33,67 @@ func DestroyUserServices(
 		)
 	}
 
+	// Services are destroyed concurrently, which matters for enclaves with hundreds of services
+	destroyServiceOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for serviceUuid, serviceObjsAndResources := range allObjectsAndResources {
+		destroyServiceOperations[operation_parallelizer.OperationID(serviceUuid)] = createDestroyUserServiceOperation(
+			ctx,
+			namespaceName,
+			serviceObjsAndResources.KubernetesResources,
+			kubernetesManager)
+	}
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(destroyServiceOperations)
+
 	successfulGuids := map[service.ServiceUUID]bool{}
+	for opID := range successfulOperations {
+		successfulGuids[service.ServiceUUID(opID)] = true
+	}
 	erroredGuids := map[service.ServiceUUID]error{}
-	for serviceUuid, serviceObjsAndResources := range allObjectsAndResources {
-		resources := serviceObjsAndResources.KubernetesResources
+	for opID, err := range failedOperations {
+		erroredGuids[service.ServiceUUID(opID)] = err
+	}
+	return successfulGuids, erroredGuids, nil
+}
 
+func createDestroyUserServiceOperation(
+	ctx context.Context,
+	namespaceName string,
+	resources *shared_helpers.UserServiceKubernetesResources,
+	kubernetesManager *kubernetes_manager.KubernetesManager) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
 		serviceToRemove := resources.Service
 		if serviceToRemove != nil {
 			if err := kubernetesManager.RemoveService(ctx, serviceToRemove); err != nil {
-				erroredGuids[serviceUuid] = stacktrace.Propagate(
+				return nil, stacktrace.Propagate(
 					err,
 					"An error occurred removing Kubernetes service '%v' in namespace '%v'",
 					serviceToRemove.Name,
 					namespaceName,
 				)
-				continue
 			}
 		}
 		podToRemove := resources.Pod
 		if podToRemove != nil {
 			if err := kubernetesManager.RemovePod(ctx, podToRemove); err != nil {
-				erroredGuids[serviceUuid] = stacktrace.Propagate(
+				return nil, stacktrace.Propagate(
 					err,
 					"An error occurred removing Kubernetes pod '%v' in namespace '%v'",
 					podToRemove.Name,
 					namespaceName,
 				)
-				continue
 			}
 		}
 		ingressToRemove := resources.Ingress
 		if ingressToRemove != nil {
 			if err := kubernetesManager.RemoveIngress(ctx, ingressToRemove); err != nil {
-				erroredGuids[serviceUuid] = stacktrace.Propagate(
+				return nil, stacktrace.Propagate(
 					err,
 					"An error occurred removing Kubernetes ingress '%v' in namespace '%v'",
 					ingressToRemove.Name,
 					namespaceName,
 				)
-				continue
 			}
 		}
+		return nil, nil
 	}
-	return successfulGuids, erroredGuids, nil
 }
@@ -0,0 +1,210 @@
+package user_services_functions
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	testEnclaveUuid   = enclave.EnclaveUUID("enclave-uuid")
+	testNamespaceName = "kt-test-enclave"
+
+	podsPathPrefix     = "/api/v1/namespaces/" + testNamespaceName + "/pods"
+	servicesPathPrefix = "/api/v1/namespaces/" + testNamespaceName + "/services"
+	ingressesPath      = "/apis/networking.k8s.io/v1/namespaces/" + testNamespaceName + "/ingresses"
+
+	// How long a delete waits for the deletes of the other services to start, after which they're considered to be
+	// made one after the other
+	concurrentDeletesTimeout = 5 * time.Second
+)
+
+// fakeKubernetesApiServer serves the Kubernetes services and pods of the user services of an enclave, holding every
+// delete until the deletes of all the services were received to tell whether they were made concurrently
+type fakeKubernetesApiServer struct {
+	t *testing.T
+
+	serviceUuids []service.ServiceUUID
+
+	// Names of the objects whose delete is rejected
+	objectNamesFailingDelete map[string]bool
+
+	mutex *sync.Mutex
+
+	deletedObjectNames map[string]bool
+
+	numDeletesInFlight int
+
+	maxNumDeletesInFlight int
+
+	allDeletesInFlight chan struct{}
+}
+
+func newFakeKubernetesApiServer(t *testing.T, serviceUuids []service.ServiceUUID, objectNamesFailingDelete map[string]bool) *fakeKubernetesApiServer {
+	return &fakeKubernetesApiServer{
+		t:                        t,
+		serviceUuids:             serviceUuids,
+		objectNamesFailingDelete: objectNamesFailingDelete,
+		mutex:                    &sync.Mutex{},
+		deletedObjectNames:       map[string]bool{},
+		numDeletesInFlight:       0,
+		maxNumDeletesInFlight:    0,
+		allDeletesInFlight:       make(chan struct{}),
+	}
+}
+
+// start serves the fake API and returns a Kubernetes manager calling it
+func (server *fakeKubernetesApiServer) start() *kubernetes_manager.KubernetesManager {
+	httpServer := httptest.NewServer(http.HandlerFunc(server.handle))
+	server.t.Cleanup(httpServer.Close)
+
+	restConfig := &rest.Config{Host: httpServer.URL}
+	clientSet, err := kubernetes.NewForConfig(restConfig)
+	require.NoError(server.t, err)
+	return kubernetes_manager.NewKubernetesManager(clientSet, restConfig, "", kubernetes_manager.PodSecurityStandard_Privileged)
+}
+
+func (server *fakeKubernetesApiServer) handle(writer http.ResponseWriter, request *http.Request) {
+	switch {
+	case request.Method == http.MethodDelete:
+		server.handleDelete(writer, request)
+	case request.URL.Query().Get("watch") == "true":
+		// The pods being waited on are already gone, so nothing is ever sent on the watches; the headers still go out
+		// right away for the watches to be stopped by closing them
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusOK)
+		writer.(http.Flusher).Flush()
+		<-request.Context().Done()
+	case request.URL.Path == podsPathPrefix && request.URL.Query().Get("fieldSelector") != "":
+		server.writeObject(writer, http.StatusOK, &apiv1.PodList{TypeMeta: metav1.TypeMeta{Kind: "PodList", APIVersion: "v1"}})
+	case request.URL.Path == podsPathPrefix:
+		server.writeObject(writer, http.StatusOK, server.getPods())
+	case request.URL.Path == servicesPathPrefix:
+		server.writeObject(writer, http.StatusOK, server.getServices())
+	case request.URL.Path == ingressesPath:
+		server.writeObject(writer, http.StatusOK, &netv1.IngressList{TypeMeta: metav1.TypeMeta{Kind: "IngressList", APIVersion: "networking.k8s.io/v1"}})
+	default:
+		server.t.Errorf("Unexpected request '%v %v'", request.Method, request.URL)
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (server *fakeKubernetesApiServer) handleDelete(writer http.ResponseWriter, request *http.Request) {
+	objectName := request.URL.Path[strings.LastIndex(request.URL.Path, "/")+1:]
+
+	server.mutex.Lock()
+	server.numDeletesInFlight++
+	if server.numDeletesInFlight > server.maxNumDeletesInFlight {
+		server.maxNumDeletesInFlight = server.numDeletesInFlight
+	}
+	if server.numDeletesInFlight == len(server.serviceUuids) {
+		close(server.allDeletesInFlight)
+	}
+	server.mutex.Unlock()
+
+	select {
+	case <-server.allDeletesInFlight:
+	case <-time.After(concurrentDeletesTimeout):
+	}
+
+	server.mutex.Lock()
+	server.numDeletesInFlight--
+	server.mutex.Unlock()
+
+	if server.objectNamesFailingDelete[objectName] {
+		server.writeObject(writer, http.StatusForbidden, &metav1.Status{
+			TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+			Status:   metav1.StatusFailure,
+			Message:  fmt.Sprintf("Deleting '%v' is forbidden", objectName),
+			Reason:   metav1.StatusReasonForbidden,
+			Code:     http.StatusForbidden,
+		})
+		return
+	}
+
+	server.mutex.Lock()
+	server.deletedObjectNames[objectName] = true
+	server.mutex.Unlock()
+	server.writeObject(writer, http.StatusOK, &metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusSuccess,
+	})
+}
+
+func (server *fakeKubernetesApiServer) getPods() *apiv1.PodList {
+	pods := &apiv1.PodList{TypeMeta: metav1.TypeMeta{Kind: "PodList", APIVersion: "v1"}}
+	for _, serviceUuid := range server.serviceUuids {
+		var pod apiv1.Pod
+		pod.Name = getTestPodName(serviceUuid)
+		pod.Namespace = testNamespaceName
+		pod.Labels = map[string]string{
+			kubernetes_label_key.GUIDKubernetesLabelKey.GetString(): string(serviceUuid),
+		}
+		pod.Spec.Containers = []apiv1.Container{{Name: string(serviceUuid)}}
+		pods.Items = append(pods.Items, pod)
+	}
+	return pods
+}
+
+func (server *fakeKubernetesApiServer) getServices() *apiv1.ServiceList {
+	services := &apiv1.ServiceList{TypeMeta: metav1.TypeMeta{Kind: "ServiceList", APIVersion: "v1"}}
+	for _, serviceUuid := range server.serviceUuids {
+		var kubernetesService apiv1.Service
+		kubernetesService.Name = getTestServiceName(serviceUuid)
+		kubernetesService.Namespace = testNamespaceName
+		kubernetesService.Labels = map[string]string{
+			kubernetes_label_key.GUIDKubernetesLabelKey.GetString(): string(serviceUuid),
+			kubernetes_label_key.IDKubernetesLabelKey.GetString():   string(serviceUuid),
+		}
+		kubernetesService.Spec.ClusterIP = "10.0.0.1"
+		services.Items = append(services.Items, kubernetesService)
+	}
+	return services
+}
+
+func (server *fakeKubernetesApiServer) writeObject(writer http.ResponseWriter, statusCode int, object interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	require.NoError(server.t, json.NewEncoder(writer).Encode(object))
+}
+
+func (server *fakeKubernetesApiServer) getMaxNumDeletesInFlight() int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.maxNumDeletesInFlight
+}
+
+func (server *fakeKubernetesApiServer) isDeleted(objectName string) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.deletedObjectNames[objectName]
+}
+
+func getTestPodName(serviceUuid service.ServiceUUID) string {
+	return "pod-" + string(serviceUuid)
+}
+
+func getTestServiceName(serviceUuid service.ServiceUUID) string {
+	return "service-" + string(serviceUuid)
+}
+
+func getTestApiContainerModeArgs() *shared_helpers.ApiContainerModeArgs {
+	return shared_helpers.NewApiContainerModeArgs(testEnclaveUuid, testNamespaceName, "")
+}
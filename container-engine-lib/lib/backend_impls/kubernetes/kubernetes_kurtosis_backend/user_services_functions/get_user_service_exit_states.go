@@ -0,0 +1,102 @@
+package user_services_functions
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	oomKilledTerminationReason = "OOMKilled"
+)
+
+// userServiceKubernetesState is what gets stored as the backend state of an exited service, as the container status
+// alone doesn't say e.g. that the pod was evicted or that the image couldn't be pulled
+type userServiceKubernetesState struct {
+	ContainerStatus apiv1.ContainerStatus `json:"containerStatus"`
+	PodEvents       []apiv1.Event         `json:"podEvents"`
+}
+
+func GetUserServiceExitStates(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (successfulUserServiceExitStates map[service.ServiceUUID]*service.ExitState, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	serviceObjectsAndResources, err := shared_helpers.GetMatchingUserServiceObjectsAndKubernetesResources(ctx, enclaveId, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Expected to be able to get user services and Kubernetes resources, instead a non nil error was returned")
+	}
+	userServiceExitStates := map[service.ServiceUUID]*service.ExitState{}
+	erredServiceExitStates := map[service.ServiceUUID]error{}
+	// All the pods of an enclave live in the same namespace, so its events are only listed once
+	eventsByNamespace := map[string][]apiv1.Event{}
+	for serviceUuid, serviceObjectAndResource := range serviceObjectsAndResources {
+		servicePod := serviceObjectAndResource.KubernetesResources.Pod
+		if servicePod == nil {
+			continue
+		}
+		containerStatus, terminatedState := getMaybeTerminatedUserServiceContainer(servicePod)
+		if terminatedState == nil {
+			continue
+		}
+
+		namespaceEvents, found := eventsByNamespace[servicePod.Namespace]
+		if !found {
+			eventList, err := kubernetesManager.GetEventsInNamespace(ctx, servicePod.Namespace)
+			if err != nil {
+				erredServiceExitStates[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the events of the namespace of service '%v'", serviceUuid)
+				continue
+			}
+			namespaceEvents = eventList.Items
+			eventsByNamespace[servicePod.Namespace] = namespaceEvents
+		}
+		podEvents := []apiv1.Event{}
+		for _, event := range namespaceEvents {
+			if event.InvolvedObject.Name == servicePod.Name {
+				podEvents = append(podEvents, event)
+			}
+		}
+
+		serializedKubernetesState, err := json.Marshal(userServiceKubernetesState{
+			ContainerStatus: *containerStatus,
+			PodEvents:       podEvents,
+		})
+		if err != nil {
+			erredServiceExitStates[serviceUuid] = stacktrace.Propagate(err, "An error occurred serializing the Kubernetes state of service '%v'", serviceUuid)
+			continue
+		}
+		userServiceExitStates[serviceUuid] = service.NewExitState(
+			terminatedState.ExitCode,
+			terminatedState.Reason == oomKilledTerminationReason,
+			terminatedState.FinishedAt.Time,
+			terminatedState.Reason,
+			serializedKubernetesState,
+		)
+	}
+	return userServiceExitStates, erredServiceExitStates, nil
+}
+
+// getMaybeTerminatedUserServiceContainer returns how the user service container of the pod last terminated, which is
+// its last termination state if Kubernetes already restarted it; the state is nil if the container never terminated
+func getMaybeTerminatedUserServiceContainer(pod *apiv1.Pod) (*apiv1.ContainerStatus, *apiv1.ContainerStateTerminated) {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Name != userServiceContainerName {
+			continue
+		}
+		if containerStatus.State.Terminated != nil {
+			return &containerStatus, containerStatus.State.Terminated
+		}
+		return &containerStatus, containerStatus.LastTerminationState.Terminated
+	}
+	return nil, nil
+}
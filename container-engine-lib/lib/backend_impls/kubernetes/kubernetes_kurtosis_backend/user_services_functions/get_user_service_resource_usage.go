@@ -0,0 +1,90 @@
+package user_services_functions
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	readPeakMemoryUsageSuccessExitCode = 0
+)
+
+func GetUserServiceResourceUsage(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (successfulUserServiceResourceUsage map[service.ServiceUUID]*service.ResourceUsage, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	serviceObjectsAndResources, err := shared_helpers.GetMatchingUserServiceObjectsAndKubernetesResources(ctx, enclaveId, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Expected to be able to get user services and Kubernetes resources, instead a non nil error was returned")
+	}
+	userServiceResourceUsage := map[service.ServiceUUID]*service.ResourceUsage{}
+	erredServiceResourceUsage := map[service.ServiceUUID]error{}
+	for serviceUuid, serviceObjectAndResource := range serviceObjectsAndResources {
+		serviceObj := serviceObjectAndResource.Service
+		servicePod := serviceObjectAndResource.KubernetesResources.Pod
+		if serviceObj == nil || servicePod == nil {
+			erredServiceResourceUsage[serviceUuid] = stacktrace.NewError("Expected to find a pod for Kurtosis service with UUID '%v', instead no pod was found", serviceUuid)
+			continue
+		}
+		if serviceObj.GetContainer().GetStatus() != container.ContainerStatus_Running {
+			erredServiceResourceUsage[serviceUuid] = stacktrace.NewError("Cannot get the resource usage of service '%v' as it isn't running", serviceUuid)
+			continue
+		}
+
+		podMetrics, err := kubernetesManager.GetPodMetrics(ctx, servicePod.Namespace, servicePod.Name)
+		if err != nil {
+			erredServiceResourceUsage[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the metrics of the pod of service '%v'", serviceUuid)
+			continue
+		}
+		var userServiceContainerMetrics *kubernetes_manager.ContainerMetrics
+		for _, containerMetrics := range podMetrics.Containers {
+			if containerMetrics.Name == userServiceContainerName {
+				userServiceContainerMetrics = &containerMetrics
+				break
+			}
+		}
+		if userServiceContainerMetrics == nil {
+			erredServiceResourceUsage[serviceUuid] = stacktrace.NewError("The metrics of pod '%v' of service '%v' don't include container '%v'; metrics-server may not have scraped it yet", servicePod.Name, serviceUuid, userServiceContainerName)
+			continue
+		}
+
+		cpuMilliCores := compute_resources.CpuMilliCores(userServiceContainerMetrics.Usage.Cpu().MilliValue())
+		memoryBytes := uint64(userServiceContainerMetrics.Usage.Memory().Value())
+		maybePeakMemoryBytes := getMaybePeakMemoryBytes(ctx, servicePod.Namespace, servicePod.Name, kubernetesManager)
+		// The metrics API has no network usage
+		userServiceResourceUsage[serviceUuid] = service.NewResourceUsage(cpuMilliCores, memoryBytes, maybePeakMemoryBytes, nil)
+	}
+	return userServiceResourceUsage, erredServiceResourceUsage, nil
+}
+
+// getMaybePeakMemoryBytes reads the peak memory usage from the cgroup of the container as the metrics API only has
+// the current usage; it's best effort as it needs a shell in the container
+func getMaybePeakMemoryBytes(ctx context.Context, namespaceName string, podName string, kubernetesManager *kubernetes_manager.KubernetesManager) *uint64 {
+	readPeakMemoryUsageOutput := &bytes.Buffer{}
+	exitCode, err := kubernetesManager.RunExecCommandWithContext(ctx, namespaceName, podName, userServiceContainerName, service.GetReadPeakMemoryUsageCommand(), readPeakMemoryUsageOutput, io.Discard)
+	if err != nil || exitCode != readPeakMemoryUsageSuccessExitCode {
+		logrus.Debugf("Couldn't read the peak memory usage of pod '%v' from its cgroup; exit code '%v', output '%v', error:\n%v", podName, exitCode, readPeakMemoryUsageOutput.String(), err)
+		return nil
+	}
+	peakMemoryBytes, err := service.ParsePeakMemoryUsage(readPeakMemoryUsageOutput.String())
+	if err != nil {
+		logrus.Debugf("Couldn't parse the peak memory usage of pod '%v':\n%v", podName, err)
+		return nil
+	}
+	return &peakMemoryBytes
+}
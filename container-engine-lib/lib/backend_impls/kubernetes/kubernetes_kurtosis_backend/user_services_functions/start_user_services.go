@@ -19,6 +19,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
@@ -63,6 +64,7 @@ func RegisterUserServices(
 	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
 	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 ) (
 	map[service.ServiceName]*service.ServiceRegistration,
 	map[service.ServiceName]error,
@@ -76,7 +78,7 @@ func RegisterUserServices(
 		return successfulServicesPool, failedServicesPool, nil
 	}
 
-	successfulRegistrations, failedRegistrations, err := registerUserServices(ctx, enclaveUUID, servicesToRegister, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	successfulRegistrations, failedRegistrations, err := registerUserServices(ctx, enclaveUUID, servicesToRegister, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager, objAttrsProvider)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred registering services with Names '%v'", servicesToRegister)
 	}
@@ -122,6 +124,7 @@ func StartRegisteredUserServices(
 	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
 	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 	restartPolicy apiv1.RestartPolicy,
 ) (
 	map[service.ServiceUUID]*service.Service,
@@ -183,6 +186,7 @@ func StartRegisteredUserServices(
 		serviceRegisteredThatCanBeStarted,
 		existingObjectsAndResources,
 		kubernetesManager,
+		objAttrsProvider,
 		restartPolicy)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while trying to start services in parallel.")
@@ -245,12 +249,16 @@ func runStartServiceOperationsInParallel(
 	services map[service.ServiceUUID]*service.ServiceConfig,
 	servicesObjectsAndResources map[service.ServiceUUID]*shared_helpers.UserServiceObjectsAndKubernetesResources,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
 	restartPolicy apiv1.RestartPolicy,
 ) (
 	map[service.ServiceUUID]*service.Service,
 	map[service.ServiceUUID]error,
 	error,
 ) {
+	// The attributes common to the objects of all the services are computed once for the whole batch
+	enclaveObjAttributesProvider := objAttrsProvider.ForEnclave(enclaveUUID)
+
 	startServiceOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
 	for serviceName, config := range services {
 		startServiceOperations[operation_parallelizer.OperationID(serviceName)] = createStartServiceOperation(
@@ -260,6 +268,7 @@ func runStartServiceOperationsInParallel(
 			servicesObjectsAndResources,
 			enclaveUUID,
 			kubernetesManager,
+			enclaveObjAttributesProvider,
 			restartPolicy)
 	}
 
@@ -294,6 +303,7 @@ func createStartServiceOperation(
 	servicesObjectsAndResources map[service.ServiceUUID]*shared_helpers.UserServiceObjectsAndKubernetesResources,
 	enclaveUuid enclave.EnclaveUUID,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
+	enclaveObjAttributesProvider object_attributes_provider.KubernetesEnclaveObjectAttributesProvider,
 	restartPolicy apiv1.RestartPolicy) operation_parallelizer.Operation {
 
 	return func() (interface{}, error) {
@@ -336,9 +346,6 @@ func createStartServiceOperation(
 		serviceRegistrationObj := matchingObjectAndResources.ServiceRegistration
 		serviceName := serviceRegistrationObj.GetName()
 
-		objectAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider()
-		enclaveObjAttributesProvider := objectAttributesProvider.ForEnclave(enclaveUuid)
-
 		var podInitContainers []apiv1.Container
 		var podVolumes []apiv1.Volume
 		var err error
@@ -391,6 +398,9 @@ func createStartServiceOperation(
 		}
 		podLabelsStrs := shared_helpers.GetStringMapFromLabelMap(podAttributes.GetLabels())
 		podAnnotationsStrs := shared_helpers.GetStringMapFromAnnotationMap(podAttributes.GetAnnotations())
+		if secretEnvVars := serviceConfig.GetSecretEnvVars(); len(secretEnvVars) > 0 {
+			podAnnotationsStrs[kubernetes_annotation_key_consts.SecretEnvVarsKubernetesAnnotationKey.GetString()] = secrets_provider.SerializeSecretEnvVarNames(secretEnvVars)
+		}
 
 		podContainers, err := getUserServicePodContainerSpecs(
 			containerImageName,
@@ -408,6 +418,12 @@ func createStartServiceOperation(
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating the container specs for the user service pod with image '%v'", containerImageName)
 		}
+		// The init containers get the resources of the service container so that the pod stays admissible under the
+		// compute quota of the enclave, which requires every container to set the limits it caps. This doesn't change the
+		// resources of the pod, since init containers run before the service container
+		for index := range podInitContainers {
+			podInitContainers[index].Resources = podContainers[0].Resources
+		}
 
 		podName := podAttributes.GetName().GetString()
 		createdPod, err := kubernetesManager.CreatePod(
@@ -790,7 +806,8 @@ func registerUserServices(
 	cliModeArgs *shared_helpers.CliModeArgs,
 	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
 	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
-	kubernetesManager *kubernetes_manager.KubernetesManager) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
 	successfulServicesPool := map[service.ServiceName]*service.ServiceRegistration{}
 	failedServicesPool := map[service.ServiceName]error{}
 
@@ -804,8 +821,7 @@ func registerUserServices(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveUuid)
 	}
 
-	objectAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider()
-	enclaveObjAttributesProvider := objectAttributesProvider.ForEnclave(enclaveUuid)
+	enclaveObjAttributesProvider := objAttrsProvider.ForEnclave(enclaveUuid)
 
 	registerServiceOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
 	for serviceName := range serviceNames {
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -27,38 +28,57 @@ func StopUserServices(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services in enclave '%v' matching filters: %+v", enclaveId, filters)
 	}
 
+	// Services are stopped concurrently, which matters for enclaves with hundreds of services
+	stopServiceOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for serviceUuid, serviceObjsAndResources := range allObjectsAndResources {
+		stopServiceOperations[operation_parallelizer.OperationID(serviceUuid)] = createStopUserServiceOperation(
+			ctx,
+			namespaceName,
+			serviceObjsAndResources.KubernetesResources,
+			kubernetesManager)
+	}
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(stopServiceOperations)
+
 	successfulUuids := map[service.ServiceUUID]bool{}
+	for opID := range successfulOperations {
+		successfulUuids[service.ServiceUUID(opID)] = true
+	}
 	erroredUuids := map[service.ServiceUUID]error{}
-	for serviceUuid, serviceObjsAndResources := range allObjectsAndResources {
-		resources := serviceObjsAndResources.KubernetesResources
+	for opID, err := range failedOperations {
+		erroredUuids[service.ServiceUUID(opID)] = err
+	}
+	return successfulUuids, erroredUuids, nil
+}
 
+func createStopUserServiceOperation(
+	ctx context.Context,
+	namespaceName string,
+	resources *shared_helpers.UserServiceKubernetesResources,
+	kubernetesManager *kubernetes_manager.KubernetesManager) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
 		pod := resources.Pod
 		if pod != nil {
 			if err := kubernetesManager.RemovePod(ctx, pod); err != nil {
-				erroredUuids[serviceUuid] = stacktrace.Propagate(
+				return nil, stacktrace.Propagate(
 					err,
 					"An error occurred removing Kubernetes pod '%v' in namespace '%v'",
 					pod.Name,
 					namespaceName,
 				)
-				continue
 			}
 		}
 
 		ingress := resources.Ingress
 		if ingress != nil {
 			if err := kubernetesManager.RemoveIngress(ctx, ingress); err != nil {
-				erroredUuids[serviceUuid] = stacktrace.Propagate(
+				return nil, stacktrace.Propagate(
 					err,
 					"An error occurred removing Kubernetes ingress '%v' in namespace '%v'",
 					ingress.Name,
 					namespaceName,
 				)
-				continue
 			}
 		}
-
-		successfulUuids[serviceUuid] = true
+		return nil, nil
 	}
-	return successfulUuids, erroredUuids, nil
 }
@@ -0,0 +1,39 @@
+package user_services_functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopUserServices_StopsServicesConcurrently(t *testing.T) {
+	serviceUuids := []service.ServiceUUID{"service-uuid-1", "service-uuid-2", "service-uuid-3"}
+	apiServer := newFakeKubernetesApiServer(t, serviceUuids, map[string]bool{})
+	kubernetesManager := apiServer.start()
+
+	successfulUuids, erroredUuids, err := StopUserServices(context.Background(), testEnclaveUuid, &service.ServiceFilters{}, nil, getTestApiContainerModeArgs(), nil, kubernetesManager)
+	require.NoError(t, err)
+	require.Empty(t, erroredUuids)
+	require.Len(t, successfulUuids, len(serviceUuids))
+	require.Equal(t, len(serviceUuids), apiServer.getMaxNumDeletesInFlight())
+	for _, serviceUuid := range serviceUuids {
+		require.True(t, apiServer.isDeleted(getTestPodName(serviceUuid)))
+		require.False(t, apiServer.isDeleted(getTestServiceName(serviceUuid)))
+	}
+}
+
+func TestStopUserServices_FailedServiceDoesNotFailTheOthers(t *testing.T) {
+	serviceUuids := []service.ServiceUUID{"service-uuid-1", "service-uuid-2", "service-uuid-3"}
+	failingServiceUuid := serviceUuids[1]
+	apiServer := newFakeKubernetesApiServer(t, serviceUuids, map[string]bool{getTestPodName(failingServiceUuid): true})
+	kubernetesManager := apiServer.start()
+
+	successfulUuids, erroredUuids, err := StopUserServices(context.Background(), testEnclaveUuid, &service.ServiceFilters{}, nil, getTestApiContainerModeArgs(), nil, kubernetesManager)
+	require.NoError(t, err)
+	require.Len(t, erroredUuids, 1)
+	require.Contains(t, erroredUuids, failingServiceUuid)
+	require.Equal(t, map[service.ServiceUUID]bool{serviceUuids[0]: true, serviceUuids[2]: true}, successfulUuids)
+	require.False(t, apiServer.isDeleted(getTestPodName(failingServiceUuid)))
+}
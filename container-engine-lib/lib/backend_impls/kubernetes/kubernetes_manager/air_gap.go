@@ -0,0 +1,26 @@
+package kubernetes_manager
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// SetAirGapConfig makes the pods created by this manager pull their images from the air-gapped mirror only
+func (manager *KubernetesManager) SetAirGapConfig(airGapConfig air_gap.AirGapConfig) {
+	manager.airGapConfig = airGapConfig
+}
+
+// useAirGapImageMirror points the containers of the pod to their image on the air-gapped mirror, as the nodes pull the
+// images themselves and can't reach the registries of the images in air-gapped mode
+func (manager *KubernetesManager) useAirGapImageMirror(podSpec *apiv1.PodSpec) {
+	for idx := range podSpec.InitContainers {
+		if mirroredImageRef, found := manager.airGapConfig.GetMirroredImageRef(podSpec.InitContainers[idx].Image); found {
+			podSpec.InitContainers[idx].Image = mirroredImageRef
+		}
+	}
+	for idx := range podSpec.Containers {
+		if mirroredImageRef, found := manager.airGapConfig.GetMirroredImageRef(podSpec.Containers[idx].Image); found {
+			podSpec.Containers[idx].Image = mirroredImageRef
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package kubernetes_manager
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestUseAirGapImageMirror(t *testing.T) {
+	manager := &KubernetesManager{}
+	manager.SetAirGapConfig(air_gap.AirGapConfig{
+		ImageMirror:   "registry.example.com/kurtosis",
+		PackageMirror: "https://git.example.com/kurtosis",
+	})
+	podSpec := apiv1.PodSpec{
+		InitContainers: []apiv1.Container{{Name: testContainerName, Image: "busybox:1.36"}},
+		Containers: []apiv1.Container{
+			{Name: testContainerName, Image: "kurtosistech/core:1.0.0"},
+			{Name: testContainerName, Image: "registry.example.com/team/image:1.0.0"},
+		},
+	}
+	manager.useAirGapImageMirror(&podSpec)
+	require.Equal(t, "registry.example.com/kurtosis/library/busybox:1.36", podSpec.InitContainers[0].Image)
+	require.Equal(t, "registry.example.com/kurtosis/kurtosistech/core:1.0.0", podSpec.Containers[0].Image)
+	// Already on the registry of the mirror
+	require.Equal(t, "registry.example.com/team/image:1.0.0", podSpec.Containers[1].Image)
+}
+
+func TestUseAirGapImageMirror_DisabledLeavesTheImagesUnchanged(t *testing.T) {
+	manager := &KubernetesManager{}
+	manager.SetAirGapConfig(air_gap.NewDisabledAirGapConfig())
+	podSpec := apiv1.PodSpec{
+		Containers: []apiv1.Container{{Name: testContainerName, Image: "nginx"}},
+	}
+	manager.useAirGapImageMirror(&podSpec)
+	require.Equal(t, "nginx", podSpec.Containers[0].Image)
+}
@@ -0,0 +1,49 @@
+package kubernetes_manager
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/backend_errors"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// The ResourceQuota admission plugin rejects the objects going over a quota as forbidden, with this in the message
+	exceededQuotaMessageFragment = "exceeded quota"
+
+	// The scheduler reports the resources that no node has enough of as e.g. "0/3 nodes are available: 3 Insufficient cpu"
+	insufficientResourcesMessageFragment = "Insufficient "
+
+	// The scheduler reports pods waiting for their volumes as e.g. "pod has unbound immediate PersistentVolumeClaims"
+	unboundVolumeClaimsMessageFragment = "persistentvolumeclaim"
+)
+
+// toBackendErrorIfKnownApiError returns the errors of the Kubernetes API caused by a known problem of the cluster as
+// backend errors, so that the user gets told how to fix them, and the other errors as is
+func toBackendErrorIfKnownApiError(err error) error {
+	if !apierrors.IsForbidden(err) {
+		return err
+	}
+	if strings.Contains(err.Error(), exceededQuotaMessageFragment) {
+		return backend_errors.NewBackendError(backend_errors.ErrorKind_QuotaExceeded, "The Kubernetes API rejected the request because it would go over a quota: %v", err)
+	}
+	return backend_errors.NewBackendError(backend_errors.ErrorKind_RBACForbidden, "The Kubernetes API forbade the request: %v", err)
+}
+
+// getUnschedulablePodBackendError returns the backend error explaining why the pod can't be scheduled on any node, if
+// it's a known problem of the cluster
+func getUnschedulablePodBackendError(pod *apiv1.Pod) (*backend_errors.BackendError, bool) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type != apiv1.PodScheduled || condition.Status != apiv1.ConditionFalse || condition.Reason != apiv1.PodReasonUnschedulable {
+			continue
+		}
+		if strings.Contains(condition.Message, insufficientResourcesMessageFragment) {
+			return backend_errors.NewBackendError(backend_errors.ErrorKind_InsufficientResources, "Pod '%v' can't be scheduled on any node: %v", pod.Name, condition.Message), true
+		}
+		if strings.Contains(strings.ToLower(condition.Message), unboundVolumeClaimsMessageFragment) {
+			return backend_errors.NewBackendError(backend_errors.ErrorKind_VolumeProvisioningFailed, "Pod '%v' can't be scheduled because its volumes weren't provisioned: %v", pod.Name, condition.Message), true
+		}
+	}
+	return nil, false
+}
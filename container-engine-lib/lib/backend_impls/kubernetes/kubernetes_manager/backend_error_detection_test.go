@@ -0,0 +1,60 @@
+package kubernetes_manager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/backend_errors"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var testPodsResource = schema.GroupResource{Group: "", Resource: "pods"}
+
+func TestToBackendErrorIfKnownApiError_QuotaExceeded(t *testing.T) {
+	err := apierrors.NewForbidden(testPodsResource, testPodName, errors.New("exceeded quota: enclave-quota, requested: limits.cpu=2, used: limits.cpu=3, limited: limits.cpu=4"))
+	requireBackendErrorKind(t, backend_errors.ErrorKind_QuotaExceeded, toBackendErrorIfKnownApiError(err))
+}
+
+func TestToBackendErrorIfKnownApiError_RBACForbidden(t *testing.T) {
+	err := apierrors.NewForbidden(testPodsResource, testPodName, errors.New("User \"kurtosis\" cannot create resource \"pods\" in API group \"\""))
+	requireBackendErrorKind(t, backend_errors.ErrorKind_RBACForbidden, toBackendErrorIfKnownApiError(err))
+}
+
+func TestToBackendErrorIfKnownApiError_OtherErrorsAreUnchanged(t *testing.T) {
+	err := apierrors.NewAlreadyExists(testPodsResource, testPodName)
+	require.Equal(t, err, toBackendErrorIfKnownApiError(err))
+}
+
+func TestGetUnschedulablePodBackendError(t *testing.T) {
+	pod := newTestPod(false)
+	_, isUnschedulable := getUnschedulablePodBackendError(pod)
+	require.False(t, isUnschedulable)
+
+	pod.Status.Conditions = []apiv1.PodCondition{newUnschedulableCondition("0/3 nodes are available: 3 Insufficient memory.")}
+	backendErr, isUnschedulable := getUnschedulablePodBackendError(pod)
+	require.True(t, isUnschedulable)
+	require.Equal(t, backend_errors.ErrorKind_InsufficientResources, backendErr.GetKind())
+
+	pod.Status.Conditions = []apiv1.PodCondition{newUnschedulableCondition("0/3 nodes are available: pod has unbound immediate PersistentVolumeClaims.")}
+	backendErr, isUnschedulable = getUnschedulablePodBackendError(pod)
+	require.True(t, isUnschedulable)
+	require.Equal(t, backend_errors.ErrorKind_VolumeProvisioningFailed, backendErr.GetKind())
+}
+
+func requireBackendErrorKind(t *testing.T, expectedKind backend_errors.ErrorKind, err error) {
+	backendErr, isBackendErr := backend_errors.GetBackendError(err)
+	require.True(t, isBackendErr)
+	require.Equal(t, expectedKind, backendErr.GetKind())
+}
+
+func newUnschedulableCondition(message string) apiv1.PodCondition {
+	condition := new(apiv1.PodCondition)
+	condition.Type = apiv1.PodScheduled
+	condition.Status = apiv1.ConditionFalse
+	condition.Reason = apiv1.PodReasonUnschedulable
+	condition.Message = message
+	return *condition
+}
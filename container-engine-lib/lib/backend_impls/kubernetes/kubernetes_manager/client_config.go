@@ -0,0 +1,181 @@
+package kubernetes_manager
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// Zero QPS and burst keep the defaults of client-go
+	defaultClientQPS        float32 = 0
+	defaultClientBurst              = 0
+	defaultClientMaxRetries         = 5
+
+	clientRetryInitialBackoff = 500 * time.Millisecond
+	clientRetryMaxBackoff     = 30 * time.Second
+	clientRetryBackoffFactor  = 2
+
+	retryAfterHeaderName = "Retry-After"
+)
+
+// ClientConfig holds the settings of the client the KubernetesManager uses to talk to the Kubernetes API server
+type ClientConfig struct {
+	// The sustained queries per second and the burst allowed by the client-side rate limiter; zero keeps the client-go defaults
+	QPS   float32
+	Burst int
+
+	// How many times a request gets retried, with exponential backoff, when the API server throttles it (429) or rejects
+	// it because of a conflict (409); zero disables the retries
+	MaxRetries int
+}
+
+func NewClientConfig(qps float32, burst int, maxRetries int) ClientConfig {
+	return ClientConfig{
+		QPS:        qps,
+		Burst:      burst,
+		MaxRetries: maxRetries,
+	}
+}
+
+func DefaultClientConfig() ClientConfig {
+	return NewClientConfig(defaultClientQPS, defaultClientBurst, defaultClientMaxRetries)
+}
+
+func (config ClientConfig) Validate() error {
+	if config.QPS < 0 {
+		return stacktrace.NewError("The Kubernetes client QPS can't be negative but was '%v'", config.QPS)
+	}
+	if config.Burst < 0 {
+		return stacktrace.NewError("The Kubernetes client burst can't be negative but was '%v'", config.Burst)
+	}
+	if config.MaxRetries < 0 {
+		return stacktrace.NewError("The Kubernetes client max retries can't be negative but was '%v'", config.MaxRetries)
+	}
+	return nil
+}
+
+// ApplyToRestConfig sets the rate limits on the REST config and wraps its transport so that every request sent with it,
+// whichever KubernetesManager operation it comes from, gets the same retry policy
+func (config ClientConfig) ApplyToRestConfig(restConfig *rest.Config) {
+	if config.QPS > 0 {
+		restConfig.QPS = config.QPS
+	}
+	if config.Burst > 0 {
+		restConfig.Burst = config.Burst
+	}
+	if config.MaxRetries > 0 {
+		maxRetries := config.MaxRetries
+		restConfig.Wrap(func(delegate http.RoundTripper) http.RoundTripper {
+			return newRetryingRoundTripper(delegate, maxRetries, clientRetryInitialBackoff, clientRetryMaxBackoff)
+		})
+	}
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+type retryingRoundTripper struct {
+	delegate http.RoundTripper
+
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+func newRetryingRoundTripper(delegate http.RoundTripper, maxRetries int, initialBackoff time.Duration, maxBackoff time.Duration) *retryingRoundTripper {
+	return &retryingRoundTripper{
+		delegate:       delegate,
+		maxRetries:     maxRetries,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+	}
+}
+
+func (roundTripper *retryingRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	// The body of the request is consumed by the first attempt so the ones that can't be rebuilt are sent only once
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return roundTripper.delegate.RoundTrip(request)
+	}
+
+	backoff := roundTripper.initialBackoff
+	currentRequest := request
+	for retryCount := 0; ; retryCount++ {
+		response, err := roundTripper.delegate.RoundTrip(currentRequest)
+		if err != nil || retryCount >= roundTripper.maxRetries || !isRetriableResponse(response) {
+			return response, err
+		}
+
+		delay := getRetryDelay(response, backoff)
+		logrus.Debugf(
+			"Kubernetes API server responded to '%v %v' with status code '%v'; retrying in '%v' (retry %v of %v)",
+			request.Method,
+			request.URL.Path,
+			response.StatusCode,
+			delay,
+			retryCount+1,
+			roundTripper.maxRetries,
+		)
+		_, _ = io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-request.Context().Done():
+			timer.Stop()
+			return nil, stacktrace.Propagate(request.Context().Err(), "The context of the '%v %v' request was done while waiting to retry it", request.Method, request.URL.Path)
+		case <-timer.C:
+		}
+
+		currentRequest = request.Clone(request.Context())
+		if request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred rebuilding the body of the '%v %v' request to retry it", request.Method, request.URL.Path)
+			}
+			currentRequest.Body = body
+		}
+		backoff = min(backoff*clientRetryBackoffFactor, roundTripper.maxBackoff)
+	}
+}
+
+// isRetriableResponse tells apart the throttled requests and the genuine conflicts, which can go through once the object
+// settles, from the other 409s like AlreadyExists that will fail the same way every time
+func isRetriableResponse(response *http.Response) bool {
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusConflict:
+		responseBody, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(responseBody))
+		if err != nil {
+			return false
+		}
+		var status metav1.Status
+		if err := json.Unmarshal(responseBody, &status); err != nil {
+			return false
+		}
+		return status.Reason == metav1.StatusReasonConflict
+	default:
+		return false
+	}
+}
+
+func getRetryDelay(response *http.Response, backoff time.Duration) time.Duration {
+	retryAfterSeconds, err := strconv.Atoi(response.Header.Get(retryAfterHeaderName))
+	if err != nil || retryAfterSeconds <= 0 {
+		return backoff
+	}
+	return time.Duration(retryAfterSeconds) * time.Second
+}
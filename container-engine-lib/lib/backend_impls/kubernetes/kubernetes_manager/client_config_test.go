@@ -0,0 +1,111 @@
+package kubernetes_manager
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	testMaxRetries     = 3
+	testInitialBackoff = time.Millisecond
+	testMaxBackoff     = 5 * time.Millisecond
+
+	testRequestBody = `{"kind":"Pod"}`
+)
+
+func TestRetryingRoundTripper_RetriesThrottledRequests(t *testing.T) {
+	var requestsCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		require.Equal(t, testRequestBody, string(body))
+		if requestsCount.Add(1) < testMaxRetries {
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writer.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	response := sendTestRequest(t, server.URL)
+	require.Equal(t, http.StatusCreated, response.StatusCode)
+	require.Equal(t, int32(testMaxRetries), requestsCount.Load())
+}
+
+func TestRetryingRoundTripper_GivesUpAfterMaxRetries(t *testing.T) {
+	var requestsCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestsCount.Add(1)
+		writeTestStatus(t, writer, http.StatusConflict, metav1.StatusReasonConflict)
+	}))
+	defer server.Close()
+
+	response := sendTestRequest(t, server.URL)
+	require.Equal(t, http.StatusConflict, response.StatusCode)
+	require.Equal(t, int32(testMaxRetries+1), requestsCount.Load())
+}
+
+func TestRetryingRoundTripper_DoesNotRetryAlreadyExists(t *testing.T) {
+	var requestsCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestsCount.Add(1)
+		writeTestStatus(t, writer, http.StatusConflict, metav1.StatusReasonAlreadyExists)
+	}))
+	defer server.Close()
+
+	response := sendTestRequest(t, server.URL)
+	require.Equal(t, http.StatusConflict, response.StatusCode)
+	require.Equal(t, int32(1), requestsCount.Load())
+
+	// The body was read to check the reason, so it must still be there for client-go to build the error from
+	var status metav1.Status
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&status))
+	require.Equal(t, metav1.StatusReasonAlreadyExists, status.Reason)
+}
+
+func TestClientConfig_ApplyToRestConfig(t *testing.T) {
+	restConfig := &rest.Config{}
+	NewClientConfig(50, 100, 0).ApplyToRestConfig(restConfig)
+	require.Equal(t, float32(50), restConfig.QPS)
+	require.Equal(t, 100, restConfig.Burst)
+	require.Nil(t, restConfig.WrapTransport)
+
+	DefaultClientConfig().ApplyToRestConfig(restConfig)
+	require.Equal(t, float32(50), restConfig.QPS)
+	require.NotNil(t, restConfig.WrapTransport)
+}
+
+func TestClientConfig_ValidateRejectsNegativeValues(t *testing.T) {
+	require.NoError(t, DefaultClientConfig().Validate())
+	require.Error(t, NewClientConfig(-1, 0, 0).Validate())
+	require.Error(t, NewClientConfig(0, -1, 0).Validate())
+	require.Error(t, NewClientConfig(0, 0, -1).Validate())
+}
+
+func sendTestRequest(t *testing.T, url string) *http.Response {
+	request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(testRequestBody))
+	require.NoError(t, err)
+	roundTripper := newRetryingRoundTripper(http.DefaultTransport, testMaxRetries, testInitialBackoff, testMaxBackoff)
+	response, err := roundTripper.RoundTrip(request)
+	require.NoError(t, err)
+	t.Cleanup(func() { response.Body.Close() })
+	return response
+}
+
+func writeTestStatus(t *testing.T, writer http.ResponseWriter, statusCode int, reason metav1.StatusReason) {
+	writer.WriteHeader(statusCode)
+	var status metav1.Status
+	status.Code = int32(statusCode)
+	status.Reason = reason
+	require.NoError(t, json.NewEncoder(writer).Encode(status))
+}
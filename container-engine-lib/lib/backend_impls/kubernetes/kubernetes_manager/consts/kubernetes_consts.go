@@ -28,6 +28,10 @@ const (
 	DaemonSetsKubernetesResource             = "daemonsets"
 	DeploymentsKubernetesResource            = "deployments"
 	DeploymentsScaleKubernetesResource       = "deployments/scale"
+	LeasesKubernetesResource                 = "leases"
+	ResourceQuotasKubernetesResource         = "resourcequotas"
+	NetworkPoliciesKubernetesResource        = "networkpolicies"
+	SecretsKubernetesResource                = "secrets"
 
 	ClusterRoleKubernetesResourceType = "ClusterRole"
 	RoleKubernetesResourceType        = "Role"
@@ -24,8 +24,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/concurrent_writer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	v1 "k8s.io/api/apps/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/backend_errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/channel_writer"
 	"github.com/kurtosis-tech/stacktrace"
@@ -42,16 +44,14 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/remotecommand"
 )
 
 const (
-	podWaitForAvailabilityTimeout          = 15 * time.Minute
-	podWaitForAvailabilityTimeBetweenPolls = 500 * time.Millisecond
-	podWaitForDeletionTimeout              = 5 * time.Minute
-	podWaitForDeletionTimeBetweenPolls     = 500 * time.Millisecond
-	podWaitForTerminationTimeout           = 5 * time.Minute
-	podWaitForTerminationTimeBetweenPolls  = 500 * time.Millisecond
+	podWaitForAvailabilityTimeout = 15 * time.Minute
+	podWaitForDeletionTimeout     = 5 * time.Minute
+	podWaitForTerminationTimeout  = 5 * time.Minute
 
 	// This is a container "reason" (machine-readable string) indicating that the container has some issue with
 	// pulling the image (usually, a typo in the image name or the image doesn't exist)
@@ -81,17 +81,6 @@ const (
 	expectedStatusMessageSliceSize       = 6
 )
 
-// We'll try to use the nicer-to-use shells first before we drop down to the lower shells
-var commandToRunWhenCreatingUserServiceShell = []string{
-	"sh",
-	"-c",
-	`if command -v 'bash' > /dev/null; then
-		echo "Found bash on container; creating bash shell..."; bash; 
-       else 
-		echo "No bash found on container; dropping down to sh shell..."; sh; 
-	fi`,
-}
-
 var (
 	globalDeletePolicy  = metav1.DeletePropagationForeground
 	globalDeleteOptions = metav1.DeleteOptions{
@@ -148,18 +137,31 @@ type KubernetesManager struct {
 	kuberneteRestConfig *rest.Config
 	// The storage class name as specified in the `kurtosis-config.yaml`
 	storageClass string
+
+	// The Pod Security Standard the pods created by this manager comply with
+	podSecurityStandard PodSecurityStandard
+
+	// The mirror the images of the pods are pulled from instead of their registry in air-gapped mode
+	airGapConfig air_gap.AirGapConfig
 }
 
 func int64Ptr(i int64) *int64 { return &i }
 
-func NewKubernetesManager(kubernetesClientSet *kubernetes.Clientset, kuberneteRestConfig *rest.Config, storageClass string) *KubernetesManager {
+func NewKubernetesManager(kubernetesClientSet *kubernetes.Clientset, kuberneteRestConfig *rest.Config, storageClass string, podSecurityStandard PodSecurityStandard) *KubernetesManager {
 	return &KubernetesManager{
 		kubernetesClientSet: kubernetesClientSet,
 		kuberneteRestConfig: kuberneteRestConfig,
 		storageClass:        storageClass,
+		podSecurityStandard: podSecurityStandard,
+		airGapConfig:        air_gap.NewDisabledAirGapConfig(),
 	}
 }
 
+// GetPodSecurityStandard returns the Pod Security Standard the pods created by this manager comply with
+func (manager *KubernetesManager) GetPodSecurityStandard() PodSecurityStandard {
+	return manager.podSecurityStandard
+}
+
 // ---------------------------Services------------------------------------------------------------------------------
 
 // CreateService creates a k8s service in the specified namespace. It connects pods to the service according to the pod labels passed in
@@ -226,7 +228,7 @@ func (manager *KubernetesManager) CreateService(ctx context.Context, namespace s
 		},
 	}
 
-	serviceResult, err := servicesClient.Create(ctx, service, globalCreateOptions)
+	serviceResult, err := applyObject(ctx, servicesClient.Patch, service, apiv1.SchemeGroupVersion.WithKind("Service"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create service '%s' in namespace '%s'", name, namespace)
 	}
@@ -423,7 +425,7 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 		},
 	}
 
-	volumeClaim, err := volumeClaimsClient.Create(ctx, &volumeClaimsDefinition, globalCreateOptions)
+	volumeClaim, err := applyObject(ctx, volumeClaimsClient.Patch, &volumeClaimsDefinition, apiv1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create volume claim '%s'", volumeClaimName)
 	}
@@ -490,7 +492,7 @@ func (manager *KubernetesManager) CreateNamespace(
 			},
 			DeletionTimestamp:          nil,
 			DeletionGracePeriodSeconds: nil,
-			Labels:                     namespaceLabels,
+			Labels:                     manager.podSecurityStandard.getNamespaceLabels(namespaceLabels),
 			Annotations:                namespaceAnnotations,
 			OwnerReferences:            nil,
 			Finalizers:                 nil,
@@ -505,7 +507,7 @@ func (manager *KubernetesManager) CreateNamespace(
 		},
 	}
 
-	namespaceResult, err := namespaceClient.Create(ctx, namespace, globalCreateOptions)
+	namespaceResult, err := applyObject(ctx, namespaceClient.Patch, namespace, apiv1.SchemeGroupVersion.WithKind("Namespace"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create namespace with name '%s'", name)
 	}
@@ -636,7 +638,7 @@ func (manager *KubernetesManager) CreateServiceAccount(ctx context.Context, name
 		AutomountServiceAccountToken: nil,
 	}
 
-	serviceAccountResult, err := client.Create(ctx, serviceAccount, globalCreateOptions)
+	serviceAccountResult, err := applyObject(ctx, client.Patch, serviceAccount, apiv1.SchemeGroupVersion.WithKind("ServiceAccount"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create service account with name '%s' in namespace '%v'", name, namespace)
 	}
@@ -723,7 +725,7 @@ func (manager *KubernetesManager) CreateRole(ctx context.Context, name string, n
 		Rules: rules,
 	}
 
-	roleResult, err := client.Create(ctx, role, globalCreateOptions)
+	roleResult, err := applyObject(ctx, client.Patch, role, rbacv1.SchemeGroupVersion.WithKind("Role"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create role with name '%s' in namespace '%v' and rules '%+v'", name, namespace, rules)
 	}
@@ -812,7 +814,7 @@ func (manager *KubernetesManager) CreateRoleBindings(ctx context.Context, name s
 		RoleRef:  roleRef,
 	}
 
-	roleBindingResult, err := client.Create(ctx, roleBinding, globalCreateOptions)
+	roleBindingResult, err := applyObject(ctx, client.Patch, roleBinding, rbacv1.SchemeGroupVersion.WithKind("RoleBinding"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create role binding with name '%s', subjects '%+v' and role ref '%v'", name, subjects, roleRef)
 	}
@@ -901,7 +903,7 @@ func (manager *KubernetesManager) CreateClusterRoles(ctx context.Context, name s
 		AggregationRule: nil,
 	}
 
-	clusterRoleResult, err := client.Create(ctx, clusterRole, globalCreateOptions)
+	clusterRoleResult, err := applyObject(ctx, client.Patch, clusterRole, rbacv1.SchemeGroupVersion.WithKind("ClusterRole"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create cluster role with name '%s' with rules '%+v'", name, rules)
 	}
@@ -989,7 +991,7 @@ func (manager *KubernetesManager) CreateClusterRoleBindings(ctx context.Context,
 		RoleRef:  roleRef,
 	}
 
-	clusterRoleBindingResult, err := client.Create(ctx, clusterRoleBinding, globalCreateOptions)
+	clusterRoleBindingResult, err := applyObject(ctx, client.Patch, clusterRoleBinding, rbacv1.SchemeGroupVersion.WithKind("ClusterRoleBinding"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create cluster role binding with name '%s', subjects '%+v' and role ref '%v'", name, subjects, roleRef)
 	}
@@ -1128,6 +1130,11 @@ func (manager *KubernetesManager) CreatePod(
 		ResourceClaims:            nil,
 	}
 
+	if err := manager.podSecurityStandard.applyToPodSpec(&podSpec); err != nil {
+		return nil, stacktrace.Propagate(err, "Pod '%v' can't be created under the '%v' Pod Security Standard", podName, manager.podSecurityStandard)
+	}
+	manager.useAirGapImageMirror(&podSpec)
+
 	podToCreate := &apiv1.Pod{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "",
@@ -1164,9 +1171,11 @@ func (manager *KubernetesManager) CreatePod(
 		return nil, stacktrace.Propagate(err, "An error occurred waiting for pod '%v' to be completely removed", podName)
 	}
 
+	// Pods aren't applied like the other objects because most of their spec can't be changed once they're created, so
+	// there's nothing to reconcile an existing pod with
 	createdPod, err := podClient.Create(ctx, podToCreate, globalCreateOptions)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Expected to be able to create pod with name '%v' and labels '%+v', instead a non-nil error was returned", podName, podLabels)
+		return nil, stacktrace.Propagate(toBackendErrorIfKnownApiError(err), "Expected to be able to create pod with name '%v' and labels '%+v', instead a non-nil error was returned", podName, podLabels)
 	}
 
 	if err := manager.waitForPodAvailability(ctx, namespaceName, podName); err != nil {
@@ -1328,6 +1337,11 @@ func (manager *KubernetesManager) CreateDaemonSet(
 		RevisionHistoryLimit: nil,
 	}
 
+	if err := manager.podSecurityStandard.applyToPodSpec(&daemonSetSpec.Template.Spec); err != nil {
+		return nil, stacktrace.Propagate(err, "Daemon set '%v' can't be created under the '%v' Pod Security Standard", daemonSetName, manager.podSecurityStandard)
+	}
+	manager.useAirGapImageMirror(&daemonSetSpec.Template.Spec)
+
 	daemonSetToCreate := &v1.DaemonSet{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "",
@@ -1353,7 +1367,7 @@ func (manager *KubernetesManager) CreateDaemonSet(
 		logrus.Debugf("Going to start daemon set using the following JSON: %v", string(daemonSetDefinitionBytes))
 	}
 
-	createdDaemonSet, err := daemonSetClient.Create(ctx, daemonSetToCreate, globalCreateOptions)
+	createdDaemonSet, err := applyObject(ctx, daemonSetClient.Patch, daemonSetToCreate, v1.SchemeGroupVersion.WithKind("DaemonSet"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating daemon set.")
 	}
@@ -1474,6 +1488,9 @@ func (manager *KubernetesManager) CreateDeployment(
 	containers []apiv1.Container,
 	volumes []apiv1.Volume,
 	affinity *apiv1.Affinity,
+	numReplicas int32,
+	serviceAccountName string,
+	nodeSelectors map[string]string,
 ) (*v1.Deployment, error) {
 	deploymentClient := manager.kubernetesClientSet.AppsV1().Deployments(namespaceName)
 
@@ -1497,7 +1514,6 @@ func (manager *KubernetesManager) CreateDeployment(
 		ManagedFields:              nil,
 	}
 
-	numReplicas := int32(1)
 	deploymentSpec := v1.DeploymentSpec{
 		Replicas: &numReplicas,
 		Strategy: v1.DeploymentStrategy{
@@ -1522,8 +1538,8 @@ func (manager *KubernetesManager) CreateDeployment(
 				TerminationGracePeriodSeconds: nil,
 				ActiveDeadlineSeconds:         nil,
 				DNSPolicy:                     "",
-				NodeSelector:                  nil,
-				ServiceAccountName:            "",
+				NodeSelector:                  nodeSelectors,
+				ServiceAccountName:            serviceAccountName,
 				DeprecatedServiceAccount:      "",
 				AutomountServiceAccountToken:  nil,
 				NodeName:                      "",
@@ -1558,6 +1574,11 @@ func (manager *KubernetesManager) CreateDeployment(
 		RevisionHistoryLimit: nil,
 	}
 
+	if err := manager.podSecurityStandard.applyToPodSpec(&deploymentSpec.Template.Spec); err != nil {
+		return nil, stacktrace.Propagate(err, "Deployment '%v' can't be created under the '%v' Pod Security Standard", deploymentName, manager.podSecurityStandard)
+	}
+	manager.useAirGapImageMirror(&deploymentSpec.Template.Spec)
+
 	deploymentToCreate := &v1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "",
@@ -1583,7 +1604,7 @@ func (manager *KubernetesManager) CreateDeployment(
 		logrus.Debugf("Going to start deployment using the following JSON: %v", string(deploymentDefinitionBytes))
 	}
 
-	createdDeployment, err := deploymentClient.Create(ctx, deploymentToCreate, globalCreateOptions)
+	createdDeployment, err := applyObject(ctx, deploymentClient.Patch, deploymentToCreate, v1.SchemeGroupVersion.WithKind("Deployment"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating deployment.")
 	}
@@ -1591,34 +1612,30 @@ func (manager *KubernetesManager) CreateDeployment(
 	return createdDeployment, nil
 }
 
-func (manager *KubernetesManager) WaitForPodManagedByDeployment(ctx context.Context, deployment *v1.Deployment, maxRetries int, retryInterval time.Duration) error {
-	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(maxRetries)*retryInterval)
-	defer cancel()
+// WaitForPodManagedByDeployment watches the pods of the deployment until the first container of one of them is ready
+func (manager *KubernetesManager) WaitForPodManagedByDeployment(ctx context.Context, deployment *v1.Deployment, timeout time.Duration) error {
+	listerWatcher := manager.newPodsListerWatcher(deployment.Namespace, noFieldSelector, metav1.FormatLabelSelector(deployment.Spec.Selector))
+	isReady, err := waitForReadyPod(ctx, listerWatcher, timeout)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred watching the pods managed by deployment '%v'", deployment.Name)
+	}
+	if !isReady {
+		return stacktrace.NewError("Timeout waiting for a pod managed by deployment '%s' to come online after %v", deployment.Name, timeout)
+	}
+	return nil
+}
 
-	ticker := time.NewTicker(retryInterval)
-	defer ticker.Stop()
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		select {
-		case <-timeoutCtx.Done():
-			return stacktrace.NewError(
-				"Timeout waiting for a pod managed by deployment '%s' to come online",
-				deployment.Name,
-			)
-		case <-ticker.C:
-			pods, err := manager.GetPodsManagedByDeployment(ctx, deployment)
-			if err != nil {
-				return stacktrace.Propagate(err, "An error occurred getting pods managed by deployment'%v'", deployment.Name)
-			}
-			if len(pods) > 0 && len(pods[0].Status.ContainerStatuses) > 0 && pods[0].Status.ContainerStatuses[0].Ready {
-				// found a pod with a running container
-				return nil
-			}
-		}
+// WaitForPodManagedByDaemonSet watches the pods of the daemon set until the first container of one of them is ready
+func (manager *KubernetesManager) WaitForPodManagedByDaemonSet(ctx context.Context, daemonSet *v1.DaemonSet, timeout time.Duration) error {
+	listerWatcher := manager.newPodsListerWatcher(daemonSet.Namespace, noFieldSelector, metav1.FormatLabelSelector(daemonSet.Spec.Selector))
+	isReady, err := waitForReadyPod(ctx, listerWatcher, timeout)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred watching the pods managed by daemon set '%v'", daemonSet.Name)
 	}
-	return stacktrace.NewError(
-		"Exceeded max retries (%d) waiting for a pod managed by deployment '%s' to come online",
-		maxRetries, deployment.Name,
-	)
+	if !isReady {
+		return stacktrace.NewError("Timeout waiting for a pod managed by daemon set '%s' to come online after %v", daemonSet.Name, timeout)
+	}
+	return nil
 }
 
 func (manager *KubernetesManager) ScaleDeployment(ctx context.Context, namespace, name string, replicas int32) error {
@@ -1711,6 +1728,28 @@ func (manager *KubernetesManager) GetConfigMap(ctx context.Context, namespace st
 	return configMap, nil
 }
 
+// UpdateConfigMapData replaces the data of the config map, failing if it was modified since it was retrieved
+func (manager *KubernetesManager) UpdateConfigMapData(ctx context.Context, configMap *apiv1.ConfigMap, data map[string]string) (*apiv1.ConfigMap, error) {
+	client := manager.kubernetesClientSet.CoreV1().ConfigMaps(configMap.Namespace)
+
+	configMapToUpdate := configMap.DeepCopy()
+	configMapToUpdate.Data = data
+	updatedConfigMap, err := client.Update(ctx, configMapToUpdate, metav1.UpdateOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		DryRun:          nil,
+		FieldManager:    "",
+		FieldValidation: "",
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the data of config map '%v' in namespace '%v'", configMap.Name, configMap.Namespace)
+	}
+
+	return updatedConfigMap, nil
+}
+
 func (manager *KubernetesManager) CreateConfigMap(
 	ctx context.Context,
 	namespaceName string,
@@ -1750,7 +1789,7 @@ func (manager *KubernetesManager) CreateConfigMap(
 		BinaryData: nil,
 	}
 
-	createdConfigMap, err := client.Create(ctx, configMapToCreate, globalCreateOptions)
+	createdConfigMap, err := applyObject(ctx, client.Patch, configMapToCreate, apiv1.SchemeGroupVersion.WithKind("ConfigMap"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating config map.")
 	}
@@ -1758,6 +1797,174 @@ func (manager *KubernetesManager) CreateConfigMap(
 	return createdConfigMap, nil
 }
 
+// ---------------------------secrets---------------------------------------------------------------------------------------
+func (manager *KubernetesManager) GetSecret(ctx context.Context, namespace string, name string) (*apiv1.Secret, error) {
+	client := manager.kubernetesClientSet.CoreV1().Secrets(namespace)
+
+	secret, err := client.Get(ctx, name, metav1.GetOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ResourceVersion: "",
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get secret with name '%s' in namespace '%s'", name, namespace)
+	}
+
+	return secret, nil
+}
+
+func (manager *KubernetesManager) CreateSecret(
+	ctx context.Context,
+	namespaceName string,
+	secretName string,
+	labels map[string]string,
+	data map[string][]byte,
+) (*apiv1.Secret, error) {
+	client := manager.kubernetesClientSet.CoreV1().Secrets(namespaceName)
+
+	secretToCreate := &apiv1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            secretName,
+			GenerateName:    "",
+			Namespace:       namespaceName,
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Immutable:  nil,
+		Data:       data,
+		StringData: nil,
+		Type:       apiv1.SecretTypeOpaque,
+	}
+
+	createdSecret, err := applyObject(ctx, client.Patch, secretToCreate, apiv1.SchemeGroupVersion.WithKind("Secret"))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating secret '%s' in namespace '%s'", secretName, namespaceName)
+	}
+
+	return createdSecret, nil
+}
+
+// ---------------------------resource quotas---------------------------------------------------------------------------------------
+func (manager *KubernetesManager) CreateResourceQuota(
+	ctx context.Context,
+	namespaceName string,
+	resourceQuotaName string,
+	labels map[string]string,
+	hard apiv1.ResourceList,
+	scopes []apiv1.ResourceQuotaScope,
+) (*apiv1.ResourceQuota, error) {
+	client := manager.kubernetesClientSet.CoreV1().ResourceQuotas(namespaceName)
+
+	resourceQuotaToCreate := &apiv1.ResourceQuota{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            resourceQuotaName,
+			GenerateName:    "",
+			Namespace:       namespaceName,
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Spec: apiv1.ResourceQuotaSpec{
+			Hard:          hard,
+			Scopes:        scopes,
+			ScopeSelector: nil,
+		},
+		Status: apiv1.ResourceQuotaStatus{
+			Hard: nil,
+			Used: nil,
+		},
+	}
+
+	createdResourceQuota, err := applyObject(ctx, client.Patch, resourceQuotaToCreate, apiv1.SchemeGroupVersion.WithKind("ResourceQuota"))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating resource quota '%s' in namespace '%s'", resourceQuotaName, namespaceName)
+	}
+
+	return createdResourceQuota, nil
+}
+
+// ---------------------------network policies---------------------------------------------------------------------------------------
+
+func (manager *KubernetesManager) CreateNetworkPolicy(
+	ctx context.Context,
+	namespaceName string,
+	networkPolicyName string,
+	labels map[string]string,
+	spec netv1.NetworkPolicySpec,
+) (*netv1.NetworkPolicy, error) {
+	client := manager.kubernetesClientSet.NetworkingV1().NetworkPolicies(namespaceName)
+
+	networkPolicyToCreate := &netv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            networkPolicyName,
+			GenerateName:    "",
+			Namespace:       namespaceName,
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Spec: spec,
+		Status: netv1.NetworkPolicyStatus{
+			Conditions: nil,
+		},
+	}
+
+	createdNetworkPolicy, err := applyObject(ctx, client.Patch, networkPolicyToCreate, netv1.SchemeGroupVersion.WithKind("NetworkPolicy"))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating network policy '%s' in namespace '%s'", networkPolicyName, namespaceName)
+	}
+
+	return createdNetworkPolicy, nil
+}
+
 func (kubernetesManager *KubernetesManager) GetVolumeSourceForHostPath(mountPath string) apiv1.VolumeSource {
 	return apiv1.VolumeSource{
 		HostPath: &apiv1.HostPathVolumeSource{
@@ -1795,6 +2002,43 @@ func (kubernetesManager *KubernetesManager) GetVolumeSourceForHostPath(mountPath
 	}
 }
 
+func (kubernetesManager *KubernetesManager) GetVolumeSourceForEmptyDir() apiv1.VolumeSource {
+	return apiv1.VolumeSource{
+		HostPath: nil,
+		EmptyDir: &apiv1.EmptyDirVolumeSource{
+			Medium:    "",
+			SizeLimit: nil,
+		},
+		GCEPersistentDisk:     nil,
+		AWSElasticBlockStore:  nil,
+		GitRepo:               nil,
+		Secret:                nil,
+		NFS:                   nil,
+		ISCSI:                 nil,
+		Glusterfs:             nil,
+		PersistentVolumeClaim: nil,
+		RBD:                   nil,
+		FlexVolume:            nil,
+		Cinder:                nil,
+		CephFS:                nil,
+		Flocker:               nil,
+		DownwardAPI:           nil,
+		FC:                    nil,
+		AzureFile:             nil,
+		ConfigMap:             nil,
+		VsphereVolume:         nil,
+		Quobyte:               nil,
+		AzureDisk:             nil,
+		PhotonPersistentDisk:  nil,
+		Projected:             nil,
+		PortworxVolume:        nil,
+		ScaleIO:               nil,
+		StorageOS:             nil,
+		CSI:                   nil,
+		Ephemeral:             nil,
+	}
+}
+
 func (kubernetesManager *KubernetesManager) GetVolumeSourceForConfigMap(configMapName string) apiv1.VolumeSource {
 	return apiv1.VolumeSource{
 		ConfigMap: &apiv1.ConfigMapVolumeSource{
@@ -1834,6 +2078,37 @@ func (kubernetesManager *KubernetesManager) GetVolumeSourceForConfigMap(configMa
 	}
 }
 
+// PodMetrics is the part of the PodMetrics object of the metrics.k8s.io API that we use; it's decoded here rather than
+// with the k8s.io/metrics client to avoid depending on it for a single call
+type PodMetrics struct {
+	Containers []ContainerMetrics `json:"containers"`
+}
+
+type ContainerMetrics struct {
+	Name  string             `json:"name"`
+	Usage apiv1.ResourceList `json:"usage"`
+}
+
+// GetPodMetrics gets the current CPU and memory usage of the containers of the given pod from the metrics API, which
+// is served by metrics-server if it is installed in the cluster
+func (manager *KubernetesManager) GetPodMetrics(ctx context.Context, namespaceName string, podName string) (*PodMetrics, error) {
+	podMetricsPath := fmt.Sprintf("/apis/metrics.k8s.io/v1beta1/namespaces/%v/pods/%v", namespaceName, podName)
+	rawPodMetrics, err := manager.kubernetesClientSet.Discovery().RESTClient().Get().AbsPath(podMetricsPath).DoRaw(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred getting the metrics of pod '%v' in namespace '%v'; the metrics API is only available if metrics-server is installed in the cluster",
+			podName,
+			namespaceName,
+		)
+	}
+	podMetrics := &PodMetrics{Containers: nil}
+	if err = json.Unmarshal(rawPodMetrics, podMetrics); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the metrics of pod '%v' in namespace '%v'", podName, namespaceName)
+	}
+	return podMetrics, nil
+}
+
 // GetContainerLogs gets the logs for a given container running inside the given pod in the give namespace
 // TODO We could upgrade this to get the logs of many containers at once just like kubectl does, see:
 //
@@ -1976,13 +2251,30 @@ func (manager *KubernetesManager) RunExecCommand(
 ) (
 	resultExitCode int32,
 	resultErr error,
+) {
+	return manager.RunExecCommandWithStdin(namespaceName, podName, containerName, command, nil, stdOutOutput, stdErrOutput)
+}
+
+// RunExecCommandWithStdin is like RunExecCommand, but with stdIn piped to the standard input of the command if it's
+// not nil
+func (manager *KubernetesManager) RunExecCommandWithStdin(
+	namespaceName string,
+	podName string,
+	containerName string,
+	command []string,
+	stdIn io.Reader,
+	stdOutOutput io.Writer,
+	stdErrOutput io.Writer,
+) (
+	resultExitCode int32,
+	resultErr error,
 ) {
 	execOptions := &apiv1.PodExecOptions{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "",
 			APIVersion: "",
 		},
-		Stdin:     shouldAllocateStdinOnPodExec,
+		Stdin:     stdIn != nil,
 		Stdout:    shouldAllocatedStdoutOnPodExec,
 		Stderr:    shouldAllocatedStderrOnPodExec,
 		TTY:       shouldAllocateTtyOnPodExec,
@@ -2016,7 +2308,7 @@ func (manager *KubernetesManager) RunExecCommand(
 	}
 
 	if err = exec.StreamWithContext(context.Background(), remotecommand.StreamOptions{
-		Stdin:             nil,
+		Stdin:             stdIn,
 		Stdout:            stdOutOutput,
 		Stderr:            stdErrOutput,
 		Tty:               false,
@@ -2320,6 +2612,17 @@ func (manager *KubernetesManager) GetAllEnclaveResourcesByLabels(ctx context.Con
 	return podsList, servicesList, clusterRolesList, clusterRoleBindingsList, nil
 }
 
+// GetEventsInNamespace returns the events of every object in the namespace, e.g. to find out why a pod failed to start
+func (manager *KubernetesManager) GetEventsInNamespace(ctx context.Context, namespace string) (*apiv1.EventList, error) {
+	eventsClient := manager.kubernetesClientSet.CoreV1().Events(namespace)
+
+	eventsResult, err := eventsClient.List(ctx, buildListOptionsFromLabels(map[string]string{}))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to list the events in namespace '%s'", namespace)
+	}
+	return eventsResult, nil
+}
+
 func (manager *KubernetesManager) GetPodsByLabels(ctx context.Context, namespace string, podLabels map[string]string) (*apiv1.PodList, error) {
 	namespacePodClient := manager.kubernetesClientSet.CoreV1().Pods(namespace)
 
@@ -2385,13 +2688,15 @@ func (manager *KubernetesManager) GetPodPortforwardEndpointUrl(namespace string,
 	return manager.kubernetesClientSet.CoreV1().RESTClient().Post().Resource("pods").Namespace(namespace).Name(podName).SubResource("portforward").URL()
 }
 
-func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.Pod) error {
+// GetExecStream runs the command interactively in the first container of the pod, attached to the terminal. The
+// session is disconnected if it ended because the connection to the pod was lost rather than because the command exited
+func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.Pod, command []string) (bool, error) {
 	containerName := pod.Spec.Containers[0].Name
 	request := manager.kubernetesClientSet.CoreV1().RESTClient().Post().Resource("pods").Name(pod.Name).Namespace(pod.Namespace).SubResource("exec")
 	// lifted from https://github.com/kubernetes/client-go/issues/912 - the terminal magic is still magical
 	request.VersionedParams(&apiv1.PodExecOptions{
 		Container: containerName,
-		Command:   commandToRunWhenCreatingUserServiceShell,
+		Command:   command,
 		Stdin:     true,
 		Stdout:    true,
 		Stderr:    true,
@@ -2403,7 +2708,7 @@ func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.
 	}, scheme.ParameterCodec)
 	exec, err := remotecommand.NewSPDYExecutor(manager.kuberneteRestConfig, "POST", request.URL())
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while creating a new SPDY executor")
+		return false, stacktrace.Propagate(err, "An error occurred while creating a new SPDY executor")
 	}
 	stdinFd := int(os.Stdin.Fd())
 	var oldState *terminal.State
@@ -2411,7 +2716,7 @@ func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.
 		oldState, err = terminal.MakeRaw(stdinFd)
 		if err != nil {
 			// print error
-			return stacktrace.Propagate(err, "An error occurred making STDIN stream raw")
+			return false, stacktrace.Propagate(err, "An error occurred making STDIN stream raw")
 		}
 		defer func() {
 			if err = terminal.Restore(stdinFd, oldState); err != nil {
@@ -2419,7 +2724,7 @@ func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.
 			}
 		}()
 	}
-	return exec.StreamWithContext(
+	if err = exec.StreamWithContext(
 		ctx,
 		remotecommand.StreamOptions{
 			TerminalSizeQueue: nil,
@@ -2427,7 +2732,19 @@ func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.
 			Stdout:            os.Stdout,
 			Stderr:            os.Stderr,
 			Tty:               true,
-		})
+		}); err != nil {
+		if ctx.Err() != nil {
+			return false, stacktrace.Propagate(err, "The exec stream on pod '%v' was interrupted", pod.Name)
+		}
+		// Kubernetes returns the exit code of the command via a string in the error message; the command exiting with
+		// an error is a normal end of the session, any other error means the stream broke
+		if _, exitCodeErr := getExitCodeFromStatusMessage(err.Error()); exitCodeErr == nil {
+			return false, nil
+		}
+		logrus.Debugf("The exec stream on pod '%v' ended with an error, considering it as disconnected:\n%v", pod.Name, err)
+		return true, nil
+	}
+	return false, nil
 }
 
 func (manager *KubernetesManager) HasComputeNodes(ctx context.Context) (bool, error) {
@@ -2564,7 +2881,7 @@ func (manager *KubernetesManager) CreateIngress(ctx context.Context, namespace s
 		},
 	}
 
-	ingressResult, err := client.Create(ctx, ingress, globalCreateOptions)
+	ingressResult, err := applyObject(ctx, client.Patch, ingress, netv1.SchemeGroupVersion.WithKind("Ingress"))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create the ingress with name '%s' in namespace '%v'", name, namespace)
 	}
@@ -2662,6 +2979,11 @@ func (manager *KubernetesManager) CreateJob(
 		ShareProcessNamespace:         nil,
 	}
 
+	if err := manager.podSecurityStandard.applyToPodSpec(&podSpec); err != nil {
+		return nil, stacktrace.Propagate(err, "Job '%v' can't be created under the '%v' Pod Security Standard", jobName, manager.podSecurityStandard)
+	}
+	manager.useAirGapImageMirror(&podSpec)
+
 	manualSelectors := jobLabels != nil
 
 	jobSpec := batchv1.JobSpec{
@@ -2710,10 +3032,11 @@ func (manager *KubernetesManager) CreateJob(
 		logrus.Debugf("Going to start job using the following JSON: %v", string(jobDefinitionBytes))
 	}
 
+	// Like pods, jobs aren't applied because their pod template can't be changed once they're created
 	job, err := jobsClient.Create(ctx, jobToCreate, globalCreateOptions)
 	if err != nil {
 		return nil, stacktrace.Propagate(
-			err,
+			toBackendErrorIfKnownApiError(err),
 			"Failed to create job '%v' in namespace '%v' with containers '%+v' and volumes '%+v'",
 			jobName,
 			namespaceName,
@@ -2768,42 +3091,19 @@ func (manager *KubernetesManager) GetPodsManagedByJob(ctx context.Context, job *
 	return podsManagedByJob, nil
 }
 
+// WaitForJobCompletion watches the job until it reports that it either completed or failed
 func (manager *KubernetesManager) WaitForJobCompletion(
 	ctx context.Context,
 	job *batchv1.Job,
-	pollInterval time.Duration,
 	timeout time.Duration,
 ) error {
-	jobsClient := manager.kubernetesClientSet.BatchV1().Jobs(job.Namespace)
-
-	// Wait for the Job to report completion (either failed or completed)
-
-	err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
-		job, err := jobsClient.Get(ctx, job.Name, metav1.GetOptions{
-			TypeMeta: metav1.TypeMeta{
-				Kind:       "",
-				APIVersion: "",
-			},
-			ResourceVersion: "",
-		})
-		if err != nil {
-			return false, err
-		}
-
-		for _, condition := range job.Status.Conditions {
-			if (condition.Type == batchv1.JobComplete && condition.Status == apiv1.ConditionTrue) ||
-				(condition.Type == batchv1.JobFailed && condition.Status == apiv1.ConditionTrue) {
-				return true, nil
-			}
-		}
-
-		return false, nil
-	})
-
+	isFinished, err := waitForJobFinished(ctx, manager.newJobsListerWatcher(job.Namespace, getObjectNameFieldSelector(job.Name)), timeout)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred waiting for job %s to complete", job.Name)
 	}
-
+	if !isFinished {
+		return stacktrace.NewError("Job %s didn't complete after %v", job.Name, timeout)
+	}
 	return nil
 }
 
@@ -2812,32 +3112,38 @@ func (manager *KubernetesManager) WaitForJobCompletion(
 // ====================================================================================================
 
 func (manager *KubernetesManager) waitForPodAvailability(ctx context.Context, namespaceName string, podName string) error {
-	// Wait for the pod to start running
-	deadline := time.Now().Add(podWaitForAvailabilityTimeout)
-	var latestPodStatus *apiv1.PodStatus
-	for time.Now().Before(deadline) {
-		pod, err := manager.GetPod(ctx, namespaceName, podName)
-		if err != nil {
-			// We shouldn't get an error on getting the pod, even if it's not ready
-			return stacktrace.Propagate(err, "An error occurred getting the just-created pod '%v'", podName)
+	var latestPod *apiv1.Pod
+	latestPodStatus := new(apiv1.PodStatus)
+	precondition := func(store cache.Store) (bool, error) {
+		if len(store.List()) == 0 {
+			return false, stacktrace.NewError("Pod '%v' in namespace '%v' wasn't found while waiting for it to become available", podName, namespaceName)
+		}
+		return false, nil
+	}
+	isAvailable, err := waitForWatchCondition(ctx, podWaitForAvailabilityTimeout, manager.newPodsListerWatcher(namespaceName, getObjectNameFieldSelector(podName), noLabelSelector), new(apiv1.Pod), precondition, func(event watch.Event) (bool, error) {
+		pod, ok := event.Object.(*apiv1.Pod)
+		if !ok {
+			return false, nil
+		}
+		if event.Type == watch.Deleted {
+			return false, stacktrace.NewError("Pod '%v' in namespace '%v' was deleted while waiting for it to become available", podName, namespaceName)
 		}
 
+		latestPod = pod
 		latestPodStatus = &pod.Status
 		switch latestPodStatus.Phase {
 		case apiv1.PodUnknown:
 			// not impl - skipping
 		case apiv1.PodRunning:
-			return nil
+			return true, nil
 		case apiv1.PodPending:
 			for _, containerStatus := range pod.Status.ContainerStatuses {
 				containerName := containerStatus.Name
 				maybeContainerWaitingState := containerStatus.State.Waiting
 				if maybeContainerWaitingState != nil && maybeContainerWaitingState.Reason == imagePullBackOffContainerReason {
-					return stacktrace.NewError(
-						"Container '%v' using image '%v' in pod '%v' in namespace '%v' is stuck in state '%v'. This likely means:\n"+
-							"1) There's a typo in either the image name or the tag name\n"+
-							"2) The image isn't accessible to Kubernetes (e.g. it's a local image, or it's in a private image registry that Kubernetes can't access)\n"+
-							"3) The image's platform/architecture might not match",
+					return false, backend_errors.NewBackendError(
+						backend_errors.ErrorKind_ImagePullBackOff,
+						"Container '%v' using image '%v' in pod '%v' in namespace '%v' is stuck in state '%v'",
 						containerName,
 						containerStatus.Image,
 						pod.Name,
@@ -2848,7 +3154,7 @@ func (manager *KubernetesManager) waitForPodAvailability(ctx context.Context, na
 			}
 		case apiv1.PodFailed:
 			podStateStr := manager.getPodInfoBlockStr(ctx, namespaceName, pod)
-			return stacktrace.NewError(
+			return false, stacktrace.NewError(
 				"Pod '%v' failed before availability with the following state:\n%v",
 				podName,
 				podStateStr,
@@ -2856,7 +3162,7 @@ func (manager *KubernetesManager) waitForPodAvailability(ctx context.Context, na
 		case apiv1.PodSucceeded:
 			podStateStr := manager.getPodInfoBlockStr(ctx, namespaceName, pod)
 			//NOTE: We'll need to change this if we ever expect to run one-off pods
-			return stacktrace.NewError(
+			return false, stacktrace.NewError(
 				"Expected state of pod '%v' to arrive at '%v' but the pod instead landed in '%v' with the following state:\n%v",
 				podName,
 				apiv1.PodRunning,
@@ -2864,7 +3170,20 @@ func (manager *KubernetesManager) waitForPodAvailability(ctx context.Context, na
 				podStateStr,
 			)
 		}
-		time.Sleep(podWaitForAvailabilityTimeBetweenPolls)
+		return false, nil
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for pod '%v' to become available", podName)
+	}
+	if isAvailable {
+		return nil
+	}
+
+	// Pods stay pending while they can't be scheduled, in case the cluster frees up room for them in the meantime
+	if latestPod != nil {
+		if backendErr, isUnschedulable := getUnschedulablePodBackendError(latestPod); isUnschedulable {
+			return stacktrace.Propagate(backendErr, "Pod '%v' did not become available after %v", podName, podWaitForAvailabilityTimeout)
+		}
 	}
 
 	containerStatusStrs := renderContainerStatuses(latestPodStatus.ContainerStatuses, containerStatusLineBulletPoint)
@@ -2881,20 +3200,32 @@ func (manager *KubernetesManager) waitForPodAvailability(ctx context.Context, na
 
 // waitForPodDeletion waits for the pod to be fully deleted if it has been marked for deletion
 func (manager *KubernetesManager) waitForPodDeletion(ctx context.Context, namespaceName string, podName string) error {
-	// Wait for the pod to start running
-	deadline := time.Now().Add(podWaitForDeletionTimeout)
-	var latestPodStatus *apiv1.PodStatus
-	for time.Now().Before(deadline) {
-		pod, err := manager.GetPod(ctx, namespaceName, podName)
-		if err != nil {
-			// If an error has been returned, it's likely the pod does not exist. Continue
-			return nil
+	latestPodStatus := new(apiv1.PodStatus)
+	precondition := func(store cache.Store) (bool, error) {
+		pods := store.List()
+		if len(pods) == 0 {
+			return true, nil
 		}
-		if pod.DeletionTimestamp == nil {
-			return stacktrace.NewError("The pod '%s' currently exists in namespace '%s' and is not scheduled for deletion",
+		if pod, ok := pods[0].(*apiv1.Pod); ok && pod.DeletionTimestamp == nil {
+			return false, stacktrace.NewError("The pod '%s' currently exists in namespace '%s' and is not scheduled for deletion",
 				podName, namespaceName)
 		}
-		time.Sleep(podWaitForDeletionTimeBetweenPolls)
+		return false, nil
+	}
+	isDeleted, err := waitForWatchCondition(ctx, podWaitForDeletionTimeout, manager.newPodsListerWatcher(namespaceName, getObjectNameFieldSelector(podName), noLabelSelector), new(apiv1.Pod), precondition, func(event watch.Event) (bool, error) {
+		if event.Type == watch.Deleted {
+			return true, nil
+		}
+		if pod, ok := event.Object.(*apiv1.Pod); ok {
+			latestPodStatus = &pod.Status
+		}
+		return false, nil
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for pod '%v' to be deleted", podName)
+	}
+	if isDeleted {
+		return nil
 	}
 
 	containerStatusStrs := renderContainerStatuses(latestPodStatus.ContainerStatuses, containerStatusLineBulletPoint)
@@ -2902,7 +3233,7 @@ func (manager *KubernetesManager) waitForPodDeletion(ctx context.Context, namesp
 		"Pod '%v' wasn't deleted after %v; its latest state is '%v' and status message is: %v\n"+
 			"The pod's container states are as follows:\n%v",
 		podName,
-		podWaitForAvailabilityTimeout,
+		podWaitForDeletionTimeout,
 		latestPodStatus.Phase,
 		latestPodStatus.Message,
 		strings.Join(containerStatusStrs, "\n"),
@@ -2910,26 +3241,27 @@ func (manager *KubernetesManager) waitForPodDeletion(ctx context.Context, namesp
 }
 
 func (manager *KubernetesManager) WaitForPodTermination(ctx context.Context, namespaceName string, podName string) error {
-	deadline := time.Now().Add(podWaitForTerminationTimeout)
-	var latestPodStatus *apiv1.PodStatus
-	for time.Now().Before(deadline) {
-		pod, err := manager.GetPod(ctx, namespaceName, podName)
-		if err != nil {
-			// The pod info is not always available after deletion, so we handle that gracefully
-			logrus.Debugf("An error occurred trying to retrieve the just-deleted pod '%v': %v, for checking if it was successfully terminated; but we can ignore this error because the pod info is not always available after deletion", podName, err)
-			return nil
+	latestPodStatus := new(apiv1.PodStatus)
+	precondition := func(store cache.Store) (bool, error) {
+		// The pod is not always there anymore after deletion, in which case it's terminated
+		return len(store.List()) == 0, nil
+	}
+	isTerminated, err := waitForWatchCondition(ctx, podWaitForTerminationTimeout, manager.newPodsListerWatcher(namespaceName, getObjectNameFieldSelector(podName), noLabelSelector), new(apiv1.Pod), precondition, func(event watch.Event) (bool, error) {
+		if event.Type == watch.Deleted {
+			return true, nil
 		}
-
-		latestPodStatus = &pod.Status
-		switch latestPodStatus.Phase {
-		case apiv1.PodPending:
-		case apiv1.PodRunning:
-		case apiv1.PodUnknown:
-		case apiv1.PodSucceeded:
-		case apiv1.PodFailed:
-			return nil
+		pod, ok := event.Object.(*apiv1.Pod)
+		if !ok {
+			return false, nil
 		}
-		time.Sleep(podWaitForTerminationTimeBetweenPolls)
+		latestPodStatus = &pod.Status
+		return latestPodStatus.Phase == apiv1.PodFailed, nil
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for pod '%v' to terminate", podName)
+	}
+	if isTerminated {
+		return nil
 	}
 
 	containerStatusStrs := renderContainerStatuses(latestPodStatus.ContainerStatuses, containerStatusLineBulletPoint)
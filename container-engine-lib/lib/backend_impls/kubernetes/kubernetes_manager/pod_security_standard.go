@@ -0,0 +1,150 @@
+package kubernetes_manager
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// PodSecurityStandard is the Kubernetes Pod Security Standard profile that the pods Kurtosis creates comply with
+type PodSecurityStandard string
+
+const (
+	// PodSecurityStandard_Privileged doesn't constrain the pods, which is what Kurtosis has always done. The empty
+	// standard, which the engines and API containers started before the standards were supported have, means the same.
+	PodSecurityStandard_Privileged PodSecurityStandard = "privileged"
+
+	// PodSecurityStandard_Restricted makes every pod run as a non-root user, with the default seccomp profile, without
+	// any capability nor privilege escalation, and refuses the pods that need host access
+	PodSecurityStandard_Restricted PodSecurityStandard = "restricted"
+
+	// The labels making the API server enforce the standard on the pods of a namespace, see
+	// https://kubernetes.io/docs/concepts/security/pod-security-admission/
+	podSecurityEnforceLabelKey        = "pod-security.kubernetes.io/enforce"
+	podSecurityEnforceVersionLabelKey = "pod-security.kubernetes.io/enforce-version"
+	podSecurityLatestVersion          = "latest"
+
+	// The user, group and filesystem group the pods run as under the restricted standard, unless their containers set
+	// another non-root user. It's the 'nonroot' user of the distroless images.
+	restrictedPodUserId int64 = 65532
+
+	allCapabilities = apiv1.Capability("ALL")
+	// The only capability the restricted standard lets the containers add back
+	netBindServiceCapability = apiv1.Capability("NET_BIND_SERVICE")
+)
+
+func (standard PodSecurityStandard) Validate() error {
+	switch standard {
+	case "", PodSecurityStandard_Privileged, PodSecurityStandard_Restricted:
+		return nil
+	default:
+		return stacktrace.NewError("Unrecognized Pod Security Standard '%v'; valid values are '%v' and '%v'", standard, PodSecurityStandard_Privileged, PodSecurityStandard_Restricted)
+	}
+}
+
+// IsRestricted returns true if the features that need host access or privileges are unavailable
+func (standard PodSecurityStandard) IsRestricted() bool {
+	return standard == PodSecurityStandard_Restricted
+}
+
+// getNamespaceLabels adds the labels enforcing the standard to the labels of a namespace Kurtosis creates, so that
+// the API server rejects any pod of the namespace that doesn't comply, including the ones Kurtosis didn't create
+func (standard PodSecurityStandard) getNamespaceLabels(namespaceLabels map[string]string) map[string]string {
+	if !standard.IsRestricted() {
+		return namespaceLabels
+	}
+	result := map[string]string{}
+	for key, value := range namespaceLabels {
+		result[key] = value
+	}
+	result[podSecurityEnforceLabelKey] = string(PodSecurityStandard_Restricted)
+	result[podSecurityEnforceVersionLabelKey] = podSecurityLatestVersion
+	return result
+}
+
+// applyToPodSpec makes the pod comply with the standard, or returns an error explaining why it can't
+func (standard PodSecurityStandard) applyToPodSpec(podSpec *apiv1.PodSpec) error {
+	if !standard.IsRestricted() {
+		return nil
+	}
+	if podSpec.HostNetwork || podSpec.HostPID || podSpec.HostIPC {
+		return stacktrace.NewError("The pod shares a namespace of its host, which the restricted Pod Security Standard forbids")
+	}
+	for _, volume := range podSpec.Volumes {
+		if volume.HostPath != nil {
+			return stacktrace.NewError("The pod mounts path '%v' of its host as volume '%v', which the restricted Pod Security Standard forbids", volume.HostPath.Path, volume.Name)
+		}
+	}
+
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = new(apiv1.PodSecurityContext)
+	}
+	podSecurityContext := podSpec.SecurityContext
+	if podSecurityContext.RunAsUser != nil && *podSecurityContext.RunAsUser == 0 {
+		return stacktrace.NewError("The pod runs as root, which the restricted Pod Security Standard forbids")
+	}
+	runAsNonRoot := true
+	podSecurityContext.RunAsNonRoot = &runAsNonRoot
+	if podSecurityContext.RunAsUser == nil {
+		podSecurityContext.RunAsUser = int64Ptr(restrictedPodUserId)
+	}
+	if podSecurityContext.RunAsGroup == nil {
+		podSecurityContext.RunAsGroup = int64Ptr(restrictedPodUserId)
+	}
+	// Gives the user of the pod write access to its volumes, which belong to root otherwise
+	if podSecurityContext.FSGroup == nil {
+		podSecurityContext.FSGroup = int64Ptr(restrictedPodUserId)
+	}
+	if podSecurityContext.SeccompProfile == nil {
+		podSecurityContext.SeccompProfile = &apiv1.SeccompProfile{
+			Type:             apiv1.SeccompProfileTypeRuntimeDefault,
+			LocalhostProfile: nil,
+		}
+	}
+
+	for idx := range podSpec.InitContainers {
+		if err := applyRestrictedStandardToContainer(&podSpec.InitContainers[idx]); err != nil {
+			return stacktrace.Propagate(err, "Init container '%v' of the pod doesn't comply with the restricted Pod Security Standard", podSpec.InitContainers[idx].Name)
+		}
+	}
+	for idx := range podSpec.Containers {
+		if err := applyRestrictedStandardToContainer(&podSpec.Containers[idx]); err != nil {
+			return stacktrace.Propagate(err, "Container '%v' of the pod doesn't comply with the restricted Pod Security Standard", podSpec.Containers[idx].Name)
+		}
+	}
+	return nil
+}
+
+func applyRestrictedStandardToContainer(container *apiv1.Container) error {
+	if container.SecurityContext == nil {
+		container.SecurityContext = new(apiv1.SecurityContext)
+	}
+	securityContext := container.SecurityContext
+	if securityContext.Privileged != nil && *securityContext.Privileged {
+		return stacktrace.NewError("The container is privileged")
+	}
+	if securityContext.RunAsUser != nil && *securityContext.RunAsUser == 0 {
+		return stacktrace.NewError("The container runs as root; set a non-root user instead")
+	}
+	if securityContext.SeccompProfile != nil && securityContext.SeccompProfile.Type == apiv1.SeccompProfileTypeUnconfined {
+		return stacktrace.NewError("The container runs without seccomp profile")
+	}
+	runAsNonRoot := true
+	securityContext.RunAsNonRoot = &runAsNonRoot
+	allowPrivilegeEscalation := false
+	securityContext.AllowPrivilegeEscalation = &allowPrivilegeEscalation
+
+	var addedCapabilities []apiv1.Capability
+	if securityContext.Capabilities != nil {
+		for _, capability := range securityContext.Capabilities.Add {
+			if capability != netBindServiceCapability {
+				return stacktrace.NewError("The container adds capability '%v'", capability)
+			}
+			addedCapabilities = append(addedCapabilities, capability)
+		}
+	}
+	securityContext.Capabilities = &apiv1.Capabilities{
+		Add:  addedCapabilities,
+		Drop: []apiv1.Capability{allCapabilities},
+	}
+	return nil
+}
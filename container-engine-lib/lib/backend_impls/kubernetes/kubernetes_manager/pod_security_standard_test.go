@@ -0,0 +1,123 @@
+package kubernetes_manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	testContainerName = "test-container"
+	testNonRootUserId = int64(1000)
+)
+
+func TestPodSecurityStandard_Validate(t *testing.T) {
+	require.NoError(t, PodSecurityStandard("").Validate())
+	require.NoError(t, PodSecurityStandard_Privileged.Validate())
+	require.NoError(t, PodSecurityStandard_Restricted.Validate())
+	require.Error(t, PodSecurityStandard("baseline").Validate())
+}
+
+func TestApplyToPodSpec_PrivilegedLeavesThePodUnchanged(t *testing.T) {
+	podSpec := newTestPodSpec()
+	podSpec.HostNetwork = true
+	require.NoError(t, PodSecurityStandard_Privileged.applyToPodSpec(&podSpec))
+	require.Nil(t, podSpec.SecurityContext)
+	require.Nil(t, podSpec.Containers[0].SecurityContext)
+}
+
+func TestApplyToPodSpec_RestrictedDefaults(t *testing.T) {
+	podSpec := newTestPodSpec()
+	require.NoError(t, PodSecurityStandard_Restricted.applyToPodSpec(&podSpec))
+
+	podSecurityContext := podSpec.SecurityContext
+	require.True(t, *podSecurityContext.RunAsNonRoot)
+	require.Equal(t, restrictedPodUserId, *podSecurityContext.RunAsUser)
+	require.Equal(t, restrictedPodUserId, *podSecurityContext.RunAsGroup)
+	require.Equal(t, restrictedPodUserId, *podSecurityContext.FSGroup)
+	require.Equal(t, apiv1.SeccompProfileTypeRuntimeDefault, podSecurityContext.SeccompProfile.Type)
+
+	containerSecurityContext := podSpec.Containers[0].SecurityContext
+	require.True(t, *containerSecurityContext.RunAsNonRoot)
+	require.False(t, *containerSecurityContext.AllowPrivilegeEscalation)
+	require.Equal(t, []apiv1.Capability{allCapabilities}, containerSecurityContext.Capabilities.Drop)
+	require.Empty(t, containerSecurityContext.Capabilities.Add)
+}
+
+func TestApplyToPodSpec_RestrictedKeepsTheNonRootUserOfTheContainer(t *testing.T) {
+	podSpec := newTestPodSpec()
+	podSpec.Containers[0].SecurityContext = &apiv1.SecurityContext{
+		RunAsUser: int64Ptr(testNonRootUserId),
+		Capabilities: &apiv1.Capabilities{
+			Add: []apiv1.Capability{netBindServiceCapability},
+		},
+	}
+	require.NoError(t, PodSecurityStandard_Restricted.applyToPodSpec(&podSpec))
+	require.Equal(t, testNonRootUserId, *podSpec.Containers[0].SecurityContext.RunAsUser)
+	require.Equal(t, []apiv1.Capability{netBindServiceCapability}, podSpec.Containers[0].SecurityContext.Capabilities.Add)
+}
+
+func TestApplyToPodSpec_RestrictedRejectsRootContainers(t *testing.T) {
+	podSpec := newTestPodSpec()
+	podSpec.Containers[0].SecurityContext = &apiv1.SecurityContext{
+		RunAsUser: int64Ptr(0),
+	}
+	require.Error(t, PodSecurityStandard_Restricted.applyToPodSpec(&podSpec))
+}
+
+func TestApplyToPodSpec_RestrictedRejectsPrivilegedContainers(t *testing.T) {
+	privileged := true
+	podSpec := newTestPodSpec()
+	podSpec.InitContainers = []apiv1.Container{{
+		Name:            testContainerName,
+		SecurityContext: &apiv1.SecurityContext{Privileged: &privileged},
+	}}
+	require.Error(t, PodSecurityStandard_Restricted.applyToPodSpec(&podSpec))
+}
+
+func TestApplyToPodSpec_RestrictedRejectsAddedCapabilities(t *testing.T) {
+	podSpec := newTestPodSpec()
+	podSpec.Containers[0].SecurityContext = &apiv1.SecurityContext{
+		Capabilities: &apiv1.Capabilities{
+			Add: []apiv1.Capability{"SYS_ADMIN"},
+		},
+	}
+	require.Error(t, PodSecurityStandard_Restricted.applyToPodSpec(&podSpec))
+}
+
+func TestApplyToPodSpec_RestrictedRejectsHostAccess(t *testing.T) {
+	podSpec := newTestPodSpec()
+	podSpec.HostPID = true
+	require.Error(t, PodSecurityStandard_Restricted.applyToPodSpec(&podSpec))
+
+	podSpec = newTestPodSpec()
+	podSpec.Volumes = []apiv1.Volume{{
+		Name: "host-logs",
+		VolumeSource: apiv1.VolumeSource{
+			HostPath: &apiv1.HostPathVolumeSource{Path: "/var/log"},
+		},
+	}}
+	require.Error(t, PodSecurityStandard_Restricted.applyToPodSpec(&podSpec))
+}
+
+func TestGetNamespaceLabels(t *testing.T) {
+	namespaceLabels := map[string]string{"app": "kurtosis"}
+
+	require.Equal(t, namespaceLabels, PodSecurityStandard_Privileged.getNamespaceLabels(namespaceLabels))
+
+	restrictedLabels := PodSecurityStandard_Restricted.getNamespaceLabels(namespaceLabels)
+	require.Equal(t, map[string]string{
+		"app":                             "kurtosis",
+		podSecurityEnforceLabelKey:        string(PodSecurityStandard_Restricted),
+		podSecurityEnforceVersionLabelKey: podSecurityLatestVersion,
+	}, restrictedLabels)
+	// The labels of the caller are left alone
+	require.Len(t, namespaceLabels, 1)
+}
+
+func newTestPodSpec() apiv1.PodSpec {
+	return apiv1.PodSpec{
+		Containers: []apiv1.Container{{Name: testContainerName}},
+	}
+}
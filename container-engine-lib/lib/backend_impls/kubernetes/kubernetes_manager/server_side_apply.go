@@ -0,0 +1,62 @@
+package kubernetes_manager
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kurtosis-tech/stacktrace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// Kurtosis is the only one supposed to manage the fields of its objects, so it takes them over from whoever changed
+	// them in the meantime rather than failing on the conflicts
+	shouldForceServerSideApply = true
+)
+
+// objectPatcher is the Patch method of the typed Kubernetes clients
+type objectPatcher[T any] func(ctx context.Context, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (T, error)
+
+type kubernetesObject interface {
+	metav1.Object
+	runtime.Object
+}
+
+// applyObject creates the object with a server-side apply under the Kurtosis field manager, so that creating an object
+// that already exists, e.g. because a previous run crashed halfway through creating its objects, reconciles it with the
+// desired state instead of failing with AlreadyExists
+func applyObject[T any](
+	ctx context.Context,
+	patch objectPatcher[T],
+	object kubernetesObject,
+	groupVersionKind schema.GroupVersionKind,
+) (T, error) {
+	var emptyResult T
+
+	// Unlike a create, an apply needs the object to tell its kind
+	object.GetObjectKind().SetGroupVersionKind(groupVersionKind)
+	serializedObject, err := json.Marshal(object)
+	if err != nil {
+		return emptyResult, stacktrace.Propagate(err, "An error occurred serializing %v '%v' to apply it", groupVersionKind.Kind, object.GetName())
+	}
+
+	shouldForce := shouldForceServerSideApply
+	patchOptions := metav1.PatchOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		DryRun:          nil,
+		Force:           &shouldForce,
+		FieldManager:    fieldManager,
+		FieldValidation: "",
+	}
+	result, err := patch(ctx, object.GetName(), types.ApplyPatchType, serializedObject, patchOptions)
+	if err != nil {
+		return emptyResult, stacktrace.Propagate(toBackendErrorIfKnownApiError(err), "An error occurred applying %v '%v'", groupVersionKind.Kind, object.GetName())
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package kubernetes_manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	testConfigMapName = "test-config-map"
+)
+
+func TestApplyObject_SendsForcedApplyPatchWithKind(t *testing.T) {
+	configMap := new(apiv1.ConfigMap)
+	configMap.Name = testConfigMapName
+	configMap.Namespace = testNamespaceName
+	configMap.Data = map[string]string{"key": "value"}
+
+	patcher := func(ctx context.Context, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*apiv1.ConfigMap, error) {
+		require.Equal(t, testConfigMapName, name)
+		require.Equal(t, types.ApplyPatchType, patchType)
+		require.Equal(t, fieldManager, options.FieldManager)
+		require.NotNil(t, options.Force)
+		require.True(t, *options.Force)
+
+		appliedConfigMap := new(apiv1.ConfigMap)
+		require.NoError(t, json.Unmarshal(data, appliedConfigMap))
+		require.Equal(t, "v1", appliedConfigMap.APIVersion)
+		require.Equal(t, "ConfigMap", appliedConfigMap.Kind)
+		require.Equal(t, configMap.Data, appliedConfigMap.Data)
+		return appliedConfigMap, nil
+	}
+
+	result, err := applyObject(context.Background(), patcher, configMap, apiv1.SchemeGroupVersion.WithKind("ConfigMap"))
+	require.NoError(t, err)
+	require.Equal(t, testConfigMapName, result.Name)
+}
+
+func TestApplyObject_PropagatesPatchError(t *testing.T) {
+	configMap := new(apiv1.ConfigMap)
+	configMap.Name = testConfigMapName
+
+	patcher := func(ctx context.Context, name string, patchType types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*apiv1.ConfigMap, error) {
+		return nil, errors.New("apply rejected")
+	}
+
+	result, err := applyObject(context.Background(), patcher, configMap, apiv1.SchemeGroupVersion.WithKind("ConfigMap"))
+	require.Error(t, err)
+	require.Nil(t, result)
+}
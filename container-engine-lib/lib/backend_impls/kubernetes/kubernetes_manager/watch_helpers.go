@@ -0,0 +1,105 @@
+package kubernetes_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	batchv1 "k8s.io/api/batch/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+const (
+	podsResourceName = "pods"
+	jobsResourceName = "jobs"
+
+	objectNameFieldSelectorKey = "metadata.name"
+
+	noFieldSelector = ""
+	noLabelSelector = ""
+)
+
+// newPodsListerWatcher lists and watches the pods of the namespace matching the selectors, which are ignored when empty
+func (manager *KubernetesManager) newPodsListerWatcher(namespaceName string, fieldSelector string, labelSelector string) cache.ListerWatcher {
+	return cache.NewFilteredListWatchFromClient(
+		manager.kubernetesClientSet.CoreV1().RESTClient(),
+		podsResourceName,
+		namespaceName,
+		func(options *metav1.ListOptions) {
+			options.FieldSelector = fieldSelector
+			options.LabelSelector = labelSelector
+		},
+	)
+}
+
+func (manager *KubernetesManager) newJobsListerWatcher(namespaceName string, fieldSelector string) cache.ListerWatcher {
+	return cache.NewFilteredListWatchFromClient(
+		manager.kubernetesClientSet.BatchV1().RESTClient(),
+		jobsResourceName,
+		namespaceName,
+		func(options *metav1.ListOptions) {
+			options.FieldSelector = fieldSelector
+		},
+	)
+}
+
+func getObjectNameFieldSelector(objectName string) string {
+	return fields.OneTermEqualSelector(objectNameFieldSelectorKey, objectName).String()
+}
+
+// waitForReadyPod waits until the first container of one of the watched pods is ready
+func waitForReadyPod(ctx context.Context, listerWatcher cache.ListerWatcher, timeout time.Duration) (bool, error) {
+	return waitForWatchCondition(ctx, timeout, listerWatcher, new(apiv1.Pod), nil, func(event watch.Event) (bool, error) {
+		pod, ok := event.Object.(*apiv1.Pod)
+		if !ok || event.Type == watch.Deleted {
+			return false, nil
+		}
+		return len(pod.Status.ContainerStatuses) > 0 && pod.Status.ContainerStatuses[0].Ready, nil
+	})
+}
+
+// waitForJobFinished waits until the job either completes or fails
+func waitForJobFinished(ctx context.Context, listerWatcher cache.ListerWatcher, timeout time.Duration) (bool, error) {
+	return waitForWatchCondition(ctx, timeout, listerWatcher, new(batchv1.Job), nil, func(event watch.Event) (bool, error) {
+		job, ok := event.Object.(*batchv1.Job)
+		if !ok || event.Type == watch.Deleted {
+			return false, nil
+		}
+		for _, condition := range job.Status.Conditions {
+			if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == apiv1.ConditionTrue {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// waitForWatchCondition runs an informer on the objects of the lister-watcher until the condition holds for one of its
+// events, which start with the objects that already exist so that no state reached before the watch began gets missed.
+// The precondition, if any, is checked first against the synced cache, e.g. to return right away when an object is
+// already gone. It returns false if the timeout elapsed before the condition held.
+func waitForWatchCondition(
+	ctx context.Context,
+	timeout time.Duration,
+	listerWatcher cache.ListerWatcher,
+	objectType runtime.Object,
+	precondition watchtools.PreconditionFunc,
+	condition watchtools.ConditionFunc,
+) (bool, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if _, err := watchtools.UntilWithSync(ctxWithTimeout, listerWatcher, objectType, precondition, condition); err != nil {
+		if ctxWithTimeout.Err() != nil && ctx.Err() == nil {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "An error occurred watching the Kubernetes objects")
+	}
+	return true, nil
+}
@@ -0,0 +1,119 @@
+package kubernetes_manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	fcache "k8s.io/client-go/tools/cache/testing"
+)
+
+const (
+	testNamespaceName = "test-namespace"
+	testPodName       = "test-pod"
+	testJobName       = "test-job"
+
+	testWatchTimeout      = 5 * time.Second
+	testShortWatchTimeout = 200 * time.Millisecond
+)
+
+func TestWaitForReadyPod_PodAlreadyReady(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	defer source.Shutdown()
+	source.Add(newTestPod(true))
+
+	isReady, err := waitForReadyPod(context.Background(), source, testWatchTimeout)
+	require.NoError(t, err)
+	require.True(t, isReady)
+}
+
+func TestWaitForReadyPod_PodBecomesReady(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	defer source.Shutdown()
+	source.Add(newTestPod(false))
+
+	go func() {
+		time.Sleep(testShortWatchTimeout)
+		source.Modify(newTestPod(true))
+	}()
+
+	isReady, err := waitForReadyPod(context.Background(), source, testWatchTimeout)
+	require.NoError(t, err)
+	require.True(t, isReady)
+}
+
+func TestWaitForReadyPod_TimesOut(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	defer source.Shutdown()
+	source.Add(newTestPod(false))
+
+	isReady, err := waitForReadyPod(context.Background(), source, testShortWatchTimeout)
+	require.NoError(t, err)
+	require.False(t, isReady)
+}
+
+func TestWaitForJobFinished_FailedJob(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	defer source.Shutdown()
+	job := new(batchv1.Job)
+	job.Name = testJobName
+	job.Namespace = testNamespaceName
+	source.Add(job)
+
+	go func() {
+		time.Sleep(testShortWatchTimeout)
+		failedJob := job.DeepCopy()
+		failedJob.Status.Conditions = []batchv1.JobCondition{
+			{
+				Type:               batchv1.JobFailed,
+				Status:             apiv1.ConditionTrue,
+				LastProbeTime:      metav1.Time{Time: time.Time{}},
+				LastTransitionTime: metav1.Time{Time: time.Time{}},
+				Reason:             "",
+				Message:            "",
+			},
+		}
+		source.Modify(failedJob)
+	}()
+
+	isFinished, err := waitForJobFinished(context.Background(), source, testWatchTimeout)
+	require.NoError(t, err)
+	require.True(t, isFinished)
+}
+
+func TestWaitForWatchCondition_PreconditionShortCircuits(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	defer source.Shutdown()
+
+	isGone, err := waitForWatchCondition(
+		context.Background(),
+		testWatchTimeout,
+		source,
+		new(apiv1.Pod),
+		func(store cache.Store) (bool, error) {
+			return len(store.List()) == 0, nil
+		},
+		func(event watch.Event) (bool, error) {
+			return false, nil
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, isGone)
+}
+
+func newTestPod(isReady bool) *apiv1.Pod {
+	pod := new(apiv1.Pod)
+	pod.Name = testPodName
+	pod.Namespace = testNamespaceName
+	pod.Status.Phase = apiv1.PodRunning
+	containerStatus := new(apiv1.ContainerStatus)
+	containerStatus.Ready = isReady
+	pod.Status.ContainerStatuses = []apiv1.ContainerStatus{*containerStatus}
+	return pod
+}
@@ -36,16 +36,18 @@ type KubernetesApiContainerObjectAttributesProvider interface {
 
 // Private so it can't be instantiated
 type kubernetesApiContainerObjectAttributesProviderImpl struct {
-	enclaveId string
+	enclaveId           string
+	mandatoryAttributes *mandatoryObjectAttributes
 }
 
 func GetKubernetesApiContainerObjectAttributesProvider(enclaveId enclave.EnclaveUUID) KubernetesApiContainerObjectAttributesProvider {
-	return newKubernetesApiContainerObjectAttributesProviderImpl(enclaveId)
+	return newKubernetesApiContainerObjectAttributesProviderImpl(enclaveId, newEmptyMandatoryObjectAttributes())
 }
 
-func newKubernetesApiContainerObjectAttributesProviderImpl(enclaveId enclave.EnclaveUUID) *kubernetesApiContainerObjectAttributesProviderImpl {
+func newKubernetesApiContainerObjectAttributesProviderImpl(enclaveId enclave.EnclaveUUID, mandatoryAttributes *mandatoryObjectAttributes) *kubernetesApiContainerObjectAttributesProviderImpl {
 	return &kubernetesApiContainerObjectAttributesProviderImpl{
-		enclaveId: string(enclaveId),
+		enclaveId:           string(enclaveId),
+		mandatoryAttributes: mandatoryAttributes,
 	}
 }
 
@@ -58,7 +60,7 @@ func (provider *kubernetesApiContainerObjectAttributesProviderImpl) ForApiContai
 	// No custom annotations for API container pod
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", apiContainerObjectName.GetString(), labels, annotations)
@@ -89,7 +91,7 @@ func (provider *kubernetesApiContainerObjectAttributesProviderImpl) ForApiContai
 		kubernetes_annotation_key_consts.PortSpecsKubernetesAnnotationKey: serializedPortsSpec,
 	}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", apiContainerObjectName.GetString(), labels, annotations)
@@ -107,7 +109,7 @@ func (provider *kubernetesApiContainerObjectAttributesProviderImpl) ForApiContai
 	// No custom annotations for API container service account
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", apiContainerObjectName.GetString(), labels, annotations)
@@ -125,7 +127,7 @@ func (provider *kubernetesApiContainerObjectAttributesProviderImpl) ForApiContai
 	// No custom annotations for API container role
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", apiContainerObjectName.GetString(), labels, annotations)
@@ -143,7 +145,7 @@ func (provider *kubernetesApiContainerObjectAttributesProviderImpl) ForApiContai
 	// No custom annotations for API container role bindings
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerObjectName, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", apiContainerObjectName.GetString(), labels, annotations)
@@ -168,7 +170,7 @@ func (provider *kubernetesApiContainerObjectAttributesProviderImpl) ForApiContai
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred generating name for APIC container cluster role")
 	}
-	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerClusterRoleName, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerClusterRoleName, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", apiContainerClusterRoleName.GetString(), labels, annotations)
@@ -192,7 +194,7 @@ func (provider *kubernetesApiContainerObjectAttributesProviderImpl) ForApiContai
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred generating name for APIC container cluster role binding")
 	}
-	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerClusterRoleBindingsName, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(apiContainerClusterRoleBindingsName, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", apiContainerClusterRoleBindingsName.GetString(), labels, annotations)
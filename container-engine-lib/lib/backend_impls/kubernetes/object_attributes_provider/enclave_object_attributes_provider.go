@@ -54,20 +54,23 @@ type KubernetesEnclaveObjectAttributesProvider interface {
 
 // Private so it can't be instantiated
 type kubernetesEnclaveObjectAttributesProviderImpl struct {
-	enclaveId string
+	enclaveId           string
+	mandatoryAttributes *mandatoryObjectAttributes
 }
 
 func newKubernetesEnclaveObjectAttributesProviderImpl(
 
 	enclaveId enclave.EnclaveUUID,
+	mandatoryAttributes *mandatoryObjectAttributes,
 ) *kubernetesEnclaveObjectAttributesProviderImpl {
 	return &kubernetesEnclaveObjectAttributesProviderImpl{
-		enclaveId: string(enclaveId),
+		enclaveId:           string(enclaveId),
+		mandatoryAttributes: mandatoryAttributes,
 	}
 }
 
 func GetKubernetesEnclaveObjectAttributesProvider(enclaveId enclave.EnclaveUUID) KubernetesEnclaveObjectAttributesProvider {
-	return newKubernetesEnclaveObjectAttributesProviderImpl(enclaveId)
+	return newKubernetesEnclaveObjectAttributesProviderImpl(enclaveId, newEmptyMandatoryObjectAttributes())
 }
 
 func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespace(creationTime time.Time, enclaveName string) (KubernetesObjectAttributes, error) {
@@ -106,7 +109,7 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespa
 		kubernetes_annotation_key_consts.EnclaveNameAnnotationKey:         enclaveNameAnnotationValue,
 	}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, customAnnotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, customAnnotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -121,7 +124,7 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespa
 
 func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForApiContainer() KubernetesApiContainerObjectAttributesProvider {
 	enclaveId := enclave.EnclaveUUID(provider.enclaveId)
-	return GetKubernetesApiContainerObjectAttributesProvider(enclaveId)
+	return newKubernetesApiContainerObjectAttributesProviderImpl(enclaveId, provider.mandatoryAttributes)
 }
 
 func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServiceService(
@@ -147,7 +150,7 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServiceSer
 	//No userServiceService annotations.
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create user service service object attributes.")
 	}
@@ -211,7 +214,7 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServicePod
 		kubernetes_annotation_key_consts.PortSpecsKubernetesAnnotationKey: serializedPortSpecsAnnotationValue,
 	}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create user service pod object attributes")
 	}
@@ -245,7 +248,7 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveDataDir
 	//No userServiceService annotations.
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create service persistent directory object attributes")
 	}
@@ -276,7 +279,7 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSinglePersiste
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create service persistent directory name for hash: '%s'", persistentKeyHash)
 	}
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create service persistent directory object attributes")
 	}
@@ -313,7 +316,7 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServiceIng
 		kubernetes_annotation_key_consts.TraefikIngressRouterEntrypointsAnnotationKey: traefikIngressRouterEntrypointsAnnotationValue,
 	}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create user service ingress object attributes")
 	}
@@ -39,18 +39,21 @@ type KubernetesEngineObjectAttributesProvider interface {
 
 // Private so it can't be instantiated
 type kubernetesEngineObjectAttributesProviderImpl struct {
-	engineGuid engine.EngineGUID
+	engineGuid          engine.EngineGUID
+	mandatoryAttributes *mandatoryObjectAttributes
 }
 
 func GetKubernetesEngineObjectAttributesProvider(engineGuid engine.EngineGUID) KubernetesEngineObjectAttributesProvider {
-	return newKubernetesEngineObjectAttributesProviderImpl(engineGuid)
+	return newKubernetesEngineObjectAttributesProviderImpl(engineGuid, newEmptyMandatoryObjectAttributes())
 }
 
 func newKubernetesEngineObjectAttributesProviderImpl(
 	engineGuid engine.EngineGUID,
+	mandatoryAttributes *mandatoryObjectAttributes,
 ) *kubernetesEngineObjectAttributesProviderImpl {
 	return &kubernetesEngineObjectAttributesProviderImpl{
-		engineGuid: engineGuid,
+		engineGuid:          engineGuid,
+		mandatoryAttributes: mandatoryAttributes,
 	}
 }
 
@@ -68,7 +71,7 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEnginePod() (Ku
 	// No custom annotations for engine pod
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -106,7 +109,7 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineService(g
 		kubernetes_annotation_key_consts.PortSpecsKubernetesAnnotationKey: serializedPortsSpec,
 	}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -129,7 +132,7 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineNamespace
 	// No custom annotations for engine namespace
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -152,7 +155,7 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineServiceAc
 	// No custom annotations for engine service account
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -175,7 +178,7 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineClusterRo
 	// No custom annotations for engine cluster role
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -198,7 +201,7 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineClusterRo
 	// No custom annotations for engine cluster role bindings
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -226,7 +229,7 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineIngress()
 		kubernetes_annotation_key_consts.TraefikIngressRouterEntrypointsAnnotationKey: traefikIngressRouterEntrypointsAnnotationValue,
 	}
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -6,6 +6,10 @@ import (
 	"strings"
 )
 
+const (
+	kurtosisDomain = "kurtosistech.com"
+)
+
 // Represents a Kubernetes label ney that is guaranteed to be valid for Kubernetes
 type KubernetesAnnotationKey struct {
 	value string
@@ -28,6 +32,16 @@ func CreateNewKubernetesAnnotationKey(str string) (*KubernetesAnnotationKey, err
 
 	return &KubernetesAnnotationKey{value: str}, nil
 }
+
+// CreateNewKubernetesMandatoryAnnotationKey creates the key of an annotation that has to be set on every object Kurtosis
+// creates. Like mandatory label keys, it can't be under the Kurtosis domain
+func CreateNewKubernetesMandatoryAnnotationKey(str string) (*KubernetesAnnotationKey, error) {
+	if strings.HasPrefix(str, kurtosisDomain) {
+		return nil, stacktrace.NewError("Mandatory annotation key '%v' can't be under the '%v' domain, which is reserved for Kurtosis", str, kurtosisDomain)
+	}
+	return CreateNewKubernetesAnnotationKey(str)
+}
+
 func (key *KubernetesAnnotationKey) GetString() string {
 	return key.value
 }
@@ -16,6 +16,9 @@ const (
 
 	enclaveNameKeyStr = labelKeyPrefixStr + "enclave-name"
 
+	// The environment variables of the pod that carry secrets, which are redacted from the enclave dumps
+	secretEnvVarsKeyStr = labelKeyPrefixStr + "secret-env-vars"
+
 	// Traefik ingress router
 	traefikKeyIngressRouterPrefixStr = "traefik.ingress.kubernetes.io/router."
 	traefikKeyEntrypointsStr         = traefikKeyIngressRouterPrefixStr + "entrypoints"
@@ -27,4 +30,5 @@ const (
 var PortSpecsKubernetesAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(portSpecsAnnotationKeyStr)
 var EnclaveCreationTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveCreationTimeKeyStr)
 var EnclaveNameAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveNameKeyStr)
+var SecretEnvVarsKubernetesAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(secretEnvVarsKeyStr)
 var TraefikIngressRouterEntrypointsAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(traefikKeyEntrypointsStr)
@@ -12,6 +12,7 @@ var labelKeyStrsToEnsure = map[string]string{
 	portSpecsAnnotationKeyStr: "kurtosistech.com/ports",
 	enclaveCreationTimeKeyStr: "kurtosistech.com/enclave-creation-time",
 	enclaveNameKeyStr:         "kurtosistech.com/enclave-name",
+	secretEnvVarsKeyStr:       "kurtosistech.com/secret-env-vars",
 	traefikKeyEntrypointsStr:  "traefik.ingress.kubernetes.io/router.entrypoints",
 }
 
@@ -19,6 +20,7 @@ var labelKeysToEnsure = map[*kubernetes_annotation_key.KubernetesAnnotationKey]s
 	PortSpecsKubernetesAnnotationKey:             "kurtosistech.com/ports",
 	EnclaveCreationTimeAnnotationKey:             "kurtosistech.com/enclave-creation-time",
 	EnclaveNameAnnotationKey:                     "kurtosistech.com/enclave-name",
+	SecretEnvVarsKubernetesAnnotationKey:         "kurtosistech.com/secret-env-vars",
 	TraefikIngressRouterEntrypointsAnnotationKey: "traefik.ingress.kubernetes.io/router.entrypoints",
 }
 
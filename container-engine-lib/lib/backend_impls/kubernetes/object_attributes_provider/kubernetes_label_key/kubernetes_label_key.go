@@ -30,6 +30,16 @@ func CreateNewKubernetesUserCustomLabelKey(str string) (*KubernetesLabelKey, err
 	return createNewKubernetesLabelKey(labelKeyStr)
 }
 
+// CreateNewKubernetesMandatoryLabelKey creates the key of a label that has to be set on every object Kurtosis creates,
+// e.g. as per the labeling conventions of an organization. It can't be under the Kurtosis domain, so that it never
+// shadows the labels Kurtosis keeps track of its objects with
+func CreateNewKubernetesMandatoryLabelKey(str string) (*KubernetesLabelKey, error) {
+	if strings.HasPrefix(str, kurtosisDomain) {
+		return nil, stacktrace.NewError("Mandatory label key '%v' can't be under the '%v' domain, which is reserved for Kurtosis", str, kurtosisDomain)
+	}
+	return createNewKubernetesLabelKey(str)
+}
+
 func ValidateUserCustomLabelKey(str string) error {
 	if err := validateNotEmptyUserCustomLabelKey(str); err != nil {
 		return stacktrace.Propagate(err, "Received an empty user custom label key")
@@ -61,3 +61,14 @@ func TestMaxAllowedLabel(t *testing.T) {
 	_, err = CreateNewKubernetesUserCustomLabelKey(overUserCustomValidMaxLabel)
 	require.Error(t, err)
 }
+
+func TestMandatoryLabelKeyCantBeUnderKurtosisDomain(t *testing.T) {
+	_, err := CreateNewKubernetesMandatoryLabelKey("example.com/cost-center")
+	require.NoError(t, err)
+
+	_, err = CreateNewKubernetesMandatoryLabelKey(appIdLabelKeyStr)
+	require.Error(t, err)
+
+	_, err = CreateNewKubernetesMandatoryLabelKey(customUserLabelsKeyPrefixStr + "cost-center")
+	require.Error(t, err)
+}
@@ -26,16 +26,18 @@ type KubernetesLogsAggregatorObjectAttributesProvider interface {
 }
 
 func GetKubernetesLogsAggregatorObjectAttributesProvider(logsAggregatorGuid logs_aggregator.LogsAggregatorGuid) KubernetesLogsAggregatorObjectAttributesProvider {
-	return newKubernetesLogsAggregatorObjectAttributesProvider(logsAggregatorGuid)
+	return newKubernetesLogsAggregatorObjectAttributesProvider(logsAggregatorGuid, newEmptyMandatoryObjectAttributes())
 }
 
 type kubernetesLogsAggregatorObjectAttributesProviderImpl struct {
-	logsAggregatorGuid logs_aggregator.LogsAggregatorGuid
+	logsAggregatorGuid  logs_aggregator.LogsAggregatorGuid
+	mandatoryAttributes *mandatoryObjectAttributes
 }
 
-func newKubernetesLogsAggregatorObjectAttributesProvider(logsAggregatorGuid logs_aggregator.LogsAggregatorGuid) *kubernetesLogsAggregatorObjectAttributesProviderImpl {
+func newKubernetesLogsAggregatorObjectAttributesProvider(logsAggregatorGuid logs_aggregator.LogsAggregatorGuid, mandatoryAttributes *mandatoryObjectAttributes) *kubernetesLogsAggregatorObjectAttributesProviderImpl {
 	return &kubernetesLogsAggregatorObjectAttributesProviderImpl{
-		logsAggregatorGuid: logsAggregatorGuid,
+		logsAggregatorGuid:  logsAggregatorGuid,
+		mandatoryAttributes: mandatoryAttributes,
 	}
 }
 
@@ -51,7 +53,7 @@ func (provider *kubernetesLogsAggregatorObjectAttributesProviderImpl) ForLogsAgg
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -71,7 +73,7 @@ func (provider *kubernetesLogsAggregatorObjectAttributesProviderImpl) ForLogsAgg
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -91,7 +93,7 @@ func (provider *kubernetesLogsAggregatorObjectAttributesProviderImpl) ForLogsAgg
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -111,7 +113,7 @@ func (provider *kubernetesLogsAggregatorObjectAttributesProviderImpl) ForLogsAgg
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -30,16 +30,18 @@ type KubernetesLogsCollectorObjectAttributesProvider interface {
 }
 
 func GetKubernetesLogsCollectorObjectAttributesProvider(logsCollectorGuid logs_collector.LogsCollectorGuid) KubernetesLogsCollectorObjectAttributesProvider {
-	return newKubernetesLogsCollectorObjectAttributesProvider(logsCollectorGuid)
+	return newKubernetesLogsCollectorObjectAttributesProvider(logsCollectorGuid, newEmptyMandatoryObjectAttributes())
 }
 
 type kubernetesLogsCollectorObjectAttributesProviderImpl struct {
-	logsCollectorGuid logs_collector.LogsCollectorGuid
+	logsCollectorGuid   logs_collector.LogsCollectorGuid
+	mandatoryAttributes *mandatoryObjectAttributes
 }
 
-func newKubernetesLogsCollectorObjectAttributesProvider(logsCollectorGuid logs_collector.LogsCollectorGuid) *kubernetesLogsCollectorObjectAttributesProviderImpl {
+func newKubernetesLogsCollectorObjectAttributesProvider(logsCollectorGuid logs_collector.LogsCollectorGuid, mandatoryAttributes *mandatoryObjectAttributes) *kubernetesLogsCollectorObjectAttributesProviderImpl {
 	return &kubernetesLogsCollectorObjectAttributesProviderImpl{
-		logsCollectorGuid: logsCollectorGuid,
+		logsCollectorGuid:   logsCollectorGuid,
+		mandatoryAttributes: mandatoryAttributes,
 	}
 }
 
@@ -55,7 +57,7 @@ func (provider *kubernetesLogsCollectorObjectAttributesProviderImpl) ForLogsColl
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -75,7 +77,7 @@ func (provider *kubernetesLogsCollectorObjectAttributesProviderImpl) ForLogsColl
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -95,7 +97,7 @@ func (provider *kubernetesLogsCollectorObjectAttributesProviderImpl) ForLogsColl
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -115,7 +117,7 @@ func (provider *kubernetesLogsCollectorObjectAttributesProviderImpl) ForLogsColl
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -135,7 +137,7 @@ func (provider *kubernetesLogsCollectorObjectAttributesProviderImpl) ForLogsColl
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -155,7 +157,7 @@ func (provider *kubernetesLogsCollectorObjectAttributesProviderImpl) ForLogsColl
 
 	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
 
-	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations, provider.mandatoryAttributes)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
 			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
@@ -0,0 +1,93 @@
+package object_attributes_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Labels and annotations that get attached to every object Kurtosis creates on top of its own ones, e.g. so that an
+// organization can enforce its labeling conventions (cost center, owner, etc.) on the cluster
+type mandatoryObjectAttributes struct {
+	labels      map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue
+	annotations map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue
+}
+
+func newMandatoryObjectAttributes(labels map[string]string, annotations map[string]string) (*mandatoryObjectAttributes, error) {
+	mandatoryLabels := map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{}
+	for keyStr, valueStr := range labels {
+		key, err := kubernetes_label_key.CreateNewKubernetesMandatoryLabelKey(keyStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the key of mandatory label '%v'", keyStr)
+		}
+		value, err := kubernetes_label_value.CreateNewKubernetesLabelValue(valueStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the value of mandatory label '%v'", keyStr)
+		}
+		mandatoryLabels[key] = value
+	}
+
+	mandatoryAnnotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+	for keyStr, valueStr := range annotations {
+		key, err := kubernetes_annotation_key.CreateNewKubernetesMandatoryAnnotationKey(keyStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the key of mandatory annotation '%v'", keyStr)
+		}
+		value, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(valueStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the value of mandatory annotation '%v'", keyStr)
+		}
+		mandatoryAnnotations[key] = value
+	}
+
+	return &mandatoryObjectAttributes{
+		labels:      mandatoryLabels,
+		annotations: mandatoryAnnotations,
+	}, nil
+}
+
+func newEmptyMandatoryObjectAttributes() *mandatoryObjectAttributes {
+	return &mandatoryObjectAttributes{
+		labels:      map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{},
+		annotations: map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{},
+	}
+}
+
+// addTo returns the given labels and annotations with the mandatory ones added; the labels and annotations Kurtosis sets
+// itself take precedence so that a mandatory attribute never changes how Kurtosis finds its objects
+func (attrs *mandatoryObjectAttributes) addTo(
+	labels map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue,
+	annotations map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue,
+) (
+	map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue,
+	map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue,
+) {
+	usedLabelKeys := map[string]bool{}
+	resultLabels := map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{}
+	for key, value := range labels {
+		usedLabelKeys[key.GetString()] = true
+		resultLabels[key] = value
+	}
+	for key, value := range attrs.labels {
+		if usedLabelKeys[key.GetString()] {
+			continue
+		}
+		resultLabels[key] = value
+	}
+
+	usedAnnotationKeys := map[string]bool{}
+	resultAnnotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+	for key, value := range annotations {
+		usedAnnotationKeys[key.GetString()] = true
+		resultAnnotations[key] = value
+	}
+	for key, value := range attrs.annotations {
+		if usedAnnotationKeys[key.GetString()] {
+			continue
+		}
+		resultAnnotations[key] = value
+	}
+	return resultLabels, resultAnnotations
+}
@@ -30,7 +30,7 @@ type kubernetesObjectAttributesImpl struct {
 	customAnnotations map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue
 }
 
-func newKubernetesObjectAttributesImpl(name *kubernetes_object_name.KubernetesObjectName, customLabels map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, customAnnotations map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue) (*kubernetesObjectAttributesImpl, error) {
+func newKubernetesObjectAttributesImpl(name *kubernetes_object_name.KubernetesObjectName, customLabels map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, customAnnotations map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue, mandatoryAttributes *mandatoryObjectAttributes) (*kubernetesObjectAttributesImpl, error) {
 	globalLabelsStrs := map[string]string{}
 	for globalKey, globalValue := range globalLabels {
 		globalLabelsStrs[globalKey.GetString()] = globalValue.GetString()
@@ -41,10 +41,13 @@ func newKubernetesObjectAttributesImpl(name *kubernetes_object_name.KubernetesOb
 		}
 	}
 
+	// Mandatory label keys can't be under the Kurtosis domain so they never collide with the global labels
+	labelsWithMandatoryOnes, annotationsWithMandatoryOnes := mandatoryAttributes.addTo(customLabels, customAnnotations)
+
 	return &kubernetesObjectAttributesImpl{
 		name:              name,
-		customLabels:      customLabels,
-		customAnnotations: customAnnotations,
+		customLabels:      labelsWithMandatoryOnes,
+		customAnnotations: annotationsWithMandatoryOnes,
 	}, nil
 }
 
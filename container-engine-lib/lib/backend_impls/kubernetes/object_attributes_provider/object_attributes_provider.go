@@ -18,30 +18,48 @@ type KubernetesObjectAttributesProvider interface {
 }
 
 func GetKubernetesObjectAttributesProvider() KubernetesObjectAttributesProvider {
-	return newKubernetesObjectAttributesProviderImpl()
+	return newKubernetesObjectAttributesProviderImpl(newEmptyMandatoryObjectAttributes())
+}
+
+// GetKubernetesObjectAttributesProviderWithMandatoryAttributes returns a provider that adds the given labels and
+// annotations to every object Kurtosis creates, including the logs aggregator and collector ones; their keys can't be
+// under the Kurtosis domain
+func GetKubernetesObjectAttributesProviderWithMandatoryAttributes(
+	mandatoryLabels map[string]string,
+	mandatoryAnnotations map[string]string,
+) (KubernetesObjectAttributesProvider, error) {
+	mandatoryAttributes, err := newMandatoryObjectAttributes(mandatoryLabels, mandatoryAnnotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the mandatory labels '%+v' and annotations '%+v'", mandatoryLabels, mandatoryAnnotations)
+	}
+	return newKubernetesObjectAttributesProviderImpl(mandatoryAttributes), nil
 }
 
 // Private so it can't be instantiated
-type kubernetesObjectAttributesProviderImpl struct{}
+type kubernetesObjectAttributesProviderImpl struct {
+	mandatoryAttributes *mandatoryObjectAttributes
+}
 
-func newKubernetesObjectAttributesProviderImpl() *kubernetesObjectAttributesProviderImpl {
-	return &kubernetesObjectAttributesProviderImpl{}
+func newKubernetesObjectAttributesProviderImpl(mandatoryAttributes *mandatoryObjectAttributes) *kubernetesObjectAttributesProviderImpl {
+	return &kubernetesObjectAttributesProviderImpl{
+		mandatoryAttributes: mandatoryAttributes,
+	}
 }
 
 func (provider *kubernetesObjectAttributesProviderImpl) ForEngine(engineGuid engine.EngineGUID) KubernetesEngineObjectAttributesProvider {
-	return GetKubernetesEngineObjectAttributesProvider(engineGuid)
+	return newKubernetesEngineObjectAttributesProviderImpl(engineGuid, provider.mandatoryAttributes)
 }
 
 func (provider *kubernetesObjectAttributesProviderImpl) ForEnclave(enclaveId enclave.EnclaveUUID) KubernetesEnclaveObjectAttributesProvider {
-	return GetKubernetesEnclaveObjectAttributesProvider(enclaveId)
+	return newKubernetesEnclaveObjectAttributesProviderImpl(enclaveId, provider.mandatoryAttributes)
 }
 
 func (provider *kubernetesObjectAttributesProviderImpl) ForLogsCollector(logsCollectorGuid logs_collector.LogsCollectorGuid) KubernetesLogsCollectorObjectAttributesProvider {
-	return GetKubernetesLogsCollectorObjectAttributesProvider(logsCollectorGuid)
+	return newKubernetesLogsCollectorObjectAttributesProvider(logsCollectorGuid, provider.mandatoryAttributes)
 }
 
 func (provider *kubernetesObjectAttributesProviderImpl) ForLogsAggregator(logsAggregatorGuid logs_aggregator.LogsAggregatorGuid) KubernetesLogsAggregatorObjectAttributesProvider {
-	return GetKubernetesLogsAggregatorObjectAttributesProvider(logsAggregatorGuid)
+	return newKubernetesLogsAggregatorObjectAttributesProvider(logsAggregatorGuid, provider.mandatoryAttributes)
 }
 
 // Gets the name for an enclave object, making sure to put the enclave ID first and join using the standardized separator
@@ -0,0 +1,69 @@
+package object_attributes_provider
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMandatoryLabelKey        = "example.com/cost-center"
+	testMandatoryLabelValue      = "engineering"
+	testMandatoryAnnotationKey   = "example.com/owner"
+	testMandatoryAnnotationValue = "platform-team"
+
+	testEnclaveUuid       = enclave.EnclaveUUID("65d2fb6d673249b8b4a91a2f4ae616de")
+	testLogsCollectorGuid = logs_collector.LogsCollectorGuid("logs-collector-guid")
+)
+
+func TestMandatoryAttributesAreAddedToEveryObject(t *testing.T) {
+	provider, err := GetKubernetesObjectAttributesProviderWithMandatoryAttributes(
+		map[string]string{testMandatoryLabelKey: testMandatoryLabelValue},
+		map[string]string{testMandatoryAnnotationKey: testMandatoryAnnotationValue},
+	)
+	require.NoError(t, err)
+
+	daemonSetAttrs, err := provider.ForLogsCollector(testLogsCollectorGuid).ForLogsCollectorDaemonSet()
+	require.NoError(t, err)
+	requireHasMandatoryAttributes(t, daemonSetAttrs)
+
+	apiContainerPodAttrs, err := provider.ForEnclave(testEnclaveUuid).ForApiContainer().ForApiContainerPod()
+	require.NoError(t, err)
+	requireHasMandatoryAttributes(t, apiContainerPodAttrs)
+	require.Equal(t, testEnclaveUuid, enclave.EnclaveUUID(getLabelsByKey(apiContainerPodAttrs)[kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString()]))
+}
+
+func TestMandatoryAttributesCantUseKurtosisDomain(t *testing.T) {
+	_, err := GetKubernetesObjectAttributesProviderWithMandatoryAttributes(
+		map[string]string{kubernetes_label_key.AppIDKubernetesLabelKey.GetString(): testMandatoryLabelValue},
+		nil,
+	)
+	require.Error(t, err)
+
+	_, err = GetKubernetesObjectAttributesProviderWithMandatoryAttributes(
+		nil,
+		map[string]string{"kurtosistech.com/owner": testMandatoryAnnotationValue},
+	)
+	require.Error(t, err)
+}
+
+func requireHasMandatoryAttributes(t *testing.T, attrs KubernetesObjectAttributes) {
+	require.Equal(t, testMandatoryLabelValue, getLabelsByKey(attrs)[testMandatoryLabelKey])
+
+	annotations := map[string]string{}
+	for key, value := range attrs.GetAnnotations() {
+		annotations[key.GetString()] = value.GetString()
+	}
+	require.Equal(t, testMandatoryAnnotationValue, annotations[testMandatoryAnnotationKey])
+}
+
+func getLabelsByKey(attrs KubernetesObjectAttributes) map[string]string {
+	labels := map[string]string{}
+	for key, value := range attrs.GetLabels() {
+		labels[key.GetString()] = value.GetString()
+	}
+	return labels
+}
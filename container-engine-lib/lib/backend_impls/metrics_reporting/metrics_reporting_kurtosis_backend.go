@@ -12,7 +12,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
@@ -20,7 +23,18 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/stacktrace"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// the spans cover the operations that are slow enough to be worth seeing in a trace, like creating containers
+	spanNamePrefix = "KurtosisBackend."
+
+	enclaveUuidAttributeKey = "kurtosis.enclave.uuid"
+	imageAttributeKey       = "kurtosis.image"
+	numberOfServicesKey     = "kurtosis.services.count"
 )
 
 // TODO CALL THE METRICS LIBRARY EVENT-REGISTRATION FUNCTIONS HERE!!!!
@@ -33,7 +47,9 @@ func NewMetricsReportingKurtosisBackend(underlying backend_interface.KurtosisBac
 }
 
 func (backend *MetricsReportingKurtosisBackend) FetchImage(ctx context.Context, image string, registrySpec *image_registry_spec.ImageRegistrySpec, downloadMode image_download_mode.ImageDownloadMode) (bool, string, error) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"FetchImage", attribute.String(imageAttributeKey, image))
 	pulledFromRemote, architecture, err := backend.underlying.FetchImage(ctx, image, registrySpec, downloadMode)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return false, "", stacktrace.Propagate(err, "An error occurred pulling image '%v'", image)
 	}
@@ -48,6 +64,14 @@ func (backend *MetricsReportingKurtosisBackend) PruneUnusedImages(ctx context.Co
 	return prunedImages, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) ListUnusedImages(ctx context.Context) ([]string, error) {
+	unusedImages, err := backend.underlying.ListUnusedImages(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing unused images")
+	}
+	return unusedImages, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 	ctx context.Context,
 	imageOrgAndRepo string,
@@ -58,9 +82,14 @@ func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 	githubAuthToken string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) (*engine.Engine, error) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"CreateEngine", attribute.String(imageAttributeKey, imageOrgAndRepo+":"+imageVersionTag))
 	result, err := backend.underlying.CreateEngine(
 		ctx,
 		imageOrgAndRepo,
@@ -71,9 +100,14 @@ func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 		githubAuthToken,
 		sinks,
 		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
 	)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the engine using image '%v' with tag '%v' and debug mode '%v'", imageOrgAndRepo, imageVersionTag, shouldStartInDebugMode)
 	}
@@ -128,7 +162,9 @@ func (backend *MetricsReportingKurtosisBackend) DumpKurtosis(ctx context.Context
 }
 
 func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"CreateEnclave", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)))
 	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v'", enclaveUuid)
 	}
@@ -163,6 +199,20 @@ func (backend *MetricsReportingKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CreateEnclaveQuota(ctx context.Context, enclaveUuid enclave.EnclaveUUID, quota enclave_quota.EnclaveQuota) error {
+	if err := backend.underlying.CreateEnclaveQuota(ctx, enclaveUuid, quota); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating quota '%+v' for enclave with UUID '%v'", quota, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CreateEnclaveEgressPolicy(ctx context.Context, enclaveUuid enclave.EnclaveUUID, egressPolicy *egress_policy.EgressPolicy) error {
+	if err := backend.underlying.CreateEnclaveEgressPolicy(ctx, enclaveUuid, egressPolicy); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating egress policy '%+v' for enclave with UUID '%v'", egressPolicy, enclaveUuid)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -197,7 +247,9 @@ func (backend *MetricsReportingKurtosisBackend) DestroyEnclaves(
 	erroredEnclaveIds map[enclave.EnclaveUUID]error,
 	resultErr error,
 ) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"DestroyEnclaves")
 	successes, failures, err := backend.underlying.DestroyEnclaves(ctx, filters)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying enclaves using filters: %+v", filters)
 	}
@@ -218,6 +270,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 		return nil, stacktrace.NewError("Requested own IP environment variable '%v' conflicts with custom environment variable", ownIpEnvVar)
 	}
 
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"CreateAPIContainer", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)), attribute.String(imageAttributeKey, image))
 	result, err := backend.underlying.CreateAPIContainer(
 		ctx,
 		image,
@@ -228,6 +281,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 		customEnvVars,
 		shouldStartInDebugMode,
 	)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		// WARNING: remember not to print 'customEnvVars' because it could end up creating a secret info leak
 		return nil, stacktrace.Propagate(
@@ -264,7 +318,9 @@ func (backend *MetricsReportingKurtosisBackend) DestroyAPIContainers(ctx context
 }
 
 func (backend *MetricsReportingKurtosisBackend) RegisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceName]bool) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"RegisterUserServices", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)), attribute.Int(numberOfServicesKey, len(services)))
 	successes, failures, err := backend.underlying.RegisterUserServices(ctx, enclaveUuid, services)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred registering services to enclave '%v' with the following service ids: %+v", enclaveUuid, services)
 	}
@@ -280,7 +336,9 @@ func (backend *MetricsReportingKurtosisBackend) UnregisterUserServices(ctx conte
 }
 
 func (backend *MetricsReportingKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"StartRegisteredUserServices", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)), attribute.Int(numberOfServicesKey, len(services)))
 	successes, failures, err := backend.underlying.StartRegisteredUserServices(ctx, enclaveUuid, services)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred starting services in enclave '%v' with the following service ids: %+v", enclaveUuid, services)
 	}
@@ -327,6 +385,42 @@ func (backend *MetricsReportingKurtosisBackend) GetUserServiceLogs(
 	return userServiceLogs, erroredUserServices, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetUserServiceResourceUsage(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ResourceUsage,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"GetUserServiceResourceUsage", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)))
+	userServiceResourceUsage, erroredUserServices, err := backend.underlying.GetUserServiceResourceUsage(ctx, enclaveUuid, filters)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the resource usage of the user services in enclave '%v' using filters '%+v'", enclaveUuid, filters)
+	}
+	return userServiceResourceUsage, erroredUserServices, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetUserServiceExitStates(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ExitState,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"GetUserServiceExitStates", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)))
+	userServiceExitStates, erroredUserServices, err := backend.underlying.GetUserServiceExitStates(ctx, enclaveUuid, filters)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the exit states of the user services in enclave '%v' using filters '%+v'", enclaveUuid, filters)
+	}
+	return userServiceExitStates, erroredUserServices, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -337,7 +431,9 @@ func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommands(
 	erroredUserServiceUuids map[service.ServiceUUID]error,
 	resultErr error,
 ) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"RunUserServiceExecCommands", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)), attribute.Int(numberOfServicesKey, len(userServiceCommands)))
 	successfulUserServiceExecResults, erroredUserServiceUuids, err := backend.underlying.RunUserServiceExecCommands(ctx, enclaveUuid, containerUser, userServiceCommands)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(
 			err,
@@ -358,12 +454,12 @@ func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommandWithStr
 	return backend.underlying.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd)
 }
 
-func (backend *MetricsReportingKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error) {
-	err := backend.underlying.GetShellOnUserService(ctx, enclaveUuid, serviceUuid)
+func (backend *MetricsReportingKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions) (isSessionDisconnected bool, resultErr error) {
+	isSessionDisconnected, err := backend.underlying.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, shellOptions)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting connection with user service with UUID '%v'", serviceUuid)
+		return false, stacktrace.Propagate(err, "An error occurred getting connection with user service with UUID '%v'", serviceUuid)
 	}
-	return nil
+	return isSessionDisconnected, nil
 }
 
 func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
@@ -385,6 +481,37 @@ func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpath string,
+	tarContent io.Reader,
+) error {
+	if err := backend.underlying.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpath, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files to '%v' in user service with UUID '%v' in enclave with UUID '%v'",
+			dstDirpath,
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CopyFilesFromImage(
+	ctx context.Context,
+	image string,
+	srcPathOnImage string,
+	output io.Writer,
+) error {
+	if err := backend.underlying.CopyFilesFromImage(ctx, image, srcPathOnImage, output); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying files from sourcepath '%v' in image '%v'", srcPathOnImage, image)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -394,7 +521,9 @@ func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	erroredUserServiceUuids map[service.ServiceUUID]error,
 	resultErr error,
 ) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"StopUserServices", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)))
 	successes, failures, err := backend.underlying.StopUserServices(ctx, enclaveUuid, filters)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred stopping user services in enclave '%v' using filters: %+v", enclaveUuid, filters)
 	}
@@ -410,7 +539,9 @@ func (backend *MetricsReportingKurtosisBackend) DestroyUserServices(
 	erroredUserServiceUuids map[service.ServiceUUID]error,
 	resultErr error,
 ) {
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"DestroyUserServices", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)))
 	successes, failures, err := backend.underlying.DestroyUserServices(ctx, enclaveUuid, filters)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying user services using filters: %+v", filters)
 	}
@@ -429,6 +560,10 @@ func (backend *MetricsReportingKurtosisBackend) DestroyLogsAggregator(ctx contex
 	return backend.underlying.DestroyLogsAggregator(ctx)
 }
 
+func (backend *MetricsReportingKurtosisBackend) UpdateLogsAggregatorSinks(ctx context.Context, sinks logs_aggregator.Sinks, shouldEnablePersistentVolumeLogsCollection bool, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig) error {
+	return backend.underlying.UpdateLogsAggregatorSinks(ctx, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig)
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateLogsCollectorForEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -436,11 +571,16 @@ func (backend *MetricsReportingKurtosisBackend) CreateLogsCollectorForEnclave(
 	logsCollectorTcpPortNumber uint16,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) (
 	*logs_collector.LogsCollector,
 	error,
 ) {
-	logsCollector, err := backend.underlying.CreateLogsCollectorForEnclave(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers)
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"CreateLogsCollectorForEnclave", attribute.String(enclaveUuidAttributeKey, string(enclaveUuid)))
+	logsCollector, err := backend.underlying.CreateLogsCollectorForEnclave(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector with TCP port number '%v' and HTTP port number '%v'", logsCollectorTcpPortNumber, logsCollectorHttpPortNumber)
 	}
@@ -458,6 +598,14 @@ func (backend *MetricsReportingKurtosisBackend) GetLogsCollectorForEnclave(ctx c
 	return maybeLogsCollector, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error) {
+	logsCollectorStatuses, err := backend.underlying.GetLogsCollectorStatuses(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the statuses of the logs collectors")
+	}
+	return logsCollectorStatuses, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
 
 	if err := backend.underlying.DestroyLogsCollectorForEnclave(ctx, enclaveUuid); err != nil {
@@ -467,6 +615,13 @@ func (backend *MetricsReportingKurtosisBackend) DestroyLogsCollectorForEnclave(c
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) UpdateLogsCollectorFiltersForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters []logs_collector.Filter) error {
+	if err := backend.underlying.UpdateLogsCollectorFiltersForEnclave(ctx, enclaveUuid, filters); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the logs collector filters of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateReverseProxy(ctx context.Context, engineGuid engine.EngineGUID) (*reverse_proxy.ReverseProxy, error) {
 	return backend.underlying.CreateReverseProxy(ctx, engineGuid)
 }
@@ -488,9 +643,15 @@ func (backend *MetricsReportingKurtosisBackend) GetAvailableCPUAndMemory(ctx con
 }
 
 func (backend *MetricsReportingKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
-	return backend.underlying.BuildImage(ctx, imageName, imageBuildSpec)
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"BuildImage", attribute.String(imageAttributeKey, imageName))
+	architecture, err := backend.underlying.BuildImage(ctx, imageName, imageBuildSpec)
+	tracing.EndSpan(span, err)
+	return architecture, err
 }
 
 func (backend *MetricsReportingKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
-	return backend.underlying.NixBuild(ctx, nixBuildSpec)
+	ctx, span := tracing.StartSpan(ctx, spanNamePrefix+"NixBuild")
+	imageName, err := backend.underlying.NixBuild(ctx, nixBuildSpec)
+	tracing.EndSpan(span, err)
+	return imageName, err
 }
@@ -0,0 +1,597 @@
+package multi_cluster
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// MultiClusterKurtosisBackend spreads the enclaves over several clusters, e.g. when a single cluster can't hold all the
+// enclaves of a CI. Each enclave lives entirely in one cluster: it's created in the cluster set with WithTargetCluster
+// or else in the one with the fewest enclaves, and every call about it goes to that cluster. The calls that match
+// enclaves through filters, like listing or destroying them, go to all the clusters, while the engine, the logs
+// aggregator, the reverse proxy and the images live in the default cluster.
+type MultiClusterKurtosisBackend struct {
+	defaultClusterName string
+
+	backendsByCluster map[string]backend_interface.KurtosisBackend
+
+	// The clusters sorted by name, so that the calls going to every cluster and the placement ties are deterministic
+	clusterNames []string
+
+	mutex *sync.Mutex
+
+	// Which cluster each known enclave lives in, so that the enclave calls don't have to look for it every time
+	enclaveClusters map[enclave.EnclaveUUID]string
+}
+
+func NewMultiClusterKurtosisBackend(
+	defaultClusterName string,
+	backendsByCluster map[string]backend_interface.KurtosisBackend,
+) (*MultiClusterKurtosisBackend, error) {
+	if _, found := backendsByCluster[defaultClusterName]; !found {
+		return nil, stacktrace.NewError("The default cluster '%v' isn't one of the clusters of the backend", defaultClusterName)
+	}
+	clusterNames := []string{}
+	for clusterName := range backendsByCluster {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+	return &MultiClusterKurtosisBackend{
+		defaultClusterName: defaultClusterName,
+		backendsByCluster:  backendsByCluster,
+		clusterNames:       clusterNames,
+		mutex:              &sync.Mutex{},
+		enclaveClusters:    map[enclave.EnclaveUUID]string{},
+	}, nil
+}
+
+// GetClusterNames returns the names of the clusters the enclaves can be created in, sorted
+func (backend *MultiClusterKurtosisBackend) GetClusterNames() []string {
+	clusterNames := make([]string, len(backend.clusterNames))
+	copy(clusterNames, backend.clusterNames)
+	return clusterNames
+}
+
+// GetKnownEnclaveCluster returns the cluster the enclave lives in, if the backend came across the enclave already,
+// i.e. created it or listed it
+func (backend *MultiClusterKurtosisBackend) GetKnownEnclaveCluster(enclaveUuid enclave.EnclaveUUID) (string, bool) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	clusterName, found := backend.enclaveClusters[enclaveUuid]
+	return clusterName, found
+}
+
+func (backend *MultiClusterKurtosisBackend) FetchImage(ctx context.Context, image string, registrySpec *image_registry_spec.ImageRegistrySpec, downloadMode image_download_mode.ImageDownloadMode) (bool, string, error) {
+	return backend.getDefaultBackend().FetchImage(ctx, image, registrySpec, downloadMode)
+}
+
+func (backend *MultiClusterKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
+	return backend.getDefaultBackend().PruneUnusedImages(ctx)
+}
+
+func (backend *MultiClusterKurtosisBackend) ListUnusedImages(ctx context.Context) ([]string, error) {
+	return backend.getDefaultBackend().ListUnusedImages(ctx)
+}
+
+func (backend *MultiClusterKurtosisBackend) CreateEngine(
+	ctx context.Context,
+	imageOrgAndRepo string,
+	imageVersionTag string,
+	grpcPortNum uint16,
+	envVars map[string]string,
+	shouldStartInDebugMode bool,
+	githubAuthToken string,
+	sinks logs_aggregator.Sinks,
+	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
+	logsCollectorFilters []logs_collector.Filter,
+	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+) (*engine.Engine, error) {
+	return backend.getDefaultBackend().CreateEngine(
+		ctx,
+		imageOrgAndRepo,
+		imageVersionTag,
+		grpcPortNum,
+		envVars,
+		shouldStartInDebugMode,
+		githubAuthToken,
+		sinks,
+		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
+		logsCollectorFilters,
+		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
+	)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]*engine.Engine, error) {
+	return backend.getDefaultBackend().GetEngines(ctx, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) StopEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]bool, map[engine.EngineGUID]error, error) {
+	return backend.getDefaultBackend().StopEngines(ctx, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) DestroyEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]bool, map[engine.EngineGUID]error, error) {
+	return backend.getDefaultBackend().DestroyEngines(ctx, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetEngineLogs(ctx context.Context, outputDirpath string) error {
+	return backend.getDefaultBackend().GetEngineLogs(ctx, outputDirpath)
+}
+
+func (backend *MultiClusterKurtosisBackend) DumpKurtosis(ctx context.Context, outputDirpath string) error {
+	return backend.getDefaultBackend().DumpKurtosis(ctx, outputDirpath)
+}
+
+func (backend *MultiClusterKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+	clusterName, err := backend.getClusterForNewEnclave(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred choosing the cluster to create enclave '%v' in", enclaveName)
+	}
+	newEnclave, err := backend.backendsByCluster[clusterName].CreateEnclave(ctx, enclaveUuid, enclaveName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v' in cluster '%v'", enclaveName, clusterName)
+	}
+	backend.setEnclaveCluster(enclaveUuid, clusterName)
+	return newEnclave, nil
+}
+
+func (backend *MultiClusterKurtosisBackend) UpdateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, newName string, creationTime *time.Time) error {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return err // already wrapped with propagate
+	}
+	return enclaveBackend.UpdateEnclave(ctx, enclaveUuid, newName, creationTime)
+}
+
+func (backend *MultiClusterKurtosisBackend) CreateEnclaveQuota(ctx context.Context, enclaveUuid enclave.EnclaveUUID, quota enclave_quota.EnclaveQuota) error {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return err // already wrapped with propagate
+	}
+	return enclaveBackend.CreateEnclaveQuota(ctx, enclaveUuid, quota)
+}
+
+func (backend *MultiClusterKurtosisBackend) CreateEnclaveEgressPolicy(ctx context.Context, enclaveUuid enclave.EnclaveUUID, egressPolicy *egress_policy.EgressPolicy) error {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return err // already wrapped with propagate
+	}
+	return enclaveBackend.CreateEnclaveEgressPolicy(ctx, enclaveUuid, egressPolicy)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.Enclave, error) {
+	result := map[enclave.EnclaveUUID]*enclave.Enclave{}
+	for _, clusterName := range backend.clusterNames {
+		enclaves, err := backend.backendsByCluster[clusterName].GetEnclaves(ctx, filters)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves of cluster '%v'", clusterName)
+		}
+		for enclaveUuid, enclaveObj := range enclaves {
+			backend.setEnclaveCluster(enclaveUuid, clusterName)
+			result[enclaveUuid] = enclaveObj
+		}
+	}
+	return result, nil
+}
+
+func (backend *MultiClusterKurtosisBackend) StopEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	return runOnEveryCluster(backend, "stopping the enclaves", func(clusterBackend backend_interface.KurtosisBackend) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+		return clusterBackend.StopEnclaves(ctx, filters)
+	})
+}
+
+func (backend *MultiClusterKurtosisBackend) DumpEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string) error {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return err // already wrapped with propagate
+	}
+	return enclaveBackend.DumpEnclave(ctx, enclaveUuid, outputDirpath)
+}
+
+func (backend *MultiClusterKurtosisBackend) DestroyEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	successfulEnclaveUuids, erroredEnclaveUuids, err := runOnEveryCluster(backend, "destroying the enclaves", func(clusterBackend backend_interface.KurtosisBackend) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+		return clusterBackend.DestroyEnclaves(ctx, filters)
+	})
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	for enclaveUuid := range successfulEnclaveUuids {
+		delete(backend.enclaveClusters, enclaveUuid)
+	}
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
+func (backend *MultiClusterKurtosisBackend) CreateAPIContainer(
+	ctx context.Context,
+	image string,
+	enclaveUuid enclave.EnclaveUUID,
+	grpcPortNum uint16,
+	enclaveDataVolumeDirpath string,
+	ownIpAddressEnvVar string,
+	customEnvVars map[string]string,
+	shouldStartInDebugMode bool,
+) (*api_container.APIContainer, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.CreateAPIContainer(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, shouldStartInDebugMode)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]*api_container.APIContainer, error) {
+	result := map[enclave.EnclaveUUID]*api_container.APIContainer{}
+	for _, clusterName := range backend.clusterNames {
+		apiContainers, err := backend.backendsByCluster[clusterName].GetAPIContainers(ctx, filters)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the API containers of cluster '%v'", clusterName)
+		}
+		for enclaveUuid, apiContainer := range apiContainers {
+			result[enclaveUuid] = apiContainer
+		}
+	}
+	return result, nil
+}
+
+func (backend *MultiClusterKurtosisBackend) StopAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	return runOnEveryCluster(backend, "stopping the API containers", func(clusterBackend backend_interface.KurtosisBackend) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+		return clusterBackend.StopAPIContainers(ctx, filters)
+	})
+}
+
+func (backend *MultiClusterKurtosisBackend) DestroyAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	return runOnEveryCluster(backend, "destroying the API containers", func(clusterBackend backend_interface.KurtosisBackend) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+		return clusterBackend.DestroyAPIContainers(ctx, filters)
+	})
+}
+
+func (backend *MultiClusterKurtosisBackend) RegisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceName]bool) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.RegisterUserServices(ctx, enclaveUuid, services)
+}
+
+func (backend *MultiClusterKurtosisBackend) UnregisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]bool) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.UnregisterUserServices(ctx, enclaveUuid, services)
+}
+
+func (backend *MultiClusterKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.StartRegisteredUserServices(ctx, enclaveUuid, services)
+}
+
+func (backend *MultiClusterKurtosisBackend) RemoveRegisteredUserServiceProcesses(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]bool) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.RemoveRegisteredUserServiceProcesses(ctx, enclaveUuid, services)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.Service, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.GetUserServices(ctx, enclaveUuid, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetUserServiceLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetUserServiceResourceUsage(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.ResourceUsage, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.GetUserServiceResourceUsage(ctx, enclaveUuid, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetUserServiceExitStates(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.ExitState, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.GetUserServiceExitStates(ctx, enclaveUuid, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) RunUserServiceExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, containerUser string, userServiceCommands map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.RunUserServiceExecCommands(ctx, enclaveUuid, containerUser, userServiceCommands)
+}
+
+func (backend *MultiClusterKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string) (chan string, chan *exec_result.ExecResult, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions) (bool, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return false, err // already wrapped with propagate
+	}
+	return enclaveBackend.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, shellOptions)
+}
+
+func (backend *MultiClusterKurtosisBackend) CopyFilesFromUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, srcPathOnService string, output io.Writer) error {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return err // already wrapped with propagate
+	}
+	return enclaveBackend.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
+}
+
+func (backend *MultiClusterKurtosisBackend) CopyFilesToUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader) error {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return err // already wrapped with propagate
+	}
+	return enclaveBackend.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+}
+
+func (backend *MultiClusterKurtosisBackend) CopyFilesFromImage(ctx context.Context, image string, srcPathOnImage string, output io.Writer) error {
+	return backend.getDefaultBackend().CopyFilesFromImage(ctx, image, srcPathOnImage, output)
+}
+
+func (backend *MultiClusterKurtosisBackend) StopUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.StopUserServices(ctx, enclaveUuid, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.DestroyUserServices(ctx, enclaveUuid, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) CreateLogsAggregator(ctx context.Context, httpPortNum uint16, sinks logs_aggregator.Sinks) (*logs_aggregator.LogsAggregator, error) {
+	return backend.getDefaultBackend().CreateLogsAggregator(ctx, httpPortNum, sinks)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
+	return backend.getDefaultBackend().GetLogsAggregator(ctx)
+}
+
+func (backend *MultiClusterKurtosisBackend) DestroyLogsAggregator(ctx context.Context) error {
+	return backend.getDefaultBackend().DestroyLogsAggregator(ctx)
+}
+
+func (backend *MultiClusterKurtosisBackend) UpdateLogsAggregatorSinks(ctx context.Context, sinks logs_aggregator.Sinks, shouldEnablePersistentVolumeLogsCollection bool, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig) error {
+	return backend.getDefaultBackend().UpdateLogsAggregatorSinks(ctx, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig)
+}
+
+func (backend *MultiClusterKurtosisBackend) CreateLogsCollectorForEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	logsCollectorHttpPortNumber uint16,
+	logsCollectorTcpPortNumber uint16,
+	logsCollectorFilters []logs_collector.Filter,
+	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+) (*logs_collector.LogsCollector, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.CreateLogsCollectorForEnclave(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*logs_collector.LogsCollector, error) {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, err // already wrapped with propagate
+	}
+	return enclaveBackend.GetLogsCollectorForEnclave(ctx, enclaveUuid)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error) {
+	result := map[string]container.ContainerStatus{}
+	for _, clusterName := range backend.clusterNames {
+		statuses, err := backend.backendsByCluster[clusterName].GetLogsCollectorStatuses(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector statuses of cluster '%v'", clusterName)
+		}
+		for logsCollectorName, status := range statuses {
+			result[logsCollectorName] = status
+		}
+	}
+	return result, nil
+}
+
+func (backend *MultiClusterKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return err // already wrapped with propagate
+	}
+	return enclaveBackend.DestroyLogsCollectorForEnclave(ctx, enclaveUuid)
+}
+
+func (backend *MultiClusterKurtosisBackend) UpdateLogsCollectorFiltersForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters []logs_collector.Filter) error {
+	enclaveBackend, err := backend.getBackendForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return err // already wrapped with propagate
+	}
+	return enclaveBackend.UpdateLogsCollectorFiltersForEnclave(ctx, enclaveUuid, filters)
+}
+
+func (backend *MultiClusterKurtosisBackend) CreateReverseProxy(ctx context.Context, engineGuid engine.EngineGUID) (*reverse_proxy.ReverseProxy, error) {
+	return backend.getDefaultBackend().CreateReverseProxy(ctx, engineGuid)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetReverseProxy(ctx context.Context) (*reverse_proxy.ReverseProxy, error) {
+	return backend.getDefaultBackend().GetReverseProxy(ctx)
+}
+
+func (backend *MultiClusterKurtosisBackend) DestroyReverseProxy(ctx context.Context) error {
+	return backend.getDefaultBackend().DestroyReverseProxy(ctx)
+}
+
+func (backend *MultiClusterKurtosisBackend) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, bool, error) {
+	return backend.getDefaultBackend().GetAvailableCPUAndMemory(ctx)
+}
+
+func (backend *MultiClusterKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
+	return backend.getDefaultBackend().BuildImage(ctx, imageName, imageBuildSpec)
+}
+
+func (backend *MultiClusterKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
+	return backend.getDefaultBackend().NixBuild(ctx, nixBuildSpec)
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func (backend *MultiClusterKurtosisBackend) getDefaultBackend() backend_interface.KurtosisBackend {
+	return backend.backendsByCluster[backend.defaultClusterName]
+}
+
+func (backend *MultiClusterKurtosisBackend) setEnclaveCluster(enclaveUuid enclave.EnclaveUUID, clusterName string) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.enclaveClusters[enclaveUuid] = clusterName
+}
+
+// getBackendForEnclave returns the backend of the cluster the enclave lives in, looking for the enclave in every cluster
+// if the backend hasn't come across it yet, e.g. because the engine restarted
+func (backend *MultiClusterKurtosisBackend) getBackendForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (backend_interface.KurtosisBackend, error) {
+	if clusterName, found := backend.GetKnownEnclaveCluster(enclaveUuid); found {
+		return backend.backendsByCluster[clusterName], nil
+	}
+
+	filters := &enclave.EnclaveFilters{
+		UUIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		Statuses: nil,
+	}
+	for _, clusterName := range backend.clusterNames {
+		clusterBackend := backend.backendsByCluster[clusterName]
+		enclaves, err := clusterBackend.GetEnclaves(ctx, filters)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred looking for enclave '%v' in cluster '%v'", enclaveUuid, clusterName)
+		}
+		if _, found := enclaves[enclaveUuid]; found {
+			backend.setEnclaveCluster(enclaveUuid, clusterName)
+			return clusterBackend, nil
+		}
+	}
+	return nil, stacktrace.NewError("Enclave '%v' wasn't found in any of the clusters '%v'", enclaveUuid, backend.clusterNames)
+}
+
+// getClusterForNewEnclave returns the cluster set in the context if any, or else the cluster with the fewest enclaves,
+// the default cluster winning the ties
+func (backend *MultiClusterKurtosisBackend) getClusterForNewEnclave(ctx context.Context) (string, error) {
+	if clusterName, found := getTargetCluster(ctx); found {
+		if _, isKnownCluster := backend.backendsByCluster[clusterName]; !isKnownCluster {
+			return "", stacktrace.NewError("Cluster '%v' isn't one of the clusters '%v' the enclaves can be created in", clusterName, backend.clusterNames)
+		}
+		return clusterName, nil
+	}
+
+	allEnclavesFilters := &enclave.EnclaveFilters{
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+	chosenClusterName := backend.defaultClusterName
+	defaultClusterEnclaves, err := backend.getDefaultBackend().GetEnclaves(ctx, allEnclavesFilters)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred counting the enclaves of cluster '%v'", backend.defaultClusterName)
+	}
+	fewestEnclaves := len(defaultClusterEnclaves)
+	for _, clusterName := range backend.clusterNames {
+		if clusterName == backend.defaultClusterName {
+			continue
+		}
+		enclaves, err := backend.backendsByCluster[clusterName].GetEnclaves(ctx, allEnclavesFilters)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred counting the enclaves of cluster '%v'", clusterName)
+		}
+		if len(enclaves) < fewestEnclaves {
+			chosenClusterName = clusterName
+			fewestEnclaves = len(enclaves)
+		}
+	}
+	return chosenClusterName, nil
+}
+
+// runOnEveryCluster runs an operation matching objects through filters on every cluster and merges the results
+func runOnEveryCluster[K comparable](
+	backend *MultiClusterKurtosisBackend,
+	operationDescription string,
+	operation func(clusterBackend backend_interface.KurtosisBackend) (map[K]bool, map[K]error, error),
+) (map[K]bool, map[K]error, error) {
+	successful := map[K]bool{}
+	errored := map[K]error{}
+	for _, clusterName := range backend.clusterNames {
+		clusterSuccessful, clusterErrored, err := operation(backend.backendsByCluster[clusterName])
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred %v of cluster '%v'", operationDescription, clusterName)
+		}
+		for key := range clusterSuccessful {
+			successful[key] = true
+		}
+		for key, keyErr := range clusterErrored {
+			errored[key] = keyErr
+		}
+	}
+	return successful, errored, nil
+}
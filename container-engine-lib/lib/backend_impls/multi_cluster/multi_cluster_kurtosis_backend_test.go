@@ -0,0 +1,123 @@
+package multi_cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultClusterName = "default-cluster"
+	otherClusterName   = "other-cluster"
+
+	testEnclaveUuid        = enclave.EnclaveUUID("test-enclave-uuid")
+	testEnclaveName        = "test-enclave"
+	existingEnclaveUuid    = enclave.EnclaveUUID("existing-enclave-uuid")
+	existingEnclaveName    = "existing-enclave"
+	otherEnclaveUuid       = enclave.EnclaveUUID("other-enclave-uuid")
+	otherEnclaveName       = "other-enclave"
+	unknownClusterName     = "unknown-cluster"
+	unknownEnclaveUuid     = enclave.EnclaveUUID("unknown-enclave-uuid")
+	testServiceName        = service.ServiceName("test-service")
+	isNotProductionEnclave = false
+)
+
+func TestMultiClusterKurtosisBackend_PlacesNewEnclaveOnLeastLoadedCluster(t *testing.T) {
+	defaultBackend := backend_interface.NewMockKurtosisBackend(t)
+	otherBackend := backend_interface.NewMockKurtosisBackend(t)
+	backend := newTestBackend(t, defaultBackend, otherBackend)
+	ctx := context.Background()
+
+	defaultBackend.EXPECT().GetEnclaves(ctx, mock.Anything).Return(map[enclave.EnclaveUUID]*enclave.Enclave{
+		existingEnclaveUuid: enclave.NewEnclave(existingEnclaveUuid, existingEnclaveName, enclave.EnclaveStatus_Running, nil, isNotProductionEnclave),
+	}, nil)
+	otherBackend.EXPECT().GetEnclaves(ctx, mock.Anything).Return(map[enclave.EnclaveUUID]*enclave.Enclave{}, nil)
+	otherBackend.EXPECT().CreateEnclave(ctx, testEnclaveUuid, testEnclaveName).Return(
+		enclave.NewEnclave(testEnclaveUuid, testEnclaveName, enclave.EnclaveStatus_Empty, nil, isNotProductionEnclave),
+		nil,
+	)
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+
+	clusterName, found := backend.GetKnownEnclaveCluster(testEnclaveUuid)
+	require.True(t, found)
+	require.Equal(t, otherClusterName, clusterName)
+
+	// The calls about the enclave go to the cluster it was created in
+	otherBackend.EXPECT().RegisterUserServices(ctx, testEnclaveUuid, mock.Anything).Return(nil, nil, nil)
+	_, _, err = backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+}
+
+func TestMultiClusterKurtosisBackend_CreatesEnclaveInTargetCluster(t *testing.T) {
+	defaultBackend := backend_interface.NewMockKurtosisBackend(t)
+	otherBackend := backend_interface.NewMockKurtosisBackend(t)
+	backend := newTestBackend(t, defaultBackend, otherBackend)
+
+	ctx := WithTargetCluster(context.Background(), defaultClusterName)
+	defaultBackend.EXPECT().CreateEnclave(ctx, testEnclaveUuid, testEnclaveName).Return(
+		enclave.NewEnclave(testEnclaveUuid, testEnclaveName, enclave.EnclaveStatus_Empty, nil, isNotProductionEnclave),
+		nil,
+	)
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+
+	_, err = backend.CreateEnclave(WithTargetCluster(context.Background(), unknownClusterName), testEnclaveUuid, testEnclaveName)
+	require.Error(t, err)
+}
+
+func TestMultiClusterKurtosisBackend_ListsEnclavesOfEveryCluster(t *testing.T) {
+	defaultBackend := backend_interface.NewMockKurtosisBackend(t)
+	otherBackend := backend_interface.NewMockKurtosisBackend(t)
+	backend := newTestBackend(t, defaultBackend, otherBackend)
+	ctx := context.Background()
+
+	defaultBackend.EXPECT().GetEnclaves(ctx, mock.Anything).Return(map[enclave.EnclaveUUID]*enclave.Enclave{
+		existingEnclaveUuid: enclave.NewEnclave(existingEnclaveUuid, existingEnclaveName, enclave.EnclaveStatus_Running, nil, isNotProductionEnclave),
+	}, nil)
+	otherBackend.EXPECT().GetEnclaves(ctx, mock.Anything).Return(map[enclave.EnclaveUUID]*enclave.Enclave{
+		otherEnclaveUuid: enclave.NewEnclave(otherEnclaveUuid, otherEnclaveName, enclave.EnclaveStatus_Running, nil, isNotProductionEnclave),
+	}, nil)
+
+	enclaves, err := backend.GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Len(t, enclaves, 2)
+
+	clusterName, found := backend.GetKnownEnclaveCluster(otherEnclaveUuid)
+	require.True(t, found)
+	require.Equal(t, otherClusterName, clusterName)
+}
+
+func TestMultiClusterKurtosisBackend_LooksForUnknownEnclaveInEveryCluster(t *testing.T) {
+	defaultBackend := backend_interface.NewMockKurtosisBackend(t)
+	otherBackend := backend_interface.NewMockKurtosisBackend(t)
+	backend := newTestBackend(t, defaultBackend, otherBackend)
+	ctx := context.Background()
+
+	defaultBackend.EXPECT().GetEnclaves(ctx, mock.Anything).Return(map[enclave.EnclaveUUID]*enclave.Enclave{}, nil)
+	otherBackend.EXPECT().GetEnclaves(ctx, mock.Anything).Return(map[enclave.EnclaveUUID]*enclave.Enclave{}, nil)
+
+	_, err := backend.GetUserServices(ctx, unknownEnclaveUuid, nil)
+	require.Error(t, err)
+}
+
+func TestNewMultiClusterKurtosisBackend_FailsOnUnknownDefaultCluster(t *testing.T) {
+	_, err := NewMultiClusterKurtosisBackend(unknownClusterName, map[string]backend_interface.KurtosisBackend{
+		defaultClusterName: backend_interface.NewMockKurtosisBackend(t),
+	})
+	require.Error(t, err)
+}
+
+func newTestBackend(t *testing.T, defaultBackend backend_interface.KurtosisBackend, otherBackend backend_interface.KurtosisBackend) *MultiClusterKurtosisBackend {
+	backend, err := NewMultiClusterKurtosisBackend(defaultClusterName, map[string]backend_interface.KurtosisBackend{
+		defaultClusterName: defaultBackend,
+		otherClusterName:   otherBackend,
+	})
+	require.NoError(t, err)
+	return backend
+}
@@ -0,0 +1,19 @@
+package multi_cluster
+
+import "context"
+
+type targetClusterContextKey struct{}
+
+// WithTargetCluster returns a context telling a MultiClusterKurtosisBackend which cluster to create the next enclaves
+// in, instead of placing them on the cluster with the fewest enclaves
+func WithTargetCluster(ctx context.Context, clusterName string) context.Context {
+	return context.WithValue(ctx, targetClusterContextKey{}, clusterName)
+}
+
+func getTargetCluster(ctx context.Context) (string, bool) {
+	clusterName, found := ctx.Value(targetClusterContextKey{}).(string)
+	if !found || clusterName == "" {
+		return "", false
+	}
+	return clusterName, true
+}
@@ -0,0 +1,71 @@
+package planning
+
+type OperationType string
+
+const (
+	OperationType_Create     OperationType = "CREATE"
+	OperationType_Update     OperationType = "UPDATE"
+	OperationType_Register   OperationType = "REGISTER"
+	OperationType_Unregister OperationType = "UNREGISTER"
+	OperationType_Start      OperationType = "START"
+	OperationType_Stop       OperationType = "STOP"
+	OperationType_Destroy    OperationType = "DESTROY"
+	OperationType_Pull       OperationType = "PULL"
+	OperationType_Build      OperationType = "BUILD"
+	OperationType_Prune      OperationType = "PRUNE"
+	OperationType_Exec       OperationType = "EXEC"
+	OperationType_CopyFiles  OperationType = "COPY_FILES"
+)
+
+type ResourceType string
+
+const (
+	ResourceType_Engine         ResourceType = "ENGINE"
+	ResourceType_Enclave        ResourceType = "ENCLAVE"
+	ResourceType_EnclaveQuota   ResourceType = "ENCLAVE_QUOTA"
+	ResourceType_EgressPolicy   ResourceType = "EGRESS_POLICY"
+	ResourceType_APIContainer   ResourceType = "API_CONTAINER"
+	ResourceType_UserService    ResourceType = "USER_SERVICE"
+	ResourceType_Image          ResourceType = "IMAGE"
+	ResourceType_Volume         ResourceType = "VOLUME"
+	ResourceType_LogsAggregator ResourceType = "LOGS_AGGREGATOR"
+	ResourceType_LogsCollector  ResourceType = "LOGS_COLLECTOR"
+	ResourceType_ReverseProxy   ResourceType = "REVERSE_PROXY"
+)
+
+// PlannedOperation is an operation that a backend call would have performed on the container engine
+type PlannedOperation struct {
+	Type     OperationType `json:"type"`
+	Resource ResourceType  `json:"resource"`
+
+	// What the operation is performed on, e.g. the UUID of the enclave or the name of the image; empty if the resource
+	// is a singleton like the logs aggregator
+	Id string `json:"id,omitempty"`
+
+	// The UUID of the enclave the resource belongs to, if any
+	EnclaveUuid string `json:"enclave_uuid,omitempty"`
+
+	// Extra information about the operation, e.g. the image a service would be started with
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Plan is the report of the operations recorded by a PlanningKurtosisBackend, in the order the calls were made
+type Plan struct {
+	Operations []*PlannedOperation `json:"operations"`
+}
+
+func (plan *Plan) GetOperations() []*PlannedOperation {
+	return plan.Operations
+}
+
+// GetOperationsOnResource returns the operations of the plan performed on the given type of resource, e.g. to review
+// which images Kurtosis would pull
+func (plan *Plan) GetOperationsOnResource(resource ResourceType) []*PlannedOperation {
+	var result []*PlannedOperation
+	for _, operation := range plan.Operations {
+		if operation.Resource == resource {
+			result = append(result, operation)
+		}
+	}
+	return result
+}
@@ -0,0 +1,644 @@
+package planning
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	imageDetailKey           = "image"
+	tagDetailKey             = "tag"
+	grpcPortDetailKey        = "grpc_port"
+	nameDetailKey            = "name"
+	pathDetailKey            = "path"
+	commandDetailKey         = "command"
+	userDetailKey            = "user"
+	maxServicesDetailKey     = "max_services"
+	maxCpuDetailKey          = "max_cpu_millicores"
+	maxMemoryDetailKey       = "max_memory_megabytes"
+	httpPortDetailKey        = "http_port"
+	tcpPortDetailKey         = "tcp_port"
+	sizeDetailKey            = "size"
+	numberOfSinksDetailKey   = "number_of_sinks"
+	buildContextDetailKey    = "build_context"
+	flakeReferenceDetailKey  = "flake_reference"
+	allowedCidrsDetailKey    = "allowed_cidrs"
+	allowedDomainsDetailKey  = "allowed_domains"
+	numberOfFiltersDetailKey = "number_of_filters"
+
+	egressPolicyAllowlistSeparator = ","
+
+	// The planned exec commands report success without running anything
+	plannedExecExitCode = 0
+	plannedExecOutput   = ""
+
+	noArchitecture = ""
+
+	noId          = ""
+	noEnclaveUuid = ""
+
+	uintBase = 10
+)
+
+var (
+	noDetails map[string]string = nil
+)
+
+// PlanningKurtosisBackend records the operations the calls that change the container engine would perform instead of
+// performing them, e.g. to review what Kurtosis will touch in a cluster, while the calls that only read from it go to
+// the underlying backend. The objects returned by the recorded calls describe what would have been created, and the
+// operations that match existing objects through filters, like destroying enclaves, are planned on the objects the
+// underlying backend currently has.
+type PlanningKurtosisBackend struct {
+	underlying backend_interface.KurtosisBackend
+
+	mutex      *sync.Mutex
+	operations []*PlannedOperation
+
+	// The registrations of the services registered in the plan, which don't exist in the underlying backend, so that
+	// they can be started in the plan too
+	plannedRegistrations map[service.ServiceUUID]*service.ServiceRegistration
+}
+
+func NewPlanningKurtosisBackend(underlying backend_interface.KurtosisBackend) *PlanningKurtosisBackend {
+	return &PlanningKurtosisBackend{
+		underlying:           underlying,
+		mutex:                &sync.Mutex{},
+		operations:           []*PlannedOperation{},
+		plannedRegistrations: map[service.ServiceUUID]*service.ServiceRegistration{},
+	}
+}
+
+// GetPlan returns the operations recorded so far
+func (backend *PlanningKurtosisBackend) GetPlan() *Plan {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	operations := make([]*PlannedOperation, len(backend.operations))
+	copy(operations, backend.operations)
+	return &Plan{Operations: operations}
+}
+
+func (backend *PlanningKurtosisBackend) FetchImage(ctx context.Context, image string, registrySpec *image_registry_spec.ImageRegistrySpec, downloadMode image_download_mode.ImageDownloadMode) (bool, string, error) {
+	backend.record(OperationType_Pull, ResourceType_Image, image, noEnclaveUuid, noDetails)
+	return false, noArchitecture, nil
+}
+
+func (backend *PlanningKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
+	unusedImages, err := backend.underlying.ListUnusedImages(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the unused images that would be pruned")
+	}
+	for _, image := range unusedImages {
+		backend.record(OperationType_Prune, ResourceType_Image, image, noEnclaveUuid, noDetails)
+	}
+	return unusedImages, nil
+}
+
+func (backend *PlanningKurtosisBackend) ListUnusedImages(ctx context.Context) ([]string, error) {
+	return backend.underlying.ListUnusedImages(ctx)
+}
+
+func (backend *PlanningKurtosisBackend) CreateEngine(
+	ctx context.Context,
+	imageOrgAndRepo string,
+	imageVersionTag string,
+	grpcPortNum uint16,
+	envVars map[string]string,
+	shouldStartInDebugMode bool,
+	githubAuthToken string,
+	sinks logs_aggregator.Sinks,
+	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
+	logsCollectorFilters []logs_collector.Filter,
+	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+) (*engine.Engine, error) {
+	engineGuidStr, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the GUID of the planned engine")
+	}
+	grpcPortSpec, err := newTcpPortSpec(grpcPortNum)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the gRPC port spec of the planned engine")
+	}
+	backend.record(OperationType_Create, ResourceType_Engine, engineGuidStr, noEnclaveUuid, map[string]string{
+		imageDetailKey:    imageOrgAndRepo,
+		tagDetailKey:      imageVersionTag,
+		grpcPortDetailKey: formatUint(uint64(grpcPortNum)),
+	})
+	return engine.NewEngine(engine.EngineGUID(engineGuidStr), container.ContainerStatus_Running, nil, grpcPortSpec), nil
+}
+
+func (backend *PlanningKurtosisBackend) GetEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]*engine.Engine, error) {
+	return backend.underlying.GetEngines(ctx, filters)
+}
+
+func (backend *PlanningKurtosisBackend) StopEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]bool, map[engine.EngineGUID]error, error) {
+	return backend.planEngineOperation(ctx, filters, OperationType_Stop)
+}
+
+func (backend *PlanningKurtosisBackend) DestroyEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]bool, map[engine.EngineGUID]error, error) {
+	return backend.planEngineOperation(ctx, filters, OperationType_Destroy)
+}
+
+func (backend *PlanningKurtosisBackend) GetEngineLogs(ctx context.Context, outputDirpath string) error {
+	return backend.underlying.GetEngineLogs(ctx, outputDirpath)
+}
+
+func (backend *PlanningKurtosisBackend) DumpKurtosis(ctx context.Context, outputDirpath string) error {
+	return backend.underlying.DumpKurtosis(ctx, outputDirpath)
+}
+
+func (backend *PlanningKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+	backend.record(OperationType_Create, ResourceType_Enclave, string(enclaveUuid), string(enclaveUuid), map[string]string{
+		nameDetailKey: enclaveName,
+	})
+	creationTime := time.Now()
+	return enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false), nil
+}
+
+func (backend *PlanningKurtosisBackend) UpdateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, newName string, creationTime *time.Time) error {
+	backend.record(OperationType_Update, ResourceType_Enclave, string(enclaveUuid), string(enclaveUuid), map[string]string{
+		nameDetailKey: newName,
+	})
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) CreateEnclaveQuota(ctx context.Context, enclaveUuid enclave.EnclaveUUID, quota enclave_quota.EnclaveQuota) error {
+	if quota.IsUnlimited() {
+		return nil
+	}
+	backend.record(OperationType_Create, ResourceType_EnclaveQuota, string(enclaveUuid), string(enclaveUuid), map[string]string{
+		maxServicesDetailKey: formatUint(uint64(quota.MaxServices)),
+		maxCpuDetailKey:      formatUint(quota.MaxCpuMilliCores),
+		maxMemoryDetailKey:   formatUint(quota.MaxMemoryMegabytes),
+	})
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) CreateEnclaveEgressPolicy(ctx context.Context, enclaveUuid enclave.EnclaveUUID, egressPolicy *egress_policy.EgressPolicy) error {
+	if egressPolicy == nil {
+		return nil
+	}
+	backend.record(OperationType_Create, ResourceType_EgressPolicy, string(enclaveUuid), string(enclaveUuid), map[string]string{
+		allowedCidrsDetailKey:   strings.Join(egressPolicy.AllowedCidrs, egressPolicyAllowlistSeparator),
+		allowedDomainsDetailKey: strings.Join(egressPolicy.AllowedDomains, egressPolicyAllowlistSeparator),
+	})
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) GetEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.Enclave, error) {
+	return backend.underlying.GetEnclaves(ctx, filters)
+}
+
+func (backend *PlanningKurtosisBackend) StopEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	return backend.planEnclaveOperation(ctx, filters, OperationType_Stop)
+}
+
+func (backend *PlanningKurtosisBackend) DumpEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string) error {
+	return backend.underlying.DumpEnclave(ctx, enclaveUuid, outputDirpath)
+}
+
+func (backend *PlanningKurtosisBackend) DestroyEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	return backend.planEnclaveOperation(ctx, filters, OperationType_Destroy)
+}
+
+func (backend *PlanningKurtosisBackend) CreateAPIContainer(
+	ctx context.Context,
+	image string,
+	enclaveUuid enclave.EnclaveUUID,
+	grpcPortNum uint16,
+	enclaveDataVolumeDirpath string,
+	ownIpAddressEnvVar string,
+	customEnvVars map[string]string,
+	shouldStartInDebugMode bool,
+) (*api_container.APIContainer, error) {
+	grpcPortSpec, err := newTcpPortSpec(grpcPortNum)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the gRPC port spec of the planned API container")
+	}
+	backend.record(OperationType_Create, ResourceType_APIContainer, string(enclaveUuid), string(enclaveUuid), map[string]string{
+		imageDetailKey:    image,
+		grpcPortDetailKey: formatUint(uint64(grpcPortNum)),
+	})
+	return api_container.NewAPIContainer(enclaveUuid, container.ContainerStatus_Running, nil, grpcPortSpec, nil, nil, nil, false), nil
+}
+
+func (backend *PlanningKurtosisBackend) GetAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]*api_container.APIContainer, error) {
+	return backend.underlying.GetAPIContainers(ctx, filters)
+}
+
+func (backend *PlanningKurtosisBackend) StopAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	return backend.planAPIContainerOperation(ctx, filters, OperationType_Stop)
+}
+
+func (backend *PlanningKurtosisBackend) DestroyAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	return backend.planAPIContainerOperation(ctx, filters, OperationType_Destroy)
+}
+
+func (backend *PlanningKurtosisBackend) RegisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceName]bool) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
+	successfulRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
+	failedRegistrations := map[service.ServiceName]error{}
+	for serviceName := range services {
+		serviceUuidStr, err := uuid_generator.GenerateUUIDString()
+		if err != nil {
+			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred generating the UUID of planned service '%v'", serviceName)
+			continue
+		}
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+		registration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveUuid, nil, string(serviceName))
+
+		backend.mutex.Lock()
+		backend.plannedRegistrations[serviceUuid] = registration
+		backend.mutex.Unlock()
+
+		backend.record(OperationType_Register, ResourceType_UserService, serviceUuidStr, string(enclaveUuid), map[string]string{
+			nameDetailKey: string(serviceName),
+		})
+		successfulRegistrations[serviceName] = registration
+	}
+	return successfulRegistrations, failedRegistrations, nil
+}
+
+func (backend *PlanningKurtosisBackend) UnregisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]bool) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	successfulUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range services {
+		backend.record(OperationType_Unregister, ResourceType_UserService, string(serviceUuid), string(enclaveUuid), noDetails)
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, map[service.ServiceUUID]error{}, nil
+}
+
+func (backend *PlanningKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+	successfulServices := map[service.ServiceUUID]*service.Service{}
+	failedServices := map[service.ServiceUUID]error{}
+	for serviceUuid, serviceConfig := range services {
+		registration, err := backend.getServiceRegistration(ctx, enclaveUuid, serviceUuid)
+		if err != nil {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the registration of service '%v' to plan starting it", serviceUuid)
+			continue
+		}
+
+		image := backend.recordServiceImage(enclaveUuid, serviceConfig)
+		if persistentDirectories := serviceConfig.GetPersistentDirectories(); persistentDirectories != nil {
+			for dirpath, persistentDirectory := range persistentDirectories.ServiceDirpathToPersistentDirectory {
+				backend.record(OperationType_Create, ResourceType_Volume, string(persistentDirectory.PersistentKey), string(enclaveUuid), map[string]string{
+					pathDetailKey: dirpath,
+					sizeDetailKey: strconv.FormatInt(int64(persistentDirectory.Size), uintBase),
+				})
+			}
+		}
+		backend.record(OperationType_Start, ResourceType_UserService, string(serviceUuid), string(enclaveUuid), map[string]string{
+			nameDetailKey:  string(registration.GetName()),
+			imageDetailKey: image,
+		})
+
+		serviceContainer := container.NewContainer(container.ContainerStatus_Running, image, serviceConfig.GetEntrypointArgs(), serviceConfig.GetCmdArgs(), serviceConfig.GetEnvVars())
+		successfulServices[serviceUuid] = service.NewService(registration, serviceConfig.GetPrivatePorts(), nil, nil, serviceContainer)
+	}
+	return successfulServices, failedServices, nil
+}
+
+func (backend *PlanningKurtosisBackend) RemoveRegisteredUserServiceProcesses(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]bool) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	successfulUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range services {
+		backend.record(OperationType_Destroy, ResourceType_UserService, string(serviceUuid), string(enclaveUuid), noDetails)
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, map[service.ServiceUUID]error{}, nil
+}
+
+func (backend *PlanningKurtosisBackend) GetUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.Service, error) {
+	return backend.underlying.GetUserServices(ctx, enclaveUuid, filters)
+}
+
+func (backend *PlanningKurtosisBackend) GetUserServiceLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error) {
+	return backend.underlying.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs)
+}
+
+func (backend *PlanningKurtosisBackend) GetUserServiceResourceUsage(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.ResourceUsage, map[service.ServiceUUID]error, error) {
+	return backend.underlying.GetUserServiceResourceUsage(ctx, enclaveUuid, filters)
+}
+
+func (backend *PlanningKurtosisBackend) GetUserServiceExitStates(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.ExitState, map[service.ServiceUUID]error, error) {
+	return backend.underlying.GetUserServiceExitStates(ctx, enclaveUuid, filters)
+}
+
+func (backend *PlanningKurtosisBackend) RunUserServiceExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, containerUser string, userServiceCommands map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+	successfulResults := map[service.ServiceUUID]*exec_result.ExecResult{}
+	for serviceUuid, command := range userServiceCommands {
+		backend.record(OperationType_Exec, ResourceType_UserService, string(serviceUuid), string(enclaveUuid), map[string]string{
+			commandDetailKey: formatCommand(command),
+			userDetailKey:    containerUser,
+		})
+		successfulResults[serviceUuid] = exec_result.NewExecResult(plannedExecExitCode, plannedExecOutput)
+	}
+	return successfulResults, map[service.ServiceUUID]error{}, nil
+}
+
+func (backend *PlanningKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string) (chan string, chan *exec_result.ExecResult, error) {
+	backend.record(OperationType_Exec, ResourceType_UserService, string(serviceUuid), string(enclaveUuid), map[string]string{
+		commandDetailKey: formatCommand(cmd),
+	})
+	execOutputChan := make(chan string)
+	close(execOutputChan)
+	finalExecResultChan := make(chan *exec_result.ExecResult, 1)
+	finalExecResultChan <- exec_result.NewExecResult(plannedExecExitCode, plannedExecOutput)
+	close(finalExecResultChan)
+	return execOutputChan, finalExecResultChan, nil
+}
+
+func (backend *PlanningKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions) (bool, error) {
+	return false, stacktrace.NewError("Can't open a shell on service '%v' while planning, as what would be run in it isn't known in advance", serviceUuid)
+}
+
+func (backend *PlanningKurtosisBackend) CopyFilesFromUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, srcPathOnService string, output io.Writer) error {
+	return backend.underlying.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
+}
+
+func (backend *PlanningKurtosisBackend) CopyFilesToUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader) error {
+	backend.record(OperationType_CopyFiles, ResourceType_UserService, string(serviceUuid), string(enclaveUuid), map[string]string{
+		pathDetailKey: dstDirpathOnService,
+	})
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) CopyFilesFromImage(ctx context.Context, image string, srcPathOnImage string, output io.Writer) error {
+	return backend.underlying.CopyFilesFromImage(ctx, image, srcPathOnImage, output)
+}
+
+func (backend *PlanningKurtosisBackend) StopUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	return backend.planUserServiceOperation(ctx, enclaveUuid, filters, OperationType_Stop)
+}
+
+func (backend *PlanningKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	return backend.planUserServiceOperation(ctx, enclaveUuid, filters, OperationType_Destroy)
+}
+
+func (backend *PlanningKurtosisBackend) CreateLogsAggregator(ctx context.Context, httpPortNum uint16, sinks logs_aggregator.Sinks) (*logs_aggregator.LogsAggregator, error) {
+	httpPortSpec, err := newTcpPortSpec(httpPortNum)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the HTTP port spec of the planned logs aggregator")
+	}
+	backend.record(OperationType_Create, ResourceType_LogsAggregator, noId, noEnclaveUuid, map[string]string{
+		httpPortDetailKey:      formatUint(uint64(httpPortNum)),
+		numberOfSinksDetailKey: formatUint(uint64(len(sinks))),
+	})
+	return logs_aggregator.NewLogsAggregator(container.ContainerStatus_Running, nil, httpPortNum, httpPortSpec), nil
+}
+
+func (backend *PlanningKurtosisBackend) GetLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
+	return backend.underlying.GetLogsAggregator(ctx)
+}
+
+func (backend *PlanningKurtosisBackend) DestroyLogsAggregator(ctx context.Context) error {
+	backend.record(OperationType_Destroy, ResourceType_LogsAggregator, noId, noEnclaveUuid, noDetails)
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) UpdateLogsAggregatorSinks(ctx context.Context, sinks logs_aggregator.Sinks, shouldEnablePersistentVolumeLogsCollection bool, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig) error {
+	backend.record(OperationType_Update, ResourceType_LogsAggregator, noId, noEnclaveUuid, map[string]string{
+		numberOfSinksDetailKey: formatUint(uint64(len(sinks))),
+	})
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) CreateLogsCollectorForEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	logsCollectorHttpPortNumber uint16,
+	logsCollectorTcpPortNumber uint16,
+	logsCollectorFilters []logs_collector.Filter,
+	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+) (*logs_collector.LogsCollector, error) {
+	tcpPortSpec, err := newTcpPortSpec(logsCollectorTcpPortNumber)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the TCP port spec of the planned logs collector")
+	}
+	httpPortSpec, err := newTcpPortSpec(logsCollectorHttpPortNumber)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the HTTP port spec of the planned logs collector")
+	}
+	backend.record(OperationType_Create, ResourceType_LogsCollector, string(enclaveUuid), string(enclaveUuid), map[string]string{
+		httpPortDetailKey: formatUint(uint64(logsCollectorHttpPortNumber)),
+		tcpPortDetailKey:  formatUint(uint64(logsCollectorTcpPortNumber)),
+	})
+	return logs_collector.NewLogsCollector(container.ContainerStatus_Running, nil, nil, tcpPortSpec, httpPortSpec), nil
+}
+
+func (backend *PlanningKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*logs_collector.LogsCollector, error) {
+	return backend.underlying.GetLogsCollectorForEnclave(ctx, enclaveUuid)
+}
+
+func (backend *PlanningKurtosisBackend) GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error) {
+	return backend.underlying.GetLogsCollectorStatuses(ctx)
+}
+
+func (backend *PlanningKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	backend.record(OperationType_Destroy, ResourceType_LogsCollector, string(enclaveUuid), string(enclaveUuid), noDetails)
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) UpdateLogsCollectorFiltersForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters []logs_collector.Filter) error {
+	backend.record(OperationType_Update, ResourceType_LogsCollector, string(enclaveUuid), string(enclaveUuid), map[string]string{
+		numberOfFiltersDetailKey: formatUint(uint64(len(filters))),
+	})
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) CreateReverseProxy(ctx context.Context, engineGuid engine.EngineGUID) (*reverse_proxy.ReverseProxy, error) {
+	backend.record(OperationType_Create, ResourceType_ReverseProxy, noId, noEnclaveUuid, noDetails)
+	return reverse_proxy.NewReverseProxy(container.ContainerStatus_Running, nil, nil, 0, 0), nil
+}
+
+func (backend *PlanningKurtosisBackend) GetReverseProxy(ctx context.Context) (*reverse_proxy.ReverseProxy, error) {
+	return backend.underlying.GetReverseProxy(ctx)
+}
+
+func (backend *PlanningKurtosisBackend) DestroyReverseProxy(ctx context.Context) error {
+	backend.record(OperationType_Destroy, ResourceType_ReverseProxy, noId, noEnclaveUuid, noDetails)
+	return nil
+}
+
+func (backend *PlanningKurtosisBackend) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, bool, error) {
+	return backend.underlying.GetAvailableCPUAndMemory(ctx)
+}
+
+func (backend *PlanningKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
+	backend.record(OperationType_Build, ResourceType_Image, imageName, noEnclaveUuid, map[string]string{
+		buildContextDetailKey: imageBuildSpec.GetBuildContextDir(),
+	})
+	return noArchitecture, nil
+}
+
+func (backend *PlanningKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
+	backend.record(OperationType_Build, ResourceType_Image, nixBuildSpec.GetImageName(), noEnclaveUuid, map[string]string{
+		flakeReferenceDetailKey: nixBuildSpec.GetFullFlakeReference(),
+	})
+	return nixBuildSpec.GetImageName(), nil
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+func (backend *PlanningKurtosisBackend) record(operationType OperationType, resource ResourceType, id string, enclaveUuid string, details map[string]string) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.operations = append(backend.operations, &PlannedOperation{
+		Type:        operationType,
+		Resource:    resource,
+		Id:          id,
+		EnclaveUuid: enclaveUuid,
+		Details:     details,
+	})
+}
+
+// recordServiceImage records how the image of the service would be obtained, and returns its name
+func (backend *PlanningKurtosisBackend) recordServiceImage(enclaveUuid enclave.EnclaveUUID, serviceConfig *service.ServiceConfig) string {
+	image := serviceConfig.GetContainerImageName()
+	if imageBuildSpec := serviceConfig.GetImageBuildSpec(); imageBuildSpec != nil {
+		backend.record(OperationType_Build, ResourceType_Image, image, string(enclaveUuid), map[string]string{
+			buildContextDetailKey: imageBuildSpec.GetBuildContextDir(),
+		})
+		return image
+	}
+	if nixBuildSpec := serviceConfig.GetNixBuildSpec(); nixBuildSpec != nil {
+		backend.record(OperationType_Build, ResourceType_Image, nixBuildSpec.GetImageName(), string(enclaveUuid), map[string]string{
+			flakeReferenceDetailKey: nixBuildSpec.GetFullFlakeReference(),
+		})
+		return nixBuildSpec.GetImageName()
+	}
+	backend.record(OperationType_Pull, ResourceType_Image, image, string(enclaveUuid), noDetails)
+	return image
+}
+
+// getServiceRegistration returns the registration of the service, whether it was registered in the plan or already
+// exists in the underlying backend
+func (backend *PlanningKurtosisBackend) getServiceRegistration(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*service.ServiceRegistration, error) {
+	backend.mutex.Lock()
+	registration, found := backend.plannedRegistrations[serviceUuid]
+	backend.mutex.Unlock()
+	if found {
+		return registration, nil
+	}
+
+	filters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    map[service.ServiceUUID]bool{serviceUuid: true},
+		Statuses: nil,
+	}
+	existingServices, err := backend.underlying.GetUserServices(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%v' from the underlying backend", serviceUuid)
+	}
+	existingService, found := existingServices[serviceUuid]
+	if !found {
+		return nil, stacktrace.NewError("Service '%v' was neither registered in the plan nor found in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	return existingService.GetRegistration(), nil
+}
+
+func (backend *PlanningKurtosisBackend) planEngineOperation(ctx context.Context, filters *engine.EngineFilters, operationType OperationType) (map[engine.EngineGUID]bool, map[engine.EngineGUID]error, error) {
+	matchingEngines, err := backend.underlying.GetEngines(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the engines matching filters '%+v'", filters)
+	}
+	successfulGuids := map[engine.EngineGUID]bool{}
+	for engineGuid := range matchingEngines {
+		backend.record(operationType, ResourceType_Engine, string(engineGuid), noEnclaveUuid, noDetails)
+		successfulGuids[engineGuid] = true
+	}
+	return successfulGuids, map[engine.EngineGUID]error{}, nil
+}
+
+func (backend *PlanningKurtosisBackend) planEnclaveOperation(ctx context.Context, filters *enclave.EnclaveFilters, operationType OperationType) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	matchingEnclaves, err := backend.underlying.GetEnclaves(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the enclaves matching filters '%+v'", filters)
+	}
+	successfulUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid := range matchingEnclaves {
+		backend.record(operationType, ResourceType_Enclave, string(enclaveUuid), string(enclaveUuid), noDetails)
+		successfulUuids[enclaveUuid] = true
+	}
+	return successfulUuids, map[enclave.EnclaveUUID]error{}, nil
+}
+
+func (backend *PlanningKurtosisBackend) planAPIContainerOperation(ctx context.Context, filters *api_container.APIContainerFilters, operationType OperationType) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	matchingApiContainers, err := backend.underlying.GetAPIContainers(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the API containers matching filters '%+v'", filters)
+	}
+	successfulUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid := range matchingApiContainers {
+		backend.record(operationType, ResourceType_APIContainer, string(enclaveUuid), string(enclaveUuid), noDetails)
+		successfulUuids[enclaveUuid] = true
+	}
+	return successfulUuids, map[enclave.EnclaveUUID]error{}, nil
+}
+
+func (backend *PlanningKurtosisBackend) planUserServiceOperation(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, operationType OperationType) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	matchingServices, err := backend.underlying.GetUserServices(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	successfulUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid, matchingService := range matchingServices {
+		backend.record(operationType, ResourceType_UserService, string(serviceUuid), string(enclaveUuid), map[string]string{
+			nameDetailKey: string(matchingService.GetRegistration().GetName()),
+		})
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, map[service.ServiceUUID]error{}, nil
+}
+
+func newTcpPortSpec(portNum uint16) (*port_spec.PortSpec, error) {
+	return port_spec.NewPortSpec(portNum, port_spec.TransportProtocol_TCP, "", nil, "")
+}
+
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, uintBase)
+}
+
+func formatCommand(command []string) string {
+	result := ""
+	for idx, arg := range command {
+		if idx > 0 {
+			result += " "
+		}
+		result += strconv.Quote(arg)
+	}
+	return result
+}
@@ -0,0 +1,95 @@
+package planning
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid  = enclave.EnclaveUUID("test-enclave-uuid")
+	testEnclaveName  = "test-enclave"
+	testServiceName  = service.ServiceName("test-service")
+	testServiceImage = "test-image:latest"
+)
+
+func TestPlanningKurtosisBackend_RecordsCreationsWithoutCallingUnderlying(t *testing.T) {
+	// The mock fails the test on any call that wasn't expected
+	underlying := backend_interface.NewMockKurtosisBackend(t)
+	backend := NewPlanningKurtosisBackend(underlying)
+	ctx := context.Background()
+
+	createdEnclave, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	require.Equal(t, testEnclaveUuid, createdEnclave.GetUUID())
+
+	_, _, err = backend.FetchImage(ctx, testServiceImage, nil, image_download_mode.ImageDownloadMode_Missing)
+	require.NoError(t, err)
+
+	operations := backend.GetPlan().GetOperations()
+	require.Len(t, operations, 2)
+	require.Equal(t, OperationType_Create, operations[0].Type)
+	require.Equal(t, ResourceType_Enclave, operations[0].Resource)
+	require.Equal(t, string(testEnclaveUuid), operations[0].Id)
+	require.Equal(t, testEnclaveName, operations[0].Details[nameDetailKey])
+	require.Equal(t, OperationType_Pull, operations[1].Type)
+	require.Equal(t, testServiceImage, operations[1].Id)
+}
+
+func TestPlanningKurtosisBackend_StartsServicesRegisteredInThePlan(t *testing.T) {
+	underlying := backend_interface.NewMockKurtosisBackend(t)
+	backend := NewPlanningKurtosisBackend(underlying)
+	ctx := context.Background()
+
+	registrations, failedRegistrations, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+	require.Empty(t, failedRegistrations)
+	registration, found := registrations[testServiceName]
+	require.True(t, found)
+
+	serviceConfig, err := service.CreateServiceConfig(testServiceImage, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, nil, nil, nil, nil, image_download_mode.ImageDownloadMode_Missing, false)
+	require.NoError(t, err)
+	startedServices, failedServices, err := backend.StartRegisteredUserServices(ctx, testEnclaveUuid, map[service.ServiceUUID]*service.ServiceConfig{
+		registration.GetUUID(): serviceConfig,
+	})
+	require.NoError(t, err)
+	require.Empty(t, failedServices)
+	require.Contains(t, startedServices, registration.GetUUID())
+
+	plan := backend.GetPlan()
+	imageOperations := plan.GetOperationsOnResource(ResourceType_Image)
+	require.Len(t, imageOperations, 1)
+	require.Equal(t, OperationType_Pull, imageOperations[0].Type)
+	serviceOperations := plan.GetOperationsOnResource(ResourceType_UserService)
+	require.Len(t, serviceOperations, 2)
+	require.Equal(t, OperationType_Register, serviceOperations[0].Type)
+	require.Equal(t, OperationType_Start, serviceOperations[1].Type)
+	require.Equal(t, testServiceImage, serviceOperations[1].Details[imageDetailKey])
+}
+
+func TestPlanningKurtosisBackend_PlansDestroyingExistingEnclaves(t *testing.T) {
+	underlying := backend_interface.NewMockKurtosisBackend(t)
+	backend := NewPlanningKurtosisBackend(underlying)
+	ctx := context.Background()
+
+	existingEnclave := enclave.NewEnclave(testEnclaveUuid, testEnclaveName, enclave.EnclaveStatus_Running, nil, false)
+	underlying.EXPECT().GetEnclaves(mock.Anything, mock.Anything).Return(map[enclave.EnclaveUUID]*enclave.Enclave{
+		testEnclaveUuid: existingEnclave,
+	}, nil)
+
+	destroyedUuids, erroredUuids, err := backend.DestroyEnclaves(ctx, &enclave.EnclaveFilters{})
+	require.NoError(t, err)
+	require.Empty(t, erroredUuids)
+	require.True(t, destroyedUuids[testEnclaveUuid])
+
+	operations := backend.GetPlan().GetOperations()
+	require.Len(t, operations, 1)
+	require.Equal(t, OperationType_Destroy, operations[0].Type)
+	require.Equal(t, string(testEnclaveUuid), operations[0].EnclaveUuid)
+}
@@ -0,0 +1,73 @@
+package backend_errors
+
+import (
+	"fmt"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type ErrorKind string
+
+const (
+	// An image of a container can't be pulled, e.g. because it doesn't exist or the registry refuses access to it
+	ErrorKind_ImagePullBackOff ErrorKind = "IMAGE_PULL_BACK_OFF"
+
+	// The cluster doesn't have enough CPU or memory left to run a container
+	ErrorKind_InsufficientResources ErrorKind = "INSUFFICIENT_RESOURCES"
+
+	// The credentials Kurtosis uses aren't allowed to perform an operation on the cluster
+	ErrorKind_RBACForbidden ErrorKind = "RBAC_FORBIDDEN"
+
+	// An operation would go over the quota of the enclave or of its namespace
+	ErrorKind_QuotaExceeded ErrorKind = "QUOTA_EXCEEDED"
+
+	// A volume of a container can't be provisioned, e.g. because the storage class doesn't exist
+	ErrorKind_VolumeProvisioningFailed ErrorKind = "VOLUME_PROVISIONING_FAILED"
+)
+
+var remediationHints = map[ErrorKind]string{
+	ErrorKind_ImagePullBackOff: "Check that the image name and tag don't have a typo, that the image is in a registry the " +
+		"container engine can reach (a locally built image isn't available to a remote cluster), that the registry " +
+		"credentials are set if the registry is private, and that the image is built for the architecture of the nodes",
+	ErrorKind_InsufficientResources: "Lower the min_cpu and min_memory of the services, remove services or enclaves that " +
+		"are no longer needed, or add nodes to the cluster",
+	ErrorKind_RBACForbidden: "Check that the credentials of the current Kubernetes context are allowed to manage the " +
+		"namespaces, workloads and RBAC objects Kurtosis creates, e.g. by binding them to the cluster-admin role",
+	ErrorKind_QuotaExceeded: "Lower the max_cpu and max_memory of the services, remove services that are no longer " +
+		"needed, or raise the quota of the enclave",
+	ErrorKind_VolumeProvisioningFailed: "Check that the storage class set in the Kurtosis config exists in the cluster " +
+		"and can provision volumes of the requested size, e.g. with 'kubectl get storageclass'",
+}
+
+// BackendError is the root cause of the errors caused by a known problem of the container engine, which the user can
+// fix as per its remediation hint, as opposed to a bug of Kurtosis
+type BackendError struct {
+	kind    ErrorKind
+	message string
+}
+
+func NewBackendError(kind ErrorKind, message string, args ...interface{}) *BackendError {
+	return &BackendError{
+		kind:    kind,
+		message: fmt.Sprintf(message, args...),
+	}
+}
+
+func (backendErr *BackendError) Error() string {
+	return backendErr.message
+}
+
+func (backendErr *BackendError) GetKind() ErrorKind {
+	return backendErr.kind
+}
+
+// GetRemediationHint returns what the user can do to fix the problem
+func (backendErr *BackendError) GetRemediationHint() string {
+	return remediationHints[backendErr.kind]
+}
+
+// GetBackendError returns the backend error that caused err, if any
+func GetBackendError(err error) (*BackendError, bool) {
+	backendErr, ok := stacktrace.RootCause(err).(*BackendError)
+	return backendErr, ok
+}
@@ -0,0 +1,36 @@
+package backend_errors
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBackendError_ThroughPropagatedErrors(t *testing.T) {
+	backendErr := NewBackendError(ErrorKind_ImagePullBackOff, "Image '%v' can't be pulled", "nginx:does-not-exist")
+	err := stacktrace.Propagate(stacktrace.Propagate(backendErr, "An error occurred starting the service"), "An error occurred adding the service")
+
+	foundErr, isBackendErr := GetBackendError(err)
+	require.True(t, isBackendErr)
+	require.Equal(t, ErrorKind_ImagePullBackOff, foundErr.GetKind())
+	require.Equal(t, "Image 'nginx:does-not-exist' can't be pulled", foundErr.Error())
+	require.NotEmpty(t, foundErr.GetRemediationHint())
+}
+
+func TestGetBackendError_OtherError(t *testing.T) {
+	_, isBackendErr := GetBackendError(stacktrace.NewError("Something else went wrong"))
+	require.False(t, isBackendErr)
+}
+
+func TestRemediationHints_EveryKindHasOne(t *testing.T) {
+	for _, kind := range []ErrorKind{
+		ErrorKind_ImagePullBackOff,
+		ErrorKind_InsufficientResources,
+		ErrorKind_RBACForbidden,
+		ErrorKind_QuotaExceeded,
+		ErrorKind_VolumeProvisioningFailed,
+	} {
+		require.NotEmpty(t, NewBackendError(kind, "").GetRemediationHint(), "Kind '%v' has no remediation hint", kind)
+	}
+}
@@ -11,7 +11,10 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
@@ -41,6 +44,9 @@ type KurtosisBackend interface {
 
 	PruneUnusedImages(ctx context.Context) ([]string, error)
 
+	// Returns the images PruneUnusedImages would remove, without removing them
+	ListUnusedImages(ctx context.Context) ([]string, error)
+
 	// Creates an engine with the given parameters
 	CreateEngine(
 		ctx context.Context,
@@ -52,10 +58,14 @@ type KurtosisBackend interface {
 		githubAuthToken string,
 		sinks logs_aggregator.Sinks,
 		shouldTurnOffPersistentVolumeLogsCollection bool,
-		// logsCollectorFilters and logsCollectorParsers needs to be passed into both CreateEngine and CreateLogsCollectorForEnclave
+		logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
+		// logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig and logsCollectorKubernetesMetadataConfig needs to be passed into both CreateEngine and CreateLogsCollectorForEnclave
 		// this is because over Docker, CreateLogsCollectorForEnclave creates the logs collector and over k8s CreateEngine does
 		logsCollectorFilters []logs_collector.Filter,
 		logsCollectorParsers []logs_collector.Parser,
+		logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+		logsCollectorBufferConfig logs_collector.BufferConfig,
 	) (
 		*engine.Engine,
 		error,
@@ -102,6 +112,14 @@ type KurtosisBackend interface {
 		creationTime *time.Time,
 	) error
 
+	// Creates the objects the backend uses to enforce the given quota on the enclave with the given UUID, if any.
+	// The API container checks the quota whenever services get added regardless of the backend
+	CreateEnclaveQuota(ctx context.Context, enclaveUuid enclave.EnclaveUUID, quota enclave_quota.EnclaveQuota) error
+
+	// Restricts the outbound traffic of the user services of the enclave with the given UUID to the allowlist of the
+	// given policy. The API container and the logs collector keep their unrestricted access
+	CreateEnclaveEgressPolicy(ctx context.Context, enclaveUuid enclave.EnclaveUUID, egressPolicy *egress_policy.EgressPolicy) error
+
 	// Gets enclaves matching the given filters
 	GetEnclaves(
 		ctx context.Context,
@@ -148,6 +166,7 @@ type KurtosisBackend interface {
 		// The environment variable that the user is requesting to populate with the container's own IP address
 		// Must not conflict with the custom environment variables
 		ownIpAddressEnvVar string,
+		// Redacted from the enclave dumps, as they carry the credentials the API container is launched with
 		customEnvVars map[string]string,
 		shouldStartInDebugMode bool,
 	) (
@@ -280,6 +299,30 @@ type KurtosisBackend interface {
 		resultError error,
 	)
 
+	// Gets the current CPU and memory usage of the running user services matching the given filters, and their peak
+	// memory usage when it can be found
+	GetUserServiceResourceUsage(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		successfulUserServiceResourceUsage map[service.ServiceUUID]*service.ResourceUsage,
+		erroredUserServiceUuids map[service.ServiceUUID]error,
+		resultError error,
+	)
+
+	// Gets how the containers of the user services matching the given filters exited; services that are still running
+	// or that never started are left out
+	GetUserServiceExitStates(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		successfulUserServiceExitStates map[service.ServiceUUID]*service.ExitState,
+		erroredUserServiceUuids map[service.ServiceUUID]error,
+		resultError error,
+	)
+
 	// Executes a shell command inside an user service instance indenfified by its ID
 	RunUserServiceExecCommands(
 		ctx context.Context,
@@ -300,8 +343,9 @@ type KurtosisBackend interface {
 		cmd []string,
 	) (execOutputChan chan string, finalExecResultChan chan *exec_result.ExecResult, resultErr error)
 
-	// Get a connection with user service to execute commands in
-	GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error)
+	// Get a connection with user service to execute commands in; the session is disconnected if it ended because the
+	// connection to the service was lost rather than because the shell exited, in which case it can be started again
+	GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions) (isSessionDisconnected bool, resultErr error)
 
 	// Copy files, packaged as a TAR, from the given user service and writes the bytes to the given output writer
 	CopyFilesFromUserService(
@@ -312,6 +356,23 @@ type KurtosisBackend interface {
 		output io.Writer,
 	) error
 
+	// Extract files, packaged as a TAR, into the given directory of the user service, creating the directory if needed
+	CopyFilesToUserService(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		dstDirpathOnService string,
+		tarContent io.Reader,
+	) error
+
+	// Copy files, packaged as a TAR, from the given image without running it, and writes the bytes to the given output writer
+	CopyFilesFromImage(
+		ctx context.Context,
+		image string,
+		srcPathOnImage string,
+		output io.Writer,
+	) error
+
 	// StopUserServices stops the user containers for the services matching the given filters
 	StopUserServices(
 		ctx context.Context,
@@ -346,6 +407,15 @@ type KurtosisBackend interface {
 
 	DestroyLogsAggregator(ctx context.Context) error
 
+	// UpdateLogsAggregatorSinks reconfigures the running logs aggregator to send logs to the given sinks, without
+	// restarting the engine; errors if no logs aggregator is running
+	UpdateLogsAggregatorSinks(
+		ctx context.Context,
+		sinks logs_aggregator.Sinks,
+		shouldEnablePersistentVolumeLogsCollection bool,
+		logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
+	) error
+
 	// Create a new Logs Collector for sending container's logs to the logs aggregator server
 	CreateLogsCollectorForEnclave(
 		ctx context.Context,
@@ -354,6 +424,9 @@ type KurtosisBackend interface {
 		logsCollectorTcpPortNumber uint16,
 		logsCollectorFilters []logs_collector.Filter,
 		logsCollectorParsers []logs_collector.Parser,
+		logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+		logsCollectorBufferConfig logs_collector.BufferConfig,
 	) (
 		*logs_collector.LogsCollector,
 		error,
@@ -362,9 +435,18 @@ type KurtosisBackend interface {
 	// Gets the logs collector, if nothing is found returns nil
 	GetLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*logs_collector.LogsCollector, error)
 
+	// GetLogsCollectorStatuses returns the status of every logs collector, keyed by what it collects the logs of: the
+	// enclave UUID over Docker, where there's one logs collector per enclave, and the node name over Kubernetes, where
+	// there's one per node
+	GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error)
+
 	// Destroy the logs collector for enclave with UUID
 	DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error
 
+	// UpdateLogsCollectorFiltersForEnclave replaces the filters applied on top of the logs collector filters to the logs
+	// of the services of the enclave, hot-reloading the logs collector instead of restarting it; no filters removes them
+	UpdateLogsCollectorFiltersForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters []logs_collector.Filter) error
+
 	CreateReverseProxy(ctx context.Context, engineGuid engine.EngineGUID) (*reverse_proxy.ReverseProxy, error)
 
 	// Returns nil if logs aggregator was not found
@@ -6,10 +6,16 @@ import (
 	api_container "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	compute_resources "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 
+	container "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+
 	context "context"
 
+	egress_policy "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
+
 	enclave "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 
+	enclave_quota "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+
 	engine "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 
 	exec_result "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
@@ -104,6 +110,51 @@ func (_c *MockKurtosisBackend_BuildImage_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CopyFilesFromImage provides a mock function with given fields: ctx, image, srcPathOnImage, output
+func (_m *MockKurtosisBackend) CopyFilesFromImage(ctx context.Context, image string, srcPathOnImage string, output io.Writer) error {
+	ret := _m.Called(ctx, image, srcPathOnImage, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Writer) error); ok {
+		r0 = rf(ctx, image, srcPathOnImage, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesFromImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesFromImage'
+type MockKurtosisBackend_CopyFilesFromImage_Call struct {
+	*mock.Call
+}
+
+// CopyFilesFromImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image string
+//   - srcPathOnImage string
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) CopyFilesFromImage(ctx interface{}, image interface{}, srcPathOnImage interface{}, output interface{}) *MockKurtosisBackend_CopyFilesFromImage_Call {
+	return &MockKurtosisBackend_CopyFilesFromImage_Call{Call: _e.mock.On("CopyFilesFromImage", ctx, image, srcPathOnImage, output)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromImage_Call) Run(run func(ctx context.Context, image string, srcPathOnImage string, output io.Writer)) *MockKurtosisBackend_CopyFilesFromImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromImage_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesFromImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromImage_Call) RunAndReturn(run func(context.Context, string, string, io.Writer) error) *MockKurtosisBackend_CopyFilesFromImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyFilesFromUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, srcPathOnService, output
 func (_m *MockKurtosisBackend) CopyFilesFromUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, srcPathOnService string, output io.Writer) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
@@ -150,6 +201,52 @@ func (_c *MockKurtosisBackend_CopyFilesFromUserService_Call) RunAndReturn(run fu
 	return _c
 }
 
+// CopyFilesToUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent
+func (_m *MockKurtosisBackend) CopyFilesToUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesToUserService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesToUserService'
+type MockKurtosisBackend_CopyFilesToUserService_Call struct {
+	*mock.Call
+}
+
+// CopyFilesToUserService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - dstDirpathOnService string
+//   - tarContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) CopyFilesToUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, dstDirpathOnService interface{}, tarContent interface{}) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	return &MockKurtosisBackend_CopyFilesToUserService_Call{Call: _e.mock.On("CopyFilesToUserService", ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader)) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string), args[4].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, shouldStartInDebugMode
 func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string, shouldStartInDebugMode bool) (*api_container.APIContainer, error) {
 	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, shouldStartInDebugMode)
@@ -267,25 +364,113 @@ func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// CreateEngine provides a mock function with given fields: ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsCollectorFilters, logsCollectorParsers
-func (_m *MockKurtosisBackend) CreateEngine(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, envVars map[string]string, shouldStartInDebugMode bool, githubAuthToken string, sinks logs_aggregator.Sinks, shouldTurnOffPersistentVolumeLogsCollection bool, logsCollectorFilters []logs_collector.Filter, logsCollectorParsers []logs_collector.Parser) (*engine.Engine, error) {
-	ret := _m.Called(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsCollectorFilters, logsCollectorParsers)
+// CreateEnclaveEgressPolicy provides a mock function with given fields: ctx, enclaveUuid, egressPolicy
+func (_m *MockKurtosisBackend) CreateEnclaveEgressPolicy(ctx context.Context, enclaveUuid enclave.EnclaveUUID, egressPolicy *egress_policy.EgressPolicy) error {
+	ret := _m.Called(ctx, enclaveUuid, egressPolicy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *egress_policy.EgressPolicy) error); ok {
+		r0 = rf(ctx, enclaveUuid, egressPolicy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CreateEnclaveEgressPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnclaveEgressPolicy'
+type MockKurtosisBackend_CreateEnclaveEgressPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateEnclaveEgressPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - egressPolicy *egress_policy.EgressPolicy
+func (_e *MockKurtosisBackend_Expecter) CreateEnclaveEgressPolicy(ctx interface{}, enclaveUuid interface{}, egressPolicy interface{}) *MockKurtosisBackend_CreateEnclaveEgressPolicy_Call {
+	return &MockKurtosisBackend_CreateEnclaveEgressPolicy_Call{Call: _e.mock.On("CreateEnclaveEgressPolicy", ctx, enclaveUuid, egressPolicy)}
+}
+
+func (_c *MockKurtosisBackend_CreateEnclaveEgressPolicy_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, egressPolicy *egress_policy.EgressPolicy)) *MockKurtosisBackend_CreateEnclaveEgressPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*egress_policy.EgressPolicy))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateEnclaveEgressPolicy_Call) Return(_a0 error) *MockKurtosisBackend_CreateEnclaveEgressPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateEnclaveEgressPolicy_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *egress_policy.EgressPolicy) error) *MockKurtosisBackend_CreateEnclaveEgressPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEnclaveQuota provides a mock function with given fields: ctx, enclaveUuid, quota
+func (_m *MockKurtosisBackend) CreateEnclaveQuota(ctx context.Context, enclaveUuid enclave.EnclaveUUID, quota enclave_quota.EnclaveQuota) error {
+	ret := _m.Called(ctx, enclaveUuid, quota)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, enclave_quota.EnclaveQuota) error); ok {
+		r0 = rf(ctx, enclaveUuid, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CreateEnclaveQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnclaveQuota'
+type MockKurtosisBackend_CreateEnclaveQuota_Call struct {
+	*mock.Call
+}
+
+// CreateEnclaveQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - quota enclave_quota.EnclaveQuota
+func (_e *MockKurtosisBackend_Expecter) CreateEnclaveQuota(ctx interface{}, enclaveUuid interface{}, quota interface{}) *MockKurtosisBackend_CreateEnclaveQuota_Call {
+	return &MockKurtosisBackend_CreateEnclaveQuota_Call{Call: _e.mock.On("CreateEnclaveQuota", ctx, enclaveUuid, quota)}
+}
+
+func (_c *MockKurtosisBackend_CreateEnclaveQuota_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, quota enclave_quota.EnclaveQuota)) *MockKurtosisBackend_CreateEnclaveQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(enclave_quota.EnclaveQuota))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateEnclaveQuota_Call) Return(_a0 error) *MockKurtosisBackend_CreateEnclaveQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateEnclaveQuota_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, enclave_quota.EnclaveQuota) error) *MockKurtosisBackend_CreateEnclaveQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEngine provides a mock function with given fields: ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsEncryptionConfig, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig
+func (_m *MockKurtosisBackend) CreateEngine(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, envVars map[string]string, shouldStartInDebugMode bool, githubAuthToken string, sinks logs_aggregator.Sinks, shouldTurnOffPersistentVolumeLogsCollection bool, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig, logsCollectorFilters []logs_collector.Filter, logsCollectorParsers []logs_collector.Parser, logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig, logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig, logsCollectorBufferConfig logs_collector.BufferConfig) (*engine.Engine, error) {
+	ret := _m.Called(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsEncryptionConfig, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 
 	var r0 *engine.Engine
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint16, map[string]string, bool, string, logs_aggregator.Sinks, bool, []logs_collector.Filter, []logs_collector.Parser) (*engine.Engine, error)); ok {
-		return rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsCollectorFilters, logsCollectorParsers)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint16, map[string]string, bool, string, logs_aggregator.Sinks, bool, logs_aggregator.LogsEncryptionConfig, []logs_collector.Filter, []logs_collector.Parser, logs_collector.SystemLogsConfig, logs_collector.KubernetesMetadataConfig, logs_collector.BufferConfig) (*engine.Engine, error)); ok {
+		return rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsEncryptionConfig, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint16, map[string]string, bool, string, logs_aggregator.Sinks, bool, []logs_collector.Filter, []logs_collector.Parser) *engine.Engine); ok {
-		r0 = rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsCollectorFilters, logsCollectorParsers)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint16, map[string]string, bool, string, logs_aggregator.Sinks, bool, logs_aggregator.LogsEncryptionConfig, []logs_collector.Filter, []logs_collector.Parser, logs_collector.SystemLogsConfig, logs_collector.KubernetesMetadataConfig, logs_collector.BufferConfig) *engine.Engine); ok {
+		r0 = rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsEncryptionConfig, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*engine.Engine)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint16, map[string]string, bool, string, logs_aggregator.Sinks, bool, []logs_collector.Filter, []logs_collector.Parser) error); ok {
-		r1 = rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsCollectorFilters, logsCollectorParsers)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint16, map[string]string, bool, string, logs_aggregator.Sinks, bool, logs_aggregator.LogsEncryptionConfig, []logs_collector.Filter, []logs_collector.Parser, logs_collector.SystemLogsConfig, logs_collector.KubernetesMetadataConfig, logs_collector.BufferConfig) error); ok {
+		r1 = rf(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsEncryptionConfig, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -308,15 +493,19 @@ type MockKurtosisBackend_CreateEngine_Call struct {
 //   - githubAuthToken string
 //   - sinks logs_aggregator.Sinks
 //   - shouldTurnOffPersistentVolumeLogsCollection bool
+//   - logsEncryptionConfig logs_aggregator.LogsEncryptionConfig
 //   - logsCollectorFilters []logs_collector.Filter
 //   - logsCollectorParsers []logs_collector.Parser
-func (_e *MockKurtosisBackend_Expecter) CreateEngine(ctx interface{}, imageOrgAndRepo interface{}, imageVersionTag interface{}, grpcPortNum interface{}, envVars interface{}, shouldStartInDebugMode interface{}, githubAuthToken interface{}, sinks interface{}, shouldTurnOffPersistentVolumeLogsCollection interface{}, logsCollectorFilters interface{}, logsCollectorParsers interface{}) *MockKurtosisBackend_CreateEngine_Call {
-	return &MockKurtosisBackend_CreateEngine_Call{Call: _e.mock.On("CreateEngine", ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsCollectorFilters, logsCollectorParsers)}
+//   - logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig
+//   - logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig
+//   - logsCollectorBufferConfig logs_collector.BufferConfig
+func (_e *MockKurtosisBackend_Expecter) CreateEngine(ctx interface{}, imageOrgAndRepo interface{}, imageVersionTag interface{}, grpcPortNum interface{}, envVars interface{}, shouldStartInDebugMode interface{}, githubAuthToken interface{}, sinks interface{}, shouldTurnOffPersistentVolumeLogsCollection interface{}, logsEncryptionConfig interface{}, logsCollectorFilters interface{}, logsCollectorParsers interface{}, logsCollectorSystemLogsConfig interface{}, logsCollectorKubernetesMetadataConfig interface{}, logsCollectorBufferConfig interface{}) *MockKurtosisBackend_CreateEngine_Call {
+	return &MockKurtosisBackend_CreateEngine_Call{Call: _e.mock.On("CreateEngine", ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, envVars, shouldStartInDebugMode, githubAuthToken, sinks, shouldTurnOffPersistentVolumeLogsCollection, logsEncryptionConfig, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)}
 }
 
-func (_c *MockKurtosisBackend_CreateEngine_Call) Run(run func(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, envVars map[string]string, shouldStartInDebugMode bool, githubAuthToken string, sinks logs_aggregator.Sinks, shouldTurnOffPersistentVolumeLogsCollection bool, logsCollectorFilters []logs_collector.Filter, logsCollectorParsers []logs_collector.Parser)) *MockKurtosisBackend_CreateEngine_Call {
+func (_c *MockKurtosisBackend_CreateEngine_Call) Run(run func(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, envVars map[string]string, shouldStartInDebugMode bool, githubAuthToken string, sinks logs_aggregator.Sinks, shouldTurnOffPersistentVolumeLogsCollection bool, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig, logsCollectorFilters []logs_collector.Filter, logsCollectorParsers []logs_collector.Parser, logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig, logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig, logsCollectorBufferConfig logs_collector.BufferConfig)) *MockKurtosisBackend_CreateEngine_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint16), args[4].(map[string]string), args[5].(bool), args[6].(string), args[7].(logs_aggregator.Sinks), args[8].(bool), args[9].([]logs_collector.Filter), args[10].([]logs_collector.Parser))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint16), args[4].(map[string]string), args[5].(bool), args[6].(string), args[7].(logs_aggregator.Sinks), args[8].(bool), args[9].(logs_aggregator.LogsEncryptionConfig), args[10].([]logs_collector.Filter), args[11].([]logs_collector.Parser), args[12].(logs_collector.SystemLogsConfig), args[13].(logs_collector.KubernetesMetadataConfig), args[14].(logs_collector.BufferConfig))
 	})
 	return _c
 }
@@ -326,7 +515,7 @@ func (_c *MockKurtosisBackend_CreateEngine_Call) Return(_a0 *engine.Engine, _a1
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateEngine_Call) RunAndReturn(run func(context.Context, string, string, uint16, map[string]string, bool, string, logs_aggregator.Sinks, bool, []logs_collector.Filter, []logs_collector.Parser) (*engine.Engine, error)) *MockKurtosisBackend_CreateEngine_Call {
+func (_c *MockKurtosisBackend_CreateEngine_Call) RunAndReturn(run func(context.Context, string, string, uint16, map[string]string, bool, string, logs_aggregator.Sinks, bool, logs_aggregator.LogsEncryptionConfig, []logs_collector.Filter, []logs_collector.Parser, logs_collector.SystemLogsConfig, logs_collector.KubernetesMetadataConfig, logs_collector.BufferConfig) (*engine.Engine, error)) *MockKurtosisBackend_CreateEngine_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -387,25 +576,25 @@ func (_c *MockKurtosisBackend_CreateLogsAggregator_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// CreateLogsCollectorForEnclave provides a mock function with given fields: ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers
-func (_m *MockKurtosisBackend) CreateLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, logsCollectorHttpPortNumber uint16, logsCollectorTcpPortNumber uint16, logsCollectorFilters []logs_collector.Filter, logsCollectorParsers []logs_collector.Parser) (*logs_collector.LogsCollector, error) {
-	ret := _m.Called(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers)
+// CreateLogsCollectorForEnclave provides a mock function with given fields: ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig
+func (_m *MockKurtosisBackend) CreateLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, logsCollectorHttpPortNumber uint16, logsCollectorTcpPortNumber uint16, logsCollectorFilters []logs_collector.Filter, logsCollectorParsers []logs_collector.Parser, logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig, logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig, logsCollectorBufferConfig logs_collector.BufferConfig) (*logs_collector.LogsCollector, error) {
+	ret := _m.Called(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 
 	var r0 *logs_collector.LogsCollector
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, uint16, uint16, []logs_collector.Filter, []logs_collector.Parser) (*logs_collector.LogsCollector, error)); ok {
-		return rf(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, uint16, uint16, []logs_collector.Filter, []logs_collector.Parser, logs_collector.SystemLogsConfig, logs_collector.KubernetesMetadataConfig, logs_collector.BufferConfig) (*logs_collector.LogsCollector, error)); ok {
+		return rf(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, uint16, uint16, []logs_collector.Filter, []logs_collector.Parser) *logs_collector.LogsCollector); ok {
-		r0 = rf(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, uint16, uint16, []logs_collector.Filter, []logs_collector.Parser, logs_collector.SystemLogsConfig, logs_collector.KubernetesMetadataConfig, logs_collector.BufferConfig) *logs_collector.LogsCollector); ok {
+		r0 = rf(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*logs_collector.LogsCollector)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, uint16, uint16, []logs_collector.Filter, []logs_collector.Parser) error); ok {
-		r1 = rf(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, uint16, uint16, []logs_collector.Filter, []logs_collector.Parser, logs_collector.SystemLogsConfig, logs_collector.KubernetesMetadataConfig, logs_collector.BufferConfig) error); ok {
+		r1 = rf(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -425,13 +614,16 @@ type MockKurtosisBackend_CreateLogsCollectorForEnclave_Call struct {
 //   - logsCollectorTcpPortNumber uint16
 //   - logsCollectorFilters []logs_collector.Filter
 //   - logsCollectorParsers []logs_collector.Parser
-func (_e *MockKurtosisBackend_Expecter) CreateLogsCollectorForEnclave(ctx interface{}, enclaveUuid interface{}, logsCollectorHttpPortNumber interface{}, logsCollectorTcpPortNumber interface{}, logsCollectorFilters interface{}, logsCollectorParsers interface{}) *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call {
-	return &MockKurtosisBackend_CreateLogsCollectorForEnclave_Call{Call: _e.mock.On("CreateLogsCollectorForEnclave", ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers)}
+//   - logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig
+//   - logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig
+//   - logsCollectorBufferConfig logs_collector.BufferConfig
+func (_e *MockKurtosisBackend_Expecter) CreateLogsCollectorForEnclave(ctx interface{}, enclaveUuid interface{}, logsCollectorHttpPortNumber interface{}, logsCollectorTcpPortNumber interface{}, logsCollectorFilters interface{}, logsCollectorParsers interface{}, logsCollectorSystemLogsConfig interface{}, logsCollectorKubernetesMetadataConfig interface{}, logsCollectorBufferConfig interface{}) *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call {
+	return &MockKurtosisBackend_CreateLogsCollectorForEnclave_Call{Call: _e.mock.On("CreateLogsCollectorForEnclave", ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)}
 }
 
-func (_c *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, logsCollectorHttpPortNumber uint16, logsCollectorTcpPortNumber uint16, logsCollectorFilters []logs_collector.Filter, logsCollectorParsers []logs_collector.Parser)) *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call {
+func (_c *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, logsCollectorHttpPortNumber uint16, logsCollectorTcpPortNumber uint16, logsCollectorFilters []logs_collector.Filter, logsCollectorParsers []logs_collector.Parser, logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig, logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig, logsCollectorBufferConfig logs_collector.BufferConfig)) *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(uint16), args[3].(uint16), args[4].([]logs_collector.Filter), args[5].([]logs_collector.Parser))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(uint16), args[3].(uint16), args[4].([]logs_collector.Filter), args[5].([]logs_collector.Parser), args[6].(logs_collector.SystemLogsConfig), args[7].(logs_collector.KubernetesMetadataConfig), args[8].(logs_collector.BufferConfig))
 	})
 	return _c
 }
@@ -441,7 +633,7 @@ func (_c *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call) Return(_a0 *lo
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, uint16, uint16, []logs_collector.Filter, []logs_collector.Parser) (*logs_collector.LogsCollector, error)) *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call {
+func (_c *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, uint16, uint16, []logs_collector.Filter, []logs_collector.Parser, logs_collector.SystemLogsConfig, logs_collector.KubernetesMetadataConfig, logs_collector.BufferConfig) (*logs_collector.LogsCollector, error)) *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1417,6 +1609,60 @@ func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) RunAndReturn(run
 	return _c
 }
 
+// GetLogsCollectorStatuses provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error) {
+	ret := _m.Called(ctx)
+
+	var r0 map[string]container.ContainerStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]container.ContainerStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]container.ContainerStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]container.ContainerStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetLogsCollectorStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogsCollectorStatuses'
+type MockKurtosisBackend_GetLogsCollectorStatuses_Call struct {
+	*mock.Call
+}
+
+// GetLogsCollectorStatuses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) GetLogsCollectorStatuses(ctx interface{}) *MockKurtosisBackend_GetLogsCollectorStatuses_Call {
+	return &MockKurtosisBackend_GetLogsCollectorStatuses_Call{Call: _e.mock.On("GetLogsCollectorStatuses", ctx)}
+}
+
+func (_c *MockKurtosisBackend_GetLogsCollectorStatuses_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_GetLogsCollectorStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetLogsCollectorStatuses_Call) Return(_a0 map[string]container.ContainerStatus, _a1 error) *MockKurtosisBackend_GetLogsCollectorStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetLogsCollectorStatuses_Call) RunAndReturn(run func(context.Context) (map[string]container.ContainerStatus, error)) *MockKurtosisBackend_GetLogsCollectorStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReverseProxy provides a mock function with given fields: ctx
 func (_m *MockKurtosisBackend) GetReverseProxy(ctx context.Context) (*reverse_proxy.ReverseProxy, error) {
 	ret := _m.Called(ctx)
@@ -1471,18 +1717,28 @@ func (_c *MockKurtosisBackend_GetReverseProxy_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// GetShellOnUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid
-func (_m *MockKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) error {
-	ret := _m.Called(ctx, enclaveUuid, serviceUuid)
+// GetShellOnUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, shellOptions
+func (_m *MockKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions) (bool, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, shellOptions)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
-		r0 = rf(ctx, enclaveUuid, serviceUuid)
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, *service.ShellOptions) (bool, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid, shellOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, *service.ShellOptions) bool); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, shellOptions)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, *service.ShellOptions) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid, shellOptions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockKurtosisBackend_GetShellOnUserService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShellOnUserService'
@@ -1494,23 +1750,89 @@ type MockKurtosisBackend_GetShellOnUserService_Call struct {
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - serviceUuid service.ServiceUUID
-func (_e *MockKurtosisBackend_Expecter) GetShellOnUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}) *MockKurtosisBackend_GetShellOnUserService_Call {
-	return &MockKurtosisBackend_GetShellOnUserService_Call{Call: _e.mock.On("GetShellOnUserService", ctx, enclaveUuid, serviceUuid)}
+//   - shellOptions *service.ShellOptions
+func (_e *MockKurtosisBackend_Expecter) GetShellOnUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, shellOptions interface{}) *MockKurtosisBackend_GetShellOnUserService_Call {
+	return &MockKurtosisBackend_GetShellOnUserService_Call{Call: _e.mock.On("GetShellOnUserService", ctx, enclaveUuid, serviceUuid, shellOptions)}
 }
 
-func (_c *MockKurtosisBackend_GetShellOnUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID)) *MockKurtosisBackend_GetShellOnUserService_Call {
+func (_c *MockKurtosisBackend_GetShellOnUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, shellOptions *service.ShellOptions)) *MockKurtosisBackend_GetShellOnUserService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(*service.ShellOptions))
 	})
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetShellOnUserService_Call) Return(resultErr error) *MockKurtosisBackend_GetShellOnUserService_Call {
-	_c.Call.Return(resultErr)
+func (_c *MockKurtosisBackend_GetShellOnUserService_Call) Return(isSessionDisconnected bool, resultErr error) *MockKurtosisBackend_GetShellOnUserService_Call {
+	_c.Call.Return(isSessionDisconnected, resultErr)
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetShellOnUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error) *MockKurtosisBackend_GetShellOnUserService_Call {
+func (_c *MockKurtosisBackend_GetShellOnUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, *service.ShellOptions) (bool, error)) *MockKurtosisBackend_GetShellOnUserService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserServiceExitStates provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetUserServiceExitStates(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.ExitState, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]*service.ExitState
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.ExitState, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]*service.ExitState); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*service.ExitState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_GetUserServiceExitStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserServiceExitStates'
+type MockKurtosisBackend_GetUserServiceExitStates_Call struct {
+	*mock.Call
+}
+
+// GetUserServiceExitStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) GetUserServiceExitStates(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetUserServiceExitStates_Call {
+	return &MockKurtosisBackend_GetUserServiceExitStates_Call{Call: _e.mock.On("GetUserServiceExitStates", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceExitStates_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_GetUserServiceExitStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceExitStates_Call) Return(successfulUserServiceExitStates map[service.ServiceUUID]*service.ExitState, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) *MockKurtosisBackend_GetUserServiceExitStates_Call {
+	_c.Call.Return(successfulUserServiceExitStates, erroredUserServiceUuids, resultError)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceExitStates_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.ExitState, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_GetUserServiceExitStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1581,6 +1903,71 @@ func (_c *MockKurtosisBackend_GetUserServiceLogs_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetUserServiceResourceUsage provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetUserServiceResourceUsage(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.ResourceUsage, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]*service.ResourceUsage
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.ResourceUsage, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]*service.ResourceUsage); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*service.ResourceUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_GetUserServiceResourceUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserServiceResourceUsage'
+type MockKurtosisBackend_GetUserServiceResourceUsage_Call struct {
+	*mock.Call
+}
+
+// GetUserServiceResourceUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) GetUserServiceResourceUsage(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetUserServiceResourceUsage_Call {
+	return &MockKurtosisBackend_GetUserServiceResourceUsage_Call{Call: _e.mock.On("GetUserServiceResourceUsage", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceResourceUsage_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_GetUserServiceResourceUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceResourceUsage_Call) Return(successfulUserServiceResourceUsage map[service.ServiceUUID]*service.ResourceUsage, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) *MockKurtosisBackend_GetUserServiceResourceUsage_Call {
+	_c.Call.Return(successfulUserServiceResourceUsage, erroredUserServiceUuids, resultError)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceResourceUsage_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.ResourceUsage, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_GetUserServiceResourceUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserServices provides a mock function with given fields: ctx, enclaveUuid, filters
 func (_m *MockKurtosisBackend) GetUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.Service, error) {
 	ret := _m.Called(ctx, enclaveUuid, filters)
@@ -1637,6 +2024,60 @@ func (_c *MockKurtosisBackend_GetUserServices_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ListUnusedImages provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) ListUnusedImages(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_ListUnusedImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnusedImages'
+type MockKurtosisBackend_ListUnusedImages_Call struct {
+	*mock.Call
+}
+
+// ListUnusedImages is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) ListUnusedImages(ctx interface{}) *MockKurtosisBackend_ListUnusedImages_Call {
+	return &MockKurtosisBackend_ListUnusedImages_Call{Call: _e.mock.On("ListUnusedImages", ctx)}
+}
+
+func (_c *MockKurtosisBackend_ListUnusedImages_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_ListUnusedImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ListUnusedImages_Call) Return(_a0 []string, _a1 error) *MockKurtosisBackend_ListUnusedImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ListUnusedImages_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockKurtosisBackend_ListUnusedImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NixBuild provides a mock function with given fields: ctx, nixBuildSpec
 func (_m *MockKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
 	ret := _m.Called(ctx, nixBuildSpec)
@@ -2438,6 +2879,95 @@ func (_c *MockKurtosisBackend_UpdateEnclave_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpdateLogsAggregatorSinks provides a mock function with given fields: ctx, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig
+func (_m *MockKurtosisBackend) UpdateLogsAggregatorSinks(ctx context.Context, sinks logs_aggregator.Sinks, shouldEnablePersistentVolumeLogsCollection bool, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig) error {
+	ret := _m.Called(ctx, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, logs_aggregator.Sinks, bool, logs_aggregator.LogsEncryptionConfig) error); ok {
+		r0 = rf(ctx, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateLogsAggregatorSinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLogsAggregatorSinks'
+type MockKurtosisBackend_UpdateLogsAggregatorSinks_Call struct {
+	*mock.Call
+}
+
+// UpdateLogsAggregatorSinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sinks logs_aggregator.Sinks
+//   - shouldEnablePersistentVolumeLogsCollection bool
+//   - logsEncryptionConfig logs_aggregator.LogsEncryptionConfig
+func (_e *MockKurtosisBackend_Expecter) UpdateLogsAggregatorSinks(ctx interface{}, sinks interface{}, shouldEnablePersistentVolumeLogsCollection interface{}, logsEncryptionConfig interface{}) *MockKurtosisBackend_UpdateLogsAggregatorSinks_Call {
+	return &MockKurtosisBackend_UpdateLogsAggregatorSinks_Call{Call: _e.mock.On("UpdateLogsAggregatorSinks", ctx, sinks, shouldEnablePersistentVolumeLogsCollection, logsEncryptionConfig)}
+}
+
+func (_c *MockKurtosisBackend_UpdateLogsAggregatorSinks_Call) Run(run func(ctx context.Context, sinks logs_aggregator.Sinks, shouldEnablePersistentVolumeLogsCollection bool, logsEncryptionConfig logs_aggregator.LogsEncryptionConfig)) *MockKurtosisBackend_UpdateLogsAggregatorSinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(logs_aggregator.Sinks), args[2].(bool), args[3].(logs_aggregator.LogsEncryptionConfig))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateLogsAggregatorSinks_Call) Return(_a0 error) *MockKurtosisBackend_UpdateLogsAggregatorSinks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateLogsAggregatorSinks_Call) RunAndReturn(run func(context.Context, logs_aggregator.Sinks, bool, logs_aggregator.LogsEncryptionConfig) error) *MockKurtosisBackend_UpdateLogsAggregatorSinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLogsCollectorFiltersForEnclave provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) UpdateLogsCollectorFiltersForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters []logs_collector.Filter) error {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, []logs_collector.Filter) error); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLogsCollectorFiltersForEnclave'
+type MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call struct {
+	*mock.Call
+}
+
+// UpdateLogsCollectorFiltersForEnclave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters []logs_collector.Filter
+func (_e *MockKurtosisBackend_Expecter) UpdateLogsCollectorFiltersForEnclave(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call {
+	return &MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call{Call: _e.mock.On("UpdateLogsCollectorFiltersForEnclave", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters []logs_collector.Filter)) *MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].([]logs_collector.Filter))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call) Return(_a0 error) *MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, []logs_collector.Filter) error) *MockKurtosisBackend_UpdateLogsCollectorFiltersForEnclave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockKurtosisBackend interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,131 @@
+package air_gap
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	pathSeparator     = "/"
+	imageTagSeparator = ":"
+	imageDigestMarker = "@"
+	gitUrlSuffix      = ".git"
+
+	publicGitHost = "github.com"
+)
+
+// The public registries an air-gapped Kurtosis must never reach, which therefore can't be the image mirror
+var publicRegistries = map[string]bool{
+	"docker.io":            true,
+	"index.docker.io":      true,
+	"registry-1.docker.io": true,
+}
+
+// The schemes git can clone the packages from the package mirror with
+var supportedPackageMirrorSchemes = map[string]bool{
+	"http":  true,
+	"https": true,
+	"ssh":   true,
+	"file":  true,
+}
+
+// AirGapConfig makes Kurtosis resolve the images and the packages only from internal mirrors, so that it never reaches
+// docker.io nor github.com. The zero value is a valid config that leaves the air-gapped mode off.
+type AirGapConfig struct {
+	// ImageMirror is the repository the images are pulled from instead of their registry, e.g.
+	// 'registry.example.com/kurtosis'. An image is expected under the mirror with its path on its registry, e.g.
+	// 'registry.example.com/kurtosis/library/nginx:latest' for 'nginx', as 'kurtosis airgap export' tags them.
+	// The images already on the registry of the mirror are pulled as they are.
+	ImageMirror string `json:"imageMirror,omitempty"`
+
+	// PackageMirror is the git server the packages are cloned from instead of GitHub, e.g.
+	// 'https://git.example.com/kurtosis'. Package 'github.com/author/repository' is cloned from
+	// '<package mirror>/author/repository.git'.
+	PackageMirror string `json:"packageMirror,omitempty"`
+}
+
+func NewDisabledAirGapConfig() AirGapConfig {
+	return AirGapConfig{
+		ImageMirror:   "",
+		PackageMirror: "",
+	}
+}
+
+// IsEnabled returns true if the images and the packages must only be resolved from the mirrors
+func (config AirGapConfig) IsEnabled() bool {
+	return config.ImageMirror != "" || config.PackageMirror != ""
+}
+
+func (config AirGapConfig) Validate() error {
+	if !config.IsEnabled() {
+		return nil
+	}
+	imageMirror := strings.TrimSpace(config.ImageMirror)
+	if imageMirror == "" {
+		return stacktrace.NewError("The air-gapped mode requires an image mirror, as the images can't be pulled from their registry")
+	}
+	if lastFragment := imageMirror[strings.LastIndex(imageMirror, pathSeparator)+1:]; strings.Contains(lastFragment, imageTagSeparator) || strings.Contains(imageMirror, imageDigestMarker) {
+		return stacktrace.NewError("The image mirror '%v' must not contain a tag or a digest", config.ImageMirror)
+	}
+	if publicRegistries[getImageMirrorRegistry(imageMirror)] {
+		return stacktrace.NewError("The image mirror '%v' is on a public registry, which the air-gapped mode can't reach", config.ImageMirror)
+	}
+
+	packageMirror := strings.TrimSpace(config.PackageMirror)
+	if packageMirror == "" {
+		return stacktrace.NewError("The air-gapped mode requires a package mirror, as the packages can't be cloned from GitHub")
+	}
+	packageMirrorUrl, err := url.Parse(packageMirror)
+	if err != nil {
+		return stacktrace.Propagate(err, "The package mirror '%v' isn't a valid URL", config.PackageMirror)
+	}
+	if !supportedPackageMirrorSchemes[packageMirrorUrl.Scheme] {
+		return stacktrace.NewError("The package mirror '%v' must be an http, https, ssh or file URL", config.PackageMirror)
+	}
+	if strings.EqualFold(packageMirrorUrl.Hostname(), publicGitHost) {
+		return stacktrace.NewError("The package mirror '%v' is on %v, which the air-gapped mode can't reach", config.PackageMirror, publicGitHost)
+	}
+	return nil
+}
+
+// GetMirroredImageRef returns the reference to pull the image from the image mirror with, e.g.
+// 'registry.example.com/kurtosis/library/nginx:latest' for 'nginx'. Returns false if the air-gapped mode is off or the
+// image is already on the registry of the mirror.
+func (config AirGapConfig) GetMirroredImageRef(imageName string) (string, bool) {
+	if config.ImageMirror == "" {
+		return "", false
+	}
+	imageMirror := strings.TrimSuffix(strings.TrimSpace(config.ImageMirror), pathSeparator)
+	registry, remainder, err := image_utils.SplitImageReference(imageName)
+	if err != nil {
+		// Left to the pull to report
+		return "", false
+	}
+	if registry == getImageMirrorRegistry(imageMirror) {
+		return "", false
+	}
+	return imageMirror + pathSeparator + remainder, true
+}
+
+// GetMirroredPackageGitUrl returns the URL to clone the repository at the given path on GitHub, e.g.
+// 'author/repository', from. Returns false if the air-gapped mode is off.
+func (config AirGapConfig) GetMirroredPackageGitUrl(repositoryPath string) (string, bool) {
+	if config.PackageMirror == "" {
+		return "", false
+	}
+	packageMirror := strings.TrimSuffix(strings.TrimSpace(config.PackageMirror), pathSeparator)
+	return packageMirror + pathSeparator + strings.Trim(repositoryPath, pathSeparator) + gitUrlSuffix, true
+}
+
+// getImageMirrorRegistry returns the registry the image mirror is on, e.g. 'registry.example.com' for
+// 'registry.example.com/kurtosis'
+func getImageMirrorRegistry(imageMirror string) string {
+	registry, _, err := image_utils.SplitImageReference(imageMirror)
+	if err != nil {
+		return ""
+	}
+	return registry
+}
@@ -0,0 +1,73 @@
+package air_gap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testImageMirror   = "registry.example.com/kurtosis"
+	testPackageMirror = "https://git.example.com/kurtosis/"
+)
+
+func TestValidate_DisabledByDefault(t *testing.T) {
+	config := NewDisabledAirGapConfig()
+	require.NoError(t, config.Validate())
+	require.False(t, config.IsEnabled())
+}
+
+func TestValidate_RequiresBothMirrors(t *testing.T) {
+	config := AirGapConfig{ImageMirror: testImageMirror, PackageMirror: ""}
+	require.Error(t, config.Validate())
+
+	config = AirGapConfig{ImageMirror: "", PackageMirror: testPackageMirror}
+	require.Error(t, config.Validate())
+
+	config = AirGapConfig{ImageMirror: testImageMirror, PackageMirror: testPackageMirror}
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsEnabled())
+}
+
+func TestValidate_PublicMirrorsAreRejected(t *testing.T) {
+	config := AirGapConfig{ImageMirror: "kurtosistech", PackageMirror: testPackageMirror}
+	require.Error(t, config.Validate())
+
+	config = AirGapConfig{ImageMirror: testImageMirror, PackageMirror: "https://github.com/kurtosis-tech"}
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_ImageMirrorWithTagIsRejected(t *testing.T) {
+	config := AirGapConfig{ImageMirror: testImageMirror + ":latest", PackageMirror: testPackageMirror}
+	require.Error(t, config.Validate())
+}
+
+func TestGetMirroredImageRef(t *testing.T) {
+	config := AirGapConfig{ImageMirror: testImageMirror, PackageMirror: testPackageMirror}
+
+	mirroredImageRef, found := config.GetMirroredImageRef("nginx")
+	require.True(t, found)
+	require.Equal(t, "registry.example.com/kurtosis/library/nginx:latest", mirroredImageRef)
+
+	mirroredImageRef, found = config.GetMirroredImageRef("ghcr.io/some-org/some-image:1.0.0")
+	require.True(t, found)
+	require.Equal(t, "registry.example.com/kurtosis/some-org/some-image:1.0.0", mirroredImageRef)
+
+	// Already on the registry of the mirror
+	_, found = config.GetMirroredImageRef("registry.example.com/team/image:1.0.0")
+	require.False(t, found)
+
+	_, found = NewDisabledAirGapConfig().GetMirroredImageRef("nginx")
+	require.False(t, found)
+}
+
+func TestGetMirroredPackageGitUrl(t *testing.T) {
+	config := AirGapConfig{ImageMirror: testImageMirror, PackageMirror: testPackageMirror}
+
+	gitUrl, found := config.GetMirroredPackageGitUrl("kurtosis-tech/postgres-package")
+	require.True(t, found)
+	require.Equal(t, "https://git.example.com/kurtosis/kurtosis-tech/postgres-package.git", gitUrl)
+
+	_, found = NewDisabledAirGapConfig().GetMirroredPackageGitUrl("kurtosis-tech/postgres-package")
+	require.False(t, found)
+}
@@ -0,0 +1,134 @@
+package artifacts_store
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type ArtifactsStoreType string
+
+const (
+	// ArtifactsStoreType_Local keeps files artifacts only on the enclave data volume of the API container (default)
+	ArtifactsStoreType_Local ArtifactsStoreType = "local"
+	// ArtifactsStoreType_S3 stores files artifacts in an S3 (or S3-compatible, e.g. MinIO) bucket, the enclave data
+	// volume only caching them
+	ArtifactsStoreType_S3 ArtifactsStoreType = "s3"
+	// ArtifactsStoreType_GCS stores files artifacts in a Google Cloud Storage bucket through its S3-compatible XML API,
+	// authenticating with HMAC keys
+	ArtifactsStoreType_GCS ArtifactsStoreType = "gcs"
+
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	gcsDefaultRegion   = "auto"
+
+	objectKeySeparator = "/"
+
+	// Content is stored under <prefix>/content/sha256/<hash>, and objects belonging to one enclave under
+	// <prefix>/enclaves/<enclave-uuid>/<name>
+	contentObjectKeyDirname = "content"
+	contentHashAlgorithm    = "sha256"
+	enclaveObjectKeyDirname = "enclaves"
+)
+
+// ArtifactsStoreConfig describes where the API containers should store the content of files artifacts.
+// The zero value is a valid config that keeps files artifacts on the local enclave data volume.
+type ArtifactsStoreConfig struct {
+	Type ArtifactsStoreType `json:"type,omitempty"`
+
+	Bucket string `json:"bucket,omitempty"`
+
+	// Prefix prepended to every object key, so that several Kurtosis clusters can share a bucket
+	Prefix string `json:"prefix,omitempty"`
+
+	Region string `json:"region,omitempty"`
+
+	// Endpoint overrides the default endpoint of the provider, e.g. to point at a MinIO instance
+	Endpoint string `json:"endpoint,omitempty"`
+
+	AccessKeyID string `json:"accessKeyId,omitempty"`
+
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+
+	// UsePathStyle addresses the bucket as <endpoint>/<bucket> instead of <bucket>.<endpoint>, required by most
+	// self-hosted S3-compatible stores
+	UsePathStyle bool `json:"usePathStyle,omitempty"`
+
+	// MaxBytesPerEnclave caps the total size of the files artifacts of each enclave, previous versions included; storing
+	// a files artifact that would go over it fails. The cache of the files downloaded from the web by the enclave is
+	// bounded to it as well. 0 means unlimited
+	MaxBytesPerEnclave uint64 `json:"maxBytesPerEnclave,omitempty"`
+}
+
+func NewLocalArtifactsStoreConfig() ArtifactsStoreConfig {
+	return ArtifactsStoreConfig{
+		Type:               ArtifactsStoreType_Local,
+		Bucket:             "",
+		Prefix:             "",
+		Region:             "",
+		Endpoint:           "",
+		AccessKeyID:        "",
+		SecretAccessKey:    "",
+		UsePathStyle:       false,
+		MaxBytesPerEnclave: 0,
+	}
+}
+
+// IsRemote returns true if files artifacts should be stored in an object store outside the enclave
+func (config ArtifactsStoreConfig) IsRemote() bool {
+	return config.Type != "" && config.Type != ArtifactsStoreType_Local
+}
+
+func (config ArtifactsStoreConfig) Validate() error {
+	switch config.Type {
+	case "", ArtifactsStoreType_Local:
+		return nil
+	case ArtifactsStoreType_S3, ArtifactsStoreType_GCS:
+		if strings.TrimSpace(config.Bucket) == "" {
+			return stacktrace.NewError("Artifacts store of type '%v' requires a bucket", config.Type)
+		}
+		if (config.AccessKeyID == "") != (config.SecretAccessKey == "") {
+			return stacktrace.NewError("Artifacts store of type '%v' requires both an access key ID and a secret access key, or neither of them", config.Type)
+		}
+		return nil
+	default:
+		return stacktrace.NewError(
+			"Unrecognized artifacts store type '%v'; valid values are: %v",
+			config.Type,
+			strings.Join([]string{string(ArtifactsStoreType_Local), string(ArtifactsStoreType_S3), string(ArtifactsStoreType_GCS)}, ", "),
+		)
+	}
+}
+
+// GetEndpoint returns the endpoint to use to reach the object store, or an empty string if the provider default
+// should be used
+func (config ArtifactsStoreConfig) GetEndpoint() string {
+	if config.Endpoint == "" && config.Type == ArtifactsStoreType_GCS {
+		return gcsDefaultEndpoint
+	}
+	return config.Endpoint
+}
+
+func (config ArtifactsStoreConfig) GetRegion() string {
+	if config.Region == "" && config.Type == ArtifactsStoreType_GCS {
+		return gcsDefaultRegion
+	}
+	return config.Region
+}
+
+// GetContentObjectKey returns the key under which content with the given hex-encoded SHA-256 is stored in the bucket.
+// It isn't namespaced by enclave, so that the same content stored by several enclaves is only kept once
+func (config ArtifactsStoreConfig) GetContentObjectKey(contentSha256 string) string {
+	return config.getObjectKey(contentObjectKeyDirname, contentHashAlgorithm, contentSha256)
+}
+
+// GetEnclaveObjectKey returns the key under which the given object of the given enclave is stored in the bucket
+func (config ArtifactsStoreConfig) GetEnclaveObjectKey(enclaveUuid string, objectName string) string {
+	return config.getObjectKey(enclaveObjectKeyDirname, enclaveUuid, objectName)
+}
+
+func (config ArtifactsStoreConfig) getObjectKey(keyFragments ...string) string {
+	if trimmedPrefix := strings.Trim(config.Prefix, objectKeySeparator); trimmedPrefix != "" {
+		keyFragments = append([]string{trimmedPrefix}, keyFragments...)
+	}
+	return strings.Join(keyFragments, objectKeySeparator)
+}
@@ -0,0 +1,64 @@
+package artifacts_store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_ZeroValueIsLocal(t *testing.T) {
+	var config ArtifactsStoreConfig
+	require.NoError(t, config.Validate())
+	require.False(t, config.IsRemote())
+}
+
+func TestValidate_RemoteStoreRequiresBucket(t *testing.T) {
+	config := NewLocalArtifactsStoreConfig()
+	config.Type = ArtifactsStoreType_S3
+	require.Error(t, config.Validate())
+
+	config.Bucket = "kurtosis-artifacts"
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsRemote())
+}
+
+func TestValidate_PartialCredentialsAreRejected(t *testing.T) {
+	config := NewLocalArtifactsStoreConfig()
+	config.Type = ArtifactsStoreType_GCS
+	config.Bucket = "kurtosis-artifacts"
+	config.AccessKeyID = "access-key"
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_UnknownTypeIsRejected(t *testing.T) {
+	config := NewLocalArtifactsStoreConfig()
+	config.Type = "azure"
+	require.Error(t, config.Validate())
+}
+
+func TestGetEndpointAndRegion_GCSDefaults(t *testing.T) {
+	config := NewLocalArtifactsStoreConfig()
+	config.Type = ArtifactsStoreType_GCS
+	require.Equal(t, gcsDefaultEndpoint, config.GetEndpoint())
+	require.Equal(t, gcsDefaultRegion, config.GetRegion())
+
+	config.Type = ArtifactsStoreType_S3
+	require.Empty(t, config.GetEndpoint())
+	require.Empty(t, config.GetRegion())
+}
+
+func TestGetContentObjectKey(t *testing.T) {
+	config := NewLocalArtifactsStoreConfig()
+	require.Equal(t, "content/sha256/abc123", config.GetContentObjectKey("abc123"))
+
+	config.Prefix = "/kurtosis/prod/"
+	require.Equal(t, "kurtosis/prod/content/sha256/abc123", config.GetContentObjectKey("abc123"))
+}
+
+func TestGetEnclaveObjectKey(t *testing.T) {
+	config := NewLocalArtifactsStoreConfig()
+	require.Equal(t, "enclaves/enclave-uuid/index.json", config.GetEnclaveObjectKey("enclave-uuid", "index.json"))
+
+	config.Prefix = "/kurtosis/prod/"
+	require.Equal(t, "kurtosis/prod/enclaves/enclave-uuid/index.json", config.GetEnclaveObjectKey("enclave-uuid", "index.json"))
+}
@@ -0,0 +1,67 @@
+package content_scanning
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	defaultScanTimeoutSeconds = 60
+)
+
+// ContentScanningConfig describes the hook the files artifacts uploaded to the enclaves are scanned with before being
+// stored. The hook is either a webhook or a command run in the API container, and can veto the storage of an artifact.
+// The zero value is a valid config that doesn't scan anything.
+type ContentScanningConfig struct {
+	// WebhookUrl is POSTed the gzipped tarball of the artifact; any non-2xx response vetoes its storage
+	WebhookUrl string `json:"webhookUrl,omitempty"`
+
+	// ExecCommand is run with the path of the gzipped tarball of the artifact as last argument; a non-zero exit code
+	// vetoes its storage
+	ExecCommand []string `json:"execCommand,omitempty"`
+
+	// TimeoutSeconds is how long a scan can take before the artifact is rejected; defaults to 60 seconds
+	TimeoutSeconds uint32 `json:"timeoutSeconds,omitempty"`
+}
+
+func NewDisabledContentScanningConfig() ContentScanningConfig {
+	return ContentScanningConfig{
+		WebhookUrl:     "",
+		ExecCommand:    nil,
+		TimeoutSeconds: 0,
+	}
+}
+
+// IsEnabled returns true if the uploaded files artifacts must be scanned to be stored
+func (config ContentScanningConfig) IsEnabled() bool {
+	return config.WebhookUrl != "" || len(config.ExecCommand) > 0
+}
+
+func (config ContentScanningConfig) GetTimeout() time.Duration {
+	if config.TimeoutSeconds == 0 {
+		return defaultScanTimeoutSeconds * time.Second
+	}
+	return time.Duration(config.TimeoutSeconds) * time.Second
+}
+
+func (config ContentScanningConfig) Validate() error {
+	if config.WebhookUrl != "" && len(config.ExecCommand) > 0 {
+		return stacktrace.NewError("Content scanning can use either a webhook or a command, but both were given")
+	}
+	if config.WebhookUrl != "" {
+		webhookUrl, err := url.Parse(config.WebhookUrl)
+		if err != nil {
+			return stacktrace.Propagate(err, "The content scanning webhook URL '%v' isn't valid", config.WebhookUrl)
+		}
+		if webhookUrl.Scheme != "http" && webhookUrl.Scheme != "https" {
+			return stacktrace.NewError("The content scanning webhook URL '%v' must be an http or https URL", config.WebhookUrl)
+		}
+	}
+	if len(config.ExecCommand) > 0 && strings.TrimSpace(config.ExecCommand[0]) == "" {
+		return stacktrace.NewError("The content scanning command has an empty executable")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package content_scanning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_DisabledByDefault(t *testing.T) {
+	config := NewDisabledContentScanningConfig()
+	require.NoError(t, config.Validate())
+	require.False(t, config.IsEnabled())
+	require.Equal(t, defaultScanTimeoutSeconds*time.Second, config.GetTimeout())
+}
+
+func TestValidate_WebhookAndCommandAreExclusive(t *testing.T) {
+	config := NewDisabledContentScanningConfig()
+	config.WebhookUrl = "https://scanner.internal/scan"
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsEnabled())
+
+	config.ExecCommand = []string{"/usr/bin/clamscan"}
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_InvalidWebhookUrlIsRejected(t *testing.T) {
+	config := NewDisabledContentScanningConfig()
+	config.WebhookUrl = "ftp://scanner.internal/scan"
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_EmptyExecutableIsRejected(t *testing.T) {
+	config := NewDisabledContentScanningConfig()
+	config.ExecCommand = []string{" ", "--quiet"}
+	require.Error(t, config.Validate())
+}
@@ -0,0 +1,127 @@
+package egress_policy
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	ipv4BitLength = 32
+	ipv6BitLength = 128
+)
+
+// EgressPolicy restricts the outbound traffic of the services of an enclave to the given CIDRs and domains. The
+// traffic within the enclave, the answers to inbound connections and the DNS lookups are always allowed, so an empty
+// policy cuts the services off from everything outside of the enclave.
+type EgressPolicy struct {
+	AllowedCidrs []string `json:"allowedCidrs,omitempty"`
+
+	// AllowedDomains are resolved when the policy is enforced, i.e. when the enclave is created; the addresses they
+	// resolve to later on aren't allowed
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+}
+
+// NewEgressPolicyFromAllowlist sorts the entries of an allowlist into CIDRs and domains; a bare IP address is allowed
+// as the CIDR of that single address
+func NewEgressPolicyFromAllowlist(allowlist []string) (*EgressPolicy, error) {
+	egressPolicy := &EgressPolicy{
+		AllowedCidrs:   []string{},
+		AllowedDomains: []string{},
+	}
+	for _, entry := range allowlist {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(entry); err == nil {
+			egressPolicy.AllowedCidrs = append(egressPolicy.AllowedCidrs, entry)
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			egressPolicy.AllowedCidrs = append(egressPolicy.AllowedCidrs, getSingleAddressCidr(ip).String())
+			continue
+		}
+		egressPolicy.AllowedDomains = append(egressPolicy.AllowedDomains, entry)
+	}
+	if err := egressPolicy.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The egress allowlist '%v' is invalid", strings.Join(allowlist, ","))
+	}
+	return egressPolicy, nil
+}
+
+func (policy *EgressPolicy) Validate() error {
+	for _, cidr := range policy.AllowedCidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return stacktrace.Propagate(err, "Allowed CIDR '%v' isn't a valid CIDR", cidr)
+		}
+	}
+	for _, domain := range policy.AllowedDomains {
+		if !isValidDomain(domain) {
+			return stacktrace.NewError("Allowed domain '%v' is neither a CIDR, an IP address nor a valid domain name", domain)
+		}
+	}
+	return nil
+}
+
+// ResolveAllowedCidrs returns the allowed CIDRs along with the addresses the allowed domains resolve to, sorted and
+// without duplicates
+func (policy *EgressPolicy) ResolveAllowedCidrs(ctx context.Context, resolver *net.Resolver) ([]*net.IPNet, error) {
+	allowedCidrsByString := map[string]*net.IPNet{}
+	for _, cidr := range policy.AllowedCidrs {
+		_, allowedCidr, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Allowed CIDR '%v' isn't a valid CIDR", cidr)
+		}
+		allowedCidrsByString[allowedCidr.String()] = allowedCidr
+	}
+	for _, domain := range policy.AllowedDomains {
+		addresses, err := resolver.LookupIPAddr(ctx, domain)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving allowed domain '%v'", domain)
+		}
+		for _, address := range addresses {
+			allowedCidr := getSingleAddressCidr(address.IP)
+			allowedCidrsByString[allowedCidr.String()] = allowedCidr
+		}
+	}
+
+	allowedCidrStrs := []string{}
+	for allowedCidrStr := range allowedCidrsByString {
+		allowedCidrStrs = append(allowedCidrStrs, allowedCidrStr)
+	}
+	sort.Strings(allowedCidrStrs)
+	result := []*net.IPNet{}
+	for _, allowedCidrStr := range allowedCidrStrs {
+		result = append(result, allowedCidrsByString[allowedCidrStr])
+	}
+	return result, nil
+}
+
+func getSingleAddressCidr(ip net.IP) *net.IPNet {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return &net.IPNet{IP: ipv4, Mask: net.CIDRMask(ipv4BitLength, ipv4BitLength)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(ipv6BitLength, ipv6BitLength)}
+}
+
+func isValidDomain(domain string) bool {
+	if domain == "" || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return false
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+		for _, char := range label {
+			isAlphanumeric := (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9')
+			if !isAlphanumeric && char != '-' {
+				return false
+			}
+		}
+	}
+	return true
+}
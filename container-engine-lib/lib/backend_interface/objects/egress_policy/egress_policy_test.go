@@ -0,0 +1,55 @@
+package egress_policy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEgressPolicyFromAllowlist(t *testing.T) {
+	egressPolicy, err := NewEgressPolicyFromAllowlist([]string{"10.0.0.0/8", " 1.2.3.4", "api.example.com", "", "2001:db8::1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.0/8", "1.2.3.4/32", "2001:db8::1/128"}, egressPolicy.AllowedCidrs)
+	require.Equal(t, []string{"api.example.com"}, egressPolicy.AllowedDomains)
+
+	// An empty allowlist cuts the services off from everything outside of the enclave
+	egressPolicy, err = NewEgressPolicyFromAllowlist([]string{})
+	require.NoError(t, err)
+	require.Empty(t, egressPolicy.AllowedCidrs)
+	require.Empty(t, egressPolicy.AllowedDomains)
+
+	_, err = NewEgressPolicyFromAllowlist([]string{"10.0.0.0/33"})
+	require.Error(t, err)
+	_, err = NewEgressPolicyFromAllowlist([]string{"https://api.example.com"})
+	require.Error(t, err)
+}
+
+func TestResolveAllowedCidrs(t *testing.T) {
+	egressPolicy := &EgressPolicy{
+		AllowedCidrs:   []string{"10.1.2.3/8", "10.0.0.0/8"},
+		AllowedDomains: []string{"localhost"},
+	}
+	allowedCidrs, err := egressPolicy.ResolveAllowedCidrs(context.Background(), net.DefaultResolver)
+	require.NoError(t, err)
+
+	allowedCidrStrs := []string{}
+	for _, allowedCidr := range allowedCidrs {
+		allowedCidrStrs = append(allowedCidrStrs, allowedCidr.String())
+	}
+	require.Contains(t, allowedCidrStrs, "10.0.0.0/8")
+	require.Contains(t, allowedCidrStrs, "127.0.0.1/32")
+	// The CIDRs covering the same addresses are only allowed once
+	require.Equal(t, 1, countOccurrences(allowedCidrStrs, "10.0.0.0/8"))
+}
+
+func countOccurrences(values []string, value string) int {
+	occurrences := 0
+	for _, candidate := range values {
+		if candidate == value {
+			occurrences++
+		}
+	}
+	return occurrences
+}
@@ -0,0 +1,75 @@
+package enclave_quota
+
+import (
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/backend_errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+// EnclaveQuota caps the resources the services of each enclave can claim. Every limit left to 0 is unlimited.
+// The zero value is a valid quota that doesn't limit anything.
+type EnclaveQuota struct {
+	MaxServices uint32 `json:"maxServices,omitempty"`
+
+	// MaxCpuMilliCores caps the sum of the max_cpu of the services of the enclave; once set, every service has to
+	// declare a max_cpu
+	MaxCpuMilliCores uint64 `json:"maxCpuMilliCores,omitempty"`
+
+	// MaxMemoryMegabytes caps the sum of the max_memory of the services of the enclave; once set, every service has to
+	// declare a max_memory
+	MaxMemoryMegabytes uint64 `json:"maxMemoryMegabytes,omitempty"`
+}
+
+func NewUnlimitedEnclaveQuota() EnclaveQuota {
+	return EnclaveQuota{
+		MaxServices:        0,
+		MaxCpuMilliCores:   0,
+		MaxMemoryMegabytes: 0,
+	}
+}
+
+func (quota EnclaveQuota) IsUnlimited() bool {
+	return quota.MaxServices == 0 && quota.MaxCpuMilliCores == 0 && quota.MaxMemoryMegabytes == 0
+}
+
+// Check returns an error describing the first limit of the quota that an enclave running the given services would
+// go over. Services registered but not started yet have a nil config and only count towards the number of services
+func (quota EnclaveQuota) Check(serviceConfigs map[service.ServiceName]*service.ServiceConfig) error {
+	if quota.MaxServices != 0 && len(serviceConfigs) > int(quota.MaxServices) {
+		return backend_errors.NewBackendError(backend_errors.ErrorKind_QuotaExceeded, "The enclave quota allows at most %d services but the enclave would have %d", quota.MaxServices, len(serviceConfigs))
+	}
+
+	serviceNames := []string{}
+	for serviceName := range serviceConfigs {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	sort.Strings(serviceNames)
+
+	totalCpuMilliCores := uint64(0)
+	totalMemoryMegabytes := uint64(0)
+	for _, serviceNameStr := range serviceNames {
+		serviceConfig := serviceConfigs[service.ServiceName(serviceNameStr)]
+		if serviceConfig == nil {
+			continue
+		}
+		cpuMilliCores := serviceConfig.GetCPUAllocationMillicpus()
+		if quota.MaxCpuMilliCores != 0 && cpuMilliCores == 0 {
+			return backend_errors.NewBackendError(backend_errors.ErrorKind_QuotaExceeded, "The enclave quota caps the total CPU of the enclave at %d millicores, so service '%s' has to set max_cpu", quota.MaxCpuMilliCores, serviceNameStr)
+		}
+		memoryMegabytes := serviceConfig.GetMemoryAllocationMegabytes()
+		if quota.MaxMemoryMegabytes != 0 && memoryMegabytes == 0 {
+			return backend_errors.NewBackendError(backend_errors.ErrorKind_QuotaExceeded, "The enclave quota caps the total memory of the enclave at %d megabytes, so service '%s' has to set max_memory", quota.MaxMemoryMegabytes, serviceNameStr)
+		}
+		totalCpuMilliCores += cpuMilliCores
+		totalMemoryMegabytes += memoryMegabytes
+	}
+
+	if quota.MaxCpuMilliCores != 0 && totalCpuMilliCores > quota.MaxCpuMilliCores {
+		return backend_errors.NewBackendError(backend_errors.ErrorKind_QuotaExceeded, "The enclave quota caps the total CPU of the enclave at %d millicores but its services would have a max_cpu of %d millicores in total", quota.MaxCpuMilliCores, totalCpuMilliCores)
+	}
+	if quota.MaxMemoryMegabytes != 0 && totalMemoryMegabytes > quota.MaxMemoryMegabytes {
+		return backend_errors.NewBackendError(backend_errors.ErrorKind_QuotaExceeded, "The enclave quota caps the total memory of the enclave at %d megabytes but its services would have a max_memory of %d megabytes in total", quota.MaxMemoryMegabytes, totalMemoryMegabytes)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package enclave_quota
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/backend_errors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck_UnlimitedQuotaAcceptsAnything(t *testing.T) {
+	quota := NewUnlimitedEnclaveQuota()
+	require.True(t, quota.IsUnlimited())
+	require.NoError(t, quota.Check(map[service.ServiceName]*service.ServiceConfig{
+		"a": newServiceConfigForTest(t, 0, 0),
+		"b": newServiceConfigForTest(t, 100000, 100000),
+	}))
+}
+
+func TestCheck_MaxServices(t *testing.T) {
+	quota := EnclaveQuota{MaxServices: 2, MaxCpuMilliCores: 0, MaxMemoryMegabytes: 0}
+	require.NoError(t, quota.Check(map[service.ServiceName]*service.ServiceConfig{
+		"a": newServiceConfigForTest(t, 0, 0),
+		"b": nil,
+	}))
+	err := quota.Check(map[service.ServiceName]*service.ServiceConfig{
+		"a": newServiceConfigForTest(t, 0, 0),
+		"b": nil,
+		"c": newServiceConfigForTest(t, 0, 0),
+	})
+	require.ErrorContains(t, err, "at most 2 services")
+	backendErr, isBackendErr := backend_errors.GetBackendError(err)
+	require.True(t, isBackendErr)
+	require.Equal(t, backend_errors.ErrorKind_QuotaExceeded, backendErr.GetKind())
+}
+
+func TestCheck_CpuAndMemory(t *testing.T) {
+	quota := EnclaveQuota{MaxServices: 0, MaxCpuMilliCores: 1000, MaxMemoryMegabytes: 512}
+	require.NoError(t, quota.Check(map[service.ServiceName]*service.ServiceConfig{
+		"a": newServiceConfigForTest(t, 500, 256),
+		"b": newServiceConfigForTest(t, 500, 256),
+	}))
+
+	err := quota.Check(map[service.ServiceName]*service.ServiceConfig{
+		"a": newServiceConfigForTest(t, 500, 256),
+		"b": newServiceConfigForTest(t, 501, 256),
+	})
+	require.ErrorContains(t, err, "max_cpu of 1001 millicores")
+
+	err = quota.Check(map[service.ServiceName]*service.ServiceConfig{
+		"a": newServiceConfigForTest(t, 500, 256),
+		"b": newServiceConfigForTest(t, 500, 257),
+	})
+	require.ErrorContains(t, err, "max_memory of 513 megabytes")
+}
+
+func TestCheck_LimitsAreRequiredOnceCapped(t *testing.T) {
+	quota := EnclaveQuota{MaxServices: 0, MaxCpuMilliCores: 1000, MaxMemoryMegabytes: 0}
+	err := quota.Check(map[service.ServiceName]*service.ServiceConfig{
+		"a": newServiceConfigForTest(t, 0, 256),
+	})
+	require.ErrorContains(t, err, "service 'a' has to set max_cpu")
+
+	quota = EnclaveQuota{MaxServices: 0, MaxCpuMilliCores: 0, MaxMemoryMegabytes: 512}
+	err = quota.Check(map[service.ServiceName]*service.ServiceConfig{
+		"a": newServiceConfigForTest(t, 500, 0),
+	})
+	require.ErrorContains(t, err, "service 'a' has to set max_memory")
+}
+
+func newServiceConfigForTest(t *testing.T, cpuAllocationMillicpus uint64, memoryAllocationMegabytes uint64) *service.ServiceConfig {
+	serviceConfig, err := service.CreateServiceConfig("image", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, cpuAllocationMillicpus, memoryAllocationMegabytes, "", 0, 0, nil, nil, nil, nil, image_download_mode.ImageDownloadMode_Missing, false)
+	require.NoError(t, err)
+	return serviceConfig
+}
@@ -4,4 +4,8 @@ const (
 	RESTAPIPortAddr       uint16 = 9779
 	RESTAPIHostIP         string = "0.0.0.0"
 	RESTAPIPortHostHeader string = "engine"
+
+	// Set on the engine replicas running on Kubernetes, which need their pod and namespace names to elect a leader
+	PodNameEnvVar      = "KURTOSIS_ENGINE_POD_NAME"
+	PodNamespaceEnvVar = "KURTOSIS_ENGINE_POD_NAMESPACE"
 )
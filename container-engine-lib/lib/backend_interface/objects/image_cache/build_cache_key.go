@@ -0,0 +1,98 @@
+package image_cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Bump this whenever the way the key is computed changes, so that keys computed by older versions never match
+	buildCacheKeyVersion = "v1"
+
+	hashedFieldSeparator = "\x00"
+)
+
+// ComputeBuildCacheKey returns a key identifying the content an image is built from: the files of the build context,
+// the build file, the build args and the target stage. Two builds with the same key are expected to produce the same
+// image, which is what allows reusing a previously built image instead of building it again.
+func ComputeBuildCacheKey(buildContextDirPath string, buildFile string, buildArgs map[string]string, targetStage string) (string, error) {
+	hasher := sha256.New()
+	writeHashedFields(hasher, buildCacheKeyVersion, buildFile, targetStage)
+
+	buildArgNames := make([]string, 0, len(buildArgs))
+	for buildArgName := range buildArgs {
+		buildArgNames = append(buildArgNames, buildArgName)
+	}
+	sort.Strings(buildArgNames)
+	for _, buildArgName := range buildArgNames {
+		writeHashedFields(hasher, "arg", buildArgName, buildArgs[buildArgName])
+	}
+
+	// WalkDir visits the files in lexical order, so the key doesn't depend on the order the filesystem lists them in
+	err := filepath.WalkDir(buildContextDirPath, func(path string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return stacktrace.Propagate(walkErr, "An error occurred walking '%v'", path)
+		}
+		relativePath, err := filepath.Rel(buildContextDirPath, path)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to the build context", path)
+		}
+		fileInfo, err := entry.Info()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the info of '%v'", path)
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		fileMode := fileInfo.Mode()
+		switch {
+		case fileMode.IsDir():
+			writeHashedFields(hasher, "dir", relativePath, fileMode.Perm().String())
+		case fileMode&fs.ModeSymlink != 0:
+			linkTarget, err := os.Readlink(path)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading the target of symlink '%v'", path)
+			}
+			writeHashedFields(hasher, "symlink", relativePath, linkTarget)
+		case fileMode.IsRegular():
+			writeHashedFields(hasher, "file", relativePath, fileMode.Perm().String(), fmt.Sprintf("%d", fileInfo.Size()))
+			if err := hashFileContent(hasher, path); err != nil {
+				return stacktrace.Propagate(err, "An error occurred hashing the content of '%v'", path)
+			}
+		default:
+			// Sockets, devices and the like can't be part of an image build context
+		}
+		return nil
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred hashing the build context at '%v'", buildContextDirPath)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func writeHashedFields(hasher hash.Hash, fields ...string) {
+	for _, field := range fields {
+		// Writing to a hash never returns an error
+		_, _ = io.WriteString(hasher, field)
+		_, _ = io.WriteString(hasher, hashedFieldSeparator)
+	}
+}
+
+func hashFileContent(hasher hash.Hash, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening '%v'", path)
+	}
+	defer file.Close()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading '%v'", path)
+	}
+	return nil
+}
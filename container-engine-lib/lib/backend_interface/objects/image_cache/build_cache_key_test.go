@@ -0,0 +1,58 @@
+package image_cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFilePerms = 0644
+	testDirPerms  = 0755
+)
+
+func TestComputeBuildCacheKey_SameContentSameKey(t *testing.T) {
+	firstContextDir := createBuildContext(t, "FROM alpine\n", "hello")
+	secondContextDir := createBuildContext(t, "FROM alpine\n", "hello")
+
+	firstKey, err := ComputeBuildCacheKey(firstContextDir, "Dockerfile", map[string]string{"A": "1", "B": "2"}, "")
+	require.NoError(t, err)
+	secondKey, err := ComputeBuildCacheKey(secondContextDir, "Dockerfile", map[string]string{"B": "2", "A": "1"}, "")
+	require.NoError(t, err)
+	require.Equal(t, firstKey, secondKey)
+}
+
+func TestComputeBuildCacheKey_ContentChangesKey(t *testing.T) {
+	contextDir := createBuildContext(t, "FROM alpine\n", "hello")
+	originalKey, err := ComputeBuildCacheKey(contextDir, "Dockerfile", nil, "")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(contextDir, "src", "main.txt"), []byte("hello, world"), testFilePerms))
+	changedKey, err := ComputeBuildCacheKey(contextDir, "Dockerfile", nil, "")
+	require.NoError(t, err)
+	require.NotEqual(t, originalKey, changedKey)
+}
+
+func TestComputeBuildCacheKey_BuildParametersChangeKey(t *testing.T) {
+	contextDir := createBuildContext(t, "FROM alpine\n", "hello")
+	originalKey, err := ComputeBuildCacheKey(contextDir, "Dockerfile", map[string]string{"A": "1"}, "")
+	require.NoError(t, err)
+
+	otherArgKey, err := ComputeBuildCacheKey(contextDir, "Dockerfile", map[string]string{"A": "2"}, "")
+	require.NoError(t, err)
+	require.NotEqual(t, originalKey, otherArgKey)
+
+	otherTargetKey, err := ComputeBuildCacheKey(contextDir, "Dockerfile", map[string]string{"A": "1"}, "server")
+	require.NoError(t, err)
+	require.NotEqual(t, originalKey, otherTargetKey)
+}
+
+func createBuildContext(t *testing.T, dockerfileContent string, sourceContent string) string {
+	contextDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(contextDir, "Dockerfile"), []byte(dockerfileContent), testFilePerms))
+	require.NoError(t, os.Mkdir(filepath.Join(contextDir, "src"), testDirPerms))
+	require.NoError(t, os.WriteFile(filepath.Join(contextDir, "src", "main.txt"), []byte(sourceContent), testFilePerms))
+	return contextDir
+}
@@ -0,0 +1,158 @@
+package image_cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type ImageCacheType string
+
+const (
+	// ImageCacheType_Local reuses images built from the same content on the same container engine, across enclaves and runs (default)
+	ImageCacheType_Local ImageCacheType = "local"
+	// ImageCacheType_Registry additionally pushes built and pulled images to a registry, so that other container
+	// engines (e.g. ephemeral CI runners) can pull them instead of rebuilding or repulling them from their origin
+	ImageCacheType_Registry ImageCacheType = "registry"
+	// ImageCacheType_None always builds images from scratch, only relying on the layer cache of the container engine
+	ImageCacheType_None ImageCacheType = "none"
+
+	buildCacheTagPrefix = "build-"
+	pullCacheTagPrefix  = "pull-"
+
+	repositoryTagSeparator  = ":"
+	repositoryPathSeparator = "/"
+
+	// How many images an enclave pulls at once if the config doesn't say otherwise; enough to overlap the round trips
+	// to the registries without saturating the bandwidth of a laptop
+	defaultMaxConcurrentPulls = 4
+)
+
+// ImageCacheConfig describes how images built or pulled by the API containers are cached across enclaves and runs.
+// The zero value is a valid config that caches built images on the local container engine.
+type ImageCacheConfig struct {
+	Type ImageCacheType `json:"type,omitempty"`
+
+	// Repository the cached images are pushed to and pulled from when the cache is registry-backed,
+	// e.g. 'registry.example.com/kurtosis-cache'. Every cached image is a tag of this repository
+	Repository string `json:"repository,omitempty"`
+
+	// Username and Password authenticate against the registry of the repository; if unset, the credentials of the
+	// Docker config file are used, if any
+	Username string `json:"username,omitempty"`
+
+	Password string `json:"password,omitempty"`
+
+	// PullThroughCaches maps registries, e.g. 'docker.io' or 'ghcr.io', to the pull-through cache proxying them, e.g.
+	// 'harbor.example.com/dockerhub-proxy'. The images of these registries are pulled through their cache, falling back
+	// to the registry itself if the cache fails. Independent of the type of the cache.
+	PullThroughCaches map[string]string `json:"pullThroughCaches,omitempty"`
+
+	// MaxConcurrentPulls caps how many distinct images an enclave pulls at once; the default applies if zero
+	MaxConcurrentPulls uint16 `json:"maxConcurrentPulls,omitempty"`
+}
+
+func NewLocalImageCacheConfig() ImageCacheConfig {
+	return ImageCacheConfig{
+		Type:               ImageCacheType_Local,
+		Repository:         "",
+		Username:           "",
+		Password:           "",
+		PullThroughCaches:  nil,
+		MaxConcurrentPulls: 0,
+	}
+}
+
+// IsEnabled returns true if images built from the same content should be reused instead of being rebuilt
+func (config ImageCacheConfig) IsEnabled() bool {
+	return config.Type != ImageCacheType_None
+}
+
+// IsRegistryBacked returns true if cached images should also be shared through a registry
+func (config ImageCacheConfig) IsRegistryBacked() bool {
+	return config.Type == ImageCacheType_Registry
+}
+
+// GetMaxConcurrentPulls returns how many distinct images an enclave pulls at once
+func (config ImageCacheConfig) GetMaxConcurrentPulls() int64 {
+	if config.MaxConcurrentPulls == 0 {
+		return defaultMaxConcurrentPulls
+	}
+	return int64(config.MaxConcurrentPulls)
+}
+
+// GetPullThroughCacheImageRef returns the reference to pull the image through the pull-through cache of its registry
+// with, e.g. 'harbor.example.com/dockerhub-proxy/library/nginx:latest' for 'nginx'. Returns false if its registry
+// has no pull-through cache.
+func (config ImageCacheConfig) GetPullThroughCacheImageRef(imageName string) (string, bool) {
+	if len(config.PullThroughCaches) == 0 {
+		return "", false
+	}
+	registry, remainder, err := image_utils.SplitImageReference(imageName)
+	if err != nil {
+		return "", false
+	}
+	pullThroughCache, found := config.PullThroughCaches[registry]
+	if !found {
+		return "", false
+	}
+	return strings.TrimSuffix(strings.TrimSpace(pullThroughCache), repositoryPathSeparator) + repositoryPathSeparator + remainder, true
+}
+
+func (config ImageCacheConfig) Validate() error {
+	for registry, pullThroughCache := range config.PullThroughCaches {
+		if strings.TrimSpace(registry) == "" {
+			return stacktrace.NewError("The registries proxied by pull-through caches can't be empty")
+		}
+		trimmedPullThroughCache := strings.TrimSpace(pullThroughCache)
+		if trimmedPullThroughCache == "" {
+			return stacktrace.NewError("The pull-through cache of registry '%v' can't be empty", registry)
+		}
+		if lastFragment := trimmedPullThroughCache[strings.LastIndex(trimmedPullThroughCache, repositoryPathSeparator)+1:]; strings.Contains(lastFragment, repositoryTagSeparator) || strings.Contains(trimmedPullThroughCache, "@") {
+			return stacktrace.NewError("The pull-through cache '%v' of registry '%v' must not contain a tag or a digest", pullThroughCache, registry)
+		}
+	}
+
+	switch config.Type {
+	case "", ImageCacheType_Local, ImageCacheType_None:
+		return nil
+	case ImageCacheType_Registry:
+		repository := strings.TrimSpace(config.Repository)
+		if repository == "" {
+			return stacktrace.NewError("Image cache of type '%v' requires a repository", config.Type)
+		}
+		if lastFragment := repository[strings.LastIndex(repository, "/")+1:]; strings.Contains(lastFragment, repositoryTagSeparator) || strings.Contains(repository, "@") {
+			return stacktrace.NewError("Image cache repository '%v' must not contain a tag or a digest; Kurtosis tags the cached images itself", repository)
+		}
+		if (config.Username == "") != (config.Password == "") {
+			return stacktrace.NewError("Image cache of type '%v' requires both a username and a password, or neither of them", config.Type)
+		}
+		return nil
+	default:
+		return stacktrace.NewError(
+			"Unrecognized image cache type '%v'; valid values are: %v",
+			config.Type,
+			strings.Join([]string{string(ImageCacheType_Local), string(ImageCacheType_Registry), string(ImageCacheType_None)}, ", "),
+		)
+	}
+}
+
+// GetBuildCacheImageRef returns the reference under which the image built with the given build cache key is stored
+// in the cache registry
+func (config ImageCacheConfig) GetBuildCacheImageRef(buildCacheKey string) string {
+	return config.getCacheImageRef(buildCacheTagPrefix + buildCacheKey)
+}
+
+// GetPullCacheImageRef returns the reference under which the given pulled image is mirrored in the cache registry
+func (config ImageCacheConfig) GetPullCacheImageRef(imageName string) string {
+	imageNameHash := sha256.Sum256([]byte(imageName))
+	return config.getCacheImageRef(pullCacheTagPrefix + hex.EncodeToString(imageNameHash[:]))
+}
+
+func (config ImageCacheConfig) getCacheImageRef(tag string) string {
+	return fmt.Sprintf("%v%v%v", strings.TrimSpace(config.Repository), repositoryTagSeparator, tag)
+}
@@ -0,0 +1,97 @@
+package image_cache
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_ZeroValueIsLocal(t *testing.T) {
+	var config ImageCacheConfig
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsEnabled())
+	require.False(t, config.IsRegistryBacked())
+}
+
+func TestValidate_RegistryCacheRequiresRepository(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	config.Type = ImageCacheType_Registry
+	require.Error(t, config.Validate())
+
+	config.Repository = "registry.example.com:5000/kurtosis-cache"
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsRegistryBacked())
+}
+
+func TestValidate_RepositoryWithTagIsRejected(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	config.Type = ImageCacheType_Registry
+	config.Repository = "registry.example.com/kurtosis-cache:latest"
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_PartialCredentialsAreRejected(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	config.Type = ImageCacheType_Registry
+	config.Repository = "registry.example.com/kurtosis-cache"
+	config.Username = "kurtosis"
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_UnknownTypeIsRejected(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	config.Type = "s3"
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_NoneDisablesCache(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	config.Type = ImageCacheType_None
+	require.NoError(t, config.Validate())
+	require.False(t, config.IsEnabled())
+}
+
+func TestGetCacheImageRefs(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	config.Type = ImageCacheType_Registry
+	config.Repository = "registry.example.com/kurtosis-cache"
+
+	require.Equal(t, "registry.example.com/kurtosis-cache:build-abc", config.GetBuildCacheImageRef("abc"))
+
+	pullCacheImageRef := config.GetPullCacheImageRef("postgres:16")
+	require.True(t, strings.HasPrefix(pullCacheImageRef, "registry.example.com/kurtosis-cache:pull-"))
+	require.Equal(t, pullCacheImageRef, config.GetPullCacheImageRef("postgres:16"))
+	require.NotEqual(t, pullCacheImageRef, config.GetPullCacheImageRef("postgres:15"))
+}
+
+func TestGetPullThroughCacheImageRef(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	config.PullThroughCaches = map[string]string{
+		"docker.io": "harbor.example.com/dockerhub-proxy/",
+	}
+	require.NoError(t, config.Validate())
+
+	pullThroughCacheImageRef, found := config.GetPullThroughCacheImageRef("nginx")
+	require.True(t, found)
+	require.Equal(t, "harbor.example.com/dockerhub-proxy/library/nginx:latest", pullThroughCacheImageRef)
+
+	_, found = config.GetPullThroughCacheImageRef("ghcr.io/org/app:1.0")
+	require.False(t, found)
+}
+
+func TestValidate_PullThroughCacheWithTagIsRejected(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	config.PullThroughCaches = map[string]string{
+		"docker.io": "harbor.example.com/dockerhub-proxy:latest",
+	}
+	require.Error(t, config.Validate())
+}
+
+func TestGetMaxConcurrentPulls(t *testing.T) {
+	config := NewLocalImageCacheConfig()
+	require.Equal(t, int64(defaultMaxConcurrentPulls), config.GetMaxConcurrentPulls())
+
+	config.MaxConcurrentPulls = 16
+	require.Equal(t, int64(16), config.GetMaxConcurrentPulls())
+}
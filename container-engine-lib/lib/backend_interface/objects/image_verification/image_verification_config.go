@@ -0,0 +1,87 @@
+package image_verification
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// AllowedSigner is a key the images can be signed with, as by 'cosign sign --key'
+type AllowedSigner struct {
+	// Name the signer is referred to by in the verification errors
+	Name string `json:"name"`
+
+	// PEM-encoded public key, as written by 'cosign generate-key-pair'
+	PublicKey string `json:"publicKey"`
+}
+
+// ImageVerificationConfig describes the signatures the images of the plans must carry to be started. The zero value is
+// a valid config that doesn't verify any image.
+type ImageVerificationConfig struct {
+	// AllowedSigners are the keys an image must be signed with one of; the images aren't verified if there's none
+	AllowedSigners []AllowedSigner `json:"allowedSigners,omitempty"`
+
+	// RequiredAttestations are the predicate types, e.g. 'https://slsa.dev/provenance/v1', of the attestations an image
+	// must carry, each signed by one of the allowed signers
+	RequiredAttestations []string `json:"requiredAttestations,omitempty"`
+
+	// AllowLocallyBuiltImages lets the plans use the images built from their package, which can't be signed
+	AllowLocallyBuiltImages bool `json:"allowLocallyBuiltImages,omitempty"`
+}
+
+func NewDisabledImageVerificationConfig() ImageVerificationConfig {
+	return ImageVerificationConfig{
+		AllowedSigners:          nil,
+		RequiredAttestations:    nil,
+		AllowLocallyBuiltImages: false,
+	}
+}
+
+// IsEnabled returns true if the images must be signed to be started
+func (config ImageVerificationConfig) IsEnabled() bool {
+	return len(config.AllowedSigners) > 0
+}
+
+func (config ImageVerificationConfig) Validate() error {
+	if !config.IsEnabled() {
+		if len(config.RequiredAttestations) > 0 {
+			return stacktrace.NewError("Image verification requires attestations but has no allowed signer to verify them with")
+		}
+		return nil
+	}
+	signerNames := map[string]bool{}
+	for _, signer := range config.AllowedSigners {
+		if strings.TrimSpace(signer.Name) == "" {
+			return stacktrace.NewError("An allowed signer of the image verification has no name")
+		}
+		if signerNames[signer.Name] {
+			return stacktrace.NewError("Allowed signer '%v' of the image verification is declared more than once", signer.Name)
+		}
+		signerNames[signer.Name] = true
+		if _, err := ParsePublicKey(signer.PublicKey); err != nil {
+			return stacktrace.Propagate(err, "Allowed signer '%v' of the image verification has an invalid public key", signer.Name)
+		}
+	}
+	for _, predicateType := range config.RequiredAttestations {
+		if strings.TrimSpace(predicateType) == "" {
+			return stacktrace.NewError("Image verification requires an attestation with an empty predicate type")
+		}
+	}
+	return nil
+}
+
+// ParsePublicKey parses a PEM-encoded public key of an allowed signer
+func ParsePublicKey(publicKeyPem string) (crypto.PublicKey, error) {
+	publicKeyBlock, _ := pem.Decode([]byte(publicKeyPem))
+	if publicKeyBlock == nil {
+		return nil, stacktrace.NewError("No PEM-encoded public key was found")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(publicKeyBlock.Bytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the public key")
+	}
+	return publicKey, nil
+}
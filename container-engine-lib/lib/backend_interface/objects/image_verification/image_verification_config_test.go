@@ -0,0 +1,51 @@
+package image_verification
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_DisabledByDefault(t *testing.T) {
+	config := NewDisabledImageVerificationConfig()
+	require.NoError(t, config.Validate())
+	require.False(t, config.IsEnabled())
+}
+
+func TestValidate_AttestationsRequireSigners(t *testing.T) {
+	config := NewDisabledImageVerificationConfig()
+	config.RequiredAttestations = []string{"https://slsa.dev/provenance/v1"}
+	require.Error(t, config.Validate())
+
+	config.AllowedSigners = []AllowedSigner{{Name: "release", PublicKey: generatePublicKeyPem(t)}}
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsEnabled())
+}
+
+func TestValidate_InvalidPublicKeyIsRejected(t *testing.T) {
+	config := NewDisabledImageVerificationConfig()
+	config.AllowedSigners = []AllowedSigner{{Name: "release", PublicKey: "not a key"}}
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_DuplicateSignerIsRejected(t *testing.T) {
+	config := NewDisabledImageVerificationConfig()
+	config.AllowedSigners = []AllowedSigner{
+		{Name: "release", PublicKey: generatePublicKeyPem(t)},
+		{Name: "release", PublicKey: generatePublicKeyPem(t)},
+	}
+	require.Error(t, config.Validate())
+}
+
+func generatePublicKeyPem(t *testing.T) string {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}))
+}
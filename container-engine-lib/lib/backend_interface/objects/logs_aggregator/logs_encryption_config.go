@@ -0,0 +1,104 @@
+package logs_aggregator
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// LogsEncryptionKeyEnvVar holds the base64-encoded key in the logs aggregator, which encrypts the logs it stores,
+	// and in the engine, which decrypts them when streaming them
+	LogsEncryptionKeyEnvVar = "KURTOSIS_LOGS_ENCRYPTION_KEY"
+
+	// LogsEncryptionKeyNumBytes is the length of the ChaCha20-Poly1305 keys
+	LogsEncryptionKeyNumBytes = 32
+
+	// LogsEncryptionNonceNumBytes is the length of the nonce drawn for each log line
+	LogsEncryptionNonceNumBytes = 12
+
+	// EncryptedLogNonceLabel is the field of the stored log lines holding the base64-encoded nonce their 'log' field was
+	// encrypted with; the log lines without it are plaintext
+	EncryptedLogNonceLabel = "log_nonce"
+
+	defaultLogsEncryptionKeySecretKey = "key"
+
+	// LogsEncryptionKeySecretCopyName is the Secret the key is copied to in the namespaces of the engine and of the logs
+	// aggregator
+	LogsEncryptionKeySecretCopyName = "kurtosis-logs-encryption-key"
+)
+
+// LogsEncryptionConfig makes the logs aggregator encrypt the log lines it stores on the logs volume, so that the output
+// of the services isn't plaintext at rest. Each line is encrypted with ChaCha20-Poly1305, the authenticated cipher the
+// logs aggregator supports, under a new nonce. The zero value is a valid config that stores the logs in plaintext.
+type LogsEncryptionConfig struct {
+	// Key is the base64-encoded 32-byte key; on Docker, which has no secrets store, it is given to the containers
+	Key string `json:"key,omitempty"`
+
+	// KeySecretName is the Kubernetes Secret holding the key; on Kubernetes the key is copied to a Secret in the namespaces
+	// of the engine and of the logs aggregator, which the containers read it from, so it's never in a pod spec
+	KeySecretName string `json:"keySecretName,omitempty"`
+
+	// KeySecretNamespace is the namespace of KeySecretName
+	KeySecretNamespace string `json:"keySecretNamespace,omitempty"`
+
+	// KeySecretKey is the key of the Secret data holding the key; the default applies if empty
+	KeySecretKey string `json:"keySecretKey,omitempty"`
+}
+
+func NewDisabledLogsEncryptionConfig() LogsEncryptionConfig {
+	return LogsEncryptionConfig{
+		Key:                "",
+		KeySecretName:      "",
+		KeySecretNamespace: "",
+		KeySecretKey:       "",
+	}
+}
+
+// IsEnabled returns true if the stored logs must be encrypted
+func (config LogsEncryptionConfig) IsEnabled() bool {
+	return config.Key != "" || config.KeySecretName != ""
+}
+
+// GetKeySecretKey returns the key of the Secret data holding the key
+func (config LogsEncryptionConfig) GetKeySecretKey() string {
+	if config.KeySecretKey == "" {
+		return defaultLogsEncryptionKeySecretKey
+	}
+	return config.KeySecretKey
+}
+
+func (config LogsEncryptionConfig) Validate() error {
+	if !config.IsEnabled() {
+		return nil
+	}
+	if config.Key != "" && config.KeySecretName != "" {
+		return stacktrace.NewError("The logs encryption key must be given either directly or through a Kubernetes Secret, not both")
+	}
+	if config.KeySecretName != "" {
+		if strings.TrimSpace(config.KeySecretName) != config.KeySecretName {
+			return stacktrace.NewError("The name of the Secret holding the logs encryption key '%v' must not have surrounding spaces", config.KeySecretName)
+		}
+		if config.KeySecretNamespace == "" {
+			return stacktrace.NewError("The namespace of Secret '%v' holding the logs encryption key is required", config.KeySecretName)
+		}
+		return nil
+	}
+	if _, err := DecodeLogsEncryptionKey(config.Key); err != nil {
+		return stacktrace.Propagate(err, "The logs encryption key is invalid")
+	}
+	return nil
+}
+
+// DecodeLogsEncryptionKey returns the key encoded in the value of LogsEncryptionKeyEnvVar
+func DecodeLogsEncryptionKey(encodedKey string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The logs encryption key isn't valid base64")
+	}
+	if len(key) != LogsEncryptionKeyNumBytes {
+		return nil, stacktrace.NewError("The logs encryption key has %v bytes instead of %v; a key can be generated with 'openssl rand -base64 %v'", len(key), LogsEncryptionKeyNumBytes, LogsEncryptionKeyNumBytes)
+	}
+	return key, nil
+}
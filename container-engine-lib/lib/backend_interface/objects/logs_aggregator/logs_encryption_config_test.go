@@ -0,0 +1,51 @@
+package logs_aggregator
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretName      = "logs-encryption-key"
+	testSecretNamespace = "kurtosis-secrets"
+)
+
+var testEncodedKey = base64.StdEncoding.EncodeToString(make([]byte, LogsEncryptionKeyNumBytes))
+
+func TestValidate_DisabledByDefault(t *testing.T) {
+	config := NewDisabledLogsEncryptionConfig()
+	require.NoError(t, config.Validate())
+	require.False(t, config.IsEnabled())
+}
+
+func TestValidate_KeyMustBe32Bytes(t *testing.T) {
+	config := LogsEncryptionConfig{Key: testEncodedKey, KeySecretName: "", KeySecretNamespace: "", KeySecretKey: ""}
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsEnabled())
+
+	config.Key = base64.StdEncoding.EncodeToString(make([]byte, LogsEncryptionKeyNumBytes/2))
+	require.Error(t, config.Validate())
+
+	config.Key = "not base64!"
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_KeyAndSecretAreMutuallyExclusive(t *testing.T) {
+	config := LogsEncryptionConfig{Key: testEncodedKey, KeySecretName: testSecretName, KeySecretNamespace: testSecretNamespace, KeySecretKey: ""}
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_SecretRequiresNamespace(t *testing.T) {
+	config := LogsEncryptionConfig{Key: "", KeySecretName: testSecretName, KeySecretNamespace: "", KeySecretKey: ""}
+	require.Error(t, config.Validate())
+
+	config.KeySecretNamespace = testSecretNamespace
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsEnabled())
+	require.Equal(t, defaultLogsEncryptionKeySecretKey, config.GetKeySecretKey())
+
+	config.KeySecretKey = "logs-key"
+	require.Equal(t, "logs-key", config.GetKeySecretKey())
+}
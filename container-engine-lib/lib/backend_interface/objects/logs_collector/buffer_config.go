@@ -0,0 +1,56 @@
+package logs_collector
+
+import (
+	"regexp"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// MemoryStorageType buffers the records of the inputs of the logs collector in memory only
+	MemoryStorageType = "memory"
+
+	// FilesystemStorageType also buffers the records of the inputs of the logs collector on disk, keeping the ones that
+	// don't fit in memory instead of pausing the inputs, and the ones not delivered yet across restarts
+	FilesystemStorageType = "filesystem"
+
+	// https://docs.fluentbit.io/manual/administration/configuring-fluent-bit/classic-mode/configuration-file#unit-sizes
+	sizeRegexStr = `^[0-9]+([kKmMgG][bB]?)?$`
+)
+
+var sizeRegex = regexp.MustCompile(sizeRegexStr)
+
+// BufferConfig tunes how the logs collector buffers the records it collects before they reach the logs aggregator, for
+// the services logging heavily to neither lose records nor grow the memory of the logs collector without limit. The
+// zero value is a valid config keeping the defaults of the logs collector.
+// See https://docs.fluentbit.io/manual/administration/buffering-and-storage
+type BufferConfig struct {
+	// MemBufLimit is the memory the records of an input can take up before it's paused, e.g. '50MB'; unlimited if empty
+	MemBufLimit string `json:"memBufLimit,omitempty"`
+
+	// StorageType is where the records of the inputs are buffered, MemoryStorageType or FilesystemStorageType; the
+	// default of the backend if empty
+	StorageType string `json:"storageType,omitempty"`
+
+	// MaxChunksUp is how many chunks of records buffered on the filesystem can be in memory at once; the default of the
+	// logs collector if zero
+	MaxChunksUp uint32 `json:"maxChunksUp,omitempty"`
+}
+
+func NewDefaultBufferConfig() BufferConfig {
+	return BufferConfig{
+		MemBufLimit: "",
+		StorageType: "",
+		MaxChunksUp: 0,
+	}
+}
+
+func (config BufferConfig) Validate() error {
+	if config.MemBufLimit != "" && !sizeRegex.MatchString(config.MemBufLimit) {
+		return stacktrace.NewError("Mem buf limit '%v' isn't a size, e.g. '50MB'", config.MemBufLimit)
+	}
+	if config.StorageType != "" && config.StorageType != MemoryStorageType && config.StorageType != FilesystemStorageType {
+		return stacktrace.NewError("Storage type '%v' isn't supported; the supported storage types are '%v' and '%v'", config.StorageType, MemoryStorageType, FilesystemStorageType)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package logs_collector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferConfig(t *testing.T) {
+	require.NoError(t, NewDefaultBufferConfig().Validate())
+
+	config := BufferConfig{
+		MemBufLimit: "50MB",
+		StorageType: FilesystemStorageType,
+		MaxChunksUp: 128,
+	}
+	require.NoError(t, config.Validate())
+}
+
+func TestBufferConfig_Invalid(t *testing.T) {
+	require.Error(t, BufferConfig{MemBufLimit: "50 megabytes", StorageType: "", MaxChunksUp: 0}.Validate())
+	require.Error(t, BufferConfig{MemBufLimit: "50MB\n    Name stdout", StorageType: "", MaxChunksUp: 0}.Validate())
+	require.Error(t, BufferConfig{MemBufLimit: "", StorageType: "disk", MaxChunksUp: 0}.Validate())
+}
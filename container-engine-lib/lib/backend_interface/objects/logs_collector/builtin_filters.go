@@ -0,0 +1,138 @@
+package logs_collector
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// SampleFilterName is a filter implemented by Kurtosis that keeps 1 log line in N of each service, e.g. to keep the
+	// logs of extremely chatty services from overwhelming the logs aggregator store
+	SampleFilterName = "kurtosis_sample"
+
+	// DedupeFilterName is a filter implemented by Kurtosis that drops the log lines of each service identical to the
+	// line the service logged just before
+	DedupeFilterName = "kurtosis_dedupe"
+
+	// SampleRateParamKey is the N of the 1 log line in N kept by the sample filter
+	SampleRateParamKey = "rate"
+
+	// ServiceParamKey restricts the filters implemented by Kurtosis to the logs of the service with this UUID; they
+	// apply to the logs of all the services they match if it isn't set
+	ServiceParamKey = "service"
+
+	luaFilterName       = "lua"
+	luaCallParamKey     = "call"
+	luaCodeParamKey     = "code"
+	sampleLuaFuncName   = "kurtosis_sample"
+	dedupeLuaFuncName   = "kurtosis_dedupe"
+	logLineRecordKey    = "log"
+	minSampleRate       = 1
+	serviceUuidRegexStr = "^[a-zA-Z0-9-]+$"
+
+	// the filters run in Lua states of their own, which keep the counters and the last lines of the services between
+	// the records; the records are keyed by service, and by tag for the ones that don't have a service
+	sampleLuaCodeTemplate = `local counts = {} ` +
+		`function ` + sampleLuaFuncName + `(tag, timestamp, record) ` +
+		`local service = record["%[1]v"] or tag ` +
+		`if "%[2]v" ~= "" and service ~= "%[2]v" then return 0, timestamp, record end ` +
+		`local count = (counts[service] or 0) %% %[3]d ` +
+		`counts[service] = count + 1 ` +
+		`if count == 0 then return 0, timestamp, record end ` +
+		`return -1, timestamp, record ` +
+		`end`
+	dedupeLuaCodeTemplate = `local last_lines = {} ` +
+		`function ` + dedupeLuaFuncName + `(tag, timestamp, record) ` +
+		`local service = record["%[1]v"] or tag ` +
+		`if "%[2]v" ~= "" and service ~= "%[2]v" then return 0, timestamp, record end ` +
+		`local line = record["` + logLineRecordKey + `"] ` +
+		`if line ~= nil and line == last_lines[service] then return -1, timestamp, record end ` +
+		`last_lines[service] = line ` +
+		`return 0, timestamp, record ` +
+		`end`
+)
+
+var serviceUuidRegex = regexp.MustCompile(serviceUuidRegexStr)
+
+// ExpandBuiltInFilters replaces the filters implemented by Kurtosis with the Fluent Bit filters implementing them,
+// keeping their match; the other filters are returned as is. The service UUID record key is the field of the collected
+// log lines holding the UUID of the service that wrote them, which depends on the backend
+func ExpandBuiltInFilters(filters []Filter, serviceUuidRecordKey string) ([]Filter, error) {
+	expandedFilters := make([]Filter, 0, len(filters))
+	for _, filter := range filters {
+		switch filter.Name {
+		case SampleFilterName:
+			params, err := getBuiltInFilterParams(filter, SampleRateParamKey)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Filter '%v' is invalid", filter.Name)
+			}
+			rateStr, found := params[SampleRateParamKey]
+			if !found {
+				return nil, stacktrace.NewError("Filter '%v' needs param '%v'", filter.Name, SampleRateParamKey)
+			}
+			rate, err := strconv.Atoi(rateStr)
+			if err != nil || rate < minSampleRate {
+				return nil, stacktrace.NewError("Param '%v' of filter '%v' is '%v', but it needs to be a positive integer", SampleRateParamKey, filter.Name, rateStr)
+			}
+			expandedFilters = append(expandedFilters, newLuaFilter(
+				filter.Match,
+				sampleLuaFuncName,
+				fmt.Sprintf(sampleLuaCodeTemplate, serviceUuidRecordKey, params[ServiceParamKey], rate),
+			))
+		case DedupeFilterName:
+			params, err := getBuiltInFilterParams(filter)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Filter '%v' is invalid", filter.Name)
+			}
+			expandedFilters = append(expandedFilters, newLuaFilter(
+				filter.Match,
+				dedupeLuaFuncName,
+				fmt.Sprintf(dedupeLuaCodeTemplate, serviceUuidRecordKey, params[ServiceParamKey]),
+			))
+		default:
+			expandedFilters = append(expandedFilters, filter)
+		}
+	}
+	return expandedFilters, nil
+}
+
+// getBuiltInFilterParams returns the params of a filter implemented by Kurtosis by key, checking that they're all
+// supported by it; all of them support the service param
+func getBuiltInFilterParams(filter Filter, supportedParamKeys ...string) (map[string]string, error) {
+	supportedParamKeys = append(supportedParamKeys, ServiceParamKey)
+	params := map[string]string{}
+	for _, param := range filter.Params {
+		isSupported := false
+		for _, supportedParamKey := range supportedParamKeys {
+			if param.Key == supportedParamKey {
+				isSupported = true
+				break
+			}
+		}
+		if !isSupported {
+			return nil, stacktrace.NewError("Param '%v' isn't supported; the supported params are %v", param.Key, supportedParamKeys)
+		}
+		if _, found := params[param.Key]; found {
+			return nil, stacktrace.NewError("Param '%v' is set more than once", param.Key)
+		}
+		params[param.Key] = param.Value
+	}
+	if serviceUuid, found := params[ServiceParamKey]; found && !serviceUuidRegex.MatchString(serviceUuid) {
+		return nil, stacktrace.NewError("Param '%v' is '%v', which isn't a service UUID", ServiceParamKey, serviceUuid)
+	}
+	return params, nil
+}
+
+func newLuaFilter(match string, funcName string, code string) Filter {
+	return Filter{
+		Name:  luaFilterName,
+		Match: match,
+		Params: []FilterParam{
+			{Key: luaCallParamKey, Value: funcName},
+			{Key: luaCodeParamKey, Value: code},
+		},
+	}
+}
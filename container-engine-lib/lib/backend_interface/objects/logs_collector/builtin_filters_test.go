@@ -0,0 +1,58 @@
+package logs_collector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceUuidRecordKey = "kurtosis_service_uuid"
+	testServiceUuid          = "4c2a8f3d9e1b47a6b5c0d2e8f7a91b3c"
+)
+
+func TestExpandBuiltInFilters(t *testing.T) {
+	filters := []Filter{
+		{Name: "grep", Match: "*", Params: []FilterParam{{Key: "Exclude", Value: "log ^DEBUG"}}},
+		{Name: SampleFilterName, Match: "*", Params: []FilterParam{{Key: SampleRateParamKey, Value: "10"}}},
+		{Name: DedupeFilterName, Match: "kurtosis.*", Params: []FilterParam{{Key: ServiceParamKey, Value: testServiceUuid}}},
+	}
+	expandedFilters, err := ExpandBuiltInFilters(filters, testServiceUuidRecordKey)
+	require.NoError(t, err)
+	require.Len(t, expandedFilters, 3)
+	require.Equal(t, filters[0], expandedFilters[0])
+
+	sampleFilter := expandedFilters[1]
+	require.Equal(t, "lua", sampleFilter.Name)
+	require.Equal(t, "*", sampleFilter.Match)
+	require.Equal(t, FilterParam{Key: "call", Value: "kurtosis_sample"}, sampleFilter.Params[0])
+	require.Contains(t, sampleFilter.Params[1].Value, `local service = record["kurtosis_service_uuid"] or tag`)
+	require.Contains(t, sampleFilter.Params[1].Value, `if "" ~= "" and service ~= "" then`)
+	require.Contains(t, sampleFilter.Params[1].Value, `local count = (counts[service] or 0) % 10`)
+	require.NotContains(t, sampleFilter.Params[1].Value, "\n")
+
+	dedupeFilter := expandedFilters[2]
+	require.Equal(t, "lua", dedupeFilter.Name)
+	require.Equal(t, "kurtosis.*", dedupeFilter.Match)
+	require.Equal(t, FilterParam{Key: "call", Value: "kurtosis_dedupe"}, dedupeFilter.Params[0])
+	require.Contains(t, dedupeFilter.Params[1].Value, `if "`+testServiceUuid+`" ~= "" and service ~= "`+testServiceUuid+`" then`)
+	require.NotContains(t, dedupeFilter.Params[1].Value, "\n")
+}
+
+func TestExpandBuiltInFilters_Invalid(t *testing.T) {
+	invalidFilters := [][]FilterParam{
+		nil,
+		{{Key: SampleRateParamKey, Value: "0"}},
+		{{Key: SampleRateParamKey, Value: "ten"}},
+		{{Key: SampleRateParamKey, Value: "10"}, {Key: SampleRateParamKey, Value: "20"}},
+		{{Key: SampleRateParamKey, Value: "10"}, {Key: "Exclude", Value: "log ^DEBUG"}},
+		{{Key: SampleRateParamKey, Value: "10"}, {Key: ServiceParamKey, Value: `" or true or "`}},
+	}
+	for _, params := range invalidFilters {
+		_, err := ExpandBuiltInFilters([]Filter{{Name: SampleFilterName, Match: "*", Params: params}}, testServiceUuidRecordKey)
+		require.Error(t, err)
+	}
+
+	_, err := ExpandBuiltInFilters([]Filter{{Name: DedupeFilterName, Match: "*", Params: []FilterParam{{Key: SampleRateParamKey, Value: "10"}}}}, testServiceUuidRecordKey)
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package logs_collector
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// whitespaces separate the key from the value of a Fluent Bit config line, and line breaks separate the lines, so
+	// names and keys containing them would end up as something else than what the user asked for
+	forbiddenNameChars  = " \t\r\n"
+	forbiddenValueChars = "\r\n"
+)
+
+// ValidateEnclaveFilters checks the filters registered by an enclave on top of the filters of the logs collector. These
+// are scoped to the services of the enclave by Kurtosis, so they can't set their own match
+func ValidateEnclaveFilters(filters []Filter) error {
+	for idx, filter := range filters {
+		if filter.Name == "" {
+			return stacktrace.NewError("Filter #%d has no name", idx+1)
+		}
+		if strings.ContainsAny(filter.Name, forbiddenNameChars) {
+			return stacktrace.NewError("The name '%s' of filter #%d contains whitespaces", filter.Name, idx+1)
+		}
+		if filter.Match != "" {
+			return stacktrace.NewError(
+				"Filter '%s' sets match '%s', but the filters of an enclave are applied to the logs of its services only, so they can't set a match",
+				filter.Name,
+				filter.Match,
+			)
+		}
+		for _, param := range filter.Params {
+			if param.Key == "" {
+				return stacktrace.NewError("Filter '%s' has a param with no key", filter.Name)
+			}
+			if strings.ContainsAny(param.Key, forbiddenNameChars) {
+				return stacktrace.NewError("The key '%s' of a param of filter '%s' contains whitespaces", param.Key, filter.Name)
+			}
+			if strings.ContainsAny(param.Value, forbiddenValueChars) {
+				return stacktrace.NewError("The value of param '%s' of filter '%s' contains line breaks", param.Key, filter.Name)
+			}
+		}
+	}
+	// the record key doesn't matter, the filters are only expanded to check the params of the ones implemented by Kurtosis
+	if _, err := ExpandBuiltInFilters(filters, ""); err != nil {
+		return stacktrace.Propagate(err, "The filters implemented by Kurtosis are invalid")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package logs_collector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEnclaveFilters(t *testing.T) {
+	filters := []Filter{
+		{
+			Name:  "modify",
+			Match: "",
+			Params: []FilterParam{
+				{Key: "Add", Value: "team payments"},
+			},
+		},
+		{
+			Name:   "grep",
+			Match:  "",
+			Params: []FilterParam{{Key: "Exclude", Value: "log ^DEBUG"}},
+		},
+	}
+	require.NoError(t, ValidateEnclaveFilters(filters))
+	require.NoError(t, ValidateEnclaveFilters(nil))
+}
+
+func TestValidateEnclaveFilters_Invalid(t *testing.T) {
+	require.Error(t, ValidateEnclaveFilters([]Filter{{Name: "", Match: "", Params: nil}}))
+	require.Error(t, ValidateEnclaveFilters([]Filter{{Name: "grep\n[OUTPUT]", Match: "", Params: nil}}))
+	require.Error(t, ValidateEnclaveFilters([]Filter{{Name: "grep", Match: "*", Params: nil}}))
+	require.Error(t, ValidateEnclaveFilters([]Filter{{Name: "grep", Match: "", Params: []FilterParam{{Key: "", Value: "log ^DEBUG"}}}}))
+	require.Error(t, ValidateEnclaveFilters([]Filter{{Name: "grep", Match: "", Params: []FilterParam{{Key: "Exclude log", Value: "^DEBUG"}}}}))
+	require.Error(t, ValidateEnclaveFilters([]Filter{{Name: "grep", Match: "", Params: []FilterParam{{Key: "Exclude", Value: "log ^DEBUG\n[OUTPUT]"}}}}))
+}
+
+func TestValidateEnclaveFilters_BuiltInFilters(t *testing.T) {
+	require.NoError(t, ValidateEnclaveFilters([]Filter{{Name: SampleFilterName, Match: "", Params: []FilterParam{{Key: SampleRateParamKey, Value: "10"}}}}))
+	require.Error(t, ValidateEnclaveFilters([]Filter{{Name: SampleFilterName, Match: "", Params: nil}}))
+}
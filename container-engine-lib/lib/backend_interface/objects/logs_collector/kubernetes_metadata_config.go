@@ -0,0 +1,77 @@
+package logs_collector
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// KubernetesMetadataFieldRecordKeyPrefix prefixes the Kubernetes metadata fields attached to the collected log lines,
+	// e.g. 'kubernetes_host' holds the name of the node of the pod
+	KubernetesMetadataFieldRecordKeyPrefix = "kubernetes_"
+
+	// KubernetesMetadataLabelRecordKeyPrefix prefixes the labels of the pods attached to the collected log lines, e.g.
+	// 'kubernetes_label_app' holds the value of the 'app' label
+	KubernetesMetadataLabelRecordKeyPrefix = "kubernetes_label_"
+
+	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
+	kubernetesLabelKeyRegexStr = `^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[a-zA-Z0-9]([-a-zA-Z0-9_.]*[a-zA-Z0-9])?$`
+)
+
+// The metadata the logs collector looks up about the pods of the services that can be attached to their log lines
+var supportedKubernetesMetadataFields = map[string]bool{
+	"pod_name":        true,
+	"namespace_name":  true,
+	"pod_id":          true,
+	"pod_ip":          true,
+	"host":            true,
+	"container_name":  true,
+	"container_image": true,
+	"container_hash":  true,
+	"docker_id":       true,
+}
+
+var kubernetesLabelKeyRegex = regexp.MustCompile(kubernetesLabelKeyRegexStr)
+
+// KubernetesMetadataConfig selects the metadata of the pods of the services that the logs collector attaches to each of
+// their log lines on Kubernetes, for the sinks of the logs aggregator to get the fields they need. The zero value is a
+// valid config that attaches none, the log lines only having the UUIDs of their enclave and service.
+type KubernetesMetadataConfig struct {
+	// Fields are the metadata fields attached, e.g. 'host' for the name of the node of the pod
+	Fields []string `json:"fields,omitempty"`
+
+	// Labels are the keys of the labels of the pods attached
+	Labels []string `json:"labels,omitempty"`
+}
+
+func NewEmptyKubernetesMetadataConfig() KubernetesMetadataConfig {
+	return KubernetesMetadataConfig{
+		Fields: nil,
+		Labels: nil,
+	}
+}
+
+func (config KubernetesMetadataConfig) Validate() error {
+	for _, field := range config.Fields {
+		if !supportedKubernetesMetadataFields[field] {
+			return stacktrace.NewError("Kubernetes metadata field '%v' isn't supported; the supported fields are %v", field, getSupportedKubernetesMetadataFields())
+		}
+	}
+	for _, label := range config.Labels {
+		if !kubernetesLabelKeyRegex.MatchString(label) {
+			return stacktrace.NewError("'%v' isn't a valid Kubernetes label key", label)
+		}
+	}
+	return nil
+}
+
+func getSupportedKubernetesMetadataFields() []string {
+	fields := []string{}
+	for field := range supportedKubernetesMetadataFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,23 @@
+package logs_collector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesMetadataConfig(t *testing.T) {
+	require.NoError(t, NewEmptyKubernetesMetadataConfig().Validate())
+
+	config := KubernetesMetadataConfig{
+		Fields: []string{"host", "pod_ip", "container_name"},
+		Labels: []string{"app", "app.kubernetes.io/name"},
+	}
+	require.NoError(t, config.Validate())
+}
+
+func TestKubernetesMetadataConfig_Invalid(t *testing.T) {
+	require.Error(t, KubernetesMetadataConfig{Fields: []string{"node"}, Labels: nil}.Validate())
+	require.Error(t, KubernetesMetadataConfig{Fields: nil, Labels: []string{""}}.Validate())
+	require.Error(t, KubernetesMetadataConfig{Fields: nil, Labels: []string{`app"] = os.exit() --`}}.Validate())
+}
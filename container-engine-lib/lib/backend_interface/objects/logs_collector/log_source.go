@@ -0,0 +1,28 @@
+package logs_collector
+
+const (
+	// LogSourceLabel is the field of the collected log lines telling where they come from; the lines without it were
+	// written by the containers of the services
+	LogSourceLabel = "log_source"
+
+	// KubernetesEventLogSource marks the events Kubernetes reported about the pod of a service (e.g. FailedScheduling,
+	// BackOff, Killing), which explain why a service that never started has no logs
+	KubernetesEventLogSource = "kubernetes_event"
+
+	// KubernetesEventIdLabel identifies a version of a Kubernetes event. Every logs collector pod watches all the
+	// events of the cluster, so the logs aggregator uses it to store each of them only once
+	KubernetesEventIdLabel = "kubernetes_event_id"
+
+	// ContainerNameLabel is the field of the collected log lines naming the container of the pod of the service that
+	// wrote them on Kubernetes, e.g. an init container or a sidecar; the lines without it were written by the main
+	// container of the service
+	ContainerNameLabel = "kurtosis_container_name"
+
+	// ContainerRestartCountLabel is the field of the collected log lines holding how many times the container that
+	// wrote them had restarted when it did, on Kubernetes
+	ContainerRestartCountLabel = "kurtosis_container_restart_count"
+
+	// ContainerRestartLogSource marks the lines the logs collector adds to the logs of a service when one of its
+	// containers restarted, which tell the logs of the run of the container that crashed apart from the logs of the next
+	ContainerRestartLogSource = "container_restart"
+)
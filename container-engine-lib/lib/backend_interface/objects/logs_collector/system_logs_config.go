@@ -0,0 +1,47 @@
+package logs_collector
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// SystemLogsStreamId is what the system logs are stored under in place of an enclave UUID, each node being a service
+	// of it named after the node. Enclave names can't contain underscores, so it can't be mistaken for an enclave.
+	SystemLogsStreamId = "_system"
+
+	// SystemLogSource marks the log lines the host services of a node wrote to its journal
+	SystemLogSource = "system"
+)
+
+// SystemLogsConfig makes the logs collector also collect the logs the host services of each Kubernetes node write to
+// the node journal, e.g. those of the kubelet and of the container runtime, which explain the node-level causes of the
+// failures of the enclaves. The zero value is a valid config that collects none.
+type SystemLogsConfig struct {
+	// SystemdUnits are the units whose logs are collected, e.g. 'kubelet.service'
+	SystemdUnits []string `json:"systemdUnits,omitempty"`
+}
+
+func NewDisabledSystemLogsConfig() SystemLogsConfig {
+	return SystemLogsConfig{
+		SystemdUnits: nil,
+	}
+}
+
+// IsEnabled returns true if the logs collector must collect the system logs
+func (config SystemLogsConfig) IsEnabled() bool {
+	return len(config.SystemdUnits) > 0
+}
+
+func (config SystemLogsConfig) Validate() error {
+	for _, unit := range config.SystemdUnits {
+		if unit == "" {
+			return stacktrace.NewError("The systemd units to collect the system logs of can't be empty")
+		}
+		if strings.ContainsAny(unit, forbiddenNameChars+"=") {
+			return stacktrace.NewError("Systemd unit '%v' to collect the system logs of contains whitespaces or '='", unit)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package logs_collector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemLogsConfig(t *testing.T) {
+	disabledConfig := NewDisabledSystemLogsConfig()
+	require.False(t, disabledConfig.IsEnabled())
+	require.NoError(t, disabledConfig.Validate())
+
+	config := SystemLogsConfig{SystemdUnits: []string{"kubelet.service", "containerd.service"}}
+	require.True(t, config.IsEnabled())
+	require.NoError(t, config.Validate())
+}
+
+func TestSystemLogsConfig_Invalid(t *testing.T) {
+	require.Error(t, SystemLogsConfig{SystemdUnits: []string{""}}.Validate())
+	require.Error(t, SystemLogsConfig{SystemdUnits: []string{"kubelet.service\n[OUTPUT]"}}.Validate())
+	require.Error(t, SystemLogsConfig{SystemdUnits: []string{"kubelet.service _PID=1"}}.Validate())
+}
@@ -0,0 +1,73 @@
+package secrets_provider
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	// RedactedSecretValue replaces the value of the environment variables carrying secrets in the enclave dumps
+	RedactedSecretValue = "<redacted>"
+
+	// SerializedArgsEnvVarName is the environment variable the engine and the API containers get their arguments from,
+	// which carry the credentials they use (stores, secrets provider, TLS keys)
+	SerializedArgsEnvVarName = "SERIALIZED_ARGS"
+
+	secretEnvVarNamesSeparator = ","
+	envVarAssignmentSeparator  = "="
+)
+
+// SerializeSecretEnvVarNames returns the names of the environment variables carrying secrets as the value of the
+// label, or annotation, of the container they're set on
+func SerializeSecretEnvVarNames(envVarNames []string) string {
+	sortedEnvVarNames := append([]string{}, envVarNames...)
+	sort.Strings(sortedEnvVarNames)
+	return strings.Join(sortedEnvVarNames, secretEnvVarNamesSeparator)
+}
+
+func DeserializeSecretEnvVarNames(serializedEnvVarNames string) map[string]bool {
+	envVarNames := map[string]bool{}
+	for _, envVarName := range strings.Split(serializedEnvVarNames, secretEnvVarNamesSeparator) {
+		if envVarName != "" {
+			envVarNames[envVarName] = true
+		}
+	}
+	return envVarNames
+}
+
+// GetDumpRedactedEnvVarNames returns the names of the environment variables whose value is left out of the enclave
+// dumps: the ones a container is labeled, or annotated, with as carrying secrets, and the arguments of the Kurtosis
+// containers, which are always redacted so that the containers created without the label don't leak them
+func GetDumpRedactedEnvVarNames(serializedSecretEnvVarNames string) map[string]bool {
+	envVarNames := DeserializeSecretEnvVarNames(serializedSecretEnvVarNames)
+	envVarNames[SerializedArgsEnvVarName] = true
+	return envVarNames
+}
+
+// RedactEnvVarAssignments replaces the value of the secret environment variables in the NAME=VALUE assignments
+func RedactEnvVarAssignments(envVarAssignments []string, secretEnvVarNames map[string]bool) []string {
+	redactedEnvVarAssignments := make([]string, len(envVarAssignments))
+	for index, envVarAssignment := range envVarAssignments {
+		envVarName, _, _ := strings.Cut(envVarAssignment, envVarAssignmentSeparator)
+		if secretEnvVarNames[envVarName] {
+			envVarAssignment = envVarName + envVarAssignmentSeparator + RedactedSecretValue
+		}
+		redactedEnvVarAssignments[index] = envVarAssignment
+	}
+	return redactedEnvVarAssignments
+}
+
+// RedactEnvVars returns the environment variables with the value of the secret ones replaced
+func RedactEnvVars(envVars map[string]string, secretEnvVarNames map[string]bool) map[string]string {
+	if len(secretEnvVarNames) == 0 {
+		return envVars
+	}
+	redactedEnvVars := make(map[string]string, len(envVars))
+	for envVarName, envVarValue := range envVars {
+		if secretEnvVarNames[envVarName] {
+			envVarValue = RedactedSecretValue
+		}
+		redactedEnvVars[envVarName] = envVarValue
+	}
+	return redactedEnvVars
+}
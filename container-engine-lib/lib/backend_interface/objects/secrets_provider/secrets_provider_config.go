@@ -0,0 +1,93 @@
+package secrets_provider
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type SecretsProviderType string
+
+const (
+	// SecretsProviderType_None doesn't resolve any secret, so the packages referencing one fail to run (default)
+	SecretsProviderType_None SecretsProviderType = "none"
+	// SecretsProviderType_Kubernetes reads the secrets from the Kubernetes Secrets of a namespace of the cluster
+	SecretsProviderType_Kubernetes SecretsProviderType = "kubernetes"
+	// SecretsProviderType_Vault reads the secrets from the KV version 2 secrets engine of a HashiCorp Vault server
+	SecretsProviderType_Vault SecretsProviderType = "vault"
+	// SecretsProviderType_EnvFile reads the secrets from a file of KEY=VALUE lines, read when the engine starts
+	SecretsProviderType_EnvFile SecretsProviderType = "env-file"
+
+	defaultVaultMount = "secret"
+)
+
+// SecretsProviderConfig describes where the API containers read the secrets that Starlark references with
+// kurtosis.secret from. The zero value is a valid config that doesn't resolve any secret.
+type SecretsProviderConfig struct {
+	Type SecretsProviderType `json:"type,omitempty"`
+
+	// Namespace holding the Kubernetes Secrets the secrets are read from
+	Namespace string `json:"namespace,omitempty"`
+
+	// VaultAddress is the URL of the Vault server, e.g. 'https://vault.example.com:8200'
+	VaultAddress string `json:"vaultAddress,omitempty"`
+
+	VaultToken string `json:"vaultToken,omitempty"`
+
+	// VaultMount is the path the KV version 2 secrets engine is mounted at; the default applies if empty
+	VaultMount string `json:"vaultMount,omitempty"`
+
+	// EnvFileSecrets are the secrets of the env file, keyed by their name. The file is read by the CLI when it starts
+	// the engine, as the API containers can't reach the filesystem of the user
+	EnvFileSecrets map[string]string `json:"envFileSecrets,omitempty"`
+}
+
+func NewNoSecretsProviderConfig() SecretsProviderConfig {
+	return SecretsProviderConfig{
+		Type:           SecretsProviderType_None,
+		Namespace:      "",
+		VaultAddress:   "",
+		VaultToken:     "",
+		VaultMount:     "",
+		EnvFileSecrets: nil,
+	}
+}
+
+// IsConfigured returns true if the secrets referenced by Starlark can be resolved
+func (config SecretsProviderConfig) IsConfigured() bool {
+	return config.Type != "" && config.Type != SecretsProviderType_None
+}
+
+func (config SecretsProviderConfig) Validate() error {
+	switch config.Type {
+	case "", SecretsProviderType_None, SecretsProviderType_EnvFile:
+		return nil
+	case SecretsProviderType_Kubernetes:
+		if strings.TrimSpace(config.Namespace) == "" {
+			return stacktrace.NewError("Secrets provider of type '%v' requires a namespace", config.Type)
+		}
+		return nil
+	case SecretsProviderType_Vault:
+		if strings.TrimSpace(config.VaultAddress) == "" {
+			return stacktrace.NewError("Secrets provider of type '%v' requires the address of the Vault server", config.Type)
+		}
+		if config.VaultToken == "" {
+			return stacktrace.NewError("Secrets provider of type '%v' requires a Vault token", config.Type)
+		}
+		return nil
+	default:
+		return stacktrace.NewError(
+			"Unrecognized secrets provider type '%v'; valid values are: %v",
+			config.Type,
+			strings.Join([]string{string(SecretsProviderType_None), string(SecretsProviderType_Kubernetes), string(SecretsProviderType_Vault), string(SecretsProviderType_EnvFile)}, ", "),
+		)
+	}
+}
+
+// GetVaultMount returns the path the KV version 2 secrets engine of the Vault server is mounted at
+func (config SecretsProviderConfig) GetVaultMount() string {
+	if trimmedMount := strings.Trim(config.VaultMount, "/"); trimmedMount != "" {
+		return trimmedMount
+	}
+	return defaultVaultMount
+}
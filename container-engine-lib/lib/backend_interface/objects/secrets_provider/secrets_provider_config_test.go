@@ -0,0 +1,57 @@
+package secrets_provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_ZeroValueIsNotConfigured(t *testing.T) {
+	var config SecretsProviderConfig
+	require.NoError(t, config.Validate())
+	require.False(t, config.IsConfigured())
+}
+
+func TestValidate_KubernetesRequiresNamespace(t *testing.T) {
+	config := NewNoSecretsProviderConfig()
+	config.Type = SecretsProviderType_Kubernetes
+	require.Error(t, config.Validate())
+
+	config.Namespace = "kurtosis-secrets"
+	require.NoError(t, config.Validate())
+	require.True(t, config.IsConfigured())
+}
+
+func TestValidate_VaultRequiresAddressAndToken(t *testing.T) {
+	config := NewNoSecretsProviderConfig()
+	config.Type = SecretsProviderType_Vault
+	config.VaultAddress = "https://vault.example.com:8200"
+	require.Error(t, config.Validate())
+
+	config.VaultToken = "vault-token"
+	require.NoError(t, config.Validate())
+	require.Equal(t, defaultVaultMount, config.GetVaultMount())
+	config.VaultMount = "/kv/"
+	require.Equal(t, "kv", config.GetVaultMount())
+}
+
+func TestValidate_UnknownTypeIsRejected(t *testing.T) {
+	config := NewNoSecretsProviderConfig()
+	config.Type = "aws"
+	require.Error(t, config.Validate())
+}
+
+func TestRedactEnvVarAssignments(t *testing.T) {
+	secretEnvVarNames := DeserializeSecretEnvVarNames(SerializeSecretEnvVarNames([]string{"PASSWORD", "API_KEY"}))
+	require.Equal(t, map[string]bool{"API_KEY": true, "PASSWORD": true}, secretEnvVarNames)
+	require.Empty(t, DeserializeSecretEnvVarNames(""))
+
+	redactedEnvVarAssignments := RedactEnvVarAssignments([]string{"PASSWORD=hunter2", "USER=admin", "API_KEY=a=b"}, secretEnvVarNames)
+	require.Equal(t, []string{"PASSWORD=<redacted>", "USER=admin", "API_KEY=<redacted>"}, redactedEnvVarAssignments)
+}
+
+func TestRedactEnvVars(t *testing.T) {
+	envVars := map[string]string{"PASSWORD": "hunter2", "USER": "admin"}
+	require.Equal(t, map[string]string{"PASSWORD": RedactedSecretValue, "USER": "admin"}, RedactEnvVars(envVars, map[string]bool{"PASSWORD": true}))
+	require.Equal(t, envVars, RedactEnvVars(envVars, map[string]bool{}))
+}
@@ -76,6 +76,9 @@ type privateServiceConfig struct {
 	FilesToBeMoved map[string]string
 
 	TiniEnabled bool
+
+	// Names of the environment variables whose value was resolved from a secret, which are redacted from the dumps
+	SecretEnvVars []string
 }
 
 func CreateServiceConfig(containerImageName string, imageBuildSpec *image_build_spec.ImageBuildSpec, imageRegistrySpec *image_registry_spec.ImageRegistrySpec, nixBuildSpec *nix_build_spec.NixBuildSpec, privatePorts map[string]*port_spec.PortSpec, publicPorts map[string]*port_spec.PortSpec, entrypointArgs []string, cmdArgs []string, envVars map[string]string, filesArtifactExpansion *service_directory.FilesArtifactsExpansion, persistentDirectories *service_directory.PersistentDirectories, cpuAllocationMillicpus uint64, memoryAllocationMegabytes uint64, privateIPAddrPlaceholder string, minCpuMilliCpus uint64, minMemoryMegaBytes uint64, labels map[string]string, user *service_user.ServiceUser, tolerations []v1.Toleration, nodeSelectors map[string]string, imageDownloadMode image_download_mode.ImageDownloadMode, tiniEnabled bool) (*ServiceConfig, error) {
@@ -109,6 +112,7 @@ func CreateServiceConfig(containerImageName string, imageBuildSpec *image_build_
 		ImageDownloadMode:            imageDownloadMode,
 		FilesToBeMoved:               map[string]string{},
 		TiniEnabled:                  tiniEnabled,
+		SecretEnvVars:                nil,
 	}
 	return &ServiceConfig{internalServiceConfig}, nil
 }
@@ -165,6 +169,10 @@ func (serviceConfig *ServiceConfig) GetEnvVars() map[string]string {
 	return serviceConfig.privateServiceConfig.EnvVars
 }
 
+func (serviceConfig *ServiceConfig) SetEnvVars(envVars map[string]string) {
+	serviceConfig.privateServiceConfig.EnvVars = envVars
+}
+
 func (serviceConfig *ServiceConfig) GetFilesArtifactsExpansion() *service_directory.FilesArtifactsExpansion {
 	return serviceConfig.privateServiceConfig.FilesArtifactExpansion
 }
@@ -263,6 +271,14 @@ func (serviceConfig *ServiceConfig) GetTiniEnabled() bool {
 	return serviceConfig.privateServiceConfig.TiniEnabled
 }
 
+func (serviceConfig *ServiceConfig) GetSecretEnvVars() []string {
+	return serviceConfig.privateServiceConfig.SecretEnvVars
+}
+
+func (serviceConfig *ServiceConfig) SetSecretEnvVars(secretEnvVars []string) {
+	serviceConfig.privateServiceConfig.SecretEnvVars = secretEnvVars
+}
+
 func (serviceConfig *ServiceConfig) UnmarshalJSON(data []byte) error {
 	// Suppressing exhaustruct requirement because we want an object with zero values
 	// nolint: exhaustruct
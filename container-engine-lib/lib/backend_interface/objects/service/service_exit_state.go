@@ -0,0 +1,61 @@
+package service
+
+import (
+	"time"
+)
+
+const (
+	successExitCode = 0
+)
+
+// ExitState is how the container of a user service that isn't running anymore exited
+type ExitState struct {
+	exitCode int32
+
+	isOomKilled bool
+
+	finishedAt time.Time
+
+	// Why the container runtime says the container exited, e.g. 'OOMKilled' or 'Error'; empty if it doesn't say
+	reason string
+
+	// What the container runtime reports about the container, e.g. the output of 'docker inspect' or the Kubernetes
+	// container status and pod events, serialized as JSON so it can be stored as is for troubleshooting
+	serializedBackendState []byte
+}
+
+func NewExitState(exitCode int32, isOomKilled bool, finishedAt time.Time, reason string, serializedBackendState []byte) *ExitState {
+	return &ExitState{
+		exitCode:               exitCode,
+		isOomKilled:            isOomKilled,
+		finishedAt:             finishedAt,
+		reason:                 reason,
+		serializedBackendState: serializedBackendState,
+	}
+}
+
+func (state *ExitState) GetExitCode() int32 {
+	return state.exitCode
+}
+
+func (state *ExitState) IsOomKilled() bool {
+	return state.isOomKilled
+}
+
+func (state *ExitState) GetFinishedAt() time.Time {
+	return state.finishedAt
+}
+
+func (state *ExitState) GetReason() string {
+	return state.reason
+}
+
+func (state *ExitState) GetSerializedBackendState() []byte {
+	return state.serializedBackendState
+}
+
+// IsCrash returns whether the container exited with an error or was killed for running out of memory, as opposed to
+// exiting cleanly
+func (state *ExitState) IsCrash() bool {
+	return state.exitCode != successExitCode || state.isOomKilled
+}
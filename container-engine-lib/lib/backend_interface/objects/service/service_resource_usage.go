@@ -0,0 +1,96 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	peakMemoryUsageBase    = 10
+	peakMemoryUsageBitSize = 64
+)
+
+// The container runtimes don't report the peak memory usage of a container on cgroup v2, so it's read from the cgroup
+// of the container itself, which is mounted at the same place in every container
+var readPeakMemoryUsageCommand = []string{
+	"sh",
+	"-c",
+	"cat /sys/fs/cgroup/memory.peak 2> /dev/null || cat /sys/fs/cgroup/memory/memory.max_usage_in_bytes",
+}
+
+// ResourceUsage is the CPU and memory a user service is using at the time it was queried
+type ResourceUsage struct {
+	cpuMilliCores compute_resources.CpuMilliCores
+
+	memoryBytes uint64
+
+	// The highest memory usage of the service since it started, or nil if the container runtime doesn't keep track of it
+	maybePeakMemoryBytes *uint64
+
+	// The network traffic of the service since it started, or nil if the backend doesn't report it
+	maybeNetworkUsage *NetworkUsage
+}
+
+func NewResourceUsage(cpuMilliCores compute_resources.CpuMilliCores, memoryBytes uint64, maybePeakMemoryBytes *uint64, maybeNetworkUsage *NetworkUsage) *ResourceUsage {
+	return &ResourceUsage{
+		cpuMilliCores:        cpuMilliCores,
+		memoryBytes:          memoryBytes,
+		maybePeakMemoryBytes: maybePeakMemoryBytes,
+		maybeNetworkUsage:    maybeNetworkUsage,
+	}
+}
+
+func (usage *ResourceUsage) GetCpuMilliCores() compute_resources.CpuMilliCores {
+	return usage.cpuMilliCores
+}
+
+func (usage *ResourceUsage) GetMemoryBytes() uint64 {
+	return usage.memoryBytes
+}
+
+func (usage *ResourceUsage) GetMaybePeakMemoryBytes() *uint64 {
+	return usage.maybePeakMemoryBytes
+}
+
+func (usage *ResourceUsage) GetMaybeNetworkUsage() *NetworkUsage {
+	return usage.maybeNetworkUsage
+}
+
+// NetworkUsage is the bytes a user service received and sent over all its network interfaces since it started
+type NetworkUsage struct {
+	rxBytes uint64
+
+	txBytes uint64
+}
+
+func NewNetworkUsage(rxBytes uint64, txBytes uint64) *NetworkUsage {
+	return &NetworkUsage{
+		rxBytes: rxBytes,
+		txBytes: txBytes,
+	}
+}
+
+func (usage *NetworkUsage) GetRxBytes() uint64 {
+	return usage.rxBytes
+}
+
+func (usage *NetworkUsage) GetTxBytes() uint64 {
+	return usage.txBytes
+}
+
+// GetReadPeakMemoryUsageCommand returns the command to run in the container of a service to print its peak memory
+// usage in bytes, to be parsed with ParsePeakMemoryUsage. It needs a shell in the container
+func GetReadPeakMemoryUsageCommand() []string {
+	return readPeakMemoryUsageCommand
+}
+
+func ParsePeakMemoryUsage(readPeakMemoryUsageOutput string) (uint64, error) {
+	peakMemoryBytes, err := strconv.ParseUint(strings.TrimSpace(readPeakMemoryUsageOutput), peakMemoryUsageBase, peakMemoryUsageBitSize)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Expected the output of the command reading the peak memory usage to be a number of bytes, but it was '%v'", readPeakMemoryUsageOutput)
+	}
+	return peakMemoryBytes, nil
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeakMemoryUsage(t *testing.T) {
+	peakMemoryBytes, err := ParsePeakMemoryUsage("104857600\n")
+	require.NoError(t, err)
+	require.Equal(t, uint64(104857600), peakMemoryBytes)
+
+	_, err = ParsePeakMemoryUsage("cat: can't open '/sys/fs/cgroup/memory/memory.max_usage_in_bytes': No such file or directory")
+	require.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package service
+
+// We'll try to use the nicer-to-use shells first before we drop down to the lower shells
+var defaultShellCommand = []string{
+	"sh",
+	"-c",
+	`if command -v 'bash' > /dev/null; then
+		echo "Found bash on container; creating bash shell..."; bash; 
+       else 
+		echo "No bash found on container; dropping down to sh shell..."; sh; 
+	fi`,
+}
+
+// ShellOptions configures the interactive shell started on a user service
+type ShellOptions struct {
+	// The shell binary to run, e.g. 'zsh'; if empty, bash is used if the container has it and sh otherwise
+	shell string
+
+	// The user to run the shell as; if empty, the user of the container is used
+	user string
+
+	// Env vars set in the shell on top of the ones of the container
+	envVars map[string]string
+}
+
+func NewShellOptions(shell string, user string, envVars map[string]string) *ShellOptions {
+	return &ShellOptions{
+		shell:   shell,
+		user:    user,
+		envVars: envVars,
+	}
+}
+
+func (options *ShellOptions) GetShell() string {
+	return options.shell
+}
+
+func (options *ShellOptions) GetUser() string {
+	return options.user
+}
+
+func (options *ShellOptions) GetEnvVars() map[string]string {
+	return options.envVars
+}
+
+// GetShellCommand returns the command to run in the container to start the shell
+func (options *ShellOptions) GetShellCommand() []string {
+	if options.shell == "" {
+		return defaultShellCommand
+	}
+	return []string{options.shell}
+}
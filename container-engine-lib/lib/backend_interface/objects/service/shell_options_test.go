@@ -0,0 +1,12 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellOptions_GetShellCommand(t *testing.T) {
+	require.Equal(t, defaultShellCommand, NewShellOptions("", "", map[string]string{}).GetShellCommand())
+	require.Equal(t, []string{"zsh"}, NewShellOptions("zsh", "postgres", map[string]string{}).GetShellCommand())
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/stacktrace"
 	bolt "go.etcd.io/bbolt"
+	"time"
 )
 
 type FileArtifactPersisted struct {
@@ -18,6 +19,20 @@ type fileArtifactData struct {
 	ArtifactNameToArtifactUuid map[string]string
 	ShortenedUuidToFullUuid    map[string][]string
 	ArtifactContentMd5         map[string][]byte
+	// Hex-encoded SHA-256 of the artifact content, keyed by artifact UUID
+	ArtifactContentSha256 map[string]string
+	// Versions of the artifact, oldest first, keyed by the UUID of the latest version
+	ArtifactVersions map[string][]FilesArtifactVersion
+	// Size in bytes of the artifact content, keyed by artifact UUID
+	ArtifactContentSize map[string]uint64
+}
+
+// FilesArtifactVersion is one version of a files artifact; the latest version is always the one the artifact name
+// points to, previous versions are kept under their own UUID
+type FilesArtifactVersion struct {
+	Version      uint32
+	ArtifactUuid string
+	CreatedAt    time.Time
 }
 
 var (
@@ -35,9 +50,9 @@ func (fileArtifactDb *FileArtifactPersisted) ListFiles() map[string]bool {
 
 func (fileArtifactDb *FileArtifactPersisted) Persist() error {
 	err := fileArtifactDb.enclaveDb.Update(func(tx *bolt.Tx) error {
-		jsonData, err := json.Marshal(fileArtifactDb.data)
+		jsonData, err := fileArtifactDb.Serialize()
 		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred marshalling data '%v'", fileArtifactDb.data)
+			return stacktrace.Propagate(err, "An error occurred serializing the file artifacts data")
 		}
 		return tx.Bucket(fileArtifactBucketName).Put(fileArtifactDataStructKey, jsonData)
 	})
@@ -47,6 +62,33 @@ func (fileArtifactDb *FileArtifactPersisted) Persist() error {
 	return nil
 }
 
+// Serialize returns the file artifacts data as it's persisted, to be kept outside the enclave database
+func (fileArtifactDb *FileArtifactPersisted) Serialize() ([]byte, error) {
+	jsonData, err := json.Marshal(fileArtifactDb.data)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred marshalling data '%v'", fileArtifactDb.data)
+	}
+	return jsonData, nil
+}
+
+// Restore replaces the file artifacts data with data returned by Serialize, and persists it
+func (fileArtifactDb *FileArtifactPersisted) Restore(serializedData []byte) error {
+	data := newEmptyFileArtifactData()
+	if err := json.Unmarshal(serializedData, data); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling file artifacts data '%v'", string(serializedData))
+	}
+	fileArtifactDb.data = data
+	if err := fileArtifactDb.Persist(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the restored file artifacts data")
+	}
+	return nil
+}
+
+// IsEmpty returns true if no files artifact was ever stored, or all of them were removed
+func (fileArtifactDb *FileArtifactPersisted) IsEmpty() bool {
+	return len(fileArtifactDb.data.ArtifactContentMd5) == 0
+}
+
 func (fileArtifactDb *FileArtifactPersisted) SetArtifactUuid(artifactName string, artifactUuid string) {
 	fileArtifactDb.data.ArtifactNameToArtifactUuid[artifactName] = artifactUuid
 }
@@ -103,25 +145,79 @@ func (fileArtifactDb *FileArtifactPersisted) DeleteContentMd5(artifactName strin
 	delete(fileArtifactDb.data.ArtifactContentMd5, artifactName)
 }
 
+func (fileArtifactDb *FileArtifactPersisted) SetContentSha256(artifactUuid string, sha256 string) {
+	fileArtifactDb.data.ArtifactContentSha256[artifactUuid] = sha256
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetContentSha256(artifactUuid string) (string, bool) {
+	value, found := fileArtifactDb.data.ArtifactContentSha256[artifactUuid]
+	return value, found
+}
+
+func (fileArtifactDb *FileArtifactPersisted) DeleteContentSha256(artifactUuid string) {
+	delete(fileArtifactDb.data.ArtifactContentSha256, artifactUuid)
+}
+
+func (fileArtifactDb *FileArtifactPersisted) SetContentSize(artifactUuid string, size uint64) {
+	fileArtifactDb.data.ArtifactContentSize[artifactUuid] = size
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetContentSize(artifactUuid string) (uint64, bool) {
+	value, found := fileArtifactDb.data.ArtifactContentSize[artifactUuid]
+	return value, found
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetContentSizeMap() map[string]uint64 {
+	return fileArtifactDb.data.ArtifactContentSize
+}
+
+func (fileArtifactDb *FileArtifactPersisted) DeleteContentSize(artifactUuid string) {
+	delete(fileArtifactDb.data.ArtifactContentSize, artifactUuid)
+}
+
+func (fileArtifactDb *FileArtifactPersisted) SetArtifactVersions(latestArtifactUuid string, versions []FilesArtifactVersion) {
+	fileArtifactDb.data.ArtifactVersions[latestArtifactUuid] = versions
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetArtifactVersions(latestArtifactUuid string) ([]FilesArtifactVersion, bool) {
+	value, found := fileArtifactDb.data.ArtifactVersions[latestArtifactUuid]
+	return value, found
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetArtifactVersionsMap() map[string][]FilesArtifactVersion {
+	return fileArtifactDb.data.ArtifactVersions
+}
+
+func (fileArtifactDb *FileArtifactPersisted) DeleteArtifactVersions(latestArtifactUuid string) {
+	delete(fileArtifactDb.data.ArtifactVersions, latestArtifactUuid)
+}
+
 func GetOrCreateNewFileArtifactsDb() (*FileArtifactPersisted, error) {
-	data := fileArtifactData{
-		map[string]string{},
-		map[string][]string{},
-		map[string][]byte{},
-	}
+	data := newEmptyFileArtifactData()
 	// using the noEnclaveDatabaseDirpath because at this point we know that the enclave database has been created, so we are getting it from this call
 	noEnclaveDatabaseDirpath := ""
 	db, err := enclave_db.GetOrCreateEnclaveDatabase(noEnclaveDatabaseDirpath)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to get enclave database")
 	}
-	fileArtifactPersisted, err := getFileArtifactsDbFromEnclaveDb(db, &data)
+	fileArtifactPersisted, err := getFileArtifactsDbFromEnclaveDb(db, data)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to hydrate pre-existing file artifacts")
 	}
 	return fileArtifactPersisted, nil
 }
 
+func newEmptyFileArtifactData() *fileArtifactData {
+	return &fileArtifactData{
+		map[string]string{},
+		map[string][]string{},
+		map[string][]byte{},
+		map[string]string{},
+		map[string][]FilesArtifactVersion{},
+		map[string]uint64{},
+	}
+}
+
 func getFileArtifactsDbFromEnclaveDb(db *enclave_db.EnclaveDB, data *fileArtifactData) (*FileArtifactPersisted, error) {
 	err := db.Update(func(tx *bolt.Tx) error {
 		bucket, bucketErr := tx.CreateBucket(fileArtifactBucketName)
@@ -157,6 +253,9 @@ func GetFileArtifactsDbForTesting(db *enclave_db.EnclaveDB, nameToUuid map[strin
 		nameToUuid,
 		map[string][]string{},
 		map[string][]byte{},
+		map[string]string{},
+		map[string][]FilesArtifactVersion{},
+		map[string]uint64{},
 	})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to hydrate pre-existing file artifacts")
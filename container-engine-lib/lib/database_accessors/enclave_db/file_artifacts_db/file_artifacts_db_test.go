@@ -19,13 +19,40 @@ func TestFileArtifactPersistance(t *testing.T) {
 	fileArtifactDb.SetContentMd5("1", []byte("1"))
 	fileArtifactDb.SetFullUuid("1", []string{"1"})
 	require.Nil(t, fileArtifactDb.Persist())
-	fileArtifactDb, err = getFileArtifactsDbFromEnclaveDb(enclaveDb, &fileArtifactData{
-		map[string]string{},
-		map[string][]string{},
-		map[string][]byte{},
-	})
+	fileArtifactDb, err = getFileArtifactsDbFromEnclaveDb(enclaveDb, newEmptyFileArtifactData())
 	require.Nil(t, err)
 	require.Len(t, fileArtifactDb.GetArtifactUuidMap(), 1)
 	require.Len(t, fileArtifactDb.GetFullUuidMap(), 1)
 	require.Len(t, fileArtifactDb.GetContentMd5Map(), 1)
 }
+
+func TestFileArtifactRestoreFromSerializedData(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	fileArtifactDb, err := GetFileArtifactsDbForTesting(enclaveDb, map[string]string{})
+	require.Nil(t, err)
+	require.True(t, fileArtifactDb.IsEmpty())
+	fileArtifactDb.SetArtifactUuid("1", "1")
+	fileArtifactDb.SetContentMd5("1", []byte("1"))
+	fileArtifactDb.SetContentSize("1", 10)
+	serializedData, err := fileArtifactDb.Serialize()
+	require.Nil(t, err)
+
+	otherEnclaveDb, otherCleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer otherCleaningFunction()
+	restoredFileArtifactDb, err := GetFileArtifactsDbForTesting(otherEnclaveDb, map[string]string{})
+	require.Nil(t, err)
+	require.Nil(t, restoredFileArtifactDb.Restore(serializedData))
+	require.False(t, restoredFileArtifactDb.IsEmpty())
+	require.Equal(t, map[string]string{"1": "1"}, restoredFileArtifactDb.GetArtifactUuidMap())
+	size, found := restoredFileArtifactDb.GetContentSize("1")
+	require.True(t, found)
+	require.Equal(t, uint64(10), size)
+
+	// The restored data is persisted, not only kept in memory
+	reloadedFileArtifactDb, err := getFileArtifactsDbFromEnclaveDb(otherEnclaveDb, newEmptyFileArtifactData())
+	require.Nil(t, err)
+	require.Len(t, reloadedFileArtifactDb.GetArtifactUuidMap(), 1)
+}
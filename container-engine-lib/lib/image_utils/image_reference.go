@@ -0,0 +1,35 @@
+package image_utils
+
+import (
+	"strings"
+
+	"github.com/docker/distribution/reference"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	registryPathSeparator = "/"
+)
+
+// NormalizeImageReference returns the fully qualified form of the image reference, e.g. 'docker.io/library/nginx:latest'
+// for 'nginx', so that the different ways of referring to the same image can be told apart from different images.
+// References that can't be parsed are returned as is, leaving it to the container engine to reject them.
+func NormalizeImageReference(imageName string) string {
+	namedReference, err := reference.ParseNormalizedNamed(imageName)
+	if err != nil {
+		return imageName
+	}
+	return reference.TagNameOnly(namedReference).String()
+}
+
+// SplitImageReference returns the registry of the image, e.g. 'docker.io' for 'nginx', and the rest of its fully
+// qualified reference, e.g. 'library/nginx:latest'
+func SplitImageReference(imageName string) (string, string, error) {
+	namedReference, err := reference.ParseNormalizedNamed(imageName)
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred parsing image reference '%v'", imageName)
+	}
+	registry := reference.Domain(namedReference)
+	remainder := strings.TrimPrefix(reference.TagNameOnly(namedReference).String(), registry+registryPathSeparator)
+	return registry, remainder, nil
+}
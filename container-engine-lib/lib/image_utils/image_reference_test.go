@@ -0,0 +1,29 @@
+package image_utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeImageReference(t *testing.T) {
+	require.Equal(t, "docker.io/library/nginx:latest", NormalizeImageReference("nginx"))
+	require.Equal(t, "docker.io/library/nginx:latest", NormalizeImageReference("docker.io/library/nginx:latest"))
+	require.Equal(t, "ghcr.io/org/app:1.0", NormalizeImageReference("ghcr.io/org/app:1.0"))
+	require.Equal(t, "Not A Reference", NormalizeImageReference("Not A Reference"))
+}
+
+func TestSplitImageReference(t *testing.T) {
+	registry, remainder, err := SplitImageReference("nginx:1.25")
+	require.NoError(t, err)
+	require.Equal(t, "docker.io", registry)
+	require.Equal(t, "library/nginx:1.25", remainder)
+
+	registry, remainder, err = SplitImageReference("ghcr.io/org/app@sha256:0123456789012345678901234567890123456789012345678901234567890123")
+	require.NoError(t, err)
+	require.Equal(t, "ghcr.io", registry)
+	require.Equal(t, "org/app@sha256:0123456789012345678901234567890123456789012345678901234567890123", remainder)
+
+	_, _, err = SplitImageReference("Not A Reference")
+	require.Error(t, err)
+}
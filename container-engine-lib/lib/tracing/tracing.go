@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// Tracing is configured with the standard OpenTelemetry environment variables, e.g. OTEL_EXPORTER_OTLP_ENDPOINT,
+	// OTEL_EXPORTER_OTLP_HEADERS or OTEL_TRACES_SAMPLER, which the exporter and the SDK read themselves
+	otelEnvVarPrefix = "OTEL_"
+
+	exporterEndpointEnvVar       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	tracesExporterEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	tracerName = "github.com/kurtosis-tech/kurtosis"
+)
+
+// ShutdownFunc flushes the spans that haven't been exported yet; it must be called before the process exits
+type ShutdownFunc func(ctx context.Context)
+
+// InitTracing makes the spans of this process exported over OTLP if an OTLP endpoint is configured, and otherwise
+// leaves tracing disabled at no cost. The trace context is propagated either way, so a process that doesn't export
+// spans doesn't break the traces going through it
+func InitTracing(ctx context.Context, serviceName string) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !IsEnabled() {
+		return func(_ context.Context) {}, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the OTLP trace exporter")
+	}
+	traceResource, err := resource.New(
+		ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the OpenTelemetry resource of service '%v'", serviceName)
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(traceResource),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	logrus.Debugf("Exporting the traces of '%v' over OTLP", serviceName)
+
+	return func(ctx context.Context) {
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			logrus.Warnf("An error occurred exporting the last spans of '%v':\n%v", serviceName, err)
+		}
+	}, nil
+}
+
+// IsEnabled is true if an OTLP endpoint to export spans to is configured
+func IsEnabled() bool {
+	return os.Getenv(exporterEndpointEnvVar) != "" || os.Getenv(tracesExporterEndpointEnvVar) != ""
+}
+
+// GetOtelEnvVars returns the OpenTelemetry environment variables of this process, for the containers it starts to
+// export their spans the same way
+func GetOtelEnvVars() map[string]string {
+	otelEnvVars := map[string]string{}
+	for _, envVar := range os.Environ() {
+		key, value, found := strings.Cut(envVar, "=")
+		if found && strings.HasPrefix(key, otelEnvVarPrefix) {
+			otelEnvVars[key] = value
+		}
+	}
+	return otelEnvVars
+}
+
+// StartSpan starts a span as a child of the one in the context, if any. The span must be ended with EndSpan
+func StartSpan(ctx context.Context, spanName string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, spanName, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends the span, marking it as failed if the operation it covers returned an error
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, stacktrace.RootCause(err).Error())
+	}
+	span.End()
+}
@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestGetOtelEnvVars(t *testing.T) {
+	t.Setenv(exporterEndpointEnvVar, "http://collector:4317")
+	t.Setenv("OTEL_SERVICE_NAME", "my-service")
+	t.Setenv("NOT_OTEL_ENV_VAR", "value")
+
+	otelEnvVars := GetOtelEnvVars()
+	require.Equal(t, "http://collector:4317", otelEnvVars[exporterEndpointEnvVar])
+	require.Equal(t, "my-service", otelEnvVars["OTEL_SERVICE_NAME"])
+	require.NotContains(t, otelEnvVars, "NOT_OTEL_ENV_VAR")
+}
+
+func TestIsEnabled(t *testing.T) {
+	t.Setenv(exporterEndpointEnvVar, "")
+	t.Setenv(tracesExporterEndpointEnvVar, "")
+	require.False(t, IsEnabled())
+
+	t.Setenv(tracesExporterEndpointEnvVar, "http://collector:4317")
+	require.True(t, IsEnabled())
+}
+
+func TestStartAndEndSpan(t *testing.T) {
+	spanRecorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+	previousTracerProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(tracerProvider)
+	defer otel.SetTracerProvider(previousTracerProvider)
+
+	ctx, parentSpan := StartSpan(context.Background(), "parent")
+	_, childSpan := StartSpan(ctx, "child", attribute.String("kurtosis.image", "nginx"))
+	EndSpan(childSpan, stacktrace.Propagate(stacktrace.NewError("the image doesn't exist"), "An error occurred pulling the image"))
+	EndSpan(parentSpan, nil)
+
+	endedSpans := spanRecorder.Ended()
+	require.Len(t, endedSpans, 2)
+	child, parent := endedSpans[0], endedSpans[1]
+	require.Equal(t, "child", child.Name())
+	require.Equal(t, parent.SpanContext().SpanID(), child.Parent().SpanID())
+	require.Contains(t, child.Attributes(), attribute.String("kurtosis.image", "nginx"))
+	require.Equal(t, codes.Error, child.Status().Code)
+	require.Equal(t, "the image doesn't exist", child.Status().Description)
+	require.Equal(t, codes.Unset, parent.Status().Code)
+}
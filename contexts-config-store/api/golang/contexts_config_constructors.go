@@ -22,6 +22,7 @@ func NewLocalOnlyContext(uuid *generated.ContextUuid, name string) *generated.Ku
 		KurtosisContextInfo: &generated.KurtosisContext_LocalOnlyContextV0{
 			LocalOnlyContextV0: &generated.LocalOnlyContextV0{},
 		},
+		Defaults: nil,
 	}
 }
 
@@ -52,5 +53,47 @@ func NewRemoteV0Context(
 				CloudInstanceId:     cloudInstanceId,
 			},
 		},
+		Defaults: nil,
+	}
+}
+
+func NewSshV0Context(
+	uuid *generated.ContextUuid,
+	name string,
+	host string,
+	port uint32,
+	user string,
+	identityFile *string,
+	jumpHost *string,
+	dockerSocketPath *string,
+) *generated.KurtosisContext {
+	return &generated.KurtosisContext{
+		Uuid: uuid,
+		Name: name,
+		KurtosisContextInfo: &generated.KurtosisContext_SshContextV0{
+			SshContextV0: &generated.SshContextV0{
+				Host:             host,
+				Port:             port,
+				User:             user,
+				IdentityFile:     identityFile,
+				JumpHost:         jumpHost,
+				DockerSocketPath: dockerSocketPath,
+			},
+		},
+		Defaults: nil,
+	}
+}
+
+func NewContextDefaults(
+	clusterName *string,
+	enclaveNamePrefix *string,
+	packageArgsFile *string,
+	verbosity *string,
+) *generated.ContextDefaults {
+	return &generated.ContextDefaults{
+		ClusterName:       clusterName,
+		EnclaveNamePrefix: enclaveNamePrefix,
+		PackageArgsFile:   packageArgsFile,
+		Verbosity:         verbosity,
 	}
 }
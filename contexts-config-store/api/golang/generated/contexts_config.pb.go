@@ -88,7 +88,10 @@ type KurtosisContext struct {
 	//
 	//	*KurtosisContext_LocalOnlyContextV0
 	//	*KurtosisContext_RemoteContextV0
+	//	*KurtosisContext_SshContextV0
 	KurtosisContextInfo isKurtosisContext_KurtosisContextInfo `protobuf_oneof:"kurtosis_context_info"`
+	// Applied by the CLI while the context is the current one, unless overridden by flags
+	Defaults *ContextDefaults `protobuf:"bytes,6,opt,name=defaults,proto3,oneof" json:"defaults,omitempty"`
 }
 
 func (x *KurtosisContext) Reset() {
@@ -158,6 +161,20 @@ func (x *KurtosisContext) GetRemoteContextV0() *RemoteContextV0 {
 	return nil
 }
 
+func (x *KurtosisContext) GetSshContextV0() *SshContextV0 {
+	if x, ok := x.GetKurtosisContextInfo().(*KurtosisContext_SshContextV0); ok {
+		return x.SshContextV0
+	}
+	return nil
+}
+
+func (x *KurtosisContext) GetDefaults() *ContextDefaults {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
 type isKurtosisContext_KurtosisContextInfo interface {
 	isKurtosisContext_KurtosisContextInfo()
 }
@@ -172,10 +189,16 @@ type KurtosisContext_RemoteContextV0 struct {
 	RemoteContextV0 *RemoteContextV0 `protobuf:"bytes,4,opt,name=remote_context_v0,json=remoteContextV0,proto3,oneof"`
 }
 
+type KurtosisContext_SshContextV0 struct {
+	SshContextV0 *SshContextV0 `protobuf:"bytes,5,opt,name=ssh_context_v0,json=sshContextV0,proto3,oneof"`
+}
+
 func (*KurtosisContext_LocalOnlyContextV0) isKurtosisContext_KurtosisContextInfo() {}
 
 func (*KurtosisContext_RemoteContextV0) isKurtosisContext_KurtosisContextInfo() {}
 
+func (*KurtosisContext_SshContextV0) isKurtosisContext_KurtosisContextInfo() {}
+
 type ContextUuid struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -223,6 +246,81 @@ func (x *ContextUuid) GetValue() string {
 	return ""
 }
 
+type ContextDefaults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cluster, from the Kurtosis config, that gets set when switching to the context
+	ClusterName *string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3,oneof" json:"cluster_name,omitempty"`
+	// Prefix of the random names of the enclaves created without an explicit name
+	EnclaveNamePrefix *string `protobuf:"bytes,2,opt,name=enclave_name_prefix,json=enclaveNamePrefix,proto3,oneof" json:"enclave_name_prefix,omitempty"`
+	// Filepath or URL of the package args of 'kurtosis run' when none are given
+	PackageArgsFile *string `protobuf:"bytes,3,opt,name=package_args_file,json=packageArgsFile,proto3,oneof" json:"package_args_file,omitempty"`
+	// Verbosity of 'kurtosis run'
+	Verbosity *string `protobuf:"bytes,4,opt,name=verbosity,proto3,oneof" json:"verbosity,omitempty"`
+}
+
+func (x *ContextDefaults) Reset() {
+	*x = ContextDefaults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contexts_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContextDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextDefaults) ProtoMessage() {}
+
+func (x *ContextDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_contexts_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextDefaults.ProtoReflect.Descriptor instead.
+func (*ContextDefaults) Descriptor() ([]byte, []int) {
+	return file_contexts_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ContextDefaults) GetClusterName() string {
+	if x != nil && x.ClusterName != nil {
+		return *x.ClusterName
+	}
+	return ""
+}
+
+func (x *ContextDefaults) GetEnclaveNamePrefix() string {
+	if x != nil && x.EnclaveNamePrefix != nil {
+		return *x.EnclaveNamePrefix
+	}
+	return ""
+}
+
+func (x *ContextDefaults) GetPackageArgsFile() string {
+	if x != nil && x.PackageArgsFile != nil {
+		return *x.PackageArgsFile
+	}
+	return ""
+}
+
+func (x *ContextDefaults) GetVerbosity() string {
+	if x != nil && x.Verbosity != nil {
+		return *x.Verbosity
+	}
+	return ""
+}
+
 type LocalOnlyContextV0 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -232,7 +330,7 @@ type LocalOnlyContextV0 struct {
 func (x *LocalOnlyContextV0) Reset() {
 	*x = LocalOnlyContextV0{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contexts_config_proto_msgTypes[3]
+		mi := &file_contexts_config_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -245,7 +343,7 @@ func (x *LocalOnlyContextV0) String() string {
 func (*LocalOnlyContextV0) ProtoMessage() {}
 
 func (x *LocalOnlyContextV0) ProtoReflect() protoreflect.Message {
-	mi := &file_contexts_config_proto_msgTypes[3]
+	mi := &file_contexts_config_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +356,7 @@ func (x *LocalOnlyContextV0) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalOnlyContextV0.ProtoReflect.Descriptor instead.
 func (*LocalOnlyContextV0) Descriptor() ([]byte, []int) {
-	return file_contexts_config_proto_rawDescGZIP(), []int{3}
+	return file_contexts_config_proto_rawDescGZIP(), []int{4}
 }
 
 type RemoteContextV0 struct {
@@ -284,7 +382,7 @@ type RemoteContextV0 struct {
 func (x *RemoteContextV0) Reset() {
 	*x = RemoteContextV0{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contexts_config_proto_msgTypes[4]
+		mi := &file_contexts_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -297,7 +395,7 @@ func (x *RemoteContextV0) String() string {
 func (*RemoteContextV0) ProtoMessage() {}
 
 func (x *RemoteContextV0) ProtoReflect() protoreflect.Message {
-	mi := &file_contexts_config_proto_msgTypes[4]
+	mi := &file_contexts_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +408,7 @@ func (x *RemoteContextV0) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteContextV0.ProtoReflect.Descriptor instead.
 func (*RemoteContextV0) Descriptor() ([]byte, []int) {
-	return file_contexts_config_proto_rawDescGZIP(), []int{4}
+	return file_contexts_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RemoteContextV0) GetHost() string {
@@ -369,6 +467,101 @@ func (x *RemoteContextV0) GetCloudInstanceId() string {
 	return ""
 }
 
+// A remote host running Kurtosis on Docker, reached over SSH. The Docker daemon and the ports published by Kurtosis on
+// the host are tunneled to the local computer, so no Kurtosis Portal or VPN is needed
+type SshContextV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hostname or IP of the remote host running Kurtosis
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Port number the SSH server of the remote host is listening on. If 0, 22 will be used
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// User to log in to the remote host as
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Private key to authenticate with. If absent, the keys of the local SSH agent will be used
+	IdentityFile *string `protobuf:"bytes,4,opt,name=identity_file,json=identityFile,proto3,oneof" json:"identity_file,omitempty"`
+	// Host to connect to the remote host through, as [user@]host[:port]. If absent, the remote host is connected to directly
+	JumpHost *string `protobuf:"bytes,5,opt,name=jump_host,json=jumpHost,proto3,oneof" json:"jump_host,omitempty"`
+	// Path of the Docker daemon socket on the remote host. If absent, /var/run/docker.sock will be used
+	DockerSocketPath *string `protobuf:"bytes,6,opt,name=docker_socket_path,json=dockerSocketPath,proto3,oneof" json:"docker_socket_path,omitempty"`
+}
+
+func (x *SshContextV0) Reset() {
+	*x = SshContextV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contexts_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshContextV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshContextV0) ProtoMessage() {}
+
+func (x *SshContextV0) ProtoReflect() protoreflect.Message {
+	mi := &file_contexts_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshContextV0.ProtoReflect.Descriptor instead.
+func (*SshContextV0) Descriptor() ([]byte, []int) {
+	return file_contexts_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SshContextV0) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SshContextV0) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SshContextV0) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SshContextV0) GetIdentityFile() string {
+	if x != nil && x.IdentityFile != nil {
+		return *x.IdentityFile
+	}
+	return ""
+}
+
+func (x *SshContextV0) GetJumpHost() string {
+	if x != nil && x.JumpHost != nil {
+		return *x.JumpHost
+	}
+	return ""
+}
+
+func (x *SshContextV0) GetDockerSocketPath() string {
+	if x != nil && x.DockerSocketPath != nil {
+		return *x.DockerSocketPath
+	}
+	return ""
+}
+
 type TlsConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -385,7 +578,7 @@ type TlsConfig struct {
 func (x *TlsConfig) Reset() {
 	*x = TlsConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contexts_config_proto_msgTypes[5]
+		mi := &file_contexts_config_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -398,7 +591,7 @@ func (x *TlsConfig) String() string {
 func (*TlsConfig) ProtoMessage() {}
 
 func (x *TlsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_contexts_config_proto_msgTypes[5]
+	mi := &file_contexts_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +604,7 @@ func (x *TlsConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TlsConfig.ProtoReflect.Descriptor instead.
 func (*TlsConfig) Descriptor() ([]byte, []int) {
-	return file_contexts_config_proto_rawDescGZIP(), []int{5}
+	return file_contexts_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TlsConfig) GetCertificateAuthority() []byte {
@@ -451,8 +644,8 @@ var file_contexts_config_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73,
 	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22, 0xa9,
-	0x02, 0x0a, 0x0f, 0x4b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22, 0xca,
+	0x03, 0x0a, 0x0f, 0x4b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65,
 	0x78, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x55,
@@ -469,53 +662,97 @@ var file_contexts_config_proto_rawDesc = []byte{
 	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52,
 	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x30, 0x48, 0x00,
 	0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56,
-	0x30, 0x42, 0x17, 0x0a, 0x15, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x23, 0x0a, 0x0b, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x14, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x56, 0x30, 0x22, 0xab, 0x03, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x30, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x5f, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6b,
-	0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6b, 0x75, 0x72, 0x74,
-	0x6f, 0x73, 0x69, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x72, 0x74,
-	0x12, 0x43, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x6c, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x56, 0x61,
-	0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f,
-	0x0a, 0x11, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x14, 0x0a,
-	0x12, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x09, 0x54, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x4b, 0x65, 0x79, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63, 0x68,
-	0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x73, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x30, 0x12, 0x4a, 0x0a, 0x0e, 0x73, 0x73, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x76, 0x30, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x53, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x30, 0x48, 0x00, 0x52,
+	0x0c, 0x73, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x30, 0x12, 0x46, 0x0a,
+	0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x01, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a, 0x15, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69,
+	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x23, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x8f, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x11, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x72, 0x67,
+	0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x72, 0x67, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69,
+	0x74, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x30, 0x22, 0xab, 0x03, 0x0a, 0x0f, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x30, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x32,
+	0x0a, 0x15, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6b,
+	0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54,
+	0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f,
+	0x76, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e,
+	0x76, 0x56, 0x61, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x11, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x0c, 0x53, 0x73, 0x68, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x56, 0x30, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x09, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x08, 0x6a, 0x75, 0x6d, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x12, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x10, 0x64,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x88,
+	0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0x8e, 0x01, 0x0a, 0x09, 0x54, 0x6c,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69,
+	0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2d,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -530,27 +767,31 @@ func file_contexts_config_proto_rawDescGZIP() []byte {
 	return file_contexts_config_proto_rawDescData
 }
 
-var file_contexts_config_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_contexts_config_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_contexts_config_proto_goTypes = []interface{}{
 	(*KurtosisContextsConfig)(nil), // 0: context_config_store.KurtosisContextsConfig
 	(*KurtosisContext)(nil),        // 1: context_config_store.KurtosisContext
 	(*ContextUuid)(nil),            // 2: context_config_store.ContextUuid
-	(*LocalOnlyContextV0)(nil),     // 3: context_config_store.LocalOnlyContextV0
-	(*RemoteContextV0)(nil),        // 4: context_config_store.RemoteContextV0
-	(*TlsConfig)(nil),              // 5: context_config_store.TlsConfig
+	(*ContextDefaults)(nil),        // 3: context_config_store.ContextDefaults
+	(*LocalOnlyContextV0)(nil),     // 4: context_config_store.LocalOnlyContextV0
+	(*RemoteContextV0)(nil),        // 5: context_config_store.RemoteContextV0
+	(*SshContextV0)(nil),           // 6: context_config_store.SshContextV0
+	(*TlsConfig)(nil),              // 7: context_config_store.TlsConfig
 }
 var file_contexts_config_proto_depIdxs = []int32{
 	2, // 0: context_config_store.KurtosisContextsConfig.currentContextUuid:type_name -> context_config_store.ContextUuid
 	1, // 1: context_config_store.KurtosisContextsConfig.contexts:type_name -> context_config_store.KurtosisContext
 	2, // 2: context_config_store.KurtosisContext.uuid:type_name -> context_config_store.ContextUuid
-	3, // 3: context_config_store.KurtosisContext.local_only_context_v0:type_name -> context_config_store.LocalOnlyContextV0
-	4, // 4: context_config_store.KurtosisContext.remote_context_v0:type_name -> context_config_store.RemoteContextV0
-	5, // 5: context_config_store.RemoteContextV0.tls_config:type_name -> context_config_store.TlsConfig
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	4, // 3: context_config_store.KurtosisContext.local_only_context_v0:type_name -> context_config_store.LocalOnlyContextV0
+	5, // 4: context_config_store.KurtosisContext.remote_context_v0:type_name -> context_config_store.RemoteContextV0
+	6, // 5: context_config_store.KurtosisContext.ssh_context_v0:type_name -> context_config_store.SshContextV0
+	3, // 6: context_config_store.KurtosisContext.defaults:type_name -> context_config_store.ContextDefaults
+	7, // 7: context_config_store.RemoteContextV0.tls_config:type_name -> context_config_store.TlsConfig
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_contexts_config_proto_init() }
@@ -596,7 +837,7 @@ func file_contexts_config_proto_init() {
 			}
 		}
 		file_contexts_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalOnlyContextV0); i {
+			switch v := v.(*ContextDefaults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -608,7 +849,7 @@ func file_contexts_config_proto_init() {
 			}
 		}
 		file_contexts_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteContextV0); i {
+			switch v := v.(*LocalOnlyContextV0); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -620,6 +861,30 @@ func file_contexts_config_proto_init() {
 			}
 		}
 		file_contexts_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteContextV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contexts_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshContextV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contexts_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TlsConfig); i {
 			case 0:
 				return &v.state
@@ -635,15 +900,18 @@ func file_contexts_config_proto_init() {
 	file_contexts_config_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*KurtosisContext_LocalOnlyContextV0)(nil),
 		(*KurtosisContext_RemoteContextV0)(nil),
+		(*KurtosisContext_SshContextV0)(nil),
 	}
-	file_contexts_config_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_contexts_config_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_contexts_config_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_contexts_config_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_contexts_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
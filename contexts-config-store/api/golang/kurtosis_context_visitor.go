@@ -10,6 +10,8 @@ type KurtosisContextVisitor[ResultType any] struct {
 	VisitLocalOnlyContextV0 func(localContext *generated.LocalOnlyContextV0) (*ResultType, error)
 
 	VisitRemoteContextV0 func(localContext *generated.RemoteContextV0) (*ResultType, error)
+
+	VisitSshContextV0 func(sshContext *generated.SshContextV0) (*ResultType, error)
 }
 
 func Visit[ResultType any](kurtosisContext *generated.KurtosisContext, visitor KurtosisContextVisitor[ResultType]) (*ResultType, error) {
@@ -17,6 +19,8 @@ func Visit[ResultType any](kurtosisContext *generated.KurtosisContext, visitor K
 		return visitor.VisitLocalOnlyContextV0(kurtosisContext.GetLocalOnlyContextV0())
 	} else if kurtosisContext.GetRemoteContextV0() != nil {
 		return visitor.VisitRemoteContextV0(kurtosisContext.GetRemoteContextV0())
+	} else if kurtosisContext.GetSshContextV0() != nil {
+		return visitor.VisitSshContextV0(kurtosisContext.GetSshContextV0())
 	}
 	return nil, stacktrace.NewError("Type of KurtosisContext couldn't be resolved: '%s'", reflect.TypeOf(kurtosisContext.KurtosisContextInfo))
 }
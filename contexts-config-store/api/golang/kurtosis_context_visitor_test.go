@@ -24,6 +24,9 @@ func TestLocalOnlyContext(t *testing.T) {
 		VisitRemoteContextV0: func(remoteContext *generated.RemoteContextV0) (*string, error) {
 			return nil, stacktrace.NewError("Should not be called")
 		},
+		VisitSshContextV0: func(sshContext *generated.SshContextV0) (*string, error) {
+			return nil, stacktrace.NewError("Should not be called")
+		},
 	})
 	require.Nil(t, err)
 	require.Equal(t, visitorTestResult, *result)
@@ -47,6 +50,27 @@ func TestRemoteContext(t *testing.T) {
 		VisitRemoteContextV0: func(remoteContext *generated.RemoteContextV0) (*string, error) {
 			return &visitorTestResult, nil
 		},
+		VisitSshContextV0: func(sshContext *generated.SshContextV0) (*string, error) {
+			return nil, stacktrace.NewError("Should not be called")
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, visitorTestResult, *result)
+}
+
+func TestSshContext(t *testing.T) {
+	kurtosisContext := NewSshV0Context(contextUuid, contextName, "kurtosis.example.com", 0, "kurtosis", nil, nil, nil)
+
+	result, err := Visit[string](kurtosisContext, KurtosisContextVisitor[string]{
+		VisitLocalOnlyContextV0: func(localOnlyContext *generated.LocalOnlyContextV0) (*string, error) {
+			return nil, stacktrace.NewError("Should not be called")
+		},
+		VisitRemoteContextV0: func(remoteContext *generated.RemoteContextV0) (*string, error) {
+			return nil, stacktrace.NewError("Should not be called")
+		},
+		VisitSshContextV0: func(sshContext *generated.SshContextV0) (*string, error) {
+			return &visitorTestResult, nil
+		},
 	})
 	require.Nil(t, err)
 	require.Equal(t, visitorTestResult, *result)
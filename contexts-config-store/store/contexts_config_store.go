@@ -27,6 +27,10 @@ type ContextsConfigStore interface {
 	// It throws an error if a context with the same UUID already exists
 	AddNewContext(newContext *generated.KurtosisContext) error
 
+	// SetContextDefaults replaces the defaults of the context passed as an argument. Nil removes them.
+	// It throws an error if the contextUuid does not point to any known context.
+	SetContextDefaults(contextUuid *generated.ContextUuid, defaults *generated.ContextDefaults) error
+
 	// RemoveContext removes the contexts passed as an argument.
 	// It does nothing if the contextUuid does not point to any known context.
 	RemoveContext(contextUuid *generated.ContextUuid) error
@@ -50,6 +54,17 @@ func IsRemote(kurtosisContext *generated.KurtosisContext) bool {
 			isRemote = true
 			return nil, nil
 		},
+		VisitSshContextV0: func(_ *generated.SshContextV0) (*struct{}, error) {
+			// SSH contexts don't go through Kurtosis Portal, see IsSsh
+			isRemote = false
+			return nil, nil
+		},
 	})
 	return isRemote
 }
+
+// IsSsh returns true if the context reaches a remote host running Kurtosis over SSH, tunneling its Docker daemon and
+// the ports Kurtosis publishes on it
+func IsSsh(kurtosisContext *generated.KurtosisContext) bool {
+	return kurtosisContext.GetSshContextV0() != nil
+}
@@ -124,6 +124,36 @@ func (store *contextConfigStoreImpl) AddNewContext(newContext *generated.Kurtosi
 	return nil
 }
 
+func (store *contextConfigStoreImpl) SetContextDefaults(contextUuid *generated.ContextUuid, defaults *generated.ContextDefaults) error {
+	store.Lock()
+	defer store.Unlock()
+
+	contextsConfig, err := store.storage.LoadContextsConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to load the list of contexts currently stored")
+	}
+
+	var contextToUpdate *generated.KurtosisContext
+	var contextUuidsInStore []string
+	for _, kurtosisContextInStore := range contextsConfig.GetContexts() {
+		contextUuidsInStore = append(contextUuidsInStore, kurtosisContextInStore.GetUuid().GetValue())
+		if kurtosisContextInStore.GetUuid().GetValue() == contextUuid.GetValue() {
+			contextToUpdate = kurtosisContextInStore
+		}
+	}
+	if contextToUpdate == nil {
+		return stacktrace.NewError("Context with UUID '%s' does not exist in store. Known contexts are: '%s'",
+			contextUuid.GetValue(), strings.Join(contextUuidsInStore, contextUuidsSeparator))
+	}
+	contextToUpdate.Defaults = defaults
+
+	newContextConfigToPersist := api.NewKurtosisContextsConfig(contextsConfig.GetCurrentContextUuid(), contextsConfig.GetContexts()...)
+	if err = store.storage.PersistContextsConfig(newContextConfigToPersist); err != nil {
+		return stacktrace.Propagate(err, "Unable to persist the new defaults of context '%s' to store", contextUuid.GetValue())
+	}
+	return nil
+}
+
 func (store *contextConfigStoreImpl) RemoveContext(contextUuid *generated.ContextUuid) error {
 	store.Lock()
 	defer store.Unlock()
@@ -98,6 +98,40 @@ func TestSetContext_NonExistingContextFailure(t *testing.T) {
 	require.Contains(t, err.Error(), expectedErr)
 }
 
+func TestSetContextDefaults(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, api.NewLocalOnlyContext(contextUuid, "context-name"), otherLocalContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	clusterName := "remote-k8s"
+	defaults := api.NewContextDefaults(&clusterName, nil, nil, nil)
+	expectedContext := api.NewLocalOnlyContext(contextUuid, "context-name")
+	expectedContext.Defaults = defaults
+	expectContextConfigAfterUpdate := api.NewKurtosisContextsConfig(contextUuid, expectedContext, otherLocalContext)
+	storage.EXPECT().PersistContextsConfig(expectContextConfigAfterUpdate).Times(1).Return(nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetContextDefaults(contextUuid, defaults)
+	require.NoError(t, err)
+}
+
+func TestSetContextDefaults_NonExistingContextFailure(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, localContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetContextDefaults(otherContextUuid, nil)
+	require.Error(t, err)
+	expectedErr := fmt.Sprintf("Context with UUID '%s' does not exist in store. Known contexts are: '%s'",
+		otherContextUuid.GetValue(), contextUuid.GetValue())
+	require.Contains(t, err.Error(), expectedErr)
+}
+
 func TestAddNewContext(t *testing.T) {
 	// Setup storage mock
 	storage := persistence.NewMockConfigPersistence(t)
@@ -252,6 +252,49 @@ func (_c *MockContextsConfigStore_SetContext_Call) RunAndReturn(run func(*genera
 	return _c
 }
 
+// SetContextDefaults provides a mock function with given fields: contextUuid, defaults
+func (_m *MockContextsConfigStore) SetContextDefaults(contextUuid *generated.ContextUuid, defaults *generated.ContextDefaults) error {
+	ret := _m.Called(contextUuid, defaults)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*generated.ContextUuid, *generated.ContextDefaults) error); ok {
+		r0 = rf(contextUuid, defaults)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContextsConfigStore_SetContextDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetContextDefaults'
+type MockContextsConfigStore_SetContextDefaults_Call struct {
+	*mock.Call
+}
+
+// SetContextDefaults is a helper method to define mock.On call
+//   - contextUuid *generated.ContextUuid
+//   - defaults *generated.ContextDefaults
+func (_e *MockContextsConfigStore_Expecter) SetContextDefaults(contextUuid interface{}, defaults interface{}) *MockContextsConfigStore_SetContextDefaults_Call {
+	return &MockContextsConfigStore_SetContextDefaults_Call{Call: _e.mock.On("SetContextDefaults", contextUuid, defaults)}
+}
+
+func (_c *MockContextsConfigStore_SetContextDefaults_Call) Run(run func(contextUuid *generated.ContextUuid, defaults *generated.ContextDefaults)) *MockContextsConfigStore_SetContextDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*generated.ContextUuid), args[1].(*generated.ContextDefaults))
+	})
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetContextDefaults_Call) Return(_a0 error) *MockContextsConfigStore_SetContextDefaults_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetContextDefaults_Call) RunAndReturn(run func(*generated.ContextUuid, *generated.ContextDefaults) error) *MockContextsConfigStore_SetContextDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockContextsConfigStore interface {
 	mock.TestingT
 	Cleanup(func())
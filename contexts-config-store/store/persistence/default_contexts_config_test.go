@@ -36,6 +36,9 @@ func TestNewDefaultContextsConfig(t *testing.T) {
 		VisitLocalOnlyContextV0: func(localContext *generated.LocalOnlyContextV0) (*struct{}, error) {
 			return nil, nil
 		},
+		VisitSshContextV0: func(sshContext *generated.SshContextV0) (*struct{}, error) {
+			return nil, stacktrace.NewError("default context should be a local-only context!")
+		},
 	})
 	require.NoError(t, err)
 }
@@ -22,6 +22,9 @@ type FilesArtifactsExpanderArgs struct {
 	APIContainerIpAddress   string                   `json:"apiContainerIpAddress"`
 	ApiContainerPort        uint16                   `json:"apiContainerPort"`
 	FilesArtifactExpansions []FilesArtifactExpansion `json:"filesArtifactExpansions"`
+
+	// Nil if the API container doesn't require mutual TLS
+	ApiContainerTlsConfig *ApiContainerTlsConfig `json:"apiContainerTlsConfig,omitempty"`
 }
 
 type FilesArtifactExpansion struct {
@@ -31,11 +34,21 @@ type FilesArtifactExpansion struct {
 	DirPathToExpandTo string `json:"dirPathToExpandTo"`
 }
 
-func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion) (*FilesArtifactsExpanderArgs, error) {
+// ApiContainerTlsConfig is what the expander authenticates to an API container that requires mutual TLS with. Every
+// field is PEM-encoded
+type ApiContainerTlsConfig struct {
+	CertificateAuthority string `json:"certificateAuthority"`
+	ClientCertificate    string `json:"clientCertificate"`
+	ClientKey            string `json:"clientKey"`
+	FipsMode             bool   `json:"fipsMode"`
+}
+
+func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion, apiContainerTlsConfig *ApiContainerTlsConfig) (*FilesArtifactsExpanderArgs, error) {
 	result := &FilesArtifactsExpanderArgs{
 		APIContainerIpAddress:   apiContainerIpAddress,
 		ApiContainerPort:        apiContainerPort,
 		FilesArtifactExpansions: filesArtifactExpansions,
+		ApiContainerTlsConfig:   apiContainerTlsConfig,
 	}
 	logrus.Debugf("Expander args: %+v", result)
 	if err := result.validate(); err != nil {
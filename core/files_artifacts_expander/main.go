@@ -6,16 +6,20 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/gammazero/workerpool"
+	"github.com/kurtosis-tech/kurtosis/api/golang/api_container_tls"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
 	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"io"
 	"net"
 	"os"
 	"os/exec"
@@ -27,9 +31,6 @@ const (
 	failureExitCode = 1
 	maxWorkers      = 4
 
-	//Files permissions for temporary file storing files artifact data: readable by all the user groups, but writable by user only
-	filesArtifactTemporaryFilePermissions = 0644
-
 	forceColors   = true
 	fullTimestamp = true
 )
@@ -76,7 +77,11 @@ func runMain() error {
 	}
 	apiContainerPortNum := filesArtifactExpanderArgs.ApiContainerPort
 	grpcUrl := fmt.Sprintf("%v:%v", apiContainerIpAddr, apiContainerPortNum)
-	apiContainerConnection, err := grpc.Dial(grpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	transportCredentials, err := getApiContainerTransportCredentials(filesArtifactExpanderArgs.ApiContainerTlsConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the transport credentials of the connection to the API container")
+	}
+	apiContainerConnection, err := grpc.Dial(grpcUrl, grpc.WithTransportCredentials(transportCredentials))
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to create a client connection to API container at address '%v', instead a non-nil error was returned", grpcUrl)
 	}
@@ -136,39 +141,66 @@ func expandFilesArtifact(ctx context.Context, apiContainerClient kurtosis_core_r
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred initiating the download of files artifact '%v'", artifactIdentifier)
 	}
+	// Never start the service with a corrupted artifact; artifacts without a recorded checksum can't be verified
+	expectedContentSha256, found, err := grpc_file_streaming.GetContentSha256Header(client)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the expected checksum of files artifact '%v'", artifactIdentifier)
+	}
+
+	// The artifact is extracted as it's downloaded, so that it never needs to fit in memory or on disk as a whole
 	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, any](client)
-	fileContent, err := clientStream.ReceiveData(
+	filesArtifactPipeReader := clientStream.PipeReader(
 		artifactIdentifier,
 		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
 			return dataChunk.Data, dataChunk.PreviousChunkHash, nil
 		},
 	)
-	if err != nil {
-		return stacktrace.Propagate(err, "Expected to be able to download files artifacts for files artifact with identifier '%v' from Kurtosis, instead a non-nil error was returned", artifactIdentifier)
+	// Closing the pipe stops the download if the extraction fails midway
+	defer filesArtifactPipeReader.Close()
+	var filesArtifactReader io.Reader = filesArtifactPipeReader
+	if found {
+		filesArtifactReader = grpc_file_streaming.NewContentChecksumVerifyingReader(filesArtifactPipeReader, expectedContentSha256)
 	}
 
-	// Save the bytes to file, might not be necessary if we can pipe the artifact bytes to stdin
-	filesArtifactFile, err := os.CreateTemp(os.TempDir(), "")
-	if err != nil {
-		return stacktrace.Propagate(err, "Expected to be able to create a temporary file for the files artifact bytes, instead a non-nil error was returned")
-	}
-	if err := filesArtifactFile.Close(); err != nil {
-		return stacktrace.Propagate(err, "Expected to be able to close the temporary file '%v' we created to store the downloaded files artifact, instead a non-nil error was returned", filesArtifactFile.Name())
+	if err := extractTarball(filesArtifactReader, filesArtifactExpansion.DirPathToExpandTo); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting files artifact '%v'", artifactIdentifier)
 	}
+	return nil
+}
 
-	filesArtifactFileName := filesArtifactFile.Name()
-	if err := os.WriteFile(filesArtifactFileName, fileContent, filesArtifactTemporaryFilePermissions); err != nil {
-		return stacktrace.Propagate(err, "Expected to be able to save files artifact to disk at path '%v', instead a non nil error was returned", filesArtifactFileName)
-	}
-	// Extract the tarball to the specified location
-	extractTarballCmd := exec.Command("tar", "-xzf", filesArtifactFileName, "-C", filesArtifactExpansion.DirPathToExpandTo)
+// extractTarball extracts the gzipped tarball read from the reader into the directory, without buffering it. The
+// reader is read until its end even if tar stops reading it earlier, so that the errors it returns at the end, like
+// checksum mismatches, aren't missed
+func extractTarball(tarballReader io.Reader, dirPathToExpandTo string) error {
+	extractTarballCmd := exec.Command("tar", "-xzf", "-", "-C", dirPathToExpandTo)
+	extractTarballCmd.Stdin = tarballReader
+	extractTarballCmdStderr := &bytes.Buffer{}
+	extractTarballCmd.Stderr = extractTarballCmdStderr
 	if err := extractTarballCmd.Run(); err != nil {
 		// Per the docs, we can downcast like so
-		castedErr, ok := err.(*exec.ExitError)
-		if !ok {
+		if _, ok := err.(*exec.ExitError); !ok {
 			return stacktrace.Propagate(err, "Command '%v' failed with an unrecognized error", extractTarballCmd.String())
 		}
-		return stacktrace.NewError("Command '%v' exited with an error and the following STDERR:\n%v", extractTarballCmd.String(), string(castedErr.Stderr))
+		return stacktrace.NewError("Command '%v' exited with an error and the following STDERR:\n%v", extractTarballCmd.String(), extractTarballCmdStderr.String())
+	}
+	if _, err := io.Copy(io.Discard, tarballReader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the rest of the tarball after extracting it")
 	}
 	return nil
 }
+
+func getApiContainerTransportCredentials(apiContainerTlsConfig *args.ApiContainerTlsConfig) (credentials.TransportCredentials, error) {
+	if apiContainerTlsConfig == nil {
+		return insecure.NewCredentials(), nil
+	}
+	transportCredentials, err := api_container_tls.NewClientTransportCredentials(
+		apiContainerTlsConfig.CertificateAuthority,
+		apiContainerTlsConfig.ClientCertificate,
+		apiContainerTlsConfig.ClientKey,
+		apiContainerTlsConfig.FipsMode,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the TLS credentials of the connection to the API container")
+	}
+	return transportCredentials, nil
+}
@@ -5,4 +5,59 @@
 
 package main
 
-// empty test file
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFileName    = "genesis.json"
+	testFileContent = `{"chainId": 1337}`
+	testFileMode    = 0644
+)
+
+func TestExtractTarball(t *testing.T) {
+	tarball := createTestTarball(t)
+	dirPathToExpandTo := t.TempDir()
+
+	tarballReader := grpc_file_streaming.NewContentChecksumVerifyingReader(bytes.NewReader(tarball), grpc_file_streaming.ComputeContentSha256(tarball))
+	require.NoError(t, extractTarball(tarballReader, dirPathToExpandTo))
+
+	extractedFileContent, err := os.ReadFile(path.Join(dirPathToExpandTo, testFileName))
+	require.NoError(t, err)
+	require.Equal(t, testFileContent, string(extractedFileContent))
+}
+
+func TestExtractTarball_ChecksumMismatchFails(t *testing.T) {
+	tarball := createTestTarball(t)
+
+	tarballReader := grpc_file_streaming.NewContentChecksumVerifyingReader(bytes.NewReader(tarball), grpc_file_streaming.ComputeContentSha256([]byte("some other content")))
+	require.Error(t, extractTarball(tarballReader, t.TempDir()))
+}
+
+func TestExtractTarball_InvalidTarballFails(t *testing.T) {
+	require.Error(t, extractTarball(bytes.NewReader([]byte("not a tarball")), t.TempDir()))
+}
+
+func createTestTarball(t *testing.T) []byte {
+	tarball := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(tarball)
+	tarWriter := tar.NewWriter(gzipWriter)
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+		Name: testFileName,
+		Mode: testFileMode,
+		Size: int64(len(testFileContent)),
+	}))
+	_, err := tarWriter.Write([]byte(testFileContent))
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return tarball.Bytes()
+}
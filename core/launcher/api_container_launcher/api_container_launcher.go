@@ -8,8 +8,15 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -46,6 +53,15 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	metricsSinkConfig metrics_client.SinkConfig,
+	tlsConfig *args.ApiContainerTlsConfig,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -65,6 +81,15 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		artifactsStoreConfig,
+		imageCacheConfig,
+		enclaveQuota,
+		metricsSinkConfig,
+		tlsConfig,
+		secretsProviderConfig,
+		imageVerificationConfig,
+		airGapConfig,
+		contentScanningConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -87,6 +112,15 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	metricsSinkConfig metrics_client.SinkConfig,
+	tlsConfig *args.ApiContainerTlsConfig,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -107,6 +141,15 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		isCI,
 		cloudUserID,
 		cloudInstanceID,
+		artifactsStoreConfig,
+		imageCacheConfig,
+		enclaveQuota,
+		metricsSinkConfig,
+		tlsConfig,
+		secretsProviderConfig,
+		imageVerificationConfig,
+		airGapConfig,
+		contentScanningConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the API container args")
@@ -117,11 +160,7 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		return nil, stacktrace.Propagate(err, "An error occurred generating the API container's environment variables")
 	}
 
-	containerImageAndTag := fmt.Sprintf(
-		"%v:%v",
-		containerImage,
-		imageVersionTag,
-	)
+	containerImageAndTag := GetApiContainerImage(imageVersionTag)
 
 	logrus.Debugf("Launching Kurtosis API container...")
 	apiContainer, err := launcher.kurtosisBackend.CreateAPIContainer(
@@ -140,3 +179,12 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 
 	return apiContainer, nil
 }
+
+// GetApiContainerImage returns the image of the API containers with the version
+func GetApiContainerImage(imageVersionTag string) string {
+	return fmt.Sprintf(
+		"%v:%v",
+		containerImage,
+		imageVersionTag,
+	)
+}
@@ -11,15 +11,43 @@ import (
 )
 
 type KubernetesBackendConfigSupplier struct {
-	storageClass string
+	storageClass        string
+	clientQPS           float32
+	clientBurst         int
+	clientMaxRetries    int
+	objectLabels        map[string]string
+	objectAnnotations   map[string]string
+	podSecurityStandard string
 }
 
-func NewKubernetesKurtosisBackendConfigSupplier(storageClass string) KubernetesBackendConfigSupplier {
+func NewKubernetesKurtosisBackendConfigSupplier(
+	storageClass string,
+	clientQPS float32,
+	clientBurst int,
+	clientMaxRetries int,
+	objectLabels map[string]string,
+	objectAnnotations map[string]string,
+	podSecurityStandard string,
+) KubernetesBackendConfigSupplier {
 	return KubernetesBackendConfigSupplier{
-		storageClass: storageClass,
+		storageClass:        storageClass,
+		clientQPS:           clientQPS,
+		clientBurst:         clientBurst,
+		clientMaxRetries:    clientMaxRetries,
+		objectLabels:        objectLabels,
+		objectAnnotations:   objectAnnotations,
+		podSecurityStandard: podSecurityStandard,
 	}
 }
 
 func (backendConfigSupplier KubernetesBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
-	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{StorageClass: backendConfigSupplier.storageClass}
+	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{
+		StorageClass:        backendConfigSupplier.storageClass,
+		ClientQPS:           backendConfigSupplier.clientQPS,
+		ClientBurst:         backendConfigSupplier.clientBurst,
+		ClientMaxRetries:    backendConfigSupplier.clientMaxRetries,
+		ObjectLabels:        backendConfigSupplier.objectLabels,
+		ObjectAnnotations:   backendConfigSupplier.objectAnnotations,
+		PodSecurityStandard: backendConfigSupplier.podSecurityStandard,
+	}
 }
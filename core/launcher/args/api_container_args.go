@@ -2,6 +2,13 @@ package args
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"reflect"
@@ -54,6 +61,33 @@ type APIContainerArgs struct {
 
 	// The Cloud Instance ID of the current user if available
 	CloudInstanceID metrics_client.CloudInstanceID `json:"cloud_instance_id"`
+
+	// Where the content of files artifacts is stored, in addition to the enclave data volume
+	ArtifactsStoreConfig artifacts_store.ArtifactsStoreConfig `json:"artifactsStoreConfig"`
+
+	// How the images built and pulled by the API container are cached across enclaves and runs
+	ImageCacheConfig image_cache.ImageCacheConfig `json:"imageCacheConfig"`
+
+	// Resources the services of the enclave can claim, checked whenever services get added
+	EnclaveQuota enclave_quota.EnclaveQuota `json:"enclaveQuota"`
+
+	// Where the API container sends the product metrics to, and which of them
+	MetricsSinkConfig metrics_client.SinkConfig `json:"metricsSinkConfig"`
+
+	// Makes the API container require mutual TLS; nil if the engine doesn't require it
+	TlsConfig *ApiContainerTlsConfig `json:"tlsConfig,omitempty"`
+
+	// Where the secrets that Starlark references with kurtosis.secret are read from
+	SecretsProviderConfig secrets_provider.SecretsProviderConfig `json:"secretsProviderConfig"`
+
+	// Who the images of the plans must be signed by to be started
+	ImageVerificationConfig image_verification.ImageVerificationConfig `json:"imageVerificationConfig"`
+
+	// The internal mirrors the images and the packages are resolved from in air-gapped mode
+	AirGapConfig air_gap.AirGapConfig `json:"airGapConfig"`
+
+	// The hook the uploaded files artifacts are scanned with before being stored
+	ContentScanningConfig content_scanning.ContentScanningConfig `json:"contentScanningConfig"`
 }
 
 var skipValidation = map[string]bool{
@@ -110,6 +144,15 @@ func NewAPIContainerArgs(
 	isCI bool,
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	metricsSinkConfig metrics_client.SinkConfig,
+	tlsConfig *ApiContainerTlsConfig,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
 		Version:                     version,
@@ -126,11 +169,41 @@ func NewAPIContainerArgs(
 		IsCI:                        isCI,
 		CloudUserID:                 cloudUserID,
 		CloudInstanceID:             cloudInstanceID,
+		ArtifactsStoreConfig:        artifactsStoreConfig,
+		ImageCacheConfig:            imageCacheConfig,
+		EnclaveQuota:                enclaveQuota,
+		MetricsSinkConfig:           metricsSinkConfig,
+		TlsConfig:                   tlsConfig,
+		SecretsProviderConfig:       secretsProviderConfig,
+		ImageVerificationConfig:     imageVerificationConfig,
+		AirGapConfig:                airGapConfig,
+		ContentScanningConfig:       contentScanningConfig,
 	}
 
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating API container args")
 	}
+	if err := artifactsStoreConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the artifacts store config")
+	}
+	if err := imageCacheConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the image cache config")
+	}
+	if err := metricsSinkConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the metrics sink config")
+	}
+	if err := secretsProviderConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the secrets provider config")
+	}
+	if err := imageVerificationConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the image verification config")
+	}
+	if err := airGapConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the air gap config")
+	}
+	if err := contentScanningConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the content scanning config")
+	}
 	return result, nil
 }
 
@@ -0,0 +1,17 @@
+package args
+
+// ApiContainerTlsConfig makes the API container only accept the calls authenticated with a certificate issued by the
+// certificate authority the engine created for its enclave. Every field is PEM-encoded
+type ApiContainerTlsConfig struct {
+	CertificateAuthority string `json:"certificateAuthority"`
+
+	ServerCertificate string `json:"serverCertificate"`
+	ServerKey         string `json:"serverKey"`
+
+	// What the API container calls itself with, from the files artifacts expanders it launches
+	ClientCertificate string `json:"clientCertificate"`
+	ClientKey         string `json:"clientKey"`
+
+	// Whether the TLS connections of the API container are restricted to the FIPS-approved parameters
+	FipsMode bool `json:"fipsMode"`
+}
@@ -2,13 +2,14 @@ package args
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/stacktrace"
 	"net"
 	"os"
 )
 
 const (
-	// All API containers accept exactly one environment variable, which contains the serialized params that
+	// All API containers accept exactly one environment variable (besides the OpenTelemetry ones), which contains the serialized params that
 	// dictate how the API container ought to behave
 	serializedArgsEnvVar = "SERIALIZED_ARGS"
 
@@ -34,6 +35,10 @@ func GetEnvFromArgs(args *APIContainerArgs) (
 	envVars := map[string]string{
 		serializedArgsEnvVar: argsStr,
 	}
+	// the API container exports its spans the same way as the engine launching it, so the traces go through it
+	for otelEnvVar, value := range tracing.GetOtelEnvVars() {
+		envVars[otelEnvVar] = value
+	}
 	return envVars, ownIpAddressEnvVar, nil
 }
 
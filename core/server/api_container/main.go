@@ -7,24 +7,34 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_program_cache"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_run"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
 	"net"
+	"net/http"
 	"os"
 	"path"
 	"runtime"
 	"strings"
 	"time"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/api_container_tls"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/fips_mode"
+	// Registers the compressions the clients can choose for their calls, e.g. when downloading files artifacts remotely
+	_ "github.com/kurtosis-tech/kurtosis/api/golang/grpc_compression"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
@@ -34,6 +44,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/files_artifact_scanning"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/image_signatures"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/secrets"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/web_files_downloader"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/analytics_logger"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/source"
@@ -42,6 +56,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 const (
@@ -58,6 +73,9 @@ const (
 	emptyFunctionName         = ""
 
 	shouldFlushMetricsClientQueueOnEachEvent = false
+
+	// Names the API container in the traces it exports
+	apiContainerTracingServiceName = "kurtosis-api-container"
 )
 
 func main() {
@@ -111,7 +129,29 @@ func runMain() error {
 	}
 	logrus.SetLevel(logLevel)
 
-	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(serverArgs.EnclaveDataVolumeDirpath)
+	shutdownTracing, err := tracing.InitTracing(ctx, apiContainerTracingServiceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred initializing the tracing of the API container")
+	}
+	defer shutdownTracing(context.Background())
+
+	maybeRemoteFileStore, err := enclave_data_directory.GetRemoteFileStore(serverArgs.ArtifactsStoreConfig, serverArgs.EnclaveUUID)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the remote store for files artifacts")
+	}
+	if maybeRemoteFileStore != nil {
+		logrus.Infof("Files artifacts will be stored in the '%v' bucket '%v', the enclave data volume caching them", serverArgs.ArtifactsStoreConfig.Type, serverArgs.ArtifactsStoreConfig.Bucket)
+	}
+
+	if maxFilesArtifactsBytes := serverArgs.ArtifactsStoreConfig.MaxBytesPerEnclave; maxFilesArtifactsBytes != 0 {
+		logrus.Infof("Files artifacts of this enclave are limited to a total of %v bytes", maxFilesArtifactsBytes)
+	}
+
+	if serverArgs.ImageCacheConfig.IsRegistryBacked() {
+		logrus.Infof("Built and pulled images will be cached in repository '%v'", serverArgs.ImageCacheConfig.Repository)
+	}
+
+	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectoryWithRemoteFileStore(serverArgs.EnclaveDataVolumeDirpath, maybeRemoteFileStore, serverArgs.ArtifactsStoreConfig.MaxBytesPerEnclave)
 
 	clusterConfig := serverArgs.KurtosisBackendConfig
 	if clusterConfig == nil {
@@ -128,30 +168,40 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave db")
 	}
 
+	webDownloadCache, err := enclaveDataDir.GetWebDownloadCache()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the web download cache")
+	}
+
+	starlarkProgramCacheDirpath, err := enclaveDataDir.GetStarlarkProgramCacheDirpath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Starlark program cache directory")
+	}
+
 	filesArtifactStore, err := enclaveDataDir.GetFilesArtifactStore()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the files artifact store")
 	}
 
 	githubAuthProvider := git_package_content_provider.NewGitHubPackageAuthProvider(githubAuthDirPath)
-	gitPackageContentProvider := git_package_content_provider.NewGitPackageContentProvider(repositoriesDirPath, tempDirectoriesDirPath, githubAuthProvider, enclaveDb)
+	gitPackageContentProvider := git_package_content_provider.NewGitPackageContentProvider(repositoriesDirPath, tempDirectoriesDirPath, githubAuthProvider, enclaveDb, serverArgs.AirGapConfig)
 
 	// TODO Extract into own function
 	var kurtosisBackend backend_interface.KurtosisBackend
 	switch serverArgs.KurtosisBackendType {
 	case args.KurtosisBackendType_Docker:
 		apiContainerModeArgs := &backend_creator.APIContainerModeArgs{
-			Context:        ctx,
-			EnclaveID:      enclave.EnclaveUUID(serverArgs.EnclaveUUID),
-			APIContainerIP: ownIpAddress,
-			IsProduction:   serverArgs.IsProductionEnclave,
+			Context:          ctx,
+			EnclaveID:        enclave.EnclaveUUID(serverArgs.EnclaveUUID),
+			APIContainerIP:   ownIpAddress,
+			IsProduction:     serverArgs.IsProductionEnclave,
+			ImageCacheConfig: serverArgs.ImageCacheConfig,
 		}
-		kurtosisBackend, err = backend_creator.GetDockerKurtosisBackend(apiContainerModeArgs, configs.NoRemoteBackendConfig)
+		kurtosisBackend, err = backend_creator.GetDockerKurtosisBackend(apiContainerModeArgs, configs.NoRemoteBackendConfig, serverArgs.AirGapConfig)
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred getting local Docker Kurtosis backend")
 		}
 	case args.KurtosisBackendType_Kubernetes:
-		// TODO Use this value when we have fields for the API container
 		clusterConfigK8s, ok := (clusterConfig).(kurtosis_backend_config.KubernetesBackendConfig)
 		if !ok {
 			return stacktrace.NewError(
@@ -162,7 +212,12 @@ func runMain() error {
 			)
 		}
 		// TODO wrap up APIContainerModeArgs if the parameter list keeps on going up (currently just IsProductionEnclave)
-		kurtosisBackend, err = kubernetes_kurtosis_backend.GetApiContainerBackend(ctx, clusterConfigK8s.StorageClass, serverArgs.IsProductionEnclave)
+		clientConfig := kubernetes_manager.NewClientConfig(clusterConfigK8s.ClientQPS, clusterConfigK8s.ClientBurst, clusterConfigK8s.ClientMaxRetries)
+		objAttrsProvider, err := object_attributes_provider.GetKubernetesObjectAttributesProviderWithMandatoryAttributes(clusterConfigK8s.ObjectLabels, clusterConfigK8s.ObjectAnnotations)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the Kubernetes object attributes provider")
+		}
+		kurtosisBackend, err = kubernetes_kurtosis_backend.GetApiContainerBackend(ctx, clusterConfigK8s.StorageClass, serverArgs.IsProductionEnclave, clientConfig, kubernetes_manager.PodSecurityStandard(clusterConfigK8s.PodSecurityStandard), serverArgs.AirGapConfig, objAttrsProvider)
 		if err != nil {
 			return stacktrace.Propagate(
 				err,
@@ -184,7 +239,15 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred while creating the interpretation time value store")
 	}
 
-	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb)
+	imageSignatureVerifier, err := image_signatures.NewImageSignatureVerifier(serverArgs.ImageVerificationConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the image signature verifier")
+	}
+	if imageSignatureVerifier != nil {
+		logrus.Infof("Only the images signed by one of the %v allowed signers will be started", len(serverArgs.ImageVerificationConfig.AllowedSigners))
+	}
+
+	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb, imageSignatureVerifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the service network")
 	}
@@ -203,6 +266,7 @@ func runMain() error {
 			serverArgs.IsCI,
 			serverArgs.CloudUserID,
 			serverArgs.CloudInstanceID,
+			serverArgs.MetricsSinkConfig,
 		),
 	)
 	if err != nil {
@@ -221,11 +285,11 @@ func runMain() error {
 	}
 
 	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
-	startosisInterpreter := startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars, interpretationTimeValueStore)
+	startosisInterpreter := startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars, interpretationTimeValueStore, starlark_program_cache.NewStarlarkProgramCache(starlarkProgramCacheDirpath))
 	startosisRunner := startosis_engine.NewStartosisRunner(
 		startosisInterpreter,
-		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore),
-		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb))
+		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore, serverArgs.ImageCacheConfig.GetMaxConcurrentPulls(), imageSignatureVerifier),
+		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb, serviceNetwork.GetLogAlertWatcher()))
 
 	starlarkRunRepository, err := starlark_run.GetOrCreateNewStarlarkRunRepository(enclaveDb)
 	if err != nil {
@@ -248,21 +312,27 @@ func runMain() error {
 		githubAuthProvider,
 		starlarkRunRepository,
 		interpretationTimeValueStore,
+		web_files_downloader.NewWebFilesDownloader(webDownloadCache, http.DefaultClient),
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the API container service")
 	}
 
 	apiContainerServiceRegistrationFunc := func(grpcServer *grpc.Server) {
-		kurtosis_core_rpc_api_bindings.RegisterApiContainerServiceServer(grpcServer, apiContainerService)
+		grpcServer.RegisterService(server.NewTracingServiceDesc(kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc), apiContainerService)
+		// So that grpcurl and the like can list and describe the API container methods without the proto files
+		reflection.Register(grpcServer)
 	}
-	apiContainerServer := minimal_grpc_server.NewMinimalGRPCServer(
+	apiContainerServer, err := newApiContainerServer(
 		serverArgs.GrpcListenPortNum,
-		grpcServerStopGracePeriod,
+		serverArgs.TlsConfig,
 		[]func(*grpc.Server){
 			apiContainerServiceRegistrationFunc,
 		},
 	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the API container server")
+	}
 
 	logrus.Info("Running server...")
 	if err := apiContainerServer.RunUntilInterrupted(); err != nil {
@@ -272,12 +342,40 @@ func runMain() error {
 	return nil
 }
 
+// newApiContainerServer only accepts the calls authenticated with a certificate issued by the authority of the enclave
+// if the engine launched the API container with a TLS config
+func newApiContainerServer(
+	listenPort uint16,
+	tlsConfig *args.ApiContainerTlsConfig,
+	serviceRegistrationFuncs []func(*grpc.Server),
+) (*minimal_grpc_server.MinimalGRPCServer, error) {
+	if tlsConfig == nil {
+		return minimal_grpc_server.NewMinimalGRPCServer(listenPort, grpcServerStopGracePeriod, serviceRegistrationFuncs), nil
+	}
+	certificateAuthorityPool, err := api_container_tls.NewCertificatePool(tlsConfig.CertificateAuthority)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the certificate authority of the enclave")
+	}
+	serverCertificate, err := tls.X509KeyPair([]byte(tlsConfig.ServerCertificate), []byte(tlsConfig.ServerKey))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the server certificate of the API container")
+	}
+	logrus.Info("The API container only accepts the calls authenticated with a certificate issued for the enclave")
+	if tlsConfig.FipsMode {
+		// The server side is only restricted in a build with validated crypto, see fips_mode.IsValidatedCryptoInUse
+		fips_mode.Enable()
+		logrus.Infof("The TLS connections of the API container are restricted to the FIPS-approved parameters, validated crypto in use: %v", fips_mode.IsValidatedCryptoInUse())
+	}
+	return minimal_grpc_server.NewMinimalHttpsGRPCServer(listenPort, grpcServerStopGracePeriod, certificateAuthorityPool, &serverCertificate, serviceRegistrationFuncs), nil
+}
+
 func createServiceNetwork(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	args *args.APIContainerArgs,
 	ownIpAddress net.IP,
 	enclaveDb *enclave_db.EnclaveDB,
+	imageSignatureVerifier *image_signatures.ImageSignatureVerifier,
 ) (service_network.ServiceNetwork, error) {
 	enclaveIdStr := args.EnclaveUUID
 	enclaveUuid := enclave.EnclaveUUID(enclaveIdStr)
@@ -286,14 +384,32 @@ func createServiceNetwork(
 		ownIpAddress,
 		args.GrpcListenPortNum,
 		args.Version,
+		args.TlsConfig,
 	)
 
+	secretsProvider, err := secrets.GetSecretsProvider(args.SecretsProviderConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the '%v' secrets provider", args.SecretsProviderConfig.Type)
+	}
+
+	filesArtifactScanner, err := files_artifact_scanning.NewFilesArtifactScanner(args.ContentScanningConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the files artifact scanner")
+	}
+	if filesArtifactScanner != nil {
+		logrus.Info("The files artifacts uploaded to the enclave are scanned before being stored")
+	}
+
 	serviceNetwork, err := service_network.NewDefaultServiceNetwork(
 		enclaveUuid,
 		apiContainerInfo,
 		kurtosisBackend,
 		enclaveDataDir,
 		enclaveDb,
+		args.EnclaveQuota,
+		secretsProvider,
+		filesArtifactScanner,
+		imageSignatureVerifier,
 	)
 
 	if err != nil {
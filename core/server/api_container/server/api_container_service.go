@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -36,21 +37,30 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_args_schema"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_events"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/files_artifact_scanning"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/web_files_downloader"
 	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -78,9 +88,19 @@ const (
 	isNotScript              = false
 	isNotRemote              = false
 	defaultParallelism       = 4
+
+	// The args schema declared in kurtosis.yml describes the arguments of the default 'run' function in the main file
+	defaultMainFunctionName = "run"
 )
 
 // Guaranteed (by a unit test) to be a 1:1 mapping between API port protos and port spec protos
+var serviceEventTypeToApiServiceEventType = map[service_events.EventType]kurtosis_core_rpc_api_bindings.ServiceEventType{
+	service_events.EventType_Started: kurtosis_core_rpc_api_bindings.ServiceEventType_SERVICE_STARTED,
+	service_events.EventType_Ready:   kurtosis_core_rpc_api_bindings.ServiceEventType_SERVICE_READY,
+	service_events.EventType_Stopped: kurtosis_core_rpc_api_bindings.ServiceEventType_SERVICE_STOPPED,
+	service_events.EventType_Crashed: kurtosis_core_rpc_api_bindings.ServiceEventType_SERVICE_CRASHED,
+}
+
 var apiContainerPortProtoToPortSpecPortProto = map[kurtosis_core_rpc_api_bindings.Port_TransportProtocol]port_spec.TransportProtocol{
 	kurtosis_core_rpc_api_bindings.Port_TCP:  port_spec.TransportProtocol_TCP,
 	kurtosis_core_rpc_api_bindings.Port_SCTP: port_spec.TransportProtocol_SCTP,
@@ -104,6 +124,8 @@ type ApiContainerService struct {
 
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider
 
+	webFilesDownloader *web_files_downloader.WebFilesDownloader
+
 	// NOTE: interpretationTimeValueStore is modified by the StarlarkInterpreter - allowing APIContainer to have access to it
 	// allows retrieving service configs of running services but it is NOT mutex protected, thus this object should never modify
 	// the interpretationTimeValueStore or it could mess with interpretation
@@ -123,6 +145,7 @@ func NewApiContainerService(
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider,
 	starlarkRunRepository *starlark_run.StarlarkRunRepository,
 	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore,
+	webFilesDownloader *web_files_downloader.WebFilesDownloader,
 ) (*ApiContainerService, error) {
 
 	if err := initStarlarkRun(starlarkRunRepository, restartPolicy); err != nil {
@@ -139,6 +162,7 @@ func NewApiContainerService(
 		metricsClient:                metricsClient,
 		githubAuthProvider:           githubAuthProvider,
 		interpretationTimeValueStore: interpretationTimeValueStore,
+		webFilesDownloader:           webFilesDownloader,
 	}
 
 	return service, nil
@@ -279,7 +303,7 @@ func (apicService *ApiContainerService) InspectFilesArtifactContents(_ context.C
 		return nil, stacktrace.NewError("An error occurred because files artifact identifier is empty '%v'", artifactIdentifier)
 	}
 
-	_, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	filesArtifactUuid, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
 	}
@@ -292,8 +316,42 @@ func (apicService *ApiContainerService) InspectFilesArtifactContents(_ context.C
 		return nil, stacktrace.Propagate(err, "An error occurred getting file descriptions from '%v'", artifactIdentifier)
 	}
 
+	// Empty for artifacts stored before checksums were recorded
+	contentSha256, _ := apicService.filesArtifactStore.GetFileContentSha256(filesArtifactUuid)
+
 	return &kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse{
 		FileDescriptions: fileDescriptions,
+		ContentSha256:    contentSha256,
+	}, nil
+}
+
+func (apicService *ApiContainerService) GetFilesArtifactHistory(_ context.Context, args *kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs) (*kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse, error) {
+	artifactIdentifier := args.GetIdentifier()
+	if artifactIdentifier == emptyFileArtifactIdentifier {
+		return nil, stacktrace.NewError("An error occurred because files artifact identifier is empty '%v'", artifactIdentifier)
+	}
+
+	artifactName, versions, err := apicService.filesArtifactStore.GetFileHistory(artifactIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the history of files artifact '%v'", artifactIdentifier)
+	}
+
+	var versionsGrpcType []*kurtosis_core_rpc_api_bindings.FilesArtifactVersion
+	for _, version := range versions {
+		var createdAt *timestamppb.Timestamp
+		if !version.GetCreatedAt().IsZero() {
+			createdAt = timestamppb.New(version.GetCreatedAt())
+		}
+		versionsGrpcType = append(versionsGrpcType, &kurtosis_core_rpc_api_bindings.FilesArtifactVersion{
+			Version:   version.GetVersion(),
+			FileUuid:  string(version.GetUuid()),
+			CreatedAt: createdAt,
+			IsLatest:  version.IsLatest(),
+		})
+	}
+	return &kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse{
+		FileName: artifactName,
+		Versions: versionsGrpcType,
 	}, nil
 }
 
@@ -304,6 +362,7 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 	var isRemote bool
 	var detectedPackageId string
 	var detectedPackageReplaceOptions map[string]string
+	var detectedPackageArgsSchema package_args_schema.PackageArgsSchema
 	packageIdFromArgs := args.GetPackageId()
 	parallelism := args.GetParallelism()
 	if parallelism == 0 {
@@ -329,7 +388,7 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 
 	var actualRelativePathToMainFile string
 	if args.ClonePackage != nil {
-		scriptWithRunFunction, actualRelativePathToMainFile, detectedPackageId, detectedPackageReplaceOptions, interpretationError =
+		scriptWithRunFunction, actualRelativePathToMainFile, detectedPackageId, detectedPackageReplaceOptions, detectedPackageArgsSchema, interpretationError =
 			apicService.runStarlarkPackageSetup(packageIdFromArgs, args.GetClonePackage(), nil, requestedRelativePathToMainFile)
 		isRemote = args.GetClonePackage()
 	} else {
@@ -338,9 +397,12 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 		//  right now the TS SDK still uses the old deprecated behavior
 		moduleContentIfLocal := args.GetLocal()
 		isRemote = args.GetRemote()
-		scriptWithRunFunction, actualRelativePathToMainFile, detectedPackageId, detectedPackageReplaceOptions, interpretationError =
+		scriptWithRunFunction, actualRelativePathToMainFile, detectedPackageId, detectedPackageReplaceOptions, detectedPackageArgsSchema, interpretationError =
 			apicService.runStarlarkPackageSetup(packageIdFromArgs, args.GetRemote(), moduleContentIfLocal, requestedRelativePathToMainFile)
 	}
+	if interpretationError == nil {
+		serializedParams, interpretationError = validatePackageArgs(detectedPackageArgsSchema, requestedRelativePathToMainFile, mainFuncName, serializedParams)
+	}
 	if interpretationError != nil {
 		if err := stream.SendMsg(binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError.ToAPIType())); err != nil {
 			return stacktrace.Propagate(err, "Error preparing for package execution and this error could not be sent through the output stream: '%s'", packageIdFromArgs)
@@ -456,21 +518,26 @@ func (apicService *ApiContainerService) GetServices(ctx context.Context, args *k
 			}
 			serviceInfos[serviceIdentifier] = serviceInfo
 		}
-		resp := binding_constructors.NewGetServicesResponse(serviceInfos)
-		return resp, nil
-	}
+	} else {
+		allServices, err := apicService.serviceNetwork.GetServices(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "an error occurred while fetching all services from the backend")
+		}
 
-	allServices, err := apicService.serviceNetwork.GetServices(ctx)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "an error occurred while fetching all services from the backend")
+		serviceInfos, err = apicService.getServiceInfosFromServiceObjs(allServices)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "an error occurred while converting the service obj into service info")
+		}
 	}
 
-	serviceInfos, err = apicService.getServiceInfosFromServiceObjs(allServices)
+	pageServiceInfos, nextPageToken, err := getPageOfServiceInfos(serviceInfos, args)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "an error occurred while converting the service obj into service info")
+		return nil, stacktrace.Propagate(err, "An error occurred getting the requested page of services")
+	}
+	resp := binding_constructors.NewGetServicesResponse(pageServiceInfos)
+	if nextPageToken != "" {
+		resp.NextPageToken = &nextPageToken
 	}
-
-	resp := binding_constructors.NewGetServicesResponse(serviceInfos)
 	return resp, nil
 }
 
@@ -501,6 +568,67 @@ func (apicService *ApiContainerService) GetExistingAndHistoricalServiceIdentifie
 	return existingAndHistoricalServiceIdentifiersResponse, nil
 }
 
+func (apicService *ApiContainerService) GetServiceDependencyGraph(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse, error) {
+	allServiceDependencies, err := apicService.serviceNetwork.GetServiceDependencies()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service dependencies")
+	}
+
+	serviceNames := []string{}
+	for serviceName := range allServiceDependencies {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	sort.Strings(serviceNames)
+
+	nodes := []*kurtosis_core_rpc_api_bindings.ServiceDependencyNode{}
+	edges := []*kurtosis_core_rpc_api_bindings.ServiceDependencyEdge{}
+	for _, serviceNameStr := range serviceNames {
+		serviceDependencies := allServiceDependencies[service.ServiceName(serviceNameStr)]
+		nodes = append(nodes, &kurtosis_core_rpc_api_bindings.ServiceDependencyNode{
+			ServiceName:        serviceNameStr,
+			HasReadyConditions: serviceDependencies.HasReadyConditions(),
+		})
+		edgesByKind := map[kurtosis_core_rpc_api_bindings.ServiceDependencyKind][]service.ServiceName{
+			kurtosis_core_rpc_api_bindings.ServiceDependencyKind_EXPLICIT:      serviceDependencies.GetExplicitDependencies(),
+			kurtosis_core_rpc_api_bindings.ServiceDependencyKind_RUNTIME_VALUE: serviceDependencies.GetRuntimeValueDependencies(),
+		}
+		for _, kind := range []kurtosis_core_rpc_api_bindings.ServiceDependencyKind{
+			kurtosis_core_rpc_api_bindings.ServiceDependencyKind_EXPLICIT,
+			kurtosis_core_rpc_api_bindings.ServiceDependencyKind_RUNTIME_VALUE,
+		} {
+			for _, dependencyName := range edgesByKind[kind] {
+				// the dependency may have been removed from the enclave since, in which case there's no node to point to
+				if _, found := allServiceDependencies[dependencyName]; !found {
+					continue
+				}
+				edges = append(edges, &kurtosis_core_rpc_api_bindings.ServiceDependencyEdge{
+					ServiceName:           serviceNameStr,
+					DependencyServiceName: string(dependencyName),
+					Kind:                  kind,
+				})
+			}
+		}
+	}
+
+	return &kurtosis_core_rpc_api_bindings.GetServiceDependencyGraphResponse{
+		Nodes: nodes,
+		Edges: edges,
+	}, nil
+}
+
+func (apicService *ApiContainerService) SetEnclaveEnvVars(_ context.Context, args *kurtosis_core_rpc_api_bindings.SetEnclaveEnvVarsArgs) (*emptypb.Empty, error) {
+	envVars := args.GetEnvVars()
+	for envVarName := range envVars {
+		if strings.TrimSpace(envVarName) == "" {
+			return nil, stacktrace.NewError("Enclave env var names cannot be empty")
+		}
+	}
+	if err := apicService.serviceNetwork.SetEnclaveEnvVars(envVars); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred setting the enclave env vars")
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (apicService *ApiContainerService) UploadFilesArtifact(server kurtosis_core_rpc_api_bindings.ApiContainerService_UploadFilesArtifactServer) error {
 	var maybeArtifactName string
 	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse](server)
@@ -532,7 +660,7 @@ func (apicService *ApiContainerService) UploadFilesArtifact(server kurtosis_core
 	)
 
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred receiving the file payload")
+		return toPermissionDeniedIfFilesArtifactRejected(toResourceExhaustedIfFilesArtifactsQuotaExceeded(stacktrace.Propagate(err, "An error occurred receiving the file payload")))
 	}
 	return nil
 }
@@ -543,7 +671,7 @@ func (apicService *ApiContainerService) DownloadFilesArtifact(args *kurtosis_cor
 		return stacktrace.NewError("Cannot download file with empty files artifact identifier")
 	}
 
-	_, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	filesArtifactUuid, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
 	}
@@ -571,10 +699,20 @@ func (apicService *ApiContainerService) DownloadFilesArtifact(args *kurtosis_cor
 			filesArtifact.GetAbsoluteFilepath())
 	}
 
+	// The content is verified against its recorded checksum as it's streamed, so that a corrupted artifact fails the
+	// download instead of being silently handed over. Artifacts stored before checksums were recorded aren't verified.
+	var fileContent io.Reader = file
+	if contentSha256, found := apicService.filesArtifactStore.GetFileContentSha256(filesArtifactUuid); found {
+		if err = grpc_file_streaming.SetContentSha256Header(server, contentSha256); err != nil {
+			return stacktrace.Propagate(err, "An error occurred advertising the checksum of files artifact '%v'", artifactIdentifier)
+		}
+		fileContent = grpc_file_streaming.NewContentChecksumVerifyingReader(file, contentSha256)
+	}
+
 	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](server)
 	err = serverStream.SendData(
 		args.Identifier,
-		file,
+		fileContent,
 		fileSize,
 		func(previousChunkHash string, contentChunk []byte) (*kurtosis_core_rpc_api_bindings.StreamedDataChunk, error) {
 			return &kurtosis_core_rpc_api_bindings.StreamedDataChunk{
@@ -592,22 +730,37 @@ func (apicService *ApiContainerService) DownloadFilesArtifact(args *kurtosis_cor
 	return nil
 }
 
-func (apicService *ApiContainerService) StoreWebFilesArtifact(_ context.Context, args *kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs) (*kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse, error) {
+func (apicService *ApiContainerService) StoreWebFilesArtifact(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs) (*kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse, error) {
 	url := args.Url
 	artifactName := args.Name
 
-	resp, err := http.Get(args.Url)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the request to URL '%v' to get the files artifact bytes", url)
+	}
+	for _, header := range args.GetHeaders() {
+		request.Header.Add(header.GetName(), header.GetValue())
+	}
+	if args.BasicAuthUsername != nil || args.BasicAuthPassword != nil {
+		request.SetBasicAuth(args.GetBasicAuthUsername(), args.GetBasicAuthPassword())
+	}
+
+	downloadedFile, err := apicService.webFilesDownloader.Download(request, args.GetContentSha256())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading the files artifact bytes from URL '%v'", url)
+	}
+	file, err := os.Open(downloadedFile.GetAbsoluteFilepath())
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred making the request to URL '%v' to get the files artifact bytes", url)
+		return nil, stacktrace.Propagate(err, "An error occurred opening the file downloaded from URL '%v'", url)
 	}
-	defer resp.Body.Close()
-	body := bufio.NewReader(resp.Body)
+	defer file.Close()
 
 	// TODO: we should probably wrap the web file into a file artifact here, not sure how files look in the APIC since
 	//  it might not even be a TGZ.
-	filesArtifactUuId, err := apicService.filesArtifactStore.StoreFile(body, []byte{}, artifactName)
+	// The file is uploaded through the service network, as anything entering the enclave, so that it's scanned
+	filesArtifactUuId, err := apicService.serviceNetwork.UploadFilesArtifact(bufio.NewReader(file), []byte{}, artifactName)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred storing the file from URL '%v' in the files artifact store", url)
+		return nil, toPermissionDeniedIfFilesArtifactRejected(toResourceExhaustedIfFilesArtifactsQuotaExceeded(stacktrace.Propagate(err, "An error occurred storing the file from URL '%v' in the files artifact store", url)))
 	}
 
 	response := &kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse{Uuid: string(filesArtifactUuId)}
@@ -621,7 +774,7 @@ func (apicService *ApiContainerService) StoreFilesArtifactFromService(ctx contex
 
 	filesArtifactId, err := apicService.serviceNetwork.CopyFilesFromService(ctx, serviceIdentifier, srcPath, name)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred copying source '%v' from service with identifier '%v'", srcPath, serviceIdentifier)
+		return nil, toResourceExhaustedIfFilesArtifactsQuotaExceeded(stacktrace.Propagate(err, "An error occurred copying source '%v' from service with identifier '%v'", srcPath, serviceIdentifier))
 	}
 
 	response := &kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse{Uuid: string(filesArtifactId)}
@@ -680,12 +833,17 @@ func (apicService *ApiContainerService) GetStarlarkPackagePlanYaml(ctx context.C
 	var interpretationError *startosis_errors.InterpretationError
 	var detectedPackageId string
 	var detectedPackageReplaceOptions map[string]string
+	var detectedPackageArgsSchema package_args_schema.PackageArgsSchema
 	var actualRelativePathToMainFile string
-	scriptWithRunFunction, actualRelativePathToMainFile, detectedPackageId, detectedPackageReplaceOptions, interpretationError =
+	scriptWithRunFunction, actualRelativePathToMainFile, detectedPackageId, detectedPackageReplaceOptions, detectedPackageArgsSchema, interpretationError =
 		apicService.runStarlarkPackageSetup(packageIdFromArgs, args.IsRemote, nil, requestedRelativePathToMainFile)
 	if interpretationError != nil {
 		return nil, stacktrace.Propagate(interpretationError, "An interpretation error occurred setting up the package for retrieving plan yaml for package: %v", packageIdFromArgs)
 	}
+	serializedParams, interpretationError = validatePackageArgs(detectedPackageArgsSchema, requestedRelativePathToMainFile, mainFuncName, serializedParams)
+	if interpretationError != nil {
+		return nil, stacktrace.Propagate(interpretationError, "The args provided for retrieving plan yaml for package '%v' are invalid", packageIdFromArgs)
+	}
 
 	_, instructionsPlan, apiInterpretationError := apicService.startosisInterpreter.Interpret(
 		ctx,
@@ -744,6 +902,47 @@ func (apicService *ApiContainerService) GetStarlarkScriptPlanYaml(ctx context.Co
 	return &kurtosis_core_rpc_api_bindings.PlanYaml{PlanYaml: planYamlStr}, nil
 }
 
+func (apicService *ApiContainerService) WatchServiceEvents(args *kurtosis_core_rpc_api_bindings.WatchServiceEventsArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_WatchServiceEventsServer) error {
+	serviceEvents, unsubscribe := apicService.serviceNetwork.SubscribeToServiceEvents()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case serviceEvent, isOpen := <-serviceEvents:
+			if !isOpen {
+				return stacktrace.NewError("The service network stopped sending the service events")
+			}
+			apiServiceEvent, err := convertServiceEventToApiServiceEvent(serviceEvent)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred converting the '%v' event of service '%v'", serviceEvent.GetEventType(), serviceEvent.GetServiceName())
+			}
+			if !doesServiceEventMatchFilters(apiServiceEvent, args.GetServiceNames(), args.GetEventTypes()) {
+				continue
+			}
+			if err := stream.Send(apiServiceEvent); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending the '%v' event of service '%v'", serviceEvent.GetEventType(), serviceEvent.GetServiceName())
+			}
+		}
+	}
+}
+
+func (apicService *ApiContainerService) StartServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	result := apicService.serviceNetwork.StartServicesAtomically(ctx, args.GetServiceIdentifiers())
+	return convertBulkServiceOperationResultToApiResponse(result), nil
+}
+
+func (apicService *ApiContainerService) StopServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	result := apicService.serviceNetwork.StopServicesAtomically(ctx, args.GetServiceIdentifiers())
+	return convertBulkServiceOperationResultToApiResponse(result), nil
+}
+
+func (apicService *ApiContainerService) RemoveServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.BulkServiceOperationArgs) (*kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse, error) {
+	result := apicService.serviceNetwork.RemoveServicesAtomically(ctx, args.GetServiceIdentifiers())
+	return convertBulkServiceOperationResultToApiResponse(result), nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -924,9 +1123,40 @@ func (apicService *ApiContainerService) getServiceInfoForIdentifier(ctx context.
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "A error occurred while converting service obj for service with id '%v' to service info", serviceIdentifier)
 	}
+	serviceInfo.Health = apicService.getServiceHealth(serviceObj.GetRegistration().GetName())
+	serviceInfo.LastCrash = apicService.getServiceLastCrash(serviceObj.GetRegistration().GetName())
 	return serviceInfo, nil
 }
 
+// getServiceHealth returns the health of the service as per its ready conditions, or nil if it has none
+func (apicService *ApiContainerService) getServiceHealth(serviceName service.ServiceName) *kurtosis_core_rpc_api_bindings.ServiceHealth {
+	serviceHealth, found := apicService.serviceNetwork.GetServiceHealth(serviceName)
+	if !found {
+		return nil
+	}
+	return binding_constructors.NewServiceHealth(
+		serviceHealth.IsHealthy(),
+		serviceHealth.GetConsecutiveFailures(),
+		serviceHealth.GetLastError(),
+		serviceHealth.GetRestartCount(),
+	)
+}
+
+// getServiceLastCrash returns how the service last crashed and where its diagnostics were stored, or nil if it didn't
+func (apicService *ApiContainerService) getServiceLastCrash(serviceName service.ServiceName) *kurtosis_core_rpc_api_bindings.ServiceCrashReport {
+	crashReport, found := apicService.serviceNetwork.GetLastCrashReport(serviceName)
+	if !found {
+		return nil
+	}
+	return binding_constructors.NewServiceCrashReport(
+		crashReport.GetExitCode(),
+		crashReport.IsOomKilled(),
+		crashReport.GetFinishedAt(),
+		crashReport.GetReason(),
+		crashReport.GetDiagnosticsFilesArtifactName(),
+	)
+}
+
 func (apicService *ApiContainerService) runStarlarkPackageSetup(
 	packageIdFromArgs string,
 	clonePackage bool,
@@ -937,6 +1167,7 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 	string, // Detected relative path (from package root) to main script
 	string, // Detected Package ID detected from [clonePackage] or [moduleContentIfLocal]
 	map[string]string, // Replace options detected from [clonePackage] or [moduleContentIfLocal]
+	package_args_schema.PackageArgsSchema, // Args schema declared in kurtosis.yml, empty for Compose packages
 	*startosis_errors.InterpretationError) {
 	var packageRootPathOnDisk string
 	var interpretationError *startosis_errors.InterpretationError
@@ -953,7 +1184,7 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 		packageRootPathOnDisk, interpretationError = apicService.packageContentProvider.GetOnDiskAbsolutePackagePath(packageIdFromArgs)
 	}
 	if interpretationError != nil {
-		return "", "", "", nil, interpretationError
+		return "", "", "", nil, nil, interpretationError
 	}
 
 	// If kurtosis.yml exists in root, treat as kurtosis package
@@ -961,20 +1192,20 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 	if _, err := os.Stat(candidateKurtosisYmlAbsFilepath); err == nil {
 		kurtosisYml, interpretationError := apicService.packageContentProvider.GetKurtosisYaml(packageRootPathOnDisk)
 		if interpretationError != nil {
-			return "", "", "", nil, interpretationError
+			return "", "", "", nil, nil, interpretationError
 		}
 		if relativePathToMainFile == "" {
 			relativePathToMainFile = startosis_constants.MainFileName
 		}
 		pathToMainFile := path.Join(packageRootPathOnDisk, relativePathToMainFile)
 		if _, err := os.Stat(pathToMainFile); err != nil {
-			return "", "", "", nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while verifying that '%v' exists in the package '%v' at '%v'", startosis_constants.MainFileName, packageIdFromArgs, pathToMainFile)
+			return "", "", "", nil, nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while verifying that '%v' exists in the package '%v' at '%v'", startosis_constants.MainFileName, packageIdFromArgs, pathToMainFile)
 		}
 		mainScriptToExecuteBytes, err := os.ReadFile(pathToMainFile)
 		if err != nil {
-			return "", "", "", nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while reading '%v' in the package '%v' at '%v'", startosis_constants.MainFileName, packageIdFromArgs, pathToMainFile)
+			return "", "", "", nil, nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while reading '%v' in the package '%v' at '%v'", startosis_constants.MainFileName, packageIdFromArgs, pathToMainFile)
 		}
-		return string(mainScriptToExecuteBytes), relativePathToMainFile, kurtosisYml.PackageName, kurtosisYml.PackageReplaceOptions, nil
+		return string(mainScriptToExecuteBytes), relativePathToMainFile, kurtosisYml.PackageName, kurtosisYml.PackageReplaceOptions, kurtosisYml.GetPackageArgs(), nil
 	}
 
 	// If kurtosis.yml doesn't exist, assume a Compose package and transpile compose into starlark
@@ -987,7 +1218,7 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 			}
 		}
 		if relativePathToMainFile == "" {
-			return "", "", "", nil, startosis_errors.NewInterpretationError(
+			return "", "", "", nil, nil, startosis_errors.NewInterpretationError(
 				"No '%s' file was found in the package root so fell back to Docker Compose package, but no "+
 					"default Compose files (%s) were found. Either add a '%s' file to the package root or add one of the "+
 					"default Compose files.",
@@ -999,11 +1230,33 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 	}
 	mainScriptToExecute, transpilationErr := docker_compose_transpiler.TranspileDockerComposePackageToStarlark(packageRootPathOnDisk, relativePathToMainFile)
 	if transpilationErr != nil {
-		return "", "", "", nil, startosis_errors.WrapWithInterpretationError(transpilationErr, "An error occurred transpiling the Docker Compose package '%v' to Starlark", packageIdFromArgs)
+		return "", "", "", nil, nil, startosis_errors.WrapWithInterpretationError(transpilationErr, "An error occurred transpiling the Docker Compose package '%v' to Starlark", packageIdFromArgs)
 	}
 
 	replacesForComposePackage := map[string]string{}
-	return mainScriptToExecute, relativePathToMainFile, packageIdFromArgs, replacesForComposePackage, nil
+	argsSchemaForComposePackage := package_args_schema.PackageArgsSchema{}
+	return mainScriptToExecute, relativePathToMainFile, packageIdFromArgs, replacesForComposePackage, argsSchemaForComposePackage, nil
+}
+
+// validatePackageArgs checks the args against the schema declared in the package's kurtosis.yml and fills in the
+// defaults of the missing ones. The schema only describes the default entrypoint, so args sent to any other file or
+// function are passed through untouched
+func validatePackageArgs(
+	packageArgsSchema package_args_schema.PackageArgsSchema,
+	requestedRelativePathToMainFile string,
+	mainFunctionName string,
+	serializedParams string,
+) (string, *startosis_errors.InterpretationError) {
+	isDefaultMainFile := requestedRelativePathToMainFile == "" || requestedRelativePathToMainFile == startosis_constants.MainFileName
+	isDefaultMainFunction := mainFunctionName == "" || mainFunctionName == defaultMainFunctionName
+	if !isDefaultMainFile || !isDefaultMainFunction {
+		return serializedParams, nil
+	}
+	serializedParamsWithDefaults, err := packageArgsSchema.ValidateAndApplyDefaults(serializedParams)
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "The args provided to the package are invalid")
+	}
+	return serializedParamsWithDefaults, nil
 }
 
 func (apicService *ApiContainerService) runStarlark(
@@ -1084,11 +1337,54 @@ func (apicService *ApiContainerService) getServiceInfosFromServiceObjs(services
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "There was an error converting the service obj for service with uuid '%v' and name '%v' to service info", uuid, serviceObj.GetRegistration().GetName())
 		}
+		serviceInfo.Health = apicService.getServiceHealth(serviceObj.GetRegistration().GetName())
+		serviceInfo.LastCrash = apicService.getServiceLastCrash(serviceObj.GetRegistration().GetName())
 		serviceInfos[serviceInfo.Name] = serviceInfo
 	}
 	return serviceInfos, nil
 }
 
+// getPageOfServiceInfos keeps the services matching the label and status filters of the args, and returns the page
+// of them the args ask for, ordered by name, along with the token of the next page
+func getPageOfServiceInfos(
+	serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+	args *kurtosis_core_rpc_api_bindings.GetServicesArgs,
+) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, string, error) {
+	filteredServiceKeysByName := map[string]string{}
+	for serviceKey, serviceInfo := range serviceInfos {
+		if !doesServiceInfoMatchFilters(serviceInfo, args.GetLabels(), args.GetStatuses()) {
+			continue
+		}
+		filteredServiceKeysByName[serviceInfo.GetName()] = serviceKey
+	}
+	sortedServiceNames := maps.Keys(filteredServiceKeysByName)
+	sort.Strings(sortedServiceNames)
+
+	pageServiceNames, nextPageToken, err := shared_utils.GetPage(sortedServiceNames, args.GetPageSize(), args.GetPageToken())
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting the page of services")
+	}
+	pageServiceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
+	for _, serviceName := range pageServiceNames {
+		serviceKey := filteredServiceKeysByName[serviceName]
+		pageServiceInfos[serviceKey] = serviceInfos[serviceKey]
+	}
+	return pageServiceInfos, nextPageToken, nil
+}
+
+func doesServiceInfoMatchFilters(
+	serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo,
+	labels map[string]string,
+	statuses []kurtosis_core_rpc_api_bindings.ServiceStatus,
+) bool {
+	for labelKey, labelValue := range labels {
+		if serviceLabelValue, found := serviceInfo.GetLabels()[labelKey]; !found || serviceLabelValue != labelValue {
+			return false
+		}
+	}
+	return len(statuses) == 0 || slices.Contains(statuses, serviceInfo.GetServiceStatus())
+}
+
 func getServiceInfoFromServiceObj(serviceObj *service.Service, serviceConfig *service.ServiceConfig) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	privatePorts := serviceObj.GetPrivatePorts()
 	privateIp := serviceObj.GetRegistration().GetPrivateIP()
@@ -1192,6 +1488,24 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service, serviceConfig *se
 	return serviceInfoResponse, nil
 }
 
+// toResourceExhaustedIfFilesArtifactsQuotaExceeded returns errors caused by the files artifacts quota of the enclave
+// with the RESOURCE_EXHAUSTED code, so that clients can tell them apart from other failures
+func toResourceExhaustedIfFilesArtifactsQuotaExceeded(err error) error {
+	if _, isQuotaErr := enclave_data_directory.GetFilesArtifactsQuotaExceededError(err); isQuotaErr {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
+// toPermissionDeniedIfFilesArtifactRejected returns errors caused by the content scanner of the cluster rejecting a
+// files artifact as PermissionDenied, so that the clients can tell them apart from failures of the upload
+func toPermissionDeniedIfFilesArtifactRejected(err error) error {
+	if _, isRejected := files_artifact_scanning.GetFilesArtifactRejectedError(err); isRejected {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
 func getFileDescriptionsFromArtifact(artifactPath string) ([]*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription, error) {
 	file, err := os.Open(artifactPath)
 	if err != nil {
@@ -1254,6 +1568,54 @@ func getTextRepresentation(reader io.Reader, lineCount int) (*string, error) {
 	return &text, nil
 }
 
+func convertServiceEventToApiServiceEvent(serviceEvent *service_events.ServiceEvent) (*kurtosis_core_rpc_api_bindings.ServiceEvent, error) {
+	apiEventType, found := serviceEventTypeToApiServiceEventType[serviceEvent.GetEventType()]
+	if !found {
+		return nil, stacktrace.NewError("Unrecognized service event type '%v'", serviceEvent.GetEventType())
+	}
+	var maybeMessage *string
+	if serviceEvent.GetMessage() != "" {
+		message := serviceEvent.GetMessage()
+		maybeMessage = &message
+	}
+	return &kurtosis_core_rpc_api_bindings.ServiceEvent{
+		ServiceName: string(serviceEvent.GetServiceName()),
+		ServiceUuid: string(serviceEvent.GetServiceUuid()),
+		EventType:   apiEventType,
+		Timestamp:   timestamppb.New(serviceEvent.GetTimestamp()),
+		Message:     maybeMessage,
+	}, nil
+}
+
+// doesServiceEventMatchFilters returns whether the event is of one of the services and of one of the types, an empty
+// filter matching everything
+func doesServiceEventMatchFilters(
+	serviceEvent *kurtosis_core_rpc_api_bindings.ServiceEvent,
+	serviceNames []string,
+	eventTypes []kurtosis_core_rpc_api_bindings.ServiceEventType,
+) bool {
+	if len(serviceNames) > 0 && !slices.Contains(serviceNames, serviceEvent.GetServiceName()) {
+		return false
+	}
+	return len(eventTypes) == 0 || slices.Contains(eventTypes, serviceEvent.GetEventType())
+}
+
+func convertBulkServiceOperationResultToApiResponse(result *service_network.BulkServiceOperationResult) *kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse {
+	failedServices := map[string]string{}
+	for serviceIdentifier, err := range result.GetFailedServices() {
+		failedServices[serviceIdentifier] = err.Error()
+	}
+	failedRollbacks := map[string]string{}
+	for serviceIdentifier, err := range result.GetFailedRollbacks() {
+		failedRollbacks[serviceIdentifier] = err.Error()
+	}
+	return &kurtosis_core_rpc_api_bindings.BulkServiceOperationResponse{
+		IsApplied:       result.IsApplied(),
+		FailedServices:  failedServices,
+		FailedRollbacks: failedRollbacks,
+	}
+}
+
 func convertServiceStatusToServiceInfoStatus(serviceStatus service.ServiceStatus) (kurtosis_core_rpc_api_bindings.ServiceStatus, error) {
 	switch serviceStatus {
 	case service.ServiceStatus_Started:
@@ -8,7 +8,10 @@ package server
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_events"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
 	"strings"
 	"testing"
 )
@@ -50,3 +53,52 @@ line
 	require.NotNil(t, output)
 	require.Equal(t, expectedOutput, *output)
 }
+
+func TestGetPageOfServiceInfos(t *testing.T) {
+	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"uuid-c": {Name: "c", ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING, Labels: map[string]string{"role": "node"}},      //nolint:exhaustruct
+		"uuid-a": {Name: "a", ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING, Labels: map[string]string{"role": "node"}},      //nolint:exhaustruct
+		"uuid-b": {Name: "b", ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_STOPPED, Labels: map[string]string{"role": "node"}},      //nolint:exhaustruct
+		"uuid-d": {Name: "d", ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING, Labels: map[string]string{"role": "validator"}}, //nolint:exhaustruct
+		"uuid-e": {Name: "e", ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING, Labels: map[string]string{"role": "node"}},      //nolint:exhaustruct
+	}
+	pageSize := uint32(2)
+	args := &kurtosis_core_rpc_api_bindings.GetServicesArgs{ //nolint:exhaustruct
+		Labels:   map[string]string{"role": "node"},
+		Statuses: []kurtosis_core_rpc_api_bindings.ServiceStatus{kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING},
+		PageSize: &pageSize,
+	}
+
+	page, nextPageToken, err := getPageOfServiceInfos(serviceInfos, args)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"uuid-a", "uuid-c"}, maps.Keys(page))
+	require.NotEmpty(t, nextPageToken)
+
+	args.PageToken = &nextPageToken
+	page, nextPageToken, err = getPageOfServiceInfos(serviceInfos, args)
+	require.NoError(t, err)
+	require.Equal(t, []string{"uuid-e"}, maps.Keys(page))
+	require.Empty(t, nextPageToken)
+}
+
+func TestOneToOneApiAndServiceEventTypeMapping(t *testing.T) {
+	require.Equal(t, len(kurtosis_core_rpc_api_bindings.ServiceEventType_name), len(serviceEventTypeToApiServiceEventType))
+	mappedApiEventTypes := maps.Values(serviceEventTypeToApiServiceEventType)
+	for enumInt, enumName := range kurtosis_core_rpc_api_bindings.ServiceEventType_name {
+		require.Contains(t, mappedApiEventTypes, kurtosis_core_rpc_api_bindings.ServiceEventType(enumInt), "No service event type is mapped to API service event type '%v'", enumName)
+	}
+}
+
+func TestDoesServiceEventMatchFilters(t *testing.T) {
+	serviceEvent, err := convertServiceEventToApiServiceEvent(
+		service_events.NewServiceEvent(service.ServiceName("db"), service.ServiceUUID("cddc2ea3948149d9afa2ef93abb4ec56"), service_events.EventType_Crashed, "exit code 1"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "exit code 1", serviceEvent.GetMessage())
+
+	require.True(t, doesServiceEventMatchFilters(serviceEvent, nil, nil))
+	require.True(t, doesServiceEventMatchFilters(serviceEvent, []string{"api", "db"}, nil))
+	require.False(t, doesServiceEventMatchFilters(serviceEvent, []string{"api"}, nil))
+	require.True(t, doesServiceEventMatchFilters(serviceEvent, nil, []kurtosis_core_rpc_api_bindings.ServiceEventType{kurtosis_core_rpc_api_bindings.ServiceEventType_SERVICE_CRASHED}))
+	require.False(t, doesServiceEventMatchFilters(serviceEvent, []string{"db"}, []kurtosis_core_rpc_api_bindings.ServiceEventType{kurtosis_core_rpc_api_bindings.ServiceEventType_SERVICE_READY}))
+}
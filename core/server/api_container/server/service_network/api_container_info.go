@@ -1,6 +1,10 @@
 package service_network
 
-import "net"
+import (
+	"net"
+
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+)
 
 type ApiContainerInfo struct {
 	ipAddress net.IP
@@ -8,17 +12,22 @@ type ApiContainerInfo struct {
 	grpcPortNum uint16
 
 	version string
+
+	// Nil if the API container doesn't require mutual TLS
+	tlsConfig *args.ApiContainerTlsConfig
 }
 
 func NewApiContainerInfo(
 	ipAddress net.IP,
 	grpcPortNum uint16,
 	version string,
+	tlsConfig *args.ApiContainerTlsConfig,
 ) *ApiContainerInfo {
 	return &ApiContainerInfo{
 		ipAddress:   ipAddress,
 		grpcPortNum: grpcPortNum,
 		version:     version,
+		tlsConfig:   tlsConfig,
 	}
 }
 
@@ -33,3 +42,8 @@ func (apic *ApiContainerInfo) GetGrpcPortNum() uint16 {
 func (apic *ApiContainerInfo) GetVersion() string {
 	return apic.version
 }
+
+// GetTlsConfig returns the certificates of the API container, or nil if it doesn't require mutual TLS
+func (apic *ApiContainerInfo) GetTlsConfig() *args.ApiContainerTlsConfig {
+	return apic.tlsConfig
+}
@@ -0,0 +1,35 @@
+package service_network
+
+// BulkServiceOperationResult reports on an operation applied to a set of services as a whole, so that either all the
+// services went through it, or none of them did, unless undoing it failed for some of them
+type BulkServiceOperationResult struct {
+	// The errors of the services the operation failed for, by the identifier the service was asked with
+	failedServices map[string]error
+
+	// The errors of the services the operation went through for, but couldn't be undone for after it failed for others,
+	// by the identifier the service was asked with
+	failedRollbacks map[string]error
+}
+
+func NewBulkServiceOperationResult(
+	failedServices map[string]error,
+	failedRollbacks map[string]error,
+) *BulkServiceOperationResult {
+	return &BulkServiceOperationResult{
+		failedServices:  failedServices,
+		failedRollbacks: failedRollbacks,
+	}
+}
+
+// IsApplied returns true if all the services went through the operation
+func (result *BulkServiceOperationResult) IsApplied() bool {
+	return len(result.failedServices) == 0
+}
+
+func (result *BulkServiceOperationResult) GetFailedServices() map[string]error {
+	return result.failedServices
+}
+
+func (result *BulkServiceOperationResult) GetFailedRollbacks() map[string]error {
+	return result.failedRollbacks
+}
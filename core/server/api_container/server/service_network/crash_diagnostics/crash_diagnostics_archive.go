@@ -0,0 +1,148 @@
+package crash_diagnostics
+
+import (
+	"archive/tar"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Enough to see what the service was doing before it crashed without storing the logs of its whole life
+	MaxLogLines = 200
+
+	diagnosticsFilesArtifactNameFormat = "%s-crash-%d"
+
+	exitStateFilename    = "exit-state.json"
+	backendStateFilename = "backend-state.json"
+	logsFilename         = "logs.txt"
+	crashDumpDirname     = "crash-dump"
+	crashDumpErrFilename = "crash-dump-error.txt"
+
+	diagnosticsFilesMode = 0o644
+
+	// Log lines can be longer than the default buffer of the scanner, e.g. JSON logs with stack traces
+	maxLogLineBytes = 1024 * 1024
+)
+
+type serializableExitState struct {
+	ExitCode    int32     `json:"exitCode"`
+	IsOomKilled bool      `json:"oomKilled"`
+	FinishedAt  time.Time `json:"finishedAt"`
+	Reason      string    `json:"reason"`
+}
+
+// GetDiagnosticsFilesArtifactName returns the name of the files artifact the diagnostics of the crash are stored in,
+// which is the same for every capture of the same crash
+func GetDiagnosticsFilesArtifactName(serviceName service.ServiceName, exitState *service.ExitState) string {
+	return fmt.Sprintf(diagnosticsFilesArtifactNameFormat, serviceName, exitState.GetFinishedAt().Unix())
+}
+
+// ReadLastLogLines returns the last maxLines lines of the logs
+func ReadLastLogLines(logs io.Reader, maxLines int) ([]string, error) {
+	lastLines := make([]string, 0, maxLines)
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(nil, maxLogLineBytes)
+	for scanner.Scan() {
+		if len(lastLines) == maxLines {
+			lastLines = lastLines[1:]
+		}
+		lastLines = append(lastLines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the logs")
+	}
+	return lastLines, nil
+}
+
+// WriteCrashDiagnostics writes the exit state, the state reported by the backend, the last log lines and, if
+// pushTarredCrashDump isn't nil, the crash dump it pushes, as a TAR to the output. Failing to copy the crash dump
+// doesn't fail the diagnostics, as the dump may not have been written; the error is stored in their place
+func WriteCrashDiagnostics(output io.Writer, exitState *service.ExitState, lastLogLines []string, pushTarredCrashDump func(output io.Writer) error) error {
+	tarWriter := tar.NewWriter(output)
+
+	serializedExitState, err := json.MarshalIndent(serializableExitState{
+		ExitCode:    exitState.GetExitCode(),
+		IsOomKilled: exitState.IsOomKilled(),
+		FinishedAt:  exitState.GetFinishedAt(),
+		Reason:      exitState.GetReason(),
+	}, "", "  ")
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the exit state")
+	}
+	if err := writeTarFile(tarWriter, exitStateFilename, serializedExitState); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the exit state")
+	}
+	if err := writeTarFile(tarWriter, backendStateFilename, exitState.GetSerializedBackendState()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the backend state")
+	}
+	logs := strings.Join(lastLogLines, "\n")
+	if err := writeTarFile(tarWriter, logsFilename, []byte(logs)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the logs")
+	}
+
+	if pushTarredCrashDump != nil {
+		if err := copyCrashDump(tarWriter, pushTarredCrashDump); err != nil {
+			if err := writeTarFile(tarWriter, crashDumpErrFilename, []byte(err.Error())); err != nil {
+				return stacktrace.Propagate(err, "An error occurred writing the error copying the crash dump")
+			}
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the TAR of the crash diagnostics")
+	}
+	return nil
+}
+
+// copyCrashDump copies the entries of the TAR'd crash dump in the crash dump directory of the diagnostics
+func copyCrashDump(tarWriter *tar.Writer, pushTarredCrashDump func(output io.Writer) error) error {
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		pipeWriter.CloseWithError(pushTarredCrashDump(pipeWriter))
+	}()
+	defer pipeReader.Close()
+
+	tarReader := tar.NewReader(pipeReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the TAR'd crash dump")
+		}
+		header.Name = path.Join(crashDumpDirname, header.Name)
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the header of crash dump entry '%v'", header.Name)
+		}
+		if _, err := io.Copy(tarWriter, tarReader); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying crash dump entry '%v'", header.Name)
+		}
+	}
+}
+
+func writeTarFile(tarWriter *tar.Writer, filename string, content []byte) error {
+	//nolint:exhaustruct
+	header := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     filename,
+		Mode:     diagnosticsFilesMode,
+		Size:     int64(len(content)),
+		ModTime:  time.Now(),
+	}
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of '%v'", filename)
+	}
+	if _, err := tarWriter.Write(content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing '%v'", filename)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package crash_diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLastLogLines(t *testing.T) {
+	lastLines, err := ReadLastLogLines(strings.NewReader("one\ntwo\nthree\nfour\n"), 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"three", "four"}, lastLines)
+
+	lastLines, err = ReadLastLogLines(strings.NewReader("one\n"), 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"one"}, lastLines)
+}
+
+func TestGetDiagnosticsFilesArtifactName(t *testing.T) {
+	exitState := service.NewExitState(oomKilledExitCode, true, testFinishedAt, "OOMKilled", nil)
+	require.Equal(t, "api-crash-1709288430", GetDiagnosticsFilesArtifactName(testServiceName, exitState))
+}
+
+func TestWriteCrashDiagnostics(t *testing.T) {
+	exitState := service.NewExitState(oomKilledExitCode, true, testFinishedAt, "OOMKilled", []byte(`{"OOMKilled":true}`))
+	pushTarredCrashDump := func(output io.Writer) error {
+		tarWriter := tar.NewWriter(output)
+		dumpContent := []byte("heap")
+		//nolint:exhaustruct
+		if err := tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "dumps/heap.hprof", Mode: 0o644, Size: int64(len(dumpContent))}); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(dumpContent); err != nil {
+			return err
+		}
+		return tarWriter.Close()
+	}
+
+	output := &bytes.Buffer{}
+	require.NoError(t, WriteCrashDiagnostics(output, exitState, []string{"starting", "out of memory"}, pushTarredCrashDump))
+
+	files := readTarFiles(t, output)
+	require.Contains(t, files[exitStateFilename], `"exitCode": 137`)
+	require.Contains(t, files[exitStateFilename], `"oomKilled": true`)
+	require.Equal(t, `{"OOMKilled":true}`, files[backendStateFilename])
+	require.Equal(t, "starting\nout of memory", files[logsFilename])
+	require.Equal(t, "heap", files["crash-dump/dumps/heap.hprof"])
+}
+
+func TestWriteCrashDiagnostics_CrashDumpCopyFails(t *testing.T) {
+	exitState := service.NewExitState(1, false, testFinishedAt, "Error", nil)
+	pushTarredCrashDump := func(output io.Writer) error {
+		return stacktrace.NewError("No such file or directory")
+	}
+
+	output := &bytes.Buffer{}
+	require.NoError(t, WriteCrashDiagnostics(output, exitState, nil, pushTarredCrashDump))
+
+	files := readTarFiles(t, output)
+	require.Contains(t, files, logsFilename)
+	require.Contains(t, files[crashDumpErrFilename], "No such file or directory")
+}
+
+func readTarFiles(t *testing.T, tarContent io.Reader) map[string]string {
+	files := map[string]string{}
+	tarReader := tar.NewReader(tarContent)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+}
@@ -0,0 +1,220 @@
+package crash_diagnostics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	checkInterval = 10 * time.Second
+
+	// Capturing the diagnostics reads logs and copies files out of the container, which shouldn't take long, but dumps
+	// can be big
+	captureTimeout = 2 * time.Minute
+)
+
+// ExitStatesGetter returns how the given services exited; services that are still running are left out
+type ExitStatesGetter func(ctx context.Context, serviceNames []service.ServiceName) (map[service.ServiceName]*service.ExitState, error)
+
+// DiagnosticsCapturer stores the diagnostics of the given crashed service into a files artifact, returning its name
+type DiagnosticsCapturer func(ctx context.Context, serviceName service.ServiceName, exitState *service.ExitState, crashDumpPath string) (string, error)
+
+// CrashReport is how a service last crashed, along with where its diagnostics were stored
+type CrashReport struct {
+	exitCode    int32
+	isOomKilled bool
+	finishedAt  time.Time
+	reason      string
+
+	// Empty if the diagnostics couldn't be captured
+	diagnosticsFilesArtifactName string
+}
+
+func (report CrashReport) GetExitCode() int32 {
+	return report.exitCode
+}
+
+func (report CrashReport) IsOomKilled() bool {
+	return report.isOomKilled
+}
+
+func (report CrashReport) GetFinishedAt() time.Time {
+	return report.finishedAt
+}
+
+func (report CrashReport) GetReason() string {
+	return report.reason
+}
+
+func (report CrashReport) GetDiagnosticsFilesArtifactName() string {
+	return report.diagnosticsFilesArtifactName
+}
+
+type watchedService struct {
+	// Path of the core or heap dumps inside the container of the service, copied with the diagnostics; may be empty
+	crashDumpPath string
+
+	lastCrash *CrashReport
+
+	// Stopping a service kills its container, which isn't a crash
+	isPaused bool
+}
+
+// CrashDiagnosticsCollector keeps checking whether the services exited with an error or were OOM-killed and, when they
+// did, captures their diagnostics once per crash
+type CrashDiagnosticsCollector struct {
+	mutex sync.Mutex
+
+	services map[service.ServiceName]*watchedService
+
+	exitStatesGetter ExitStatesGetter
+
+	capturer DiagnosticsCapturer
+
+	startCheckLoopOnce sync.Once
+}
+
+func NewCrashDiagnosticsCollector(exitStatesGetter ExitStatesGetter, capturer DiagnosticsCapturer) *CrashDiagnosticsCollector {
+	return &CrashDiagnosticsCollector{
+		mutex:              sync.Mutex{},
+		services:           map[service.ServiceName]*watchedService{},
+		exitStatesGetter:   exitStatesGetter,
+		capturer:           capturer,
+		startCheckLoopOnce: sync.Once{},
+	}
+}
+
+// Register starts watching the given service for crashes. Registering a service again replaces its crash dump path
+// and forgets its last crash.
+func (collector *CrashDiagnosticsCollector) Register(serviceName service.ServiceName, crashDumpPath string) {
+	collector.mutex.Lock()
+	collector.services[serviceName] = &watchedService{
+		crashDumpPath: crashDumpPath,
+		lastCrash:     nil,
+		isPaused:      false,
+	}
+	collector.mutex.Unlock()
+
+	collector.startCheckLoopOnce.Do(func() {
+		go collector.runCheckLoop(context.Background())
+	})
+}
+
+func (collector *CrashDiagnosticsCollector) Unregister(serviceName service.ServiceName) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	delete(collector.services, serviceName)
+}
+
+// Pause suspends the checks of the given service, e.g. because it's being stopped
+func (collector *CrashDiagnosticsCollector) Pause(serviceName service.ServiceName) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	if watchedSvc, found := collector.services[serviceName]; found {
+		watchedSvc.isPaused = true
+	}
+}
+
+// Resume resumes the checks of the given service, e.g. because it was started again
+func (collector *CrashDiagnosticsCollector) Resume(serviceName service.ServiceName) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	if watchedSvc, found := collector.services[serviceName]; found {
+		watchedSvc.isPaused = false
+	}
+}
+
+// GetLastCrashReport returns how the given service last crashed, or false if it didn't crash since it was registered
+func (collector *CrashDiagnosticsCollector) GetLastCrashReport(serviceName service.ServiceName) (CrashReport, bool) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	watchedSvc, found := collector.services[serviceName]
+	if !found || watchedSvc.lastCrash == nil {
+		return CrashReport{exitCode: 0, isOomKilled: false, finishedAt: time.Time{}, reason: "", diagnosticsFilesArtifactName: ""}, false
+	}
+	return *watchedSvc.lastCrash, true
+}
+
+func (collector *CrashDiagnosticsCollector) runCheckLoop(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			collector.checkServices(ctx)
+		}
+	}
+}
+
+// checkServices captures the diagnostics of the services that crashed since they were last checked
+func (collector *CrashDiagnosticsCollector) checkServices(ctx context.Context) {
+	collector.mutex.Lock()
+	servicesToCheck := map[service.ServiceName]*watchedService{}
+	serviceNamesToCheck := []service.ServiceName{}
+	for serviceName, watchedSvc := range collector.services {
+		if !watchedSvc.isPaused {
+			servicesToCheck[serviceName] = watchedSvc
+			serviceNamesToCheck = append(serviceNamesToCheck, serviceName)
+		}
+	}
+	collector.mutex.Unlock()
+	if len(serviceNamesToCheck) == 0 {
+		return
+	}
+
+	exitStates, err := collector.exitStatesGetter(ctx, serviceNamesToCheck)
+	if err != nil {
+		logrus.Debugf("An error occurred getting the exit states of services '%v' to check whether they crashed:\n%v", serviceNamesToCheck, err)
+		return
+	}
+	for serviceName, exitState := range exitStates {
+		checkedSvc, found := servicesToCheck[serviceName]
+		if !found || !exitState.IsCrash() {
+			continue
+		}
+		if !collector.isNewCrash(checkedSvc, exitState) {
+			continue
+		}
+		collector.captureCrash(ctx, serviceName, checkedSvc, exitState)
+	}
+}
+
+// isNewCrash returns false if the crash was already captured, as the container stays exited until it's started again
+// so the same crash is seen on every check
+func (collector *CrashDiagnosticsCollector) isNewCrash(checkedSvc *watchedService, exitState *service.ExitState) bool {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	return checkedSvc.lastCrash == nil || !checkedSvc.lastCrash.finishedAt.Equal(exitState.GetFinishedAt())
+}
+
+func (collector *CrashDiagnosticsCollector) captureCrash(ctx context.Context, serviceName service.ServiceName, checkedSvc *watchedService, exitState *service.ExitState) {
+	logrus.Warnf("Service '%s' crashed with exit code '%d' (OOM-killed: %v); capturing its diagnostics", serviceName, exitState.GetExitCode(), exitState.IsOomKilled())
+	captureCtx, cancelCaptureCtx := context.WithTimeout(ctx, captureTimeout)
+	defer cancelCaptureCtx()
+	// A crash whose diagnostics couldn't be captured is still reported, and isn't retried
+	diagnosticsFilesArtifactName, err := collector.capturer(captureCtx, serviceName, exitState, checkedSvc.crashDumpPath)
+	if err != nil {
+		logrus.Errorf("An error occurred capturing the crash diagnostics of service '%s':\n%v", serviceName, err)
+		diagnosticsFilesArtifactName = ""
+	}
+
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	// The service could have been removed or registered again while its diagnostics were being captured
+	if currentSvc, found := collector.services[serviceName]; !found || currentSvc != checkedSvc {
+		return
+	}
+	checkedSvc.lastCrash = &CrashReport{
+		exitCode:                     exitState.GetExitCode(),
+		isOomKilled:                  exitState.IsOomKilled(),
+		finishedAt:                   exitState.GetFinishedAt(),
+		reason:                       exitState.GetReason(),
+		diagnosticsFilesArtifactName: diagnosticsFilesArtifactName,
+	}
+}
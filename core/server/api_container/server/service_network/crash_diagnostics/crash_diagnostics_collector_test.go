@@ -0,0 +1,138 @@
+package crash_diagnostics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName   = service.ServiceName("api")
+	testCrashDumpPath = "/var/dumps"
+	testArtifactName  = "api-crash-1709288430"
+	oomKilledExitCode = 137
+)
+
+var (
+	testFinishedAt = time.Date(2024, time.March, 1, 10, 20, 30, 0, time.UTC)
+)
+
+type capturedCrash struct {
+	serviceName   service.ServiceName
+	crashDumpPath string
+}
+
+func TestCheckServices_CapturesCrashOnce(t *testing.T) {
+	exitStates := map[service.ServiceName]*service.ExitState{
+		testServiceName: service.NewExitState(oomKilledExitCode, true, testFinishedAt, "OOMKilled", nil),
+	}
+	capturedCrashes := []capturedCrash{}
+	collector := newCollectorForTest(exitStates, &capturedCrashes, nil)
+	collector.Register(testServiceName, testCrashDumpPath)
+
+	collector.checkServices(context.Background())
+	collector.checkServices(context.Background())
+
+	require.Equal(t, []capturedCrash{{serviceName: testServiceName, crashDumpPath: testCrashDumpPath}}, capturedCrashes)
+	crashReport, found := collector.GetLastCrashReport(testServiceName)
+	require.True(t, found)
+	require.Equal(t, int32(oomKilledExitCode), crashReport.GetExitCode())
+	require.True(t, crashReport.IsOomKilled())
+	require.Equal(t, "OOMKilled", crashReport.GetReason())
+	require.Equal(t, testFinishedAt, crashReport.GetFinishedAt())
+	require.Equal(t, testArtifactName, crashReport.GetDiagnosticsFilesArtifactName())
+}
+
+func TestCheckServices_CapturesNewCrashOfSameService(t *testing.T) {
+	exitStates := map[service.ServiceName]*service.ExitState{
+		testServiceName: service.NewExitState(1, false, testFinishedAt, "Error", nil),
+	}
+	capturedCrashes := []capturedCrash{}
+	collector := newCollectorForTest(exitStates, &capturedCrashes, nil)
+	collector.Register(testServiceName, "")
+
+	collector.checkServices(context.Background())
+	exitStates[testServiceName] = service.NewExitState(1, false, testFinishedAt.Add(time.Minute), "Error", nil)
+	collector.checkServices(context.Background())
+
+	require.Len(t, capturedCrashes, 2)
+	crashReport, found := collector.GetLastCrashReport(testServiceName)
+	require.True(t, found)
+	require.Equal(t, testFinishedAt.Add(time.Minute), crashReport.GetFinishedAt())
+}
+
+func TestCheckServices_IgnoresCleanExits(t *testing.T) {
+	exitStates := map[service.ServiceName]*service.ExitState{
+		testServiceName: service.NewExitState(0, false, testFinishedAt, "Completed", nil),
+	}
+	capturedCrashes := []capturedCrash{}
+	collector := newCollectorForTest(exitStates, &capturedCrashes, nil)
+	collector.Register(testServiceName, "")
+
+	collector.checkServices(context.Background())
+
+	require.Empty(t, capturedCrashes)
+	_, found := collector.GetLastCrashReport(testServiceName)
+	require.False(t, found)
+}
+
+func TestCheckServices_SkipsPausedServices(t *testing.T) {
+	exitStates := map[service.ServiceName]*service.ExitState{
+		testServiceName: service.NewExitState(oomKilledExitCode, false, testFinishedAt, "", nil),
+	}
+	capturedCrashes := []capturedCrash{}
+	collector := newCollectorForTest(exitStates, &capturedCrashes, nil)
+	collector.Register(testServiceName, "")
+
+	collector.Pause(testServiceName)
+	collector.checkServices(context.Background())
+	require.Empty(t, capturedCrashes)
+
+	collector.Resume(testServiceName)
+	collector.checkServices(context.Background())
+	require.Len(t, capturedCrashes, 1)
+}
+
+func TestCheckServices_ReportsCrashWhenCaptureFails(t *testing.T) {
+	exitStates := map[service.ServiceName]*service.ExitState{
+		testServiceName: service.NewExitState(1, false, testFinishedAt, "Error", nil),
+	}
+	capturedCrashes := []capturedCrash{}
+	collector := newCollectorForTest(exitStates, &capturedCrashes, stacktrace.NewError("Disk full"))
+	collector.Register(testServiceName, "")
+
+	collector.checkServices(context.Background())
+	collector.checkServices(context.Background())
+
+	require.Len(t, capturedCrashes, 1)
+	crashReport, found := collector.GetLastCrashReport(testServiceName)
+	require.True(t, found)
+	require.Empty(t, crashReport.GetDiagnosticsFilesArtifactName())
+}
+
+func newCollectorForTest(exitStates map[service.ServiceName]*service.ExitState, capturedCrashes *[]capturedCrash, captureErr error) *CrashDiagnosticsCollector {
+	exitStatesGetter := func(ctx context.Context, serviceNames []service.ServiceName) (map[service.ServiceName]*service.ExitState, error) {
+		result := map[service.ServiceName]*service.ExitState{}
+		for _, serviceName := range serviceNames {
+			if exitState, found := exitStates[serviceName]; found {
+				result[serviceName] = exitState
+			}
+		}
+		return result, nil
+	}
+	capturer := func(ctx context.Context, serviceName service.ServiceName, exitState *service.ExitState, crashDumpPath string) (string, error) {
+		*capturedCrashes = append(*capturedCrashes, capturedCrash{serviceName: serviceName, crashDumpPath: crashDumpPath})
+		if captureErr != nil {
+			return "", captureErr
+		}
+		return testArtifactName, nil
+	}
+	collector := NewCrashDiagnosticsCollector(exitStatesGetter, capturer)
+	// The check loop isn't needed as the tests run the checks themselves
+	collector.startCheckLoopOnce.Do(func() {})
+	return collector
+}
@@ -22,16 +22,26 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/crash_diagnostics"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/enclave_env_vars"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/log_alerts"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_dependencies"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_events"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 	"github.com/kurtosis-tech/kurtosis/path-compression"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/files_artifact_scanning"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/image_signatures"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/secrets"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -53,6 +63,9 @@ const (
 
 	shouldFollowLogs = false
 
+	// Log alerts keep matching the lines logged by their service until it stops
+	shouldFollowServiceLogs = true
+
 	publicPortsSuffix = "-public"
 
 	serviceLogsHeader = "== SERVICE '%s' LOGS ==================================="
@@ -83,6 +96,36 @@ type DefaultServiceNetwork struct {
 
 	// This contains all service identifiers ever successfully created
 	serviceIdentifiersRepository *service_identifiers.ServiceIdentifiersRepository
+
+	// This contains the dependencies of the services currently in the enclave
+	serviceDependenciesRepository *service_dependencies.ServiceDependenciesRepository
+
+	// This contains the env vars injected into every service of the enclave
+	enclaveEnvVarsRepository *enclave_env_vars.EnclaveEnvVarsRepository
+
+	// This keeps checking the ready conditions of the services after they started
+	serviceHealthMonitor *service_health.ServiceHealthMonitor
+
+	// This follows the logs of the services that have log alerts
+	logAlertWatcher *log_alerts.LogAlertWatcher
+
+	// This captures the diagnostics of the services that crash
+	crashDiagnosticsCollector *crash_diagnostics.CrashDiagnosticsCollector
+
+	// This sends the lifecycle events of the services to the ones watching them
+	serviceEventBroadcaster *service_events.ServiceEventBroadcaster
+
+	enclaveQuota enclave_quota.EnclaveQuota
+
+	// This resolves the secrets the env vars of the services reference when they start; nil if none is configured
+	secretsProvider secrets.SecretsProvider
+
+	// This scans the files artifacts uploaded to the enclave before they're stored; nil if the cluster doesn't scan them
+	filesArtifactScanner *files_artifact_scanning.FilesArtifactScanner
+
+	// This pins the images to the digest they were verified at when they're started; nil if the cluster doesn't
+	// verify them
+	imageSignatureVerifier *image_signatures.ImageSignatureVerifier
 }
 
 func NewDefaultServiceNetwork(
@@ -91,6 +134,10 @@ func NewDefaultServiceNetwork(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	enclaveDb *enclave_db.EnclaveDB,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	secretsProvider secrets.SecretsProvider,
+	filesArtifactScanner *files_artifact_scanning.FilesArtifactScanner,
+	imageSignatureVerifier *image_signatures.ImageSignatureVerifier,
 ) (*DefaultServiceNetwork, error) {
 	serviceIdentifiersRepository, err := service_identifiers.GetOrCreateNewServiceIdentifiersRepository(enclaveDb)
 	if err != nil {
@@ -100,8 +147,16 @@ func NewDefaultServiceNetwork(
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the service registration repository")
 	}
+	serviceDependenciesRepository, err := service_dependencies.GetOrCreateNewServiceDependenciesRepository(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the service dependencies repository")
+	}
+	enclaveEnvVarsRepository, err := enclave_env_vars.GetOrCreateNewEnclaveEnvVarsRepository(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the enclave env vars repository")
+	}
 
-	return &DefaultServiceNetwork{
+	network := &DefaultServiceNetwork{
 		enclaveUuid:      enclaveUuid,
 		apiContainerInfo: apiContainerInfo,
 		mutex:            &sync.Mutex{},
@@ -111,7 +166,22 @@ func NewDefaultServiceNetwork(
 
 		serviceRegistrationRepository: serviceRegistrationRepository,
 		serviceIdentifiersRepository:  serviceIdentifiersRepository,
-	}, nil
+		serviceDependenciesRepository: serviceDependenciesRepository,
+		enclaveEnvVarsRepository:      enclaveEnvVarsRepository,
+		serviceHealthMonitor:          nil,
+		logAlertWatcher:               nil,
+		crashDiagnosticsCollector:     nil,
+		serviceEventBroadcaster:       service_events.NewServiceEventBroadcaster(),
+
+		enclaveQuota:           enclaveQuota,
+		secretsProvider:        secretsProvider,
+		filesArtifactScanner:   filesArtifactScanner,
+		imageSignatureVerifier: imageSignatureVerifier,
+	}
+	network.serviceHealthMonitor = service_health.NewServiceHealthMonitor(network.restartService)
+	network.logAlertWatcher = log_alerts.NewLogAlertWatcher(network.streamServiceLogs)
+	network.crashDiagnosticsCollector = crash_diagnostics.NewCrashDiagnosticsCollector(network.getServiceExitStates, network.captureCrashDiagnostics)
+	return network, nil
 }
 
 // AddService creates and starts the service in their own container
@@ -179,27 +249,40 @@ func (network *DefaultServiceNetwork) AddServices(
 		currentlyRunningServicesInEnclave[serviceName] = true
 	}
 
-	// We register all the services one by one
-	serviceSuccessfullyRegistered := map[service.ServiceName]*service.ServiceRegistration{}
-	servicesToStart := map[service.ServiceUUID]*service.ServiceConfig{}
-	for serviceName, serviceConfig := range serviceConfigs {
-
-		serviceRegistration, err := network.registerService(ctx, serviceName)
-		if err != nil {
-			failedServices[serviceName] = stacktrace.Propagate(err, "Failed registering service with name: '%s'", serviceName)
-			continue
+	if err := network.checkEnclaveQuotaUnlocked(serviceConfigs); err != nil {
+		for serviceName := range serviceConfigs {
+			failedServices[serviceName] = stacktrace.Propagate(err, "Failed adding service '%s'", serviceName)
 		}
-		serviceSuccessfullyRegistered[serviceName] = serviceRegistration
-		servicesToStart[serviceRegistration.GetUUID()] = serviceConfig
+		return map[service.ServiceName]*service.Service{}, failedServices, nil
+	}
+
+	if err := network.injectEnclaveEnvVarsUnlocked(serviceConfigs); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred injecting the enclave env vars into the configs of services '%v'", serviceConfigs)
+	}
+
+	// We register all the services in a single batch
+	servicesToRegister := map[service.ServiceName]bool{}
+	for serviceName := range serviceConfigs {
+		servicesToRegister[serviceName] = true
+	}
+	serviceSuccessfullyRegistered, serviceFailedRegistration := network.registerServices(ctx, servicesToRegister)
+	for serviceName, serviceRegistrationErr := range serviceFailedRegistration {
+		failedServices[serviceName] = stacktrace.Propagate(serviceRegistrationErr, "Failed registering service with name: '%s'", serviceName)
+	}
+	servicesToStart := map[service.ServiceUUID]*service.ServiceConfig{}
+	for serviceName, serviceRegistration := range serviceSuccessfullyRegistered {
+		servicesToStart[serviceRegistration.GetUUID()] = serviceConfigs[serviceName]
 	}
 	defer func() {
 		if batchSuccessfullyStarted {
 			return
 		}
+		servicesToUnregister := map[service.ServiceName]bool{}
 		for serviceName := range serviceSuccessfullyRegistered {
-			if err := network.unregisterService(ctx, serviceName); err != nil {
-				logrus.Errorf("Error unregistering service '%s' from the service network. Error was: %v", serviceName, err)
-			}
+			servicesToUnregister[serviceName] = true
+		}
+		for serviceName, err := range network.unregisterServices(ctx, servicesToUnregister) {
+			logrus.Errorf("Error unregistering service '%s' from the service network. Error was: %v", serviceName, err)
 		}
 	}()
 	if len(failedServices) > 0 {
@@ -228,11 +311,13 @@ func (network *DefaultServiceNetwork) AddServices(
 		if batchSuccessfullyStarted {
 			return
 		}
-		for serviceName, startedService := range startedServices {
-			if err := network.destroyService(ctx, serviceName, startedService.GetRegistration().GetUUID()); err != nil {
-				logrus.Errorf("One or more services failed to be started for this batch. Kurtosis tries to"+
-					"roll back the entire batch, but failed destroying some services. Error was: %v", err)
-			}
+		servicesToDestroy := map[service.ServiceUUID]bool{}
+		for _, startedService := range startedServices {
+			servicesToDestroy[startedService.GetRegistration().GetUUID()] = true
+		}
+		for serviceUuid, err := range network.destroyServices(ctx, servicesToDestroy) {
+			logrus.Errorf("One or more services failed to be started for this batch. Kurtosis tries to"+
+				"roll back the entire batch, but failed destroying service '%s'. Error was: %v", serviceUuid, err)
 		}
 	}()
 	if len(failedServices) > 0 {
@@ -263,6 +348,9 @@ func (network *DefaultServiceNetwork) AddServices(
 			return nil, nil, stacktrace.Propagate(err, "An error occurred while updating service status to '%s' in service registration for service '%s' after the service was started", serviceStatus, serviceName)
 		}
 	}
+	for serviceName, startedService := range startedServices {
+		network.publishServiceEvent(serviceName, startedService.GetRegistration().GetUUID(), service_events.EventType_Started, "")
+	}
 
 	batchSuccessfullyStarted = true
 	return startedServices, map[service.ServiceName]error{}, nil
@@ -300,6 +388,10 @@ func (network *DefaultServiceNetwork) UpdateServices(ctx context.Context, update
 		return successfullyUpdatedService, failedServicesPool, nil
 	}
 
+	if err := network.injectEnclaveEnvVarsUnlocked(updateServiceConfigs); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred injecting the enclave env vars into the configs of services '%v'", updateServiceConfigs)
+	}
+
 	// First, remove the service
 	serviceUuidToNameMap := map[service.ServiceUUID]service.ServiceName{}
 	serviceUuidsToRemove := map[service.ServiceUUID]bool{}
@@ -312,6 +404,9 @@ func (network *DefaultServiceNetwork) UpdateServices(ctx context.Context, update
 			serviceUuid := serviceRegistration.GetUUID()
 			serviceUuidsToRemove[serviceUuid] = true
 			serviceUuidToNameMap[serviceUuid] = serviceName
+			// The updated service is monitored again once it passes its new ready conditions, if it has any
+			network.serviceHealthMonitor.Unregister(serviceName)
+			network.crashDiagnosticsCollector.Unregister(serviceName)
 		}
 	}
 	successfullyRemovedServices, failedRemovedServices, err := network.kurtosisBackend.RemoveRegisteredUserServiceProcesses(ctx, network.enclaveUuid, serviceUuidsToRemove)
@@ -380,6 +475,7 @@ func (network *DefaultServiceNetwork) UpdateServices(ctx context.Context, update
 			failedServicesPool[serviceName] = stacktrace.Propagate(err, "An error occurred while updating service status to '%s' in service registration for service '%s' after the service was updated", serviceStatus, serviceName)
 			continue
 		}
+		network.publishServiceEvent(serviceName, serviceUuid, service_events.EventType_Started, "")
 		successfullyUpdatedService[serviceName] = newServiceObj
 	}
 	return successfullyUpdatedService, failedServicesPool, nil
@@ -419,8 +515,8 @@ func (network *DefaultServiceNetwork) RemoveService(
 		return "", stacktrace.Propagate(err, "An error occurred stopping service '%v'", serviceUuid)
 	}
 
-	if err := network.serviceRegistrationRepository.Delete(serviceName); err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred deleting the service registration for service '%v' from the repository", serviceName)
+	if err := network.forgetServiceUnlocked(serviceName, serviceUuid); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred forgetting service '%v' after stopping it", serviceName)
 	}
 
 	return serviceUuid, nil
@@ -454,11 +550,7 @@ func (network *DefaultServiceNetwork) StartServices(
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
 
-	successfulUuids := map[service.ServiceUUID]bool{}
-	erroredUuids := map[service.ServiceUUID]error{}
-	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{}
 	serviceRegistrations := map[service.ServiceUUID]*service.ServiceRegistration{}
-
 	for _, serviceIdentifier := range serviceIdentifiers {
 		serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
 		if err != nil {
@@ -467,30 +559,21 @@ func (network *DefaultServiceNetwork) StartServices(
 		serviceRegistrations[serviceRegistration.GetUUID()] = serviceRegistration
 	}
 
-	for serviceUuid, serviceRegistration := range serviceRegistrations {
-		serviceConfigs[serviceUuid] = serviceRegistration.GetConfig()
-	}
-
-	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigs)
-	if err != nil {
-		return nil, nil, err
-	}
+	return network.startServicesUnlocked(ctx, serviceRegistrations)
+}
 
-	for successfulUuid, successfulService := range successfulServices {
-		serviceName := successfulService.GetRegistration().GetName()
-		serviceStatus := service.ServiceStatus_Started
-		if err := network.serviceRegistrationRepository.UpdateStatus(serviceName, serviceStatus); err != nil {
-			failedServices[successfulUuid] = stacktrace.Propagate(err, "An error occurred while updating status to '%v' for service '%v' after it was successfully started", serviceStatus, serviceName)
-			continue
-		}
-		successfulUuids[successfulUuid] = true
-	}
+func (network *DefaultServiceNetwork) StartServicesAtomically(
+	ctx context.Context,
+	serviceIdentifiers []string,
+) *BulkServiceOperationResult {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
 
-	for erroredUuid, err := range failedServices {
-		erroredUuids[erroredUuid] = err
+	serviceRegistrations, serviceIdentifiersByUuid, unknownServices := network.getServiceRegistrationsForIdentifiersUnlocked(serviceIdentifiers)
+	if len(unknownServices) > 0 {
+		return NewBulkServiceOperationResult(unknownServices, map[string]error{})
 	}
-
-	return successfulUuids, erroredUuids, nil
+	return network.applyToServicesAtomicallyUnlocked(ctx, serviceRegistrations, serviceIdentifiersByUuid, network.startServicesUnlocked, network.stopServicesUnlocked, service.ServiceStatus_Started)
 }
 
 func (network *DefaultServiceNetwork) StopService(
@@ -521,44 +604,58 @@ func (network *DefaultServiceNetwork) StopServices(
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
 
-	serviceUuids := map[service.ServiceUUID]bool{}
-	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
-
+	serviceRegistrations := map[service.ServiceUUID]*service.ServiceRegistration{}
 	for _, serviceIdentifier := range serviceIdentifiers {
 		serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
 		if err != nil {
 			return nil, nil, stacktrace.Propagate(err, "An error occurred while getting service registration for identifier '%v'", serviceIdentifier)
 		}
-		serviceUuids[serviceRegistration.GetUUID()] = true
-		serviceNamesByUuid[serviceRegistration.GetUUID()] = serviceRegistration.GetName()
+		serviceRegistrations[serviceRegistration.GetUUID()] = serviceRegistration
 	}
 
-	stopServiceFilters := &service.ServiceFilters{
-		Names:    nil,
-		UUIDs:    serviceUuids,
-		Statuses: nil,
+	return network.stopServicesUnlocked(ctx, serviceRegistrations)
+}
+
+func (network *DefaultServiceNetwork) StopServicesAtomically(
+	ctx context.Context,
+	serviceIdentifiers []string,
+) *BulkServiceOperationResult {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistrations, serviceIdentifiersByUuid, unknownServices := network.getServiceRegistrationsForIdentifiersUnlocked(serviceIdentifiers)
+	if len(unknownServices) > 0 {
+		return NewBulkServiceOperationResult(unknownServices, map[string]error{})
 	}
-	successfulUuids, erroredUuids, err := network.kurtosisBackend.StopUserServices(ctx, network.enclaveUuid, stopServiceFilters)
-	if err != nil {
-		return successfulUuids, erroredUuids, stacktrace.Propagate(err, "An error occurred during the call to stop services")
+	return network.applyToServicesAtomicallyUnlocked(ctx, serviceRegistrations, serviceIdentifiersByUuid, network.stopServicesUnlocked, network.startServicesUnlocked, service.ServiceStatus_Stopped)
+}
+
+func (network *DefaultServiceNetwork) RemoveServicesAtomically(
+	ctx context.Context,
+	serviceIdentifiers []string,
+) *BulkServiceOperationResult {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistrations, serviceIdentifiersByUuid, unknownServices := network.getServiceRegistrationsForIdentifiersUnlocked(serviceIdentifiers)
+	if len(unknownServices) > 0 {
+		return NewBulkServiceOperationResult(unknownServices, map[string]error{})
 	}
 
-	for successfulUuid := range successfulUuids {
-		serviceName, found := serviceNamesByUuid[successfulUuid]
-		if !found {
-			erroredUuids[successfulUuid] = stacktrace.NewError("Expected to find service UUID '%v' in map '%+v' after the service was successfully stopped, but it was not found; this is a bug in Kurtosis", successfulUuid, serviceNamesByUuid)
-			delete(successfulUuids, successfulUuid)
-			continue
-		}
-		serviceStatus := service.ServiceStatus_Stopped
-		if err := network.serviceRegistrationRepository.UpdateStatus(serviceName, serviceStatus); err != nil {
-			erroredUuids[successfulUuid] = stacktrace.Propagate(err, "An error occurred while updating status to '%v' for service '%v' after it was successfully stopped", serviceStatus, serviceName)
-			delete(successfulUuids, successfulUuid)
-			continue
-		}
+	// The services are all stopped first, as that can still be undone if any of them fails to stop; forgetting them
+	// can't be undone, but it only fails if the enclave database does
+	stopResult := network.applyToServicesAtomicallyUnlocked(ctx, serviceRegistrations, serviceIdentifiersByUuid, network.stopServicesUnlocked, network.startServicesUnlocked, service.ServiceStatus_Stopped)
+	if !stopResult.IsApplied() {
+		return stopResult
 	}
 
-	return successfulUuids, erroredUuids, nil
+	failedServices := map[string]error{}
+	for serviceUuid, serviceRegistration := range serviceRegistrations {
+		if err := network.forgetServiceUnlocked(serviceRegistration.GetName(), serviceUuid); err != nil {
+			failedServices[serviceIdentifiersByUuid[serviceUuid]] = err
+		}
+	}
+	return NewBulkServiceOperationResult(failedServices, map[string]error{})
 }
 
 func (network *DefaultServiceNetwork) RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error) {
@@ -761,6 +858,14 @@ func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context,
 	return filesArtifactUuid, nil
 }
 
+func (network *DefaultServiceNetwork) CopyFilesFromImage(ctx context.Context, image string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	filesArtifactUuid, err := network.copyFilesFromImageUnlocked(ctx, image, srcPath, artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "There was an error in copying files from image '%v' over to disk", image)
+	}
+	return filesArtifactUuid, nil
+}
+
 func (network *DefaultServiceNetwork) ExistServiceRegistration(serviceName service.ServiceName) (bool, error) {
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
@@ -787,6 +892,15 @@ func (network *DefaultServiceNetwork) UploadFilesArtifact(data io.Reader, conten
 		return "", stacktrace.Propagate(err, "An error occurred while getting files artifact store")
 	}
 
+	if network.filesArtifactScanner != nil {
+		scannedData, removeScannedData, err := network.filesArtifactScanner.ScanFilesArtifact(artifactName, data)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred scanning files artifact '%v' before storing it", artifactName)
+		}
+		defer removeScannedData()
+		data = scannedData
+	}
+
 	filesArtifactUuid, err := filesArtifactStore.StoreFile(data, contentMd5, artifactName)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while trying to store files.")
@@ -812,6 +926,15 @@ func (network *DefaultServiceNetwork) UpdateFilesArtifact(fileArtifactUuid encla
 		return stacktrace.Propagate(err, "An error occurred while getting files artifact store")
 	}
 
+	if network.filesArtifactScanner != nil {
+		scannedContent, removeScannedContent, err := network.filesArtifactScanner.ScanFilesArtifact(string(fileArtifactUuid), updatedContent)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred scanning the new content of files artifact '%v' before storing it", fileArtifactUuid)
+		}
+		defer removeScannedContent()
+		updatedContent = scannedContent
+	}
+
 	if err = filesArtifactStore.UpdateFile(fileArtifactUuid, updatedContent, contentMd5); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while trying to update a files artifact.")
 	}
@@ -826,6 +949,81 @@ func (network *DefaultServiceNetwork) GetExistingAndHistoricalServiceIdentifiers
 	return serviceIdentifiers, nil
 }
 
+// SaveServiceDependencies stores the dependencies of a service, replacing the previously stored ones if the service
+// was updated
+func (network *DefaultServiceNetwork) SaveServiceDependencies(dependencies *service_dependencies.ServiceDependencies) error {
+	if err := network.serviceDependenciesRepository.Save(dependencies); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the dependencies of service '%s' in the repository", dependencies.GetServiceName())
+	}
+	return nil
+}
+
+// GetServiceDependencies returns the dependencies of every service currently in the enclave, keyed by service name
+func (network *DefaultServiceNetwork) GetServiceDependencies() (map[service.ServiceName]*service_dependencies.ServiceDependencies, error) {
+	allDependencies, err := network.serviceDependenciesRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service dependencies from the repository")
+	}
+	return allDependencies, nil
+}
+
+func (network *DefaultServiceNetwork) SetEnclaveEnvVars(envVars map[string]string) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	if err := network.enclaveEnvVarsRepository.Upsert(envVars); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the enclave env vars in the repository")
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) GetEnclaveEnvVars() (map[string]string, error) {
+	envVars, err := network.enclaveEnvVarsRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave env vars from the repository")
+	}
+	return envVars, nil
+}
+
+// MonitorServiceHealth keeps running the probe of the service after it started, to report whether it's still healthy
+// and restart it if it isn't and its restart policy says so
+func (network *DefaultServiceNetwork) MonitorServiceHealth(serviceName service.ServiceName, probe service_health.HealthProbe, restartPolicy service_health.RestartPolicy) {
+	network.serviceHealthMonitor.Register(serviceName, probe, restartPolicy)
+}
+
+func (network *DefaultServiceNetwork) GetServiceHealth(serviceName service.ServiceName) (service_health.ServiceHealth, bool) {
+	return network.serviceHealthMonitor.GetHealth(serviceName)
+}
+
+// CollectCrashDiagnostics keeps checking whether the service crashed after it started, to capture its exit state, last
+// logs, backend state and crash dump, if it has a path to one, into a files artifact when it does
+func (network *DefaultServiceNetwork) CollectCrashDiagnostics(serviceName service.ServiceName, crashDumpPath string) {
+	network.crashDiagnosticsCollector.Register(serviceName, crashDumpPath)
+}
+
+func (network *DefaultServiceNetwork) GetLastCrashReport(serviceName service.ServiceName) (crash_diagnostics.CrashReport, bool) {
+	return network.crashDiagnosticsCollector.GetLastCrashReport(serviceName)
+}
+
+func (network *DefaultServiceNetwork) GetLogAlertWatcher() *log_alerts.LogAlertWatcher {
+	return network.logAlertWatcher
+}
+
+func (network *DefaultServiceNetwork) MarkServiceReady(serviceName service.ServiceName) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the registration of service '%v'; its ready event won't be sent:\n%v", serviceName, err)
+		return
+	}
+	network.publishServiceEvent(serviceName, serviceRegistration.GetUUID(), service_events.EventType_Ready, "")
+}
+
+func (network *DefaultServiceNetwork) SubscribeToServiceEvents() (<-chan *service_events.ServiceEvent, func()) {
+	return network.serviceEventBroadcaster.Subscribe()
+}
+
 // GetUniqueNameForFileArtifact : this will return unique artifact name after 5 retries, same as enclave id generator
 func (network *DefaultServiceNetwork) GetUniqueNameForFileArtifact() (string, error) {
 	filesArtifactStore, err := network.enclaveDataDir.GetFilesArtifactStore()
@@ -848,139 +1046,539 @@ func (network *DefaultServiceNetwork) GetEnclaveUuid() enclave.EnclaveUUID {
 //	Private helper methods
 //
 // ====================================================================================================
-// registerService handles all the operations necessary to register a service before is can be started with
-// startRegisteredService. If something fails along the way, the function takes care of rolling back the previous
-// changes such that the enclave remains in the state before the call
-// TODO: The approach is naive here as we register a single service, so it needs to be called within a loop
-//
-//	to register multiple services. We could leverage the fact that the BE handles registering multiple services
-//	with a single call. For now, as registering a service is fairly low lift, it's fine this way
-func (network *DefaultServiceNetwork) registerService(
-	ctx context.Context,
-	serviceName service.ServiceName,
-) (
-	*service.ServiceRegistration,
-	error,
-) {
-	serviceSuccessfullyRegistered := false
+// streamServiceLogs follows the logs of the service from the start of its container
+func (network *DefaultServiceNetwork) streamServiceLogs(ctx context.Context, serviceName service.ServiceName) (io.ReadCloser, error) {
+	network.mutex.Lock()
+	serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(string(serviceName))
+	network.mutex.Unlock()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching registration for service '%v'", serviceName)
+	}
+	serviceUuid := serviceRegistration.GetUUID()
 
-	serviceToRegister := map[service.ServiceName]bool{
-		serviceName: true,
+	serviceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
 	}
-	servicesSuccessfullyRegistered, serviceFailedRegistration, err := network.kurtosisBackend.RegisterUserServices(ctx, network.enclaveUuid, serviceToRegister)
+	successfulServiceLogs, erroredServiceUuids, err := network.kurtosisBackend.GetUserServiceLogs(ctx, network.enclaveUuid, serviceFilters, shouldFollowServiceLogs)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Unexpected error happened registering service '%s'", serviceName)
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs of service '%v'", serviceName)
 	}
-	if serviceRegistrationErr, found := serviceFailedRegistration[serviceName]; found {
-		return nil, stacktrace.Propagate(serviceRegistrationErr, "Error registering service '%s'", serviceName)
+	if serviceErr, found := erroredServiceUuids[serviceUuid]; found {
+		return nil, stacktrace.Propagate(serviceErr, "An error occurred getting the logs of service '%v'", serviceName)
 	}
-	serviceRegistration, found := servicesSuccessfullyRegistered[serviceName]
+	logStream, found := successfulServiceLogs[serviceUuid]
 	if !found {
-		return nil, stacktrace.NewError("Unexpected error while registering service '%s'. It was not flagged as neither failed nor successfully registered. This is a Kurtosis internal bug.", serviceName)
+		return nil, stacktrace.NewError("No logs were returned for service '%v'", serviceName)
 	}
-	defer func() {
-		if serviceSuccessfullyRegistered {
-			return
+	return logStream, nil
+}
+
+// getServiceExitStates returns how the containers of the services exited, leaving out the ones still running
+func (network *DefaultServiceNetwork) getServiceExitStates(ctx context.Context, serviceNames []service.ServiceName) (map[service.ServiceName]*service.ExitState, error) {
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	serviceUuids := map[service.ServiceUUID]bool{}
+	network.mutex.Lock()
+	for _, serviceName := range serviceNames {
+		serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+		if err != nil {
+			// The service could have been removed since it was asked for
+			continue
 		}
-		serviceUuid := serviceRegistration.GetUUID()
-		serviceToUnregister := map[service.ServiceUUID]bool{
+		serviceNamesByUuid[serviceRegistration.GetUUID()] = serviceName
+		serviceUuids[serviceRegistration.GetUUID()] = true
+	}
+	network.mutex.Unlock()
+	if len(serviceUuids) == 0 {
+		return map[service.ServiceName]*service.ExitState{}, nil
+	}
+
+	serviceFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuids,
+		Statuses: nil,
+	}
+	successfulExitStates, erroredServiceUuids, err := network.kurtosisBackend.GetUserServiceExitStates(ctx, network.enclaveUuid, serviceFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the exit states of services '%v'", serviceNames)
+	}
+	for serviceUuid, serviceErr := range erroredServiceUuids {
+		logrus.Debugf("An error occurred getting the exit state of service '%v':\n%v", serviceNamesByUuid[serviceUuid], serviceErr)
+	}
+	exitStates := map[service.ServiceName]*service.ExitState{}
+	for serviceUuid, exitState := range successfulExitStates {
+		if serviceName, found := serviceNamesByUuid[serviceUuid]; found {
+			exitStates[serviceName] = exitState
+		}
+	}
+	return exitStates, nil
+}
+
+// captureCrashDiagnostics stores the exit state, the last log lines, the backend state and, if the service has a path
+// to one, the crash dump of the crashed service into a files artifact named after the crash. A crash already captured,
+// e.g. before the API container restarted, isn't captured again
+func (network *DefaultServiceNetwork) captureCrashDiagnostics(ctx context.Context, serviceName service.ServiceName, exitState *service.ExitState, crashDumpPath string) (string, error) {
+	artifactName := crash_diagnostics.GetDiagnosticsFilesArtifactName(serviceName, exitState)
+
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while fetching registration for service '%v'", serviceName)
+	}
+	serviceUuid := serviceRegistration.GetUUID()
+
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the files artifact store")
+	}
+	_, _, _, alreadyCaptured, err := store.GetFile(artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An unexpected error occurred checking for file artifact '%s' existence in the store", artifactName)
+	}
+	if alreadyCaptured {
+		return artifactName, nil
+	}
+	crashMessage := fmt.Sprintf("exit code %d", exitState.GetExitCode())
+	if exitState.IsOomKilled() {
+		crashMessage = crashMessage + ", OOM-killed"
+	}
+	network.publishServiceEvent(serviceName, serviceUuid, service_events.EventType_Crashed, crashMessage)
+
+	lastLogLines, err := network.getLastServiceLogLinesUnlocked(ctx, serviceUuid)
+	if err != nil {
+		// The diagnostics are still worth having without the logs
+		logrus.Warnf("An error occurred getting the logs of crashed service '%v'; its diagnostics won't include them:\n%v", serviceName, err)
+		lastLogLines = nil
+	}
+	var pushTarredCrashDump func(output io.Writer) error
+	if crashDumpPath != "" {
+		pushTarredCrashDump = func(output io.Writer) error {
+			return network.kurtosisBackend.CopyFilesFromUserService(ctx, network.enclaveUuid, serviceUuid, crashDumpPath, output)
+		}
+	}
+	pushTarredDiagnostics := func(output io.Writer) error {
+		return crash_diagnostics.WriteCrashDiagnostics(output, exitState, lastLogLines, pushTarredCrashDump)
+	}
+	if _, err := network.storeGzippedFilesArtifactUnlocked(artifactName, pushTarredDiagnostics); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing the crash diagnostics of service '%v' in the files artifact store", serviceName)
+	}
+	logrus.Infof("Stored the crash diagnostics of service '%v' in files artifact '%v'", serviceName, artifactName)
+	return artifactName, nil
+}
+
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) getLastServiceLogLinesUnlocked(ctx context.Context, serviceUuid service.ServiceUUID) ([]string, error) {
+	serviceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
 			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	successfulServiceLogs, erroredServiceUuids, err := network.kurtosisBackend.GetUserServiceLogs(ctx, network.enclaveUuid, serviceFilters, shouldFollowLogs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs of service '%v'", serviceUuid)
+	}
+	if serviceErr, found := erroredServiceUuids[serviceUuid]; found {
+		return nil, stacktrace.Propagate(serviceErr, "An error occurred getting the logs of service '%v'", serviceUuid)
+	}
+	logs, found := successfulServiceLogs[serviceUuid]
+	if !found {
+		return nil, stacktrace.NewError("No logs were returned for service '%v'", serviceUuid)
+	}
+	defer logs.Close()
+	lastLogLines, err := crash_diagnostics.ReadLastLogLines(logs, crash_diagnostics.MaxLogLines)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the logs of service '%v'", serviceUuid)
+	}
+	return lastLogLines, nil
+}
+
+func (network *DefaultServiceNetwork) publishServiceEvent(serviceName service.ServiceName, serviceUuid service.ServiceUUID, eventType service_events.EventType, message string) {
+	network.serviceEventBroadcaster.Publish(service_events.NewServiceEvent(serviceName, serviceUuid, eventType, message))
+}
+
+func (network *DefaultServiceNetwork) restartService(ctx context.Context, serviceName service.ServiceName) error {
+	if err := network.StopService(ctx, string(serviceName)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred stopping service '%v' to restart it", serviceName)
+	}
+	if err := network.StartService(ctx, string(serviceName)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting service '%v' again after stopping it", serviceName)
+	}
+	return nil
+}
+
+// startServicesUnlocked starts the registered services, and returns the UUIDs of the ones that started and the errors
+// of the ones that didn't
+func (network *DefaultServiceNetwork) startServicesUnlocked(
+	ctx context.Context,
+	serviceRegistrations map[service.ServiceUUID]*service.ServiceRegistration,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	successfulUuids := map[service.ServiceUUID]bool{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{}
+
+	for serviceUuid, serviceRegistration := range serviceRegistrations {
+		serviceConfig, err := secrets.ResolveSecretsInServiceConfig(ctx, serviceRegistration.GetConfig(), network.secretsProvider)
+		if err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred resolving the secrets of service '%v'", serviceRegistration.GetName())
+			continue
 		}
-		_, failedService, unexpectedErr := network.kurtosisBackend.UnregisterUserServices(ctx, network.enclaveUuid, serviceToUnregister)
-		if unexpectedErr != nil {
-			logrus.Errorf("An unexpected error happened unregistering service '%s' after it failed starting. It"+
-				"is possible the service is still registered to the enclave.", serviceName)
-			return
+		serviceConfig, err = network.pinVerifiedImage(serviceConfig)
+		if err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred pinning the image of service '%v'", serviceRegistration.GetName())
+			continue
 		}
-		if unregisteringErr, found := failedService[serviceUuid]; found {
-			logrus.Errorf("An error happened unregistering service '%s' after it failed starting. It"+
-				"is possible the service is still registered to the enclave. The error was\n%v",
-				serviceName, unregisteringErr.Error())
-			return
+		serviceConfigs[serviceUuid] = serviceConfig
+	}
+
+	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for successfulUuid, successfulService := range successfulServices {
+		serviceName := successfulService.GetRegistration().GetName()
+		serviceStatus := service.ServiceStatus_Started
+		if err := network.serviceRegistrationRepository.UpdateStatus(serviceName, serviceStatus); err != nil {
+			failedServices[successfulUuid] = stacktrace.Propagate(err, "An error occurred while updating status to '%v' for service '%v' after it was successfully started", serviceStatus, serviceName)
+			continue
 		}
-	}()
+		network.serviceHealthMonitor.Resume(serviceName)
+		network.crashDiagnosticsCollector.Resume(serviceName)
+		network.publishServiceEvent(serviceName, successfulUuid, service_events.EventType_Started, "")
+		successfulUuids[successfulUuid] = true
+	}
 
-	if err := network.serviceRegistrationRepository.Save(serviceRegistration); err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred saving service registration '%+v' for service '%s'", serviceRegistration, serviceName)
+	for erroredUuid, err := range failedServices {
+		erroredUuids[erroredUuid] = err
 	}
-	// remove service from the registered service repository is something fails downstream
-	defer func() {
-		if serviceSuccessfullyRegistered {
-			return
+
+	return successfulUuids, erroredUuids, nil
+}
+
+// stopServicesUnlocked stops the registered services, and returns the UUIDs of the ones that stopped and the errors
+// of the ones that didn't
+func (network *DefaultServiceNetwork) stopServicesUnlocked(
+	ctx context.Context,
+	serviceRegistrations map[service.ServiceUUID]*service.ServiceRegistration,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	serviceUuids := map[service.ServiceUUID]bool{}
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	for serviceUuid, serviceRegistration := range serviceRegistrations {
+		serviceUuids[serviceUuid] = true
+		serviceNamesByUuid[serviceUuid] = serviceRegistration.GetName()
+	}
+
+	// Stopped services would otherwise fail their checks, and be restarted if their restart policy says so, and the
+	// killing of their containers would be taken for crashes
+	for _, serviceName := range serviceNamesByUuid {
+		network.serviceHealthMonitor.Pause(serviceName)
+		network.crashDiagnosticsCollector.Pause(serviceName)
+	}
+
+	stopServiceFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuids,
+		Statuses: nil,
+	}
+	successfulUuids, erroredUuids, err := network.kurtosisBackend.StopUserServices(ctx, network.enclaveUuid, stopServiceFilters)
+	if err != nil {
+		return successfulUuids, erroredUuids, stacktrace.Propagate(err, "An error occurred during the call to stop services")
+	}
+
+	for successfulUuid := range successfulUuids {
+		serviceName, found := serviceNamesByUuid[successfulUuid]
+		if !found {
+			erroredUuids[successfulUuid] = stacktrace.NewError("Expected to find service UUID '%v' in map '%+v' after the service was successfully stopped, but it was not found; this is a bug in Kurtosis", successfulUuid, serviceNamesByUuid)
+			delete(successfulUuids, successfulUuid)
+			continue
 		}
-		if err := network.serviceRegistrationRepository.Delete(serviceName); err != nil {
-			logrus.Errorf("We tried to delete the service registration for service  '%s' we had stored but failed:\n%v", serviceName, err)
+		serviceStatus := service.ServiceStatus_Stopped
+		if err := network.serviceRegistrationRepository.UpdateStatus(serviceName, serviceStatus); err != nil {
+			erroredUuids[successfulUuid] = stacktrace.Propagate(err, "An error occurred while updating status to '%v' for service '%v' after it was successfully stopped", serviceStatus, serviceName)
+			delete(successfulUuids, successfulUuid)
+			continue
 		}
-	}()
+		network.publishServiceEvent(serviceName, successfulUuid, service_events.EventType_Stopped, "")
+	}
 
-	serviceSuccessfullyRegistered = true
-	return serviceRegistration, nil
+	return successfulUuids, erroredUuids, nil
 }
 
-// unregisterService is the opposite of register service. It cleans up everything is can to property unregister a
-// service. It is expected that the service was properly registered.
-// As registerService rolls back things if a failure happens halfway, we should never end up with a service
-// half-registered, but it's worth calling out that this method with throw if called with such a service
-func (network *DefaultServiceNetwork) unregisterService(ctx context.Context, serviceName service.ServiceName) error {
+// applyToServicesAtomicallyUnlocked applies the operation to all the services, and if it fails for any of them, undoes
+// it with the rollback operation on the ones it succeeded for. The services that were already in the status the
+// operation puts them in are left as they are, as undoing it would change their status instead of restoring it
+func (network *DefaultServiceNetwork) applyToServicesAtomicallyUnlocked(
+	ctx context.Context,
+	serviceRegistrations map[service.ServiceUUID]*service.ServiceRegistration,
+	serviceIdentifiersByUuid map[service.ServiceUUID]string,
+	operation func(context.Context, map[service.ServiceUUID]*service.ServiceRegistration) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error),
+	rollbackOperation func(context.Context, map[service.ServiceUUID]*service.ServiceRegistration) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error),
+	statusAfterOperation service.ServiceStatus,
+) *BulkServiceOperationResult {
+	successfulUuids, erroredUuids, err := operation(ctx, serviceRegistrations)
+	failedServices := map[string]error{}
+	for serviceUuid, serviceErr := range erroredUuids {
+		failedServices[serviceIdentifiersByUuid[serviceUuid]] = serviceErr
+	}
+	if err != nil {
+		for serviceUuid := range serviceRegistrations {
+			if _, found := successfulUuids[serviceUuid]; !found {
+				failedServices[serviceIdentifiersByUuid[serviceUuid]] = err
+			}
+		}
+	}
+	if len(failedServices) == 0 {
+		return NewBulkServiceOperationResult(failedServices, map[string]error{})
+	}
 
-	serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	serviceRegistrationsToRollBack := map[service.ServiceUUID]*service.ServiceRegistration{}
+	for serviceUuid := range successfulUuids {
+		serviceRegistration, found := serviceRegistrations[serviceUuid]
+		if !found || serviceRegistration.GetStatus() == statusAfterOperation {
+			continue
+		}
+		serviceRegistrationsToRollBack[serviceUuid] = serviceRegistration
+	}
+	failedRollbacks := map[string]error{}
+	if len(serviceRegistrationsToRollBack) == 0 {
+		return NewBulkServiceOperationResult(failedServices, failedRollbacks)
+	}
+	rolledBackUuids, erroredRollbackUuids, err := rollbackOperation(ctx, serviceRegistrationsToRollBack)
+	for serviceUuid, rollbackErr := range erroredRollbackUuids {
+		failedRollbacks[serviceIdentifiersByUuid[serviceUuid]] = rollbackErr
+	}
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting service registration for service '%s'", serviceName)
+		for serviceUuid := range serviceRegistrationsToRollBack {
+			if _, found := rolledBackUuids[serviceUuid]; !found {
+				failedRollbacks[serviceIdentifiersByUuid[serviceUuid]] = err
+			}
+		}
 	}
+	return NewBulkServiceOperationResult(failedServices, failedRollbacks)
+}
 
+// getServiceRegistrationsForIdentifiersUnlocked returns the registrations of the services by UUID, along with the
+// identifier each was asked with, and the errors of the identifiers that don't match any service
+func (network *DefaultServiceNetwork) getServiceRegistrationsForIdentifiersUnlocked(
+	serviceIdentifiers []string,
+) (
+	map[service.ServiceUUID]*service.ServiceRegistration,
+	map[service.ServiceUUID]string,
+	map[string]error,
+) {
+	serviceRegistrations := map[service.ServiceUUID]*service.ServiceRegistration{}
+	serviceIdentifiersByUuid := map[service.ServiceUUID]string{}
+	unknownServices := map[string]error{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
+		if err != nil {
+			unknownServices[serviceIdentifier] = stacktrace.Propagate(err, "An error occurred while getting service registration for identifier '%v'", serviceIdentifier)
+			continue
+		}
+		serviceUuid := serviceRegistration.GetUUID()
+		if _, found := serviceRegistrations[serviceUuid]; found {
+			continue
+		}
+		serviceRegistrations[serviceUuid] = serviceRegistration
+		serviceIdentifiersByUuid[serviceUuid] = serviceIdentifier
+	}
+	return serviceRegistrations, serviceIdentifiersByUuid, unknownServices
+}
+
+// forgetServiceUnlocked deletes everything the API container keeps about a service that got stopped, except its logs
+func (network *DefaultServiceNetwork) forgetServiceUnlocked(serviceName service.ServiceName, serviceUuid service.ServiceUUID) error {
 	if err := network.serviceRegistrationRepository.Delete(serviceName); err != nil {
 		return stacktrace.Propagate(err, "An error occurred deleting the service registration for service '%v' from the repository", serviceName)
 	}
-	serviceUuid := serviceRegistration.GetUUID()
-	serviceToUnregister := map[service.ServiceUUID]bool{
-		serviceUuid: true,
+
+	if err := network.serviceDependenciesRepository.Delete(serviceName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred deleting the dependencies of service '%v' from the repository", serviceName)
 	}
-	_, failedService, unexpectedErr := network.kurtosisBackend.UnregisterUserServices(ctx, network.enclaveUuid, serviceToUnregister)
-	if unexpectedErr != nil {
-		return stacktrace.Propagate(unexpectedErr, "An unexpected error happened unregistering service '%s'. It "+
-			"is possible the service is still registered to the enclave.", serviceName)
+
+	network.serviceHealthMonitor.Unregister(serviceName)
+	network.logAlertWatcher.StopWatching(serviceName)
+	network.crashDiagnosticsCollector.Unregister(serviceName)
+	network.publishServiceEvent(serviceName, serviceUuid, service_events.EventType_Stopped, "removed")
+	return nil
+}
+
+// checkEnclaveQuotaUnlocked checks that the services already in the enclave and the ones about to be added fit in the
+// quota of the enclave
+func (network *DefaultServiceNetwork) checkEnclaveQuotaUnlocked(serviceConfigsToAdd map[service.ServiceName]*service.ServiceConfig) error {
+	if network.enclaveQuota.IsUnlimited() {
+		return nil
 	}
-	if unregisteringErr, found := failedService[serviceUuid]; found {
-		return stacktrace.Propagate(unregisteringErr, "An error happened unregistering service '%s'. It"+
-			"is possible the service is still registered to the enclave.",
-			serviceName)
+	serviceRegistrations, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting all service registrations from the repository")
+	}
+	serviceConfigs := map[service.ServiceName]*service.ServiceConfig{}
+	for serviceName, serviceRegistration := range serviceRegistrations {
+		serviceConfigs[serviceName] = serviceRegistration.GetConfig()
+	}
+	for serviceName, serviceConfig := range serviceConfigsToAdd {
+		serviceConfigs[serviceName] = serviceConfig
+	}
+	if err := network.enclaveQuota.Check(serviceConfigs); err != nil {
+		return stacktrace.Propagate(err, "The services of enclave '%v' would go over its quota", network.enclaveUuid)
+	}
+	return nil
+}
+
+// injectEnclaveEnvVarsUnlocked merges the enclave env vars into the env vars of each service config. Env vars set
+// on the service config itself take precedence over the enclave ones.
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) injectEnclaveEnvVarsUnlocked(serviceConfigs map[service.ServiceName]*service.ServiceConfig) error {
+	enclaveEnvVars, err := network.enclaveEnvVarsRepository.GetAll()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave env vars from the repository")
+	}
+	if len(enclaveEnvVars) == 0 {
+		return nil
+	}
+	for _, serviceConfig := range serviceConfigs {
+		mergedEnvVars := make(map[string]string, len(enclaveEnvVars)+len(serviceConfig.GetEnvVars()))
+		for envVarName, envVarValue := range enclaveEnvVars {
+			mergedEnvVars[envVarName] = envVarValue
+		}
+		for envVarName, envVarValue := range serviceConfig.GetEnvVars() {
+			mergedEnvVars[envVarName] = envVarValue
+		}
+		serviceConfig.SetEnvVars(mergedEnvVars)
 	}
 	return nil
 }
 
-// startRegisteredService handles the logistic of starting a service in the relevant Kurtosis backend:
-func (network *DefaultServiceNetwork) startRegisteredService(
+// registerServices registers all the services with a single call to the backend, and returns the registrations of
+// the ones that were registered and the errors of the ones that weren't, as when they were registered one by one.
+// Services that can't be fully registered are rolled back individually, such that the successfully registered ones
+// can be used or unregistered by the caller
+func (network *DefaultServiceNetwork) registerServices(
 	ctx context.Context,
-	serviceUuid service.ServiceUUID,
-	serviceConfig *service.ServiceConfig,
+	serviceNames map[service.ServiceName]bool,
 ) (
-	*service.Service,
-	error,
+	map[service.ServiceName]*service.ServiceRegistration,
+	map[service.ServiceName]error,
 ) {
-	serviceStartedSuccessfully := false
+	registeredServices := map[service.ServiceName]*service.ServiceRegistration{}
+	failedServices := map[service.ServiceName]error{}
+
+	servicesSuccessfullyRegistered, serviceFailedRegistration, err := network.kurtosisBackend.RegisterUserServices(ctx, network.enclaveUuid, serviceNames)
+	if err != nil {
+		for serviceName := range serviceNames {
+			failedServices[serviceName] = stacktrace.Propagate(err, "Unexpected error happened registering service '%s'", serviceName)
+		}
+		return registeredServices, failedServices
+	}
 
-	// Docker and K8s requires the minimum memory limit to be 6 megabytes to we make sure the allocation is at least that amount
-	// But first, we check that it's not the default value, meaning the user potentially didn't even set it
-	if serviceConfig.GetMemoryAllocationMegabytes() != defaultMemoryAllocMegabytes && serviceConfig.GetMemoryAllocationMegabytes() < minMemoryLimit {
-		return nil, stacktrace.NewError("Memory allocation, `%d`, is too low. Kurtosis requires the memory limit to be at least `%d` megabytes for service with UUID '%v'.", serviceConfig.GetMemoryAllocationMegabytes(), minMemoryLimit, serviceUuid)
+	servicesToUnregister := map[service.ServiceUUID]service.ServiceName{}
+	for serviceName := range serviceNames {
+		if serviceRegistrationErr, found := serviceFailedRegistration[serviceName]; found {
+			failedServices[serviceName] = stacktrace.Propagate(serviceRegistrationErr, "Error registering service '%s'", serviceName)
+			continue
+		}
+		serviceRegistration, found := servicesSuccessfullyRegistered[serviceName]
+		if !found {
+			failedServices[serviceName] = stacktrace.NewError("Unexpected error while registering service '%s'. It was not flagged as neither failed nor successfully registered. This is a Kurtosis internal bug.", serviceName)
+			continue
+		}
+		if err := network.serviceRegistrationRepository.Save(serviceRegistration); err != nil {
+			failedServices[serviceName] = stacktrace.Propagate(err, "An error occurred saving service registration '%+v' for service '%s'", serviceRegistration, serviceName)
+			servicesToUnregister[serviceRegistration.GetUUID()] = serviceName
+			continue
+		}
+		registeredServices[serviceName] = serviceRegistration
 	}
 
-	// TODO(gb): make the backend also handle starting service sequentially to simplify the logic there as well
-	serviceConfigMap := map[service.ServiceUUID]*service.ServiceConfig{
-		serviceUuid: serviceConfig,
+	if len(servicesToUnregister) == 0 {
+		return registeredServices, failedServices
 	}
-	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigMap)
-	if err != nil {
-		return nil, err
+	serviceUuidsToUnregister := map[service.ServiceUUID]bool{}
+	for serviceUuid := range servicesToUnregister {
+		serviceUuidsToUnregister[serviceUuid] = true
+	}
+	_, failedUnregistrations, unexpectedErr := network.kurtosisBackend.UnregisterUserServices(ctx, network.enclaveUuid, serviceUuidsToUnregister)
+	if unexpectedErr != nil {
+		logrus.Errorf("An unexpected error happened unregistering services '%v' after they failed being registered. It"+
+			"is possible the services are still registered to the enclave.", servicesToUnregister)
+		return registeredServices, failedServices
+	}
+	for serviceUuid, unregisteringErr := range failedUnregistrations {
+		logrus.Errorf("An error happened unregistering service '%s' after it failed being registered. It"+
+			"is possible the service is still registered to the enclave. The error was\n%v",
+			servicesToUnregister[serviceUuid], unregisteringErr.Error())
+	}
+	return registeredServices, failedServices
+}
+
+// unregisterServices is the opposite of registerServices. It cleans up everything is can to property unregister the
+// services, with a single call to the backend. It is expected that the services were properly registered.
+// As registerServices rolls back things if a failure happens halfway, we should never end up with a service
+// half-registered, but it's worth calling out that this method returns an error for such a service
+func (network *DefaultServiceNetwork) unregisterServices(ctx context.Context, serviceNames map[service.ServiceName]bool) map[service.ServiceName]error {
+	failedServices := map[service.ServiceName]error{}
+	servicesToUnregister := map[service.ServiceUUID]service.ServiceName{}
+	serviceUuidsToUnregister := map[service.ServiceUUID]bool{}
+	for serviceName := range serviceNames {
+		serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+		if err != nil {
+			failedServices[serviceName] = stacktrace.Propagate(err, "An error occurred getting service registration for service '%s'", serviceName)
+			continue
+		}
+		if err := network.serviceRegistrationRepository.Delete(serviceName); err != nil {
+			failedServices[serviceName] = stacktrace.Propagate(err, "An error occurred deleting the service registration for service '%v' from the repository", serviceName)
+			continue
+		}
+		servicesToUnregister[serviceRegistration.GetUUID()] = serviceName
+		serviceUuidsToUnregister[serviceRegistration.GetUUID()] = true
 	}
-	if failedServiceErr, isFailed := failedServices[serviceUuid]; isFailed {
-		return nil, failedServiceErr
+	if len(serviceUuidsToUnregister) == 0 {
+		return failedServices
 	}
 
-	startedService, isSuccessful := successfulServices[serviceUuid]
-	if !isSuccessful {
-		return nil, stacktrace.NewError("Service '%s' did not start properly but no error was thrown. This is a Kurtosis internal bug", serviceUuid)
+	_, failedUnregistrations, unexpectedErr := network.kurtosisBackend.UnregisterUserServices(ctx, network.enclaveUuid, serviceUuidsToUnregister)
+	if unexpectedErr != nil {
+		for _, serviceName := range servicesToUnregister {
+			failedServices[serviceName] = stacktrace.Propagate(unexpectedErr, "An unexpected error happened unregistering service '%s'. It "+
+				"is possible the service is still registered to the enclave.", serviceName)
+		}
+		return failedServices
+	}
+	for serviceUuid, unregisteringErr := range failedUnregistrations {
+		serviceName := servicesToUnregister[serviceUuid]
+		failedServices[serviceName] = stacktrace.Propagate(unregisteringErr, "An error happened unregistering service '%s'. It"+
+			"is possible the service is still registered to the enclave.",
+			serviceName)
 	}
+	return failedServices
+}
+
+// waitForStartedService waits for a service started in the relevant Kurtosis backend to open its ports, destroying it
+// if it doesn't
+func (network *DefaultServiceNetwork) waitForStartedService(
+	ctx context.Context,
+	startedService *service.Service,
+	serviceConfig *service.ServiceConfig,
+) (
+	*service.Service,
+	error,
+) {
+	serviceStartedSuccessfully := false
 	defer func() {
 		if serviceStartedSuccessfully {
 			return
@@ -1032,45 +1630,50 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 	return startedService, nil
 }
 
-// destroyService is the opposite of startRegisteredService. It removes a started service from the enclave. Note that it does not
-// take care of unregistering the service. For this, unregisterService should be called
-// Similar to unregisterService, it is expected that the service passed to destroyService has been properly started.
-// the function might fail if the service is half-started
-// Note: the function also takes care of destroying any networking sidecar associated with the service
-func (network *DefaultServiceNetwork) destroyService(ctx context.Context, serviceName service.ServiceName, serviceUuid service.ServiceUUID) error {
-	// deleting the service first
+// destroyServices is the opposite of startRegisteredServices. It removes started services from the enclave, with a
+// single call to the backend. Note that it does not take care of unregistering the services. For this,
+// unregisterServices should be called
+// Similar to unregisterServices, it is expected that the services passed to destroyServices have been properly
+// started. The function might return an error for a service that is half-started
+// Note: the function also takes care of destroying any networking sidecar associated with the services
+func (network *DefaultServiceNetwork) destroyServices(ctx context.Context, serviceUuids map[service.ServiceUUID]bool) map[service.ServiceUUID]error {
+	failedServices := map[service.ServiceUUID]error{}
+	if len(serviceUuids) == 0 {
+		return failedServices
+	}
 	userServiceFilters := &service.ServiceFilters{
-		Names: nil,
-		UUIDs: map[service.ServiceUUID]bool{
-			serviceUuid: true,
-		},
+		Names:    nil,
+		UUIDs:    serviceUuids,
 		Statuses: nil,
 	}
 	successfullyDestroyedUuids, failedToDestroyUuids, err := network.kurtosisBackend.DestroyUserServices(context.Background(), network.enclaveUuid, userServiceFilters)
 	if err != nil {
-		return stacktrace.Propagate(err, "Attempted to destroy the service with UUID '%v' but had no success. You must manually destroy the service as well as its sidecar if any", serviceUuid)
-	}
-	if _, found := successfullyDestroyedUuids[serviceUuid]; found {
-		if len(failedToDestroyUuids) != 0 {
-			return stacktrace.NewError("Service '%s' was successfully destroyed but unexpected error were returned by Kurtosis backend. This is unexpected and should be investigated. Here are the errors:\n%v", serviceUuid, failedToDestroyUuids)
+		for serviceUuid := range serviceUuids {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "Attempted to destroy the service with UUID '%v' but had no success. You must manually destroy the service as well as its sidecar if any", serviceUuid)
 		}
-	} else {
+		return failedServices
+	}
+	for serviceUuid := range serviceUuids {
 		if failedToDestroyErr, found := failedToDestroyUuids[serviceUuid]; found {
-			return stacktrace.Propagate(failedToDestroyErr, "Attempted to destroy the service with UUID '%v' but had no success. You must manually destroy the service as well as its sidecar if any", serviceUuid)
-		} else {
-			return stacktrace.NewError("Attempted to destroy service '%s' but it was neither marked as successfully destroyed nor errored in the result. This is a Kurtosis bug", serviceUuid)
+			failedServices[serviceUuid] = stacktrace.Propagate(failedToDestroyErr, "Attempted to destroy the service with UUID '%v' but had no success. You must manually destroy the service as well as its sidecar if any", serviceUuid)
+			continue
+		}
+		if _, found := successfullyDestroyedUuids[serviceUuid]; !found {
+			failedServices[serviceUuid] = stacktrace.NewError("Attempted to destroy service '%s' but it was neither marked as successfully destroyed nor errored in the result. This is a Kurtosis bug", serviceUuid)
 		}
 	}
-
-	return nil
+	return failedServices
 }
 
 // startRegisteredServices starts multiple services in parallel
 //
-// It iterates over all the services to start and kicks off a go subroutine for each of them.
-// The subroutine will block until it can write to concurrencyControlChan. concurrencyControlChan is a simple buffered
-// channel that can contain a max of 4 values. It's a common way in go to control concurrency to make sure no more than
-// X subroutine is running at the same time.
+// All the services are created with a single call to the backend, so that it can share the work common to the services
+// and create their objects concurrently rather than one service at a time.
+//
+// It then iterates over all the successfully created services and kicks off a go subroutine for each of them to wait for its ports
+// to be open. The subroutine will block until it can write to concurrencyControlChan. concurrencyControlChan is a simple
+// buffered channel that can contain a max of batchSize values. It's a common way in go to control concurrency to make
+// sure no more than X subroutine is running at the same time.
 //
 // Once the for loops has started all the subroutine, we use a WaitGroup for this method to block until all subroutines
 // have completed
@@ -1082,25 +1685,68 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 	serviceConfigs map[service.ServiceUUID]*service.ServiceConfig,
 	batchSize int,
 ) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error) {
+	startedServices := map[service.ServiceUUID]*service.Service{}
+	failedServices := map[service.ServiceUUID]error{}
+
+	for serviceUuid, serviceConfig := range serviceConfigs {
+		// Docker and K8s requires the minimum memory limit to be 6 megabytes to we make sure the allocation is at least that amount
+		// But first, we check that it's not the default value, meaning the user potentially didn't even set it
+		if serviceConfig.GetMemoryAllocationMegabytes() != defaultMemoryAllocMegabytes && serviceConfig.GetMemoryAllocationMegabytes() < minMemoryLimit {
+			failedServices[serviceUuid] = stacktrace.NewError("Memory allocation, `%d`, is too low. Kurtosis requires the memory limit to be at least `%d` megabytes for service with UUID '%v'.", serviceConfig.GetMemoryAllocationMegabytes(), minMemoryLimit, serviceUuid)
+		}
+	}
+	if len(failedServices) > 0 {
+		return startedServices, failedServices
+	}
+
+	resolvedServiceConfigs := map[service.ServiceUUID]*service.ServiceConfig{}
+	for serviceUuid, serviceConfig := range serviceConfigs {
+		resolvedServiceConfig, err := secrets.ResolveSecretsInServiceConfig(ctx, serviceConfig, network.secretsProvider)
+		if err != nil {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred resolving the secrets of service with UUID '%v'", serviceUuid)
+			continue
+		}
+		resolvedServiceConfig, err = network.pinVerifiedImage(resolvedServiceConfig)
+		if err != nil {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred pinning the image of service with UUID '%v'", serviceUuid)
+			continue
+		}
+		resolvedServiceConfigs[serviceUuid] = resolvedServiceConfig
+	}
+	if len(failedServices) > 0 {
+		return startedServices, failedServices
+	}
+
+	createdServices, failedCreations, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, resolvedServiceConfigs)
+	if err != nil {
+		for serviceUuid := range serviceConfigs {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred starting service '%s'", serviceUuid)
+		}
+		return startedServices, failedServices
+	}
+	for serviceUuid := range serviceConfigs {
+		if failedCreationErr, isFailed := failedCreations[serviceUuid]; isFailed {
+			failedServices[serviceUuid] = failedCreationErr
+			continue
+		}
+		if _, isSuccessful := createdServices[serviceUuid]; !isSuccessful {
+			failedServices[serviceUuid] = stacktrace.NewError("Service '%s' did not start properly but no error was thrown. This is a Kurtosis internal bug", serviceUuid)
+		}
+	}
+
 	wg := sync.WaitGroup{}
 
 	concurrencyControlChan := make(chan bool, batchSize)
 	defer close(concurrencyControlChan)
 
-	startedServices := map[service.ServiceUUID]*service.Service{}
-	failedServices := map[service.ServiceUUID]error{}
 	mapWriteMutex := sync.Mutex{}
 
 	// async kick off all the routines one by one
-	for serviceUuid, serviceConfig := range serviceConfigs {
-		serviceToStartUuid := serviceUuid
-		serviceToStartConfig := serviceConfig
+	for serviceUuid, createdService := range createdServices {
+		serviceToWaitForUuid := serviceUuid
+		serviceToWaitFor := createdService
+		serviceToWaitForConfig := serviceConfigs[serviceUuid]
 
-		if len(failedServices) > 0 {
-			// stop scheduling more service start
-			// as one already failed, the full batch will be reverted anyway so no need to continue any further
-			break
-		}
 		// The concurrencyControlChan will block if the buffer is currently full, i.e. if maxConcurrentServiceStart
 		// subroutines are already running in the background
 		concurrencyControlChan <- true
@@ -1113,16 +1759,16 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 				wg.Done()
 				<-concurrencyControlChan
 			}()
-			logrus.Debugf("Starting service '%s'", serviceToStartUuid)
-			startedService, err := network.startRegisteredService(ctx, serviceToStartUuid, serviceToStartConfig)
+			logrus.Debugf("Waiting for service '%s' to start", serviceToWaitForUuid)
+			startedService, err := network.waitForStartedService(ctx, serviceToWaitFor, serviceToWaitForConfig)
 			mapWriteMutex.Lock()
 			defer mapWriteMutex.Unlock()
 			if err != nil {
-				failedServices[serviceToStartUuid] = err
-				logrus.Debugf("Service '%s' could not start due to some errors", serviceToStartUuid)
+				failedServices[serviceToWaitForUuid] = err
+				logrus.Debugf("Service '%s' could not start due to some errors", serviceToWaitForUuid)
 			} else {
-				startedServices[serviceToStartUuid] = startedService
-				logrus.Debugf("Service '%s' started successfully", serviceToStartUuid)
+				startedServices[serviceToWaitForUuid] = startedService
+				logrus.Debugf("Service '%s' started successfully", serviceToWaitForUuid)
 			}
 		}()
 	}
@@ -1132,6 +1778,19 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 	return startedServices, failedServices
 }
 
+// pinVerifiedImage returns the service config starting its image at the digest it was verified at, so that the image
+// started is the one verified even if its tag was moved since
+func (network *DefaultServiceNetwork) pinVerifiedImage(serviceConfig *service.ServiceConfig) (*service.ServiceConfig, error) {
+	if network.imageSignatureVerifier == nil {
+		return serviceConfig, nil
+	}
+	pinnedServiceConfig, err := network.imageSignatureVerifier.PinVerifiedImageInServiceConfig(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred pinning image '%v' to the digest it was verified at", serviceConfig.GetContainerImageName())
+	}
+	return pinnedServiceConfig, nil
+}
+
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
 func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.Context, serviceName service.ServiceName, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 
@@ -1141,6 +1800,36 @@ func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.C
 	}
 	serviceUuid := serviceRegistrationObj.GetUUID()
 
+	pushTarredFileBytes := func(output io.Writer) error {
+		return network.kurtosisBackend.CopyFilesFromUserService(ctx, network.enclaveUuid, serviceUuid, srcPath, output)
+	}
+	filesArtifactUuid, err := network.storeGzippedFilesArtifactUnlocked(artifactName, pushTarredFileBytes)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing files from path '%v' on service '%v' in the files artifact store", srcPath, serviceUuid)
+	}
+	return filesArtifactUuid, nil
+}
+
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) copyFilesFromImageUnlocked(ctx context.Context, image string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	imageReference := image
+	if network.imageSignatureVerifier != nil {
+		imageReference = network.imageSignatureVerifier.GetVerifiedImageReference(image)
+	}
+	pushTarredFileBytes := func(output io.Writer) error {
+		return network.kurtosisBackend.CopyFilesFromImage(ctx, imageReference, srcPath, output)
+	}
+	filesArtifactUuid, err := network.storeGzippedFilesArtifactUnlocked(artifactName, pushTarredFileBytes)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing files from path '%v' in image '%v' in the files artifact store", srcPath, image)
+	}
+	return filesArtifactUuid, nil
+}
+
+// storeGzippedFilesArtifactUnlocked gzips the TAR'd bytes pushed by pushTarredFileBytes and stores them under the given
+// artifact name, updating the artifact if it already exists
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) storeGzippedFilesArtifactUnlocked(artifactName string, pushTarredFileBytes func(output io.Writer) error) (enclave_data_directory.FilesArtifactUUID, error) {
 	store, err := network.enclaveDataDir.GetFilesArtifactStore()
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred getting the files artifact store")
@@ -1178,28 +1867,21 @@ func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.C
 		}
 	}()
 
-	if err := network.gzipAndPushTarredFileBytesToOutput(ctx, pipeWriter, serviceUuid, srcPath); err != nil {
+	if err := gzipAndPushTarredFileBytesToOutput(pipeWriter, pushTarredFileBytes); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred gzip'ing and pushing tar'd file bytes to the pipe")
 	}
 
 	storeFileResult := <-storeFilesArtifactResultChan
 	if storeFileResult.err != nil {
-		return "", stacktrace.Propagate(
-			err,
-			"An error occurred storing files from path '%v' on service '%v' in in the files artifact store",
-			srcPath,
-			serviceUuid,
-		)
+		return "", stacktrace.Propagate(storeFileResult.err, "An error occurred storing files artifact '%v' in the files artifact store", artifactName)
 	}
 
 	return storeFileResult.filesArtifactUuid, nil
 }
 
-func (network *DefaultServiceNetwork) gzipAndPushTarredFileBytesToOutput(
-	ctx context.Context,
+func gzipAndPushTarredFileBytesToOutput(
 	output io.WriteCloser,
-	serviceUuid service.ServiceUUID,
-	srcPathOnContainer string,
+	pushTarredFileBytes func(output io.Writer) error,
 ) error {
 	defer output.Close()
 
@@ -1207,8 +1889,8 @@ func (network *DefaultServiceNetwork) gzipAndPushTarredFileBytesToOutput(
 	gzippingOutput := gzip.NewWriter(output)
 	defer gzippingOutput.Close()
 
-	if err := network.kurtosisBackend.CopyFilesFromUserService(ctx, network.enclaveUuid, serviceUuid, srcPathOnContainer, gzippingOutput); err != nil {
-		return stacktrace.Propagate(err, "An error occurred copying source '%v' from user service with UUID '%v' in enclave with UUID '%v'", srcPathOnContainer, serviceUuid, network.enclaveUuid)
+	if err := pushTarredFileBytes(gzippingOutput); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the TAR'd file bytes")
 	}
 
 	return nil
@@ -12,6 +12,7 @@ import (
 	"net"
 	"net/netip"
 	"os"
+	"reflect"
 	"strconv"
 	"testing"
 	"time"
@@ -21,6 +22,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
@@ -52,6 +54,7 @@ var (
 		testIpFromInt(0),
 		uint16(1234),
 		"0.0.0",
+		nil,
 	)
 	unusedEnclaveDataDir *enclave_data_directory.EnclaveDataDirectory
 
@@ -84,6 +87,10 @@ func TestAddService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -140,6 +147,84 @@ func TestAddService_Successful(t *testing.T) {
 	require.Len(t, allExistingAndHistoricalIdentifiers, 1)
 }
 
+func TestAddService_InjectsEnclaveEnvVars(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	serviceConfig := testServiceConfig(t, testContainerImageName)
+	serviceConfig.SetEnvVars(map[string]string{
+		"FEATURE_FLAG": "service-value",
+	})
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
+	)
+	require.Nil(t, err)
+
+	require.NoError(t, network.SetEnclaveEnvVars(map[string]string{
+		"CHAIN_ID":     "1337",
+		"FEATURE_FLAG": "enclave-value",
+	}))
+
+	backend.EXPECT().RegisterUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceName]bool{
+			serviceName: true,
+		},
+	).Times(1).Return(
+		map[service.ServiceName]*service.ServiceRegistration{
+			serviceName: serviceRegistration,
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+
+	// The service is started with the enclave env vars, the ones set on the service config taking precedence
+	expectedEnvVars := map[string]string{
+		"CHAIN_ID":     "1337",
+		"FEATURE_FLAG": "service-value",
+	}
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			startedServiceConfig, foundService := services[serviceUuid]
+			return len(services) == 1 && foundService && reflect.DeepEqual(expectedEnvVars, startedServiceConfig.GetEnvVars())
+		})).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil)
+
+	startedService, err := network.AddService(ctx, serviceName, serviceConfig)
+	require.Nil(t, err)
+	require.NotNil(t, startedService)
+}
+
 func TestAddService_FailedToStart(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -165,6 +250,10 @@ func TestAddService_FailedToStart(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -259,12 +348,16 @@ func TestAddServices_Success(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 
 	// Configure the mock to also be testing that the right functions are called along the way
 
-	// The services are registered one by one before being started
+	// The services are registered in a single batch before being started
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
@@ -352,64 +445,46 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 
 	// Configure the mock to also be testing that the right functions are called along the way
 
-	// The services are registered one by one before being started
+	// The services are registered in a single batch before being started
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
 		map[service.ServiceName]bool{
 			successfulServiceName: true,
+			failedServiceName:     true,
 		},
 	).Times(1).Return(
 		map[service.ServiceName]*service.ServiceRegistration{
 			successfulServiceName: successfulServiceRegistration,
-		},
-		map[service.ServiceName]error{},
-		nil,
-	)
-	backend.EXPECT().RegisterUserServices(
-		ctx,
-		enclaveName,
-		map[service.ServiceName]bool{
-			failedServiceName: true,
-		},
-	).Times(1).Return(
-		map[service.ServiceName]*service.ServiceRegistration{
-			failedServiceName: failedServiceRegistration,
+			failedServiceName:     failedServiceRegistration,
 		},
 		map[service.ServiceName]error{},
 		nil,
 	)
 
-	// StartUserService will be called three times, with all the provided services
+	// StartRegisteredUserServices will be called once, with all the provided services
 	backend.EXPECT().StartRegisteredUserServices(
 		ctx,
 		enclaveName,
 		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
-			// Matcher function returning true iff the services map arg contains exactly the following key:
-			// {successfulServiceName}
+			// Matcher function returning true iff the services map arg contains exactly the following keys:
+			// {successfulServiceName, failedServiceName}
 			_, foundSuccessfulService := services[successfulServiceUuid]
-			return len(services) == 1 && foundSuccessfulService
+			_, foundFailedService := services[failedServiceUuid]
+			return len(services) == 2 && foundSuccessfulService && foundFailedService
 		})).Times(1).Return(
 		map[service.ServiceUUID]*service.Service{
 			successfulServiceUuid: successfulService,
 		},
-		map[service.ServiceUUID]error{},
-		nil)
-	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
-		enclaveName,
-		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
-			// Matcher function returning true iff the services map arg contains exactly the following key:
-			// {failedServiceName}
-			_, foundFailedService := services[failedServiceUuid]
-			return len(services) == 1 && foundFailedService
-		})).Times(1).Return(
-		map[service.ServiceUUID]*service.Service{},
 		map[service.ServiceUUID]error{
 			failedServiceUuid: stacktrace.NewError("Failed starting service"),
 		},
@@ -431,29 +506,18 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		map[service.ServiceUUID]error{},
 		nil)
 
-	// Both failedService and sidecarFailedService are unregistered in the deferred functions
+	// Both successfulService and failedService are unregistered in a single batch in the deferred functions
 	backend.EXPECT().UnregisterUserServices(
 		ctx,
 		enclaveName,
 		map[service.ServiceUUID]bool{
 			successfulServiceUuid: true,
+			failedServiceUuid:     true,
 		},
 	).Times(1).Return(
 		map[service.ServiceUUID]bool{
 			successfulServiceUuid: true,
-		},
-		map[service.ServiceUUID]error{},
-		nil,
-	)
-	backend.EXPECT().UnregisterUserServices(
-		ctx,
-		enclaveName,
-		map[service.ServiceUUID]bool{
-			failedServiceUuid: true,
-		},
-	).Times(1).Return(
-		map[service.ServiceUUID]bool{
-			failedServiceUuid: true,
+			failedServiceUuid:     true,
 		},
 		map[service.ServiceUUID]error{},
 		nil,
@@ -503,6 +567,10 @@ func TestAddServices_FailedToRegisterService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -532,6 +600,198 @@ func TestAddServices_FailedToRegisterService(t *testing.T) {
 	require.Len(t, failure, 1)
 }
 
+func TestAddServices_PartialRegistrationFailureKeepsPerServiceErrors(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	// One service will be registered successfully
+	registeredServiceIndex := 1
+	registeredServiceName := testServiceNameFromInt(registeredServiceIndex)
+	registeredServiceUuid := testServiceUuidFromInt(registeredServiceIndex)
+	registeredServiceIp := testIpFromInt(registeredServiceIndex)
+	registeredServiceRegistration := service.NewServiceRegistration(registeredServiceName, registeredServiceUuid, enclaveName, registeredServiceIp, string(registeredServiceName))
+	registeredServiceConfig := testServiceConfig(t, testContainerImageName)
+
+	// One service will fail to be registered
+	failedServiceIndex := 2
+	failedServiceName := testServiceNameFromInt(failedServiceIndex)
+	failedServiceConfig := testServiceConfig(t, testContainerImageName)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
+	)
+	require.Nil(t, err)
+
+	backend.EXPECT().RegisterUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceName]bool{
+			registeredServiceName: true,
+			failedServiceName:     true,
+		},
+	).Times(1).Return(
+		map[service.ServiceName]*service.ServiceRegistration{
+			registeredServiceName: registeredServiceRegistration,
+		},
+		map[service.ServiceName]error{
+			failedServiceName: errors.New("Service failed to register"),
+		},
+		nil,
+	)
+
+	// Nothing is started, and the service that was registered is unregistered as the batch failed
+	backend.EXPECT().UnregisterUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]bool{
+			registeredServiceUuid: true,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{
+			registeredServiceUuid: true,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	success, failure, err := network.AddServices(
+		ctx,
+		map[service.ServiceName]*service.ServiceConfig{
+			registeredServiceName: registeredServiceConfig,
+			failedServiceName:     failedServiceConfig,
+		},
+		1,
+	)
+	require.Nil(t, err)
+	require.Empty(t, success)
+	require.Len(t, failure, 1)
+	require.Contains(t, failure, failedServiceName)
+	require.Contains(t, failure[failedServiceName].Error(), "Service failed to register")
+
+	serviceNames, err := network.GetServiceNames()
+	require.Nil(t, err)
+	require.Empty(t, serviceNames)
+}
+
+func TestAddServices_UnexpectedRegistrationErrorFailsEachService(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	firstServiceName := testServiceNameFromInt(1)
+	secondServiceName := testServiceNameFromInt(2)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
+	)
+	require.Nil(t, err)
+
+	backend.EXPECT().RegisterUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceName]bool{
+			firstServiceName:  true,
+			secondServiceName: true,
+		},
+	).Times(1).Return(
+		nil,
+		nil,
+		errors.New("Backend unreachable"),
+	)
+
+	success, failure, err := network.AddServices(
+		ctx,
+		map[service.ServiceName]*service.ServiceConfig{
+			firstServiceName:  testServiceConfig(t, testContainerImageName),
+			secondServiceName: testServiceConfig(t, testContainerImageName),
+		},
+		1,
+	)
+	require.Nil(t, err)
+	require.Empty(t, success)
+	require.Len(t, failure, 2)
+	for _, serviceName := range []service.ServiceName{firstServiceName, secondServiceName} {
+		require.Contains(t, failure, serviceName)
+		require.Contains(t, failure[serviceName].Error(), "Backend unreachable")
+	}
+}
+
+func TestAddServices_OverEnclaveQuota(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	quota := enclave_quota.EnclaveQuota{
+		MaxServices:        1,
+		MaxCpuMilliCores:   0,
+		MaxMemoryMegabytes: 0,
+	}
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		quota,
+		nil,
+		nil,
+		nil,
+	)
+	require.Nil(t, err)
+
+	// The backend is never called as the batch is rejected upfront
+	success, failure, err := network.AddServices(
+		ctx,
+		map[service.ServiceName]*service.ServiceConfig{
+			testServiceNameFromInt(1): testServiceConfig(t, testContainerImageName),
+			testServiceNameFromInt(2): testServiceConfig(t, testContainerImageName),
+		},
+		1,
+	)
+	require.Nil(t, err)
+	require.Empty(t, success)
+	require.Len(t, failure, 2)
+	require.ErrorContains(t, failure[testServiceNameFromInt(1)], "at most 1 services")
+}
+
 func TestStopService_Successful(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -557,6 +817,10 @@ func TestStopService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -615,6 +879,10 @@ func TestStopService_StopUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -670,6 +938,10 @@ func TestStopService_ServiceAlreadyStopped(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -729,6 +1001,10 @@ func TestStartService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -783,6 +1059,10 @@ func TestStartService_StartRegisteredUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -837,6 +1117,10 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -864,6 +1148,132 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 	require.Equal(t, serviceRegistrationAfterBeingStarted.GetStatus(), service.ServiceStatus_Started)
 }
 
+func TestStopServicesAtomically_FailureStartsBackTheStoppedServices(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	stoppedServiceName := testServiceNameFromInt(1)
+	stoppedServiceUuid := testServiceUuidFromInt(1)
+	stoppedServiceIp := testIpFromInt(1)
+	stoppedServiceConfig := testServiceConfig(t, testContainerImageName)
+	stoppedServiceRegistration := service.NewServiceRegistration(stoppedServiceName, stoppedServiceUuid, enclaveName, stoppedServiceIp, string(stoppedServiceName))
+	stoppedServiceRegistration.SetStatus(service.ServiceStatus_Started)
+	stoppedServiceRegistration.SetConfig(stoppedServiceConfig)
+	stoppedServiceObj := service.NewService(stoppedServiceRegistration, map[string]*port_spec.PortSpec{}, stoppedServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+
+	failedServiceName := testServiceNameFromInt(2)
+	failedServiceUuid := testServiceUuidFromInt(2)
+	failedServiceRegistration := service.NewServiceRegistration(failedServiceName, failedServiceUuid, enclaveName, testIpFromInt(2), string(failedServiceName))
+	failedServiceRegistration.SetStatus(service.ServiceStatus_Started)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
+	)
+	require.Nil(t, err)
+	require.NoError(t, network.serviceRegistrationRepository.Save(stoppedServiceRegistration))
+	require.NoError(t, network.serviceRegistrationRepository.Save(failedServiceRegistration))
+
+	backend.EXPECT().StopUserServices(
+		ctx,
+		enclaveName,
+		&service.ServiceFilters{
+			Names: nil,
+			UUIDs: map[service.ServiceUUID]bool{
+				stoppedServiceUuid: true,
+				failedServiceUuid:  true,
+			},
+			Statuses: nil,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{
+			stoppedServiceUuid: true,
+		},
+		map[service.ServiceUUID]error{
+			failedServiceUuid: stacktrace.NewError("Failed stopping service"),
+		},
+		nil,
+	)
+	// Only the service that got stopped is started back
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]*service.ServiceConfig{
+			stoppedServiceUuid: stoppedServiceConfig,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			stoppedServiceUuid: stoppedServiceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	result := network.StopServicesAtomically(ctx, []string{string(stoppedServiceName), string(failedServiceName)})
+	require.False(t, result.IsApplied())
+	require.Len(t, result.GetFailedServices(), 1)
+	require.Contains(t, result.GetFailedServices()[string(failedServiceName)].Error(), "Failed stopping service")
+	require.Empty(t, result.GetFailedRollbacks())
+
+	stoppedServiceRegistrationAfterRollback, err := network.serviceRegistrationRepository.Get(stoppedServiceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, stoppedServiceRegistrationAfterRollback.GetStatus())
+}
+
+func TestStartServicesAtomically_UnknownServiceStartsNothing(t *testing.T) {
+	ctx := context.Background()
+	// No call to the backend is expected
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceName := testServiceNameFromInt(1)
+	serviceRegistration := service.NewServiceRegistration(serviceName, testServiceUuidFromInt(1), enclaveName, testIpFromInt(1), string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Stopped)
+	unknownServiceName := "unknown-service"
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
+	)
+	require.Nil(t, err)
+	require.NoError(t, network.serviceRegistrationRepository.Save(serviceRegistration))
+
+	result := network.StartServicesAtomically(ctx, []string{string(serviceName), unknownServiceName})
+	require.False(t, result.IsApplied())
+	require.Len(t, result.GetFailedServices(), 1)
+	require.Contains(t, result.GetFailedServices(), unknownServiceName)
+	require.Empty(t, result.GetFailedRollbacks())
+}
+
 func TestUpdateService(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -882,6 +1292,10 @@ func TestUpdateService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_quota.NewUnlimitedEnclaveQuota(),
+		nil,
+		nil,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -960,29 +1374,19 @@ func TestUpdateService(t *testing.T) {
 		nil,
 	)
 
-	// The services will then be re-created
+	// The services will then be re-created in a single batch
 	serviceObj := service.NewService(existingServiceRegistration, map[string]*port_spec.PortSpec{}, existingServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, "", nil, nil, nil))
 	backend.EXPECT().StartRegisteredUserServices(
 		ctx,
 		enclaveName,
 		map[service.ServiceUUID]*service.ServiceConfig{
-			existingServiceRegistration.GetUUID(): updatedServiceConfig,
+			existingServiceRegistration.GetUUID():            updatedServiceConfig,
+			failedToBeRecreatedServiceRegistration.GetUUID(): updatedServiceConfig,
 		},
 	).Times(1).Return(
 		map[service.ServiceUUID]*service.Service{
 			existingServiceRegistration.GetUUID(): serviceObj,
 		},
-		map[service.ServiceUUID]error{},
-		nil,
-	)
-	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
-		enclaveName,
-		map[service.ServiceUUID]*service.ServiceConfig{
-			failedToBeRecreatedServiceRegistration.GetUUID(): updatedServiceConfig,
-		},
-	).Times(1).Return(
-		map[service.ServiceUUID]*service.Service{},
 		map[service.ServiceUUID]error{
 			failedToBeRecreatedServiceRegistration.GetUUID(): stacktrace.NewError("Unable to re-create service"),
 		},
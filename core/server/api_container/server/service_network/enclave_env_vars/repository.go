@@ -0,0 +1,75 @@
+package enclave_env_vars
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	enclaveEnvVarsBucketName = []byte("enclave-env-vars-repository")
+)
+
+// EnclaveEnvVarsRepository stores the environment variables injected into every service of the enclave, keyed by
+// environment variable name
+type EnclaveEnvVarsRepository struct {
+	enclaveDb *enclave_db.EnclaveDB
+}
+
+func GetOrCreateNewEnclaveEnvVarsRepository(enclaveDb *enclave_db.EnclaveDB) (*EnclaveEnvVarsRepository, error) {
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(enclaveEnvVarsBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the enclave env vars database bucket")
+		}
+		logrus.Debugf("Enclave env vars bucket: '%+v'", bucket)
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the enclave env vars repository")
+	}
+
+	return &EnclaveEnvVarsRepository{
+		enclaveDb: enclaveDb,
+	}, nil
+}
+
+// Upsert stores the provided environment variables, overriding the value of the ones that were already set. Variables
+// that are not part of envVars are left untouched
+func (repository *EnclaveEnvVarsRepository) Upsert(envVars map[string]string) error {
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(enclaveEnvVarsBucketName)
+
+		for envVarName, envVarValue := range envVars {
+			if err := bucket.Put([]byte(envVarName), []byte(envVarValue)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while saving enclave env var '%s' into the enclave db bucket", envVarName)
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while saving enclave env vars into the enclave db")
+	}
+	return nil
+}
+
+func (repository *EnclaveEnvVarsRepository) GetAll() (map[string]string, error) {
+	envVars := map[string]string{}
+
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(enclaveEnvVarsBucketName)
+
+		if err := bucket.ForEach(func(envVarNameKey, envVarValueBytes []byte) error {
+			envVars[string(envVarNameKey)] = string(envVarValueBytes)
+			return nil
+		}); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while iterating the enclave env vars repository")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all enclave env vars from the enclave env vars repository")
+	}
+
+	return envVars, nil
+}
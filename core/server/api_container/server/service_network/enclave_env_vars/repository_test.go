@@ -0,0 +1,61 @@
+package enclave_env_vars
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"testing"
+)
+
+const (
+	chainIdEnvVarName     = "CHAIN_ID"
+	featureFlagEnvVarName = "FEATURE_FLAG"
+)
+
+func TestGetAll_EmptyRepository(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	envVars, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Empty(t, envVars)
+}
+
+func TestUpsert_OverridesExistingAndKeepsOthers(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.Upsert(map[string]string{
+		chainIdEnvVarName:     "1",
+		featureFlagEnvVarName: "false",
+	}))
+	require.NoError(t, repository.Upsert(map[string]string{
+		featureFlagEnvVarName: "true",
+	}))
+
+	envVars, err := repository.GetAll()
+	require.NoError(t, err)
+	expectedEnvVars := map[string]string{
+		chainIdEnvVarName:     "1",
+		featureFlagEnvVarName: "true",
+	}
+	require.Equal(t, expectedEnvVars, envVars)
+}
+
+func getRepositoryForTest(t *testing.T) *EnclaveEnvVarsRepository {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	enclaveDb := &enclave_db.EnclaveDB{
+		DB: db,
+	}
+	repository, err := GetOrCreateNewEnclaveEnvVarsRepository(enclaveDb)
+	require.NoError(t, err)
+
+	return repository
+}
@@ -0,0 +1,238 @@
+package log_alerts
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+)
+
+type Action string
+
+const (
+	// Action_Fail fails the run the alert fires during
+	Action_Fail Action = "fail"
+	// Action_Event reports the matching line in the output of the run the alert fires during
+	Action_Event Action = "event"
+	// Action_Task runs the task of the alert, and reports it like an event
+	Action_Task Action = "task"
+
+	// Log lines longer than this are cut, as a line logged without a newline could otherwise grow forever
+	maxLogLineSizeBytes = 1024 * 1024
+)
+
+var allActions = map[Action]bool{
+	Action_Fail:  true,
+	Action_Event: true,
+	Action_Task:  true,
+}
+
+func IsValidAction(action Action) bool {
+	return allActions[action]
+}
+
+// LogStreamer follows the logs of the given service from the start of its container, until the context is cancelled
+// or the service stops
+type LogStreamer func(ctx context.Context, serviceName service.ServiceName) (io.ReadCloser, error)
+
+// TaskRunner runs the task of an alert for the log line that fired it
+type TaskRunner func(ctx context.Context, logLine string) error
+
+// LogAlert fires whenever a log line of the service matches its regex
+type LogAlert struct {
+	serviceName     service.ServiceName
+	regex           *regexp.Regexp
+	action          Action
+	maybeTaskRunner TaskRunner
+}
+
+// NewLogAlert creates an alert; the task runner is only used, and required, by the task action
+func NewLogAlert(serviceName service.ServiceName, regex *regexp.Regexp, action Action, maybeTaskRunner TaskRunner) *LogAlert {
+	return &LogAlert{
+		serviceName:     serviceName,
+		regex:           regex,
+		action:          action,
+		maybeTaskRunner: maybeTaskRunner,
+	}
+}
+
+func (alert *LogAlert) GetServiceName() service.ServiceName {
+	return alert.serviceName
+}
+
+func (alert *LogAlert) GetRegex() *regexp.Regexp {
+	return alert.regex
+}
+
+func (alert *LogAlert) GetAction() Action {
+	return alert.action
+}
+
+// FiredLogAlert is a log line that matched an alert
+type FiredLogAlert struct {
+	alert   *LogAlert
+	logLine string
+
+	// Set if the task of the alert failed
+	maybeTaskErr error
+}
+
+func (firedAlert *FiredLogAlert) GetAlert() *LogAlert {
+	return firedAlert.alert
+}
+
+func (firedAlert *FiredLogAlert) GetLogLine() string {
+	return firedAlert.logLine
+}
+
+func (firedAlert *FiredLogAlert) GetMaybeTaskErr() error {
+	return firedAlert.maybeTaskErr
+}
+
+func (firedAlert *FiredLogAlert) String() string {
+	return fmt.Sprintf("Log alert '%s' of service '%s' fired on line: %s", firedAlert.alert.regex.String(), firedAlert.alert.serviceName, firedAlert.logLine)
+}
+
+// LogAlertWatcher follows the logs of the services that have alerts, running the tasks of the alerts that fire and
+// keeping the other fired alerts for the run being executed to report
+type LogAlertWatcher struct {
+	mutex sync.Mutex
+
+	streamer LogStreamer
+
+	// Stops following the logs of each service, for all its alerts
+	cancelWatchFuncs map[service.ServiceName][]context.CancelFunc
+
+	// Fired alerts are only kept while a run is being executed, as there's nothing to report them to otherwise
+	isReporting bool
+	firedAlerts []*FiredLogAlert
+
+	// Receives a value, without blocking, whenever alerts are kept for reporting
+	firedNotifications chan struct{}
+}
+
+func NewLogAlertWatcher(streamer LogStreamer) *LogAlertWatcher {
+	return &LogAlertWatcher{
+		mutex:              sync.Mutex{},
+		streamer:           streamer,
+		cancelWatchFuncs:   map[service.ServiceName][]context.CancelFunc{},
+		isReporting:        false,
+		firedAlerts:        nil,
+		firedNotifications: make(chan struct{}, 1),
+	}
+}
+
+// Watch starts following the logs of the service of the alert, from the start of its container, so lines logged
+// before the alert was added can fire it too
+func (watcher *LogAlertWatcher) Watch(alert *LogAlert) {
+	ctx, cancelWatch := context.WithCancel(context.Background())
+	watcher.mutex.Lock()
+	watcher.cancelWatchFuncs[alert.serviceName] = append(watcher.cancelWatchFuncs[alert.serviceName], cancelWatch)
+	watcher.mutex.Unlock()
+
+	go watcher.watch(ctx, alert)
+}
+
+// StopWatching stops following the logs of the service, e.g. because it's being removed
+func (watcher *LogAlertWatcher) StopWatching(serviceName service.ServiceName) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+	for _, cancelWatch := range watcher.cancelWatchFuncs[serviceName] {
+		cancelWatch()
+	}
+	delete(watcher.cancelWatchFuncs, serviceName)
+}
+
+// StartReporting keeps the alerts firing from now on until StopReporting is called
+func (watcher *LogAlertWatcher) StartReporting() {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+	watcher.isReporting = true
+	watcher.firedAlerts = nil
+}
+
+func (watcher *LogAlertWatcher) StopReporting() {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+	watcher.isReporting = false
+	watcher.firedAlerts = nil
+}
+
+// Fired receives a value when alerts fired since the last call to TakeFiredAlerts
+func (watcher *LogAlertWatcher) Fired() <-chan struct{} {
+	return watcher.firedNotifications
+}
+
+// TakeFiredAlerts returns the alerts that fired since the last call, in the order they fired
+func (watcher *LogAlertWatcher) TakeFiredAlerts() []*FiredLogAlert {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+	firedAlerts := watcher.firedAlerts
+	watcher.firedAlerts = nil
+	return firedAlerts
+}
+
+func (watcher *LogAlertWatcher) watch(ctx context.Context, alert *LogAlert) {
+	logStream, err := watcher.streamer(ctx, alert.serviceName)
+	if err != nil {
+		logrus.Errorf("An error occurred following the logs of service '%s' for log alert '%s'; the alert won't fire:\n%v", alert.serviceName, alert.regex.String(), err)
+		return
+	}
+	// Closing the stream is what unblocks reading it when the watch gets cancelled
+	streamEnded := make(chan struct{})
+	defer close(streamEnded)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-streamEnded:
+		}
+		if err := logStream.Close(); err != nil {
+			logrus.Debugf("An error occurred closing the log stream of service '%s':\n%v", alert.serviceName, err)
+		}
+	}()
+
+	scanner := bufio.NewScanner(logStream)
+	scanner.Buffer(nil, maxLogLineSizeBytes)
+	for scanner.Scan() {
+		logLine := scanner.Text()
+		if alert.regex.MatchString(logLine) {
+			watcher.fire(ctx, alert, logLine)
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		logrus.Warnf("An error occurred reading the logs of service '%s' for log alert '%s'; the alert won't fire anymore:\n%v", alert.serviceName, alert.regex.String(), err)
+	}
+}
+
+func (watcher *LogAlertWatcher) fire(ctx context.Context, alert *LogAlert, logLine string) {
+	firedAlert := &FiredLogAlert{
+		alert:        alert,
+		logLine:      logLine,
+		maybeTaskErr: nil,
+	}
+	if alert.action == Action_Task && alert.maybeTaskRunner != nil {
+		logrus.Infof("Running the task of log alert '%s' of service '%s'", alert.regex.String(), alert.serviceName)
+		if err := alert.maybeTaskRunner(ctx, logLine); err != nil {
+			logrus.Errorf("An error occurred running the task of log alert '%s' of service '%s':\n%v", alert.regex.String(), alert.serviceName, err)
+			firedAlert.maybeTaskErr = err
+		}
+	}
+
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+	if !watcher.isReporting {
+		logrus.Warnf("%s; no run is being executed to report it to", firedAlert.String())
+		return
+	}
+	watcher.firedAlerts = append(watcher.firedAlerts, firedAlert)
+	select {
+	case watcher.firedNotifications <- struct{}{}:
+	default:
+		// A notification is already pending, and it covers this alert too
+	}
+}
@@ -0,0 +1,89 @@
+package log_alerts
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName = service.ServiceName("test-service")
+
+	testLogs = "starting\nERROR: disk full\nrunning\nERROR: out of memory\n"
+
+	firedTimeout = 5 * time.Second
+)
+
+var testRegex = regexp.MustCompile("^ERROR")
+
+func newTestWatcher() *LogAlertWatcher {
+	return NewLogAlertWatcher(func(ctx context.Context, serviceName service.ServiceName) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(testLogs)), nil
+	})
+}
+
+func waitForFiredAlerts(t *testing.T, watcher *LogAlertWatcher, expectedNum int) []*FiredLogAlert {
+	firedAlerts := []*FiredLogAlert{}
+	for len(firedAlerts) < expectedNum {
+		select {
+		case <-watcher.Fired():
+			firedAlerts = append(firedAlerts, watcher.TakeFiredAlerts()...)
+		case <-time.After(firedTimeout):
+			require.FailNow(t, "Timed out waiting for the log alerts to fire", "Got %d of %d", len(firedAlerts), expectedNum)
+		}
+	}
+	return firedAlerts
+}
+
+func TestLogAlertWatcher_ReportsEachMatchingLine(t *testing.T) {
+	watcher := newTestWatcher()
+	watcher.StartReporting()
+	defer watcher.StopReporting()
+
+	watcher.Watch(NewLogAlert(testServiceName, testRegex, Action_Fail, nil))
+	firedAlerts := waitForFiredAlerts(t, watcher, 2)
+
+	require.Len(t, firedAlerts, 2)
+	require.Equal(t, "ERROR: disk full", firedAlerts[0].GetLogLine())
+	require.Equal(t, "ERROR: out of memory", firedAlerts[1].GetLogLine())
+	require.Equal(t, Action_Fail, firedAlerts[0].GetAlert().GetAction())
+	require.Equal(t, testServiceName, firedAlerts[0].GetAlert().GetServiceName())
+}
+
+func TestLogAlertWatcher_RunsTheTaskOfTheAlert(t *testing.T) {
+	watcher := newTestWatcher()
+	watcher.StartReporting()
+	defer watcher.StopReporting()
+
+	taskLogLines := make(chan string, 2)
+	watcher.Watch(NewLogAlert(testServiceName, testRegex, Action_Task, func(ctx context.Context, logLine string) error {
+		taskLogLines <- logLine
+		return nil
+	}))
+	firedAlerts := waitForFiredAlerts(t, watcher, 2)
+
+	require.Len(t, firedAlerts, 2)
+	require.NoError(t, firedAlerts[0].GetMaybeTaskErr())
+	require.Equal(t, "ERROR: disk full", <-taskLogLines)
+	require.Equal(t, "ERROR: out of memory", <-taskLogLines)
+}
+
+func TestLogAlertWatcher_DoesNotKeepAlertsFiredOutsideOfRuns(t *testing.T) {
+	watcher := newTestWatcher()
+
+	taskDone := make(chan struct{}, 2)
+	watcher.Watch(NewLogAlert(testServiceName, testRegex, Action_Task, func(ctx context.Context, logLine string) error {
+		taskDone <- struct{}{}
+		return nil
+	}))
+	<-taskDone
+	<-taskDone
+
+	require.Empty(t, watcher.TakeFiredAlerts())
+}
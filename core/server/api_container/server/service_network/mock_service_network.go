@@ -20,6 +20,16 @@ import (
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
+	service_dependencies "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_dependencies"
+
+	log_alerts "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/log_alerts"
+
+	crash_diagnostics "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/crash_diagnostics"
+
+	service_health "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+
+	service_events "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_events"
+
 	service_identifiers "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 )
 
@@ -157,6 +167,95 @@ func (_c *MockServiceNetwork_AddServices_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CollectCrashDiagnostics provides a mock function with given fields: serviceName, crashDumpPath
+func (_m *MockServiceNetwork) CollectCrashDiagnostics(serviceName service.ServiceName, crashDumpPath string) {
+	_m.Called(serviceName, crashDumpPath)
+}
+
+// MockServiceNetwork_CollectCrashDiagnostics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CollectCrashDiagnostics'
+type MockServiceNetwork_CollectCrashDiagnostics_Call struct {
+	*mock.Call
+}
+
+// CollectCrashDiagnostics is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+//   - crashDumpPath string
+func (_e *MockServiceNetwork_Expecter) CollectCrashDiagnostics(serviceName interface{}, crashDumpPath interface{}) *MockServiceNetwork_CollectCrashDiagnostics_Call {
+	return &MockServiceNetwork_CollectCrashDiagnostics_Call{Call: _e.mock.On("CollectCrashDiagnostics", serviceName, crashDumpPath)}
+}
+
+func (_c *MockServiceNetwork_CollectCrashDiagnostics_Call) Run(run func(serviceName service.ServiceName, crashDumpPath string)) *MockServiceNetwork_CollectCrashDiagnostics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CollectCrashDiagnostics_Call) Return() *MockServiceNetwork_CollectCrashDiagnostics_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServiceNetwork_CollectCrashDiagnostics_Call) RunAndReturn(run func(service.ServiceName, string)) *MockServiceNetwork_CollectCrashDiagnostics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyFilesFromImage provides a mock function with given fields: ctx, image, srcPath, artifactName
+func (_m *MockServiceNetwork) CopyFilesFromImage(ctx context.Context, image string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	ret := _m.Called(ctx, image, srcPath, artifactName)
+
+	var r0 enclave_data_directory.FilesArtifactUUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
+		return rf(ctx, image, srcPath, artifactName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) enclave_data_directory.FilesArtifactUUID); ok {
+		r0 = rf(ctx, image, srcPath, artifactName)
+	} else {
+		r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, image, srcPath, artifactName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_CopyFilesFromImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesFromImage'
+type MockServiceNetwork_CopyFilesFromImage_Call struct {
+	*mock.Call
+}
+
+// CopyFilesFromImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image string
+//   - srcPath string
+//   - artifactName string
+func (_e *MockServiceNetwork_Expecter) CopyFilesFromImage(ctx interface{}, image interface{}, srcPath interface{}, artifactName interface{}) *MockServiceNetwork_CopyFilesFromImage_Call {
+	return &MockServiceNetwork_CopyFilesFromImage_Call{Call: _e.mock.On("CopyFilesFromImage", ctx, image, srcPath, artifactName)}
+}
+
+func (_c *MockServiceNetwork_CopyFilesFromImage_Call) Run(run func(ctx context.Context, image string, srcPath string, artifactName string)) *MockServiceNetwork_CopyFilesFromImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesFromImage_Call) Return(_a0 enclave_data_directory.FilesArtifactUUID, _a1 error) *MockServiceNetwork_CopyFilesFromImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesFromImage_Call) RunAndReturn(run func(context.Context, string, string, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_CopyFilesFromImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyFilesFromService provides a mock function with given fields: ctx, serviceIdentifier, srcPath, artifactName
 func (_m *MockServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	ret := _m.Called(ctx, serviceIdentifier, srcPath, artifactName)
@@ -307,6 +406,59 @@ func (_c *MockServiceNetwork_GetApiContainerInfo_Call) RunAndReturn(run func() *
 	return _c
 }
 
+// GetEnclaveEnvVars provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetEnclaveEnvVars() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[string]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetEnclaveEnvVars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnclaveEnvVars'
+type MockServiceNetwork_GetEnclaveEnvVars_Call struct {
+	*mock.Call
+}
+
+// GetEnclaveEnvVars is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetEnclaveEnvVars() *MockServiceNetwork_GetEnclaveEnvVars_Call {
+	return &MockServiceNetwork_GetEnclaveEnvVars_Call{Call: _e.mock.On("GetEnclaveEnvVars")}
+}
+
+func (_c *MockServiceNetwork_GetEnclaveEnvVars_Call) Run(run func()) *MockServiceNetwork_GetEnclaveEnvVars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetEnclaveEnvVars_Call) Return(_a0 map[string]string, _a1 error) *MockServiceNetwork_GetEnclaveEnvVars_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetEnclaveEnvVars_Call) RunAndReturn(run func() (map[string]string, error)) *MockServiceNetwork_GetEnclaveEnvVars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEnclaveUuid provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetEnclaveUuid() enclave.EnclaveUUID {
 	ret := _m.Called()
@@ -469,6 +621,101 @@ func (_c *MockServiceNetwork_GetFilesArtifactMd5_Call) RunAndReturn(run func(str
 	return _c
 }
 
+// GetLastCrashReport provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) GetLastCrashReport(serviceName service.ServiceName) (crash_diagnostics.CrashReport, bool) {
+	ret := _m.Called(serviceName)
+
+	var r0 crash_diagnostics.CrashReport
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(service.ServiceName) (crash_diagnostics.CrashReport, bool)); ok {
+		return rf(serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName) crash_diagnostics.CrashReport); ok {
+		r0 = rf(serviceName)
+	} else {
+		r0 = ret.Get(0).(crash_diagnostics.CrashReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName) bool); ok {
+		r1 = rf(serviceName)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetLastCrashReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastCrashReport'
+type MockServiceNetwork_GetLastCrashReport_Call struct {
+	*mock.Call
+}
+
+// GetLastCrashReport is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetLastCrashReport(serviceName interface{}) *MockServiceNetwork_GetLastCrashReport_Call {
+	return &MockServiceNetwork_GetLastCrashReport_Call{Call: _e.mock.On("GetLastCrashReport", serviceName)}
+}
+
+func (_c *MockServiceNetwork_GetLastCrashReport_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_GetLastCrashReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetLastCrashReport_Call) Return(_a0 crash_diagnostics.CrashReport, _a1 bool) *MockServiceNetwork_GetLastCrashReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetLastCrashReport_Call) RunAndReturn(run func(service.ServiceName) (crash_diagnostics.CrashReport, bool)) *MockServiceNetwork_GetLastCrashReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLogAlertWatcher provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetLogAlertWatcher() *log_alerts.LogAlertWatcher {
+	ret := _m.Called()
+
+	var r0 *log_alerts.LogAlertWatcher
+	if rf, ok := ret.Get(0).(func() *log_alerts.LogAlertWatcher); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*log_alerts.LogAlertWatcher)
+		}
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_GetLogAlertWatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogAlertWatcher'
+type MockServiceNetwork_GetLogAlertWatcher_Call struct {
+	*mock.Call
+}
+
+// GetLogAlertWatcher is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetLogAlertWatcher() *MockServiceNetwork_GetLogAlertWatcher_Call {
+	return &MockServiceNetwork_GetLogAlertWatcher_Call{Call: _e.mock.On("GetLogAlertWatcher")}
+}
+
+func (_c *MockServiceNetwork_GetLogAlertWatcher_Call) Run(run func()) *MockServiceNetwork_GetLogAlertWatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetLogAlertWatcher_Call) Return(_a0 *log_alerts.LogAlertWatcher) *MockServiceNetwork_GetLogAlertWatcher_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetLogAlertWatcher_Call) RunAndReturn(run func() *log_alerts.LogAlertWatcher) *MockServiceNetwork_GetLogAlertWatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -524,6 +771,111 @@ func (_c *MockServiceNetwork_GetService_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetServiceDependencies provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetServiceDependencies() (map[service.ServiceName]*service_dependencies.ServiceDependencies, error) {
+	ret := _m.Called()
+
+	var r0 map[service.ServiceName]*service_dependencies.ServiceDependencies
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[service.ServiceName]*service_dependencies.ServiceDependencies, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[service.ServiceName]*service_dependencies.ServiceDependencies); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*service_dependencies.ServiceDependencies)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceDependencies'
+type MockServiceNetwork_GetServiceDependencies_Call struct {
+	*mock.Call
+}
+
+// GetServiceDependencies is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetServiceDependencies() *MockServiceNetwork_GetServiceDependencies_Call {
+	return &MockServiceNetwork_GetServiceDependencies_Call{Call: _e.mock.On("GetServiceDependencies")}
+}
+
+func (_c *MockServiceNetwork_GetServiceDependencies_Call) Run(run func()) *MockServiceNetwork_GetServiceDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceDependencies_Call) Return(_a0 map[service.ServiceName]*service_dependencies.ServiceDependencies, _a1 error) *MockServiceNetwork_GetServiceDependencies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceDependencies_Call) RunAndReturn(run func() (map[service.ServiceName]*service_dependencies.ServiceDependencies, error)) *MockServiceNetwork_GetServiceDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceHealth provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) GetServiceHealth(serviceName service.ServiceName) (service_health.ServiceHealth, bool) {
+	ret := _m.Called(serviceName)
+
+	var r0 service_health.ServiceHealth
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(service.ServiceName) (service_health.ServiceHealth, bool)); ok {
+		return rf(serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName) service_health.ServiceHealth); ok {
+		r0 = rf(serviceName)
+	} else {
+		r0 = ret.Get(0).(service_health.ServiceHealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName) bool); ok {
+		r1 = rf(serviceName)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceHealth'
+type MockServiceNetwork_GetServiceHealth_Call struct {
+	*mock.Call
+}
+
+// GetServiceHealth is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetServiceHealth(serviceName interface{}) *MockServiceNetwork_GetServiceHealth_Call {
+	return &MockServiceNetwork_GetServiceHealth_Call{Call: _e.mock.On("GetServiceHealth", serviceName)}
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) Return(_a0 service_health.ServiceHealth, _a1 bool) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) RunAndReturn(run func(service.ServiceName) (service_health.ServiceHealth, bool)) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServiceNames provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetServiceNames() (map[service.ServiceName]bool, error) {
 	ret := _m.Called()
@@ -743,6 +1095,74 @@ func (_c *MockServiceNetwork_HttpRequestService_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// MarkServiceReady provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) MarkServiceReady(serviceName service.ServiceName) {
+	_m.Called(serviceName)
+}
+
+// MockServiceNetwork_MarkServiceReady_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkServiceReady'
+type MockServiceNetwork_MarkServiceReady_Call struct {
+	*mock.Call
+}
+
+// MarkServiceReady is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) MarkServiceReady(serviceName interface{}) *MockServiceNetwork_MarkServiceReady_Call {
+	return &MockServiceNetwork_MarkServiceReady_Call{Call: _e.mock.On("MarkServiceReady", serviceName)}
+}
+
+func (_c *MockServiceNetwork_MarkServiceReady_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_MarkServiceReady_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_MarkServiceReady_Call) Return() *MockServiceNetwork_MarkServiceReady_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServiceNetwork_MarkServiceReady_Call) RunAndReturn(run func(service.ServiceName)) *MockServiceNetwork_MarkServiceReady_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MonitorServiceHealth provides a mock function with given fields: serviceName, probe, restartPolicy
+func (_m *MockServiceNetwork) MonitorServiceHealth(serviceName service.ServiceName, probe service_health.HealthProbe, restartPolicy service_health.RestartPolicy) {
+	_m.Called(serviceName, probe, restartPolicy)
+}
+
+// MockServiceNetwork_MonitorServiceHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MonitorServiceHealth'
+type MockServiceNetwork_MonitorServiceHealth_Call struct {
+	*mock.Call
+}
+
+// MonitorServiceHealth is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+//   - probe service_health.HealthProbe
+//   - restartPolicy service_health.RestartPolicy
+func (_e *MockServiceNetwork_Expecter) MonitorServiceHealth(serviceName interface{}, probe interface{}, restartPolicy interface{}) *MockServiceNetwork_MonitorServiceHealth_Call {
+	return &MockServiceNetwork_MonitorServiceHealth_Call{Call: _e.mock.On("MonitorServiceHealth", serviceName, probe, restartPolicy)}
+}
+
+func (_c *MockServiceNetwork_MonitorServiceHealth_Call) Run(run func(serviceName service.ServiceName, probe service_health.HealthProbe, restartPolicy service_health.RestartPolicy)) *MockServiceNetwork_MonitorServiceHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName), args[1].(service_health.HealthProbe), args[2].(service_health.RestartPolicy))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_MonitorServiceHealth_Call) Return() *MockServiceNetwork_MonitorServiceHealth_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServiceNetwork_MonitorServiceHealth_Call) RunAndReturn(run func(service.ServiceName, service_health.HealthProbe, service_health.RestartPolicy)) *MockServiceNetwork_MonitorServiceHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error) {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -796,6 +1216,51 @@ func (_c *MockServiceNetwork_RemoveService_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// RemoveServicesAtomically provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) RemoveServicesAtomically(ctx context.Context, serviceIdentifiers []string) *BulkServiceOperationResult {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 *BulkServiceOperationResult
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *BulkServiceOperationResult); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*BulkServiceOperationResult)
+		}
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_RemoveServicesAtomically_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveServicesAtomically'
+type MockServiceNetwork_RemoveServicesAtomically_Call struct {
+	*mock.Call
+}
+
+// RemoveServicesAtomically is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers []string
+func (_e *MockServiceNetwork_Expecter) RemoveServicesAtomically(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_RemoveServicesAtomically_Call {
+	return &MockServiceNetwork_RemoveServicesAtomically_Call{Call: _e.mock.On("RemoveServicesAtomically", ctx, serviceIdentifiers)}
+}
+
+func (_c *MockServiceNetwork_RemoveServicesAtomically_Call) Run(run func(ctx context.Context, serviceIdentifiers []string)) *MockServiceNetwork_RemoveServicesAtomically_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveServicesAtomically_Call) Return(_a0 *BulkServiceOperationResult) *MockServiceNetwork_RemoveServicesAtomically_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveServicesAtomically_Call) RunAndReturn(run func(context.Context, []string) *BulkServiceOperationResult) *MockServiceNetwork_RemoveServicesAtomically_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RenderTemplates provides a mock function with given fields: templatesAndDataByDestinationRelFilepath, artifactName
 func (_m *MockServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	ret := _m.Called(templatesAndDataByDestinationRelFilepath, artifactName)
@@ -969,6 +1434,90 @@ func (_c *MockServiceNetwork_RunExecs_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// SaveServiceDependencies provides a mock function with given fields: dependencies
+func (_m *MockServiceNetwork) SaveServiceDependencies(dependencies *service_dependencies.ServiceDependencies) error {
+	ret := _m.Called(dependencies)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*service_dependencies.ServiceDependencies) error); ok {
+		r0 = rf(dependencies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SaveServiceDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveServiceDependencies'
+type MockServiceNetwork_SaveServiceDependencies_Call struct {
+	*mock.Call
+}
+
+// SaveServiceDependencies is a helper method to define mock.On call
+//   - dependencies *service_dependencies.ServiceDependencies
+func (_e *MockServiceNetwork_Expecter) SaveServiceDependencies(dependencies interface{}) *MockServiceNetwork_SaveServiceDependencies_Call {
+	return &MockServiceNetwork_SaveServiceDependencies_Call{Call: _e.mock.On("SaveServiceDependencies", dependencies)}
+}
+
+func (_c *MockServiceNetwork_SaveServiceDependencies_Call) Run(run func(dependencies *service_dependencies.ServiceDependencies)) *MockServiceNetwork_SaveServiceDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*service_dependencies.ServiceDependencies))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SaveServiceDependencies_Call) Return(_a0 error) *MockServiceNetwork_SaveServiceDependencies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SaveServiceDependencies_Call) RunAndReturn(run func(*service_dependencies.ServiceDependencies) error) *MockServiceNetwork_SaveServiceDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnclaveEnvVars provides a mock function with given fields: envVars
+func (_m *MockServiceNetwork) SetEnclaveEnvVars(envVars map[string]string) error {
+	ret := _m.Called(envVars)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string) error); ok {
+		r0 = rf(envVars)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SetEnclaveEnvVars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnclaveEnvVars'
+type MockServiceNetwork_SetEnclaveEnvVars_Call struct {
+	*mock.Call
+}
+
+// SetEnclaveEnvVars is a helper method to define mock.On call
+//   - envVars map[string]string
+func (_e *MockServiceNetwork_Expecter) SetEnclaveEnvVars(envVars interface{}) *MockServiceNetwork_SetEnclaveEnvVars_Call {
+	return &MockServiceNetwork_SetEnclaveEnvVars_Call{Call: _e.mock.On("SetEnclaveEnvVars", envVars)}
+}
+
+func (_c *MockServiceNetwork_SetEnclaveEnvVars_Call) Run(run func(envVars map[string]string)) *MockServiceNetwork_SetEnclaveEnvVars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetEnclaveEnvVars_Call) Return(_a0 error) *MockServiceNetwork_SetEnclaveEnvVars_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetEnclaveEnvVars_Call) RunAndReturn(run func(map[string]string) error) *MockServiceNetwork_SetEnclaveEnvVars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) StartService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -1076,6 +1625,51 @@ func (_c *MockServiceNetwork_StartServices_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// StartServicesAtomically provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) StartServicesAtomically(ctx context.Context, serviceIdentifiers []string) *BulkServiceOperationResult {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 *BulkServiceOperationResult
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *BulkServiceOperationResult); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*BulkServiceOperationResult)
+		}
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_StartServicesAtomically_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartServicesAtomically'
+type MockServiceNetwork_StartServicesAtomically_Call struct {
+	*mock.Call
+}
+
+// StartServicesAtomically is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers []string
+func (_e *MockServiceNetwork_Expecter) StartServicesAtomically(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_StartServicesAtomically_Call {
+	return &MockServiceNetwork_StartServicesAtomically_Call{Call: _e.mock.On("StartServicesAtomically", ctx, serviceIdentifiers)}
+}
+
+func (_c *MockServiceNetwork_StartServicesAtomically_Call) Run(run func(ctx context.Context, serviceIdentifiers []string)) *MockServiceNetwork_StartServicesAtomically_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_StartServicesAtomically_Call) Return(_a0 *BulkServiceOperationResult) *MockServiceNetwork_StartServicesAtomically_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_StartServicesAtomically_Call) RunAndReturn(run func(context.Context, []string) *BulkServiceOperationResult) *MockServiceNetwork_StartServicesAtomically_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StopService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) StopService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -1183,6 +1777,106 @@ func (_c *MockServiceNetwork_StopServices_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// StopServicesAtomically provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) StopServicesAtomically(ctx context.Context, serviceIdentifiers []string) *BulkServiceOperationResult {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 *BulkServiceOperationResult
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *BulkServiceOperationResult); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*BulkServiceOperationResult)
+		}
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_StopServicesAtomically_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopServicesAtomically'
+type MockServiceNetwork_StopServicesAtomically_Call struct {
+	*mock.Call
+}
+
+// StopServicesAtomically is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers []string
+func (_e *MockServiceNetwork_Expecter) StopServicesAtomically(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_StopServicesAtomically_Call {
+	return &MockServiceNetwork_StopServicesAtomically_Call{Call: _e.mock.On("StopServicesAtomically", ctx, serviceIdentifiers)}
+}
+
+func (_c *MockServiceNetwork_StopServicesAtomically_Call) Run(run func(ctx context.Context, serviceIdentifiers []string)) *MockServiceNetwork_StopServicesAtomically_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_StopServicesAtomically_Call) Return(_a0 *BulkServiceOperationResult) *MockServiceNetwork_StopServicesAtomically_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_StopServicesAtomically_Call) RunAndReturn(run func(context.Context, []string) *BulkServiceOperationResult) *MockServiceNetwork_StopServicesAtomically_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeToServiceEvents provides a mock function with given fields:
+func (_m *MockServiceNetwork) SubscribeToServiceEvents() (<-chan *service_events.ServiceEvent, func()) {
+	ret := _m.Called()
+
+	var r0 <-chan *service_events.ServiceEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan *service_events.ServiceEvent, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan *service_events.ServiceEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *service_events.ServiceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_SubscribeToServiceEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeToServiceEvents'
+type MockServiceNetwork_SubscribeToServiceEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeToServiceEvents is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) SubscribeToServiceEvents() *MockServiceNetwork_SubscribeToServiceEvents_Call {
+	return &MockServiceNetwork_SubscribeToServiceEvents_Call{Call: _e.mock.On("SubscribeToServiceEvents")}
+}
+
+func (_c *MockServiceNetwork_SubscribeToServiceEvents_Call) Run(run func()) *MockServiceNetwork_SubscribeToServiceEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SubscribeToServiceEvents_Call) Return(_a0 <-chan *service_events.ServiceEvent, _a1 func()) *MockServiceNetwork_SubscribeToServiceEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SubscribeToServiceEvents_Call) RunAndReturn(run func() (<-chan *service_events.ServiceEvent, func())) *MockServiceNetwork_SubscribeToServiceEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateFilesArtifact provides a mock function with given fields: fileArtifactUuid, updatedContent, contentMd5
 func (_m *MockServiceNetwork) UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte) error {
 	ret := _m.Called(fileArtifactUuid, updatedContent, contentMd5)
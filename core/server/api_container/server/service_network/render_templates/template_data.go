@@ -5,6 +5,7 @@ import (
 	"crypto/md5"
 	"encoding/json"
 	"fmt"
+	"github.com/Masterminds/sprig/v3"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/stacktrace"
@@ -19,7 +20,9 @@ const (
 )
 
 type TemplateData struct {
-	template *template.Template
+	// The parsed template isn't kept around as the sprig function map makes it impossible to compare two TemplateData
+	templateName   string
+	templateString string
 
 	dataAsSerializedJson string
 }
@@ -29,13 +32,14 @@ func CreateTemplateData(templateString string, dataAsSerializedJson string) (*Te
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error generating unique template name")
 	}
-	parsedTemplate, err := template.New(templateName).Parse(templateString)
-	if err != nil {
+	// Parsing upfront surfaces malformed templates before anything gets rendered
+	if _, err := parseTemplate(templateName, templateString); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred parsing the template string '%s'", templateString)
 	}
 
 	return &TemplateData{
-		template:             parsedTemplate,
+		templateName:         templateName,
+		templateString:       templateString,
 		dataAsSerializedJson: dataAsSerializedJson,
 	}, nil
 }
@@ -65,13 +69,18 @@ func (templateData *TemplateData) RenderToFile(destinationAbsoluteFilePath strin
 		return stacktrace.Propagate(err, "There was an error in creating the parent directory '%s' to write the file '%s' into.", destinationFileDir, destinationAbsoluteFilePath)
 	}
 
+	parsedTemplate, err := parseTemplate(templateData.templateName, templateData.templateString)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the template string '%s'", templateData.templateString)
+	}
+
 	renderedTemplateFile, err := os.Create(destinationAbsoluteFilePath)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while creating temporary file to render template into for file '%s'.", destinationAbsoluteFilePath)
 	}
 	defer renderedTemplateFile.Close()
 
-	if err = templateData.template.Execute(renderedTemplateFile, decodedData); err != nil {
+	if err = parsedTemplate.Execute(renderedTemplateFile, decodedData); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while writing the rendered template to destination '%s'", destinationAbsoluteFilePath)
 	}
 	return nil
@@ -94,6 +103,22 @@ func decodeJsonString(dataAsSerializedJson string) (interface{}, error) {
 	return decodedData, nil
 }
 
+// The sprig functions reading the environment of the API container, whose args hold the credentials of the enclave,
+// are left out like Helm does
+var sprigFunctionNamesNotAvailable = []string{
+	"env",
+	"expandenv",
+}
+
+// parseTemplate makes the sprig functions (default, toJson, b64enc, randAlphaNum, ...) available to every template
+func parseTemplate(templateName string, templateString string) (*template.Template, error) {
+	parsedTemplate, err := template.New(templateName).Funcs(getTemplateFuncMap()).Parse(templateString)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing template '%s'", templateName)
+	}
+	return parsedTemplate, nil
+}
+
 func generateUniqueTemplateName(templateString string) (string, error) {
 	md5Hash := md5.New()
 	if _, err := md5Hash.Write([]byte(templateString)); err != nil {
@@ -103,3 +128,14 @@ func generateUniqueTemplateName(templateString string) (string, error) {
 	templateName := fmt.Sprintf("%s%s", templateNamePrefix, templateStringHash)
 	return templateName, nil
 }
+
+func getTemplateFuncMap() template.FuncMap {
+	funcMap := template.FuncMap{}
+	for funcName, function := range sprig.TxtFuncMap() {
+		funcMap[funcName] = function
+	}
+	for _, funcName := range sprigFunctionNamesNotAvailable {
+		delete(funcMap, funcName)
+	}
+	return funcMap
+}
@@ -0,0 +1,36 @@
+package render_templates
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestRenderToFile_SprigFunctions(t *testing.T) {
+	templateData, err := CreateTemplateData(
+		`{{ .Name | default "anonymous" }} {{ .Greeting | b64enc }} {{ .Numbers | toJson }} {{ randAlphaNum 8 | len }}`,
+		`{"Name":"","Greeting":"hello","Numbers":[1,2,3]}`,
+	)
+	require.NoError(t, err)
+
+	destinationFilePath := path.Join(t.TempDir(), "nested", "output.txt")
+	require.NoError(t, templateData.RenderToFile(destinationFilePath))
+
+	renderedContent, err := os.ReadFile(destinationFilePath)
+	require.NoError(t, err)
+	require.Equal(t, "anonymous aGVsbG8= [1,2,3] 8", string(renderedContent))
+}
+
+func TestCreateTemplateData_FailsOnUnknownFunction(t *testing.T) {
+	_, err := CreateTemplateData(`{{ .Name | notARealFunction }}`, `{"Name":"John"}`)
+	require.Error(t, err)
+}
+
+func TestCreateTemplateData_FailsOnEnvironmentFunctions(t *testing.T) {
+	_, err := CreateTemplateData(`{{ env "SERIALIZED_ARGS" }}`, `{}`)
+	require.Error(t, err)
+
+	_, err = CreateTemplateData(`{{ expandenv "$SERIALIZED_ARGS" }}`, `{}`)
+	require.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package service_dependencies
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	serviceDependenciesBucketName = []byte("service-dependencies-repository")
+)
+
+// ServiceDependenciesRepository stores the dependencies of every service currently in the enclave, keyed by service name
+type ServiceDependenciesRepository struct {
+	enclaveDb *enclave_db.EnclaveDB
+}
+
+func GetOrCreateNewServiceDependenciesRepository(enclaveDb *enclave_db.EnclaveDB) (*ServiceDependenciesRepository, error) {
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(serviceDependenciesBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the service dependencies database bucket")
+		}
+		logrus.Debugf("Service dependencies bucket: '%+v'", bucket)
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the service dependencies repository")
+	}
+
+	return &ServiceDependenciesRepository{
+		enclaveDb: enclaveDb,
+	}, nil
+}
+
+// Save stores the dependencies of a service, replacing any previously stored ones
+func (repository *ServiceDependenciesRepository) Save(dependencies *ServiceDependencies) error {
+	serviceName := dependencies.GetServiceName()
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceDependenciesBucketName)
+
+		jsonBytes, err := json.Marshal(dependencies)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred marshalling dependencies for service '%s'", serviceName)
+		}
+
+		if err := bucket.Put([]byte(serviceName), jsonBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving dependencies for service '%s' into the enclave db bucket", serviceName)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while saving dependencies for service '%s' into the enclave db", serviceName)
+	}
+	return nil
+}
+
+func (repository *ServiceDependenciesRepository) GetAll() (map[service.ServiceName]*ServiceDependencies, error) {
+	allDependencies := map[service.ServiceName]*ServiceDependencies{}
+
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceDependenciesBucketName)
+
+		if err := bucket.ForEach(func(serviceNameKey, dependenciesBytes []byte) error {
+			serviceName := service.ServiceName(serviceNameKey)
+			dependencies := &ServiceDependencies{
+				serviceName:              "",
+				explicitDependencies:     nil,
+				runtimeValueDependencies: nil,
+				hasReadyConditions:       false,
+			}
+			if err := json.Unmarshal(dependenciesBytes, dependencies); err != nil {
+				return stacktrace.Propagate(err, "An error occurred unmarshalling dependencies for service '%s'", serviceName)
+			}
+			allDependencies[serviceName] = dependencies
+			return nil
+		}); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while iterating the service dependencies repository")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting all service dependencies from the service dependencies repository")
+	}
+
+	return allDependencies, nil
+}
+
+func (repository *ServiceDependenciesRepository) Delete(serviceName service.ServiceName) error {
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceDependenciesBucketName)
+
+		if err := bucket.Delete([]byte(serviceName)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting the dependencies of service '%s' from the service dependencies bucket", serviceName)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while deleting the dependencies of service '%s' from the service dependencies repository", serviceName)
+	}
+	return nil
+}
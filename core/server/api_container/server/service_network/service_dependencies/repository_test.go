@@ -0,0 +1,83 @@
+package service_dependencies
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"testing"
+)
+
+const (
+	databaseServiceName = service.ServiceName("database")
+	apiServiceName      = service.ServiceName("api")
+	frontendServiceName = service.ServiceName("frontend")
+)
+
+func TestSaveAndGetAll_Success(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	databaseDependencies := NewServiceDependencies(databaseServiceName, nil, nil, true)
+	apiDependencies := NewServiceDependencies(apiServiceName, nil, []service.ServiceName{databaseServiceName}, false)
+	frontendDependencies := NewServiceDependencies(frontendServiceName, []service.ServiceName{apiServiceName}, nil, false)
+
+	require.NoError(t, repository.Save(databaseDependencies))
+	require.NoError(t, repository.Save(apiDependencies))
+	require.NoError(t, repository.Save(frontendDependencies))
+
+	allDependencies, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Len(t, allDependencies, 3)
+	require.Equal(t, databaseDependencies, allDependencies[databaseServiceName])
+	require.Equal(t, apiDependencies, allDependencies[apiServiceName])
+	require.Equal(t, frontendDependencies, allDependencies[frontendServiceName])
+}
+
+func TestSave_OverridesPreviousDependencies(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.Save(NewServiceDependencies(apiServiceName, nil, []service.ServiceName{databaseServiceName}, false)))
+	updatedApiDependencies := NewServiceDependencies(apiServiceName, []service.ServiceName{frontendServiceName}, nil, true)
+	require.NoError(t, repository.Save(updatedApiDependencies))
+
+	allDependencies, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Len(t, allDependencies, 1)
+	require.Equal(t, updatedApiDependencies, allDependencies[apiServiceName])
+}
+
+func TestDelete_Success(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.Save(NewServiceDependencies(databaseServiceName, nil, nil, false)))
+	require.NoError(t, repository.Save(NewServiceDependencies(apiServiceName, nil, []service.ServiceName{databaseServiceName}, false)))
+
+	require.NoError(t, repository.Delete(databaseServiceName))
+	// deleting a service without stored dependencies is a no-op
+	require.NoError(t, repository.Delete(frontendServiceName))
+
+	allDependencies, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Len(t, allDependencies, 1)
+	require.Contains(t, allDependencies, apiServiceName)
+}
+
+func getRepositoryForTest(t *testing.T) *ServiceDependenciesRepository {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	enclaveDb := &enclave_db.EnclaveDB{
+		DB: db,
+	}
+	repository, err := GetOrCreateNewServiceDependenciesRepository(enclaveDb)
+	require.NoError(t, err)
+
+	return repository
+}
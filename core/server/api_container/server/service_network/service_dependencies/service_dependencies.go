@@ -0,0 +1,83 @@
+package service_dependencies
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ServiceDependencies holds the dependencies a service declared, or implicitly got, when it was added to the enclave
+type ServiceDependencies struct {
+	serviceName service.ServiceName
+
+	// services explicitly listed in the `depends_on` attribute of the service config
+	explicitDependencies []service.ServiceName
+
+	// services whose runtime values (IP address, hostname, ports...) are referenced in the service config
+	runtimeValueDependencies []service.ServiceName
+
+	hasReadyConditions bool
+}
+
+type serviceDependenciesJson struct {
+	ServiceName              service.ServiceName   `json:"service_name"`
+	ExplicitDependencies     []service.ServiceName `json:"explicit_dependencies"`
+	RuntimeValueDependencies []service.ServiceName `json:"runtime_value_dependencies"`
+	HasReadyConditions       bool                  `json:"has_ready_conditions"`
+}
+
+func NewServiceDependencies(
+	serviceName service.ServiceName,
+	explicitDependencies []service.ServiceName,
+	runtimeValueDependencies []service.ServiceName,
+	hasReadyConditions bool,
+) *ServiceDependencies {
+	return &ServiceDependencies{
+		serviceName:              serviceName,
+		explicitDependencies:     explicitDependencies,
+		runtimeValueDependencies: runtimeValueDependencies,
+		hasReadyConditions:       hasReadyConditions,
+	}
+}
+
+func (dependencies *ServiceDependencies) GetServiceName() service.ServiceName {
+	return dependencies.serviceName
+}
+
+func (dependencies *ServiceDependencies) GetExplicitDependencies() []service.ServiceName {
+	return dependencies.explicitDependencies
+}
+
+func (dependencies *ServiceDependencies) GetRuntimeValueDependencies() []service.ServiceName {
+	return dependencies.runtimeValueDependencies
+}
+
+func (dependencies *ServiceDependencies) HasReadyConditions() bool {
+	return dependencies.hasReadyConditions
+}
+
+func (dependencies *ServiceDependencies) MarshalJSON() ([]byte, error) {
+	return json.Marshal(serviceDependenciesJson{
+		ServiceName:              dependencies.serviceName,
+		ExplicitDependencies:     dependencies.explicitDependencies,
+		RuntimeValueDependencies: dependencies.runtimeValueDependencies,
+		HasReadyConditions:       dependencies.hasReadyConditions,
+	})
+}
+
+func (dependencies *ServiceDependencies) UnmarshalJSON(data []byte) error {
+	unmarshalled := &serviceDependenciesJson{
+		ServiceName:              "",
+		ExplicitDependencies:     nil,
+		RuntimeValueDependencies: nil,
+		HasReadyConditions:       false,
+	}
+	if err := json.Unmarshal(data, unmarshalled); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling service dependencies")
+	}
+	dependencies.serviceName = unmarshalled.ServiceName
+	dependencies.explicitDependencies = unmarshalled.ExplicitDependencies
+	dependencies.runtimeValueDependencies = unmarshalled.RuntimeValueDependencies
+	dependencies.hasReadyConditions = unmarshalled.HasReadyConditions
+	return nil
+}
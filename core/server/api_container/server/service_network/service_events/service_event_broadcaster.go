@@ -0,0 +1,119 @@
+package service_events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+)
+
+type EventType string
+
+const (
+	// EventType_Started is sent when the container of the service started, including after an update or a restart
+	EventType_Started EventType = "started"
+	// EventType_Ready is sent when the service passed its ready conditions, or right after it started if it has none
+	EventType_Ready EventType = "ready"
+	// EventType_Stopped is sent when the service was stopped or removed
+	EventType_Stopped EventType = "stopped"
+	// EventType_Crashed is sent when the container of the service exited with an error or ran out of memory
+	EventType_Crashed EventType = "crashed"
+
+	// A subscriber falling further behind than this misses events rather than blocking the service network
+	subscriptionBufferSize = 256
+)
+
+// ServiceEvent is a change in the lifecycle of a service of the enclave
+type ServiceEvent struct {
+	serviceName service.ServiceName
+	serviceUuid service.ServiceUUID
+	eventType   EventType
+	timestamp   time.Time
+	// Details about the event, e.g. the exit code of a crashed service; empty if there are none
+	message string
+}
+
+func NewServiceEvent(serviceName service.ServiceName, serviceUuid service.ServiceUUID, eventType EventType, message string) *ServiceEvent {
+	return &ServiceEvent{
+		serviceName: serviceName,
+		serviceUuid: serviceUuid,
+		eventType:   eventType,
+		timestamp:   time.Now(),
+		message:     message,
+	}
+}
+
+func (event *ServiceEvent) GetServiceName() service.ServiceName {
+	return event.serviceName
+}
+
+func (event *ServiceEvent) GetServiceUuid() service.ServiceUUID {
+	return event.serviceUuid
+}
+
+func (event *ServiceEvent) GetEventType() EventType {
+	return event.eventType
+}
+
+func (event *ServiceEvent) GetTimestamp() time.Time {
+	return event.timestamp
+}
+
+func (event *ServiceEvent) GetMessage() string {
+	return event.message
+}
+
+// ServiceEventBroadcaster sends the events of the services to everyone subscribed to them, from the moment they
+// subscribed; events aren't kept for later subscribers
+type ServiceEventBroadcaster struct {
+	mutex sync.Mutex
+
+	subscriptions map[uint64]chan *ServiceEvent
+
+	nextSubscriptionId uint64
+}
+
+func NewServiceEventBroadcaster() *ServiceEventBroadcaster {
+	return &ServiceEventBroadcaster{
+		mutex:              sync.Mutex{},
+		subscriptions:      map[uint64]chan *ServiceEvent{},
+		nextSubscriptionId: 0,
+	}
+}
+
+// Subscribe returns the channel receiving the events published from now on, and the function to call to stop
+// receiving them, which closes the channel
+func (broadcaster *ServiceEventBroadcaster) Subscribe() (<-chan *ServiceEvent, func()) {
+	broadcaster.mutex.Lock()
+	defer broadcaster.mutex.Unlock()
+	subscriptionId := broadcaster.nextSubscriptionId
+	broadcaster.nextSubscriptionId++
+	events := make(chan *ServiceEvent, subscriptionBufferSize)
+	broadcaster.subscriptions[subscriptionId] = events
+
+	unsubscribe := func() {
+		broadcaster.mutex.Lock()
+		defer broadcaster.mutex.Unlock()
+		if _, found := broadcaster.subscriptions[subscriptionId]; !found {
+			return
+		}
+		delete(broadcaster.subscriptions, subscriptionId)
+		close(events)
+	}
+	return events, unsubscribe
+}
+
+// Publish sends the event to all the subscriptions without blocking, so it can be called while holding the lock of
+// the service network
+func (broadcaster *ServiceEventBroadcaster) Publish(event *ServiceEvent) {
+	broadcaster.mutex.Lock()
+	defer broadcaster.mutex.Unlock()
+	for _, events := range broadcaster.subscriptions {
+		select {
+		case events <- event:
+		default:
+			logrus.Warnf("A subscriber to the service events is too far behind; it missed the '%s' event of service '%s'", event.eventType, event.serviceName)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package service_events
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName = service.ServiceName("test-service")
+	testServiceUuid = service.ServiceUUID("cddc2ea3948149d9afa2ef93abb4ec56")
+)
+
+func TestServiceEventBroadcaster_SendsEventsToAllSubscriptions(t *testing.T) {
+	broadcaster := NewServiceEventBroadcaster()
+	firstEvents, unsubscribeFirst := broadcaster.Subscribe()
+	defer unsubscribeFirst()
+	secondEvents, unsubscribeSecond := broadcaster.Subscribe()
+	defer unsubscribeSecond()
+
+	broadcaster.Publish(NewServiceEvent(testServiceName, testServiceUuid, EventType_Started, ""))
+	broadcaster.Publish(NewServiceEvent(testServiceName, testServiceUuid, EventType_Crashed, "exit code 137"))
+
+	for _, events := range []<-chan *ServiceEvent{firstEvents, secondEvents} {
+		startedEvent := <-events
+		require.Equal(t, EventType_Started, startedEvent.GetEventType())
+		require.Equal(t, testServiceName, startedEvent.GetServiceName())
+		require.Equal(t, testServiceUuid, startedEvent.GetServiceUuid())
+		crashedEvent := <-events
+		require.Equal(t, EventType_Crashed, crashedEvent.GetEventType())
+		require.Equal(t, "exit code 137", crashedEvent.GetMessage())
+	}
+}
+
+func TestServiceEventBroadcaster_UnsubscribeClosesTheChannel(t *testing.T) {
+	broadcaster := NewServiceEventBroadcaster()
+	events, unsubscribe := broadcaster.Subscribe()
+	unsubscribe()
+	// Unsubscribing twice, e.g. in a defer after an early one, is harmless
+	unsubscribe()
+
+	broadcaster.Publish(NewServiceEvent(testServiceName, testServiceUuid, EventType_Stopped, ""))
+	_, isOpen := <-events
+	require.False(t, isOpen)
+}
+
+func TestServiceEventBroadcaster_DoesNotBlockOnSlowSubscriptions(t *testing.T) {
+	broadcaster := NewServiceEventBroadcaster()
+	events, unsubscribe := broadcaster.Subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < subscriptionBufferSize+1; i++ {
+		broadcaster.Publish(NewServiceEvent(testServiceName, testServiceUuid, EventType_Ready, ""))
+	}
+	require.Len(t, events, subscriptionBufferSize)
+}
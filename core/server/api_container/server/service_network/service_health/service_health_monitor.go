@@ -0,0 +1,255 @@
+package service_health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+)
+
+type RestartPolicy string
+
+const (
+	// RestartPolicy_Never only reports the service as unhealthy (default)
+	RestartPolicy_Never RestartPolicy = "never"
+	// RestartPolicy_OnUnhealthy restarts the service once it's unhealthy
+	RestartPolicy_OnUnhealthy RestartPolicy = "on_unhealthy"
+
+	checkInterval = 10 * time.Second
+
+	// Each check runs the ready conditions once, so it shouldn't take longer than the interval between two checks
+	probeTimeout = checkInterval
+
+	// A single failed check could just be a blip, so a service only becomes unhealthy after several in a row
+	unhealthyThreshold = 3
+
+	// Time a service gets to come back up after being (re)started before its checks resume
+	startupGracePeriod = 30 * time.Second
+)
+
+// HealthProbe checks the ready conditions of a service once, returning an error if they aren't met
+type HealthProbe func(ctx context.Context) error
+
+// ServiceRestarter restarts the given service
+type ServiceRestarter func(ctx context.Context, serviceName service.ServiceName) error
+
+// ServiceHealth is the result of the checks of the ready conditions of a service
+type ServiceHealth struct {
+	isHealthy           bool
+	consecutiveFailures uint32
+	lastError           string
+	restartCount        uint32
+}
+
+func (health ServiceHealth) IsHealthy() bool {
+	return health.isHealthy
+}
+
+func (health ServiceHealth) GetConsecutiveFailures() uint32 {
+	return health.consecutiveFailures
+}
+
+func (health ServiceHealth) GetLastError() string {
+	return health.lastError
+}
+
+func (health ServiceHealth) GetRestartCount() uint32 {
+	return health.restartCount
+}
+
+type monitoredService struct {
+	probe         HealthProbe
+	restartPolicy RestartPolicy
+	health        ServiceHealth
+
+	// Stopped services aren't checked, so that stopping a service doesn't make it unhealthy (or restart it)
+	isPaused bool
+
+	// Checks are skipped until then, to give (re)started services time to come up
+	nextCheckTime time.Time
+}
+
+// ServiceHealthMonitor keeps checking the ready conditions of the services after they started, marking the services
+// failing them as unhealthy and restarting them if their restart policy says so
+type ServiceHealthMonitor struct {
+	mutex sync.Mutex
+
+	services map[service.ServiceName]*monitoredService
+
+	restarter ServiceRestarter
+
+	startCheckLoopOnce sync.Once
+}
+
+func NewServiceHealthMonitor(restarter ServiceRestarter) *ServiceHealthMonitor {
+	return &ServiceHealthMonitor{
+		mutex:              sync.Mutex{},
+		services:           map[service.ServiceName]*monitoredService{},
+		restarter:          restarter,
+		startCheckLoopOnce: sync.Once{},
+	}
+}
+
+// Register starts monitoring the given service, which is expected to have just passed its ready conditions. Registering
+// a service again replaces its probe and restart policy and resets its health.
+func (monitor *ServiceHealthMonitor) Register(serviceName service.ServiceName, probe HealthProbe, restartPolicy RestartPolicy) {
+	monitor.mutex.Lock()
+	monitor.services[serviceName] = &monitoredService{
+		probe:         probe,
+		restartPolicy: restartPolicy,
+		health: ServiceHealth{
+			isHealthy:           true,
+			consecutiveFailures: 0,
+			lastError:           "",
+			restartCount:        0,
+		},
+		isPaused:      false,
+		nextCheckTime: time.Now().Add(checkInterval),
+	}
+	monitor.mutex.Unlock()
+
+	monitor.startCheckLoopOnce.Do(func() {
+		go monitor.runCheckLoop(context.Background())
+	})
+}
+
+func (monitor *ServiceHealthMonitor) Unregister(serviceName service.ServiceName) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	delete(monitor.services, serviceName)
+}
+
+// Pause suspends the checks of the given service, e.g. because it's being stopped
+func (monitor *ServiceHealthMonitor) Pause(serviceName service.ServiceName) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	if monitoredSvc, found := monitor.services[serviceName]; found {
+		monitoredSvc.isPaused = true
+	}
+}
+
+// Resume resumes the checks of the given service after giving it time to come up
+func (monitor *ServiceHealthMonitor) Resume(serviceName service.ServiceName) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	if monitoredSvc, found := monitor.services[serviceName]; found {
+		monitoredSvc.isPaused = false
+		monitoredSvc.health.consecutiveFailures = 0
+		monitoredSvc.nextCheckTime = time.Now().Add(startupGracePeriod)
+	}
+}
+
+// GetHealth returns the health of the given service, or false if the service isn't monitored
+func (monitor *ServiceHealthMonitor) GetHealth(serviceName service.ServiceName) (ServiceHealth, bool) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	monitoredSvc, found := monitor.services[serviceName]
+	if !found {
+		return ServiceHealth{isHealthy: false, consecutiveFailures: 0, lastError: "", restartCount: 0}, false
+	}
+	return monitoredSvc.health, true
+}
+
+func (monitor *ServiceHealthMonitor) runCheckLoop(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			monitor.checkServices(ctx, time.Now())
+		}
+	}
+}
+
+// checkServices runs the probes of the services due for a check, in parallel, and restarts the ones that became
+// unhealthy if their restart policy says so
+func (monitor *ServiceHealthMonitor) checkServices(ctx context.Context, now time.Time) {
+	monitor.mutex.Lock()
+	servicesToCheck := map[service.ServiceName]*monitoredService{}
+	for serviceName, monitoredSvc := range monitor.services {
+		if !monitoredSvc.isPaused && !now.Before(monitoredSvc.nextCheckTime) {
+			servicesToCheck[serviceName] = monitoredSvc
+		}
+	}
+	monitor.mutex.Unlock()
+
+	probeErrs := map[service.ServiceName]error{}
+	probeErrsMutex := sync.Mutex{}
+	waitGroup := sync.WaitGroup{}
+	for serviceName, monitoredSvc := range servicesToCheck {
+		waitGroup.Add(1)
+		go func(serviceName service.ServiceName, probe HealthProbe) {
+			defer waitGroup.Done()
+			probeCtx, cancelProbeCtx := context.WithTimeout(ctx, probeTimeout)
+			defer cancelProbeCtx()
+			probeErr := probe(probeCtx)
+			probeErrsMutex.Lock()
+			probeErrs[serviceName] = probeErr
+			probeErrsMutex.Unlock()
+		}(serviceName, monitoredSvc.probe)
+	}
+	waitGroup.Wait()
+
+	servicesToRestart := []service.ServiceName{}
+	monitor.mutex.Lock()
+	for serviceName, checkedSvc := range servicesToCheck {
+		// The service could have been removed, registered again or stopped while its probe was running
+		if currentSvc, found := monitor.services[serviceName]; !found || currentSvc != checkedSvc || currentSvc.isPaused {
+			continue
+		}
+		if monitor.recordProbeResult(serviceName, checkedSvc, probeErrs[serviceName]) {
+			servicesToRestart = append(servicesToRestart, serviceName)
+		}
+	}
+	monitor.mutex.Unlock()
+
+	for _, serviceName := range servicesToRestart {
+		monitor.restartUnhealthyService(ctx, serviceName)
+	}
+}
+
+// recordProbeResult updates the health of the service with the result of its probe, returning true if the service
+// should be restarted. Must be called with the mutex held
+func (monitor *ServiceHealthMonitor) recordProbeResult(serviceName service.ServiceName, monitoredSvc *monitoredService, probeErr error) bool {
+	health := &monitoredSvc.health
+	if probeErr == nil {
+		if !health.isHealthy {
+			logrus.Infof("Service '%s' is healthy again", serviceName)
+		}
+		health.isHealthy = true
+		health.consecutiveFailures = 0
+		health.lastError = ""
+		return false
+	}
+
+	health.consecutiveFailures++
+	health.lastError = probeErr.Error()
+	if health.consecutiveFailures < unhealthyThreshold {
+		return false
+	}
+	if health.isHealthy {
+		logrus.Warnf("Service '%s' is unhealthy as its ready conditions failed %d checks in a row; last error was:\n%v", serviceName, health.consecutiveFailures, probeErr)
+	}
+	health.isHealthy = false
+	return monitoredSvc.restartPolicy == RestartPolicy_OnUnhealthy
+}
+
+func (monitor *ServiceHealthMonitor) restartUnhealthyService(ctx context.Context, serviceName service.ServiceName) {
+	logrus.Infof("Restarting unhealthy service '%s' as per its restart policy", serviceName)
+	if err := monitor.restarter(ctx, serviceName); err != nil {
+		logrus.Errorf("An error occurred restarting unhealthy service '%s':\n%v", serviceName, err)
+		return
+	}
+
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	if monitoredSvc, found := monitor.services[serviceName]; found {
+		monitoredSvc.health.restartCount++
+		monitoredSvc.health.consecutiveFailures = 0
+		monitoredSvc.nextCheckTime = time.Now().Add(startupGracePeriod)
+	}
+}
@@ -0,0 +1,124 @@
+package service_health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName = service.ServiceName("api")
+)
+
+var (
+	failingProbe = func(ctx context.Context) error {
+		return stacktrace.NewError("Connection refused")
+	}
+	passingProbe = func(ctx context.Context) error {
+		return nil
+	}
+)
+
+func TestCheckServices_BecomesUnhealthyAfterThreshold(t *testing.T) {
+	restartedServices := []service.ServiceName{}
+	monitor := newMonitorForTest(&restartedServices)
+	monitor.Register(testServiceName, failingProbe, RestartPolicy_Never)
+
+	now := time.Now().Add(checkInterval)
+	for i := 1; i < unhealthyThreshold; i++ {
+		monitor.checkServices(context.Background(), now)
+		health, found := monitor.GetHealth(testServiceName)
+		require.True(t, found)
+		require.True(t, health.IsHealthy())
+		require.Equal(t, uint32(i), health.GetConsecutiveFailures())
+	}
+
+	monitor.checkServices(context.Background(), now)
+	health, found := monitor.GetHealth(testServiceName)
+	require.True(t, found)
+	require.False(t, health.IsHealthy())
+	require.Equal(t, uint32(unhealthyThreshold), health.GetConsecutiveFailures())
+	require.Contains(t, health.GetLastError(), "Connection refused")
+	require.Empty(t, restartedServices)
+}
+
+func TestCheckServices_RecoversAfterPassingProbe(t *testing.T) {
+	restartedServices := []service.ServiceName{}
+	monitor := newMonitorForTest(&restartedServices)
+	monitor.Register(testServiceName, failingProbe, RestartPolicy_Never)
+
+	now := time.Now().Add(checkInterval)
+	for i := 0; i < unhealthyThreshold; i++ {
+		monitor.checkServices(context.Background(), now)
+	}
+	monitor.services[testServiceName].probe = passingProbe
+	monitor.checkServices(context.Background(), now)
+
+	health, found := monitor.GetHealth(testServiceName)
+	require.True(t, found)
+	require.True(t, health.IsHealthy())
+	require.Zero(t, health.GetConsecutiveFailures())
+	require.Empty(t, health.GetLastError())
+}
+
+func TestCheckServices_RestartsUnhealthyServiceOnUnhealthyPolicy(t *testing.T) {
+	restartedServices := []service.ServiceName{}
+	monitor := newMonitorForTest(&restartedServices)
+	monitor.Register(testServiceName, failingProbe, RestartPolicy_OnUnhealthy)
+
+	now := time.Now().Add(checkInterval)
+	for i := 0; i < unhealthyThreshold; i++ {
+		monitor.checkServices(context.Background(), now)
+	}
+	require.Equal(t, []service.ServiceName{testServiceName}, restartedServices)
+
+	health, found := monitor.GetHealth(testServiceName)
+	require.True(t, found)
+	require.False(t, health.IsHealthy())
+	require.Equal(t, uint32(1), health.GetRestartCount())
+	require.Zero(t, health.GetConsecutiveFailures())
+
+	// The restarted service gets a grace period before being checked again
+	monitor.checkServices(context.Background(), now)
+	health, _ = monitor.GetHealth(testServiceName)
+	require.Zero(t, health.GetConsecutiveFailures())
+}
+
+func TestCheckServices_SkipsPausedServices(t *testing.T) {
+	restartedServices := []service.ServiceName{}
+	monitor := newMonitorForTest(&restartedServices)
+	monitor.Register(testServiceName, failingProbe, RestartPolicy_OnUnhealthy)
+	monitor.Pause(testServiceName)
+
+	now := time.Now().Add(checkInterval)
+	for i := 0; i < unhealthyThreshold; i++ {
+		monitor.checkServices(context.Background(), now)
+	}
+
+	health, found := monitor.GetHealth(testServiceName)
+	require.True(t, found)
+	require.True(t, health.IsHealthy())
+	require.Zero(t, health.GetConsecutiveFailures())
+	require.Empty(t, restartedServices)
+}
+
+func TestGetHealth_UnregisteredService(t *testing.T) {
+	restartedServices := []service.ServiceName{}
+	monitor := newMonitorForTest(&restartedServices)
+	monitor.Register(testServiceName, passingProbe, RestartPolicy_Never)
+	monitor.Unregister(testServiceName)
+
+	_, found := monitor.GetHealth(testServiceName)
+	require.False(t, found)
+}
+
+func newMonitorForTest(restartedServices *[]service.ServiceName) *ServiceHealthMonitor {
+	return NewServiceHealthMonitor(func(ctx context.Context, serviceName service.ServiceName) error {
+		*restartedServices = append(*restartedServices, serviceName)
+		return nil
+	})
+}
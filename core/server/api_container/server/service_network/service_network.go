@@ -5,7 +5,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/crash_diagnostics"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/log_alerts"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_dependencies"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_events"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"io"
@@ -57,6 +62,10 @@ type ServiceNetwork interface {
 
 	RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error)
 
+	// RemoveServicesAtomically removes either all the services or none of them: they are all stopped first, and the
+	// ones that stopped are started back if any fails to
+	RemoveServicesAtomically(ctx context.Context, serviceIdentifiers []string) *BulkServiceOperationResult
+
 	StartService(ctx context.Context, serviceIdentifier string) error
 
 	StartServices(
@@ -68,6 +77,10 @@ type ServiceNetwork interface {
 		error,
 	)
 
+	// StartServicesAtomically starts either all the services or none of them: the ones that started are stopped back
+	// if any fails to
+	StartServicesAtomically(ctx context.Context, serviceIdentifiers []string) *BulkServiceOperationResult
+
 	StopService(ctx context.Context, serviceIdentifier string) error
 
 	StopServices(
@@ -79,6 +92,10 @@ type ServiceNetwork interface {
 		error,
 	)
 
+	// StopServicesAtomically stops either all the services or none of them: the ones that stopped are started back if
+	// any fails to
+	StopServicesAtomically(ctx context.Context, serviceIdentifiers []string) *BulkServiceOperationResult
+
 	RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error)
 
 	RunExecs(
@@ -98,10 +115,46 @@ type ServiceNetwork interface {
 
 	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
+	CopyFilesFromImage(ctx context.Context, image string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
+
 	GetServiceNames() (map[service.ServiceName]bool, error)
 
 	GetExistingAndHistoricalServiceIdentifiers() (service_identifiers.ServiceIdentifiers, error)
 
+	SaveServiceDependencies(dependencies *service_dependencies.ServiceDependencies) error
+
+	GetServiceDependencies() (map[service.ServiceName]*service_dependencies.ServiceDependencies, error)
+
+	// SetEnclaveEnvVars upserts the environment variables that get injected into every service started in the enclave
+	SetEnclaveEnvVars(envVars map[string]string) error
+
+	GetEnclaveEnvVars() (map[string]string, error)
+
+	// MonitorServiceHealth keeps running the probe of the service after it started, marking the service unhealthy when
+	// it keeps failing and restarting it if its restart policy says so
+	MonitorServiceHealth(serviceName service.ServiceName, probe service_health.HealthProbe, restartPolicy service_health.RestartPolicy)
+
+	// GetServiceHealth returns the health of the service, or false if it isn't monitored
+	GetServiceHealth(serviceName service.ServiceName) (service_health.ServiceHealth, bool)
+
+	// CollectCrashDiagnostics keeps checking whether the service crashed after it started, capturing its exit state,
+	// last logs, backend state and crash dump, if it has a path to one, into a files artifact when it does
+	CollectCrashDiagnostics(serviceName service.ServiceName, crashDumpPath string)
+
+	// GetLastCrashReport returns how the service last crashed, or false if it didn't crash since it started
+	GetLastCrashReport(serviceName service.ServiceName) (crash_diagnostics.CrashReport, bool)
+
+	// GetLogAlertWatcher returns the watcher following the logs of the services that have log alerts
+	GetLogAlertWatcher() *log_alerts.LogAlertWatcher
+
+	// MarkServiceReady sends the ready event of the service, once it passed its ready conditions or, if it has none,
+	// once it started
+	MarkServiceReady(serviceName service.ServiceName)
+
+	// SubscribeToServiceEvents returns the channel receiving the lifecycle events of the services from now on, and the
+	// function to call to stop receiving them
+	SubscribeToServiceEvents() (<-chan *service_events.ServiceEvent, func())
+
 	ExistServiceRegistration(serviceName service.ServiceName) (bool, error)
 
 	RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
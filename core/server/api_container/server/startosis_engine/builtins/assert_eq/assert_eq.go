@@ -0,0 +1,79 @@
+package assert_eq
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	AssertEqBuiltinName = "assert_eq"
+
+	ActualArgName   = "actual"
+	ExpectedArgName = "expected"
+	MsgArgName      = "msg"
+)
+
+// NewAssertEq creates the helper package tests use to compare values. The comparison happens at interpretation time,
+// so values only known at execution time, like the output of plan.exec, must be checked with plan.verify instead
+func NewAssertEq() *kurtosis_helper.KurtosisHelper {
+	return &kurtosis_helper.KurtosisHelper{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: AssertEqBuiltinName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ActualArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         nil,
+				},
+				{
+					Name:              ExpectedArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         nil,
+				},
+				{
+					Name:              MsgArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: &assertEqCapabilities{},
+	}
+}
+
+type assertEqCapabilities struct{}
+
+func (builtin *assertEqCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	actualValue, err := builtin_argument.ExtractArgumentValue[starlark.Value](arguments, ActualArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", ActualArgName)
+	}
+	expectedValue, err := builtin_argument.ExtractArgumentValue[starlark.Value](arguments, ExpectedArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", ExpectedArgName)
+	}
+
+	areEqual, err := starlark.Equal(actualValue, expectedValue)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to compare '%v' with '%v'", actualValue, expectedValue)
+	}
+	if areEqual {
+		return starlark.None, nil
+	}
+
+	if arguments.IsSet(MsgArgName) {
+		msgValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, MsgArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", MsgArgName)
+		}
+		return nil, startosis_errors.NewInterpretationError("%s: expected '%v' but got '%v'", msgValue.GoString(), expectedValue, actualValue)
+	}
+	return nil, startosis_errors.NewInterpretationError("Assertion failed: expected '%v' but got '%v'", expectedValue, actualValue)
+}
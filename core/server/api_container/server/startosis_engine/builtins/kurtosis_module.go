@@ -3,6 +3,7 @@ package builtins
 import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/secret"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	starlarkjson "go.starlark.net/lib/json"
 	"go.starlark.net/starlark"
@@ -26,9 +27,13 @@ func KurtosisModule(thread *starlark.Thread, enclaveUuid enclave.EnclaveUUID, en
 		return nil, interpretationErr
 	}
 
+	members := *enclaveEnvVarsStringDict
+	// Takes precedence over an enclave env var of the same name
+	members[secret.SecretBuiltinName] = starlark.NewBuiltin(secret.SecretBuiltinName, secret.NewSecret().CreateBuiltin())
+
 	return &starlarkstruct.Module{
 		Name:    KurtosisModuleName,
-		Members: *enclaveEnvVarsStringDict,
+		Members: members,
 	}, nil
 }
 
@@ -0,0 +1,51 @@
+package secret
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/secrets"
+	"go.starlark.net/starlark"
+)
+
+const (
+	SecretBuiltinName = "secret"
+
+	PathArgName = "path"
+
+	secretPathRegexp = "^" + secrets.SecretPathRegexp + "$"
+)
+
+// NewSecret creates the helper returning a reference to a secret of the secrets provider of the cluster, to set as the
+// value of an env var of a service. The reference is only resolved when the service starts, so the value of the
+// secret never appears in the plan
+func NewSecret() *kurtosis_helper.KurtosisHelper {
+	return &kurtosis_helper.KurtosisHelper{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SecretBuiltinName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PathArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringRegexp(value, PathArgName, secretPathRegexp)
+					},
+				},
+			},
+		},
+
+		Capabilities: &secretCapabilities{},
+	}
+}
+
+type secretCapabilities struct{}
+
+func (builtin *secretCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	pathValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, PathArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", PathArgName)
+	}
+	return starlark.String(secrets.NewSecretPlaceholder(pathValue.GoString())), nil
+}
@@ -23,7 +23,9 @@ func NewEnclavePlan() *EnclavePlan {
 	}
 }
 
-func (enclavePlan *EnclavePlan) PartialDeepClone(indexOfFirstInstructionToNotClone int) *EnclavePlan {
+// PartialDeepClone clones the instructions before indexOfFirstInstructionToNotClone, except the ones whose UUIDs are in
+// instructionUuidsToNotClone
+func (enclavePlan *EnclavePlan) PartialDeepClone(indexOfFirstInstructionToNotClone int, instructionUuidsToNotClone map[string]bool) *EnclavePlan {
 	newPlan := &EnclavePlan{
 		EnclavePlanInstructions: []*EnclavePlanInstruction{},
 	}
@@ -31,6 +33,9 @@ func (enclavePlan *EnclavePlan) PartialDeepClone(indexOfFirstInstructionToNotClo
 		if idx >= indexOfFirstInstructionToNotClone {
 			return newPlan
 		}
+		if instructionUuidsToNotClone[instruction.Uuid] {
+			continue
+		}
 		newPlan.AppendInstruction(instruction.Clone())
 	}
 	return newPlan
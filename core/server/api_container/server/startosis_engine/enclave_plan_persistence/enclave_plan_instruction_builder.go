@@ -55,6 +55,11 @@ func (builder *EnclavePlanInstructionBuilder) AddServiceName(serviceName service
 	return builder
 }
 
+// GetServiceNames returns the names of the services the instruction acts on
+func (builder *EnclavePlanInstructionBuilder) GetServiceNames() []string {
+	return builder.serviceNames
+}
+
 func (builder *EnclavePlanInstructionBuilder) AddFilesArtifact(filesArtifactName string, filesArtifactMd5 []byte) *EnclavePlanInstructionBuilder {
 	builder.filesArtifacts[filesArtifactName] = filesArtifactMd5
 	return builder
@@ -7,12 +7,17 @@ import (
 type EnclaveComponents struct {
 	enclaveServices      map[service.ServiceName]EnclaveComponentStatus
 	enclaveFilesArtifact map[string]EnclaveComponentStatus
+
+	// true when the enclave-wide env vars have been changed by the current run, in which case all services need to be
+	// re-created to pick up the new values
+	enclaveEnvVarsUpdated bool
 }
 
 func NewEnclaveComponents() *EnclaveComponents {
 	return &EnclaveComponents{
-		enclaveServices:      map[service.ServiceName]EnclaveComponentStatus{},
-		enclaveFilesArtifact: map[string]EnclaveComponentStatus{},
+		enclaveServices:       map[service.ServiceName]EnclaveComponentStatus{},
+		enclaveFilesArtifact:  map[string]EnclaveComponentStatus{},
+		enclaveEnvVarsUpdated: false,
 	}
 }
 
@@ -37,3 +42,11 @@ func (components *EnclaveComponents) HasFilesArtifactBeenUpdated(artifactName st
 	}
 	return false
 }
+
+func (components *EnclaveComponents) SetEnclaveEnvVarsUpdated() {
+	components.enclaveEnvVarsUpdated = true
+}
+
+func (components *EnclaveComponents) HaveEnclaveEnvVarsBeenUpdated() bool {
+	return components.enclaveEnvVarsUpdated
+}
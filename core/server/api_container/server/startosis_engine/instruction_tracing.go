@@ -0,0 +1,56 @@
+package startosis_engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instructionSpanNamePrefix = "Starlark."
+
+	instructionNameAttributeKey       = "kurtosis.instruction.name"
+	instructionNumberAttributeKey     = "kurtosis.instruction.number"
+	instructionPositionAttributeKey   = "kurtosis.instruction.position"
+	instructionArgsDigestAttributeKey = "kurtosis.instruction.args_digest"
+	serviceNamesAttributeKey          = "kurtosis.services.names"
+
+	// The arguments are only exported as a digest as they can contain secrets; the digest is enough to tell apart the
+	// runs of an instruction with different arguments
+	argsDigestPrefix = "sha256:"
+)
+
+// startInstructionSpan starts the span covering the execution of the instruction, e.g. 'Starlark.add_service', as a
+// child of the span of the run. The span must be ended with tracing.EndSpan
+func startInstructionSpan(
+	ctx context.Context,
+	instructionNumber uint32,
+	instruction kurtosis_instruction.KurtosisInstruction,
+	canonicalInstruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction,
+) (context.Context, trace.Span) {
+	return tracing.StartSpan(
+		ctx,
+		instructionSpanNamePrefix+canonicalInstruction.GetInstructionName(),
+		attribute.String(instructionNameAttributeKey, canonicalInstruction.GetInstructionName()),
+		attribute.Int64(instructionNumberAttributeKey, int64(instructionNumber)),
+		attribute.String(instructionPositionAttributeKey, instruction.GetPositionInOriginalScript().String()),
+		attribute.String(instructionArgsDigestAttributeKey, getInstructionArgsDigest(canonicalInstruction)),
+		attribute.StringSlice(serviceNamesAttributeKey, instruction.GetPersistableAttributes().GetServiceNames()),
+	)
+}
+
+func getInstructionArgsDigest(canonicalInstruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction) string {
+	argsHash := sha256.New()
+	for _, arg := range canonicalInstruction.GetArguments() {
+		// Quoted so that two different lists of arguments can't be written the same way
+		fmt.Fprintf(argsHash, "%q=%q\n", arg.GetArgName(), arg.GetSerializedArgValue())
+	}
+	return argsDigestPrefix + hex.EncodeToString(argsHash.Sum(nil))
+}
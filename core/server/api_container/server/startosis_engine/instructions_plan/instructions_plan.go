@@ -24,14 +24,23 @@ type InstructionsPlan struct {
 
 	// list of package names that this instructions plan relies on
 	packageDependencies map[string]bool
+
+	// UUIDs of the enclave plan instructions that this plan reconciled with instructions it holds, and which should
+	// therefore be removed from the enclave plan when this plan gets executed
+	replacedEnclavePlanInstructionUuids map[string]bool
+
+	// how this plan compares with the enclave plan it was optimized against, nil if the enclave plan was empty
+	reconciliationSummary *ReconciliationSummary
 }
 
 func NewInstructionsPlan() *InstructionsPlan {
 	return &InstructionsPlan{
-		indexOfFirstInstruction:    0,
-		scheduledInstructionsIndex: map[ScheduledInstructionUuid]*ScheduledInstruction{},
-		instructionsSequence:       []ScheduledInstructionUuid{},
-		packageDependencies:        map[string]bool{},
+		indexOfFirstInstruction:             0,
+		scheduledInstructionsIndex:          map[ScheduledInstructionUuid]*ScheduledInstruction{},
+		instructionsSequence:                []ScheduledInstructionUuid{},
+		packageDependencies:                 map[string]bool{},
+		replacedEnclavePlanInstructionUuids: map[string]bool{},
+		reconciliationSummary:               nil,
 	}
 }
 
@@ -103,3 +112,19 @@ func (plan *InstructionsPlan) AddPackageDependency(packageDependency string) {
 func (plan *InstructionsPlan) Size() int {
 	return len(plan.instructionsSequence)
 }
+
+func (plan *InstructionsPlan) AddReplacedEnclavePlanInstructionUuid(enclavePlanInstructionUuid string) {
+	plan.replacedEnclavePlanInstructionUuids[enclavePlanInstructionUuid] = true
+}
+
+func (plan *InstructionsPlan) GetReplacedEnclavePlanInstructionUuids() map[string]bool {
+	return plan.replacedEnclavePlanInstructionUuids
+}
+
+func (plan *InstructionsPlan) SetReconciliationSummary(reconciliationSummary *ReconciliationSummary) {
+	plan.reconciliationSummary = reconciliationSummary
+}
+
+func (plan *InstructionsPlan) GetReconciliationSummary() *ReconciliationSummary {
+	return plan.reconciliationSummary
+}
@@ -0,0 +1,23 @@
+package instructions_plan
+
+import "fmt"
+
+// ReconciliationSummary describes the actions a plan takes on an enclave that already ran instructions
+type ReconciliationSummary struct {
+	// instructions already applied to the enclave, which won't be executed again
+	NumUnchanged int
+
+	// instructions that change what the enclave already has, e.g. a service with a new config
+	NumUpdated int
+
+	// instructions the enclave has never seen
+	NumAdded int
+
+	// instructions of the enclave plan that this plan doesn't touch
+	NumLeftInPlace int
+}
+
+func (summary *ReconciliationSummary) String() string {
+	return fmt.Sprintf("Reconciled with the enclave state: %d instruction(s) unchanged, %d updated, %d added, %d previously run instruction(s) left in place",
+		summary.NumUnchanged, summary.NumUpdated, summary.NumAdded, summary.NumLeftInPlace)
+}
@@ -4,10 +4,18 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 )
 
+const (
+	// NoInstructionIdx is used when the instruction making the mask invalid was not read from it
+	NoInstructionIdx = -1
+)
+
 type InstructionsPlanMask struct {
 	readIdx                 int
 	enclavePlanInstructions []*enclave_plan_persistence.EnclavePlanInstruction
 	isValid                 bool
+
+	// index of the instruction that made the mask invalid, NoInstructionIdx while the mask is valid
+	invalidIdx int
 }
 
 func NewInstructionsPlanMask(size int) *InstructionsPlanMask {
@@ -15,6 +23,7 @@ func NewInstructionsPlanMask(size int) *InstructionsPlanMask {
 		readIdx:                 0,
 		enclavePlanInstructions: make([]*enclave_plan_persistence.EnclavePlanInstruction, size),
 		isValid:                 true, // the mask is considered valid until it's proven to be invalid
+		invalidIdx:              NoInstructionIdx,
 	}
 }
 
@@ -37,10 +46,24 @@ func (mask *InstructionsPlanMask) Size() int {
 	return len(mask.enclavePlanInstructions)
 }
 
-func (mask *InstructionsPlanMask) MarkAsInvalid() {
+func (mask *InstructionsPlanMask) GetAt(idx int) *enclave_plan_persistence.EnclavePlanInstruction {
+	return mask.enclavePlanInstructions[idx]
+}
+
+// MarkAsInvalid takes the index of the mask instruction that doesn't fit, or NoInstructionIdx if the instruction making
+// the mask invalid was not read from it
+func (mask *InstructionsPlanMask) MarkAsInvalid(invalidIdx int) {
+	if mask.isValid {
+		mask.invalidIdx = invalidIdx
+	}
 	mask.isValid = false
 }
 
+// GetInvalidIdx returns the index of the first mask instruction that didn't fit, or NoInstructionIdx
+func (mask *InstructionsPlanMask) GetInvalidIdx() int {
+	return mask.invalidIdx
+}
+
 func (mask *InstructionsPlanMask) IsValid() bool {
 	return mask.isValid
 }
@@ -0,0 +1,101 @@
+package startosis_engine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_builtins"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/assert_eq"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/import_module"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/read_file"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/stretchr/testify/require"
+)
+
+const starlarkPackagePath = "go.starlark.net/starlark"
+
+// The signatures in the API are what 'kurtosis lint' checks packages against, so they must match the real builtins
+func TestStarlarkBuiltinsSignaturesAreInSync(t *testing.T) {
+	planInstructions := []*kurtosis_starlark_framework.KurtosisBaseBuiltin{}
+	for _, planInstruction := range KurtosisPlanInstructions("", nil, nil, nil, nil, false, nil, image_download_mode.ImageDownloadMode_Missing) {
+		planInstructions = append(planInstructions, planInstruction.KurtosisBaseBuiltin)
+	}
+	require.Equal(t, starlark_builtins.GetPlanInstructions(), getBuiltinSignatures(planInstructions))
+
+	globalBuiltins := []*kurtosis_starlark_framework.KurtosisBaseBuiltin{}
+	for _, typeConstructor := range kurtosisTypeConstructors() {
+		globalBuiltins = append(globalBuiltins, typeConstructor.KurtosisBaseBuiltin)
+	}
+	globalBuiltins = append(
+		globalBuiltins,
+		import_module.NewImportModule("", nil, nil, nil, nil).KurtosisBaseBuiltin,
+		read_file.NewReadFileHelper("", nil, nil).KurtosisBaseBuiltin,
+		assert_eq.NewAssertEq().KurtosisBaseBuiltin,
+	)
+	require.Equal(t, starlark_builtins.GetGlobalBuiltins(), getBuiltinSignatures(globalBuiltins))
+}
+
+func TestStarlarkBuiltinsPredeclaredNamesAreInSync(t *testing.T) {
+	predeclaredNames := []string{builtins.KurtosisModuleName}
+	for name := range Predeclared() {
+		predeclaredNames = append(predeclaredNames, name)
+	}
+	for _, helper := range KurtosisHelpers("", nil, nil, nil, nil) {
+		predeclaredNames = append(predeclaredNames, helper.Name())
+	}
+	for _, typeConstructor := range KurtosisTypeConstructors() {
+		predeclaredNames = append(predeclaredNames, typeConstructor.Name())
+	}
+
+	expectedPredeclaredNames := starlark_builtins.GetOtherPredeclaredNames()
+	for _, globalBuiltin := range starlark_builtins.GetGlobalBuiltins() {
+		expectedPredeclaredNames = append(expectedPredeclaredNames, globalBuiltin.Name)
+	}
+	require.ElementsMatch(t, expectedPredeclaredNames, predeclaredNames)
+}
+
+func getBuiltinSignatures(baseBuiltins []*kurtosis_starlark_framework.KurtosisBaseBuiltin) []*starlark_builtins.Builtin {
+	signatures := []*starlark_builtins.Builtin{}
+	for _, baseBuiltin := range baseBuiltins {
+		signature := &starlark_builtins.Builtin{
+			Name:                  baseBuiltin.Name,
+			Arguments:             []*starlark_builtins.Argument{},
+			DeprecationMitigation: "",
+		}
+		if baseBuiltin.Deprecation != nil {
+			signature.DeprecationMitigation = baseBuiltin.Deprecation.GetMitigation()
+		}
+		for _, argument := range baseBuiltin.Arguments {
+			argumentSignature := &starlark_builtins.Argument{
+				Name:                  argument.Name,
+				IsOptional:            argument.IsOptional,
+				TypeName:              getArgumentTypeName(argument),
+				DeprecationMitigation: "",
+			}
+			if argument.IsDeprecated() && argument.Deprecation.GetMaybeShouldShowDeprecationNoticeBaseOnArgumentValueFunc() == nil {
+				argumentSignature.DeprecationMitigation = argument.Deprecation.GetMitigation()
+			}
+			signature.Arguments = append(signature.Arguments, argumentSignature)
+		}
+		signatures = append(signatures, signature)
+	}
+	return signatures
+}
+
+// getArgumentTypeName returns the Starlark type name of the argument, without calling Type() on the zero value of
+// Kurtosis types as they're nil pointers
+func getArgumentTypeName(argument *builtin_argument.BuiltinArgument) string {
+	zeroValue := argument.ZeroValueProvider()
+	zeroValueType := reflect.TypeOf(zeroValue)
+	if zeroValueType == nil {
+		// the argument is an interface, so several types are accepted
+		return ""
+	}
+	if zeroValueType.Kind() == reflect.Pointer && zeroValueType.Elem().PkgPath() != starlarkPackagePath {
+		return zeroValueType.Elem().Name()
+	}
+	return zeroValue.Type()
+}
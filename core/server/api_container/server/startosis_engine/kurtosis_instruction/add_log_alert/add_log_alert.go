@@ -0,0 +1,221 @@
+package add_log_alert
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/log_alerts"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/tasks"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"go.starlark.net/starlark"
+)
+
+const (
+	AddLogAlertBuiltinName = "add_log_alert"
+
+	ServiceNameArgName = "service_name"
+	RegexArgName       = "regex"
+	ActionArgName      = "action"
+	RunArgName         = "run"
+	ImageArgName       = "image"
+
+	defaultAction = log_alerts.Action_Fail
+
+	// The task of the alert gets the log line that fired it in this env var
+	logLineEnvVarName = "KURTOSIS_LOG_ALERT_LINE"
+
+	descriptionFormatStr = "Adding log alert '%v' to service '%v'"
+)
+
+func NewAddLogAlert(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: AddLogAlertBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              RegexArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, RegexArgName)
+					},
+				},
+				{
+					Name:              ActionArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, ActionArgName, []string{string(log_alerts.Action_Fail), string(log_alerts.Action_Event), string(log_alerts.Action_Task)})
+					},
+				},
+				{
+					Name:              RunArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, RunArgName)
+					},
+				},
+				{
+					Name:              ImageArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ImageArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &AddLogAlertCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName: "",            // populated at interpretation time
+				regex:       nil,           // populated at interpretation time
+				action:      defaultAction, // populated at interpretation time
+				run:         "",            // populated at interpretation time
+				image:       "",            // populated at interpretation time
+				description: "",            // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+			RegexArgName:       true,
+			ActionArgName:      true,
+		},
+	}
+}
+
+type AddLogAlertCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName service.ServiceName
+	regex       *regexp.Regexp
+	action      log_alerts.Action
+
+	// The sh script of the task action, and the image of its container
+	run   string
+	image string
+
+	description string
+}
+
+func (builtin *AddLogAlertCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+
+	regexStr, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, RegexArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RegexArgName)
+	}
+	regex, err := regexp.Compile(regexStr.GoString())
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "The value of the '%s' argument isn't a valid regex", RegexArgName)
+	}
+	builtin.regex = regex
+
+	if arguments.IsSet(ActionArgName) {
+		action, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ActionArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ActionArgName)
+		}
+		builtin.action = log_alerts.Action(action.GoString())
+	}
+
+	if arguments.IsSet(RunArgName) {
+		run, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, RunArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RunArgName)
+		}
+		builtin.run = run.GoString()
+	}
+	if arguments.IsSet(ImageArgName) {
+		image, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ImageArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ImageArgName)
+		}
+		builtin.image = image.GoString()
+	}
+	if builtin.action == log_alerts.Action_Task && builtin.run == "" {
+		return nil, startosis_errors.NewInterpretationError("The '%s' argument is required by the '%s' action", RunArgName, log_alerts.Action_Task)
+	}
+	if builtin.action != log_alerts.Action_Task && (builtin.run != "" || builtin.image != "") {
+		return nil, startosis_errors.NewInterpretationError("The '%s' and '%s' arguments can only be set with the '%s' action", RunArgName, ImageArgName, log_alerts.Action_Task)
+	}
+
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(descriptionFormatStr, builtin.regex.String(), builtin.serviceName))
+	return starlark.None, nil
+}
+
+func (builtin *AddLogAlertCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if validatorEnvironment.DoesServiceNameExist(builtin.serviceName) == startosis_validator.ComponentNotFound {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", AddLogAlertBuiltinName, builtin.serviceName)
+	}
+	if builtin.action == log_alerts.Action_Task && builtin.image != "" {
+		validatorEnvironment.AppendRequiredImagePull(builtin.image)
+	}
+	return nil
+}
+
+func (builtin *AddLogAlertCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	var maybeTaskRunner log_alerts.TaskRunner
+	if builtin.action == log_alerts.Action_Task {
+		maybeTaskRunner = func(ctx context.Context, logLine string) error {
+			return tasks.RunShTask(ctx, builtin.serviceNetwork, builtin.image, builtin.run, map[string]string{logLineEnvVarName: logLine})
+		}
+	}
+	alert := log_alerts.NewLogAlert(builtin.serviceName, builtin.regex, builtin.action, maybeTaskRunner)
+	builtin.serviceNetwork.GetLogAlertWatcher().Watch(alert)
+	return fmt.Sprintf("Log alert '%s' with action '%s' added to service '%s'", builtin.regex.String(), builtin.action, builtin.serviceName), nil
+}
+
+func (builtin *AddLogAlertCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	// An updated service has a new container, whose logs the alert needs to follow
+	if instructionsAreEqual && enclaveComponents.HasServiceBeenUpdated(builtin.serviceName) {
+		return enclave_structure.InstructionIsUpdate
+	} else if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *AddLogAlertCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(
+		AddLogAlertBuiltinName,
+	).AddServiceName(
+		builtin.serviceName,
+	)
+}
+
+func (builtin *AddLogAlertCapabilities) UpdatePlan(_ *plan_yaml.PlanYamlGenerator) error {
+	// log alerts do not affect the plan
+	return nil
+}
+
+func (builtin *AddLogAlertCapabilities) Description() string {
+	return builtin.description
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
@@ -84,6 +85,9 @@ func NewAddService(
 
 				resultUuid:     "",  // populated at interpretation time
 				readyCondition: nil, // populated at interpretation time
+				restartPolicy:  "",  // populated at interpretation time
+				crashDumpPath:  "",  // populated at interpretation time
+				dependsOn:      nil, // populated at interpretation time
 
 				interpretationTimeValueStore: interpretationTimeValueStore,
 				description:                  "",  // populated at interpretation time
@@ -107,6 +111,9 @@ type AddServiceCapabilities struct {
 	serviceName    service.ServiceName
 	serviceConfig  *service.ServiceConfig
 	readyCondition *service_config.ReadyCondition
+	restartPolicy  service_health.RestartPolicy
+	crashDumpPath  string
+	dependsOn      []service.ServiceName
 
 	// These params are needed to successfully convert service config if an ImageBuildSpec was provided
 	packageId              string
@@ -153,10 +160,25 @@ func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuilt
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
+	dependsOn, interpretationErr := serviceConfig.GetDependsOn()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	restartPolicy, interpretationErr := serviceConfig.GetRestartPolicy()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	crashDumpPath, interpretationErr := serviceConfig.GetCrashDumpPath()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
 
 	builtin.serviceName = service.ServiceName(serviceName.GoString())
 	builtin.serviceConfig = apiServiceConfig
 	builtin.readyCondition = readyCondition
+	builtin.restartPolicy = restartPolicy
+	builtin.crashDumpPath = crashDumpPath
+	builtin.dependsOn = dependsOn
 	builtin.resultUuid, err = builtin.runtimeValueStore.GetOrCreateValueAssociatedWithService(builtin.serviceName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", AddServiceBuiltinName)
@@ -179,6 +201,9 @@ func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuilt
 }
 
 func (builtin *AddServiceCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if validationErr := validateDependsOn(validatorEnvironment, AddServiceBuiltinName, builtin.serviceName, builtin.dependsOn); validationErr != nil {
+		return validationErr
+	}
 	if validationErr := validateSingleService(validatorEnvironment, builtin.serviceName, builtin.serviceConfig); validationErr != nil {
 		return validationErr
 	}
@@ -211,6 +236,8 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Unexpected error occurred starting service '%s'", replacedServiceName)
 	}
+	// Before the readiness check, so that services crashing while starting up get their diagnostics captured too
+	builtin.serviceNetwork.CollectCrashDiagnostics(replacedServiceName, builtin.crashDumpPath)
 
 	if err := runServiceReadinessCheck(
 		ctx,
@@ -218,6 +245,7 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 		builtin.runtimeValueStore,
 		replacedServiceName,
 		builtin.readyCondition,
+		builtin.restartPolicy,
 	); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while checking if service '%v' is ready", replacedServiceName)
 	}
@@ -225,6 +253,14 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 	if err := fillAddServiceReturnValueWithRuntimeValues(startedService, builtin.resultUuid, builtin.runtimeValueStore); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while adding service return values with result key UUID '%s'", builtin.resultUuid)
 	}
+
+	serviceDependencies, err := getServiceDependencies(builtin.runtimeValueStore, replacedServiceName, builtin.serviceConfig, builtin.dependsOn, builtin.readyCondition)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the dependencies of service '%s'", replacedServiceName)
+	}
+	if err := builtin.serviceNetwork.SaveServiceDependencies(serviceDependencies); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred saving the dependencies of service '%s'", replacedServiceName)
+	}
 	instructionResult := fmt.Sprintf("Service '%s' added with service UUID '%s'", replacedServiceName, startedService.GetRegistration().GetUUID())
 	return instructionResult, nil
 }
@@ -268,6 +304,12 @@ func (builtin *AddServiceCapabilities) TryResolveWith(instructionsAreEqual bool,
 		}
 	}
 
+	// We check if the enclave env vars injected into the service have changed. If that's the case, it should be rerun
+	if enclaveComponents.HaveEnclaveEnvVarsBeenUpdated() {
+		enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentIsUpdated)
+		return enclave_structure.InstructionIsUpdate
+	}
+
 	// We check if service config was changed by a set_service instruction. If that's the case, it should be rerun
 	if builtin.interpretationTimeValueStore.ExistsNewServiceConfigForService(builtin.serviceName) {
 		enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentIsUpdated)
@@ -3,11 +3,14 @@ package add_service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_dependencies"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
@@ -104,6 +107,7 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 	}
 
 	validatorEnvironment.AddServiceName(serviceName)
+	validatorEnvironment.AddServiceUsingContainerImage(serviceConfig.GetContainerImageName(), serviceName)
 
 	if serviceConfig.GetImageBuildSpec() != nil {
 		validatorEnvironment.AppendRequiredImageBuild(serviceConfig.GetContainerImageName(), serviceConfig.GetImageBuildSpec())
@@ -128,6 +132,65 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 	return nil
 }
 
+// validateDependsOn checks that the services listed in `depends_on` were added to the enclave before this service.
+// It must run before the service itself is added to the validator environment.
+func validateDependsOn(validatorEnvironment *startosis_validator.ValidatorEnvironment, instructionName string, serviceName service.ServiceName, dependsOn []service.ServiceName) *startosis_errors.ValidationError {
+	for _, dependencyName := range dependsOn {
+		if dependencyName == serviceName {
+			return startosis_errors.NewValidationError("There was an error validating '%s' as service '%s' declares a dependency on itself in '%s'", instructionName, serviceName, service_config.DependsOnAttr)
+		}
+		if validatorEnvironment.DoesServiceNameExist(dependencyName) == startosis_validator.ComponentNotFound {
+			return startosis_errors.NewValidationError("There was an error validating '%s' as service '%s' depends on service '%s' which does not exist. Services listed in '%s' must be added before the service depending on them", instructionName, serviceName, dependencyName, service_config.DependsOnAttr)
+		}
+	}
+	return nil
+}
+
+// getServiceDependencies builds the dependencies of a service from its explicit `depends_on` list and from the runtime
+// values of other services referenced in its (not yet rendered) config
+func getServiceDependencies(
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	serviceConfig *service.ServiceConfig,
+	dependsOn []service.ServiceName,
+	readyConditions *service_config.ReadyCondition,
+) (*service_dependencies.ServiceDependencies, error) {
+	stringsToScan := []string{}
+	stringsToScan = append(stringsToScan, serviceConfig.GetEntrypointArgs()...)
+	stringsToScan = append(stringsToScan, serviceConfig.GetCmdArgs()...)
+	for _, envVarValue := range serviceConfig.GetEnvVars() {
+		stringsToScan = append(stringsToScan, envVarValue)
+	}
+
+	runtimeValueDependenciesSet := map[service.ServiceName]bool{}
+	for _, stringToScan := range stringsToScan {
+		runtimeValueUuids, err := magic_string_helper.GetRuntimeValueUuidsInString(stringToScan)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred extracting the runtime values referenced in '%s'", stringToScan)
+		}
+		for _, runtimeValueUuid := range runtimeValueUuids {
+			dependencyName, found, err := runtimeValueStore.GetServiceNameAssociatedWithValue(runtimeValueUuid)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the service associated with runtime value '%s'", runtimeValueUuid)
+			}
+			// runtime values not associated with a service come from recipes, which aren't tracked as dependencies
+			if found && dependencyName != serviceName {
+				runtimeValueDependenciesSet[dependencyName] = true
+			}
+		}
+	}
+
+	var runtimeValueDependencies []service.ServiceName
+	for dependencyName := range runtimeValueDependenciesSet {
+		runtimeValueDependencies = append(runtimeValueDependencies, dependencyName)
+	}
+	sort.Slice(runtimeValueDependencies, func(i, j int) bool {
+		return runtimeValueDependencies[i] < runtimeValueDependencies[j]
+	})
+
+	return service_dependencies.NewServiceDependencies(serviceName, dependsOn, runtimeValueDependencies, readyConditions != nil), nil
+}
+
 func invalidServiceNameErrorText(
 	serviceName service.ServiceName,
 ) string {
@@ -225,6 +288,7 @@ func runServiceReadinessCheck(
 	runtimeValueStore *runtime_value_store.RuntimeValueStore,
 	serviceName service.ServiceName,
 	readyConditions *service_config.ReadyCondition,
+	restartPolicy service_health.RestartPolicy,
 ) error {
 	if readyConditions != nil {
 
@@ -294,6 +358,12 @@ func runServiceReadinessCheck(
 			time.Since(startTime),
 			recipe.ResultMapToString(lastResult),
 		)
+
+		// The ready conditions keep being checked after the service started, to catch services that stop working later on
+		serviceNetwork.MonitorServiceHealth(serviceName, func(ctx context.Context) error {
+			return shared_helpers.ExecuteServiceAssertionOnce(ctx, serviceNetwork, runtimeValueStore, serviceName, recipe, field, assertion, target)
+		}, restartPolicy)
 	}
+	serviceNetwork.MarkServiceReady(serviceName)
 	return nil
 }
@@ -129,6 +129,42 @@ func TestAddServiceShared_ServiceNameWithRuntimeValuesAreReplaced(t *testing.T)
 	require.Equal(t, service.ServiceName("database-1"), replacedServiceName)
 }
 
+func TestAddServiceShared_GetServiceDependencies(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, err)
+
+	databaseServiceName := service.ServiceName("database")
+	databaseValueUuid, err := runtimeValueStore.GetOrCreateValueAssociatedWithService(databaseServiceName)
+	require.NoError(t, err)
+	cacheServiceName := service.ServiceName("cache")
+	cacheValueUuid, err := runtimeValueStore.GetOrCreateValueAssociatedWithService(cacheServiceName)
+	require.NoError(t, err)
+	recipeValueUuid, err := runtimeValueStore.CreateValue()
+	require.NoError(t, err)
+
+	serviceName := service.ServiceName("api")
+	serviceConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, nil, nil, nil, []string{
+		"--db=" + fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, databaseValueUuid, ipAddressRuntimeValue),
+		"--token=" + fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, recipeValueUuid, "body"),
+	}, map[string]string{
+		"CACHE_HOST":    fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, cacheValueUuid, hostnameRuntimeValue),
+		"DATABASE_HOST": fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, databaseValueUuid, hostnameRuntimeValue),
+	}, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true)
+	require.NoError(t, err)
+
+	explicitDependencies := []service.ServiceName{"migrations"}
+	serviceDependencies, err := getServiceDependencies(runtimeValueStore, serviceName, serviceConfig, explicitDependencies, nil)
+	require.NoError(t, err)
+	require.Equal(t, serviceName, serviceDependencies.GetServiceName())
+	require.Equal(t, explicitDependencies, serviceDependencies.GetExplicitDependencies())
+	require.Equal(t, []service.ServiceName{cacheServiceName, databaseServiceName}, serviceDependencies.GetRuntimeValueDependencies())
+	require.False(t, serviceDependencies.HasReadyConditions())
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
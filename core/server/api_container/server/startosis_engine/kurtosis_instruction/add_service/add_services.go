@@ -6,6 +6,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_dependencies"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
@@ -76,6 +78,9 @@ func NewAddServices(
 
 				resultUuids:       map[service.ServiceName]string{}, // populated at interpretation time
 				readyConditions:   nil,                              // populated at interpretation time
+				restartPolicies:   nil,                              // populated at interpretation time
+				crashDumpPaths:    nil,                              // populated at interpretation time
+				dependsOn:         nil,                              // populated at interpretation time
 				description:       "",                               // populated at interpretation time
 				imageDownloadMode: imageDownloadMode,
 			}
@@ -101,6 +106,12 @@ type AddServicesCapabilities struct {
 
 	readyConditions map[service.ServiceName]*service_config.ReadyCondition
 
+	restartPolicies map[service.ServiceName]service_health.RestartPolicy
+
+	crashDumpPaths map[service.ServiceName]string
+
+	dependsOn map[service.ServiceName][]service.ServiceName
+
 	resultUuids map[service.ServiceName]string
 	description string
 
@@ -112,7 +123,7 @@ func (builtin *AddServicesCapabilities) Interpret(locatorOfModuleInWhichThisBuil
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConfigsArgName)
 	}
-	serviceConfigs, readyConditions, interpretationErr := validateAndConvertConfigsAndReadyConditions(
+	serviceConfigs, readyConditions, restartPolicies, crashDumpPaths, dependsOn, interpretationErr := validateAndConvertConfigsAndReadyConditions(
 		builtin.serviceNetwork,
 		ServiceConfigsDict,
 		locatorOfModuleInWhichThisBuiltInIsBeingCalled,
@@ -126,6 +137,9 @@ func (builtin *AddServicesCapabilities) Interpret(locatorOfModuleInWhichThisBuil
 	}
 	builtin.serviceConfigs = serviceConfigs
 	builtin.readyConditions = readyConditions
+	builtin.restartPolicies = restartPolicies
+	builtin.crashDumpPaths = crashDumpPaths
+	builtin.dependsOn = dependsOn
 
 	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(addServicesDescriptionFormatStr, len(builtin.serviceConfigs), getNamesAsCommaSeparatedList(builtin.serviceConfigs)))
 
@@ -138,6 +152,13 @@ func (builtin *AddServicesCapabilities) Interpret(locatorOfModuleInWhichThisBuil
 }
 
 func (builtin *AddServicesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	// dependencies are validated before any service of the batch is added to the environment, as services of a same
+	// batch are started concurrently and therefore can't depend on each other
+	for serviceName, dependsOn := range builtin.dependsOn {
+		if err := validateDependsOn(validatorEnvironment, AddServicesBuiltinName, serviceName, dependsOn); err != nil {
+			return err
+		}
+	}
 	for serviceName, serviceConfig := range builtin.serviceConfigs {
 		if err := validateSingleService(validatorEnvironment, serviceName, serviceConfig); err != nil {
 			return err
@@ -152,12 +173,19 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 	if !ok {
 		return "", stacktrace.NewError("An error occurred when getting parallelism level from execution context")
 	}
+	allServiceDependencies := make([]*service_dependencies.ServiceDependencies, 0, len(builtin.serviceConfigs))
 	for serviceName, serviceConfig := range builtin.serviceConfigs {
 		renderedServiceName, renderedServiceConfig, err := replaceMagicStrings(builtin.runtimeValueStore, serviceName, serviceConfig)
 		if err != nil {
 			return "", stacktrace.Propagate(err, "An error occurred replacing a magic string in '%s' instruction arguments for service: '%s'. Execution cannot proceed", AddServicesBuiltinName, serviceName)
 		}
 		renderedServiceConfigs[renderedServiceName] = renderedServiceConfig
+
+		serviceDependencies, err := getServiceDependencies(builtin.runtimeValueStore, renderedServiceName, serviceConfig, builtin.dependsOn[serviceName], builtin.readyConditions[serviceName])
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred getting the dependencies of service '%s'", renderedServiceName)
+		}
+		allServiceDependencies = append(allServiceDependencies, serviceDependencies)
 	}
 
 	serviceToUpdate := map[service.ServiceName]*service.ServiceConfig{}
@@ -210,6 +238,11 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 	}
 	shouldDeleteAllStartedServices := true
 
+	// Before the readiness check, so that services crashing while starting up get their diagnostics captured too
+	for serviceName := range startedAndUpdatedService {
+		builtin.serviceNetwork.CollectCrashDiagnostics(serviceName, builtin.crashDumpPaths[serviceName])
+	}
+
 	//TODO we should move the readiness check functionality to the default service network to improve performance
 	///TODO because we won't have to wait for all services to start for checking readiness, but first we have to
 	//TODO propagate the Recipes to this layer too and probably move the wait instruction also
@@ -235,12 +268,17 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 		}
 		instructionResult.WriteString(fmt.Sprintf("\n  Service '%s' added with UUID '%s'", serviceName, serviceObj.GetRegistration().GetUUID()))
 	}
+	for _, serviceDependencies := range allServiceDependencies {
+		if err := builtin.serviceNetwork.SaveServiceDependencies(serviceDependencies); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred saving the dependencies of service '%s'", serviceDependencies.GetServiceName())
+		}
+	}
 	shouldDeleteAllStartedServices = false
 	return instructionResult.String(), nil
 }
 
 func (builtin *AddServicesCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
-	if instructionsAreEqual {
+	if instructionsAreEqual && !enclaveComponents.HaveEnclaveEnvVarsBeenUpdated() {
 		for serviceName := range builtin.serviceConfigs {
 			enclaveComponents.AddService(serviceName, enclave_structure.ComponentWasLeftIntact)
 		}
@@ -295,7 +333,8 @@ func (builtin *AddServicesCapabilities) TryResolveWith(instructionsAreEqual bool
 		}
 	}
 
-	if !instructionsAreEqual || atLeastOneFileHasBeenUpdated {
+	// services also need to be re-created when the enclave env vars injected into them have changed
+	if !instructionsAreEqual || atLeastOneFileHasBeenUpdated || enclaveComponents.HaveEnclaveEnvVarsBeenUpdated() {
 		for serviceName := range builtin.serviceConfigs {
 			if _, found := previouslyAddedService[serviceName]; found {
 				enclaveComponents.AddService(serviceName, enclave_structure.ComponentIsUpdated)
@@ -416,6 +455,7 @@ func (builtin *AddServicesCapabilities) runServiceReadinessCheck(
 		builtin.runtimeValueStore,
 		serviceName,
 		readyConditions,
+		builtin.restartPolicies[serviceName],
 	); err != nil {
 		serviceErr = stacktrace.Propagate(err, "An error occurred while checking if service '%v' is ready", serviceName)
 		return
@@ -433,45 +473,69 @@ func validateAndConvertConfigsAndReadyConditions(
 ) (
 	map[service.ServiceName]*service.ServiceConfig,
 	map[service.ServiceName]*service_config.ReadyCondition,
+	map[service.ServiceName]service_health.RestartPolicy,
+	map[service.ServiceName]string,
+	map[service.ServiceName][]service.ServiceName,
 	*startosis_errors.InterpretationError,
 ) {
 	configsDict, ok := configs.(*starlark.Dict)
 	if !ok {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
+		return nil, nil, nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
 	}
 	if configsDict.Len() == 0 {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
+		return nil, nil, nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
 	}
 	convertedServiceConfigs := map[service.ServiceName]*service.ServiceConfig{}
 	readyConditionsByServiceName := map[service.ServiceName]*service_config.ReadyCondition{}
+	restartPoliciesByServiceName := map[service.ServiceName]service_health.RestartPolicy{}
+	crashDumpPathsByServiceName := map[service.ServiceName]string{}
+	dependsOnByServiceName := map[service.ServiceName][]service.ServiceName{}
 	for _, serviceName := range configsDict.Keys() {
 		serviceNameStr, isServiceNameAString := serviceName.(starlark.String)
 		if !isServiceNameAString {
-			return nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
+			return nil, nil, nil, nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
 		}
 
 		dictValue, found, err := configsDict.Get(serviceName)
 		if err != nil || !found {
-			return nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
+			return nil, nil, nil, nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
 		}
 		serviceConfig, isDictValueAServiceConfig := dictValue.(*service_config.ServiceConfig)
 		if !isDictValueAServiceConfig {
-			return nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
+			return nil, nil, nil, nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
 		}
 		apiServiceConfig, interpretationErr := serviceConfig.ToKurtosisType(serviceNetwork, locatorOfModuleInWhichThisBuiltInIsBeingCalled, packageId, packageContentProvider, packageReplaceOptions, imageDownloadMode)
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, nil, nil, interpretationErr
 		}
 		convertedServiceConfigs[service.ServiceName(serviceNameStr.GoString())] = apiServiceConfig
 
 		readyConditions, interpretationErr := serviceConfig.GetReadyCondition()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, nil, nil, interpretationErr
 		}
 
 		readyConditionsByServiceName[service.ServiceName(serviceNameStr.GoString())] = readyConditions
+
+		restartPolicy, interpretationErr := serviceConfig.GetRestartPolicy()
+		if interpretationErr != nil {
+			return nil, nil, nil, nil, nil, interpretationErr
+		}
+		restartPoliciesByServiceName[service.ServiceName(serviceNameStr.GoString())] = restartPolicy
+
+		crashDumpPath, interpretationErr := serviceConfig.GetCrashDumpPath()
+		if interpretationErr != nil {
+			return nil, nil, nil, nil, nil, interpretationErr
+		}
+		crashDumpPathsByServiceName[service.ServiceName(serviceNameStr.GoString())] = crashDumpPath
+
+		dependsOn, interpretationErr := serviceConfig.GetDependsOn()
+		if interpretationErr != nil {
+			return nil, nil, nil, nil, nil, interpretationErr
+		}
+		dependsOnByServiceName[service.ServiceName(serviceNameStr.GoString())] = dependsOn
 	}
-	return convertedServiceConfigs, readyConditionsByServiceName, nil
+	return convertedServiceConfigs, readyConditionsByServiceName, restartPoliciesByServiceName, crashDumpPathsByServiceName, dependsOnByServiceName, nil
 }
 
 func makeAndPersistAddServicesInterpretationReturnValue(serviceConfigs map[service.ServiceName]*service.ServiceConfig, runtimeValueStore *runtime_value_store.RuntimeValueStore, interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore) (map[service.ServiceName]string, *starlark.Dict, *startosis_errors.InterpretationError) {
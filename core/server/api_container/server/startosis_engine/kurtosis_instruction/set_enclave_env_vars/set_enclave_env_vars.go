@@ -0,0 +1,145 @@
+package set_enclave_env_vars
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"sort"
+	"strings"
+)
+
+const (
+	SetEnclaveEnvVarsBuiltinName = "set_enclave_env_vars"
+
+	EnvVarsArgName = "env_vars"
+
+	descriptionStr = "Setting enclave env vars"
+)
+
+func NewSetEnclaveEnvVars(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SetEnclaveEnvVarsBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              EnvVarsArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringMappingToString(value, EnvVarsArgName)
+					},
+				},
+			},
+			Deprecation: nil,
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &SetEnclaveEnvVarsCapabilities{
+				serviceNetwork:    serviceNetwork,
+				runtimeValueStore: runtimeValueStore,
+
+				envVars:     nil, // populated at interpretation time
+				description: "",  // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			EnvVarsArgName: true,
+		},
+	}
+}
+
+type SetEnclaveEnvVarsCapabilities struct {
+	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	envVars map[string]string
+
+	description string
+}
+
+func (builtin *SetEnclaveEnvVarsCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	envVarsStarlark, err := builtin_argument.ExtractArgumentValue[*starlark.Dict](arguments, EnvVarsArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", EnvVarsArgName)
+	}
+	envVars, interpretationErr := kurtosis_types.SafeCastToMapStringString(envVarsStarlark, EnvVarsArgName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if len(envVars) == 0 {
+		return nil, startosis_errors.NewInterpretationError("The '%s' argument of '%s' needs to contain at least one environment variable", EnvVarsArgName, SetEnclaveEnvVarsBuiltinName)
+	}
+	for envVarName := range envVars {
+		if strings.TrimSpace(envVarName) == "" {
+			return nil, startosis_errors.NewInterpretationError("The '%s' argument of '%s' contains an environment variable with an empty name", EnvVarsArgName, SetEnclaveEnvVarsBuiltinName)
+		}
+	}
+
+	builtin.envVars = envVars
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, descriptionStr)
+	return starlark.None, nil
+}
+
+func (builtin *SetEnclaveEnvVarsCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, _ *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	return nil
+}
+
+func (builtin *SetEnclaveEnvVarsCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	renderedEnvVars := make(map[string]string, len(builtin.envVars))
+	for envVarName, envVarValue := range builtin.envVars {
+		renderedEnvVarValue, err := magic_string_helper.ReplaceRuntimeValueInString(envVarValue, builtin.runtimeValueStore)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred replacing runtime values in the value of enclave env var '%s': '%s'", envVarName, envVarValue)
+		}
+		renderedEnvVars[envVarName] = renderedEnvVarValue
+	}
+
+	if err := builtin.serviceNetwork.SetEnclaveEnvVars(renderedEnvVars); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting the enclave env vars")
+	}
+
+	envVarNames := make([]string, 0, len(renderedEnvVars))
+	for envVarName := range renderedEnvVars {
+		envVarNames = append(envVarNames, envVarName)
+	}
+	sort.Strings(envVarNames)
+	return fmt.Sprintf("Enclave env vars '%s' set. They will be injected into every service added from now on", strings.Join(envVarNames, "', '")), nil
+}
+
+func (builtin *SetEnclaveEnvVarsCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	// the enclave env vars are changing, all the services added after this instruction need to be re-created to
+	// pick up the new values
+	enclaveComponents.SetEnclaveEnvVarsUpdated()
+	return enclave_structure.InstructionIsUpdate
+}
+
+func (builtin *SetEnclaveEnvVarsCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(SetEnclaveEnvVarsBuiltinName)
+}
+
+func (builtin *SetEnclaveEnvVarsCapabilities) UpdatePlan(_ *plan_yaml.PlanYamlGenerator) error {
+	// setting enclave env vars does not affect the plan
+	return nil
+}
+
+func (builtin *SetEnclaveEnvVarsCapabilities) Description() string {
+	return builtin.description
+}
@@ -64,6 +64,20 @@ func GetOrReplaceRuntimeValueFromString(originalString string, runtimeValueStore
 	}
 }
 
+// GetRuntimeValueUuidsInString returns the UUIDs of all the runtime values referenced in the string, in order of
+// appearance
+func GetRuntimeValueUuidsInString(originalString string) ([]string, error) {
+	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
+	if runtimeValueMatchIndex == subExpNotFound {
+		return nil, stacktrace.NewError("There was an error in finding the sub group '%v' in regexp '%v'. This is a Kurtosis Bug", runtimeValueSubgroupName, compiledRuntimeValueReplacementRegex.String())
+	}
+	var runtimeValueUuids []string
+	for _, match := range compiledRuntimeValueReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches) {
+		runtimeValueUuids = append(runtimeValueUuids, match[runtimeValueMatchIndex])
+	}
+	return runtimeValueUuids, nil
+}
+
 func getRuntimeValueFromRegexMatch(match []string, runtimeValueStore *runtime_value_store.RuntimeValueStore) (starlark.Comparable, error) {
 	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
 	if runtimeValueMatchIndex == subExpNotFound {
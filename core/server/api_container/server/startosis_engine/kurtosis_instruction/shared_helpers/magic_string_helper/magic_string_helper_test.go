@@ -90,6 +90,24 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 	require.Equal(t, resolvedInterpolatedString, testExpectedInterpolatedString.GoString())
 }
 
+func TestGetRuntimeValueUuidsInString(t *testing.T) {
+	firstUuid := "cddc2ea3948149d9afa2ef93abb4ec52"
+	secondUuid := "ae5c8bf2fbeb4de68f647280b1c79cbb"
+	interpolatedString := fmt.Sprintf(
+		"http://%v:%v and %v",
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, firstUuid, "ip_address"),
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, firstUuid, "ports.http.number"),
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, secondUuid, "hostname"),
+	)
+	runtimeValueUuids, err := GetRuntimeValueUuidsInString(interpolatedString)
+	require.NoError(t, err)
+	require.Equal(t, []string{firstUuid, firstUuid, secondUuid}, runtimeValueUuids)
+
+	runtimeValueUuids, err = GetRuntimeValueUuidsInString("no runtime value here")
+	require.NoError(t, err)
+	require.Empty(t, runtimeValueUuids)
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
@@ -80,6 +80,28 @@ func ExecuteServiceAssertionWithRecipe(
 
 }
 
+// ExecuteServiceAssertionOnce executes the recipe once and asserts its result, without retrying; used to keep
+// checking the ready conditions of a service after it started
+func ExecuteServiceAssertionOnce(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	recipe recipe.Recipe,
+	valueField string,
+	assertion string,
+	target starlark.Comparable,
+) error {
+	result, err := execRequestAndGetValue(ctx, serviceNetwork, runtimeValueStore, serviceName, recipe, valueField)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred executing the recipe on service '%v'", serviceName)
+	}
+	if err := assertResult(result[valueField], assertion, target); err != nil {
+		return stacktrace.Propagate(err, "The result of the recipe executed on service '%v' didn't pass the assertion", serviceName)
+	}
+	return nil
+}
+
 func assertResult(currentResult starlark.Comparable, assertion string, target starlark.Comparable) error {
 	err := verify.Verify(currentResult, assertion, target)
 	if err != nil {
@@ -0,0 +1,189 @@
+package store_image_files
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	StoreImageFilesBuiltinName = "store_image_files"
+
+	ImageArgName        = "image"
+	SrcArgName          = "src"
+	ArtifactNameArgName = "name"
+)
+
+const (
+	descriptionFormatStr = "Storing files from image '%v' at path '%v' to files artifact with name '%v'"
+)
+
+// NewStoreImageFiles creates the instruction storing a path baked into a container image as a files artifact. No
+// container is started from the image, so it also works with images without a shell or an entrypoint
+func NewStoreImageFiles(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: StoreImageFilesBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ImageArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ImageArgName)
+					},
+				},
+				{
+					Name:              SrcArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, SrcArgName)
+					},
+				},
+				{
+					Name:              ArtifactNameArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &StoreImageFilesCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				image:        "", // populated at interpretation time
+				src:          "", // populated at interpretation time
+				artifactName: "", // populated at interpretation time
+				description:  "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ImageArgName:        true,
+			SrcArgName:          true,
+			ArtifactNameArgName: true,
+		},
+	}
+}
+
+type StoreImageFilesCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	image        string
+	src          string
+	artifactName string
+	description  string
+}
+
+func (builtin *StoreImageFilesCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	if !arguments.IsSet(ArtifactNameArgName) {
+		natureThemeName, err := builtin.serviceNetwork.GetUniqueNameForFileArtifact()
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to auto generate name '%s' argument", ArtifactNameArgName)
+		}
+		builtin.artifactName = natureThemeName
+	} else {
+		artifactName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ArtifactNameArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ArtifactNameArgName)
+		}
+		builtin.artifactName = artifactName.GoString()
+	}
+
+	image, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ImageArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ImageArgName)
+	}
+
+	src, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, SrcArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", SrcArgName)
+	}
+
+	builtin.image = image.GoString()
+	builtin.src = src.GoString()
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(descriptionFormatStr, builtin.image, builtin.src, builtin.artifactName))
+	return starlark.String(builtin.artifactName), nil
+}
+
+func (builtin *StoreImageFilesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if validatorEnvironment.DoesArtifactNameExist(builtin.artifactName) == startosis_validator.ComponentCreatedOrUpdatedDuringPackageRun {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as artifact name '%v' already exists", StoreImageFilesBuiltinName, builtin.artifactName)
+	}
+	// the image is pulled during validation, like the images of the services, so it's available locally at execution time
+	validatorEnvironment.AppendRequiredImagePull(builtin.image)
+	validatorEnvironment.AddArtifactName(builtin.artifactName)
+	return nil
+}
+
+func (builtin *StoreImageFilesCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	artifactUuid, err := builtin.serviceNetwork.CopyFilesFromImage(ctx, builtin.image, builtin.src, builtin.artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed to copy file '%v' from image '%v'", builtin.src, builtin.image)
+	}
+	instructionResult := fmt.Sprintf("Files with artifact name '%s' uploaded with artifact UUID '%s'", builtin.artifactName, artifactUuid)
+	return instructionResult, nil
+}
+
+func (builtin *StoreImageFilesCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	// if other instruction is nil or other instruction is not a store_image_files instruction, status is unknown
+	if other == nil {
+		enclaveComponents.AddFilesArtifact(builtin.artifactName, enclave_structure.ComponentIsNew)
+		return enclave_structure.InstructionIsUnknown
+	}
+	if other.Type != StoreImageFilesBuiltinName {
+		enclaveComponents.AddFilesArtifact(builtin.artifactName, enclave_structure.ComponentIsNew)
+		return enclave_structure.InstructionIsUnknown
+	}
+
+	// if artifact names don't match, status is unknown, instructions can't be resolved together
+	if !other.HasOnlyFilesArtifactName(builtin.artifactName) {
+		enclaveComponents.AddFilesArtifact(builtin.artifactName, enclave_structure.ComponentIsNew)
+		return enclave_structure.InstructionIsUnknown
+	}
+
+	// If artifact names are the same but instructions are not equal, it needs to be re-run anyway
+	if !instructionsAreEqual {
+		enclaveComponents.AddFilesArtifact(builtin.artifactName, enclave_structure.ComponentIsUpdated)
+		return enclave_structure.InstructionIsUpdate
+	}
+
+	enclaveComponents.AddFilesArtifact(builtin.artifactName, enclave_structure.ComponentWasLeftIntact)
+	return enclave_structure.InstructionIsEqual
+}
+
+func (builtin *StoreImageFilesCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	// No need for the MD5 here, the image and the path are part of the instruction arguments, so the instruction is
+	// considered equal as long as they don't change
+	builder.SetType(
+		StoreImageFilesBuiltinName,
+	).AddFilesArtifact(
+		builtin.artifactName, nil,
+	)
+}
+
+func (builtin *StoreImageFilesCapabilities) UpdatePlan(plan *plan_yaml.PlanYamlGenerator) error {
+	err := plan.AddStoreImageFiles(builtin.artifactName, builtin.image, builtin.src)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating plan with store image files")
+	}
+	return nil
+}
+
+func (builtin *StoreImageFilesCapabilities) Description() string {
+	return builtin.description
+}
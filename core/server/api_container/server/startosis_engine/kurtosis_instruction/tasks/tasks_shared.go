@@ -236,6 +236,37 @@ func executeWithWait(ctx context.Context, serviceNetwork service_network.Service
 	}
 }
 
+// RunShTask runs the sh script in a one-off task container of the image, or of the default image of run_sh if empty,
+// and removes the container afterwards. It's for tasks run outside of the plan, so it returns an error if the script
+// doesn't exit with 0 instead of storing its result
+func RunShTask(ctx context.Context, serviceNetwork service_network.ServiceNetwork, image string, run string, envVars map[string]string) error {
+	if image == "" {
+		image = defaultRunShImageName
+	}
+	serviceConfig, err := getServiceConfig(image, nil, nil, nil, nil, &envVars)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the config of the task container")
+	}
+	taskName := fmt.Sprintf("task-%v", uuid.NewRandom().String())
+	if _, err = serviceNetwork.AddService(ctx, service.ServiceName(taskName), serviceConfig); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a task with image: %v", image)
+	}
+	defer func() {
+		if err := removeService(ctx, serviceNetwork, taskName); err != nil {
+			logrus.Warnf("Attempted to remove the temporary task container '%v' but failed; it will get cleaned up with the enclave:\n%v", taskName, err)
+		}
+	}()
+
+	result, err := executeWithWait(ctx, serviceNetwork, taskName, DefaultWaitTimeoutDurationStr, getCommandToRunForStreamingLogs(run))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred executing task command: %v", run)
+	}
+	if int64(result.GetExitCode()) != defaultAcceptableCodes[0] {
+		return stacktrace.NewError(formatErrorMessage(fmt.Sprintf("Task returned exit code '%v' with output:", result.GetExitCode()), result.GetOutput()))
+	}
+	return nil
+}
+
 func validatePathIsUniqueWhileCreatingFileArtifact(storeSpecList []*store_spec.StoreSpec) *startosis_errors.ValidationError {
 	if len(storeSpecList) > 0 {
 		duplicates := map[string]uint16{}
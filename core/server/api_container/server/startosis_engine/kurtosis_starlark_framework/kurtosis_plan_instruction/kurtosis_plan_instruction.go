@@ -61,8 +61,9 @@ func (builtin *KurtosisPlanInstructionWrapper) CreateBuiltin() func(thread *star
 
 		var enclavePlanInstructionPulledFromMaskMaybe *enclave_plan_persistence.EnclavePlanInstruction
 		var instructionResolutionStatus enclave_structure.InstructionResolutionStatus
+		maskIdx := resolver.NoInstructionIdx
 		if builtin.instructionPlanMask.HasNext() {
-			_, enclavePlanInstructionPulledFromMaskMaybe = builtin.instructionPlanMask.Next()
+			maskIdx, enclavePlanInstructionPulledFromMaskMaybe = builtin.instructionPlanMask.Next()
 			if enclavePlanInstructionPulledFromMaskMaybe != nil {
 				instructionResolutionStatus = instructionWrapper.TryResolveWith(enclavePlanInstructionPulledFromMaskMaybe, builtin.enclaveComponents)
 			} else {
@@ -111,14 +112,14 @@ func (builtin *KurtosisPlanInstructionWrapper) CreateBuiltin() func(thread *star
 					instructionWrapper.GetPositionInOriginalScript().String())
 			}
 			if enclavePlanInstructionPulledFromMaskMaybe != nil { // why is it that the mask is invalid if this is the case? and why not make this check before adding the instruction to the plan?
-				builtin.instructionPlanMask.MarkAsInvalid()
+				builtin.instructionPlanMask.MarkAsInvalid(maskIdx)
 				logrus.Debugf("Marking the plan as invalid as instruction '%s' differs from '%s'",
 					instructionWrapper.String(), enclavePlanInstructionPulledFromMaskMaybe.StarlarkCode)
 			}
 			return returnedFutureValue, nil
 		case enclave_structure.InstructionIsNotResolvableAbort:
 			// if the instructions differs, then the mask is invalid
-			builtin.instructionPlanMask.MarkAsInvalid()
+			builtin.instructionPlanMask.MarkAsInvalid(maskIdx)
 			logrus.Debugf("Marking the plan as invalid as instruction '%s' had the following resolution status: '%s'",
 				instructionWrapper.String(), instructionResolutionStatus)
 			if err := builtin.instructionsPlan.AddInstruction(instructionWrapper, returnedFutureValue); err != nil {
@@ -0,0 +1,57 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/log_alerts"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_log_alert"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+const (
+	testLogAlertRegex = "^FATAL"
+)
+
+type addLogAlertTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestAddLogAlert() {
+	logAlertWatcher := log_alerts.NewLogAlertWatcher(func(ctx context.Context, serviceName service.ServiceName) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("")), nil
+	})
+	suite.serviceNetwork.EXPECT().GetLogAlertWatcher().Times(1).Return(logAlertWatcher)
+
+	suite.run(&addLogAlertTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *addLogAlertTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return add_log_alert.NewAddLogAlert(t.serviceNetwork)
+}
+
+func (t *addLogAlertTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q)", add_log_alert.AddLogAlertBuiltinName, add_log_alert.ServiceNameArgName, testServiceName, add_log_alert.RegexArgName, testLogAlertRegex, add_log_alert.ActionArgName, log_alerts.Action_Event)
+}
+
+func (t *addLogAlertTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addLogAlertTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Log alert '%s' with action '%s' added to service '%s'", testLogAlertRegex, log_alerts.Action_Event, testServiceName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
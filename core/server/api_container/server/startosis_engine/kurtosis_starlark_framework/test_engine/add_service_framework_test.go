@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_dependencies"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
@@ -47,6 +48,11 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddService() {
 		service.NewService(service.NewServiceRegistration(testServiceName, testServiceUuid, testEnclaveUuid, nil, string(testServiceName)), nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, "", nil, nil, nil)),
 		nil,
 	)
+	suite.serviceNetwork.EXPECT().SaveServiceDependencies(
+		service_dependencies.NewServiceDependencies(testServiceName, nil, nil, false),
+	).Times(1).Return(nil)
+	suite.serviceNetwork.EXPECT().CollectCrashDiagnostics(testServiceName, testCrashDumpPath).Times(1).Return()
+	suite.serviceNetwork.EXPECT().MarkServiceReady(testServiceName).Times(1).Return()
 
 	suite.run(&addServiceTestCase{
 		T:                            suite.T(),
@@ -69,7 +75,7 @@ func (t *addServiceTestCase) GetInstruction() *kurtosis_plan_instruction.Kurtosi
 }
 
 func (t *addServiceTestCase) GetStarlarkCode() string {
-	serviceConfig := fmt.Sprintf("ServiceConfig(image=%q)", testContainerImageName)
+	serviceConfig := fmt.Sprintf("ServiceConfig(image=%q, crash_dump_path=%q)", testContainerImageName, testCrashDumpPath)
 	return fmt.Sprintf(`%s(%s=%q, %s=%s)`, add_service.AddServiceBuiltinName, add_service.ServiceNameArgName, testServiceName, add_service.ServiceConfigArgName, serviceConfig)
 }
 
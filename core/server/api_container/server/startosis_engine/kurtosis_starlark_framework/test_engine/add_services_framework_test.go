@@ -16,6 +16,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_dependencies"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
@@ -163,6 +165,29 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 		TLS:              nil,
 	}, nil)
 
+	suite.serviceNetwork.EXPECT().SaveServiceDependencies(
+		service_dependencies.NewServiceDependencies(testServiceName, nil, nil, true),
+	).Times(1).Return(nil)
+	suite.serviceNetwork.EXPECT().SaveServiceDependencies(
+		service_dependencies.NewServiceDependencies(testServiceName2, nil, nil, true),
+	).Times(1).Return(nil)
+
+	suite.serviceNetwork.EXPECT().CollectCrashDiagnostics(testServiceName, "").Times(1).Return()
+	suite.serviceNetwork.EXPECT().CollectCrashDiagnostics(testServiceName2, "").Times(1).Return()
+	suite.serviceNetwork.EXPECT().MarkServiceReady(testServiceName).Times(1).Return()
+	suite.serviceNetwork.EXPECT().MarkServiceReady(testServiceName2).Times(1).Return()
+
+	suite.serviceNetwork.EXPECT().MonitorServiceHealth(
+		testServiceName,
+		mock.Anything,
+		service_health.RestartPolicy_Never,
+	).Times(1).Return()
+	suite.serviceNetwork.EXPECT().MonitorServiceHealth(
+		testServiceName2,
+		mock.Anything,
+		service_health.RestartPolicy_OnUnhealthy,
+	).Times(1).Return()
+
 	suite.run(&addServicesTestCase{
 		T:                            suite.T(),
 		serviceNetwork:               suite.serviceNetwork,
@@ -196,7 +221,7 @@ func (t *addServicesTestCase) GetStarlarkCode() string {
 		testReadyConditions2Timeout,
 	)
 	serviceConfig1 := fmt.Sprintf("ServiceConfig(image=%q, ready_conditions=%s)", testContainerImageName, service1ReadyConditionsScriptPart)
-	serviceConfig2 := fmt.Sprintf("ServiceConfig(image=%q, cpu_allocation=%d, memory_allocation=%d, ready_conditions=%s)", testContainerImageName, testCpuAllocation, testMemoryAllocation, service2ReadyConditionsScriptPart)
+	serviceConfig2 := fmt.Sprintf("ServiceConfig(image=%q, cpu_allocation=%d, memory_allocation=%d, ready_conditions=%s, restart_policy=%q)", testContainerImageName, testCpuAllocation, testMemoryAllocation, service2ReadyConditionsScriptPart, service_health.RestartPolicy_OnUnhealthy)
 	return fmt.Sprintf(`%s(%s={%q: %s, %q: %s})`, add_service.AddServicesBuiltinName, add_service.ConfigsArgName, testServiceName, serviceConfig1, testServiceName2, serviceConfig2)
 }
 
@@ -0,0 +1,51 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/assert_eq"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type assertEqTestCase struct {
+	*testing.T
+
+	actual string
+}
+
+func (suite *KurtosisHelperTestSuite) TestAssertEq() {
+	suite.run(&assertEqTestCase{
+		T:      suite.T(),
+		actual: `{"key": ["value"]}`,
+	})
+}
+
+func (suite *KurtosisHelperTestSuite) TestAssertEq_NotEqual() {
+	suite.runShouldFail(
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		&assertEqTestCase{
+			T:      suite.T(),
+			actual: `{"key": ["other-value"]}`,
+		},
+		`Values differ: expected '{"key": ["value"]}' but got '{"key": ["other-value"]}'`,
+	)
+}
+
+func (t *assertEqTestCase) GetHelper() *kurtosis_helper.KurtosisHelper {
+	return assert_eq.NewAssertEq()
+}
+
+func (t *assertEqTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(`%s(%s=%s, %s=%s, %s=%q)`, assert_eq.AssertEqBuiltinName, assert_eq.ActualArgName, t.actual, assert_eq.ExpectedArgName, `{"key": ["value"]}`, assert_eq.MsgArgName, "Values differ")
+}
+
+func (t *assertEqTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *assertEqTestCase) Assert(result starlark.Value) {
+	require.Equal(t, starlark.None, result)
+}
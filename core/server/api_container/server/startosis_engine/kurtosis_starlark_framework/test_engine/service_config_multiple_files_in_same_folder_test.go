@@ -22,7 +22,7 @@ type serviceConfigMultipleFilesInSameFolderTestCase struct {
 func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigMultipleFilesInSameFolder() {
 
 	suite.serviceNetwork.EXPECT().GetApiContainerInfo().Times(1).Return(
-		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), 0, "0.0.0"),
+		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), 0, "0.0.0", nil),
 	)
 
 	suite.run(&serviceConfigMultipleFilesInSameFolderTestCase{
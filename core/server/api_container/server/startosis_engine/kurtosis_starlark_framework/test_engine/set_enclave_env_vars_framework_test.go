@@ -0,0 +1,58 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/set_enclave_env_vars"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	testEnclaveEnvVarName  = "CHAIN_ID"
+	testEnclaveEnvVarValue = "1337"
+)
+
+type setEnclaveEnvVarsTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestSetEnclaveEnvVars() {
+	suite.serviceNetwork.EXPECT().SetEnclaveEnvVars(
+		map[string]string{
+			testEnclaveEnvVarName: testEnclaveEnvVarValue,
+		},
+	).Times(1).Return(
+		nil,
+	)
+
+	suite.run(&setEnclaveEnvVarsTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *setEnclaveEnvVarsTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return set_enclave_env_vars.NewSetEnclaveEnvVars(t.serviceNetwork, t.runtimeValueStore)
+}
+
+func (t *setEnclaveEnvVarsTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s={%q: %q})", set_enclave_env_vars.SetEnclaveEnvVarsBuiltinName, set_enclave_env_vars.EnvVarsArgName, testEnclaveEnvVarName, testEnclaveEnvVarValue)
+}
+
+func (t *setEnclaveEnvVarsTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *setEnclaveEnvVarsTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Enclave env vars '%s' set. They will be injected into every service added from now on", testEnclaveEnvVarName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -31,6 +31,7 @@ var (
 	testModuleAbsoluteLocator                      = startosis_packages.NewPackageAbsoluteLocator(testModuleFileName, "")
 	emptyAbsoluteLocator                           = startosis_packages.NewPackageAbsoluteLocator("", "")
 	testContainerImageName                         = "kurtosistech/example-datastore-server"
+	testCrashDumpPath                              = "/var/dumps"
 	testBuildContextDir                            = "./"
 	defaultBuildFile                               = "Dockerfile"
 	testBuildFile                                  = "foo.Dockerfile"
@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_image_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type storeImageFilesTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestStoreImageFiles() {
+	suite.serviceNetwork.EXPECT().CopyFilesFromImage(
+		mock.Anything,
+		testContainerImageName,
+		testSrcPath,
+		testArtifactName,
+	).Times(1).Return(
+		testArtifactUuid,
+		nil,
+	)
+
+	suite.run(&storeImageFilesTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *storeImageFilesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return store_image_files.NewStoreImageFiles(t.serviceNetwork)
+}
+
+func (t *storeImageFilesTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q)", store_image_files.StoreImageFilesBuiltinName, store_image_files.ImageArgName, testContainerImageName, store_image_files.SrcArgName, testSrcPath, store_image_files.ArtifactNameArgName, testArtifactName)
+}
+
+func (t *storeImageFilesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *storeImageFilesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.String(testArtifactName), interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Files with artifact name '%s' uploaded with artifact UUID '%s'", testArtifactName, testArtifactUuid)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
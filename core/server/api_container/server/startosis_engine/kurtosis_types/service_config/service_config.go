@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
@@ -52,6 +53,9 @@ const (
 	NodeSelectorsAttr               = "node_selectors"
 	FilesToBeMovedAttr              = "files_to_be_moved"
 	TiniEnabledAttr                 = "tini_enabled"
+	DependsOnAttr                   = "depends_on"
+	RestartPolicyAttr               = "restart_policy"
+	CrashDumpPathAttr               = "crash_dump_path"
 
 	DefaultPrivateIPAddrPlaceholder = "KURTOSIS_IP_ADDR_PLACEHOLDER"
 
@@ -227,6 +231,33 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
 					Validator:         nil,
 				},
+				{
+					Name:              DependsOnAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringListWithNotEmptyValues(value, DependsOnAttr)
+					},
+				},
+				{
+					Name:              RestartPolicyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, RestartPolicyAttr, []string{
+							string(service_health.RestartPolicy_Never),
+							string(service_health.RestartPolicy_OnUnhealthy),
+						})
+					},
+				},
+				{
+					Name:              CrashDumpPathAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, CrashDumpPathAttr)
+					},
+				},
 			},
 		},
 
@@ -583,6 +614,62 @@ func (config *ServiceConfig) GetReadyCondition() (*ReadyCondition, *startosis_er
 	return readyConditions, nil
 }
 
+// GetDependsOn returns the names of the services this service explicitly declared as dependencies. It only records
+// the dependency, Kurtosis already starts services in the order they're added in the plan.
+func (config *ServiceConfig) GetDependsOn() ([]service.ServiceName, *startosis_errors.InterpretationError) {
+	dependsOnStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, DependsOnAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found || dependsOnStarlark.Len() == 0 {
+		return nil, nil
+	}
+
+	dependsOn, interpretationErr := kurtosis_types.SafeCastToStringSlice(dependsOnStarlark, DependsOnAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	serviceNames := make([]service.ServiceName, len(dependsOn))
+	for index, serviceNameStr := range dependsOn {
+		serviceNames[index] = service.ServiceName(serviceNameStr)
+	}
+	return serviceNames, nil
+}
+
+// GetRestartPolicy returns what to do with the service when it fails its ready conditions after it started. Defaults
+// to only reporting the service as unhealthy.
+func (config *ServiceConfig) GetRestartPolicy() (service_health.RestartPolicy, *startosis_errors.InterpretationError) {
+	restartPolicyStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, RestartPolicyAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return service_health.RestartPolicy_Never, nil
+	}
+
+	readyConditions, interpretationErr := config.GetReadyCondition()
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if readyConditions == nil {
+		return "", startosis_errors.NewInterpretationError("Attribute '%s' of '%s' requires '%s' to be set, as they are what the health of the service is checked with", RestartPolicyAttr, ServiceConfigTypeName, ReadyConditionsAttr)
+	}
+	return service_health.RestartPolicy(restartPolicyStarlark.GoString()), nil
+}
+
+// GetCrashDumpPath returns the path inside the service container where the service writes its core or heap dumps, if
+// any. It gets copied in the crash diagnostics of the service when it crashes. Empty when not set.
+func (config *ServiceConfig) GetCrashDumpPath() (string, *startosis_errors.InterpretationError) {
+	crashDumpPathStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, CrashDumpPathAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", nil
+	}
+	return crashDumpPathStarlark.GoString(), nil
+}
+
 func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string][]string, serviceNetwork service_network.ServiceNetwork) (*service_directory.FilesArtifactsExpansion, *startosis_errors.InterpretationError) {
 	filesArtifactsExpansions := []args.FilesArtifactExpansion{}
 	serviceDirpathsToArtifactIdentifiers := map[string][]string{}
@@ -608,10 +695,21 @@ func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string][]str
 	//  passing the APIC info DOWN to the backend and have the backend create the expander itself.
 	//  Here writing those info into each service config is dumb
 	apiContainerInfo := serviceNetwork.GetApiContainerInfo()
+	// The expander calls the API container with the client certificate the engine issued to the API container itself
+	var apiContainerTlsConfig *args.ApiContainerTlsConfig
+	if tlsConfig := apiContainerInfo.GetTlsConfig(); tlsConfig != nil {
+		apiContainerTlsConfig = &args.ApiContainerTlsConfig{
+			CertificateAuthority: tlsConfig.CertificateAuthority,
+			ClientCertificate:    tlsConfig.ClientCertificate,
+			ClientKey:            tlsConfig.ClientKey,
+			FipsMode:             tlsConfig.FipsMode,
+		}
+	}
 	filesArtifactsExpanderArgs, err := args.NewFilesArtifactsExpanderArgs(
 		apiContainerInfo.GetIpAddress().String(),
 		apiContainerInfo.GetGrpcPortNum(),
 		filesArtifactsExpansions,
+		apiContainerTlsConfig,
 	)
 	if err != nil {
 		return nil, startosis_errors.NewInterpretationError("An error occurred creating files artifacts expander args")
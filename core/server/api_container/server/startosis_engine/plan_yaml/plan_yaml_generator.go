@@ -379,6 +379,17 @@ func (planYaml *PlanYamlGenerator) AddStoreServiceFiles(filesArtifactName, locat
 	return nil
 }
 
+func (planYaml *PlanYamlGenerator) AddStoreImageFiles(filesArtifactName, image, locator string) error {
+	uuid := planYaml.generateUuid()
+	filesArtifactYaml := &FilesArtifact{} //nolint exhaustruct
+	filesArtifactYaml.Uuid = uuid
+	filesArtifactYaml.Name = filesArtifactName
+	filesArtifactYaml.Files = []string{locator}
+	planYaml.addFilesArtifactYaml(filesArtifactYaml)
+	planYaml.addImage(image)
+	return nil
+}
+
 func (planYaml *PlanYamlGenerator) RemoveService(serviceName string) {
 	for idx, service := range planYaml.privatePlanYaml.Services {
 		if service.Name == serviceName {
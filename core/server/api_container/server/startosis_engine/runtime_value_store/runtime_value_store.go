@@ -75,6 +75,16 @@ func (re *RuntimeValueStore) GetOrCreateValueAssociatedWithService(serviceName s
 	return uuid, nil
 }
 
+// GetServiceNameAssociatedWithValue returns the service the value UUID was created for by
+// GetOrCreateValueAssociatedWithService, and false if the value isn't associated with any service
+func (re *RuntimeValueStore) GetServiceNameAssociatedWithValue(uuid string) (service.ServiceName, bool, error) {
+	serviceName, found, err := re.serviceAssociatedValuesRepository.GetServiceName(uuid)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred getting the service associated with value '%s' from the service associated values repository", uuid)
+	}
+	return serviceName, found, nil
+}
+
 func (re *RuntimeValueStore) SetValue(uuid string, value map[string]starlark.Comparable) error {
 	if err := re.recipeResultRepository.Save(uuid, value); err != nil {
 		return stacktrace.Propagate(err, "An error occurred saving value '%+v' using UUID key '%s' into the recipe result repository", value, uuid)
@@ -101,6 +101,32 @@ func (repository *serviceAssociatedValuesRepository) Exist(
 	return exist, nil
 }
 
+// GetServiceName returns the name of the service the value UUID is associated with, and false if it isn't associated
+// with any service
+func (repository *serviceAssociatedValuesRepository) GetServiceName(
+	uuid string,
+) (service.ServiceName, bool, error) {
+	var (
+		serviceName service.ServiceName
+		found       bool
+	)
+
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceAssociatedValuesBucketName)
+
+		return bucket.ForEach(func(serviceNameKey, uuidBytes []byte) error {
+			if string(uuidBytes) == uuid {
+				serviceName = service.ServiceName(serviceNameKey)
+				found = true
+			}
+			return nil
+		})
+	}); err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred while looking for the service associated with value '%s' in the enclave db", uuid)
+	}
+	return serviceName, found, nil
+}
+
 func getServiceNameKey(serviceName service.ServiceName) []byte {
 	return []byte(serviceName)
 }
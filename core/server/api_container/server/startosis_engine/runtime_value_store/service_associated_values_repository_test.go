@@ -63,6 +63,33 @@ func TestNotExist_Success(t *testing.T) {
 	require.False(t, exist)
 }
 
+func TestGetServiceName_Success(t *testing.T) {
+	repository := getAssociatedValuesRepositoryForTest(t)
+
+	err := repository.Save(firstServiceName, firstServiceAssociatedValueUuid)
+	require.NoError(t, err)
+
+	err = repository.Save(secondServiceName, secondServiceAssociatedValueUuid)
+	require.NoError(t, err)
+
+	serviceName, found, err := repository.GetServiceName(secondServiceAssociatedValueUuid)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, secondServiceName, serviceName)
+}
+
+func TestGetServiceName_NotAssociatedValue(t *testing.T) {
+	repository := getAssociatedValuesRepositoryForTest(t)
+
+	err := repository.Save(firstServiceName, firstServiceAssociatedValueUuid)
+	require.NoError(t, err)
+
+	serviceName, found, err := repository.GetServiceName(secondServiceAssociatedValueUuid)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Empty(t, serviceName)
+}
+
 func getAssociatedValuesRepositoryForTest(t *testing.T) *serviceAssociatedValuesRepository {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
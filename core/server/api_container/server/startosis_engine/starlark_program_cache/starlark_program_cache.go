@@ -0,0 +1,181 @@
+package starlark_program_cache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+)
+
+const (
+	// Bounds the memory the compiled programs take; a package with a deep dependency tree has a few hundred modules
+	maxProgramsInMemory = 2000
+
+	compiledProgramFileExtension   = ".starc"
+	compiledProgramTempFilePattern = "*.starc-tmp"
+
+	keyPartsSeparator = "\x00"
+)
+
+// StarlarkProgramCache keeps the Starlark modules parsed and compiled, keyed by the hash of their content, so that
+// running a package again only parses the modules that changed. Programs are immutable once compiled, so one can be
+// executed by many interpretations at once.
+// The most recently used programs are kept in memory; if a directory is given, every program is also written there, so
+// that it's reused across restarts and by the API containers of the other enclaves sharing the directory
+type StarlarkProgramCache struct {
+	mutex *sync.Mutex
+
+	// The elements of the list are *cachedProgram, the most recently used first
+	programsByKey       map[string]*list.Element
+	recentlyUsedEntries *list.List
+
+	// Empty if the programs are only kept in memory
+	maybeDirpath string
+}
+
+type cachedProgram struct {
+	key     string
+	program *starlark.Program
+}
+
+func NewStarlarkProgramCache(maybeDirpath string) *StarlarkProgramCache {
+	return &StarlarkProgramCache{
+		mutex:               &sync.Mutex{},
+		programsByKey:       map[string]*list.Element{},
+		recentlyUsedEntries: list.New(),
+		maybeDirpath:        maybeDirpath,
+	}
+}
+
+// GetOrCompile returns the program of the module, compiling it if it isn't cached. The errors of the compilation are
+// returned as-is, so that the caller can report where the module is wrong
+func (cache *StarlarkProgramCache) GetOrCompile(moduleLocator string, serializedStarlark string, predeclared starlark.StringDict) (*starlark.Program, error) {
+	// The names that are predeclared change how the module is compiled, so they're part of the key as well as the
+	// locator, which the positions reported by the program refer to
+	key := getProgramKey(moduleLocator, serializedStarlark, predeclared)
+	if program, found := cache.getFromMemory(key); found {
+		return program, nil
+	}
+	if program, found := cache.getFromDisk(key); found {
+		cache.addToMemory(key, program)
+		return program, nil
+	}
+
+	_, program, err := starlark.SourceProgram(moduleLocator, serializedStarlark, predeclared.Has)
+	if err != nil {
+		return nil, err
+	}
+	cache.addToMemory(key, program)
+	cache.addToDisk(key, program)
+	return program, nil
+}
+
+func (cache *StarlarkProgramCache) getFromMemory(key string) (*starlark.Program, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	element, found := cache.programsByKey[key]
+	if !found {
+		return nil, false
+	}
+	cache.recentlyUsedEntries.MoveToFront(element)
+	return element.Value.(*cachedProgram).program, true
+}
+
+func (cache *StarlarkProgramCache) addToMemory(key string, program *starlark.Program) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, found := cache.programsByKey[key]; found {
+		cache.recentlyUsedEntries.MoveToFront(element)
+		return
+	}
+	cache.programsByKey[key] = cache.recentlyUsedEntries.PushFront(&cachedProgram{
+		key:     key,
+		program: program,
+	})
+	for cache.recentlyUsedEntries.Len() > maxProgramsInMemory {
+		leastRecentlyUsedEntry := cache.recentlyUsedEntries.Back()
+		cache.recentlyUsedEntries.Remove(leastRecentlyUsedEntry)
+		delete(cache.programsByKey, leastRecentlyUsedEntry.Value.(*cachedProgram).key)
+	}
+}
+
+// getFromDisk treats unreadable programs, e.g. ones compiled by another version of Starlark, as missing, so that they
+// get compiled and written again
+func (cache *StarlarkProgramCache) getFromDisk(key string) (*starlark.Program, bool) {
+	if cache.maybeDirpath == "" {
+		return nil, false
+	}
+	compiledProgram, err := os.ReadFile(cache.getProgramFilepath(key))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logrus.Warnf("An error occurred reading compiled Starlark program '%v'; it will be compiled again:\n%v", key, err)
+		}
+		return nil, false
+	}
+	program, err := starlark.CompiledProgram(bytes.NewReader(compiledProgram))
+	if err != nil {
+		logrus.Debugf("Compiled Starlark program '%v' couldn't be decoded; it will be compiled again:\n%v", key, err)
+		return nil, false
+	}
+	return program, true
+}
+
+// addToDisk writes to a temporary file first, so that the API containers of the other enclaves never read a partially
+// written program. The cache is only an optimization, so failures are only logged
+func (cache *StarlarkProgramCache) addToDisk(key string, program *starlark.Program) {
+	if cache.maybeDirpath == "" {
+		return
+	}
+	if err := cache.writeProgramFile(key, program); err != nil {
+		logrus.Warnf("An error occurred writing compiled Starlark program '%v' to the cache directory:\n%v", key, err)
+	}
+}
+
+func (cache *StarlarkProgramCache) writeProgramFile(key string, program *starlark.Program) error {
+	tempFile, err := os.CreateTemp(cache.maybeDirpath, compiledProgramTempFilePattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary file in '%v'", cache.maybeDirpath)
+	}
+	tempFilepath := tempFile.Name()
+	defer func() {
+		// No-op if the temporary file was already renamed
+		_ = os.Remove(tempFilepath)
+	}()
+
+	writeErr := program.Write(tempFile)
+	if err := tempFile.Close(); err != nil && writeErr == nil {
+		writeErr = stacktrace.Propagate(err, "An error occurred closing temporary file '%v'", tempFilepath)
+	}
+	if writeErr != nil {
+		return stacktrace.Propagate(writeErr, "An error occurred writing the compiled program to temporary file '%v'", tempFilepath)
+	}
+	if err := os.Rename(tempFilepath, cache.getProgramFilepath(key)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving temporary file '%v' into the cache", tempFilepath)
+	}
+	return nil
+}
+
+func (cache *StarlarkProgramCache) getProgramFilepath(key string) string {
+	return path.Join(cache.maybeDirpath, key+compiledProgramFileExtension)
+}
+
+func getProgramKey(moduleLocator string, serializedStarlark string, predeclared starlark.StringDict) string {
+	hash := sha256.New()
+	hash.Write([]byte(moduleLocator))
+	hash.Write([]byte(keyPartsSeparator))
+	// Sorted, so the key doesn't depend on the order the names were added in
+	for _, predeclaredName := range predeclared.Keys() {
+		hash.Write([]byte(predeclaredName))
+		hash.Write([]byte(keyPartsSeparator))
+	}
+	hash.Write([]byte(keyPartsSeparator))
+	hash.Write([]byte(serializedStarlark))
+	return hex.EncodeToString(hash.Sum(nil))
+}
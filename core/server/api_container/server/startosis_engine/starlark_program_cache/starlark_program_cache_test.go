@@ -0,0 +1,83 @@
+package starlark_program_cache
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+const (
+	testModuleLocator = "github.com/kurtosis-tech/test-package/main.star"
+	testModule        = "greeting = prefix + \"world\"\n"
+	testChangedModule = "greeting = prefix + \"there\"\n"
+
+	testFilePerms = 0644
+)
+
+var testPredeclared = starlark.StringDict{
+	"prefix": starlark.String("hello "),
+}
+
+func TestGetOrCompile_ReusesTheProgramOfUnchangedModules(t *testing.T) {
+	cache := NewStarlarkProgramCache("")
+
+	program, err := cache.GetOrCompile(testModuleLocator, testModule, testPredeclared)
+	require.NoError(t, err)
+	sameProgram, err := cache.GetOrCompile(testModuleLocator, testModule, testPredeclared)
+	require.NoError(t, err)
+	require.Same(t, program, sameProgram)
+
+	changedProgram, err := cache.GetOrCompile(testModuleLocator, testChangedModule, testPredeclared)
+	require.NoError(t, err)
+	require.NotSame(t, program, changedProgram)
+	require.Equal(t, starlark.String("hello there"), initProgram(t, changedProgram)["greeting"])
+}
+
+func TestGetOrCompile_ReusesTheProgramsOfTheCacheDirectory(t *testing.T) {
+	cacheDirpath := t.TempDir()
+	_, err := NewStarlarkProgramCache(cacheDirpath).GetOrCompile(testModuleLocator, testModule, testPredeclared)
+	require.NoError(t, err)
+
+	// e.g. the API container of another enclave
+	otherCache := NewStarlarkProgramCache(cacheDirpath)
+	key := getProgramKey(testModuleLocator, testModule, testPredeclared)
+	program, found := otherCache.getFromDisk(key)
+	require.True(t, found)
+	require.Equal(t, starlark.String("hello world"), initProgram(t, program)["greeting"])
+}
+
+func TestGetOrCompile_CompilesAgainTheUnreadableProgramsOfTheCacheDirectory(t *testing.T) {
+	cacheDirpath := t.TempDir()
+	cache := NewStarlarkProgramCache(cacheDirpath)
+	key := getProgramKey(testModuleLocator, testModule, testPredeclared)
+	require.NoError(t, os.WriteFile(cache.getProgramFilepath(key), []byte("not a program"), testFilePerms))
+
+	program, err := cache.GetOrCompile(testModuleLocator, testModule, testPredeclared)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("hello world"), initProgram(t, program)["greeting"])
+
+	_, found := NewStarlarkProgramCache(cacheDirpath).getFromDisk(key)
+	require.True(t, found)
+}
+
+func TestGetOrCompile_ReturnsTheCompilationErrorsAsIs(t *testing.T) {
+	cache := NewStarlarkProgramCache("")
+
+	_, err := cache.GetOrCompile(testModuleLocator, "greeting = (", testPredeclared)
+	require.IsType(t, syntax.Error{}, err)
+
+	// The predeclared names are part of the key, so the module compiled with them isn't reused without them
+	_, err = cache.GetOrCompile(testModuleLocator, testModule, testPredeclared)
+	require.NoError(t, err)
+	_, err = cache.GetOrCompile(testModuleLocator, testModule, starlark.StringDict{})
+	require.Error(t, err)
+}
+
+func initProgram(t *testing.T, program *starlark.Program) starlark.StringDict {
+	globals, err := program.Init(&starlark.Thread{}, testPredeclared)
+	require.NoError(t, err)
+	return globals
+}
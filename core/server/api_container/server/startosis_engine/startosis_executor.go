@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/backend_errors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/log_alerts"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
@@ -15,6 +19,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"sync"
+	"time"
 )
 
 const (
@@ -35,30 +40,35 @@ type StartosisExecutor struct {
 	enclavePlan        *enclave_plan_persistence.EnclavePlan
 	enclaveDb          *enclave_db.EnclaveDB
 	runtimeValueStore  *runtime_value_store.RuntimeValueStore
+
+	// The log alerts firing while a run is executed are reported in its output, and can fail it
+	logAlertWatcher *log_alerts.LogAlertWatcher
 }
 
 type ExecutionError struct {
 	Error string
 }
 
-func NewStartosisExecutor(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, runtimeValueStore *runtime_value_store.RuntimeValueStore, enclavePlan *enclave_plan_persistence.EnclavePlan, enclaveDb *enclave_db.EnclaveDB) *StartosisExecutor {
+func NewStartosisExecutor(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, runtimeValueStore *runtime_value_store.RuntimeValueStore, enclavePlan *enclave_plan_persistence.EnclavePlan, enclaveDb *enclave_db.EnclaveDB, logAlertWatcher *log_alerts.LogAlertWatcher) *StartosisExecutor {
 	return &StartosisExecutor{
 		mutex:              &sync.Mutex{},
 		starlarkValueSerde: starlarkValueSerde,
 		enclaveDb:          enclaveDb,
 		enclavePlan:        enclavePlan,
 		runtimeValueStore:  runtimeValueStore,
+		logAlertWatcher:    logAlertWatcher,
 	}
 }
 
 // Execute executes the list of Kurtosis instructions _asynchronously_ against the Kurtosis backend
 // Consumers of this method should read the response lines channel and return as soon as one it is closed
 //
-// The channel of KurtosisExecutionResponseLine can contain three kinds of line:
+// The channel of KurtosisExecutionResponseLine can contain four kinds of line:
 // - A regular KurtosisInstruction that was successfully executed
 // - A KurtosisExecutionError if the execution failed
 // - A ProgressInfo to update the current "state" of the execution
-func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, parallelism int, indexOfFirstInstructionInEnclavePlan int, instructionsSequence []*instructions_plan.ScheduledInstruction, serializedScriptOutput string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+// - A RunPlan with all the instructions and the values they return, once all of them were sent, if it's a dry run
+func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, parallelism int, indexOfFirstInstructionInEnclavePlan int, replacedEnclavePlanInstructionUuids map[string]bool, instructionsSequence []*instructions_plan.ScheduledInstruction, serializedScriptOutput string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	executor.mutex.Lock()
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	ctxWithParallelism := context.WithValue(ctx, startosis_constants.ParallelismParam, parallelism)
@@ -72,7 +82,7 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 		logrus.Debugf("Current enclave plan contains %d instuctions. About to process a new plan with %d instructions starting at index %d (dry-run: %v)",
 			executor.enclavePlan.Size(), len(instructionsSequence), indexOfFirstInstructionInEnclavePlan, dryRun)
 
-		executor.enclavePlan = executor.enclavePlan.PartialDeepClone(indexOfFirstInstructionInEnclavePlan)
+		executor.enclavePlan = executor.enclavePlan.PartialDeepClone(indexOfFirstInstructionInEnclavePlan, replacedEnclavePlanInstructionUuids)
 
 		defer func() {
 			// TODO: we now perist the plan at the end of the execution. We could persist it everytime an instruction
@@ -89,7 +99,13 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 
 		logrus.Debugf("Transfered %d instructions from previous enclave plan to keep the enclave state consistent", executor.enclavePlan.Size())
 
+		if !dryRun {
+			executor.logAlertWatcher.StartReporting()
+			defer executor.logAlertWatcher.StopReporting()
+		}
+
 		totalNumberOfInstructions := uint32(len(instructionsSequence))
+		plannedInstructions := []*kurtosis_core_rpc_api_bindings.StarlarkPlannedInstruction{}
 		for index, scheduledInstruction := range instructionsSequence {
 			instructionNumber := uint32(index + 1)
 			progress := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
@@ -97,17 +113,34 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 			starlarkRunResponseLineStream <- progress
 
 			instruction := scheduledInstruction.GetInstruction()
-			canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstruction(instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted()))
+			starlarkInstruction := instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted())
+			canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstruction(starlarkInstruction)
 			starlarkRunResponseLineStream <- canonicalInstruction
 
-			if !dryRun {
+			if dryRun {
+				plannedInstructions = append(plannedInstructions, binding_constructors.NewStarlarkPlannedInstruction(
+					starlarkInstruction,
+					executor.starlarkValueSerde.Serialize(scheduledInstruction.GetReturnedValue()),
+				))
+			} else {
 				var err error
 				var instructionOutput *string
+				var executionDuration time.Duration
 				if scheduledInstruction.IsExecuted() {
 					// instruction already executed within this enclave. Do not run it
 					instructionOutput = &skippedInstructionOutput
 				} else {
-					instructionOutput, err = instruction.Execute(ctxWithParallelism)
+					executionStartTime := time.Now()
+					instructionCtx, instructionSpan := startInstructionSpan(ctxWithParallelism, instructionNumber, instruction, starlarkInstruction)
+					var maybeFailingLogAlert *log_alerts.FiredLogAlert
+					instructionOutput, maybeFailingLogAlert, err = executor.executeReportingLogAlerts(instructionCtx, instruction, starlarkRunResponseLineStream)
+					executionDuration = time.Since(executionStartTime)
+					if maybeFailingLogAlert != nil {
+						tracing.EndSpan(instructionSpan, stacktrace.NewError("%s", maybeFailingLogAlert.String()))
+						sendErrorAndFail(starlarkRunResponseLineStream, stacktrace.NewError("%s", maybeFailingLogAlert.String()), "A log alert failed the run while executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+						return
+					}
+					tracing.EndSpan(instructionSpan, err)
 				}
 				if err != nil {
 					sendErrorAndFail(starlarkRunResponseLineStream, err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
@@ -118,7 +151,11 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 					if len(instructionOutputStr) > outputSizeLimit {
 						instructionOutputStr = fmt.Sprintf("%s%s", instructionOutputStr[0:outputSizeLimit], outputLimitReachedSuffix)
 					}
-					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(instructionOutputStr)
+					if scheduledInstruction.IsExecuted() {
+						starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(instructionOutputStr)
+					} else {
+						starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromTimedInstructionResult(instructionOutputStr, executionDuration)
+					}
 				}
 				// add the instruction into the current enclave plan
 				enclavePlanInstruction, err := scheduledInstruction.GetInstruction().GetPersistableAttributes().SetUuid(
@@ -134,6 +171,11 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 		}
 
 		if !dryRun {
+			// The alerts firing after the last instruction started are reported when it's done
+			if maybeFailingLogAlert := reportFiredLogAlerts(executor.logAlertWatcher, starlarkRunResponseLineStream); maybeFailingLogAlert != nil {
+				sendErrorAndFail(starlarkRunResponseLineStream, stacktrace.NewError("%s", maybeFailingLogAlert.String()), "A log alert failed the run")
+				return
+			}
 			logrus.Debugf("Serialized script output before runtime value replace: '%v'", serializedScriptOutput)
 			scriptWithValuesReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(serializedScriptOutput, executor.runtimeValueStore)
 			if err != nil {
@@ -143,6 +185,7 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunSuccessEvent(scriptWithValuesReplaced)
 			logrus.Debugf("Current enclave plan has been updated. It now contains %d instructions", executor.enclavePlan.Size())
 		} else {
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunPlan(plannedInstructions)
 			logrus.Debugf("Current enclave plan remained the same as the it was a dry-run. It contains %d instructions", executor.enclavePlan.Size())
 		}
 	}()
@@ -153,9 +196,77 @@ func (executor *StartosisExecutor) GetCurrentEnclavePLan() *enclave_plan_persist
 	return executor.enclavePlan
 }
 
+// executeReportingLogAlerts executes the instruction while reporting the log alerts that fire, cancelling it and
+// returning the alert if one that fails the run fires
+func (executor *StartosisExecutor) executeReportingLogAlerts(ctx context.Context, instruction kurtosis_instruction.KurtosisInstruction, starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (*string, *log_alerts.FiredLogAlert, error) {
+	instructionCtx, cancelInstructionCtx := context.WithCancel(ctx)
+	defer cancelInstructionCtx()
+
+	type instructionResult struct {
+		output *string
+		err    error
+	}
+	instructionResultChan := make(chan instructionResult, 1)
+	go func() {
+		output, err := instruction.Execute(instructionCtx)
+		instructionResultChan <- instructionResult{output: output, err: err}
+	}()
+
+	for {
+		select {
+		case result := <-instructionResultChan:
+			return result.output, nil, result.err
+		case <-executor.logAlertWatcher.Fired():
+			if maybeFailingLogAlert := reportFiredLogAlerts(executor.logAlertWatcher, starlarkRunResponseLineStream); maybeFailingLogAlert != nil {
+				cancelInstructionCtx()
+				<-instructionResultChan
+				return nil, maybeFailingLogAlert, nil
+			}
+		}
+	}
+}
+
+// reportFiredLogAlerts sends the log alerts that fired to the output of the run, returning the first one that fails it
+func reportFiredLogAlerts(logAlertWatcher *log_alerts.LogAlertWatcher, starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) *log_alerts.FiredLogAlert {
+	for _, firedLogAlert := range logAlertWatcher.TakeFiredAlerts() {
+		switch firedLogAlert.GetAlert().GetAction() {
+		case log_alerts.Action_Fail:
+			return firedLogAlert
+		case log_alerts.Action_Task:
+			if taskErr := firedLogAlert.GetMaybeTaskErr(); taskErr != nil {
+				starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromWarning(fmt.Sprintf("%s; its task failed:\n%v", firedLogAlert.String(), taskErr))
+				continue
+			}
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInfoMsg(fmt.Sprintf("%s; its task ran", firedLogAlert.String()))
+		case log_alerts.Action_Event:
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInfoMsg(firedLogAlert.String())
+		}
+	}
+	return nil
+}
+
 func sendErrorAndFail(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, err error, msg string, msgArgs ...interface{}) {
 	propagatedErr := stacktrace.Propagate(err, msg, msgArgs...)
 	serializedError := binding_constructors.NewStarlarkExecutionError(propagatedErr.Error())
+	if backendErr, isBackendErr := backend_errors.GetBackendError(err); isBackendErr {
+		serializedError = binding_constructors.NewStarlarkExecutionErrorFromBackendError(propagatedErr.Error(), convertBackendErrorKind(backendErr.GetKind()), backendErr.GetRemediationHint())
+	}
 	starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromExecutionError(serializedError)
 	starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
 }
+
+func convertBackendErrorKind(kind backend_errors.ErrorKind) kurtosis_core_rpc_api_bindings.BackendErrorKind {
+	switch kind {
+	case backend_errors.ErrorKind_ImagePullBackOff:
+		return kurtosis_core_rpc_api_bindings.BackendErrorKind_IMAGE_PULL_BACK_OFF
+	case backend_errors.ErrorKind_InsufficientResources:
+		return kurtosis_core_rpc_api_bindings.BackendErrorKind_INSUFFICIENT_RESOURCES
+	case backend_errors.ErrorKind_RBACForbidden:
+		return kurtosis_core_rpc_api_bindings.BackendErrorKind_RBAC_FORBIDDEN
+	case backend_errors.ErrorKind_QuotaExceeded:
+		return kurtosis_core_rpc_api_bindings.BackendErrorKind_QUOTA_EXCEEDED
+	case backend_errors.ErrorKind_VolumeProvisioningFailed:
+		return kurtosis_core_rpc_api_bindings.BackendErrorKind_VOLUME_PROVISIONING_FAILED
+	}
+	return kurtosis_core_rpc_api_bindings.BackendErrorKind_NO_BACKEND_ERROR
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/backend_errors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/log_alerts"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
@@ -16,6 +18,11 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.starlark.net/starlark"
 	"os"
 	"strings"
@@ -49,7 +56,7 @@ func TestExecuteKurtosisInstructions_ExecuteForReal_Success(t *testing.T) {
 	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
 	require.NoError(t, createRuntimeValueStoreErr)
 
-	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, log_alerts.NewLogAlertWatcher(nil))
 
 	instructionsPlan := instructions_plan.NewInstructionsPlan()
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully, "description1")
@@ -93,7 +100,7 @@ func TestExecuteKurtosisInstructions_ExecuteForReal_FailureHalfWay(t *testing.T)
 	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
 	require.NoError(t, err)
 
-	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, log_alerts.NewLogAlertWatcher(nil))
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully, "description1")
 	instruction2 := createMockInstruction(t, "instruction2", throwOnExecute, "description2")
@@ -138,7 +145,7 @@ func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
 	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
 	require.NoError(t, createRuntimeValueStoreErr)
 
-	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, log_alerts.NewLogAlertWatcher(nil))
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully, "description1")
 	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully, "description2")
@@ -164,6 +171,60 @@ func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
 	require.Equal(t, serializedInstruction, expectedSerializedInstructions)
 }
 
+func TestExecuteKurtosisInstructions_ExportsASpanPerExecutedInstruction(t *testing.T) {
+	spanRecorder := tracetest.NewSpanRecorder()
+	previousTracerProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	defer otel.SetTracerProvider(previousTracerProvider)
+
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, log_alerts.NewLogAlertWatcher(nil))
+
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully, "description1")
+	scheduledInstruction1 := instructions_plan.NewScheduledInstruction("instruction1", instruction1, starlark.None).Executed(true)
+	instructionsPlan.AddScheduledInstruction(scheduledInstruction1)
+
+	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully, "description2")
+	instruction3 := createMockInstruction(t, "instruction3", throwOnExecute, "description3")
+	require.NoError(t, instructionsPlan.AddInstruction(instruction2, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction3, starlark.None))
+
+	_, _, executionError := executeSynchronously(t, executor, executeForReal, instructionsPlan)
+	require.NotNil(t, executionError)
+
+	// instruction1 has no span as it was already executed
+	endedSpans := spanRecorder.Ended()
+	require.Len(t, endedSpans, 2)
+	require.Equal(t, "Starlark.instruction2", endedSpans[0].Name())
+	require.Contains(t, endedSpans[0].Attributes(), attribute.Int64(instructionNumberAttributeKey, 2))
+	require.Contains(t, endedSpans[0].Attributes(), attribute.String(instructionPositionAttributeKey, dummyPosition.String()))
+	require.Equal(t, codes.Unset, endedSpans[0].Status().Code)
+	require.Equal(t, "Starlark.instruction3", endedSpans[1].Name())
+	require.Equal(t, codes.Error, endedSpans[1].Status().Code)
+	require.Equal(t, "expected error for test", endedSpans[1].Status().Description)
+}
+
+func TestGetInstructionArgsDigest(t *testing.T) {
+	newInstruction := func(args ...*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg) *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
+		return binding_constructors.NewStarlarkInstruction(dummyPosition.ToAPIType(), "add_service", "add_service()", args, isSkipped, "")
+	}
+	nameArg := binding_constructors.NewStarlarkInstructionKwarg(`"my-service"`, "name", true)
+	otherNameArg := binding_constructors.NewStarlarkInstructionKwarg(`"my-other-service"`, "name", true)
+
+	digest := getInstructionArgsDigest(newInstruction(nameArg))
+	require.True(t, strings.HasPrefix(digest, argsDigestPrefix))
+	require.NotContains(t, digest, "my-service")
+	require.Equal(t, digest, getInstructionArgsDigest(newInstruction(nameArg)))
+	require.NotEqual(t, digest, getInstructionArgsDigest(newInstruction(otherNameArg)))
+}
+
 func createMockInstruction(t *testing.T, instructionName string, executeSuccessfully bool, description string) *mock_instruction.MockKurtosisInstruction {
 	instruction := mock_instruction.NewMockKurtosisInstruction(t)
 
@@ -187,6 +248,46 @@ func createMockInstruction(t *testing.T, instructionName string, executeSuccessf
 	return instruction
 }
 
+func TestExecuteKurtosisInstructions_DoDryRunSendsThePlan(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, log_alerts.NewLogAlertWatcher(nil))
+
+	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully, "description1")
+	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully, "description2")
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(instruction1, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction2, starlark.String("{{kurtosis:service.ip_address}}")))
+
+	scheduledInstructions, err := instructionsPlan.GeneratePlan()
+	require.Nil(t, err)
+
+	var plan *kurtosis_core_rpc_api_bindings.StarlarkRunPlan
+	for executionResponseLine := range executor.Execute(context.Background(), doDryRun, noParallelism, 0, map[string]bool{}, scheduledInstructions, noScriptOutputObject) {
+		if executionResponseLine.GetPlan() != nil {
+			plan = executionResponseLine.GetPlan()
+		}
+	}
+	require.NotNil(t, plan)
+
+	expectedPlannedInstructions := []*kurtosis_core_rpc_api_bindings.StarlarkPlannedInstruction{
+		binding_constructors.NewStarlarkPlannedInstruction(
+			binding_constructors.NewStarlarkInstruction(
+				dummyPosition.ToAPIType(), "instruction1", "instruction1()", noInstructionArgsForTesting, isSkipped, "description1"),
+			"None"),
+		binding_constructors.NewStarlarkPlannedInstruction(
+			binding_constructors.NewStarlarkInstruction(
+				dummyPosition.ToAPIType(), "instruction2", "instruction2()", noInstructionArgsForTesting, isSkipped, "description2"),
+			`"{{kurtosis:service.ip_address}}"`),
+	}
+	require.Equal(t, expectedPlannedInstructions, plan.GetInstructions())
+}
+
 func executeSynchronously(t *testing.T, executor *StartosisExecutor, dryRun bool, instructionsPlan *instructions_plan.InstructionsPlan) (string, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) {
 	scriptOutput := strings.Builder{}
 	var serializedInstructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
@@ -194,7 +295,7 @@ func executeSynchronously(t *testing.T, executor *StartosisExecutor, dryRun bool
 	scheduledInstructions, err := instructionsPlan.GeneratePlan()
 	require.Nil(t, err)
 
-	executionResponseLines := executor.Execute(context.Background(), dryRun, noParallelism, 0, scheduledInstructions, noScriptOutputObject)
+	executionResponseLines := executor.Execute(context.Background(), dryRun, noParallelism, 0, map[string]bool{}, scheduledInstructions, noScriptOutputObject)
 	for executionResponseLine := range executionResponseLines {
 		if executionResponseLine.GetError() != nil {
 			return scriptOutput.String(), serializedInstructions, executionResponseLine.GetError().GetExecutionError()
@@ -228,3 +329,25 @@ func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 
 	return enclaveDb
 }
+
+func TestSendErrorAndFail_SendsTheKindAndHintOfBackendErrors(t *testing.T) {
+	responseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, 2)
+	backendErr := backend_errors.NewBackendError(backend_errors.ErrorKind_ImagePullBackOff, "Image 'nginx:does-not-exist' can't be pulled")
+	sendErrorAndFail(responseLineStream, backendErr, "An error occurred executing instruction")
+
+	executionError := (<-responseLineStream).GetError().GetExecutionError()
+	require.NotNil(t, executionError)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.BackendErrorKind_IMAGE_PULL_BACK_OFF, executionError.GetBackendErrorKind())
+	require.Equal(t, backendErr.GetRemediationHint(), executionError.GetRemediationHint())
+	require.Contains(t, executionError.GetErrorMessage(), "can't be pulled")
+	require.NotNil(t, (<-responseLineStream).GetRunFinishedEvent())
+}
+
+func TestSendErrorAndFail_OtherErrorsHaveNoKind(t *testing.T) {
+	responseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, 2)
+	sendErrorAndFail(responseLineStream, errors.New("something went wrong"), "An error occurred executing instruction")
+
+	executionError := (<-responseLineStream).GetError().GetExecutionError()
+	require.Equal(t, kurtosis_core_rpc_api_bindings.BackendErrorKind_NO_BACKEND_ERROR, executionError.GetBackendErrorKind())
+	require.Empty(t, executionError.GetRemediationHint())
+}
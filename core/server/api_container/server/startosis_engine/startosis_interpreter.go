@@ -17,6 +17,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/package_io"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_program_cache"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
@@ -63,6 +64,8 @@ type StartosisInterpreter struct {
 	starlarkValueSerde           *kurtosis_types.StarlarkValueSerde
 	enclaveEnvVars               string
 	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore
+	// Shared by all the interpretations, as a package is interpreted several times per run and mostly unchanged between runs
+	programCache *starlark_program_cache.StarlarkProgramCache
 	// This is a function that allows the consumer of the interpreter to adjust the default builtins.
 	// It is useful when external libraries or helpers need to be plugged in to kurtosis,
 	// for example when running unit tests using the starlarktest package
@@ -74,11 +77,11 @@ type StartosisInterpreterBuiltinsProcessor func(thread *starlark.Thread, predecl
 
 type SerializedInterpretationOutput string
 
-func NewStartosisInterpreter(serviceNetwork service_network.ServiceNetwork, packageContentProvider startosis_packages.PackageContentProvider, runtimeValueStore *runtime_value_store.RuntimeValueStore, starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveVarEnvs string, interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore) *StartosisInterpreter {
-	return NewStartosisInterpreterWithBuiltinsProcessor(serviceNetwork, packageContentProvider, runtimeValueStore, starlarkValueSerde, enclaveVarEnvs, interpretationTimeValueStore, nil)
+func NewStartosisInterpreter(serviceNetwork service_network.ServiceNetwork, packageContentProvider startosis_packages.PackageContentProvider, runtimeValueStore *runtime_value_store.RuntimeValueStore, starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveVarEnvs string, interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore, programCache *starlark_program_cache.StarlarkProgramCache) *StartosisInterpreter {
+	return NewStartosisInterpreterWithBuiltinsProcessor(serviceNetwork, packageContentProvider, runtimeValueStore, starlarkValueSerde, enclaveVarEnvs, interpretationTimeValueStore, programCache, nil)
 }
 
-func NewStartosisInterpreterWithBuiltinsProcessor(serviceNetwork service_network.ServiceNetwork, packageContentProvider startosis_packages.PackageContentProvider, runtimeValueStore *runtime_value_store.RuntimeValueStore, starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveVarEnvs string, interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore, processBuiltins StartosisInterpreterBuiltinsProcessor) *StartosisInterpreter {
+func NewStartosisInterpreterWithBuiltinsProcessor(serviceNetwork service_network.ServiceNetwork, packageContentProvider startosis_packages.PackageContentProvider, runtimeValueStore *runtime_value_store.RuntimeValueStore, starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveVarEnvs string, interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore, programCache *starlark_program_cache.StarlarkProgramCache, processBuiltins StartosisInterpreterBuiltinsProcessor) *StartosisInterpreter {
 	return &StartosisInterpreter{
 		mutex:                        &sync.Mutex{},
 		serviceNetwork:               serviceNetwork,
@@ -87,6 +90,7 @@ func NewStartosisInterpreterWithBuiltinsProcessor(serviceNetwork service_network
 		enclaveEnvVars:               enclaveVarEnvs,
 		starlarkValueSerde:           starlarkValueSerde,
 		interpretationTimeValueStore: interpretationTimeValueStore,
+		programCache:                 programCache,
 		processBuiltins:              processBuiltins,
 	}
 }
@@ -179,10 +183,24 @@ func (interpreter *StartosisInterpreter) InterpretAndOptimizePlan(
 			logrus.Debugf("Writing %d instruction at the beginning of the plan mask, leaving %d empty at the end", numberOfInstructionCopiedToMask, potentialMask.Size()-numberOfInstructionCopiedToMask)
 		} else {
 			// We cannot find any more instructions inside the enclave state matching the first instruction of the plan
+			// -> try reconciling the instructions of the new plan one by one with the ones of the enclave state, so
+			// that the instructions already applied to the enclave are not run again
+			reconciledSerializedScriptOutput, reconciledPlan, interpretationErrorApi := interpreter.reconcileWithEnclavePlan(ctx, packageId, packageReplaceOptions, mainFunctionName, relativePathtoMainFile, serializedStarlark, serializedJsonParams, nonBlockingMode, currentEnclavePlanSequence, naiveInstructionsPlanSequence, imageDownloadMode)
+			if interpretationErrorApi != nil {
+				return startosis_constants.NoOutputObject, nil, interpretationErrorApi
+			}
+			if reconciledPlan != nil {
+				logrus.Debugf("Reconciled the new plan with the enclave plan to obtain a %d instructions plan", reconciledPlan.Size())
+				return reconciledSerializedScriptOutput, reconciledPlan, nil
+			}
+
 			optimizedPlan.SetIndexOfFirstInstruction(currentEnclavePlan.Size())
 			for _, newPlanInstruction := range naiveInstructionsPlanSequence {
 				optimizedPlan.AddScheduledInstruction(newPlanInstruction)
 			}
+			if currentEnclavePlan.Size() > 0 {
+				optimizedPlan.SetReconciliationSummary(getReconciliationSummary(naiveInstructionsPlanSequence, nil, currentEnclavePlan.Size()))
+			}
 			logrus.Debugf("Exhausted all possibilities. Concatenated the previous enclave plan with the new plan to obtain a %d instructions plan", optimizedPlan.Size())
 			return naiveInstructionsPlanSerializedScriptOutput, optimizedPlan, nil
 		}
@@ -216,6 +234,7 @@ func (interpreter *StartosisInterpreter) InterpretAndOptimizePlan(
 		for _, scheduledInstruction := range attemptInstructionsPlanSequence {
 			optimizedPlan.AddScheduledInstruction(scheduledInstruction)
 		}
+		optimizedPlan.SetReconciliationSummary(getReconciliationSummary(attemptInstructionsPlanSequence, potentialMask, matchingInstructionIdx))
 
 		// finally we can return the optimized plan as well as the serialized script output returned by the last
 		// interpretation attempt
@@ -223,6 +242,86 @@ func (interpreter *StartosisInterpreter) InterpretAndOptimizePlan(
 	}
 }
 
+// reconcileWithEnclavePlan is the fallback of InterpretAndOptimizePlan for when the new plan doesn't line up with the end
+// of the enclave plan, typically because the package was changed. It builds a mask reconciling each instruction of the
+// new plan with any instruction of the enclave plan it can be resolved with, regardless of their positions, and
+// interprets the package again with it.
+// The instructions of the enclave plan that are reconciled get replaced by the ones of the new plan, while the others
+// are left in place, such that running a package on an enclave only applies what's new or changed.
+// It returns a nil plan if no instruction could be reconciled
+func (interpreter *StartosisInterpreter) reconcileWithEnclavePlan(
+	ctx context.Context,
+	packageId string,
+	packageReplaceOptions map[string]string,
+	mainFunctionName string,
+	relativePathtoMainFile string,
+	serializedStarlark string,
+	serializedJsonParams string,
+	nonBlockingMode bool,
+	currentEnclavePlanSequence []*enclave_plan_persistence.EnclavePlanInstruction,
+	naiveInstructionsPlanSequence []*instructions_plan.ScheduledInstruction,
+	imageDownloadMode image_download_mode.ImageDownloadMode,
+) (string, *instructions_plan.InstructionsPlan, *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) {
+	maskInstructions := make([]*enclave_plan_persistence.EnclavePlanInstruction, len(naiveInstructionsPlanSequence))
+	// enclave plan instructions that were reconciled with an instruction of the new plan, even if they were later
+	// removed from the mask because they couldn't be used as-is
+	reconciledEnclavePlanInstructionIdxs := map[int]bool{}
+	if reconcileInstructions(currentEnclavePlanSequence, naiveInstructionsPlanSequence, maskInstructions, reconciledEnclavePlanInstructionIdxs) == 0 {
+		return startosis_constants.NoOutputObject, nil, nil
+	}
+
+	// Every attempt either removes an instruction from the mask or adds one that was never in it, which bounds the
+	// number of attempts
+	maxAttempts := 2*len(currentEnclavePlanSequence) + 1
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		mask := resolver.NewInstructionsPlanMask(len(maskInstructions))
+		for idx, maskInstruction := range maskInstructions {
+			mask.InsertAt(idx, maskInstruction)
+		}
+		attemptSerializedScriptOutput, attemptInstructionsPlan, interpretationErrorApi := interpreter.Interpret(ctx, packageId, mainFunctionName, packageReplaceOptions, relativePathtoMainFile, serializedStarlark, serializedJsonParams, nonBlockingMode, enclave_structure.NewEnclaveComponents(), mask, imageDownloadMode)
+		if interpretationErrorApi != nil {
+			return startosis_constants.NoOutputObject, nil, interpretationErrorApi
+		}
+
+		if !mask.IsValid() {
+			// remove the instruction that doesn't fit from the mask and try again. If the mask was made invalid by
+			// an instruction that wasn't reconciled, e.g. an instruction that can never be resolved, give up
+			invalidIdx := mask.GetInvalidIdx()
+			if invalidIdx == resolver.NoInstructionIdx || invalidIdx >= len(maskInstructions) || maskInstructions[invalidIdx] == nil {
+				logrus.Debugf("Plan mask was made invalid by an instruction that was not reconciled with the enclave plan. Giving up reconciliation")
+				return startosis_constants.NoOutputObject, nil, nil
+			}
+			logrus.Debugf("Removing instruction '%s' from the plan mask as it made the mask invalid", maskInstructions[invalidIdx].StarlarkCode)
+			maskInstructions[invalidIdx] = nil
+			continue
+		}
+
+		attemptInstructionsPlanSequence, interpretationErr := attemptInstructionsPlan.GeneratePlan()
+		if interpretationErr != nil {
+			return startosis_constants.NoOutputObject, nil, interpretationErr.ToAPIType()
+		}
+
+		// instructions depending on the values returned by reconciled instructions might only be reconcilable now that
+		// they were interpreted with the values of the enclave plan
+		if len(attemptInstructionsPlanSequence) == len(maskInstructions) && reconcileInstructions(currentEnclavePlanSequence, attemptInstructionsPlanSequence, maskInstructions, reconciledEnclavePlanInstructionIdxs) > 0 {
+			continue
+		}
+
+		reconciledPlan := instructions_plan.NewInstructionsPlan()
+		reconciledPlan.SetIndexOfFirstInstruction(len(currentEnclavePlanSequence))
+		for enclavePlanInstructionIdx := range reconciledEnclavePlanInstructionIdxs {
+			reconciledPlan.AddReplacedEnclavePlanInstructionUuid(currentEnclavePlanSequence[enclavePlanInstructionIdx].Uuid)
+		}
+		for _, scheduledInstruction := range attemptInstructionsPlanSequence {
+			reconciledPlan.AddScheduledInstruction(scheduledInstruction)
+		}
+		reconciledPlan.SetReconciliationSummary(getReconciliationSummary(attemptInstructionsPlanSequence, mask, len(currentEnclavePlanSequence)-len(reconciledEnclavePlanInstructionIdxs)))
+		return attemptSerializedScriptOutput, reconciledPlan, nil
+	}
+	logrus.Warnf("Could not reconcile the new plan with the enclave plan after %d attempts, this is unexpected", maxAttempts)
+	return startosis_constants.NoOutputObject, nil, nil
+}
+
 // Interpret interprets the Starlark script and produce different outputs:
 //   - A potential interpretation error that the writer of the script should be aware of (syntax error in the Startosis
 //     code, inconsistent). Can be nil if the script was successfully interpreted
@@ -355,10 +454,15 @@ func (interpreter *StartosisInterpreter) interpretInternal(
 		return nil, interpretationErr
 	}
 
-	globalVariables, err := starlark.ExecFile(thread, moduleLocator, serializedStarlark, *predeclared)
+	program, err := interpreter.programCache.GetOrCompile(moduleLocator, serializedStarlark, *predeclared)
+	if err != nil {
+		return nil, generateInterpretationError(err)
+	}
+	globalVariables, err := program.Init(thread, *predeclared)
 	if err != nil {
 		return nil, generateInterpretationError(err)
 	}
+	globalVariables.Freeze()
 
 	return globalVariables, nil
 }
@@ -438,6 +542,67 @@ func findFirstEqualInstructionPastIndex(currentEnclaveInstructionsList []*enclav
 	return -1 // no match
 }
 
+// reconcileInstructions fills the empty slots of maskInstructions with the first enclave plan instruction not
+// reconciled yet that the instruction at the same index can be resolved with, preferring equal instructions over
+// updated ones. It returns the number of instructions it reconciled
+func reconcileInstructions(
+	currentEnclaveInstructionsList []*enclave_plan_persistence.EnclavePlanInstruction,
+	instructionsList []*instructions_plan.ScheduledInstruction,
+	maskInstructions []*enclave_plan_persistence.EnclavePlanInstruction,
+	reconciledEnclavePlanInstructionIdxs map[int]bool,
+) int {
+	numberOfReconciledInstructions := 0
+	for idx, scheduledInstruction := range instructionsList {
+		if maskInstructions[idx] != nil {
+			continue
+		}
+		matchingInstructionIdx := -1
+		for enclavePlanInstructionIdx, enclavePlanInstruction := range currentEnclaveInstructionsList {
+			if reconciledEnclavePlanInstructionIdxs[enclavePlanInstructionIdx] {
+				continue
+			}
+			// We just need to compare instructions to see if they match, without needing any enclave specific context here
+			fakeEnclaveComponent := enclave_structure.NewEnclaveComponents()
+			instructionResolutionResult := scheduledInstruction.GetInstruction().TryResolveWith(enclavePlanInstruction, fakeEnclaveComponent)
+			if instructionResolutionResult == enclave_structure.InstructionIsEqual {
+				matchingInstructionIdx = enclavePlanInstructionIdx
+				break
+			}
+			if instructionResolutionResult == enclave_structure.InstructionIsUpdate && matchingInstructionIdx < 0 {
+				matchingInstructionIdx = enclavePlanInstructionIdx
+			}
+		}
+		if matchingInstructionIdx < 0 {
+			continue
+		}
+		maskInstructions[idx] = currentEnclaveInstructionsList[matchingInstructionIdx]
+		reconciledEnclavePlanInstructionIdxs[matchingInstructionIdx] = true
+		numberOfReconciledInstructions += 1
+	}
+	return numberOfReconciledInstructions
+}
+
+// getReconciliationSummary counts what the instructions of a plan do to the enclave. Instructions that were not
+// executed yet are updates if they were interpreted against an instruction of the mask, which can be nil
+func getReconciliationSummary(instructionsList []*instructions_plan.ScheduledInstruction, mask *resolver.InstructionsPlanMask, numberOfInstructionsLeftInPlace int) *instructions_plan.ReconciliationSummary {
+	summary := &instructions_plan.ReconciliationSummary{
+		NumUnchanged:   0,
+		NumUpdated:     0,
+		NumAdded:       0,
+		NumLeftInPlace: numberOfInstructionsLeftInPlace,
+	}
+	for idx, scheduledInstruction := range instructionsList {
+		if scheduledInstruction.IsExecuted() {
+			summary.NumUnchanged += 1
+		} else if mask != nil && idx < mask.Size() && mask.GetAt(idx) != nil {
+			summary.NumUpdated += 1
+		} else {
+			summary.NumAdded += 1
+		}
+	}
+	return summary
+}
+
 // This method handles the different cases a Startosis module can be executed.
 // - If input args are empty it uses empty JSON ({}) as the input args
 // - If input args aren't empty it tries to deserialize them
@@ -14,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_program_cache"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
 	"github.com/stretchr/testify/require"
@@ -69,10 +70,10 @@ func (suite *StartosisInterpreterIdempotentTestSuite) SetupTest() {
 
 	serviceNetwork := service_network.NewMockServiceNetwork(suite.T())
 	serviceNetwork.EXPECT().GetApiContainerInfo().Maybe().Return(
-		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), uint16(1234), "0.0.0"),
+		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), uint16(1234), "0.0.0", nil),
 	)
 	serviceNetwork.EXPECT().GetEnclaveUuid().Maybe().Return(enclaveUuid)
-	suite.interpreter = NewStartosisInterpreter(serviceNetwork, suite.packageContentProvider, runtimeValueStore, starlarkValueSerde, "", interpretationTimeValueStore)
+	suite.interpreter = NewStartosisInterpreter(serviceNetwork, suite.packageContentProvider, runtimeValueStore, starlarkValueSerde, "", interpretationTimeValueStore, starlark_program_cache.NewStarlarkProgramCache(""))
 }
 
 func TestRunStartosisInterpreterIdempotentTestSuite(t *testing.T) {
@@ -265,9 +266,9 @@ func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_D
 // Submit a package with an update on an instruction located "in the middle" of the package
 // Current plan ->     [`print("instruction1")`  `print("instruction2")`      `print("instruction3")`]
 // Package to run ->   [`print("instruction1")`  `print("instruction2_NEW")`  `print("instruction3")`]
-// That will result in the concatenation of the two plans because we're not able to properly resolve dependencies yet
-// [`print("instruction1")`  `print("instruction2")`  `print("instruction3")`  `print("instruction1")`  `print("instruction2_NEW")`  `print("instruction3")`]
-// The first three are imported from a previous plan, already executed, while the last three are from all new
+// The instructions that didn't change are reconciled with the ones of the current plan, which they replace
+// [`print("instruction2")`  `print("instruction1")`  `print("instruction2_NEW")`  `print("instruction3")`]
+// The first one is left in place from the previous plan, while only `print("instruction2_NEW")` is new
 func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_InvalidNewVersionOfThePackage() {
 	initialScript := `def run(plan, args):
 	plan.print(msg="instruction1")
@@ -318,7 +319,7 @@ func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_I
 
 	scheduledInstruction4 := instructionSequence[0]
 	require.Equal(suite.T(), `print(msg="instruction1")`, scheduledInstruction4.GetInstruction().String())
-	require.False(suite.T(), scheduledInstruction4.IsExecuted())
+	require.True(suite.T(), scheduledInstruction4.IsExecuted())
 
 	scheduledInstruction5 := instructionSequence[1]
 	require.Equal(suite.T(), `print(msg="instruction2_NEW")`, scheduledInstruction5.GetInstruction().String())
@@ -326,7 +327,20 @@ func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_I
 
 	scheduledInstruction6 := instructionSequence[2]
 	require.Equal(suite.T(), `print(msg="instruction3")`, scheduledInstruction6.GetInstruction().String())
-	require.False(suite.T(), scheduledInstruction6.IsExecuted())
+	require.True(suite.T(), scheduledInstruction6.IsExecuted())
+
+	currentEnclavePlanSequence := convertedEnclavePlan.GeneratePlan()
+	require.Equal(suite.T(), map[string]bool{
+		currentEnclavePlanSequence[0].Uuid: true,
+		currentEnclavePlanSequence[2].Uuid: true,
+	}, instructionsPlan.GetReplacedEnclavePlanInstructionUuids())
+
+	require.Equal(suite.T(), &instructions_plan.ReconciliationSummary{
+		NumUnchanged:   2,
+		NumUpdated:     0,
+		NumAdded:       1,
+		NumLeftInPlace: 1,
+	}, instructionsPlan.GetReconciliationSummary())
 }
 
 // Submit a package with an update to an add_service instruction. add_service instructions is supports being run twice
@@ -399,6 +413,81 @@ func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_A
 	require.True(suite.T(), scheduledInstruction4.IsExecuted())
 }
 
+// Submit a package which updates a service and adds a new one in between the services already in the enclave
+// Current plan ->     [`add_service(service_1)`      `add_service(service_2)`]
+// Package to run ->   [`add_service(service_1_NEW)`  `add_service(service_3)`  `add_service(service_2)`]
+// Check that the services get reconciled with the ones of the enclave, so that only service_1 is updated and service_3
+// is added, while service_2 is skipped
+func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_ReconcileServicesWithEnclavePlan() {
+	initialScript := `def run(plan):
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+	plan.add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+`
+	// Interpretation of the initial script to generate the current enclave plan
+	_, currentEnclavePlan, interpretationApiErr := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		noPackageReplaceOptions,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		initialScript,
+		noInputParams,
+		defaultNonBlockingMode,
+		enclave_structure.NewEnclaveComponents(),
+		resolver.NewInstructionsPlanMask(0),
+		image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(suite.T(), interpretationApiErr)
+	require.Equal(suite.T(), 2, currentEnclavePlan.Size())
+	convertedEnclavePlan := suite.convertInstructionPlanToEnclavePlan(currentEnclavePlan)
+
+	updatedScript := `def run(plan):
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.5.0")) # <-- version updated
+	plan.add_service(name="service_3", config=ServiceConfig(image="kurtosistech/image:1.2.3")) # <-- new
+	plan.add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.2.3")) # <-- identical
+`
+	// Interpret the updated script against the current enclave plan
+	_, instructionsPlan, interpretationError := suite.interpreter.InterpretAndOptimizePlan(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		noPackageReplaceOptions,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		updatedScript,
+		noInputParams,
+		defaultNonBlockingMode,
+		convertedEnclavePlan,
+		image_download_mode.ImageDownloadMode_Missing,
+	)
+	require.Nil(suite.T(), interpretationError)
+
+	instructionSequence, err := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), err)
+	require.Equal(suite.T(), 2, instructionsPlan.GetIndexOfFirstInstruction())
+	require.Equal(suite.T(), 3, len(instructionSequence))
+
+	scheduledInstruction1 := instructionSequence[0]
+	require.Equal(suite.T(), `add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.5.0"))`, scheduledInstruction1.GetInstruction().String())
+	require.False(suite.T(), scheduledInstruction1.IsExecuted())
+
+	scheduledInstruction2 := instructionSequence[1]
+	require.Equal(suite.T(), `add_service(name="service_3", config=ServiceConfig(image="kurtosistech/image:1.2.3"))`, scheduledInstruction2.GetInstruction().String())
+	require.False(suite.T(), scheduledInstruction2.IsExecuted())
+
+	scheduledInstruction3 := instructionSequence[2]
+	require.Equal(suite.T(), `add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.2.3"))`, scheduledInstruction3.GetInstruction().String())
+	require.True(suite.T(), scheduledInstruction3.IsExecuted())
+
+	// both services of the enclave plan are replaced by the ones of the new plan
+	require.Len(suite.T(), instructionsPlan.GetReplacedEnclavePlanInstructionUuids(), 2)
+
+	require.Equal(suite.T(), &instructions_plan.ReconciliationSummary{
+		NumUnchanged:   1,
+		NumUpdated:     1,
+		NumAdded:       1,
+		NumLeftInPlace: 0,
+	}, instructionsPlan.GetReconciliationSummary())
+}
+
 // Submit a package with an update to an upload_files instruction. upload_files instructions support being run twice
 // in an enclave, updating "live" the file underneath if it has changed. Check that the add_service and its direct
 // dependencies are scheduled for a re-run but other instructions remains "SKIPPED"
@@ -23,6 +23,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_service_files"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_program_cache"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
@@ -83,7 +84,7 @@ func (suite *StartosisInterpreterTestSuite) SetupTest() {
 	suite.interpretationTimeValueStore = interpretationTimeValueStore
 	require.NotNil(suite.T(), interpretationTimeValueStore)
 
-	suite.interpreter = NewStartosisInterpreter(suite.serviceNetwork, suite.packageContentProvider, suite.runtimeValueStore, nil, "", suite.interpretationTimeValueStore)
+	suite.interpreter = NewStartosisInterpreter(suite.serviceNetwork, suite.packageContentProvider, suite.runtimeValueStore, nil, "", suite.interpretationTimeValueStore, starlark_program_cache.NewStarlarkProgramCache(""))
 
 	service.NewServiceRegistration(
 		testServiceName,
@@ -800,6 +801,26 @@ this is a test string
 	validateScriptOutputFromPrintInstructions(suite.T(), instructionsPlan, expectedOutput)
 }
 
+func (suite *StartosisInterpreterTestSuite) TestStartosisInterpreter_Secret() {
+	script := `
+def run(plan):
+	plan.print(kurtosis.secret("database/password"))
+`
+
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, script, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
+	require.Nil(suite.T(), interpretationError)
+	require.Equal(suite.T(), 1, instructionsPlan.Size())
+	// The secret is only resolved when the services referencing it start
+	validateScriptOutputFromPrintInstructions(suite.T(), instructionsPlan, "{{kurtosis:secret:database/password}}\n")
+
+	invalidScript := `
+def run(plan):
+	kurtosis.secret("database password")
+`
+	_, _, interpretationError = suite.interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, invalidScript, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
+	require.NotNil(suite.T(), interpretationError)
+}
+
 func (suite *StartosisInterpreterTestSuite) TestStartosisInterpreter_RenderTemplates() {
 	script := `
 template_data = {
@@ -1100,7 +1121,7 @@ def run(plan):
 	}
 
 	// We'll create a new interpreter using the processBuiltins transformer above
-	interpreter := NewStartosisInterpreterWithBuiltinsProcessor(suite.serviceNetwork, suite.packageContentProvider, suite.runtimeValueStore, nil, "", suite.interpretationTimeValueStore, processBuiltins)
+	interpreter := NewStartosisInterpreterWithBuiltinsProcessor(suite.serviceNetwork, suite.packageContentProvider, suite.runtimeValueStore, nil, "", suite.interpretationTimeValueStore, starlark_program_cache.NewStarlarkProgramCache(""), processBuiltins)
 
 	_, _, interpretationError := interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, script, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
 
@@ -1125,7 +1146,7 @@ def run(plan):
 	}
 
 	// We'll create a new interpreter using the processBuiltins transformer above
-	interpreter := NewStartosisInterpreterWithBuiltinsProcessor(suite.serviceNetwork, suite.packageContentProvider, suite.runtimeValueStore, nil, "", suite.interpretationTimeValueStore, processBuiltins)
+	interpreter := NewStartosisInterpreterWithBuiltinsProcessor(suite.serviceNetwork, suite.packageContentProvider, suite.runtimeValueStore, nil, "", suite.interpretationTimeValueStore, starlark_program_cache.NewStarlarkProgramCache(""), processBuiltins)
 
 	// If everything goes well we should see no error & message on the output
 	_, instructionsPlan, interpretationError := interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, script, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
@@ -7,6 +7,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/docker_compose_transpiler"
@@ -60,14 +61,17 @@ type GitPackageContentProvider struct {
 	repositoriesDir                 string
 	packageReplaceOptionsRepository *packageReplaceOptionsRepository
 	githubAuthProvider              *GitHubPackageAuthProvider
+	// In air-gapped mode, the packages are cloned from the package mirror instead of GitHub
+	airGapConfig air_gap.AirGapConfig
 }
 
-func NewGitPackageContentProvider(repositoriesDir, tmpDir string, githubAuthProvider *GitHubPackageAuthProvider, enclaveDb *enclave_db.EnclaveDB) *GitPackageContentProvider {
+func NewGitPackageContentProvider(repositoriesDir, tmpDir string, githubAuthProvider *GitHubPackageAuthProvider, enclaveDb *enclave_db.EnclaveDB, airGapConfig air_gap.AirGapConfig) *GitPackageContentProvider {
 	return &GitPackageContentProvider{
 		repositoriesDir:                 repositoriesDir,
 		repositoriesTmpDir:              tmpDir,
 		githubAuthProvider:              githubAuthProvider,
 		packageReplaceOptionsRepository: newPackageReplaceOptionsRepository(enclaveDb),
+		airGapConfig:                    airGapConfig,
 	}
 }
 
@@ -420,6 +424,15 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 func (provider *GitPackageContentProvider) cloneWithRetries(parsedURL *shared_utils.ParsedGitURL, gitClonePath string, githubAuth *http.BasicAuth, depth int) (*git.Repository, *startosis_errors.InterpretationError) {
 	retryDelay := retryDelayStartValue
 
+	cloneUrl := parsedURL.GetGitURL()
+	mirroredCloneUrl, isMirrored := provider.airGapConfig.GetMirroredPackageGitUrl(parsedURL.GetRelativeRepoPath())
+	if isMirrored {
+		logrus.Debugf("Cloning git repository '%s' from the air-gapped mirror as '%s'", cloneUrl, mirroredCloneUrl)
+		cloneUrl = mirroredCloneUrl
+		// The GitHub token is meant for GitHub only, so it's never sent to the mirror
+		githubAuth = nil
+	}
+
 	var repo *git.Repository
 	var err error
 
@@ -429,7 +442,7 @@ func (provider *GitPackageContentProvider) cloneWithRetries(parsedURL *shared_ut
 		//TODO and the Starlark package could be just a small sub-folder inside a giant mono-repository
 		//TODO and even now, in the upload_files instruction, we are allowing to upload files or a folder for any repository, but we are cloning the entire repository for this
 		repo, err = git.PlainClone(gitClonePath, isNotBareClone, &git.CloneOptions{
-			URL:               parsedURL.GetGitURL(),
+			URL:               cloneUrl,
 			Auth:              githubAuth,
 			RemoteName:        "",
 			ReferenceName:     "",
@@ -464,7 +477,10 @@ func (provider *GitPackageContentProvider) cloneWithRetries(parsedURL *shared_ut
 	if err != nil {
 		// We silence the underlying error here as it can be confusing to the user. For example, when there's a typo in
 		// the repo name, pointing to a non existing repo, the underlying error is: "authentication required"
-		logrus.Errorf("Error cloning git repository: '%s' to '%s'. Error was: \n%s", parsedURL.GetGitURL(), gitClonePath, err.Error())
+		logrus.Errorf("Error cloning git repository: '%s' to '%s'. Error was: \n%s", cloneUrl, gitClonePath, err.Error())
+		if isMirrored {
+			return nil, startosis_errors.NewInterpretationError("Error in cloning git repository '%s' from the air-gapped mirror as '%s'. Make sure that it was exported with 'kurtosis airgap export' and pushed to the mirror.", parsedURL.GetGitURL(), cloneUrl)
+		}
 		return nil, startosis_errors.NewInterpretationError("Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists or if it's a private repository, that you are logged into GitHub via `kurtosis github login`.\nIf this is NOT a private repo, there could be an issue with MTUs configured by Docker networks. Please refer to discussion and articles at this issue: https://github.com/kurtosis-tech/kurtosis/issues/2150", parsedURL.GetGitURL(), gitClonePath, parsedURL.GetGitURL())
 	}
 	return repo, nil
@@ -2,7 +2,10 @@ package git_package_content_provider
 
 import (
 	"fmt"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/docker_compose_transpiler"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
@@ -15,6 +18,7 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 )
 
 const (
@@ -39,7 +43,7 @@ func TestGitPackageProvider_SucceedsForValidKurtosisPackage(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star"
 
@@ -50,6 +54,39 @@ func TestGitPackageProvider_SucceedsForValidKurtosisPackage(t *testing.T) {
 	require.Equal(t, "a = \"World!\"\n", contents)
 }
 
+func TestGitPackageProvider_ClonesFromTheAirGapMirror(t *testing.T) {
+	packageDir, err := os.MkdirTemp("", packagesDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageDir)
+	packageTmpDir, err := os.MkdirTemp("", repositoriesTmpDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(packageTmpDir)
+	githubAuthDir, err := os.MkdirTemp("", githubAuthDirRelPath)
+	require.Nil(t, err)
+	defer os.RemoveAll(githubAuthDir)
+	mirrorDir, err := os.MkdirTemp("", "package-mirror")
+	require.Nil(t, err)
+	defer os.RemoveAll(mirrorDir)
+
+	// The mirror only has the package, so the test fails if GitHub is reached instead
+	createMirroredRepositoryForTest(t, path.Join(mirrorDir, "air-gapped-author", "air-gapped-package.git"), map[string]string{
+		startosis_constants.KurtosisYamlName: "name: github.com/air-gapped-author/air-gapped-package\n",
+		"main.star":                          "a = \"offline\"\n",
+	})
+	airGapConfig := air_gap.AirGapConfig{
+		ImageMirror:   "registry.example.com/kurtosis",
+		PackageMirror: "file://" + mirrorDir,
+	}
+
+	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, airGapConfig)
+
+	moduleAbsoluteLocator := startosis_packages.NewPackageAbsoluteLocator("github.com/air-gapped-author/air-gapped-package/main.star", defaultMainBranch)
+	contents, interpretationErr := provider.GetModuleContents(moduleAbsoluteLocator)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "a = \"offline\"\n", contents)
+}
+
 func TestGitPackageProvider_SucceedsForValidDockerComposePackage(t *testing.T) {
 	packageDir, err := os.MkdirTemp("", packagesDirRelPath)
 	require.Nil(t, err)
@@ -62,7 +99,7 @@ func TestGitPackageProvider_SucceedsForValidDockerComposePackage(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	sampleComposeModule := "github.com/kurtosis-tech/django-compose/docker-compose.yml"
 
@@ -85,7 +122,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithExplicitMasterSet(t *test
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@main"
 
@@ -108,7 +145,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithBranch(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@test-branch"
 
@@ -131,7 +168,7 @@ func TestGitPackageProvider_FailsForInvalidBranch(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@non-existent-branch"
 
@@ -153,7 +190,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithTag(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@0.1.1"
 
@@ -176,7 +213,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithCommit(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@ec9062828e1a687a5db7dfa750f754f88119e4c0"
 
@@ -199,7 +236,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithCommitOnABranch(t *testin
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@df88baf51caffbe7e8f66c0e54715f680f4482b2"
 
@@ -222,7 +259,7 @@ func TestGitPackageProvider_SucceedsForNonStarlarkFile(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	// TODO replace this with something local or static
 	sampleStarlarkPackage := "github.com/kurtosis-tech/prometheus-package/static-files/prometheus.yml.tmpl"
@@ -246,7 +283,7 @@ func TestGitPackageProvider_FailsForNonExistentPackage(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 	nonExistentModulePath := "github.com/kurtosis-tech/non-existent-startosis-load/sample.star"
 
 	nonExistentModuleAbsoluteLocator := startosis_packages.NewPackageAbsoluteLocator(nonExistentModulePath, defaultMainBranch)
@@ -267,7 +304,7 @@ func TestGitPackageProvider_GetContentFromAbsoluteLocatorWithCommit(t *testing.T
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	absoluteLocatorStr := "github.com/ethpandaops/ethereum-package/src/package_io/input_parser.star"
 	commitHash := "fcaa2c23301c0f7012301fe019a75b0fa369961b"
@@ -291,7 +328,7 @@ func TestGitPackageProvider_GetContentFromAbsoluteLocatorWithCommitComparedWithM
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	absoluteLocatorStr := "github.com/kurtosis-tech/another-sample-dependency-package/directory/internal-module.star"
 	commitHashInMainBranch := ""
@@ -312,7 +349,7 @@ func TestGitPackageProvider_GetContentFromAbsoluteLocatorWithCommitComparedWithM
 	require.Nil(t, err)
 	defer os.RemoveAll(githubAuthDir)
 
-	provider2 := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider2 := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	commitHashInAnotherBranch := "f610049f1f9174bce871431af7d5d35cb6bfd76d"
 
@@ -338,7 +375,7 @@ func TestGetAbsolutePathOnDisk_WorksForPureDirectories(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	packagePath := "github.com/kurtosis-tech/datastore-army-package/src/helpers.star"
 
@@ -362,7 +399,7 @@ func TestGetAbsolutePathOnDisk_WorksForNonInMainBranchLocators(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	absoluteFileLocator := "github.com/kurtosis-tech/sample-dependency-package@test-branch/main.star"
 
@@ -386,7 +423,7 @@ func TestGetAbsolutePathOnDisk_GenericRepositoryDir(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(repositoriesDir, repositoriesTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(repositoriesDir, repositoriesTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 	repositoryPathURL := "github.com/kurtosis-tech/minimal-grpc-server/golang/scripts"
 
 	absoluteLocator := startosis_packages.NewPackageAbsoluteLocator(repositoryPathURL, defaultMainBranch)
@@ -411,7 +448,7 @@ func TestGetAbsolutePathOnDisk_GenericRepositoryFile(t *testing.T) {
 	defer os.RemoveAll(githubAuthDir)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(repositoriesDir, repositoriesTmpDir, githubAuthProvider, nil)
+	provider := NewGitPackageContentProvider(repositoriesDir, repositoriesTmpDir, githubAuthProvider, nil, air_gap.NewDisabledAirGapConfig())
 
 	repositoryPathURL := "github.com/kurtosis-tech/minimal-grpc-server/golang/scripts/build.sh"
 
@@ -424,7 +461,7 @@ func TestGetAbsolutePathOnDisk_GenericRepositoryFile(t *testing.T) {
 }
 
 func TestGetAbsoluteLocator_SucceedsForRelativeFile(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/kurtosis-tech/avalanche-package"
 	parentModuleId := "github.com/kurtosis-tech/avalanche-package/src/builder.star"
@@ -445,7 +482,7 @@ func TestGetAbsoluteLocator_SucceedsForRelativeFile(t *testing.T) {
 }
 
 func TestGetAbsoluteLocator_RegularReplaceSucceeds(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/kurtosis-tech/sample-startosis-load/sample-package"
 	parentModuleId := "github.com/kurtosis-tech/sample-startosis-load/sample-package/main.star"
@@ -462,7 +499,7 @@ func TestGetAbsoluteLocator_RegularReplaceSucceeds(t *testing.T) {
 }
 
 func TestGetAbsoluteLocator_AnotherPackageWithCommitReplaceSucceeds(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/kurtosis-tech/sample-startosis-load/sample-package"
 	parentModuleId := "github.com/kurtosis-tech/sample-startosis-load/sample-package/main.star"
@@ -478,7 +515,7 @@ func TestGetAbsoluteLocator_AnotherPackageWithCommitReplaceSucceeds(t *testing.T
 }
 
 func TestGetAbsoluteLocator_RootPackageReplaceSucceeds(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/kurtosis-tech/sample-startosis-load/sample-package"
 	parentModuleId := "github.com/kurtosis-tech/sample-startosis-load/sample-package/main.star"
@@ -496,7 +533,7 @@ func TestGetAbsoluteLocator_RootPackageReplaceSucceeds(t *testing.T) {
 }
 
 func TestGetAbsoluteLocator_SubPackageReplaceSucceeds(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/kurtosis-tech/sample-startosis-load/sample-package"
 	parentModuleId := "github.com/kurtosis-tech/sample-startosis-load/sample-package/main.star"
@@ -514,7 +551,7 @@ func TestGetAbsoluteLocator_SubPackageReplaceSucceeds(t *testing.T) {
 }
 
 func TestGetAbsoluteLocator_ReplacePackageInternalModuleSucceeds(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/kurtosis-tech/sample-startosis-load/sample-package"
 	parentModuleId := "github.com/kurtosis-tech/sample-startosis-load/sample-package/main.star"
@@ -530,7 +567,7 @@ func TestGetAbsoluteLocator_ReplacePackageInternalModuleSucceeds(t *testing.T) {
 }
 
 func TestGetAbsoluteLocator_NoMainBranchReplaceSucceeds(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/kurtosis-tech/sample-startosis-load/sample-package"
 	parentModuleId := "github.com/kurtosis-tech/sample-startosis-load/sample-package/main.star"
@@ -546,7 +583,7 @@ func TestGetAbsoluteLocator_NoMainBranchReplaceSucceeds(t *testing.T) {
 }
 
 func TestGetAbsoluteLocator_ShouldBlockSamePackageAbsoluteLocator(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/main-package"
 	locatorOfModuleInWhichThisBuiltInIsBeingCalled := "github.com/main-package/main.star"
@@ -557,7 +594,7 @@ func TestGetAbsoluteLocator_ShouldBlockSamePackageAbsoluteLocator(t *testing.T)
 }
 
 func TestGetAbsoluteLocator_ShouldBlockSamePackageAbsoluteLocatorInSubfolder(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/main-package"
 	locatorOfModuleInWhichThisBuiltInIsBeingCalled := "github.com/main-package/main.star"
@@ -568,7 +605,7 @@ func TestGetAbsoluteLocator_ShouldBlockSamePackageAbsoluteLocatorInSubfolder(t *
 }
 
 func TestGetAbsoluteLocator_SameRepositorySubpackagesShouldNotBeBlocked(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/main-project/package1-in-subfolder"
 	locatorOfModuleInWhichThisBuiltInIsBeingCalled := "github.com/main-project/package1-in-subfolder/main.star"
@@ -579,7 +616,7 @@ func TestGetAbsoluteLocator_SameRepositorySubpackagesShouldNotBeBlocked(t *testi
 }
 
 func TestGetAbsoluteLocator_RelativeLocatorShouldNotBeBlocked(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/main-package"
 	locatorOfModuleInWhichThisBuiltInIsBeingCalled := "github.com/main-package/main.star"
@@ -590,7 +627,7 @@ func TestGetAbsoluteLocator_RelativeLocatorShouldNotBeBlocked(t *testing.T) {
 }
 
 func TestGetAbsoluteLocator_AbsoluteLocatorIsInRootPackageButSourceIsNotShouldNotBeBlocked(t *testing.T) {
-	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil)
+	provider := NewGitPackageContentProvider("", "", NewGitHubPackageAuthProvider(""), nil, air_gap.NewDisabledAirGapConfig())
 
 	packageId := "github.com/main-package"
 	locatorOfModuleInWhichThisBuiltInIsBeingCalled := "github.com/child-package/main.star"
@@ -824,7 +861,7 @@ func TestCloneReplacedPackagesIfNeeded_Succeeds(t *testing.T) {
 	enclaveDb := getEnclaveDbForTest(t)
 
 	githubAuthProvider := NewGitHubPackageAuthProvider(githubAuthDir)
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, enclaveDb)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, githubAuthProvider, enclaveDb, air_gap.NewDisabledAirGapConfig())
 
 	firstRunReplacePackageOptions := map[string]string{
 		"github.com/kurtosis-tech/sample-dependency-package": "../from-local-folder",
@@ -867,3 +904,26 @@ func getEnclaveDbForTest(t *testing.T) *enclave_db.EnclaveDB {
 		DB: db,
 	}
 }
+
+func createMirroredRepositoryForTest(t *testing.T, mirroredRepositoryPath string, filesContents map[string]string) {
+	workDir, err := os.MkdirTemp("", "package-work-dir")
+	require.Nil(t, err)
+	defer os.RemoveAll(workDir)
+
+	repo, err := git.PlainInit(workDir, false)
+	require.Nil(t, err)
+	workTree, err := repo.Worktree()
+	require.Nil(t, err)
+	for fileName, fileContents := range filesContents {
+		require.Nil(t, os.WriteFile(path.Join(workDir, fileName), []byte(fileContents), 0644))
+		_, err = workTree.Add(fileName)
+		require.Nil(t, err)
+	}
+	_, err = workTree.Commit("Add the package", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.Nil(t, err)
+
+	_, err = git.PlainClone(mirroredRepositoryPath, true, &git.CloneOptions{URL: workDir, Mirror: true})
+	require.Nil(t, err)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/image_signatures"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
@@ -46,8 +47,8 @@ type StartosisValidator struct {
 	backend *backend_interface.KurtosisBackend
 }
 
-func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore) *StartosisValidator {
-	imagesValidator := startosis_validator.NewImagesValidator(kurtosisBackend)
+func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore, maxConcurrentImagePulls int64, imageSignatureVerifier *image_signatures.ImageSignatureVerifier) *StartosisValidator {
+	imagesValidator := startosis_validator.NewImagesValidator(kurtosisBackend, maxConcurrentImagePulls, imageSignatureVerifier)
 	return &StartosisValidator{
 		imagesValidator,
 		serviceNetwork,
@@ -179,6 +180,7 @@ func (validator *StartosisValidator) validateImagesAccountingForProgress(ctx con
 				imageCurrentlyBeingValidated = removeIfPresent(imageCurrentlyBeingValidated, imageName)
 
 				imageSuccessfullyValidated[imageName] = validatedImage
+				sendContainerImageTiming(validatedImage, environment, starlarkRunResponseLineStream)
 
 				updateProgressWithDownloadInfo(starlarkRunResponseLineStream, imageCurrentlyBeingValidated, numberOfImageValidated, totalImageNumberToValidate)
 			case err, isChanOpen := <-errors:
@@ -257,6 +259,18 @@ func sendContainerImageSummaryInfoMsg(
 	}
 }
 
+func sendContainerImageTiming(
+	validatedImage *startosis_validator.ValidatedImage,
+	environment *startosis_validator.ValidatorEnvironment,
+	starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine,
+) {
+	var serviceNames []string
+	for _, serviceName := range environment.GetServicesUsingContainerImage(validatedImage.GetName()) {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromContainerImageTiming(validatedImage.GetName(), serviceNames, validatedImage.GetDuration())
+}
+
 func updateProgressWithDownloadInfo(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, imageCurrentlyInProgress []string, numberOfImageValidated uint32, totalNumberOfImagesToValidate uint32) {
 	msgLines := []string{validationInProgressMsg}
 	for _, imageName := range imageCurrentlyInProgress {
@@ -2,27 +2,37 @@ package startosis_validator
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_utils"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/image_signatures"
 	"github.com/sirupsen/logrus"
 )
 
-const maxNumberOfConcurrentDownloads = int64(4)
-
 type ImagesValidator struct {
 	kurtosisBackend *backend_interface.KurtosisBackend
+
+	// How many images are downloaded or built at once
+	maxNumberOfConcurrentDownloads int64
+
+	// Checks the signatures of the images before they're downloaded, nil if the cluster doesn't require them
+	imageSignatureVerifier *image_signatures.ImageSignatureVerifier
 }
 
-func NewImagesValidator(kurtosisBackend *backend_interface.KurtosisBackend) *ImagesValidator {
+func NewImagesValidator(kurtosisBackend *backend_interface.KurtosisBackend, maxNumberOfConcurrentDownloads int64, imageSignatureVerifier *image_signatures.ImageSignatureVerifier) *ImagesValidator {
 	return &ImagesValidator{
 		kurtosisBackend,
+		maxNumberOfConcurrentDownloads,
+		imageSignatureVerifier,
 	}
 }
 
@@ -43,7 +53,7 @@ func (validator *ImagesValidator) Validate(
 	imageValidationErrors chan<- error) {
 	// We use a buffered channel to control concurrency. We push a bool to this channel when a download starts, and
 	// pop one when it finishes
-	imageCurrentlyValidating := make(chan bool, maxNumberOfConcurrentDownloads)
+	imageCurrentlyValidating := make(chan bool, validator.maxNumberOfConcurrentDownloads)
 	defer func() {
 		close(imageValidationStarted)
 		close(imageValidationFinished)
@@ -52,9 +62,9 @@ func (validator *ImagesValidator) Validate(
 	}()
 
 	wg := &sync.WaitGroup{}
-	for imageName, maybeImageRegistrySpec := range environment.imagesToPull {
+	for _, imageNames := range groupImagesReferringToSameImage(environment.imagesToPull) {
 		wg.Add(1)
-		go fetchImageFromBackend(ctx, wg, imageCurrentlyValidating, validator.kurtosisBackend, imageName, maybeImageRegistrySpec, environment.imageDownloadMode, imageValidationErrors, imageValidationStarted, imageValidationFinished)
+		go fetchImageFromBackend(ctx, wg, imageCurrentlyValidating, validator.kurtosisBackend, validator.imageSignatureVerifier, imageNames, getImageRegistrySpec(imageNames, environment.imagesToPull), environment.imageDownloadMode, imageValidationErrors, imageValidationStarted, imageValidationFinished)
 	}
 	for imageName, imageBuildSpec := range environment.imagesToBuild {
 		wg.Add(1)
@@ -69,21 +79,66 @@ func (validator *ImagesValidator) Validate(
 	logrus.Debug("All image validation submitted, currently in progress.")
 }
 
-func fetchImageFromBackend(ctx context.Context, wg *sync.WaitGroup, imageCurrentlyDownloading chan bool, backend *backend_interface.KurtosisBackend, imageName string, registrySpec *image_registry_spec.ImageRegistrySpec, imageDownloadMode image_download_mode.ImageDownloadMode, pullErrors chan<- error, imageDownloadStarted chan<- string, imageDownloadFinished chan<- *ValidatedImage) {
+// groupImagesReferringToSameImage groups the names referring to the same image, e.g. 'nginx' and
+// 'docker.io/library/nginx:latest', so that each image is only downloaded once
+func groupImagesReferringToSameImage(imagesToPull map[string]*image_registry_spec.ImageRegistrySpec) map[string][]string {
+	imageNamesByReference := map[string][]string{}
+	for imageName := range imagesToPull {
+		imageReference := image_utils.NormalizeImageReference(imageName)
+		imageNamesByReference[imageReference] = append(imageNamesByReference[imageReference], imageName)
+	}
+	for _, imageNames := range imageNamesByReference {
+		sort.Strings(imageNames)
+	}
+	return imageNamesByReference
+}
+
+// getImageRegistrySpec returns the credentials given for any of the names of the image, if any
+func getImageRegistrySpec(imageNames []string, imagesToPull map[string]*image_registry_spec.ImageRegistrySpec) *image_registry_spec.ImageRegistrySpec {
+	for _, imageName := range imageNames {
+		if registrySpec := imagesToPull[imageName]; registrySpec != nil {
+			return registrySpec
+		}
+	}
+	return nil
+}
+
+// fetchImageFromBackend downloads the image the names refer to once, reporting the validation of every name
+func fetchImageFromBackend(ctx context.Context, wg *sync.WaitGroup, imageCurrentlyDownloading chan bool, backend *backend_interface.KurtosisBackend, imageSignatureVerifier *image_signatures.ImageSignatureVerifier, imageNames []string, registrySpec *image_registry_spec.ImageRegistrySpec, imageDownloadMode image_download_mode.ImageDownloadMode, pullErrors chan<- error, imageDownloadStarted chan<- string, imageDownloadFinished chan<- *ValidatedImage) {
+	imageName := imageNames[0]
 	logrus.Debugf("Requesting the download of image: '%s'", imageName)
 	var imagePulledFromRemote bool
 	var imageArch string
 	imageBuiltLocally := false
 	defer wg.Done()
 	imageCurrentlyDownloading <- true
-	imageDownloadStarted <- imageName
+	for _, name := range imageNames {
+		imageDownloadStarted <- name
+	}
+	validationStartTime := time.Now()
 	defer func() {
 		<-imageCurrentlyDownloading
-		imageDownloadFinished <- NewValidatedImage(imageName, imagePulledFromRemote, imageBuiltLocally, imageArch)
+		for _, name := range imageNames {
+			imageDownloadFinished <- NewValidatedImage(name, imagePulledFromRemote, imageBuiltLocally, imageArch, time.Since(validationStartTime))
+		}
 	}()
 
-	logrus.Debugf("Starting the download of image: '%s'", imageName)
-	imagePulledFromRemote, imageArch, err := (*backend).FetchImage(ctx, imageName, registrySpec, imageDownloadMode)
+	imageReferenceToFetch := imageName
+	if imageSignatureVerifier != nil {
+		// The image is verified before being downloaded so that an image the cluster doesn't trust never lands on it,
+		// and it's downloaded at the digest it was verified at so that a tag moved in between changes nothing
+		pinnedReference, err := imageSignatureVerifier.VerifyImage(ctx, imageName, registrySpec)
+		if err != nil {
+			logrus.Warnf("Container image '%s' failed the signature verification. Error was: '%s'", imageName, err.Error())
+			pullErrors <- startosis_errors.WrapWithValidationError(err, "Image '%v' was rejected by the image verification policy of the cluster.", imageName)
+			return
+		}
+		logrus.Debugf("Container image '%s' is signed by an allowed signer, pinned to '%s'", imageName, pinnedReference)
+		imageReferenceToFetch = pinnedReference
+	}
+
+	logrus.Debugf("Starting the download of image: '%s'", imageReferenceToFetch)
+	imagePulledFromRemote, imageArch, err := (*backend).FetchImage(ctx, imageReferenceToFetch, registrySpec, imageDownloadMode)
 	if err != nil {
 		logrus.Warnf("Container image '%s' download failed. Error was: '%s'", imageName, err.Error())
 		pullErrors <- startosis_errors.WrapWithValidationError(err, "Failed fetching the required image '%v'.", imageName)
@@ -109,11 +164,17 @@ func (validator *ImagesValidator) buildImageUsingBackend(
 	defer wg.Done()
 	imageCurrentlyBuilding <- true
 	imageBuildStarted <- imageName
+	validationStartTime := time.Now()
 	defer func() {
 		<-imageCurrentlyBuilding
-		imageBuildFinished <- NewValidatedImage(imageName, imagePulledFromRemote, imageBuiltLocally, imageArch)
+		imageBuildFinished <- NewValidatedImage(imageName, imagePulledFromRemote, imageBuiltLocally, imageArch, time.Since(validationStartTime))
 	}()
 
+	if validator.imageSignatureVerifier != nil && !validator.imageSignatureVerifier.AllowsLocallyBuiltImages() {
+		buildErrors <- startosis_errors.NewValidationError("Image '%v' can't be built as the image verification policy of the cluster only allows signed images. Push a signed image and use it instead, or allow locally built images in the cluster config.", imageName)
+		return
+	}
+
 	logrus.Debugf("Starting the build of image: '%s'", imageName)
 	imageArch, err := (*backend).BuildImage(ctx, imageName, imageBuildSpec)
 	if err != nil {
@@ -142,11 +203,17 @@ func (validator *ImagesValidator) nixBuildUsingBackend(
 	defer wg.Done()
 	imageCurrentlyBuilding <- true
 	nixBuildStarted <- imageRef
+	validationStartTime := time.Now()
 	defer func() {
 		<-imageCurrentlyBuilding
-		nixBuildFinished <- NewValidatedImage(imageName, imagePulledFromRemote, imageBuiltLocally, imageArch)
+		nixBuildFinished <- NewValidatedImage(imageName, imagePulledFromRemote, imageBuiltLocally, imageArch, time.Since(validationStartTime))
 	}()
 
+	if validator.imageSignatureVerifier != nil && !validator.imageSignatureVerifier.AllowsLocallyBuiltImages() {
+		buildErrors <- startosis_errors.NewValidationError("Image '%v' can't be built with Nix as the image verification policy of the cluster only allows signed images. Push a signed image and use it instead, or allow locally built images in the cluster config.", imageRef)
+		return
+	}
+
 	logrus.Debugf("Starting the build of image: '%s'", imageRef)
 	imageName, err := (*backend).NixBuild(ctx, nixBuildSpec)
 	if err != nil {
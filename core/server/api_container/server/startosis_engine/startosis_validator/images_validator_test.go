@@ -0,0 +1,26 @@
+package startosis_validator
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupImagesReferringToSameImage(t *testing.T) {
+	registrySpec := image_registry_spec.NewImageRegistrySpec("ghcr.io/org/app:1.0", "user", "password", "ghcr.io")
+	imagesToPull := map[string]*image_registry_spec.ImageRegistrySpec{
+		"nginx":                          nil,
+		"nginx:latest":                   nil,
+		"docker.io/library/nginx:latest": nil,
+		"ghcr.io/org/app:1.0":            registrySpec,
+	}
+
+	imageNamesByReference := groupImagesReferringToSameImage(imagesToPull)
+	require.Equal(t, map[string][]string{
+		"docker.io/library/nginx:latest": {"docker.io/library/nginx:latest", "nginx", "nginx:latest"},
+		"ghcr.io/org/app:1.0":            {"ghcr.io/org/app:1.0"},
+	}, imageNamesByReference)
+	require.Equal(t, registrySpec, getImageRegistrySpec(imageNamesByReference["ghcr.io/org/app:1.0"], imagesToPull))
+	require.Nil(t, getImageRegistrySpec(imageNamesByReference["docker.io/library/nginx:latest"], imagesToPull))
+}
@@ -1,18 +1,22 @@
 package startosis_validator
 
+import "time"
+
 type ValidatedImage struct {
 	name             string
 	pulledFromRemote bool
 	builtLocally     bool
 	architecture     string
+	duration         time.Duration
 }
 
-func NewValidatedImage(name string, pulledFromRemote bool, builtLocally bool, architecture string) *ValidatedImage {
+func NewValidatedImage(name string, pulledFromRemote bool, builtLocally bool, architecture string, duration time.Duration) *ValidatedImage {
 	return &ValidatedImage{
 		name:             name,
 		pulledFromRemote: pulledFromRemote,
 		builtLocally:     builtLocally,
-		architecture:     architecture}
+		architecture:     architecture,
+		duration:         duration}
 }
 
 func (v *ValidatedImage) GetName() string {
@@ -30,3 +34,8 @@ func (v *ValidatedImage) IsBuiltLocally() bool {
 func (v *ValidatedImage) GetArchitecture() string {
 	return v.architecture
 }
+
+// GetDuration returns the wall time spent pulling or building the image
+func (v *ValidatedImage) GetDuration() time.Duration {
+	return v.duration
+}
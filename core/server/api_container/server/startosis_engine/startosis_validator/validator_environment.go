@@ -27,6 +27,7 @@ type ValidatorEnvironment struct {
 	minCPUByServiceName           map[service.ServiceName]compute_resources.CpuMilliCores
 	minMemoryByServiceName        map[service.ServiceName]compute_resources.MemoryInMegaBytes
 	imageDownloadMode             image_download_mode.ImageDownloadMode
+	serviceNamesByContainerImage  map[string][]service.ServiceName
 }
 
 func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode) *ValidatorEnvironment {
@@ -49,10 +50,11 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 		availableMemoryInMegaBytes:    availableMemoryInMegaBytes,
 		isResourceInformationComplete: isResourceInformationComplete,
 		// TODO account for idempotent runs on this and make it pre-load the cache whenever we create a NewValidatorEnvironment
-		persistentKeys:         map[service_directory.DirectoryPersistentKey]ComponentExistence{},
-		minMemoryByServiceName: map[service.ServiceName]compute_resources.MemoryInMegaBytes{},
-		minCPUByServiceName:    map[service.ServiceName]compute_resources.CpuMilliCores{},
-		imageDownloadMode:      imageDownloadMode,
+		persistentKeys:               map[service_directory.DirectoryPersistentKey]ComponentExistence{},
+		minMemoryByServiceName:       map[service.ServiceName]compute_resources.MemoryInMegaBytes{},
+		minCPUByServiceName:          map[service.ServiceName]compute_resources.CpuMilliCores{},
+		imageDownloadMode:            imageDownloadMode,
+		serviceNamesByContainerImage: map[string][]service.ServiceName{},
 	}
 }
 
@@ -72,6 +74,15 @@ func (environment *ValidatorEnvironment) AppendRequiredNixBuild(containerImage s
 	environment.nixToBuild[containerImage] = nixBuildSpec
 }
 
+// AddServiceUsingContainerImage records which services use an image, to attribute the time spent fetching it
+func (environment *ValidatorEnvironment) AddServiceUsingContainerImage(containerImage string, serviceName service.ServiceName) {
+	environment.serviceNamesByContainerImage[containerImage] = append(environment.serviceNamesByContainerImage[containerImage], serviceName)
+}
+
+func (environment *ValidatorEnvironment) GetServicesUsingContainerImage(containerImage string) []service.ServiceName {
+	return environment.serviceNamesByContainerImage[containerImage]
+}
+
 func (environment *ValidatorEnvironment) GetNumberOfContainerImagesToProcess() uint32 {
 	return uint32(len(environment.imagesToPull) + len(environment.imagesToBuild) + len(environment.nixToBuild))
 }
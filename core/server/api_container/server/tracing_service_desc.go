@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	fullMethodNameFormat = "/%s/%s"
+)
+
+// NewTracingServiceDesc returns a copy of the service description whose handlers start a span for each call, joining
+// the trace of the caller if the call carries one. The minimal gRPC server the API container runs on doesn't take
+// interceptors, so the tracing is added to the service itself
+func NewTracingServiceDesc(serviceDesc grpc.ServiceDesc) *grpc.ServiceDesc {
+	tracingUnaryInterceptor := otelgrpc.UnaryServerInterceptor()
+	tracingStreamInterceptor := otelgrpc.StreamServerInterceptor()
+
+	methods := make([]grpc.MethodDesc, len(serviceDesc.Methods))
+	for idx, method := range serviceDesc.Methods {
+		handler := method.Handler
+		methods[idx] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return handler(srv, ctx, dec, chainUnaryInterceptors(tracingUnaryInterceptor, interceptor))
+			},
+		}
+	}
+
+	streams := make([]grpc.StreamDesc, len(serviceDesc.Streams))
+	for idx, stream := range serviceDesc.Streams {
+		handler := stream.Handler
+		streamInfo := &grpc.StreamServerInfo{
+			FullMethod:     fmt.Sprintf(fullMethodNameFormat, serviceDesc.ServiceName, stream.StreamName),
+			IsClientStream: stream.ClientStreams,
+			IsServerStream: stream.ServerStreams,
+		}
+		streams[idx] = grpc.StreamDesc{
+			StreamName: stream.StreamName,
+			Handler: func(srv interface{}, serverStream grpc.ServerStream) error {
+				return tracingStreamInterceptor(srv, serverStream, streamInfo, handler)
+			},
+			ServerStreams: stream.ServerStreams,
+			ClientStreams: stream.ClientStreams,
+		}
+	}
+
+	return &grpc.ServiceDesc{
+		ServiceName: serviceDesc.ServiceName,
+		HandlerType: serviceDesc.HandlerType,
+		Methods:     methods,
+		Streams:     streams,
+		Metadata:    serviceDesc.Metadata,
+	}
+}
+
+// chainUnaryInterceptors makes the calls go through the tracing interceptor before the one of the server, if any
+func chainUnaryInterceptors(tracingInterceptor grpc.UnaryServerInterceptor, serverInterceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if serverInterceptor == nil {
+		return tracingInterceptor
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return tracingInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return serverInterceptor(ctx, req, info, handler)
+		})
+	}
+}
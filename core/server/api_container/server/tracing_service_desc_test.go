@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	testServiceName = "test.TestService"
+	testMethodName  = "TestMethod"
+
+	callerTraceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+	callerSpanId  = "00f067aa0ba902b7"
+)
+
+func TestNewTracingServiceDesc_UnaryCallJoinsTheTraceOfTheCaller(t *testing.T) {
+	spanRecorder := tracetest.NewSpanRecorder()
+	previousTracerProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(previousTracerProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	}()
+
+	var spanContextOfTheCall trace.SpanContext
+	serviceDesc := grpc.ServiceDesc{
+		ServiceName: testServiceName,
+		HandlerType: nil,
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: testMethodName,
+				// mimics the handlers generated by protoc-gen-go-grpc
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+					handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+						spanContextOfTheCall = trace.SpanContextFromContext(ctx)
+						return req, nil
+					}
+					info := &grpc.UnaryServerInfo{
+						Server:     srv,
+						FullMethod: "/" + testServiceName + "/" + testMethodName,
+					}
+					return interceptor(ctx, nil, info, handler)
+				},
+			},
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: nil,
+	}
+
+	tracingServiceDesc := NewTracingServiceDesc(serviceDesc)
+	require.Len(t, tracingServiceDesc.Methods, 1)
+	require.Equal(t, testMethodName, tracingServiceDesc.Methods[0].MethodName)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", "00-"+callerTraceId+"-"+callerSpanId+"-01"))
+	_, err := tracingServiceDesc.Methods[0].Handler(nil, ctx, func(interface{}) error { return nil }, nil)
+	require.NoError(t, err)
+
+	endedSpans := spanRecorder.Ended()
+	require.Len(t, endedSpans, 1)
+	span := endedSpans[0]
+	require.Equal(t, testServiceName+"/"+testMethodName, span.Name())
+	require.Equal(t, callerTraceId, span.SpanContext().TraceID().String())
+	require.Equal(t, callerSpanId, span.Parent().SpanID().String())
+	require.Equal(t, span.SpanContext().SpanID(), spanContextOfTheCall.SpanID())
+}
@@ -30,12 +30,29 @@ const (
 
 	// Name of directory INSIDE THE ENCLAVE DATA DIR containing the enclave database (currently the bolt dB is implemented)
 	enclaveDatabase = "enclave-database"
+
+	// Name of directory INSIDE THE ENCLAVE DATA DIR where files downloaded from the web are cached, keyed by the
+	// SHA-256 of their content
+	webDownloadCacheDirname = "web-download-cache"
+
+	// The least recently used downloads are evicted past this size, as they can always be downloaded again
+	webDownloadCacheMaxBytes = 1024 * 1024 * 1024
+
+	// Name of directory INSIDE THE ENCLAVE DATA DIR where compiled Starlark programs are cached. On Docker, the volume
+	// shared by the API containers of all the enclaves is mounted there
+	starlarkProgramCacheDirname = "starlark-program-cache"
 )
 
 // A directory containing all the data associated with a certain enclave (i.e. a Docker subnetwork where services are spun up)
 // An enclave is created either per-test (in the testing framework) or per interactive instance (with Kurtosis Interactive)
 type EnclaveDataDirectory struct {
 	absMountDirpath string
+
+	// If set, files artifacts are stored there, the enclave data volume only caching them
+	maybeRemoteFileStore RemoteFileStore
+
+	// Cap on the total size of the files artifacts of the enclave; 0 means unlimited
+	maxFilesArtifactsBytes uint64
 }
 
 var (
@@ -45,7 +62,15 @@ var (
 )
 
 func NewEnclaveDataDirectory(absMountDirpath string) *EnclaveDataDirectory {
-	return &EnclaveDataDirectory{absMountDirpath: absMountDirpath}
+	return NewEnclaveDataDirectoryWithRemoteFileStore(absMountDirpath, nil, unlimitedFilesArtifactsBytes)
+}
+
+func NewEnclaveDataDirectoryWithRemoteFileStore(absMountDirpath string, maybeRemoteFileStore RemoteFileStore, maxFilesArtifactsBytes uint64) *EnclaveDataDirectory {
+	return &EnclaveDataDirectory{
+		absMountDirpath:        absMountDirpath,
+		maybeRemoteFileStore:   maybeRemoteFileStore,
+		maxFilesArtifactsBytes: maxFilesArtifactsBytes,
+	}
 }
 
 func (dir EnclaveDataDirectory) GetFilesArtifactStore() (*FilesArtifactStore, error) {
@@ -64,12 +89,44 @@ func (dir EnclaveDataDirectory) GetFilesArtifactStore() (*FilesArtifactStore, er
 			dbError = stacktrace.Propagate(err, "Failed to get file artifacts db")
 			return
 		}
-		currentFilesArtifactStore = newFilesArtifactStoreFromDb(absoluteDirpath, relativeDirpath, db)
+		if err := restoreLostFilesArtifactsIndex(db, dir.maybeRemoteFileStore); err != nil {
+			dbError = stacktrace.Propagate(err, "Failed to restore the file artifacts db from the remote store")
+			return
+		}
+		currentFilesArtifactStore = newFilesArtifactStoreFromDb(absoluteDirpath, relativeDirpath, db, dir.maybeRemoteFileStore, dir.maxFilesArtifactsBytes)
 	})
 
 	return currentFilesArtifactStore, dbError
 }
 
+// GetWebDownloadCache returns the cache of files downloaded from the web. It's not backed by the remote file store as
+// its content can always be downloaded again, which is also why it's bounded in size
+func (dir EnclaveDataDirectory) GetWebDownloadCache() (*FileCache, error) {
+	relativeDirpath := webDownloadCacheDirname
+	absoluteDirpath := path.Join(dir.absMountDirpath, relativeDirpath)
+	if err := ensureDirpathExists(absoluteDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred ensuring the web download cache dirpath '%v' exists.", absoluteDirpath)
+	}
+	// The downloads sit on the same volume as the files artifacts, so they're kept within the quota of the enclave too
+	maxTotalBytes := uint64(webDownloadCacheMaxBytes)
+	maxFileBytes := uint64(unboundedFileCacheBytes)
+	if dir.maxFilesArtifactsBytes != unlimitedFilesArtifactsBytes {
+		maxFileBytes = dir.maxFilesArtifactsBytes
+		if dir.maxFilesArtifactsBytes < maxTotalBytes {
+			maxTotalBytes = dir.maxFilesArtifactsBytes
+		}
+	}
+	return newBoundedFileCache(absoluteDirpath, relativeDirpath, maxTotalBytes, maxFileBytes), nil
+}
+
+func (dir EnclaveDataDirectory) GetStarlarkProgramCacheDirpath() (string, error) {
+	absoluteDirpath := path.Join(dir.absMountDirpath, starlarkProgramCacheDirname)
+	if err := ensureDirpathExists(absoluteDirpath); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred ensuring the Starlark program cache dirpath '%v' exists.", absoluteDirpath)
+	}
+	return absoluteDirpath, nil
+}
+
 func (dir EnclaveDataDirectory) GetEnclaveDataDirectoryPaths() (string, string, string, string, error) {
 	repositoriesStoreDirpath := path.Join(dir.absMountDirpath, repositoriesStoreDirname)
 	if err := ensureDirpathExists(repositoriesStoreDirpath); err != nil {
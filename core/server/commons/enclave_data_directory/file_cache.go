@@ -12,7 +12,18 @@ import (
 	"io"
 	"os"
 	"path"
+	"sort"
+	"strings"
 	"sync"
+	"time"
+)
+
+const (
+	stagingFileSuffix  = ".staging"
+	stagingFilePattern = "*" + stagingFileSuffix
+
+	// No cap on the total size of the files of the cache
+	unboundedFileCacheBytes = 0
 )
 
 // Represents a write-only file cache, backed by a directory inside the enclave data dir
@@ -20,6 +31,12 @@ type FileCache struct {
 	absoluteDirpath              string
 	dirpathRelativeToDataDirRoot string
 
+	// If set, the least recently used files are evicted when adding a file brings the total size of the cache over it
+	maxTotalBytes uint64
+
+	// If set, files bigger than this can't be added to the cache, as they wouldn't fit in the quota of the enclave
+	maxFileBytes uint64
+
 	// Mutex to ensure we don't get race conditions when adding/getting files from the cache
 	mutex *sync.Mutex
 }
@@ -28,10 +45,33 @@ func newFileCache(absoluteDirpath string, dirpathRelativeToDataDirRoot string) *
 	return &FileCache{
 		absoluteDirpath:              absoluteDirpath,
 		dirpathRelativeToDataDirRoot: dirpathRelativeToDataDirRoot,
+		maxTotalBytes:                unboundedFileCacheBytes,
+		maxFileBytes:                 unboundedFileCacheBytes,
+		mutex:                        &sync.Mutex{},
+	}
+}
+
+// newBoundedFileCache returns a cache of files that can always be regenerated, so they're evicted least recently used
+// first to keep the total size of the cache under maxTotalBytes
+func newBoundedFileCache(absoluteDirpath string, dirpathRelativeToDataDirRoot string, maxTotalBytes uint64, maxFileBytes uint64) *FileCache {
+	return &FileCache{
+		absoluteDirpath:              absoluteDirpath,
+		dirpathRelativeToDataDirRoot: dirpathRelativeToDataDirRoot,
+		maxTotalBytes:                maxTotalBytes,
+		maxFileBytes:                 maxFileBytes,
 		mutex:                        &sync.Mutex{},
 	}
 }
 
+// LimitToMaxFileSize returns a reader failing with a FilesArtifactsQuotaExceededError once more bytes than a file of
+// the cache can hold are read, so that content that can't be added is never fully written
+func (cache *FileCache) LimitToMaxFileSize(reader io.Reader, fileIdentifier string) io.Reader {
+	if cache.maxFileBytes == unboundedFileCacheBytes {
+		return reader
+	}
+	return newQuotaLimitedReader(reader, fileIdentifier, cache.maxFileBytes, 0)
+}
+
 func (cache *FileCache) AddFile(key string, reader io.Reader) (*EnclaveDataDirFile, error) {
 	cache.mutex.Lock()
 	defer cache.mutex.Unlock()
@@ -68,6 +108,9 @@ func (cache *FileCache) AddFile(key string, reader io.Reader) (*EnclaveDataDirFi
 	}
 
 	shouldDeleteFile = false
+	if err := cache.evictLeastRecentlyUsedFilesUnlocked(key); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred evicting files from the cache to make room for file with key '%v'", key)
+	}
 	return newFileObj, nil
 }
 
@@ -80,9 +123,78 @@ func (cache *FileCache) GetFile(key string) (*EnclaveDataDirFile, error) {
 		return nil, stacktrace.NewError("No file with key '%v' exists in the cache", key)
 	}
 
+	if cache.maxTotalBytes != unboundedFileCacheBytes {
+		// The modification time is what tells the least recently used files apart on eviction
+		now := time.Now()
+		if err := os.Chtimes(fileObj.absoluteFilepath, now, now); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred marking file with key '%v' as recently used", key)
+		}
+	}
+
 	return fileObj, nil
 }
 
+// CreateStagingFile creates a temporary file inside the cache directory, for content to be written before it's known
+// under which key it should be added to the cache with AddStagedFile. The caller is responsible for removing it if it
+// isn't added
+func (cache *FileCache) CreateStagingFile() (*os.File, error) {
+	stagingFile, err := os.CreateTemp(cache.absoluteDirpath, stagingFilePattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a staging file in cache directory '%v'", cache.absoluteDirpath)
+	}
+	return stagingFile, nil
+}
+
+// AddStagedFile moves a closed file created by CreateStagingFile under the given key, without copying its content
+func (cache *FileCache) AddStagedFile(key string, stagingFilepath string) (*EnclaveDataDirFile, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	newFileObj := cache.getFileObjFromKey(key)
+	if _, err := os.Stat(newFileObj.absoluteFilepath); err == nil {
+		return nil, stacktrace.NewError("Cannot add file with key '%v' to the cache; a file with that key already exists", key)
+	}
+	if err := os.Rename(stagingFilepath, newFileObj.absoluteFilepath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred moving staging file '%v' under key '%v'", stagingFilepath, key)
+	}
+	if err := cache.evictLeastRecentlyUsedFilesUnlocked(key); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred evicting files from the cache to make room for file with key '%v'", key)
+	}
+	return newFileObj, nil
+}
+
+func (cache *FileCache) HasFile(key string) bool {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	fileObj := cache.getFileObjFromKey(key)
+	_, err := os.Stat(fileObj.absoluteFilepath)
+	return err == nil
+}
+
+// GetTotalSize returns the size of all the files in the cache directory
+func (cache *FileCache) GetTotalSize() (uint64, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	dirEntries, err := os.ReadDir(cache.absoluteDirpath)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred listing the files of the cache directory '%v'", cache.absoluteDirpath)
+	}
+	var totalSize uint64
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.Type().IsRegular() {
+			continue
+		}
+		fileInfo, err := dirEntry.Info()
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred getting the size of file '%v' of the cache", dirEntry.Name())
+		}
+		totalSize += uint64(fileInfo.Size())
+	}
+	return totalSize, nil
+}
+
 func (cache *FileCache) RemoveFile(key string) error {
 	cache.mutex.Lock()
 	defer cache.mutex.Unlock()
@@ -99,6 +211,51 @@ func (cache *FileCache) RemoveFile(key string) error {
 	return nil
 }
 
+// evictLeastRecentlyUsedFilesUnlocked removes the least recently used files until the total size of the cache is back
+// under its bound. The file with keyToKeep is never evicted, even if it doesn't fit in the bound on its own, as it's
+// about to be used; neither are the files being staged
+func (cache *FileCache) evictLeastRecentlyUsedFilesUnlocked(keyToKeep string) error {
+	if cache.maxTotalBytes == unboundedFileCacheBytes {
+		return nil
+	}
+	dirEntries, err := os.ReadDir(cache.absoluteDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the files of the cache directory '%v'", cache.absoluteDirpath)
+	}
+	var totalSize uint64
+	var evictableFileInfos []os.FileInfo
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.Type().IsRegular() || strings.HasSuffix(dirEntry.Name(), stagingFileSuffix) {
+			continue
+		}
+		fileInfo, err := dirEntry.Info()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the size of file '%v' of the cache", dirEntry.Name())
+		}
+		totalSize += uint64(fileInfo.Size())
+		if dirEntry.Name() != keyToKeep {
+			evictableFileInfos = append(evictableFileInfos, fileInfo)
+		}
+	}
+	sort.Slice(evictableFileInfos, func(i, j int) bool {
+		return evictableFileInfos[i].ModTime().Before(evictableFileInfos[j].ModTime())
+	})
+	for _, fileInfo := range evictableFileInfos {
+		if totalSize <= cache.maxTotalBytes {
+			break
+		}
+		if err := os.Remove(path.Join(cache.absoluteDirpath, fileInfo.Name())); err != nil {
+			return stacktrace.Propagate(err, "An error occurred evicting file with key '%v' from the cache", fileInfo.Name())
+		}
+		logrus.Debugf("Evicted file with key '%v' from the cache directory '%v'", fileInfo.Name(), cache.absoluteDirpath)
+		totalSize -= uint64(fileInfo.Size())
+	}
+	if totalSize > cache.maxTotalBytes {
+		logrus.Warnf("File with key '%v' alone goes over the '%v' bytes the cache directory '%v' is bounded to", keyToKeep, cache.maxTotalBytes, cache.absoluteDirpath)
+	}
+	return nil
+}
+
 func (cache *FileCache) getFileObjFromKey(key string) *EnclaveDataDirFile {
 	absoluteFilepath := path.Join(cache.absoluteDirpath, key)
 	relativeFilepath := path.Join(cache.dirpathRelativeToDataDirRoot, key)
@@ -62,6 +62,37 @@ func TestFileCache_GetErrorsOnNonexistentKey(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestFileCache_HasFile(t *testing.T) {
+	fileCache := getTestFileCache(t)
+
+	testKey := "test-key"
+	assert.False(t, fileCache.HasFile(testKey))
+
+	_, err := fileCache.AddFile(testKey, strings.NewReader("test-file-contents"))
+	assert.Nil(t, err)
+	assert.True(t, fileCache.HasFile(testKey))
+
+	err = fileCache.RemoveFile(testKey)
+	assert.Nil(t, err)
+	assert.False(t, fileCache.HasFile(testKey))
+}
+
+func TestFileCache_GetTotalSize(t *testing.T) {
+	fileCache := getTestFileCache(t)
+
+	totalSize, err := fileCache.GetTotalSize()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), totalSize)
+
+	_, err = fileCache.AddFile("first-key", strings.NewReader("12345"))
+	assert.Nil(t, err)
+	_, err = fileCache.AddFile("second-key", strings.NewReader("123"))
+	assert.Nil(t, err)
+	totalSize, err = fileCache.GetTotalSize()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(8), totalSize)
+}
+
 func TestFileCache_AddErrorsOnDuplicateAdd(t *testing.T) {
 	fileCache := getTestFileCache(t)
 
@@ -95,6 +126,39 @@ func TestFileCache_RemoveFileFailsForNonExistingKeys(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestFileCache_BoundedCacheEvictsLeastRecentlyUsedFiles(t *testing.T) {
+	absDirpath, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	fileCache := newBoundedFileCache(absDirpath, "", 10, unboundedFileCacheBytes)
+
+	addStagedTestFile(t, fileCache, "first-key", "1234")
+	addStagedTestFile(t, fileCache, "second-key", "1234")
+	// Using the first file makes the second one the least recently used
+	_, err = fileCache.GetFile("first-key")
+	assert.Nil(t, err)
+
+	addStagedTestFile(t, fileCache, "third-key", "1234")
+	assert.True(t, fileCache.HasFile("first-key"))
+	assert.False(t, fileCache.HasFile("second-key"))
+	assert.True(t, fileCache.HasFile("third-key"))
+
+	// A file going over the bound on its own is kept, as it's about to be used
+	addStagedTestFile(t, fileCache, "large-key", "12345678901")
+	assert.True(t, fileCache.HasFile("large-key"))
+	assert.False(t, fileCache.HasFile("first-key"))
+	assert.False(t, fileCache.HasFile("third-key"))
+}
+
+func addStagedTestFile(t *testing.T, fileCache *FileCache, key string, contents string) {
+	stagingFile, err := fileCache.CreateStagingFile()
+	assert.Nil(t, err)
+	_, err = stagingFile.WriteString(contents)
+	assert.Nil(t, err)
+	assert.Nil(t, stagingFile.Close())
+	_, err = fileCache.AddStagedFile(key, stagingFile.Name())
+	assert.Nil(t, err)
+}
+
 func getTestFileCache(t *testing.T) *FileCache {
 	absDirpath, err := os.MkdirTemp("", "")
 	assert.Nil(t, err)
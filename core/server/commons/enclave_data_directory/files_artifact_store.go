@@ -6,6 +6,9 @@
 package enclave_data_directory
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
@@ -13,8 +16,11 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -23,6 +29,21 @@ const (
 	// TODO: this is something we can take a look in detail
 	// but we with random numbers as suffix, we should always be able to have some unique name available
 	maxFileArtifactNameRetriesDefault = 5
+
+	// Previous versions of an artifact can be retrieved with '<identifier>@<version>', the latest with '<identifier>@latest'
+	artifactVersionSeparator = "@"
+	latestArtifactVersionTag = "latest"
+	firstArtifactVersion     = 1
+	// Older versions are dropped once an artifact has been updated more than this, so that artifacts regenerated on
+	// every run don't fill up the enclave data volume
+	maxFilesArtifactVersionsKept = 10
+
+	stagedContentTmpDir         = ""
+	stagedContentTmpFilePattern = "files-artifact-update-*"
+
+	// With a remote store, the enclave data volume only caches the content of files artifacts, and the least recently
+	// used are evicted past this size as they can always be downloaded again
+	remoteFilesArtifactsCacheMaxBytes = 1024 * 1024 * 1024
 )
 
 type FilesArtifactStore struct {
@@ -31,16 +52,50 @@ type FilesArtifactStore struct {
 	fileArtifactDb                  *file_artifacts_db.FileArtifactPersisted
 	maxRetriesToGetFileArtifactName int
 	generateNatureThemeName         func() string
+
+	// Cap on the total size of the files artifacts of the enclave, previous versions included
+	maxTotalBytes uint64
+
+	// If set, it holds the content of the files artifacts and the file cache only caches it, and the files artifacts
+	// index is uploaded to it every time it's persisted
+	maybeRemoteFileStore RemoteFileStore
 }
 
-func newFilesArtifactStoreFromDb(absoluteDirpath string, dirpathRelativeToDataDirRoot string, db *file_artifacts_db.FileArtifactPersisted) *FilesArtifactStore {
+func newFilesArtifactStoreFromDb(absoluteDirpath string, dirpathRelativeToDataDirRoot string, db *file_artifacts_db.FileArtifactPersisted, maybeRemoteFileStore RemoteFileStore, maxTotalBytes uint64) *FilesArtifactStore {
+	fileCache := newFileCache(absoluteDirpath, dirpathRelativeToDataDirRoot)
+	if maybeRemoteFileStore != nil {
+		fileCache = newBoundedFileCache(absoluteDirpath, dirpathRelativeToDataDirRoot, remoteFilesArtifactsCacheMaxBytes, unboundedFileCacheBytes)
+	}
 	return &FilesArtifactStore{
-		fileCache:                       newFileCache(absoluteDirpath, dirpathRelativeToDataDirRoot),
+		fileCache:                       fileCache,
 		mutex:                           &sync.RWMutex{},
 		maxRetriesToGetFileArtifactName: maxFileArtifactNameRetriesDefault,
 		generateNatureThemeName:         name_generator.GenerateNatureThemeNameForFileArtifacts,
 		fileArtifactDb:                  db,
+		maxTotalBytes:                   maxTotalBytes,
+		maybeRemoteFileStore:            maybeRemoteFileStore,
+	}
+}
+
+// restoreLostFilesArtifactsIndex restores the files artifacts index from the remote store if the enclave database has
+// none, which is the case when the enclave data volume was lost. The content itself is downloaded when first accessed
+func restoreLostFilesArtifactsIndex(db *file_artifacts_db.FileArtifactPersisted, maybeRemoteFileStore RemoteFileStore) error {
+	if maybeRemoteFileStore == nil || !db.IsEmpty() {
+		return nil
+	}
+	serializedIndex := &bytes.Buffer{}
+	found, err := maybeRemoteFileStore.DownloadIndex(serializedIndex)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading the files artifacts index from the remote store")
+	}
+	if !found {
+		return nil
+	}
+	if err := db.Restore(serializedIndex.Bytes()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring the files artifacts index downloaded from the remote store")
 	}
+	logrus.Infof("Restored the index of %v files artifacts from the remote store", len(db.GetArtifactUuidMap()))
+	return nil
 }
 
 // method needed for testing
@@ -57,37 +112,78 @@ func NewFilesArtifactStoreForTesting(
 		fileArtifactDb:                  fileArtifactDb,
 		maxRetriesToGetFileArtifactName: maxRetry,
 		generateNatureThemeName:         nameGeneratorMock,
+		maxTotalBytes:                   unlimitedFilesArtifactsBytes,
+		maybeRemoteFileStore:            nil,
 	}
 }
 
-// StoreFile Saves file to disk.
+// StoreFile Saves file to disk. Storing under the name of an existing files artifact stores the content as its latest
+// version, keeping its UUID, as UpdateFile does
 func (store FilesArtifactStore) StoreFile(reader io.Reader, contentMd5 []byte, artifactName string) (FilesArtifactUUID, error) {
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
 
+	if existingFilesArtifactUuid, found := store.fileArtifactDb.GetArtifactUuid(artifactName); found {
+		if err := store.updateFileUnlocked(FilesArtifactUUID(existingFilesArtifactUuid), reader, contentMd5); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred storing a new version of files artifact '%v'", artifactName)
+		}
+		return FilesArtifactUUID(existingFilesArtifactUuid), nil
+	}
+
 	filesArtifactUuid, err := NewFilesArtifactUUID()
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred creating new files artifact UUID")
 	}
 
-	if _, found := store.fileArtifactDb.GetArtifactUuid(artifactName); found {
-		return "", stacktrace.NewError("Files artifact name '%v' has already been used", artifactName)
+	contentWithinQuota, err := store.limitToQuotaUnlocked(reader, artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred checking the files artifacts quota for '%v'", artifactName)
 	}
-
-	err = store.storeFilesToArtifactUuidUnlocked(artifactName, filesArtifactUuid, reader, contentMd5)
+	err = store.storeFilesToArtifactUuidUnlocked(artifactName, filesArtifactUuid, contentWithinQuota, contentMd5)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred creating new files artifact UUID")
 	}
-	if err := store.fileArtifactDb.Persist(); err != nil {
+	store.fileArtifactDb.SetArtifactVersions(string(filesArtifactUuid), []file_artifacts_db.FilesArtifactVersion{
+		{
+			Version:      firstArtifactVersion,
+			ArtifactUuid: string(filesArtifactUuid),
+			CreatedAt:    time.Now(),
+		},
+	})
+	if err := store.persistUnlocked(); err != nil {
 		return "", stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
 	}
 	return filesArtifactUuid, nil
 }
 
+// UpdateFile stores the new content as the latest version of the files artifact, keeping its UUID, and keeps the
+// previous content as an older version retrievable with '<identifier>@<version>'
 func (store FilesArtifactStore) UpdateFile(filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte) error {
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
+	return store.updateFileUnlocked(filesArtifactUuid, reader, contentMd5)
+}
+
+// updateFileUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) updateFileUnlocked(filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte) error {
+	if store.maxTotalBytes != unlimitedFilesArtifactsBytes {
+		// The new content is staged before the current content is touched, so that the files artifact is left intact
+		// if the new content doesn't fit in the quota
+		stagedContent, err := store.stageContentWithinQuotaUnlocked(reader, string(filesArtifactUuid))
+		if err != nil {
+			return stacktrace.Propagate(err, "Error staging the new content of files artifact '%s'", filesArtifactUuid)
+		}
+		defer func() {
+			stagedContent.Close()
+			os.Remove(stagedContent.Name())
+		}()
+		reader = stagedContent
+	}
 
+	versions, err := store.archiveLatestVersionUnlocked(filesArtifactUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error keeping the current content of files artifact '%s' as a previous version", filesArtifactUuid)
+	}
 	artifactName, err := store.removeFileUnlocked(filesArtifactUuid)
 	if err != nil {
 		return stacktrace.Propagate(err, "Error removing the files artifact '%s' prior to persisting its new content", filesArtifactUuid)
@@ -95,10 +191,14 @@ func (store FilesArtifactStore) UpdateFile(filesArtifactUuid FilesArtifactUUID,
 	if err = store.storeFilesToArtifactUuidUnlocked(artifactName, filesArtifactUuid, reader, contentMd5); err != nil {
 		return stacktrace.Propagate(err, "Error persisting updated content for files artifact '%s'", filesArtifactUuid)
 	}
+	store.fileArtifactDb.SetArtifactVersions(string(filesArtifactUuid), versions)
+	if err := store.persistUnlocked(); err != nil {
+		return stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
+	}
 	return nil
 }
 
-// GetFile Get the file by uuid, then by shortened uuid and finally by name
+// GetFile Get the file by uuid, then by shortened uuid, then by name and finally by '<identifier>@<version>'
 func (store FilesArtifactStore) GetFile(artifactIdentifier string) (FilesArtifactUUID, *EnclaveDataDirFile, []byte, bool, error) {
 	store.mutex.RLock()
 	defer store.mutex.RUnlock()
@@ -123,20 +223,62 @@ func (store FilesArtifactStore) GetFile(artifactIdentifier string) (FilesArtifac
 		return store.getFileUnlocked(FilesArtifactUUID(filesArtifactUuidGet))
 	}
 
+	if separatorIdx := strings.LastIndex(artifactIdentifier, artifactVersionSeparator); separatorIdx > 0 {
+		versionUuid, found, err := store.getVersionUuidUnlocked(artifactIdentifier[:separatorIdx], artifactIdentifier[separatorIdx+1:])
+		if err != nil {
+			return "", nil, nil, false, stacktrace.Propagate(err, "An error occurred getting the files artifact version '%v'", artifactIdentifier)
+		}
+		if found {
+			return store.getFileUnlocked(versionUuid)
+		}
+	}
+
 	if err := store.fileArtifactDb.Persist(); err != nil {
 		return "", nil, nil, false, stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
 	}
 	return "", nil, nil, false, nil
 }
 
-// RemoveFile Remove the file by uuid, then by shortened uuid and then by name
+// GetFileHistory returns the name of the files artifact and its versions, oldest first
+func (store FilesArtifactStore) GetFileHistory(artifactIdentifier string) (string, []FilesArtifactVersion, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	latestUuid, found, err := store.getLatestVersionUuidUnlocked(artifactIdentifier)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred resolving files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return "", nil, stacktrace.NewError("Couldn't find files artifact for identifier '%v', tried looking up UUID, shortened UUID and by name", artifactIdentifier)
+	}
+
+	var artifactName string
+	for name, artifactUuid := range store.fileArtifactDb.GetArtifactUuidMap() {
+		if artifactUuid == string(latestUuid) {
+			artifactName = name
+		}
+	}
+	var versions []FilesArtifactVersion
+	for _, persistedVersion := range store.getPersistedVersionsUnlocked(latestUuid) {
+		versions = append(versions, FilesArtifactVersion{
+			version:   persistedVersion.Version,
+			uuid:      FilesArtifactUUID(persistedVersion.ArtifactUuid),
+			createdAt: persistedVersion.CreatedAt,
+			isLatest:  persistedVersion.ArtifactUuid == string(latestUuid),
+		})
+	}
+	return artifactName, versions, nil
+}
+
+// RemoveFile Remove the file by uuid, then by shortened uuid and then by name. Removing the latest version of a files
+// artifact removes all its previous versions too
 func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedErr error) {
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
 	var filesArtifactUuid FilesArtifactUUID
 
 	filesArtifactUuid = FilesArtifactUUID(artifactIdentifier)
-	_, err := store.removeFileUnlocked(filesArtifactUuid)
+	err := store.removeFileAndVersionsUnlocked(filesArtifactUuid)
 	if err == nil {
 		return nil
 	}
@@ -147,7 +289,7 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 			return stacktrace.NewError("Tried using the shortened uuid '%v' to remove file but found multiple matches '%v'. Use a complete uuid to be specific about what to delete.", artifactIdentifier, filesArtifactUuids)
 		}
 		filesArtifactUuid = FilesArtifactUUID(filesArtifactUuids[0])
-		_, err = store.removeFileUnlocked(filesArtifactUuid)
+		err = store.removeFileAndVersionsUnlocked(filesArtifactUuid)
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing files artifact with UUID '%s'", filesArtifactUuid)
 		}
@@ -156,7 +298,7 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 
 	filesArtifactUuidGet, found := store.fileArtifactDb.GetArtifactUuid(artifactIdentifier)
 	if found {
-		_, err = store.removeFileUnlocked(FilesArtifactUUID(filesArtifactUuidGet))
+		err = store.removeFileAndVersionsUnlocked(FilesArtifactUUID(filesArtifactUuidGet))
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing files artifact with UUID '%s'", filesArtifactUuid)
 		}
@@ -169,6 +311,14 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 	return stacktrace.NewError("Couldn't find file for identifier '%v', tried looking up UUID, shortened UUID and by name", artifactIdentifier)
 }
 
+// GetFileContentSha256 returns the hex-encoded SHA-256 of the content of the files artifact, and false if none was
+// recorded (artifacts stored before checksums were introduced)
+func (store FilesArtifactStore) GetFileContentSha256(filesArtifactUuid FilesArtifactUUID) (string, bool) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	return store.fileArtifactDb.GetContentSha256(string(filesArtifactUuid))
+}
+
 func (store FilesArtifactStore) ListFiles() map[string]bool {
 	store.mutex.RLock()
 	defer store.mutex.RUnlock()
@@ -225,6 +375,64 @@ func (store FilesArtifactStore) GenerateUniqueNameForFileArtifact() string {
 	return maybeUniqueNameWithRandomNumber
 }
 
+// limitToQuotaUnlocked returns a reader failing with a FilesArtifactsQuotaExceededError if the content goes over what's
+// left of the quota. Previous versions dropped by an update aren't deducted, so an update can be refused while it would
+// have fit once they're gone
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) limitToQuotaUnlocked(reader io.Reader, artifactIdentifier string) (io.Reader, error) {
+	if store.maxTotalBytes == unlimitedFilesArtifactsBytes {
+		return reader, nil
+	}
+	usedBytes, err := store.getUsedBytesUnlocked()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred computing the size of the files artifacts of the enclave")
+	}
+	return newQuotaLimitedReader(reader, artifactIdentifier, store.maxTotalBytes, usedBytes), nil
+}
+
+// getUsedBytesUnlocked returns the total size of the files artifacts of the enclave. With a remote store, the file cache
+// only holds part of them, so the sizes recorded when they were stored are summed up instead
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getUsedBytesUnlocked() (uint64, error) {
+	if store.maybeRemoteFileStore == nil {
+		return store.fileCache.GetTotalSize()
+	}
+	var usedBytes uint64
+	for _, contentSize := range store.fileArtifactDb.GetContentSizeMap() {
+		usedBytes += contentSize
+	}
+	return usedBytes, nil
+}
+
+// stageContentWithinQuotaUnlocked writes the content to a temporary file, failing if it goes over what's left of the
+// quota. The caller is responsible for closing and removing the returned file, which is rewound to its beginning
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) stageContentWithinQuotaUnlocked(reader io.Reader, artifactIdentifier string) (*os.File, error) {
+	contentWithinQuota, err := store.limitToQuotaUnlocked(reader, artifactIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking the files artifacts quota for '%v'", artifactIdentifier)
+	}
+	stagedContent, err := os.CreateTemp(stagedContentTmpDir, stagedContentTmpFilePattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the temporary file to stage the content of '%v'", artifactIdentifier)
+	}
+	shouldRemoveStagedContent := true
+	defer func() {
+		if shouldRemoveStagedContent {
+			stagedContent.Close()
+			os.Remove(stagedContent.Name())
+		}
+	}()
+	if _, err = io.Copy(stagedContent, contentWithinQuota); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred staging the content of '%v'", artifactIdentifier)
+	}
+	if _, err = stagedContent.Seek(0, io.SeekStart); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred rewinding the staged content of '%v'", artifactIdentifier)
+	}
+	shouldRemoveStagedContent = false
+	return stagedContent, nil
+}
+
 // storeFilesToArtifactUuidUnlocked this is an non thread method to be used from thread safe contexts
 func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(filesArtifactName string, filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte) error {
 
@@ -232,17 +440,115 @@ func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(filesArtifactNa
 		[]string{string(filesArtifactUuid), artifactExtension},
 		".",
 	)
-	_, err := store.fileCache.AddFile(filename, reader)
+	// The checksum is computed while the content is written to the cache, so the content is only read once
+	contentHasher := sha256.New()
+	file, err := store.fileCache.AddFile(filename, io.TeeReader(reader, contentHasher))
 	if err != nil {
 		return stacktrace.Propagate(err, "Could not store file '%s' to the file cache", filename)
 	}
+	contentSha256 := hex.EncodeToString(contentHasher.Sum(nil))
+	fileInfo, err := os.Stat(file.GetAbsoluteFilepath())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the size of file '%v'", file.GetAbsoluteFilepath())
+	}
+	if store.maybeRemoteFileStore != nil {
+		if err := store.uploadContentUnlocked(contentSha256, file); err != nil {
+			if removeErr := store.fileCache.RemoveFile(filename); removeErr != nil {
+				logrus.Errorf("An error occurred removing file '%v' whose content couldn't be uploaded to the remote store:\n%v", filename, removeErr)
+			}
+			return stacktrace.Propagate(err, "An error occurred uploading the content of files artifact '%v' to the remote store", filesArtifactName)
+		}
+	}
 	shortenedUuidSlice, _ := store.fileArtifactDb.GetFullUuid(uuid_generator.ShortenedUUIDString(string(filesArtifactUuid)))
 	store.fileArtifactDb.SetFullUuid(uuid_generator.ShortenedUUIDString(string(filesArtifactUuid)), append(shortenedUuidSlice, string(filesArtifactUuid)))
 	store.fileArtifactDb.SetContentMd5(string(filesArtifactUuid), contentMd5)
+	store.fileArtifactDb.SetContentSha256(string(filesArtifactUuid), contentSha256)
+	store.fileArtifactDb.SetContentSize(string(filesArtifactUuid), uint64(fileInfo.Size()))
 	store.fileArtifactDb.SetArtifactUuid(filesArtifactName, string(filesArtifactUuid))
 	return nil
 }
 
+// uploadContentUnlocked uploads the content of the file to the remote store, unless content with the same checksum
+// was already uploaded by this or another enclave
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) uploadContentUnlocked(contentSha256 string, file *EnclaveDataDirFile) error {
+	isAlreadyUploaded, err := store.maybeRemoteFileStore.HasContent(contentSha256)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking whether content '%v' is already in the remote store", contentSha256)
+	}
+	if isAlreadyUploaded {
+		return nil
+	}
+	content, err := os.Open(file.GetAbsoluteFilepath())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening file '%v'", file.GetAbsoluteFilepath())
+	}
+	defer content.Close()
+	if err := store.maybeRemoteFileStore.UploadContent(contentSha256, content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred uploading content '%v' to the remote store", contentSha256)
+	}
+	return nil
+}
+
+// downloadContentUnlocked adds the content of the files artifact to the file cache from the remote store, after it was
+// evicted or the enclave data volume was lost. The content is checked against its recorded checksum before being added
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) downloadContentUnlocked(filesArtifactUuid FilesArtifactUUID, filename string) error {
+	contentSha256, found := store.fileArtifactDb.GetContentSha256(string(filesArtifactUuid))
+	if !found {
+		return stacktrace.NewError("No checksum was recorded for files artifact '%v', so its content can't be found in the remote store", filesArtifactUuid)
+	}
+	stagingFile, err := store.fileCache.CreateStagingFile()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the file to download the content of files artifact '%v' to", filesArtifactUuid)
+	}
+	defer func() {
+		// No-op if the staging file was added to the cache
+		_ = os.Remove(stagingFile.Name())
+	}()
+
+	contentHasher := sha256.New()
+	downloadErr := store.maybeRemoteFileStore.DownloadContent(contentSha256, io.MultiWriter(stagingFile, contentHasher))
+	if err := stagingFile.Close(); err != nil && downloadErr == nil {
+		downloadErr = stacktrace.Propagate(err, "An error occurred closing staging file '%v'", stagingFile.Name())
+	}
+	if downloadErr != nil {
+		return stacktrace.Propagate(downloadErr, "An error occurred downloading content '%v' from the remote store", contentSha256)
+	}
+	if downloadedContentSha256 := hex.EncodeToString(contentHasher.Sum(nil)); downloadedContentSha256 != contentSha256 {
+		return stacktrace.NewError("The content downloaded for files artifact '%v' has checksum '%v' instead of '%v'", filesArtifactUuid, downloadedContentSha256, contentSha256)
+	}
+	if _, err := store.fileCache.AddStagedFile(filename, stagingFile.Name()); err != nil {
+		// Readers share the lock, so another one may have downloaded the same content in the meantime
+		if store.fileCache.HasFile(filename) {
+			return nil
+		}
+		return stacktrace.Propagate(err, "An error occurred adding the downloaded content of files artifact '%v' to the file cache", filesArtifactUuid)
+	}
+	logrus.Debugf("Downloaded the content of files artifact '%v' from the remote store", filesArtifactUuid)
+	return nil
+}
+
+// persistUnlocked persists the files artifacts index to the enclave database, and uploads it to the remote store if
+// there's one, so it can be restored if the enclave data volume is lost
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) persistUnlocked() error {
+	if err := store.fileArtifactDb.Persist(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the files artifacts index")
+	}
+	if store.maybeRemoteFileStore == nil {
+		return nil
+	}
+	serializedIndex, err := store.fileArtifactDb.Serialize()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the files artifacts index")
+	}
+	if err := store.maybeRemoteFileStore.UploadIndex(bytes.NewReader(serializedIndex)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred uploading the files artifacts index to the remote store")
+	}
+	return nil
+}
+
 // getFileUnlocked this is not thread safe, must be used from a thread safe context
 func (store FilesArtifactStore) getFileUnlocked(filesArtifactUuid FilesArtifactUUID) (FilesArtifactUUID, *EnclaveDataDirFile, []byte, bool, error) {
 	fileContentHash, found := store.fileArtifactDb.GetContentMd5(string(filesArtifactUuid))
@@ -253,6 +559,11 @@ func (store FilesArtifactStore) getFileUnlocked(filesArtifactUuid FilesArtifactU
 		[]string{string(filesArtifactUuid), artifactExtension},
 		".",
 	)
+	if store.maybeRemoteFileStore != nil && !store.fileCache.HasFile(filename) {
+		if err := store.downloadContentUnlocked(filesArtifactUuid, filename); err != nil {
+			return "", nil, nil, false, stacktrace.Propagate(err, "Could not retrieve the content of files artifact '%s' from the remote store", filesArtifactUuid)
+		}
+	}
 	enclaveDataDirFile, err := store.fileCache.GetFile(filename)
 	if err != nil {
 		return "", nil, nil, false, stacktrace.Propagate(err, "Could not retrieve file with filename '%s' from the file cache", filename)
@@ -262,22 +573,25 @@ func (store FilesArtifactStore) getFileUnlocked(filesArtifactUuid FilesArtifactU
 
 // removeFileUnlocked this is not thread safe, must be used from a thread safe context
 func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifactUUID) (string, error) {
-	filename := strings.Join(
-		[]string{string(filesArtifactUuid), artifactExtension},
-		".",
-	)
-
 	var artifactName string
-	if err := store.fileCache.RemoveFile(filename); err != nil {
-		return "", stacktrace.Propagate(err, "There was an error in removing '%v' from the file store", filename)
+	if err := store.removeCachedContentUnlocked(filesArtifactUuid); err != nil {
+		return "", stacktrace.Propagate(err, "There was an error in removing the content of '%v' from the file store", filesArtifactUuid)
 	}
 	for name, artifactUuid := range store.fileArtifactDb.GetArtifactUuidMap() {
 		if artifactUuid == string(filesArtifactUuid) {
 			artifactName = name
 			store.fileArtifactDb.DeleteArtifactUuid(name)
 			store.fileArtifactDb.DeleteContentMd5(artifactUuid)
+			store.fileArtifactDb.DeleteContentSha256(artifactUuid)
+			store.fileArtifactDb.DeleteContentSize(artifactUuid)
 		}
 	}
+	store.removeShortenedUuidUnlocked(filesArtifactUuid)
+	return artifactName, nil
+}
+
+// removeShortenedUuidUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) removeShortenedUuidUnlocked(filesArtifactUuid FilesArtifactUUID) {
 	shortenedUuid := uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))
 	artifactUuids, found := store.fileArtifactDb.GetFullUuid(shortenedUuid)
 	if found {
@@ -290,11 +604,202 @@ func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifa
 		// if there's only one matching uuid we delete the shortened uuid
 		if len(artifactUuids) == 1 {
 			store.fileArtifactDb.DeleteFullUuid(shortenedUuid)
-			return artifactName, nil
+			return
 		}
 		// otherwise we just delete the target artifact uuid
 		store.fileArtifactDb.DeleteFullUuidIndex(shortenedUuid, targetArtifactIdx)
 	}
+}
 
-	return artifactName, nil
+// removeFileAndVersionsUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) removeFileAndVersionsUnlocked(filesArtifactUuid FilesArtifactUUID) error {
+	if _, err := store.removeFileUnlocked(filesArtifactUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing files artifact with UUID '%s'", filesArtifactUuid)
+	}
+	for latestUuid, versions := range store.fileArtifactDb.GetArtifactVersionsMap() {
+		if latestUuid == string(filesArtifactUuid) {
+			for _, version := range versions {
+				if version.ArtifactUuid == latestUuid {
+					continue
+				}
+				if err := store.removeArchivedVersionUnlocked(FilesArtifactUUID(version.ArtifactUuid)); err != nil {
+					return stacktrace.Propagate(err, "An error occurred removing version '%v' of files artifact with UUID '%s'", version.Version, filesArtifactUuid)
+				}
+			}
+			store.fileArtifactDb.DeleteArtifactVersions(latestUuid)
+			continue
+		}
+		// a previous version was removed on its own, it's dropped from the history of its artifact
+		var remainingVersions []file_artifacts_db.FilesArtifactVersion
+		for _, version := range versions {
+			if version.ArtifactUuid != string(filesArtifactUuid) {
+				remainingVersions = append(remainingVersions, version)
+			}
+		}
+		store.fileArtifactDb.SetArtifactVersions(latestUuid, remainingVersions)
+	}
+	if err := store.persistUnlocked(); err != nil {
+		return stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
+	}
+	return nil
+}
+
+// removeArchivedVersionUnlocked removes a previous version of a files artifact, which unlike the latest version isn't
+// referenced by any artifact name
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) removeArchivedVersionUnlocked(versionUuid FilesArtifactUUID) error {
+	if err := store.removeCachedContentUnlocked(versionUuid); err != nil {
+		return stacktrace.Propagate(err, "There was an error in removing the content of '%v' from the file store", versionUuid)
+	}
+	store.fileArtifactDb.DeleteContentMd5(string(versionUuid))
+	store.fileArtifactDb.DeleteContentSha256(string(versionUuid))
+	store.fileArtifactDb.DeleteContentSize(string(versionUuid))
+	store.removeShortenedUuidUnlocked(versionUuid)
+	return nil
+}
+
+// removeCachedContentUnlocked removes the content of the files artifact from the file cache. With a remote store, the
+// content may have been evicted from the cache already, and it's left in the remote store as other files artifacts may
+// share it
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) removeCachedContentUnlocked(filesArtifactUuid FilesArtifactUUID) error {
+	filename := strings.Join(
+		[]string{string(filesArtifactUuid), artifactExtension},
+		".",
+	)
+	if store.maybeRemoteFileStore != nil {
+		if _, found := store.fileArtifactDb.GetContentMd5(string(filesArtifactUuid)); !found {
+			return stacktrace.NewError("No files artifact with UUID '%v' exists in the store", filesArtifactUuid)
+		}
+		if !store.fileCache.HasFile(filename) {
+			return nil
+		}
+	}
+	if err := store.fileCache.RemoveFile(filename); err != nil {
+		return stacktrace.Propagate(err, "There was an error in removing '%v' from the file cache", filename)
+	}
+	return nil
+}
+
+// archiveLatestVersionUnlocked copies the current content of the files artifact under a new UUID, and returns the
+// versions of the artifact once the new content is stored as the latest version, dropping the oldest ones beyond
+// maxFilesArtifactVersionsKept
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) archiveLatestVersionUnlocked(filesArtifactUuid FilesArtifactUUID) ([]file_artifacts_db.FilesArtifactVersion, error) {
+	_, currentFile, currentContentMd5, _, err := store.getFileUnlocked(filesArtifactUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the current content of files artifact '%s'", filesArtifactUuid)
+	}
+	currentContentSha256, _ := store.fileArtifactDb.GetContentSha256(string(filesArtifactUuid))
+
+	archivedUuid, err := NewFilesArtifactUUID()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the UUID of the previous version")
+	}
+	currentContent, err := os.Open(currentFile.GetAbsoluteFilepath())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening file '%v'", currentFile.GetAbsoluteFilepath())
+	}
+	defer currentContent.Close()
+	archivedFilename := strings.Join(
+		[]string{string(archivedUuid), artifactExtension},
+		".",
+	)
+	if _, err = store.fileCache.AddFile(archivedFilename, currentContent); err != nil {
+		return nil, stacktrace.Propagate(err, "Could not store file '%s' to the file cache", archivedFilename)
+	}
+	shortenedUuidSlice, _ := store.fileArtifactDb.GetFullUuid(uuid_generator.ShortenedUUIDString(string(archivedUuid)))
+	store.fileArtifactDb.SetFullUuid(uuid_generator.ShortenedUUIDString(string(archivedUuid)), append(shortenedUuidSlice, string(archivedUuid)))
+	store.fileArtifactDb.SetContentMd5(string(archivedUuid), currentContentMd5)
+	if currentContentSize, found := store.fileArtifactDb.GetContentSize(string(filesArtifactUuid)); found {
+		store.fileArtifactDb.SetContentSize(string(archivedUuid), currentContentSize)
+	}
+	if currentContentSha256 != "" {
+		store.fileArtifactDb.SetContentSha256(string(archivedUuid), currentContentSha256)
+	}
+
+	versions := store.getPersistedVersionsUnlocked(filesArtifactUuid)
+	latestVersion := versions[len(versions)-1]
+	versions[len(versions)-1].ArtifactUuid = string(archivedUuid)
+	versions = append(versions, file_artifacts_db.FilesArtifactVersion{
+		Version:      latestVersion.Version + 1,
+		ArtifactUuid: string(filesArtifactUuid),
+		CreatedAt:    time.Now(),
+	})
+	for len(versions) > maxFilesArtifactVersionsKept {
+		if err := store.removeArchivedVersionUnlocked(FilesArtifactUUID(versions[0].ArtifactUuid)); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred dropping version '%v' of files artifact '%s'", versions[0].Version, filesArtifactUuid)
+		}
+		versions = versions[1:]
+	}
+	return versions, nil
+}
+
+// getPersistedVersionsUnlocked returns a copy of the versions of the files artifact, oldest first. Artifacts stored
+// before versions were tracked only have a first version whose creation time is unknown.
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getPersistedVersionsUnlocked(latestUuid FilesArtifactUUID) []file_artifacts_db.FilesArtifactVersion {
+	persistedVersions, found := store.fileArtifactDb.GetArtifactVersions(string(latestUuid))
+	if !found || len(persistedVersions) == 0 {
+		return []file_artifacts_db.FilesArtifactVersion{
+			{
+				Version:      firstArtifactVersion,
+				ArtifactUuid: string(latestUuid),
+				CreatedAt:    time.Time{},
+			},
+		}
+	}
+	return append([]file_artifacts_db.FilesArtifactVersion{}, persistedVersions...)
+}
+
+// getLatestVersionUuidUnlocked resolves an identifier (UUID, shortened UUID or name) to the UUID of the latest version
+// of the files artifact, including when the identifier designates one of its previous versions
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getLatestVersionUuidUnlocked(artifactIdentifier string) (FilesArtifactUUID, bool, error) {
+	artifactUuid := artifactIdentifier
+	if filesArtifactUuids, found := store.fileArtifactDb.GetFullUuid(artifactIdentifier); found {
+		if len(filesArtifactUuids) > maxAllowedMatchesAgainstShortenedUuid {
+			return "", false, stacktrace.NewError("Tried using the shortened uuid '%v' to get file but found multiple matches '%v'. Use a complete uuid to be specific about what to get.", artifactIdentifier, filesArtifactUuids)
+		}
+		artifactUuid = filesArtifactUuids[0]
+	} else if filesArtifactUuid, found := store.fileArtifactDb.GetArtifactUuid(artifactIdentifier); found {
+		artifactUuid = filesArtifactUuid
+	}
+
+	for _, latestUuid := range store.fileArtifactDb.GetArtifactUuidMap() {
+		if latestUuid == artifactUuid {
+			return FilesArtifactUUID(latestUuid), true, nil
+		}
+	}
+	for latestUuid, versions := range store.fileArtifactDb.GetArtifactVersionsMap() {
+		for _, version := range versions {
+			if version.ArtifactUuid == artifactUuid {
+				return FilesArtifactUUID(latestUuid), true, nil
+			}
+		}
+	}
+	return "", false, nil
+}
+
+// getVersionUuidUnlocked returns the UUID under which the given version ('latest' or a version number) of the files
+// artifact is stored
+// this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getVersionUuidUnlocked(artifactIdentifier string, version string) (FilesArtifactUUID, bool, error) {
+	latestUuid, found, err := store.getLatestVersionUuidUnlocked(artifactIdentifier)
+	if err != nil || !found {
+		return "", found, err
+	}
+	if version == latestArtifactVersionTag {
+		return latestUuid, true, nil
+	}
+	versionNumber, err := strconv.ParseUint(version, 10, 32)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "Files artifact version '%v' is neither a version number nor '%v'", version, latestArtifactVersionTag)
+	}
+	for _, persistedVersion := range store.getPersistedVersionsUnlocked(latestUuid) {
+		if uint64(persistedVersion.Version) == versionNumber {
+			return FilesArtifactUUID(persistedVersion.ArtifactUuid), true, nil
+		}
+	}
+	return "", false, nil
 }
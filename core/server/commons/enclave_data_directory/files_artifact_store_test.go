@@ -6,9 +6,14 @@
 package enclave_data_directory
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/stretchr/testify/require"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -43,21 +48,40 @@ func TestFileStore_StoreFileSimpleCase(t *testing.T) {
 	require.Equal(t, []byte(testContent), file)
 }
 
-func TestFileStore_StoringToExistingUUIDFails(t *testing.T) {
+func TestFileStore_StoringToExistingNameAddsVersion(t *testing.T) {
 	fileStore, closer := getTestFileStore(t)
 	defer closer()
-	testContent := "Long Live Kurtosis!"
-	reader := strings.NewReader(testContent)
 	testArtifactName := "test-artifact-name"
-	fakeMd5 := []byte("blah")
-	filesArtifactUuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifactName)
+	filesArtifactUuid, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), []byte("md5-1"), testArtifactName)
 	require.Nil(t, err)
 	require.Equal(t, 32, len(filesArtifactUuid)) //UUID is 128 bits but in string it is hex represented chars so 32 chars
 
-	anotherTestContent := "This one should fail"
-	anotherReader := strings.NewReader(anotherTestContent)
-	_, err = fileStore.StoreFile(anotherReader, fakeMd5, testArtifactName)
-	require.NotNil(t, err)
+	secondUuid, err := fileStore.StoreFile(strings.NewReader("This one is the latest"), []byte("md5-2"), testArtifactName)
+	require.Nil(t, err)
+	require.Equal(t, filesArtifactUuid, secondUuid)
+	require.Len(t, fileStore.fileArtifactDb.GetArtifactUuidMap(), 1)
+
+	latestUuid, latestFile, latestMd5, found, err := fileStore.GetFile(testArtifactName)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, filesArtifactUuid, latestUuid)
+	require.Equal(t, []byte("md5-2"), latestMd5)
+	latestContent, err := os.ReadFile(latestFile.GetAbsoluteFilepath())
+	require.Nil(t, err)
+	require.Equal(t, "This one is the latest", string(latestContent))
+
+	_, firstFile, firstMd5, found, err := fileStore.GetFile(testArtifactName + "@1")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("md5-1"), firstMd5)
+	firstContent, err := os.ReadFile(firstFile.GetAbsoluteFilepath())
+	require.Nil(t, err)
+	require.Equal(t, "Long Live Kurtosis!", string(firstContent))
+
+	_, versions, err := fileStore.GetFileHistory(testArtifactName)
+	require.Nil(t, err)
+	require.Len(t, versions, 2)
+	require.True(t, versions[1].IsLatest())
 }
 
 func TestFileStore_GetFilepathByUUIDProperFilepath(t *testing.T) {
@@ -147,6 +171,153 @@ func TestFileStore_RemoveFileRemovesFileFromDisk(t *testing.T) {
 	require.True(t, os.IsNotExist(err))
 }
 
+func TestFileStore_StoreFileRecordsContentSha256(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	testContent := "Long Live Kurtosis!"
+	fakeMd5 := []byte("blah")
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), fakeMd5, "test-artifact")
+	require.Nil(t, err)
+
+	expectedSha256 := sha256.Sum256([]byte(testContent))
+	contentSha256, found := fileStore.GetFileContentSha256(uuid)
+	require.True(t, found)
+	require.Equal(t, hex.EncodeToString(expectedSha256[:]), contentSha256)
+
+	err = fileStore.RemoveFile(string(uuid))
+	require.Nil(t, err)
+	_, found = fileStore.GetFileContentSha256(uuid)
+	require.False(t, found)
+}
+
+func TestFileStore_UpdateFileKeepsPreviousVersions(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	testArtifactName := "test-artifact"
+	uuid, err := fileStore.StoreFile(strings.NewReader("first"), []byte("md5-1"), testArtifactName)
+	require.Nil(t, err)
+	require.Nil(t, fileStore.UpdateFile(uuid, strings.NewReader("second"), []byte("md5-2")))
+
+	latestUuid, latestFile, latestMd5, found, err := fileStore.GetFile(testArtifactName)
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uuid, latestUuid)
+	require.Equal(t, []byte("md5-2"), latestMd5)
+	latestContent, err := os.ReadFile(latestFile.GetAbsoluteFilepath())
+	require.Nil(t, err)
+	require.Equal(t, "second", string(latestContent))
+
+	firstUuid, firstFile, firstMd5, found, err := fileStore.GetFile(testArtifactName + "@1")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.NotEqual(t, uuid, firstUuid)
+	require.Equal(t, []byte("md5-1"), firstMd5)
+	firstContent, err := os.ReadFile(firstFile.GetAbsoluteFilepath())
+	require.Nil(t, err)
+	require.Equal(t, "first", string(firstContent))
+
+	latestUuid, _, _, found, err = fileStore.GetFile(string(uuid) + "@latest")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uuid, latestUuid)
+
+	_, _, _, found, err = fileStore.GetFile(testArtifactName + "@3")
+	require.Nil(t, err)
+	require.False(t, found)
+
+	artifactName, versions, err := fileStore.GetFileHistory(string(firstUuid))
+	require.Nil(t, err)
+	require.Equal(t, testArtifactName, artifactName)
+	require.Len(t, versions, 2)
+	require.Equal(t, uint32(1), versions[0].GetVersion())
+	require.Equal(t, firstUuid, versions[0].GetUuid())
+	require.False(t, versions[0].IsLatest())
+	require.Equal(t, uint32(2), versions[1].GetVersion())
+	require.Equal(t, uuid, versions[1].GetUuid())
+	require.True(t, versions[1].IsLatest())
+}
+
+func TestFileStore_UpdateFileDropsOldestVersions(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	testArtifactName := "test-artifact"
+	uuid, err := fileStore.StoreFile(strings.NewReader("version 1"), []byte{}, testArtifactName)
+	require.Nil(t, err)
+	for version := 2; version <= maxFilesArtifactVersionsKept+2; version++ {
+		require.Nil(t, fileStore.UpdateFile(uuid, strings.NewReader(fmt.Sprintf("version %d", version)), []byte{}))
+	}
+
+	_, versions, err := fileStore.GetFileHistory(testArtifactName)
+	require.Nil(t, err)
+	require.Len(t, versions, maxFilesArtifactVersionsKept)
+	require.Equal(t, uint32(3), versions[0].GetVersion())
+	require.Len(t, fileStore.fileArtifactDb.GetContentMd5Map(), maxFilesArtifactVersionsKept)
+
+	_, _, _, found, err := fileStore.GetFile(testArtifactName + "@2")
+	require.Nil(t, err)
+	require.False(t, found)
+}
+
+func TestFileStore_RemoveFileRemovesPreviousVersions(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	uuid, err := fileStore.StoreFile(strings.NewReader("first"), []byte{}, "test-artifact")
+	require.Nil(t, err)
+	require.Nil(t, fileStore.UpdateFile(uuid, strings.NewReader("second"), []byte{}))
+	require.Len(t, fileStore.fileArtifactDb.GetContentMd5Map(), 2)
+
+	require.Nil(t, fileStore.RemoveFile("test-artifact"))
+	require.Empty(t, fileStore.fileArtifactDb.GetContentMd5Map())
+	require.Empty(t, fileStore.fileArtifactDb.GetFullUuidMap())
+	require.Empty(t, fileStore.fileArtifactDb.GetArtifactVersionsMap())
+}
+
+func TestFileStore_StoreFileOverQuotaFails(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	fileStore.maxTotalBytes = 10
+	_, err := fileStore.StoreFile(strings.NewReader("123456"), []byte{}, "first-artifact")
+	require.Nil(t, err)
+
+	_, err = fileStore.StoreFile(strings.NewReader("123456"), []byte{}, "second-artifact")
+	require.NotNil(t, err)
+	quotaErr, isQuotaErr := GetFilesArtifactsQuotaExceededError(err)
+	require.True(t, isQuotaErr)
+	require.Equal(t, "second-artifact", quotaErr.GetArtifactIdentifier())
+	require.Equal(t, uint64(10), quotaErr.GetMaxTotalBytes())
+	require.Equal(t, uint64(6), quotaErr.GetUsedBytes())
+	require.False(t, fileStore.CheckIfArtifactNameExists("second-artifact"))
+
+	// nothing is left behind by the refused artifact, so what's left of the quota can still be used
+	_, err = fileStore.StoreFile(strings.NewReader("1234"), []byte{}, "third-artifact")
+	require.Nil(t, err)
+}
+
+func TestFileStore_UpdateFileOverQuotaKeepsCurrentContent(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	fileStore.maxTotalBytes = 10
+	uuid, err := fileStore.StoreFile(strings.NewReader("first"), []byte{}, "test-artifact")
+	require.Nil(t, err)
+
+	err = fileStore.UpdateFile(uuid, strings.NewReader("second one"), []byte{})
+	require.NotNil(t, err)
+	_, isQuotaErr := GetFilesArtifactsQuotaExceededError(err)
+	require.True(t, isQuotaErr)
+
+	_, latestFile, _, found, err := fileStore.GetFile("test-artifact")
+	require.Nil(t, err)
+	require.True(t, found)
+	latestContent, err := os.ReadFile(latestFile.GetAbsoluteFilepath())
+	require.Nil(t, err)
+	require.Equal(t, "first", string(latestContent))
+	_, versions, err := fileStore.GetFileHistory("test-artifact")
+	require.Nil(t, err)
+	require.Len(t, versions, 1)
+
+	require.Nil(t, fileStore.UpdateFile(uuid, strings.NewReader("new"), []byte{}))
+}
+
 func TestFileStore_RemoveFileFailsForNonExistentId(t *testing.T) {
 	fileStore, closer := getTestFileStore(t)
 	defer closer()
@@ -183,6 +354,134 @@ func TestFilesArtifactStore_GetFileNamesAndUuids(t *testing.T) {
 	require.Contains(t, fileNameAndUuids, FileNameAndUuid{uuid: anotherUUID, name: testArtifact2})
 }
 
+func TestFileStore_RemoteStoreHoldsContentByChecksum(t *testing.T) {
+	remoteStore := newInMemoryRemoteFileStore()
+	fileStore, closer := getTestFileStoreWithRemoteStore(t, remoteStore)
+	defer closer()
+	testContent := "Long Live Kurtosis!"
+	_, err := fileStore.StoreFile(strings.NewReader(testContent), []byte{}, "test-artifact")
+	require.Nil(t, err)
+
+	expectedSha256 := sha256.Sum256([]byte(testContent))
+	require.Equal(t, testContent, string(remoteStore.contents[hex.EncodeToString(expectedSha256[:])]))
+	require.NotEmpty(t, remoteStore.index)
+
+	// Another enclave storing the same content reuses what's already in the remote store
+	otherEnclaveRemoteStore := remoteStore.getStoreOfOtherEnclave()
+	otherFileStore, otherCloser := getTestFileStoreWithRemoteStore(t, otherEnclaveRemoteStore)
+	defer otherCloser()
+	_, err = otherFileStore.StoreFile(strings.NewReader(testContent), []byte{}, "test-artifact")
+	require.Nil(t, err)
+	require.Equal(t, 1, remoteStore.numContentUploads)
+	require.Equal(t, 0, otherEnclaveRemoteStore.numContentUploads)
+	require.Len(t, remoteStore.contents, 1)
+	require.NotEqual(t, remoteStore.index, otherEnclaveRemoteStore.index)
+}
+
+func TestFileStore_GetFileDownloadsEvictedContentFromRemoteStore(t *testing.T) {
+	remoteStore := newInMemoryRemoteFileStore()
+	fileStore, closer := getTestFileStoreWithRemoteStore(t, remoteStore)
+	defer closer()
+	testContent := "Long Live Kurtosis!"
+	_, err := fileStore.StoreFile(strings.NewReader(testContent), []byte{}, "test-artifact")
+	require.Nil(t, err)
+	_, storedFile, _, found, err := fileStore.GetFile("test-artifact")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Nil(t, os.Remove(storedFile.GetAbsoluteFilepath()))
+
+	_, retrievedFile, _, found, err := fileStore.GetFile("test-artifact")
+	require.Nil(t, err)
+	require.True(t, found)
+	retrievedContent, err := os.ReadFile(retrievedFile.GetAbsoluteFilepath())
+	require.Nil(t, err)
+	require.Equal(t, testContent, string(retrievedContent))
+
+	// Content that doesn't match its recorded checksum is refused
+	require.Nil(t, os.Remove(retrievedFile.GetAbsoluteFilepath()))
+	for contentSha256 := range remoteStore.contents {
+		remoteStore.contents[contentSha256] = []byte("corrupted")
+	}
+	_, _, _, _, err = fileStore.GetFile("test-artifact")
+	require.NotNil(t, err)
+}
+
+func TestFileStore_RestoresLostIndexFromRemoteStore(t *testing.T) {
+	remoteStore := newInMemoryRemoteFileStore()
+	fileStore, closer := getTestFileStoreWithRemoteStore(t, remoteStore)
+	defer closer()
+	testContent := "Long Live Kurtosis!"
+	filesArtifactUuid, err := fileStore.StoreFile(strings.NewReader(testContent), []byte{}, "test-artifact")
+	require.Nil(t, err)
+
+	// Simulate the loss of the enclave data volume, enclave database included
+	db, otherCloser, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer otherCloser()
+	fileArtifactDb, err := file_artifacts_db.GetFileArtifactsDbForTesting(db, map[string]string{})
+	require.Nil(t, err)
+	require.Nil(t, restoreLostFilesArtifactsIndex(fileArtifactDb, remoteStore))
+	absDirpath, err := os.MkdirTemp("", "")
+	require.Nil(t, err)
+	restoredFileStore := newFilesArtifactStoreFromDb(absDirpath, "", fileArtifactDb, remoteStore, unlimitedFilesArtifactsBytes)
+
+	retrievedUuid, retrievedFile, _, found, err := restoredFileStore.GetFile("test-artifact")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, filesArtifactUuid, retrievedUuid)
+	retrievedContent, err := os.ReadFile(retrievedFile.GetAbsoluteFilepath())
+	require.Nil(t, err)
+	require.Equal(t, testContent, string(retrievedContent))
+}
+
+func TestFileStore_RemoveFileKeepsContentInRemoteStore(t *testing.T) {
+	remoteStore := newInMemoryRemoteFileStore()
+	fileStore, closer := getTestFileStoreWithRemoteStore(t, remoteStore)
+	defer closer()
+	_, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), []byte{}, "test-artifact")
+	require.Nil(t, err)
+	_, storedFile, _, _, err := fileStore.GetFile("test-artifact")
+	require.Nil(t, err)
+	require.Nil(t, os.Remove(storedFile.GetAbsoluteFilepath()))
+
+	// Removing an artifact whose content was evicted from the enclave data volume works too
+	require.Nil(t, fileStore.RemoveFile("test-artifact"))
+	require.False(t, fileStore.CheckIfArtifactNameExists("test-artifact"))
+	require.Len(t, remoteStore.contents, 1)
+	require.NotNil(t, fileStore.RemoveFile("test-artifact"))
+}
+
+func TestFileStore_StoreFileFailsOnRemoteStoreError(t *testing.T) {
+	remoteStore := newInMemoryRemoteFileStore()
+	remoteStore.uploadErr = stacktrace.NewError("This is a test failure.")
+	fileStore, closer := getTestFileStoreWithRemoteStore(t, remoteStore)
+	defer closer()
+
+	_, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), []byte{}, "test-artifact")
+	require.NotNil(t, err)
+	require.False(t, fileStore.CheckIfArtifactNameExists("test-artifact"))
+	files, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Empty(t, files)
+}
+
+func TestFileStore_QuotaCountsContentEvictedToRemoteStore(t *testing.T) {
+	remoteStore := newInMemoryRemoteFileStore()
+	fileStore, closer := getTestFileStoreWithRemoteStore(t, remoteStore)
+	defer closer()
+	fileStore.maxTotalBytes = 10
+	_, err := fileStore.StoreFile(strings.NewReader("123456"), []byte{}, "first-artifact")
+	require.Nil(t, err)
+	_, storedFile, _, _, err := fileStore.GetFile("first-artifact")
+	require.Nil(t, err)
+	require.Nil(t, os.Remove(storedFile.GetAbsoluteFilepath()))
+
+	_, err = fileStore.StoreFile(strings.NewReader("654321"), []byte{}, "second-artifact")
+	quotaErr, isQuotaErr := GetFilesArtifactsQuotaExceededError(err)
+	require.True(t, isQuotaErr)
+	require.Equal(t, uint64(6), quotaErr.GetUsedBytes())
+}
+
 func getTestFileStore(t *testing.T) (*FilesArtifactStore, func()) {
 	absDirpath, err := os.MkdirTemp("", "")
 	require.Nil(t, err)
@@ -190,60 +489,88 @@ func getTestFileStore(t *testing.T) (*FilesArtifactStore, func()) {
 	require.Nil(t, err)
 	fileArtifactDb, err := file_artifacts_db.GetFileArtifactsDbForTesting(db, map[string]string{})
 	require.Nil(t, err)
-	fileStore := newFilesArtifactStoreFromDb(absDirpath, "", fileArtifactDb)
+	fileStore := newFilesArtifactStoreFromDb(absDirpath, "", fileArtifactDb, nil, unlimitedFilesArtifactsBytes)
 	require.Nil(t, err)
 	return fileStore, closer
 }
 
-func Test_generateUniqueNameForFileArtifact_MaxRetriesOver(t *testing.T) {
-	timesCalled := 0
-	// this method should be call 4 time (maxRetries + 1)
-	mockedGenerateNameMethod := func() string {
-		timesCalled = timesCalled + 1
-		if timesCalled == 4 {
-			return "last-noun"
-		}
-		return "adjective-noun"
-	}
-
-	artifactIdToUUIDMap := map[string]string{
-		"adjective-noun": "a",
-		"last-noun":      "b",
-		"last-noun-1":    "b",
-	}
-
+func getTestFileStoreWithRemoteStore(t *testing.T, remoteStore RemoteFileStore) (*FilesArtifactStore, func()) {
+	absDirpath, err := os.MkdirTemp("", "")
+	require.Nil(t, err)
 	db, closer, err := test_helpers.CreateEnclaveDbForTesting()
 	require.Nil(t, err)
-	defer closer()
-	fileArtifactDb, err := file_artifacts_db.GetFileArtifactsDbForTesting(db, artifactIdToUUIDMap)
+	fileArtifactDb, err := file_artifacts_db.GetFileArtifactsDbForTesting(db, map[string]string{})
 	require.Nil(t, err)
+	return newFilesArtifactStoreFromDb(absDirpath, "", fileArtifactDb, remoteStore, unlimitedFilesArtifactsBytes), closer
+}
 
-	fileArtifactStoreUnderTest := NewFilesArtifactStoreForTesting("/", "/", fileArtifactDb, 3, mockedGenerateNameMethod)
-	actual := fileArtifactStoreUnderTest.GenerateUniqueNameForFileArtifact()
-	require.Equal(t, "last-noun-2", actual)
+// inMemoryRemoteFileStore is the remote store of one enclave, sharing its content with the stores of other enclaves
+type inMemoryRemoteFileStore struct {
+	contents          map[string][]byte
+	numContentUploads int
+	index             []byte
+	uploadErr         error
 }
 
-func Test_generateUniqueNameForFileArtifact_Found(t *testing.T) {
-	timesCalled := 0
-	mockedGenerateNameMethod := func() string {
-		timesCalled = timesCalled + 1
-		if timesCalled == 4 {
-			return "unique-name"
-		}
-		return "non-unique-name"
+func newInMemoryRemoteFileStore() *inMemoryRemoteFileStore {
+	return &inMemoryRemoteFileStore{
+		contents:          map[string][]byte{},
+		numContentUploads: 0,
+		index:             nil,
+		uploadErr:         nil,
 	}
+}
 
-	artifactIdToUUIDMap := map[string]string{
-		"non-unique-name": "a",
+// getStoreOfOtherEnclave returns a store sharing the content of this one, but not its index
+func (store *inMemoryRemoteFileStore) getStoreOfOtherEnclave() *inMemoryRemoteFileStore {
+	return &inMemoryRemoteFileStore{
+		contents:          store.contents,
+		numContentUploads: 0,
+		index:             nil,
+		uploadErr:         nil,
 	}
+}
 
-	db, closer, err := test_helpers.CreateEnclaveDbForTesting()
-	require.Nil(t, err)
-	defer closer()
-	fileArtifactDb, err := file_artifacts_db.GetFileArtifactsDbForTesting(db, artifactIdToUUIDMap)
-	require.Nil(t, err)
+func (store *inMemoryRemoteFileStore) HasContent(contentSha256 string) (bool, error) {
+	_, found := store.contents[contentSha256]
+	return found, nil
+}
 
-	fileArtifactStoreUnderTest := NewFilesArtifactStoreForTesting("/", "/", fileArtifactDb, 3, mockedGenerateNameMethod)
-	actual := fileArtifactStoreUnderTest.GenerateUniqueNameForFileArtifact()
-	require.Equal(t, "unique-name", actual)
+func (store *inMemoryRemoteFileStore) UploadContent(contentSha256 string, reader io.Reader) error {
+	if store.uploadErr != nil {
+		return store.uploadErr
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	store.contents[contentSha256] = content
+	store.numContentUploads++
+	return nil
+}
+
+func (store *inMemoryRemoteFileStore) DownloadContent(contentSha256 string, writer io.Writer) error {
+	content, found := store.contents[contentSha256]
+	if !found {
+		return stacktrace.NewError("No content with SHA-256 '%v'", contentSha256)
+	}
+	_, err := writer.Write(content)
+	return err
+}
+
+func (store *inMemoryRemoteFileStore) UploadIndex(reader io.Reader) error {
+	index, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	store.index = index
+	return nil
+}
+
+func (store *inMemoryRemoteFileStore) DownloadIndex(writer io.Writer) (bool, error) {
+	if store.index == nil {
+		return false, nil
+	}
+	_, err := writer.Write(store.index)
+	return true, err
 }
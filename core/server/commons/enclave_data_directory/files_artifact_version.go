@@ -0,0 +1,30 @@
+package enclave_data_directory
+
+import "time"
+
+type FilesArtifactVersion struct {
+	version   uint32
+	uuid      FilesArtifactUUID
+	createdAt time.Time
+	isLatest  bool
+}
+
+func (artifactVersion FilesArtifactVersion) GetVersion() uint32 {
+	return artifactVersion.version
+}
+
+// GetUuid returns the UUID under which the content of this version is stored; the latest version shares the UUID of
+// the artifact itself
+func (artifactVersion FilesArtifactVersion) GetUuid() FilesArtifactUUID {
+	return artifactVersion.uuid
+}
+
+// GetCreatedAt returns when this version was stored, or the zero time if it's unknown (artifacts stored before
+// versions were tracked)
+func (artifactVersion FilesArtifactVersion) GetCreatedAt() time.Time {
+	return artifactVersion.createdAt
+}
+
+func (artifactVersion FilesArtifactVersion) IsLatest() bool {
+	return artifactVersion.isLatest
+}
@@ -0,0 +1,82 @@
+package enclave_data_directory
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// No cap on the total size of the files artifacts of the enclave
+	unlimitedFilesArtifactsBytes = 0
+)
+
+// FilesArtifactsQuotaExceededError is the root cause of the error returned when storing a files artifact would bring
+// the total size of the files artifacts of the enclave over its quota
+type FilesArtifactsQuotaExceededError struct {
+	artifactIdentifier string
+	maxTotalBytes      uint64
+	usedBytes          uint64
+}
+
+func (quotaErr *FilesArtifactsQuotaExceededError) Error() string {
+	return fmt.Sprintf(
+		"Files artifact '%v' doesn't fit in the files artifacts quota of the enclave: %v bytes out of %v are already used. "+
+			"Remove files artifacts that are no longer needed, or raise the quota in the artifacts store config of the cluster",
+		quotaErr.artifactIdentifier,
+		quotaErr.usedBytes,
+		quotaErr.maxTotalBytes,
+	)
+}
+
+func (quotaErr *FilesArtifactsQuotaExceededError) GetArtifactIdentifier() string {
+	return quotaErr.artifactIdentifier
+}
+
+func (quotaErr *FilesArtifactsQuotaExceededError) GetMaxTotalBytes() uint64 {
+	return quotaErr.maxTotalBytes
+}
+
+func (quotaErr *FilesArtifactsQuotaExceededError) GetUsedBytes() uint64 {
+	return quotaErr.usedBytes
+}
+
+// GetFilesArtifactsQuotaExceededError returns the quota error that caused err, if any
+func GetFilesArtifactsQuotaExceededError(err error) (*FilesArtifactsQuotaExceededError, bool) {
+	quotaErr, ok := stacktrace.RootCause(err).(*FilesArtifactsQuotaExceededError)
+	return quotaErr, ok
+}
+
+// quotaLimitedReader fails with a FilesArtifactsQuotaExceededError as soon as more than the bytes left in the quota
+// are read, so that the content going over the quota is never fully written
+type quotaLimitedReader struct {
+	reader         io.Reader
+	remainingBytes uint64
+	quotaErr       *FilesArtifactsQuotaExceededError
+}
+
+func newQuotaLimitedReader(reader io.Reader, artifactIdentifier string, maxTotalBytes uint64, usedBytes uint64) *quotaLimitedReader {
+	var remainingBytes uint64
+	if usedBytes < maxTotalBytes {
+		remainingBytes = maxTotalBytes - usedBytes
+	}
+	return &quotaLimitedReader{
+		reader:         reader,
+		remainingBytes: remainingBytes,
+		quotaErr: &FilesArtifactsQuotaExceededError{
+			artifactIdentifier: artifactIdentifier,
+			maxTotalBytes:      maxTotalBytes,
+			usedBytes:          usedBytes,
+		},
+	}
+}
+
+func (limitedReader *quotaLimitedReader) Read(p []byte) (int, error) {
+	bytesRead, err := limitedReader.reader.Read(p)
+	if uint64(bytesRead) > limitedReader.remainingBytes {
+		return 0, limitedReader.quotaErr
+	}
+	limitedReader.remainingBytes -= uint64(bytesRead)
+	return bytesRead, err
+}
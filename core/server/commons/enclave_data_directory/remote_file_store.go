@@ -0,0 +1,47 @@
+package enclave_data_directory
+
+import (
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// RemoteFileStore is an object store living outside the enclave (e.g. an S3 or GCS bucket) holding the content of the
+// files artifacts, which the enclave data volume only caches. Content is keyed by its SHA-256 and shared by all the
+// enclaves using the store, so content stored by several enclaves is only uploaded once. It's never removed by the
+// enclaves as others may still use it; a lifecycle rule on the bucket is how it gets cleaned up
+type RemoteFileStore interface {
+	// HasContent tells whether content with the given hex-encoded SHA-256 is already stored
+	HasContent(contentSha256 string) (bool, error)
+
+	// UploadContent stores the content of the reader under its hex-encoded SHA-256
+	UploadContent(contentSha256 string, reader io.Reader) error
+
+	// DownloadContent writes the content with the given hex-encoded SHA-256 to the writer
+	DownloadContent(contentSha256 string, writer io.Writer) error
+
+	// UploadIndex stores the index of the files artifacts of the enclave (names, UUIDs, versions and checksums),
+	// overwriting the previous one
+	UploadIndex(reader io.Reader) error
+
+	// DownloadIndex writes the index of the files artifacts of the enclave to the writer, and returns false if none was
+	// uploaded yet
+	DownloadIndex(writer io.Writer) (bool, error)
+}
+
+// GetRemoteFileStore returns the remote store matching the artifacts store config, or nil if files artifacts should only
+// be kept on the enclave data volume
+func GetRemoteFileStore(config artifacts_store.ArtifactsStoreConfig, enclaveUuid string) (RemoteFileStore, error) {
+	if !config.IsRemote() {
+		return nil, nil
+	}
+	if err := config.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the artifacts store config")
+	}
+	remoteFileStore, err := newS3RemoteFileStore(config, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the '%v' remote file store for bucket '%v'", config.Type, config.Bucket)
+	}
+	return remoteFileStore, nil
+}
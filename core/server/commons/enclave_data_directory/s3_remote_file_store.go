@@ -0,0 +1,153 @@
+package enclave_data_directory
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// The AWS SDK refuses to build a client without a region, even for S3-compatible stores that don't use one
+	defaultS3Region = "us-east-1"
+
+	noSessionToken = ""
+
+	// Name of the object holding the files artifacts index of an enclave, under the objects of the enclave
+	filesArtifactsIndexObjectName = "files-artifacts-index.json"
+)
+
+// s3RemoteFileStore stores files in an S3 bucket, or in any store exposing an S3-compatible API (GCS, MinIO, ...)
+type s3RemoteFileStore struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+
+	bucket string
+
+	// Content is shared by all the enclaves, only the files artifacts index is namespaced by enclave
+	enclaveUuid string
+
+	config artifacts_store.ArtifactsStoreConfig
+}
+
+func newS3RemoteFileStore(config artifacts_store.ArtifactsStoreConfig, enclaveUuid string) (*s3RemoteFileStore, error) {
+	region := config.GetRegion()
+	if region == "" {
+		region = defaultS3Region
+	}
+	awsConfig := aws.NewConfig().WithRegion(region).WithS3ForcePathStyle(config.UsePathStyle)
+	if endpoint := config.GetEndpoint(); endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(endpoint)
+	}
+	// Without static credentials, the SDK falls back to the default chain (env vars, shared config, instance role, ...)
+	if config.AccessKeyID != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, noSessionToken))
+	}
+
+	awsSession, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the session to the '%v' artifacts store", config.Type)
+	}
+
+	return &s3RemoteFileStore{
+		client:      s3.New(awsSession),
+		uploader:    s3manager.NewUploader(awsSession),
+		bucket:      config.Bucket,
+		enclaveUuid: enclaveUuid,
+		config:      config,
+	}, nil
+}
+
+func (store *s3RemoteFileStore) HasContent(contentSha256 string) (bool, error) {
+	objectKey := store.config.GetContentObjectKey(contentSha256)
+	headObjectInput := &s3.HeadObjectInput{ //nolint:exhaustruct
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(objectKey),
+	}
+	if _, err := store.client.HeadObject(headObjectInput); err != nil {
+		if isObjectNotFoundErr(err) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "An error occurred checking whether object '%v' exists in bucket '%v'", objectKey, store.bucket)
+	}
+	return true, nil
+}
+
+func (store *s3RemoteFileStore) UploadContent(contentSha256 string, reader io.Reader) error {
+	return store.upload(store.config.GetContentObjectKey(contentSha256), reader)
+}
+
+func (store *s3RemoteFileStore) DownloadContent(contentSha256 string, writer io.Writer) error {
+	objectKey := store.config.GetContentObjectKey(contentSha256)
+	found, err := store.download(objectKey, writer)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading content with SHA-256 '%v'", contentSha256)
+	}
+	if !found {
+		return stacktrace.NewError("No object '%v' exists in bucket '%v' for content with SHA-256 '%v'", objectKey, store.bucket, contentSha256)
+	}
+	return nil
+}
+
+func (store *s3RemoteFileStore) UploadIndex(reader io.Reader) error {
+	return store.upload(store.config.GetEnclaveObjectKey(store.enclaveUuid, filesArtifactsIndexObjectName), reader)
+}
+
+func (store *s3RemoteFileStore) DownloadIndex(writer io.Writer) (bool, error) {
+	found, err := store.download(store.config.GetEnclaveObjectKey(store.enclaveUuid, filesArtifactsIndexObjectName), writer)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred downloading the files artifacts index of enclave '%v'", store.enclaveUuid)
+	}
+	return found, nil
+}
+
+func (store *s3RemoteFileStore) upload(objectKey string, reader io.Reader) error {
+	uploadInput := &s3manager.UploadInput{ //nolint:exhaustruct
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(objectKey),
+		Body:   reader,
+	}
+	if _, err := store.uploader.Upload(uploadInput); err != nil {
+		return stacktrace.Propagate(err, "An error occurred uploading object '%v' to bucket '%v'", objectKey, store.bucket)
+	}
+	return nil
+}
+
+// download returns false if no object exists under the key
+func (store *s3RemoteFileStore) download(objectKey string, writer io.Writer) (bool, error) {
+	getObjectInput := &s3.GetObjectInput{ //nolint:exhaustruct
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(objectKey),
+	}
+	getObjectOutput, err := store.client.GetObject(getObjectInput)
+	if err != nil {
+		if isObjectNotFoundErr(err) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "An error occurred getting object '%v' from bucket '%v'", objectKey, store.bucket)
+	}
+	defer getObjectOutput.Body.Close()
+
+	if _, err := io.Copy(writer, getObjectOutput.Body); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred reading the content of object '%v' from bucket '%v'", objectKey, store.bucket)
+	}
+	return true, nil
+}
+
+// isObjectNotFoundErr must be given the error returned by the SDK, before it's wrapped; HEAD requests have no body, so
+// a missing object only shows in their status code
+func isObjectNotFoundErr(err error) bool {
+	var requestFailure awserr.RequestFailure
+	if errors.As(err, &requestFailure) {
+		return requestFailure.StatusCode() == http.StatusNotFound
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package files_artifact_scanning
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	stagedContentFilePattern = "files-artifact-scan-*.tgz"
+
+	// FilesArtifactNameHeader tells the webhook which files artifact it's scanning
+	FilesArtifactNameHeader  = "X-Kurtosis-Files-Artifact-Name"
+	filesArtifactContentType = "application/gzip"
+
+	// Only the beginning of what the scanner answers is kept as the reason of the rejection
+	maxRejectionReasonBytes = 4096
+)
+
+// FilesArtifactRejectedError is the root cause of the error returned when the scanner vetoes the storage of a files
+// artifact
+type FilesArtifactRejectedError struct {
+	artifactName string
+	reason       string
+}
+
+func (rejectedErr *FilesArtifactRejectedError) Error() string {
+	return fmt.Sprintf("Files artifact '%v' was rejected by the content scanner of the cluster: %v", rejectedErr.artifactName, rejectedErr.reason)
+}
+
+func (rejectedErr *FilesArtifactRejectedError) GetArtifactName() string {
+	return rejectedErr.artifactName
+}
+
+func (rejectedErr *FilesArtifactRejectedError) GetReason() string {
+	return rejectedErr.reason
+}
+
+// GetFilesArtifactRejectedError returns the rejection that caused err, if any
+func GetFilesArtifactRejectedError(err error) (*FilesArtifactRejectedError, bool) {
+	rejectedErr, ok := stacktrace.RootCause(err).(*FilesArtifactRejectedError)
+	return rejectedErr, ok
+}
+
+// FilesArtifactScanner runs the content scanning hook of the cluster on the files artifacts uploaded to the enclave,
+// before they're stored. A scanner that can't be reached or fails rejects nothing but fails the upload, so that no
+// unscanned content is ever stored.
+type FilesArtifactScanner struct {
+	webhookUrl  string
+	execCommand []string
+	timeout     time.Duration
+
+	httpClient *http.Client
+}
+
+// NewFilesArtifactScanner returns nil if the config doesn't enable the content scanning
+func NewFilesArtifactScanner(config content_scanning.ContentScanningConfig) (*FilesArtifactScanner, error) {
+	if !config.IsEnabled() {
+		return nil, nil
+	}
+	if err := config.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The content scanning config is invalid")
+	}
+	return &FilesArtifactScanner{
+		webhookUrl:  config.WebhookUrl,
+		execCommand: config.ExecCommand,
+		timeout:     config.GetTimeout(),
+		httpClient: &http.Client{
+			Transport:     nil,
+			CheckRedirect: nil,
+			Jar:           nil,
+			Timeout:       0,
+		},
+	}, nil
+}
+
+// ScanFilesArtifact stages the content of the files artifact in a temporary file, as the scanner has to see all of it
+// before it's stored, and scans it. If the scanner accepts it, the staged content is returned to be stored in place of
+// the original reader; the caller must call the returned function once it's stored to remove the staged content.
+func (scanner *FilesArtifactScanner) ScanFilesArtifact(artifactName string, content io.Reader) (io.Reader, func(), error) {
+	stagedContentFile, err := os.CreateTemp("", stagedContentFilePattern)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the temporary file to stage files artifact '%v' in", artifactName)
+	}
+	removeStagedContent := func() {
+		stagedContentFile.Close()
+		os.Remove(stagedContentFile.Name())
+	}
+	shouldRemoveStagedContent := true
+	defer func() {
+		if shouldRemoveStagedContent {
+			removeStagedContent()
+		}
+	}()
+
+	if _, err = io.Copy(stagedContentFile, content); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred staging files artifact '%v' to scan it", artifactName)
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), scanner.timeout)
+	defer cancelFunc()
+	if scanner.webhookUrl != "" {
+		err = scanner.scanWithWebhook(ctx, artifactName, stagedContentFile)
+	} else {
+		err = scanner.scanWithCommand(ctx, artifactName, stagedContentFile.Name())
+	}
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Files artifact '%v' couldn't be stored as it failed the content scanning", artifactName)
+	}
+
+	if _, err = stagedContentFile.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred rewinding the staged content of files artifact '%v'", artifactName)
+	}
+	shouldRemoveStagedContent = false
+	return stagedContentFile, removeStagedContent, nil
+}
+
+func (scanner *FilesArtifactScanner) scanWithWebhook(ctx context.Context, artifactName string, stagedContentFile *os.File) error {
+	if _, err := stagedContentFile.Seek(0, io.SeekStart); err != nil {
+		return stacktrace.Propagate(err, "An error occurred rewinding the staged content of files artifact '%v'", artifactName)
+	}
+	// The staged content is stored once scanned, so the request mustn't close it
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, scanner.webhookUrl, io.NopCloser(stagedContentFile))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the request to content scanning webhook '%v'", scanner.webhookUrl)
+	}
+	request.Header.Set("Content-Type", filesArtifactContentType)
+	request.Header.Set(FilesArtifactNameHeader, artifactName)
+
+	response, err := scanner.httpClient.Do(request)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred calling content scanning webhook '%v'", scanner.webhookUrl)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+	responseBody, err := io.ReadAll(io.LimitReader(response.Body, maxRejectionReasonBytes))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the answer of content scanning webhook '%v'", scanner.webhookUrl)
+	}
+	reason := fmt.Sprintf("the webhook answered with status '%v'", response.Status)
+	if trimmedResponseBody := strings.TrimSpace(string(responseBody)); trimmedResponseBody != "" {
+		reason = fmt.Sprintf("%v: %v", reason, trimmedResponseBody)
+	}
+	return &FilesArtifactRejectedError{
+		artifactName: artifactName,
+		reason:       reason,
+	}
+}
+
+func (scanner *FilesArtifactScanner) scanWithCommand(ctx context.Context, artifactName string, stagedContentFilepath string) error {
+	commandArgs := append(append([]string{}, scanner.execCommand[1:]...), stagedContentFilepath)
+	command := exec.CommandContext(ctx, scanner.execCommand[0], commandArgs...)
+	var output bytes.Buffer
+	command.Stdout = &output
+	command.Stderr = &output
+
+	err := command.Run()
+	if err == nil {
+		return nil
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || ctx.Err() != nil {
+		return stacktrace.Propagate(err, "An error occurred running content scanning command '%v'", strings.Join(scanner.execCommand, " "))
+	}
+	reason := fmt.Sprintf("the scanning command exited with code %v", exitErr.ExitCode())
+	if trimmedOutput := strings.TrimSpace(output.String()); trimmedOutput != "" {
+		if len(trimmedOutput) > maxRejectionReasonBytes {
+			trimmedOutput = trimmedOutput[:maxRejectionReasonBytes]
+		}
+		reason = fmt.Sprintf("%v: %v", reason, trimmedOutput)
+	}
+	return &FilesArtifactRejectedError{
+		artifactName: artifactName,
+		reason:       reason,
+	}
+}
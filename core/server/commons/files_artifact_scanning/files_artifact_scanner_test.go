@@ -0,0 +1,87 @@
+package files_artifact_scanning
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testArtifactName = "test-artifact"
+	cleanContent     = "clean content"
+	infectedContent  = "EICAR infected content"
+)
+
+func TestNewFilesArtifactScanner_DisabledConfigReturnsNil(t *testing.T) {
+	scanner, err := NewFilesArtifactScanner(content_scanning.NewDisabledContentScanningConfig())
+	require.NoError(t, err)
+	require.Nil(t, scanner)
+}
+
+func TestScanFilesArtifact_Webhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, testArtifactName, request.Header.Get(FilesArtifactNameHeader))
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		if strings.Contains(string(body), "EICAR") {
+			writer.WriteHeader(http.StatusForbidden)
+			_, _ = writer.Write([]byte("malware found"))
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := content_scanning.NewDisabledContentScanningConfig()
+	config.WebhookUrl = server.URL
+	scanner, err := NewFilesArtifactScanner(config)
+	require.NoError(t, err)
+
+	requireAccepted(t, scanner)
+	requireRejected(t, scanner, "malware found")
+}
+
+func TestScanFilesArtifact_Command(t *testing.T) {
+	config := content_scanning.NewDisabledContentScanningConfig()
+	config.ExecCommand = []string{"sh", "-c", `if grep -q EICAR "$1"; then echo "malware found"; exit 1; fi`, "scan"}
+	scanner, err := NewFilesArtifactScanner(config)
+	require.NoError(t, err)
+
+	requireAccepted(t, scanner)
+	requireRejected(t, scanner, "malware found")
+}
+
+func TestScanFilesArtifact_UnreachableScannerFailsWithoutRejecting(t *testing.T) {
+	config := content_scanning.NewDisabledContentScanningConfig()
+	config.ExecCommand = []string{"/non/existent/scanner"}
+	scanner, err := NewFilesArtifactScanner(config)
+	require.NoError(t, err)
+
+	_, _, err = scanner.ScanFilesArtifact(testArtifactName, strings.NewReader(cleanContent))
+	require.Error(t, err)
+	_, isRejected := GetFilesArtifactRejectedError(err)
+	require.False(t, isRejected)
+}
+
+func requireAccepted(t *testing.T, scanner *FilesArtifactScanner) {
+	scannedContent, removeScannedContent, err := scanner.ScanFilesArtifact(testArtifactName, strings.NewReader(cleanContent))
+	require.NoError(t, err)
+	defer removeScannedContent()
+	storedContent, err := io.ReadAll(scannedContent)
+	require.NoError(t, err)
+	require.Equal(t, cleanContent, string(storedContent))
+}
+
+func requireRejected(t *testing.T, scanner *FilesArtifactScanner, expectedReason string) {
+	_, _, err := scanner.ScanFilesArtifact(testArtifactName, strings.NewReader(infectedContent))
+	require.Error(t, err)
+	rejectedErr, isRejected := GetFilesArtifactRejectedError(err)
+	require.True(t, isRejected)
+	require.Equal(t, testArtifactName, rejectedErr.GetArtifactName())
+	require.Contains(t, rejectedErr.GetReason(), expectedReason)
+}
@@ -0,0 +1,377 @@
+package image_signatures
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// cosign stores the signatures and the attestations of an image next to it, under tags derived from its digest
+	signatureTagSuffix   = ".sig"
+	attestationTagSuffix = ".att"
+
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	dsseEnvelopeMediaType     = "application/vnd.dsse.envelope.v1+json"
+	dssePreAuthEncodingFormat = "DSSEv1 %d %s %d %s"
+	inTotoPayloadType         = "application/vnd.in-toto+json"
+
+	registryRequestTimeout = 30 * time.Second
+
+	pinnedReferenceDigestSeparator = "@"
+)
+
+type allowedSigner struct {
+	name      string
+	publicKey crypto.PublicKey
+}
+
+type ociManifest struct {
+	Layers []struct {
+		MediaType   string            `json:"mediaType"`
+		Digest      string            `json:"digest"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"layers"`
+}
+
+// simpleSigningPayload is what cosign signs when signing an image, binding the signature to the image digest
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+	Signatures  []struct {
+		Sig string `json:"sig"`
+	} `json:"signatures"`
+}
+
+type inTotoStatement struct {
+	PredicateType string `json:"predicateType"`
+	Subject       []struct {
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+}
+
+// ImageSignatureVerifier checks that the images are signed, with cosign, by one of the signers allowed by the cluster
+// and that they carry the attestations it requires
+type ImageSignatureVerifier struct {
+	allowedSigners          []*allowedSigner
+	requiredAttestations    []string
+	allowLocallyBuiltImages bool
+
+	registryClient *registryClient
+
+	// The references pinning the verified images to the digest they were verified at, by normalized image name, so
+	// that a tag moved after the verification doesn't get another image started
+	verifiedImageReferences      map[string]string
+	verifiedImageReferencesMutex *sync.Mutex
+}
+
+// NewImageSignatureVerifier returns nil if the config doesn't enable the image verification
+func NewImageSignatureVerifier(config image_verification.ImageVerificationConfig) (*ImageSignatureVerifier, error) {
+	return newImageSignatureVerifier(config, &http.Client{
+		Transport:     nil,
+		CheckRedirect: nil,
+		Jar:           nil,
+		Timeout:       registryRequestTimeout,
+	})
+}
+
+func newImageSignatureVerifier(config image_verification.ImageVerificationConfig, httpClient *http.Client) (*ImageSignatureVerifier, error) {
+	if !config.IsEnabled() {
+		return nil, nil
+	}
+	if err := config.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The image verification config is invalid")
+	}
+	var allowedSigners []*allowedSigner
+	for _, signer := range config.AllowedSigners {
+		publicKey, err := image_verification.ParsePublicKey(signer.PublicKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the public key of allowed signer '%v'", signer.Name)
+		}
+		allowedSigners = append(allowedSigners, &allowedSigner{
+			name:      signer.Name,
+			publicKey: publicKey,
+		})
+	}
+	return &ImageSignatureVerifier{
+		allowedSigners:               allowedSigners,
+		requiredAttestations:         config.RequiredAttestations,
+		allowLocallyBuiltImages:      config.AllowLocallyBuiltImages,
+		registryClient:               newRegistryClient(httpClient),
+		verifiedImageReferences:      map[string]string{},
+		verifiedImageReferencesMutex: &sync.Mutex{},
+	}, nil
+}
+
+// AllowsLocallyBuiltImages returns true if the images built from the packages can be used, as they can't be signed
+func (verifier *ImageSignatureVerifier) AllowsLocallyBuiltImages() bool {
+	return verifier.allowLocallyBuiltImages
+}
+
+// VerifyImage returns an error explaining why the image isn't allowed if it isn't signed by one of the allowed signers
+// or lacks one of the required attestations. Otherwise, it returns the reference pinning the image to the digest it
+// was verified at, e.g. 'docker.io/library/nginx@sha256:abc' for 'nginx', which is what must be pulled and started.
+func (verifier *ImageSignatureVerifier) VerifyImage(ctx context.Context, imageName string, registrySpec *image_registry_spec.ImageRegistrySpec) (string, error) {
+	namedReference, err := reference.ParseNormalizedNamed(imageName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing image reference '%v'", imageName)
+	}
+	registry := reference.Domain(namedReference)
+	repository := reference.Path(namedReference)
+
+	imageDigest, err := verifier.resolveImageDigest(ctx, registry, repository, namedReference, registrySpec)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred resolving the digest of image '%v'", imageName)
+	}
+
+	signerName, err := verifier.verifySignatures(ctx, registry, repository, imageDigest, registrySpec)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred verifying the signatures of image '%v'", imageName)
+	}
+	if signerName == "" {
+		return "", stacktrace.NewError("Image '%v' (%v) isn't signed by any of the signers allowed by the cluster: %v", imageName, imageDigest, strings.Join(verifier.getAllowedSignerNames(), ", "))
+	}
+
+	if len(verifier.requiredAttestations) > 0 {
+		attestedPredicateTypes, err := verifier.getVerifiedAttestations(ctx, registry, repository, imageDigest, registrySpec)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred verifying the attestations of image '%v'", imageName)
+		}
+		for _, requiredPredicateType := range verifier.requiredAttestations {
+			if !attestedPredicateTypes[requiredPredicateType] {
+				return "", stacktrace.NewError("Image '%v' (%v) has no '%v' attestation signed by any of the signers allowed by the cluster: %v", imageName, imageDigest, requiredPredicateType, strings.Join(verifier.getAllowedSignerNames(), ", "))
+			}
+		}
+	}
+
+	pinnedReference, err := reference.ParseNormalizedNamed(reference.TrimNamed(namedReference).String() + pinnedReferenceDigestSeparator + imageDigest)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pinning image '%v' to digest '%v'", imageName, imageDigest)
+	}
+	verifier.verifiedImageReferencesMutex.Lock()
+	defer verifier.verifiedImageReferencesMutex.Unlock()
+	verifier.verifiedImageReferences[image_utils.NormalizeImageReference(imageName)] = pinnedReference.String()
+	return pinnedReference.String(), nil
+}
+
+// GetVerifiedImageReference returns the reference pinning the image to the digest it was last verified at, or the
+// image name as is if it wasn't verified, like the images built locally
+func (verifier *ImageSignatureVerifier) GetVerifiedImageReference(imageName string) string {
+	verifier.verifiedImageReferencesMutex.Lock()
+	defer verifier.verifiedImageReferencesMutex.Unlock()
+	pinnedReference, found := verifier.verifiedImageReferences[image_utils.NormalizeImageReference(imageName)]
+	if !found {
+		return imageName
+	}
+	return pinnedReference
+}
+
+// PinVerifiedImageInServiceConfig returns a copy of the service config starting the image at the digest it was
+// verified at. The service config is returned as is if its image wasn't verified.
+func (verifier *ImageSignatureVerifier) PinVerifiedImageInServiceConfig(serviceConfig *service.ServiceConfig) (*service.ServiceConfig, error) {
+	pinnedReference := verifier.GetVerifiedImageReference(serviceConfig.GetContainerImageName())
+	if pinnedReference == serviceConfig.GetContainerImageName() {
+		return serviceConfig, nil
+	}
+	serializedServiceConfig, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the service config to copy it")
+	}
+	var pinnedServiceConfig service.ServiceConfig
+	if err := json.Unmarshal(serializedServiceConfig, &pinnedServiceConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the copy of the service config")
+	}
+	pinnedServiceConfig.SetContainerImageName(pinnedReference)
+	return &pinnedServiceConfig, nil
+}
+
+// resolveImageDigest returns the digest of the manifest the image reference points to, computed from the manifest
+// itself. Digested references are looked up too, to make sure the registry serves a manifest matching the digest.
+func (verifier *ImageSignatureVerifier) resolveImageDigest(ctx context.Context, registry string, repository string, namedReference reference.Named, registrySpec *image_registry_spec.ImageRegistrySpec) (string, error) {
+	var manifestReference string
+	if digestedReference, isDigested := namedReference.(reference.Digested); isDigested {
+		manifestReference = digestedReference.Digest().String()
+	} else if taggedReference, isTagged := reference.TagNameOnly(namedReference).(reference.Tagged); isTagged {
+		manifestReference = taggedReference.Tag()
+	} else {
+		return "", stacktrace.NewError("Image reference '%v' has neither a tag nor a digest", namedReference.String())
+	}
+	manifest, manifestDigest, err := verifier.registryClient.getManifest(ctx, registry, repository, manifestReference, registrySpec)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting manifest '%v'", manifestReference)
+	}
+	if manifest == nil {
+		return "", stacktrace.NewError("Manifest '%v' wasn't found in repository '%v/%v'", manifestReference, registry, repository)
+	}
+	return manifestDigest, nil
+}
+
+// verifySignatures returns the name of the allowed signer that signed the image, or an empty string if none did
+func (verifier *ImageSignatureVerifier) verifySignatures(ctx context.Context, registry string, repository string, imageDigest string, registrySpec *image_registry_spec.ImageRegistrySpec) (string, error) {
+	signatureManifest, err := verifier.getCosignManifest(ctx, registry, repository, imageDigest, signatureTagSuffix, registrySpec)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the signatures")
+	}
+	if signatureManifest == nil {
+		return "", nil
+	}
+	for _, layer := range signatureManifest.Layers {
+		encodedSignature, found := layer.Annotations[cosignSignatureAnnotation]
+		if !found {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+		if err != nil {
+			continue
+		}
+		payload, err := verifier.registryClient.getBlob(ctx, registry, repository, layer.Digest, registrySpec)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred getting signature payload '%v'", layer.Digest)
+		}
+		signerName := verifier.findSigner(payload, signature)
+		if signerName == "" {
+			continue
+		}
+		// A signature only vouches for the image whose digest it carries, otherwise the signature of another image
+		// could be copied over
+		var signedPayload simpleSigningPayload
+		if err := json.Unmarshal(payload, &signedPayload); err != nil {
+			continue
+		}
+		if signedPayload.Critical.Image.DockerManifestDigest == imageDigest {
+			return signerName, nil
+		}
+	}
+	return "", nil
+}
+
+// getVerifiedAttestations returns the predicate types of the attestations of the image signed by an allowed signer
+func (verifier *ImageSignatureVerifier) getVerifiedAttestations(ctx context.Context, registry string, repository string, imageDigest string, registrySpec *image_registry_spec.ImageRegistrySpec) (map[string]bool, error) {
+	attestedPredicateTypes := map[string]bool{}
+	attestationManifest, err := verifier.getCosignManifest(ctx, registry, repository, imageDigest, attestationTagSuffix, registrySpec)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the attestations")
+	}
+	if attestationManifest == nil {
+		return attestedPredicateTypes, nil
+	}
+	imageDigestHex := strings.TrimPrefix(imageDigest, sha256DigestPrefix)
+	for _, layer := range attestationManifest.Layers {
+		if layer.MediaType != dsseEnvelopeMediaType {
+			continue
+		}
+		envelopeBytes, err := verifier.registryClient.getBlob(ctx, registry, repository, layer.Digest, registrySpec)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting attestation '%v'", layer.Digest)
+		}
+		statement, isVerified := verifier.verifyAttestation(envelopeBytes)
+		if !isVerified {
+			continue
+		}
+		for _, subject := range statement.Subject {
+			if subject.Digest["sha256"] == imageDigestHex {
+				attestedPredicateTypes[statement.PredicateType] = true
+			}
+		}
+	}
+	return attestedPredicateTypes, nil
+}
+
+// verifyAttestation returns the in-toto statement of the DSSE envelope if it's signed by an allowed signer
+func (verifier *ImageSignatureVerifier) verifyAttestation(envelopeBytes []byte) (*inTotoStatement, bool) {
+	var envelope dsseEnvelope
+	if err := json.Unmarshal(envelopeBytes, &envelope); err != nil || envelope.PayloadType != inTotoPayloadType {
+		return nil, false
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, false
+	}
+	// DSSE signs the pre-authentication encoding of the payload, which binds its type to it
+	preAuthEncoding := []byte(fmt.Sprintf(dssePreAuthEncodingFormat, len(envelope.PayloadType), envelope.PayloadType, len(payload), payload))
+	for _, envelopeSignature := range envelope.Signatures {
+		signature, err := base64.StdEncoding.DecodeString(envelopeSignature.Sig)
+		if err != nil {
+			continue
+		}
+		if verifier.findSigner(preAuthEncoding, signature) == "" {
+			continue
+		}
+		var statement inTotoStatement
+		if err := json.Unmarshal(payload, &statement); err != nil {
+			return nil, false
+		}
+		return &statement, true
+	}
+	return nil, false
+}
+
+func (verifier *ImageSignatureVerifier) getCosignManifest(ctx context.Context, registry string, repository string, imageDigest string, tagSuffix string, registrySpec *image_registry_spec.ImageRegistrySpec) (*ociManifest, error) {
+	// 'sha256:abc' is stored under tag 'sha256-abc.sig', as tags can't hold colons
+	cosignTag := strings.Replace(imageDigest, ":", "-", 1) + tagSuffix
+	manifestBytes, _, err := verifier.registryClient.getManifest(ctx, registry, repository, cosignTag, registrySpec)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting manifest '%v'", cosignTag)
+	}
+	if manifestBytes == nil {
+		return nil, nil
+	}
+	var manifest ociManifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding manifest '%v'", cosignTag)
+	}
+	return &manifest, nil
+}
+
+// findSigner returns the name of the allowed signer whose key the signature was made with, or an empty string
+func (verifier *ImageSignatureVerifier) findSigner(message []byte, signature []byte) string {
+	messageHash := sha256.Sum256(message)
+	for _, signer := range verifier.allowedSigners {
+		isValid := false
+		switch publicKey := signer.publicKey.(type) {
+		case *ecdsa.PublicKey:
+			isValid = ecdsa.VerifyASN1(publicKey, messageHash[:], signature)
+		case *rsa.PublicKey:
+			isValid = rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, messageHash[:], signature) == nil
+		case ed25519.PublicKey:
+			isValid = ed25519.Verify(publicKey, message, signature)
+		}
+		if isValid {
+			return signer.name
+		}
+	}
+	return ""
+}
+
+func (verifier *ImageSignatureVerifier) getAllowedSignerNames() []string {
+	var signerNames []string
+	for _, signer := range verifier.allowedSigners {
+		signerNames = append(signerNames, signer.name)
+	}
+	return signerNames
+}
@@ -0,0 +1,314 @@
+package image_signatures
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRepository      = "org/app"
+	testTag             = "1.0"
+	testPredicateType   = "https://slsa.dev/provenance/v1"
+	testBearerToken     = "test-token"
+	testImageManifest   = `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`
+	testSignatureFormat = `{"critical":{"identity":{"docker-reference":"%v"},"image":{"docker-manifest-digest":"%v"},"type":"cosign container image signature"},"optional":null}`
+)
+
+// fakeRegistry serves the manifests and the blobs of a single repository
+type fakeRegistry struct {
+	server    *httptest.Server
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	// The digests the registry claims the manifests have, by reference, instead of leaving the header out
+	reportedDigests map[string]string
+	requireToken    bool
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	registry := &fakeRegistry{
+		server: nil,
+		manifests: map[string][]byte{
+			testTag: []byte(testImageManifest),
+			computeSha256Digest([]byte(testImageManifest)): []byte(testImageManifest),
+		},
+		blobs:           map[string][]byte{},
+		reportedDigests: map[string]string{},
+		requireToken:    false,
+	}
+	registry.server = httptest.NewTLSServer(http.HandlerFunc(registry.serve))
+	t.Cleanup(registry.server.Close)
+	return registry
+}
+
+func (registry *fakeRegistry) serve(writer http.ResponseWriter, request *http.Request) {
+	if request.URL.Path == "/token" {
+		_, _ = writer.Write([]byte(fmt.Sprintf(`{"token":"%v"}`, testBearerToken)))
+		return
+	}
+	if registry.requireToken && request.Header.Get("Authorization") != "Bearer "+testBearerToken {
+		writer.Header().Set(wwwAuthenticateHeader, fmt.Sprintf(`Bearer realm="%v/token",service="registry",scope="repository:%v:pull"`, registry.server.URL, testRepository))
+		writer.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	manifestPrefix := fmt.Sprintf("/v2/%v/manifests/", testRepository)
+	blobPrefix := fmt.Sprintf("/v2/%v/blobs/", testRepository)
+	var content []byte
+	switch {
+	case strings.HasPrefix(request.URL.Path, manifestPrefix):
+		manifestReference := strings.TrimPrefix(request.URL.Path, manifestPrefix)
+		content = registry.manifests[manifestReference]
+		if reportedDigest, found := registry.reportedDigests[manifestReference]; found {
+			writer.Header().Set(dockerContentDigestHeader, reportedDigest)
+		}
+	case strings.HasPrefix(request.URL.Path, blobPrefix):
+		content = registry.blobs[strings.TrimPrefix(request.URL.Path, blobPrefix)]
+	}
+	if content == nil {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = writer.Write(content)
+}
+
+func (registry *fakeRegistry) getImageName() string {
+	return fmt.Sprintf("%v/%v:%v", strings.TrimPrefix(registry.server.URL, "https://"), testRepository, testTag)
+}
+
+func (registry *fakeRegistry) getDigestedImageName(imageDigest string) string {
+	return fmt.Sprintf("%v/%v@%v", strings.TrimPrefix(registry.server.URL, "https://"), testRepository, imageDigest)
+}
+
+func (registry *fakeRegistry) getImageDigest() string {
+	return computeSha256Digest([]byte(testImageManifest))
+}
+
+func (registry *fakeRegistry) addBlob(content []byte) string {
+	blobDigest := computeSha256Digest(content)
+	registry.blobs[blobDigest] = content
+	return blobDigest
+}
+
+func (registry *fakeRegistry) sign(t *testing.T, privateKey *ecdsa.PrivateKey, signedDigest string) {
+	payload := []byte(fmt.Sprintf(testSignatureFormat, registry.getImageName(), signedDigest))
+	signature := signMessage(t, privateKey, payload)
+	manifest := fmt.Sprintf(
+		`{"schemaVersion":2,"layers":[{"mediaType":"application/vnd.dev.cosign.simplesigning.v1+json","digest":"%v","annotations":{"%v":"%v"}}]}`,
+		registry.addBlob(payload),
+		cosignSignatureAnnotation,
+		base64.StdEncoding.EncodeToString(signature),
+	)
+	registry.manifests[getSignatureTag(registry.getImageDigest())] = []byte(manifest)
+}
+
+func (registry *fakeRegistry) attest(t *testing.T, privateKey *ecdsa.PrivateKey, predicateType string) {
+	statement := fmt.Sprintf(
+		`{"_type":"https://in-toto.io/Statement/v1","predicateType":"%v","subject":[{"name":"%v","digest":{"sha256":"%v"}}],"predicate":{}}`,
+		predicateType,
+		testRepository,
+		strings.TrimPrefix(registry.getImageDigest(), sha256DigestPrefix),
+	)
+	preAuthEncoding := fmt.Sprintf(dssePreAuthEncodingFormat, len(inTotoPayloadType), inTotoPayloadType, len(statement), statement)
+	envelope, err := json.Marshal(map[string]interface{}{
+		"payloadType": inTotoPayloadType,
+		"payload":     base64.StdEncoding.EncodeToString([]byte(statement)),
+		"signatures":  []map[string]string{{"sig": base64.StdEncoding.EncodeToString(signMessage(t, privateKey, []byte(preAuthEncoding)))}},
+	})
+	require.NoError(t, err)
+	manifest := fmt.Sprintf(`{"schemaVersion":2,"layers":[{"mediaType":"%v","digest":"%v"}]}`, dsseEnvelopeMediaType, registry.addBlob(envelope))
+	registry.manifests[strings.Replace(registry.getImageDigest(), ":", "-", 1)+attestationTagSuffix] = []byte(manifest)
+}
+
+func TestVerifyImage_SignedByAllowedSigner(t *testing.T) {
+	registry := newFakeRegistry(t)
+	privateKey := generatePrivateKey(t)
+	registry.sign(t, privateKey, registry.getImageDigest())
+
+	verifier := createVerifier(t, registry, privateKey)
+	require.Equal(t, registry.getImageName(), verifier.GetVerifiedImageReference(registry.getImageName()))
+
+	pinnedReference, err := verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.NoError(t, err)
+	require.Equal(t, registry.getDigestedImageName(registry.getImageDigest()), pinnedReference)
+	require.Equal(t, pinnedReference, verifier.GetVerifiedImageReference(registry.getImageName()))
+}
+
+func TestPinVerifiedImageInServiceConfig(t *testing.T) {
+	registry := newFakeRegistry(t)
+	privateKey := generatePrivateKey(t)
+	registry.sign(t, privateKey, registry.getImageDigest())
+	verifier := createVerifier(t, registry, privateKey)
+
+	serviceConfig, err := service.CreateServiceConfig(registry.getImageName(), nil, nil, nil, nil, nil, nil, nil, map[string]string{"MODE": "release"}, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true)
+	require.NoError(t, err)
+	unpinnedServiceConfig, err := verifier.PinVerifiedImageInServiceConfig(serviceConfig)
+	require.NoError(t, err)
+	require.Same(t, serviceConfig, unpinnedServiceConfig)
+
+	pinnedReference, err := verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.NoError(t, err)
+	pinnedServiceConfig, err := verifier.PinVerifiedImageInServiceConfig(serviceConfig)
+	require.NoError(t, err)
+	require.Equal(t, pinnedReference, pinnedServiceConfig.GetContainerImageName())
+	require.Equal(t, map[string]string{"MODE": "release"}, pinnedServiceConfig.GetEnvVars())
+	// The config stored in the enclave keeps the name the package used
+	require.Equal(t, registry.getImageName(), serviceConfig.GetContainerImageName())
+}
+
+func TestVerifyImage_SignedDigestedImage(t *testing.T) {
+	registry := newFakeRegistry(t)
+	privateKey := generatePrivateKey(t)
+	registry.sign(t, privateKey, registry.getImageDigest())
+
+	verifier := createVerifier(t, registry, privateKey)
+	digestedImageName := registry.getDigestedImageName(registry.getImageDigest())
+	pinnedReference, err := verifier.VerifyImage(context.Background(), digestedImageName, nil)
+	require.NoError(t, err)
+	require.Equal(t, digestedImageName, pinnedReference)
+}
+
+func TestVerifyImage_DigestReportedByRegistryIsNotTrusted(t *testing.T) {
+	registry := newFakeRegistry(t)
+	privateKey := generatePrivateKey(t)
+	// The registry claims the tag points to a signed image while it serves another one
+	signedImageDigest := computeSha256Digest([]byte("signed image"))
+	registry.sign(t, privateKey, signedImageDigest)
+	registry.manifests[getSignatureTag(signedImageDigest)] = registry.manifests[getSignatureTag(registry.getImageDigest())]
+	registry.reportedDigests[testTag] = signedImageDigest
+
+	verifier := createVerifier(t, registry, privateKey)
+	_, err := verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), fmt.Sprintf("Registry reported digest '%v'", signedImageDigest))
+	require.Equal(t, registry.getImageName(), verifier.GetVerifiedImageReference(registry.getImageName()))
+}
+
+func TestVerifyImage_ManifestNotMatchingDigestedReferenceIsRejected(t *testing.T) {
+	registry := newFakeRegistry(t)
+	privateKey := generatePrivateKey(t)
+	signedImageDigest := computeSha256Digest([]byte("signed image"))
+	registry.sign(t, privateKey, signedImageDigest)
+	registry.manifests[getSignatureTag(signedImageDigest)] = registry.manifests[getSignatureTag(registry.getImageDigest())]
+	registry.manifests[signedImageDigest] = []byte(testImageManifest)
+
+	verifier := createVerifier(t, registry, privateKey)
+	_, err := verifier.VerifyImage(context.Background(), registry.getDigestedImageName(signedImageDigest), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't match its digest")
+}
+
+func TestVerifyImage_UnsignedImageIsRejected(t *testing.T) {
+	registry := newFakeRegistry(t)
+	verifier := createVerifier(t, registry, generatePrivateKey(t))
+
+	_, err := verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't signed by any of the signers allowed by the cluster: release")
+}
+
+func TestVerifyImage_SignedByOtherSignerIsRejected(t *testing.T) {
+	registry := newFakeRegistry(t)
+	registry.sign(t, generatePrivateKey(t), registry.getImageDigest())
+
+	verifier := createVerifier(t, registry, generatePrivateKey(t))
+	_, err := verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.Error(t, err)
+}
+
+func TestVerifyImage_SignatureOfOtherImageIsRejected(t *testing.T) {
+	registry := newFakeRegistry(t)
+	privateKey := generatePrivateKey(t)
+	registry.sign(t, privateKey, computeSha256Digest([]byte("another image")))
+
+	verifier := createVerifier(t, registry, privateKey)
+	_, err := verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.Error(t, err)
+}
+
+func TestVerifyImage_RequiredAttestation(t *testing.T) {
+	registry := newFakeRegistry(t)
+	privateKey := generatePrivateKey(t)
+	registry.sign(t, privateKey, registry.getImageDigest())
+
+	verifier := createVerifier(t, registry, privateKey, testPredicateType)
+	_, err := verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), fmt.Sprintf("has no '%v' attestation", testPredicateType))
+
+	registry.attest(t, privateKey, testPredicateType)
+	_, err = verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.NoError(t, err)
+}
+
+func TestVerifyImage_AuthenticatesWithBearerToken(t *testing.T) {
+	registry := newFakeRegistry(t)
+	registry.requireToken = true
+	privateKey := generatePrivateKey(t)
+	registry.sign(t, privateKey, registry.getImageDigest())
+
+	verifier := createVerifier(t, registry, privateKey)
+	_, err := verifier.VerifyImage(context.Background(), registry.getImageName(), nil)
+	require.NoError(t, err)
+}
+
+func TestParseAuthenticateChallenge(t *testing.T) {
+	scheme, parameters := parseAuthenticateChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/nginx:pull,push"`)
+	require.Equal(t, "Bearer", scheme)
+	require.Equal(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/nginx:pull,push",
+	}, parameters)
+}
+
+func TestNewImageSignatureVerifier_DisabledConfig(t *testing.T) {
+	verifier, err := NewImageSignatureVerifier(image_verification.NewDisabledImageVerificationConfig())
+	require.NoError(t, err)
+	require.Nil(t, verifier)
+}
+
+func createVerifier(t *testing.T, registry *fakeRegistry, privateKey *ecdsa.PrivateKey, requiredAttestations ...string) *ImageSignatureVerifier {
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	config := image_verification.NewDisabledImageVerificationConfig()
+	config.AllowedSigners = []image_verification.AllowedSigner{
+		{Name: "release", PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}))},
+	}
+	config.RequiredAttestations = requiredAttestations
+	verifier, err := newImageSignatureVerifier(config, registry.server.Client())
+	require.NoError(t, err)
+	return verifier
+}
+
+func getSignatureTag(imageDigest string) string {
+	return strings.Replace(imageDigest, ":", "-", 1) + signatureTagSuffix
+}
+
+func generatePrivateKey(t *testing.T) *ecdsa.PrivateKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return privateKey
+}
+
+func signMessage(t *testing.T, privateKey *ecdsa.PrivateKey, message []byte) []byte {
+	messageHash := sha256.Sum256(message)
+	signature, err := ecdsa.SignASN1(rand.Reader, privateKey, messageHash[:])
+	require.NoError(t, err)
+	return signature
+}
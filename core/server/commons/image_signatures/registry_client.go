@@ -0,0 +1,276 @@
+package image_signatures
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	registryUrlFormat = "https://%v/v2/%v/%v/%v"
+	manifestsPath     = "manifests"
+	blobsPath         = "blobs"
+
+	// Docker Hub doesn't serve the registry API under the domain its images are referred to with
+	dockerHubDomain         = "docker.io"
+	dockerHubRegistryDomain = "registry-1.docker.io"
+
+	dockerContentDigestHeader = "Docker-Content-Digest"
+	wwwAuthenticateHeader     = "WWW-Authenticate"
+	bearerAuthScheme          = "Bearer"
+	basicAuthScheme           = "Basic"
+	pullScopeFormat           = "repository:%v:pull"
+
+	sha256DigestPrefix       = "sha256:"
+	digestAlgorithmSeparator = ":"
+
+	// The manifests and the signature payloads are small, so anything bigger is refused rather than read in memory
+	maxRegistryResponseBytes = 4 * 1024 * 1024
+	maxRegistryErrorBytes    = 4096
+)
+
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+type tokenResponse struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// registryClient reads the manifests and the blobs of images from the registries they're stored in, following the
+// OCI distribution specification. It only needs pull access to the repositories.
+type registryClient struct {
+	httpClient *http.Client
+
+	// Bearer tokens by registry repository, as the registries hand them out per repository
+	tokens      map[string]string
+	tokensMutex *sync.Mutex
+}
+
+func newRegistryClient(httpClient *http.Client) *registryClient {
+	return &registryClient{
+		httpClient:  httpClient,
+		tokens:      map[string]string{},
+		tokensMutex: &sync.Mutex{},
+	}
+}
+
+// getManifest returns the manifest the reference, a tag or a digest, points to along with its digest. The returned
+// manifest is nil if there's no such manifest in the repository.
+// The digest is computed from the manifest received rather than taken from the registry, so that what's verified is
+// what gets pulled; a registry reporting another digest, or serving another manifest than the digest asked for, is
+// refused.
+func (client *registryClient) getManifest(ctx context.Context, registry string, repository string, reference string, registrySpec *image_registry_spec.ImageRegistrySpec) ([]byte, string, error) {
+	isDigestReference := strings.Contains(reference, digestAlgorithmSeparator)
+	if isDigestReference && !strings.HasPrefix(reference, sha256DigestPrefix) {
+		return nil, "", stacktrace.NewError("Manifest '%v' isn't addressed by a SHA256 digest, which is the only digest supported", reference)
+	}
+	manifestUrl := fmt.Sprintf(registryUrlFormat, getRegistryDomain(registry), repository, manifestsPath, reference)
+	manifest, headers, err := client.get(ctx, registry, repository, manifestUrl, strings.Join(manifestMediaTypes, ","), registrySpec)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting manifest '%v' of repository '%v/%v'", reference, registry, repository)
+	}
+	if manifest == nil {
+		return nil, "", nil
+	}
+	manifestDigest := computeSha256Digest(manifest)
+	if isDigestReference && manifestDigest != reference {
+		return nil, "", stacktrace.NewError("The content of manifest '%v' of repository '%v/%v' doesn't match its digest, it hashes to '%v'", reference, registry, repository, manifestDigest)
+	}
+	if reportedDigest := headers.Get(dockerContentDigestHeader); reportedDigest != "" && reportedDigest != manifestDigest {
+		return nil, "", stacktrace.NewError("Registry reported digest '%v' for manifest '%v' of repository '%v/%v' but its content hashes to '%v'", reportedDigest, reference, registry, repository, manifestDigest)
+	}
+	return manifest, manifestDigest, nil
+}
+
+// getBlob returns the blob with the given digest, making sure its content matches the digest
+func (client *registryClient) getBlob(ctx context.Context, registry string, repository string, blobDigest string, registrySpec *image_registry_spec.ImageRegistrySpec) ([]byte, error) {
+	if !strings.HasPrefix(blobDigest, sha256DigestPrefix) {
+		return nil, stacktrace.NewError("Blob '%v' isn't addressed by a SHA256 digest, which is the only digest supported", blobDigest)
+	}
+	blobUrl := fmt.Sprintf(registryUrlFormat, getRegistryDomain(registry), repository, blobsPath, blobDigest)
+	blob, _, err := client.get(ctx, registry, repository, blobUrl, "", registrySpec)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting blob '%v' of repository '%v/%v'", blobDigest, registry, repository)
+	}
+	if blob == nil {
+		return nil, stacktrace.NewError("Blob '%v' wasn't found in repository '%v/%v'", blobDigest, registry, repository)
+	}
+	if computeSha256Digest(blob) != blobDigest {
+		return nil, stacktrace.NewError("The content of blob '%v' of repository '%v/%v' doesn't match its digest", blobDigest, registry, repository)
+	}
+	return blob, nil
+}
+
+// get returns the body of the response, or nil if the registry answered that there's nothing at the URL. It
+// authenticates against the registry when it asks for it, once per repository.
+func (client *registryClient) get(ctx context.Context, registry string, repository string, registryUrl string, accept string, registrySpec *image_registry_spec.ImageRegistrySpec) ([]byte, http.Header, error) {
+	tokenKey := registry + "/" + repository
+	response, err := client.doGet(ctx, registryUrl, accept, client.getAuthorization(tokenKey))
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred requesting '%v'", registryUrl)
+	}
+	if response.StatusCode == http.StatusUnauthorized {
+		challenge := response.Header.Get(wwwAuthenticateHeader)
+		response.Body.Close()
+		authorization, err := client.authenticate(ctx, repository, challenge, registrySpec)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred authenticating against registry '%v'", registry)
+		}
+		client.setAuthorization(tokenKey, authorization)
+		response, err = client.doGet(ctx, registryUrl, accept, authorization)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred requesting '%v'", registryUrl)
+		}
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return nil, nil, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		errorBody, _ := io.ReadAll(io.LimitReader(response.Body, maxRegistryErrorBytes))
+		return nil, nil, stacktrace.NewError("Registry answered status '%v' when requesting '%v': %v", response.Status, registryUrl, strings.TrimSpace(string(errorBody)))
+	}
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxRegistryResponseBytes+1))
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred reading the response to '%v'", registryUrl)
+	}
+	if len(body) > maxRegistryResponseBytes {
+		return nil, nil, stacktrace.NewError("The response to '%v' is bigger than the %v bytes allowed", registryUrl, maxRegistryResponseBytes)
+	}
+	return body, response.Header, nil
+}
+
+func (client *registryClient) doGet(ctx context.Context, requestUrl string, accept string, authorization string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the request to '%v'", requestUrl)
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending the request to '%v'", requestUrl)
+	}
+	return response, nil
+}
+
+// authenticate answers the challenge of the registry, returning the value of the authorization header to retry with
+func (client *registryClient) authenticate(ctx context.Context, repository string, challenge string, registrySpec *image_registry_spec.ImageRegistrySpec) (string, error) {
+	scheme, parameters := parseAuthenticateChallenge(challenge)
+	switch {
+	case strings.EqualFold(scheme, basicAuthScheme):
+		if registrySpec == nil {
+			return "", stacktrace.NewError("The registry requires credentials but none were given for the image")
+		}
+		return basicAuthScheme + " " + encodeBasicCredentials(registrySpec.GetUsername(), registrySpec.GetPassword()), nil
+	case strings.EqualFold(scheme, bearerAuthScheme):
+		realm, found := parameters["realm"]
+		if !found {
+			return "", stacktrace.NewError("The registry asked for a bearer token without saying where to get it from")
+		}
+		query := url.Values{}
+		if service, found := parameters["service"]; found {
+			query.Set("service", service)
+		}
+		scope, found := parameters["scope"]
+		if !found {
+			scope = fmt.Sprintf(pullScopeFormat, repository)
+		}
+		query.Set("scope", scope)
+		tokenUrl := realm + "?" + query.Encode()
+		basicAuthorization := ""
+		if registrySpec != nil {
+			basicAuthorization = basicAuthScheme + " " + encodeBasicCredentials(registrySpec.GetUsername(), registrySpec.GetPassword())
+		}
+		response, err := client.doGet(ctx, tokenUrl, "", basicAuthorization)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred requesting a token from '%v'", realm)
+		}
+		defer response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			return "", stacktrace.NewError("Token server '%v' answered status '%v'", realm, response.Status)
+		}
+		var token tokenResponse
+		if err := json.NewDecoder(io.LimitReader(response.Body, maxRegistryResponseBytes)).Decode(&token); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred decoding the token sent by '%v'", realm)
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		if token.Token == "" {
+			return "", stacktrace.NewError("Token server '%v' didn't send any token", realm)
+		}
+		return bearerAuthScheme + " " + token.Token, nil
+	default:
+		return "", stacktrace.NewError("The registry asked for authentication scheme '%v', which isn't supported", scheme)
+	}
+}
+
+func (client *registryClient) getAuthorization(tokenKey string) string {
+	client.tokensMutex.Lock()
+	defer client.tokensMutex.Unlock()
+	return client.tokens[tokenKey]
+}
+
+func (client *registryClient) setAuthorization(tokenKey string, authorization string) {
+	client.tokensMutex.Lock()
+	defer client.tokensMutex.Unlock()
+	client.tokens[tokenKey] = authorization
+}
+
+// parseAuthenticateChallenge parses a WWW-Authenticate header, e.g. 'Bearer realm="https://auth.docker.io/token",service="registry.docker.io"'
+func parseAuthenticateChallenge(challenge string) (string, map[string]string) {
+	scheme, rawParameters, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	parameters := map[string]string{}
+	for rawParameters != "" {
+		name, rest, found := strings.Cut(strings.TrimLeft(rawParameters, ", "), "=")
+		if !found {
+			break
+		}
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			// Quoted values, like the scopes, can hold commas
+			value, rawParameters, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rawParameters, _ = strings.Cut(rest, ",")
+		}
+		parameters[strings.ToLower(strings.TrimSpace(name))] = value
+	}
+	return scheme, parameters
+}
+
+func encodeBasicCredentials(username string, password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+}
+
+func getRegistryDomain(registry string) string {
+	if registry == dockerHubDomain {
+		return dockerHubRegistryDomain
+	}
+	return registry
+}
+
+func computeSha256Digest(content []byte) string {
+	hash := sha256.Sum256(content)
+	return sha256DigestPrefix + hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,27 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// envFileSecretsProvider resolves the secrets from the env file the CLI read when it started the engine, whose
+// variable names are the paths of the secrets
+type envFileSecretsProvider struct {
+	secrets map[string]string
+}
+
+func newEnvFileSecretsProvider(secrets map[string]string) *envFileSecretsProvider {
+	return &envFileSecretsProvider{
+		secrets: secrets,
+	}
+}
+
+func (provider *envFileSecretsProvider) GetSecret(_ context.Context, secretPath string) (string, error) {
+	value, found := provider.secrets[secretPath]
+	if !found {
+		return "", stacktrace.NewError("No secret '%v' was found in the env file of the secrets provider", secretPath)
+	}
+	return value, nil
+}
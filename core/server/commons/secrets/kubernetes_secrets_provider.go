@@ -0,0 +1,60 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/stacktrace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// kubernetesSecretsProvider resolves the secrets from the Kubernetes Secrets of a namespace, with the service account
+// of the API container. A secret path is the name of a Kubernetes Secret followed by one of its keys, e.g.
+// 'database/password'
+type kubernetesSecretsProvider struct {
+	clientSet kubernetes.Interface
+
+	namespace string
+}
+
+func newInClusterKubernetesSecretsProvider(namespace string) (*kubernetesSecretsProvider, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the config of the Kubernetes cluster the API container runs in")
+	}
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes client")
+	}
+	return newKubernetesSecretsProvider(clientSet, namespace), nil
+}
+
+func newKubernetesSecretsProvider(clientSet kubernetes.Interface, namespace string) *kubernetesSecretsProvider {
+	return &kubernetesSecretsProvider{
+		clientSet: clientSet,
+		namespace: namespace,
+	}
+}
+
+func (provider *kubernetesSecretsProvider) GetSecret(ctx context.Context, secretPath string) (string, error) {
+	secretName, key, err := splitSecretPath(secretPath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing secret path '%v'", secretPath)
+	}
+	secret, err := provider.clientSet.CoreV1().Secrets(provider.namespace).Get(ctx, secretName, metav1.GetOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ResourceVersion: "",
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting Kubernetes Secret '%v' in namespace '%v'", secretName, provider.namespace)
+	}
+	value, found := secret.Data[key]
+	if !found {
+		return "", stacktrace.NewError("Kubernetes Secret '%v' in namespace '%v' has no key '%v'", secretName, provider.namespace, key)
+	}
+	return string(value), nil
+}
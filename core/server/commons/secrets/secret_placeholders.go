@@ -0,0 +1,108 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// SecretPlaceholderFormat is what kurtosis.secret returns for a secret path. The placeholders are only replaced by
+	// the value of the secret in the environment variables of the services, when the services are started, so the
+	// values never appear in the plans
+	SecretPlaceholderFormat = "{{kurtosis:secret:%v}}"
+
+	// SecretPathRegexp is what a secret path is made of
+	SecretPathRegexp = "[a-zA-Z0-9-_./]+"
+
+	secretPathSubgroupName = "secret_path"
+)
+
+var compiledSecretPlaceholderRegex = regexp.MustCompile(
+	"\\{\\{kurtosis:secret:(?P<" + secretPathSubgroupName + ">" + SecretPathRegexp + ")\\}\\}",
+)
+
+func NewSecretPlaceholder(secretPath string) string {
+	return fmt.Sprintf(SecretPlaceholderFormat, secretPath)
+}
+
+func ContainsSecretPlaceholder(str string) bool {
+	return compiledSecretPlaceholderRegex.MatchString(str)
+}
+
+// ReplaceSecretsInString replaces the secret placeholders of the string with the values of the secrets, returning
+// whether the string had any
+func ReplaceSecretsInString(ctx context.Context, str string, provider SecretsProvider) (string, bool, error) {
+	if !ContainsSecretPlaceholder(str) {
+		return str, false, nil
+	}
+	secretPathMatchIndex := compiledSecretPlaceholderRegex.SubexpIndex(secretPathSubgroupName)
+	var replacementErr error
+	replacedStr := compiledSecretPlaceholderRegex.ReplaceAllStringFunc(str, func(placeholder string) string {
+		if replacementErr != nil {
+			return placeholder
+		}
+		secretPath := compiledSecretPlaceholderRegex.FindStringSubmatch(placeholder)[secretPathMatchIndex]
+		if provider == nil {
+			replacementErr = stacktrace.NewError("Secret '%v' is referenced but no secrets provider is configured; set the 'secrets-provider' of the cluster in the Kurtosis config", secretPath)
+			return placeholder
+		}
+		value, err := provider.GetSecret(ctx, secretPath)
+		if err != nil {
+			replacementErr = stacktrace.Propagate(err, "An error occurred getting the value of secret '%v'", secretPath)
+			return placeholder
+		}
+		return value
+	})
+	if replacementErr != nil {
+		return "", false, replacementErr
+	}
+	return replacedStr, true, nil
+}
+
+// ResolveSecretsInServiceConfig returns the config the service is started with, whose environment variables carry the
+// values of the secrets they reference. The config is copied rather than modified, so that the values of the secrets
+// aren't kept with the service registration and are read again every time the service starts
+func ResolveSecretsInServiceConfig(ctx context.Context, serviceConfig *service.ServiceConfig, provider SecretsProvider) (*service.ServiceConfig, error) {
+	if !hasSecretPlaceholders(serviceConfig) {
+		return serviceConfig, nil
+	}
+	serializedServiceConfig, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the service config to copy it")
+	}
+	var resolvedServiceConfig service.ServiceConfig
+	if err := json.Unmarshal(serializedServiceConfig, &resolvedServiceConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the copy of the service config")
+	}
+	resolvedEnvVars := map[string]string{}
+	secretEnvVarNames := []string{}
+	for envVarName, envVarValue := range serviceConfig.GetEnvVars() {
+		resolvedEnvVarValue, hasSecrets, err := ReplaceSecretsInString(ctx, envVarValue, provider)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets of environment variable '%v'", envVarName)
+		}
+		if hasSecrets {
+			secretEnvVarNames = append(secretEnvVarNames, envVarName)
+		}
+		resolvedEnvVars[envVarName] = resolvedEnvVarValue
+	}
+	sort.Strings(secretEnvVarNames)
+	resolvedServiceConfig.SetEnvVars(resolvedEnvVars)
+	resolvedServiceConfig.SetSecretEnvVars(secretEnvVarNames)
+	return &resolvedServiceConfig, nil
+}
+
+func hasSecretPlaceholders(serviceConfig *service.ServiceConfig) bool {
+	for _, envVarValue := range serviceConfig.GetEnvVars() {
+		if ContainsSecretPlaceholder(envVarValue) {
+			return true
+		}
+	}
+	return false
+}
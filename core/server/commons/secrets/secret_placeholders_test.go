@@ -0,0 +1,53 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceSecretsInString(t *testing.T) {
+	provider := newEnvFileSecretsProvider(map[string]string{"DB_USER": "admin", "DB_PASSWORD": "hunter2"})
+
+	replacedStr, hasSecrets, err := ReplaceSecretsInString(context.Background(), "postgres://"+NewSecretPlaceholder("DB_USER")+":"+NewSecretPlaceholder("DB_PASSWORD")+"@db", provider)
+	require.NoError(t, err)
+	require.True(t, hasSecrets)
+	require.Equal(t, "postgres://admin:hunter2@db", replacedStr)
+
+	replacedStr, hasSecrets, err = ReplaceSecretsInString(context.Background(), "no secret", provider)
+	require.NoError(t, err)
+	require.False(t, hasSecrets)
+	require.Equal(t, "no secret", replacedStr)
+
+	_, _, err = ReplaceSecretsInString(context.Background(), NewSecretPlaceholder("API_KEY"), provider)
+	require.Error(t, err)
+	_, _, err = ReplaceSecretsInString(context.Background(), NewSecretPlaceholder("DB_PASSWORD"), nil)
+	require.Error(t, err)
+}
+
+func TestResolveSecretsInServiceConfig(t *testing.T) {
+	provider := newEnvFileSecretsProvider(map[string]string{"DB_PASSWORD": "hunter2"})
+	envVars := map[string]string{"PASSWORD": NewSecretPlaceholder("DB_PASSWORD"), "USER": "admin"}
+	serviceConfig, err := service.CreateServiceConfig("postgres", nil, nil, nil, nil, nil, nil, nil, envVars, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true)
+	require.NoError(t, err)
+
+	resolvedServiceConfig, err := ResolveSecretsInServiceConfig(context.Background(), serviceConfig, provider)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"PASSWORD": "hunter2", "USER": "admin"}, resolvedServiceConfig.GetEnvVars())
+	require.Equal(t, []string{"PASSWORD"}, resolvedServiceConfig.GetSecretEnvVars())
+	require.Equal(t, "postgres", resolvedServiceConfig.GetContainerImageName())
+	// The config kept with the service registration still references the secret
+	require.Equal(t, NewSecretPlaceholder("DB_PASSWORD"), serviceConfig.GetEnvVars()["PASSWORD"])
+	require.Empty(t, serviceConfig.GetSecretEnvVars())
+
+	// The configs without secrets are started as they are
+	resolvedServiceConfig, err = ResolveSecretsInServiceConfig(context.Background(), resolvedServiceConfig, provider)
+	require.NoError(t, err)
+	require.Equal(t, []string{"PASSWORD"}, resolvedServiceConfig.GetSecretEnvVars())
+
+	_, err = ResolveSecretsInServiceConfig(context.Background(), serviceConfig, nil)
+	require.Error(t, err)
+}
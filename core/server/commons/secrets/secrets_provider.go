@@ -0,0 +1,53 @@
+package secrets
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	secretPathSeparator = "/"
+)
+
+// SecretsProvider resolves the secrets that Starlark references with kurtosis.secret
+type SecretsProvider interface {
+	// GetSecret returns the value of the secret at the path, erroring if there's none
+	GetSecret(ctx context.Context, secretPath string) (string, error)
+}
+
+// GetSecretsProvider returns the provider matching the config, or nil if the secrets can't be resolved
+func GetSecretsProvider(config secrets_provider.SecretsProviderConfig) (SecretsProvider, error) {
+	if !config.IsConfigured() {
+		return nil, nil
+	}
+	if err := config.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the secrets provider config")
+	}
+	switch config.Type {
+	case secrets_provider.SecretsProviderType_EnvFile:
+		return newEnvFileSecretsProvider(config.EnvFileSecrets), nil
+	case secrets_provider.SecretsProviderType_Vault:
+		return newVaultSecretsProvider(config.VaultAddress, config.VaultToken, config.GetVaultMount()), nil
+	case secrets_provider.SecretsProviderType_Kubernetes:
+		kubernetesSecretsProvider, err := newInClusterKubernetesSecretsProvider(config.Namespace)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the provider reading the secrets from namespace '%v'", config.Namespace)
+		}
+		return kubernetesSecretsProvider, nil
+	default:
+		return nil, stacktrace.NewError("Unrecognized secrets provider type '%v'", config.Type)
+	}
+}
+
+// splitSecretPath splits the path of a secret stored in Kubernetes or Vault, e.g. 'database/password', into the name
+// of the object holding the secret and its key in the object
+func splitSecretPath(secretPath string) (string, string, error) {
+	separatorIndex := strings.LastIndex(secretPath, secretPathSeparator)
+	if separatorIndex <= 0 || separatorIndex == len(secretPath)-1 {
+		return "", "", stacktrace.NewError("Secret path '%v' isn't of the form 'NAME%vKEY'", secretPath, secretPathSeparator)
+	}
+	return secretPath[:separatorIndex], secretPath[separatorIndex+1:], nil
+}
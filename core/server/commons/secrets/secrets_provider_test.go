@@ -0,0 +1,112 @@
+package secrets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	testVaultToken = "vault-token"
+	testNamespace  = "secrets"
+)
+
+func TestGetSecretsProvider_NotConfigured(t *testing.T) {
+	provider, err := GetSecretsProvider(secrets_provider.NewNoSecretsProviderConfig())
+	require.NoError(t, err)
+	require.Nil(t, provider)
+}
+
+func TestEnvFileSecretsProvider(t *testing.T) {
+	config := secrets_provider.NewNoSecretsProviderConfig()
+	config.Type = secrets_provider.SecretsProviderType_EnvFile
+	config.EnvFileSecrets = map[string]string{"DB_PASSWORD": "hunter2"}
+	provider, err := GetSecretsProvider(config)
+	require.NoError(t, err)
+
+	value, err := provider.GetSecret(context.Background(), "DB_PASSWORD")
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", value)
+	_, err = provider.GetSecret(context.Background(), "API_KEY")
+	require.Error(t, err)
+}
+
+func TestVaultSecretsProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get(vaultTokenHeader) != testVaultToken {
+			writer.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if request.URL.Path != "/v1/kv/data/team/database" {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = writer.Write([]byte(`{"data": {"data": {"password": "hunter2", "port": 5432}, "metadata": {"version": 3}}}`))
+	}))
+	defer server.Close()
+
+	config := secrets_provider.NewNoSecretsProviderConfig()
+	config.Type = secrets_provider.SecretsProviderType_Vault
+	config.VaultAddress = server.URL + "/"
+	config.VaultToken = testVaultToken
+	config.VaultMount = "/kv/"
+	provider, err := GetSecretsProvider(config)
+	require.NoError(t, err)
+
+	value, err := provider.GetSecret(context.Background(), "team/database/password")
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", value)
+	_, err = provider.GetSecret(context.Background(), "team/database/user")
+	require.Error(t, err)
+	// Only the string values can be used in env vars
+	_, err = provider.GetSecret(context.Background(), "team/database/port")
+	require.Error(t, err)
+	_, err = provider.GetSecret(context.Background(), "team/cache/password")
+	require.Error(t, err)
+	_, err = provider.GetSecret(context.Background(), "password")
+	require.Error(t, err)
+
+	provider = newVaultSecretsProvider(server.URL, "wrong-token", "kv")
+	_, err = provider.GetSecret(context.Background(), "team/database/password")
+	require.Error(t, err)
+}
+
+func TestKubernetesSecretsProvider(t *testing.T) {
+	clientSet := fake.NewSimpleClientset(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "database", Namespace: testNamespace},
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+	})
+	provider := newKubernetesSecretsProvider(clientSet, testNamespace)
+
+	value, err := provider.GetSecret(context.Background(), "database/password")
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", value)
+	_, err = provider.GetSecret(context.Background(), "database/user")
+	require.Error(t, err)
+	_, err = provider.GetSecret(context.Background(), "cache/password")
+	require.Error(t, err)
+
+	// The Secrets of the other namespaces can't be read
+	provider = newKubernetesSecretsProvider(clientSet, "other")
+	_, err = provider.GetSecret(context.Background(), "database/password")
+	require.Error(t, err)
+}
+
+func TestSplitSecretPath(t *testing.T) {
+	name, key, err := splitSecretPath("team/database/password")
+	require.NoError(t, err)
+	require.Equal(t, "team/database", name)
+	require.Equal(t, "password", key)
+
+	for _, invalidSecretPath := range []string{"password", "/password", "database/"} {
+		_, _, err = splitSecretPath(invalidSecretPath)
+		require.Error(t, err, invalidSecretPath)
+	}
+}
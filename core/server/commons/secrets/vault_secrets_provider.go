@@ -0,0 +1,90 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	vaultTokenHeader = "X-Vault-Token"
+
+	// The KV version 2 secrets engine serves the latest version of a secret under the 'data' path of its mount
+	vaultKvV2SecretUrlFormat = "%v/v1/%v/data/%v"
+
+	vaultRequestTimeout = 30 * time.Second
+
+	// Vault returns its errors in small JSON bodies, which are only read up to this size
+	maxVaultErrorBodyBytes = 4096
+)
+
+type vaultKvV2Response struct {
+	Data struct {
+		Data map[string]interface{} `json:"data"`
+	} `json:"data"`
+}
+
+// vaultSecretsProvider resolves the secrets from the KV version 2 secrets engine of a HashiCorp Vault server. A secret
+// path is the path of a Vault secret followed by one of its keys, e.g. 'database/password'
+type vaultSecretsProvider struct {
+	address string
+	token   string
+	mount   string
+
+	httpClient *http.Client
+}
+
+func newVaultSecretsProvider(address string, token string, mount string) *vaultSecretsProvider {
+	return &vaultSecretsProvider{
+		address: strings.TrimRight(address, "/"),
+		token:   token,
+		mount:   mount,
+		httpClient: &http.Client{
+			Transport:     nil,
+			CheckRedirect: nil,
+			Jar:           nil,
+			Timeout:       vaultRequestTimeout,
+		},
+	}
+}
+
+func (provider *vaultSecretsProvider) GetSecret(ctx context.Context, secretPath string) (string, error) {
+	vaultSecretPath, key, err := splitSecretPath(secretPath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing secret path '%v'", secretPath)
+	}
+	url := fmt.Sprintf(vaultKvV2SecretUrlFormat, provider.address, provider.mount, vaultSecretPath)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating the request reading Vault secret '%v'", vaultSecretPath)
+	}
+	request.Header.Set(vaultTokenHeader, provider.token)
+	response, err := provider.httpClient.Do(request)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading Vault secret '%v'", vaultSecretPath)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		errorBody, _ := io.ReadAll(io.LimitReader(response.Body, maxVaultErrorBodyBytes))
+		return "", stacktrace.NewError("Vault answered status '%v' when reading secret '%v': %v", response.Status, vaultSecretPath, strings.TrimSpace(string(errorBody)))
+	}
+	var secret vaultKvV2Response
+	if err := json.NewDecoder(response.Body).Decode(&secret); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred decoding Vault secret '%v'", vaultSecretPath)
+	}
+	value, found := secret.Data.Data[key]
+	if !found {
+		return "", stacktrace.NewError("Vault secret '%v' has no key '%v'", vaultSecretPath, key)
+	}
+	stringValue, isString := value.(string)
+	if !isString {
+		return "", stacktrace.NewError("Key '%v' of Vault secret '%v' isn't a string", key, vaultSecretPath)
+	}
+	return stringValue, nil
+}
@@ -0,0 +1,120 @@
+package web_files_downloader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	sha256HexLength = 2 * sha256.Size
+)
+
+// WebFilesDownloader downloads files from the web into a content-addressed cache, so that content pinned with its
+// SHA-256 is only downloaded once per enclave
+type WebFilesDownloader struct {
+	cache *enclave_data_directory.FileCache
+
+	httpClient *http.Client
+}
+
+func NewWebFilesDownloader(cache *enclave_data_directory.FileCache, httpClient *http.Client) *WebFilesDownloader {
+	return &WebFilesDownloader{
+		cache:      cache,
+		httpClient: httpClient,
+	}
+}
+
+// Download returns the cached file holding the response body of the request. If maybeContentSha256 is set, the content
+// must have this hex-encoded SHA-256, and the request isn't sent at all if content with this checksum was already
+// downloaded
+func (downloader *WebFilesDownloader) Download(request *http.Request, maybeContentSha256 string) (*enclave_data_directory.EnclaveDataDirFile, error) {
+	expectedContentSha256 := strings.ToLower(maybeContentSha256)
+	if expectedContentSha256 != "" {
+		if err := validateContentSha256(expectedContentSha256); err != nil {
+			return nil, stacktrace.Propagate(err, "The checksum of the content to download from '%v' is invalid", request.URL)
+		}
+		if downloader.cache.HasFile(expectedContentSha256) {
+			logrus.Debugf("Content with SHA-256 '%v' was already downloaded, reusing it instead of downloading '%v'", expectedContentSha256, request.URL)
+			cachedFile, err := downloader.cache.GetFile(expectedContentSha256)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the cached content with SHA-256 '%v'", expectedContentSha256)
+			}
+			return cachedFile, nil
+		}
+	}
+
+	// The download is staged in the cache directory itself, so that it's moved rather than copied into the cache
+	stagingFile, err := downloader.cache.CreateStagingFile()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a staging file to download '%v' into", request.URL)
+	}
+	defer func() {
+		stagingFile.Close()
+		// No-op if the staging file was added to the cache
+		os.Remove(stagingFile.Name())
+	}()
+
+	contentSha256, err := downloader.downloadTo(request, stagingFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading '%v'", request.URL)
+	}
+	if expectedContentSha256 != "" && contentSha256 != expectedContentSha256 {
+		return nil, stacktrace.NewError("The content downloaded from '%v' has SHA-256 '%v', but '%v' was expected", request.URL, contentSha256, expectedContentSha256)
+	}
+
+	// The same content might have been downloaded before without pinning its checksum
+	if downloader.cache.HasFile(contentSha256) {
+		cachedFile, err := downloader.cache.GetFile(contentSha256)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the cached content with SHA-256 '%v'", contentSha256)
+		}
+		return cachedFile, nil
+	}
+
+	if err := stagingFile.Close(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred closing the file downloaded from '%v'", request.URL)
+	}
+	cachedFile, err := downloader.cache.AddStagedFile(contentSha256, stagingFile.Name())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the content downloaded from '%v' to the cache", request.URL)
+	}
+	return cachedFile, nil
+}
+
+// downloadTo writes the response body, limited to what the cache can hold, to the output and returns its hex-encoded
+// SHA-256
+func (downloader *WebFilesDownloader) downloadTo(request *http.Request, output io.Writer) (string, error) {
+	response, err := downloader.httpClient.Do(request)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred making the request")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return "", stacktrace.NewError("The request failed with status '%v'", response.Status)
+	}
+
+	hasher := sha256.New()
+	body := downloader.cache.LimitToMaxFileSize(response.Body, request.URL.String())
+	if numBytesCopied, err := io.Copy(io.MultiWriter(output, hasher), body); err != nil {
+		return "", stacktrace.Propagate(err, "'%v' bytes were downloaded before an error occurred reading the response body", numBytesCopied)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func validateContentSha256(contentSha256 string) error {
+	if len(contentSha256) != sha256HexLength {
+		return stacktrace.NewError("Expected a hex-encoded SHA-256 of '%v' characters, but '%v' has '%v'", sha256HexLength, contentSha256, len(contentSha256))
+	}
+	if _, err := hex.DecodeString(contentSha256); err != nil {
+		return stacktrace.Propagate(err, "'%v' isn't hex-encoded", contentSha256)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package web_files_downloader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	testContent = "large-fixture-content"
+
+	testUsername = "user"
+	testPassword = "password"
+)
+
+func TestDownload_ReusesContentWithPinnedChecksum(t *testing.T) {
+	numRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		numRequests++
+		_, _ = writer.Write([]byte(testContent))
+	}))
+	defer server.Close()
+	downloader := getTestDownloader(t, server)
+
+	contentSha256 := getSha256(testContent)
+	downloadedFile, err := downloader.Download(newRequest(t, server.URL), contentSha256)
+	require.NoError(t, err)
+	requireFileContent(t, downloadedFile, testContent)
+
+	cachedFile, err := downloader.Download(newRequest(t, server.URL), contentSha256)
+	require.NoError(t, err)
+	requireFileContent(t, cachedFile, testContent)
+	require.Equal(t, 1, numRequests)
+}
+
+func TestDownload_StagesDownloadInCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte(testContent))
+	}))
+	defer server.Close()
+	downloader := getTestDownloader(t, server)
+
+	downloadedFile, err := downloader.Download(newRequest(t, server.URL), "")
+	require.NoError(t, err)
+	_, err = downloader.Download(newRequest(t, server.URL), "")
+	require.NoError(t, err)
+
+	// The second download is staged next to the first one and dropped as its content is already cached
+	cacheDirEntries, err := os.ReadDir(filepath.Dir(downloadedFile.GetAbsoluteFilepath()))
+	require.NoError(t, err)
+	require.Len(t, cacheDirEntries, 1)
+	require.Equal(t, getSha256(testContent), cacheDirEntries[0].Name())
+}
+
+func TestDownload_FailsOverEnclaveQuota(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte(testContent))
+	}))
+	defer server.Close()
+	cacheDirpath := t.TempDir()
+	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectoryWithRemoteFileStore(cacheDirpath, nil, uint64(len(testContent)-1))
+	cache, err := enclaveDataDir.GetWebDownloadCache()
+	require.NoError(t, err)
+	downloader := NewWebFilesDownloader(cache, server.Client())
+
+	_, err = downloader.Download(newRequest(t, server.URL), "")
+	require.Error(t, err)
+	_, isQuotaErr := enclave_data_directory.GetFilesArtifactsQuotaExceededError(err)
+	require.True(t, isQuotaErr)
+
+	cacheDirEntries, err := os.ReadDir(filepath.Join(cacheDirpath, "web-download-cache"))
+	require.NoError(t, err)
+	require.Empty(t, cacheDirEntries)
+}
+
+func TestDownload_DownloadsAgainWithoutPinnedChecksum(t *testing.T) {
+	numRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		numRequests++
+		_, _ = writer.Write([]byte(testContent))
+	}))
+	defer server.Close()
+	downloader := getTestDownloader(t, server)
+
+	_, err := downloader.Download(newRequest(t, server.URL), "")
+	require.NoError(t, err)
+	downloadedFile, err := downloader.Download(newRequest(t, server.URL), "")
+	require.NoError(t, err)
+	requireFileContent(t, downloadedFile, testContent)
+	require.Equal(t, 2, numRequests)
+}
+
+func TestDownload_FailsOnChecksumMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte(testContent))
+	}))
+	defer server.Close()
+	downloader := getTestDownloader(t, server)
+
+	_, err := downloader.Download(newRequest(t, server.URL), getSha256("some-other-content"))
+	require.Error(t, err)
+}
+
+func TestDownload_FailsOnInvalidChecksum(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte(testContent))
+	}))
+	defer server.Close()
+	downloader := getTestDownloader(t, server)
+
+	_, err := downloader.Download(newRequest(t, server.URL), "not-a-checksum")
+	require.Error(t, err)
+}
+
+func TestDownload_FailsOnErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		username, password, ok := request.BasicAuth()
+		if !ok || username != testUsername || password != testPassword {
+			writer.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = writer.Write([]byte(testContent))
+	}))
+	defer server.Close()
+	downloader := getTestDownloader(t, server)
+
+	_, err := downloader.Download(newRequest(t, server.URL), "")
+	require.Error(t, err)
+
+	authenticatedRequest := newRequest(t, server.URL)
+	authenticatedRequest.SetBasicAuth(testUsername, testPassword)
+	downloadedFile, err := downloader.Download(authenticatedRequest, "")
+	require.NoError(t, err)
+	requireFileContent(t, downloadedFile, testContent)
+}
+
+func getTestDownloader(t *testing.T, server *httptest.Server) *WebFilesDownloader {
+	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(t.TempDir())
+	cache, err := enclaveDataDir.GetWebDownloadCache()
+	require.NoError(t, err)
+	return NewWebFilesDownloader(cache, server.Client())
+}
+
+func newRequest(t *testing.T, url string) *http.Request {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	return request
+}
+
+func getSha256(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+func requireFileContent(t *testing.T, file *enclave_data_directory.EnclaveDataDirFile, expectedContent string) {
+	content, err := os.ReadFile(file.GetAbsoluteFilepath())
+	require.NoError(t, err)
+	require.Equal(t, expectedContent, string(content))
+}
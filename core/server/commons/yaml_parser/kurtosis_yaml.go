@@ -2,6 +2,7 @@ package yaml_parser
 
 import (
 	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_args_schema"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"os"
@@ -10,11 +11,13 @@ import (
 var noPackageNameFound = ""
 var naPackageDescriptionFound = ""
 var noPackageReplaceOptions = map[string]string{}
+var noPackageArgs = package_args_schema.PackageArgsSchema{}
 
 type KurtosisYaml struct {
-	PackageName           string            `yaml:"name"`
-	PackageDescription    string            `yaml:"description"`
-	PackageReplaceOptions map[string]string `yaml:"replace"`
+	PackageName           string                                `yaml:"name"`
+	PackageDescription    string                                `yaml:"description"`
+	PackageReplaceOptions map[string]string                     `yaml:"replace"`
+	PackageArgs           package_args_schema.PackageArgsSchema `yaml:"args,omitempty"`
 }
 
 func (parser *KurtosisYaml) GetPackageName() string {
@@ -38,6 +41,13 @@ func (parser *KurtosisYaml) GetPackageReplaceOptions() map[string]string {
 	return parser.PackageReplaceOptions
 }
 
+func (parser *KurtosisYaml) GetPackageArgs() package_args_schema.PackageArgsSchema {
+	if parser == nil {
+		return noPackageArgs
+	}
+	return parser.PackageArgs
+}
+
 // TODO: this parsing logic is similar to what have we in the api, maybe we should move everything into one
 // common package. This method assumes that the kurtosis.yml exists in the path provided.
 func parseKurtosisYamlInternal(absPathToKurtosisYaml string, read func(filename string) ([]byte, error)) (*KurtosisYaml, error) {
@@ -50,6 +60,9 @@ func parseKurtosisYamlInternal(absPathToKurtosisYaml string, read func(filename
 	if err = yaml.UnmarshalStrict(kurtosisYamlContent, &kurtosisYaml); err != nil {
 		return nil, stacktrace.Propagate(err, "Error occurred while analyzing the contents of '%v'", absPathToKurtosisYaml)
 	}
+	if err = kurtosisYaml.PackageArgs.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The 'args' section of '%v' is invalid", absPathToKurtosisYaml)
+	}
 	logrus.Debugf("parsed kurtosis.yml '%+v'", kurtosisYaml)
 	return &kurtosisYaml, nil
 }
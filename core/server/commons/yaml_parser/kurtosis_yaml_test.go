@@ -19,6 +19,24 @@ description: |
 replace:
   github.com/kurtosis-tech/sample-dependency-package: github.com/kurtosis-tech/another-sample-dependency-package
   github.com/ethpandaops/ethereum-package: github.com/my-forked/ethereum-package
+`)
+	sampleYamlWithArgs = []byte(`
+name: github.com/test-author/test-repo
+args:
+  - name: network
+    type: string
+    enum: [mainnet, testnet]
+    default: testnet
+  - name: node_count
+    type: int
+    required: true
+`)
+	sampleYamlWithInvalidArgDefault = []byte(`
+name: github.com/test-author/test-repo
+args:
+  - name: node_count
+    type: int
+    default: three
 `)
 	sampleInCorrectKeyYaml         = []byte(`incorrect_name_key: github.com/test/test`)
 	sampleDuplicatedReplaceKeyYaml = []byte(`
@@ -78,3 +96,29 @@ func Test_parseKurtosisYamlInternal_DuplicatedReplaceKeyYaml(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "key \"github.com/kurtosis-tech/sample-dependency-package\" already set in map")
 }
+
+func Test_parseKurtosisYamlInternal_WithArgsSuccess(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return sampleYamlWithArgs, nil
+	}
+
+	actual, err := parseKurtosisYamlInternal(kurtosisYmlPath, mockRead)
+	require.Nil(t, err)
+	packageArgs := actual.GetPackageArgs()
+	require.Len(t, packageArgs, 2)
+	require.Equal(t, "network", packageArgs[0].Name)
+	require.Equal(t, "testnet", packageArgs[0].Default)
+	require.Equal(t, []interface{}{"mainnet", "testnet"}, packageArgs[0].Enum)
+	require.Equal(t, "node_count", packageArgs[1].Name)
+	require.True(t, packageArgs[1].Required)
+}
+
+func Test_parseKurtosisYamlInternal_InvalidArgDefault(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return sampleYamlWithInvalidArgDefault, nil
+	}
+
+	_, err := parseKurtosisYamlInternal(kurtosisYmlPath, mockRead)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Default value of package argument 'node_count' is invalid")
+}
@@ -50,6 +50,9 @@ const (
 	// KurtosisEnclaveManagerServerGetServiceLogsProcedure is the fully-qualified name of the
 	// KurtosisEnclaveManagerServer's GetServiceLogs RPC.
 	KurtosisEnclaveManagerServerGetServiceLogsProcedure = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetServiceLogs"
+	// KurtosisEnclaveManagerServerGetServiceResourceUsageProcedure is the fully-qualified name of the
+	// KurtosisEnclaveManagerServer's GetServiceResourceUsage RPC.
+	KurtosisEnclaveManagerServerGetServiceResourceUsageProcedure = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetServiceResourceUsage"
 	// KurtosisEnclaveManagerServerListFilesArtifactNamesAndUuidsProcedure is the fully-qualified name
 	// of the KurtosisEnclaveManagerServer's ListFilesArtifactNamesAndUuids RPC.
 	KurtosisEnclaveManagerServerListFilesArtifactNamesAndUuidsProcedure = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/ListFilesArtifactNamesAndUuids"
@@ -110,6 +113,7 @@ type KurtosisEnclaveManagerServerClient interface {
 	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	GetServices(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.GetServicesRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServicesResponse], error)
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse], error)
+	GetServiceResourceUsage(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.GetListFilesArtifactNamesAndUuidsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	RunStarlarkPackage(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.RunStarlarkPackageRequest]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error)
 	RunStarlarkScript(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.RunStarlarkScriptRequest]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error)
@@ -160,6 +164,11 @@ func NewKurtosisEnclaveManagerServerClient(httpClient connect.HTTPClient, baseUR
 			baseURL+KurtosisEnclaveManagerServerGetServiceLogsProcedure,
 			opts...,
 		),
+		getServiceResourceUsage: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs, kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse](
+			httpClient,
+			baseURL+KurtosisEnclaveManagerServerGetServiceResourceUsageProcedure,
+			opts...,
+		),
 		listFilesArtifactNamesAndUuids: connect.NewClient[kurtosis_enclave_manager_api_bindings.GetListFilesArtifactNamesAndUuidsRequest, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse](
 			httpClient,
 			baseURL+KurtosisEnclaveManagerServerListFilesArtifactNamesAndUuidsProcedure,
@@ -254,6 +263,7 @@ type kurtosisEnclaveManagerServerClient struct {
 	getEnclaves                    *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
 	getServices                    *connect.Client[kurtosis_enclave_manager_api_bindings.GetServicesRequest, kurtosis_core_rpc_api_bindings.GetServicesResponse]
 	getServiceLogs                 *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]
+	getServiceResourceUsage        *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs, kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse]
 	listFilesArtifactNamesAndUuids *connect.Client[kurtosis_enclave_manager_api_bindings.GetListFilesArtifactNamesAndUuidsRequest, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse]
 	runStarlarkPackage             *connect.Client[kurtosis_enclave_manager_api_bindings.RunStarlarkPackageRequest, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
 	runStarlarkScript              *connect.Client[kurtosis_enclave_manager_api_bindings.RunStarlarkScriptRequest, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
@@ -293,6 +303,12 @@ func (c *kurtosisEnclaveManagerServerClient) GetServiceLogs(ctx context.Context,
 	return c.getServiceLogs.CallServerStream(ctx, req)
 }
 
+// GetServiceResourceUsage calls
+// kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetServiceResourceUsage.
+func (c *kurtosisEnclaveManagerServerClient) GetServiceResourceUsage(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error) {
+	return c.getServiceResourceUsage.CallUnary(ctx, req)
+}
+
 // ListFilesArtifactNamesAndUuids calls
 // kurtosis_enclave_manager.KurtosisEnclaveManagerServer.ListFilesArtifactNamesAndUuids.
 func (c *kurtosisEnclaveManagerServerClient) ListFilesArtifactNamesAndUuids(ctx context.Context, req *connect.Request[kurtosis_enclave_manager_api_bindings.GetListFilesArtifactNamesAndUuidsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error) {
@@ -395,6 +411,7 @@ type KurtosisEnclaveManagerServerHandler interface {
 	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	GetServices(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.GetServicesRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServicesResponse], error)
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error
+	GetServiceResourceUsage(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.GetListFilesArtifactNamesAndUuidsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	RunStarlarkPackage(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.RunStarlarkPackageRequest], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error
 	RunStarlarkScript(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.RunStarlarkScriptRequest], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error
@@ -440,6 +457,11 @@ func NewKurtosisEnclaveManagerServerHandler(svc KurtosisEnclaveManagerServerHand
 		svc.GetServiceLogs,
 		opts...,
 	)
+	kurtosisEnclaveManagerServerGetServiceResourceUsageHandler := connect.NewUnaryHandler(
+		KurtosisEnclaveManagerServerGetServiceResourceUsageProcedure,
+		svc.GetServiceResourceUsage,
+		opts...,
+	)
 	kurtosisEnclaveManagerServerListFilesArtifactNamesAndUuidsHandler := connect.NewUnaryHandler(
 		KurtosisEnclaveManagerServerListFilesArtifactNamesAndUuidsProcedure,
 		svc.ListFilesArtifactNamesAndUuids,
@@ -535,6 +557,8 @@ func NewKurtosisEnclaveManagerServerHandler(svc KurtosisEnclaveManagerServerHand
 			kurtosisEnclaveManagerServerGetServicesHandler.ServeHTTP(w, r)
 		case KurtosisEnclaveManagerServerGetServiceLogsProcedure:
 			kurtosisEnclaveManagerServerGetServiceLogsHandler.ServeHTTP(w, r)
+		case KurtosisEnclaveManagerServerGetServiceResourceUsageProcedure:
+			kurtosisEnclaveManagerServerGetServiceResourceUsageHandler.ServeHTTP(w, r)
 		case KurtosisEnclaveManagerServerListFilesArtifactNamesAndUuidsProcedure:
 			kurtosisEnclaveManagerServerListFilesArtifactNamesAndUuidsHandler.ServeHTTP(w, r)
 		case KurtosisEnclaveManagerServerRunStarlarkPackageProcedure:
@@ -594,6 +618,10 @@ func (UnimplementedKurtosisEnclaveManagerServerHandler) GetServiceLogs(context.C
 	return connect.NewError(connect.CodeUnimplemented, errors.New("kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetServiceLogs is not implemented"))
 }
 
+func (UnimplementedKurtosisEnclaveManagerServerHandler) GetServiceResourceUsage(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetServiceResourceUsage is not implemented"))
+}
+
 func (UnimplementedKurtosisEnclaveManagerServerHandler) ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.GetListFilesArtifactNamesAndUuidsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kurtosis_enclave_manager.KurtosisEnclaveManagerServer.ListFilesArtifactNamesAndUuids is not implemented"))
 }
@@ -27,6 +27,7 @@ const (
 	KurtosisEnclaveManagerServer_GetEnclaves_FullMethodName                    = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetEnclaves"
 	KurtosisEnclaveManagerServer_GetServices_FullMethodName                    = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetServices"
 	KurtosisEnclaveManagerServer_GetServiceLogs_FullMethodName                 = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetServiceLogs"
+	KurtosisEnclaveManagerServer_GetServiceResourceUsage_FullMethodName        = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetServiceResourceUsage"
 	KurtosisEnclaveManagerServer_ListFilesArtifactNamesAndUuids_FullMethodName = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/ListFilesArtifactNamesAndUuids"
 	KurtosisEnclaveManagerServer_RunStarlarkPackage_FullMethodName             = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/RunStarlarkPackage"
 	KurtosisEnclaveManagerServer_RunStarlarkScript_FullMethodName              = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/RunStarlarkScript"
@@ -54,6 +55,7 @@ type KurtosisEnclaveManagerServerClient interface {
 	GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error)
 	GetServices(ctx context.Context, in *GetServicesRequest, opts ...grpc.CallOption) (*kurtosis_core_rpc_api_bindings.GetServicesResponse, error)
 	GetServiceLogs(ctx context.Context, in *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, opts ...grpc.CallOption) (KurtosisEnclaveManagerServer_GetServiceLogsClient, error)
+	GetServiceResourceUsage(ctx context.Context, in *kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs, opts ...grpc.CallOption) (*kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse, error)
 	ListFilesArtifactNamesAndUuids(ctx context.Context, in *GetListFilesArtifactNamesAndUuidsRequest, opts ...grpc.CallOption) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse, error)
 	RunStarlarkPackage(ctx context.Context, in *RunStarlarkPackageRequest, opts ...grpc.CallOption) (KurtosisEnclaveManagerServer_RunStarlarkPackageClient, error)
 	RunStarlarkScript(ctx context.Context, in *RunStarlarkScriptRequest, opts ...grpc.CallOption) (KurtosisEnclaveManagerServer_RunStarlarkScriptClient, error)
@@ -140,6 +142,15 @@ func (x *kurtosisEnclaveManagerServerGetServiceLogsClient) Recv() (*kurtosis_eng
 	return m, nil
 }
 
+func (c *kurtosisEnclaveManagerServerClient) GetServiceResourceUsage(ctx context.Context, in *kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs, opts ...grpc.CallOption) (*kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse, error) {
+	out := new(kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse)
+	err := c.cc.Invoke(ctx, KurtosisEnclaveManagerServer_GetServiceResourceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kurtosisEnclaveManagerServerClient) ListFilesArtifactNamesAndUuids(ctx context.Context, in *GetListFilesArtifactNamesAndUuidsRequest, opts ...grpc.CallOption) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse, error) {
 	out := new(kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse)
 	err := c.cc.Invoke(ctx, KurtosisEnclaveManagerServer_ListFilesArtifactNamesAndUuids_FullMethodName, in, out, opts...)
@@ -370,6 +381,7 @@ type KurtosisEnclaveManagerServerServer interface {
 	GetEnclaves(context.Context, *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error)
 	GetServices(context.Context, *GetServicesRequest) (*kurtosis_core_rpc_api_bindings.GetServicesResponse, error)
 	GetServiceLogs(*kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, KurtosisEnclaveManagerServer_GetServiceLogsServer) error
+	GetServiceResourceUsage(context.Context, *kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs) (*kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse, error)
 	ListFilesArtifactNamesAndUuids(context.Context, *GetListFilesArtifactNamesAndUuidsRequest) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse, error)
 	RunStarlarkPackage(*RunStarlarkPackageRequest, KurtosisEnclaveManagerServer_RunStarlarkPackageServer) error
 	RunStarlarkScript(*RunStarlarkScriptRequest, KurtosisEnclaveManagerServer_RunStarlarkScriptServer) error
@@ -405,6 +417,9 @@ func (UnimplementedKurtosisEnclaveManagerServerServer) GetServices(context.Conte
 func (UnimplementedKurtosisEnclaveManagerServerServer) GetServiceLogs(*kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, KurtosisEnclaveManagerServer_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedKurtosisEnclaveManagerServerServer) GetServiceResourceUsage(context.Context, *kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs) (*kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceResourceUsage not implemented")
+}
 func (UnimplementedKurtosisEnclaveManagerServerServer) ListFilesArtifactNamesAndUuids(context.Context, *GetListFilesArtifactNamesAndUuidsRequest) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFilesArtifactNamesAndUuids not implemented")
 }
@@ -543,6 +558,24 @@ func (x *kurtosisEnclaveManagerServerGetServiceLogsServer) Send(m *kurtosis_engi
 	return x.ServerStream.SendMsg(m)
 }
 
+func _KurtosisEnclaveManagerServer_GetServiceResourceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KurtosisEnclaveManagerServerServer).GetServiceResourceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KurtosisEnclaveManagerServer_GetServiceResourceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KurtosisEnclaveManagerServerServer).GetServiceResourceUsage(ctx, req.(*kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KurtosisEnclaveManagerServer_ListFilesArtifactNamesAndUuids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetListFilesArtifactNamesAndUuidsRequest)
 	if err := dec(in); err != nil {
@@ -877,6 +910,10 @@ var KurtosisEnclaveManagerServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetServices",
 			Handler:    _KurtosisEnclaveManagerServer_GetServices_Handler,
 		},
+		{
+			MethodName: "GetServiceResourceUsage",
+			Handler:    _KurtosisEnclaveManagerServer_GetServiceResourceUsage_Handler,
+		},
 		{
 			MethodName: "ListFilesArtifactNamesAndUuids",
 			Handler:    _KurtosisEnclaveManagerServer_ListFilesArtifactNamesAndUuids_Handler,
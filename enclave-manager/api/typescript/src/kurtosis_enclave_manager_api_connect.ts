@@ -5,7 +5,7 @@
 
 import { AddAliasRequest, CreateRepositoryWebhookRequest, DownloadFilesArtifactRequest, GetCloudInstanceConfigRequest, GetListFilesArtifactNamesAndUuidsRequest, GetServicesRequest, GetStarlarkRunRequest, HealthCheckRequest, HealthCheckResponse, InspectFilesArtifactContentsRequest, LockUnlockPortRequest, RunStarlarkPackageRequest, RunStarlarkScriptRequest, StarlarkPackagePlanYamlArgs, StarlarkScriptPlanYamlArgs } from "./kurtosis_enclave_manager_api_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetServiceLogsArgs, GetServiceLogsResponse } from "./engine_service_pb.js";
+import { CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetServiceLogsArgs, GetServiceLogsResponse, GetServiceResourceUsageArgs, GetServiceResourceUsageResponse } from "./engine_service_pb.js";
 import { GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, StarlarkRunResponseLine, StreamedDataChunk } from "./api_container_service_pb.js";
 import { GetCloudInstanceConfigResponse, IsNewKurtosisVersionAvailableResponse } from "./kurtosis_backend_server_api_pb.js";
 
@@ -51,6 +51,15 @@ export const KurtosisEnclaveManagerServer = {
       O: GetServiceLogsResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * @generated from rpc kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetServiceResourceUsage
+     */
+    getServiceResourceUsage: {
+      name: "GetServiceResourceUsage",
+      I: GetServiceResourceUsageArgs,
+      O: GetServiceResourceUsageResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc kurtosis_enclave_manager.KurtosisEnclaveManagerServer.ListFilesArtifactNamesAndUuids
      */
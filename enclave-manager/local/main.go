@@ -9,7 +9,10 @@ const (
 	enforceAuth = false
 )
 
+// The local enclave manager doesn't ask to log in
+var userAuthenticator *server.UserAuthenticator = nil
+
 func main() {
 	logrus.Info("Running the enclave manager from the enclave manager main package.")
-	server.RunEnclaveManagerApiServer(enforceAuth)
+	server.RunEnclaveManagerApiServer(enforceAuth, userAuthenticator)
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/api_container_tls"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings/kurtosis_core_rpc_api_bindingsconnect"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
@@ -37,6 +38,7 @@ const (
 	numberOfElementsHostString = 2
 	slashSeparator             = "/"
 	shortUuidLength            = 12
+	rootPath                   = "/"
 )
 
 type Authentication struct {
@@ -305,7 +307,7 @@ func (c *WebServer) GetServices(ctx context.Context, req *connect.Request[kurtos
 	if !isValidRequest {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -528,6 +530,21 @@ func (c *WebServer) GetServiceLogs(
 	return nil
 }
 
+func (c *WebServer) GetServiceResourceUsage(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error) {
+	isValidRequest, _, err := c.ValidateRequestAuthorization(ctx, c.enforceAuth, req.Header())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Authentication attempt failed")
+	}
+	if !isValidRequest {
+		return nil, stacktrace.Propagate(err, "User not authorized")
+	}
+	resourceUsage, err := (*c.engineServiceClient).GetServiceResourceUsage(ctx, req)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the resource usage of the services of enclave '%v' from the engine", req.Msg.GetEnclaveIdentifier())
+	}
+	return resourceUsage, nil
+}
+
 func (c *WebServer) ListFilesArtifactNamesAndUuids(ctx context.Context, req *connect.Request[kurtosis_enclave_manager_api_bindings.GetListFilesArtifactNamesAndUuidsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error) {
 	isValidRequest, _, err := c.ValidateRequestAuthorization(ctx, c.enforceAuth, req.Header())
 	if err != nil {
@@ -536,7 +553,7 @@ func (c *WebServer) ListFilesArtifactNamesAndUuids(ctx context.Context, req *con
 	if !isValidRequest {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -556,7 +573,7 @@ func (c *WebServer) ListFilesArtifactNamesAndUuids(ctx context.Context, req *con
 }
 
 func (c *WebServer) RunStarlarkPackage(ctx context.Context, req *connect.Request[kurtosis_enclave_manager_api_bindings.RunStarlarkPackageRequest], responseStream *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error {
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -588,7 +605,7 @@ func (c *WebServer) RunStarlarkPackage(ctx context.Context, req *connect.Request
 }
 
 func (c *WebServer) RunStarlarkScript(ctx context.Context, req *connect.Request[kurtosis_enclave_manager_api_bindings.RunStarlarkScriptRequest], responseStream *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error {
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -662,7 +679,7 @@ func (c *WebServer) InspectFilesArtifactContents(ctx context.Context, req *conne
 	if !isValidRequest {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -696,7 +713,7 @@ func (c *WebServer) DownloadFilesArtifact(
 	if !isValidRequest {
 		return stacktrace.Propagate(err, "User not authorized")
 	}
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -736,7 +753,7 @@ func (c *WebServer) GetStarlarkRun(
 	if !isValidRequest {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -776,7 +793,7 @@ func (c *WebServer) GetStarlarkScriptPlanYaml(
 	if !isValidRequest {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -811,7 +828,7 @@ func (c *WebServer) GetStarlarkPackagePlanYaml(
 	if !isValidRequest {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	apiContainerServiceClient, err := c.createAPICClient(ctx, req.Msg.ApicIpAddress, req.Msg.ApicPort)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create the APIC client")
 	}
@@ -837,21 +854,86 @@ func (c *WebServer) GetStarlarkPackagePlanYaml(
 }
 
 func (c *WebServer) createAPICClient(
+	ctx context.Context,
 	ip string,
 	port int32,
 ) (*kurtosis_core_rpc_api_bindingsconnect.ApiContainerServiceClient, error) {
-	host, err := url.Parse(fmt.Sprintf("http://%s:%d", ip, port))
+	tlsCredentials, err := c.getAPICTlsCredentials(ctx, ip, port)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get the TLS credentials of the APIC")
+	}
+	if tlsCredentials == nil {
+		host, err := url.Parse(fmt.Sprintf("http://%s:%d", ip, port))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to parse the connection url for the APIC")
+		}
+		apiContainerServiceClient := kurtosis_core_rpc_api_bindingsconnect.NewApiContainerServiceClient(
+			http.DefaultClient,
+			host.String(),
+			connect.WithGRPCWeb(),
+		)
+		return &apiContainerServiceClient, nil
+	}
+
+	// The APICs that require mutual TLS only speak gRPC, over HTTP/2
+	tlsConfig, err := api_container_tls.NewClientTlsConfig(tlsCredentials.GetCertificateAuthority(), tlsCredentials.GetClientCertificate(), tlsCredentials.GetClientKey(), tlsCredentials.GetFipsMode())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create the TLS config of the connection to the APIC")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.ForceAttemptHTTP2 = true
+	host, err := url.Parse(fmt.Sprintf("https://%s:%d", ip, port))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to parse the connection url for the APIC")
 	}
 	apiContainerServiceClient := kurtosis_core_rpc_api_bindingsconnect.NewApiContainerServiceClient(
-		http.DefaultClient,
+		&http.Client{
+			Transport:     transport,
+			CheckRedirect: nil,
+			Jar:           nil,
+			Timeout:       0,
+		},
 		host.String(),
-		connect.WithGRPCWeb(),
+		connect.WithGRPC(),
 	)
 	return &apiContainerServiceClient, nil
 }
 
+// getAPICTlsCredentials returns the credentials the engine issues for the APIC reachable at the address, or nil if it
+// doesn't require mutual TLS
+func (c *WebServer) getAPICTlsCredentials(
+	ctx context.Context,
+	ip string,
+	port int32,
+) (*kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials, error) {
+	enclaves, err := (*c.engineServiceClient).GetEnclaves(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get the enclaves from the engine")
+	}
+	for _, enclaveInfo := range enclaves.Msg.EnclaveInfo {
+		hostMachineInfo := enclaveInfo.GetApiContainerHostMachineInfo()
+		isReachedOnHostMachine := hostMachineInfo.GetIpOnHostMachine() == ip && int32(hostMachineInfo.GetGrpcPortOnHostMachine()) == port
+		apiContainerInfo := enclaveInfo.GetApiContainerInfo()
+		isReachedInsideEnclave := apiContainerInfo.GetBridgeIpAddress() == ip && int32(apiContainerInfo.GetGrpcPortInsideEnclave()) == port
+		if !isReachedOnHostMachine && !isReachedInsideEnclave {
+			continue
+		}
+		if !enclaveInfo.GetIsApiContainerMtlsRequired() {
+			return nil, nil
+		}
+		getTlsCredentialsArgs := &kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs{
+			EnclaveIdentifier: enclaveInfo.GetEnclaveUuid(),
+		}
+		tlsCredentials, err := (*c.engineServiceClient).GetApiContainerTlsCredentials(ctx, connect.NewRequest(getTlsCredentialsArgs))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to get the TLS credentials of the APIC of enclave '%s' from the engine", enclaveInfo.GetEnclaveUuid())
+		}
+		return tlsCredentials.Msg, nil
+	}
+	return nil, nil
+}
+
 func (c *WebServer) createKurtosisCloudBackendClient() (*kurtosis_backend_server_rpc_api_bindingsconnect.KurtosisCloudBackendServerClient, error) {
 	parsedUrl, err := url.Parse(fmt.Sprintf("%s:%d", kurtosisCloudApiHost, kurtosisCloudApiPort))
 	if err != nil {
@@ -966,20 +1048,37 @@ func extractJwtToken(header http.Header) (string, error) {
 	return reqToken, nil
 }
 
-func RunEnclaveManagerApiServer(enforceAuth bool) error {
+// RunEnclaveManagerApiServer serves the enclave manager API until interrupted. If userAuthenticator isn't nil, the
+// calls must be made by users who logged in through the endpoints served under userAuthPathPrefix
+func RunEnclaveManagerApiServer(enforceAuth bool, userAuthenticator *UserAuthenticator) error {
+	if enforceAuth && userAuthenticator != nil {
+		return stacktrace.NewError("The enclave manager login can't be enabled on a Kurtosis Cloud instance, which has its own authentication")
+	}
 	srv, err := NewWebserver(enforceAuth)
 	if err != nil {
 		logrus.Fatal("an error occurred while processing the auth settings, exiting!", err)
 		return err
 	}
-	apiPath, handler := kurtosis_enclave_manager_api_bindingsconnect.NewKurtosisEnclaveManagerServerHandler(srv)
+
+	handlerOptions := []connect.HandlerOption{}
+	if userAuthenticator != nil {
+		handlerOptions = append(handlerOptions, connect.WithInterceptors(NewUserAuthInterceptor(userAuthenticator)))
+	}
+	apiPath, apiHandler := kurtosis_enclave_manager_api_bindingsconnect.NewKurtosisEnclaveManagerServerHandler(srv, handlerOptions...)
+
+	handler := http.NewServeMux()
+	handler.Handle(apiPath, apiHandler)
+	if userAuthenticator != nil {
+		logrus.Infof("Enclave manager login is enabled with password login %v and OIDC %v", userAuthenticator.IsPasswordLoginEnabled(), userAuthenticator.GetOidcLoginConfig() != nil)
+		handler.Handle(userAuthPathPrefix, newUserAuthHttpHandler(userAuthenticator))
+	}
 
 	logrus.Infof("Web server running and listening on port %d", listenPort)
 	apiServer := connect_server.NewConnectServer(
 		listenPort,
 		grpcServerStopGracePeriod,
 		handler,
-		apiPath,
+		rootPath,
 	)
 
 	emCors := cors.AllowAll()
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	userAuthPathPrefix   = "/auth/"
+	userAuthMethodsPath  = userAuthPathPrefix + "methods"
+	userAuthLoginPath    = userAuthPathPrefix + "login"
+	userAuthLogoutPath   = userAuthPathPrefix + "logout"
+	userAuthSessionPath  = userAuthPathPrefix + "session"
+	jsonContentType      = "application/json"
+	contentTypeHeader    = "Content-Type"
+	maxLoginRequestBytes = 64 * 1024
+
+	// Slows down guessing passwords; the response is delayed by this much when a login fails
+	failedLoginDelay = time.Second
+)
+
+type userAuthMethodsResponse struct {
+	PasswordLogin bool               `json:"passwordLogin"`
+	Oidc          *oidcLoginResponse `json:"oidc"`
+}
+
+type oidcLoginResponse struct {
+	IssuerUrl string `json:"issuerUrl"`
+	ClientId  string `json:"clientId"`
+}
+
+// Either the username and the password, or the ID token, are set
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	IdToken  string `json:"idToken"`
+}
+
+type loginResponse struct {
+	Token   string       `json:"token"`
+	Session *UserSession `json:"session"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// newUserAuthHttpHandler serves the endpoints the UI uses to log users in and out, next to the enclave manager API
+func newUserAuthHttpHandler(authenticator *UserAuthenticator) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(userAuthMethodsPath, func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			writeJsonError(writer, http.StatusMethodNotAllowed, "Only GET is allowed")
+			return
+		}
+		response := userAuthMethodsResponse{
+			PasswordLogin: authenticator.IsPasswordLoginEnabled(),
+			Oidc:          nil,
+		}
+		if oidc := authenticator.GetOidcLoginConfig(); oidc != nil {
+			response.Oidc = &oidcLoginResponse{
+				IssuerUrl: oidc.IssuerUrl,
+				ClientId:  oidc.ClientId,
+			}
+		}
+		writeJson(writer, http.StatusOK, response)
+	})
+
+	mux.HandleFunc(userAuthLoginPath, func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			writeJsonError(writer, http.StatusMethodNotAllowed, "Only POST is allowed")
+			return
+		}
+		var loginArgs loginRequest
+		if err := json.NewDecoder(http.MaxBytesReader(writer, request.Body, maxLoginRequestBytes)).Decode(&loginArgs); err != nil {
+			writeJsonError(writer, http.StatusBadRequest, "The body must be a JSON object with either a username and a password, or an ID token")
+			return
+		}
+
+		var token string
+		var session *UserSession
+		var err error
+		if loginArgs.IdToken != "" {
+			token, session, err = authenticator.LoginWithOidcIdToken(request.Context(), loginArgs.IdToken)
+		} else {
+			token, session, err = authenticator.LoginWithPassword(loginArgs.Username, loginArgs.Password)
+		}
+		if err != nil {
+			logrus.Debugf("Rejected a login to the enclave manager from '%v':\n%v", request.RemoteAddr, err)
+			time.Sleep(failedLoginDelay)
+			writeJsonError(writer, http.StatusUnauthorized, "Invalid credentials")
+			return
+		}
+		logrus.Infof("User '%v' logged in to the enclave manager", session.Username)
+		writeJson(writer, http.StatusOK, loginResponse{
+			Token:   token,
+			Session: session,
+		})
+	})
+
+	mux.HandleFunc(userAuthLogoutPath, func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			writeJsonError(writer, http.StatusMethodNotAllowed, "Only POST is allowed")
+			return
+		}
+		if err := authenticator.Logout(request.Header.Get(authorizationHeader)); err != nil {
+			writeJsonError(writer, http.StatusBadRequest, "A bearer token is required to log out")
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc(userAuthSessionPath, func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			writeJsonError(writer, http.StatusMethodNotAllowed, "Only GET is allowed")
+			return
+		}
+		session, err := authenticator.Authenticate(request.Context(), request.Header.Get(authorizationHeader))
+		if err != nil {
+			writeJsonError(writer, http.StatusUnauthorized, "Not logged in")
+			return
+		}
+		writeJson(writer, http.StatusOK, session)
+	})
+
+	return mux
+}
+
+func writeJson(writer http.ResponseWriter, statusCode int, body interface{}) {
+	writer.Header().Set(contentTypeHeader, jsonContentType)
+	writer.WriteHeader(statusCode)
+	if err := json.NewEncoder(writer).Encode(body); err != nil {
+		logrus.Warnf("An error occurred writing the response of an enclave manager auth endpoint:\n%v", err)
+	}
+}
+
+func writeJsonError(writer http.ResponseWriter, statusCode int, message string) {
+	writeJson(writer, statusCode, errorResponse{Error: message})
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/enclave-manager/api/golang/kurtosis_enclave_manager_api_bindings/kurtosis_enclave_manager_api_bindingsconnect"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// Procedures that can be called without logging in, e.g. by load balancer health checks
+var unauthenticatedProcedures = map[string]bool{
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerCheckProcedure: true,
+}
+
+// Procedures that change the engine itself, for every user
+var adminScopeProcedures = map[string]bool{
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure: true,
+}
+
+// Procedures not listed here or above require the write scope, so that new procedures are locked down until they get
+// classified
+var readScopeProcedures = map[string]bool{
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerGetEnclavesProcedure:                    true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerGetServicesProcedure:                    true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerGetServiceLogsProcedure:                 true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerListFilesArtifactNamesAndUuidsProcedure: true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerInspectFilesArtifactContentsProcedure:   true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerDownloadFilesArtifactProcedure:          true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerGetStarlarkRunProcedure:                 true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerGetStarlarkScriptPlanYamlProcedure:      true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerGetStarlarkPackagePlanYamlProcedure:     true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerGetCloudInstanceConfigProcedure:         true,
+	kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerIsNewKurtosisVersionAvailableProcedure:  true,
+}
+
+// UserAuthInterceptor rejects the calls to the enclave manager API that aren't made by a logged in user granted the
+// scope the called procedure requires
+type UserAuthInterceptor struct {
+	authenticator *UserAuthenticator
+}
+
+func NewUserAuthInterceptor(authenticator *UserAuthenticator) *UserAuthInterceptor {
+	return &UserAuthInterceptor{
+		authenticator: authenticator,
+	}
+}
+
+func (interceptor *UserAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := interceptor.authorize(ctx, request.Spec().Procedure, request.Header()); err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	}
+}
+
+// WrapStreamingClient is a no-op as the enclave manager doesn't make calls through this interceptor
+func (interceptor *UserAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *UserAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := interceptor.authorize(ctx, conn.Spec().Procedure, conn.RequestHeader()); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (interceptor *UserAuthInterceptor) authorize(ctx context.Context, procedure string, header http.Header) error {
+	if unauthenticatedProcedures[procedure] {
+		return nil
+	}
+	session, err := interceptor.authenticator.Authenticate(ctx, header.Get(authorizationHeader))
+	if err != nil {
+		logrus.Debugf("Rejected unauthenticated call to '%v':\n%v", procedure, err)
+		return connect.NewError(connect.CodeUnauthenticated, stacktrace.NewError("Logging in is required to call '%v'", procedure))
+	}
+	requiredScope := getProcedureRequiredScope(procedure)
+	if !session.IsGranted(requiredScope) {
+		logrus.Debugf("Rejected call to '%v' by '%v' who lacks scope '%v'", procedure, session.Username, requiredScope)
+		return connect.NewError(connect.CodePermissionDenied, stacktrace.NewError("Calling '%v' requires scope '%v', which user '%v' isn't granted", procedure, requiredScope, session.Username))
+	}
+	logrus.Debugf("Authorized call to '%v' by '%v'", procedure, session.Username)
+	return nil
+}
+
+func getProcedureRequiredScope(procedure string) string {
+	if adminScopeProcedures[procedure] {
+		return UserAuthScope_Admin
+	}
+	if readScopeProcedures[procedure] {
+		return UserAuthScope_Read
+	}
+	return UserAuthScope_Write
+}
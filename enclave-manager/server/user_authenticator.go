@@ -0,0 +1,265 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// The same scopes as the engine tokens, so that a user can't do more through the enclave manager than a token with
+	// the same scopes could do through the engine APIs
+	UserAuthScope_Read  = "read"
+	UserAuthScope_Write = "write"
+	UserAuthScope_Admin = "admin"
+
+	authorizationHeader         = "Authorization"
+	bearerAuthScheme            = "bearer"
+	authorizationHeaderNumParts = 2
+
+	jwtPartSeparator     = "."
+	jwtNumPartSeparators = 2
+
+	sessionDuration       = 12 * time.Hour
+	sessionTokenNumBytes  = 32
+	unknownUserBcryptCost = bcrypt.DefaultCost
+)
+
+// UserAuthConfig lists who can log in to the enclave manager
+type UserAuthConfig struct {
+	Users []LocalUser
+
+	// Oidc is nil if users can't log in with an OpenID Connect provider
+	Oidc *OidcLoginConfig
+}
+
+// LocalUser logs in with a username and a password
+type LocalUser struct {
+	Username string
+
+	// bcrypt hash of the password
+	PasswordBcrypt string
+
+	Scopes []string
+}
+
+// OidcLoginConfig lets users log in with an ID token issued by an OpenID Connect provider
+type OidcLoginConfig struct {
+	// IssuerUrl and ClientId are what the UI needs to send users to the provider
+	IssuerUrl string
+	ClientId  string
+
+	// ValidateIdToken returns the name of the user the ID token was issued to and the scopes it grants
+	ValidateIdToken func(ctx context.Context, idToken string) (string, []string, error)
+}
+
+// UserSession is created when a user logs in, and lasts until the user logs out or sessionDuration elapses
+type UserSession struct {
+	Username  string    `json:"username"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// IsGranted returns true if the user can make calls requiring the given scope
+func (session *UserSession) IsGranted(requiredScope string) bool {
+	for _, grantedScope := range session.Scopes {
+		if grantedScope == requiredScope || grantedScope == UserAuthScope_Admin {
+			return true
+		}
+		if grantedScope == UserAuthScope_Write && requiredScope == UserAuthScope_Read {
+			return true
+		}
+	}
+	return false
+}
+
+// UserAuthenticator logs users in to the enclave manager and resolves the session tokens carried by their calls
+type UserAuthenticator struct {
+	usersByUsername map[string]LocalUser
+
+	// Compared against when the username is unknown, so that the response time doesn't tell which usernames exist
+	unknownUserPasswordBcrypt []byte
+
+	oidc *OidcLoginConfig
+
+	// Guards sessionsByTokenHash
+	sessionsMutex sync.Mutex
+
+	// Only the SHA-256 of the session tokens is kept
+	sessionsByTokenHash map[string]*UserSession
+}
+
+func NewUserAuthenticator(config UserAuthConfig) (*UserAuthenticator, error) {
+	usersByUsername := map[string]LocalUser{}
+	for _, user := range config.Users {
+		usersByUsername[user.Username] = user
+	}
+	unknownUserPasswordBcrypt, err := bcrypt.GenerateFromPassword([]byte(generateRandomHex(sessionTokenNumBytes)), unknownUserBcryptCost)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred hashing the password compared against for unknown users")
+	}
+	return &UserAuthenticator{
+		usersByUsername:           usersByUsername,
+		unknownUserPasswordBcrypt: unknownUserPasswordBcrypt,
+		oidc:                      config.Oidc,
+		sessionsMutex:             sync.Mutex{},
+		sessionsByTokenHash:       map[string]*UserSession{},
+	}, nil
+}
+
+// IsPasswordLoginEnabled returns true if local users are configured
+func (authenticator *UserAuthenticator) IsPasswordLoginEnabled() bool {
+	return len(authenticator.usersByUsername) > 0
+}
+
+// GetOidcLoginConfig returns nil if users can't log in with an OpenID Connect provider
+func (authenticator *UserAuthenticator) GetOidcLoginConfig() *OidcLoginConfig {
+	return authenticator.oidc
+}
+
+// LoginWithPassword returns a new session token for the local user, or an error if the password doesn't match
+func (authenticator *UserAuthenticator) LoginWithPassword(username string, password string) (string, *UserSession, error) {
+	user, found := authenticator.usersByUsername[username]
+	passwordBcrypt := authenticator.unknownUserPasswordBcrypt
+	if found {
+		passwordBcrypt = []byte(user.PasswordBcrypt)
+	}
+	if err := bcrypt.CompareHashAndPassword(passwordBcrypt, []byte(password)); err != nil || !found {
+		return "", nil, stacktrace.NewError("Invalid username or password")
+	}
+	token, session := authenticator.createSession(user.Username, user.Scopes)
+	return token, session, nil
+}
+
+// LoginWithOidcIdToken returns a new session token for the user the ID token was issued to, or an error if the token
+// isn't valid
+func (authenticator *UserAuthenticator) LoginWithOidcIdToken(ctx context.Context, idToken string) (string, *UserSession, error) {
+	if authenticator.oidc == nil {
+		return "", nil, stacktrace.NewError("Logging in with an OpenID Connect provider isn't enabled")
+	}
+	username, scopes, err := authenticator.oidc.ValidateIdToken(ctx, idToken)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "The ID token isn't valid")
+	}
+	if len(scopes) == 0 {
+		return "", nil, stacktrace.NewError("The ID token of '%v' doesn't grant any scope", username)
+	}
+	token, session := authenticator.createSession(username, scopes)
+	return token, session, nil
+}
+
+// Authenticate returns the session of the bearer token in the value of the Authorization header, or an error if the
+// token is missing, unknown or expired. If users can log in with an OpenID Connect provider, the bearer token can also
+// be an ID token of the provider, as 'kurtosis engine login' gets, which is then checked again on every call.
+func (authenticator *UserAuthenticator) Authenticate(ctx context.Context, authorizationHeaderValue string) (*UserSession, error) {
+	token, err := getBearerToken(authorizationHeaderValue)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the bearer token of the call")
+	}
+	if authenticator.oidc != nil && isJwt(token) {
+		return authenticator.authenticateOidcIdToken(ctx, token)
+	}
+	tokenHash := hashSessionToken(token)
+
+	authenticator.sessionsMutex.Lock()
+	defer authenticator.sessionsMutex.Unlock()
+	session, found := authenticator.sessionsByTokenHash[tokenHash]
+	if !found {
+		return nil, stacktrace.NewError("The session doesn't exist; it may have been logged out")
+	}
+	if time.Now().After(session.ExpiresAt) {
+		delete(authenticator.sessionsByTokenHash, tokenHash)
+		return nil, stacktrace.NewError("The session of '%v' expired at %v", session.Username, session.ExpiresAt)
+	}
+	return session, nil
+}
+
+// Logout ends the session of the bearer token in the value of the Authorization header; it's a no-op if the session
+// doesn't exist
+func (authenticator *UserAuthenticator) Logout(authorizationHeaderValue string) error {
+	token, err := getBearerToken(authorizationHeaderValue)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the bearer token of the call")
+	}
+	authenticator.sessionsMutex.Lock()
+	defer authenticator.sessionsMutex.Unlock()
+	delete(authenticator.sessionsByTokenHash, hashSessionToken(token))
+	return nil
+}
+
+// authenticateOidcIdToken returns a session that isn't stored, as the ID token is checked again on every call. Its
+// expiration time is the time of the call for the same reason.
+func (authenticator *UserAuthenticator) authenticateOidcIdToken(ctx context.Context, idToken string) (*UserSession, error) {
+	username, scopes, err := authenticator.oidc.ValidateIdToken(ctx, idToken)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The ID token isn't valid")
+	}
+	if len(scopes) == 0 {
+		return nil, stacktrace.NewError("The ID token of '%v' doesn't grant any scope", username)
+	}
+	return &UserSession{
+		Username:  username,
+		Scopes:    scopes,
+		ExpiresAt: time.Now(),
+	}, nil
+}
+
+func (authenticator *UserAuthenticator) createSession(username string, scopes []string) (string, *UserSession) {
+	token := generateRandomHex(sessionTokenNumBytes)
+	now := time.Now()
+	session := &UserSession{
+		Username:  username,
+		Scopes:    scopes,
+		ExpiresAt: now.Add(sessionDuration),
+	}
+
+	authenticator.sessionsMutex.Lock()
+	defer authenticator.sessionsMutex.Unlock()
+	// Sessions that are never used again after they expire would pile up otherwise
+	for tokenHash, existingSession := range authenticator.sessionsByTokenHash {
+		if now.After(existingSession.ExpiresAt) {
+			delete(authenticator.sessionsByTokenHash, tokenHash)
+		}
+	}
+	authenticator.sessionsByTokenHash[hashSessionToken(token)] = session
+	return token, session
+}
+
+func getBearerToken(authorizationHeaderValue string) (string, error) {
+	if authorizationHeaderValue == "" {
+		return "", stacktrace.NewError("The call has no '%v' header", authorizationHeader)
+	}
+	headerParts := strings.SplitN(strings.TrimSpace(authorizationHeaderValue), " ", authorizationHeaderNumParts)
+	if len(headerParts) != authorizationHeaderNumParts || !strings.EqualFold(headerParts[0], bearerAuthScheme) {
+		return "", stacktrace.NewError("The '%v' header must have the form 'Bearer <token>'", authorizationHeader)
+	}
+	token := strings.TrimSpace(headerParts[1])
+	if token == "" {
+		return "", stacktrace.NewError("The '%v' header has an empty bearer token", authorizationHeader)
+	}
+	return token, nil
+}
+
+// isJwt returns true if the token has the three dot-separated parts of a JWT; the session tokens are hex strings
+func isJwt(token string) bool {
+	return strings.Count(token, jwtPartSeparator) == jwtNumPartSeparators
+}
+
+func hashSessionToken(token string) string {
+	tokenHash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(tokenHash[:])
+}
+
+func generateRandomHex(numBytes int) string {
+	randomBytes := make([]byte, numBytes)
+	// crypto/rand.Read never returns an error on the platforms the engine runs on
+	_, _ = rand.Read(randomBytes)
+	return hex.EncodeToString(randomBytes)
+}
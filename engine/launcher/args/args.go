@@ -5,7 +5,14 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
@@ -74,12 +81,69 @@ type EngineServerArgs struct {
 	LogsCollectorFilters []logs_collector.Filter `json:"logsCollectorFilters"`
 
 	LogsCollectorParsers []logs_collector.Parser `json:"logsCollectorParsers"`
+
+	// Which host services of the Kubernetes nodes the logs collectors collect the system logs of
+	LogsCollectorSystemLogsConfig logs_collector.SystemLogsConfig `json:"logsCollectorSystemLogsConfig"`
+
+	// Which metadata of the pods of the services the logs collectors attach to their log lines on Kubernetes
+	LogsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig `json:"logsCollectorKubernetesMetadataConfig"`
+
+	// How the logs collectors buffer the records they collect
+	LogsCollectorBufferConfig logs_collector.BufferConfig `json:"logsCollectorBufferConfig"`
+
+	// Where the API containers of the enclaves store the content of files artifacts
+	ArtifactsStoreConfig artifacts_store.ArtifactsStoreConfig `json:"artifactsStoreConfig"`
+
+	// How the API containers of the enclaves cache the images they build and pull
+	ImageCacheConfig image_cache.ImageCacheConfig `json:"imageCacheConfig"`
+
+	// Resources the services of each enclave can claim, enforced by the API containers
+	EnclaveQuota enclave_quota.EnclaveQuota `json:"enclaveQuota"`
+
+	// Who can call the engine APIs; authentication is disabled if empty
+	AuthConfig EngineAuthConfig `json:"authConfig"`
+
+	// Who can log in to the enclave manager UI; the login is disabled if empty
+	EnclaveManagerAuthConfig EnclaveManagerAuthConfig `json:"enclaveManagerAuthConfig"`
+
+	// TTL given to the enclaves created without one, as a duration string like '4h'; enclaves don't expire if empty
+	DefaultEnclaveTtl string `json:"defaultEnclaveTtl"`
+
+	// Where the engine and the API containers of the enclaves send the product metrics to, and which of them
+	MetricsSinkConfig metrics_client.SinkConfig `json:"metricsSinkConfig"`
+
+	// Where the engine keeps the state that must survive its restarts
+	StateStoreConfig StateStoreConfig `json:"stateStoreConfig"`
+
+	// How many log lines the engine buffers for each service logs stream, and what happens when its client falls behind
+	LogStreamingConfig LogStreamingConfig `json:"logStreamingConfig"`
+
+	// Whether the API containers launched by the engine only accept the calls authenticated with a certificate the
+	// engine issued for their enclave
+	ShouldRequireApiContainerMtls bool `json:"shouldRequireApiContainerMtls"`
+
+	// Where the API containers read the secrets that Starlark references with kurtosis.secret from
+	SecretsProviderConfig secrets_provider.SecretsProviderConfig `json:"secretsProviderConfig"`
+
+	// Who the images of the plans run in the enclaves must be signed by to be started
+	ImageVerificationConfig image_verification.ImageVerificationConfig `json:"imageVerificationConfig"`
+
+	// The internal mirrors the engine and the API containers resolve the images and the packages from in air-gapped mode
+	AirGapConfig air_gap.AirGapConfig `json:"airGapConfig"`
+
+	// The hook the API containers scan the files artifacts uploaded to the enclaves with before storing them
+	ContentScanningConfig content_scanning.ContentScanningConfig `json:"contentScanningConfig"`
+
+	// Whether the TLS connections of the engine, of the API containers and of their clients are restricted to the
+	// FIPS-approved parameters
+	IsFipsModeEnabled bool `json:"isFipsModeEnabled"`
 }
 
 var skipValidation = map[string]bool{
 	"cloud_instance_id": true,
 	"cloud_user_id":     true,
 	"domain":            true,
+	"defaultEnclaveTtl": true,
 }
 
 func (args *EngineServerArgs) UnmarshalJSON(data []byte) error {
@@ -135,34 +199,118 @@ func NewEngineServerArgs(
 	logRetentionPeriod string,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	authConfig EngineAuthConfig,
+	enclaveManagerAuthConfig EnclaveManagerAuthConfig,
+	defaultEnclaveTtl string,
+	metricsSinkConfig metrics_client.SinkConfig,
+	stateStoreConfig StateStoreConfig,
+	logStreamingConfig LogStreamingConfig,
+	shouldRequireApiContainerMtls bool,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
+	isFipsModeEnabled bool,
 ) (*EngineServerArgs, error) {
 	if enclaveEnvVars == "" {
 		enclaveEnvVars = emptyJsonField
 	}
 	result := &EngineServerArgs{
-		GrpcListenPortNum:           grpcListenPortNum,
-		LogLevelStr:                 logLevelStr,
-		ImageVersionTag:             imageVersionTag,
-		MetricsUserID:               metricsUserID,
-		DidUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
-		KurtosisBackendType:         kurtosisBackendType,
-		KurtosisLocalBackendConfig:  kurtosisLocalBackendConfig,
-		OnBastionHost:               onBastionHost,
-		PoolSize:                    poolSize,
-		EnclaveEnvVars:              enclaveEnvVars,
-		IsCI:                        isCI,
-		CloudUserID:                 cloudUserID,
-		CloudInstanceID:             cloudInstanceID,
-		AllowedCORSOrigins:          allowedCORSOrigins,
-		RestartAPIContainers:        restartAPIContainers,
-		Domain:                      domain,
-		LogRetentionPeriod:          logRetentionPeriod,
-		LogsCollectorFilters:        logsCollectorFilters,
-		LogsCollectorParsers:        logsCollectorParsers,
+		GrpcListenPortNum:                     grpcListenPortNum,
+		LogLevelStr:                           logLevelStr,
+		ImageVersionTag:                       imageVersionTag,
+		MetricsUserID:                         metricsUserID,
+		DidUserAcceptSendingMetrics:           didUserAcceptSendingMetrics,
+		KurtosisBackendType:                   kurtosisBackendType,
+		KurtosisLocalBackendConfig:            kurtosisLocalBackendConfig,
+		OnBastionHost:                         onBastionHost,
+		PoolSize:                              poolSize,
+		EnclaveEnvVars:                        enclaveEnvVars,
+		IsCI:                                  isCI,
+		CloudUserID:                           cloudUserID,
+		CloudInstanceID:                       cloudInstanceID,
+		AllowedCORSOrigins:                    allowedCORSOrigins,
+		RestartAPIContainers:                  restartAPIContainers,
+		Domain:                                domain,
+		LogRetentionPeriod:                    logRetentionPeriod,
+		LogsCollectorFilters:                  logsCollectorFilters,
+		LogsCollectorParsers:                  logsCollectorParsers,
+		LogsCollectorSystemLogsConfig:         logsCollectorSystemLogsConfig,
+		LogsCollectorKubernetesMetadataConfig: logsCollectorKubernetesMetadataConfig,
+		LogsCollectorBufferConfig:             logsCollectorBufferConfig,
+		ArtifactsStoreConfig:                  artifactsStoreConfig,
+		ImageCacheConfig:                      imageCacheConfig,
+		EnclaveQuota:                          enclaveQuota,
+		AuthConfig:                            authConfig,
+		EnclaveManagerAuthConfig:              enclaveManagerAuthConfig,
+		DefaultEnclaveTtl:                     defaultEnclaveTtl,
+		MetricsSinkConfig:                     metricsSinkConfig,
+		StateStoreConfig:                      stateStoreConfig,
+		LogStreamingConfig:                    logStreamingConfig,
+		ShouldRequireApiContainerMtls:         shouldRequireApiContainerMtls,
+		SecretsProviderConfig:                 secretsProviderConfig,
+		ImageVerificationConfig:               imageVerificationConfig,
+		AirGapConfig:                          airGapConfig,
+		ContentScanningConfig:                 contentScanningConfig,
+		IsFipsModeEnabled:                     isFipsModeEnabled,
 	}
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating engine server args")
 	}
+	if err := logsCollectorSystemLogsConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the logs collector system logs config")
+	}
+	if err := logsCollectorKubernetesMetadataConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the logs collector Kubernetes metadata config")
+	}
+	if err := logsCollectorBufferConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the logs collector buffer config")
+	}
+	if err := artifactsStoreConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the artifacts store config")
+	}
+	if err := imageCacheConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the image cache config")
+	}
+	if err := secretsProviderConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the secrets provider config")
+	}
+	if err := imageVerificationConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the image verification config")
+	}
+	if err := airGapConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the air gap config")
+	}
+	if err := contentScanningConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the content scanning config")
+	}
+	if err := authConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the engine auth config")
+	}
+	if err := enclaveManagerAuthConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the enclave manager auth config")
+	}
+	if _, err := ParseEnclaveTtl(defaultEnclaveTtl); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the default enclave TTL")
+	}
+	if err := metricsSinkConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the metrics sink config")
+	}
+	if err := stateStoreConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the state store config")
+	}
+	if err := logStreamingConfig.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the log streaming config")
+	}
+	if isFipsModeEnabled && !shouldRequireApiContainerMtls {
+		return nil, stacktrace.NewError("The FIPS mode requires the API containers to require mutual TLS")
+	}
 	return result, nil
 }
 
@@ -2,12 +2,13 @@ package args
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/stacktrace"
 	"os"
 )
 
 const (
-	// All engine servers accept exactly one environment variable, which contains the serialized params that
+	// All engine servers accept exactly one environment variable (besides the OpenTelemetry ones), which contains the serialized params that
 	// dictate how the API container ought to behave
 	serializedArgsEnvVar = "SERIALIZED_ARGS"
 )
@@ -21,9 +22,14 @@ func GetEnvFromArgs(args *EngineServerArgs) (map[string]string, error) {
 
 	argsStr := string(argsBytes)
 
-	return map[string]string{
+	envVars := map[string]string{
 		serializedArgsEnvVar: argsStr,
-	}, nil
+	}
+	// the engine exports its spans the same way as the process launching it, so the traces go through it
+	for otelEnvVar, value := range tracing.GetOtelEnvVars() {
+		envVars[otelEnvVar] = value
+	}
+	return envVars, nil
 }
 
 // Intended to be used in the container main.go function - gets args from the environment
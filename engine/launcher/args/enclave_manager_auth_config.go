@@ -0,0 +1,72 @@
+package args
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Prefixes of the bcrypt hash variants, e.g. the ones produced by 'htpasswd -nbB'
+	bcryptHashPrefix = "$2"
+)
+
+// EnclaveManagerAuthConfig lists who can log in to the enclave manager UI served by 'kurtosis web'. The zero value
+// disables the login, so that anyone who can reach the enclave manager ports has full control of the engine
+type EnclaveManagerAuthConfig struct {
+	Users []EnclaveManagerUserConfig `json:"users,omitempty"`
+
+	// Oidc, if set, also lets users log in with an ID token issued by an OpenID Connect provider
+	Oidc *OidcConfig `json:"oidc,omitempty"`
+}
+
+// EnclaveManagerUserConfig is a local user logging in with a password. Only the bcrypt hash of the password is kept, so
+// that the password can't be read back from the engine container environment
+type EnclaveManagerUserConfig struct {
+	Username string `json:"username"`
+
+	// bcrypt hash of the password
+	PasswordBcrypt string `json:"passwordBcrypt"`
+
+	// The same scopes as the engine tokens, see EngineAuthScope_Read
+	Scopes []string `json:"scopes"`
+}
+
+func NewDisabledEnclaveManagerAuthConfig() EnclaveManagerAuthConfig {
+	return EnclaveManagerAuthConfig{
+		Users: nil,
+		Oidc:  nil,
+	}
+}
+
+// IsEnabled returns true if users need to log in to use the enclave manager
+func (config EnclaveManagerAuthConfig) IsEnabled() bool {
+	return len(config.Users) > 0 || config.Oidc != nil
+}
+
+func (config EnclaveManagerAuthConfig) Validate() error {
+	usernames := map[string]bool{}
+	for _, user := range config.Users {
+		if strings.TrimSpace(user.Username) == "" {
+			return stacktrace.NewError("Enclave manager users require a username")
+		}
+		if usernames[user.Username] {
+			return stacktrace.NewError("Enclave manager username '%v' is used more than once", user.Username)
+		}
+		usernames[user.Username] = true
+
+		if !strings.HasPrefix(user.PasswordBcrypt, bcryptHashPrefix) {
+			return stacktrace.NewError("Enclave manager user '%v' requires the bcrypt hash of the password", user.Username)
+		}
+		if err := validateEngineAuthScopes(user.Scopes); err != nil {
+			return stacktrace.Propagate(err, "Enclave manager user '%v' has invalid scopes", user.Username)
+		}
+	}
+
+	if config.Oidc != nil {
+		if err := config.Oidc.validate(); err != nil {
+			return stacktrace.Propagate(err, "Enclave manager auth OIDC config is invalid")
+		}
+	}
+	return nil
+}
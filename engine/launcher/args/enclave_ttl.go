@@ -0,0 +1,30 @@
+package args
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// NoEnclaveTtl is the TTL of the enclaves that live until they're removed
+	NoEnclaveTtl = time.Duration(0)
+)
+
+// ParseEnclaveTtl parses an enclave TTL written as a duration string like '4h' or '30m'. An empty string parses to
+// NoEnclaveTtl
+func ParseEnclaveTtl(ttl string) (time.Duration, error) {
+	ttl = strings.TrimSpace(ttl)
+	if ttl == "" {
+		return NoEnclaveTtl, nil
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return NoEnclaveTtl, stacktrace.Propagate(err, "Enclave TTL '%v' isn't a valid duration; valid examples are '4h' and '30m'", ttl)
+	}
+	if duration <= 0 {
+		return NoEnclaveTtl, stacktrace.NewError("Enclave TTL '%v' must be positive", ttl)
+	}
+	return duration, nil
+}
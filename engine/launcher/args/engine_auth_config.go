@@ -0,0 +1,180 @@
+package args
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// EngineAuthScope_Read allows the calls that only read the state of the engine and its enclaves (listing and
+	// inspecting enclaves, streaming logs, ...)
+	EngineAuthScope_Read = "read"
+	// EngineAuthScope_Write also allows the calls creating, running things in, stopping and destroying enclaves. It
+	// implies EngineAuthScope_Read
+	EngineAuthScope_Write = "write"
+	// EngineAuthScope_Admin allows every call on every enclave, regardless of who owns it. It implies EngineAuthScope_Write
+	EngineAuthScope_Admin = "admin"
+
+	defaultOidcScopesClaim = "scope"
+	defaultOidcGroupsClaim = "groups"
+
+	sha256HexLength = 64
+)
+
+var validEngineAuthScopes = map[string]bool{
+	EngineAuthScope_Read:  true,
+	EngineAuthScope_Write: true,
+	EngineAuthScope_Admin: true,
+}
+
+// EngineAuthConfig lists who can call the engine gRPC and REST APIs. The zero value disables authentication, so that
+// anyone who can reach the engine ports can use it
+type EngineAuthConfig struct {
+	StaticTokens []StaticTokenConfig `json:"staticTokens,omitempty"`
+
+	// Oidc, if set, also accepts the JWTs issued by an OpenID Connect provider
+	Oidc *OidcConfig `json:"oidc,omitempty"`
+}
+
+// StaticTokenConfig is a long-lived token given to a user or a machine. Only the SHA-256 of the token is kept, so that
+// the token can't be read back from the engine container environment
+type StaticTokenConfig struct {
+	// Name identifies the token in the engine logs
+	Name string `json:"name"`
+
+	// Hex-encoded SHA-256 of the token
+	TokenSha256 string `json:"tokenSha256"`
+
+	Scopes []string `json:"scopes"`
+}
+
+type OidcConfig struct {
+	// IssuerUrl is where the engine discovers the keys the tokens are signed with; it must match the 'iss' claim
+	IssuerUrl string `json:"issuerUrl"`
+
+	// Audience must be one of the values of the 'aud' claim
+	Audience string `json:"audience"`
+
+	// ScopesClaim is the claim listing the engine scopes the token grants, either as a space-separated string or as a
+	// list. Defaults to 'scope'
+	ScopesClaim string `json:"scopesClaim,omitempty"`
+
+	// GroupsClaim is the claim listing the groups of the user in the identity provider. Defaults to 'groups'
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+
+	// GroupScopes maps the groups of the identity provider to the engine scopes their members are granted, on top of
+	// the scopes of the scopes claim, so that the roles can be managed in the identity provider
+	GroupScopes map[string][]string `json:"groupScopes,omitempty"`
+}
+
+func NewDisabledEngineAuthConfig() EngineAuthConfig {
+	return EngineAuthConfig{
+		StaticTokens: nil,
+		Oidc:         nil,
+	}
+}
+
+// IsEnabled returns true if calls to the engine need to carry a token
+func (config EngineAuthConfig) IsEnabled() bool {
+	return len(config.StaticTokens) > 0 || config.Oidc != nil
+}
+
+func (config EngineAuthConfig) Validate() error {
+	tokenNames := map[string]bool{}
+	for _, staticToken := range config.StaticTokens {
+		if strings.TrimSpace(staticToken.Name) == "" {
+			return stacktrace.NewError("Engine auth static tokens require a name")
+		}
+		if tokenNames[staticToken.Name] {
+			return stacktrace.NewError("Engine auth static token name '%v' is used more than once", staticToken.Name)
+		}
+		tokenNames[staticToken.Name] = true
+
+		if _, err := hex.DecodeString(staticToken.TokenSha256); err != nil || len(staticToken.TokenSha256) != sha256HexLength {
+			return stacktrace.NewError("Engine auth static token '%v' requires the hex-encoded SHA-256 of the token, got '%v'", staticToken.Name, staticToken.TokenSha256)
+		}
+		if err := validateEngineAuthScopes(staticToken.Scopes); err != nil {
+			return stacktrace.Propagate(err, "Engine auth static token '%v' has invalid scopes", staticToken.Name)
+		}
+	}
+
+	if config.Oidc != nil {
+		if err := config.Oidc.validate(); err != nil {
+			return stacktrace.Propagate(err, "Engine auth OIDC config is invalid")
+		}
+	}
+	return nil
+}
+
+// GetScopesClaim returns the claim of the OIDC tokens holding the engine scopes
+func (config OidcConfig) GetScopesClaim() string {
+	if config.ScopesClaim == "" {
+		return defaultOidcScopesClaim
+	}
+	return config.ScopesClaim
+}
+
+// GetGroupsClaim returns the claim of the OIDC tokens holding the groups of the user
+func (config OidcConfig) GetGroupsClaim() string {
+	if config.GroupsClaim == "" {
+		return defaultOidcGroupsClaim
+	}
+	return config.GroupsClaim
+}
+
+// GetScopesOfGroups returns the engine scopes granted to the members of the given groups, without duplicates
+func (config OidcConfig) GetScopesOfGroups(groups []string) []string {
+	scopes := []string{}
+	isScopeAdded := map[string]bool{}
+	for _, group := range groups {
+		for _, scope := range config.GroupScopes[group] {
+			if !isScopeAdded[scope] {
+				isScopeAdded[scope] = true
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
+
+func (config OidcConfig) validate() error {
+	if strings.TrimSpace(config.IssuerUrl) == "" {
+		return stacktrace.NewError("An issuer URL is required")
+	}
+	if strings.TrimSpace(config.Audience) == "" {
+		return stacktrace.NewError("An audience is required")
+	}
+	for group, scopes := range config.GroupScopes {
+		if err := validateEngineAuthScopes(scopes); err != nil {
+			return stacktrace.Propagate(err, "Group '%v' has invalid scopes", group)
+		}
+	}
+	return nil
+}
+
+// IsEngineAuthScopeGranted returns true if the granted scopes allow a call requiring the given scope
+func IsEngineAuthScopeGranted(grantedScopes []string, requiredScope string) bool {
+	for _, grantedScope := range grantedScopes {
+		if grantedScope == requiredScope || grantedScope == EngineAuthScope_Admin {
+			return true
+		}
+		if grantedScope == EngineAuthScope_Write && requiredScope == EngineAuthScope_Read {
+			return true
+		}
+	}
+	return false
+}
+
+func validateEngineAuthScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return stacktrace.NewError("At least one scope is required; valid values are: %v, %v, %v", EngineAuthScope_Read, EngineAuthScope_Write, EngineAuthScope_Admin)
+	}
+	for _, scope := range scopes {
+		if !validEngineAuthScopes[scope] {
+			return stacktrace.NewError("Unrecognized scope '%v'; valid values are: %v, %v, %v", scope, EngineAuthScope_Read, EngineAuthScope_Write, EngineAuthScope_Admin)
+		}
+	}
+	return nil
+}
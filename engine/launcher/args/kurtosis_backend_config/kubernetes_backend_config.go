@@ -7,4 +7,21 @@ package kurtosis_backend_config
 
 type KubernetesBackendConfig struct {
 	StorageClass string
+
+	// The rate limits and the retries on throttling and conflicts of the client talking to the Kubernetes API server
+	ClientQPS        float32
+	ClientBurst      int
+	ClientMaxRetries int
+
+	// Labels and annotations added to every object Kurtosis creates in the cluster
+	ObjectLabels      map[string]string
+	ObjectAnnotations map[string]string
+
+	// The Pod Security Standard the pods Kurtosis creates comply with; empty means privileged
+	PodSecurityStandard string
+
+	// The name of the cluster the engine runs in, and the kubeconfigs to reach the other clusters the engine can create
+	// enclaves in, keyed by the names of the clusters
+	ClusterName                  string
+	AdditionalClusterKubeconfigs map[string]string
 }
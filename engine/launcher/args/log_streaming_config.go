@@ -0,0 +1,63 @@
+package args
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type SlowLogConsumerPolicy string
+
+const (
+	// SlowLogConsumerPolicy_Block stops reading the logs of the stream until its client catches up
+	SlowLogConsumerPolicy_Block SlowLogConsumerPolicy = "block"
+
+	// SlowLogConsumerPolicy_DropOldest drops the oldest buffered log lines, so that the client keeps up with the latest
+	// ones at the cost of a gap
+	SlowLogConsumerPolicy_DropOldest SlowLogConsumerPolicy = "drop-oldest"
+
+	// SlowLogConsumerPolicy_Disconnect ends the stream with an error, so that the client can reconnect
+	SlowLogConsumerPolicy_Disconnect SlowLogConsumerPolicy = "disconnect"
+
+	// Log lines are a few hundred bytes, so each stream buffers a few megabytes at most
+	defaultLogStreamBufferSize = 10000
+)
+
+// LogStreamingConfig bounds the log lines the engine buffers for each service logs stream, so that clients that can't
+// keep up with high-volume services don't grow the engine memory
+type LogStreamingConfig struct {
+	// The maximum number of log lines buffered for each stream; defaultLogStreamBufferSize if 0
+	BufferSize uint32 `json:"bufferSize,omitempty"`
+
+	// What happens to the followed streams whose buffer is full; SlowLogConsumerPolicy_Block if empty. The streams that
+	// aren't followed always wait for their client, as their logs are already stored
+	SlowConsumerPolicy SlowLogConsumerPolicy `json:"slowConsumerPolicy,omitempty"`
+}
+
+func NewDefaultLogStreamingConfig() LogStreamingConfig {
+	return LogStreamingConfig{
+		BufferSize:         0,
+		SlowConsumerPolicy: "",
+	}
+}
+
+func (config LogStreamingConfig) Validate() error {
+	switch config.SlowConsumerPolicy {
+	case "", SlowLogConsumerPolicy_Block, SlowLogConsumerPolicy_DropOldest, SlowLogConsumerPolicy_Disconnect:
+		return nil
+	default:
+		return stacktrace.NewError("Unrecognized slow log consumer policy '%v'; the supported ones are '%v', '%v' and '%v'", config.SlowConsumerPolicy, SlowLogConsumerPolicy_Block, SlowLogConsumerPolicy_DropOldest, SlowLogConsumerPolicy_Disconnect)
+	}
+}
+
+func (config LogStreamingConfig) GetBufferSize() uint32 {
+	if config.BufferSize == 0 {
+		return defaultLogStreamBufferSize
+	}
+	return config.BufferSize
+}
+
+func (config LogStreamingConfig) GetSlowConsumerPolicy() SlowLogConsumerPolicy {
+	if config.SlowConsumerPolicy == "" {
+		return SlowLogConsumerPolicy_Block
+	}
+	return config.SlowConsumerPolicy
+}
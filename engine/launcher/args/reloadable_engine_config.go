@@ -0,0 +1,63 @@
+package args
+
+import (
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ReloadableEngineConfig is the part of the engine config that can change while the engine runs, so that changing it
+// doesn't drop the log streams and port forwards that restarting the engine would
+type ReloadableEngineConfig struct {
+	LogRetentionPeriod string `json:"logRetentionPeriod"`
+
+	// Only applies to the enclaves created after the change
+	EnclaveQuota enclave_quota.EnclaveQuota `json:"enclaveQuota"`
+
+	// Can change the tokens but not enable or disable authentication, which requires restarting the engine
+	AuthConfig EngineAuthConfig `json:"authConfig"`
+
+	// Only applies to the enclaves created after the change
+	DefaultEnclaveTtl string `json:"defaultEnclaveTtl"`
+}
+
+func NewReloadableEngineConfig(
+	logRetentionPeriod string,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	authConfig EngineAuthConfig,
+	defaultEnclaveTtl string,
+) ReloadableEngineConfig {
+	return ReloadableEngineConfig{
+		LogRetentionPeriod: logRetentionPeriod,
+		EnclaveQuota:       enclaveQuota,
+		AuthConfig:         authConfig,
+		DefaultEnclaveTtl:  defaultEnclaveTtl,
+	}
+}
+
+// GetReloadableConfig returns the part of the engine server args that can change while the engine runs
+func (args EngineServerArgs) GetReloadableConfig() ReloadableEngineConfig {
+	return NewReloadableEngineConfig(args.LogRetentionPeriod, args.EnclaveQuota, args.AuthConfig, args.DefaultEnclaveTtl)
+}
+
+func (config ReloadableEngineConfig) Validate() error {
+	if _, err := config.GetLogRetentionPeriod(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the log retention period")
+	}
+	if err := config.AuthConfig.Validate(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the engine auth config")
+	}
+	if _, err := ParseEnclaveTtl(config.DefaultEnclaveTtl); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the default enclave TTL")
+	}
+	return nil
+}
+
+func (config ReloadableEngineConfig) GetLogRetentionPeriod() (time.Duration, error) {
+	logRetentionPeriod, err := time.ParseDuration(config.LogRetentionPeriod)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing a duration from provided log retention period string: %v", config.LogRetentionPeriod)
+	}
+	return logRetentionPeriod, nil
+}
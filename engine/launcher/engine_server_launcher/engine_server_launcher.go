@@ -10,9 +10,16 @@ import (
 	"net"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -54,8 +61,27 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	logRetentionPeriod string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	authConfig args.EngineAuthConfig,
+	enclaveManagerAuthConfig args.EnclaveManagerAuthConfig,
+	defaultEnclaveTtl string,
+	metricsSinkConfig metrics_client.SinkConfig,
+	stateStoreConfig args.StateStoreConfig,
+	logStreamingConfig args.LogStreamingConfig,
+	shouldRequireApiContainerMtls bool,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
+	isFipsModeEnabled bool,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -83,8 +109,27 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 		logRetentionPeriod,
 		sinks,
 		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
+		artifactsStoreConfig,
+		imageCacheConfig,
+		enclaveQuota,
+		authConfig,
+		enclaveManagerAuthConfig,
+		defaultEnclaveTtl,
+		metricsSinkConfig,
+		stateStoreConfig,
+		logStreamingConfig,
+		shouldRequireApiContainerMtls,
+		secretsProviderConfig,
+		imageVerificationConfig,
+		airGapConfig,
+		contentScanningConfig,
+		isFipsModeEnabled,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred launching the engine server container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -114,8 +159,27 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 	logRetentionPeriod string,
 	sinks logs_aggregator.Sinks,
 	shouldEnablePersistentVolumeLogsCollection bool,
+	logsEncryptionConfig logs_aggregator.LogsEncryptionConfig,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	authConfig args.EngineAuthConfig,
+	enclaveManagerAuthConfig args.EnclaveManagerAuthConfig,
+	defaultEnclaveTtl string,
+	metricsSinkConfig metrics_client.SinkConfig,
+	stateStoreConfig args.StateStoreConfig,
+	logStreamingConfig args.LogStreamingConfig,
+	shouldRequireApiContainerMtls bool,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
+	isFipsModeEnabled bool,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -143,6 +207,24 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		logRetentionPeriod,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
+		artifactsStoreConfig,
+		imageCacheConfig,
+		enclaveQuota,
+		authConfig,
+		enclaveManagerAuthConfig,
+		defaultEnclaveTtl,
+		metricsSinkConfig,
+		stateStoreConfig,
+		logStreamingConfig,
+		shouldRequireApiContainerMtls,
+		secretsProviderConfig,
+		imageVerificationConfig,
+		airGapConfig,
+		contentScanningConfig,
+		isFipsModeEnabled,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the engine server args")
@@ -163,8 +245,12 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		githubAuthToken,
 		sinks,
 		shouldEnablePersistentVolumeLogsCollection,
+		logsEncryptionConfig,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred launching the engine server container")
@@ -13,15 +13,55 @@ import (
 type KubernetesBackendConfigSupplier struct {
 	storageClass           string
 	enclaveSizeInMegabytes uint
+	clientQPS              float32
+	clientBurst            int
+	clientMaxRetries       int
+	objectLabels           map[string]string
+	objectAnnotations      map[string]string
+	podSecurityStandard    string
+
+	clusterName                  string
+	additionalClusterKubeconfigs map[string]string
 }
 
-func NewKubernetesKurtosisBackendConfigSupplier(storageClass string, enclaveSizeInMegabytes uint) KubernetesBackendConfigSupplier {
+func NewKubernetesKurtosisBackendConfigSupplier(
+	storageClass string,
+	enclaveSizeInMegabytes uint,
+	clientQPS float32,
+	clientBurst int,
+	clientMaxRetries int,
+	objectLabels map[string]string,
+	objectAnnotations map[string]string,
+	podSecurityStandard string,
+	clusterName string,
+	additionalClusterKubeconfigs map[string]string,
+) KubernetesBackendConfigSupplier {
 	return KubernetesBackendConfigSupplier{
 		storageClass:           storageClass,
 		enclaveSizeInMegabytes: enclaveSizeInMegabytes,
+		clientQPS:              clientQPS,
+		clientBurst:            clientBurst,
+		clientMaxRetries:       clientMaxRetries,
+		objectLabels:           objectLabels,
+		objectAnnotations:      objectAnnotations,
+		podSecurityStandard:    podSecurityStandard,
+
+		clusterName:                  clusterName,
+		additionalClusterKubeconfigs: additionalClusterKubeconfigs,
 	}
 }
 
 func (backendConfigSupplier KubernetesBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
-	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{StorageClass: backendConfigSupplier.storageClass}
+	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{
+		StorageClass:        backendConfigSupplier.storageClass,
+		ClientQPS:           backendConfigSupplier.clientQPS,
+		ClientBurst:         backendConfigSupplier.clientBurst,
+		ClientMaxRetries:    backendConfigSupplier.clientMaxRetries,
+		ObjectLabels:        backendConfigSupplier.objectLabels,
+		ObjectAnnotations:   backendConfigSupplier.objectAnnotations,
+		PodSecurityStandard: backendConfigSupplier.podSecurityStandard,
+
+		ClusterName:                  backendConfigSupplier.clusterName,
+		AdditionalClusterKubeconfigs: backendConfigSupplier.additionalClusterKubeconfigs,
+	}
 }
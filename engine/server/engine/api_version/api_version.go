@@ -0,0 +1,72 @@
+package api_version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// The versions the engine and the clients are released with; any other version, e.g. a dev build, can't be compared
+	releaseVersionRegexStr = `^(\d+)\.(\d+)\.(\d+)$`
+
+	majorVersionSubmatchIdx = 1
+	minorVersionSubmatchIdx = 2
+	versionNumberBase       = 10
+	versionNumberBitSize    = 64
+
+	upgradeInstructionsUrl = "https://docs.kurtosis.com/upgrade"
+)
+
+var releaseVersionRegex = regexp.MustCompile(releaseVersionRegexStr)
+
+// CheckCompatibility tells whether a client built for a Kurtosis version can use the API of an engine, which is the case
+// when their major and minor versions match, and what to do about it if not. Versions that can't be compared are
+// deemed compatible, with a message warning about it
+func CheckCompatibility(engineVersion string, clientVersion string) (bool, string) {
+	engineMajorVersion, engineMinorVersion, isEngineVersionRelease := parseReleaseVersion(engineVersion)
+	clientMajorVersion, clientMinorVersion, isClientVersionRelease := parseReleaseVersion(clientVersion)
+	if !isEngineVersionRelease || !isClientVersionRelease {
+		return true, fmt.Sprintf(
+			"The engine version '%v' and the client version '%v' can't be compared as they don't both match format X.Y.Z, so you may encounter runtime errors if their APIs differ",
+			engineVersion,
+			clientVersion,
+		)
+	}
+	if engineMajorVersion == clientMajorVersion && engineMinorVersion == clientMinorVersion {
+		return true, ""
+	}
+
+	isEngineOlder := engineMajorVersion < clientMajorVersion || (engineMajorVersion == clientMajorVersion && engineMinorVersion < clientMinorVersion)
+	if isEngineOlder {
+		return false, fmt.Sprintf(
+			"The running engine version '%v' is older than the version '%v' this client was built for, so their APIs differ. Restart the engine with a Kurtosis CLI of version '%v' via 'kurtosis engine restart'",
+			engineVersion,
+			clientVersion,
+			clientVersion,
+		)
+	}
+	return false, fmt.Sprintf(
+		"The running engine version '%v' is newer than the version '%v' this client was built for, so their APIs differ. Upgrade your Kurtosis CLI and SDK using the instructions at %v",
+		engineVersion,
+		clientVersion,
+		upgradeInstructionsUrl,
+	)
+}
+
+// parseReleaseVersion returns the major and minor versions of an X.Y.Z version, and false if it isn't one
+func parseReleaseVersion(version string) (uint64, uint64, bool) {
+	submatches := releaseVersionRegex.FindStringSubmatch(version)
+	if submatches == nil {
+		return 0, 0, false
+	}
+	majorVersion, err := strconv.ParseUint(submatches[majorVersionSubmatchIdx], versionNumberBase, versionNumberBitSize)
+	if err != nil {
+		return 0, 0, false
+	}
+	minorVersion, err := strconv.ParseUint(submatches[minorVersionSubmatchIdx], versionNumberBase, versionNumberBitSize)
+	if err != nil {
+		return 0, 0, false
+	}
+	return majorVersion, minorVersion, true
+}
@@ -0,0 +1,31 @@
+package api_version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCompatibility_SameMinorVersion(t *testing.T) {
+	isCompatible, message := CheckCompatibility("0.90.1", "0.90.3")
+	require.True(t, isCompatible)
+	require.Empty(t, message)
+}
+
+func TestCheckCompatibility_OlderEngine(t *testing.T) {
+	isCompatible, message := CheckCompatibility("0.89.4", "0.90.0")
+	require.False(t, isCompatible)
+	require.Contains(t, message, "kurtosis engine restart")
+}
+
+func TestCheckCompatibility_NewerEngine(t *testing.T) {
+	isCompatible, message := CheckCompatibility("1.0.0", "0.90.0")
+	require.False(t, isCompatible)
+	require.Contains(t, message, upgradeInstructionsUrl)
+}
+
+func TestCheckCompatibility_DevVersionCantBeCompared(t *testing.T) {
+	isCompatible, message := CheckCompatibility("0.90.1-dirty", "0.90.1")
+	require.True(t, isCompatible)
+	require.Contains(t, message, "can't be compared")
+}
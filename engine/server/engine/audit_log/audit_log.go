@@ -0,0 +1,183 @@
+package audit_log
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	GrpcApi = "grpc"
+	RestApi = "rest"
+
+	OkResult = "ok"
+
+	// Recorded as the principal of the calls made to engines that don't require authentication
+	UnauthenticatedPrincipal = "unauthenticated"
+
+	auditLogFilePerm = 0o600
+	auditLogDirPerm  = 0o755
+
+	// The audit log is split in a file per day and per engine replica, e.g. '2024-05-01-kurtosis-engine-0.jsonl', so
+	// that replicas never append to the same file and old days can be archived or removed as a whole
+	auditLogFileDateLayout = "2006-01-02"
+	auditLogFileExtension  = ".jsonl"
+
+	argsDigestPrefix = "sha256:"
+)
+
+// Entry is a mutating call made to the engine APIs
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	Principal string `json:"principal"`
+
+	// GrpcApi or RestApi
+	Api string `json:"api"`
+
+	// The procedure of gRPC calls, the method and route of REST calls
+	Operation string `json:"operation"`
+
+	// Digest of the arguments of the call rather than the arguments themselves, which can carry secrets
+	ArgsDigest string `json:"argsDigest"`
+
+	// OkResult, or the error code of the call
+	Result string `json:"result"`
+}
+
+type Filters struct {
+	// Entries before it are skipped; zero to keep them all
+	Since time.Time
+
+	// Entries of other principals are skipped; empty to keep them all
+	Principal string
+
+	// Only the latest entries are kept, up to this number; zero to keep them all
+	Limit int
+}
+
+// AuditLog records the mutating calls made to the engine APIs in append-only files on the engine volume, which all the
+// engine replicas share
+type AuditLog struct {
+	dirpath string
+
+	// Names the files of this engine replica
+	replicaName string
+
+	mutex sync.Mutex
+}
+
+func NewAuditLog(dirpath string, replicaName string) *AuditLog {
+	return &AuditLog{
+		dirpath:     dirpath,
+		replicaName: strings.ReplaceAll(replicaName, string(filepath.Separator), "_"),
+		mutex:       sync.Mutex{},
+	}
+}
+
+// Record appends the entry to the audit log, and only returns once it's on disk
+func (auditLog *AuditLog) Record(entry Entry) error {
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing audit log entry '%+v'", entry)
+	}
+	entryBytes = append(entryBytes, '\n')
+
+	auditLog.mutex.Lock()
+	defer auditLog.mutex.Unlock()
+	if err = os.MkdirAll(auditLog.dirpath, auditLogDirPerm); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the audit log directory '%v'", auditLog.dirpath)
+	}
+	auditLogFilepath := path.Join(auditLog.dirpath, getAuditLogFileName(entry.Timestamp, auditLog.replicaName))
+	auditLogFile, err := os.OpenFile(auditLogFilepath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, auditLogFilePerm)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening audit log file '%v'", auditLogFilepath)
+	}
+	defer auditLogFile.Close()
+	if _, err = auditLogFile.Write(entryBytes); err != nil {
+		return stacktrace.Propagate(err, "An error occurred appending to audit log file '%v'", auditLogFilepath)
+	}
+	if err = auditLogFile.Sync(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred syncing audit log file '%v'", auditLogFilepath)
+	}
+	return nil
+}
+
+// Query returns the entries recorded by every engine replica that match the filters, from the oldest to the latest
+func (auditLog *AuditLog) Query(filters Filters) ([]Entry, error) {
+	auditLogFilepaths, err := filepath.Glob(path.Join(auditLog.dirpath, "*"+auditLogFileExtension))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the audit log files in '%v'", auditLog.dirpath)
+	}
+	// Files of the days before the filter can't hold any matching entry
+	sinceDate := filters.Since.UTC().Format(auditLogFileDateLayout)
+	entries := []Entry{}
+	for _, auditLogFilepath := range auditLogFilepaths {
+		auditLogFileName := path.Base(auditLogFilepath)
+		if !filters.Since.IsZero() && len(auditLogFileName) >= len(auditLogFileDateLayout) && auditLogFileName[:len(auditLogFileDateLayout)] < sinceDate {
+			continue
+		}
+		fileEntries, err := readAuditLogFile(auditLogFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading audit log file '%v'", auditLogFilepath)
+		}
+		for _, entry := range fileEntries {
+			if entry.Timestamp.Before(filters.Since) {
+				continue
+			}
+			if filters.Principal != "" && entry.Principal != filters.Principal {
+				continue
+			}
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	if filters.Limit > 0 && len(entries) > filters.Limit {
+		entries = entries[len(entries)-filters.Limit:]
+	}
+	return entries, nil
+}
+
+// GetArgsDigest returns the digest recorded for the given serialized arguments
+func GetArgsDigest(argsBytes []byte) string {
+	argsHash := sha256.Sum256(argsBytes)
+	return argsDigestPrefix + hex.EncodeToString(argsHash[:])
+}
+
+func getAuditLogFileName(timestamp time.Time, replicaName string) string {
+	return timestamp.UTC().Format(auditLogFileDateLayout) + "-" + replicaName + auditLogFileExtension
+}
+
+// readAuditLogFile skips the lines it can't parse, e.g. the last one if the engine crashed while writing it
+func readAuditLogFile(auditLogFilepath string) ([]Entry, error) {
+	auditLogFile, err := os.Open(auditLogFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening audit log file '%v'", auditLogFilepath)
+	}
+	defer auditLogFile.Close()
+	entries := []Entry{}
+	scanner := bufio.NewScanner(auditLogFile)
+	for scanner.Scan() {
+		entry := Entry{} // nolint:exhaustruct
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred scanning audit log file '%v'", auditLogFilepath)
+	}
+	return entries, nil
+}
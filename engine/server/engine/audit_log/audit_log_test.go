@@ -0,0 +1,71 @@
+package audit_log
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_MergesReplicasAndFilters(t *testing.T) {
+	dirpath := t.TempDir()
+	firstReplicaLog := NewAuditLog(dirpath, "engine-0")
+	secondReplicaLog := NewAuditLog(dirpath, "engine-1")
+
+	yesterday := time.Date(2024, time.May, 1, 23, 0, 0, 0, time.UTC)
+	today := yesterday.Add(2 * time.Hour)
+	require.NoError(t, firstReplicaLog.Record(newTestEntry(yesterday, "token:ci", "/engine_api.EngineService/CreateEnclave")))
+	require.NoError(t, secondReplicaLog.Record(newTestEntry(today.Add(time.Minute), "token:ci", "/engine_api.EngineService/DestroyEnclave")))
+	require.NoError(t, firstReplicaLog.Record(newTestEntry(today, "oidc:jane@example.com", "/engine_api.EngineService/Clean")))
+
+	entries, err := firstReplicaLog.Query(Filters{Since: time.Time{}, Principal: "", Limit: 0})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, "/engine_api.EngineService/CreateEnclave", entries[0].Operation)
+	require.Equal(t, "/engine_api.EngineService/Clean", entries[1].Operation)
+	require.Equal(t, "/engine_api.EngineService/DestroyEnclave", entries[2].Operation)
+
+	entries, err = secondReplicaLog.Query(Filters{Since: today, Principal: "token:ci", Limit: 0})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "/engine_api.EngineService/DestroyEnclave", entries[0].Operation)
+
+	entries, err = secondReplicaLog.Query(Filters{Since: time.Time{}, Principal: "", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "/engine_api.EngineService/Clean", entries[0].Operation)
+}
+
+func TestQuery_SkipsTruncatedEntries(t *testing.T) {
+	dirpath := t.TempDir()
+	auditLog := NewAuditLog(dirpath, "engine")
+	timestamp := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, auditLog.Record(newTestEntry(timestamp, "token:ci", "/engine_api.EngineService/CreateEnclave")))
+
+	auditLogFile, err := os.OpenFile(path.Join(dirpath, getAuditLogFileName(timestamp, "engine")), os.O_APPEND|os.O_WRONLY, auditLogFilePerm)
+	require.NoError(t, err)
+	_, err = auditLogFile.WriteString(`{"timestamp":"2024-05-01T12:01:00Z","princ`)
+	require.NoError(t, err)
+	require.NoError(t, auditLogFile.Close())
+
+	entries, err := auditLog.Query(Filters{Since: time.Time{}, Principal: "", Limit: 0})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestGetArgsDigest(t *testing.T) {
+	require.Equal(t, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", GetArgsDigest([]byte{}))
+}
+
+func newTestEntry(timestamp time.Time, principal string, operation string) Entry {
+	return Entry{
+		Timestamp:  timestamp,
+		Principal:  principal,
+		Api:        GrpcApi,
+		Operation:  operation,
+		ArgsDigest: GetArgsDigest([]byte(operation)),
+		Result:     OkResult,
+	}
+}
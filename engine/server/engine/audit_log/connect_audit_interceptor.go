@@ -0,0 +1,111 @@
+package audit_log
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// ConnectAuditInterceptor records the mutating calls made to the engine gRPC API in the audit log
+type ConnectAuditInterceptor struct {
+	auditLog *AuditLog
+}
+
+func NewConnectAuditInterceptor(auditLog *AuditLog) *ConnectAuditInterceptor {
+	return &ConnectAuditInterceptor{
+		auditLog: auditLog,
+	}
+}
+
+func (interceptor *ConnectAuditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := request.Spec().Procedure
+		if !auth.IsMutatingProcedure(procedure) {
+			return next(ctx, request)
+		}
+		timestamp := time.Now()
+		response, err := next(ctx, request)
+		interceptor.record(ctx, timestamp, procedure, getMessageDigest(request.Any()), err)
+		return response, err
+	}
+}
+
+// WrapStreamingClient is a no-op as the engine doesn't make calls through this interceptor
+func (interceptor *ConnectAuditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *ConnectAuditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		procedure := conn.Spec().Procedure
+		if !auth.IsMutatingProcedure(procedure) {
+			return next(ctx, conn)
+		}
+		timestamp := time.Now()
+		digestingConn := &firstMessageDigestingConn{StreamingHandlerConn: conn, firstMessageDigest: ""}
+		err := next(ctx, digestingConn)
+		interceptor.record(ctx, timestamp, procedure, digestingConn.firstMessageDigest, err)
+		return err
+	}
+}
+
+// record doesn't fail the call if the audit log can't be written, as the call already happened
+func (interceptor *ConnectAuditInterceptor) record(ctx context.Context, timestamp time.Time, procedure string, argsDigest string, callErr error) {
+	result := OkResult
+	if callErr != nil {
+		result = connect.CodeOf(callErr).String()
+	}
+	entry := Entry{
+		Timestamp:  timestamp,
+		Principal:  getPrincipalName(ctx),
+		Api:        GrpcApi,
+		Operation:  procedure,
+		ArgsDigest: argsDigest,
+		Result:     result,
+	}
+	if err := interceptor.auditLog.Record(entry); err != nil {
+		logrus.Errorf("An error occurred recording call to '%v' by '%v' in the audit log:\n%v", procedure, entry.Principal, err)
+	}
+}
+
+// firstMessageDigestingConn keeps the digest of the first message of the stream, which carries the arguments of the call
+type firstMessageDigestingConn struct {
+	connect.StreamingHandlerConn
+
+	firstMessageDigest string
+}
+
+func (conn *firstMessageDigestingConn) Receive(message any) error {
+	if err := conn.StreamingHandlerConn.Receive(message); err != nil {
+		return err
+	}
+	if conn.firstMessageDigest == "" {
+		conn.firstMessageDigest = getMessageDigest(message)
+	}
+	return nil
+}
+
+func getMessageDigest(message any) string {
+	protoMessage, ok := message.(proto.Message)
+	if !ok {
+		return ""
+	}
+	messageBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(protoMessage) // nolint:exhaustruct
+	if err != nil {
+		logrus.Warnf("An error occurred serializing the arguments of a call to digest them for the audit log:\n%v", err)
+		return ""
+	}
+	return GetArgsDigest(messageBytes)
+}
+
+func getPrincipalName(ctx context.Context) string {
+	principal := auth.GetPrincipalFromContext(ctx)
+	if principal == nil {
+		return UnauthenticatedPrincipal
+	}
+	return principal.Name
+}
@@ -0,0 +1,69 @@
+package audit_log
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	unmatchedRoute = "unmatched"
+)
+
+// NewEchoAuditMiddleware records the mutating calls made to the engine REST API in the audit log. Calls are recorded
+// with their route, e.g. '/api/enclaves/:enclave_identifier', along with the digest of their path and body
+func NewEchoAuditMiddleware(auditLog *AuditLog) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			if !auth.IsMutatingHttpMethod(request.Method) {
+				return next(ctx)
+			}
+			timestamp := time.Now()
+			var body []byte
+			if request.Body != nil {
+				var err error
+				body, err = io.ReadAll(request.Body)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusBadRequest, "An error occurred reading the request body")
+				}
+				request.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			err := next(ctx)
+
+			statusCode := ctx.Response().Status
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				statusCode = httpErr.Code
+			} else if err != nil {
+				statusCode = http.StatusInternalServerError
+			}
+			route := ctx.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			result := OkResult
+			if statusCode >= http.StatusBadRequest {
+				result = strconv.Itoa(statusCode)
+			}
+			entry := Entry{
+				Timestamp:  timestamp,
+				Principal:  getPrincipalName(request.Context()),
+				Api:        RestApi,
+				Operation:  request.Method + " " + route,
+				ArgsDigest: GetArgsDigest(append([]byte(request.URL.RequestURI()+"\n"), body...)),
+				Result:     result,
+			}
+			if recordErr := auditLog.Record(entry); recordErr != nil {
+				logrus.Errorf("An error occurred recording call to '%v' by '%v' in the audit log:\n%v", entry.Operation, entry.Principal, recordErr)
+			}
+			return err
+		}
+	}
+}
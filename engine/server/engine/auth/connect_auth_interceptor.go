@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// Procedures that change how the whole engine behaves, for every principal
+var adminScopeProcedures = map[string]bool{
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceUpdateEngineConfigProcedure: true,
+}
+
+// Procedures that only admins can call but that don't change anything
+var readOnlyAdminScopeProcedures = map[string]bool{
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetAuditLogProcedure: true,
+}
+
+// Procedures not listed here or above require the write scope, so that new procedures are locked down until they get
+// classified
+var readScopeProcedures = map[string]bool{
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEngineInfoProcedure:                              true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceNegotiateApiVersionProcedure:                        true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEngineHealthProcedure:                            true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclavesProcedure:                                true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceListEnclavesProcedure:                               true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure: true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetServiceLogsProcedure:                             true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetServiceResourceUsageProcedure:                    true,
+}
+
+// ConnectAuthInterceptor rejects the calls to the engine gRPC API that don't carry a token granting the scope the
+// called procedure requires
+type ConnectAuthInterceptor struct {
+	authenticator *EngineAuthenticator
+}
+
+func NewConnectAuthInterceptor(authenticator *EngineAuthenticator) *ConnectAuthInterceptor {
+	return &ConnectAuthInterceptor{
+		authenticator: authenticator,
+	}
+}
+
+func (interceptor *ConnectAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		principal, err := interceptor.authorize(ctx, request.Spec().Procedure, request.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ContextWithPrincipal(ctx, principal), request)
+	}
+}
+
+// WrapStreamingClient is a no-op as the engine doesn't make calls through this interceptor
+func (interceptor *ConnectAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *ConnectAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		principal, err := interceptor.authorize(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ContextWithPrincipal(ctx, principal), conn)
+	}
+}
+
+func (interceptor *ConnectAuthInterceptor) authorize(ctx context.Context, procedure string, header http.Header) (*Principal, error) {
+	principal, err := interceptor.authenticator.Authenticate(ctx, header.Get(AuthorizationHeader))
+	if err != nil {
+		logrus.Debugf("Rejected unauthenticated call to '%v':\n%v", procedure, err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, stacktrace.NewError("A valid engine token is required to call '%v'", procedure))
+	}
+	requiredScope := getProcedureRequiredScope(procedure)
+	if !principal.IsGranted(requiredScope) {
+		logrus.Debugf("Rejected call to '%v' by '%v' which lacks scope '%v'", procedure, principal.Name, requiredScope)
+		return nil, connect.NewError(connect.CodePermissionDenied, stacktrace.NewError("Calling '%v' requires scope '%v', which the token doesn't grant", procedure, requiredScope))
+	}
+	logrus.Debugf("Authorized call to '%v' by '%v'", procedure, principal.Name)
+	return principal, nil
+}
+
+// IsMutatingProcedure returns true if calling the procedure can change the engine or its enclaves
+func IsMutatingProcedure(procedure string) bool {
+	return !readScopeProcedures[procedure] && !readOnlyAdminScopeProcedures[procedure]
+}
+
+func getProcedureRequiredScope(procedure string) string {
+	if adminScopeProcedures[procedure] || readOnlyAdminScopeProcedures[procedure] {
+		return args.EngineAuthScope_Admin
+	}
+	if readScopeProcedures[procedure] {
+		return args.EngineAuthScope_Read
+	}
+	return args.EngineAuthScope_Write
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Browsers can't set headers on WebSocket connections, so WebSocket clients send their token base64url-encoded in
+	// a subprotocol instead, e.g. 'base64url.bearer.kurtosis.engine.<token>', next to the protocol the engine accepts
+	webSocketBearerTokenSubprotocolPrefix = "base64url.bearer.kurtosis.engine."
+
+	webSocketSubprotocolHeader     = "Sec-WebSocket-Protocol"
+	webSocketSubprotocolsSeparator = ","
+	bearerAuthorizationPrefix      = "Bearer "
+)
+
+// NewEchoAuthMiddleware rejects the calls to the engine REST API that don't carry a token granting the scope the call
+// requires: the read scope for GET and HEAD requests, the write scope for everything else
+func NewEchoAuthMiddleware(authenticator *EngineAuthenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			principal, err := authenticator.Authenticate(request.Context(), getAuthorizationHeaderValue(request))
+			if err != nil {
+				logrus.Debugf("Rejected unauthenticated call to '%v %v':\n%v", request.Method, request.URL.Path, err)
+				return ctx.JSON(http.StatusUnauthorized, api_type.ResponseInfo{
+					Code:    http.StatusUnauthorized,
+					Message: "A valid engine token is required",
+					Type:    api_type.ERROR,
+				})
+			}
+			requiredScope := getHttpMethodRequiredScope(request.Method)
+			if !principal.IsGranted(requiredScope) {
+				logrus.Debugf("Rejected call to '%v %v' by '%v' which lacks scope '%v'", request.Method, request.URL.Path, principal.Name, requiredScope)
+				return ctx.JSON(http.StatusForbidden, api_type.ResponseInfo{
+					Code:    http.StatusForbidden,
+					Message: fmt.Sprintf("This call requires scope '%v', which the token doesn't grant", requiredScope),
+					Type:    api_type.ERROR,
+				})
+			}
+			logrus.Debugf("Authorized call to '%v %v' by '%v'", request.Method, request.URL.Path, principal.Name)
+			ctx.SetRequest(request.WithContext(ContextWithPrincipal(request.Context(), principal)))
+			return next(ctx)
+		}
+	}
+}
+
+// getAuthorizationHeaderValue falls back to the token WebSocket clients send in a subprotocol when the call has no
+// Authorization header
+func getAuthorizationHeaderValue(request *http.Request) string {
+	if authorizationHeaderValue := request.Header.Get(AuthorizationHeader); authorizationHeaderValue != "" {
+		return authorizationHeaderValue
+	}
+	for _, subprotocolsHeaderValue := range request.Header.Values(webSocketSubprotocolHeader) {
+		for _, subprotocol := range strings.Split(subprotocolsHeaderValue, webSocketSubprotocolsSeparator) {
+			encodedToken, found := strings.CutPrefix(strings.TrimSpace(subprotocol), webSocketBearerTokenSubprotocolPrefix)
+			if !found {
+				continue
+			}
+			token, err := base64.RawURLEncoding.DecodeString(encodedToken)
+			if err != nil {
+				logrus.Debugf("Ignored WebSocket subprotocol carrying a token that isn't base64url-encoded:\n%v", err)
+				continue
+			}
+			return bearerAuthorizationPrefix + string(token)
+		}
+	}
+	return ""
+}
+
+// IsMutatingHttpMethod returns true if calls to the engine REST API with the method can change the engine or its enclaves
+func IsMutatingHttpMethod(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead
+}
+
+func getHttpMethodRequiredScope(method string) string {
+	if !IsMutatingHttpMethod(method) {
+		return args.EngineAuthScope_Read
+	}
+	return args.EngineAuthScope_Write
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEchoAuthMiddleware_AcceptsTokenInWebSocketSubprotocol(t *testing.T) {
+	authenticator := NewEngineAuthenticator(args.EngineAuthConfig{
+		StaticTokens: []args.StaticTokenConfig{
+			{Name: "viewer", TokenSha256: hashToken(viewerToken), Scopes: []string{args.EngineAuthScope_Read}},
+		},
+		Oidc: nil,
+	})
+	handler := NewEchoAuthMiddleware(authenticator)(func(ctx echo.Context) error {
+		require.Equal(t, "token:viewer", GetPrincipalFromContext(ctx.Request().Context()).Name)
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	callWithSubprotocols := func(subprotocols string) int {
+		request := httptest.NewRequest(http.MethodGet, "/enclaves/test-enclave/logs", nil)
+		request.Header.Set("Sec-WebSocket-Protocol", subprotocols)
+		recorder := httptest.NewRecorder()
+		require.NoError(t, handler(echo.New().NewContext(request, recorder)))
+		return recorder.Code
+	}
+
+	encodedToken := base64.RawURLEncoding.EncodeToString([]byte(viewerToken))
+	require.Equal(t, http.StatusOK, callWithSubprotocols("kurtosis.engine.v1, base64url.bearer.kurtosis.engine."+encodedToken))
+	require.Equal(t, http.StatusUnauthorized, callWithSubprotocols("kurtosis.engine.v1"))
+	require.Equal(t, http.StatusUnauthorized, callWithSubprotocols("base64url.bearer.kurtosis.engine.not base64url"))
+	require.Equal(t, http.StatusUnauthorized, callWithSubprotocols("base64url.bearer.kurtosis.engine."+base64.RawURLEncoding.EncodeToString([]byte("unknown-token"))))
+}
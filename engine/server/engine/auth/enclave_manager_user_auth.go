@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+
+	em_api "github.com/kurtosis-tech/kurtosis/enclave-manager/server"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// NewEnclaveManagerUserAuthenticator returns what logs users in to the enclave manager; their OIDC ID tokens are
+// checked the same way as the OIDC tokens of the calls to the engine
+func NewEnclaveManagerUserAuthenticator(config args.EnclaveManagerAuthConfig) (*em_api.UserAuthenticator, error) {
+	users := []em_api.LocalUser{}
+	for _, user := range config.Users {
+		users = append(users, em_api.LocalUser{
+			Username:       user.Username,
+			PasswordBcrypt: user.PasswordBcrypt,
+			Scopes:         user.Scopes,
+		})
+	}
+
+	var oidcLoginConfig *em_api.OidcLoginConfig
+	if config.Oidc != nil {
+		validator := newOidcTokenValidator(*config.Oidc)
+		oidcLoginConfig = &em_api.OidcLoginConfig{
+			IssuerUrl: config.Oidc.IssuerUrl,
+			ClientId:  config.Oidc.Audience,
+			ValidateIdToken: func(ctx context.Context, idToken string) (string, []string, error) {
+				principal, err := validator.validate(ctx, idToken)
+				if err != nil {
+					return "", nil, stacktrace.Propagate(err, "An error occurred validating the OIDC ID token")
+				}
+				return principal.Name, principal.Scopes, nil
+			},
+		}
+	}
+
+	authenticator, err := em_api.NewUserAuthenticator(em_api.UserAuthConfig{
+		Users: users,
+		Oidc:  oidcLoginConfig,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave manager user authenticator")
+	}
+	return authenticator, nil
+}
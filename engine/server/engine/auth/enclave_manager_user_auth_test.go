@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	em_api "github.com/kurtosis-tech/kurtosis/enclave-manager/server"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	testUsername = "jane"
+	testPassword = "correct horse battery staple"
+)
+
+func TestEnclaveManagerUserAuthenticator_PasswordLogin(t *testing.T) {
+	passwordBcrypt, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	require.NoError(t, err)
+	authenticator, err := NewEnclaveManagerUserAuthenticator(args.EnclaveManagerAuthConfig{
+		Users: []args.EnclaveManagerUserConfig{
+			{Username: testUsername, PasswordBcrypt: string(passwordBcrypt), Scopes: []string{args.EngineAuthScope_Read}},
+		},
+		Oidc: nil,
+	})
+	require.NoError(t, err)
+	require.True(t, authenticator.IsPasswordLoginEnabled())
+	require.Nil(t, authenticator.GetOidcLoginConfig())
+
+	_, _, err = authenticator.LoginWithPassword(testUsername, "wrong password")
+	require.Error(t, err)
+	_, _, err = authenticator.LoginWithPassword("unknown", testPassword)
+	require.Error(t, err)
+	_, _, err = authenticator.LoginWithOidcIdToken(context.Background(), "some-id-token")
+	require.Error(t, err)
+
+	token, session, err := authenticator.LoginWithPassword(testUsername, testPassword)
+	require.NoError(t, err)
+	require.Equal(t, testUsername, session.Username)
+	require.True(t, session.ExpiresAt.After(time.Now()))
+
+	authenticatedSession, err := authenticator.Authenticate(context.Background(), "Bearer "+token)
+	require.NoError(t, err)
+	require.Equal(t, testUsername, authenticatedSession.Username)
+	require.True(t, authenticatedSession.IsGranted(em_api.UserAuthScope_Read))
+	require.False(t, authenticatedSession.IsGranted(em_api.UserAuthScope_Write))
+
+	_, err = authenticator.Authenticate(context.Background(), "Bearer unknown-token")
+	require.Error(t, err)
+
+	require.NoError(t, authenticator.Logout("Bearer "+token))
+	_, err = authenticator.Authenticate(context.Background(), "Bearer "+token)
+	require.Error(t, err)
+}
+
+func TestEnclaveManagerUserAuthenticator_OidcLogin(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, testRsaBits)
+	require.NoError(t, err)
+	issuer := startTestOidcProvider(t, &privateKey.PublicKey)
+
+	authenticator, err := NewEnclaveManagerUserAuthenticator(args.EnclaveManagerAuthConfig{
+		Users: nil,
+		Oidc: &args.OidcConfig{
+			IssuerUrl:   issuer,
+			Audience:    testAudience,
+			ScopesClaim: "",
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, authenticator.IsPasswordLoginEnabled())
+	require.Equal(t, testAudience, authenticator.GetOidcLoginConfig().ClientId)
+
+	idToken := signTestToken(t, privateKey, jwt.MapClaims{
+		"iss":   issuer,
+		"aud":   testAudience,
+		"sub":   testUsername,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": "openid write",
+	})
+	token, session, err := authenticator.LoginWithOidcIdToken(context.Background(), idToken)
+	require.NoError(t, err)
+	require.Equal(t, "oidc:"+testUsername, session.Username)
+
+	authenticatedSession, err := authenticator.Authenticate(context.Background(), "Bearer "+token)
+	require.NoError(t, err)
+	require.True(t, authenticatedSession.IsGranted(em_api.UserAuthScope_Write))
+	require.False(t, authenticatedSession.IsGranted(em_api.UserAuthScope_Admin))
+
+	noScopeIdToken := signTestToken(t, privateKey, jwt.MapClaims{
+		"iss": issuer,
+		"aud": testAudience,
+		"sub": testUsername,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	_, _, err = authenticator.LoginWithOidcIdToken(context.Background(), noScopeIdToken)
+	require.Error(t, err)
+
+	// The ID tokens the CLI logs in with are accepted without a session
+	directSession, err := authenticator.Authenticate(context.Background(), "Bearer "+idToken)
+	require.NoError(t, err)
+	require.Equal(t, "oidc:"+testUsername, directSession.Username)
+	require.True(t, directSession.IsGranted(em_api.UserAuthScope_Write))
+
+	_, err = authenticator.Authenticate(context.Background(), "Bearer "+noScopeIdToken)
+	require.Error(t, err)
+}
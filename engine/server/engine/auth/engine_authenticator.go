@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	AuthorizationHeader = "Authorization"
+
+	bearerAuthScheme            = "bearer"
+	authorizationHeaderNumParts = 2
+
+	staticTokenPrincipalPrefix = "token:"
+)
+
+// Principal is who a call to the engine is made on behalf of
+type Principal struct {
+	// Name shows up in the engine logs, e.g. 'token:ci' or 'oidc:jane@example.com'
+	Name string
+
+	Scopes []string
+}
+
+// IsGranted returns true if the principal can make calls requiring the given scope
+func (principal *Principal) IsGranted(requiredScope string) bool {
+	return args.IsEngineAuthScopeGranted(principal.Scopes, requiredScope)
+}
+
+// EngineAuthenticator resolves the bearer token carried by a call to the engine to the principal it belongs to
+type EngineAuthenticator struct {
+	// Guards the tokens, which change when the engine config gets reloaded
+	mutex sync.RWMutex
+
+	staticTokens []args.StaticTokenConfig
+
+	// nil if OIDC tokens aren't accepted
+	oidcValidator *oidcTokenValidator
+}
+
+func NewEngineAuthenticator(config args.EngineAuthConfig) *EngineAuthenticator {
+	authenticator := &EngineAuthenticator{
+		mutex:         sync.RWMutex{},
+		staticTokens:  nil,
+		oidcValidator: nil,
+	}
+	authenticator.UpdateConfig(config)
+	return authenticator
+}
+
+// UpdateConfig replaces the tokens the calls are authenticated against. The keys of the OIDC provider are kept if its
+// config doesn't change
+func (authenticator *EngineAuthenticator) UpdateConfig(config args.EngineAuthConfig) {
+	authenticator.mutex.Lock()
+	defer authenticator.mutex.Unlock()
+
+	authenticator.staticTokens = config.StaticTokens
+	if config.Oidc == nil {
+		authenticator.oidcValidator = nil
+	} else if authenticator.oidcValidator == nil || !reflect.DeepEqual(authenticator.oidcValidator.config, *config.Oidc) {
+		authenticator.oidcValidator = newOidcTokenValidator(*config.Oidc)
+	}
+}
+
+// Authenticate returns the principal of the bearer token in the value of the Authorization header, or an error if the
+// token is missing or isn't valid
+func (authenticator *EngineAuthenticator) Authenticate(ctx context.Context, authorizationHeaderValue string) (*Principal, error) {
+	token, err := getBearerToken(authorizationHeaderValue)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the bearer token of the call")
+	}
+
+	authenticator.mutex.RLock()
+	staticTokens := authenticator.staticTokens
+	oidcValidator := authenticator.oidcValidator
+	authenticator.mutex.RUnlock()
+
+	tokenHash := sha256.Sum256([]byte(token))
+	tokenHashHex := hex.EncodeToString(tokenHash[:])
+	for _, staticToken := range staticTokens {
+		if subtle.ConstantTimeCompare([]byte(tokenHashHex), []byte(strings.ToLower(staticToken.TokenSha256))) == 1 {
+			return &Principal{
+				Name:   staticTokenPrincipalPrefix + staticToken.Name,
+				Scopes: staticToken.Scopes,
+			}, nil
+		}
+	}
+
+	if oidcValidator == nil {
+		return nil, stacktrace.NewError("The bearer token doesn't match any of the engine tokens")
+	}
+	principal, err := oidcValidator.validate(ctx, token)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The bearer token doesn't match any of the engine tokens and isn't a valid OIDC token")
+	}
+	return principal, nil
+}
+
+func getBearerToken(authorizationHeaderValue string) (string, error) {
+	if authorizationHeaderValue == "" {
+		return "", stacktrace.NewError("The call has no '%v' header", AuthorizationHeader)
+	}
+	headerParts := strings.SplitN(strings.TrimSpace(authorizationHeaderValue), " ", authorizationHeaderNumParts)
+	if len(headerParts) != authorizationHeaderNumParts || !strings.EqualFold(headerParts[0], bearerAuthScheme) {
+		return "", stacktrace.NewError("The '%v' header must have the form 'Bearer <token>'", AuthorizationHeader)
+	}
+	token := strings.TrimSpace(headerParts[1])
+	if token == "" {
+		return "", stacktrace.NewError("The '%v' header has an empty bearer token", AuthorizationHeader)
+	}
+	return token, nil
+}
+
+type principalContextKey struct{}
+
+// ContextWithPrincipal returns a copy of the context carrying the principal a call is made on behalf of
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// GetPrincipalFromContext returns the principal a call is made on behalf of, or nil if the engine doesn't require
+// authentication
+func GetPrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalContextKey{}).(*Principal)
+	return principal
+}
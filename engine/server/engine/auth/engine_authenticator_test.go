@@ -0,0 +1,239 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ciToken     = "ci-token"
+	viewerToken = "viewer-token"
+
+	testKeyId    = "test-key"
+	testAudience = "kurtosis-engine"
+	testRsaBits  = 2048
+)
+
+func TestAuthenticate_StaticTokens(t *testing.T) {
+	authenticator := NewEngineAuthenticator(args.EngineAuthConfig{
+		StaticTokens: []args.StaticTokenConfig{
+			{Name: "ci", TokenSha256: hashToken(ciToken), Scopes: []string{args.EngineAuthScope_Write}},
+			{Name: "viewer", TokenSha256: hashToken(viewerToken), Scopes: []string{args.EngineAuthScope_Read}},
+		},
+		Oidc: nil,
+	})
+	ctx := context.Background()
+
+	principal, err := authenticator.Authenticate(ctx, "Bearer "+ciToken)
+	require.NoError(t, err)
+	require.Equal(t, "token:ci", principal.Name)
+	require.True(t, principal.IsGranted(args.EngineAuthScope_Read))
+	require.True(t, principal.IsGranted(args.EngineAuthScope_Write))
+
+	principal, err = authenticator.Authenticate(ctx, "bearer "+viewerToken)
+	require.NoError(t, err)
+	require.Equal(t, "token:viewer", principal.Name)
+	require.True(t, principal.IsGranted(args.EngineAuthScope_Read))
+	require.False(t, principal.IsGranted(args.EngineAuthScope_Write))
+
+	_, err = authenticator.Authenticate(ctx, "Bearer unknown-token")
+	require.Error(t, err)
+	_, err = authenticator.Authenticate(ctx, "")
+	require.Error(t, err)
+	_, err = authenticator.Authenticate(ctx, "Basic "+ciToken)
+	require.Error(t, err)
+}
+
+func TestAuthenticate_UpdateConfig(t *testing.T) {
+	authenticator := NewEngineAuthenticator(args.EngineAuthConfig{
+		StaticTokens: []args.StaticTokenConfig{
+			{Name: "ci", TokenSha256: hashToken(ciToken), Scopes: []string{args.EngineAuthScope_Write}},
+		},
+		Oidc: nil,
+	})
+	ctx := context.Background()
+
+	_, err := authenticator.Authenticate(ctx, "Bearer "+ciToken)
+	require.NoError(t, err)
+
+	authenticator.UpdateConfig(args.EngineAuthConfig{
+		StaticTokens: []args.StaticTokenConfig{
+			{Name: "viewer", TokenSha256: hashToken(viewerToken), Scopes: []string{args.EngineAuthScope_Read}},
+		},
+		Oidc: nil,
+	})
+
+	_, err = authenticator.Authenticate(ctx, "Bearer "+ciToken)
+	require.Error(t, err)
+	principal, err := authenticator.Authenticate(ctx, "Bearer "+viewerToken)
+	require.NoError(t, err)
+	require.Equal(t, "token:viewer", principal.Name)
+}
+
+func TestAuthenticate_OidcTokens(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, testRsaBits)
+	require.NoError(t, err)
+	issuer := startTestOidcProvider(t, &privateKey.PublicKey)
+
+	authenticator := NewEngineAuthenticator(args.EngineAuthConfig{
+		StaticTokens: nil,
+		Oidc: &args.OidcConfig{
+			IssuerUrl:   issuer,
+			Audience:    testAudience,
+			ScopesClaim: "",
+		},
+	})
+	ctx := context.Background()
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   issuer,
+			"aud":   []string{"other", testAudience},
+			"sub":   "jane",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"scope": "openid read",
+		}
+	}
+
+	principal, err := authenticator.Authenticate(ctx, "Bearer "+signTestToken(t, privateKey, validClaims()))
+	require.NoError(t, err)
+	require.Equal(t, "oidc:jane", principal.Name)
+	require.True(t, principal.IsGranted(args.EngineAuthScope_Read))
+	require.False(t, principal.IsGranted(args.EngineAuthScope_Write))
+
+	expiredClaims := validClaims()
+	expiredClaims["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = authenticator.Authenticate(ctx, "Bearer "+signTestToken(t, privateKey, expiredClaims))
+	require.Error(t, err)
+
+	wrongAudienceClaims := validClaims()
+	wrongAudienceClaims["aud"] = "other"
+	_, err = authenticator.Authenticate(ctx, "Bearer "+signTestToken(t, privateKey, wrongAudienceClaims))
+	require.Error(t, err)
+
+	wrongIssuerClaims := validClaims()
+	wrongIssuerClaims["iss"] = "https://attacker.example.com"
+	_, err = authenticator.Authenticate(ctx, "Bearer "+signTestToken(t, privateKey, wrongIssuerClaims))
+	require.Error(t, err)
+
+	otherPrivateKey, err := rsa.GenerateKey(rand.Reader, testRsaBits)
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate(ctx, "Bearer "+signTestToken(t, otherPrivateKey, validClaims()))
+	require.Error(t, err)
+}
+
+func TestAuthenticate_OidcGroupsAreMappedToScopes(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, testRsaBits)
+	require.NoError(t, err)
+	issuer := startTestOidcProvider(t, &privateKey.PublicKey)
+
+	authenticator := NewEngineAuthenticator(args.EngineAuthConfig{
+		StaticTokens: nil,
+		Oidc: &args.OidcConfig{
+			IssuerUrl:   issuer,
+			Audience:    testAudience,
+			ScopesClaim: "",
+			GroupsClaim: "",
+			GroupScopes: map[string][]string{
+				"kurtosis-admins":  {string(args.EngineAuthScope_Admin)},
+				"kurtosis-readers": {string(args.EngineAuthScope_Read)},
+			},
+		},
+	})
+	ctx := context.Background()
+	claims := jwt.MapClaims{
+		"iss":    issuer,
+		"aud":    testAudience,
+		"sub":    "jane",
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"groups": []string{"kurtosis-readers", "unrelated"},
+	}
+
+	principal, err := authenticator.Authenticate(ctx, "Bearer "+signTestToken(t, privateKey, claims))
+	require.NoError(t, err)
+	require.True(t, principal.IsGranted(args.EngineAuthScope_Read))
+	require.False(t, principal.IsGranted(args.EngineAuthScope_Write))
+
+	claims["groups"] = []string{"kurtosis-admins"}
+	principal, err = authenticator.Authenticate(ctx, "Bearer "+signTestToken(t, privateKey, claims))
+	require.NoError(t, err)
+	require.True(t, principal.IsGranted(args.EngineAuthScope_Admin))
+
+	claims["groups"] = []string{"unrelated"}
+	principal, err = authenticator.Authenticate(ctx, "Bearer "+signTestToken(t, privateKey, claims))
+	require.NoError(t, err)
+	require.False(t, principal.IsGranted(args.EngineAuthScope_Read))
+}
+
+func TestGetProcedureRequiredScope(t *testing.T) {
+	require.Equal(t, args.EngineAuthScope_Read, getProcedureRequiredScope("/engine_api.EngineService/GetEnclaves"))
+	require.Equal(t, args.EngineAuthScope_Write, getProcedureRequiredScope("/engine_api.EngineService/DestroyEnclave"))
+	require.Equal(t, args.EngineAuthScope_Write, getProcedureRequiredScope("/engine_api.EngineService/GetApiContainerTlsCredentials"))
+	require.Equal(t, args.EngineAuthScope_Write, getProcedureRequiredScope("/engine_api.EngineService/SomeNewProcedure"))
+	require.Equal(t, args.EngineAuthScope_Admin, getProcedureRequiredScope("/engine_api.EngineService/UpdateEngineConfig"))
+	require.Equal(t, args.EngineAuthScope_Admin, getProcedureRequiredScope("/engine_api.EngineService/GetAuditLog"))
+}
+
+func TestIsMutatingProcedure(t *testing.T) {
+	require.True(t, IsMutatingProcedure("/engine_api.EngineService/DestroyEnclave"))
+	require.True(t, IsMutatingProcedure("/engine_api.EngineService/UpdateEngineConfig"))
+	require.False(t, IsMutatingProcedure("/engine_api.EngineService/GetEnclaves"))
+	require.False(t, IsMutatingProcedure("/engine_api.EngineService/GetAuditLog"))
+}
+
+func hashToken(token string) string {
+	tokenHash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(tokenHash[:])
+}
+
+func signTestToken(t *testing.T, privateKey *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header[keyIdHeader] = testKeyId
+	signedToken, err := token.SignedString(privateKey)
+	require.NoError(t, err)
+	return signedToken
+}
+
+// startTestOidcProvider serves the discovery document and the key set of a provider whose only key is the given one,
+// and returns its issuer URL
+func startTestOidcProvider(t *testing.T, publicKey *rsa.PublicKey) string {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(oidcDiscoveryPath, func(writer http.ResponseWriter, _ *http.Request) {
+		require.NoError(t, json.NewEncoder(writer).Encode(oidcDiscoveryDocument{
+			Issuer:  server.URL,
+			JwksUri: server.URL + "/keys",
+		}))
+	})
+	mux.HandleFunc("/keys", func(writer http.ResponseWriter, _ *http.Request) {
+		require.NoError(t, json.NewEncoder(writer).Encode(jsonWebKeySet{
+			Keys: []jsonWebKey{
+				{
+					KeyType:  rsaKeyType,
+					KeyId:    testKeyId,
+					Modulus:  base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+					Exponent: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+					Curve:    "",
+					X:        "",
+					Y:        "",
+				},
+			},
+		}))
+	})
+	return server.URL
+}
@@ -0,0 +1,275 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+	oidcHttpRequestTimeout = 10 * time.Second
+
+	// Keys are fetched again when a token is signed with an unknown key (e.g. after the provider rotated its keys), but
+	// no more often than this so that garbage tokens can't be used to hammer the provider
+	minTimeBetweenKeysRefreshes = time.Minute
+
+	keyIdHeader = "kid"
+
+	subjectClaim = "sub"
+
+	oidcPrincipalPrefix = "oidc:"
+
+	rsaKeyType = "RSA"
+	ecKeyType  = "EC"
+
+	issuerUrlTrailingSlash = "/"
+)
+
+var (
+	acceptedSigningMethods = []string{
+		jwt.SigningMethodRS256.Alg(),
+		jwt.SigningMethodRS384.Alg(),
+		jwt.SigningMethodRS512.Alg(),
+		jwt.SigningMethodES256.Alg(),
+		jwt.SigningMethodES384.Alg(),
+		jwt.SigningMethodES512.Alg(),
+	}
+
+	ellipticCurvesByName = map[string]elliptic.Curve{
+		"P-256": elliptic.P256(),
+		"P-384": elliptic.P384(),
+		"P-521": elliptic.P521(),
+	}
+)
+
+type oidcDiscoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JwksUri string `json:"jwks_uri"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyId   string `json:"kid"`
+	// RSA
+	Modulus  string `json:"n"`
+	Exponent string `json:"e"`
+	// EC
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+// oidcTokenValidator checks the signature and the claims of the JWTs issued by an OpenID Connect provider. The keys of
+// the provider are discovered on first use, so that the engine starts even if the provider can't be reached yet
+type oidcTokenValidator struct {
+	config     args.OidcConfig
+	httpClient *http.Client
+
+	mutex            sync.Mutex
+	publicKeysById   map[string]interface{}
+	lastKeysRefresh  time.Time
+	hasFetchedAnyKey bool
+}
+
+func newOidcTokenValidator(config args.OidcConfig) *oidcTokenValidator {
+	return &oidcTokenValidator{
+		config: config,
+		// nolint:exhaustruct
+		httpClient:       &http.Client{Timeout: oidcHttpRequestTimeout},
+		mutex:            sync.Mutex{},
+		publicKeysById:   map[string]interface{}{},
+		lastKeysRefresh:  time.Time{},
+		hasFetchedAnyKey: false,
+	}
+}
+
+func (validator *oidcTokenValidator) validate(ctx context.Context, tokenString string) (*Principal, error) {
+	// nolint:exhaustruct
+	parser := &jwt.Parser{ValidMethods: acceptedSigningMethods}
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		keyId, _ := token.Header[keyIdHeader].(string)
+		return validator.getPublicKey(ctx, keyId)
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "The token couldn't be verified")
+	}
+
+	// the signature, 'exp' and 'nbf' were checked by the parser, but 'exp' isn't required by it
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, stacktrace.NewError("The token has no expiration time")
+	}
+	issuer, _ := claims["iss"].(string)
+	if trimIssuerUrl(issuer) != trimIssuerUrl(validator.config.IssuerUrl) {
+		return nil, stacktrace.NewError("The token was issued by '%v' instead of '%v'", issuer, validator.config.IssuerUrl)
+	}
+	if !claims.VerifyAudience(validator.config.Audience, true) {
+		return nil, stacktrace.NewError("The token wasn't issued for audience '%v'", validator.config.Audience)
+	}
+
+	subject, _ := claims[subjectClaim].(string)
+	scopes := getScopesFromClaim(claims[validator.config.GetScopesClaim()])
+	// The groups claim holds a list of groups the same way the scopes claim can
+	groups := getScopesFromClaim(claims[validator.config.GetGroupsClaim()])
+	return &Principal{
+		Name:   oidcPrincipalPrefix + subject,
+		Scopes: append(scopes, validator.config.GetScopesOfGroups(groups)...),
+	}, nil
+}
+
+func (validator *oidcTokenValidator) getPublicKey(ctx context.Context, keyId string) (interface{}, error) {
+	validator.mutex.Lock()
+	defer validator.mutex.Unlock()
+
+	if publicKey, found := validator.publicKeysById[keyId]; found {
+		return publicKey, nil
+	}
+	if validator.hasFetchedAnyKey && time.Since(validator.lastKeysRefresh) < minTimeBetweenKeysRefreshes {
+		return nil, stacktrace.NewError("No key with ID '%v' was found in the keys of OIDC provider '%v'", keyId, validator.config.IssuerUrl)
+	}
+
+	publicKeysById, err := validator.fetchPublicKeys(ctx)
+	validator.lastKeysRefresh = time.Now()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred fetching the keys of OIDC provider '%v'", validator.config.IssuerUrl)
+	}
+	validator.publicKeysById = publicKeysById
+	validator.hasFetchedAnyKey = true
+
+	publicKey, found := validator.publicKeysById[keyId]
+	if !found {
+		return nil, stacktrace.NewError("No key with ID '%v' was found in the keys of OIDC provider '%v'", keyId, validator.config.IssuerUrl)
+	}
+	return publicKey, nil
+}
+
+func (validator *oidcTokenValidator) fetchPublicKeys(ctx context.Context) (map[string]interface{}, error) {
+	discoveryUrl := trimIssuerUrl(validator.config.IssuerUrl) + oidcDiscoveryPath
+	discoveryDocument := &oidcDiscoveryDocument{
+		Issuer:  "",
+		JwksUri: "",
+	}
+	if err := validator.getJson(ctx, discoveryUrl, discoveryDocument); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the OIDC discovery document")
+	}
+	if discoveryDocument.JwksUri == "" {
+		return nil, stacktrace.NewError("The OIDC discovery document at '%v' has no 'jwks_uri'", discoveryUrl)
+	}
+
+	keySet := &jsonWebKeySet{Keys: nil}
+	if err := validator.getJson(ctx, discoveryDocument.JwksUri, keySet); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the OIDC key set")
+	}
+
+	publicKeysById := map[string]interface{}{}
+	for _, key := range keySet.Keys {
+		publicKey, err := key.toPublicKey()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing key '%v' of the OIDC key set", key.KeyId)
+		}
+		if publicKey == nil {
+			// keys of other types (e.g. symmetric keys) can't sign tokens we accept
+			continue
+		}
+		publicKeysById[key.KeyId] = publicKey
+	}
+	return publicKeysById, nil
+}
+
+func (validator *oidcTokenValidator) getJson(ctx context.Context, url string, result interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the request to '%v'", url)
+	}
+	response, err := validator.httpClient.Do(request)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred calling '%v'", url)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return stacktrace.NewError("Calling '%v' returned status '%v'", url, response.Status)
+	}
+	if err = json.NewDecoder(response.Body).Decode(result); err != nil {
+		return stacktrace.Propagate(err, "An error occurred decoding the JSON returned by '%v'", url)
+	}
+	return nil
+}
+
+// toPublicKey returns nil if the key isn't a public key of a supported type
+func (key jsonWebKey) toPublicKey() (interface{}, error) {
+	switch key.KeyType {
+	case rsaKeyType:
+		modulus, err := decodeBase64UrlInt(key.Modulus)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decoding the modulus of the RSA key")
+		}
+		exponent, err := decodeBase64UrlInt(key.Exponent)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decoding the exponent of the RSA key")
+		}
+		return &rsa.PublicKey{N: modulus, E: int(exponent.Int64())}, nil
+	case ecKeyType:
+		curve, found := ellipticCurvesByName[key.Curve]
+		if !found {
+			return nil, stacktrace.NewError("Unsupported elliptic curve '%v'", key.Curve)
+		}
+		x, err := decodeBase64UrlInt(key.X)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decoding the X coordinate of the EC key")
+		}
+		y, err := decodeBase64UrlInt(key.Y)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decoding the Y coordinate of the EC key")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBase64UrlInt(value string) (*big.Int, error) {
+	valueBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "'%v' isn't a base64url-encoded value", value)
+	}
+	return new(big.Int).SetBytes(valueBytes), nil
+}
+
+// getScopesFromClaim accepts both the space-separated string of the standard 'scope' claim and a list of strings
+func getScopesFromClaim(claimValue interface{}) []string {
+	switch scopes := claimValue.(type) {
+	case string:
+		return strings.Fields(scopes)
+	case []interface{}:
+		result := []string{}
+		for _, scope := range scopes {
+			if scopeStr, ok := scope.(string); ok {
+				result = append(result, scopeStr)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+func trimIssuerUrl(issuerUrl string) string {
+	return strings.TrimSuffix(issuerUrl, issuerUrlTrailingSlash)
+}
@@ -0,0 +1,90 @@
+package bounded_buffer
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// boundedBufferLogsDatabaseClient buffers a bounded number of log lines for each stream of the wrapped client, so that
+// the clients that can't keep up with high-volume services don't grow the engine memory. The followed streams whose
+// buffer is full are handled with the slow consumer policy; the others wait for their client as their logs are stored
+type boundedBufferLogsDatabaseClient struct {
+	logsDatabaseClient centralized_logs.LogsDatabaseClient
+
+	logStreamingConfig args.LogStreamingConfig
+}
+
+func NewBoundedBufferLogsDatabaseClient(
+	logsDatabaseClient centralized_logs.LogsDatabaseClient,
+	logStreamingConfig args.LogStreamingConfig,
+) *boundedBufferLogsDatabaseClient {
+	return &boundedBufferLogsDatabaseClient{
+		logsDatabaseClient: logsDatabaseClient,
+		logStreamingConfig: logStreamingConfig,
+	}
+}
+
+func (client *boundedBufferLogsDatabaseClient) StreamUserServiceLogs(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	userServiceUuids map[service.ServiceUUID]bool,
+	conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
+	shouldFollowLogs bool,
+	shouldReturnAllLogs bool,
+	numLogLines uint32,
+) (
+	chan map[service.ServiceUUID][]logline.LogLine,
+	chan error,
+	context.CancelFunc,
+	error,
+) {
+	// Cancelling the stream also stops the buffer, which could otherwise wait forever for the client to read it
+	streamCtx, cancelStreamCtxFunc := context.WithCancel(ctx)
+	sourceLogsChan, sourceErrChan, cancelSourceCtxFunc, err := client.logsDatabaseClient.StreamUserServiceLogs(streamCtx, enclaveUuid, userServiceUuids, conjunctiveLogLineFilters, shouldFollowLogs, shouldReturnAllLogs, numLogLines)
+	if err != nil {
+		cancelStreamCtxFunc()
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred streaming the logs of services '%+v' in enclave '%v'", userServiceUuids, enclaveUuid)
+	}
+	cancelFunc := func() {
+		cancelStreamCtxFunc()
+		cancelSourceCtxFunc()
+	}
+
+	slowConsumerPolicy := client.logStreamingConfig.GetSlowConsumerPolicy()
+	if !shouldFollowLogs {
+		slowConsumerPolicy = args.SlowLogConsumerPolicy_Block
+	}
+	buffer := newLogStreamBuffer(int(client.logStreamingConfig.GetBufferSize()), slowConsumerPolicy)
+	logsChan, errChan := buffer.start(streamCtx, sourceLogsChan, sourceErrChan, cancelFunc)
+	return logsChan, errChan, cancelFunc, nil
+}
+
+func (client *boundedBufferLogsDatabaseClient) FilterExistingServiceUuids(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	userServiceUuids map[service.ServiceUUID]bool,
+) (map[service.ServiceUUID]bool, error) {
+	return client.logsDatabaseClient.FilterExistingServiceUuids(ctx, enclaveUuid, userServiceUuids)
+}
+
+func (client *boundedBufferLogsDatabaseClient) StartLogFileManagement(ctx context.Context) {
+	client.logsDatabaseClient.StartLogFileManagement(ctx)
+}
+
+func (client *boundedBufferLogsDatabaseClient) RemoveEnclaveLogs(enclaveUuid string) error {
+	return client.logsDatabaseClient.RemoveEnclaveLogs(enclaveUuid)
+}
+
+func (client *boundedBufferLogsDatabaseClient) RemoveAllLogs() error {
+	return client.logsDatabaseClient.RemoveAllLogs()
+}
+
+func (client *boundedBufferLogsDatabaseClient) SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int) {
+	client.logsDatabaseClient.SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks)
+}
@@ -0,0 +1,185 @@
+package bounded_buffer
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// logStreamBuffer holds the logs of one stream that its client hasn't read yet, up to maxBufferedLogLines
+type logStreamBuffer struct {
+	maxBufferedLogLines int
+
+	slowConsumerPolicy args.SlowLogConsumerPolicy
+
+	// Oldest first
+	bufferedLogs []map[service.ServiceUUID][]logline.LogLine
+
+	numBufferedLogLines int
+
+	numDroppedLogLines int
+}
+
+func newLogStreamBuffer(maxBufferedLogLines int, slowConsumerPolicy args.SlowLogConsumerPolicy) *logStreamBuffer {
+	return &logStreamBuffer{
+		maxBufferedLogLines: maxBufferedLogLines,
+		slowConsumerPolicy:  slowConsumerPolicy,
+		bufferedLogs:        []map[service.ServiceUUID][]logline.LogLine{},
+		numBufferedLogLines: 0,
+		numDroppedLogLines:  0,
+	}
+}
+
+// start buffers the logs of the source in the background, and returns the channels they and the errors of the source
+// are passed on through. An error is passed on after the logs received before it, and ends the stream
+func (buffer *logStreamBuffer) start(
+	ctx context.Context,
+	sourceLogsChan chan map[service.ServiceUUID][]logline.LogLine,
+	sourceErrChan chan error,
+	cancelSourceFunc func(),
+) (chan map[service.ServiceUUID][]logline.LogLine, chan error) {
+	logsChan := make(chan map[service.ServiceUUID][]logline.LogLine)
+	// Buffered so that the error can be passed on without waiting for the client
+	errChan := make(chan error, 1)
+	go buffer.run(ctx, sourceLogsChan, sourceErrChan, cancelSourceFunc, logsChan, errChan)
+	return logsChan, errChan
+}
+
+func (buffer *logStreamBuffer) run(
+	ctx context.Context,
+	sourceLogsChan chan map[service.ServiceUUID][]logline.LogLine,
+	sourceErrChan chan error,
+	cancelSourceFunc func(),
+	logsChan chan map[service.ServiceUUID][]logline.LogLine,
+	errChan chan error,
+) {
+	defer func() {
+		engine_metrics.AddBufferedLogLines(-buffer.numBufferedLogLines)
+		// The senders of the source would block forever once the buffer stops reading it
+		drainSource(sourceLogsChan, sourceErrChan)
+	}()
+
+	// nil once closed, so that they're no longer selected
+	openSourceLogsChan := sourceLogsChan
+	openSourceErrChan := sourceErrChan
+	var streamErr error
+	for {
+		if streamErr != nil && len(buffer.bufferedLogs) == 0 {
+			errChan <- streamErr
+			close(errChan)
+			return
+		}
+		if openSourceLogsChan == nil && openSourceErrChan == nil && len(buffer.bufferedLogs) == 0 {
+			close(logsChan)
+			close(errChan)
+			return
+		}
+
+		// The source isn't read once it failed, nor while the buffer is full with the block policy
+		receivableSourceLogsChan := openSourceLogsChan
+		receivableSourceErrChan := openSourceErrChan
+		if streamErr != nil {
+			receivableSourceLogsChan = nil
+			receivableSourceErrChan = nil
+		} else if buffer.slowConsumerPolicy == args.SlowLogConsumerPolicy_Block && buffer.numBufferedLogLines >= buffer.maxBufferedLogLines {
+			receivableSourceLogsChan = nil
+		}
+		var sendableLogsChan chan map[service.ServiceUUID][]logline.LogLine
+		var oldestLogs map[service.ServiceUUID][]logline.LogLine
+		if len(buffer.bufferedLogs) > 0 {
+			sendableLogsChan = logsChan
+			oldestLogs = buffer.bufferedLogs[0]
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case logs, isChanOpen := <-receivableSourceLogsChan:
+			if !isChanOpen {
+				openSourceLogsChan = nil
+				continue
+			}
+			if err := buffer.push(logs); err != nil {
+				streamErr = err
+				cancelSourceFunc()
+			}
+		case err, isChanOpen := <-receivableSourceErrChan:
+			if !isChanOpen {
+				openSourceErrChan = nil
+				continue
+			}
+			streamErr = err
+		case sendableLogsChan <- oldestLogs:
+			buffer.dropOldest()
+		}
+	}
+}
+
+// push buffers the logs, applying the slow consumer policy if the buffer is full. It returns an error if the stream
+// has to end
+func (buffer *logStreamBuffer) push(logs map[service.ServiceUUID][]logline.LogLine) error {
+	buffer.bufferedLogs = append(buffer.bufferedLogs, logs)
+	numLogLines := countLogLines(logs)
+	buffer.numBufferedLogLines += numLogLines
+	engine_metrics.AddBufferedLogLines(numLogLines)
+	if buffer.numBufferedLogLines <= buffer.maxBufferedLogLines {
+		return nil
+	}
+
+	switch buffer.slowConsumerPolicy {
+	case args.SlowLogConsumerPolicy_DropOldest:
+		if buffer.numDroppedLogLines == 0 {
+			logrus.Warnf("The client of a service logs stream can't keep up with its logs; dropping the oldest of the '%v' log lines buffered for it", buffer.maxBufferedLogLines)
+		}
+		// The latest logs are kept even if they're over the limit on their own
+		for buffer.numBufferedLogLines > buffer.maxBufferedLogLines && len(buffer.bufferedLogs) > 1 {
+			numDroppedLogLines := buffer.dropOldest()
+			buffer.numDroppedLogLines += numDroppedLogLines
+			engine_metrics.CountDroppedLogLines(numDroppedLogLines)
+		}
+	case args.SlowLogConsumerPolicy_Disconnect:
+		for len(buffer.bufferedLogs) > 0 {
+			buffer.dropOldest()
+		}
+		engine_metrics.CountSlowLogConsumerDisconnection()
+		return stacktrace.NewError("The client of the service logs stream couldn't keep up with its logs, more than '%v' log lines were waiting for it; ending the stream", buffer.maxBufferedLogLines)
+	case args.SlowLogConsumerPolicy_Block:
+		// The source isn't read until the client catches up
+	}
+	return nil
+}
+
+// dropOldest removes the oldest logs from the buffer, either because they were sent or to make room, and returns
+// their number of lines
+func (buffer *logStreamBuffer) dropOldest() int {
+	numLogLines := countLogLines(buffer.bufferedLogs[0])
+	buffer.bufferedLogs[0] = nil
+	buffer.bufferedLogs = buffer.bufferedLogs[1:]
+	buffer.numBufferedLogLines -= numLogLines
+	engine_metrics.AddBufferedLogLines(-numLogLines)
+	return numLogLines
+}
+
+func countLogLines(logs map[service.ServiceUUID][]logline.LogLine) int {
+	numLogLines := 0
+	for _, serviceLogLines := range logs {
+		numLogLines += len(serviceLogLines)
+	}
+	return numLogLines
+}
+
+func drainSource(sourceLogsChan chan map[service.ServiceUUID][]logline.LogLine, sourceErrChan chan error) {
+	go func() {
+		for range sourceLogsChan {
+		}
+	}()
+	go func() {
+		for range sourceErrChan {
+		}
+	}()
+}
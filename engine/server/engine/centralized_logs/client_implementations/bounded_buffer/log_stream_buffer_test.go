@@ -0,0 +1,101 @@
+package bounded_buffer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceUuid = service.ServiceUUID("test-service")
+
+	testMaxBufferedLogLines = 2
+	testNumLogLines         = 5
+)
+
+func TestLogStreamBuffer_BlockKeepsEveryLogLine(t *testing.T) {
+	sourceLogsChan, sourceErrChan := newTestSource()
+	buffer := newLogStreamBuffer(testMaxBufferedLogLines, args.SlowLogConsumerPolicy_Block)
+	logsChan, _ := buffer.start(context.Background(), sourceLogsChan, sourceErrChan, func() {})
+
+	go sendTestLogLines(sourceLogsChan, sourceErrChan)
+
+	require.Equal(t, []string{"0", "1", "2", "3", "4"}, receiveLogLines(logsChan))
+}
+
+func TestLogStreamBuffer_DropOldestKeepsTheLatestLogLines(t *testing.T) {
+	sourceLogsChan, sourceErrChan := newTestSource()
+	buffer := newLogStreamBuffer(testMaxBufferedLogLines, args.SlowLogConsumerPolicy_DropOldest)
+	logsChan, _ := buffer.start(context.Background(), sourceLogsChan, sourceErrChan, func() {})
+
+	// The client only reads once every log line was sent
+	sendTestLogLines(sourceLogsChan, sourceErrChan)
+
+	require.Equal(t, []string{"3", "4"}, receiveLogLines(logsChan))
+}
+
+func TestLogStreamBuffer_DisconnectEndsTheStream(t *testing.T) {
+	sourceLogsChan, sourceErrChan := newTestSource()
+	wasSourceCancelled := make(chan bool, 1)
+	buffer := newLogStreamBuffer(testMaxBufferedLogLines, args.SlowLogConsumerPolicy_Disconnect)
+	_, errChan := buffer.start(context.Background(), sourceLogsChan, sourceErrChan, func() {
+		wasSourceCancelled <- true
+	})
+
+	sendTestLogLines(sourceLogsChan, sourceErrChan)
+
+	require.Error(t, <-errChan)
+	require.True(t, <-wasSourceCancelled)
+}
+
+func TestLogStreamBuffer_SourceErrorComesAfterTheLogLinesBeforeIt(t *testing.T) {
+	sourceLogsChan, sourceErrChan := newTestSource()
+	buffer := newLogStreamBuffer(testMaxBufferedLogLines, args.SlowLogConsumerPolicy_Block)
+	logsChan, errChan := buffer.start(context.Background(), sourceLogsChan, sourceErrChan, func() {})
+
+	sourceLogsChan <- newTestLogs("0")
+	sourceErrChan <- errors.New("test error")
+
+	select {
+	case err := <-errChan:
+		require.Fail(t, "The error was passed on before the log line", "%v", err)
+	case logs := <-logsChan:
+		require.Equal(t, "0", logs[testServiceUuid][0].GetContent())
+	}
+	require.Error(t, <-errChan)
+}
+
+func newTestSource() (chan map[service.ServiceUUID][]logline.LogLine, chan error) {
+	return make(chan map[service.ServiceUUID][]logline.LogLine), make(chan error)
+}
+
+func sendTestLogLines(sourceLogsChan chan map[service.ServiceUUID][]logline.LogLine, sourceErrChan chan error) {
+	for i := 0; i < testNumLogLines; i++ {
+		sourceLogsChan <- newTestLogs(fmt.Sprint(i))
+	}
+	close(sourceLogsChan)
+	close(sourceErrChan)
+}
+
+func newTestLogs(content string) map[service.ServiceUUID][]logline.LogLine {
+	return map[service.ServiceUUID][]logline.LogLine{
+		testServiceUuid: {*logline.NewLogLine(content, time.Now())},
+	}
+}
+
+func receiveLogLines(logsChan chan map[service.ServiceUUID][]logline.LogLine) []string {
+	logLineContents := []string{}
+	for logs := range logsChan {
+		for _, logLine := range logs[testServiceUuid] {
+			logLineContents = append(logLineContents, logLine.GetContent())
+		}
+	}
+	return logLineContents
+}
@@ -170,6 +170,10 @@ func (client *kurtosisBackendLogsDatabaseClient) RemoveAllLogs() error {
 	return nil
 }
 
+func (client *kurtosisBackendLogsDatabaseClient) SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int) {
+	// the logs are kept by the backend, so there's no retention period to change
+}
+
 // ====================================================================================================
 //
 //	Private helper functions
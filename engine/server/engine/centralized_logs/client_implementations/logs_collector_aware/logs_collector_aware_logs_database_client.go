@@ -0,0 +1,107 @@
+package logs_collector_aware
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// logsCollectorAwareLogsDatabaseClient reads the logs of the enclaves that have a logs collector from the logs it
+// collected, and the logs of the enclaves created without logs collection straight from the container engine
+type logsCollectorAwareLogsDatabaseClient struct {
+	kurtosisBackend backend_interface.KurtosisBackend
+
+	// Used for the enclaves that have a logs collector, and for everything that isn't specific to an enclave
+	collectedLogsDatabaseClient centralized_logs.LogsDatabaseClient
+
+	// Used for the enclaves created without logs collection
+	containerEngineLogsDatabaseClient centralized_logs.LogsDatabaseClient
+}
+
+func NewLogsCollectorAwareLogsDatabaseClient(
+	kurtosisBackend backend_interface.KurtosisBackend,
+	collectedLogsDatabaseClient centralized_logs.LogsDatabaseClient,
+	containerEngineLogsDatabaseClient centralized_logs.LogsDatabaseClient,
+) *logsCollectorAwareLogsDatabaseClient {
+	return &logsCollectorAwareLogsDatabaseClient{
+		kurtosisBackend:                   kurtosisBackend,
+		collectedLogsDatabaseClient:       collectedLogsDatabaseClient,
+		containerEngineLogsDatabaseClient: containerEngineLogsDatabaseClient,
+	}
+}
+
+func (client *logsCollectorAwareLogsDatabaseClient) StreamUserServiceLogs(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	userServiceUuids map[service.ServiceUUID]bool,
+	conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
+	shouldFollowLogs bool,
+	shouldReturnAllLogs bool,
+	numLogLines uint32,
+) (
+	chan map[service.ServiceUUID][]logline.LogLine,
+	chan error,
+	context.CancelFunc,
+	error,
+) {
+	enclaveLogsDatabaseClient, err := client.getLogsDatabaseClientForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs database client for enclave '%v'", enclaveUuid)
+	}
+	return enclaveLogsDatabaseClient.StreamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, conjunctiveLogLineFilters, shouldFollowLogs, shouldReturnAllLogs, numLogLines)
+}
+
+func (client *logsCollectorAwareLogsDatabaseClient) FilterExistingServiceUuids(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	userServiceUuids map[service.ServiceUUID]bool,
+) (map[service.ServiceUUID]bool, error) {
+	enclaveLogsDatabaseClient, err := client.getLogsDatabaseClientForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs database client for enclave '%v'", enclaveUuid)
+	}
+	return enclaveLogsDatabaseClient.FilterExistingServiceUuids(ctx, enclaveUuid, userServiceUuids)
+}
+
+func (client *logsCollectorAwareLogsDatabaseClient) StartLogFileManagement(ctx context.Context) {
+	client.collectedLogsDatabaseClient.StartLogFileManagement(ctx)
+}
+
+func (client *logsCollectorAwareLogsDatabaseClient) RemoveEnclaveLogs(enclaveUuid string) error {
+	// Enclaves without logs collection have no collected logs, so there's nothing to tell them apart for here
+	return client.collectedLogsDatabaseClient.RemoveEnclaveLogs(enclaveUuid)
+}
+
+func (client *logsCollectorAwareLogsDatabaseClient) RemoveAllLogs() error {
+	return client.collectedLogsDatabaseClient.RemoveAllLogs()
+}
+
+func (client *logsCollectorAwareLogsDatabaseClient) SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int) {
+	client.collectedLogsDatabaseClient.SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks)
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func (client *logsCollectorAwareLogsDatabaseClient) getLogsDatabaseClientForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (centralized_logs.LogsDatabaseClient, error) {
+	// The system logs of the nodes are only ever collected
+	if enclaveUuid == logs_collector.SystemLogsStreamId {
+		return client.collectedLogsDatabaseClient, nil
+	}
+	logsCollector, err := client.kurtosisBackend.GetLogsCollectorForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector for enclave '%v'", enclaveUuid)
+	}
+	if logsCollector == nil {
+		return client.containerEngineLogsDatabaseClient, nil
+	}
+	return client.collectedLogsDatabaseClient, nil
+}
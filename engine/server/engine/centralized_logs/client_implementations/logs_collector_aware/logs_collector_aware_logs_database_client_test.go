@@ -0,0 +1,73 @@
+package logs_collector_aware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/kurtosis_backend"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("test-enclave")
+
+	collectedServiceUuid       = service.ServiceUUID("collected-service")
+	containerEngineServiceUuid = service.ServiceUUID("container-engine-service")
+)
+
+func TestFilterExistingServiceUuids_EnclaveWithLogsCollectorUsesCollectedLogs(t *testing.T) {
+	runningLogsCollector := logs_collector.NewLogsCollector(container.ContainerStatus_Running, nil, nil, nil, nil)
+	client := newTestClient(t, runningLogsCollector)
+
+	existingServiceUuids, err := client.FilterExistingServiceUuids(context.Background(), testEnclaveUuid, getRequestedServiceUuids())
+	require.NoError(t, err)
+	require.Equal(t, map[service.ServiceUUID]bool{collectedServiceUuid: true}, existingServiceUuids)
+}
+
+func TestFilterExistingServiceUuids_EnclaveWithoutLogsCollectorUsesContainerEngine(t *testing.T) {
+	client := newTestClient(t, nil)
+
+	existingServiceUuids, err := client.FilterExistingServiceUuids(context.Background(), testEnclaveUuid, getRequestedServiceUuids())
+	require.NoError(t, err)
+	require.Equal(t, map[service.ServiceUUID]bool{containerEngineServiceUuid: true}, existingServiceUuids)
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+// newTestClient returns a client whose enclave has the given logs collector, and whose underlying logs database clients
+// each know about a different service, so that the tests can tell which one was used
+func newTestClient(t *testing.T, enclaveLogsCollector *logs_collector.LogsCollector) *logsCollectorAwareLogsDatabaseClient {
+	kurtosisBackend := backend_interface.NewMockKurtosisBackend(t)
+	kurtosisBackend.EXPECT().GetLogsCollectorForEnclave(mock.Anything, testEnclaveUuid).Return(enclaveLogsCollector, nil)
+
+	return NewLogsCollectorAwareLogsDatabaseClient(
+		kurtosisBackend,
+		kurtosis_backend.NewKurtosisBackendLogsDatabaseClient(newBackendWithService(t, collectedServiceUuid)),
+		kurtosis_backend.NewKurtosisBackendLogsDatabaseClient(newBackendWithService(t, containerEngineServiceUuid)),
+	)
+}
+
+func newBackendWithService(t *testing.T, serviceUuid service.ServiceUUID) backend_interface.KurtosisBackend {
+	kurtosisBackend := backend_interface.NewMockKurtosisBackend(t)
+	kurtosisBackend.EXPECT().GetUserServices(mock.Anything, testEnclaveUuid, mock.Anything).Maybe().Return(
+		map[service.ServiceUUID]*service.Service{serviceUuid: nil},
+		nil,
+	)
+	return kurtosisBackend
+}
+
+func getRequestedServiceUuids() map[service.ServiceUUID]bool {
+	return map[service.ServiceUUID]bool{
+		collectedServiceUuid:       true,
+		containerEngineServiceUuid: true,
+	}
+}
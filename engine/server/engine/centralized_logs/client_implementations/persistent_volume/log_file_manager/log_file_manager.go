@@ -14,15 +14,28 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"math"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"time"
 )
 
 const (
 	oneWeek = 7 * 24 * time.Hour
+
+	minLogRetentionPeriodInWeeks = 1
 )
 
+// GetLogRetentionPeriodInWeeks rounds the log retention period up to whole weeks, as log files hold a week of logs
+func GetLogRetentionPeriodInWeeks(logRetentionPeriod time.Duration) int {
+	logRetentionPeriodInWeeks := int(math.Ceil(float64(logRetentionPeriod) / float64(oneWeek)))
+	if logRetentionPeriodInWeeks < minLogRetentionPeriodInWeeks {
+		return minLogRetentionPeriodInWeeks
+	}
+	return logRetentionPeriodInWeeks
+}
+
 // LogFileManager is responsible for creating and removing log files from filesystem.
 type LogFileManager struct {
 	kurtosisBackend backend_interface.KurtosisBackend
@@ -33,7 +46,8 @@ type LogFileManager struct {
 
 	time logs_clock.LogsClock
 
-	logRetentionPeriodInWeeks int
+	// Atomic as the engine config can change it while log files are being removed
+	logRetentionPeriodInWeeks atomic.Int64
 }
 
 func NewLogFileManager(
@@ -42,13 +56,19 @@ func NewLogFileManager(
 	fileLayout file_layout.LogFileLayout,
 	time logs_clock.LogsClock,
 	logRetentionPeriodInWeeks int) *LogFileManager {
-	return &LogFileManager{
-		kurtosisBackend:           kurtosisBackend,
-		filesystem:                filesystem,
-		fileLayout:                fileLayout,
-		time:                      time,
-		logRetentionPeriodInWeeks: logRetentionPeriodInWeeks,
+	manager := &LogFileManager{ // nolint:exhaustruct
+		kurtosisBackend: kurtosisBackend,
+		filesystem:      filesystem,
+		fileLayout:      fileLayout,
+		time:            time,
 	}
+	manager.SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks)
+	return manager
+}
+
+// SetLogRetentionPeriodInWeeks changes how long log files are kept, starting with the next removal of old log files
+func (manager *LogFileManager) SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int) {
+	manager.logRetentionPeriodInWeeks.Store(int64(logRetentionPeriodInWeeks))
 }
 
 // StartLogFileManagement initiates logic for managing log files in the filesystem
@@ -143,7 +163,7 @@ func (manager *LogFileManager) RemoveLogsBeyondRetentionPeriod(ctx context.Conte
 			serviceNameStr := string(serviceRegistration.GetName())
 			serviceShortUuidStr := uuid_generator.ShortenedUUIDString(serviceUuidStr)
 
-			retentionPeriod := time.Duration(manager.logRetentionPeriodInWeeks) * oneWeek
+			retentionPeriod := time.Duration(manager.logRetentionPeriodInWeeks.Load()) * oneWeek
 			oldServiceLogFilesByUuid, err := manager.fileLayout.GetLogFilePaths(manager.filesystem, retentionPeriod, 1, string(enclaveUuid), serviceUuidStr)
 			if err != nil {
 				logrus.Errorf("An error occurred getting log file paths for service '%v' in enclave '%v' logs beyond retention: %v", serviceUuidStr, enclaveUuid, err)
@@ -193,7 +213,7 @@ func (manager *LogFileManager) RemoveAllLogs() error {
 
 func (manager *LogFileManager) RemoveEnclaveLogs(enclaveUuid string) error {
 	currentTime := manager.time.Now()
-	for i := 0; i < manager.logRetentionPeriodInWeeks; i++ {
+	for i := 0; i < int(manager.logRetentionPeriodInWeeks.Load()); i++ {
 		year, week := currentTime.Add(time.Duration(-i) * oneWeek).ISOWeek()
 		enclaveLogsDirPathForWeek := getEnclaveLogsDirPath(year, week, enclaveUuid)
 		if err := manager.filesystem.RemoveAll(enclaveLogsDirPathForWeek); err != nil {
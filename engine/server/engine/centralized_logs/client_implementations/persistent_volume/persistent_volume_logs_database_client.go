@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_file_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/stream_logs_strategy"
@@ -111,6 +112,11 @@ func (client *persistentVolumeLogsDatabaseClient) FilterExistingServiceUuids(
 	enclaveUuid enclave.EnclaveUUID,
 	userServiceUuids map[service.ServiceUUID]bool,
 ) (map[service.ServiceUUID]bool, error) {
+	// The system logs are stored under the names of the nodes, which aren't services that can be looked up
+	if enclaveUuid == logs_collector.SystemLogsStreamId {
+		return userServiceUuids, nil
+	}
+
 	userServiceFilters := &service.ServiceFilters{
 		Names:    nil,
 		UUIDs:    userServiceUuids,
@@ -143,6 +149,11 @@ func (client *persistentVolumeLogsDatabaseClient) RemoveAllLogs() error {
 	return client.logFileManager.RemoveAllLogs()
 }
 
+func (client *persistentVolumeLogsDatabaseClient) SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int) {
+	client.logFileManager.SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks)
+	client.streamStrategy.SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks)
+}
+
 // ====================================================================================================
 //
 //	Private helper functions
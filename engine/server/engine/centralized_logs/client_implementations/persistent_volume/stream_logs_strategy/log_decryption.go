@@ -0,0 +1,54 @@
+package stream_logs_strategy
+
+import (
+	"encoding/base64"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
+	"github.com/kurtosis-tech/stacktrace"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// getLogMessage returns the log message of the json log line, decrypting it if the logs aggregator encrypted it at rest
+// The lines stored before the encryption was turned on are plaintext, so both kinds of lines can be in the same file
+func getLogMessage(jsonLog JsonLog, logsEncryptionKey []byte) (string, error) {
+	logMsgStr, found := jsonLog[volume_consts.LogLabel]
+	if !found {
+		return "", stacktrace.NewError("An error retrieving the log field '%v' from json log: %v\n", volume_consts.LogLabel, jsonLog)
+	}
+	encodedNonce, isEncrypted := jsonLog[logs_aggregator.EncryptedLogNonceLabel]
+	if !isEncrypted {
+		return logMsgStr, nil
+	}
+	if logsEncryptionKey == nil {
+		return "", stacktrace.NewError("The log line is encrypted but the engine has no logs encryption key; the logs encryption key must be configured to read it")
+	}
+	decryptedLogMsg, err := decryptLogMessage(logMsgStr, encodedNonce, logsEncryptionKey)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred decrypting the log line; it was either encrypted with another key or tampered with")
+	}
+	return decryptedLogMsg, nil
+}
+
+func decryptLogMessage(encodedCiphertext string, encodedNonce string, logsEncryptionKey []byte) (string, error) {
+	aead, err := chacha20poly1305.New(logsEncryptionKey)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating the cipher from the logs encryption key")
+	}
+	nonce, err := base64.StdEncoding.DecodeString(encodedNonce)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "The nonce of the log line isn't valid base64")
+	}
+	if len(nonce) != aead.NonceSize() {
+		return "", stacktrace.NewError("The nonce of the log line has %v bytes instead of %v", len(nonce), aead.NonceSize())
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(encodedCiphertext)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "The encrypted log line isn't valid base64")
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred authenticating the encrypted log line")
+	}
+	return string(plaintext), nil
+}
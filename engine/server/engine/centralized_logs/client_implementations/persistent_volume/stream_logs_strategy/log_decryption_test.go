@@ -0,0 +1,77 @@
+package stream_logs_strategy
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const testLogMsg = "Starting the database..."
+
+// newEncryptedJsonLog encrypts the log message like the logs aggregator does before storing it
+func newEncryptedJsonLog(t *testing.T, key []byte, logMsg string) JsonLog {
+	aead, err := chacha20poly1305.New(key)
+	require.NoError(t, err)
+	nonce := make([]byte, logs_aggregator.LogsEncryptionNonceNumBytes)
+	_, err = rand.Read(nonce)
+	require.NoError(t, err)
+	return JsonLog{
+		volume_consts.LogLabel:                 base64.StdEncoding.EncodeToString(aead.Seal(nil, nonce, []byte(logMsg), nil)),
+		logs_aggregator.EncryptedLogNonceLabel: base64.StdEncoding.EncodeToString(nonce),
+	}
+}
+
+func newTestLogsEncryptionKey(t *testing.T) []byte {
+	key := make([]byte, logs_aggregator.LogsEncryptionKeyNumBytes)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func TestGetLogMessage_PlaintextLinesAreReturnedAsIs(t *testing.T) {
+	jsonLog := JsonLog{volume_consts.LogLabel: testLogMsg}
+
+	logMsg, err := getLogMessage(jsonLog, nil)
+	require.NoError(t, err)
+	require.Equal(t, testLogMsg, logMsg)
+
+	logMsg, err = getLogMessage(jsonLog, newTestLogsEncryptionKey(t))
+	require.NoError(t, err)
+	require.Equal(t, testLogMsg, logMsg)
+}
+
+func TestGetLogMessage_EncryptedLinesAreDecrypted(t *testing.T) {
+	key := newTestLogsEncryptionKey(t)
+	jsonLog := newEncryptedJsonLog(t, key, testLogMsg)
+
+	logMsg, err := getLogMessage(jsonLog, key)
+	require.NoError(t, err)
+	require.Equal(t, testLogMsg, logMsg)
+}
+
+func TestGetLogMessage_EncryptedLinesRequireTheKey(t *testing.T) {
+	jsonLog := newEncryptedJsonLog(t, newTestLogsEncryptionKey(t), testLogMsg)
+
+	_, err := getLogMessage(jsonLog, nil)
+	require.Error(t, err)
+
+	_, err = getLogMessage(jsonLog, newTestLogsEncryptionKey(t))
+	require.Error(t, err)
+}
+
+func TestGetLogMessage_TamperedLinesAreRejected(t *testing.T) {
+	key := newTestLogsEncryptionKey(t)
+	jsonLog := newEncryptedJsonLog(t, key, testLogMsg)
+	ciphertext, err := base64.StdEncoding.DecodeString(jsonLog[volume_consts.LogLabel])
+	require.NoError(t, err)
+	ciphertext[0] ^= 1
+	jsonLog[volume_consts.LogLabel] = base64.StdEncoding.EncodeToString(ciphertext)
+
+	_, err = getLogMessage(jsonLog, key)
+	require.Error(t, err)
+}
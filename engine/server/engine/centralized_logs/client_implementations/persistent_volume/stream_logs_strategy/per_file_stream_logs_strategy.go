@@ -27,6 +27,10 @@ func NewPerFileStreamLogsStrategy() *PerFileStreamLogsStrategy {
 
 type JsonLog map[string]string
 
+func (strategy *PerFileStreamLogsStrategy) SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int) {
+	// this strategy keeps a single log file per service, so there's no retention period
+}
+
 func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 	ctx context.Context,
 	fs volume_filesystem.VolumeFilesystem,
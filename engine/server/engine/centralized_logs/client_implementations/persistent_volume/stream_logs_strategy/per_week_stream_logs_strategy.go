@@ -5,13 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/file_layout"
 	"io"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/hpcloud/tail"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
@@ -23,6 +26,12 @@ import (
 
 const (
 	oneWeek = 7 * 24 * time.Hour
+
+	kubernetesEventLogMessagePrefix = "[kubernetes event] "
+
+	containerLogMessagePrefixTemplate = "[%v] "
+
+	containerRestartLogMessagePrefix = "[container restart] "
 )
 
 // PerWeekStreamLogsStrategy pulls logs from filesystem where there is a log file per year, per week, per enclave, per service
@@ -31,15 +40,27 @@ const (
 // [.../28/d3e8832d671f/61830789f03a.json] is the file containing logs from service with uuid 61830789f03a, in enclave with uuid d3e8832d671f,
 // in the 28th week of the current year
 type PerWeekStreamLogsStrategy struct {
-	time                      logs_clock.LogsClock
-	logRetentionPeriodInWeeks int
+	time logs_clock.LogsClock
+
+	// Atomic as the engine config can change it while logs are being streamed
+	logRetentionPeriodInWeeks atomic.Int64
+
+	// Decrypts the log lines the logs aggregator encrypted at rest; nil if the logs aren't encrypted
+	logsEncryptionKey []byte
 }
 
-func NewPerWeekStreamLogsStrategy(time logs_clock.LogsClock, logRetentionPeriodInWeeks int) *PerWeekStreamLogsStrategy {
-	return &PerWeekStreamLogsStrategy{
-		time:                      time,
-		logRetentionPeriodInWeeks: logRetentionPeriodInWeeks,
+func NewPerWeekStreamLogsStrategy(time logs_clock.LogsClock, logRetentionPeriodInWeeks int, logsEncryptionKey []byte) *PerWeekStreamLogsStrategy {
+	strategy := &PerWeekStreamLogsStrategy{ // nolint:exhaustruct
+		time:              time,
+		logsEncryptionKey: logsEncryptionKey,
 	}
+	strategy.SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks)
+	return strategy
+}
+
+// SetLogRetentionPeriodInWeeks changes how far back the logs streamed from now on go
+func (strategy *PerWeekStreamLogsStrategy) SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int) {
+	strategy.logRetentionPeriodInWeeks.Store(int64(logRetentionPeriodInWeeks))
 }
 
 func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
@@ -54,7 +75,8 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 	shouldReturnAllLogs bool,
 	numLogLines uint32,
 ) {
-	paths, err := strategy.getLogFilePaths(fs, strategy.logRetentionPeriodInWeeks, string(enclaveUuid), string(serviceUuid))
+	logRetentionPeriodInWeeks := int(strategy.logRetentionPeriodInWeeks.Load())
+	paths, err := strategy.getLogFilePaths(fs, logRetentionPeriodInWeeks, string(enclaveUuid), string(serviceUuid))
 	if err != nil {
 		streamErrChan <- stacktrace.Propagate(err, "An error occurred retrieving log file paths for service '%v' in enclave '%v'.", serviceUuid, enclaveUuid)
 		return
@@ -67,11 +89,11 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 			serviceUuid, enclaveUuid)
 		return
 	}
-	if len(paths) > strategy.logRetentionPeriodInWeeks {
+	if len(paths) > logRetentionPeriodInWeeks {
 		logrus.Warnf(
 			`We expected to retrieve logs going back '%v' weeks, but instead retrieved logs going back '%v' weeks. 
 					This means logs past the retention period are being returned, likely a bug in Kurtosis.`,
-			strategy.logRetentionPeriodInWeeks, len(paths))
+			logRetentionPeriodInWeeks, len(paths))
 	}
 
 	logsReader, files, err := getLogsReader(fs, paths)
@@ -272,9 +294,9 @@ func (strategy *PerWeekStreamLogsStrategy) sendJsonLogLine(jsonLog JsonLog, conj
 	// "log":"hi","timestamp":"2023-08-14T14:57:49Z"}
 
 	// Then extract the actual log message using the vectors log field
-	logMsgStr, found := jsonLog[volume_consts.LogLabel]
-	if !found {
-		return stacktrace.NewError("An error retrieving the log field '%v' from json log: %v\n", volume_consts.LogLabel, jsonLog)
+	logMsgStr, err := getLogMessage(jsonLog, strategy.logsEncryptionKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the log message from json log line.")
 	}
 
 	// Extract the timestamp using vectors timestamp field
@@ -282,7 +304,7 @@ func (strategy *PerWeekStreamLogsStrategy) sendJsonLogLine(jsonLog JsonLog, conj
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred parsing timestamp from json log line.")
 	}
-	logLine := logline.NewLogLine(logMsgStr, *logTimestamp)
+	logLine := logline.NewLogLine(markLogMessageSource(jsonLog, logMsgStr), *logTimestamp)
 
 	// Then filter by checking if the log message is valid based on requested filters
 	validLogLine, err := logLine.IsValidLogLineBaseOnFilters(conjunctiveLogLinesFiltersWithRegex)
@@ -306,9 +328,26 @@ func (strategy *PerWeekStreamLogsStrategy) sendJsonLogLine(jsonLog JsonLog, conj
 	return nil
 }
 
+// The events Kubernetes reported about the pod of the service are stored alongside its logs, so they're marked to
+// not be mistaken for lines the service wrote; so are the lines written by the containers of the pod of the service
+// other than its main one, e.g. its init containers and sidecars, which get the name of their container, and the
+// lines the logs collector added where a container restarted
+func markLogMessageSource(jsonLog JsonLog, logMsgStr string) string {
+	if jsonLog[logs_collector.LogSourceLabel] == logs_collector.KubernetesEventLogSource {
+		return kubernetesEventLogMessagePrefix + logMsgStr
+	}
+	if containerName, found := jsonLog[logs_collector.ContainerNameLabel]; found && containerName != "" {
+		logMsgStr = fmt.Sprintf(containerLogMessagePrefixTemplate, containerName) + logMsgStr
+	}
+	if jsonLog[logs_collector.LogSourceLabel] == logs_collector.ContainerRestartLogSource {
+		logMsgStr = containerRestartLogMessagePrefix + logMsgStr
+	}
+	return logMsgStr
+}
+
 // Returns true if [logLine] has no timestamp
 func (strategy *PerWeekStreamLogsStrategy) isWithinRetentionPeriod(logLine *logline.LogLine) (bool, error) {
-	retentionPeriod := strategy.time.Now().Add(time.Duration(-strategy.logRetentionPeriodInWeeks) * oneWeek)
+	retentionPeriod := strategy.time.Now().Add(time.Duration(-strategy.logRetentionPeriodInWeeks.Load()) * oneWeek)
 	timestamp := logLine.GetTimestamp()
 	return timestamp.After(retentionPeriod), nil
 }
@@ -3,6 +3,7 @@ package stream_logs_strategy
 import (
 	"bufio"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
@@ -56,7 +57,7 @@ func TestGetLogFilePaths(t *testing.T) {
 	}
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting, nil)
 	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriodInWeeksForTesting, testEnclaveUuid, testUserService1Uuid)
 
 	require.NoError(t, err)
@@ -93,7 +94,7 @@ func TestGetLogFilePathsAcrossNewYear(t *testing.T) {
 	}
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting, nil)
 	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriodInWeeksForTesting, testEnclaveUuid, testUserService1Uuid)
 
 	require.NoError(t, err)
@@ -130,7 +131,7 @@ func TestGetLogFilePathsAcrossNewYearWith53Weeks(t *testing.T) {
 	}
 
 	mockTime := logs_clock.NewMockLogsClock(2016, currentWeek, 1)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting, nil)
 	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriodInWeeksForTesting, testEnclaveUuid, testUserService1Uuid)
 
 	require.NoError(t, err)
@@ -162,7 +163,7 @@ func TestGetLogFilePathsWithDiffRetentionPeriod(t *testing.T) {
 	}
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting, nil)
 	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriod, testEnclaveUuid, testUserService1Uuid)
 
 	require.NoError(t, err)
@@ -194,7 +195,7 @@ func TestGetLogFilePathsReturnsAllAvailableWeeks(t *testing.T) {
 	currentWeek := 2
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting, nil)
 	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriodInWeeksForTesting, testEnclaveUuid, testUserService1Uuid)
 
 	require.NoError(t, err)
@@ -219,7 +220,7 @@ func TestGetLogFilePathsReturnsCorrectPathsIfWeeksMissingInBetween(t *testing.T)
 	currentWeek := 3
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting, nil)
 	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriodInWeeksForTesting, testEnclaveUuid, testUserService1Uuid)
 
 	require.NoError(t, err)
@@ -248,7 +249,7 @@ func TestGetLogFilePathsReturnsCorrectPathsIfCurrentWeekHasNoLogsYet(t *testing.
 		week2filepath,
 	}
 
-	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting, nil)
 	logFilePaths, err := strategy.getLogFilePaths(filesystem, retentionPeriodInWeeksForTesting, testEnclaveUuid, testUserService1Uuid)
 
 	require.NoError(t, err)
@@ -266,7 +267,7 @@ func TestIsWithinRetentionPeriod(t *testing.T) {
 
 	// week 41 would put the log line outside the retention period
 	mockTime := logs_clock.NewMockLogsClock(2023, 41, 0)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, retentionPeriodInWeeksForTesting, nil)
 
 	timestamp, err := parseTimestampFromJsonLogLine(jsonLogLine)
 	require.NoError(t, err)
@@ -457,6 +458,32 @@ func TestGetJsonLogStringWithEOFAndNoValidJsonEnding(t *testing.T) {
 	require.Equal(t, logLine1, jsonLogStr)
 }
 
+func TestMarkLogMessageSource(t *testing.T) {
+	serviceLogLine := JsonLog{
+		"log": "Starting the node",
+	}
+	require.Equal(t, "Starting the node", markLogMessageSource(serviceLogLine, serviceLogLine["log"]))
+
+	kubernetesEventLogLine := JsonLog{
+		"log":                         "Warning BackOff: Back-off restarting failed container",
+		logs_collector.LogSourceLabel: logs_collector.KubernetesEventLogSource,
+	}
+	require.Equal(t, "[kubernetes event] Warning BackOff: Back-off restarting failed container", markLogMessageSource(kubernetesEventLogLine, kubernetesEventLogLine["log"]))
+
+	initContainerLogLine := JsonLog{
+		"log":                             "Expanding files artifact",
+		logs_collector.ContainerNameLabel: "files-artifact-expander",
+	}
+	require.Equal(t, "[files-artifact-expander] Expanding files artifact", markLogMessageSource(initContainerLogLine, initContainerLogLine["log"]))
+
+	containerRestartLogLine := JsonLog{
+		"log":                         "Container restarted (restart 1); the lines before are the logs of its previous run",
+		logs_collector.LogSourceLabel: logs_collector.ContainerRestartLogSource,
+		logs_collector.ContainerRestartCountLabel: "1",
+	}
+	require.Equal(t, "[container restart] Container restarted (restart 1); the lines before are the logs of its previous run", markLogMessageSource(containerRestartLogLine, containerRestartLogLine["log"]))
+}
+
 func TestParseTimestampFromJsonLogLineReturnsTime(t *testing.T) {
 	timestampStr := "2023-09-06T00:35:15Z" // utc timestamp
 	jsonLogLine := map[string]string{
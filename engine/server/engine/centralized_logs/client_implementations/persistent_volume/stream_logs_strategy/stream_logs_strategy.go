@@ -24,4 +24,7 @@ type StreamLogsStrategy interface {
 		shouldReturnAllLogs bool,
 		numLogLines uint32,
 	)
+
+	// SetLogRetentionPeriodInWeeks changes how far back the logs streamed from now on go
+	SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int)
 }
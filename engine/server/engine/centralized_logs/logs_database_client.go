@@ -37,4 +37,7 @@ type LogsDatabaseClient interface {
 	RemoveEnclaveLogs(enclaveUuid string) error
 
 	RemoveAllLogs() error
+
+	// SetLogRetentionPeriodInWeeks changes how long logs are kept and how far back the logs streamed from now on go
+	SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int)
 }
@@ -0,0 +1,188 @@
+package config_reloader
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_file_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	engineConfigStateKey = "engine-config.json"
+
+	watchInterval = 10 * time.Second
+)
+
+type logRetentionPeriodSetter interface {
+	SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int)
+}
+
+type enclaveQuotaSetter interface {
+	SetEnclaveQuota(enclaveQuota enclave_quota.EnclaveQuota)
+}
+
+type defaultEnclaveTtlSetter interface {
+	SetDefaultTtl(defaultTtl time.Duration)
+}
+
+// storedEngineConfig is the last config applied to the engine, kept in the state store so that every engine replica,
+// including the ones restarting, applies it
+type storedEngineConfig struct {
+	// StartupConfig is the config of the engine that stored the config; engines started with another config, e.g. by
+	// `kurtosis engine restart` after editing the Kurtosis config, ignore it as it predates theirs
+	StartupConfig args.ReloadableEngineConfig `json:"startupConfig"`
+
+	Config args.ReloadableEngineConfig `json:"config"`
+}
+
+// ConfigReloader applies changes to the engine config without restarting the engine, which would drop the active log
+// streams and port forwards
+type ConfigReloader struct {
+	stateStore state_store.StateStore
+
+	// The config the engine was started with
+	startupConfig args.ReloadableEngineConfig
+
+	// Guards the current config and serializes applying configs
+	mutex         sync.Mutex
+	currentConfig args.ReloadableEngineConfig
+
+	// nil if the engine doesn't require authentication
+	authenticator *auth.EngineAuthenticator
+
+	logsDbClient   logRetentionPeriodSetter
+	enclaveManager enclaveQuotaSetter
+	enclaveReaper  defaultEnclaveTtlSetter
+}
+
+func NewConfigReloader(
+	stateStore state_store.StateStore,
+	startupConfig args.ReloadableEngineConfig,
+	authenticator *auth.EngineAuthenticator,
+	logsDbClient logRetentionPeriodSetter,
+	enclaveManager enclaveQuotaSetter,
+	enclaveReaper defaultEnclaveTtlSetter,
+) *ConfigReloader {
+	return &ConfigReloader{
+		stateStore:     stateStore,
+		startupConfig:  startupConfig,
+		mutex:          sync.Mutex{},
+		currentConfig:  startupConfig,
+		authenticator:  authenticator,
+		logsDbClient:   logsDbClient,
+		enclaveManager: enclaveManager,
+		enclaveReaper:  enclaveReaper,
+	}
+}
+
+// UpdateConfig applies the config to this engine, and stores it so that the other engine replicas apply it too
+func (reloader *ConfigReloader) UpdateConfig(config args.ReloadableEngineConfig) error {
+	if err := reloader.validate(config); err != nil {
+		return stacktrace.Propagate(err, "The engine config is invalid")
+	}
+	err := reloader.stateStore.Update(engineConfigStateKey, func(_ []byte) ([]byte, error) {
+		return json.Marshal(storedEngineConfig{
+			StartupConfig: reloader.startupConfig,
+			Config:        config,
+		})
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the engine config")
+	}
+	if err = reloader.apply(config); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the engine config")
+	}
+	return nil
+}
+
+// StartWatching applies the config stored by the engine replicas now and then periodically, in the background
+func (reloader *ConfigReloader) StartWatching(ctx context.Context) {
+	reloader.ReloadStoredConfig()
+	go func() {
+		watchTicker := time.NewTicker(watchInterval)
+		defer watchTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-watchTicker.C:
+				reloader.ReloadStoredConfig()
+			}
+		}
+	}()
+}
+
+// ReloadStoredConfig applies the config stored by the engine replicas if it changed. Failures are logged and retried
+// on the next run
+func (reloader *ConfigReloader) ReloadStoredConfig() {
+	storedConfigBytes, err := reloader.stateStore.Load(engineConfigStateKey)
+	if err != nil {
+		logrus.Errorf("An error occurred loading the stored engine config; will retry in '%v':\n%v", watchInterval, err)
+		return
+	}
+	if storedConfigBytes == nil {
+		return
+	}
+	storedConfig := &storedEngineConfig{} // nolint:exhaustruct
+	if err = json.Unmarshal(storedConfigBytes, storedConfig); err != nil {
+		logrus.Errorf("An error occurred unmarshalling the stored engine config; will retry in '%v':\n%v", watchInterval, err)
+		return
+	}
+	if !reflect.DeepEqual(storedConfig.StartupConfig, reloader.startupConfig) {
+		return
+	}
+	if err = reloader.apply(storedConfig.Config); err != nil {
+		logrus.Errorf("An error occurred applying the stored engine config; will retry in '%v':\n%v", watchInterval, err)
+	}
+}
+
+func (reloader *ConfigReloader) validate(config args.ReloadableEngineConfig) error {
+	if err := config.Validate(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the engine config")
+	}
+	isAuthEnabled := reloader.authenticator != nil
+	if config.AuthConfig.IsEnabled() != isAuthEnabled {
+		return stacktrace.NewError("Enabling or disabling engine authentication requires restarting the engine; only the tokens can change while it runs")
+	}
+	return nil
+}
+
+func (reloader *ConfigReloader) apply(config args.ReloadableEngineConfig) error {
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	if reflect.DeepEqual(config, reloader.currentConfig) {
+		return nil
+	}
+	if err := reloader.validate(config); err != nil {
+		return stacktrace.Propagate(err, "The engine config is invalid")
+	}
+	logRetentionPeriod, err := config.GetLogRetentionPeriod()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the log retention period")
+	}
+	defaultEnclaveTtl, err := args.ParseEnclaveTtl(config.DefaultEnclaveTtl)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the default enclave TTL")
+	}
+
+	reloader.logsDbClient.SetLogRetentionPeriodInWeeks(log_file_manager.GetLogRetentionPeriodInWeeks(logRetentionPeriod))
+	reloader.enclaveManager.SetEnclaveQuota(config.EnclaveQuota)
+	reloader.enclaveReaper.SetDefaultTtl(defaultEnclaveTtl)
+	if reloader.authenticator != nil {
+		reloader.authenticator.UpdateConfig(config.AuthConfig)
+	}
+	reloader.currentConfig = config
+	logrus.Infof("Applied the new engine config with log retention period '%v', enclave quota '%+v', %v static token(s), OIDC %v and default enclave TTL '%v'",
+		config.LogRetentionPeriod, config.EnclaveQuota, len(config.AuthConfig.StaticTokens), config.AuthConfig.Oidc != nil, config.DefaultEnclaveTtl)
+	return nil
+}
@@ -0,0 +1,87 @@
+package config_reloader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tokenSha256 = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+)
+
+type fakeConfigTargets struct {
+	logRetentionPeriodInWeeks int
+	enclaveQuota              enclave_quota.EnclaveQuota
+	defaultTtl                time.Duration
+}
+
+func (targets *fakeConfigTargets) SetLogRetentionPeriodInWeeks(logRetentionPeriodInWeeks int) {
+	targets.logRetentionPeriodInWeeks = logRetentionPeriodInWeeks
+}
+
+func (targets *fakeConfigTargets) SetEnclaveQuota(enclaveQuota enclave_quota.EnclaveQuota) {
+	targets.enclaveQuota = enclaveQuota
+}
+
+func (targets *fakeConfigTargets) SetDefaultTtl(defaultTtl time.Duration) {
+	targets.defaultTtl = defaultTtl
+}
+
+func TestUpdateConfig_AppliesToEveryReplica(t *testing.T) {
+	stateStore := state_store.NewFileStateStore(t.TempDir())
+	startupConfig := args.NewReloadableEngineConfig("168h", enclave_quota.NewUnlimitedEnclaveQuota(), args.NewDisabledEngineAuthConfig(), "")
+
+	updatingTargets := &fakeConfigTargets{} // nolint:exhaustruct
+	updatingReloader := NewConfigReloader(stateStore, startupConfig, nil, updatingTargets, updatingTargets, updatingTargets)
+	otherReplicaTargets := &fakeConfigTargets{} // nolint:exhaustruct
+	otherReplicaReloader := NewConfigReloader(stateStore, startupConfig, nil, otherReplicaTargets, otherReplicaTargets, otherReplicaTargets)
+
+	newQuota := enclave_quota.EnclaveQuota{MaxServices: 5, MaxCpuMilliCores: 0, MaxMemoryMegabytes: 0}
+	newConfig := args.NewReloadableEngineConfig("500h", newQuota, args.NewDisabledEngineAuthConfig(), "4h")
+	require.NoError(t, updatingReloader.UpdateConfig(newConfig))
+	require.Equal(t, 3, updatingTargets.logRetentionPeriodInWeeks)
+	require.Equal(t, newQuota, updatingTargets.enclaveQuota)
+	require.Equal(t, 4*time.Hour, updatingTargets.defaultTtl)
+
+	otherReplicaReloader.ReloadStoredConfig()
+	require.Equal(t, 3, otherReplicaTargets.logRetentionPeriodInWeeks)
+	require.Equal(t, newQuota, otherReplicaTargets.enclaveQuota)
+	require.Equal(t, 4*time.Hour, otherReplicaTargets.defaultTtl)
+}
+
+func TestReloadStoredConfig_IgnoresConfigOfPreviousEngine(t *testing.T) {
+	stateStore := state_store.NewFileStateStore(t.TempDir())
+	previousStartupConfig := args.NewReloadableEngineConfig("168h", enclave_quota.NewUnlimitedEnclaveQuota(), args.NewDisabledEngineAuthConfig(), "")
+	previousTargets := &fakeConfigTargets{} // nolint:exhaustruct
+	previousReloader := NewConfigReloader(stateStore, previousStartupConfig, nil, previousTargets, previousTargets, previousTargets)
+	require.NoError(t, previousReloader.UpdateConfig(args.NewReloadableEngineConfig("500h", enclave_quota.NewUnlimitedEnclaveQuota(), args.NewDisabledEngineAuthConfig(), "")))
+
+	restartedStartupConfig := args.NewReloadableEngineConfig("24h", enclave_quota.NewUnlimitedEnclaveQuota(), args.NewDisabledEngineAuthConfig(), "")
+	restartedTargets := &fakeConfigTargets{} // nolint:exhaustruct
+	restartedReloader := NewConfigReloader(stateStore, restartedStartupConfig, nil, restartedTargets, restartedTargets, restartedTargets)
+	restartedReloader.ReloadStoredConfig()
+	require.Equal(t, 0, restartedTargets.logRetentionPeriodInWeeks)
+}
+
+func TestUpdateConfig_CannotToggleAuth(t *testing.T) {
+	stateStore := state_store.NewFileStateStore(t.TempDir())
+	startupConfig := args.NewReloadableEngineConfig("168h", enclave_quota.NewUnlimitedEnclaveQuota(), args.NewDisabledEngineAuthConfig(), "")
+	targets := &fakeConfigTargets{} // nolint:exhaustruct
+	reloader := NewConfigReloader(stateStore, startupConfig, nil, targets, targets, targets)
+
+	authConfig := args.EngineAuthConfig{
+		StaticTokens: []args.StaticTokenConfig{{Name: "ci", TokenSha256: tokenSha256, Scopes: []string{args.EngineAuthScope_Write}}},
+		Oidc:         nil,
+	}
+	err := reloader.UpdateConfig(args.NewReloadableEngineConfig("168h", enclave_quota.NewUnlimitedEnclaveQuota(), authConfig, ""))
+	require.ErrorContains(t, err, "requires restarting the engine")
+
+	stored, err := stateStore.Load(engineConfigStateKey)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+}
@@ -0,0 +1,159 @@
+package enclave_access
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// EnclaveAccessController decides which enclaves the principal of a call can use. An enclave can be used by its owner,
+// the principal that created it, by the principals it's shared with, and by admins. Enclaves without an owner, created
+// before authentication was enabled, can only be used by admins until one of them shares it.
+type EnclaveAccessController struct {
+	// nil if the engine doesn't require authentication, in which case every enclave can be used by anyone
+	store *enclaveAccessStore
+}
+
+func NewEnclaveAccessController(stateStore state_store.StateStore) *EnclaveAccessController {
+	return &EnclaveAccessController{
+		store: newEnclaveAccessStore(stateStore),
+	}
+}
+
+func NewDisabledEnclaveAccessController() *EnclaveAccessController {
+	return &EnclaveAccessController{
+		store: nil,
+	}
+}
+
+// RecordOwner makes the principal of the call the owner of the enclave it just created
+func (controller *EnclaveAccessController) RecordOwner(ctx context.Context, enclaveUuid string) error {
+	principal := auth.GetPrincipalFromContext(ctx)
+	if controller.store == nil || principal == nil {
+		return nil
+	}
+	if err := controller.store.setOwner(enclaveUuid, principal.Name); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording '%v' as the owner of enclave '%v'", principal.Name, enclaveUuid)
+	}
+	return nil
+}
+
+// GetOwner returns the owner of the enclave, or nil if it has none or the engine doesn't require authentication
+func (controller *EnclaveAccessController) GetOwner(enclaveUuid string) *string {
+	if controller.store == nil {
+		return nil
+	}
+	access, err := controller.store.get(enclaveUuid)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the owner of enclave '%v'; it will be reported as unowned:\n%v", enclaveUuid, err)
+		return nil
+	}
+	if access == nil {
+		return nil
+	}
+	return &access.Owner
+}
+
+// IsAdmin returns true if the principal of the call can use every enclave
+func (controller *EnclaveAccessController) IsAdmin(ctx context.Context) bool {
+	if controller.store == nil {
+		return true
+	}
+	principal := auth.GetPrincipalFromContext(ctx)
+	return principal != nil && principal.IsGranted(args.EngineAuthScope_Admin)
+}
+
+// CanAccess returns true if the principal of the call can use the enclave
+func (controller *EnclaveAccessController) CanAccess(ctx context.Context, enclaveUuid string) bool {
+	if controller.IsAdmin(ctx) {
+		return true
+	}
+	principal := auth.GetPrincipalFromContext(ctx)
+	access, err := controller.store.get(enclaveUuid)
+	if err != nil {
+		logrus.Errorf("An error occurred getting the access of enclave '%v'; access is denied:\n%v", enclaveUuid, err)
+		return false
+	}
+	if principal == nil || access == nil {
+		return false
+	}
+	if access.Owner == principal.Name {
+		return true
+	}
+	for _, grantee := range access.Grantees {
+		if grantee == principal.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAccess returns an error if the principal of the call can't use the enclave
+func (controller *EnclaveAccessController) CheckAccess(ctx context.Context, enclaveUuid string) error {
+	if controller.CanAccess(ctx, enclaveUuid) {
+		return nil
+	}
+	return stacktrace.NewError("Enclave '%v' isn't owned by nor shared with '%v'", enclaveUuid, getPrincipalName(ctx))
+}
+
+// Share grants the principal access to the enclave, or revokes it, and returns the updated access of the enclave.
+// Only the owner of the enclave and admins can share it
+func (controller *EnclaveAccessController) Share(ctx context.Context, enclaveUuid string, grantee string, shouldRevoke bool) (*EnclaveAccess, error) {
+	if controller.store == nil {
+		return nil, stacktrace.NewError("Enclaves can't be shared because the engine doesn't require authentication, so everyone can already use every enclave")
+	}
+	grantee = strings.TrimSpace(grantee)
+	if grantee == "" {
+		return nil, stacktrace.NewError("The principal to share enclave '%v' with can't be empty", enclaveUuid)
+	}
+	access, err := controller.store.get(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the access of enclave '%v'", enclaveUuid)
+	}
+	principalName := getPrincipalName(ctx)
+	isOwner := access != nil && access.Owner == principalName
+	if !isOwner && !controller.IsAdmin(ctx) {
+		return nil, stacktrace.NewError("Only the owner of enclave '%v' and admins can share it, and '%v' is neither", enclaveUuid, principalName)
+	}
+	updatedAccess, err := controller.store.updateGrantees(enclaveUuid, principalName, grantee, shouldRevoke)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the access of enclave '%v'", enclaveUuid)
+	}
+	return updatedAccess, nil
+}
+
+// GetEnclaveUuidsWithState returns the UUIDs of the enclaves whose access is kept in the state store
+func (controller *EnclaveAccessController) GetEnclaveUuidsWithState() ([]string, error) {
+	if controller.store == nil {
+		return []string{}, nil
+	}
+	enclaveUuids, err := controller.store.getEnclaveUuids()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves with an owner")
+	}
+	return enclaveUuids, nil
+}
+
+// ForgetEnclaves removes the access of the enclaves from the state store, once they're gone for good
+func (controller *EnclaveAccessController) ForgetEnclaves(enclaveUuids []string) error {
+	if controller.store == nil {
+		return nil
+	}
+	if err := controller.store.remove(enclaveUuids...); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the access of enclaves '%v'", enclaveUuids)
+	}
+	return nil
+}
+
+func getPrincipalName(ctx context.Context) string {
+	principal := auth.GetPrincipalFromContext(ctx)
+	if principal == nil {
+		return ""
+	}
+	return principal.Name
+}
@@ -0,0 +1,84 @@
+package enclave_access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid      = "enclave-uuid"
+	otherTestEnclaveUuid = "other-enclave-uuid"
+)
+
+func TestEnclaveAccessController_OwnersGranteesAndAdmins(t *testing.T) {
+	storeDirpath := t.TempDir()
+	controller := NewEnclaveAccessController(state_store.NewFileStateStore(storeDirpath))
+
+	aliceCtx := newPrincipalContext("token:alice", args.EngineAuthScope_Write)
+	bobCtx := newPrincipalContext("token:bob", args.EngineAuthScope_Write)
+	adminCtx := newPrincipalContext("token:admin", args.EngineAuthScope_Admin)
+
+	require.NoError(t, controller.RecordOwner(aliceCtx, testEnclaveUuid))
+	require.Equal(t, "token:alice", *controller.GetOwner(testEnclaveUuid))
+	require.True(t, controller.CanAccess(aliceCtx, testEnclaveUuid))
+	require.False(t, controller.CanAccess(bobCtx, testEnclaveUuid))
+	require.True(t, controller.CanAccess(adminCtx, testEnclaveUuid))
+
+	_, err := controller.Share(bobCtx, testEnclaveUuid, "token:bob", false)
+	require.Error(t, err)
+	access, err := controller.Share(aliceCtx, testEnclaveUuid, "token:bob", false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"token:bob"}, access.Grantees)
+	require.True(t, controller.CanAccess(bobCtx, testEnclaveUuid))
+
+	// The access survives engine restarts
+	reloadedController := NewEnclaveAccessController(state_store.NewFileStateStore(storeDirpath))
+	require.True(t, reloadedController.CanAccess(bobCtx, testEnclaveUuid))
+
+	access, err = controller.Share(aliceCtx, testEnclaveUuid, "token:bob", true)
+	require.NoError(t, err)
+	require.Empty(t, access.Grantees)
+	require.False(t, controller.CanAccess(bobCtx, testEnclaveUuid))
+}
+
+func TestEnclaveAccessController_UnownedEnclaves(t *testing.T) {
+	controller := NewEnclaveAccessController(state_store.NewFileStateStore(t.TempDir()))
+
+	aliceCtx := newPrincipalContext("token:alice", args.EngineAuthScope_Write)
+	adminCtx := newPrincipalContext("token:admin", args.EngineAuthScope_Admin)
+
+	require.Nil(t, controller.GetOwner(otherTestEnclaveUuid))
+	require.False(t, controller.CanAccess(aliceCtx, otherTestEnclaveUuid))
+	require.True(t, controller.CanAccess(adminCtx, otherTestEnclaveUuid))
+
+	_, err := controller.Share(aliceCtx, otherTestEnclaveUuid, "token:alice", false)
+	require.Error(t, err)
+	access, err := controller.Share(adminCtx, otherTestEnclaveUuid, "token:alice", false)
+	require.NoError(t, err)
+	require.Equal(t, "token:admin", access.Owner)
+	require.True(t, controller.CanAccess(aliceCtx, otherTestEnclaveUuid))
+}
+
+func TestEnclaveAccessController_Disabled(t *testing.T) {
+	controller := NewDisabledEnclaveAccessController()
+	ctx := context.Background()
+
+	require.NoError(t, controller.RecordOwner(ctx, testEnclaveUuid))
+	require.Nil(t, controller.GetOwner(testEnclaveUuid))
+	require.True(t, controller.CanAccess(ctx, testEnclaveUuid))
+	require.True(t, controller.IsAdmin(ctx))
+	_, err := controller.Share(ctx, testEnclaveUuid, "token:bob", false)
+	require.Error(t, err)
+}
+
+func newPrincipalContext(name string, scope string) context.Context {
+	return auth.ContextWithPrincipal(context.Background(), &auth.Principal{
+		Name:   name,
+		Scopes: []string{scope},
+	})
+}
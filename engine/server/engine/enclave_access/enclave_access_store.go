@@ -0,0 +1,162 @@
+package enclave_access
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Also the name of the file the engines that ran before the state store stored the access in
+	enclaveAccessStateKey = "enclave-access.json"
+)
+
+// EnclaveAccess is who can use an enclave besides admins
+type EnclaveAccess struct {
+	Owner string `json:"owner"`
+
+	Grantees []string `json:"grantees"`
+}
+
+// enclaveAccessStore keeps the access of every enclave as JSON in the engine state store, so that it survives engine
+// restarts and is seen by every engine replica
+type enclaveAccessStore struct {
+	stateStore state_store.StateStore
+
+	// Every call checks the access of an enclave, and listing the enclaves checks every one of them
+	decodedAccessByEnclaveUuid *state_store.DecodedValueCache[map[string]*EnclaveAccess]
+}
+
+func newEnclaveAccessStore(stateStore state_store.StateStore) *enclaveAccessStore {
+	return &enclaveAccessStore{
+		stateStore:                 stateStore,
+		decodedAccessByEnclaveUuid: state_store.NewDecodedValueCache(deserializeAccessByEnclaveUuid),
+	}
+}
+
+// get returns the access of the enclave, or nil if it has no owner
+func (store *enclaveAccessStore) get(enclaveUuid string) (*EnclaveAccess, error) {
+	accessByEnclaveUuid, err := store.load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave access store")
+	}
+	access, found := accessByEnclaveUuid[enclaveUuid]
+	if !found {
+		return nil, nil
+	}
+	return access, nil
+}
+
+func (store *enclaveAccessStore) setOwner(enclaveUuid string, owner string) error {
+	err := store.update(func(accessByEnclaveUuid map[string]*EnclaveAccess) {
+		accessByEnclaveUuid[enclaveUuid] = &EnclaveAccess{
+			Owner:    owner,
+			Grantees: []string{},
+		}
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the owner of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+// updateGrantees adds or removes the grantee and returns the updated access of the enclave. Unowned enclaves get the
+// given owner, so that admins can share enclaves created before authentication was enabled
+func (store *enclaveAccessStore) updateGrantees(enclaveUuid string, ownerIfUnowned string, grantee string, shouldRevoke bool) (*EnclaveAccess, error) {
+	var updatedAccess *EnclaveAccess
+	err := store.update(func(accessByEnclaveUuid map[string]*EnclaveAccess) {
+		previousAccess, found := accessByEnclaveUuid[enclaveUuid]
+		updatedAccess = &EnclaveAccess{
+			Owner:    ownerIfUnowned,
+			Grantees: []string{},
+		}
+		if found {
+			updatedAccess.Owner = previousAccess.Owner
+			for _, previousGrantee := range previousAccess.Grantees {
+				if previousGrantee != grantee {
+					updatedAccess.Grantees = append(updatedAccess.Grantees, previousGrantee)
+				}
+			}
+		}
+		if !shouldRevoke {
+			updatedAccess.Grantees = append(updatedAccess.Grantees, grantee)
+		}
+		sort.Strings(updatedAccess.Grantees)
+		accessByEnclaveUuid[enclaveUuid] = updatedAccess
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred persisting the access of enclave '%v'", enclaveUuid)
+	}
+	return updatedAccess, nil
+}
+
+// getEnclaveUuids returns the UUIDs of the enclaves with an owner
+func (store *enclaveAccessStore) getEnclaveUuids() ([]string, error) {
+	accessByEnclaveUuid, err := store.load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave access store")
+	}
+	enclaveUuids := []string{}
+	for enclaveUuid := range accessByEnclaveUuid {
+		enclaveUuids = append(enclaveUuids, enclaveUuid)
+	}
+	return enclaveUuids, nil
+}
+
+func (store *enclaveAccessStore) remove(enclaveUuids ...string) error {
+	err := store.update(func(accessByEnclaveUuid map[string]*EnclaveAccess) {
+		for _, enclaveUuid := range enclaveUuids {
+			delete(accessByEnclaveUuid, enclaveUuid)
+		}
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the removal of the access of enclaves '%v'", enclaveUuids)
+	}
+	return nil
+}
+
+// load returns the access of every enclave, which is shared with the other callers so it must not be modified
+func (store *enclaveAccessStore) load() (map[string]*EnclaveAccess, error) {
+	serializedAccessByEnclaveUuid, err := store.stateStore.Load(enclaveAccessStateKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", enclaveAccessStateKey)
+	}
+	accessByEnclaveUuid, err := store.decodedAccessByEnclaveUuid.Decode(serializedAccessByEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave access store")
+	}
+	return accessByEnclaveUuid, nil
+}
+
+// update applies the change to the latest access of every enclave
+func (store *enclaveAccessStore) update(changeFunc func(accessByEnclaveUuid map[string]*EnclaveAccess)) error {
+	err := store.stateStore.Update(enclaveAccessStateKey, func(serializedAccessByEnclaveUuid []byte) ([]byte, error) {
+		accessByEnclaveUuid, err := deserializeAccessByEnclaveUuid(serializedAccessByEnclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave access store")
+		}
+		changeFunc(accessByEnclaveUuid)
+		updatedSerializedAccessByEnclaveUuid, err := json.Marshal(accessByEnclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing the enclave access store")
+		}
+		return updatedSerializedAccessByEnclaveUuid, nil
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the enclave access store")
+	}
+	return nil
+}
+
+func deserializeAccessByEnclaveUuid(serializedAccessByEnclaveUuid []byte) (map[string]*EnclaveAccess, error) {
+	accessByEnclaveUuid := map[string]*EnclaveAccess{}
+	if serializedAccessByEnclaveUuid == nil {
+		return accessByEnclaveUuid, nil
+	}
+	if err := json.Unmarshal(serializedAccessByEnclaveUuid, &accessByEnclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the access of the enclaves")
+	}
+	return accessByEnclaveUuid, nil
+}
@@ -0,0 +1,191 @@
+package enclave_certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/api_container_tls"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Enclaves can live for as long as they're needed and their certificates aren't renewed, so they're valid for as
+	// long as the enclaves are reasonably expected to live
+	certificateValidity = 10 * 365 * 24 * time.Hour
+
+	// The certificates issued to the callers of the engine are renewed by the SDK as they expire, so that a leaked one
+	// isn't of use for long
+	callerCertificateValidity = time.Hour
+
+	// Tolerates the clocks of the engine and of the clients being slightly apart
+	certificateClockSkew = time.Hour
+
+	serialNumberBits = 128
+
+	certificateAuthorityCommonNameFormat = "Kurtosis enclave %v"
+
+	certificatePemBlockType = "CERTIFICATE"
+	privateKeyPemBlockType  = "EC PRIVATE KEY"
+)
+
+// TlsCredentials is a PEM-encoded certificate and its private key
+type TlsCredentials struct {
+	CertificatePem string
+	KeyPem         string
+}
+
+// certificateAuthority is the authority of an enclave, which issues the certificates its API container and the clients
+// of the API container authenticate each other with
+type certificateAuthority struct {
+	certificate    *x509.Certificate
+	certificatePem string
+	privateKey     *ecdsa.PrivateKey
+}
+
+func newCertificateAuthority(enclaveUuid string) (*certificateAuthority, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the private key of the certificate authority of enclave '%v'", enclaveUuid)
+	}
+	template, err := newCertificateTemplate(newName(fmt.Sprintf(certificateAuthorityCommonNameFormat, enclaveUuid)), certificateValidity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the certificate of the authority of enclave '%v'", enclaveUuid)
+	}
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	certificateDer, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred signing the certificate of the authority of enclave '%v'", enclaveUuid)
+	}
+	certificate, err := x509.ParseCertificate(certificateDer)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the certificate of the authority of enclave '%v'", enclaveUuid)
+	}
+	return &certificateAuthority{
+		certificate:    certificate,
+		certificatePem: encodePem(certificatePemBlockType, certificateDer),
+		privateKey:     privateKey,
+	}, nil
+}
+
+func deserializeCertificateAuthority(serializedAuthority *serializedCertificateAuthority) (*certificateAuthority, error) {
+	certificateBlock, _ := pem.Decode([]byte(serializedAuthority.CertificatePem))
+	if certificateBlock == nil {
+		return nil, stacktrace.NewError("No PEM-encoded certificate was found in the certificate of the authority")
+	}
+	certificate, err := x509.ParseCertificate(certificateBlock.Bytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the certificate of the authority")
+	}
+	privateKeyBlock, _ := pem.Decode([]byte(serializedAuthority.PrivateKeyPem))
+	if privateKeyBlock == nil {
+		return nil, stacktrace.NewError("No PEM-encoded private key was found in the private key of the authority")
+	}
+	privateKey, err := x509.ParseECPrivateKey(privateKeyBlock.Bytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the private key of the authority")
+	}
+	return &certificateAuthority{
+		certificate:    certificate,
+		certificatePem: serializedAuthority.CertificatePem,
+		privateKey:     privateKey,
+	}, nil
+}
+
+func (authority *certificateAuthority) serialize() (*serializedCertificateAuthority, error) {
+	privateKeyDer, err := x509.MarshalECPrivateKey(authority.privateKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the private key of the authority")
+	}
+	return &serializedCertificateAuthority{
+		CertificatePem: authority.certificatePem,
+		PrivateKeyPem:  encodePem(privateKeyPemBlockType, privateKeyDer),
+	}, nil
+}
+
+// issueServerCertificate issues the certificate of the API container, for the name its clients expect whatever the
+// address they reach it at
+func (authority *certificateAuthority) issueServerCertificate() (*TlsCredentials, error) {
+	return authority.issueCertificate(api_container_tls.ServerName, x509.ExtKeyUsageServerAuth, certificateValidity)
+}
+
+// issueClientCertificate issues a certificate the API container accepts the calls of, valid for the given duration
+func (authority *certificateAuthority) issueClientCertificate(commonName string, validity time.Duration) (*TlsCredentials, error) {
+	return authority.issueCertificate(commonName, x509.ExtKeyUsageClientAuth, validity)
+}
+
+func (authority *certificateAuthority) issueCertificate(commonName string, extKeyUsage x509.ExtKeyUsage, validity time.Duration) (*TlsCredentials, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the private key of certificate '%v'", commonName)
+	}
+	template, err := newCertificateTemplate(newName(commonName), validity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating certificate '%v'", commonName)
+	}
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	template.ExtKeyUsage = []x509.ExtKeyUsage{extKeyUsage}
+	if extKeyUsage == x509.ExtKeyUsageServerAuth {
+		template.DNSNames = []string{commonName}
+	}
+	certificateDer, err := x509.CreateCertificate(rand.Reader, template, authority.certificate, &privateKey.PublicKey, authority.privateKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred signing certificate '%v'", commonName)
+	}
+	privateKeyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the private key of certificate '%v'", commonName)
+	}
+	return &TlsCredentials{
+		CertificatePem: encodePem(certificatePemBlockType, certificateDer),
+		KeyPem:         encodePem(privateKeyPemBlockType, privateKeyDer),
+	}, nil
+}
+
+// newCertificateTemplate returns a certificate valid from now on for the given duration, to which the caller adds its
+// usages. It's allocated with new as most of the many fields of a certificate are filled in when it's signed
+func newCertificateTemplate(subject pkix.Name, validity time.Duration) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the serial number of the certificate")
+	}
+	now := time.Now()
+	template := new(x509.Certificate)
+	template.SerialNumber = serialNumber
+	template.Subject = subject
+	template.NotBefore = now.Add(-certificateClockSkew)
+	template.NotAfter = now.Add(validity)
+	return template, nil
+}
+
+func newName(commonName string) pkix.Name {
+	return pkix.Name{
+		Country:            nil,
+		Organization:       nil,
+		OrganizationalUnit: nil,
+		Locality:           nil,
+		Province:           nil,
+		StreetAddress:      nil,
+		PostalCode:         nil,
+		SerialNumber:       "",
+		CommonName:         commonName,
+		Names:              nil,
+		ExtraNames:         nil,
+	}
+}
+
+func encodePem(blockType string, der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:    blockType,
+		Headers: nil,
+		Bytes:   der,
+	}))
+}
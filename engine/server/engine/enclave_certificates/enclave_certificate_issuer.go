@@ -0,0 +1,278 @@
+package enclave_certificates
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/fips_mode"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveCertificateAuthoritiesStateKey = "enclave-certificate-authorities.json"
+
+	apiContainerClientCommonName = "kurtosis-api-container"
+	engineClientCommonName       = "kurtosis-engine"
+
+	// The callers of the engine are told apart in the certificates they get, e.g. 'kurtosis-client token:ci'
+	callerClientCommonNameFormat = "kurtosis-client %v"
+)
+
+type serializedCertificateAuthority struct {
+	CertificatePem string `json:"certificate"`
+	PrivateKeyPem  string `json:"privateKey"`
+}
+
+// ClientTlsCredentials is what the clients of the API container of an enclave need to call it
+type ClientTlsCredentials struct {
+	CertificateAuthorityPem string
+	TlsCredentials
+}
+
+// EnclaveCertificateIssuer creates a certificate authority for each enclave, which issues the certificates its API
+// container and the clients of the API container authenticate each other with. Only the engine and the callers that
+// can change the enclave get a client certificate, short-lived for the latter, so the other workloads of the cluster
+// can't call the API container nor pose as it. The authorities are kept in the state store, so that the API containers keep trusting the clients of the
+// engine across its restarts
+type EnclaveCertificateIssuer struct {
+	stateStore state_store.StateStore
+
+	// If false, the API containers launched from now on don't require mutual TLS. The ones that were launched requiring
+	// it keep their authority until they're launched again
+	isEnabled bool
+
+	// Listing the enclaves gets the client credentials of every one of them
+	decodedAuthorityByEnclaveUuid *state_store.DecodedValueCache[map[string]*serializedCertificateAuthority]
+
+	// The client credentials of the engine are issued once per enclave for the lifetime of the engine, rather than on
+	// every call
+	clientCredentialsMutex         *sync.Mutex
+	clientCredentialsByEnclaveUuid map[string]*ClientTlsCredentials
+}
+
+func NewEnclaveCertificateIssuer(stateStore state_store.StateStore, isEnabled bool) *EnclaveCertificateIssuer {
+	return &EnclaveCertificateIssuer{
+		stateStore:                     stateStore,
+		isEnabled:                      isEnabled,
+		decodedAuthorityByEnclaveUuid:  state_store.NewDecodedValueCache(deserializeAuthorityByEnclaveUuid),
+		clientCredentialsMutex:         &sync.Mutex{},
+		clientCredentialsByEnclaveUuid: map[string]*ClientTlsCredentials{},
+	}
+}
+
+// GetApiContainerTlsConfig returns the certificates the API container of the enclave is launched with, creating the
+// authority of the enclave if it has none yet. It returns nil if the API containers don't require mutual TLS, in which
+// case the authority of the enclave is dropped so that its clients stop using TLS
+func (issuer *EnclaveCertificateIssuer) GetApiContainerTlsConfig(enclaveUuid string) (*args.ApiContainerTlsConfig, error) {
+	if !issuer.isEnabled {
+		if err := issuer.ForgetEnclaves([]string{enclaveUuid}); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred dropping the certificate authority of enclave '%v'", enclaveUuid)
+		}
+		return nil, nil
+	}
+	authority, err := issuer.getOrCreateAuthority(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the certificate authority of enclave '%v'", enclaveUuid)
+	}
+	serverCredentials, err := authority.issueServerCertificate()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred issuing the server certificate of the API container of enclave '%v'", enclaveUuid)
+	}
+	apiContainerClientCredentials, err := authority.issueClientCertificate(apiContainerClientCommonName, certificateValidity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred issuing the client certificate of the API container of enclave '%v'", enclaveUuid)
+	}
+	return &args.ApiContainerTlsConfig{
+		CertificateAuthority: authority.certificatePem,
+		ServerCertificate:    serverCredentials.CertificatePem,
+		ServerKey:            serverCredentials.KeyPem,
+		ClientCertificate:    apiContainerClientCredentials.CertificatePem,
+		ClientKey:            apiContainerClientCredentials.KeyPem,
+		FipsMode:             fips_mode.IsEnabled(),
+	}, nil
+}
+
+// IsMutualTlsRequired returns true if the API container of the enclave only accepts the calls authenticated with a
+// certificate issued for its enclave
+func (issuer *EnclaveCertificateIssuer) IsMutualTlsRequired(enclaveUuid string) (bool, error) {
+	authorityByEnclaveUuid, err := issuer.load()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred loading the enclave certificate authorities")
+	}
+	_, found := authorityByEnclaveUuid[enclaveUuid]
+	return found, nil
+}
+
+// IssueCallerClientCredentials returns a short-lived certificate a caller of the engine calls the API container of the
+// enclave with, or nil if the API container doesn't require mutual TLS. The caller is expected to be allowed to change
+// the enclave
+func (issuer *EnclaveCertificateIssuer) IssueCallerClientCredentials(enclaveUuid string, callerName string) (*ClientTlsCredentials, error) {
+	authorityByEnclaveUuid, err := issuer.load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave certificate authorities")
+	}
+	serializedAuthority, found := authorityByEnclaveUuid[enclaveUuid]
+	if !found {
+		return nil, nil
+	}
+	authority, err := deserializeCertificateAuthority(serializedAuthority)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the certificate authority of enclave '%v'", enclaveUuid)
+	}
+	tlsCredentials, err := authority.issueClientCertificate(fmt.Sprintf(callerClientCommonNameFormat, callerName), callerCertificateValidity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred issuing a client certificate of the API container of enclave '%v' to '%v'", enclaveUuid, callerName)
+	}
+	return &ClientTlsCredentials{
+		CertificateAuthorityPem: authority.certificatePem,
+		TlsCredentials:          *tlsCredentials,
+	}, nil
+}
+
+// GetClientCredentials returns what the engine calls the API container of the enclave with, or nil if the API
+// container doesn't require mutual TLS
+func (issuer *EnclaveCertificateIssuer) GetClientCredentials(enclaveUuid string) (*ClientTlsCredentials, error) {
+	issuer.clientCredentialsMutex.Lock()
+	defer issuer.clientCredentialsMutex.Unlock()
+	authorityByEnclaveUuid, err := issuer.load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave certificate authorities")
+	}
+	serializedAuthority, found := authorityByEnclaveUuid[enclaveUuid]
+	if !found {
+		delete(issuer.clientCredentialsByEnclaveUuid, enclaveUuid)
+		return nil, nil
+	}
+	// The authority of the enclave is replaced when it's dropped and created again, invalidating its clients
+	if clientCredentials, found := issuer.clientCredentialsByEnclaveUuid[enclaveUuid]; found && clientCredentials.CertificateAuthorityPem == serializedAuthority.CertificatePem {
+		return clientCredentials, nil
+	}
+	authority, err := deserializeCertificateAuthority(serializedAuthority)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the certificate authority of enclave '%v'", enclaveUuid)
+	}
+	tlsCredentials, err := authority.issueClientCertificate(engineClientCommonName, certificateValidity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred issuing a client certificate of the API container of enclave '%v'", enclaveUuid)
+	}
+	clientCredentials := &ClientTlsCredentials{
+		CertificateAuthorityPem: authority.certificatePem,
+		TlsCredentials:          *tlsCredentials,
+	}
+	issuer.clientCredentialsByEnclaveUuid[enclaveUuid] = clientCredentials
+	return clientCredentials, nil
+}
+
+// GetEnclaveUuidsWithState returns the UUIDs of the enclaves whose certificate authority is kept in the state store
+func (issuer *EnclaveCertificateIssuer) GetEnclaveUuidsWithState() ([]string, error) {
+	authorityByEnclaveUuid, err := issuer.load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave certificate authorities")
+	}
+	enclaveUuids := []string{}
+	for enclaveUuid := range authorityByEnclaveUuid {
+		enclaveUuids = append(enclaveUuids, enclaveUuid)
+	}
+	sort.Strings(enclaveUuids)
+	return enclaveUuids, nil
+}
+
+// ForgetEnclaves removes the certificate authority of the enclaves from the state store
+func (issuer *EnclaveCertificateIssuer) ForgetEnclaves(enclaveUuids []string) error {
+	authorityByEnclaveUuid, err := issuer.load()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading the enclave certificate authorities")
+	}
+	hasAuthorityToForget := false
+	for _, enclaveUuid := range enclaveUuids {
+		if _, found := authorityByEnclaveUuid[enclaveUuid]; found {
+			hasAuthorityToForget = true
+		}
+	}
+	// Leaves the state store untouched when mutual TLS was never enabled
+	if !hasAuthorityToForget {
+		return nil
+	}
+	err = issuer.stateStore.Update(enclaveCertificateAuthoritiesStateKey, func(serializedAuthorityByEnclaveUuid []byte) ([]byte, error) {
+		authorityByEnclaveUuid, err := deserializeAuthorityByEnclaveUuid(serializedAuthorityByEnclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave certificate authorities")
+		}
+		for _, enclaveUuid := range enclaveUuids {
+			delete(authorityByEnclaveUuid, enclaveUuid)
+		}
+		return serializeAuthorityByEnclaveUuid(authorityByEnclaveUuid)
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating key '%v' of the state store", enclaveCertificateAuthoritiesStateKey)
+	}
+	return nil
+}
+
+func (issuer *EnclaveCertificateIssuer) getOrCreateAuthority(enclaveUuid string) (*certificateAuthority, error) {
+	var authority *certificateAuthority
+	err := issuer.stateStore.Update(enclaveCertificateAuthoritiesStateKey, func(serializedAuthorityByEnclaveUuid []byte) ([]byte, error) {
+		authorityByEnclaveUuid, err := deserializeAuthorityByEnclaveUuid(serializedAuthorityByEnclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave certificate authorities")
+		}
+		// The API container of the enclave is launched again, e.g. when the engine restarts the API containers, and
+		// keeps the authority its clients already trust
+		if serializedAuthority, found := authorityByEnclaveUuid[enclaveUuid]; found {
+			authority, err = deserializeCertificateAuthority(serializedAuthority)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred deserializing the certificate authority of enclave '%v'", enclaveUuid)
+			}
+			return serializedAuthorityByEnclaveUuid, nil
+		}
+		authority, err = newCertificateAuthority(enclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the certificate authority of enclave '%v'", enclaveUuid)
+		}
+		authorityByEnclaveUuid[enclaveUuid], err = authority.serialize()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing the certificate authority of enclave '%v'", enclaveUuid)
+		}
+		return serializeAuthorityByEnclaveUuid(authorityByEnclaveUuid)
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating key '%v' of the state store", enclaveCertificateAuthoritiesStateKey)
+	}
+	return authority, nil
+}
+
+// load returns the authorities, which are shared with the other callers and must not be modified
+func (issuer *EnclaveCertificateIssuer) load() (map[string]*serializedCertificateAuthority, error) {
+	serializedAuthorityByEnclaveUuid, err := issuer.stateStore.Load(enclaveCertificateAuthoritiesStateKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", enclaveCertificateAuthoritiesStateKey)
+	}
+	authorityByEnclaveUuid, err := issuer.decodedAuthorityByEnclaveUuid.Decode(serializedAuthorityByEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave certificate authorities")
+	}
+	return authorityByEnclaveUuid, nil
+}
+
+func deserializeAuthorityByEnclaveUuid(serializedAuthorityByEnclaveUuid []byte) (map[string]*serializedCertificateAuthority, error) {
+	authorityByEnclaveUuid := map[string]*serializedCertificateAuthority{}
+	if serializedAuthorityByEnclaveUuid == nil {
+		return authorityByEnclaveUuid, nil
+	}
+	if err := json.Unmarshal(serializedAuthorityByEnclaveUuid, &authorityByEnclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave certificate authorities")
+	}
+	return authorityByEnclaveUuid, nil
+}
+
+func serializeAuthorityByEnclaveUuid(authorityByEnclaveUuid map[string]*serializedCertificateAuthority) ([]byte, error) {
+	serializedAuthorityByEnclaveUuid, err := json.Marshal(authorityByEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the enclave certificate authorities")
+	}
+	return serializedAuthorityByEnclaveUuid, nil
+}
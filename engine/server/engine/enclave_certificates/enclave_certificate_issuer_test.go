@@ -0,0 +1,175 @@
+package enclave_certificates
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/api_container_tls"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid      = "enclave-uuid"
+	otherTestEnclaveUuid = "other-enclave-uuid"
+)
+
+func TestEnclaveCertificateIssuer_ClientsAndApiContainerAuthenticateEachOther(t *testing.T) {
+	storeDirpath := t.TempDir()
+	issuer := NewEnclaveCertificateIssuer(state_store.NewFileStateStore(storeDirpath), true)
+
+	tlsConfig, err := issuer.GetApiContainerTlsConfig(testEnclaveUuid)
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig)
+	clientCredentials, err := issuer.GetClientCredentials(testEnclaveUuid)
+	require.NoError(t, err)
+	require.NotNil(t, clientCredentials)
+	require.Equal(t, tlsConfig.CertificateAuthority, clientCredentials.CertificateAuthorityPem)
+	require.NoError(t, handshake(t, tlsConfig, clientCredentials.CertificatePem, clientCredentials.KeyPem))
+	// The API container calls itself from the files artifacts expanders
+	require.NoError(t, handshake(t, tlsConfig, tlsConfig.ClientCertificate, tlsConfig.ClientKey))
+	// The certificates are P-256 ECDSA ones, which the FIPS-approved parameters allow
+	fipsModeTlsConfig := *tlsConfig
+	fipsModeTlsConfig.FipsMode = true
+	require.NoError(t, handshake(t, &fipsModeTlsConfig, clientCredentials.CertificatePem, clientCredentials.KeyPem))
+
+	// The API container launched again, e.g. after an engine restart, keeps trusting the clients
+	reloadedIssuer := NewEnclaveCertificateIssuer(state_store.NewFileStateStore(storeDirpath), true)
+	relaunchedTlsConfig, err := reloadedIssuer.GetApiContainerTlsConfig(testEnclaveUuid)
+	require.NoError(t, err)
+	require.Equal(t, tlsConfig.CertificateAuthority, relaunchedTlsConfig.CertificateAuthority)
+	require.NoError(t, handshake(t, relaunchedTlsConfig, clientCredentials.CertificatePem, clientCredentials.KeyPem))
+}
+
+func TestEnclaveCertificateIssuer_CallersGetShortLivedCertificates(t *testing.T) {
+	issuer := NewEnclaveCertificateIssuer(state_store.NewFileStateStore(t.TempDir()), true)
+	isMutualTlsRequired, err := issuer.IsMutualTlsRequired(testEnclaveUuid)
+	require.NoError(t, err)
+	require.False(t, isMutualTlsRequired)
+	callerCredentials, err := issuer.IssueCallerClientCredentials(testEnclaveUuid, "alice")
+	require.NoError(t, err)
+	require.Nil(t, callerCredentials)
+
+	tlsConfig, err := issuer.GetApiContainerTlsConfig(testEnclaveUuid)
+	require.NoError(t, err)
+	isMutualTlsRequired, err = issuer.IsMutualTlsRequired(testEnclaveUuid)
+	require.NoError(t, err)
+	require.True(t, isMutualTlsRequired)
+	callerCredentials, err = issuer.IssueCallerClientCredentials(testEnclaveUuid, "alice")
+	require.NoError(t, err)
+	require.NotNil(t, callerCredentials)
+	require.Equal(t, tlsConfig.CertificateAuthority, callerCredentials.CertificateAuthorityPem)
+	require.NoError(t, handshake(t, tlsConfig, callerCredentials.CertificatePem, callerCredentials.KeyPem))
+
+	callerCertificate := parseCertificate(t, callerCredentials.CertificatePem, callerCredentials.KeyPem)
+	require.Equal(t, "kurtosis-client alice", callerCertificate.Subject.CommonName)
+	require.True(t, callerCertificate.NotAfter.Before(time.Now().Add(callerCertificateValidity+time.Minute)))
+	engineCredentials, err := issuer.GetClientCredentials(testEnclaveUuid)
+	require.NoError(t, err)
+	engineCertificate := parseCertificate(t, engineCredentials.CertificatePem, engineCredentials.KeyPem)
+	require.NotEqual(t, engineCertificate.Subject.CommonName, callerCertificate.Subject.CommonName)
+
+	// Every call gets a certificate of its own, rather than the one the engine calls the API container with
+	otherCallerCredentials, err := issuer.IssueCallerClientCredentials(testEnclaveUuid, "alice")
+	require.NoError(t, err)
+	require.NotEqual(t, callerCredentials.KeyPem, otherCallerCredentials.KeyPem)
+	require.NotEqual(t, engineCredentials.KeyPem, callerCredentials.KeyPem)
+}
+
+func TestEnclaveCertificateIssuer_EnclavesDontTrustEachOther(t *testing.T) {
+	issuer := NewEnclaveCertificateIssuer(state_store.NewFileStateStore(t.TempDir()), true)
+
+	tlsConfig, err := issuer.GetApiContainerTlsConfig(testEnclaveUuid)
+	require.NoError(t, err)
+	_, err = issuer.GetApiContainerTlsConfig(otherTestEnclaveUuid)
+	require.NoError(t, err)
+	otherClientCredentials, err := issuer.GetClientCredentials(otherTestEnclaveUuid)
+	require.NoError(t, err)
+	require.Error(t, handshake(t, tlsConfig, otherClientCredentials.CertificatePem, otherClientCredentials.KeyPem))
+}
+
+func TestEnclaveCertificateIssuer_Disabled(t *testing.T) {
+	storeDirpath := t.TempDir()
+	issuer := NewEnclaveCertificateIssuer(state_store.NewFileStateStore(storeDirpath), true)
+	_, err := issuer.GetApiContainerTlsConfig(testEnclaveUuid)
+	require.NoError(t, err)
+
+	// The clients stop using TLS once the API container is launched again without requiring it
+	disabledIssuer := NewEnclaveCertificateIssuer(state_store.NewFileStateStore(storeDirpath), false)
+	clientCredentials, err := disabledIssuer.GetClientCredentials(testEnclaveUuid)
+	require.NoError(t, err)
+	require.NotNil(t, clientCredentials)
+	tlsConfig, err := disabledIssuer.GetApiContainerTlsConfig(testEnclaveUuid)
+	require.NoError(t, err)
+	require.Nil(t, tlsConfig)
+	clientCredentials, err = disabledIssuer.GetClientCredentials(testEnclaveUuid)
+	require.NoError(t, err)
+	require.Nil(t, clientCredentials)
+}
+
+func TestEnclaveCertificateIssuer_ForgetEnclaves(t *testing.T) {
+	issuer := NewEnclaveCertificateIssuer(state_store.NewFileStateStore(t.TempDir()), true)
+	enclaveUuids, err := issuer.GetEnclaveUuidsWithState()
+	require.NoError(t, err)
+	require.Empty(t, enclaveUuids)
+
+	_, err = issuer.GetApiContainerTlsConfig(testEnclaveUuid)
+	require.NoError(t, err)
+	_, err = issuer.GetApiContainerTlsConfig(otherTestEnclaveUuid)
+	require.NoError(t, err)
+	enclaveUuids, err = issuer.GetEnclaveUuidsWithState()
+	require.NoError(t, err)
+	require.Equal(t, []string{testEnclaveUuid, otherTestEnclaveUuid}, enclaveUuids)
+
+	require.NoError(t, issuer.ForgetEnclaves([]string{testEnclaveUuid}))
+	enclaveUuids, err = issuer.GetEnclaveUuidsWithState()
+	require.NoError(t, err)
+	require.Equal(t, []string{otherTestEnclaveUuid}, enclaveUuids)
+}
+
+func parseCertificate(t *testing.T, certificatePem string, keyPem string) *x509.Certificate {
+	keyPair, err := tls.X509KeyPair([]byte(certificatePem), []byte(keyPem))
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(keyPair.Certificate[0])
+	require.NoError(t, err)
+	return certificate
+}
+
+// handshake runs a TLS handshake between the API container launched with the TLS config and a client with the
+// certificate, returning the error of the API container
+func handshake(t *testing.T, apiContainerTlsConfig *args.ApiContainerTlsConfig, clientCertificatePem string, clientKeyPem string) error {
+	certificateAuthorityPool, err := api_container_tls.NewCertificatePool(apiContainerTlsConfig.CertificateAuthority)
+	require.NoError(t, err)
+	serverCertificate, err := tls.X509KeyPair([]byte(apiContainerTlsConfig.ServerCertificate), []byte(apiContainerTlsConfig.ServerKey))
+	require.NoError(t, err)
+	clientTlsConfig, err := api_container_tls.NewClientTlsConfig(apiContainerTlsConfig.CertificateAuthority, clientCertificatePem, clientKeyPem, apiContainerTlsConfig.FipsMode)
+	require.NoError(t, err)
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+	server := tls.Server(serverConn, &tls.Config{
+		Certificates: []tls.Certificate{serverCertificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    certificateAuthorityPool,
+	})
+	client := tls.Client(clientConn, clientTlsConfig)
+
+	clientErrChan := make(chan error, 1)
+	go func() {
+		clientErrChan <- client.Handshake()
+		// Unblocks the server if the client gave up
+		clientConn.Close()
+	}()
+	serverErr := server.Handshake()
+	serverConn.Close()
+	clientErr := <-clientErrChan
+	if serverErr != nil {
+		return serverErr
+	}
+	return clientErr
+}
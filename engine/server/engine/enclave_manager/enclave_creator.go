@@ -2,14 +2,25 @@ package enclave_manager
 
 import (
 	"context"
+	"sync"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_certificates"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -24,19 +35,65 @@ const (
 type EnclaveCreator struct {
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
+	artifactsStoreConfig                      artifacts_store.ArtifactsStoreConfig
+	imageCacheConfig                          image_cache.ImageCacheConfig
+	metricsSinkConfig                         metrics_client.SinkConfig
+	secretsProviderConfig                     secrets_provider.SecretsProviderConfig
+	imageVerificationConfig                   image_verification.ImageVerificationConfig
+	airGapConfig                              air_gap.AirGapConfig
+	contentScanningConfig                     content_scanning.ContentScanningConfig
+
+	// Issues the certificates the API containers require their clients to authenticate with
+	certificateIssuer *enclave_certificates.EnclaveCertificateIssuer
+
+	// Guards the enclave quota, which changes when the engine config gets reloaded
+	enclaveQuotaMutex sync.RWMutex
+	enclaveQuota      enclave_quota.EnclaveQuota
 }
 
 func newEnclaveCreator(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	metricsSinkConfig metrics_client.SinkConfig,
+	certificateIssuer *enclave_certificates.EnclaveCertificateIssuer,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
 ) *EnclaveCreator {
 
 	return &EnclaveCreator{
 		kurtosisBackend: kurtosisBackend,
 		apiContainerKurtosisBackendConfigSupplier: apiContainerKurtosisBackendConfigSupplier,
+		artifactsStoreConfig:                      artifactsStoreConfig,
+		imageCacheConfig:                          imageCacheConfig,
+		metricsSinkConfig:                         metricsSinkConfig,
+		secretsProviderConfig:                     secretsProviderConfig,
+		imageVerificationConfig:                   imageVerificationConfig,
+		airGapConfig:                              airGapConfig,
+		contentScanningConfig:                     contentScanningConfig,
+		certificateIssuer:                         certificateIssuer,
+		enclaveQuotaMutex:                         sync.RWMutex{},
+		enclaveQuota:                              enclaveQuota,
 	}
 }
 
+// SetEnclaveQuota changes the quota of the enclaves created from now on; existing enclaves keep theirs
+func (creator *EnclaveCreator) SetEnclaveQuota(enclaveQuota enclave_quota.EnclaveQuota) {
+	creator.enclaveQuotaMutex.Lock()
+	defer creator.enclaveQuotaMutex.Unlock()
+	creator.enclaveQuota = enclaveQuota
+}
+
+func (creator *EnclaveCreator) getEnclaveQuota() enclave_quota.EnclaveQuota {
+	creator.enclaveQuotaMutex.RLock()
+	defer creator.enclaveQuotaMutex.RUnlock()
+	return creator.enclaveQuota
+}
+
 func (creator *EnclaveCreator) CreateEnclave(
 	setupCtx context.Context,
 	// If blank, will use the default
@@ -55,6 +112,13 @@ func (creator *EnclaveCreator) CreateEnclave(
 	shouldAPICRunInDebugMode bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+	// If true, the enclave has no logs collector; the logs of its services are read straight from the container engine
+	shouldSkipLogsCollection bool,
+	// If nil, the services of the enclave can reach any destination
+	egressPolicy *egress_policy.EgressPolicy,
 ) (*types.EnclaveInfo, error) {
 
 	uuid, err := uuid_generator.GenerateUUIDString()
@@ -68,6 +132,7 @@ func (creator *EnclaveCreator) CreateEnclave(
 
 	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName)
 	if err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_CreateEnclave)
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with name `%v` and uuid '%v'", enclaveName, enclaveUuid)
 	}
 	shouldDestroyEnclave := true
@@ -87,10 +152,17 @@ func (creator *EnclaveCreator) CreateEnclave(
 		}
 	}()
 
+	if err := creator.kurtosisBackend.CreateEnclaveQuota(setupCtx, enclaveUuid, creator.getEnclaveQuota()); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the quota of enclave '%v'", enclaveUuid)
+	}
+
 	// only create log collector for backend as
-	shouldDeleteLogsCollector := true
+	shouldDeleteLogsCollector := !shouldSkipLogsCollection
 	// TODO the logs collector has a random private ip address in the enclave network that must be tracked
-	if _, err := creator.kurtosisBackend.CreateLogsCollectorForEnclave(setupCtx, enclaveUuid, defaultHttpLogsCollectorPortNum, defaultTcpLogsCollectorPortNum, logsCollectorFilters, logsCollectorParsers); err != nil {
+	if shouldSkipLogsCollection {
+		logrus.Infof("Enclave '%v' is created without a logs collector", enclaveUuid)
+	} else if _, err := creator.kurtosisBackend.CreateLogsCollectorForEnclave(setupCtx, enclaveUuid, defaultHttpLogsCollectorPortNum, defaultTcpLogsCollectorPortNum, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig); err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_CreateLogsCollector)
 		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector with TCP port number '%v' and HTTP port number '%v'", defaultTcpLogsCollectorPortNum, defaultHttpLogsCollectorPortNum)
 	}
 	defer func() {
@@ -135,6 +207,13 @@ func (creator *EnclaveCreator) CreateEnclave(
 		}
 	}()
 
+	// The rules are removed along with the enclave, so the enclave teardown above covers them as well
+	if egressPolicy != nil {
+		if err := creator.kurtosisBackend.CreateEnclaveEgressPolicy(setupCtx, enclaveUuid, egressPolicy); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred restricting the egress of enclave '%v'", enclaveUuid)
+		}
+	}
+
 	var apiContainerHostMachineInfo *types.EnclaveAPIContainerHostMachineInfo
 	if apiContainer.GetPublicIPAddress() != nil &&
 		apiContainer.GetPublicGRPCPort() != nil {
@@ -205,6 +284,11 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
 ) {
+	// The API container keeps the authority of its enclave when it's launched again, so its clients keep trusting it
+	tlsConfig, err := creator.certificateIssuer.GetApiContainerTlsConfig(string(enclaveUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the TLS config of the API container of enclave '%v'", enclaveUuid)
+	}
 	apiContainerLauncher := api_container_launcher.NewApiContainerLauncher(
 		creator.kurtosisBackend,
 	)
@@ -223,7 +307,16 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 			isCI,
 			cloudUserID,
 			cloudInstanceID,
-			shouldStartInDebugMode)
+			shouldStartInDebugMode,
+			creator.artifactsStoreConfig,
+			creator.imageCacheConfig,
+			creator.getEnclaveQuota(),
+			creator.metricsSinkConfig,
+			tlsConfig,
+			creator.secretsProviderConfig,
+			creator.imageVerificationConfig,
+			creator.airGapConfig,
+			creator.contentScanningConfig)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with custom version '%v', but an error occurred", enclaveUuid, apiContainerImageVersionTag)
 		}
@@ -243,6 +336,15 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		creator.artifactsStoreConfig,
+		creator.imageCacheConfig,
+		creator.getEnclaveQuota(),
+		creator.metricsSinkConfig,
+		tlsConfig,
+		creator.secretsProviderConfig,
+		creator.imageVerificationConfig,
+		creator.airGapConfig,
+		creator.contentScanningConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with the default version, but an error occurred", enclaveUuid)
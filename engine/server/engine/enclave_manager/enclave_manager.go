@@ -13,14 +13,25 @@ import (
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 
 	dockerTypes "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/multi_cluster"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_certificates"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
@@ -37,6 +48,15 @@ const (
 	errorDelimiter = ", "
 
 	enclaveNameNotFound = "Name Not Found"
+
+	// Operations reported in the backend call error metric
+	backendOperation_CreateEnclave        = "create_enclave"
+	backendOperation_CreateLogsCollector  = "create_logs_collector"
+	backendOperation_GetEnclaves          = "get_enclaves"
+	backendOperation_StopEnclaves         = "stop_enclaves"
+	backendOperation_DestroyEnclaves      = "destroy_enclaves"
+	backendOperation_GetApiContainers     = "get_api_containers"
+	backendOperation_DestroyApiContainers = "destroy_api_containers"
 )
 
 // TODO Move this to the KurtosisBackend to calculate!!
@@ -57,6 +77,11 @@ type EnclaveManager struct {
 	//  enclave modifications are atomic
 	mutex *sync.Mutex
 
+	// The enclaves being destroyed, which happens without holding the mutex so that destroying several enclaves, e.g.
+	// with 'kurtosis enclave rm', runs in parallel. The backend already destroys several enclaves at once in parallel
+	// when cleaning, so the destructions of different enclaves don't race with each other. Guarded by the mutex
+	destroyingEnclaveUuids map[enclave.EnclaveUUID]bool
+
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	kurtosisBackendType                       args.KurtosisBackendType
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
@@ -78,8 +103,11 @@ type EnclaveManager struct {
 	cloudUserID                 metrics_client.CloudUserID
 	cloudInstanceID             metrics_client.CloudInstanceID
 
-	logsCollectorFilters []logs_collector.Filter
-	logsCollectorParsers []logs_collector.Parser
+	logsCollectorFilters                  []logs_collector.Filter
+	logsCollectorParsers                  []logs_collector.Parser
+	logsCollectorSystemLogsConfig         logs_collector.SystemLogsConfig
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig
+	logsCollectorBufferConfig             logs_collector.BufferConfig
 }
 
 func CreateEnclaveManager(
@@ -97,26 +125,43 @@ func CreateEnclaveManager(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	metricsSinkConfig metrics_client.SinkConfig,
+	certificateIssuer *enclave_certificates.EnclaveCertificateIssuer,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
 ) (*EnclaveManager, error) {
-	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier)
+	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier, artifactsStoreConfig, imageCacheConfig, enclaveQuota, metricsSinkConfig, certificateIssuer, secretsProviderConfig, imageVerificationConfig, airGapConfig, contentScanningConfig)
 
 	var (
 		err         error
 		enclavePool *EnclavePool
 	)
 
-	// The enclave pool feature is only available for Kubernetes so far
+	// The enclaves of the pool get renamed when they're handed out, which Docker can't do as it can't change the labels of a
+	// network, so Docker only gets the API container image ready
+	if kurtosisBackendType == args.KurtosisBackendType_Docker && poolSize > 0 {
+		go prePullApiContainerImage(kurtosisBackend, engineVersion)
+	}
 	if kurtosisBackendType == args.KurtosisBackendType_Kubernetes {
-		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID, logsCollectorFilters, logsCollectorParsers)
+		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID, logsCollectorFilters, logsCollectorParsers, logsCollectorSystemLogsConfig, logsCollectorKubernetesMetadataConfig, logsCollectorBufferConfig)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating enclave pool with pool-size '%v' and engine version '%v'", poolSize, engineVersion)
 		}
 	}
 
 	enclaveManager := &EnclaveManager{
-		mutex:               &sync.Mutex{},
-		kurtosisBackend:     kurtosisBackend,
-		kurtosisBackendType: kurtosisBackendType,
+		mutex:                  &sync.Mutex{},
+		destroyingEnclaveUuids: map[enclave.EnclaveUUID]bool{},
+		kurtosisBackend:        kurtosisBackend,
+		kurtosisBackendType:    kurtosisBackendType,
 		apiContainerKurtosisBackendConfigSupplier: apiContainerKurtosisBackendConfigSupplier,
 		allExistingAndHistoricalIdentifiers:       []*types.EnclaveIdentifiers{},
 		enclaveCreator:                            enclaveCreator,
@@ -130,6 +175,9 @@ func CreateEnclaveManager(
 		cloudInstanceID:                           cloudInstanceID,
 		logsCollectorFilters:                      logsCollectorFilters,
 		logsCollectorParsers:                      logsCollectorParsers,
+		logsCollectorSystemLogsConfig:             logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig:     logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig:                 logsCollectorBufferConfig,
 	}
 
 	return enclaveManager, nil
@@ -148,6 +196,11 @@ func (manager *EnclaveManager) CreateEnclave(
 	enclaveName string,
 	isProduction bool,
 	shouldAPICRunInDebugMode bool,
+	// If blank, the backend picks the cluster
+	clusterName string,
+	shouldSkipLogsCollection bool,
+	// If nil, the services of the enclave can reach any destination
+	egressPolicy *egress_policy.EgressPolicy,
 ) (*types.EnclaveInfo, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -176,8 +229,17 @@ func (manager *EnclaveManager) CreateEnclave(
 		return nil, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
 	}
 
+	if clusterName != "" {
+		if _, isMultiCluster := manager.kurtosisBackend.(*multi_cluster.MultiClusterKurtosisBackend); !isMultiCluster {
+			return nil, stacktrace.NewError("Enclave '%v' can't be created in cluster '%v' because the engine doesn't span several clusters", enclaveName, clusterName)
+		}
+		setupCtx = multi_cluster.WithTargetCluster(setupCtx, clusterName)
+	}
+
 	// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
-	if !isProduction && manager.enclavePool != nil {
+	// The enclaves of the pool were created up front, so they can't honor a requested cluster, skip logs collection nor
+	// restrict their egress
+	if !isProduction && clusterName == "" && !shouldSkipLogsCollection && egressPolicy == nil && manager.enclavePool != nil {
 		enclaveInfo, err = manager.enclavePool.GetEnclave(
 			setupCtx,
 			enclaveName,
@@ -208,6 +270,11 @@ func (manager *EnclaveManager) CreateEnclave(
 			shouldAPICRunInDebugMode,
 			manager.logsCollectorFilters,
 			manager.logsCollectorParsers,
+			manager.logsCollectorSystemLogsConfig,
+			manager.logsCollectorKubernetesMetadataConfig,
+			manager.logsCollectorBufferConfig,
+			shouldSkipLogsCollection,
+			egressPolicy,
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(
@@ -230,6 +297,15 @@ func (manager *EnclaveManager) CreateEnclave(
 	return enclaveInfo, nil
 }
 
+// GetEnclaveCluster returns the cluster the enclave runs in, if the engine spans several clusters and knows the enclave
+func (manager *EnclaveManager) GetEnclaveCluster(enclaveUuid string) (string, bool) {
+	multiClusterBackend, isMultiCluster := manager.kurtosisBackend.(*multi_cluster.MultiClusterKurtosisBackend)
+	if !isMultiCluster {
+		return "", false
+	}
+	return multiClusterBackend.GetKnownEnclaveCluster(enclave.EnclaveUUID(enclaveUuid))
+}
+
 // It's a liiiitle weird that we return an EnclaveInfo object (which is a Protobuf object), but as of 2021-10-21 this class
 //
 //	is only used by the EngineServerService so we might as well return the object that EngineServerService wants
@@ -262,6 +338,9 @@ func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifie
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
+	if manager.destroyingEnclaveUuids[enclaveUuid] {
+		return stacktrace.NewError("Enclave '%v' is being destroyed", enclaveIdentifier)
+	}
 
 	return manager.stopEnclaveWithoutMutex(ctx, enclaveUuid)
 }
@@ -270,13 +349,15 @@ func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifie
 // TODO remove these notes - this should be working on active enclaves as well
 // Destroys an enclave, deleting all objects associated with it in the container engine (containers, volumes, networks, etc.)
 func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
-
-	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	enclaveUuid, err := manager.startDestroyingEnclave(ctx, enclaveIdentifier)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+		return stacktrace.Propagate(err, "An error occurred starting to destroy enclave '%v'", enclaveIdentifier)
 	}
+	defer func() {
+		manager.mutex.Lock()
+		defer manager.mutex.Unlock()
+		delete(manager.destroyingEnclaveUuids, enclaveUuid)
+	}()
 
 	enclaveDestroyFilter := &enclave.EnclaveFilters{
 		UUIDs: map[enclave.EnclaveUUID]bool{
@@ -286,6 +367,7 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 	}
 	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, enclaveDestroyFilter)
 	if err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_DestroyEnclaves)
 		return stacktrace.Propagate(err, "An error occurred destroying the enclave")
 	}
 	if _, found := successfullyDestroyedEnclaves[enclaveUuid]; found {
@@ -301,7 +383,9 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 	return destructionErr
 }
 
-func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) ([]*types.EnclaveNameAndUuid, error) {
+// Clean removes the stopped enclaves, or all of them if shouldCleanAll is set, among the ones shouldCleanEnclave returns
+// true for
+func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool, shouldCleanEnclave func(enclave.EnclaveUUID) bool) ([]*types.EnclaveNameAndUuid, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 	// TODO: Refactor with kurtosis backend
@@ -315,7 +399,14 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 
 	enclaveUUIDsToClean := map[enclave.EnclaveUUID]bool{}
 	for enclaveUUID := range enclavesForUuidNameMapping {
-		enclaveUUIDsToClean[enclaveUUID] = true
+		// The enclaves being destroyed are already on their way out
+		if shouldCleanEnclave(enclaveUUID) && !manager.destroyingEnclaveUuids[enclaveUUID] {
+			enclaveUUIDsToClean[enclaveUUID] = true
+		}
+	}
+	// An empty UUIDs filter would match every enclave in the backend
+	if len(enclaveUUIDsToClean) == 0 {
+		return resultEnclaveNameAndUuids, nil
 	}
 
 	successfullyRemovedEnclaveUuidStrs, removalErrors, err := manager.cleanEnclaves(ctx, enclaveUUIDsToClean, shouldCleanAll)
@@ -364,6 +455,12 @@ func (manager *EnclaveManager) GetEnclaveUuidForEnclaveIdentifier(ctx context.Co
 	return manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 }
 
+// SetEnclaveQuota changes the quota of the enclaves created from now on, pooled enclaves included; existing enclaves
+// keep theirs
+func (manager *EnclaveManager) SetEnclaveQuota(enclaveQuota enclave_quota.EnclaveQuota) {
+	manager.enclaveCreator.SetEnclaveQuota(enclaveQuota)
+}
+
 func (manager *EnclaveManager) GetExistingAndHistoricalEnclaveIdentifiers() ([]*types.EnclaveIdentifiers, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -412,6 +509,7 @@ func (manager *EnclaveManager) RestartAllEnclaveAPIContainers(ctx context.Contex
 	}
 	allAPIContainersRunning, err := manager.kurtosisBackend.GetAPIContainers(ctx, getAPIContainersRunningFilters)
 	if err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_GetApiContainers)
 		return stacktrace.Propagate(err, "An error occurred getting API containers using filters '%+v'", getAPIContainersRunningFilters)
 	}
 
@@ -468,6 +566,7 @@ func (manager *EnclaveManager) destroyApiContainers(ctx context.Context, enclave
 
 	_, erroredApiContainerIds, err := manager.kurtosisBackend.DestroyAPIContainers(ctx, destroyAPIContainersFilters)
 	if err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_DestroyApiContainers)
 		return stacktrace.Propagate(err, "An error occurred")
 	}
 
@@ -503,6 +602,7 @@ func getEnclaveApiContainerInformation(
 	apiContainerByEnclaveIdFilter := getApiContainerByEnclaveIdFilter(enclaveId)
 	enclaveApiContainers, err := kurtosisBackend.GetAPIContainers(ctx, apiContainerByEnclaveIdFilter)
 	if err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_GetApiContainers)
 		return types.ContainerStatus_NONEXISTENT, nil, nil, stacktrace.Propagate(err, "An error occurred getting the containers for enclave '%v'", enclaveId)
 	}
 	numOfFoundApiContainers := len(enclaveApiContainers)
@@ -557,6 +657,7 @@ func getEnclaveApiContainerInformation(
 func (manager *EnclaveManager) stopEnclaveWithoutMutex(ctx context.Context, enclaveId enclave.EnclaveUUID) error {
 	_, enclaveStopErrs, err := manager.kurtosisBackend.StopEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveId))
 	if err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_StopEnclaves)
 		return stacktrace.Propagate(err, "Attempted to stop enclave '%v' but the backend threw an error", enclaveId)
 	}
 	// Handle any err thrown by the backend
@@ -601,6 +702,7 @@ func (manager *EnclaveManager) cleanEnclaves(
 	}
 	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, destroyEnclaveFilters)
 	if err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_DestroyEnclaves)
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying enclaves during cleaning")
 	}
 
@@ -622,11 +724,28 @@ func (manager *EnclaveManager) cleanEnclaves(
 	return successfullyDestroyedEnclaveIdStrs, enclaveDestructionErrors, nil
 }
 
+// startDestroyingEnclave marks the enclave as being destroyed, so that no one else destroys or stops it meanwhile
+func (manager *EnclaveManager) startDestroyingEnclave(ctx context.Context, enclaveIdentifier string) (enclave.EnclaveUUID, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+	if manager.destroyingEnclaveUuids[enclaveUuid] {
+		return "", stacktrace.NewError("Enclave '%v' is already being destroyed", enclaveIdentifier)
+	}
+	manager.destroyingEnclaveUuids[enclaveUuid] = true
+	return enclaveUuid, nil
+}
+
 func (manager *EnclaveManager) getEnclavesWithoutMutex(
 	ctx context.Context,
 ) (map[enclave.EnclaveUUID]*types.EnclaveInfo, error) {
 	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
 	if err != nil {
+		engine_metrics.CountBackendCallError(backendOperation_GetEnclaves)
 		return nil, stacktrace.Propagate(err, "Error thrown retrieving enclaves")
 	}
 
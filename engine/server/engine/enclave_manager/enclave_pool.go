@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -25,23 +29,33 @@ const (
 	createTestEnclave = false
 
 	defaultApicDebugModeForEnclavesInThePool = false
+
+	// The enclaves of the pool collect the logs of their services, like the enclaves created on demand by default
+	shouldSkipLogsCollectionForEnclavesInThePool = false
 )
 
 type EnclavePool struct {
-	kurtosisBackend             backend_interface.KurtosisBackend
-	enclaveCreator              *EnclaveCreator
-	idleEnclavesChan            chan *types.EnclaveInfo
-	fillChan                    chan bool
-	engineVersion               string
-	cancelSubRoutineCtxFunc     context.CancelFunc
-	enclaveEnvVars              string
-	metricsUserID               string
-	didUserAcceptSendingMetrics bool
-	isCI                        bool
-	cloudUserID                 metrics_client.CloudUserID
-	cloudInstanceID             metrics_client.CloudInstanceID
-	logsCollectorFilters        []logs_collector.Filter
-	logsCollectorParsers        []logs_collector.Parser
+	kurtosisBackend                       backend_interface.KurtosisBackend
+	enclaveCreator                        *EnclaveCreator
+	idleEnclavesChan                      chan *types.EnclaveInfo
+	fillChan                              chan bool
+	engineVersion                         string
+	cancelSubRoutineCtxFunc               context.CancelFunc
+	enclaveEnvVars                        string
+	metricsUserID                         string
+	didUserAcceptSendingMetrics           bool
+	isCI                                  bool
+	cloudUserID                           metrics_client.CloudUserID
+	cloudInstanceID                       metrics_client.CloudInstanceID
+	logsCollectorFilters                  []logs_collector.Filter
+	logsCollectorParsers                  []logs_collector.Parser
+	logsCollectorSystemLogsConfig         logs_collector.SystemLogsConfig
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig
+	logsCollectorBufferConfig             logs_collector.BufferConfig
+
+	// Tracks the idle enclaves being created, which happens concurrently so that the pool fills up again quickly after
+	// a burst of enclave creations
+	fillingWaitGroup *sync.WaitGroup
 }
 
 // CreateEnclavePool will do the following:
@@ -62,6 +76,9 @@ func CreateEnclavePool(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
 ) (*EnclavePool, error) {
 
 	//TODO the current implementation only removes the previous idle enclave, it's pending to implement the reusable feature
@@ -97,20 +114,24 @@ func CreateEnclavePool(
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(context.Background())
 
 	enclavePool := &EnclavePool{
-		kurtosisBackend:             kurtosisBackend,
-		enclaveCreator:              enclaveCreator,
-		idleEnclavesChan:            idleEnclavesChan,
-		fillChan:                    fillChan,
-		engineVersion:               engineVersion,
-		cancelSubRoutineCtxFunc:     cancelCtxFunc,
-		enclaveEnvVars:              enclaveEnvVars,
-		metricsUserID:               metricsUserID,
-		didUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
-		isCI:                        isCI,
-		cloudUserID:                 cloudUserID,
-		cloudInstanceID:             cloudInstanceID,
-		logsCollectorFilters:        logsCollectorFilters,
-		logsCollectorParsers:        logsCollectorParsers,
+		kurtosisBackend:                       kurtosisBackend,
+		enclaveCreator:                        enclaveCreator,
+		idleEnclavesChan:                      idleEnclavesChan,
+		fillChan:                              fillChan,
+		engineVersion:                         engineVersion,
+		cancelSubRoutineCtxFunc:               cancelCtxFunc,
+		enclaveEnvVars:                        enclaveEnvVars,
+		metricsUserID:                         metricsUserID,
+		didUserAcceptSendingMetrics:           didUserAcceptSendingMetrics,
+		isCI:                                  isCI,
+		cloudUserID:                           cloudUserID,
+		cloudInstanceID:                       cloudInstanceID,
+		logsCollectorFilters:                  logsCollectorFilters,
+		logsCollectorParsers:                  logsCollectorParsers,
+		logsCollectorSystemLogsConfig:         logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig: logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig:             logsCollectorBufferConfig,
+		fillingWaitGroup:                      &sync.WaitGroup{},
 	}
 
 	go enclavePool.run(ctxWithCancel)
@@ -214,6 +235,8 @@ func (pool *EnclavePool) Close() error {
 
 	// will terminate running processes in the subroutine
 	pool.cancelSubRoutineCtxFunc()
+	// the idle enclaves being created are added to the pool channel, which can't be closed until they're done
+	pool.fillingWaitGroup.Wait()
 
 	// destroy all the idle enclaves
 	if err := destroyIdleEnclaves(pool.kurtosisBackend); err != nil {
@@ -243,14 +266,17 @@ func (pool *EnclavePool) run(ctx context.Context) {
 		// wait until receive the re-fill signal or the ctx has done signal
 		select {
 		case <-pool.fillChan:
-			if err := pool.createAndAddOneIdleEnclaveIfNeeded(ctx); err != nil {
-				if err == context.Canceled {
-					logrus.Debug("The subroutine context has been canceled")
-				} else {
-					logrus.Errorf("An error occurred filling the enclave pool. Error\n%v", err)
+			pool.fillingWaitGroup.Add(1)
+			go func() {
+				defer pool.fillingWaitGroup.Done()
+				if err := pool.createAndAddOneIdleEnclaveIfNeeded(ctx); err != nil {
+					if err == context.Canceled {
+						logrus.Debug("The subroutine context has been canceled")
+					} else {
+						logrus.Errorf("An error occurred filling the enclave pool. Error\n%v", err)
+					}
 				}
-				break
-			}
+			}()
 		case <-ctx.Done():
 			logrus.Debug("The subroutine context has done")
 			logrus.Debug("Enclave pool sub-routine stopped")
@@ -303,6 +329,11 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.Encla
 		defaultApicDebugModeForEnclavesInThePool,
 		pool.logsCollectorFilters,
 		pool.logsCollectorParsers,
+		pool.logsCollectorSystemLogsConfig,
+		pool.logsCollectorKubernetesMetadataConfig,
+		pool.logsCollectorBufferConfig,
+		shouldSkipLogsCollectionForEnclavesInThePool,
+		nil, // The enclaves of the pool can reach any destination; the ones restricting their egress are never taken from it
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -407,6 +438,23 @@ func destroyIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend) erro
 
 const destroyEnclaveMaxRetries = 5
 
+var (
+	// The API container image is public
+	noImageRegistrySpec *image_registry_spec.ImageRegistrySpec = nil
+)
+
+// prePullApiContainerImage pulls the image of the API containers the engine creates by default, so that the first
+// enclave created doesn't wait for it
+func prePullApiContainerImage(kurtosisBackend backend_interface.KurtosisBackend, engineVersion string) {
+	apiContainerImage := api_container_launcher.GetApiContainerImage(engineVersion)
+	logrus.Debugf("Pre-pulling API container image '%v'...", apiContainerImage)
+	if _, _, err := kurtosisBackend.FetchImage(context.Background(), apiContainerImage, noImageRegistrySpec, image_download_mode.ImageDownloadMode_Missing); err != nil {
+		logrus.Warnf("An error occurred pre-pulling API container image '%v'; it will be pulled when the next enclave is created:\n%v", apiContainerImage, err)
+		return
+	}
+	logrus.Debugf("Pre-pulled API container image '%v'", apiContainerImage)
+}
+
 // destroyIdleEnclavesFromPreviousRuns destroy idle enclaves created before the beforeTime with a retry strategy
 // We have seen the "context deadline exceeded" from Kubernetes in the past, and this usually happens
 // because the Kubernetes has just started, and it is a bit slow to retrieve the information and throws that error
@@ -0,0 +1,137 @@
+package enclave_reaper
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Also the name of the file the engines that ran before the state store stored the expiration times in
+	enclaveExpirationStateKey = "enclave-expirations.json"
+)
+
+// enclaveExpirationStore keeps when each enclave with a TTL expires as JSON in the engine state store, so that
+// enclaves still expire after engine restarts and whichever engine replica leads reaps them
+type enclaveExpirationStore struct {
+	stateStore state_store.StateStore
+
+	// Listing the enclaves gets the expiration time of every one of them
+	decodedExpirationTimeByEnclaveUuid *state_store.DecodedValueCache[map[string]time.Time]
+}
+
+func newEnclaveExpirationStore(stateStore state_store.StateStore) *enclaveExpirationStore {
+	return &enclaveExpirationStore{
+		stateStore:                         stateStore,
+		decodedExpirationTimeByEnclaveUuid: state_store.NewDecodedValueCache(deserializeExpirationTimeByEnclaveUuid),
+	}
+}
+
+// get returns when the enclave expires, or nil if it has no TTL
+func (store *enclaveExpirationStore) get(enclaveUuid string) (*time.Time, error) {
+	expirationTimeByEnclaveUuid, err := store.load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave expiration store")
+	}
+	expirationTime, found := expirationTimeByEnclaveUuid[enclaveUuid]
+	if !found {
+		return nil, nil
+	}
+	return &expirationTime, nil
+}
+
+// getExpired returns the UUIDs of the enclaves that expired at the given time
+func (store *enclaveExpirationStore) getExpired(now time.Time) ([]string, error) {
+	expirationTimeByEnclaveUuid, err := store.load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave expiration store")
+	}
+	expiredEnclaveUuids := []string{}
+	for enclaveUuid, expirationTime := range expirationTimeByEnclaveUuid {
+		if !expirationTime.After(now) {
+			expiredEnclaveUuids = append(expiredEnclaveUuids, enclaveUuid)
+		}
+	}
+	return expiredEnclaveUuids, nil
+}
+
+func (store *enclaveExpirationStore) set(enclaveUuid string, expirationTime time.Time) error {
+	err := store.update(func(expirationTimeByEnclaveUuid map[string]time.Time) {
+		expirationTimeByEnclaveUuid[enclaveUuid] = expirationTime
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the expiration time of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (store *enclaveExpirationStore) remove(enclaveUuids ...string) error {
+	err := store.update(func(expirationTimeByEnclaveUuid map[string]time.Time) {
+		for _, enclaveUuid := range enclaveUuids {
+			delete(expirationTimeByEnclaveUuid, enclaveUuid)
+		}
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the removal of the expiration time of enclaves '%v'", enclaveUuids)
+	}
+	return nil
+}
+
+// getEnclaveUuids returns the UUIDs of the enclaves with an expiration time
+func (store *enclaveExpirationStore) getEnclaveUuids() ([]string, error) {
+	expirationTimeByEnclaveUuid, err := store.load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave expiration store")
+	}
+	enclaveUuids := []string{}
+	for enclaveUuid := range expirationTimeByEnclaveUuid {
+		enclaveUuids = append(enclaveUuids, enclaveUuid)
+	}
+	return enclaveUuids, nil
+}
+
+// load returns the expiration time of every enclave, which is shared with the other callers so it must not be modified
+func (store *enclaveExpirationStore) load() (map[string]time.Time, error) {
+	serializedExpirationTimeByEnclaveUuid, err := store.stateStore.Load(enclaveExpirationStateKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", enclaveExpirationStateKey)
+	}
+	expirationTimeByEnclaveUuid, err := store.decodedExpirationTimeByEnclaveUuid.Decode(serializedExpirationTimeByEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave expiration store")
+	}
+	return expirationTimeByEnclaveUuid, nil
+}
+
+// update applies the change to the latest expiration time of every enclave
+func (store *enclaveExpirationStore) update(changeFunc func(expirationTimeByEnclaveUuid map[string]time.Time)) error {
+	err := store.stateStore.Update(enclaveExpirationStateKey, func(serializedExpirationTimeByEnclaveUuid []byte) ([]byte, error) {
+		expirationTimeByEnclaveUuid, err := deserializeExpirationTimeByEnclaveUuid(serializedExpirationTimeByEnclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave expiration store")
+		}
+		changeFunc(expirationTimeByEnclaveUuid)
+		updatedSerializedExpirationTimeByEnclaveUuid, err := json.Marshal(expirationTimeByEnclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing the enclave expiration store")
+		}
+		return updatedSerializedExpirationTimeByEnclaveUuid, nil
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the enclave expiration store")
+	}
+	return nil
+}
+
+func deserializeExpirationTimeByEnclaveUuid(serializedExpirationTimeByEnclaveUuid []byte) (map[string]time.Time, error) {
+	expirationTimeByEnclaveUuid := map[string]time.Time{}
+	if serializedExpirationTimeByEnclaveUuid == nil {
+		return expirationTimeByEnclaveUuid, nil
+	}
+	if err := json.Unmarshal(serializedExpirationTimeByEnclaveUuid, &expirationTimeByEnclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the expiration times of the enclaves")
+	}
+	return expirationTimeByEnclaveUuid, nil
+}
@@ -0,0 +1,162 @@
+package enclave_reaper
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	reapingInterval = time.Minute
+)
+
+// enclaveDestroyer is the part of the enclave manager the reaper uses
+type enclaveDestroyer interface {
+	GetEnclaves(ctx context.Context) (map[string]*types.EnclaveInfo, error)
+	DestroyEnclave(ctx context.Context, enclaveIdentifier string) error
+}
+
+// EnclaveReaper destroys the enclaves whose TTL is over, so that forgotten enclaves don't pile up on shared clusters
+type EnclaveReaper struct {
+	// TTL given to the enclaves created without one; args.NoEnclaveTtl if they don't expire. Atomic as it changes
+	// when the engine config gets reloaded
+	defaultTtl atomic.Int64
+
+	store *enclaveExpirationStore
+
+	enclaveManager enclaveDestroyer
+
+	metricsClient metrics_client.MetricsClient
+}
+
+func NewEnclaveReaper(
+	stateStore state_store.StateStore,
+	defaultTtl time.Duration,
+	enclaveManager enclaveDestroyer,
+	metricsClient metrics_client.MetricsClient,
+) *EnclaveReaper {
+	reaper := &EnclaveReaper{ // nolint:exhaustruct
+		store:          newEnclaveExpirationStore(stateStore),
+		enclaveManager: enclaveManager,
+		metricsClient:  metricsClient,
+	}
+	reaper.SetDefaultTtl(defaultTtl)
+	return reaper
+}
+
+// SetDefaultTtl changes the TTL given to the enclaves created without one from now on; the existing enclaves keep
+// their expiration time
+func (reaper *EnclaveReaper) SetDefaultTtl(defaultTtl time.Duration) {
+	reaper.defaultTtl.Store(int64(defaultTtl))
+}
+
+// SetTtl makes the enclave expire once the TTL, a duration string like '4h', is over. An empty TTL means the default
+// one, and nothing happens if there's none
+func (reaper *EnclaveReaper) SetTtl(enclaveUuid string, ttlStr string) error {
+	ttl, err := args.ParseEnclaveTtl(ttlStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the TTL of enclave '%v'", enclaveUuid)
+	}
+	if ttl == args.NoEnclaveTtl {
+		ttl = time.Duration(reaper.defaultTtl.Load())
+	}
+	if ttl == args.NoEnclaveTtl {
+		return nil
+	}
+	expirationTime := time.Now().Add(ttl)
+	if err = reaper.store.set(enclaveUuid, expirationTime); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the expiration time of enclave '%v' to '%v'", enclaveUuid, expirationTime)
+	}
+	logrus.Infof("Enclave '%v' will expire at '%v'", enclaveUuid, expirationTime.Format(time.RFC3339))
+	return nil
+}
+
+// GetExpirationTime returns when the enclave will be destroyed, or nil if it has no TTL
+func (reaper *EnclaveReaper) GetExpirationTime(enclaveUuid string) *time.Time {
+	expirationTime, err := reaper.store.get(enclaveUuid)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the expiration time of enclave '%v'; it will be reported as not expiring:\n%v", enclaveUuid, err)
+		return nil
+	}
+	return expirationTime
+}
+
+// GetEnclaveUuidsWithState returns the UUIDs of the enclaves whose expiration time is kept in the state store
+func (reaper *EnclaveReaper) GetEnclaveUuidsWithState() ([]string, error) {
+	enclaveUuids, err := reaper.store.getEnclaveUuids()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves with an expiration time")
+	}
+	return enclaveUuids, nil
+}
+
+// ForgetEnclaves removes the expiration time of the enclaves from the state store, once they're gone for good
+func (reaper *EnclaveReaper) ForgetEnclaves(enclaveUuids []string) error {
+	if err := reaper.store.remove(enclaveUuids...); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the expiration time of enclaves '%v'", enclaveUuids)
+	}
+	return nil
+}
+
+// StartEnclaveReaping destroys the expired enclaves now and then periodically, in the background
+func (reaper *EnclaveReaper) StartEnclaveReaping(ctx context.Context) {
+	go func() {
+		logrus.Debugf("Scheduling the reaping of expired enclaves every '%v'...", reapingInterval)
+		reaper.ReapExpiredEnclaves(ctx)
+
+		reapingTicker := time.NewTicker(reapingInterval)
+		defer reapingTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-reapingTicker.C:
+				reaper.ReapExpiredEnclaves(ctx)
+			}
+		}
+	}()
+}
+
+// ReapExpiredEnclaves destroys the enclaves whose TTL is over. Failures are logged and retried on the next run
+func (reaper *EnclaveReaper) ReapExpiredEnclaves(ctx context.Context) {
+	expiredEnclaveUuids, err := reaper.store.getExpired(time.Now())
+	if err != nil {
+		logrus.Errorf("An error occurred getting the expired enclaves; will retry in '%v':\n%v", reapingInterval, err)
+		return
+	}
+	if len(expiredEnclaveUuids) == 0 {
+		return
+	}
+	existingEnclaves, err := reaper.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		logrus.Errorf("An error occurred getting the enclaves to reap the expired ones; will retry in '%v':\n%v", reapingInterval, err)
+		return
+	}
+	for _, enclaveUuid := range expiredEnclaveUuids {
+		enclaveInfo, found := existingEnclaves[enclaveUuid]
+		if found {
+			if err = reaper.enclaveManager.DestroyEnclave(ctx, enclaveUuid); err != nil {
+				logrus.Errorf("An error occurred destroying expired enclave '%v'; will retry in '%v':\n%v", enclaveUuid, reapingInterval, err)
+				continue
+			}
+			logrus.WithFields(logrus.Fields{
+				"enclave_uuid": enclaveUuid,
+				"enclave_name": enclaveInfo.Name,
+			}).Info("Destroyed expired enclave")
+			if err = reaper.metricsClient.TrackExpireEnclave(enclaveUuid); err != nil {
+				logrus.Warnf("An error occurred while logging the expire enclave event for enclave '%v'", enclaveUuid)
+			}
+		}
+		// Enclaves removed before expiring are forgotten too
+		if err = reaper.store.remove(enclaveUuid); err != nil {
+			logrus.Errorf("An error occurred forgetting the expiration time of enclave '%v':\n%v", enclaveUuid, err)
+		}
+	}
+}
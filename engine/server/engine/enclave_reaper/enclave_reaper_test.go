@@ -0,0 +1,97 @@
+package enclave_reaper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	expiredEnclaveUuid   = "expired-enclave-uuid"
+	liveEnclaveUuid      = "live-enclave-uuid"
+	forgottenEnclaveUuid = "forgotten-enclave-uuid"
+)
+
+type fakeEnclaveManager struct {
+	enclaves map[string]*types.EnclaveInfo
+
+	destroyedEnclaveIdentifiers []string
+}
+
+func (manager *fakeEnclaveManager) GetEnclaves(_ context.Context) (map[string]*types.EnclaveInfo, error) {
+	return manager.enclaves, nil
+}
+
+func (manager *fakeEnclaveManager) DestroyEnclave(_ context.Context, enclaveIdentifier string) error {
+	manager.destroyedEnclaveIdentifiers = append(manager.destroyedEnclaveIdentifiers, enclaveIdentifier)
+	delete(manager.enclaves, enclaveIdentifier)
+	return nil
+}
+
+// fakeMetricsClient only implements the call the reaper makes
+type fakeMetricsClient struct {
+	metrics_client.MetricsClient
+
+	expiredEnclaveIds []string
+}
+
+func (client *fakeMetricsClient) TrackExpireEnclave(enclaveId string) error {
+	client.expiredEnclaveIds = append(client.expiredEnclaveIds, enclaveId)
+	return nil
+}
+
+func TestReapExpiredEnclaves(t *testing.T) {
+	enclaveManager := &fakeEnclaveManager{
+		enclaves: map[string]*types.EnclaveInfo{
+			expiredEnclaveUuid: {EnclaveUuid: expiredEnclaveUuid, Name: "expired"},
+			liveEnclaveUuid:    {EnclaveUuid: liveEnclaveUuid, Name: "live"},
+		},
+		destroyedEnclaveIdentifiers: nil,
+	}
+	metricsClient := &fakeMetricsClient{MetricsClient: nil, expiredEnclaveIds: nil}
+	storeDirpath := t.TempDir()
+	reaper := NewEnclaveReaper(state_store.NewFileStateStore(storeDirpath), args.NoEnclaveTtl, enclaveManager, metricsClient)
+
+	require.NoError(t, reaper.store.set(expiredEnclaveUuid, time.Now().Add(-time.Minute)))
+	require.NoError(t, reaper.store.set(forgottenEnclaveUuid, time.Now().Add(-time.Minute)))
+	require.NoError(t, reaper.SetTtl(liveEnclaveUuid, "1h"))
+
+	reaper.ReapExpiredEnclaves(context.Background())
+
+	require.Equal(t, []string{expiredEnclaveUuid}, enclaveManager.destroyedEnclaveIdentifiers)
+	require.Equal(t, []string{expiredEnclaveUuid}, metricsClient.expiredEnclaveIds)
+	require.Nil(t, reaper.GetExpirationTime(expiredEnclaveUuid))
+	require.Nil(t, reaper.GetExpirationTime(forgottenEnclaveUuid))
+	require.NotNil(t, reaper.GetExpirationTime(liveEnclaveUuid))
+
+	// Expiration times survive engine restarts
+	reloadedReaper := NewEnclaveReaper(state_store.NewFileStateStore(storeDirpath), args.NoEnclaveTtl, enclaveManager, metricsClient)
+	require.Equal(t, reaper.GetExpirationTime(liveEnclaveUuid).Unix(), reloadedReaper.GetExpirationTime(liveEnclaveUuid).Unix())
+}
+
+func TestSetTtl(t *testing.T) {
+	enclaveManager := &fakeEnclaveManager{enclaves: map[string]*types.EnclaveInfo{}, destroyedEnclaveIdentifiers: nil}
+	reaper := NewEnclaveReaper(state_store.NewFileStateStore(t.TempDir()), 4*time.Hour, enclaveManager, nil)
+
+	require.NoError(t, reaper.SetTtl(liveEnclaveUuid, ""))
+	expirationTime := reaper.GetExpirationTime(liveEnclaveUuid)
+	require.NotNil(t, expirationTime)
+	require.WithinDuration(t, time.Now().Add(4*time.Hour), *expirationTime, time.Minute)
+
+	require.NoError(t, reaper.SetTtl(expiredEnclaveUuid, "30m"))
+	require.WithinDuration(t, time.Now().Add(30*time.Minute), *reaper.GetExpirationTime(expiredEnclaveUuid), time.Minute)
+
+	require.Error(t, reaper.SetTtl(forgottenEnclaveUuid, "soon"))
+	require.Error(t, reaper.SetTtl(forgottenEnclaveUuid, "-1h"))
+	require.Nil(t, reaper.GetExpirationTime(forgottenEnclaveUuid))
+
+	reaperWithoutDefault := NewEnclaveReaper(state_store.NewFileStateStore(t.TempDir()), args.NoEnclaveTtl, enclaveManager, nil)
+	require.NoError(t, reaperWithoutDefault.SetTtl(liveEnclaveUuid, ""))
+	require.Nil(t, reaperWithoutDefault.GetExpirationTime(liveEnclaveUuid))
+}
@@ -0,0 +1,249 @@
+package engine_health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	SubsystemName_Backend        = "backend"
+	SubsystemName_LogsAggregator = "logs-aggregator"
+	SubsystemName_LogsCollector  = "logs-collector"
+	SubsystemName_StateStore     = "state-store"
+	SubsystemName_ArtifactsStore = "artifacts-store"
+
+	// A subsystem that doesn't answer by then is as good as down for the users
+	checkTimeout = 10 * time.Second
+
+	// Loading a key proves that the state store can be read; the key is never written, so loading it is cheap
+	stateStoreHealthCheckKey = "health-check"
+
+	// The AWS SDK uses it when the artifacts store config has no region
+	defaultS3Region      = "us-east-1"
+	s3EndpointFormat     = "https://s3.%s.amazonaws.com"
+	artifactsStoreMethod = http.MethodHead
+)
+
+type HealthStatus int
+
+// Ordered from the best to the worst, so that the overall status is the greatest one
+const (
+	HealthStatus_Healthy HealthStatus = iota
+	HealthStatus_Degraded
+	HealthStatus_Unhealthy
+)
+
+// SubsystemHealth is the health of a subsystem the engine relies on, or of a part of it
+type SubsystemHealth struct {
+	Name string
+
+	// E.g. the node or the enclave of a logs collector; empty if the subsystem has a single part
+	Instance string
+
+	Status HealthStatus
+
+	// What's wrong with the subsystem, or how it's set up if it's healthy
+	Message string
+}
+
+// healthCheckedBackend is the part of the Kurtosis backend the checker uses
+type healthCheckedBackend interface {
+	GetEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]*engine.Engine, error)
+	GetLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error)
+	GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error)
+}
+
+// EngineHealthChecker checks the subsystems the engine relies on, so that e.g. logs that stopped being collected are
+// noticed before someone needs them
+type EngineHealthChecker struct {
+	kurtosisBackend healthCheckedBackend
+
+	// The name of the backend, for the messages
+	kurtosisBackendName string
+
+	stateStore state_store.StateStore
+
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig
+
+	httpClient *http.Client
+}
+
+func NewEngineHealthChecker(
+	kurtosisBackend healthCheckedBackend,
+	kurtosisBackendName string,
+	stateStore state_store.StateStore,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+) *EngineHealthChecker {
+	return &EngineHealthChecker{
+		kurtosisBackend:      kurtosisBackend,
+		kurtosisBackendName:  kurtosisBackendName,
+		stateStore:           stateStore,
+		artifactsStoreConfig: artifactsStoreConfig,
+		httpClient:           &http.Client{Timeout: checkTimeout}, //nolint:exhaustruct
+	}
+}
+
+// CheckHealth checks all the subsystems concurrently, and returns their health sorted by name and instance
+func (checker *EngineHealthChecker) CheckHealth(ctx context.Context) []*SubsystemHealth {
+	checkCtx, cancelCheckCtx := context.WithTimeout(ctx, checkTimeout)
+	defer cancelCheckCtx()
+
+	checks := []func(ctx context.Context) []*SubsystemHealth{
+		checker.checkBackend,
+		checker.checkLogsAggregator,
+		checker.checkLogsCollectors,
+		checker.checkStateStore,
+		checker.checkArtifactsStore,
+	}
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	result := []*SubsystemHealth{}
+	for _, check := range checks {
+		waitGroup.Add(1)
+		go func(check func(ctx context.Context) []*SubsystemHealth) {
+			defer waitGroup.Done()
+			subsystemsHealth := check(checkCtx)
+			mutex.Lock()
+			defer mutex.Unlock()
+			result = append(result, subsystemsHealth...)
+		}(check)
+	}
+	waitGroup.Wait()
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Instance < result[j].Instance
+	})
+	return result
+}
+
+// GetOverallStatus returns the worst status of the subsystems
+func GetOverallStatus(subsystemsHealth []*SubsystemHealth) HealthStatus {
+	overallStatus := HealthStatus_Healthy
+	for _, subsystemHealth := range subsystemsHealth {
+		if subsystemHealth.Status > overallStatus {
+			overallStatus = subsystemHealth.Status
+		}
+	}
+	return overallStatus
+}
+
+func (checker *EngineHealthChecker) checkBackend(ctx context.Context) []*SubsystemHealth {
+	engines, err := checker.kurtosisBackend.GetEngines(ctx, &engine.EngineFilters{GUIDs: nil, Statuses: nil})
+	if err != nil {
+		return []*SubsystemHealth{newSubsystemHealth(SubsystemName_Backend, "", HealthStatus_Unhealthy, "The %v backend can't be reached: %v", checker.kurtosisBackendName, stacktrace.RootCause(err))}
+	}
+	return []*SubsystemHealth{newSubsystemHealth(SubsystemName_Backend, "", HealthStatus_Healthy, "The %v backend is reachable and runs %d engine(s)", checker.kurtosisBackendName, len(engines))}
+}
+
+func (checker *EngineHealthChecker) checkLogsAggregator(ctx context.Context) []*SubsystemHealth {
+	maybeLogsAggregator, err := checker.kurtosisBackend.GetLogsAggregator(ctx)
+	if err != nil {
+		return []*SubsystemHealth{newSubsystemHealth(SubsystemName_LogsAggregator, "", HealthStatus_Unhealthy, "An error occurred getting the logs aggregator: %v", stacktrace.RootCause(err))}
+	}
+	if maybeLogsAggregator == nil {
+		return []*SubsystemHealth{newSubsystemHealth(SubsystemName_LogsAggregator, "", HealthStatus_Unhealthy, "No logs aggregator exists, so no service logs are stored")}
+	}
+	if maybeLogsAggregator.GetStatus() != container.ContainerStatus_Running {
+		return []*SubsystemHealth{newSubsystemHealth(SubsystemName_LogsAggregator, "", HealthStatus_Unhealthy, "The logs aggregator is %v, so no service logs are stored", maybeLogsAggregator.GetStatus())}
+	}
+	return []*SubsystemHealth{newSubsystemHealth(SubsystemName_LogsAggregator, "", HealthStatus_Healthy, "The logs aggregator is running")}
+}
+
+// checkLogsCollectors reports each logs collector on its own, as a stopped one only loses the logs of its node or
+// enclave
+func (checker *EngineHealthChecker) checkLogsCollectors(ctx context.Context) []*SubsystemHealth {
+	logsCollectorStatuses, err := checker.kurtosisBackend.GetLogsCollectorStatuses(ctx)
+	if err != nil {
+		return []*SubsystemHealth{newSubsystemHealth(SubsystemName_LogsCollector, "", HealthStatus_Degraded, "An error occurred getting the statuses of the logs collectors: %v", stacktrace.RootCause(err))}
+	}
+	result := []*SubsystemHealth{}
+	for instance, status := range logsCollectorStatuses {
+		if status != container.ContainerStatus_Running {
+			result = append(result, newSubsystemHealth(SubsystemName_LogsCollector, instance, HealthStatus_Degraded, "The logs collector is %v, so the logs of its services are missing", status))
+			continue
+		}
+		result = append(result, newSubsystemHealth(SubsystemName_LogsCollector, instance, HealthStatus_Healthy, "The logs collector is running"))
+	}
+	return result
+}
+
+// checkStateStore reports a state store that can't be read as degraded, as only enclave sharing and expiration rely on
+// it
+func (checker *EngineHealthChecker) checkStateStore(ctx context.Context) []*SubsystemHealth {
+	loadErrChan := make(chan error, 1)
+	go func() {
+		_, err := checker.stateStore.Load(stateStoreHealthCheckKey)
+		loadErrChan <- err
+	}()
+	select {
+	case <-ctx.Done():
+		return []*SubsystemHealth{newSubsystemHealth(SubsystemName_StateStore, "", HealthStatus_Degraded, "The state store didn't answer in time")}
+	case err := <-loadErrChan:
+		if err != nil {
+			return []*SubsystemHealth{newSubsystemHealth(SubsystemName_StateStore, "", HealthStatus_Degraded, "The state store can't be read: %v", stacktrace.RootCause(err))}
+		}
+	}
+	return []*SubsystemHealth{newSubsystemHealth(SubsystemName_StateStore, "", HealthStatus_Healthy, "The state store can be read")}
+}
+
+// checkArtifactsStore only checks that a remote artifacts store can be reached, as the engine has no credentials to
+// access its bucket; without it, the API containers can't store files artifacts nor get the ones evicted from their
+// enclave data volume
+func (checker *EngineHealthChecker) checkArtifactsStore(ctx context.Context) []*SubsystemHealth {
+	if !checker.artifactsStoreConfig.IsRemote() {
+		return []*SubsystemHealth{newSubsystemHealth(SubsystemName_ArtifactsStore, "", HealthStatus_Healthy, "Files artifacts are stored on the enclave data volumes")}
+	}
+	endpoint := getArtifactsStoreEndpoint(checker.artifactsStoreConfig)
+	if err := checker.probeEndpoint(ctx, endpoint); err != nil {
+		return []*SubsystemHealth{newSubsystemHealth(SubsystemName_ArtifactsStore, "", HealthStatus_Degraded, "The %v artifacts store at '%v' can't be reached, so files artifacts can't be stored in bucket '%v': %v", checker.artifactsStoreConfig.Type, endpoint, checker.artifactsStoreConfig.Bucket, stacktrace.RootCause(err))}
+	}
+	return []*SubsystemHealth{newSubsystemHealth(SubsystemName_ArtifactsStore, "", HealthStatus_Healthy, "Files artifacts are stored in %v bucket '%v' at '%v'", checker.artifactsStoreConfig.Type, checker.artifactsStoreConfig.Bucket, endpoint)}
+}
+
+// probeEndpoint returns an error if the endpoint doesn't answer; any answer, even an error status, means it's reachable
+func (checker *EngineHealthChecker) probeEndpoint(ctx context.Context, endpoint string) error {
+	request, err := http.NewRequestWithContext(ctx, artifactsStoreMethod, endpoint, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred building the request to '%v'", endpoint)
+	}
+	response, err := checker.httpClient.Do(request)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending a request to '%v'", endpoint)
+	}
+	response.Body.Close()
+	return nil
+}
+
+func getArtifactsStoreEndpoint(config artifacts_store.ArtifactsStoreConfig) string {
+	if endpoint := config.GetEndpoint(); endpoint != "" {
+		return endpoint
+	}
+	region := config.GetRegion()
+	if region == "" {
+		region = defaultS3Region
+	}
+	return fmt.Sprintf(s3EndpointFormat, region)
+}
+
+func newSubsystemHealth(name string, instance string, status HealthStatus, messageFormat string, messageArgs ...interface{}) *SubsystemHealth {
+	return &SubsystemHealth{
+		Name:     name,
+		Instance: instance,
+		Status:   status,
+		Message:  fmt.Sprintf(messageFormat, messageArgs...),
+	}
+}
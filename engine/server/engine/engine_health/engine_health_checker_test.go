@@ -0,0 +1,184 @@
+package engine_health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBackendName = "docker"
+	testNodeName    = "node-1"
+	otherNodeName   = "node-2"
+	testBucket      = "artifacts"
+)
+
+type fakeBackend struct {
+	enginesErr error
+
+	maybeLogsAggregator *logs_aggregator.LogsAggregator
+
+	logsCollectorStatuses map[string]container.ContainerStatus
+}
+
+func (backend *fakeBackend) GetEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]*engine.Engine, error) {
+	if backend.enginesErr != nil {
+		return nil, backend.enginesErr
+	}
+	return map[engine.EngineGUID]*engine.Engine{}, nil
+}
+
+func (backend *fakeBackend) GetLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
+	return backend.maybeLogsAggregator, nil
+}
+
+func (backend *fakeBackend) GetLogsCollectorStatuses(ctx context.Context) (map[string]container.ContainerStatus, error) {
+	return backend.logsCollectorStatuses, nil
+}
+
+type failingStateStore struct{}
+
+func (store failingStateStore) Load(key string) ([]byte, error) {
+	return nil, stacktrace.NewError("Permission denied")
+}
+
+func (store failingStateStore) Update(key string, updateFunc func(currentValue []byte) ([]byte, error)) error {
+	return stacktrace.NewError("Permission denied")
+}
+
+func TestCheckHealth_AllHealthy(t *testing.T) {
+	backend := &fakeBackend{
+		enginesErr:            nil,
+		maybeLogsAggregator:   logs_aggregator.NewLogsAggregator(container.ContainerStatus_Running, nil, 0, nil),
+		logsCollectorStatuses: map[string]container.ContainerStatus{testNodeName: container.ContainerStatus_Running},
+	}
+	checker := NewEngineHealthChecker(backend, testBackendName, state_store.NewFileStateStore(t.TempDir()), artifacts_store.NewLocalArtifactsStoreConfig())
+
+	subsystemsHealth := checker.CheckHealth(context.Background())
+
+	require.Equal(t, HealthStatus_Healthy, GetOverallStatus(subsystemsHealth))
+	require.Equal(t, []string{SubsystemName_ArtifactsStore, SubsystemName_Backend, SubsystemName_LogsAggregator, SubsystemName_LogsCollector, SubsystemName_StateStore}, getNames(subsystemsHealth))
+}
+
+func TestCheckHealth_ReportsEachLogsCollector(t *testing.T) {
+	backend := &fakeBackend{
+		enginesErr:          nil,
+		maybeLogsAggregator: logs_aggregator.NewLogsAggregator(container.ContainerStatus_Running, nil, 0, nil),
+		logsCollectorStatuses: map[string]container.ContainerStatus{
+			otherNodeName: container.ContainerStatus_Stopped,
+			testNodeName:  container.ContainerStatus_Running,
+		},
+	}
+	checker := NewEngineHealthChecker(backend, testBackendName, state_store.NewFileStateStore(t.TempDir()), artifacts_store.NewLocalArtifactsStoreConfig())
+
+	subsystemsHealth := checker.CheckHealth(context.Background())
+
+	require.Equal(t, HealthStatus_Degraded, GetOverallStatus(subsystemsHealth))
+	logsCollectorsHealth := getSubsystemHealth(subsystemsHealth, SubsystemName_LogsCollector)
+	require.Len(t, logsCollectorsHealth, 2)
+	require.Equal(t, testNodeName, logsCollectorsHealth[0].Instance)
+	require.Equal(t, HealthStatus_Healthy, logsCollectorsHealth[0].Status)
+	require.Equal(t, otherNodeName, logsCollectorsHealth[1].Instance)
+	require.Equal(t, HealthStatus_Degraded, logsCollectorsHealth[1].Status)
+}
+
+func TestCheckHealth_MissingLogsAggregatorIsUnhealthy(t *testing.T) {
+	backend := &fakeBackend{
+		enginesErr:            nil,
+		maybeLogsAggregator:   nil,
+		logsCollectorStatuses: map[string]container.ContainerStatus{},
+	}
+	checker := NewEngineHealthChecker(backend, testBackendName, failingStateStore{}, artifacts_store.NewLocalArtifactsStoreConfig())
+
+	subsystemsHealth := checker.CheckHealth(context.Background())
+
+	require.Equal(t, HealthStatus_Unhealthy, GetOverallStatus(subsystemsHealth))
+	require.Equal(t, HealthStatus_Unhealthy, getSubsystemHealth(subsystemsHealth, SubsystemName_LogsAggregator)[0].Status)
+	stateStoreHealth := getSubsystemHealth(subsystemsHealth, SubsystemName_StateStore)[0]
+	require.Equal(t, HealthStatus_Degraded, stateStoreHealth.Status)
+	require.Contains(t, stateStoreHealth.Message, "Permission denied")
+}
+
+func TestCheckHealth_UnreachableBackendIsUnhealthy(t *testing.T) {
+	backend := &fakeBackend{
+		enginesErr:            stacktrace.NewError("Cannot connect to the Docker daemon"),
+		maybeLogsAggregator:   logs_aggregator.NewLogsAggregator(container.ContainerStatus_Running, nil, 0, nil),
+		logsCollectorStatuses: map[string]container.ContainerStatus{},
+	}
+	checker := NewEngineHealthChecker(backend, testBackendName, state_store.NewFileStateStore(t.TempDir()), artifacts_store.NewLocalArtifactsStoreConfig())
+
+	subsystemsHealth := checker.CheckHealth(context.Background())
+
+	backendHealth := getSubsystemHealth(subsystemsHealth, SubsystemName_Backend)[0]
+	require.Equal(t, HealthStatus_Unhealthy, backendHealth.Status)
+	require.Contains(t, backendHealth.Message, "Cannot connect to the Docker daemon")
+}
+
+func TestCheckArtifactsStore_Reachable(t *testing.T) {
+	// Any answer means the store is reachable, even a denied one
+	artifactsStore := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusForbidden)
+	}))
+	defer artifactsStore.Close()
+	checker := NewEngineHealthChecker(&fakeBackend{enginesErr: nil, maybeLogsAggregator: nil, logsCollectorStatuses: nil}, testBackendName, failingStateStore{}, newS3ArtifactsStoreConfig(artifactsStore.URL))
+
+	artifactsStoreHealth := checker.checkArtifactsStore(context.Background())
+
+	require.Len(t, artifactsStoreHealth, 1)
+	require.Equal(t, HealthStatus_Healthy, artifactsStoreHealth[0].Status)
+}
+
+func TestCheckArtifactsStore_Unreachable(t *testing.T) {
+	artifactsStore := httptest.NewServer(http.NotFoundHandler())
+	unreachableEndpoint := artifactsStore.URL
+	artifactsStore.Close()
+	checker := NewEngineHealthChecker(&fakeBackend{enginesErr: nil, maybeLogsAggregator: nil, logsCollectorStatuses: nil}, testBackendName, failingStateStore{}, newS3ArtifactsStoreConfig(unreachableEndpoint))
+
+	artifactsStoreHealth := checker.checkArtifactsStore(context.Background())
+
+	require.Len(t, artifactsStoreHealth, 1)
+	require.Equal(t, HealthStatus_Degraded, artifactsStoreHealth[0].Status)
+	require.Contains(t, artifactsStoreHealth[0].Message, testBucket)
+}
+
+func TestGetArtifactsStoreEndpoint_DefaultsToAwsRegion(t *testing.T) {
+	config := newS3ArtifactsStoreConfig("")
+	require.Equal(t, "https://s3.us-east-1.amazonaws.com", getArtifactsStoreEndpoint(config))
+	config.Region = "eu-west-1"
+	require.Equal(t, "https://s3.eu-west-1.amazonaws.com", getArtifactsStoreEndpoint(config))
+}
+
+func newS3ArtifactsStoreConfig(endpoint string) artifacts_store.ArtifactsStoreConfig {
+	config := artifacts_store.NewLocalArtifactsStoreConfig()
+	config.Type = artifacts_store.ArtifactsStoreType_S3
+	config.Bucket = testBucket
+	config.Endpoint = endpoint
+	return config
+}
+
+func getNames(subsystemsHealth []*SubsystemHealth) []string {
+	names := []string{}
+	for _, subsystemHealth := range subsystemsHealth {
+		names = append(names, subsystemHealth.Name)
+	}
+	return names
+}
+
+func getSubsystemHealth(subsystemsHealth []*SubsystemHealth, name string) []*SubsystemHealth {
+	result := []*SubsystemHealth{}
+	for _, subsystemHealth := range subsystemsHealth {
+		if subsystemHealth.Name == name {
+			result = append(result, subsystemHealth)
+		}
+	}
+	return result
+}
@@ -0,0 +1,49 @@
+package engine_metrics
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	okCode = "ok"
+)
+
+// ConnectMetricsInterceptor measures the duration of the calls to the engine gRPC API
+type ConnectMetricsInterceptor struct{}
+
+func NewConnectMetricsInterceptor() *ConnectMetricsInterceptor {
+	return &ConnectMetricsInterceptor{}
+}
+
+func (interceptor *ConnectMetricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		startTime := time.Now()
+		response, err := next(ctx, request)
+		ObserveApiCall(GrpcApi, request.Spec().Procedure, getConnectCode(err), time.Since(startTime))
+		return response, err
+	}
+}
+
+// WrapStreamingClient is a no-op as the engine doesn't make calls through this interceptor
+func (interceptor *ConnectMetricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *ConnectMetricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		startTime := time.Now()
+		err := next(ctx, conn)
+		ObserveApiCall(GrpcApi, conn.Spec().Procedure, getConnectCode(err), time.Since(startTime))
+		return err
+	}
+}
+
+func getConnectCode(err error) string {
+	if err == nil {
+		return okCode
+	}
+	return connect.CodeOf(err).String()
+}
@@ -0,0 +1,36 @@
+package engine_metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	unmatchedRoute = "unmatched"
+)
+
+// NewEchoMetricsMiddleware measures the duration of the calls to the engine REST API. Calls are labelled with their
+// route, e.g. '/api/enclaves/:enclave_identifier', rather than their path to keep the number of series bounded
+func NewEchoMetricsMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			startTime := time.Now()
+			err := next(ctx)
+			statusCode := ctx.Response().Status
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				statusCode = httpErr.Code
+			} else if err != nil {
+				statusCode = http.StatusInternalServerError
+			}
+			route := ctx.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			ObserveApiCall(RestApi, ctx.Request().Method+" "+route, strconv.Itoa(statusCode), time.Since(startTime))
+			return err
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package engine_metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	metricsNamespace = "kurtosis_engine"
+
+	apiLabel       = "api"
+	methodLabel    = "method"
+	codeLabel      = "code"
+	resultLabel    = "result"
+	operationLabel = "operation"
+	statusLabel    = "status"
+
+	GrpcApi = "grpc"
+	RestApi = "rest"
+
+	StarlarkRunResult_Success     = "success"
+	StarlarkRunResult_Failure     = "failure"
+	StarlarkRunResult_Interrupted = "interrupted"
+
+	enclaveCountTimeout = 10 * time.Second
+)
+
+var (
+	// Starlark runs go from seconds to the better part of an hour for big packages
+	starlarkRunDurationBuckets = []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200, 2400, 3600}
+
+	// nolint:exhaustruct
+	apiCallDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "api_call_duration_seconds",
+			Help:      "Duration of the calls to the engine gRPC and REST APIs, streams included",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{apiLabel, methodLabel, codeLabel},
+	)
+	// nolint:exhaustruct
+	starlarkRunDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "starlark_run_duration_seconds",
+			Help:      "Duration of the Starlark runs started through the engine REST API",
+			Buckets:   starlarkRunDurationBuckets,
+		},
+		[]string{resultLabel},
+	)
+	// nolint:exhaustruct
+	openLogStreams = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "log_streams",
+			Help:      "Number of service log streams currently open",
+		},
+		[]string{apiLabel},
+	)
+	// nolint:exhaustruct
+	bufferedLogLines = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "log_stream_buffered_lines",
+			Help:      "Number of log lines buffered for the clients of all the service log streams",
+		},
+	)
+	// nolint:exhaustruct
+	droppedLogLines = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "log_stream_dropped_lines_total",
+			Help:      "Number of log lines dropped because the client of their stream couldn't keep up",
+		},
+	)
+	// nolint:exhaustruct
+	slowLogConsumerDisconnections = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "log_stream_slow_consumer_disconnections_total",
+			Help:      "Number of service log streams ended because their client couldn't keep up",
+		},
+	)
+	// nolint:exhaustruct
+	backendCallErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "backend_call_errors_total",
+			Help:      "Number of calls to the container backend (Docker or Kubernetes) that failed",
+		},
+		[]string{operationLabel},
+	)
+	enclavesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "enclaves"),
+		"Number of enclaves, by status",
+		[]string{statusLabel},
+		nil,
+	)
+
+	registry = prometheus.NewRegistry()
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{ReportErrors: false, PidFn: nil, Namespace: ""}),
+		apiCallDuration,
+		starlarkRunDuration,
+		openLogStreams,
+		bufferedLogLines,
+		droppedLogLines,
+		slowLogConsumerDisconnections,
+		backendCallErrors,
+	)
+}
+
+// enclaveLister is the part of the enclave manager the enclave count needs
+type enclaveLister interface {
+	GetEnclaves(ctx context.Context) (map[string]*types.EnclaveInfo, error)
+}
+
+// enclaveCollector counts the enclaves at scrape time so that the count is never stale
+type enclaveCollector struct {
+	enclaveManager enclaveLister
+}
+
+func (collector *enclaveCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- enclavesDesc
+}
+
+func (collector *enclaveCollector) Collect(metrics chan<- prometheus.Metric) {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), enclaveCountTimeout)
+	defer cancelFunc()
+	enclaves, err := collector.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the enclaves to count them; the count will be missing from the metrics:\n%v", err)
+		return
+	}
+	numEnclavesByStatus := map[types.EnclaveStatus]int{
+		types.EnclaveStatus_RUNNING: 0,
+		types.EnclaveStatus_STOPPED: 0,
+		types.EnclaveStatus_EMPTY:   0,
+	}
+	for _, enclaveInfo := range enclaves {
+		numEnclavesByStatus[enclaveInfo.EnclaveStatus]++
+	}
+	for status, numEnclaves := range numEnclavesByStatus {
+		metrics <- prometheus.MustNewConstMetric(enclavesDesc, prometheus.GaugeValue, float64(numEnclaves), string(status))
+	}
+}
+
+// RegisterEnclaveCount adds the number of enclaves of the enclave manager to the metrics
+func RegisterEnclaveCount(enclaveManager enclaveLister) {
+	registry.MustRegister(&enclaveCollector{enclaveManager: enclaveManager})
+}
+
+// Handler serves the engine metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	// nolint:exhaustruct
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+func ObserveApiCall(api string, method string, code string, duration time.Duration) {
+	apiCallDuration.WithLabelValues(api, method, code).Observe(duration.Seconds())
+}
+
+func ObserveStarlarkRun(result string, duration time.Duration) {
+	starlarkRunDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+// TrackLogStream counts a log stream as open until the returned function is called
+func TrackLogStream(api string) func() {
+	openLogStreams.WithLabelValues(api).Inc()
+	return func() {
+		openLogStreams.WithLabelValues(api).Dec()
+	}
+}
+
+// AddBufferedLogLines changes the number of log lines buffered for the log stream clients, by a negative delta once
+// they're sent or dropped
+func AddBufferedLogLines(delta int) {
+	bufferedLogLines.Add(float64(delta))
+}
+
+func CountDroppedLogLines(numLogLines int) {
+	droppedLogLines.Add(float64(numLogLines))
+}
+
+func CountSlowLogConsumerDisconnection() {
+	slowLogConsumerDisconnections.Inc()
+}
+
+func CountBackendCallError(operation string) {
+	backendCallErrors.WithLabelValues(operation).Inc()
+}
@@ -0,0 +1,46 @@
+package engine_metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEnclaveManager struct {
+	enclaves map[string]*types.EnclaveInfo
+}
+
+func (manager *fakeEnclaveManager) GetEnclaves(_ context.Context) (map[string]*types.EnclaveInfo, error) {
+	return manager.enclaves, nil
+}
+
+func TestHandler(t *testing.T) {
+	RegisterEnclaveCount(&fakeEnclaveManager{
+		enclaves: map[string]*types.EnclaveInfo{
+			"running-enclave-uuid":         {EnclaveStatus: types.EnclaveStatus_RUNNING},
+			"another-running-enclave-uuid": {EnclaveStatus: types.EnclaveStatus_RUNNING},
+			"stopped-enclave-uuid":         {EnclaveStatus: types.EnclaveStatus_STOPPED},
+		},
+	})
+	CountBackendCallError("destroy_enclaves")
+	CountDroppedLogLines(3)
+	untrackLogStream := TrackLogStream(GrpcApi)
+	TrackLogStream(GrpcApi)
+	untrackLogStream()
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	metrics := recorder.Body.String()
+	require.Contains(t, metrics, `kurtosis_engine_enclaves{status="RUNNING"} 2`)
+	require.Contains(t, metrics, `kurtosis_engine_enclaves{status="STOPPED"} 1`)
+	require.Contains(t, metrics, `kurtosis_engine_enclaves{status="EMPTY"} 0`)
+	require.Contains(t, metrics, `kurtosis_engine_backend_call_errors_total{operation="destroy_enclaves"} 1`)
+	require.Contains(t, metrics, `kurtosis_engine_log_streams{api="grpc"} 1`)
+	require.Contains(t, metrics, `kurtosis_engine_log_stream_dropped_lines_total 3`)
+}
@@ -0,0 +1,54 @@
+package engine_tracing
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	rpcSystemAttributeKey = "rpc.system"
+	rpcSystem             = "grpc"
+)
+
+// ConnectTracingInterceptor starts a span for each call to the engine gRPC API, joining the trace of the caller if
+// the call carries one, so that the spans of the enclave operations it runs are part of it
+type ConnectTracingInterceptor struct{}
+
+func NewConnectTracingInterceptor() *ConnectTracingInterceptor {
+	return &ConnectTracingInterceptor{}
+}
+
+func (interceptor *ConnectTracingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, span := startServerSpan(ctx, request.Spec().Procedure, request.Header())
+		response, err := next(ctx, request)
+		tracing.EndSpan(span, err)
+		return response, err
+	}
+}
+
+// WrapStreamingClient is a no-op as the engine doesn't make calls through this interceptor
+func (interceptor *ConnectTracingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *ConnectTracingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, span := startServerSpan(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		err := next(ctx, conn)
+		tracing.EndSpan(span, err)
+		return err
+	}
+}
+
+func startServerSpan(ctx context.Context, procedure string, requestHeader http.Header) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(requestHeader))
+	return tracing.StartSpan(ctx, procedure, attribute.String(rpcSystemAttributeKey, rpcSystem))
+}
@@ -0,0 +1,72 @@
+package engine_tracing
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	callerTraceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+	callerSpanId  = "00f067aa0ba902b7"
+)
+
+func TestWrapUnary_JoinsTheTraceOfTheCaller(t *testing.T) {
+	spanRecorder := setUpTracing(t)
+
+	request := connect.NewRequest(&emptypb.Empty{})
+	request.Header().Set("traceparent", "00-"+callerTraceId+"-"+callerSpanId+"-01")
+
+	var spanContextOfTheCall trace.SpanContext
+	next := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		spanContextOfTheCall = trace.SpanContextFromContext(ctx)
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+	_, err := NewConnectTracingInterceptor().WrapUnary(next)(context.Background(), request)
+	require.Error(t, err)
+
+	endedSpans := spanRecorder.Ended()
+	require.Len(t, endedSpans, 1)
+	span := endedSpans[0]
+	require.Equal(t, callerTraceId, span.SpanContext().TraceID().String())
+	require.Equal(t, callerSpanId, span.Parent().SpanID().String())
+	require.Equal(t, span.SpanContext().SpanID(), spanContextOfTheCall.SpanID())
+	require.Equal(t, codes.Error, span.Status().Code)
+}
+
+func TestWrapUnary_StartsATraceWithoutTraceContext(t *testing.T) {
+	spanRecorder := setUpTracing(t)
+
+	next := func(_ context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+	_, err := NewConnectTracingInterceptor().WrapUnary(next)(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	require.NoError(t, err)
+
+	endedSpans := spanRecorder.Ended()
+	require.Len(t, endedSpans, 1)
+	require.False(t, endedSpans[0].Parent().IsValid())
+	require.Equal(t, codes.Unset, endedSpans[0].Status().Code)
+}
+
+func setUpTracing(t *testing.T) *tracetest.SpanRecorder {
+	spanRecorder := tracetest.NewSpanRecorder()
+	previousTracerProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousTracerProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	return spanRecorder
+}
@@ -0,0 +1,86 @@
+package leader_election
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	leaseName = "kurtosis-engine-leader"
+
+	// How long the other replicas wait before taking over from a leader that stopped renewing its lease, e.g. because
+	// its node went away
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// kubernetesLeaderElector elects the leader among the engine replicas with a Lease in the engine namespace
+type kubernetesLeaderElector struct {
+	kubernetesClientSet *kubernetes.Clientset
+
+	namespaceName string
+
+	// The name of the pod of this replica
+	identity string
+}
+
+func NewKubernetesLeaderElector(kubernetesClientSet *kubernetes.Clientset, namespaceName string, identity string) (LeaderElector, error) {
+	if namespaceName == "" || identity == "" {
+		return nil, stacktrace.NewError("The namespace and pod name of the engine replica are required to elect a leader, but got namespace '%v' and pod name '%v'", namespaceName, identity)
+	}
+	return &kubernetesLeaderElector{
+		kubernetesClientSet: kubernetesClientSet,
+		namespaceName:       namespaceName,
+		identity:            identity,
+	}, nil
+}
+
+func (elector *kubernetesLeaderElector) Run(ctx context.Context, leaderTasks func(leaderCtx context.Context)) {
+	// nolint:exhaustruct
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      leaseName,
+			Namespace: elector.namespaceName,
+		},
+		Client: elector.kubernetesClientSet.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity:      elector.identity,
+			EventRecorder: nil,
+		},
+	}
+	// nolint:exhaustruct
+	leaderElectionConfig := leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            leaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				logrus.Infof("Engine replica '%v' is now the leader", elector.identity)
+				leaderTasks(leaderCtx)
+			},
+			OnStoppedLeading: func() {
+				logrus.Infof("Engine replica '%v' isn't the leader anymore", elector.identity)
+			},
+			OnNewLeader: func(identity string) {
+				logrus.Debugf("Engine replica '%v' is the leader", identity)
+			},
+		},
+	}
+	go func() {
+		// The election ends when the leadership is lost, so campaign again until the engine stops
+		for ctx.Err() == nil {
+			leaderelection.RunOrDie(ctx, leaderElectionConfig)
+		}
+	}()
+}
@@ -0,0 +1,24 @@
+package leader_election
+
+import (
+	"context"
+)
+
+// LeaderElector picks which engine replica runs the background tasks that must only run once per engine, e.g. reaping
+// the expired enclaves
+type LeaderElector interface {
+	// Run calls leaderTasks every time this replica becomes the leader, with a context that gets cancelled when it stops
+	// being it. It returns right away
+	Run(ctx context.Context, leaderTasks func(leaderCtx context.Context))
+}
+
+// standaloneLeaderElector is for the engines that never have more than one replica, which always lead
+type standaloneLeaderElector struct{}
+
+func NewStandaloneLeaderElector() LeaderElector {
+	return &standaloneLeaderElector{}
+}
+
+func (elector *standaloneLeaderElector) Run(ctx context.Context, leaderTasks func(leaderCtx context.Context)) {
+	leaderTasks(ctx)
+}
@@ -9,7 +9,6 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
-	"math"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
@@ -18,10 +17,14 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/fips_mode"
+	"github.com/kurtosis-tech/kurtosis/api/golang/grpc_compression"
 	enclaveApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/core_rest_api"
 	engineApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/engine_rest_api"
 	loggingApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/websocket_api"
@@ -29,23 +32,52 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/multi_cluster"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/air_gap"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/artifacts_store"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/content_scanning"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave_quota"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_cache"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_verification"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/secrets_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	em_api "github.com/kurtosis-tech/kurtosis/enclave-manager/server"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/bounded_buffer"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/logs_collector_aware"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/file_layout"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_file_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/stream_logs_strategy"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/config_reloader"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_access"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_certificates"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_reaper"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_health"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_tracing"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/leader_election"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/resource_usage_sampler"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/server"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_compactor"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/streaming"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/analytics_logger"
@@ -56,14 +88,17 @@ import (
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 const (
 	successExitCode = 0
 	failureExitCode = 1
 
-	numHoursInAWeek           = 7 * 24
 	grpcServerStopGracePeriod = 5 * time.Second
+	// The engine gRPC port serves several services, each under its own path
+	grpcServeMuxRootPath = "/"
 
 	forceColors   = true
 	fullTimestamp = true
@@ -84,6 +119,7 @@ const (
 	streamerExpirationTime = time.Hour * 2
 
 	pathToApiGroup = "/api"
+	pathToMetrics  = "/metrics"
 
 	pathToEnclaveSpecs   = "/specs/enclave"
 	pathToEngineSpecs    = "/specs/engine"
@@ -91,11 +127,23 @@ const (
 
 	envJsFilename = "env.js"
 	envJsFilePerm = 0644
+
+	// Kept on the logs volume, which outlives the engine and is shared by all its replicas
+	auditLogDirname = "audit-log"
+
+	// Where the SQLite state store is kept when no path is configured, next to the file state store it replaces
+	defaultSqliteStateStoreFilename = "engine-state.db"
+
+	// Names the audit log files of the engine replica if its hostname is unknown
+	defaultAuditLogReplicaName = "engine"
+
+	// Names the engine in the traces it exports
+	engineTracingServiceName = "kurtosis-engine"
 )
 
 var (
 	defaultCORSOrigins []string = []string{"*"}
-	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept}
+	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization}
 )
 
 // Nil indicates that the KurtosisBackend should not operate in API container mode, which is appropriate here
@@ -152,6 +200,17 @@ func runMain() error {
 	}
 	logrus.SetLevel(logLevel)
 
+	if serverArgs.IsFipsModeEnabled {
+		fips_mode.Enable()
+		logrus.Infof("The TLS connections of the engine and of the API containers are restricted to the FIPS-approved parameters, validated crypto in use: %v", fips_mode.IsValidatedCryptoInUse())
+	}
+
+	shutdownTracing, err := tracing.InitTracing(ctx, engineTracingServiceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred initializing the tracing of the engine")
+	}
+	defer shutdownTracing(context.Background())
+
 	backendConfig := serverArgs.KurtosisLocalBackendConfig
 	if backendConfig == nil {
 		return stacktrace.NewError("Backend configuration parameters are null - there must be backend configuration parameters.")
@@ -171,7 +230,7 @@ func runMain() error {
 		}
 	}
 
-	kurtosisBackend, err := getKurtosisBackend(ctx, serverArgs.KurtosisBackendType, backendConfig, remoteBackendConfigMaybe)
+	kurtosisBackend, err := getKurtosisBackend(ctx, serverArgs.KurtosisBackendType, backendConfig, remoteBackendConfigMaybe, serverArgs.AirGapConfig)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis backend for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
 	}
@@ -180,8 +239,24 @@ func runMain() error {
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred parsing a duration from provided log retention period string: %v", serverArgs.LogRetentionPeriod)
 	}
-	logsDatabaseClient := getLogsDatabaseClient(kurtosisBackend, logRetentionPeriodDuration)
-	logsDatabaseClient.StartLogFileManagement(ctx)
+	// The key is only given to the engine when the logs aggregator encrypts the logs it stores
+	var logsEncryptionKey []byte
+	if encodedLogsEncryptionKey := os.Getenv(logs_aggregator.LogsEncryptionKeyEnvVar); encodedLogsEncryptionKey != "" {
+		logsEncryptionKey, err = logs_aggregator.DecodeLogsEncryptionKey(encodedLogsEncryptionKey)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred decoding the logs encryption key in environment variable '%v'", logs_aggregator.LogsEncryptionKeyEnvVar)
+		}
+	}
+	logsDatabaseClient := getLogsDatabaseClient(kurtosisBackend, logRetentionPeriodDuration, serverArgs.LogStreamingConfig, logsEncryptionKey)
+
+	stateStore, leaderElector, err := getStateStoreAndLeaderElector(ctx, serverArgs.KurtosisBackendType, serverArgs.StateStoreConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the state store and the leader elector for backend type '%v'", serverArgs.KurtosisBackendType)
+	}
+
+	// The authorities of the enclaves are kept in the state store, so that their API containers keep trusting the engine
+	// across its restarts
+	certificateIssuer := enclave_certificates.NewEnclaveCertificateIssuer(stateStore, serverArgs.ShouldRequireApiContainerMtls)
 
 	enclaveManager, err := getEnclaveManager(
 		kurtosisBackend,
@@ -198,6 +273,18 @@ func runMain() error {
 		serverArgs.KurtosisLocalBackendConfig,
 		serverArgs.LogsCollectorFilters,
 		serverArgs.LogsCollectorParsers,
+		serverArgs.LogsCollectorSystemLogsConfig,
+		serverArgs.LogsCollectorKubernetesMetadataConfig,
+		serverArgs.LogsCollectorBufferConfig,
+		serverArgs.ArtifactsStoreConfig,
+		serverArgs.ImageCacheConfig,
+		serverArgs.EnclaveQuota,
+		serverArgs.MetricsSinkConfig,
+		certificateIssuer,
+		serverArgs.SecretsProviderConfig,
+		serverArgs.ImageVerificationConfig,
+		serverArgs.AirGapConfig,
+		serverArgs.ContentScanningConfig,
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create an enclave manager for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
@@ -248,9 +335,18 @@ func runMain() error {
 		}
 	}()
 
+	// nil if anyone who can reach the enclave manager ports can use it
+	var enclaveManagerUserAuthenticator *em_api.UserAuthenticator
+	if serverArgs.EnclaveManagerAuthConfig.IsEnabled() {
+		enclaveManagerUserAuthenticator, err = auth.NewEnclaveManagerUserAuthenticator(serverArgs.EnclaveManagerAuthConfig)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the enclave manager user authenticator")
+		}
+	}
+
 	go func() {
 		enforceAuth := serverArgs.OnBastionHost
-		err = em_api.RunEnclaveManagerApiServer(enforceAuth)
+		err = em_api.RunEnclaveManagerApiServer(enforceAuth, enclaveManagerUserAuthenticator)
 		if err != nil {
 			logrus.Fatal("an error occurred while processing the auth settings, exiting!", err)
 			fmt.Fprintln(logrus.StandardLogger().Out, err)
@@ -272,6 +368,7 @@ func runMain() error {
 			serverArgs.IsCI,
 			serverArgs.CloudUserID,
 			serverArgs.CloudInstanceID,
+			serverArgs.MetricsSinkConfig,
 		),
 	)
 	if err != nil {
@@ -283,11 +380,61 @@ func runMain() error {
 		}
 	}()
 
-	if serverArgs.RestartAPIContainers {
-		if err := enclaveManager.RestartAllEnclaveAPIContainers(ctx); err != nil {
-			return stacktrace.Propagate(err, "An error occurred restarting all API containers.")
-		}
+	// nil if anyone who can reach the engine ports can call it
+	var authenticator *auth.EngineAuthenticator
+	if serverArgs.AuthConfig.IsEnabled() {
+		logrus.Infof("Engine authentication is enabled with %v static token(s) and OIDC %v", len(serverArgs.AuthConfig.StaticTokens), serverArgs.AuthConfig.Oidc != nil)
+		authenticator = auth.NewEngineAuthenticator(serverArgs.AuthConfig)
+	}
+
+	enclaveAccessController := enclave_access.NewDisabledEnclaveAccessController()
+	if authenticator != nil {
+		enclaveAccessController = enclave_access.NewEnclaveAccessController(stateStore)
+	}
+
+	defaultEnclaveTtl, err := args.ParseEnclaveTtl(serverArgs.DefaultEnclaveTtl)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the default enclave TTL")
 	}
+	enclaveReaper := enclave_reaper.NewEnclaveReaper(stateStore, defaultEnclaveTtl, enclaveManager, metricsClient)
+
+	// The owners of the removed enclaves can read their logs until the logs are deleted, so their access is kept as long
+	stateCompactor := state_compactor.NewStateCompactor(stateStore, enclaveManager, logRetentionPeriodDuration, enclaveAccessController, enclaveReaper, certificateIssuer)
+
+	// Every engine replica applies the config changes, not only the leader
+	configReloader := config_reloader.NewConfigReloader(stateStore, serverArgs.GetReloadableConfig(), authenticator, logsDatabaseClient, enclaveManager, enclaveReaper)
+	configReloader.StartWatching(ctx)
+
+	auditLogReplicaName, err := os.Hostname()
+	if err != nil {
+		logrus.Warnf("An error occurred getting the hostname of the engine, its audit log files will be named after '%v':\n%v", defaultAuditLogReplicaName, err)
+		auditLogReplicaName = defaultAuditLogReplicaName
+	}
+	auditLog := audit_log.NewAuditLog(path.Join(volume_consts.LogsStorageDirpath, auditLogDirname), auditLogReplicaName)
+
+	engine_metrics.RegisterEnclaveCount(enclaveManager)
+
+	// Every engine replica samples the services, as the samples are kept in memory and any replica can be asked for them
+	resourceUsageSampler := resource_usage_sampler.NewResourceUsageSampler(enclaveManager, kurtosisBackend)
+	resourceUsageSampler.StartSampling(ctx)
+
+	engineHealthChecker := engine_health.NewEngineHealthChecker(kurtosisBackend, serverArgs.KurtosisBackendType.String(), stateStore, serverArgs.ArtifactsStoreConfig)
+
+	// Only one engine replica runs the background tasks. The log file management and the API containers restart
+	// happen once per replica process as they don't stop with the leadership
+	var onceTasks sync.Once
+	leaderElector.Run(ctx, func(leaderCtx context.Context) {
+		onceTasks.Do(func() {
+			logsDatabaseClient.StartLogFileManagement(ctx)
+			if serverArgs.RestartAPIContainers {
+				if err := enclaveManager.RestartAllEnclaveAPIContainers(ctx); err != nil {
+					logrus.Errorf("An error occurred restarting all API containers:\n%v", err)
+				}
+			}
+		})
+		enclaveReaper.StartEnclaveReaping(leaderCtx)
+		stateCompactor.StartCompaction(leaderCtx)
+	})
 
 	go func() {
 		err := restApiServer(
@@ -296,6 +443,11 @@ func runMain() error {
 			enclaveManager,
 			logsDatabaseClient,
 			metricsClient,
+			authenticator,
+			enclaveAccessController,
+			enclaveReaper,
+			auditLog,
+			certificateIssuer,
 		)
 		if err != nil {
 			logrus.Fatal("The REST API server is down, exiting!", err)
@@ -310,15 +462,41 @@ func runMain() error {
 		serverArgs.MetricsUserID,
 		serverArgs.DidUserAcceptSendingMetrics,
 		logsDatabaseClient,
-		metricsClient)
-	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer)
+		metricsClient,
+		enclaveAccessController,
+		enclaveReaper,
+		configReloader,
+		auditLog,
+		resourceUsageSampler,
+		engineHealthChecker,
+		certificateIssuer)
+	// The tracing interceptor comes first so that the spans of the other interceptors are part of the trace of the call,
+	// then the metrics one so that the calls rejected by the auth one are measured too
+	interceptors := []connect.Interceptor{engine_tracing.NewConnectTracingInterceptor(), engine_metrics.NewConnectMetricsInterceptor()}
+	if authenticator != nil {
+		interceptors = append(interceptors, auth.NewConnectAuthInterceptor(authenticator))
+	}
+	// The audit interceptor comes after the auth one so that it knows who made the call
+	interceptors = append(interceptors, audit_log.NewConnectAuditInterceptor(auditLog))
+	handlerOptions := []connect.HandlerOption{connect.WithInterceptors(interceptors...)}
+	// The clients choose whether to compress their calls, e.g. the service logs streamed to remote CLIs
+	handlerOptions = append(handlerOptions, grpc_compression.GetConnectHandlerOptions()...)
+	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer, handlerOptions...)
 	defer func() {
 		if err := engineConnectServer.Close(); err != nil {
 			logrus.Errorf("We tried to close the engine connect server service but something fails. Err:\n%v", err)
 		}
 	}()
 
-	engineHttpServer := connect_server.NewConnectServer(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, handler, apiPath)
+	// The gRPC reflection services are served next to the engine service, so that grpcurl and the like can discover it
+	engineServeMux := http.NewServeMux()
+	engineServeMux.Handle(apiPath, handler)
+	grpcReflectionHandler := server.NewGrpcReflectionHandler()
+	for _, grpcReflectionPath := range server.GrpcReflectionPaths {
+		engineServeMux.Handle(grpcReflectionPath, grpcReflectionHandler)
+	}
+
+	engineHttpServer := connect_server.NewConnectServer(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, engineServeMux, grpcServeMuxRootPath)
 	if err := engineHttpServer.RunServerUntilInterruptedWithCors(cors.AllowAll()); err != nil {
 		return stacktrace.Propagate(err, "An error occurred running the server.")
 	}
@@ -341,6 +519,18 @@ func getEnclaveManager(
 	kurtosisLocalBackendConfig interface{},
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	logsCollectorSystemLogsConfig logs_collector.SystemLogsConfig,
+	logsCollectorKubernetesMetadataConfig logs_collector.KubernetesMetadataConfig,
+	logsCollectorBufferConfig logs_collector.BufferConfig,
+	artifactsStoreConfig artifacts_store.ArtifactsStoreConfig,
+	imageCacheConfig image_cache.ImageCacheConfig,
+	enclaveQuota enclave_quota.EnclaveQuota,
+	metricsSinkConfig metrics_client.SinkConfig,
+	certificateIssuer *enclave_certificates.EnclaveCertificateIssuer,
+	secretsProviderConfig secrets_provider.SecretsProviderConfig,
+	imageVerificationConfig image_verification.ImageVerificationConfig,
+	airGapConfig air_gap.AirGapConfig,
+	contentScanningConfig content_scanning.ContentScanningConfig,
 ) (*enclave_manager.EnclaveManager, error) {
 	var apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 	switch kurtosisBackendType {
@@ -351,7 +541,15 @@ func getEnclaveManager(
 		if !ok {
 			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Kubernetes.String())
 		}
-		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewKubernetesKurtosisBackendConfigSupplier(kurtosisLocalBackendConfigKubernetesType.StorageClass)
+		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewKubernetesKurtosisBackendConfigSupplier(
+			kurtosisLocalBackendConfigKubernetesType.StorageClass,
+			kurtosisLocalBackendConfigKubernetesType.ClientQPS,
+			kurtosisLocalBackendConfigKubernetesType.ClientBurst,
+			kurtosisLocalBackendConfigKubernetesType.ClientMaxRetries,
+			kurtosisLocalBackendConfigKubernetesType.ObjectLabels,
+			kurtosisLocalBackendConfigKubernetesType.ObjectAnnotations,
+			kurtosisLocalBackendConfigKubernetesType.PodSecurityStandard,
+		)
 	default:
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
 	}
@@ -371,6 +569,18 @@ func getEnclaveManager(
 		cloudInstanceId,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		logsCollectorSystemLogsConfig,
+		logsCollectorKubernetesMetadataConfig,
+		logsCollectorBufferConfig,
+		artifactsStoreConfig,
+		imageCacheConfig,
+		enclaveQuota,
+		metricsSinkConfig,
+		certificateIssuer,
+		secretsProviderConfig,
+		imageVerificationConfig,
+		airGapConfig,
+		contentScanningConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave manager for backend type '%+v' using pool-size '%v' and engine version '%v'", kurtosisBackendType, poolSize, engineVersion)
@@ -379,12 +589,78 @@ func getEnclaveManager(
 	return enclaveManager, nil
 }
 
-func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBackendType, backendConfig interface{}, remoteBackendConfigMaybe *configs.KurtosisRemoteBackendConfig) (backend_interface.KurtosisBackend, error) {
+// The engine replicas running on Kubernetes share their state through Kubernetes and elect a leader among them, while
+// the single engine running on Docker keeps its state next to the logs, the only engine storage that survives restarts.
+// The installations with many enclaves can keep the state in a SQL database instead
+func getStateStoreAndLeaderElector(ctx context.Context, kurtosisBackendType args.KurtosisBackendType, stateStoreConfig args.StateStoreConfig) (state_store.StateStore, leader_election.LeaderElector, error) {
+	switch kurtosisBackendType {
+	case args.KurtosisBackendType_Docker:
+		stateStore := state_store.NewFileStateStore(volume_consts.LogsStorageDirpath)
+		if stateStoreConfig.Type != args.StateStoreType_Default {
+			var err error
+			stateStore, err = getSqlStateStore(stateStoreConfig)
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred creating the '%v' state store", stateStoreConfig.Type)
+			}
+		}
+		return stateStore, leader_election.NewStandaloneLeaderElector(), nil
+	case args.KurtosisBackendType_Kubernetes:
+		kubernetesConfig, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting in cluster Kubernetes config")
+		}
+		kubernetesClientSet, err := kubernetes.NewForConfig(kubernetesConfig)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes client set")
+		}
+		var stateStore state_store.StateStore
+		switch stateStoreConfig.Type {
+		case args.StateStoreType_Default:
+			stateStore, err = state_store.NewKubernetesStateStore(ctx, kubernetesClientSet, volume_consts.LogsStorageDirpath)
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes state store")
+			}
+		case args.StateStoreType_Sqlite:
+			// The file isn't shared by the engine replicas
+			return nil, nil, stacktrace.NewError("The '%v' state store isn't supported on Kubernetes; use '%v' instead", args.StateStoreType_Sqlite, args.StateStoreType_Postgres)
+		default:
+			stateStore, err = getSqlStateStore(stateStoreConfig)
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred creating the '%v' state store", stateStoreConfig.Type)
+			}
+		}
+		leaderElector, err := leader_election.NewKubernetesLeaderElector(kubernetesClientSet, os.Getenv(engine.PodNamespaceEnvVar), os.Getenv(engine.PodNameEnvVar))
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes leader elector")
+		}
+		return stateStore, leaderElector, nil
+	default:
+		return nil, nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
+	}
+}
+
+// The state stored by the engines that ran before switching to the database is still read from its previous location
+func getSqlStateStore(stateStoreConfig args.StateStoreConfig) (state_store.StateStore, error) {
+	switch stateStoreConfig.Type {
+	case args.StateStoreType_Sqlite:
+		databaseFilepath := stateStoreConfig.Url
+		if databaseFilepath == "" {
+			databaseFilepath = path.Join(volume_consts.LogsStorageDirpath, defaultSqliteStateStoreFilename)
+		}
+		return state_store.NewSqliteStateStore(databaseFilepath, volume_consts.LogsStorageDirpath)
+	case args.StateStoreType_Postgres:
+		return state_store.NewPostgresStateStore(stateStoreConfig.Url, volume_consts.LogsStorageDirpath)
+	default:
+		return nil, stacktrace.NewError("State store type '%v' isn't a SQL database", stateStoreConfig.Type)
+	}
+}
+
+func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBackendType, backendConfig interface{}, remoteBackendConfigMaybe *configs.KurtosisRemoteBackendConfig, airGapConfig air_gap.AirGapConfig) (backend_interface.KurtosisBackend, error) {
 	var kurtosisBackend backend_interface.KurtosisBackend
 	var err error
 	switch kurtosisBackendType {
 	case args.KurtosisBackendType_Docker:
-		kurtosisBackend, err = backend_creator.GetDockerKurtosisBackend(apiContainerModeArgsForKurtosisBackend, remoteBackendConfigMaybe)
+		kurtosisBackend, err = backend_creator.GetDockerKurtosisBackend(apiContainerModeArgsForKurtosisBackend, remoteBackendConfigMaybe, airGapConfig)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred getting local Docker Kurtosis backend")
 		}
@@ -399,13 +675,35 @@ func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBa
 		if !ok {
 			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Kubernetes.String())
 		}
-		kurtosisBackend, err = kubernetes_kurtosis_backend.GetEngineServerBackend(ctx, clusterConfigK8s.StorageClass)
+		clientConfig := kubernetes_manager.NewClientConfig(clusterConfigK8s.ClientQPS, clusterConfigK8s.ClientBurst, clusterConfigK8s.ClientMaxRetries)
+		podSecurityStandard := kubernetes_manager.PodSecurityStandard(clusterConfigK8s.PodSecurityStandard)
+		objAttrsProvider, err := object_attributes_provider.GetKubernetesObjectAttributesProviderWithMandatoryAttributes(clusterConfigK8s.ObjectLabels, clusterConfigK8s.ObjectAnnotations)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes object attributes provider")
+		}
+		kurtosisBackend, err = kubernetes_kurtosis_backend.GetEngineServerBackend(ctx, clusterConfigK8s.StorageClass, clientConfig, podSecurityStandard, airGapConfig, objAttrsProvider)
 		if err != nil {
 			return nil, stacktrace.Propagate(
 				err,
 				"An error occurred getting Kurtosis Kubernetes backend for engine",
 			)
 		}
+		if len(clusterConfigK8s.AdditionalClusterKubeconfigs) > 0 {
+			backendsByCluster := map[string]backend_interface.KurtosisBackend{
+				clusterConfigK8s.ClusterName: kurtosisBackend,
+			}
+			for clusterName, kubeconfig := range clusterConfigK8s.AdditionalClusterKubeconfigs {
+				clusterBackend, err := kubernetes_kurtosis_backend.GetEngineServerBackendForKubeconfig(ctx, kubeconfig, clusterConfigK8s.StorageClass, clientConfig, podSecurityStandard, airGapConfig, objAttrsProvider)
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred getting Kurtosis Kubernetes backend for additional cluster '%v'", clusterName)
+				}
+				backendsByCluster[clusterName] = clusterBackend
+			}
+			kurtosisBackend, err = multi_cluster.NewMultiClusterKurtosisBackend(clusterConfigK8s.ClusterName, backendsByCluster)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating the Kurtosis backend spanning the Kubernetes clusters")
+			}
+		}
 	default:
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
 	}
@@ -414,21 +712,23 @@ func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBa
 }
 
 // getLogsDatabaseClient returns a logs db client that uses a persistent volume for storage, retrieval, and streaming of logs
-func getLogsDatabaseClient(kurtosisBackend backend_interface.KurtosisBackend, logRetentionPeriod time.Duration) centralized_logs.LogsDatabaseClient {
+// The logs of the enclaves created without logs collection are read from the container engine instead
+func getLogsDatabaseClient(kurtosisBackend backend_interface.KurtosisBackend, logRetentionPeriod time.Duration, logStreamingConfig args.LogStreamingConfig, logsEncryptionKey []byte) centralized_logs.LogsDatabaseClient {
 	var logsDatabaseClient centralized_logs.LogsDatabaseClient
 	realTime := logs_clock.NewRealClock()
 
-	logRetentionPeriodInWeeks := int(math.Ceil(logRetentionPeriod.Hours() / float64(numHoursInAWeek)))
-	if logRetentionPeriodInWeeks < 1 {
-		logRetentionPeriodInWeeks = 1
-	}
+	logRetentionPeriodInWeeks := log_file_manager.GetLogRetentionPeriodInWeeks(logRetentionPeriod)
 	logrus.Infof("Setting log retention period to '%v' week(s).", logRetentionPeriodInWeeks)
 	osFs := volume_filesystem.NewOsVolumeFilesystem()
 	perWeekFileLayout := file_layout.NewPerWeekFileLayout(realTime)
 	logFileManager := log_file_manager.NewLogFileManager(kurtosisBackend, osFs, perWeekFileLayout, realTime, logRetentionPeriodInWeeks)
-	perWeekStreamLogsStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(realTime, logRetentionPeriodInWeeks)
+	perWeekStreamLogsStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(realTime, logRetentionPeriodInWeeks, logsEncryptionKey)
+
+	persistentVolumeLogsDatabaseClient := persistent_volume.NewPersistentVolumeLogsDatabaseClient(kurtosisBackend, osFs, logFileManager, perWeekStreamLogsStrategy)
+	kurtosisBackendLogsDatabaseClient := kurtosis_backend.NewKurtosisBackendLogsDatabaseClient(kurtosisBackend)
 
-	logsDatabaseClient = persistent_volume.NewPersistentVolumeLogsDatabaseClient(kurtosisBackend, osFs, logFileManager, perWeekStreamLogsStrategy)
+	logsDatabaseClient = logs_collector_aware.NewLogsCollectorAwareLogsDatabaseClient(kurtosisBackend, persistentVolumeLogsDatabaseClient, kurtosisBackendLogsDatabaseClient)
+	logsDatabaseClient = bounded_buffer.NewBoundedBufferLogsDatabaseClient(logsDatabaseClient, logStreamingConfig)
 	return logsDatabaseClient
 }
 
@@ -448,6 +748,11 @@ func restApiServer(
 	enclave_manager *enclave_manager.EnclaveManager,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	metricsClient metrics_client.MetricsClient,
+	authenticator *auth.EngineAuthenticator,
+	enclaveAccessController *enclave_access.EnclaveAccessController,
+	enclaveReaper *enclave_reaper.EnclaveReaper,
+	auditLog *audit_log.AuditLog,
+	certificateIssuer *enclave_certificates.EnclaveCertificateIssuer,
 ) error {
 
 	asyncStarlarkLogs := streaming.NewStreamerPool[*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamerPoolSize, streamerExpirationTime)
@@ -457,6 +762,7 @@ func restApiServer(
 
 	// This is how you set up a basic Echo router
 	echoRouter := echo.New()
+	echoRouter.Use(engine_metrics.NewEchoMetricsMiddleware())
 	echoApiRouter := echoRouter.Group(pathToApiGroup)
 	echoApiRouter.Use(echomiddleware.Logger())
 
@@ -470,12 +776,21 @@ func restApiServer(
 		AllowHeaders: defaultCORSHeaders,
 	}))
 
+	// Registered after the CORS middleware so that preflight requests, which never carry credentials, get answered
+	if authenticator != nil {
+		echoApiRouter.Use(auth.NewEchoAuthMiddleware(authenticator))
+	}
+	echoApiRouter.Use(audit_log.NewEchoAuditMiddleware(auditLog))
+	echoApiRouter.Use(server.NewEnclaveAccessMiddleware(enclave_manager, enclaveAccessController))
+
 	// ============================== Engine Management API ======================================
 	engineRuntime := server.EngineRuntime{
 		ImageVersionTag: serverArgs.ImageVersionTag,
 		EnclaveManager:  enclave_manager,
 		LogsDbClient:    logsDatabaseClient,
 		MetricsClient:   metricsClient,
+		EnclaveAccess:   enclaveAccessController,
+		EnclaveReaper:   enclaveReaper,
 	}
 	engineApi.RegisterHandlers(echoApiRouter, engineApi.NewStrictHandler(engineRuntime, nil))
 
@@ -498,7 +813,7 @@ func restApiServer(
 	loggingApi.RegisterHandlers(echoApiRouter, webSocketRuntime)
 
 	// ============================== Engine Management API ======================================
-	enclaveRuntime, err := server.NewEnclaveRuntime(ctx, *enclave_manager, asyncStarlarkLogs, false)
+	enclaveRuntime, err := server.NewEnclaveRuntime(ctx, *enclave_manager, asyncStarlarkLogs, false, certificateIssuer)
 	if err != nil {
 		newErr := stacktrace.Propagate(err, "Failed to initialize %T", enclaveRuntime)
 		return newErr
@@ -532,6 +847,13 @@ func restApiServer(
 		server.ServeSwaggerUI(echoRouter, pathToApiGroup, pathToWebsocketSpecs, server.NewSwaggerUIConfig(swaggerWebsocket))
 	}
 
+	// ============================== Serve Prometheus metrics ======================================
+	metricsMiddlewares := []echo.MiddlewareFunc{}
+	if authenticator != nil {
+		metricsMiddlewares = append(metricsMiddlewares, auth.NewEchoAuthMiddleware(authenticator))
+	}
+	echoRouter.GET(pathToMetrics, echo.WrapHandler(engine_metrics.Handler()), metricsMiddlewares...)
+
 	// ============================== Start Server ======================================
 	return echoRouter.Start(net.JoinHostPort(engine.RESTAPIHostIP, fmt.Sprint(engine.RESTAPIPortAddr)))
 }
@@ -45,3 +45,10 @@ func ToGrpcImageDownloadMode(flag api_type.ImageDownloadMode) rpc_api.ImageDownl
 		panic(fmt.Sprintf("Missing conversion of Image Download Mode Enum value: %s", flag))
 	}
 }
+
+func ToGrpcHttpHeader(header api_type.HttpHeader) *rpc_api.HttpHeader {
+	return &rpc_api.HttpHeader{
+		Name:  header.Name,
+		Value: header.Value,
+	}
+}
@@ -204,3 +204,17 @@ func ToHttpRestartPolicy(policy rpc_api.RestartPolicy) api_type.RestartPolicy {
 		panic(fmt.Sprintf("Missing conversion of Restart Policy Enum value: %s", policy))
 	}
 }
+
+func ToHttpFilesArtifactVersion(version *rpc_api.FilesArtifactVersion) api_type.FilesArtifactVersion {
+	var createdAt *api_type.Timestamp
+	if version.CreatedAt != nil {
+		createdAtTime := version.CreatedAt.AsTime()
+		createdAt = &createdAtTime
+	}
+	return api_type.FilesArtifactVersion{
+		CreatedAt: createdAt,
+		FileUuid:  version.FileUuid,
+		IsLatest:  version.IsLatest,
+		Version:   int64(version.Version),
+	}
+}
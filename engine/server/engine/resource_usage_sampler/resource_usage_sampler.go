@@ -0,0 +1,173 @@
+package resource_usage_sampler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SamplingInterval = 15 * time.Second
+
+	// Only the latest samples are kept, enough to graph what happened during a run
+	retentionWindow = 10 * time.Minute
+)
+
+// enclaveLister is the part of the enclave manager the sampler uses
+type enclaveLister interface {
+	GetEnclaves(ctx context.Context) (map[string]*types.EnclaveInfo, error)
+}
+
+// resourceUsageGetter is the part of the Kurtosis backend the sampler uses
+type resourceUsageGetter interface {
+	GetUserServiceResourceUsage(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		map[service.ServiceUUID]*service.ResourceUsage,
+		map[service.ServiceUUID]error,
+		error,
+	)
+}
+
+// Sample is the resource usage of a service at some point in time
+type Sample struct {
+	Timestamp time.Time
+
+	Usage *service.ResourceUsage
+}
+
+// ResourceUsageSampler samples the resource usage of the running services of the running enclaves periodically, and
+// keeps the samples of the last few minutes in memory so that the UI can graph them
+type ResourceUsageSampler struct {
+	mutex sync.RWMutex
+
+	// Ordered from the oldest to the latest
+	samplesByServiceUuidByEnclaveUuid map[enclave.EnclaveUUID]map[service.ServiceUUID][]*Sample
+
+	enclaveManager enclaveLister
+
+	kurtosisBackend resourceUsageGetter
+}
+
+func NewResourceUsageSampler(enclaveManager enclaveLister, kurtosisBackend resourceUsageGetter) *ResourceUsageSampler {
+	return &ResourceUsageSampler{
+		mutex:                             sync.RWMutex{},
+		samplesByServiceUuidByEnclaveUuid: map[enclave.EnclaveUUID]map[service.ServiceUUID][]*Sample{},
+		enclaveManager:                    enclaveManager,
+		kurtosisBackend:                   kurtosisBackend,
+	}
+}
+
+// StartSampling samples the services now and then periodically, in the background
+func (sampler *ResourceUsageSampler) StartSampling(ctx context.Context) {
+	go func() {
+		logrus.Debugf("Scheduling the sampling of the resource usage of the services every '%v'...", SamplingInterval)
+		sampler.SampleServices(ctx)
+
+		samplingTicker := time.NewTicker(SamplingInterval)
+		defer samplingTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-samplingTicker.C:
+				sampler.SampleServices(ctx)
+			}
+		}
+	}()
+}
+
+// SampleServices takes a sample of every running service of the running enclaves. The samples of the services and
+// enclaves that stopped running are dropped
+func (sampler *ResourceUsageSampler) SampleServices(ctx context.Context) {
+	enclaves, err := sampler.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		logrus.Errorf("An error occurred getting the enclaves to sample the resource usage of their services; will retry in '%v':\n%v", SamplingInterval, err)
+		return
+	}
+	runningServicesFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: map[container.ContainerStatus]bool{container.ContainerStatus_Running: true},
+	}
+
+	newSamplesByEnclaveUuid := map[enclave.EnclaveUUID]map[service.ServiceUUID]*Sample{}
+	for enclaveUuidStr, enclaveInfo := range enclaves {
+		if enclaveInfo.EnclaveStatus != types.EnclaveStatus_RUNNING {
+			continue
+		}
+		enclaveUuid := enclave.EnclaveUUID(enclaveUuidStr)
+		resourceUsageByServiceUuid, erroredServiceUuids, err := sampler.kurtosisBackend.GetUserServiceResourceUsage(ctx, enclaveUuid, runningServicesFilters)
+		if err != nil {
+			logrus.Warnf("An error occurred sampling the resource usage of the services of enclave '%v':\n%v", enclaveUuid, err)
+			continue
+		}
+		for serviceUuid, err := range erroredServiceUuids {
+			logrus.Debugf("An error occurred sampling the resource usage of service '%v' of enclave '%v':\n%v", serviceUuid, enclaveUuid, err)
+		}
+		timestamp := time.Now()
+		newSamplesByEnclaveUuid[enclaveUuid] = map[service.ServiceUUID]*Sample{}
+		for serviceUuid, resourceUsage := range resourceUsageByServiceUuid {
+			newSamplesByEnclaveUuid[enclaveUuid][serviceUuid] = &Sample{
+				Timestamp: timestamp,
+				Usage:     resourceUsage,
+			}
+		}
+	}
+	sampler.addSamples(newSamplesByEnclaveUuid, time.Now())
+}
+
+// GetSamples returns the retained samples of each service of the enclave taken at or after the given time, ordered
+// from the oldest to the latest
+func (sampler *ResourceUsageSampler) GetSamples(enclaveUuid enclave.EnclaveUUID, since time.Time) map[service.ServiceUUID][]*Sample {
+	sampler.mutex.RLock()
+	defer sampler.mutex.RUnlock()
+
+	samplesByServiceUuid := map[service.ServiceUUID][]*Sample{}
+	for serviceUuid, samples := range sampler.samplesByServiceUuidByEnclaveUuid[enclaveUuid] {
+		samplesSince := samplesTakenSince(samples, since)
+		if len(samplesSince) > 0 {
+			samplesByServiceUuid[serviceUuid] = samplesSince
+		}
+	}
+	return samplesByServiceUuid
+}
+
+// addSamples replaces the series of the enclaves with the ones of the services that got a new sample, dropping the
+// samples older than the retention window
+func (sampler *ResourceUsageSampler) addSamples(newSamplesByEnclaveUuid map[enclave.EnclaveUUID]map[service.ServiceUUID]*Sample, now time.Time) {
+	sampler.mutex.Lock()
+	defer sampler.mutex.Unlock()
+
+	oldestRetainedTimestamp := now.Add(-retentionWindow)
+	samplesByServiceUuidByEnclaveUuid := map[enclave.EnclaveUUID]map[service.ServiceUUID][]*Sample{}
+	for enclaveUuid, newSampleByServiceUuid := range newSamplesByEnclaveUuid {
+		samplesByServiceUuid := map[service.ServiceUUID][]*Sample{}
+		for serviceUuid, newSample := range newSampleByServiceUuid {
+			previousSamples := samplesTakenSince(sampler.samplesByServiceUuidByEnclaveUuid[enclaveUuid][serviceUuid], oldestRetainedTimestamp)
+			// The previous samples are copied so that the slices returned by GetSamples never change
+			samples := make([]*Sample, 0, len(previousSamples)+1)
+			samples = append(samples, previousSamples...)
+			samplesByServiceUuid[serviceUuid] = append(samples, newSample)
+		}
+		samplesByServiceUuidByEnclaveUuid[enclaveUuid] = samplesByServiceUuid
+	}
+	sampler.samplesByServiceUuidByEnclaveUuid = samplesByServiceUuidByEnclaveUuid
+}
+
+func samplesTakenSince(samples []*Sample, since time.Time) []*Sample {
+	for idx, sample := range samples {
+		if !sample.Timestamp.Before(since) {
+			return samples[idx:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package resource_usage_sampler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	runningEnclaveUuid = enclave.EnclaveUUID("running-enclave-uuid")
+	stoppedEnclaveUuid = enclave.EnclaveUUID("stopped-enclave-uuid")
+
+	firstServiceUuid  = service.ServiceUUID("first-service-uuid")
+	secondServiceUuid = service.ServiceUUID("second-service-uuid")
+)
+
+type fakeEnclaveManager struct {
+	enclaves map[string]*types.EnclaveInfo
+}
+
+func (manager *fakeEnclaveManager) GetEnclaves(_ context.Context) (map[string]*types.EnclaveInfo, error) {
+	return manager.enclaves, nil
+}
+
+type fakeKurtosisBackend struct {
+	resourceUsageByServiceUuidByEnclaveUuid map[enclave.EnclaveUUID]map[service.ServiceUUID]*service.ResourceUsage
+}
+
+func (backend *fakeKurtosisBackend) GetUserServiceResourceUsage(
+	_ context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	_ *service.ServiceFilters,
+) (map[service.ServiceUUID]*service.ResourceUsage, map[service.ServiceUUID]error, error) {
+	return backend.resourceUsageByServiceUuidByEnclaveUuid[enclaveUuid], map[service.ServiceUUID]error{}, nil
+}
+
+func TestSampleServices(t *testing.T) {
+	enclaveManager := &fakeEnclaveManager{
+		enclaves: map[string]*types.EnclaveInfo{
+			string(runningEnclaveUuid): {EnclaveUuid: string(runningEnclaveUuid), EnclaveStatus: types.EnclaveStatus_RUNNING},
+			string(stoppedEnclaveUuid): {EnclaveUuid: string(stoppedEnclaveUuid), EnclaveStatus: types.EnclaveStatus_STOPPED},
+		},
+	}
+	kurtosisBackend := &fakeKurtosisBackend{
+		resourceUsageByServiceUuidByEnclaveUuid: map[enclave.EnclaveUUID]map[service.ServiceUUID]*service.ResourceUsage{
+			runningEnclaveUuid: {
+				firstServiceUuid:  service.NewResourceUsage(compute_resources.CpuMilliCores(100), 1_000, nil, service.NewNetworkUsage(10, 20)),
+				secondServiceUuid: service.NewResourceUsage(compute_resources.CpuMilliCores(200), 2_000, nil, nil),
+			},
+			stoppedEnclaveUuid: {
+				firstServiceUuid: service.NewResourceUsage(compute_resources.CpuMilliCores(300), 3_000, nil, nil),
+			},
+		},
+	}
+	sampler := NewResourceUsageSampler(enclaveManager, kurtosisBackend)
+
+	sampler.SampleServices(context.Background())
+	// The second service stops running
+	delete(kurtosisBackend.resourceUsageByServiceUuidByEnclaveUuid[runningEnclaveUuid], secondServiceUuid)
+	sampler.SampleServices(context.Background())
+
+	samplesByServiceUuid := sampler.GetSamples(runningEnclaveUuid, time.Time{})
+	require.Len(t, samplesByServiceUuid, 1)
+	require.Len(t, samplesByServiceUuid[firstServiceUuid], 2)
+	require.Equal(t, compute_resources.CpuMilliCores(100), samplesByServiceUuid[firstServiceUuid][1].Usage.GetCpuMilliCores())
+	require.Empty(t, sampler.GetSamples(stoppedEnclaveUuid, time.Time{}))
+}
+
+func TestAddSamples_DropsSamplesOutsideOfTheRetentionWindow(t *testing.T) {
+	sampler := NewResourceUsageSampler(&fakeEnclaveManager{enclaves: nil}, &fakeKurtosisBackend{resourceUsageByServiceUuidByEnclaveUuid: nil})
+	usage := service.NewResourceUsage(compute_resources.CpuMilliCores(100), 1_000, nil, nil)
+	start := time.Now()
+
+	newSample := func(timestamp time.Time) map[enclave.EnclaveUUID]map[service.ServiceUUID]*Sample {
+		return map[enclave.EnclaveUUID]map[service.ServiceUUID]*Sample{
+			runningEnclaveUuid: {firstServiceUuid: {Timestamp: timestamp, Usage: usage}},
+		}
+	}
+	sampler.addSamples(newSample(start), start)
+	sampler.addSamples(newSample(start.Add(retentionWindow/2)), start.Add(retentionWindow/2))
+	sampler.addSamples(newSample(start.Add(retentionWindow+time.Second)), start.Add(retentionWindow+time.Second))
+
+	samples := sampler.GetSamples(runningEnclaveUuid, time.Time{})[firstServiceUuid]
+	require.Len(t, samples, 2)
+	require.Equal(t, start.Add(retentionWindow/2), samples[0].Timestamp)
+
+	samplesSince := sampler.GetSamples(runningEnclaveUuid, start.Add(retentionWindow))[firstServiceUuid]
+	require.Len(t, samplesSince, 1)
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"net/http"
+
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_access"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierPathParam = "enclave_identifier"
+)
+
+// NewEnclaveAccessMiddleware rejects the calls to the REST API routes of an enclave the caller can't use. It must be
+// registered after the authentication middleware, which puts the caller in the request context
+func NewEnclaveAccessMiddleware(enclaveManager *enclave_manager.EnclaveManager, enclaveAccess *enclave_access.EnclaveAccessController) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			enclaveIdentifier := ctx.Param(enclaveIdentifierPathParam)
+			request := ctx.Request()
+			if enclaveIdentifier == "" || enclaveAccess.IsAdmin(request.Context()) {
+				return next(ctx)
+			}
+			enclaveUuid := enclaveIdentifier
+			// Identifiers that don't resolve are checked as UUIDs, so that the logs of destroyed enclaves stay restricted
+			if resolvedEnclaveUuid, err := enclaveManager.GetEnclaveUuidForEnclaveIdentifier(request.Context(), enclaveIdentifier); err == nil {
+				enclaveUuid = string(resolvedEnclaveUuid)
+			}
+			if err := enclaveAccess.CheckAccess(request.Context(), enclaveUuid); err != nil {
+				logrus.Debugf("Rejected call to '%v %v':\n%v", request.Method, request.URL.Path, err)
+				return ctx.JSON(http.StatusForbidden, api_type.ResponseInfo{
+					Code:    http.StatusForbidden,
+					Message: err.Error(),
+					Type:    api_type.ERROR,
+				})
+			}
+			return next(ctx)
+		}
+	}
+}
@@ -8,6 +8,10 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/api_container_tls"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/fips_mode"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_certificates"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_grpc"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
@@ -18,6 +22,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -37,9 +42,10 @@ type enclaveRuntime struct {
 	ctx                      context.Context
 	lock                     sync.Mutex
 	asyncStarlarkLogs        streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine]
+	certificateIssuer        *enclave_certificates.EnclaveCertificateIssuer
 }
 
-func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManager, asyncStarlarkLogs streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine], connectOnHostMachine bool) (*enclaveRuntime, error) {
+func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManager, asyncStarlarkLogs streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine], connectOnHostMachine bool, certificateIssuer *enclave_certificates.EnclaveCertificateIssuer) (*enclaveRuntime, error) {
 
 	runtime := enclaveRuntime{
 		enclaveManager:           manager,
@@ -48,6 +54,7 @@ func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManag
 		ctx:                      ctx,
 		asyncStarlarkLogs:        asyncStarlarkLogs,
 		lock:                     sync.Mutex{},
+		certificateIssuer:        certificateIssuer,
 	}
 
 	err := runtime.refreshEnclaveConnections()
@@ -177,9 +184,14 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierArtifactsRemoteFile(
 	}
 	logrus.Infof("Uploading file artifact to enclave %s", enclaveIdentifier)
 
+	headers := utils.MapList(utils.DerefWith(request.Body.Headers, []api_type.HttpHeader{}), to_grpc.ToGrpcHttpHeader)
 	storeWebFilesArtifactArgs := rpc_api.StoreWebFilesArtifactArgs{
-		Url:  request.Body.Url,
-		Name: request.Body.Name,
+		Url:               request.Body.Url,
+		Name:              request.Body.Name,
+		Headers:           headers,
+		BasicAuthUsername: request.Body.BasicAuthUsername,
+		BasicAuthPassword: request.Body.BasicAuthPassword,
+		ContentSha256:     request.Body.ContentSha256,
 	}
 	storedArtifact, err := (*apiContainerClient).StoreWebFilesArtifact(ctx, &storeWebFilesArtifactArgs)
 	if err != nil {
@@ -290,6 +302,31 @@ func (manager *enclaveRuntime) GetEnclavesEnclaveIdentifierArtifactsArtifactIden
 	return response, nil
 }
 
+// (GET /enclaves/{enclave_identifier}/artifacts/{artifact_identifier}/history)
+func (manager *enclaveRuntime) GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory(ctx context.Context, request api.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryRequestObject) (api.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistoryResponseObject, error) {
+	enclaveIdentifier := request.EnclaveIdentifier
+	artifactIdentifier := request.ArtifactIdentifier
+	apiContainerClient, responseErr := manager.getApiClientOrResponseError(enclaveIdentifier)
+	if responseErr != nil {
+		return api.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistorydefaultJSONResponse{Body: *responseErr, StatusCode: int(responseErr.Code)}, nil
+	}
+	logrus.Infof("Getting the history of file artifact %s on enclave %s", artifactIdentifier, enclaveIdentifier)
+
+	getFilesArtifactHistoryArgs := rpc_api.GetFilesArtifactHistoryArgs{
+		Identifier: artifactIdentifier,
+	}
+	history, err := (*apiContainerClient).GetFilesArtifactHistory(ctx, &getFilesArtifactHistoryArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Can't get the history of artifact %s using gRPC call with enclave %s", artifactIdentifier, enclaveIdentifier)
+	}
+
+	response := api_type.FilesArtifactHistory{
+		FileName: history.FileName,
+		Versions: utils.MapList(history.Versions, to_http.ToHttpFilesArtifactVersion),
+	}
+	return api.GetEnclavesEnclaveIdentifierArtifactsArtifactIdentifierHistory200JSONResponse(response), nil
+}
+
 // (GET /enclaves/{enclave_identifier}/services)
 func (manager *enclaveRuntime) GetEnclavesEnclaveIdentifierServices(ctx context.Context, request api.GetEnclavesEnclaveIdentifierServicesRequestObject) (api.GetEnclavesEnclaveIdentifierServicesResponseObject, error) {
 	enclaveIdentifier := request.EnclaveIdentifier
@@ -647,6 +684,40 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkPackagesPack
 	return api.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageId200JSONResponse(response), nil
 }
 
+// (POST /enclaves/{enclave_identifier}/starlark/packages/{package_id}/plan)
+func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan(ctx context.Context, request api.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanRequestObject) (api.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlanResponseObject, error) {
+	enclaveIdentifier := request.EnclaveIdentifier
+	apiContainerClient, responseErr := manager.getApiClientOrResponseError(enclaveIdentifier)
+	if responseErr != nil {
+		return api.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlandefaultJSONResponse{Body: *responseErr, StatusCode: int(responseErr.Code)}, nil
+	}
+
+	packageId := request.PackageId
+	// The gRPC always expect a JSON object even though it's marked as optional, so we need to default to `{}``
+	jsonParams := utils.DerefWith(request.Body.Params, map[string]interface{}{})
+	jsonBlob, err := json.Marshal(jsonParams)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "I'm actually panicking here. Re-serializing a already deserialized JSON parameter should never fail.")
+	}
+	jsonString := string(jsonBlob)
+
+	logrus.Infof("Getting the plan of Starlark package `%s` on enclave %s", packageId, enclaveIdentifier)
+	starlarkPackagePlanYamlArgs := rpc_api.StarlarkPackagePlanYamlArgs{
+		PackageId:              packageId,
+		SerializedParams:       &jsonString,
+		IsRemote:               utils.DerefWith(request.Body.ClonePackage, false),
+		RelativePathToMainFile: request.Body.RelativePathToMainFile,
+		MainFunctionName:       request.Body.MainFunctionName,
+	}
+	planYaml, err := (*apiContainerClient).GetStarlarkPackagePlanYaml(ctx, &starlarkPackagePlanYamlArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Can't get the plan of Starlark package %s using gRPC call with enclave %s", packageId, enclaveIdentifier)
+	}
+
+	response := api_type.PlanYaml{PlanYaml: planYaml.PlanYaml}
+	return api.PostEnclavesEnclaveIdentifierStarlarkPackagesPackageIdPlan200JSONResponse(response), nil
+}
+
 // (POST /enclaves/{enclave_identifier}/starlark/scripts)
 func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx context.Context, request api.PostEnclavesEnclaveIdentifierStarlarkScriptsRequestObject) (api.PostEnclavesEnclaveIdentifierStarlarkScriptsResponseObject, error) {
 	enclaveIdentifier := request.EnclaveIdentifier
@@ -710,13 +781,44 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx
 	return api.PostEnclavesEnclaveIdentifierStarlarkScripts200JSONResponse(response), nil
 }
 
+// (POST /enclaves/{enclave_identifier}/starlark/scripts/plan)
+func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScriptsPlan(ctx context.Context, request api.PostEnclavesEnclaveIdentifierStarlarkScriptsPlanRequestObject) (api.PostEnclavesEnclaveIdentifierStarlarkScriptsPlanResponseObject, error) {
+	enclaveIdentifier := request.EnclaveIdentifier
+	apiContainerClient, responseErr := manager.getApiClientOrResponseError(enclaveIdentifier)
+	if responseErr != nil {
+		return api.PostEnclavesEnclaveIdentifierStarlarkScriptsPlandefaultJSONResponse{Body: *responseErr, StatusCode: int(responseErr.Code)}, nil
+	}
+	logrus.Infof("Getting the plan of Starlark script on enclave %s", enclaveIdentifier)
+
+	jsonString, err := utils.MapPointerWithError(request.Body.Params, func(v map[string]interface{}) (string, error) {
+		jsonBlob, err := json.Marshal(v)
+		return string(jsonBlob), err
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "I'm actually panicking here. Re-serializing a already deserialized JSON parameter should never fail.")
+	}
+
+	starlarkScriptPlanYamlArgs := rpc_api.StarlarkScriptPlanYamlArgs{
+		SerializedScript: request.Body.SerializedScript,
+		SerializedParams: jsonString,
+		MainFunctionName: request.Body.MainFunctionName,
+	}
+	planYaml, err := (*apiContainerClient).GetStarlarkScriptPlanYaml(ctx, &starlarkScriptPlanYamlArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Can't get the plan of Starlark script using gRPC call with enclave %s", enclaveIdentifier)
+	}
+
+	response := api_type.PlanYaml{PlanYaml: planYaml.PlanYaml}
+	return api.PostEnclavesEnclaveIdentifierStarlarkScriptsPlan200JSONResponse(response), nil
+}
+
 // ===============================================================================================================
 // ===================================== Internal Functions =====================================================
 // ===============================================================================================================
 
 // GetGrpcClientConn returns a client conn dialed in to the local port
 // It is the caller's responsibility to call resultClientConn.close()
-func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool) (resultClientConn *grpc.ClientConn, resultErr error) {
+func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool, certificateIssuer *enclave_certificates.EnclaveCertificateIssuer) (resultClientConn *grpc.ClientConn, resultErr error) {
 	enclaveAPIContainerInfo := enclaveInfo.ApiContainerInfo
 	if enclaveAPIContainerInfo == nil {
 		logrus.Infof("No API container info is available for enclave %s", enclaveInfo.EnclaveUuid)
@@ -735,8 +837,14 @@ func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool)
 		apiContainerIP = enclaveAPIContainerHostMachineInfo.IpOnHostMachine
 	}
 
+	transportCredentials, err := getApiContainerTransportCredentials(enclaveInfo.EnclaveUuid, certificateIssuer)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the transport credentials of the API container of enclave %s", enclaveInfo.EnclaveUuid)
+	}
+
 	grpcServerAddress := fmt.Sprintf("%v:%v", apiContainerIP, apiContainerGrpcPort)
-	grpcConnection, err := grpc.Dial(grpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}, kurtosis_context.GetTracingDialOptions()...)
+	grpcConnection, err := grpc.Dial(grpcServerAddress, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", grpcServerAddress)
 	}
@@ -744,6 +852,23 @@ func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool)
 	return grpcConnection, nil
 }
 
+// getApiContainerTransportCredentials authenticates the engine to the API container of the enclave if it requires mutual
+// TLS
+func getApiContainerTransportCredentials(enclaveUuid string, certificateIssuer *enclave_certificates.EnclaveCertificateIssuer) (credentials.TransportCredentials, error) {
+	clientCredentials, err := certificateIssuer.GetClientCredentials(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the client credentials of enclave %s", enclaveUuid)
+	}
+	if clientCredentials == nil {
+		return insecure.NewCredentials(), nil
+	}
+	transportCredentials, err := api_container_tls.NewClientTransportCredentials(clientCredentials.CertificateAuthorityPem, clientCredentials.CertificatePem, clientCredentials.KeyPem, fips_mode.IsEnabled())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the TLS credentials of enclave %s", enclaveUuid)
+	}
+	return transportCredentials, nil
+}
+
 func (manager *enclaveRuntime) getApiClientOrResponseError(enclaveUuid string) (*rpc_api.ApiContainerServiceClient, *api_type.ResponseInfo) {
 	client, err := manager.getGrpcClientForEnclaveUUID(enclaveUuid)
 	if err != nil {
@@ -798,7 +923,7 @@ func (runtime *enclaveRuntime) refreshEnclaveConnections() error {
 	for uuid, info := range enclaves {
 		_, found := runtime.remoteApiContainerClient[uuid]
 		if !found && info != nil {
-			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine)
+			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine, runtime.certificateIssuer)
 			if err != nil {
 				return stacktrace.Propagate(err, "Failed to establish gRPC connection with enclave manager service on enclave %s", uuid)
 			}
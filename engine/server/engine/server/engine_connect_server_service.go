@@ -2,27 +2,49 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/fips_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/egress_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	engine_args "github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_version"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/config_reloader"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_access"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_certificates"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_reaper"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_health"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/resource_usage_sampler"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	subnetworkDisableBecauseItIsDeprecated = false
+
+	// Zero-padded so that the keys sort by creation time
+	enclaveSortKeyFormat = "%020d/%v"
 )
 
 type EngineConnectServerService struct {
@@ -41,6 +63,27 @@ type EngineConnectServerService struct {
 	logsDatabaseClient centralized_logs.LogsDatabaseClient
 
 	metricsClient metrics_client.MetricsClient
+
+	// Decides which enclaves the caller can see and use
+	enclaveAccessController *enclave_access.EnclaveAccessController
+
+	// Destroys the enclaves once their TTL is over
+	enclaveReaper *enclave_reaper.EnclaveReaper
+
+	// Applies changes to the engine config without restarting the engine
+	configReloader *config_reloader.ConfigReloader
+
+	// Where the mutating calls to the engine APIs are recorded
+	auditLog *audit_log.AuditLog
+
+	// Keeps the latest resource usage samples of the running services
+	resourceUsageSampler *resource_usage_sampler.ResourceUsageSampler
+
+	// Checks the subsystems the engine relies on
+	engineHealthChecker *engine_health.EngineHealthChecker
+
+	// Issues the credentials the callers that can use an enclave call its API container with
+	certificateIssuer *enclave_certificates.EnclaveCertificateIssuer
 }
 
 func NewEngineConnectServerService(
@@ -50,6 +93,13 @@ func NewEngineConnectServerService(
 	didUserAcceptSendingMetrics bool,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	metricsClient metrics_client.MetricsClient,
+	enclaveAccessController *enclave_access.EnclaveAccessController,
+	enclaveReaper *enclave_reaper.EnclaveReaper,
+	configReloader *config_reloader.ConfigReloader,
+	auditLog *audit_log.AuditLog,
+	resourceUsageSampler *resource_usage_sampler.ResourceUsageSampler,
+	engineHealthChecker *engine_health.EngineHealthChecker,
+	certificateIssuer *enclave_certificates.EnclaveCertificateIssuer,
 ) *EngineConnectServerService {
 	service := &EngineConnectServerService{
 		imageVersionTag:             imageVersionTag,
@@ -58,6 +108,13 @@ func NewEngineConnectServerService(
 		didUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
 		logsDatabaseClient:          logsDatabaseClient,
 		metricsClient:               metricsClient,
+		enclaveAccessController:     enclaveAccessController,
+		enclaveReaper:               enclaveReaper,
+		configReloader:              configReloader,
+		auditLog:                    auditLog,
+		resourceUsageSampler:        resourceUsageSampler,
+		engineHealthChecker:         engineHealthChecker,
+		certificateIssuer:           certificateIssuer,
 	}
 	return service
 }
@@ -75,6 +132,19 @@ func toGrpcEnclaveStatus(status types.EnclaveStatus) kurtosis_engine_rpc_api_bin
 	}
 }
 
+func toGrpcSubsystemHealthStatus(status engine_health.HealthStatus) kurtosis_engine_rpc_api_bindings.SubsystemHealthStatus {
+	switch status {
+	case engine_health.HealthStatus_Healthy:
+		return kurtosis_engine_rpc_api_bindings.SubsystemHealthStatus_SubsystemHealthStatus_HEALTHY
+	case engine_health.HealthStatus_Degraded:
+		return kurtosis_engine_rpc_api_bindings.SubsystemHealthStatus_SubsystemHealthStatus_DEGRADED
+	case engine_health.HealthStatus_Unhealthy:
+		return kurtosis_engine_rpc_api_bindings.SubsystemHealthStatus_SubsystemHealthStatus_UNHEALTHY
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %v", status))
+	}
+}
+
 func toGrpcContainerStatus(status types.ContainerStatus) kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus {
 	switch status {
 	case types.ContainerStatus_NONEXISTENT:
@@ -108,6 +178,23 @@ func toGrpcTimestamp(timestamp time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(timestamp)
 }
 
+func toGrpcResourceUsageSample(sample *resource_usage_sampler.Sample) *kurtosis_engine_rpc_api_bindings.ResourceUsageSample {
+	grpcSample := &kurtosis_engine_rpc_api_bindings.ResourceUsageSample{
+		Timestamp:      toGrpcTimestamp(sample.Timestamp),
+		CpuMilliCores:  uint64(sample.Usage.GetCpuMilliCores()),
+		MemoryBytes:    sample.Usage.GetMemoryBytes(),
+		NetworkRxBytes: nil,
+		NetworkTxBytes: nil,
+	}
+	if networkUsage := sample.Usage.GetMaybeNetworkUsage(); networkUsage != nil {
+		rxBytes := networkUsage.GetRxBytes()
+		txBytes := networkUsage.GetTxBytes()
+		grpcSample.NetworkRxBytes = &rxBytes
+		grpcSample.NetworkTxBytes = &txBytes
+	}
+	return grpcSample
+}
+
 func toGrpcEnclaveMode(mode types.EnclaveMode) kurtosis_engine_rpc_api_bindings.EnclaveMode {
 	switch mode {
 	case types.EnclaveMode_PRODUCTION:
@@ -152,11 +239,81 @@ func toGrpcEnclaveNameAndUuid(identifier types.EnclaveNameAndUuid) kurtosis_engi
 
 func (service *EngineConnectServerService) GetEngineInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse], error) {
 	result := &kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{
+		EngineVersion:   service.imageVersionTag,
+		FipsMode:        fips_mode.IsEnabled(),
+		ValidatedCrypto: fips_mode.IsValidatedCryptoInUse(),
+	}
+	return connect.NewResponse(result), nil
+}
+
+func (service *EngineConnectServerService) NegotiateApiVersion(_ context.Context, args *connect.Request[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse], error) {
+	isCompatible, message := api_version.CheckCompatibility(service.imageVersionTag, args.Msg.GetClientVersion())
+	result := &kurtosis_engine_rpc_api_bindings.NegotiateApiVersionResponse{
 		EngineVersion: service.imageVersionTag,
+		IsCompatible:  isCompatible,
+		Message:       message,
 	}
 	return connect.NewResponse(result), nil
 }
 
+func (service *EngineConnectServerService) GetEngineHealth(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse], error) {
+	subsystemsHealth := service.engineHealthChecker.CheckHealth(ctx)
+	response := &kurtosis_engine_rpc_api_bindings.GetEngineHealthResponse{
+		Status:     toGrpcSubsystemHealthStatus(engine_health.GetOverallStatus(subsystemsHealth)),
+		Subsystems: []*kurtosis_engine_rpc_api_bindings.SubsystemHealth{},
+	}
+	for _, subsystemHealth := range subsystemsHealth {
+		response.Subsystems = append(response.Subsystems, &kurtosis_engine_rpc_api_bindings.SubsystemHealth{
+			Name:     subsystemHealth.Name,
+			Instance: subsystemHealth.Instance,
+			Status:   toGrpcSubsystemHealthStatus(subsystemHealth.Status),
+			Message:  subsystemHealth.Message,
+		})
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) UpdateEngineConfig(_ context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEngineConfigArgs]) (*connect.Response[emptypb.Empty], error) {
+	var config engine_args.ReloadableEngineConfig
+	if err := json.Unmarshal([]byte(connectArgs.Msg.GetConfigJson()), &config); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, stacktrace.Propagate(err, "An error occurred unmarshalling the engine config"))
+	}
+	if err := service.configReloader.UpdateConfig(config); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the engine config")
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (service *EngineConnectServerService) GetAuditLog(_ context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error) {
+	args := connectArgs.Msg
+	filters := audit_log.Filters{
+		Since:     time.Time{},
+		Principal: args.GetPrincipal(),
+		Limit:     int(args.GetLimit()),
+	}
+	if args.GetSince() != nil {
+		filters.Since = args.GetSince().AsTime()
+	}
+	entries, err := service.auditLog.Query(filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred querying the audit log")
+	}
+	response := &kurtosis_engine_rpc_api_bindings.GetAuditLogResponse{
+		Entries: []*kurtosis_engine_rpc_api_bindings.AuditLogEntry{},
+	}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, &kurtosis_engine_rpc_api_bindings.AuditLogEntry{
+			Timestamp:  toGrpcTimestamp(entry.Timestamp),
+			Principal:  entry.Principal,
+			Api:        entry.Api,
+			Operation:  entry.Operation,
+			ArgsDigest: entry.ArgsDigest,
+			Result:     entry.Result,
+		})
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error) {
 	args := connectArgs.Msg
 
@@ -170,8 +327,21 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		return nil, stacktrace.Propagate(err, "An error occurred parsing the log level string '%v':", args.ApiContainerLogLevel)
 	}
 
+	// Validated before creating the enclave so that an invalid TTL doesn't leave an enclave behind
+	if _, err = engine_args.ParseEnclaveTtl(args.GetTtl()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, stacktrace.Propagate(err, "An error occurred validating the TTL of new enclave '%v'", args.GetEnclaveName()))
+	}
+
 	isProduction := args.GetMode() == kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION
 
+	var egressPolicy *egress_policy.EgressPolicy
+	if args.GetShouldRestrictEgress() || len(args.GetEgressAllowlist()) > 0 {
+		egressPolicy, err = egress_policy.NewEgressPolicyFromAllowlist(args.GetEgressAllowlist())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, stacktrace.Propagate(err, "An error occurred validating the egress allowlist of new enclave '%v'", args.GetEnclaveName()))
+		}
+	}
+
 	enclaveInfo, err := service.enclaveManager.CreateEnclave(
 		ctx,
 		service.imageVersionTag,
@@ -180,14 +350,22 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		args.GetEnclaveName(),
 		isProduction,
 		args.GetShouldApicRunInDebugMode(),
+		args.GetClusterName(),
+		args.GetShouldSkipLogsCollection(),
+		egressPolicy,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new enclave with name '%v'", args.GetEnclaveName())
 	}
+	if err = service.enclaveAccessController.RecordOwner(ctx, enclaveInfo.EnclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "Enclave '%v' was created but an error occurred recording its owner; only admins will be able to use it", enclaveInfo.Name)
+	}
+	if err = service.enclaveReaper.SetTtl(enclaveInfo.EnclaveUuid, args.GetTtl()); err != nil {
+		return nil, stacktrace.Propagate(err, "Enclave '%v' was created but an error occurred setting its TTL; it won't expire", enclaveInfo.Name)
+	}
 
-	grpcEnclaveInfo := toGrpcEnclaveInfo(*enclaveInfo)
 	response := &kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse{
-		EnclaveInfo: &grpcEnclaveInfo,
+		EnclaveInfo: service.toGrpcEnclaveInfoWithEngineState(enclaveInfo),
 	}
 
 	return connect.NewResponse(response), nil
@@ -198,23 +376,68 @@ func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, _ *c
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves")
 	}
+	for enclaveUuid := range infoForEnclaves {
+		if !service.enclaveAccessController.CanAccess(ctx, enclaveUuid) {
+			delete(infoForEnclaves, enclaveUuid)
+		}
+	}
 	response := &kurtosis_engine_rpc_api_bindings.GetEnclavesResponse{
 		EnclaveInfo: utils.MapMapValues(
 			infoForEnclaves,
-			func(info *types.EnclaveInfo) *kurtosis_engine_rpc_api_bindings.EnclaveInfo {
-				return utils.MapPointer(info, toGrpcEnclaveInfo)
-			})}
+			service.toGrpcEnclaveInfoWithEngineState,
+		)}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) ListEnclaves(ctx context.Context, args *connect.Request[kurtosis_engine_rpc_api_bindings.ListEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ListEnclavesResponse], error) {
+	infoForEnclaves, err := service.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves")
+	}
+	filteredEnclaveInfosBySortKey := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for enclaveUuid, enclaveInfo := range infoForEnclaves {
+		if !service.enclaveAccessController.CanAccess(ctx, enclaveUuid) {
+			continue
+		}
+		grpcEnclaveInfo := service.toGrpcEnclaveInfoWithEngineState(enclaveInfo)
+		if !doesEnclaveInfoMatchFilters(grpcEnclaveInfo, args.Msg.GetStatuses(), args.Msg.Owner) {
+			continue
+		}
+		filteredEnclaveInfosBySortKey[getEnclaveInfoSortKey(grpcEnclaveInfo)] = grpcEnclaveInfo
+	}
+	sortedEnclaveSortKeys := maps.Keys(filteredEnclaveInfosBySortKey)
+	sort.Strings(sortedEnclaveSortKeys)
+
+	pageEnclaveSortKeys, nextPageToken, err := shared_utils.GetPage(sortedEnclaveSortKeys, args.Msg.GetPageSize(), args.Msg.GetPageToken())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the requested page of enclaves")
+	}
+	response := &kurtosis_engine_rpc_api_bindings.ListEnclavesResponse{
+		EnclaveInfos: utils.MapList(pageEnclaveSortKeys, func(sortKey string) *kurtosis_engine_rpc_api_bindings.EnclaveInfo {
+			return filteredEnclaveInfosBySortKey[sortKey]
+		}),
+		NextPageToken: nil,
+	}
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
 	return connect.NewResponse(response), nil
 }
 
-func (service *EngineConnectServerService) GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error) {
+func (service *EngineConnectServerService) GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error) {
 	allIdentifiers, err := service.enclaveManager.GetExistingAndHistoricalEnclaveIdentifiers()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while fetching enclave identifiers")
 	}
+	accessibleIdentifiers := []*types.EnclaveIdentifiers{}
+	for _, identifier := range allIdentifiers {
+		if service.enclaveAccessController.CanAccess(ctx, identifier.EnclaveUuid) {
+			accessibleIdentifiers = append(accessibleIdentifiers, identifier)
+		}
+	}
 	response := &kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse{
 		AllIdentifiers: utils.MapList(
-			allIdentifiers,
+			accessibleIdentifiers,
 			func(identifier *types.EnclaveIdentifiers) *kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers {
 				return utils.MapPointer(identifier, toGrpcEnclaveIdentifiers)
 			})}
@@ -229,6 +452,10 @@ func (service *EngineConnectServerService) StopEnclave(ctx context.Context, conn
 		logrus.Warnf("An error occurred while logging the stop enclave event for enclave '%v'", enclaveIdentifier)
 	}
 
+	if err := service.checkEnclaveAccess(ctx, enclaveIdentifier); err != nil {
+		return nil, err
+	}
+
 	if err := service.enclaveManager.StopEnclave(ctx, enclaveIdentifier); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred stopping enclave '%v'", enclaveIdentifier)
 	}
@@ -244,19 +471,74 @@ func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, c
 		logrus.Warnf("An error occurred while logging the destroy enclave event for enclave '%v'", enclaveIdentifier)
 	}
 
+	if err := service.checkEnclaveAccess(ctx, enclaveIdentifier); err != nil {
+		return nil, err
+	}
+
 	if err := service.enclaveManager.DestroyEnclave(ctx, enclaveIdentifier); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred destroying enclave with identifier '%v':", args.EnclaveIdentifier)
 	}
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (service *EngineConnectServerService) ShareEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.ShareEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.GetEnclaveIdentifier()
+	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	access, err := service.enclaveAccessController.Share(ctx, string(enclaveUuid), args.GetPrincipal(), args.GetRevoke())
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, stacktrace.Propagate(err, "An error occurred sharing enclave '%v' with '%v'", enclaveIdentifier, args.GetPrincipal()))
+	}
+	response := &kurtosis_engine_rpc_api_bindings.ShareEnclaveResponse{
+		Owner:    access.Owner,
+		Grantees: access.Grantees,
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) GetApiContainerTlsCredentials(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetApiContainerTlsCredentialsArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials], error) {
+	enclaveIdentifier := connectArgs.Msg.GetEnclaveIdentifier()
+	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	if err = service.enclaveAccessController.CheckAccess(ctx, string(enclaveUuid)); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	callerName := audit_log.UnauthenticatedPrincipal
+	if principal := auth.GetPrincipalFromContext(ctx); principal != nil {
+		callerName = principal.Name
+	}
+	clientCredentials, err := service.certificateIssuer.IssueCallerClientCredentials(string(enclaveUuid), callerName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred issuing the credentials of the API container of enclave '%v'", enclaveIdentifier)
+	}
+	if clientCredentials == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, stacktrace.NewError("The API container of enclave '%v' doesn't require mutual TLS, it's called without credentials", enclaveIdentifier))
+	}
+	response := &kurtosis_engine_rpc_api_bindings.ApiContainerTlsCredentials{
+		CertificateAuthority: clientCredentials.CertificateAuthorityPem,
+		ClientCertificate:    clientCredentials.CertificatePem,
+		ClientKey:            clientCredentials.KeyPem,
+		FipsMode:             fips_mode.IsEnabled(),
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) Clean(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	args := connectArgs.Msg
-	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.GetShouldCleanAll())
+	canAccessEnclave := func(enclaveUuid enclave.EnclaveUUID) bool {
+		return service.enclaveAccessController.CanAccess(ctx, string(enclaveUuid))
+	}
+	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.GetShouldCleanAll(), canAccessEnclave)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while cleaning enclaves")
 	}
-	if args.GetShouldCleanAll() {
+	// The logs of the enclaves of other principals are only removed by admins
+	if args.GetShouldCleanAll() && service.enclaveAccessController.IsAdmin(ctx) {
 		if err = service.logsDatabaseClient.RemoveAllLogs(); err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred removing all logs.")
 		}
@@ -274,15 +556,25 @@ func (service *EngineConnectServerService) Clean(ctx context.Context, connectArg
 func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], stream *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error {
 	args := connectArgs.Msg
 	enclaveIdentifier := args.GetEnclaveIdentifier()
-	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(context.Background(), enclaveIdentifier)
 
 	contextWithCancel, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	if err != nil {
-		logrus.Errorf("An error occurred while fetching uuid for enclave '%v'. This could happen if the enclave has been deleted. Treating it as UUID", enclaveIdentifier)
-		enclaveUuid = enclave.EnclaveUUID(enclaveIdentifier)
+	// The system logs of the nodes are streamed like the logs of an enclave, the nodes being its services
+	enclaveUuid := enclave.EnclaveUUID(logs_collector.SystemLogsStreamId)
+	if enclaveIdentifier != logs_collector.SystemLogsStreamId {
+		var err error
+		enclaveUuid, err = service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(context.Background(), enclaveIdentifier)
+		if err != nil {
+			logrus.Errorf("An error occurred while fetching uuid for enclave '%v'. This could happen if the enclave has been deleted. Treating it as UUID", enclaveIdentifier)
+			enclaveUuid = enclave.EnclaveUUID(enclaveIdentifier)
+		}
+	}
+	if err := service.enclaveAccessController.CheckAccess(ctx, string(enclaveUuid)); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, err)
 	}
+	untrackLogStream := engine_metrics.TrackLogStream(engine_metrics.GrpcApi)
+	defer untrackLogStream()
 	serviceUuidStrSet := args.GetServiceUuidSet()
 	requestedServiceUuids := make(map[user_service.ServiceUUID]bool, len(serviceUuidStrSet))
 	shouldFollowLogs := args.GetFollowLogs()
@@ -371,6 +663,37 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 	}
 }
 
+func (service *EngineConnectServerService) GetServiceResourceUsage(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.GetEnclaveIdentifier()
+	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	if err = service.enclaveAccessController.CheckAccess(ctx, string(enclaveUuid)); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	since := time.Time{}
+	if args.GetSince() != nil {
+		since = args.GetSince().AsTime()
+	}
+
+	response := &kurtosis_engine_rpc_api_bindings.GetServiceResourceUsageResponse{
+		ResourceUsageByServiceUuid: map[string]*kurtosis_engine_rpc_api_bindings.ResourceUsageSeries{},
+		SamplingIntervalSeconds:    uint32(resource_usage_sampler.SamplingInterval.Seconds()),
+	}
+	for serviceUuid, samples := range service.resourceUsageSampler.GetSamples(enclaveUuid, since) {
+		series := &kurtosis_engine_rpc_api_bindings.ResourceUsageSeries{
+			Samples: []*kurtosis_engine_rpc_api_bindings.ResourceUsageSample{},
+		}
+		for _, sample := range samples {
+			series.Samples = append(series.Samples, toGrpcResourceUsageSample(sample))
+		}
+		response.ResourceUsageByServiceUuid[string(serviceUuid)] = series
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) Close() error {
 	if err := service.enclaveManager.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the enclave manager")
@@ -378,6 +701,56 @@ func (service *EngineConnectServerService) Close() error {
 	return nil
 }
 
+func doesEnclaveInfoMatchFilters(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, statuses []kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus, maybeOwner *string) bool {
+	if maybeOwner != nil && enclaveInfo.GetOwner() != *maybeOwner {
+		return false
+	}
+	return len(statuses) == 0 || slices.Contains(statuses, enclaveInfo.GetContainersStatus())
+}
+
+// getEnclaveInfoSortKey orders the enclaves by creation time, the same way as 'kurtosis enclave ls', the UUID making
+// the key unique; the enclaves created before the creation time was tracked come first
+func getEnclaveInfoSortKey(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) string {
+	creationTimeUnixNano := int64(0)
+	if enclaveInfo.GetCreationTime() != nil {
+		creationTimeUnixNano = enclaveInfo.GetCreationTime().AsTime().UnixNano()
+	}
+	return fmt.Sprintf(enclaveSortKeyFormat, creationTimeUnixNano, enclaveInfo.GetEnclaveUuid())
+}
+
+// toGrpcEnclaveInfoWithEngineState adds what only the engine knows about the enclave, its owner, when it expires and
+// whether its API container requires mutual TLS. It must only be used for the enclaves the caller can use
+func (service *EngineConnectServerService) toGrpcEnclaveInfoWithEngineState(info *types.EnclaveInfo) *kurtosis_engine_rpc_api_bindings.EnclaveInfo {
+	grpcEnclaveInfo := utils.MapPointer(info, toGrpcEnclaveInfo)
+	grpcEnclaveInfo.Owner = service.enclaveAccessController.GetOwner(info.EnclaveUuid)
+	if expirationTime := service.enclaveReaper.GetExpirationTime(info.EnclaveUuid); expirationTime != nil {
+		grpcEnclaveInfo.ExpirationTime = toGrpcTimestamp(*expirationTime)
+	}
+	if clusterName, found := service.enclaveManager.GetEnclaveCluster(info.EnclaveUuid); found {
+		grpcEnclaveInfo.ClusterName = &clusterName
+	}
+	isApiContainerMtlsRequired, err := service.certificateIssuer.IsMutualTlsRequired(info.EnclaveUuid)
+	if err != nil {
+		// The clients then fail to call the API container rather than calling it without TLS
+		logrus.Warnf("An error occurred checking whether the API container of enclave '%v' requires mutual TLS, its clients are told that it does:\n%v", info.EnclaveUuid, err)
+		isApiContainerMtlsRequired = true
+	}
+	grpcEnclaveInfo.IsApiContainerMtlsRequired = isApiContainerMtlsRequired
+	return grpcEnclaveInfo
+}
+
+// checkEnclaveAccess returns a permission denied error if the caller can't use the enclave
+func (service *EngineConnectServerService) checkEnclaveAccess(ctx context.Context, enclaveIdentifier string) error {
+	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	if err = service.enclaveAccessController.CheckAccess(ctx, string(enclaveUuid)); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return nil
+}
+
 func (service *EngineConnectServerService) reportAnyMissingUuidsAndGetNotFoundUuidsList(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
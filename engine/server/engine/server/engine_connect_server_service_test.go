@@ -0,0 +1,52 @@
+package server
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetEnclaveInfoSortKey_OrdersByCreationTime(t *testing.T) {
+	now := time.Now()
+	newEnclave := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{EnclaveUuid: "a", CreationTime: timestamppb.New(now)}                 //nolint:exhaustruct
+	oldEnclave := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{EnclaveUuid: "b", CreationTime: timestamppb.New(now.Add(-time.Hour))} //nolint:exhaustruct
+	enclaveWithoutCreationTime := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{EnclaveUuid: "c"}                                     //nolint:exhaustruct
+
+	sortKeys := []string{
+		getEnclaveInfoSortKey(newEnclave),
+		getEnclaveInfoSortKey(oldEnclave),
+		getEnclaveInfoSortKey(enclaveWithoutCreationTime),
+	}
+	sort.Strings(sortKeys)
+
+	expectedSortKeys := []string{
+		getEnclaveInfoSortKey(enclaveWithoutCreationTime),
+		getEnclaveInfoSortKey(oldEnclave),
+		getEnclaveInfoSortKey(newEnclave),
+	}
+	require.Equal(t, expectedSortKeys, sortKeys)
+}
+
+func TestDoesEnclaveInfoMatchFilters(t *testing.T) {
+	owner := "token:ci"
+	otherOwner := "oidc:jane"
+	enclaveInfo := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{ //nolint:exhaustruct
+		ContainersStatus: kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING,
+		Owner:            &owner,
+	}
+	runningStatuses := []kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus{
+		kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING,
+	}
+	stoppedStatuses := []kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus{
+		kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED,
+	}
+
+	require.True(t, doesEnclaveInfoMatchFilters(enclaveInfo, nil, nil))
+	require.True(t, doesEnclaveInfoMatchFilters(enclaveInfo, runningStatuses, &owner))
+	require.False(t, doesEnclaveInfoMatchFilters(enclaveInfo, stoppedStatuses, nil))
+	require.False(t, doesEnclaveInfoMatchFilters(enclaveInfo, nil, &otherOwner))
+}
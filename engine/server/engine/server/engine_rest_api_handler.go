@@ -6,7 +6,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"net/http"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_access"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_reaper"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
@@ -17,6 +20,13 @@ import (
 	api "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/engine_rest_api"
 )
 
+const (
+	// The REST API doesn't let the caller choose the cluster of a new enclave
+	letBackendPickCluster = ""
+	// Nor to skip the logs collection
+	dontSkipLogsCollection = false
+)
+
 type EngineRuntime struct {
 	// The version tag of the engine server image, so it can report its own version
 	ImageVersionTag string
@@ -26,13 +36,22 @@ type EngineRuntime struct {
 	LogsDbClient centralized_logs.LogsDatabaseClient
 
 	MetricsClient metrics_client.MetricsClient
+
+	// Decides which enclaves the caller can see and use
+	EnclaveAccess *enclave_access.EnclaveAccessController
+
+	// Destroys the enclaves once their TTL is over
+	EnclaveReaper *enclave_reaper.EnclaveReaper
 }
 
 // Delete Enclaves
 // (DELETE /enclaves)
 func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.DeleteEnclavesRequestObject) (api.DeleteEnclavesResponseObject, error) {
 	removeAll := utils.DerefWith(request.Params.RemoveAll, false)
-	removedEnclaveUuidsAndNames, err := engine.EnclaveManager.Clean(ctx, removeAll)
+	canAccessEnclave := func(enclaveUuid enclave.EnclaveUUID) bool {
+		return engine.EnclaveAccess.CanAccess(ctx, string(enclaveUuid))
+	}
+	removedEnclaveUuidsAndNames, err := engine.EnclaveManager.Clean(ctx, removeAll, canAccessEnclave)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred while cleaning enclaves")
 		return api.DeleteEnclavesdefaultJSONResponse{
@@ -40,7 +59,8 @@ func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.Dele
 			StatusCode: int(response.Code),
 		}, nil
 	}
-	if removeAll {
+	// The logs of the enclaves of other principals are only removed by admins
+	if removeAll && engine.EnclaveAccess.IsAdmin(ctx) {
 		if err = engine.LogsDbClient.RemoveAllLogs(); err != nil {
 			response := internalErrorResponseInfof(err, "An error occurred removing all logs")
 			return api.DeleteEnclavesdefaultJSONResponse{
@@ -64,6 +84,11 @@ func (engine EngineRuntime) GetEnclaves(ctx context.Context, request api.GetEncl
 			StatusCode: int(response.Code),
 		}, nil
 	}
+	for enclaveUuid := range infoForEnclaves {
+		if !engine.EnclaveAccess.CanAccess(ctx, enclaveUuid) {
+			delete(infoForEnclaves, enclaveUuid)
+		}
+	}
 	response := utils.MapMapValues(infoForEnclaves, func(enclave *types.EnclaveInfo) api_type.EnclaveInfo { return to_http.ToHttpEnclaveInfo(*enclave) })
 	return api.GetEnclaves200JSONResponse(response), nil
 }
@@ -105,6 +130,9 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 		enclaveName,
 		isProduction,
 		bool(shouldApicRunInDebugMode),
+		letBackendPickCluster,
+		dontSkipLogsCollection,
+		nil, // Nor to restrict the egress
 	)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred creating new enclave with name '%v'", request.Body.EnclaveName)
@@ -113,6 +141,21 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 			StatusCode: int(response.Code),
 		}, nil
 	}
+	if err = engine.EnclaveAccess.RecordOwner(ctx, enclaveInfo.EnclaveUuid); err != nil {
+		response := internalErrorResponseInfof(err, "Enclave '%v' was created but an error occurred recording its owner; only admins will be able to use it", enclaveInfo.Name)
+		return api.PostEnclavesdefaultJSONResponse{
+			Body:       response,
+			StatusCode: int(response.Code),
+		}, nil
+	}
+	// The REST API doesn't take a TTL, so the enclave gets the default one
+	if err = engine.EnclaveReaper.SetTtl(enclaveInfo.EnclaveUuid, ""); err != nil {
+		response := internalErrorResponseInfof(err, "Enclave '%v' was created but an error occurred setting its TTL; it won't expire", enclaveInfo.Name)
+		return api.PostEnclavesdefaultJSONResponse{
+			Body:       response,
+			StatusCode: int(response.Code),
+		}, nil
+	}
 
 	response := to_http.ToHttpEnclaveInfo(*enclaveInfo)
 	return api.PostEnclaves200JSONResponse(response), nil
@@ -130,7 +173,13 @@ func (engine EngineRuntime) GetEnclavesHistory(ctx context.Context, request api.
 		}, nil
 	}
 
-	identifiersMapApi := utils.MapList(allIdentifiers, to_http.ToHttpEnclaveIdentifiers)
+	accessibleIdentifiers := []*types.EnclaveIdentifiers{}
+	for _, identifier := range allIdentifiers {
+		if engine.EnclaveAccess.CanAccess(ctx, identifier.EnclaveUuid) {
+			accessibleIdentifiers = append(accessibleIdentifiers, identifier)
+		}
+	}
+	identifiersMapApi := utils.MapList(accessibleIdentifiers, to_http.ToHttpEnclaveIdentifiers)
 	return api.GetEnclavesHistory200JSONResponse(identifiersMapApi), nil
 }
 
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// GrpcReflectionPaths are the paths of the gRPC reflection services, to be served next to the engine service; grpcurl
+// and the like still use v1alpha
+var GrpcReflectionPaths = []string{
+	"/" + grpc_reflection_v1.ServerReflection_ServiceDesc.ServiceName + "/",
+	"/" + grpc_reflection_v1alpha.ServerReflection_ServiceDesc.ServiceName + "/",
+}
+
+// reflectedServices tells the reflection services which services the engine serves, as the engine service isn't
+// registered on the gRPC server answering the reflection calls
+type reflectedServices map[string]grpc.ServiceInfo
+
+func (services reflectedServices) GetServiceInfo() map[string]grpc.ServiceInfo {
+	return services
+}
+
+// NewGrpcReflectionHandler returns the handler of the gRPC reflection services, so that tools like grpcurl can list and
+// describe the engine methods without the proto files. The engine service itself is served by connect, which has no
+// reflection of its own, so the reflection services are served by a gRPC server of their own
+func NewGrpcReflectionHandler() http.Handler {
+	services := reflectedServices{
+		kurtosis_engine_rpc_api_bindings.EngineService_ServiceDesc.ServiceName: {Methods: nil, Metadata: nil},
+		grpc_reflection_v1.ServerReflection_ServiceDesc.ServiceName:            {Methods: nil, Metadata: nil},
+		grpc_reflection_v1alpha.ServerReflection_ServiceDesc.ServiceName:       {Methods: nil, Metadata: nil},
+	}
+	reflectionServerOptions := reflection.ServerOptions{
+		Services:           services,
+		DescriptorResolver: nil,
+		ExtensionResolver:  nil,
+	}
+	grpcServer := grpc.NewServer()
+	grpc_reflection_v1.RegisterServerReflectionServer(grpcServer, reflection.NewServerV1(reflectionServerOptions))
+	grpc_reflection_v1alpha.RegisterServerReflectionServer(grpcServer, reflection.NewServer(reflectionServerOptions))
+	return grpcServer
+}
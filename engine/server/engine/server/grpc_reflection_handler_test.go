@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+)
+
+func TestGrpcReflectionHandler_ListsEngineService(t *testing.T) {
+	// Served the same way as by the engine connect server
+	httpServer := httptest.NewServer(h2c.NewHandler(NewGrpcReflectionHandler(), &http2.Server{})) //nolint:exhaustruct
+	defer httpServer.Close()
+
+	conn, err := grpc.Dial(strings.TrimPrefix(httpServer.URL, "http://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := grpc_reflection_v1.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	err = stream.Send(&grpc_reflection_v1.ServerReflectionRequest{
+		Host:           "",
+		MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{ListServices: ""},
+	})
+	require.NoError(t, err)
+	response, err := stream.Recv()
+	require.NoError(t, err)
+
+	serviceNames := []string{}
+	for _, service := range response.GetListServicesResponse().GetService() {
+		serviceNames = append(serviceNames, service.GetName())
+	}
+	require.Contains(t, serviceNames, kurtosis_engine_rpc_api_bindings.EngineService_ServiceDesc.ServiceName)
+}
@@ -0,0 +1,155 @@
+package state_compactor
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	compactionInterval = time.Hour
+
+	enclaveRemovalTimesStateKey = "enclave-removal-times.json"
+)
+
+// enclaveGetter is the part of the enclave manager the compactor uses
+type enclaveGetter interface {
+	GetEnclaves(ctx context.Context) (map[string]*types.EnclaveInfo, error)
+}
+
+// EnclaveStateHolder is a part of the engine keeping state about each enclave in the state store
+type EnclaveStateHolder interface {
+	// GetEnclaveUuidsWithState returns the UUIDs of the enclaves the holder keeps state about
+	GetEnclaveUuidsWithState() ([]string, error)
+
+	// ForgetEnclaves removes the state of the enclaves
+	ForgetEnclaves(enclaveUuids []string) error
+}
+
+// StateCompactor removes the state of the enclaves that are gone from the state store, which otherwise grows with
+// every enclave ever created and gets slower to read and update. The state of a removed enclave is kept for the
+// retention period, as its owner can still read its logs until then
+type StateCompactor struct {
+	stateStore state_store.StateStore
+
+	enclaveManager enclaveGetter
+
+	// How long the state of the enclaves is kept after they're removed
+	retentionPeriod time.Duration
+
+	stateHolders []EnclaveStateHolder
+}
+
+func NewStateCompactor(
+	stateStore state_store.StateStore,
+	enclaveManager enclaveGetter,
+	retentionPeriod time.Duration,
+	stateHolders ...EnclaveStateHolder,
+) *StateCompactor {
+	return &StateCompactor{
+		stateStore:      stateStore,
+		enclaveManager:  enclaveManager,
+		retentionPeriod: retentionPeriod,
+		stateHolders:    stateHolders,
+	}
+}
+
+// StartCompaction compacts the state store now and then periodically, in the background
+func (compactor *StateCompactor) StartCompaction(ctx context.Context) {
+	go func() {
+		logrus.Debugf("Scheduling the compaction of the state store every '%v'...", compactionInterval)
+		compactor.CompactState(ctx)
+
+		compactionTicker := time.NewTicker(compactionInterval)
+		defer compactionTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-compactionTicker.C:
+				compactor.CompactState(ctx)
+			}
+		}
+	}()
+}
+
+// CompactState forgets the enclaves removed for longer than the retention period. Failures are logged and retried on
+// the next run
+func (compactor *StateCompactor) CompactState(ctx context.Context) {
+	existingEnclaves, err := compactor.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		logrus.Errorf("An error occurred getting the enclaves to compact the state store; will retry in '%v':\n%v", compactionInterval, err)
+		return
+	}
+	removedEnclaveUuids := map[string]bool{}
+	for _, stateHolder := range compactor.stateHolders {
+		enclaveUuids, err := stateHolder.GetEnclaveUuidsWithState()
+		if err != nil {
+			logrus.Errorf("An error occurred getting the enclaves with state to compact the state store; will retry in '%v':\n%v", compactionInterval, err)
+			return
+		}
+		for _, enclaveUuid := range enclaveUuids {
+			if _, found := existingEnclaves[enclaveUuid]; !found {
+				removedEnclaveUuids[enclaveUuid] = true
+			}
+		}
+	}
+
+	expiredEnclaveUuids, err := compactor.updateRemovalTimes(removedEnclaveUuids, time.Now())
+	if err != nil {
+		logrus.Errorf("An error occurred recording when the enclaves were removed; will retry in '%v':\n%v", compactionInterval, err)
+		return
+	}
+	if len(expiredEnclaveUuids) == 0 {
+		return
+	}
+	for _, stateHolder := range compactor.stateHolders {
+		if err = stateHolder.ForgetEnclaves(expiredEnclaveUuids); err != nil {
+			logrus.Errorf("An error occurred forgetting the enclaves removed more than '%v' ago; will retry in '%v':\n%v", compactor.retentionPeriod, compactionInterval, err)
+			return
+		}
+	}
+	logrus.Infof("Compacted the state store by forgetting %v enclave(s) removed more than '%v' ago", len(expiredEnclaveUuids), compactor.retentionPeriod)
+}
+
+// updateRemovalTimes records when each removed enclave was first seen removed, and returns the ones removed for longer
+// than the retention period. The enclaves no holder keeps state about anymore, e.g. the ones forgotten by the previous
+// run, are dropped from the removal times
+func (compactor *StateCompactor) updateRemovalTimes(removedEnclaveUuids map[string]bool, now time.Time) ([]string, error) {
+	expiredEnclaveUuids := []string{}
+	err := compactor.stateStore.Update(enclaveRemovalTimesStateKey, func(serializedRemovalTimeByEnclaveUuid []byte) ([]byte, error) {
+		removalTimeByEnclaveUuid := map[string]time.Time{}
+		if serializedRemovalTimeByEnclaveUuid != nil {
+			if err := json.Unmarshal(serializedRemovalTimeByEnclaveUuid, &removalTimeByEnclaveUuid); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred deserializing the removal times of the enclaves")
+			}
+		}
+		expiredEnclaveUuids = []string{}
+		updatedRemovalTimeByEnclaveUuid := map[string]time.Time{}
+		for enclaveUuid := range removedEnclaveUuids {
+			removalTime, found := removalTimeByEnclaveUuid[enclaveUuid]
+			if !found {
+				updatedRemovalTimeByEnclaveUuid[enclaveUuid] = now
+				continue
+			}
+			if now.Sub(removalTime) >= compactor.retentionPeriod {
+				expiredEnclaveUuids = append(expiredEnclaveUuids, enclaveUuid)
+			}
+			updatedRemovalTimeByEnclaveUuid[enclaveUuid] = removalTime
+		}
+		updatedSerializedRemovalTimeByEnclaveUuid, err := json.Marshal(updatedRemovalTimeByEnclaveUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing the removal times of the enclaves")
+		}
+		return updatedSerializedRemovalTimeByEnclaveUuid, nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating key '%v' of the state store", enclaveRemovalTimesStateKey)
+	}
+	return expiredEnclaveUuids, nil
+}
@@ -0,0 +1,88 @@
+package state_compactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/state_store"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	existingEnclaveUuid = "existing-enclave"
+	removedEnclaveUuid  = "removed-enclave"
+)
+
+type fakeEnclaveGetter struct {
+	enclaves map[string]*types.EnclaveInfo
+}
+
+func (getter *fakeEnclaveGetter) GetEnclaves(ctx context.Context) (map[string]*types.EnclaveInfo, error) {
+	return getter.enclaves, nil
+}
+
+type fakeStateHolder struct {
+	enclaveUuids map[string]bool
+}
+
+func (holder *fakeStateHolder) GetEnclaveUuidsWithState() ([]string, error) {
+	enclaveUuids := []string{}
+	for enclaveUuid := range holder.enclaveUuids {
+		enclaveUuids = append(enclaveUuids, enclaveUuid)
+	}
+	return enclaveUuids, nil
+}
+
+func (holder *fakeStateHolder) ForgetEnclaves(enclaveUuids []string) error {
+	for _, enclaveUuid := range enclaveUuids {
+		delete(holder.enclaveUuids, enclaveUuid)
+	}
+	return nil
+}
+
+func TestCompactState_KeepsRemovedEnclavesForTheRetentionPeriod(t *testing.T) {
+	stateHolder := newFakeStateHolder()
+	compactor := NewStateCompactor(state_store.NewFileStateStore(t.TempDir()), newFakeEnclaveGetter(), time.Hour, stateHolder)
+
+	compactor.CompactState(context.Background())
+	compactor.CompactState(context.Background())
+	require.Len(t, stateHolder.enclaveUuids, 2)
+}
+
+func TestCompactState_ForgetsRemovedEnclavesAfterTheRetentionPeriod(t *testing.T) {
+	stateStore := state_store.NewFileStateStore(t.TempDir())
+	stateHolder := newFakeStateHolder()
+	compactor := NewStateCompactor(stateStore, newFakeEnclaveGetter(), 0, stateHolder)
+
+	// The first run records when the enclave was removed
+	compactor.CompactState(context.Background())
+	require.Len(t, stateHolder.enclaveUuids, 2)
+
+	compactor.CompactState(context.Background())
+	require.Equal(t, map[string]bool{existingEnclaveUuid: true}, stateHolder.enclaveUuids)
+
+	// The removal time of the forgotten enclave goes away on the next run
+	compactor.CompactState(context.Background())
+	serializedRemovalTimes, err := stateStore.Load(enclaveRemovalTimesStateKey)
+	require.NoError(t, err)
+	require.JSONEq(t, "{}", string(serializedRemovalTimes))
+}
+
+func newFakeEnclaveGetter() *fakeEnclaveGetter {
+	return &fakeEnclaveGetter{
+		enclaves: map[string]*types.EnclaveInfo{
+			existingEnclaveUuid: nil,
+		},
+	}
+}
+
+func newFakeStateHolder() *fakeStateHolder {
+	return &fakeStateHolder{
+		enclaveUuids: map[string]bool{
+			existingEnclaveUuid: true,
+			removedEnclaveUuid:  true,
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package state_store
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// DecodedValueCache keeps the last value of a key decoded, so that the stores looking up one entry of a large value,
+// e.g. the access of one enclave among hundreds, only decode it again once it changes. Listing the enclaves looks up
+// every one of them, which would otherwise decode the whole value once per enclave
+type DecodedValueCache[T any] struct {
+	mutex *sync.Mutex
+
+	decodeFunc func(serializedValue []byte) (T, error)
+
+	// nil until a value gets decoded
+	serializedValue []byte
+	decodedValue    T
+}
+
+func NewDecodedValueCache[T any](decodeFunc func(serializedValue []byte) (T, error)) *DecodedValueCache[T] {
+	var noDecodedValue T
+	return &DecodedValueCache[T]{
+		mutex:           &sync.Mutex{},
+		decodeFunc:      decodeFunc,
+		serializedValue: nil,
+		decodedValue:    noDecodedValue,
+	}
+}
+
+// Decode returns the decoded value, from the cache if it's the last one decoded. The result is shared by every caller
+// so it must not be modified
+func (cache *DecodedValueCache[T]) Decode(serializedValue []byte) (T, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.serializedValue != nil && bytes.Equal(cache.serializedValue, serializedValue) {
+		return cache.decodedValue, nil
+	}
+	decodedValue, err := cache.decodeFunc(serializedValue)
+	if err != nil {
+		var noDecodedValue T
+		return noDecodedValue, stacktrace.Propagate(err, "An error occurred decoding the value")
+	}
+	if serializedValue != nil {
+		cache.serializedValue = serializedValue
+		cache.decodedValue = decodedValue
+	}
+	return decodedValue, nil
+}
@@ -0,0 +1,30 @@
+package state_store
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodedValueCache_OnlyDecodesChangedValues(t *testing.T) {
+	numDecodes := 0
+	cache := NewDecodedValueCache(func(serializedValue []byte) (string, error) {
+		numDecodes++
+		return strings.ToUpper(string(serializedValue)), nil
+	})
+
+	decodedValue, err := cache.Decode([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, "A", decodedValue)
+
+	decodedValue, err = cache.Decode([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, "A", decodedValue)
+	require.Equal(t, 1, numDecodes)
+
+	decodedValue, err = cache.Decode([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, "B", decodedValue)
+	require.Equal(t, 2, numDecodes)
+}
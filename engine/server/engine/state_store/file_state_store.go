@@ -0,0 +1,78 @@
+package state_store
+
+import (
+	"os"
+	"path"
+	"sync"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	storeFilePerm = 0o600
+	storeDirPerm  = 0o755
+
+	tmpStoreFileSuffix = ".tmp"
+)
+
+// fileStateStore keeps each key in its own file of a directory, which only works when there's a single engine
+type fileStateStore struct {
+	mutex *sync.Mutex
+
+	dirpath string
+}
+
+func NewFileStateStore(dirpath string) StateStore {
+	return &fileStateStore{
+		mutex:   &sync.Mutex{},
+		dirpath: dirpath,
+	}
+}
+
+func (store *fileStateStore) Load(key string) ([]byte, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	value, err := readStateFile(path.Join(store.dirpath, key))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", key)
+	}
+	return value, nil
+}
+
+// Update replaces the file atomically so that a crash never leaves a truncated value behind
+func (store *fileStateStore) Update(key string, updateFunc func(currentValue []byte) ([]byte, error)) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	storeFilepath := path.Join(store.dirpath, key)
+	currentValue, err := readStateFile(storeFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", key)
+	}
+	updatedValue, err := updateFunc(currentValue)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred computing the updated value of key '%v' of the state store", key)
+	}
+	if err = os.MkdirAll(store.dirpath, storeDirPerm); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the state store directory '%v'", store.dirpath)
+	}
+	tmpStoreFilepath := storeFilepath + tmpStoreFileSuffix
+	if err = os.WriteFile(tmpStoreFilepath, updatedValue, storeFilePerm); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing key '%v' of the state store to '%v'", key, tmpStoreFilepath)
+	}
+	if err = os.Rename(tmpStoreFilepath, storeFilepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving key '%v' of the state store from '%v' to '%v'", key, tmpStoreFilepath, storeFilepath)
+	}
+	return nil
+}
+
+// readStateFile returns nil if the file doesn't exist
+func readStateFile(storeFilepath string) ([]byte, error) {
+	value, err := os.ReadFile(storeFilepath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading state store file '%v'", storeFilepath)
+	}
+	return value, nil
+}
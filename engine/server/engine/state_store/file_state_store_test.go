@@ -0,0 +1,31 @@
+package state_store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKey = "test-key.json"
+)
+
+func TestFileStateStore(t *testing.T) {
+	storeDirpath := t.TempDir()
+	store := NewFileStateStore(storeDirpath)
+
+	value, err := store.Load(testKey)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	appendFunc := func(currentValue []byte) ([]byte, error) {
+		return append(currentValue, 'a'), nil
+	}
+	require.NoError(t, store.Update(testKey, appendFunc))
+	require.NoError(t, store.Update(testKey, appendFunc))
+
+	// The values survive engine restarts
+	value, err = NewFileStateStore(storeDirpath).Load(testKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("aa"), value)
+}
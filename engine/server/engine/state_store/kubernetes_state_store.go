@@ -0,0 +1,174 @@
+package state_store
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// The state outlives the engine namespace, which gets recreated on every engine restart, so it's kept in a
+	// namespace of its own that the engine cleanup doesn't touch
+	stateNamespaceName = "kurtosis-engine-state"
+	stateConfigMapName = "kurtosis-engine-state"
+
+	kubernetesCallTimeout = 30 * time.Second
+
+	// Every call checks enclave access, so the replicas share a recent copy of the state rather than reading it on
+	// every call
+	stateCacheDuration = 1 * time.Second
+)
+
+// kubernetesStateStore keeps the keys in a ConfigMap shared by all the engine replicas. Concurrent updates are
+// detected with the resource version of the ConfigMap and retried
+type kubernetesStateStore struct {
+	kubernetesClientSet *kubernetes.Clientset
+
+	// Where the engines that ran before the state was kept in Kubernetes stored it, so that it isn't lost on upgrade
+	legacyDirpath string
+
+	mutex *sync.Mutex
+
+	cachedConfigMap   *apiv1.ConfigMap
+	cachedConfigMapAt time.Time
+}
+
+func NewKubernetesStateStore(ctx context.Context, kubernetesClientSet *kubernetes.Clientset, legacyDirpath string) (StateStore, error) {
+	if err := createStateConfigMapIfMissing(ctx, kubernetesClientSet); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the ConfigMap holding the engine state")
+	}
+	return &kubernetesStateStore{
+		kubernetesClientSet: kubernetesClientSet,
+		legacyDirpath:       legacyDirpath,
+		mutex:               &sync.Mutex{},
+		cachedConfigMap:     nil,
+		cachedConfigMapAt:   time.Time{},
+	}, nil
+}
+
+func (store *kubernetesStateStore) Load(key string) ([]byte, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.cachedConfigMap == nil || time.Since(store.cachedConfigMapAt) > stateCacheDuration {
+		configMap, err := store.getConfigMap()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the ConfigMap holding the engine state")
+		}
+		store.cacheConfigMap(configMap)
+	}
+	value, err := store.getValue(store.cachedConfigMap, key)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", key)
+	}
+	return value, nil
+}
+
+func (store *kubernetesStateStore) Update(key string, updateFunc func(currentValue []byte) ([]byte, error)) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := store.getConfigMap()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the ConfigMap holding the engine state")
+		}
+		currentValue, err := store.getValue(configMap, key)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", key)
+		}
+		updatedValue, err := updateFunc(currentValue)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred computing the updated value of key '%v' of the state store", key)
+		}
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[key] = string(updatedValue)
+
+		ctx, cancelFunc := context.WithTimeout(context.Background(), kubernetesCallTimeout)
+		defer cancelFunc()
+		// nolint:exhaustruct
+		updatedConfigMap, err := store.kubernetesClientSet.CoreV1().ConfigMaps(stateNamespaceName).Update(ctx, configMap, metav1.UpdateOptions{})
+		if err != nil {
+			// Not wrapped so that conflicts are recognized and retried
+			return err
+		}
+		store.cacheConfigMap(updatedConfigMap)
+		return nil
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating key '%v' of the state store", key)
+	}
+	return nil
+}
+
+func (store *kubernetesStateStore) getConfigMap() (*apiv1.ConfigMap, error) {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), kubernetesCallTimeout)
+	defer cancelFunc()
+	// nolint:exhaustruct
+	configMap, err := store.kubernetesClientSet.CoreV1().ConfigMaps(stateNamespaceName).Get(ctx, stateConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting ConfigMap '%v' in namespace '%v'", stateConfigMapName, stateNamespaceName)
+	}
+	return configMap, nil
+}
+
+func (store *kubernetesStateStore) cacheConfigMap(configMap *apiv1.ConfigMap) {
+	store.cachedConfigMap = configMap
+	store.cachedConfigMapAt = time.Now()
+}
+
+// getValue falls back to the file the engine stored the key in before the state was kept in Kubernetes
+func (store *kubernetesStateStore) getValue(configMap *apiv1.ConfigMap, key string) ([]byte, error) {
+	if value, found := configMap.Data[key]; found {
+		return []byte(value), nil
+	}
+	legacyValue, err := readStateFile(path.Join(store.legacyDirpath, key))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the value of key '%v' stored by a previous engine", key)
+	}
+	return legacyValue, nil
+}
+
+func createStateConfigMapIfMissing(ctx context.Context, kubernetesClientSet *kubernetes.Clientset) error {
+	ctx, cancelFunc := context.WithTimeout(ctx, kubernetesCallTimeout)
+	defer cancelFunc()
+	// Only the app label so that the state isn't mistaken for the resources of an engine or an enclave
+	labels := map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString(): label_value_consts.AppIDKubernetesLabelValue.GetString(),
+	}
+	// nolint:exhaustruct
+	namespace := &apiv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   stateNamespaceName,
+			Labels: labels,
+		},
+	}
+	// nolint:exhaustruct
+	if _, err := kubernetesClientSet.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return stacktrace.Propagate(err, "An error occurred creating namespace '%v'", stateNamespaceName)
+	}
+	// nolint:exhaustruct
+	configMap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      stateConfigMapName,
+			Namespace: stateNamespaceName,
+			Labels:    labels,
+		},
+		Data: map[string]string{},
+	}
+	// nolint:exhaustruct
+	if _, err := kubernetesClientSet.CoreV1().ConfigMaps(stateNamespaceName).Create(ctx, configMap, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return stacktrace.Propagate(err, "An error occurred creating ConfigMap '%v' in namespace '%v'", stateConfigMapName, stateNamespaceName)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package state_store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path"
+	"time"
+
+	// Registers the 'pgx' database/sql driver
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/kurtosis-tech/stacktrace"
+	// Registers the 'sqlite' database/sql driver
+	_ "modernc.org/sqlite"
+)
+
+const (
+	sqliteDriverName   = "sqlite"
+	postgresDriverName = "pgx"
+
+	// Makes concurrent writers wait for each other instead of failing right away, and keeps the database consistent
+	// on crashes without syncing on every write
+	sqliteConnectionParams = "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=synchronous(NORMAL)"
+
+	sqlCallTimeout = 30 * time.Second
+
+	// Each update only retries when another replica updated the same key in between, so a handful is plenty
+	maxUpdateAttempts = 10
+
+	// The key is the primary key, so every lookup is an index lookup. The version detects concurrent updates
+	createSqliteStateTableStatement   = "CREATE TABLE IF NOT EXISTS engine_state (key TEXT PRIMARY KEY, value BLOB NOT NULL, version INTEGER NOT NULL)"
+	createPostgresStateTableStatement = "CREATE TABLE IF NOT EXISTS engine_state (key TEXT PRIMARY KEY, value BYTEA NOT NULL, version BIGINT NOT NULL)"
+
+	selectValueStatement = "SELECT value, version FROM engine_state WHERE key = $1"
+	insertValueStatement = "INSERT INTO engine_state (key, value, version) VALUES ($1, $2, 1) ON CONFLICT (key) DO NOTHING"
+	updateValueStatement = "UPDATE engine_state SET value = $1, version = version + 1 WHERE key = $2 AND version = $3"
+
+	// The version of the keys that aren't in the database yet; the stored ones start at 1
+	noVersionBecauseAbsent = int64(0)
+)
+
+// sqlStateStore keeps the keys in a table of a SQL database, for the engines managing too many enclaves for the
+// default stores, which rewrite the whole state on every update. Postgres can be shared by all the engine replicas;
+// concurrent updates are detected with the version of each key and retried
+type sqlStateStore struct {
+	db *sql.DB
+
+	// Where the engines that ran before the state was kept in the database stored it, so that it isn't lost when
+	// switching to the database
+	legacyDirpath string
+}
+
+// NewSqliteStateStore keeps the state in the SQLite database file, creating it if needed
+func NewSqliteStateStore(databaseFilepath string, legacyDirpath string) (StateStore, error) {
+	db, err := sql.Open(sqliteDriverName, databaseFilepath+sqliteConnectionParams)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening SQLite database '%v'", databaseFilepath)
+	}
+	// SQLite only has one writer at a time anyway, and a single connection avoids waiting on its file lock
+	db.SetMaxOpenConns(1)
+	store, err := newSqlStateStore(db, createSqliteStateTableStatement, legacyDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the state store in SQLite database '%v'", databaseFilepath)
+	}
+	return store, nil
+}
+
+// NewPostgresStateStore keeps the state in the Postgres database at the URL
+func NewPostgresStateStore(databaseUrl string, legacyDirpath string) (StateStore, error) {
+	db, err := sql.Open(postgresDriverName, databaseUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the Postgres database")
+	}
+	store, err := newSqlStateStore(db, createPostgresStateTableStatement, legacyDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the state store in the Postgres database")
+	}
+	return store, nil
+}
+
+func newSqlStateStore(db *sql.DB, createStateTableStatement string, legacyDirpath string) (*sqlStateStore, error) {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), sqlCallTimeout)
+	defer cancelFunc()
+	if _, err := db.ExecContext(ctx, createStateTableStatement); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the table holding the engine state")
+	}
+	return &sqlStateStore{
+		db:            db,
+		legacyDirpath: legacyDirpath,
+	}, nil
+}
+
+func (store *sqlStateStore) Load(key string) ([]byte, error) {
+	value, _, err := store.getValue(key)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", key)
+	}
+	return value, nil
+}
+
+func (store *sqlStateStore) Update(key string, updateFunc func(currentValue []byte) ([]byte, error)) error {
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		currentValue, currentVersion, err := store.getValue(key)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred loading key '%v' of the state store", key)
+		}
+		updatedValue, err := updateFunc(currentValue)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred computing the updated value of key '%v' of the state store", key)
+		}
+		wasUpdated, err := store.setValueIfUnchanged(key, updatedValue, currentVersion)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred updating key '%v' of the state store", key)
+		}
+		if wasUpdated {
+			return nil
+		}
+	}
+	return stacktrace.NewError("Key '%v' of the state store kept being updated concurrently; gave up after %v attempts", key, maxUpdateAttempts)
+}
+
+// getValue returns the value of the key along with its version, noVersionBecauseAbsent if it's not in the database. It
+// falls back to the file the engine stored the key in before the state was kept in the database
+func (store *sqlStateStore) getValue(key string) ([]byte, int64, error) {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), sqlCallTimeout)
+	defer cancelFunc()
+	var value []byte
+	var version int64
+	err := store.db.QueryRowContext(ctx, selectValueStatement, key).Scan(&value, &version)
+	if errors.Is(err, sql.ErrNoRows) {
+		legacyValue, err := readStateFile(path.Join(store.legacyDirpath, key))
+		if err != nil {
+			return nil, noVersionBecauseAbsent, stacktrace.Propagate(err, "An error occurred reading the value of key '%v' stored by a previous engine", key)
+		}
+		return legacyValue, noVersionBecauseAbsent, nil
+	}
+	if err != nil {
+		return nil, noVersionBecauseAbsent, stacktrace.Propagate(err, "An error occurred selecting key '%v' from the database", key)
+	}
+	// Empty values are stored as such because the column can't be null
+	if len(value) == 0 {
+		value = nil
+	}
+	return value, version, nil
+}
+
+// setValueIfUnchanged returns false if another update of the key happened since its version was read
+func (store *sqlStateStore) setValueIfUnchanged(key string, value []byte, expectedVersion int64) (bool, error) {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), sqlCallTimeout)
+	defer cancelFunc()
+	if value == nil {
+		value = []byte{}
+	}
+	var result sql.Result
+	var err error
+	if expectedVersion == noVersionBecauseAbsent {
+		result, err = store.db.ExecContext(ctx, insertValueStatement, key, value)
+	} else {
+		result, err = store.db.ExecContext(ctx, updateValueStatement, value, key, expectedVersion)
+	}
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred writing key '%v' to the database", key)
+	}
+	numRowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the number of rows written for key '%v'", key)
+	}
+	return numRowsAffected > 0, nil
+}
@@ -0,0 +1,74 @@
+package state_store
+
+import (
+	"os"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDatabaseFilename  = "engine-state.db"
+	testConcurrentUpdates = 20
+)
+
+func TestSqliteStateStore(t *testing.T) {
+	databaseFilepath := path.Join(t.TempDir(), testDatabaseFilename)
+	store, err := NewSqliteStateStore(databaseFilepath, t.TempDir())
+	require.NoError(t, err)
+
+	value, err := store.Load(testKey)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	appendFunc := func(currentValue []byte) ([]byte, error) {
+		return append(currentValue, 'a'), nil
+	}
+	require.NoError(t, store.Update(testKey, appendFunc))
+	require.NoError(t, store.Update(testKey, appendFunc))
+
+	// The values survive engine restarts
+	reopenedStore, err := NewSqliteStateStore(databaseFilepath, t.TempDir())
+	require.NoError(t, err)
+	value, err = reopenedStore.Load(testKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("aa"), value)
+}
+
+func TestSqliteStateStore_ConcurrentUpdatesAreNotLost(t *testing.T) {
+	store, err := NewSqliteStateStore(path.Join(t.TempDir(), testDatabaseFilename), t.TempDir())
+	require.NoError(t, err)
+
+	waitGroup := &sync.WaitGroup{}
+	for i := 0; i < testConcurrentUpdates; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			require.NoError(t, store.Update(testKey, func(currentValue []byte) ([]byte, error) {
+				return append(currentValue, 'a'), nil
+			}))
+		}()
+	}
+	waitGroup.Wait()
+
+	value, err := store.Load(testKey)
+	require.NoError(t, err)
+	require.Len(t, value, testConcurrentUpdates)
+}
+
+func TestSqliteStateStore_KeepsTheValuesOfTheFileStateStore(t *testing.T) {
+	legacyDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(legacyDirpath, testKey), []byte("a"), storeFilePerm))
+
+	store, err := NewSqliteStateStore(path.Join(t.TempDir(), testDatabaseFilename), legacyDirpath)
+	require.NoError(t, err)
+	require.NoError(t, store.Update(testKey, func(currentValue []byte) ([]byte, error) {
+		return append(currentValue, 'b'), nil
+	}))
+
+	value, err := store.Load(testKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("ab"), value)
+}
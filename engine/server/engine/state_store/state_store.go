@@ -0,0 +1,13 @@
+package state_store
+
+// StateStore keeps the small pieces of state the engine needs to survive restarts, e.g. who owns each enclave. On
+// Kubernetes it's shared by all the engine replicas, so that any of them can serve any call
+type StateStore interface {
+	// Load returns the value of the key, or nil if it was never stored
+	Load(key string) ([]byte, error)
+
+	// Update replaces the value of the key, nil if it was never stored, with the one returned by updateFunc. The
+	// update is atomic: updateFunc might get called again with the fresh value if another replica updated the key
+	// concurrently
+	Update(key string, updateFunc func(currentValue []byte) ([]byte, error)) error
+}
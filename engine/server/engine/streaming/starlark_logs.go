@@ -3,11 +3,13 @@ package streaming
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
 	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/stacktrace"
 )
@@ -47,12 +49,23 @@ func (async *asyncStarlarkLogs) Close() {
 
 func (async *asyncStarlarkLogs) AttachStream(stream grpc.ClientStream) {
 	logrus.Debugf("Asynchronously reading the stream of Starlark execution logs")
+	startTime := time.Now()
+	// Stays interrupted if the stream ends before the run finished event
+	runResult := engine_metrics.StarlarkRunResult_Interrupted
 	defer func() {
+		engine_metrics.ObserveStarlarkRun(runResult, time.Since(startTime))
 		close(async.starlarkRunResponseLineChan)
 	}()
 	for {
 		responseLine := new(rpc_api.StarlarkRunResponseLine)
 		err := stream.RecvMsg(responseLine)
+		if runFinishedEvent := responseLine.GetRunFinishedEvent(); err == nil && runFinishedEvent != nil {
+			if runFinishedEvent.GetIsRunSuccessful() {
+				runResult = engine_metrics.StarlarkRunResult_Success
+			} else {
+				runResult = engine_metrics.StarlarkRunResult_Failure
+			}
+		}
 		if err == io.EOF {
 			logrus.Debugf("Successfully reached the end of the response stream. Closing.")
 			return
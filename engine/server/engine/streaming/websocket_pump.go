@@ -25,6 +25,9 @@ const (
 	// nolint:gomnd
 	numPongsToWait = 9
 	pingPeriod     = (pongWait * numPongsToWait) / 10
+	// Selected when offered by a client, which it must do next to the subprotocol carrying its token for browsers to
+	// accept the connection; the subprotocol carrying the token is never echoed back
+	wsEngineSubprotocol = "kurtosis.engine.v1"
 )
 
 type WebsocketPump[T interface{}] struct {
@@ -44,6 +47,7 @@ func NewWebsocketPump[T interface{}](ctx echo.Context, cors cors.Cors) (*Websock
 		ReadBufferSize:  wsReadBufferSize,
 		WriteBufferSize: wsWriteBufferSize,
 		CheckOrigin:     cors.OriginAllowed,
+		Subprotocols:    []string{wsEngineSubprotocol},
 	}
 
 	conn, err := upgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
@@ -85,7 +85,19 @@ gc_flags=""
 if "${debug_image}"; then
   gc_flags="all=-N -l"
 fi
-if ! CGO_ENABLED=0 GOOS=linux GOARCH=${architecture_to_build} go build -gcflags="${gc_flags}" -o "${MAIN_BINARY_OUTPUT_FILEPATH}.${architecture_to_build}" "${MAIN_GO_FILEPATH}"; then
+# A FIPS build (KURTOSIS_FIPS_BUILD=true) links the validated BoringCrypto module, which needs cgo, statically so that
+# the binary still runs in the Alpine image; it must be built on the architecture it targets
+build_env=("CGO_ENABLED=0")
+ld_flags=""
+if [ "${KURTOSIS_FIPS_BUILD:-false}" == "true" ]; then
+  if [ "${architecture_to_build}" != "${DEFAULT_ARCHITECTURE_TO_BUILD}" ]; then
+    echo "Error: A FIPS build must be built on the architecture it targets, '${architecture_to_build}' isn't '${DEFAULT_ARCHITECTURE_TO_BUILD}'" >&2
+    exit 1
+  fi
+  build_env=("CGO_ENABLED=1" "GOEXPERIMENT=boringcrypto")
+  ld_flags="-linkmode=external -extldflags=-static"
+fi
+if ! env "${build_env[@]}" GOOS=linux GOARCH=${architecture_to_build} go build -gcflags="${gc_flags}" -ldflags="${ld_flags}" -o "${MAIN_BINARY_OUTPUT_FILEPATH}.${architecture_to_build}" "${MAIN_GO_FILEPATH}"; then
   echo "Error: An error occurred building the server code" >&2
   exit 1
 fi
@@ -1,6 +1,7 @@
 package grpc_file_streaming
 
 import (
+	"bytes"
 	"io"
 
 	"github.com/kurtosis-tech/stacktrace"
@@ -11,13 +12,29 @@ import (
 // 4MB limit set by GRPC.
 type ClientStream[DataChunkMessageType any, ServerResponseType any] struct {
 	grpcStream grpc.ClientStream
+
+	// Can be nil, in which case no progress will be reported
+	progressReporter ProgressReporter
 }
 
 func NewClientStream[DataChunkMessageType any, ServerResponseType any](
 	grpcStream grpc.ClientStream,
 ) *ClientStream[DataChunkMessageType, ServerResponseType] {
 	return &ClientStream[DataChunkMessageType, ServerResponseType]{
-		grpcStream: grpcStream,
+		grpcStream:       grpcStream,
+		progressReporter: nil,
+	}
+}
+
+// NewClientStreamWithProgressReporter returns a ClientStream that calls progressReporter every time a chunk is sent or
+// received, so that callers (e.g. the CLI) can display the progress of large transfers
+func NewClientStreamWithProgressReporter[DataChunkMessageType any, ServerResponseType any](
+	grpcStream grpc.ClientStream,
+	progressReporter ProgressReporter,
+) *ClientStream[DataChunkMessageType, ServerResponseType] {
+	return &ClientStream[DataChunkMessageType, ServerResponseType]{
+		grpcStream:       grpcStream,
+		progressReporter: progressReporter,
 	}
 }
 
@@ -37,6 +54,7 @@ func (clientStream *ClientStream[DataChunkMessageType, ServerResponseType]) Send
 		contentSizeInBytes,
 		clientStream.grpcStream.SendMsg,
 		grpcMsgConstructor,
+		clientStream.progressReporter,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred sending '%s'", contentNameForLogging)
@@ -60,20 +78,37 @@ func (clientStream *ClientStream[DataChunkMessageType, ServerResponseType]) Send
 // ReceiveData receives some content via streaming expecting the content to be received in fixed-sized chunks from
 // the server until the server returns io.EOF. Once that happens, the chunks are assembled into a single byte array
 // and returned.
+// Prefer ReceiveDataToWriter for large payloads, as this method holds the entire payload in memory.
 func (clientStream *ClientStream[DataChunkMessageType, ServerResponseType]) ReceiveData(
 	contentNameForLogging string,
 	grpcMsgExtractor func(dataChunk *DataChunkMessageType) ([]byte, string, error),
 ) ([]byte, error) {
-	// Read all the chunks and assemble them into a single byte array assembledContent
-	assembledContent, err := readMessagesFromStream[DataChunkMessageType](
+	assembledContent := &bytes.Buffer{}
+	if err := clientStream.ReceiveDataToWriter(contentNameForLogging, grpcMsgExtractor, assembledContent); err != nil {
+		return nil, err
+	}
+	return assembledContent.Bytes(), nil
+}
+
+// ReceiveDataToWriter receives some content via streaming expecting the content to be received in fixed-sized chunks
+// from the server until the server returns io.EOF. Each chunk is written to the writer as soon as it's received, so
+// the memory footprint stays constant regardless of the size of the content.
+func (clientStream *ClientStream[DataChunkMessageType, ServerResponseType]) ReceiveDataToWriter(
+	contentNameForLogging string,
+	grpcMsgExtractor func(dataChunk *DataChunkMessageType) ([]byte, string, error),
+	writer io.Writer,
+) error {
+	if err := readMessagesFromStreamToWriter[DataChunkMessageType](
 		contentNameForLogging,
 		clientStream.grpcStream.RecvMsg,
-		grpcMsgExtractor)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred sending the data chunks for '%s' through the stream",
+		grpcMsgExtractor,
+		writer,
+		clientStream.progressReporter,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the data chunks for '%s' through the stream",
 			contentNameForLogging)
 	}
-	return assembledContent, nil
+	return nil
 }
 
 // PipeReader pipe out data via streaming expecting the content to be received in fixed-sized chunks from
@@ -87,19 +122,9 @@ func (clientStream *ClientStream[DataChunkMessageType, ServerResponseType]) Pipe
 	// Use pipe and gorotines to stream over data from the client directly to the pipe consumer
 	pipeReader, pipeWriter := io.Pipe()
 	go func() {
-		defer pipeWriter.Close()
-		// Read all the chunks and assemble them into a single byte array assembledContent
-		_, err := readMessagesFromStream[DataChunkMessageType](
-			contentNameForLogging,
-			clientStream.grpcStream.RecvMsg,
-			func(chunk *DataChunkMessageType) ([]byte, string, error) {
-				data, hash, extErr := grpcMsgExtractor(chunk)
-				_, _ = pipeWriter.Write(data)
-				return []byte{}, hash, extErr
-			})
-		if err != nil {
-			pipeReader.CloseWithError(stacktrace.Propagate(err, "An error occurred sending the data chunks for '%s' through the stream", contentNameForLogging))
-		}
+		err := clientStream.ReceiveDataToWriter(contentNameForLogging, grpcMsgExtractor, pipeWriter)
+		// CloseWithError(nil) is equivalent to Close(), so the consumer gets io.EOF on success
+		pipeWriter.CloseWithError(err)
 	}()
 
 	return pipeReader
@@ -0,0 +1,87 @@
+package grpc_file_streaming
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ContentSha256MetadataKey is the key of the GRPC header through which the server advertises the SHA-256 of the
+	// content it's about to stream, so that the client can verify what it received
+	ContentSha256MetadataKey = "content-sha256"
+)
+
+// ContentChecksumVerifyingReader computes the SHA-256 of the content read from the wrapped reader and, once the end of
+// the content is reached, returns an error instead of io.EOF if it doesn't match the expected checksum
+type ContentChecksumVerifyingReader struct {
+	reader io.Reader
+
+	hasher hash.Hash
+
+	expectedSha256 string
+}
+
+func NewContentChecksumVerifyingReader(reader io.Reader, expectedSha256 string) *ContentChecksumVerifyingReader {
+	return &ContentChecksumVerifyingReader{
+		reader:         reader,
+		hasher:         sha256.New(),
+		expectedSha256: expectedSha256,
+	}
+}
+
+func (verifyingReader *ContentChecksumVerifyingReader) Read(p []byte) (int, error) {
+	readBytes, err := verifyingReader.reader.Read(p)
+	// hash.Hash.Write never returns an error
+	verifyingReader.hasher.Write(p[:readBytes])
+	if errors.Is(err, io.EOF) {
+		actualSha256 := hex.EncodeToString(verifyingReader.hasher.Sum(nil))
+		if verifyErr := VerifyContentSha256(verifyingReader.expectedSha256, actualSha256); verifyErr != nil {
+			return readBytes, verifyErr
+		}
+	}
+	return readBytes, err
+}
+
+// ComputeContentSha256 returns the hex-encoded SHA-256 of the content
+func ComputeContentSha256(content []byte) string {
+	checksum := sha256.Sum256(content)
+	return hex.EncodeToString(checksum[:])
+}
+
+// VerifyContentSha256 returns an error if the actual checksum doesn't match the expected one
+func VerifyContentSha256(expectedSha256 string, actualSha256 string) error {
+	if expectedSha256 != actualSha256 {
+		return stacktrace.NewError("Content integrity check failed: expected SHA-256 '%s' but the content has SHA-256 '%s'", expectedSha256, actualSha256)
+	}
+	return nil
+}
+
+// SetContentSha256Header advertises the checksum of the content the server is about to stream. It must be called
+// before the first chunk is sent.
+func SetContentSha256Header(grpcStream grpc.ServerStream, contentSha256 string) error {
+	if err := grpcStream.SetHeader(metadata.Pairs(ContentSha256MetadataKey, contentSha256)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the content checksum header on the stream")
+	}
+	return nil
+}
+
+// GetContentSha256Header returns the checksum advertised by the server for the content it streamed, and false if the
+// server didn't advertise one (e.g. older servers, or content stored before checksums were recorded)
+func GetContentSha256Header(grpcStream grpc.ClientStream) (string, bool, error) {
+	header, err := grpcStream.Header()
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred reading the headers of the stream")
+	}
+	values := header.Get(ContentSha256MetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return "", false, nil
+	}
+	return values[0], true, nil
+}
@@ -0,0 +1,27 @@
+package grpc_file_streaming
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentChecksumVerifyingReader_matchingChecksum(t *testing.T) {
+	content := []byte("files artifact content")
+	reader := NewContentChecksumVerifyingReader(bytes.NewReader(content), ComputeContentSha256(content))
+
+	readContent, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, content, readContent)
+}
+
+func TestContentChecksumVerifyingReader_mismatchingChecksum(t *testing.T) {
+	content := []byte("files artifact content")
+	reader := NewContentChecksumVerifyingReader(bytes.NewReader(content), ComputeContentSha256([]byte("other content")))
+
+	_, err := io.ReadAll(reader)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Content integrity check failed")
+}
@@ -1,6 +1,7 @@
 package grpc_file_streaming
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"encoding/hex"
 	"errors"
@@ -14,8 +15,16 @@ const (
 	// per message
 	chunkSize       = 3 * 1024 * 1024
 	bytesInKilobyte = 1024
+
+	// UnknownContentSize can be passed as the content size when the total size of the payload is not known upfront
+	// (e.g. when the payload is itself a stream). Progress reporters will receive it as the total size.
+	UnknownContentSize = uint64(0)
 )
 
+// ProgressReporter is called every time a chunk has been sent or received with the number of bytes transferred so far
+// and the total size of the payload (UnknownContentSize if it's not known)
+type ProgressReporter func(transferredBytes uint64, totalBytes uint64)
+
 // sendMessagesToStream is a helper function that takes as input the payload to be sent, split it into fixed-size chunks
 // and send it via the stream using sendViaStreamFunc.
 // grpcMsgConstructor must be implemented by the user of this function. it should produce an actual proto message object
@@ -26,10 +35,12 @@ func sendMessagesToStream[DataChunkProtoMessage any](
 	payloadSizeInBytes uint64,
 	sendViaStreamFunc func(msg any) error,
 	grpcMsgConstructor func(previousChunkHash string, contentChunk []byte) (*DataChunkProtoMessage, error),
+	progressReporter ProgressReporter,
 ) error {
 	var previousChunkHash string
 	hasher := sha1.New()
 	chunkNumber := 0
+	var bytesSent uint64
 
 	buf := make([]byte, chunkSize)
 	for {
@@ -61,6 +72,11 @@ func sendMessagesToStream[DataChunkProtoMessage any](
 		hasher.Reset()
 		hasher.Write(contentChunk)
 		previousChunkHash = hex.EncodeToString(hasher.Sum(nil))
+
+		bytesSent += uint64(bytesRead)
+		if progressReporter != nil {
+			progressReporter(bytesSent, payloadSizeInBytes)
+		}
 	}
 	return nil
 }
@@ -68,18 +84,39 @@ func sendMessagesToStream[DataChunkProtoMessage any](
 // readMessagesFromStream is a helper function that reads the content of the stream until it returns an io.EOF.
 // It extracts the valuable information (i.e. the byte array and the previous chunk hash) form the generic proto message
 // using the provided grpcMsgExtractor function.
-// It returns a simple byte array corresponding to the assembled payload (concatenation of all chunks)
-// TODO: We should convert the result into a io.ReadCloser here to save memory and fully benefit from streaming.
-//
-//	Otherwise we end up storing the entire payload in memory, for large files it may cause issues.
+// It returns a simple byte array corresponding to the assembled payload (concatenation of all chunks). Prefer
+// readMessagesFromStreamToWriter for large payloads as this keeps the entire payload in memory.
 func readMessagesFromStream[DataChunkMessageType any](
 	payloadNameForLogging string,
 	readMsgFromStream func(msg any) error,
 	grpcMsgExtractor func(dataChunk *DataChunkMessageType) ([]byte, string, error),
 ) ([]byte, error) {
-	var assembledContent []byte
+	assembledContent := &bytes.Buffer{}
+	if err := readMessagesFromStreamToWriter[DataChunkMessageType](
+		payloadNameForLogging,
+		readMsgFromStream,
+		grpcMsgExtractor,
+		assembledContent,
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	return assembledContent.Bytes(), nil
+}
+
+// readMessagesFromStreamToWriter reads the content of the stream until it returns an io.EOF, writing each chunk to
+// the writer as soon as it's been received and validated. This keeps memory usage constant regardless of the size of
+// the payload.
+func readMessagesFromStreamToWriter[DataChunkMessageType any](
+	payloadNameForLogging string,
+	readMsgFromStream func(msg any) error,
+	grpcMsgExtractor func(dataChunk *DataChunkMessageType) ([]byte, string, error),
+	writer io.Writer,
+	progressReporter ProgressReporter,
+) error {
 	var blockIdx int
 	var computedPreviousBlockHash string
+	var bytesReceived uint64
 	hasher := sha1.New()
 
 	dataChunk := new(DataChunkMessageType)
@@ -87,30 +124,37 @@ func readMessagesFromStream[DataChunkMessageType any](
 	for errorReceivingChunk == nil {
 		chunkContent, previousChunkHashFromChunk, err := grpcMsgExtractor(dataChunk)
 		if err != nil {
-			return nil, stacktrace.NewError("An unexpected error occurred extracting data from the streamed GRPC "+
+			return stacktrace.NewError("An unexpected error occurred extracting data from the streamed GRPC "+
 				"message for '%s'", payloadNameForLogging)
 		}
 		logrus.Debugf("Receiving content for '%s'. Block number %d", payloadNameForLogging, blockIdx)
 
 		if previousChunkHashFromChunk != computedPreviousBlockHash {
-			return nil, stacktrace.NewError("An unexpected error occurred receiving data chunk for '%s'. Hash "+
+			return stacktrace.NewError("An unexpected error occurred receiving data chunk for '%s'. Hash "+
 				"validation did not pass: was '%s' - wanted '%s'. Maybe a block failed to be sent (networking issues) "+
 				"and now entire chain is broken. Retrying the operation might fix this issue.",
 				payloadNameForLogging, previousChunkHashFromChunk, computedPreviousBlockHash)
 		}
-		assembledContent = append(assembledContent, chunkContent...)
+		if _, err = writer.Write(chunkContent); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing block number %d of '%s'", blockIdx, payloadNameForLogging)
+		}
 
 		hasher.Reset()
 		hasher.Write(chunkContent)
 		computedPreviousBlockHash = hex.EncodeToString(hasher.Sum(nil))
 
+		bytesReceived += uint64(len(chunkContent))
+		if progressReporter != nil {
+			progressReporter(bytesReceived, UnknownContentSize)
+		}
+
 		dataChunk = new(DataChunkMessageType)
 		errorReceivingChunk = readMsgFromStream(dataChunk) // TODO: we can add a retryer here
 		blockIdx += 1
 	}
 	if errorReceivingChunk != io.EOF {
-		return nil, stacktrace.Propagate(errorReceivingChunk, "An unexpected error occurred receiving '%s'",
+		return stacktrace.Propagate(errorReceivingChunk, "An unexpected error occurred receiving '%s'",
 			payloadNameForLogging)
 	}
-	return assembledContent, nil
+	return nil
 }
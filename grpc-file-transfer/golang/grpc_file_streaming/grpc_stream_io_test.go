@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/kurtosis-tech/stacktrace"
@@ -12,8 +13,29 @@ import (
 
 const (
 	testFileName = "test-file"
+
+	// Uploads used to be refused from this size on, before they were streamed in chunks
+	formerDataTransferLimit = 2000 * 1024 * 1024
 )
 
+// zeroReader is an endless source of zeros, to stream big payloads without holding them in memory
+type zeroReader struct{}
+
+func (reader zeroReader) Read(buffer []byte) (int, error) {
+	clear(buffer)
+	return len(buffer), nil
+}
+
+// byteCounter is a writer only counting the bytes written to it
+type byteCounter struct {
+	count uint64
+}
+
+func (counter *byteCounter) Write(content []byte) (int, error) {
+	counter.count += uint64(len(content))
+	return len(content), nil
+}
+
 func TestReadMessagesFromStream_serverStream_success(t *testing.T) {
 	serverStream := NewMockServerStream(
 		NewTestDataChunk([]byte("hello "), ""),
@@ -95,7 +117,7 @@ func TestSendBytesStream_successOneChunk(t *testing.T) {
 	}
 
 	payloadSize := uint64(len(fullContent))
-	err = sendMessagesToStream[TestDataChunk](testFileName, bytes.NewReader(fullContent), payloadSize, clientStream.SendMsg, dataChunkConstructor)
+	err = sendMessagesToStream[TestDataChunk](testFileName, bytes.NewReader(fullContent), payloadSize, clientStream.SendMsg, dataChunkConstructor, nil)
 	require.NoError(t, err)
 	require.NoError(t, clientStream.CloseSend())
 	assembledContent, err := clientStream.GetAssembledContent()
@@ -114,7 +136,7 @@ func TestSendBytesStream_successMultipleChunks(t *testing.T) {
 	}
 
 	payloadSize := uint64(len(fullContent))
-	err = sendMessagesToStream[TestDataChunk](testFileName, bytes.NewReader(fullContent), payloadSize, clientStream.SendMsg, dataChunkConstructor)
+	err = sendMessagesToStream[TestDataChunk](testFileName, bytes.NewReader(fullContent), payloadSize, clientStream.SendMsg, dataChunkConstructor, nil)
 	require.NoError(t, err)
 	require.NoError(t, clientStream.CloseSend())
 	assembledContent, err := clientStream.GetAssembledContent()
@@ -123,6 +145,131 @@ func TestSendBytesStream_successMultipleChunks(t *testing.T) {
 	require.Equal(t, fullContent, assembledContent)
 }
 
+func TestReadMessagesFromStreamToWriter_reportsProgress(t *testing.T) {
+	serverStream := NewMockServerStream(
+		NewTestDataChunk([]byte("hello "), ""),
+		NewTestDataChunk([]byte("world "), "c4d871ad13ad00fde9a7bb7ff7ed2543aec54241"),
+		NewTestDataChunk([]byte("! =)"), "7b32ed4d82732b720681291852f38d511fef276e"),
+	)
+
+	chunkExtractor := func(dataChunk *TestDataChunk) ([]byte, string, error) {
+		return dataChunk.Chunk, dataChunk.PreviousChunkHash, nil
+	}
+	var reportedProgress []uint64
+	progressReporter := func(transferredBytes uint64, totalBytes uint64) {
+		require.Equal(t, UnknownContentSize, totalBytes)
+		reportedProgress = append(reportedProgress, transferredBytes)
+	}
+
+	writtenContent := &bytes.Buffer{}
+	err := readMessagesFromStreamToWriter[TestDataChunk](testFileName, serverStream.RecvMsg, chunkExtractor, writtenContent, progressReporter)
+	require.NoError(t, err)
+	require.Equal(t, "hello world ! =)", writtenContent.String())
+	require.Equal(t, []uint64{6, 12, 16}, reportedProgress)
+}
+
+func TestSendBytesStream_reportsProgress(t *testing.T) {
+	clientStream := NewMockClientStream()
+
+	fullContent, err := generateRandomByteArray(chunkSize + chunkSize/2) // 1 full chunk plus half a chunk
+	require.Nil(t, err)
+	dataChunkConstructor := func(previousChunkHash string, contentChunk []byte) (*TestDataChunk, error) {
+		return NewTestDataChunk(contentChunk, previousChunkHash), nil
+	}
+	payloadSize := uint64(len(fullContent))
+	var reportedProgress []uint64
+	progressReporter := func(transferredBytes uint64, totalBytes uint64) {
+		require.Equal(t, payloadSize, totalBytes)
+		reportedProgress = append(reportedProgress, transferredBytes)
+	}
+
+	err = sendMessagesToStream[TestDataChunk](testFileName, bytes.NewReader(fullContent), payloadSize, clientStream.SendMsg, dataChunkConstructor, progressReporter)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{chunkSize, payloadSize}, reportedProgress)
+}
+
+func TestServerStreamReceiveData_streamsContentToConsumer(t *testing.T) {
+	serverStream := NewMockServerStream(
+		NewTestDataChunk([]byte("hello "), ""),
+		NewTestDataChunk([]byte("world "), "c4d871ad13ad00fde9a7bb7ff7ed2543aec54241"),
+		NewTestDataChunk([]byte("! =)"), "7b32ed4d82732b720681291852f38d511fef276e"),
+	)
+	stream := NewServerStream[TestDataChunk, TestDataChunk](serverStream)
+
+	chunkExtractor := func(dataChunk *TestDataChunk) ([]byte, string, error) {
+		return dataChunk.Chunk, dataChunk.PreviousChunkHash, nil
+	}
+	var consumedContent []byte
+	err := stream.ReceiveData(testFileName, chunkExtractor, func(assembledContent io.Reader) (*TestDataChunk, error) {
+		content, err := io.ReadAll(assembledContent)
+		if err != nil {
+			return nil, err
+		}
+		consumedContent = content
+		return NewTestDataChunk([]byte{}, ""), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "hello world ! =)", string(consumedContent))
+}
+
+func TestServerStreamReceiveData_consumerSeesTransferError(t *testing.T) {
+	serverStream := NewMockServerStream(
+		NewTestDataChunk([]byte("hello "), ""),
+		NewTestDataChunk([]byte("world "), "this_is_not_the_correct_hash_for_block_0"),
+	)
+	stream := NewServerStream[TestDataChunk, TestDataChunk](serverStream)
+
+	chunkExtractor := func(dataChunk *TestDataChunk) ([]byte, string, error) {
+		return dataChunk.Chunk, dataChunk.PreviousChunkHash, nil
+	}
+	err := stream.ReceiveData(testFileName, chunkExtractor, func(assembledContent io.Reader) (*TestDataChunk, error) {
+		_, err := io.ReadAll(assembledContent)
+		if err != nil {
+			return nil, err
+		}
+		return NewTestDataChunk([]byte{}, ""), nil
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Hash validation did not pass")
+}
+
+func TestStreamTransfer_payloadAboveFormerTransferLimit(t *testing.T) {
+	payloadSize := uint64(formerDataTransferLimit + chunkSize + 1)
+	dataChunkConstructor := func(previousChunkHash string, contentChunk []byte) (*TestDataChunk, error) {
+		return NewTestDataChunk(contentChunk, previousChunkHash), nil
+	}
+	chunkExtractor := func(dataChunk *TestDataChunk) ([]byte, string, error) {
+		return dataChunk.Chunk, dataChunk.PreviousChunkHash, nil
+	}
+
+	// The chunks are handed over from the sender to the receiver one by one, as the GRPC stream would do
+	chunks := make(chan *TestDataChunk)
+	sendErrChan := make(chan error, 1)
+	go func() {
+		sendViaStream := func(msg any) error {
+			chunks <- msg.(*TestDataChunk)
+			return nil
+		}
+		err := sendMessagesToStream[TestDataChunk](testFileName, io.LimitReader(zeroReader{}, int64(payloadSize)), payloadSize, sendViaStream, dataChunkConstructor, nil)
+		close(chunks)
+		sendErrChan <- err
+	}()
+	readFromStream := func(msg any) error {
+		chunk, isOpen := <-chunks
+		if !isOpen {
+			return io.EOF
+		}
+		*msg.(*TestDataChunk) = *chunk
+		return nil
+	}
+
+	receivedContent := &byteCounter{count: 0}
+	err := readMessagesFromStreamToWriter[TestDataChunk](testFileName, readFromStream, chunkExtractor, receivedContent, nil)
+	require.NoError(t, err)
+	require.NoError(t, <-sendErrChan)
+	require.Equal(t, payloadSize, receivedContent.count)
+}
+
 //////////////////////////// HELPER FUNCTIONS BELOW \\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
 
 func generateRandomByteArray(size int) ([]byte, error) {
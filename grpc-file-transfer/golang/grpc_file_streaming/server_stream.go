@@ -1,7 +1,6 @@
 package grpc_file_streaming
 
 import (
-	"bytes"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
 	"io"
@@ -35,6 +34,7 @@ func (serverStream *ServerStream[DataChunkMessageType, ServerResponseType]) Send
 		contentSizeInBytes,
 		serverStream.grpcStream.SendMsg,
 		grpcMsgConstructor,
+		nil,
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred sending '%s'", contentNameForLogging)
@@ -43,31 +43,45 @@ func (serverStream *ServerStream[DataChunkMessageType, ServerResponseType]) Send
 }
 
 // ReceiveData receives some content via streaming expecting the content to be received in fixed-sized chunks from the
-// client until the client returns an EOF. Once that happens, the chunks are assembled in passed to the
-// assembledContentConsumer consumer function.
+// client until the client returns an EOF. The chunks are piped to the assembledContentConsumer consumer function as
+// they arrive, so the payload is never fully held in memory. If the transfer fails midway, the reader passed to the
+// consumer returns the transfer error.
 func (serverStream *ServerStream[DataChunkMessageType, ServerResponseType]) ReceiveData(
 	contentNameForLogging string,
 	grpcMsgExtractor func(dataChunk *DataChunkMessageType) ([]byte, string, error),
 	assembledContentConsumer func(assembledContent io.Reader) (*ServerResponseType, error),
 ) error {
-	// Read all the chunks and assemble them into a single byte array assembledContent
-	assembledContent, err := readMessagesFromStream[DataChunkMessageType](
-		contentNameForLogging,
-		serverStream.grpcStream.RecvMsg,
-		grpcMsgExtractor)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred sending '%s' through the stream", contentNameForLogging)
-	}
+	pipeReader, pipeWriter := io.Pipe()
+	receiveErrChan := make(chan error, 1)
+	go func() {
+		err := readMessagesFromStreamToWriter[DataChunkMessageType](
+			contentNameForLogging,
+			serverStream.grpcStream.RecvMsg,
+			grpcMsgExtractor,
+			pipeWriter,
+			nil,
+		)
+		// CloseWithError(nil) is equivalent to Close(), so the consumer gets io.EOF on success
+		pipeWriter.CloseWithError(err)
+		receiveErrChan <- err
+	}()
 
-	// Consume the fully assembled content and send the final response object through the stream if successful
-	response, err := assembledContentConsumer(bytes.NewReader(assembledContent))
-	if err != nil {
-		return stacktrace.Propagate(err, "Error consuming the assembled content for '%s' after all chunks were "+
-			"received", contentNameForLogging)
+	// Consume the content while it's being received
+	response, consumerErr := assembledContentConsumer(pipeReader)
+	// Unblocks the receiving goroutine in case the consumer returned without reading the entire content
+	pipeReader.Close()
+	receiveErr := <-receiveErrChan
+	// The consumer error takes precedence as it already carries the receive error if the transfer failed midway, and
+	// the receive error is only a closed pipe if the consumer bailed out early
+	if consumerErr != nil {
+		return stacktrace.Propagate(consumerErr, "Error consuming the content received for '%s'", contentNameForLogging)
+	}
+	if receiveErr != nil {
+		return stacktrace.Propagate(receiveErr, "An error occurred receiving '%s' through the stream", contentNameForLogging)
 	}
 
 	// And send the final server response object corresponding, effectively closing the stream
-	if err = serverStream.grpcStream.SendMsg(response); err != nil {
+	if err := serverStream.grpcStream.SendMsg(response); err != nil {
 		return stacktrace.Propagate(err, "Error sending the final response object for '%s' through the stream",
 			contentNameForLogging)
 	}
@@ -36,6 +36,7 @@ const (
 	createAction          = "create"
 	stopAction            = "stop"
 	destroyAction         = "destroy"
+	expireAction          = "expire"
 	runAction             = "run"
 	updateAction          = "update"
 	serviceStartAction    = "service-start"
@@ -98,6 +99,15 @@ func NewDestroyEnclaveEvent(enclaveId string) *Event {
 	return event
 }
 
+func NewExpireEnclaveEvent(enclaveId string) *Event {
+	hashedEnclaveId := hashString(strings.TrimSpace(enclaveId))
+	properties := map[string]string{
+		enclaveIDPropertyKey: hashedEnclaveId,
+	}
+	event := newEvent(enclaveCategory, expireAction, properties)
+	return event
+}
+
 func NewKurtosisRunEvent(packageId string, isRemote bool, isDryRun bool, isScript bool) *Event {
 	isRemotePackageStr := fmt.Sprintf("%v", isRemote)
 	isDryRunStr := fmt.Sprintf("%v", isDryRun)
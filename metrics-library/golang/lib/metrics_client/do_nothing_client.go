@@ -43,6 +43,12 @@ func (client *doNothingClient) TrackDestroyEnclave(enclaveId string) error {
 	return nil
 }
 
+func (client *doNothingClient) TrackExpireEnclave(enclaveId string) error {
+	logrus.Debugf("Do-nothing metrics client TrackExpireEnclave called with argument enclaveId '%v'; skipping sending event", enclaveId)
+	client.callback.Success()
+	return nil
+}
+
 func (client *doNothingClient) TrackKurtosisRun(packageId string, isRemote bool, isDryRun bool, isScript bool) error {
 	logrus.Debugf("Do-nothing metrics client TrackKurtosisRun called with arguments packageId '%v', isRemote '%v', isDryRun '%v', isScript '%v'; skipping sending event", packageId, isRemote, isDryRun, isScript)
 	client.callback.Success()
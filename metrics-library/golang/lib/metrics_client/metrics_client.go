@@ -6,6 +6,7 @@ type MetricsClient interface {
 	TrackCreateEnclave(enclaveId string, isSubnetworkingEnabled bool) error
 	TrackStopEnclave(enclaveId string) error
 	TrackDestroyEnclave(enclaveId string) error
+	TrackExpireEnclave(enclaveId string) error
 	TrackKurtosisRun(packageId string, isRemote bool, isDryRun bool, isScript bool) error
 	TrackServiceUpdate(enclaveId string, serviceId string) error
 	TrackStartService(enclaveId string, serviceId string) error
@@ -20,10 +20,14 @@ func CreateMetricsClient(options *CreateMetricsClientOption) (MetricsClient, fun
 		metricsClientType = defaultMetricsType
 	}
 
+	if err := options.sinkConfig.Validate(); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred validating the metrics sink config")
+	}
+
 	switch metricsClientType {
 	case Segment:
 		segmentCallback := newSegmentCallback(options.callbackObject.Success, options.callbackObject.Failure)
-		metricsClient, err := newSegmentClient(options.source, options.sourceVersion, options.userId, options.backendType, options.shouldFlushQueueOnEachEvent, segmentCallback, options.logger, options.isCI, options.cloudUserId, options.cloudInstanceId)
+		metricsClient, err := newSegmentClient(options.source, options.sourceVersion, options.userId, options.backendType, options.shouldFlushQueueOnEachEvent, segmentCallback, options.logger, options.isCI, options.cloudUserId, options.cloudInstanceId, options.sinkConfig)
 		if err != nil {
 			return nil, nil, stacktrace.Propagate(err, "An error occurred creating Segment metrics client")
 		}
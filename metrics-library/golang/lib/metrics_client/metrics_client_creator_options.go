@@ -17,6 +17,7 @@ type CreateMetricsClientOption struct {
 	isCI                        bool
 	cloudUserId                 CloudUserID
 	cloudInstanceId             CloudInstanceID
+	sinkConfig                  SinkConfig
 }
 
 func NewMetricsClientCreatorOption(source source.Source,
@@ -29,7 +30,8 @@ func NewMetricsClientCreatorOption(source source.Source,
 	logger analytics.Logger,
 	isCI bool,
 	cloudUserId CloudUserID,
-	cloudInstanceId CloudInstanceID) *CreateMetricsClientOption {
+	cloudInstanceId CloudInstanceID,
+	sinkConfig SinkConfig) *CreateMetricsClientOption {
 	return &CreateMetricsClientOption{
 		source:                      source,
 		sourceVersion:               sourceVersion,
@@ -42,5 +44,6 @@ func NewMetricsClientCreatorOption(source source.Source,
 		isCI:                        isCI,
 		cloudUserId:                 cloudUserId,
 		cloudInstanceId:             cloudInstanceId,
+		sinkConfig:                  sinkConfig,
 	}
 }
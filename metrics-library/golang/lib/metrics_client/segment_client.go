@@ -5,6 +5,7 @@ import (
 	metrics_source "github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/source"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/segmentio/backo-go"
+	"github.com/sirupsen/logrus"
 	"gopkg.in/segmentio/analytics-go.v3"
 	"runtime"
 	"strconv"
@@ -44,13 +45,14 @@ type segmentClient struct {
 	backendType      string
 	cloudUserId      CloudUserID
 	cloudInstanceId  CloudInstanceID
+	sinkConfig       SinkConfig
 }
 
 // The argument shouldFlushQueueOnEachEvent is used to imitate a sync request, it is not exactly the same because
 // the event is enqueued but the queue is flushed suddenly so is pretty close to event traked in sync
 // The argument callbackObject is an object that will be used by the client to notify the
 // application when messages sends to the backend API succeeded or failed.
-func newSegmentClient(source metrics_source.Source, sourceVersion string, userId string, backendType string, shouldFlushQueueOnEachEvent bool, callbackObject analytics.Callback, logger analytics.Logger, isCI bool, cloudUserId CloudUserID, cloudInstanceId CloudInstanceID) (*segmentClient, error) {
+func newSegmentClient(source metrics_source.Source, sourceVersion string, userId string, backendType string, shouldFlushQueueOnEachEvent bool, callbackObject analytics.Callback, logger analytics.Logger, isCI bool, cloudUserId CloudUserID, cloudInstanceId CloudInstanceID, sinkConfig SinkConfig) (*segmentClient, error) {
 
 	// nolint: exhaustruct
 	config := analytics.Config{
@@ -73,7 +75,13 @@ func newSegmentClient(source metrics_source.Source, sourceVersion string, userId
 		config.BatchSize = batchSizeValueForFlushAfterEveryEvent
 	}
 
-	client, err := analytics.NewWithConfig(accountWriteKey, config)
+	writeKey := accountWriteKey
+	if sinkConfig.Endpoint != "" {
+		config.Endpoint = sinkConfig.Endpoint
+		writeKey = sinkConfig.WriteKey
+	}
+
+	client, err := analytics.NewWithConfig(writeKey, config)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new Segment client with config '%+v'", config)
 	}
@@ -92,12 +100,12 @@ func newSegmentClient(source metrics_source.Source, sourceVersion string, userId
 		}
 	}
 
-	return &segmentClient{client: client, analyticsContext: analyticsContext, userID: userId, isCI: strconv.FormatBool(isCI), backendType: backendType, cloudUserId: cloudUserId, cloudInstanceId: cloudInstanceId}, nil
+	return &segmentClient{client: client, analyticsContext: analyticsContext, userID: userId, isCI: strconv.FormatBool(isCI), backendType: backendType, cloudUserId: cloudUserId, cloudInstanceId: cloudInstanceId, sinkConfig: sinkConfig}, nil
 }
 
 func (segment *segmentClient) TrackShouldSendMetricsUserElection(didUserAcceptSendingMetrics bool) error {
 	newEvent := event.NewShouldSendMetricsUserElectionEvent(didUserAcceptSendingMetrics)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Install, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking should-send-metrics user election")
 	}
 
@@ -106,7 +114,7 @@ func (segment *segmentClient) TrackShouldSendMetricsUserElection(didUserAcceptSe
 
 func (segment *segmentClient) TrackUserSharedEmailAddress(userSharedEmailAddress string) error {
 	newEvent := event.NewUserSharesEmailAddress(userSharedEmailAddress)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Install, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking user-shares-email-address event")
 	}
 	return nil
@@ -115,7 +123,7 @@ func (segment *segmentClient) TrackUserSharedEmailAddress(userSharedEmailAddress
 func (segment *segmentClient) TrackCreateEnclave(enclaveId string, isSubnetworkingEnabled bool) error {
 	newEvent := event.NewCreateEnclaveEvent(enclaveId, isSubnetworkingEnabled)
 
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Enclave, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking create enclave event")
 	}
 	return nil
@@ -123,7 +131,7 @@ func (segment *segmentClient) TrackCreateEnclave(enclaveId string, isSubnetworki
 
 func (segment *segmentClient) TrackStopEnclave(enclaveId string) error {
 	newEvent := event.NewStopEnclaveEvent(enclaveId)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Enclave, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking stop enclave event")
 	}
 	return nil
@@ -131,15 +139,23 @@ func (segment *segmentClient) TrackStopEnclave(enclaveId string) error {
 
 func (segment *segmentClient) TrackDestroyEnclave(enclaveId string) error {
 	newEvent := event.NewDestroyEnclaveEvent(enclaveId)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Enclave, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking destroy enclave event")
 	}
 	return nil
 }
 
+func (segment *segmentClient) TrackExpireEnclave(enclaveId string) error {
+	newEvent := event.NewExpireEnclaveEvent(enclaveId)
+	if err := segment.track(Category_Enclave, newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking expire enclave event")
+	}
+	return nil
+}
+
 func (segment *segmentClient) TrackKurtosisRun(packageId string, isRemote bool, isDryRun bool, isScript bool) error {
 	newEvent := event.NewKurtosisRunEvent(packageId, isRemote, isDryRun, isScript)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Run, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking run kurtosis event")
 	}
 	return nil
@@ -147,7 +163,7 @@ func (segment *segmentClient) TrackKurtosisRun(packageId string, isRemote bool,
 
 func (segment *segmentClient) TrackServiceUpdate(enclaveId string, serviceId string) error {
 	newEvent := event.NewUpdateServiceEvent(enclaveId, serviceId)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Service, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking service update event")
 	}
 	return nil
@@ -155,7 +171,7 @@ func (segment *segmentClient) TrackServiceUpdate(enclaveId string, serviceId str
 
 func (segment *segmentClient) TrackStartService(enclaveId string, serviceId string) error {
 	newEvent := event.NewStartServiceEvent(enclaveId, serviceId)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Service, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking start service event")
 	}
 	return nil
@@ -163,7 +179,7 @@ func (segment *segmentClient) TrackStartService(enclaveId string, serviceId stri
 
 func (segment *segmentClient) TrackStopService(enclaveId string, serviceId string) error {
 	newEvent := event.NewStopServiceEvent(enclaveId, serviceId)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Service, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking stop service event")
 	}
 	return nil
@@ -171,7 +187,7 @@ func (segment *segmentClient) TrackStopService(enclaveId string, serviceId strin
 
 func (segment *segmentClient) TrackKurtosisRunFinishedEvent(packageId string, numberOfServices int, isSuccess bool) error {
 	newEvent := event.NewKurtosisRunFinishedEvent(packageId, numberOfServices, isSuccess)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Run, newEvent); err != nil {
 		return stacktrace.Propagate(err, "An error occurred tracking kurtosis run finished event")
 	}
 	return nil
@@ -179,7 +195,7 @@ func (segment *segmentClient) TrackKurtosisRunFinishedEvent(packageId string, nu
 
 func (segment *segmentClient) TrackKurtosisAnalyticsToggle(analyticsStatus bool) error {
 	newEvent := event.NewKurtosisAnalyticsToggleEvent(analyticsStatus)
-	if err := segment.track(newEvent); err != nil {
+	if err := segment.track(Category_Install, newEvent); err != nil {
 		return stacktrace.Propagate(err, "an error occurred while tracking kurtosis analytics toggle event")
 	}
 	return nil
@@ -197,7 +213,11 @@ func (segment *segmentClient) close() (err error) {
 //	Private helper methods
 //
 // ====================================================================================================
-func (segment *segmentClient) track(event *event.Event) error {
+func (segment *segmentClient) track(category Category, event *event.Event) error {
+	if !segment.sinkConfig.IsCategoryEnabled(category) {
+		logrus.Debugf("Not sending event '%v' as the metrics of category '%v' are disabled", event.GetName(), category)
+		return nil
+	}
 
 	propertiesToTrack := analytics.NewProperties()
 
@@ -0,0 +1,98 @@
+package metrics_client
+
+import (
+	"net/url"
+	"sort"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Category groups the events that users can stop sending separately, without opting out of all of them
+type Category string
+
+const (
+	// The election about sending metrics, the analytics toggle and the email address shared at install time
+	Category_Install Category = "install"
+	// Creating, stopping, destroying and expiring enclaves
+	Category_Enclave Category = "enclave"
+	// Running packages and scripts, and whether they succeeded
+	Category_Run Category = "run"
+	// Starting, stopping and updating services
+	Category_Service Category = "service"
+
+	httpScheme  = "http"
+	httpsScheme = "https"
+)
+
+var allCategories = map[Category]bool{
+	Category_Install: true,
+	Category_Enclave: true,
+	Category_Run:     true,
+	Category_Service: true,
+}
+
+// SinkConfig is where the metrics are sent and which of them are. The zero value sends all of them to Kurtosis
+type SinkConfig struct {
+	// Endpoint of a collector implementing the Segment HTTP tracking API, e.g. a self-hosted RudderStack, to send the
+	// metrics to instead of Kurtosis; if empty, they're sent to Kurtosis
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Write key of the source the metrics are sent to in the collector at Endpoint
+	WriteKey string `json:"writeKey,omitempty"`
+
+	DisabledCategories []Category `json:"disabledCategories,omitempty"`
+}
+
+func NewDefaultSinkConfig() SinkConfig {
+	return SinkConfig{
+		Endpoint:           "",
+		WriteKey:           "",
+		DisabledCategories: nil,
+	}
+}
+
+// GetAllCategories returns the categories of events, sorted
+func GetAllCategories() []Category {
+	categories := []Category{}
+	for category := range allCategories {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i] < categories[j]
+	})
+	return categories
+}
+
+func IsValidCategory(category Category) bool {
+	return allCategories[category]
+}
+
+func (config SinkConfig) Validate() error {
+	if config.Endpoint != "" {
+		endpointUrl, err := url.Parse(config.Endpoint)
+		if err != nil {
+			return stacktrace.Propagate(err, "The metrics endpoint '%v' isn't a valid URL", config.Endpoint)
+		}
+		if (endpointUrl.Scheme != httpScheme && endpointUrl.Scheme != httpsScheme) || endpointUrl.Host == "" {
+			return stacktrace.NewError("The metrics endpoint '%v' must be an absolute HTTP or HTTPS URL", config.Endpoint)
+		}
+	}
+	if config.WriteKey != "" && config.Endpoint == "" {
+		return stacktrace.NewError("A metrics write key can only be set along with a metrics endpoint")
+	}
+	for _, category := range config.DisabledCategories {
+		if !IsValidCategory(category) {
+			return stacktrace.NewError("Unrecognized metrics category '%v'; the valid ones are %v", category, GetAllCategories())
+		}
+	}
+	return nil
+}
+
+func (config SinkConfig) IsCategoryEnabled(category Category) bool {
+	for _, disabledCategory := range config.DisabledCategories {
+		if disabledCategory == category {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,27 @@
+package metrics_client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinkConfigValidate(t *testing.T) {
+	require.NoError(t, NewDefaultSinkConfig().Validate())
+	require.NoError(t, SinkConfig{Endpoint: "https://metrics.example.com:8080", WriteKey: "key", DisabledCategories: []Category{Category_Run, Category_Service}}.Validate())
+
+	require.Error(t, SinkConfig{Endpoint: "metrics.example.com", WriteKey: "", DisabledCategories: nil}.Validate())
+	require.Error(t, SinkConfig{Endpoint: "", WriteKey: "key", DisabledCategories: nil}.Validate())
+	require.Error(t, SinkConfig{Endpoint: "", WriteKey: "", DisabledCategories: []Category{"services"}}.Validate())
+}
+
+func TestSinkConfigIsCategoryEnabled(t *testing.T) {
+	sinkConfig := SinkConfig{Endpoint: "", WriteKey: "", DisabledCategories: []Category{Category_Run}}
+	require.False(t, sinkConfig.IsCategoryEnabled(Category_Run))
+	require.True(t, sinkConfig.IsCategoryEnabled(Category_Enclave))
+	require.True(t, NewDefaultSinkConfig().IsCategoryEnabled(Category_Run))
+}
+
+func TestGetAllCategories(t *testing.T) {
+	require.Equal(t, []Category{Category_Enclave, Category_Install, Category_Run, Category_Service}, GetAllCategories())
+}